@@ -7,15 +7,20 @@ import (
 	"log"
 	"os"
 	"path/filepath"
+	"strings"
 	"time"
 
+	"github.com/andrescamacho/spacetraders-go/internal/adapters/alerting"
 	"github.com/andrescamacho/spacetraders-go/internal/adapters/api"
 	capacityAdapters "github.com/andrescamacho/spacetraders-go/internal/adapters/capacity"
 	expansionAdapters "github.com/andrescamacho/spacetraders-go/internal/adapters/expansion"
 	"github.com/andrescamacho/spacetraders-go/internal/adapters/graph"
 	"github.com/andrescamacho/spacetraders-go/internal/adapters/grpc"
+	"github.com/andrescamacho/spacetraders-go/internal/adapters/metrics"
 	"github.com/andrescamacho/spacetraders-go/internal/adapters/persistence"
 	"github.com/andrescamacho/spacetraders-go/internal/adapters/routing"
+	auditApp "github.com/andrescamacho/spacetraders-go/internal/application/audit"
+	auditQuery "github.com/andrescamacho/spacetraders-go/internal/application/audit/queries"
 	autooutfitCmd "github.com/andrescamacho/spacetraders-go/internal/application/autooutfit"
 	bootstrapCmd "github.com/andrescamacho/spacetraders-go/internal/application/bootstrap/commands"
 	capacityCmd "github.com/andrescamacho/spacetraders-go/internal/application/capacity/commands"
@@ -23,40 +28,48 @@ import (
 	contractCmd "github.com/andrescamacho/spacetraders-go/internal/application/contract/commands"
 	contractQuery "github.com/andrescamacho/spacetraders-go/internal/application/contract/queries"
 	contractServices "github.com/andrescamacho/spacetraders-go/internal/application/contract/services"
+	"github.com/andrescamacho/spacetraders-go/internal/application/events"
 	expansionCmd "github.com/andrescamacho/spacetraders-go/internal/application/expansion/commands"
 	fleetCmd "github.com/andrescamacho/spacetraders-go/internal/application/fleet/commands"
+	fleetQuery "github.com/andrescamacho/spacetraders-go/internal/application/fleet/queries"
+	fleetServices "github.com/andrescamacho/spacetraders-go/internal/application/fleet/services"
 	gasCmd "github.com/andrescamacho/spacetraders-go/internal/application/gas/commands"
 	gasQuery "github.com/andrescamacho/spacetraders-go/internal/application/gas/queries"
-	ledgerCmd "github.com/andrescamacho/spacetraders-go/internal/application/ledger/commands"
 	ledgerQuery "github.com/andrescamacho/spacetraders-go/internal/application/ledger/queries"
+	ledgerServices "github.com/andrescamacho/spacetraders-go/internal/application/ledger/services"
 	"github.com/andrescamacho/spacetraders-go/internal/application/liquidation"
+	logCommands "github.com/andrescamacho/spacetraders-go/internal/application/logging/commands"
 	goodsCmd "github.com/andrescamacho/spacetraders-go/internal/application/manufacturing/commands"
+	goodsQuery "github.com/andrescamacho/spacetraders-go/internal/application/manufacturing/queries"
 	goodsServices "github.com/andrescamacho/spacetraders-go/internal/application/manufacturing/services"
 	"github.com/andrescamacho/spacetraders-go/internal/application/mediator"
+	playerCmd "github.com/andrescamacho/spacetraders-go/internal/application/player/commands"
 	playerQuery "github.com/andrescamacho/spacetraders-go/internal/application/player/queries"
 	scoutingCmd "github.com/andrescamacho/spacetraders-go/internal/application/scouting/commands"
 	scoutingQuery "github.com/andrescamacho/spacetraders-go/internal/application/scouting/queries"
 	ship "github.com/andrescamacho/spacetraders-go/internal/application/ship"
 	shipAssignment "github.com/andrescamacho/spacetraders-go/internal/application/ship/commands/assignment"
-	shipCargo "github.com/andrescamacho/spacetraders-go/internal/application/ship/commands/cargo"
 	shipNav "github.com/andrescamacho/spacetraders-go/internal/application/ship/commands/navigation"
 	shipOutfit "github.com/andrescamacho/spacetraders-go/internal/application/ship/commands/outfitting"
-	shipTactics "github.com/andrescamacho/spacetraders-go/internal/application/ship/commands/tactics"
 	shipQuery "github.com/andrescamacho/spacetraders-go/internal/application/ship/queries"
-	shipTypes "github.com/andrescamacho/spacetraders-go/internal/application/ship/types"
 	shipyardCmd "github.com/andrescamacho/spacetraders-go/internal/application/shipyard/commands"
 	shipyardQuery "github.com/andrescamacho/spacetraders-go/internal/application/shipyard/queries"
+	shipyardServices "github.com/andrescamacho/spacetraders-go/internal/application/shipyard/services"
 	storageApp "github.com/andrescamacho/spacetraders-go/internal/application/storage"
 	storageCmd "github.com/andrescamacho/spacetraders-go/internal/application/storage/commands"
+	systemCmd "github.com/andrescamacho/spacetraders-go/internal/application/system/commands"
 	"github.com/andrescamacho/spacetraders-go/internal/application/system/gategraph"
 	systemQuery "github.com/andrescamacho/spacetraders-go/internal/application/system/queries"
+	tankerCmd "github.com/andrescamacho/spacetraders-go/internal/application/tanker/commands"
 	tradeRouteCmd "github.com/andrescamacho/spacetraders-go/internal/application/trading/commands"
 	tradingQueries "github.com/andrescamacho/spacetraders-go/internal/application/trading/queries"
 	tradingSvc "github.com/andrescamacho/spacetraders-go/internal/application/trading/services"
 	watchkeeper "github.com/andrescamacho/spacetraders-go/internal/captain"
 	"github.com/andrescamacho/spacetraders-go/internal/domain/capacity"
 	"github.com/andrescamacho/spacetraders-go/internal/domain/captain"
+	domainContainer "github.com/andrescamacho/spacetraders-go/internal/domain/container"
 	"github.com/andrescamacho/spacetraders-go/internal/domain/goods"
+	"github.com/andrescamacho/spacetraders-go/internal/domain/ledger"
 	"github.com/andrescamacho/spacetraders-go/internal/domain/navigation"
 	domainRouting "github.com/andrescamacho/spacetraders-go/internal/domain/routing"
 	"github.com/andrescamacho/spacetraders-go/internal/domain/shared"
@@ -66,6 +79,7 @@ import (
 	"github.com/andrescamacho/spacetraders-go/internal/infrastructure/config"
 	"github.com/andrescamacho/spacetraders-go/internal/infrastructure/database"
 	"github.com/andrescamacho/spacetraders-go/internal/infrastructure/pidfile"
+	"github.com/andrescamacho/spacetraders-go/internal/infrastructure/wiring"
 )
 
 func main() {
@@ -160,6 +174,8 @@ func run(cfg *config.Config) error {
 	tradingMarketRepo := persistence.NewMarketRepositoryAdapter(marketRepo)
 	transactionRepo := persistence.NewGormTransactionRepository(db)
 	priceHistoryRepo := persistence.NewGormMarketPriceHistoryRepository(db)
+	supplyTransitionRepo := persistence.NewMarketSupplyTransitionRepository(db)
+	laneExecutionRepo := persistence.NewTradeLaneExecutionRepository(db)
 
 	// 4. Initialize API client
 	apiClient := api.NewSpaceTradersClient()
@@ -167,12 +183,9 @@ func run(cfg *config.Config) error {
 	// GetAgent caller shares this one client, so the money guards and monitors all
 	// benefit at once; safety comes from invalidating on every credit-decreasing
 	// call inside the client. 0/unset -> the client's built-in 15s default.
-	apiClient.SetAgentCacheTTL(time.Duration(cfg.Daemon.AgentCacheTTLSeconds) * time.Second)
-	// sp-ratelimit-prio: arm priority-aware rate-limit scheduling only if the
-	// config opts in. Default/absent (false) => the client keeps the legacy
-	// FIFO/blocking token acquisition, byte-identical to before. When on,
-	// trade-critical calls jump contended status polls without changing the rate.
-	apiClient.SetPriorityScheduling(cfg.Daemon.APIPrioritySchedulingEnabled)
+	if err := applyAPIClientConfig(apiClient, nil, cfg.Daemon); err != nil {
+		return err
+	}
 	fmt.Println("API client initialized")
 
 	// 4. Initialize ship repository (adapts API responses to domain entities)
@@ -181,26 +194,50 @@ func run(cfg *config.Config) error {
 	fmt.Println("Ship repository will be initialized after waypoint provider")
 
 	// 5. Initialize routing client
-	// Use real gRPC client if routing address is configured, otherwise use mock
+	// Routing calls go through a backend registry that fails over, in the configured
+	// order, from the OR-Tools gRPC service to the in-process native and greedy
+	// planners when an attempt times out or the service is unreachable. Without a
+	// routing address the mock client is used on its own.
 	var routingClient domainRouting.RoutingClient
 	if cfg.Routing.Address != "" {
-		fmt.Printf("Connecting to routing service at %s...\n", cfg.Routing.Address)
-		grpcClient, err := routing.NewGRPCRoutingClient(cfg.Routing.Address)
-		if err != nil {
-			return fmt.Errorf("failed to create routing client: %w", err)
-		}
-		// Boot-time reachability probe (sp-g5ct): the daemon does NOT depend on the
-		// routing service being up — the lazy gRPC conn reconnects on its own — but
-		// operators should see routing state at startup. Bounded and non-fatal either way.
-		probeCtx, probeCancel := context.WithTimeout(context.Background(), 2*time.Second)
-		if probeErr := grpcClient.WaitForReady(probeCtx); probeErr != nil {
-			fmt.Printf("Routing service UNREACHABLE at boot (%s) — continuing, will reconnect (route planning degraded until it returns)\n", cfg.Routing.Address)
-		} else {
-			fmt.Printf("Routing service reachable at %s\n", cfg.Routing.Address)
+		registry := routing.NewBackendRegistry(routing.BackendTimeouts{
+			Route: cfg.Routing.Timeout.Dijkstra,
+			Tour:  cfg.Routing.Timeout.TSP,
+			Fleet: cfg.Routing.Timeout.VRP,
+		}, cfg.Routing.FailoverCooldown, nil) // nil = use RealClock
+		for _, name := range cfg.Routing.ResolvedBackends() {
+			var backend domainRouting.RoutingClient
+			switch name {
+			case routing.BackendORTools:
+				fmt.Printf("Connecting to routing service at %s...\n", cfg.Routing.Address)
+				grpcClient, err := routing.NewGRPCRoutingClient(cfg.Routing.Address)
+				if err != nil {
+					return fmt.Errorf("failed to create routing client: %w", err)
+				}
+				// Boot-time reachability probe (sp-g5ct): the daemon does NOT depend on the
+				// routing service being up — the lazy gRPC conn reconnects on its own — but
+				// operators should see routing state at startup. Bounded and non-fatal either way.
+				probeCtx, probeCancel := context.WithTimeout(context.Background(), 2*time.Second)
+				if probeErr := grpcClient.WaitForReady(probeCtx); probeErr != nil {
+					fmt.Printf("Routing service UNREACHABLE at boot (%s) — continuing, will reconnect (calls fail over until it returns)\n", cfg.Routing.Address)
+				} else {
+					fmt.Printf("Routing service reachable at %s\n", cfg.Routing.Address)
+				}
+				probeCancel()
+				backend = grpcClient
+			case routing.BackendNative:
+				backend = routing.NewNativeRoutingClient()
+			case routing.BackendGreedy:
+				backend = routing.NewGreedyRoutingClient()
+			default:
+				return fmt.Errorf("unknown routing backend %q in routing.backends", name)
+			}
+			if err := registry.Register(name, backend); err != nil {
+				return fmt.Errorf("failed to register routing backend: %w", err)
+			}
 		}
-		probeCancel()
-		routingClient = grpcClient
-		fmt.Println("Routing client initialized (gRPC OR-Tools service)")
+		routingClient = registry
+		fmt.Printf("Routing client initialized (backends: %s)\n", strings.Join(registry.Names(), " -> "))
 	} else {
 		routingClient = routing.NewMockRoutingClient()
 		fmt.Println("Routing client initialized (mock - configure routing.address to use real service)")
@@ -222,7 +259,46 @@ func run(cfg *config.Config) error {
 	// ship saves to sp-60ff last-write-wins). Setter injection keeps the 4
 	// NewShipRepository call sites untouched.
 	shipRepoImpl.SetCASRetryPolicy(cfg.Daemon.MaxCASRetries, cfg.Daemon.CASRetryDisabled)
+	// Reads land overdue arrivals themselves instead of returning IN_TRANSIT
+	// until the sweeper's next pass; arrival_sync_on_read_disabled reverts it.
+	shipRepoImpl.SetArrivalSyncOnRead(!cfg.Daemon.ArrivalSyncOnReadDisabled)
+	// Ship state cache: off unless ship_state_cache_ttl_seconds is set. The
+	// client's mutation listener is wired with it so cargo-changing calls made
+	// outside the repository (trades, transfers, deliveries) drop the entry.
+	if cfg.Daemon.ShipStateCacheTTLSeconds > 0 {
+		shipRepoImpl.SetShipStateCacheTTL(time.Duration(cfg.Daemon.ShipStateCacheTTLSeconds) * time.Second)
+		apiClient.SetShipMutationListener(shipRepoImpl.InvalidateShipState)
+	}
+	// Fuel calibration: record every navigation's predicted vs actual fuel and
+	// fit per-mode correction factors for ShipFuelService. Fit once at boot from
+	// the stored history so the factors survive restarts. The route executor,
+	// the mining estimator and stuck detection read the fit through
+	// fuelCalibration; it stays nil (uncorrected formulas) when disabled.
+	var fuelCalibration navigation.FuelCalibrationSource
+	if !cfg.Daemon.FuelCalibrationDisabled {
+		calibrationService := ship.NewFuelCalibrationService(persistence.NewFuelObservationRepository(db), cfg.Daemon.FuelCalibrationMinSamples, 0, 0)
+		calibrationCtx, calibrationCancel := context.WithTimeout(context.Background(), 10*time.Second)
+		if _, err := calibrationService.Recalibrate(calibrationCtx); err != nil {
+			fmt.Printf("Warning: initial fuel calibration failed: %v\n", err)
+		}
+		calibrationCancel()
+		shipRepoImpl.SetFuelObserver(calibrationService)
+		fuelCalibration = calibrationService
+	}
 	shipRepo = shipRepoImpl
+	// Market fee model: record each cargo transaction's quoted vs charged total
+	// and fit per-market fee rates that lane ranking and contract profitability
+	// charge. Fit once at boot so the rates survive restarts; the cargo handlers
+	// are wired as observers below.
+	var marketFees *tradingSvc.MarketFeeService
+	if !cfg.Daemon.MarketFeeModelDisabled {
+		marketFees = tradingSvc.NewMarketFeeService(persistence.NewMarketFeeObservationRepository(db), cfg.Daemon.MarketFeeMinSamples, 0, 0)
+		feeCtx, feeCancel := context.WithTimeout(context.Background(), 10*time.Second)
+		if _, err := marketFees.Recalibrate(feeCtx); err != nil {
+			fmt.Printf("Warning: initial market fee fit failed: %v\n", err)
+		}
+		feeCancel()
+	}
 	// sp-arrwait: wire the arrival-wait live-reconfirm kill-switch (live by default;
 	// arrival_wait_live_reconfirm_disabled reverts WaitForShipArrival to the pre-fix
 	// DB-only park). Mirrors SetCASRetryPolicy's boot wiring; inverted-polarity flag
@@ -230,49 +306,57 @@ func run(cfg *config.Config) error {
 	ship.SetArrivalWaitLiveReconfirm(!cfg.Daemon.ArrivalWaitLiveReconfirmDisabled)
 	fmt.Println("Ship repository initialized")
 
+	// Operator ship tags: coordinators that take a ship list also take a tag
+	// and resolve it here (see `ship tag`).
+	shipTagRepo := persistence.NewShipTagRepository(db)
+
 	// 7. Initialize mediator (CQRS dispatcher)
 	med := common.NewMediator()
 
 	// 7a. Register middleware (must be done before registering handlers)
 	med.RegisterMiddleware(common.PlayerTokenMiddleware(playerRepo))
 
-	// 8. Register command handlers
-	// Register atomic command handlers (used by RouteExecutor)
-	orbitHandler := shipTactics.NewOrbitShipHandler(shipRepo)
-	if err := mediator.RegisterHandler[*shipTypes.OrbitShipCommand](med, orbitHandler); err != nil {
-		return fmt.Errorf("failed to register OrbitShip handler: %w", err)
-	}
-
-	dockHandler := shipTactics.NewDockShipHandler(shipRepo)
-	if err := mediator.RegisterHandler[*shipTypes.DockShipCommand](med, dockHandler); err != nil {
-		return fmt.Errorf("failed to register DockShip handler: %w", err)
+	// 7b. Command audit: record every dispatched command for "who told that ship
+	// to fly" debugging. Records are queued here and written by the daemon's
+	// supervised audit loop (SetCommandAuditWriter below).
+	commandAuditRepo := persistence.NewCommandAuditRepository(db)
+	var commandAuditRecorder *auditApp.CommandAuditRecorder
+	if !cfg.Daemon.CommandAuditDisabled {
+		commandAuditRecorder = auditApp.NewCommandAuditRecorder(commandAuditRepo, cfg.Daemon.ResolvedCommandAuditRetention(), nil)
+		med.RegisterMiddleware(commandAuditRecorder.Middleware())
 	}
 
-	refuelHandler := shipTactics.NewRefuelShipHandler(shipRepo, playerRepo, apiClient, med)
-	if err := mediator.RegisterHandler[*shipTypes.RefuelShipCommand](med, refuelHandler); err != nil {
-		return fmt.Errorf("failed to register RefuelShip handler: %w", err)
+	// 7c. Per-command timeouts from config: the deadline rides the request's
+	// context, so handlers that honour ctx stop when it passes.
+	if len(cfg.Daemon.CommandTimeouts) > 0 {
+		med.RegisterMiddleware(mediator.TimeoutMiddleware(cfg.Daemon.CommandTimeouts))
 	}
 
-	setFlightModeHandler := shipNav.NewSetFlightModeHandler(shipRepo)
-	if err := mediator.RegisterHandler[*shipTypes.SetFlightModeCommand](med, setFlightModeHandler); err != nil {
-		return fmt.Errorf("failed to register SetFlightMode handler: %w", err)
-	}
-
-	navigateDirectHandler := shipNav.NewNavigateDirectHandler(shipRepo, waypointRepo)
-	if err := mediator.RegisterHandler[*shipTypes.NavigateDirectCommand](med, navigateDirectHandler); err != nil {
-		return fmt.Errorf("failed to register NavigateDirect handler: %w", err)
-	}
-
-	// Create extracted services for NavigateRouteHandler
-	waypointEnricher := ship.NewWaypointEnricher(waypointRepo)
-	routePlanner := ship.NewRoutePlanner(routingClient)
-
 	// Market scanner for automatic market data collection during navigation
-	marketScanner := ship.NewMarketScanner(apiClient, marketRepo, playerRepo, priceHistoryRepo)
+	// The deduper is shared by every opportunistic scan (route arrivals, scout
+	// tours, system warm-up), so a market any of them scanned within the window
+	// is not re-scanned by the others.
+	marketScanDeduper := ship.NewMarketScanDeduper(cfg.Daemon.ResolvedMarketScanDedupWindow(), nil)
+
+	// Coordination event bus: containers announce market scans, arrivals,
+	// contract acceptances and clean exits here so coordinators can react to
+	// each other between polls.
+	coordinationBus := events.NewBus(nil)
+	grpc.SetCoordinationEventPublisher(coordinationBus)
+	// Each scan also grades its waypoint's fuel, so route planning only schedules
+	// refuels where a market verifiably sells FUEL, and records goods whose supply
+	// level moved since the last scan.
+	marketScanner := ship.NewMarketScanner(apiClient, marketRepo, playerRepo, priceHistoryRepo).
+		WithScanDeduper(marketScanDeduper).
+		WithCapabilityRecorder(waypointRepo).
+		WithEventPublisher(coordinationBus).
+		WithSupplyTransitionRecorder(supplyTransitionRepo)
 
 	// Ship event bus for pub/sub of ship state changes (arrival, cooldown, etc.)
 	// Used by ShipStateScheduler (publisher) and RouteExecutor (subscriber)
 	shipEventBus := ship.NewShipEventBus()
+	shipEventBus.SetCoordinationPublisher(coordinationBus)
+	shipRepoImpl.SetArrivalEventPublisher(shipEventBus)
 	fmt.Println("Ship event bus initialized")
 
 	captainEventRepo := persistence.NewGormCaptainEventRepository(db)
@@ -313,23 +397,49 @@ func run(cfg *config.Config) error {
 	// route-arrival hook or a scout that visits a SHIPYARD-trait marketplace never
 	// persists a shipyard_inventory row.
 	shipyardInventoryRepo := persistence.NewShipyardInventoryRepository(db)
+	shipPriceHistoryRepo := persistence.NewShipyardPriceHistoryRepository(db)
 	shipyardScanner := ship.NewShipyardScanner(
 		apiClient, shipyardInventoryRepo, waypointRepo, captainEventRepo,
 		domainShipyard.NewHeavyShipTypeSet(cfg.Scouting.HeavyShipTypes),
-	)
+	).WithPriceHistory(shipPriceHistoryRepo)
 
 	routeExecutor := ship.NewRouteExecutor(shipRepo, med, nil, marketScanner, shipyardScanner, nil, waypointRepo, shipEventBus) // nil = use RealClock and default refuel strategy
+	routeExecutor.WithFuelPriceReader(marketRepo)
+	if fuelCalibration != nil {
+		routeExecutor.WithFuelCalibration(fuelCalibration)
+	}
+
+	// 8. Register command handlers. The core ship, navigation, cargo, market,
+	// player and ledger handlers are shared with the end-to-end test harness.
+	core, err := wiring.RegisterCoreHandlers(med, wiring.CoreDependencies{
+		DB:              db,
+		ShipRepo:        shipRepo,
+		PlayerRepo:      playerRepo,
+		WaypointRepo:    waypointRepo,
+		MarketRepo:      marketRepo,
+		TransactionRepo: transactionRepo,
+		APIClient:       apiClient,
+		RoutingClient:   routingClient,
+		GraphService:    graphService,
+		MarketScanner:   marketScanner,
+		RouteExecutor:   routeExecutor,
+		MarketFees:      marketFees,
+		BuyImpact:       cfg.TradeImpact.ResolvedBuyImpact(),
+		DockScanMaxAge:  cfg.Scouting.ResolvedDockScanMaxAge(),
+
+		CreditReservationTTL: cfg.Daemon.ResolvedCreditReservationTTL(),
+		DriftRouteMaxTime:    cfg.Daemon.ResolvedDriftRouteMaxTime(),
+		FuelReservePercent:   cfg.Daemon.ResolvedFuelReservePercent(),
+	})
+	if err != nil {
+		return err
+	}
+	waypointEnricher, routePlanner, navigateRouteHandler, cargoCostBasis := core.WaypointEnricher, core.RoutePlanner, core.NavigateRoute, core.CargoCostBasis
+	creditReservations := core.CreditReservations
 
-	// NavigateRoute handler (now uses extracted services)
-	navigateRouteHandler := shipNav.NewNavigateRouteHandler(
-		shipRepo,
-		graphService,
-		waypointEnricher,
-		routePlanner,
-		routeExecutor,
-	)
-	if err := mediator.RegisterHandler[*shipNav.NavigateRouteCommand](med, navigateRouteHandler); err != nil {
-		return fmt.Errorf("failed to register NavigateRoute handler: %w", err)
+	rescueStrandedShipHandler := shipNav.NewRescueStrandedShipHandler(shipRepo, graphService, waypointEnricher, routeExecutor, med)
+	if err := mediator.RegisterHandler[*shipNav.RescueStrandedShipCommand](med, rescueStrandedShipHandler); err != nil {
+		return fmt.Errorf("failed to register RescueStrandedShip handler: %w", err)
 	}
 
 	jumpShipHandler := shipNav.NewJumpShipHandler(shipRepo, playerRepo, apiClient, med, containerRepo, api.NewConstructionSiteRepository(apiClient, playerRepo), nil) // constructionRepo enables the at-complete-gate driveless-jump check; nil clock = RealClock
@@ -348,6 +458,9 @@ func run(cfg *config.Config) error {
 	if err := mediator.RegisterHandler[*shipOutfit.RemoveModuleCommand](med, outfittingHandler); err != nil {
 		return fmt.Errorf("failed to register RemoveModule handler: %w", err)
 	}
+	if err := mediator.RegisterHandler[*shipOutfit.InstallMountCommand](med, outfittingHandler); err != nil {
+		return fmt.Errorf("failed to register InstallMount handler: %w", err)
+	}
 	if err := mediator.RegisterHandler[*shipOutfit.ListShipModulesQuery](med, outfittingHandler); err != nil {
 		return fmt.Errorf("failed to register ListShipModules handler: %w", err)
 	}
@@ -355,35 +468,54 @@ func run(cfg *config.Config) error {
 	// Market scouting handlers (shipyardScanner constructed above, next to the
 	// route executor it now also feeds — sp-42ow emit-path fix)
 	scoutTourHandler := scoutingCmd.NewScoutTourHandler(shipRepo, med, marketScanner, shipyardScanner, nil) // nil clock = RealClock (sp-zixw)
+	scoutTourHandler.SetProgressStore(grpc.NewScoutTourConfigPersister(containerRepo))
+	scoutTourHandler.SetWaypointBlacklist(core.WaypointBlacklist)
 	if err := mediator.RegisterHandler[*scoutingCmd.ScoutTourCommand](med, scoutTourHandler); err != nil {
 		return fmt.Errorf("failed to register ScoutTour handler: %w", err)
 	}
 
-	getMarketHandler := scoutingQuery.NewGetMarketDataHandler(marketRepo)
-	if err := mediator.RegisterHandler[*scoutingQuery.GetMarketDataQuery](med, getMarketHandler); err != nil {
-		return fmt.Errorf("failed to register GetMarketData handler: %w", err)
+	exportMarketsHandler := scoutingQuery.NewExportMarketDataHandler(marketRepo, priceHistoryRepo, nil)
+	if err := mediator.RegisterHandler[*scoutingQuery.ExportMarketDataQuery](med, exportMarketsHandler); err != nil {
+		return fmt.Errorf("failed to register ExportMarketData handler: %w", err)
+	}
+
+	supplyTransitionStatsHandler := scoutingQuery.NewGetSupplyTransitionStatsHandler(supplyTransitionRepo, nil)
+	if err := mediator.RegisterHandler[*scoutingQuery.GetSupplyTransitionStatsQuery](med, supplyTransitionStatsHandler); err != nil {
+		return fmt.Errorf("failed to register GetSupplyTransitionStats handler: %w", err)
+	}
+	tradeLaneStatsHandler := tradingQueries.NewGetTradeLaneStatsHandler(laneExecutionRepo, nil)
+	if err := mediator.RegisterHandler[*tradingQueries.GetTradeLaneStatsQuery](med, tradeLaneStatsHandler); err != nil {
+		return fmt.Errorf("failed to register GetTradeLaneStats handler: %w", err)
+	}
+	runBacktestHandler := tradingQueries.NewRunBacktestHandler(priceHistoryRepo, waypointRepo)
+	if err := mediator.RegisterHandler[*tradingQueries.RunBacktestQuery](med, runBacktestHandler); err != nil {
+		return fmt.Errorf("failed to register RunBacktest handler: %w", err)
 	}
 
-	listMarketsHandler := scoutingQuery.NewListMarketDataHandler(marketRepo)
-	if err := mediator.RegisterHandler[*scoutingQuery.ListMarketDataQuery](med, listMarketsHandler); err != nil {
-		return fmt.Errorf("failed to register ListMarketData handler: %w", err)
+	// Faction reputation: RecordFactionReputation snapshots GET /my/factions after
+	// each contract fulfillment; GetFactionStanding ranks factions from that
+	// history so contract resumption prefers factions we are building reputation with.
+	factionReputationRepo := persistence.NewFactionReputationRepository(db)
+	getFactionStandingHandler := playerQuery.NewGetFactionStandingHandler(factionReputationRepo, nil)
+	if err := mediator.RegisterHandler[*playerQuery.GetFactionStandingQuery](med, getFactionStandingHandler); err != nil {
+		return fmt.Errorf("failed to register GetFactionStanding handler: %w", err)
 	}
 
-	// Player query handlers
-	getPlayerHandler := playerQuery.NewGetPlayerHandler(playerRepo, apiClient)
-	if err := mediator.RegisterHandler[*playerQuery.GetPlayerQuery](med, getPlayerHandler); err != nil {
-		return fmt.Errorf("failed to register GetPlayer handler: %w", err)
+	// Player command handlers. RegisterPlayer + SyncPlayer back the RegisterAgent
+	// RPC, which onboards a freshly registered agent without a daemon restart.
+	registerPlayerHandler := playerCmd.NewRegisterPlayerHandler(playerRepo, apiClient)
+	if err := mediator.RegisterHandler[*playerCmd.RegisterPlayerCommand](med, registerPlayerHandler); err != nil {
+		return fmt.Errorf("failed to register RegisterPlayer handler: %w", err)
 	}
 
-	// Ship query handlers
-	listShipsHandler := shipQuery.NewListShipsHandler(shipRepo, playerRepo)
-	if err := mediator.RegisterHandler[*shipQuery.ListShipsQuery](med, listShipsHandler); err != nil {
-		return fmt.Errorf("failed to register ListShips handler: %w", err)
+	syncPlayerHandler := playerCmd.NewSyncPlayerHandler(playerRepo, apiClient)
+	if err := mediator.RegisterHandler[*playerCmd.SyncPlayerCommand](med, syncPlayerHandler); err != nil {
+		return fmt.Errorf("failed to register SyncPlayer handler: %w", err)
 	}
 
-	getShipHandler := shipQuery.NewGetShipHandler(shipRepo, playerRepo)
-	if err := mediator.RegisterHandler[*shipQuery.GetShipQuery](med, getShipHandler); err != nil {
-		return fmt.Errorf("failed to register GetShip handler: %w", err)
+	recordFactionReputationHandler := playerCmd.NewRecordFactionReputationHandler(apiClient, factionReputationRepo, nil)
+	if err := mediator.RegisterHandler[*playerCmd.RecordFactionReputationCommand](med, recordFactionReputationHandler); err != nil {
+		return fmt.Errorf("failed to register RecordFactionReputation handler: %w", err)
 	}
 
 	// containerRepo satisfies ContainerStatusReader so refresh can reconcile a
@@ -401,6 +533,13 @@ func run(cfg *config.Config) error {
 		return fmt.Errorf("failed to register FindNearestJumpGate handler: %w", err)
 	}
 
+	// Route progress query: reads the per-segment snapshots routeExecutor records
+	// as each leg departs, arrives and refuels.
+	getNavigationProgressHandler := shipQuery.NewGetNavigationProgressHandler(routeExecutor.Progress(), playerRepo)
+	if err := mediator.RegisterHandler[*shipQuery.GetNavigationProgressQuery](med, getNavigationProgressHandler); err != nil {
+		return fmt.Errorf("failed to register GetNavigationProgress handler: %w", err)
+	}
+
 	getJumpGateConnectionsHandler := shipQuery.NewGetJumpGateConnectionsHandler(graphService, apiClient, playerRepo)
 	if err := mediator.RegisterHandler[*shipQuery.GetJumpGateConnectionsQuery](med, getJumpGateConnectionsHandler); err != nil {
 		return fmt.Errorf("failed to register GetJumpGateConnections handler: %w", err)
@@ -445,53 +584,89 @@ func run(cfg *config.Config) error {
 		return fmt.Errorf("failed to register GetWaypoint handler: %w", err)
 	}
 
+	systemOverviewHandler := systemQuery.NewGetSystemOverviewHandler(
+		shipRepo, containerRepo, marketRepo, contractRepo,
+		tradingQueries.NewProfitableLaneReader(marketRepo), nil,
+	)
+	if err := mediator.RegisterHandler[*systemQuery.GetSystemOverviewQuery](med, systemOverviewHandler); err != nil {
+		return fmt.Errorf("failed to register GetSystemOverview handler: %w", err)
+	}
+
+	// Graph export for debugging routes: DOT/GraphML of the system graph, with
+	// an optional planned (not flown) route overlaid.
+	exportSystemGraphHandler := systemQuery.NewExportSystemGraphHandler(graphService, waypointEnricher, shipRepo, routePlanner)
+	if err := mediator.RegisterHandler[*systemQuery.ExportSystemGraphQuery](med, exportSystemGraphHandler); err != nil {
+		return fmt.Errorf("failed to register ExportSystemGraph handler: %w", err)
+	}
+
+	// System warm-up: pre-fetches a new system's waypoints and scans the markets
+	// and shipyards where the player has ships, persisting progress into the
+	// SYSTEM_WARMUP container config for `container get`.
+	warmSystemHandler := systemCmd.NewWarmSystemHandler(graphService, waypointRepo, shipRepo, marketScanner, shipyardScanner)
+	warmSystemHandler.SetProgressPersister(grpc.NewSystemWarmupConfigPersister(containerRepo))
+	if err := mediator.RegisterHandler[*systemCmd.WarmSystemCommand](med, warmSystemHandler); err != nil {
+		return fmt.Errorf("failed to register WarmSystem handler: %w", err)
+	}
+
 	// Shipyard handlers
-	getShipyardListingsHandler := shipyardQuery.NewGetShipyardListingsHandler(apiClient, playerRepo)
+	getShipyardListingsHandler := shipyardQuery.NewGetShipyardListingsHandler(apiClient, playerRepo).WithPriceHistory(shipPriceHistoryRepo)
 	if err := mediator.RegisterHandler[*shipyardQuery.GetShipyardListingsQuery](med, getShipyardListingsHandler); err != nil {
 		return fmt.Errorf("failed to register GetShipyardListings handler: %w", err)
 	}
 
+	// Ship price history: scans and live listing reads append snapshots; the
+	// query summarises them per yard for buy-the-dip decisions.
+	getShipPriceHistoryHandler := shipyardQuery.NewGetShipPriceHistoryHandler(shipPriceHistoryRepo, nil)
+	if err := mediator.RegisterHandler[*shipyardQuery.GetShipPriceHistoryQuery](med, getShipPriceHistoryHandler); err != nil {
+		return fmt.Errorf("failed to register GetShipPriceHistory handler: %w", err)
+	}
+
+	// Command audit: read back the commands the audit middleware recorded.
+	getCommandAuditHandler := auditQuery.NewGetCommandAuditHandler(commandAuditRepo, nil)
+	if err := mediator.RegisterHandler[*auditQuery.GetCommandAuditQuery](med, getCommandAuditHandler); err != nil {
+		return fmt.Errorf("failed to register GetCommandAudit handler: %w", err)
+	}
+
 	purchaseShipHandler := shipyardCmd.NewPurchaseShipHandler(shipRepo, playerRepo, waypointRepo, graphService, apiClient, med)
+	purchaseShipHandler.SetCreditReservations(creditReservations)
 	if err := mediator.RegisterHandler[*shipyardCmd.PurchaseShipCommand](med, purchaseShipHandler); err != nil {
 		return fmt.Errorf("failed to register PurchaseShip handler: %w", err)
 	}
 
-	batchPurchaseShipsHandler := shipyardCmd.NewBatchPurchaseShipsHandler(playerRepo, med, apiClient)
+	batchPurchaseShipsHandler := shipyardCmd.NewBatchPurchaseShipsHandler(playerRepo, med, apiClient, nil) // nil = RealClock
+	batchPurchaseShipsHandler.SetLoadoutPresets(config.NewLoadoutPresetStore(cfg.Daemon.ResolvedLoadoutPresetsDir()))
+	batchPurchaseShipsHandler.SetCreditReservations(creditReservations)
 	if err := mediator.RegisterHandler[*shipyardCmd.BatchPurchaseShipsCommand](med, batchPurchaseShipsHandler); err != nil {
 		return fmt.Errorf("failed to register BatchPurchaseShips handler: %w", err)
 	}
 
-	// Cargo handlers (pass marketScanner to refresh market data after transactions)
-	purchaseCargoHandler := shipCargo.NewPurchaseCargoHandler(shipRepo, playerRepo, apiClient, marketRepo, med, marketScanner)
-	if err := mediator.RegisterHandler[*shipCargo.PurchaseCargoCommand](med, purchaseCargoHandler); err != nil {
-		return fmt.Errorf("failed to register PurchaseCargo handler: %w", err)
-	}
-
-	jettisonCargoHandler := shipCargo.NewJettisonCargoHandler(shipRepo, playerRepo, apiClient)
-	if err := mediator.RegisterHandler[*shipCargo.JettisonCargoCommand](med, jettisonCargoHandler); err != nil {
-		return fmt.Errorf("failed to register JettisonCargo handler: %w", err)
+	scrapShipHandler := shipyardCmd.NewScrapShipHandler(shipRepo, playerRepo, apiClient, med)
+	if err := mediator.RegisterHandler[*shipyardCmd.ScrapShipCommand](med, scrapShipHandler); err != nil {
+		return fmt.Errorf("failed to register ScrapShip handler: %w", err)
 	}
-
-	// Ledger handlers
-	playerResolver := common.NewPlayerResolver(playerRepo)
-	recordTransactionHandler := ledgerCmd.NewRecordTransactionHandler(transactionRepo, nil) // nil = use RealClock
-	if err := mediator.RegisterHandler[*ledgerCmd.RecordTransactionCommand](med, recordTransactionHandler); err != nil {
-		return fmt.Errorf("failed to register RecordTransaction handler: %w", err)
+	repairShipHandler := shipyardCmd.NewRepairShipHandler(shipRepo, playerRepo, apiClient, med)
+	if err := mediator.RegisterHandler[*shipyardCmd.RepairShipCommand](med, repairShipHandler); err != nil {
+		return fmt.Errorf("failed to register RepairShip handler: %w", err)
 	}
 
-	getTransactionsHandler := ledgerQuery.NewGetTransactionsHandler(transactionRepo, playerResolver)
-	if err := mediator.RegisterHandler[*ledgerQuery.GetTransactionsQuery](med, getTransactionsHandler); err != nil {
-		return fmt.Errorf("failed to register GetTransactions handler: %w", err)
+	getScrapRecommendationsHandler := fleetQuery.NewGetScrapRecommendationsHandler(shipRepo, transactionRepo, nil)
+	if err := mediator.RegisterHandler[*fleetQuery.GetScrapRecommendationsQuery](med, getScrapRecommendationsHandler); err != nil {
+		return fmt.Errorf("failed to register GetScrapRecommendations handler: %w", err)
 	}
 
-	getProfitLossHandler := ledgerQuery.NewGetProfitLossHandler(transactionRepo)
-	if err := mediator.RegisterHandler[*ledgerQuery.GetProfitLossQuery](med, getProfitLossHandler); err != nil {
-		return fmt.Errorf("failed to register GetProfitLoss handler: %w", err)
+	// Container log retention. The archive is optional: without archive_dir,
+	// pruned lines are deleted outright.
+	var logArchive domainContainer.LogArchive
+	if cfg.ContainerLogRetention.ArchiveDir != "" {
+		zstdArchive, err := persistence.NewZstdContainerLogArchive(cfg.ContainerLogRetention.ArchiveDir)
+		if err != nil {
+			return err
+		}
+		logArchive = zstdArchive
 	}
-
-	getCashFlowHandler := ledgerQuery.NewGetCashFlowHandler(transactionRepo)
-	if err := mediator.RegisterHandler[*ledgerQuery.GetCashFlowQuery](med, getCashFlowHandler); err != nil {
-		return fmt.Errorf("failed to register GetCashFlow handler: %w", err)
+	pruneLogsHandler := logCommands.NewPruneLogsHandler(containerLogRepo, logArchive, nil)
+	if err := mediator.RegisterHandler[*logCommands.PruneLogsCommand](med, pruneLogsHandler); err != nil {
+		return fmt.Errorf("failed to register PruneLogs handler: %w", err)
 	}
 
 	// Contract handlers
@@ -501,10 +676,16 @@ func run(cfg *config.Config) error {
 	}
 
 	acceptContractHandler := contractCmd.NewAcceptContractHandler(contractRepo, playerRepo, apiClient, med)
+	acceptContractHandler.SetEventPublisher(coordinationBus)
 	if err := mediator.RegisterHandler[*contractCmd.AcceptContractCommand](med, acceptContractHandler); err != nil {
 		return fmt.Errorf("failed to register AcceptContract handler: %w", err)
 	}
 
+	onboardStarterContractHandler := contractCmd.NewOnboardStarterContractHandler(contractRepo, apiClient, med)
+	if err := mediator.RegisterHandler[*contractCmd.OnboardStarterContractCommand](med, onboardStarterContractHandler); err != nil {
+		return fmt.Errorf("failed to register OnboardStarterContract handler: %w", err)
+	}
+
 	deliverContractHandler := contractCmd.NewDeliverContractHandler(contractRepo, apiClient, playerRepo)
 	if err := mediator.RegisterHandler[*contractCmd.DeliverContractCommand](med, deliverContractHandler); err != nil {
 		return fmt.Errorf("failed to register DeliverContract handler: %w", err)
@@ -516,10 +697,21 @@ func run(cfg *config.Config) error {
 	}
 
 	evaluateContractProfitabilityHandler := contractQuery.NewEvaluateContractProfitabilityHandler(shipRepo, tradingMarketRepo)
+	// Ask history for the delivery-window drift simulation; unset would keep
+	// every evaluation at instantaneous prices.
+	evaluateContractProfitabilityHandler.SetPriceHistoryReader(priceHistoryRepo)
 	if err := mediator.RegisterHandler[*contractQuery.EvaluateContractProfitabilityQuery](med, evaluateContractProfitabilityHandler); err != nil {
 		return fmt.Errorf("failed to register EvaluateContractProfitability handler: %w", err)
 	}
 
+	// Contract workflow audit trail: RunWorkflowCommand records every step it
+	// takes (see contractWorkflowOpts below); this query reads a contract's back.
+	contractWorkflowAuditRepo := persistence.NewContractWorkflowAuditRepository(db)
+	getContractWorkflowAuditHandler := contractQuery.NewGetContractWorkflowAuditHandler(contractWorkflowAuditRepo)
+	if err := mediator.RegisterHandler[*contractQuery.GetContractWorkflowAuditQuery](med, getContractWorkflowAuditHandler); err != nil {
+		return fmt.Errorf("failed to register GetContractWorkflowAudit handler: %w", err)
+	}
+
 	// ContractWorkflow handler is constructed AFTER the storage coordinator +
 	// warehouse (sp-dchv Lane B/D) so it can be wired with inventory-first
 	// sourcing — see "Inventory-first contract sourcing" below.
@@ -535,6 +727,11 @@ func run(cfg *config.Config) error {
 			ConfidenceThreshold: cfg.Contract.SourcePreposition.ConfidenceThreshold,
 		},
 	)
+	// Repositioning flies as one throttled bulk move, pairing hulls with the
+	// chosen markets by routed travel time.
+	rebalanceFleetHandler.SetFleetMover(fleetServices.NewFleetMoveService(
+		med, routePlanner, waypointRepo, nil,
+		fleetServices.DefaultFleetMoveConcurrency, fleetServices.DefaultFleetMoveLaunchSpacing))
 	if err := mediator.RegisterHandler[*contractCmd.RebalanceContractFleetCommand](med, rebalanceFleetHandler); err != nil {
 		return fmt.Errorf("failed to register RebalanceContractFleet handler: %w", err)
 	}
@@ -549,11 +746,6 @@ func run(cfg *config.Config) error {
 		return fmt.Errorf("failed to register HomeShip handler: %w", err)
 	}
 
-	sellCargoHandler := shipCargo.NewSellCargoHandler(shipRepo, playerRepo, apiClient, marketRepo, med, marketScanner)
-	if err := mediator.RegisterHandler[*shipCargo.SellCargoCommand](med, sellCargoHandler); err != nil {
-		return fmt.Errorf("failed to register SellCargo handler: %w", err)
-	}
-
 	// 7. Initialize daemon server
 	socketPath := cfg.Daemon.SocketPath
 	fmt.Printf("Starting daemon server on: %s\n", socketPath)
@@ -567,12 +759,99 @@ func run(cfg *config.Config) error {
 	if err != nil {
 		return fmt.Errorf("failed to create daemon server: %w", err)
 	}
+	shipLeases := domainContainer.NewShipAssignmentManager(nil)
+	shipLeases.SetRepository(persistence.NewShipAssignmentRepository(db))
+	daemonServer.SetShipAssignmentManager(shipLeases)
+	if cfg.Daemon.ArrivalWatcherEnabled {
+		daemonServer.SetArrivalWatcher()
+	}
+	if cfg.Daemon.StrandedShipRescueEnabled {
+		daemonServer.SetStrandedShipRescuer(grpc.NewMediatorStrandedShipRescuer(med))
+	}
+	daemonServer.SetFuelCalibration(fuelCalibration)
+	if failures := cfg.Daemon.ResolvedWaypointBlacklistFailures(); failures > 0 {
+		routeExecutor.WithWaypointFailureReporter(daemonServer.SetWaypointBlacklister(
+			core.WaypointBlacklist, failures, cfg.Daemon.ResolvedWaypointBlacklistTTL()))
+	}
+	if cfg.CashflowAlerts.Enabled {
+		sinks := []ledger.CashflowAlertSink{alerting.NewLogSink()}
+		if cfg.CashflowAlerts.WebhookURL != "" {
+			sinks = append(sinks, alerting.NewWebhookSink(cfg.CashflowAlerts.WebhookURL))
+		}
+		cashflowAlerter, err := ledgerServices.NewCashflowAlerter(transactionRepo, cfg.CashflowAlerts.ResolvedThresholds(), sinks, nil, cfg.CashflowAlerts.ResolvedCooldown())
+		if err != nil {
+			return fmt.Errorf("invalid cashflow_alerts config: %w", err)
+		}
+		daemonServer.SetCashflowAlerter(cashflowAlerter, cfg.CashflowAlerts.ResolvedCheckInterval())
+	}
+	if cfg.CreditReconciliation.Enabled {
+		creditReconciler := ledgerServices.NewCreditReconciler(transactionRepo, playerRepo, apiClient, med, cfg.CreditReconciliation.Tolerance)
+		daemonServer.SetCreditReconciler(creditReconciler, cfg.CreditReconciliation.ResolvedInterval())
+	}
+	if cfg.Contract.Janitor.Enabled {
+		contractJanitor := contractServices.NewContractJanitor(med, contractRepo, daemonServer, daemonServer)
+		daemonServer.SetContractJanitor(contractJanitor, cfg.Contract.Janitor.ResolvedInterval())
+	}
+	if cfg.StatusWatch.Enabled {
+		daemonServer.SetStatusWatch(apiClient, cfg.StatusWatch.ResolvedInterval(), cfg.StatusWatch.ResolvedWarnWithin())
+	}
+	if cfg.ContainerLogRetention.Enabled {
+		daemonServer.SetLogPruning(logCommands.PruneLogsCommand{
+			MaxAge:              cfg.ContainerLogRetention.ResolvedMaxAge(),
+			MaxRowsPerContainer: cfg.ContainerLogRetention.ResolvedMaxRows(),
+		}, cfg.ContainerLogRetention.ResolvedInterval())
+	}
+	if commandAuditRecorder != nil {
+		daemonServer.SetCommandAuditWriter(commandAuditRecorder)
+	}
+
+	// The daily summary reads API outcomes and extraction yields from the
+	// activity tracker NewDaemonServer installed, so it registers here.
+	dailyActivity := metrics.GetGlobalDailyActivityTracker()
+	getDailySummaryHandler := ledgerQuery.NewGetDailySummaryHandler(transactionRepo, dailyActivity, dailyActivity, nil)
+	if err := mediator.RegisterHandler[*ledgerQuery.GetDailySummaryQuery](med, getDailySummaryHandler); err != nil {
+		return fmt.Errorf("failed to register GetDailySummary handler: %w", err)
+	}
+	if cfg.DailySummary.Enabled {
+		daemonServer.SetDailySummaryLog(cfg.DailySummary.ResolvedInterval())
+	}
+	if cfg.Scheduler.Enabled {
+		scheduledReconciler := ledgerServices.NewCreditReconciler(transactionRepo, playerRepo, apiClient, med, cfg.CreditReconciliation.Tolerance)
+		daemonServer.SetScheduler(persistence.NewScheduledJobRepository(db), cfg.Scheduler.ResolvedCheckInterval(), cfg.Scheduler.Jobs, scheduledReconciler)
+	}
+
+	// Config hot-reload: SIGHUP or an edit to the config file re-reads it, and
+	// the subscribers below adopt the knobs they can change in place. Everything
+	// else is logged as changed and takes effect at the next restart.
+	configReloader := config.NewReloader(cfg)
+	configReloader.Subscribe("api-client", func(prev, next *config.Config) error {
+		return applyAPIClientConfig(apiClient, &prev.Daemon, next.Daemon)
+	})
+	configReloader.Subscribe("metrics-server", func(prev, next *config.Config) error {
+		return daemonServer.ApplyMetricsConfig(next.Metrics)
+	})
+	configReloader.Subscribe("market-scan-dedup", func(prev, next *config.Config) error {
+		marketScanDeduper.SetWindow(next.Daemon.ResolvedMarketScanDedupWindow())
+		return nil
+	})
+	daemonServer.SetConfigReloader(configReloader, cfg.Daemon.ResolvedConfigReloadCheckInterval())
+
+	// Read-only HTTP/JSON gateway for consumers that do not speak gRPC (opt-in).
+	if cfg.HTTPGateway.Enabled {
+		daemonServer.SetHTTPGateway(cfg.HTTPGateway.Address(), cfg.HTTPGateway.Token)
+	}
 
 	// Now that daemon server is created, register handlers that need daemonClient
 	// This avoids circular dependency (handler can call daemon server methods directly)
 	daemonClientLocal := grpc.NewDaemonClientLocal(daemonServer)
 
+	setContainerLogLevelHandler := logCommands.NewSetContainerLogLevelHandler(daemonServer)
+	if err := mediator.RegisterHandler[*logCommands.SetContainerLogLevelCommand](med, setContainerLogLevelHandler); err != nil {
+		return fmt.Errorf("failed to register SetContainerLogLevel handler: %w", err)
+	}
+
 	scoutMarketsHandler := scoutingCmd.NewScoutMarketsHandler(shipRepo, graphService, routingClient, daemonClientLocal, nil) // nil = use RealClock
+	scoutMarketsHandler.SetShipTagRepository(shipTagRepo)
 	if err := mediator.RegisterHandler[*scoutingCmd.ScoutMarketsCommand](med, scoutMarketsHandler); err != nil {
 		return fmt.Errorf("failed to register ScoutMarkets handler: %w", err)
 	}
@@ -605,6 +884,7 @@ func run(cfg *config.Config) error {
 
 	contractFleetCoordinatorHandler := contractCmd.NewRunFleetCoordinatorHandler(med, shipRepo, contractRepo, tradingMarketRepo, daemonClientLocal, graphService, waypointConverter, containerRepo, nil, captainEventRepo)
 	contractFleetCoordinatorHandler.SetEventSubscriber(shipEventBus)
+	contractFleetCoordinatorHandler.SetCoordinationSubscriber(coordinationBus)
 	// First-boot seed marker (sp-86vb): persist "the --dedicated-ships seed has
 	// been applied" into the coordinator's own container config after first boot,
 	// so a daemon restart does NOT replay the stale seed over live fleet state and
@@ -628,10 +908,22 @@ func run(cfg *config.Config) error {
 	// configured the registry is empty and contract routing is byte-identical to today
 	// (the natural off-switch). Mirrors SetIdleArbLauncher(daemonServer) above.
 	contractFleetCoordinatorHandler.SetDepotRegistryProvider(daemonServer)
+	// Procurement-by-mining: a mineable contract good whose mining estimate beats
+	// the market is mined by a contract-scoped sub-operation the daemon server
+	// launches (claim-first, recovery-safe, like the idle-arb legs).
+	contractFleetCoordinatorHandler.SetMiningProcurement(
+		contractServices.NewDepositMiningEstimator(waypointRepo, contractServices.MiningEstimatorConfig{}).WithFuelCalibration(fuelCalibration),
+		daemonServer,
+	)
 	if err := mediator.RegisterHandler[*contractCmd.RunFleetCoordinatorCommand](med, contractFleetCoordinatorHandler); err != nil {
 		return fmt.Errorf("failed to register ContractFleetCoordinator handler: %w", err)
 	}
 
+	runContractMiningHandler := contractCmd.NewRunContractMiningHandler(med, shipRepo, contractRepo, ship.NewTransferOrchestrator(shipRepo, apiClient, shipEventBus), nil)
+	if err := mediator.RegisterHandler[*contractCmd.RunContractMiningCommand](med, runContractMiningHandler); err != nil {
+		return fmt.Errorf("failed to register RunContractMining handler: %w", err)
+	}
+
 	// Register AssignScoutingFleet handler (depends on daemonClientLocal)
 	assignScoutingFleetHandler := scoutingCmd.NewAssignScoutingFleetHandler(
 		shipRepo,
@@ -641,6 +933,18 @@ func run(cfg *config.Config) error {
 		daemonClientLocal,
 		nil, // nil = use RealClock
 	)
+	// The refresh scheduler only acts on a command carrying a freshness SLA
+	// (scout-all-markets --freshness-sla); plain assignments are unchanged.
+	assignScoutingFleetHandler.SetMarketRefreshScheduler(scoutingCmd.NewMarketRefreshScheduler(
+		marketRepo,
+		scoutingCmd.MarketRefreshSchedulerConfig{
+			LeadPercent:  cfg.Scouting.MarketRefreshLeadPercent,
+			MaxMarkets:   cfg.Scouting.MarketRefreshMaxMarkets,
+			UsageWindow:  time.Duration(cfg.Scouting.MarketRefreshUsageWindowHours) * time.Hour,
+			PassInterval: time.Duration(cfg.Scouting.MarketRefreshPassIntervalSecs) * time.Second,
+		},
+		nil, // nil = use RealClock
+	))
 	if err := mediator.RegisterHandler[*scoutingCmd.AssignScoutingFleetCommand](med, assignScoutingFleetHandler); err != nil {
 		return fmt.Errorf("failed to register AssignScoutingFleet handler: %w", err)
 	}
@@ -673,6 +977,9 @@ func run(cfg *config.Config) error {
 	tradeFleetCoordinatorHandler.SetTourLauncher(daemonServer)
 	tradeFleetCoordinatorHandler.SetEventRecorder(captainEventRepo)    // sp-6wxq: emit coordinator error-loop events on reconcile streak breach
 	tradeFleetCoordinatorHandler.SetActiveContainerShips(daemonServer) // sp-6asm: reaper safety signal — hulls a live/recent container touched (never reap those)
+	// Dynamic fleet sizing pins/releases hulls through AssignShipFleet; inert unless
+	// trade_fleet.max_fleet_size is set.
+	tradeFleetCoordinatorHandler.SetFleetAssigner(tradeRouteCmd.NewMediatorTradeFleetAssigner(med))
 	if err := mediator.RegisterHandler[*tradeRouteCmd.RunTradeFleetCoordinatorCommand](med, tradeFleetCoordinatorHandler); err != nil {
 		return fmt.Errorf("failed to register TradeFleetCoordinator handler: %w", err)
 	}
@@ -735,9 +1042,18 @@ func run(cfg *config.Config) error {
 	// activation logic. Built per-player because it bakes in the playerID; the poll-loop-only
 	// collaborators (factory tracker/state, sell distributor, storage, container reader, event
 	// publisher) are left nil — construction activation uses only task/pipeline/queue/market.
+	// Each player's task queue is bounded and outlives the per-tick activator, so its depth and
+	// rejection counters stay readable through GetTaskQueueStatsQuery. A pass clears the queue
+	// before refilling it, so tasks finished since the last pass hold no quota.
+	constructionTaskQueues := goodsServices.NewTaskQueueRegistry(goodsServices.TaskQueueLimits{
+		Capacity:      cfg.Manufacturing.TaskQueueCapacity,
+		PipelineQuota: cfg.Manufacturing.TaskQueuePipelineQuota,
+	}.WithDefaults())
 	constructionActivatorFactory := func(pid int) goodsCmd.ConstructionActivator {
+		taskQueue := constructionTaskQueues.Queue(pid)
+		taskQueue.Clear()
 		return goodsServices.NewSupplyMonitor(
-			marketRepoAdapter, nil, nil, constructionPipelineRepo, goodsServices.NewTaskQueue(),
+			marketRepoAdapter, nil, nil, constructionPipelineRepo, taskQueue,
 			constructionTaskRepo, nil, goodsMarketLocator, nil, nil, nil, time.Minute, pid,
 		)
 	}
@@ -749,10 +1065,37 @@ func run(cfg *config.Config) error {
 	// intermediates that have a factory, buy abundant ones) instead of the flat one-level node —
 	// bounded by the pipeline's SupplyChainDepth + the resolver's cycle guard, config-reversible.
 	constructionCoordinatorHandler.SetTreeResolver(goodsResolver)
+	// Stuck-task watchdog: reclaim tasks left ASSIGNED/EXECUTING by a dead worker (ship released,
+	// task back to READY, DEAD_LETTER once its retries are spent). ON unless config disables it.
+	if !cfg.Manufacturing.TaskWatchdogDisabled {
+		constructionCoordinatorHandler.SetTaskWatchdog(goodsServices.NewTaskWatchdog(
+			constructionTaskRepo, shipRepo, waypointRepo, nil,
+			time.Duration(cfg.Manufacturing.TaskWatchdogMinStuckMinutes)*time.Minute,
+			cfg.Manufacturing.TaskWatchdogRouteMultiplier,
+		))
+	}
 	if err := mediator.RegisterHandler[*goodsCmd.RunConstructionCoordinatorCommand](med, constructionCoordinatorHandler); err != nil {
 		return fmt.Errorf("failed to register ConstructionCoordinator handler: %w", err)
 	}
 
+	// Register the read-only supply-chain graph query: one pipeline's factories, input
+	// deliveries, supply levels, tasks and assigned ships as a DAG, built from the same
+	// rows the coordinators persist.
+	getSupplyChainGraphHandler := goodsQuery.NewGetSupplyChainGraphHandler(
+		constructionPipelineRepo,
+		constructionTaskRepo,
+		persistence.NewGormManufacturingFactoryStateRepository(db),
+	)
+	if err := mediator.RegisterHandler[*goodsQuery.GetSupplyChainGraphQuery](med, getSupplyChainGraphHandler); err != nil {
+		return fmt.Errorf("failed to register GetSupplyChainGraph handler: %w", err)
+	}
+
+	// Register the read-only task queue stats query: depth per pipeline and rejection counters
+	// of each player's bounded manufacturing task queue.
+	if err := mediator.RegisterHandler[*goodsQuery.GetTaskQueueStatsQuery](med, goodsQuery.NewGetTaskQueueStatsHandler(constructionTaskQueues)); err != nil {
+		return fmt.Errorf("failed to register GetTaskQueueStats handler: %w", err)
+	}
+
 	// Register the standing factory-SITING coordinator (sp-vdld): the standing "brain" that
 	// automates factory discovery, placement, and capacity planning. Each slow tick it SCANs
 	// candidate (good,system) sites (export-site hard gate + in-system input eligibility +
@@ -896,6 +1239,34 @@ func run(cfg *config.Config) error {
 		return fmt.Errorf("failed to register FleetAutosizerCoordinator handler: %w", err)
 	}
 
+	// Shipyard watch: a standing container, launched at boot when [shipyard_watch] is enabled,
+	// that records when the watched ship types are in stock. An auto-purchase target buys only
+	// while the autosizer above measures unmet demand for its class and the price fits in 25%
+	// of the live treasury, with the purchasing ship claimed for the watch container.
+	if cfg.ShipyardWatch.Enabled {
+		targets := make([]shipyardCmd.ShipyardWatchTarget, 0, len(cfg.ShipyardWatch.Targets))
+		for _, t := range cfg.ShipyardWatch.Targets {
+			targets = append(targets, shipyardCmd.ShipyardWatchTarget{
+				WaypointSymbol: t.Waypoint,
+				ShipType:       t.ShipType,
+				MaxPrice:       t.MaxPrice,
+				AutoPurchase:   t.AutoPurchase,
+				PurchasingShip: t.PurchasingShip,
+				DemandClass:    t.DemandClass,
+			})
+		}
+		shipyardWatchHandler, err := shipyardCmd.NewRunShipyardWatchCoordinatorHandler(med, persistence.NewShipAvailabilityWindowRepository(db), shipRepo, targets, nil)
+		if err != nil {
+			return fmt.Errorf("invalid shipyard_watch config: %w", err)
+		}
+		shipyardWatchHandler.SetTreasuryReader(expansionAdapters.NewTreasuryReader(apiClient))
+		shipyardWatchHandler.SetDemandReader(grpc.NewShipyardWatchDemandReader(fleetAutosizerHandler, cfg.ShipyardWatch.ResolvedDemandMaxAge(), nil))
+		if err := mediator.RegisterHandler[*shipyardCmd.RunShipyardWatchCoordinatorCommand](med, shipyardWatchHandler); err != nil {
+			return fmt.Errorf("failed to register ShipyardWatchCoordinator handler: %w", err)
+		}
+		daemonServer.SetShipyardWatch(cfg.ShipyardWatch.ResolvedInterval())
+	}
+
 	// Captain bootstrap coordinator (sp-3nbe): the reconciler that drives a cold agent through the
 	// cold-start arc to the jump gate. Slice 1 runs the DATA phase (probes → target, scout every
 	// market). LIVE BY DEFAULT once first-launched (CLI/gRPC 'workflow bootstrap'), recovery-adopted
@@ -910,6 +1281,26 @@ func run(cfg *config.Config) error {
 		return fmt.Errorf("failed to register BootstrapCoordinator handler: %w", err)
 	}
 
+	// Fleet templates: BootstrapFleetCommand applies a YAML profile from the fleet templates
+	// directory — buys the starter ships, then launches the profile's standing coordinators in
+	// dependency order through the same launch verbs the CLI uses.
+	bootstrapFleetHandler := grpc.NewBootstrapFleetHandler(
+		daemonServer, config.NewFleetTemplateStore(cfg.Daemon.ResolvedFleetTemplatesDir()), shipRepo, med,
+	)
+	if err := mediator.RegisterHandler[*bootstrapCmd.BootstrapFleetCommand](med, bootstrapFleetHandler); err != nil {
+		return fmt.Errorf("failed to register BootstrapFleet handler: %w", err)
+	}
+
+	// Operation profiles: LaunchOperationProfileCommand launches one launch verb from a
+	// versioned YAML parameter set in the operation profiles directory, validated against the
+	// verb's params first and stamped with the profile revision on every container it starts.
+	launchProfileHandler := grpc.NewLaunchOperationProfileHandler(
+		daemonServer, config.NewOperationProfileStore(cfg.Daemon.ResolvedOperationProfilesDir()),
+	)
+	if err := mediator.RegisterHandler[*fleetCmd.LaunchOperationProfileCommand](med, launchProfileHandler); err != nil {
+		return fmt.Errorf("failed to register LaunchOperationProfile handler: %w", err)
+	}
+
 	// Trade-route coordinator: a single-hull pure-arbitrage circuit that runs as a
 	// recovery-safe daemon container. Registered in the daemon mediator so its
 	// NavigateRouteCommand legs resolve to the RouteExecutor-backed handler (orbit →
@@ -940,6 +1331,7 @@ func run(cfg *config.Config) error {
 	// re-adopted mid-transit before any movement (jump/navigate) instead of 4214'ing
 	// and burning the container restart budget on a routine arrival.
 	tradeRouteCoordinatorHandler.SetEventSubscriber(shipEventBus)
+	tradeRouteCoordinatorHandler.SetCreditReservations(creditReservations)
 	// sp-78ai L4: read-only absorption consult (trade-analyst Q1: "circuits write
 	// nothing") — scanLanes excludes a lane whose sell side is shadowed or whose
 	// reserved depth can't absorb a circuit tranche. Shares the SAME ledger instance
@@ -958,6 +1350,13 @@ func run(cfg *config.Config) error {
 			laneCooldownLedger,
 		)
 	}
+	// Ship maintenance: a worn hull detours to the nearest shipyard for a repair between
+	// circuits, before the wear turns into failures.
+	if cfg.ShipMaintenance.Enabled {
+		tradeRouteCoordinatorHandler.SetShipRepairer(
+			shipyardServices.NewRepairScheduler(med, waypointRepo, cfg.ShipMaintenance.ResolvedRepairThreshold()),
+		)
+	}
 	if err := mediator.RegisterHandler[*tradeRouteCmd.RunTradeRouteCoordinatorCommand](med, tradeRouteCoordinatorHandler); err != nil {
 		return fmt.Errorf("failed to register TradeRouteCoordinator handler: %w", err)
 	}
@@ -1242,6 +1641,21 @@ func run(cfg *config.Config) error {
 		return fmt.Errorf("failed to register ShipyardBackfillCoordinator handler: %w", err)
 	}
 
+	// Probe parking: the standing passive price feed. Each tick it parks idle probes at the
+	// highest-value unwatched markets (ranked off the same refresh census the market refresh
+	// scheduler reads), one per market, as docked single-market scout tours. A thin launch
+	// verb calls DaemonServer.ProbeParkingCoordinator.
+	parkProbesHandler := scoutingCmd.NewParkProbesHandler(
+		shipRepo,
+		waypointRepo,
+		marketRepo,
+		daemonClientLocal,
+		nil, // nil = use RealClock
+	)
+	if err := mediator.RegisterHandler[*scoutingCmd.ParkProbesCommand](med, parkProbesHandler); err != nil {
+		return fmt.Errorf("failed to register ParkProbes handler: %w", err)
+	}
+
 	// Capacity reconciler (epic st-7zk, foundation st-fyr): the standing declarative
 	// reconciler that drives the contract-delivery machine's actual capacity topology toward
 	// a computed desired topology (SENSE → PLAN → DIFF → GOVERN → CONVERGE), maximizing
@@ -1318,6 +1732,7 @@ func run(cfg *config.Config) error {
 	// Wait out a mid-transit re-adoption before the resume path's jump, instead of
 	// 4214'ing and burning the container restart budget on a routine arrival.
 	arbCoordinatorHandler.SetEventSubscriber(shipEventBus)
+	arbCoordinatorHandler.SetCreditReservations(creditReservations)
 	// sp-dkj7: durably record a fresh buy's cost into the container config so a
 	// restart-rebuilt resume reloads it and reports honest P&L (a resumed run skips the
 	// completed buy, which otherwise leaves TotalCost=0 and over-states NetProfit).
@@ -1325,6 +1740,15 @@ func run(cfg *config.Config) error {
 	// sp-78ai L2: convert an arb/idle-arb leg's PLANNED absorption hold into an
 	// EXECUTED recovery shadow at sale completion (shared ledger instance above).
 	arbCoordinatorHandler.SetAbsorptionLedger(absorptionLedger)
+	// Partial fills: a remainder the destination cannot absorb is split across the
+	// next-best importers in the destination system, ranked by the same distributor
+	// that spreads factory collection sells.
+	arbCoordinatorHandler.SetSellMarketRanker(goodsServices.NewSellMarketDistributor(marketRepo, constructionTaskRepo))
+	// Arrival re-price: a tranche whose destination bid collapsed in flight is taken
+	// to a better-paying market within a bounded detour, measured on cached coordinates.
+	arbCoordinatorHandler.SetWaypointRepository(waypointRepo)
+	// Keep each sold lot as a lane execution for the trade lane leaderboard.
+	arbCoordinatorHandler.SetLaneExecutionRecorder(laneExecutionRepo)
 	if err := mediator.RegisterHandler[*tradeRouteCmd.RunArbCoordinatorCommand](med, arbCoordinatorHandler); err != nil {
 		return fmt.Errorf("failed to register ArbCoordinator handler: %w", err)
 	}
@@ -1342,6 +1766,7 @@ func run(cfg *config.Config) error {
 	)
 	tourCoordinatorHandler.SetGateGraph(gateGraphService)
 	tourCoordinatorHandler.SetChartGateOnArrival(chartGateOnArrival) // sp-bcsu: chart cross-gate tour arrivals
+	tourCoordinatorHandler.SetCreditReservations(creditReservations)
 	// sp-mtvg: wire the global best-sink reader so the tour coordinator can SEE (and count
 	// on tour_candidates_dropped_total) the profitable exotic lanes whose sink is beyond the
 	// 1-gate-hop tour graph. The raw GORM repo carries BestSinksAcrossSystems; read-only.
@@ -1367,6 +1792,9 @@ func run(cfg *config.Config) error {
 	// injection. Absent/empty ⇒ no filtering ⇒ byte-identical; arming = adding goods to
 	// config.yaml + daemon restart. Cargo only — refueling never reads the tour snapshot.
 	tourCoordinatorHandler.SetCargoBlocklist(cfg.TradeFleet.CargoBlocklist)
+	// Record each sale of tour-bought cargo against the lane it travelled, the same
+	// history arb runs feed.
+	tourCoordinatorHandler.SetLaneExecutionRecorder(laneExecutionRepo)
 	// sp-v34b: stamp the tour-scan load policy so the shared arrival + post-trade scans
 	// SAMPLE the deliberate price-impact instrumentation (the top API consumer, ~80% of
 	// API) instead of scanning every market around every trade. Resolved from [trade_impact]
@@ -1385,11 +1813,13 @@ func run(cfg *config.Config) error {
 	// We'll register these handlers after storage coordinator is created.
 
 	siphonResourcesHandler := gasCmd.NewSiphonResourcesHandler(shipRepo, playerRepo, apiClient, shipEventBus)
+	siphonResourcesHandler.SetCostBasisRecorder(cargoCostBasis)
 	if err := mediator.RegisterHandler[*gasCmd.SiphonResourcesCommand](med, siphonResourcesHandler); err != nil {
 		return fmt.Errorf("failed to register SiphonResources handler: %w", err)
 	}
 
 	transferCargoHandler := gasCmd.NewTransferCargoHandler(shipRepo, apiClient)
+	transferCargoHandler.SetCostBasisRecorder(cargoCostBasis)
 	if err := mediator.RegisterHandler[*gasCmd.TransferCargoCommand](med, transferCargoHandler); err != nil {
 		return fmt.Errorf("failed to register TransferCargo handler: %w", err)
 	}
@@ -1413,6 +1843,7 @@ func run(cfg *config.Config) error {
 	gasCoordinatorHandler := gasCmd.NewRunGasCoordinatorHandler(
 		med, shipRepo, storageOperationRepo, daemonClientLocal, waypointRepo, storageCoordinator, nil, // nil = use RealClock
 	)
+	gasCoordinatorHandler.SetShipTagRepository(shipTagRepo)
 	if err := mediator.RegisterHandler[*gasCmd.RunGasCoordinatorCommand](med, gasCoordinatorHandler); err != nil {
 		return fmt.Errorf("failed to register RunGasCoordinator handler: %w", err)
 	}
@@ -1427,6 +1858,21 @@ func run(cfg *config.Config) error {
 		return fmt.Errorf("failed to register RunStorageShipWorker handler: %w", err)
 	}
 
+	// Tanker fleet: field refuels from FUEL carried in a tanker's hold, and the
+	// standing coordinator that keeps tankers stocked and stationed at the
+	// extraction sites. Launched only by `workflow tankers`.
+	refuelFromTankerHandler := tankerCmd.NewRefuelFromTankerHandler(shipRepo, apiClient)
+	refuelFromTankerHandler.SetCostBasisRecorder(cargoCostBasis)
+	if err := mediator.RegisterHandler[*tankerCmd.RefuelFromTankerCommand](med, refuelFromTankerHandler); err != nil {
+		return fmt.Errorf("failed to register RefuelFromTanker handler: %w", err)
+	}
+	rescueStrandedShipHandler.SetFuelDonor(tankerCmd.NewFieldFuelDonor(shipRepo, med))
+	tankerCoordinatorHandler := tankerCmd.NewRunTankerCoordinatorHandler(med, shipRepo, waypointRepo, nil) // nil = use RealClock
+	tankerCoordinatorHandler.SetShipTagRepository(shipTagRepo)
+	if err := mediator.RegisterHandler[*tankerCmd.RunTankerCoordinatorCommand](med, tankerCoordinatorHandler); err != nil {
+		return fmt.Errorf("failed to register RunTankerCoordinator handler: %w", err)
+	}
+
 	// Warehouse coordinator (sp-dchv Lane B): passive inventory buffer on a
 	// dedicated hull. Shares the SAME storageCoordinator as gas + manufacturing,
 	// so a warehouse hull's deposits (tour/trade legs) and withdrawals
@@ -1463,9 +1909,26 @@ func run(cfg *config.Config) error {
 	// warehouse ROI (buffer hit-rate, served-from-buffer, contract-leg-avoided) is
 	// measurable. The GORM recorder persists to warehouse_withdrawals; nil clock =
 	// RealClock. Additive/fail-open — a record error never fails the draw.
-	contractWorkflowHandler := contractCmd.NewRunWorkflowHandler(med, shipRepo, contractRepo, nil,
+	contractWorkflowOpts := []contractCmd.RunWorkflowOption{
 		contractCmd.WithInventorySourcing(contractInventoryFinder, storageCoordinator, apiClient),
-		contractCmd.WithWithdrawalRecording(persistence.NewWithdrawalEventRepository(db), nil))
+		contractCmd.WithWithdrawalRecording(persistence.NewWithdrawalEventRepository(db), nil),
+		// Delivery saga: a load whose delivery fails permanently (contract expired
+		// or fulfilled elsewhere) is sold at the best in-system bid, filed in the
+		// ledger against the contract, instead of riding the hull stranded.
+		contractCmd.WithDeliveryCompensation(marketRepo),
+		// Every negotiate/accept/purchase/navigate/deliver/fulfill step is
+		// persisted to contract_workflow_steps, queryable by contract ID.
+		contractCmd.WithWorkflowAudit(contractWorkflowAuditRepo, nil),
+	}
+	// Multi-contract delivery batching (contract.batching): opt-in. One hauler packs
+	// several accepted contracts' goods into shared holds, stops ordered by the VRP.
+	if cfg.Contract.Batching.Enabled {
+		contractWorkflowOpts = append(contractWorkflowOpts, contractCmd.WithContractBatching(
+			contractServices.NewMarketBatchSourcePlanner(marketRepo),
+			contractServices.NewVRPBatchStopOrderer(routingClient, graphService),
+			cfg.Contract.Batching.EffectiveMaxContracts()))
+	}
+	contractWorkflowHandler := contractCmd.NewRunWorkflowHandler(med, shipRepo, contractRepo, nil, contractWorkflowOpts...)
 	if err := mediator.RegisterHandler[*contractCmd.RunWorkflowCommand](med, contractWorkflowHandler); err != nil {
 		return fmt.Errorf("failed to register ContractWorkflow handler: %w", err)
 	}
@@ -1525,6 +1988,7 @@ func run(cfg *config.Config) error {
 	stockerCoordinatorHandler.SetGateGraph(gateGraphService)
 	stockerCoordinatorHandler.SetChartGateOnArrival(chartGateOnArrival) // sp-bcsu: chart cross-system haul arrivals
 	stockerCoordinatorHandler.SetEventSubscriber(shipEventBus)
+	stockerCoordinatorHandler.SetCreditReservations(creditReservations)
 	// sp-j6uz: emit a structured stock-IN event on each CONFIRMED stocker→warehouse deposit so
 	// downstream analysis can measure depot throughput/coverage (the stock-IN mirror of the
 	// kqxe withdrawal recorder wired above). Additive + fail-open — a record error never fails
@@ -1545,3 +2009,46 @@ func run(cfg *config.Config) error {
 	fmt.Println("\nDaemon stopped")
 	return nil
 }
+
+// applyAPIClientConfig pushes the [daemon] API client knobs onto the client. prev
+// is the config being replaced on a hot reload and nil at boot.
+func applyAPIClientConfig(apiClient *api.SpaceTradersClient, prev *config.DaemonConfig, next config.DaemonConfig) error {
+	// sp-oszc: cache Get Agent (the #2 API consumer) with a short TTL. Every
+	// GetAgent caller shares this one client, so the money guards and monitors all
+	// benefit at once; safety comes from invalidating on every credit-decreasing
+	// call inside the client. 0/unset -> the client's built-in 15s default.
+	apiClient.SetAgentCacheTTL(time.Duration(next.AgentCacheTTLSeconds) * time.Second)
+	// sp-ratelimit-prio: arm priority-aware rate-limit scheduling only if the
+	// config opts in. Default/absent (false) => the client keeps the legacy
+	// FIFO/blocking token acquisition, byte-identical to before. When on,
+	// trade-critical calls jump contended status polls without changing the rate.
+	// A reload only swaps the scheduler when the flag flips, so queued callers
+	// are not stranded on a discarded one.
+	if prev == nil || prev.APIPrioritySchedulingEnabled != next.APIPrioritySchedulingEnabled {
+		apiClient.SetPriorityScheduling(next.APIPrioritySchedulingEnabled)
+	}
+	// Burst held back for interactive CLI reads; 0/unset holds back nothing.
+	apiClient.SetInteractiveBurstReserve(next.APIInteractiveBurstReserve)
+	// Per-endpoint-class retry overrides. Unlisted classes keep the client's
+	// default table (purchases never re-sent after an ambiguous failure); an unset
+	// max_retries keeps the class's default budget and only moves the backoff.
+	if prev == nil && len(next.APIRetryPolicies) == 0 {
+		return nil
+	}
+	retryPolicies := make(map[api.RetryClass]api.RetryPolicy, len(next.APIRetryPolicies))
+	for name, settings := range next.APIRetryPolicies {
+		class := api.RetryClass(name)
+		policy := apiClient.DefaultClassRetryPolicy(class)
+		if settings.MaxRetries != nil {
+			policy.MaxRetries = *settings.MaxRetries
+		}
+		if settings.BackoffBaseMs > 0 {
+			policy.BackoffBase = time.Duration(settings.BackoffBaseMs) * time.Millisecond
+		}
+		retryPolicies[class] = policy
+	}
+	if err := apiClient.SetRetryPolicies(retryPolicies); err != nil {
+		return fmt.Errorf("invalid daemon.api_retry_policies: %w", err)
+	}
+	return nil
+}
@@ -21,8 +21,9 @@ import (
 // registered for type ...". Unit tests exercising a handler in isolation can
 // never catch this class; only a check of the composition root itself can.
 //
-// The check parses cmd/spacetraders-daemon/main.go - the sole production
-// composition root (internal/application/setup/handler_registry.go is a
+// The check parses cmd/spacetraders-daemon/main.go - the production
+// composition root, together with the core handler wiring it delegates to in
+// internal/infrastructure/wiring (internal/application/setup/handler_registry.go is a
 // partial/dead registry: it wires only 8 of ~50 real handlers and has no
 // caller besides a passing reference in internal/adapters/cli/ship.go, so it
 // is NOT ground truth) - for every mediator.RegisterHandler[T] call, and
@@ -42,8 +43,6 @@ import (
 var knownUnregisteredExceptions = map[string]string{
 	"RunFactoryWorkerCommand":  "type declared (internal/application/manufacturing/types/factory_types.go) with no handler implementation anywhere in the codebase; dead/aspirational code predating sp-423c, superseded by RunFactoryCoordinatorCommand",
 	"RefreshMarketDataCommand": "RefreshMarketDataHandler exists (internal/application/scouting/commands/refresh_market_data.go) but nothing constructs or dispatches this command anywhere in the codebase; dead code predating sp-423c",
-	"SyncPlayerCommand":        "handler exists (internal/application/player/commands/register_player.go) but nothing constructs or dispatches this command anywhere in the codebase, unlike its sibling RegisterPlayerCommand; dead code predating sp-423c",
-	"RegisterPlayerCommand":    "dispatched via a direct handler.Handle() call from the CLI (internal/adapters/cli/player.go), bypassing the mediator by design",
 	"CargoTransactionCommand":  "dispatched via a direct handler.Handle() call from SellCargoHandler/PurchaseCargoHandler as an internal shared-handler composition (internal/application/ship/commands/cargo/), bypassing the mediator by design",
 }
 
@@ -53,9 +52,9 @@ var knownUnregisteredExceptions = map[string]string{
 // mediator would fail with "no handler registered for type ..." in
 // production.
 func TestEveryDeclaredCommandAndQueryIsRegisteredOrExempt(t *testing.T) {
-	mainGoPath, appDir := gatePaths(t)
+	rootPaths, appDir := gatePaths(t)
 
-	registered := registeredHandlerTypes(t, mainGoPath)
+	registered := registeredHandlerTypes(t, rootPaths)
 	declared := declaredCommandAndQueryTypes(t, appDir)
 
 	for name, locations := range declared {
@@ -84,9 +83,9 @@ func TestEveryDeclaredCommandAndQueryIsRegisteredOrExempt(t *testing.T) {
 // silently exempting fewer and fewer real gaps as it drifts, or worse, mask a
 // grep mistake made when the entry was added.
 func TestKnownUnregisteredExceptionsAreStillAccurate(t *testing.T) {
-	mainGoPath, appDir := gatePaths(t)
+	rootPaths, appDir := gatePaths(t)
 
-	registered := registeredHandlerTypes(t, mainGoPath)
+	registered := registeredHandlerTypes(t, rootPaths)
 	declared := declaredCommandAndQueryTypes(t, appDir)
 
 	for name, reason := range knownUnregisteredExceptions {
@@ -158,11 +157,12 @@ func sortStrings(s []string) {
 	}
 }
 
-// gatePaths resolves main.go and the internal/application tree relative to
-// this test file's own location, so the check is independent of the working
+// gatePaths resolves the composition-root files (main.go and the shared
+// wiring package) and the internal/application tree relative to this test
+// file's own location, so the check is independent of the working
 // directory `go test` runs in (mirrors migrationsDir in
 // internal/adapters/persistence/schema_enum_drift_test.go).
-func gatePaths(t *testing.T) (mainGoPath, appDir string) {
+func gatePaths(t *testing.T) (rootPaths []string, appDir string) {
 	t.Helper()
 	_, thisFile, _, ok := runtime.Caller(0)
 	if !ok {
@@ -170,7 +170,7 @@ func gatePaths(t *testing.T) (mainGoPath, appDir string) {
 	}
 	// thisFile = <gobot>/cmd/spacetraders-daemon/main_test.go
 	dir := filepath.Dir(thisFile)
-	mainGoPath = filepath.Join(dir, "main.go")
+	mainGoPath := filepath.Join(dir, "main.go")
 	appDir = filepath.Join(dir, "..", "..", "internal", "application")
 	if _, err := os.Stat(mainGoPath); err != nil {
 		t.Fatalf("main.go not found at %s: %v", mainGoPath, err)
@@ -178,24 +178,45 @@ func gatePaths(t *testing.T) (mainGoPath, appDir string) {
 	if _, err := os.Stat(appDir); err != nil {
 		t.Fatalf("internal/application not found at %s: %v", appDir, err)
 	}
-	return mainGoPath, appDir
+	wiringFiles, err := filepath.Glob(filepath.Join(dir, "..", "..", "internal", "infrastructure", "wiring", "*.go"))
+	if err != nil {
+		t.Fatalf("glob wiring package: %v", err)
+	}
+	rootPaths = []string{mainGoPath}
+	for _, path := range wiringFiles {
+		if !strings.HasSuffix(path, "_test.go") {
+			rootPaths = append(rootPaths, path)
+		}
+	}
+	return rootPaths, appDir
 }
 
-// registeredHandlerTypes parses mainGoPath and returns the set of type names
+// registeredHandlerTypes parses each of rootPaths and returns the set of type names
 // passed as the sole type argument to every mediator.RegisterHandler[T] call,
 // e.g. mediator.RegisterHandler[*shipNav.JumpShipCommand](med, handler) yields
 // "JumpShipCommand". Matching is by short type name only, package-qualifier
 // and pointer stripped - safe because Command/Query type names are unique
 // across the codebase (enforced by the uniqueness check in the primary test).
-func registeredHandlerTypes(t *testing.T, mainGoPath string) map[string]struct{} {
+func registeredHandlerTypes(t *testing.T, rootPaths []string) map[string]struct{} {
 	t.Helper()
 	fset := token.NewFileSet()
-	file, err := parser.ParseFile(fset, mainGoPath, nil, 0)
-	if err != nil {
-		t.Fatalf("parse %s: %v", mainGoPath, err)
+	registered := make(map[string]struct{})
+	for _, path := range rootPaths {
+		file, err := parser.ParseFile(fset, path, nil, 0)
+		if err != nil {
+			t.Fatalf("parse %s: %v", path, err)
+		}
+		collectRegisteredHandlerTypes(file, registered)
 	}
+	if len(registered) == 0 {
+		t.Fatalf("parsed zero mediator.RegisterHandler[...] calls from %v; parser or main.go shape changed", rootPaths)
+	}
+	return registered
+}
 
-	registered := make(map[string]struct{})
+// collectRegisteredHandlerTypes adds the type argument of every
+// mediator.RegisterHandler[T] call in file to registered.
+func collectRegisteredHandlerTypes(file *ast.File, registered map[string]struct{}) {
 	ast.Inspect(file, func(n ast.Node) bool {
 		call, ok := n.(*ast.CallExpr)
 		if !ok {
@@ -221,10 +242,6 @@ func registeredHandlerTypes(t *testing.T, mainGoPath string) map[string]struct{}
 		}
 		return true
 	})
-	if len(registered) == 0 {
-		t.Fatalf("parsed zero mediator.RegisterHandler[...] calls from %s; parser or main.go shape changed", mainGoPath)
-	}
-	return registered
 }
 
 // typeArgName reduces a type expression (e.g. *shipNav.JumpShipCommand) to
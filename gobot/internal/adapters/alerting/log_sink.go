@@ -0,0 +1,24 @@
+package alerting
+
+import (
+	"context"
+	"log"
+
+	"github.com/andrescamacho/spacetraders-go/internal/domain/ledger"
+)
+
+// LogSink writes cashflow alerts to the daemon log.
+type LogSink struct {
+	logf func(format string, args ...interface{}) // log.Printf in prod, injected in tests
+}
+
+// NewLogSink creates a LogSink writing through log.Printf.
+func NewLogSink() *LogSink {
+	return &LogSink{logf: log.Printf}
+}
+
+// Send logs the alert as one line.
+func (s *LogSink) Send(_ context.Context, alert ledger.CashflowAlert) error {
+	s.logf("ALERT player %d: %s", alert.PlayerID.Value(), alert.Message())
+	return nil
+}
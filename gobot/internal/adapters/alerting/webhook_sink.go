@@ -0,0 +1,84 @@
+package alerting
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/andrescamacho/spacetraders-go/internal/domain/ledger"
+)
+
+// defaultWebhookTimeout bounds one POST so a hung receiver cannot stall the
+// alert loop.
+const defaultWebhookTimeout = 10 * time.Second
+
+// WebhookSink POSTs cashflow alerts as JSON to an operator-configured URL.
+type WebhookSink struct {
+	url    string
+	client *http.Client
+}
+
+// NewWebhookSink creates a WebhookSink for url.
+func NewWebhookSink(url string) *WebhookSink {
+	return &WebhookSink{url: url, client: &http.Client{Timeout: defaultWebhookTimeout}}
+}
+
+// webhookPayload is the JSON body of one alert. Text carries the rendered
+// message so chat webhooks that only display a text field still show it.
+type webhookPayload struct {
+	Text          string   `json:"text"`
+	Threshold     string   `json:"threshold"`
+	PlayerID      int      `json:"player_id"`
+	NetPerHour    int      `json:"net_per_hour"`
+	MinNetPerHour int      `json:"min_net_per_hour"`
+	WindowMinutes int      `json:"window_minutes"`
+	Inflow        int      `json:"inflow"`
+	Outflow       int      `json:"outflow"`
+	Transactions  int      `json:"transactions"`
+	Excluded      []string `json:"excluded_categories,omitempty"`
+	RaisedAt      string   `json:"raised_at"`
+}
+
+// Send POSTs the alert; any non-2xx response is an error.
+func (s *WebhookSink) Send(ctx context.Context, alert ledger.CashflowAlert) error {
+	payload := webhookPayload{
+		Text:          alert.Message(),
+		Threshold:     alert.Threshold.Name,
+		PlayerID:      alert.PlayerID.Value(),
+		NetPerHour:    alert.NetPerHour,
+		MinNetPerHour: alert.Threshold.MinNetPerHour,
+		WindowMinutes: int(alert.Threshold.Window / time.Minute),
+		Inflow:        alert.Window.Inflow,
+		Outflow:       alert.Window.Outflow,
+		Transactions:  alert.Window.Count,
+		RaisedAt:      alert.RaisedAt.UTC().Format(time.RFC3339),
+	}
+	for _, c := range alert.Threshold.ExcludeCategories {
+		payload.Excluded = append(payload.Excluded, c.String())
+	}
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return fmt.Errorf("failed to encode webhook payload: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, s.url, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("failed to build webhook request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("webhook POST failed: %w", err)
+	}
+	defer resp.Body.Close()
+	_, _ = io.Copy(io.Discard, resp.Body)
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("webhook returned HTTP %d", resp.StatusCode)
+	}
+	return nil
+}
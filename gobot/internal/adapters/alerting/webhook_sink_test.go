@@ -0,0 +1,58 @@
+package alerting
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/andrescamacho/spacetraders-go/internal/domain/ledger"
+	"github.com/andrescamacho/spacetraders-go/internal/domain/shared"
+)
+
+func testAlert() ledger.CashflowAlert {
+	return ledger.CashflowAlert{
+		PlayerID: shared.MustNewPlayerID(3),
+		Threshold: ledger.CashflowThreshold{
+			Name: "hourly-loss", Window: time.Hour, MinNetPerHour: -50000,
+			ExcludeCategories: []ledger.Category{ledger.CategoryShipInvestments},
+		},
+		Window:     ledger.CashflowWindow{Inflow: 10000, Outflow: 90000, Net: -80000, Count: 4},
+		NetPerHour: -80000,
+		RaisedAt:   time.Date(2024, 3, 1, 12, 0, 0, 0, time.UTC),
+	}
+}
+
+func TestWebhookSink_PostsAlertAsJSON(t *testing.T) {
+	var got webhookPayload
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodPost, r.Method)
+		require.Equal(t, "application/json", r.Header.Get("Content-Type"))
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&got))
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	require.NoError(t, NewWebhookSink(server.URL).Send(context.Background(), testAlert()))
+	require.Equal(t, "hourly-loss", got.Threshold)
+	require.Equal(t, 3, got.PlayerID)
+	require.Equal(t, -80000, got.NetPerHour)
+	require.Equal(t, 60, got.WindowMinutes)
+	require.Equal(t, []string{"SHIP_INVESTMENTS"}, got.Excluded)
+	require.Equal(t, "2024-03-01T12:00:00Z", got.RaisedAt)
+	require.Contains(t, got.Text, "-80000 credits/hour")
+}
+
+func TestWebhookSink_Non2xxIsAnError(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusBadGateway)
+	}))
+	defer server.Close()
+
+	err := NewWebhookSink(server.URL).Send(context.Background(), testAlert())
+	require.ErrorContains(t, err, "HTTP 502")
+}
@@ -0,0 +1,330 @@
+// Package apitest provides a SpaceTraders mock server for API-client tests.
+//
+// A Server is an httptest.Server with a routing table of fixtures: each route is
+// a method plus a path pattern ("/my/ships/{shipSymbol}/scrap") answered with a
+// canned response. Routes can queue one-shot failures, 429 rate-limit replies
+// with Retry-After, and added latency ahead of their steady response, and every
+// request is recorded for assertions. Point a client at Server.URL:
+//
+//	srv := apitest.NewServer(t)
+//	srv.On(http.MethodPost, "/my/ships/{shipSymbol}/scrap").RespondData(scrapFixture)
+//	client := api.NewSpaceTradersClientWithConfig(srv.URL, 3, time.Millisecond, clock)
+//
+// A request that matches no route is answered 404 in the API's error envelope
+// and fails the test, so a client calling the wrong path never passes silently.
+package apitest
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+// Error codes the SpaceTraders API puts in its error envelope for the
+// conditions the server simulates.
+const (
+	ErrorCodeRateLimited = 429
+	ErrorCodeNotFound    = 404
+)
+
+// Request is one request the server received.
+type Request struct {
+	Method string
+	Path   string
+	Header http.Header
+	Body   []byte
+}
+
+// JSON decodes the request body into v.
+func (r Request) JSON(v interface{}) error {
+	return json.Unmarshal(r.Body, v)
+}
+
+// Server is a SpaceTraders mock server. Safe for concurrent use.
+type Server struct {
+	*httptest.Server
+
+	tb testing.TB
+
+	mu          sync.Mutex
+	routes      []*Route
+	requests    []Request
+	rateLimited []Response // server-wide one-shot replies, ahead of any route
+}
+
+// NewServer starts a mock server that is closed when the test ends.
+func NewServer(tb testing.TB) *Server {
+	tb.Helper()
+	s := &Server{tb: tb}
+	s.Server = httptest.NewServer(http.HandlerFunc(s.serve))
+	tb.Cleanup(s.Close)
+	return s
+}
+
+// On returns the route for method and pattern, creating it if needed. Pattern
+// segments written {name} match any single path segment.
+func (s *Server) On(method, pattern string) *Route {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, route := range s.routes {
+		if route.method == method && route.pattern == pattern {
+			return route
+		}
+	}
+	route := &Route{
+		server:   s,
+		method:   method,
+		pattern:  pattern,
+		segments: splitPath(pattern),
+		steady:   Response{Status: http.StatusOK, Body: `{"data":{}}`},
+	}
+	s.routes = append(s.routes, route)
+	return route
+}
+
+// RateLimitNext answers the next n requests to any route with 429 and a
+// Retry-After of retryAfter, as the API does when the account's request budget
+// is spent.
+func (s *Server) RateLimitNext(n int, retryAfter time.Duration) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for i := 0; i < n; i++ {
+		s.rateLimited = append(s.rateLimited, rateLimitResponse(retryAfter))
+	}
+}
+
+// Requests returns every request received so far, in arrival order.
+func (s *Server) Requests() []Request {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]Request(nil), s.requests...)
+}
+
+// Calls returns how many requests hit the route for method and pattern.
+func (s *Server) Calls(method, pattern string) int {
+	segments := splitPath(pattern)
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	count := 0
+	for _, req := range s.requests {
+		if req.Method == method && matchSegments(segments, splitPath(req.Path)) {
+			count++
+		}
+	}
+	return count
+}
+
+func (s *Server) serve(w http.ResponseWriter, r *http.Request) {
+	body, _ := io.ReadAll(r.Body)
+
+	s.mu.Lock()
+	s.requests = append(s.requests, Request{
+		Method: r.Method,
+		Path:   r.URL.Path,
+		Header: r.Header.Clone(),
+		Body:   body,
+	})
+	var resp Response
+	var handler http.HandlerFunc
+	if len(s.rateLimited) > 0 {
+		resp, s.rateLimited = s.rateLimited[0], s.rateLimited[1:]
+	} else if route := s.match(r.Method, r.URL.Path); route != nil {
+		resp, handler = route.next()
+	} else {
+		s.mu.Unlock()
+		s.tb.Errorf("apitest: no fixture for %s %s", r.Method, r.URL.Path)
+		writeResponse(w, errorResponse(http.StatusNotFound, ErrorCodeNotFound, "no fixture for "+r.Method+" "+r.URL.Path))
+		return
+	}
+	s.mu.Unlock()
+
+	if resp.Latency > 0 {
+		select {
+		case <-time.After(resp.Latency):
+		case <-r.Context().Done():
+			return
+		}
+	}
+	if handler != nil {
+		r.Body = io.NopCloser(strings.NewReader(string(body)))
+		handler(w, r)
+		return
+	}
+	writeResponse(w, resp)
+}
+
+// match returns the first route whose method and pattern match. Callers hold mu.
+func (s *Server) match(method, path string) *Route {
+	segments := splitPath(path)
+	for _, route := range s.routes {
+		if route.method == method && matchSegments(route.segments, segments) {
+			return route
+		}
+	}
+	return nil
+}
+
+// Response is one canned reply.
+type Response struct {
+	Status  int
+	Body    string
+	Header  http.Header
+	Latency time.Duration
+}
+
+// Route is the fixture for one method and path pattern: a queue of one-shot
+// responses served first, then a steady response for every later call.
+type Route struct {
+	server   *Server
+	method   string
+	pattern  string
+	segments []string
+
+	queue   []Response
+	steady  Response
+	handler http.HandlerFunc
+	latency time.Duration
+}
+
+// Respond sets the steady response to status with a raw JSON body.
+func (r *Route) Respond(status int, body string) *Route {
+	r.server.mu.Lock()
+	defer r.server.mu.Unlock()
+	r.steady = Response{Status: status, Body: body}
+	r.handler = nil
+	return r
+}
+
+// RespondData sets the steady response to 200 with data wrapped in the API's
+// {"data": ...} envelope. data may be a raw JSON string or any value
+// encoding/json can marshal.
+func (r *Route) RespondData(data interface{}) *Route {
+	return r.Respond(http.StatusOK, dataEnvelope(r.server.tb, data))
+}
+
+// Fail sets the steady response to an API error.
+func (r *Route) Fail(status, code int, message string) *Route {
+	resp := errorResponse(status, code, message)
+	return r.Respond(resp.Status, resp.Body)
+}
+
+// Handle answers the route with a custom handler, for the rare fixture that
+// must inspect the request to build its reply. Queued responses still go first.
+func (r *Route) Handle(handler http.HandlerFunc) *Route {
+	r.server.mu.Lock()
+	defer r.server.mu.Unlock()
+	r.handler = handler
+	return r
+}
+
+// FailNext queues n API errors ahead of the steady response.
+func (r *Route) FailNext(n, status, code int, message string) *Route {
+	return r.enqueue(n, errorResponse(status, code, message))
+}
+
+// RateLimitNext queues n 429 replies carrying Retry-After ahead of the steady
+// response.
+func (r *Route) RateLimitNext(n int, retryAfter time.Duration) *Route {
+	return r.enqueue(n, rateLimitResponse(retryAfter))
+}
+
+// WithLatency delays every reply on this route by d. A client that gives up
+// first sees its own timeout, as against a slow API.
+func (r *Route) WithLatency(d time.Duration) *Route {
+	r.server.mu.Lock()
+	defer r.server.mu.Unlock()
+	r.latency = d
+	return r
+}
+
+func (r *Route) enqueue(n int, resp Response) *Route {
+	r.server.mu.Lock()
+	defer r.server.mu.Unlock()
+	for i := 0; i < n; i++ {
+		r.queue = append(r.queue, resp)
+	}
+	return r
+}
+
+// next pops the reply for one call. Callers hold the server's mu.
+func (r *Route) next() (Response, http.HandlerFunc) {
+	if len(r.queue) > 0 {
+		resp := r.queue[0]
+		r.queue = r.queue[1:]
+		resp.Latency = r.latency
+		return resp, nil
+	}
+	resp := r.steady
+	resp.Latency = r.latency
+	return resp, r.handler
+}
+
+func errorResponse(status, code int, message string) Response {
+	body, _ := json.Marshal(map[string]interface{}{
+		"error": map[string]interface{}{"code": code, "message": message},
+	})
+	return Response{Status: status, Body: string(body)}
+}
+
+func rateLimitResponse(retryAfter time.Duration) Response {
+	resp := errorResponse(http.StatusTooManyRequests, ErrorCodeRateLimited, "You have reached your API limit.")
+	resp.Header = http.Header{}
+	// Retry-After is whole seconds on the wire; round up so a sub-second wait
+	// is never advertised as 0.
+	seconds := int((retryAfter + time.Second - 1) / time.Second)
+	resp.Header.Set("Retry-After", strconv.Itoa(seconds))
+	return resp
+}
+
+func dataEnvelope(tb testing.TB, data interface{}) string {
+	raw, ok := data.(string)
+	if !ok {
+		encoded, err := json.Marshal(data)
+		if err != nil {
+			tb.Fatalf("apitest: cannot encode fixture data: %v", err)
+		}
+		raw = string(encoded)
+	}
+	return fmt.Sprintf(`{"data":%s}`, raw)
+}
+
+func writeResponse(w http.ResponseWriter, resp Response) {
+	for key, values := range resp.Header {
+		for _, value := range values {
+			w.Header().Add(key, value)
+		}
+	}
+	w.Header().Set("Content-Type", "application/json")
+	status := resp.Status
+	if status == 0 {
+		status = http.StatusOK
+	}
+	w.WriteHeader(status)
+	_, _ = io.WriteString(w, resp.Body)
+}
+
+func splitPath(path string) []string {
+	return strings.Split(strings.Trim(path, "/"), "/")
+}
+
+func matchSegments(pattern, path []string) bool {
+	if len(pattern) != len(path) {
+		return false
+	}
+	for i, segment := range pattern {
+		if strings.HasPrefix(segment, "{") && strings.HasSuffix(segment, "}") {
+			continue
+		}
+		if segment != path[i] {
+			return false
+		}
+	}
+	return true
+}
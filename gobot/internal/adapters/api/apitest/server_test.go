@@ -0,0 +1,105 @@
+package apitest
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+)
+
+func do(t *testing.T, srv *Server, method, path string) (*http.Response, string) {
+	t.Helper()
+	req, err := http.NewRequest(method, srv.URL+path, strings.NewReader(`{"units":5}`))
+	if err != nil {
+		t.Fatalf("new request: %v", err)
+	}
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatalf("%s %s: %v", method, path, err)
+	}
+	defer resp.Body.Close()
+	body, _ := io.ReadAll(resp.Body)
+	return resp, string(body)
+}
+
+func TestServer_MatchesPatternsAndWrapsData(t *testing.T) {
+	srv := NewServer(t)
+	srv.On(http.MethodGet, "/my/ships/{shipSymbol}").RespondData(map[string]string{"symbol": "SHIP-1"})
+	srv.On(http.MethodPost, "/my/ships/{shipSymbol}/scrap").RespondData(`{"transaction":{"totalPrice":12}}`)
+
+	resp, body := do(t, srv, http.MethodGet, "/my/ships/SHIP-1")
+	if resp.StatusCode != http.StatusOK || body != `{"data":{"symbol":"SHIP-1"}}` {
+		t.Fatalf("unexpected reply %d %s", resp.StatusCode, body)
+	}
+	if _, body := do(t, srv, http.MethodPost, "/my/ships/SHIP-2/scrap"); body != `{"data":{"transaction":{"totalPrice":12}}}` {
+		t.Fatalf("raw JSON data must be wrapped as-is, got %s", body)
+	}
+
+	if got := srv.Calls(http.MethodPost, "/my/ships/{shipSymbol}/scrap"); got != 1 {
+		t.Fatalf("expected one scrap call, got %d", got)
+	}
+	requests := srv.Requests()
+	var payload struct{ Units int }
+	if len(requests) != 2 || requests[1].JSON(&payload) != nil || payload.Units != 5 {
+		t.Fatalf("requests must be recorded with their bodies, got %+v", requests)
+	}
+}
+
+func TestServer_QueuedFailuresGoFirst(t *testing.T) {
+	srv := NewServer(t)
+	srv.On(http.MethodGet, "/my/agent").
+		RespondData(`{"credits":100}`).
+		FailNext(1, http.StatusBadRequest, 4000, "bad").
+		RateLimitNext(1, 1500*time.Millisecond)
+
+	resp, body := do(t, srv, http.MethodGet, "/my/agent")
+	var envelope struct {
+		Error struct {
+			Code    int
+			Message string
+		}
+	}
+	_ = json.Unmarshal([]byte(body), &envelope)
+	if resp.StatusCode != http.StatusBadRequest || envelope.Error.Code != 4000 || envelope.Error.Message != "bad" {
+		t.Fatalf("expected the queued error first, got %d %s", resp.StatusCode, body)
+	}
+
+	resp, _ = do(t, srv, http.MethodGet, "/my/agent")
+	if resp.StatusCode != http.StatusTooManyRequests || resp.Header.Get("Retry-After") != "2" {
+		t.Fatalf("expected 429 with Retry-After rounded up to 2s, got %d %q", resp.StatusCode, resp.Header.Get("Retry-After"))
+	}
+
+	if resp, _ := do(t, srv, http.MethodGet, "/my/agent"); resp.StatusCode != http.StatusOK {
+		t.Fatalf("the steady response follows the queue, got %d", resp.StatusCode)
+	}
+}
+
+func TestServer_ServerWideRateLimitCoversEveryRoute(t *testing.T) {
+	srv := NewServer(t)
+	srv.On(http.MethodGet, "/my/agent").RespondData(`{}`)
+	srv.RateLimitNext(2, time.Second)
+
+	for i := 0; i < 2; i++ {
+		if resp, _ := do(t, srv, http.MethodGet, "/my/agent"); resp.StatusCode != http.StatusTooManyRequests {
+			t.Fatalf("request %d: expected 429, got %d", i+1, resp.StatusCode)
+		}
+	}
+	if resp, _ := do(t, srv, http.MethodGet, "/my/agent"); resp.StatusCode != http.StatusOK {
+		t.Fatalf("expected the route after the limit cleared, got %d", resp.StatusCode)
+	}
+}
+
+func TestServer_LatencyOutlastsAShortTimeout(t *testing.T) {
+	srv := NewServer(t)
+	srv.On(http.MethodGet, "/my/agent").RespondData(`{}`).WithLatency(200 * time.Millisecond)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	req, _ := http.NewRequestWithContext(ctx, http.MethodGet, srv.URL+"/my/agent", nil)
+	if _, err := http.DefaultClient.Do(req); err == nil {
+		t.Fatal("a client timeout shorter than the injected latency must fail")
+	}
+}
@@ -95,6 +95,19 @@ type SpaceTradersClient struct {
 	// the hot path of every request, so it is an atomic pointer to stay race-free
 	// with the boot-time setter.
 	scheduler atomic.Pointer[priorityScheduler]
+
+	// interactiveReserve is how many burst tokens background calls leave in
+	// the bucket for interactive ones (SetInteractiveBurstReserve). Zero — the
+	// default — reserves nothing.
+	interactiveReserve atomic.Int64
+
+	// shipMutationListener, when set, is told which ships each non-GET request
+	// touched (SetShipMutationListener). Nil — the default — notifies nobody.
+	shipMutationListener atomic.Pointer[ShipMutationListener]
+
+	// retryPolicies is the per-endpoint-class retry table (SetRetryPolicies).
+	// Nil — until the daemon wires config — means defaultRetryPolicies.
+	retryPolicies atomic.Pointer[map[RetryClass]RetryPolicy]
 }
 
 // NewSpaceTradersClient creates a new SpaceTraders API client with default settings
@@ -223,8 +236,14 @@ func (c *SpaceTradersClient) SetPriorityScheduling(enabled bool) {
 // c.rateLimiter.Wait(ctx). With it ON, the acquisition is ordered by the call's
 // priority (endpoint classification, overridable via WithPriority), but every
 // token still comes from the SAME limiter, so the rate/burst/refill are
-// unchanged — only the order of contended waiters differs.
+// unchanged — only the order of contended waiters differs. Calls that are not
+// interactive first wait out any interactive burst reserve.
 func (c *SpaceTradersClient) acquireRateToken(ctx context.Context, endpoint string) error {
+	if p, _ := PriorityFromContext(ctx); p != PriorityInteractive {
+		if err := c.waitAboveInteractiveReserve(ctx); err != nil {
+			return err
+		}
+	}
 	if s := c.scheduler.Load(); s != nil {
 		return s.wait(ctx, priorityForRequest(ctx, endpoint))
 	}
@@ -246,6 +265,23 @@ func (c *SpaceTradersClient) GetShip(ctx context.Context, symbol, token string)
 	return response.Data.toShipData(), nil
 }
 
+// GetShipNav retrieves only a ship's nav block. It costs the same rate-limit
+// token as GetShip but skips cargo, modules and mounts, so arrival checks use
+// it instead of a full ship read.
+func (c *SpaceTradersClient) GetShipNav(ctx context.Context, symbol, token string) (*navigation.ShipNavData, error) {
+	path := fmt.Sprintf("/my/ships/%s/nav", symbol)
+
+	var response struct {
+		Data shipNavDTO `json:"data"`
+	}
+
+	if err := c.request(ctx, "GET", path, token, nil, &response); err != nil {
+		return nil, fmt.Errorf("failed to get ship nav: %w", err)
+	}
+
+	return response.Data.toShipNavData(symbol), nil
+}
+
 // ListShips retrieves all ships for the authenticated agent
 // Uses pagination to fetch all ships (20 per page)
 func (c *SpaceTradersClient) ListShips(ctx context.Context, token string) ([]*navigation.ShipData, error) {
@@ -405,13 +441,26 @@ func (c *SpaceTradersClient) DockShip(ctx context.Context, symbol, token string)
 
 // RefuelShip refuels a ship
 func (c *SpaceTradersClient) RefuelShip(ctx context.Context, symbol, token string, units *int) (*navigation.RefuelResult, error) {
-	path := fmt.Sprintf("/my/ships/%s/refuel", symbol)
-
 	// Always send an object (empty {} if no units specified)
 	body := map[string]interface{}{}
 	if units != nil {
 		body["units"] = *units
 	}
+	return c.refuel(ctx, symbol, token, body)
+}
+
+// RefuelShipFromCargo refuels a ship with units of fuel drawn from the FUEL in
+// its own cargo hold (fromCargo) instead of buying from the local market. No
+// credits change hands, so the result's CreditsCost is normally 0.
+func (c *SpaceTradersClient) RefuelShipFromCargo(ctx context.Context, symbol, token string, units int) (*navigation.RefuelResult, error) {
+	return c.refuel(ctx, symbol, token, map[string]interface{}{
+		"units":     units,
+		"fromCargo": true,
+	})
+}
+
+func (c *SpaceTradersClient) refuel(ctx context.Context, symbol, token string, body map[string]interface{}) (*navigation.RefuelResult, error) {
+	path := fmt.Sprintf("/my/ships/%s/refuel", symbol)
 
 	var response struct {
 		Data struct {
@@ -672,6 +721,73 @@ func (c *SpaceTradersClient) fetchAgentLive(ctx context.Context, token string) (
 	}, nil
 }
 
+// factionsPageLimit is the API's maximum page size for GET /factions.
+const factionsPageLimit = 20
+
+// GetFactions lists every faction in the game (GET /factions), following
+// pagination until meta.total is reached. The list is small (a couple of pages)
+// and near-static, so callers read it rarely.
+func (c *SpaceTradersClient) GetFactions(ctx context.Context, token string) ([]domainPorts.FactionData, error) {
+	var factions []domainPorts.FactionData
+	for page := 1; ; page++ {
+		path := fmt.Sprintf("/factions?page=%d&limit=%d", page, factionsPageLimit)
+
+		var response struct {
+			Data []struct {
+				Symbol       string `json:"symbol"`
+				Name         string `json:"name"`
+				Headquarters string `json:"headquarters"`
+				IsRecruiting bool   `json:"isRecruiting"`
+			} `json:"data"`
+			Meta struct {
+				Total int `json:"total"`
+			} `json:"meta"`
+		}
+
+		if err := c.request(ctx, "GET", path, token, nil, &response); err != nil {
+			return nil, fmt.Errorf("failed to list factions: %w", err)
+		}
+
+		for _, f := range response.Data {
+			factions = append(factions, domainPorts.FactionData{
+				Symbol:       f.Symbol,
+				Name:         f.Name,
+				Headquarters: f.Headquarters,
+				IsRecruiting: f.IsRecruiting,
+			})
+		}
+
+		if len(response.Data) == 0 || len(factions) >= response.Meta.Total {
+			return factions, nil
+		}
+	}
+}
+
+// GetMyFactionReputation reads the agent's reputation with every faction
+// (GET /my/factions). The endpoint postdates the vendored 2.3.0 spec; its
+// payload is a plain list of {symbol, reputation}.
+func (c *SpaceTradersClient) GetMyFactionReputation(ctx context.Context, token string) ([]domainPorts.FactionReputationData, error) {
+	var response struct {
+		Data []struct {
+			Symbol     string `json:"symbol"`
+			Reputation int    `json:"reputation"`
+		} `json:"data"`
+	}
+
+	if err := c.request(ctx, "GET", "/my/factions", token, nil, &response); err != nil {
+		return nil, fmt.Errorf("failed to get faction reputation: %w", err)
+	}
+
+	reputations := make([]domainPorts.FactionReputationData, 0, len(response.Data))
+	for _, f := range response.Data {
+		reputations = append(reputations, domainPorts.FactionReputationData{
+			FactionSymbol: f.Symbol,
+			Reputation:    f.Reputation,
+		})
+	}
+	return reputations, nil
+}
+
 // ListWaypoints retrieves waypoints for a system with pagination
 func (c *SpaceTradersClient) ListWaypoints(ctx context.Context, systemSymbol, token string, page, limit int) (*system.WaypointsListResponse, error) {
 	path := fmt.Sprintf("/systems/%s/waypoints?page=%d&limit=%d", systemSymbol, page, limit)
@@ -684,6 +800,8 @@ func (c *SpaceTradersClient) ListWaypoints(ctx context.Context, systemSymbol, to
 			Y        float64                  `json:"y"`
 			Traits   []map[string]interface{} `json:"traits"`
 			Orbitals []map[string]string      `json:"orbitals"`
+
+			IsUnderConstruction bool `json:"isUnderConstruction"`
 		} `json:"data"`
 		Meta struct {
 			Total int `json:"total"`
@@ -705,6 +823,8 @@ func (c *SpaceTradersClient) ListWaypoints(ctx context.Context, systemSymbol, to
 			Y:        wp.Y,
 			Traits:   wp.Traits,
 			Orbitals: wp.Orbitals,
+
+			IsUnderConstruction: wp.IsUnderConstruction,
 		}
 	}
 
@@ -835,6 +955,37 @@ func (c *SpaceTradersClient) GetContract(ctx context.Context, contractID, token
 	return c.parseContractData(response.Data)
 }
 
+// ListContracts lists the agent's contracts, following pagination
+func (c *SpaceTradersClient) ListContracts(ctx context.Context, token string) ([]*domainPorts.ContractData, error) {
+	var contracts []*domainPorts.ContractData
+	for page := 1; ; page++ {
+		path := fmt.Sprintf("/my/contracts?page=%d&limit=20", page)
+
+		var response struct {
+			Data []map[string]interface{} `json:"data"`
+			Meta struct {
+				Total int `json:"total"`
+			} `json:"meta"`
+		}
+
+		if err := c.request(ctx, "GET", path, token, nil, &response); err != nil {
+			return nil, fmt.Errorf("failed to list contracts (page %d): %w", page, err)
+		}
+
+		for _, data := range response.Data {
+			contractData, err := c.parseContractData(data)
+			if err != nil {
+				return nil, err
+			}
+			contracts = append(contracts, contractData)
+		}
+
+		if len(response.Data) == 0 || len(contracts) >= response.Meta.Total {
+			return contracts, nil
+		}
+	}
+}
+
 // AcceptContract accepts a contract
 func (c *SpaceTradersClient) AcceptContract(ctx context.Context, contractID, token string) (*domainPorts.ContractData, error) {
 	path := fmt.Sprintf("/my/contracts/%s/accept", contractID)
@@ -1044,6 +1195,53 @@ func (c *SpaceTradersClient) modifyShipModule(ctx context.Context, action, shipS
 	return result, nil
 }
 
+// InstallShipMount installs a mount from the ship's cargo. The response mirrors
+// a module install ({agent, mounts[], cargo, transaction}); only the mount
+// symbols are kept.
+func (c *SpaceTradersClient) InstallShipMount(ctx context.Context, shipSymbol, mountSymbol, token string) (*domainPorts.MountInstallResult, error) {
+	path := fmt.Sprintf("/my/ships/%s/mounts/install", shipSymbol)
+
+	body := map[string]interface{}{
+		"symbol": mountSymbol,
+	}
+
+	var response struct {
+		Data struct {
+			Agent *struct {
+				Credits int `json:"credits"`
+			} `json:"agent"`
+			Mounts []struct {
+				Symbol string `json:"symbol"`
+			} `json:"mounts"`
+			Cargo struct {
+				Capacity int `json:"capacity"`
+			} `json:"cargo"`
+			Transaction struct {
+				TotalPrice int `json:"totalPrice"`
+			} `json:"transaction"`
+		} `json:"data"`
+	}
+
+	if err := c.request(ctx, "POST", path, token, body, &response); err != nil {
+		return nil, fmt.Errorf("failed to install ship mount: %w", err)
+	}
+	c.invalidateAgentCache() // the install charges a shipyard fee
+
+	result := &domainPorts.MountInstallResult{
+		Fee:           response.Data.Transaction.TotalPrice,
+		CargoCapacity: response.Data.Cargo.Capacity,
+		Mounts:        make([]string, 0, len(response.Data.Mounts)),
+	}
+	for _, m := range response.Data.Mounts {
+		result.Mounts = append(result.Mounts, m.Symbol)
+	}
+	if response.Data.Agent != nil {
+		credits := response.Data.Agent.Credits
+		result.AgentCredits = &credits
+	}
+	return result, nil
+}
+
 // GetShipModules lists the modules currently installed on a ship.
 func (c *SpaceTradersClient) GetShipModules(ctx context.Context, shipSymbol, token string) ([]domainPorts.ModuleInfo, error) {
 	path := fmt.Sprintf("/my/ships/%s/modules", shipSymbol)
@@ -1524,6 +1722,105 @@ func (c *SpaceTradersClient) PurchaseShip(ctx context.Context, shipType, waypoin
 	}, nil
 }
 
+// ScrapShip scraps a ship docked at a shipyard for credits
+func (c *SpaceTradersClient) ScrapShip(ctx context.Context, shipSymbol, token string) (*domainPorts.ShipScrapResult, error) {
+	path := fmt.Sprintf("/my/ships/%s/scrap", shipSymbol)
+
+	var response struct {
+		Data struct {
+			Agent struct {
+				AccountID       string `json:"accountId"`
+				Symbol          string `json:"symbol"`
+				Headquarters    string `json:"headquarters"`
+				Credits         int    `json:"credits"`
+				StartingFaction string `json:"startingFaction"`
+			} `json:"agent"`
+			Transaction struct {
+				WaypointSymbol string `json:"waypointSymbol"`
+				ShipSymbol     string `json:"shipSymbol"`
+				TotalPrice     int    `json:"totalPrice"`
+				Timestamp      string `json:"timestamp"`
+			} `json:"transaction"`
+		} `json:"data"`
+	}
+
+	// Send empty JSON object {} instead of nil to satisfy API requirements
+	emptyBody := map[string]interface{}{}
+	if err := c.request(ctx, "POST", path, token, emptyBody, &response); err != nil {
+		return nil, fmt.Errorf("failed to scrap ship: %w", err)
+	}
+	c.invalidateAgentCache() // scrapping credits the agent -> drop the stale-low cache
+
+	return &domainPorts.ShipScrapResult{
+		Agent: &player.AgentData{
+			AccountID:       response.Data.Agent.AccountID,
+			Symbol:          response.Data.Agent.Symbol,
+			Headquarters:    response.Data.Agent.Headquarters,
+			Credits:         response.Data.Agent.Credits,
+			StartingFaction: response.Data.Agent.StartingFaction,
+		},
+		Transaction: &domainPorts.ShipScrapTransaction{
+			WaypointSymbol: response.Data.Transaction.WaypointSymbol,
+			ShipSymbol:     response.Data.Transaction.ShipSymbol,
+			TotalPrice:     response.Data.Transaction.TotalPrice,
+			Timestamp:      response.Data.Transaction.Timestamp,
+		},
+	}, nil
+}
+
+// RepairShip repairs a ship docked at a shipyard back to full condition
+func (c *SpaceTradersClient) RepairShip(ctx context.Context, shipSymbol, token string) (*domainPorts.ShipRepairResult, error) {
+	path := fmt.Sprintf("/my/ships/%s/repair", shipSymbol)
+
+	var response struct {
+		Data struct {
+			Agent struct {
+				AccountID       string `json:"accountId"`
+				Symbol          string `json:"symbol"`
+				Headquarters    string `json:"headquarters"`
+				Credits         int    `json:"credits"`
+				StartingFaction string `json:"startingFaction"`
+			} `json:"agent"`
+			Ship        map[string]interface{} `json:"ship"`
+			Transaction struct {
+				WaypointSymbol string `json:"waypointSymbol"`
+				ShipSymbol     string `json:"shipSymbol"`
+				TotalPrice     int    `json:"totalPrice"`
+				Timestamp      string `json:"timestamp"`
+			} `json:"transaction"`
+		} `json:"data"`
+	}
+
+	// Send empty JSON object {} instead of nil to satisfy API requirements
+	emptyBody := map[string]interface{}{}
+	if err := c.request(ctx, "POST", path, token, emptyBody, &response); err != nil {
+		return nil, fmt.Errorf("failed to repair ship: %w", err)
+	}
+	c.invalidateAgentCache() // repairs spend credits -> drop the stale-high cache
+
+	shipData, err := c.convertShipData(response.Data.Ship)
+	if err != nil {
+		return nil, fmt.Errorf("failed to convert ship data: %w", err)
+	}
+
+	return &domainPorts.ShipRepairResult{
+		Agent: &player.AgentData{
+			AccountID:       response.Data.Agent.AccountID,
+			Symbol:          response.Data.Agent.Symbol,
+			Headquarters:    response.Data.Agent.Headquarters,
+			Credits:         response.Data.Agent.Credits,
+			StartingFaction: response.Data.Agent.StartingFaction,
+		},
+		Ship: shipData,
+		Transaction: &domainPorts.ShipRepairTransaction{
+			WaypointSymbol: response.Data.Transaction.WaypointSymbol,
+			ShipSymbol:     response.Data.Transaction.ShipSymbol,
+			TotalPrice:     response.Data.Transaction.TotalPrice,
+			Timestamp:      response.Data.Transaction.Timestamp,
+		},
+	}, nil
+}
+
 // convertShipData converts ship data from API response map to ShipData struct
 func (c *SpaceTradersClient) convertShipData(data map[string]interface{}) (*navigation.ShipData, error) {
 	raw, err := json.Marshal(data)
@@ -0,0 +1,55 @@
+package api
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+// TestListContracts_FollowsPagination pins GET /my/contracts: pages are read
+// until meta.total contracts are collected, each decoded like GetContract.
+func TestListContracts_FollowsPagination(t *testing.T) {
+	var pages []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/my/contracts" {
+			t.Errorf("expected /my/contracts, got %s", r.URL.Path)
+		}
+		page := r.URL.Query().Get("page")
+		pages = append(pages, page)
+		id, accepted := "starter", false
+		if page == "2" {
+			id, accepted = "older", true
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		_, _ = fmt.Fprintf(w, `{
+			"data": [{"id": %q, "factionSymbol": "COSMIC", "type": "PROCUREMENT", "accepted": %t, "fulfilled": false,
+				"terms": {"deadline": "2030-01-01T00:00:00Z", "payment": {"onAccepted": 1500, "onFulfilled": 9000},
+					"deliver": [{"tradeSymbol": "IRON_ORE", "destinationSymbol": "X1-HQ-A1", "unitsRequired": 40, "unitsFulfilled": 0}]}}],
+			"meta": {"total": 2, "page": %s, "limit": 20}
+		}`, id, accepted, page)
+	}))
+	defer server.Close()
+
+	client := NewSpaceTradersClientWithConfig(server.URL, 0, time.Millisecond, nil)
+	contracts, err := client.ListContracts(context.Background(), "token")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(pages) != 2 || pages[0] != "1" || pages[1] != "2" {
+		t.Fatalf("expected pages 1 then 2, got %v", pages)
+	}
+	if len(contracts) != 2 || contracts[0].ID != "starter" || contracts[1].ID != "older" {
+		t.Fatalf("contracts decoded wrong: %+v", contracts)
+	}
+	if contracts[0].Accepted || !contracts[1].Accepted {
+		t.Fatalf("accepted flags decoded wrong: %+v", contracts)
+	}
+	if contracts[0].Terms.Payment.OnAccepted != 1500 || len(contracts[0].Terms.Deliveries) != 1 {
+		t.Fatalf("terms decoded wrong: %+v", contracts[0].Terms)
+	}
+}
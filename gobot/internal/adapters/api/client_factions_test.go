@@ -0,0 +1,70 @@
+package api
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+// TestGetFactions_FollowsPagination pins the faction roster reader: it keeps paging
+// GET /factions until meta.total factions are collected.
+func TestGetFactions_FollowsPagination(t *testing.T) {
+	var pages []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		page := r.URL.Query().Get("page")
+		pages = append(pages, page)
+		symbol := "COSMIC"
+		if page == "2" {
+			symbol = "VOID"
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		_, _ = fmt.Fprintf(w, `{
+			"data": [{"symbol": %q, "name": "Faction", "description": "d", "headquarters": "X1-HQ-A1", "traits": [], "isRecruiting": true}],
+			"meta": {"total": 2, "page": %s, "limit": 20}
+		}`, symbol, page)
+	}))
+	defer server.Close()
+
+	client := NewSpaceTradersClientWithConfig(server.URL, 0, time.Millisecond, nil)
+	factions, err := client.GetFactions(context.Background(), "token")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(pages) != 2 || pages[0] != "1" || pages[1] != "2" {
+		t.Fatalf("expected pages 1 then 2, got %v", pages)
+	}
+	if len(factions) != 2 || factions[0].Symbol != "COSMIC" || factions[1].Symbol != "VOID" {
+		t.Fatalf("factions decoded wrong: %+v", factions)
+	}
+	if factions[0].Headquarters != "X1-HQ-A1" || !factions[0].IsRecruiting {
+		t.Fatalf("faction detail decoded wrong: %+v", factions[0])
+	}
+}
+
+// TestGetMyFactionReputation_DecodesReputation pins GET /my/factions: one
+// {symbol, reputation} entry per faction.
+func TestGetMyFactionReputation_DecodesReputation(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/my/factions" {
+			t.Errorf("expected /my/factions, got %s", r.URL.Path)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write([]byte(`{"data": [{"symbol": "COSMIC", "reputation": 37}, {"symbol": "VOID", "reputation": -4}]}`))
+	}))
+	defer server.Close()
+
+	client := NewSpaceTradersClientWithConfig(server.URL, 0, time.Millisecond, nil)
+	reps, err := client.GetMyFactionReputation(context.Background(), "token")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(reps) != 2 || reps[0].FactionSymbol != "COSMIC" || reps[0].Reputation != 37 || reps[1].Reputation != -4 {
+		t.Fatalf("reputation decoded wrong: %+v", reps)
+	}
+}
@@ -0,0 +1,68 @@
+package api
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/andrescamacho/spacetraders-go/internal/adapters/api/apitest"
+)
+
+// ScrapShip POSTs to /my/ships/{shipSymbol}/scrap and returns the refund and the agent's
+// post-scrap credits from the same response, which the ledger row anchors on.
+func TestScrapShip_PostsToShipScrapPathAndParsesRefund(t *testing.T) {
+	var gotMethod, gotPath string
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotMethod, gotPath = r.Method, r.URL.Path
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"data":{"agent":{"symbol":"TORWIND","credits":152000},` +
+			`"transaction":{"waypointSymbol":"X1-DA78-A2","shipSymbol":"TORWIND-9","totalPrice":12000,"timestamp":"2026-07-09T12:00:00Z"}}}`))
+	}))
+	defer server.Close()
+
+	client := NewSpaceTradersClientWithConfig(server.URL, 0, time.Millisecond, nil)
+
+	result, err := client.ScrapShip(context.Background(), "TORWIND-9", "token")
+	if err != nil {
+		t.Fatalf("a successful scrap must return no error, got %v", err)
+	}
+	if gotMethod != http.MethodPost || gotPath != "/my/ships/TORWIND-9/scrap" {
+		t.Fatalf("scrap must POST to /my/ships/{ship}/scrap, got %s %s", gotMethod, gotPath)
+	}
+	if result.Transaction.TotalPrice != 12000 || result.Transaction.WaypointSymbol != "X1-DA78-A2" {
+		t.Fatalf("unexpected scrap transaction: %+v", result.Transaction)
+	}
+	if result.Agent.Credits != 152000 {
+		t.Fatalf("expected post-scrap credits 152000, got %d", result.Agent.Credits)
+	}
+}
+
+// A scrap rejected with 429 waits out the advertised Retry-After and is sent
+// again: the refund arrives, and exactly one more request reached the server.
+func TestScrapShip_WaitsOutRateLimitThenScraps(t *testing.T) {
+	srv := apitest.NewServer(t)
+	srv.On(http.MethodPost, "/my/ships/{shipSymbol}/scrap").
+		RespondData(`{"agent":{"symbol":"TORWIND","credits":152000},`+
+			`"transaction":{"waypointSymbol":"X1-DA78-A2","shipSymbol":"TORWIND-9","totalPrice":12000,"timestamp":"2026-07-09T12:00:00Z"}}`).
+		RateLimitNext(1, 3*time.Second)
+
+	client, clock := newRetryTestClient(srv.URL, 3)
+	start := clock.CurrentTime
+
+	result, err := client.ScrapShip(context.Background(), "TORWIND-9", "token")
+	if err != nil {
+		t.Fatalf("a rate-limited scrap must be retried, got %v", err)
+	}
+	if result.Transaction.TotalPrice != 12000 {
+		t.Fatalf("unexpected scrap transaction: %+v", result.Transaction)
+	}
+	if calls := srv.Calls(http.MethodPost, "/my/ships/{shipSymbol}/scrap"); calls != 2 {
+		t.Fatalf("expected the 429 and one retry, got %d calls", calls)
+	}
+	if waited := clock.CurrentTime.Sub(start); waited != 3*time.Second {
+		t.Fatalf("expected the 3s Retry-After to be honored, waited %v", waited)
+	}
+}
@@ -4,13 +4,14 @@ import "strings"
 
 var endpointNameMap = map[string]string{
 	// Agent
-	"/my/agent": "Get Agent",
+	"/my/agent":    "Get Agent",
+	"/my/factions": "Get My Factions",
 
 	// Ships
 	"/my/ships":                      "List Ships",
 	"/my/ships/*":                    "Get Ship",
 	"/my/ships/*/cargo":              "Get Cargo",
-	"/my/ships/*/nav":                "Set Flight Mode",
+	"/my/ships/*/nav":                "Ship Nav",
 	"/my/ships/*/navigate":           "Navigate",
 	"/my/ships/*/dock":               "Dock",
 	"/my/ships/*/orbit":              "Orbit",
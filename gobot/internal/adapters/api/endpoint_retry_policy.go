@@ -0,0 +1,153 @@
+package api
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+)
+
+// RetryClass groups endpoints that share a retry policy. Classes are resolved
+// from the request method plus the human-readable endpoint name produced by
+// apiEndpointClassifier, so a new path only needs a name to be classified.
+type RetryClass string
+
+const (
+	// RetryClassNavigation covers ship movement and state changes: Navigate,
+	// Dock, Orbit, Jump, Warp and flight-mode PATCHes to Ship Nav.
+	RetryClassNavigation RetryClass = "navigation"
+	// RetryClassMarketRead covers market and shipyard reads.
+	RetryClassMarketRead RetryClass = "market_read"
+	// RetryClassPurchase covers calls that spend credits on goods or hulls:
+	// Buy Cargo and Purchase Ship. Re-sending one after an ambiguous failure can
+	// buy twice.
+	RetryClassPurchase RetryClass = "purchase"
+	// RetryClassSell covers Sell Cargo.
+	RetryClassSell RetryClass = "sell"
+	// RetryClassRead is every other GET.
+	RetryClassRead RetryClass = "read"
+	// RetryClassWrite is every other non-GET.
+	RetryClassWrite RetryClass = "write"
+)
+
+// knownRetryClasses is the closed set SetRetryPolicies accepts.
+var knownRetryClasses = map[RetryClass]struct{}{
+	RetryClassNavigation: {},
+	RetryClassMarketRead: {},
+	RetryClassPurchase:   {},
+	RetryClassSell:       {},
+	RetryClassRead:       {},
+	RetryClassWrite:      {},
+}
+
+var navigationEndpoints = map[string]struct{}{
+	"Navigate": {},
+	"Dock":     {},
+	"Orbit":    {},
+	"Jump":     {},
+	"Warp":     {},
+	"Ship Nav": {},
+}
+
+var marketReadEndpoints = map[string]struct{}{
+	"Get Market":   {},
+	"Get Shipyard": {},
+}
+
+// retryClassFor classifies one request. POST /my/ships is named "List Ships" by
+// the endpoint classifier (the path is shared with the list read), so the method
+// tells a ship purchase apart.
+func retryClassFor(method, endpoint string) RetryClass {
+	isRead := method == http.MethodGet
+	switch {
+	case endpoint == "Buy Cargo":
+		return RetryClassPurchase
+	case endpoint == "List Ships" && method == http.MethodPost:
+		return RetryClassPurchase
+	case endpoint == "Sell Cargo":
+		return RetryClassSell
+	}
+	if _, ok := navigationEndpoints[endpoint]; ok && !isRead {
+		return RetryClassNavigation
+	}
+	if _, ok := marketReadEndpoints[endpoint]; ok && isRead {
+		return RetryClassMarketRead
+	}
+	if isRead {
+		return RetryClassRead
+	}
+	return RetryClassWrite
+}
+
+// RetryPolicy bounds how an endpoint class retries AMBIGUOUS failures — network
+// errors and 5xx responses, where the server may already have applied the
+// request. A 429 is never ambiguous (the request was rejected before it ran), so
+// rate-limit retries stay bounded by the client-wide maxRetries for every class.
+type RetryPolicy struct {
+	// MaxRetries is how many times an ambiguous failure is re-sent. 0 = never.
+	MaxRetries int
+	// BackoffBase is the exponential-backoff base between attempts; 0 keeps the
+	// client-wide base.
+	BackoffBase time.Duration
+}
+
+// defaultRetryPolicies is the table in force before any override: purchases
+// never re-send after an ambiguous failure, because a timed-out buy that did land
+// would be bought again. Every other class inherits the client-wide settings.
+func defaultRetryPolicies() map[RetryClass]RetryPolicy {
+	return map[RetryClass]RetryPolicy{
+		RetryClassPurchase: {MaxRetries: 0},
+	}
+}
+
+// SetRetryPolicies overrides the per-class retry table. Classes absent from
+// policies keep their default (see defaultRetryPolicies); an unknown class is
+// rejected so a config typo cannot silently leave purchases retrying. Wired at
+// daemon boot from DaemonConfig.APIRetryPolicies.
+func (c *SpaceTradersClient) SetRetryPolicies(policies map[RetryClass]RetryPolicy) error {
+	table := defaultRetryPolicies()
+	for class, policy := range policies {
+		if _, ok := knownRetryClasses[class]; !ok {
+			return fmt.Errorf("unknown API retry class %q", class)
+		}
+		if policy.MaxRetries < 0 {
+			return fmt.Errorf("API retry class %q: max retries must be >= 0, got %d", class, policy.MaxRetries)
+		}
+		table[class] = policy
+	}
+	c.retryPolicies.Store(&table)
+	return nil
+}
+
+// ClassRetryPolicy returns the policy currently in force for class, with
+// inherited fields resolved to the client-wide settings. Config wiring uses it to
+// override one field of a class without resetting the other.
+func (c *SpaceTradersClient) ClassRetryPolicy(class RetryClass) RetryPolicy {
+	table := defaultRetryPolicies()
+	if stored := c.retryPolicies.Load(); stored != nil {
+		table = *stored
+	}
+	return c.resolveRetryPolicy(table, class)
+}
+
+// DefaultClassRetryPolicy is ClassRetryPolicy against the built-in table,
+// ignoring any override in force. A config reload builds on it so that an
+// override dropped from the file falls back to the default rather than sticking.
+func (c *SpaceTradersClient) DefaultClassRetryPolicy(class RetryClass) RetryPolicy {
+	return c.resolveRetryPolicy(defaultRetryPolicies(), class)
+}
+
+func (c *SpaceTradersClient) resolveRetryPolicy(table map[RetryClass]RetryPolicy, class RetryClass) RetryPolicy {
+	policy, ok := table[class]
+	if !ok {
+		policy = RetryPolicy{MaxRetries: c.maxRetries}
+	}
+	if policy.BackoffBase <= 0 {
+		policy.BackoffBase = c.backoffBase
+	}
+	return policy
+}
+
+// retryPolicyFor resolves the effective policy for one request.
+func (c *SpaceTradersClient) retryPolicyFor(method, endpoint string) RetryPolicy {
+	return c.ClassRetryPolicy(retryClassFor(method, endpoint))
+}
@@ -0,0 +1,102 @@
+package api
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func TestRetryClassFor(t *testing.T) {
+	cases := []struct {
+		method, path string
+		want         RetryClass
+	}{
+		{http.MethodPost, "/my/ships/TORWIND-1/purchase", RetryClassPurchase},
+		{http.MethodPost, "/my/ships", RetryClassPurchase},
+		{http.MethodGet, "/my/ships", RetryClassRead},
+		{http.MethodPost, "/my/ships/TORWIND-1/sell", RetryClassSell},
+		{http.MethodPost, "/my/ships/TORWIND-1/navigate", RetryClassNavigation},
+		{http.MethodPatch, "/my/ships/TORWIND-1/nav", RetryClassNavigation},
+		{http.MethodGet, "/systems/X1-AB12/waypoints/X1-AB12-A1/market", RetryClassMarketRead},
+		{http.MethodGet, "/my/agent", RetryClassRead},
+		{http.MethodPost, "/my/contracts/clxyz123abc/deliver", RetryClassWrite},
+	}
+	for _, tc := range cases {
+		if got := retryClassFor(tc.method, apiEndpointClassifier.classify(tc.path)); got != tc.want {
+			t.Errorf("%s %s: expected class %q, got %q", tc.method, tc.path, tc.want, got)
+		}
+	}
+}
+
+// A purchase is never re-sent after an ambiguous failure by default: a 5xx may
+// mean the buy landed, and a retry would buy again.
+func TestPurchaseNotRetriedAfterServerErrorByDefault(t *testing.T) {
+	server, attempts := flakyServer(t, 500, 1000, "")
+	client, _ := newRetryTestClient(server.URL, 5)
+
+	err := client.request(context.Background(), http.MethodPost, "/my/ships/TORWIND-1/purchase", "token", nil, nil)
+	if err == nil || !strings.Contains(err.Error(), "max retries exceeded") {
+		t.Fatalf("expected the purchase to fail without retrying, got: %v", err)
+	}
+	if *attempts != 1 {
+		t.Fatalf("expected exactly 1 attempt, got %d", *attempts)
+	}
+}
+
+// A 429 is never ambiguous — the request was rejected before it ran — so even a
+// never-retry class waits out rate limiting.
+func TestPurchaseStillRetriesRateLimit(t *testing.T) {
+	server, attempts := flakyServer(t, 429, 2, "")
+	client, _ := newRetryTestClient(server.URL, 5)
+
+	var result namedPayload
+	if err := client.request(context.Background(), http.MethodPost, "/my/ships/TORWIND-1/purchase", "token", nil, &result); err != nil {
+		t.Fatalf("expected success after rate-limit retries, got: %v", err)
+	}
+	if *attempts != 3 {
+		t.Fatalf("expected 3 attempts, got %d", *attempts)
+	}
+}
+
+// An override raises one class above the client-wide budget and leaves the rest alone.
+func TestSetRetryPoliciesOverridesPerClass(t *testing.T) {
+	server, attempts := flakyServer(t, 503, 1000, "")
+	client, _ := newRetryTestClient(server.URL, 1)
+	if err := client.SetRetryPolicies(map[RetryClass]RetryPolicy{RetryClassMarketRead: {MaxRetries: 4}}); err != nil {
+		t.Fatalf("set policies: %v", err)
+	}
+
+	_ = client.request(context.Background(), http.MethodGet, "/systems/X1-AB12/waypoints/X1-AB12-A1/market", "token", nil, nil)
+	if *attempts != 5 {
+		t.Fatalf("market read should retry 4x (5 attempts), got %d", *attempts)
+	}
+
+	*attempts = 0
+	_ = client.request(context.Background(), http.MethodGet, "/my/agent", "token", nil, nil)
+	if *attempts != 2 {
+		t.Fatalf("other reads keep the client-wide 1 retry (2 attempts), got %d", *attempts)
+	}
+}
+
+func TestSetRetryPoliciesRejectsUnknownClass(t *testing.T) {
+	client, _ := newRetryTestClient("http://unused", 1)
+	if err := client.SetRetryPolicies(map[RetryClass]RetryPolicy{"purchases": {}}); err == nil {
+		t.Fatalf("a misspelled class must be rejected")
+	}
+}
+
+// A config reload rebuilds from DefaultClassRetryPolicy, so an override dropped
+// from the file must not leak into it.
+func TestDefaultClassRetryPolicyIgnoresOverrides(t *testing.T) {
+	client, _ := newRetryTestClient("http://unused", 1)
+	if err := client.SetRetryPolicies(map[RetryClass]RetryPolicy{RetryClassPurchase: {MaxRetries: 3}}); err != nil {
+		t.Fatalf("set policies: %v", err)
+	}
+	if got := client.ClassRetryPolicy(RetryClassPurchase).MaxRetries; got != 3 {
+		t.Fatalf("override should be in force, got %d retries", got)
+	}
+	if got := client.DefaultClassRetryPolicy(RetryClassPurchase).MaxRetries; got != 0 {
+		t.Fatalf("default purchase policy never retries, got %d", got)
+	}
+}
@@ -105,8 +105,6 @@ func (b *GraphBuilder) BuildSystemGraph(ctx context.Context, systemSymbol string
 			}
 		}
 
-		hasFuel := shared.TraitsGrantFuel(traits)
-
 		waypointObj, err := shared.NewWaypoint(wp.Symbol, wp.X, wp.Y)
 		if err != nil {
 			log.Printf("Warning: failed to create waypoint %s: %v", wp.Symbol, err)
@@ -116,7 +114,7 @@ func (b *GraphBuilder) BuildSystemGraph(ctx context.Context, systemSymbol string
 		waypointObj.SystemSymbol = systemSymbol
 		waypointObj.Type = wp.Type
 		waypointObj.Traits = traits
-		waypointObj.HasFuel = hasFuel
+		waypointObj.ApplyCapabilities(shared.CapabilitiesFromTraits(traits, wp.IsUnderConstruction))
 		waypointObj.Orbitals = orbitals
 
 		graph.AddWaypoint(waypointObj)
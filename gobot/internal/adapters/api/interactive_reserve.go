@@ -0,0 +1,62 @@
+package api
+
+import (
+	"context"
+	"math"
+	"time"
+)
+
+// SetInteractiveBurstReserve holds back fraction of the rate limiter's burst
+// for interactive calls (those tagged PriorityInteractive), so an operator's
+// Get Ship or List Ships is not queued behind a burst of background scans.
+// Background calls wait while taking a token would leave fewer than the
+// reserve in the bucket; interactive calls may spend it. The reserve never
+// adds tokens, so the rate ceiling and burst are unchanged.
+//
+// The reserve is soft: background callers that clear the check together may
+// dip into it by a token or two. fraction is clamped to [0, 1); 0 — the
+// default — disables the reserve. Safe to call while requests are in flight.
+func (c *SpaceTradersClient) SetInteractiveBurstReserve(fraction float64) {
+	if fraction <= 0 || math.IsNaN(fraction) {
+		c.interactiveReserve.Store(0)
+		return
+	}
+	burst := c.rateLimiter.Burst()
+	reserve := int64(math.Round(fraction * float64(burst)))
+	if reserve >= int64(burst) {
+		reserve = int64(burst) - 1
+	}
+	c.interactiveReserve.Store(reserve)
+}
+
+// InteractiveBurstReserve returns the number of burst tokens currently held
+// back for interactive calls.
+func (c *SpaceTradersClient) InteractiveBurstReserve() int {
+	return int(c.interactiveReserve.Load())
+}
+
+// waitAboveInteractiveReserve blocks until the bucket holds a token beyond the
+// interactive reserve, or ctx is done.
+func (c *SpaceTradersClient) waitAboveInteractiveReserve(ctx context.Context) error {
+	for {
+		reserve := float64(c.interactiveReserve.Load())
+		if reserve <= 0 {
+			return nil
+		}
+		deficit := reserve + 1 - c.rateLimiter.Tokens()
+		if deficit <= 0 {
+			return nil
+		}
+		refill := float64(c.rateLimiter.Limit())
+		if refill <= 0 || math.IsInf(refill, 1) {
+			return nil
+		}
+		timer := time.NewTimer(time.Duration(deficit / refill * float64(time.Second)))
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return ctx.Err()
+		case <-timer.C:
+		}
+	}
+}
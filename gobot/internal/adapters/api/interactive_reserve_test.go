@@ -0,0 +1,46 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestSetInteractiveBurstReserve_ClampsToTheBurst(t *testing.T) {
+	client := NewSpaceTradersClientWithConfig("http://unused", 0, time.Millisecond, nil)
+
+	client.SetInteractiveBurstReserve(0.2)
+	if got := client.InteractiveBurstReserve(); got != 6 {
+		t.Fatalf("20%% of a 30-token burst should reserve 6, got %d", got)
+	}
+	client.SetInteractiveBurstReserve(1)
+	if got := client.InteractiveBurstReserve(); got != RateLimitBurst-1 {
+		t.Fatalf("the reserve must leave background calls one token, got %d", got)
+	}
+	client.SetInteractiveBurstReserve(0)
+	if got := client.InteractiveBurstReserve(); got != 0 {
+		t.Fatalf("0 disables the reserve, got %d", got)
+	}
+}
+
+func TestAcquireRateToken_OnlyInteractiveCallsSpendTheReserve(t *testing.T) {
+	client := NewSpaceTradersClientWithConfig("http://unused", 0, time.Millisecond, nil)
+	client.SetInteractiveBurstReserve(0.2)
+	// Drain the bucket down to exactly the 6 reserved tokens.
+	if !client.rateLimiter.AllowN(time.Now(), RateLimitBurst-6) {
+		t.Fatal("could not drain the limiter")
+	}
+
+	background, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	if err := client.acquireRateToken(background, "Get Ship"); !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("a background call must wait out the reserve, got %v", err)
+	}
+
+	interactive, cancel := context.WithTimeout(WithPriority(context.Background(), PriorityInteractive), 50*time.Millisecond)
+	defer cancel()
+	if err := client.acquireRateToken(interactive, "Get Ship"); err != nil {
+		t.Fatalf("an interactive call may spend the reserve, got %v", err)
+	}
+}
@@ -29,6 +29,13 @@ const (
 	// dock/navigate/orbit steps enabling an imminent trade, or a Get Agent read a
 	// spend-gate is blocked on.
 	PriorityHigh
+
+	// PriorityInteractive is for calls an operator is waiting on at the CLI
+	// (Get Ship, List Ships). It is never derived from the endpoint: the daemon
+	// tags the request context from the RPC's metadata. Interactive calls are
+	// served ahead of every other tier and may spend the burst tokens held back
+	// by SetInteractiveBurstReserve.
+	PriorityInteractive
 )
 
 func (p Priority) String() string {
@@ -37,6 +44,8 @@ func (p Priority) String() string {
 		return "LOW"
 	case PriorityHigh:
 		return "HIGH"
+	case PriorityInteractive:
+		return "INTERACTIVE"
 	default:
 		return "NORMAL"
 	}
@@ -53,7 +62,9 @@ func WithPriority(ctx context.Context, p Priority) context.Context {
 	return context.WithValue(ctx, priorityContextKey{}, p)
 }
 
-func priorityFromContext(ctx context.Context) (Priority, bool) {
+// PriorityFromContext returns the priority WithPriority tagged ctx with; ok is
+// false for an untagged context.
+func PriorityFromContext(ctx context.Context) (Priority, bool) {
 	p, ok := ctx.Value(priorityContextKey{}).(Priority)
 	return p, ok
 }
@@ -88,7 +99,7 @@ var lowPriorityEndpoints = map[string]struct{}{
 // Precedence: explicit ctx override (WithPriority) > endpoint classification >
 // NORMAL default.
 func priorityForRequest(ctx context.Context, endpoint string) Priority {
-	if p, ok := priorityFromContext(ctx); ok {
+	if p, ok := PriorityFromContext(ctx); ok {
 		return p
 	}
 	return priorityForEndpoint(endpoint)
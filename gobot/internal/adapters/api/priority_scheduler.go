@@ -10,8 +10,9 @@ import (
 
 // defaultPriorityAgingWindow bounds how long any single waiter can be bypassed by
 // higher-priority waiters. Once a waiter has been parked at least this long its
-// effective priority is promoted to the top tier, so it can be bypassed only by
-// waiters that were ALREADY ahead of it (a finite, draining set). This is the
+// effective priority is promoted to the trade-critical tier, so it can be
+// bypassed only by waiters that were ALREADY ahead of it (a finite, draining
+// set) and by operator-paced interactive calls. This is the
 // no-starvation guarantee: at 2 req/s a deprioritised poll resolves within
 // roughly this window plus the drain of whatever was queued ahead of it.
 const defaultPriorityAgingWindow = 2 * time.Second
@@ -145,12 +146,13 @@ func (s *priorityScheduler) selectNextLocked() *priorityWaiter {
 }
 
 // effectivePriority is the waiter's tier for selection purposes. Bounded aging:
-// once a waiter has been parked at least agingWindow it is promoted to the top
-// tier. This is the no-starvation guarantee — a promoted waiter can then be
-// bypassed only by waiters with an EARLIER enqueue time (a finite set that drains
-// as tokens are issued and never grows, since enqueue times only move forward),
-// so every waiter is admitted within a bounded time regardless of how much
-// higher-priority load arrives after it.
+// once a waiter has been parked at least agingWindow it is promoted to the
+// trade-critical tier. This is the no-starvation guarantee — a promoted waiter
+// can then be bypassed only by waiters with an EARLIER enqueue time (a finite
+// set that drains as tokens are issued and never grows, since enqueue times
+// only move forward) and by interactive calls, which are paced by an operator
+// at the CLI, so every waiter is admitted within a bounded time regardless of
+// how much background load arrives after it.
 func (s *priorityScheduler) effectivePriority(w *priorityWaiter, now time.Time) Priority {
 	if now.Sub(w.enqueuedAt) >= s.agingWindow {
 		return PriorityHigh
@@ -55,7 +55,7 @@ func newGateTokenSource() *gateTokenSource {
 
 func (g *gateTokenSource) wait(ctx context.Context) error {
 	g.mu.Lock()
-	p, _ := priorityFromContext(ctx)
+	p, _ := PriorityFromContext(ctx)
 	g.entries = append(g.entries, p)
 	g.mu.Unlock()
 	select {
@@ -3,6 +3,9 @@ package api
 import (
 	"context"
 	"fmt"
+
+	"github.com/andrescamacho/spacetraders-go/internal/domain/player"
+	domainPorts "github.com/andrescamacho/spacetraders-go/internal/domain/ports"
 )
 
 type RegisterResult struct {
@@ -11,7 +14,24 @@ type RegisterResult struct {
 	Faction     string
 }
 
+// Register is the CLI-facing shape of RegisterAgent, kept for the era-opening
+// `player register --new` flow.
 func (c *SpaceTradersClient) Register(ctx context.Context, accountToken, agentSymbol, faction string) (*RegisterResult, error) {
+	registration, err := c.RegisterAgent(ctx, accountToken, agentSymbol, faction)
+	if err != nil {
+		return nil, err
+	}
+
+	return &RegisterResult{
+		Token:       registration.Token,
+		AgentSymbol: registration.Agent.Symbol,
+		Faction:     registration.Agent.StartingFaction,
+	}, nil
+}
+
+// RegisterAgent creates a new agent via POST /register using the account token
+// and returns the agent's token together with its starting state.
+func (c *SpaceTradersClient) RegisterAgent(ctx context.Context, accountToken, agentSymbol, faction string) (*domainPorts.AgentRegistration, error) {
 	body := map[string]interface{}{
 		"symbol":  agentSymbol,
 		"faction": faction,
@@ -21,7 +41,10 @@ func (c *SpaceTradersClient) Register(ctx context.Context, accountToken, agentSy
 		Data struct {
 			Token string `json:"token"`
 			Agent struct {
+				AccountID       string `json:"accountId"`
 				Symbol          string `json:"symbol"`
+				Headquarters    string `json:"headquarters"`
+				Credits         int    `json:"credits"`
 				StartingFaction string `json:"startingFaction"`
 			} `json:"agent"`
 		} `json:"data"`
@@ -31,9 +54,14 @@ func (c *SpaceTradersClient) Register(ctx context.Context, accountToken, agentSy
 		return nil, fmt.Errorf("failed to register agent: %w", err)
 	}
 
-	return &RegisterResult{
-		Token:       response.Data.Token,
-		AgentSymbol: response.Data.Agent.Symbol,
-		Faction:     response.Data.Agent.StartingFaction,
+	return &domainPorts.AgentRegistration{
+		Token: response.Data.Token,
+		Agent: &player.AgentData{
+			AccountID:       response.Data.Agent.AccountID,
+			Symbol:          response.Data.Agent.Symbol,
+			Headquarters:    response.Data.Agent.Headquarters,
+			Credits:         response.Data.Agent.Credits,
+			StartingFaction: response.Data.Agent.StartingFaction,
+		},
 	}, nil
 }
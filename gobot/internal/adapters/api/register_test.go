@@ -51,3 +51,21 @@ func TestRegisterReturnsErrorOnNonSuccessStatus(t *testing.T) {
 	require.Error(t, err)
 	require.Nil(t, result)
 }
+
+func TestRegisterAgentParsesStartingAgentState(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprint(w, `{"data":{"token":"agent-jwt-token","agent":{"accountId":"acct-1","symbol":"ORION","headquarters":"X1-OR1-A1","credits":175000,"startingFaction":"COSMIC"}}}`)
+	}))
+	t.Cleanup(server.Close)
+	client, _ := newRetryTestClient(server.URL, 0)
+
+	registration, err := client.RegisterAgent(context.Background(), "account-token-abc", "ORION", "COSMIC")
+
+	require.NoError(t, err)
+	require.Equal(t, "agent-jwt-token", registration.Token)
+	require.Equal(t, "ORION", registration.Agent.Symbol)
+	require.Equal(t, "X1-OR1-A1", registration.Agent.Headquarters)
+	require.Equal(t, 175000, registration.Agent.Credits)
+	require.Equal(t, "COSMIC", registration.Agent.StartingFaction)
+}
@@ -9,6 +9,9 @@ import (
 	"net/http"
 	"strconv"
 	"time"
+
+	"github.com/andrescamacho/spacetraders-go/internal/adapters/metrics"
+	"github.com/andrescamacho/spacetraders-go/internal/domain/shared"
 )
 
 type retryDecision struct {
@@ -118,12 +121,25 @@ func (c *SpaceTradersClient) sendOnce(ctx context.Context, method, url, token st
 func (c *SpaceTradersClient) doWithRetry(ctx context.Context, method, path, token string, body interface{}, onTerminal func(statusCode int, respBody []byte) error) error {
 	url := c.baseURL + path
 	endpoint := apiEndpointClassifier.classify(path)
+	defer c.notifyShipMutation(method, path, body)
 	overallStart := time.Now()
 
 	var lastErr error
 	var finalStatusCode int
 
-	for attempt := 0; attempt <= c.maxRetries; attempt++ {
+	// Rate-limit (429) retries and ambiguous (network/5xx) retries are budgeted
+	// separately: the endpoint class's policy bounds only the ambiguous ones, so a
+	// purchase can refuse to re-send after a timeout yet still wait out a 429.
+	policy := c.retryPolicyFor(method, endpoint)
+	maxAttempts := max(c.maxRetries, policy.MaxRetries)
+	rateLimitRetries, ambiguousRetries := 0, 0
+
+	for attempt := 0; attempt <= maxAttempts; attempt++ {
+		// A paused container's calls wait here, before taking a rate token.
+		if err := shared.WaitWhilePaused(ctx); err != nil {
+			return err
+		}
+
 		rateLimitStart := time.Now()
 		// Priority-aware token acquisition (default OFF => byte-identical to the
 		// legacy c.rateLimiter.Wait). endpoint is the human-readable name computed
@@ -149,6 +165,7 @@ func (c *SpaceTradersClient) doWithRetry(ctx context.Context, method, path, toke
 			purpose := classifyPurpose(method, attempt)
 			tracker.Record(hull, purpose, outcome.statusCode == http.StatusTooManyRequests)
 		}
+		metrics.GetGlobalDailyActivityTracker().RecordAPIOutcome(outcome.networkErr != nil || outcome.statusCode >= http.StatusBadRequest)
 
 		decision := outcome.classify()
 		if !decision.retryable {
@@ -165,7 +182,16 @@ func (c *SpaceTradersClient) doWithRetry(ctx context.Context, method, path, toke
 		if collector := c.getMetricsCollector(); collector != nil {
 			collector.RecordAPIRetry(method, endpoint, decision.metricReason)
 		}
-		if attempt >= c.maxRetries {
+		rateLimited := outcome.networkErr == nil && outcome.statusCode == http.StatusTooManyRequests
+		exhausted := attempt >= maxAttempts
+		if rateLimited {
+			exhausted = exhausted || rateLimitRetries >= c.maxRetries
+			rateLimitRetries++
+		} else {
+			exhausted = exhausted || ambiguousRetries >= policy.MaxRetries
+			ambiguousRetries++
+		}
+		if exhausted {
 			finalStatusCode = outcome.statusCode
 			break
 		}
@@ -173,7 +199,7 @@ func (c *SpaceTradersClient) doWithRetry(ctx context.Context, method, path, toke
 			return fmt.Errorf("context cancelled: %w", ctx.Err())
 		}
 
-		delay := addJitter(c.backoffBase * time.Duration(1<<attempt))
+		delay := addJitter(policy.BackoffBase * time.Duration(1<<attempt))
 		if decision.retryAfter > 0 {
 			delay = decision.retryAfter
 		}
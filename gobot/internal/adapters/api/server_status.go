@@ -11,12 +11,16 @@ type ServerResets struct {
 }
 
 type ServerStatus struct {
+	Status       string
+	Version      string
 	ResetDate    string
 	ServerResets ServerResets
 }
 
 func (c *SpaceTradersClient) GetServerStatus(ctx context.Context) (*ServerStatus, error) {
 	var response struct {
+		Status       string `json:"status"`
+		Version      string `json:"version"`
 		ResetDate    string `json:"resetDate"`
 		ServerResets struct {
 			Next      string `json:"next"`
@@ -29,6 +33,8 @@ func (c *SpaceTradersClient) GetServerStatus(ctx context.Context) (*ServerStatus
 	}
 
 	return &ServerStatus{
+		Status:    response.Status,
+		Version:   response.Version,
 		ResetDate: response.ResetDate,
 		ServerResets: ServerResets{
 			Next:      response.ServerResets.Next,
@@ -0,0 +1,112 @@
+package api
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/andrescamacho/spacetraders-go/internal/domain/navigation"
+	"github.com/andrescamacho/spacetraders-go/internal/domain/shared"
+)
+
+// SetArrivalSyncOnRead turns the read-path arrival sync on or off (default
+// on). With it on, a lookup that finds a ship still IN_TRANSIT past its
+// arrival time confirms the arrival with one nav read and transitions the
+// ship to IN_ORBIT before returning it, instead of handing coordinators a
+// ship that landed up to a sweeper interval ago.
+func (r *ShipRepository) SetArrivalSyncOnRead(enabled bool) {
+	r.arrivalSyncDisabled = !enabled
+}
+
+// SetArrivalEventPublisher sets where the read-path arrival sync publishes
+// ARRIVED, so waiters parked on the event bus wake even when a read, not
+// the arrival timer, landed the transition. nil publishes nothing.
+func (r *ShipRepository) SetArrivalEventPublisher(publisher navigation.ShipEventPublisher) {
+	r.arrivalPublisher = publisher
+}
+
+// arrivalOverdue reports whether ship is still IN_TRANSIT after its arrival time.
+func (r *ShipRepository) arrivalOverdue(ship *navigation.Ship) bool {
+	if r.arrivalSyncDisabled || ship == nil || !ship.IsInTransit() {
+		return false
+	}
+	arrival := ship.ArrivalTime()
+	return arrival != nil && !arrival.After(r.clock.Now())
+}
+
+// syncArrival brings an overdue ship up to date. The API's nav block is the
+// authority: a ship it no longer shows in transit is transitioned to orbit;
+// one it still shows in transit keeps flying, with its arrival moved to the
+// API's. When the nav read fails the local arrival time is trusted, as the
+// arrival timer does. Any failure returns ship unchanged — a read must not
+// fail because its ship could not be synced.
+func (r *ShipRepository) syncArrival(ctx context.Context, ship *navigation.Ship) *navigation.Ship {
+	if !r.arrivalOverdue(ship) {
+		return ship
+	}
+	symbol, playerID := ship.ShipSymbol(), ship.PlayerID()
+
+	if nav, ok := r.readNavForArrival(ctx, symbol, playerID); ok && navigation.NavStatus(nav.NavStatus) == navigation.NavStatusInTransit {
+		arrival, perr := time.Parse(time.RFC3339, nav.ArrivalTime)
+		if perr != nil || !arrival.After(r.clock.Now()) {
+			return ship
+		}
+		fresh, _, err := r.SaveWithRetry(ctx, symbol, playerID, func(sh *navigation.Ship) (bool, error) {
+			if !sh.IsInTransit() {
+				return false, nil
+			}
+			sh.SetArrivalTime(arrival)
+			return true, nil
+		})
+		if err != nil {
+			log.Printf("Warning: arrival sync could not persist %s's new arrival: %v", symbol, err)
+			return ship
+		}
+		return fresh
+	}
+
+	fresh, saved, err := r.SaveWithRetry(ctx, symbol, playerID, arriveOverdue)
+	if err != nil {
+		log.Printf("Warning: arrival sync could not transition %s to orbit: %v", symbol, err)
+		return ship
+	}
+	if saved && r.arrivalPublisher != nil {
+		r.arrivalPublisher.PublishArrived(symbol, playerID, fresh.CurrentLocation().Symbol, fresh.NavStatus())
+	}
+	return fresh
+}
+
+// readNavForArrival reads symbol's nav block, reporting false when it cannot.
+func (r *ShipRepository) readNavForArrival(ctx context.Context, symbol string, playerID shared.PlayerID) (*navigation.ShipNavData, bool) {
+	if r.apiClient == nil || r.playerRepo == nil {
+		return nil, false
+	}
+	nav, err := r.GetShipNav(ctx, symbol, playerID)
+	if err != nil {
+		return nil, false
+	}
+	return nav, true
+}
+
+// syncArrivals runs syncArrival over ships in place.
+func (r *ShipRepository) syncArrivals(ctx context.Context, ships []*navigation.Ship) {
+	for i, ship := range ships {
+		if r.arrivalOverdue(ship) {
+			ships[i] = r.syncArrival(ctx, ship)
+		}
+	}
+}
+
+// arriveOverdue is the SaveWithRetry mutation the arrival sync lands. Like
+// the arrival timer's, it skips the write when a concurrent writer already
+// took the ship out of transit.
+func arriveOverdue(sh *navigation.Ship) (bool, error) {
+	if !sh.IsInTransit() {
+		return false, nil
+	}
+	if err := sh.Arrive(); err != nil {
+		return false, err
+	}
+	sh.ClearArrivalTime()
+	return true, nil
+}
@@ -15,29 +15,51 @@ type requirementsDTO struct {
 	Slots int `json:"slots"`
 }
 
+// shipNavDTO is a ship's nav block: embedded in every full ship read and
+// returned on its own by GET /my/ships/{symbol}/nav.
+type shipNavDTO struct {
+	SystemSymbol   string `json:"systemSymbol"`
+	WaypointSymbol string `json:"waypointSymbol"`
+	Status         string `json:"status"`
+	FlightMode     string `json:"flightMode"`
+	Route          *struct {
+		Arrival string `json:"arrival"`
+		// The API's route.origin is a waypoint object (symbol + coordinates)
+		// marking where the current transit began; departureTime is when it
+		// began.
+		DepartureTime string `json:"departureTime"`
+		Origin        struct {
+			Symbol string  `json:"symbol"`
+			X      float64 `json:"x"`
+			Y      float64 `json:"y"`
+		} `json:"origin"`
+	} `json:"route,omitempty"`
+}
+
+// toShipNavData maps the nav block for shipSymbol (the nav endpoint's
+// response does not repeat it).
+func (n shipNavDTO) toShipNavData(shipSymbol string) *navigation.ShipNavData {
+	data := &navigation.ShipNavData{
+		Symbol:       shipSymbol,
+		SystemSymbol: n.SystemSymbol,
+		Location:     n.WaypointSymbol,
+		NavStatus:    n.Status,
+		FlightMode:   n.FlightMode,
+	}
+	if n.Route != nil {
+		data.ArrivalTime = n.Route.Arrival
+		data.DepartureTime = n.Route.DepartureTime
+		data.OriginSymbol = n.Route.Origin.Symbol
+	}
+	return data
+}
+
 type shipDTO struct {
 	Symbol       string `json:"symbol"`
 	Registration struct {
 		Role string `json:"role"`
 	} `json:"registration"`
-	Nav struct {
-		SystemSymbol   string `json:"systemSymbol"`
-		WaypointSymbol string `json:"waypointSymbol"`
-		Status         string `json:"status"`
-		FlightMode     string `json:"flightMode"`
-		Route          *struct {
-			Arrival string `json:"arrival"`
-			// The API's route.origin is a waypoint object (symbol + coordinates)
-			// marking where the current transit began; departureTime is when it
-			// began.
-			DepartureTime string `json:"departureTime"`
-			Origin        struct {
-				Symbol string  `json:"symbol"`
-				X      float64 `json:"x"`
-				Y      float64 `json:"y"`
-			} `json:"origin"`
-		} `json:"route,omitempty"`
-	} `json:"nav"`
+	Nav  shipNavDTO `json:"nav"`
 	Fuel struct {
 		Current  int `json:"current"`
 		Capacity int `json:"capacity"`
@@ -55,11 +77,17 @@ type shipDTO struct {
 	Cooldown *struct {
 		Expiration string `json:"expiration"`
 	} `json:"cooldown,omitempty"`
+	// Condition and Integrity (0-1) on the engine, frame and reactor are
+	// their wear; see navigation.ComponentCondition.
 	Engine struct {
-		Speed int `json:"speed"`
+		Speed     int     `json:"speed"`
+		Condition float64 `json:"condition"`
+		Integrity float64 `json:"integrity"`
 	} `json:"engine"`
 	Frame struct {
-		Symbol string `json:"symbol"`
+		Symbol    string  `json:"symbol"`
+		Condition float64 `json:"condition"`
+		Integrity float64 `json:"integrity"`
 		// ModuleSlots/MountingPoints are the frame's fixed budgets - frames
 		// have no swap/upgrade endpoint, so these are permanent for the life
 		// of the hull.
@@ -74,6 +102,8 @@ type shipDTO struct {
 		Name         string          `json:"name"`
 		PowerOutput  int             `json:"powerOutput"`
 		Requirements requirementsDTO `json:"requirements"`
+		Condition    float64         `json:"condition"`
+		Integrity    float64         `json:"integrity"`
 	} `json:"reactor"`
 	Crew struct {
 		Current  int `json:"current"`
@@ -191,9 +221,15 @@ func (d *shipDTO) toShipData() *navigation.ShipData {
 			Crew:  d.Reactor.Requirements.Crew,
 			Slots: d.Reactor.Requirements.Slots,
 		},
-		CrewCurrent:  d.Crew.Current,
-		CrewRequired: d.Crew.Required,
-		CrewCapacity: d.Crew.Capacity,
-		Cargo:        cargo,
+		CrewCurrent:      d.Crew.Current,
+		CrewRequired:     d.Crew.Required,
+		CrewCapacity:     d.Crew.Capacity,
+		FrameCondition:   d.Frame.Condition,
+		FrameIntegrity:   d.Frame.Integrity,
+		ReactorCondition: d.Reactor.Condition,
+		ReactorIntegrity: d.Reactor.Integrity,
+		EngineCondition:  d.Engine.Condition,
+		EngineIntegrity:  d.Engine.Integrity,
+		Cargo:            cargo,
 	}
 }
@@ -0,0 +1,65 @@
+package api
+
+import (
+	"net/http"
+	"strings"
+)
+
+// ShipMutationListener is told the symbol of every ship a non-GET API request
+// may have changed. The daemon wires ShipRepository.InvalidateShipState here so
+// the ship state cache never serves cargo, fuel or nav that a trade, transfer,
+// extraction or module install has since moved.
+type ShipMutationListener func(shipSymbol string)
+
+// SetShipMutationListener registers the listener; nil removes it. Wired once at
+// daemon boot, before requests flow; the atomic pointer keeps it race-free
+// otherwise, mirroring SetPriorityScheduling.
+func (c *SpaceTradersClient) SetShipMutationListener(listener ShipMutationListener) {
+	if listener == nil {
+		c.shipMutationListener.Store(nil)
+		return
+	}
+	c.shipMutationListener.Store(&listener)
+}
+
+// notifyShipMutation reports the ships a request touched. It runs whatever the
+// outcome: a failed or retried mutation may still have landed server-side, and
+// a redundant invalidation only costs one live read.
+func (c *SpaceTradersClient) notifyShipMutation(method, path string, body interface{}) {
+	if method == http.MethodGet {
+		return
+	}
+	listener := c.shipMutationListener.Load()
+	if listener == nil {
+		return
+	}
+	for _, symbol := range mutatedShipSymbols(path, body) {
+		(*listener)(symbol)
+	}
+}
+
+// mutatedShipSymbols extracts the ships a mutating request addresses: the
+// /my/ships/{symbol}/... path segment, plus a "shipSymbol" body field - the
+// transfer target, or the ship delivering to a contract or construction site.
+func mutatedShipSymbols(path string, body interface{}) []string {
+	var symbols []string
+	if rest, ok := strings.CutPrefix(path, "/my/ships/"); ok {
+		if end := strings.IndexAny(rest, "/?"); end >= 0 {
+			rest = rest[:end]
+		}
+		if rest != "" {
+			symbols = append(symbols, rest)
+		}
+	}
+	var bodySymbol string
+	switch b := body.(type) {
+	case map[string]interface{}:
+		bodySymbol, _ = b["shipSymbol"].(string)
+	case map[string]string:
+		bodySymbol = b["shipSymbol"]
+	}
+	if bodySymbol != "" && (len(symbols) == 0 || symbols[0] != bodySymbol) {
+		symbols = append(symbols, bodySymbol)
+	}
+	return symbols
+}
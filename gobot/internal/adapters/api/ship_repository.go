@@ -56,6 +56,9 @@ type cachedShipList struct {
 // Caching Strategy:
 //   - In-memory cache (15s TTL): Prevents redundant DB reads
 //     when multiple coordinators call FindAllByPlayer in quick succession
+//   - Ship state cache (opt-in, see SetShipStateCacheTTL): write-through cache of
+//     API ship reads, so repeated SyncShipFromAPI/GetShipData calls do not each
+//     spend a rate-limit token
 type ShipRepository struct {
 	apiClient        domainPorts.APIClient
 	playerRepo       player.PlayerRepository
@@ -64,16 +67,24 @@ type ShipRepository struct {
 	db               *gorm.DB     // Database connection for ship state persistence
 	clock            shared.Clock // Clock for timestamps
 	shipListCache    sync.Map     // key: playerID (int) -> *cachedShipList
+	stateCache       *shipStateCache
 
 	// Optional arrival scheduler - notified after navigation to schedule state transition
 	arrivalScheduler navigation.ArrivalScheduler
 
+	// Optional fuel observer - fed predicted vs actual fuel after every navigation
+	fuelObserver navigation.FuelObserver
+
 	// CAS-retry knob. maxCASRetries<=0 means "use defaultMaxCASRetries";
 	// casRetryDisabled forces the last-write-wins-on-conflict path. Both default to
 	// their zero value so retry is LIVE by default across every construction path
 	// (RULINGS #5); the daemon overrides them from DaemonConfig via SetCASRetryPolicy.
 	maxCASRetries    int
 	casRetryDisabled bool
+
+	// Read-path arrival sync (see SetArrivalSyncOnRead); on unless disabled.
+	arrivalSyncDisabled bool
+	arrivalPublisher    navigation.ShipEventPublisher
 }
 
 // defaultMaxCASRetries is the number of re-find + re-apply attempts SaveWithRetry
@@ -100,6 +111,7 @@ func NewShipRepository(
 		waypointProvider: waypointProvider,
 		db:               db,
 		clock:            clock,
+		stateCache:       newShipStateCache(clock),
 	}
 }
 
@@ -110,6 +122,12 @@ func (r *ShipRepository) SetArrivalScheduler(scheduler navigation.ArrivalSchedul
 	r.arrivalScheduler = scheduler
 }
 
+// SetFuelObserver sets the observer fed each navigation's predicted vs actual
+// fuel use, for fuel calibration. Setter injection mirrors SetArrivalScheduler.
+func (r *ShipRepository) SetFuelObserver(observer navigation.FuelObserver) {
+	r.fuelObserver = observer
+}
+
 // SetCASRetryPolicy configures the optimistic-concurrency retry knob for
 // SaveWithRetry. maxRetries<=0 selects the built-in default (defaultMaxCASRetries);
 // disabled=true forces the last-write-wins path, disabling re-apply retry entirely.
@@ -119,6 +137,50 @@ func (r *ShipRepository) SetCASRetryPolicy(maxRetries int, disabled bool) {
 	r.casRetryDisabled = disabled
 }
 
+// SetShipStateCacheTTL arms the ship state cache: API ship reads younger than
+// ttl are served from memory unless a staleness policy (past arrival, fuel after
+// navigation) or an invalidating mutation says otherwise. ttl<=0 disables it,
+// which is the default. Wire InvalidateShipState into the API client's mutation
+// listener alongside this, or cargo-changing calls made outside the repository
+// would be served stale.
+func (r *ShipRepository) SetShipStateCacheTTL(ttl time.Duration) {
+	r.stateCache.setTTL(ttl)
+}
+
+// InvalidateShipState drops a ship's cached API state. The API client calls it
+// after every ship-mutating request.
+func (r *ShipRepository) InvalidateShipState(shipSymbol string) {
+	r.stateCache.invalidate(shipSymbol)
+}
+
+// fetchShipData reads a ship from the API, serving it from the ship state cache
+// when a fresh entry exists and ctx does not demand a fresh read. Every live
+// read is written to the cache.
+func (r *ShipRepository) fetchShipData(ctx context.Context, symbol string, playerID shared.PlayerID, token string) (*navigation.ShipData, error) {
+	if !navigation.FreshShipReadRequested(ctx) {
+		if cached, ok := r.stateCache.get(symbol, playerID.Value()); ok {
+			return cached, nil
+		}
+	}
+	generation := r.stateCache.beginFetch(symbol)
+	shipData, err := r.apiClient.GetShip(ctx, symbol, token)
+	if err != nil {
+		return nil, err
+	}
+	r.stateCache.store(shipData, playerID.Value(), generation)
+	return shipData, nil
+}
+
+// writeThroughShipState patches the cached entry for ship with a mutation the
+// API has just confirmed. No entry means nothing to patch: the next read goes
+// live. peeked/generation come from stateCache.peek taken before the API call.
+func (r *ShipRepository) writeThroughShipState(peeked shipStateEntry, generation uint64, ok bool, apply func(entry *shipStateEntry)) {
+	if !ok {
+		return
+	}
+	r.stateCache.writeThrough(peeked, generation, apply)
+}
+
 // resolvedCASRetries reports the effective retry bound: 0 when disabled (straight
 // to last-write-wins on the first conflict), otherwise the configured value or
 // defaultMaxCASRetries when unset.
@@ -135,7 +197,19 @@ func (r *ShipRepository) resolvedCASRetries() int {
 // FindBySymbol retrieves a ship by symbol and player ID from database.
 // If not found in DB, syncs from API first.
 // Database is the source of truth after daemon startup.
+// A ship found still IN_TRANSIT past its arrival time is synced first (see
+// SetArrivalSyncOnRead).
 func (r *ShipRepository) FindBySymbol(ctx context.Context, symbol string, playerID shared.PlayerID) (*navigation.Ship, error) {
+	ship, err := r.findBySymbol(ctx, symbol, playerID)
+	if err != nil {
+		return nil, err
+	}
+	return r.syncArrival(ctx, ship), nil
+}
+
+// findBySymbol is FindBySymbol without the arrival sync. SaveWithRetry loads
+// through it, so the sync's own saves cannot recurse.
+func (r *ShipRepository) findBySymbol(ctx context.Context, symbol string, playerID shared.PlayerID) (*navigation.Ship, error) {
 	var model persistence.ShipModel
 	err := r.db.WithContext(ctx).
 		Where("ship_symbol = ? AND player_id = ?", symbol, playerID.Value()).
@@ -161,7 +235,7 @@ func (r *ShipRepository) GetShipData(ctx context.Context, symbol string, playerI
 	}
 
 	// Fetch ship data from API (includes ArrivalTime for IN_TRANSIT ships)
-	shipData, err := r.apiClient.GetShip(ctx, symbol, player.Token)
+	shipData, err := r.fetchShipData(ctx, symbol, playerID, player.Token)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get ship from API: %w", err)
 	}
@@ -169,11 +243,30 @@ func (r *ShipRepository) GetShipData(ctx context.Context, symbol string, playerI
 	return shipData, nil
 }
 
+// GetShipNav retrieves only the ship's nav block from API. It always goes
+// live: the ship state cache holds whole ships, and a nav read is what
+// callers use to learn whether the cached nav has moved on.
+func (r *ShipRepository) GetShipNav(ctx context.Context, symbol string, playerID shared.PlayerID) (*navigation.ShipNavData, error) {
+	player, err := r.playerRepo.FindByID(ctx, playerID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find player: %w", err)
+	}
+
+	navData, err := r.apiClient.GetShipNav(ctx, symbol, player.Token)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get ship nav from API: %w", err)
+	}
+
+	return navData, nil
+}
+
 // FindAllByPlayer retrieves all ships for a player from database with short-lived caching.
 // Database is the source of truth after daemon startup.
 //
 // Caching: Returns cached ship list if within 15 seconds of last fetch.
 // This prevents redundant DB reads when multiple coordinators call this method.
+// Ships overdue to arrive are synced either way (see SetArrivalSyncOnRead);
+// the sync's saves drop the cached list.
 func (r *ShipRepository) FindAllByPlayer(ctx context.Context, playerID shared.PlayerID) ([]*navigation.Ship, error) {
 	cacheKey := playerID.Value()
 
@@ -184,6 +277,7 @@ func (r *ShipRepository) FindAllByPlayer(ctx context.Context, playerID shared.Pl
 			// Return a copy to prevent mutation of cached data
 			shipsCopy := make([]*navigation.Ship, len(cachedList.ships))
 			copy(shipsCopy, cachedList.ships)
+			r.syncArrivals(ctx, shipsCopy)
 			return shipsCopy, nil
 		}
 	}
@@ -207,6 +301,7 @@ func (r *ShipRepository) FindAllByPlayer(ctx context.Context, playerID shared.Pl
 		}
 		ships = append(ships, ship)
 	}
+	r.syncArrivals(ctx, ships)
 
 	// Cache the result
 	r.shipListCache.Store(cacheKey, &cachedShipList{
@@ -299,6 +394,8 @@ func (r *ShipRepository) Navigate(ctx context.Context, ship *navigation.Ship, de
 	}
 
 	// Call API to navigate ship
+	cached, generation, hasCached := r.stateCache.peek(ship.ShipSymbol())
+	origin := ship.CurrentLocation()
 	navResult, err := r.apiClient.NavigateShip(ctx, ship.ShipSymbol(), destination.Symbol, player.Token)
 	if err != nil {
 		return nil, fmt.Errorf("failed to navigate ship: %w", err)
@@ -313,6 +410,7 @@ func (r *ShipRepository) Navigate(ctx context.Context, ship *navigation.Ship, de
 	if err := ship.ConsumeFuel(navResult.FuelConsumed); err != nil {
 		return nil, fmt.Errorf("failed to consume fuel: %w", err)
 	}
+	r.observeFuel(ctx, ship, origin, destination, navResult)
 
 	// Set flight mode from result
 	if navResult.FlightMode != "" {
@@ -331,6 +429,24 @@ func (r *ShipRepository) Navigate(ctx context.Context, ship *navigation.Ship, de
 		log.Printf("Warning: failed to persist ship %s after navigate: %v", ship.ShipSymbol(), err)
 	}
 
+	// Nav is confirmed by the response; fuel is only derived, so it is marked
+	// stale and the next read of this ship goes live.
+	r.writeThroughShipState(cached, generation, hasCached, func(entry *shipStateEntry) {
+		entry.data.NavStatus = string(navigation.NavStatusInTransit)
+		if origin != nil {
+			entry.data.OriginSymbol = origin.Symbol
+			entry.data.OriginX = origin.X
+			entry.data.OriginY = origin.Y
+		}
+		entry.data.DepartureTime = r.clock.Now().Format(time.RFC3339)
+		entry.data.Location = destination.Symbol
+		entry.data.ArrivalTime = navResult.ArrivalTimeStr
+		if navResult.FlightMode != "" {
+			entry.data.FlightMode = navResult.FlightMode
+		}
+		entry.fuelStale = true
+	})
+
 	// Invalidate cache for this player
 	r.shipListCache.Delete(playerID.Value())
 
@@ -351,6 +467,7 @@ func (r *ShipRepository) Dock(ctx context.Context, ship *navigation.Ship, player
 	}
 
 	// Call API to dock ship (API itself is idempotent - will succeed if already docked)
+	cached, generation, hasCached := r.stateCache.peek(ship.ShipSymbol())
 	if err := r.apiClient.DockShip(ctx, ship.ShipSymbol(), player.Token); err != nil {
 		// Check if error is because ship is already docked (API returns specific error)
 		// If so, this is idempotent behavior - not an error
@@ -369,6 +486,10 @@ func (r *ShipRepository) Dock(ctx context.Context, ship *navigation.Ship, player
 		log.Printf("Warning: failed to persist ship %s after dock: %v", ship.ShipSymbol(), err)
 	}
 
+	r.writeThroughShipState(cached, generation, hasCached, func(entry *shipStateEntry) {
+		entry.data.NavStatus = string(navigation.NavStatusDocked)
+	})
+
 	// Invalidate cache for this player
 	r.shipListCache.Delete(playerID.Value())
 
@@ -384,6 +505,7 @@ func (r *ShipRepository) Orbit(ctx context.Context, ship *navigation.Ship, playe
 	}
 
 	// Call API to orbit ship (API itself is idempotent - will succeed if already in orbit)
+	cached, generation, hasCached := r.stateCache.peek(ship.ShipSymbol())
 	if err := r.apiClient.OrbitShip(ctx, ship.ShipSymbol(), player.Token); err != nil {
 		// Check if error is because ship is already in orbit (API returns specific error)
 		// If so, this is idempotent behavior - not an error
@@ -405,6 +527,11 @@ func (r *ShipRepository) Orbit(ctx context.Context, ship *navigation.Ship, playe
 		log.Printf("Warning: failed to persist ship %s after orbit: %v", ship.ShipSymbol(), err)
 	}
 
+	r.writeThroughShipState(cached, generation, hasCached, func(entry *shipStateEntry) {
+		entry.data.NavStatus = string(navigation.NavStatusInOrbit)
+		entry.data.ArrivalTime = ""
+	})
+
 	// Invalidate cache for this player
 	r.shipListCache.Delete(playerID.Value())
 
@@ -420,6 +547,7 @@ func (r *ShipRepository) Refuel(ctx context.Context, ship *navigation.Ship, play
 	}
 
 	// Call API to refuel ship
+	cached, generation, hasCached := r.stateCache.peek(ship.ShipSymbol())
 	refuelResult, err := r.apiClient.RefuelShip(ctx, ship.ShipSymbol(), player.Token, units)
 	if err != nil {
 		return nil, fmt.Errorf("failed to refuel ship: %w", err)
@@ -444,12 +572,75 @@ func (r *ShipRepository) Refuel(ctx context.Context, ship *navigation.Ship, play
 		log.Printf("Warning: failed to persist ship %s after refuel: %v", ship.ShipSymbol(), err)
 	}
 
+	r.writeThroughShipState(cached, generation, hasCached, func(entry *shipStateEntry) {
+		entry.data.FuelCurrent = ship.Fuel().Current
+	})
+
 	// Invalidate cache for this player
 	r.shipListCache.Delete(playerID.Value())
 
 	return refuelResult, nil
 }
 
+// RefuelFromCargo burns cargoUnits of the ship's own FUEL cargo into its tank
+// via API and persists the lighter hold and fuller tank to the database.
+func (r *ShipRepository) RefuelFromCargo(ctx context.Context, ship *navigation.Ship, playerID shared.PlayerID, cargoUnits int) (*navigation.RefuelResult, error) {
+	player, err := r.playerRepo.FindByID(ctx, playerID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find player: %w", err)
+	}
+
+	refuelResult, err := r.apiClient.RefuelShipFromCargo(ctx, ship.ShipSymbol(), player.Token, cargoUnits*shared.FuelPerCargoUnit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to refuel ship from cargo: %w", err)
+	}
+
+	if err := ship.RemoveCargo(shared.FuelCargoSymbol, cargoUnits); err != nil {
+		return nil, fmt.Errorf("failed to update ship cargo: %w", err)
+	}
+	if added := refuelResult.FuelCurrent - ship.Fuel().Current; added > 0 {
+		if err := ship.Refuel(added); err != nil {
+			return nil, fmt.Errorf("failed to update ship fuel: %w", err)
+		}
+	}
+
+	if err := r.Save(ctx, ship); err != nil {
+		log.Printf("Warning: failed to persist ship %s after refuel from cargo: %v", ship.ShipSymbol(), err)
+	}
+
+	// Both cargo and fuel changed; drop the cached state rather than patch it.
+	r.stateCache.invalidate(ship.ShipSymbol())
+	r.shipListCache.Delete(playerID.Value())
+
+	return refuelResult, nil
+}
+
+// observeFuel reports the navigation's fuel use to the fuel observer. The
+// prediction is the uncalibrated formula for the leg, so fits measure the
+// formula's own drift.
+func (r *ShipRepository) observeFuel(ctx context.Context, ship *navigation.Ship, origin, destination *shared.Waypoint, navResult *navigation.Result) {
+	if r.fuelObserver == nil || origin == nil || navResult.FuelConsumed <= 0 {
+		return
+	}
+	modeName := navResult.FlightMode
+	if modeName == "" {
+		modeName = ship.FlightMode()
+	}
+	mode, ok := shared.ParseFlightMode(modeName)
+	if !ok {
+		return
+	}
+	distance := origin.DistanceTo(destination)
+	r.fuelObserver.ObserveFuel(ctx, navigation.FuelObservation{
+		ShipSymbol: ship.ShipSymbol(),
+		Mode:       mode,
+		Distance:   distance,
+		Predicted:  mode.FuelCost(distance),
+		Actual:     navResult.FuelConsumed,
+		ObservedAt: r.clock.Now(),
+	})
+}
+
 // SetFlightMode sets the ship's flight mode via API and persists state to database.
 func (r *ShipRepository) SetFlightMode(ctx context.Context, ship *navigation.Ship, playerID shared.PlayerID, mode string) error {
 	// Skip if already set
@@ -464,6 +655,7 @@ func (r *ShipRepository) SetFlightMode(ctx context.Context, ship *navigation.Shi
 	}
 
 	// Call API to set flight mode
+	cached, generation, hasCached := r.stateCache.peek(ship.ShipSymbol())
 	if err := r.apiClient.SetFlightMode(ctx, ship.ShipSymbol(), mode, player.Token); err != nil {
 		return fmt.Errorf("failed to set flight mode: %w", err)
 	}
@@ -476,6 +668,10 @@ func (r *ShipRepository) SetFlightMode(ctx context.Context, ship *navigation.Shi
 		log.Printf("Warning: failed to persist ship %s after set flight mode: %v", ship.ShipSymbol(), err)
 	}
 
+	r.writeThroughShipState(cached, generation, hasCached, func(entry *shipStateEntry) {
+		entry.data.FlightMode = mode
+	})
+
 	// Invalidate cache for this player
 	r.shipListCache.Delete(playerID.Value())
 
@@ -496,7 +692,9 @@ func (r *ShipRepository) JettisonCargo(ctx context.Context, ship *navigation.Shi
 	}
 
 	// Note: Cargo is updated by the API, and we refetch ship state when needed
-	// No need to update the domain entity here
+	// No need to update the domain entity here, but the cached API state is
+	// now wrong about cargo.
+	r.stateCache.invalidate(ship.ShipSymbol())
 
 	return nil
 }
@@ -583,6 +781,7 @@ func (r *ShipRepository) shipDataToDomain(ctx context.Context, data *navigation.
 	)
 	ship.SetReactor(data.ReactorSymbol, data.ReactorName, data.ReactorPowerOutput, reactorRequirements)
 	ship.SetCrew(data.CrewCurrent, data.CrewRequired, data.CrewCapacity)
+	ship.SetCondition(navigation.ConditionFromShipData(data))
 
 	return ship, nil
 }
@@ -824,6 +1023,7 @@ func (r *ShipRepository) shipToModel(ship *navigation.Ship) persistence.ShipMode
 	model.CrewCurrent = ship.CrewCurrent()
 	model.CrewRequired = ship.CrewRequired()
 	model.CrewCapacity = ship.CrewCapacity()
+	setModelCondition(&model, ship.Condition())
 
 	// Cooldown
 	model.CooldownExpiration = ship.CooldownExpiration()
@@ -1032,7 +1232,7 @@ func (r *ShipRepository) SaveWithRetry(ctx context.Context, symbol string, playe
 	var ship *navigation.Ship
 	for attempt := 0; ; attempt++ {
 		var err error
-		ship, err = r.FindBySymbol(ctx, symbol, playerID)
+		ship, err = r.findBySymbol(ctx, symbol, playerID)
 		if err != nil {
 			return nil, false, err
 		}
@@ -1248,7 +1448,7 @@ func (r *ShipRepository) ClaimShip(ctx context.Context, shipSymbol string, conta
 			First(&model).Error
 
 		if errors.Is(err, gorm.ErrRecordNotFound) {
-			return fmt.Errorf("ship %s not found for player %d", shipSymbol, playerID.Value())
+			return shared.Errorf(shared.CodeShipNotFound, "ship %s not found for player %d", shipSymbol, playerID.Value())
 		}
 		if err != nil {
 			return fmt.Errorf("failed to lock ship: %w", err)
@@ -1334,7 +1534,7 @@ func (r *ShipRepository) ReserveForCaptain(ctx context.Context, shipSymbol strin
 			First(&model).Error
 
 		if errors.Is(err, gorm.ErrRecordNotFound) {
-			return fmt.Errorf("ship %s not found for player %d", shipSymbol, playerID.Value())
+			return shared.Errorf(shared.CodeShipNotFound, "ship %s not found for player %d", shipSymbol, playerID.Value())
 		}
 		if err != nil {
 			return fmt.Errorf("failed to lock ship: %w", err)
@@ -1399,7 +1599,7 @@ func (r *ShipRepository) ReleaseCaptainReservation(ctx context.Context, shipSymb
 			First(&model).Error
 
 		if errors.Is(err, gorm.ErrRecordNotFound) {
-			return fmt.Errorf("ship %s not found for player %d", shipSymbol, playerID.Value())
+			return shared.Errorf(shared.CodeShipNotFound, "ship %s not found for player %d", shipSymbol, playerID.Value())
 		}
 		if err != nil {
 			return fmt.Errorf("failed to lock ship: %w", err)
@@ -1464,7 +1664,7 @@ func (r *ShipRepository) PreemptForCaptain(ctx context.Context, shipSymbol strin
 			First(&model).Error
 
 		if errors.Is(err, gorm.ErrRecordNotFound) {
-			return fmt.Errorf("ship %s not found for player %d", shipSymbol, playerID.Value())
+			return shared.Errorf(shared.CodeShipNotFound, "ship %s not found for player %d", shipSymbol, playerID.Value())
 		}
 		if err != nil {
 			return fmt.Errorf("failed to lock ship: %w", err)
@@ -1553,7 +1753,7 @@ func (r *ShipRepository) ReleaseContainerClaim(ctx context.Context, shipSymbol s
 			First(&model).Error
 
 		if errors.Is(err, gorm.ErrRecordNotFound) {
-			return fmt.Errorf("ship %s not found for player %d", shipSymbol, playerID.Value())
+			return shared.Errorf(shared.CodeShipNotFound, "ship %s not found for player %d", shipSymbol, playerID.Value())
 		}
 		if err != nil {
 			return fmt.Errorf("failed to lock ship: %w", err)
@@ -1622,7 +1822,7 @@ func (r *ShipRepository) AssignFleet(ctx context.Context, shipSymbol string, fle
 			First(&model).Error
 
 		if errors.Is(err, gorm.ErrRecordNotFound) {
-			return fmt.Errorf("ship %s not found for player %d", shipSymbol, playerID.Value())
+			return shared.Errorf(shared.CodeShipNotFound, "ship %s not found for player %d", shipSymbol, playerID.Value())
 		}
 		if err != nil {
 			return fmt.Errorf("failed to lock ship: %w", err)
@@ -1667,7 +1867,7 @@ func (r *ShipRepository) SetCargoReservation(ctx context.Context, shipSymbol, go
 			First(&model).Error
 
 		if errors.Is(err, gorm.ErrRecordNotFound) {
-			return fmt.Errorf("ship %s not found for player %d", shipSymbol, playerID.Value())
+			return shared.Errorf(shared.CodeShipNotFound, "ship %s not found for player %d", shipSymbol, playerID.Value())
 		}
 		if err != nil {
 			return fmt.Errorf("failed to lock ship: %w", err)
@@ -1824,6 +2024,14 @@ func (r *ShipRepository) modelToDomain(ctx context.Context, model *persistence.S
 	// being clobbered to zero (see shipToModel).
 	ship.SetTransitOrigin(model.OriginSymbol, model.OriginX, model.OriginY, model.DepartureTime)
 
+	// Component wear: reloaded like the transit origin so a whole-row Save
+	// keeps the last synced condition.
+	ship.SetCondition(navigation.ShipCondition{
+		Frame:   navigation.ComponentCondition{Condition: model.FrameCondition, Integrity: model.FrameIntegrity},
+		Reactor: navigation.ComponentCondition{Condition: model.ReactorCondition, Integrity: model.ReactorIntegrity},
+		Engine:  navigation.ComponentCondition{Condition: model.EngineCondition, Integrity: model.EngineIntegrity},
+	})
+
 	ship.SetPersistedVersion(model.Version)
 	return ship, nil
 }
@@ -1989,10 +2197,23 @@ func (r *ShipRepository) shipDataToModel(ctx context.Context, data *navigation.S
 	model.CrewCurrent = data.CrewCurrent
 	model.CrewRequired = data.CrewRequired
 	model.CrewCapacity = data.CrewCapacity
+	condition := navigation.ConditionFromShipData(data)
+	setModelCondition(model, condition)
+	metrics.RecordShipCondition(data.Symbol, condition)
 
 	return model, nil
 }
 
+// setModelCondition flattens a ship's component wear into its columns
+func setModelCondition(model *persistence.ShipModel, condition navigation.ShipCondition) {
+	model.FrameCondition = condition.Frame.Condition
+	model.FrameIntegrity = condition.Frame.Integrity
+	model.ReactorCondition = condition.Reactor.Condition
+	model.ReactorIntegrity = condition.Reactor.Integrity
+	model.EngineCondition = condition.Engine.Condition
+	model.EngineIntegrity = condition.Engine.Integrity
+}
+
 // SyncAllFromAPI fetches all ships from API and upserts to database
 func (r *ShipRepository) SyncAllFromAPI(ctx context.Context, playerID shared.PlayerID) (int, error) {
 	player, err := r.playerRepo.FindByID(ctx, playerID)
@@ -2001,10 +2222,12 @@ func (r *ShipRepository) SyncAllFromAPI(ctx context.Context, playerID shared.Pla
 	}
 
 	// Fetch all ships from API
+	epoch := r.stateCache.beginFleetFetch()
 	shipsData, err := r.apiClient.ListShips(ctx, player.Token)
 	if err != nil {
 		return 0, fmt.Errorf("failed to list ships from API: %w", err)
 	}
+	r.stateCache.storeFleet(shipsData, playerID.Value(), epoch)
 
 	now := r.clock.Now()
 	models := make([]persistence.ShipModel, 0, len(shipsData))
@@ -2141,8 +2364,8 @@ func (r *ShipRepository) SyncShipFromAPI(ctx context.Context, symbol string, pla
 		return nil, err
 	}
 
-	// Fetch from API
-	shipData, err := r.apiClient.GetShip(ctx, symbol, player.Token)
+	// Fetch from API (or a fresh ship state cache entry)
+	shipData, err := r.fetchShipData(ctx, symbol, playerID, player.Token)
 	if err != nil {
 		return nil, err
 	}
@@ -0,0 +1,141 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+
+	"github.com/andrescamacho/spacetraders-go/internal/adapters/persistence"
+	"github.com/andrescamacho/spacetraders-go/internal/domain/navigation"
+	"github.com/andrescamacho/spacetraders-go/internal/domain/player"
+	domainPorts "github.com/andrescamacho/spacetraders-go/internal/domain/ports"
+	"github.com/andrescamacho/spacetraders-go/internal/domain/shared"
+	"github.com/andrescamacho/spacetraders-go/internal/infrastructure/database"
+)
+
+// arrivalSyncFakeAPIClient answers GetShipNav with a fixed nav block or error.
+type arrivalSyncFakeAPIClient struct {
+	domainPorts.APIClient
+	nav      *navigation.ShipNavData
+	err      error
+	navReads int
+}
+
+func (f *arrivalSyncFakeAPIClient) GetShipNav(_ context.Context, _, _ string) (*navigation.ShipNavData, error) {
+	f.navReads++
+	return f.nav, f.err
+}
+
+type arrivalSyncPublisher struct {
+	navigation.ShipEventPublisher
+	arrived []string
+}
+
+func (p *arrivalSyncPublisher) PublishArrived(shipSymbol string, _ shared.PlayerID, _ string, _ navigation.NavStatus) {
+	p.arrived = append(p.arrived, shipSymbol)
+}
+
+func setupArrivalSyncRepo(t *testing.T, apiClient *arrivalSyncFakeAPIClient, arrival time.Time) (*ShipRepository, shared.PlayerID, *gorm.DB) {
+	t.Helper()
+	db, err := database.NewTestConnection()
+	require.NoError(t, err)
+
+	playerRow := persistence.PlayerModel{AgentSymbol: "TORWIND", Token: "tok-a", CreatedAt: time.Now()}
+	require.NoError(t, db.Create(&playerRow).Error)
+	playerID := shared.MustNewPlayerID(playerRow.ID)
+
+	require.NoError(t, db.Create(&persistence.ShipModel{
+		ShipSymbol:       "TORWIND-3",
+		PlayerID:         playerRow.ID,
+		AssignmentStatus: "idle",
+		NavStatus:        "IN_TRANSIT",
+		LocationSymbol:   "X1-TEST-B2",
+		SystemSymbol:     "X1-TEST",
+		ArrivalTime:      &arrival,
+		FuelCurrent:      100,
+		FuelCapacity:     100,
+		CargoCapacity:    40,
+		EngineSpeed:      30,
+		Version:          1,
+	}).Error)
+
+	playerRepo := &syncNavOriginFakePlayerRepo{p: &player.Player{ID: playerID, Token: "tok-a"}}
+	repo := NewShipRepository(apiClient, playerRepo, nil, syncNavOriginFakeWaypointProvider{}, db, nil)
+	return repo, playerID, db
+}
+
+func TestFindBySymbol_OverdueArrivalConfirmedByAPIReturnsInOrbit(t *testing.T) {
+	apiClient := &arrivalSyncFakeAPIClient{nav: &navigation.ShipNavData{Symbol: "TORWIND-3", NavStatus: "IN_ORBIT"}}
+	repo, playerID, db := setupArrivalSyncRepo(t, apiClient, time.Now().Add(-time.Minute))
+	publisher := &arrivalSyncPublisher{}
+	repo.SetArrivalEventPublisher(publisher)
+
+	ship, err := repo.FindBySymbol(context.Background(), "TORWIND-3", playerID)
+	require.NoError(t, err)
+	require.Equal(t, navigation.NavStatusInOrbit, ship.NavStatus())
+	require.Nil(t, ship.ArrivalTime())
+	require.Equal(t, []string{"TORWIND-3"}, publisher.arrived)
+
+	var row persistence.ShipModel
+	require.NoError(t, db.Where("ship_symbol = ?", "TORWIND-3").First(&row).Error)
+	require.Equal(t, "IN_ORBIT", row.NavStatus)
+
+	_, err = repo.FindBySymbol(context.Background(), "TORWIND-3", playerID)
+	require.NoError(t, err)
+	require.Equal(t, 1, apiClient.navReads, "a synced ship is not re-read")
+}
+
+func TestFindBySymbol_OverdueArrivalStillInTransitFollowsAPIArrival(t *testing.T) {
+	later := time.Now().Add(5 * time.Minute).UTC().Truncate(time.Second)
+	apiClient := &arrivalSyncFakeAPIClient{nav: &navigation.ShipNavData{
+		Symbol: "TORWIND-3", NavStatus: "IN_TRANSIT", ArrivalTime: later.Format(time.RFC3339),
+	}}
+	repo, playerID, _ := setupArrivalSyncRepo(t, apiClient, time.Now().Add(-time.Minute))
+
+	ship, err := repo.FindBySymbol(context.Background(), "TORWIND-3", playerID)
+	require.NoError(t, err)
+	require.True(t, ship.IsInTransit())
+	require.NotNil(t, ship.ArrivalTime())
+	require.True(t, ship.ArrivalTime().Equal(later))
+}
+
+func TestFindBySymbol_OverdueArrivalFallsBackToLocalTimerWhenNavReadFails(t *testing.T) {
+	apiClient := &arrivalSyncFakeAPIClient{err: errors.New("rate limited")}
+	repo, playerID, _ := setupArrivalSyncRepo(t, apiClient, time.Now().Add(-time.Minute))
+
+	ship, err := repo.FindBySymbol(context.Background(), "TORWIND-3", playerID)
+	require.NoError(t, err)
+	require.Equal(t, navigation.NavStatusInOrbit, ship.NavStatus())
+}
+
+func TestFindBySymbol_ArrivalSyncLeavesFutureAndDisabledAlone(t *testing.T) {
+	apiClient := &arrivalSyncFakeAPIClient{nav: &navigation.ShipNavData{NavStatus: "IN_ORBIT"}}
+	repo, playerID, _ := setupArrivalSyncRepo(t, apiClient, time.Now().Add(time.Minute))
+
+	ship, err := repo.FindBySymbol(context.Background(), "TORWIND-3", playerID)
+	require.NoError(t, err)
+	require.True(t, ship.IsInTransit(), "arrival not yet due")
+
+	apiClient = &arrivalSyncFakeAPIClient{nav: &navigation.ShipNavData{NavStatus: "IN_ORBIT"}}
+	repo, playerID, _ = setupArrivalSyncRepo(t, apiClient, time.Now().Add(-time.Minute))
+	repo.SetArrivalSyncOnRead(false)
+
+	ship, err = repo.FindBySymbol(context.Background(), "TORWIND-3", playerID)
+	require.NoError(t, err)
+	require.True(t, ship.IsInTransit())
+	require.Zero(t, apiClient.navReads)
+}
+
+func TestFindAllByPlayer_SyncsOverdueArrivals(t *testing.T) {
+	apiClient := &arrivalSyncFakeAPIClient{nav: &navigation.ShipNavData{NavStatus: "IN_ORBIT"}}
+	repo, playerID, _ := setupArrivalSyncRepo(t, apiClient, time.Now().Add(-time.Minute))
+
+	ships, err := repo.FindAllByPlayer(context.Background(), playerID)
+	require.NoError(t, err)
+	require.Len(t, ships, 1)
+	require.Equal(t, navigation.NavStatusInOrbit, ships[0].NavStatus())
+}
@@ -0,0 +1,177 @@
+package api
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/andrescamacho/spacetraders-go/internal/adapters/persistence"
+	"github.com/andrescamacho/spacetraders-go/internal/domain/navigation"
+	"github.com/andrescamacho/spacetraders-go/internal/domain/player"
+	domainPorts "github.com/andrescamacho/spacetraders-go/internal/domain/ports"
+	"github.com/andrescamacho/spacetraders-go/internal/domain/shared"
+	"github.com/andrescamacho/spacetraders-go/internal/infrastructure/database"
+)
+
+// stateCacheFakeAPIClient serves one ship from GetShip and counts the reads, so
+// the tests can tell a cache hit from a live call.
+type stateCacheFakeAPIClient struct {
+	domainPorts.APIClient
+	ship     navigation.ShipData
+	getCalls int
+}
+
+func (f *stateCacheFakeAPIClient) GetShip(_ context.Context, _ string, _ string) (*navigation.ShipData, error) {
+	f.getCalls++
+	data := f.ship
+	return &data, nil
+}
+
+func (f *stateCacheFakeAPIClient) DockShip(_ context.Context, _ string, _ string) error {
+	return nil
+}
+
+func (f *stateCacheFakeAPIClient) OrbitShip(_ context.Context, _ string, _ string) error {
+	return nil
+}
+
+func (f *stateCacheFakeAPIClient) NavigateShip(_ context.Context, _, _ string, _ string) (*navigation.Result, error) {
+	return &navigation.Result{FuelConsumed: 10, ArrivalTimeStr: "2030-01-01T00:10:00Z"}, nil
+}
+
+func setupStateCacheRepo(t *testing.T) (*ShipRepository, *stateCacheFakeAPIClient, *shared.MockClock, shared.PlayerID) {
+	t.Helper()
+	db, err := database.NewTestConnection()
+	require.NoError(t, err)
+
+	playerRow := persistence.PlayerModel{AgentSymbol: "TORWIND", Token: "tok-a", CreatedAt: time.Now()}
+	require.NoError(t, db.Create(&playerRow).Error)
+	playerID := shared.MustNewPlayerID(playerRow.ID)
+
+	apiClient := &stateCacheFakeAPIClient{ship: navigation.ShipData{
+		Symbol:        "TORWIND-1",
+		Location:      "X1-SC-A1",
+		NavStatus:     "IN_ORBIT",
+		FlightMode:    "CRUISE",
+		FuelCurrent:   80,
+		FuelCapacity:  100,
+		CargoCapacity: 40,
+		EngineSpeed:   10,
+		FrameSymbol:   "FRAME_FRIGATE",
+		Role:          "COMMAND",
+	}}
+	playerRepo := &syncNavOriginFakePlayerRepo{p: &player.Player{ID: playerID, Token: "tok-a"}}
+	clock := &shared.MockClock{CurrentTime: time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC)}
+	repo := NewShipRepository(apiClient, playerRepo, nil, syncNavOriginFakeWaypointProvider{}, db, clock)
+	repo.SetShipStateCacheTTL(time.Minute)
+	return repo, apiClient, clock, playerID
+}
+
+// Repeat reads inside the TTL are served from memory; the TTL, an explicit
+// invalidation and a fresh-read context each force the next read live.
+func TestShipStateCache_ServesRepeatReadsWithinTTL(t *testing.T) {
+	repo, apiClient, clock, playerID := setupStateCacheRepo(t)
+	ctx := context.Background()
+
+	_, err := repo.SyncShipFromAPI(ctx, "TORWIND-1", playerID)
+	require.NoError(t, err)
+	_, err = repo.GetShipData(ctx, "TORWIND-1", playerID)
+	require.NoError(t, err)
+	require.Equal(t, 1, apiClient.getCalls, "second read must be a cache hit")
+
+	_, err = repo.GetShipData(navigation.WithFreshShipRead(ctx), "TORWIND-1", playerID)
+	require.NoError(t, err)
+	require.Equal(t, 2, apiClient.getCalls, "a fresh-read context bypasses the cache")
+
+	repo.InvalidateShipState("TORWIND-1")
+	_, err = repo.GetShipData(ctx, "TORWIND-1", playerID)
+	require.NoError(t, err)
+	require.Equal(t, 3, apiClient.getCalls, "an invalidated ship is read live")
+
+	clock.Advance(time.Minute)
+	_, err = repo.GetShipData(ctx, "TORWIND-1", playerID)
+	require.NoError(t, err)
+	require.Equal(t, 4, apiClient.getCalls, "an entry at the TTL is read live")
+}
+
+// With the TTL unset the cache is inert: every read goes to the API.
+func TestShipStateCache_DisabledByDefault(t *testing.T) {
+	repo, apiClient, _, playerID := setupStateCacheRepo(t)
+	repo.SetShipStateCacheTTL(0)
+
+	for i := 0; i < 2; i++ {
+		_, err := repo.GetShipData(context.Background(), "TORWIND-1", playerID)
+		require.NoError(t, err)
+	}
+	require.Equal(t, 2, apiClient.getCalls)
+}
+
+// Dock and orbit write their confirmed nav status through to the cache; a navigate
+// writes the transit through but marks fuel stale, so the read after it goes
+// live, and an IN_TRANSIT snapshot expires once its arrival time passes.
+func TestShipStateCache_WriteThroughAndStalenessPolicies(t *testing.T) {
+	repo, apiClient, clock, playerID := setupStateCacheRepo(t)
+	ctx := context.Background()
+
+	ship, err := repo.SyncShipFromAPI(ctx, "TORWIND-1", playerID)
+	require.NoError(t, err)
+	require.NoError(t, repo.Dock(ctx, ship, playerID))
+
+	data, err := repo.GetShipData(ctx, "TORWIND-1", playerID)
+	require.NoError(t, err)
+	require.Equal(t, 1, apiClient.getCalls, "dock is written through, not invalidated")
+	require.Equal(t, "DOCKED", data.NavStatus)
+
+	require.NoError(t, repo.Orbit(ctx, ship, playerID))
+	destination, err := shared.NewWaypoint("X1-SC-B2", 10, 0)
+	require.NoError(t, err)
+	_, err = repo.Navigate(ctx, ship, destination, playerID)
+	require.NoError(t, err)
+
+	entry, _, ok := repo.stateCache.peek("TORWIND-1")
+	require.True(t, ok)
+	require.Equal(t, "IN_TRANSIT", entry.data.NavStatus)
+	require.Equal(t, "X1-SC-B2", entry.data.Location)
+	require.True(t, entry.fuelStale)
+
+	_, err = repo.GetShipData(ctx, "TORWIND-1", playerID)
+	require.NoError(t, err)
+	require.Equal(t, 2, apiClient.getCalls, "fuel is stale after navigation")
+
+	// Cache a transit that lands in 30s, then step past its arrival.
+	apiClient.ship.NavStatus = "IN_TRANSIT"
+	apiClient.ship.ArrivalTime = clock.Now().Add(30 * time.Second).Format(time.RFC3339)
+	repo.InvalidateShipState("TORWIND-1")
+	_, err = repo.GetShipData(ctx, "TORWIND-1", playerID)
+	require.NoError(t, err)
+	_, err = repo.GetShipData(ctx, "TORWIND-1", playerID)
+	require.NoError(t, err)
+	require.Equal(t, 3, apiClient.getCalls, "transit before arrival is served from cache")
+
+	clock.Advance(30 * time.Second)
+	_, err = repo.GetShipData(ctx, "TORWIND-1", playerID)
+	require.NoError(t, err)
+	require.Equal(t, 4, apiClient.getCalls, "nav status is stale once arrival time passes")
+}
+
+func TestMutatedShipSymbols(t *testing.T) {
+	cases := []struct {
+		name string
+		path string
+		body interface{}
+		want []string
+	}{
+		{"ship action", "/my/ships/SHIP-1/sell", map[string]interface{}{"symbol": "IRON"}, []string{"SHIP-1"}},
+		{"ship nav patch", "/my/ships/SHIP-1/nav", map[string]string{"flightMode": "DRIFT"}, []string{"SHIP-1"}},
+		{"transfer target", "/my/ships/SHIP-1/transfer", map[string]interface{}{"shipSymbol": "SHIP-2"}, []string{"SHIP-1", "SHIP-2"}},
+		{"contract delivery", "/my/contracts/C1/deliver", map[string]interface{}{"shipSymbol": "SHIP-3"}, []string{"SHIP-3"}},
+		{"ship purchase", "/my/ships", map[string]interface{}{"shipType": "SHIP_PROBE"}, nil},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.want, mutatedShipSymbols(tc.path, tc.body))
+		})
+	}
+}
@@ -0,0 +1,215 @@
+package api
+
+import (
+	"sync"
+	"time"
+
+	"github.com/andrescamacho/spacetraders-go/internal/domain/navigation"
+	"github.com/andrescamacho/spacetraders-go/internal/domain/shared"
+)
+
+// shipStateCache holds the last API read of each ship so SyncShipFromAPI and
+// GetShipData can answer repeat reads without a GET /my/ships/{symbol}.
+//
+// It is write-through: every live read stores its result, and the repository's
+// own mutations (navigate, dock, orbit, refuel, flight mode) patch the cached
+// entry with the state the API just confirmed. Any other ship-mutating API call
+// (cargo trades, transfers, extraction, module installs...) drops the entry via
+// the client's mutation listener, because only the API knows its outcome.
+//
+// A hit additionally has to pass every staleness policy; one stale field makes
+// the whole entry a miss, since ShipData is persisted as one row. ttl<=0
+// disables the cache entirely (the default).
+type shipStateCache struct {
+	mu      sync.Mutex
+	ttl     time.Duration
+	clock   shared.Clock
+	entries map[string]*shipStateEntry // key: ship symbol
+
+	// generation bumps on every invalidation so a live read that started before
+	// a mutation cannot store its pre-mutation snapshot after the drop.
+	generation map[string]uint64
+	// epoch bumps on any invalidation, fencing fleet-wide reads the same way.
+	epoch uint64
+}
+
+// shipStateEntry is one cached ship read plus the field-level staleness marks
+// the write-through path sets.
+type shipStateEntry struct {
+	playerID  int
+	data      navigation.ShipData
+	fetchedAt time.Time
+
+	// fuelStale is set by a navigate: the API reports fuel consumed, but the
+	// repository's arithmetic on it is not authoritative enough to serve.
+	fuelStale bool
+}
+
+// shipStalenessPolicy reports why an entry may no longer be served, or "" when
+// the policy has no objection.
+type shipStalenessPolicy func(entry *shipStateEntry, now time.Time, ttl time.Duration) string
+
+// shipStalenessPolicies are evaluated in order on every cache read.
+var shipStalenessPolicies = []shipStalenessPolicy{
+	staleAfterTTL,
+	staleNavAfterArrival,
+	staleFuelAfterNavigation,
+}
+
+// staleAfterTTL bounds every entry's age regardless of field.
+func staleAfterTTL(entry *shipStateEntry, now time.Time, ttl time.Duration) string {
+	if now.Sub(entry.fetchedAt) >= ttl {
+		return "ttl"
+	}
+	return ""
+}
+
+// staleNavAfterArrival expires an IN_TRANSIT snapshot once its arrival time
+// passes: the ship has landed, and the cached nav status no longer says so. A
+// transit with no parseable arrival cannot be proven current and is stale too.
+func staleNavAfterArrival(entry *shipStateEntry, now time.Time, _ time.Duration) string {
+	if navigation.NavStatus(entry.data.NavStatus) != navigation.NavStatusInTransit {
+		return ""
+	}
+	arrival, err := time.Parse(time.RFC3339, entry.data.ArrivalTime)
+	if err != nil || !now.Before(arrival) {
+		return "nav_arrived"
+	}
+	return ""
+}
+
+// staleFuelAfterNavigation expires an entry whose fuel was marked stale by a
+// navigate write-through.
+func staleFuelAfterNavigation(entry *shipStateEntry, _ time.Time, _ time.Duration) string {
+	if entry.fuelStale {
+		return "fuel_after_navigation"
+	}
+	return ""
+}
+
+func newShipStateCache(clock shared.Clock) *shipStateCache {
+	return &shipStateCache{
+		clock:      clock,
+		entries:    make(map[string]*shipStateEntry),
+		generation: make(map[string]uint64),
+	}
+}
+
+func (c *shipStateCache) setTTL(ttl time.Duration) {
+	c.mu.Lock()
+	c.ttl = ttl
+	if ttl <= 0 {
+		c.entries = make(map[string]*shipStateEntry)
+	}
+	c.mu.Unlock()
+}
+
+// get returns a copy of the cached ship data when it passes every staleness
+// policy; a stale entry is dropped on the way out.
+func (c *shipStateCache) get(symbol string, playerID int) (*navigation.ShipData, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.ttl <= 0 {
+		return nil, false
+	}
+	entry, ok := c.entries[symbol]
+	if !ok || entry.playerID != playerID {
+		return nil, false
+	}
+	now := c.clock.Now()
+	for _, policy := range shipStalenessPolicies {
+		if reason := policy(entry, now, c.ttl); reason != "" {
+			delete(c.entries, symbol)
+			return nil, false
+		}
+	}
+	data := entry.data
+	return &data, true
+}
+
+// beginFetch returns the generation a live read must present to store.
+func (c *shipStateCache) beginFetch(symbol string) uint64 {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.generation[symbol]
+}
+
+// store caches a live read, unless the ship was invalidated since beginFetch.
+func (c *shipStateCache) store(data *navigation.ShipData, playerID int, generation uint64) {
+	if data == nil {
+		return
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.ttl <= 0 || c.generation[data.Symbol] != generation {
+		return
+	}
+	c.entries[data.Symbol] = &shipStateEntry{
+		playerID:  playerID,
+		data:      *data,
+		fetchedAt: c.clock.Now(),
+	}
+}
+
+// beginFleetFetch returns the epoch a fleet-wide read must present to store.
+func (c *shipStateCache) beginFleetFetch() uint64 {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.epoch
+}
+
+// storeFleet caches a fleet-wide live read, unless any ship was invalidated
+// since beginFleetFetch - the list cannot tell which of its entries predate it.
+func (c *shipStateCache) storeFleet(ships []*navigation.ShipData, playerID int, epoch uint64) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.ttl <= 0 || c.epoch != epoch {
+		return
+	}
+	now := c.clock.Now()
+	for _, data := range ships {
+		if data == nil {
+			continue
+		}
+		c.entries[data.Symbol] = &shipStateEntry{playerID: playerID, data: *data, fetchedAt: now}
+	}
+}
+
+// peek snapshots the current entry and its generation so a mutation can write
+// through onto it after its own API call has invalidated the ship.
+func (c *shipStateCache) peek(symbol string) (shipStateEntry, uint64, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	entry, ok := c.entries[symbol]
+	if !ok {
+		return shipStateEntry{}, 0, false
+	}
+	return *entry, c.generation[symbol], true
+}
+
+// writeThrough re-stores a peeked entry with the confirmed mutation applied.
+// At most one invalidation may have happened since peek - the mutation's own
+// API call; anything more means another writer touched the ship and the
+// snapshot is no longer a safe base. The entry keeps its original fetchedAt,
+// so the TTL still bounds how long after the last live read it is served.
+func (c *shipStateCache) writeThrough(entry shipStateEntry, generation uint64, apply func(entry *shipStateEntry)) {
+	apply(&entry)
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	symbol := entry.data.Symbol
+	if c.ttl <= 0 || c.generation[symbol]-generation > 1 {
+		return
+	}
+	c.generation[symbol]++
+	c.epoch++
+	c.entries[symbol] = &entry
+}
+
+// invalidate drops a ship's entry and fences any in-flight read of it.
+func (c *shipStateCache) invalidate(symbol string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.generation[symbol]++
+	c.epoch++
+	delete(c.entries, symbol)
+}
@@ -79,7 +79,10 @@ func (c *WaypointConverter) convertWaypointFromMap(
 		wp.SystemSymbol = systemSymbol
 	}
 
-	wp.HasFuel = c.extractHasFuel(wpMap)
+	if c.extractHasFuel(wpMap) {
+		// Structure-only graph data can imply fuel but never verify it.
+		wp.ApplyCapabilities(shared.WaypointCapabilities{Fuel: shared.FuelAvailabilityImplied})
+	}
 
 	if orbitals, ok := wpMap["orbitals"].([]string); ok {
 		wp.Orbitals = orbitals
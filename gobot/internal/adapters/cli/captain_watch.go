@@ -14,6 +14,7 @@ import (
 
 	"github.com/andrescamacho/spacetraders-go/internal/adapters/persistence"
 	watchkeeper "github.com/andrescamacho/spacetraders-go/internal/captain"
+	"github.com/andrescamacho/spacetraders-go/internal/domain/navigation"
 	"github.com/andrescamacho/spacetraders-go/internal/infrastructure/config"
 	"github.com/andrescamacho/spacetraders-go/internal/infrastructure/database"
 )
@@ -157,11 +158,11 @@ func deriveETADeadlineForShip(ctx context.Context, navReader shipNavReader, ship
 		return time.Time{}, time.Time{}, false
 	}
 	inTransit, arrival, err := navReader.ShipNav(ctx, shipSymbol)
-	if err != nil || !inTransit || arrival == nil {
+	if err != nil || !inTransit {
 		return time.Time{}, time.Time{}, false
 	}
-	remaining := arrival.Sub(now)
-	if remaining <= 0 {
+	remaining, known := navigation.RemainingUntilArrival(arrival, now)
+	if !known || remaining <= 0 {
 		return time.Time{}, time.Time{}, false
 	}
 	margined := time.Duration(float64(remaining) * (1 + etaMargin))
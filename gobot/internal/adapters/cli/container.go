@@ -24,6 +24,9 @@ func NewContainerCommand() *cobra.Command {
 	cmd.AddCommand(newContainerLogsCommand())
 	cmd.AddCommand(newContainerGetCommand())
 	cmd.AddCommand(newContainerStopCommand())
+	cmd.AddCommand(newContainerPauseCommand())
+	cmd.AddCommand(newContainerResumeCommand())
+	cmd.AddCommand(newContainerLogLevelCommand())
 
 	return cmd
 }
@@ -38,7 +41,7 @@ func newContainerListCommand() *cobra.Command {
 		Short: "List all containers",
 		Long: `List all background containers with their status.
 
-By default, only active containers (RUNNING, INTERRUPTED) are shown.
+By default, only active containers (RUNNING, PAUSED, INTERRUPTED) are shown.
 Use --show-all to see containers in all states including completed and failed.`,
 		RunE: func(cmd *cobra.Command, args []string) error {
 			client, err := connectDaemon()
@@ -59,13 +62,13 @@ Use --show-all to see containers in all states including completed and failed.`,
 			var statusPtr *string
 			if showAll {
 				// Show all container statuses
-				allStatuses := "PENDING,RUNNING,COMPLETED,FAILED,STOPPING,STOPPED,INTERRUPTED"
+				allStatuses := "PENDING,RUNNING,PAUSED,COMPLETED,FAILED,STOPPING,STOPPED,INTERRUPTED"
 				statusPtr = &allStatuses
 			} else if status != "" {
 				// Use explicit status filter
 				statusPtr = &status
 			}
-			// else: nil = use daemon's default (RUNNING,INTERRUPTED)
+			// else: nil = use daemon's default (RUNNING,PAUSED,INTERRUPTED)
 
 			containers, err := client.ListContainers(ctx, playerIDPtr, statusPtr)
 			if err != nil {
@@ -202,6 +205,132 @@ Reads and mutates live daemon state, so the daemon must be running.`,
 	return cmd
 }
 
+// newContainerPauseCommand pauses a running container
+func newContainerPauseCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "pause <container-id>",
+		Short: "Pause a running container",
+		Long: `Pause a running container and its running child containers. Each one finishes
+the iteration in flight, keeps its ships assigned, and issues no new API calls
+until resumed. A coordinator that loops inside a single iteration gets a short
+grace period to wind down, after which its API calls wait for the resume.
+
+The pause is saved in the container's config, so it survives a daemon restart.
+Reads and mutates live daemon state, so the daemon must be running.
+
+Examples:
+  spacetraders container pause tour_coordinator-1-3f2a8c1d
+  spacetraders container resume tour_coordinator-1-3f2a8c1d`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			client, err := connectDaemon()
+			if err != nil {
+				return err
+			}
+			defer client.Close()
+
+			ctx, cancel := context.WithTimeout(context.Background(), 15*time.Second)
+			defer cancel()
+
+			result, err := client.PauseContainer(ctx, args[0])
+			if err != nil {
+				return fmt.Errorf("failed to pause container: %w", err)
+			}
+
+			fmt.Printf("✓ Container paused: %s\n", result.ContainerID)
+			printSwitchedChildren("paused", result.Children)
+			return nil
+		},
+	}
+
+	return cmd
+}
+
+// newContainerResumeCommand resumes a paused container
+func newContainerResumeCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "resume <container-id>",
+		Short: "Resume a paused container",
+		Long: `Resume a paused container and its paused child containers: the next
+iteration starts and API calls held by the pause go through.
+
+Reads and mutates live daemon state, so the daemon must be running.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			client, err := connectDaemon()
+			if err != nil {
+				return err
+			}
+			defer client.Close()
+
+			ctx, cancel := context.WithTimeout(context.Background(), 15*time.Second)
+			defer cancel()
+
+			result, err := client.ResumeContainer(ctx, args[0])
+			if err != nil {
+				return fmt.Errorf("failed to resume container: %w", err)
+			}
+
+			fmt.Printf("✓ Container resumed: %s\n", result.ContainerID)
+			printSwitchedChildren("resumed", result.Children)
+			return nil
+		},
+	}
+
+	return cmd
+}
+
+// printSwitchedChildren lists the child containers a pause or resume reached.
+func printSwitchedChildren(verb string, children []string) {
+	if len(children) == 0 {
+		return
+	}
+	fmt.Printf("  Also %s %d child container(s):\n", verb, len(children))
+	for _, id := range children {
+		fmt.Printf("    %s\n", id)
+	}
+}
+
+// newContainerLogLevelCommand changes a running container's log level
+func newContainerLogLevelCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "log-level <container-id> <level>",
+		Short: "Change a running container's log level",
+		Long: `Change the level a running container logs at: DEBUG, INFO, WARNING or ERROR.
+Lines below the level are not written. Containers log at DEBUG unless launched
+with a log_level in their config; turn noisy containers down to INFO and crank
+a single misbehaving coordinator back up to DEBUG.
+
+The new level is also saved in the container's config, so it survives a daemon
+restart. Reads and mutates live daemon state, so the daemon must be running.
+
+Examples:
+  spacetraders container log-level tanker_coordinator-1-3f2a8c1d debug
+  spacetraders container log-level tanker_coordinator-1-3f2a8c1d info`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			client, err := connectDaemon()
+			if err != nil {
+				return err
+			}
+			defer client.Close()
+
+			ctx, cancel := context.WithTimeout(context.Background(), 15*time.Second)
+			defer cancel()
+
+			result, err := client.SetContainerLogLevel(ctx, args[0], args[1])
+			if err != nil {
+				return fmt.Errorf("failed to set container log level: %w", err)
+			}
+
+			fmt.Printf("✓ Container %s now logs at %s (was %s)\n", result.ContainerID, result.Level, result.PreviousLevel)
+			return nil
+		},
+	}
+
+	return cmd
+}
+
 // newContainerLogsCommand retrieves container logs from database
 func newContainerLogsCommand() *cobra.Command {
 	var (
@@ -597,6 +597,7 @@ func runContractDemand(ctx context.Context, miner demandMinerProvider, out io.Wr
 func newContractStartCommand() *cobra.Command {
 	var (
 		dedicatedShipsCsv  string
+		dedicatedGroup     string
 		standbyStationsCsv string
 	)
 
@@ -622,13 +623,16 @@ for this contract coordinator. Pair with --standby-stations so an idle
 dedicated ship homes to the nearest standby waypoint instead of being
 balanced to a market. Both flags are optional; omitting them keeps the
 coordinator's original behavior (all idle haulers, no dedicated fleet).
+--dedicated-group adds every ship of a fleet group (see 'fleet group') to the
+dedicated fleet.
 
 Examples:
   spacetraders contract start --player-id 1
   spacetraders contract start --agent ENDURANCE
   spacetraders contract start --agent ENDURANCE \
     --dedicated-ships ENDURANCE-4,ENDURANCE-5,ENDURANCE-6 \
-    --standby-stations X1-TEST-J56,X1-TEST-E42,X1-TEST-H49,X1-TEST-B7`,
+    --standby-stations X1-TEST-J56,X1-TEST-E42,X1-TEST-H49,X1-TEST-B7
+  spacetraders contract start --agent ENDURANCE --dedicated-group haulers-alpha`,
 		RunE: func(cmd *cobra.Command, args []string) error {
 
 			// Resolve player from flags or defaults
@@ -639,6 +643,10 @@ Examples:
 
 			// Parse the optional dedicated-fleet CSV flags (sp-snmb). Both are
 			// nil when unset, keeping the coordinator's plain behavior intact.
+			dedicatedShipsCsv, err = resolveTaggedShipsCsv(context.Background(), dedicatedShipsCsv, dedicatedGroup)
+			if err != nil {
+				return err
+			}
 			dedicatedShips := parseCsvList(dedicatedShipsCsv)
 			standbyStations := parseCsvList(standbyStationsCsv)
 
@@ -678,6 +686,7 @@ Examples:
 	}
 
 	cmd.Flags().StringVar(&dedicatedShipsCsv, "dedicated-ships", "", "Comma-separated list of ship symbols reserved exclusively for this contract coordinator (optional)")
+	cmd.Flags().StringVar(&dedicatedGroup, "dedicated-group", "", "Also dedicate every ship in this fleet group (optional)")
 	cmd.Flags().StringVar(&standbyStationsCsv, "standby-stations", "", "Comma-separated list of waypoints an idle dedicated ship homes to (optional, requires --dedicated-ships)")
 
 	return cmd
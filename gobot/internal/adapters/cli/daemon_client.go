@@ -5,6 +5,8 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/andrescamacho/spacetraders-go/internal/adapters/grpcerrors"
+	"github.com/andrescamacho/spacetraders-go/internal/adapters/grpcpriority"
 	"github.com/andrescamacho/spacetraders-go/internal/application/common"
 	pb "github.com/andrescamacho/spacetraders-go/pkg/proto/daemon"
 	"google.golang.org/grpc"
@@ -140,6 +142,21 @@ type StopContainerResponse struct {
 	Message     string
 }
 
+// PauseResumeContainerResponse reports a container paused or resumed, with the
+// children switched along with it
+type PauseResumeContainerResponse struct {
+	ContainerID string
+	Status      string
+	Children    []string
+}
+
+// SetContainerLogLevelResponse reports a container log level change
+type SetContainerLogLevelResponse struct {
+	ContainerID   string
+	PreviousLevel string
+	Level         string
+}
+
 type LogEntry struct {
 	Timestamp string
 	Level     string
@@ -151,6 +168,13 @@ type HealthResponse struct {
 	Status           string
 	Version          string
 	ActiveContainers int32
+	PausedContainers int32
+
+	// RuntimeTerminations counts containers stopped for exceeding their
+	// max_runtime since the daemon booted; RecentRuntimeTerminations lists
+	// the latest of them, oldest first.
+	RuntimeTerminations       int32
+	RecentRuntimeTerminations []*pb.RuntimeTermination
 }
 
 // NewDaemonClient creates a new daemon client
@@ -166,6 +190,7 @@ func NewDaemonClient(socketPath string) (*DaemonClient, error) {
 		"unix:"+socketPath,
 		grpc.WithTransportCredentials(insecure.NewCredentials()),
 		grpc.WithBlock(),
+		grpc.WithUnaryInterceptor(grpcerrors.UnaryClientInterceptor()),
 	)
 	if err != nil {
 		return nil, fmt.Errorf("failed to connect to daemon socket: %w", err)
@@ -195,6 +220,8 @@ func (c *DaemonClient) NavigateShip(
 	shipSymbol, destination string,
 	playerID int,
 	agentSymbol string,
+	idempotencyKey string,
+	fuelReservePercent *int,
 ) (*NavigateResponse, error) {
 	req := &pb.NavigateShipRequest{
 		ShipSymbol:  shipSymbol,
@@ -204,6 +231,13 @@ func (c *DaemonClient) NavigateShip(
 	if agentSymbol != "" {
 		req.AgentSymbol = &agentSymbol
 	}
+	if idempotencyKey != "" {
+		req.IdempotencyKey = &idempotencyKey
+	}
+	if fuelReservePercent != nil {
+		percent := int32(*fuelReservePercent)
+		req.FuelReservePercent = &percent
+	}
 
 	resp, err := c.client.NavigateShip(ctx, req)
 	if err != nil {
@@ -745,10 +779,12 @@ func (c *DaemonClient) AssignScoutingFleet(
 	systemSymbol string,
 	playerID int,
 	agentSymbol string,
+	freshnessSLA time.Duration,
 ) (*AssignScoutingFleetResponse, error) {
 	req := &pb.AssignScoutingFleetRequest{
-		SystemSymbol: systemSymbol,
-		PlayerId:     int32(playerID),
+		SystemSymbol:        systemSymbol,
+		PlayerId:            int32(playerID),
+		FreshnessSlaSeconds: int32(freshnessSLA / time.Second),
 	}
 	if agentSymbol != "" {
 		req.AgentSymbol = &agentSymbol
@@ -852,6 +888,48 @@ func (c *DaemonClient) StopContainer(
 	}, nil
 }
 
+// PauseContainer pauses a running container and its running children
+func (c *DaemonClient) PauseContainer(ctx context.Context, containerID string) (*PauseResumeContainerResponse, error) {
+	resp, err := c.client.PauseContainer(ctx, &pb.PauseContainerRequest{ContainerId: containerID})
+	if err != nil {
+		return nil, fmt.Errorf(grpcCallFailed, err)
+	}
+	return &PauseResumeContainerResponse{
+		ContainerID: resp.ContainerId,
+		Status:      resp.Status,
+		Children:    resp.Children,
+	}, nil
+}
+
+// ResumeContainer resumes a paused container and its paused children
+func (c *DaemonClient) ResumeContainer(ctx context.Context, containerID string) (*PauseResumeContainerResponse, error) {
+	resp, err := c.client.ResumeContainer(ctx, &pb.ResumeContainerRequest{ContainerId: containerID})
+	if err != nil {
+		return nil, fmt.Errorf(grpcCallFailed, err)
+	}
+	return &PauseResumeContainerResponse{
+		ContainerID: resp.ContainerId,
+		Status:      resp.Status,
+		Children:    resp.Children,
+	}, nil
+}
+
+// SetContainerLogLevel changes a running container's log level
+func (c *DaemonClient) SetContainerLogLevel(ctx context.Context, containerID, level string) (*SetContainerLogLevelResponse, error) {
+	resp, err := c.client.SetContainerLogLevel(ctx, &pb.SetContainerLogLevelRequest{
+		ContainerId: containerID,
+		Level:       level,
+	})
+	if err != nil {
+		return nil, fmt.Errorf(grpcCallFailed, err)
+	}
+	return &SetContainerLogLevelResponse{
+		ContainerID:   resp.ContainerId,
+		PreviousLevel: resp.PreviousLevel,
+		Level:         resp.Level,
+	}, nil
+}
+
 // GetContainerLogs retrieves container logs
 func (c *DaemonClient) GetContainerLogs(
 	ctx context.Context,
@@ -901,6 +979,10 @@ func (c *DaemonClient) HealthCheck(ctx context.Context) (*HealthResponse, error)
 		Status:           resp.Status,
 		Version:          resp.Version,
 		ActiveContainers: resp.ActiveContainers,
+		PausedContainers: resp.PausedContainers,
+
+		RuntimeTerminations:       resp.RuntimeTerminations,
+		RecentRuntimeTerminations: resp.RecentRuntimeTerminations,
 	}, nil
 }
 
@@ -925,7 +1007,7 @@ func (c *DaemonClient) ListShips(ctx context.Context, playerID *int32, agentSymb
 		AgentSymbol: agentSymbol,
 	}
 
-	resp, err := c.client.ListShips(ctx, req)
+	resp, err := c.client.ListShips(grpcpriority.WithInteractive(ctx), req)
 	if err != nil {
 		return nil, fmt.Errorf(grpcCallFailed, err)
 	}
@@ -934,14 +1016,15 @@ func (c *DaemonClient) ListShips(ctx context.Context, playerID *int32, agentSymb
 }
 
 // GetShip gets detailed ship information
-func (c *DaemonClient) GetShip(ctx context.Context, shipSymbol string, playerID *int32, agentSymbol *string) (*pb.GetShipResponse, error) {
+func (c *DaemonClient) GetShip(ctx context.Context, shipSymbol string, playerID *int32, agentSymbol *string, noCache bool) (*pb.GetShipResponse, error) {
 	req := &pb.GetShipRequest{
 		ShipSymbol:  shipSymbol,
 		PlayerId:    playerID,
 		AgentSymbol: agentSymbol,
+		NoCache:     noCache,
 	}
 
-	resp, err := c.client.GetShip(ctx, req)
+	resp, err := c.client.GetShip(grpcpriority.WithInteractive(ctx), req)
 	if err != nil {
 		return nil, fmt.Errorf(grpcCallFailed, err)
 	}
@@ -965,6 +1048,27 @@ func (c *DaemonClient) RefreshShip(ctx context.Context, shipSymbol string, playe
 	return resp, nil
 }
 
+// RegisterAgent registers (or adopts) an agent through the daemon, which stores
+// the player and syncs its credits and fleet so it is operable immediately.
+// Unless skipStarterContract is set the daemon also accepts the agent's starter
+// contract and queues its workflow.
+func (c *DaemonClient) RegisterAgent(ctx context.Context, agentSymbol, faction, accountToken string, token *string, skipStarterContract bool) (*pb.RegisterAgentResponse, error) {
+	req := &pb.RegisterAgentRequest{
+		AgentSymbol:         agentSymbol,
+		Faction:             faction,
+		AccountToken:        accountToken,
+		Token:               token,
+		SkipStarterContract: skipStarterContract,
+	}
+
+	resp, err := c.client.RegisterAgent(ctx, req)
+	if err != nil {
+		return nil, fmt.Errorf(grpcCallFailed, err)
+	}
+
+	return resp, nil
+}
+
 // ReserveShip reserves a ship for the captain's direct manual use, hiding it
 // from every coordinator's assignment discovery (sp-i1ku). When force is true,
 // a coordinator's live claim is PREEMPTED — atomically revoked and transferred
@@ -1166,6 +1270,110 @@ func (c *DaemonClient) ListWaypoints(ctx context.Context, systemSymbol string, t
 	return resp, nil
 }
 
+// ExportMarketData exports a system's markets (and optional price-history window) as CSV or JSON
+func (c *DaemonClient) ExportMarketData(ctx context.Context, systemSymbol, format string, historyHours int32, playerID int, agentSymbol *string) (*pb.ExportMarketDataResponse, error) {
+	req := &pb.ExportMarketDataRequest{
+		SystemSymbol: systemSymbol,
+		Format:       format,
+		HistoryHours: historyHours,
+		PlayerId:     int32(playerID),
+		AgentSymbol:  agentSymbol,
+	}
+
+	resp, err := c.client.ExportMarketData(ctx, req)
+	if err != nil {
+		return nil, fmt.Errorf(grpcCallFailed, err)
+	}
+
+	return resp, nil
+}
+
+// GetSupplyTransitionStats gets per (market, good) supply transition frequency and dwell times
+func (c *DaemonClient) GetSupplyTransitionStats(ctx context.Context, waypointSymbol, goodSymbol string, windowHours int32, playerID int, agentSymbol *string) (*pb.GetSupplyTransitionStatsResponse, error) {
+	req := &pb.GetSupplyTransitionStatsRequest{
+		WaypointSymbol: waypointSymbol,
+		GoodSymbol:     goodSymbol,
+		WindowHours:    windowHours,
+		PlayerId:       int32(playerID),
+		AgentSymbol:    agentSymbol,
+	}
+
+	resp, err := c.client.GetSupplyTransitionStats(ctx, req)
+	if err != nil {
+		return nil, fmt.Errorf(grpcCallFailed, err)
+	}
+
+	return resp, nil
+}
+
+// GetSystemOverview gets the fleet's ships, containers, markets, contract deliveries and lanes in one system
+func (c *DaemonClient) GetSystemOverview(ctx context.Context, systemSymbol string, maxLanes int32, playerID int, agentSymbol *string) (*pb.GetSystemOverviewResponse, error) {
+	req := &pb.GetSystemOverviewRequest{
+		SystemSymbol: systemSymbol,
+		MaxLanes:     maxLanes,
+		PlayerId:     int32(playerID),
+		AgentSymbol:  agentSymbol,
+	}
+
+	resp, err := c.client.GetSystemOverview(ctx, req)
+	if err != nil {
+		return nil, fmt.Errorf(grpcCallFailed, err)
+	}
+
+	return resp, nil
+}
+
+// WarmSystem launches a background warm-up of a system's waypoints, markets and shipyards
+func (c *DaemonClient) WarmSystem(ctx context.Context, systemSymbol string, marketMaxAgeSeconds int32, playerID int, agentSymbol *string) (*pb.WarmSystemResponse, error) {
+	req := &pb.WarmSystemRequest{
+		SystemSymbol:        systemSymbol,
+		MarketMaxAgeSeconds: marketMaxAgeSeconds,
+		PlayerId:            int32(playerID),
+		AgentSymbol:         agentSymbol,
+	}
+
+	resp, err := c.client.WarmSystem(ctx, req)
+	if err != nil {
+		return nil, fmt.Errorf(grpcCallFailed, err)
+	}
+
+	return resp, nil
+}
+
+// AddScheduledJob defines or redefines a recurring job
+func (c *DaemonClient) AddScheduledJob(ctx context.Context, req *pb.AddScheduledJobRequest) (*pb.ScheduledJob, error) {
+	resp, err := c.client.AddScheduledJob(ctx, req)
+	if err != nil {
+		return nil, fmt.Errorf(grpcCallFailed, err)
+	}
+	return resp.Job, nil
+}
+
+// ListScheduledJobs lists the recurring jobs with their run history
+func (c *DaemonClient) ListScheduledJobs(ctx context.Context, playerID int, agentSymbol *string) ([]*pb.ScheduledJob, error) {
+	resp, err := c.client.ListScheduledJobs(ctx, &pb.ListScheduledJobsRequest{
+		PlayerId:    int32(playerID),
+		AgentSymbol: agentSymbol,
+	})
+	if err != nil {
+		return nil, fmt.Errorf(grpcCallFailed, err)
+	}
+	return resp.Jobs, nil
+}
+
+// RemoveScheduledJob deletes a recurring job
+func (c *DaemonClient) RemoveScheduledJob(ctx context.Context, name string, playerID int, agentSymbol *string) error {
+	_, err := c.client.RemoveScheduledJob(ctx, &pb.RemoveScheduledJobRequest{
+		Name:        name,
+		PlayerId:    int32(playerID),
+		AgentSymbol: agentSymbol,
+	})
+	if err != nil {
+		return fmt.Errorf(grpcCallFailed, err)
+	}
+	return nil
+}
+
 // GetWaypoint gets the detail of a single waypoint
 func (c *DaemonClient) GetWaypoint(ctx context.Context, waypointSymbol string, playerID *int32, agentSymbol *string) (*pb.GetWaypointResponse, error) {
 	req := &pb.GetWaypointRequest{
@@ -1220,8 +1428,21 @@ func (c *DaemonClient) PurchaseShip(ctx context.Context, purchasingShipSymbol, s
 	return resp, nil
 }
 
+// BatchPurchaseOptions are the optional knobs of a batch purchase; zero
+// values leave them to the daemon.
+type BatchPurchaseOptions struct {
+	Loadout     string
+	MaxPrice    int
+	WaitForDip  bool
+	DipDeadline time.Time
+	DipPoll     time.Duration
+
+	// FailurePolicy is stop, best_effort or rollback; "" leaves the daemon's stop.
+	FailurePolicy string
+}
+
 // BatchPurchaseShips purchases multiple ships in batch
-func (c *DaemonClient) BatchPurchaseShips(ctx context.Context, purchasingShipSymbol, shipType string, quantity, maxBudget, playerID int, agentSymbol, shipyardWaypoint string) (*pb.BatchPurchaseShipsResponse, error) {
+func (c *DaemonClient) BatchPurchaseShips(ctx context.Context, purchasingShipSymbol, shipType string, quantity, maxBudget, playerID int, agentSymbol, shipyardWaypoint string, opts BatchPurchaseOptions) (*pb.BatchPurchaseShipsResponse, error) {
 	req := &pb.BatchPurchaseShipsRequest{
 		PurchasingShipSymbol: purchasingShipSymbol,
 		ShipType:             shipType,
@@ -1235,6 +1456,27 @@ func (c *DaemonClient) BatchPurchaseShips(ctx context.Context, purchasingShipSym
 	if shipyardWaypoint != "" {
 		req.ShipyardWaypoint = &shipyardWaypoint
 	}
+	if opts.Loadout != "" {
+		req.Loadout = &opts.Loadout
+	}
+	if opts.MaxPrice > 0 {
+		maxPrice := int32(opts.MaxPrice)
+		req.MaxPrice = &maxPrice
+	}
+	if opts.WaitForDip {
+		req.WaitForDip = &opts.WaitForDip
+	}
+	if !opts.DipDeadline.IsZero() {
+		deadline := opts.DipDeadline.UTC().Format(time.RFC3339)
+		req.DipDeadline = &deadline
+	}
+	if opts.DipPoll > 0 {
+		pollSecs := int32(opts.DipPoll / time.Second)
+		req.DipPollSecs = &pollSecs
+	}
+	if opts.FailurePolicy != "" {
+		req.FailurePolicy = &opts.FailurePolicy
+	}
 
 	resp, err := c.client.BatchPurchaseShips(ctx, req)
 	if err != nil {
@@ -1405,6 +1647,46 @@ func (c *DaemonClient) BootstrapCoordinator(ctx context.Context, playerID int, a
 	return resp.ContainerId, nil
 }
 
+// BootstrapFleet applies a fleet template: the daemon buys the profile's starter ships and
+// launches its containers in a one-shot container whose ID is returned. dryRun returns the plan
+// instead and spends nothing. purchasingShip may be empty to let the daemon pick an idle hull.
+func (c *DaemonClient) BootstrapFleet(ctx context.Context, playerID int, agentSymbol, profile, purchasingShip string, dryRun bool) (*pb.BootstrapFleetResponse, error) {
+	req := &pb.BootstrapFleetRequest{
+		PlayerId: int32(playerID),
+		Profile:  profile,
+		DryRun:   dryRun,
+	}
+	if agentSymbol != "" {
+		req.AgentSymbol = &agentSymbol
+	}
+	if purchasingShip != "" {
+		req.PurchasingShip = &purchasingShip
+	}
+	resp, err := c.client.BootstrapFleet(ctx, req)
+	if err != nil {
+		return nil, fmt.Errorf(grpcCallFailed, err)
+	}
+	return resp, nil
+}
+
+// LaunchOperationProfile launches one operation from a versioned parameter set and returns what
+// each target got. dryRun validates the profile and reports the launches without starting anything.
+func (c *DaemonClient) LaunchOperationProfile(ctx context.Context, playerID int, agentSymbol, profile string, dryRun bool) (*pb.LaunchOperationProfileResponse, error) {
+	req := &pb.LaunchOperationProfileRequest{
+		PlayerId: int32(playerID),
+		Profile:  profile,
+		DryRun:   dryRun,
+	}
+	if agentSymbol != "" {
+		req.AgentSymbol = &agentSymbol
+	}
+	resp, err := c.client.LaunchOperationProfile(ctx, req)
+	if err != nil {
+		return nil, fmt.Errorf(grpcCallFailed, err)
+	}
+	return resp, nil
+}
+
 // WorkerRebalancerCoordinator starts the standing worker-rebalancer coordinator (sp-f5pr).
 // dryRun decides + logs the ferry it would dispatch but ferries nothing.
 func (c *DaemonClient) WorkerRebalancerCoordinator(ctx context.Context, playerID int, agentSymbol string, dryRun bool) (string, error) {
@@ -1480,6 +1762,67 @@ func (c *DaemonClient) ShipyardBackfillCoordinator(ctx context.Context, playerID
 	return resp.ContainerId, nil
 }
 
+// ProbeParkingCoordinatorParams carries the launch knobs for the probe-parking
+// coordinator. All are optional; a 0 value uses the coordinator's default.
+type ProbeParkingCoordinatorParams struct {
+	TickIntervalSecs  int
+	ScanIntervalSecs  int
+	ReserveIdleProbes int
+}
+
+// ProbeParkingCoordinator starts the standing probe-parking coordinator.
+func (c *DaemonClient) ProbeParkingCoordinator(ctx context.Context, playerID int, agentSymbol string, p ProbeParkingCoordinatorParams) (string, error) {
+	req := &pb.ProbeParkingCoordinatorRequest{
+		PlayerId:          int32(playerID),
+		TickIntervalSecs:  int32(p.TickIntervalSecs),
+		ScanIntervalSecs:  int32(p.ScanIntervalSecs),
+		ReserveIdleProbes: int32(p.ReserveIdleProbes),
+	}
+	if agentSymbol != "" {
+		req.AgentSymbol = &agentSymbol
+	}
+	resp, err := c.client.ProbeParkingCoordinator(ctx, req)
+	if err != nil {
+		return "", fmt.Errorf(grpcCallFailed, err)
+	}
+	return resp.ContainerId, nil
+}
+
+// TankerCoordinatorParams carries the launch knobs for the tanker coordinator.
+// Tankers come from TankerShips and/or TankerShipTag; the rest are optional and a
+// 0 or empty value uses the coordinator's default.
+type TankerCoordinatorParams struct {
+	TankerShips      []string
+	TankerShipTag    string
+	Stations         []string
+	FuelSource       string
+	RefuelBelowPct   int
+	ReloadBelowUnits int
+	TickIntervalSecs int
+}
+
+// TankerCoordinator starts the standing tanker coordinator.
+func (c *DaemonClient) TankerCoordinator(ctx context.Context, playerID int, agentSymbol string, p TankerCoordinatorParams) (string, error) {
+	req := &pb.TankerCoordinatorRequest{
+		PlayerId:         int32(playerID),
+		TankerShips:      p.TankerShips,
+		TankerShipTag:    p.TankerShipTag,
+		Stations:         p.Stations,
+		FuelSource:       p.FuelSource,
+		RefuelBelowPct:   int32(p.RefuelBelowPct),
+		ReloadBelowUnits: int32(p.ReloadBelowUnits),
+		TickIntervalSecs: int32(p.TickIntervalSecs),
+	}
+	if agentSymbol != "" {
+		req.AgentSymbol = &agentSymbol
+	}
+	resp, err := c.client.TankerCoordinator(ctx, req)
+	if err != nil {
+		return "", fmt.Errorf(grpcCallFailed, err)
+	}
+	return resp.ContainerId, nil
+}
+
 // AddScoutPost adds or updates a desired-state scout post (sp-cxpq). hulls is the
 // probe budget N (sp-enry); 0 defaults to single-hull.
 func (c *DaemonClient) AddScoutPost(ctx context.Context, playerID int, agentSymbol, systemSymbol string, freshnessSeconds int, kind string, hulls int) (*ScoutPost, error) {
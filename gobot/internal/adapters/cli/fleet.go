@@ -53,7 +53,8 @@ Examples:
   spacetraders fleet remove --operation contract --ship TORWIND-1
   spacetraders fleet assign --ship TORWIND-19 --fleet bulk_circuit
   spacetraders fleet unassign --ship TORWIND-19
-  spacetraders fleet list`,
+  spacetraders fleet list
+  spacetraders fleet group assign --group mining-alpha --fleet contract`,
 	}
 
 	cmd.AddCommand(newFleetAddCommand())
@@ -62,6 +63,7 @@ Examples:
 	cmd.AddCommand(newFleetUnassignCommand())
 	cmd.AddCommand(newFleetListCommand())
 	cmd.AddCommand(newFleetHubCommand())
+	cmd.AddCommand(newFleetGroupCommand())
 
 	return cmd
 }
@@ -0,0 +1,244 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/andrescamacho/spacetraders-go/internal/domain/navigation"
+	pb "github.com/andrescamacho/spacetraders-go/pkg/proto/daemon"
+)
+
+// fleetGroupOperator is the subset of daemon operations the `fleet group` verbs
+// fan out over a group's members. *DaemonClient satisfies it; tests substitute
+// a recorder.
+type fleetGroupOperator interface {
+	AssignShipFleet(ctx context.Context, shipSymbol, fleet string, playerID *int32, agentSymbol *string) (*pb.AssignShipFleetResponse, error)
+	UnassignShipFleet(ctx context.Context, shipSymbol string, playerID *int32, agentSymbol *string) (*pb.UnassignShipFleetResponse, error)
+	NavigateShip(ctx context.Context, shipSymbol, destination string, playerID int, agentSymbol string, idempotencyKey string, fuelReservePercent *int) (*NavigateResponse, error)
+}
+
+// newFleetGroupCommand creates the fleet group subcommand group
+func newFleetGroupCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "group",
+		Short: "Operate on a named group of ships as one unit",
+		Long: `Operate on a named group of ships as one unit.
+
+A fleet group is the set of ships wearing a ship tag: build "mining-alpha"
+with 'ship tag add --tag mining-alpha' and every verb below applies to all of
+its members. Membership is persisted with the tags, so a group survives
+daemon restarts and is read fresh on every command.
+
+Coordinator commands that take a ship list also take a group
+(e.g. 'contract start --dedicated-group', 'workflow scout-markets --group');
+coordinators that discover their own ships pick a group up through
+'fleet group assign'.
+
+Each verb is applied ship by ship: a failure on one member is reported and
+the rest still run.
+
+Examples:
+  spacetraders fleet group show --group mining-alpha
+  spacetraders fleet group assign --group mining-alpha --fleet contract
+  spacetraders fleet group reposition --group mining-alpha --waypoint X1-TEST-B7
+  spacetraders fleet group release --group mining-alpha`,
+	}
+
+	cmd.AddCommand(newFleetGroupShowCommand())
+	cmd.AddCommand(newFleetGroupAssignCommand())
+	cmd.AddCommand(newFleetGroupRepositionCommand())
+	cmd.AddCommand(newFleetGroupReleaseCommand())
+
+	return cmd
+}
+
+// loadFleetGroup resolves a group's members from the ship tag store
+func loadFleetGroup(ctx context.Context, name string) (*navigation.FleetGroup, error) {
+	tags, playerID, err := openShipTagStore(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return navigation.LoadFleetGroup(ctx, tags, playerID, name)
+}
+
+// applyToFleetGroup runs op on every member of group, collecting one output line
+// per ship. It keeps going past failures and returns an error naming how many
+// members failed, alongside the output for all of them.
+func applyToFleetGroup(group *navigation.FleetGroup, op func(shipSymbol string) (string, error)) (string, error) {
+	var out strings.Builder
+	failed := 0
+	for _, ship := range group.Ships {
+		line, err := op(ship)
+		if err != nil {
+			failed++
+			fmt.Fprintf(&out, "✗ %s: %v\n", ship, err)
+			continue
+		}
+		fmt.Fprintf(&out, "✓ %s: %s\n", ship, line)
+	}
+	if failed > 0 {
+		return out.String(), fmt.Errorf("%d of %d ships in group %q failed", failed, len(group.Ships), group.Name)
+	}
+	return out.String(), nil
+}
+
+// runFleetGroupAssign dedicates every member of group to fleet, handing the whole
+// group to that fleet's coordinator. Busy members are not interrupted; the fleet
+// takes each over when its current claim is released.
+func runFleetGroupAssign(ctx context.Context, client fleetGroupOperator, group *navigation.FleetGroup, fleet string, playerIdent *PlayerIdentifier) (string, error) {
+	playerID, agentSymbol := playerPointers(playerIdent)
+	return applyToFleetGroup(group, func(ship string) (string, error) {
+		resp, err := client.AssignShipFleet(ctx, ship, fleet, playerID, agentSymbol)
+		if err != nil {
+			return "", err
+		}
+		return fmt.Sprintf("dedicated to fleet %q", resp.Fleet), nil
+	})
+}
+
+// runFleetGroupRelease clears every member's fleet dedication, returning the
+// group to the general pool. Members finish any in-progress job first.
+func runFleetGroupRelease(ctx context.Context, client fleetGroupOperator, group *navigation.FleetGroup, playerIdent *PlayerIdentifier) (string, error) {
+	playerID, agentSymbol := playerPointers(playerIdent)
+	return applyToFleetGroup(group, func(ship string) (string, error) {
+		if _, err := client.UnassignShipFleet(ctx, ship, playerID, agentSymbol); err != nil {
+			return "", err
+		}
+		return "released to the general pool", nil
+	})
+}
+
+// runFleetGroupReposition sends every member of group to waypoint, one navigate
+// container per ship.
+func runFleetGroupReposition(ctx context.Context, client fleetGroupOperator, group *navigation.FleetGroup, waypoint string, playerIdent *PlayerIdentifier) (string, error) {
+	return applyToFleetGroup(group, func(ship string) (string, error) {
+		resp, err := client.NavigateShip(ctx, ship, waypoint, playerIdent.PlayerID, playerIdent.AgentSymbol, "", nil)
+		if err != nil {
+			return "", err
+		}
+		return fmt.Sprintf("navigating to %s (container %s)", resp.Destination, resp.ContainerID), nil
+	})
+}
+
+// newFleetGroupShowCommand creates the fleet group show subcommand
+func newFleetGroupShowCommand() *cobra.Command {
+	var name string
+
+	cmd := &cobra.Command{
+		Use:   "show",
+		Short: "List a group's ships",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			group, err := loadFleetGroup(context.Background(), name)
+			if err != nil {
+				return err
+			}
+			fmt.Printf("Fleet group %q (%d ships)\n", group.Name, len(group.Ships))
+			for _, ship := range group.Ships {
+				fmt.Printf("  %s\n", ship)
+			}
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVar(&name, "group", "", "Group name (required)")
+
+	return cmd
+}
+
+// newFleetGroupAssignCommand creates the fleet group assign subcommand
+func newFleetGroupAssignCommand() *cobra.Command {
+	var name, fleet string
+
+	cmd := &cobra.Command{
+		Use:   "assign",
+		Short: "Dedicate every ship in a group to a coordinator's fleet",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if fleet == "" {
+				return fmt.Errorf("--fleet flag is required (use 'fleet group release' to clear a dedication)")
+			}
+			return runFleetGroupVerb(name, func(ctx context.Context, client fleetGroupOperator, group *navigation.FleetGroup, playerIdent *PlayerIdentifier) (string, error) {
+				return runFleetGroupAssign(ctx, client, group, fleet, playerIdent)
+			})
+		},
+	}
+
+	cmd.Flags().StringVar(&name, "group", "", "Group name (required)")
+	cmd.Flags().StringVar(&fleet, "fleet", "", "Fleet to dedicate the group to, e.g. contract or trade (required)")
+
+	return cmd
+}
+
+// newFleetGroupRepositionCommand creates the fleet group reposition subcommand
+func newFleetGroupRepositionCommand() *cobra.Command {
+	var name, waypoint string
+
+	cmd := &cobra.Command{
+		Use:   "reposition",
+		Short: "Send every ship in a group to a waypoint",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if waypoint == "" {
+				return fmt.Errorf("--waypoint flag is required")
+			}
+			return runFleetGroupVerb(name, func(ctx context.Context, client fleetGroupOperator, group *navigation.FleetGroup, playerIdent *PlayerIdentifier) (string, error) {
+				return runFleetGroupReposition(ctx, client, group, strings.ToUpper(waypoint), playerIdent)
+			})
+		},
+	}
+
+	cmd.Flags().StringVar(&name, "group", "", "Group name (required)")
+	cmd.Flags().StringVar(&waypoint, "waypoint", "", "Destination waypoint (required)")
+
+	return cmd
+}
+
+// newFleetGroupReleaseCommand creates the fleet group release subcommand
+func newFleetGroupReleaseCommand() *cobra.Command {
+	var name string
+
+	cmd := &cobra.Command{
+		Use:   "release",
+		Short: "Return every ship in a group to the general pool",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runFleetGroupVerb(name, runFleetGroupRelease)
+		},
+	}
+
+	cmd.Flags().StringVar(&name, "group", "", "Group name (required)")
+
+	return cmd
+}
+
+// runFleetGroupVerb loads the group, connects to the daemon and prints the
+// per-ship outcome of verb.
+func runFleetGroupVerb(name string, verb func(ctx context.Context, client fleetGroupOperator, group *navigation.FleetGroup, playerIdent *PlayerIdentifier) (string, error)) error {
+	if name == "" {
+		return fmt.Errorf("--group flag is required")
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 60*time.Second)
+	defer cancel()
+
+	group, err := loadFleetGroup(ctx, name)
+	if err != nil {
+		return err
+	}
+
+	playerIdent, err := resolvePlayerIdentifier()
+	if err != nil {
+		return err
+	}
+
+	client, err := connectDaemon()
+	if err != nil {
+		return err
+	}
+	defer client.Close()
+
+	out, err := verb(ctx, client, group, playerIdent)
+	fmt.Print(out)
+	return err
+}
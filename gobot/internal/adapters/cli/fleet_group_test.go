@@ -0,0 +1,79 @@
+package cli
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/andrescamacho/spacetraders-go/internal/domain/navigation"
+	pb "github.com/andrescamacho/spacetraders-go/pkg/proto/daemon"
+)
+
+// fakeFleetGroupOperator records the per-ship calls a group verb fans out and
+// fails the ships listed in failFor.
+type fakeFleetGroupOperator struct {
+	assigned   []assignCall
+	unassigned []string
+	navigated  map[string]string
+	failFor    map[string]bool
+}
+
+func (f *fakeFleetGroupOperator) AssignShipFleet(_ context.Context, shipSymbol, fleet string, _ *int32, _ *string) (*pb.AssignShipFleetResponse, error) {
+	if f.failFor[shipSymbol] {
+		return nil, errors.New("daemon unavailable")
+	}
+	f.assigned = append(f.assigned, assignCall{ship: shipSymbol, fleet: fleet})
+	return &pb.AssignShipFleetResponse{ShipSymbol: shipSymbol, Fleet: fleet}, nil
+}
+
+func (f *fakeFleetGroupOperator) UnassignShipFleet(_ context.Context, shipSymbol string, _ *int32, _ *string) (*pb.UnassignShipFleetResponse, error) {
+	f.unassigned = append(f.unassigned, shipSymbol)
+	return &pb.UnassignShipFleetResponse{ShipSymbol: shipSymbol}, nil
+}
+
+func (f *fakeFleetGroupOperator) NavigateShip(_ context.Context, shipSymbol, destination string, _ int, _ string, _ string, _ *int) (*NavigateResponse, error) {
+	if f.navigated == nil {
+		f.navigated = make(map[string]string)
+	}
+	f.navigated[shipSymbol] = destination
+	return &NavigateResponse{ContainerID: "nav-" + shipSymbol, ShipSymbol: shipSymbol, Destination: destination}, nil
+}
+
+func testFleetGroup() *navigation.FleetGroup {
+	return &navigation.FleetGroup{Name: "mining-alpha", Ships: []string{"AGENT-4", "AGENT-5"}}
+}
+
+func TestFleetGroupAssign_DedicatesEveryMember(t *testing.T) {
+	client := &fakeFleetGroupOperator{}
+
+	_, err := runFleetGroupAssign(context.Background(), client, testFleetGroup(), "contract", &PlayerIdentifier{PlayerID: 1})
+	require.NoError(t, err)
+	require.Equal(t, []assignCall{{ship: "AGENT-4", fleet: "contract"}, {ship: "AGENT-5", fleet: "contract"}}, client.assigned)
+}
+
+// One member failing is reported without stopping the rest of the group.
+func TestFleetGroupAssign_ContinuesPastAFailedMember(t *testing.T) {
+	client := &fakeFleetGroupOperator{failFor: map[string]bool{"AGENT-4": true}}
+
+	out, err := runFleetGroupAssign(context.Background(), client, testFleetGroup(), "contract", &PlayerIdentifier{PlayerID: 1})
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "1 of 2 ships")
+	require.Equal(t, []assignCall{{ship: "AGENT-5", fleet: "contract"}}, client.assigned)
+	require.True(t, strings.Contains(out, "✗ AGENT-4") && strings.Contains(out, "✓ AGENT-5"), out)
+}
+
+func TestFleetGroupReleaseAndReposition(t *testing.T) {
+	client := &fakeFleetGroupOperator{}
+	player := &PlayerIdentifier{PlayerID: 1}
+
+	_, err := runFleetGroupRelease(context.Background(), client, testFleetGroup(), player)
+	require.NoError(t, err)
+	require.Equal(t, []string{"AGENT-4", "AGENT-5"}, client.unassigned)
+
+	_, err = runFleetGroupReposition(context.Background(), client, testFleetGroup(), "X1-TEST-B7", player)
+	require.NoError(t, err)
+	require.Equal(t, map[string]string{"AGENT-4": "X1-TEST-B7", "AGENT-5": "X1-TEST-B7"}, client.navigated)
+}
@@ -37,6 +37,12 @@ func NewHealthCommand() *cobra.Command {
 			fmt.Printf("  Status:            %s\n", health.Status)
 			fmt.Printf("  Version:           %s\n", health.Version)
 			fmt.Printf("  Active Containers: %d\n", health.ActiveContainers)
+			fmt.Printf("  Paused Containers: %d\n", health.PausedContainers)
+			fmt.Printf("  Runtime Stops:     %d\n", health.RuntimeTerminations)
+			for _, t := range health.RecentRuntimeTerminations {
+				fmt.Printf("    %s  %s (%s) after %s\n", t.GetTerminatedAt(), t.GetContainerId(), t.GetContainerType(),
+					time.Duration(t.GetRuntimeSeconds())*time.Second)
+			}
 
 			if showAPIBudget {
 				budget, err := client.GetAPIBudget(ctx)
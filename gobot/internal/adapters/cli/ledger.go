@@ -70,16 +70,21 @@ Categories (derived deterministically from transaction type):
                       not just standalone arbitrage trades
   TRADING_COSTS     - Cost of ANY cargo purchase (PURCHASE_CARGO): factory inputs,
                       tour/trade buys, construction supply — not just standalone trades
-  SHIP_INVESTMENTS  - Expenses from purchasing ships
+  SHIP_INVESTMENTS  - Expenses from purchasing ships (and credits recovered by scrapping them)
   CONTRACT_REVENUE  - Income from contracts
+  BALANCE_ADJUSTMENTS - Credit changes the ledger missed, booked by credit reconciliation
+  MAINTENANCE_COSTS - Shipyard repairs of worn ships
 
 Transaction Types:
   REFUEL              - Ship refueling
   PURCHASE_CARGO      - Cargo purchase
   SELL_CARGO          - Cargo sale
   PURCHASE_SHIP       - Ship purchase
+  SCRAP_SHIP          - Ship scrapped for credits
   CONTRACT_ACCEPTED   - Contract acceptance payment
   CONTRACT_FULFILLED  - Contract fulfillment payment
+  BALANCE_ADJUSTMENT  - Reconciliation to the API's agent credits
+  REPAIR_SHIP         - Shipyard repair of a worn ship
 
 Examples:
   spacetraders ledger list --player-id 1 --limit 10
@@ -111,14 +116,22 @@ func newLedgerReportCommand() *cobra.Command {
 
 Reports analyze transactions over a specified date range to provide
 financial insights including revenue, expenses, and net profit.
+balance-sheet and income-statement report from double-entry books
+built from the same transactions, and audit them for capture bugs.
 
 Examples:
   spacetraders ledger report profit-loss --start-date 2024-01-01 --end-date 2024-01-31
-  spacetraders ledger report cash-flow --start-date 2024-01-15 --end-date 2024-01-22`,
+  spacetraders ledger report cash-flow --start-date 2024-01-15 --end-date 2024-01-22
+  spacetraders ledger report by-operation --start-date 2024-01-15 --end-date 2024-01-22
+  spacetraders ledger report balance-sheet --as-of 2024-01-31
+  spacetraders ledger report income-statement --start-date 2024-01-01 --end-date 2024-01-31`,
 	}
 
 	cmd.AddCommand(newLedgerProfitLossCommand())
+	cmd.AddCommand(newLedgerProfitLossByOperationCommand())
 	cmd.AddCommand(newLedgerCashFlowCommand())
+	cmd.AddCommand(newLedgerBalanceSheetCommand())
+	cmd.AddCommand(newLedgerIncomeStatementCommand())
 
 	return cmd
 }
@@ -156,6 +169,45 @@ Example:
 	return cmd
 }
 
+// newLedgerProfitLossByOperationCommand creates the per-operation P&L subcommand
+func newLedgerProfitLossByOperationCommand() *cobra.Command {
+	var (
+		startDate     string
+		endDate       string
+		operationType string
+		containers    bool
+	)
+
+	cmd := &cobra.Command{
+		Use:   "by-operation",
+		Short: "Generate profit & loss per operation and container",
+		Long: `Generate a P&L statement broken down by the operation that generated each
+transaction (contract, arbitrage, mining, ...), most profitable first.
+
+With --containers each operation is further split by the container that
+recorded the cashflow. Transactions recorded outside any container appear
+under an empty container id; rows predating attribution appear under
+"unattributed".
+
+Example:
+  spacetraders ledger report by-operation --start-date 2024-01-01 --end-date 2024-01-31
+  spacetraders ledger report by-operation --start-date 2024-01-01 --end-date 2024-01-31 \
+    --operation arbitrage --containers`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runProfitLossByOperation(playerID, startDate, endDate, operationType, containers)
+		},
+	}
+
+	cmd.Flags().StringVar(&startDate, "start-date", "", "Start date (YYYY-MM-DD) [required]")
+	cmd.Flags().StringVar(&endDate, "end-date", "", "End date (YYYY-MM-DD) [required]")
+	cmd.Flags().StringVar(&operationType, "operation", "", "Restrict to one operation type")
+	cmd.Flags().BoolVar(&containers, "containers", false, "Break each operation down by container")
+	cmd.MarkFlagRequired("start-date")
+	cmd.MarkFlagRequired("end-date")
+
+	return cmd
+}
+
 // newLedgerCashFlowCommand creates the cash flow report subcommand
 func newLedgerCashFlowCommand() *cobra.Command {
 	var (
@@ -326,6 +378,55 @@ func runProfitLoss(playerID int, startDate, endDate string) error {
 	return nil
 }
 
+// runProfitLossByOperation executes the per-operation P&L report command
+func runProfitLossByOperation(playerID int, startDate, endDate, operationType string, containers bool) error {
+	start, err := time.Parse("2006-01-02", startDate)
+	if err != nil {
+		return fmt.Errorf("invalid start date format: %w", err)
+	}
+	end, err := time.Parse("2006-01-02", endDate)
+	if err != nil {
+		return fmt.Errorf("invalid end date format: %w", err)
+	}
+	// Set to end of day
+	end = end.Add(23*time.Hour + 59*time.Minute + 59*time.Second)
+
+	cfg, err := config.LoadConfig("")
+	if err != nil {
+		return fmt.Errorf("failed to load config: %w", err)
+	}
+
+	db, err := database.NewConnection(&cfg.Database)
+	if err != nil {
+		return fmt.Errorf("failed to connect to database: %w", err)
+	}
+
+	transactionRepo := persistence.NewGormTransactionRepository(db)
+	playerRepo := persistence.NewGormPlayerRepository(db)
+	handler := queries.NewGetProfitLossByOperationHandler(transactionRepo)
+
+	ctx := context.Background()
+	resolvedPlayer, err := resolveDefaultPlayer(ctx, playerRepo)
+	if err != nil {
+		return err
+	}
+	playerID = resolvedPlayer.ID.Value()
+
+	result, err := handler.Handle(ctx, &queries.GetProfitLossByOperationQuery{
+		PlayerID:      playerID,
+		StartDate:     start,
+		EndDate:       end,
+		OperationType: operationType,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to generate per-operation P&L report: %w", err)
+	}
+
+	displayProfitLossByOperation(result.(*queries.GetProfitLossByOperationResponse), containers)
+
+	return nil
+}
+
 // runCashFlow executes the cash flow report command
 func runCashFlow(playerID int, startDate, endDate, groupBy string) error {
 	// Parse dates
@@ -522,6 +623,30 @@ func displayProfitLoss(response *queries.GetProfitLossResponse) {
 	fmt.Println("─────────────────────────────────────────────────────────────────────────────")
 }
 
+// displayProfitLossByOperation formats and displays the per-operation P&L
+func displayProfitLossByOperation(response *queries.GetProfitLossByOperationResponse, containers bool) {
+	fmt.Printf("\nPROFIT & LOSS BY OPERATION\n")
+	fmt.Printf("Period: %s\n", response.Period)
+	fmt.Println("─────────────────────────────────────────────────────────────────────────────")
+	fmt.Printf("  %-25s %15s %15s %15s %6s\n", "OPERATION", "REVENUE", "EXPENSES", "NET", "TXNS")
+
+	for _, op := range response.Operations {
+		fmt.Printf("  %-25s %15s %15s %15s %6d\n", op.OperationType,
+			formatCredits(op.Revenue), formatCredits(-op.Expenses), formatAmount(op.NetProfit), op.TransactionCount)
+		if !containers {
+			continue
+		}
+		for _, c := range op.Containers {
+			fmt.Printf("    %-23s %15s %15s %15s %6d\n", orDash(c.ContainerID),
+				formatCredits(c.Revenue), formatCredits(-c.Expenses), formatAmount(c.NetProfit), c.TransactionCount)
+		}
+	}
+
+	fmt.Println("\n─────────────────────────────────────────────────────────────────────────────")
+	fmt.Printf("NET PROFIT:               %s\n", formatAmount(response.NetProfit))
+	fmt.Println("─────────────────────────────────────────────────────────────────────────────")
+}
+
 // displayCashFlow formats and displays cash flow report
 func displayCashFlow(response *queries.GetCashFlowResponse) {
 	fmt.Printf("\nCASH FLOW STATEMENT (By Category)\n")
@@ -0,0 +1,222 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/andrescamacho/spacetraders-go/internal/adapters/persistence"
+	"github.com/andrescamacho/spacetraders-go/internal/application/ledger/queries"
+	"github.com/andrescamacho/spacetraders-go/internal/domain/ledger"
+	"github.com/andrescamacho/spacetraders-go/internal/infrastructure/config"
+	"github.com/andrescamacho/spacetraders-go/internal/infrastructure/database"
+)
+
+// newLedgerBalanceSheetCommand creates the double-entry balance sheet subcommand
+func newLedgerBalanceSheetCommand() *cobra.Command {
+	var asOf string
+
+	cmd := &cobra.Command{
+		Use:   "balance-sheet",
+		Short: "Generate a double-entry balance sheet and audit the ledger",
+		Long: `Generate a balance sheet from the double-entry books.
+
+The books are built on demand from the transaction log: each transaction
+posts a balanced journal entry across cash, inventory (cargo at cost),
+ships (at purchase price), equity, revenue and expense accounts.
+
+The report also audits the log. It fails the audit when a transaction's
+sign runs against its type, when a balance_before does not continue the
+previous balance_after (a missed transaction), or when the cash account
+does not end at the log's running balance. Each finding is listed.
+
+Example:
+  spacetraders ledger report balance-sheet --as-of 2024-01-31`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runBalanceSheet(asOf)
+		},
+	}
+
+	cmd.Flags().StringVar(&asOf, "as-of", "", "Report the position at the end of this date (YYYY-MM-DD, default now)")
+
+	return cmd
+}
+
+// newLedgerIncomeStatementCommand creates the double-entry income statement subcommand
+func newLedgerIncomeStatementCommand() *cobra.Command {
+	var (
+		startDate string
+		endDate   string
+	)
+
+	cmd := &cobra.Command{
+		Use:   "income-statement",
+		Short: "Generate an accrual income statement from the double-entry books",
+		Long: `Generate an income statement from the double-entry books.
+
+Unlike profit-loss, which nets cash in against cash out, cargo bought for
+resale is held as inventory until sold and each sale is charged with the
+cost of the units sold; ship purchases are assets, and scrapping a ship
+books the gain or loss against its purchase price.
+
+Example:
+  spacetraders ledger report income-statement \
+    --start-date 2024-01-01 --end-date 2024-01-31`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runIncomeStatement(startDate, endDate)
+		},
+	}
+
+	cmd.Flags().StringVar(&startDate, "start-date", "", "Start date (YYYY-MM-DD) [required]")
+	cmd.Flags().StringVar(&endDate, "end-date", "", "End date (YYYY-MM-DD) [required]")
+	cmd.MarkFlagRequired("start-date")
+	cmd.MarkFlagRequired("end-date")
+
+	return cmd
+}
+
+// openLedgerTransactions connects to the database and resolves the effective
+// player for a ledger report
+func openLedgerTransactions(ctx context.Context) (ledger.TransactionRepository, int, error) {
+	cfg, err := config.LoadConfig("")
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to load config: %w", err)
+	}
+
+	db, err := database.NewConnection(&cfg.Database)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to connect to database: %w", err)
+	}
+
+	resolvedPlayer, err := resolveDefaultPlayer(ctx, persistence.NewGormPlayerRepository(db))
+	if err != nil {
+		return nil, 0, err
+	}
+	return persistence.NewGormTransactionRepository(db), resolvedPlayer.ID.Value(), nil
+}
+
+// runBalanceSheet executes the balance sheet report command
+func runBalanceSheet(asOf string) error {
+	end := time.Now()
+	if asOf != "" {
+		day, err := time.Parse("2006-01-02", asOf)
+		if err != nil {
+			return fmt.Errorf("invalid as-of date format: %w", err)
+		}
+		end = day.Add(23*time.Hour + 59*time.Minute + 59*time.Second)
+	}
+
+	ctx := context.Background()
+	transactionRepo, playerID, err := openLedgerTransactions(ctx)
+	if err != nil {
+		return err
+	}
+
+	result, err := queries.NewGetBalanceSheetHandler(transactionRepo).Handle(ctx, &queries.GetBalanceSheetQuery{
+		PlayerID: playerID,
+		AsOf:     end,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to generate balance sheet: %w", err)
+	}
+
+	displayBalanceSheet(os.Stdout, result.(*queries.GetBalanceSheetResponse))
+	return nil
+}
+
+// runIncomeStatement executes the income statement report command
+func runIncomeStatement(startDate, endDate string) error {
+	start, err := time.Parse("2006-01-02", startDate)
+	if err != nil {
+		return fmt.Errorf("invalid start date format: %w", err)
+	}
+	end, err := time.Parse("2006-01-02", endDate)
+	if err != nil {
+		return fmt.Errorf("invalid end date format: %w", err)
+	}
+	end = end.Add(23*time.Hour + 59*time.Minute + 59*time.Second)
+
+	ctx := context.Background()
+	transactionRepo, playerID, err := openLedgerTransactions(ctx)
+	if err != nil {
+		return err
+	}
+
+	result, err := queries.NewGetIncomeStatementHandler(transactionRepo).Handle(ctx, &queries.GetIncomeStatementQuery{
+		PlayerID:  playerID,
+		StartDate: start,
+		EndDate:   end,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to generate income statement: %w", err)
+	}
+
+	displayIncomeStatement(os.Stdout, result.(*queries.GetIncomeStatementResponse))
+	return nil
+}
+
+// displayBalanceSheet formats the balance sheet and the audit of the log
+func displayBalanceSheet(out io.Writer, response *queries.GetBalanceSheetResponse) {
+	sheet := response.Sheet
+	fmt.Fprintf(out, "\nBALANCE SHEET\n")
+	fmt.Fprintf(out, "As of: %s (%d transactions)\n", response.AsOf.Format("2006-01-02 15:04"), response.Transactions)
+	fmt.Fprintln(out, "─────────────────────────────────────────────────────────────────────────────")
+
+	fmt.Fprintln(out, "\nASSETS")
+	for _, line := range sheet.Assets {
+		fmt.Fprintf(out, "  %-25s %s\n", line.Account.String()+":", formatCredits(line.Balance))
+	}
+	fmt.Fprintf(out, "  %-25s %s\n", "Total Assets:", formatCredits(sheet.TotalAssets))
+
+	fmt.Fprintln(out, "\nEQUITY")
+	fmt.Fprintf(out, "  %-25s %s\n", "Opening Equity:", formatCredits(sheet.OpeningEquity))
+	fmt.Fprintf(out, "  %-25s %s\n", "Retained Earnings:", formatAmount(sheet.RetainedEarnings))
+	fmt.Fprintf(out, "  %-25s %s\n", "Total Equity:", formatCredits(sheet.TotalEquity))
+
+	fmt.Fprintln(out, "\n─────────────────────────────────────────────────────────────────────────────")
+	fmt.Fprintf(out, "Trial balance: debits %s, credits %s\n", formatCredits(response.Debits), formatCredits(response.Credits))
+	fmt.Fprintf(out, "Cash vs ledger balance: %s\n", formatAmount(response.CashDifference))
+	if response.Sound {
+		fmt.Fprintln(out, "✓ Books balance and reconcile")
+		return
+	}
+	fmt.Fprintf(out, "✗ Books do not reconcile: %d issue(s)\n", len(response.Issues))
+	for _, issue := range response.Issues {
+		fmt.Fprintf(out, "  %s  %-12s %s %s: %s\n", issue.Timestamp.Format("2006-01-02 15:04:05"), issue.Kind,
+			formatAmount(issue.Amount), issue.TransactionID.String(), issue.Detail)
+	}
+}
+
+// displayIncomeStatement formats the accrual income statement
+func displayIncomeStatement(out io.Writer, response *queries.GetIncomeStatementResponse) {
+	statement := response.Statement
+	fmt.Fprintf(out, "\nINCOME STATEMENT\n")
+	fmt.Fprintf(out, "Period: %s\n", response.Period)
+	fmt.Fprintln(out, "─────────────────────────────────────────────────────────────────────────────")
+
+	fmt.Fprintln(out, "\nREVENUE")
+	for _, line := range statement.Revenue {
+		if line.Balance != 0 {
+			fmt.Fprintf(out, "  %-25s %s\n", line.Account.String()+":", formatCredits(line.Balance))
+		}
+	}
+	fmt.Fprintf(out, "  %-25s %s\n", "Total Revenue:", formatCredits(statement.TotalRevenue))
+
+	fmt.Fprintln(out, "\nEXPENSES")
+	for _, line := range statement.Expenses {
+		if line.Balance != 0 {
+			fmt.Fprintf(out, "  %-25s %s\n", line.Account.String()+":", formatCredits(line.Balance))
+		}
+	}
+	fmt.Fprintf(out, "  %-25s %s\n", "Total Expenses:", formatCredits(statement.TotalExpenses))
+
+	fmt.Fprintln(out, "\n─────────────────────────────────────────────────────────────────────────────")
+	fmt.Fprintf(out, "NET INCOME:               %s\n", formatAmount(statement.NetIncome))
+	if !response.Sound {
+		fmt.Fprintf(out, "✗ The ledger has %d unreconciled issue(s); see 'ledger report balance-sheet'\n", response.IssueCount)
+	}
+}
@@ -10,6 +10,7 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"github.com/andrescamacho/spacetraders-go/internal/application/ledger/queries"
+	"github.com/andrescamacho/spacetraders-go/internal/domain/ledger"
 )
 
 // sampleTransactionsResponse returns a response with one fully-attributed trade
@@ -187,3 +188,26 @@ func TestMetaString(t *testing.T) {
 	require.Equal(t, "", metaString(m, "units"))         // non-string value
 	require.Equal(t, "", metaString(nil, "good_symbol")) // nil map
 }
+
+// An unreconciled balance sheet lists each issue the books found.
+func TestDisplayBalanceSheet_ListsIssuesWhenUnsound(t *testing.T) {
+	var buf bytes.Buffer
+	displayBalanceSheet(&buf, &queries.GetBalanceSheetResponse{
+		AsOf:           time.Date(2026, 7, 9, 23, 59, 0, 0, time.UTC),
+		Sheet:          ledger.BalanceSheet{TotalAssets: 800, OpeningEquity: 1000, RetainedEarnings: -200, TotalEquity: 800},
+		Debits:         1000,
+		Credits:        1000,
+		CashDifference: -200,
+		Issues: []ledger.BookIssue{{
+			Kind:   ledger.BookIssueBalanceGap,
+			Amount: -200,
+			Detail: "balance_before 700 does not continue the previous balance_after 900",
+		}},
+	})
+
+	out := buf.String()
+	require.Contains(t, out, "Books do not reconcile: 1 issue(s)")
+	require.Contains(t, out, "BALANCE_GAP")
+	require.Contains(t, out, "balance_before 700")
+	require.NotContains(t, out, "Books balance and reconcile")
+}
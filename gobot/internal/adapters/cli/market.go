@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"os"
 	"sort"
+	"strings"
 	"text/tabwriter"
 	"time"
 
@@ -41,6 +42,8 @@ Examples:
 	cmd.AddCommand(newMarketHistoryCommand())
 	cmd.AddCommand(newMarketFindCommand())
 	cmd.AddCommand(newMarketSpreadsCommand())
+	cmd.AddCommand(newMarketExportCommand())
+	cmd.AddCommand(newMarketSupplyStatsCommand())
 
 	return cmd
 }
@@ -862,3 +865,188 @@ Examples:
 
 	return cmd
 }
+
+// newMarketExportCommand creates the market export subcommand
+func newMarketExportCommand() *cobra.Command {
+	var (
+		systemSymbol string
+		format       string
+		historyHours int
+		outputPath   string
+	)
+
+	cmd := &cobra.Command{
+		Use:   "export",
+		Short: "Export a system's market data as CSV or JSON",
+		Long: `Export a snapshot of a system's cached markets through the daemon.
+
+Each row carries the good's trade type, supply, activity, prices and trade
+volume. With --history-hours the price changes recorded in that trailing window
+are appended (CSV rows are tagged snapshot/history; JSON has separate arrays).
+
+Examples:
+  spacetraders market export --system X1-GZ7 --agent ENDURANCE > markets.csv
+  spacetraders market export --system X1-GZ7 --format json --history-hours 48 --output markets.json`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if systemSymbol == "" {
+				return fmt.Errorf("--system flag is required")
+			}
+			if historyHours < 0 {
+				return fmt.Errorf("--history-hours must not be negative")
+			}
+
+			playerIdent, err := resolvePlayerIdentifier()
+			if err != nil {
+				return err
+			}
+
+			client, err := connectDaemon()
+			if err != nil {
+				return err
+			}
+			defer client.Close()
+
+			ctx, cancel := context.WithTimeout(context.Background(), 60*time.Second)
+			defer cancel()
+
+			var agentSymbol *string
+			if playerIdent.AgentSymbol != "" {
+				agentSymbol = &playerIdent.AgentSymbol
+			}
+
+			resp, err := client.ExportMarketData(ctx, systemSymbol, format, int32(historyHours), playerIdent.PlayerID, agentSymbol)
+			if err != nil {
+				return fmt.Errorf("failed to export market data: %w", err)
+			}
+
+			if outputPath == "" {
+				_, err = os.Stdout.Write(resp.Content)
+				return err
+			}
+			if err := os.WriteFile(outputPath, resp.Content, 0o644); err != nil {
+				return fmt.Errorf("failed to write %s: %w", outputPath, err)
+			}
+			fmt.Fprintf(os.Stderr, "Exported %d markets (%d snapshot rows, %d history rows) to %s\n",
+				resp.MarketCount, resp.SnapshotRows, resp.HistoryRows, outputPath)
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVar(&systemSymbol, "system", "", "System symbol (required)")
+	cmd.Flags().StringVar(&format, "format", "csv", "Export format: csv or json")
+	cmd.Flags().IntVar(&historyHours, "history-hours", 0, "Include price history from the last N hours (0 = snapshot only)")
+	cmd.Flags().StringVarP(&outputPath, "output", "o", "", "Write to this file instead of stdout")
+
+	return cmd
+}
+
+// supplyLevelOrder lists supply levels from scarcest to most abundant, the
+// order the supply-stats dwell column reads in.
+var supplyLevelOrder = []shared.SupplyLevel{
+	shared.SupplyLevelScarce,
+	shared.SupplyLevelLimited,
+	shared.SupplyLevelModerate,
+	shared.SupplyLevelHigh,
+	shared.SupplyLevelAbundant,
+}
+
+// formatSupplyDwell renders average dwell seconds per level, scarcest first,
+// e.g. "MODERATE 40m0s, HIGH 10m0s".
+func formatSupplyDwell(dwellSeconds map[string]int64) string {
+	var parts []string
+	for _, level := range supplyLevelOrder {
+		if seconds, ok := dwellSeconds[string(level)]; ok {
+			parts = append(parts, fmt.Sprintf("%s %s", level, time.Duration(seconds)*time.Second))
+		}
+	}
+	if len(parts) == 0 {
+		return "-"
+	}
+	return strings.Join(parts, ", ")
+}
+
+// newMarketSupplyStatsCommand creates the market supply-stats subcommand
+func newMarketSupplyStatsCommand() *cobra.Command {
+	var (
+		waypointSymbol string
+		goodSymbol     string
+		windowHours    int
+		jsonOut        bool
+	)
+
+	cmd := &cobra.Command{
+		Use:   "supply-stats",
+		Short: "Show how often market supply levels change and how long they hold",
+		Long: `Summarise the supply transitions the market scanner recorded, per market and good.
+
+For each (market, good) shows the number of level changes in the window, the
+rate per hour, the current level and the average time spent at each level.
+Use it to tune the supply monitor's poll interval and HIGH gating.
+
+Examples:
+  spacetraders market supply-stats --agent ENDURANCE
+  spacetraders market supply-stats --good FABRICS --window-hours 48`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if windowHours < 0 {
+				return fmt.Errorf("--window-hours must not be negative")
+			}
+
+			playerIdent, err := resolvePlayerIdentifier()
+			if err != nil {
+				return err
+			}
+
+			client, err := connectDaemon()
+			if err != nil {
+				return err
+			}
+			defer client.Close()
+
+			ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+			defer cancel()
+
+			var agentSymbol *string
+			if playerIdent.AgentSymbol != "" {
+				agentSymbol = &playerIdent.AgentSymbol
+			}
+
+			resp, err := client.GetSupplyTransitionStats(ctx, waypointSymbol, goodSymbol, int32(windowHours), playerIdent.PlayerID, agentSymbol)
+			if err != nil {
+				return fmt.Errorf("failed to get supply transition stats: %w", err)
+			}
+
+			if jsonOut {
+				encoder := json.NewEncoder(os.Stdout)
+				encoder.SetIndent("", "  ")
+				return encoder.Encode(resp)
+			}
+
+			if len(resp.Stats) == 0 {
+				fmt.Printf("No supply transitions recorded since %s\n", resp.Since)
+				return nil
+			}
+
+			fmt.Printf("Supply transitions since %s\n\n", resp.Since)
+			w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+			fmt.Fprintln(w, "MARKET\tGOOD\tCHANGES\tPER HOUR\tCURRENT\tAVG DWELL")
+			for _, stat := range resp.Stats {
+				fmt.Fprintf(w, "%s\t%s\t%d\t%.2f\t%s\t%s\n",
+					stat.WaypointSymbol,
+					stat.GoodSymbol,
+					stat.Transitions,
+					stat.TransitionsPerHour,
+					stat.CurrentSupply,
+					formatSupplyDwell(stat.AverageDwellSeconds),
+				)
+			}
+			return w.Flush()
+		},
+	}
+
+	cmd.Flags().StringVar(&waypointSymbol, "market", "", "Only this market waypoint")
+	cmd.Flags().StringVar(&goodSymbol, "good", "", "Only this good")
+	cmd.Flags().IntVar(&windowHours, "window-hours", 0, "Look back this many hours (0 = one week)")
+	cmd.Flags().BoolVar(&jsonOut, "json", false, "Output as JSON")
+
+	return cmd
+}
@@ -0,0 +1,13 @@
+package cli
+
+import "testing"
+
+func TestFormatSupplyDwell_ScarcestLevelFirst(t *testing.T) {
+	got := formatSupplyDwell(map[string]int64{"HIGH": 600, "MODERATE": 2400})
+	if want := "MODERATE 40m0s, HIGH 10m0s"; got != want {
+		t.Fatalf("formatSupplyDwell = %q, want %q", got, want)
+	}
+	if got := formatSupplyDwell(nil); got != "-" {
+		t.Fatalf("no complete stays should render -, got %q", got)
+	}
+}
@@ -56,6 +56,8 @@ func newOperationsStartCommand() *cobra.Command {
 		// Gas-specific flags
 		siphonsCsv string
 		storageCsv string
+		siphonTag  string
+		storageTag string
 		gasGiant   string
 		force      bool
 		maxLegTime int
@@ -75,6 +77,10 @@ Examples:
   spacetraders operations start --system X1-AU21 --gas \
     --siphons SIPHON-1,SIPHON-2 --storage STORAGE-1
 
+  # Siphons and storage selected by ship tag (see 'ship tag')
+  spacetraders operations start --system X1-AU21 --gas \
+    --siphon-tag gas-siphons --storage-tag gas-storage
+
   # Dry run to preview the operation
   spacetraders operations start --system X1-AU21 --gas --dry-run`,
 		RunE: func(cmd *cobra.Command, args []string) error {
@@ -90,11 +96,18 @@ Examples:
 
 			// Validate gas-specific requirements
 			if enableGas {
-				if siphonsCsv == "" {
-					return fmt.Errorf("--siphons flag is required when --gas is enabled")
+				if siphonsCsv == "" && siphonTag == "" {
+					return fmt.Errorf("--siphons or --siphon-tag is required when --gas is enabled")
+				}
+				if storageCsv == "" && storageTag == "" {
+					return fmt.Errorf("--storage or --storage-tag is required when --gas is enabled")
+				}
+				var err error
+				if siphonsCsv, err = resolveTaggedShipsCsv(context.Background(), siphonsCsv, siphonTag); err != nil {
+					return err
 				}
-				if storageCsv == "" {
-					return fmt.Errorf("--storage flag is required when --gas is enabled")
+				if storageCsv, err = resolveTaggedShipsCsv(context.Background(), storageCsv, storageTag); err != nil {
+					return err
 				}
 			}
 
@@ -168,6 +181,8 @@ Examples:
 	// Gas-specific flags
 	cmd.Flags().StringVar(&siphonsCsv, "siphons", "", "Comma-separated siphon ship symbols (required for gas)")
 	cmd.Flags().StringVar(&storageCsv, "storage", "", "Comma-separated storage ship symbols (required for gas)")
+	cmd.Flags().StringVar(&siphonTag, "siphon-tag", "", "Also siphon with every ship wearing this tag")
+	cmd.Flags().StringVar(&storageTag, "storage-tag", "", "Also buffer with every ship wearing this tag")
 	cmd.Flags().StringVar(&gasGiant, "gas-giant", "", "Gas giant waypoint (optional, auto-selects if not provided)")
 	cmd.Flags().BoolVar(&force, "force", false, "Override fuel validation warnings (gas)")
 	cmd.Flags().IntVar(&maxLegTime, "max-leg-time", 0, "Max time per leg in minutes (gas, 0 = no limit)")
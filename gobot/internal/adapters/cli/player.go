@@ -49,6 +49,8 @@ func newPlayerRegisterCommand() *cobra.Command {
 		token       string
 		faction     string
 		newAgent    bool
+		viaDaemon   bool
+		skipStarter bool
 	)
 
 	cmd := &cobra.Command{
@@ -62,9 +64,19 @@ to obtain your unique agent symbol and JWT token.
 The token will be stored securely in the local database and used for all
 API requests on behalf of this agent.
 
+With --daemon the running daemon stores the player and syncs its credits
+and ships, so the agent can be operated immediately without a restart. No
+era row is opened on this path. A fresh agent's starter contract is accepted
+and a contract workflow queued on its command ship; pass
+--skip-starter-contract to leave the contract for later.
+
 Example:
-  spacetraders player register --agent ENDURANCE --token eyJ... --faction COSMIC`,
+  spacetraders player register --agent ENDURANCE --token eyJ... --faction COSMIC
+  spacetraders player register --agent ENDURANCE --faction COSMIC --daemon`,
 		RunE: func(cmd *cobra.Command, args []string) error {
+			if viaDaemon {
+				return runPlayerRegisterViaDaemon(agentSymbol, faction, token, skipStarter)
+			}
 			if newAgent {
 				return runPlayerRegisterNewCommand(agentSymbol, faction)
 			}
@@ -96,10 +108,70 @@ Example:
 	cmd.Flags().StringVar(&token, "token", "", "SpaceTraders API JWT token (required unless --new)")
 	cmd.Flags().StringVar(&faction, "faction", "", "Starting faction (optional)")
 	cmd.Flags().BoolVar(&newAgent, "new", false, "Register a new agent via the API using ST_ACCOUNT_TOKEN and create its era row")
+	cmd.Flags().BoolVar(&viaDaemon, "daemon", false, "Onboard through the running daemon (registers via ST_ACCOUNT_TOKEN when --token is omitted)")
+	cmd.Flags().BoolVar(&skipStarter, "skip-starter-contract", false, "With --daemon, do not accept the starter contract or queue its workflow")
 
 	return cmd
 }
 
+// runPlayerRegisterViaDaemon onboards an agent through the daemon's
+// RegisterAgent RPC. An empty token registers a new agent with ST_ACCOUNT_TOKEN.
+func runPlayerRegisterViaDaemon(agentSymbol, faction, token string, skipStarterContract bool) error {
+	if agentSymbol == "" {
+		return fmt.Errorf("--agent flag is required")
+	}
+
+	var tokenPtr *string
+	accountToken := ""
+	if token != "" {
+		tokenPtr = &token
+	} else {
+		accountToken = os.Getenv("ST_ACCOUNT_TOKEN")
+		if accountToken == "" {
+			return fmt.Errorf("ST_ACCOUNT_TOKEN is required to register a new agent (or pass --token)")
+		}
+		if faction == "" {
+			return fmt.Errorf("--faction flag is required to register a new agent")
+		}
+	}
+
+	client, err := connectDaemon()
+	if err != nil {
+		return err
+	}
+	defer client.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 60*time.Second)
+	defer cancel()
+
+	resp, err := client.RegisterAgent(ctx, agentSymbol, faction, accountToken, tokenPtr, skipStarterContract)
+	if err != nil {
+		return fmt.Errorf("failed to register agent: %w", err)
+	}
+
+	if resp.Registered {
+		fmt.Printf("✓ Agent registered with the SpaceTraders API\n")
+	} else {
+		fmt.Printf("✓ Agent token adopted\n")
+	}
+	fmt.Printf("  Player ID:    %d\n", resp.PlayerId)
+	fmt.Printf("  Agent:        %s\n", resp.AgentSymbol)
+	fmt.Printf("  Faction:      %s\n", resp.Faction)
+	fmt.Printf("  Headquarters: %s\n", resp.Headquarters)
+	fmt.Printf("  Credits:      %d\n", resp.Credits)
+	fmt.Printf("  Ships synced: %d\n", resp.ShipsSynced)
+	if resp.StarterContractAccepted {
+		fmt.Printf("  Starter:      %s accepted\n", resp.StarterContractId)
+	}
+	if resp.ContractWorkflowContainerId != "" {
+		fmt.Printf("  Workflow:     %s\n", resp.ContractWorkflowContainerId)
+	}
+	if resp.StarterContractNote != "" {
+		fmt.Printf("  Note:         %s\n", resp.StarterContractNote)
+	}
+	return nil
+}
+
 // newPlayerListCommand creates the player list subcommand
 func newPlayerListCommand() *cobra.Command {
 	cmd := &cobra.Command{
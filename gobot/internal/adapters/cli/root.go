@@ -76,6 +76,7 @@ Examples:
 	rootCmd.AddCommand(NewHistoryCommand())
 	rootCmd.AddCommand(NewTourCommand())
 	rootCmd.AddCommand(NewTuneCommand())
+	rootCmd.AddCommand(NewScheduleCommand())
 	rootCmd.AddCommand(NewVersionCommand())
 	rootCmd.AddCommand(NewDocsCommand())
 
@@ -0,0 +1,258 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	pb "github.com/andrescamacho/spacetraders-go/pkg/proto/daemon"
+)
+
+// NewScheduleCommand creates the schedule command with subcommands
+func NewScheduleCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "schedule",
+		Short: "Manage the daemon's recurring jobs",
+		Long: `Manage the recurring jobs the daemon runs on a fixed interval.
+
+Jobs are persisted with their last run, next run and outcome, so the cadence
+survives daemon restarts. A job is not started again while its previous run is
+still going; for scout tours, that means while the tour's containers are still
+running. Jobs can also be defined under [scheduler] in config.yaml, which
+requires scheduler.enabled.
+
+Kinds and their params:
+  scout_markets     system, ships (comma-separated), markets (default all), iterations (default 1)
+  ledger_reconcile  none; compares API credits with the ledger and books the drift
+  market_export     system, path, format (csv|json, default csv), history_hours (default 0)`,
+	}
+
+	cmd.AddCommand(newScheduleAddCommand())
+	cmd.AddCommand(newScheduleListCommand())
+	cmd.AddCommand(newScheduleRemoveCommand())
+
+	return cmd
+}
+
+// newScheduleAddCommand creates the schedule add subcommand
+func newScheduleAddCommand() *cobra.Command {
+	var (
+		name     string
+		kind     string
+		every    time.Duration
+		params   []string
+		disabled bool
+	)
+
+	cmd := &cobra.Command{
+		Use:   "add",
+		Short: "Define a recurring job, or redefine one by name",
+		Long: `Define a recurring job. The first run is due at once.
+
+Adding a job under an existing name replaces its definition but keeps its run
+history; a changed --every reschedules the next run from the last one.
+
+Examples:
+  spacetraders schedule add --name scout-home --kind scout_markets --every 2h \
+    --param system=X1-AB12 --param ships=AGENT-2,AGENT-3
+  spacetraders schedule add --name nightly-reconcile --kind ledger_reconcile --every 24h
+  spacetraders schedule add --name hourly-export --kind market_export --every 1h \
+    --param system=X1-AB12 --param path=/var/lib/spacetraders/markets.csv`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if name == "" || kind == "" {
+				return fmt.Errorf("--name and --kind are required")
+			}
+			parsed, err := parseScheduleParams(params)
+			if err != nil {
+				return err
+			}
+
+			playerIdent, err := resolvePlayerIdentifier()
+			if err != nil {
+				return err
+			}
+			client, err := connectDaemon()
+			if err != nil {
+				return err
+			}
+			defer client.Close()
+
+			ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+			defer cancel()
+
+			req := &pb.AddScheduledJobRequest{
+				Name:            name,
+				Kind:            kind,
+				IntervalSeconds: int64(every / time.Second),
+				Params:          parsed,
+				Disabled:        disabled,
+				PlayerId:        int32(playerIdent.PlayerID),
+			}
+			if playerIdent.AgentSymbol != "" {
+				req.AgentSymbol = &playerIdent.AgentSymbol
+			}
+			job, err := client.AddScheduledJob(ctx, req)
+			if err != nil {
+				return fmt.Errorf("failed to add scheduled job: %w", err)
+			}
+
+			fmt.Printf("Scheduled %s (%s) every %s, next run %s\n", job.Name, job.Kind,
+				time.Duration(job.IntervalSeconds)*time.Second, job.NextRunAt)
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVar(&name, "name", "", "Job name (required)")
+	cmd.Flags().StringVar(&kind, "kind", "", "Job kind: scout_markets, ledger_reconcile or market_export (required)")
+	cmd.Flags().DurationVar(&every, "every", time.Hour, "Interval between runs (at least 1m)")
+	cmd.Flags().StringArrayVar(&params, "param", nil, "Job param as key=value (repeatable)")
+	cmd.Flags().BoolVar(&disabled, "disabled", false, "Keep the definition but do not run it")
+
+	return cmd
+}
+
+// newScheduleListCommand creates the schedule list subcommand
+func newScheduleListCommand() *cobra.Command {
+	return &cobra.Command{
+		Use:   "list",
+		Short: "List recurring jobs with their last and next runs",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			playerIdent, err := resolvePlayerIdentifier()
+			if err != nil {
+				return err
+			}
+			client, err := connectDaemon()
+			if err != nil {
+				return err
+			}
+			defer client.Close()
+
+			ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+			defer cancel()
+
+			var agentSymbol *string
+			if playerIdent.AgentSymbol != "" {
+				agentSymbol = &playerIdent.AgentSymbol
+			}
+			jobs, err := client.ListScheduledJobs(ctx, playerIdent.PlayerID, agentSymbol)
+			if err != nil {
+				return fmt.Errorf("failed to list scheduled jobs: %w", err)
+			}
+
+			displayScheduledJobs(os.Stdout, jobs)
+			return nil
+		},
+	}
+}
+
+// newScheduleRemoveCommand creates the schedule remove subcommand
+func newScheduleRemoveCommand() *cobra.Command {
+	var name string
+
+	cmd := &cobra.Command{
+		Use:   "remove",
+		Short: "Delete a recurring job",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if name == "" {
+				return fmt.Errorf("--name flag is required")
+			}
+
+			playerIdent, err := resolvePlayerIdentifier()
+			if err != nil {
+				return err
+			}
+			client, err := connectDaemon()
+			if err != nil {
+				return err
+			}
+			defer client.Close()
+
+			ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+			defer cancel()
+
+			var agentSymbol *string
+			if playerIdent.AgentSymbol != "" {
+				agentSymbol = &playerIdent.AgentSymbol
+			}
+			if err := client.RemoveScheduledJob(ctx, name, playerIdent.PlayerID, agentSymbol); err != nil {
+				return fmt.Errorf("failed to remove scheduled job: %w", err)
+			}
+
+			fmt.Printf("Removed scheduled job %s\n", name)
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVar(&name, "name", "", "Job name (required)")
+
+	return cmd
+}
+
+// parseScheduleParams turns repeated key=value flags into a param map
+func parseScheduleParams(pairs []string) (map[string]string, error) {
+	params := make(map[string]string, len(pairs))
+	for _, pair := range pairs {
+		key, value, ok := strings.Cut(pair, "=")
+		key = strings.TrimSpace(key)
+		if !ok || key == "" {
+			return nil, fmt.Errorf("invalid --param %q: want key=value", pair)
+		}
+		params[key] = strings.TrimSpace(value)
+	}
+	return params, nil
+}
+
+// displayScheduledJobs prints one row per job, then any failure messages
+func displayScheduledJobs(out io.Writer, jobs []*pb.ScheduledJob) {
+	if len(jobs) == 0 {
+		fmt.Fprintln(out, "No scheduled jobs")
+		return
+	}
+
+	w := tabwriter.NewWriter(out, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "NAME\tKIND\tEVERY\tLAST RUN\tSTATUS\tNEXT RUN\tPARAMS")
+	for _, job := range jobs {
+		lastRun, status, nextRun := job.LastRunAt, job.LastStatus, job.NextRunAt
+		if lastRun == "" {
+			lastRun = "never"
+		}
+		if status == "" {
+			status = "-"
+		}
+		if !job.Enabled {
+			nextRun = "disabled"
+		}
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t%s\t%s\n", job.Name, job.Kind,
+			time.Duration(job.IntervalSeconds)*time.Second, lastRun, status, nextRun, formatScheduleParams(job.Params))
+	}
+	w.Flush()
+
+	for _, job := range jobs {
+		if job.LastError != "" {
+			fmt.Fprintf(out, "%s last failed: %s\n", job.Name, job.LastError)
+		}
+	}
+}
+
+func formatScheduleParams(params map[string]string) string {
+	if len(params) == 0 {
+		return "-"
+	}
+	keys := make([]string, 0, len(params))
+	for key := range params {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	pairs := make([]string, 0, len(keys))
+	for _, key := range keys {
+		pairs = append(pairs, key+"="+params[key])
+	}
+	return strings.Join(pairs, " ")
+}
@@ -0,0 +1,37 @@
+package cli
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	pb "github.com/andrescamacho/spacetraders-go/pkg/proto/daemon"
+)
+
+func TestParseScheduleParams(t *testing.T) {
+	params, err := parseScheduleParams([]string{"system=X1-AB12", " ships = AGENT-2,AGENT-3 "})
+	require.NoError(t, err)
+	require.Equal(t, map[string]string{"system": "X1-AB12", "ships": "AGENT-2,AGENT-3"}, params)
+
+	_, err = parseScheduleParams([]string{"system"})
+	require.Error(t, err)
+}
+
+func TestDisplayScheduledJobs(t *testing.T) {
+	var out bytes.Buffer
+	displayScheduledJobs(&out, []*pb.ScheduledJob{
+		{Name: "export", Kind: "MARKET_EXPORT", IntervalSeconds: 3600, Enabled: true,
+			Params:    map[string]string{"system": "X1-AB12", "path": "/tmp/m.csv"},
+			LastRunAt: "2026-03-01T10:00:00Z", LastStatus: "FAILED", LastError: "disk full", NextRunAt: "2026-03-01T11:00:00Z"},
+		{Name: "reconcile", Kind: "LEDGER_RECONCILE", IntervalSeconds: 86400, NextRunAt: "2026-03-02T00:00:00Z"},
+	})
+
+	text := out.String()
+	require.Contains(t, text, "path=/tmp/m.csv system=X1-AB12")
+	require.Contains(t, text, "export last failed: disk full")
+	reconcileRow := text[strings.Index(text, "reconcile"):]
+	require.Contains(t, reconcileRow, "never")
+	require.Contains(t, reconcileRow, "disabled")
+}
@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"os"
 	"sort"
+	"strings"
 	"text/tabwriter"
 	"time"
 
@@ -63,6 +64,7 @@ Examples:
 	cmd.AddCommand(newShipBuyCommand())
 	cmd.AddCommand(newShipJettisonCommand())
 	cmd.AddCommand(newShipOutfitCommand())
+	cmd.AddCommand(newShipTagCommand())
 
 	return cmd
 }
@@ -210,7 +212,7 @@ func renderShipList(rows []shipListRow, jsonOut bool) error {
 // runShipList merges live daemon ship data with the persisted per-ship
 // assignment info and renders the result. The assignment repository is only
 // queried when there is at least one ship to enrich.
-func runShipList(ctx context.Context, ships []*pb.ShipInfo, lister shipAssignmentLister, playerID int, now time.Time, jsonOut bool) error {
+func runShipList(ctx context.Context, ships []*pb.ShipInfo, lister shipAssignmentLister, playerID int, now time.Time, jsonOut bool, filter shipListFilter) error {
 	if len(ships) == 0 {
 		fmt.Println("No ships found.")
 		return nil
@@ -226,11 +228,58 @@ func runShipList(ctx context.Context, ships []*pb.ShipInfo, lister shipAssignmen
 		infoMap[info.ShipSymbol] = info
 	}
 
-	rows := buildShipRows(ships, infoMap, now)
+	rows, total := filter.apply(buildShipRows(ships, infoMap, now))
+	if filter.CountOnly {
+		fmt.Println(total)
+		return nil
+	}
+	if len(rows) == 0 {
+		fmt.Println("No ships match.")
+		return nil
+	}
 
 	return renderShipList(rows, jsonOut)
 }
 
+// shipListFilter narrows and pages the `ship list` rows. Filters match
+// case-insensitively; System matches the system part of the location.
+type shipListFilter struct {
+	Status    string
+	Role      string
+	System    string
+	Offset    int
+	Limit     int // 0 shows every remaining row
+	CountOnly bool
+}
+
+// apply returns the page of rows the filter selects, and how many rows matched
+// before paging. rows must already be in display order.
+func (f shipListFilter) apply(rows []shipListRow) ([]shipListRow, int) {
+	matched := make([]shipListRow, 0, len(rows))
+	for _, r := range rows {
+		if f.Status != "" && !strings.EqualFold(r.NavStatus, f.Status) {
+			continue
+		}
+		if f.Role != "" && !strings.EqualFold(r.Role, f.Role) {
+			continue
+		}
+		if f.System != "" && !strings.EqualFold(shared.ExtractSystemSymbol(r.Location), f.System) {
+			continue
+		}
+		matched = append(matched, r)
+	}
+
+	total := len(matched)
+	if f.Offset >= total {
+		return nil, total
+	}
+	end := total
+	if f.Limit > 0 && f.Offset+f.Limit < end {
+		end = f.Offset + f.Limit
+	}
+	return matched[f.Offset:end], total
+}
+
 // newShipAssignmentStore bootstraps a DB-backed assignment lister and player
 // repository for resolving a numeric player ID from CLI flags.
 func newShipAssignmentStore() (shipAssignmentLister, *persistence.GormPlayerRepository, error) {
@@ -264,7 +313,10 @@ func resolveShipListPlayerID(ctx context.Context, playerRepo *persistence.GormPl
 
 // newShipListCommand creates the ship list subcommand
 func newShipListCommand() *cobra.Command {
-	var jsonOut bool
+	var (
+		jsonOut bool
+		filter  shipListFilter
+	)
 
 	cmd := &cobra.Command{
 		Use:   "list",
@@ -280,11 +332,20 @@ The FLEET column is a one-glance check for a hull pinned to the wrong fleet
 at purchase time (the sp-lybx incident) — no need to cross-check each ship
 against 'fleet list' individually.
 
+--status, --role and --system narrow the list; --limit and --offset page
+through what they match, and --count prints only how many ships match.
+
 Examples:
   spacetraders ship list --player-id 1
   spacetraders ship list --agent ENDURANCE
-  spacetraders ship list --player-id 1 --json`,
+  spacetraders ship list --player-id 1 --json
+  spacetraders ship list --status DOCKED --system X1-GZ7 --limit 20
+  spacetraders ship list --role SATELLITE --count`,
 		RunE: func(cmd *cobra.Command, args []string) error {
+			if filter.Offset < 0 || filter.Limit < 0 {
+				return fmt.Errorf("--offset and --limit must not be negative")
+			}
+
 			// Get daemon client
 			client, err := connectDaemon()
 			if err != nil {
@@ -308,6 +369,10 @@ Examples:
 			}
 
 			if len(response.Ships) == 0 {
+				if filter.CountOnly {
+					fmt.Println(0)
+					return nil
+				}
 				fmt.Println("No ships found.")
 				return nil
 			}
@@ -322,18 +387,27 @@ Examples:
 				return err
 			}
 
-			return runShipList(ctx, response.Ships, lister, resolvedPlayerID, time.Now(), jsonOut)
+			return runShipList(ctx, response.Ships, lister, resolvedPlayerID, time.Now(), jsonOut, filter)
 		},
 	}
 
 	cmd.Flags().BoolVar(&jsonOut, "json", false, "Output as JSON")
+	cmd.Flags().StringVar(&filter.Status, "status", "", "Only ships in this nav status (DOCKED, IN_ORBIT, IN_TRANSIT)")
+	cmd.Flags().StringVar(&filter.Role, "role", "", "Only ships with this role")
+	cmd.Flags().StringVar(&filter.System, "system", "", "Only ships currently in this system")
+	cmd.Flags().IntVar(&filter.Offset, "offset", 0, "Skip this many matching ships")
+	cmd.Flags().IntVar(&filter.Limit, "limit", 0, "Show at most this many ships (0 = all)")
+	cmd.Flags().BoolVar(&filter.CountOnly, "count", false, "Print only the number of matching ships")
 
 	return cmd
 }
 
 // newShipInfoCommand creates the ship info subcommand
 func newShipInfoCommand() *cobra.Command {
-	var shipSymbol string
+	var (
+		shipSymbol string
+		noCache    bool
+	)
 
 	cmd := &cobra.Command{
 		Use:   "info",
@@ -343,9 +417,13 @@ func newShipInfoCommand() *cobra.Command {
 Displays ship location, navigation status, fuel levels, cargo capacity,
 cargo contents, and engine specifications.
 
+By default the daemon answers from its cached ship state. --no-cache re-reads
+the ship from the SpaceTraders API (one rate-limited call) and persists it.
+
 Examples:
   spacetraders ship info --ship ENDURANCE-1 --player-id 1
-  spacetraders ship info --ship ENDURANCE-1 --agent ENDURANCE`,
+  spacetraders ship info --ship ENDURANCE-1 --agent ENDURANCE
+  spacetraders ship info --ship ENDURANCE-1 --no-cache`,
 		RunE: func(cmd *cobra.Command, args []string) error {
 			if shipSymbol == "" {
 				return fmt.Errorf("--ship flag is required")
@@ -368,7 +446,7 @@ Examples:
 			ctx := context.Background()
 			playerID, agentSymbol := playerPointers(playerIdent)
 
-			response, err := client.GetShip(ctx, shipSymbol, playerID, agentSymbol)
+			response, err := client.GetShip(ctx, shipSymbol, playerID, agentSymbol, noCache)
 			if err != nil {
 				return fmt.Errorf("failed to get ship: %w", err)
 			}
@@ -401,6 +479,7 @@ Examples:
 	}
 
 	cmd.Flags().StringVar(&shipSymbol, "ship", "", "Ship symbol (required)")
+	cmd.Flags().BoolVar(&noCache, "no-cache", false, "Re-read the ship from the SpaceTraders API instead of the daemon cache")
 
 	return cmd
 }
@@ -885,8 +964,10 @@ func sortedOverrideKeys(overrides map[string]bool) []string {
 // newShipNavigateCommand creates the ship navigate subcommand
 func newShipNavigateCommand() *cobra.Command {
 	var (
-		shipSymbol  string
-		destination string
+		shipSymbol         string
+		destination        string
+		idempotencyKey     string
+		fuelReservePercent int
 	)
 
 	cmd := &cobra.Command{
@@ -900,9 +981,17 @@ The daemon will automatically:
 - Navigate to the destination
 - Return a container ID for tracking progress
 
+Scripts that retry on a timeout should pass --idempotency-key: a retry with the
+same key gets the original container back instead of dispatching the ship twice.
+
+The route keeps the fleet-wide fuel reserve aboard unless --fuel-reserve-percent
+overrides it for this trip; 0 plans without a reserve.
+
 Examples:
   spacetraders ship navigate --ship AGENT-1 --destination X1-GZ7-B1 --player-id 1
-  spacetraders ship navigate --ship SCOUT-2 --destination X1-GZ7-A1 --agent ENDURANCE`,
+  spacetraders ship navigate --ship SCOUT-2 --destination X1-GZ7-A1 --agent ENDURANCE
+  spacetraders ship navigate --ship SCOUT-2 --destination X1-GZ7-A1 --idempotency-key tour-42-leg-3
+  spacetraders ship navigate --ship AGENT-1 --destination X1-GZ7-B1 --fuel-reserve-percent 0`,
 		RunE: func(cmd *cobra.Command, args []string) error {
 			// Validate flags
 			if shipSymbol == "" {
@@ -929,7 +1018,15 @@ Examples:
 			ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
 			defer cancel()
 
-			result, err := client.NavigateShip(ctx, shipSymbol, destination, playerIdent.PlayerID, playerIdent.AgentSymbol)
+			var reserve *int
+			if cmd.Flags().Changed("fuel-reserve-percent") {
+				if fuelReservePercent < 0 {
+					return fmt.Errorf("--fuel-reserve-percent must not be negative")
+				}
+				reserve = &fuelReservePercent
+			}
+
+			result, err := client.NavigateShip(ctx, shipSymbol, destination, playerIdent.PlayerID, playerIdent.AgentSymbol, idempotencyKey, reserve)
 			if err != nil {
 				return fmt.Errorf("navigation failed: %w", err)
 			}
@@ -950,6 +1047,8 @@ Examples:
 	// Command-specific flags
 	cmd.Flags().StringVar(&shipSymbol, "ship", "", "Ship symbol to navigate (required)")
 	cmd.Flags().StringVar(&destination, "destination", "", "Destination waypoint symbol (required)")
+	cmd.Flags().StringVar(&idempotencyKey, "idempotency-key", "", "Reuse the navigation an earlier call with this key started")
+	cmd.Flags().IntVar(&fuelReservePercent, "fuel-reserve-percent", 0, "Fuel reserve for this route as a percentage of the tank (default: the fleet-wide reserve)")
 
 	return cmd
 }
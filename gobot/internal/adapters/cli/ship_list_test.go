@@ -176,7 +176,7 @@ func TestRunShipListPropagatesListerError(t *testing.T) {
 	lister := &fakeShipAssignmentLister{err: errors.New("db down")}
 	ships := []*pb.ShipInfo{{Symbol: "SHIP-1"}}
 
-	err := runShipList(context.Background(), ships, lister, 7, time.Now(), false)
+	err := runShipList(context.Background(), ships, lister, 7, time.Now(), false, shipListFilter{})
 
 	require.Error(t, err)
 	require.Equal(t, 7, lister.gotID)
@@ -185,8 +185,30 @@ func TestRunShipListPropagatesListerError(t *testing.T) {
 func TestRunShipListSkipsAssignmentLookupWhenNoShips(t *testing.T) {
 	lister := &fakeShipAssignmentLister{}
 
-	err := runShipList(context.Background(), nil, lister, 7, time.Now(), false)
+	err := runShipList(context.Background(), nil, lister, 7, time.Now(), false, shipListFilter{})
 
 	require.NoError(t, err)
 	require.Equal(t, 0, lister.calls)
 }
+
+func TestShipListFilterNarrowsThenPages(t *testing.T) {
+	rows := []shipListRow{
+		{Symbol: "SHIP-1", Location: "X1-A-A1", NavStatus: "DOCKED", Role: "HAULER"},
+		{Symbol: "SHIP-2", Location: "X1-A-A2", NavStatus: "IN_ORBIT", Role: "HAULER"},
+		{Symbol: "SHIP-3", Location: "X1-B-B1", NavStatus: "DOCKED", Role: "SATELLITE"},
+		{Symbol: "SHIP-4", Location: "X1-A-A3", NavStatus: "DOCKED", Role: "HAULER"},
+	}
+
+	page, total := shipListFilter{Status: "docked", System: "x1-a"}.apply(rows)
+	require.Equal(t, 2, total)
+	require.Equal(t, []string{"SHIP-1", "SHIP-4"}, []string{page[0].Symbol, page[1].Symbol})
+
+	page, total = shipListFilter{Role: "HAULER", Offset: 1, Limit: 1}.apply(rows)
+	require.Equal(t, 3, total)
+	require.Len(t, page, 1)
+	require.Equal(t, "SHIP-2", page[0].Symbol)
+
+	page, total = shipListFilter{Offset: 9}.apply(rows)
+	require.Equal(t, 4, total)
+	require.Empty(t, page)
+}
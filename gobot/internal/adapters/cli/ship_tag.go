@@ -0,0 +1,228 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/spf13/cobra"
+
+	"github.com/andrescamacho/spacetraders-go/internal/adapters/persistence"
+	"github.com/andrescamacho/spacetraders-go/internal/domain/navigation"
+	"github.com/andrescamacho/spacetraders-go/internal/domain/shared"
+	"github.com/andrescamacho/spacetraders-go/internal/infrastructure/config"
+	"github.com/andrescamacho/spacetraders-go/internal/infrastructure/database"
+)
+
+// newShipTagCommand creates the ship tag subcommand group
+func newShipTagCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "tag",
+		Short: "Label ships with operator-defined tags",
+		Long: `Label ships with operator-defined tags.
+
+A tag is a free-form group name ("fast-haulers", "gate-probes") used to slice
+the fleet logically. A ship may wear any number of tags, and a tag grants no
+ownership — use 'fleet assign' to dedicate a hull to a coordinator. Commands
+that take a ship list also take a tag, e.g. 'operations start --siphon-tag',
+and 'fleet group' operates on every ship wearing a tag as one unit.
+
+Tags are case-insensitive and may use letters, digits, '-', '_' and '.'.
+
+Examples:
+  spacetraders ship tag add --ship TORWIND-4 --tag fast-haulers
+  spacetraders ship tag remove --ship TORWIND-4 --tag fast-haulers
+  spacetraders ship tag list
+  spacetraders ship tag list --tag fast-haulers`,
+	}
+
+	cmd.AddCommand(newShipTagAddCommand())
+	cmd.AddCommand(newShipTagRemoveCommand())
+	cmd.AddCommand(newShipTagListCommand())
+
+	return cmd
+}
+
+// newShipTagAddCommand creates the ship tag add subcommand
+func newShipTagAddCommand() *cobra.Command {
+	var shipSymbol, tag string
+
+	cmd := &cobra.Command{
+		Use:   "add",
+		Short: "Tag a ship",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if shipSymbol == "" {
+				return fmt.Errorf("--ship flag is required")
+			}
+			normalized, err := navigation.NormalizeShipTag(tag)
+			if err != nil {
+				return err
+			}
+
+			ctx := context.Background()
+			tags, playerID, err := openShipTagStore(ctx)
+			if err != nil {
+				return err
+			}
+			if err := tags.AddShipTag(ctx, playerID, strings.ToUpper(shipSymbol), normalized); err != nil {
+				return err
+			}
+
+			fmt.Printf("✓ %s tagged %q\n", strings.ToUpper(shipSymbol), normalized)
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVar(&shipSymbol, "ship", "", "Ship symbol (required)")
+	cmd.Flags().StringVar(&tag, "tag", "", "Tag to add (required)")
+
+	return cmd
+}
+
+// newShipTagRemoveCommand creates the ship tag remove subcommand
+func newShipTagRemoveCommand() *cobra.Command {
+	var shipSymbol, tag string
+
+	cmd := &cobra.Command{
+		Use:   "remove",
+		Short: "Remove a tag from a ship",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if shipSymbol == "" {
+				return fmt.Errorf("--ship flag is required")
+			}
+			normalized, err := navigation.NormalizeShipTag(tag)
+			if err != nil {
+				return err
+			}
+
+			ctx := context.Background()
+			tags, playerID, err := openShipTagStore(ctx)
+			if err != nil {
+				return err
+			}
+			removed, err := tags.RemoveShipTag(ctx, playerID, strings.ToUpper(shipSymbol), normalized)
+			if err != nil {
+				return err
+			}
+
+			if !removed {
+				fmt.Printf("%s was not tagged %q — nothing to remove\n", strings.ToUpper(shipSymbol), normalized)
+				return nil
+			}
+			fmt.Printf("✓ %s untagged %q\n", strings.ToUpper(shipSymbol), normalized)
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVar(&shipSymbol, "ship", "", "Ship symbol (required)")
+	cmd.Flags().StringVar(&tag, "tag", "", "Tag to remove (required)")
+
+	return cmd
+}
+
+// newShipTagListCommand creates the ship tag list subcommand
+func newShipTagListCommand() *cobra.Command {
+	var tag string
+
+	cmd := &cobra.Command{
+		Use:   "list",
+		Short: "List tagged ships",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := context.Background()
+			tags, playerID, err := openShipTagStore(ctx)
+			if err != nil {
+				return err
+			}
+
+			if tag != "" {
+				normalized, err := navigation.NormalizeShipTag(tag)
+				if err != nil {
+					return err
+				}
+				ships, err := tags.FindShipsByTag(ctx, playerID, normalized)
+				if err != nil {
+					return err
+				}
+				if len(ships) == 0 {
+					fmt.Printf("No ships tagged %q.\n", normalized)
+					return nil
+				}
+				for _, ship := range ships {
+					fmt.Println(ship)
+				}
+				return nil
+			}
+
+			all, err := tags.ListShipTags(ctx, playerID)
+			if err != nil {
+				return err
+			}
+			renderShipTags(all)
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVar(&tag, "tag", "", "Only list the ships wearing this tag")
+
+	return cmd
+}
+
+// renderShipTags prints one row per tagged ship, in symbol order
+func renderShipTags(tags map[string][]string) {
+	if len(tags) == 0 {
+		fmt.Println("No ships are tagged.")
+		return
+	}
+	symbols := make([]string, 0, len(tags))
+	for symbol := range tags {
+		symbols = append(symbols, symbol)
+	}
+	sort.Strings(symbols)
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "SHIP\tTAGS")
+	for _, symbol := range symbols {
+		fmt.Fprintf(w, "%s\t%s\n", symbol, strings.Join(tags[symbol], ", "))
+	}
+	w.Flush()
+}
+
+// openShipTagStore connects to the database and resolves the effective player
+func openShipTagStore(ctx context.Context) (*persistence.ShipTagRepositoryGORM, shared.PlayerID, error) {
+	cfg, err := config.LoadConfig("")
+	if err != nil {
+		return nil, shared.PlayerID{}, fmt.Errorf("failed to load config: %w", err)
+	}
+
+	db, err := database.NewConnection(&cfg.Database)
+	if err != nil {
+		return nil, shared.PlayerID{}, fmt.Errorf("failed to connect to database: %w", err)
+	}
+
+	p, err := resolveDefaultPlayer(ctx, persistence.NewGormPlayerRepository(db))
+	if err != nil {
+		return nil, shared.PlayerID{}, err
+	}
+	return persistence.NewShipTagRepository(db), p.ID, nil
+}
+
+// resolveTaggedShipsCsv appends the ships wearing tag to a comma-separated ship
+// list, so a command whose daemon RPC takes symbols can also be driven by a
+// tag. An empty tag returns csv unchanged without touching the database.
+func resolveTaggedShipsCsv(ctx context.Context, csv, tag string) (string, error) {
+	if tag == "" {
+		return csv, nil
+	}
+	tags, playerID, err := openShipTagStore(ctx)
+	if err != nil {
+		return "", err
+	}
+	ships, err := navigation.ResolveShipSelector(ctx, tags, playerID, parseCsvList(csv), tag)
+	if err != nil {
+		return "", err
+	}
+	return strings.Join(ships, ","), nil
+}
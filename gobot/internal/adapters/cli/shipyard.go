@@ -121,6 +121,12 @@ func newShipyardPurchaseCommand() *cobra.Command {
 		quantity         int
 		maxBudget        int
 		shipyardWaypoint string
+		loadout          string
+		maxPrice         int
+		waitForDip       bool
+		dipWait          time.Duration
+		dipPoll          time.Duration
+		failurePolicy    string
 	)
 
 	cmd := &cobra.Command{
@@ -138,13 +144,28 @@ The purchasing ship will:
 2. Navigate to the shipyard waypoint if not already there
 3. Dock if in orbit
 4. Purchase the specified ship(s)
+5. Apply the --loadout preset to each purchased ship, if given
+
+--max-price caps what any one ship may cost. A listing above it ends the batch,
+or with --wait-for-dip the batch re-reads the listing every --dip-poll until the
+price falls to the cap or --dip-wait runs out. Nothing is bought above the cap.
+
+--failure-policy decides what a failed purchase does to the rest of the batch:
+stop (default) keeps the ships bought so far and ends the batch, best_effort
+carries on with the remaining purchases, and rollback makes the batch atomic -
+it needs --waypoint and refuses up front unless that yard lists the type and the
+whole quantity is affordable, and a failure mid-batch scraps every ship it
+already bought.
 
 The operation runs in a background container that can be monitored.
 
 Examples:
   spacetraders shipyard purchase --ship AGENT-1 --type SHIP_PROBE --player-id 1
   spacetraders shipyard purchase --ship AGENT-1 --type SHIP_PROBE --quantity 5 --budget 500000 --player-id 1
-  spacetraders shipyard purchase --ship AGENT-1 --type SHIP_MINING_DRONE --quantity 10 --waypoint X1-GZ7-A1 --player-id 1`,
+  spacetraders shipyard purchase --ship AGENT-1 --type SHIP_MINING_DRONE --quantity 10 --waypoint X1-GZ7-A1 --player-id 1
+  spacetraders shipyard purchase --ship AGENT-1 --type SHIP_LIGHT_HAULER --quantity 2 --loadout trade-hauler --player-id 1
+  spacetraders shipyard purchase --ship AGENT-1 --type SHIP_LIGHT_HAULER --max-price 80000 --wait-for-dip --dip-wait 12h --player-id 1
+  spacetraders shipyard purchase --ship AGENT-1 --type SHIP_MINING_DRONE --quantity 4 --waypoint X1-GZ7-A1 --failure-policy rollback --player-id 1`,
 		RunE: func(cmd *cobra.Command, args []string) error {
 			// Validate flags
 			if purchasingShip == "" {
@@ -159,6 +180,25 @@ Examples:
 			if maxBudget < 0 {
 				return fmt.Errorf("--budget cannot be negative")
 			}
+			if maxPrice < 0 || dipWait < 0 || dipPoll < 0 {
+				return fmt.Errorf("--max-price, --dip-wait and --dip-poll cannot be negative")
+			}
+			if waitForDip && maxPrice == 0 {
+				return fmt.Errorf("--wait-for-dip requires --max-price")
+			}
+			if !waitForDip && (dipWait > 0 || dipPoll > 0) {
+				return fmt.Errorf("--dip-wait and --dip-poll require --wait-for-dip")
+			}
+			opts := BatchPurchaseOptions{
+				Loadout:       loadout,
+				MaxPrice:      maxPrice,
+				WaitForDip:    waitForDip,
+				DipPoll:       dipPoll,
+				FailurePolicy: failurePolicy,
+			}
+			if dipWait > 0 {
+				opts.DipDeadline = time.Now().Add(dipWait)
+			}
 
 			// Resolve player from flags or defaults
 			playerIdent, err := resolvePlayerIdentifier()
@@ -177,7 +217,7 @@ Examples:
 			ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
 			defer cancel()
 
-			response, err := client.BatchPurchaseShips(ctx, purchasingShip, shipType, quantity, maxBudget, playerIdent.PlayerID, playerIdent.AgentSymbol, shipyardWaypoint)
+			response, err := client.BatchPurchaseShips(ctx, purchasingShip, shipType, quantity, maxBudget, playerIdent.PlayerID, playerIdent.AgentSymbol, shipyardWaypoint, opts)
 			if err != nil {
 				return fmt.Errorf("failed to batch purchase ships: %w", err)
 			}
@@ -198,6 +238,18 @@ Examples:
 			} else {
 				fmt.Printf("  Shipyard:         Auto-discovering...\n")
 			}
+			if loadout != "" {
+				fmt.Printf("  Loadout:          %s\n", loadout)
+			}
+			if maxPrice > 0 {
+				fmt.Printf("  Max Price:        %d credits per ship\n", maxPrice)
+			}
+			if waitForDip {
+				fmt.Printf("  Wait For Dip:     yes\n")
+			}
+			if failurePolicy != "" {
+				fmt.Printf("  Failure Policy:   %s\n", failurePolicy)
+			}
 			fmt.Printf("  Status:           %s\n", response.Status)
 			fmt.Printf("\nTrack progress with: spacetraders container logs %s\n", response.ContainerId)
 
@@ -210,6 +262,12 @@ Examples:
 	cmd.Flags().IntVar(&quantity, "quantity", 1, "Number of ships to purchase (default: 1)")
 	cmd.Flags().IntVar(&maxBudget, "budget", 0, "Maximum budget in credits (0 = no limit, default: 0)")
 	cmd.Flags().StringVar(&shipyardWaypoint, "waypoint", "", "Shipyard waypoint (optional - will auto-discover if not provided)")
+	cmd.Flags().StringVar(&loadout, "loadout", "", "Loadout preset applied to each purchased ship (optional, from configs/loadout-presets)")
+	cmd.Flags().IntVar(&maxPrice, "max-price", 0, "Maximum price per ship in credits (0 = no cap)")
+	cmd.Flags().BoolVar(&waitForDip, "wait-for-dip", false, "Wait for a listing above --max-price to fall to it instead of stopping")
+	cmd.Flags().DurationVar(&dipWait, "dip-wait", 0, "How long --wait-for-dip waits before giving up (0 = daemon default)")
+	cmd.Flags().DurationVar(&dipPoll, "dip-poll", 0, "How often --wait-for-dip re-reads the listing (0 = daemon default)")
+	cmd.Flags().StringVar(&failurePolicy, "failure-policy", "", "What a failed purchase does to the batch: stop, best_effort or rollback (default: stop)")
 
 	return cmd
 }
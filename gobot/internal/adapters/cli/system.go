@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"sort"
 	"strings"
+	"time"
 
 	"github.com/spf13/cobra"
 
@@ -35,6 +36,77 @@ system live on demand.`,
 	}
 
 	cmd.AddCommand(newSystemGatesCommand())
+	cmd.AddCommand(newSystemWarmCommand())
+	cmd.AddCommand(newSystemOverviewCommand())
+	return cmd
+}
+
+// newSystemWarmCommand creates `system warm`: launch a background container that
+// pre-fetches a system's waypoints, markets and shipyards so the first navigation
+// into it does not page the waypoint list inline. Progress is persisted in the
+// container config and shown by `container get`.
+func newSystemWarmCommand() *cobra.Command {
+	var (
+		systemSymbol string
+		marketMaxAge time.Duration
+	)
+
+	cmd := &cobra.Command{
+		Use:   "warm",
+		Short: "Pre-fetch a system's waypoints, markets and shipyards in the background",
+		Long: `Launch a background warm-up of a system through the daemon.
+
+The warm-up caches the system's waypoint graph, then scans every market and
+shipyard where one of your ships is currently present. Markets and shipyards
+without a ship are left for the next scout visit, because a remote read has no
+prices. Markets scanned within --market-max-age are skipped.
+
+Examples:
+  spacetraders system warm --system X1-JP61 --agent ENDURANCE
+  spacetraders system warm --system X1-JP61 --market-max-age 30m
+  spacetraders container get <container-id>   # follow progress`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if systemSymbol == "" {
+				return fmt.Errorf("--system flag is required")
+			}
+			if marketMaxAge < 0 {
+				return fmt.Errorf("--market-max-age must not be negative")
+			}
+
+			playerIdent, err := resolvePlayerIdentifier()
+			if err != nil {
+				return err
+			}
+
+			client, err := connectDaemon()
+			if err != nil {
+				return err
+			}
+			defer client.Close()
+
+			ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+			defer cancel()
+
+			var agentSymbol *string
+			if playerIdent.AgentSymbol != "" {
+				agentSymbol = &playerIdent.AgentSymbol
+			}
+
+			resp, err := client.WarmSystem(ctx, systemSymbol, int32(marketMaxAge.Seconds()), playerIdent.PlayerID, agentSymbol)
+			if err != nil {
+				return fmt.Errorf("failed to start system warm-up: %w", err)
+			}
+
+			fmt.Printf("System warm-up started for %s\n", resp.SystemSymbol)
+			fmt.Printf("  Container ID: %s\n", resp.ContainerId)
+			fmt.Printf("\nFollow progress with: spacetraders container get %s\n", resp.ContainerId)
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVar(&systemSymbol, "system", "", "System symbol (required)")
+	cmd.Flags().DurationVar(&marketMaxAge, "market-max-age", 0, "Skip markets scanned within this age (0 = rescan all reachable markets)")
+
 	return cmd
 }
 
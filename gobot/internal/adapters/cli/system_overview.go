@@ -0,0 +1,141 @@
+package cli
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"text/tabwriter"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	pb "github.com/andrescamacho/spacetraders-go/pkg/proto/daemon"
+)
+
+// newSystemOverviewCommand creates `system overview`: one daemon call that shows
+// the ships in a system and their tasks, the containers working it, how fresh its
+// market cache is, open contract deliveries bound for it and its best lanes.
+func newSystemOverviewCommand() *cobra.Command {
+	var (
+		systemSymbol string
+		maxLanes     int
+		jsonOut      bool
+	)
+
+	cmd := &cobra.Command{
+		Use:   "overview",
+		Short: "Show the fleet's ships, containers, markets, contracts and lanes in a system",
+		Long: `Show everything the fleet has going on in one system.
+
+Lists the ships located there with the container command each is working for,
+the running containers with ships there or whose config names the system, the
+age of the system's cached markets, open contract deliveries to its waypoints
+and its most profitable arbitrage lanes.
+
+Examples:
+  spacetraders system overview --system X1-KA42 --agent ENDURANCE
+  spacetraders system overview --system X1-KA42 --lanes 10 --json`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if systemSymbol == "" {
+				return fmt.Errorf("--system flag is required")
+			}
+			if maxLanes < 0 {
+				return fmt.Errorf("--lanes must not be negative")
+			}
+
+			playerIdent, err := resolvePlayerIdentifier()
+			if err != nil {
+				return err
+			}
+
+			client, err := connectDaemon()
+			if err != nil {
+				return err
+			}
+			defer client.Close()
+
+			ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+			defer cancel()
+
+			var agentSymbol *string
+			if playerIdent.AgentSymbol != "" {
+				agentSymbol = &playerIdent.AgentSymbol
+			}
+
+			resp, err := client.GetSystemOverview(ctx, systemSymbol, int32(maxLanes), playerIdent.PlayerID, agentSymbol)
+			if err != nil {
+				return fmt.Errorf("failed to get system overview: %w", err)
+			}
+
+			if jsonOut {
+				encoder := json.NewEncoder(os.Stdout)
+				encoder.SetIndent("", "  ")
+				return encoder.Encode(resp)
+			}
+			return renderSystemOverview(os.Stdout, resp)
+		},
+	}
+
+	cmd.Flags().StringVar(&systemSymbol, "system", "", "System symbol (required)")
+	cmd.Flags().IntVar(&maxLanes, "lanes", 0, "Arbitrage lanes to list (0 = five)")
+	cmd.Flags().BoolVar(&jsonOut, "json", false, "Output as JSON")
+
+	return cmd
+}
+
+// renderSystemOverview prints the overview as one titled table per section;
+// empty sections print a single "none" line so the layout stays fixed.
+func renderSystemOverview(out io.Writer, resp *pb.GetSystemOverviewResponse) error {
+	w := tabwriter.NewWriter(out, 0, 0, 2, ' ', 0)
+	section := func(title string, count int, header string) bool {
+		fmt.Fprintf(w, "\n%s (%d)\n", title, count)
+		if count == 0 {
+			fmt.Fprintln(w, "  none")
+			return false
+		}
+		fmt.Fprintln(w, "  "+header)
+		return true
+	}
+
+	fmt.Fprintf(w, "System %s\n", resp.SystemSymbol)
+
+	if section("Ships", len(resp.Ships), "SHIP\tROLE\tLOCATION\tSTATUS\tTASK") {
+		for _, ship := range resp.Ships {
+			fmt.Fprintf(w, "  %s\t%s\t%s\t%s\t%s\n", ship.Symbol, ship.Role, ship.Location, ship.NavStatus, orDash(ship.Task))
+		}
+	}
+
+	if section("Containers", len(resp.Containers), "ID\tTYPE\tCOMMAND\tSHIPS HERE") {
+		for _, c := range resp.Containers {
+			fmt.Fprintf(w, "  %s\t%s\t%s\t%d\n", c.Id, c.ContainerType, c.CommandType, c.ShipsInSystem)
+		}
+	}
+
+	markets := resp.Markets
+	if markets == nil {
+		markets = &pb.SystemMarketFreshness{}
+	}
+	fmt.Fprintf(w, "\nMarkets (%d, %d scanned)\n", markets.Markets, markets.Scanned)
+	if markets.Scanned > 0 {
+		fmt.Fprintf(w, "  newest %s, median %s, oldest %s\n",
+			humanizeDuration(time.Duration(markets.NewestAgeSeconds)*time.Second),
+			humanizeDuration(time.Duration(markets.MedianAgeSeconds)*time.Second),
+			humanizeDuration(time.Duration(markets.OldestAgeSeconds)*time.Second))
+	}
+
+	if section("Contract deliveries", len(resp.Deliveries), "CONTRACT\tGOOD\tDESTINATION\tPROGRESS\tDEADLINE") {
+		for _, d := range resp.Deliveries {
+			fmt.Fprintf(w, "  %s\t%s\t%s\t%d/%d\t%s\n", d.ContractId, d.Good, d.Destination, d.UnitsFulfilled, d.UnitsRequired, orDash(d.Deadline))
+		}
+	}
+
+	if section("Arbitrage lanes", len(resp.Lanes), "GOOD\tBUY AT\tSELL AT\tASK\tBID\tSPREAD\tSUPPLY") {
+		for _, lane := range resp.Lanes {
+			fmt.Fprintf(w, "  %s\t%s\t%s\t%d\t%d\t%d\t%s\n", lane.Good, lane.SourceWaypoint, lane.DestWaypoint, lane.SourceAsk, lane.DestBid, lane.SpreadPerUnit, orDash(lane.SourceSupply))
+		}
+	}
+
+	return w.Flush()
+}
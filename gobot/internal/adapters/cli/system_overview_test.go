@@ -0,0 +1,44 @@
+package cli
+
+import (
+	"strings"
+	"testing"
+
+	pb "github.com/andrescamacho/spacetraders-go/pkg/proto/daemon"
+)
+
+func TestRenderSystemOverview_SectionsAndEmptyPlaceholders(t *testing.T) {
+	var out strings.Builder
+	err := renderSystemOverview(&out, &pb.GetSystemOverviewResponse{
+		SystemSymbol: "X1-KA42",
+		Ships: []*pb.SystemOverviewShip{
+			{Symbol: "SHIP-1", Role: "HAULER", Location: "X1-KA42-A1", NavStatus: "DOCKED", Task: "arbitrage_worker"},
+			{Symbol: "SHIP-2", Role: "SATELLITE", Location: "X1-KA42-B2", NavStatus: "IN_ORBIT"},
+		},
+		Markets: &pb.SystemMarketFreshness{Markets: 6, Scanned: 5, NewestAgeSeconds: 90, MedianAgeSeconds: 1200, OldestAgeSeconds: 7500},
+		Lanes: []*pb.SystemOverviewLane{
+			{Good: "FUEL", SourceWaypoint: "X1-KA42-A1", DestWaypoint: "X1-KA42-B2", SourceAsk: 70, DestBid: 95, SpreadPerUnit: 22, SourceSupply: "HIGH"},
+		},
+	})
+	if err != nil {
+		t.Fatalf("renderSystemOverview: %v", err)
+	}
+	got := out.String()
+
+	for _, want := range []string{
+		"System X1-KA42",
+		"Ships (2)",
+		"arbitrage_worker",
+		"Containers (0)\n  none",
+		"Markets (6, 5 scanned)\n  newest 1m, median 20m, oldest 2h5m",
+		"Contract deliveries (0)\n  none",
+		"Arbitrage lanes (1)",
+	} {
+		if !strings.Contains(got, want) {
+			t.Errorf("overview missing %q:\n%s", want, got)
+		}
+	}
+	if !strings.Contains(got, "IN_ORBIT  -") {
+		t.Errorf("an idle ship's task should render as -:\n%s", got)
+	}
+}
@@ -3,6 +3,7 @@ package cli
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"os"
@@ -16,6 +17,7 @@ import (
 	"github.com/andrescamacho/spacetraders-go/internal/adapters/persistence"
 	"github.com/andrescamacho/spacetraders-go/internal/domain/container"
 	"github.com/andrescamacho/spacetraders-go/internal/domain/player"
+	"github.com/andrescamacho/spacetraders-go/internal/domain/shared"
 	"github.com/andrescamacho/spacetraders-go/internal/infrastructure/config"
 	"github.com/andrescamacho/spacetraders-go/internal/infrastructure/database"
 )
@@ -55,8 +57,8 @@ type containerLister interface {
 }
 
 type containerStopper interface {
-	// StopContainer asks the daemon to stop a live container. A wrapped error whose
-	// message contains "not found" signals an orphan (no runtime handle) and is
+	// StopContainer asks the daemon to stop a live container. An error matching
+	// shared.ErrContainerNotFound signals an orphan (no runtime handle) and is
 	// handled by reconciling the DB row directly, not by failing the drain.
 	StopContainer(ctx context.Context, containerID string) error
 }
@@ -252,7 +254,7 @@ type drainReport struct {
 // coordinators FIRST (they run iterations=-1 reconcile loops that relaunch
 // workers, so stopping a worker before its coordinator just thrashes;
 // restart_policy=on-failure makes an explicit stop terminal). A daemon-unknown
-// orphan (StopContainer → "not found") is reconciled straight to STOPPED in the DB.
+// orphan (StopContainer → CONTAINER_NOT_FOUND) is reconciled straight to STOPPED in the DB.
 //
 // It re-lists across passes (skipping already-handled IDs) so any worker a
 // coordinator spawned in the enumerate→stop window is still caught, converging
@@ -344,7 +346,7 @@ func orderCoordinatorsFirst(cs []activeContainer) []activeContainer {
 }
 
 func isNotFoundErr(err error) bool {
-	return strings.Contains(strings.ToLower(err.Error()), "not found")
+	return errors.Is(err, shared.ErrContainerNotFound)
 }
 
 // ---- production adapters ---------------------------------------------------
@@ -12,6 +12,7 @@ import (
 	"github.com/andrescamacho/spacetraders-go/internal/adapters/api"
 	"github.com/andrescamacho/spacetraders-go/internal/adapters/persistence"
 	"github.com/andrescamacho/spacetraders-go/internal/domain/player"
+	"github.com/andrescamacho/spacetraders-go/internal/domain/shared"
 )
 
 // ---- fakes -----------------------------------------------------------------
@@ -107,7 +108,7 @@ func (f *fakeFleet) ListActiveContainers(ctx context.Context, playerID int) ([]a
 func (f *fakeFleet) StopContainer(ctx context.Context, containerID string) error {
 	f.stopOrder = append(f.stopOrder, containerID)
 	if f.notFound[containerID] {
-		return errors.New("rpc error: container not found")
+		return shared.Errorf(shared.CodeContainerNotFound, "container not found: %s", containerID)
 	}
 	f.remove(containerID)
 	return nil
@@ -36,8 +36,12 @@ Examples:
 	cmd.AddCommand(newWorkflowFleetAutosizerCommand())
 	cmd.AddCommand(newWorkflowCapacityReconcilerCommand())
 	cmd.AddCommand(newWorkflowShipyardBackfillCommand())
+	cmd.AddCommand(newWorkflowParkProbesCommand())
+	cmd.AddCommand(newWorkflowTankersCommand())
 	cmd.AddCommand(newWorkflowAutoOutfitCommand())
 	cmd.AddCommand(newWorkflowBootstrapCommand())
+	cmd.AddCommand(newWorkflowBootstrapFleetCommand())
+	cmd.AddCommand(newWorkflowLaunchProfileCommand())
 	cmd.AddCommand(newWorkflowWorkerRebalancerCoordinatorCommand())
 	cmd.AddCommand(newWorkflowWarehouseCommand())
 	cmd.AddCommand(newWorkflowStockerCommand())
@@ -131,6 +135,7 @@ Examples:
 func newWorkflowScoutMarketsCommand() *cobra.Command {
 	var (
 		shipsCsv   string
+		group      string
 		system     string
 		marketsCsv string
 		iterations int
@@ -158,10 +163,13 @@ Examples:
   spacetraders workflow scout-markets --ships SCOUT-1 --system X1-GZ7 --markets X1-GZ7-A1,X1-GZ7-B2 --agent ENDURANCE
 
   # Infinite loop
-  spacetraders workflow scout-markets --ships SCOUT-1,SCOUT-2,SCOUT-3 --system X1-TEST --markets X1-TEST-A1,X1-TEST-B2,X1-TEST-C3 --iterations -1 --agent ENDURANCE`,
+  spacetraders workflow scout-markets --ships SCOUT-1,SCOUT-2,SCOUT-3 --system X1-TEST --markets X1-TEST-A1,X1-TEST-B2,X1-TEST-C3 --iterations -1 --agent ENDURANCE
+
+  # Every ship in a fleet group
+  spacetraders workflow scout-markets --group probes-alpha --system X1-TEST --markets X1-TEST-A1,X1-TEST-B2 --agent ENDURANCE`,
 		RunE: func(cmd *cobra.Command, args []string) error {
-			if shipsCsv == "" {
-				return fmt.Errorf("--ships flag is required")
+			if shipsCsv == "" && group == "" {
+				return fmt.Errorf("--ships or --group flag is required")
 			}
 			if system == "" {
 				return fmt.Errorf("--system flag is required")
@@ -170,7 +178,11 @@ Examples:
 				return fmt.Errorf("--markets flag is required")
 			}
 
-			ships := parseCsvList(shipsCsv)
+			selected, err := resolveTaggedShipsCsv(context.Background(), shipsCsv, group)
+			if err != nil {
+				return err
+			}
+			ships := parseCsvList(selected)
 			markets := parseCsvList(marketsCsv)
 
 			if len(ships) == 0 {
@@ -238,7 +250,8 @@ Examples:
 		},
 	}
 
-	cmd.Flags().StringVar(&shipsCsv, "ships", "", "Comma-separated list of ship symbols (required)")
+	cmd.Flags().StringVar(&shipsCsv, "ships", "", "Comma-separated list of ship symbols (required unless --group)")
+	cmd.Flags().StringVar(&group, "group", "", "Also scout with every ship in this fleet group")
 	cmd.Flags().StringVar(&system, "system", "", "System symbol (required)")
 	cmd.Flags().StringVar(&marketsCsv, "markets", "", "Comma-separated list of market waypoints (required)")
 	cmd.Flags().IntVar(&iterations, "iterations", 1, "Number of complete tours (-1 = infinite, 0 = the default of 1; N tours otherwise)")
@@ -249,7 +262,8 @@ Examples:
 // newWorkflowScoutAllMarketsCommand creates the workflow scout-all-markets subcommand
 func newWorkflowScoutAllMarketsCommand() *cobra.Command {
 	var (
-		system string
+		system       string
+		freshnessSLA time.Duration
 	)
 
 	cmd := &cobra.Command{
@@ -267,16 +281,27 @@ The command will:
 
 This command is idempotent: ships with existing containers are reused automatically.
 
+With --freshness-sla the container instead runs a standing refresh loop: every pass
+it ranks the markets by data age and trading importance (recent arbitrage and
+manufacturing usage) and tours the scouts over only the stalest high-value markets
+that are falling out of the SLA.
+
 Examples:
   # Scout all markets in system X1-GZ7
   spacetraders workflow scout-all-markets --system X1-GZ7 --agent ENDURANCE
 
+  # Keep X1-GZ7 price data under 30 minutes old, refreshing the most valuable markets first
+  spacetraders workflow scout-all-markets --system X1-GZ7 --freshness-sla 30m
+
   # Scout all markets in system X1-TEST
   spacetraders workflow scout-all-markets --system X1-TEST --player-id 1`,
 		RunE: func(cmd *cobra.Command, args []string) error {
 			if system == "" {
 				return fmt.Errorf("--system flag is required")
 			}
+			if freshnessSLA < 0 {
+				return fmt.Errorf("--freshness-sla must not be negative")
+			}
 
 			playerIdent, err := resolvePlayerIdentifier()
 			if err != nil {
@@ -295,7 +320,7 @@ Examples:
 
 			fmt.Printf("Starting scout fleet assignment for system %s...\n\n", system)
 
-			result, err := client.AssignScoutingFleet(ctx, system, playerIdent.PlayerID, playerIdent.AgentSymbol)
+			result, err := client.AssignScoutingFleet(ctx, system, playerIdent.PlayerID, playerIdent.AgentSymbol, freshnessSLA)
 			if err != nil {
 				return fmt.Errorf("failed to create fleet assignment container: %w", err)
 			}
@@ -305,7 +330,11 @@ Examples:
 			fmt.Printf("  System:       %s\n", system)
 			fmt.Printf("  Agent:        %s (player %d)\n\n", playerIdent.AgentSymbol, playerIdent.PlayerID)
 			fmt.Println("The fleet assignment is running in the background.")
-			fmt.Println("VRP optimization will distribute markets across probe/satellite ships.")
+			if freshnessSLA > 0 {
+				fmt.Printf("Markets falling out of the %s freshness SLA are re-toured each pass.\n", freshnessSLA)
+			} else {
+				fmt.Println("VRP optimization will distribute markets across probe/satellite ships.")
+			}
 			fmt.Println()
 			fmt.Println("Track progress with:")
 			fmt.Printf("  spacetraders container logs %s\n", result.ContainerID)
@@ -318,6 +347,7 @@ Examples:
 	}
 
 	cmd.Flags().StringVar(&system, "system", "", "System symbol (required)")
+	cmd.Flags().DurationVar(&freshnessSLA, "freshness-sla", 0, "Keep market data fresher than this by touring the stalest high-value markets (e.g. 30m); 0 = one-off full assignment")
 
 	return cmd
 }
@@ -0,0 +1,101 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"text/tabwriter"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	pb "github.com/andrescamacho/spacetraders-go/pkg/proto/daemon"
+)
+
+// newWorkflowBootstrapFleetCommand creates the workflow bootstrap-fleet subcommand: it applies a
+// fleet template (configs/fleet-templates) once. The daemon buys the profile's ship shortfall and
+// launches its standing containers in a one-shot container; --dry-run prints the plan instead.
+func newWorkflowBootstrapFleetCommand() *cobra.Command {
+	var (
+		profile        string
+		purchasingShip string
+		dryRun         bool
+	)
+
+	cmd := &cobra.Command{
+		Use:   "bootstrap-fleet",
+		Short: "Apply a fleet template: buy its starter ships and launch its standing containers",
+		Long: `Apply a fleet template to a player once. The template (a name in the daemon's fleet
+templates directory, or a path to a YAML file) lists ship orders and standing containers.
+
+The daemon buys each order's shortfall — hulls already owned with the order's frame count
+toward it — then starts the containers in dependency order, leaving any type already running
+alone. It runs in a one-shot container, so re-applying a template only fills what is missing.
+
+Pass --dry-run to print what would be bought and launched without spending anything.
+
+Examples:
+  spacetraders workflow bootstrap-fleet --profile standard --agent ENDURANCE
+  spacetraders workflow bootstrap-fleet --profile standard --player-id 1 --dry-run
+  spacetraders workflow bootstrap-fleet --profile ./my-fleet.yaml --ship ENDURANCE-1 --player-id 1`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if profile == "" {
+				return fmt.Errorf("--profile is required")
+			}
+			playerIdent, err := resolvePlayerIdentifier()
+			if err != nil {
+				return err
+			}
+
+			client, err := connectDaemon()
+			if err != nil {
+				return err
+			}
+			defer client.Close()
+
+			ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+			defer cancel()
+
+			resp, err := client.BootstrapFleet(ctx, playerIdent.PlayerID, playerIdent.AgentSymbol, profile, purchasingShip, dryRun)
+			if err != nil {
+				return fmt.Errorf("failed to apply fleet template: %w", err)
+			}
+
+			if !dryRun {
+				fmt.Println("✓ Fleet template application started")
+				fmt.Printf("  Container ID: %s\n", resp.ContainerId)
+				fmt.Printf("  Profile:      %s\n", profile)
+				fmt.Printf("\nTrack progress with: spacetraders container logs %s\n", resp.ContainerId)
+				return nil
+			}
+			printFleetTemplatePlan(resp)
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVar(&profile, "profile", "", "Fleet template name or YAML path (required)")
+	cmd.Flags().StringVar(&purchasingShip, "ship", "", "Ship that flies to the shipyards (default: an idle hull)")
+	cmd.Flags().BoolVar(&dryRun, "dry-run", false, "Print what would be bought and launched without spending anything")
+
+	return cmd
+}
+
+// printFleetTemplatePlan prints a dry run's ship orders and containers.
+func printFleetTemplatePlan(resp *pb.BootstrapFleetResponse) {
+	fmt.Printf("Fleet template %s (dry run)\n\n", resp.Template)
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "SHIP TYPE\tWANTED\tOWNED\tTO BUY")
+	for _, p := range resp.Purchases {
+		fmt.Fprintf(w, "%s\t%d\t%d\t%d\n", p.ShipType, p.Wanted, p.Owned, max(p.Wanted-p.Owned, 0))
+	}
+	w.Flush()
+
+	fmt.Println()
+	w = tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "CONTAINER\tTYPE\tSTATUS\tREASON")
+	for _, c := range resp.Containers {
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\n", c.Name, c.CommandType, c.Status, c.Reason)
+	}
+	w.Flush()
+}
@@ -0,0 +1,89 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	pb "github.com/andrescamacho/spacetraders-go/pkg/proto/daemon"
+)
+
+// newWorkflowLaunchProfileCommand creates the workflow launch-profile subcommand: it launches one
+// operation from a versioned parameter set (configs/operation-profiles). --dry-run validates the
+// profile and prints the launches without starting anything.
+func newWorkflowLaunchProfileCommand() *cobra.Command {
+	var (
+		profile string
+		dryRun  bool
+	)
+
+	cmd := &cobra.Command{
+		Use:   "launch-profile",
+		Short: "Launch an operation from a versioned operation profile",
+		Long: `Launch one operation from an operation profile. The profile (a name in the daemon's
+operation profiles directory, or a path to a YAML file) names the operation, its params and,
+for ship operations, the hulls to run it on.
+
+The daemon checks the params against the operation's launch schema before starting anything,
+so a typo or a wrong type fails the whole launch. A ship that fails to launch is reported in
+its row and does not stop the others. Each launch records the profile's version and digest.
+
+Pass --dry-run to validate the profile and print the launches without starting anything.
+
+Examples:
+  spacetraders workflow launch-profile --profile tour-haulers --agent ENDURANCE
+  spacetraders workflow launch-profile --profile ./my-profile.yaml --player-id 1 --dry-run`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if profile == "" {
+				return fmt.Errorf("--profile is required")
+			}
+			playerIdent, err := resolvePlayerIdentifier()
+			if err != nil {
+				return err
+			}
+
+			client, err := connectDaemon()
+			if err != nil {
+				return err
+			}
+			defer client.Close()
+
+			ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+			defer cancel()
+
+			resp, err := client.LaunchOperationProfile(ctx, playerIdent.PlayerID, playerIdent.AgentSymbol, profile, dryRun)
+			if err != nil {
+				return fmt.Errorf("failed to launch operation profile: %w", err)
+			}
+
+			printOperationLaunches(resp)
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVar(&profile, "profile", "", "Operation profile name or YAML path (required)")
+	cmd.Flags().BoolVar(&dryRun, "dry-run", false, "Validate the profile and print the launches without starting anything")
+
+	return cmd
+}
+
+// printOperationLaunches prints the profile revision and one row per launch target.
+func printOperationLaunches(resp *pb.LaunchOperationProfileResponse) {
+	suffix := ""
+	if resp.DryRun {
+		suffix = " (dry run)"
+	}
+	fmt.Printf("Operation profile %s v%d (%s) → %s%s\n\n", resp.Profile, resp.Version, resp.Digest, resp.Operation, suffix)
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "TARGET\tSTATUS\tCONTAINERS\tREASON")
+	for _, l := range resp.Launches {
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\n", l.Target, l.Status, strings.Join(l.ContainerIds, ","), l.Reason)
+	}
+	w.Flush()
+}
@@ -0,0 +1,81 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/spf13/cobra"
+)
+
+// newWorkflowParkProbesCommand creates the workflow park-probes subcommand: the launch verb
+// for the standing probe-parking coordinator.
+//
+// Like the other coordinator verbs it is a THIN CLIENT: it asks the daemon to start one
+// recovery-safe coordinator container and returns its id.
+func newWorkflowParkProbesCommand() *cobra.Command {
+	var (
+		tickInterval time.Duration
+		scanInterval time.Duration
+		reserve      int
+	)
+
+	cmd := &cobra.Command{
+		Use:   "park-probes",
+		Short: "Start the standing probe-parking coordinator (one docked probe per high-value market)",
+		Long: `Start the STANDING probe-parking coordinator for a player. Instead of touring, idle
+probes are parked one per market at the highest-value markets, docked, and left re-scanning
+on a short cadence — near-real-time prices for arbitrage with no tour overhead.
+
+Each tick it:
+  RANK   each system's markets by recent arbitrage and manufacturing use.
+  SKIP   markets that already hold a parked probe.
+  PARK   the nearest idle probe at each remaining market, in rank order, as a docked
+         single-market scout tour that re-scans every --scan-interval.
+
+Only idle probes are used, so hulls manning scout posts or tours are never poached;
+--reserve keeps some idle for them. Parked tours are ordinary scout_tour containers: stop
+one with 'spacetraders container stop' to free its probe.
+
+Examples:
+  spacetraders workflow park-probes --agent TORWIND
+  spacetraders workflow park-probes --player-id 1 --scan-interval 5m --reserve 2`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			playerIdent, err := resolvePlayerIdentifier()
+			if err != nil {
+				return err
+			}
+
+			client, err := connectDaemon()
+			if err != nil {
+				return err
+			}
+			defer client.Close()
+
+			ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+			defer cancel()
+
+			containerID, err := client.ProbeParkingCoordinator(ctx, playerIdent.PlayerID, playerIdent.AgentSymbol, ProbeParkingCoordinatorParams{
+				TickIntervalSecs:  int(tickInterval.Seconds()),
+				ScanIntervalSecs:  int(scanInterval.Seconds()),
+				ReserveIdleProbes: reserve,
+			})
+			if err != nil {
+				return fmt.Errorf("probe parking coordinator failed: %w", err)
+			}
+
+			fmt.Println("✓ Probe parking coordinator started")
+			fmt.Printf("  Container ID: %s\n", containerID)
+			fmt.Printf("  Agent:        %s (player %d)\n", playerIdent.AgentSymbol, playerIdent.PlayerID)
+			fmt.Println("\n  It parks idle probes at the highest-value unwatched markets each tick, one per market.")
+			fmt.Println("  Watch decisions with 'spacetraders container logs " + containerID + "'.")
+			fmt.Println("  Stop with 'spacetraders container stop " + containerID + "'.")
+			return nil
+		},
+	}
+
+	cmd.Flags().DurationVar(&tickInterval, "tick", 0, "Reconcile cadence (e.g. 120s); 0 uses the coordinator default")
+	cmd.Flags().DurationVar(&scanInterval, "scan-interval", 0, "Parked probe re-scan cadence (clamped to 5m-30m); 0 uses 5m")
+	cmd.Flags().IntVar(&reserve, "reserve", 0, "Idle probes to leave unparked for other work")
+	return cmd
+}
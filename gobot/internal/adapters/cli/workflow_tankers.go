@@ -0,0 +1,94 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/spf13/cobra"
+)
+
+// newWorkflowTankersCommand creates the workflow tankers subcommand: the launch verb for the
+// standing tanker coordinator. It only asks the daemon to start the coordinator container.
+func newWorkflowTankersCommand() *cobra.Command {
+	var (
+		ships        []string
+		tag          string
+		stations     []string
+		fuelSource   string
+		refuelBelow  int
+		reloadBelow  int
+		tickInterval time.Duration
+	)
+
+	cmd := &cobra.Command{
+		Use:   "tankers",
+		Short: "Start the standing tanker coordinator (field refuelling for mining drones)",
+		Long: `Start the STANDING tanker coordinator for a player. Tankers are haulers that carry FUEL
+as cargo and sit at mining sites, topping up drones in place so they never leave the site
+to refuel.
+
+Each tick, for every tanker it:
+  RELOAD  at --fuel-source (or the nearest fuel market) once its FUEL cargo runs low.
+  STATION at its assigned site: a --station, or else the site where most extraction
+          ships are working.
+  SERVE   each ship there below --refuel-below percent fuel, lowest tank first, by
+          transferring FUEL and refuelling from cargo.
+
+Ships being served need one free cargo slot per 100 fuel. The tankers are claimed for
+as long as the coordinator runs.
+
+Examples:
+  spacetraders workflow tankers --agent TORWIND --ships TORWIND-7,TORWIND-8
+  spacetraders workflow tankers --player-id 1 --tag tanker --station X1-AB12-C3 --refuel-below 50`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(ships) == 0 && tag == "" {
+				return fmt.Errorf("at least one of --ships or --tag is required")
+			}
+
+			playerIdent, err := resolvePlayerIdentifier()
+			if err != nil {
+				return err
+			}
+
+			client, err := connectDaemon()
+			if err != nil {
+				return err
+			}
+			defer client.Close()
+
+			ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+			defer cancel()
+
+			containerID, err := client.TankerCoordinator(ctx, playerIdent.PlayerID, playerIdent.AgentSymbol, TankerCoordinatorParams{
+				TankerShips:      ships,
+				TankerShipTag:    tag,
+				Stations:         stations,
+				FuelSource:       fuelSource,
+				RefuelBelowPct:   refuelBelow,
+				ReloadBelowUnits: reloadBelow,
+				TickIntervalSecs: int(tickInterval.Seconds()),
+			})
+			if err != nil {
+				return fmt.Errorf("tanker coordinator failed: %w", err)
+			}
+
+			fmt.Println("✓ Tanker coordinator started")
+			fmt.Printf("  Container ID: %s\n", containerID)
+			fmt.Printf("  Agent:        %s (player %d)\n", playerIdent.AgentSymbol, playerIdent.PlayerID)
+			fmt.Println("\n  Tankers hold station at mining sites and refuel drones running low.")
+			fmt.Println("  Watch decisions with 'spacetraders container logs " + containerID + "'.")
+			fmt.Println("  Stop with 'spacetraders container stop " + containerID + "'.")
+			return nil
+		},
+	}
+
+	cmd.Flags().StringSliceVar(&ships, "ships", nil, "Tanker ship symbols (comma-separated)")
+	cmd.Flags().StringVar(&tag, "tag", "", "Use every ship carrying this tag as a tanker")
+	cmd.Flags().StringSliceVar(&stations, "station", nil, "Waypoints to station tankers at, assigned round-robin; default is the busiest extraction sites")
+	cmd.Flags().StringVar(&fuelSource, "fuel-source", "", "Market to load FUEL at; default is the nearest fuel market")
+	cmd.Flags().IntVar(&refuelBelow, "refuel-below", 0, "Serve ships under this fuel percentage; 0 uses 60")
+	cmd.Flags().IntVar(&reloadBelow, "reload-below", 0, "Reload once FUEL cargo falls under this many units; 0 uses a quarter of the hold")
+	cmd.Flags().DurationVar(&tickInterval, "tick", 0, "Coordinator cadence (e.g. 60s); 0 uses the coordinator default")
+	return cmd
+}
@@ -77,3 +77,21 @@ func TestWorkflowShipyardBackfillFlags(t *testing.T) {
 	require.Nil(t, cmd.Flags().Lookup("dry-run"),
 		"shipyard-backfill must NOT expose --dry-run: the sp-rhju engine has no dry-run mode")
 }
+
+// The park-probes launch verb is registered under `workflow` and exposes its three knobs.
+func TestWorkflowParkProbesCommandIsRegistered(t *testing.T) {
+	cmd := findWorkflowSubcommand("park-probes")
+	require.NotNil(t, cmd, "park-probes subcommand should be registered under `workflow`")
+	for _, flag := range []string{"tick", "scan-interval", "reserve"} {
+		require.NotNil(t, cmd.Flags().Lookup(flag), "park-probes must expose --%s", flag)
+	}
+}
+
+// The tankers launch verb is registered under `workflow` and exposes its knobs.
+func TestWorkflowTankersCommandIsRegistered(t *testing.T) {
+	cmd := findWorkflowSubcommand("tankers")
+	require.NotNil(t, cmd, "tankers subcommand should be registered under `workflow`")
+	for _, flag := range []string{"ships", "tag", "station", "fuel-source", "refuel-below", "reload-below", "tick"} {
+		require.NotNil(t, cmd.Flags().Lookup(flag), "tankers must expose --%s", flag)
+	}
+}
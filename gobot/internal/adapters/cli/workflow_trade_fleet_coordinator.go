@@ -49,6 +49,12 @@ Tuning is config-driven (config.yaml [trade_fleet], live on daemon restart):
   tick_seconds           reconcile cadence (default 30)
   max_hops / max_spend / min_margin / replan_limit / working_capital_reserve
                          per-tour caps (0 = the tour's own default)
+  min_fleet_size / max_fleet_size
+                         bounds for dynamic sizing: the coordinator pins idle cargo hulls
+                         into the fleet or releases surplus ones to match tour throughput
+                         (max 0 = sizing off, the fleet stays as pinned)
+  sizing_interval_minutes
+                         throughput window between sizing decisions (default 60)
 
 Examples:
   spacetraders workflow trade-fleet-coordinator --agent TORWIND
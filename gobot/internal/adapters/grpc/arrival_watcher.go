@@ -0,0 +1,256 @@
+package grpc
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/andrescamacho/spacetraders-go/internal/domain/navigation"
+	"github.com/andrescamacho/spacetraders-go/internal/domain/shared"
+	"github.com/andrescamacho/spacetraders-go/internal/infrastructure/supervise"
+)
+
+// ArrivalWatcherRetryDelay is how long the watcher waits before re-checking a
+// ship whose nav read failed or still showed it in transit past its arrival.
+const ArrivalWatcherRetryDelay = 15 * time.Second
+
+// arrivalWatcherMaxFailures is how many failed nav reads a ship gets before
+// the watcher stops waiting on the API and transitions it locally, exactly as
+// the timer scheduler would have.
+const arrivalWatcherMaxFailures = 3
+
+// arrivalConfirmTimeout bounds one nav read plus the state write that follows.
+const arrivalConfirmTimeout = 15 * time.Second
+
+// watchedArrival is one in-transit ship the watcher is tracking.
+type watchedArrival struct {
+	playerID shared.PlayerID
+	arrival  time.Time
+	failures int
+}
+
+// ArrivalWatcher tracks the arrival timestamps of in-transit ships from a
+// single loop: it sleeps until the earliest one is due, then confirms the
+// arrival with a GetShipNav read (the nav block only) before transitioning
+// the ship to IN_ORBIT and publishing ARRIVED. A ship the API still shows in
+// transit is re-tracked at the API's arrival time, so waiters are woken on
+// the real arrival rather than a stale local ETA and do not need a full ship
+// read of their own to find out.
+//
+// Installed behind ShipStateScheduler.SetArrivalWatcher, so navigation and
+// the startup ScheduleAllPending feed it through ScheduleArrival. Cooldowns
+// and the stuck-ship sweeper stay with the timer scheduler.
+type ArrivalWatcher struct {
+	shipRepo       navigation.ShipRepository
+	clock          shared.Clock
+	eventPublisher navigation.ShipEventPublisher
+
+	mu      sync.Mutex
+	pending map[string]*watchedArrival // key: ship symbol
+	wakeCh  chan struct{}
+}
+
+// NewArrivalWatcher creates a watcher. eventPublisher is optional - if nil, no
+// events will be published.
+func NewArrivalWatcher(shipRepo navigation.ShipRepository, clock shared.Clock, eventPublisher navigation.ShipEventPublisher) *ArrivalWatcher {
+	if clock == nil {
+		clock = shared.NewRealClock()
+	}
+	return &ArrivalWatcher{
+		shipRepo:       shipRepo,
+		clock:          clock,
+		eventPublisher: eventPublisher,
+		pending:        make(map[string]*watchedArrival),
+		wakeCh:         make(chan struct{}, 1),
+	}
+}
+
+// ScheduleArrival starts (or restarts) tracking ship's arrival
+func (w *ArrivalWatcher) ScheduleArrival(ship *navigation.Ship) {
+	if ship.ArrivalTime() == nil {
+		return
+	}
+	w.mu.Lock()
+	w.pending[ship.ShipSymbol()] = &watchedArrival{
+		playerID: ship.PlayerID(),
+		arrival:  *ship.ArrivalTime(),
+	}
+	w.mu.Unlock()
+	w.wake()
+}
+
+// PendingCount returns the number of tracked ships (for testing/monitoring)
+func (w *ArrivalWatcher) PendingCount() int {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	return len(w.pending)
+}
+
+// wake nudges Run to recompute its sleep after the earliest arrival changed.
+func (w *ArrivalWatcher) wake() {
+	select {
+	case w.wakeCh <- struct{}{}:
+	default:
+	}
+}
+
+// Run blocks, confirming arrivals as they come due, until ctx is canceled. It
+// runs under the daemon Supervisor; each pass runs under supervise.Guard so
+// one bad confirmation cannot stop the loop.
+func (w *ArrivalWatcher) Run(ctx context.Context) error {
+	fmt.Println("Arrival watcher started")
+	for {
+		var due <-chan time.Time
+		if delay, ok := w.nextDelay(); ok {
+			due = shared.After(w.clock, delay)
+		}
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-w.wakeCh:
+		case <-due:
+			supervise.Guard("arrival-watcher", func() {
+				w.confirmDue(ctx)
+			})
+		}
+	}
+}
+
+// nextDelay returns the sleep until the earliest tracked arrival (plus
+// ClockDriftBuffer), or false when nothing is tracked.
+func (w *ArrivalWatcher) nextDelay() (time.Duration, bool) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	var earliest time.Time
+	for _, entry := range w.pending {
+		if earliest.IsZero() || entry.arrival.Before(earliest) {
+			earliest = entry.arrival
+		}
+	}
+	if earliest.IsZero() {
+		return 0, false
+	}
+	delay := earliest.Add(ClockDriftBuffer).Sub(w.clock.Now())
+	if delay < 0 {
+		delay = 0
+	}
+	return delay, true
+}
+
+// confirmDue takes every ship whose arrival is due off the watch list and
+// confirms it against the API.
+func (w *ArrivalWatcher) confirmDue(ctx context.Context) {
+	now := w.clock.Now()
+	due := make(map[string]*watchedArrival)
+	w.mu.Lock()
+	for symbol, entry := range w.pending {
+		if !entry.arrival.Add(ClockDriftBuffer).After(now) {
+			due[symbol] = entry
+			delete(w.pending, symbol)
+		}
+	}
+	w.mu.Unlock()
+
+	for symbol, entry := range due {
+		w.confirm(ctx, symbol, entry)
+	}
+}
+
+// confirm reads symbol's nav and either applies the arrival or re-tracks the
+// ship at its updated arrival time.
+func (w *ArrivalWatcher) confirm(ctx context.Context, symbol string, entry *watchedArrival) {
+	confirmCtx, cancel := context.WithTimeout(ctx, arrivalConfirmTimeout)
+	defer cancel()
+
+	nav, err := w.shipRepo.GetShipNav(confirmCtx, symbol, entry.playerID)
+	if err != nil {
+		entry.failures++
+		if entry.failures >= arrivalWatcherMaxFailures {
+			fmt.Printf("Arrival watcher: nav read for %s failed %d times, transitioning locally: %v\n", symbol, entry.failures, err)
+			w.applyArrival(confirmCtx, symbol, entry.playerID)
+			return
+		}
+		fmt.Printf("Warning: Arrival watcher nav read for %s failed, retrying in %v: %v\n", symbol, ArrivalWatcherRetryDelay, err)
+		w.retrack(symbol, entry, w.clock.Now().Add(ArrivalWatcherRetryDelay))
+		return
+	}
+
+	if navigation.NavStatus(nav.NavStatus) != navigation.NavStatusInTransit {
+		w.applyArrival(confirmCtx, symbol, entry.playerID)
+		return
+	}
+
+	// Still in transit: follow the API's arrival time when it moved later
+	// (persisting it so the sweeper does not force the arrival early), and
+	// otherwise check again shortly.
+	next := w.clock.Now().Add(ArrivalWatcherRetryDelay)
+	if arrival, perr := time.Parse(time.RFC3339, nav.ArrivalTime); perr == nil && arrival.After(w.clock.Now()) {
+		next = arrival
+		if _, _, err := w.shipRepo.SaveWithRetry(confirmCtx, symbol, entry.playerID, setArrivalIfInTransit(arrival)); err != nil {
+			fmt.Printf("Warning: Failed to save updated arrival for %s: %v\n", symbol, err)
+		}
+	}
+	fmt.Printf("Arrival watcher: %s still in transit, re-checking at %s\n", symbol, next.Format(time.RFC3339))
+	w.retrack(symbol, entry, next)
+}
+
+// retrack puts entry back on the watch list at arrival, unless a fresh
+// ScheduleArrival for the same ship landed while it was being confirmed.
+func (w *ArrivalWatcher) retrack(symbol string, entry *watchedArrival, arrival time.Time) {
+	entry.arrival = arrival
+	w.mu.Lock()
+	if _, exists := w.pending[symbol]; !exists {
+		w.pending[symbol] = entry
+	}
+	w.mu.Unlock()
+}
+
+// applyArrival transitions the ship to IN_ORBIT under CAS-retry and publishes
+// ARRIVED, mirroring ShipStateScheduler.handleArrival.
+func (w *ArrivalWatcher) applyArrival(ctx context.Context, symbol string, playerID shared.PlayerID) {
+	freshShip, saved, err := w.shipRepo.SaveWithRetry(ctx, symbol, playerID, arriveIfInTransit)
+	if err != nil {
+		fmt.Printf("Warning: Failed to transition ship %s to orbit: %v\n", symbol, err)
+		return
+	}
+	if !saved {
+		return
+	}
+	fmt.Printf("Ship %s arrived at %s (confirmed)\n", symbol, freshShip.CurrentLocation().Symbol)
+	if w.eventPublisher != nil {
+		w.eventPublisher.PublishArrived(
+			symbol,
+			playerID,
+			freshShip.CurrentLocation().Symbol,
+			freshShip.NavStatus(),
+		)
+	}
+}
+
+// setArrivalIfInTransit returns the SaveWithRetry mutation that moves a
+// still-in-transit hull's arrival time to arrival. It reports changed=false
+// when the hull already left transit or the time is unchanged.
+func setArrivalIfInTransit(arrival time.Time) navigation.ShipMutation {
+	return func(sh *navigation.Ship) (bool, error) {
+		if !sh.IsInTransit() {
+			return false, nil
+		}
+		if current := sh.ArrivalTime(); current != nil && current.Equal(arrival) {
+			return false, nil
+		}
+		sh.SetArrivalTime(arrival)
+		return true, nil
+	}
+}
+
+// SetArrivalWatcher routes ship arrivals through an ArrivalWatcher, which
+// confirms each one with a nav read before transitioning the ship, instead of
+// the timer scheduler's unconfirmed local transition.
+func (s *DaemonServer) SetArrivalWatcher() {
+	if s.shipStateScheduler == nil {
+		return
+	}
+	s.arrivalWatcher = NewArrivalWatcher(s.shipRepo, s.clock, s.shipStateScheduler.eventPublisher)
+	s.shipStateScheduler.SetArrivalWatcher(s.arrivalWatcher)
+}
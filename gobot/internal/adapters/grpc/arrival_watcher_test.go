@@ -0,0 +1,118 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/andrescamacho/spacetraders-go/internal/domain/navigation"
+	"github.com/andrescamacho/spacetraders-go/internal/domain/shared"
+)
+
+// arrivalWatcherRepo serves a scripted nav read and applies SaveWithRetry
+// mutations to one in-memory ship.
+type arrivalWatcherRepo struct {
+	navigation.ShipRepository
+	ship     *navigation.Ship
+	nav      *navigation.ShipNavData
+	navErr   error
+	navCalls int
+}
+
+func (r *arrivalWatcherRepo) GetShipNav(context.Context, string, shared.PlayerID) (*navigation.ShipNavData, error) {
+	r.navCalls++
+	return r.nav, r.navErr
+}
+
+func (r *arrivalWatcherRepo) SaveWithRetry(_ context.Context, _ string, _ shared.PlayerID, mutate navigation.ShipMutation) (*navigation.Ship, bool, error) {
+	changed, err := mutate(r.ship)
+	return r.ship, changed, err
+}
+
+func newInTransitShip(t *testing.T, arrival time.Time) *navigation.Ship {
+	t.Helper()
+	ship := newTestShipWithArrival(t, "TORWIND-3", 7, arrival)
+	_, err := ship.EnsureInOrbit()
+	require.NoError(t, err)
+	dest, err := shared.NewWaypoint("X1-TR-IMPORT", 10, 0)
+	require.NoError(t, err)
+	require.NoError(t, ship.StartTransit(dest))
+	ship.SetArrivalTime(arrival)
+	return ship
+}
+
+func TestArrivalWatcher_ConfirmsDueArrivalWithNavRead(t *testing.T) {
+	now := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+	clock := &shared.MockClock{CurrentTime: now}
+	ship := newInTransitShip(t, now.Add(time.Minute))
+	repo := &arrivalWatcherRepo{ship: ship, nav: &navigation.ShipNavData{NavStatus: string(navigation.NavStatusInOrbit)}}
+	w := NewArrivalWatcher(repo, clock, nil)
+
+	w.ScheduleArrival(ship)
+	delay, ok := w.nextDelay()
+	require.True(t, ok)
+	require.Equal(t, time.Minute+ClockDriftBuffer, delay)
+
+	w.confirmDue(context.Background())
+	require.Equal(t, 0, repo.navCalls, "nothing is due before the arrival")
+
+	clock.Advance(time.Minute + ClockDriftBuffer)
+	w.confirmDue(context.Background())
+	require.Equal(t, 1, repo.navCalls)
+	require.Equal(t, navigation.NavStatusInOrbit, ship.NavStatus())
+	require.Equal(t, 0, w.PendingCount())
+}
+
+func TestArrivalWatcher_FollowsLaterAPIArrival(t *testing.T) {
+	now := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+	clock := &shared.MockClock{CurrentTime: now}
+	ship := newInTransitShip(t, now)
+	later := now.Add(2 * time.Minute)
+	repo := &arrivalWatcherRepo{ship: ship, nav: &navigation.ShipNavData{
+		NavStatus:   string(navigation.NavStatusInTransit),
+		ArrivalTime: later.Format(time.RFC3339),
+	}}
+	w := NewArrivalWatcher(repo, clock, nil)
+
+	w.ScheduleArrival(ship)
+	clock.Advance(ClockDriftBuffer)
+	w.confirmDue(context.Background())
+
+	require.True(t, ship.IsInTransit())
+	require.True(t, ship.ArrivalTime().Equal(later), "the API's arrival is persisted")
+	require.Equal(t, 1, w.PendingCount())
+	delay, _ := w.nextDelay()
+	require.Equal(t, 2*time.Minute, delay)
+}
+
+func TestArrivalWatcher_FallsBackToLocalArrivalAfterRepeatedFailures(t *testing.T) {
+	now := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+	clock := &shared.MockClock{CurrentTime: now}
+	ship := newInTransitShip(t, now)
+	repo := &arrivalWatcherRepo{ship: ship, navErr: errors.New("API unreachable")}
+	w := NewArrivalWatcher(repo, clock, nil)
+
+	w.ScheduleArrival(ship)
+	for i := 0; i < arrivalWatcherMaxFailures; i++ {
+		clock.Advance(ArrivalWatcherRetryDelay + ClockDriftBuffer)
+		w.confirmDue(context.Background())
+	}
+
+	require.Equal(t, arrivalWatcherMaxFailures, repo.navCalls)
+	require.Equal(t, navigation.NavStatusInOrbit, ship.NavStatus())
+	require.Equal(t, 0, w.PendingCount())
+}
+
+func TestShipStateScheduler_ForwardsArrivalsToWatcher(t *testing.T) {
+	s := NewShipStateScheduler(nil, &shared.RealClock{}, nil)
+	w := NewArrivalWatcher(nil, &shared.RealClock{}, nil)
+	s.SetArrivalWatcher(w)
+
+	s.ScheduleArrival(newTestShipWithArrival(t, "TORWIND-4", 7, time.Now().Add(time.Hour)))
+
+	require.Equal(t, 0, s.PendingCount(), "no local timer is armed")
+	require.Equal(t, 1, w.PendingCount())
+}
@@ -3,8 +3,8 @@ package grpc
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
-	"strings"
 	"time"
 
 	"github.com/andrescamacho/spacetraders-go/internal/adapters/api"
@@ -524,24 +524,7 @@ func (a *bootstrapAcquirer) buyWith(ctx context.Context, playerID int, shipType,
 		if err != nil {
 			return bootstrapCmd.BuyResult{}, err
 		}
-		// sp-7r7w: PREFER the exclusive purchasing ship (the pivoted command frigate) when it is idle, so
-		// every cold-start + scaling buy runs through the deterministic, protected buy ship rather than an
-		// incidentally-idle hull. Fall back to any idle hull before the pivot exists (e.g. the DATA probe
-		// buy) or if the purchasing ship is momentarily busy.
-		for _, s := range ships {
-			if s.IsIdle() && s.DedicatedFleet() == navigation.PurchasingFleet {
-				purchaser = s.ShipSymbol()
-				break
-			}
-		}
-		if purchaser == "" {
-			for _, s := range ships {
-				if s.IsIdle() {
-					purchaser = s.ShipSymbol()
-					break
-				}
-			}
-		}
+		purchaser = idlePurchaser(ships)
 		if purchaser == "" {
 			return bootstrapCmd.BuyResult{}, fmt.Errorf("no idle hull available to execute the purchase")
 		}
@@ -566,6 +549,25 @@ func (a *bootstrapAcquirer) buyWith(ctx context.Context, playerID int, shipType,
 	return bootstrapCmd.BuyResult{ShipSymbol: bought.ShipSymbol(), Price: int64(batch.TotalCost)}, nil
 }
 
+// idlePurchaser picks the hull to fly a buy. sp-7r7w: PREFER the exclusive purchasing ship (the
+// pivoted command frigate) when it is idle, so every cold-start + scaling buy runs through the
+// deterministic, protected buy ship rather than an incidentally-idle hull. Fall back to any idle hull
+// before the pivot exists (e.g. the DATA probe buy) or if the purchasing ship is momentarily busy.
+// Returns "" when no hull is idle.
+func idlePurchaser(ships []*navigation.Ship) string {
+	for _, s := range ships {
+		if s.IsIdle() && s.DedicatedFleet() == navigation.PurchasingFleet {
+			return s.ShipSymbol()
+		}
+	}
+	for _, s := range ships {
+		if s.IsIdle() {
+			return s.ShipSymbol()
+		}
+	}
+	return ""
+}
+
 // --- hauler acquirer (INCOME: reuse the probe price-check + buy, then dedicate + place on the hub) ---
 
 // bootstrapHaulerAcquirer embeds the DATA-phase acquirer to reuse its cheapest-yard PriceCheck and the
@@ -663,7 +665,7 @@ type bootstrapFrigateContractLoop struct{ server *DaemonServer }
 // double-claim the frigate while the loop holds it (RULINGS #7).
 func (r *bootstrapFrigateContractLoop) StartLoop(ctx context.Context, playerID int, frigateSymbol string) error {
 	if _, err := r.server.BatchContractWorkflow(ctx, frigateSymbol, playerID, -1); err != nil {
-		if strings.Contains(err.Error(), "already running") {
+		if errors.Is(err, shared.ErrContainerAlreadyRunning) {
 			return nil
 		}
 		return err
@@ -0,0 +1,61 @@
+package grpc
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/andrescamacho/spacetraders-go/internal/domain/ledger"
+	"github.com/andrescamacho/spacetraders-go/internal/domain/shared"
+	"github.com/andrescamacho/spacetraders-go/internal/infrastructure/supervise"
+)
+
+// CashflowAlertChecker evaluates the ledger cashflow thresholds for a player
+// and delivers any alerts (implemented by the ledger CashflowAlerter).
+type CashflowAlertChecker interface {
+	Check(ctx context.Context, playerID shared.PlayerID) ([]ledger.CashflowAlert, error)
+}
+
+// SetCashflowAlerter arms cashflow alerting: Start launches a loop checking the
+// live player's ledger every interval. Must be called before Start; leaving it
+// unset keeps alerting off.
+func (s *DaemonServer) SetCashflowAlerter(checker CashflowAlertChecker, interval time.Duration) {
+	if checker == nil || interval <= 0 {
+		return
+	}
+	s.cashflowAlerter = checker
+	s.cashflowAlertInterval = interval
+}
+
+// runCashflowAlerts checks the cashflow thresholds every interval until ctx is
+// canceled. The tick body runs under supervise.Guard so one bad check cannot
+// kill the loop.
+func (s *DaemonServer) runCashflowAlerts(ctx context.Context) error {
+	ticker := time.NewTicker(s.cashflowAlertInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+			supervise.Guard("cashflow-alerts", func() {
+				s.checkCashflowAlerts(ctx)
+			})
+		}
+	}
+}
+
+func (s *DaemonServer) checkCashflowAlerts(ctx context.Context) {
+	pid := s.primaryPlayerID(ctx)
+	if pid == 0 {
+		return
+	}
+	playerID, err := shared.NewPlayerID(pid)
+	if err != nil {
+		log.Printf("Cashflow alert check: resolve primary player id %d: %v", pid, err)
+		return
+	}
+	if _, err := s.cashflowAlerter.Check(ctx, playerID); err != nil {
+		log.Printf("Cashflow alert check failed: %v", err)
+	}
+}
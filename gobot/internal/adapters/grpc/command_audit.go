@@ -0,0 +1,16 @@
+package grpc
+
+import "context"
+
+// CommandAuditWriter drains the mediator's command audit queue into storage
+// until ctx is canceled (implemented by the audit CommandAuditRecorder).
+type CommandAuditWriter interface {
+	Run(ctx context.Context) error
+}
+
+// SetCommandAuditWriter arms command auditing: Start runs the writer under
+// supervision. Must be called before Start; leaving it unset means queued
+// records are never written, so only wire it alongside the audit middleware.
+func (s *DaemonServer) SetCommandAuditWriter(writer CommandAuditWriter) {
+	s.commandAudit = writer
+}
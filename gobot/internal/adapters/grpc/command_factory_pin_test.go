@@ -53,10 +53,11 @@ func TestRecoveryFactoryRebuildsCommandFromLaunchConfig(t *testing.T) {
 				"iterations":  3,
 			},
 			want: &scoutingCmd.ScoutTourCommand{
-				PlayerID:   pid,
-				ShipSymbol: "SHIP-A",
-				Markets:    []string{"M1", "M2"},
-				Iterations: 3,
+				PlayerID:    pid,
+				ShipSymbol:  "SHIP-A",
+				Markets:     []string{"M1", "M2"},
+				Iterations:  3,
+				ContainerID: "scout-1",
 			},
 		},
 		{
@@ -82,6 +83,7 @@ func TestRecoveryFactoryRebuildsCommandFromLaunchConfig(t *testing.T) {
 				Markets:      []string{"M1"},
 				Iterations:   2,
 				ScanInterval: 600 * time.Second,
+				ContainerID:  "scout-2",
 			},
 		},
 		{
@@ -188,11 +190,12 @@ func TestRecoveryFactoryRebuildsCommandFromLaunchConfig(t *testing.T) {
 			commandType: "batch_purchase_ships",
 			containerID: "batch-1",
 			launchConfig: map[string]interface{}{
-				"ship_symbol": "SHIP-A",
-				"ship_type":   "SHIP_MINING_DRONE",
-				"quantity":    4,
-				"max_budget":  120000,
-				"shipyard":    "WP-YARD",
+				"ship_symbol":    "SHIP-A",
+				"ship_type":      "SHIP_MINING_DRONE",
+				"quantity":       4,
+				"max_budget":     120000,
+				"shipyard":       "WP-YARD",
+				"failure_policy": "rollback",
 			},
 			want: &shipyardCmd.BatchPurchaseShipsCommand{
 				PurchasingShipSymbol: "SHIP-A",
@@ -201,6 +204,7 @@ func TestRecoveryFactoryRebuildsCommandFromLaunchConfig(t *testing.T) {
 				MaxBudget:            120000,
 				PlayerID:             pid,
 				ShipyardWaypoint:     "WP-YARD",
+				FailurePolicy:        shipyardCmd.BatchFailureRollback,
 			},
 		},
 		{
@@ -595,6 +599,35 @@ func TestRecoveryFactoryRebuildsCommandFromLaunchConfig(t *testing.T) {
 				RefillHysteresis:  8,
 			},
 		},
+		{
+			// A contract mining sub-operation rebuilds its whole crew on restart: the
+			// transport from ship_symbol, the miners from miner_ships (JSON []string →
+			// []interface{} pinned).
+			name:        "contract_mining",
+			commandType: "contract_mining",
+			containerID: "contract-mining-1",
+			launchConfig: map[string]interface{}{
+				"ship_symbol":  "SHIP-T",
+				"miner_ships":  []string{"SHIP-M1", "SHIP-M2"},
+				"contract_id":  "C-1",
+				"good":         "IRON_ORE",
+				"units":        60,
+				"asteroid":     "X1-A-B7",
+				"destination":  "X1-A-H1",
+				"container_id": "contract-mining-1",
+				"operation":    "contract",
+			},
+			want: &contractCmd.RunContractMiningCommand{
+				PlayerID:      pid,
+				ContainerID:   "contract-mining-1",
+				ContractID:    "C-1",
+				Good:          "IRON_ORE",
+				Asteroid:      "X1-A-B7",
+				Destination:   "X1-A-H1",
+				MinerShips:    []string{"SHIP-M1", "SHIP-M2"},
+				TransportShip: "SHIP-T",
+			},
+		},
 	}
 
 	for _, tc := range cases {
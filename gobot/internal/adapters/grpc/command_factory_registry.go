@@ -19,6 +19,8 @@ import (
 	shipTypesCmd "github.com/andrescamacho/spacetraders-go/internal/application/ship/types"
 	shipyardCmd "github.com/andrescamacho/spacetraders-go/internal/application/shipyard/commands"
 	storageCmd "github.com/andrescamacho/spacetraders-go/internal/application/storage/commands"
+	systemCmd "github.com/andrescamacho/spacetraders-go/internal/application/system/commands"
+	tankerCmd "github.com/andrescamacho/spacetraders-go/internal/application/tanker/commands"
 	tradingCmd "github.com/andrescamacho/spacetraders-go/internal/application/trading/commands"
 	manufacturingDomain "github.com/andrescamacho/spacetraders-go/internal/domain/manufacturing"
 	"github.com/andrescamacho/spacetraders-go/internal/domain/shared"
@@ -257,7 +259,13 @@ type ContainerSpec struct {
 	// restart rebuild can never hand a coordinator-owned budget to the runner
 	// loop. See containerSpecList for the full per-type semantics table.
 	CoordinatorOwnsIterations bool
-	build                     func(cfg *configReader, playerID int, containerID string) interface{}
+	// DependsOn names the containers — by command type or container ID — this type
+	// needs running before it starts. Restart recovery starts containers in
+	// dependency order and parks a container BLOCKED instead of starting it when a
+	// dependency did not come up; a dependency failing at runtime blocks its running
+	// dependents (see container_dependencies.go).
+	DependsOn []string
+	build     func(cfg *configReader, playerID int, containerID string) interface{}
 }
 
 func (spec ContainerSpec) BuildCommand(config map[string]interface{}, playerID int, containerID string) (interface{}, error) {
@@ -315,6 +323,9 @@ func (spec ContainerSpec) BuildCommand(config map[string]interface{}, playerID i
 //	contract_workflow           one contract      coordinator   re-adopts standalone; worker
 //	                                                            (coordinator_id) waits for parent
 //	contract_fleet_coordinator  ∞ internal loop   coordinator   re-adopts
+//	contract_mining             one delivery      coordinator   re-adopts; re-reads the delivery's
+//	                                                            remaining units and resumes mining
+//	                                                            (cargo already aboard is kept)
 //	purchase_ship               one purchase      coordinator   re-adopts (idempotence at API)
 //	batch_purchase_ships        one batch         coordinator   re-adopts
 //	goods_factory_coordinator   one cycle         RUNNER        re-adopts with persisted budget
@@ -366,14 +377,19 @@ func containerSpecList() []ContainerSpec {
 		{CommandType: "frontier_expansion_coordinator", build: buildFrontierExpansionCoordinatorCommand},
 		{CommandType: "market_freshness_sizer_coordinator", build: buildMarketFreshnessSizerCoordinatorCommand},
 		{CommandType: "shipyard_backfill_coordinator", build: buildShipyardBackfillCoordinatorCommand},
+		{CommandType: "shipyard_watch_coordinator", build: buildShipyardWatchCoordinatorCommand},
+		{CommandType: "probe_parking_coordinator", build: buildProbeParkingCoordinatorCommand},
+		{CommandType: "tanker_coordinator", build: buildTankerCoordinatorCommand},
 		{CommandType: "scout_reposition", build: buildScoutRepositionCommand, CoordinatorOwnsIterations: true},
 		{CommandType: "contract_workflow", build: buildContractWorkflowCommand},
 		{CommandType: "contract_fleet_coordinator", build: buildContractFleetCoordinatorCommand},
 		// trade_fleet_coordinator (sp-1278): a standing coordinator that loops forever
 		// inside one Handle() call, so — like scout_post/contract_fleet — it is NOT a
 		// CoordinatorOwnsIterations type; the container-level iteration budget (-1) is
-		// irrelevant because Handle() never returns.
-		{CommandType: "trade_fleet_coordinator", build: buildTradeFleetCoordinatorCommand},
+		// irrelevant because Handle() never returns. It plans tours off scouted market
+		// prices, so it starts after the scout_post_coordinator that keeps them fresh.
+		{CommandType: "trade_fleet_coordinator", build: buildTradeFleetCoordinatorCommand,
+			DependsOn: []string{"scout_post_coordinator"}},
 		// worker_rebalancer_coordinator (sp-f5pr): a standing coordinator that loops
 		// forever inside one Handle() call, so — like trade_fleet/scout_post — it is NOT a
 		// CoordinatorOwnsIterations type. worker_ferry is its one-shot cross-system relay
@@ -386,6 +402,10 @@ func containerSpecList() []ContainerSpec {
 		// coordinator owns re-dispatch, so the container wraps exactly ONE iteration
 		// (CoordinatorOwnsIterations).
 		{CommandType: "cargo_liquidation", build: buildCargoLiquidationCommand, CoordinatorOwnsIterations: true},
+		// contract_mining: the contract fleet coordinator's procurement-by-mining
+		// sub-operation. It loops mine → transfer → deliver inside one Handle() until the
+		// delivery is met, so the container wraps exactly ONE iteration.
+		{CommandType: "contract_mining", build: buildContractMiningCommand, CoordinatorOwnsIterations: true},
 		{CommandType: "purchase_ship", build: buildPurchaseShipCommand},
 		{CommandType: "batch_purchase_ships", build: buildBatchPurchaseShipsCommand},
 		{CommandType: "goods_factory_coordinator", build: buildGoodsFactoryCoordinatorCommand},
@@ -406,6 +426,10 @@ func containerSpecList() []ContainerSpec {
 		// fleet_autosizer/siting it loops forever inside one Handle(), so it is NOT a
 		// CoordinatorOwnsIterations type; the container-level budget (-1) is irrelevant.
 		{CommandType: "bootstrap", build: buildBootstrapCommand},
+		// bootstrap_fleet: a one-shot fleet template application. It buys the profile's
+		// shortfall and launches its containers inside one Handle(), so the container wraps
+		// exactly ONE iteration; a re-run only buys what is still missing.
+		{CommandType: "bootstrap_fleet", build: buildBootstrapFleetCommand, CoordinatorOwnsIterations: true},
 		// capacity_reconciler_coordinator (st-7zk): the standing capacity reconciler. Like
 		// fleet_autosizer/siting it loops forever inside one Handle(), so it is NOT a
 		// CoordinatorOwnsIterations type; the container-level budget (-1) is irrelevant.
@@ -436,6 +460,7 @@ func containerSpecList() []ContainerSpec {
 		{CommandType: "refuel_ship", build: buildRefuelShipCommand, CoordinatorOwnsIterations: true},
 		{CommandType: "jettison_cargo", build: buildJettisonCargoCommand, CoordinatorOwnsIterations: true},
 		{CommandType: "scout_fleet_assignment", build: buildScoutFleetAssignmentCommand, CoordinatorOwnsIterations: true},
+		{CommandType: "system_warmup", build: buildWarmSystemCommand, CoordinatorOwnsIterations: true},
 		{CommandType: "gas_siphon_worker", IsWorker: true},
 		{CommandType: "storage_ship", IsWorker: true},
 	}
@@ -555,6 +580,8 @@ func buildScoutTourCommand(cfg *configReader, playerID int, containerID string)
 		Iterations:         iterations,
 		ScanInterval:       time.Duration(cfg.OptionalInt("scan_interval_secs", 0)) * time.Second,
 		StartJitterMaxSecs: cfg.OptionalInt("tour_start_jitter_max_seconds", 0),
+		DockAtPost:         cfg.OptionalBool("dock_at_post"),
+		ContainerID:        containerID,
 	}
 }
 
@@ -637,6 +664,11 @@ func buildTradeFleetCoordinatorCommand(cfg *configReader, playerID int, containe
 		// 30-min default.
 		ReapStaleCaptainReservationsEnabled: cfg.OptionalBool("trade_fleet_reap_stale_captain_reservations_enabled"),
 		ReapIdleThresholdSecs:               cfg.OptionalInt("trade_fleet_reap_idle_threshold_secs", 0),
+		// Dynamic sizing: off unless a max is set. The window is minutes on config, seconds on
+		// the command, converted here like the relaunch-backoff ceiling.
+		MinFleetSize:       cfg.OptionalInt("trade_fleet_min_size", 0),
+		MaxFleetSize:       cfg.OptionalInt("trade_fleet_max_size", 0),
+		SizingIntervalSecs: cfg.OptionalInt("trade_fleet_sizing_interval_minutes", 0) * 60,
 	}
 }
 
@@ -695,6 +727,23 @@ func buildWorkerFerryCommand(cfg *configReader, playerID int, containerID string
 // restart is safe: the worker reconciles the hull against the server, so an already-cleared
 // hold is an idempotent no-op. min_jettison_value defaults to 0 (jettison OFF — never
 // destroy value without an explicit floor, RULINGS #5).
+// buildContractMiningCommand rebuilds a contract mining sub-operation from its persisted
+// launch config. Resuming after a restart is safe: the command re-reads the delivery's
+// remaining units from the contract, and units already aboard the transport count toward
+// its next load.
+func buildContractMiningCommand(cfg *configReader, playerID int, containerID string) interface{} {
+	return &contractCmd.RunContractMiningCommand{
+		PlayerID:      shared.MustNewPlayerID(playerID),
+		ContainerID:   containerID,
+		ContractID:    cfg.RequiredString("contract_id"),
+		Good:          cfg.RequiredString("good"),
+		Asteroid:      cfg.RequiredString("asteroid"),
+		Destination:   cfg.RequiredString("destination"),
+		MinerShips:    cfg.RequiredStringSlice("miner_ships"),
+		TransportShip: cfg.RequiredString("ship_symbol"),
+	}
+}
+
 func buildCargoLiquidationCommand(cfg *configReader, playerID int, containerID string) interface{} {
 	return &liquidationCmd.LiquidateCargoCommand{
 		PlayerID:         shared.MustNewPlayerID(playerID),
@@ -795,6 +844,51 @@ func buildShipyardBackfillCoordinatorCommand(cfg *configReader, playerID int, co
 	}
 }
 
+// buildShipyardWatchCoordinatorCommand rebuilds the standing shipyard watch from its
+// persisted launch config so restart recovery re-adopts it. The watched targets come from
+// config.yaml [shipyard_watch] through the handler, not the container config, so an edit +
+// restart retunes a recovered watch.
+func buildShipyardWatchCoordinatorCommand(cfg *configReader, playerID int, containerID string) interface{} {
+	return &shipyardCmd.RunShipyardWatchCoordinatorCommand{
+		PlayerID:         shared.MustNewPlayerID(playerID),
+		ContainerID:      cfg.RequiredNonEmptyString("container_id"),
+		TickIntervalSecs: cfg.OptionalInt("tick_interval_secs", 0),
+	}
+}
+
+// buildProbeParkingCoordinatorCommand rebuilds the standing probe-parking coordinator from
+// its persisted launch config so restart recovery re-adopts it. Like the backfill sweep it
+// is a reconcile-loop coordinator (NOT a CoordinatorOwnsIterations type); every knob is
+// optional (0 → the coordinator's own default). The parked tours themselves are standalone
+// scout_tour containers and recover on their own.
+func buildProbeParkingCoordinatorCommand(cfg *configReader, playerID int, containerID string) interface{} {
+	return &scoutingCmd.ParkProbesCommand{
+		PlayerID:          shared.MustNewPlayerID(playerID),
+		ContainerID:       cfg.RequiredNonEmptyString("container_id"),
+		TickIntervalSecs:  cfg.OptionalInt("tick_interval_secs", 0),
+		ScanIntervalSecs:  cfg.OptionalInt("scan_interval_secs", 0),
+		ReserveIdleProbes: cfg.OptionalInt("reserve_idle_probes", 0),
+	}
+}
+
+// buildTankerCoordinatorCommand rebuilds the standing tanker coordinator from its
+// persisted launch config so restart recovery re-adopts it, tankers and all. A
+// reconcile-loop coordinator (NOT a CoordinatorOwnsIterations type); the tanker list or
+// tag is identity, every other knob is optional (empty/0 → the coordinator's default).
+func buildTankerCoordinatorCommand(cfg *configReader, playerID int, containerID string) interface{} {
+	return &tankerCmd.RunTankerCoordinatorCommand{
+		PlayerID:         shared.MustNewPlayerID(playerID),
+		ContainerID:      cfg.RequiredNonEmptyString("container_id"),
+		TankerShips:      cfg.OptionalStringSlice("tanker_ships"),
+		TankerShipTag:    cfg.OptionalString("tanker_ship_tag"),
+		Stations:         cfg.OptionalStringSlice("stations"),
+		FuelSource:       cfg.OptionalString("fuel_source"),
+		RefuelBelowPct:   cfg.OptionalInt("refuel_below_pct", 0),
+		ReloadBelowUnits: cfg.OptionalInt("reload_below_units", 0),
+		TickIntervalSecs: cfg.OptionalInt("tick_interval_secs", 0),
+	}
+}
+
 // buildAutoOutfitCoordinatorCommand rebuilds the standing guarded auto-outfit coordinator
 // from its persisted launch config so restart recovery re-adopts it byte-identically
 // (RULINGS #2, sp-buyd). Like the autosizer it is a reconcile-loop coordinator (NOT a
@@ -857,11 +951,16 @@ func buildScoutRepositionCommand(cfg *configReader, playerID int, containerID st
 // RouteExecutor waits out a transit already in progress (the boot-time
 // ShipStateScheduler.ScheduleAllPending re-arms the arrival timer).
 func buildNavigateShipCommand(cfg *configReader, playerID int, containerID string) interface{} {
-	return &shipNavCmd.NavigateRouteCommand{
-		ShipSymbol:  cfg.RequiredString("ship_symbol"),
-		Destination: cfg.RequiredString("destination"),
-		PlayerID:    shared.MustNewPlayerID(playerID),
+	cmd := &shipNavCmd.NavigateRouteCommand{
+		ShipSymbol:     cfg.RequiredString("ship_symbol"),
+		Destination:    cfg.RequiredString("destination"),
+		PlayerID:       shared.MustNewPlayerID(playerID),
+		IdempotencyKey: cfg.OptionalString(idempotencyKeyConfigKey),
 	}
+	if percent, ok := cfg.PresentInt(fuelReservePercentConfigKey); ok {
+		cmd.FuelReservePercent = &percent
+	}
+	return cmd
 }
 
 // buildRouteShipCommand rebuilds a one-shot cross-system route from its persisted
@@ -877,6 +976,18 @@ func buildRouteShipCommand(cfg *configReader, playerID int, containerID string)
 	}
 }
 
+// buildWarmSystemCommand rebuilds a system warm-up from its persisted launch config.
+// Re-running after a restart is cheap: the waypoint graph is already cached and
+// markets scanned within market_max_age_secs are skipped.
+func buildWarmSystemCommand(cfg *configReader, playerID int, containerID string) interface{} {
+	return &systemCmd.WarmSystemCommand{
+		SystemSymbol: cfg.RequiredNonEmptyString("system_symbol"),
+		PlayerID:     shared.MustNewPlayerID(playerID),
+		ContainerID:  containerID,
+		MarketMaxAge: time.Duration(cfg.OptionalInt("market_max_age_secs", 0)) * time.Second,
+	}
+}
+
 // buildDockShipCommand / buildOrbitShipCommand / buildRefuelShipCommand rebuild
 // the remaining one-shot ship ops (sp-7yej invariant 4). All are idempotent to
 // re-run after a restart: docking a docked ship, orbiting an orbiting ship and
@@ -925,11 +1036,13 @@ func buildJettisonCargoCommand(cfg *configReader, playerID int, containerID stri
 
 // buildScoutFleetAssignmentCommand rebuilds the async VRP fleet-assignment pass
 // (sp-7yej invariant 4). Re-running the assignment after a restart is safe —
-// it recomputes routes from current fleet/market state and claims no hull.
+// it recomputes routes from current fleet/market state and claims no hull. A
+// persisted freshness_sla_secs resumes the standing market refresh loop.
 func buildScoutFleetAssignmentCommand(cfg *configReader, playerID int, containerID string) interface{} {
 	return &scoutingCmd.AssignScoutingFleetCommand{
 		PlayerID:     shared.MustNewPlayerID(playerID),
 		SystemSymbol: cfg.RequiredString("system_symbol"),
+		FreshnessSLA: time.Duration(cfg.OptionalInt("freshness_sla_secs", 0)) * time.Second,
 	}
 }
 
@@ -971,6 +1084,9 @@ func buildContractFleetCoordinatorCommand(cfg *configReader, playerID int, conta
 		// are dead and a config edit + restart retunes a recovered coordinator.
 		AutoLiquidationDisabled:     cfg.OptionalBool("auto_liquidation_disabled"),
 		LiquidationMinJettisonValue: cfg.OptionalInt("liquidation_min_jettison_value", 0),
+		// Procurement-by-mining: absent key → false → ON wherever idle
+		// excavators share the contract's home system.
+		MiningProcurementDisabled: cfg.OptionalBool("mining_procurement_disabled"),
 		// Idle-gap arb knobs (sp-1z2h): absent keys → 0 → the contract
 		// package's documented defaults (IdleArbConfig.WithDefaults). These
 		// keys are resolved LIVE from config.yaml by resolveIdleArbConfig on
@@ -996,6 +1112,10 @@ func buildContractFleetCoordinatorCommand(cfg *configReader, playerID int, conta
 		IdleArbMinNetProfit:    cfg.OptionalInt("idle_arb_min_net_profit", 0),
 		IdleArbNetProfitPct:    cfg.OptionalInt("idle_arb_net_profit_pct", 0),
 		IdleArbFuelCostPerUnit: cfg.OptionalInt("idle_arb_fuel_cost_per_unit", 0),
+		// Anti-starvation policy (0 → off; relaxed floor 0s → WithDefaults: 25/u, 5%).
+		IdleArbStarvationAfterSecs:    cfg.OptionalInt("idle_arb_starvation_after_secs", 0),
+		IdleArbStarvationMinNetProfit: cfg.OptionalInt("idle_arb_starvation_min_net_profit", 0),
+		IdleArbStarvationNetProfitPct: cfg.OptionalInt("idle_arb_starvation_net_profit_pct", 0),
 	}
 }
 
@@ -1016,7 +1136,28 @@ func buildBatchPurchaseShipsCommand(cfg *configReader, playerID int, containerID
 		MaxBudget:            cfg.RequiredInt("max_budget"),
 		PlayerID:             shared.MustNewPlayerID(playerID),
 		ShipyardWaypoint:     cfg.OptionalString("shipyard"),
+		MaxPrice:             cfg.OptionalInt("max_price", 0),
+		WaitForDip:           cfg.OptionalBool("wait_for_dip"),
+		DipDeadline:          optionalDipDeadline(cfg),
+		DipPollInterval:      time.Duration(cfg.OptionalInt("dip_poll_secs", 0)) * time.Second,
+		Loadout:              cfg.OptionalString("loadout"),
+		FailurePolicy:        shipyardCmd.BatchFailurePolicy(cfg.OptionalString("failure_policy")),
+	}
+}
+
+// optionalDipDeadline reads the absolute RFC3339 dip_deadline, so a recovered
+// wait-for-dip batch keeps its original deadline instead of restarting the
+// clock. Absent → zero (the handler's default timeout); unparseable → fail.
+func optionalDipDeadline(cfg *configReader) time.Time {
+	raw := cfg.OptionalString("dip_deadline")
+	if raw == "" {
+		return time.Time{}
+	}
+	deadline, err := time.Parse(time.RFC3339, raw)
+	if err != nil {
+		cfg.fail("dip_deadline")
 	}
+	return deadline
 }
 
 // buildConstructionCoordinatorCommand rebuilds the standing construction-supply drain command
@@ -0,0 +1,135 @@
+package grpc
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/http"
+	"os"
+	"os/signal"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/andrescamacho/spacetraders-go/internal/infrastructure/config"
+	"github.com/andrescamacho/spacetraders-go/internal/infrastructure/supervise"
+)
+
+// SetConfigReloader arms config hot-reload: Start launches a loop that reloads
+// the config file on SIGHUP and, when checkInterval > 0, whenever the file's
+// modification time moves. Must be called before Start; leaving it unset (or
+// passing a reloader with no file) keeps the boot config for the daemon's life.
+func (s *DaemonServer) SetConfigReloader(reloader *config.Reloader, checkInterval time.Duration) {
+	if reloader == nil || !reloader.Enabled() {
+		return
+	}
+	s.configReloader = reloader
+	s.configReloadInterval = checkInterval
+}
+
+// runConfigReload waits for SIGHUP or a file-check tick until ctx is canceled.
+// Each reload runs under supervise.Guard so a panicking subscriber cannot kill
+// the loop.
+func (s *DaemonServer) runConfigReload(ctx context.Context) error {
+	hup := make(chan os.Signal, 1)
+	signal.Notify(hup, syscall.SIGHUP)
+	defer signal.Stop(hup)
+
+	var tick <-chan time.Time
+	if s.configReloadInterval > 0 {
+		ticker := time.NewTicker(s.configReloadInterval)
+		defer ticker.Stop()
+		tick = ticker.C
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-hup:
+			supervise.Guard("config-reload", func() {
+				result, err := s.configReloader.Reload()
+				logConfigReload("SIGHUP", s.configReloader.Path(), result, err)
+			})
+		case <-tick:
+			supervise.Guard("config-reload", func() {
+				result, err := s.configReloader.ReloadIfChanged()
+				if result == nil && err == nil {
+					return
+				}
+				logConfigReload("file change", s.configReloader.Path(), result, err)
+			})
+		}
+	}
+}
+
+func logConfigReload(trigger, path string, result *config.ReloadResult, err error) {
+	if err != nil {
+		log.Printf("Config reload (%s) failed, keeping the running config: %v", trigger, err)
+		return
+	}
+	changed := "none"
+	if len(result.Changed) > 0 {
+		changed = strings.Join(result.Changed, ", ")
+	}
+	log.Printf("Config reloaded from %s (%s): changed sections [%s]; applied live by [%s]; other changes take effect at the next restart",
+		path, trigger, changed, strings.Join(result.Applied, ", "))
+	for name, subErr := range result.Failed {
+		log.Printf("Config reload: %s kept its previous settings: %v", name, subErr)
+	}
+}
+
+// ApplyMetricsConfig moves the Prometheus endpoint to next's host, port and
+// path without a restart. Collectors are registered at boot only, so turning
+// metrics on or off is refused and needs a restart. On a failed rebind the
+// previous endpoint keeps serving.
+func (s *DaemonServer) ApplyMetricsConfig(next config.MetricsConfig) error {
+	s.metricsMu.Lock()
+	defer s.metricsMu.Unlock()
+
+	prev := s.metricsConfig
+	wasEnabled := prev != nil && prev.Enabled
+	if wasEnabled != next.Enabled {
+		return fmt.Errorf("metrics.enabled changed to %t; turning metrics on or off needs a restart", next.Enabled)
+	}
+	if !wasEnabled || (prev.Host == next.Host && prev.Port == next.Port && prev.Path == next.Path) {
+		s.metricsConfig = &next
+		return nil
+	}
+
+	// The same address cannot be bound twice, so a path-only change frees the
+	// old listener first; a new address is bound before the old one closes.
+	old := s.metricsServer
+	sameAddr := prev.Host == next.Host && prev.Port == next.Port
+	if sameAddr {
+		shutdownHTTPServer(old)
+	}
+	s.metricsConfig = &next
+	if err := s.startMetricsServer(); err != nil {
+		s.metricsConfig = prev
+		if sameAddr {
+			if restoreErr := s.startMetricsServer(); restoreErr != nil {
+				return fmt.Errorf("%w (and restoring %s:%d failed: %v)", err, prev.Host, prev.Port, restoreErr)
+			}
+		} else {
+			s.metricsServer = old
+		}
+		return err
+	}
+	if !sameAddr {
+		shutdownHTTPServer(old)
+	}
+	log.Printf("Metrics server moved to %s:%d%s", next.Host, next.Port, next.Path)
+	return nil
+}
+
+func shutdownHTTPServer(server *http.Server) {
+	if server == nil {
+		return
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	if err := server.Shutdown(ctx); err != nil {
+		log.Printf("Error shutting down metrics server: %v", err)
+	}
+}
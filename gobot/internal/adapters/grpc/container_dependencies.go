@@ -0,0 +1,175 @@
+package grpc
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/andrescamacho/spacetraders-go/internal/adapters/persistence"
+	"github.com/andrescamacho/spacetraders-go/internal/domain/container"
+)
+
+// dependencyNode builds the dependency-graph node for a persisted container from
+// its spec's DependsOn.
+func (s *DaemonServer) dependencyNode(containerModel *persistence.ContainerModel) container.DependencyNode {
+	return container.DependencyNode{
+		ID:          containerModel.ID,
+		CommandType: containerModel.CommandType,
+		DependsOn:   s.containerSpecs[containerModel.CommandType].DependsOn,
+	}
+}
+
+// recoveryCandidate is a container the recovery pass intends to restart, with its
+// parsed config.
+type recoveryCandidate struct {
+	model  *persistence.ContainerModel
+	config map[string]interface{}
+}
+
+// recoverInDependencyOrder restarts the candidates so every container starts after
+// the containers it depends on. A container whose dependency did not come up — it
+// failed recovery or is itself blocked — is marked BLOCKED instead of started, as
+// is every container on or behind a dependency cycle. Blocked containers are
+// exempt from the lost diff: the dependency that failed is the one announced.
+// Returns how many were blocked.
+func (s *DaemonServer) recoverInDependencyOrder(
+	ctx context.Context,
+	candidates []recoveryCandidate,
+	recovered, exempt map[string]bool,
+	failReason map[string]recoveryLoss,
+) int {
+	byID := make(map[string]recoveryCandidate, len(candidates))
+	nodes := make([]container.DependencyNode, 0, len(candidates))
+	for _, c := range candidates {
+		byID[c.model.ID] = c
+		nodes = append(nodes, s.dependencyNode(c.model))
+	}
+	ordered, cyclic := container.OrderByDependencies(nodes)
+
+	blocked := 0
+	for _, node := range cyclic {
+		s.markContainerBlocked(ctx, byID[node.ID].model, "dependency_cycle",
+			fmt.Sprintf("dependencies [%s] form a cycle and cannot be started in order", strings.Join(node.DependsOn, ", ")))
+		exempt[node.ID] = true
+		blocked++
+	}
+
+	for _, node := range ordered {
+		c := byID[node.ID]
+		if unmet := container.UnmetDependencies(node, nodes, recovered); len(unmet) > 0 {
+			s.markContainerBlocked(ctx, c.model, "dependency_unavailable",
+				fmt.Sprintf("waiting on [%s], which did not start", strings.Join(unmet, ", ")))
+			exempt[node.ID] = true
+			blocked++
+			continue
+		}
+
+		// Recover using generic recovery with command factory
+		if err := s.recoverContainer(ctx, c.model, c.config); err != nil {
+			fmt.Printf("Container %s: Recovery failed: %v\n", c.model.ID, err)
+			s.markContainerFailed(ctx, c.model, "recovery_failed", err.Error())
+			failReason[c.model.ID] = recoveryLoss{
+				id: c.model.ID, commandType: c.model.CommandType,
+				playerID: c.model.PlayerID,
+				reason:   fmt.Sprintf("recovery_failed: %v", err),
+			}
+		} else {
+			recovered[c.model.ID] = true
+		}
+	}
+	return blocked
+}
+
+// markContainerBlocked parks a container BLOCKED because a dependency is not
+// running, and releases its ships so they are not held by a container that is not
+// working. The next recovery pass re-evaluates it and starts it once the
+// dependency is up.
+func (s *DaemonServer) markContainerBlocked(ctx context.Context, containerModel *persistence.ContainerModel, reason string, details string) {
+	fmt.Printf("Container %s: Blocked (%s: %s)\n", containerModel.ID, reason, details)
+
+	now := time.Now()
+	if err := s.containerRepo.UpdateStatus(
+		ctx,
+		containerModel.ID,
+		containerModel.PlayerID,
+		container.ContainerStatusBlocked,
+		&now, // stoppedAt
+		nil,  // exitCode - nil, the container did not fail
+		fmt.Sprintf("%s: %s", reason, details),
+	); err != nil {
+		fmt.Printf("Warning: Failed to mark container %s as BLOCKED: %v\n", containerModel.ID, err)
+	}
+
+	s.releaseContainerShips(ctx, containerModel, reason)
+}
+
+// ContainerFailed implements ContainerFailureListener: it blocks the running
+// containers that depend on the one that just failed. It works in the background
+// because blocking a dependent waits for that dependent's goroutine to exit, which
+// must not hold up the failing runner's own exit.
+func (s *DaemonServer) ContainerFailed(containerID string, playerID int) {
+	go s.blockDependentsOf(containerID, playerID)
+}
+
+// blockDependentsOf stops every running container left with an unmet dependency
+// once failedID is down, marking it BLOCKED. A dependent still served by another
+// running container of the same type keeps running. Blocking cascades: a blocked
+// container's own dependents are re-checked in turn.
+func (s *DaemonServer) blockDependentsOf(failedID string, playerID int) {
+	if s.containerRepo == nil {
+		return
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	failedModel, err := s.containerRepo.Get(ctx, failedID, playerID)
+	if err != nil || failedModel == nil {
+		return
+	}
+	runningModels, err := s.containerRepo.ListByStatus(ctx, container.ContainerStatusRunning, &playerID)
+	if err != nil {
+		fmt.Printf("Warning: failed to list running containers to block dependents of %s: %v\n", failedID, err)
+		return
+	}
+
+	known := []container.DependencyNode{s.dependencyNode(failedModel)}
+	running := make(map[string]bool, len(runningModels))
+	for _, m := range runningModels {
+		if m.ID == failedID {
+			continue
+		}
+		known = append(known, s.dependencyNode(m))
+		running[m.ID] = true
+	}
+
+	down := []container.DependencyNode{known[0]}
+	for len(down) > 0 {
+		gone := down[0]
+		down = down[1:]
+		for _, node := range known {
+			if !running[node.ID] || !node.Needs(gone) {
+				continue
+			}
+			unmet := container.UnmetDependencies(node, known, running)
+			if len(unmet) == 0 {
+				continue
+			}
+
+			s.containersMu.RLock()
+			runner, ok := s.containers[node.ID]
+			s.containersMu.RUnlock()
+			if !ok {
+				continue
+			}
+			reason := fmt.Sprintf("dependency_failed: waiting on [%s] after %s failed", strings.Join(unmet, ", "), failedID)
+			fmt.Printf("Container %s: Blocked (%s)\n", node.ID, reason)
+			if err := runner.Block(reason); err != nil {
+				fmt.Printf("Warning: failed to block container %s: %v\n", node.ID, err)
+				continue
+			}
+			running[node.ID] = false
+			down = append(down, node)
+		}
+	}
+}
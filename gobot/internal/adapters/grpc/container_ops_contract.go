@@ -7,6 +7,7 @@ import (
 
 	"github.com/andrescamacho/spacetraders-go/internal/adapters/persistence"
 	"github.com/andrescamacho/spacetraders-go/internal/domain/container"
+	"github.com/andrescamacho/spacetraders-go/internal/domain/shared"
 	"github.com/andrescamacho/spacetraders-go/pkg/utils"
 )
 
@@ -86,7 +87,7 @@ func (s *DaemonServer) PersistContractWorkflow(
 	}
 
 	if !created {
-		return fmt.Errorf("CONTRACT_WORKFLOW container already running for player %d", playerID)
+		return shared.Errorf(shared.CodeContainerAlreadyRunning, "CONTRACT_WORKFLOW container already running for player %d", playerID)
 	}
 
 	return nil
@@ -287,6 +288,9 @@ var idleArbConfigKeys = []string{
 	"idle_arb_min_net_profit",
 	"idle_arb_net_profit_pct",
 	"idle_arb_fuel_cost_per_unit",
+	"idle_arb_starvation_after_secs",
+	"idle_arb_starvation_min_net_profit",
+	"idle_arb_starvation_net_profit_pct",
 }
 
 // resolveIdleArbConfig makes config.yaml the single LIVE source of truth for the
@@ -362,6 +366,15 @@ func (s *DaemonServer) injectIdleArbConfig(config map[string]interface{}) {
 	if ia.FuelCostPerUnit != 0 {
 		config["idle_arb_fuel_cost_per_unit"] = ia.FuelCostPerUnit
 	}
+	if ia.StarvationAfterSeconds != 0 {
+		config["idle_arb_starvation_after_secs"] = ia.StarvationAfterSeconds
+	}
+	if ia.StarvationMinNetProfitPerUnit != 0 {
+		config["idle_arb_starvation_min_net_profit"] = ia.StarvationMinNetProfitPerUnit
+	}
+	if ia.StarvationNetProfitPct != 0 {
+		config["idle_arb_starvation_net_profit_pct"] = ia.StarvationNetProfitPct
+	}
 }
 
 // autoLiquidationConfigKeys enumerates every launch-config key the parked-hull
@@ -0,0 +1,112 @@
+package grpc
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	appContract "github.com/andrescamacho/spacetraders-go/internal/application/contract"
+	"github.com/andrescamacho/spacetraders-go/internal/domain/container"
+	"github.com/andrescamacho/spacetraders-go/internal/domain/shared"
+	"github.com/andrescamacho/spacetraders-go/pkg/utils"
+)
+
+// LaunchContractMining implements appContract.ContractMiningLauncher: it starts the
+// contract fleet coordinator's mining + transport sub-operation for one delivery.
+//
+// It follows LaunchIdleArb's launch shape: the command is built through the same factory
+// recovery uses ("contract_mining"), the container row is persisted BEFORE any hull claim
+// (the sp-1hp9 FK ordering), and every hull — each miner and the transport — is claimed
+// synchronously under spec.Operation before this returns. A refused claim terminalizes the
+// row and releases the hulls already claimed for it, so a failed launch leaves nothing
+// behind and the coordinator falls back to buying.
+//
+// The transport is persisted as ship_symbol, so the runner re-claims it idempotently on
+// start and on restart recovery; the miners keep their DB claim across a restart and are
+// released with the transport by the runner's release-on-exit.
+func (s *DaemonServer) LaunchContractMining(ctx context.Context, spec appContract.ContractMiningSpec) (string, error) {
+	if spec.ContractID == "" || spec.Good == "" || spec.Asteroid == "" || spec.Destination == "" {
+		return "", fmt.Errorf("contract mining launch requires contract, good, asteroid and destination")
+	}
+	if len(spec.MinerShips) == 0 || spec.TransportShip == "" {
+		return "", fmt.Errorf("contract mining launch requires at least one miner and a transport ship")
+	}
+	if spec.Operation == "" {
+		return "", fmt.Errorf("contract mining launch requires the coordinator's fleet identity (operation)")
+	}
+
+	playerID := shared.MustNewPlayerID(spec.PlayerID)
+	containerID := utils.GenerateContainerID("contract-mining", spec.TransportShip)
+
+	config := map[string]interface{}{
+		"ship_symbol":  spec.TransportShip,
+		"miner_ships":  spec.MinerShips,
+		"contract_id":  spec.ContractID,
+		"good":         spec.Good,
+		"units":        spec.Units,
+		"asteroid":     spec.Asteroid,
+		"destination":  spec.Destination,
+		"container_id": containerID,
+		"operation":    spec.Operation,
+	}
+
+	cmd, err := s.buildCommandForType("contract_mining", config, spec.PlayerID, containerID)
+	if err != nil {
+		return "", fmt.Errorf("failed to create contract mining command: %w", err)
+	}
+
+	containerEntity := container.NewContainer(
+		containerID,
+		container.ContainerTypeContractMining,
+		spec.PlayerID,
+		1,   // the command loops until the delivery is met
+		nil, // top-level, recovered independently
+		config,
+		nil, // default RealClock
+	)
+
+	if err := s.containerRepo.Add(ctx, containerEntity, "contract_mining"); err != nil {
+		return "", fmt.Errorf("failed to persist contract mining container: %w", err)
+	}
+
+	hulls := append([]string{spec.TransportShip}, spec.MinerShips...)
+	for i, hull := range hulls {
+		if err := s.shipRepo.ClaimShip(ctx, hull, containerID, playerID, spec.Operation); err != nil {
+			for _, claimed := range hulls[:i] {
+				if _, relErr := s.shipRepo.ReleaseContainerClaim(ctx, claimed, playerID, "contract_mining_launch_failed"); relErr != nil {
+					fmt.Printf("Contract mining launch cleanup: could not release %s (%v)\n", claimed, relErr)
+				}
+			}
+			s.terminalizeLaunchClaimFailure(ctx, containerEntity, err)
+			return "", fmt.Errorf("contract mining claim of %s refused: %w", hull, err)
+		}
+	}
+
+	s.startContainerRunner(containerEntity, cmd, containerID, "Contract mining container")
+
+	return containerID, nil
+}
+
+// ActiveContractMining implements appContract.ContractMiningLauncher: the id of the
+// PENDING or RUNNING contract_mining container for contractID, or "" when there is none.
+func (s *DaemonServer) ActiveContractMining(ctx context.Context, playerID int, contractID string) (string, error) {
+	for _, status := range []container.ContainerStatus{container.ContainerStatusRunning, container.ContainerStatusPending} {
+		models, err := s.containerRepo.ListByStatus(ctx, status, &playerID)
+		if err != nil {
+			return "", fmt.Errorf("failed to list containers: %w", err)
+		}
+		for _, m := range models {
+			if m.ContainerType != string(container.ContainerTypeContractMining) {
+				continue
+			}
+			var config map[string]interface{}
+			if err := json.Unmarshal([]byte(m.Config), &config); err != nil {
+				continue
+			}
+			if id, _ := config["contract_id"].(string); id == contractID {
+				return m.ID, nil
+			}
+		}
+	}
+	return "", nil
+}
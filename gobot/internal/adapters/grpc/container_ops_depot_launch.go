@@ -674,7 +674,9 @@ func (s *DaemonServer) positionDepotElementHull(
 	if loc := ship.CurrentLocation(); loc != nil && loc.Symbol == targetWaypoint {
 		return ship, true, nil // already parked at its waypoint — nothing to reposition
 	}
-	navigate := s.NavigateShip
+	navigate := func(ctx context.Context, shipSymbol, destination string, playerID int) (string, error) {
+		return s.NavigateShip(ctx, shipSymbol, destination, playerID, "", nil)
+	}
 	if s.depotNavigateOverride != nil {
 		navigate = s.depotNavigateOverride
 	}
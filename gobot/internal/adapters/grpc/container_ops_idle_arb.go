@@ -106,7 +106,7 @@ func (s *DaemonServer) LaunchIdleArb(ctx context.Context, spec appContract.IdleA
 		// The row is now an orphan — persisted, but no hull was claimed and no runner
 		// owns it. Terminalize it FAILED the way the runner's sp-cr86 claim-failure
 		// path does, so it is not a zombie stuck at PENDING with no one to advance it.
-		s.terminalizeIdleArbClaimFailure(ctx, containerEntity, err)
+		s.terminalizeLaunchClaimFailure(ctx, containerEntity, err)
 		return "", fmt.Errorf("idle-arb claim of %s refused: %w", spec.ShipSymbol, err)
 	}
 
@@ -117,14 +117,14 @@ func (s *DaemonServer) LaunchIdleArb(ctx context.Context, spec appContract.IdleA
 	return containerID, nil
 }
 
-// terminalizeIdleArbClaimFailure marks a just-persisted idle-arb container row FAILED
-// when the synchronous hull claim is refused AFTER the row exists (the hull was taken
-// between the dispatcher's read and this claim). Without it the row is a zombie:
+// terminalizeLaunchClaimFailure marks a just-persisted idle-arb or contract-mining
+// container row FAILED when the synchronous hull claim is refused AFTER the row exists
+// (the hull was taken between the dispatcher's read and this claim). Without it the row is a zombie:
 // persisted PENDING with no runner ever created to advance or release it, and the
 // watchkeeper would spam heartbeat_lost for it — the same failure sp-cr86 fixed inside
 // the runner, here at the pre-runner claim boundary. No ship state is released: the
 // claim failed, so nothing was ever assigned to this container.
-func (s *DaemonServer) terminalizeIdleArbClaimFailure(ctx context.Context, c *container.Container, cause error) {
+func (s *DaemonServer) terminalizeLaunchClaimFailure(ctx context.Context, c *container.Container, cause error) {
 	now := s.clock.Now()
 	exitCode := 1
 	if err := s.containerRepo.UpdateStatus(
@@ -136,6 +136,6 @@ func (s *DaemonServer) terminalizeIdleArbClaimFailure(ctx context.Context, c *co
 		&exitCode,
 		fmt.Sprintf("claim_failed: %s", cause.Error()),
 	); err != nil {
-		fmt.Printf("Idle-arb launch cleanup: could not terminalize orphan container %s (%v)\n", c.ID(), err)
+		fmt.Printf("Launch cleanup: could not terminalize orphan container %s (%v)\n", c.ID(), err)
 	}
 }
@@ -0,0 +1,39 @@
+package grpc
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/andrescamacho/spacetraders-go/internal/domain/container"
+	"github.com/andrescamacho/spacetraders-go/internal/domain/shared"
+)
+
+var _ container.LogLevelSetter = (*DaemonServer)(nil)
+
+// SetContainerLogLevel changes a running container's log level and writes it to
+// the container's persisted config under log_level, so a restart-recovered
+// container comes back at the same level. The runner switches first; a failed
+// config write is reported but leaves the live change in place.
+func (s *DaemonServer) SetContainerLogLevel(ctx context.Context, containerID, level string) (string, error) {
+	level, err := container.NormalizeLogLevel(level)
+	if err != nil {
+		return "", err
+	}
+
+	s.containersMu.RLock()
+	runner, exists := s.containers[containerID]
+	s.containersMu.RUnlock()
+	if !exists {
+		return "", shared.Errorf(shared.CodeContainerNotFound, "container not found: %s", containerID)
+	}
+
+	previous := runner.LogLevel()
+	runner.SetLogLevel(level)
+
+	playerID := runner.Container().PlayerID()
+	updates := map[string]interface{}{container.LogLevelKey: level}
+	if err := s.mergeContainerConfig(ctx, containerID, playerID, updates); err != nil {
+		return previous, fmt.Errorf("log level changed but persisting it failed: %w", err)
+	}
+	return previous, nil
+}
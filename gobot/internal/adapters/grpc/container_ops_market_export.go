@@ -0,0 +1,68 @@
+package grpc
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	scoutingQuery "github.com/andrescamacho/spacetraders-go/internal/application/scouting/queries"
+	"github.com/andrescamacho/spacetraders-go/internal/domain/shared"
+)
+
+// ExportMarketData renders a system's cached markets, plus the price history
+// recorded within historyWindow, as a CSV or JSON document. It is a read-only
+// query, so analysts get the data without opening the daemon's database.
+func (s *DaemonServer) ExportMarketData(
+	ctx context.Context,
+	playerID int,
+	systemSymbol string,
+	format string,
+	historyWindow time.Duration,
+) (*scoutingQuery.ExportMarketDataResponse, error) {
+	exportFormat, err := scoutingQuery.ParseMarketExportFormat(format)
+	if err != nil {
+		return nil, err
+	}
+
+	response, err := s.mediator.Send(ctx, &scoutingQuery.ExportMarketDataQuery{
+		PlayerID:      shared.MustNewPlayerID(playerID),
+		SystemSymbol:  systemSymbol,
+		Format:        exportFormat,
+		HistoryWindow: historyWindow,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to export market data: %w", err)
+	}
+
+	exportResp, ok := response.(*scoutingQuery.ExportMarketDataResponse)
+	if !ok {
+		return nil, fmt.Errorf("unexpected response type")
+	}
+	return exportResp, nil
+}
+
+// GetSupplyTransitionStats summarises the supply transitions the market scanner
+// recorded within window, per (market, good).
+func (s *DaemonServer) GetSupplyTransitionStats(
+	ctx context.Context,
+	playerID int,
+	waypointSymbol string,
+	goodSymbol string,
+	window time.Duration,
+) (*scoutingQuery.GetSupplyTransitionStatsResponse, error) {
+	response, err := s.mediator.Send(ctx, &scoutingQuery.GetSupplyTransitionStatsQuery{
+		PlayerID:       shared.MustNewPlayerID(playerID),
+		WaypointSymbol: waypointSymbol,
+		GoodSymbol:     goodSymbol,
+		Window:         window,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get supply transition stats: %w", err)
+	}
+
+	statsResp, ok := response.(*scoutingQuery.GetSupplyTransitionStatsResponse)
+	if !ok {
+		return nil, fmt.Errorf("unexpected response type")
+	}
+	return statsResp, nil
+}
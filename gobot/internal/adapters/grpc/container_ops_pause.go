@@ -0,0 +1,111 @@
+package grpc
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/andrescamacho/spacetraders-go/internal/domain/container"
+	"github.com/andrescamacho/spacetraders-go/internal/domain/shared"
+)
+
+// PauseContainer pauses a running container and its running children. Each one
+// finishes the iteration in flight, keeps its ships and makes no further API
+// calls until ResumeContainer. The pause is written to the container's config
+// under paused, so a restart-recovered container comes back paused. Returns the
+// IDs of the children paused along with it.
+func (s *DaemonServer) PauseContainer(ctx context.Context, containerID string) ([]string, error) {
+	return s.setContainerPaused(ctx, containerID, true)
+}
+
+// ResumeContainer resumes a paused container and its paused children, and
+// clears the pause from their configs.
+func (s *DaemonServer) ResumeContainer(ctx context.Context, containerID string) ([]string, error) {
+	return s.setContainerPaused(ctx, containerID, false)
+}
+
+// setContainerPaused applies a pause or resume to the container, then to its
+// in-memory children (best-effort: a child already in the target state, or that
+// has finished, is skipped), persisting each switched container's flag. Runners
+// switch first; a failed config write leaves the live change in place and is
+// reported for the parent, logged for a child.
+func (s *DaemonServer) setContainerPaused(ctx context.Context, containerID string, pause bool) ([]string, error) {
+	s.containersMu.RLock()
+	runner, exists := s.containers[containerID]
+	s.containersMu.RUnlock()
+	if !exists {
+		return nil, shared.Errorf(shared.CodeContainerNotFound, "container not found: %s", containerID)
+	}
+
+	apply := func(r *ContainerRunner) error {
+		if pause {
+			return r.Pause()
+		}
+		return r.Resume()
+	}
+	if err := apply(runner); err != nil {
+		return nil, err
+	}
+
+	var updates map[string]interface{}
+	if pause {
+		updates = map[string]interface{}{container.PausedKey: true}
+	} else {
+		updates = map[string]interface{}{container.PausedKey: nil}
+	}
+
+	playerID := runner.Container().PlayerID()
+	var children []string
+	models, err := s.containerRepo.FindChildContainers(ctx, containerID, playerID)
+	if err != nil {
+		fmt.Printf("Warning: failed to find child containers for %s: %v\n", containerID, err)
+	}
+	for _, child := range models {
+		s.containersMu.RLock()
+		childRunner, ok := s.containers[child.ID]
+		s.containersMu.RUnlock()
+		if !ok || apply(childRunner) != nil {
+			continue
+		}
+		children = append(children, child.ID)
+		if err := s.mergeContainerConfig(ctx, child.ID, playerID, updates); err != nil {
+			fmt.Printf("Warning: failed to persist pause for child container %s: %v\n", child.ID, err)
+		}
+	}
+
+	if err := s.mergeContainerConfig(ctx, containerID, playerID, updates); err != nil {
+		return children, fmt.Errorf("container %s switched but persisting the pause failed: %w", containerID, err)
+	}
+	return children, nil
+}
+
+// mergeContainerConfig merges updates into a container's persisted config. A nil
+// value deletes its key. A container without a row is left alone.
+func (s *DaemonServer) mergeContainerConfig(ctx context.Context, containerID string, playerID int, updates map[string]interface{}) error {
+	model, err := s.containerRepo.Get(ctx, containerID, playerID)
+	if err != nil {
+		return fmt.Errorf("reading container %s config failed: %w", containerID, err)
+	}
+	if model == nil {
+		return nil
+	}
+
+	config := map[string]interface{}{}
+	if model.Config != "" {
+		if err := json.Unmarshal([]byte(model.Config), &config); err != nil {
+			return fmt.Errorf("container %s config is unreadable: %w", containerID, err)
+		}
+	}
+	for key, value := range updates {
+		if value == nil {
+			delete(config, key)
+			continue
+		}
+		config[key] = value
+	}
+	merged, err := json.Marshal(config)
+	if err != nil {
+		return fmt.Errorf("failed to serialize container %s config: %w", containerID, err)
+	}
+	return s.containerRepo.UpdateContainerConfig(ctx, containerID, playerID, string(merged))
+}
@@ -0,0 +1,152 @@
+package grpc
+
+import (
+	"context"
+	"fmt"
+
+	contractCmd "github.com/andrescamacho/spacetraders-go/internal/application/contract/commands"
+	playerCmd "github.com/andrescamacho/spacetraders-go/internal/application/player/commands"
+	"github.com/andrescamacho/spacetraders-go/internal/domain/shared"
+)
+
+// RegisteredAgent is the onboarding summary returned by RegisterAgent.
+type RegisteredAgent struct {
+	PlayerID     int
+	AgentSymbol  string
+	Faction      string
+	Headquarters string
+	Credits      int
+	ShipsSynced  int
+	Registered   bool
+
+	// StarterContractID is the starter contract onboarding found, accepted or
+	// not; StarterContractAccepted reports whether onboarding accepted it, and
+	// ContractWorkflowID the workflow container queued to run it. StarterNote
+	// says why a step was skipped or failed — onboarding never fails the
+	// registration, since the player is already stored by then.
+	StarterContractID       string
+	StarterContractAccepted bool
+	ContractWorkflowID      string
+	StarterNote             string
+}
+
+// RegisterAgent stores a new player — registering the agent through the API
+// when no token is supplied — then syncs its credits and fleet so the agent
+// can be operated straight away. The startup ship resync only covers the
+// open-era player, so without the explicit SyncAllFromAPI here a freshly
+// onboarded agent would have an empty ship cache until the next restart.
+//
+// Unless skipStarterContract is set it then accepts a fresh agent's starter
+// contract and queues a single contract workflow on the command ship, so the
+// agent starts earning without waiting for an operator.
+func (s *DaemonServer) RegisterAgent(ctx context.Context, agentSymbol, faction, accountToken, token string, skipStarterContract bool) (*RegisteredAgent, error) {
+	response, err := s.mediator.Send(ctx, &playerCmd.RegisterPlayerCommand{
+		Symbol:       agentSymbol,
+		Token:        token,
+		AccountToken: accountToken,
+		Faction:      faction,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to register agent: %w", err)
+	}
+
+	registerResp, ok := response.(*playerCmd.RegisterPlayerResponse)
+	if !ok {
+		return nil, fmt.Errorf("unexpected response type")
+	}
+
+	p := registerResp.Player
+	if p.ID.IsZero() {
+		return nil, fmt.Errorf("agent %s stored but its player id could not be resolved", p.AgentSymbol)
+	}
+
+	result := &RegisteredAgent{
+		PlayerID:    p.ID.Value(),
+		AgentSymbol: p.AgentSymbol,
+		Faction:     p.StartingFaction,
+		Registered:  registerResp.Registered,
+	}
+	if hq, ok := p.Metadata["headquarters"].(string); ok {
+		result.Headquarters = hq
+	}
+
+	// SyncPlayer resolves the token through PlayerTokenMiddleware by PlayerID,
+	// which now finds the row written above.
+	syncResp, err := s.mediator.Send(ctx, &playerCmd.SyncPlayerCommand{PlayerID: result.PlayerID})
+	if err != nil {
+		return nil, fmt.Errorf("agent %s stored as player %d but credit sync failed: %w", result.AgentSymbol, result.PlayerID, err)
+	}
+	if synced, ok := syncResp.(*playerCmd.SyncPlayerResponse); ok && synced.Player != nil {
+		result.Credits = synced.Player.Credits
+		if hq, ok := synced.Player.Metadata["headquarters"].(string); ok && hq != "" {
+			result.Headquarters = hq
+		}
+	}
+
+	if s.shipRepo != nil {
+		count, err := s.shipRepo.SyncAllFromAPI(ctx, p.ID)
+		if err != nil {
+			return nil, fmt.Errorf("agent %s stored as player %d but ship sync failed: %w", result.AgentSymbol, result.PlayerID, err)
+		}
+		result.ShipsSynced = count
+	}
+
+	if !skipStarterContract {
+		s.onboardStarterContract(ctx, p.ID, result)
+	}
+
+	return result, nil
+}
+
+// onboardStarterContract accepts the agent's starter contract and queues the
+// contract workflow that delivers it, recording the outcome on result.
+func (s *DaemonServer) onboardStarterContract(ctx context.Context, playerID shared.PlayerID, result *RegisteredAgent) {
+	response, err := s.mediator.Send(ctx, &contractCmd.OnboardStarterContractCommand{PlayerID: playerID})
+	if err != nil {
+		result.StarterNote = fmt.Sprintf("starter contract not accepted: %v", err)
+		return
+	}
+	onboarded, ok := response.(*contractCmd.OnboardStarterContractResponse)
+	if !ok {
+		result.StarterNote = fmt.Sprintf("starter contract onboarding returned %T", response)
+		return
+	}
+	if onboarded.Contract != nil {
+		result.StarterContractID = onboarded.Contract.ContractID()
+	}
+	if !onboarded.Accepted {
+		result.StarterNote = onboarded.Reason
+		return
+	}
+	result.StarterContractAccepted = true
+
+	shipSymbol, err := s.commandShipSymbol(ctx, playerID)
+	if err != nil {
+		result.StarterNote = fmt.Sprintf("contract workflow not queued: %v", err)
+		return
+	}
+	containerID, err := s.BatchContractWorkflow(ctx, shipSymbol, playerID.Value(), 1)
+	if err != nil {
+		result.StarterNote = fmt.Sprintf("contract workflow not queued: %v", err)
+		return
+	}
+	result.ContractWorkflowID = containerID
+}
+
+// commandShipSymbol returns the agent's command ship, the only hauler a fresh
+// agent owns.
+func (s *DaemonServer) commandShipSymbol(ctx context.Context, playerID shared.PlayerID) (string, error) {
+	if s.shipRepo == nil {
+		return "", fmt.Errorf("no ship repository")
+	}
+	ships, err := s.shipRepo.FindAllByPlayer(ctx, playerID)
+	if err != nil {
+		return "", fmt.Errorf("failed to list ships: %w", err)
+	}
+	for _, ship := range ships {
+		if ship.Role() == commandRole {
+			return ship.ShipSymbol(), nil
+		}
+	}
+	return "", fmt.Errorf("agent has no %s ship", commandRole)
+}
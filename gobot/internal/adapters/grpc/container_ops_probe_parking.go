@@ -0,0 +1,67 @@
+package grpc
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/andrescamacho/spacetraders-go/internal/domain/container"
+	"github.com/andrescamacho/spacetraders-go/pkg/utils"
+)
+
+// ProbeParkingCoordinator creates and starts the standing probe-parking coordinator for a
+// player, mirroring ShipyardBackfillCoordinator. Each tick it parks idle probes at the
+// highest-value unwatched markets as docked single-market scout tours. The container id is
+// keyed by player and the persisted config is the recovery source, read back through the
+// SAME buildCommandForType (probe_parking_coordinator → buildProbeParkingCoordinatorCommand)
+// the creation path uses. Nothing launches it at boot; every knob is optional and a 0 value
+// uses the coordinator's own default.
+func (s *DaemonServer) ProbeParkingCoordinator(
+	ctx context.Context,
+	playerID int,
+	tickIntervalSecs int,
+	scanIntervalSecs int,
+	reserveIdleProbes int,
+) (string, error) {
+	// Double-launch guard: ONE parking coordinator per player. A twin would race the
+	// same idle probes onto the same unwatched markets.
+	existingID, err := firstContainerIDOfType(ctx, s.containerRepo, playerID, container.ContainerTypeProbeParkingCoordinator)
+	if err != nil {
+		return "", fmt.Errorf("failed to check for a running probe parking coordinator: %w", err)
+	}
+	if existingID != "" {
+		return "", fmt.Errorf("probe parking coordinator already running for player %d (container %s) — stop it first: spacetraders container stop %s",
+			playerID, existingID, existingID)
+	}
+
+	containerID := utils.GenerateContainerID("probe_parking_coordinator", fmt.Sprintf("player-%d", playerID))
+
+	config := map[string]interface{}{
+		"container_id":        containerID,
+		"tick_interval_secs":  tickIntervalSecs,
+		"scan_interval_secs":  scanIntervalSecs,
+		"reserve_idle_probes": reserveIdleProbes,
+	}
+
+	cmd, err := s.buildCommandForType("probe_parking_coordinator", config, playerID, containerID)
+	if err != nil {
+		return "", fmt.Errorf("failed to create probe parking command: %w", err)
+	}
+
+	containerEntity := container.NewContainer(
+		containerID,
+		container.ContainerTypeProbeParkingCoordinator,
+		playerID,
+		-1,  // Infinite iterations (reconcile loop) — NOT a CoordinatorOwnsIterations type
+		nil, // No parent container
+		config,
+		nil, // Use default RealClock for production
+	)
+
+	if err := s.containerRepo.Add(ctx, containerEntity, "probe_parking_coordinator"); err != nil {
+		return "", fmt.Errorf("failed to persist probe parking container: %w", err)
+	}
+
+	s.startContainerRunner(containerEntity, cmd, containerID, "Probe parking container")
+
+	return containerID, nil
+}
@@ -3,7 +3,9 @@ package grpc
 import (
 	"context"
 	"fmt"
+	"time"
 
+	shipyardCmd "github.com/andrescamacho/spacetraders-go/internal/application/shipyard/commands"
 	"github.com/andrescamacho/spacetraders-go/internal/domain/container"
 	"github.com/andrescamacho/spacetraders-go/pkg/utils"
 )
@@ -46,17 +48,61 @@ func (s *DaemonServer) PurchaseShip(ctx context.Context, purchasingShipSymbol, s
 
 	runner := NewContainerRunner(containerEntity, s.mediator, cmd, s.logRepo, s.containerRepo, s.shipRepo, s.clock)
 
-	s.containersMu.Lock()
-	s.containers[containerID] = runner
-	s.containersMu.Unlock()
+	s.registerContainer(containerID, runner)
 
 	runner.Start()
 
 	return containerID, "", 0, 0, "starting", nil
 }
 
-// BatchPurchaseShips purchases multiple ships from a shipyard as a background operation
-func (s *DaemonServer) BatchPurchaseShips(ctx context.Context, purchasingShipSymbol, shipType string, quantity, maxBudget, playerID int, shipyardWaypoint *string, iterations *int) (string, int32, int32, string, string, error) {
+// BatchPurchaseOptions are the optional knobs of a batch purchase. Zero values
+// leave each one off.
+type BatchPurchaseOptions struct {
+	Loadout     string    // Preset applied to every ship the batch buys
+	MaxPrice    int       // Per-ship price cap
+	WaitForDip  bool      // Wait for the listing to fall to MaxPrice
+	DipDeadline time.Time // When a dip wait gives up; zero = handler default
+	DipPollSecs int       // Listing re-read interval while waiting; 0 = handler default
+	// FailurePolicy is stop, best_effort or rollback; "" = stop.
+	FailurePolicy string
+}
+
+// config writes the options into a batch purchase launch config under the
+// keys buildBatchPurchaseShipsCommand reads back, so a recovered batch keeps
+// them.
+func (o BatchPurchaseOptions) config(config map[string]interface{}) error {
+	if o.MaxPrice < 0 || o.DipPollSecs < 0 {
+		return fmt.Errorf("max price and dip poll interval cannot be negative")
+	}
+	if o.WaitForDip && o.MaxPrice == 0 {
+		return fmt.Errorf("wait for dip needs a max price to wait for")
+	}
+	if _, err := shipyardCmd.ParseBatchFailurePolicy(o.FailurePolicy); err != nil {
+		return err
+	}
+	if o.Loadout != "" {
+		config["loadout"] = o.Loadout
+	}
+	if o.MaxPrice > 0 {
+		config["max_price"] = o.MaxPrice
+	}
+	if o.WaitForDip {
+		config["wait_for_dip"] = true
+	}
+	if !o.DipDeadline.IsZero() {
+		config["dip_deadline"] = o.DipDeadline.UTC().Format(time.RFC3339)
+	}
+	if o.DipPollSecs > 0 {
+		config["dip_poll_secs"] = o.DipPollSecs
+	}
+	if o.FailurePolicy != "" {
+		config["failure_policy"] = o.FailurePolicy
+	}
+	return nil
+}
+
+// BatchPurchaseShips purchases multiple ships from a shipyard as a background operation.
+func (s *DaemonServer) BatchPurchaseShips(ctx context.Context, purchasingShipSymbol, shipType string, quantity, maxBudget, playerID int, shipyardWaypoint *string, iterations *int, opts BatchPurchaseOptions) (string, int32, int32, string, string, error) {
 	shipyard := ""
 	if shipyardWaypoint != nil {
 		shipyard = *shipyardWaypoint
@@ -77,6 +123,9 @@ func (s *DaemonServer) BatchPurchaseShips(ctx context.Context, purchasingShipSym
 		"max_budget":  maxBudget,
 		"shipyard":    shipyard,
 	}
+	if err := opts.config(config); err != nil {
+		return "", 0, 0, "", "", err
+	}
 
 	// Create batch purchase command from the launch config
 	cmd, err := s.buildCommandForType("batch_purchase_ships", config, playerID, containerID)
@@ -101,9 +150,7 @@ func (s *DaemonServer) BatchPurchaseShips(ctx context.Context, purchasingShipSym
 
 	runner := NewContainerRunner(containerEntity, s.mediator, cmd, s.logRepo, s.containerRepo, s.shipRepo, s.clock)
 
-	s.containersMu.Lock()
-	s.containers[containerID] = runner
-	s.containersMu.Unlock()
+	s.registerContainer(containerID, runner)
 
 	runner.Start()
 
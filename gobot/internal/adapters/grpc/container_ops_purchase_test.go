@@ -0,0 +1,47 @@
+package grpc
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	shipyardCmd "github.com/andrescamacho/spacetraders-go/internal/application/shipyard/commands"
+)
+
+// The wait-for-dip options a caller sets are written to the launch config and
+// read back from the persisted row, so a recovered batch keeps its cap and
+// its original deadline.
+func TestBatchPurchaseOptions_SurviveLaunchConfig(t *testing.T) {
+	s := newFactoryTestServer()
+	deadline := time.Date(2030, 1, 2, 3, 4, 5, 0, time.UTC)
+	config := map[string]interface{}{
+		"ship_symbol": "SHIP-A",
+		"ship_type":   "SHIP_LIGHT_HAULER",
+		"quantity":    2,
+		"max_budget":  0,
+		"shipyard":    "",
+	}
+	require.NoError(t, BatchPurchaseOptions{
+		MaxPrice:      80000,
+		WaitForDip:    true,
+		DipDeadline:   deadline,
+		DipPollSecs:   300,
+		FailurePolicy: "rollback",
+	}.config(config))
+
+	got, err := s.buildCommandForType("batch_purchase_ships", jsonRoundTrip(t, config), 1, "batch-1")
+	require.NoError(t, err)
+	cmd := got.(*shipyardCmd.BatchPurchaseShipsCommand)
+	require.Equal(t, 80000, cmd.MaxPrice)
+	require.True(t, cmd.WaitForDip)
+	require.True(t, deadline.Equal(cmd.DipDeadline))
+	require.Equal(t, 5*time.Minute, cmd.DipPollInterval)
+	require.Equal(t, shipyardCmd.BatchFailureRollback, cmd.FailurePolicy)
+}
+
+func TestBatchPurchaseOptions_WaitForDipNeedsMaxPrice(t *testing.T) {
+	require.Error(t, BatchPurchaseOptions{WaitForDip: true}.config(map[string]interface{}{}))
+	require.Error(t, BatchPurchaseOptions{MaxPrice: -1}.config(map[string]interface{}{}))
+	require.Error(t, BatchPurchaseOptions{FailurePolicy: "retry"}.config(map[string]interface{}{}))
+}
@@ -52,12 +52,13 @@ func (s *DaemonServer) ListShips(ctx context.Context, playerID *int, agentSymbol
 }
 
 // GetShip handles ship detail requests
-func (s *DaemonServer) GetShip(ctx context.Context, shipSymbol string, playerID *int, agentSymbol string) (*pb.ShipDetail, error) {
+func (s *DaemonServer) GetShip(ctx context.Context, shipSymbol string, playerID *int, agentSymbol string, noCache bool) (*pb.ShipDetail, error) {
 	// Create query
 	query := &shipQuery.GetShipQuery{
 		ShipSymbol:  shipSymbol,
 		PlayerID:    playerID,
 		AgentSymbol: agentSymbol,
+		NoCache:     noCache,
 	}
 
 	// Execute via mediator
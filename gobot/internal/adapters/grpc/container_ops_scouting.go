@@ -4,7 +4,9 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"time"
 
+	"github.com/andrescamacho/spacetraders-go/internal/adapters/persistence"
 	scoutingCmd "github.com/andrescamacho/spacetraders-go/internal/application/scouting/commands"
 	"github.com/andrescamacho/spacetraders-go/internal/domain/container"
 	"github.com/andrescamacho/spacetraders-go/internal/domain/shared"
@@ -58,6 +60,8 @@ func (s *DaemonServer) ScoutTour(ctx context.Context, containerID string, shipSy
 // respawning to the coordinator's reconcile pass — the contract_workflow worker
 // pattern. Unlike a contract worker it uses a plain Add (not CreateIfNoActiveWorker):
 // many scout tours run concurrently, one per post, so there is no one-worker cap.
+// A tour persisted with an empty coordinatorID (a parked probe, see
+// ParkProbesCommand) has no parent and recovers on its own like a direct launch.
 func (s *DaemonServer) PersistScoutTourWorker(
 	ctx context.Context,
 	containerID string,
@@ -67,6 +71,7 @@ func (s *DaemonServer) PersistScoutTourWorker(
 	scanIntervalSecs int,
 	playerID int,
 	coordinatorID string,
+	dockAtPost bool,
 ) error {
 	config := map[string]interface{}{
 		"ship_symbol":        shipSymbol,
@@ -74,6 +79,7 @@ func (s *DaemonServer) PersistScoutTourWorker(
 		"iterations":         iterations,
 		"scan_interval_secs": scanIntervalSecs,
 		"coordinator_id":     coordinatorID,
+		"dock_at_post":       dockAtPost,
 	}
 
 	containerEntity := container.NewContainer(
@@ -321,11 +327,13 @@ func (s *DaemonServer) injectScoutingConfig(config map[string]interface{}) {
 }
 
 // AssignScoutingFleet creates a scout-fleet-assignment container for async VRP optimization
-// Returns the container ID immediately without blocking
+// Returns the container ID immediately without blocking. A positive freshnessSLA makes the
+// container a standing market refresh loop; it is persisted so a restart resumes the loop.
 func (s *DaemonServer) AssignScoutingFleet(
 	ctx context.Context,
 	systemSymbol string,
 	playerID int,
+	freshnessSLA time.Duration,
 ) (string, error) {
 	containerID := utils.GenerateContainerID("scout-fleet-assignment", systemSymbol)
 
@@ -333,6 +341,14 @@ func (s *DaemonServer) AssignScoutingFleet(
 	cmd := &scoutingCmd.AssignScoutingFleetCommand{
 		PlayerID:     shared.MustNewPlayerID(int(playerID)),
 		SystemSymbol: systemSymbol,
+		FreshnessSLA: freshnessSLA,
+	}
+
+	config := map[string]interface{}{
+		"system_symbol": systemSymbol,
+	}
+	if freshnessSLA > 0 {
+		config["freshness_sla_secs"] = int(freshnessSLA / time.Second)
 	}
 
 	// Create container entity (one-time execution)
@@ -342,9 +358,7 @@ func (s *DaemonServer) AssignScoutingFleet(
 		playerID,
 		1,   // One-time execution
 		nil, // No parent container
-		map[string]interface{}{
-			"system_symbol": systemSymbol,
-		},
+		config,
 		nil, // Use default RealClock for production
 	)
 
@@ -356,3 +370,77 @@ func (s *DaemonServer) AssignScoutingFleet(
 
 	return containerID, nil
 }
+
+// scoutTourProgressConfigKey is the container config key a multi-market tour's
+// progress is merged under; `container get` shows it next to the launch knobs.
+const scoutTourProgressConfigKey = "tour_progress"
+
+// ScoutTourConfigPersister backs the scout tour's ScoutTourProgressStore with
+// the container config, so a tour rebuilt after a crash or daemon restart finds
+// the circuit it was flying. Writes are a read-modify-write of the config map
+// written back as a single column, like SystemWarmupConfigPersister.
+type ScoutTourConfigPersister struct {
+	containerRepo *persistence.ContainerRepositoryGORM
+}
+
+// NewScoutTourConfigPersister wires the config-backed scout tour progress store.
+func NewScoutTourConfigPersister(containerRepo *persistence.ContainerRepositoryGORM) *ScoutTourConfigPersister {
+	return &ScoutTourConfigPersister{containerRepo: containerRepo}
+}
+
+// LoadScoutTourProgress reads the progress merged into the container's config,
+// or nil when the tour has recorded none yet.
+func (p *ScoutTourConfigPersister) LoadScoutTourProgress(ctx context.Context, containerID string, playerID int) (*scoutingCmd.ScoutTourProgress, error) {
+	config, err := p.loadConfig(ctx, containerID, playerID)
+	if err != nil {
+		return nil, err
+	}
+	raw, ok := config[scoutTourProgressConfigKey]
+	if !ok || raw == nil {
+		return nil, nil
+	}
+	encoded, err := json.Marshal(raw)
+	if err != nil {
+		return nil, fmt.Errorf("re-encode container %s tour progress: %w", containerID, err)
+	}
+	var progress scoutingCmd.ScoutTourProgress
+	if err := json.Unmarshal(encoded, &progress); err != nil {
+		return nil, fmt.Errorf("decode container %s tour progress: %w", containerID, err)
+	}
+	return &progress, nil
+}
+
+// PersistScoutTourProgress merges progress into the container's persisted
+// config under the "tour_progress" key, preserving the launch knobs the
+// recovery rebuild reads.
+func (p *ScoutTourConfigPersister) PersistScoutTourProgress(ctx context.Context, containerID string, playerID int, progress scoutingCmd.ScoutTourProgress) error {
+	config, err := p.loadConfig(ctx, containerID, playerID)
+	if err != nil {
+		return err
+	}
+	config[scoutTourProgressConfigKey] = progress
+
+	merged, err := json.Marshal(config)
+	if err != nil {
+		return fmt.Errorf("serialize container %s config after merging tour progress: %w", containerID, err)
+	}
+	return p.containerRepo.UpdateContainerConfig(ctx, containerID, playerID, string(merged))
+}
+
+func (p *ScoutTourConfigPersister) loadConfig(ctx context.Context, containerID string, playerID int) (map[string]interface{}, error) {
+	model, err := p.containerRepo.Get(ctx, containerID, playerID)
+	if err != nil {
+		return nil, fmt.Errorf("load container %s for tour progress: %w", containerID, err)
+	}
+	if model == nil {
+		return nil, fmt.Errorf("container %s not found - no tour progress", containerID)
+	}
+
+	config := map[string]interface{}{}
+	if model.Config != "" {
+		if uerr := json.Unmarshal([]byte(model.Config), &config); uerr != nil {
+			return nil, fmt.Errorf("deserialize container %s config for tour progress: %w", containerID, uerr)
+		}
+	}
+	return config, nil
+}
@@ -2,6 +2,7 @@ package grpc
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 
 	shipCargo "github.com/andrescamacho/spacetraders-go/internal/application/ship/commands/cargo"
@@ -14,13 +15,39 @@ import (
 
 // NavigateShip handles ship navigation requests
 // This will be called by the gRPC handler when proto is generated
-func (s *DaemonServer) NavigateShip(ctx context.Context, shipSymbol, destination string, playerID int) (string, error) {
+func (s *DaemonServer) NavigateShip(ctx context.Context, shipSymbol, destination string, playerID int, idempotencyKey string, fuelReservePercent *int) (string, error) {
+	if idempotencyKey != "" {
+		existingID, err := s.findIdempotentNavigation(ctx, shipSymbol, destination, playerID, idempotencyKey)
+		if err != nil {
+			return "", err
+		}
+		if existingID != "" {
+			return existingID, nil
+		}
+	}
+
 	containerID := utils.GenerateContainerID("navigate", shipSymbol)
 
 	cmd := &shipNav.NavigateRouteCommand{
-		ShipSymbol:  shipSymbol,
-		Destination: destination,
-		PlayerID:    shared.MustNewPlayerID(playerID),
+		ShipSymbol:         shipSymbol,
+		Destination:        destination,
+		PlayerID:           shared.MustNewPlayerID(playerID),
+		IdempotencyKey:     idempotencyKey,
+		FuelReservePercent: fuelReservePercent,
+	}
+
+	config := map[string]interface{}{
+		"ship_symbol": shipSymbol,
+		"destination": destination,
+		// sp-sg35 BRIDGE: captain manual-op authority — this deliberate CLI op
+		// may operate a fleet-dedicated hull (audited override; see the const).
+		captainManualAuthorityKey: true,
+		idempotencyKeyConfigKey:   idempotencyKey,
+	}
+	// Only an explicit override is recorded, so a recovered route without one
+	// keeps following the fleet-wide reserve.
+	if fuelReservePercent != nil {
+		config[fuelReservePercentConfigKey] = *fuelReservePercent
 	}
 
 	containerEntity := container.NewContainer(
@@ -29,13 +56,7 @@ func (s *DaemonServer) NavigateShip(ctx context.Context, shipSymbol, destination
 		playerID,
 		1,   // Single iteration for navigate
 		nil, // No parent container
-		map[string]interface{}{
-			"ship_symbol": shipSymbol,
-			"destination": destination,
-			// sp-sg35 BRIDGE: captain manual-op authority — this deliberate CLI op
-			// may operate a fleet-dedicated hull (audited override; see the const).
-			captainManualAuthorityKey: true,
-		},
+		config,
 		nil, // Use default RealClock for production
 	)
 
@@ -48,6 +69,57 @@ func (s *DaemonServer) NavigateShip(ctx context.Context, shipSymbol, destination
 	return containerID, nil
 }
 
+// idempotencyKeyConfigKey is the launch-config key a keyed navigate container
+// records its caller's idempotency key under ("" when the call had none).
+const idempotencyKeyConfigKey = "idempotency_key"
+
+// fuelReservePercentConfigKey is the launch-config key a navigate container
+// records its fuel reserve override under; absent means the fleet-wide reserve.
+const fuelReservePercentConfigKey = "fuel_reserve_percent"
+
+// findIdempotentNavigation returns the navigate container an earlier call with
+// idempotencyKey launched, when a retry should reuse it: it is still pending or
+// running, or it completed and the ship is at the destination. "" means launch
+// a new one. A key first used for another ship or destination is an error.
+func (s *DaemonServer) findIdempotentNavigation(ctx context.Context, shipSymbol, destination string, playerID int, idempotencyKey string) (string, error) {
+	for _, status := range []container.ContainerStatus{
+		container.ContainerStatusRunning,
+		container.ContainerStatusPending,
+		container.ContainerStatusCompleted,
+	} {
+		models, err := s.containerRepo.ListByStatus(ctx, status, &playerID)
+		if err != nil {
+			return "", fmt.Errorf("failed to list containers: %w", err)
+		}
+		for _, m := range models {
+			if m.ContainerType != string(container.ContainerTypeNavigate) {
+				continue
+			}
+			var config map[string]interface{}
+			if err := json.Unmarshal([]byte(m.Config), &config); err != nil {
+				continue
+			}
+			if key, _ := config[idempotencyKeyConfigKey].(string); key != idempotencyKey {
+				continue
+			}
+			ship, _ := config["ship_symbol"].(string)
+			dest, _ := config["destination"].(string)
+			if ship != shipSymbol || dest != destination {
+				return "", fmt.Errorf("idempotency key %q was already used for %s -> %s", idempotencyKey, ship, dest)
+			}
+			if status != container.ContainerStatusCompleted {
+				return m.ID, nil
+			}
+			arrived, err := s.shipRepo.FindBySymbol(ctx, shipSymbol, shared.MustNewPlayerID(playerID))
+			if err == nil && arrived.CurrentLocation().Symbol == destination {
+				return m.ID, nil
+			}
+			return "", nil
+		}
+	}
+	return "", nil
+}
+
 // RouteShip handles cross-system point-to-point travel requests (sp-6hjw). It is the
 // daemon side of the `ship route` verb: unlike NavigateShip (which dispatches the
 // in-system-only NavigateRouteCommand and fails cross-system with "waypoint not found
@@ -10,6 +10,7 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"github.com/andrescamacho/spacetraders-go/internal/adapters/persistence"
+	shipNav "github.com/andrescamacho/spacetraders-go/internal/application/ship/commands/navigation"
 	"github.com/andrescamacho/spacetraders-go/internal/domain/container"
 	"github.com/andrescamacho/spacetraders-go/internal/domain/navigation"
 	"github.com/andrescamacho/spacetraders-go/internal/domain/shared"
@@ -169,7 +170,7 @@ func TestCaptainManualCLIOpsStampAuthorityFlag(t *testing.T) {
 		call func(s *DaemonServer, ship string, pid int) (string, error)
 	}{
 		{"navigate", func(s *DaemonServer, ship string, pid int) (string, error) {
-			return s.NavigateShip(context.Background(), ship, "X1-TR-A1", pid)
+			return s.NavigateShip(context.Background(), ship, "X1-TR-A1", pid, "", nil)
 		}},
 		{"route", func(s *DaemonServer, ship string, pid int) (string, error) {
 			return s.RouteShip(context.Background(), ship, "X1-TR-A1", pid)
@@ -294,3 +295,26 @@ func TestWithoutCaptainAuthorityCaptainReservationStillRejected(t *testing.T) {
 		t.Fatalf("no pass-through may be logged without the captain-authority flag: %+v", logs.snapshot())
 	}
 }
+
+// A navigate container's fuel reserve override survives recovery; a container
+// launched without one rebuilds without one, so it keeps the fleet policy.
+func TestBuildNavigateShipCommand_RestoresFuelReserveOverride(t *testing.T) {
+	s := newFactoryTestServer()
+
+	cmd, err := s.buildCommandForType("navigate_ship", jsonRoundTrip(t, map[string]interface{}{
+		"ship_symbol":               "SHIP-1",
+		"destination":               "X1-TR-A1",
+		fuelReservePercentConfigKey: 0,
+	}), 7, "nav-1")
+	require.NoError(t, err)
+	nav := cmd.(*shipNav.NavigateRouteCommand)
+	require.NotNil(t, nav.FuelReservePercent)
+	require.Equal(t, 0, *nav.FuelReservePercent)
+
+	cmd, err = s.buildCommandForType("navigate_ship", jsonRoundTrip(t, map[string]interface{}{
+		"ship_symbol": "SHIP-1",
+		"destination": "X1-TR-A1",
+	}), 7, "nav-2")
+	require.NoError(t, err)
+	require.Nil(t, cmd.(*shipNav.NavigateRouteCommand).FuelReservePercent)
+}
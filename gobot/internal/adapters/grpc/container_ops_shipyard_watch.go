@@ -0,0 +1,89 @@
+package grpc
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/andrescamacho/spacetraders-go/internal/domain/container"
+	"github.com/andrescamacho/spacetraders-go/pkg/utils"
+)
+
+// SetShipyardWatch arms the standing shipyard watch: boot launches one
+// shipyard_watch_coordinator container per player checking the watched yards
+// every interval. Must be called before Start; leaving it unset keeps the
+// watch off.
+func (s *DaemonServer) SetShipyardWatch(interval time.Duration) {
+	if interval <= 0 {
+		return
+	}
+	s.shipyardWatchTickSecs = int(interval / time.Second)
+}
+
+// ShipyardWatchCoordinator creates and starts the standing shipyard watch for a player.
+// The watch records availability windows for the configured targets and, for an
+// auto-purchase target, claims the purchasing ship for this container while it buys
+// (RULINGS #3/#7). The persisted config is the recovery source (RULINGS #2), read back
+// through the same buildCommandForType the creation path uses.
+func (s *DaemonServer) ShipyardWatchCoordinator(ctx context.Context, playerID int, tickIntervalSecs int) (string, error) {
+	// Double-launch guard: ONE watch per player. A twin loop would open duplicate
+	// windows and race the first for the same purchasing ship.
+	existingID, err := firstContainerIDOfType(ctx, s.containerRepo, playerID, container.ContainerTypeShipyardWatch)
+	if err != nil {
+		return "", fmt.Errorf("failed to check for a running shipyard watch: %w", err)
+	}
+	if existingID != "" {
+		return "", fmt.Errorf("shipyard watch already running for player %d (container %s) — stop it first: spacetraders container stop %s",
+			playerID, existingID, existingID)
+	}
+
+	containerID := utils.GenerateContainerID("shipyard_watch_coordinator", fmt.Sprintf("player-%d", playerID))
+
+	config := map[string]interface{}{
+		"container_id":       containerID,
+		"tick_interval_secs": tickIntervalSecs,
+	}
+
+	cmd, err := s.buildCommandForType("shipyard_watch_coordinator", config, playerID, containerID)
+	if err != nil {
+		return "", fmt.Errorf("failed to create shipyard watch command: %w", err)
+	}
+
+	containerEntity := container.NewContainer(
+		containerID,
+		container.ContainerTypeShipyardWatch,
+		playerID,
+		-1,  // Infinite iterations (watch loop) — NOT a CoordinatorOwnsIterations type
+		nil, // No parent container
+		config,
+		nil, // Use default RealClock for production
+	)
+
+	if err := s.containerRepo.Add(ctx, containerEntity, "shipyard_watch_coordinator"); err != nil {
+		return "", fmt.Errorf("failed to persist shipyard watch container: %w", err)
+	}
+
+	s.startContainerRunner(containerEntity, cmd, containerID, "Shipyard watch container")
+
+	return containerID, nil
+}
+
+// ensureShipyardWatchStanding launches the shipyard watch when [shipyard_watch] armed it
+// and none is already running for the player. A warm restart re-adopts the running one
+// through RecoverRunningContainers instead. A launch failure is logged and non-fatal.
+func (s *DaemonServer) ensureShipyardWatchStanding(ctx context.Context, playerID int) {
+	if s.shipyardWatchTickSecs <= 0 {
+		return
+	}
+	running, err := containerTypeRunning(ctx, s.containerRepo, playerID, container.ContainerTypeShipyardWatch)
+	if err != nil {
+		fmt.Printf("Warning: failed to check shipyard watch state: %v\n", err)
+		return
+	}
+	if running {
+		return
+	}
+	if _, lerr := s.ShipyardWatchCoordinator(ctx, playerID, s.shipyardWatchTickSecs); lerr != nil {
+		fmt.Printf("Warning: failed to launch boot-standing shipyard watch: %v\n", lerr)
+	}
+}
@@ -0,0 +1,79 @@
+package grpc
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/andrescamacho/spacetraders-go/internal/adapters/persistence"
+	fleetCmd "github.com/andrescamacho/spacetraders-go/internal/application/fleet/commands"
+	"github.com/andrescamacho/spacetraders-go/internal/domain/container"
+	"github.com/andrescamacho/spacetraders-go/internal/domain/shared"
+)
+
+// The shipyard watch stands at boot only when [shipyard_watch] armed it, and a warm
+// restart with one already RUNNING launches no twin.
+func TestEnsureShipyardWatchStanding_LaunchesOnlyWhenArmed(t *testing.T) {
+	s, db, playerID := newRecoveryTestServer(t)
+	s.playerRepo = persistence.NewGormPlayerRepository(db)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	s.ensureShipyardWatchStanding(ctx, playerID)
+	require.Equal(t, int64(0), countContainersOfType(t, db, playerID, container.ContainerTypeShipyardWatch),
+		"an unarmed shipyard watch must not be launched")
+
+	s.SetShipyardWatch(2 * time.Minute)
+	s.ensureShipyardWatchStanding(ctx, playerID)
+	require.Equal(t, int64(1), countContainersOfType(t, db, playerID, container.ContainerTypeShipyardWatch),
+		"an armed shipyard watch must be launched once")
+
+	var model persistence.ContainerModel
+	require.NoError(t, db.Where("player_id = ? AND container_type = ?", playerID,
+		string(container.ContainerTypeShipyardWatch)).First(&model).Error)
+	require.Contains(t, model.Config, `"tick_interval_secs":120`)
+
+	s.ensureShipyardWatchStanding(ctx, playerID)
+	require.Equal(t, int64(1), countContainersOfType(t, db, playerID, container.ContainerTypeShipyardWatch),
+		"a running shipyard watch must not be launched twice")
+}
+
+type fixedMeasuredDemand struct {
+	m  fleetCmd.MeasuredClassDemand
+	ok bool
+}
+
+func (f fixedMeasuredDemand) MeasuredDemand(int, fleetCmd.HullClass) (fleetCmd.MeasuredClassDemand, bool) {
+	return f.m, f.ok
+}
+
+// Only a fresh, readable autosizer measurement counts as demand.
+func TestShipyardWatchDemandReader_FailsClosedOnMissingOrStaleDemand(t *testing.T) {
+	now := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	clock := shared.NewSimulatedClock(now)
+	player := shared.MustNewPlayerID(1)
+	measured := func(readable bool, age time.Duration) fixedMeasuredDemand {
+		return fixedMeasuredDemand{ok: true, m: fleetCmd.MeasuredClassDemand{
+			Demand:     fleetCmd.ClassDemand{Demand: 4, Current: 1, Readable: readable},
+			MeasuredAt: now.Add(-age),
+		}}
+	}
+
+	shortfall, readable, err := NewShipyardWatchDemandReader(measured(true, 10*time.Minute), 30*time.Minute, clock).Shortfall(context.Background(), player, "light")
+	require.NoError(t, err)
+	require.True(t, readable)
+	require.Equal(t, 3, shortfall)
+
+	for name, source := range map[string]fixedMeasuredDemand{
+		"unmeasured": {},
+		"unreadable": measured(false, time.Minute),
+		"stale":      measured(true, time.Hour),
+	} {
+		_, readable, err := NewShipyardWatchDemandReader(source, 30*time.Minute, clock).Shortfall(context.Background(), player, "light")
+		require.NoError(t, err, name)
+		require.False(t, readable, name)
+	}
+}
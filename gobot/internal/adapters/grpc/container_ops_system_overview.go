@@ -0,0 +1,33 @@
+package grpc
+
+import (
+	"context"
+	"fmt"
+
+	systemQuery "github.com/andrescamacho/spacetraders-go/internal/application/system/queries"
+	"github.com/andrescamacho/spacetraders-go/internal/domain/shared"
+)
+
+// GetSystemOverview gathers the fleet's ships, containers, market freshness,
+// contract deliveries and top arbitrage lanes for one system in one query.
+func (s *DaemonServer) GetSystemOverview(
+	ctx context.Context,
+	playerID int,
+	systemSymbol string,
+	maxLanes int,
+) (*systemQuery.GetSystemOverviewResponse, error) {
+	response, err := s.mediator.Send(ctx, &systemQuery.GetSystemOverviewQuery{
+		PlayerID:     shared.MustNewPlayerID(playerID),
+		SystemSymbol: systemSymbol,
+		MaxLanes:     maxLanes,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get system overview: %w", err)
+	}
+
+	overviewResp, ok := response.(*systemQuery.GetSystemOverviewResponse)
+	if !ok {
+		return nil, fmt.Errorf("unexpected response type")
+	}
+	return overviewResp, nil
+}
@@ -0,0 +1,98 @@
+package grpc
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/andrescamacho/spacetraders-go/internal/adapters/persistence"
+	systemCmd "github.com/andrescamacho/spacetraders-go/internal/application/system/commands"
+	"github.com/andrescamacho/spacetraders-go/internal/domain/container"
+	"github.com/andrescamacho/spacetraders-go/pkg/utils"
+)
+
+// warmupProgressConfigKey is the container config key the warm-up progress is
+// merged under; `container get` shows it alongside the launch knobs.
+const warmupProgressConfigKey = "progress"
+
+// WarmSystem launches a one-shot background warm-up of a system: it pre-fetches the
+// waypoint graph, then scans the markets and shipyards the player has ships at, so a
+// later navigation command into the system does not page the waypoint list inline.
+// The launch config is the recovery source (rebuilt by buildWarmSystemCommand) and
+// the handler merges its progress into the same config as it runs.
+func (s *DaemonServer) WarmSystem(ctx context.Context, playerID int, systemSymbol string, marketMaxAge time.Duration) (string, error) {
+	if systemSymbol == "" {
+		return "", fmt.Errorf("system symbol is required")
+	}
+
+	containerID := utils.GenerateContainerID("warm", systemSymbol)
+
+	config := map[string]interface{}{
+		"container_id":        containerID,
+		"system_symbol":       systemSymbol,
+		"market_max_age_secs": int(marketMaxAge.Seconds()),
+	}
+
+	cmd, err := s.buildCommandForType("system_warmup", config, playerID, containerID)
+	if err != nil {
+		return "", fmt.Errorf("failed to create system warm-up command: %w", err)
+	}
+
+	containerEntity := container.NewContainer(
+		containerID,
+		container.ContainerTypeSystemWarmup,
+		playerID,
+		1,   // Single iteration: one pass over the system
+		nil, // No parent container
+		config,
+		nil, // Use default RealClock for production
+	)
+
+	if err := s.containerRepo.Add(ctx, containerEntity, "system_warmup"); err != nil {
+		return "", fmt.Errorf("failed to persist system warm-up container: %w", err)
+	}
+
+	s.startContainerRunner(containerEntity, cmd, containerID, "System warm-up container")
+
+	return containerID, nil
+}
+
+// SystemWarmupConfigPersister backs the warm-up handler's WarmupProgressPersister with
+// the container config. Like ArbCostConfigPersister it is a read-modify-write of the
+// config map written back as a single column, so it never clobbers the status and
+// heartbeat columns the runner updates concurrently.
+type SystemWarmupConfigPersister struct {
+	containerRepo *persistence.ContainerRepositoryGORM
+}
+
+// NewSystemWarmupConfigPersister wires the config-backed warm-up progress store.
+func NewSystemWarmupConfigPersister(containerRepo *persistence.ContainerRepositoryGORM) *SystemWarmupConfigPersister {
+	return &SystemWarmupConfigPersister{containerRepo: containerRepo}
+}
+
+// PersistWarmupProgress merges progress into the container's persisted config under
+// the "progress" key, preserving the launch knobs the recovery rebuild reads.
+func (p *SystemWarmupConfigPersister) PersistWarmupProgress(ctx context.Context, containerID string, playerID int, progress systemCmd.SystemWarmupProgress) error {
+	model, err := p.containerRepo.Get(ctx, containerID, playerID)
+	if err != nil {
+		return fmt.Errorf("load container %s to persist warm-up progress: %w", containerID, err)
+	}
+	if model == nil {
+		return fmt.Errorf("container %s not found - cannot persist warm-up progress", containerID)
+	}
+
+	config := map[string]interface{}{}
+	if model.Config != "" {
+		if uerr := json.Unmarshal([]byte(model.Config), &config); uerr != nil {
+			return fmt.Errorf("deserialize container %s config to persist warm-up progress: %w", containerID, uerr)
+		}
+	}
+	config[warmupProgressConfigKey] = progress
+
+	merged, err := json.Marshal(config)
+	if err != nil {
+		return fmt.Errorf("serialize container %s config after merging warm-up progress: %w", containerID, err)
+	}
+	return p.containerRepo.UpdateContainerConfig(ctx, containerID, playerID, string(merged))
+}
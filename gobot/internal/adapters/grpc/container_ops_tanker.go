@@ -0,0 +1,78 @@
+package grpc
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/andrescamacho/spacetraders-go/internal/domain/container"
+	"github.com/andrescamacho/spacetraders-go/pkg/utils"
+)
+
+// TankerCoordinatorParams carries the tanker coordinator's launch knobs. Tankers come
+// from TankerShips and/or TankerShipTag; everything else is optional and a zero value
+// uses the coordinator's own default.
+type TankerCoordinatorParams struct {
+	TankerShips      []string
+	TankerShipTag    string
+	Stations         []string
+	FuelSource       string
+	RefuelBelowPct   int
+	ReloadBelowUnits int
+	TickIntervalSecs int
+}
+
+// TankerCoordinator creates and starts the standing tanker coordinator for a player,
+// mirroring ProbeParkingCoordinator: one per player, keyed by player, with the persisted
+// config as the recovery source read back through buildCommandForType
+// (tanker_coordinator → buildTankerCoordinatorCommand). Nothing launches it at boot.
+func (s *DaemonServer) TankerCoordinator(ctx context.Context, playerID int, params TankerCoordinatorParams) (string, error) {
+	if len(params.TankerShips) == 0 && params.TankerShipTag == "" {
+		return "", fmt.Errorf("at least one tanker ship or a tanker ship tag is required")
+	}
+
+	// Double-launch guard: two coordinators would fight over the same tankers.
+	existingID, err := firstContainerIDOfType(ctx, s.containerRepo, playerID, container.ContainerTypeTankerCoordinator)
+	if err != nil {
+		return "", fmt.Errorf("failed to check for a running tanker coordinator: %w", err)
+	}
+	if existingID != "" {
+		return "", fmt.Errorf("tanker coordinator already running for player %d (container %s) — stop it first: spacetraders container stop %s",
+			playerID, existingID, existingID)
+	}
+
+	containerID := utils.GenerateContainerID("tanker_coordinator", fmt.Sprintf("player-%d", playerID))
+
+	config := map[string]interface{}{
+		"container_id":       containerID,
+		"tanker_ships":       params.TankerShips,
+		"tanker_ship_tag":    params.TankerShipTag,
+		"stations":           params.Stations,
+		"fuel_source":        params.FuelSource,
+		"refuel_below_pct":   params.RefuelBelowPct,
+		"reload_below_units": params.ReloadBelowUnits,
+		"tick_interval_secs": params.TickIntervalSecs,
+	}
+
+	cmd, err := s.buildCommandForType("tanker_coordinator", config, playerID, containerID)
+	if err != nil {
+		return "", fmt.Errorf("failed to create tanker coordinator command: %w", err)
+	}
+
+	containerEntity := container.NewContainer(
+		containerID,
+		container.ContainerTypeTankerCoordinator,
+		playerID,
+		-1,  // Infinite iterations (reconcile loop) — NOT a CoordinatorOwnsIterations type
+		nil, // No parent container
+		config,
+		nil, // Use default RealClock for production
+	)
+
+	if err := s.containerRepo.Add(ctx, containerEntity, "tanker_coordinator"); err != nil {
+		return "", fmt.Errorf("failed to persist tanker coordinator container: %w", err)
+	}
+
+	s.startContainerRunner(containerEntity, cmd, containerID, "Tanker coordinator container")
+
+	return containerID, nil
+}
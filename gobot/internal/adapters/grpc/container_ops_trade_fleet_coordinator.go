@@ -129,6 +129,9 @@ var tradeFleetConfigKeys = []string{
 	"trade_fleet_masspark_min_hulls",
 	"trade_fleet_reap_stale_captain_reservations_enabled",
 	"trade_fleet_reap_idle_threshold_secs",
+	"trade_fleet_min_size",
+	"trade_fleet_max_size",
+	"trade_fleet_sizing_interval_minutes",
 }
 
 // resolveTradeFleetConfig makes config.yaml the single LIVE source of truth for the
@@ -216,4 +219,15 @@ func (s *DaemonServer) injectTradeFleetConfig(config map[string]interface{}) {
 	if tf.ReapIdleThresholdSeconds != 0 {
 		config["trade_fleet_reap_idle_threshold_secs"] = tf.ReapIdleThresholdSeconds
 	}
+	// Dynamic sizing is off unless the captain sets max_fleet_size; min and the window defer
+	// to the coordinator (0 / 60 min) when unset.
+	if tf.MinFleetSize != 0 {
+		config["trade_fleet_min_size"] = tf.MinFleetSize
+	}
+	if tf.MaxFleetSize != 0 {
+		config["trade_fleet_max_size"] = tf.MaxFleetSize
+	}
+	if tf.SizingIntervalMinutes != 0 {
+		config["trade_fleet_sizing_interval_minutes"] = tf.SizingIntervalMinutes
+	}
 }
@@ -80,6 +80,23 @@ func TestTradeFleetConfig_KnobsRoundTrip(t *testing.T) {
 	require.Equal(t, int64(50000), cmd.WorkingCapitalReserve)
 }
 
+// The dynamic-sizing bounds land on the command as set; the window crosses from
+// minutes on config to seconds on the command.
+func TestTradeFleetConfig_SizingKnobsRoundTrip(t *testing.T) {
+	cmd := buildTradeCmd(t, config.TradeFleetConfig{
+		Enabled:               boolPtr(true),
+		MinFleetSize:          2,
+		MaxFleetSize:          9,
+		SizingIntervalMinutes: 30,
+	})
+	require.Equal(t, 2, cmd.MinFleetSize)
+	require.Equal(t, 9, cmd.MaxFleetSize)
+	require.Equal(t, 1800, cmd.SizingIntervalSecs)
+
+	unset := buildTradeCmd(t, config.TradeFleetConfig{Enabled: boolPtr(true)})
+	require.Zero(t, unset.MaxFleetSize, "sizing must stay off unless the captain sets a max")
+}
+
 // resolveTradeFleetConfig makes config.yaml the live source of truth: a stale knob
 // persisted at a prior boot is cleared and NOT allowed to shadow the current (now
 // unset) live value, while the coordinator's identity keys survive untouched. This is
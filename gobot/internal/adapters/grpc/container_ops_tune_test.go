@@ -166,7 +166,7 @@ func tuneProbeTxn(t *testing.T, playerID int, ts time.Time, price int) *ledger.T
 	tx, err := ledger.NewTransaction(
 		shared.MustNewPlayerID(playerID), ts, ledger.TransactionTypePurchaseShip,
 		-price, price+10, 10, "Purchased SHIP_PROBE",
-		map[string]interface{}{"ship_type": "SHIP_PROBE"}, "", "", "tune-test",
+		map[string]interface{}{"ship_type": "SHIP_PROBE"}, "", "", "tune-test", "",
 	)
 	require.NoError(t, err)
 	return tx
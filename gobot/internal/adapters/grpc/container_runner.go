@@ -108,6 +108,34 @@ type ContainerRunner struct {
 	taskIncomplete       bool
 	taskIncompleteReason string
 
+	// logLevel is the threshold Log filters on, seeded from the container's
+	// log_level config and changed at runtime by SetLogLevel. Guarded by mu.
+	logLevel string
+
+	// terminationReason is set when the runner itself terminates the container
+	// (max_runtime exceeded) so the execute loop's ctx-cancel exits release the
+	// ships under that terminal reason instead of the resumable "canceled".
+	// Guarded by mu.
+	terminationReason string
+
+	// pausedAt is when the current pause began; resumed is closed when it ends.
+	// Both are set by Pause and guarded by mu.
+	pausedAt time.Time
+	resumed  chan struct{}
+
+	// terminationRecorder is told about max_runtime terminations; nil = no-op.
+	terminationRecorder RuntimeTerminationRecorder
+
+	// failureListener is told once the container has terminally FAILED, so its
+	// dependents can be blocked; nil = no-op.
+	failureListener ContainerFailureListener
+
+	// shipLeases holds the lease on the hull this container claims, renewed
+	// from the heartbeat; nil = no leases. leasedShip is the hull it holds a
+	// lease on, guarded by mu.
+	shipLeases *container.ShipAssignmentManager
+	leasedShip string
+
 	// Heartbeat control
 	heartbeatStop chan struct{} // Signal to stop heartbeat goroutine
 	heartbeatDone chan struct{} // Signal that heartbeat goroutine has stopped
@@ -121,6 +149,33 @@ type ContainerRunner struct {
 	logs []LogEntry
 }
 
+// exitReasonRuntimeExceeded is the exit and ship-release reason of a container
+// stopped for running past its max_runtime budget.
+const exitReasonRuntimeExceeded = "runtime_exceeded"
+
+// pauseDrainWindow is how long a paused container's API calls keep flowing so the
+// iteration in flight can finish. Coordinators that loop inside a single
+// iteration never finish one, so after the window their calls wait at the pause
+// gate instead.
+var pauseDrainWindow = 2 * time.Minute
+
+// pausePollInterval bounds how long a paused runner takes to notice the STOPPING
+// flag a graceful daemon shutdown sets without cancelling its context.
+const pausePollInterval = time.Second
+
+// RuntimeTerminationRecorder records containers auto-terminated for exceeding
+// their max_runtime. Implemented by daemon.HealthMonitor.
+type RuntimeTerminationRecorder interface {
+	RecordRuntimeTermination(containerID, containerType string, runtime time.Duration)
+}
+
+// ContainerFailureListener is told when a container terminally fails — never on
+// an iteration the restart loop retries. Implemented by DaemonServer to block
+// the failed container's dependents.
+type ContainerFailureListener interface {
+	ContainerFailed(containerID string, playerID int)
+}
+
 // LogEntry represents a single log message from a container
 type LogEntry struct {
 	Timestamp time.Time
@@ -158,6 +213,7 @@ func NewContainerRunner(
 		heartbeatStop:   make(chan struct{}),
 		heartbeatDone:   make(chan struct{}),
 		logs:            make([]LogEntry, 0),
+		logLevel:        container.LogLevelFromConfig(containerEntity.Metadata()),
 	}
 }
 
@@ -167,6 +223,24 @@ func (r *ContainerRunner) SetEventPublisher(publisher navigation.ShipEventPublis
 	r.eventPublisher = publisher
 }
 
+// SetRuntimeTerminationRecorder sets the recorder told about max_runtime
+// terminations. This should be called before Start().
+func (r *ContainerRunner) SetRuntimeTerminationRecorder(recorder RuntimeTerminationRecorder) {
+	r.terminationRecorder = recorder
+}
+
+// SetFailureListener sets the listener told about terminal failures. This
+// should be called before Start().
+func (r *ContainerRunner) SetFailureListener(listener ContainerFailureListener) {
+	r.failureListener = listener
+}
+
+// SetShipLeases sets the manager this container leases its hull from. This
+// should be called before Start().
+func (r *ContainerRunner) SetShipLeases(leases *container.ShipAssignmentManager) {
+	r.shipLeases = leases
+}
+
 // Container returns the underlying container entity
 func (r *ContainerRunner) Container() *container.Container {
 	r.mu.RLock()
@@ -185,6 +259,13 @@ func (r *ContainerRunner) Start() error {
 
 	r.log("INFO", "Container started", nil)
 
+	// A container paused before a daemon restart comes back paused.
+	if container.PausedFromConfig(r.containerEntity.Metadata()) {
+		if err := r.Pause(); err != nil {
+			r.log("WARNING", fmt.Sprintf("Failed to restore pause: %v", err), nil)
+		}
+	}
+
 	// Persist status update to database (RUNNING)
 	if r.containerRepo != nil {
 		ctx, cancel := context.WithTimeout(context.Background(), dbOperationTimeout)
@@ -224,6 +305,14 @@ func (r *ContainerRunner) Start() error {
 	// and the container itself keeps running.
 	go supervise.Guard("container-heartbeat:"+r.containerEntity.ID(), r.runHeartbeat)
 
+	// Enforce the max_runtime budget, if any. Iteration budgets cannot bound a
+	// coordinator that loops inside a single Handle() and never counts one.
+	if maxRuntime := r.containerEntity.MaxRuntime(); maxRuntime > 0 {
+		go supervise.Guard("container-runtime-limit:"+r.containerEntity.ID(), func() {
+			r.enforceMaxRuntime(maxRuntime)
+		})
+	}
+
 	// Execute the container operation
 	go r.execute()
 
@@ -269,10 +358,96 @@ func (r *ContainerRunner) terminalizeClaimFailure(err error) {
 
 	r.releaseShipAssignments("claim_failed")
 	r.signalCompletionWithStatus(false, err.Error())
+	r.notifyFailed()
+}
+
+// Pause holds the container. The iteration in flight is left to finish, no new
+// iteration starts, and its ships stay claimed, since the heartbeat keeps
+// running. API calls beneath the container wait at its pause gate once
+// pauseDrainWindow has passed.
+func (r *ContainerRunner) Pause() error {
+	r.mu.Lock()
+	if err := r.containerEntity.Pause(); err != nil {
+		r.mu.Unlock()
+		return err
+	}
+	r.pausedAt = r.clock.Now()
+	r.resumed = make(chan struct{})
+	r.mu.Unlock()
+
+	r.log("INFO", "Container paused", nil)
+	return nil
+}
+
+// Resume releases a paused container: a held iteration loop starts its next
+// iteration and API calls waiting at the pause gate go through.
+func (r *ContainerRunner) Resume() error {
+	r.mu.Lock()
+	if err := r.containerEntity.Resume(); err != nil {
+		r.mu.Unlock()
+		return err
+	}
+	close(r.resumed)
+	r.mu.Unlock()
+
+	r.log("INFO", "Container resumed", nil)
+	return nil
+}
+
+// WaitWhilePaused is the container's pause gate (shared.PauseGate). It returns
+// at once unless the container has been paused for longer than
+// pauseDrainWindow, and otherwise waits for awaitResume.
+func (r *ContainerRunner) WaitWhilePaused(ctx context.Context) error {
+	r.mu.RLock()
+	draining := !r.containerEntity.IsPaused() || r.clock.Now().Sub(r.pausedAt) < pauseDrainWindow
+	r.mu.RUnlock()
+	if draining {
+		return nil
+	}
+	return r.awaitResume(ctx)
+}
+
+// awaitResume blocks while the container is paused. It returns nil once the
+// container is resumed or asked to stop, so a graceful shutdown still reaches
+// the caller's exit path, and ctx's error if ctx is done first.
+func (r *ContainerRunner) awaitResume(ctx context.Context) error {
+	for {
+		r.mu.RLock()
+		paused := r.containerEntity.IsPaused()
+		resumed := r.resumed
+		r.mu.RUnlock()
+		if !paused {
+			return nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-resumed:
+		case <-time.After(pausePollInterval):
+		}
+	}
 }
 
 // Stop gracefully stops the container
 func (r *ContainerRunner) Stop() error {
+	return r.stop("stopped")
+}
+
+// Block stops the container because a dependency failed, persisting BLOCKED
+// instead of STOPPED so the next recovery pass re-evaluates it.
+func (r *ContainerRunner) Block(reason string) error {
+	return r.stopAs(container.ContainerStatusBlocked, reason)
+}
+
+// stop gracefully stops the container, persisting STOPPED with exitReason and
+// releasing its ships under the same reason.
+func (r *ContainerRunner) stop(exitReason string) error {
+	return r.stopAs(container.ContainerStatusStopped, exitReason)
+}
+
+// stopAs gracefully stops the container and persists status with exitReason.
+func (r *ContainerRunner) stopAs(status container.ContainerStatus, exitReason string) error {
 	r.mu.Lock()
 	if err := r.containerEntity.Stop(); err != nil {
 		r.mu.Unlock()
@@ -300,7 +475,7 @@ func (r *ContainerRunner) Stop() error {
 	r.containerEntity.MarkStopped()
 	r.mu.Unlock()
 
-	// Persist STOPPED status to database
+	// Persist the stopped status to database
 	if r.containerRepo != nil {
 		ctx, cancel := context.WithTimeout(context.Background(), dbOperationTimeout)
 		defer cancel()
@@ -310,21 +485,60 @@ func (r *ContainerRunner) Stop() error {
 			ctx,
 			r.containerEntity.ID(),
 			r.containerEntity.PlayerID(),
-			container.ContainerStatusStopped,
-			&now,      // stoppedAt
-			nil,       // exitCode (nil for graceful stop)
-			"stopped", // exitReason
+			status,
+			&now,       // stoppedAt
+			nil,        // exitCode (nil for graceful stop)
+			exitReason, // exitReason
 		); err != nil {
-			r.log("ERROR", fmt.Sprintf("Failed to persist STOPPED status: %v", err), nil)
+			r.log("ERROR", fmt.Sprintf("Failed to persist %s status: %v", status, err), nil)
 		}
 	}
 
 	// Release ship assignments for this container
-	r.releaseShipAssignments("stopped")
+	r.releaseShipAssignments(exitReason)
 
 	return nil
 }
 
+// enforceMaxRuntime waits out the container's max_runtime and then terminates
+// it, unless the container finishes or is cancelled first. The budget counts
+// from the container's runtime_started_at when it has one (a recovered
+// container keeps what it had left before the restart), else from now.
+func (r *ContainerRunner) enforceMaxRuntime(maxRuntime time.Duration) {
+	remaining := maxRuntime
+	if startedAt, ok := container.RuntimeStartedAtFromConfig(r.containerEntity.Metadata()); ok {
+		remaining -= r.clock.Now().Sub(startedAt)
+	}
+	if remaining > 0 {
+		select {
+		case <-r.ctx.Done():
+			return
+		case <-r.done:
+			return
+		case <-shared.After(r.clock, remaining):
+		}
+	}
+
+	r.mu.Lock()
+	if r.containerEntity.IsFinished() || r.containerEntity.IsStopping() {
+		r.mu.Unlock()
+		return
+	}
+	r.terminationReason = exitReasonRuntimeExceeded
+	r.mu.Unlock()
+
+	r.log("WARNING", fmt.Sprintf("Max runtime %s exceeded, terminating container", maxRuntime), nil)
+	if err := r.stop(exitReasonRuntimeExceeded); err != nil {
+		r.log("ERROR", fmt.Sprintf("Failed to stop container after max runtime: %v", err), nil)
+		return
+	}
+
+	if r.terminationRecorder != nil {
+		r.terminationRecorder.RecordRuntimeTermination(
+			r.containerEntity.ID(), string(r.containerEntity.Type()), r.containerEntity.RuntimeDuration())
+	}
+}
+
 // runHeartbeat periodically updates the container's heartbeat timestamp
 // This allows detection of crashed containers that stop updating their heartbeat
 func (r *ContainerRunner) runHeartbeat() {
@@ -350,6 +564,7 @@ func (r *ContainerRunner) runHeartbeat() {
 				}
 				cancel()
 			}
+			r.renewShipLease()
 		}
 	}
 }
@@ -433,6 +648,20 @@ func (r *ContainerRunner) execute() {
 			return
 		}
 
+		// A paused container holds here, between iterations, with its ships still
+		// claimed. A stop while held cancels ctx; a graceful shutdown sets
+		// STOPPING, which the checks above pick up on the next pass.
+		r.mu.RLock()
+		isPaused := r.containerEntity.IsPaused()
+		r.mu.RUnlock()
+		if isPaused {
+			if err := r.awaitResume(r.ctx); err != nil {
+				r.log("INFO", "Stop signal received while paused", nil)
+				return
+			}
+			continue
+		}
+
 		// Execute single iteration
 		if err := r.runIterationProtected(); err != nil {
 			// Check if error is due to context cancellation (shutdown signal)
@@ -440,8 +669,7 @@ func (r *ContainerRunner) execute() {
 			if r.ctx.Err() != nil {
 				r.log("INFO", "Context canceled, stopping container", nil)
 				// Signal completion on context cancellation (graceful shutdown)
-				r.signalCompletion()
-				r.releaseShipAssignments("canceled")
+				r.exitCanceled()
 				return
 			}
 
@@ -470,8 +698,7 @@ func (r *ContainerRunner) execute() {
 				// above: signal graceful completion and release the hull.
 				if waitErr := r.sleepOrCancel(backoff); waitErr != nil {
 					r.log("INFO", "Restart backoff canceled by stop/shutdown", nil)
-					r.signalCompletion()
-					r.releaseShipAssignments("canceled")
+					r.exitCanceled()
 					return
 				}
 
@@ -605,6 +832,23 @@ func (r *ContainerRunner) finishCleanExit() {
 	// Signal completion to coordinator (if callback set)
 	// Now safe to signal - ship is fully released
 	r.signalCompletion()
+	r.publishCompleted()
+}
+
+// publishCompleted announces the clean exit on the coordination event bus, so
+// coordinators waiting for freed hulls re-evaluate at once. Runs after the ship
+// release for the same reason signalCompletion does.
+func (r *ContainerRunner) publishCompleted() {
+	publisher := resolveCoordinationPublisher()
+	if publisher == nil {
+		return
+	}
+	publisher.Publish(shared.CoordinationEvent{
+		Topic:    shared.TopicContainerCompleted,
+		PlayerID: r.containerEntity.PlayerID(),
+		Subject:  r.containerEntity.ID(),
+		Payload:  map[string]interface{}{"container_type": string(r.containerEntity.Type())},
+	})
 }
 
 // signalCompletion signals container completion via event bus.
@@ -748,8 +992,10 @@ func (r *ContainerRunner) executeIteration() error {
 		"iteration": r.containerEntity.CurrentIteration() + 1,
 	})
 
-	// Add logger to context so handlers can log
-	ctxWithLogger := common.WithLogger(r.ctx, r)
+	// Add logger to context so handlers can log, and the container ID so every
+	// command the handler dispatches is attributed to this container
+	ctxWithLogger := common.WithContainerID(common.WithLogger(r.ctx, r), r.containerEntity.ID())
+	ctxWithLogger = shared.WithPauseGate(ctxWithLogger, r)
 
 	// Execute command via mediator
 	result, err := r.mediator.Send(ctxWithLogger, r.command)
@@ -836,6 +1082,7 @@ func (r *ContainerRunner) handleError(err error) {
 // still-restarting container keeps its RUNNING row and only flips to FAILED once it
 // truly gives up (always alongside the workflow.failed event). Mirrors the
 // UpdateStatus shape of terminalizeClaimFailure and finishCleanExit's COMPLETED write.
+// Once the row is written the failure listener is told, so dependents are blocked.
 func (r *ContainerRunner) persistFailed(reason string) {
 	if r.containerRepo == nil {
 		return
@@ -856,6 +1103,15 @@ func (r *ContainerRunner) persistFailed(reason string) {
 	); dbErr != nil {
 		r.log("ERROR", fmt.Sprintf("Failed to persist FAILED status: %v", dbErr), nil)
 	}
+	r.notifyFailed()
+}
+
+// notifyFailed tells the failure listener, if any, that the container terminally
+// failed. Called only after the FAILED row is written, so the listener reads it.
+func (r *ContainerRunner) notifyFailed() {
+	if r.failureListener != nil {
+		r.failureListener.ContainerFailed(r.containerEntity.ID(), r.containerEntity.PlayerID())
+	}
 }
 
 // recordCrash surfaces a true, unrecoverable container crash. It logs a single
@@ -879,9 +1135,14 @@ func (r *ContainerRunner) recordCrash(err error) {
 
 // Logging
 
-// Log adds a log entry (implements common.ContainerLogger interface)
+// Log adds a log entry (implements common.ContainerLogger interface). Lines below
+// the container's log level are dropped.
 func (r *ContainerRunner) Log(level, message string, metadata map[string]interface{}) {
 	r.mu.Lock()
+	if !container.LogLevelEnabled(r.logLevel, level) {
+		r.mu.Unlock()
+		return
+	}
 	entry := LogEntry{
 		Timestamp: time.Now(),
 		Level:     level,
@@ -917,6 +1178,21 @@ func (r *ContainerRunner) Log(level, message string, metadata map[string]interfa
 	}()
 }
 
+// LogLevel returns the level this container currently logs at.
+func (r *ContainerRunner) LogLevel() string {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	return r.logLevel
+}
+
+// SetLogLevel changes the level this container logs at, effective for the
+// next line. level must already be normalized.
+func (r *ContainerRunner) SetLogLevel(level string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.logLevel = level
+}
+
 // log is a lowercase alias for backward compatibility with existing code
 func (r *ContainerRunner) log(level, message string, metadata map[string]interface{}) {
 	r.Log(level, message, metadata)
@@ -1050,6 +1326,7 @@ func (r *ContainerRunner) attemptClaimShip(shipSymbol, operation string, captain
 			return fmt.Errorf("failed to claim ship %s: %w", shipSymbol, err)
 		}
 		r.log("INFO", fmt.Sprintf("Claimed ship %s for container (operation %s)", shipSymbol, operation), nil)
+		r.leaseShip(ctx, shipSymbol, playerID)
 		return nil
 	}
 
@@ -1061,6 +1338,7 @@ func (r *ContainerRunner) attemptClaimShip(shipSymbol, operation string, captain
 	// Idempotent for a recovered container that already holds this claim.
 	if ship.IsAssigned() && ship.ContainerID() == r.containerEntity.ID() {
 		r.log("INFO", fmt.Sprintf("Ship %s already assigned to this container (recovered)", shipSymbol), nil)
+		r.leaseShip(ctx, shipSymbol, playerID)
 		return nil
 	}
 
@@ -1141,9 +1419,39 @@ func (r *ContainerRunner) attemptClaimShip(shipSymbol, operation string, captain
 	}
 
 	r.log("INFO", fmt.Sprintf("Assigned ship %s to container", shipSymbol), nil)
+	r.leaseShip(ctx, shipSymbol, playerID)
 	return nil
 }
 
+// leaseShip takes the lease on a hull this container has just claimed. The
+// claim on the ship aggregate stays the authority on ownership, so a lease the
+// manager refuses is logged rather than failing the container.
+func (r *ContainerRunner) leaseShip(ctx context.Context, shipSymbol string, playerID shared.PlayerID) {
+	if r.shipLeases == nil {
+		return
+	}
+	if _, err := r.shipLeases.LeaseShip(ctx, shipSymbol, playerID.Value(), r.containerEntity.ID(), container.LeasePriorityNormal, nil); err != nil {
+		r.log("WARNING", fmt.Sprintf("Failed to lease ship %s: %v", shipSymbol, err), nil)
+		return
+	}
+	r.mu.Lock()
+	r.leasedShip = shipSymbol
+	r.mu.Unlock()
+}
+
+// renewShipLease renews the lease on this container's hull, if it holds one.
+func (r *ContainerRunner) renewShipLease() {
+	r.mu.RLock()
+	shipSymbol := r.leasedShip
+	r.mu.RUnlock()
+	if shipSymbol == "" {
+		return
+	}
+	if err := r.shipLeases.RenewLease(shipSymbol, r.containerEntity.ID(), false); err != nil {
+		r.log("WARNING", fmt.Sprintf("Failed to renew lease on ship %s: %v", shipSymbol, err), nil)
+	}
+}
+
 // isTransientClaimError reports whether a claim failure is the transient
 // claim-handoff race (sp-ku8e) — the hull is momentarily still assigned to
 // another, just-finished container — and is therefore worth a brief retry. A
@@ -1179,6 +1487,24 @@ func (r *ContainerRunner) sleepOrCancel(d time.Duration) error {
 	}
 }
 
+// exitCanceled signals completion and releases the ships of a container whose
+// ctx was cancelled mid-run. A shutdown cancel is a graceful, resumable exit; a
+// runner-initiated termination (max_runtime) is reported as a failure and
+// releases the ships under its terminal reason.
+func (r *ContainerRunner) exitCanceled() {
+	r.mu.RLock()
+	reason := r.terminationReason
+	r.mu.RUnlock()
+
+	if reason == "" {
+		r.signalCompletion()
+		r.releaseShipAssignments("canceled")
+		return
+	}
+	r.signalCompletionWithStatus(false, reason)
+	r.releaseShipAssignments(reason)
+}
+
 // releaseShipAssignments releases all ship assignments for this container
 // flowRemovalWanted reports whether a container exit for the given reason is
 // terminal and should drop the container's flow from the read-only feed. Only the
@@ -1224,7 +1550,13 @@ func (r *ContainerRunner) releaseShipAssignments(reason string) {
 			}); err != nil {
 			r.log("ERROR", fmt.Sprintf("Failed to release ship %s: %v", symbol, err), nil)
 		}
+		if r.shipLeases != nil {
+			r.shipLeases.DropLease(symbol, r.containerEntity.ID(), reason)
+		}
 	}
+	r.mu.Lock()
+	r.leasedShip = ""
+	r.mu.Unlock()
 
 	if len(assignedShips) > 0 {
 		r.log("INFO", fmt.Sprintf("Released %d ship assignments (reason: %s)", len(assignedShips), reason), nil)
@@ -0,0 +1,151 @@
+package grpc
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/andrescamacho/spacetraders-go/internal/domain/container"
+	"github.com/andrescamacho/spacetraders-go/internal/domain/navigation"
+	"github.com/andrescamacho/spacetraders-go/internal/domain/shared"
+)
+
+// releasingShipRepo extends the trade-route fake with the CAS release path the
+// runner uses to hand its hulls back.
+type releasingShipRepo struct {
+	*tradeRouteShipRepo
+}
+
+func (r releasingShipRepo) SaveWithRetry(_ context.Context, symbol string, _ shared.PlayerID, mutate navigation.ShipMutation) (*navigation.Ship, bool, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	ship := r.ships[symbol]
+	changed, err := mutate(ship)
+	return ship, changed, err
+}
+
+type recordedTermination struct {
+	containerID   string
+	containerType string
+}
+
+type fakeTerminationRecorder struct {
+	mu           sync.Mutex
+	terminations []recordedTermination
+}
+
+func (f *fakeTerminationRecorder) RecordRuntimeTermination(containerID, containerType string, _ time.Duration) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.terminations = append(f.terminations, recordedTermination{containerID, containerType})
+}
+
+func (f *fakeTerminationRecorder) recorded() []recordedTermination {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return append([]recordedTermination(nil), f.terminations...)
+}
+
+// instantTimerClock fires every timer at once, so a max_runtime budget
+// elapses as soon as the runner arms it.
+type instantTimerClock struct {
+	*recordingClock
+}
+
+func (c instantTimerClock) After(time.Duration) <-chan time.Time {
+	fired := make(chan time.Time, 1)
+	fired <- c.Now()
+	return fired
+}
+
+// blockedTimerClock never fires a timer.
+type blockedTimerClock struct {
+	*recordingClock
+}
+
+func (blockedTimerClock) After(time.Duration) <-chan time.Time {
+	return nil
+}
+
+// A coordinator that loops inside one Handle() never counts an iteration, so
+// max_iterations cannot bound it. Once its max_runtime elapses the runner must
+// stop it, persist STOPPED runtime_exceeded, release its hull under the same
+// reason and report the termination.
+func TestMaxRuntimeExceeded_StopsContainerAndReleasesShips(t *testing.T) {
+	s, db, playerID := newRecoveryTestServer(t)
+
+	ship := newIdleTradeShip(t, "SHIP-LOOP", playerID)
+	repo := releasingShipRepo{&tradeRouteShipRepo{ships: map[string]*navigation.Ship{"SHIP-LOOP": ship}}}
+
+	const containerID = "goods_factory-LOOP"
+	entity := container.NewContainer(containerID, container.ContainerType("goods_factory_coordinator"), playerID, -1, nil,
+		map[string]interface{}{"ship_symbol": "SHIP-LOOP", container.MaxRuntimeKey: float64(3600)}, nil)
+	require.NoError(t, s.containerRepo.Add(context.Background(), entity, "goods_factory_coordinator"))
+
+	clock := instantTimerClock{&recordingClock{current: time.Date(2026, 7, 10, 0, 0, 0, 0, time.UTC)}}
+	med := &ctxEnteredBlockingMediator{entered: make(chan struct{})}
+	runner := NewContainerRunner(entity, med, nil, noopLogRepo{}, s.containerRepo, repo, clock)
+	recorder := &fakeTerminationRecorder{}
+	runner.SetRuntimeTerminationRecorder(recorder)
+
+	require.NoError(t, runner.Start())
+
+	require.Eventually(t, func() bool { return len(recorder.recorded()) == 1 }, 5*time.Second, 10*time.Millisecond)
+	require.Equal(t, recordedTermination{containerID, "goods_factory_coordinator"}, recorder.recorded()[0])
+
+	requireContainerState(t, db, containerID, "STOPPED", exitReasonRuntimeExceeded)
+	require.False(t, ship.IsAssigned())
+	require.Equal(t, exitReasonRuntimeExceeded, *ship.Assignment().ReleaseReason())
+}
+
+// Without a max_runtime the watchdog never arms: the container keeps running.
+func TestMaxRuntimeUnset_DoesNotTerminate(t *testing.T) {
+	s, _, playerID := newRecoveryTestServer(t)
+
+	const containerID = "goods_factory-FOREVER"
+	entity := container.NewContainer(containerID, container.ContainerType("goods_factory_coordinator"), playerID, -1, nil,
+		map[string]interface{}{}, nil)
+	require.NoError(t, s.containerRepo.Add(context.Background(), entity, "goods_factory_coordinator"))
+
+	clock := &recordingClock{current: time.Date(2026, 7, 10, 0, 0, 0, 0, time.UTC)}
+	med := &ctxEnteredBlockingMediator{entered: make(chan struct{})}
+	runner := NewContainerRunner(entity, med, nil, noopLogRepo{}, s.containerRepo, nil, clock)
+	recorder := &fakeTerminationRecorder{}
+	runner.SetRuntimeTerminationRecorder(recorder)
+
+	require.NoError(t, runner.Start())
+	<-med.entered
+
+	require.Empty(t, recorder.recorded())
+	require.True(t, runner.Container().IsRunning())
+	require.NoError(t, runner.Stop())
+}
+
+// A container recovered after a restart keeps the budget it had left: one
+// launched two hours ago with a one-hour max_runtime is stopped at once,
+// without waiting on a timer.
+func TestMaxRuntime_CountsFromPersistedStart(t *testing.T) {
+	s, db, playerID := newRecoveryTestServer(t)
+
+	now := time.Date(2026, 7, 10, 0, 0, 0, 0, time.UTC)
+	const containerID = "goods_factory-RECOVERED"
+	entity := container.NewContainer(containerID, container.ContainerType("goods_factory_coordinator"), playerID, -1, nil,
+		map[string]interface{}{
+			container.MaxRuntimeKey:       float64(3600),
+			container.RuntimeStartedAtKey: now.Add(-2 * time.Hour).Format(time.RFC3339Nano),
+		}, nil)
+	require.NoError(t, s.containerRepo.Add(context.Background(), entity, "goods_factory_coordinator"))
+
+	med := &ctxEnteredBlockingMediator{entered: make(chan struct{})}
+	runner := NewContainerRunner(entity, med, nil, noopLogRepo{}, s.containerRepo, nil, blockedTimerClock{&recordingClock{current: now}})
+	recorder := &fakeTerminationRecorder{}
+	runner.SetRuntimeTerminationRecorder(recorder)
+
+	require.NoError(t, runner.Start())
+
+	require.Eventually(t, func() bool { return len(recorder.recorded()) == 1 }, 5*time.Second, 10*time.Millisecond)
+	requireContainerState(t, db, containerID, "STOPPED", exitReasonRuntimeExceeded)
+}
@@ -0,0 +1,140 @@
+package grpc
+
+import (
+	"context"
+	"reflect"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/andrescamacho/spacetraders-go/internal/application/common"
+	"github.com/andrescamacho/spacetraders-go/internal/domain/container"
+	"github.com/andrescamacho/spacetraders-go/internal/domain/shared"
+)
+
+// pauseWindowMediator parks every iteration's Send until released, reporting
+// each entry on entered, so a test can pause the runner mid-iteration.
+type pauseWindowMediator struct {
+	calls   int32
+	entered chan int32
+	release chan struct{}
+}
+
+func (m *pauseWindowMediator) Send(_ context.Context, _ common.Request) (common.Response, error) {
+	m.entered <- atomic.AddInt32(&m.calls, 1)
+	<-m.release
+	return struct{ common.Response }{}, nil
+}
+func (m *pauseWindowMediator) Register(_ reflect.Type, _ common.RequestHandler) error { return nil }
+func (m *pauseWindowMediator) RegisterMiddleware(_ common.Middleware)                 {}
+
+func TestExecute_PausedContainerFinishesItsIterationAndHoldsUntilResumed(t *testing.T) {
+	s, _, playerID := newRecoveryTestServer(t)
+	id := "goods_factory-LAB_INSTRUMENTS-5a1e0c2b"
+	insertRunningContainer(t, s.db, id, "goods_factory_coordinator", "goods_factory_coordinator",
+		`{"target_good":"LAB_INSTRUMENTS","system_symbol":"X1-JP61","max_iterations":2}`, playerID, nil)
+
+	clock := &recordingClock{current: time.Date(2026, 7, 10, 0, 1, 0, 0, time.UTC)}
+	entity := container.NewContainer(id, container.ContainerType("goods_factory_coordinator"), playerID, 2, nil, nil, clock)
+	require.NoError(t, entity.Start())
+
+	med := &pauseWindowMediator{entered: make(chan int32, 2), release: make(chan struct{}, 2)}
+	r := NewContainerRunner(entity, med, nil, noopLogRepo{}, s.containerRepo, nil, clock)
+
+	done := make(chan struct{})
+	go func() { r.execute(); close(done) }()
+
+	require.Equal(t, int32(1), <-med.entered)
+	require.NoError(t, r.Pause())
+	med.release <- struct{}{}
+
+	select {
+	case call := <-med.entered:
+		t.Fatalf("a paused container must not start iteration %d", call)
+	case <-time.After(300 * time.Millisecond):
+	}
+	require.Equal(t, container.ContainerStatusPaused, r.Container().Status())
+	require.Equal(t, 1, r.Container().CurrentIteration(), "the iteration in flight still counts")
+
+	require.NoError(t, r.Resume())
+	select {
+	case call := <-med.entered:
+		require.Equal(t, int32(2), call)
+	case <-time.After(3 * time.Second):
+		t.Fatal("resume never started the next iteration")
+	}
+	med.release <- struct{}{}
+
+	select {
+	case <-done:
+	case <-time.After(3 * time.Second):
+		t.Fatal("the resumed container never finished its budget")
+	}
+	require.Equal(t, "COMPLETED", persistedStatus(t, s, id))
+}
+
+func TestPauseGate_DrainsThenHoldsAPICallsUntilResumed(t *testing.T) {
+	clock := &recordingClock{current: time.Date(2026, 7, 10, 0, 1, 0, 0, time.UTC)}
+	entity := container.NewContainer("tour-1", container.ContainerTypeTrading, 1, -1, nil, nil, clock)
+	require.NoError(t, entity.Start())
+	r := NewContainerRunner(entity, nilCleanMediator{}, nil, noopLogRepo{}, nil, nil, clock)
+	ctx := shared.WithPauseGate(context.Background(), r)
+
+	require.NoError(t, shared.WaitWhilePaused(ctx), "a running container's calls pass")
+
+	require.NoError(t, r.Pause())
+	require.NoError(t, shared.WaitWhilePaused(ctx), "calls keep flowing inside the drain window")
+
+	clock.Sleep(pauseDrainWindow)
+	waited := make(chan error, 1)
+	go func() { waited <- shared.WaitWhilePaused(ctx) }()
+	select {
+	case err := <-waited:
+		t.Fatalf("after the drain window a paused container's call must wait, returned %v", err)
+	case <-time.After(200 * time.Millisecond):
+	}
+
+	require.NoError(t, r.Resume())
+	select {
+	case err := <-waited:
+		require.NoError(t, err)
+	case <-time.After(3 * time.Second):
+		t.Fatal("resume never released the held call")
+	}
+
+	require.NoError(t, r.Pause())
+	clock.Sleep(pauseDrainWindow)
+	cancelled, cancel := context.WithCancel(ctx)
+	cancel()
+	require.ErrorIs(t, shared.WaitWhilePaused(cancelled), context.Canceled)
+}
+
+func TestPauseContainer_PersistsThePauseForRecovery(t *testing.T) {
+	s, _, playerID := newRecoveryTestServer(t)
+	id := "tour_coordinator-1-7c3d9e0f"
+	insertRunningContainer(t, s.db, id, "tour_coordinator", "TOUR_COORDINATOR",
+		`{"system_symbol":"X1-JP61","log_level":"DEBUG"}`, playerID, nil)
+
+	entity := container.NewContainer(id, container.ContainerType("TOUR_COORDINATOR"), playerID, -1, nil, nil, nil)
+	require.NoError(t, entity.Start())
+	s.containers[id] = NewContainerRunner(entity, nilCleanMediator{}, nil, noopLogRepo{}, s.containerRepo, nil, nil)
+
+	_, err := s.PauseContainer(context.Background(), id)
+	require.NoError(t, err)
+	config, found, err := s.PersistedContainerConfig(context.Background(), id, playerID)
+	require.NoError(t, err)
+	require.True(t, found)
+	require.JSONEq(t, `{"system_symbol":"X1-JP61","log_level":"DEBUG","paused":true}`, config)
+
+	_, err = s.PauseContainer(context.Background(), id)
+	require.Error(t, err, "a paused container cannot be paused again")
+
+	_, err = s.ResumeContainer(context.Background(), id)
+	require.NoError(t, err)
+	config, _, err = s.PersistedContainerConfig(context.Background(), id, playerID)
+	require.NoError(t, err)
+	require.JSONEq(t, `{"system_symbol":"X1-JP61","log_level":"DEBUG"}`, config)
+	require.Equal(t, container.ContainerStatusRunning, entity.Status())
+}
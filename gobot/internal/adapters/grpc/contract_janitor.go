@@ -0,0 +1,120 @@
+package grpc
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"sort"
+	"time"
+
+	contractServices "github.com/andrescamacho/spacetraders-go/internal/application/contract/services"
+	"github.com/andrescamacho/spacetraders-go/internal/domain/container"
+	"github.com/andrescamacho/spacetraders-go/internal/domain/shared"
+	"github.com/andrescamacho/spacetraders-go/internal/infrastructure/supervise"
+)
+
+// ContractJanitorRunner expires the live player's lapsed contract offers
+// (implemented by the contract ContractJanitor).
+type ContractJanitorRunner interface {
+	Sweep(ctx context.Context, playerID shared.PlayerID) (contractServices.ContractJanitorResult, error)
+}
+
+// SetContractJanitor arms the contract janitor: Start launches a loop sweeping
+// the live player every interval. Must be called before Start; leaving it unset
+// keeps the janitor off.
+func (s *DaemonServer) SetContractJanitor(janitor ContractJanitorRunner, interval time.Duration) {
+	if janitor == nil || interval <= 0 {
+		return
+	}
+	s.contractJanitor = janitor
+	s.contractJanitorInterval = interval
+}
+
+// runContractJanitor sweeps every interval until ctx is canceled, each sweep
+// under supervise.Guard so a panic in one cannot end the loop.
+func (s *DaemonServer) runContractJanitor(ctx context.Context) error {
+	ticker := time.NewTicker(s.contractJanitorInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+			supervise.Guard("contract-janitor", func() {
+				s.sweepContracts(ctx)
+			})
+		}
+	}
+}
+
+func (s *DaemonServer) sweepContracts(ctx context.Context) {
+	pid := s.primaryPlayerID(ctx)
+	if pid == 0 {
+		return
+	}
+	playerID, err := shared.NewPlayerID(pid)
+	if err != nil {
+		log.Printf("Contract janitor: resolve primary player id %d: %v", pid, err)
+		return
+	}
+	result, err := s.contractJanitor.Sweep(ctx, playerID)
+	if err != nil {
+		log.Printf("Contract janitor failed: %v", err)
+		return
+	}
+	if len(result.Expired) > 0 {
+		log.Printf("Contract janitor: expired %v, stopped %d container(s), replacement %q",
+			result.Expired, result.ContainersStopped, result.Replacement)
+	}
+}
+
+// StopContractContainers implements contractServices.ContractTaskCanceller: it
+// stops every PENDING or RUNNING container whose config names contractID.
+// Stopping a container releases the ships it claimed.
+func (s *DaemonServer) StopContractContainers(ctx context.Context, playerID int, contractID string) (int, error) {
+	stopped := 0
+	for _, status := range []container.ContainerStatus{container.ContainerStatusRunning, container.ContainerStatusPending} {
+		models, err := s.containerRepo.ListByStatus(ctx, status, &playerID)
+		if err != nil {
+			return stopped, fmt.Errorf("failed to list containers: %w", err)
+		}
+		for _, m := range models {
+			var config map[string]interface{}
+			if err := json.Unmarshal([]byte(m.Config), &config); err != nil {
+				continue
+			}
+			if id, _ := config["contract_id"].(string); id != contractID {
+				continue
+			}
+			if err := s.StopContainer(m.ID); err != nil {
+				return stopped, fmt.Errorf("failed to stop container %s: %w", m.ID, err)
+			}
+			stopped++
+		}
+	}
+	return stopped, nil
+}
+
+// RunningContractWorkflowShip implements contractServices.ContractWorkflowLocator:
+// the ship of the first RUNNING contract workflow container by id, or "".
+func (s *DaemonServer) RunningContractWorkflowShip(ctx context.Context, playerID int) (string, error) {
+	models, err := s.containerRepo.ListByStatus(ctx, container.ContainerStatusRunning, &playerID)
+	if err != nil {
+		return "", fmt.Errorf("failed to list containers: %w", err)
+	}
+	sort.Slice(models, func(i, j int) bool { return models[i].ID < models[j].ID })
+	for _, m := range models {
+		if m.ContainerType != string(container.ContainerTypeContractWorkflow) {
+			continue
+		}
+		var config map[string]interface{}
+		if err := json.Unmarshal([]byte(m.Config), &config); err != nil {
+			continue
+		}
+		if ship, _ := config["ship_symbol"].(string); ship != "" {
+			return ship, nil
+		}
+	}
+	return "", nil
+}
@@ -0,0 +1,71 @@
+package grpc
+
+import (
+	"context"
+	"log"
+	"time"
+
+	ledgerServices "github.com/andrescamacho/spacetraders-go/internal/application/ledger/services"
+	"github.com/andrescamacho/spacetraders-go/internal/domain/shared"
+	"github.com/andrescamacho/spacetraders-go/internal/infrastructure/supervise"
+)
+
+// CreditReconcilerRunner compares the live player's API credits with the
+// ledger and books any confirmed drift (implemented by the ledger
+// CreditReconciler).
+type CreditReconcilerRunner interface {
+	Reconcile(ctx context.Context, playerID shared.PlayerID) (ledgerServices.CreditReconciliation, error)
+}
+
+// SetCreditReconciler arms credit reconciliation: Start launches a loop
+// reconciling the live player every interval. Must be called before Start;
+// leaving it unset keeps reconciliation off.
+func (s *DaemonServer) SetCreditReconciler(reconciler CreditReconcilerRunner, interval time.Duration) {
+	if reconciler == nil || interval <= 0 {
+		return
+	}
+	s.creditReconciler = reconciler
+	s.creditReconcileInterval = interval
+}
+
+// runCreditReconciliation reconciles every interval until ctx is canceled. The
+// tick body runs under supervise.Guard so one bad check cannot kill the loop.
+func (s *DaemonServer) runCreditReconciliation(ctx context.Context) error {
+	ticker := time.NewTicker(s.creditReconcileInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+			supervise.Guard("credit-reconciliation", func() {
+				s.reconcileCredits(ctx)
+			})
+		}
+	}
+}
+
+func (s *DaemonServer) reconcileCredits(ctx context.Context) {
+	pid := s.primaryPlayerID(ctx)
+	if pid == 0 {
+		return
+	}
+	playerID, err := shared.NewPlayerID(pid)
+	if err != nil {
+		log.Printf("Credit reconciliation: resolve primary player id %d: %v", pid, err)
+		return
+	}
+	result, err := s.creditReconciler.Reconcile(ctx, playerID)
+	if err != nil {
+		log.Printf("Credit reconciliation failed: %v", err)
+		return
+	}
+	switch {
+	case result.Adjusted:
+		log.Printf("Credit reconciliation: booked %+d adjustment (API %d, ledger %d)",
+			result.Drift, result.APICredits, result.LedgerBalance)
+	case result.Pending:
+		log.Printf("Credit reconciliation: drift %+d (API %d, ledger %d), confirming next check",
+			result.Drift, result.APICredits, result.LedgerBalance)
+	}
+}
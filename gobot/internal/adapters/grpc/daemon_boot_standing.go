@@ -127,6 +127,10 @@ func (s *DaemonServer) ensureBootStandingCoordinators(ctx context.Context, playe
 	// goods_factory coordinators re-adopted by RecoverRunningContainers, and this pass skips any already
 	// running (RULINGS #2). Runs here, after recovery, so a warm restart re-adopts rather than duplicates.
 	s.ensureGateSourceFeeders(ctx, playerID)
+
+	// The shipyard watch is config-armed rather than unconditional: it stands only when
+	// [shipyard_watch] is enabled (SetShipyardWatch), and is otherwise a no-op.
+	s.ensureShipyardWatchStanding(ctx, playerID)
 }
 
 // ensureBootstrapStanding launches the standing captain-bootstrap coordinator (sp-ov8z) when none is
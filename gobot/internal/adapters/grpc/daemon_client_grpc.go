@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/andrescamacho/spacetraders-go/internal/adapters/grpcerrors"
 	scoutingCmd "github.com/andrescamacho/spacetraders-go/internal/application/scouting/commands"
 	"github.com/andrescamacho/spacetraders-go/internal/domain/daemon"
 	pb "github.com/andrescamacho/spacetraders-go/pkg/proto/daemon"
@@ -25,6 +26,7 @@ func NewDaemonClientGRPC(socketPath string) (*DaemonClientGRPC, error) {
 	conn, err := grpc.NewClient(
 		"unix:"+socketPath,
 		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithUnaryInterceptor(grpcerrors.UnaryClientInterceptor()),
 	)
 	if err != nil {
 		return nil, fmt.Errorf("failed to connect to daemon socket: %w", err)
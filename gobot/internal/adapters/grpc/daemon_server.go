@@ -18,13 +18,18 @@ import (
 
 	"github.com/andrescamacho/spacetraders-go/internal/adapters/api"
 	"github.com/andrescamacho/spacetraders-go/internal/adapters/flowfeed"
+	"github.com/andrescamacho/spacetraders-go/internal/adapters/grpcerrors"
+	"github.com/andrescamacho/spacetraders-go/internal/adapters/grpcpriority"
 	"github.com/andrescamacho/spacetraders-go/internal/adapters/metrics"
 	"github.com/andrescamacho/spacetraders-go/internal/adapters/persistence"
 	"github.com/andrescamacho/spacetraders-go/internal/application/common"
+	logCommands "github.com/andrescamacho/spacetraders-go/internal/application/logging/commands"
+	"github.com/andrescamacho/spacetraders-go/internal/application/serverstatus"
 	storageApp "github.com/andrescamacho/spacetraders-go/internal/application/storage"
 	tradingsvc "github.com/andrescamacho/spacetraders-go/internal/application/trading/services"
 	"github.com/andrescamacho/spacetraders-go/internal/domain/captain"
 	"github.com/andrescamacho/spacetraders-go/internal/domain/container"
+	domainDaemon "github.com/andrescamacho/spacetraders-go/internal/domain/daemon"
 	"github.com/andrescamacho/spacetraders-go/internal/domain/navigation"
 	"github.com/andrescamacho/spacetraders-go/internal/domain/player"
 	domainPorts "github.com/andrescamacho/spacetraders-go/internal/domain/ports"
@@ -119,6 +124,72 @@ type DaemonServer struct {
 	containers   map[string]*ContainerRunner
 	containersMu sync.RWMutex
 
+	// shipLeases, when set by SetShipAssignmentManager, holds the daemon's
+	// ship leases and is rehydrated at boot before containers are recovered.
+	shipLeases *container.ShipAssignmentManager
+
+	// healthMonitor records container health events; every registered runner
+	// reports its max_runtime terminations here.
+	healthMonitor *domainDaemon.HealthMonitor
+	// strandedRescueEnabled starts the periodic stranded-ship check; set by
+	// SetStrandedShipRescuer.
+	strandedRescueEnabled bool
+
+	// commandAudit, when set by SetCommandAuditWriter, persists the mediator's
+	// command audit trail from a supervised loop.
+	commandAudit CommandAuditWriter
+	// cashflowAlerter, when set by SetCashflowAlerter, is checked every
+	// cashflowAlertInterval by a supervised loop launched in Start.
+	cashflowAlerter       CashflowAlertChecker
+	cashflowAlertInterval time.Duration
+
+	// creditReconciler, when set by SetCreditReconciler, compares API credits
+	// with the ledger every creditReconcileInterval from a loop launched in Start.
+	creditReconciler        CreditReconcilerRunner
+	creditReconcileInterval time.Duration
+
+	// contractJanitor, when set by SetContractJanitor, expires lapsed contract
+	// offers every contractJanitorInterval from a loop launched in Start.
+	contractJanitor         ContractJanitorRunner
+	contractJanitorInterval time.Duration
+
+	// shipyardWatchTickSecs, when set by SetShipyardWatch, arms the standing
+	// shipyard watch container launched at boot (ensureShipyardWatchStanding).
+	shipyardWatchTickSecs int
+
+	// statusWatcher, when set by SetStatusWatch, reads the API status at boot
+	// and every statusWatchInterval from a loop launched in Start.
+	statusWatcher       *serverstatus.StatusWatcher
+	statusWatchInterval time.Duration
+
+	// logPrunePolicy, when set by SetLogPruning, is sent as a PruneLogsCommand
+	// at startup and every logPruneInterval from a loop launched in Start.
+	logPrunePolicy   *logCommands.PruneLogsCommand
+	logPruneInterval time.Duration
+
+	// configReloader, when set by SetConfigReloader, re-reads the config file
+	// on SIGHUP and every configReloadInterval (0 = SIGHUP only).
+	configReloader       *config.Reloader
+	configReloadInterval time.Duration
+
+	// dailySummaryInterval, when set by SetDailySummaryLog, is the cadence of
+	// the supervised loop launched in Start that logs the operations digest.
+	dailySummaryInterval time.Duration
+
+	// arrivalWatcher, when set by SetArrivalWatcher, confirms arrivals with a
+	// nav read from a loop launched in Start.
+	arrivalWatcher *ArrivalWatcher
+
+	// scheduler, when set by SetScheduler, runs the persisted recurring jobs
+	// from a loop launched in Start.
+	scheduler *jobScheduler
+
+	// httpGatewayAddr, when set by SetHTTPGateway, is where Start serves the
+	// read-only HTTP/JSON gateway.
+	httpGatewayAddr   string
+	httpGatewayToken  string
+	httpGatewayServer *http.Server
+
 	// Container spec registry - single source of truth for command construction
 	containerSpecs map[string]ContainerSpec
 
@@ -126,7 +197,9 @@ type DaemonServer struct {
 	pendingWorkerCommands   map[string]interface{}
 	pendingWorkerCommandsMu sync.RWMutex
 
-	// Metrics
+	// Metrics. metricsMu guards metricsServer and metricsConfig, which a config
+	// reload may swap while the daemon runs (ApplyMetricsConfig).
+	metricsMu                     sync.Mutex
 	metricsServer                 *http.Server
 	metricsConfig                 *config.MetricsConfig
 	containerMetricsCollector     MetricsCollector
@@ -219,6 +292,13 @@ type DaemonServer struct {
 // samples/day/hull, ample resolution for an hours/day KPI.
 const DutyCycleSampleInterval = 60 * time.Second
 
+// Health monitor cadence: how often a check cycle may run and how long a stuck
+// ship gets before recovery is attempted.
+const (
+	healthCheckInterval   = 5 * time.Minute
+	healthRecoveryTimeout = 10 * time.Minute
+)
+
 // NewDaemonServer creates a new daemon server instance
 // shipEventPublisher is the event bus for ship state change notifications.
 // Pass the ShipEventBus created in main.go - it implements both publisher and subscriber interfaces.
@@ -274,6 +354,9 @@ func NewDaemonServer(
 	// this up automatically via SpaceTradersClient.getBudgetTracker()'s
 	// fallback to the global, the same pattern getMetricsCollector() uses.
 	metrics.SetGlobalAPIBudgetTracker(metrics.NewAPIBudgetTracker(api.RateLimitPerSecond, clock))
+	// The daily summary's API-error and extraction-yield sections read this
+	// hour-bucketed tally; like the budget tracker it is always on.
+	metrics.SetGlobalDailyActivityTracker(metrics.NewDailyActivityTracker(clock))
 
 	// Wire arrival scheduler to ship repository so navigation triggers arrival timers
 	if concreteRepo, ok := shipRepo.(interface {
@@ -297,6 +380,7 @@ func NewDaemonServer(
 		shipStateScheduler:       shipStateScheduler,
 		listener:                 listener,
 		containers:               make(map[string]*ContainerRunner),
+		healthMonitor:            domainDaemon.NewHealthMonitor(healthCheckInterval, healthRecoveryTimeout, clock),
 		containerSpecs:           make(map[string]ContainerSpec),
 		pendingWorkerCommands:    make(map[string]interface{}),
 		metricsConfig:            metricsConfig,
@@ -602,6 +686,25 @@ func NewDaemonServer(
 			return nil, fmt.Errorf("failed to register bootstrap metrics collector: %w", err)
 		}
 		metrics.SetGlobalBootstrapCollector(bootstrapCollector)
+
+		// Create credit reconciliation collector: the ledger credit reconciler emits its
+		// drift gauge and adjustment counters through the global set here — the signal that
+		// transaction capture is leaking. Event-driven (no polling goroutine).
+		creditReconciliationCollector := metrics.NewCreditReconciliationMetricsCollector()
+		if err := creditReconciliationCollector.Register(); err != nil {
+			listener.Close()
+			return nil, fmt.Errorf("failed to register credit reconciliation metrics collector: %w", err)
+		}
+		metrics.SetGlobalCreditReconciliationCollector(creditReconciliationCollector)
+
+		// Create server status collector: the status watcher emits the reset countdown
+		// and the version-change / reset-detected counters through the global set here.
+		serverStatusCollector := metrics.NewServerStatusMetricsCollector()
+		if err := serverStatusCollector.Register(); err != nil {
+			listener.Close()
+			return nil, fmt.Errorf("failed to register server status metrics collector: %w", err)
+		}
+		metrics.SetGlobalServerStatusCollector(serverStatusCollector)
 	}
 
 	// Register container specs for launch and recovery
@@ -629,24 +732,20 @@ func (s *DaemonServer) Start() error {
 	)
 	bootCancel()
 
-	// Release all zombie assignments from previous daemon runs
+	// Restore the leases of containers recovery will resume and release the
+	// hulls of those that died with the previous daemon.
 	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
 	defer cancel()
 
-	if s.shipRepo != nil {
+	if s.shipLeases != nil {
 		eraRepo := persistence.NewEraRepository(s.db)
 		openEra, err := eraRepo.FindOpenEra(ctx)
 		if err != nil {
-			fmt.Printf("Warning: Failed to resolve open era for zombie assignment release: %v\n", err)
+			fmt.Printf("Warning: Failed to resolve open era for ship assignment rehydration: %v\n", err)
 		} else if openEra == nil {
-			fmt.Println("No open era - skipping zombie assignment release")
-		} else {
-			count, err := s.shipRepo.ReleaseAllActive(ctx, shared.MustNewPlayerID(openEra.PlayerID), "daemon_restart")
-			if err != nil {
-				fmt.Printf("Warning: Failed to release zombie assignments: %v\n", err)
-			} else if count > 0 {
-				fmt.Printf("Released %d zombie ship assignment(s) on daemon startup\n", count)
-			}
+			fmt.Println("No open era - skipping ship assignment rehydration")
+		} else if err := s.rehydrateShipAssignments(ctx, openEra.PlayerID); err != nil {
+			fmt.Printf("Warning: Failed to rehydrate ship assignments: %v\n", err)
 		}
 	}
 
@@ -669,6 +768,11 @@ func (s *DaemonServer) Start() error {
 		if err := s.shipStateScheduler.ScheduleAllPending(scheduleCtx); err != nil {
 			fmt.Printf("Warning: Failed to schedule pending state transitions: %v\n", err)
 		}
+		// Arrival watcher: confirms the arrivals ScheduleAllPending and
+		// navigation hand it. Off unless SetArrivalWatcher was called.
+		if s.arrivalWatcher != nil {
+			s.sup.Go(s.runCtx, "arrival-watcher", s.arrivalWatcher.Run)
+		}
 		// Start background sweeper under supervision (sp-i01z): restarts
 		// with backoff on crash, escalates a crash loop to the captain.
 		s.sup.Go(s.runCtx, "ship-state-sweeper", s.shipStateScheduler.RunSweeper)
@@ -682,12 +786,86 @@ func (s *DaemonServer) Start() error {
 		s.sup.Go(s.runCtx, "ship-resync", s.shipResyncScheduler.Run)
 	}
 
+	// Stranded-ship rescue: periodically hand the fleet to the health monitor,
+	// which drifts idle dry ships to the nearest fuel. Off unless a rescuer
+	// was wired.
+	if s.strandedRescueEnabled && s.shipRepo != nil {
+		s.sup.Go(s.runCtx, "stranded-ship-rescue", s.runStrandedShipRescue)
+	}
+
+	// Cashflow alerting: periodically net the ledger against the configured
+	// thresholds. Off unless an alerter was wired.
+	if s.cashflowAlerter != nil {
+		s.sup.Go(s.runCtx, "cashflow-alerts", s.runCashflowAlerts)
+	}
+
+	// Credit reconciliation: periodically compare the API's agent credits with
+	// the ledger balance and book confirmed drift.
+	if s.creditReconciler != nil {
+		s.sup.Go(s.runCtx, "credit-reconciliation", s.runCreditReconciliation)
+	}
+
+	// Ship leases: release the hulls of containers that stopped renewing them.
+	if s.shipLeases != nil {
+		s.sup.Go(s.runCtx, "ship-lease-expiry", s.runLeaseExpiry)
+	}
+
+	// Contract janitor: expire negotiated contracts that lapsed unaccepted and
+	// negotiate a replacement for a running contract workflow.
+	if s.contractJanitor != nil {
+		s.sup.Go(s.runCtx, "contract-janitor", s.runContractJanitor)
+	}
+
+	// Server status watch: warn ahead of announced resets and version changes,
+	// and stand the fleet down when a reset lands.
+	if s.statusWatcher != nil {
+		s.sup.Go(s.runCtx, "status-watch", s.runStatusWatch)
+	}
+
+	// Container log retention: prune (and archive) expired log lines.
+	if s.logPrunePolicy != nil {
+		s.sup.Go(s.runCtx, "log-pruning", s.runLogPruning)
+	}
+
+	// Command audit: write the mediator's queued command records and apply
+	// their retention. Off unless a writer was wired.
+	if s.commandAudit != nil {
+		s.sup.Go(s.runCtx, "command-audit", s.commandAudit.Run)
+	}
+
+	// Config hot-reload: re-read the config file on SIGHUP or when it changes
+	// and hand it to the live subscribers. Off unless a reloader was wired.
+	if s.configReloader != nil {
+		s.sup.Go(s.runCtx, "config-reload", s.runConfigReload)
+	}
+
+	// Daily summary: periodically log the operations digest. Off unless a
+	// cadence was wired.
+	if s.dailySummaryInterval > 0 {
+		s.sup.Go(s.runCtx, "daily-summary", s.runDailySummaryLog)
+	}
+
+	// Recurring job scheduler: runs the persisted scheduled jobs. Off unless
+	// SetScheduler was called.
+	if s.scheduler != nil {
+		s.sup.Go(s.runCtx, "scheduler", s.runScheduler)
+	}
+
 	// Start the duty-cycle KPI sampler (sp-51ti). Unconditional, like the
 	// ship state scheduler above — not gated behind metricsConfig.Enabled.
 	if s.dutyCycleSampler != nil {
 		s.dutyCycleSampler.Start()
 	}
 
+	// Start the HTTP/JSON gateway if configured
+	if s.httpGatewayAddr != "" {
+		if err := s.startHTTPGateway(); err != nil {
+			fmt.Printf("Warning: Failed to start HTTP gateway: %v\n", err)
+		} else {
+			fmt.Printf("HTTP gateway listening on %s\n", s.httpGatewayAddr)
+		}
+	}
+
 	// Start metrics server if enabled
 	if s.metricsConfig != nil && s.metricsConfig.Enabled {
 		if err := s.startMetricsServer(); err != nil {
@@ -760,8 +938,13 @@ func (s *DaemonServer) Start() error {
 	// Start shutdown handler
 	go s.handleShutdown()
 
-	// Create gRPC server
-	grpcServer := grpc.NewServer()
+	// Create gRPC server. Coded domain errors leave as statuses with an ErrorInfo
+	// detail so clients can branch on the code rather than the message. Calls the
+	// CLI marks interactive reach the API client at interactive priority.
+	grpcServer := grpc.NewServer(grpc.ChainUnaryInterceptor(
+		grpcerrors.UnaryServerInterceptor(),
+		grpcpriority.UnaryServerInterceptor(),
+	))
 
 	// Create and register service implementation
 	serviceImpl := newDaemonServiceImpl(s)
@@ -816,14 +999,16 @@ func (s *DaemonServer) startMetricsServer() error {
 		return fmt.Errorf("failed to bind metrics server to %s: %w", addr, err)
 	}
 
-	// Create HTTP server
-	s.metricsServer = &http.Server{
+	// Create HTTP server. The goroutine serves its own copy so a config reload
+	// that swaps s.metricsServer cannot redirect it.
+	server := &http.Server{
 		Handler: mux,
 	}
+	s.metricsServer = server
 
 	// Start server in goroutine using the already-bound listener
 	go func() {
-		if err := s.metricsServer.Serve(listener); err != nil && err != http.ErrServerClosed {
+		if err := server.Serve(listener); err != nil && err != http.ErrServerClosed {
 			fmt.Printf("Metrics server error: %v\n", err)
 		}
 	}()
@@ -833,6 +1018,8 @@ func (s *DaemonServer) startMetricsServer() error {
 
 // stopMetricsServer gracefully stops the HTTP metrics server
 func (s *DaemonServer) stopMetricsServer() {
+	s.metricsMu.Lock()
+	defer s.metricsMu.Unlock()
 	if s.metricsServer == nil {
 		return
 	}
@@ -887,6 +1074,7 @@ func (s *DaemonServer) handleShutdown() {
 
 	// Stop metrics server and collector
 	s.stopMetricsServer()
+	s.stopHTTPGateway()
 
 	// Close listener
 	if s.listener != nil {
@@ -1077,6 +1265,8 @@ func (s *DaemonServer) gracefulShutdownWithTimeout(timeout time.Duration) {
 // RecoverRunningContainers recovers containers that were RUNNING or INTERRUPTED when daemon stopped
 // INTERRUPTED = graceful shutdown (daemon called interruptAllContainers)
 // RUNNING = ungraceful shutdown (kill -9, crash) - backwards compatibility
+// BLOCKED = paused on a dependency; started again once the dependency comes up
+// Containers are started in dependency order (see recoverInDependencyOrder).
 func (s *DaemonServer) RecoverRunningContainers(ctx context.Context) error {
 	// Query database for INTERRUPTED containers (graceful shutdown)
 	interruptedContainers, err := s.containerRepo.ListByStatus(ctx, container.ContainerStatusInterrupted, nil)
@@ -1090,21 +1280,28 @@ func (s *DaemonServer) RecoverRunningContainers(ctx context.Context) error {
 		return fmt.Errorf("failed to list RUNNING containers: %w", err)
 	}
 
-	// Combine both lists
+	// Query database for BLOCKED containers (paused on a dependency)
+	blockedContainers, err := s.containerRepo.ListByStatus(ctx, container.ContainerStatusBlocked, nil)
+	if err != nil {
+		return fmt.Errorf("failed to list BLOCKED containers: %w", err)
+	}
+
+	// Combine all lists
 	allContainers := append(interruptedContainers, runningContainers...)
+	allContainers = append(allContainers, blockedContainers...)
 
 	if len(allContainers) == 0 {
 		fmt.Println("No containers to recover")
 		return nil
 	}
 
-	fmt.Printf("Recovering %d container(s) from previous daemon instance (%d INTERRUPTED, %d RUNNING)...\n",
-		len(allContainers), len(interruptedContainers), len(runningContainers))
+	fmt.Printf("Recovering %d container(s) from previous daemon instance (%d INTERRUPTED, %d RUNNING, %d BLOCKED)...\n",
+		len(allContainers), len(interruptedContainers), len(runningContainers), len(blockedContainers))
 
 	// sp-njpu: scope recovery to the current open era's player. After a universe
 	// reset / era close, containers belonging to a prior era's player must NOT be
 	// re-instantiated against the reset universe (cross-era zombies). Mirrors the
-	// open-era scoping of ReleaseAllActive on daemon startup (sp-s7b7). A nil openEra
+	// open-era scoping of ship assignment rehydration on daemon startup (sp-s7b7). A nil openEra
 	// means every era is closed, so nothing is live. A resolution error aborts
 	// recovery without touching any container so the next restart can retry.
 	openEra, err := persistence.NewEraRepository(s.db).FindOpenEra(ctx)
@@ -1123,6 +1320,7 @@ func (s *DaemonServer) RecoverRunningContainers(ctx context.Context) error {
 	failReason := make(map[string]recoveryLoss) // explicitly failed, with a captured reason
 	coordinatorSkipCount := 0
 	deadEraCount := 0
+	var startable []recoveryCandidate
 
 	for _, containerModel := range allContainers {
 		// sp-njpu: skip any container whose player is not the open-era player. This
@@ -1182,27 +1380,18 @@ func (s *DaemonServer) RecoverRunningContainers(ctx context.Context) error {
 			continue
 		}
 
-		// Recover using generic recovery with command factory
-		if err := s.recoverContainer(ctx, containerModel, config); err != nil {
-			fmt.Printf("Container %s: Recovery failed: %v\n", containerModel.ID, err)
-			s.markContainerFailed(ctx, containerModel, "recovery_failed", err.Error())
-			failReason[containerModel.ID] = recoveryLoss{
-				id: containerModel.ID, commandType: containerModel.CommandType,
-				playerID: containerModel.PlayerID,
-				reason:   fmt.Sprintf("recovery_failed: %v", err),
-			}
-		} else {
-			recovered[containerModel.ID] = true
-		}
+		startable = append(startable, recoveryCandidate{model: containerModel, config: config})
 	}
 
+	blockedCount := s.recoverInDependencyOrder(ctx, startable, recovered, exempt, failReason)
+
 	// sp-tit8: diff expected-vs-recovered and announce every candidate that
 	// neither ended running nor was a by-design skip. The summary NAMES each
 	// loss so an operator never has to guess which container "N failed" was.
 	lost := s.collectAndAnnounceLostContainers(allContainers, recovered, exempt, failReason)
 
-	fmt.Printf("Container recovery complete: %d recovered, %d lost%s, %d coordinator-managed skipped, %d dead-era skipped\n",
-		len(recovered), len(lost), formatLostSummary(lost), coordinatorSkipCount, deadEraCount)
+	fmt.Printf("Container recovery complete: %d recovered, %d lost%s, %d blocked on dependencies, %d coordinator-managed skipped, %d dead-era skipped\n",
+		len(recovered), len(lost), formatLostSummary(lost), blockedCount, coordinatorSkipCount, deadEraCount)
 	return nil
 }
 
@@ -1317,8 +1506,8 @@ func formatLostSummary(lost []recoveryLoss) string {
 // markContainerDeadEra marks a container FAILED because it belongs to a player whose
 // era is closed / the universe was reset (sp-njpu). It is NOT re-instantiated: reviving
 // it would burn API calls against a dead token on a reset map. Ship assignments are
-// left untouched — they belong to the reset universe, and the startup zombie-release
-// path (ReleaseAllActive) deliberately scopes only to the open-era player.
+// left untouched — they belong to the reset universe, and the startup assignment
+// rehydration deliberately scopes only to the open-era player.
 func (s *DaemonServer) markContainerDeadEra(ctx context.Context, containerModel *persistence.ContainerModel, openEra *persistence.EraModel) {
 	livePlayer := "none (no open era)"
 	if openEra != nil {
@@ -1392,6 +1581,11 @@ func (s *DaemonServer) recoverContainer(ctx context.Context, containerModel *per
 		iterations = int(iter)
 	}
 
+	// The max_runtime budget keeps counting from the original launch.
+	if _, stamped := container.RuntimeStartedAtFromConfig(config); !stamped && containerModel.StartedAt != nil {
+		config[container.RuntimeStartedAtKey] = containerModel.StartedAt.UTC().Format(time.RFC3339Nano)
+	}
+
 	// Recreate container entity
 	containerEntity := container.NewContainer(
 		containerModel.ID,
@@ -1437,6 +1631,12 @@ func (s *DaemonServer) markContainerFailed(ctx context.Context, containerModel *
 
 	// Release ship assignments for this failed container
 	// This prevents orphaned assignments when containers fail during recovery
+	s.releaseContainerShips(ctx, containerModel, reason)
+}
+
+// releaseContainerShips releases every ship still assigned to a container the
+// recovery pass is not starting, under reason.
+func (s *DaemonServer) releaseContainerShips(ctx context.Context, containerModel *persistence.ContainerModel, reason string) {
 	playerID := shared.MustNewPlayerID(containerModel.PlayerID)
 	assignedShips, err := s.shipRepo.FindByContainer(ctx, containerModel.ID, playerID)
 	if err != nil {
@@ -1447,7 +1647,7 @@ func (s *DaemonServer) markContainerFailed(ctx context.Context, containerModel *
 			// Release under CAS-retry (sp-wa7c): re-apply ForceRelease on the FRESH row
 			// so a concurrent writer's cargo/nav update survives instead of being
 			// last-write-wins clobbered by the FindByContainer snapshot. Skip unless the
-			// hull is still on THIS failed container (a concurrent release or re-claim ->
+			// hull is still on THIS container (a concurrent release or re-claim ->
 			// changed=false), so a hull that moved on is not released out from under its
 			// new owner.
 			if _, _, err := s.shipRepo.SaveWithRetry(ctx, shipSymbol, playerID,
@@ -1460,6 +1660,9 @@ func (s *DaemonServer) markContainerFailed(ctx context.Context, containerModel *
 				}); err != nil {
 				fmt.Printf("Warning: Failed to release ship %s for container %s: %v\n", shipSymbol, containerModel.ID, err)
 			}
+			if s.shipLeases != nil {
+				s.shipLeases.DropLease(shipSymbol, containerModel.ID, reason)
+			}
 		}
 	}
 }
@@ -1535,7 +1738,7 @@ func (s *DaemonServer) GetContainer(containerID string) (*container.Container, e
 
 	runner, exists := s.containers[containerID]
 	if !exists {
-		return nil, fmt.Errorf("container not found: %s", containerID)
+		return nil, shared.Errorf(shared.CodeContainerNotFound, "container not found: %s", containerID)
 	}
 
 	return runner.Container(), nil
@@ -1566,7 +1769,7 @@ func (s *DaemonServer) StopContainer(containerID string) error {
 	s.containersMu.RUnlock()
 
 	if !exists {
-		return fmt.Errorf("container not found: %s", containerID)
+		return shared.Errorf(shared.CodeContainerNotFound, "container not found: %s", containerID)
 	}
 
 	// Get playerID from the container
@@ -1696,6 +1899,14 @@ func (s *DaemonServer) DeleteContainer(ctx context.Context, containerID string,
 // Container registration
 
 func (s *DaemonServer) registerContainer(containerID string, runner *ContainerRunner) {
+	if s.healthMonitor != nil {
+		runner.SetRuntimeTerminationRecorder(s.healthMonitor)
+	}
+	if s.shipLeases != nil {
+		runner.SetShipLeases(s.shipLeases)
+	}
+	runner.SetFailureListener(s)
+
 	s.containersMu.Lock()
 	defer s.containersMu.Unlock()
 	s.containers[containerID] = runner
@@ -7,10 +7,12 @@ import (
 	"time"
 
 	"github.com/andrescamacho/spacetraders-go/internal/adapters/metrics"
+	logCommands "github.com/andrescamacho/spacetraders-go/internal/application/logging/commands"
 	playerQuery "github.com/andrescamacho/spacetraders-go/internal/application/player/queries"
 	shipNav "github.com/andrescamacho/spacetraders-go/internal/application/ship/commands/navigation"
 	shipOutfit "github.com/andrescamacho/spacetraders-go/internal/application/ship/commands/outfitting"
 	"github.com/andrescamacho/spacetraders-go/internal/domain/captain"
+	"github.com/andrescamacho/spacetraders-go/internal/domain/daemon"
 	"github.com/andrescamacho/spacetraders-go/internal/domain/manufacturing"
 	"github.com/andrescamacho/spacetraders-go/internal/domain/ports"
 	domainScouting "github.com/andrescamacho/spacetraders-go/internal/domain/scouting"
@@ -78,7 +80,12 @@ func (s *daemonServiceImpl) NavigateShip(ctx context.Context, req *pb.NavigateSh
 	}
 
 	// Call daemon's NavigateShip method
-	containerID, err := s.daemon.NavigateShip(ctx, req.ShipSymbol, req.Destination, playerID)
+	var fuelReservePercent *int
+	if req.FuelReservePercent != nil {
+		percent := int(req.GetFuelReservePercent())
+		fuelReservePercent = &percent
+	}
+	containerID, err := s.daemon.NavigateShip(ctx, req.ShipSymbol, req.Destination, playerID, req.GetIdempotencyKey(), fuelReservePercent)
 	if err != nil {
 		return nil, fmt.Errorf("failed to navigate ship: %w", err)
 	}
@@ -589,6 +596,77 @@ func (s *daemonServiceImpl) BootstrapCoordinator(ctx context.Context, req *pb.Bo
 	return &pb.BootstrapCoordinatorResponse{ContainerId: containerID, Status: "RUNNING"}, nil
 }
 
+// BootstrapFleet applies a fleet template in a one-shot container, or returns its plan for a
+// dry run.
+func (s *daemonServiceImpl) BootstrapFleet(ctx context.Context, req *pb.BootstrapFleetRequest) (*pb.BootstrapFleetResponse, error) {
+	playerID, err := s.resolvePlayerID(ctx, req.PlayerId, req.AgentSymbol)
+	if err != nil {
+		return nil, fmt.Errorf("failed to resolve player: %w", err)
+	}
+
+	containerID, plan, err := s.daemon.BootstrapFleet(ctx, playerID, req.GetAgentSymbol(), req.Profile, req.GetPurchasingShip(), req.DryRun)
+	if err != nil {
+		return nil, fmt.Errorf("failed to apply fleet template: %w", err)
+	}
+	if plan == nil {
+		return &pb.BootstrapFleetResponse{ContainerId: containerID}, nil
+	}
+
+	resp := &pb.BootstrapFleetResponse{Template: plan.Template, DryRun: plan.DryRun}
+	for _, p := range plan.Purchases {
+		resp.Purchases = append(resp.Purchases, &pb.FleetPurchasePlan{
+			ShipType: p.ShipType,
+			Wanted:   int32(p.Wanted),
+			Owned:    int32(p.Owned),
+			Bought:   int32(p.Bought),
+			Cost:     int64(p.Cost),
+			Error:    p.Error,
+		})
+	}
+	for _, c := range plan.Containers {
+		resp.Containers = append(resp.Containers, &pb.FleetContainerPlan{
+			Name:        c.Name,
+			CommandType: c.CommandType,
+			ContainerId: c.ContainerID,
+			Status:      c.Status,
+			Reason:      c.Reason,
+		})
+	}
+	return resp, nil
+}
+
+// LaunchOperationProfile launches one operation from a versioned parameter set. A profile that
+// does not fit its operation fails the call; a target that fails to launch is reported in its
+// launch entry and does not stop the others.
+func (s *daemonServiceImpl) LaunchOperationProfile(ctx context.Context, req *pb.LaunchOperationProfileRequest) (*pb.LaunchOperationProfileResponse, error) {
+	playerID, err := s.resolvePlayerID(ctx, req.PlayerId, req.AgentSymbol)
+	if err != nil {
+		return nil, fmt.Errorf("failed to resolve player: %w", err)
+	}
+
+	result, err := s.daemon.LaunchOperationProfile(ctx, playerID, req.GetAgentSymbol(), req.Profile, req.DryRun)
+	if err != nil {
+		return nil, fmt.Errorf("failed to launch operation profile: %w", err)
+	}
+
+	resp := &pb.LaunchOperationProfileResponse{
+		Profile:   result.Profile,
+		Version:   int32(result.Version),
+		Digest:    result.Digest,
+		Operation: result.Operation,
+		DryRun:    result.DryRun,
+	}
+	for _, l := range result.Launches {
+		resp.Launches = append(resp.Launches, &pb.OperationLaunch{
+			Target:       l.Target,
+			ContainerIds: l.ContainerIDs,
+			Status:       l.Status,
+			Reason:       l.Reason,
+		})
+	}
+	return resp, nil
+}
+
 // CapacityReconcilerCoordinator starts the standing capacity reconciler (st-7zk). Explicit
 // start only — the daemon never boot-arms it (deploy-inert foundation).
 func (s *daemonServiceImpl) CapacityReconcilerCoordinator(ctx context.Context, req *pb.CapacityReconcilerCoordinatorRequest) (*pb.CapacityReconcilerCoordinatorResponse, error) {
@@ -666,6 +744,50 @@ func (s *daemonServiceImpl) ShipyardBackfillCoordinator(ctx context.Context, req
 	return &pb.ShipyardBackfillCoordinatorResponse{ContainerId: containerID, Status: "RUNNING"}, nil
 }
 
+// ProbeParkingCoordinator starts the standing probe-parking coordinator. EXPLICIT START ONLY.
+func (s *daemonServiceImpl) ProbeParkingCoordinator(ctx context.Context, req *pb.ProbeParkingCoordinatorRequest) (*pb.ProbeParkingCoordinatorResponse, error) {
+	playerID, err := s.resolvePlayerID(ctx, req.PlayerId, req.AgentSymbol)
+	if err != nil {
+		return nil, fmt.Errorf("failed to resolve player: %w", err)
+	}
+
+	containerID, err := s.daemon.ProbeParkingCoordinator(
+		ctx,
+		playerID,
+		int(req.TickIntervalSecs),
+		int(req.ScanIntervalSecs),
+		int(req.ReserveIdleProbes),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to start probe parking coordinator: %w", err)
+	}
+
+	return &pb.ProbeParkingCoordinatorResponse{ContainerId: containerID, Status: "RUNNING"}, nil
+}
+
+// TankerCoordinator starts the standing tanker coordinator. EXPLICIT START ONLY.
+func (s *daemonServiceImpl) TankerCoordinator(ctx context.Context, req *pb.TankerCoordinatorRequest) (*pb.TankerCoordinatorResponse, error) {
+	playerID, err := s.resolvePlayerID(ctx, req.PlayerId, req.AgentSymbol)
+	if err != nil {
+		return nil, fmt.Errorf("failed to resolve player: %w", err)
+	}
+
+	containerID, err := s.daemon.TankerCoordinator(ctx, playerID, TankerCoordinatorParams{
+		TankerShips:      req.TankerShips,
+		TankerShipTag:    req.TankerShipTag,
+		Stations:         req.Stations,
+		FuelSource:       req.FuelSource,
+		RefuelBelowPct:   int(req.RefuelBelowPct),
+		ReloadBelowUnits: int(req.ReloadBelowUnits),
+		TickIntervalSecs: int(req.TickIntervalSecs),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to start tanker coordinator: %w", err)
+	}
+
+	return &pb.TankerCoordinatorResponse{ContainerId: containerID, Status: "RUNNING"}, nil
+}
+
 // WorkerRebalancerCoordinator starts the standing worker-rebalancer coordinator (sp-f5pr):
 // it ferries idle undedicated light-haulers cross-system to worker-starved factory systems.
 // All tuning lives in config.yaml [worker_rebalancer] (resolved live on every build); this
@@ -807,6 +929,7 @@ func (s *daemonServiceImpl) AssignScoutingFleet(ctx context.Context, req *pb.Ass
 		ctx,
 		req.SystemSymbol,
 		playerID,
+		time.Duration(req.FreshnessSlaSeconds)*time.Second,
 	)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create fleet assignment container: %w", err)
@@ -834,10 +957,10 @@ func (s *daemonServiceImpl) ListContainers(ctx context.Context, req *pb.ListCont
 		// User explicitly requested a status - use as-is
 		status = req.Status
 	} else {
-		// DEFAULT: Only show active containers (RUNNING, INTERRUPTED)
+		// DEFAULT: Only show active containers (RUNNING, PAUSED, INTERRUPTED)
 		// Rationale: Operators care about what's currently active, not history
 		// Use comma-separated list for multiple statuses
-		defaultStatuses := "RUNNING,INTERRUPTED"
+		defaultStatuses := "RUNNING,PAUSED,INTERRUPTED"
 		status = &defaultStatuses
 	}
 
@@ -931,6 +1054,55 @@ func (s *daemonServiceImpl) StopContainer(ctx context.Context, req *pb.StopConta
 	}, nil
 }
 
+// PauseContainer pauses a running container and its running children
+func (s *daemonServiceImpl) PauseContainer(ctx context.Context, req *pb.PauseContainerRequest) (*pb.PauseContainerResponse, error) {
+	children, err := s.daemon.PauseContainer(ctx, req.ContainerId)
+	if err != nil {
+		return nil, fmt.Errorf("failed to pause container: %w", err)
+	}
+
+	return &pb.PauseContainerResponse{
+		ContainerId: req.ContainerId,
+		Status:      "PAUSED",
+		Children:    children,
+	}, nil
+}
+
+// ResumeContainer resumes a paused container and its paused children
+func (s *daemonServiceImpl) ResumeContainer(ctx context.Context, req *pb.ResumeContainerRequest) (*pb.ResumeContainerResponse, error) {
+	children, err := s.daemon.ResumeContainer(ctx, req.ContainerId)
+	if err != nil {
+		return nil, fmt.Errorf("failed to resume container: %w", err)
+	}
+
+	return &pb.ResumeContainerResponse{
+		ContainerId: req.ContainerId,
+		Status:      "RUNNING",
+		Children:    children,
+	}, nil
+}
+
+// SetContainerLogLevel changes a running container's log level
+func (s *daemonServiceImpl) SetContainerLogLevel(ctx context.Context, req *pb.SetContainerLogLevelRequest) (*pb.SetContainerLogLevelResponse, error) {
+	result, err := s.daemon.mediator.Send(ctx, &logCommands.SetContainerLogLevelCommand{
+		ContainerID: req.ContainerId,
+		Level:       req.Level,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to set container log level: %w", err)
+	}
+
+	resp, ok := result.(*logCommands.SetContainerLogLevelResponse)
+	if !ok {
+		return nil, fmt.Errorf("unexpected response type: %T", result)
+	}
+	return &pb.SetContainerLogLevelResponse{
+		ContainerId:   resp.ContainerID,
+		PreviousLevel: resp.PreviousLevel,
+		Level:         resp.Level,
+	}, nil
+}
+
 // GetContainerLogs retrieves container logs
 func (s *daemonServiceImpl) GetContainerLogs(ctx context.Context, req *pb.GetContainerLogsRequest) (*pb.GetContainerLogsResponse, error) {
 	// TODO: Implement log retrieval when logging infrastructure is wired
@@ -944,18 +1116,34 @@ func (s *daemonServiceImpl) GetContainerLogs(ctx context.Context, req *pb.GetCon
 func (s *daemonServiceImpl) HealthCheck(ctx context.Context, req *pb.HealthCheckRequest) (*pb.HealthCheckResponse, error) {
 	// Get active container count
 	containers := s.daemon.ListContainers(nil, nil)
-	activeCount := 0
+	activeCount, pausedCount := 0, 0
 	for _, cont := range containers {
-		if cont.Status() == "RUNNING" {
+		switch cont.Status() {
+		case "RUNNING":
 			activeCount++
+		case "PAUSED":
+			pausedCount++
 		}
 	}
 
-	return &pb.HealthCheckResponse{
+	resp := &pb.HealthCheckResponse{
 		Status:           "ok",
 		Version:          "0.1.0",
 		ActiveContainers: int32(activeCount),
-	}, nil
+		PausedContainers: int32(pausedCount),
+	}
+	if monitor := s.daemon.healthMonitor; monitor != nil {
+		resp.RuntimeTerminations = int32(monitor.GetMetrics().RuntimeTerminations)
+		for _, t := range monitor.RuntimeTerminations() {
+			resp.RecentRuntimeTerminations = append(resp.RecentRuntimeTerminations, &pb.RuntimeTermination{
+				ContainerId:    t.ContainerID,
+				ContainerType:  t.ContainerType,
+				RuntimeSeconds: int64(t.Runtime / time.Second),
+				TerminatedAt:   t.TerminatedAt.Format(time.RFC3339),
+			})
+		}
+	}
+	return resp, nil
 }
 
 // GetAPIBudget returns API request-budget observability (sp-51ti): per-hull
@@ -1010,7 +1198,7 @@ func (s *daemonServiceImpl) GetShip(ctx context.Context, req *pb.GetShipRequest)
 	agentSymbol := stringValue(req.AgentSymbol)
 
 	// Call daemon's GetShip method
-	shipDetail, err := s.daemon.GetShip(ctx, req.ShipSymbol, playerID, agentSymbol)
+	shipDetail, err := s.daemon.GetShip(ctx, req.ShipSymbol, playerID, agentSymbol, req.NoCache)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get ship: %w", err)
 	}
@@ -1392,6 +1580,21 @@ func (s *daemonServiceImpl) BatchPurchaseShips(ctx context.Context, req *pb.Batc
 		iterations = &iter
 	}
 
+	opts := BatchPurchaseOptions{
+		Loadout:       req.GetLoadout(),
+		MaxPrice:      int(req.GetMaxPrice()),
+		WaitForDip:    req.GetWaitForDip(),
+		DipPollSecs:   int(req.GetDipPollSecs()),
+		FailurePolicy: req.GetFailurePolicy(),
+	}
+	if req.GetDipDeadline() != "" {
+		deadline, err := time.Parse(time.RFC3339, req.GetDipDeadline())
+		if err != nil {
+			return nil, fmt.Errorf("invalid dip_deadline %q: %w", req.GetDipDeadline(), err)
+		}
+		opts.DipDeadline = deadline
+	}
+
 	// Call daemon's BatchPurchaseShips method
 	containerID, shipsToPurchase, maxBudget, resolvedShipyard, status, err := s.daemon.BatchPurchaseShips(
 		ctx,
@@ -1402,6 +1605,7 @@ func (s *daemonServiceImpl) BatchPurchaseShips(ctx context.Context, req *pb.Batc
 		playerID,
 		shipyardWaypoint,
 		iterations,
+		opts,
 	)
 	if err != nil {
 		return nil, fmt.Errorf("failed to batch purchase ships: %w", err)
@@ -2014,3 +2218,261 @@ func (s *daemonServiceImpl) ConstructionGoodOverride(ctx context.Context, req *p
 		MinSupply:        result.Override.MinSupply,
 	}, nil
 }
+
+func (s *daemonServiceImpl) RegisterAgent(ctx context.Context, req *pb.RegisterAgentRequest) (*pb.RegisterAgentResponse, error) {
+	result, err := s.daemon.RegisterAgent(ctx, req.AgentSymbol, req.Faction, req.AccountToken, stringValue(req.Token), req.SkipStarterContract)
+	if err != nil {
+		return nil, fmt.Errorf("failed to register agent: %w", err)
+	}
+
+	return &pb.RegisterAgentResponse{
+		PlayerId:     int32(result.PlayerID),
+		AgentSymbol:  result.AgentSymbol,
+		Faction:      result.Faction,
+		Headquarters: result.Headquarters,
+		Credits:      int64(result.Credits),
+		ShipsSynced:  int32(result.ShipsSynced),
+		Registered:   result.Registered,
+
+		StarterContractId:           result.StarterContractID,
+		StarterContractAccepted:     result.StarterContractAccepted,
+		ContractWorkflowContainerId: result.ContractWorkflowID,
+		StarterContractNote:         result.StarterNote,
+	}, nil
+}
+
+// ExportMarketData implements the ExportMarketData RPC
+func (s *daemonServiceImpl) ExportMarketData(ctx context.Context, req *pb.ExportMarketDataRequest) (*pb.ExportMarketDataResponse, error) {
+	playerID, err := s.resolvePlayerID(ctx, req.PlayerId, req.AgentSymbol)
+	if err != nil {
+		return nil, fmt.Errorf("failed to resolve player: %w", err)
+	}
+	if req.SystemSymbol == "" {
+		return nil, fmt.Errorf("system_symbol is required")
+	}
+	if req.HistoryHours < 0 {
+		return nil, fmt.Errorf("history_hours must not be negative")
+	}
+
+	result, err := s.daemon.ExportMarketData(ctx, playerID, req.SystemSymbol, req.Format,
+		time.Duration(req.HistoryHours)*time.Hour)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.ExportMarketDataResponse{
+		Format:       string(result.Format),
+		Content:      result.Content,
+		MarketCount:  int32(result.MarketCount),
+		SnapshotRows: int32(result.SnapshotRows),
+		HistoryRows:  int32(result.HistoryRows),
+	}, nil
+}
+
+// GetSupplyTransitionStats implements the GetSupplyTransitionStats RPC
+func (s *daemonServiceImpl) GetSupplyTransitionStats(ctx context.Context, req *pb.GetSupplyTransitionStatsRequest) (*pb.GetSupplyTransitionStatsResponse, error) {
+	playerID, err := s.resolvePlayerID(ctx, req.PlayerId, req.AgentSymbol)
+	if err != nil {
+		return nil, fmt.Errorf("failed to resolve player: %w", err)
+	}
+	if req.WindowHours < 0 {
+		return nil, fmt.Errorf("window_hours must not be negative")
+	}
+
+	result, err := s.daemon.GetSupplyTransitionStats(ctx, playerID, req.WaypointSymbol, req.GoodSymbol,
+		time.Duration(req.WindowHours)*time.Hour)
+	if err != nil {
+		return nil, err
+	}
+
+	stats := make([]*pb.SupplyTransitionStat, 0, len(result.Stats))
+	for _, stat := range result.Stats {
+		edges := make(map[string]int32, len(stat.Edges))
+		for edge, count := range stat.Edges {
+			edges[edge] = int32(count)
+		}
+		dwell := make(map[string]int64, len(stat.AverageDwell))
+		for level, d := range stat.AverageDwell {
+			dwell[level] = int64(d.Seconds())
+		}
+		stats = append(stats, &pb.SupplyTransitionStat{
+			WaypointSymbol:      stat.WaypointSymbol,
+			GoodSymbol:          stat.GoodSymbol,
+			Transitions:         int32(stat.Transitions),
+			TransitionsPerHour:  stat.TransitionsPerHour,
+			Edges:               edges,
+			AverageDwellSeconds: dwell,
+			CurrentSupply:       stat.CurrentSupply,
+			LastTransitionAt:    stat.LastTransition.Format(time.RFC3339),
+		})
+	}
+	return &pb.GetSupplyTransitionStatsResponse{
+		Since: result.Since.Format(time.RFC3339),
+		Stats: stats,
+	}, nil
+}
+
+// GetSystemOverview implements the GetSystemOverview RPC
+func (s *daemonServiceImpl) GetSystemOverview(ctx context.Context, req *pb.GetSystemOverviewRequest) (*pb.GetSystemOverviewResponse, error) {
+	playerID, err := s.resolvePlayerID(ctx, req.PlayerId, req.AgentSymbol)
+	if err != nil {
+		return nil, fmt.Errorf("failed to resolve player: %w", err)
+	}
+	if req.SystemSymbol == "" {
+		return nil, fmt.Errorf("system_symbol is required")
+	}
+	if req.MaxLanes < 0 {
+		return nil, fmt.Errorf("max_lanes must not be negative")
+	}
+
+	result, err := s.daemon.GetSystemOverview(ctx, playerID, req.SystemSymbol, int(req.MaxLanes))
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &pb.GetSystemOverviewResponse{
+		SystemSymbol: result.SystemSymbol,
+		Markets: &pb.SystemMarketFreshness{
+			Markets:          int32(result.Markets.Markets),
+			Scanned:          int32(result.Markets.Scanned),
+			NewestAgeSeconds: int64(result.Markets.NewestAge.Seconds()),
+			MedianAgeSeconds: int64(result.Markets.MedianAge.Seconds()),
+			OldestAgeSeconds: int64(result.Markets.OldestAge.Seconds()),
+		},
+	}
+	for _, ship := range result.Ships {
+		resp.Ships = append(resp.Ships, &pb.SystemOverviewShip{
+			Symbol:      ship.Symbol,
+			Role:        ship.Role,
+			Location:    ship.Location,
+			NavStatus:   ship.NavStatus,
+			ContainerId: ship.ContainerID,
+			Task:        ship.Task,
+		})
+	}
+	for _, c := range result.Containers {
+		startedAt := ""
+		if c.StartedAt != nil {
+			startedAt = c.StartedAt.Format(time.RFC3339)
+		}
+		resp.Containers = append(resp.Containers, &pb.SystemOverviewContainer{
+			Id:            c.ID,
+			ContainerType: c.ContainerType,
+			CommandType:   c.CommandType,
+			ShipsInSystem: int32(c.ShipsInSystem),
+			StartedAt:     startedAt,
+		})
+	}
+	for _, d := range result.Deliveries {
+		resp.Deliveries = append(resp.Deliveries, &pb.SystemOverviewDelivery{
+			ContractId:     d.ContractID,
+			Good:           d.Good,
+			Destination:    d.Destination,
+			UnitsRequired:  int32(d.UnitsRequired),
+			UnitsFulfilled: int32(d.UnitsFulfilled),
+			Deadline:       d.Deadline,
+		})
+	}
+	for _, lane := range result.Lanes {
+		resp.Lanes = append(resp.Lanes, &pb.SystemOverviewLane{
+			Good:           lane.Good,
+			SourceWaypoint: lane.SourceWaypoint,
+			DestWaypoint:   lane.DestWaypoint,
+			SourceAsk:      int32(lane.SourceAsk),
+			DestBid:        int32(lane.DestBid),
+			SpreadPerUnit:  int32(lane.SpreadPerUnit),
+			SourceSupply:   lane.SourceSupply,
+		})
+	}
+	return resp, nil
+}
+
+// WarmSystem implements the WarmSystem RPC
+func (s *daemonServiceImpl) WarmSystem(ctx context.Context, req *pb.WarmSystemRequest) (*pb.WarmSystemResponse, error) {
+	playerID, err := s.resolvePlayerID(ctx, req.PlayerId, req.AgentSymbol)
+	if err != nil {
+		return nil, fmt.Errorf("failed to resolve player: %w", err)
+	}
+	if req.MarketMaxAgeSeconds < 0 {
+		return nil, fmt.Errorf("market_max_age_seconds must not be negative")
+	}
+
+	containerID, err := s.daemon.WarmSystem(ctx, playerID, req.SystemSymbol,
+		time.Duration(req.MarketMaxAgeSeconds)*time.Second)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.WarmSystemResponse{
+		ContainerId:  containerID,
+		SystemSymbol: req.SystemSymbol,
+	}, nil
+}
+
+// AddScheduledJob defines or redefines a recurring job
+func (s *daemonServiceImpl) AddScheduledJob(ctx context.Context, req *pb.AddScheduledJobRequest) (*pb.AddScheduledJobResponse, error) {
+	playerID, err := s.resolvePlayerID(ctx, req.PlayerId, req.AgentSymbol)
+	if err != nil {
+		return nil, fmt.Errorf("failed to resolve player: %w", err)
+	}
+	kind, err := daemon.ParseScheduledJobKind(req.Kind)
+	if err != nil {
+		return nil, err
+	}
+
+	job, err := s.daemon.AddScheduledJob(ctx, playerID, req.Name, kind,
+		time.Duration(req.IntervalSeconds)*time.Second, req.Params, !req.Disabled)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.AddScheduledJobResponse{Job: scheduledJobToProto(job)}, nil
+}
+
+// ListScheduledJobs lists the recurring jobs with their run history
+func (s *daemonServiceImpl) ListScheduledJobs(ctx context.Context, req *pb.ListScheduledJobsRequest) (*pb.ListScheduledJobsResponse, error) {
+	playerID, err := s.resolvePlayerID(ctx, req.PlayerId, req.AgentSymbol)
+	if err != nil {
+		return nil, fmt.Errorf("failed to resolve player: %w", err)
+	}
+
+	jobs, err := s.daemon.ListScheduledJobs(ctx, playerID)
+	if err != nil {
+		return nil, err
+	}
+	resp := &pb.ListScheduledJobsResponse{Jobs: make([]*pb.ScheduledJob, 0, len(jobs))}
+	for _, job := range jobs {
+		resp.Jobs = append(resp.Jobs, scheduledJobToProto(job))
+	}
+	return resp, nil
+}
+
+// RemoveScheduledJob deletes a recurring job
+func (s *daemonServiceImpl) RemoveScheduledJob(ctx context.Context, req *pb.RemoveScheduledJobRequest) (*pb.RemoveScheduledJobResponse, error) {
+	playerID, err := s.resolvePlayerID(ctx, req.PlayerId, req.AgentSymbol)
+	if err != nil {
+		return nil, fmt.Errorf("failed to resolve player: %w", err)
+	}
+
+	if err := s.daemon.RemoveScheduledJob(ctx, playerID, req.Name); err != nil {
+		return nil, err
+	}
+	return &pb.RemoveScheduledJobResponse{Name: req.Name}, nil
+}
+
+func scheduledJobToProto(job *daemon.ScheduledJob) *pb.ScheduledJob {
+	out := &pb.ScheduledJob{
+		Name:             job.Name,
+		Kind:             string(job.Kind),
+		IntervalSeconds:  int64(job.Interval / time.Second),
+		Params:           job.Params,
+		Enabled:          job.Enabled,
+		NextRunAt:        job.NextRunAt.Format(time.RFC3339),
+		LastStatus:       string(job.LastStatus),
+		LastError:        job.LastError,
+		LastContainerIds: job.LastContainerIDs,
+	}
+	if job.LastRunAt != nil {
+		out.LastRunAt = job.LastRunAt.Format(time.RFC3339)
+	}
+	return out
+}
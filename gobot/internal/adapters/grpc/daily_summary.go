@@ -0,0 +1,81 @@
+package grpc
+
+import (
+	"context"
+	"log"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	ledgerQuery "github.com/andrescamacho/spacetraders-go/internal/application/ledger/queries"
+	"github.com/andrescamacho/spacetraders-go/internal/infrastructure/supervise"
+)
+
+// SetDailySummaryLog arms the scheduled operations digest: Start launches a
+// loop that logs GetDailySummaryQuery for the live player every interval. Must
+// be called before Start; leaving it unset keeps the digest off.
+func (s *DaemonServer) SetDailySummaryLog(interval time.Duration) {
+	if interval <= 0 {
+		return
+	}
+	s.dailySummaryInterval = interval
+}
+
+// runDailySummaryLog logs the digest every interval until ctx is canceled. The
+// tick body runs under supervise.Guard so one bad query cannot kill the loop.
+func (s *DaemonServer) runDailySummaryLog(ctx context.Context) error {
+	ticker := time.NewTicker(s.dailySummaryInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+			supervise.Guard("daily-summary", func() {
+				s.logDailySummary(ctx)
+			})
+		}
+	}
+}
+
+func (s *DaemonServer) logDailySummary(ctx context.Context) {
+	pid := s.primaryPlayerID(ctx)
+	if pid == 0 {
+		return
+	}
+	resp, err := s.mediator.Send(ctx, &ledgerQuery.GetDailySummaryQuery{PlayerID: pid, Window: s.dailySummaryInterval})
+	if err != nil {
+		log.Printf("Daily summary failed: %v", err)
+		return
+	}
+	summary, ok := resp.(*ledgerQuery.GetDailySummaryResponse)
+	if !ok {
+		log.Printf("Daily summary failed: unexpected response type %T", resp)
+		return
+	}
+
+	log.Printf("Daily summary for player %d (%s to %s): net %d (revenue %d, expenses %d, %d transactions)",
+		pid, summary.Start.Format(time.RFC3339), summary.End.Format(time.RFC3339),
+		summary.NetProfit, summary.TotalRevenue, summary.TotalExpenses, summary.TransactionCount)
+	log.Printf("Daily summary: arbitrage %d runs, %d sales, profit %d | contracts %d accepted, %d fulfilled, revenue %d",
+		summary.ArbitrageRuns, summary.ArbitrageSales, summary.ArbitrageProfit,
+		summary.ContractsAccepted, summary.ContractsFulfilled, summary.ContractRevenue)
+	log.Printf("Daily summary: extracted %d units [%s] | API %d requests, %d failed (%.1f%%)",
+		summary.ExtractedUnits, formatExtractedGoods(summary.ExtractedGoods),
+		summary.APIRequests, summary.APIFailures, summary.APIErrorRatePct)
+}
+
+// formatExtractedGoods renders good=units pairs in a stable order.
+func formatExtractedGoods(goods map[string]int) string {
+	names := make([]string, 0, len(goods))
+	for good := range goods {
+		names = append(names, good)
+	}
+	sort.Strings(names)
+	parts := make([]string, 0, len(names))
+	for _, good := range names {
+		parts = append(parts, good+"="+strconv.Itoa(goods[good]))
+	}
+	return strings.Join(parts, " ")
+}
@@ -0,0 +1,213 @@
+package grpc
+
+import (
+	"context"
+	"fmt"
+
+	bootstrapCmd "github.com/andrescamacho/spacetraders-go/internal/application/bootstrap/commands"
+	"github.com/andrescamacho/spacetraders-go/internal/application/common"
+	shipyardCmd "github.com/andrescamacho/spacetraders-go/internal/application/shipyard/commands"
+	"github.com/andrescamacho/spacetraders-go/internal/domain/container"
+	"github.com/andrescamacho/spacetraders-go/internal/domain/navigation"
+	"github.com/andrescamacho/spacetraders-go/internal/domain/shared"
+	"github.com/andrescamacho/spacetraders-go/pkg/utils"
+)
+
+// This file wires BootstrapFleetCommand's ports to the daemon. Ship orders reuse the
+// BatchPurchaseShips path; template containers start through the same launch verbs the CLI
+// uses, so a template-launched coordinator is indistinguishable from a hand-launched one and
+// recovers the same way across restarts.
+
+// NewBootstrapFleetHandler assembles BootstrapFleetCommand's handler. templates resolves profile
+// names; shipRepo backs the owned-hull count and purchaser pick; med runs the purchases.
+func NewBootstrapFleetHandler(
+	server *DaemonServer,
+	templates bootstrapCmd.FleetTemplateLoader,
+	shipRepo navigation.ShipRepository,
+	med common.Mediator,
+) *bootstrapCmd.BootstrapFleetHandler {
+	return bootstrapCmd.NewBootstrapFleetHandler(
+		templates,
+		&fleetTemplateBuyer{shipRepo: shipRepo, med: med},
+		&fleetTemplateLauncher{server: server},
+	)
+}
+
+// fleetTemplateLauncher starts template containers through the standing coordinators of
+// launchableOperations.
+type fleetTemplateLauncher struct {
+	server *DaemonServer
+}
+
+func (l *fleetTemplateLauncher) SupportsCommandType(commandType string) bool {
+	entry, ok := launchableOperations[commandType]
+	return ok && entry.containerType != ""
+}
+
+// LaunchFleetContainer starts spec unless a container of its type is already RUNNING or PENDING
+// for the player, in which case that container is reported instead — every launchable type is
+// one-per-player. A misspelt or mistyped knob in the profile fails the launch rather than being
+// silently dropped.
+func (l *fleetTemplateLauncher) LaunchFleetContainer(ctx context.Context, playerID int, agentSymbol string, spec container.ContainerTemplate) (string, bool, error) {
+	entry, ok := launchableOperations[spec.CommandType]
+	if !ok || entry.containerType == "" {
+		return "", false, fmt.Errorf("command type %s cannot be launched from a fleet template", spec.CommandType)
+	}
+	if err := checkProfileParams(spec.Config, entry.params); err != nil {
+		return "", false, fmt.Errorf("invalid config for %s: %w", spec.CommandType, err)
+	}
+
+	existingID, err := firstContainerIDOfType(ctx, l.server.containerRepo, playerID, entry.containerType)
+	if err != nil {
+		return "", false, fmt.Errorf("failed to check for a running %s: %w", spec.CommandType, err)
+	}
+	if existingID != "" {
+		return existingID, true, nil
+	}
+
+	ids, err := entry.launch(l.server, ctx, playerID, agentSymbol, nil, newConfigReader(spec.Config))
+	if err != nil {
+		return "", false, err
+	}
+	return ids[0], false, nil
+}
+
+// fleetTemplateBuyer buys template ship orders through BatchPurchaseShips.
+type fleetTemplateBuyer struct {
+	shipRepo navigation.ShipRepository
+	med      common.Mediator
+}
+
+func (b *fleetTemplateBuyer) OwnedByFrame(ctx context.Context, playerID int) (map[string]int, error) {
+	pid, err := shared.NewPlayerID(playerID)
+	if err != nil {
+		return nil, err
+	}
+	ships, err := b.shipRepo.FindAllByPlayer(ctx, pid)
+	if err != nil {
+		return nil, err
+	}
+	owned := make(map[string]int)
+	for _, s := range ships {
+		owned[s.FrameSymbol()]++
+	}
+	return owned, nil
+}
+
+// Buy runs one batch purchase for the order. An empty purchasingShip resolves to an idle hull
+// the same way the bootstrap coordinator's buys do.
+func (b *fleetTemplateBuyer) Buy(ctx context.Context, playerID int, purchasingShip string, order container.ShipOrder, quantity int) (int, int, error) {
+	pid, err := shared.NewPlayerID(playerID)
+	if err != nil {
+		return 0, 0, err
+	}
+	if purchasingShip == "" {
+		ships, err := b.shipRepo.FindAllByPlayer(ctx, pid)
+		if err != nil {
+			return 0, 0, err
+		}
+		if purchasingShip = idlePurchaser(ships); purchasingShip == "" {
+			return 0, 0, fmt.Errorf("no idle hull available to execute the purchase")
+		}
+	}
+
+	resp, err := b.med.Send(ctx, &shipyardCmd.BatchPurchaseShipsCommand{
+		PurchasingShipSymbol: purchasingShip,
+		ShipType:             order.ShipType,
+		Quantity:             quantity,
+		MaxBudget:            order.MaxBudget,
+		PlayerID:             pid,
+		ShipyardWaypoint:     order.Shipyard,
+		Loadout:              order.Loadout,
+		MaxPrice:             order.MaxPrice,
+	})
+	if err != nil {
+		return 0, 0, err
+	}
+	batch, ok := resp.(*shipyardCmd.BatchPurchaseShipsResponse)
+	if !ok {
+		return 0, 0, fmt.Errorf("unexpected response type %T", resp)
+	}
+	if batch.PriceAboveMax {
+		return batch.ShipsPurchasedCount, batch.TotalCost,
+			fmt.Errorf("bought %d of %d %s: listing at %d is above max_price %d", batch.ShipsPurchasedCount, quantity, order.ShipType, batch.LastQuotedPrice, order.MaxPrice)
+	}
+	if batch.ShipsPurchasedCount < quantity {
+		return batch.ShipsPurchasedCount, batch.TotalCost,
+			fmt.Errorf("bought %d of %d %s (budget or treasury exhausted)", batch.ShipsPurchasedCount, quantity, order.ShipType)
+	}
+	return batch.ShipsPurchasedCount, batch.TotalCost, nil
+}
+
+// BootstrapFleet applies a fleet template for the player: buys the profile's starter ships and
+// launches its standing coordinators in dependency order. profile is a template name in the
+// fleet templates directory or a path to a YAML file; purchasingShip may be empty to let the buy
+// pick an idle hull. A dry run reports the plan at once without spending or launching anything;
+// otherwise the template is applied in a bootstrap_fleet container, whose ID is returned.
+func (s *DaemonServer) BootstrapFleet(ctx context.Context, playerID int, agentSymbol, profile, purchasingShip string, dryRun bool) (string, *bootstrapCmd.BootstrapFleetResponse, error) {
+	if profile == "" {
+		return "", nil, fmt.Errorf("a fleet template profile is required")
+	}
+	if agentSymbol == "" {
+		agentSymbol = s.agentSymbolForPlayer(ctx, playerID)
+	}
+
+	if dryRun {
+		resp, err := s.mediator.Send(ctx, &bootstrapCmd.BootstrapFleetCommand{
+			PlayerID:             playerID,
+			AgentSymbol:          agentSymbol,
+			Profile:              profile,
+			PurchasingShipSymbol: purchasingShip,
+			DryRun:               true,
+		})
+		if err != nil {
+			return "", nil, err
+		}
+		plan, ok := resp.(*bootstrapCmd.BootstrapFleetResponse)
+		if !ok {
+			return "", nil, fmt.Errorf("unexpected response type %T", resp)
+		}
+		return "", plan, nil
+	}
+
+	containerID := utils.GenerateContainerID("bootstrap_fleet", fmt.Sprintf("player-%d", playerID))
+	config := map[string]interface{}{
+		"profile":      profile,
+		"agent_symbol": agentSymbol,
+	}
+	if purchasingShip != "" {
+		config["purchasing_ship"] = purchasingShip
+	}
+	cmd, err := s.buildCommandForType("bootstrap_fleet", config, playerID, containerID)
+	if err != nil {
+		return "", nil, fmt.Errorf("failed to create command: %w", err)
+	}
+
+	containerEntity := container.NewContainer(
+		containerID,
+		container.ContainerTypeFleetBootstrap,
+		playerID,
+		1,
+		nil,
+		config,
+		nil,
+	)
+	if err := s.containerRepo.Add(ctx, containerEntity, "bootstrap_fleet"); err != nil {
+		return "", nil, fmt.Errorf("failed to persist container: %w", err)
+	}
+	runner := NewContainerRunner(containerEntity, s.mediator, cmd, s.logRepo, s.containerRepo, s.shipRepo, s.clock)
+	s.registerContainer(containerID, runner)
+	if err := runner.Start(); err != nil {
+		return "", nil, fmt.Errorf("failed to start fleet bootstrap: %w", err)
+	}
+	return containerID, nil, nil
+}
+
+func buildBootstrapFleetCommand(cfg *configReader, playerID int, containerID string) interface{} {
+	return &bootstrapCmd.BootstrapFleetCommand{
+		PlayerID:             playerID,
+		AgentSymbol:          cfg.OptionalString("agent_symbol"),
+		Profile:              cfg.RequiredString("profile"),
+		PurchasingShipSymbol: cfg.OptionalString("purchasing_ship"),
+	}
+}
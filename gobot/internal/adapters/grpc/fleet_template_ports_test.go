@@ -0,0 +1,107 @@
+package grpc
+
+import (
+	"context"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/andrescamacho/spacetraders-go/internal/adapters/persistence"
+	bootstrapCmd "github.com/andrescamacho/spacetraders-go/internal/application/bootstrap/commands"
+	"github.com/andrescamacho/spacetraders-go/internal/application/common"
+)
+
+// fleetPlanMediator answers BootstrapFleetCommand with a one-order plan and
+// hands every command it receives to sent.
+type fleetPlanMediator struct {
+	sent chan *bootstrapCmd.BootstrapFleetCommand
+}
+
+func (m *fleetPlanMediator) Send(_ context.Context, request common.Request) (common.Response, error) {
+	cmd := request.(*bootstrapCmd.BootstrapFleetCommand)
+	m.sent <- cmd
+	return &bootstrapCmd.BootstrapFleetResponse{
+		Template:  "standard",
+		DryRun:    cmd.DryRun,
+		Purchases: []bootstrapCmd.FleetPurchaseResult{{ShipType: "SHIP_PROBE", Wanted: 2}},
+	}, nil
+}
+
+func (m *fleetPlanMediator) Register(reflect.Type, common.RequestHandler) error { return nil }
+
+func (m *fleetPlanMediator) RegisterMiddleware(common.Middleware) {}
+
+// Every standing coordinator a template may launch must be a registered container spec,
+// or a template-launched container could not be recovered after a restart.
+func TestLaunchableStandingCoordinatorsAreRegisteredSpecs(t *testing.T) {
+	specs := make(map[string]bool)
+	for _, spec := range containerSpecList() {
+		specs[spec.CommandType] = true
+	}
+	for name, entry := range launchableOperations {
+		if entry.containerType != "" && !specs[name] {
+			t.Errorf("launchable coordinator %s has no container spec", name)
+		}
+	}
+}
+
+// Fleet templates launch only the standing coordinators and check their config against the
+// same params an operation profile is checked against.
+func TestFleetTemplateLauncher_ChecksConfigAgainstRegistry(t *testing.T) {
+	l := &fleetTemplateLauncher{}
+	if !l.SupportsCommandType("worker_rebalancer_coordinator") || l.SupportsCommandType("tour_run") {
+		t.Fatal("templates must launch the standing coordinators and nothing per-ship")
+	}
+	params := launchableOperations["worker_rebalancer_coordinator"].params
+	if err := checkProfileParams(map[string]interface{}{"dry_run": true}, params); err != nil {
+		t.Fatalf("valid config rejected: %v", err)
+	}
+	params = launchableOperations["scout_post_coordinator"].params
+	for name, cfg := range map[string]map[string]interface{}{
+		"unknown key":   {"tick_secs": 60},
+		"bool as int":   {"tick_interval_secs": true},
+		"int as string": {"tick_interval_secs": "60"},
+	} {
+		if err := checkProfileParams(cfg, params); err == nil {
+			t.Errorf("%s: expected an error", name)
+		}
+	}
+}
+
+// A dry run returns the plan at once; a real run applies the template inside a
+// persisted bootstrap_fleet container, so the purchases fly under a container.
+func TestBootstrapFleet_DryRunPlansAndRealRunStartsContainer(t *testing.T) {
+	s, db, playerID := newRecoveryTestServer(t)
+	med := &fleetPlanMediator{sent: make(chan *bootstrapCmd.BootstrapFleetCommand, 2)}
+	s.mediator = med
+	ctx := context.Background()
+
+	containerID, plan, err := s.BootstrapFleet(ctx, playerID, "REC-AGENT", "standard", "", true)
+	require.NoError(t, err)
+	require.Empty(t, containerID)
+	require.True(t, plan.DryRun)
+	require.Len(t, plan.Purchases, 1)
+	require.True(t, (<-med.sent).DryRun)
+
+	containerID, plan, err = s.BootstrapFleet(ctx, playerID, "REC-AGENT", "standard", "SHIP-1", false)
+	require.NoError(t, err)
+	require.Nil(t, plan)
+	require.NotEmpty(t, containerID)
+
+	select {
+	case cmd := <-med.sent:
+		require.False(t, cmd.DryRun)
+		require.Equal(t, "standard", cmd.Profile)
+		require.Equal(t, "SHIP-1", cmd.PurchasingShipSymbol)
+		require.Equal(t, playerID, cmd.PlayerID)
+	case <-time.After(5 * time.Second):
+		t.Fatal("the bootstrap_fleet container never sent the command")
+	}
+
+	var model persistence.ContainerModel
+	require.NoError(t, db.Where("id = ?", containerID).First(&model).Error)
+	require.Equal(t, "bootstrap_fleet", model.CommandType)
+	require.Contains(t, model.Config, `"profile":"standard"`)
+}
@@ -0,0 +1,58 @@
+package grpc
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/http"
+	"time"
+
+	"github.com/andrescamacho/spacetraders-go/internal/adapters/httpgateway"
+)
+
+// SetHTTPGateway arms the read-only HTTP/JSON gateway: Start serves it on addr,
+// requiring token on every request. Must be called before Start; leaving it
+// unset keeps the gateway off.
+func (s *DaemonServer) SetHTTPGateway(addr, token string) {
+	s.httpGatewayAddr = addr
+	s.httpGatewayToken = token
+}
+
+// startHTTPGateway binds and serves the gateway. Ships and containers are
+// answered by the same service implementation the gRPC socket uses; markets and
+// P&L go through the mediator.
+func (s *DaemonServer) startHTTPGateway() error {
+	handler, err := httpgateway.NewHandler(newDaemonServiceImpl(s), s.mediator, s.httpGatewayToken)
+	if err != nil {
+		return err
+	}
+
+	// Bind first so a taken port is reported instead of failing in the background
+	listener, err := net.Listen("tcp", s.httpGatewayAddr)
+	if err != nil {
+		return fmt.Errorf("failed to bind http gateway to %s: %w", s.httpGatewayAddr, err)
+	}
+
+	s.httpGatewayServer = &http.Server{
+		Handler:           handler,
+		ReadHeaderTimeout: 10 * time.Second,
+	}
+	go func() {
+		if err := s.httpGatewayServer.Serve(listener); err != nil && err != http.ErrServerClosed {
+			fmt.Printf("HTTP gateway error: %v\n", err)
+		}
+	}()
+	return nil
+}
+
+// stopHTTPGateway gracefully stops the gateway, if it is running.
+func (s *DaemonServer) stopHTTPGateway() {
+	if s.httpGatewayServer == nil {
+		return
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	if err := s.httpGatewayServer.Shutdown(ctx); err != nil {
+		fmt.Printf("Error shutting down HTTP gateway: %v\n", err)
+	}
+}
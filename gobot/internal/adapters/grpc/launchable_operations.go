@@ -0,0 +1,393 @@
+package grpc
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/andrescamacho/spacetraders-go/internal/domain/container"
+)
+
+// This file is the one registry of operations that can be launched by name: fleet templates
+// start its standing coordinators and operation profiles start any of its entries. Every
+// entry goes through the same launch verb the CLI uses, so a launch by name keeps every guard
+// of a hand-launched one (idle-hull refusal, atomic claim, recovery).
+
+// profileParamKind is the value kind an operation profile param must decode to.
+type profileParamKind int
+
+const (
+	profileString profileParamKind = iota
+	profileInt
+	profileBool
+	profileStrings
+)
+
+func (k profileParamKind) String() string {
+	switch k {
+	case profileInt:
+		return "an integer"
+	case profileBool:
+		return "a bool"
+	case profileStrings:
+		return "a list of strings"
+	}
+	return "a string"
+}
+
+// profileParam is one knob an operation accepts from a profile.
+type profileParam struct {
+	key      string
+	kind     profileParamKind
+	required bool
+}
+
+// launchShips is how an operation takes the profile's ships.
+type launchShips int
+
+const (
+	// launchNoShips is a standing coordinator that finds its own hulls.
+	launchNoShips launchShips = iota
+	// launchPerShip starts one run per ship.
+	launchPerShip
+	// launchShipList starts one run over the whole ship list.
+	launchShipList
+)
+
+// launchableOperation is one operation that can be launched by name: how it takes ships, the
+// params its launch verb takes and the launch. containerType is set for the one-per-player
+// standing coordinators, and is the type checked for an already-running instance; only those
+// may be named by a fleet template.
+type launchableOperation struct {
+	containerType container.ContainerType
+	ships         launchShips
+	params        []profileParam
+	launch        func(s *DaemonServer, ctx context.Context, playerID int, agentSymbol string, ships []string, cfg *configReader) ([]string, error)
+}
+
+// launchableOperations are the operations a fleet template or operation profile can launch,
+// keyed by name (the command type for the standing coordinators). Each takes only the knobs
+// its launch verb takes; unset knobs take the verb's own defaults exactly as an omitted CLI
+// flag does, and everything else resolves from config.yaml inside buildCommandForType.
+var launchableOperations = map[string]launchableOperation{
+	"scout_post_coordinator": {
+		containerType: container.ContainerTypeScoutPostCoordinator,
+		params: []profileParam{
+			{key: "tick_interval_secs", kind: profileInt},
+		},
+		launch: func(s *DaemonServer, ctx context.Context, playerID int, _ string, _ []string, cfg *configReader) ([]string, error) {
+			return launchedOne(s.ScoutPostCoordinator(ctx, playerID, cfg.OptionalInt("tick_interval_secs", 0)))
+		},
+	},
+	"market_freshness_sizer_coordinator": {
+		containerType: container.ContainerTypeMarketFreshnessSizer,
+		params: []profileParam{
+			{key: "tick_interval_secs", kind: profileInt},
+			{key: "dry_run", kind: profileBool},
+			{key: "sla_seconds", kind: profileInt},
+			{key: "max_probes_per_system", kind: profileInt},
+			{key: "max_probe_fleet", kind: profileInt},
+			{key: "max_spend_per_cycle", kind: profileInt},
+			{key: "purchase_cooldown_secs", kind: profileInt},
+		},
+		launch: func(s *DaemonServer, ctx context.Context, playerID int, _ string, _ []string, cfg *configReader) ([]string, error) {
+			return launchedOne(s.MarketFreshnessSizerCoordinator(ctx, playerID,
+				cfg.OptionalInt("tick_interval_secs", 0),
+				cfg.OptionalBool("dry_run"),
+				cfg.OptionalInt("sla_seconds", 0),
+				cfg.OptionalInt("max_probes_per_system", 0),
+				cfg.OptionalInt("max_probe_fleet", 0),
+				cfg.OptionalInt("max_spend_per_cycle", 0),
+				cfg.OptionalInt("purchase_cooldown_secs", 0)))
+		},
+	},
+	"probe_parking_coordinator": {
+		containerType: container.ContainerTypeProbeParkingCoordinator,
+		params: []profileParam{
+			{key: "tick_interval_secs", kind: profileInt},
+			{key: "scan_interval_secs", kind: profileInt},
+			{key: "reserve_idle_probes", kind: profileInt},
+		},
+		launch: func(s *DaemonServer, ctx context.Context, playerID int, _ string, _ []string, cfg *configReader) ([]string, error) {
+			return launchedOne(s.ProbeParkingCoordinator(ctx, playerID,
+				cfg.OptionalInt("tick_interval_secs", 0),
+				cfg.OptionalInt("scan_interval_secs", 0),
+				cfg.OptionalInt("reserve_idle_probes", 0)))
+		},
+	},
+	"shipyard_backfill_coordinator": {
+		containerType: container.ContainerTypeShipyardBackfillCoordinator,
+		params: []profileParam{
+			{key: "tick_interval_secs", kind: profileInt},
+			{key: "max_dispatches_per_cycle", kind: profileInt},
+		},
+		launch: func(s *DaemonServer, ctx context.Context, playerID int, _ string, _ []string, cfg *configReader) ([]string, error) {
+			return launchedOne(s.ShipyardBackfillCoordinator(ctx, playerID,
+				cfg.OptionalInt("tick_interval_secs", 0),
+				cfg.OptionalInt("max_dispatches_per_cycle", 0)))
+		},
+	},
+	"contract_fleet_coordinator": {
+		containerType: container.ContainerTypeContractFleetCoordinator,
+		launch: func(s *DaemonServer, ctx context.Context, playerID int, _ string, _ []string, _ *configReader) ([]string, error) {
+			return launchedOne(s.ContractFleetCoordinator(ctx, nil, playerID, nil, nil))
+		},
+	},
+	"trade_fleet_coordinator": {
+		containerType: container.ContainerTypeTradeFleetCoordinator,
+		launch: func(s *DaemonServer, ctx context.Context, playerID int, agentSymbol string, _ []string, _ *configReader) ([]string, error) {
+			return launchedOne(s.TradeFleetCoordinator(ctx, playerID, agentSymbol))
+		},
+	},
+	"fleet_autosizer": {
+		containerType: container.ContainerTypeFleetAutosizer,
+		launch: func(s *DaemonServer, ctx context.Context, playerID int, agentSymbol string, _ []string, _ *configReader) ([]string, error) {
+			return launchedOne(s.FleetAutosizerCoordinator(ctx, playerID, agentSymbol))
+		},
+	},
+	"siting_coordinator": {
+		containerType: container.ContainerTypeSitingCoordinator,
+		launch: func(s *DaemonServer, ctx context.Context, playerID int, agentSymbol string, _ []string, _ *configReader) ([]string, error) {
+			return launchedOne(s.SitingCoordinator(ctx, playerID, agentSymbol))
+		},
+	},
+	"worker_rebalancer_coordinator": {
+		containerType: container.ContainerTypeWorkerRebalancerCoordinator,
+		params: []profileParam{
+			{key: "dry_run", kind: profileBool},
+		},
+		launch: func(s *DaemonServer, ctx context.Context, playerID int, agentSymbol string, _ []string, cfg *configReader) ([]string, error) {
+			return launchedOne(s.WorkerRebalancerCoordinator(ctx, playerID, agentSymbol, cfg.OptionalBool("dry_run")))
+		},
+	},
+	"capacity_reconciler_coordinator": {
+		containerType: container.ContainerTypeCapacityReconciler,
+		params: []profileParam{
+			{key: "dry_run", kind: profileBool},
+		},
+		launch: func(s *DaemonServer, ctx context.Context, playerID int, _ string, _ []string, cfg *configReader) ([]string, error) {
+			return launchedOne(s.CapacityReconcilerCoordinator(ctx, playerID, cfg.OptionalBool("dry_run")))
+		},
+	},
+	"auto_outfit_coordinator": {
+		containerType: container.ContainerTypeAutoOutfitCoordinator,
+		params: []profileParam{
+			{key: "dry_run", kind: profileBool},
+		},
+		launch: func(s *DaemonServer, ctx context.Context, playerID int, _ string, _ []string, cfg *configReader) ([]string, error) {
+			return launchedOne(s.AutoOutfitCoordinator(ctx, playerID, cfg.OptionalBool("dry_run")))
+		},
+	},
+	"bootstrap": {
+		containerType: container.ContainerTypeBootstrapCoordinator,
+		params: []profileParam{
+			{key: "dry_run", kind: profileBool},
+		},
+		launch: func(s *DaemonServer, ctx context.Context, playerID int, agentSymbol string, _ []string, cfg *configReader) ([]string, error) {
+			return launchedOne(s.BootstrapCoordinator(ctx, playerID, agentSymbol, cfg.OptionalBool("dry_run")))
+		},
+	},
+	"tour_run": {
+		ships: launchPerShip,
+		params: []profileParam{
+			{key: "max_hops", kind: profileInt},
+			{key: "max_spend", kind: profileInt},
+			{key: "min_margin", kind: profileInt},
+			{key: "replan_limit", kind: profileInt},
+			{key: "working_capital_reserve", kind: profileInt},
+			{key: "working_capital_reserve_treasury_pct", kind: profileInt},
+			{key: "iterations", kind: profileInt},
+		},
+		launch: func(s *DaemonServer, ctx context.Context, playerID int, agentSymbol string, ships []string, cfg *configReader) ([]string, error) {
+			result, err := s.StartTourRun(ctx, ships[0],
+				cfg.OptionalInt("max_hops", 0),
+				int64(cfg.OptionalInt("max_spend", 0)),
+				cfg.OptionalInt("min_margin", 0),
+				cfg.OptionalInt("replan_limit", 0),
+				int64(cfg.OptionalInt("working_capital_reserve", 0)),
+				cfg.OptionalInt("working_capital_reserve_treasury_pct", 0),
+				agentSymbol,
+				cfg.OptionalInt("iterations", 0),
+				playerID, nil)
+			if err != nil {
+				return nil, err
+			}
+			return []string{result.ContainerID}, nil
+		},
+	},
+	"arb_run": {
+		ships: launchPerShip,
+		params: []profileParam{
+			{key: "good", kind: profileString, required: true},
+			{key: "buy_at", kind: profileString, required: true},
+			{key: "sell_at", kind: profileString, required: true},
+			{key: "max_units", kind: profileInt},
+			{key: "max_spend", kind: profileInt},
+			{key: "min_margin", kind: profileInt},
+			{key: "working_capital_reserve", kind: profileInt},
+		},
+		launch: func(s *DaemonServer, ctx context.Context, playerID int, _ string, ships []string, cfg *configReader) ([]string, error) {
+			result, err := s.StartArbRun(ctx, ships[0],
+				cfg.RequiredString("good"),
+				cfg.RequiredString("buy_at"),
+				cfg.RequiredString("sell_at"),
+				cfg.OptionalInt("max_units", 0),
+				cfg.OptionalInt("max_spend", 0),
+				cfg.OptionalInt("min_margin", 0),
+				cfg.OptionalInt("working_capital_reserve", 0),
+				playerID)
+			if err != nil {
+				return nil, err
+			}
+			return []string{result.ContainerID}, nil
+		},
+	},
+	"trade_route": {
+		ships: launchPerShip,
+		params: []profileParam{
+			{key: "system", kind: profileString, required: true},
+			{key: "max_visits", kind: profileInt},
+			{key: "dest_waypoint", kind: profileString},
+		},
+		launch: func(s *DaemonServer, ctx context.Context, playerID int, _ string, ships []string, cfg *configReader) ([]string, error) {
+			result, err := s.StartTradeRoute(ctx, ships[0],
+				cfg.RequiredString("system"),
+				cfg.OptionalInt("max_visits", 0),
+				playerID,
+				cfg.OptionalString("dest_waypoint"))
+			if err != nil {
+				return nil, err
+			}
+			return []string{result.ContainerID}, nil
+		},
+	},
+	"stocker": {
+		ships: launchPerShip,
+		params: []profileParam{
+			{key: "warehouse", kind: profileString, required: true},
+			{key: "budget_per_leg", kind: profileInt},
+			{key: "working_capital_reserve", kind: profileInt},
+			{key: "iterations", kind: profileInt},
+			{key: "max_market_age_minutes", kind: profileInt},
+			{key: "target_per_good", kind: profileInt},
+			{key: "standing", kind: profileBool},
+			{key: "tick_seconds", kind: profileInt},
+			{key: "refill_hysteresis", kind: profileInt},
+		},
+		launch: func(s *DaemonServer, ctx context.Context, playerID int, agentSymbol string, ships []string, cfg *configReader) ([]string, error) {
+			result, err := s.StartStocker(ctx, ships[0],
+				cfg.RequiredString("warehouse"),
+				cfg.OptionalInt("budget_per_leg", 0),
+				int64(cfg.OptionalInt("working_capital_reserve", 0)),
+				cfg.OptionalInt("iterations", 0),
+				cfg.OptionalInt("max_market_age_minutes", 0),
+				cfg.OptionalInt("target_per_good", 0),
+				cfg.OptionalBool("standing"),
+				cfg.OptionalInt("tick_seconds", 0),
+				cfg.OptionalInt("refill_hysteresis", 0),
+				agentSymbol,
+				playerID)
+			if err != nil {
+				return nil, err
+			}
+			return []string{result.ContainerID}, nil
+		},
+	},
+	"scout_markets": {
+		ships: launchShipList,
+		params: []profileParam{
+			{key: "system", kind: profileString, required: true},
+			{key: "markets", kind: profileStrings},
+			{key: "iterations", kind: profileInt},
+		},
+		launch: func(s *DaemonServer, ctx context.Context, playerID int, _ string, ships []string, cfg *configReader) ([]string, error) {
+			ids, _, reused, err := s.ScoutMarkets(ctx, ships,
+				cfg.RequiredString("system"),
+				cfg.OptionalStringSlice("markets"),
+				cfg.OptionalInt("iterations", 1),
+				playerID)
+			if err != nil {
+				return nil, err
+			}
+			// A reused tour was launched by someone else; only the new ones are this profile's.
+			fresh := make([]string, 0, len(ids))
+			for _, id := range ids {
+				if !slices.Contains(reused, id) {
+					fresh = append(fresh, id)
+				}
+			}
+			return fresh, nil
+		},
+	},
+}
+
+// launchedOne adapts a launch verb that starts a single container.
+func launchedOne(containerID string, err error) ([]string, error) {
+	if err != nil {
+		return nil, err
+	}
+	return []string{containerID}, nil
+}
+
+// checkProfileParams rejects unknown params, missing required ones and values of the wrong
+// kind. It is stricter than the launch-config reader, which falls back to a default on a
+// mistyped value: a profile is hand-written, so a wrong value must fail loudly.
+func checkProfileParams(params map[string]interface{}, schema []profileParam) error {
+	known := make(map[string]profileParam, len(schema))
+	for _, p := range schema {
+		known[p.key] = p
+	}
+
+	var bad []string
+	for key, value := range params {
+		p, ok := known[key]
+		if !ok {
+			bad = append(bad, key+" (unknown)")
+			continue
+		}
+		if !profileValueMatches(value, p.kind) {
+			bad = append(bad, fmt.Sprintf("%s (want %s)", key, p.kind))
+		}
+	}
+	for _, p := range schema {
+		if _, ok := params[p.key]; p.required && !ok {
+			bad = append(bad, p.key+" (required)")
+		}
+	}
+	if len(bad) == 0 {
+		return nil
+	}
+	sort.Strings(bad)
+	return fmt.Errorf("%s", strings.Join(bad, ", "))
+}
+
+func profileValueMatches(value interface{}, kind profileParamKind) bool {
+	switch kind {
+	case profileInt:
+		if f, ok := value.(float64); ok {
+			return f == math.Trunc(f)
+		}
+		_, ok := intValue(value)
+		return ok
+	case profileBool:
+		_, ok := value.(bool)
+		return ok
+	case profileStrings:
+		_, ok := stringSliceValue(value)
+		return ok
+	}
+	s, ok := value.(string)
+	return ok && s != ""
+}
+
+func launchableOperationNames() []string {
+	names := make([]string, 0, len(launchableOperations))
+	for name := range launchableOperations {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
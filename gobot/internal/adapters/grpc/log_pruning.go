@@ -0,0 +1,59 @@
+package grpc
+
+import (
+	"context"
+	"log"
+	"time"
+
+	logCommands "github.com/andrescamacho/spacetraders-go/internal/application/logging/commands"
+	"github.com/andrescamacho/spacetraders-go/internal/infrastructure/supervise"
+)
+
+// SetLogPruning arms scheduled container log pruning: Start launches a loop
+// that sends policy as a PruneLogsCommand at startup and then every interval.
+// Must be called before Start; leaving it unset keeps pruning off.
+func (s *DaemonServer) SetLogPruning(policy logCommands.PruneLogsCommand, interval time.Duration) {
+	if interval <= 0 || (policy.MaxAge <= 0 && policy.MaxRowsPerContainer <= 0) {
+		return
+	}
+	s.logPrunePolicy = &policy
+	s.logPruneInterval = interval
+}
+
+// runLogPruning prunes once, then every interval until ctx is canceled. The
+// tick body runs under supervise.Guard so one bad prune cannot kill the loop.
+func (s *DaemonServer) runLogPruning(ctx context.Context) error {
+	supervise.Guard("log-pruning", func() {
+		s.pruneLogs(ctx)
+	})
+	ticker := time.NewTicker(s.logPruneInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+			supervise.Guard("log-pruning", func() {
+				s.pruneLogs(ctx)
+			})
+		}
+	}
+}
+
+func (s *DaemonServer) pruneLogs(ctx context.Context) {
+	cmd := *s.logPrunePolicy
+	resp, err := s.mediator.Send(ctx, &cmd)
+	result, _ := resp.(*logCommands.PruneLogsResponse)
+	if err != nil {
+		deleted := int64(0)
+		if result != nil {
+			deleted = result.Deleted
+		}
+		log.Printf("Container log pruning failed after deleting %d lines: %v", deleted, err)
+		return
+	}
+	if result != nil && result.Deleted > 0 {
+		log.Printf("Container log pruning: deleted %d lines (%d archived in %d files, %d containers over the row cap)",
+			result.Deleted, result.Archived, len(result.ArchiveFiles), result.CappedContainers)
+	}
+}
@@ -0,0 +1,104 @@
+package grpc
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	fleetCmd "github.com/andrescamacho/spacetraders-go/internal/application/fleet/commands"
+	"github.com/andrescamacho/spacetraders-go/internal/domain/container"
+)
+
+// This file wires LaunchOperationProfileCommand's launcher to the daemon. Profiles start
+// through launchableOperations, so a profile-launched run differs from a hand-launched one
+// only in the profile stamp on its launch config.
+
+// NewLaunchOperationProfileHandler assembles LaunchOperationProfileCommand's handler.
+// profiles resolves profile names.
+func NewLaunchOperationProfileHandler(server *DaemonServer, profiles fleetCmd.OperationProfileLoader) *fleetCmd.LaunchOperationProfileHandler {
+	return fleetCmd.NewLaunchOperationProfileHandler(profiles, &operationProfileLauncher{server: server})
+}
+
+// operationProfileLauncher starts profiles through launchableOperations.
+type operationProfileLauncher struct {
+	server *DaemonServer
+}
+
+// ValidateOperation checks the profile against its operation's params and returns one target
+// per ship for per-ship operations, or the profile's label for the rest. A standing
+// coordinator finds its own hulls, so its profile must list none.
+func (l *operationProfileLauncher) ValidateOperation(profile *container.OperationProfile) ([]string, error) {
+	entry, ok := launchableOperations[profile.Operation]
+	if !ok {
+		return nil, fmt.Errorf("unknown operation %q (known: %s)", profile.Operation, strings.Join(launchableOperationNames(), ", "))
+	}
+	switch {
+	case entry.ships == launchNoShips && len(profile.Ships) > 0:
+		return nil, fmt.Errorf("%s finds its own hulls and takes no ships", profile.Operation)
+	case entry.ships != launchNoShips && len(profile.Ships) == 0:
+		return nil, fmt.Errorf("%s needs at least one ship", profile.Operation)
+	}
+	if err := checkProfileParams(profile.Params, entry.params); err != nil {
+		return nil, err
+	}
+	if entry.ships == launchPerShip {
+		return append([]string(nil), profile.Ships...), nil
+	}
+	return []string{profile.Label()}, nil
+}
+
+// LaunchOperation starts one target and stamps the profile on every container it started. A
+// standing coordinator already running for the player is refused, not launched twice.
+func (l *operationProfileLauncher) LaunchOperation(ctx context.Context, playerID int, agentSymbol string, profile *container.OperationProfile, target string) ([]string, error) {
+	entry, ok := launchableOperations[profile.Operation]
+	if !ok {
+		return nil, fmt.Errorf("unknown operation %q", profile.Operation)
+	}
+	ships := profile.Ships
+	if entry.ships == launchPerShip {
+		ships = []string{target}
+	}
+	if entry.containerType != "" {
+		existingID, err := firstContainerIDOfType(ctx, l.server.containerRepo, playerID, entry.containerType)
+		if err != nil {
+			return nil, fmt.Errorf("failed to check for a running %s: %w", profile.Operation, err)
+		}
+		if existingID != "" {
+			return nil, fmt.Errorf("%s already running (container %s)", profile.Operation, existingID)
+		}
+	}
+
+	ids, err := entry.launch(l.server, ctx, playerID, agentSymbol, ships, newConfigReader(profile.Params))
+	if err != nil {
+		return nil, err
+	}
+	for _, id := range ids {
+		if err := l.server.mergeContainerConfig(ctx, id, playerID, profile.ConfigStamp()); err != nil {
+			return ids, fmt.Errorf("failed to record profile %s on %s: %w", profile.Label(), id, err)
+		}
+	}
+	return ids, nil
+}
+
+// LaunchOperationProfile launches an operation profile for the player. profile is a profile
+// name in the operation profiles directory or a path to a YAML file. dryRun validates the
+// profile and reports the planned launches without starting anything.
+func (s *DaemonServer) LaunchOperationProfile(ctx context.Context, playerID int, agentSymbol, profile string, dryRun bool) (*fleetCmd.LaunchOperationProfileResponse, error) {
+	if agentSymbol == "" {
+		agentSymbol = s.agentSymbolForPlayer(ctx, playerID)
+	}
+	resp, err := s.mediator.Send(ctx, &fleetCmd.LaunchOperationProfileCommand{
+		PlayerID:    playerID,
+		AgentSymbol: agentSymbol,
+		Profile:     profile,
+		DryRun:      dryRun,
+	})
+	if err != nil {
+		return nil, err
+	}
+	out, ok := resp.(*fleetCmd.LaunchOperationProfileResponse)
+	if !ok {
+		return nil, fmt.Errorf("unexpected response type %T", resp)
+	}
+	return out, nil
+}
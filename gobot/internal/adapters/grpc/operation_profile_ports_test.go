@@ -0,0 +1,65 @@
+package grpc
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/andrescamacho/spacetraders-go/internal/domain/container"
+)
+
+// A profile is checked against its operation's params: unknown, missing and mistyped values
+// are all reported at once; a fitting profile yields one target per ship.
+func TestOperationProfileLauncher_ValidateOperation(t *testing.T) {
+	l := &operationProfileLauncher{}
+	profile := &container.OperationProfile{
+		Name:      "arb-x1",
+		Version:   2,
+		Operation: "arb_run",
+		Ships:     []string{"H-1", "H-2"},
+		Params: map[string]interface{}{
+			"good":       "IRON_ORE",
+			"buy_at":     "X1-AB-A1",
+			"min_margin": 8.5,
+			"max_unit":   40,
+		},
+	}
+	_, err := l.ValidateOperation(profile)
+	if err == nil {
+		t.Fatal("expected a validation error")
+	}
+	for _, want := range []string{"max_unit (unknown)", "min_margin (want an integer)", "sell_at (required)"} {
+		if !strings.Contains(err.Error(), want) {
+			t.Errorf("error %q does not mention %q", err, want)
+		}
+	}
+
+	profile.Params = map[string]interface{}{"good": "IRON_ORE", "buy_at": "X1-AB-A1", "sell_at": "X1-AB-B2", "min_margin": 8}
+	targets, err := l.ValidateOperation(profile)
+	if err != nil {
+		t.Fatalf("ValidateOperation: %v", err)
+	}
+	if len(targets) != 2 || targets[0] != "H-1" {
+		t.Fatalf("targets = %v, want one per ship", targets)
+	}
+
+	profile.Operation = "scout_markets"
+	profile.Params = map[string]interface{}{"system": "X1-AB", "markets": []interface{}{"X1-AB-A1"}}
+	if targets, err = l.ValidateOperation(profile); err != nil || len(targets) != 1 || targets[0] != "arb-x1@v2" {
+		t.Fatalf("scout_markets targets = %v err=%v, want the profile as one target", targets, err)
+	}
+
+	profile.Operation = "siting_coordinator"
+	profile.Params = nil
+	if _, err := l.ValidateOperation(profile); err == nil {
+		t.Fatal("a standing coordinator finds its own hulls; a ship list must be rejected")
+	}
+	profile.Ships = nil
+	if targets, err = l.ValidateOperation(profile); err != nil || len(targets) != 1 {
+		t.Fatalf("siting_coordinator targets = %v err=%v, want the profile as one target", targets, err)
+	}
+
+	profile.Operation = "warp_drive"
+	if _, err := l.ValidateOperation(profile); err == nil {
+		t.Fatal("an unknown operation must be rejected")
+	}
+}
@@ -0,0 +1,101 @@
+package grpc
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+
+	"github.com/andrescamacho/spacetraders-go/internal/adapters/persistence"
+)
+
+// registerDependentSpec adds a test container type that depends on
+// scout_post_coordinator and builds the same command, so it recovers live in the
+// recovery harness.
+func registerDependentSpec(s *DaemonServer) {
+	s.containerSpecs["dependent_coordinator"] = ContainerSpec{
+		CommandType: "dependent_coordinator",
+		DependsOn:   []string{"scout_post_coordinator"},
+		build:       buildScoutPostCoordinatorCommand,
+	}
+}
+
+func requireEventualStatus(t *testing.T, db *gorm.DB, id, wantStatus string) {
+	t.Helper()
+	require.Eventually(t, func() bool {
+		var model persistence.ContainerModel
+		return db.First(&model, "id = ?", id).Error == nil && model.Status == wantStatus
+	}, 2*time.Second, 10*time.Millisecond, "container %s never reached %s", id, wantStatus)
+}
+
+// A dependent whose dependency fails recovery is parked BLOCKED rather than
+// started, and only the failed dependency is announced lost.
+func TestRecoveryBlocksDependentWhenDependencyFails(t *testing.T) {
+	rec := &syncRecorder{}
+	SetCaptainEventRecorder(rec)
+	defer SetCaptainEventRecorder(nil)
+
+	s, db, playerID := newRecoveryTestServer(t)
+	registerDependentSpec(s)
+	// Inserted first so the pass has to reorder it behind its dependency.
+	insertRunningContainer(t, db, "dependent-1", "dependent_coordinator", "SCOUT_POST_COORDINATOR",
+		`{"container_id":"dependent-1","tick_interval_secs":30}`, playerID, nil)
+	// Fails recovery: the stub ship repo cannot load SHIP-FAIL.
+	insertRunningContainer(t, db, "scoutpost-1", "scout_post_coordinator", "SCOUT_POST_COORDINATOR",
+		`{"container_id":"scoutpost-1","ship_symbol":"SHIP-FAIL"}`, playerID, nil)
+
+	require.NoError(t, s.RecoverRunningContainers(context.Background()))
+
+	requireContainerState(t, db, "scoutpost-1", "FAILED", "recovery_failed")
+	requireContainerState(t, db, "dependent-1", "BLOCKED", "dependency_unavailable: waiting on [scout_post_coordinator]")
+	require.Nil(t, s.registeredRunner("dependent-1"))
+
+	lost := rec.lost()
+	require.Len(t, lost, 1, "the blocked dependent is not a second loss")
+	require.Equal(t, "scoutpost-1", lost[0].Ship)
+}
+
+// A BLOCKED container is started again once its dependency recovers.
+func TestRecoveryResumesBlockedDependent(t *testing.T) {
+	s, db, playerID := newRecoveryTestServer(t)
+	registerDependentSpec(s)
+	insertRunningContainer(t, db, "dependent-1", "dependent_coordinator", "SCOUT_POST_COORDINATOR",
+		`{"container_id":"dependent-1","tick_interval_secs":30}`, playerID, nil)
+	require.NoError(t, db.Model(&persistence.ContainerModel{}).Where("id = ?", "dependent-1").
+		Update("status", "BLOCKED").Error)
+	insertRunningContainer(t, db, "scoutpost-1", "scout_post_coordinator", "SCOUT_POST_COORDINATOR",
+		`{"container_id":"scoutpost-1","tick_interval_secs":30}`, playerID, nil)
+
+	require.NoError(t, s.RecoverRunningContainers(context.Background()))
+
+	requireEventualStatus(t, db, "dependent-1", "RUNNING")
+	for _, id := range []string{"scoutpost-1", "dependent-1"} {
+		r := s.registeredRunner(id)
+		require.NotNil(t, r, "%s should be running", id)
+		r.cancelFunc()
+	}
+}
+
+// A dependency failing at runtime blocks its running dependents.
+func TestDependencyFailureBlocksRunningDependent(t *testing.T) {
+	s, db, playerID := newRecoveryTestServer(t)
+	registerDependentSpec(s)
+	insertRunningContainer(t, db, "scoutpost-1", "scout_post_coordinator", "SCOUT_POST_COORDINATOR",
+		`{"container_id":"scoutpost-1","tick_interval_secs":30}`, playerID, nil)
+	insertRunningContainer(t, db, "dependent-1", "dependent_coordinator", "SCOUT_POST_COORDINATOR",
+		`{"container_id":"dependent-1","tick_interval_secs":30}`, playerID, nil)
+	require.NoError(t, s.RecoverRunningContainers(context.Background()))
+	require.Eventually(t, func() bool {
+		r := s.registeredRunner("dependent-1")
+		return r != nil && r.Container().IsRunning()
+	}, 2*time.Second, 10*time.Millisecond)
+
+	provider := s.registeredRunner("scoutpost-1")
+	provider.cancelFunc()
+	provider.persistFailed("boom")
+
+	requireEventualStatus(t, db, "dependent-1", "BLOCKED")
+	requireContainerState(t, db, "dependent-1", "BLOCKED", "dependency_failed: waiting on [scout_post_coordinator] after scoutpost-1 failed")
+}
@@ -0,0 +1,335 @@
+package grpc
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/andrescamacho/spacetraders-go/internal/domain/daemon"
+	"github.com/andrescamacho/spacetraders-go/internal/domain/shared"
+	"github.com/andrescamacho/spacetraders-go/internal/infrastructure/config"
+	"github.com/andrescamacho/spacetraders-go/internal/infrastructure/supervise"
+)
+
+// jobScheduler holds the recurring job scheduler's state; set by SetScheduler.
+type jobScheduler struct {
+	repo          daemon.ScheduledJobRepository
+	checkInterval time.Duration
+	// seeds are the config-defined jobs upserted for the live player at start
+	seeds []config.ScheduledJobConfig
+	// reconciler runs LEDGER_RECONCILE jobs
+	reconciler CreditReconcilerRunner
+
+	// running holds the jobs with a run in flight in this process, keyed by
+	// player and name, so a slow run is never started twice.
+	mu      sync.Mutex
+	running map[string]bool
+}
+
+// SetScheduler arms the recurring job scheduler: Start seeds the config jobs
+// and launches a loop that runs due jobs every checkInterval. Must be called
+// before Start; leaving it unset keeps the scheduler, and its RPCs, off.
+func (s *DaemonServer) SetScheduler(repo daemon.ScheduledJobRepository, checkInterval time.Duration, seeds []config.ScheduledJobConfig, reconciler CreditReconcilerRunner) {
+	if repo == nil || checkInterval <= 0 {
+		return
+	}
+	s.scheduler = &jobScheduler{
+		repo:          repo,
+		checkInterval: checkInterval,
+		seeds:         seeds,
+		reconciler:    reconciler,
+		running:       make(map[string]bool),
+	}
+}
+
+// runScheduler seeds the config jobs, then runs due jobs every check interval
+// until ctx is canceled. Each tick runs under supervise.Guard so one bad job
+// cannot kill the loop.
+func (s *DaemonServer) runScheduler(ctx context.Context) error {
+	supervise.Guard("scheduler-seed", func() {
+		s.seedScheduledJobs(ctx)
+	})
+
+	ticker := time.NewTicker(s.scheduler.checkInterval)
+	defer ticker.Stop()
+	for {
+		supervise.Guard("scheduler", func() {
+			if playerID := s.primaryPlayerID(ctx); playerID != 0 {
+				s.runDueJobs(ctx, playerID, time.Now())
+			}
+		})
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
+
+// seedScheduledJobs upserts the config-defined jobs for the live player. A bad
+// definition is logged and skipped rather than blocking the others.
+func (s *DaemonServer) seedScheduledJobs(ctx context.Context) {
+	if len(s.scheduler.seeds) == 0 {
+		return
+	}
+	playerID := s.primaryPlayerID(ctx)
+	if playerID == 0 {
+		log.Printf("Scheduler: no player to seed %d configured job(s) for", len(s.scheduler.seeds))
+		return
+	}
+	for _, seed := range s.scheduler.seeds {
+		kind, err := daemon.ParseScheduledJobKind(seed.Kind)
+		if err == nil {
+			_, err = s.AddScheduledJob(ctx, playerID, seed.Name, kind, seed.Interval(), seed.Params, !seed.Disabled)
+		}
+		if err != nil {
+			log.Printf("Scheduler: skipping configured job %q: %v", seed.Name, err)
+		}
+	}
+}
+
+// runDueJobs starts every due job of the player that is not still running
+func (s *DaemonServer) runDueJobs(ctx context.Context, playerID int, now time.Time) {
+	jobs, err := s.scheduler.repo.List(ctx, playerID)
+	if err != nil {
+		log.Printf("Scheduler: %v", err)
+		return
+	}
+	for _, job := range jobs {
+		if !job.IsDue(now) {
+			continue
+		}
+		if s.scheduledJobBusy(job) {
+			log.Printf("Scheduler: %s is still running from %s, skipping this run", job.Name, formatJobTime(job.LastRunAt))
+			job.RecordSkip(now)
+			if err := s.scheduler.repo.Save(ctx, job); err != nil {
+				log.Printf("Scheduler: %v", err)
+			}
+			continue
+		}
+		s.startScheduledJob(ctx, job, now)
+	}
+}
+
+// scheduledJobBusy reports whether the job's previous run is still going:
+// either in flight in this process or, for jobs that launch containers, with
+// any of those containers not yet finished.
+func (s *DaemonServer) scheduledJobBusy(job *daemon.ScheduledJob) bool {
+	s.scheduler.mu.Lock()
+	inFlight := s.scheduler.running[scheduledJobKey(job)]
+	s.scheduler.mu.Unlock()
+	if inFlight {
+		return true
+	}
+	for _, containerID := range job.LastContainerIDs {
+		if cont, err := s.GetContainer(containerID); err == nil && !cont.IsFinished() {
+			return true
+		}
+	}
+	return false
+}
+
+// startScheduledJob runs the job in the background and persists its outcome
+func (s *DaemonServer) startScheduledJob(ctx context.Context, job *daemon.ScheduledJob, now time.Time) {
+	job.MarkStarted(now)
+	if err := s.scheduler.repo.Save(ctx, job); err != nil {
+		log.Printf("Scheduler: not starting %s: %v", job.Name, err)
+		return
+	}
+
+	key := scheduledJobKey(job)
+	s.scheduler.mu.Lock()
+	s.scheduler.running[key] = true
+	s.scheduler.mu.Unlock()
+
+	go func() {
+		defer func() {
+			s.scheduler.mu.Lock()
+			delete(s.scheduler.running, key)
+			s.scheduler.mu.Unlock()
+		}()
+		supervise.Guard("scheduler-job", func() {
+			containerIDs, err := s.executeScheduledJob(ctx, job)
+			if err != nil {
+				log.Printf("Scheduler: %s (%s) failed: %v", job.Name, job.Kind, err)
+			} else {
+				log.Printf("Scheduler: %s (%s) completed", job.Name, job.Kind)
+			}
+			s.recordScheduledRun(ctx, job, now, containerIDs, err)
+		})
+	}()
+}
+
+// recordScheduledRun saves a run's outcome onto the job's current row, so a
+// job redefined while it ran keeps its new definition and a removed one stays
+// removed.
+func (s *DaemonServer) recordScheduledRun(ctx context.Context, job *daemon.ScheduledJob, startedAt time.Time, containerIDs []string, runErr error) {
+	current, err := s.scheduler.repo.FindByName(ctx, job.PlayerID, job.Name)
+	if err != nil {
+		log.Printf("Scheduler: %v", err)
+		return
+	}
+	if current == nil {
+		return
+	}
+	current.RecordRun(startedAt, containerIDs, runErr)
+	if err := s.scheduler.repo.Save(ctx, current); err != nil {
+		log.Printf("Scheduler: %v", err)
+	}
+}
+
+// executeScheduledJob runs one job, returning the containers it launched
+func (s *DaemonServer) executeScheduledJob(ctx context.Context, job *daemon.ScheduledJob) ([]string, error) {
+	switch job.Kind {
+	case daemon.ScheduledJobScoutMarkets:
+		iterations, err := intParam(job.Params, "iterations", 1)
+		if err != nil {
+			return nil, err
+		}
+		containerIDs, _, _, err := s.ScoutMarkets(ctx, listParam(job.Params, "ships"), job.Params["system"],
+			listParam(job.Params, "markets"), iterations, job.PlayerID)
+		return containerIDs, err
+
+	case daemon.ScheduledJobLedgerReconcile:
+		if s.scheduler.reconciler == nil {
+			return nil, fmt.Errorf("no credit reconciler is configured")
+		}
+		playerID, err := shared.NewPlayerID(job.PlayerID)
+		if err != nil {
+			return nil, err
+		}
+		result, err := s.scheduler.reconciler.Reconcile(ctx, playerID)
+		if err != nil {
+			return nil, err
+		}
+		if result.Adjusted {
+			log.Printf("Scheduler: %s booked %+d adjustment (API %d, ledger %d)",
+				job.Name, result.Drift, result.APICredits, result.LedgerBalance)
+		}
+		return nil, nil
+
+	case daemon.ScheduledJobMarketExport:
+		historyHours, err := intParam(job.Params, "history_hours", 0)
+		if err != nil {
+			return nil, err
+		}
+		format := job.Params["format"]
+		if format == "" {
+			format = "csv"
+		}
+		export, err := s.ExportMarketData(ctx, job.PlayerID, job.Params["system"], format, time.Duration(historyHours)*time.Hour)
+		if err != nil {
+			return nil, err
+		}
+		if err := writeFileAtomically(job.Params["path"], export.Content); err != nil {
+			return nil, err
+		}
+		return nil, nil
+
+	default:
+		return nil, fmt.Errorf("unknown job kind %q", job.Kind)
+	}
+}
+
+// AddScheduledJob defines a job, or redefines the one with the same name while
+// keeping its run history.
+func (s *DaemonServer) AddScheduledJob(ctx context.Context, playerID int, name string, kind daemon.ScheduledJobKind, interval time.Duration, params map[string]string, enabled bool) (*daemon.ScheduledJob, error) {
+	if s.scheduler == nil {
+		return nil, fmt.Errorf("the scheduler is not enabled (set scheduler.enabled in config.yaml)")
+	}
+	job, err := daemon.NewScheduledJob(playerID, name, kind, interval, params, time.Now())
+	if err != nil {
+		return nil, err
+	}
+	job.Enabled = enabled
+
+	existing, err := s.scheduler.repo.FindByName(ctx, playerID, job.Name)
+	if err != nil {
+		return nil, err
+	}
+	if existing != nil {
+		existing.Redefine(job)
+		job = existing
+	}
+	if err := s.scheduler.repo.Save(ctx, job); err != nil {
+		return nil, err
+	}
+	return job, nil
+}
+
+// ListScheduledJobs returns the player's jobs in name order
+func (s *DaemonServer) ListScheduledJobs(ctx context.Context, playerID int) ([]*daemon.ScheduledJob, error) {
+	if s.scheduler == nil {
+		return nil, fmt.Errorf("the scheduler is not enabled (set scheduler.enabled in config.yaml)")
+	}
+	return s.scheduler.repo.List(ctx, playerID)
+}
+
+// RemoveScheduledJob deletes a job. A run in flight finishes, but its outcome
+// is dropped.
+func (s *DaemonServer) RemoveScheduledJob(ctx context.Context, playerID int, name string) error {
+	if s.scheduler == nil {
+		return fmt.Errorf("the scheduler is not enabled (set scheduler.enabled in config.yaml)")
+	}
+	removed, err := s.scheduler.repo.Delete(ctx, playerID, name)
+	if err != nil {
+		return err
+	}
+	if !removed {
+		return fmt.Errorf("no scheduled job named %q", name)
+	}
+	return nil
+}
+
+func scheduledJobKey(job *daemon.ScheduledJob) string {
+	return strconv.Itoa(job.PlayerID) + "/" + job.Name
+}
+
+func formatJobTime(t *time.Time) string {
+	if t == nil {
+		return "never"
+	}
+	return t.Format(time.RFC3339)
+}
+
+// listParam splits a comma-separated param, dropping blanks
+func listParam(params map[string]string, key string) []string {
+	var values []string
+	for _, value := range strings.Split(params[key], ",") {
+		if value = strings.TrimSpace(value); value != "" {
+			values = append(values, value)
+		}
+	}
+	return values
+}
+
+// intParam parses an integer param, returning def when it is unset
+func intParam(params map[string]string, key string, def int) (int, error) {
+	raw := strings.TrimSpace(params[key])
+	if raw == "" {
+		return def, nil
+	}
+	value, err := strconv.Atoi(raw)
+	if err != nil || value < 0 {
+		return 0, fmt.Errorf("param %s=%q is not a non-negative integer", key, raw)
+	}
+	return value, nil
+}
+
+// writeFileAtomically writes content beside path and renames it into place, so
+// a reader never sees a half-written export.
+func writeFileAtomically(path string, content []byte) error {
+	tmp := path + ".tmp"
+	if err := os.WriteFile(tmp, content, 0o644); err != nil {
+		return fmt.Errorf("failed to write %s: %w", tmp, err)
+	}
+	if err := os.Rename(tmp, path); err != nil {
+		return fmt.Errorf("failed to move export into %s: %w", path, err)
+	}
+	return nil
+}
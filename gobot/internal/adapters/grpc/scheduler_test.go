@@ -0,0 +1,71 @@
+package grpc
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/andrescamacho/spacetraders-go/internal/adapters/persistence"
+	ledgerServices "github.com/andrescamacho/spacetraders-go/internal/application/ledger/services"
+	"github.com/andrescamacho/spacetraders-go/internal/domain/daemon"
+	"github.com/andrescamacho/spacetraders-go/internal/domain/shared"
+	"github.com/andrescamacho/spacetraders-go/internal/infrastructure/database"
+)
+
+// blockingReconciler holds each Reconcile until release is closed
+type blockingReconciler struct {
+	started chan struct{}
+	release chan struct{}
+}
+
+func (r *blockingReconciler) Reconcile(ctx context.Context, _ shared.PlayerID) (ledgerServices.CreditReconciliation, error) {
+	r.started <- struct{}{}
+	<-r.release
+	return ledgerServices.CreditReconciliation{}, nil
+}
+
+// A run still in flight when its job comes due again is skipped, and the
+// finished run's outcome is recorded on the job.
+func TestScheduler_SkipsOverlappingRunAndRecordsOutcome(t *testing.T) {
+	db, err := database.NewTestConnection()
+	require.NoError(t, err)
+	repo := persistence.NewScheduledJobRepository(db)
+	reconciler := &blockingReconciler{started: make(chan struct{}, 1), release: make(chan struct{})}
+
+	s := &DaemonServer{containers: map[string]*ContainerRunner{}}
+	s.SetScheduler(repo, time.Minute, nil, reconciler)
+	ctx := context.Background()
+
+	_, err = s.AddScheduledJob(ctx, 1, "nightly", daemon.ScheduledJobLedgerReconcile, time.Hour, nil, true)
+	require.NoError(t, err)
+
+	now := time.Now()
+	s.runDueJobs(ctx, 1, now)
+	<-reconciler.started
+
+	// Not due again until the next interval, then found still running.
+	s.runDueJobs(ctx, 1, now.Add(time.Minute))
+	s.runDueJobs(ctx, 1, now.Add(time.Hour+time.Minute))
+	job, err := repo.FindByName(ctx, 1, "nightly")
+	require.NoError(t, err)
+	require.Equal(t, daemon.ScheduledJobStatusSkipped, job.LastStatus)
+
+	close(reconciler.release)
+	require.Eventually(t, func() bool {
+		job, err := repo.FindByName(ctx, 1, "nightly")
+		return err == nil && job.LastStatus == daemon.ScheduledJobStatusSucceeded
+	}, 5*time.Second, 10*time.Millisecond)
+
+	job, err = repo.FindByName(ctx, 1, "nightly")
+	require.NoError(t, err)
+	require.NotNil(t, job.LastRunAt)
+	require.True(t, job.NextRunAt.After(now.Add(time.Hour+time.Minute)))
+}
+
+func TestScheduler_RPCsRequireEnabledScheduler(t *testing.T) {
+	s := &DaemonServer{}
+	_, err := s.ListScheduledJobs(context.Background(), 1)
+	require.ErrorContains(t, err, "not enabled")
+}
@@ -0,0 +1,103 @@
+package grpc
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/andrescamacho/spacetraders-go/internal/adapters/persistence"
+	"github.com/andrescamacho/spacetraders-go/internal/domain/container"
+)
+
+// SetShipAssignmentManager wires the manager that holds the daemon's ship
+// leases. Must be called before Start: boot rehydrates it before containers
+// are recovered. Leaving it unset keeps assignments on the ship aggregate
+// alone, with nothing released at boot.
+func (s *DaemonServer) SetShipAssignmentManager(manager *container.ShipAssignmentManager) {
+	s.shipLeases = manager
+}
+
+// runLeaseExpiry releases expired ship leases every half lease TTL until ctx
+// is canceled. A container renews its lease from its heartbeat, so a lease
+// only expires when the container has stopped beating.
+func (s *DaemonServer) runLeaseExpiry(ctx context.Context) error {
+	ticker := time.NewTicker(container.DefaultLeaseTTL / 2)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+			expired, err := s.shipLeases.ExpireLeases(ctx)
+			if err != nil {
+				log.Printf("Ship lease expiry failed: %v", err)
+			}
+			if expired > 0 {
+				log.Printf("Ship lease expiry: released %d hull(s) whose container stopped renewing", expired)
+			}
+		}
+	}
+}
+
+// rehydrateShipAssignments restores the leases of playerID's containers that
+// recovery is about to resume and releases the rest as daemon_restart. Worker
+// containers are respawned by their coordinators rather than resumed, so
+// their hulls are released for the coordinators to claim again.
+func (s *DaemonServer) rehydrateShipAssignments(ctx context.Context, playerID int) error {
+	resumable, err := s.resumableContainerIDs(ctx, playerID)
+	if err != nil {
+		return err
+	}
+	restored, released, err := s.shipLeases.Rehydrate(ctx, playerID, resumable)
+	if err != nil {
+		return err
+	}
+	if restored > 0 || released > 0 {
+		fmt.Printf("Ship assignments on daemon startup: %d restored, %d released\n", restored, released)
+	}
+	return nil
+}
+
+// resumableContainerIDs returns playerID's containers that RecoverRunningContainers
+// will try to resume: the INTERRUPTED, RUNNING and BLOCKED ones that are not
+// coordinator-managed workers.
+func (s *DaemonServer) resumableContainerIDs(ctx context.Context, playerID int) (map[string]bool, error) {
+	resumable := make(map[string]bool)
+	for _, status := range []container.ContainerStatus{
+		container.ContainerStatusInterrupted,
+		container.ContainerStatusRunning,
+		container.ContainerStatusBlocked,
+	} {
+		models, err := s.containerRepo.ListByStatus(ctx, status, &playerID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list %s containers: %w", status, err)
+		}
+		for _, m := range models {
+			if s.resumedByRecovery(m) {
+				resumable[m.ID] = true
+			}
+		}
+	}
+	return resumable, nil
+}
+
+// resumedByRecovery reports whether RecoverRunningContainers resumes a
+// container itself rather than leaving it to its coordinator, by the same
+// three worker tests it applies. A config that does not parse fails recovery,
+// so it is not resumed either.
+func (s *DaemonServer) resumedByRecovery(m *persistence.ContainerModel) bool {
+	var config map[string]interface{}
+	if err := json.Unmarshal([]byte(m.Config), &config); err != nil {
+		return false
+	}
+	if coordinatorID, _ := config["coordinator_id"].(string); coordinatorID != "" {
+		return false
+	}
+	if m.ParentContainerID != nil && *m.ParentContainerID != "" {
+		return false
+	}
+	spec, hasSpec := s.containerSpecs[m.CommandType]
+	return !hasSpec || !spec.IsWorker
+}
@@ -0,0 +1,122 @@
+package grpc
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+
+	"github.com/andrescamacho/spacetraders-go/internal/adapters/persistence"
+	"github.com/andrescamacho/spacetraders-go/internal/domain/container"
+	"github.com/andrescamacho/spacetraders-go/internal/domain/navigation"
+)
+
+func insertAssignedShip(t *testing.T, db *gorm.DB, symbol string, playerID int, containerID string) {
+	t.Helper()
+	require.NoError(t, db.Create(&persistence.ShipModel{
+		ShipSymbol: symbol, PlayerID: playerID, Role: "HAULER",
+		ContainerID: &containerID, AssignmentStatus: "active", SyncedAt: time.Now(),
+	}).Error)
+}
+
+// Boot keeps the hulls of containers recovery will resume and releases those
+// of containers that died with the daemon and of coordinator-managed workers.
+func TestRehydrateShipAssignments_KeepsResumableContainersOnly(t *testing.T) {
+	s, db, playerID := newRecoveryTestServer(t)
+	ctx := context.Background()
+
+	insertRunningContainer(t, db, "trade-1", "trade_route", "trade_route", `{"ship_symbol":"SHIP-1"}`, playerID, nil)
+	insertRunningContainer(t, db, "worker-1", "contract_workflow", "contract_workflow", `{"ship_symbol":"SHIP-2","coordinator_id":"coord-1"}`, playerID, nil)
+	insertRunningContainer(t, db, "done-1", "trade_route", "trade_route", `{"ship_symbol":"SHIP-3"}`, playerID, nil)
+	require.NoError(t, db.Model(&persistence.ContainerModel{}).Where("id = ?", "done-1").Update("status", "STOPPED").Error)
+	insertAssignedShip(t, db, "SHIP-1", playerID, "trade-1")
+	insertAssignedShip(t, db, "SHIP-2", playerID, "worker-1")
+	insertAssignedShip(t, db, "SHIP-3", playerID, "done-1")
+
+	leases := container.NewShipAssignmentManager(nil)
+	leases.SetRepository(persistence.NewShipAssignmentRepository(db))
+	s.SetShipAssignmentManager(leases)
+
+	require.NoError(t, s.rehydrateShipAssignments(ctx, playerID))
+
+	held, ok := leases.GetAssignment("SHIP-1")
+	require.True(t, ok)
+	require.Equal(t, "trade-1", held.ContainerID())
+
+	for _, symbol := range []string{"SHIP-2", "SHIP-3"} {
+		var ship persistence.ShipModel
+		require.NoError(t, db.Where("ship_symbol = ?", symbol).First(&ship).Error)
+		require.Equal(t, "idle", ship.AssignmentStatus, symbol)
+		require.Equal(t, container.ReleaseReasonDaemonRestart, ship.ReleaseReason, symbol)
+	}
+}
+
+// A runner leases the hull it claims and gives the lease up with the hull
+// when it stops.
+func TestContainerRunner_LeasesClaimedShip(t *testing.T) {
+	s, _, playerID := newRecoveryTestServer(t)
+
+	ship := newIdleTradeShip(t, "SHIP-LEASE", playerID)
+	repo := releasingShipRepo{&tradeRouteShipRepo{ships: map[string]*navigation.Ship{"SHIP-LEASE": ship}}}
+
+	const containerID = "goods_factory-LEASE"
+	entity := container.NewContainer(containerID, container.ContainerType("goods_factory_coordinator"), playerID, -1, nil,
+		map[string]interface{}{"ship_symbol": "SHIP-LEASE"}, nil)
+	require.NoError(t, s.containerRepo.Add(context.Background(), entity, "goods_factory_coordinator"))
+
+	leases := container.NewShipAssignmentManager(nil)
+	med := &ctxEnteredBlockingMediator{entered: make(chan struct{})}
+	runner := NewContainerRunner(entity, med, nil, noopLogRepo{}, s.containerRepo, repo, s.clock)
+	runner.SetShipLeases(leases)
+
+	require.NoError(t, runner.Start())
+	<-med.entered
+
+	held, ok := leases.GetAssignment("SHIP-LEASE")
+	require.True(t, ok)
+	require.True(t, held.IsActive())
+	require.Equal(t, containerID, held.ContainerID())
+
+	require.NoError(t, runner.Stop())
+	require.Eventually(t, func() bool {
+		_, err := leases.AssignShip(context.Background(), "SHIP-LEASE", playerID, "goods_factory-NEXT")
+		return err == nil
+	}, 5*time.Second, 10*time.Millisecond)
+}
+
+// The stranded-ship check feeds the health monitor the live containers and
+// their leases; a lease whose container is no longer registered is released
+// through the manager, in the database too, before the check sees it.
+func TestLiveShipAssignments_ReleasesLeasesOfStoppedContainers(t *testing.T) {
+	s, db, playerID := newRecoveryTestServer(t)
+	ctx := context.Background()
+
+	insertRunningContainer(t, db, "trade-1", "trade_route", "trade_route", `{"ship_symbol":"SHIP-1"}`, playerID, nil)
+	insertRunningContainer(t, db, "trade-2", "trade_route", "trade_route", `{"ship_symbol":"SHIP-2"}`, playerID, nil)
+	insertAssignedShip(t, db, "SHIP-1", playerID, "trade-1")
+	insertAssignedShip(t, db, "SHIP-2", playerID, "trade-2")
+
+	leases := container.NewShipAssignmentManager(nil)
+	leases.SetRepository(persistence.NewShipAssignmentRepository(db))
+	s.SetShipAssignmentManager(leases)
+	require.NoError(t, s.rehydrateShipAssignments(ctx, playerID))
+
+	live := container.NewContainer("trade-1", container.ContainerType("trade_route"), playerID, -1, nil,
+		map[string]interface{}{"ship_symbol": "SHIP-1"}, nil)
+	s.containersMu.Lock()
+	s.containers["trade-1"] = NewContainerRunner(live, nil, nil, noopLogRepo{}, s.containerRepo, nil, s.clock)
+	s.containersMu.Unlock()
+
+	containers := s.liveContainers()
+	require.Len(t, containers, 1)
+	assignments, err := s.liveShipAssignments(ctx, playerID, containers)
+	require.NoError(t, err)
+	require.Len(t, assignments, 1)
+	require.Equal(t, "trade-1", assignments["SHIP-1"].ContainerID())
+
+	var ship persistence.ShipModel
+	require.NoError(t, db.Where("ship_symbol = ?", "SHIP-2").First(&ship).Error)
+	require.Equal(t, "idle", ship.AssignmentStatus)
+}
@@ -39,7 +39,8 @@ type ShipStateScheduler struct {
 	eventPublisher navigation.ShipEventPublisher
 	timers         map[string]*time.Timer // key: shipSymbol or shipSymbol:cooldown
 	mu             sync.Mutex
-	stopCh         chan struct{} // signals sweeper goroutine to stop
+	arrivalWatcher *ArrivalWatcher // when set, arrivals are confirmed by it instead of local timers
+	stopCh         chan struct{}   // signals sweeper goroutine to stop
 }
 
 // NewShipStateScheduler creates a new scheduler for ship state transitions.
@@ -57,12 +58,29 @@ func NewShipStateScheduler(shipRepo navigation.ShipRepository, clock shared.Cloc
 	}
 }
 
+// SetArrivalWatcher hands arrivals to watcher: ScheduleArrival forwards to it
+// rather than arming a local timer, so each arrival is confirmed with a nav
+// read before the ship is transitioned. Call before ScheduleAllPending.
+func (s *ShipStateScheduler) SetArrivalWatcher(watcher *ArrivalWatcher) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.arrivalWatcher = watcher
+}
+
 // ScheduleArrival schedules a timer to transition ship from IN_TRANSIT to IN_ORBIT
 func (s *ShipStateScheduler) ScheduleArrival(ship *navigation.Ship) {
 	if ship.ArrivalTime() == nil {
 		return
 	}
 
+	s.mu.Lock()
+	watcher := s.arrivalWatcher
+	s.mu.Unlock()
+	if watcher != nil {
+		watcher.ScheduleArrival(ship)
+		return
+	}
+
 	delay := time.Until(*ship.ArrivalTime())
 	if delay < 0 {
 		delay = 0 // Already past, execute immediately
@@ -0,0 +1,49 @@
+package grpc
+
+import (
+	"context"
+	"time"
+
+	fleetCmd "github.com/andrescamacho/spacetraders-go/internal/application/fleet/commands"
+	"github.com/andrescamacho/spacetraders-go/internal/domain/shared"
+)
+
+// measuredDemandSource is the slice of the fleet autosizer handler the shipyard
+// watch reads demand from.
+type measuredDemandSource interface {
+	MeasuredDemand(playerID int, class fleetCmd.HullClass) (fleetCmd.MeasuredClassDemand, bool)
+}
+
+// ShipyardWatchDemandReader hands the shipyard watch the demand the fleet autosizer
+// measured for a hull class. A class the autosizer has not measured, measured as
+// unreadable, or measured longer than maxAge ago reads as unreadable, so the watch
+// refuses the purchase.
+type ShipyardWatchDemandReader struct {
+	source measuredDemandSource
+	maxAge time.Duration
+	clock  shared.Clock
+}
+
+// NewShipyardWatchDemandReader wires the reader over the autosizer handler. A nil
+// clock defaults to the real clock.
+func NewShipyardWatchDemandReader(source measuredDemandSource, maxAge time.Duration, clock shared.Clock) *ShipyardWatchDemandReader {
+	if clock == nil {
+		clock = shared.NewRealClock()
+	}
+	return &ShipyardWatchDemandReader{source: source, maxAge: maxAge, clock: clock}
+}
+
+// Shortfall implements shipyard commands' ShipyardWatchDemand.
+func (r *ShipyardWatchDemandReader) Shortfall(_ context.Context, playerID shared.PlayerID, class string) (int, bool, error) {
+	if r.source == nil {
+		return 0, false, nil
+	}
+	m, ok := r.source.MeasuredDemand(playerID.Value(), fleetCmd.HullClass(class))
+	if !ok || !m.Demand.Readable {
+		return 0, false, nil
+	}
+	if r.clock.Now().Sub(m.MeasuredAt) > r.maxAge {
+		return 0, false, nil
+	}
+	return m.Demand.Shortfall(), true, nil
+}
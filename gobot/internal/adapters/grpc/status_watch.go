@@ -0,0 +1,132 @@
+package grpc
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/andrescamacho/spacetraders-go/internal/adapters/api"
+	"github.com/andrescamacho/spacetraders-go/internal/adapters/persistence"
+	"github.com/andrescamacho/spacetraders-go/internal/application/serverstatus"
+	"github.com/andrescamacho/spacetraders-go/internal/domain/container"
+	"github.com/andrescamacho/spacetraders-go/internal/infrastructure/supervise"
+)
+
+// SetStatusWatch arms the server status watcher: Start launches a loop that
+// reads the API status at boot and every interval, warns warnWithin ahead of
+// an announced reset, and on a reset stops every running coordinator and
+// flags the cached graphs and markets. Must be called before Start; leaving
+// it unset keeps the watcher off.
+func (s *DaemonServer) SetStatusWatch(status serverStatusReader, interval, warnWithin time.Duration) {
+	if status == nil || interval <= 0 || s.db == nil {
+		return
+	}
+	s.statusWatcher = serverstatus.NewStatusWatcher(
+		&apiStatusReader{api: status},
+		&eraResetBaseline{eras: persistence.NewEraRepository(s.db)},
+		&daemonResetResponder{server: s, caches: persistence.NewResetCacheInvalidator(s.db)},
+		warnWithin,
+	)
+	s.statusWatchInterval = interval
+}
+
+// runStatusWatch checks the status once at boot, so a reset that happened while
+// the daemon was down is caught before coordinators get far, then every
+// interval until ctx is canceled. Each check runs under supervise.Guard.
+func (s *DaemonServer) runStatusWatch(ctx context.Context) error {
+	check := func() {
+		supervise.Guard("status-watch", func() {
+			if _, err := s.statusWatcher.Check(ctx); err != nil {
+				log.Printf("Server status check failed: %v", err)
+			}
+		})
+	}
+	check()
+
+	ticker := time.NewTicker(s.statusWatchInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+			check()
+		}
+	}
+}
+
+// apiStatusReader adapts the API client's status call to the watcher's view.
+type apiStatusReader struct{ api serverStatusReader }
+
+func (r *apiStatusReader) ServerStatus(ctx context.Context) (*serverstatus.ServerStatus, error) {
+	status, err := r.api.GetServerStatus(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return toWatchedStatus(status), nil
+}
+
+func toWatchedStatus(status *api.ServerStatus) *serverstatus.ServerStatus {
+	watched := &serverstatus.ServerStatus{Version: status.Version, ResetDate: status.ResetDate}
+	if next, err := time.Parse(time.RFC3339, status.ServerResets.Next); err == nil {
+		watched.NextReset = next
+	}
+	return watched
+}
+
+// eraResetBaseline reads the open era's resetDate: the universe the stored
+// data was gathered in.
+type eraResetBaseline struct{ eras *persistence.EraRepository }
+
+func (b *eraResetBaseline) KnownResetDate(ctx context.Context) (string, bool, error) {
+	era, err := b.eras.FindOpenEra(ctx)
+	if err != nil || era == nil || era.UniverseResetDate == nil {
+		return "", false, err
+	}
+	return era.UniverseResetDate.Format("2006-01-02"), true, nil
+}
+
+// daemonResetResponder stops the daemon's coordinators and flags its caches
+// when the watcher detects a reset.
+type daemonResetResponder struct {
+	server *DaemonServer
+	caches *persistence.ResetCacheInvalidator
+}
+
+// PauseCoordinators stops every RUNNING or PENDING coordinator container (and,
+// through StopContainer, its workers). Stopped containers are not recovered on
+// restart, so the fleet stays idle until an operator relaunches it for the new
+// universe.
+func (r *daemonResetResponder) PauseCoordinators(ctx context.Context, reason string) (int, error) {
+	live := string(container.ContainerStatusRunning) + "," + string(container.ContainerStatusPending)
+	paused := 0
+	var failed []string
+	for _, cont := range r.server.ListContainers(nil, &live) {
+		if !strings.HasSuffix(string(cont.Type()), "_COORDINATOR") {
+			continue
+		}
+		if err := r.server.StopContainer(cont.ID()); err != nil {
+			failed = append(failed, cont.ID())
+			continue
+		}
+		paused++
+	}
+	log.Printf("Server status: %s; stopped %d coordinator(s)", reason, paused)
+	if len(failed) > 0 {
+		return paused, fmt.Errorf("failed to stop coordinator(s) %s", strings.Join(failed, ", "))
+	}
+	return paused, nil
+}
+
+// InvalidateCaches flags every cached system graph and the live player's
+// market rows.
+func (r *daemonResetResponder) InvalidateCaches(ctx context.Context) error {
+	report, err := r.caches.InvalidateCaches(ctx, r.server.primaryPlayerID(ctx))
+	if err != nil {
+		return err
+	}
+	log.Printf("Server status: invalidated %d system graph(s) and %d market row(s)", report.SystemGraphs, report.MarketRows)
+	return nil
+}
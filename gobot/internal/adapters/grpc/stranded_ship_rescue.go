@@ -0,0 +1,134 @@
+package grpc
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/andrescamacho/spacetraders-go/internal/application/common"
+	shipNav "github.com/andrescamacho/spacetraders-go/internal/application/ship/commands/navigation"
+	"github.com/andrescamacho/spacetraders-go/internal/domain/container"
+	domainDaemon "github.com/andrescamacho/spacetraders-go/internal/domain/daemon"
+	"github.com/andrescamacho/spacetraders-go/internal/domain/navigation"
+	"github.com/andrescamacho/spacetraders-go/internal/domain/shared"
+)
+
+// mediatorStrandedShipRescuer implements the health monitor's rescue port by
+// sending RescueStrandedShipCommand through the mediator.
+type mediatorStrandedShipRescuer struct {
+	mediator common.Mediator
+}
+
+// NewMediatorStrandedShipRescuer adapts the mediator to the health monitor's
+// StrandedShipRescuer port.
+func NewMediatorStrandedShipRescuer(mediator common.Mediator) domainDaemon.StrandedShipRescuer {
+	return &mediatorStrandedShipRescuer{mediator: mediator}
+}
+
+func (r *mediatorStrandedShipRescuer) RescueStrandedShip(ctx context.Context, ship *navigation.Ship) error {
+	_, err := r.mediator.Send(ctx, &shipNav.RescueStrandedShipCommand{
+		ShipSymbol: ship.ShipSymbol(),
+		PlayerID:   ship.PlayerID(),
+	})
+	return err
+}
+
+// SetStrandedShipRescuer arms the stranded-ship rescue: the health monitor
+// gets the rescuer and Start launches the periodic check that feeds it the
+// fleet. Must be called before Start; leaving it unset keeps rescue off.
+func (s *DaemonServer) SetStrandedShipRescuer(rescuer domainDaemon.StrandedShipRescuer) {
+	if s.healthMonitor == nil || rescuer == nil {
+		return
+	}
+	s.healthMonitor.SetStrandedShipRescuer(rescuer)
+	s.strandedRescueEnabled = true
+}
+
+// SetFuelCalibration makes the health monitor's stuck detection estimate
+// arrivals with the calibrated fuel costs. nil keeps the uncorrected formulas.
+func (s *DaemonServer) SetFuelCalibration(calibration navigation.FuelCalibrationSource) {
+	if s.healthMonitor == nil || calibration == nil {
+		return
+	}
+	s.healthMonitor.SetFuelCalibration(calibration)
+}
+
+// runStrandedShipRescue runs a health check over the live player's fleet every
+// check interval until ctx is canceled. A rescue drifts the ship to fuel
+// inside the check, so a tick can take minutes; ticks that fall due meanwhile
+// are dropped rather than queued.
+func (s *DaemonServer) runStrandedShipRescue(ctx context.Context) error {
+	ticker := time.NewTicker(s.healthMonitor.CheckInterval())
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+			if err := s.checkStrandedShips(ctx); err != nil {
+				log.Printf("Stranded ship check failed: %v", err)
+			}
+		}
+	}
+}
+
+func (s *DaemonServer) checkStrandedShips(ctx context.Context) error {
+	pid := s.primaryPlayerID(ctx)
+	if pid == 0 {
+		return nil
+	}
+	playerID, err := shared.NewPlayerID(pid)
+	if err != nil {
+		return fmt.Errorf("resolve primary player id %d: %w", pid, err)
+	}
+
+	ships, err := s.shipRepo.FindAllByPlayer(ctx, playerID)
+	if err != nil {
+		return fmt.Errorf("failed to list ships: %w", err)
+	}
+	bySymbol := make(map[string]*navigation.Ship, len(ships))
+	for _, ship := range ships {
+		bySymbol[ship.ShipSymbol()] = ship
+	}
+
+	containers := s.liveContainers()
+	assignments, err := s.liveShipAssignments(ctx, pid, containers)
+	if err != nil {
+		return err
+	}
+
+	_, err = s.healthMonitor.RunCheck(ctx, assignments, containers, bySymbol)
+	return err
+}
+
+// liveContainers returns the domain containers of the runners registered now.
+func (s *DaemonServer) liveContainers() map[string]*container.Container {
+	s.containersMu.RLock()
+	defer s.containersMu.RUnlock()
+	containers := make(map[string]*container.Container, len(s.containers))
+	for id, runner := range s.containers {
+		containers[id] = runner.Container()
+	}
+	return containers
+}
+
+// liveShipAssignments releases, through the lease manager, the leases held by
+// containers that are no longer registered and returns the rest. The health
+// check only sees copies, so its own stale cleanup finds nothing left to
+// release and the persisted leases never drift from memory.
+func (s *DaemonServer) liveShipAssignments(ctx context.Context, playerID int, containers map[string]*container.Container) (map[string]*container.ShipAssignment, error) {
+	if s.shipLeases == nil {
+		return nil, nil
+	}
+	live := make(map[string]bool, len(containers))
+	for id := range containers {
+		live[id] = true
+	}
+	if cleaned, err := s.shipLeases.CleanOrphanedAssignments(ctx, live); err != nil {
+		return nil, fmt.Errorf("failed to release orphaned ship leases: %w", err)
+	} else if cleaned > 0 {
+		log.Printf("Stranded ship check: released %d lease(s) held by stopped containers", cleaned)
+	}
+	return s.shipLeases.ActiveAssignments(playerID), nil
+}
@@ -0,0 +1,16 @@
+package grpc
+
+import (
+	"time"
+
+	domainDaemon "github.com/andrescamacho/spacetraders-go/internal/domain/daemon"
+)
+
+// SetWaypointBlacklister arms the health monitor's waypoint blacklisting: a
+// waypoint where threshold of a player's route segments fail within half an
+// hour is blacklisted for ttl (0: until cleared). It returns the monitor for
+// route executors to report segment failures to.
+func (s *DaemonServer) SetWaypointBlacklister(blacklister domainDaemon.WaypointBlacklister, threshold int, ttl time.Duration) *domainDaemon.HealthMonitor {
+	s.healthMonitor.SetWaypointBlacklister(blacklister, threshold, ttl)
+	return s.healthMonitor
+}
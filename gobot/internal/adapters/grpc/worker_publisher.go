@@ -4,11 +4,15 @@ import (
 	"sync"
 
 	"github.com/andrescamacho/spacetraders-go/internal/domain/navigation"
+	"github.com/andrescamacho/spacetraders-go/internal/domain/shared"
 )
 
 var (
 	workerPublisherMu      sync.RWMutex
 	defaultWorkerPublisher navigation.ShipEventPublisher
+
+	coordinationPublisherMu sync.RWMutex
+	coordinationPublisher   shared.CoordinationPublisher
 )
 
 // SetDefaultWorkerEventPublisher wires the ship event bus for worker-completed
@@ -32,3 +36,19 @@ func resolveWorkerPublisher(instance navigation.ShipEventPublisher) navigation.S
 	defer workerPublisherMu.RUnlock()
 	return defaultWorkerPublisher
 }
+
+// SetCoordinationEventPublisher wires the coordination event bus every runner
+// announces its clean exits on (container.completed). Called once from the
+// daemon main; nil leaves runners silent.
+func SetCoordinationEventPublisher(p shared.CoordinationPublisher) {
+	coordinationPublisherMu.Lock()
+	defer coordinationPublisherMu.Unlock()
+	coordinationPublisher = p
+}
+
+// resolveCoordinationPublisher returns the package coordination publisher, or nil
+func resolveCoordinationPublisher() shared.CoordinationPublisher {
+	coordinationPublisherMu.RLock()
+	defer coordinationPublisherMu.RUnlock()
+	return coordinationPublisher
+}
@@ -0,0 +1,120 @@
+// Package grpcerrors carries domain error codes (shared.ErrorCode) across the daemon's
+// gRPC boundary. The server side turns a coded error into a gRPC status with a matching
+// status code and an ErrorInfo detail naming the domain code; the client side rebuilds a
+// coded error from that detail, so errors.Is(err, shared.ErrContainerNotFound) works in
+// the CLI exactly as it does inside the daemon.
+package grpcerrors
+
+import (
+	"context"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/andrescamacho/spacetraders-go/internal/domain/shared"
+)
+
+// Domain is the ErrorInfo domain stamped on every coded status, so a client only decodes
+// reasons this daemon issued.
+const Domain = "spacetraders"
+
+// grpcCodes maps each domain code to the gRPC status code it is reported under. A code
+// missing here is reported as codes.Unknown, still with its ErrorInfo detail.
+var grpcCodes = map[shared.ErrorCode]codes.Code{
+	shared.CodeInsufficientFuel:        codes.FailedPrecondition,
+	shared.CodeInvalidNavStatus:        codes.FailedPrecondition,
+	shared.CodeInvalidArgument:         codes.InvalidArgument,
+	shared.CodeShipNotFound:            codes.NotFound,
+	shared.CodeShipBusy:                codes.FailedPrecondition,
+	shared.CodeShipNotReserved:         codes.FailedPrecondition,
+	shared.CodeMarketNotFound:          codes.NotFound,
+	shared.CodeContainerNotFound:       codes.NotFound,
+	shared.CodeContainerAlreadyRunning: codes.AlreadyExists,
+}
+
+// ToStatus converts a coded error into a gRPC status error carrying its code. Errors that
+// are already statuses, and errors with no code, are returned unchanged.
+func ToStatus(err error) error {
+	if err == nil {
+		return nil
+	}
+	if _, ok := status.FromError(err); ok {
+		return err
+	}
+	code := shared.CodeOf(err)
+	if code == "" {
+		return err
+	}
+
+	grpcCode, ok := grpcCodes[code]
+	if !ok {
+		grpcCode = codes.Unknown
+	}
+	st, detailErr := status.New(grpcCode, err.Error()).WithDetails(&errdetails.ErrorInfo{
+		Reason: string(code),
+		Domain: Domain,
+	})
+	if detailErr != nil {
+		return status.Error(grpcCode, err.Error())
+	}
+	return st.Err()
+}
+
+// FromStatus rebuilds a coded error from a gRPC status produced by ToStatus. The returned
+// error keeps the status's message and GRPCStatus, and unwraps to a shared.CodedError.
+// Errors without a spacetraders ErrorInfo detail are returned unchanged.
+func FromStatus(err error) error {
+	if err == nil {
+		return nil
+	}
+	st, ok := status.FromError(err)
+	if !ok {
+		return err
+	}
+	for _, detail := range st.Details() {
+		info, ok := detail.(*errdetails.ErrorInfo)
+		if !ok || info.GetDomain() != Domain {
+			continue
+		}
+		return &statusError{
+			status: st,
+			coded:  &shared.CodedError{Code: shared.ErrorCode(info.GetReason()), Message: st.Message()},
+		}
+	}
+	return err
+}
+
+// statusError is a client-side gRPC error whose domain code survived the round trip.
+type statusError struct {
+	status *status.Status
+	coded  *shared.CodedError
+}
+
+func (e *statusError) Error() string {
+	return e.status.Err().Error()
+}
+
+func (e *statusError) GRPCStatus() *status.Status {
+	return e.status
+}
+
+func (e *statusError) Unwrap() error {
+	return e.coded
+}
+
+// UnaryServerInterceptor reports handler errors through ToStatus.
+func UnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, _ *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		resp, err := handler(ctx, req)
+		return resp, ToStatus(err)
+	}
+}
+
+// UnaryClientInterceptor decodes call errors through FromStatus.
+func UnaryClientInterceptor() grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		return FromStatus(invoker(ctx, method, req, reply, cc, opts...))
+	}
+}
@@ -0,0 +1,82 @@
+package grpcerrors
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+
+	"github.com/andrescamacho/spacetraders-go/internal/domain/shared"
+	pb "github.com/andrescamacho/spacetraders-go/pkg/proto/daemon"
+)
+
+// stopService fails StopContainer with a configurable error and leaves every other RPC
+// unimplemented.
+type stopService struct {
+	pb.UnimplementedDaemonServiceServer
+	err error
+}
+
+func (s *stopService) StopContainer(context.Context, *pb.StopContainerRequest) (*pb.StopContainerResponse, error) {
+	return nil, s.err
+}
+
+// dialService serves svc over an in-memory listener through the server interceptor and
+// returns a client dialed through the client interceptor — the same pairing the daemon
+// and the CLI use.
+func dialService(t *testing.T, svc pb.DaemonServiceServer) pb.DaemonServiceClient {
+	t.Helper()
+	listener := bufconn.Listen(1 << 20)
+	server := grpc.NewServer(grpc.UnaryInterceptor(UnaryServerInterceptor()))
+	pb.RegisterDaemonServiceServer(server, svc)
+	go func() { _ = server.Serve(listener) }()
+	t.Cleanup(server.Stop)
+
+	conn, err := grpc.NewClient("passthrough:///bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) { return listener.DialContext(ctx) }),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithUnaryInterceptor(UnaryClientInterceptor()),
+	)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = conn.Close() })
+	return pb.NewDaemonServiceClient(conn)
+}
+
+func TestCodedErrorSurvivesTheRoundTrip(t *testing.T) {
+	notFound := shared.Errorf(shared.CodeContainerNotFound, "container not found: %s", "c-1")
+	client := dialService(t, &stopService{err: fmt.Errorf("failed to stop container: %w", notFound)})
+
+	_, err := client.StopContainer(context.Background(), &pb.StopContainerRequest{ContainerId: "c-1"})
+
+	require.True(t, errors.Is(err, shared.ErrContainerNotFound), "got %v", err)
+	require.Equal(t, shared.CodeContainerNotFound, shared.CodeOf(err))
+	require.Equal(t, codes.NotFound, status.Code(err))
+	require.Contains(t, err.Error(), "failed to stop container: container not found: c-1")
+}
+
+func TestTypedDomainErrorIsCodedOnTheWire(t *testing.T) {
+	client := dialService(t, &stopService{err: shared.NewShipAlreadyAssignedError("AGENT-1", "c-2")})
+
+	_, err := client.StopContainer(context.Background(), &pb.StopContainerRequest{ContainerId: "c-1"})
+
+	require.True(t, errors.Is(err, shared.ErrShipBusy), "got %v", err)
+	require.Equal(t, codes.FailedPrecondition, status.Code(err))
+}
+
+func TestUncodedErrorStaysUnknownWithoutCode(t *testing.T) {
+	client := dialService(t, &stopService{err: errors.New("boom")})
+
+	_, err := client.StopContainer(context.Background(), &pb.StopContainerRequest{ContainerId: "c-1"})
+
+	require.Error(t, err)
+	require.Equal(t, codes.Unknown, status.Code(err))
+	require.Empty(t, shared.CodeOf(err))
+}
@@ -0,0 +1,48 @@
+// Package grpcpriority carries a request's API priority across the daemon's gRPC
+// boundary. The CLI marks a call an operator is waiting on with WithInteractive;
+// the daemon's interceptor turns that metadata into api.PriorityInteractive on
+// the handler's context, so the shared API client serves the call ahead of
+// background work and lets it spend the interactive burst reserve.
+package grpcpriority
+
+import (
+	"context"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+
+	"github.com/andrescamacho/spacetraders-go/internal/adapters/api"
+)
+
+// MetadataKey is the gRPC metadata key holding the request priority.
+const MetadataKey = "x-spacetraders-priority"
+
+// Interactive is the MetadataKey value for calls an operator is waiting on.
+const Interactive = "interactive"
+
+// WithInteractive marks the outgoing RPCs made with ctx as interactive.
+func WithInteractive(ctx context.Context) context.Context {
+	return metadata.AppendToOutgoingContext(ctx, MetadataKey, Interactive)
+}
+
+// FromIncoming returns ctx tagged with the API priority named in its incoming
+// metadata. Unknown or absent values leave ctx unchanged.
+func FromIncoming(ctx context.Context) context.Context {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return ctx
+	}
+	for _, value := range md.Get(MetadataKey) {
+		if value == Interactive {
+			return api.WithPriority(ctx, api.PriorityInteractive)
+		}
+	}
+	return ctx
+}
+
+// UnaryServerInterceptor applies FromIncoming to every call.
+func UnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, _ *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		return handler(FromIncoming(ctx), req)
+	}
+}
@@ -0,0 +1,40 @@
+package grpcpriority
+
+import (
+	"context"
+	"testing"
+
+	"google.golang.org/grpc/metadata"
+
+	"github.com/andrescamacho/spacetraders-go/internal/adapters/api"
+)
+
+// incoming moves the outgoing metadata of ctx to the incoming side, as the
+// transport does between the CLI and the daemon.
+func incoming(ctx context.Context) context.Context {
+	md, _ := metadata.FromOutgoingContext(ctx)
+	return metadata.NewIncomingContext(context.Background(), md)
+}
+
+func TestFromIncoming_TagsInteractiveCalls(t *testing.T) {
+	ctx := FromIncoming(incoming(WithInteractive(context.Background())))
+
+	var got api.Priority
+	handler := func(ctx context.Context, _ interface{}) (interface{}, error) {
+		got, _ = api.PriorityFromContext(ctx)
+		return nil, nil
+	}
+	if _, err := UnaryServerInterceptor()(incoming(WithInteractive(context.Background())), nil, nil, handler); err != nil {
+		t.Fatal(err)
+	}
+	if p, _ := api.PriorityFromContext(ctx); p != api.PriorityInteractive || got != api.PriorityInteractive {
+		t.Fatalf("expected an interactive priority, got %s", got)
+	}
+}
+
+func TestFromIncoming_LeavesUnmarkedCallsAlone(t *testing.T) {
+	ctx := FromIncoming(incoming(context.Background()))
+	if _, ok := api.PriorityFromContext(ctx); ok {
+		t.Fatal("an unmarked call must keep endpoint classification")
+	}
+}
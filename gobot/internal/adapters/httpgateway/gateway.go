@@ -0,0 +1,317 @@
+// Package httpgateway serves the daemon's read-side queries as plain HTTP/JSON,
+// for scripts and dashboards that cannot speak gRPC over the daemon's Unix
+// socket. Every endpoint is a GET and requires a bearer token.
+package httpgateway
+
+import (
+	"context"
+	"crypto/subtle"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/andrescamacho/spacetraders-go/internal/application/common"
+	ledgerQuery "github.com/andrescamacho/spacetraders-go/internal/application/ledger/queries"
+	scoutingQuery "github.com/andrescamacho/spacetraders-go/internal/application/scouting/queries"
+	"github.com/andrescamacho/spacetraders-go/internal/domain/shared"
+	pb "github.com/andrescamacho/spacetraders-go/pkg/proto/daemon"
+)
+
+// DefaultProfitLossWindow is the period /profit-loss reports when the request
+// names no start.
+const DefaultProfitLossWindow = 24 * time.Hour
+
+// ReadService is the slice of the daemon's gRPC service the gateway exposes.
+// Ships and containers go through it so HTTP and gRPC callers see the same rows.
+type ReadService interface {
+	ListShips(ctx context.Context, req *pb.ListShipsRequest) (*pb.ListShipsResponse, error)
+	ListContainers(ctx context.Context, req *pb.ListContainersRequest) (*pb.ListContainersResponse, error)
+	GetContainer(ctx context.Context, req *pb.GetContainerRequest) (*pb.GetContainerResponse, error)
+}
+
+var protoJSON = protojson.MarshalOptions{UseProtoNames: true, EmitUnpopulated: true}
+
+type gateway struct {
+	reads    ReadService
+	mediator common.Mediator
+	now      func() time.Time
+}
+
+// NewHandler returns the gateway's routes behind bearer-token auth. An empty
+// token is refused: the gateway never serves unauthenticated.
+//
+//	GET /api/v1/ships?player_id=&agent_symbol=
+//	GET /api/v1/containers?player_id=&status=
+//	GET /api/v1/containers/{id}
+//	GET /api/v1/markets/{waypoint}?player_id=
+//	GET /api/v1/profit-loss?player_id=&start=&end=   (RFC 3339; default the last 24h)
+func NewHandler(reads ReadService, mediator common.Mediator, token string) (http.Handler, error) {
+	if token == "" {
+		return nil, errors.New("http gateway requires a token")
+	}
+	g := &gateway{reads: reads, mediator: mediator, now: time.Now}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /api/v1/ships", g.listShips)
+	mux.HandleFunc("GET /api/v1/containers", g.listContainers)
+	mux.HandleFunc("GET /api/v1/containers/{id}", g.getContainer)
+	mux.HandleFunc("GET /api/v1/markets/{waypoint}", g.getMarket)
+	mux.HandleFunc("GET /api/v1/profit-loss", g.getProfitLoss)
+	return requireToken(token, mux), nil
+}
+
+// requireToken rejects any request without "Authorization: Bearer <token>".
+func requireToken(token string, next http.Handler) http.Handler {
+	want := []byte("Bearer " + token)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		got := []byte(r.Header.Get("Authorization"))
+		if subtle.ConstantTimeCompare(got, want) != 1 {
+			w.Header().Set("WWW-Authenticate", "Bearer")
+			writeError(w, http.StatusUnauthorized, errors.New("missing or invalid token"))
+			return
+		}
+		next.ServeHTTP(w, r)
+	})
+}
+
+func (g *gateway) listShips(w http.ResponseWriter, r *http.Request) {
+	req := &pb.ListShipsRequest{}
+	playerID, ok, err := optionalPlayerID(r)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err)
+		return
+	}
+	if ok {
+		req.PlayerId = &playerID
+	}
+	if agent := r.URL.Query().Get("agent_symbol"); agent != "" {
+		req.AgentSymbol = &agent
+	}
+	resp, err := g.reads.ListShips(r.Context(), req)
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, err)
+		return
+	}
+	writeProto(w, resp)
+}
+
+func (g *gateway) listContainers(w http.ResponseWriter, r *http.Request) {
+	req := &pb.ListContainersRequest{}
+	playerID, ok, err := optionalPlayerID(r)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err)
+		return
+	}
+	if ok {
+		req.PlayerId = &playerID
+	}
+	if status := r.URL.Query().Get("status"); status != "" {
+		req.Status = &status
+	}
+	resp, err := g.reads.ListContainers(r.Context(), req)
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, err)
+		return
+	}
+	writeProto(w, resp)
+}
+
+func (g *gateway) getContainer(w http.ResponseWriter, r *http.Request) {
+	resp, err := g.reads.GetContainer(r.Context(), &pb.GetContainerRequest{ContainerId: r.PathValue("id")})
+	if err != nil {
+		status := http.StatusInternalServerError
+		if errors.Is(err, shared.ErrContainerNotFound) {
+			status = http.StatusNotFound
+		}
+		writeError(w, status, err)
+		return
+	}
+	writeProto(w, resp)
+}
+
+// marketGood is one trade good in the /markets response. Bid is what the
+// market pays us for the good and Ask is what we pay it; the domain stores
+// them as PurchasePrice and SellPrice, named from the market's side.
+type marketGood struct {
+	Symbol      string  `json:"symbol"`
+	TradeType   string  `json:"trade_type"`
+	Supply      *string `json:"supply"`
+	Activity    *string `json:"activity"`
+	Bid         int     `json:"bid"`
+	Ask         int     `json:"ask"`
+	TradeVolume int     `json:"trade_volume"`
+}
+
+type marketResponse struct {
+	WaypointSymbol string       `json:"waypoint_symbol"`
+	LastUpdated    time.Time    `json:"last_updated"`
+	TradeGoods     []marketGood `json:"trade_goods"`
+}
+
+func (g *gateway) getMarket(w http.ResponseWriter, r *http.Request) {
+	playerID, err := requiredPlayerID(r)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err)
+		return
+	}
+	waypoint := r.PathValue("waypoint")
+	resp, err := g.mediator.Send(r.Context(), &scoutingQuery.GetMarketDataQuery{
+		PlayerID:       playerID,
+		WaypointSymbol: waypoint,
+	})
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, err)
+		return
+	}
+	result, ok := resp.(*scoutingQuery.GetMarketDataResponse)
+	if !ok {
+		writeError(w, http.StatusInternalServerError, fmt.Errorf("unexpected response type %T", resp))
+		return
+	}
+	if result.Market == nil {
+		writeError(w, http.StatusNotFound, fmt.Errorf("no market data for %s", waypoint))
+		return
+	}
+
+	goods := result.Market.TradeGoods()
+	out := marketResponse{
+		WaypointSymbol: result.Market.WaypointSymbol(),
+		LastUpdated:    result.Market.LastUpdated(),
+		TradeGoods:     make([]marketGood, 0, len(goods)),
+	}
+	for i := range goods {
+		good := &goods[i]
+		out.TradeGoods = append(out.TradeGoods, marketGood{
+			Symbol:      good.Symbol(),
+			TradeType:   string(good.TradeType()),
+			Supply:      good.Supply(),
+			Activity:    good.Activity(),
+			Bid:         good.PurchasePrice(),
+			Ask:         good.SellPrice(),
+			TradeVolume: good.TradeVolume(),
+		})
+	}
+	writeJSON(w, http.StatusOK, out)
+}
+
+type profitLossResponse struct {
+	PlayerID         int            `json:"player_id"`
+	Start            time.Time      `json:"start"`
+	End              time.Time      `json:"end"`
+	TotalRevenue     int            `json:"total_revenue"`
+	TotalExpenses    int            `json:"total_expenses"`
+	NetProfit        int            `json:"net_profit"`
+	RevenueBreakdown map[string]int `json:"revenue_breakdown"`
+	ExpenseBreakdown map[string]int `json:"expense_breakdown"`
+}
+
+func (g *gateway) getProfitLoss(w http.ResponseWriter, r *http.Request) {
+	playerID, err := requiredPlayerID(r)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err)
+		return
+	}
+	end, err := timeParam(r, "end", g.now())
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err)
+		return
+	}
+	start, err := timeParam(r, "start", end.Add(-DefaultProfitLossWindow))
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err)
+		return
+	}
+	if !start.Before(end) {
+		writeError(w, http.StatusBadRequest, errors.New("start must be before end"))
+		return
+	}
+
+	resp, err := g.mediator.Send(r.Context(), &ledgerQuery.GetProfitLossQuery{
+		PlayerID:  playerID.Value(),
+		StartDate: start,
+		EndDate:   end,
+	})
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, err)
+		return
+	}
+	result, ok := resp.(*ledgerQuery.GetProfitLossResponse)
+	if !ok {
+		writeError(w, http.StatusInternalServerError, fmt.Errorf("unexpected response type %T", resp))
+		return
+	}
+	writeJSON(w, http.StatusOK, profitLossResponse{
+		PlayerID:         playerID.Value(),
+		Start:            start,
+		End:              end,
+		TotalRevenue:     result.TotalRevenue,
+		TotalExpenses:    result.TotalExpenses,
+		NetProfit:        result.NetProfit,
+		RevenueBreakdown: result.RevenueBreakdown,
+		ExpenseBreakdown: result.ExpenseBreakdown,
+	})
+}
+
+// optionalPlayerID reads the player_id query parameter, reporting whether it
+// was given.
+func optionalPlayerID(r *http.Request) (int32, bool, error) {
+	raw := r.URL.Query().Get("player_id")
+	if raw == "" {
+		return 0, false, nil
+	}
+	id, err := strconv.ParseInt(raw, 10, 32)
+	if err != nil || id <= 0 {
+		return 0, false, fmt.Errorf("invalid player_id %q", raw)
+	}
+	return int32(id), true, nil
+}
+
+func requiredPlayerID(r *http.Request) (shared.PlayerID, error) {
+	id, ok, err := optionalPlayerID(r)
+	if err != nil {
+		return shared.PlayerID{}, err
+	}
+	if !ok {
+		return shared.PlayerID{}, errors.New("player_id is required")
+	}
+	return shared.NewPlayerID(int(id))
+}
+
+// timeParam parses an RFC 3339 query parameter, returning fallback when absent.
+func timeParam(r *http.Request, name string, fallback time.Time) (time.Time, error) {
+	raw := r.URL.Query().Get(name)
+	if raw == "" {
+		return fallback, nil
+	}
+	t, err := time.Parse(time.RFC3339, raw)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("invalid %s %q: want RFC 3339", name, raw)
+	}
+	return t, nil
+}
+
+func writeProto(w http.ResponseWriter, msg proto.Message) {
+	body, err := protoJSON.Marshal(msg)
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, err)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	_, _ = w.Write(body)
+}
+
+func writeJSON(w http.ResponseWriter, status int, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(v)
+}
+
+func writeError(w http.ResponseWriter, status int, err error) {
+	writeJSON(w, status, map[string]string{"error": err.Error()})
+}
@@ -0,0 +1,187 @@
+package httpgateway
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/andrescamacho/spacetraders-go/internal/application/common"
+	ledgerQuery "github.com/andrescamacho/spacetraders-go/internal/application/ledger/queries"
+	scoutingQuery "github.com/andrescamacho/spacetraders-go/internal/application/scouting/queries"
+	"github.com/andrescamacho/spacetraders-go/internal/domain/market"
+	"github.com/andrescamacho/spacetraders-go/internal/domain/shared"
+	pb "github.com/andrescamacho/spacetraders-go/pkg/proto/daemon"
+)
+
+const testToken = "s3cret"
+
+type fakeReads struct {
+	shipsReq *pb.ListShipsRequest
+}
+
+func (f *fakeReads) ListShips(_ context.Context, req *pb.ListShipsRequest) (*pb.ListShipsResponse, error) {
+	f.shipsReq = req
+	return &pb.ListShipsResponse{Ships: []*pb.ShipInfo{{Symbol: "AGENT-1", Location: "X1-A1"}}}, nil
+}
+
+func (f *fakeReads) ListContainers(_ context.Context, _ *pb.ListContainersRequest) (*pb.ListContainersResponse, error) {
+	return &pb.ListContainersResponse{}, nil
+}
+
+func (f *fakeReads) GetContainer(_ context.Context, req *pb.GetContainerRequest) (*pb.GetContainerResponse, error) {
+	return nil, fmt.Errorf("failed to get container: %w", shared.Errorf(shared.CodeContainerNotFound, "container not found: %s", req.ContainerId))
+}
+
+type fakeMediator struct {
+	sent      []common.Request
+	responses map[reflect.Type]common.Response
+}
+
+func (m *fakeMediator) Send(_ context.Context, request common.Request) (common.Response, error) {
+	m.sent = append(m.sent, request)
+	return m.responses[reflect.TypeOf(request)], nil
+}
+
+func (m *fakeMediator) Register(reflect.Type, common.RequestHandler) error { return nil }
+
+func (m *fakeMediator) RegisterMiddleware(common.Middleware) {}
+
+func newTestGateway(t *testing.T, med *fakeMediator) (*httptest.Server, *fakeReads) {
+	t.Helper()
+	reads := &fakeReads{}
+	if med == nil {
+		med = &fakeMediator{}
+	}
+	handler, err := NewHandler(reads, med, testToken)
+	require.NoError(t, err)
+	srv := httptest.NewServer(handler)
+	t.Cleanup(srv.Close)
+	return srv, reads
+}
+
+func get(t *testing.T, srv *httptest.Server, path string, token string) (*http.Response, map[string]interface{}) {
+	t.Helper()
+	req, err := http.NewRequest(http.MethodGet, srv.URL+path, nil)
+	require.NoError(t, err)
+	if token != "" {
+		req.Header.Set("Authorization", "Bearer "+token)
+	}
+	resp, err := http.DefaultClient.Do(req)
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	var body map[string]interface{}
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&body))
+	return resp, body
+}
+
+func TestNewHandler_RequiresToken(t *testing.T) {
+	_, err := NewHandler(&fakeReads{}, &fakeMediator{}, "")
+	require.Error(t, err)
+}
+
+func TestGateway_RejectsMissingOrWrongToken(t *testing.T) {
+	srv, _ := newTestGateway(t, nil)
+
+	resp, _ := get(t, srv, "/api/v1/ships", "")
+	require.Equal(t, http.StatusUnauthorized, resp.StatusCode)
+
+	resp, _ = get(t, srv, "/api/v1/ships", "wrong")
+	require.Equal(t, http.StatusUnauthorized, resp.StatusCode)
+}
+
+func TestGateway_ListShipsProxiesFiltersAndUsesProtoNames(t *testing.T) {
+	srv, reads := newTestGateway(t, nil)
+
+	resp, body := get(t, srv, "/api/v1/ships?player_id=7", testToken)
+
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	require.Equal(t, int32(7), reads.shipsReq.GetPlayerId())
+	ships := body["ships"].([]interface{})
+	require.Len(t, ships, 1)
+	require.Equal(t, "AGENT-1", ships[0].(map[string]interface{})["symbol"])
+}
+
+func TestGateway_GetContainerNotFound(t *testing.T) {
+	srv, _ := newTestGateway(t, nil)
+
+	resp, body := get(t, srv, "/api/v1/containers/missing-1", testToken)
+
+	require.Equal(t, http.StatusNotFound, resp.StatusCode)
+	require.Contains(t, body["error"], "missing-1")
+}
+
+func TestGateway_GetMarket(t *testing.T) {
+	supply := "HIGH"
+	good, err := market.NewTradeGood("IRON_ORE", &supply, nil, 40, 55, 100, market.TradeTypeExport)
+	require.NoError(t, err)
+	updated := time.Date(2024, 3, 1, 12, 0, 0, 0, time.UTC)
+	mkt, err := market.NewMarket("X1-A1", []market.TradeGood{*good}, updated)
+	require.NoError(t, err)
+	med := &fakeMediator{responses: map[reflect.Type]common.Response{
+		reflect.TypeOf(&scoutingQuery.GetMarketDataQuery{}): &scoutingQuery.GetMarketDataResponse{Market: mkt},
+	}}
+	srv, _ := newTestGateway(t, med)
+
+	resp, _ := get(t, srv, "/api/v1/markets/X1-A1", testToken)
+	require.Equal(t, http.StatusBadRequest, resp.StatusCode, "player_id is required")
+
+	resp, body := get(t, srv, "/api/v1/markets/X1-A1?player_id=2", testToken)
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	require.Equal(t, "X1-A1", body["waypoint_symbol"])
+	goods := body["trade_goods"].([]interface{})
+	require.Len(t, goods, 1)
+	good0 := goods[0].(map[string]interface{})
+	require.Equal(t, "IRON_ORE", good0["symbol"])
+	// The market buys IRON_ORE from us at 40 and sells it to us at 55.
+	require.Equal(t, float64(40), good0["bid"])
+	require.Equal(t, float64(55), good0["ask"])
+	require.NotContains(t, good0, "purchase_price")
+	require.NotContains(t, good0, "sell_price")
+	query := med.sent[len(med.sent)-1].(*scoutingQuery.GetMarketDataQuery)
+	require.Equal(t, 2, query.PlayerID.Value())
+}
+
+func TestGateway_GetMarketWithoutDataIsNotFound(t *testing.T) {
+	med := &fakeMediator{responses: map[reflect.Type]common.Response{
+		reflect.TypeOf(&scoutingQuery.GetMarketDataQuery{}): &scoutingQuery.GetMarketDataResponse{},
+	}}
+	srv, _ := newTestGateway(t, med)
+
+	resp, _ := get(t, srv, "/api/v1/markets/X1-ZZ?player_id=2", testToken)
+
+	require.Equal(t, http.StatusNotFound, resp.StatusCode)
+}
+
+func TestGateway_ProfitLossDefaultsToLastDay(t *testing.T) {
+	med := &fakeMediator{responses: map[reflect.Type]common.Response{
+		reflect.TypeOf(&ledgerQuery.GetProfitLossQuery{}): &ledgerQuery.GetProfitLossResponse{
+			TotalRevenue: 900, TotalExpenses: 400, NetProfit: 500,
+			RevenueBreakdown: map[string]int{"TRADING_REVENUE": 900},
+		},
+	}}
+	now := time.Date(2024, 3, 2, 0, 0, 0, 0, time.UTC)
+	g := &gateway{reads: &fakeReads{}, mediator: med, now: func() time.Time { return now }}
+	rec := httptest.NewRecorder()
+	g.getProfitLoss(rec, httptest.NewRequest(http.MethodGet, "/api/v1/profit-loss?player_id=3", nil))
+
+	require.Equal(t, http.StatusOK, rec.Code)
+	query := med.sent[0].(*ledgerQuery.GetProfitLossQuery)
+	require.Equal(t, now.Add(-DefaultProfitLossWindow), query.StartDate)
+	require.Equal(t, now, query.EndDate)
+	var body profitLossResponse
+	require.NoError(t, json.NewDecoder(rec.Body).Decode(&body))
+	require.Equal(t, 500, body.NetProfit)
+	require.Equal(t, 900, body.RevenueBreakdown["TRADING_REVENUE"])
+
+	rec = httptest.NewRecorder()
+	g.getProfitLoss(rec, httptest.NewRequest(http.MethodGet,
+		"/api/v1/profit-loss?player_id=3&start=2024-03-02T00:00:00Z&end=2024-03-01T00:00:00Z", nil))
+	require.Equal(t, http.StatusBadRequest, rec.Code)
+}
@@ -0,0 +1,95 @@
+package metrics
+
+import (
+	"strconv"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// CreditReconciliationMetricsCollector houses the credit reconciler's drift series:
+//
+//   - ledger_credit_drift: a GAUGE set on every reconciliation to the API's agent credits
+//     minus the ledger's running balance, before any adjustment. Zero while transaction
+//     capture is whole; a value that keeps coming back non-zero is a leak.
+//   - ledger_reconciliation_adjustments_total: a COUNTER of adjustment rows booked, by
+//     direction ("gain" when the API held more than the ledger, "loss" when less).
+//   - ledger_reconciliation_adjusted_credits_total: a COUNTER of the credits those rows
+//     moved, by direction — the size of the leak over time.
+//
+// Pure OBSERVATION: every method is nil-safe and best-effort, so a metrics miss never
+// touches the reconciliation itself.
+type CreditReconciliationMetricsCollector struct {
+	drift            *prometheus.GaugeVec
+	adjustmentsTotal *prometheus.CounterVec
+	adjustedCredits  *prometheus.CounterVec
+}
+
+// NewCreditReconciliationMetricsCollector creates a new credit reconciliation metrics collector.
+func NewCreditReconciliationMetricsCollector() *CreditReconciliationMetricsCollector {
+	return &CreditReconciliationMetricsCollector{
+		drift: prometheus.NewGaugeVec(
+			prometheus.GaugeOpts{
+				Namespace: namespace,
+				Subsystem: subsystem,
+				Name:      "ledger_credit_drift",
+				Help:      "API agent credits minus the ledger's running balance at the last reconciliation, before adjusting",
+			},
+			[]string{"player_id"},
+		),
+		adjustmentsTotal: prometheus.NewCounterVec(
+			prometheus.CounterOpts{
+				Namespace: namespace,
+				Subsystem: subsystem,
+				Name:      "ledger_reconciliation_adjustments_total",
+				Help:      "BALANCE_ADJUSTMENT rows booked by credit reconciliation, by direction (gain|loss)",
+			},
+			[]string{"player_id", "direction"},
+		),
+		adjustedCredits: prometheus.NewCounterVec(
+			prometheus.CounterOpts{
+				Namespace: namespace,
+				Subsystem: subsystem,
+				Name:      "ledger_reconciliation_adjusted_credits_total",
+				Help:      "Credits moved by BALANCE_ADJUSTMENT rows, by direction (gain|loss)",
+			},
+			[]string{"player_id", "direction"},
+		),
+	}
+}
+
+// Register registers the credit reconciliation metrics with the Prometheus registry. A nil
+// Registry (metrics disabled) is a no-op, matching the sibling collectors.
+func (c *CreditReconciliationMetricsCollector) Register() error {
+	if Registry == nil {
+		return nil
+	}
+	if err := Registry.Register(c.drift); err != nil {
+		return err
+	}
+	if err := Registry.Register(c.adjustmentsTotal); err != nil {
+		return err
+	}
+	return Registry.Register(c.adjustedCredits)
+}
+
+// RecordDrift sets the drift gauge for a player (called on every reconciliation).
+func (c *CreditReconciliationMetricsCollector) RecordDrift(playerID, drift int) {
+	if c == nil || c.drift == nil {
+		return
+	}
+	c.drift.WithLabelValues(strconv.Itoa(playerID)).Set(float64(drift))
+}
+
+// RecordAdjustment counts one booked adjustment of amount credits (signed).
+func (c *CreditReconciliationMetricsCollector) RecordAdjustment(playerID, amount int) {
+	if c == nil || c.adjustmentsTotal == nil || amount == 0 {
+		return
+	}
+	direction, magnitude := "gain", amount
+	if amount < 0 {
+		direction, magnitude = "loss", -amount
+	}
+	pid := strconv.Itoa(playerID)
+	c.adjustmentsTotal.WithLabelValues(pid, direction).Inc()
+	c.adjustedCredits.WithLabelValues(pid, direction).Add(float64(magnitude))
+}
@@ -0,0 +1,145 @@
+package metrics
+
+import (
+	"sync"
+	"time"
+
+	"github.com/andrescamacho/spacetraders-go/internal/domain/shared"
+)
+
+// dailyActivityRetention bounds how far back the tracker answers. It is one
+// hour wider than the day the daily summary reads so the oldest partial hour
+// is still inside the ring when asked for "the last 24h".
+const dailyActivityRetention = 25 * time.Hour
+
+// activityBucket tallies one wall-clock hour.
+type activityBucket struct {
+	hour        time.Time
+	apiRequests int
+	apiFailures int
+	yields      map[int]map[string]int // playerID -> good -> units
+}
+
+// DailyActivityTracker is the in-memory, hour-bucketed tally behind the daily
+// summary's two non-ledger sections: API attempt outcomes and extraction
+// yields. Neither is persisted anywhere else, so both restart from zero with
+// the daemon — a summary read less than a day after startup covers only the
+// time since.
+//
+// Like APIBudgetTracker, recording is best-effort: a nil receiver must never
+// panic the request or worker path it is instrumenting.
+type DailyActivityTracker struct {
+	mu      sync.Mutex
+	buckets []*activityBucket // oldest first
+	clock   shared.Clock
+}
+
+// NewDailyActivityTracker constructs a tracker. clock defaults to the real
+// clock when nil.
+func NewDailyActivityTracker(clock shared.Clock) *DailyActivityTracker {
+	if clock == nil {
+		clock = shared.NewRealClock()
+	}
+	return &DailyActivityTracker{clock: clock}
+}
+
+// RecordAPIOutcome tallies one API attempt; failed marks a network error or a
+// 4xx/5xx response. Safe to call on a nil receiver.
+func (t *DailyActivityTracker) RecordAPIOutcome(failed bool) {
+	if t == nil {
+		return
+	}
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	bucket := t.currentLocked()
+	bucket.apiRequests++
+	if failed {
+		bucket.apiFailures++
+	}
+}
+
+// RecordExtractionYield tallies units of good extracted for a player. Safe to
+// call on a nil receiver.
+func (t *DailyActivityTracker) RecordExtractionYield(playerID int, good string, units int) {
+	if t == nil || units <= 0 {
+		return
+	}
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	bucket := t.currentLocked()
+	if bucket.yields == nil {
+		bucket.yields = make(map[int]map[string]int)
+	}
+	if bucket.yields[playerID] == nil {
+		bucket.yields[playerID] = make(map[string]int)
+	}
+	bucket.yields[playerID][good] += units
+}
+
+// APIOutcomesSince sums API attempts and failures in the hours from since's
+// hour onward. Safe to call on a nil receiver.
+func (t *DailyActivityTracker) APIOutcomesSince(since time.Time) (requests, failures int) {
+	if t == nil {
+		return 0, 0
+	}
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	for _, bucket := range t.bucketsSinceLocked(since) {
+		requests += bucket.apiRequests
+		failures += bucket.apiFailures
+	}
+	return requests, failures
+}
+
+// ExtractionYieldsSince sums a player's extracted units per good in the hours
+// from since's hour onward. Safe to call on a nil receiver.
+func (t *DailyActivityTracker) ExtractionYieldsSince(playerID int, since time.Time) map[string]int {
+	yields := make(map[string]int)
+	if t == nil {
+		return yields
+	}
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	for _, bucket := range t.bucketsSinceLocked(since) {
+		for good, units := range bucket.yields[playerID] {
+			yields[good] += units
+		}
+	}
+	return yields
+}
+
+// currentLocked returns the bucket for the current hour, opening it (and
+// pruning expired ones) on an hour change. Caller must hold t.mu.
+func (t *DailyActivityTracker) currentLocked() *activityBucket {
+	now := t.clock.Now()
+	hour := now.Truncate(time.Hour)
+	if n := len(t.buckets); n > 0 && t.buckets[n-1].hour.Equal(hour) {
+		return t.buckets[n-1]
+	}
+
+	cutoff := now.Add(-dailyActivityRetention)
+	kept := t.buckets[:0]
+	for _, bucket := range t.buckets {
+		if !bucket.hour.Before(cutoff) {
+			kept = append(kept, bucket)
+		}
+	}
+	t.buckets = append(kept, &activityBucket{hour: hour})
+	return t.buckets[len(t.buckets)-1]
+}
+
+// bucketsSinceLocked returns the retained buckets whose hour is at or after
+// since's hour. Caller must hold t.mu.
+func (t *DailyActivityTracker) bucketsSinceLocked(since time.Time) []*activityBucket {
+	from := since.Truncate(time.Hour)
+	for i, bucket := range t.buckets {
+		if !bucket.hour.Before(from) {
+			return t.buckets[i:]
+		}
+	}
+	return nil
+}
@@ -0,0 +1,61 @@
+package metrics
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/andrescamacho/spacetraders-go/internal/domain/shared"
+)
+
+func TestDailyActivityTracker_NilReceiver_DoesNotPanic(t *testing.T) {
+	var tr *DailyActivityTracker
+	require.NotPanics(t, func() {
+		tr.RecordAPIOutcome(true)
+		tr.RecordExtractionYield(1, "LIQUID_HYDROGEN", 10)
+	})
+	requests, failures := tr.APIOutcomesSince(time.Time{})
+	assert.Zero(t, requests)
+	assert.Zero(t, failures)
+	assert.Empty(t, tr.ExtractionYieldsSince(1, time.Time{}))
+}
+
+func TestDailyActivityTracker_SumsOnlyTheRequestedHours(t *testing.T) {
+	start := time.Date(2026, 7, 9, 12, 30, 0, 0, time.UTC)
+	clock := &shared.MockClock{CurrentTime: start}
+	tr := NewDailyActivityTracker(clock)
+
+	tr.RecordAPIOutcome(false)
+	tr.RecordExtractionYield(1, "LIQUID_HYDROGEN", 10)
+	clock.Advance(3 * time.Hour)
+	tr.RecordAPIOutcome(true)
+	tr.RecordAPIOutcome(false)
+	tr.RecordExtractionYield(1, "LIQUID_HYDROGEN", 5)
+	tr.RecordExtractionYield(1, "HYDROCARBON", 7)
+	tr.RecordExtractionYield(2, "HYDROCARBON", 100) // another player
+
+	requests, failures := tr.APIOutcomesSince(start)
+	assert.Equal(t, 3, requests)
+	assert.Equal(t, 1, failures)
+	assert.Equal(t, map[string]int{"LIQUID_HYDROGEN": 15, "HYDROCARBON": 7}, tr.ExtractionYieldsSince(1, start))
+
+	requests, failures = tr.APIOutcomesSince(start.Add(2 * time.Hour))
+	assert.Equal(t, 2, requests)
+	assert.Equal(t, 1, failures)
+}
+
+func TestDailyActivityTracker_PrunesHoursOlderThanRetention(t *testing.T) {
+	start := time.Date(2026, 7, 9, 12, 0, 0, 0, time.UTC)
+	clock := &shared.MockClock{CurrentTime: start}
+	tr := NewDailyActivityTracker(clock)
+
+	tr.RecordAPIOutcome(true)
+	clock.Advance(30 * time.Hour)
+	tr.RecordAPIOutcome(false) // opening a new hour prunes the expired one
+
+	requests, failures := tr.APIOutcomesSince(start)
+	assert.Equal(t, 1, requests)
+	assert.Zero(t, failures)
+}
@@ -2,6 +2,8 @@ package metrics
 
 import (
 	"github.com/prometheus/client_golang/prometheus"
+
+	"github.com/andrescamacho/spacetraders-go/internal/domain/navigation"
 )
 
 // FleetHealthMetricsCollector houses the fleet-health event counters that back the
@@ -22,6 +24,15 @@ type FleetHealthMetricsCollector struct {
 	// dark-looping. Keyed by ship+system exactly (the ship symbol is globally unique and
 	// already agent-scoped), so the alert can name the specific stranded hull and where.
 	hullStrandedTotal *prometheus.CounterVec
+
+	// shipCondition is each hull's last synced component condition (0-1) by component
+	// (frame|reactor|engine), so wear is graphable over time and a hull closing on the
+	// repair threshold is visible before it starts failing.
+	shipCondition *prometheus.GaugeVec
+	// shipRepairsTotal counts shipyard repairs by ship, and repairCreditsTotal what they
+	// cost, so the repair threshold can be tuned against its spend.
+	shipRepairsTotal   *prometheus.CounterVec
+	repairCreditsTotal prometheus.Counter
 }
 
 // NewFleetHealthMetricsCollector creates a new fleet-health metrics collector.
@@ -36,6 +47,32 @@ func NewFleetHealthMetricsCollector() *FleetHealthMetricsCollector {
 			},
 			[]string{"ship", "system"},
 		),
+		shipCondition: prometheus.NewGaugeVec(
+			prometheus.GaugeOpts{
+				Namespace: namespace,
+				Subsystem: subsystem,
+				Name:      "fleet_ship_condition",
+				Help:      "Last synced ship component condition (0-1) by component (frame|reactor|engine)",
+			},
+			[]string{"ship", "component"},
+		),
+		shipRepairsTotal: prometheus.NewCounterVec(
+			prometheus.CounterOpts{
+				Namespace: namespace,
+				Subsystem: subsystem,
+				Name:      "fleet_ship_repairs_total",
+				Help:      "Shipyard repairs performed, by ship",
+			},
+			[]string{"ship"},
+		),
+		repairCreditsTotal: prometheus.NewCounter(
+			prometheus.CounterOpts{
+				Namespace: namespace,
+				Subsystem: subsystem,
+				Name:      "fleet_ship_repair_credits_total",
+				Help:      "Credits spent on shipyard repairs",
+			},
+		),
 	}
 }
 
@@ -45,7 +82,14 @@ func (c *FleetHealthMetricsCollector) Register() error {
 	if Registry == nil {
 		return nil // Metrics not enabled
 	}
-	return Registry.Register(c.hullStrandedTotal)
+	for _, collector := range []prometheus.Collector{
+		c.hullStrandedTotal, c.shipCondition, c.shipRepairsTotal, c.repairCreditsTotal,
+	} {
+		if err := Registry.Register(collector); err != nil {
+			return err
+		}
+	}
+	return nil
 }
 
 // RecordHullStranded records one stranded-hull episode for a (ship, system). Emitted once
@@ -57,3 +101,26 @@ func (c *FleetHealthMetricsCollector) RecordHullStranded(ship, systemSymbol stri
 	}
 	c.hullStrandedTotal.WithLabelValues(ship, systemSymbol).Inc()
 }
+
+// RecordShipCondition sets a hull's component condition gauges from its last synced
+// condition. An unreported condition (all zero) is skipped rather than graphed as a
+// wrecked hull.
+func (c *FleetHealthMetricsCollector) RecordShipCondition(ship string, condition navigation.ShipCondition) {
+	if c == nil || c.shipCondition == nil || !condition.Reported() {
+		return
+	}
+	c.shipCondition.WithLabelValues(ship, "frame").Set(condition.Frame.Condition)
+	c.shipCondition.WithLabelValues(ship, "reactor").Set(condition.Reactor.Condition)
+	c.shipCondition.WithLabelValues(ship, "engine").Set(condition.Engine.Condition)
+}
+
+// RecordShipRepair records one shipyard repair and its cost.
+func (c *FleetHealthMetricsCollector) RecordShipRepair(ship string, cost int) {
+	if c == nil || c.shipRepairsTotal == nil {
+		return
+	}
+	c.shipRepairsTotal.WithLabelValues(ship).Inc()
+	if cost > 0 {
+		c.repairCreditsTotal.Add(float64(cost))
+	}
+}
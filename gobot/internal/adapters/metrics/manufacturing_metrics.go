@@ -56,6 +56,9 @@ type ManufacturingMetricsCollector struct {
 	taskAssignmentsTotal          *prometheus.CounterVec
 	taskTypeReservationSkipsTotal *prometheus.CounterVec
 
+	// Task Queue Metrics (1 metric) - bounded task queue backpressure
+	taskQueueRejectionsTotal *prometheus.CounterVec
+
 	// Lifecycle scaffolding (ctx/cancelFunc/wg + Start context + Stop) is shared
 	// via the embedded pollingCollector.
 	pollingCollector
@@ -349,6 +352,17 @@ func NewManufacturingMetricsCollector(db *gorm.DB) *ManufacturingMetricsCollecto
 			[]string{"player_id", "task_type", "reason"},
 		),
 
+		// Task Queue Metrics
+		taskQueueRejectionsTotal: prometheus.NewCounterVec(
+			prometheus.CounterOpts{
+				Namespace: namespace,
+				Subsystem: subsystem,
+				Name:      "manufacturing_task_queue_rejections_total",
+				Help:      "Total tasks a bounded task queue rejected or evicted, by reason",
+			},
+			[]string{"player_id", "task_type", "reason"},
+		),
+
 		// Configuration
 		pollInterval: 30 * time.Second,
 	}
@@ -394,6 +408,8 @@ func (c *ManufacturingMetricsCollector) Register() error {
 		c.taskStarvationMinutes,
 		c.taskAssignmentsTotal,
 		c.taskTypeReservationSkipsTotal,
+		// Task Queue
+		c.taskQueueRejectionsTotal,
 	}
 
 	for _, metric := range metrics {
@@ -756,6 +772,12 @@ func (c *ManufacturingMetricsCollector) RecordTaskTypeReservationSkip(playerID i
 	c.taskTypeReservationSkipsTotal.WithLabelValues(playerIDStr, taskType, reason).Inc()
 }
 
+// RecordTaskQueueRejection records a task a bounded task queue turned away or evicted
+func (c *ManufacturingMetricsCollector) RecordTaskQueueRejection(playerID int, taskType, reason string) {
+	playerIDStr := strconv.Itoa(playerID)
+	c.taskQueueRejectionsTotal.WithLabelValues(playerIDStr, taskType, reason).Inc()
+}
+
 // UpdateTaskStarvationMinutes updates the starvation minutes for task types
 func (c *ManufacturingMetricsCollector) UpdateTaskStarvationMinutes(playerID int, taskType string, minutes float64) {
 	playerIDStr := strconv.Itoa(playerID)
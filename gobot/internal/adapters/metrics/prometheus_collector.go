@@ -98,6 +98,16 @@ var (
 	// derived-phase gauge + probe-purchase counter through it.
 	globalBootstrapCollector *BootstrapMetricsCollector
 
+	// globalCreditReconciliationCollector is the singleton credit reconciliation collector.
+	// Set by SetGlobalCreditReconciliationCollector() when metrics are enabled; the ledger
+	// credit reconciler emits its drift gauge and adjustment counters through it.
+	globalCreditReconciliationCollector *CreditReconciliationMetricsCollector
+
+	// globalServerStatusCollector is the singleton server status collector. Set by
+	// SetGlobalServerStatusCollector() when metrics are enabled; the status watcher
+	// emits its reset countdown and version/reset counters through it.
+	globalServerStatusCollector *ServerStatusMetricsCollector
+
 	// globalSitingCollector is the singleton factory-siting collector. Set by
 	// SetGlobalSitingCollector() when metrics are enabled; the siting coordinator's ACT and
 	// EMIT steps increment the launch/retire/scout-demand counters through it.
@@ -109,6 +119,11 @@ var (
 	// same pattern getMetricsCollector() uses for globalAPICollector.
 	globalAPIBudgetTracker *APIBudgetTracker
 
+	// globalDailyActivityTracker is the singleton hour-bucketed tally of API
+	// outcomes and extraction yields behind the daily summary. Set by
+	// SetGlobalDailyActivityTracker() at daemon startup.
+	globalDailyActivityTracker *DailyActivityTracker
+
 	// globalDutyCycleSampler is the singleton duty-cycle KPI sampler
 	// (captain amendment). Set by SetGlobalDutyCycleSampler() at
 	// daemon startup so a future CLI/gRPC read can reach it without a direct
@@ -372,6 +387,13 @@ func RecordManufacturingTaskAssignment(playerID int, taskType string) {
 	}
 }
 
+// RecordManufacturingTaskQueueRejection records a bounded task queue rejection globally
+func RecordManufacturingTaskQueueRejection(playerID int, taskType, reason string) {
+	if globalManufacturingCollector != nil {
+		globalManufacturingCollector.RecordTaskQueueRejection(playerID, taskType, reason)
+	}
+}
+
 // SetGlobalAbsorptionCollector sets the global absorption burn-in collector.
 func SetGlobalAbsorptionCollector(collector *AbsorptionMetricsCollector) {
 	globalAbsorptionCollector = collector
@@ -579,6 +601,22 @@ func RecordHullStranded(ship, system string) {
 	}
 }
 
+// RecordShipCondition records a hull's synced component condition globally. No-op
+// when metrics are disabled.
+func RecordShipCondition(ship string, condition navigation.ShipCondition) {
+	if globalFleetHealthCollector != nil {
+		globalFleetHealthCollector.RecordShipCondition(ship, condition)
+	}
+}
+
+// RecordShipRepair records one shipyard repair and its cost globally. No-op when
+// metrics are disabled.
+func RecordShipRepair(ship string, cost int) {
+	if globalFleetHealthCollector != nil {
+		globalFleetHealthCollector.RecordShipRepair(ship, cost)
+	}
+}
+
 // SetGlobalChainPnLCollector sets the global chain-P&L collector. Pass nil to
 // clear it (e.g. in test cleanup).
 func SetGlobalChainPnLCollector(collector *ChainPnLMetricsCollector) {
@@ -708,6 +746,58 @@ func GetGlobalBootstrapCollector() *BootstrapMetricsCollector {
 	return globalBootstrapCollector
 }
 
+// SetGlobalCreditReconciliationCollector sets the global credit reconciliation collector.
+// Pass nil to clear it (e.g. in test cleanup).
+func SetGlobalCreditReconciliationCollector(collector *CreditReconciliationMetricsCollector) {
+	globalCreditReconciliationCollector = collector
+}
+
+// RecordCreditDrift sets a player's ledger credit drift gauge globally.
+// No-op when metrics are disabled.
+func RecordCreditDrift(playerID, drift int) {
+	if globalCreditReconciliationCollector != nil {
+		globalCreditReconciliationCollector.RecordDrift(playerID, drift)
+	}
+}
+
+// RecordCreditAdjustment counts one booked balance adjustment globally.
+// No-op when metrics are disabled.
+func RecordCreditAdjustment(playerID, amount int) {
+	if globalCreditReconciliationCollector != nil {
+		globalCreditReconciliationCollector.RecordAdjustment(playerID, amount)
+	}
+}
+
+// SetGlobalServerStatusCollector sets the global server status collector.
+// Pass nil to clear it (e.g. in test cleanup).
+func SetGlobalServerStatusCollector(collector *ServerStatusMetricsCollector) {
+	globalServerStatusCollector = collector
+}
+
+// RecordServerResetCountdown sets the seconds-to-next-reset gauge globally.
+// No-op when metrics are disabled.
+func RecordServerResetCountdown(seconds float64) {
+	if globalServerStatusCollector != nil {
+		globalServerStatusCollector.RecordResetCountdown(seconds)
+	}
+}
+
+// RecordServerVersionChange counts one API version change globally.
+// No-op when metrics are disabled.
+func RecordServerVersionChange() {
+	if globalServerStatusCollector != nil {
+		globalServerStatusCollector.RecordVersionChange()
+	}
+}
+
+// RecordServerResetDetected counts one detected server reset globally.
+// No-op when metrics are disabled.
+func RecordServerResetDetected() {
+	if globalServerStatusCollector != nil {
+		globalServerStatusCollector.RecordResetDetected()
+	}
+}
+
 // RecordAutosizerPurchase increments the autosizer purchase counter for a class globally.
 // No-op when metrics are disabled, so a metrics miss never touches the buy path.
 func RecordAutosizerPurchase(class string) {
@@ -752,6 +842,24 @@ func GetGlobalAPIBudgetTracker() *APIBudgetTracker {
 	return globalAPIBudgetTracker
 }
 
+// SetGlobalDailyActivityTracker sets the global daily activity tracker.
+// Pass nil to clear it (e.g. in test cleanup).
+func SetGlobalDailyActivityTracker(tracker *DailyActivityTracker) {
+	globalDailyActivityTracker = tracker
+}
+
+// GetGlobalDailyActivityTracker returns the global daily activity tracker.
+// Returns nil if it was never set.
+func GetGlobalDailyActivityTracker() *DailyActivityTracker {
+	return globalDailyActivityTracker
+}
+
+// RecordExtractionYield tallies extracted units for the daily summary
+// globally. No-op when the tracker was never set.
+func RecordExtractionYield(playerID int, good string, units int) {
+	globalDailyActivityTracker.RecordExtractionYield(playerID, good, units)
+}
+
 // SetGlobalDutyCycleSampler sets the global duty-cycle KPI sampler.
 // Pass nil to clear it (e.g. in test cleanup).
 func SetGlobalDutyCycleSampler(sampler *DutyCycleSampler) {
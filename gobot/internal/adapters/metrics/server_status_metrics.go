@@ -0,0 +1,90 @@
+package metrics
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// ServerStatusMetricsCollector houses the status watcher's series:
+//
+//   - server_reset_countdown_seconds: a GAUGE set on every status check to the seconds
+//     until the server's announced next reset. Negative once the announced time has
+//     passed and the server has not moved it yet.
+//   - server_version_changes_total: a COUNTER of API version changes seen between checks.
+//   - server_resets_detected_total: a COUNTER of resets detected (the server's resetDate
+//     moved past the one the daemon was running against).
+//
+// Pure OBSERVATION: every method is nil-safe, so a metrics miss never touches the watcher.
+type ServerStatusMetricsCollector struct {
+	resetCountdown prometheus.Gauge
+	versionChanges prometheus.Counter
+	resetsDetected prometheus.Counter
+}
+
+// NewServerStatusMetricsCollector creates a new server status metrics collector.
+func NewServerStatusMetricsCollector() *ServerStatusMetricsCollector {
+	return &ServerStatusMetricsCollector{
+		resetCountdown: prometheus.NewGauge(
+			prometheus.GaugeOpts{
+				Namespace: namespace,
+				Subsystem: subsystem,
+				Name:      "server_reset_countdown_seconds",
+				Help:      "Seconds until the server's announced next reset, at the last status check",
+			},
+		),
+		versionChanges: prometheus.NewCounter(
+			prometheus.CounterOpts{
+				Namespace: namespace,
+				Subsystem: subsystem,
+				Name:      "server_version_changes_total",
+				Help:      "API version changes seen between status checks",
+			},
+		),
+		resetsDetected: prometheus.NewCounter(
+			prometheus.CounterOpts{
+				Namespace: namespace,
+				Subsystem: subsystem,
+				Name:      "server_resets_detected_total",
+				Help:      "Server resets detected by the status watcher",
+			},
+		),
+	}
+}
+
+// Register registers the server status metrics with the Prometheus registry. A nil
+// Registry (metrics disabled) is a no-op, matching the sibling collectors.
+func (c *ServerStatusMetricsCollector) Register() error {
+	if Registry == nil {
+		return nil
+	}
+	if err := Registry.Register(c.resetCountdown); err != nil {
+		return err
+	}
+	if err := Registry.Register(c.versionChanges); err != nil {
+		return err
+	}
+	return Registry.Register(c.resetsDetected)
+}
+
+// RecordResetCountdown sets the seconds-to-next-reset gauge.
+func (c *ServerStatusMetricsCollector) RecordResetCountdown(seconds float64) {
+	if c == nil || c.resetCountdown == nil {
+		return
+	}
+	c.resetCountdown.Set(seconds)
+}
+
+// RecordVersionChange counts one API version change.
+func (c *ServerStatusMetricsCollector) RecordVersionChange() {
+	if c == nil || c.versionChanges == nil {
+		return
+	}
+	c.versionChanges.Inc()
+}
+
+// RecordResetDetected counts one detected server reset.
+func (c *ServerStatusMetricsCollector) RecordResetDetected() {
+	if c == nil || c.resetsDetected == nil {
+		return
+	}
+	c.resetsDetected.Inc()
+}
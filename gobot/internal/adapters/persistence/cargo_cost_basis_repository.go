@@ -0,0 +1,80 @@
+package persistence
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/andrescamacho/spacetraders-go/internal/domain/ledger"
+)
+
+// CargoCostBasisRepositoryGORM implements ledger.CargoCostBasisRepository over
+// the cargo_cost_basis table, one row per (player, ship, good).
+type CargoCostBasisRepositoryGORM struct {
+	db *gorm.DB
+}
+
+// NewCargoCostBasisRepository creates the GORM-backed cost basis store.
+func NewCargoCostBasisRepository(db *gorm.DB) *CargoCostBasisRepositoryGORM {
+	return &CargoCostBasisRepositoryGORM{db: db}
+}
+
+// Find returns the basis for a ship's good, or nil when none is tracked.
+func (r *CargoCostBasisRepositoryGORM) Find(ctx context.Context, playerID int, shipSymbol, goodSymbol string) (*ledger.CargoCostBasis, error) {
+	var row CargoCostBasisModel
+	err := r.db.WithContext(ctx).
+		Where("player_id = ? AND ship_symbol = ? AND good_symbol = ?", playerID, shipSymbol, goodSymbol).
+		First(&row).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read cargo cost basis: %w", err)
+	}
+	return &ledger.CargoCostBasis{
+		PlayerID:   row.PlayerID,
+		ShipSymbol: row.ShipSymbol,
+		GoodSymbol: row.GoodSymbol,
+		Units:      row.Units,
+		TotalCost:  row.TotalCost,
+		UpdatedAt:  row.UpdatedAt,
+	}, nil
+}
+
+// Save upserts the basis; an empty basis deletes its row.
+func (r *CargoCostBasisRepositoryGORM) Save(ctx context.Context, basis *ledger.CargoCostBasis) error {
+	db := r.db.WithContext(ctx)
+	if basis.IsEmpty() {
+		err := db.Where("player_id = ? AND ship_symbol = ? AND good_symbol = ?", basis.PlayerID, basis.ShipSymbol, basis.GoodSymbol).
+			Delete(&CargoCostBasisModel{}).Error
+		if err != nil {
+			return fmt.Errorf("failed to clear cargo cost basis: %w", err)
+		}
+		return nil
+	}
+
+	updatedAt := basis.UpdatedAt
+	if updatedAt.IsZero() {
+		updatedAt = time.Now().UTC()
+	}
+	row := CargoCostBasisModel{
+		PlayerID:   basis.PlayerID,
+		ShipSymbol: basis.ShipSymbol,
+		GoodSymbol: basis.GoodSymbol,
+		Units:      basis.Units,
+		TotalCost:  basis.TotalCost,
+		UpdatedAt:  updatedAt,
+	}
+	err := db.Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "player_id"}, {Name: "ship_symbol"}, {Name: "good_symbol"}},
+		DoUpdates: clause.AssignmentColumns([]string{"units", "total_cost", "updated_at"}),
+	}).Create(&row).Error
+	if err != nil {
+		return fmt.Errorf("failed to save cargo cost basis: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,40 @@
+package persistence_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/andrescamacho/spacetraders-go/internal/adapters/persistence"
+	"github.com/andrescamacho/spacetraders-go/internal/domain/ledger"
+	"github.com/andrescamacho/spacetraders-go/internal/infrastructure/database"
+)
+
+func TestCargoCostBasisRepositoryUpsertsAndDeletesWhenEmpty(t *testing.T) {
+	db, err := database.NewTestConnection()
+	require.NoError(t, err)
+	repo := persistence.NewCargoCostBasisRepository(db)
+	ctx := context.Background()
+
+	missing, err := repo.Find(ctx, 1, "SHIP-1", "IRON")
+	require.NoError(t, err)
+	require.Nil(t, missing)
+
+	basis := ledger.NewCargoCostBasis(1, "SHIP-1", "IRON")
+	basis.Acquire(10, 1000)
+	require.NoError(t, repo.Save(ctx, basis))
+	basis.Acquire(10, 500)
+	require.NoError(t, repo.Save(ctx, basis))
+
+	found, err := repo.Find(ctx, 1, "SHIP-1", "IRON")
+	require.NoError(t, err)
+	require.Equal(t, 20, found.Units)
+	require.Equal(t, 1500, found.TotalCost)
+
+	found.Release(20)
+	require.NoError(t, repo.Save(ctx, found))
+	gone, err := repo.Find(ctx, 1, "SHIP-1", "IRON")
+	require.NoError(t, err)
+	require.Nil(t, gone)
+}
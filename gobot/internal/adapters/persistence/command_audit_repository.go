@@ -0,0 +1,107 @@
+package persistence
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"gorm.io/gorm"
+
+	"github.com/andrescamacho/spacetraders-go/internal/domain/audit"
+)
+
+// CommandAuditRepositoryGORM implements audit.CommandAuditRepository over the
+// command_audit table.
+type CommandAuditRepositoryGORM struct {
+	db *gorm.DB
+}
+
+// NewCommandAuditRepository creates the GORM-backed command audit store.
+func NewCommandAuditRepository(db *gorm.DB) *CommandAuditRepositoryGORM {
+	return &CommandAuditRepositoryGORM{db: db}
+}
+
+// RecordCommands appends one row per record in a single insert.
+func (r *CommandAuditRepositoryGORM) RecordCommands(ctx context.Context, records []audit.CommandRecord) error {
+	if len(records) == 0 {
+		return nil
+	}
+	rows := make([]CommandAuditModel, 0, len(records))
+	for _, rec := range records {
+		rows = append(rows, CommandAuditModel{
+			PlayerID:    rec.PlayerID,
+			CommandType: rec.CommandType,
+			ShipSymbol:  rec.ShipSymbol,
+			ContainerID: rec.ContainerID,
+			Payload:     rec.Payload,
+			StartedAt:   rec.StartedAt,
+			DurationMs:  rec.Duration.Milliseconds(),
+			Success:     rec.Success,
+			Error:       rec.Error,
+		})
+	}
+	if err := r.db.WithContext(ctx).Create(&rows).Error; err != nil {
+		return fmt.Errorf("failed to record command audit: %w", err)
+	}
+	return nil
+}
+
+// FindCommands returns the records matching filter, newest first.
+func (r *CommandAuditRepositoryGORM) FindCommands(ctx context.Context, filter audit.CommandFilter) ([]audit.CommandRecord, error) {
+	query := r.db.WithContext(ctx).Model(&CommandAuditModel{})
+	if filter.PlayerID != 0 {
+		query = query.Where("player_id = ?", filter.PlayerID)
+	}
+	if filter.CommandType != "" {
+		query = query.Where("command_type = ?", filter.CommandType)
+	}
+	if filter.ShipSymbol != "" {
+		query = query.Where("ship_symbol = ?", filter.ShipSymbol)
+	}
+	if filter.ContainerID != "" {
+		query = query.Where("container_id = ?", filter.ContainerID)
+	}
+	if !filter.Since.IsZero() {
+		query = query.Where("started_at >= ?", filter.Since)
+	}
+	if !filter.Until.IsZero() {
+		query = query.Where("started_at < ?", filter.Until)
+	}
+	if filter.FailedOnly {
+		query = query.Where("success = ?", false)
+	}
+	if filter.Limit > 0 {
+		query = query.Limit(filter.Limit)
+	}
+
+	var rows []CommandAuditModel
+	if err := query.Order("started_at DESC, id DESC").Find(&rows).Error; err != nil {
+		return nil, fmt.Errorf("failed to read command audit: %w", err)
+	}
+
+	out := make([]audit.CommandRecord, 0, len(rows))
+	for _, row := range rows {
+		out = append(out, audit.CommandRecord{
+			ID:          row.ID,
+			PlayerID:    row.PlayerID,
+			CommandType: row.CommandType,
+			ShipSymbol:  row.ShipSymbol,
+			ContainerID: row.ContainerID,
+			Payload:     row.Payload,
+			StartedAt:   row.StartedAt,
+			Duration:    time.Duration(row.DurationMs) * time.Millisecond,
+			Success:     row.Success,
+			Error:       row.Error,
+		})
+	}
+	return out, nil
+}
+
+// DeleteCommandsBefore drops records started before cutoff.
+func (r *CommandAuditRepositoryGORM) DeleteCommandsBefore(ctx context.Context, cutoff time.Time) (int64, error) {
+	result := r.db.WithContext(ctx).Where("started_at < ?", cutoff).Delete(&CommandAuditModel{})
+	if result.Error != nil {
+		return 0, fmt.Errorf("failed to prune command audit: %w", result.Error)
+	}
+	return result.RowsAffected, nil
+}
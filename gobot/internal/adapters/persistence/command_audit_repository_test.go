@@ -0,0 +1,58 @@
+package persistence_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/andrescamacho/spacetraders-go/internal/adapters/persistence"
+	"github.com/andrescamacho/spacetraders-go/internal/domain/audit"
+	"github.com/andrescamacho/spacetraders-go/internal/infrastructure/database"
+)
+
+// Records read back newest first under each filter, and retention drops only
+// the rows older than the cutoff.
+func TestCommandAuditRepository_FiltersAndPrunes(t *testing.T) {
+	db, err := database.NewTestConnection()
+	require.NoError(t, err)
+	repo := persistence.NewCommandAuditRepository(db)
+	ctx := context.Background()
+
+	base := time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC)
+	require.NoError(t, repo.RecordCommands(ctx, []audit.CommandRecord{
+		{PlayerID: 1, CommandType: "NavigateRouteCommand", ShipSymbol: "AGENT-1", ContainerID: "trade-1",
+			StartedAt: base, Duration: 1500 * time.Millisecond, Success: true},
+		{PlayerID: 1, CommandType: "DockShipCommand", ShipSymbol: "AGENT-1",
+			StartedAt: base.Add(time.Hour), Success: false, Error: "ship in transit"},
+		{PlayerID: 1, CommandType: "NavigateRouteCommand", ShipSymbol: "AGENT-2",
+			StartedAt: base.Add(2 * time.Hour), Success: true},
+		{PlayerID: 2, CommandType: "NavigateRouteCommand", ShipSymbol: "OTHER-1",
+			StartedAt: base.Add(2 * time.Hour), Success: true},
+	}))
+
+	ship, err := repo.FindCommands(ctx, audit.CommandFilter{PlayerID: 1, ShipSymbol: "AGENT-1"})
+	require.NoError(t, err)
+	require.Len(t, ship, 2)
+	require.Equal(t, "DockShipCommand", ship[0].CommandType, "newest first")
+	require.Equal(t, "trade-1", ship[1].ContainerID)
+	require.Equal(t, 1500*time.Millisecond, ship[1].Duration)
+
+	failed, err := repo.FindCommands(ctx, audit.CommandFilter{PlayerID: 1, FailedOnly: true})
+	require.NoError(t, err)
+	require.Len(t, failed, 1)
+	require.Equal(t, "ship in transit", failed[0].Error)
+
+	limited, err := repo.FindCommands(ctx, audit.CommandFilter{CommandType: "NavigateRouteCommand", Limit: 2})
+	require.NoError(t, err)
+	require.Len(t, limited, 2)
+
+	deleted, err := repo.DeleteCommandsBefore(ctx, base.Add(90*time.Minute))
+	require.NoError(t, err)
+	require.EqualValues(t, 2, deleted)
+
+	remaining, err := repo.FindCommands(ctx, audit.CommandFilter{})
+	require.NoError(t, err)
+	require.Len(t, remaining, 2)
+}
@@ -0,0 +1,113 @@
+package persistence
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/klauspost/compress/zstd"
+
+	"github.com/andrescamacho/spacetraders-go/internal/domain/container"
+)
+
+// ZstdContainerLogArchive writes pruned container log lines to
+// Zstandard-compressed JSON-lines files, one file per batch. Container logs
+// are a few messages repeated with different ship symbols and numbers, so they
+// compress well and an archive of everything the database sheds stays small
+// enough to keep.
+//
+// Files are named container_logs_<first id>-<last id>.jsonl.zst and are written
+// under a temporary name and renamed into place, so a crash mid-write never
+// leaves a truncated archive that looks complete. Read one back with
+// `zstd -dc FILE | jq`.
+type ZstdContainerLogArchive struct {
+	dir string
+}
+
+// NewZstdContainerLogArchive creates an archive that writes into dir, creating it if needed.
+func NewZstdContainerLogArchive(dir string) (*ZstdContainerLogArchive, error) {
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, fmt.Errorf("failed to create log archive directory %s: %w", dir, err)
+	}
+	return &ZstdContainerLogArchive{dir: dir}, nil
+}
+
+// archivedLogLine is the on-disk shape of one log line.
+type archivedLogLine struct {
+	ID          int             `json:"id"`
+	ContainerID string          `json:"container_id"`
+	PlayerID    int             `json:"player_id"`
+	Timestamp   time.Time       `json:"timestamp"`
+	Level       string          `json:"level"`
+	Message     string          `json:"message"`
+	Metadata    json.RawMessage `json:"metadata,omitempty"`
+}
+
+// Write stores records in a new archive file and returns its path
+func (a *ZstdContainerLogArchive) Write(ctx context.Context, records []container.LogRecord) (string, error) {
+	if len(records) == 0 {
+		return "", nil
+	}
+	name := fmt.Sprintf("container_logs_%d-%d.jsonl.zst", records[0].ID, records[len(records)-1].ID)
+	path := filepath.Join(a.dir, name)
+
+	tmp, err := os.CreateTemp(a.dir, name+".*.tmp")
+	if err != nil {
+		return "", fmt.Errorf("failed to create log archive file: %w", err)
+	}
+	defer os.Remove(tmp.Name()) // no-op once renamed
+
+	if err := writeZstdLogLines(tmp, records); err != nil {
+		tmp.Close()
+		return "", err
+	}
+	if err := tmp.Sync(); err != nil {
+		tmp.Close()
+		return "", fmt.Errorf("failed to sync log archive: %w", err)
+	}
+	if err := tmp.Close(); err != nil {
+		return "", fmt.Errorf("failed to close log archive: %w", err)
+	}
+	if err := os.Rename(tmp.Name(), path); err != nil {
+		return "", fmt.Errorf("failed to move log archive into place: %w", err)
+	}
+	return path, nil
+}
+
+func writeZstdLogLines(f *os.File, records []container.LogRecord) error {
+	encoder, err := zstd.NewWriter(f, zstd.WithEncoderLevel(zstd.SpeedBetterCompression))
+	if err != nil {
+		return fmt.Errorf("failed to start zstd encoder: %w", err)
+	}
+	buf := bufio.NewWriter(encoder)
+	lines := json.NewEncoder(buf)
+	for _, record := range records {
+		line := archivedLogLine{
+			ID:          record.ID,
+			ContainerID: record.ContainerID,
+			PlayerID:    record.PlayerID,
+			Timestamp:   record.Timestamp,
+			Level:       record.Level,
+			Message:     record.Message,
+		}
+		if record.Metadata != "" && json.Valid([]byte(record.Metadata)) {
+			line.Metadata = json.RawMessage(record.Metadata)
+		}
+		if err := lines.Encode(line); err != nil {
+			encoder.Close()
+			return fmt.Errorf("failed to encode log line %d: %w", record.ID, err)
+		}
+	}
+	if err := buf.Flush(); err != nil {
+		encoder.Close()
+		return fmt.Errorf("failed to write log archive: %w", err)
+	}
+	if err := encoder.Close(); err != nil {
+		return fmt.Errorf("failed to finish log archive: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,86 @@
+package persistence
+
+import (
+	"context"
+	"time"
+
+	"github.com/andrescamacho/spacetraders-go/internal/domain/container"
+)
+
+// FindLogsBefore returns up to limit log lines older than cutoff, oldest first
+func (r *GormContainerLogRepository) FindLogsBefore(ctx context.Context, cutoff time.Time, limit int) ([]container.LogRecord, error) {
+	var models []ContainerLogModel
+	err := r.db.WithContext(ctx).
+		Where("timestamp < ?", cutoff).
+		Order("timestamp ASC, id ASC").
+		Limit(limit).
+		Find(&models).Error
+	if err != nil {
+		return nil, err
+	}
+	return containerLogModelsToRecords(models), nil
+}
+
+// FindContainersOverLogCap returns the containers holding more than maxRows log lines
+func (r *GormContainerLogRepository) FindContainersOverLogCap(ctx context.Context, maxRows int) ([]container.LogVolume, error) {
+	var rows []struct {
+		ContainerID string
+		PlayerID    int
+		RowCount    int
+	}
+	err := r.db.WithContext(ctx).
+		Model(&ContainerLogModel{}).
+		Select("container_id, player_id, COUNT(*) AS row_count").
+		Group("container_id, player_id").
+		Having("COUNT(*) > ?", maxRows).
+		Scan(&rows).Error
+	if err != nil {
+		return nil, err
+	}
+	volumes := make([]container.LogVolume, len(rows))
+	for i, row := range rows {
+		volumes[i] = container.LogVolume{ContainerID: row.ContainerID, PlayerID: row.PlayerID, Rows: row.RowCount}
+	}
+	return volumes, nil
+}
+
+// FindOldestLogs returns a container's limit oldest log lines, oldest first
+func (r *GormContainerLogRepository) FindOldestLogs(ctx context.Context, containerID string, playerID int, limit int) ([]container.LogRecord, error) {
+	var models []ContainerLogModel
+	err := r.db.WithContext(ctx).
+		Where("container_id = ? AND player_id = ?", containerID, playerID).
+		Order("timestamp ASC, id ASC").
+		Limit(limit).
+		Find(&models).Error
+	if err != nil {
+		return nil, err
+	}
+	return containerLogModelsToRecords(models), nil
+}
+
+// DeleteLogs deletes the log lines with the given IDs
+func (r *GormContainerLogRepository) DeleteLogs(ctx context.Context, ids []int) (int64, error) {
+	if len(ids) == 0 {
+		return 0, nil
+	}
+	result := r.db.WithContext(ctx).Where("id IN ?", ids).Delete(&ContainerLogModel{})
+	return result.RowsAffected, result.Error
+}
+
+func containerLogModelsToRecords(models []ContainerLogModel) []container.LogRecord {
+	records := make([]container.LogRecord, len(models))
+	for i, model := range models {
+		records[i] = container.LogRecord{
+			ID:          model.ID,
+			ContainerID: model.ContainerID,
+			PlayerID:    model.PlayerID,
+			Timestamp:   model.Timestamp,
+			Level:       model.Level,
+			Message:     model.Message,
+		}
+		if model.Metadata != nil {
+			records[i].Metadata = *model.Metadata
+		}
+	}
+	return records
+}
@@ -0,0 +1,89 @@
+package persistence_test
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/klauspost/compress/zstd"
+	"github.com/stretchr/testify/require"
+
+	"github.com/andrescamacho/spacetraders-go/internal/adapters/persistence"
+	"github.com/andrescamacho/spacetraders-go/internal/infrastructure/database"
+)
+
+// The retention reads find old lines and over-cap containers oldest first, and
+// the zstd archive round-trips what it is given before the rows are deleted.
+func TestContainerLogRetention_FindArchiveDelete(t *testing.T) {
+	db, err := database.NewTestConnection()
+	require.NoError(t, err)
+	ctx := context.Background()
+
+	require.NoError(t, db.Create(&persistence.PlayerModel{ID: 1, AgentSymbol: "AGENT", Token: "t", CreatedAt: time.Now()}).Error)
+	require.NoError(t, db.Create(&persistence.ContainerModel{ID: "busy", PlayerID: 1}).Error)
+	require.NoError(t, db.Create(&persistence.ContainerModel{ID: "quiet", PlayerID: 1}).Error)
+
+	base := time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC)
+	meta := `{"ship":"AGENT-1"}`
+	for i := 0; i < 5; i++ {
+		require.NoError(t, db.Create(&persistence.ContainerLogModel{ContainerID: "busy", PlayerID: 1,
+			Timestamp: base.Add(time.Duration(i) * time.Hour), Level: "INFO", Message: "tick", Metadata: &meta}).Error)
+	}
+	require.NoError(t, db.Create(&persistence.ContainerLogModel{ContainerID: "quiet", PlayerID: 1,
+		Timestamp: base.Add(30 * time.Minute), Level: "ERROR", Message: "boom"}).Error)
+
+	repo := persistence.NewGormContainerLogRepository(db, nil)
+
+	old, err := repo.FindLogsBefore(ctx, base.Add(90*time.Minute), 10)
+	require.NoError(t, err)
+	require.Len(t, old, 3)
+	require.Equal(t, "busy", old[0].ContainerID)
+	require.Equal(t, "quiet", old[1].ContainerID)
+	require.Equal(t, meta, old[0].Metadata)
+
+	over, err := repo.FindContainersOverLogCap(ctx, 2)
+	require.NoError(t, err)
+	require.Len(t, over, 1)
+	require.Equal(t, "busy", over[0].ContainerID)
+	require.Equal(t, 5, over[0].Rows)
+
+	oldest, err := repo.FindOldestLogs(ctx, "busy", 1, 3)
+	require.NoError(t, err)
+	require.Len(t, oldest, 3)
+	require.True(t, oldest[0].Timestamp.Before(oldest[2].Timestamp))
+
+	archive, err := persistence.NewZstdContainerLogArchive(t.TempDir())
+	require.NoError(t, err)
+	path, err := archive.Write(ctx, oldest)
+	require.NoError(t, err)
+
+	f, err := os.Open(path)
+	require.NoError(t, err)
+	defer f.Close()
+	decoder, err := zstd.NewReader(f)
+	require.NoError(t, err)
+	defer decoder.Close()
+	var lines []map[string]interface{}
+	scanner := bufio.NewScanner(decoder)
+	for scanner.Scan() {
+		var line map[string]interface{}
+		require.NoError(t, json.Unmarshal(scanner.Bytes(), &line))
+		lines = append(lines, line)
+	}
+	require.NoError(t, scanner.Err())
+	require.Len(t, lines, 3)
+	require.Equal(t, "tick", lines[0]["message"])
+	require.Equal(t, "AGENT-1", lines[0]["metadata"].(map[string]interface{})["ship"])
+
+	ids := []int{oldest[0].ID, oldest[1].ID, oldest[2].ID}
+	deleted, err := repo.DeleteLogs(ctx, ids)
+	require.NoError(t, err)
+	require.EqualValues(t, 3, deleted)
+
+	var remaining int64
+	require.NoError(t, db.Model(&persistence.ContainerLogModel{}).Count(&remaining).Error)
+	require.EqualValues(t, 3, remaining)
+}
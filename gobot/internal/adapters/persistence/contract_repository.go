@@ -59,6 +59,30 @@ func (r *GormContractRepository) FindActiveContracts(ctx context.Context, player
 	return contracts, nil
 }
 
+// FindPendingContracts retrieves a player's negotiated contracts that are still
+// awaiting acceptance: not accepted, not fulfilled and not flagged accept-expired
+func (r *GormContractRepository) FindPendingContracts(ctx context.Context, playerID int) ([]*contract.Contract, error) {
+	var models []ContractModel
+	result := r.db.WithContext(ctx).
+		Where("player_id = ? AND accepted = ? AND fulfilled = ? AND accept_expired = ?", playerID, false, false, false).
+		Find(&models)
+
+	if result.Error != nil {
+		return nil, fmt.Errorf("failed to find pending contracts: %w", result.Error)
+	}
+
+	contracts := make([]*contract.Contract, 0, len(models))
+	for _, model := range models {
+		entity, err := r.modelToEntity(&model)
+		if err != nil {
+			return nil, fmt.Errorf("failed to convert contract %s: %w", model.ID, err)
+		}
+		contracts = append(contracts, entity)
+	}
+
+	return contracts, nil
+}
+
 // Add persists a contract to the database
 func (r *GormContractRepository) Add(ctx context.Context, c *contract.Contract) error {
 	model, err := r.entityToModel(c)
@@ -178,6 +202,11 @@ func (r *GormContractRepository) modelToEntity(model *ContractModel) (*contract.
 			return nil, fmt.Errorf("failed to set fulfilled state: %w", err)
 		}
 	}
+	if model.AcceptExpired {
+		if err := c.ExpireUnaccepted(); err != nil {
+			return nil, fmt.Errorf("failed to set accept-expired state: %w", err)
+		}
+	}
 
 	return c, nil
 }
@@ -202,5 +231,6 @@ func (r *GormContractRepository) entityToModel(c *contract.Contract) (*ContractM
 		PaymentOnFulfilled: c.Terms().Payment.OnFulfilled,
 		DeliveriesJSON:     string(deliveriesJSON),
 		LastUpdated:        time.Now().UTC().Format(time.RFC3339),
+		AcceptExpired:      c.AcceptExpired(),
 	}, nil
 }
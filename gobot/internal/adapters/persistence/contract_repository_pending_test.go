@@ -0,0 +1,58 @@
+package persistence_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/andrescamacho/spacetraders-go/internal/adapters/persistence"
+	"github.com/andrescamacho/spacetraders-go/internal/infrastructure/database"
+)
+
+// FindPendingContracts is the janitor's work list: only negotiated offers still
+// awaiting acceptance. Once a contract is flagged accept-expired and saved, it
+// round-trips with the flag and drops off the list.
+func TestFindPendingContracts_SkipsAcceptedAndAcceptExpired(t *testing.T) {
+	db, err := database.NewTestConnection()
+	require.NoError(t, err)
+	ctx := context.Background()
+
+	player := persistence.PlayerModel{AgentSymbol: "JANITOR-AGENT", Token: "tok", CreatedAt: time.Now()}
+	require.NoError(t, db.Create(&player).Error)
+
+	row := func(id string, accepted, acceptExpired bool) persistence.ContractModel {
+		return persistence.ContractModel{
+			ID: id, PlayerID: player.ID, FactionSymbol: "COSMIC", Type: "PROCUREMENT",
+			Accepted: accepted, AcceptExpired: acceptExpired,
+			DeadlineToAccept: "2026-07-11T00:00:00Z", Deadline: "2026-07-20T00:00:00Z",
+			DeliveriesJSON: `[{"TradeSymbol":"FUEL","DestinationSymbol":"X1-AA-1","UnitsRequired":10}]`,
+			LastUpdated:    "2026-07-10T00:00:00Z",
+		}
+	}
+	for _, m := range []persistence.ContractModel{
+		row("c-offer", false, false),
+		row("c-accepted", true, false),
+		row("c-lapsed", false, true),
+	} {
+		require.NoError(t, db.Create(&m).Error)
+	}
+
+	repo := persistence.NewGormContractRepository(db)
+	pending, err := repo.FindPendingContracts(ctx, player.ID)
+	require.NoError(t, err)
+	require.Len(t, pending, 1)
+	require.Equal(t, "c-offer", pending[0].ContractID())
+
+	require.NoError(t, pending[0].ExpireUnaccepted())
+	require.NoError(t, repo.Add(ctx, pending[0]))
+
+	reloaded, err := repo.FindByID(ctx, "c-offer")
+	require.NoError(t, err)
+	require.True(t, reloaded.AcceptExpired())
+
+	pending, err = repo.FindPendingContracts(ctx, player.ID)
+	require.NoError(t, err)
+	require.Empty(t, pending)
+}
@@ -0,0 +1,78 @@
+package persistence
+
+import (
+	"context"
+	"fmt"
+
+	"gorm.io/gorm"
+
+	"github.com/andrescamacho/spacetraders-go/internal/domain/contract"
+)
+
+// ContractWorkflowAuditRepositoryGORM implements
+// contract.WorkflowAuditRepository over the contract_workflow_steps table.
+type ContractWorkflowAuditRepositoryGORM struct {
+	db *gorm.DB
+}
+
+var _ contract.WorkflowAuditRepository = (*ContractWorkflowAuditRepositoryGORM)(nil)
+
+// NewContractWorkflowAuditRepository creates the GORM-backed workflow audit trail.
+func NewContractWorkflowAuditRepository(db *gorm.DB) *ContractWorkflowAuditRepositoryGORM {
+	return &ContractWorkflowAuditRepositoryGORM{db: db}
+}
+
+// RecordStep appends one step record.
+func (r *ContractWorkflowAuditRepositoryGORM) RecordStep(ctx context.Context, record contract.WorkflowStepRecord) error {
+	row := &ContractWorkflowStepModel{
+		PlayerID:    record.PlayerID,
+		ContractID:  record.ContractID,
+		ContainerID: record.ContainerID,
+		ShipSymbol:  record.ShipSymbol,
+		Step:        string(record.Step),
+		Outcome:     string(record.Outcome),
+		Waypoint:    record.Waypoint,
+		TradeSymbol: record.TradeSymbol,
+		Units:       record.Units,
+		Credits:     record.Credits,
+		Detail:      record.Detail,
+		StartedAt:   record.StartedAt,
+		FinishedAt:  record.FinishedAt,
+	}
+	if err := r.db.WithContext(ctx).Create(row).Error; err != nil {
+		return fmt.Errorf("record contract workflow step: %w", err)
+	}
+	return nil
+}
+
+// FindByContract returns contractID's steps for playerID ordered by insertion
+// (id ASC), so steps read back in the order the workflow took them.
+func (r *ContractWorkflowAuditRepositoryGORM) FindByContract(ctx context.Context, playerID int, contractID string) ([]contract.WorkflowStepRecord, error) {
+	var rows []ContractWorkflowStepModel
+	if err := r.db.WithContext(ctx).
+		Where("player_id = ? AND contract_id = ?", playerID, contractID).
+		Order("id ASC").
+		Find(&rows).Error; err != nil {
+		return nil, fmt.Errorf("find workflow steps for contract %s: %w", contractID, err)
+	}
+
+	out := make([]contract.WorkflowStepRecord, 0, len(rows))
+	for _, row := range rows {
+		out = append(out, contract.WorkflowStepRecord{
+			PlayerID:    row.PlayerID,
+			ContractID:  row.ContractID,
+			ContainerID: row.ContainerID,
+			ShipSymbol:  row.ShipSymbol,
+			Step:        contract.WorkflowStep(row.Step),
+			Outcome:     contract.WorkflowStepOutcome(row.Outcome),
+			Waypoint:    row.Waypoint,
+			TradeSymbol: row.TradeSymbol,
+			Units:       row.Units,
+			Credits:     row.Credits,
+			Detail:      row.Detail,
+			StartedAt:   row.StartedAt,
+			FinishedAt:  row.FinishedAt,
+		})
+	}
+	return out, nil
+}
@@ -0,0 +1,43 @@
+package persistence_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/andrescamacho/spacetraders-go/internal/adapters/persistence"
+	"github.com/andrescamacho/spacetraders-go/internal/domain/contract"
+	"github.com/andrescamacho/spacetraders-go/internal/infrastructure/database"
+)
+
+func TestContractWorkflowAuditRepositoryFindsStepsByContractInOrder(t *testing.T) {
+	db, err := database.NewTestConnection()
+	require.NoError(t, err)
+	repo := persistence.NewContractWorkflowAuditRepository(db)
+	ctx := context.Background()
+	at := time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)
+
+	for _, record := range []contract.WorkflowStepRecord{
+		{PlayerID: 1, ContractID: "C-1", ShipSymbol: "AGENT-1", Step: contract.WorkflowStepAccept, Outcome: contract.WorkflowStepSucceeded, Credits: 5000},
+		{PlayerID: 1, ContractID: "C-2", ShipSymbol: "AGENT-2", Step: contract.WorkflowStepAccept, Outcome: contract.WorkflowStepSucceeded},
+		{PlayerID: 1, ContractID: "C-1", ShipSymbol: "AGENT-1", Step: contract.WorkflowStepPurchase, Outcome: contract.WorkflowStepFailed,
+			Waypoint: "X1-A-M1", TradeSymbol: "IRON", Units: 40, Detail: "insufficient credits"},
+		{PlayerID: 2, ContractID: "C-1", Step: contract.WorkflowStepAccept, Outcome: contract.WorkflowStepSucceeded},
+	} {
+		record.StartedAt, record.FinishedAt = at, at.Add(time.Second)
+		require.NoError(t, repo.RecordStep(ctx, record))
+	}
+
+	steps, err := repo.FindByContract(ctx, 1, "C-1")
+	require.NoError(t, err)
+	require.Len(t, steps, 2)
+	require.Equal(t, contract.WorkflowStepAccept, steps[0].Step)
+	require.Equal(t, 5000, steps[0].Credits)
+	require.Equal(t, contract.WorkflowStepPurchase, steps[1].Step)
+	require.Equal(t, contract.WorkflowStepFailed, steps[1].Outcome)
+	require.Equal(t, "insufficient credits", steps[1].Detail)
+	require.Equal(t, 40, steps[1].Units)
+	require.True(t, steps[1].FinishedAt.Equal(at.Add(time.Second)))
+}
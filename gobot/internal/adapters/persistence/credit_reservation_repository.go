@@ -0,0 +1,74 @@
+package persistence
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"gorm.io/gorm"
+
+	"github.com/andrescamacho/spacetraders-go/internal/domain/ledger"
+)
+
+// CreditReservationRepositoryGORM implements ledger.CreditReservationRepository
+// over the credit_reservations table.
+type CreditReservationRepositoryGORM struct {
+	db *gorm.DB
+}
+
+var _ ledger.CreditReservationRepository = (*CreditReservationRepositoryGORM)(nil)
+
+// NewCreditReservationRepository creates the GORM-backed credit reservation store.
+func NewCreditReservationRepository(db *gorm.DB) *CreditReservationRepositoryGORM {
+	return &CreditReservationRepositoryGORM{db: db}
+}
+
+// Save inserts the reservation.
+func (r *CreditReservationRepositoryGORM) Save(ctx context.Context, reservation *ledger.CreditReservation) error {
+	row := CreditReservationModel{
+		ID:        reservation.ID,
+		PlayerID:  reservation.PlayerID,
+		Holder:    reservation.Holder,
+		Amount:    reservation.Amount,
+		ExpiresAt: reservation.ExpiresAt,
+	}
+	if err := r.db.WithContext(ctx).Create(&row).Error; err != nil {
+		return fmt.Errorf("failed to save credit reservation %s: %w", reservation.ID, err)
+	}
+	return nil
+}
+
+// Delete drops the reservation with id, if any.
+func (r *CreditReservationRepositoryGORM) Delete(ctx context.Context, id string) error {
+	if err := r.db.WithContext(ctx).Where("id = ?", id).Delete(&CreditReservationModel{}).Error; err != nil {
+		return fmt.Errorf("failed to delete credit reservation %s: %w", id, err)
+	}
+	return nil
+}
+
+// FindActive returns every reservation still unexpired at now.
+func (r *CreditReservationRepositoryGORM) FindActive(ctx context.Context, now time.Time) ([]*ledger.CreditReservation, error) {
+	var rows []CreditReservationModel
+	if err := r.db.WithContext(ctx).Where("expires_at > ?", now).Order("expires_at ASC").Find(&rows).Error; err != nil {
+		return nil, fmt.Errorf("failed to read credit reservations: %w", err)
+	}
+	out := make([]*ledger.CreditReservation, 0, len(rows))
+	for _, row := range rows {
+		out = append(out, &ledger.CreditReservation{
+			ID:        row.ID,
+			PlayerID:  row.PlayerID,
+			Holder:    row.Holder,
+			Amount:    row.Amount,
+			ExpiresAt: row.ExpiresAt,
+		})
+	}
+	return out, nil
+}
+
+// DeleteExpired drops every reservation expired at now.
+func (r *CreditReservationRepositoryGORM) DeleteExpired(ctx context.Context, now time.Time) error {
+	if err := r.db.WithContext(ctx).Where("expires_at <= ?", now).Delete(&CreditReservationModel{}).Error; err != nil {
+		return fmt.Errorf("failed to purge expired credit reservations: %w", err)
+	}
+	return nil
+}
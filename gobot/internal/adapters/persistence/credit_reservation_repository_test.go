@@ -0,0 +1,39 @@
+package persistence_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/andrescamacho/spacetraders-go/internal/adapters/persistence"
+	"github.com/andrescamacho/spacetraders-go/internal/domain/ledger"
+	"github.com/andrescamacho/spacetraders-go/internal/infrastructure/database"
+)
+
+// Saved reservations read back while unexpired; expired and deleted ones do not.
+func TestCreditReservationRepository_SaveFindExpire(t *testing.T) {
+	db, err := database.NewTestConnection()
+	require.NoError(t, err)
+	repo := persistence.NewCreditReservationRepository(db)
+	ctx := context.Background()
+
+	now := time.Date(2030, 1, 1, 12, 0, 0, 0, time.UTC)
+	require.NoError(t, repo.Save(ctx, &ledger.CreditReservation{ID: "live", PlayerID: 1, Holder: "trade:SHIP-1", Amount: 40_000, ExpiresAt: now.Add(time.Minute)}))
+	require.NoError(t, repo.Save(ctx, &ledger.CreditReservation{ID: "lapsed", PlayerID: 1, Holder: "arb:SHIP-2", Amount: 9_000, ExpiresAt: now.Add(-time.Minute)}))
+	require.NoError(t, repo.Save(ctx, &ledger.CreditReservation{ID: "released", PlayerID: 2, Holder: "shipyard", Amount: 1_000, ExpiresAt: now.Add(time.Minute)}))
+	require.NoError(t, repo.Delete(ctx, "released"))
+
+	active, err := repo.FindActive(ctx, now)
+	require.NoError(t, err)
+	require.Len(t, active, 1)
+	require.Equal(t, "live", active[0].ID)
+	require.Equal(t, 40_000, active[0].Amount)
+	require.Equal(t, "trade:SHIP-1", active[0].Holder)
+
+	require.NoError(t, repo.DeleteExpired(ctx, now))
+	var count int64
+	require.NoError(t, db.Model(&persistence.CreditReservationModel{}).Count(&count).Error)
+	require.EqualValues(t, 1, count)
+}
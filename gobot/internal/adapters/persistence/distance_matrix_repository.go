@@ -0,0 +1,122 @@
+package persistence
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/andrescamacho/spacetraders-go/internal/domain/routing"
+)
+
+// DistanceMatrixRepositoryGORM implements routing.DistanceMatrixRepository
+// over the system_distance_matrices table.
+type DistanceMatrixRepositoryGORM struct {
+	db *gorm.DB
+}
+
+var _ routing.DistanceMatrixRepository = (*DistanceMatrixRepositoryGORM)(nil)
+
+// NewDistanceMatrixRepository creates the GORM-backed distance matrix store.
+func NewDistanceMatrixRepository(db *gorm.DB) *DistanceMatrixRepositoryGORM {
+	return &DistanceMatrixRepositoryGORM{db: db}
+}
+
+// distanceMatrixData is the JSON layout of matrix_data. Cells are stored as
+// [distance, fuel..., seconds...] in shared.FlightMode order to keep rows short.
+type distanceMatrixData struct {
+	Waypoints []distanceMatrixWaypoint `json:"waypoints"`
+	Cells     [][][9]float64           `json:"cells"`
+}
+
+type distanceMatrixWaypoint struct {
+	Symbol string  `json:"symbol"`
+	X      float64 `json:"x"`
+	Y      float64 `json:"y"`
+}
+
+// Get returns the stored matrix, or nil when there is none.
+func (r *DistanceMatrixRepositoryGORM) Get(ctx context.Context, systemSymbol string, engineSpeed int) (*routing.DistanceMatrix, error) {
+	var row DistanceMatrixModel
+	err := r.db.WithContext(ctx).
+		Where("system_symbol = ? AND engine_speed = ?", systemSymbol, engineSpeed).
+		First(&row).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get distance matrix for %s: %w", systemSymbol, err)
+	}
+
+	var data distanceMatrixData
+	if err := json.Unmarshal([]byte(row.MatrixData), &data); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal distance matrix for %s: %w", systemSymbol, err)
+	}
+	if len(data.Cells) != len(data.Waypoints) {
+		return nil, fmt.Errorf("distance matrix for %s has %d rows for %d waypoints", systemSymbol, len(data.Cells), len(data.Waypoints))
+	}
+	waypoints := make([]routing.MatrixWaypoint, len(data.Waypoints))
+	for i, wp := range data.Waypoints {
+		waypoints[i] = routing.MatrixWaypoint{Symbol: wp.Symbol, X: wp.X, Y: wp.Y}
+	}
+	cells := make([][]routing.MatrixCell, len(data.Cells))
+	for i, row := range data.Cells {
+		if len(row) != len(data.Waypoints) {
+			return nil, fmt.Errorf("distance matrix for %s row %d has %d cells for %d waypoints", systemSymbol, i, len(row), len(data.Waypoints))
+		}
+		cells[i] = make([]routing.MatrixCell, len(row))
+		for j, packed := range row {
+			cell := routing.MatrixCell{Distance: packed[0]}
+			for mode := range cell.Fuel {
+				cell.Fuel[mode] = int(packed[1+mode])
+				cell.Seconds[mode] = int(packed[5+mode])
+			}
+			cells[i][j] = cell
+		}
+	}
+	return routing.RestoreDistanceMatrix(systemSymbol, engineSpeed, waypoints, cells), nil
+}
+
+// Save upserts matrix.
+func (r *DistanceMatrixRepositoryGORM) Save(ctx context.Context, matrix *routing.DistanceMatrix) error {
+	data := distanceMatrixData{
+		Waypoints: make([]distanceMatrixWaypoint, len(matrix.Waypoints)),
+		Cells:     make([][][9]float64, len(matrix.Cells)),
+	}
+	for i, wp := range matrix.Waypoints {
+		data.Waypoints[i] = distanceMatrixWaypoint{Symbol: wp.Symbol, X: wp.X, Y: wp.Y}
+	}
+	for i, row := range matrix.Cells {
+		data.Cells[i] = make([][9]float64, len(row))
+		for j, cell := range row {
+			packed := [9]float64{cell.Distance}
+			for mode := range cell.Fuel {
+				packed[1+mode] = float64(cell.Fuel[mode])
+				packed[5+mode] = float64(cell.Seconds[mode])
+			}
+			data.Cells[i][j] = packed
+		}
+	}
+	encoded, err := json.Marshal(data)
+	if err != nil {
+		return fmt.Errorf("failed to marshal distance matrix for %s: %w", matrix.SystemSymbol, err)
+	}
+
+	row := DistanceMatrixModel{
+		SystemSymbol: matrix.SystemSymbol,
+		EngineSpeed:  matrix.EngineSpeed,
+		MatrixData:   string(encoded),
+		UpdatedAt:    time.Now(),
+	}
+	if err := r.db.WithContext(ctx).Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "system_symbol"}, {Name: "engine_speed"}},
+		DoUpdates: clause.AssignmentColumns([]string{"matrix_data", "updated_at"}),
+	}).Create(&row).Error; err != nil {
+		return fmt.Errorf("failed to save distance matrix for %s: %w", matrix.SystemSymbol, err)
+	}
+	return nil
+}
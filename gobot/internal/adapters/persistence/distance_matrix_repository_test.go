@@ -0,0 +1,48 @@
+package persistence_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/andrescamacho/spacetraders-go/internal/adapters/persistence"
+	"github.com/andrescamacho/spacetraders-go/internal/domain/routing"
+	"github.com/andrescamacho/spacetraders-go/internal/domain/system"
+	"github.com/andrescamacho/spacetraders-go/internal/infrastructure/database"
+)
+
+func TestDistanceMatrixRepositoryRoundTripsAndUpserts(t *testing.T) {
+	db, err := database.NewTestConnection()
+	require.NoError(t, err)
+	repo := persistence.NewDistanceMatrixRepository(db)
+	ctx := context.Background()
+
+	missing, err := repo.Get(ctx, "X1-A", 30)
+	require.NoError(t, err)
+	require.Nil(t, missing)
+
+	waypoints := []*system.WaypointData{
+		{Symbol: "X1-A-1", X: 0, Y: 0},
+		{Symbol: "X1-A-2", X: 30, Y: 40},
+	}
+	matrix := routing.NewDistanceMatrix("X1-A", 30, waypoints)
+	require.NoError(t, repo.Save(ctx, matrix))
+
+	all := append(waypoints, &system.WaypointData{Symbol: "X1-A-3", X: 0, Y: 10})
+	grown, _ := matrix.Updated(all)
+	require.NoError(t, repo.Save(ctx, grown))
+
+	loaded, err := repo.Get(ctx, "X1-A", 30)
+	require.NoError(t, err)
+	require.Equal(t, grown.Waypoints, loaded.Waypoints)
+	require.Equal(t, grown.Cells, loaded.Cells)
+	require.True(t, loaded.Covers(30, all))
+	cell, ok := loaded.Cell("X1-A-2", "X1-A-1")
+	require.True(t, ok)
+	require.Equal(t, 50.0, cell.Distance)
+
+	other, err := repo.Get(ctx, "X1-A", 10)
+	require.NoError(t, err)
+	require.Nil(t, other, "matrices are per engine speed")
+}
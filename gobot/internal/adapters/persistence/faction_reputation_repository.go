@@ -0,0 +1,64 @@
+package persistence
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"gorm.io/gorm"
+
+	"github.com/andrescamacho/spacetraders-go/internal/domain/player"
+)
+
+// FactionReputationRepositoryGORM implements player.FactionReputationRepository
+// over the append-only faction_reputation_snapshots table.
+type FactionReputationRepositoryGORM struct {
+	db *gorm.DB
+}
+
+// NewFactionReputationRepository creates the GORM-backed reputation snapshot store.
+func NewFactionReputationRepository(db *gorm.DB) *FactionReputationRepositoryGORM {
+	return &FactionReputationRepositoryGORM{db: db}
+}
+
+// SaveSnapshot appends one row per faction in a single insert.
+func (r *FactionReputationRepositoryGORM) SaveSnapshot(ctx context.Context, playerID int, reputations []player.FactionReputation) error {
+	if len(reputations) == 0 {
+		return nil
+	}
+	rows := make([]FactionReputationSnapshotModel, 0, len(reputations))
+	for _, rep := range reputations {
+		rows = append(rows, FactionReputationSnapshotModel{
+			PlayerID:      playerID,
+			FactionSymbol: rep.FactionSymbol,
+			Reputation:    rep.Reputation,
+			CapturedAt:    rep.CapturedAt,
+		})
+	}
+	if err := r.db.WithContext(ctx).Create(&rows).Error; err != nil {
+		return fmt.Errorf("failed to save faction reputation snapshot: %w", err)
+	}
+	return nil
+}
+
+// FindSince returns the player's snapshots captured at or after since, oldest first.
+func (r *FactionReputationRepositoryGORM) FindSince(ctx context.Context, playerID int, since time.Time) ([]player.FactionReputation, error) {
+	var rows []FactionReputationSnapshotModel
+	err := r.db.WithContext(ctx).
+		Where("player_id = ? AND captured_at >= ?", playerID, since).
+		Order("captured_at ASC, id ASC").
+		Find(&rows).Error
+	if err != nil {
+		return nil, fmt.Errorf("failed to read faction reputation snapshots: %w", err)
+	}
+
+	out := make([]player.FactionReputation, 0, len(rows))
+	for _, row := range rows {
+		out = append(out, player.FactionReputation{
+			FactionSymbol: row.FactionSymbol,
+			Reputation:    row.Reputation,
+			CapturedAt:    row.CapturedAt,
+		})
+	}
+	return out, nil
+}
@@ -0,0 +1,46 @@
+package persistence_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/andrescamacho/spacetraders-go/internal/adapters/persistence"
+	"github.com/andrescamacho/spacetraders-go/internal/domain/player"
+	"github.com/andrescamacho/spacetraders-go/internal/infrastructure/database"
+)
+
+// Snapshots read back oldest first, scoped to the player and the since window.
+func TestFactionReputationRepository_SavesAndReadsWindow(t *testing.T) {
+	db, err := database.NewTestConnection()
+	require.NoError(t, err)
+	repo := persistence.NewFactionReputationRepository(db)
+	ctx := context.Background()
+
+	base := time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC)
+	require.NoError(t, repo.SaveSnapshot(ctx, 1, []player.FactionReputation{
+		{FactionSymbol: "COSMIC", Reputation: 10, CapturedAt: base},
+	}))
+	require.NoError(t, repo.SaveSnapshot(ctx, 1, []player.FactionReputation{
+		{FactionSymbol: "COSMIC", Reputation: 25, CapturedAt: base.Add(2 * time.Hour)},
+		{FactionSymbol: "VOID", Reputation: 3, CapturedAt: base.Add(2 * time.Hour)},
+	}))
+	require.NoError(t, repo.SaveSnapshot(ctx, 2, []player.FactionReputation{
+		{FactionSymbol: "COSMIC", Reputation: 99, CapturedAt: base.Add(2 * time.Hour)},
+	}))
+
+	all, err := repo.FindSince(ctx, 1, time.Time{})
+	require.NoError(t, err)
+	require.Len(t, all, 3)
+	require.Equal(t, 10, all[0].Reputation, "oldest first")
+	require.True(t, all[0].CapturedAt.Equal(base))
+
+	recent, err := repo.FindSince(ctx, 1, base.Add(time.Hour))
+	require.NoError(t, err)
+	require.Len(t, recent, 2)
+	for _, rep := range recent {
+		require.NotEqual(t, 99, rep.Reputation, "other players' snapshots must not leak")
+	}
+}
@@ -0,0 +1,64 @@
+package persistence
+
+import (
+	"context"
+	"fmt"
+
+	"gorm.io/gorm"
+
+	"github.com/andrescamacho/spacetraders-go/internal/domain/navigation"
+	"github.com/andrescamacho/spacetraders-go/internal/domain/shared"
+)
+
+// FuelObservationRepositoryGORM implements navigation.FuelObservationRepository
+// over the append-only fuel_observations table.
+type FuelObservationRepositoryGORM struct {
+	db *gorm.DB
+}
+
+// NewFuelObservationRepository creates the GORM-backed fuel observation store.
+func NewFuelObservationRepository(db *gorm.DB) *FuelObservationRepositoryGORM {
+	return &FuelObservationRepositoryGORM{db: db}
+}
+
+// Record appends one observation.
+func (r *FuelObservationRepositoryGORM) Record(ctx context.Context, observation navigation.FuelObservation) error {
+	row := FuelObservationModel{
+		ShipSymbol: observation.ShipSymbol,
+		FlightMode: observation.Mode.Name(),
+		Distance:   observation.Distance,
+		Predicted:  observation.Predicted,
+		Actual:     observation.Actual,
+		ObservedAt: observation.ObservedAt,
+	}
+	if err := r.db.WithContext(ctx).Create(&row).Error; err != nil {
+		return fmt.Errorf("failed to record fuel observation: %w", err)
+	}
+	return nil
+}
+
+// FindRecent returns up to limit observations of mode, newest first.
+func (r *FuelObservationRepositoryGORM) FindRecent(ctx context.Context, mode shared.FlightMode, limit int) ([]navigation.FuelObservation, error) {
+	var rows []FuelObservationModel
+	err := r.db.WithContext(ctx).
+		Where("flight_mode = ?", mode.Name()).
+		Order("observed_at DESC, id DESC").
+		Limit(limit).
+		Find(&rows).Error
+	if err != nil {
+		return nil, fmt.Errorf("failed to read fuel observations: %w", err)
+	}
+
+	out := make([]navigation.FuelObservation, 0, len(rows))
+	for _, row := range rows {
+		out = append(out, navigation.FuelObservation{
+			ShipSymbol: row.ShipSymbol,
+			Mode:       mode,
+			Distance:   row.Distance,
+			Predicted:  row.Predicted,
+			Actual:     row.Actual,
+			ObservedAt: row.ObservedAt,
+		})
+	}
+	return out, nil
+}
@@ -0,0 +1,42 @@
+package persistence_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/andrescamacho/spacetraders-go/internal/adapters/persistence"
+	"github.com/andrescamacho/spacetraders-go/internal/domain/navigation"
+	"github.com/andrescamacho/spacetraders-go/internal/domain/shared"
+	"github.com/andrescamacho/spacetraders-go/internal/infrastructure/database"
+)
+
+// Observations read back newest first, scoped to one flight mode and capped at limit.
+func TestFuelObservationRepository_FindRecentByMode(t *testing.T) {
+	db, err := database.NewTestConnection()
+	require.NoError(t, err)
+	repo := persistence.NewFuelObservationRepository(db)
+	ctx := context.Background()
+
+	base := time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC)
+	for i, actual := range []int{40, 42, 44} {
+		require.NoError(t, repo.Record(ctx, navigation.FuelObservation{
+			ShipSymbol: "TORWIND-1", Mode: shared.FlightModeCruise, Distance: 40.2,
+			Predicted: 41, Actual: actual, ObservedAt: base.Add(time.Duration(i) * time.Minute),
+		}))
+	}
+	require.NoError(t, repo.Record(ctx, navigation.FuelObservation{
+		ShipSymbol: "TORWIND-1", Mode: shared.FlightModeBurn, Distance: 40.2,
+		Predicted: 81, Actual: 80, ObservedAt: base.Add(time.Hour),
+	}))
+
+	recent, err := repo.FindRecent(ctx, shared.FlightModeCruise, 2)
+	require.NoError(t, err)
+	require.Len(t, recent, 2)
+	require.Equal(t, 44, recent[0].Actual, "newest first")
+	require.Equal(t, 42, recent[1].Actual)
+	require.Equal(t, shared.FlightModeCruise, recent[0].Mode)
+	require.InDelta(t, 40.2, recent[0].Distance, 1e-9)
+}
@@ -187,6 +187,7 @@ func (r *GormManufacturingTaskRepository) FindIncomplete(ctx context.Context, pl
 		Where("player_id = ? AND status NOT IN ?", playerID, []string{
 			string(manufacturing.TaskStatusCompleted),
 			string(manufacturing.TaskStatusFailed),
+			string(manufacturing.TaskStatusDeadLetter),
 		}).
 		Order("priority DESC, created_at ASC").
 		Find(&models)
@@ -427,11 +428,12 @@ func (r *GormManufacturingTaskRepository) modelToTask(m *ManufacturingTaskModel,
 // ExistsLiquidateForShipAndGood checks if an incomplete LIQUIDATE task already exists for ship+good
 func (r *GormManufacturingTaskRepository) ExistsLiquidateForShipAndGood(ctx context.Context, shipSymbol string, good string, playerID int) (bool, error) {
 	// Check for existing incomplete LIQUIDATE task
-	// An "incomplete" task is one that is not COMPLETED, CANCELLED, or FAILED
+	// An "incomplete" task is one that is not COMPLETED, CANCELLED, FAILED, or DEAD_LETTER
 	terminalStatuses := []string{
 		string(manufacturing.TaskStatusCompleted),
 		string(manufacturing.TaskStatusCancelled),
 		string(manufacturing.TaskStatusFailed),
+		string(manufacturing.TaskStatusDeadLetter),
 	}
 
 	var count int64
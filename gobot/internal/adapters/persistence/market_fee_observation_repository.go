@@ -0,0 +1,64 @@
+package persistence
+
+import (
+	"context"
+	"fmt"
+
+	"gorm.io/gorm"
+
+	"github.com/andrescamacho/spacetraders-go/internal/domain/trading"
+)
+
+// MarketFeeObservationRepositoryGORM implements trading.MarketFeeRepository
+// over the append-only market_fee_observations table.
+type MarketFeeObservationRepositoryGORM struct {
+	db *gorm.DB
+}
+
+// NewMarketFeeObservationRepository creates the GORM-backed fee observation store.
+func NewMarketFeeObservationRepository(db *gorm.DB) *MarketFeeObservationRepositoryGORM {
+	return &MarketFeeObservationRepositoryGORM{db: db}
+}
+
+// Record appends one observation.
+func (r *MarketFeeObservationRepositoryGORM) Record(ctx context.Context, observation trading.MarketFeeObservation) error {
+	row := MarketFeeObservationModel{
+		WaypointSymbol:  observation.Waypoint,
+		GoodSymbol:      observation.Good,
+		TransactionType: observation.TransactionType,
+		Units:           observation.Units,
+		Expected:        observation.Expected,
+		Actual:          observation.Actual,
+		ObservedAt:      observation.ObservedAt,
+	}
+	if err := r.db.WithContext(ctx).Create(&row).Error; err != nil {
+		return fmt.Errorf("failed to record market fee observation: %w", err)
+	}
+	return nil
+}
+
+// FindRecent returns up to limit observations across every market, newest first.
+func (r *MarketFeeObservationRepositoryGORM) FindRecent(ctx context.Context, limit int) ([]trading.MarketFeeObservation, error) {
+	var rows []MarketFeeObservationModel
+	err := r.db.WithContext(ctx).
+		Order("observed_at DESC, id DESC").
+		Limit(limit).
+		Find(&rows).Error
+	if err != nil {
+		return nil, fmt.Errorf("failed to read market fee observations: %w", err)
+	}
+
+	out := make([]trading.MarketFeeObservation, 0, len(rows))
+	for _, row := range rows {
+		out = append(out, trading.MarketFeeObservation{
+			Waypoint:        row.WaypointSymbol,
+			Good:            row.GoodSymbol,
+			TransactionType: row.TransactionType,
+			Units:           row.Units,
+			Expected:        row.Expected,
+			Actual:          row.Actual,
+			ObservedAt:      row.ObservedAt,
+		})
+	}
+	return out, nil
+}
@@ -0,0 +1,37 @@
+package persistence_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/andrescamacho/spacetraders-go/internal/adapters/persistence"
+	"github.com/andrescamacho/spacetraders-go/internal/domain/trading"
+	"github.com/andrescamacho/spacetraders-go/internal/infrastructure/database"
+)
+
+// Observations from every market read back newest first, capped at limit.
+func TestMarketFeeObservationRepository_FindRecent(t *testing.T) {
+	db, err := database.NewTestConnection()
+	require.NoError(t, err)
+	repo := persistence.NewMarketFeeObservationRepository(db)
+	ctx := context.Background()
+
+	base := time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC)
+	for i, waypoint := range []string{"X1-A1", "X1-B2", "X1-A1"} {
+		require.NoError(t, repo.Record(ctx, trading.MarketFeeObservation{
+			Waypoint: waypoint, Good: "IRON_ORE", TransactionType: trading.FeeTransactionPurchase,
+			Units: 20, Expected: 1000, Actual: 1000 + 10*i, ObservedAt: base.Add(time.Duration(i) * time.Minute),
+		}))
+	}
+
+	recent, err := repo.FindRecent(ctx, 2)
+	require.NoError(t, err)
+	require.Len(t, recent, 2)
+	require.Equal(t, 1020, recent[0].Actual, "newest first")
+	require.Equal(t, "X1-B2", recent[1].Waypoint)
+	require.Equal(t, trading.FeeTransactionPurchase, recent[0].TransactionType)
+	require.Equal(t, 20, recent[0].Units)
+}
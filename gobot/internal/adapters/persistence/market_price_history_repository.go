@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/andrescamacho/spacetraders-go/internal/domain/backtest"
 	"github.com/andrescamacho/spacetraders-go/internal/domain/market"
 	"github.com/andrescamacho/spacetraders-go/internal/domain/shared"
 	"gorm.io/gorm"
@@ -85,6 +86,68 @@ func (r *GormMarketPriceHistoryRepository) GetPriceHistory(
 	return histories, nil
 }
 
+// ListSystemPriceHistory returns every price change a player recorded in a
+// system between since and until (inclusive), oldest first, as backtest
+// snapshots.
+func (r *GormMarketPriceHistoryRepository) ListSystemPriceHistory(
+	ctx context.Context,
+	playerID int,
+	systemSymbol string,
+	since, until time.Time,
+) ([]backtest.PriceSnapshot, error) {
+	var models []MarketPriceHistoryModel
+	result := r.db.WithContext(ctx).
+		Where("player_id = ? AND waypoint_symbol LIKE ?", playerID, systemSymbol+"-%").
+		Where("recorded_at >= ? AND recorded_at <= ?", since, until).
+		Order("recorded_at ASC, id ASC").
+		Find(&models)
+	if result.Error != nil {
+		return nil, fmt.Errorf("failed to list system price history: %w", result.Error)
+	}
+
+	snapshots := make([]backtest.PriceSnapshot, 0, len(models))
+	for _, model := range models {
+		snapshots = append(snapshots, backtest.PriceSnapshot{
+			WaypointSymbol: model.WaypointSymbol,
+			GoodSymbol:     model.GoodSymbol,
+			Bid:            model.PurchasePrice,
+			Ask:            model.SellPrice,
+			TradeVolume:    model.TradeVolume,
+			RecordedAt:     model.RecordedAt,
+		})
+	}
+	return snapshots, nil
+}
+
+// ListSystemPriceChanges returns every price change a player recorded in a
+// system since the given time, oldest first, in one query.
+func (r *GormMarketPriceHistoryRepository) ListSystemPriceChanges(
+	ctx context.Context,
+	playerID int,
+	systemSymbol string,
+	since time.Time,
+) ([]*market.MarketPriceHistory, error) {
+	var models []MarketPriceHistoryModel
+	result := r.db.WithContext(ctx).
+		Where("player_id = ? AND waypoint_symbol LIKE ?", playerID, systemSymbol+"-%").
+		Where("recorded_at >= ?", since).
+		Order("recorded_at ASC, id ASC").
+		Find(&models)
+	if result.Error != nil {
+		return nil, fmt.Errorf("failed to list system price changes: %w", result.Error)
+	}
+
+	histories := make([]*market.MarketPriceHistory, 0, len(models))
+	for _, model := range models {
+		history, err := r.modelToHistory(&model)
+		if err != nil {
+			return nil, fmt.Errorf("failed to convert model to history: %w", err)
+		}
+		histories = append(histories, history)
+	}
+	return histories, nil
+}
+
 // GetVolatilityMetrics calculates price volatility statistics for a good
 // Returns mean price, std deviation, max price change %, and change frequency
 func (r *GormMarketPriceHistoryRepository) GetVolatilityMetrics(
@@ -62,3 +62,58 @@ func TestGetPriceHistory_LegacyRowsWithNullTierReadBackCleanly(t *testing.T) {
 	require.Nil(t, got[0].Supply())
 	require.Nil(t, got[0].Activity())
 }
+
+func TestListSystemPriceHistory_ScopesToPlayerSystemAndWindow(t *testing.T) {
+	db, err := database.NewTestConnection()
+	require.NoError(t, err)
+	seedPlayer(t, db, 1, "TEST-AGENT")
+	seedPlayer(t, db, 2, "OTHER-AGENT")
+
+	base := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+	rows := []persistence.MarketPriceHistoryModel{
+		{WaypointSymbol: "X1-NK36-D39", GoodSymbol: "IRON", PlayerID: 1, PurchasePrice: 40, SellPrice: 45, TradeVolume: 60, RecordedAt: base.Add(time.Hour)},
+		{WaypointSymbol: "X1-NK36-A1", GoodSymbol: "IRON", PlayerID: 1, PurchasePrice: 30, SellPrice: 35, TradeVolume: 60, RecordedAt: base},
+		{WaypointSymbol: "X1-NK36-A1", GoodSymbol: "IRON", PlayerID: 1, PurchasePrice: 31, SellPrice: 36, TradeVolume: 60, RecordedAt: base.Add(-time.Hour)},
+		{WaypointSymbol: "X1-OTHER-A1", GoodSymbol: "IRON", PlayerID: 1, PurchasePrice: 30, SellPrice: 35, TradeVolume: 60, RecordedAt: base},
+		{WaypointSymbol: "X1-NK36-A1", GoodSymbol: "IRON", PlayerID: 2, PurchasePrice: 30, SellPrice: 35, TradeVolume: 60, RecordedAt: base},
+	}
+	for i := range rows {
+		require.NoError(t, db.Create(&rows[i]).Error)
+	}
+
+	repo := persistence.NewGormMarketPriceHistoryRepository(db)
+	got, err := repo.ListSystemPriceHistory(context.Background(), 1, "X1-NK36", base, base.Add(2*time.Hour))
+	require.NoError(t, err)
+	require.Len(t, got, 2)
+	require.Equal(t, "X1-NK36-A1", got[0].WaypointSymbol)
+	require.Equal(t, 30, got[0].Bid)
+	require.Equal(t, 35, got[0].Ask)
+	require.Equal(t, "X1-NK36-D39", got[1].WaypointSymbol)
+}
+
+// ListSystemPriceChanges reads a whole system's window in one query, oldest
+// first, leaving other systems and older changes out.
+func TestListSystemPriceChanges_ScopesToSystemAndWindow(t *testing.T) {
+	db, err := database.NewTestConnection()
+	require.NoError(t, err)
+	repo := persistence.NewGormMarketPriceHistoryRepository(db)
+	seedPlayer(t, db, 1, "TEST-AGENT")
+	playerID := shared.MustNewPlayerID(1)
+
+	now := time.Now().UTC().Truncate(time.Second)
+	record := func(waypoint, good string, bid int, at time.Time) {
+		history, err := market.NewMarketPriceHistoryWithID(0, waypoint, good, playerID, bid, bid+10, nil, nil, 20, at)
+		require.NoError(t, err)
+		require.NoError(t, repo.RecordPriceChange(context.Background(), history))
+	}
+	record("X1-AA-B2", "IRON", 30, now.Add(-2*time.Hour))
+	record("X1-AA-A1", "COPPER", 20, now.Add(-3*time.Hour))
+	record("X1-AA-A1", "IRON", 10, now.Add(-48*time.Hour))
+	record("X1-BB-A1", "IRON", 40, now.Add(-time.Hour))
+
+	got, err := repo.ListSystemPriceChanges(context.Background(), 1, "X1-AA", now.Add(-24*time.Hour))
+	require.NoError(t, err)
+	require.Len(t, got, 2)
+	require.Equal(t, "COPPER", got[0].GoodSymbol())
+	require.Equal(t, "X1-AA-B2", got[1].WaypointSymbol())
+}
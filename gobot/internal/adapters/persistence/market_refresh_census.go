@@ -0,0 +1,134 @@
+package persistence
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	domainScouting "github.com/andrescamacho/spacetraders-go/internal/domain/scouting"
+)
+
+// refreshArbitrageOperations and refreshManufacturingOperations classify the ledger's
+// normalized operation_type values into the two usage kinds the refresh scheduler weighs.
+// Unlisted operations (contract, refuel-only, liquidation...) add no importance.
+var (
+	refreshArbitrageOperations     = map[string]bool{"arb_run": true, "trade_route": true, "tour": true}
+	refreshManufacturingOperations = map[string]bool{"manufacturing": true, "factory_workflow": true, "construction_supply": true}
+)
+
+// refreshActiveTaskStatuses are the manufacturing task states whose markets are still
+// going to be traded at; terminal tasks no longer make a market important.
+var refreshActiveTaskStatuses = []string{"PENDING", "READY", "ASSIGNED", "EXECUTING"}
+
+// MarketRefreshCandidates returns the refresh census for one system: each scanned market's
+// age at now (latest scan across its goods, as SystemsFreshness collapses it), plus its
+// usage since usageSince. Arbitrage and manufacturing uses are counted from PURCHASE_CARGO /
+// SELL_CARGO ledger rows whose metadata names the market, and every active manufacturing
+// task sourcing from, selling to, or feeding a factory at the market adds one manufacturing
+// use. The metadata is decoded in Go rather than with a JSON operator so the read is
+// dialect-agnostic (the test harness is SQLite).
+func (r *MarketRepositoryGORM) MarketRefreshCandidates(
+	ctx context.Context,
+	playerID int,
+	systemSymbol string,
+	now, usageSince time.Time,
+) ([]domainScouting.MarketRefreshCandidate, error) {
+	var scans []struct {
+		WaypointSymbol string
+		LastUpdated    time.Time
+	}
+	err := r.db.WithContext(ctx).
+		Table(marketDataTable).
+		Select("waypoint_symbol, last_updated").
+		Where("player_id = ? AND waypoint_symbol LIKE ?", playerID, systemSymbol+"-%").
+		Scan(&scans).Error
+	if err != nil {
+		return nil, fmt.Errorf("failed to read market scan ages: %w", err)
+	}
+
+	// Collapse per-(waypoint,good) rows to the market's latest scan in code rather than
+	// with MAX(), which SQLite hands back as text.
+	latest := make(map[string]time.Time, len(scans))
+	order := make([]string, 0, len(scans))
+	for _, scan := range scans {
+		seen, ok := latest[scan.WaypointSymbol]
+		if !ok {
+			order = append(order, scan.WaypointSymbol)
+		}
+		if !ok || scan.LastUpdated.After(seen) {
+			latest[scan.WaypointSymbol] = scan.LastUpdated
+		}
+	}
+	byWaypoint := make(map[string]*domainScouting.MarketRefreshCandidate, len(latest))
+	for waypoint, scannedAt := range latest {
+		byWaypoint[waypoint] = &domainScouting.MarketRefreshCandidate{
+			WaypointSymbol: waypoint,
+			Scanned:        true,
+			AgeSeconds:     now.Sub(scannedAt).Seconds(),
+		}
+	}
+	if len(byWaypoint) == 0 {
+		return nil, nil
+	}
+
+	var trades []struct {
+		OperationType string
+		Metadata      string
+	}
+	err = r.db.WithContext(ctx).
+		Model(&TransactionModel{}).
+		Select("operation_type, metadata").
+		Where("player_id = ? AND transaction_type IN ? AND timestamp >= ?",
+			playerID, []string{"PURCHASE_CARGO", "SELL_CARGO"}, usageSince).
+		Scan(&trades).Error
+	if err != nil {
+		return nil, fmt.Errorf("failed to read market trading usage: %w", err)
+	}
+	for _, trade := range trades {
+		arbitrage := refreshArbitrageOperations[trade.OperationType]
+		if !arbitrage && !refreshManufacturingOperations[trade.OperationType] {
+			continue
+		}
+		var metadata struct {
+			Waypoint string `json:"waypoint"`
+		}
+		if json.Unmarshal([]byte(trade.Metadata), &metadata) != nil {
+			continue
+		}
+		candidate := byWaypoint[metadata.Waypoint]
+		if candidate == nil {
+			continue
+		}
+		if arbitrage {
+			candidate.ArbitrageUses++
+		} else {
+			candidate.ManufacturingUses++
+		}
+	}
+
+	var tasks []ManufacturingTaskModel
+	err = r.db.WithContext(ctx).
+		Select("source_market, target_market, factory_symbol").
+		Where("player_id = ? AND status IN ?", playerID, refreshActiveTaskStatuses).
+		Find(&tasks).Error
+	if err != nil {
+		return nil, fmt.Errorf("failed to read manufacturing task markets: %w", err)
+	}
+	for _, task := range tasks {
+		for _, market := range []*string{task.SourceMarket, task.TargetMarket, task.FactorySymbol} {
+			if market == nil {
+				continue
+			}
+			if candidate := byWaypoint[*market]; candidate != nil {
+				candidate.ManufacturingUses++
+			}
+		}
+	}
+
+	out := make([]domainScouting.MarketRefreshCandidate, 0, len(order))
+	for _, waypoint := range order {
+		out = append(out, *byWaypoint[waypoint])
+	}
+	return out, nil
+}
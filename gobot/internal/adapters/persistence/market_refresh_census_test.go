@@ -0,0 +1,85 @@
+package persistence_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/andrescamacho/spacetraders-go/internal/adapters/persistence"
+	domainScouting "github.com/andrescamacho/spacetraders-go/internal/domain/scouting"
+	"github.com/andrescamacho/spacetraders-go/internal/infrastructure/database"
+)
+
+// MarketRefreshCandidates collapses per-good rows to one age per market in the system,
+// counts arbitrage and manufacturing cargo trades inside the usage window by the
+// metadata waypoint, and adds one manufacturing use per active task touching the market.
+func TestMarketRepo_MarketRefreshCandidates(t *testing.T) {
+	db, err := database.NewTestConnection()
+	require.NoError(t, err)
+	player := persistence.PlayerModel{AgentSymbol: "SP-REFRESH", Token: "tok", CreatedAt: time.Now()}
+	require.NoError(t, db.Create(&player).Error)
+	repo := persistence.NewMarketRepository(db)
+	ctx := context.Background()
+	now := time.Now().UTC().Truncate(time.Second)
+
+	addMarket := func(waypoint, good string, scannedAt time.Time) {
+		require.NoError(t, db.Create(&persistence.MarketData{
+			WaypointSymbol: waypoint, GoodSymbol: good, PurchasePrice: 10, SellPrice: 12,
+			TradeVolume: 100, LastUpdated: scannedAt, PlayerID: player.ID,
+		}).Error)
+	}
+	addMarket("X1-RF-M1", "FUEL", now.Add(-600*time.Second))
+	addMarket("X1-RF-M1", "FOOD", now.Add(-300*time.Second))
+	addMarket("X1-RF-M2", "FUEL", now.Add(-900*time.Second))
+	addMarket("X1-OTHER-M1", "FUEL", now.Add(-900*time.Second))
+
+	trades := []struct {
+		id, txType, opType, metadata string
+		at                           time.Time
+	}{
+		{"t1", "SELL_CARGO", "arb_run", `{"waypoint":"X1-RF-M1"}`, now.Add(-time.Hour)},
+		{"t2", "PURCHASE_CARGO", "tour", `{"waypoint":"X1-RF-M1"}`, now.Add(-time.Hour)},
+		{"t3", "PURCHASE_CARGO", "factory_workflow", `{"waypoint":"X1-RF-M2"}`, now.Add(-time.Hour)},
+		{"t4", "SELL_CARGO", "arb_run", `{"waypoint":"X1-RF-M2"}`, now.Add(-48 * time.Hour)},
+		{"t5", "SELL_CARGO", "contract", `{"waypoint":"X1-RF-M2"}`, now.Add(-time.Hour)},
+		{"t6", "REFUEL", "arb_run", `{"waypoint":"X1-RF-M2"}`, now.Add(-time.Hour)},
+	}
+	for _, tr := range trades {
+		require.NoError(t, db.Create(&persistence.TransactionModel{
+			ID: tr.id, PlayerID: player.ID, Timestamp: tr.at, TransactionType: tr.txType,
+			Category: "TRADING", Amount: 1, OperationType: tr.opType, Metadata: tr.metadata,
+		}).Error)
+	}
+
+	source, target := "X1-RF-M2", "X1-RF-M1"
+	require.NoError(t, db.Create(&persistence.ManufacturingTaskModel{
+		ID: "task-active", PlayerID: player.ID, TaskType: "ACQUIRE_DELIVER", Status: "ASSIGNED",
+		Good: "IRON", SourceMarket: &source, TargetMarket: &target,
+	}).Error)
+	require.NoError(t, db.Create(&persistence.ManufacturingTaskModel{
+		ID: "task-done", PlayerID: player.ID, TaskType: "ACQUIRE_DELIVER", Status: "COMPLETED",
+		Good: "IRON", SourceMarket: &source,
+	}).Error)
+
+	got, err := repo.MarketRefreshCandidates(ctx, player.ID, "X1-RF", now, now.Add(-24*time.Hour))
+	require.NoError(t, err)
+	require.Len(t, got, 2, "only the requested system's markets")
+
+	byWaypoint := map[string]domainScouting.MarketRefreshCandidate{}
+	for _, c := range got {
+		byWaypoint[c.WaypointSymbol] = c
+	}
+
+	m1 := byWaypoint["X1-RF-M1"]
+	require.True(t, m1.Scanned)
+	require.InDelta(t, 300, m1.AgeSeconds, 1, "latest scan across the market's goods")
+	require.Equal(t, 2, m1.ArbitrageUses, "arb_run and tour trades")
+	require.Equal(t, 1, m1.ManufacturingUses, "active task target")
+
+	m2 := byWaypoint["X1-RF-M2"]
+	require.InDelta(t, 900, m2.AgeSeconds, 1)
+	require.Equal(t, 0, m2.ArbitrageUses, "out-of-window, non-cargo and contract rows ignored")
+	require.Equal(t, 2, m2.ManufacturingUses, "factory trade plus active task source")
+}
@@ -16,7 +16,8 @@ import (
 
 const marketDataTable = "market_data"
 
-// MarketRepositoryGORM implements market persistence using GORM
+// MarketRepositoryGORM implements market persistence using GORM. The finders
+// that select a market to trade at skip the player's blacklisted waypoints.
 type MarketRepositoryGORM struct {
 	db *gorm.DB
 }
@@ -199,6 +200,7 @@ func (r *MarketRepositoryGORM) FindCheapestMarketSelling(
 		Table(marketDataTable).
 		Select("waypoint_symbol, good_symbol as trade_symbol, sell_price, supply").
 		Where("player_id = ?", playerID).
+		Scopes(excludeBlacklistedWaypoints(playerID)).
 		Where("waypoint_symbol LIKE ?", systemSymbol+"-%").
 		Where("good_symbol = ?", goodSymbol).
 		Order("sell_price ASC").
@@ -247,6 +249,7 @@ func (r *MarketRepositoryGORM) FindCheapestMarketsSellingAllSystems(
 		Table(marketDataTable).
 		Select("waypoint_symbol, good_symbol as trade_symbol, sell_price, supply").
 		Where("player_id = ?", playerID).
+		Scopes(excludeBlacklistedWaypoints(playerID)).
 		Where("good_symbol = ?", goodSymbol).
 		Order("sell_price ASC").
 		Limit(limit).
@@ -290,6 +293,7 @@ func (r *MarketRepositoryGORM) FindCheapestMarketSellingWithSupply(
 		Table(marketDataTable).
 		Select("waypoint_symbol, good_symbol as trade_symbol, sell_price, supply").
 		Where("player_id = ?", playerID).
+		Scopes(excludeBlacklistedWaypoints(playerID)).
 		Where("waypoint_symbol LIKE ?", systemSymbol+"-%").
 		Where("good_symbol = ?", goodSymbol).
 		Where("supply = ?", supplyLevel).
@@ -334,6 +338,7 @@ func (r *MarketRepositoryGORM) FindBestMarketBuying(
 		Table(marketDataTable).
 		Select("waypoint_symbol, good_symbol as trade_symbol, purchase_price, supply").
 		Where("player_id = ?", playerID).
+		Scopes(excludeBlacklistedWaypoints(playerID)).
 		Where("waypoint_symbol LIKE ?", systemSymbol+"-%").
 		Where("good_symbol = ?", goodSymbol).
 		Order("purchase_price DESC").
@@ -392,6 +397,7 @@ func (r *MarketRepositoryGORM) BestSinksAcrossSystems(
 		Table(marketDataTable).
 		Select("DISTINCT ON (good_symbol) good_symbol, waypoint_symbol, purchase_price").
 		Where("player_id = ?", playerID).
+		Scopes(excludeBlacklistedWaypoints(playerID)).
 		Where("good_symbol IN ?", goods).
 		Where("last_updated >= ?", now.Add(-maxAge)).
 		Where("(trade_type IS NULL OR trade_type <> ?)", string(market.TradeTypeExport)).
@@ -663,6 +669,7 @@ func (r *MarketRepositoryGORM) FindBestMarketForBuying(
 		Table(marketDataTable).
 		Select("waypoint_symbol, good_symbol, sell_price, supply, activity, trade_type").
 		Where("player_id = ?", playerID).
+		Scopes(excludeBlacklistedWaypoints(playerID)).
 		Where("waypoint_symbol LIKE ?", systemSymbol+"-%").
 		Where("good_symbol = ?", goodSymbol).
 		Scan(&results).Error
@@ -761,6 +768,7 @@ func (r *MarketRepositoryGORM) FindMarketsTradingGood(
 		Table(marketDataTable).
 		Select("waypoint_symbol, trade_type, purchase_price, sell_price, supply, activity, trade_volume, last_updated").
 		Where("player_id = ?", playerID).
+		Scopes(excludeBlacklistedWaypoints(playerID)).
 		Where("good_symbol = ?", goodSymbol)
 
 	if systemSymbol != "" {
@@ -839,6 +847,7 @@ func (r *MarketRepositoryGORM) FindAllGoodListingsInSystem(
 		Table(marketDataTable).
 		Select("waypoint_symbol, good_symbol, trade_type, purchase_price, sell_price, supply, activity, trade_volume, last_updated").
 		Where("player_id = ?", playerID).
+		Scopes(excludeBlacklistedWaypoints(playerID)).
 		Where("waypoint_symbol LIKE ?", systemSymbol+"-%").
 		Scan(&rows).Error
 	if err != nil {
@@ -885,6 +894,7 @@ func (r *MarketRepositoryGORM) FindFactoryForGood(
 		Table(marketDataTable).
 		Select("waypoint_symbol, good_symbol, sell_price, supply, activity").
 		Where("player_id = ?", playerID).
+		Scopes(excludeBlacklistedWaypoints(playerID)).
 		Where("waypoint_symbol LIKE ?", systemSymbol+"-%").
 		Where("good_symbol = ?", goodSymbol).
 		Where("trade_type = ?", "EXPORT").
@@ -0,0 +1,69 @@
+package persistence
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"gorm.io/gorm"
+
+	"github.com/andrescamacho/spacetraders-go/internal/domain/market"
+)
+
+// MarketSupplyTransitionRepositoryGORM implements market.SupplyTransitionRepository
+// over the append-only market_supply_transitions table.
+type MarketSupplyTransitionRepositoryGORM struct {
+	db *gorm.DB
+}
+
+var _ market.SupplyTransitionRepository = (*MarketSupplyTransitionRepositoryGORM)(nil)
+
+// NewMarketSupplyTransitionRepository creates the GORM-backed supply transition store.
+func NewMarketSupplyTransitionRepository(db *gorm.DB) *MarketSupplyTransitionRepositoryGORM {
+	return &MarketSupplyTransitionRepositoryGORM{db: db}
+}
+
+// Record appends one transition.
+func (r *MarketSupplyTransitionRepositoryGORM) Record(ctx context.Context, transition market.SupplyTransition) error {
+	row := MarketSupplyTransitionModel{
+		PlayerID:       transition.PlayerID,
+		WaypointSymbol: transition.WaypointSymbol,
+		GoodSymbol:     transition.GoodSymbol,
+		FromSupply:     transition.FromSupply,
+		ToSupply:       transition.ToSupply,
+		TransitionedAt: transition.TransitionedAt,
+	}
+	if err := r.db.WithContext(ctx).Create(&row).Error; err != nil {
+		return fmt.Errorf("failed to record supply transition: %w", err)
+	}
+	return nil
+}
+
+// ListTransitions returns playerID's transitions at or after since, oldest
+// first, optionally narrowed to one market and/or good.
+func (r *MarketSupplyTransitionRepositoryGORM) ListTransitions(ctx context.Context, playerID int, waypointSymbol, goodSymbol string, since time.Time) ([]market.SupplyTransition, error) {
+	query := r.db.WithContext(ctx).Where("player_id = ? AND transitioned_at >= ?", playerID, since)
+	if waypointSymbol != "" {
+		query = query.Where("waypoint_symbol = ?", waypointSymbol)
+	}
+	if goodSymbol != "" {
+		query = query.Where("good_symbol = ?", goodSymbol)
+	}
+	var rows []MarketSupplyTransitionModel
+	if err := query.Order("transitioned_at ASC, id ASC").Find(&rows).Error; err != nil {
+		return nil, fmt.Errorf("failed to list supply transitions for player %d: %w", playerID, err)
+	}
+
+	out := make([]market.SupplyTransition, 0, len(rows))
+	for _, row := range rows {
+		out = append(out, market.SupplyTransition{
+			PlayerID:       row.PlayerID,
+			WaypointSymbol: row.WaypointSymbol,
+			GoodSymbol:     row.GoodSymbol,
+			FromSupply:     row.FromSupply,
+			ToSupply:       row.ToSupply,
+			TransitionedAt: row.TransitionedAt,
+		})
+	}
+	return out, nil
+}
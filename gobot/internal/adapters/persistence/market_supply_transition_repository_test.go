@@ -0,0 +1,40 @@
+package persistence_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/andrescamacho/spacetraders-go/internal/adapters/persistence"
+	"github.com/andrescamacho/spacetraders-go/internal/domain/market"
+	"github.com/andrescamacho/spacetraders-go/internal/infrastructure/database"
+)
+
+func TestMarketSupplyTransitionRepositoryListsByWindowAndFilter(t *testing.T) {
+	db, err := database.NewTestConnection()
+	require.NoError(t, err)
+	repo := persistence.NewMarketSupplyTransitionRepository(db)
+	ctx := context.Background()
+	start := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	for i, tr := range []market.SupplyTransition{
+		{PlayerID: 1, WaypointSymbol: "X1-A-F1", GoodSymbol: "FABRICS", FromSupply: "SCARCE", ToSupply: "MODERATE"},
+		{PlayerID: 1, WaypointSymbol: "X1-A-F1", GoodSymbol: "FABRICS", FromSupply: "MODERATE", ToSupply: "HIGH"},
+		{PlayerID: 1, WaypointSymbol: "X1-A-F2", GoodSymbol: "IRON", FromSupply: "HIGH", ToSupply: "LIMITED"},
+		{PlayerID: 2, WaypointSymbol: "X1-A-F1", GoodSymbol: "FABRICS", FromSupply: "HIGH", ToSupply: "LIMITED"},
+	} {
+		tr.TransitionedAt = start.Add(time.Duration(i) * time.Hour)
+		require.NoError(t, repo.Record(ctx, tr))
+	}
+
+	all, err := repo.ListTransitions(ctx, 1, "", "", time.Time{})
+	require.NoError(t, err)
+	require.Len(t, all, 3)
+
+	fabrics, err := repo.ListTransitions(ctx, 1, "X1-A-F1", "FABRICS", start.Add(30*time.Minute))
+	require.NoError(t, err)
+	require.Len(t, fabrics, 1)
+	require.Equal(t, "HIGH", fabrics[0].ToSupply)
+}
@@ -34,6 +34,10 @@ type WaypointModel struct {
 	Orbitals       string  `gorm:"column:orbitals;type:text"`          // JSON array as text
 	SyncedAt       string  `gorm:"column:synced_at"`                   // ISO timestamp string
 	EraID          *int    `gorm:"column:era_id"`
+	// FuelAvailability is the shared.FuelAvailability grade; '' on rows written
+	// before it existed, which re-derive it from traits.
+	FuelAvailability  string `gorm:"column:fuel_availability;not null;default:''"`
+	UnderConstruction bool   `gorm:"column:under_construction;not null;default:false"`
 }
 
 func (WaypointModel) TableName() string {
@@ -176,6 +180,15 @@ type ShipModel struct {
 	CrewRequired             int    `gorm:"column:crew_required;default:0"`
 	CrewCapacity             int    `gorm:"column:crew_capacity;default:0"`
 
+	// Component wear (0-1) from the last API sync; all zero until a ship
+	// payload carrying condition has been stored. See migration 052.
+	FrameCondition   float64 `gorm:"column:frame_condition;default:0"`
+	FrameIntegrity   float64 `gorm:"column:frame_integrity;default:0"`
+	ReactorCondition float64 `gorm:"column:reactor_condition;default:0"`
+	ReactorIntegrity float64 `gorm:"column:reactor_integrity;default:0"`
+	EngineCondition  float64 `gorm:"column:engine_condition;default:0"`
+	EngineIntegrity  float64 `gorm:"column:engine_integrity;default:0"`
+
 	// Sync metadata
 	SyncedAt time.Time `gorm:"column:synced_at;autoCreateTime"`
 	Version  int       `gorm:"column:version;default:1"`
@@ -225,6 +238,10 @@ type SystemGraphModel struct {
 	GraphData    string    `gorm:"column:graph_data;type:jsonb;not null"` // Use JSONB for PostgreSQL, falls back to TEXT for SQLite
 	CreatedAt    time.Time `gorm:"column:created_at;not null;autoCreateTime"`
 	UpdatedAt    time.Time `gorm:"column:updated_at;not null;autoUpdateTime"`
+	// InvalidatedAt is set when a server reset makes the cached graph
+	// untrustworthy. An invalidated graph reads as a cache miss, and the next
+	// Add clears it.
+	InvalidatedAt *time.Time `gorm:"column:invalidated_at"`
 }
 
 func (SystemGraphModel) TableName() string {
@@ -267,6 +284,10 @@ type ContractModel struct {
 	PaymentOnFulfilled int          `gorm:"column:payment_on_fulfilled;not null"`
 	DeliveriesJSON     string       `gorm:"column:deliveries_json;type:text;not null"`
 	LastUpdated        string       `gorm:"column:last_updated;not null"` // ISO timestamp
+	// AcceptExpired marks a negotiated contract whose accept deadline passed
+	// before it was accepted; the contract janitor sets it (column added by
+	// migration 059).
+	AcceptExpired bool `gorm:"column:accept_expired;not null;default:false"`
 }
 
 func (ContractModel) TableName() string {
@@ -360,10 +381,11 @@ type TransactionModel struct {
 	BalanceBefore     int          `gorm:"column:balance_before;not null"`
 	BalanceAfter      int          `gorm:"column:balance_after;not null"`
 	Description       string       `gorm:"column:description;type:text"`
-	Metadata          string       `gorm:"column:metadata;type:jsonb"`                           // JSON metadata
-	RelatedEntityType string       `gorm:"column:related_entity_type;index:idx_related;size:50"` // e.g., "contract", "factory"
-	RelatedEntityID   string       `gorm:"column:related_entity_id;index:idx_related;size:100"`  // ID of related entity
-	OperationType     string       `gorm:"column:operation_type;size:50"`                        // e.g., "contract", "arbitrage", "rebalancing", "factory"
+	Metadata          string       `gorm:"column:metadata;type:jsonb"`                                    // JSON metadata
+	RelatedEntityType string       `gorm:"column:related_entity_type;index:idx_related;size:50"`          // e.g., "contract", "factory"
+	RelatedEntityID   string       `gorm:"column:related_entity_id;index:idx_related;size:100"`           // ID of related entity
+	OperationType     string       `gorm:"column:operation_type;size:50"`                                 // e.g., "contract", "arbitrage", "rebalancing", "factory"
+	ContainerID       string       `gorm:"column:container_id;index:idx_transactions_container;size:100"` // Container that generated the cashflow
 	CreatedAt         time.Time    `gorm:"column:created_at;not null;autoCreateTime"`
 }
 
@@ -881,6 +903,291 @@ func (ShipyardInventoryModel) TableName() string {
 	return "shipyard_inventory"
 }
 
+// FactionReputationSnapshotModel is one faction's reputation with the agent at a
+// capture time (GET /my/factions). Rows are append-only history: the standing
+// query derives each faction's current reputation and its gain over a window from
+// them. Like TourLegTelemetryModel, player_id is a plain indexed column with no
+// players foreign key. CREATE'd by migration 044, so the column-drift gate holds
+// model and migration in lockstep.
+type FactionReputationSnapshotModel struct {
+	ID            uint      `gorm:"column:id;primaryKey;autoIncrement"`
+	PlayerID      int       `gorm:"column:player_id;not null;index:idx_faction_reputation_player_time"`
+	FactionSymbol string    `gorm:"column:faction_symbol;size:64;not null"`
+	Reputation    int       `gorm:"column:reputation;not null"`
+	CapturedAt    time.Time `gorm:"column:captured_at;not null;index:idx_faction_reputation_player_time"`
+}
+
+func (FactionReputationSnapshotModel) TableName() string {
+	return "faction_reputation_snapshots"
+}
+
+// FuelObservationModel is one navigation's predicted vs actual fuel use, the
+// input to the per-flight-mode fuel calibration fit. Append-only; CREATE'd by
+// migration 046.
+type FuelObservationModel struct {
+	ID         uint      `gorm:"column:id;primaryKey;autoIncrement"`
+	ShipSymbol string    `gorm:"column:ship_symbol;size:64;not null"`
+	FlightMode string    `gorm:"column:flight_mode;size:16;not null;index:idx_fuel_observations_mode_time"`
+	Distance   float64   `gorm:"column:distance;not null"`
+	Predicted  int       `gorm:"column:predicted;not null"`
+	Actual     int       `gorm:"column:actual;not null"`
+	ObservedAt time.Time `gorm:"column:observed_at;not null;index:idx_fuel_observations_mode_time"`
+}
+
+func (FuelObservationModel) TableName() string {
+	return "fuel_observations"
+}
+
+// ShipyardPriceSnapshotModel is one observed shipyard price for a ship type.
+// Append-only history, written by shipyard scans and live listing reads; the
+// ship price history query and buy-the-dip purchasing read it. Like
+// FactionReputationSnapshotModel, player_id is a plain indexed column with no
+// players foreign key. CREATE'd by migration 049.
+type ShipyardPriceSnapshotModel struct {
+	ID             uint      `gorm:"column:id;primaryKey;autoIncrement"`
+	PlayerID       int       `gorm:"column:player_id;not null;index:idx_shipyard_price_snapshots_type_time"`
+	SystemSymbol   string    `gorm:"column:system_symbol;size:32;not null"`
+	WaypointSymbol string    `gorm:"column:waypoint_symbol;size:64;not null"`
+	ShipType       string    `gorm:"column:ship_type;size:64;not null;index:idx_shipyard_price_snapshots_type_time"`
+	PurchasePrice  int       `gorm:"column:purchase_price;not null"`
+	Supply         string    `gorm:"column:supply;size:20;not null;default:''"`
+	ObservedAt     time.Time `gorm:"column:observed_at;not null;index:idx_shipyard_price_snapshots_type_time"`
+}
+
+func (ShipyardPriceSnapshotModel) TableName() string {
+	return "shipyard_price_snapshots"
+}
+
+// CommandAuditModel is one command dispatched through the daemon mediator,
+// written by the command audit middleware and pruned by its retention sweep.
+// player_id is a plain indexed column with no players foreign key, like the
+// other append-only histories. CREATE'd by migration 050.
+type CommandAuditModel struct {
+	ID          uint      `gorm:"column:id;primaryKey;autoIncrement"`
+	PlayerID    int       `gorm:"column:player_id;not null;default:0;index:idx_command_audit_player_time"`
+	CommandType string    `gorm:"column:command_type;size:128;not null"`
+	ShipSymbol  string    `gorm:"column:ship_symbol;size:64;not null;default:'';index:idx_command_audit_ship_time"`
+	ContainerID string    `gorm:"column:container_id;size:255;not null;default:''"`
+	Payload     string    `gorm:"column:payload;type:text;not null;default:''"`
+	StartedAt   time.Time `gorm:"column:started_at;not null;index:idx_command_audit_player_time;index:idx_command_audit_ship_time"`
+	DurationMs  int64     `gorm:"column:duration_ms;not null;default:0"`
+	Success     bool      `gorm:"column:success;not null"`
+	Error       string    `gorm:"column:error;type:text;not null;default:''"`
+}
+
+func (CommandAuditModel) TableName() string {
+	return "command_audit"
+}
+
+// ShipTagModel is one operator-defined label on a ship. A ship may carry many;
+// the composite key keeps each (player, ship, tag) unique. player_id is a plain
+// column with no players foreign key, like the append-only histories. CREATE'd
+// by migration 054.
+type ShipTagModel struct {
+	PlayerID   int       `gorm:"column:player_id;primaryKey;not null;index:idx_ship_tags_player_tag"`
+	ShipSymbol string    `gorm:"column:ship_symbol;primaryKey;size:64;not null"`
+	Tag        string    `gorm:"column:tag;primaryKey;size:64;not null;index:idx_ship_tags_player_tag"`
+	CreatedAt  time.Time `gorm:"column:created_at;not null"`
+}
+
+func (ShipTagModel) TableName() string {
+	return "ship_tags"
+}
+
+// MarketFeeObservationModel is one cargo transaction's quoted vs charged total
+// at a market, the input to the per-market fee fit. Append-only; CREATE'd by
+// migration 055.
+type MarketFeeObservationModel struct {
+	ID              uint      `gorm:"column:id;primaryKey;autoIncrement"`
+	WaypointSymbol  string    `gorm:"column:waypoint_symbol;size:64;not null"`
+	GoodSymbol      string    `gorm:"column:good_symbol;size:64;not null"`
+	TransactionType string    `gorm:"column:transaction_type;size:16;not null"`
+	Units           int       `gorm:"column:units;not null"`
+	Expected        int       `gorm:"column:expected;not null"`
+	Actual          int       `gorm:"column:actual;not null"`
+	ObservedAt      time.Time `gorm:"column:observed_at;not null;index:idx_market_fee_observations_time"`
+}
+
+func (MarketFeeObservationModel) TableName() string {
+	return "market_fee_observations"
+}
+
+// CargoCostBasisModel is the weighted-average cost of one good in one ship's
+// hold, kept current as cargo is bought, mined, moved and sold. CREATE'd by
+// migration 057.
+type CargoCostBasisModel struct {
+	PlayerID   int       `gorm:"column:player_id;primaryKey;not null"`
+	ShipSymbol string    `gorm:"column:ship_symbol;primaryKey;size:64;not null"`
+	GoodSymbol string    `gorm:"column:good_symbol;primaryKey;size:64;not null"`
+	Units      int       `gorm:"column:units;not null"`
+	TotalCost  int       `gorm:"column:total_cost;not null"`
+	UpdatedAt  time.Time `gorm:"column:updated_at;not null"`
+}
+
+func (CargoCostBasisModel) TableName() string {
+	return "cargo_cost_basis"
+}
+
+// MarketSupplyTransitionModel is one change of a good's supply level at a
+// market, as seen by consecutive scans. Append-only; CREATE'd by migration 058.
+type MarketSupplyTransitionModel struct {
+	ID             uint      `gorm:"column:id;primaryKey;autoIncrement"`
+	PlayerID       int       `gorm:"column:player_id;not null;index:idx_market_supply_transitions_player_time"`
+	WaypointSymbol string    `gorm:"column:waypoint_symbol;size:64;not null"`
+	GoodSymbol     string    `gorm:"column:good_symbol;size:64;not null"`
+	FromSupply     string    `gorm:"column:from_supply;size:16;not null"`
+	ToSupply       string    `gorm:"column:to_supply;size:16;not null"`
+	TransitionedAt time.Time `gorm:"column:transitioned_at;not null;index:idx_market_supply_transitions_player_time"`
+}
+
+func (MarketSupplyTransitionModel) TableName() string {
+	return "market_supply_transitions"
+}
+
+// TradeLaneExecutionModel is one realized trade along a (buy market, sell
+// market, good) lane, written by the arb and tour coordinators as they sell.
+// Append-only; CREATE'd by migration 060.
+type TradeLaneExecutionModel struct {
+	ID          uint      `gorm:"column:id;primaryKey;autoIncrement"`
+	PlayerID    int       `gorm:"column:player_id;not null;index:idx_trade_lane_executions_player_time"`
+	ContainerID string    `gorm:"column:container_id;size:128"`
+	Source      string    `gorm:"column:source;size:32;not null"`
+	BuyMarket   string    `gorm:"column:buy_market;size:64;not null"`
+	SellMarket  string    `gorm:"column:sell_market;size:64;not null"`
+	Good        string    `gorm:"column:good;size:64;not null"`
+	Units       int       `gorm:"column:units;not null"`
+	Cost        int       `gorm:"column:cost;not null"`
+	Revenue     int       `gorm:"column:revenue;not null"`
+	ExecutedAt  time.Time `gorm:"column:executed_at;not null;index:idx_trade_lane_executions_player_time"`
+}
+
+func (TradeLaneExecutionModel) TableName() string {
+	return "trade_lane_executions"
+}
+
+// ScheduledJobModel is one recurring daemon job with its run history, keyed by
+// (player, name). Params and LastContainerIDs are JSON text. CREATE'd by
+// migration 061.
+type ScheduledJobModel struct {
+	PlayerID         int        `gorm:"column:player_id;primaryKey;not null"`
+	Name             string     `gorm:"column:name;primaryKey;size:64;not null"`
+	Kind             string     `gorm:"column:kind;size:32;not null"`
+	IntervalSeconds  int64      `gorm:"column:interval_seconds;not null"`
+	Params           string     `gorm:"column:params;type:text"`
+	Enabled          bool       `gorm:"column:enabled;not null;default:true"`
+	LastRunAt        *time.Time `gorm:"column:last_run_at"`
+	NextRunAt        time.Time  `gorm:"column:next_run_at;not null"`
+	LastStatus       string     `gorm:"column:last_status;size:16"`
+	LastError        string     `gorm:"column:last_error;type:text"`
+	LastContainerIDs string     `gorm:"column:last_container_ids;type:text"`
+	UpdatedAt        time.Time  `gorm:"column:updated_at;not null"`
+}
+
+func (ScheduledJobModel) TableName() string {
+	return "scheduled_jobs"
+}
+
+// DistanceMatrixModel is one system's precomputed hop costs for an engine
+// speed, stored as JSON next to the system graph. CREATE'd by migration 062.
+type DistanceMatrixModel struct {
+	SystemSymbol string    `gorm:"column:system_symbol;primaryKey;size:64;not null"`
+	EngineSpeed  int       `gorm:"column:engine_speed;primaryKey;not null"`
+	MatrixData   string    `gorm:"column:matrix_data;type:text;not null"`
+	UpdatedAt    time.Time `gorm:"column:updated_at;not null"`
+}
+
+func (DistanceMatrixModel) TableName() string {
+	return "system_distance_matrices"
+}
+
+// ContractWorkflowStepModel is one step of a contract workflow run in the
+// workflow audit trail. CREATE'd by migration 063.
+type ContractWorkflowStepModel struct {
+	ID          uint      `gorm:"column:id;primaryKey;autoIncrement"`
+	PlayerID    int       `gorm:"column:player_id;not null;index:idx_contract_workflow_steps_contract,priority:1"`
+	ContractID  string    `gorm:"column:contract_id;size:255;not null;default:'';index:idx_contract_workflow_steps_contract,priority:2"`
+	ContainerID string    `gorm:"column:container_id;size:255;not null;default:''"`
+	ShipSymbol  string    `gorm:"column:ship_symbol;size:64;not null;default:''"`
+	Step        string    `gorm:"column:step;size:32;not null"`
+	Outcome     string    `gorm:"column:outcome;size:32;not null"`
+	Waypoint    string    `gorm:"column:waypoint;size:64;not null;default:''"`
+	TradeSymbol string    `gorm:"column:trade_symbol;size:64;not null;default:''"`
+	Units       int       `gorm:"column:units;not null;default:0"`
+	Credits     int       `gorm:"column:credits;not null;default:0"`
+	Detail      string    `gorm:"column:detail;type:text;not null;default:''"`
+	StartedAt   time.Time `gorm:"column:started_at;not null"`
+	FinishedAt  time.Time `gorm:"column:finished_at;not null"`
+}
+
+func (ContractWorkflowStepModel) TableName() string {
+	return "contract_workflow_steps"
+}
+
+// WaypointBlacklistModel is one blacklisted waypoint for a player. A NULL
+// ExpiresAt keeps it blacklisted until cleared. CREATE'd by migration 064.
+type WaypointBlacklistModel struct {
+	PlayerID       int        `gorm:"column:player_id;primaryKey;not null"`
+	WaypointSymbol string     `gorm:"column:waypoint_symbol;primaryKey;size:64;not null"`
+	Reason         string     `gorm:"column:reason;type:text;not null;default:''"`
+	Source         string     `gorm:"column:source;size:32;not null;default:''"`
+	CreatedAt      time.Time  `gorm:"column:created_at;not null"`
+	ExpiresAt      *time.Time `gorm:"column:expires_at"`
+}
+
+func (WaypointBlacklistModel) TableName() string {
+	return waypointBlacklistTable
+}
+
+// ShipAvailabilityWindowModel is one stretch during which a watched shipyard
+// had a ship type in stock. A NULL ClosedAt marks the open window. CREATE'd by
+// migration 065.
+type ShipAvailabilityWindowModel struct {
+	ID             int64      `gorm:"column:id;primaryKey;autoIncrement"`
+	PlayerID       int        `gorm:"column:player_id;not null;index:idx_ship_availability_windows_yard,priority:1"`
+	WaypointSymbol string     `gorm:"column:waypoint_symbol;size:64;not null;index:idx_ship_availability_windows_yard,priority:2"`
+	ShipType       string     `gorm:"column:ship_type;size:64;not null;index:idx_ship_availability_windows_yard,priority:3"`
+	OpenedAt       time.Time  `gorm:"column:opened_at;not null"`
+	LastSeenAt     time.Time  `gorm:"column:last_seen_at;not null"`
+	ClosedAt       *time.Time `gorm:"column:closed_at"`
+	OpenPrice      int        `gorm:"column:open_price;not null"`
+	MinPrice       int        `gorm:"column:min_price;not null"`
+	LastPrice      int        `gorm:"column:last_price;not null"`
+	PurchasedAt    *time.Time `gorm:"column:purchased_at"`
+}
+
+func (ShipAvailabilityWindowModel) TableName() string {
+	return "ship_availability_windows"
+}
+
+// ShipCommandIdempotencyModel is the finished result of one keyed navigation
+// command, kept for replay across restarts. CREATE'd by migration 066.
+type ShipCommandIdempotencyModel struct {
+	Scope          string    `gorm:"column:scope;primaryKey;size:32;not null;index:idx_ship_command_idempotency_recorded,priority:1"`
+	IdempotencyKey string    `gorm:"column:idempotency_key;primaryKey;size:128;not null"`
+	ShipSymbol     string    `gorm:"column:ship_symbol;size:64;not null"`
+	Destination    string    `gorm:"column:destination;size:64;not null"`
+	Response       string    `gorm:"column:response;type:text;not null"`
+	RecordedAt     time.Time `gorm:"column:recorded_at;not null;index:idx_ship_command_idempotency_recorded,priority:2"`
+}
+
+func (ShipCommandIdempotencyModel) TableName() string {
+	return "ship_command_idempotency"
+}
+
+// CreditReservationModel is credits a coordinator has earmarked for an imminent
+// purchase, held until released or ExpiresAt. CREATE'd by migration 067.
+type CreditReservationModel struct {
+	ID        string    `gorm:"column:id;primaryKey;size:64;not null"`
+	PlayerID  int       `gorm:"column:player_id;not null"`
+	Holder    string    `gorm:"column:holder;size:128;not null;default:''"`
+	Amount    int       `gorm:"column:amount;not null"`
+	ExpiresAt time.Time `gorm:"column:expires_at;not null;index:idx_credit_reservations_expires"`
+}
+
+func (CreditReservationModel) TableName() string {
+	return "credit_reservations"
+}
+
 // AllModels is the single canonical registry of every persisted model struct.
 // AutoMigrate and any test/tooling that needs the full model set must consume
 // this slice instead of maintaining a parallel hand-written list, so newly
@@ -916,5 +1223,21 @@ func AllModels() []any {
 		&WarehouseStockingModel{},
 		&ShipyardInventoryModel{},
 		&SystemCoordModel{},
+		&FactionReputationSnapshotModel{},
+		&FuelObservationModel{},
+		&ShipyardPriceSnapshotModel{},
+		&CommandAuditModel{},
+		&ShipTagModel{},
+		&MarketFeeObservationModel{},
+		&CargoCostBasisModel{},
+		&MarketSupplyTransitionModel{},
+		&TradeLaneExecutionModel{},
+		&ScheduledJobModel{},
+		&DistanceMatrixModel{},
+		&ContractWorkflowStepModel{},
+		&WaypointBlacklistModel{},
+		&ShipAvailabilityWindowModel{},
+		&ShipCommandIdempotencyModel{},
+		&CreditReservationModel{},
 	}
 }
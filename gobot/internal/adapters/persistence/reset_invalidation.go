@@ -0,0 +1,64 @@
+package persistence
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// marketInvalidatedAt is the last_updated a reset backdates market rows to: far
+// enough in the past that every freshness check treats the row as stale and
+// rescans the market before trading on it.
+var marketInvalidatedAt = time.Unix(0, 0).UTC()
+
+// ResetInvalidation counts the cached rows a server reset flagged.
+type ResetInvalidation struct {
+	SystemGraphs int64
+	MarketRows   int64
+}
+
+// ResetCacheInvalidator flags the caches a server reset makes untrustworthy.
+// Unlike CloseEra it deletes nothing: graphs are stamped invalidated_at (a
+// cache miss until rebuilt) and the player's market rows are backdated so they
+// read as stale, leaving the rows in place for the era's history.
+type ResetCacheInvalidator struct {
+	db *gorm.DB
+}
+
+// NewResetCacheInvalidator creates a new ResetCacheInvalidator.
+func NewResetCacheInvalidator(db *gorm.DB) *ResetCacheInvalidator {
+	return &ResetCacheInvalidator{db: db}
+}
+
+// InvalidateCaches flags every cached system graph and the player's market
+// rows, in one transaction. Rows already flagged are left alone, so a repeated
+// call reports zero.
+func (r *ResetCacheInvalidator) InvalidateCaches(ctx context.Context, playerID int) (*ResetInvalidation, error) {
+	report := &ResetInvalidation{}
+	now := time.Now().UTC()
+
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		res := tx.Model(&SystemGraphModel{}).
+			Where("invalidated_at IS NULL").
+			Update("invalidated_at", now)
+		if res.Error != nil {
+			return fmt.Errorf("failed to invalidate system graphs: %w", res.Error)
+		}
+		report.SystemGraphs = res.RowsAffected
+
+		res = tx.Model(&MarketData{}).
+			Where("player_id = ? AND last_updated > ?", playerID, marketInvalidatedAt).
+			Update("last_updated", marketInvalidatedAt)
+		if res.Error != nil {
+			return fmt.Errorf("failed to invalidate market data: %w", res.Error)
+		}
+		report.MarketRows = res.RowsAffected
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return report, nil
+}
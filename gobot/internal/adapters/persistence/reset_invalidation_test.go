@@ -0,0 +1,51 @@
+package persistence_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/andrescamacho/spacetraders-go/internal/adapters/persistence"
+	"github.com/andrescamacho/spacetraders-go/internal/domain/system"
+	"github.com/andrescamacho/spacetraders-go/internal/infrastructure/database"
+)
+
+func TestInvalidateCachesFlagsGraphsAndThePlayersMarkets(t *testing.T) {
+	db, err := database.NewTestConnection()
+	require.NoError(t, err)
+	ctx := context.Background()
+
+	require.NoError(t, db.Create(&persistence.PlayerModel{ID: 1, AgentSymbol: "TORWIND", Token: "t", CreatedAt: time.Now()}).Error)
+	require.NoError(t, db.Create(&persistence.PlayerModel{ID: 2, AgentSymbol: "ORION", Token: "", CreatedAt: time.Now()}).Error)
+	scannedAt := time.Now().UTC().Add(-time.Hour)
+	require.NoError(t, db.Create(&persistence.MarketData{WaypointSymbol: "X1-A1", GoodSymbol: "IRON", LastUpdated: scannedAt, PlayerID: 1}).Error)
+	require.NoError(t, db.Create(&persistence.MarketData{WaypointSymbol: "X1-A1", GoodSymbol: "COPPER", LastUpdated: scannedAt, PlayerID: 2}).Error)
+
+	graphs := persistence.NewGormSystemGraphRepository(db)
+	require.NoError(t, graphs.Add(ctx, "X1-A", &system.NavigationGraph{}))
+
+	invalidator := persistence.NewResetCacheInvalidator(db)
+	report, err := invalidator.InvalidateCaches(ctx, 1)
+	require.NoError(t, err)
+	require.Equal(t, int64(1), report.SystemGraphs)
+	require.Equal(t, int64(1), report.MarketRows)
+
+	cached, err := graphs.Get(ctx, "X1-A")
+	require.NoError(t, err)
+	require.Nil(t, cached, "an invalidated graph must read as a cache miss")
+
+	var other persistence.MarketData
+	require.NoError(t, db.Where("player_id = ?", 2).First(&other).Error)
+	require.WithinDuration(t, scannedAt, other.LastUpdated, time.Second, "another player's markets are left alone")
+
+	again, err := invalidator.InvalidateCaches(ctx, 1)
+	require.NoError(t, err)
+	require.Zero(t, again.SystemGraphs+again.MarketRows)
+
+	require.NoError(t, graphs.Add(ctx, "X1-A", &system.NavigationGraph{}))
+	cached, err = graphs.Get(ctx, "X1-A")
+	require.NoError(t, err)
+	require.NotNil(t, cached, "rebuilding the graph clears its invalidation")
+}
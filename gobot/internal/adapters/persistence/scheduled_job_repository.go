@@ -0,0 +1,132 @@
+package persistence
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/andrescamacho/spacetraders-go/internal/domain/daemon"
+)
+
+// ScheduledJobRepositoryGORM implements daemon.ScheduledJobRepository over the
+// scheduled_jobs table.
+type ScheduledJobRepositoryGORM struct {
+	db *gorm.DB
+}
+
+var _ daemon.ScheduledJobRepository = (*ScheduledJobRepositoryGORM)(nil)
+
+// NewScheduledJobRepository creates the GORM-backed scheduled job store.
+func NewScheduledJobRepository(db *gorm.DB) *ScheduledJobRepositoryGORM {
+	return &ScheduledJobRepositoryGORM{db: db}
+}
+
+// Save inserts the job or overwrites the row with the same player and name.
+func (r *ScheduledJobRepositoryGORM) Save(ctx context.Context, job *daemon.ScheduledJob) error {
+	row, err := scheduledJobToModel(job)
+	if err != nil {
+		return err
+	}
+	if err := r.db.WithContext(ctx).Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "player_id"}, {Name: "name"}},
+		UpdateAll: true,
+	}).Create(&row).Error; err != nil {
+		return fmt.Errorf("failed to save scheduled job %s: %w", job.Name, err)
+	}
+	return nil
+}
+
+// FindByName returns the named job, or nil when there is none.
+func (r *ScheduledJobRepositoryGORM) FindByName(ctx context.Context, playerID int, name string) (*daemon.ScheduledJob, error) {
+	var row ScheduledJobModel
+	err := r.db.WithContext(ctx).Where("player_id = ? AND name = ?", playerID, name).First(&row).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to find scheduled job %s: %w", name, err)
+	}
+	return scheduledJobFromModel(row)
+}
+
+// List returns playerID's jobs in name order.
+func (r *ScheduledJobRepositoryGORM) List(ctx context.Context, playerID int) ([]*daemon.ScheduledJob, error) {
+	var rows []ScheduledJobModel
+	if err := r.db.WithContext(ctx).Where("player_id = ?", playerID).Order("name").Find(&rows).Error; err != nil {
+		return nil, fmt.Errorf("failed to list scheduled jobs for player %d: %w", playerID, err)
+	}
+	jobs := make([]*daemon.ScheduledJob, 0, len(rows))
+	for _, row := range rows {
+		job, err := scheduledJobFromModel(row)
+		if err != nil {
+			return nil, err
+		}
+		jobs = append(jobs, job)
+	}
+	return jobs, nil
+}
+
+// Delete removes the named job, reporting whether a row was removed.
+func (r *ScheduledJobRepositoryGORM) Delete(ctx context.Context, playerID int, name string) (bool, error) {
+	result := r.db.WithContext(ctx).Where("player_id = ? AND name = ?", playerID, name).Delete(&ScheduledJobModel{})
+	if result.Error != nil {
+		return false, fmt.Errorf("failed to delete scheduled job %s: %w", name, result.Error)
+	}
+	return result.RowsAffected > 0, nil
+}
+
+func scheduledJobToModel(job *daemon.ScheduledJob) (ScheduledJobModel, error) {
+	params, err := json.Marshal(job.Params)
+	if err != nil {
+		return ScheduledJobModel{}, fmt.Errorf("failed to encode params of scheduled job %s: %w", job.Name, err)
+	}
+	containerIDs, err := json.Marshal(job.LastContainerIDs)
+	if err != nil {
+		return ScheduledJobModel{}, fmt.Errorf("failed to encode containers of scheduled job %s: %w", job.Name, err)
+	}
+	return ScheduledJobModel{
+		PlayerID:         job.PlayerID,
+		Name:             job.Name,
+		Kind:             string(job.Kind),
+		IntervalSeconds:  int64(job.Interval / time.Second),
+		Params:           string(params),
+		Enabled:          job.Enabled,
+		LastRunAt:        job.LastRunAt,
+		NextRunAt:        job.NextRunAt,
+		LastStatus:       string(job.LastStatus),
+		LastError:        job.LastError,
+		LastContainerIDs: string(containerIDs),
+		UpdatedAt:        time.Now().UTC(),
+	}, nil
+}
+
+func scheduledJobFromModel(row ScheduledJobModel) (*daemon.ScheduledJob, error) {
+	job := &daemon.ScheduledJob{
+		PlayerID:   row.PlayerID,
+		Name:       row.Name,
+		Kind:       daemon.ScheduledJobKind(row.Kind),
+		Interval:   time.Duration(row.IntervalSeconds) * time.Second,
+		Params:     map[string]string{},
+		Enabled:    row.Enabled,
+		LastRunAt:  row.LastRunAt,
+		NextRunAt:  row.NextRunAt,
+		LastStatus: daemon.ScheduledJobStatus(row.LastStatus),
+		LastError:  row.LastError,
+	}
+	if row.Params != "" {
+		if err := json.Unmarshal([]byte(row.Params), &job.Params); err != nil {
+			return nil, fmt.Errorf("failed to decode params of scheduled job %s: %w", row.Name, err)
+		}
+	}
+	if row.LastContainerIDs != "" {
+		if err := json.Unmarshal([]byte(row.LastContainerIDs), &job.LastContainerIDs); err != nil {
+			return nil, fmt.Errorf("failed to decode containers of scheduled job %s: %w", row.Name, err)
+		}
+	}
+	return job, nil
+}
@@ -0,0 +1,48 @@
+package persistence_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/andrescamacho/spacetraders-go/internal/adapters/persistence"
+	"github.com/andrescamacho/spacetraders-go/internal/domain/daemon"
+	"github.com/andrescamacho/spacetraders-go/internal/infrastructure/database"
+)
+
+func TestScheduledJobRepositoryRoundTripsAndUpserts(t *testing.T) {
+	db, err := database.NewTestConnection()
+	require.NoError(t, err)
+	repo := persistence.NewScheduledJobRepository(db)
+	ctx := context.Background()
+	now := time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)
+
+	job, err := daemon.NewScheduledJob(1, "scout", daemon.ScheduledJobScoutMarkets, 2*time.Hour,
+		map[string]string{"system": "X1-A", "ships": "AGENT-2,AGENT-3"}, now)
+	require.NoError(t, err)
+	require.NoError(t, repo.Save(ctx, job))
+
+	job.RecordRun(now, []string{"scout-1"}, nil)
+	require.NoError(t, repo.Save(ctx, job))
+
+	loaded, err := repo.FindByName(ctx, 1, "scout")
+	require.NoError(t, err)
+	require.NotNil(t, loaded)
+	require.Equal(t, "AGENT-2,AGENT-3", loaded.Params["ships"])
+	require.Equal(t, []string{"scout-1"}, loaded.LastContainerIDs)
+	require.Equal(t, daemon.ScheduledJobStatusSucceeded, loaded.LastStatus)
+	require.True(t, loaded.NextRunAt.Equal(now.Add(2*time.Hour)))
+
+	jobs, err := repo.List(ctx, 1)
+	require.NoError(t, err)
+	require.Len(t, jobs, 1)
+
+	removed, err := repo.Delete(ctx, 1, "scout")
+	require.NoError(t, err)
+	require.True(t, removed)
+	missing, err := repo.FindByName(ctx, 1, "scout")
+	require.NoError(t, err)
+	require.Nil(t, missing)
+}
@@ -89,6 +89,7 @@ func registeredEnumConstraints() []enumConstraint {
 				string(manufacturing.TaskStatusExecuting),
 				string(manufacturing.TaskStatusCompleted),
 				string(manufacturing.TaskStatusFailed),
+				string(manufacturing.TaskStatusDeadLetter),
 			},
 		},
 		{
@@ -44,6 +44,9 @@ func (r *ShipAssignmentRepositoryGORM) Assign(
 	}
 
 	if existingAssignment != nil && existingAssignment.Status() == assignmentStatusActive {
+		if existingAssignment.ContainerID() == assignment.ContainerID() {
+			return nil
+		}
 		return fmt.Errorf("ship %s is already assigned to container %s",
 			assignment.ShipSymbol(), existingAssignment.ContainerID())
 	}
@@ -114,6 +117,43 @@ func (r *ShipAssignmentRepositoryGORM) FindByShipSymbol(
 	return r.FindByShip(ctx, shipSymbol, playerID)
 }
 
+// FindAllActive retrieves every active container assignment for a player, so
+// an assignment manager can rehydrate after a daemon restart. Captain
+// reservations are excluded for the same reason ReleaseAllActive skips them:
+// they are not held by a container.
+func (r *ShipAssignmentRepositoryGORM) FindAllActive(
+	ctx context.Context,
+	playerID int,
+) ([]*container.ShipAssignment, error) {
+	var models []ShipModel
+
+	err := r.db.WithContext(ctx).
+		Where("player_id = ? AND assignment_status = ?", playerID, assignmentStatusActive).
+		Where("assignment_owner IS NULL OR assignment_owner != ?", string(navigation.AssignmentOwnerCaptain)).
+		Where("container_id IS NOT NULL").
+		Find(&models).Error
+	if err != nil {
+		return nil, fmt.Errorf("failed to find active ship assignments: %w", err)
+	}
+
+	assignments := make([]*container.ShipAssignment, 0, len(models))
+	for _, model := range models {
+		var assignedAt time.Time
+		if model.AssignedAt != nil {
+			assignedAt = *model.AssignedAt
+		}
+		assignments = append(assignments, container.ReconstructShipAssignment(
+			model.ShipSymbol,
+			model.PlayerID,
+			derefString(model.ContainerID),
+			assignedAt,
+			nil, // Clock not needed for retrieval
+		))
+	}
+
+	return assignments, nil
+}
+
 // FindByContainer retrieves all ship assignments for a container
 func (r *ShipAssignmentRepositoryGORM) FindByContainer(
 	ctx context.Context,
@@ -172,6 +212,36 @@ func (r *ShipAssignmentRepositoryGORM) Release(
 	return nil
 }
 
+// ReleaseHeldBy marks a ship assignment as idle only while containerID still
+// holds it, so a stale lease never frees a ship another container has since
+// claimed.
+func (r *ShipAssignmentRepositoryGORM) ReleaseHeldBy(
+	ctx context.Context,
+	shipSymbol string,
+	playerID int,
+	containerID string,
+	reason string,
+) error {
+	now := time.Now()
+
+	result := r.db.WithContext(ctx).
+		Model(&ShipModel{}).
+		Where("ship_symbol = ? AND player_id = ? AND container_id = ? AND assignment_status = ?",
+			shipSymbol, playerID, containerID, assignmentStatusActive).
+		Updates(map[string]interface{}{
+			"assignment_status": assignmentStatusIdle,
+			"container_id":      nil,
+			"released_at":       now,
+			"release_reason":    reason,
+		})
+
+	if result.Error != nil {
+		return fmt.Errorf("failed to release ship assignment: %w", result.Error)
+	}
+
+	return nil
+}
+
 // Transfer transfers a ship assignment from one container to another
 // This is used by the contract fleet coordinator to transfer ships between
 // the coordinator and worker containers
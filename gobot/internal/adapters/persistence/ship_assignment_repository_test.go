@@ -190,3 +190,57 @@ func TestReleaseAllActiveExcludesCaptainReservations(t *testing.T) {
 	require.Equal(t, string(navigation.AssignmentOwnerCaptain), reservedShip.AssignmentOwner, "captain ownership must be untouched")
 	require.Equal(t, "manual gate-supply errand", reservedShip.AssignmentReason, "reservation reason must be untouched")
 }
+
+// FindAllActive returns only live container claims: idle ships and captain
+// reservations are not for an assignment manager to rehydrate.
+func TestFindAllActiveReturnsContainerAssignmentsOnly(t *testing.T) {
+	repo, playerID, db := setupShipAssignmentRepo(t)
+	ctx := context.Background()
+
+	containerID := "CTR-1"
+	seedContainerParent(t, db, containerID, playerID)
+	assignedAt := time.Now().Add(-time.Hour).UTC().Truncate(time.Second)
+
+	require.NoError(t, db.Create(&persistence.ShipModel{
+		ShipSymbol: "SHIP-1", PlayerID: playerID, ContainerID: &containerID,
+		AssignmentStatus: "active", AssignedAt: &assignedAt,
+	}).Error)
+	require.NoError(t, db.Create(&persistence.ShipModel{
+		ShipSymbol: "SHIP-2", PlayerID: playerID, AssignmentStatus: "idle",
+	}).Error)
+	require.NoError(t, db.Create(&persistence.ShipModel{
+		ShipSymbol: "SHIP-3", PlayerID: playerID, AssignmentStatus: "active",
+		AssignmentOwner: string(navigation.AssignmentOwnerCaptain),
+	}).Error)
+
+	active, err := repo.FindAllActive(ctx, playerID)
+	require.NoError(t, err)
+	require.Len(t, active, 1)
+	require.Equal(t, "SHIP-1", active[0].ShipSymbol())
+	require.Equal(t, containerID, active[0].ContainerID())
+	require.True(t, active[0].AssignedAt().Equal(assignedAt))
+}
+
+// ReleaseHeldBy frees a hull only for the container that still holds it.
+func TestReleaseHeldByOnlyReleasesTheHolder(t *testing.T) {
+	repo, playerID, db := setupShipAssignmentRepo(t)
+	ctx := context.Background()
+
+	containerID := "CTR-1"
+	seedContainerParent(t, db, containerID, playerID)
+	require.NoError(t, db.Create(&persistence.ShipModel{
+		ShipSymbol: "SHIP-1", PlayerID: playerID, Role: "HAULER",
+		ContainerID: &containerID, AssignmentStatus: "active", SyncedAt: time.Now(),
+	}).Error)
+
+	require.NoError(t, repo.ReleaseHeldBy(ctx, "SHIP-1", playerID, "CTR-OTHER", "lease_expired"))
+	held, err := repo.FindByShip(ctx, "SHIP-1", playerID)
+	require.NoError(t, err)
+	require.NotNil(t, held)
+	require.Equal(t, "CTR-1", held.ContainerID())
+
+	require.NoError(t, repo.ReleaseHeldBy(ctx, "SHIP-1", playerID, "CTR-1", "lease_expired"))
+	held, err = repo.FindByShip(ctx, "SHIP-1", playerID)
+	require.NoError(t, err)
+	require.True(t, held == nil || held.Status() != "active")
+}
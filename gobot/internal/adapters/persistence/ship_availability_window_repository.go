@@ -0,0 +1,100 @@
+package persistence
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"gorm.io/gorm"
+
+	"github.com/andrescamacho/spacetraders-go/internal/domain/shipyard"
+)
+
+// ShipAvailabilityWindowRepositoryGORM implements
+// shipyard.AvailabilityWindowRepository over the ship_availability_windows table.
+type ShipAvailabilityWindowRepositoryGORM struct {
+	db *gorm.DB
+}
+
+var _ shipyard.AvailabilityWindowRepository = (*ShipAvailabilityWindowRepositoryGORM)(nil)
+
+// NewShipAvailabilityWindowRepository creates the GORM-backed availability window store.
+func NewShipAvailabilityWindowRepository(db *gorm.DB) *ShipAvailabilityWindowRepositoryGORM {
+	return &ShipAvailabilityWindowRepositoryGORM{db: db}
+}
+
+// FindOpen returns the open window for (waypoint, ship type), or nil.
+func (r *ShipAvailabilityWindowRepositoryGORM) FindOpen(ctx context.Context, playerID int, waypointSymbol, shipType string) (*shipyard.AvailabilityWindow, error) {
+	var row ShipAvailabilityWindowModel
+	err := r.db.WithContext(ctx).
+		Where("player_id = ? AND waypoint_symbol = ? AND ship_type = ? AND closed_at IS NULL", playerID, waypointSymbol, shipType).
+		Order("opened_at DESC").
+		First(&row).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to find open availability window for %s at %s: %w", shipType, waypointSymbol, err)
+	}
+	window := windowFromModel(row)
+	return &window, nil
+}
+
+// Save inserts a new window, assigning its ID, or updates an existing one.
+func (r *ShipAvailabilityWindowRepositoryGORM) Save(ctx context.Context, playerID int, window *shipyard.AvailabilityWindow) error {
+	row := ShipAvailabilityWindowModel{
+		ID:             window.ID,
+		PlayerID:       playerID,
+		WaypointSymbol: window.WaypointSymbol,
+		ShipType:       window.ShipType,
+		OpenedAt:       window.OpenedAt,
+		LastSeenAt:     window.LastSeenAt,
+		ClosedAt:       window.ClosedAt,
+		OpenPrice:      window.OpenPrice,
+		MinPrice:       window.MinPrice,
+		LastPrice:      window.LastPrice,
+		PurchasedAt:    window.PurchasedAt,
+	}
+	if err := r.db.WithContext(ctx).Save(&row).Error; err != nil {
+		return fmt.Errorf("failed to save availability window for %s at %s: %w", window.ShipType, window.WaypointSymbol, err)
+	}
+	window.ID = row.ID
+	return nil
+}
+
+// FindSince returns the player's windows for shipType still open at or after
+// since (open windows always qualify), oldest first.
+func (r *ShipAvailabilityWindowRepositoryGORM) FindSince(ctx context.Context, playerID int, shipType string, since time.Time) ([]shipyard.AvailabilityWindow, error) {
+	query := r.db.WithContext(ctx).
+		Where("player_id = ?", playerID).
+		Where("closed_at IS NULL OR closed_at >= ?", since)
+	if shipType != "" {
+		query = query.Where("ship_type = ?", shipType)
+	}
+
+	var rows []ShipAvailabilityWindowModel
+	if err := query.Order("opened_at ASC, id ASC").Find(&rows).Error; err != nil {
+		return nil, fmt.Errorf("failed to read availability windows: %w", err)
+	}
+	out := make([]shipyard.AvailabilityWindow, 0, len(rows))
+	for _, row := range rows {
+		out = append(out, windowFromModel(row))
+	}
+	return out, nil
+}
+
+func windowFromModel(row ShipAvailabilityWindowModel) shipyard.AvailabilityWindow {
+	return shipyard.AvailabilityWindow{
+		ID:             row.ID,
+		WaypointSymbol: row.WaypointSymbol,
+		ShipType:       row.ShipType,
+		OpenedAt:       row.OpenedAt,
+		LastSeenAt:     row.LastSeenAt,
+		ClosedAt:       row.ClosedAt,
+		OpenPrice:      row.OpenPrice,
+		MinPrice:       row.MinPrice,
+		LastPrice:      row.LastPrice,
+		PurchasedAt:    row.PurchasedAt,
+	}
+}
@@ -0,0 +1,50 @@
+package persistence_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/andrescamacho/spacetraders-go/internal/adapters/persistence"
+	"github.com/andrescamacho/spacetraders-go/internal/domain/shipyard"
+	"github.com/andrescamacho/spacetraders-go/internal/infrastructure/database"
+)
+
+// A window is inserted open, updated in place as reads come in, and once
+// closed is no longer the open window while still showing in the history.
+func TestShipAvailabilityWindowRepository_OpenUpdateClose(t *testing.T) {
+	db, err := database.NewTestConnection()
+	require.NoError(t, err)
+	repo := persistence.NewShipAvailabilityWindowRepository(db)
+	ctx := context.Background()
+
+	base := time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC)
+	window := shipyard.OpenAvailabilityWindow("X1-AA-Y1", "SHIP_MINING_DRONE", 40_000, base)
+	require.NoError(t, repo.Save(ctx, 1, window))
+	require.NotZero(t, window.ID)
+
+	open, err := repo.FindOpen(ctx, 1, "X1-AA-Y1", "SHIP_MINING_DRONE")
+	require.NoError(t, err)
+	require.NotNil(t, open)
+	require.Equal(t, window.ID, open.ID)
+
+	other, err := repo.FindOpen(ctx, 2, "X1-AA-Y1", "SHIP_MINING_DRONE")
+	require.NoError(t, err)
+	require.Nil(t, other, "other players' windows must not leak")
+
+	open.Observe(36_000, base.Add(time.Hour))
+	open.Close(base.Add(2 * time.Hour))
+	require.NoError(t, repo.Save(ctx, 1, open))
+
+	gone, err := repo.FindOpen(ctx, 1, "X1-AA-Y1", "SHIP_MINING_DRONE")
+	require.NoError(t, err)
+	require.Nil(t, gone)
+
+	history, err := repo.FindSince(ctx, 1, "SHIP_MINING_DRONE", base)
+	require.NoError(t, err)
+	require.Len(t, history, 1)
+	require.Equal(t, 36_000, history[0].MinPrice)
+	require.Equal(t, 2*time.Hour, history[0].Duration())
+}
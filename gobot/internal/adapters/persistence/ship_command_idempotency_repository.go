@@ -0,0 +1,91 @@
+package persistence
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/andrescamacho/spacetraders-go/internal/domain/navigation"
+)
+
+// ShipCommandIdempotencyRepositoryGORM implements
+// navigation.CommandIdempotencyRepository over the ship_command_idempotency table.
+type ShipCommandIdempotencyRepositoryGORM struct {
+	db *gorm.DB
+}
+
+var _ navigation.CommandIdempotencyRepository = (*ShipCommandIdempotencyRepositoryGORM)(nil)
+
+// NewShipCommandIdempotencyRepository creates the GORM-backed idempotency store.
+func NewShipCommandIdempotencyRepository(db *gorm.DB) *ShipCommandIdempotencyRepositoryGORM {
+	return &ShipCommandIdempotencyRepositoryGORM{db: db}
+}
+
+// Save inserts the record, replacing any earlier result for its key.
+func (r *ShipCommandIdempotencyRepositoryGORM) Save(ctx context.Context, record navigation.CommandIdempotencyRecord) error {
+	row := ShipCommandIdempotencyModel{
+		Scope:          record.Scope,
+		IdempotencyKey: record.Key,
+		ShipSymbol:     record.ShipSymbol,
+		Destination:    record.Destination,
+		Response:       string(record.Response),
+		RecordedAt:     record.RecordedAt,
+	}
+	err := r.db.WithContext(ctx).Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "scope"}, {Name: "idempotency_key"}},
+		DoUpdates: clause.AssignmentColumns([]string{"ship_symbol", "destination", "response", "recorded_at"}),
+	}).Create(&row).Error
+	if err != nil {
+		return fmt.Errorf("failed to save %s idempotency key %q: %w", record.Scope, record.Key, err)
+	}
+	return nil
+}
+
+// Delete drops the record for (scope, key), if any.
+func (r *ShipCommandIdempotencyRepositoryGORM) Delete(ctx context.Context, scope, key string) error {
+	err := r.db.WithContext(ctx).
+		Where("scope = ? AND idempotency_key = ?", scope, key).
+		Delete(&ShipCommandIdempotencyModel{}).Error
+	if err != nil {
+		return fmt.Errorf("failed to delete %s idempotency key %q: %w", scope, key, err)
+	}
+	return nil
+}
+
+// FindSince returns scope's records recorded at or after since, oldest first.
+func (r *ShipCommandIdempotencyRepositoryGORM) FindSince(ctx context.Context, scope string, since time.Time) ([]navigation.CommandIdempotencyRecord, error) {
+	var rows []ShipCommandIdempotencyModel
+	err := r.db.WithContext(ctx).
+		Where("scope = ? AND recorded_at >= ?", scope, since).
+		Order("recorded_at ASC").
+		Find(&rows).Error
+	if err != nil {
+		return nil, fmt.Errorf("failed to read %s idempotency keys: %w", scope, err)
+	}
+	out := make([]navigation.CommandIdempotencyRecord, 0, len(rows))
+	for _, row := range rows {
+		out = append(out, navigation.CommandIdempotencyRecord{
+			Scope:       row.Scope,
+			Key:         row.IdempotencyKey,
+			ShipSymbol:  row.ShipSymbol,
+			Destination: row.Destination,
+			Response:    []byte(row.Response),
+			RecordedAt:  row.RecordedAt,
+		})
+	}
+	return out, nil
+}
+
+// DeleteBefore drops scope's records recorded before cutoff.
+func (r *ShipCommandIdempotencyRepositoryGORM) DeleteBefore(ctx context.Context, scope string, cutoff time.Time) error {
+	err := r.db.WithContext(ctx).
+		Where("scope = ? AND recorded_at < ?", scope, cutoff).
+		Delete(&ShipCommandIdempotencyModel{}).Error
+	if err != nil {
+		return fmt.Errorf("failed to purge %s idempotency keys: %w", scope, err)
+	}
+	return nil
+}
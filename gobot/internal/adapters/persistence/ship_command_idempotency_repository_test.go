@@ -0,0 +1,49 @@
+package persistence_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/andrescamacho/spacetraders-go/internal/adapters/persistence"
+	"github.com/andrescamacho/spacetraders-go/internal/domain/navigation"
+	"github.com/andrescamacho/spacetraders-go/internal/infrastructure/database"
+)
+
+// A saved key is replaced in place, read back by scope from a given time, and
+// dropped by Delete and DeleteBefore.
+func TestShipCommandIdempotencyRepository_SaveFindDelete(t *testing.T) {
+	db, err := database.NewTestConnection()
+	require.NoError(t, err)
+	repo := persistence.NewShipCommandIdempotencyRepository(db)
+	ctx := context.Background()
+
+	base := time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC)
+	require.NoError(t, repo.Save(ctx, navigation.CommandIdempotencyRecord{Scope: "navigate_route", Key: "old", ShipSymbol: "SHIP-1", Destination: "X1-AA-B2", Response: []byte(`{}`), RecordedAt: base}))
+	require.NoError(t, repo.Save(ctx, navigation.CommandIdempotencyRecord{Scope: "navigate_route", Key: "k1", ShipSymbol: "SHIP-1", Destination: "X1-AA-B2", Response: []byte(`{"a":1}`), RecordedAt: base.Add(time.Hour)}))
+	require.NoError(t, repo.Save(ctx, navigation.CommandIdempotencyRecord{Scope: "navigate_route", Key: "k1", ShipSymbol: "SHIP-1", Destination: "X1-AA-B2", Response: []byte(`{"a":2}`), RecordedAt: base.Add(2 * time.Hour)}))
+	require.NoError(t, repo.Save(ctx, navigation.CommandIdempotencyRecord{Scope: "navigate_direct", Key: "k1", ShipSymbol: "SHIP-1", Destination: "X1-AA-B2", Response: []byte(`{}`), RecordedAt: base.Add(time.Hour)}))
+
+	records, err := repo.FindSince(ctx, "navigate_route", base.Add(30*time.Minute))
+	require.NoError(t, err)
+	require.Len(t, records, 1)
+	require.Equal(t, "k1", records[0].Key)
+	require.Equal(t, "X1-AA-B2", records[0].Destination)
+	require.JSONEq(t, `{"a":2}`, string(records[0].Response))
+
+	require.NoError(t, repo.DeleteBefore(ctx, "navigate_route", base.Add(30*time.Minute)))
+	records, err = repo.FindSince(ctx, "navigate_route", base.Add(-time.Hour))
+	require.NoError(t, err)
+	require.Len(t, records, 1)
+
+	require.NoError(t, repo.Delete(ctx, "navigate_route", "k1"))
+	records, err = repo.FindSince(ctx, "navigate_route", base.Add(-time.Hour))
+	require.NoError(t, err)
+	require.Empty(t, records)
+
+	direct, err := repo.FindSince(ctx, "navigate_direct", base)
+	require.NoError(t, err)
+	require.Len(t, direct, 1, "other scopes are untouched")
+}
@@ -0,0 +1,77 @@
+package persistence
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/andrescamacho/spacetraders-go/internal/domain/shared"
+)
+
+// ShipTagRepositoryGORM implements navigation.ShipTagRepository over the
+// ship_tags table.
+type ShipTagRepositoryGORM struct {
+	db *gorm.DB
+}
+
+// NewShipTagRepository creates the GORM-backed ship tag store.
+func NewShipTagRepository(db *gorm.DB) *ShipTagRepositoryGORM {
+	return &ShipTagRepositoryGORM{db: db}
+}
+
+// AddShipTag inserts the tag, doing nothing when the ship already wears it.
+func (r *ShipTagRepositoryGORM) AddShipTag(ctx context.Context, playerID shared.PlayerID, shipSymbol, tag string) error {
+	row := ShipTagModel{
+		PlayerID:   playerID.Value(),
+		ShipSymbol: shipSymbol,
+		Tag:        tag,
+		CreatedAt:  time.Now().UTC(),
+	}
+	if err := r.db.WithContext(ctx).Clauses(clause.OnConflict{DoNothing: true}).Create(&row).Error; err != nil {
+		return fmt.Errorf("failed to tag ship %s: %w", shipSymbol, err)
+	}
+	return nil
+}
+
+// RemoveShipTag deletes the tag, reporting whether a row was removed.
+func (r *ShipTagRepositoryGORM) RemoveShipTag(ctx context.Context, playerID shared.PlayerID, shipSymbol, tag string) (bool, error) {
+	result := r.db.WithContext(ctx).
+		Where("player_id = ? AND ship_symbol = ? AND tag = ?", playerID.Value(), shipSymbol, tag).
+		Delete(&ShipTagModel{})
+	if result.Error != nil {
+		return false, fmt.Errorf("failed to untag ship %s: %w", shipSymbol, result.Error)
+	}
+	return result.RowsAffected > 0, nil
+}
+
+// FindShipsByTag returns the symbols of the ships wearing tag, in symbol order.
+func (r *ShipTagRepositoryGORM) FindShipsByTag(ctx context.Context, playerID shared.PlayerID, tag string) ([]string, error) {
+	var symbols []string
+	if err := r.db.WithContext(ctx).Model(&ShipTagModel{}).
+		Where("player_id = ? AND tag = ?", playerID.Value(), tag).
+		Order("ship_symbol").
+		Pluck("ship_symbol", &symbols).Error; err != nil {
+		return nil, fmt.Errorf("failed to find ships tagged %q: %w", tag, err)
+	}
+	return symbols, nil
+}
+
+// ListShipTags returns the player's tags keyed by ship symbol, each ship's tags
+// in name order.
+func (r *ShipTagRepositoryGORM) ListShipTags(ctx context.Context, playerID shared.PlayerID) (map[string][]string, error) {
+	var rows []ShipTagModel
+	if err := r.db.WithContext(ctx).
+		Where("player_id = ?", playerID.Value()).
+		Order("ship_symbol, tag").
+		Find(&rows).Error; err != nil {
+		return nil, fmt.Errorf("failed to list ship tags: %w", err)
+	}
+	tags := make(map[string][]string)
+	for _, row := range rows {
+		tags[row.ShipSymbol] = append(tags[row.ShipSymbol], row.Tag)
+	}
+	return tags, nil
+}
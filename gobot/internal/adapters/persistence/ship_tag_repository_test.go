@@ -0,0 +1,50 @@
+package persistence_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/andrescamacho/spacetraders-go/internal/adapters/persistence"
+	"github.com/andrescamacho/spacetraders-go/internal/domain/shared"
+	"github.com/andrescamacho/spacetraders-go/internal/infrastructure/database"
+)
+
+// Tags are idempotent per ship, scoped per player, and resolve back to their
+// ships in symbol order.
+func TestShipTagRepository_TagResolveAndRemove(t *testing.T) {
+	db, err := database.NewTestConnection()
+	require.NoError(t, err)
+	repo := persistence.NewShipTagRepository(db)
+	ctx := context.Background()
+	p1, p2 := shared.MustNewPlayerID(1), shared.MustNewPlayerID(2)
+
+	require.NoError(t, repo.AddShipTag(ctx, p1, "AGENT-3", "fast-haulers"))
+	require.NoError(t, repo.AddShipTag(ctx, p1, "AGENT-1", "fast-haulers"))
+	require.NoError(t, repo.AddShipTag(ctx, p1, "AGENT-1", "fast-haulers"), "re-adding is a no-op")
+	require.NoError(t, repo.AddShipTag(ctx, p1, "AGENT-1", "gate-probes"))
+	require.NoError(t, repo.AddShipTag(ctx, p2, "OTHER-1", "fast-haulers"))
+
+	ships, err := repo.FindShipsByTag(ctx, p1, "fast-haulers")
+	require.NoError(t, err)
+	require.Equal(t, []string{"AGENT-1", "AGENT-3"}, ships)
+
+	all, err := repo.ListShipTags(ctx, p1)
+	require.NoError(t, err)
+	require.Equal(t, map[string][]string{
+		"AGENT-1": {"fast-haulers", "gate-probes"},
+		"AGENT-3": {"fast-haulers"},
+	}, all)
+
+	removed, err := repo.RemoveShipTag(ctx, p1, "AGENT-1", "fast-haulers")
+	require.NoError(t, err)
+	require.True(t, removed)
+	removed, err = repo.RemoveShipTag(ctx, p1, "AGENT-1", "fast-haulers")
+	require.NoError(t, err)
+	require.False(t, removed, "removing an absent tag reports false")
+
+	ships, err = repo.FindShipsByTag(ctx, p1, "fast-haulers")
+	require.NoError(t, err)
+	require.Equal(t, []string{"AGENT-3"}, ships)
+}
@@ -0,0 +1,73 @@
+package persistence
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"gorm.io/gorm"
+
+	"github.com/andrescamacho/spacetraders-go/internal/domain/shipyard"
+)
+
+// ShipyardPriceHistoryRepositoryGORM implements shipyard.PriceHistoryRepository
+// over the append-only shipyard_price_snapshots table.
+type ShipyardPriceHistoryRepositoryGORM struct {
+	db *gorm.DB
+}
+
+// NewShipyardPriceHistoryRepository creates the GORM-backed ship price history store.
+func NewShipyardPriceHistoryRepository(db *gorm.DB) *ShipyardPriceHistoryRepositoryGORM {
+	return &ShipyardPriceHistoryRepositoryGORM{db: db}
+}
+
+// RecordSnapshots appends one row per snapshot in a single insert.
+func (r *ShipyardPriceHistoryRepositoryGORM) RecordSnapshots(ctx context.Context, playerID int, snapshots []shipyard.ShipPriceSnapshot) error {
+	if len(snapshots) == 0 {
+		return nil
+	}
+	rows := make([]ShipyardPriceSnapshotModel, 0, len(snapshots))
+	for _, s := range snapshots {
+		rows = append(rows, ShipyardPriceSnapshotModel{
+			PlayerID:       playerID,
+			SystemSymbol:   s.SystemSymbol,
+			WaypointSymbol: s.WaypointSymbol,
+			ShipType:       s.ShipType,
+			PurchasePrice:  s.PurchasePrice,
+			Supply:         s.Supply,
+			ObservedAt:     s.ObservedAt,
+		})
+	}
+	if err := r.db.WithContext(ctx).Create(&rows).Error; err != nil {
+		return fmt.Errorf("failed to record shipyard price snapshots: %w", err)
+	}
+	return nil
+}
+
+// FindSince returns the player's snapshots of shipType observed at or after
+// since, oldest first, optionally narrowed to one waypoint.
+func (r *ShipyardPriceHistoryRepositoryGORM) FindSince(ctx context.Context, playerID int, shipType, waypointSymbol string, since time.Time) ([]shipyard.ShipPriceSnapshot, error) {
+	query := r.db.WithContext(ctx).
+		Where("player_id = ? AND ship_type = ? AND observed_at >= ?", playerID, shipType, since)
+	if waypointSymbol != "" {
+		query = query.Where("waypoint_symbol = ?", waypointSymbol)
+	}
+
+	var rows []ShipyardPriceSnapshotModel
+	if err := query.Order("observed_at ASC, id ASC").Find(&rows).Error; err != nil {
+		return nil, fmt.Errorf("failed to read shipyard price snapshots: %w", err)
+	}
+
+	out := make([]shipyard.ShipPriceSnapshot, 0, len(rows))
+	for _, row := range rows {
+		out = append(out, shipyard.ShipPriceSnapshot{
+			SystemSymbol:   row.SystemSymbol,
+			WaypointSymbol: row.WaypointSymbol,
+			ShipType:       row.ShipType,
+			PurchasePrice:  row.PurchasePrice,
+			Supply:         row.Supply,
+			ObservedAt:     row.ObservedAt,
+		})
+	}
+	return out, nil
+}
@@ -0,0 +1,54 @@
+package persistence_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/andrescamacho/spacetraders-go/internal/adapters/persistence"
+	"github.com/andrescamacho/spacetraders-go/internal/domain/shipyard"
+	"github.com/andrescamacho/spacetraders-go/internal/infrastructure/database"
+)
+
+// Snapshots append (a re-observed yard keeps its earlier prices) and read back
+// oldest first, scoped to the player, ship type, window and optional waypoint.
+func TestShipyardPriceHistoryRepository_AppendsAndReadsWindow(t *testing.T) {
+	db, err := database.NewTestConnection()
+	require.NoError(t, err)
+	repo := persistence.NewShipyardPriceHistoryRepository(db)
+	ctx := context.Background()
+
+	base := time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC)
+	snap := func(waypoint, shipType string, price int, at time.Time) shipyard.ShipPriceSnapshot {
+		return shipyard.ShipPriceSnapshot{
+			SystemSymbol: "X1-AA", WaypointSymbol: waypoint, ShipType: shipType,
+			PurchasePrice: price, Supply: "MODERATE", ObservedAt: at,
+		}
+	}
+	require.NoError(t, repo.RecordSnapshots(ctx, 1, []shipyard.ShipPriceSnapshot{
+		snap("X1-AA-Y1", "SHIP_LIGHT_HAULER", 90_000, base),
+		snap("X1-AA-Y1", "SHIP_PROBE", 20_000, base),
+	}))
+	require.NoError(t, repo.RecordSnapshots(ctx, 1, []shipyard.ShipPriceSnapshot{
+		snap("X1-AA-Y1", "SHIP_LIGHT_HAULER", 84_000, base.Add(2*time.Hour)),
+		snap("X1-AA-Y2", "SHIP_LIGHT_HAULER", 88_000, base.Add(2*time.Hour)),
+	}))
+	require.NoError(t, repo.RecordSnapshots(ctx, 2, []shipyard.ShipPriceSnapshot{
+		snap("X1-AA-Y1", "SHIP_LIGHT_HAULER", 1, base.Add(2*time.Hour)),
+	}))
+
+	all, err := repo.FindSince(ctx, 1, "SHIP_LIGHT_HAULER", "", time.Time{})
+	require.NoError(t, err)
+	require.Len(t, all, 3)
+	require.Equal(t, 90_000, all[0].PurchasePrice, "oldest first, and the re-observation must not overwrite it")
+	for _, s := range all {
+		require.NotEqual(t, 1, s.PurchasePrice, "other players' snapshots must not leak")
+	}
+
+	yard, err := repo.FindSince(ctx, 1, "SHIP_LIGHT_HAULER", "X1-AA-Y1", base.Add(time.Hour))
+	require.NoError(t, err)
+	require.Len(t, yard, 1)
+	require.Equal(t, 84_000, yard[0].PurchasePrice)
+}
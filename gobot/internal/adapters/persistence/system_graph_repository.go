@@ -23,12 +23,13 @@ func NewGormSystemGraphRepository(db *gorm.DB) system.SystemGraphRepository {
 	}
 }
 
-// Get retrieves a graph for a system from cache
+// Get retrieves a graph for a system from cache. A graph invalidated by a
+// server reset is a cache miss.
 func (r *GormSystemGraphRepository) Get(ctx context.Context, systemSymbol string) (*system.NavigationGraph, error) {
 	var model SystemGraphModel
 
 	err := r.db.WithContext(ctx).
-		Where("system_symbol = ?", systemSymbol).
+		Where("system_symbol = ? AND invalidated_at IS NULL", systemSymbol).
 		First(&model).Error
 
 	if err != nil {
@@ -46,7 +47,7 @@ func (r *GormSystemGraphRepository) Get(ctx context.Context, systemSymbol string
 	return &graph, nil
 }
 
-// Add persists a graph for a system (upsert)
+// Add persists a graph for a system (upsert), clearing any invalidation
 func (r *GormSystemGraphRepository) Add(ctx context.Context, systemSymbol string, graph *system.NavigationGraph) error {
 	graphJSON, err := json.Marshal(graph)
 	if err != nil {
@@ -64,7 +65,7 @@ func (r *GormSystemGraphRepository) Add(ctx context.Context, systemSymbol string
 	err = r.db.WithContext(ctx).
 		Clauses(clause.OnConflict{
 			Columns:   []clause.Column{{Name: "system_symbol"}},
-			DoUpdates: clause.AssignmentColumns([]string{"graph_data", "updated_at"}),
+			DoUpdates: clause.AssignmentColumns([]string{"graph_data", "updated_at", "invalidated_at"}),
 		}).
 		Create(&model).Error
 
@@ -0,0 +1,72 @@
+package persistence
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"gorm.io/gorm"
+
+	"github.com/andrescamacho/spacetraders-go/internal/domain/trading"
+)
+
+// TradeLaneExecutionRepositoryGORM implements trading.LaneExecutionRepository
+// over the append-only trade_lane_executions table.
+type TradeLaneExecutionRepositoryGORM struct {
+	db *gorm.DB
+}
+
+var _ trading.LaneExecutionRepository = (*TradeLaneExecutionRepositoryGORM)(nil)
+
+// NewTradeLaneExecutionRepository creates the GORM-backed lane execution store.
+func NewTradeLaneExecutionRepository(db *gorm.DB) *TradeLaneExecutionRepositoryGORM {
+	return &TradeLaneExecutionRepositoryGORM{db: db}
+}
+
+// Record appends one execution.
+func (r *TradeLaneExecutionRepositoryGORM) Record(ctx context.Context, execution trading.LaneExecution) error {
+	row := TradeLaneExecutionModel{
+		PlayerID:    execution.PlayerID,
+		ContainerID: execution.ContainerID,
+		Source:      execution.Source,
+		BuyMarket:   execution.BuyMarket,
+		SellMarket:  execution.SellMarket,
+		Good:        execution.Good,
+		Units:       execution.Units,
+		Cost:        execution.Cost,
+		Revenue:     execution.Revenue,
+		ExecutedAt:  execution.ExecutedAt,
+	}
+	if err := r.db.WithContext(ctx).Create(&row).Error; err != nil {
+		return fmt.Errorf("failed to record lane execution: %w", err)
+	}
+	return nil
+}
+
+// ListExecutions returns playerID's executions at or after since, oldest first.
+func (r *TradeLaneExecutionRepositoryGORM) ListExecutions(ctx context.Context, playerID int, since time.Time) ([]trading.LaneExecution, error) {
+	var rows []TradeLaneExecutionModel
+	if err := r.db.WithContext(ctx).
+		Where("player_id = ? AND executed_at >= ?", playerID, since).
+		Order("executed_at ASC, id ASC").
+		Find(&rows).Error; err != nil {
+		return nil, fmt.Errorf("failed to list lane executions for player %d: %w", playerID, err)
+	}
+
+	out := make([]trading.LaneExecution, 0, len(rows))
+	for _, row := range rows {
+		out = append(out, trading.LaneExecution{
+			PlayerID:    row.PlayerID,
+			ContainerID: row.ContainerID,
+			Source:      row.Source,
+			BuyMarket:   row.BuyMarket,
+			SellMarket:  row.SellMarket,
+			Good:        row.Good,
+			Units:       row.Units,
+			Cost:        row.Cost,
+			Revenue:     row.Revenue,
+			ExecutedAt:  row.ExecutedAt,
+		})
+	}
+	return out, nil
+}
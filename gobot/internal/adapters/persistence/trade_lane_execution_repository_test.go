@@ -0,0 +1,40 @@
+package persistence_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/andrescamacho/spacetraders-go/internal/adapters/persistence"
+	"github.com/andrescamacho/spacetraders-go/internal/domain/trading"
+	"github.com/andrescamacho/spacetraders-go/internal/infrastructure/database"
+)
+
+func TestTradeLaneExecutionRepositoryListsByPlayerAndWindow(t *testing.T) {
+	db, err := database.NewTestConnection()
+	require.NoError(t, err)
+	repo := persistence.NewTradeLaneExecutionRepository(db)
+	ctx := context.Background()
+	start := time.Date(2026, 2, 1, 0, 0, 0, 0, time.UTC)
+
+	for i, e := range []trading.LaneExecution{
+		{PlayerID: 1, Source: trading.LaneSourceArb, BuyMarket: "X1-A-B1", SellMarket: "X1-A-C2", Good: "FUEL", Units: 40, Cost: 2000, Revenue: 2600},
+		{PlayerID: 1, Source: trading.LaneSourceTour, BuyMarket: "X1-A-B1", SellMarket: "X1-A-C2", Good: "FUEL", Units: 20, Cost: 1000, Revenue: 1200},
+		{PlayerID: 2, Source: trading.LaneSourceArb, BuyMarket: "X1-A-B1", SellMarket: "X1-A-C2", Good: "FUEL", Units: 10, Cost: 500, Revenue: 400},
+	} {
+		e.ExecutedAt = start.Add(time.Duration(i) * time.Hour)
+		require.NoError(t, repo.Record(ctx, e))
+	}
+
+	all, err := repo.ListExecutions(ctx, 1, time.Time{})
+	require.NoError(t, err)
+	require.Len(t, all, 2)
+	require.Equal(t, 600, all[0].Profit())
+
+	recent, err := repo.ListExecutions(ctx, 1, start.Add(30*time.Minute))
+	require.NoError(t, err)
+	require.Len(t, recent, 1)
+	require.Equal(t, trading.LaneSourceTour, recent[0].Source)
+}
@@ -131,6 +131,13 @@ func (r *GormTransactionRepository) applyFilters(query *gorm.DB, opts ledger.Que
 		query = query.Where("related_entity_id = ?", *opts.RelatedEntityID)
 	}
 
+	if opts.OperationType != nil {
+		query = query.Where("operation_type = ?", *opts.OperationType)
+	}
+	if opts.ContainerID != nil {
+		query = query.Where("container_id = ?", *opts.ContainerID)
+	}
+
 	return query
 }
 
@@ -175,6 +182,7 @@ func (r *GormTransactionRepository) modelToTransaction(model *TransactionModel)
 		model.RelatedEntityType,
 		model.RelatedEntityID,
 		model.OperationType,
+		model.ContainerID,
 	), nil
 }
 
@@ -203,5 +211,6 @@ func (r *GormTransactionRepository) transactionToModel(tx *ledger.Transaction) (
 		RelatedEntityType: tx.RelatedEntityType(),
 		RelatedEntityID:   tx.RelatedEntityID(),
 		OperationType:     tx.OperationType(),
+		ContainerID:       tx.ContainerID(),
 	}, nil
 }
@@ -0,0 +1,95 @@
+package persistence
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/andrescamacho/spacetraders-go/internal/domain/system"
+)
+
+const waypointBlacklistTable = "waypoint_blacklist"
+
+// WaypointBlacklistRepositoryGORM implements system.WaypointBlacklistRepository
+// over the waypoint_blacklist table.
+type WaypointBlacklistRepositoryGORM struct {
+	db *gorm.DB
+}
+
+var _ system.WaypointBlacklistRepository = (*WaypointBlacklistRepositoryGORM)(nil)
+
+// NewWaypointBlacklistRepository creates the GORM-backed waypoint blacklist.
+func NewWaypointBlacklistRepository(db *gorm.DB) *WaypointBlacklistRepositoryGORM {
+	return &WaypointBlacklistRepositoryGORM{db: db}
+}
+
+// Add upserts entry: blacklisting an already blacklisted waypoint replaces its
+// reason, source and expiry.
+func (r *WaypointBlacklistRepositoryGORM) Add(ctx context.Context, entry *system.BlacklistedWaypoint) error {
+	row := WaypointBlacklistModel{
+		PlayerID:       entry.PlayerID,
+		WaypointSymbol: entry.WaypointSymbol,
+		Reason:         entry.Reason,
+		Source:         entry.Source,
+		CreatedAt:      entry.CreatedAt,
+		ExpiresAt:      entry.ExpiresAt,
+	}
+	if err := r.db.WithContext(ctx).Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "player_id"}, {Name: "waypoint_symbol"}},
+		DoUpdates: clause.AssignmentColumns([]string{"reason", "source", "created_at", "expires_at"}),
+	}).Create(&row).Error; err != nil {
+		return fmt.Errorf("failed to blacklist waypoint %s: %w", entry.WaypointSymbol, err)
+	}
+	return nil
+}
+
+// Remove deletes the entry for waypointSymbol.
+func (r *WaypointBlacklistRepositoryGORM) Remove(ctx context.Context, playerID int, waypointSymbol string) (bool, error) {
+	result := r.db.WithContext(ctx).
+		Where("player_id = ? AND waypoint_symbol = ?", playerID, waypointSymbol).
+		Delete(&WaypointBlacklistModel{})
+	if result.Error != nil {
+		return false, fmt.Errorf("failed to clear blacklisted waypoint %s: %w", waypointSymbol, result.Error)
+	}
+	return result.RowsAffected > 0, nil
+}
+
+// ListActive returns the player's unexpired entries ordered by waypoint.
+func (r *WaypointBlacklistRepositoryGORM) ListActive(ctx context.Context, playerID int, now time.Time) ([]*system.BlacklistedWaypoint, error) {
+	var rows []WaypointBlacklistModel
+	if err := r.db.WithContext(ctx).
+		Where("player_id = ?", playerID).
+		Where("expires_at IS NULL OR expires_at > ?", now).
+		Order("waypoint_symbol ASC").
+		Find(&rows).Error; err != nil {
+		return nil, fmt.Errorf("failed to list blacklisted waypoints: %w", err)
+	}
+
+	entries := make([]*system.BlacklistedWaypoint, len(rows))
+	for i, row := range rows {
+		entries[i] = &system.BlacklistedWaypoint{
+			PlayerID:       row.PlayerID,
+			WaypointSymbol: row.WaypointSymbol,
+			Reason:         row.Reason,
+			Source:         row.Source,
+			CreatedAt:      row.CreatedAt,
+			ExpiresAt:      row.ExpiresAt,
+		}
+	}
+	return entries, nil
+}
+
+// excludeBlacklistedWaypoints drops market_data rows at waypoints playerID has
+// an active blacklist entry for, so market selection never picks one.
+func excludeBlacklistedWaypoints(playerID int) func(*gorm.DB) *gorm.DB {
+	return func(db *gorm.DB) *gorm.DB {
+		return db.Where(
+			"waypoint_symbol NOT IN (SELECT waypoint_symbol FROM "+waypointBlacklistTable+
+				" WHERE player_id = ? AND (expires_at IS NULL OR expires_at > ?))",
+			playerID, time.Now(),
+		)
+	}
+}
@@ -0,0 +1,74 @@
+package persistence_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/andrescamacho/spacetraders-go/internal/adapters/persistence"
+	"github.com/andrescamacho/spacetraders-go/internal/domain/system"
+	"github.com/andrescamacho/spacetraders-go/internal/infrastructure/database"
+)
+
+func TestWaypointBlacklistRepository_UpsertsExpiresAndClears(t *testing.T) {
+	db, err := database.NewTestConnection()
+	require.NoError(t, err)
+	repo := persistence.NewWaypointBlacklistRepository(db)
+	ctx := context.Background()
+	now := time.Now()
+	past, future := now.Add(-time.Minute), now.Add(time.Hour)
+
+	require.NoError(t, repo.Add(ctx, &system.BlacklistedWaypoint{PlayerID: 1, WaypointSymbol: "X1-A-1", Reason: "pirates", Source: system.BlacklistSourceOperator, CreatedAt: now}))
+	require.NoError(t, repo.Add(ctx, &system.BlacklistedWaypoint{PlayerID: 1, WaypointSymbol: "X1-A-2", Source: system.BlacklistSourceHealthMonitor, CreatedAt: now, ExpiresAt: &past}))
+	require.NoError(t, repo.Add(ctx, &system.BlacklistedWaypoint{PlayerID: 2, WaypointSymbol: "X1-A-3", CreatedAt: now}))
+	// Re-blacklisting replaces the entry rather than failing on the key.
+	require.NoError(t, repo.Add(ctx, &system.BlacklistedWaypoint{PlayerID: 1, WaypointSymbol: "X1-A-1", Reason: "api 500s", Source: system.BlacklistSourceHealthMonitor, CreatedAt: now, ExpiresAt: &future}))
+
+	active, err := repo.ListActive(ctx, 1, now)
+	require.NoError(t, err)
+	require.Len(t, active, 1, "expired and other players' entries are not listed")
+	require.Equal(t, "X1-A-1", active[0].WaypointSymbol)
+	require.Equal(t, "api 500s", active[0].Reason)
+	require.Equal(t, system.BlacklistSourceHealthMonitor, active[0].Source)
+	require.NotNil(t, active[0].ExpiresAt)
+
+	removed, err := repo.Remove(ctx, 1, "X1-A-1")
+	require.NoError(t, err)
+	require.True(t, removed)
+	removed, err = repo.Remove(ctx, 1, "X1-A-1")
+	require.NoError(t, err)
+	require.False(t, removed)
+}
+
+// Market selection never picks a blacklisted market, however cheap.
+func TestMarketRepo_SelectionSkipsBlacklistedWaypoints(t *testing.T) {
+	db, err := database.NewTestConnection()
+	require.NoError(t, err)
+	player := persistence.PlayerModel{AgentSymbol: "SP-BLACKLIST", Token: "tok", CreatedAt: time.Now()}
+	require.NoError(t, db.Create(&player).Error)
+	markets := persistence.NewMarketRepository(db)
+	blacklist := persistence.NewWaypointBlacklistRepository(db)
+	ctx := context.Background()
+
+	for waypoint, price := range map[string]int{"X1-AA-CHEAP": 10, "X1-AA-DEAR": 20} {
+		require.NoError(t, db.Create(&persistence.MarketData{
+			WaypointSymbol: waypoint, GoodSymbol: "IRON", PurchasePrice: price - 2, SellPrice: price,
+			TradeVolume: 100, LastUpdated: time.Now(), PlayerID: player.ID,
+		}).Error)
+	}
+
+	cheapest, err := markets.FindCheapestMarketSelling(ctx, "IRON", "X1-AA", player.ID)
+	require.NoError(t, err)
+	require.Equal(t, "X1-AA-CHEAP", cheapest.WaypointSymbol)
+
+	require.NoError(t, blacklist.Add(ctx, &system.BlacklistedWaypoint{PlayerID: player.ID, WaypointSymbol: "X1-AA-CHEAP", CreatedAt: time.Now()}))
+
+	cheapest, err = markets.FindCheapestMarketSelling(ctx, "IRON", "X1-AA", player.ID)
+	require.NoError(t, err)
+	require.Equal(t, "X1-AA-DEAR", cheapest.WaypointSymbol)
+	listings, err := markets.FindMarketsTradingGood(ctx, "IRON", "X1-AA", player.ID)
+	require.NoError(t, err)
+	require.Len(t, listings, 1)
+}
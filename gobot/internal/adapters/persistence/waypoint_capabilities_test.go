@@ -0,0 +1,46 @@
+package persistence_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/andrescamacho/spacetraders-go/internal/adapters/persistence"
+	"github.com/andrescamacho/spacetraders-go/internal/domain/shared"
+	"github.com/andrescamacho/spacetraders-go/internal/infrastructure/database"
+)
+
+// A market scan's fuel grade persists, and a later trait-only re-sync of the same
+// waypoint does not downgrade it back to IMPLIED.
+func TestWaypointRecordMarketGoodsSurvivesTraitResync(t *testing.T) {
+	db, err := database.NewTestConnection()
+	require.NoError(t, err)
+	repo := persistence.NewGormWaypointRepository(db)
+	ctx := context.Background()
+
+	newMarketplace := func() *shared.Waypoint {
+		wp, err := shared.NewWaypoint("X1-ABC-A1", 3, 4)
+		require.NoError(t, err)
+		wp.SystemSymbol = "X1-ABC"
+		wp.Type = "PLANET"
+		wp.Traits = []string{"MARKETPLACE"}
+		wp.ApplyCapabilities(shared.CapabilitiesFromTraits(wp.Traits, false))
+		return wp
+	}
+
+	require.NoError(t, repo.Add(ctx, newMarketplace()))
+	require.NoError(t, repo.RecordMarketGoods(ctx, "X1-ABC-A1", []string{"IRON_ORE"}))
+
+	got, err := repo.FindBySymbol(ctx, "X1-ABC-A1", "X1-ABC")
+	require.NoError(t, err)
+	require.Equal(t, shared.FuelAvailabilityAbsent, got.Capabilities.Fuel)
+	require.False(t, got.HasFuel)
+
+	require.NoError(t, repo.Add(ctx, newMarketplace()))
+	got, err = repo.FindBySymbol(ctx, "X1-ABC-A1", "X1-ABC")
+	require.NoError(t, err)
+	require.Equal(t, shared.FuelAvailabilityAbsent, got.Capabilities.Fuel)
+	require.False(t, got.HasFuel)
+	require.True(t, got.Capabilities.Marketplace)
+}
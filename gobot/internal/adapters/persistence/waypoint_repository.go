@@ -172,13 +172,26 @@ func (r *GormWaypointRepository) ListBySystemWithTrait(ctx context.Context, syst
 	return r.modelsToWaypoints(models)
 }
 
-// Add persists a waypoint
+// Add persists a waypoint. A trait-derived fuel grade never overwrites one a
+// market scan already recorded for the symbol (see RecordMarketGoods).
 func (r *GormWaypointRepository) Add(ctx context.Context, waypoint *shared.Waypoint) error {
 	model, err := r.waypointToModel(waypoint)
 	if err != nil {
 		return fmt.Errorf("failed to convert waypoint to model: %w", err)
 	}
 
+	if !waypoint.Capabilities.Fuel.FromMarket() {
+		var existing WaypointModel
+		err := r.db.WithContext(ctx).
+			Select("fuel_availability", "has_fuel").
+			Where("waypoint_symbol = ?", waypoint.Symbol).
+			First(&existing).Error
+		if err == nil && shared.FuelAvailability(existing.FuelAvailability).FromMarket() {
+			model.FuelAvailability = existing.FuelAvailability
+			model.HasFuel = existing.HasFuel
+		}
+	}
+
 	model.EraID = r.openEraID(ctx)
 
 	result := r.db.WithContext(ctx).Save(model)
@@ -189,6 +202,44 @@ func (r *GormWaypointRepository) Add(ctx context.Context, waypoint *shared.Waypo
 	return nil
 }
 
+// RecordMarketGoods grades the waypoint's fuel from a scanned market's trade-good
+// symbols and persists it with has_fuel. A waypoint not yet cached is a no-op: its
+// row is written by the next graph build, and the next scan grades it.
+func (r *GormWaypointRepository) RecordMarketGoods(ctx context.Context, waypointSymbol string, goods []string) error {
+	var model WaypointModel
+	result := r.db.WithContext(ctx).
+		Where("waypoint_symbol = ?", waypointSymbol).
+		First(&model)
+	if result.Error != nil {
+		if result.Error == gorm.ErrRecordNotFound {
+			return nil
+		}
+		return fmt.Errorf("failed to read waypoint %s: %w", waypointSymbol, result.Error)
+	}
+
+	waypoint, err := r.modelToWaypoint(&model)
+	if err != nil {
+		return fmt.Errorf("failed to decode waypoint %s: %w", waypointSymbol, err)
+	}
+	waypoint.ApplyCapabilities(waypoint.Capabilities.WithMarketGoods(goods))
+
+	hasFuel := 0
+	if waypoint.HasFuel {
+		hasFuel = 1
+	}
+	result = r.db.WithContext(ctx).
+		Model(&WaypointModel{}).
+		Where("waypoint_symbol = ?", waypointSymbol).
+		Updates(map[string]interface{}{
+			"fuel_availability": string(waypoint.Capabilities.Fuel),
+			"has_fuel":          hasFuel,
+		})
+	if result.Error != nil {
+		return fmt.Errorf("failed to record market capabilities for %s: %w", waypointSymbol, result.Error)
+	}
+	return nil
+}
+
 func (r *GormWaypointRepository) modelsToWaypoints(models []WaypointModel) ([]*shared.Waypoint, error) {
 	waypoints := make([]*shared.Waypoint, 0, len(models))
 	for i := range models {
@@ -211,7 +262,6 @@ func (r *GormWaypointRepository) modelToWaypoint(model *WaypointModel) (*shared.
 
 	waypoint.SystemSymbol = model.SystemSymbol
 	waypoint.Type = model.Type
-	waypoint.HasFuel = model.HasFuel == 1
 
 	if model.Traits != "" {
 		var traits []string
@@ -231,6 +281,16 @@ func (r *GormWaypointRepository) modelToWaypoint(model *WaypointModel) (*shared.
 		waypoint.Orbitals = orbitals
 	}
 
+	caps := shared.CapabilitiesFromTraits(waypoint.Traits, model.UnderConstruction)
+	switch {
+	case model.FuelAvailability != "":
+		caps.Fuel = shared.FuelAvailability(model.FuelAvailability)
+	case model.HasFuel == 1 && caps.Fuel == shared.FuelAvailabilityNone:
+		// Pre-capability row flagged has_fuel by a source other than traits.
+		caps.Fuel = shared.FuelAvailabilityImplied
+	}
+	waypoint.ApplyCapabilities(caps)
+
 	return waypoint, nil
 }
 
@@ -269,5 +329,8 @@ func (r *GormWaypointRepository) waypointToModel(waypoint *shared.Waypoint) (*Wa
 		HasFuel:        hasFuel,
 		Orbitals:       orbitalsJSON,
 		SyncedAt:       time.Now().Format(time.RFC3339),
+
+		FuelAvailability:  string(waypoint.Capabilities.Fuel),
+		UnderConstruction: waypoint.Capabilities.UnderConstruction,
 	}, nil
 }
@@ -0,0 +1,250 @@
+package routing
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/andrescamacho/spacetraders-go/internal/application/common"
+	domainRouting "github.com/andrescamacho/spacetraders-go/internal/domain/routing"
+	"github.com/andrescamacho/spacetraders-go/internal/domain/shared"
+)
+
+// Backend names, as used in routing.backends and with domainRouting.WithBackend.
+const (
+	BackendORTools = "ortools"
+	BackendNative  = "native"
+	BackendGreedy  = "greedy"
+)
+
+// DefaultFailoverCooldown is how long a backend that timed out or was
+// unreachable is tried last, when the cooldown is unset.
+const DefaultFailoverCooldown = time.Minute
+
+// BackendTimeouts bound a single attempt on one backend, per operation family.
+// Zero leaves the attempt bounded only by the caller's context.
+type BackendTimeouts struct {
+	Route time.Duration // PlanRoute
+	Tour  time.Duration // OptimizeTour, OptimizeFueledTour, OptimizeTradeTour
+	Fleet time.Duration // PartitionFleet
+}
+
+// BackendRegistry is a RoutingClient over several named backends. A call goes
+// to the backend the context asks for (domainRouting.WithBackend), else to the
+// first registered, and moves on to the next when the attempt times out or the
+// backend is unreachable. Any other error is the backend's answer and is
+// returned as-is: a route the OR-Tools service found infeasible would not
+// become feasible elsewhere, and retrying would hide the reason.
+//
+// A backend that failed over is tried last for the cooldown, so an outage costs
+// one timeout per cooldown rather than one per call. The daemon keeps routing
+// through an OR-Tools outage, on cheaper plans, instead of parking every ship.
+type BackendRegistry struct {
+	timeouts BackendTimeouts
+	cooldown time.Duration
+	clock    shared.Clock
+
+	mu        sync.Mutex
+	backends  []namedBackend
+	coolUntil map[string]time.Time
+}
+
+type namedBackend struct {
+	name   string
+	client domainRouting.RoutingClient
+}
+
+// NewBackendRegistry creates an empty registry. cooldown <= 0 selects
+// DefaultFailoverCooldown. If clock is nil, uses RealClock.
+func NewBackendRegistry(timeouts BackendTimeouts, cooldown time.Duration, clock shared.Clock) *BackendRegistry {
+	if cooldown <= 0 {
+		cooldown = DefaultFailoverCooldown
+	}
+	if clock == nil {
+		clock = shared.NewRealClock()
+	}
+	return &BackendRegistry{
+		timeouts:  timeouts,
+		cooldown:  cooldown,
+		clock:     clock,
+		coolUntil: make(map[string]time.Time),
+	}
+}
+
+// Register appends a backend to the failover order.
+func (r *BackendRegistry) Register(name string, client domainRouting.RoutingClient) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for _, b := range r.backends {
+		if b.name == name {
+			return fmt.Errorf("routing backend %q already registered", name)
+		}
+	}
+	r.backends = append(r.backends, namedBackend{name: name, client: client})
+	return nil
+}
+
+// Names returns the registered backends in failover order.
+func (r *BackendRegistry) Names() []string {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	names := make([]string, len(r.backends))
+	for i, b := range r.backends {
+		names[i] = b.name
+	}
+	return names
+}
+
+// Close closes every backend that holds a connection.
+func (r *BackendRegistry) Close() error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	var errs []error
+	for _, b := range r.backends {
+		if closer, ok := b.client.(io.Closer); ok {
+			if err := closer.Close(); err != nil {
+				errs = append(errs, fmt.Errorf("close routing backend %s: %w", b.name, err))
+			}
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// PlanRoute implements RoutingClient.PlanRoute with failover
+func (r *BackendRegistry) PlanRoute(ctx context.Context, req *domainRouting.RouteRequest) (*domainRouting.RouteResponse, error) {
+	return withFailover(ctx, r, "PlanRoute", r.timeouts.Route, func(ctx context.Context, c domainRouting.RoutingClient) (*domainRouting.RouteResponse, error) {
+		return c.PlanRoute(ctx, req)
+	})
+}
+
+// OptimizeTour implements RoutingClient.OptimizeTour with failover
+func (r *BackendRegistry) OptimizeTour(ctx context.Context, req *domainRouting.TourRequest) (*domainRouting.TourResponse, error) {
+	return withFailover(ctx, r, "OptimizeTour", r.timeouts.Tour, func(ctx context.Context, c domainRouting.RoutingClient) (*domainRouting.TourResponse, error) {
+		return c.OptimizeTour(ctx, req)
+	})
+}
+
+// OptimizeFueledTour implements RoutingClient.OptimizeFueledTour with failover
+func (r *BackendRegistry) OptimizeFueledTour(ctx context.Context, req *domainRouting.FueledTourRequest) (*domainRouting.FueledTourResponse, error) {
+	return withFailover(ctx, r, "OptimizeFueledTour", r.timeouts.Tour, func(ctx context.Context, c domainRouting.RoutingClient) (*domainRouting.FueledTourResponse, error) {
+		return c.OptimizeFueledTour(ctx, req)
+	})
+}
+
+// PartitionFleet implements RoutingClient.PartitionFleet with failover
+func (r *BackendRegistry) PartitionFleet(ctx context.Context, req *domainRouting.VRPRequest) (*domainRouting.VRPResponse, error) {
+	return withFailover(ctx, r, "PartitionFleet", r.timeouts.Fleet, func(ctx context.Context, c domainRouting.RoutingClient) (*domainRouting.VRPResponse, error) {
+		return c.PartitionFleet(ctx, req)
+	})
+}
+
+// OptimizeTradeTour implements RoutingClient.OptimizeTradeTour with failover.
+// Only the OR-Tools backend plans trade tours; the others answer infeasible,
+// which the executor already treats as "trade single-lane".
+func (r *BackendRegistry) OptimizeTradeTour(
+	ctx context.Context,
+	snapshot []domainRouting.TourGoodSnapshot,
+	waypoints []domainRouting.TourWaypoint,
+	ship domainRouting.TourShipState,
+	cons domainRouting.TourConstraints,
+	deposits []domainRouting.TourDepositCandidate,
+	absorption []domainRouting.TourMarketAbsorption,
+) (*domainRouting.TourPlan, error) {
+	return withFailover(ctx, r, "OptimizeTradeTour", r.timeouts.Tour, func(ctx context.Context, c domainRouting.RoutingClient) (*domainRouting.TourPlan, error) {
+		return c.OptimizeTradeTour(ctx, snapshot, waypoints, ship, cons, deposits, absorption)
+	})
+}
+
+// withFailover runs call on each backend in attemptOrder until one answers.
+func withFailover[T any](
+	ctx context.Context,
+	r *BackendRegistry,
+	op string,
+	timeout time.Duration,
+	call func(context.Context, domainRouting.RoutingClient) (T, error),
+) (T, error) {
+	var zero T
+	order := r.attemptOrder(domainRouting.BackendFromContext(ctx))
+	if len(order) == 0 {
+		return zero, fmt.Errorf("no routing backends registered")
+	}
+
+	var lastErr error
+	for i, b := range order {
+		attemptCtx, cancel := ctx, context.CancelFunc(func() {})
+		if timeout > 0 {
+			attemptCtx, cancel = context.WithTimeout(ctx, timeout)
+		}
+		result, err := call(attemptCtx, b.client)
+		cancel()
+		if err == nil {
+			return result, nil
+		}
+		if ctx.Err() != nil || !isFailoverError(err) {
+			return zero, err
+		}
+
+		r.coolDown(b.name)
+		lastErr = err
+		if i+1 < len(order) {
+			common.LoggerFromContext(ctx).Log("WARNING", fmt.Sprintf("Routing backend %s failed %s, failing over to %s: %v", b.name, op, order[i+1].name, err), map[string]interface{}{
+				"action":   "routing_failover",
+				"backend":  b.name,
+				"next":     order[i+1].name,
+				"rpc":      op,
+				"cooldown": r.cooldown.String(),
+			})
+		}
+	}
+	return zero, fmt.Errorf("all routing backends failed %s: %w", op, lastErr)
+}
+
+// attemptOrder puts the requested backend first, then the rest in registration
+// order with those still cooling down moved to the back. A requested backend
+// is tried first even while cooling down: the caller asked for it.
+func (r *BackendRegistry) attemptOrder(requested string) []namedBackend {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	now := r.clock.Now()
+
+	order := make([]namedBackend, 0, len(r.backends))
+	var cooling []namedBackend
+	for _, b := range r.backends {
+		if b.name == requested {
+			order = append([]namedBackend{b}, order...)
+			continue
+		}
+		if now.Before(r.coolUntil[b.name]) {
+			cooling = append(cooling, b)
+			continue
+		}
+		order = append(order, b)
+	}
+	return append(order, cooling...)
+}
+
+func (r *BackendRegistry) coolDown(name string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.coolUntil[name] = r.clock.Now().Add(r.cooldown)
+}
+
+// isFailoverError reports whether err means the backend could not answer in
+// time (the attempt's deadline, or gRPC Unavailable/DeadlineExceeded) rather
+// than that it answered with a failure.
+func isFailoverError(err error) bool {
+	if errors.Is(err, context.DeadlineExceeded) {
+		return true
+	}
+	switch status.Code(err) {
+	case codes.Unavailable, codes.DeadlineExceeded:
+		return true
+	}
+	return false
+}
@@ -0,0 +1,135 @@
+package routing
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	domainRouting "github.com/andrescamacho/spacetraders-go/internal/domain/routing"
+	"github.com/andrescamacho/spacetraders-go/internal/domain/shared"
+)
+
+// scriptedBackend answers PlanRoute with err, or blocks until the attempt's
+// deadline when hang is set, and counts its calls.
+type scriptedBackend struct {
+	MockRoutingClient
+	name  string
+	err   error
+	hang  bool
+	calls int
+}
+
+func (b *scriptedBackend) PlanRoute(ctx context.Context, req *domainRouting.RouteRequest) (*domainRouting.RouteResponse, error) {
+	b.calls++
+	if b.hang {
+		<-ctx.Done()
+		return nil, ctx.Err()
+	}
+	if b.err != nil {
+		return nil, b.err
+	}
+	return &domainRouting.RouteResponse{Steps: []*domainRouting.RouteStepData{{Waypoint: b.name}}}, nil
+}
+
+func newTestRegistry(t *testing.T, clock shared.Clock, backends ...*scriptedBackend) *BackendRegistry {
+	t.Helper()
+	registry := NewBackendRegistry(BackendTimeouts{Route: 20 * time.Millisecond}, time.Minute, clock)
+	for _, b := range backends {
+		if err := registry.Register(b.name, b); err != nil {
+			t.Fatalf("register %s: %v", b.name, err)
+		}
+	}
+	return registry
+}
+
+func answeredBy(t *testing.T, resp *domainRouting.RouteResponse, err error) string {
+	t.Helper()
+	if err != nil {
+		t.Fatalf("PlanRoute: %v", err)
+	}
+	return resp.Steps[0].Waypoint
+}
+
+// A primary that times out hands the call to the next backend, and is then
+// tried last until its cooldown passes.
+func TestBackendRegistry_FailsOverOnTimeoutAndCoolsDown(t *testing.T) {
+	clock := &shared.MockClock{CurrentTime: time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)}
+	primary := &scriptedBackend{name: BackendORTools, hang: true}
+	native := &scriptedBackend{name: BackendNative}
+	registry := newTestRegistry(t, clock, primary, native)
+
+	resp, err := registry.PlanRoute(context.Background(), &domainRouting.RouteRequest{})
+	if got := answeredBy(t, resp, err); got != BackendNative {
+		t.Fatalf("expected failover to native, answered by %s", got)
+	}
+
+	resp, err = registry.PlanRoute(context.Background(), &domainRouting.RouteRequest{})
+	if got := answeredBy(t, resp, err); got != BackendNative || primary.calls != 1 {
+		t.Fatalf("cooling primary should be skipped: answered by %s, primary calls %d", got, primary.calls)
+	}
+
+	primary.hang = false
+	clock.CurrentTime = clock.CurrentTime.Add(2 * time.Minute)
+	resp, err = registry.PlanRoute(context.Background(), &domainRouting.RouteRequest{})
+	if got := answeredBy(t, resp, err); got != BackendORTools {
+		t.Fatalf("primary should be trusted again after the cooldown, answered by %s", got)
+	}
+}
+
+// Only "could not answer" errors fail over; a backend's own failure is its answer.
+func TestBackendRegistry_FailoverErrorsOnly(t *testing.T) {
+	unavailable := &scriptedBackend{name: BackendORTools, err: status.Error(codes.Unavailable, "connection refused")}
+	native := &scriptedBackend{name: BackendNative}
+	registry := newTestRegistry(t, nil, unavailable, native)
+	resp, err := registry.PlanRoute(context.Background(), &domainRouting.RouteRequest{})
+	if got := answeredBy(t, resp, err); got != BackendNative {
+		t.Fatalf("Unavailable should fail over, answered by %s", got)
+	}
+
+	infeasible := &scriptedBackend{name: BackendORTools, err: errors.New("routing failed: no path")}
+	native = &scriptedBackend{name: BackendNative}
+	registry = newTestRegistry(t, nil, infeasible, native)
+	if _, err := registry.PlanRoute(context.Background(), &domainRouting.RouteRequest{}); err == nil || native.calls != 0 {
+		t.Fatalf("a routing failure must be returned as-is: err %v, native calls %d", err, native.calls)
+	}
+}
+
+// The caller's own cancellation ends the call; it is not the backend's fault.
+func TestBackendRegistry_CallerCancellationDoesNotFailOver(t *testing.T) {
+	primary := &scriptedBackend{name: BackendORTools, hang: true}
+	native := &scriptedBackend{name: BackendNative}
+	registry := NewBackendRegistry(BackendTimeouts{}, time.Minute, nil)
+	_ = registry.Register(primary.name, primary)
+	_ = registry.Register(native.name, native)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	if _, err := registry.PlanRoute(ctx, &domainRouting.RouteRequest{}); err == nil || native.calls != 0 {
+		t.Fatalf("expected the caller's deadline to end the call: err %v, native calls %d", err, native.calls)
+	}
+}
+
+// WithBackend puts the requested backend first for that call only.
+func TestBackendRegistry_PerRequestSelection(t *testing.T) {
+	primary := &scriptedBackend{name: BackendORTools}
+	greedy := &scriptedBackend{name: BackendGreedy}
+	registry := newTestRegistry(t, nil, primary, greedy)
+
+	ctx := domainRouting.WithBackend(context.Background(), BackendGreedy)
+	resp, err := registry.PlanRoute(ctx, &domainRouting.RouteRequest{})
+	if got := answeredBy(t, resp, err); got != BackendGreedy {
+		t.Fatalf("expected the requested backend, answered by %s", got)
+	}
+	resp, err = registry.PlanRoute(context.Background(), &domainRouting.RouteRequest{})
+	if got := answeredBy(t, resp, err); got != BackendORTools {
+		t.Fatalf("unrequested calls go to the primary, answered by %s", got)
+	}
+
+	if err := registry.Register(BackendGreedy, greedy); err == nil {
+		t.Fatal("registering a duplicate name should fail")
+	}
+}
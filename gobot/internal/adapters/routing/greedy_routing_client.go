@@ -0,0 +1,93 @@
+package routing
+
+import (
+	"context"
+	"fmt"
+
+	domainRouting "github.com/andrescamacho/spacetraders-go/internal/domain/routing"
+)
+
+// GreedyRoutingClient is the last-resort routing backend. PlanRoute flies
+// straight to the goal, refuelling first when the start sells fuel, and falls
+// back to one stop at the nearest fuel station only when even DRIFT cannot make
+// the direct hop. It does no search, so it answers instantly for any system
+// size; tours and fleets share the native backend's greedy heuristics.
+type GreedyRoutingClient struct{}
+
+// NewGreedyRoutingClient creates a new greedy routing client
+func NewGreedyRoutingClient() *GreedyRoutingClient {
+	return &GreedyRoutingClient{}
+}
+
+// PlanRoute returns a direct hop, or a hop via the nearest fuel station
+func (c *GreedyRoutingClient) PlanRoute(ctx context.Context, req *domainRouting.RouteRequest) (*domainRouting.RouteResponse, error) {
+	start, goal, err := routeEndpoints(req)
+	if err != nil {
+		return nil, err
+	}
+	if start.Symbol == goal.Symbol {
+		return &domainRouting.RouteResponse{Steps: []*domainRouting.RouteStepData{}}, nil
+	}
+
+	modes := hopModes(req)
+	fuel := req.CurrentFuel
+	if start.HasFuel {
+		fuel = req.FuelCapacity
+	}
+
+	distance := calculateDistance(start.X, start.Y, goal.X, goal.Y)
+	if mode, ok := pickMode(modes, distance, fuel); ok {
+		hops := []hop{{to: goal, mode: mode, fuel: mode.FuelCost(distance), seconds: mode.TravelTime(distance, req.EngineSpeed), distance: distance}}
+		return buildRouteResponse(start, hops, req.CurrentFuel, req.FuelCapacity), nil
+	}
+
+	station := findNearestFuelStation(start, req.Waypoints)
+	if station == nil {
+		return nil, fmt.Errorf("routing failed: %s is out of range of %s and no fuel station is reachable", goal.Symbol, start.Symbol)
+	}
+	toStation := calculateDistance(start.X, start.Y, station.X, station.Y)
+	toGoal := calculateDistance(station.X, station.Y, goal.X, goal.Y)
+	firstMode, ok := pickMode(modes, toStation, fuel)
+	if !ok {
+		return nil, fmt.Errorf("routing failed: nearest fuel station %s is out of range of %s", station.Symbol, start.Symbol)
+	}
+	secondMode, ok := pickMode(modes, toGoal, req.FuelCapacity)
+	if !ok {
+		return nil, fmt.Errorf("routing failed: %s is out of range of fuel station %s", goal.Symbol, station.Symbol)
+	}
+	hops := []hop{
+		{to: station, mode: firstMode, fuel: firstMode.FuelCost(toStation), seconds: firstMode.TravelTime(toStation, req.EngineSpeed), distance: toStation},
+		{to: goal, mode: secondMode, fuel: secondMode.FuelCost(toGoal), seconds: secondMode.TravelTime(toGoal, req.EngineSpeed), distance: toGoal},
+	}
+	return buildRouteResponse(start, hops, req.CurrentFuel, req.FuelCapacity), nil
+}
+
+// OptimizeTour visits the targets nearest-first and returns to the start.
+func (c *GreedyRoutingClient) OptimizeTour(ctx context.Context, req *domainRouting.TourRequest) (*domainRouting.TourResponse, error) {
+	return planTour(ctx, c.PlanRoute, req)
+}
+
+// OptimizeFueledTour visits the targets nearest-first, then the return waypoint
+// if one is set, carrying fuel from leg to leg.
+func (c *GreedyRoutingClient) OptimizeFueledTour(ctx context.Context, req *domainRouting.FueledTourRequest) (*domainRouting.FueledTourResponse, error) {
+	return planFueledTour(ctx, c.PlanRoute, req)
+}
+
+// PartitionFleet hands markets out round-robin, each ship taking the unassigned
+// market nearest its last one.
+func (c *GreedyRoutingClient) PartitionFleet(ctx context.Context, req *domainRouting.VRPRequest) (*domainRouting.VRPResponse, error) {
+	return partitionFleetGreedy(req), nil
+}
+
+// OptimizeTradeTour always reports an infeasible plan; see NativeRoutingClient.
+func (c *GreedyRoutingClient) OptimizeTradeTour(
+	ctx context.Context,
+	snapshot []domainRouting.TourGoodSnapshot,
+	waypoints []domainRouting.TourWaypoint,
+	ship domainRouting.TourShipState,
+	cons domainRouting.TourConstraints,
+	deposits []domainRouting.TourDepositCandidate,
+	absorption []domainRouting.TourMarketAbsorption,
+) (*domainRouting.TourPlan, error) {
+	return &domainRouting.TourPlan{Feasible: false, InfeasibleReason: "greedy routing backend has no trade tour solver"}, nil
+}
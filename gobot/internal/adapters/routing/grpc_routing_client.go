@@ -86,9 +86,16 @@ func (c *GRPCRoutingClient) PlanRoute(ctx context.Context, req *domainRouting.Ro
 		FuelCapacity:  int32(req.FuelCapacity),
 		EngineSpeed:   int32(req.EngineSpeed),
 		Waypoints:     convertWaypointsToPb(req.Waypoints),
-		FuelEfficient: req.FuelEfficient,
+		FuelEfficient: req.FuelEfficient && !req.AvoidDrift,
 		PreferCruise:  req.PreferCruise,
 	}
+	if !req.Objective.IsZero() {
+		pbReq.Objective = &pb.RouteObjective{
+			TimeWeight:       req.Objective.TimeWeight,
+			FuelWeight:       req.Objective.FuelWeight,
+			RefuelStopWeight: req.Objective.RefuelStopWeight,
+		}
+	}
 
 	pbResp, err := c.client.PlanRoute(ctx, pbReq)
 	if err != nil {
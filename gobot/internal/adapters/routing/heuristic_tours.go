@@ -0,0 +1,203 @@
+package routing
+
+import (
+	"context"
+	"math"
+
+	domainRouting "github.com/andrescamacho/spacetraders-go/internal/domain/routing"
+	"github.com/andrescamacho/spacetraders-go/internal/domain/system"
+)
+
+// planRouteFunc plans a single leg; the in-process backends pass their PlanRoute.
+type planRouteFunc func(ctx context.Context, req *domainRouting.RouteRequest) (*domainRouting.RouteResponse, error)
+
+// planTour orders the targets nearest-first and chains PlanRoute legs through
+// them and back to the start. Each leg assumes a full tank, as the OR-Tools
+// OptimizeTour does.
+func planTour(ctx context.Context, plan planRouteFunc, req *domainRouting.TourRequest) (*domainRouting.TourResponse, error) {
+	byName := waypointIndex(req.AllWaypoints)
+	order := nearestFirst(byName, req.StartWaypoint, req.Waypoints)
+
+	resp := &domainRouting.TourResponse{VisitOrder: order, CombinedRoute: []*domainRouting.RouteStepData{}}
+	stops := append(append([]string{}, order...), req.StartWaypoint)
+	from := req.StartWaypoint
+	for _, to := range stops {
+		leg, err := plan(ctx, &domainRouting.RouteRequest{
+			SystemSymbol:  req.SystemSymbol,
+			StartWaypoint: from,
+			GoalWaypoint:  to,
+			CurrentFuel:   req.FuelCapacity,
+			FuelCapacity:  req.FuelCapacity,
+			EngineSpeed:   req.EngineSpeed,
+			Waypoints:     req.AllWaypoints,
+		})
+		if err != nil {
+			return nil, err
+		}
+		resp.CombinedRoute = append(resp.CombinedRoute, leg.Steps...)
+		resp.TotalTimeSeconds += leg.TotalTimeSeconds
+		from = to
+	}
+	return resp, nil
+}
+
+// planFueledTour orders the targets nearest-first and plans a PlanRoute leg to
+// each, then to ReturnWaypoint if set. Fuel is carried between legs: a leg's
+// refuels top the tank up, its travel drains it.
+func planFueledTour(ctx context.Context, plan planRouteFunc, req *domainRouting.FueledTourRequest) (*domainRouting.FueledTourResponse, error) {
+	byName := waypointIndex(req.AllWaypoints)
+	order := nearestFirst(byName, req.StartWaypoint, req.TargetWaypoints)
+	stops := append([]string{}, order...)
+	if req.ReturnWaypoint != "" {
+		stops = append(stops, req.ReturnWaypoint)
+	}
+
+	resp := &domainRouting.FueledTourResponse{VisitOrder: order, Legs: make([]*domainRouting.TourLegData, 0, len(stops))}
+	from := req.StartWaypoint
+	fuel := req.CurrentFuel
+	for _, to := range stops {
+		route, err := plan(ctx, &domainRouting.RouteRequest{
+			SystemSymbol:  req.SystemSymbol,
+			StartWaypoint: from,
+			GoalWaypoint:  to,
+			CurrentFuel:   fuel,
+			FuelCapacity:  req.FuelCapacity,
+			EngineSpeed:   req.EngineSpeed,
+			Waypoints:     req.AllWaypoints,
+		})
+		if err != nil {
+			return nil, err
+		}
+		leg, endFuel := tourLegFromRoute(from, to, route, fuel, req.FuelCapacity)
+		fuel = endFuel
+		resp.Legs = append(resp.Legs, leg)
+		resp.TotalTimeSeconds += route.TotalTimeSeconds
+		resp.TotalFuelCost += route.TotalFuelCost
+		resp.TotalDistance += route.TotalDistance
+		if leg.RefuelBefore {
+			resp.RefuelStops++
+		}
+		for _, stop := range leg.IntermediateStops {
+			if stop.RefuelAmount > 0 {
+				resp.RefuelStops++
+			}
+		}
+		from = to
+	}
+	return resp, nil
+}
+
+// tourLegFromRoute folds a planned route into one tour leg: a refuel at the
+// origin becomes RefuelBefore, and every waypoint passed on the way becomes an
+// intermediate stop. The leg's flight mode is that of its last hop.
+func tourLegFromRoute(from, to string, route *domainRouting.RouteResponse, fuel, capacity int) (*domainRouting.TourLegData, int) {
+	leg := &domainRouting.TourLegData{
+		FromWaypoint:      from,
+		ToWaypoint:        to,
+		FlightMode:        defaultFlightMode,
+		FuelCost:          route.TotalFuelCost,
+		TimeSeconds:       route.TotalTimeSeconds,
+		Distance:          route.TotalDistance,
+		IntermediateStops: []*domainRouting.IntermediateStopData{},
+	}
+	var stop *domainRouting.IntermediateStopData
+	for _, step := range route.Steps {
+		switch step.Action {
+		case domainRouting.RouteActionRefuel:
+			if stop == nil {
+				leg.RefuelBefore = true
+				leg.RefuelAmount = capacity - fuel
+			} else {
+				stop.RefuelAmount = capacity - fuel
+			}
+			fuel = capacity
+		case domainRouting.RouteActionTravel:
+			fuel -= step.FuelCost
+			leg.FlightMode = step.Mode
+			if step.Waypoint != to {
+				stop = &domainRouting.IntermediateStopData{
+					Waypoint:    step.Waypoint,
+					FlightMode:  step.Mode,
+					FuelCost:    step.FuelCost,
+					TimeSeconds: step.TimeSeconds,
+				}
+				leg.IntermediateStops = append(leg.IntermediateStops, stop)
+			}
+		}
+	}
+	return leg, fuel
+}
+
+// partitionFleetGreedy deals markets to ships in turn, each ship taking the
+// unassigned market nearest the last waypoint on its list (its current
+// location to begin with). Counts stay within one of each other.
+func partitionFleetGreedy(req *domainRouting.VRPRequest) *domainRouting.VRPResponse {
+	byName := waypointIndex(req.AllWaypoints)
+	assignments := make(map[string]*domainRouting.ShipTourData)
+	tails := make(map[string]string, len(req.ShipSymbols))
+	for _, ship := range req.ShipSymbols {
+		if cfg := req.ShipConfigs[ship]; cfg != nil {
+			tails[ship] = cfg.CurrentLocation
+		}
+	}
+
+	remaining := append([]string{}, req.MarketWaypoints...)
+	for len(remaining) > 0 && len(req.ShipSymbols) > 0 {
+		for _, ship := range req.ShipSymbols {
+			if len(remaining) == 0 {
+				break
+			}
+			pick := nearestIndex(byName, tails[ship], remaining)
+			market := remaining[pick]
+			remaining = append(remaining[:pick], remaining[pick+1:]...)
+
+			tour := assignments[ship]
+			if tour == nil {
+				tour = &domainRouting.ShipTourData{Route: []*domainRouting.RouteStepData{}}
+				assignments[ship] = tour
+			}
+			tour.Waypoints = append(tour.Waypoints, market)
+			tails[ship] = market
+		}
+	}
+	return &domainRouting.VRPResponse{Assignments: assignments}
+}
+
+func waypointIndex(waypoints []*system.WaypointData) map[string]*system.WaypointData {
+	byName := make(map[string]*system.WaypointData, len(waypoints))
+	for _, wp := range waypoints {
+		byName[wp.Symbol] = wp
+	}
+	return byName
+}
+
+// nearestFirst orders targets by repeatedly visiting the closest unvisited one.
+func nearestFirst(byName map[string]*system.WaypointData, start string, targets []string) []string {
+	remaining := append([]string{}, targets...)
+	order := make([]string, 0, len(targets))
+	at := start
+	for len(remaining) > 0 {
+		pick := nearestIndex(byName, at, remaining)
+		at = remaining[pick]
+		order = append(order, at)
+		remaining = append(remaining[:pick], remaining[pick+1:]...)
+	}
+	return order
+}
+
+// nearestIndex returns the index in candidates of the waypoint closest to from.
+// Unknown waypoints sort last, so the first candidate wins when nothing is known.
+func nearestIndex(byName map[string]*system.WaypointData, from string, candidates []string) int {
+	origin := byName[from]
+	best, bestDistance := 0, math.MaxFloat64
+	for i, symbol := range candidates {
+		wp := byName[symbol]
+		if origin == nil || wp == nil {
+			continue
+		}
+		if d := calculateDistance(origin.X, origin.Y, wp.X, wp.Y); d < bestDistance {
+			best, bestDistance = i, d
+		}
+	}
+	return best
+}
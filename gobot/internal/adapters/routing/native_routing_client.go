@@ -0,0 +1,328 @@
+package routing
+
+import (
+	"container/heap"
+	"context"
+	"fmt"
+
+	domainRouting "github.com/andrescamacho/spacetraders-go/internal/domain/routing"
+	"github.com/andrescamacho/spacetraders-go/internal/domain/shared"
+	"github.com/andrescamacho/spacetraders-go/internal/domain/system"
+)
+
+// NativeRoutingClient plans routes in-process, without the OR-Tools service. It
+// is the failover backend when the service is down or slow: PlanRoute is a
+// fuel-aware Dijkstra over the system's waypoints, tours are nearest-neighbour
+// orderings of PlanRoute legs, and fleets are partitioned greedily. Plans are
+// good, not optimal. It has no market model, so OptimizeTradeTour always
+// returns an infeasible plan and the tour executor falls back to single-lane
+// trading.
+type NativeRoutingClient struct{}
+
+// NewNativeRoutingClient creates a new native routing client
+func NewNativeRoutingClient() *NativeRoutingClient {
+	return &NativeRoutingClient{}
+}
+
+// PlanRoute finds the cheapest path from start to goal under the request's
+// objective — the fastest path when none is set. Every fuel station on the way
+// is assumed to be a refuel opportunity, and each hop takes the flight mode the
+// tank allows that costs least (BURN, CRUISE or DRIFT; BURN is skipped when
+// PreferCruise or FuelEfficient is set). Refuel steps are emitted only where
+// the fuel aboard would not carry the ship to the next station.
+func (c *NativeRoutingClient) PlanRoute(ctx context.Context, req *domainRouting.RouteRequest) (*domainRouting.RouteResponse, error) {
+	start, goal, err := routeEndpoints(req)
+	if err != nil {
+		return nil, err
+	}
+	if start.Symbol == goal.Symbol {
+		return &domainRouting.RouteResponse{Steps: []*domainRouting.RouteStepData{}}, nil
+	}
+
+	modes := hopModes(req)
+	hops, err := cheapestPath(ctx, req, start, goal, modes)
+	if err != nil {
+		return nil, err
+	}
+	return buildRouteResponse(start, hops, req.CurrentFuel, req.FuelCapacity), nil
+}
+
+// OptimizeTour visits the targets nearest-first and returns to the start.
+func (c *NativeRoutingClient) OptimizeTour(ctx context.Context, req *domainRouting.TourRequest) (*domainRouting.TourResponse, error) {
+	return planTour(ctx, c.PlanRoute, req)
+}
+
+// OptimizeFueledTour visits the targets nearest-first, then the return waypoint
+// if one is set, carrying fuel from leg to leg.
+func (c *NativeRoutingClient) OptimizeFueledTour(ctx context.Context, req *domainRouting.FueledTourRequest) (*domainRouting.FueledTourResponse, error) {
+	return planFueledTour(ctx, c.PlanRoute, req)
+}
+
+// PartitionFleet hands markets out round-robin, each ship taking the unassigned
+// market nearest its last one.
+func (c *NativeRoutingClient) PartitionFleet(ctx context.Context, req *domainRouting.VRPRequest) (*domainRouting.VRPResponse, error) {
+	return partitionFleetGreedy(req), nil
+}
+
+// OptimizeTradeTour has no market model to plan against; the infeasible plan
+// makes the executor fail open to single-lane trading.
+func (c *NativeRoutingClient) OptimizeTradeTour(
+	ctx context.Context,
+	snapshot []domainRouting.TourGoodSnapshot,
+	waypoints []domainRouting.TourWaypoint,
+	ship domainRouting.TourShipState,
+	cons domainRouting.TourConstraints,
+	deposits []domainRouting.TourDepositCandidate,
+	absorption []domainRouting.TourMarketAbsorption,
+) (*domainRouting.TourPlan, error) {
+	return &domainRouting.TourPlan{Feasible: false, InfeasibleReason: "native routing backend has no trade tour solver"}, nil
+}
+
+// hop is one TRAVEL leg of a planned path.
+type hop struct {
+	to       *system.WaypointData
+	mode     shared.FlightMode
+	fuel     int
+	seconds  int
+	distance float64
+}
+
+// hopModes lists the flight modes req lets a hop use, fastest first.
+func hopModes(req *domainRouting.RouteRequest) []shared.FlightMode {
+	modes := []shared.FlightMode{shared.FlightModeBurn, shared.FlightModeCruise, shared.FlightModeDrift}
+	if req.PreferCruise || req.FuelEfficient {
+		modes = modes[1:]
+	}
+	if req.AvoidDrift {
+		modes = modes[:len(modes)-1]
+	}
+	return modes
+}
+
+// pickMode returns the fastest mode in modes whose fuel cost fits in fuel.
+func pickMode(modes []shared.FlightMode, distance float64, fuel int) (shared.FlightMode, bool) {
+	for _, mode := range modes {
+		if mode.FuelCost(distance) <= fuel {
+			return mode, true
+		}
+	}
+	return shared.FlightModeDrift, false
+}
+
+func routeEndpoints(req *domainRouting.RouteRequest) (start, goal *system.WaypointData, err error) {
+	for _, wp := range req.Waypoints {
+		if wp.Symbol == req.StartWaypoint {
+			start = wp
+		}
+		if wp.Symbol == req.GoalWaypoint {
+			goal = wp
+		}
+	}
+	if start == nil {
+		return nil, nil, fmt.Errorf("routing failed: start waypoint %s not in waypoint list", req.StartWaypoint)
+	}
+	if goal == nil {
+		return nil, nil, fmt.Errorf("routing failed: goal waypoint %s not in waypoint list", req.GoalWaypoint)
+	}
+	return start, goal, nil
+}
+
+// cheapestMode returns the mode in modes, among those whose fuel cost fits in
+// departFuel, that the objective scores lowest, with the hop's cost. A hop
+// burning more than arrivalFuel counts as a refuel stop. Ties go to the
+// earlier, faster mode.
+func cheapestMode(modes []shared.FlightMode, cell domainRouting.MatrixCell, departFuel, arrivalFuel int, objective domainRouting.RouteObjective) (shared.FlightMode, float64, bool) {
+	best, bestCost, found := shared.FlightModeDrift, 0.0, false
+	for _, mode := range modes {
+		fuel := cell.FuelCost(mode)
+		if fuel > departFuel {
+			continue
+		}
+		refuels := 0
+		if fuel > arrivalFuel {
+			refuels = 1
+		}
+		cost := objective.Cost(cell.TravelTime(mode), fuel, refuels)
+		if !found || cost < bestCost {
+			best, bestCost, found = mode, cost, true
+		}
+	}
+	return best, bestCost, found
+}
+
+// hopCells prices the hops between a request's waypoints, from its distance
+// matrix when that covers them and by computing each distance otherwise.
+type hopCells struct {
+	req  *domainRouting.RouteRequest
+	rows []int // request waypoint index → matrix row; nil without a matrix
+}
+
+func newHopCells(req *domainRouting.RouteRequest) hopCells {
+	cells := hopCells{req: req}
+	if !req.Matrix.Covers(req.EngineSpeed, req.Waypoints) {
+		return cells
+	}
+	cells.rows = make([]int, len(req.Waypoints))
+	for i, wp := range req.Waypoints {
+		cells.rows[i], _ = req.Matrix.Index(wp.Symbol)
+	}
+	return cells
+}
+
+func (h hopCells) cell(from, to int) domainRouting.MatrixCell {
+	if h.rows != nil {
+		return h.req.Matrix.Cells[h.rows[from]][h.rows[to]]
+	}
+	a, b := h.req.Waypoints[from], h.req.Waypoints[to]
+	return domainRouting.NewMatrixCell(calculateDistance(a.X, a.Y, b.X, b.Y), h.req.EngineSpeed)
+}
+
+// pathLabel is a Dijkstra label: the cheapest known arrival at a waypoint, the
+// fuel left on arrival, and the hop that got there.
+type pathLabel struct {
+	cost float64
+	fuel int
+	prev int // index into waypoints, -1 at the start
+	via  hop
+	done bool
+}
+
+// cheapestPath runs Dijkstra on the objective's cost (travel time by
+// default). A label carries the fuel left on arrival; departing a fuel station
+// resets it to capacity. Keeping one label per waypoint makes this a heuristic
+// (a costlier arrival with more fuel can be the better one), which is the trade
+// for staying quadratic.
+func cheapestPath(ctx context.Context, req *domainRouting.RouteRequest, start, goal *system.WaypointData, modes []shared.FlightMode) ([]hop, error) {
+	waypoints := req.Waypoints
+	index := make(map[string]int, len(waypoints))
+	for i, wp := range waypoints {
+		index[wp.Symbol] = i
+	}
+
+	cells := newHopCells(req)
+	labels := make([]*pathLabel, len(waypoints))
+	startIdx := index[start.Symbol]
+	labels[startIdx] = &pathLabel{fuel: req.CurrentFuel, prev: -1}
+	queue := &labelQueue{}
+	heap.Push(queue, labelEntry{idx: startIdx})
+
+	goalIdx := index[goal.Symbol]
+	for queue.Len() > 0 {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		entry := heap.Pop(queue).(labelEntry)
+		current := labels[entry.idx]
+		if current.done || entry.cost != current.cost {
+			continue
+		}
+		current.done = true
+		if entry.idx == goalIdx {
+			break
+		}
+
+		from := waypoints[entry.idx]
+		departFuel := current.fuel
+		if from.HasFuel {
+			departFuel = req.FuelCapacity
+		}
+		for i, to := range waypoints {
+			if i == entry.idx || (labels[i] != nil && labels[i].done) {
+				continue
+			}
+			cell := cells.cell(entry.idx, i)
+			mode, hopCost, ok := cheapestMode(modes, cell, departFuel, current.fuel, req.Objective)
+			if !ok {
+				continue
+			}
+			fuel := cell.FuelCost(mode)
+			seconds := cell.TravelTime(mode)
+			distance := cell.Distance
+			cost := current.cost + hopCost
+			if labels[i] != nil && labels[i].cost <= cost {
+				continue
+			}
+			labels[i] = &pathLabel{
+				cost: cost,
+				fuel: departFuel - fuel,
+				prev: entry.idx,
+				via:  hop{to: to, mode: mode, fuel: fuel, seconds: seconds, distance: distance},
+			}
+			heap.Push(queue, labelEntry{idx: i, cost: cost})
+		}
+	}
+
+	if labels[goalIdx] == nil {
+		return nil, fmt.Errorf("routing failed: no path from %s to %s within fuel capacity %d", start.Symbol, goal.Symbol, req.FuelCapacity)
+	}
+	var hops []hop
+	for i := goalIdx; labels[i].prev >= 0; i = labels[i].prev {
+		hops = append(hops, labels[i].via)
+	}
+	for l, r := 0, len(hops)-1; l < r; l, r = l+1, r-1 {
+		hops[l], hops[r] = hops[r], hops[l]
+	}
+	return hops, nil
+}
+
+// buildRouteResponse turns hops into route steps, inserting a REFUEL before a
+// hop out of a fuel station only when the fuel aboard would not reach the next
+// station (or the goal).
+func buildRouteResponse(start *system.WaypointData, hops []hop, currentFuel, capacity int) *domainRouting.RouteResponse {
+	resp := &domainRouting.RouteResponse{Steps: []*domainRouting.RouteStepData{}}
+	fuel := currentFuel
+	at := start
+	for i, h := range hops {
+		if at.HasFuel && fuel < fuelToNextStation(hops[i:]) {
+			resp.Steps = append(resp.Steps, &domainRouting.RouteStepData{
+				Action:   domainRouting.RouteActionRefuel,
+				Waypoint: at.Symbol,
+			})
+			fuel = capacity
+		}
+		resp.Steps = append(resp.Steps, &domainRouting.RouteStepData{
+			Action:      domainRouting.RouteActionTravel,
+			Waypoint:    h.to.Symbol,
+			FuelCost:    h.fuel,
+			TimeSeconds: h.seconds,
+			Mode:        h.mode.Name(),
+		})
+		fuel -= h.fuel
+		resp.TotalFuelCost += h.fuel
+		resp.TotalTimeSeconds += h.seconds
+		resp.TotalDistance += h.distance
+		at = h.to
+	}
+	return resp
+}
+
+// fuelToNextStation sums the fuel of hops up to and including the first one
+// that lands on a fuel station.
+func fuelToNextStation(hops []hop) int {
+	total := 0
+	for _, h := range hops {
+		total += h.fuel
+		if h.to.HasFuel {
+			break
+		}
+	}
+	return total
+}
+
+type labelEntry struct {
+	idx  int
+	cost float64
+}
+
+type labelQueue []labelEntry
+
+func (q labelQueue) Len() int            { return len(q) }
+func (q labelQueue) Less(i, j int) bool  { return q[i].cost < q[j].cost }
+func (q labelQueue) Swap(i, j int)       { q[i], q[j] = q[j], q[i] }
+func (q *labelQueue) Push(x interface{}) { *q = append(*q, x.(labelEntry)) }
+func (q *labelQueue) Pop() interface{} {
+	old := *q
+	item := old[len(old)-1]
+	*q = old[:len(old)-1]
+	return item
+}
@@ -0,0 +1,161 @@
+package routing
+
+import (
+	"context"
+	"testing"
+
+	domainRouting "github.com/andrescamacho/spacetraders-go/internal/domain/routing"
+	"github.com/andrescamacho/spacetraders-go/internal/domain/system"
+)
+
+// A line of waypoints 100 apart with fuel at B: a 150-fuel tank cannot cruise
+// A→C (200) in one hop, so the native planner stops at B, refuels there only
+// because the fuel aboard would not reach C, and flies the fastest mode each
+// tank allows.
+func TestNativeRoutingClient_PlanRouteRefuelsOnlyWhenNeeded(t *testing.T) {
+	waypoints := []*system.WaypointData{
+		{Symbol: "X1-A", X: 0, Y: 0},
+		{Symbol: "X1-B", X: 100, Y: 0, HasFuel: true},
+		{Symbol: "X1-C", X: 200, Y: 0},
+	}
+	client := NewNativeRoutingClient()
+
+	resp, err := client.PlanRoute(context.Background(), &domainRouting.RouteRequest{
+		StartWaypoint: "X1-A",
+		GoalWaypoint:  "X1-C",
+		CurrentFuel:   150,
+		FuelCapacity:  150,
+		EngineSpeed:   30,
+		Waypoints:     waypoints,
+		PreferCruise:  true,
+	})
+	if err != nil {
+		t.Fatalf("PlanRoute: %v", err)
+	}
+
+	var got []string
+	for _, step := range resp.Steps {
+		if step.Action == domainRouting.RouteActionRefuel {
+			got = append(got, "REFUEL@"+step.Waypoint)
+		} else {
+			got = append(got, step.Mode+"@"+step.Waypoint)
+		}
+	}
+	want := []string{"CRUISE@X1-B", "REFUEL@X1-B", "CRUISE@X1-C"}
+	if len(got) != len(want) {
+		t.Fatalf("steps = %v, want %v", got, want)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Fatalf("steps = %v, want %v", got, want)
+		}
+	}
+	if resp.TotalFuelCost != 200 || resp.TotalDistance != 200 {
+		t.Fatalf("totals = fuel %d distance %.0f, want 200/200", resp.TotalFuelCost, resp.TotalDistance)
+	}
+}
+
+// With no fuel station in range the native planner reports a routing failure,
+// which the registry returns rather than failing over.
+func TestNativeRoutingClient_PlanRouteOutOfRange(t *testing.T) {
+	_, err := NewNativeRoutingClient().PlanRoute(context.Background(), &domainRouting.RouteRequest{
+		StartWaypoint: "X1-A",
+		GoalWaypoint:  "X1-B",
+		CurrentFuel:   0,
+		FuelCapacity:  0,
+		EngineSpeed:   30,
+		Waypoints: []*system.WaypointData{
+			{Symbol: "X1-A", X: 0, Y: 0},
+			{Symbol: "X1-B", X: 500, Y: 0},
+		},
+	})
+	if err == nil || isFailoverError(err) {
+		t.Fatalf("expected a non-failover routing error, got %v", err)
+	}
+}
+
+// Markets are dealt so each ship takes the one nearest its last stop.
+func TestPartitionFleetGreedy_NearestFirstRoundRobin(t *testing.T) {
+	resp := partitionFleetGreedy(&domainRouting.VRPRequest{
+		ShipSymbols:     []string{"SHIP-1", "SHIP-2"},
+		MarketWaypoints: []string{"X1-W2", "X1-E1", "X1-W1", "X1-E2"},
+		ShipConfigs: map[string]*domainRouting.ShipConfigData{
+			"SHIP-1": {CurrentLocation: "X1-W0"},
+			"SHIP-2": {CurrentLocation: "X1-E0"},
+		},
+		AllWaypoints: []*system.WaypointData{
+			{Symbol: "X1-W0", X: -10}, {Symbol: "X1-W1", X: -20}, {Symbol: "X1-W2", X: -30},
+			{Symbol: "X1-E0", X: 10}, {Symbol: "X1-E1", X: 20}, {Symbol: "X1-E2", X: 30},
+		},
+	})
+	west := resp.Assignments["SHIP-1"].Waypoints
+	east := resp.Assignments["SHIP-2"].Waypoints
+	if len(west) != 2 || west[0] != "X1-W1" || west[1] != "X1-W2" {
+		t.Fatalf("SHIP-1 got %v, want [X1-W1 X1-W2]", west)
+	}
+	if len(east) != 2 || east[0] != "X1-E1" || east[1] != "X1-E2" {
+		t.Fatalf("SHIP-2 got %v, want [X1-E1 X1-E2]", east)
+	}
+}
+
+// The objective picks the flight mode: the default plans the fastest hop
+// (BURN), a fuel-weighted objective trades time for fuel.
+func TestNativeRoutingClient_PlanRouteFollowsObjective(t *testing.T) {
+	waypoints := []*system.WaypointData{
+		{Symbol: "X1-A", X: 0, Y: 0},
+		{Symbol: "X1-B", X: 100, Y: 0},
+	}
+	plan := func(objective domainRouting.RouteObjective) *domainRouting.RouteResponse {
+		t.Helper()
+		resp, err := NewNativeRoutingClient().PlanRoute(context.Background(), &domainRouting.RouteRequest{
+			StartWaypoint: "X1-A",
+			GoalWaypoint:  "X1-B",
+			CurrentFuel:   400,
+			FuelCapacity:  400,
+			EngineSpeed:   30,
+			Waypoints:     waypoints,
+			Objective:     objective,
+		})
+		if err != nil {
+			t.Fatalf("PlanRoute: %v", err)
+		}
+		return resp
+	}
+
+	fastest := plan(domainRouting.RouteObjective{})
+	if len(fastest.Steps) != 1 || fastest.Steps[0].Mode != "BURN" {
+		t.Fatalf("default objective should BURN the hop, got %+v", fastest.Steps[0])
+	}
+	saver := plan(domainRouting.ObjectiveFuelSaver)
+	if saver.TotalFuelCost >= fastest.TotalFuelCost || saver.TotalTimeSeconds <= fastest.TotalTimeSeconds {
+		t.Fatalf("fuel saver should burn less fuel for more time: saver %d fuel/%ds, fastest %d fuel/%ds",
+			saver.TotalFuelCost, saver.TotalTimeSeconds, fastest.TotalFuelCost, fastest.TotalTimeSeconds)
+	}
+}
+
+// A matrix covering the request's waypoints prices the hops in place of the
+// coordinates, so a planner fed the cached matrix never recomputes distances.
+func TestNativeRoutingClient_PlanRouteUsesCoveringMatrix(t *testing.T) {
+	waypoints := []*system.WaypointData{
+		{Symbol: "X1-A", X: 0, Y: 0},
+		{Symbol: "X1-B", X: 100, Y: 0},
+	}
+	matrix := domainRouting.NewDistanceMatrix("X1", 30, waypoints)
+	matrix.Cells[0][1] = domainRouting.NewMatrixCell(40, 30)
+
+	resp, err := NewNativeRoutingClient().PlanRoute(context.Background(), &domainRouting.RouteRequest{
+		StartWaypoint: "X1-A",
+		GoalWaypoint:  "X1-B",
+		CurrentFuel:   400,
+		FuelCapacity:  400,
+		EngineSpeed:   30,
+		Waypoints:     waypoints,
+		Matrix:        matrix,
+	})
+	if err != nil {
+		t.Fatalf("PlanRoute: %v", err)
+	}
+	if resp.TotalDistance != 40 {
+		t.Fatalf("distance = %.0f, want the matrix's 40", resp.TotalDistance)
+	}
+}
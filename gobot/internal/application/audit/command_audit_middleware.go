@@ -0,0 +1,275 @@
+// Package audit holds the mediator's command audit trail: a middleware that
+// records every dispatched command and the recorder that persists the records
+// off the dispatch path and applies the retention policy.
+package audit
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/andrescamacho/spacetraders-go/internal/application/logging"
+	"github.com/andrescamacho/spacetraders-go/internal/application/mediator"
+	domainAudit "github.com/andrescamacho/spacetraders-go/internal/domain/audit"
+	"github.com/andrescamacho/spacetraders-go/internal/domain/shared"
+)
+
+const (
+	// DefaultCommandAuditRetention is how long records are kept when the
+	// retention is unset.
+	DefaultCommandAuditRetention = 7 * 24 * time.Hour
+
+	// maxPayloadLength bounds the stored payload summary. Commands carrying
+	// long lists (fleet coordinators' ship sets) are cut here, not skipped.
+	maxPayloadLength = 2000
+	// commandAuditBuffer is how many records may wait for the writer before
+	// new ones are dropped. Dispatch never blocks on the audit table.
+	commandAuditBuffer = 4096
+	// commandAuditBatchSize and commandAuditFlushInterval bound how long a
+	// record waits before it is written.
+	commandAuditBatchSize     = 200
+	commandAuditFlushInterval = 2 * time.Second
+	// commandAuditPruneInterval is how often the retention sweep runs.
+	commandAuditPruneInterval = time.Hour
+)
+
+// CommandAuditRecorder queues command records from the middleware and writes
+// them in batches from Run. A full queue drops records (counted in Dropped)
+// rather than slowing dispatch: the audit trail is a debugging aid, never a
+// reason for a coordinator to stall.
+type CommandAuditRecorder struct {
+	repo      domainAudit.CommandAuditRepository
+	clock     shared.Clock
+	retention time.Duration
+	queue     chan domainAudit.CommandRecord
+	dropped   atomic.Int64
+}
+
+// NewCommandAuditRecorder creates a recorder. retention <= 0 selects
+// DefaultCommandAuditRetention. If clock is nil, uses RealClock.
+func NewCommandAuditRecorder(repo domainAudit.CommandAuditRepository, retention time.Duration, clock shared.Clock) *CommandAuditRecorder {
+	if retention <= 0 {
+		retention = DefaultCommandAuditRetention
+	}
+	if clock == nil {
+		clock = shared.NewRealClock()
+	}
+	return &CommandAuditRecorder{
+		repo:      repo,
+		clock:     clock,
+		retention: retention,
+		queue:     make(chan domainAudit.CommandRecord, commandAuditBuffer),
+	}
+}
+
+// Dropped reports how many records were discarded because the queue was full.
+func (r *CommandAuditRecorder) Dropped() int64 {
+	return r.dropped.Load()
+}
+
+// Middleware records every command the mediator dispatches. Queries are not
+// recorded: they change nothing, and the status polls alone would swamp the
+// table.
+func (r *CommandAuditRecorder) Middleware() mediator.Middleware {
+	return func(ctx context.Context, request mediator.Request, next mediator.HandlerFunc) (mediator.Response, error) {
+		commandType := requestTypeName(request)
+		if !strings.HasSuffix(commandType, "Command") {
+			return next(ctx, request)
+		}
+
+		started := r.clock.Now()
+		response, err := next(ctx, request)
+
+		record := domainAudit.CommandRecord{
+			CommandType: commandType,
+			ContainerID: logging.ContainerIDFromContext(ctx),
+			StartedAt:   started,
+			Duration:    r.clock.Now().Sub(started),
+			Success:     err == nil,
+		}
+		record.PlayerID, record.ShipSymbol, record.Payload = summarizeCommand(request)
+		if err != nil {
+			record.Error = err.Error()
+		}
+		r.enqueue(record)
+		return response, err
+	}
+}
+
+func (r *CommandAuditRecorder) enqueue(record domainAudit.CommandRecord) {
+	select {
+	case r.queue <- record:
+	default:
+		r.dropped.Add(1)
+	}
+}
+
+// Run writes queued records until ctx is cancelled, then flushes what is left.
+// It also deletes records older than the retention, once at start and then
+// every commandAuditPruneInterval. Write failures are logged, never returned:
+// losing audit rows must not take the loop down.
+func (r *CommandAuditRecorder) Run(ctx context.Context) error {
+	flushTicker := time.NewTicker(commandAuditFlushInterval)
+	defer flushTicker.Stop()
+	pruneTicker := time.NewTicker(commandAuditPruneInterval)
+	defer pruneTicker.Stop()
+
+	r.prune(ctx)
+	batch := make([]domainAudit.CommandRecord, 0, commandAuditBatchSize)
+	for {
+		select {
+		case <-ctx.Done():
+			r.drain(&batch)
+			r.flush(context.Background(), &batch)
+			return nil
+		case record := <-r.queue:
+			batch = append(batch, record)
+			if len(batch) >= commandAuditBatchSize {
+				r.flush(ctx, &batch)
+			}
+		case <-flushTicker.C:
+			r.flush(ctx, &batch)
+		case <-pruneTicker.C:
+			r.prune(ctx)
+		}
+	}
+}
+
+func (r *CommandAuditRecorder) drain(batch *[]domainAudit.CommandRecord) {
+	for {
+		select {
+		case record := <-r.queue:
+			*batch = append(*batch, record)
+		default:
+			return
+		}
+	}
+}
+
+func (r *CommandAuditRecorder) flush(ctx context.Context, batch *[]domainAudit.CommandRecord) {
+	if len(*batch) == 0 {
+		return
+	}
+	if err := r.repo.RecordCommands(ctx, *batch); err != nil {
+		fmt.Printf("Warning: failed to write %d command audit records: %v\n", len(*batch), err)
+	}
+	*batch = (*batch)[:0]
+}
+
+func (r *CommandAuditRecorder) prune(ctx context.Context) {
+	cutoff := r.clock.Now().Add(-r.retention)
+	if _, err := r.repo.DeleteCommandsBefore(ctx, cutoff); err != nil {
+		fmt.Printf("Warning: command audit retention sweep failed: %v\n", err)
+	}
+}
+
+// requestTypeName is the request's bare type name, e.g. "NavigateRouteCommand".
+func requestTypeName(request mediator.Request) string {
+	t := reflect.TypeOf(request)
+	if t == nil {
+		return ""
+	}
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	return t.Name()
+}
+
+// summarizeCommand pulls the player and ship out of a command and renders its
+// set fields as a JSON object, cut to maxPayloadLength. Zero-valued fields are
+// left out and secrets (any field named like a token or password) are masked.
+func summarizeCommand(request mediator.Request) (playerID int, shipSymbol, payload string) {
+	v := reflect.ValueOf(request)
+	for v.Kind() == reflect.Ptr {
+		if v.IsNil() {
+			return 0, "", ""
+		}
+		v = v.Elem()
+	}
+	if v.Kind() != reflect.Struct {
+		return 0, "", ""
+	}
+
+	fields := make(map[string]interface{})
+	t := v.Type()
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		if !field.IsExported() {
+			continue
+		}
+		value := v.Field(i)
+		if value.IsZero() {
+			continue
+		}
+		switch field.Name {
+		case "PlayerID":
+			playerID = playerIDValue(value)
+		case "ShipSymbol":
+			if s, ok := value.Interface().(string); ok {
+				shipSymbol = s
+			}
+		}
+		if isSecretField(field.Name) {
+			fields[field.Name] = "***"
+			continue
+		}
+		fields[field.Name] = payloadValue(value)
+	}
+	return playerID, shipSymbol, encodePayload(fields)
+}
+
+func playerIDValue(value reflect.Value) int {
+	switch id := value.Interface().(type) {
+	case shared.PlayerID:
+		return id.Value()
+	case *shared.PlayerID:
+		return id.Value()
+	case int:
+		return id
+	}
+	return 0
+}
+
+func isSecretField(name string) bool {
+	lower := strings.ToLower(name)
+	return strings.Contains(lower, "token") || strings.Contains(lower, "password") || strings.Contains(lower, "secret")
+}
+
+// payloadValue renders value objects through their String method, since most
+// keep their fields unexported and would otherwise encode as {}.
+func payloadValue(value reflect.Value) interface{} {
+	if value.Kind() == reflect.Func || value.Kind() == reflect.Chan {
+		return value.Type().String()
+	}
+	if stringer, ok := value.Interface().(fmt.Stringer); ok {
+		return stringer.String()
+	}
+	return value.Interface()
+}
+
+func encodePayload(fields map[string]interface{}) string {
+	data, err := json.Marshal(fields)
+	if err != nil {
+		// A field JSON cannot encode: fall back to the sorted field names and
+		// their printed values.
+		names := make([]string, 0, len(fields))
+		for name := range fields {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		parts := make([]string, 0, len(names))
+		for _, name := range names {
+			parts = append(parts, fmt.Sprintf("%s=%v", name, fields[name]))
+		}
+		data = []byte(strings.Join(parts, " "))
+	}
+	if len(data) > maxPayloadLength {
+		return string(data[:maxPayloadLength]) + "…"
+	}
+	return string(data)
+}
@@ -0,0 +1,116 @@
+package audit
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/andrescamacho/spacetraders-go/internal/application/logging"
+	"github.com/andrescamacho/spacetraders-go/internal/application/mediator"
+	domainAudit "github.com/andrescamacho/spacetraders-go/internal/domain/audit"
+	"github.com/andrescamacho/spacetraders-go/internal/domain/shared"
+)
+
+type fakeAuditRepo struct {
+	mu      sync.Mutex
+	records []domainAudit.CommandRecord
+	cutoffs []time.Time
+}
+
+func (f *fakeAuditRepo) RecordCommands(_ context.Context, records []domainAudit.CommandRecord) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.records = append(f.records, records...)
+	return nil
+}
+
+func (f *fakeAuditRepo) FindCommands(context.Context, domainAudit.CommandFilter) ([]domainAudit.CommandRecord, error) {
+	return nil, nil
+}
+
+func (f *fakeAuditRepo) DeleteCommandsBefore(_ context.Context, cutoff time.Time) (int64, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.cutoffs = append(f.cutoffs, cutoff)
+	return 0, nil
+}
+
+type flyCommand struct {
+	ShipSymbol  string
+	Destination string
+	PlayerID    shared.PlayerID
+	Token       string
+}
+
+type shipStatusQuery struct{ ShipSymbol string }
+
+type flyHandler struct{ err error }
+
+func (h flyHandler) Handle(context.Context, mediator.Request) (mediator.Response, error) {
+	return "ok", h.err
+}
+
+// A dispatched command is recorded with its player, ship, originating container,
+// outcome and a payload that masks secrets; a query is not recorded.
+func TestCommandAuditMiddleware_RecordsCommandsOnly(t *testing.T) {
+	repo := &fakeAuditRepo{}
+	clock := &shared.MockClock{CurrentTime: time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC)}
+	recorder := NewCommandAuditRecorder(repo, 0, clock)
+
+	med := mediator.NewMediator()
+	med.RegisterMiddleware(recorder.Middleware())
+	if err := mediator.RegisterHandler[*flyCommand](med, flyHandler{err: errors.New("no fuel")}); err != nil {
+		t.Fatal(err)
+	}
+	if err := mediator.RegisterHandler[*shipStatusQuery](med, flyHandler{}); err != nil {
+		t.Fatal(err)
+	}
+
+	ctx := logging.WithContainerID(context.Background(), "trade-1")
+	_, _ = med.Send(ctx, &flyCommand{ShipSymbol: "AGENT-1", Destination: "X1-B2", PlayerID: shared.MustNewPlayerID(7), Token: "jwt"})
+	_, _ = med.Send(ctx, &shipStatusQuery{ShipSymbol: "AGENT-1"})
+
+	runCtx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if err := recorder.Run(runCtx); err != nil {
+		t.Fatalf("Run: %v", err)
+	}
+
+	if len(repo.records) != 1 {
+		t.Fatalf("recorded %d, want 1 (the query must not be audited)", len(repo.records))
+	}
+	rec := repo.records[0]
+	if rec.CommandType != "flyCommand" || rec.PlayerID != 7 || rec.ShipSymbol != "AGENT-1" || rec.ContainerID != "trade-1" {
+		t.Fatalf("unexpected record: %+v", rec)
+	}
+	if rec.Success || rec.Error != "no fuel" {
+		t.Fatalf("failure not recorded: %+v", rec)
+	}
+
+	var payload map[string]interface{}
+	if err := json.Unmarshal([]byte(rec.Payload), &payload); err != nil {
+		t.Fatalf("payload is not JSON: %q", rec.Payload)
+	}
+	if payload["Token"] != "***" || payload["Destination"] != "X1-B2" || payload["PlayerID"] != "7" {
+		t.Fatalf("unexpected payload: %v", payload)
+	}
+
+	wantCutoff := clock.CurrentTime.Add(-DefaultCommandAuditRetention)
+	if len(repo.cutoffs) != 1 || !repo.cutoffs[0].Equal(wantCutoff) {
+		t.Fatalf("retention cutoffs = %v, want [%v]", repo.cutoffs, wantCutoff)
+	}
+}
+
+// A full queue drops records instead of blocking dispatch.
+func TestCommandAuditRecorder_DropsWhenQueueFull(t *testing.T) {
+	recorder := NewCommandAuditRecorder(&fakeAuditRepo{}, time.Hour, nil)
+	for i := 0; i < commandAuditBuffer+3; i++ {
+		recorder.enqueue(domainAudit.CommandRecord{CommandType: "flyCommand"})
+	}
+	if got := recorder.Dropped(); got != 3 {
+		t.Fatalf("dropped = %d, want 3", got)
+	}
+}
@@ -0,0 +1,83 @@
+package queries
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/andrescamacho/spacetraders-go/internal/application/common"
+	"github.com/andrescamacho/spacetraders-go/internal/domain/audit"
+	"github.com/andrescamacho/spacetraders-go/internal/domain/shared"
+)
+
+const (
+	// DefaultCommandAuditWindow is how far back the query reads when Since is unset.
+	DefaultCommandAuditWindow = 24 * time.Hour
+	// DefaultCommandAuditLimit caps the records returned when Limit is unset.
+	DefaultCommandAuditLimit = 100
+)
+
+// GetCommandAuditQuery asks which commands were dispatched for a player,
+// optionally narrowed to one ship, container or command type.
+type GetCommandAuditQuery struct {
+	PlayerID    shared.PlayerID
+	ShipSymbol  string    // Optional
+	ContainerID string    // Optional
+	CommandType string    // Optional, bare type name, e.g. "NavigateRouteCommand"
+	Since       time.Time // Zero => DefaultCommandAuditWindow ago
+	Until       time.Time // Zero => now
+	FailedOnly  bool
+	Limit       int // <=0 => DefaultCommandAuditLimit
+}
+
+// GetCommandAuditResponse holds the matching records, newest first.
+type GetCommandAuditResponse struct {
+	Records []audit.CommandRecord
+}
+
+// GetCommandAuditHandler handles the GetCommandAudit query.
+type GetCommandAuditHandler struct {
+	repo  audit.CommandAuditRepository
+	clock shared.Clock
+}
+
+// NewGetCommandAuditHandler creates a new GetCommandAuditHandler. A nil clock
+// defaults to the real clock.
+func NewGetCommandAuditHandler(repo audit.CommandAuditRepository, clock shared.Clock) *GetCommandAuditHandler {
+	if clock == nil {
+		clock = shared.NewRealClock()
+	}
+	return &GetCommandAuditHandler{repo: repo, clock: clock}
+}
+
+// Handle executes the GetCommandAudit query.
+func (h *GetCommandAuditHandler) Handle(ctx context.Context, request common.Request) (common.Response, error) {
+	query, ok := request.(*GetCommandAuditQuery)
+	if !ok {
+		return nil, fmt.Errorf("invalid request type: expected *GetCommandAuditQuery")
+	}
+
+	since := query.Since
+	if since.IsZero() {
+		since = h.clock.Now().Add(-DefaultCommandAuditWindow)
+	}
+	limit := query.Limit
+	if limit <= 0 {
+		limit = DefaultCommandAuditLimit
+	}
+
+	records, err := h.repo.FindCommands(ctx, audit.CommandFilter{
+		PlayerID:    query.PlayerID.Value(),
+		CommandType: query.CommandType,
+		ShipSymbol:  query.ShipSymbol,
+		ContainerID: query.ContainerID,
+		Since:       since,
+		Until:       query.Until,
+		FailedOnly:  query.FailedOnly,
+		Limit:       limit,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &GetCommandAuditResponse{Records: records}, nil
+}
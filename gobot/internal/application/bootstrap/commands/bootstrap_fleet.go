@@ -0,0 +1,241 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/andrescamacho/spacetraders-go/internal/application/common"
+	"github.com/andrescamacho/spacetraders-go/internal/domain/container"
+)
+
+// BootstrapFleetCommand stands a new agent up from a fleet template (a YAML profile, see
+// container.FleetTemplate): it buys the profile's starter ships, then launches its standing
+// containers in dependency order. Unlike the bootstrap coordinator it is one-shot — it applies
+// the profile once and returns — and it is safe to re-run: ship orders with a frame only buy the
+// shortfall, and containers already running are left alone.
+type BootstrapFleetCommand struct {
+	PlayerID    int
+	AgentSymbol string
+	// Profile names the template (resolved in the daemon's fleet template directory) or is a
+	// path to a YAML file.
+	Profile string
+	// PurchasingShipSymbol flies to the shipyards; empty lets the buyer pick an idle hull.
+	PurchasingShipSymbol string
+	// DryRun reports what would be bought and launched without spending or starting anything.
+	DryRun bool
+}
+
+// Fleet container launch outcomes.
+const (
+	FleetContainerLaunched       = "LAUNCHED"
+	FleetContainerAlreadyRunning = "ALREADY_RUNNING"
+	FleetContainerPlanned        = "PLANNED"
+	FleetContainerSkipped        = "SKIPPED"
+	FleetContainerFailed         = "FAILED"
+)
+
+// FleetPurchaseResult is the outcome of one ship order.
+type FleetPurchaseResult struct {
+	ShipType string
+	Wanted   int
+	// Owned is how many matching hulls the agent already had (frame orders only).
+	Owned  int
+	Bought int
+	Cost   int
+	Error  string
+}
+
+// FleetContainerResult is the outcome of one template container.
+type FleetContainerResult struct {
+	Name        string
+	CommandType string
+	ContainerID string
+	Status      string
+	Reason      string
+}
+
+// BootstrapFleetResponse reports every ship order and container, in the order applied.
+type BootstrapFleetResponse struct {
+	Template   string
+	DryRun     bool
+	Purchases  []FleetPurchaseResult
+	Containers []FleetContainerResult
+}
+
+// FleetTemplateLoader resolves a profile name to a validated template.
+type FleetTemplateLoader interface {
+	LoadFleetTemplate(profile string) (*container.FleetTemplate, error)
+}
+
+// FleetShipBuyer counts and buys hulls for the ship orders.
+type FleetShipBuyer interface {
+	// OwnedByFrame counts the player's hulls per frame symbol.
+	OwnedByFrame(ctx context.Context, playerID int) (map[string]int, error)
+	// Buy purchases up to quantity hulls for the order and returns how many it bought and what
+	// they cost. A partial buy returns its counts alongside the error.
+	Buy(ctx context.Context, playerID int, purchasingShip string, order container.ShipOrder, quantity int) (bought, cost int, err error)
+}
+
+// FleetContainerLauncher starts template containers through the daemon's launch verbs.
+type FleetContainerLauncher interface {
+	// SupportsCommandType reports whether templates may name commandType.
+	SupportsCommandType(commandType string) bool
+	// LaunchFleetContainer starts the container unless one of its type is already running for
+	// the player, in which case it returns that container's ID and alreadyRunning.
+	LaunchFleetContainer(ctx context.Context, playerID int, agentSymbol string, spec container.ContainerTemplate) (containerID string, alreadyRunning bool, err error)
+}
+
+// BootstrapFleetHandler applies fleet templates.
+type BootstrapFleetHandler struct {
+	templates FleetTemplateLoader
+	buyer     FleetShipBuyer
+	launcher  FleetContainerLauncher
+}
+
+// NewBootstrapFleetHandler creates a BootstrapFleetHandler.
+func NewBootstrapFleetHandler(templates FleetTemplateLoader, buyer FleetShipBuyer, launcher FleetContainerLauncher) *BootstrapFleetHandler {
+	return &BootstrapFleetHandler{templates: templates, buyer: buyer, launcher: launcher}
+}
+
+// Handle loads the profile, checks every container type is launchable before spending anything,
+// buys the ship shortfall and launches the containers. A failed ship order is reported and does
+// not stop the containers; a container whose dependency did not come up is skipped.
+func (h *BootstrapFleetHandler) Handle(ctx context.Context, request common.Request) (common.Response, error) {
+	cmd, ok := request.(*BootstrapFleetCommand)
+	if !ok {
+		return nil, fmt.Errorf("invalid request type")
+	}
+	if cmd.Profile == "" {
+		return nil, fmt.Errorf("a fleet template profile is required")
+	}
+
+	tmpl, err := h.templates.LoadFleetTemplate(cmd.Profile)
+	if err != nil {
+		return nil, err
+	}
+	ordered, err := tmpl.LaunchOrder()
+	if err != nil {
+		return nil, err
+	}
+	var unsupported []string
+	for _, c := range ordered {
+		if !h.launcher.SupportsCommandType(c.CommandType) {
+			unsupported = append(unsupported, fmt.Sprintf("%s (%s)", c.Name, c.CommandType))
+		}
+	}
+	if len(unsupported) > 0 {
+		return nil, fmt.Errorf("fleet template %s names container types that cannot be launched from a template: %s",
+			tmpl.Name, strings.Join(unsupported, ", "))
+	}
+
+	logger := common.LoggerFromContext(ctx)
+	logger.Log("INFO", fmt.Sprintf("Applying fleet template %s", tmpl.Name), map[string]interface{}{
+		"action":     "bootstrap_fleet",
+		"template":   tmpl.Name,
+		"ships":      len(tmpl.Ships),
+		"containers": len(ordered),
+		"dry_run":    cmd.DryRun,
+	})
+
+	resp := &BootstrapFleetResponse{Template: tmpl.Name, DryRun: cmd.DryRun}
+	purchases, err := h.buyShips(ctx, cmd, tmpl.Ships)
+	if err != nil {
+		return nil, err
+	}
+	resp.Purchases = purchases
+	resp.Containers = h.launchContainers(ctx, cmd, ordered)
+	return resp, nil
+}
+
+// buyShips buys each order's shortfall. Only a failure to read the current fleet aborts: without
+// it a re-run could buy a whole second fleet.
+func (h *BootstrapFleetHandler) buyShips(ctx context.Context, cmd *BootstrapFleetCommand, orders []container.ShipOrder) ([]FleetPurchaseResult, error) {
+	if len(orders) == 0 {
+		return nil, nil
+	}
+	owned, err := h.buyer.OwnedByFrame(ctx, cmd.PlayerID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read the current fleet: %w", err)
+	}
+
+	logger := common.LoggerFromContext(ctx)
+	results := make([]FleetPurchaseResult, 0, len(orders))
+	for _, order := range orders {
+		result := FleetPurchaseResult{ShipType: order.ShipType, Wanted: order.Quantity}
+		if order.Frame != "" {
+			result.Owned = owned[order.Frame]
+		}
+		shortfall := order.Quantity - result.Owned
+		if shortfall <= 0 || cmd.DryRun {
+			results = append(results, result)
+			continue
+		}
+
+		bought, cost, err := h.buyer.Buy(ctx, cmd.PlayerID, cmd.PurchasingShipSymbol, order, shortfall)
+		result.Bought, result.Cost = bought, cost
+		if order.Frame != "" {
+			owned[order.Frame] += bought
+		}
+		if err != nil {
+			result.Error = err.Error()
+			logger.Log("WARNING", fmt.Sprintf("Fleet template purchase of %s failed: %v", order.ShipType, err), map[string]interface{}{
+				"action":    "bootstrap_fleet_purchase",
+				"ship_type": order.ShipType,
+				"wanted":    shortfall,
+				"bought":    bought,
+			})
+		}
+		results = append(results, result)
+	}
+	return results, nil
+}
+
+// launchContainers starts the containers in order. A container starts only once every template
+// container it depends on is running; the rest are skipped with the dependency named.
+func (h *BootstrapFleetHandler) launchContainers(ctx context.Context, cmd *BootstrapFleetCommand, ordered []container.ContainerTemplate) []FleetContainerResult {
+	nodes := make([]container.DependencyNode, 0, len(ordered))
+	for _, c := range ordered {
+		nodes = append(nodes, container.DependencyNode{ID: c.Name, CommandType: c.CommandType, DependsOn: c.DependsOn})
+	}
+
+	logger := common.LoggerFromContext(ctx)
+	running := make(map[string]bool, len(ordered))
+	results := make([]FleetContainerResult, 0, len(ordered))
+	for i, c := range ordered {
+		result := FleetContainerResult{Name: c.Name, CommandType: c.CommandType}
+		if unmet := container.UnmetDependencies(nodes[i], nodes, running); len(unmet) > 0 {
+			result.Status = FleetContainerSkipped
+			result.Reason = fmt.Sprintf("waiting on [%s], which did not start", strings.Join(unmet, ", "))
+			results = append(results, result)
+			continue
+		}
+		if cmd.DryRun {
+			result.Status = FleetContainerPlanned
+			running[c.Name] = true
+			results = append(results, result)
+			continue
+		}
+
+		id, alreadyRunning, err := h.launcher.LaunchFleetContainer(ctx, cmd.PlayerID, cmd.AgentSymbol, c)
+		result.ContainerID = id
+		switch {
+		case err != nil:
+			result.Status = FleetContainerFailed
+			result.Reason = err.Error()
+			logger.Log("WARNING", fmt.Sprintf("Fleet template container %s failed to launch: %v", c.Name, err), map[string]interface{}{
+				"action":       "bootstrap_fleet_launch",
+				"container":    c.Name,
+				"command_type": c.CommandType,
+			})
+		case alreadyRunning:
+			result.Status = FleetContainerAlreadyRunning
+			running[c.Name] = true
+		default:
+			result.Status = FleetContainerLaunched
+			running[c.Name] = true
+		}
+		results = append(results, result)
+	}
+	return results
+}
@@ -0,0 +1,163 @@
+package commands
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/andrescamacho/spacetraders-go/internal/domain/container"
+)
+
+type fakeTemplateLoader struct{ tmpl *container.FleetTemplate }
+
+func (f *fakeTemplateLoader) LoadFleetTemplate(string) (*container.FleetTemplate, error) {
+	return f.tmpl, nil
+}
+
+type fakeFleetBuyer struct {
+	owned  map[string]int
+	bought map[string]int
+	fail   map[string]bool
+}
+
+func (f *fakeFleetBuyer) OwnedByFrame(context.Context, int) (map[string]int, error) {
+	out := make(map[string]int, len(f.owned))
+	for k, v := range f.owned {
+		out[k] = v
+	}
+	return out, nil
+}
+
+func (f *fakeFleetBuyer) Buy(_ context.Context, _ int, _ string, order container.ShipOrder, quantity int) (int, int, error) {
+	if f.fail[order.ShipType] {
+		return 0, 0, errors.New("insufficient credits")
+	}
+	f.bought[order.ShipType] += quantity
+	return quantity, quantity * 1000, nil
+}
+
+type fakeFleetLauncher struct {
+	running  map[string]bool
+	fail     map[string]bool
+	launched []string
+}
+
+func (f *fakeFleetLauncher) SupportsCommandType(commandType string) bool {
+	return commandType != "navigate_ship"
+}
+
+func (f *fakeFleetLauncher) LaunchFleetContainer(_ context.Context, _ int, _ string, spec container.ContainerTemplate) (string, bool, error) {
+	if f.fail[spec.CommandType] {
+		return "", false, errors.New("boom")
+	}
+	if f.running[spec.CommandType] {
+		return "existing-" + spec.Name, true, nil
+	}
+	f.launched = append(f.launched, spec.Name)
+	return "id-" + spec.Name, false, nil
+}
+
+func standardFleetTemplate() *container.FleetTemplate {
+	return &container.FleetTemplate{
+		Name: "standard",
+		Ships: []container.ShipOrder{
+			{ShipType: "SHIP_PROBE", Quantity: 3, Frame: "FRAME_PROBE"},
+			{ShipType: "SHIP_LIGHT_HAULER", Quantity: 2},
+		},
+		Containers: []container.ContainerTemplate{
+			{Name: "trade", CommandType: "trade_fleet_coordinator", DependsOn: []string{"scouts"}},
+			{Name: "scouts", CommandType: "scout_post_coordinator"},
+			{Name: "contracts", CommandType: "contract_fleet_coordinator"},
+		},
+	}
+}
+
+func statuses(results []FleetContainerResult) map[string]string {
+	out := make(map[string]string, len(results))
+	for _, r := range results {
+		out[r.Name] = r.Status
+	}
+	return out
+}
+
+// Frame orders buy only the shortfall; containers launch providers first and
+// leave an already-running type alone.
+func TestBootstrapFleet_BuysShortfallAndLaunchesInOrder(t *testing.T) {
+	buyer := &fakeFleetBuyer{owned: map[string]int{"FRAME_PROBE": 1}, bought: map[string]int{}}
+	launcher := &fakeFleetLauncher{running: map[string]bool{"contract_fleet_coordinator": true}}
+	h := NewBootstrapFleetHandler(&fakeTemplateLoader{standardFleetTemplate()}, buyer, launcher)
+
+	resp, err := h.Handle(context.Background(), &BootstrapFleetCommand{PlayerID: 1, Profile: "standard"})
+	if err != nil {
+		t.Fatalf("Handle: %v", err)
+	}
+	out := resp.(*BootstrapFleetResponse)
+
+	if want := map[string]int{"SHIP_PROBE": 2, "SHIP_LIGHT_HAULER": 2}; !reflect.DeepEqual(buyer.bought, want) {
+		t.Fatalf("bought = %v, want %v", buyer.bought, want)
+	}
+	if want := []string{"scouts", "trade"}; !reflect.DeepEqual(launcher.launched, want) {
+		t.Fatalf("launched = %v, want %v", launcher.launched, want)
+	}
+	if got := statuses(out.Containers)["contracts"]; got != FleetContainerAlreadyRunning {
+		t.Fatalf("contracts status = %s, want %s", got, FleetContainerAlreadyRunning)
+	}
+}
+
+// A container whose dependency failed is skipped; a failed purchase is reported
+// without stopping the launches.
+func TestBootstrapFleet_SkipsDependentsOfFailedLaunch(t *testing.T) {
+	buyer := &fakeFleetBuyer{bought: map[string]int{}, fail: map[string]bool{"SHIP_LIGHT_HAULER": true}}
+	launcher := &fakeFleetLauncher{fail: map[string]bool{"scout_post_coordinator": true}}
+	h := NewBootstrapFleetHandler(&fakeTemplateLoader{standardFleetTemplate()}, buyer, launcher)
+
+	resp, err := h.Handle(context.Background(), &BootstrapFleetCommand{PlayerID: 1, Profile: "standard"})
+	if err != nil {
+		t.Fatalf("Handle: %v", err)
+	}
+	out := resp.(*BootstrapFleetResponse)
+
+	want := map[string]string{
+		"scouts":    FleetContainerFailed,
+		"trade":     FleetContainerSkipped,
+		"contracts": FleetContainerLaunched,
+	}
+	if got := statuses(out.Containers); !reflect.DeepEqual(got, want) {
+		t.Fatalf("statuses = %v, want %v", got, want)
+	}
+	if out.Purchases[1].Error == "" {
+		t.Fatal("the failed hauler purchase must be reported")
+	}
+}
+
+// Dry run neither buys nor launches, and an unsupported container type is
+// refused before anything is spent.
+func TestBootstrapFleet_DryRunAndUnsupportedType(t *testing.T) {
+	buyer := &fakeFleetBuyer{bought: map[string]int{}}
+	launcher := &fakeFleetLauncher{}
+	h := NewBootstrapFleetHandler(&fakeTemplateLoader{standardFleetTemplate()}, buyer, launcher)
+
+	resp, err := h.Handle(context.Background(), &BootstrapFleetCommand{PlayerID: 1, Profile: "standard", DryRun: true})
+	if err != nil {
+		t.Fatalf("Handle: %v", err)
+	}
+	if len(buyer.bought) != 0 || len(launcher.launched) != 0 {
+		t.Fatalf("dry run acted: bought %v, launched %v", buyer.bought, launcher.launched)
+	}
+	for _, r := range resp.(*BootstrapFleetResponse).Containers {
+		if r.Status != FleetContainerPlanned {
+			t.Fatalf("%s status = %s, want %s", r.Name, r.Status, FleetContainerPlanned)
+		}
+	}
+
+	tmpl := standardFleetTemplate()
+	tmpl.Containers = append(tmpl.Containers, container.ContainerTemplate{Name: "nav", CommandType: "navigate_ship"})
+	h = NewBootstrapFleetHandler(&fakeTemplateLoader{tmpl}, buyer, launcher)
+	if _, err := h.Handle(context.Background(), &BootstrapFleetCommand{PlayerID: 1, Profile: "standard"}); err == nil {
+		t.Fatal("an unsupported container type must be rejected")
+	}
+	if len(buyer.bought) != 0 {
+		t.Fatalf("nothing may be bought when the template is rejected, bought %v", buyer.bought)
+	}
+}
@@ -53,8 +53,10 @@ var (
 
 // Logging functions
 var (
-	WithLogger        = logging.WithLogger
-	LoggerFromContext = logging.LoggerFromContext
+	WithLogger             = logging.WithLogger
+	LoggerFromContext      = logging.LoggerFromContext
+	WithContainerID        = logging.WithContainerID
+	ContainerIDFromContext = logging.ContainerIDFromContext
 )
 
 // Ship DTO functions
@@ -11,6 +11,7 @@ import (
 	"github.com/andrescamacho/spacetraders-go/internal/domain/contract"
 	"github.com/andrescamacho/spacetraders-go/internal/domain/player"
 	domainPorts "github.com/andrescamacho/spacetraders-go/internal/domain/ports"
+	"github.com/andrescamacho/spacetraders-go/internal/domain/shared"
 )
 
 // Type aliases for convenience
@@ -23,6 +24,9 @@ type AcceptContractHandler struct {
 	playerRepo   player.PlayerRepository
 	apiClient    domainPorts.APIClient
 	mediator     common.Mediator
+
+	// eventPublisher announces each acceptance as contract.accepted; nil skips it
+	eventPublisher shared.CoordinationPublisher
 }
 
 // NewAcceptContractHandler creates a new accept contract handler
@@ -40,6 +44,12 @@ func NewAcceptContractHandler(
 	}
 }
 
+// SetEventPublisher wires the coordination event publisher so sourcing and
+// fleet coordinators learn of a newly accepted contract without polling.
+func (h *AcceptContractHandler) SetEventPublisher(publisher shared.CoordinationPublisher) {
+	h.eventPublisher = publisher
+}
+
 // Handle executes the accept contract command
 func (h *AcceptContractHandler) Handle(ctx context.Context, request common.Request) (common.Response, error) {
 	cmd, ok := request.(*AcceptContractCommand)
@@ -84,6 +94,15 @@ func (h *AcceptContractHandler) Handle(ctx context.Context, request common.Reque
 	}
 	go h.recordContractAcceptance(ctx, contract, authoritativeBalance)
 
+	if h.eventPublisher != nil {
+		h.eventPublisher.Publish(shared.CoordinationEvent{
+			Topic:    shared.TopicContractAccepted,
+			PlayerID: cmd.PlayerID.Value(),
+			Subject:  contract.ContractID(),
+			Payload:  map[string]interface{}{"faction": contract.FactionSymbol(), "type": contract.Type()},
+		})
+	}
+
 	return &AcceptContractResponse{
 		Contract: contract,
 	}, nil
@@ -162,6 +181,12 @@ func (h *AcceptContractHandler) recordContractAcceptance(
 		OperationType:        "contract",
 	}
 
+	// Attribute the cashflow to the running container; operation_type stays
+	// "contract" since accept/fulfill are contract income whoever drives them.
+	if opCtx := shared.OperationContextFromContext(ctx); opCtx != nil && opCtx.IsValid() {
+		recordCmd.ContainerID = opCtx.ContainerID
+	}
+
 	// Record transaction via mediator
 	_, err = h.mediator.Send(context.Background(), recordCmd)
 	if err != nil {
@@ -8,9 +8,11 @@ import (
 	contractTypes "github.com/andrescamacho/spacetraders-go/internal/application/contract/types"
 	ledgerCommands "github.com/andrescamacho/spacetraders-go/internal/application/ledger/commands"
 	"github.com/andrescamacho/spacetraders-go/internal/application/logging"
+	playerCommands "github.com/andrescamacho/spacetraders-go/internal/application/player/commands"
 	"github.com/andrescamacho/spacetraders-go/internal/domain/contract"
 	"github.com/andrescamacho/spacetraders-go/internal/domain/player"
 	domainPorts "github.com/andrescamacho/spacetraders-go/internal/domain/ports"
+	"github.com/andrescamacho/spacetraders-go/internal/domain/shared"
 )
 
 // Type aliases for convenience
@@ -84,6 +86,10 @@ func (h *FulfillContractHandler) Handle(ctx context.Context, request common.Requ
 	}
 	go h.recordContractFulfillment(ctx, contract, authoritativeBalance)
 
+	// Fulfillment is what moves faction reputation, so it is the natural moment
+	// to snapshot it for the faction-standing query. Non-blocking and best-effort.
+	go h.recordFactionReputation(ctx, cmd.PlayerID)
+
 	return &FulfillContractResponse{
 		Contract: contract,
 	}, nil
@@ -168,6 +174,12 @@ func (h *FulfillContractHandler) recordContractFulfillment(
 		OperationType:        "contract",
 	}
 
+	// Attribute the cashflow to the running container; operation_type stays
+	// "contract" since accept/fulfill are contract income whoever drives them.
+	if opCtx := shared.OperationContextFromContext(ctx); opCtx != nil && opCtx.IsValid() {
+		recordCmd.ContainerID = opCtx.ContainerID
+	}
+
 	// Record transaction via mediator
 	_, err = h.mediator.Send(context.Background(), recordCmd)
 	if err != nil {
@@ -185,3 +197,16 @@ func (h *FulfillContractHandler) recordContractFulfillment(
 		})
 	}
 }
+
+// recordFactionReputation snapshots the agent's faction reputation after a
+// fulfillment. Like the ledger write it runs detached from the caller's context
+// and only logs on failure.
+func (h *FulfillContractHandler) recordFactionReputation(ctx context.Context, playerID shared.PlayerID) {
+	if _, err := h.mediator.Send(context.Background(), &playerCommands.RecordFactionReputationCommand{PlayerID: playerID}); err != nil {
+		logging.LoggerFromContext(ctx).Log("WARNING", "Failed to record faction reputation after fulfillment", map[string]interface{}{
+			"action":    "record_faction_reputation",
+			"error":     err.Error(),
+			"player_id": playerID.Value(),
+		})
+	}
+}
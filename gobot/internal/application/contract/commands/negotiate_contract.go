@@ -94,7 +94,7 @@ func (h *NegotiateContractHandler) Handle(ctx context.Context, request common.Re
 		return nil, fmt.Errorf("API returned nil result or contract")
 	}
 
-	newContract := h.convertToDomain(result.Contract, cmd.PlayerID)
+	newContract := contractFromAPIData(result.Contract, cmd.PlayerID)
 
 	if err := h.saveContract(ctx, newContract); err != nil {
 		return nil, err
@@ -171,7 +171,7 @@ func (h *NegotiateContractHandler) handleExistingContractError(
 			return nil, fmt.Errorf("failed to fetch existing contract %s: %w", result.ExistingContractID, err)
 		}
 
-		existingContract := h.convertToDomain(existingContractData, playerID)
+		existingContract := contractFromAPIData(existingContractData, playerID)
 
 		if err := h.contractRepo.Add(ctx, existingContract); err != nil {
 			return nil, fmt.Errorf("failed to save existing contract: %w", err)
@@ -192,8 +192,9 @@ func (h *NegotiateContractHandler) saveContract(ctx context.Context, contract *c
 	return nil
 }
 
-// convertToDomain converts API contract data to domain entity
-func (h *NegotiateContractHandler) convertToDomain(data *domainPorts.ContractData, playerID shared.PlayerID) *contract.Contract {
+// contractFromAPIData converts API contract data to a domain contract, restoring
+// its accepted and fulfilled state.
+func contractFromAPIData(data *domainPorts.ContractData, playerID shared.PlayerID) *contract.Contract {
 	// Convert deliveries
 	deliveries := make([]contract.Delivery, len(data.Terms.Deliveries))
 	for i, d := range data.Terms.Deliveries {
@@ -0,0 +1,108 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/andrescamacho/spacetraders-go/internal/application/common"
+	contractTypes "github.com/andrescamacho/spacetraders-go/internal/application/contract/types"
+	"github.com/andrescamacho/spacetraders-go/internal/application/logging"
+	"github.com/andrescamacho/spacetraders-go/internal/domain/contract"
+	domainPorts "github.com/andrescamacho/spacetraders-go/internal/domain/ports"
+)
+
+// Type aliases for convenience
+type OnboardStarterContractCommand = contractTypes.OnboardStarterContractCommand
+type OnboardStarterContractResponse = contractTypes.OnboardStarterContractResponse
+
+// OnboardStarterContractHandler accepts the contract a new agent is registered
+// with. The starter contract is pre-negotiated and its acceptance payment is
+// pure profit, so a fresh agent should never sit on it waiting for the first
+// contract workflow to come around.
+type OnboardStarterContractHandler struct {
+	contractRepo contract.ContractRepository
+	apiClient    domainPorts.APIClient
+	mediator     common.Mediator
+}
+
+// NewOnboardStarterContractHandler creates a new starter contract onboarding handler
+func NewOnboardStarterContractHandler(
+	contractRepo contract.ContractRepository,
+	apiClient domainPorts.APIClient,
+	mediator common.Mediator,
+) *OnboardStarterContractHandler {
+	return &OnboardStarterContractHandler{
+		contractRepo: contractRepo,
+		apiClient:    apiClient,
+		mediator:     mediator,
+	}
+}
+
+// Handle lists the agent's contracts, imports them, and accepts the starter
+// through AcceptContractCommand so the payment reaches the ledger the usual way.
+func (h *OnboardStarterContractHandler) Handle(ctx context.Context, request common.Request) (common.Response, error) {
+	cmd, ok := request.(*OnboardStarterContractCommand)
+	if !ok {
+		return nil, fmt.Errorf("invalid request type")
+	}
+
+	token, err := common.PlayerTokenFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	contracts, err := h.apiClient.ListContracts(ctx, token)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list contracts: %w", err)
+	}
+
+	starter, reason := selectStarterContract(contracts)
+	if starter == nil {
+		return &OnboardStarterContractResponse{Reason: reason}, nil
+	}
+
+	// Import before accepting: AcceptContractCommand works from the repository.
+	entity := contractFromAPIData(starter, cmd.PlayerID)
+	if err := h.contractRepo.Add(ctx, entity); err != nil {
+		return nil, fmt.Errorf("failed to import starter contract %s: %w", starter.ID, err)
+	}
+
+	resp, err := h.mediator.Send(ctx, &AcceptContractCommand{ContractID: starter.ID, PlayerID: cmd.PlayerID})
+	if err != nil {
+		return nil, fmt.Errorf("failed to accept starter contract %s: %w", starter.ID, err)
+	}
+	accepted, ok := resp.(*AcceptContractResponse)
+	if !ok {
+		return nil, fmt.Errorf("unexpected accept response type %T", resp)
+	}
+
+	logging.LoggerFromContext(ctx).Log("INFO", fmt.Sprintf(
+		"Accepted starter contract %s from %s (%d on accept, %d on fulfil)",
+		starter.ID, starter.FactionSymbol, starter.Terms.Payment.OnAccepted, starter.Terms.Payment.OnFulfilled),
+		map[string]interface{}{
+			"action":      "starter_contract_accepted",
+			"contract_id": starter.ID,
+			"player_id":   cmd.PlayerID.Value(),
+		})
+
+	return &OnboardStarterContractResponse{Contract: accepted.Contract, Accepted: true}, nil
+}
+
+// selectStarterContract picks the open contract of an agent that has never
+// accepted one. An agent with any accepted contract is past onboarding, so its
+// open offers are left for the contract workflow to judge.
+func selectStarterContract(contracts []*domainPorts.ContractData) (*domainPorts.ContractData, string) {
+	var starter *domainPorts.ContractData
+	for _, c := range contracts {
+		if c.Accepted || c.Fulfilled {
+			return nil, fmt.Sprintf("agent already accepted contract %s", c.ID)
+		}
+		if starter == nil {
+			starter = c
+		}
+	}
+	if starter == nil {
+		return nil, "agent holds no contracts"
+	}
+	return starter, ""
+}
@@ -0,0 +1,106 @@
+package commands
+
+import (
+	"context"
+	"testing"
+
+	"github.com/andrescamacho/spacetraders-go/internal/application/auth"
+	"github.com/andrescamacho/spacetraders-go/internal/application/common"
+	domainPorts "github.com/andrescamacho/spacetraders-go/internal/domain/ports"
+	"github.com/andrescamacho/spacetraders-go/internal/domain/shared"
+)
+
+type onboardStubAPIClient struct {
+	domainPorts.APIClient
+	contracts []*domainPorts.ContractData
+}
+
+func (c *onboardStubAPIClient) ListContracts(_ context.Context, _ string) ([]*domainPorts.ContractData, error) {
+	return c.contracts, nil
+}
+
+// onboardFakeMediator stands in for AcceptContractHandler: it accepts the
+// contract the handler imported into the repository.
+type onboardFakeMediator struct {
+	common.Mediator
+	repo     *negotiateStubContractRepo
+	accepted []string
+}
+
+func (m *onboardFakeMediator) Send(_ context.Context, request common.Request) (common.Response, error) {
+	cmd := request.(*AcceptContractCommand)
+	m.accepted = append(m.accepted, cmd.ContractID)
+	imported := m.repo.added[len(m.repo.added)-1]
+	if err := imported.Accept(); err != nil {
+		return nil, err
+	}
+	return &AcceptContractResponse{Contract: imported}, nil
+}
+
+func onboardStarterData(id string, accepted bool) *domainPorts.ContractData {
+	return &domainPorts.ContractData{
+		ID:            id,
+		FactionSymbol: "COSMIC",
+		Type:          "PROCUREMENT",
+		Accepted:      accepted,
+		Terms: domainPorts.ContractTermsData{
+			Payment: domainPorts.PaymentData{OnAccepted: 1500, OnFulfilled: 9000},
+			Deliveries: []domainPorts.DeliveryData{
+				{TradeSymbol: "IRON_ORE", DestinationSymbol: "X1-TEST-A1", UnitsRequired: 40},
+			},
+		},
+	}
+}
+
+func runOnboard(t *testing.T, contracts ...*domainPorts.ContractData) (*OnboardStarterContractResponse, *negotiateStubContractRepo, *onboardFakeMediator) {
+	t.Helper()
+	repo := &negotiateStubContractRepo{}
+	med := &onboardFakeMediator{repo: repo}
+	handler := NewOnboardStarterContractHandler(repo, &onboardStubAPIClient{contracts: contracts}, med)
+
+	ctx := auth.WithPlayerToken(context.Background(), "test-token")
+	resp, err := handler.Handle(ctx, &OnboardStarterContractCommand{PlayerID: shared.MustNewPlayerID(1)})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	return resp.(*OnboardStarterContractResponse), repo, med
+}
+
+// A fresh agent's single open contract is imported and accepted.
+func TestOnboardStarterContract_AcceptsFreshAgentsContract(t *testing.T) {
+	resp, repo, med := runOnboard(t, onboardStarterData("starter", false))
+
+	if !resp.Accepted || resp.Contract == nil || resp.Contract.ContractID() != "starter" {
+		t.Fatalf("expected the starter to be accepted, got %+v", resp)
+	}
+	if len(repo.added) != 1 {
+		t.Fatalf("expected the starter imported before acceptance, got %d adds", len(repo.added))
+	}
+	if len(med.accepted) != 1 || med.accepted[0] != "starter" {
+		t.Fatalf("expected one AcceptContractCommand for the starter, got %v", med.accepted)
+	}
+}
+
+// An agent that has accepted any contract is past onboarding: its open offers
+// are not auto-accepted.
+func TestOnboardStarterContract_SkipsAgentPastOnboarding(t *testing.T) {
+	resp, repo, med := runOnboard(t, onboardStarterData("offer", false), onboardStarterData("older", true))
+
+	if resp.Accepted || resp.Reason == "" {
+		t.Fatalf("expected onboarding to decline with a reason, got %+v", resp)
+	}
+	if len(repo.added) != 0 || len(med.accepted) != 0 {
+		t.Fatalf("expected no import or accept, got %d adds and %v", len(repo.added), med.accepted)
+	}
+}
+
+func TestOnboardStarterContract_NoContracts(t *testing.T) {
+	resp, _, med := runOnboard(t)
+
+	if resp.Accepted || resp.Contract != nil || resp.Reason == "" {
+		t.Fatalf("expected an empty result with a reason, got %+v", resp)
+	}
+	if len(med.accepted) != 0 {
+		t.Fatalf("expected no accept, got %v", med.accepted)
+	}
+}
@@ -7,10 +7,12 @@ import (
 	"github.com/andrescamacho/spacetraders-go/internal/application/common"
 	appContract "github.com/andrescamacho/spacetraders-go/internal/application/contract"
 	contractTypes "github.com/andrescamacho/spacetraders-go/internal/application/contract/types"
+	fleetServices "github.com/andrescamacho/spacetraders-go/internal/application/fleet/services"
 	shipNav "github.com/andrescamacho/spacetraders-go/internal/application/ship/commands/navigation"
 	domainContract "github.com/andrescamacho/spacetraders-go/internal/domain/contract"
 	"github.com/andrescamacho/spacetraders-go/internal/domain/market"
 	"github.com/andrescamacho/spacetraders-go/internal/domain/navigation"
+	"github.com/andrescamacho/spacetraders-go/internal/domain/shared"
 	"github.com/andrescamacho/spacetraders-go/internal/domain/system"
 )
 
@@ -34,6 +36,17 @@ type RebalanceContractFleetHandler struct {
 	contractRepo   domainContract.ContractRepository
 	sourceFinder   SourceMarketFinder
 	prepositionCfg SourcePrepositionConfig
+
+	// fleetMover, when wired, flies the repositioning as one throttled bulk move
+	// that re-pairs hulls with the chosen markets by routed travel time. Nil
+	// keeps the unthrottled one-goroutine-per-ship fan-out.
+	fleetMover FleetMover
+}
+
+// FleetMover repositions a group of ships onto a set of targets in one bulk
+// move. Satisfied by *fleetServices.FleetMoveService.
+type FleetMover interface {
+	MoveFleet(ctx context.Context, playerID shared.PlayerID, ships []*navigation.Ship, targets []string, progress fleetServices.FleetMoveProgress) (*fleetServices.FleetMoveReport, error)
 }
 
 // MarketRepository defines the interface for market data access needed by rebalancing
@@ -100,6 +113,12 @@ func NewRebalanceContractFleetHandler(
 	}
 }
 
+// SetFleetMover wires the bulk fleet mover used for repositioning. Optional:
+// nil keeps the per-ship fan-out.
+func (h *RebalanceContractFleetHandler) SetFleetMover(mover FleetMover) {
+	h.fleetMover = mover
+}
+
 // Handle executes the fleet rebalancing command
 func (h *RebalanceContractFleetHandler) Handle(ctx context.Context, request common.Request) (common.Response, error) {
 	cmd, ok := request.(*RebalanceContractFleetCommand)
@@ -140,6 +159,13 @@ func (h *RebalanceContractFleetHandler) Handle(ctx context.Context, request comm
 		return nil, err
 	}
 
+	if h.fleetMover != nil {
+		if err := h.executeBulkRepositioning(ctx, cmd, ships, result); err != nil {
+			return nil, err
+		}
+		return result, nil
+	}
+
 	if err := h.executeParallelRepositioning(ctx, cmd, ships, result); err != nil {
 		return nil, err
 	}
@@ -373,6 +399,41 @@ func ensureContains(markets []string, symbol string) []string {
 	return append(markets, symbol)
 }
 
+// executeBulkRepositioning hands the assigned ships and their markets to the
+// fleet mover as one move. The distribution checker chose WHICH markets to
+// cover; the mover decides which hull covers which by routed travel time, so
+// result.Assignments is rewritten with the pairs actually flown.
+func (h *RebalanceContractFleetHandler) executeBulkRepositioning(
+	ctx context.Context,
+	cmd *RebalanceContractFleetCommand,
+	ships []*navigation.Ship,
+	result *RebalanceContractFleetResponse,
+) error {
+	logger := common.LoggerFromContext(ctx)
+
+	assigned := make([]*navigation.Ship, 0, len(result.Assignments))
+	targets := make([]string, 0, len(result.Assignments))
+	for _, ship := range ships {
+		if target, ok := result.Assignments[ship.ShipSymbol()]; ok {
+			assigned = append(assigned, ship)
+			targets = append(targets, target)
+		}
+	}
+
+	logger.Log("INFO", fmt.Sprintf("Starting ship repositioning (bulk move of %d ships)...", len(assigned)), nil)
+	report, err := h.fleetMover.MoveFleet(ctx, cmd.PlayerID, assigned, targets, nil)
+	if err != nil {
+		return fmt.Errorf("failed to reposition fleet: %w", err)
+	}
+
+	for _, moved := range report.Results {
+		result.Assignments[moved.ShipSymbol] = moved.Destination
+	}
+	result.ShipsMoved = report.Moved
+	logger.Log("INFO", fmt.Sprintf("Rebalancing complete: %d moved, %d already in place, %d failed", report.Moved, report.Skipped, report.Failed), nil)
+	return nil
+}
+
 func (h *RebalanceContractFleetHandler) executeParallelRepositioning(
 	ctx context.Context,
 	cmd *RebalanceContractFleetCommand,
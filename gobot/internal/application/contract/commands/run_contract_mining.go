@@ -0,0 +1,400 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/andrescamacho/spacetraders-go/internal/application/common"
+	appShip "github.com/andrescamacho/spacetraders-go/internal/application/ship"
+	shipCargo "github.com/andrescamacho/spacetraders-go/internal/application/ship/commands/cargo"
+	shipNav "github.com/andrescamacho/spacetraders-go/internal/application/ship/commands/navigation"
+	shipTypes "github.com/andrescamacho/spacetraders-go/internal/application/ship/types"
+	domainContract "github.com/andrescamacho/spacetraders-go/internal/domain/contract"
+	"github.com/andrescamacho/spacetraders-go/internal/domain/navigation"
+	"github.com/andrescamacho/spacetraders-go/internal/domain/shared"
+)
+
+const (
+	// contractMiningDefaultCooldown paces a round whose extractions all failed
+	// and so reported no cooldown.
+	contractMiningDefaultCooldown = 70 * time.Second
+
+	// contractMiningMaxFailedRounds ends the sub-operation after this many
+	// rounds in a row without a single successful extraction. The coordinator
+	// then buys the rest of the delivery.
+	contractMiningMaxFailedRounds = 5
+
+	// contractMiningDepartFill is the share of a capacity-bound load the
+	// transport must carry before it leaves for the delivery. The last few
+	// units of space are not worth another round of cooldown; whatever the
+	// miners still hold rides on the next trip.
+	contractMiningDepartFill = 0.9
+)
+
+// RunContractMiningCommand runs a temporary mining + transport sub-operation
+// for one contract delivery: the miners extract at the asteroid, hand the
+// contract good to the transport hull and jettison everything else, and the
+// transport carries full loads to the delivery waypoint until the delivery is
+// met. Launched by the contract fleet coordinator when mining beats buying.
+type RunContractMiningCommand struct {
+	PlayerID      shared.PlayerID
+	ContainerID   string
+	ContractID    string
+	Good          string
+	Asteroid      string
+	Destination   string
+	MinerShips    []string
+	TransportShip string
+}
+
+// RunContractMiningResponse reports what the sub-operation delivered
+type RunContractMiningResponse struct {
+	UnitsMined     int
+	UnitsDelivered int
+	Completed      bool // the delivery was met
+}
+
+// RunContractMiningHandler implements the contract mining sub-operation
+type RunContractMiningHandler struct {
+	mediator     common.Mediator
+	shipRepo     navigation.ShipRepository
+	contractRepo domainContract.ContractRepository
+	handoff      *appShip.TransferOrchestrator
+	clock        shared.Clock
+}
+
+// NewRunContractMiningHandler creates a new contract mining handler. handoff
+// moves the good from the miners into the transport.
+// The clock parameter is optional - if nil, defaults to RealClock.
+func NewRunContractMiningHandler(
+	mediator common.Mediator,
+	shipRepo navigation.ShipRepository,
+	contractRepo domainContract.ContractRepository,
+	handoff *appShip.TransferOrchestrator,
+	clock shared.Clock,
+) *RunContractMiningHandler {
+	if clock == nil {
+		clock = shared.NewRealClock()
+	}
+	return &RunContractMiningHandler{
+		mediator:     mediator,
+		shipRepo:     shipRepo,
+		contractRepo: contractRepo,
+		handoff:      handoff,
+		clock:        clock,
+	}
+}
+
+// Handle executes the contract mining sub-operation
+func (h *RunContractMiningHandler) Handle(ctx context.Context, request common.Request) (common.Response, error) {
+	cmd, ok := request.(*RunContractMiningCommand)
+	if !ok {
+		return nil, fmt.Errorf("invalid request type")
+	}
+	if cmd.ContractID == "" || cmd.Good == "" || cmd.Asteroid == "" || cmd.Destination == "" {
+		return nil, fmt.Errorf("contract mining requires contract, good, asteroid and destination")
+	}
+	if len(cmd.MinerShips) == 0 || cmd.TransportShip == "" {
+		return nil, fmt.Errorf("contract mining requires at least one miner and a transport ship")
+	}
+
+	logger := common.LoggerFromContext(ctx)
+	result := &RunContractMiningResponse{}
+
+	for {
+		remaining, err := h.remainingUnits(ctx, cmd)
+		if err != nil {
+			return result, err
+		}
+		if remaining == 0 {
+			result.Completed = true
+			logger.Log("INFO", "Contract mining complete - delivery met", map[string]interface{}{
+				"action":          "contract_mining_complete",
+				"contract_id":     cmd.ContractID,
+				"trade_symbol":    cmd.Good,
+				"units_mined":     result.UnitsMined,
+				"units_delivered": result.UnitsDelivered,
+			})
+			return result, nil
+		}
+
+		carried, err := h.fillTransport(ctx, cmd, remaining, result)
+		if err != nil {
+			return result, err
+		}
+
+		delivered, err := h.deliverLoad(ctx, cmd, carried, remaining)
+		if err != nil {
+			return result, err
+		}
+		result.UnitsDelivered += delivered
+	}
+}
+
+// remainingUnits is how much of the contract's delivery of cmd.Good to
+// cmd.Destination is still outstanding
+func (h *RunContractMiningHandler) remainingUnits(ctx context.Context, cmd *RunContractMiningCommand) (int, error) {
+	contract, err := h.contractRepo.FindByID(ctx, cmd.ContractID)
+	if err != nil {
+		return 0, fmt.Errorf("failed to load contract %s: %w", cmd.ContractID, err)
+	}
+	for _, delivery := range contract.Terms().Deliveries {
+		if delivery.TradeSymbol == cmd.Good && delivery.DestinationSymbol == cmd.Destination {
+			return delivery.UnitsRequired - delivery.UnitsFulfilled, nil
+		}
+	}
+	return 0, fmt.Errorf("contract %s has no delivery of %s to %s", cmd.ContractID, cmd.Good, cmd.Destination)
+}
+
+// fillTransport brings the transport and miners to the asteroid and mines
+// until the transport carries a full load (capped at remaining). After every
+// round it drains all the miners, largest holding first, and it departs once
+// the load is nearly full. Returns the units of the good aboard the transport.
+func (h *RunContractMiningHandler) fillTransport(ctx context.Context, cmd *RunContractMiningCommand, remaining int, result *RunContractMiningResponse) (int, error) {
+	logger := common.LoggerFromContext(ctx)
+
+	for _, shipSymbol := range append([]string{cmd.TransportShip}, cmd.MinerShips...) {
+		if err := h.navigate(ctx, cmd, shipSymbol, cmd.Asteroid); err != nil {
+			return 0, err
+		}
+	}
+
+	failedRounds := 0
+	for {
+		// Miners may still hold units left over from the previous trip.
+		carried, space, err := h.transportLoad(ctx, cmd)
+		if err != nil {
+			return 0, err
+		}
+		if !transportLoadReady(carried, space, remaining) {
+			h.drainMiners(ctx, cmd, min(space, remaining-carried))
+			if carried, space, err = h.transportLoad(ctx, cmd); err != nil {
+				return 0, err
+			}
+		}
+		if transportLoadReady(carried, space, remaining) {
+			return carried, nil
+		}
+
+		cooldown, extracted := h.mineRound(ctx, cmd, result)
+		if extracted {
+			failedRounds = 0
+		} else if failedRounds++; failedRounds >= contractMiningMaxFailedRounds {
+			return 0, fmt.Errorf("no successful extraction in %d rounds at %s", failedRounds, cmd.Asteroid)
+		}
+
+		logger.Log("DEBUG", "Contract mining round finished", map[string]interface{}{
+			"action":       "contract_mining_round",
+			"contract_id":  cmd.ContractID,
+			"carried":      carried,
+			"cooldown_sec": int(cooldown.Seconds()),
+		})
+		select {
+		case <-ctx.Done():
+			return 0, ctx.Err()
+		default:
+		}
+		h.clock.Sleep(cooldown)
+	}
+}
+
+// transportLoad returns the units of the good aboard the transport and its
+// free cargo space.
+func (h *RunContractMiningHandler) transportLoad(ctx context.Context, cmd *RunContractMiningCommand) (carried, space int, err error) {
+	transport, err := h.shipRepo.FindBySymbol(ctx, cmd.TransportShip, cmd.PlayerID)
+	if err != nil {
+		return 0, 0, fmt.Errorf("failed to load transport %s: %w", cmd.TransportShip, err)
+	}
+	return transport.Cargo().GetItemUnits(cmd.Good), transport.AvailableCargoSpace(), nil
+}
+
+// transportLoadReady reports whether the transport should leave for the
+// delivery. A load that covers the rest of the delivery must be complete; a
+// load bounded by the hold only needs contractMiningDepartFill of it.
+func transportLoadReady(carried, space, remaining int) bool {
+	if carried >= remaining || space <= 0 {
+		return true
+	}
+	if carried+space >= remaining {
+		return false
+	}
+	return float64(carried) >= contractMiningDepartFill*float64(carried+space)
+}
+
+// minerPickup is one planned transfer from a miner to the transport.
+type minerPickup struct {
+	Miner string
+	Units int
+}
+
+// planMinerPickups orders the transfers that move up to space units from the
+// miners' holdings into the transport. Larger holdings go first so the hold
+// fills in as few transfer calls as possible; a miner whose units do not fit
+// keeps them for the next trip.
+func planMinerPickups(holdings map[string]int, space int) []minerPickup {
+	miners := make([]string, 0, len(holdings))
+	for miner, units := range holdings {
+		if units > 0 {
+			miners = append(miners, miner)
+		}
+	}
+	sort.Slice(miners, func(i, j int) bool {
+		if holdings[miners[i]] != holdings[miners[j]] {
+			return holdings[miners[i]] > holdings[miners[j]]
+		}
+		return miners[i] < miners[j]
+	})
+
+	var plan []minerPickup
+	for _, miner := range miners {
+		if space <= 0 {
+			break
+		}
+		units := min(holdings[miner], space)
+		plan = append(plan, minerPickup{Miner: miner, Units: units})
+		space -= units
+	}
+	return plan
+}
+
+// drainMiners polls every miner's hold and hands up to space units of the
+// good to the transport in planMinerPickups order. Returns the units moved.
+func (h *RunContractMiningHandler) drainMiners(ctx context.Context, cmd *RunContractMiningCommand, space int) int {
+	logger := common.LoggerFromContext(ctx)
+	holdings := make(map[string]int, len(cmd.MinerShips))
+	for _, miner := range cmd.MinerShips {
+		ship, err := h.shipRepo.FindBySymbol(ctx, miner, cmd.PlayerID)
+		if err != nil {
+			continue
+		}
+		holdings[miner] = ship.Cargo().GetItemUnits(cmd.Good)
+	}
+
+	moved := 0
+	for _, pickup := range planMinerPickups(holdings, space) {
+		result, err := h.handoff.Handoff(ctx, appShip.HandoffRequest{
+			SourceShip: pickup.Miner,
+			Haulers:    []string{cmd.TransportShip},
+			Goods:      []string{cmd.Good},
+			MaxUnits:   pickup.Units,
+			PlayerID:   cmd.PlayerID,
+		})
+		if err != nil {
+			logger.Log("WARNING", fmt.Sprintf("Handoff from %s to %s failed: %v", pickup.Miner, cmd.TransportShip, err), nil)
+			continue
+		}
+		for hauler, reason := range result.SkippedHaulers {
+			logger.Log("WARNING", fmt.Sprintf("Handoff from %s skipped %s: %s", pickup.Miner, hauler, reason), nil)
+		}
+		moved += result.UnitsTransferred
+	}
+	if moved > 0 {
+		logger.Log("DEBUG", "Drained miners into transport", map[string]interface{}{
+			"action":      "contract_mining_drain",
+			"contract_id": cmd.ContractID,
+			"ship_symbol": cmd.TransportShip,
+			"units":       moved,
+		})
+	}
+	return moved
+}
+
+// mineRound has every miner extract once and jettisons whatever else was
+// extracted; the good stays aboard until drainMiners collects it. Returns the
+// longest cooldown to wait out and whether any extraction succeeded.
+func (h *RunContractMiningHandler) mineRound(ctx context.Context, cmd *RunContractMiningCommand, result *RunContractMiningResponse) (time.Duration, bool) {
+	logger := common.LoggerFromContext(ctx)
+	var cooldown time.Duration
+	extracted := false
+
+	for _, miner := range cmd.MinerShips {
+		resp, err := h.mediator.Send(ctx, &shipCargo.ExtractResourcesCommand{ShipSymbol: miner, PlayerID: cmd.PlayerID})
+		if err != nil {
+			logger.Log("WARNING", fmt.Sprintf("Extraction by %s failed: %v", miner, err), map[string]interface{}{
+				"action":      "contract_mining_extract_failed",
+				"ship_symbol": miner,
+				"contract_id": cmd.ContractID,
+			})
+			continue
+		}
+		extraction := resp.(*shipCargo.ExtractResourcesResponse)
+		extracted = true
+		if extraction.CooldownDuration > cooldown {
+			cooldown = extraction.CooldownDuration
+		}
+		if extraction.YieldSymbol == cmd.Good {
+			result.UnitsMined += extraction.YieldUnits
+		}
+
+		ship, err := h.shipRepo.FindBySymbol(ctx, miner, cmd.PlayerID)
+		if err != nil {
+			continue
+		}
+		// Off-target yield only takes the space the next extraction needs.
+		for _, item := range ship.Cargo().GetOtherItems(cmd.Good) {
+			if _, err := h.mediator.Send(ctx, &shipCargo.JettisonCargoCommand{
+				ShipSymbol: miner,
+				PlayerID:   cmd.PlayerID,
+				GoodSymbol: item.Symbol,
+				Units:      item.Units,
+			}); err != nil {
+				logger.Log("WARNING", fmt.Sprintf("Jettison of %s from %s failed: %v", item.Symbol, miner, err), nil)
+			}
+		}
+	}
+
+	if cooldown == 0 {
+		cooldown = contractMiningDefaultCooldown
+	}
+	return cooldown, extracted
+}
+
+// deliverLoad flies the transport to the destination and delivers what it
+// carries, capped at remaining
+func (h *RunContractMiningHandler) deliverLoad(ctx context.Context, cmd *RunContractMiningCommand, carried, remaining int) (int, error) {
+	units := carried
+	if units > remaining {
+		units = remaining
+	}
+	if units == 0 {
+		return 0, nil
+	}
+
+	if err := h.navigate(ctx, cmd, cmd.TransportShip, cmd.Destination); err != nil {
+		return 0, err
+	}
+	if _, err := h.mediator.Send(ctx, &shipTypes.DockShipCommand{ShipSymbol: cmd.TransportShip, PlayerID: cmd.PlayerID}); err != nil {
+		return 0, fmt.Errorf("failed to dock transport at %s: %w", cmd.Destination, err)
+	}
+	if _, err := h.mediator.Send(ctx, &DeliverContractCommand{
+		ContractID:  cmd.ContractID,
+		ShipSymbol:  cmd.TransportShip,
+		TradeSymbol: cmd.Good,
+		Units:       units,
+		PlayerID:    cmd.PlayerID,
+	}); err != nil {
+		return 0, fmt.Errorf("failed to deliver mined %s: %w", cmd.Good, err)
+	}
+
+	common.LoggerFromContext(ctx).Log("INFO", "Mined cargo delivered", map[string]interface{}{
+		"action":       "contract_mining_delivered",
+		"contract_id":  cmd.ContractID,
+		"ship_symbol":  cmd.TransportShip,
+		"trade_symbol": cmd.Good,
+		"units":        units,
+	})
+	return units, nil
+}
+
+func (h *RunContractMiningHandler) navigate(ctx context.Context, cmd *RunContractMiningCommand, shipSymbol, destination string) error {
+	if _, err := h.mediator.Send(ctx, &shipNav.NavigateRouteCommand{
+		ShipSymbol:  shipSymbol,
+		Destination: destination,
+		PlayerID:    cmd.PlayerID,
+	}); err != nil {
+		return fmt.Errorf("failed to navigate %s to %s: %w", shipSymbol, destination, err)
+	}
+	return nil
+}
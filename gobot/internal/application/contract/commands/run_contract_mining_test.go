@@ -0,0 +1,39 @@
+package commands
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestPlanMinerPickups_LargestHoldingsFirstUntilTheHoldIsFull(t *testing.T) {
+	holdings := map[string]int{"MINER-1": 8, "MINER-2": 25, "MINER-3": 0, "MINER-4": 15}
+
+	got := planMinerPickups(holdings, 35)
+	want := []minerPickup{{Miner: "MINER-2", Units: 25}, {Miner: "MINER-4", Units: 10}}
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("planMinerPickups = %+v, want %+v", got, want)
+	}
+
+	if got := planMinerPickups(holdings, 0); len(got) != 0 {
+		t.Fatalf("a full transport takes nothing, got %+v", got)
+	}
+}
+
+func TestTransportLoadReady(t *testing.T) {
+	cases := []struct {
+		name                      string
+		carried, space, remaining int
+		want                      bool
+	}{
+		{"delivery covered", 20, 20, 20, true},
+		{"hold full", 40, 0, 100, true},
+		{"last load must be complete", 38, 10, 40, false},
+		{"capacity-bound load nearly full", 37, 3, 100, true},
+		{"capacity-bound load half full", 20, 20, 100, false},
+	}
+	for _, tc := range cases {
+		if got := transportLoadReady(tc.carried, tc.space, tc.remaining); got != tc.want {
+			t.Errorf("%s: transportLoadReady(%d, %d, %d) = %v, want %v", tc.name, tc.carried, tc.space, tc.remaining, got, tc.want)
+		}
+	}
+}
@@ -11,6 +11,7 @@ import (
 	contractServices "github.com/andrescamacho/spacetraders-go/internal/application/contract/services"
 	contractTypes "github.com/andrescamacho/spacetraders-go/internal/application/contract/types"
 	domainContract "github.com/andrescamacho/spacetraders-go/internal/domain/contract"
+	"github.com/andrescamacho/spacetraders-go/internal/domain/market"
 	"github.com/andrescamacho/spacetraders-go/internal/domain/navigation"
 	domainPorts "github.com/andrescamacho/spacetraders-go/internal/domain/ports"
 	"github.com/andrescamacho/spacetraders-go/internal/domain/shared"
@@ -52,6 +53,12 @@ type RunWorkflowHandler struct {
 	// the single-shot path is unaffected. Injectable so tests advance it
 	// instantly (shared.MockClock).
 	clock shared.Clock
+	// maxBatchContracts caps how many contracts (the primary included) one hold
+	// services when multi-contract batching is wired; 0 leaves batching off.
+	maxBatchContracts int
+	// audit records the negotiate, accept and fulfill steps; nil records
+	// nothing.
+	audit *contractServices.WorkflowAuditor
 }
 
 // RunWorkflowOption configures optional collaborators on the contract workflow
@@ -60,7 +67,9 @@ type RunWorkflowHandler struct {
 type RunWorkflowOption func(*runWorkflowConfig)
 
 type runWorkflowConfig struct {
-	deliveryOpts []contractServices.DeliveryExecutorOption
+	deliveryOpts      []contractServices.DeliveryExecutorOption
+	maxBatchContracts int
+	audit             *contractServices.WorkflowAuditor
 }
 
 // WithInventorySourcing enables inventory-first contract sourcing (sp-dchv Lane
@@ -84,6 +93,49 @@ func WithWithdrawalRecording(recorder storage.WithdrawalRecorder, clock shared.C
 	}
 }
 
+// WithContractBatching enables multi-contract delivery batching: when other accepted
+// contracts are outstanding alongside the primary one, the hauler packs up to
+// maxContracts of them into shared holds and flies one purchase-then-deliver tour
+// per hold, stops ordered by the VRP solver. A nil planner or orderer, or
+// maxContracts < 2, is a no-op.
+func WithContractBatching(planner contractServices.BatchSourcePlanner, orderer contractServices.BatchStopOrderer, maxContracts int) RunWorkflowOption {
+	return func(c *runWorkflowConfig) {
+		if planner == nil || orderer == nil || maxContracts < 2 {
+			return
+		}
+		c.deliveryOpts = append(c.deliveryOpts, contractServices.WithDeliveryBatching(planner, orderer))
+		c.maxBatchContracts = maxContracts
+	}
+}
+
+// WithDeliveryCompensation arms the delivery saga's compensation on the
+// delivery executor: a load whose delivery fails permanently is sold at the
+// best in-system market and the sale filed against the contract. A nil finder
+// is a no-op.
+func WithDeliveryCompensation(markets market.MarketRepository) RunWorkflowOption {
+	return func(c *runWorkflowConfig) {
+		if markets == nil {
+			return
+		}
+		c.deliveryOpts = append(c.deliveryOpts, contractServices.WithDeliveryCompensation(markets))
+	}
+}
+
+// WithWorkflowAudit persists every workflow step (negotiate, accept, purchase,
+// navigate, deliver, fulfill) with its ship, timestamps, credits and outcome,
+// queryable by contract ID. A nil repo is a no-op; a nil clock defaults to the
+// real clock.
+func WithWorkflowAudit(repo domainContract.WorkflowAuditRepository, clock shared.Clock) RunWorkflowOption {
+	return func(c *runWorkflowConfig) {
+		auditor := contractServices.NewWorkflowAuditor(repo, clock)
+		if auditor == nil {
+			return
+		}
+		c.audit = auditor
+		c.deliveryOpts = append(c.deliveryOpts, contractServices.WithWorkflowAudit(auditor))
+	}
+}
+
 // NewRunWorkflowHandler creates a new contract workflow handler
 func NewRunWorkflowHandler(
 	mediator common.Mediator,
@@ -106,9 +158,11 @@ func NewRunWorkflowHandler(
 	}
 
 	return &RunWorkflowHandler{
-		lifecycleService: lifecycleService,
-		deliveryExecutor: deliveryExecutor,
-		clock:            clock,
+		lifecycleService:  lifecycleService,
+		deliveryExecutor:  deliveryExecutor,
+		clock:             clock,
+		maxBatchContracts: cfg.maxBatchContracts,
+		audit:             cfg.audit,
 	}
 }
 
@@ -149,6 +203,13 @@ func (h *RunWorkflowHandler) Handle(ctx context.Context, request common.Request)
 			result.Error = insufficientErr.Error()
 			return result, nil
 		}
+		// A compensated delivery saga is likewise a clean exit: the contract
+		// can never take this load, and the load has already been sold off.
+		var compensatedErr *contractServices.ErrDeliveryCompensated
+		if errors.As(err, &compensatedErr) {
+			result.Error = compensatedErr.Error()
+			return result, nil
+		}
 
 		result.Error = err.Error()
 		return result, err
@@ -168,10 +229,11 @@ func (h *RunWorkflowHandler) executeWorkflow(
 	cmd *RunWorkflowCommand,
 	result *RunWorkflowResponse,
 ) error {
-	contract, wasNegotiated, err := h.lifecycleService.FindOrNegotiateContract(ctx, cmd.ShipSymbol, cmd.PlayerID)
+	contract, wasNegotiated, err := h.findOrNegotiate(ctx, cmd)
 	if err != nil {
 		return err
 	}
+	ctx = contractServices.WithWorkflowScope(ctx, contract.ContractID(), cmd.ContainerID)
 
 	if wasNegotiated {
 		result.Negotiated = true
@@ -183,7 +245,7 @@ func (h *RunWorkflowHandler) executeWorkflow(
 	}
 
 	var wasAccepted bool
-	contract, wasAccepted, err = h.lifecycleService.AcceptContractIfNeeded(ctx, contract, cmd.PlayerID)
+	contract, wasAccepted, err = h.acceptIfNeeded(ctx, cmd, contract)
 	if err != nil {
 		return err
 	}
@@ -192,6 +254,11 @@ func (h *RunWorkflowHandler) executeWorkflow(
 		result.Accepted = true
 	}
 
+	contract, err = h.processBatchedContracts(ctx, cmd, contract, result)
+	if err != nil {
+		return err
+	}
+
 	contract, err = h.deliveryExecutor.ProcessAllDeliveries(ctx, cmd.ShipSymbol, cmd.PlayerID, contract, profitabilityResp, result, cmd.ContainerID)
 	if err != nil {
 		return err
@@ -214,10 +281,17 @@ func (h *RunWorkflowHandler) executeWorkflow(
 			"contract_id": contract.ContractID(),
 		})
 		result.Error = msg
+		h.audit.Record(ctx, domainContract.WorkflowStepRecord{
+			PlayerID:   cmd.PlayerID.Value(),
+			ShipSymbol: cmd.ShipSymbol,
+			Step:       domainContract.WorkflowStepFulfill,
+			Outcome:    domainContract.WorkflowStepSkipped,
+			Detail:     "deliveries incomplete after sourcing pass",
+		}, h.audit.Now(), nil)
 		return nil
 	}
 
-	if err := h.lifecycleService.FulfillContract(ctx, contract, cmd.PlayerID); err != nil {
+	if err := h.fulfill(ctx, cmd, contract); err != nil {
 		return err
 	}
 
@@ -239,6 +313,67 @@ func (h *RunWorkflowHandler) executeWorkflow(
 	return nil
 }
 
+// processBatchedContracts runs the multi-contract batching pass in front of the
+// primary contract's own delivery leg. The primary goes first in the batch so its
+// goods are never starved by the others; any other contract the pass completes is
+// fulfilled here and its payout added to the result. Returns the updated primary
+// contract for the per-contract leg to finish (a no-op when batching is off or no
+// other contract is outstanding).
+func (h *RunWorkflowHandler) processBatchedContracts(
+	ctx context.Context,
+	cmd *RunWorkflowCommand,
+	contract *domainContract.Contract,
+	result *RunWorkflowResponse,
+) (*domainContract.Contract, error) {
+	if h.maxBatchContracts < 2 || !h.deliveryExecutor.BatchingEnabled() {
+		return contract, nil
+	}
+	logger := common.LoggerFromContext(ctx)
+
+	others, err := h.lifecycleService.FindOtherActiveContracts(ctx, cmd.PlayerID, contract.ContractID(), h.maxBatchContracts-1)
+	if err != nil {
+		// Batching is an optimisation; the per-contract leg still runs.
+		logger.Log("WARNING", "Could not list other active contracts; skipping batching", map[string]interface{}{
+			"ship_symbol": cmd.ShipSymbol,
+			"action":      "batch_contracts_lookup",
+			"error":       err.Error(),
+		})
+		return contract, nil
+	}
+	if len(others) == 0 {
+		return contract, nil
+	}
+
+	batched, err := h.deliveryExecutor.ProcessBatchedDeliveries(ctx, cmd.ShipSymbol, cmd.PlayerID,
+		append([]*domainContract.Contract{contract}, others...), result, cmd.ContainerID)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, other := range batched[1:] {
+		if !other.CanFulfill() {
+			continue
+		}
+		if err := h.fulfill(ctx, cmd, other); err != nil {
+			// The deliveries landed; the coordinator's next pass fulfills it.
+			logger.Log("WARNING", "Batched contract delivered but fulfill failed; leaving for coordinator", map[string]interface{}{
+				"ship_symbol": cmd.ShipSymbol,
+				"action":      "batch_fulfill_failed",
+				"contract_id": other.ContractID(),
+				"error":       err.Error(),
+			})
+			continue
+		}
+		result.TotalProfit += h.lifecycleService.CalculateTotalProfit(other)
+		logger.Log("INFO", "Batched contract fulfilled", map[string]interface{}{
+			"ship_symbol": cmd.ShipSymbol,
+			"action":      "batch_contract_fulfilled",
+			"contract_id": other.ContractID(),
+		})
+	}
+	return batched[0], nil
+}
+
 // negotiateNextContractBestEffort reuses the same idempotent lifecycle calls
 // FindOrNegotiateContract makes for a fresh worker (FindActiveContracts
 // first, so it never re-negotiates a contract another path already claimed)
@@ -252,7 +387,7 @@ func (h *RunWorkflowHandler) executeWorkflow(
 func (h *RunWorkflowHandler) negotiateNextContractBestEffort(ctx context.Context, cmd *RunWorkflowCommand) {
 	logger := common.LoggerFromContext(ctx)
 
-	nextContract, wasNegotiated, err := h.lifecycleService.FindOrNegotiateContract(ctx, cmd.ShipSymbol, cmd.PlayerID)
+	nextContract, wasNegotiated, err := h.findOrNegotiate(ctx, cmd)
 	if err != nil {
 		logger.Log("WARNING", "Best-effort next-contract negotiation failed; falling back to coordinator discovery", map[string]interface{}{
 			"ship_symbol": cmd.ShipSymbol,
@@ -262,7 +397,8 @@ func (h *RunWorkflowHandler) negotiateNextContractBestEffort(ctx context.Context
 		return
 	}
 
-	if _, _, err := h.lifecycleService.AcceptContractIfNeeded(ctx, nextContract, cmd.PlayerID); err != nil {
+	ctx = contractServices.WithWorkflowScope(ctx, nextContract.ContractID(), cmd.ContainerID)
+	if _, _, err := h.acceptIfNeeded(ctx, cmd, nextContract); err != nil {
 		logger.Log("WARNING", "Best-effort next-contract acceptance failed; falling back to coordinator discovery", map[string]interface{}{
 			"ship_symbol": cmd.ShipSymbol,
 			"action":      "accept_next_contract",
@@ -280,6 +416,63 @@ func (h *RunWorkflowHandler) negotiateNextContractBestEffort(ctx context.Context
 	})
 }
 
+// findOrNegotiate resumes the player's active contract or negotiates a new one,
+// recording the negotiate step: skipped when an active contract was resumed.
+func (h *RunWorkflowHandler) findOrNegotiate(ctx context.Context, cmd *RunWorkflowCommand) (*domainContract.Contract, bool, error) {
+	started := h.audit.Now()
+	contract, wasNegotiated, err := h.lifecycleService.FindOrNegotiateContract(ctx, cmd.ShipSymbol, cmd.PlayerID)
+	record := domainContract.WorkflowStepRecord{
+		PlayerID:    cmd.PlayerID.Value(),
+		ContainerID: cmd.ContainerID,
+		ShipSymbol:  cmd.ShipSymbol,
+		Step:        domainContract.WorkflowStepNegotiate,
+	}
+	if contract != nil {
+		record.ContractID = contract.ContractID()
+	}
+	if err == nil && !wasNegotiated {
+		record.Outcome = domainContract.WorkflowStepSkipped
+		record.Detail = "resumed active contract"
+	}
+	h.audit.Record(ctx, record, started, err)
+	return contract, wasNegotiated, err
+}
+
+// acceptIfNeeded accepts contract unless it already is, recording the accept
+// step with the acceptance payment.
+func (h *RunWorkflowHandler) acceptIfNeeded(ctx context.Context, cmd *RunWorkflowCommand, contract *domainContract.Contract) (*domainContract.Contract, bool, error) {
+	started := h.audit.Now()
+	accepted, wasAccepted, err := h.lifecycleService.AcceptContractIfNeeded(ctx, contract, cmd.PlayerID)
+	record := domainContract.WorkflowStepRecord{
+		PlayerID:   cmd.PlayerID.Value(),
+		ShipSymbol: cmd.ShipSymbol,
+		Step:       domainContract.WorkflowStepAccept,
+	}
+	switch {
+	case err == nil && !wasAccepted:
+		record.Outcome = domainContract.WorkflowStepSkipped
+		record.Detail = "already accepted"
+	case err == nil:
+		record.Credits = contract.Terms().Payment.OnAccepted
+	}
+	h.audit.Record(ctx, record, started, err)
+	return accepted, wasAccepted, err
+}
+
+// fulfill fulfills contract, recording the fulfill step with its payment.
+func (h *RunWorkflowHandler) fulfill(ctx context.Context, cmd *RunWorkflowCommand, contract *domainContract.Contract) error {
+	started := h.audit.Now()
+	err := h.lifecycleService.FulfillContract(ctx, contract, cmd.PlayerID)
+	h.audit.Record(ctx, domainContract.WorkflowStepRecord{
+		PlayerID:   cmd.PlayerID.Value(),
+		ContractID: contract.ContractID(),
+		ShipSymbol: cmd.ShipSymbol,
+		Step:       domainContract.WorkflowStepFulfill,
+		Credits:    contract.Terms().Payment.OnFulfilled,
+	}, started, err)
+	return err
+}
+
 // ============================================================================
 // Continuous single-hull contract loop (sp-ehg9)
 // ============================================================================
@@ -0,0 +1,70 @@
+package commands
+
+import (
+	"context"
+	"testing"
+
+	"github.com/andrescamacho/spacetraders-go/internal/application/auth"
+	"github.com/andrescamacho/spacetraders-go/internal/domain/contract"
+	"github.com/andrescamacho/spacetraders-go/internal/domain/shared"
+)
+
+// workflowAuditRecorder keeps recorded steps in memory.
+type workflowAuditRecorder struct {
+	contract.WorkflowAuditRepository
+	steps []contract.WorkflowStepRecord
+}
+
+func (r *workflowAuditRecorder) RecordStep(_ context.Context, record contract.WorkflowStepRecord) error {
+	r.steps = append(r.steps, record)
+	return nil
+}
+
+// A resumed, already-accepted contract records its negotiate and accept steps
+// as skipped and its fulfill with the payout; the next contract the ship claims
+// straight after is filed under its own ID.
+func TestRunWorkflowHandler_RecordsWorkflowSteps(t *testing.T) {
+	current := mustNewWorkflowTestContract(t, "contract-current", 80)
+	if err := current.Accept(); err != nil {
+		t.Fatalf("seed Accept: %v", err)
+	}
+	contractRepo := newWorkflowStubContractRepo(current)
+	mediator := &workflowFakeMediator{contractRepo: contractRepo, nextContract: mustNewWorkflowTestContract(t, "contract-next", 0)}
+	recorder := &workflowAuditRecorder{}
+	handler := NewRunWorkflowHandler(mediator, nil, contractRepo, nil, WithWorkflowAudit(recorder, nil))
+
+	_, err := handler.Handle(auth.WithPlayerToken(context.Background(), "test-token"), &RunWorkflowCommand{
+		ShipSymbol:  "TORWIND-3",
+		PlayerID:    shared.MustNewPlayerID(1),
+		ContainerID: "contract-work-1",
+	})
+	if err != nil {
+		t.Fatalf("Handle: %v", err)
+	}
+
+	type step struct {
+		contractID string
+		step       contract.WorkflowStep
+		outcome    contract.WorkflowStepOutcome
+		credits    int
+	}
+	want := []step{
+		{"contract-current", contract.WorkflowStepNegotiate, contract.WorkflowStepSkipped, 0},
+		{"contract-current", contract.WorkflowStepAccept, contract.WorkflowStepSkipped, 0},
+		{"contract-current", contract.WorkflowStepFulfill, contract.WorkflowStepSucceeded, 20000},
+		{"contract-next", contract.WorkflowStepNegotiate, contract.WorkflowStepSucceeded, 0},
+		{"contract-next", contract.WorkflowStepAccept, contract.WorkflowStepSucceeded, 5000},
+	}
+	if len(recorder.steps) != len(want) {
+		t.Fatalf("recorded %d steps, want %d: %+v", len(recorder.steps), len(want), recorder.steps)
+	}
+	for i, w := range want {
+		got := recorder.steps[i]
+		if got.ContractID != w.contractID || got.Step != w.step || got.Outcome != w.outcome || got.Credits != w.credits {
+			t.Fatalf("step %d = %s %s %s %d, want %+v", i, got.ContractID, got.Step, got.Outcome, got.Credits, w)
+		}
+		if got.ShipSymbol != "TORWIND-3" || got.ContainerID != "contract-work-1" || got.FinishedAt.IsZero() {
+			t.Fatalf("step %d missing ship, container or timestamps: %+v", i, got)
+		}
+	}
+}
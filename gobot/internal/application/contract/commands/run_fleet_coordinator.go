@@ -92,6 +92,16 @@ type RunFleetCoordinatorHandler struct {
 	// injects a store-backed provider via SetDepotRegistryProvider, mirroring
 	// the invFinder / standbyProvider optional-injection idiom.
 	depotRegistryProvider appContract.DepotRegistryProvider
+
+	// coordinationEvents is handed to the idle-arb dispatcher so a market
+	// update wakes a harvest pass early. Nil leaves the dispatcher on its tick.
+	coordinationEvents shared.CoordinationSubscriber
+
+	// miningEstimator + miningLauncher enable procurement-by-mining (see
+	// procureByMining). Wired together via SetMiningProcurement; either nil
+	// keeps every delivery on the market path.
+	miningEstimator appContract.MiningCostEstimator
+	miningLauncher  appContract.ContractMiningLauncher
 }
 
 // NewRunFleetCoordinatorHandler creates a new fleet coordinator handler
@@ -138,6 +148,13 @@ func (h *RunFleetCoordinatorHandler) SetEventSubscriber(subscriber navigation.Sh
 	h.eventSubscriber = subscriber
 }
 
+// SetCoordinationSubscriber wires the coordination event bus into the idle-arb
+// dispatcher this coordinator spawns, so it wakes on market.updated. Optional:
+// without it the dispatcher polls on its interval alone.
+func (h *RunFleetCoordinatorHandler) SetCoordinationSubscriber(subscriber shared.CoordinationSubscriber) {
+	h.coordinationEvents = subscriber
+}
+
 // SetDedicatedFleetSeedMarker wires the durable first-boot marker so the
 // coordinator persists "the --dedicated-ships seed has been applied" after its
 // first boot and skips replaying that seed on every later restart. Left unset
@@ -267,6 +284,10 @@ func (h *RunFleetCoordinatorHandler) Handle(ctx context.Context, request common.
 				MinNetProfitPerUnit: cmd.IdleArbMinNetProfit,
 				NetProfitFraction:   float64(cmd.IdleArbNetProfitPct) / 100.0,
 				FuelCostPerUnit:     cmd.IdleArbFuelCostPerUnit,
+				// Anti-starvation policy (0 → off). Percent → fraction.
+				StarvationAfter:               time.Duration(cmd.IdleArbStarvationAfterSecs) * time.Second,
+				StarvationMinNetProfitPerUnit: cmd.IdleArbStarvationMinNetProfit,
+				StarvationNetProfitFraction:   float64(cmd.IdleArbStarvationNetProfitPct) / 100.0,
 			},
 		)
 		// Wires the cross-engine absorption ledger so the dispatcher consults it
@@ -279,6 +300,8 @@ func (h *RunFleetCoordinatorHandler) Handle(ctx context.Context, request common.
 		dispatcher.SetStandbyResolver(func(resolveCtx context.Context) []string {
 			return appContract.ResolveStandbyStations(resolveCtx, common.LoggerFromContext(resolveCtx), h.standbyProvider, cmd.ContainerID, cmd.PlayerID.Value(), cmd.StandbyStations)
 		})
+		// Market-update wake-ups: a fresh scan runs a pass early. Inert when unwired.
+		dispatcher.SetEventSubscriber(h.coordinationEvents)
 		go dispatcher.Run(ctx)
 	}
 
@@ -320,6 +343,10 @@ func (h *RunFleetCoordinatorHandler) Handle(ctx context.Context, request common.
 	// already-cleared hold).
 	liquidationCooldown := make(map[string]time.Time)
 
+	// miningAttempted is the set of contracts a mining sub-operation has been
+	// launched for, in-memory for this run only (see procureByMining).
+	miningAttempted := make(map[string]bool)
+
 	// Executes one contract at a time (game constraint: one active contract per player).
 	for {
 		select {
@@ -492,6 +519,13 @@ func (h *RunFleetCoordinatorHandler) Handle(ctx context.Context, request common.
 			h.clock.Sleep(30 * time.Second)
 			continue
 		}
+		// PROCUREMENT-BY-MINING: a mineable good whose mining estimate beats the
+		// market is handed to a contract-scoped mining sub-operation; the
+		// contract stays parked while it runs, then re-plans from what is left.
+		if h.procureByMining(ctx, cmd, contract, plan, availableShips, miningAttempted) {
+			h.clock.Sleep(appContract.MiningProcurementRecheckInterval)
+			continue
+		}
 		purchaseMarket := plan.Market
 
 		// SOURCING DEFER GATE: never EXECUTE a sourcing run whose projected net is
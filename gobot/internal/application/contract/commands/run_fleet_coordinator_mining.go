@@ -0,0 +1,176 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/andrescamacho/spacetraders-go/internal/application/common"
+	appContract "github.com/andrescamacho/spacetraders-go/internal/application/contract"
+	domainContract "github.com/andrescamacho/spacetraders-go/internal/domain/contract"
+	"github.com/andrescamacho/spacetraders-go/internal/domain/shared"
+)
+
+// miningHullRole is the registration role of a hull that can extract ore.
+const miningHullRole = "EXCAVATOR"
+
+// SetMiningProcurement wires procurement-by-mining: the estimator prices
+// mining a mineable contract good and the launcher starts the contract-scoped
+// mining sub-operation when mining wins. Optional and nil-safe: without both
+// the coordinator buys every good, as before.
+func (h *RunFleetCoordinatorHandler) SetMiningProcurement(estimator appContract.MiningCostEstimator, launcher appContract.ContractMiningLauncher) {
+	h.miningEstimator = estimator
+	h.miningLauncher = launcher
+}
+
+// procureByMining decides whether this pass's delivery is sourced by mining
+// instead of a purchasing worker. It returns true when the contract is parked
+// on a mining sub-operation — one already running, or one it just launched —
+// and false when the caller should carry on with the market plan.
+//
+// attempted remembers the contracts a sub-operation was launched for during
+// this coordinator's life. A sub-operation that ends with the delivery still
+// open (too many failed extractions, a lost hull) is not relaunched: the rest
+// is bought, so a contract never loops on mining it cannot finish.
+func (h *RunFleetCoordinatorHandler) procureByMining(
+	ctx context.Context,
+	cmd *RunFleetCoordinatorCommand,
+	contract *domainContract.Contract,
+	plan *appContract.SourcingPlan,
+	availableShips []string,
+	attempted map[string]bool,
+) bool {
+	if h.miningEstimator == nil || h.miningLauncher == nil || cmd.MiningProcurementDisabled {
+		return false
+	}
+	if plan == nil || plan.Source == appContract.SourceInventory {
+		return false
+	}
+	logger := common.LoggerFromContext(ctx)
+	playerID := cmd.PlayerID.Value()
+	contractID := contract.ContractID()
+
+	active, err := h.miningLauncher.ActiveContractMining(ctx, playerID, contractID)
+	if err != nil {
+		logger.Log("WARNING", fmt.Sprintf("Failed to check contract mining for %s: %v - buying instead", contractID, err), nil)
+		return false
+	}
+	if active != "" {
+		logger.Log("INFO", "Contract good is being mined - waiting on the mining sub-operation", map[string]interface{}{
+			"action":       "mining_procurement_wait",
+			"contract_id":  contractID,
+			"container_id": active,
+			"trade_symbol": plan.Good,
+		})
+		return true
+	}
+	if attempted[contractID] {
+		return false
+	}
+
+	destination := ""
+	for _, delivery := range contract.Terms().Deliveries {
+		if delivery.TradeSymbol == plan.Good && delivery.UnitsRequired > delivery.UnitsFulfilled {
+			destination = delivery.DestinationSymbol
+			break
+		}
+	}
+	if destination == "" {
+		return false
+	}
+	homeSystem := shared.ExtractSystemSymbol(destination)
+
+	miners, err := h.idleMiners(ctx, cmd.PlayerID, homeSystem)
+	if err != nil || len(miners) == 0 {
+		return false
+	}
+	transport := h.miningTransport(ctx, cmd.PlayerID, availableShips, homeSystem)
+	if transport == "" {
+		return false
+	}
+
+	estimate := h.miningEstimator.EstimateMining(ctx, playerID, destination, plan.Good, plan.UnitsRemaining, len(miners))
+	deadline, _ := time.Parse(time.RFC3339, contract.Terms().Deadline)
+	decision := appContract.EvaluateMiningProcurement(plan, estimate, deadline, h.clock.Now())
+	if !decision.Mine {
+		logger.Log("DEBUG", "Buying contract good rather than mining: "+decision.Reason, map[string]interface{}{
+			"action":       "mining_procurement_skipped",
+			"contract_id":  contractID,
+			"trade_symbol": plan.Good,
+		})
+		return false
+	}
+
+	// Accept before mining for the same reason the defer gate does: an
+	// accepted contract is what NegotiateContract resumes next pass.
+	if _, err := h.contractMarketService.EnsureAccepted(ctx, contract, cmd.PlayerID); err != nil {
+		logger.Log("WARNING", fmt.Sprintf("Contract %s could not be accepted before mining (%v) - buying instead", contractID, err), nil)
+		return false
+	}
+
+	attempted[contractID] = true
+	containerID, err := h.miningLauncher.LaunchContractMining(ctx, appContract.ContractMiningSpec{
+		ContractID:    contractID,
+		Good:          plan.Good,
+		Units:         plan.UnitsRemaining,
+		Asteroid:      estimate.Asteroid,
+		Destination:   destination,
+		MinerShips:    miners,
+		TransportShip: transport,
+		PlayerID:      playerID,
+		Operation:     dedicatedFleetContract,
+	})
+	if err != nil {
+		logger.Log("WARNING", fmt.Sprintf("Failed to launch contract mining for %s: %v - buying instead", contractID, err), nil)
+		return false
+	}
+
+	logger.Log("INFO", "Mining contract good instead of buying: "+decision.Reason, map[string]interface{}{
+		"action":          "mining_procurement_launched",
+		"contract_id":     contractID,
+		"container_id":    containerID,
+		"trade_symbol":    plan.Good,
+		"units":           plan.UnitsRemaining,
+		"asteroid":        estimate.Asteroid,
+		"miners":          miners,
+		"transport":       transport,
+		"market_cost":     decision.MarketCost,
+		"mining_cost":     decision.MiningCost,
+		"mining_duration": decision.MiningDuration.Round(time.Minute).String(),
+	})
+	return true
+}
+
+// idleMiners lists the unclaimed excavators in system
+func (h *RunFleetCoordinatorHandler) idleMiners(ctx context.Context, playerID shared.PlayerID, system string) ([]string, error) {
+	ships, err := h.shipRepo.FindIdleByPlayer(ctx, playerID)
+	if err != nil {
+		return nil, err
+	}
+	var miners []string
+	for _, ship := range ships {
+		if ship.Role() != miningHullRole || ship.IsReservedByCaptain() || ship.IsInTransit() {
+			continue
+		}
+		if loc := ship.CurrentLocation(); loc == nil || loc.SystemSymbol != system {
+			continue
+		}
+		miners = append(miners, ship.ShipSymbol())
+	}
+	return miners, nil
+}
+
+// miningTransport picks the pool hull that carries the mined units: the first
+// available one in the contract's home system with an empty hold.
+func (h *RunFleetCoordinatorHandler) miningTransport(ctx context.Context, playerID shared.PlayerID, availableShips []string, system string) string {
+	for _, symbol := range availableShips {
+		ship, err := h.shipRepo.FindBySymbol(ctx, symbol, playerID)
+		if err != nil || ship.IsInTransit() || !ship.IsCargoEmpty() {
+			continue
+		}
+		if loc := ship.CurrentLocation(); loc != nil && loc.SystemSymbol == system {
+			return symbol
+		}
+	}
+	return ""
+}
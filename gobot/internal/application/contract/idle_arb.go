@@ -148,6 +148,19 @@ type IdleArbConfig struct {
 	// this floor is the CROSS-trip decision: should this lane be flown AT ALL this
 	// pass, at current live prices.
 	FuelCostPerUnit int
+
+	// Anti-starvation policy (see idle_arb_starvation.go). A hull that has gone
+	// StarvationAfter without an assignment is STARVING: the per-trip floor above
+	// keeps passing up its small lanes while bigger ones never come, so the
+	// dispatcher drops it to the relaxed floor below rather than leave it idle.
+	// Every other guard still binds. 0 → the policy is off (the default: it
+	// lowers a money guard, so a captain opts in).
+	StarvationAfter time.Duration
+	// StarvationMinNetProfitPerUnit and StarvationNetProfitFraction are the
+	// relaxed floor's absolute and relative parts, combined exactly like the
+	// normal floor's. Neither can raise the floor above the normal one.
+	StarvationMinNetProfitPerUnit int
+	StarvationNetProfitFraction   float64
 }
 
 // Idle-arb defaults. HubRadius 250 is the loose outer hub-local filter;
@@ -183,6 +196,10 @@ const (
 	DefaultIdleArbMinNetProfit      = 100
 	DefaultIdleArbNetProfitFraction = 0.20
 	DefaultIdleArbFuelCostPerUnit   = 35
+	// Relaxed floor for starving hulls: still net-positive after fuel (25/u,
+	// 5% of the buy), so a starvation leg is small but never a loss at quote.
+	DefaultIdleArbStarvationMinNetProfit      = 25
+	DefaultIdleArbStarvationNetProfitFraction = 0.05
 )
 
 // DefaultIdleArbBlacklist is the initial excluded-goods list — ELECTRONICS
@@ -238,6 +255,15 @@ func (c IdleArbConfig) WithDefaults() IdleArbConfig {
 	if c.FuelCostPerUnit <= 0 {
 		c.FuelCostPerUnit = DefaultIdleArbFuelCostPerUnit
 	}
+	if c.StarvationAfter < 0 {
+		c.StarvationAfter = 0
+	}
+	if c.StarvationMinNetProfitPerUnit <= 0 {
+		c.StarvationMinNetProfitPerUnit = DefaultIdleArbStarvationMinNetProfit
+	}
+	if c.StarvationNetProfitFraction <= 0 {
+		c.StarvationNetProfitFraction = DefaultIdleArbStarvationNetProfitFraction
+	}
 	return c
 }
 
@@ -357,6 +383,7 @@ type IdleArbDispatcher struct {
 	launchStandby   []string                       // the launch standby set — the fallback when no live resolver is wired
 	standbyResolver func(context.Context) []string // resolves the LIVE standby set each pass (nil → launchStandby)
 	lanes           *laneMutex                     // one hull per (good, sink) per recovery window
+	events          shared.CoordinationSubscriber  // market.updated wake-ups between ticks (nil → tick only)
 
 	// The cross-engine absorption ledger. nil → integration inert (the same
 	// optional-port contract the other guards use). When wired, the dispatcher
@@ -386,6 +413,7 @@ type IdleArbDispatcher struct {
 	skipLaneHeld     int // legs skipped: best lane held by a live/recovering leg
 	skipUnprofitable int // legs skipped: live net_per_u below the profitability floor
 	rehomed          int // hulls re-homed post-leg (cumulative)
+	starvationLegs   int // legs launched under the relaxed starvation floor
 }
 
 // NewIdleArbDispatcher wires a dispatcher for the given dedicated fleet. A nil
@@ -465,6 +493,13 @@ func (d *IdleArbDispatcher) resolveStandby(ctx context.Context) []string {
 	return d.launchStandby
 }
 
+// SetEventSubscriber wires the coordination event bus so a market.updated
+// event for this player runs a pass early, instead of a fresh spread waiting
+// out the rest of the Interval. Nil (unset) keeps Run on its fixed tick.
+func (d *IdleArbDispatcher) SetEventSubscriber(subscriber shared.CoordinationSubscriber) {
+	d.events = subscriber
+}
+
 // SetAbsorptionLedger wires the cross-engine absorption ledger, the
 // optional-port idiom the other dispatcher dependencies use. A nil ledger leaves the
 // consult and the launch-record inert. consultDisabled is the
@@ -662,14 +697,19 @@ func (d *IdleArbDispatcher) netProfitFloor(hubAsk int) int {
 }
 
 // laneClearsProfitFloor reports whether the lane's live net_per_u meets
-// its binding floor — the per-trip go/no-go the dispatcher applies to an otherwise
-// eligible lane before launching. A lane that fails auto-re-enters the next pass
-// its price recovers (a profitability skip never latches the lane mutex).
-func (d *IdleArbDispatcher) laneClearsProfitFloor(hubAsk, sinkBid int) bool {
-	return d.laneNetPerUnit(hubAsk, sinkBid) >= d.netProfitFloor(hubAsk)
+// the hull's binding floor — the per-trip go/no-go the dispatcher applies to an
+// otherwise eligible lane before launching. A lane that fails auto-re-enters the
+// next pass its price recovers (a profitability skip never latches the lane mutex).
+func (d *IdleArbDispatcher) laneClearsProfitFloor(hull *navigation.Ship, hubAsk, sinkBid int) bool {
+	return d.laneNetPerUnit(hubAsk, sinkBid) >= d.profitFloorFor(hull, hubAsk)
 }
 
-// Run ticks DispatchOnce every Interval until ctx is cancelled. Started as a
+// idleArbMinWakeGap is the least time between a pass and an early one woken by
+// a market.updated event.
+const idleArbMinWakeGap = 30 * time.Second
+
+// Run ticks DispatchOnce every Interval until ctx is cancelled, and earlier on
+// a market update when an event subscriber is wired. Started as a
 // goroutine by the fleet coordinator's Handle; the coordinator's own context
 // bounds its life, so a stopped coordinator stops the harvest with it.
 func (d *IdleArbDispatcher) Run(ctx context.Context) {
@@ -679,12 +719,33 @@ func (d *IdleArbDispatcher) Run(ctx context.Context) {
 		d.fleet, d.cfg.ReserveHulls, d.cfg.HubRadius, d.cfg.LeashRadius, d.cfg.MaxLegDuration, d.cfg.MaxSpendPerLeg, d.cfg.MinMarginPerUnit, d.cfg.Interval,
 	), nil)
 
+	// A nil channel never fires, so without a subscriber this is the plain tick.
+	var marketUpdates <-chan shared.CoordinationEvent
+	if d.events != nil {
+		updates, unsubscribe := d.events.Subscribe(shared.TopicMarketUpdated, d.playerID.Value())
+		defer unsubscribe()
+		marketUpdates = updates
+	}
+
+	lastPass := d.clock.Now()
 	for {
 		select {
 		case <-ctx.Done():
 			return
 		case <-time.After(d.cfg.Interval):
+		case _, ok := <-marketUpdates:
+			if !ok {
+				marketUpdates = nil
+				continue
+			}
+			// Scouts save markets every few seconds; an early pass at most
+			// once per idleArbMinWakeGap keeps the wake-ups from turning into
+			// a busy loop over the fleet and market tables.
+			if d.clock.Now().Sub(lastPass) < idleArbMinWakeGap {
+				continue
+			}
 		}
+		lastPass = d.clock.Now()
 		d.DispatchOnce(ctx)
 	}
 }
@@ -802,6 +863,8 @@ func (d *IdleArbDispatcher) DispatchOnce(ctx context.Context) int {
 		// re-reads live prices and fails closed, so a leg whose live margin
 		// has collapsed below that fraction of its quote aborts pre-buy (zero
 		// spend) instead of buying on a razor cushion.
+		// Judged before the launch: the leg's claim is what ends the starvation.
+		starving := d.isStarving(hull)
 		spec := IdleArbSpec{
 			ShipSymbol: hull.ShipSymbol(),
 			Good:       lane.Good,
@@ -827,6 +890,9 @@ func (d *IdleArbDispatcher) DispatchOnce(ctx context.Context) int {
 		}
 		launched++
 		d.launched++
+		if starving {
+			d.starvationLegs++
+		}
 		// LANE MUTEX: mark this (good, sink) held the instant the leg
 		// launches, so a later candidate THIS pass that would pick the same sink is
 		// skipped:lane-held (within-pass dedupe), and the next pass holds it until
@@ -850,6 +916,7 @@ func (d *IdleArbDispatcher) DispatchOnce(ctx context.Context) int {
 			"margin":       lane.MarginPerUnit,
 			"distance":     lane.Distance,
 			"container_id": containerID,
+			"starving":     starving,
 		})
 	}
 }
@@ -1069,7 +1136,7 @@ func (d *IdleArbDispatcher) pickHubLocalLane(ctx context.Context, hull *navigati
 			// the lane, so a below-floor lane is attributed to this gate (not
 			// masked by a policy/leash skip), and it auto-re-enters the pass
 			// its price recovers.
-			if reason == skipNone && !d.laneClearsProfitFloor(ask, bid) {
+			if reason == skipNone && !d.laneClearsProfitFloor(hull, ask, bid) {
 				reason = skipReasonUnprofitable
 			}
 
@@ -1145,7 +1212,7 @@ func (d *IdleArbDispatcher) logCandidate(ctx context.Context, hull *navigation.S
 		lane.Distance, d.cfg.LeashRadius, d.cfg.HubRadius,
 		legSeconds, d.cfg.MaxLegDuration,
 		lane.MarginPerUnit, lane.DestBid, lane.SourceAsk,
-		d.laneNetPerUnit(lane.SourceAsk, lane.DestBid), d.cfg.FuelCostPerUnit, d.netProfitFloor(lane.SourceAsk),
+		d.laneNetPerUnit(lane.SourceAsk, lane.DestBid), d.cfg.FuelCostPerUnit, d.profitFloorFor(hull, lane.SourceAsk),
 		now.Sub(buyMarket.LastUpdated()).Round(time.Second), now.Sub(sellMarket.LastUpdated()).Round(time.Second),
 		verdict,
 	), nil)
@@ -1234,8 +1301,8 @@ func (d *IdleArbDispatcher) logHarvestSummary(ctx context.Context, launchedThisP
 	}
 	logger.Log("INFO", fmt.Sprintf(
 		"Idle-arb harvest: %d leg(s) launched this pass; %d hull(s) re-homed this pass; %d attempt(s) total at %.1f/hr; "+
-			"skipped legs - blacklist %d, contract-good %d, leash %d, lane-held %d, reserved %d, unprofitable %d; re-homed %d total (cumulative; margin-aborts logged per-leg by the arb run)",
+			"skipped legs - blacklist %d, contract-good %d, leash %d, lane-held %d, reserved %d, unprofitable %d; re-homed %d total; starvation legs %d total (cumulative; margin-aborts logged per-leg by the arb run)",
 		launchedThisPass, rehomedThisPass, d.attempts, rate,
-		d.skipBlacklist, d.skipContractGood, d.skipLeash, d.skipLaneHeld, d.skipReserved, d.skipUnprofitable, d.rehomed,
+		d.skipBlacklist, d.skipContractGood, d.skipLeash, d.skipLaneHeld, d.skipReserved, d.skipUnprofitable, d.rehomed, d.starvationLegs,
 	), nil)
 }
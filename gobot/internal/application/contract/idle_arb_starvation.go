@@ -0,0 +1,57 @@
+package contract
+
+import (
+	"math"
+	"time"
+
+	"github.com/andrescamacho/spacetraders-go/internal/domain/navigation"
+)
+
+// ANTI-STARVATION. The per-trip profitability floor is sized for the lanes
+// worth a hull's time when the fleet is busy; on a quiet hub it turns away
+// every small, certain profit while the big lanes it waits for never appear,
+// and the hull sits idle for hours. With StarvationAfter set, a hull that has
+// gone that long without an assignment flies the best local lane that clears
+// the RELAXED floor instead. Only the profitability floor moves: the reserve,
+// blacklist, contract-good exclusion, leash, lane mutex, absorption consult
+// and the arb run's live-verify and spend guards all bind exactly as before.
+// The relaxation ends with the starvation itself — the leg's claim resets the
+// hull's idle clock, so its next lane is judged on the normal floor until it
+// starves again.
+
+// idleFor is how long the hull has gone without an assignment: since its last
+// release, or since this dispatcher started when it has none on record (a
+// hull never claimed, or released before the restart that lost the time).
+func (d *IdleArbDispatcher) idleFor(hull *navigation.Ship) time.Duration {
+	since := d.startTime
+	if assignment := hull.Assignment(); assignment != nil && assignment.ReleasedAt() != nil && assignment.ReleasedAt().After(since) {
+		since = *assignment.ReleasedAt()
+	}
+	return d.clock.Now().Sub(since)
+}
+
+// isStarving reports whether the anti-starvation policy covers the hull.
+func (d *IdleArbDispatcher) isStarving(hull *navigation.Ship) bool {
+	return d.cfg.StarvationAfter > 0 && d.idleFor(hull) >= d.cfg.StarvationAfter
+}
+
+// starvationFloor is the relaxed counterpart of netProfitFloor, capped at
+// the normal floor so a misconfigured policy can only ever loosen the gate.
+func (d *IdleArbDispatcher) starvationFloor(hubAsk int) int {
+	floor := d.cfg.StarvationMinNetProfitPerUnit
+	if relative := int(math.Ceil(d.cfg.StarvationNetProfitFraction * float64(hubAsk))); relative > floor {
+		floor = relative
+	}
+	if normal := d.netProfitFloor(hubAsk); normal < floor {
+		return normal
+	}
+	return floor
+}
+
+// profitFloorFor is the per-unit net floor that binds for this hull.
+func (d *IdleArbDispatcher) profitFloorFor(hull *navigation.Ship, hubAsk int) int {
+	if d.isStarving(hull) {
+		return d.starvationFloor(hubAsk)
+	}
+	return d.netProfitFloor(hubAsk)
+}
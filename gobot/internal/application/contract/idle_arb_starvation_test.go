@@ -0,0 +1,92 @@
+package contract
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/andrescamacho/spacetraders-go/internal/domain/navigation"
+	"github.com/andrescamacho/spacetraders-go/internal/domain/shared"
+)
+
+// starvationFixture is a profitFixture on a mock clock, so a test can age the
+// hulls' idle time past StarvationAfter.
+func starvationFixture(t *testing.T, cfg IdleArbConfig, hubAsk, sinkBid int) (*profitFixture, *shared.MockClock) {
+	t.Helper()
+	f := newProfitFixture(t, 2, cfg, "POLYNUCLEOTIDES", hubAsk, sinkBid)
+	clock := &shared.MockClock{CurrentTime: time.Date(2024, 5, 1, 8, 0, 0, 0, time.UTC)}
+	f.d.clock = clock
+	f.d.startTime = clock.Now()
+	return f, clock
+}
+
+func starvationCfg() IdleArbConfig {
+	return IdleArbConfig{ReserveHulls: 1, StarvationAfter: 30 * time.Minute}
+}
+
+// A small, certain lane the normal floor refuses (net 45/u < 100) is flown once
+// the hull has starved, and only then.
+func TestIdleArbStarvation_StarvingHullFliesBelowNormalFloor(t *testing.T) {
+	// ask 420, bid 500 -> net 80-35 = 45/u: below the normal 100/u floor, above
+	// the relaxed max(25, 5% of 420 = 21) = 25/u.
+	f, clock := starvationFixture(t, starvationCfg(), 420, 500)
+
+	if launched := f.d.DispatchOnce(context.Background()); launched != 0 {
+		t.Fatalf("a freshly idle hull must still be held to the normal floor, got %d launches", launched)
+	}
+
+	clock.Advance(31 * time.Minute)
+	if launched := f.d.DispatchOnce(context.Background()); launched != 1 {
+		t.Fatalf("a hull idle past StarvationAfter must fly the small lane, got %d launches", launched)
+	}
+	if f.d.starvationLegs != 1 {
+		t.Fatalf("the leg must be counted as a starvation leg, got %d", f.d.starvationLegs)
+	}
+}
+
+// Idle time runs from the hull's last release, not from when the dispatcher
+// started: a hull that just finished a job is not starving.
+func TestIdleArbStarvation_RecentReleaseIsNotStarving(t *testing.T) {
+	f, clock := starvationFixture(t, starvationCfg(), 420, 500)
+	clock.Advance(2 * time.Hour)
+	released := clock.Now().Add(-5 * time.Minute)
+	for _, ship := range f.repo.ships {
+		ship.SetAssignment(navigation.NewActiveAssignment("contract-worker", released.Add(-time.Hour)).Released("completed", released))
+	}
+
+	if launched := f.d.DispatchOnce(context.Background()); launched != 0 {
+		t.Fatalf("a hull released 5 minutes ago must be held to the normal floor, got %d launches", launched)
+	}
+}
+
+func TestIdleArbStarvation_OffByDefault(t *testing.T) {
+	f, clock := starvationFixture(t, IdleArbConfig{ReserveHulls: 1}, 420, 500)
+	clock.Advance(24 * time.Hour)
+
+	if launched := f.d.DispatchOnce(context.Background()); launched != 0 {
+		t.Fatalf("with no StarvationAfter the normal floor must bind however long a hull idles, got %d launches", launched)
+	}
+}
+
+// The relaxed floor is still a floor: a lane netting less than it stays refused.
+func TestIdleArbStarvation_RelaxedFloorStillBinds(t *testing.T) {
+	// ask 420, bid 465 -> net 45-35 = 10/u, below the relaxed 25/u.
+	f, clock := starvationFixture(t, starvationCfg(), 420, 465)
+	clock.Advance(time.Hour)
+
+	if launched := f.d.DispatchOnce(context.Background()); launched != 0 {
+		t.Fatalf("a lane below the relaxed floor must not fly, got %d launches", launched)
+	}
+	if f.d.skipUnprofitable == 0 {
+		t.Fatalf("the refusal must be attributed to the profitability gate")
+	}
+}
+
+// A relaxed floor configured above the normal one never tightens the gate.
+func TestIdleArbStarvation_FloorNeverExceedsNormal(t *testing.T) {
+	f, _ := starvationFixture(t, IdleArbConfig{ReserveHulls: 1, StarvationAfter: time.Minute, StarvationMinNetProfitPerUnit: 500}, 420, 500)
+
+	if got, normal := f.d.starvationFloor(420), f.d.netProfitFloor(420); got != normal {
+		t.Fatalf("starvation floor %d must be capped at the normal floor %d", got, normal)
+	}
+}
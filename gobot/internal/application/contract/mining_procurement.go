@@ -0,0 +1,126 @@
+package contract
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// Procurement-by-mining.
+//
+// Some contract goods are raw ores an excavator can pull out of a home-system
+// asteroid for little more than fuel. When the market ask for such a good is
+// steep, the fleet coordinator compares the purchase cost of the sourcing plan
+// against an estimate of what mining the same units would cost and how long it
+// would take, and when mining wins it launches a temporary mining + transport
+// sub-operation scoped to the contract instead of a purchasing worker. The
+// sub-operation extracts at the asteroid, hands the good to a transport hull,
+// delivers it to the contract destination, and ends once the delivery is met.
+//
+// Mining only ever replaces a purchase it beats; every failure to estimate,
+// find miners or launch falls back to the market path, so the contract is never
+// parked on mining it cannot do (RULINGS #1).
+const (
+	// MiningAdvantagePct is how much cheaper mining must project than buying,
+	// as a percent of the purchase cost, before the coordinator gives up a
+	// known market price for an estimate.
+	MiningAdvantagePct = 25
+
+	// MiningDeadlineSlack is the runway that must remain past the projected
+	// mining duration before the contract deadline. Mining yields are random;
+	// the slack leaves room to fall back to buying if extraction runs slow.
+	MiningDeadlineSlack = 12 * time.Hour
+
+	// MiningProcurementRecheckInterval is how long the coordinator waits
+	// between checks on a running mining sub-operation.
+	MiningProcurementRecheckInterval = 2 * time.Minute
+)
+
+// MiningEstimate is the projected cost and duration of mining a contract's
+// units at one asteroid with the miners on hand.
+type MiningEstimate struct {
+	Asteroid string        // waypoint symbol of the asteroid to mine
+	UnitCost int           // operating cost per delivered unit (fuel and wear)
+	Duration time.Duration // projected time to extract and deliver every unit
+}
+
+// TotalCost is the projected cost of mining units.
+func (e *MiningEstimate) TotalCost(units int) int {
+	return e.UnitCost * units
+}
+
+// MiningCostEstimator projects what mining units of a contract good for
+// delivery at destination would cost, mining only in the destination's system
+// (RULINGS #14). Fail-open like InventorySourceFinder: nil means "cannot mine
+// this here" for any reason — no asteroid yields the good, no miners, or a
+// read error — and the caller keeps its market plan.
+type MiningCostEstimator interface {
+	EstimateMining(ctx context.Context, playerID int, destination, good string, units, miners int) *MiningEstimate
+}
+
+// ProcurementDecision is the outcome of comparing a market plan with mining.
+type ProcurementDecision struct {
+	Mine           bool
+	MarketCost     int
+	MiningCost     int
+	MiningDuration time.Duration
+	Reason         string
+}
+
+// EvaluateMiningProcurement decides whether to mine a plan's units rather than
+// buy them. Mining wins only for a market plan, when the estimate beats the
+// purchase cost by MiningAdvantagePct and finishes MiningDeadlineSlack before
+// the contract deadline. Inventory plans are already free and never mined.
+func EvaluateMiningProcurement(plan *SourcingPlan, estimate *MiningEstimate, deadline, now time.Time) ProcurementDecision {
+	if plan == nil || plan.Source == SourceInventory {
+		return ProcurementDecision{Reason: "inventory covers the delivery"}
+	}
+	decision := ProcurementDecision{MarketCost: plan.GoodsCost}
+	if estimate == nil {
+		decision.Reason = "no mining estimate"
+		return decision
+	}
+
+	decision.MiningCost = estimate.TotalCost(plan.UnitsRemaining)
+	decision.MiningDuration = estimate.Duration
+
+	if decision.MiningCost*100 > plan.GoodsCost*(100-MiningAdvantagePct) {
+		decision.Reason = fmt.Sprintf("mining (%d) does not beat buying (%d) by %d%%",
+			decision.MiningCost, plan.GoodsCost, MiningAdvantagePct)
+		return decision
+	}
+	if now.Add(estimate.Duration + MiningDeadlineSlack).After(deadline) {
+		decision.Reason = fmt.Sprintf("mining takes %s, too close to the deadline", estimate.Duration.Round(time.Minute))
+		return decision
+	}
+
+	decision.Mine = true
+	decision.Reason = fmt.Sprintf("mining (%d) beats buying (%d)", decision.MiningCost, plan.GoodsCost)
+	return decision
+}
+
+// ContractMiningSpec describes one mining + transport sub-operation scoped to
+// a contract delivery.
+type ContractMiningSpec struct {
+	ContractID    string
+	Good          string
+	Units         int
+	Asteroid      string
+	Destination   string // contract delivery waypoint
+	MinerShips    []string
+	TransportShip string
+	PlayerID      int
+	Operation     string // claim identity, e.g. "contract"
+}
+
+// ContractMiningLauncher starts and tracks contract mining sub-operations.
+// Implemented by the daemon server, which claims the hulls before returning,
+// like IdleArbLauncher.
+type ContractMiningLauncher interface {
+	LaunchContractMining(ctx context.Context, spec ContractMiningSpec) (containerID string, err error)
+
+	// ActiveContractMining returns the container mining for contractID, or ""
+	// when none is running. Read from the container store, so a coordinator
+	// restarted mid-operation finds the sub-operation it launched.
+	ActiveContractMining(ctx context.Context, playerID int, contractID string) (containerID string, err error)
+}
@@ -0,0 +1,59 @@
+package contract
+
+import (
+	"testing"
+	"time"
+)
+
+func TestEvaluateMiningProcurement(t *testing.T) {
+	now := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	deadline := now.Add(48 * time.Hour)
+	plan := &SourcingPlan{Good: "IRON_ORE", UnitAsk: 100, UnitsRemaining: 60, GoodsCost: 6000}
+
+	cases := []struct {
+		name     string
+		plan     *SourcingPlan
+		estimate *MiningEstimate
+		wantMine bool
+	}{
+		{
+			name:     "cheap and quick mining wins",
+			plan:     plan,
+			estimate: &MiningEstimate{Asteroid: "X1-A-B7", UnitCost: 10, Duration: 2 * time.Hour},
+			wantMine: true,
+		},
+		{
+			name:     "no estimate keeps the market plan",
+			plan:     plan,
+			estimate: nil,
+		},
+		{
+			// 60 × 80 = 4800 is cheaper than 6000, but not by 25%.
+			name:     "a thin advantage is not worth the uncertainty",
+			plan:     plan,
+			estimate: &MiningEstimate{Asteroid: "X1-A-B7", UnitCost: 80, Duration: time.Hour},
+		},
+		{
+			name:     "mining that eats into the deadline slack loses",
+			plan:     plan,
+			estimate: &MiningEstimate{Asteroid: "X1-A-B7", UnitCost: 10, Duration: 40 * time.Hour},
+		},
+		{
+			name:     "inventory is never mined",
+			plan:     &SourcingPlan{Good: "IRON_ORE", UnitsRemaining: 60, Source: SourceInventory},
+			estimate: &MiningEstimate{Asteroid: "X1-A-B7", UnitCost: 1, Duration: time.Minute},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			decision := EvaluateMiningProcurement(tc.plan, tc.estimate, deadline, now)
+			if decision.Mine != tc.wantMine {
+				t.Fatalf("Mine = %v, want %v (%s)", decision.Mine, tc.wantMine, decision.Reason)
+			}
+			if decision.Reason == "" {
+				t.Fatal("every decision must say why")
+			}
+		})
+	}
+}
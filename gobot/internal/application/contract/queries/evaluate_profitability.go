@@ -3,6 +3,8 @@ package queries
 import (
 	"context"
 	"fmt"
+	"sort"
+	"time"
 
 	"github.com/andrescamacho/spacetraders-go/internal/application/common"
 	appContract "github.com/andrescamacho/spacetraders-go/internal/application/contract"
@@ -18,6 +20,30 @@ type EvaluateContractProfitabilityQuery struct {
 	ShipSymbol      string
 	PlayerID        shared.PlayerID
 	FuelCostPerTrip int // Fuel cost per round trip (for delivery and return)
+
+	// SimulatePriceDrift additionally prices the delivery window against the
+	// source market's ask history, producing an optimistic/pessimistic range.
+	// It is a no-op when no price history reader is wired.
+	SimulatePriceDrift bool
+	// HistoryWindow is how far back the drift estimate reads; zero falls back
+	// to defaultDriftHistoryWindow.
+	HistoryWindow time.Duration
+}
+
+// defaultDriftHistoryWindow is the trailing ask history the drift simulation
+// reads when the query does not set one. 24h spans several supply refills, so
+// one quiet afternoon does not read as a flat market.
+const defaultDriftHistoryWindow = 24 * time.Hour
+
+// driftHistoryLimit caps the history rows read per good.
+const driftHistoryLimit = 200
+
+// ContractPriceHistoryReader supplies the ask series the drift simulation is
+// estimated from. Narrow by design — the simulation needs one good's history at
+// one waypoint over a window. A nil reader disables the simulation; the daemon
+// wires the DB-backed price history repository via SetPriceHistoryReader.
+type ContractPriceHistoryReader interface {
+	GetPriceHistory(ctx context.Context, waypointSymbol, goodSymbol string, since time.Time, limit int) ([]*market.MarketPriceHistory, error)
 }
 
 // ProfitabilityResult contains the profitability evaluation results
@@ -25,6 +51,7 @@ type ProfitabilityResult struct {
 	IsProfitable           bool
 	NetProfit              int
 	PurchaseCost           int
+	FeeCost                int // estimated market fees on the purchases, already in NetProfit
 	TripsRequired          int
 	CheapestMarketWaypoint string
 	Reason                 string
@@ -34,6 +61,14 @@ type ProfitabilityResult struct {
 	// executor's ladder cap (sp-1z2h) compares each purchase trip's realized
 	// per-unit price against this basis to stop an intra-run ask ladder.
 	MarketPrices map[string]int
+
+	// Simulated reports whether the drift simulation ran. When it did,
+	// OptimisticNetProfit/PessimisticNetProfit bound the net profit over the
+	// delivery window, and IsProfitable/Reason reflect the pessimistic case
+	// whenever the contract is profitable only at instantaneous prices.
+	Simulated            bool
+	OptimisticNetProfit  int
+	PessimisticNetProfit int
 }
 
 // EvaluateContractProfitabilityHandler evaluates contract profitability
@@ -42,8 +77,9 @@ type ProfitabilityResult struct {
 // 2. Builds ProfitabilityContext
 // 3. Delegates calculation to Contract.EvaluateProfitability()
 type EvaluateContractProfitabilityHandler struct {
-	shipRepo   navigation.ShipRepository
-	marketRepo market.MarketRepository
+	shipRepo     navigation.ShipRepository
+	marketRepo   market.MarketRepository
+	priceHistory ContractPriceHistoryReader
 }
 
 // NewEvaluateContractProfitabilityHandler creates a new handler
@@ -57,6 +93,12 @@ func NewEvaluateContractProfitabilityHandler(
 	}
 }
 
+// SetPriceHistoryReader wires the ask history the drift simulation reads.
+// Leaving it unset keeps every evaluation instantaneous.
+func (h *EvaluateContractProfitabilityHandler) SetPriceHistoryReader(reader ContractPriceHistoryReader) {
+	h.priceHistory = reader
+}
+
 // Handle executes the profitability evaluation query
 func (h *EvaluateContractProfitabilityHandler) Handle(ctx context.Context, request common.Request) (common.Response, error) {
 	query, ok := request.(*EvaluateContractProfitabilityQuery)
@@ -69,12 +111,16 @@ func (h *EvaluateContractProfitabilityHandler) Handle(ctx context.Context, reque
 		return nil, err
 	}
 
-	marketPrices, cheapestMarketWaypoint, err := h.buildMarketPricesMap(ctx, query)
+	marketPrices, sourceMarkets, cheapestMarketWaypoint, err := h.buildMarketPricesMap(ctx, query)
 	if err != nil {
 		return nil, err
 	}
 
-	profitabilityCtx := h.buildProfitabilityContext(ship, marketPrices, cheapestMarketWaypoint, query.FuelCostPerTrip)
+	profitabilityCtx := h.buildProfitabilityContext(ship, marketPrices, sourceMarkets, cheapestMarketWaypoint, query.FuelCostPerTrip)
+
+	if query.SimulatePriceDrift && h.priceHistory != nil {
+		return h.simulate(ctx, query, profitabilityCtx, sourceMarkets)
+	}
 
 	evaluation, err := h.delegateCalculationToDomain(query.Contract, profitabilityCtx)
 	if err != nil {
@@ -86,6 +132,78 @@ func (h *EvaluateContractProfitabilityHandler) Handle(ctx context.Context, reque
 	return result, nil
 }
 
+// simulate runs the drift simulation and folds its range into the result. A
+// contract that clears the threshold only at instantaneous prices is reported
+// unprofitable with the pessimistic figures, so callers see the real exposure.
+func (h *EvaluateContractProfitabilityHandler) simulate(
+	ctx context.Context,
+	query *EvaluateContractProfitabilityQuery,
+	profitabilityCtx domainContract.ProfitabilityContext,
+	sourceMarkets map[string]string,
+) (*ProfitabilityResult, error) {
+	drift, err := h.estimateDrift(ctx, query, sourceMarkets)
+	if err != nil {
+		return nil, err
+	}
+
+	simulation, err := query.Contract.SimulateProfitability(domainContract.ProfitabilitySimulationContext{
+		ProfitabilityContext: profitabilityCtx,
+		Drift:                drift,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("profitability simulation failed: %w", err)
+	}
+
+	result := h.convertToApplicationDTO(simulation.Instantaneous)
+	result.MarketPrices = profitabilityCtx.MarketPrices
+	result.Simulated = true
+	result.OptimisticNetProfit = simulation.Optimistic.NetProfit
+	result.PessimisticNetProfit = simulation.Pessimistic.NetProfit
+
+	if simulation.ProfitableOnlyAtInstantaneousPrices() {
+		result.IsProfitable = false
+		result.Reason = fmt.Sprintf("Profitable only at instantaneous prices (pessimistic net %d after supply drift)", simulation.Pessimistic.NetProfit)
+	}
+
+	return result, nil
+}
+
+// estimateDrift reads each good's ask history at its source market and
+// derives per-trip drift bounds. A good with no history is left out of the
+// map, which the domain simulates at a flat price.
+func (h *EvaluateContractProfitabilityHandler) estimateDrift(
+	ctx context.Context,
+	query *EvaluateContractProfitabilityQuery,
+	sourceMarkets map[string]string,
+) (map[string]domainContract.PriceDrift, error) {
+	window := query.HistoryWindow
+	if window <= 0 {
+		window = defaultDriftHistoryWindow
+	}
+	since := time.Now().Add(-window)
+
+	drift := make(map[string]domainContract.PriceDrift, len(sourceMarkets))
+	for good, waypoint := range sourceMarkets {
+		history, err := h.priceHistory.GetPriceHistory(ctx, waypoint, good, since, driftHistoryLimit)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read price history for %s at %s: %w", good, waypoint, err)
+		}
+		if len(history) < 2 {
+			continue
+		}
+
+		sort.Slice(history, func(i, j int) bool {
+			return history[i].RecordedAt().Before(history[j].RecordedAt())
+		})
+		asks := make([]int, len(history))
+		for i, entry := range history {
+			asks[i] = entry.SellPrice()
+		}
+		drift[good] = domainContract.EstimatePriceDrift(asks)
+	}
+	return drift, nil
+}
+
 func (h *EvaluateContractProfitabilityHandler) fetchShip(ctx context.Context, shipSymbol string, playerID shared.PlayerID) (*navigation.Ship, error) {
 	ship, err := h.shipRepo.FindBySymbol(ctx, shipSymbol, playerID)
 	if err != nil {
@@ -103,8 +221,12 @@ func (h *EvaluateContractProfitabilityHandler) fetchShip(ctx context.Context, sh
 // only (RULINGS #14), matching the executor's zero-jump navigation (sp-9hu8):
 // pricing at a cross-system market the worker cannot fly would both mis-project
 // profit and point the hull at an unreachable waypoint.
-func (h *EvaluateContractProfitabilityHandler) buildMarketPricesMap(ctx context.Context, query *EvaluateContractProfitabilityQuery) (map[string]int, string, error) {
+//
+// It also returns each good's source market so the drift simulation reads the
+// history of the market the executor will actually buy from.
+func (h *EvaluateContractProfitabilityHandler) buildMarketPricesMap(ctx context.Context, query *EvaluateContractProfitabilityQuery) (map[string]int, map[string]string, string, error) {
 	marketPrices := make(map[string]int)
+	sourceMarkets := make(map[string]string)
 	var cheapestMarketWaypoint string
 
 	for _, delivery := range query.Contract.Terms().Deliveries {
@@ -115,22 +237,24 @@ func (h *EvaluateContractProfitabilityHandler) buildMarketPricesMap(ctx context.
 
 		plan, err := appContract.PlanDeliverySourcing(ctx, delivery, h.marketRepo, query.PlayerID.Value())
 		if err != nil {
-			return nil, "", err
+			return nil, nil, "", err
 		}
 
 		marketPrices[delivery.TradeSymbol] = plan.UnitAsk
+		sourceMarkets[delivery.TradeSymbol] = plan.Market
 
 		if cheapestMarketWaypoint == "" {
 			cheapestMarketWaypoint = plan.Market
 		}
 	}
 
-	return marketPrices, cheapestMarketWaypoint, nil
+	return marketPrices, sourceMarkets, cheapestMarketWaypoint, nil
 }
 
-func (h *EvaluateContractProfitabilityHandler) buildProfitabilityContext(ship *navigation.Ship, marketPrices map[string]int, cheapestMarketWaypoint string, fuelCostPerTrip int) domainContract.ProfitabilityContext {
+func (h *EvaluateContractProfitabilityHandler) buildProfitabilityContext(ship *navigation.Ship, marketPrices map[string]int, sourceMarkets map[string]string, cheapestMarketWaypoint string, fuelCostPerTrip int) domainContract.ProfitabilityContext {
 	return domainContract.ProfitabilityContext{
 		MarketPrices:           marketPrices,
+		SourceMarkets:          sourceMarkets,
 		CargoCapacity:          ship.Cargo().Capacity,
 		FuelCostPerTrip:        fuelCostPerTrip,
 		CheapestMarketWaypoint: cheapestMarketWaypoint,
@@ -150,6 +274,7 @@ func (h *EvaluateContractProfitabilityHandler) convertToApplicationDTO(evaluatio
 		IsProfitable:           evaluation.IsProfitable,
 		NetProfit:              evaluation.NetProfit,
 		PurchaseCost:           evaluation.PurchaseCost,
+		FeeCost:                evaluation.FeeCost,
 		TripsRequired:          evaluation.TripsRequired,
 		CheapestMarketWaypoint: evaluation.CheapestMarketWaypoint,
 		Reason:                 evaluation.Reason,
@@ -0,0 +1,51 @@
+package queries
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/andrescamacho/spacetraders-go/internal/application/common"
+	domainContract "github.com/andrescamacho/spacetraders-go/internal/domain/contract"
+	"github.com/andrescamacho/spacetraders-go/internal/domain/shared"
+)
+
+// GetContractWorkflowAuditQuery asks for every recorded workflow step of one
+// contract: negotiate, accept, each purchase, navigation and delivery, and
+// fulfill, with ship, timestamps, credits and outcome.
+type GetContractWorkflowAuditQuery struct {
+	PlayerID   shared.PlayerID
+	ContractID string
+}
+
+// GetContractWorkflowAuditResponse holds the contract's steps in the order they
+// were recorded.
+type GetContractWorkflowAuditResponse struct {
+	Steps []domainContract.WorkflowStepRecord
+}
+
+// GetContractWorkflowAuditHandler handles the GetContractWorkflowAudit query.
+type GetContractWorkflowAuditHandler struct {
+	repo domainContract.WorkflowAuditRepository
+}
+
+// NewGetContractWorkflowAuditHandler creates a new GetContractWorkflowAuditHandler.
+func NewGetContractWorkflowAuditHandler(repo domainContract.WorkflowAuditRepository) *GetContractWorkflowAuditHandler {
+	return &GetContractWorkflowAuditHandler{repo: repo}
+}
+
+// Handle executes the GetContractWorkflowAudit query.
+func (h *GetContractWorkflowAuditHandler) Handle(ctx context.Context, request common.Request) (common.Response, error) {
+	query, ok := request.(*GetContractWorkflowAuditQuery)
+	if !ok {
+		return nil, fmt.Errorf("invalid request type: expected *GetContractWorkflowAuditQuery")
+	}
+	if query.ContractID == "" {
+		return nil, fmt.Errorf("contract ID is required")
+	}
+
+	steps, err := h.repo.FindByContract(ctx, query.PlayerID.Value(), query.ContractID)
+	if err != nil {
+		return nil, err
+	}
+	return &GetContractWorkflowAuditResponse{Steps: steps}, nil
+}
@@ -0,0 +1,407 @@
+package services
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/andrescamacho/spacetraders-go/internal/application/common"
+	appContract "github.com/andrescamacho/spacetraders-go/internal/application/contract"
+	shipCargo "github.com/andrescamacho/spacetraders-go/internal/application/ship/commands/cargo"
+	domainContract "github.com/andrescamacho/spacetraders-go/internal/domain/contract"
+	"github.com/andrescamacho/spacetraders-go/internal/domain/market"
+	"github.com/andrescamacho/spacetraders-go/internal/domain/navigation"
+	"github.com/andrescamacho/spacetraders-go/internal/domain/routing"
+	"github.com/andrescamacho/spacetraders-go/internal/domain/shared"
+	"github.com/andrescamacho/spacetraders-go/internal/domain/system"
+)
+
+// BatchSourcePlanner picks the market a batched delivery is bought at. The
+// production planner is the same home-system cheapest-market selection the
+// single-contract path and the coordinator's defer gate use (PlanDeliverySourcing).
+type BatchSourcePlanner interface {
+	PlanDeliverySourcing(ctx context.Context, delivery domainContract.Delivery, playerID int) (*appContract.SourcingPlan, error)
+}
+
+// BatchStopOrderer orders one leg of a batched trip (its purchase markets, then
+// its destinations) for the hull starting at ship's location. It must return
+// every stop it was given; ordering is an optimisation, never a filter.
+type BatchStopOrderer interface {
+	OrderStops(ctx context.Context, ship *navigation.Ship, playerID shared.PlayerID, stops []string) []string
+}
+
+// WithDeliveryBatching enables multi-contract batching: ProcessBatchedDeliveries
+// sources through planner and orders each trip's stops through orderer. Both are
+// required; a nil collaborator leaves batching off.
+func WithDeliveryBatching(planner BatchSourcePlanner, orderer BatchStopOrderer) DeliveryExecutorOption {
+	return func(e *DeliveryExecutor) {
+		e.batchPlanner = planner
+		e.batchOrderer = orderer
+	}
+}
+
+// BatchingEnabled reports whether the executor was wired for multi-contract batching.
+func (e *DeliveryExecutor) BatchingEnabled() bool {
+	return e.batchPlanner != nil && e.batchOrderer != nil
+}
+
+// ProcessBatchedDeliveries services several accepted contracts with one hauler: it
+// packs their outstanding in-system deliveries into shared holds
+// (domainContract.PlanBatchTrips) and flies each hold as one trip — every purchase
+// market in routed order, then every destination in routed order — instead of one
+// source→deliver round trip per contract.
+//
+// It is an optimisation in front of the per-contract path, never a replacement:
+// deliveries it cannot source now, or in another system than the hull, are left
+// untouched, and a ladder-cap breach stops further buying of that good. Whatever it
+// leaves owed is picked up by ProcessAllDeliveries or the coordinator's next pass.
+// Cargo already aboard is left alone for that path to deliver. Returns the contracts
+// in input order, updated from each deliver response.
+func (e *DeliveryExecutor) ProcessBatchedDeliveries(
+	ctx context.Context,
+	shipSymbol string,
+	playerID shared.PlayerID,
+	contracts []*domainContract.Contract,
+	result *RunWorkflowResponse,
+	containerID string,
+) ([]*domainContract.Contract, error) {
+	if !e.BatchingEnabled() || len(contracts) < 2 {
+		return contracts, nil
+	}
+	logger := common.LoggerFromContext(ctx)
+
+	if containerID != "" {
+		ctx = shared.WithOperationContext(ctx, shared.NewOperationContext(containerID, "contract_workflow"))
+	}
+
+	ship, err := e.shipRepo.FindBySymbol(ctx, shipSymbol, playerID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load ship for batched deliveries: %w", err)
+	}
+
+	deliveries, projectedAsk := e.planBatchDeliveries(ctx, ship, playerID, contracts)
+	trips := domainContract.PlanBatchTrips(deliveries, ship.AvailableCargoSpace())
+	if len(trips) == 0 {
+		return contracts, nil
+	}
+
+	byID := make(map[string]*domainContract.Contract, len(contracts))
+	for _, c := range contracts {
+		byID[c.ContractID()] = c
+	}
+
+	logger.Log("INFO", "Batched contract deliveries planned", map[string]interface{}{
+		"ship_symbol":    shipSymbol,
+		"action":         "batch_deliveries_planned",
+		"contract_count": len(contracts),
+		"delivery_count": len(deliveries),
+		"trip_count":     len(trips),
+	})
+
+	halted := make(map[string]bool)
+	for _, trip := range trips {
+		ship, err = e.purchaseBatchTrip(ctx, shipSymbol, playerID, ship, trip, projectedAsk, halted)
+		if err != nil {
+			return nil, err
+		}
+		ship, err = e.deliverBatchTrip(ctx, shipSymbol, playerID, ship, trip, byID)
+		if err != nil {
+			return nil, err
+		}
+		result.TotalTrips++
+
+		logger.Log("INFO", "Batched contract trip completed", map[string]interface{}{
+			"ship_symbol":  shipSymbol,
+			"action":       "batch_trip_completed",
+			"contract_ids": trip.ContractIDs(),
+			"units":        trip.Units(),
+		})
+	}
+
+	out := make([]*domainContract.Contract, len(contracts))
+	for i, c := range contracts {
+		out[i] = byID[c.ContractID()]
+	}
+	return out, nil
+}
+
+// planBatchDeliveries turns each contract's outstanding deliveries into batch
+// deliveries with a chosen source market, keeping only what the hull can fly
+// zero-jump (RULINGS #14) and what has a market source right now. It also returns
+// each good's projected ask, the ladder-cap basis.
+func (e *DeliveryExecutor) planBatchDeliveries(
+	ctx context.Context,
+	ship *navigation.Ship,
+	playerID shared.PlayerID,
+	contracts []*domainContract.Contract,
+) ([]domainContract.BatchDelivery, map[string]int) {
+	logger := common.LoggerFromContext(ctx)
+	shipSystem := ship.CurrentLocation().SystemSymbol
+	projectedAsk := make(map[string]int)
+
+	var deliveries []domainContract.BatchDelivery
+	for _, c := range contracts {
+		for _, delivery := range c.Terms().Deliveries {
+			owed := delivery.UnitsRequired - delivery.UnitsFulfilled
+			if owed <= 0 || shared.ExtractSystemSymbol(delivery.DestinationSymbol) != shipSystem {
+				continue
+			}
+			plan, err := e.batchPlanner.PlanDeliverySourcing(ctx, delivery, playerID.Value())
+			if err != nil || plan == nil || plan.Source == appContract.SourceInventory || plan.Market == "" {
+				reason := "no market source"
+				if err != nil {
+					reason = err.Error()
+				}
+				logger.Log("INFO", "Delivery left out of batch; per-contract path will source it", map[string]interface{}{
+					"ship_symbol":  ship.ShipSymbol(),
+					"action":       "batch_delivery_skipped",
+					"contract_id":  c.ContractID(),
+					"trade_symbol": delivery.TradeSymbol,
+					"reason":       reason,
+				})
+				continue
+			}
+			if _, seen := projectedAsk[delivery.TradeSymbol]; !seen {
+				projectedAsk[delivery.TradeSymbol] = plan.UnitAsk
+			}
+			deliveries = append(deliveries, domainContract.BatchDelivery{
+				ContractID:   c.ContractID(),
+				TradeSymbol:  delivery.TradeSymbol,
+				SourceMarket: plan.Market,
+				Destination:  delivery.DestinationSymbol,
+				Units:        owed,
+			})
+		}
+	}
+	return deliveries, projectedAsk
+}
+
+// purchaseBatchTrip buys every load of the trip, visiting its markets in routed
+// order. A good whose realized price breaches the ladder cap is halted for the
+// rest of the batch; an insufficient-credits failure parks like the single path.
+func (e *DeliveryExecutor) purchaseBatchTrip(
+	ctx context.Context,
+	shipSymbol string,
+	playerID shared.PlayerID,
+	ship *navigation.Ship,
+	trip domainContract.BatchTrip,
+	projectedAsk map[string]int,
+	halted map[string]bool,
+) (*navigation.Ship, error) {
+	logger := common.LoggerFromContext(ctx)
+
+	for _, marketSymbol := range e.batchOrderer.OrderStops(ctx, ship, playerID, trip.PurchaseMarkets()) {
+		docked := false
+		for _, load := range trip.Loads {
+			if load.SourceMarket != marketSymbol || halted[load.TradeSymbol] {
+				continue
+			}
+			if !docked {
+				var err error
+				ship, err = e.navigateAndDock(ctx, shipSymbol, marketSymbol, playerID)
+				if err != nil {
+					return nil, fmt.Errorf("failed to navigate to market: %w", err)
+				}
+				docked = true
+			}
+
+			purchaseResp, err := e.mediator.Send(ctx, &shipCargo.PurchaseCargoCommand{
+				ShipSymbol: shipSymbol,
+				GoodSymbol: load.TradeSymbol,
+				Units:      load.Units,
+				PlayerID:   playerID,
+			})
+			if err != nil {
+				if IsInsufficientCreditsError(err) {
+					return nil, &ErrInsufficientCredits{
+						ShipSymbol:     shipSymbol,
+						TradeSymbol:    load.TradeSymbol,
+						UnitsAttempted: load.Units,
+						Cause:          err,
+					}
+				}
+				return nil, fmt.Errorf("failed to purchase cargo: %w", err)
+			}
+
+			if breached, realizedPerUnit := sourcingLadderBreached(purchaseResp, projectedAsk[load.TradeSymbol]); breached {
+				halted[load.TradeSymbol] = true
+				logger.Log("WARNING", fmt.Sprintf(
+					"Sourcing ladder cap: batched buy realized %d/unit exceeds %d/%dx projected ask %d for %s at %s - halting further batched purchases of it (remainder re-projects through the defer gate; never-skip stands)",
+					realizedPerUnit, appContract.SourcingLadderCapNumer, appContract.SourcingLadderCapDenom,
+					projectedAsk[load.TradeSymbol], load.TradeSymbol, marketSymbol,
+				), map[string]interface{}{
+					"ship_symbol":       shipSymbol,
+					"action":            "sourcing_ladder_cap",
+					"trade_symbol":      load.TradeSymbol,
+					"market":            marketSymbol,
+					"realized_per_unit": realizedPerUnit,
+					"projected_ask":     projectedAsk[load.TradeSymbol],
+				})
+			}
+		}
+	}
+
+	ship, err := e.shipRepo.FindBySymbol(ctx, shipSymbol, playerID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to reload ship after batched purchases: %w", err)
+	}
+	return ship, nil
+}
+
+// deliverBatchTrip delivers every load of the trip, visiting its destinations in
+// routed order. Each load delivers what is actually aboard of its good (capped at
+// the load), so a halted or short purchase delivers partially rather than failing.
+func (e *DeliveryExecutor) deliverBatchTrip(
+	ctx context.Context,
+	shipSymbol string,
+	playerID shared.PlayerID,
+	ship *navigation.Ship,
+	trip domainContract.BatchTrip,
+	byID map[string]*domainContract.Contract,
+) (*navigation.Ship, error) {
+	for _, destination := range e.batchOrderer.OrderStops(ctx, ship, playerID, trip.Destinations()) {
+		docked := false
+		for _, load := range trip.Loads {
+			if load.Destination != destination {
+				continue
+			}
+			current, err := e.shipRepo.FindBySymbol(ctx, shipSymbol, playerID)
+			if err != nil {
+				return nil, fmt.Errorf("failed to reload ship before delivery: %w", err)
+			}
+			units := min(current.Cargo().GetItemUnits(load.TradeSymbol), load.Units)
+			if units <= 0 {
+				continue
+			}
+			if !docked {
+				if _, err := e.navigateAndDock(ctx, shipSymbol, destination, playerID); err != nil {
+					return nil, fmt.Errorf("failed to navigate to delivery: %w", err)
+				}
+				docked = true
+			}
+
+			deliverResp, err := e.mediator.Send(ctx, &DeliverContractCommand{
+				ContractID:  load.ContractID,
+				ShipSymbol:  shipSymbol,
+				TradeSymbol: load.TradeSymbol,
+				Units:       units,
+				PlayerID:    playerID,
+			})
+			if err != nil {
+				// A permanently failed contract in a shared hold is compensated on
+				// its own; the other contracts' loads are still delivered.
+				if saga := e.compensateFailedDelivery(ctx, shipSymbol, playerID, load.ContractID, load.TradeSymbol, err); saga != nil {
+					docked = false
+					continue
+				}
+				return nil, fmt.Errorf("failed to deliver cargo for contract %s: %w", load.ContractID, err)
+			}
+			if resp, ok := deliverResp.(*DeliverContractResponse); ok && resp.Contract != nil {
+				byID[load.ContractID] = resp.Contract
+			}
+		}
+	}
+
+	ship, err := e.shipRepo.FindBySymbol(ctx, shipSymbol, playerID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to reload ship after batched deliveries: %w", err)
+	}
+	return ship, nil
+}
+
+// MarketBatchSourcePlanner is the production BatchSourcePlanner: market-only
+// PlanDeliverySourcing over the market repository.
+type MarketBatchSourcePlanner struct {
+	marketRepo market.MarketRepository
+}
+
+// NewMarketBatchSourcePlanner creates the market-backed batch source planner.
+func NewMarketBatchSourcePlanner(marketRepo market.MarketRepository) *MarketBatchSourcePlanner {
+	return &MarketBatchSourcePlanner{marketRepo: marketRepo}
+}
+
+// PlanDeliverySourcing picks the cheapest home-system market for the delivery.
+func (p *MarketBatchSourcePlanner) PlanDeliverySourcing(ctx context.Context, delivery domainContract.Delivery, playerID int) (*appContract.SourcingPlan, error) {
+	return appContract.PlanDeliverySourcing(ctx, delivery, p.marketRepo, playerID)
+}
+
+// VRPBatchStopOrderer orders a batched trip's stops with the routing service's VRP
+// solver over a one-hull fleet, so the tour is sequenced the same way scout tours
+// are. Any routing failure falls back to the given order: a worse tour is still a
+// correct one.
+type VRPBatchStopOrderer struct {
+	routingClient routing.RoutingClient
+	graphProvider system.ISystemGraphProvider
+}
+
+// NewVRPBatchStopOrderer creates the routing-backed stop orderer.
+func NewVRPBatchStopOrderer(routingClient routing.RoutingClient, graphProvider system.ISystemGraphProvider) *VRPBatchStopOrderer {
+	return &VRPBatchStopOrderer{routingClient: routingClient, graphProvider: graphProvider}
+}
+
+// OrderStops returns stops in VRP tour order from the ship's location.
+func (o *VRPBatchStopOrderer) OrderStops(ctx context.Context, ship *navigation.Ship, playerID shared.PlayerID, stops []string) []string {
+	if len(stops) < 2 {
+		return stops
+	}
+	logger := common.LoggerFromContext(ctx)
+	systemSymbol := ship.CurrentLocation().SystemSymbol
+
+	fallback := func(err error) []string {
+		logger.Log("WARNING", "Batched stop ordering failed; flying stops in planned order", map[string]interface{}{
+			"ship_symbol": ship.ShipSymbol(),
+			"action":      "batch_stop_order_fallback",
+			"error":       err.Error(),
+		})
+		return stops
+	}
+
+	graphResult, err := o.graphProvider.GetGraph(ctx, systemSymbol, false, playerID.Value())
+	if err != nil {
+		return fallback(fmt.Errorf("failed to get graph: %w", err))
+	}
+	waypoints := make([]*system.WaypointData, 0, len(graphResult.Graph.Waypoints))
+	for symbol, wp := range graphResult.Graph.Waypoints {
+		waypoints = append(waypoints, &system.WaypointData{Symbol: symbol, X: wp.X, Y: wp.Y, HasFuel: wp.HasFuel})
+	}
+
+	resp, err := o.routingClient.PartitionFleet(ctx, &routing.VRPRequest{
+		SystemSymbol:    systemSymbol,
+		ShipSymbols:     []string{ship.ShipSymbol()},
+		MarketWaypoints: stops,
+		ShipConfigs: map[string]*routing.ShipConfigData{
+			ship.ShipSymbol(): {
+				CurrentLocation: ship.CurrentLocation().Symbol,
+				FuelCapacity:    ship.Fuel().Capacity,
+				EngineSpeed:     ship.EngineSpeed(),
+			},
+		},
+		AllWaypoints: waypoints,
+	})
+	if err != nil {
+		return fallback(fmt.Errorf("VRP optimization failed: %w", err))
+	}
+	tour, ok := resp.Assignments[ship.ShipSymbol()]
+	if !ok || tour == nil {
+		return fallback(fmt.Errorf("VRP returned no tour for %s", ship.ShipSymbol()))
+	}
+
+	// Keep the solver's order for the stops we asked about, then append any it
+	// dropped so no purchase or delivery is ever lost to the optimiser.
+	wanted := make(map[string]bool, len(stops))
+	for _, s := range stops {
+		wanted[s] = true
+	}
+	ordered := make([]string, 0, len(stops))
+	for _, wp := range tour.Waypoints {
+		if wanted[wp] {
+			ordered = append(ordered, wp)
+			delete(wanted, wp)
+		}
+	}
+	for _, s := range stops {
+		if wanted[s] {
+			ordered = append(ordered, s)
+		}
+	}
+	return ordered
+}
@@ -0,0 +1,177 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/andrescamacho/spacetraders-go/internal/application/common"
+	appContract "github.com/andrescamacho/spacetraders-go/internal/application/contract"
+	shipCargo "github.com/andrescamacho/spacetraders-go/internal/application/ship/commands/cargo"
+	shipNav "github.com/andrescamacho/spacetraders-go/internal/application/ship/commands/navigation"
+	shipTypes "github.com/andrescamacho/spacetraders-go/internal/application/ship/types"
+	domainContract "github.com/andrescamacho/spacetraders-go/internal/domain/contract"
+	"github.com/andrescamacho/spacetraders-go/internal/domain/navigation"
+	"github.com/andrescamacho/spacetraders-go/internal/domain/shared"
+)
+
+// batchFakeMediator moves cargo through the hull on purchase and deliver so the
+// executor's "deliver what is aboard" reads see real state, and records the stop
+// sequence the hull flew.
+type batchFakeMediator struct {
+	common.Mediator
+
+	t         *testing.T
+	ship      *navigation.Ship
+	contracts map[string]*domainContract.Contract
+	visits    []string
+	purchases int
+}
+
+func (m *batchFakeMediator) Send(ctx context.Context, request common.Request) (common.Response, error) {
+	switch req := request.(type) {
+	case *shipNav.NavigateRouteCommand:
+		m.visits = append(m.visits, req.Destination)
+		return &shipNav.NavigateRouteResponse{Status: "completed", Ship: m.ship}, nil
+
+	case *shipTypes.DockShipCommand:
+		return nil, nil
+
+	case *shipCargo.PurchaseCargoCommand:
+		m.purchases++
+		item, err := shared.NewCargoItem(req.GoodSymbol, req.GoodSymbol, "", req.Units)
+		if err != nil {
+			m.t.Fatalf("cargo item: %v", err)
+		}
+		if err := m.ship.ReceiveCargo(item); err != nil {
+			m.t.Fatalf("receive cargo: %v", err)
+		}
+		return &shipCargo.PurchaseCargoResponse{TotalCost: req.Units * 10, UnitsAdded: req.Units, TransactionCount: 1}, nil
+
+	case *DeliverContractCommand:
+		if err := m.ship.RemoveCargo(req.TradeSymbol, req.Units); err != nil {
+			m.t.Fatalf("remove cargo: %v", err)
+		}
+		c := m.contracts[req.ContractID]
+		if err := c.DeliverCargo(req.TradeSymbol, req.Units); err != nil {
+			m.t.Fatalf("deliver cargo: %v", err)
+		}
+		return &DeliverContractResponse{Contract: c, UnitsDelivered: req.Units}, nil
+
+	default:
+		return nil, fmt.Errorf("unexpected mediator command in batch test: %T", request)
+	}
+}
+
+// batchFakePlanner sources each good at a fixed market; unlisted goods have no source.
+type batchFakePlanner struct {
+	markets map[string]string
+}
+
+func (p *batchFakePlanner) PlanDeliverySourcing(_ context.Context, delivery domainContract.Delivery, _ int) (*appContract.SourcingPlan, error) {
+	market, ok := p.markets[delivery.TradeSymbol]
+	if !ok {
+		return nil, nil
+	}
+	return &appContract.SourcingPlan{Good: delivery.TradeSymbol, Market: market, UnitAsk: 10}, nil
+}
+
+// reversingOrderer flies every leg in reverse, proving the executor follows the
+// orderer rather than the planned order.
+type reversingOrderer struct{}
+
+func (reversingOrderer) OrderStops(_ context.Context, _ *navigation.Ship, _ shared.PlayerID, stops []string) []string {
+	out := make([]string, len(stops))
+	for i, s := range stops {
+		out[len(stops)-1-i] = s
+	}
+	return out
+}
+
+func batchTestContract(t *testing.T, id string, deliveries ...domainContract.Delivery) *domainContract.Contract {
+	t.Helper()
+	c, err := domainContract.NewContract(id, shared.MustNewPlayerID(1), "COSMIC", "PROCUREMENT", domainContract.Terms{
+		Payment:    domainContract.Payment{OnAccepted: 1_000, OnFulfilled: 5_000},
+		Deliveries: deliveries,
+		Deadline:   "2030-07-20T00:00:00Z",
+	}, nil)
+	if err != nil {
+		t.Fatalf("contract: %v", err)
+	}
+	if err := c.Accept(); err != nil {
+		t.Fatalf("accept: %v", err)
+	}
+	return c
+}
+
+// Two contracts fit one 40-unit hold: the hull buys both goods, then delivers both,
+// in one trip, with each leg flown in the orderer's sequence. A delivery with no
+// market source is left for the per-contract path.
+func TestProcessBatchedDeliveries_OneTripServicesBothContracts(t *testing.T) {
+	ship := buildShipWithIronOre(t, 0)
+	shipRepo := &reconcileFakeShipRepo{cached: ship, server: ship}
+	primary := batchTestContract(t, "ct-a",
+		domainContract.Delivery{TradeSymbol: "IRON_ORE", DestinationSymbol: "X1-PZ28-D1", UnitsRequired: 20},
+		domainContract.Delivery{TradeSymbol: "GOLD", DestinationSymbol: "X1-PZ28-D1", UnitsRequired: 5},
+	)
+	other := batchTestContract(t, "ct-b",
+		domainContract.Delivery{TradeSymbol: "COPPER_ORE", DestinationSymbol: "X1-PZ28-D2", UnitsRequired: 15},
+	)
+	mediator := &batchFakeMediator{t: t, ship: ship, contracts: map[string]*domainContract.Contract{"ct-a": primary, "ct-b": other}}
+	planner := &batchFakePlanner{markets: map[string]string{"IRON_ORE": "X1-PZ28-M1", "COPPER_ORE": "X1-PZ28-M2"}}
+	executor := NewDeliveryExecutor(mediator, shipRepo, NewCargoManager(mediator, shipRepo),
+		WithDeliveryBatching(planner, reversingOrderer{}))
+
+	result := &RunWorkflowResponse{}
+	ctx := common.WithLogger(context.Background(), &capturingLogger{})
+	got, err := executor.ProcessBatchedDeliveries(ctx, "TORWIND-1", shared.MustNewPlayerID(1),
+		[]*domainContract.Contract{primary, other}, result, "")
+	if err != nil {
+		t.Fatalf("batched deliveries: %v", err)
+	}
+
+	if result.TotalTrips != 1 {
+		t.Fatalf("both contracts fit one hold: expected 1 trip, got %d", result.TotalTrips)
+	}
+	if want := []string{"X1-PZ28-M2", "X1-PZ28-M1", "X1-PZ28-D2", "X1-PZ28-D1"}; !reflect.DeepEqual(mediator.visits, want) {
+		t.Fatalf("expected all purchases then all deliveries in orderer sequence %v, got %v", want, mediator.visits)
+	}
+	if !got[1].CanFulfill() {
+		t.Fatalf("the other contract should be completely delivered: %+v", got[1].Terms().Deliveries)
+	}
+	if got[0].CanFulfill() {
+		t.Fatalf("the unsourceable GOLD delivery must be left owed for the per-contract path")
+	}
+	if units := got[0].Terms().Deliveries[0].UnitsFulfilled; units != 20 {
+		t.Fatalf("primary IRON_ORE should be delivered, got %d units", units)
+	}
+	if ship.CargoUnits() != 0 {
+		t.Fatalf("hold should be empty after the trip, has %d units", ship.CargoUnits())
+	}
+}
+
+// Batching without its collaborators, or with a single contract, does nothing.
+func TestProcessBatchedDeliveries_NoOpWhenDisabledOrAlone(t *testing.T) {
+	ship := buildShipWithIronOre(t, 0)
+	shipRepo := &reconcileFakeShipRepo{cached: ship, server: ship}
+	c1 := batchTestContract(t, "ct-a", domainContract.Delivery{TradeSymbol: "IRON_ORE", DestinationSymbol: "X1-PZ28-D1", UnitsRequired: 5})
+	c2 := batchTestContract(t, "ct-b", domainContract.Delivery{TradeSymbol: "IRON_ORE", DestinationSymbol: "X1-PZ28-D1", UnitsRequired: 5})
+	mediator := &batchFakeMediator{t: t, ship: ship}
+	ctx := common.WithLogger(context.Background(), &capturingLogger{})
+
+	plain := NewDeliveryExecutor(mediator, shipRepo, NewCargoManager(mediator, shipRepo))
+	if _, err := plain.ProcessBatchedDeliveries(ctx, "TORWIND-1", shared.MustNewPlayerID(1), []*domainContract.Contract{c1, c2}, &RunWorkflowResponse{}, ""); err != nil {
+		t.Fatalf("disabled batching: %v", err)
+	}
+
+	batching := NewDeliveryExecutor(mediator, shipRepo, NewCargoManager(mediator, shipRepo),
+		WithDeliveryBatching(&batchFakePlanner{markets: map[string]string{"IRON_ORE": "X1-PZ28-M1"}}, reversingOrderer{}))
+	if _, err := batching.ProcessBatchedDeliveries(ctx, "TORWIND-1", shared.MustNewPlayerID(1), []*domainContract.Contract{c1}, &RunWorkflowResponse{}, ""); err != nil {
+		t.Fatalf("single contract: %v", err)
+	}
+
+	if mediator.purchases != 0 || len(mediator.visits) != 0 {
+		t.Fatalf("expected no flying or buying, got %d purchases and visits %v", mediator.purchases, mediator.visits)
+	}
+}
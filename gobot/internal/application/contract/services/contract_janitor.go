@@ -0,0 +1,146 @@
+package services
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/andrescamacho/spacetraders-go/internal/application/common"
+	domainContract "github.com/andrescamacho/spacetraders-go/internal/domain/contract"
+	"github.com/andrescamacho/spacetraders-go/internal/domain/shared"
+)
+
+// PendingContractStore reads negotiated contracts still awaiting acceptance and
+// saves them back once flagged.
+type PendingContractStore interface {
+	FindPendingContracts(ctx context.Context, playerID int) ([]*domainContract.Contract, error)
+	Add(ctx context.Context, contract *domainContract.Contract) error
+}
+
+// ContractTaskCanceller stops the containers still working a contract. Stopping
+// a container releases the ships it claimed.
+type ContractTaskCanceller interface {
+	StopContractContainers(ctx context.Context, playerID int, contractID string) (stopped int, err error)
+}
+
+// ContractWorkflowLocator finds the ship of a running contract workflow
+// container, or "" when no workflow is running.
+type ContractWorkflowLocator interface {
+	RunningContractWorkflowShip(ctx context.Context, playerID int) (shipSymbol string, err error)
+}
+
+// ContractJanitorResult is what one janitor sweep cleaned up.
+type ContractJanitorResult struct {
+	Expired           []string
+	ContainersStopped int
+	// Replacement is the contract negotiated in place of the lapsed ones, or ""
+	// when none was negotiated.
+	Replacement string
+}
+
+// ContractJanitor clears out negotiated contracts whose accept deadline passed
+// before they were accepted. Each one is flagged accept-expired, the containers
+// still working it are stopped, and when a contract workflow is still running a
+// replacement is negotiated through its ship so the workflow has something to
+// accept on its next pass.
+type ContractJanitor struct {
+	mediator  common.Mediator
+	contracts PendingContractStore
+	tasks     ContractTaskCanceller
+	workflows ContractWorkflowLocator
+}
+
+// NewContractJanitor creates a contract janitor.
+func NewContractJanitor(
+	mediator common.Mediator,
+	contracts PendingContractStore,
+	tasks ContractTaskCanceller,
+	workflows ContractWorkflowLocator,
+) *ContractJanitor {
+	return &ContractJanitor{
+		mediator:  mediator,
+		contracts: contracts,
+		tasks:     tasks,
+		workflows: workflows,
+	}
+}
+
+// Sweep runs one cleanup pass for the player. A contract that cannot be saved
+// fails the sweep; stopping containers and negotiating the replacement are
+// best-effort and only logged, so the next sweep retries them.
+func (j *ContractJanitor) Sweep(ctx context.Context, playerID shared.PlayerID) (ContractJanitorResult, error) {
+	logger := common.LoggerFromContext(ctx)
+	var result ContractJanitorResult
+
+	pending, err := j.contracts.FindPendingContracts(ctx, playerID.Value())
+	if err != nil {
+		return result, fmt.Errorf("failed to list pending contracts: %w", err)
+	}
+
+	for _, c := range pending {
+		if !c.AcceptDeadlinePassed() {
+			continue
+		}
+		if err := c.ExpireUnaccepted(); err != nil {
+			return result, fmt.Errorf("failed to expire contract %s: %w", c.ContractID(), err)
+		}
+		if err := j.contracts.Add(ctx, c); err != nil {
+			return result, fmt.Errorf("failed to save expired contract %s: %w", c.ContractID(), err)
+		}
+		result.Expired = append(result.Expired, c.ContractID())
+
+		stopped, err := j.tasks.StopContractContainers(ctx, playerID.Value(), c.ContractID())
+		if err != nil {
+			logger.Log("WARNING", "Failed to stop containers for expired contract", map[string]interface{}{
+				"action":      "contract_janitor",
+				"contract_id": c.ContractID(),
+				"error":       err.Error(),
+			})
+		}
+		result.ContainersStopped += stopped
+
+		logger.Log("INFO", "Expired contract that was never accepted", map[string]interface{}{
+			"action":             "contract_janitor",
+			"contract_id":        c.ContractID(),
+			"deadline_to_accept": c.Terms().DeadlineToAccept,
+			"containers_stopped": stopped,
+		})
+	}
+
+	if len(result.Expired) == 0 {
+		return result, nil
+	}
+
+	shipSymbol, err := j.workflows.RunningContractWorkflowShip(ctx, playerID.Value())
+	if err != nil || shipSymbol == "" {
+		if err != nil {
+			logger.Log("WARNING", "Failed to find a running contract workflow", map[string]interface{}{
+				"action": "contract_janitor",
+				"error":  err.Error(),
+			})
+		}
+		return result, nil
+	}
+
+	resp, err := j.mediator.Send(ctx, &NegotiateContractCommand{
+		ShipSymbol: shipSymbol,
+		PlayerID:   playerID,
+	})
+	if err != nil {
+		logger.Log("WARNING", "Failed to negotiate a replacement contract", map[string]interface{}{
+			"action":      "contract_janitor",
+			"ship_symbol": shipSymbol,
+			"error":       err.Error(),
+		})
+		return result, nil
+	}
+	if negotiated, ok := resp.(*NegotiateContractResponse); ok && negotiated.Contract != nil {
+		result.Replacement = negotiated.Contract.ContractID()
+		logger.Log("INFO", "Negotiated replacement contract", map[string]interface{}{
+			"action":      "contract_janitor",
+			"ship_symbol": shipSymbol,
+			"contract_id": result.Replacement,
+		})
+	}
+
+	return result, nil
+}
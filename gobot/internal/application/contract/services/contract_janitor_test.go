@@ -0,0 +1,115 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/andrescamacho/spacetraders-go/internal/application/common"
+	contractTypes "github.com/andrescamacho/spacetraders-go/internal/application/contract/types"
+	domainContract "github.com/andrescamacho/spacetraders-go/internal/domain/contract"
+	"github.com/andrescamacho/spacetraders-go/internal/domain/shared"
+)
+
+type janitorFakeStore struct {
+	pending []*domainContract.Contract
+	saved   []string
+}
+
+func (s *janitorFakeStore) FindPendingContracts(context.Context, int) ([]*domainContract.Contract, error) {
+	return s.pending, nil
+}
+
+func (s *janitorFakeStore) Add(_ context.Context, c *domainContract.Contract) error {
+	s.saved = append(s.saved, c.ContractID())
+	return nil
+}
+
+type janitorFakeTasks struct{ stopped map[string]int }
+
+func (t *janitorFakeTasks) StopContractContainers(_ context.Context, _ int, contractID string) (int, error) {
+	return t.stopped[contractID], nil
+}
+
+type janitorFakeWorkflows struct{ ship string }
+
+func (w janitorFakeWorkflows) RunningContractWorkflowShip(context.Context, int) (string, error) {
+	return w.ship, nil
+}
+
+type janitorFakeMediator struct {
+	common.Mediator
+
+	negotiatedFor []string
+	replacement   *domainContract.Contract
+}
+
+func (m *janitorFakeMediator) Send(_ context.Context, request common.Request) (common.Response, error) {
+	if cmd, ok := request.(*contractTypes.NegotiateContractCommand); ok {
+		m.negotiatedFor = append(m.negotiatedFor, cmd.ShipSymbol)
+		return &contractTypes.NegotiateContractResponse{Contract: m.replacement, WasNegotiated: true}, nil
+	}
+	return nil, fmt.Errorf("unexpected mediator command in janitor test: %T", request)
+}
+
+func janitorContract(t *testing.T, id, deadlineToAccept string, clock shared.Clock) *domainContract.Contract {
+	t.Helper()
+	c, err := domainContract.NewContract(id, shared.MustNewPlayerID(1), "COSMIC", "PROCUREMENT", domainContract.Terms{
+		Deliveries:       []domainContract.Delivery{{TradeSymbol: "IRON_ORE", DestinationSymbol: "X1-A-H1", UnitsRequired: 10}},
+		DeadlineToAccept: deadlineToAccept,
+		Deadline:         "2026-05-08T12:00:00Z",
+	}, clock)
+	if err != nil {
+		t.Fatalf("NewContract: %v", err)
+	}
+	return c
+}
+
+func TestContractJanitor_ExpiresLapsedOffersAndNegotiatesAReplacement(t *testing.T) {
+	clock := &shared.MockClock{CurrentTime: time.Date(2026, 5, 1, 12, 0, 0, 0, time.UTC)}
+	store := &janitorFakeStore{pending: []*domainContract.Contract{
+		janitorContract(t, "C-LAPSED", "2026-05-01T10:00:00Z", clock),
+		janitorContract(t, "C-OPEN", "2026-05-02T10:00:00Z", clock),
+	}}
+	med := &janitorFakeMediator{replacement: janitorContract(t, "C-NEW", "2026-05-02T12:00:00Z", clock)}
+	janitor := NewContractJanitor(med, store, &janitorFakeTasks{stopped: map[string]int{"C-LAPSED": 2}}, janitorFakeWorkflows{ship: "HAULER-1"})
+
+	result, err := janitor.Sweep(context.Background(), shared.MustNewPlayerID(1))
+	if err != nil {
+		t.Fatalf("Sweep: %v", err)
+	}
+
+	if len(result.Expired) != 1 || result.Expired[0] != "C-LAPSED" {
+		t.Fatalf("only the lapsed offer expires, got %v", result.Expired)
+	}
+	if len(store.saved) != 1 || !store.pending[0].AcceptExpired() {
+		t.Fatalf("the lapsed offer must be saved flagged, saved %v", store.saved)
+	}
+	if result.ContainersStopped != 2 {
+		t.Fatalf("expected 2 containers stopped, got %d", result.ContainersStopped)
+	}
+	if len(med.negotiatedFor) != 1 || med.negotiatedFor[0] != "HAULER-1" || result.Replacement != "C-NEW" {
+		t.Fatalf("expected one replacement negotiated through HAULER-1, got %v / %q", med.negotiatedFor, result.Replacement)
+	}
+}
+
+func TestContractJanitor_NoReplacementWithoutARunningWorkflow(t *testing.T) {
+	clock := &shared.MockClock{CurrentTime: time.Date(2026, 5, 1, 12, 0, 0, 0, time.UTC)}
+	store := &janitorFakeStore{pending: []*domainContract.Contract{
+		janitorContract(t, "C-LAPSED", "2026-05-01T10:00:00Z", clock),
+	}}
+	med := &janitorFakeMediator{}
+	janitor := NewContractJanitor(med, store, &janitorFakeTasks{}, janitorFakeWorkflows{})
+
+	result, err := janitor.Sweep(context.Background(), shared.MustNewPlayerID(1))
+	if err != nil {
+		t.Fatalf("Sweep: %v", err)
+	}
+	if len(result.Expired) != 1 {
+		t.Fatalf("the lapsed offer still expires, got %v", result.Expired)
+	}
+	if len(med.negotiatedFor) != 0 || result.Replacement != "" {
+		t.Fatalf("no workflow is running, so nothing is negotiated, got %v", med.negotiatedFor)
+	}
+}
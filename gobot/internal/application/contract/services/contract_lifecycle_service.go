@@ -49,7 +49,7 @@ func (s *ContractLifecycleService) FindOrNegotiateContract(
 	}
 
 	if len(activeContracts) > 0 {
-		contract := activeContracts[0]
+		contract := preferFactionStanding(ctx, s.mediator, playerID, activeContracts)[0]
 		logger.Log("INFO", "Resuming existing active contract", map[string]interface{}{
 			"ship_symbol": shipSymbol,
 			"action":      "resume_contract",
@@ -106,6 +106,9 @@ func (s *ContractLifecycleService) EvaluateContractProfitability(
 		ShipSymbol:      shipSymbol,
 		PlayerID:        playerID,
 		FuelCostPerTrip: 0,
+		// Price the delivery window against ask history so the verdict reflects
+		// the supply we drain while buying, not just today's ask.
+		SimulatePriceDrift: true,
 	}
 
 	profitabilityResp, err := s.mediator.Send(ctx, profitabilityQuery)
@@ -121,12 +124,20 @@ func (s *ContractLifecycleService) EvaluateContractProfitability(
 
 	profitResult := profitabilityResp.(*contractQueries.ProfitabilityResult)
 	if !profitResult.IsProfitable {
-		logger.Log("WARNING", "Contract unprofitable but accepting anyway", map[string]interface{}{
+		// The verdict is advisory: contracts are never declined (RULINGS #1),
+		// so an unprofitable — or only instantaneously profitable — contract
+		// is logged with its simulated range and accepted.
+		fields := map[string]interface{}{
 			"ship_symbol": shipSymbol,
 			"action":      "accept_unprofitable",
 			"contract_id": contract.ContractID(),
 			"reason":      profitResult.Reason,
-		})
+		}
+		if profitResult.Simulated {
+			fields["optimistic_net_profit"] = profitResult.OptimisticNetProfit
+			fields["pessimistic_net_profit"] = profitResult.PessimisticNetProfit
+		}
+		logger.Log("WARNING", "Contract unprofitable but accepting anyway", fields)
 	} else {
 		logger.Log("INFO", "Contract profitability confirmed", map[string]interface{}{
 			"ship_symbol": shipSymbol,
@@ -187,3 +198,34 @@ func (s *ContractLifecycleService) CalculateTotalProfit(
 ) int {
 	return contract.Terms().Payment.OnAccepted + contract.Terms().Payment.OnFulfilled
 }
+
+// FindOtherActiveContracts returns the player's accepted, unfulfilled contracts other
+// than the one the workflow is already working, at most limit of them. These are the
+// candidates multi-contract batching packs into the same hold.
+func (s *ContractLifecycleService) FindOtherActiveContracts(
+	ctx context.Context,
+	playerID shared.PlayerID,
+	excludeContractID string,
+	limit int,
+) ([]*domainContract.Contract, error) {
+	if limit <= 0 {
+		return nil, nil
+	}
+
+	activeContracts, err := s.contractRepo.FindActiveContracts(ctx, playerID.Value())
+	if err != nil {
+		return nil, fmt.Errorf("failed to check active contracts: %w", err)
+	}
+
+	var others []*domainContract.Contract
+	for _, c := range activeContracts {
+		if c.ContractID() == excludeContractID || !c.Accepted() || c.Fulfilled() {
+			continue
+		}
+		others = append(others, c)
+		if len(others) == limit {
+			break
+		}
+	}
+	return others, nil
+}
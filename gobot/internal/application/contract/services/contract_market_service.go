@@ -90,9 +90,10 @@ func (s *ContractMarketService) NegotiateContract(
 	logger := common.LoggerFromContext(ctx)
 
 	if len(activeContracts) > 0 {
-		// Resume existing contract
-		logger.Log("INFO", fmt.Sprintf("Resuming existing active contract: %s", activeContracts[0].ContractID()), nil)
-		return activeContracts[0], nil
+		// Resume existing contract, preferring factions we are building reputation with
+		contract := preferFactionStanding(ctx, s.mediator, shared.MustNewPlayerID(playerID), activeContracts)[0]
+		logger.Log("INFO", fmt.Sprintf("Resuming existing active contract: %s", contract.ContractID()), nil)
+		return contract, nil
 	}
 
 	// Negotiate new contract
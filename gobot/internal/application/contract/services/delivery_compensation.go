@@ -0,0 +1,187 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/andrescamacho/spacetraders-go/internal/application/common"
+	shipCargo "github.com/andrescamacho/spacetraders-go/internal/application/ship/commands/cargo"
+	domainContract "github.com/andrescamacho/spacetraders-go/internal/domain/contract"
+	"github.com/andrescamacho/spacetraders-go/internal/domain/market"
+	"github.com/andrescamacho/spacetraders-go/internal/domain/shared"
+)
+
+// permanentDeliveryErrorCodes are the SpaceTraders contract error codes after
+// which delivering a load can never succeed: the deadline passed (4503), the
+// contract is already fulfilled (4504) or was never accepted (4505), it belongs
+// to another agent (4506), the good or destination no longer matches its terms
+// (4508), or the delivery is already complete (4509). Anything else — a
+// navigation failure, a dock conflict, a transient 5xx — is retried by the
+// normal crash-and-respawn path with the cargo still aboard.
+var permanentDeliveryErrorCodes = []string{
+	`"code":4503`,
+	`"code":4504`,
+	`"code":4505`,
+	`"code":4506`,
+	`"code":4508`,
+	`"code":4509`,
+}
+
+// IsPermanentDeliveryFailure reports whether a deliver error means the load can
+// never be delivered against its contract. Detection is by error code in the
+// wire-format text, like IsInsufficientCreditsError.
+func IsPermanentDeliveryFailure(err error) bool {
+	if err == nil {
+		return false
+	}
+	text := err.Error()
+	for _, code := range permanentDeliveryErrorCodes {
+		if strings.Contains(text, code) {
+			return true
+		}
+	}
+	return false
+}
+
+// ErrDeliveryCompensated signals that a contract delivery failed permanently and
+// the saga compensated by selling the load. The contract can not progress, so
+// RunWorkflowHandler exits cleanly on it instead of crashing the container into
+// a respawn that would fail the same way.
+type ErrDeliveryCompensated struct {
+	Saga  *domainContract.DeliverySaga
+	Cause error
+}
+
+func (e *ErrDeliveryCompensated) Error() string {
+	return fmt.Sprintf(
+		"contract %s delivery of %s by %s failed permanently; compensation %s: sold %d/%d units for %d credits, %d stranded: %v",
+		e.Saga.ContractID(), e.Saga.TradeSymbol(), e.Saga.ShipSymbol(), e.Saga.Step(),
+		e.Saga.UnitsCompensated(), e.Saga.UnitsAboard(), e.Saga.CompensationRevenue(), e.Saga.UnitsStranded(), e.Cause,
+	)
+}
+
+func (e *ErrDeliveryCompensated) Unwrap() error { return e.Cause }
+
+// compensationMarketFinder finds the best in-system bid for stranded
+// deliverables. Satisfied by market.MarketRepository.
+type compensationMarketFinder interface {
+	FindBestMarketBuying(ctx context.Context, goodSymbol, systemSymbol string, playerID int) (*market.BestMarketBuyingResult, error)
+}
+
+// WithDeliveryCompensation enables the delivery saga's compensating action:
+// when a delivery fails permanently, the load aboard is sold at the best
+// in-system market and the sale is filed in the ledger against the contract. A
+// nil finder leaves a failed delivery an ordinary error, as before.
+func WithDeliveryCompensation(markets compensationMarketFinder) DeliveryExecutorOption {
+	return func(e *DeliveryExecutor) {
+		if markets != nil {
+			e.compensationMarkets = markets
+		}
+	}
+}
+
+// compensateFailedDelivery runs the saga's compensation for tradeSymbol aboard
+// shipSymbol after deliverErr. It returns nil when compensation is not wired or
+// deliverErr is not permanent, so the caller keeps its ordinary error path;
+// otherwise the saga, closed as COMPENSATED or STRANDED.
+func (e *DeliveryExecutor) compensateFailedDelivery(
+	ctx context.Context,
+	shipSymbol string,
+	playerID shared.PlayerID,
+	contractID string,
+	tradeSymbol string,
+	deliverErr error,
+) *domainContract.DeliverySaga {
+	if e.compensationMarkets == nil || !IsPermanentDeliveryFailure(deliverErr) {
+		return nil
+	}
+	logger := common.LoggerFromContext(ctx)
+
+	ship, err := e.shipRepo.FindBySymbol(ctx, shipSymbol, playerID)
+	if err != nil {
+		saga := domainContract.NewDeliverySaga(contractID, shipSymbol, tradeSymbol, 0)
+		_ = saga.FailDelivery(deliverErr.Error())
+		_ = saga.Strand(fmt.Sprintf("reload ship: %v", err))
+		return saga
+	}
+	saga := domainContract.NewDeliverySaga(contractID, shipSymbol, tradeSymbol, ship.Cargo().GetItemUnits(tradeSymbol))
+	_ = saga.FailDelivery(deliverErr.Error())
+
+	logger.Log("WARNING", fmt.Sprintf(
+		"Contract %s delivery of %s failed permanently (%v); compensating by selling %d units aboard %s",
+		contractID, tradeSymbol, deliverErr, saga.UnitsAboard(), shipSymbol), map[string]interface{}{
+		"action":       "delivery_saga_compensate",
+		"ship_symbol":  shipSymbol,
+		"contract_id":  contractID,
+		"trade_symbol": tradeSymbol,
+		"units":        saga.UnitsAboard(),
+	})
+
+	if saga.UnitsAboard() == 0 {
+		_ = saga.Compensated(0, 0)
+		return saga
+	}
+
+	system := ""
+	if loc := ship.CurrentLocation(); loc != nil {
+		system = loc.SystemSymbol
+	}
+	best, err := e.compensationMarkets.FindBestMarketBuying(ctx, tradeSymbol, system, playerID.Value())
+	if err != nil || best == nil || best.PurchasePrice <= 0 {
+		reason := fmt.Sprintf("no market in %s bids for %s", system, tradeSymbol)
+		if err != nil {
+			reason = fmt.Sprintf("market lookup: %v", err)
+		}
+		e.strand(ctx, saga, reason)
+		return saga
+	}
+
+	if _, err := e.navigateAndDock(ctx, shipSymbol, best.WaypointSymbol, playerID); err != nil {
+		e.strand(ctx, saga, fmt.Sprintf("reach %s: %v", best.WaypointSymbol, err))
+		return saga
+	}
+
+	resp, err := e.mediator.Send(shared.WithCompensationFor(ctx, contractID), &shipCargo.SellCargoCommand{
+		ShipSymbol: shipSymbol,
+		GoodSymbol: tradeSymbol,
+		Units:      saga.UnitsAboard(),
+		PlayerID:   playerID,
+	})
+	if err != nil {
+		e.strand(ctx, saga, fmt.Sprintf("sell at %s: %v", best.WaypointSymbol, err))
+		return saga
+	}
+	sold, revenue := 0, 0
+	if sr, ok := resp.(*shipCargo.SellCargoResponse); ok && sr != nil {
+		sold, revenue = sr.UnitsSold, sr.TotalRevenue
+	}
+	_ = saga.Compensated(sold, revenue)
+
+	logger.Log("INFO", fmt.Sprintf(
+		"Contract %s compensation: sold %d/%d %s at %s for %d credits",
+		contractID, sold, saga.UnitsAboard(), tradeSymbol, best.WaypointSymbol, revenue), map[string]interface{}{
+		"action":       "delivery_saga_compensated",
+		"ship_symbol":  shipSymbol,
+		"contract_id":  contractID,
+		"trade_symbol": tradeSymbol,
+		"units_sold":   sold,
+		"revenue":      revenue,
+		"market":       best.WaypointSymbol,
+	})
+	return saga
+}
+
+// strand closes the saga with the load still aboard and says so
+func (e *DeliveryExecutor) strand(ctx context.Context, saga *domainContract.DeliverySaga, reason string) {
+	_ = saga.Strand(reason)
+	common.LoggerFromContext(ctx).Log("ERROR", fmt.Sprintf(
+		"Contract %s compensation stranded %d %s aboard %s: %s — left for the liquidation sweep",
+		saga.ContractID(), saga.UnitsAboard(), saga.TradeSymbol(), saga.ShipSymbol(), reason), map[string]interface{}{
+		"action":       "delivery_saga_stranded",
+		"ship_symbol":  saga.ShipSymbol(),
+		"contract_id":  saga.ContractID(),
+		"trade_symbol": saga.TradeSymbol(),
+		"units":        saga.UnitsAboard(),
+	})
+}
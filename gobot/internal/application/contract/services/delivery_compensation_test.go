@@ -0,0 +1,123 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/andrescamacho/spacetraders-go/internal/application/common"
+	shipCargo "github.com/andrescamacho/spacetraders-go/internal/application/ship/commands/cargo"
+	shipNav "github.com/andrescamacho/spacetraders-go/internal/application/ship/commands/navigation"
+	shipTypes "github.com/andrescamacho/spacetraders-go/internal/application/ship/types"
+	domainContract "github.com/andrescamacho/spacetraders-go/internal/domain/contract"
+	"github.com/andrescamacho/spacetraders-go/internal/domain/market"
+	"github.com/andrescamacho/spacetraders-go/internal/domain/navigation"
+	"github.com/andrescamacho/spacetraders-go/internal/domain/shared"
+)
+
+// compensationFakeMediator fails every delivery with deliverErr and records
+// where the compensating sale went and which contract it was filed against.
+type compensationFakeMediator struct {
+	common.Mediator
+
+	navShip     *navigation.Ship
+	deliverErr  error
+	navigatedTo []string
+	soldUnits   int
+	soldAgainst string
+	sellRevenue int
+}
+
+func (m *compensationFakeMediator) Send(ctx context.Context, request common.Request) (common.Response, error) {
+	switch req := request.(type) {
+	case *shipNav.NavigateRouteCommand:
+		m.navigatedTo = append(m.navigatedTo, req.Destination)
+		return &shipNav.NavigateRouteResponse{Status: "completed", Ship: m.navShip}, nil
+	case *shipTypes.DockShipCommand:
+		return nil, nil
+	case *DeliverContractCommand:
+		return nil, m.deliverErr
+	case *shipCargo.SellCargoCommand:
+		m.soldUnits = req.Units
+		m.soldAgainst, _ = shared.CompensationForFromContext(ctx)
+		return &shipCargo.SellCargoResponse{UnitsSold: req.Units, TotalRevenue: req.Units * m.sellRevenue}, nil
+	default:
+		return nil, errors.New("unexpected command")
+	}
+}
+
+type bestBidFinder struct {
+	best *market.BestMarketBuyingResult
+}
+
+func (f bestBidFinder) FindBestMarketBuying(context.Context, string, string, int) (*market.BestMarketBuyingResult, error) {
+	return f.best, nil
+}
+
+func compensationContract(t *testing.T) *domainContract.Contract {
+	t.Helper()
+	contract, err := domainContract.NewContract("C-EXPIRED", shared.MustNewPlayerID(1), "COSMIC", "PROCUREMENT", domainContract.Terms{
+		Deliveries: []domainContract.Delivery{ladderDelivery(40)},
+	}, nil)
+	if err != nil {
+		t.Fatalf("contract: %v", err)
+	}
+	return contract
+}
+
+// A delivery rejected because the contract expired sells the load at the best
+// bid, files the sale against the contract, and exits as a compensated saga.
+func TestProcessSingleDelivery_PermanentFailureCompensates(t *testing.T) {
+	ship := buildShipWithIronOre(t, 40)
+	shipRepo := &reconcileFakeShipRepo{cached: ship, server: ship}
+	mediator := &compensationFakeMediator{
+		navShip:     ship,
+		deliverErr:  errors.New(`failed to deliver contract: API error (status 400): {"error":{"code":4503,"message":"contract deadline has passed"}}`),
+		sellRevenue: 90,
+	}
+	executor := NewDeliveryExecutor(mediator, shipRepo, NewCargoManager(mediator, shipRepo),
+		WithDeliveryCompensation(bestBidFinder{&market.BestMarketBuyingResult{WaypointSymbol: "X1-PZ28-S1", PurchasePrice: 90}}))
+
+	_, err := executor.ProcessSingleDelivery(context.Background(), "TORWIND-1", shared.MustNewPlayerID(1),
+		compensationContract(t), ladderDelivery(40), nil, &RunWorkflowResponse{}, nil)
+
+	var compensated *ErrDeliveryCompensated
+	if !errors.As(err, &compensated) {
+		t.Fatalf("expected ErrDeliveryCompensated, got %v", err)
+	}
+	saga := compensated.Saga
+	if saga.Step() != domainContract.DeliverySagaCompensated || saga.UnitsCompensated() != 40 || saga.CompensationRevenue() != 3600 {
+		t.Fatalf("unexpected saga: %s sold %d for %d", saga.Step(), saga.UnitsCompensated(), saga.CompensationRevenue())
+	}
+	if mediator.soldAgainst != "C-EXPIRED" || mediator.soldUnits != 40 {
+		t.Fatalf("expected all 40 units sold against C-EXPIRED, got %d against %q", mediator.soldUnits, mediator.soldAgainst)
+	}
+	if last := mediator.navigatedTo[len(mediator.navigatedTo)-1]; last != "X1-PZ28-S1" {
+		t.Fatalf("expected the hull routed to the best bid, got %v", mediator.navigatedTo)
+	}
+}
+
+// A transient delivery failure, or one with compensation not wired, keeps the
+// ordinary error path and sells nothing.
+func TestProcessSingleDelivery_TransientFailureIsNotCompensated(t *testing.T) {
+	ship := buildShipWithIronOre(t, 40)
+	shipRepo := &reconcileFakeShipRepo{cached: ship, server: ship}
+	finder := WithDeliveryCompensation(bestBidFinder{&market.BestMarketBuyingResult{WaypointSymbol: "X1-PZ28-S1", PurchasePrice: 90}})
+
+	transient := &compensationFakeMediator{navShip: ship, deliverErr: errors.New("API error (status 503): unavailable")}
+	executor := NewDeliveryExecutor(transient, shipRepo, NewCargoManager(transient, shipRepo), finder)
+	_, err := executor.ProcessSingleDelivery(context.Background(), "TORWIND-1", shared.MustNewPlayerID(1),
+		compensationContract(t), ladderDelivery(40), nil, &RunWorkflowResponse{}, nil)
+	var compensated *ErrDeliveryCompensated
+	if err == nil || errors.As(err, &compensated) || transient.soldUnits != 0 {
+		t.Fatalf("a transient failure must not compensate: err=%v sold=%d", err, transient.soldUnits)
+	}
+
+	unwired := &compensationFakeMediator{navShip: ship, deliverErr: errors.New(`{"code":4503}`)}
+	executor = NewDeliveryExecutor(unwired, shipRepo, NewCargoManager(unwired, shipRepo))
+	_, err = executor.ProcessSingleDelivery(context.Background(), "TORWIND-1", shared.MustNewPlayerID(1),
+		compensationContract(t), ladderDelivery(40), nil, &RunWorkflowResponse{}, nil)
+	if err == nil || errors.As(err, &compensated) || unwired.soldUnits != 0 {
+		t.Fatalf("without compensation wired the failure stays an error: err=%v sold=%d", err, unwired.soldUnits)
+	}
+}
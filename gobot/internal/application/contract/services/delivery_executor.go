@@ -5,6 +5,7 @@ import (
 	"errors"
 	"fmt"
 	"math"
+	"time"
 
 	"github.com/andrescamacho/spacetraders-go/internal/application/common"
 	appContract "github.com/andrescamacho/spacetraders-go/internal/application/contract"
@@ -17,6 +18,7 @@ import (
 	domainContract "github.com/andrescamacho/spacetraders-go/internal/domain/contract"
 	"github.com/andrescamacho/spacetraders-go/internal/domain/navigation"
 	domainPorts "github.com/andrescamacho/spacetraders-go/internal/domain/ports"
+	domainRouting "github.com/andrescamacho/spacetraders-go/internal/domain/routing"
 	"github.com/andrescamacho/spacetraders-go/internal/domain/shared"
 	"github.com/andrescamacho/spacetraders-go/internal/domain/storage"
 	"github.com/andrescamacho/spacetraders-go/pkg/utils"
@@ -50,6 +52,19 @@ type DeliveryExecutor struct {
 	// timestamp; WithWithdrawalRecorder defaults it to a RealClock.
 	withdrawalRecorder storage.WithdrawalRecorder
 	withdrawalClock    shared.Clock
+
+	// Multi-contract batching collaborators, wired together via
+	// WithDeliveryBatching. Both nil leaves ProcessBatchedDeliveries a no-op.
+	batchPlanner BatchSourcePlanner
+	batchOrderer BatchStopOrderer
+
+	// compensationMarkets, wired via WithDeliveryCompensation, arms the delivery
+	// saga's compensating sale. nil leaves a failed delivery an ordinary error.
+	compensationMarkets compensationMarketFinder
+
+	// audit, wired via WithWorkflowAudit, records each purchase, navigation and
+	// delivery to the contract workflow audit trail. nil records nothing.
+	audit *WorkflowAuditor
 }
 
 // DeliveryExecutorOption configures optional collaborators without breaking the
@@ -84,6 +99,14 @@ func WithWithdrawalRecorder(recorder storage.WithdrawalRecorder, clock shared.Cl
 	}
 }
 
+// WithWorkflowAudit records the executor's purchase, navigate and deliver steps
+// through auditor. A nil auditor is a no-op.
+func WithWorkflowAudit(auditor *WorkflowAuditor) DeliveryExecutorOption {
+	return func(e *DeliveryExecutor) {
+		e.audit = auditor
+	}
+}
+
 // NewDeliveryExecutor creates a new delivery executor service
 func NewDeliveryExecutor(
 	mediator common.Mediator,
@@ -285,10 +308,17 @@ func (e *DeliveryExecutor) ProcessSingleDelivery(
 
 		fulfilledBefore := currentDelivery.UnitsFulfilled
 
-		contract, err = e.DeliverContractCargo(ctx, shipSymbol, playerID, contract, ship, currentDelivery)
+		deliveredContract, err := e.DeliverContractCargo(ctx, shipSymbol, playerID, contract, ship, currentDelivery)
 		if err != nil {
+			// COMPENSATE (delivery saga): a permanent failure means the load
+			// aboard can never be delivered. Sell it rather than crash into a
+			// respawn that fails the same way with the cargo still stranded.
+			if saga := e.compensateFailedDelivery(ctx, shipSymbol, playerID, contract.ContractID(), currentDelivery.TradeSymbol, err); saga != nil {
+				return nil, &ErrDeliveryCompensated{Saga: saga, Cause: err}
+			}
 			return nil, err
 		}
+		contract = deliveredContract
 
 		// Re-read the good's registration from the deliver response (the loop's
 		// source of truth). A nil contract only happens in unit fakes that skip
@@ -469,7 +499,9 @@ func (e *DeliveryExecutor) executeSinglePurchaseTrip(
 		PlayerID:   playerID,
 	}
 
+	purchaseStarted := e.audit.Now()
 	purchaseResp, err := e.mediator.Send(ctx, purchaseCmd)
+	e.recordPurchase(ctx, playerID, shipSymbol, cheapestMarket, tradeSymbol, unitsThisTrip, purchaseResp, purchaseStarted, err)
 	if err != nil {
 		if IsInsufficientCreditsError(err) {
 			return nil, 0, false, false, &ErrInsufficientCredits{
@@ -513,6 +545,35 @@ func (e *DeliveryExecutor) executeSinglePurchaseTrip(
 	return ship, unitsToPurchase, ladderBreached, ladderBreached, nil
 }
 
+// recordPurchase files a purchase trip to the workflow audit trail, with the
+// units and cost the purchase response reports when it succeeded.
+func (e *DeliveryExecutor) recordPurchase(
+	ctx context.Context,
+	playerID shared.PlayerID,
+	shipSymbol, market, tradeSymbol string,
+	units int,
+	purchaseResp common.Response,
+	startedAt time.Time,
+	err error,
+) {
+	if e.audit == nil {
+		return
+	}
+	record := domainContract.WorkflowStepRecord{
+		PlayerID:    playerID.Value(),
+		ShipSymbol:  shipSymbol,
+		Step:        domainContract.WorkflowStepPurchase,
+		Waypoint:    market,
+		TradeSymbol: tradeSymbol,
+		Units:       units,
+	}
+	if resp, ok := purchaseResp.(*shipCargo.PurchaseCargoResponse); ok && resp != nil {
+		record.Units = resp.UnitsAdded
+		record.Credits = -resp.TotalCost
+	}
+	e.audit.Record(ctx, record, startedAt, err)
+}
+
 // sourcingLadderBreached reports whether the trip's realized per-unit price ran
 // past SourcingLadderCapNumer/Denom (1.5×) of the projected ask, and what it
 // realized. A zero/unknown basis, a non-PurchaseCargoResponse, or a zero-unit
@@ -579,7 +640,17 @@ func (e *DeliveryExecutor) DeliverContractCargo(
 		PlayerID:    playerID,
 	}
 
+	deliverStarted := e.audit.Now()
 	deliverResp, err := e.mediator.Send(ctx, deliverCmd)
+	e.audit.Record(ctx, domainContract.WorkflowStepRecord{
+		PlayerID:    playerID.Value(),
+		ContractID:  contract.ContractID(),
+		ShipSymbol:  shipSymbol,
+		Step:        domainContract.WorkflowStepDeliver,
+		Waypoint:    delivery.DestinationSymbol,
+		TradeSymbol: delivery.TradeSymbol,
+		Units:       unitsToDeliver,
+	}, deliverStarted, err)
 	if err != nil {
 		return nil, fmt.Errorf("failed to deliver cargo: %w", err)
 	}
@@ -614,14 +685,23 @@ func (e *DeliveryExecutor) navigateToWaypoint(
 	destination string,
 	playerID shared.PlayerID,
 ) (*navigation.Ship, error) {
-	// Use HIGH-LEVEL NavigateRouteCommand (handles route planning, refueling, multi-hop, idempotency)
+	// Use HIGH-LEVEL NavigateRouteCommand (handles route planning, refueling, multi-hop, idempotency).
+	// A contract's payout waits on the hull, so its legs are planned for time.
 	navigateCmd := &shipNav.NavigateRouteCommand{
 		ShipSymbol:  shipSymbol,
 		Destination: destination,
 		PlayerID:    playerID,
+		Objective:   domainRouting.ObjectiveFastest,
 	}
 
+	started := e.audit.Now()
 	resp, err := e.mediator.Send(ctx, navigateCmd)
+	e.audit.Record(ctx, domainContract.WorkflowStepRecord{
+		PlayerID:   playerID.Value(),
+		ShipSymbol: shipSymbol,
+		Step:       domainContract.WorkflowStepNavigate,
+		Waypoint:   destination,
+	}, started, err)
 	if err != nil {
 		return nil, fmt.Errorf("failed to navigate: %w", err)
 	}
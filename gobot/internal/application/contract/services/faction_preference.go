@@ -0,0 +1,60 @@
+package services
+
+import (
+	"context"
+	"sort"
+
+	"github.com/andrescamacho/spacetraders-go/internal/application/common"
+	playerQueries "github.com/andrescamacho/spacetraders-go/internal/application/player/queries"
+	domainContract "github.com/andrescamacho/spacetraders-go/internal/domain/contract"
+	"github.com/andrescamacho/spacetraders-go/internal/domain/shared"
+)
+
+// preferFactionStanding orders active contracts so the ones from factions we are
+// building reputation with come first, using GetFactionStandingQuery's preference
+// order. Contracts from factions with no standing keep their place after the ranked
+// ones. The coordinator and its workers both resume activeContracts[0], so both
+// call this to agree on which contract is next.
+//
+// Fail-open: a single contract, a failed query, or no standings returns the input
+// order unchanged — reputation is a tie-breaker, never a reason to stall.
+func preferFactionStanding(
+	ctx context.Context,
+	mediator common.Mediator,
+	playerID shared.PlayerID,
+	contracts []*domainContract.Contract,
+) []*domainContract.Contract {
+	if len(contracts) < 2 || mediator == nil {
+		return contracts
+	}
+
+	resp, err := mediator.Send(ctx, &playerQueries.GetFactionStandingQuery{PlayerID: playerID})
+	if err != nil {
+		common.LoggerFromContext(ctx).Log("WARNING", "Faction standing unavailable; keeping contract order", map[string]interface{}{
+			"action": "faction_preference",
+			"error":  err.Error(),
+		})
+		return contracts
+	}
+	standing, ok := resp.(*playerQueries.GetFactionStandingResponse)
+	if !ok || len(standing.Standings) == 0 {
+		return contracts
+	}
+
+	rank := make(map[string]int, len(standing.Standings))
+	for i, s := range standing.Standings {
+		rank[s.FactionSymbol] = i
+	}
+	rankOf := func(c *domainContract.Contract) int {
+		if r, ok := rank[c.FactionSymbol()]; ok {
+			return r
+		}
+		return len(rank)
+	}
+
+	ordered := append([]*domainContract.Contract(nil), contracts...)
+	sort.SliceStable(ordered, func(i, j int) bool {
+		return rankOf(ordered[i]) < rankOf(ordered[j])
+	})
+	return ordered
+}
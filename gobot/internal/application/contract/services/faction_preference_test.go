@@ -0,0 +1,71 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/andrescamacho/spacetraders-go/internal/application/common"
+	playerQueries "github.com/andrescamacho/spacetraders-go/internal/application/player/queries"
+	domainContract "github.com/andrescamacho/spacetraders-go/internal/domain/contract"
+	"github.com/andrescamacho/spacetraders-go/internal/domain/player"
+	"github.com/andrescamacho/spacetraders-go/internal/domain/shared"
+)
+
+// standingFakeMediator answers GetFactionStandingQuery with a fixed standing list.
+type standingFakeMediator struct {
+	common.Mediator
+	standings []player.FactionStanding
+	err       error
+}
+
+func (m *standingFakeMediator) Send(_ context.Context, request common.Request) (common.Response, error) {
+	if _, ok := request.(*playerQueries.GetFactionStandingQuery); !ok {
+		return nil, errors.New("unexpected request")
+	}
+	if m.err != nil {
+		return nil, m.err
+	}
+	return &playerQueries.GetFactionStandingResponse{Standings: m.standings}, nil
+}
+
+func factionContract(t *testing.T, id, faction string) *domainContract.Contract {
+	t.Helper()
+	c, err := domainContract.NewContract(id, shared.MustNewPlayerID(1), faction, "PROCUREMENT", domainContract.Terms{
+		Deliveries: []domainContract.Delivery{{TradeSymbol: "IRON_ORE", DestinationSymbol: "X1-A-B1", UnitsRequired: 1}},
+		Deadline:   "2030-07-20T00:00:00Z",
+	}, nil)
+	if err != nil {
+		t.Fatalf("contract: %v", err)
+	}
+	return c
+}
+
+func TestPreferFactionStanding_OrdersByStandingThenKeepsUnranked(t *testing.T) {
+	unranked := factionContract(t, "ct-1", "QUANTUM")
+	flat := factionContract(t, "ct-2", "GALACTIC")
+	rising := factionContract(t, "ct-3", "COSMIC")
+	med := &standingFakeMediator{standings: []player.FactionStanding{
+		{FactionSymbol: "COSMIC", Gain: 12},
+		{FactionSymbol: "GALACTIC"},
+	}}
+	ctx := common.WithLogger(context.Background(), &capturingLogger{})
+
+	got := preferFactionStanding(ctx, med, shared.MustNewPlayerID(1), []*domainContract.Contract{unranked, flat, rising})
+	if got[0] != rising || got[1] != flat || got[2] != unranked {
+		t.Fatalf("expected COSMIC, GALACTIC, then unranked QUANTUM; got %s, %s, %s",
+			got[0].FactionSymbol(), got[1].FactionSymbol(), got[2].FactionSymbol())
+	}
+}
+
+func TestPreferFactionStanding_FailsOpen(t *testing.T) {
+	first := factionContract(t, "ct-1", "QUANTUM")
+	second := factionContract(t, "ct-2", "COSMIC")
+	med := &standingFakeMediator{err: errors.New("no handler")}
+	ctx := common.WithLogger(context.Background(), &capturingLogger{})
+
+	got := preferFactionStanding(ctx, med, shared.MustNewPlayerID(1), []*domainContract.Contract{first, second})
+	if got[0] != first || got[1] != second {
+		t.Fatalf("a failed standing query must keep the repository order")
+	}
+}
@@ -0,0 +1,127 @@
+package services
+
+import (
+	"context"
+	"math"
+	"time"
+
+	appContract "github.com/andrescamacho/spacetraders-go/internal/application/contract"
+	"github.com/andrescamacho/spacetraders-go/internal/domain/goods"
+	"github.com/andrescamacho/spacetraders-go/internal/domain/navigation"
+	"github.com/andrescamacho/spacetraders-go/internal/domain/shared"
+	"github.com/andrescamacho/spacetraders-go/internal/domain/system"
+)
+
+// MiningEstimatorConfig holds the yield and cost assumptions behind a mining
+// estimate. Zero values take the defaults below.
+type MiningEstimatorConfig struct {
+	UnitsPerExtraction int           // average units one extraction yields (default 10)
+	Cooldown           time.Duration // reactor cooldown between extractions (default 70s)
+	UnitCost           int           // operating cost per mined unit (default 10)
+	HaulEngineSpeed    int           // engine speed assumed for the haul to the delivery (default 10)
+}
+
+const (
+	defaultMiningUnitsPerExtraction = 10
+	defaultMiningCooldown           = 70 * time.Second
+	defaultMiningUnitCost           = 10
+	// defaultMiningHaulEngineSpeed assumes a slow hauler so the deadline check
+	// errs toward declining.
+	defaultMiningHaulEngineSpeed = 10
+)
+
+func (c MiningEstimatorConfig) withDefaults() MiningEstimatorConfig {
+	if c.UnitsPerExtraction <= 0 {
+		c.UnitsPerExtraction = defaultMiningUnitsPerExtraction
+	}
+	if c.Cooldown <= 0 {
+		c.Cooldown = defaultMiningCooldown
+	}
+	if c.UnitCost <= 0 {
+		c.UnitCost = defaultMiningUnitCost
+	}
+	if c.HaulEngineSpeed <= 0 {
+		c.HaulEngineSpeed = defaultMiningHaulEngineSpeed
+	}
+	return c
+}
+
+// DepositMiningEstimator satisfies appContract.MiningCostEstimator from the
+// cached waypoint traits: it picks the home-system asteroid whose deposit
+// yields the good most often, nearest the delivery on ties, and projects the
+// duration from the miners' combined extraction rate scaled by that share,
+// plus the haul from the asteroid to the delivery.
+// Fail-open: any lookup failure returns nil and the contract is bought.
+type DepositMiningEstimator struct {
+	waypoints system.WaypointRepository
+	cfg       MiningEstimatorConfig
+	eta       *navigation.ETAService
+}
+
+// NewDepositMiningEstimator creates an estimator over the waypoint cache
+func NewDepositMiningEstimator(waypoints system.WaypointRepository, cfg MiningEstimatorConfig) *DepositMiningEstimator {
+	return &DepositMiningEstimator{waypoints: waypoints, cfg: cfg.withDefaults(), eta: navigation.NewETAService(nil)}
+}
+
+// WithFuelCalibration makes the haul estimates use the fuel costs calibration
+// has fitted from observed navigations. Returns the estimator for chaining.
+func (e *DepositMiningEstimator) WithFuelCalibration(calibration navigation.FuelCalibrationSource) *DepositMiningEstimator {
+	e.eta = navigation.NewETAService(navigation.NewShipFuelService(calibration))
+	return e
+}
+
+// EstimateMining returns the estimate for mining units of good for delivery at
+// destination, or nil when it cannot be mined in the destination's system.
+func (e *DepositMiningEstimator) EstimateMining(ctx context.Context, playerID int, destination, good string, units, miners int) *appContract.MiningEstimate {
+	if e == nil || e.waypoints == nil || units <= 0 || miners <= 0 {
+		return nil
+	}
+	deposits := goods.DepositsYielding(good)
+	if len(deposits) == 0 {
+		return nil
+	}
+
+	systemSymbol := shared.ExtractSystemSymbol(destination)
+	dest, err := e.waypoints.FindBySymbol(ctx, destination, systemSymbol)
+	if err != nil {
+		dest = nil
+	}
+
+	var best *shared.Waypoint
+	bestShare, bestDistance := 0.0, math.MaxFloat64
+	for _, deposit := range deposits {
+		asteroids, err := e.waypoints.ListBySystemWithTrait(ctx, systemSymbol, deposit)
+		if err != nil {
+			continue
+		}
+		share := 1.0 / float64(goods.DepositYieldCount(deposit))
+		for _, asteroid := range asteroids {
+			distance := 0.0
+			if dest != nil {
+				distance = asteroid.DistanceTo(dest)
+			}
+			if share > bestShare || (share == bestShare && distance < bestDistance) {
+				best, bestShare, bestDistance = asteroid, share, distance
+			}
+		}
+	}
+	if best == nil {
+		return nil
+	}
+
+	unitsPerSecond := float64(miners*e.cfg.UnitsPerExtraction) * bestShare / e.cfg.Cooldown.Seconds()
+	duration := time.Duration(float64(units) / unitsPerSecond * float64(time.Second))
+	if dest != nil {
+		// Fuel is left out (capacity 0): the haul's refuelling is not what
+		// decides whether the deadline is met.
+		haul, err := e.eta.Estimate(navigation.ETAQuery{From: best, To: dest, EngineSpeed: e.cfg.HaulEngineSpeed})
+		if err == nil {
+			duration += haul.Duration
+		}
+	}
+	return &appContract.MiningEstimate{
+		Asteroid: best.Symbol,
+		UnitCost: e.cfg.UnitCost,
+		Duration: duration,
+	}
+}
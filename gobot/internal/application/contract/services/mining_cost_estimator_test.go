@@ -0,0 +1,93 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/andrescamacho/spacetraders-go/internal/domain/shared"
+)
+
+// fakeTraitWaypoints answers ListBySystemWithTrait from a trait → waypoints map.
+type fakeTraitWaypoints struct {
+	byTrait map[string][]*shared.Waypoint
+	all     map[string]*shared.Waypoint
+}
+
+func (f *fakeTraitWaypoints) FindBySymbol(_ context.Context, symbol, _ string) (*shared.Waypoint, error) {
+	if wp, ok := f.all[symbol]; ok {
+		return wp, nil
+	}
+	return nil, errors.New("not found")
+}
+func (f *fakeTraitWaypoints) ListBySystem(context.Context, string) ([]*shared.Waypoint, error) {
+	return nil, nil
+}
+func (f *fakeTraitWaypoints) ListBySystemWithTrait(_ context.Context, _ string, trait string) ([]*shared.Waypoint, error) {
+	return f.byTrait[trait], nil
+}
+func (f *fakeTraitWaypoints) Add(context.Context, *shared.Waypoint) error { return nil }
+
+func mustWaypoint(t *testing.T, symbol string, x, y float64) *shared.Waypoint {
+	t.Helper()
+	wp, err := shared.NewWaypoint(symbol, x, y)
+	if err != nil {
+		t.Fatalf("NewWaypoint(%s): %v", symbol, err)
+	}
+	return wp
+}
+
+func TestDepositMiningEstimator_PicksNearestAsteroidWithTheBestShare(t *testing.T) {
+	dest := mustWaypoint(t, "X1-A-H1", 0, 0)
+	near := mustWaypoint(t, "X1-A-B1", 10, 0)
+	far := mustWaypoint(t, "X1-A-B2", 200, 0)
+	mineral := mustWaypoint(t, "X1-A-B3", 1, 0)
+	repo := &fakeTraitWaypoints{
+		byTrait: map[string][]*shared.Waypoint{
+			"COMMON_METAL_DEPOSITS": {far, near},
+			"MINERAL_DEPOSITS":      {mineral},
+		},
+		all: map[string]*shared.Waypoint{dest.Symbol: dest},
+	}
+	estimator := NewDepositMiningEstimator(repo, MiningEstimatorConfig{UnitsPerExtraction: 10, Cooldown: 60 * time.Second, UnitCost: 5})
+
+	estimate := estimator.EstimateMining(context.Background(), 1, dest.Symbol, "IRON_ORE", 100, 2)
+	if estimate == nil {
+		t.Fatal("iron ore is mineable at a common metal deposit")
+	}
+	if estimate.Asteroid != near.Symbol {
+		t.Fatalf("expected the nearest common metal asteroid, got %s", estimate.Asteroid)
+	}
+	// 2 miners × 10 units per 60s, one yield in five is iron: 100 units take
+	// 1500s, plus the 10-unit cruise haul to the delivery at speed 10.
+	haul := time.Duration(shared.FlightModeCruise.TravelTime(10, 10)) * time.Second
+	if estimate.Duration != 1500*time.Second+haul {
+		t.Fatalf("unexpected duration %s", estimate.Duration)
+	}
+	if estimate.TotalCost(100) != 500 {
+		t.Fatalf("unexpected total cost %d", estimate.TotalCost(100))
+	}
+
+	// Quartz sand comes from every deposit type. The closer mineral deposit
+	// yields it one time in six, so the nearest 1-in-5 asteroid wins.
+	quartz := estimator.EstimateMining(context.Background(), 1, dest.Symbol, "QUARTZ_SAND", 100, 2)
+	if quartz == nil || quartz.Asteroid != near.Symbol {
+		t.Fatalf("expected the 1-in-5 common metal asteroid for quartz, got %+v", quartz)
+	}
+}
+
+func TestDepositMiningEstimator_NilWhenTheGoodCannotBeMinedHere(t *testing.T) {
+	repo := &fakeTraitWaypoints{byTrait: map[string][]*shared.Waypoint{}}
+	estimator := NewDepositMiningEstimator(repo, MiningEstimatorConfig{})
+
+	if got := estimator.EstimateMining(context.Background(), 1, "X1-A-H1", "FABRICS", 50, 1); got != nil {
+		t.Fatalf("fabrics are not mined, got %+v", got)
+	}
+	if got := estimator.EstimateMining(context.Background(), 1, "X1-A-H1", "IRON_ORE", 50, 1); got != nil {
+		t.Fatalf("no asteroid in the system, got %+v", got)
+	}
+	if got := estimator.EstimateMining(context.Background(), 1, "X1-A-H1", "IRON_ORE", 50, 0); got != nil {
+		t.Fatalf("no miners, got %+v", got)
+	}
+}
@@ -0,0 +1,89 @@
+package services
+
+import (
+	"context"
+	"time"
+
+	"github.com/andrescamacho/spacetraders-go/internal/application/common"
+	domainContract "github.com/andrescamacho/spacetraders-go/internal/domain/contract"
+	"github.com/andrescamacho/spacetraders-go/internal/domain/shared"
+)
+
+// WorkflowAuditor records each contract workflow step to the workflow audit
+// trail. A nil *WorkflowAuditor records nothing, so the workflow handler and
+// delivery executor call it unconditionally. Recording is telemetry: a storage
+// failure is logged and never fails the step.
+type WorkflowAuditor struct {
+	repo  domainContract.WorkflowAuditRepository
+	clock shared.Clock
+}
+
+// NewWorkflowAuditor creates an auditor over repo, or returns nil when repo is
+// nil. A nil clock defaults to the real clock.
+func NewWorkflowAuditor(repo domainContract.WorkflowAuditRepository, clock shared.Clock) *WorkflowAuditor {
+	if repo == nil {
+		return nil
+	}
+	if clock == nil {
+		clock = shared.NewRealClock()
+	}
+	return &WorkflowAuditor{repo: repo, clock: clock}
+}
+
+type workflowScopeKey struct{}
+
+type workflowScope struct {
+	contractID  string
+	containerID string
+}
+
+// WithWorkflowScope marks ctx as running contractID's workflow in containerID,
+// so steps recorded deeper in the call tree (purchase, navigate, deliver) are
+// filed against that contract.
+func WithWorkflowScope(ctx context.Context, contractID, containerID string) context.Context {
+	return context.WithValue(ctx, workflowScopeKey{}, workflowScope{contractID: contractID, containerID: containerID})
+}
+
+// Now stamps a step's start. It returns the zero time on a nil auditor.
+func (a *WorkflowAuditor) Now() time.Time {
+	if a == nil {
+		return time.Time{}
+	}
+	return a.clock.Now()
+}
+
+// Record files record, started at startedAt, as finished now. The contract and
+// container default to ctx's workflow scope. An unset Outcome is derived from
+// err: succeeded when nil, failed with err as Detail otherwise.
+func (a *WorkflowAuditor) Record(ctx context.Context, record domainContract.WorkflowStepRecord, startedAt time.Time, err error) {
+	if a == nil {
+		return
+	}
+	if scope, ok := ctx.Value(workflowScopeKey{}).(workflowScope); ok {
+		if record.ContractID == "" {
+			record.ContractID = scope.contractID
+		}
+		if record.ContainerID == "" {
+			record.ContainerID = scope.containerID
+		}
+	}
+	if record.Outcome == "" {
+		record.Outcome = domainContract.WorkflowStepSucceeded
+		if err != nil {
+			record.Outcome = domainContract.WorkflowStepFailed
+			record.Detail = err.Error()
+		}
+	}
+	record.StartedAt = startedAt
+	record.FinishedAt = a.clock.Now()
+
+	if recErr := a.repo.RecordStep(ctx, record); recErr != nil {
+		common.LoggerFromContext(ctx).Log("WARNING", "Contract workflow step record failed (step unaffected; audit only)", map[string]interface{}{
+			"ship_symbol": record.ShipSymbol,
+			"action":      "workflow_audit_failed",
+			"contract_id": record.ContractID,
+			"step":        string(record.Step),
+			"error":       recErr.Error(),
+		})
+	}
+}
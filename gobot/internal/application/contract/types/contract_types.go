@@ -47,6 +47,25 @@ type AcceptContractResponse struct {
 	Contract *contract.Contract
 }
 
+// ============================================================================
+// Starter Contract Onboarding
+// ============================================================================
+
+// OnboardStarterContractCommand accepts a fresh agent's pre-negotiated starter
+// contract. An agent that has ever accepted a contract is not fresh and is left
+// alone.
+type OnboardStarterContractCommand struct {
+	PlayerID shared.PlayerID
+}
+
+// OnboardStarterContractResponse reports what onboarding found. Contract is nil
+// when the agent holds no starter contract.
+type OnboardStarterContractResponse struct {
+	Contract *contract.Contract
+	Accepted bool   // true when this command accepted the contract
+	Reason   string // why nothing was accepted, when Accepted is false
+}
+
 // ============================================================================
 // Contract Delivery
 // ============================================================================
@@ -171,6 +190,10 @@ type RunFleetCoordinatorCommand struct {
 	IdleArbMinNetProfit    int // absolute after-fuel net floor per unit (default 100)
 	IdleArbNetProfitPct    int // relative net floor as % of buy price (default 20)
 	IdleArbFuelCostPerUnit int // per-cargo-unit fuel estimate subtracted from spread (default 35)
+	// Anti-starvation policy: hulls idle this long get the relaxed floor below.
+	IdleArbStarvationAfterSecs    int // idle seconds before a hull is starving (0 = policy off)
+	IdleArbStarvationMinNetProfit int // relaxed absolute net floor per unit (default 25)
+	IdleArbStarvationNetProfitPct int // relaxed net floor as % of buy price (default 5)
 
 	// CommandCargoBaseline (sp-uj6a, RULINGS #5): minimum cargo capacity a
 	// COMMAND-role hull must carry to remain a contract-selection candidate
@@ -195,6 +218,12 @@ type RunFleetCoordinatorCommand struct {
 	// nothing is destroyed without an explicit captain-set threshold; a lot with a bid is
 	// always sold (value recovered, never dumped — RULINGS #5).
 	LiquidationMinJettisonValue int
+
+	// MiningProcurementDisabled turns off procurement-by-mining: with it set the
+	// coordinator always buys, even a mineable good whose mining estimate beats
+	// the market (see contract.EvaluateMiningProcurement). Absent key → false →
+	// mining is considered wherever idle excavators exist.
+	MiningProcurementDisabled bool
 }
 
 // RunFleetCoordinatorResponse contains fleet coordination results.
@@ -0,0 +1,114 @@
+package events
+
+import (
+	"sync"
+	"sync/atomic"
+
+	"github.com/andrescamacho/spacetraders-go/internal/domain/shared"
+)
+
+// subscriberBuffer is how many undelivered events a subscriber may fall behind
+// by before newer ones are dropped for it.
+const subscriberBuffer = 16
+
+// subscriptionKey scopes a subscription to one player's events on one topic
+type subscriptionKey struct {
+	topic    shared.EventTopic
+	playerID int
+}
+
+// Bus is the in-process pub/sub bus containers use to signal each other
+// (market.updated, ship.arrived, contract.accepted, container.completed).
+// It follows ShipEventBus's delivery rules: each subscriber gets a buffered
+// channel, and Publish never blocks — a subscriber whose buffer is full misses
+// the event rather than stalling the publisher. Events are wake-up hints, so a
+// missed one only means the subscriber waits for its next poll.
+type Bus struct {
+	mu          sync.RWMutex
+	subscribers map[subscriptionKey][]chan shared.CoordinationEvent
+	clock       shared.Clock
+	published   atomic.Int64
+	dropped     atomic.Int64
+}
+
+// Compile-time interface checks
+var (
+	_ shared.CoordinationPublisher  = (*Bus)(nil)
+	_ shared.CoordinationSubscriber = (*Bus)(nil)
+)
+
+// NewBus creates an empty bus. If clock is nil, uses RealClock.
+func NewBus(clock shared.Clock) *Bus {
+	if clock == nil {
+		clock = shared.NewRealClock()
+	}
+	return &Bus{
+		subscribers: make(map[subscriptionKey][]chan shared.CoordinationEvent),
+		clock:       clock,
+	}
+}
+
+// Publish delivers event to every subscriber of its topic and player. A zero
+// OccurredAt is stamped with the bus clock.
+func (b *Bus) Publish(event shared.CoordinationEvent) {
+	if event.OccurredAt.IsZero() {
+		event.OccurredAt = b.clock.Now()
+	}
+	b.published.Add(1)
+
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+	for _, ch := range b.subscribers[subscriptionKey{event.Topic, event.PlayerID}] {
+		select {
+		case ch <- event:
+		default:
+			b.dropped.Add(1)
+		}
+	}
+}
+
+// Subscribe returns a channel of playerID's events on topic and the func that
+// ends the subscription. Cancelling twice is safe.
+func (b *Bus) Subscribe(topic shared.EventTopic, playerID int) (<-chan shared.CoordinationEvent, func()) {
+	key := subscriptionKey{topic, playerID}
+	ch := make(chan shared.CoordinationEvent, subscriberBuffer)
+
+	b.mu.Lock()
+	b.subscribers[key] = append(b.subscribers[key], ch)
+	b.mu.Unlock()
+
+	var once sync.Once
+	cancel := func() {
+		once.Do(func() { b.unsubscribe(key, ch) })
+	}
+	return ch, cancel
+}
+
+func (b *Bus) unsubscribe(key subscriptionKey, ch chan shared.CoordinationEvent) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	channels := b.subscribers[key]
+	for i, c := range channels {
+		if c == ch {
+			close(c)
+			channels[i] = channels[len(channels)-1]
+			b.subscribers[key] = channels[:len(channels)-1]
+			break
+		}
+	}
+	if len(b.subscribers[key]) == 0 {
+		delete(b.subscribers, key)
+	}
+}
+
+// Stats reports how many events were published, how many deliveries were
+// dropped on full subscriber buffers, and the live subscription count.
+func (b *Bus) Stats() (published, dropped int64, subscriptions int) {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+	for _, channels := range b.subscribers {
+		subscriptions += len(channels)
+	}
+	return b.published.Load(), b.dropped.Load(), subscriptions
+}
@@ -0,0 +1,84 @@
+package events
+
+import (
+	"testing"
+	"time"
+
+	"github.com/andrescamacho/spacetraders-go/internal/domain/shared"
+)
+
+// An event reaches only the subscribers of its topic and player, stamped with
+// the bus clock when the publisher left OccurredAt unset.
+func TestBus_DeliversByTopicAndPlayer(t *testing.T) {
+	now := time.Date(2024, 3, 1, 12, 0, 0, 0, time.UTC)
+	bus := NewBus(&shared.MockClock{CurrentTime: now})
+
+	markets, cancelMarkets := bus.Subscribe(shared.TopicMarketUpdated, 1)
+	defer cancelMarkets()
+	otherPlayer, cancelOther := bus.Subscribe(shared.TopicMarketUpdated, 2)
+	defer cancelOther()
+	arrivals, cancelArrivals := bus.Subscribe(shared.TopicShipArrived, 1)
+	defer cancelArrivals()
+
+	bus.Publish(shared.CoordinationEvent{Topic: shared.TopicMarketUpdated, PlayerID: 1, Subject: "X1-A1"})
+
+	select {
+	case event := <-markets:
+		if event.Subject != "X1-A1" {
+			t.Fatalf("expected subject X1-A1, got %q", event.Subject)
+		}
+		if !event.OccurredAt.Equal(now) {
+			t.Fatalf("expected OccurredAt stamped %v, got %v", now, event.OccurredAt)
+		}
+	default:
+		t.Fatal("subscriber to the topic and player received nothing")
+	}
+	select {
+	case <-otherPlayer:
+		t.Fatal("another player's subscriber must not receive the event")
+	case <-arrivals:
+		t.Fatal("another topic's subscriber must not receive the event")
+	default:
+	}
+}
+
+// A subscriber that stops reading misses events instead of blocking the
+// publisher, and the misses are counted.
+func TestBus_FullSubscriberDropsWithoutBlocking(t *testing.T) {
+	bus := NewBus(nil)
+	_, cancel := bus.Subscribe(shared.TopicContainerCompleted, 1)
+	defer cancel()
+
+	for i := 0; i < subscriberBuffer+3; i++ {
+		bus.Publish(shared.CoordinationEvent{Topic: shared.TopicContainerCompleted, PlayerID: 1})
+	}
+
+	published, dropped, subscriptions := bus.Stats()
+	if published != subscriberBuffer+3 {
+		t.Fatalf("expected %d published, got %d", subscriberBuffer+3, published)
+	}
+	if dropped != 3 {
+		t.Fatalf("expected 3 dropped, got %d", dropped)
+	}
+	if subscriptions != 1 {
+		t.Fatalf("expected 1 subscription, got %d", subscriptions)
+	}
+}
+
+// Cancelling closes the channel, removes the subscription and is idempotent.
+func TestBus_CancelClosesAndUnsubscribes(t *testing.T) {
+	bus := NewBus(nil)
+	ch, cancel := bus.Subscribe(shared.TopicContractAccepted, 1)
+
+	cancel()
+	cancel()
+
+	if _, ok := <-ch; ok {
+		t.Fatal("expected the channel closed after cancel")
+	}
+	if _, _, subscriptions := bus.Stats(); subscriptions != 0 {
+		t.Fatalf("expected no subscriptions after cancel, got %d", subscriptions)
+	}
+	// Publishing with no subscribers is a no-op
+	bus.Publish(shared.CoordinationEvent{Topic: shared.TopicContractAccepted, PlayerID: 1})
+}
@@ -178,7 +178,7 @@ func probeTxn(t *testing.T, ts time.Time, price int) *ledger.Transaction {
 		10,       // balanceAfter = before + amount
 		"Purchased SHIP_PROBE",
 		map[string]interface{}{"ship_type": probeShipType},
-		"", "", "fleet expansion",
+		"", "", "fleet expansion", "",
 	)
 	require.NoError(t, err)
 	return tx
@@ -0,0 +1,149 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/andrescamacho/spacetraders-go/internal/application/common"
+	"github.com/andrescamacho/spacetraders-go/internal/domain/container"
+)
+
+// LaunchOperationProfileCommand launches an operation from a profile (a versioned YAML
+// parameter set, see container.OperationProfile) instead of a hand-typed flag list. The
+// profile is checked against its operation's launch schema before anything starts, so a
+// misspelt or mistyped knob fails the whole launch rather than one hull at a time. Every
+// launched container carries the profile's name, version and digest in its config.
+type LaunchOperationProfileCommand struct {
+	PlayerID    int
+	AgentSymbol string
+	// Profile names the profile (resolved in the daemon's operation profiles directory) or is
+	// a path to a YAML file.
+	Profile string
+	// DryRun validates the profile and reports the planned launches without starting anything.
+	DryRun bool
+}
+
+// Operation profile launch outcomes.
+const (
+	OperationLaunchStarted = "LAUNCHED"
+	OperationLaunchPlanned = "PLANNED"
+	OperationLaunchFailed  = "FAILED"
+)
+
+// OperationLaunchResult is the outcome of one launch target: a ship for single-hull
+// operations, or the whole profile for operations that take the ship list at once.
+type OperationLaunchResult struct {
+	Target       string
+	ContainerIDs []string
+	Status       string
+	Reason       string
+}
+
+// LaunchOperationProfileResponse reports the profile revision and every launch, in order.
+type LaunchOperationProfileResponse struct {
+	Profile   string
+	Version   int
+	Digest    string
+	Operation string
+	DryRun    bool
+	Launches  []OperationLaunchResult
+}
+
+// OperationProfileLoader resolves a profile name to a validated profile.
+type OperationProfileLoader interface {
+	LoadOperationProfile(profile string) (*container.OperationProfile, error)
+}
+
+// OperationLauncher checks profiles against their operation's launch schema and starts them
+// through the daemon's launch verbs.
+type OperationLauncher interface {
+	// ValidateOperation checks the profile's operation and params: a known operation, every
+	// required param present, no unknown param and every value of the right kind. It returns
+	// the launch targets in order.
+	ValidateOperation(profile *container.OperationProfile) ([]string, error)
+	// LaunchOperation starts one target and stamps the profile's revision on what it started.
+	// Container IDs are returned even alongside an error when the launch itself succeeded.
+	LaunchOperation(ctx context.Context, playerID int, agentSymbol string, profile *container.OperationProfile, target string) ([]string, error)
+}
+
+// LaunchOperationProfileHandler launches operation profiles.
+type LaunchOperationProfileHandler struct {
+	profiles OperationProfileLoader
+	launcher OperationLauncher
+}
+
+// NewLaunchOperationProfileHandler creates a LaunchOperationProfileHandler.
+func NewLaunchOperationProfileHandler(profiles OperationProfileLoader, launcher OperationLauncher) *LaunchOperationProfileHandler {
+	return &LaunchOperationProfileHandler{profiles: profiles, launcher: launcher}
+}
+
+// Handle loads and validates the profile, then launches each target. A target that fails to
+// launch is reported and does not stop the others.
+func (h *LaunchOperationProfileHandler) Handle(ctx context.Context, request common.Request) (common.Response, error) {
+	cmd, ok := request.(*LaunchOperationProfileCommand)
+	if !ok {
+		return nil, fmt.Errorf("invalid request type")
+	}
+	if cmd.Profile == "" {
+		return nil, fmt.Errorf("an operation profile is required")
+	}
+
+	profile, err := h.profiles.LoadOperationProfile(cmd.Profile)
+	if err != nil {
+		return nil, err
+	}
+	targets, err := h.launcher.ValidateOperation(profile)
+	if err != nil {
+		return nil, fmt.Errorf("operation profile %s does not fit %s: %w", profile.Label(), profile.Operation, err)
+	}
+
+	logger := common.LoggerFromContext(ctx)
+	logger.Log("INFO", fmt.Sprintf("Launching operation profile %s (%s)", profile.Label(), profile.Operation), map[string]interface{}{
+		"action":    "launch_operation_profile",
+		"profile":   profile.Name,
+		"version":   profile.Version,
+		"digest":    profile.Digest,
+		"operation": profile.Operation,
+		"targets":   len(targets),
+		"dry_run":   cmd.DryRun,
+	})
+
+	resp := &LaunchOperationProfileResponse{
+		Profile:   profile.Name,
+		Version:   profile.Version,
+		Digest:    profile.Digest,
+		Operation: profile.Operation,
+		DryRun:    cmd.DryRun,
+	}
+	for _, target := range targets {
+		result := OperationLaunchResult{Target: target}
+		if cmd.DryRun {
+			result.Status = OperationLaunchPlanned
+			resp.Launches = append(resp.Launches, result)
+			continue
+		}
+
+		ids, err := h.launcher.LaunchOperation(ctx, cmd.PlayerID, cmd.AgentSymbol, profile, target)
+		result.ContainerIDs = ids
+		switch {
+		case err != nil && len(ids) == 0:
+			result.Status = OperationLaunchFailed
+			result.Reason = err.Error()
+			logger.Log("WARNING", fmt.Sprintf("Operation profile %s failed to launch %s: %v", profile.Label(), target, err), map[string]interface{}{
+				"action":    "launch_operation_profile",
+				"profile":   profile.Name,
+				"version":   profile.Version,
+				"operation": profile.Operation,
+				"target":    target,
+			})
+		case err != nil:
+			// Launched, but the profile stamp did not land: the containers run regardless.
+			result.Status = OperationLaunchStarted
+			result.Reason = err.Error()
+		default:
+			result.Status = OperationLaunchStarted
+		}
+		resp.Launches = append(resp.Launches, result)
+	}
+	return resp, nil
+}
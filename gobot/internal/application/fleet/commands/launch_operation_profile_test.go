@@ -0,0 +1,93 @@
+package commands
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/andrescamacho/spacetraders-go/internal/domain/container"
+)
+
+type fakeProfileLoader struct{ profile *container.OperationProfile }
+
+func (f *fakeProfileLoader) LoadOperationProfile(string) (*container.OperationProfile, error) {
+	return f.profile, nil
+}
+
+type fakeOperationLauncher struct {
+	invalid  error
+	fail     map[string]bool
+	launched []string
+}
+
+func (f *fakeOperationLauncher) ValidateOperation(profile *container.OperationProfile) ([]string, error) {
+	if f.invalid != nil {
+		return nil, f.invalid
+	}
+	return profile.Ships, nil
+}
+
+func (f *fakeOperationLauncher) LaunchOperation(_ context.Context, _ int, _ string, _ *container.OperationProfile, target string) ([]string, error) {
+	if f.fail[target] {
+		return nil, errors.New("ship is not idle")
+	}
+	f.launched = append(f.launched, target)
+	return []string{"tour-" + target}, nil
+}
+
+func haulerProfile() *container.OperationProfile {
+	return &container.OperationProfile{
+		Name:      "tour-haulers",
+		Version:   3,
+		Operation: "tour_run",
+		Ships:     []string{"H-1", "H-2", "H-3"},
+		Digest:    "abc123def456",
+	}
+}
+
+// Each ship launches on its own; one busy hull is reported without stopping the rest.
+func TestLaunchOperationProfile_LaunchesEachTarget(t *testing.T) {
+	launcher := &fakeOperationLauncher{fail: map[string]bool{"H-2": true}}
+	h := NewLaunchOperationProfileHandler(&fakeProfileLoader{profile: haulerProfile()}, launcher)
+
+	resp, err := h.Handle(context.Background(), &LaunchOperationProfileCommand{PlayerID: 1, Profile: "tour-haulers"})
+	if err != nil {
+		t.Fatalf("Handle: %v", err)
+	}
+	out := resp.(*LaunchOperationProfileResponse)
+	if out.Version != 3 || out.Digest != "abc123def456" || len(out.Launches) != 3 {
+		t.Fatalf("unexpected response: %+v", out)
+	}
+	want := []string{OperationLaunchStarted, OperationLaunchFailed, OperationLaunchStarted}
+	for i, l := range out.Launches {
+		if l.Status != want[i] {
+			t.Fatalf("launch %s status = %s, want %s", l.Target, l.Status, want[i])
+		}
+	}
+	if len(launcher.launched) != 2 {
+		t.Fatalf("launched %v, want H-1 and H-3", launcher.launched)
+	}
+}
+
+// A profile that does not fit its operation starts nothing; a dry run plans without launching.
+func TestLaunchOperationProfile_ValidatesBeforeLaunchingAndHonoursDryRun(t *testing.T) {
+	launcher := &fakeOperationLauncher{invalid: errors.New("min_margn (unknown)")}
+	h := NewLaunchOperationProfileHandler(&fakeProfileLoader{profile: haulerProfile()}, launcher)
+	if _, err := h.Handle(context.Background(), &LaunchOperationProfileCommand{PlayerID: 1, Profile: "tour-haulers"}); err == nil {
+		t.Fatal("an invalid profile must fail the launch")
+	}
+
+	launcher.invalid = nil
+	resp, err := h.Handle(context.Background(), &LaunchOperationProfileCommand{PlayerID: 1, Profile: "tour-haulers", DryRun: true})
+	if err != nil {
+		t.Fatalf("Handle: %v", err)
+	}
+	for _, l := range resp.(*LaunchOperationProfileResponse).Launches {
+		if l.Status != OperationLaunchPlanned {
+			t.Fatalf("dry run launch %s status = %s, want PLANNED", l.Target, l.Status)
+		}
+	}
+	if len(launcher.launched) != 0 {
+		t.Fatalf("a dry run must not launch: %v", launcher.launched)
+	}
+}
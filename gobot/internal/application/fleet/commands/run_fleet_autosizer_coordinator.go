@@ -226,6 +226,21 @@ type RunFleetAutosizerCoordinatorHandler struct {
 
 	mu    sync.Mutex
 	state map[string]*autosizerState // keyed by container ID
+
+	// measured is the latest demand each class reported, per player — what
+	// MeasuredDemand hands to buyers outside the autosizer (the shipyard watch).
+	measured map[measuredDemandKey]MeasuredClassDemand
+}
+
+type measuredDemandKey struct {
+	playerID int
+	class    HullClass
+}
+
+// MeasuredClassDemand is one class's demand as the autosizer last measured it.
+type MeasuredClassDemand struct {
+	Demand     ClassDemand
+	MeasuredAt time.Time
 }
 
 // autosizerState is the per-coordinator in-memory edge-trigger bookkeeping.
@@ -247,11 +262,28 @@ func NewRunFleetAutosizerCoordinatorHandler(clock shared.Clock) *RunFleetAutosiz
 		clock = shared.NewRealClock()
 	}
 	return &RunFleetAutosizerCoordinatorHandler{
-		clock: clock,
-		state: make(map[string]*autosizerState),
+		clock:    clock,
+		state:    make(map[string]*autosizerState),
+		measured: make(map[measuredDemandKey]MeasuredClassDemand),
 	}
 }
 
+// MeasuredDemand returns the demand the autosizer last measured for the player's
+// class, and false when it has measured none (not running, or the class is off).
+func (h *RunFleetAutosizerCoordinatorHandler) MeasuredDemand(playerID int, class HullClass) (MeasuredClassDemand, bool) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	m, ok := h.measured[measuredDemandKey{playerID: playerID, class: class}]
+	return m, ok
+}
+
+// recordDemand keeps d as the player's latest measured demand for its class.
+func (h *RunFleetAutosizerCoordinatorHandler) recordDemand(playerID int, class HullClass, d ClassDemand) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.measured[measuredDemandKey{playerID: playerID, class: class}] = MeasuredClassDemand{Demand: d, MeasuredAt: h.clock.Now()}
+}
+
 // AddDemandProvider registers a class demand provider. Registration order is evaluation order.
 func (h *RunFleetAutosizerCoordinatorHandler) AddDemandProvider(p ClassDemandProvider) {
 	h.providers = append(h.providers, p)
@@ -159,6 +159,30 @@ func TestReconcile_UnreadableDemand_NeverShortfall(t *testing.T) {
 	}
 }
 
+// Each tick's demand reads are kept per player and class for MeasuredDemand, so a buyer
+// outside the autosizer sizes against the same measurement; an unmeasured class reads as absent.
+func TestReconcile_RecordsMeasuredDemand(t *testing.T) {
+	light := &fakeDemandProvider{class: HullClassLight, demand: ClassDemand{Demand: 5, Current: 2, Readable: true}}
+	h := newHandlerWith(light)
+
+	if _, ok := h.MeasuredDemand(42, HullClassLight); ok {
+		t.Fatal("no demand may be reported before the first tick")
+	}
+	if _, err := h.reconcileOnce(context.Background(), &RunFleetAutosizerCoordinatorCommand{PlayerID: 42, ContainerID: "c1"}); err != nil {
+		t.Fatalf("reconcileOnce error: %v", err)
+	}
+	m, ok := h.MeasuredDemand(42, HullClassLight)
+	if !ok || m.Demand.Shortfall() != 3 || m.MeasuredAt.IsZero() {
+		t.Fatalf("expected the light shortfall of 3 recorded, got %+v ok=%v", m, ok)
+	}
+	if _, ok := h.MeasuredDemand(42, HullClassHeavy); ok {
+		t.Fatal("a class with no provider must read as unmeasured")
+	}
+	if _, ok := h.MeasuredDemand(7, HullClassLight); ok {
+		t.Fatal("another player's measurement must not leak")
+	}
+}
+
 func TestResolveConfig_Defaults(t *testing.T) {
 	cfg := resolveFleetAutosizerConfig(&RunFleetAutosizerCoordinatorCommand{})
 
@@ -321,6 +321,7 @@ func (h *RunFleetAutosizerCoordinatorHandler) reconcileOnce(ctx context.Context,
 			continue
 		}
 		res.ClassesEvaluated++
+		h.recordDemand(cmd.PlayerID, class, d)
 		if d.Readable && d.Shortfall() > 0 {
 			res.ShortfallClasses++
 		}
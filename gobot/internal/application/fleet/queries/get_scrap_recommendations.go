@@ -0,0 +1,172 @@
+package queries
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/andrescamacho/spacetraders-go/internal/application/common"
+	"github.com/andrescamacho/spacetraders-go/internal/domain/ledger"
+	"github.com/andrescamacho/spacetraders-go/internal/domain/navigation"
+	"github.com/andrescamacho/spacetraders-go/internal/domain/shared"
+)
+
+// DefaultScrapMinIdleDays is the idle threshold GetScrapRecommendationsQuery
+// applies when it names none.
+const DefaultScrapMinIdleDays = 7
+
+// GetScrapRecommendationsQuery asks which idle ships are worth scrapping: those
+// idle for more than MinIdleDays whose lifetime ledger ROI is negative.
+type GetScrapRecommendationsQuery struct {
+	PlayerID    shared.PlayerID
+	MinIdleDays int // 0 => DefaultScrapMinIdleDays
+}
+
+// ScrapRecommendation is one ship the fleet would be better off without.
+//
+// Revenue and Costs are the ship's lifetime ledger rows (attributed through the
+// ship_symbol metadata every cargo, refuel and purchase row carries); Costs
+// includes the purchase price, so NetProfit < 0 means the ship has not yet paid
+// for itself.
+type ScrapRecommendation struct {
+	ShipSymbol string
+	Frame      string
+	Role       string
+	IdleSince  time.Time
+	IdleDays   float64
+	Revenue    int
+	Costs      int
+	NetProfit  int
+	CargoUnits int // non-zero blocks ScrapShipCommand until the cargo is cleared
+}
+
+// GetScrapRecommendationsResponse lists the recommendations, worst ROI first
+type GetScrapRecommendationsResponse struct {
+	MinIdleDays     int
+	Recommendations []ScrapRecommendation
+}
+
+// GetScrapRecommendationsHandler handles the GetScrapRecommendations query
+type GetScrapRecommendationsHandler struct {
+	shipRepo        navigation.ShipRepository
+	transactionRepo ledger.TransactionRepository
+	clock           shared.Clock
+}
+
+// NewGetScrapRecommendationsHandler creates a new GetScrapRecommendationsHandler.
+// If clock is nil, uses RealClock.
+func NewGetScrapRecommendationsHandler(
+	shipRepo navigation.ShipRepository,
+	transactionRepo ledger.TransactionRepository,
+	clock shared.Clock,
+) *GetScrapRecommendationsHandler {
+	if clock == nil {
+		clock = shared.NewRealClock()
+	}
+	return &GetScrapRecommendationsHandler{
+		shipRepo:        shipRepo,
+		transactionRepo: transactionRepo,
+		clock:           clock,
+	}
+}
+
+// shipLedger is one ship's lifetime ledger activity
+type shipLedger struct {
+	revenue      int
+	costs        int
+	lastActivity time.Time
+}
+
+// Handle executes the GetScrapRecommendations query
+func (h *GetScrapRecommendationsHandler) Handle(ctx context.Context, request common.Request) (common.Response, error) {
+	query, ok := request.(*GetScrapRecommendationsQuery)
+	if !ok {
+		return nil, fmt.Errorf("invalid request type: expected *GetScrapRecommendationsQuery")
+	}
+
+	minIdleDays := query.MinIdleDays
+	if minIdleDays <= 0 {
+		minIdleDays = DefaultScrapMinIdleDays
+	}
+
+	ships, err := h.shipRepo.FindIdleByPlayer(ctx, query.PlayerID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load idle ships: %w", err)
+	}
+
+	transactions, err := h.transactionRepo.FindByPlayer(ctx, query.PlayerID, ledger.QueryOptions{
+		Limit: 0, // No limit - ROI is lifetime
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to query transactions: %w", err)
+	}
+	ledgers := ledgerByShip(transactions)
+
+	now := h.clock.Now()
+	minIdle := time.Duration(minIdleDays) * 24 * time.Hour
+	recommendations := make([]ScrapRecommendation, 0)
+	for _, ship := range ships {
+		activity := ledgers[ship.ShipSymbol()]
+		idleSince := activity.lastActivity
+		if assignment := ship.Assignment(); assignment != nil && assignment.ReleasedAt() != nil && assignment.ReleasedAt().After(idleSince) {
+			idleSince = *assignment.ReleasedAt()
+		}
+		// A ship with neither a release nor a ledger row has no evidence of how
+		// long it has sat; never recommend scrapping on a guess.
+		if idleSince.IsZero() || now.Sub(idleSince) <= minIdle {
+			continue
+		}
+
+		net := activity.revenue - activity.costs
+		if net >= 0 {
+			continue
+		}
+		recommendations = append(recommendations, ScrapRecommendation{
+			ShipSymbol: ship.ShipSymbol(),
+			Frame:      ship.FrameSymbol(),
+			Role:       ship.Role(),
+			IdleSince:  idleSince,
+			IdleDays:   now.Sub(idleSince).Hours() / 24,
+			Revenue:    activity.revenue,
+			Costs:      activity.costs,
+			NetProfit:  net,
+			CargoUnits: ship.CargoUnits(),
+		})
+	}
+
+	sort.Slice(recommendations, func(i, j int) bool {
+		if recommendations[i].NetProfit != recommendations[j].NetProfit {
+			return recommendations[i].NetProfit < recommendations[j].NetProfit
+		}
+		return recommendations[i].ShipSymbol < recommendations[j].ShipSymbol
+	})
+
+	return &GetScrapRecommendationsResponse{
+		MinIdleDays:     minIdleDays,
+		Recommendations: recommendations,
+	}, nil
+}
+
+// ledgerByShip folds transactions into per-ship totals keyed by the
+// ship_symbol metadata; rows without one are fleet-wide and skipped.
+func ledgerByShip(transactions []*ledger.Transaction) map[string]shipLedger {
+	byShip := make(map[string]shipLedger)
+	for _, tx := range transactions {
+		symbol, _ := tx.Metadata()["ship_symbol"].(string)
+		if symbol == "" {
+			continue
+		}
+		entry := byShip[symbol]
+		if tx.IsIncome() {
+			entry.revenue += tx.Amount()
+		} else {
+			entry.costs += -tx.Amount()
+		}
+		if tx.Timestamp().After(entry.lastActivity) {
+			entry.lastActivity = tx.Timestamp()
+		}
+		byShip[symbol] = entry
+	}
+	return byShip
+}
@@ -0,0 +1,109 @@
+package queries
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/andrescamacho/spacetraders-go/internal/domain/ledger"
+	"github.com/andrescamacho/spacetraders-go/internal/domain/navigation"
+	"github.com/andrescamacho/spacetraders-go/internal/domain/shared"
+)
+
+var scrapNow = time.Date(2026, 7, 20, 12, 0, 0, 0, time.UTC)
+
+type scrapFakeShipRepo struct {
+	navigation.ShipRepository
+	idle []*navigation.Ship
+}
+
+func (r *scrapFakeShipRepo) FindIdleByPlayer(context.Context, shared.PlayerID) ([]*navigation.Ship, error) {
+	return r.idle, nil
+}
+
+type scrapFakeTransactionRepo struct {
+	ledger.TransactionRepository
+	transactions []*ledger.Transaction
+}
+
+func (r *scrapFakeTransactionRepo) FindByPlayer(context.Context, shared.PlayerID, ledger.QueryOptions) ([]*ledger.Transaction, error) {
+	return r.transactions, nil
+}
+
+func idleShip(t *testing.T, symbol string, releasedDaysAgo int) *navigation.Ship {
+	t.Helper()
+	loc, err := shared.NewWaypoint("X1-A1", 0, 0)
+	require.NoError(t, err)
+	fuel, err := shared.NewFuel(100, 100)
+	require.NoError(t, err)
+	cargo, err := shared.NewCargo(40, 0, nil)
+	require.NoError(t, err)
+	ship, err := navigation.NewShip(symbol, shared.MustNewPlayerID(1), loc, fuel, 100, 40, cargo, 30, "FRAME_LIGHT_FREIGHTER", "HAULER", nil, navigation.NavStatusDocked)
+	require.NoError(t, err)
+	if releasedDaysAgo > 0 {
+		released := scrapNow.AddDate(0, 0, -releasedDaysAgo)
+		ship.SetAssignment(navigation.NewActiveAssignment("c-1", released.Add(-time.Hour)).Released("done", released))
+	}
+	return ship
+}
+
+func shipTx(t *testing.T, ship string, txType ledger.TransactionType, amount, daysAgo int) *ledger.Transaction {
+	t.Helper()
+	tx, err := ledger.NewTransaction(
+		shared.MustNewPlayerID(1), scrapNow.AddDate(0, 0, -daysAgo), txType,
+		amount, 100000, 100000+amount, "test", map[string]interface{}{"ship_symbol": ship}, "", "", "", "",
+	)
+	require.NoError(t, err)
+	return tx
+}
+
+// Only ships idle past the threshold whose lifetime ledger (purchase included)
+// is under water are recommended, worst first.
+func TestGetScrapRecommendations_RecommendsLongIdleNegativeROIShips(t *testing.T) {
+	ships := &scrapFakeShipRepo{idle: []*navigation.Ship{
+		idleShip(t, "SHIP-LOSER", 10),  // idle 10d, net -20k
+		idleShip(t, "SHIP-WORST", 30),  // idle 30d, net -50k
+		idleShip(t, "SHIP-EARNER", 10), // idle 10d, paid for itself
+		idleShip(t, "SHIP-RECENT", 2),  // net negative but only idle 2d
+		idleShip(t, "SHIP-UNKNOWN", 0), // no release, no ledger rows
+	}}
+	txs := &scrapFakeTransactionRepo{transactions: []*ledger.Transaction{
+		shipTx(t, "SHIP-LOSER", ledger.TransactionTypePurchaseShip, -30000, 40),
+		shipTx(t, "SHIP-LOSER", ledger.TransactionTypeSellCargo, 10000, 12),
+		shipTx(t, "SHIP-WORST", ledger.TransactionTypePurchaseShip, -50000, 60),
+		shipTx(t, "SHIP-EARNER", ledger.TransactionTypePurchaseShip, -30000, 40),
+		shipTx(t, "SHIP-EARNER", ledger.TransactionTypeSellCargo, 45000, 15),
+		shipTx(t, "SHIP-RECENT", ledger.TransactionTypePurchaseShip, -30000, 40),
+	}}
+	handler := NewGetScrapRecommendationsHandler(ships, txs, &shared.MockClock{CurrentTime: scrapNow})
+
+	resp, err := handler.Handle(context.Background(), &GetScrapRecommendationsQuery{PlayerID: shared.MustNewPlayerID(1)})
+	require.NoError(t, err)
+	out := resp.(*GetScrapRecommendationsResponse)
+
+	require.Equal(t, DefaultScrapMinIdleDays, out.MinIdleDays)
+	require.Len(t, out.Recommendations, 2)
+	require.Equal(t, "SHIP-WORST", out.Recommendations[0].ShipSymbol)
+	require.Equal(t, -50000, out.Recommendations[0].NetProfit)
+	require.InDelta(t, 30, out.Recommendations[0].IdleDays, 0.01)
+	require.Equal(t, "SHIP-LOSER", out.Recommendations[1].ShipSymbol)
+	require.Equal(t, 10000, out.Recommendations[1].Revenue)
+	require.Equal(t, 30000, out.Recommendations[1].Costs)
+}
+
+// The last ledger row counts as activity: a ship released long ago but trading
+// manually since is not idle.
+func TestGetScrapRecommendations_RecentLedgerActivityResetsIdleClock(t *testing.T) {
+	ships := &scrapFakeShipRepo{idle: []*navigation.Ship{idleShip(t, "SHIP-A", 30)}}
+	txs := &scrapFakeTransactionRepo{transactions: []*ledger.Transaction{
+		shipTx(t, "SHIP-A", ledger.TransactionTypePurchaseShip, -30000, 60),
+		shipTx(t, "SHIP-A", ledger.TransactionTypeRefuel, -100, 3),
+	}}
+	handler := NewGetScrapRecommendationsHandler(ships, txs, &shared.MockClock{CurrentTime: scrapNow})
+
+	resp, err := handler.Handle(context.Background(), &GetScrapRecommendationsQuery{PlayerID: shared.MustNewPlayerID(1), MinIdleDays: 5})
+	require.NoError(t, err)
+	require.Empty(t, resp.(*GetScrapRecommendationsResponse).Recommendations)
+}
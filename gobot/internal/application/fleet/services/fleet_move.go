@@ -0,0 +1,290 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/andrescamacho/spacetraders-go/internal/application/common"
+	navCmd "github.com/andrescamacho/spacetraders-go/internal/application/ship/commands/navigation"
+	"github.com/andrescamacho/spacetraders-go/internal/domain/navigation"
+	"github.com/andrescamacho/spacetraders-go/internal/domain/routing"
+	"github.com/andrescamacho/spacetraders-go/internal/domain/shared"
+)
+
+const (
+	// DefaultFleetMoveConcurrency caps how many hulls of one bulk move fly at
+	// once. Each hop costs several API calls (orbit, navigate, dock, refuel), so
+	// at the 2 req/s limit a handful of concurrent hulls already fills the budget.
+	DefaultFleetMoveConcurrency = 4
+
+	// DefaultFleetMoveLaunchSpacing staggers departures so a large move does not
+	// open with one burst that drains the limiter for every other container.
+	DefaultFleetMoveLaunchSpacing = 2 * time.Second
+)
+
+// FleetMoveStatus is a ship's state within a bulk move
+type FleetMoveStatus string
+
+const (
+	FleetMoveStarted  FleetMoveStatus = "STARTED"
+	FleetMoveArrived  FleetMoveStatus = "ARRIVED"
+	FleetMoveSkipped  FleetMoveStatus = "SKIPPED" // already at its target
+	FleetMoveFailed   FleetMoveStatus = "FAILED"
+	FleetMoveNoRoute  FleetMoveStatus = "NO_ROUTE" // no reachable target was left for it
+	FleetMoveCanceled FleetMoveStatus = "CANCELED" // ctx ended before it departed
+)
+
+// FleetMoveResult is one ship's outcome. EstimatedSeconds is the routed travel
+// time the assignment was solved on.
+type FleetMoveResult struct {
+	ShipSymbol       string
+	Destination      string
+	EstimatedSeconds int
+	Status           FleetMoveStatus
+	Err              error
+}
+
+// FleetMoveReport summarises a bulk move
+type FleetMoveReport struct {
+	Results               []FleetMoveResult
+	Moved                 int
+	Skipped               int
+	Failed                int
+	TotalEstimatedSeconds int
+}
+
+// FleetMoveProgress receives each ship's status as the move runs: STARTED when
+// it departs, then its terminal status. Called from the moving goroutines, so it
+// must be safe for concurrent use.
+type FleetMoveProgress func(FleetMoveResult)
+
+// routeEstimator plans a ship's route to get its travel time. Satisfied by
+// *ship.RoutePlanner, which asks the routing client.
+type routeEstimator interface {
+	PlanRoute(ctx context.Context, ship *navigation.Ship, destination string, waypoints map[string]*shared.Waypoint, preferCruise bool) (*navigation.Route, error)
+}
+
+// systemWaypointLister loads a system's waypoints for route planning. Satisfied
+// by *persistence.GormWaypointRepository.
+type systemWaypointLister interface {
+	ListBySystem(ctx context.Context, systemSymbol string) ([]*shared.Waypoint, error)
+}
+
+// FleetMoveService repositions a group of ships onto a set of target waypoints
+// in one operation. It gives each ship a distinct target so the fleet's total
+// routed travel time is minimal, then flies the moves concurrently under an API
+// throttle, reporting each ship's progress and failure. Moves go through
+// NavigateRouteCommand, so refuelling and arrival handling are the route
+// executor's as for any single move.
+type FleetMoveService struct {
+	mediator      common.Mediator
+	routes        routeEstimator
+	waypoints     systemWaypointLister
+	clock         shared.Clock
+	concurrency   int
+	launchSpacing time.Duration
+}
+
+// NewFleetMoveService creates a bulk-move service. concurrency <= 0 uses
+// DefaultFleetMoveConcurrency, launchSpacing 0 departs ships back to back, and a
+// nil clock uses RealClock.
+func NewFleetMoveService(
+	mediator common.Mediator,
+	routes routeEstimator,
+	waypoints systemWaypointLister,
+	clock shared.Clock,
+	concurrency int,
+	launchSpacing time.Duration,
+) *FleetMoveService {
+	if clock == nil {
+		clock = shared.NewRealClock()
+	}
+	if concurrency <= 0 {
+		concurrency = DefaultFleetMoveConcurrency
+	}
+	if launchSpacing < 0 {
+		launchSpacing = 0
+	}
+	return &FleetMoveService{
+		mediator:      mediator,
+		routes:        routes,
+		waypoints:     waypoints,
+		clock:         clock,
+		concurrency:   concurrency,
+		launchSpacing: launchSpacing,
+	}
+}
+
+// MoveFleet sends ships to targets, one ship per target, and waits for every
+// move to finish. There must be at least as many targets as ships; surplus
+// targets are left empty. A single ship's failure never stops the others: it
+// is recorded in the report, and only invalid input is returned as an error.
+// progress may be nil.
+func (s *FleetMoveService) MoveFleet(
+	ctx context.Context,
+	playerID shared.PlayerID,
+	ships []*navigation.Ship,
+	targets []string,
+	progress FleetMoveProgress,
+) (*FleetMoveReport, error) {
+	if len(ships) == 0 {
+		return &FleetMoveReport{}, nil
+	}
+	if len(targets) < len(ships) {
+		return nil, fmt.Errorf("fleet move needs a target per ship: %d ships, %d targets", len(ships), len(targets))
+	}
+	if progress == nil {
+		progress = func(FleetMoveResult) {}
+	}
+	logger := common.LoggerFromContext(ctx)
+
+	results := s.assign(ctx, ships, targets)
+
+	report := &FleetMoveReport{Results: results}
+	var wg sync.WaitGroup
+	slots := make(chan struct{}, s.concurrency)
+	launched := 0
+	for i := range results {
+		result := &results[i]
+		switch {
+		case result.Status == FleetMoveNoRoute:
+			progress(*result)
+			continue
+		case ships[i].CurrentLocation().Symbol == result.Destination:
+			result.Status = FleetMoveSkipped
+			progress(*result)
+			continue
+		}
+
+		if launched > 0 && s.launchSpacing > 0 {
+			select {
+			case <-shared.After(s.clock, s.launchSpacing):
+			case <-ctx.Done():
+			}
+		}
+		select {
+		case slots <- struct{}{}:
+		case <-ctx.Done():
+		}
+		if ctx.Err() != nil {
+			result.Status = FleetMoveCanceled
+			result.Err = ctx.Err()
+			progress(*result)
+			continue
+		}
+		launched++
+
+		wg.Add(1)
+		go func(result *FleetMoveResult) {
+			defer wg.Done()
+			defer func() { <-slots }()
+
+			result.Status = FleetMoveStarted
+			progress(*result)
+			logger.Log("INFO", fmt.Sprintf("Fleet move: %s to %s (~%ds)", result.ShipSymbol, result.Destination, result.EstimatedSeconds), map[string]interface{}{
+				"action":      "fleet_move",
+				"ship_symbol": result.ShipSymbol,
+				"destination": result.Destination,
+			})
+
+			_, err := s.mediator.Send(ctx, &navCmd.NavigateRouteCommand{
+				ShipSymbol:  result.ShipSymbol,
+				Destination: result.Destination,
+				PlayerID:    playerID,
+			})
+			if err != nil {
+				result.Status = FleetMoveFailed
+				result.Err = err
+				logger.Log("ERROR", fmt.Sprintf("Fleet move: %s failed to reach %s: %v", result.ShipSymbol, result.Destination, err), nil)
+			} else {
+				result.Status = FleetMoveArrived
+			}
+			progress(*result)
+		}(result)
+	}
+	wg.Wait()
+
+	for _, result := range results {
+		switch result.Status {
+		case FleetMoveArrived:
+			report.Moved++
+			report.TotalEstimatedSeconds += result.EstimatedSeconds
+		case FleetMoveSkipped:
+			report.Skipped++
+		default:
+			report.Failed++
+		}
+	}
+	logger.Log("INFO", fmt.Sprintf("Fleet move complete: %d moved, %d already in place, %d failed", report.Moved, report.Skipped, report.Failed), nil)
+	return report, nil
+}
+
+// assign solves which ship goes to which target by routed travel time. A ship
+// the solver could only give an unreachable target comes back as NO_ROUTE.
+func (s *FleetMoveService) assign(ctx context.Context, ships []*navigation.Ship, targets []string) []FleetMoveResult {
+	waypointsBySystem := make(map[string]map[string]*shared.Waypoint)
+	cost := make([][]float64, len(ships))
+	for i, ship := range ships {
+		system := ship.CurrentLocation().SystemSymbol
+		waypoints, ok := waypointsBySystem[system]
+		if !ok {
+			waypoints = s.loadWaypoints(ctx, system)
+			waypointsBySystem[system] = waypoints
+		}
+		cost[i] = make([]float64, len(targets))
+		for j, target := range targets {
+			cost[i][j] = s.travelSeconds(ctx, ship, target, waypoints)
+		}
+	}
+
+	assignment := routing.SolveAssignment(cost)
+	results := make([]FleetMoveResult, len(ships))
+	for i, ship := range ships {
+		j := assignment[i]
+		results[i] = FleetMoveResult{
+			ShipSymbol:  ship.ShipSymbol(),
+			Destination: targets[j],
+		}
+		if cost[i][j] >= routing.UnreachableCost {
+			results[i].Status = FleetMoveNoRoute
+			results[i].Err = fmt.Errorf("no route from %s to any remaining target", ship.CurrentLocation().Symbol)
+			continue
+		}
+		results[i].EstimatedSeconds = int(cost[i][j])
+	}
+	return results
+}
+
+// loadWaypoints returns a system's waypoints keyed by symbol. A failed load
+// leaves the map empty, which makes every target in the system unreachable.
+func (s *FleetMoveService) loadWaypoints(ctx context.Context, systemSymbol string) map[string]*shared.Waypoint {
+	waypoints := make(map[string]*shared.Waypoint)
+	list, err := s.waypoints.ListBySystem(ctx, systemSymbol)
+	if err != nil {
+		common.LoggerFromContext(ctx).Log("WARNING", fmt.Sprintf("Fleet move: failed to load waypoints for %s: %v", systemSymbol, err), nil)
+		return waypoints
+	}
+	for _, wp := range list {
+		waypoints[wp.Symbol] = wp
+	}
+	return waypoints
+}
+
+// travelSeconds is the routed travel time from the ship to target, 0 when it is
+// already there, or UnreachableCost when the target is outside the ship's
+// system or the router finds no path.
+func (s *FleetMoveService) travelSeconds(ctx context.Context, ship *navigation.Ship, target string, waypoints map[string]*shared.Waypoint) float64 {
+	if ship.CurrentLocation().Symbol == target {
+		return 0
+	}
+	if _, ok := waypoints[target]; !ok {
+		return routing.UnreachableCost
+	}
+	route, err := s.routes.PlanRoute(ctx, ship, target, waypoints, false)
+	if err != nil || route == nil {
+		return routing.UnreachableCost
+	}
+	return float64(route.TotalTravelTime())
+}
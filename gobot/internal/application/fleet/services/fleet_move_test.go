@@ -0,0 +1,131 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+
+	"github.com/andrescamacho/spacetraders-go/internal/application/common"
+	navCmd "github.com/andrescamacho/spacetraders-go/internal/application/ship/commands/navigation"
+	"github.com/andrescamacho/spacetraders-go/internal/domain/navigation"
+	"github.com/andrescamacho/spacetraders-go/internal/domain/shared"
+)
+
+// distanceRoutes prices a route at one second per unit of straight-line distance
+type distanceRoutes struct{}
+
+func (distanceRoutes) PlanRoute(_ context.Context, ship *navigation.Ship, destination string, waypoints map[string]*shared.Waypoint, _ bool) (*navigation.Route, error) {
+	from, to := ship.CurrentLocation(), waypoints[destination]
+	distance := from.DistanceTo(to)
+	segment := navigation.NewRouteSegment(from, to, distance, 0, int(distance), shared.FlightModeCruise, false)
+	return navigation.NewRoute("r", ship.ShipSymbol(), 1, []*navigation.RouteSegment{segment}, 100, false)
+}
+
+type stubWaypoints []*shared.Waypoint
+
+func (s stubWaypoints) ListBySystem(context.Context, string) ([]*shared.Waypoint, error) {
+	return s, nil
+}
+
+type navMediator struct {
+	common.Mediator
+	mu   sync.Mutex
+	sent map[string]string
+	fail map[string]bool
+}
+
+func (m *navMediator) Send(_ context.Context, request common.Request) (common.Response, error) {
+	nav := request.(*navCmd.NavigateRouteCommand)
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.sent[nav.ShipSymbol] = nav.Destination
+	if m.fail[nav.ShipSymbol] {
+		return nil, errors.New("out of fuel")
+	}
+	return nil, nil
+}
+
+func shipAt(t *testing.T, symbol string, location *shared.Waypoint) *navigation.Ship {
+	t.Helper()
+	fuel, _ := shared.NewFuel(100, 100)
+	cargo, _ := shared.NewCargo(40, 0, nil)
+	ship, err := navigation.NewShip(symbol, shared.MustNewPlayerID(1), location, fuel, 100, 40, cargo, 30, "FRAME_LIGHT_FREIGHTER", "HAULER", nil, navigation.NavStatusInOrbit)
+	if err != nil {
+		t.Fatalf("ship: %v", err)
+	}
+	return ship
+}
+
+// Ships are paired with targets to minimise total travel time rather than in
+// input order, a ship already on a target is skipped, and one ship's failure
+// is reported without stopping the rest.
+func TestFleetMoveService_AssignsByTravelTimeAndReportsFailures(t *testing.T) {
+	west, _ := shared.NewWaypoint("X1-A1-WEST", -100, 0)
+	east, _ := shared.NewWaypoint("X1-A1-EAST", 100, 0)
+	north, _ := shared.NewWaypoint("X1-A1-NORTH", 0, 100)
+	south, _ := shared.NewWaypoint("X1-A1-SOUTH", 0, -100)
+	nearWest, _ := shared.NewWaypoint("X1-A1-W2", -90, 0)
+	nearEast, _ := shared.NewWaypoint("X1-A1-E2", 90, 0)
+
+	med := &navMediator{sent: map[string]string{}, fail: map[string]bool{"SHIP-B": true}}
+	service := NewFleetMoveService(med, distanceRoutes{}, stubWaypoints{west, east, north, south, nearWest, nearEast}, nil, 2, 0)
+
+	ships := []*navigation.Ship{
+		shipAt(t, "SHIP-A", nearWest),
+		shipAt(t, "SHIP-B", nearEast),
+		shipAt(t, "SHIP-C", north),
+	}
+	var mu sync.Mutex
+	statuses := map[string][]FleetMoveStatus{}
+	report, err := service.MoveFleet(context.Background(), shared.MustNewPlayerID(1), ships,
+		[]string{"X1-A1-EAST", "X1-A1-WEST", "X1-A1-NORTH"},
+		func(r FleetMoveResult) {
+			mu.Lock()
+			defer mu.Unlock()
+			statuses[r.ShipSymbol] = append(statuses[r.ShipSymbol], r.Status)
+		})
+	if err != nil {
+		t.Fatalf("MoveFleet: %v", err)
+	}
+
+	if med.sent["SHIP-A"] != "X1-A1-WEST" || med.sent["SHIP-B"] != "X1-A1-EAST" {
+		t.Fatalf("expected each ship sent to its nearer target, got %v", med.sent)
+	}
+	if _, moved := med.sent["SHIP-C"]; moved {
+		t.Fatal("a ship already on its target must not be navigated")
+	}
+	if report.Moved != 1 || report.Skipped != 1 || report.Failed != 1 {
+		t.Fatalf("expected 1 moved, 1 skipped, 1 failed, got %+v", report)
+	}
+	if report.TotalEstimatedSeconds != 10 {
+		t.Fatalf("expected 10s of travel for the one arrival, got %d", report.TotalEstimatedSeconds)
+	}
+	if got := statuses["SHIP-B"]; len(got) != 2 || got[0] != FleetMoveStarted || got[1] != FleetMoveFailed {
+		t.Fatalf("expected SHIP-B progress STARTED then FAILED, got %v", got)
+	}
+	if got := statuses["SHIP-C"]; len(got) != 1 || got[0] != FleetMoveSkipped {
+		t.Fatalf("expected SHIP-C progress SKIPPED, got %v", got)
+	}
+}
+
+// A target outside the loaded system is unreachable, and fewer targets than
+// ships is rejected up front.
+func TestFleetMoveService_UnreachableAndShortTargets(t *testing.T) {
+	home, _ := shared.NewWaypoint("X1-A1-HOME", 0, 0)
+	med := &navMediator{sent: map[string]string{}}
+	service := NewFleetMoveService(med, distanceRoutes{}, stubWaypoints{home}, nil, 0, 0)
+	ship := shipAt(t, "SHIP-A", home)
+
+	report, err := service.MoveFleet(context.Background(), shared.MustNewPlayerID(1), []*navigation.Ship{ship}, []string{"X9-Z9-AWAY"}, nil)
+	if err != nil {
+		t.Fatalf("MoveFleet: %v", err)
+	}
+	if report.Results[0].Status != FleetMoveNoRoute || report.Failed != 1 || len(med.sent) != 0 {
+		t.Fatalf("expected NO_ROUTE with no navigation, got %+v sent=%v", report.Results[0], med.sent)
+	}
+
+	if _, err := service.MoveFleet(context.Background(), shared.MustNewPlayerID(1), []*navigation.Ship{ship, ship}, []string{"X1-A1-HOME"}, nil); err == nil {
+		t.Fatal("expected an error when ships outnumber targets")
+	}
+}
@@ -0,0 +1,18 @@
+package commands
+
+import (
+	"context"
+
+	"github.com/andrescamacho/spacetraders-go/internal/application/common"
+)
+
+// logCostBasisFailure reports a cost basis update that did not land; the
+// siphon or transfer it followed already committed on the API.
+func logCostBasisFailure(ctx context.Context, shipSymbol, goodSymbol string, units int, err error) {
+	common.LoggerFromContext(ctx).Log("WARNING", "Failed to update cargo cost basis", map[string]interface{}{
+		"ship_symbol": shipSymbol,
+		"good":        goodSymbol,
+		"units":       units,
+		"error":       err.Error(),
+	})
+}
@@ -25,6 +25,8 @@ type RunGasCoordinatorCommand struct {
 	GasGiant       string   // Waypoint symbol of the gas giant
 	SiphonShips    []string // Ships for siphoning (need siphon mounts + gas processor)
 	StorageShips   []string // Ships that buffer cargo in orbit (stay at gas giant)
+	SiphonShipTag  string   // Optional: also siphon with every ship wearing this tag
+	StorageShipTag string   // Optional: also buffer with every ship wearing this tag
 	ContainerID    string   // Coordinator's own container ID
 	Force          bool     // Override fuel validation warnings
 	DryRun         bool     // If true, only plan routes without starting workers
@@ -49,6 +51,7 @@ type RunGasCoordinatorHandler struct {
 	waypointRepo       system.WaypointRepository
 	storageCoordinator storage.StorageCoordinator
 	clock              domainShared.Clock
+	shipTags           navigation.ShipTagRepository
 }
 
 // NewRunGasCoordinatorHandler creates a new gas coordinator handler
@@ -75,6 +78,12 @@ func NewRunGasCoordinatorHandler(
 	}
 }
 
+// SetShipTagRepository enables the siphon and storage tag selectors. Without it
+// a command naming a tag is rejected.
+func (h *RunGasCoordinatorHandler) SetShipTagRepository(tags navigation.ShipTagRepository) {
+	h.shipTags = tags
+}
+
 // Handle executes the gas coordinator command
 func (h *RunGasCoordinatorHandler) Handle(ctx context.Context, request common.Request) (common.Response, error) {
 	logger := common.LoggerFromContext(ctx)
@@ -84,6 +93,16 @@ func (h *RunGasCoordinatorHandler) Handle(ctx context.Context, request common.Re
 		return nil, fmt.Errorf("invalid request type")
 	}
 
+	siphonShips, err := navigation.ResolveShipSelector(ctx, h.shipTags, cmd.PlayerID, cmd.SiphonShips, cmd.SiphonShipTag)
+	if err != nil {
+		return nil, err
+	}
+	storageShips, err := navigation.ResolveShipSelector(ctx, h.shipTags, cmd.PlayerID, cmd.StorageShips, cmd.StorageShipTag)
+	if err != nil {
+		return nil, err
+	}
+	cmd.SiphonShips, cmd.StorageShips = siphonShips, storageShips
+
 	result := &RunGasCoordinatorResponse{
 		TotalTransfers:      0,
 		TotalUnitsDelivered: 0,
@@ -5,8 +5,10 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/andrescamacho/spacetraders-go/internal/adapters/metrics"
 	"github.com/andrescamacho/spacetraders-go/internal/application/common"
 	shipapp "github.com/andrescamacho/spacetraders-go/internal/application/ship"
+	"github.com/andrescamacho/spacetraders-go/internal/domain/ledger"
 	"github.com/andrescamacho/spacetraders-go/internal/domain/navigation"
 	"github.com/andrescamacho/spacetraders-go/internal/domain/player"
 	domainPorts "github.com/andrescamacho/spacetraders-go/internal/domain/ports"
@@ -33,6 +35,7 @@ type SiphonResourcesHandler struct {
 	playerRepo          player.PlayerRepository
 	apiClient           domainPorts.APIClient
 	shipEventSubscriber navigation.ShipEventSubscriber
+	costBasis           ledger.CargoCostBasisRecorder
 }
 
 // NewSiphonResourcesHandler creates a new siphon resources handler
@@ -53,6 +56,12 @@ func NewSiphonResourcesHandler(
 	}
 }
 
+// SetCostBasisRecorder adds each siphoned yield to the ship's cargo cost basis
+// at zero cost. nil disables the tracking.
+func (h *SiphonResourcesHandler) SetCostBasisRecorder(recorder ledger.CargoCostBasisRecorder) {
+	h.costBasis = recorder
+}
+
 // Handle executes the siphon resources command
 func (h *SiphonResourcesHandler) Handle(ctx context.Context, request common.Request) (common.Response, error) {
 	cmd, ok := request.(*SiphonResourcesCommand)
@@ -93,6 +102,7 @@ func (h *SiphonResourcesHandler) Handle(ctx context.Context, request common.Requ
 	if err != nil {
 		return nil, fmt.Errorf("failed to siphon resources: %w", err)
 	}
+	metrics.RecordExtractionYield(cmd.PlayerID.Value(), result.YieldSymbol, result.YieldUnits)
 
 	if result.Cargo != nil {
 		// Convert CargoData to domain Cargo
@@ -117,6 +127,12 @@ func (h *SiphonResourcesHandler) Handle(ctx context.Context, request common.Requ
 		}
 	}
 
+	if h.costBasis != nil {
+		if err := h.costBasis.RecordAcquisition(ctx, cmd.PlayerID.Value(), cmd.ShipSymbol, result.YieldSymbol, result.YieldUnits, 0); err != nil {
+			logCostBasisFailure(ctx, cmd.ShipSymbol, result.YieldSymbol, result.YieldUnits, err)
+		}
+	}
+
 	return &SiphonResourcesResponse{
 		YieldSymbol:      result.YieldSymbol,
 		YieldUnits:       result.YieldUnits,
@@ -5,6 +5,7 @@ import (
 	"fmt"
 
 	"github.com/andrescamacho/spacetraders-go/internal/application/common"
+	"github.com/andrescamacho/spacetraders-go/internal/domain/ledger"
 	"github.com/andrescamacho/spacetraders-go/internal/domain/navigation"
 	domainPorts "github.com/andrescamacho/spacetraders-go/internal/domain/ports"
 	"github.com/andrescamacho/spacetraders-go/internal/domain/shared"
@@ -29,6 +30,7 @@ type TransferCargoResponse struct {
 type TransferCargoHandler struct {
 	shipRepo  navigation.ShipRepository
 	apiClient domainPorts.APIClient
+	costBasis ledger.CargoCostBasisRecorder
 }
 
 // NewTransferCargoHandler creates a new transfer cargo handler
@@ -42,6 +44,12 @@ func NewTransferCargoHandler(
 	}
 }
 
+// SetCostBasisRecorder moves each transfer's share of the cargo cost basis to
+// the receiving ship. nil disables the tracking.
+func (h *TransferCargoHandler) SetCostBasisRecorder(recorder ledger.CargoCostBasisRecorder) {
+	h.costBasis = recorder
+}
+
 // Handle executes the transfer cargo command
 func (h *TransferCargoHandler) Handle(ctx context.Context, request common.Request) (common.Response, error) {
 	cmd, ok := request.(*TransferCargoCommand)
@@ -106,6 +114,12 @@ func (h *TransferCargoHandler) Handle(ctx context.Context, request common.Reques
 			return true, nil
 		})
 
+	if h.costBasis != nil {
+		if err := h.costBasis.RecordTransfer(ctx, cmd.PlayerID.Value(), cmd.FromShip, cmd.ToShip, cmd.GoodSymbol, transferred); err != nil {
+			logCostBasisFailure(ctx, cmd.FromShip, cmd.GoodSymbol, transferred, err)
+		}
+	}
+
 	return &TransferCargoResponse{
 		UnitsTransferred: result.UnitsTransferred,
 		RemainingCargo:   result.RemainingCargo,
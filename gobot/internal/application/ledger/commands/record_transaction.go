@@ -24,6 +24,7 @@ type RecordTransactionCommand struct {
 	RelatedEntityType string
 	RelatedEntityID   string
 	OperationType     string     // Optional: operation type (e.g., "contract", "arbitrage", "rebalancing", "factory")
+	ContainerID       string     // Optional: container whose operation generated this cashflow
 	Timestamp         *time.Time // Optional: if provided, use this timestamp; otherwise use current time
 
 	// AuthoritativeBalance, when non-nil, is the agent's credit balance as
@@ -149,6 +150,7 @@ func (h *RecordTransactionHandler) Handle(ctx context.Context, request common.Re
 		cmd.RelatedEntityType,
 		cmd.RelatedEntityID,
 		cmd.OperationType,
+		cmd.ContainerID,
 	)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create transaction: %w", err)
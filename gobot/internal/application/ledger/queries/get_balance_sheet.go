@@ -0,0 +1,89 @@
+package queries
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/andrescamacho/spacetraders-go/internal/application/common"
+	"github.com/andrescamacho/spacetraders-go/internal/domain/ledger"
+	"github.com/andrescamacho/spacetraders-go/internal/domain/shared"
+)
+
+// GetBalanceSheetQuery asks for the double-entry position as of a moment,
+// built from every transaction recorded up to it
+type GetBalanceSheetQuery struct {
+	PlayerID int
+	AsOf     time.Time
+}
+
+// GetBalanceSheetResponse is the balance sheet plus the audit of the log it
+// was built from. Sound is false when the books caught a capture bug: a
+// transaction whose sign runs against its type, a gap in the balance chain,
+// or a cash account that does not end at the log's running balance.
+type GetBalanceSheetResponse struct {
+	AsOf           time.Time
+	Sheet          ledger.BalanceSheet
+	Debits         int // trial balance
+	Credits        int
+	CashDifference int // log balance less the cash account
+	Issues         []ledger.BookIssue
+	Sound          bool
+	Transactions   int
+}
+
+// GetBalanceSheetHandler handles the GetBalanceSheet query
+type GetBalanceSheetHandler struct {
+	transactionRepo ledger.TransactionRepository
+}
+
+// NewGetBalanceSheetHandler creates a new GetBalanceSheetHandler
+func NewGetBalanceSheetHandler(transactionRepo ledger.TransactionRepository) *GetBalanceSheetHandler {
+	return &GetBalanceSheetHandler{transactionRepo: transactionRepo}
+}
+
+// Handle executes the GetBalanceSheet query
+func (h *GetBalanceSheetHandler) Handle(ctx context.Context, request common.Request) (common.Response, error) {
+	query, ok := request.(*GetBalanceSheetQuery)
+	if !ok {
+		return nil, fmt.Errorf("invalid request type: expected *GetBalanceSheetQuery")
+	}
+
+	books, count, err := loadBooks(ctx, h.transactionRepo, query.PlayerID, query.AsOf)
+	if err != nil {
+		return nil, err
+	}
+
+	debits, credits := books.TrialBalance()
+	return &GetBalanceSheetResponse{
+		AsOf:           query.AsOf,
+		Sheet:          books.BalanceSheet(),
+		Debits:         debits,
+		Credits:        credits,
+		CashDifference: books.CashDifference(),
+		Issues:         books.Issues(),
+		Sound:          books.IsSound(),
+		Transactions:   count,
+	}, nil
+}
+
+// loadBooks posts every transaction recorded up to end. The books always start
+// from the first transaction: inventory cost and ship book values carry over
+// from before any reporting period.
+func loadBooks(ctx context.Context, repo ledger.TransactionRepository, player int, end time.Time) (*ledger.Books, int, error) {
+	playerID, err := shared.NewPlayerID(player)
+	if err != nil {
+		return nil, 0, fmt.Errorf("invalid player ID: %w", err)
+	}
+
+	transactions, err := repo.FindByPlayer(ctx, playerID, ledger.QueryOptions{
+		EndDate: &end,
+		Limit:   0, // No limit - the books need the whole log
+		OrderBy: "timestamp ASC",
+	})
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to query transactions: %w", err)
+	}
+
+	return ledger.BuildBooks(transactions), len(transactions), nil
+}
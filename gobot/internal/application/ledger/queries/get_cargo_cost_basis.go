@@ -0,0 +1,181 @@
+package queries
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/andrescamacho/spacetraders-go/internal/application/common"
+	"github.com/andrescamacho/spacetraders-go/internal/domain/ledger"
+	"github.com/andrescamacho/spacetraders-go/internal/domain/shared"
+)
+
+const (
+	// DefaultCostBasisLookback bounds how far back purchases are read when
+	// Lookback is unset. Cargo older than this has usually been sold or
+	// re-bought; a basis built from it would be a guess.
+	DefaultCostBasisLookback = 48 * time.Hour
+	// costBasisScanLimit caps the purchase rows read per query.
+	costBasisScanLimit = 500
+)
+
+// GetCargoCostBasisQuery asks what a ship paid per unit for the good it holds.
+// The tracked per-ship basis answers when it covers Units; otherwise the
+// ledger's PURCHASE_CARGO rows for that ship and good are read, most recent
+// first until Units are covered, so the basis describes the cargo most likely
+// still aboard.
+type GetCargoCostBasisQuery struct {
+	PlayerID   int
+	ShipSymbol string
+	GoodSymbol string
+	Units      int           // Units to cover; <=0 => every purchase in the window
+	Lookback   time.Duration // <=0 => DefaultCostBasisLookback
+}
+
+// GetCargoCostBasisResponse is the weighted per-unit cost of the covered units.
+// Tracked is true when the answer came from the tracked basis, which also
+// knows mined and siphoned cargo (at zero cost). From the ledger, Known is
+// false when no purchase of the good by the ship is in the window (mined,
+// siphoned or delivered cargo, or a purchase older than the lookback); PerUnit
+// is then zero.
+type GetCargoCostBasisResponse struct {
+	Known        bool
+	Tracked      bool
+	PerUnit      int
+	UnitsCovered int
+}
+
+// GetCargoCostBasisHandler handles the GetCargoCostBasis query
+type GetCargoCostBasisHandler struct {
+	transactionRepo ledger.TransactionRepository
+	tracked         ledger.CargoCostBasisRepository
+	clock           shared.Clock
+}
+
+// NewGetCargoCostBasisHandler creates a new GetCargoCostBasisHandler. If clock
+// is nil, uses RealClock.
+func NewGetCargoCostBasisHandler(transactionRepo ledger.TransactionRepository, clock shared.Clock) *GetCargoCostBasisHandler {
+	if clock == nil {
+		clock = shared.NewRealClock()
+	}
+	return &GetCargoCostBasisHandler{
+		transactionRepo: transactionRepo,
+		clock:           clock,
+	}
+}
+
+// SetTrackedBasis makes the tracked per-ship basis the first answer, ahead
+// of the ledger scan. nil leaves the ledger as the only source.
+func (h *GetCargoCostBasisHandler) SetTrackedBasis(tracked ledger.CargoCostBasisRepository) {
+	h.tracked = tracked
+}
+
+// Handle executes the GetCargoCostBasis query
+func (h *GetCargoCostBasisHandler) Handle(ctx context.Context, request common.Request) (common.Response, error) {
+	query, ok := request.(*GetCargoCostBasisQuery)
+	if !ok {
+		return nil, fmt.Errorf("invalid request type: expected *GetCargoCostBasisQuery")
+	}
+
+	playerID, err := shared.NewPlayerID(query.PlayerID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid player ID: %w", err)
+	}
+
+	if resp := h.trackedBasis(ctx, query); resp != nil {
+		return resp, nil
+	}
+
+	lookback := query.Lookback
+	if lookback <= 0 {
+		lookback = DefaultCostBasisLookback
+	}
+	since := h.clock.Now().Add(-lookback)
+	txType := ledger.TransactionTypePurchaseCargo
+
+	purchases, err := h.transactionRepo.FindByPlayer(ctx, playerID, ledger.QueryOptions{
+		StartDate:       &since,
+		TransactionType: &txType,
+		Limit:           costBasisScanLimit,
+		OrderBy:         "timestamp DESC",
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to read purchases: %w", err)
+	}
+
+	totalCost := 0
+	covered := 0
+	for _, tx := range purchases {
+		if query.Units > 0 && covered >= query.Units {
+			break
+		}
+		meta := tx.Metadata()
+		if metadataString(meta, "ship_symbol") != query.ShipSymbol || metadataString(meta, "good_symbol") != query.GoodSymbol {
+			continue
+		}
+		units := metadataInt(meta, "units")
+		if units <= 0 {
+			continue
+		}
+		cost := -tx.Amount()
+		if query.Units > 0 && covered+units > query.Units {
+			// Only part of this purchase is still needed: take its share.
+			take := query.Units - covered
+			cost = cost * take / units
+			units = take
+		}
+		totalCost += cost
+		covered += units
+	}
+
+	if covered == 0 {
+		return &GetCargoCostBasisResponse{}, nil
+	}
+	return &GetCargoCostBasisResponse{
+		Known:        true,
+		PerUnit:      (totalCost + covered - 1) / covered,
+		UnitsCovered: covered,
+	}, nil
+}
+
+// trackedBasis answers from the tracked basis when it holds at least the
+// units asked about. A basis that covers fewer — cargo aboard from before
+// tracking began — or an unreadable one defers to the ledger.
+func (h *GetCargoCostBasisHandler) trackedBasis(ctx context.Context, query *GetCargoCostBasisQuery) *GetCargoCostBasisResponse {
+	if h.tracked == nil {
+		return nil
+	}
+	basis, err := h.tracked.Find(ctx, query.PlayerID, query.ShipSymbol, query.GoodSymbol)
+	if err != nil || basis == nil || basis.IsEmpty() || basis.Units < query.Units {
+		return nil
+	}
+	covered := basis.Units
+	if query.Units > 0 {
+		covered = query.Units
+	}
+	return &GetCargoCostBasisResponse{
+		Known:        true,
+		Tracked:      true,
+		PerUnit:      basis.PerUnit(),
+		UnitsCovered: covered,
+	}
+}
+
+func metadataString(meta map[string]interface{}, key string) string {
+	s, _ := meta[key].(string)
+	return s
+}
+
+// metadataInt reads an integer metadata value. Rows written in-process carry
+// an int; rows read back from the JSON column carry a float64.
+func metadataInt(meta map[string]interface{}, key string) int {
+	switch v := meta[key].(type) {
+	case int:
+		return v
+	case int64:
+		return int(v)
+	case float64:
+		return int(v)
+	}
+	return 0
+}
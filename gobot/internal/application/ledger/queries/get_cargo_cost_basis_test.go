@@ -0,0 +1,107 @@
+package queries
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/andrescamacho/spacetraders-go/internal/domain/ledger"
+	"github.com/andrescamacho/spacetraders-go/internal/domain/shared"
+)
+
+func cargoPurchaseTx(t *testing.T, at time.Time, ship, good string, units, cost int) *ledger.Transaction {
+	t.Helper()
+	tx, err := ledger.NewTransaction(
+		shared.MustNewPlayerID(1), at, ledger.TransactionTypePurchaseCargo,
+		-cost, 100000, 100000-cost, "test",
+		map[string]interface{}{"ship_symbol": ship, "good_symbol": good, "units": float64(units)},
+		"", "", "trade", "trade-1",
+	)
+	require.NoError(t, err)
+	return tx
+}
+
+// The newest purchases of the good by the ship are averaged until the asked
+// units are covered; other ships, other goods and the older remainder are not.
+func TestGetCargoCostBasis_WeightsNewestPurchasesCoveringUnits(t *testing.T) {
+	now := time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC)
+	repo := &byOperationFakeRepo{transactions: []*ledger.Transaction{
+		cargoPurchaseTx(t, now.Add(-time.Hour), "SHIP-1", "IRON", 20, 2000),
+		cargoPurchaseTx(t, now.Add(-2*time.Hour), "SHIP-2", "IRON", 20, 9000),
+		cargoPurchaseTx(t, now.Add(-3*time.Hour), "SHIP-1", "COPPER", 20, 9000),
+		cargoPurchaseTx(t, now.Add(-4*time.Hour), "SHIP-1", "IRON", 20, 4000),
+		cargoPurchaseTx(t, now.Add(-5*time.Hour), "SHIP-1", "IRON", 20, 8000),
+	}}
+	handler := NewGetCargoCostBasisHandler(repo, &shared.MockClock{CurrentTime: now})
+
+	resp, err := handler.Handle(context.Background(), &GetCargoCostBasisQuery{
+		PlayerID: 1, ShipSymbol: "SHIP-1", GoodSymbol: "IRON", Units: 30,
+	})
+	require.NoError(t, err)
+	out := resp.(*GetCargoCostBasisResponse)
+
+	// 20 units at 100 plus 10 of the next 20 at 200 → 4000 over 30 units.
+	require.True(t, out.Known)
+	require.Equal(t, 30, out.UnitsCovered)
+	require.Equal(t, 134, out.PerUnit)
+	require.Equal(t, ledger.TransactionTypePurchaseCargo, *repo.lastOpts.TransactionType)
+	require.Equal(t, now.Add(-DefaultCostBasisLookback), *repo.lastOpts.StartDate)
+}
+
+func TestGetCargoCostBasis_UnknownWithoutPurchases(t *testing.T) {
+	handler := NewGetCargoCostBasisHandler(&byOperationFakeRepo{}, nil)
+
+	resp, err := handler.Handle(context.Background(), &GetCargoCostBasisQuery{
+		PlayerID: 1, ShipSymbol: "SHIP-1", GoodSymbol: "IRON", Units: 10,
+	})
+	require.NoError(t, err)
+	require.False(t, resp.(*GetCargoCostBasisResponse).Known)
+}
+
+type fakeTrackedBasis map[string]*ledger.CargoCostBasis
+
+func (f fakeTrackedBasis) Find(_ context.Context, _ int, ship, good string) (*ledger.CargoCostBasis, error) {
+	return f[ship+"/"+good], nil
+}
+
+func (f fakeTrackedBasis) Save(context.Context, *ledger.CargoCostBasis) error { return nil }
+
+// A tracked basis that covers the units answers ahead of the ledger, mined
+// cargo included; one that covers too few defers to the purchases.
+func TestGetCargoCostBasis_PrefersTrackedBasisThatCoversTheUnits(t *testing.T) {
+	now := time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC)
+	repo := &byOperationFakeRepo{transactions: []*ledger.Transaction{
+		cargoPurchaseTx(t, now.Add(-time.Hour), "SHIP-1", "IRON", 20, 2000),
+	}}
+	handler := NewGetCargoCostBasisHandler(repo, &shared.MockClock{CurrentTime: now})
+	handler.SetTrackedBasis(fakeTrackedBasis{
+		"SHIP-1/IRON": {Units: 30, TotalCost: 2000},
+		"SHIP-1/ORE":  {Units: 5},
+	})
+
+	resp, err := handler.Handle(context.Background(), &GetCargoCostBasisQuery{
+		PlayerID: 1, ShipSymbol: "SHIP-1", GoodSymbol: "IRON", Units: 30,
+	})
+	require.NoError(t, err)
+	out := resp.(*GetCargoCostBasisResponse)
+	require.True(t, out.Known && out.Tracked)
+	require.Equal(t, 67, out.PerUnit)
+
+	resp, err = handler.Handle(context.Background(), &GetCargoCostBasisQuery{
+		PlayerID: 1, ShipSymbol: "SHIP-1", GoodSymbol: "ORE", Units: 5,
+	})
+	require.NoError(t, err)
+	out = resp.(*GetCargoCostBasisResponse)
+	require.True(t, out.Known && out.Tracked, "mined cargo has a known zero basis")
+	require.Zero(t, out.PerUnit)
+
+	resp, err = handler.Handle(context.Background(), &GetCargoCostBasisQuery{
+		PlayerID: 1, ShipSymbol: "SHIP-1", GoodSymbol: "IRON", Units: 40,
+	})
+	require.NoError(t, err)
+	out = resp.(*GetCargoCostBasisResponse)
+	require.False(t, out.Tracked, "a basis covering fewer units than asked defers to the ledger")
+	require.Equal(t, 100, out.PerUnit)
+}
@@ -0,0 +1,185 @@
+package queries
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/andrescamacho/spacetraders-go/internal/application/common"
+	"github.com/andrescamacho/spacetraders-go/internal/domain/ledger"
+	"github.com/andrescamacho/spacetraders-go/internal/domain/shared"
+)
+
+// DefaultDailySummaryWindow is the period GetDailySummaryQuery covers when it names none.
+const DefaultDailySummaryWindow = 24 * time.Hour
+
+// arbitrageOperationType is the ledger operation_type the arb_run containers book their
+// buys and sells under.
+const arbitrageOperationType = "arb_run"
+
+// APIOutcomeReader reports API attempts and failures since a point in time (satisfied by
+// the daemon's metrics.DailyActivityTracker).
+type APIOutcomeReader interface {
+	APIOutcomesSince(since time.Time) (requests, failures int)
+}
+
+// ExtractionYieldReader reports a player's extracted units per good since a point in time
+// (satisfied by the daemon's metrics.DailyActivityTracker).
+type ExtractionYieldReader interface {
+	ExtractionYieldsSince(playerID int, since time.Time) map[string]int
+}
+
+// GetDailySummaryQuery asks for the operations digest of one player over the last Window
+// (DefaultDailySummaryWindow when zero).
+type GetDailySummaryQuery struct {
+	PlayerID int
+	Window   time.Duration
+}
+
+// GetDailySummaryResponse is the operations digest: the ledger's money in and out, the
+// arbitrage and contract activity booked in it, extraction yields, and API health.
+type GetDailySummaryResponse struct {
+	Start time.Time
+	End   time.Time
+
+	TotalRevenue     int
+	TotalExpenses    int
+	NetProfit        int
+	TransactionCount int
+
+	ArbitrageRuns    int // distinct arb_run containers that sold in the window
+	ArbitrageSales   int
+	ArbitrageRevenue int
+	ArbitrageCosts   int
+	ArbitrageProfit  int
+
+	ContractsAccepted  int
+	ContractsFulfilled int
+	ContractRevenue    int
+
+	ExtractedUnits int
+	ExtractedGoods map[string]int // good -> units
+
+	APIRequests     int
+	APIFailures     int
+	APIErrorRatePct float64
+}
+
+// GetDailySummaryHandler handles the GetDailySummary query
+type GetDailySummaryHandler struct {
+	transactionRepo ledger.TransactionRepository
+	apiOutcomes     APIOutcomeReader
+	yields          ExtractionYieldReader
+	clock           shared.Clock
+}
+
+// NewGetDailySummaryHandler creates a new GetDailySummaryHandler. apiOutcomes and yields
+// may be nil, which leaves those sections zero. If clock is nil, uses RealClock.
+func NewGetDailySummaryHandler(
+	transactionRepo ledger.TransactionRepository,
+	apiOutcomes APIOutcomeReader,
+	yields ExtractionYieldReader,
+	clock shared.Clock,
+) *GetDailySummaryHandler {
+	if clock == nil {
+		clock = shared.NewRealClock()
+	}
+	return &GetDailySummaryHandler{
+		transactionRepo: transactionRepo,
+		apiOutcomes:     apiOutcomes,
+		yields:          yields,
+		clock:           clock,
+	}
+}
+
+// Handle executes the GetDailySummary query
+func (h *GetDailySummaryHandler) Handle(ctx context.Context, request common.Request) (common.Response, error) {
+	query, ok := request.(*GetDailySummaryQuery)
+	if !ok {
+		return nil, fmt.Errorf("invalid request type: expected *GetDailySummaryQuery")
+	}
+
+	playerID, err := shared.NewPlayerID(query.PlayerID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid player ID: %w", err)
+	}
+
+	window := query.Window
+	if window <= 0 {
+		window = DefaultDailySummaryWindow
+	}
+	end := h.clock.Now()
+	start := end.Add(-window)
+
+	transactions, err := h.transactionRepo.FindByPlayer(ctx, playerID, ledger.QueryOptions{
+		StartDate: &start,
+		EndDate:   &end,
+		Limit:     0, // No limit - get all transactions
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to query transactions: %w", err)
+	}
+
+	summary := summarizeTransactions(transactions)
+	summary.Start = start
+	summary.End = end
+
+	summary.ExtractedGoods = map[string]int{}
+	if h.yields != nil {
+		summary.ExtractedGoods = h.yields.ExtractionYieldsSince(playerID.Value(), start)
+		for _, units := range summary.ExtractedGoods {
+			summary.ExtractedUnits += units
+		}
+	}
+
+	if h.apiOutcomes != nil {
+		summary.APIRequests, summary.APIFailures = h.apiOutcomes.APIOutcomesSince(start)
+		if summary.APIRequests > 0 {
+			summary.APIErrorRatePct = float64(summary.APIFailures) / float64(summary.APIRequests) * 100
+		}
+	}
+
+	return summary, nil
+}
+
+func summarizeTransactions(transactions []*ledger.Transaction) *GetDailySummaryResponse {
+	summary := &GetDailySummaryResponse{TransactionCount: len(transactions)}
+	arbRuns := make(map[string]bool)
+
+	for _, tx := range transactions {
+		amount := tx.Amount()
+		if tx.IsIncome() {
+			summary.TotalRevenue += amount
+		} else {
+			summary.TotalExpenses += -amount
+		}
+
+		switch tx.TransactionType() {
+		case ledger.TransactionTypeContractAccepted:
+			summary.ContractsAccepted++
+			summary.ContractRevenue += amount
+		case ledger.TransactionTypeContractFulfilled:
+			summary.ContractsFulfilled++
+			summary.ContractRevenue += amount
+		}
+
+		if tx.OperationType() != arbitrageOperationType {
+			continue
+		}
+		switch tx.TransactionType() {
+		case ledger.TransactionTypeSellCargo:
+			summary.ArbitrageSales++
+			summary.ArbitrageRevenue += amount
+			if tx.ContainerID() != "" {
+				arbRuns[tx.ContainerID()] = true
+			}
+		case ledger.TransactionTypePurchaseCargo, ledger.TransactionTypeRefuel:
+			summary.ArbitrageCosts += -amount
+		}
+	}
+
+	summary.NetProfit = summary.TotalRevenue - summary.TotalExpenses
+	summary.ArbitrageRuns = len(arbRuns)
+	summary.ArbitrageProfit = summary.ArbitrageRevenue - summary.ArbitrageCosts
+	return summary
+}
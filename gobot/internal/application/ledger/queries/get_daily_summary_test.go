@@ -0,0 +1,88 @@
+package queries
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/andrescamacho/spacetraders-go/internal/domain/ledger"
+	"github.com/andrescamacho/spacetraders-go/internal/domain/shared"
+)
+
+type fakeActivity struct {
+	requests, failures int
+	yields             map[string]int
+	since              time.Time
+}
+
+func (f *fakeActivity) APIOutcomesSince(since time.Time) (int, int) {
+	f.since = since
+	return f.requests, f.failures
+}
+
+func (f *fakeActivity) ExtractionYieldsSince(_ int, _ time.Time) map[string]int {
+	return f.yields
+}
+
+// One digest folds the ledger totals, the arb_run and contract rows inside
+// them, extraction yields and the API error rate over the last 24h.
+func TestGetDailySummary_AggregatesEverySection(t *testing.T) {
+	now := time.Date(2024, 1, 2, 12, 0, 0, 0, time.UTC)
+	repo := &byOperationFakeRepo{transactions: []*ledger.Transaction{
+		attributedTx(t, ledger.TransactionTypePurchaseCargo, -4000, "arb_run", "arb-1"),
+		attributedTx(t, ledger.TransactionTypeSellCargo, 7000, "arb_run", "arb-1"),
+		attributedTx(t, ledger.TransactionTypeSellCargo, 1000, "arb_run", "arb-1"),
+		attributedTx(t, ledger.TransactionTypePurchaseCargo, -2000, "arb_run", "arb-2"),
+		attributedTx(t, ledger.TransactionTypeSellCargo, 1500, "arb_run", "arb-2"),
+		attributedTx(t, ledger.TransactionTypeContractAccepted, 5000, "contract", "c-1"),
+		attributedTx(t, ledger.TransactionTypeContractFulfilled, 20000, "contract", "c-1"),
+		attributedTx(t, ledger.TransactionTypeRefuel, -500, "", ""),
+	}}
+	activity := &fakeActivity{requests: 200, failures: 5, yields: map[string]int{"LIQUID_HYDROGEN": 30, "HYDROCARBON": 12}}
+	handler := NewGetDailySummaryHandler(repo, activity, activity, &shared.MockClock{CurrentTime: now})
+
+	resp, err := handler.Handle(context.Background(), &GetDailySummaryQuery{PlayerID: 1})
+	require.NoError(t, err)
+	out := resp.(*GetDailySummaryResponse)
+
+	start := now.Add(-DefaultDailySummaryWindow)
+	require.Equal(t, start, out.Start)
+	require.Equal(t, now, out.End)
+	require.Equal(t, start, *repo.lastOpts.StartDate)
+	require.Equal(t, start, activity.since)
+
+	require.Equal(t, 34500, out.TotalRevenue)
+	require.Equal(t, 6500, out.TotalExpenses)
+	require.Equal(t, 28000, out.NetProfit)
+	require.Equal(t, 8, out.TransactionCount)
+
+	require.Equal(t, 2, out.ArbitrageRuns)
+	require.Equal(t, 3, out.ArbitrageSales)
+	require.Equal(t, 9500, out.ArbitrageRevenue)
+	require.Equal(t, 6000, out.ArbitrageCosts)
+	require.Equal(t, 3500, out.ArbitrageProfit)
+
+	require.Equal(t, 1, out.ContractsAccepted)
+	require.Equal(t, 1, out.ContractsFulfilled)
+	require.Equal(t, 25000, out.ContractRevenue)
+
+	require.Equal(t, 42, out.ExtractedUnits)
+	require.Equal(t, 200, out.APIRequests)
+	require.Equal(t, 5, out.APIFailures)
+	require.InDelta(t, 2.5, out.APIErrorRatePct, 0.001)
+}
+
+func TestGetDailySummary_NilReadersLeaveSectionsEmpty(t *testing.T) {
+	handler := NewGetDailySummaryHandler(&byOperationFakeRepo{}, nil, nil, nil)
+
+	resp, err := handler.Handle(context.Background(), &GetDailySummaryQuery{PlayerID: 1, Window: time.Hour})
+	require.NoError(t, err)
+	out := resp.(*GetDailySummaryResponse)
+
+	require.Equal(t, time.Hour, out.End.Sub(out.Start))
+	require.Empty(t, out.ExtractedGoods)
+	require.Zero(t, out.APIRequests)
+	require.Zero(t, out.APIErrorRatePct)
+}
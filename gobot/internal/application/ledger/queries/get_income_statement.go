@@ -0,0 +1,60 @@
+package queries
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/andrescamacho/spacetraders-go/internal/application/common"
+	"github.com/andrescamacho/spacetraders-go/internal/domain/ledger"
+)
+
+// GetIncomeStatementQuery asks for the accrual income statement of a period.
+// Unlike GetProfitLoss, which nets cash in against cash out, it books cargo
+// purchases as inventory and charges a sale with the cost of the units sold,
+// and books ship purchases as assets.
+type GetIncomeStatementQuery struct {
+	PlayerID  int
+	StartDate time.Time
+	EndDate   time.Time
+}
+
+// GetIncomeStatementResponse is the period's income statement. Sound and
+// IssueCount summarize the audit of the log up to EndDate (see
+// GetBalanceSheetResponse).
+type GetIncomeStatementResponse struct {
+	Period     string
+	Statement  ledger.IncomeStatement
+	Sound      bool
+	IssueCount int
+}
+
+// GetIncomeStatementHandler handles the GetIncomeStatement query
+type GetIncomeStatementHandler struct {
+	transactionRepo ledger.TransactionRepository
+}
+
+// NewGetIncomeStatementHandler creates a new GetIncomeStatementHandler
+func NewGetIncomeStatementHandler(transactionRepo ledger.TransactionRepository) *GetIncomeStatementHandler {
+	return &GetIncomeStatementHandler{transactionRepo: transactionRepo}
+}
+
+// Handle executes the GetIncomeStatement query
+func (h *GetIncomeStatementHandler) Handle(ctx context.Context, request common.Request) (common.Response, error) {
+	query, ok := request.(*GetIncomeStatementQuery)
+	if !ok {
+		return nil, fmt.Errorf("invalid request type: expected *GetIncomeStatementQuery")
+	}
+
+	books, _, err := loadBooks(ctx, h.transactionRepo, query.PlayerID, query.EndDate)
+	if err != nil {
+		return nil, err
+	}
+
+	return &GetIncomeStatementResponse{
+		Period:     formatPeriod(query.StartDate, query.EndDate),
+		Statement:  books.IncomeStatement(query.StartDate, query.EndDate),
+		Sound:      books.IsSound(),
+		IssueCount: len(books.Issues()),
+	}, nil
+}
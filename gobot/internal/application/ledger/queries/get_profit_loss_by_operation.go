@@ -0,0 +1,167 @@
+package queries
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/andrescamacho/spacetraders-go/internal/application/common"
+	"github.com/andrescamacho/spacetraders-go/internal/domain/ledger"
+	"github.com/andrescamacho/spacetraders-go/internal/domain/shared"
+)
+
+// UnattributedOperation labels transactions recorded without an operation
+// type (rows written before attribution existed).
+const UnattributedOperation = "unattributed"
+
+// GetProfitLossByOperationQuery represents a query for a P&L statement broken
+// down by the operation (and container) that generated each cashflow, so
+// mining, arbitrage, contracts etc. can be compared on net profit.
+type GetProfitLossByOperationQuery struct {
+	PlayerID  int
+	StartDate time.Time
+	EndDate   time.Time
+	// OperationType optionally restricts the report to one operation.
+	OperationType string
+}
+
+// ContainerProfitLoss is one container's share of an operation's P&L.
+type ContainerProfitLoss struct {
+	ContainerID      string
+	Revenue          int
+	Expenses         int // positive
+	NetProfit        int
+	TransactionCount int
+}
+
+// OperationProfitLoss is the P&L for one operation type. Containers lists the
+// containers that contributed, most profitable first; manual transactions
+// carry an empty ContainerID.
+type OperationProfitLoss struct {
+	OperationType    string
+	Revenue          int
+	Expenses         int // positive
+	NetProfit        int
+	TransactionCount int
+	Containers       []ContainerProfitLoss
+}
+
+// GetProfitLossByOperationResponse lists operations most profitable first.
+type GetProfitLossByOperationResponse struct {
+	Period     string
+	Operations []OperationProfitLoss
+	NetProfit  int
+}
+
+// GetProfitLossByOperationHandler handles the GetProfitLossByOperation query
+type GetProfitLossByOperationHandler struct {
+	transactionRepo ledger.TransactionRepository
+}
+
+// NewGetProfitLossByOperationHandler creates a new GetProfitLossByOperationHandler
+func NewGetProfitLossByOperationHandler(transactionRepo ledger.TransactionRepository) *GetProfitLossByOperationHandler {
+	return &GetProfitLossByOperationHandler{
+		transactionRepo: transactionRepo,
+	}
+}
+
+// Handle executes the GetProfitLossByOperation query
+func (h *GetProfitLossByOperationHandler) Handle(ctx context.Context, request common.Request) (common.Response, error) {
+	query, ok := request.(*GetProfitLossByOperationQuery)
+	if !ok {
+		return nil, fmt.Errorf("invalid request type: expected *GetProfitLossByOperationQuery")
+	}
+
+	playerID, err := shared.NewPlayerID(query.PlayerID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid player ID: %w", err)
+	}
+
+	opts := ledger.QueryOptions{
+		StartDate: &query.StartDate,
+		EndDate:   &query.EndDate,
+		Limit:     0, // No limit - get all transactions
+	}
+	if query.OperationType != "" {
+		opts.OperationType = &query.OperationType
+	}
+
+	transactions, err := h.transactionRepo.FindByPlayer(ctx, playerID, opts)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query transactions: %w", err)
+	}
+
+	return h.calculateByOperation(query, transactions), nil
+}
+
+func (h *GetProfitLossByOperationHandler) calculateByOperation(
+	query *GetProfitLossByOperationQuery,
+	transactions []*ledger.Transaction,
+) *GetProfitLossByOperationResponse {
+	type key struct{ operation, container string }
+	operations := make(map[string]*OperationProfitLoss)
+	containers := make(map[key]*ContainerProfitLoss)
+
+	netProfit := 0
+	for _, tx := range transactions {
+		operation := tx.OperationType()
+		if operation == "" {
+			operation = UnattributedOperation
+		}
+
+		op, ok := operations[operation]
+		if !ok {
+			op = &OperationProfitLoss{OperationType: operation}
+			operations[operation] = op
+		}
+		k := key{operation, tx.ContainerID()}
+		c, ok := containers[k]
+		if !ok {
+			c = &ContainerProfitLoss{ContainerID: tx.ContainerID()}
+			containers[k] = c
+		}
+
+		amount := tx.Amount()
+		if tx.IsIncome() {
+			op.Revenue += amount
+			c.Revenue += amount
+		} else {
+			op.Expenses += -amount
+			c.Expenses += -amount
+		}
+		op.NetProfit += amount
+		c.NetProfit += amount
+		op.TransactionCount++
+		c.TransactionCount++
+		netProfit += amount
+	}
+
+	for k, c := range containers {
+		op := operations[k.operation]
+		op.Containers = append(op.Containers, *c)
+	}
+
+	result := make([]OperationProfitLoss, 0, len(operations))
+	for _, op := range operations {
+		sort.Slice(op.Containers, func(i, j int) bool {
+			if op.Containers[i].NetProfit != op.Containers[j].NetProfit {
+				return op.Containers[i].NetProfit > op.Containers[j].NetProfit
+			}
+			return op.Containers[i].ContainerID < op.Containers[j].ContainerID
+		})
+		result = append(result, *op)
+	}
+	sort.Slice(result, func(i, j int) bool {
+		if result[i].NetProfit != result[j].NetProfit {
+			return result[i].NetProfit > result[j].NetProfit
+		}
+		return result[i].OperationType < result[j].OperationType
+	})
+
+	return &GetProfitLossByOperationResponse{
+		Period:     formatPeriod(query.StartDate, query.EndDate),
+		Operations: result,
+		NetProfit:  netProfit,
+	}
+}
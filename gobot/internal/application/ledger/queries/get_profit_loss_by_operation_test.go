@@ -0,0 +1,84 @@
+package queries
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/andrescamacho/spacetraders-go/internal/domain/ledger"
+	"github.com/andrescamacho/spacetraders-go/internal/domain/shared"
+)
+
+type byOperationFakeRepo struct {
+	ledger.TransactionRepository
+	transactions []*ledger.Transaction
+	lastOpts     ledger.QueryOptions
+}
+
+func (r *byOperationFakeRepo) FindByPlayer(_ context.Context, _ shared.PlayerID, opts ledger.QueryOptions) ([]*ledger.Transaction, error) {
+	r.lastOpts = opts
+	return r.transactions, nil
+}
+
+func attributedTx(t *testing.T, txType ledger.TransactionType, amount int, operation, container string) *ledger.Transaction {
+	t.Helper()
+	tx, err := ledger.NewTransaction(
+		shared.MustNewPlayerID(1), time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC), txType,
+		amount, 100000, 100000+amount, "test", nil, "", "", operation, container,
+	)
+	require.NoError(t, err)
+	return tx
+}
+
+// Operations are netted independently and ranked by net profit, each split
+// by the container that recorded the cashflow; rows without an operation
+// type land in the unattributed bucket.
+func TestGetProfitLossByOperation_GroupsByOperationAndContainer(t *testing.T) {
+	repo := &byOperationFakeRepo{transactions: []*ledger.Transaction{
+		attributedTx(t, ledger.TransactionTypePurchaseCargo, -4000, "arbitrage", "arb-1"),
+		attributedTx(t, ledger.TransactionTypeSellCargo, 7000, "arbitrage", "arb-1"),
+		attributedTx(t, ledger.TransactionTypePurchaseCargo, -2000, "arbitrage", "arb-2"),
+		attributedTx(t, ledger.TransactionTypeSellCargo, 1500, "arbitrage", "arb-2"),
+		attributedTx(t, ledger.TransactionTypeRefuel, -300, "mining", "mine-1"),
+		attributedTx(t, ledger.TransactionTypeSellCargo, 100, "mining", "mine-1"),
+		attributedTx(t, ledger.TransactionTypeRefuel, -50, "", ""),
+	}}
+	handler := NewGetProfitLossByOperationHandler(repo)
+
+	resp, err := handler.Handle(context.Background(), &GetProfitLossByOperationQuery{
+		PlayerID:  1,
+		StartDate: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+		EndDate:   time.Date(2024, 1, 31, 0, 0, 0, 0, time.UTC),
+	})
+	require.NoError(t, err)
+	out := resp.(*GetProfitLossByOperationResponse)
+
+	require.Len(t, out.Operations, 3)
+	arb := out.Operations[0]
+	require.Equal(t, "arbitrage", arb.OperationType)
+	require.Equal(t, 8500, arb.Revenue)
+	require.Equal(t, 6000, arb.Expenses)
+	require.Equal(t, 2500, arb.NetProfit)
+	require.Equal(t, 4, arb.TransactionCount)
+	require.Equal(t, []ContainerProfitLoss{
+		{ContainerID: "arb-1", Revenue: 7000, Expenses: 4000, NetProfit: 3000, TransactionCount: 2},
+		{ContainerID: "arb-2", Revenue: 1500, Expenses: 2000, NetProfit: -500, TransactionCount: 2},
+	}, arb.Containers)
+
+	require.Equal(t, UnattributedOperation, out.Operations[1].OperationType)
+	require.Equal(t, "mining", out.Operations[2].OperationType)
+	require.Equal(t, -200, out.Operations[2].NetProfit)
+	require.Equal(t, 2250, out.NetProfit)
+}
+
+func TestGetProfitLossByOperation_ForwardsOperationFilter(t *testing.T) {
+	repo := &byOperationFakeRepo{}
+	handler := NewGetProfitLossByOperationHandler(repo)
+
+	_, err := handler.Handle(context.Background(), &GetProfitLossByOperationQuery{PlayerID: 1, OperationType: "mining"})
+	require.NoError(t, err)
+	require.NotNil(t, repo.lastOpts.OperationType)
+	require.Equal(t, "mining", *repo.lastOpts.OperationType)
+}
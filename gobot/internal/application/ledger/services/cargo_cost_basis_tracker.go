@@ -0,0 +1,106 @@
+package services
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/andrescamacho/spacetraders-go/internal/domain/ledger"
+)
+
+// CargoCostBasisTracker keeps each ship's per-good cost basis current as cargo
+// is bought, mined, moved and sold, so a sale can be judged against what the
+// cargo actually cost. Updates are read-modify-write on the repository and are
+// serialized here, which is enough for one daemon.
+type CargoCostBasisTracker struct {
+	repo ledger.CargoCostBasisRepository
+	now  func() time.Time
+
+	mu sync.Mutex
+}
+
+var _ ledger.CargoCostBasisRecorder = (*CargoCostBasisTracker)(nil)
+
+// NewCargoCostBasisTracker creates a tracker over repo.
+func NewCargoCostBasisTracker(repo ledger.CargoCostBasisRepository) *CargoCostBasisTracker {
+	return &CargoCostBasisTracker{repo: repo, now: time.Now}
+}
+
+// RecordAcquisition adds units bought for totalCost, or mined (totalCost 0).
+func (t *CargoCostBasisTracker) RecordAcquisition(ctx context.Context, playerID int, shipSymbol, goodSymbol string, units, totalCost int) error {
+	if units <= 0 {
+		return nil
+	}
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	basis, err := t.load(ctx, playerID, shipSymbol, goodSymbol)
+	if err != nil {
+		return err
+	}
+	basis.Acquire(units, totalCost)
+	return t.save(ctx, basis)
+}
+
+// RecordRemoval releases units sold or jettisoned.
+func (t *CargoCostBasisTracker) RecordRemoval(ctx context.Context, playerID int, shipSymbol, goodSymbol string, units int) error {
+	if units <= 0 {
+		return nil
+	}
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	basis, err := t.load(ctx, playerID, shipSymbol, goodSymbol)
+	if err != nil || basis.IsEmpty() {
+		return err
+	}
+	basis.Release(units)
+	return t.save(ctx, basis)
+}
+
+// RecordTransfer moves units, with their average share of the source's cost,
+// to the receiving ship. Units the source was never seen to acquire arrive at
+// zero cost.
+func (t *CargoCostBasisTracker) RecordTransfer(ctx context.Context, playerID int, fromShip, toShip, goodSymbol string, units int) error {
+	if units <= 0 {
+		return nil
+	}
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	from, err := t.load(ctx, playerID, fromShip, goodSymbol)
+	if err != nil {
+		return err
+	}
+	to, err := t.load(ctx, playerID, toShip, goodSymbol)
+	if err != nil {
+		return err
+	}
+	to.Acquire(units, from.Release(units))
+	if err := t.save(ctx, from); err != nil {
+		return err
+	}
+	return t.save(ctx, to)
+}
+
+// Basis returns the tracked basis for a ship's good, or nil when none is
+// tracked.
+func (t *CargoCostBasisTracker) Basis(ctx context.Context, playerID int, shipSymbol, goodSymbol string) (*ledger.CargoCostBasis, error) {
+	return t.repo.Find(ctx, playerID, shipSymbol, goodSymbol)
+}
+
+func (t *CargoCostBasisTracker) load(ctx context.Context, playerID int, shipSymbol, goodSymbol string) (*ledger.CargoCostBasis, error) {
+	basis, err := t.repo.Find(ctx, playerID, shipSymbol, goodSymbol)
+	if err != nil {
+		return nil, err
+	}
+	if basis == nil {
+		basis = ledger.NewCargoCostBasis(playerID, shipSymbol, goodSymbol)
+	}
+	return basis, nil
+}
+
+func (t *CargoCostBasisTracker) save(ctx context.Context, basis *ledger.CargoCostBasis) error {
+	basis.UpdatedAt = t.now().UTC()
+	return t.repo.Save(ctx, basis)
+}
@@ -0,0 +1,67 @@
+package services
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/andrescamacho/spacetraders-go/internal/domain/ledger"
+)
+
+type memoryCostBasisRepo map[string]ledger.CargoCostBasis
+
+func (m memoryCostBasisRepo) Find(_ context.Context, _ int, ship, good string) (*ledger.CargoCostBasis, error) {
+	basis, ok := m[ship+"/"+good]
+	if !ok {
+		return nil, nil
+	}
+	return &basis, nil
+}
+
+func (m memoryCostBasisRepo) Save(_ context.Context, basis *ledger.CargoCostBasis) error {
+	key := basis.ShipSymbol + "/" + basis.GoodSymbol
+	if basis.IsEmpty() {
+		delete(m, key)
+		return nil
+	}
+	m[key] = *basis
+	return nil
+}
+
+// Bought and mined units average together, a transfer carries its share of
+// the cost to the receiving hull, and a sale releases units at the average.
+func TestCargoCostBasisTracker_FollowsCargoThroughItsMoves(t *testing.T) {
+	ctx := context.Background()
+	repo := memoryCostBasisRepo{}
+	tracker := NewCargoCostBasisTracker(repo)
+
+	require.NoError(t, tracker.RecordAcquisition(ctx, 1, "MINER-1", "IRON", 10, 1000))
+	require.NoError(t, tracker.RecordAcquisition(ctx, 1, "MINER-1", "IRON", 10, 0))
+	require.NoError(t, tracker.RecordTransfer(ctx, 1, "MINER-1", "HAULER-1", "IRON", 15))
+
+	miner, err := tracker.Basis(ctx, 1, "MINER-1", "IRON")
+	require.NoError(t, err)
+	require.Equal(t, 5, miner.Units)
+	require.Equal(t, 250, miner.TotalCost)
+
+	hauler, err := tracker.Basis(ctx, 1, "HAULER-1", "IRON")
+	require.NoError(t, err)
+	require.Equal(t, 15, hauler.Units)
+	require.Equal(t, 50, hauler.PerUnit())
+
+	require.NoError(t, tracker.RecordRemoval(ctx, 1, "HAULER-1", "IRON", 15))
+	hauler, err = tracker.Basis(ctx, 1, "HAULER-1", "IRON")
+	require.NoError(t, err)
+	require.Nil(t, hauler, "a hold sold empty drops its basis")
+}
+
+// Selling cargo the tracker never saw arrive is not an error and leaves
+// nothing behind.
+func TestCargoCostBasisTracker_RemovalOfUntrackedCargoIsANoOp(t *testing.T) {
+	repo := memoryCostBasisRepo{}
+	tracker := NewCargoCostBasisTracker(repo)
+
+	require.NoError(t, tracker.RecordRemoval(context.Background(), 1, "SHIP-1", "FUEL", 40))
+	require.Empty(t, repo)
+}
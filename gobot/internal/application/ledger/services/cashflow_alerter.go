@@ -0,0 +1,121 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/andrescamacho/spacetraders-go/internal/domain/ledger"
+	"github.com/andrescamacho/spacetraders-go/internal/domain/shared"
+)
+
+// DefaultCashflowAlertCooldown is how long a threshold stays quiet after firing
+// while it remains breached, so a losing loop pages once an hour rather than on
+// every check.
+const DefaultCashflowAlertCooldown = time.Hour
+
+// CashflowAlerter checks the ledger against cashflow thresholds and hands every
+// breach to its sinks. A threshold fires when it first breaches, then at most
+// once per cooldown while it stays breached; recovering re-arms it.
+type CashflowAlerter struct {
+	transactionRepo ledger.TransactionRepository
+	thresholds      []ledger.CashflowThreshold
+	sinks           []ledger.CashflowAlertSink
+	clock           shared.Clock
+	cooldown        time.Duration
+
+	mu        sync.Mutex
+	lastFired map[string]time.Time // threshold name -> last alert sent
+}
+
+// NewCashflowAlerter validates the thresholds and builds an alerter. cooldown <= 0
+// selects DefaultCashflowAlertCooldown; a nil clock defaults to the real clock.
+func NewCashflowAlerter(
+	transactionRepo ledger.TransactionRepository,
+	thresholds []ledger.CashflowThreshold,
+	sinks []ledger.CashflowAlertSink,
+	clock shared.Clock,
+	cooldown time.Duration,
+) (*CashflowAlerter, error) {
+	seen := make(map[string]bool, len(thresholds))
+	for _, t := range thresholds {
+		if err := t.Validate(); err != nil {
+			return nil, err
+		}
+		if seen[t.Name] {
+			return nil, fmt.Errorf("duplicate cashflow threshold name %q", t.Name)
+		}
+		seen[t.Name] = true
+	}
+	if clock == nil {
+		clock = shared.NewRealClock()
+	}
+	if cooldown <= 0 {
+		cooldown = DefaultCashflowAlertCooldown
+	}
+	return &CashflowAlerter{
+		transactionRepo: transactionRepo,
+		thresholds:      thresholds,
+		sinks:           sinks,
+		clock:           clock,
+		cooldown:        cooldown,
+		lastFired:       make(map[string]time.Time),
+	}, nil
+}
+
+// Check evaluates every threshold for the player and returns the alerts it raised.
+// A failing sink does not stop the other sinks: its error is joined into the
+// returned error alongside the raised alerts.
+func (a *CashflowAlerter) Check(ctx context.Context, playerID shared.PlayerID) ([]ledger.CashflowAlert, error) {
+	if len(a.thresholds) == 0 {
+		return nil, nil
+	}
+	now := a.clock.Now()
+
+	var longest time.Duration
+	for _, t := range a.thresholds {
+		longest = max(longest, t.Window)
+	}
+	start := now.Add(-longest)
+	transactions, err := a.transactionRepo.FindByPlayer(ctx, playerID, ledger.QueryOptions{
+		StartDate: &start,
+		EndDate:   &now,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to load transactions: %w", err)
+	}
+
+	var sent []ledger.CashflowAlert
+	var sinkErrs []error
+	for _, threshold := range a.thresholds {
+		alert, breached := threshold.Evaluate(playerID, transactions, now)
+		if !a.shouldFire(threshold.Name, breached, now) {
+			continue
+		}
+		for _, sink := range a.sinks {
+			if err := sink.Send(ctx, alert); err != nil {
+				sinkErrs = append(sinkErrs, fmt.Errorf("cashflow alert %q: %w", threshold.Name, err))
+			}
+		}
+		sent = append(sent, alert)
+	}
+	return sent, errors.Join(sinkErrs...)
+}
+
+// shouldFire records the threshold's state and reports whether a breach is due
+// to be sent.
+func (a *CashflowAlerter) shouldFire(name string, breached bool, now time.Time) bool {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	if !breached {
+		delete(a.lastFired, name)
+		return false
+	}
+	if last, ok := a.lastFired[name]; ok && now.Sub(last) < a.cooldown {
+		return false
+	}
+	a.lastFired[name] = now
+	return true
+}
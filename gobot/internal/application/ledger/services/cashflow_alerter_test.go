@@ -0,0 +1,136 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/andrescamacho/spacetraders-go/internal/domain/ledger"
+	"github.com/andrescamacho/spacetraders-go/internal/domain/shared"
+)
+
+type alerterFakeRepo struct {
+	ledger.TransactionRepository
+	transactions []*ledger.Transaction
+}
+
+func (r *alerterFakeRepo) FindByPlayer(_ context.Context, _ shared.PlayerID, _ ledger.QueryOptions) ([]*ledger.Transaction, error) {
+	return r.transactions, nil
+}
+
+type recordingSink struct {
+	alerts []ledger.CashflowAlert
+	err    error
+}
+
+func (s *recordingSink) Send(_ context.Context, alert ledger.CashflowAlert) error {
+	s.alerts = append(s.alerts, alert)
+	return s.err
+}
+
+func alerterTx(t *testing.T, at time.Time, txType ledger.TransactionType, amount int) *ledger.Transaction {
+	t.Helper()
+	tx, err := ledger.NewTransaction(
+		shared.MustNewPlayerID(1), at, txType,
+		amount, 1000000, 1000000+amount, "test", nil, "", "", "", "",
+	)
+	require.NoError(t, err)
+	return tx
+}
+
+var alerterNow = time.Date(2024, 3, 1, 12, 0, 0, 0, time.UTC)
+
+func hourlyLossThreshold() ledger.CashflowThreshold {
+	return ledger.CashflowThreshold{Name: "hourly-loss", Window: time.Hour, MinNetPerHour: -50000}
+}
+
+// A losing hour fires once, stays quiet through the cooldown, and re-fires after it.
+func TestCashflowAlerter_FiresOnBreachAndRespectsCooldown(t *testing.T) {
+	repo := &alerterFakeRepo{transactions: []*ledger.Transaction{
+		alerterTx(t, alerterNow.Add(-20*time.Minute), ledger.TransactionTypePurchaseCargo, -90000),
+		alerterTx(t, alerterNow.Add(-10*time.Minute), ledger.TransactionTypeSellCargo, 20000),
+		alerterTx(t, alerterNow.Add(-3*time.Hour), ledger.TransactionTypeSellCargo, 500000),
+	}}
+	sink := &recordingSink{}
+	clock := &shared.MockClock{CurrentTime: alerterNow}
+	alerter, err := NewCashflowAlerter(repo, []ledger.CashflowThreshold{hourlyLossThreshold()}, []ledger.CashflowAlertSink{sink}, clock, 30*time.Minute)
+	require.NoError(t, err)
+
+	sent, err := alerter.Check(context.Background(), shared.MustNewPlayerID(1))
+	require.NoError(t, err)
+	require.Len(t, sent, 1)
+	require.Equal(t, -70000, sent[0].NetPerHour, "the sale three hours ago is outside the window")
+	require.Equal(t, 20000, sent[0].Window.Inflow)
+	require.Equal(t, 90000, sent[0].Window.Outflow)
+	require.Len(t, sink.alerts, 1)
+
+	clock.CurrentTime = alerterNow.Add(10 * time.Minute)
+	sent, err = alerter.Check(context.Background(), shared.MustNewPlayerID(1))
+	require.NoError(t, err)
+	require.Empty(t, sent, "still inside the cooldown")
+
+	clock.CurrentTime = alerterNow.Add(35 * time.Minute)
+	sent, err = alerter.Check(context.Background(), shared.MustNewPlayerID(1))
+	require.NoError(t, err)
+	require.Len(t, sent, 1, "cooldown elapsed while still breached")
+}
+
+// A threshold that recovers re-arms, so the next breach fires without waiting out the cooldown.
+func TestCashflowAlerter_RecoveryRearmsThreshold(t *testing.T) {
+	repo := &alerterFakeRepo{transactions: []*ledger.Transaction{
+		alerterTx(t, alerterNow.Add(-5*time.Minute), ledger.TransactionTypePurchaseCargo, -80000),
+	}}
+	sink := &recordingSink{}
+	clock := &shared.MockClock{CurrentTime: alerterNow}
+	alerter, err := NewCashflowAlerter(repo, []ledger.CashflowThreshold{hourlyLossThreshold()}, []ledger.CashflowAlertSink{sink}, clock, time.Hour)
+	require.NoError(t, err)
+
+	_, err = alerter.Check(context.Background(), shared.MustNewPlayerID(1))
+	require.NoError(t, err)
+
+	repo.transactions = nil
+	clock.CurrentTime = alerterNow.Add(5 * time.Minute)
+	_, err = alerter.Check(context.Background(), shared.MustNewPlayerID(1))
+	require.NoError(t, err)
+
+	repo.transactions = []*ledger.Transaction{alerterTx(t, alerterNow.Add(8*time.Minute), ledger.TransactionTypePurchaseCargo, -80000)}
+	clock.CurrentTime = alerterNow.Add(10 * time.Minute)
+	_, err = alerter.Check(context.Background(), shared.MustNewPlayerID(1))
+	require.NoError(t, err)
+	require.Len(t, sink.alerts, 2)
+}
+
+// Excluded categories never count toward the window, and a failing sink is
+// reported without stopping the others.
+func TestCashflowAlerter_ExcludedCategoriesAndFailingSink(t *testing.T) {
+	repo := &alerterFakeRepo{transactions: []*ledger.Transaction{
+		alerterTx(t, alerterNow.Add(-20*time.Minute), ledger.TransactionTypePurchaseShip, -400000),
+		alerterTx(t, alerterNow.Add(-15*time.Minute), ledger.TransactionTypeRefuel, -60000),
+	}}
+	failing := &recordingSink{err: errors.New("webhook down")}
+	healthy := &recordingSink{}
+	excludingShips := ledger.CashflowThreshold{
+		Name: "operations", Window: time.Hour, MinNetPerHour: -100000,
+		ExcludeCategories: []ledger.Category{ledger.CategoryShipInvestments},
+	}
+	alerter, err := NewCashflowAlerter(repo, []ledger.CashflowThreshold{excludingShips, hourlyLossThreshold()},
+		[]ledger.CashflowAlertSink{failing, healthy}, &shared.MockClock{CurrentTime: alerterNow}, 0)
+	require.NoError(t, err)
+
+	sent, err := alerter.Check(context.Background(), shared.MustNewPlayerID(1))
+	require.ErrorContains(t, err, "webhook down")
+	require.Len(t, sent, 1)
+	require.Equal(t, "hourly-loss", sent[0].Threshold.Name)
+	require.Len(t, healthy.alerts, 1)
+}
+
+func TestNewCashflowAlerter_RejectsInvalidThresholds(t *testing.T) {
+	_, err := NewCashflowAlerter(nil, []ledger.CashflowThreshold{{Name: "x"}}, nil, nil, 0)
+	require.ErrorContains(t, err, "window must be positive")
+
+	_, err = NewCashflowAlerter(nil, []ledger.CashflowThreshold{hourlyLossThreshold(), hourlyLossThreshold()}, nil, nil, 0)
+	require.ErrorContains(t, err, "duplicate")
+}
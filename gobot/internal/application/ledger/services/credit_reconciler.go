@@ -0,0 +1,177 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/andrescamacho/spacetraders-go/internal/adapters/metrics"
+	"github.com/andrescamacho/spacetraders-go/internal/application/common"
+	ledgerCommands "github.com/andrescamacho/spacetraders-go/internal/application/ledger/commands"
+	"github.com/andrescamacho/spacetraders-go/internal/domain/ledger"
+	"github.com/andrescamacho/spacetraders-go/internal/domain/player"
+	"github.com/andrescamacho/spacetraders-go/internal/domain/shared"
+)
+
+// ReconciliationOperationType attributes balance adjustments in the ledger.
+const ReconciliationOperationType = "reconciliation"
+
+// creditsFetchTimeout bounds the GetAgent call. The shared client retries with
+// backoff that ignores ctx, so an outage would otherwise hold the check for
+// minutes; the next check simply tries again.
+const creditsFetchTimeout = 10 * time.Second
+
+// AgentCreditsAPI is the slice of the SpaceTraders client the reconciler needs
+// to read the agent's live credits.
+type AgentCreditsAPI interface {
+	GetAgent(ctx context.Context, token string) (*player.AgentData, error)
+}
+
+// CreditReconciliation is the outcome of one check.
+type CreditReconciliation struct {
+	APICredits    int
+	LedgerBalance int
+	// Drift is APICredits - LedgerBalance: positive when the ledger missed
+	// income, negative when it missed spend.
+	Drift int
+	// Pending is true when a drift was seen for the first time and is waiting
+	// for the next check to confirm it.
+	Pending bool
+	// Adjusted is true when a BALANCE_ADJUSTMENT row of Drift was booked.
+	Adjusted bool
+}
+
+// CreditReconciler compares the API's agent credits with the ledger's running
+// balance (the newest transaction's balance_after) and books any gap beyond the
+// tolerance as a BALANCE_ADJUSTMENT, so the ledger re-anchors to the API and
+// the leak is visible as its own category rather than hidden in the next row's
+// re-anchor.
+//
+// A drift is only booked once two consecutive checks see the same drift over
+// the same newest transaction. A sale whose API call has returned but whose
+// ledger row is still being written looks exactly like a leak for a moment;
+// requiring the drift to hold still with the ledger unchanged keeps those out.
+type CreditReconciler struct {
+	transactionRepo ledger.TransactionRepository
+	playerRepo      player.PlayerRepository
+	agentAPI        AgentCreditsAPI
+	mediator        common.Mediator
+	tolerance       int
+
+	mu      sync.Mutex
+	pending map[int]pendingDrift // player id -> drift awaiting confirmation
+}
+
+type pendingDrift struct {
+	latestID ledger.TransactionID
+	drift    int
+}
+
+// NewCreditReconciler creates a reconciler. Drifts whose magnitude is at most
+// tolerance are reported but not booked.
+func NewCreditReconciler(
+	transactionRepo ledger.TransactionRepository,
+	playerRepo player.PlayerRepository,
+	agentAPI AgentCreditsAPI,
+	mediator common.Mediator,
+	tolerance int,
+) *CreditReconciler {
+	if tolerance < 0 {
+		tolerance = 0
+	}
+	return &CreditReconciler{
+		transactionRepo: transactionRepo,
+		playerRepo:      playerRepo,
+		agentAPI:        agentAPI,
+		mediator:        mediator,
+		tolerance:       tolerance,
+		pending:         make(map[int]pendingDrift),
+	}
+}
+
+// Reconcile runs one check for the player. A player with no ledger rows yet
+// has no running balance to compare and is left alone.
+func (r *CreditReconciler) Reconcile(ctx context.Context, playerID shared.PlayerID) (CreditReconciliation, error) {
+	latest, err := r.latestTransaction(ctx, playerID)
+	if err != nil || latest == nil {
+		return CreditReconciliation{}, err
+	}
+
+	p, err := r.playerRepo.FindByID(ctx, playerID)
+	if err != nil {
+		return CreditReconciliation{}, fmt.Errorf("failed to load player: %w", err)
+	}
+	fetchCtx, cancel := context.WithTimeout(ctx, creditsFetchTimeout)
+	agent, err := r.agentAPI.GetAgent(fetchCtx, p.Token)
+	cancel()
+	if err != nil {
+		return CreditReconciliation{}, fmt.Errorf("failed to fetch agent credits: %w", err)
+	}
+
+	result := CreditReconciliation{
+		APICredits:    agent.Credits,
+		LedgerBalance: latest.BalanceAfter(),
+		Drift:         agent.Credits - latest.BalanceAfter(),
+	}
+	metrics.RecordCreditDrift(playerID.Value(), result.Drift)
+
+	if !r.confirmed(playerID.Value(), latest.ID(), result.Drift) {
+		result.Pending = result.Drift < -r.tolerance || result.Drift > r.tolerance
+		return result, nil
+	}
+
+	credits := agent.Credits
+	if _, err := r.mediator.Send(ctx, &ledgerCommands.RecordTransactionCommand{
+		PlayerID:             playerID.Value(),
+		TransactionType:      string(ledger.TransactionTypeBalanceAdjustment),
+		Amount:               result.Drift,
+		AuthoritativeBalance: &credits,
+		Description: fmt.Sprintf("Credit reconciliation: API %d, ledger %d (%+d)",
+			result.APICredits, result.LedgerBalance, result.Drift),
+		Metadata: map[string]interface{}{
+			"agent":          p.AgentSymbol,
+			"api_credits":    result.APICredits,
+			"ledger_balance": result.LedgerBalance,
+		},
+		OperationType: ReconciliationOperationType,
+	}); err != nil {
+		return result, fmt.Errorf("failed to record balance adjustment: %w", err)
+	}
+	metrics.RecordCreditAdjustment(playerID.Value(), result.Drift)
+	result.Adjusted = true
+	return result, nil
+}
+
+// confirmed records the drift seen over latestID and reports whether it
+// repeats the previous check's, i.e. is due to be booked. A drift within
+// tolerance clears any pending one.
+func (r *CreditReconciler) confirmed(playerID int, latestID ledger.TransactionID, drift int) bool {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if drift >= -r.tolerance && drift <= r.tolerance {
+		delete(r.pending, playerID)
+		return false
+	}
+	prev, ok := r.pending[playerID]
+	if ok && prev.latestID == latestID && prev.drift == drift {
+		delete(r.pending, playerID)
+		return true
+	}
+	r.pending[playerID] = pendingDrift{latestID: latestID, drift: drift}
+	return false
+}
+
+func (r *CreditReconciler) latestTransaction(ctx context.Context, playerID shared.PlayerID) (*ledger.Transaction, error) {
+	rows, err := r.transactionRepo.FindByPlayer(ctx, playerID, ledger.QueryOptions{
+		Limit:   1,
+		OrderBy: "timestamp DESC",
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to load latest transaction: %w", err)
+	}
+	if len(rows) == 0 {
+		return nil, nil
+	}
+	return rows[0], nil
+}
@@ -0,0 +1,118 @@
+package services
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/andrescamacho/spacetraders-go/internal/application/common"
+	ledgerCommands "github.com/andrescamacho/spacetraders-go/internal/application/ledger/commands"
+	"github.com/andrescamacho/spacetraders-go/internal/domain/ledger"
+	"github.com/andrescamacho/spacetraders-go/internal/domain/player"
+	"github.com/andrescamacho/spacetraders-go/internal/domain/shared"
+)
+
+type reconcilerFakePlayers struct {
+	player.PlayerRepository
+}
+
+func (reconcilerFakePlayers) FindByID(_ context.Context, id shared.PlayerID) (*player.Player, error) {
+	return player.NewPlayer(id, "AGENT", "tok"), nil
+}
+
+type reconcilerFakeAPI struct{ credits int }
+
+func (a *reconcilerFakeAPI) GetAgent(_ context.Context, token string) (*player.AgentData, error) {
+	return &player.AgentData{Symbol: "AGENT", Credits: a.credits}, nil
+}
+
+type reconcilerRecordingMediator struct {
+	common.Mediator
+	recorded []*ledgerCommands.RecordTransactionCommand
+}
+
+func (m *reconcilerRecordingMediator) Send(_ context.Context, request common.Request) (common.Response, error) {
+	m.recorded = append(m.recorded, request.(*ledgerCommands.RecordTransactionCommand))
+	return &ledgerCommands.RecordTransactionResponse{}, nil
+}
+
+func (m *reconcilerRecordingMediator) Register(reflect.Type, common.RequestHandler) error { return nil }
+
+func newTestReconciler(t *testing.T, ledgerBalance, apiCredits, tolerance int) (*CreditReconciler, *alerterFakeRepo, *reconcilerFakeAPI, *reconcilerRecordingMediator) {
+	t.Helper()
+	latest, err := ledger.NewTransaction(
+		shared.MustNewPlayerID(1), alerterNow, ledger.TransactionTypeSellCargo,
+		500, ledgerBalance-500, ledgerBalance, "test", nil, "", "", "", "",
+	)
+	require.NoError(t, err)
+	repo := &alerterFakeRepo{transactions: []*ledger.Transaction{latest}}
+	api := &reconcilerFakeAPI{credits: apiCredits}
+	med := &reconcilerRecordingMediator{}
+	return NewCreditReconciler(repo, reconcilerFakePlayers{}, api, med, tolerance), repo, api, med
+}
+
+// A drift is held for one check and booked on the second when it repeats over
+// the same newest transaction, re-anchoring the ledger to the API's credits.
+func TestCreditReconciler_BooksDriftConfirmedTwice(t *testing.T) {
+	reconciler, _, _, med := newTestReconciler(t, 100000, 99250, 0)
+	ctx := context.Background()
+	pid := shared.MustNewPlayerID(1)
+
+	first, err := reconciler.Reconcile(ctx, pid)
+	require.NoError(t, err)
+	require.Equal(t, -750, first.Drift)
+	require.True(t, first.Pending)
+	require.False(t, first.Adjusted)
+	require.Empty(t, med.recorded)
+
+	second, err := reconciler.Reconcile(ctx, pid)
+	require.NoError(t, err)
+	require.True(t, second.Adjusted)
+	require.Len(t, med.recorded, 1)
+
+	cmd := med.recorded[0]
+	require.Equal(t, string(ledger.TransactionTypeBalanceAdjustment), cmd.TransactionType)
+	require.Equal(t, -750, cmd.Amount)
+	require.Equal(t, 99250, *cmd.AuthoritativeBalance)
+	require.Equal(t, ReconciliationOperationType, cmd.OperationType)
+}
+
+// A drift that moves between checks (a transaction still being recorded) is
+// never booked on the strength of one sighting.
+func TestCreditReconciler_ChangingDriftRestartsConfirmation(t *testing.T) {
+	reconciler, _, api, med := newTestReconciler(t, 100000, 101000, 0)
+	ctx := context.Background()
+	pid := shared.MustNewPlayerID(1)
+
+	_, err := reconciler.Reconcile(ctx, pid)
+	require.NoError(t, err)
+	api.credits = 102000
+	result, err := reconciler.Reconcile(ctx, pid)
+	require.NoError(t, err)
+	require.True(t, result.Pending)
+	require.Empty(t, med.recorded)
+}
+
+// Drift within tolerance is reported but not booked, and an empty ledger has
+// no balance to reconcile against.
+func TestCreditReconciler_ToleranceAndEmptyLedger(t *testing.T) {
+	reconciler, repo, _, med := newTestReconciler(t, 100000, 100040, 50)
+	ctx := context.Background()
+	pid := shared.MustNewPlayerID(1)
+
+	for i := 0; i < 2; i++ {
+		result, err := reconciler.Reconcile(ctx, pid)
+		require.NoError(t, err)
+		require.Equal(t, 40, result.Drift)
+		require.False(t, result.Pending)
+		require.False(t, result.Adjusted)
+	}
+	require.Empty(t, med.recorded)
+
+	repo.transactions = nil
+	result, err := reconciler.Reconcile(ctx, pid)
+	require.NoError(t, err)
+	require.Equal(t, CreditReconciliation{}, result)
+}
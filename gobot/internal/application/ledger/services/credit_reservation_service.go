@@ -0,0 +1,203 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/andrescamacho/spacetraders-go/internal/application/common"
+	"github.com/andrescamacho/spacetraders-go/internal/domain/ledger"
+	"github.com/andrescamacho/spacetraders-go/internal/domain/shared"
+)
+
+// DefaultCreditReservationTTL is how long a reservation holds credits when the
+// holder never releases it. It comfortably covers one purchase round trip.
+const DefaultCreditReservationTTL = 2 * time.Minute
+
+// CreditReservationService earmarks credits for coordinators about to buy, so
+// two of them reading the same live balance cannot both spend it. Reservations
+// are served from memory (one daemon owns the treasury) and lapse after the
+// TTL; expired entries are dropped lazily on every call. With a repository
+// attached (WithRepository) every reservation is written through and Load
+// restores the unexpired ones at boot, so a restart does not silently free
+// credits that were held when the daemon went down. Repository writes happen
+// outside the lock, so a slow write only delays its own caller; expired rows
+// are swept from the repository by the next Reserve or Release.
+type CreditReservationService struct {
+	clock shared.Clock
+	ttl   time.Duration
+	repo  ledger.CreditReservationRepository
+
+	mu      sync.Mutex
+	entries map[string]*ledger.CreditReservation
+	// sweepDue is set when entries expired in memory, so the next call with a
+	// context deletes their rows too.
+	sweepDue bool
+}
+
+var _ ledger.CreditReservations = (*CreditReservationService)(nil)
+
+// NewCreditReservationService creates a service whose reservations expire
+// after ttl (DefaultCreditReservationTTL when ttl <= 0). clock may be nil.
+func NewCreditReservationService(ttl time.Duration, clock shared.Clock) *CreditReservationService {
+	if ttl <= 0 {
+		ttl = DefaultCreditReservationTTL
+	}
+	if clock == nil {
+		clock = shared.NewRealClock()
+	}
+	return &CreditReservationService{
+		clock:   clock,
+		ttl:     ttl,
+		entries: make(map[string]*ledger.CreditReservation),
+	}
+}
+
+// WithRepository writes reservations through to repo and returns the service
+// for chaining. Called once at wiring time, before Load.
+func (s *CreditReservationService) WithRepository(repo ledger.CreditReservationRepository) *CreditReservationService {
+	s.repo = repo
+	return s
+}
+
+// Load restores the unexpired reservations from the repository and purges the
+// expired ones. It is a no-op without a repository.
+func (s *CreditReservationService) Load(ctx context.Context) error {
+	if s.repo == nil {
+		return nil
+	}
+	now := s.clock.Now()
+	if err := s.repo.DeleteExpired(ctx, now); err != nil {
+		return fmt.Errorf("failed to purge expired credit reservations: %w", err)
+	}
+	active, err := s.repo.FindActive(ctx, now)
+	if err != nil {
+		return fmt.Errorf("failed to load credit reservations: %w", err)
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, reservation := range active {
+		s.entries[reservation.ID] = reservation
+	}
+	return nil
+}
+
+// Reserve earmarks amount for holder when liveCredits less the player's other
+// active reservations covers it. The reservation counts against concurrent
+// callers from the moment it passes the check; one the repository then cannot
+// record is withdrawn and refused rather than held in memory only.
+func (s *CreditReservationService) Reserve(ctx context.Context, playerID int, holder string, amount, liveCredits int) (*ledger.CreditReservation, error) {
+	s.mu.Lock()
+	now := s.clock.Now()
+	s.pruneLocked(now)
+
+	reserved := s.reservedLocked(playerID, "")
+	if liveCredits-reserved < amount {
+		s.mu.Unlock()
+		s.sweepExpired(ctx, now)
+		return nil, &ledger.ErrInsufficientUnreservedCredits{Need: amount, Credits: liveCredits, Reserved: reserved}
+	}
+
+	reservation := &ledger.CreditReservation{
+		ID:        "credits-" + uuid.NewString(),
+		PlayerID:  playerID,
+		Holder:    holder,
+		Amount:    amount,
+		ExpiresAt: now.Add(s.ttl),
+	}
+	s.entries[reservation.ID] = reservation
+	copied := *reservation
+	s.mu.Unlock()
+
+	s.sweepExpired(ctx, now)
+	if s.repo != nil {
+		if err := s.repo.Save(ctx, &copied); err != nil {
+			s.mu.Lock()
+			delete(s.entries, reservation.ID)
+			s.mu.Unlock()
+			return nil, fmt.Errorf("failed to record credit reservation for %s: %w", holder, err)
+		}
+	}
+	return &copied, nil
+}
+
+// Release frees a reservation before it expires. A row the repository fails to
+// delete is logged and left to lapse at its expiry.
+func (s *CreditReservationService) Release(ctx context.Context, id string) {
+	s.mu.Lock()
+	delete(s.entries, id)
+	now := s.clock.Now()
+	s.pruneLocked(now)
+	s.mu.Unlock()
+
+	if s.repo == nil {
+		return
+	}
+	s.sweepExpired(ctx, now)
+	if err := s.repo.Delete(ctx, id); err != nil {
+		common.LoggerFromContext(ctx).Log("WARNING", "Failed to delete released credit reservation", map[string]interface{}{
+			"reservation_id": id,
+			"error":          err.Error(),
+		})
+	}
+}
+
+// ReservedExcept totals the player's active reservations other than exceptID.
+func (s *CreditReservationService) ReservedExcept(playerID int, exceptID string) int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.pruneLocked(s.clock.Now())
+	return s.reservedLocked(playerID, exceptID)
+}
+
+// ActiveCount returns the number of unexpired reservations (for monitoring).
+func (s *CreditReservationService) ActiveCount() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.pruneLocked(s.clock.Now())
+	return len(s.entries)
+}
+
+func (s *CreditReservationService) pruneLocked(now time.Time) {
+	for id, r := range s.entries {
+		if r.Expired(now) {
+			delete(s.entries, id)
+			s.sweepDue = s.repo != nil
+		}
+	}
+}
+
+// sweepExpired deletes the repository rows of reservations that expired
+// without being released, once pruneLocked has seen one lapse. A failed sweep
+// is logged and retried by a later call; expired rows never count anyway.
+func (s *CreditReservationService) sweepExpired(ctx context.Context, now time.Time) {
+	s.mu.Lock()
+	due := s.sweepDue
+	s.sweepDue = false
+	s.mu.Unlock()
+	if !due {
+		return
+	}
+	if err := s.repo.DeleteExpired(ctx, now); err != nil {
+		s.mu.Lock()
+		s.sweepDue = true
+		s.mu.Unlock()
+		common.LoggerFromContext(ctx).Log("WARNING", "Failed to sweep expired credit reservations", map[string]interface{}{
+			"error": err.Error(),
+		})
+	}
+}
+
+func (s *CreditReservationService) reservedLocked(playerID int, exceptID string) int {
+	total := 0
+	for id, r := range s.entries {
+		if r.PlayerID == playerID && id != exceptID {
+			total += r.Amount
+		}
+	}
+	return total
+}
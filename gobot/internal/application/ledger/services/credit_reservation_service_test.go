@@ -0,0 +1,195 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/andrescamacho/spacetraders-go/internal/domain/ledger"
+	"github.com/andrescamacho/spacetraders-go/internal/domain/shared"
+)
+
+// A second holder reading the same live balance cannot reserve what the first
+// already holds, and gets it once the first releases.
+func TestCreditReservationService_SecondHolderSeesFirstReservation(t *testing.T) {
+	svc := NewCreditReservationService(time.Minute, &shared.MockClock{CurrentTime: time.Now()})
+
+	first, err := svc.Reserve(context.Background(), 1, "trade-route:A", 70_000, 100_000)
+	require.NoError(t, err)
+
+	_, err = svc.Reserve(context.Background(), 1, "shipyard:B", 50_000, 100_000)
+	var short *ledger.ErrInsufficientUnreservedCredits
+	require.ErrorAs(t, err, &short)
+	require.Equal(t, 70_000, short.Reserved)
+
+	require.Equal(t, 0, svc.ReservedExcept(1, first.ID), "a holder never counts its own reservation")
+	require.Equal(t, 0, svc.ReservedExcept(2, ""), "reservations are per player")
+
+	svc.Release(context.Background(), first.ID)
+	_, err = svc.Reserve(context.Background(), 1, "shipyard:B", 50_000, 100_000)
+	require.NoError(t, err)
+}
+
+// An unreleased reservation stops holding credits once its TTL passes.
+func TestCreditReservationService_ReservationsExpire(t *testing.T) {
+	clock := &shared.MockClock{CurrentTime: time.Now()}
+	svc := NewCreditReservationService(time.Minute, clock)
+
+	_, err := svc.Reserve(context.Background(), 1, "arb:A", 90_000, 100_000)
+	require.NoError(t, err)
+	require.Equal(t, 90_000, svc.ReservedExcept(1, ""))
+
+	clock.Advance(time.Minute)
+	require.Equal(t, 0, svc.ReservedExcept(1, ""))
+	require.Equal(t, 0, svc.ActiveCount())
+}
+
+type memCreditReservationRepo struct {
+	rows    map[string]ledger.CreditReservation
+	saveErr error
+}
+
+func (m *memCreditReservationRepo) Save(_ context.Context, r *ledger.CreditReservation) error {
+	if m.saveErr != nil {
+		return m.saveErr
+	}
+	m.rows[r.ID] = *r
+	return nil
+}
+
+func (m *memCreditReservationRepo) Delete(_ context.Context, id string) error {
+	delete(m.rows, id)
+	return nil
+}
+
+func (m *memCreditReservationRepo) FindActive(_ context.Context, now time.Time) ([]*ledger.CreditReservation, error) {
+	var out []*ledger.CreditReservation
+	for _, r := range m.rows {
+		if !r.Expired(now) {
+			copied := r
+			out = append(out, &copied)
+		}
+	}
+	return out, nil
+}
+
+func (m *memCreditReservationRepo) DeleteExpired(_ context.Context, now time.Time) error {
+	for id, r := range m.rows {
+		if r.Expired(now) {
+			delete(m.rows, id)
+		}
+	}
+	return nil
+}
+
+// A reservation held when the daemon stops is held again by the service the
+// next boot loads; a released one is not.
+func TestCreditReservationService_ReloadsPersistedReservations(t *testing.T) {
+	ctx := context.Background()
+	clock := &shared.MockClock{CurrentTime: time.Now()}
+	repo := &memCreditReservationRepo{rows: map[string]ledger.CreditReservation{}}
+
+	before := NewCreditReservationService(time.Minute, clock).WithRepository(repo)
+	_, err := before.Reserve(ctx, 1, "trade-route:A", 60_000, 100_000)
+	require.NoError(t, err)
+	released, err := before.Reserve(ctx, 1, "shipyard:B", 10_000, 100_000)
+	require.NoError(t, err)
+	before.Release(ctx, released.ID)
+
+	after := NewCreditReservationService(time.Minute, clock).WithRepository(repo)
+	require.NoError(t, after.Load(ctx))
+	require.Equal(t, 60_000, after.ReservedExcept(1, ""))
+
+	_, err = after.Reserve(ctx, 1, "arb:C", 50_000, 100_000)
+	var short *ledger.ErrInsufficientUnreservedCredits
+	require.ErrorAs(t, err, &short, "the reloaded reservation still holds its credits")
+}
+
+// A reservation the repository cannot record is refused, not held in memory.
+func TestCreditReservationService_RefusesUnrecordedReservation(t *testing.T) {
+	repo := &memCreditReservationRepo{rows: map[string]ledger.CreditReservation{}, saveErr: errors.New("db down")}
+	svc := NewCreditReservationService(time.Minute, nil).WithRepository(repo)
+
+	_, err := svc.Reserve(context.Background(), 1, "arb:A", 10_000, 100_000)
+	require.Error(t, err)
+	require.Equal(t, 0, svc.ActiveCount())
+}
+
+// A reservation that lapses without a release has its row swept by the next
+// call, not left in the repository until a restart.
+func TestCreditReservationService_SweepsExpiredRows(t *testing.T) {
+	ctx := context.Background()
+	clock := &shared.MockClock{CurrentTime: time.Now()}
+	repo := &memCreditReservationRepo{rows: map[string]ledger.CreditReservation{}}
+	svc := NewCreditReservationService(time.Minute, clock).WithRepository(repo)
+
+	_, err := svc.Reserve(ctx, 1, "arb:A", 10_000, 100_000)
+	require.NoError(t, err)
+	clock.Advance(time.Minute)
+
+	kept, err := svc.Reserve(ctx, 1, "arb:B", 10_000, 100_000)
+	require.NoError(t, err)
+	require.Len(t, repo.rows, 1)
+	require.Contains(t, repo.rows, kept.ID)
+}
+
+// gatedCreditReservationRepo blocks the save of holder "slow" until gate
+// closes, standing in for a stalled database write.
+type gatedCreditReservationRepo struct {
+	memCreditReservationRepo
+	mu      sync.Mutex
+	gate    chan struct{}
+	entered chan struct{}
+}
+
+func (g *gatedCreditReservationRepo) Save(ctx context.Context, r *ledger.CreditReservation) error {
+	if r.Holder == "slow" {
+		close(g.entered)
+		<-g.gate
+	}
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	return g.memCreditReservationRepo.Save(ctx, r)
+}
+
+// A stalled write holds up only its own caller: another purchaser reserves
+// meanwhile, and still sees the stalled reservation's credits as taken.
+func TestCreditReservationService_SlowSaveDoesNotBlockOthers(t *testing.T) {
+	ctx := context.Background()
+	repo := &gatedCreditReservationRepo{
+		memCreditReservationRepo: memCreditReservationRepo{rows: map[string]ledger.CreditReservation{}},
+		gate:                     make(chan struct{}),
+		entered:                  make(chan struct{}),
+	}
+	svc := NewCreditReservationService(time.Minute, nil).WithRepository(repo)
+
+	done := make(chan error, 1)
+	go func() {
+		_, err := svc.Reserve(ctx, 1, "slow", 60_000, 100_000)
+		done <- err
+	}()
+	<-repo.entered
+
+	reserved := make(chan error, 1)
+	go func() {
+		_, err := svc.Reserve(ctx, 1, "fast", 30_000, 100_000)
+		reserved <- err
+	}()
+	select {
+	case err := <-reserved:
+		require.NoError(t, err)
+	case <-time.After(5 * time.Second):
+		t.Fatal("a slow save blocked another Reserve")
+	}
+
+	_, err := svc.Reserve(ctx, 1, "late", 20_000, 100_000)
+	var short *ledger.ErrInsufficientUnreservedCredits
+	require.ErrorAs(t, err, &short, "the reservation still being written holds its credits")
+
+	close(repo.gate)
+	require.NoError(t, <-done)
+}
@@ -0,0 +1,147 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/andrescamacho/spacetraders-go/internal/application/common"
+	"github.com/andrescamacho/spacetraders-go/internal/domain/container"
+	"github.com/andrescamacho/spacetraders-go/internal/domain/shared"
+)
+
+// pruneBatchSize is how many log lines are archived and deleted at a time. It
+// bounds both the memory a prune holds and the size of one archive file.
+const pruneBatchSize = 5000
+
+// PruneLogsCommand applies the container log retention policy: lines older
+// than MaxAge go, then each container is cut back to its newest
+// MaxRowsPerContainer lines. At least one limit must be set. When the handler
+// has an archive, every line is written there before it is deleted.
+type PruneLogsCommand struct {
+	MaxAge              time.Duration // <=0 => no age limit
+	MaxRowsPerContainer int           // <=0 => no per-container cap
+}
+
+// PruneLogsResponse reports what one prune removed.
+type PruneLogsResponse struct {
+	Deleted      int64
+	Archived     int
+	ArchiveFiles []string
+	// CappedContainers is how many containers were over MaxRowsPerContainer.
+	CappedContainers int
+}
+
+// PruneLogsHandler handles the PruneLogs command
+type PruneLogsHandler struct {
+	repo    container.LogRetentionRepository
+	archive container.LogArchive
+	clock   shared.Clock
+}
+
+// NewPruneLogsHandler creates a new PruneLogsHandler. A nil archive deletes
+// expired lines without keeping them. If clock is nil, uses RealClock.
+func NewPruneLogsHandler(repo container.LogRetentionRepository, archive container.LogArchive, clock shared.Clock) *PruneLogsHandler {
+	if clock == nil {
+		clock = shared.NewRealClock()
+	}
+	return &PruneLogsHandler{repo: repo, archive: archive, clock: clock}
+}
+
+// Handle executes the PruneLogs command. An archive failure stops the prune
+// before anything unarchived is deleted; lines already pruned stay pruned and
+// the response counts them.
+func (h *PruneLogsHandler) Handle(ctx context.Context, request common.Request) (common.Response, error) {
+	cmd, ok := request.(*PruneLogsCommand)
+	if !ok {
+		return nil, fmt.Errorf("invalid request type: expected *PruneLogsCommand")
+	}
+	if cmd.MaxAge <= 0 && cmd.MaxRowsPerContainer <= 0 {
+		return nil, fmt.Errorf("prune logs needs MaxAge or MaxRowsPerContainer")
+	}
+
+	response := &PruneLogsResponse{}
+	if cmd.MaxAge > 0 {
+		if err := h.pruneByAge(ctx, h.clock.Now().Add(-cmd.MaxAge), response); err != nil {
+			return response, err
+		}
+	}
+	if cmd.MaxRowsPerContainer > 0 {
+		if err := h.pruneByRowCap(ctx, cmd.MaxRowsPerContainer, response); err != nil {
+			return response, err
+		}
+	}
+	return response, nil
+}
+
+func (h *PruneLogsHandler) pruneByAge(ctx context.Context, cutoff time.Time, response *PruneLogsResponse) error {
+	for {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		batch, err := h.repo.FindLogsBefore(ctx, cutoff, pruneBatchSize)
+		if err != nil {
+			return fmt.Errorf("failed to read expired logs: %w", err)
+		}
+		if err := h.prune(ctx, batch, response); err != nil {
+			return err
+		}
+		if len(batch) < pruneBatchSize {
+			return nil
+		}
+	}
+}
+
+func (h *PruneLogsHandler) pruneByRowCap(ctx context.Context, maxRows int, response *PruneLogsResponse) error {
+	volumes, err := h.repo.FindContainersOverLogCap(ctx, maxRows)
+	if err != nil {
+		return fmt.Errorf("failed to count container logs: %w", err)
+	}
+	response.CappedContainers = len(volumes)
+
+	for _, volume := range volumes {
+		for excess := volume.Rows - maxRows; excess > 0; {
+			if err := ctx.Err(); err != nil {
+				return err
+			}
+			batch, err := h.repo.FindOldestLogs(ctx, volume.ContainerID, volume.PlayerID, min(excess, pruneBatchSize))
+			if err != nil {
+				return fmt.Errorf("failed to read logs of container %s: %w", volume.ContainerID, err)
+			}
+			if len(batch) == 0 {
+				break
+			}
+			if err := h.prune(ctx, batch, response); err != nil {
+				return err
+			}
+			excess -= len(batch)
+		}
+	}
+	return nil
+}
+
+// prune archives batch, when there is an archive, then deletes it.
+func (h *PruneLogsHandler) prune(ctx context.Context, batch []container.LogRecord, response *PruneLogsResponse) error {
+	if len(batch) == 0 {
+		return nil
+	}
+	if h.archive != nil {
+		path, err := h.archive.Write(ctx, batch)
+		if err != nil {
+			return fmt.Errorf("failed to archive %d log lines: %w", len(batch), err)
+		}
+		response.Archived += len(batch)
+		response.ArchiveFiles = append(response.ArchiveFiles, path)
+	}
+
+	ids := make([]int, len(batch))
+	for i, record := range batch {
+		ids[i] = record.ID
+	}
+	deleted, err := h.repo.DeleteLogs(ctx, ids)
+	response.Deleted += deleted
+	if err != nil {
+		return fmt.Errorf("failed to delete %d log lines: %w", len(batch), err)
+	}
+	return nil
+}
@@ -0,0 +1,143 @@
+package commands
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/andrescamacho/spacetraders-go/internal/domain/container"
+	"github.com/andrescamacho/spacetraders-go/internal/domain/shared"
+)
+
+// memoryLogRepo keeps log lines in a slice; IDs are assigned in timestamp order.
+type memoryLogRepo struct {
+	records []container.LogRecord
+}
+
+func (r *memoryLogRepo) sorted() []container.LogRecord {
+	out := append([]container.LogRecord{}, r.records...)
+	sort.Slice(out, func(i, j int) bool { return out[i].Timestamp.Before(out[j].Timestamp) })
+	return out
+}
+
+func (r *memoryLogRepo) FindLogsBefore(ctx context.Context, cutoff time.Time, limit int) ([]container.LogRecord, error) {
+	var out []container.LogRecord
+	for _, rec := range r.sorted() {
+		if rec.Timestamp.Before(cutoff) && len(out) < limit {
+			out = append(out, rec)
+		}
+	}
+	return out, nil
+}
+
+func (r *memoryLogRepo) FindContainersOverLogCap(ctx context.Context, maxRows int) ([]container.LogVolume, error) {
+	counts := map[string]int{}
+	for _, rec := range r.records {
+		counts[rec.ContainerID]++
+	}
+	var out []container.LogVolume
+	for id, n := range counts {
+		if n > maxRows {
+			out = append(out, container.LogVolume{ContainerID: id, PlayerID: 1, Rows: n})
+		}
+	}
+	return out, nil
+}
+
+func (r *memoryLogRepo) FindOldestLogs(ctx context.Context, containerID string, playerID int, limit int) ([]container.LogRecord, error) {
+	var out []container.LogRecord
+	for _, rec := range r.sorted() {
+		if rec.ContainerID == containerID && len(out) < limit {
+			out = append(out, rec)
+		}
+	}
+	return out, nil
+}
+
+func (r *memoryLogRepo) DeleteLogs(ctx context.Context, ids []int) (int64, error) {
+	gone := map[int]bool{}
+	for _, id := range ids {
+		gone[id] = true
+	}
+	kept := r.records[:0]
+	for _, rec := range r.records {
+		if !gone[rec.ID] {
+			kept = append(kept, rec)
+		}
+	}
+	deleted := int64(len(r.records) - len(kept))
+	r.records = kept
+	return deleted, nil
+}
+
+type recordingArchive struct {
+	written []container.LogRecord
+	err     error
+}
+
+func (a *recordingArchive) Write(ctx context.Context, records []container.LogRecord) (string, error) {
+	if a.err != nil {
+		return "", a.err
+	}
+	a.written = append(a.written, records...)
+	return "archive.jsonl.zst", nil
+}
+
+func seedLogs(now time.Time) *memoryLogRepo {
+	repo := &memoryLogRepo{}
+	id := 0
+	add := func(containerID string, age time.Duration) {
+		id++
+		repo.records = append(repo.records, container.LogRecord{ID: id, ContainerID: containerID, PlayerID: 1, Timestamp: now.Add(-age)})
+	}
+	add("busy", 30*24*time.Hour) // past the age limit
+	for i := 4; i >= 1; i-- {
+		add("busy", time.Duration(i)*time.Hour)
+	}
+	add("quiet", time.Hour)
+	return repo
+}
+
+// Age goes first, then each container is cut to its newest lines; everything
+// deleted was archived first.
+func TestPruneLogsHandler_AgeThenRowCap(t *testing.T) {
+	clock := &shared.MockClock{CurrentTime: time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC)}
+	repo := seedLogs(clock.CurrentTime)
+	archive := &recordingArchive{}
+	handler := NewPruneLogsHandler(repo, archive, clock)
+
+	resp, err := handler.Handle(context.Background(), &PruneLogsCommand{MaxAge: 14 * 24 * time.Hour, MaxRowsPerContainer: 2})
+	if err != nil {
+		t.Fatalf("Handle: %v", err)
+	}
+	result := resp.(*PruneLogsResponse)
+	if result.Deleted != 3 || result.Archived != 3 || len(archive.written) != 3 || result.CappedContainers != 1 {
+		t.Fatalf("unexpected result %+v (archived %d)", result, len(archive.written))
+	}
+	var left []int
+	for _, rec := range repo.records {
+		left = append(left, rec.ID)
+	}
+	if len(left) != 3 || left[0] != 4 || left[1] != 5 || left[2] != 6 {
+		t.Fatalf("expected the two newest busy lines and the quiet line to remain, got ids %v", left)
+	}
+}
+
+// A failed archive write leaves the lines in the database.
+func TestPruneLogsHandler_ArchiveFailureDeletesNothing(t *testing.T) {
+	clock := &shared.MockClock{CurrentTime: time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC)}
+	repo := seedLogs(clock.CurrentTime)
+	handler := NewPruneLogsHandler(repo, &recordingArchive{err: errors.New("disk full")}, clock)
+
+	if _, err := handler.Handle(context.Background(), &PruneLogsCommand{MaxAge: 14 * 24 * time.Hour}); err == nil {
+		t.Fatal("expected the archive error")
+	}
+	if len(repo.records) != 6 {
+		t.Fatalf("nothing may be deleted when archiving fails, %d lines left", len(repo.records))
+	}
+	if _, err := handler.Handle(context.Background(), &PruneLogsCommand{}); err == nil {
+		t.Fatal("a command with no limit should be rejected")
+	}
+}
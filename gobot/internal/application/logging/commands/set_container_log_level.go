@@ -0,0 +1,59 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/andrescamacho/spacetraders-go/internal/application/common"
+	"github.com/andrescamacho/spacetraders-go/internal/domain/container"
+)
+
+// SetContainerLogLevelCommand changes the log level of one running container,
+// e.g. to turn a single misbehaving coordinator up to DEBUG without touching
+// the rest of the fleet.
+type SetContainerLogLevelCommand struct {
+	ContainerID string
+	Level       string // DEBUG, INFO, WARNING (or WARN) or ERROR, any case
+}
+
+// SetContainerLogLevelResponse reports the change.
+type SetContainerLogLevelResponse struct {
+	ContainerID   string
+	PreviousLevel string
+	Level         string
+}
+
+// SetContainerLogLevelHandler handles the SetContainerLogLevel command
+type SetContainerLogLevelHandler struct {
+	setter container.LogLevelSetter
+}
+
+// NewSetContainerLogLevelHandler creates a new SetContainerLogLevelHandler
+func NewSetContainerLogLevelHandler(setter container.LogLevelSetter) *SetContainerLogLevelHandler {
+	return &SetContainerLogLevelHandler{setter: setter}
+}
+
+// Handle validates the level and applies it to the container
+func (h *SetContainerLogLevelHandler) Handle(ctx context.Context, request common.Request) (common.Response, error) {
+	cmd, ok := request.(*SetContainerLogLevelCommand)
+	if !ok {
+		return nil, fmt.Errorf("invalid request type: expected *SetContainerLogLevelCommand")
+	}
+	if cmd.ContainerID == "" {
+		return nil, fmt.Errorf("container id is required")
+	}
+	level, err := container.NormalizeLogLevel(cmd.Level)
+	if err != nil {
+		return nil, err
+	}
+
+	previous, err := h.setter.SetContainerLogLevel(ctx, cmd.ContainerID, level)
+	if err != nil {
+		return nil, err
+	}
+	return &SetContainerLogLevelResponse{
+		ContainerID:   cmd.ContainerID,
+		PreviousLevel: previous,
+		Level:         level,
+	}, nil
+}
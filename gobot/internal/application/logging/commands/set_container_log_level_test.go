@@ -0,0 +1,44 @@
+package commands
+
+import (
+	"context"
+	"testing"
+)
+
+type recordingLogLevelSetter struct {
+	containerID, level string
+}
+
+func (s *recordingLogLevelSetter) SetContainerLogLevel(ctx context.Context, containerID, level string) (string, error) {
+	s.containerID, s.level = containerID, level
+	return "INFO", nil
+}
+
+func TestSetContainerLogLevel_NormalizesAndApplies(t *testing.T) {
+	setter := &recordingLogLevelSetter{}
+	handler := NewSetContainerLogLevelHandler(setter)
+
+	resp, err := handler.Handle(context.Background(), &SetContainerLogLevelCommand{ContainerID: "c-1", Level: "debug"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	out := resp.(*SetContainerLogLevelResponse)
+	if setter.containerID != "c-1" || setter.level != "DEBUG" {
+		t.Errorf("setter got (%q, %q), want (c-1, DEBUG)", setter.containerID, setter.level)
+	}
+	if out.PreviousLevel != "INFO" || out.Level != "DEBUG" {
+		t.Errorf("response = %+v, want INFO -> DEBUG", out)
+	}
+}
+
+func TestSetContainerLogLevel_RejectsUnknownLevel(t *testing.T) {
+	setter := &recordingLogLevelSetter{}
+	handler := NewSetContainerLogLevelHandler(setter)
+
+	if _, err := handler.Handle(context.Background(), &SetContainerLogLevelCommand{ContainerID: "c-1", Level: "TRACE"}); err == nil {
+		t.Fatal("expected an error for an unknown level")
+	}
+	if setter.containerID != "" {
+		t.Error("setter must not be called for an invalid level")
+	}
+}
@@ -12,6 +12,7 @@ type contextKey int
 
 const (
 	loggerKey contextKey = iota
+	containerIDKey
 )
 
 // WithLogger adds a logger to the context
@@ -27,6 +28,18 @@ func LoggerFromContext(ctx context.Context) ContainerLogger {
 	return &noOpLogger{}
 }
 
+// WithContainerID tags the context with the container whose run it belongs to,
+// so requests dispatched further down can be attributed to it
+func WithContainerID(ctx context.Context, containerID string) context.Context {
+	return context.WithValue(ctx, containerIDKey, containerID)
+}
+
+// ContainerIDFromContext returns the container the context belongs to, or "" outside a container run
+func ContainerIDFromContext(ctx context.Context) string {
+	id, _ := ctx.Value(containerIDKey).(string)
+	return id
+}
+
 // noOpLogger is a logger that does nothing (fallback when no logger in context)
 type noOpLogger struct{}
 
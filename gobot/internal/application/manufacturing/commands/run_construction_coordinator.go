@@ -89,6 +89,12 @@ type ConstructionTreeResolver interface {
 	BuildDependencyTree(ctx context.Context, targetGood, systemSymbol string, playerID int) (*goods.SupplyChainNode, error)
 }
 
+// ConstructionTaskWatchdog reclaims tasks stuck in ASSIGNED/EXECUTING after their worker
+// died. *services.TaskWatchdog satisfies it.
+type ConstructionTaskWatchdog interface {
+	Sweep(ctx context.Context, playerID int) (mfgServices.TaskWatchdogResult, error)
+}
+
 // RunConstructionCoordinatorHandler is the thin construction-supply drain. Each
 // tick it: runs the activator, polls READY DELIVER_TO_CONSTRUCTION tasks from EXECUTING
 // pipelines, claims idle in-system haulers under the shared "manufacturing" identity, then
@@ -119,6 +125,9 @@ type RunConstructionCoordinatorHandler struct {
 	// constructionSupplyTaskDefaultTimeout; overridable — the daemon can tune it and the
 	// in-package tests set a tiny bound to keep the timeout test fast.
 	taskTimeout time.Duration
+	// watchdog reclaims the player's stuck in-flight tasks at the top of every tick. Optional
+	// (wired by SetTaskWatchdog); nil skips the sweep.
+	watchdog ConstructionTaskWatchdog
 }
 
 // NewRunConstructionCoordinatorHandler builds the drain. clock defaults to a RealClock when nil.
@@ -153,6 +162,13 @@ func (h *RunConstructionCoordinatorHandler) SetTreeResolver(resolver Constructio
 	h.resolver = resolver
 }
 
+// SetTaskWatchdog wires the stuck-task watchdog swept at the top of every tick, before
+// activation, so a task it returns to READY is dispatched the same tick. Optional — left
+// unset no task is ever reclaimed.
+func (h *RunConstructionCoordinatorHandler) SetTaskWatchdog(watchdog ConstructionTaskWatchdog) {
+	h.watchdog = watchdog
+}
+
 // Handle runs the standing drain loop: drain each tick until the container is cancelled
 // (or MaxIterations is reached for a bounded run). The per-tick delay is raced against
 // cancellation so a stop is prompt. reconcile lives in drainOnce (the unit tests drive).
@@ -202,6 +218,19 @@ func (h *RunConstructionCoordinatorHandler) Handle(ctx context.Context, request
 func (h *RunConstructionCoordinatorHandler) drainOnce(ctx context.Context, cmd *RunConstructionCoordinatorCommand) (*RunConstructionCoordinatorResponse, error) {
 	logger := common.LoggerFromContext(ctx)
 
+	// Stuck-task watchdog: a task whose worker died sits ASSIGNED/EXECUTING forever and holds
+	// its hull. This drain never persists an in-flight status mid-supply, so nothing it is
+	// running itself can be reclaimed here. A sweep failure only costs this tick's sweep.
+	if h.watchdog != nil {
+		if swept, err := h.watchdog.Sweep(ctx, cmd.PlayerID); err != nil {
+			logger.Log("WARNING", fmt.Sprintf("Construction drain: task watchdog sweep failed: %v", err), nil)
+		} else if swept.Reclaimed > 0 || swept.DeadLettered > 0 {
+			logger.Log("INFO", fmt.Sprintf("Construction drain: task watchdog reclaimed %d stuck task(s), dead-lettered %d", swept.Reclaimed, swept.DeadLettered), map[string]interface{}{
+				"reclaimed": swept.Reclaimed, "dead_lettered": swept.DeadLettered,
+			})
+		}
+	}
+
 	// Surviving activator: PENDING -> READY for construction tasks whose deps are complete
 	// (and re-source deferred ones). NO new activation logic. Per-step enter/exit + count
 	// logging makes a stuck activation visible in the log stream rather than an
@@ -244,7 +273,7 @@ func (h *RunConstructionCoordinatorHandler) drainOnce(ctx context.Context, cmd *
 	}
 
 	// Return this container's claims to the idle pool at tick end so a drained hull is
-	// reusable next tick (ship claims also auto-release on restart via ReleaseAllActive).
+	// reusable next tick (ship claims of unresumed containers also release on restart).
 	defer h.releaseClaims(ctx, cmd.ContainerID, playerID)
 
 	// Fan the ready materials into concurrent lot-tasks and pair each with an idle hauler. The
@@ -0,0 +1,273 @@
+package queries
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/andrescamacho/spacetraders-go/internal/application/mediator"
+	"github.com/andrescamacho/spacetraders-go/internal/domain/manufacturing"
+	"github.com/andrescamacho/spacetraders-go/internal/domain/shared"
+)
+
+// GetSupplyChainGraphQuery asks for the live dependency graph of one manufacturing
+// pipeline, so a UI or CLI can render the pipeline instead of reading task rows.
+type GetSupplyChainGraphQuery struct {
+	PipelineID string
+	PlayerID   shared.PlayerID
+}
+
+// GetSupplyChainGraphResponse is the pipeline's DAG: one node per good the pipeline
+// moves, and an edge from every input good to the good it feeds.
+type GetSupplyChainGraphResponse struct {
+	PipelineID   string
+	PipelineType manufacturing.PipelineType
+	Status       manufacturing.PipelineStatus
+	ProductGood  string
+
+	// Nodes are ordered root-first (Depth ascending), then by good symbol.
+	Nodes []SupplyChainGraphNode
+	Edges []SupplyChainGraphEdge
+
+	// AssignedShips lists every ship currently holding a task of this pipeline.
+	AssignedShips []string
+}
+
+// SupplyChainGraphNode is one good in the pipeline.
+type SupplyChainGraphNode struct {
+	Good string
+	// Depth is the longest distance from a root (a good nothing else consumes);
+	// the product is depth 0.
+	Depth int
+	// Inputs are the goods this pipeline's factories for Good are fed. A good the
+	// pipeline buys rather than fabricates has no factory state and so no inputs,
+	// even when it has a recipe.
+	Inputs    []string
+	Factories []SupplyChainGraphFactory
+	Tasks     []SupplyChainGraphTask
+}
+
+// SupplyChainGraphFactory is a factory producing the node's good for this pipeline.
+type SupplyChainGraphFactory struct {
+	FactorySymbol      string
+	CurrentSupply      string
+	PreviousSupply     string
+	AllInputsDelivered bool
+	ReadyForCollection bool
+	Inputs             []SupplyChainGraphFactoryInput
+}
+
+// SupplyChainGraphFactoryInput is the delivery state of one required input.
+type SupplyChainGraphFactoryInput struct {
+	Good        string
+	Delivered   bool
+	Quantity    int
+	DeliveredBy string
+}
+
+// SupplyChainGraphTask is one task moving the node's good.
+type SupplyChainGraphTask struct {
+	ID            string
+	TaskType      manufacturing.TaskType
+	Status        manufacturing.TaskStatus
+	Quantity      int
+	SourceMarket  string
+	FactorySymbol string
+	TargetMarket  string
+	AssignedShip  string
+	DependsOn     []string
+}
+
+// SupplyChainGraphEdge says From is an input of To.
+type SupplyChainGraphEdge struct {
+	From string
+	To   string
+}
+
+// GetSupplyChainGraphHandler builds the graph from persisted pipeline, task and
+// factory-state rows only; it never calls the API.
+type GetSupplyChainGraphHandler struct {
+	pipelineRepo     manufacturing.PipelineRepository
+	taskRepo         manufacturing.TaskRepository
+	factoryStateRepo manufacturing.FactoryStateRepository
+}
+
+// NewGetSupplyChainGraphHandler creates a new GetSupplyChainGraphHandler.
+func NewGetSupplyChainGraphHandler(
+	pipelineRepo manufacturing.PipelineRepository,
+	taskRepo manufacturing.TaskRepository,
+	factoryStateRepo manufacturing.FactoryStateRepository,
+) *GetSupplyChainGraphHandler {
+	return &GetSupplyChainGraphHandler{
+		pipelineRepo:     pipelineRepo,
+		taskRepo:         taskRepo,
+		factoryStateRepo: factoryStateRepo,
+	}
+}
+
+// Handle executes the GetSupplyChainGraph query.
+func (h *GetSupplyChainGraphHandler) Handle(ctx context.Context, request mediator.Request) (mediator.Response, error) {
+	query, ok := request.(*GetSupplyChainGraphQuery)
+	if !ok {
+		return nil, fmt.Errorf("invalid request type: expected *GetSupplyChainGraphQuery")
+	}
+
+	pipeline, err := h.pipelineRepo.FindByID(ctx, query.PipelineID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load pipeline %s: %w", query.PipelineID, err)
+	}
+	if pipeline == nil || pipeline.PlayerID() != query.PlayerID.Value() {
+		return nil, fmt.Errorf("pipeline %s not found", query.PipelineID)
+	}
+
+	tasks, err := h.taskRepo.FindByPipelineID(ctx, pipeline.ID())
+	if err != nil {
+		return nil, fmt.Errorf("failed to load tasks for pipeline %s: %w", pipeline.ID(), err)
+	}
+	states, err := h.factoryStateRepo.FindByPipelineID(ctx, pipeline.ID())
+	if err != nil {
+		return nil, fmt.Errorf("failed to load factory states for pipeline %s: %w", pipeline.ID(), err)
+	}
+
+	return buildSupplyChainGraph(pipeline, tasks, states), nil
+}
+
+func buildSupplyChainGraph(
+	pipeline *manufacturing.ManufacturingPipeline,
+	tasks []*manufacturing.ManufacturingTask,
+	states []*manufacturing.FactoryState,
+) *GetSupplyChainGraphResponse {
+	nodes := make(map[string]*SupplyChainGraphNode)
+	node := func(good string) *SupplyChainGraphNode {
+		n, ok := nodes[good]
+		if !ok {
+			n = &SupplyChainGraphNode{Good: good}
+			nodes[good] = n
+		}
+		return n
+	}
+
+	if pipeline.ProductGood() != "" {
+		node(pipeline.ProductGood())
+	}
+	for _, material := range pipeline.Materials() {
+		node(material.TradeSymbol())
+	}
+
+	// A factory state names both the good it produces and the inputs it is
+	// actually being fed, so it is the only source of edges.
+	factoryInputs := make(map[string]map[string]bool)
+	for _, state := range states {
+		n := node(state.OutputGood())
+		factory := SupplyChainGraphFactory{
+			FactorySymbol:      state.FactorySymbol(),
+			CurrentSupply:      state.CurrentSupply(),
+			PreviousSupply:     state.PreviousSupply(),
+			AllInputsDelivered: state.AllInputsDelivered(),
+			ReadyForCollection: state.ReadyForCollection(),
+		}
+		if factoryInputs[state.OutputGood()] == nil {
+			factoryInputs[state.OutputGood()] = make(map[string]bool)
+		}
+		for _, input := range state.RequiredInputs() {
+			node(input)
+			factoryInputs[state.OutputGood()][input] = true
+			entry := SupplyChainGraphFactoryInput{Good: input}
+			if delivered := state.DeliveredInputs()[input]; delivered != nil {
+				entry.Delivered = delivered.Delivered
+				entry.Quantity = delivered.Quantity
+				entry.DeliveredBy = delivered.DeliveredBy
+			}
+			factory.Inputs = append(factory.Inputs, entry)
+		}
+		n.Factories = append(n.Factories, factory)
+	}
+
+	shipSet := make(map[string]bool)
+	for _, task := range tasks {
+		n := node(task.Good())
+		n.Tasks = append(n.Tasks, SupplyChainGraphTask{
+			ID:            task.ID(),
+			TaskType:      task.TaskType(),
+			Status:        task.Status(),
+			Quantity:      task.Quantity(),
+			SourceMarket:  task.SourceMarket(),
+			FactorySymbol: task.FactorySymbol(),
+			TargetMarket:  task.TargetMarket(),
+			AssignedShip:  task.AssignedShip(),
+			DependsOn:     task.DependsOn(),
+		})
+		if task.AssignedShip() != "" && !task.IsTerminal() {
+			shipSet[task.AssignedShip()] = true
+		}
+	}
+
+	for good, n := range nodes {
+		for input := range factoryInputs[good] {
+			n.Inputs = append(n.Inputs, input)
+		}
+		sort.Strings(n.Inputs)
+	}
+
+	assignDepths(nodes)
+
+	response := &GetSupplyChainGraphResponse{
+		PipelineID:   pipeline.ID(),
+		PipelineType: pipeline.PipelineType(),
+		Status:       pipeline.Status(),
+		ProductGood:  pipeline.ProductGood(),
+	}
+	for _, n := range nodes {
+		response.Nodes = append(response.Nodes, *n)
+	}
+	sort.Slice(response.Nodes, func(i, j int) bool {
+		if response.Nodes[i].Depth != response.Nodes[j].Depth {
+			return response.Nodes[i].Depth < response.Nodes[j].Depth
+		}
+		return response.Nodes[i].Good < response.Nodes[j].Good
+	})
+	for _, n := range response.Nodes {
+		for _, input := range n.Inputs {
+			response.Edges = append(response.Edges, SupplyChainGraphEdge{From: input, To: n.Good})
+		}
+	}
+	for ship := range shipSet {
+		response.AssignedShips = append(response.AssignedShips, ship)
+	}
+	sort.Strings(response.AssignedShips)
+
+	return response
+}
+
+// assignDepths sets each node's Depth to its longest distance from a root. A
+// recipe cycle (none exist in ExportToImportMap today) is cut where it closes
+// rather than looping.
+func assignDepths(nodes map[string]*SupplyChainGraphNode) {
+	consumed := make(map[string]bool)
+	for _, n := range nodes {
+		for _, input := range n.Inputs {
+			consumed[input] = true
+		}
+	}
+
+	onPath := make(map[string]bool)
+	var visit func(good string, depth int)
+	visit = func(good string, depth int) {
+		n := nodes[good]
+		if onPath[good] || depth < n.Depth {
+			return
+		}
+		n.Depth = depth
+		onPath[good] = true
+		for _, input := range n.Inputs {
+			visit(input, depth+1)
+		}
+		onPath[good] = false
+	}
+
+	for good := range nodes {
+		if !consumed[good] {
+			visit(good, 0)
+		}
+	}
+}
@@ -0,0 +1,105 @@
+package queries
+
+import (
+	"context"
+	"testing"
+
+	"github.com/andrescamacho/spacetraders-go/internal/domain/manufacturing"
+	"github.com/andrescamacho/spacetraders-go/internal/domain/shared"
+	"github.com/stretchr/testify/require"
+)
+
+// graphStubPipelineRepo embeds the domain interface so only FindByID needs a
+// concrete implementation.
+type graphStubPipelineRepo struct {
+	manufacturing.PipelineRepository
+	pipeline *manufacturing.ManufacturingPipeline
+}
+
+func (r *graphStubPipelineRepo) FindByID(_ context.Context, _ string) (*manufacturing.ManufacturingPipeline, error) {
+	return r.pipeline, nil
+}
+
+type graphStubTaskRepo struct {
+	manufacturing.TaskRepository
+	tasks []*manufacturing.ManufacturingTask
+}
+
+func (r *graphStubTaskRepo) FindByPipelineID(_ context.Context, _ string) ([]*manufacturing.ManufacturingTask, error) {
+	return r.tasks, nil
+}
+
+type graphStubFactoryStateRepo struct {
+	manufacturing.FactoryStateRepository
+	states []*manufacturing.FactoryState
+}
+
+func (r *graphStubFactoryStateRepo) FindByPipelineID(_ context.Context, _ string) ([]*manufacturing.FactoryState, error) {
+	return r.states, nil
+}
+
+func TestGetSupplyChainGraph_BuildsPipelineDAG(t *testing.T) {
+	pipeline := manufacturing.NewPipeline("ADVANCED_CIRCUITRY", "X1-AB12-M1", 4000, 1)
+
+	circuitry := manufacturing.NewFactoryState("X1-AB12-F1", "ADVANCED_CIRCUITRY", pipeline.ID(), 1, []string{"ELECTRONICS", "MICROPROCESSORS"})
+	require.NoError(t, circuitry.RecordDelivery("ELECTRONICS", 40, "TORWIND-2"))
+	circuitry.UpdateSupply("LIMITED")
+	electronics := manufacturing.NewFactoryState("X1-AB12-F2", "ELECTRONICS", pipeline.ID(), 1, []string{"SILICON_CRYSTALS", "COPPER"})
+
+	buyMicro := manufacturing.NewAcquireDeliverTask(pipeline.ID(), 1, "MICROPROCESSORS", "X1-AB12-E1", "X1-AB12-F1", nil)
+	require.NoError(t, buyMicro.MarkReady())
+	require.NoError(t, buyMicro.AssignShip("TORWIND-3"))
+	buySilicon := manufacturing.NewAcquireDeliverTask(pipeline.ID(), 1, "SILICON_CRYSTALS", "X1-AB12-E2", "X1-AB12-F2", nil)
+	sell := manufacturing.NewCollectSellTask(pipeline.ID(), 1, "ADVANCED_CIRCUITRY", "X1-AB12-F1", "X1-AB12-M1", []string{buyMicro.ID()})
+
+	handler := NewGetSupplyChainGraphHandler(
+		&graphStubPipelineRepo{pipeline: pipeline},
+		&graphStubTaskRepo{tasks: []*manufacturing.ManufacturingTask{buyMicro, buySilicon, sell}},
+		&graphStubFactoryStateRepo{states: []*manufacturing.FactoryState{circuitry, electronics}},
+	)
+
+	resp, err := handler.Handle(context.Background(), &GetSupplyChainGraphQuery{PipelineID: pipeline.ID(), PlayerID: shared.MustNewPlayerID(1)})
+	require.NoError(t, err)
+	graph := resp.(*GetSupplyChainGraphResponse)
+
+	var order []string
+	depths := make(map[string]int)
+	byGood := make(map[string]SupplyChainGraphNode)
+	for _, n := range graph.Nodes {
+		order = append(order, n.Good)
+		depths[n.Good] = n.Depth
+		byGood[n.Good] = n
+	}
+	require.Equal(t, []string{"ADVANCED_CIRCUITRY", "ELECTRONICS", "MICROPROCESSORS", "COPPER", "SILICON_CRYSTALS"}, order)
+	require.Equal(t, 0, depths["ADVANCED_CIRCUITRY"])
+	require.Equal(t, 1, depths["MICROPROCESSORS"])
+	require.Equal(t, 2, depths["SILICON_CRYSTALS"])
+
+	require.Empty(t, byGood["MICROPROCESSORS"].Inputs, "a bought good has no factory state and so no inputs")
+
+	root := byGood["ADVANCED_CIRCUITRY"]
+	require.Len(t, root.Factories, 1)
+	require.Equal(t, "LIMITED", root.Factories[0].CurrentSupply)
+	require.Equal(t, []SupplyChainGraphFactoryInput{
+		{Good: "ELECTRONICS", Delivered: true, Quantity: 40, DeliveredBy: "TORWIND-2"},
+		{Good: "MICROPROCESSORS"},
+	}, root.Factories[0].Inputs)
+	require.Len(t, root.Tasks, 1)
+	require.Equal(t, manufacturing.TaskTypeCollectSell, root.Tasks[0].TaskType)
+
+	require.ElementsMatch(t, []SupplyChainGraphEdge{
+		{From: "ELECTRONICS", To: "ADVANCED_CIRCUITRY"},
+		{From: "MICROPROCESSORS", To: "ADVANCED_CIRCUITRY"},
+		{From: "COPPER", To: "ELECTRONICS"},
+		{From: "SILICON_CRYSTALS", To: "ELECTRONICS"},
+	}, graph.Edges)
+	require.Equal(t, []string{"TORWIND-3"}, graph.AssignedShips)
+}
+
+func TestGetSupplyChainGraph_RejectsOtherPlayersPipeline(t *testing.T) {
+	pipeline := manufacturing.NewPipeline("ELECTRONICS", "X1-AB12-M1", 900, 2)
+	handler := NewGetSupplyChainGraphHandler(&graphStubPipelineRepo{pipeline: pipeline}, &graphStubTaskRepo{}, &graphStubFactoryStateRepo{})
+
+	_, err := handler.Handle(context.Background(), &GetSupplyChainGraphQuery{PipelineID: pipeline.ID(), PlayerID: shared.MustNewPlayerID(1)})
+	require.ErrorContains(t, err, "not found")
+}
@@ -0,0 +1,52 @@
+package queries
+
+import (
+	"context"
+	"fmt"
+
+	mfgServices "github.com/andrescamacho/spacetraders-go/internal/application/manufacturing/services"
+	"github.com/andrescamacho/spacetraders-go/internal/application/mediator"
+	"github.com/andrescamacho/spacetraders-go/internal/domain/shared"
+)
+
+// GetTaskQueueStatsQuery asks for the depth and rejection counters of a
+// player's manufacturing task queue, so an operator can see which pipelines
+// are flooding it.
+type GetTaskQueueStatsQuery struct {
+	PlayerID shared.PlayerID
+}
+
+// GetTaskQueueStatsResponse is the queue snapshot. Active is false when no
+// activation pass has built the player's queue yet; Stats is then empty.
+type GetTaskQueueStatsResponse struct {
+	Active bool
+	Stats  mfgServices.TaskQueueStats
+}
+
+// TaskQueueStatsSource reports a player's task queue stats (satisfied by
+// services.TaskQueueRegistry).
+type TaskQueueStatsSource interface {
+	TaskQueueStats(playerID int) (mfgServices.TaskQueueStats, bool)
+}
+
+// GetTaskQueueStatsHandler reads the in-memory task queues; it touches neither
+// the database nor the API.
+type GetTaskQueueStatsHandler struct {
+	source TaskQueueStatsSource
+}
+
+// NewGetTaskQueueStatsHandler creates a new GetTaskQueueStatsHandler.
+func NewGetTaskQueueStatsHandler(source TaskQueueStatsSource) *GetTaskQueueStatsHandler {
+	return &GetTaskQueueStatsHandler{source: source}
+}
+
+// Handle executes the GetTaskQueueStats query.
+func (h *GetTaskQueueStatsHandler) Handle(_ context.Context, request mediator.Request) (mediator.Response, error) {
+	query, ok := request.(*GetTaskQueueStatsQuery)
+	if !ok {
+		return nil, fmt.Errorf("invalid request type: expected *GetTaskQueueStatsQuery")
+	}
+
+	stats, active := h.source.TaskQueueStats(query.PlayerID.Value())
+	return &GetTaskQueueStatsResponse{Active: active, Stats: stats}, nil
+}
@@ -7,6 +7,7 @@ import (
 
 	"github.com/andrescamacho/spacetraders-go/internal/domain/goods"
 	"github.com/andrescamacho/spacetraders-go/internal/domain/market"
+	"github.com/andrescamacho/spacetraders-go/internal/domain/trading"
 )
 
 // sp-2dv4 (money-integrity #3). A SHIP_PARTS goods factory bled -675k in 18min:
@@ -28,8 +29,9 @@ import (
 //	import bids drive this negative — Guard 1 alone would have refused the incident.
 //
 //	Guard 2 — absorption-bounded spend: cap one chain pass's total feed spend at
-//	what the FINAL sink can absorb (sink_bid × sink_trade_volume × a bounded number
-//	of tranches), NOT at the treasury. A vol-6 sink therefore caps feed spend to a
+//	what the FINAL sink can absorb (a bounded number of sink_trade_volume tranches,
+//	each sold at the bid our own earlier tranches have walked down), NOT at the
+//	treasury. A vol-6 sink therefore caps feed spend to a
 //	commensurately small number regardless of how much cash is on hand.
 //
 // It is strictly additive and UPSTREAM: it does not touch the 9aoc solvency
@@ -47,8 +49,9 @@ const (
 
 	// sinkAbsorptionTranches is how many product trade-volume churns the final
 	// sink is assumed to absorb before its bid decays under our own delivery.
-	// One chain pass's feed spend must fit inside sink_bid × sink_volume ×
-	// sinkAbsorptionTranches; a small-volume sink yields a small cap. Tune here.
+	// One chain pass's feed spend must fit inside the projected proceeds of that
+	// many sink transactions (sinkAbsorptionCap); a small-volume sink yields a
+	// small cap. Tune here.
 	sinkAbsorptionTranches = 4
 )
 
@@ -89,7 +92,7 @@ type ChainProjection struct {
 	ProjectedPL   int      // summed chain P&L across all stages, live prices
 	RequiredPL    int      // chainMarginSafetyFraction × FeedSpend
 	FeedSpend     int      // total raw-input (feed) purchase cost for one chain pass
-	AbsorptionCap int      // SinkBid × SinkVolume × sinkAbsorptionTranches
+	AbsorptionCap int      // projected proceeds of sinkAbsorptionTranches sink transactions
 	SinkBid       int      // final resale sink's live bid
 	SinkVolume    int      // final resale sink's trade volume
 	Stages        []string // compact per-stage descriptors, embedded in the message text
@@ -133,7 +136,7 @@ func (g *ChainMarginGuard) Evaluate(ctx context.Context, root *goods.SupplyChain
 	proj.ProjectedPL = pl
 	proj.FeedSpend = feedSpend
 	proj.RequiredPL = int(float64(feedSpend) * chainMarginSafetyFraction)
-	proj.AbsorptionCap = sink.Price * sink.TradeVolume * sinkAbsorptionTranches
+	proj.AbsorptionCap = sinkAbsorptionCap(sink)
 
 	// Guard 1 — live chain margin. Crushed feed import bids drive ProjectedPL
 	// negative; a thin margin fails to clear RequiredPL. Either parks pre-spend.
@@ -223,6 +226,15 @@ func (g *ChainMarginGuard) branchPL(
 	return totalPL, totalFeed, nil
 }
 
+// sinkAbsorptionCap is the credits sinkAbsorptionTranches full transactions into
+// the sink would bring in. The bid is walked down tranche by tranche with the
+// fitted sell impact scaled by the sink's activity, so a WEAK sink's later
+// tranches sell for less than its snapshot bid and the cap shrinks with it.
+func sinkAbsorptionCap(sink *MarketLocatorResult) int {
+	units := sink.TradeVolume * sinkAbsorptionTranches
+	return trading.PlanSellFill(sink.Price, sink.TradeVolume, units, sink.Activity, trading.DefaultSellImpactCoefficient).Total
+}
+
 func failClosed(proj ChainProjection, detail string) ChainProjection {
 	proj.Proceed = false
 	proj.Reason = chainGuardUnpriceable
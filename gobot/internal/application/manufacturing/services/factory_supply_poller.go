@@ -8,6 +8,7 @@ import (
 	"github.com/andrescamacho/spacetraders-go/internal/application/common"
 	"github.com/andrescamacho/spacetraders-go/internal/domain/manufacturing"
 	"github.com/andrescamacho/spacetraders-go/internal/domain/market"
+	"github.com/andrescamacho/spacetraders-go/internal/domain/shared"
 )
 
 // FactorySupplyPoller owns the supply monitor poll cycle: it observes factory
@@ -29,11 +30,12 @@ type FactorySupplyPoller struct {
 	playerID          int
 }
 
-// Run starts the poll loop until the context is cancelled.
+// Run starts the poll loop until the context is cancelled. The interval runs on
+// the context's clock (shared.WithClock), so a simulated scenario drives the
+// monitor by advancing time rather than waiting it out.
 func (p *FactorySupplyPoller) Run(ctx context.Context) {
 	logger := common.LoggerFromContext(ctx)
-	ticker := time.NewTicker(p.pollInterval)
-	defer ticker.Stop()
+	clock := shared.ClockFromContext(ctx)
 
 	logger.Log("INFO", "Supply monitor started", map[string]interface{}{
 		"poll_interval": p.pollInterval.String(),
@@ -41,7 +43,7 @@ func (p *FactorySupplyPoller) Run(ctx context.Context) {
 
 	for {
 		select {
-		case <-ticker.C:
+		case <-shared.After(clock, p.pollInterval):
 			p.PollOnce(ctx)
 		case <-ctx.Done():
 			logger.Log("INFO", "Supply monitor stopped", nil)
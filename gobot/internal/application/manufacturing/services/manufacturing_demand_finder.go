@@ -269,7 +269,9 @@ func (f *ManufacturingDemandFinder) closestDemandEntry(
 	return bestEntry
 }
 
-// findFactoryWaypoint finds the export market for a good (factory for manufacturing, source for arbitrage)
+// findFactoryWaypoint finds the export market for a good (factory for manufacturing, source for arbitrage).
+// A good no in-system market exports falls back to an EXCHANGE listing that passes the
+// exchangeMaxSpread caution, so EXCHANGE-only goods like FUEL still resolve a source.
 func (f *ManufacturingDemandFinder) findFactoryWaypoint(
 	ctx context.Context,
 	good string,
@@ -282,6 +284,7 @@ func (f *ManufacturingDemandFinder) findFactoryWaypoint(
 		return nil, fmt.Errorf("failed to get markets: %w", err)
 	}
 
+	exchangeWaypoint := ""
 	for _, waypointSymbol := range marketWaypoints {
 		marketData, err := f.marketRepo.GetMarketData(ctx, waypointSymbol, playerID)
 		if err != nil || marketData == nil {
@@ -289,13 +292,22 @@ func (f *ManufacturingDemandFinder) findFactoryWaypoint(
 		}
 
 		for _, tradeGood := range marketData.TradeGoods() {
-			if tradeGood.Symbol() == good && tradeGood.TradeType() == market.TradeTypeExport {
+			if tradeGood.Symbol() != good {
+				continue
+			}
+			if tradeGood.TradeType() == market.TradeTypeExport {
 				// Found the export market (factory) for this good
 				return f.waypointProvider.GetWaypoint(ctx, waypointSymbol, systemSymbol, playerID)
 			}
+			if exchangeWaypoint == "" && tradeGood.TradeType() == market.TradeTypeExchange && exchangeSpreadAcceptable(&tradeGood) {
+				exchangeWaypoint = waypointSymbol
+			}
 		}
 	}
 
+	if exchangeWaypoint != "" {
+		return f.waypointProvider.GetWaypoint(ctx, exchangeWaypoint, systemSymbol, playerID)
+	}
 	return nil, fmt.Errorf("no export market found for %s", good)
 }
 
@@ -46,6 +46,11 @@ type MarketLocatorResult struct {
 	Supply         string // SCARCE, LIMITED, MODERATE, HIGH, ABUNDANT
 	Price          int    // sell_price (for exports) or purchase_price (for imports)
 	TradeVolume    int    // Maximum units per transaction
+	// Exchange marks a source that is an EXCHANGE listing standing in for a missing
+	// EXPORT producer (see sourceListings). Its ask already passed the exchangeMaxSpread
+	// caution but carries the exchange's spread, so callers pricing a chain off it should
+	// expect a thinner margin than from a producer.
+	Exchange bool
 }
 
 // FindImportMarket finds a market that wants to buy a good (imports it).
@@ -160,7 +165,7 @@ func (l *MarketLocator) scannedTradeGood(ctx context.Context, waypointSymbol str
 		return nil, fmt.Errorf("failed to get market data: %w", err)
 	}
 	if marketData == nil {
-		return nil, fmt.Errorf("no market data found for %s (market may not have been scanned)", waypointSymbol)
+		return nil, shared.Errorf(shared.CodeMarketNotFound, "no market data found for %s (market may not have been scanned)", waypointSymbol)
 	}
 
 	tradeGood := marketData.FindGood(good)
@@ -230,6 +235,7 @@ func (l *MarketLocator) FindExportMarket(
 				Supply:         supplyOrEmpty(tradeGood),
 				Price:          price,
 				TradeVolume:    tradeGood.TradeVolume(),
+				Exchange:       tradeGood.TradeType() == market.TradeTypeExchange,
 			}
 		}
 	}
@@ -259,6 +265,9 @@ func isModeratePlusSupply(supply string) bool {
 // This is used for raw material acquisition in manufacturing pipelines.
 // Example: LIQUID_NITROGEN at ABUNDANT G52 costs 18-28 credits, but SCARCE C44 costs 650+.
 //
+// A good no in-system market EXPORTs (FUEL and other EXCHANGE-only goods) is ranked over its
+// EXCHANGE listings instead, under the same supply floor; see sourceListings.
+//
 // Returns error if no market with MODERATE or better supply exists.
 func (l *MarketLocator) FindExportMarketBySupplyPriority(
 	ctx context.Context,
@@ -271,8 +280,8 @@ func (l *MarketLocator) FindExportMarketBySupplyPriority(
 		return l.findShipyardSellingShip(ctx, good, systemSymbol, playerID)
 	}
 
-	// Get all markets in the system to consider activity
-	marketWaypoints, err := l.marketRepo.FindAllMarketsInSystem(ctx, systemSymbol, playerID)
+	// Get all source listings in the system to consider activity
+	listings, err := l.sourceListings(ctx, good, systemSymbol, playerID)
 	if err != nil {
 		return nil, fmt.Errorf("failed to find markets: %w", err)
 	}
@@ -284,22 +293,14 @@ func (l *MarketLocator) FindExportMarketBySupplyPriority(
 		activity       string
 		price          int
 		tradeVolume    int
+		exchange       bool
 		supplyScore    int // ABUNDANT=3, HIGH=2, MODERATE=1
 		activityScore  int // WEAK=4, GROWING=3, STRONG=2, RESTRICTED=1
 	}
 	var candidates []candidateMarket
 
-	for _, waypointSymbol := range marketWaypoints {
-		marketData, err := l.marketRepo.GetMarketData(ctx, waypointSymbol, playerID)
-		if err != nil || marketData == nil {
-			continue
-		}
-
-		tradeGood := marketData.FindGood(good)
-		if tradeGood == nil || tradeGood.TradeType() != market.TradeTypeExport {
-			continue
-		}
-
+	for _, listing := range listings {
+		tradeGood := listing.tradeGood
 		supply := supplyOrEmpty(tradeGood)
 
 		// Skip SCARCE and LIMITED - only accept MODERATE+
@@ -311,11 +312,12 @@ func (l *MarketLocator) FindExportMarketBySupplyPriority(
 		activity := activityOrEmpty(tradeGood)
 
 		candidates = append(candidates, candidateMarket{
-			waypointSymbol: waypointSymbol,
+			waypointSymbol: listing.waypointSymbol,
 			supply:         supply,
 			activity:       activity,
 			price:          tradeGood.SellPrice(),
 			tradeVolume:    tradeGood.TradeVolume(),
+			exchange:       listing.exchange,
 			supplyScore:    supplyScore,
 			activityScore:  ExportActivityScore(activity),
 		})
@@ -343,6 +345,7 @@ func (l *MarketLocator) FindExportMarketBySupplyPriority(
 		Supply:         best.supply,
 		Price:          best.price,
 		TradeVolume:    best.tradeVolume,
+		Exchange:       best.exchange,
 	}, nil
 }
 
@@ -355,8 +358,8 @@ func (l *MarketLocator) FindExportMarketBySupplyPriority(
 // from, a ladder cannot poison this: a source that ladders degrades out of MODERATE+ supply
 // and therefore drops out of both the candidate set AND this median.
 //
-// Eligibility mirrors FindExportMarketBySupplyPriority exactly: EXPORT trade type, supply
-// MODERATE or better (SCARCE/LIMITED excluded). count==0 means no eligible source (the
+// Eligibility mirrors FindExportMarketBySupplyPriority exactly: EXPORT trade type (EXCHANGE for
+// an EXCHANGE-only good), supply MODERATE or better (SCARCE/LIMITED excluded). count==0 means no eligible source (the
 // caller is on the rescue/fallback path and must use a different baseline). Ship types have
 // no supply semantics and return count==0.
 func (l *MarketLocator) EligibleSourceMedianAsk(
@@ -369,21 +372,14 @@ func (l *MarketLocator) EligibleSourceMedianAsk(
 		return 0, 0, nil
 	}
 
-	marketWaypoints, err := l.marketRepo.FindAllMarketsInSystem(ctx, systemSymbol, playerID)
+	listings, err := l.sourceListings(ctx, good, systemSymbol, playerID)
 	if err != nil {
 		return 0, 0, fmt.Errorf("failed to find markets for %s eligible-median: %w", good, err)
 	}
 
-	asks := make([]int, 0, len(marketWaypoints))
-	for _, waypointSymbol := range marketWaypoints {
-		marketData, err := l.marketRepo.GetMarketData(ctx, waypointSymbol, playerID)
-		if err != nil || marketData == nil {
-			continue
-		}
-		tradeGood := marketData.FindGood(good)
-		if tradeGood == nil || tradeGood.TradeType() != market.TradeTypeExport {
-			continue
-		}
+	asks := make([]int, 0, len(listings))
+	for _, listing := range listings {
+		tradeGood := listing.tradeGood
 		// MODERATE+ only — the identical eligibility filter as FindExportMarketBySupplyPriority.
 		if !isModeratePlusSupply(supplyOrEmpty(tradeGood)) {
 			continue
@@ -418,7 +414,7 @@ func (l *MarketLocator) EligibleSourceMedianAsk(
 //   - err != nil: the system market LIST read failed — fail toward production (no pause).
 //
 // Eligibility mirrors FindExportMarketBySupplyPriority / EligibleSourceMedianAsk exactly (EXPORT
-// trade type, supply MODERATE or better, priced). Ship types have no supply semantics.
+// trade type or, for an EXCHANGE-only good, EXCHANGE; supply MODERATE or better, priced). Ship types have no supply semantics.
 func (l *MarketLocator) InputSourceEligibility(
 	ctx context.Context,
 	good string,
@@ -430,20 +426,14 @@ func (l *MarketLocator) InputSourceEligibility(
 		return true, true, nil
 	}
 
-	marketWaypoints, err := l.marketRepo.FindAllMarketsInSystem(ctx, systemSymbol, playerID)
+	// A per-waypoint read miss is NOT counted as a readable source (fail toward production).
+	listings, err := l.sourceListings(ctx, good, systemSymbol, playerID)
 	if err != nil {
 		return false, false, fmt.Errorf("failed to find markets for %s input-eligibility: %w", good, err)
 	}
 
-	for _, waypointSymbol := range marketWaypoints {
-		marketData, err := l.marketRepo.GetMarketData(ctx, waypointSymbol, playerID)
-		if err != nil || marketData == nil {
-			continue // a per-waypoint read miss is NOT counted as a readable source (fail toward production)
-		}
-		tradeGood := marketData.FindGood(good)
-		if tradeGood == nil || tradeGood.TradeType() != market.TradeTypeExport {
-			continue
-		}
+	for _, listing := range listings {
+		tradeGood := listing.tradeGood
 		if tradeGood.SellPrice() <= 0 {
 			continue // unpriceable listing — not a usable source
 		}
@@ -596,6 +586,9 @@ func (l *MarketLocator) FindConstructionSource(
 // - MODERATE: 0-15% (average prices)
 // - LIMITED: +15-30% (above average prices)
 // - SCARCE: +30-70% (worst prices - NEVER BUY)
+//
+// An EXCHANGE-only good is looked up over its EXCHANGE listings under the same HIGH/ABUNDANT
+// gate (see sourceListings), so its pipelines do not stall for want of an exporter.
 func (l *MarketLocator) FindExportMarketWithGoodSupply(
 	ctx context.Context,
 	good string,
@@ -608,8 +601,8 @@ func (l *MarketLocator) FindExportMarketWithGoodSupply(
 		return l.findShipyardSellingShip(ctx, good, systemSymbol, playerID)
 	}
 
-	// Get all markets in the system
-	marketWaypoints, err := l.marketRepo.FindAllMarketsInSystem(ctx, systemSymbol, playerID)
+	// Get every market selling the good to us (EXPORT, or EXCHANGE for an EXCHANGE-only good)
+	listings, err := l.sourceListings(ctx, good, systemSymbol, playerID)
 	if err != nil {
 		return nil, fmt.Errorf("failed to find markets in system: %w", err)
 	}
@@ -622,23 +615,8 @@ func (l *MarketLocator) FindExportMarketWithGoodSupply(
 	}
 	var candidates []candidateMarket
 
-	for _, waypointSymbol := range marketWaypoints {
-		// Get market data
-		marketData, err := l.marketRepo.GetMarketData(ctx, waypointSymbol, playerID)
-		if err != nil || marketData == nil {
-			continue // Skip markets we can't access
-		}
-
-		// Check if this market exports the good
-		tradeGood := marketData.FindGood(good)
-		if tradeGood == nil {
-			continue // Market doesn't have this good
-		}
-
-		// Only consider EXPORT markets (selling to us)
-		if tradeGood.TradeType() != market.TradeTypeExport {
-			continue
-		}
+	for _, listing := range listings {
+		tradeGood := listing.tradeGood
 
 		// Check supply level - only HIGH or ABUNDANT
 		supply := supplyOrEmpty(tradeGood)
@@ -650,11 +628,12 @@ func (l *MarketLocator) FindExportMarketWithGoodSupply(
 
 		candidates = append(candidates, candidateMarket{
 			result: &MarketLocatorResult{
-				WaypointSymbol: waypointSymbol,
+				WaypointSymbol: listing.waypointSymbol,
 				Activity:       activity,
 				Supply:         supply,
 				Price:          tradeGood.SellPrice(),
 				TradeVolume:    tradeGood.TradeVolume(),
+				Exchange:       listing.exchange,
 			},
 			supply: supply,
 			price:  tradeGood.SellPrice(),
@@ -0,0 +1,67 @@
+package services
+
+import (
+	"context"
+
+	"github.com/andrescamacho/spacetraders-go/internal/domain/market"
+)
+
+// exchangeMaxSpread is the pricing caution applied to an EXCHANGE source: its ask may
+// exceed its own bid by at most this fraction. An exchange is a two-way trader, not a
+// producer, so its ask carries the whole spread on top of whatever it paid; a listing
+// wider than this is priced like a consumer and is not treated as a source.
+const exchangeMaxSpread = 0.30
+
+// sourceListing is one in-system market offering a good for sale.
+type sourceListing struct {
+	waypointSymbol string
+	tradeGood      *market.TradeGood
+	exchange       bool
+}
+
+// sourceListings returns the in-system listings a good can be sourced from. The EXPORT
+// listings (producers) are the sources whenever at least one market in the system exports
+// the good. For an EXCHANGE-only good (FUEL and the like, which no in-system market
+// produces) the EXCHANGE listings stand in, minus any whose spread fails the
+// exchangeMaxSpread caution. IMPORT listings are never sources (sp-9mkf). A per-waypoint
+// read miss is skipped; only the system list read surfaces an error.
+func (l *MarketLocator) sourceListings(ctx context.Context, good, systemSymbol string, playerID int) ([]sourceListing, error) {
+	marketWaypoints, err := l.marketRepo.FindAllMarketsInSystem(ctx, systemSymbol, playerID)
+	if err != nil {
+		return nil, err
+	}
+
+	var exports, exchanges []sourceListing
+	for _, waypointSymbol := range marketWaypoints {
+		marketData, err := l.marketRepo.GetMarketData(ctx, waypointSymbol, playerID)
+		if err != nil || marketData == nil {
+			continue
+		}
+		tradeGood := marketData.FindGood(good)
+		if tradeGood == nil {
+			continue
+		}
+		switch tradeGood.TradeType() {
+		case market.TradeTypeExport:
+			exports = append(exports, sourceListing{waypointSymbol: waypointSymbol, tradeGood: tradeGood})
+		case market.TradeTypeExchange:
+			if exchangeSpreadAcceptable(tradeGood) {
+				exchanges = append(exchanges, sourceListing{waypointSymbol: waypointSymbol, tradeGood: tradeGood, exchange: true})
+			}
+		}
+	}
+	if len(exports) > 0 {
+		return exports, nil
+	}
+	return exchanges, nil
+}
+
+// exchangeSpreadAcceptable applies the exchangeMaxSpread caution to an EXCHANGE listing.
+// A listing without a bid cannot show its spread and is refused.
+func exchangeSpreadAcceptable(tradeGood *market.TradeGood) bool {
+	bid, ask := tradeGood.PurchasePrice(), tradeGood.SellPrice()
+	if bid <= 0 || ask <= 0 {
+		return false
+	}
+	return float64(ask) <= float64(bid)*(1+exchangeMaxSpread)
+}
@@ -0,0 +1,83 @@
+package services
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/andrescamacho/spacetraders-go/internal/domain/market"
+)
+
+// newSpreadMarket builds a single-good market with an explicit bid (purchase) and ask (sell).
+func newSpreadMarket(t *testing.T, waypointSymbol, good, supply string, tradeType market.TradeType, bid, ask int) *market.Market {
+	t.Helper()
+	activity := "STRONG"
+	tradeGood, err := market.NewTradeGood(good, &supply, &activity, bid, ask, 40, tradeType)
+	if err != nil {
+		t.Fatalf("NewTradeGood(%s): %v", good, err)
+	}
+	m, err := market.NewMarket(waypointSymbol, []market.TradeGood{*tradeGood}, time.Now())
+	if err != nil {
+		t.Fatalf("NewMarket(%s): %v", waypointSymbol, err)
+	}
+	return m
+}
+
+// FUEL is EXCHANGE-listed everywhere; with no exporter in-system the supply-priority
+// locator must source from the exchange instead of stalling with "no export market".
+func TestFindExportMarketBySupplyPriority_FallsBackToExchangeForExchangeOnlyGood(t *testing.T) {
+	const exchange = "X1-UQ16-XCH1"
+	repo := &plannerStubMarketRepo{
+		marketWaypoints: []string{exchange},
+		markets: map[string]*market.Market{
+			exchange: newSpreadMarket(t, exchange, "FUEL", "ABUNDANT", market.TradeTypeExchange, 68, 72),
+		},
+	}
+	locator := NewMarketLocator(repo, nil, nil, nil)
+
+	result, err := locator.FindExportMarketBySupplyPriority(context.Background(), "FUEL", "X1-UQ16", 1)
+	if err != nil {
+		t.Fatalf("FindExportMarketBySupplyPriority must fall back to EXCHANGE: %v", err)
+	}
+	if result.WaypointSymbol != exchange || !result.Exchange {
+		t.Fatalf("expected exchange source %s flagged Exchange, got %+v", exchange, result)
+	}
+}
+
+// A real exporter always wins: the EXCHANGE fallback only applies when nothing exports.
+func TestFindExportMarketBySupplyPriority_PrefersExporterOverExchange(t *testing.T) {
+	const exchange = "X1-UQ16-XCH1"
+	const exporter = "X1-UQ16-EX1A"
+	repo := &plannerStubMarketRepo{
+		marketWaypoints: []string{exchange, exporter},
+		markets: map[string]*market.Market{
+			exchange: newSpreadMarket(t, exchange, "FUEL", "ABUNDANT", market.TradeTypeExchange, 68, 70),
+			exporter: newSpreadMarket(t, exporter, "FUEL", "MODERATE", market.TradeTypeExport, 60, 90),
+		},
+	}
+	locator := NewMarketLocator(repo, nil, nil, nil)
+
+	result, err := locator.FindExportMarketBySupplyPriority(context.Background(), "FUEL", "X1-UQ16", 1)
+	if err != nil {
+		t.Fatalf("FindExportMarketBySupplyPriority: %v", err)
+	}
+	if result.WaypointSymbol != exporter || result.Exchange {
+		t.Fatalf("expected exporter %s, got %+v", exporter, result)
+	}
+}
+
+// An exchange whose ask sits more than exchangeMaxSpread over its bid is refused as a source.
+func TestFindExportMarketBySupplyPriority_RefusesWideSpreadExchange(t *testing.T) {
+	const exchange = "X1-UQ16-XCH1"
+	repo := &plannerStubMarketRepo{
+		marketWaypoints: []string{exchange},
+		markets: map[string]*market.Market{
+			exchange: newSpreadMarket(t, exchange, "FUEL", "ABUNDANT", market.TradeTypeExchange, 50, 90),
+		},
+	}
+	locator := NewMarketLocator(repo, nil, nil, nil)
+
+	if result, err := locator.FindExportMarketBySupplyPriority(context.Background(), "FUEL", "X1-UQ16", 1); err == nil {
+		t.Fatalf("wide-spread exchange must not be a source, got %+v", result)
+	}
+}
@@ -1153,14 +1153,12 @@ func (e *ProductionExecutor) PollForProduction(
 		}
 		waitDuration := intervals[intervalIndex]
 
-		// Wait before next poll
-		// Create a timer for the wait duration
-		timer := time.NewTimer(waitDuration)
+		// Wait before next poll, on the executor's clock so a simulated
+		// scenario advances through fabrication instead of sleeping it out
 		select {
 		case <-ctx.Done():
-			timer.Stop()
 			return 0, 0, fmt.Errorf("production polling cancelled during wait: %w", ctx.Err())
-		case <-timer.C:
+		case <-shared.After(e.clock, waitDuration):
 			// Continue to next poll attempt
 		}
 
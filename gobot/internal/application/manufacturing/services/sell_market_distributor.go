@@ -3,6 +3,7 @@ package services
 import (
 	"context"
 	"fmt"
+	"sort"
 
 	"github.com/andrescamacho/spacetraders-go/internal/application/common"
 	"github.com/andrescamacho/spacetraders-go/internal/domain/manufacturing"
@@ -103,6 +104,30 @@ func (d *SellMarketDistributor) SelectSellMarket(
 	return selectedMarket.WaypointSymbol, nil
 }
 
+// RankSellMarkets returns every eligible sell market for a good in a system,
+// best first under the same priority SelectSellMarket applies. Callers placing
+// more than one market can absorb (an arbitrage remainder the destination could
+// not take) walk the list in order. Unlike SelectSellMarket there is no fallback:
+// an empty list means no eligible market.
+func (d *SellMarketDistributor) RankSellMarkets(
+	ctx context.Context,
+	good string,
+	systemSymbol string,
+	playerID int,
+) ([]*EligibleMarket, error) {
+	eligibleMarkets, err := d.findEligibleSellMarkets(ctx, good, systemSymbol, playerID)
+	if err != nil {
+		return nil, err
+	}
+	if d.taskRepo != nil {
+		d.countPendingTasksPerMarket(ctx, eligibleMarkets, good, playerID)
+	}
+	sort.SliceStable(eligibleMarkets, func(i, j int) bool {
+		return betterSellMarket(eligibleMarkets[i], eligibleMarkets[j])
+	})
+	return eligibleMarkets, nil
+}
+
 // findEligibleSellMarkets finds all markets that are eligible sell destinations.
 // Eligible markets: NOT EXPORT type (exclude factories), SCARCE or LIMITED supply, WEAK or RESTRICTED activity.
 //
@@ -220,29 +245,27 @@ func (d *SellMarketDistributor) selectBestMarket(markets []*EligibleMarket) *Eli
 
 	best := markets[0]
 	for _, m := range markets[1:] {
-		// Primary: fewer pending tasks wins
-		if m.PendingTasks < best.PendingTasks {
+		if betterSellMarket(m, best) {
 			best = m
-			continue
-		}
-		if m.PendingTasks > best.PendingTasks {
-			continue
 		}
+	}
 
-		// Secondary: SCARCE > LIMITED (SCARCE markets pay more)
-		if m.Supply == supplyScarce && best.Supply == supplyLimited {
-			best = m
-			continue
-		}
-		if m.Supply == supplyLimited && best.Supply == supplyScarce {
-			continue
-		}
+	return best
+}
 
-		// Tertiary: higher purchase price wins
-		if m.PurchasePrice > best.PurchasePrice {
-			best = m
-		}
+// betterSellMarket reports whether a ranks strictly ahead of b under the
+// selectBestMarket priority.
+func betterSellMarket(a, b *EligibleMarket) bool {
+	// Primary: fewer pending tasks wins
+	if a.PendingTasks != b.PendingTasks {
+		return a.PendingTasks < b.PendingTasks
 	}
 
-	return best
+	// Secondary: SCARCE > LIMITED (SCARCE markets pay more)
+	if a.Supply != b.Supply {
+		return a.Supply == supplyScarce
+	}
+
+	// Tertiary: higher purchase price wins
+	return a.PurchasePrice > b.PurchasePrice
 }
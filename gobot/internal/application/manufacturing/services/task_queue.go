@@ -56,13 +56,33 @@ type TaskQueue struct {
 	mu       sync.RWMutex
 	tasks    taskHeap
 	taskByID map[string]*manufacturing.ManufacturingTask
+
+	// limits bounds the queue (zero = unbounded); playerID labels its rejection
+	// metrics. depthByPipeline and the rejection counters back Stats.
+	limits             TaskQueueLimits
+	playerID           int
+	depthByPipeline    map[string]int
+	rejected           map[TaskRejectReason]int
+	rejectedByPipeline map[string]int
 }
 
-// NewTaskQueue creates a new task queue
+// NewTaskQueue creates a new, unbounded task queue
 func NewTaskQueue() *TaskQueue {
+	return NewBoundedTaskQueue(TaskQueueLimits{}, 0)
+}
+
+// NewBoundedTaskQueue creates a task queue that holds at most limits.Capacity
+// tasks, and at most limits.PipelineQuota from any one pipeline. Rejections are
+// recorded against playerID.
+func NewBoundedTaskQueue(limits TaskQueueLimits, playerID int) *TaskQueue {
 	tq := &TaskQueue{
-		tasks:    make(taskHeap, 0),
-		taskByID: make(map[string]*manufacturing.ManufacturingTask),
+		tasks:              make(taskHeap, 0),
+		taskByID:           make(map[string]*manufacturing.ManufacturingTask),
+		limits:             limits,
+		playerID:           playerID,
+		depthByPipeline:    make(map[string]int),
+		rejected:           make(map[TaskRejectReason]int),
+		rejectedByPipeline: make(map[string]int),
 	}
 	heap.Init(&tq.tasks)
 	return tq
@@ -71,10 +91,19 @@ func NewTaskQueue() *TaskQueue {
 // Enqueue adds a task to the queue
 // If a task with the same ID already exists, it is replaced with the new task
 // to ensure the queue reflects the current task state from the database.
+// On a bounded queue a new task is rejected when its pipeline is at quota; when
+// the queue is full it displaces the lowest effective-priority task if it
+// outranks it, and is rejected otherwise. A rejected task stays READY in the
+// repository, so a later activation pass offers it again.
 func (q *TaskQueue) Enqueue(task *manufacturing.ManufacturingTask) {
 	q.mu.Lock()
 	defer q.mu.Unlock()
 
+	q.enqueueLocked(task)
+}
+
+// enqueueLocked is Enqueue for callers holding the lock.
+func (q *TaskQueue) enqueueLocked(task *manufacturing.ManufacturingTask) {
 	// Only add if task is READY
 	if task.Status() != manufacturing.TaskStatusReady {
 		return
@@ -84,9 +113,31 @@ func (q *TaskQueue) Enqueue(task *manufacturing.ManufacturingTask) {
 	// This ensures the queue reflects the current task state from the database
 	if _, exists := q.taskByID[task.ID()]; exists {
 		q.removeByIDLocked(task.ID())
+		q.pushLocked(task)
+		return
+	}
+
+	if quota := q.limits.PipelineQuota; quota > 0 && q.depthByPipeline[task.PipelineID()] >= quota {
+		q.rejectLocked(task, TaskRejectPipelineQuota)
+		return
+	}
+	if capacity := q.limits.Capacity; capacity > 0 && q.tasks.Len() >= capacity {
+		victim := q.lowestPriorityLocked()
+		if victim == nil || effectivePriority(victim) >= effectivePriority(task) {
+			q.rejectLocked(task, TaskRejectCapacity)
+			return
+		}
+		q.removeByIDLocked(victim.ID())
+		q.rejectLocked(victim, TaskRejectEvicted)
 	}
 
+	q.pushLocked(task)
+}
+
+// pushLocked adds a task to the heap and index (must hold lock)
+func (q *TaskQueue) pushLocked(task *manufacturing.ManufacturingTask) {
 	q.taskByID[task.ID()] = task
+	q.depthByPipeline[task.PipelineID()]++
 	heap.Push(&q.tasks, task)
 }
 
@@ -95,9 +146,14 @@ func (q *TaskQueue) Dequeue() *manufacturing.ManufacturingTask {
 	q.mu.Lock()
 	defer q.mu.Unlock()
 
+	// Effective priority grows while tasks wait, so the order the heap was built
+	// in goes stale; rebuild it so an aged task is popped in its turn.
+	heap.Init(&q.tasks)
+
 	for q.tasks.Len() > 0 {
 		task := heap.Pop(&q.tasks).(*manufacturing.ManufacturingTask)
 		delete(q.taskByID, task.ID())
+		q.dropPipelineDepthLocked(task.PipelineID())
 
 		// Verify task is still ready (may have changed since enqueued)
 		if task.Status() == manufacturing.TaskStatusReady {
@@ -167,11 +223,13 @@ func (q *TaskQueue) Remove(taskID string) bool {
 
 // removeByIDLocked removes a task by ID (must hold lock)
 func (q *TaskQueue) removeByIDLocked(taskID string) bool {
-	if _, exists := q.taskByID[taskID]; !exists {
+	task, exists := q.taskByID[taskID]
+	if !exists {
 		return false
 	}
 
 	delete(q.taskByID, taskID)
+	q.dropPipelineDepthLocked(task.PipelineID())
 
 	for i, t := range q.tasks {
 		if t.ID() == taskID {
@@ -236,13 +294,18 @@ func (q *TaskQueue) GetReadyTasksByType(taskType manufacturing.TaskType) []*manu
 	return result
 }
 
-// Clear removes all tasks from the queue
+// Clear removes all tasks from the queue. Rejection counters are kept.
 func (q *TaskQueue) Clear() {
 	q.mu.Lock()
 	defer q.mu.Unlock()
 
+	q.clearLocked()
+}
+
+func (q *TaskQueue) clearLocked() {
 	q.tasks = make(taskHeap, 0)
 	q.taskByID = make(map[string]*manufacturing.ManufacturingTask)
+	q.depthByPipeline = make(map[string]int)
 	heap.Init(&q.tasks)
 }
 
@@ -277,13 +340,9 @@ func (q *TaskQueue) LoadFromRepository(ctx context.Context, repo manufacturing.T
 	q.mu.Lock()
 	defer q.mu.Unlock()
 
-	q.tasks = make(taskHeap, 0, len(tasks))
-	q.taskByID = make(map[string]*manufacturing.ManufacturingTask)
-	heap.Init(&q.tasks)
-
+	q.clearLocked()
 	for _, task := range tasks {
-		q.taskByID[task.ID()] = task
-		heap.Push(&q.tasks, task)
+		q.enqueueLocked(task)
 	}
 
 	return nil
@@ -0,0 +1,211 @@
+package services
+
+import (
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/andrescamacho/spacetraders-go/internal/adapters/metrics"
+	"github.com/andrescamacho/spacetraders-go/internal/domain/manufacturing"
+)
+
+const (
+	// DefaultTaskQueueCapacity is how many READY tasks a bounded queue holds
+	// before new ones must outrank a queued task to get in.
+	DefaultTaskQueueCapacity = 200
+
+	// DefaultTaskQueuePipelineQuota is how many READY tasks one pipeline may hold
+	// in a bounded queue, so a single runaway pipeline cannot fill it.
+	DefaultTaskQueuePipelineQuota = 25
+)
+
+// TaskQueueLimits bounds a TaskQueue. A zero field leaves that dimension
+// unbounded.
+type TaskQueueLimits struct {
+	Capacity      int
+	PipelineQuota int
+}
+
+// WithDefaults fills unset (zero or negative) limits with the defaults.
+func (l TaskQueueLimits) WithDefaults() TaskQueueLimits {
+	if l.Capacity <= 0 {
+		l.Capacity = DefaultTaskQueueCapacity
+	}
+	if l.PipelineQuota <= 0 {
+		l.PipelineQuota = DefaultTaskQueuePipelineQuota
+	}
+	return l
+}
+
+// TaskRejectReason says why a bounded queue turned a task away.
+type TaskRejectReason string
+
+const (
+	// TaskRejectPipelineQuota: the task's pipeline already held its quota.
+	TaskRejectPipelineQuota TaskRejectReason = "pipeline_quota"
+	// TaskRejectCapacity: the queue was full and the task outranked nothing in it.
+	TaskRejectCapacity TaskRejectReason = "capacity"
+	// TaskRejectEvicted: a queued task was displaced by a higher-priority one.
+	TaskRejectEvicted TaskRejectReason = "evicted"
+)
+
+// TaskQueueStats is a snapshot of a queue's depth and its rejections so far.
+type TaskQueueStats struct {
+	Capacity      int
+	PipelineQuota int
+	Depth         int
+
+	// OldestWait is how long the longest-waiting READY task has been queued.
+	OldestWait time.Duration
+
+	// Pipelines is ordered by depth, deepest first, then by pipeline ID. A
+	// pipeline with nothing queued appears only if it has had rejections.
+	Pipelines []PipelineQueueStats
+
+	Rejected map[TaskRejectReason]int
+}
+
+// PipelineQueueStats is one pipeline's share of a queue.
+type PipelineQueueStats struct {
+	PipelineID string
+	Depth      int
+	Rejected   int
+	OldestWait time.Duration
+}
+
+// Stats returns a snapshot of the queue's depth per pipeline and its
+// rejection counters.
+func (q *TaskQueue) Stats() TaskQueueStats {
+	q.mu.RLock()
+	defer q.mu.RUnlock()
+
+	now := time.Now()
+	stats := TaskQueueStats{
+		Capacity:      q.limits.Capacity,
+		PipelineQuota: q.limits.PipelineQuota,
+		Depth:         q.tasks.Len(),
+		Rejected:      make(map[TaskRejectReason]int, len(q.rejected)),
+	}
+	for reason, n := range q.rejected {
+		stats.Rejected[reason] = n
+	}
+
+	byPipeline := make(map[string]*PipelineQueueStats)
+	entry := func(pipelineID string) *PipelineQueueStats {
+		if p, ok := byPipeline[pipelineID]; ok {
+			return p
+		}
+		p := &PipelineQueueStats{PipelineID: pipelineID}
+		byPipeline[pipelineID] = p
+		return p
+	}
+	for _, task := range q.tasks {
+		p := entry(task.PipelineID())
+		p.Depth++
+		if readyAt := task.ReadyAt(); readyAt != nil {
+			wait := now.Sub(*readyAt)
+			if wait > p.OldestWait {
+				p.OldestWait = wait
+			}
+			if wait > stats.OldestWait {
+				stats.OldestWait = wait
+			}
+		}
+	}
+	for pipelineID, n := range q.rejectedByPipeline {
+		entry(pipelineID).Rejected = n
+	}
+
+	stats.Pipelines = make([]PipelineQueueStats, 0, len(byPipeline))
+	for _, p := range byPipeline {
+		stats.Pipelines = append(stats.Pipelines, *p)
+	}
+	sort.Slice(stats.Pipelines, func(i, j int) bool {
+		if stats.Pipelines[i].Depth != stats.Pipelines[j].Depth {
+			return stats.Pipelines[i].Depth > stats.Pipelines[j].Depth
+		}
+		return stats.Pipelines[i].PipelineID < stats.Pipelines[j].PipelineID
+	})
+	return stats
+}
+
+// rejectLocked counts a task turned away or displaced (must hold lock)
+func (q *TaskQueue) rejectLocked(task *manufacturing.ManufacturingTask, reason TaskRejectReason) {
+	q.rejected[reason]++
+	q.rejectedByPipeline[task.PipelineID()]++
+	metrics.RecordManufacturingTaskQueueRejection(q.playerID, string(task.TaskType()), string(reason))
+}
+
+// lowestPriorityLocked returns the queued task a full queue gives up first:
+// the lowest effective priority, the most recently readied on a tie (must
+// hold lock).
+func (q *TaskQueue) lowestPriorityLocked() *manufacturing.ManufacturingTask {
+	var lowest *manufacturing.ManufacturingTask
+	lowestPriority := 0
+	for _, task := range q.tasks {
+		priority := effectivePriority(task)
+		if lowest == nil || priority < lowestPriority ||
+			(priority == lowestPriority && readiedAfter(task, lowest)) {
+			lowest, lowestPriority = task, priority
+		}
+	}
+	return lowest
+}
+
+func readiedAfter(a, b *manufacturing.ManufacturingTask) bool {
+	aReady, bReady := a.ReadyAt(), b.ReadyAt()
+	return aReady != nil && bReady != nil && aReady.After(*bReady)
+}
+
+// dropPipelineDepthLocked forgets one queued task of pipelineID (must hold lock)
+func (q *TaskQueue) dropPipelineDepthLocked(pipelineID string) {
+	if q.depthByPipeline[pipelineID] <= 1 {
+		delete(q.depthByPipeline, pipelineID)
+		return
+	}
+	q.depthByPipeline[pipelineID]--
+}
+
+// TaskQueueRegistry owns one bounded task queue per player, so activation
+// passes that build their collaborators per tick still share a queue whose
+// depth and rejections operators can inspect.
+type TaskQueueRegistry struct {
+	limits TaskQueueLimits
+
+	mu     sync.Mutex
+	queues map[int]*TaskQueue
+}
+
+// NewTaskQueueRegistry creates a registry whose queues are bounded by limits.
+func NewTaskQueueRegistry(limits TaskQueueLimits) *TaskQueueRegistry {
+	return &TaskQueueRegistry{
+		limits: limits,
+		queues: make(map[int]*TaskQueue),
+	}
+}
+
+// Queue returns the player's queue, creating it on first use.
+func (r *TaskQueueRegistry) Queue(playerID int) *TaskQueue {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	queue, ok := r.queues[playerID]
+	if !ok {
+		queue = NewBoundedTaskQueue(r.limits, playerID)
+		r.queues[playerID] = queue
+	}
+	return queue
+}
+
+// TaskQueueStats returns the stats of the player's queue; false when the
+// player has no queue yet.
+func (r *TaskQueueRegistry) TaskQueueStats(playerID int) (TaskQueueStats, bool) {
+	r.mu.Lock()
+	queue, ok := r.queues[playerID]
+	r.mu.Unlock()
+
+	if !ok {
+		return TaskQueueStats{}, false
+	}
+	return queue.Stats(), true
+}
@@ -0,0 +1,110 @@
+package services
+
+import (
+	"testing"
+	"time"
+
+	"github.com/andrescamacho/spacetraders-go/internal/domain/manufacturing"
+)
+
+// boundedReadyTask is a READY ACQUIRE_DELIVER task of pipelineID at base priority.
+func boundedReadyTask(t *testing.T, pipelineID string, priority int) *manufacturing.ManufacturingTask {
+	t.Helper()
+	task := manufacturing.NewAcquireDeliverTask(pipelineID, 1, "IRON", "X1-A1", "X1-F1", nil)
+	if err := task.MarkReady(); err != nil {
+		t.Fatalf("mark ready: %v", err)
+	}
+	task.SetPriority(priority)
+	return task
+}
+
+// agedReadyTask is a READY task that has waited long enough to earn the full aging bonus.
+func agedReadyTask(pipelineID string, priority int) *manufacturing.ManufacturingTask {
+	readyAt := time.Now().Add(-2 * time.Hour)
+	return manufacturing.ReconstituteTask(
+		"aged-"+pipelineID, pipelineID, 1, manufacturing.TaskTypeAcquireDeliver, manufacturing.TaskStatusReady,
+		"IRON", 0, 0, "X1-A1", "", "X1-F1", "", "", "", nil, "",
+		priority, 0, 3, 0, 0, "", readyAt, &readyAt, nil, nil, false, false, nil,
+	)
+}
+
+func TestBoundedTaskQueue_PipelineQuotaRejectsTheOverflow(t *testing.T) {
+	q := NewBoundedTaskQueue(TaskQueueLimits{PipelineQuota: 2}, 1)
+	for i := 0; i < 3; i++ {
+		q.Enqueue(boundedReadyTask(t, "pipe-a", 10))
+	}
+	q.Enqueue(boundedReadyTask(t, "pipe-b", 10))
+
+	stats := q.Stats()
+	if stats.Depth != 3 {
+		t.Fatalf("expected 2 tasks of pipe-a and 1 of pipe-b queued, got depth %d", stats.Depth)
+	}
+	if stats.Rejected[TaskRejectPipelineQuota] != 1 {
+		t.Fatalf("expected one quota rejection, got %v", stats.Rejected)
+	}
+	if first := stats.Pipelines[0]; first.PipelineID != "pipe-a" || first.Depth != 2 || first.Rejected != 1 {
+		t.Fatalf("expected pipe-a first with depth 2 and 1 rejection, got %+v", first)
+	}
+}
+
+func TestBoundedTaskQueue_FullQueueAdmitsOnlyHigherPriority(t *testing.T) {
+	q := NewBoundedTaskQueue(TaskQueueLimits{Capacity: 2}, 1)
+	q.Enqueue(boundedReadyTask(t, "pipe-a", 10))
+	q.Enqueue(boundedReadyTask(t, "pipe-b", 20))
+
+	urgent := boundedReadyTask(t, "pipe-c", 50)
+	q.Enqueue(urgent)
+	q.Enqueue(boundedReadyTask(t, "pipe-d", 5))
+
+	stats := q.Stats()
+	if stats.Depth != 2 || q.GetTask(urgent.ID()) == nil {
+		t.Fatalf("expected the urgent task to displace one queued task, got depth %d", stats.Depth)
+	}
+	if stats.Rejected[TaskRejectEvicted] != 1 || stats.Rejected[TaskRejectCapacity] != 1 {
+		t.Fatalf("expected one eviction and one capacity rejection, got %v", stats.Rejected)
+	}
+	if top := q.Dequeue(); top != urgent {
+		t.Fatalf("expected the urgent task first, got %v", top)
+	}
+	if next := q.Dequeue(); next == nil || next.PipelineID() != "pipe-b" {
+		t.Fatalf("expected the priority-10 task to be the one evicted, got %v", next)
+	}
+}
+
+// Eviction ranks by effective priority: a task that has waited keeps its place
+// over a fresher one with a higher base priority.
+func TestBoundedTaskQueue_EvictionHonoursAging(t *testing.T) {
+	q := NewBoundedTaskQueue(TaskQueueLimits{Capacity: 2}, 1)
+	aged := agedReadyTask("pipe-old", 10)
+	q.Enqueue(aged)
+	q.Enqueue(boundedReadyTask(t, "pipe-fresh", 50))
+
+	q.Enqueue(boundedReadyTask(t, "pipe-new", 60))
+
+	if q.GetTask(aged.ID()) == nil {
+		t.Fatal("the aged task must outrank a fresh priority-50 task and survive eviction")
+	}
+	if q.Stats().Rejected[TaskRejectEvicted] != 1 {
+		t.Fatalf("expected the fresh task evicted, got %v", q.Stats().Rejected)
+	}
+}
+
+func TestTaskQueueRegistry_ClearKeepsRejectionCounters(t *testing.T) {
+	registry := NewTaskQueueRegistry(TaskQueueLimits{PipelineQuota: 1})
+	if _, ok := registry.TaskQueueStats(7); ok {
+		t.Fatal("a player without a queue has no stats")
+	}
+
+	q := registry.Queue(7)
+	q.Enqueue(boundedReadyTask(t, "pipe-a", 10))
+	q.Enqueue(boundedReadyTask(t, "pipe-a", 10))
+	q.Clear()
+
+	stats, ok := registry.TaskQueueStats(7)
+	if !ok || stats.Depth != 0 || stats.Rejected[TaskRejectPipelineQuota] != 1 {
+		t.Fatalf("expected an empty queue that remembers its rejection, got %+v", stats)
+	}
+	if registry.Queue(7) != q {
+		t.Fatal("the registry must hand the same queue back")
+	}
+}
@@ -0,0 +1,214 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/andrescamacho/spacetraders-go/internal/application/common"
+	"github.com/andrescamacho/spacetraders-go/internal/domain/manufacturing"
+	"github.com/andrescamacho/spacetraders-go/internal/domain/navigation"
+	"github.com/andrescamacho/spacetraders-go/internal/domain/shared"
+	"github.com/andrescamacho/spacetraders-go/internal/domain/system"
+)
+
+const (
+	// DefaultTaskWatchdogMinStuck is the floor below which no in-flight task is ever
+	// reclaimed, however short its route. It matches the construction drain's 30m
+	// per-supplyTask timeout, so a live worker has always given up on a task before the
+	// watchdog would take it.
+	DefaultTaskWatchdogMinStuck = 30 * time.Minute
+
+	// DefaultTaskWatchdogRouteMultiplier is how many estimated round trips a task may
+	// take before it is considered stuck — slack for refuel hops, dock waits and
+	// rate-limit backoff the straight-line estimate does not see.
+	DefaultTaskWatchdogRouteMultiplier = 3.0
+
+	// watchdogEngineSpeed is the engine speed the route estimate assumes: a slow
+	// hauler, so the estimate errs long and a slow ship is never reclaimed mid-haul.
+	watchdogEngineSpeed = 10
+
+	// watchdogStopOverhead covers docking, buying/selling and orbiting at each of
+	// the task's two stops.
+	watchdogStopOverhead = 2 * time.Minute
+
+	watchdogReleaseReason = "manufacturing_task_watchdog"
+)
+
+// TaskWatchdogResult counts what one Sweep reclaimed.
+type TaskWatchdogResult struct {
+	// Reclaimed tasks went back to READY for another ship.
+	Reclaimed int
+	// DeadLettered tasks had spent their retries and were parked as DEAD_LETTER.
+	DeadLettered int
+}
+
+// TaskWatchdog reclaims manufacturing tasks left in ASSIGNED or EXECUTING by a worker
+// that stopped making progress — most often a ship whose container died mid-task,
+// which nothing else ever returns to the queue. A task is stuck once it has been in
+// flight longer than its expected duration: the estimated route time between its two
+// stops times a multiplier, floored at a minimum. A stuck task's ship claim is
+// released and the task goes back to READY with its retry counter bumped; the retry
+// that exhausts the budget dead-letters it (ManufacturingTask.ReclaimStuck).
+type TaskWatchdog struct {
+	taskRepo  manufacturing.TaskRepository
+	shipRepo  navigation.ShipRepository
+	waypoints system.WaypointRepository
+	clock     shared.Clock
+
+	minStuck        time.Duration
+	routeMultiplier float64
+}
+
+// NewTaskWatchdog builds a watchdog. waypoints is optional: without it every task
+// gets the minStuck floor. minStuck <= 0 and routeMultiplier <= 0 select the
+// defaults; a nil clock defaults to the real clock.
+func NewTaskWatchdog(
+	taskRepo manufacturing.TaskRepository,
+	shipRepo navigation.ShipRepository,
+	waypoints system.WaypointRepository,
+	clock shared.Clock,
+	minStuck time.Duration,
+	routeMultiplier float64,
+) *TaskWatchdog {
+	if clock == nil {
+		clock = shared.NewRealClock()
+	}
+	if minStuck <= 0 {
+		minStuck = DefaultTaskWatchdogMinStuck
+	}
+	if routeMultiplier <= 0 {
+		routeMultiplier = DefaultTaskWatchdogRouteMultiplier
+	}
+	return &TaskWatchdog{
+		taskRepo:        taskRepo,
+		shipRepo:        shipRepo,
+		waypoints:       waypoints,
+		clock:           clock,
+		minStuck:        minStuck,
+		routeMultiplier: routeMultiplier,
+	}
+}
+
+// Sweep reclaims every stuck in-flight task of the player. A failure on one task is
+// logged and skipped so it cannot shield the others; only a failed task listing is
+// returned as an error.
+func (w *TaskWatchdog) Sweep(ctx context.Context, playerID int) (TaskWatchdogResult, error) {
+	logger := common.LoggerFromContext(ctx)
+	var result TaskWatchdogResult
+
+	for _, status := range []manufacturing.TaskStatus{manufacturing.TaskStatusAssigned, manufacturing.TaskStatusExecuting} {
+		tasks, err := w.taskRepo.FindByStatus(ctx, playerID, status)
+		if err != nil {
+			return result, fmt.Errorf("failed to list %s tasks: %w", status, err)
+		}
+		for _, task := range tasks {
+			since := inFlightSince(task)
+			allowed := w.ExpectedDuration(ctx, task)
+			now := w.clock.Now()
+			age := now.Sub(since)
+			if age <= allowed {
+				continue
+			}
+
+			ship := task.AssignedShip()
+			reason := fmt.Sprintf("stuck in %s for %s (expected at most %s)", task.Status(), age.Round(time.Second), allowed.Round(time.Second))
+			deadLettered, err := task.ReclaimStuck(reason)
+			if err != nil {
+				logger.Log("WARNING", fmt.Sprintf("Task watchdog: could not reclaim task %s: %v", task.ID(), err), nil)
+				continue
+			}
+			if err := w.taskRepo.Update(ctx, task); err != nil {
+				logger.Log("WARNING", fmt.Sprintf("Task watchdog: could not persist reclaimed task %s: %v", task.ID(), err), nil)
+				continue
+			}
+			if ship != "" {
+				w.releaseShip(ctx, ship, now.Add(-allowed), playerID)
+			}
+
+			verb := "reclaimed"
+			if deadLettered {
+				verb = "dead-lettered"
+				result.DeadLettered++
+			} else {
+				result.Reclaimed++
+			}
+			logger.Log("WARNING", fmt.Sprintf("Task watchdog: %s task %s (%s %s, ship %s): %s", verb, task.ID(), task.TaskType(), task.Good(), ship, reason), map[string]interface{}{
+				"action":        "manufacturing_task_watchdog",
+				"task_id":       task.ID(),
+				"task_type":     string(task.TaskType()),
+				"good":          task.Good(),
+				"ship":          ship,
+				"retry_count":   task.RetryCount(),
+				"dead_lettered": deadLettered,
+			})
+		}
+	}
+	return result, nil
+}
+
+// ExpectedDuration is how long task may stay in flight before it is stuck: the
+// estimated trip between its first and final stops, times the route multiplier, never
+// below the minStuck floor. The trip is counted twice — the ship first has to reach
+// the first stop from wherever it was — and a stop that cannot be located falls back
+// to the floor.
+func (w *TaskWatchdog) ExpectedDuration(ctx context.Context, task *manufacturing.ManufacturingTask) time.Duration {
+	if w.waypoints == nil {
+		return w.minStuck
+	}
+	from := w.findWaypoint(ctx, task.GetFirstDestination())
+	to := w.findWaypoint(ctx, task.GetFinalDestination())
+	if from == nil || to == nil {
+		return w.minStuck
+	}
+
+	leg := time.Duration(shared.FlightModeCruise.TravelTime(from.DistanceTo(to), watchdogEngineSpeed)) * time.Second
+	trip := 2*leg + 2*watchdogStopOverhead
+	expected := time.Duration(float64(trip) * w.routeMultiplier)
+	return max(expected, w.minStuck)
+}
+
+func (w *TaskWatchdog) findWaypoint(ctx context.Context, symbol string) *shared.Waypoint {
+	if symbol == "" {
+		return nil
+	}
+	wp, err := w.waypoints.FindBySymbol(ctx, symbol, shared.ExtractSystemSymbol(symbol))
+	if err != nil {
+		return nil
+	}
+	return wp
+}
+
+// releaseShip returns the stuck task's ship to the idle pool — but only a container
+// claim taken before claimedBefore (now minus the task's allowance), i.e. one held at
+// least as long as the task was allowed to run. A younger claim belongs to newer work
+// (the hull was already freed and re-claimed) and is left alone, as is a captain
+// reservation.
+func (w *TaskWatchdog) releaseShip(ctx context.Context, shipSymbol string, claimedBefore time.Time, playerID int) {
+	pid, err := shared.NewPlayerID(playerID)
+	if err != nil {
+		return
+	}
+	if _, _, err := w.shipRepo.SaveWithRetry(ctx, shipSymbol, pid, func(sh *navigation.Ship) (bool, error) {
+		if !sh.IsAssigned() || sh.Assignment().IsCaptainReservation() || sh.Assignment().AssignedAt().After(claimedBefore) {
+			return false, nil
+		}
+		sh.ForceRelease(watchdogReleaseReason, w.clock)
+		return true, nil
+	}); err != nil {
+		common.LoggerFromContext(ctx).Log("WARNING", fmt.Sprintf("Task watchdog: could not release ship %s: %v", shipSymbol, err), nil)
+	}
+}
+
+// inFlightSince is when the task went in flight: its execution start, or — for a task
+// still ASSIGNED, which carries no assignment timestamp — when it became READY, which
+// can only overstate how long it has been assigned.
+func inFlightSince(task *manufacturing.ManufacturingTask) time.Time {
+	if started := task.StartedAt(); started != nil {
+		return *started
+	}
+	if ready := task.ReadyAt(); ready != nil {
+		return *ready
+	}
+	return task.CreatedAt()
+}
@@ -0,0 +1,168 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/andrescamacho/spacetraders-go/internal/domain/manufacturing"
+	"github.com/andrescamacho/spacetraders-go/internal/domain/navigation"
+	"github.com/andrescamacho/spacetraders-go/internal/domain/shared"
+	"github.com/andrescamacho/spacetraders-go/internal/domain/system"
+)
+
+// watchdogStubTaskRepo serves in-flight tasks by status and records updates.
+type watchdogStubTaskRepo struct {
+	manufacturing.TaskRepository
+
+	byStatus map[manufacturing.TaskStatus][]*manufacturing.ManufacturingTask
+	updated  []*manufacturing.ManufacturingTask
+}
+
+func (r *watchdogStubTaskRepo) FindByStatus(_ context.Context, _ int, status manufacturing.TaskStatus) ([]*manufacturing.ManufacturingTask, error) {
+	return r.byStatus[status], nil
+}
+
+func (r *watchdogStubTaskRepo) Update(_ context.Context, task *manufacturing.ManufacturingTask) error {
+	r.updated = append(r.updated, task)
+	return nil
+}
+
+// watchdogStubShipRepo applies SaveWithRetry mutations to in-memory ships.
+type watchdogStubShipRepo struct {
+	navigation.ShipRepository
+
+	ships map[string]*navigation.Ship
+}
+
+func (r *watchdogStubShipRepo) SaveWithRetry(_ context.Context, symbol string, _ shared.PlayerID, mutate navigation.ShipMutation) (*navigation.Ship, bool, error) {
+	ship, ok := r.ships[symbol]
+	if !ok {
+		return nil, false, fmt.Errorf("ship %s not found", symbol)
+	}
+	changed, err := mutate(ship)
+	return ship, changed, err
+}
+
+// watchdogStubWaypoints places every waypoint on the x axis at a fixed coordinate.
+type watchdogStubWaypoints struct {
+	system.WaypointRepository
+
+	x map[string]float64
+}
+
+func (r *watchdogStubWaypoints) FindBySymbol(_ context.Context, symbol, _ string) (*shared.Waypoint, error) {
+	x, ok := r.x[symbol]
+	if !ok {
+		return nil, fmt.Errorf("waypoint %s not found", symbol)
+	}
+	return shared.NewWaypoint(symbol, x, 0)
+}
+
+func executingWatchdogTask(t *testing.T, ship string) *manufacturing.ManufacturingTask {
+	t.Helper()
+	task := manufacturing.NewAcquireDeliverTask("pipeline-1", 1, "COPPER", "X1-TEST-A1", "X1-TEST-F1", nil)
+	if err := task.MarkReady(); err != nil {
+		t.Fatalf("MarkReady: %v", err)
+	}
+	if err := task.AssignShip(ship); err != nil {
+		t.Fatalf("AssignShip: %v", err)
+	}
+	if err := task.StartExecution(); err != nil {
+		t.Fatalf("StartExecution: %v", err)
+	}
+	return task
+}
+
+// A task whose worker died is reclaimed once it outlives its allowance: the task
+// goes back to READY with a retry counted and its long-held ship claim released.
+func TestTaskWatchdog_ReclaimsTaskPastAllowanceAndReleasesShip(t *testing.T) {
+	task := executingWatchdogTask(t, "HAULER-1")
+	clock := &shared.MockClock{CurrentTime: time.Now().Add(-2 * time.Hour)}
+	ship := newStopTestAssignedShip(t, "HAULER-1", "dead-ctr", clock)
+	clock.CurrentTime = time.Now().Add(45 * time.Minute)
+
+	taskRepo := &watchdogStubTaskRepo{byStatus: map[manufacturing.TaskStatus][]*manufacturing.ManufacturingTask{
+		manufacturing.TaskStatusExecuting: {task},
+	}}
+	shipRepo := &watchdogStubShipRepo{ships: map[string]*navigation.Ship{"HAULER-1": ship}}
+	watchdog := NewTaskWatchdog(taskRepo, shipRepo, nil, clock, 0, 0)
+
+	result, err := watchdog.Sweep(context.Background(), 1)
+	if err != nil {
+		t.Fatalf("Sweep: %v", err)
+	}
+	if result.Reclaimed != 1 || result.DeadLettered != 0 {
+		t.Fatalf("expected 1 reclaimed, got %+v", result)
+	}
+	if task.Status() != manufacturing.TaskStatusReady || task.RetryCount() != 1 || task.AssignedShip() != "" {
+		t.Fatalf("expected READY, retry 1, no ship; got %s, retry %d, ship %q", task.Status(), task.RetryCount(), task.AssignedShip())
+	}
+	if len(taskRepo.updated) != 1 {
+		t.Fatalf("expected the reclaimed task to be persisted, got %d updates", len(taskRepo.updated))
+	}
+	if ship.IsAssigned() {
+		t.Fatalf("expected the dead worker's claim on HAULER-1 to be released")
+	}
+}
+
+// A task still inside its allowance is left alone.
+func TestTaskWatchdog_LeavesTaskWithinAllowance(t *testing.T) {
+	task := executingWatchdogTask(t, "HAULER-1")
+	clock := &shared.MockClock{CurrentTime: time.Now().Add(20 * time.Minute)}
+	taskRepo := &watchdogStubTaskRepo{byStatus: map[manufacturing.TaskStatus][]*manufacturing.ManufacturingTask{
+		manufacturing.TaskStatusExecuting: {task},
+	}}
+	watchdog := NewTaskWatchdog(taskRepo, &watchdogStubShipRepo{}, nil, clock, 0, 0)
+
+	result, err := watchdog.Sweep(context.Background(), 1)
+	if err != nil {
+		t.Fatalf("Sweep: %v", err)
+	}
+	if result.Reclaimed != 0 || task.Status() != manufacturing.TaskStatusExecuting || len(taskRepo.updated) != 0 {
+		t.Fatalf("a task inside the 30m floor must not be touched: result=%+v status=%s", result, task.Status())
+	}
+}
+
+// A long route raises the allowance above the floor: the same 45 minutes that
+// reclaims a short-haul task is normal for a far one.
+func TestTaskWatchdog_ExpectedDurationScalesWithRoute(t *testing.T) {
+	task := executingWatchdogTask(t, "HAULER-1")
+	waypoints := &watchdogStubWaypoints{x: map[string]float64{"X1-TEST-A1": 0, "X1-TEST-F1": 600}}
+	watchdog := NewTaskWatchdog(&watchdogStubTaskRepo{}, &watchdogStubShipRepo{}, waypoints, &shared.MockClock{CurrentTime: time.Now()}, 0, 0)
+
+	// 600 units in CRUISE at speed 10 is 1860s a leg; (2 legs + 2 stops) x 3.
+	want := 3 * (2*1860*time.Second + 2*watchdogStopOverhead)
+	if got := watchdog.ExpectedDuration(context.Background(), task); got != want {
+		t.Fatalf("expected %s, got %s", want, got)
+	}
+
+	waypoints.x["X1-TEST-F1"] = 10
+	if got := watchdog.ExpectedDuration(context.Background(), task); got != DefaultTaskWatchdogMinStuck {
+		t.Fatalf("a short route must fall back to the %s floor, got %s", DefaultTaskWatchdogMinStuck, got)
+	}
+}
+
+// A hull claimed after the task went overdue belongs to newer work and keeps its claim.
+func TestTaskWatchdog_KeepsYoungerClaimOnShip(t *testing.T) {
+	task := executingWatchdogTask(t, "HAULER-1")
+	clock := &shared.MockClock{CurrentTime: time.Now().Add(40 * time.Minute)}
+	ship := newStopTestAssignedShip(t, "HAULER-1", "new-ctr", clock)
+	clock.CurrentTime = time.Now().Add(45 * time.Minute)
+
+	taskRepo := &watchdogStubTaskRepo{byStatus: map[manufacturing.TaskStatus][]*manufacturing.ManufacturingTask{
+		manufacturing.TaskStatusExecuting: {task},
+	}}
+	watchdog := NewTaskWatchdog(taskRepo, &watchdogStubShipRepo{ships: map[string]*navigation.Ship{"HAULER-1": ship}}, nil, clock, 0, 0)
+
+	if _, err := watchdog.Sweep(context.Background(), 1); err != nil {
+		t.Fatalf("Sweep: %v", err)
+	}
+	if task.Status() != manufacturing.TaskStatusReady {
+		t.Fatalf("the stuck task is still reclaimed, got %s", task.Status())
+	}
+	if !ship.IsAssigned() || ship.ContainerID() != "new-ctr" {
+		t.Fatalf("a claim taken after the task went overdue must survive")
+	}
+}
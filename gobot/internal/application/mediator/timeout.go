@@ -0,0 +1,41 @@
+package mediator
+
+import (
+	"context"
+	"reflect"
+	"strings"
+	"time"
+)
+
+// TimeoutMiddleware bounds each request whose type has an entry in timeouts
+// with a context deadline. Entries are keyed by the request's type name without
+// its package ("NavigateRouteCommand"), matched case-insensitively since config
+// keys arrive lowercased. Requests without an entry run as before, and a
+// caller's earlier deadline — an RPC's, say — still wins.
+func TimeoutMiddleware(timeouts map[string]time.Duration) Middleware {
+	byName := make(map[string]time.Duration, len(timeouts))
+	for name, timeout := range timeouts {
+		byName[strings.ToLower(name)] = timeout
+	}
+	return func(ctx context.Context, request Request, next HandlerFunc) (Response, error) {
+		timeout, ok := byName[strings.ToLower(requestTypeName(request))]
+		if !ok || timeout <= 0 {
+			return next(ctx, request)
+		}
+		ctx, cancel := context.WithTimeout(ctx, timeout)
+		defer cancel()
+		return next(ctx, request)
+	}
+}
+
+// requestTypeName is the request's type name with any pointer stripped.
+func requestTypeName(request Request) string {
+	t := reflect.TypeOf(request)
+	for t != nil && t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	if t == nil {
+		return ""
+	}
+	return t.Name()
+}
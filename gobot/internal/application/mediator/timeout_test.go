@@ -0,0 +1,35 @@
+package mediator
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+type slowCommand struct{}
+
+type quickQuery struct{}
+
+func TestTimeoutMiddleware_BoundsOnlyListedRequests(t *testing.T) {
+	middleware := TimeoutMiddleware(map[string]time.Duration{"slowcommand": time.Minute})
+
+	var deadline bool
+	next := func(ctx context.Context, _ Request) (Response, error) {
+		_, deadline = ctx.Deadline()
+		return nil, nil
+	}
+
+	if _, err := middleware(context.Background(), &slowCommand{}, next); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !deadline {
+		t.Fatal("a listed request must run under a deadline, whatever the key's case")
+	}
+
+	if _, err := middleware(context.Background(), &quickQuery{}, next); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if deadline {
+		t.Fatal("an unlisted request must run without a deadline")
+	}
+}
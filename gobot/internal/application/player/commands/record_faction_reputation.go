@@ -0,0 +1,73 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/andrescamacho/spacetraders-go/internal/application/common"
+	"github.com/andrescamacho/spacetraders-go/internal/domain/player"
+	domainPorts "github.com/andrescamacho/spacetraders-go/internal/domain/ports"
+	"github.com/andrescamacho/spacetraders-go/internal/domain/shared"
+)
+
+// RecordFactionReputationCommand reads the agent's current faction reputation
+// from the API and appends it to the snapshot history the faction-standing
+// query is computed from.
+type RecordFactionReputationCommand struct {
+	PlayerID shared.PlayerID
+}
+
+// RecordFactionReputationResponse carries the snapshot that was stored.
+type RecordFactionReputationResponse struct {
+	Reputations []player.FactionReputation
+}
+
+// RecordFactionReputationHandler handles the RecordFactionReputation command.
+type RecordFactionReputationHandler struct {
+	apiClient domainPorts.APIClient
+	repo      player.FactionReputationRepository
+	clock     shared.Clock
+}
+
+// NewRecordFactionReputationHandler creates a new RecordFactionReputationHandler.
+// A nil clock defaults to the real clock.
+func NewRecordFactionReputationHandler(apiClient domainPorts.APIClient, repo player.FactionReputationRepository, clock shared.Clock) *RecordFactionReputationHandler {
+	if clock == nil {
+		clock = shared.NewRealClock()
+	}
+	return &RecordFactionReputationHandler{apiClient: apiClient, repo: repo, clock: clock}
+}
+
+// Handle executes the RecordFactionReputation command.
+func (h *RecordFactionReputationHandler) Handle(ctx context.Context, request common.Request) (common.Response, error) {
+	cmd, ok := request.(*RecordFactionReputationCommand)
+	if !ok {
+		return nil, fmt.Errorf("invalid request type: expected *RecordFactionReputationCommand")
+	}
+
+	token, err := common.PlayerTokenFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := h.apiClient.GetMyFactionReputation(ctx, token)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read faction reputation: %w", err)
+	}
+
+	capturedAt := h.clock.Now()
+	reputations := make([]player.FactionReputation, 0, len(data))
+	for _, d := range data {
+		reputations = append(reputations, player.FactionReputation{
+			FactionSymbol: d.FactionSymbol,
+			Reputation:    d.Reputation,
+			CapturedAt:    capturedAt,
+		})
+	}
+
+	if err := h.repo.SaveSnapshot(ctx, cmd.PlayerID.Value(), reputations); err != nil {
+		return nil, err
+	}
+
+	return &RecordFactionReputationResponse{Reputations: reputations}, nil
+}
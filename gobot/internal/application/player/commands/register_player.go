@@ -11,27 +11,43 @@ import (
 	"github.com/andrescamacho/spacetraders-go/internal/domain/shared"
 )
 
-// RegisterPlayerCommand represents a command to register a new player
+// RegisterPlayerCommand represents a command to register a new player.
+//
+// With Token set it adopts an agent that already exists. With Token empty and
+// AccountToken set it first creates the agent via the API (POST /register),
+// then stores the minted token — the path used right after a server reset.
+//
+// The callsign is deliberately not named AgentSymbol: PlayerTokenMiddleware
+// resolves any AgentSymbol field against the players table and would reject
+// the command before the player it creates exists.
 type RegisterPlayerCommand struct {
-	AgentSymbol string
-	Token       string                 // JWT token from SpaceTraders API registration
-	Metadata    map[string]interface{} // Optional metadata (faction, headquarters, etc.)
+	Symbol       string                 // Callsign of the agent
+	Token        string                 // JWT token from SpaceTraders API registration
+	AccountToken string                 // Account token; used only when Token is empty
+	Faction      string                 // Starting faction; required when registering via the API
+	Metadata     map[string]interface{} // Optional metadata (faction, headquarters, etc.)
 }
 
 // RegisterPlayerResponse represents the result of registering a player
 type RegisterPlayerResponse struct {
 	Player *player.Player
+	// Registered is true when the agent was created through the API by this
+	// command rather than adopted from a caller-supplied token.
+	Registered bool
 }
 
 // RegisterPlayerHandler handles the RegisterPlayer command
 type RegisterPlayerHandler struct {
 	playerRepo player.PlayerRepository
+	apiClient  domainPorts.APIClient
 }
 
-// NewRegisterPlayerHandler creates a new RegisterPlayerHandler
-func NewRegisterPlayerHandler(playerRepo player.PlayerRepository) *RegisterPlayerHandler {
+// NewRegisterPlayerHandler creates a new RegisterPlayerHandler. apiClient is
+// only consulted for API registrations and may be nil for token adoption.
+func NewRegisterPlayerHandler(playerRepo player.PlayerRepository, apiClient domainPorts.APIClient) *RegisterPlayerHandler {
 	return &RegisterPlayerHandler{
 		playerRepo: playerRepo,
+		apiClient:  apiClient,
 	}
 }
 
@@ -42,26 +58,77 @@ func (h *RegisterPlayerHandler) Handle(ctx context.Context, request common.Reque
 		return nil, fmt.Errorf("invalid request type: expected *RegisterPlayerCommand")
 	}
 
-	if cmd.AgentSymbol == "" {
+	if cmd.Symbol == "" {
 		return nil, fmt.Errorf("agent_symbol is required")
 	}
-	if cmd.Token == "" {
-		return nil, fmt.Errorf("token is required")
+	if cmd.Token == "" && cmd.AccountToken == "" {
+		return nil, fmt.Errorf("token or account_token is required")
 	}
 
-	player := &player.Player{
-		AgentSymbol: cmd.AgentSymbol,
-		Token:       cmd.Token,
-		Metadata:    cmd.Metadata,
-		Credits:     0, // Will be synced from API later
+	metadata := make(map[string]interface{}, len(cmd.Metadata)+2)
+	for k, v := range cmd.Metadata {
+		metadata[k] = v
 	}
 
-	if err := h.playerRepo.Add(ctx, player); err != nil {
+	agentSymbol := cmd.Symbol
+	token := cmd.Token
+	faction := cmd.Faction
+	registered := false
+
+	if token == "" {
+		if cmd.Faction == "" {
+			return nil, fmt.Errorf("faction is required to register a new agent")
+		}
+		if h.apiClient == nil {
+			return nil, fmt.Errorf("agent registration requires an API client")
+		}
+
+		registration, err := h.apiClient.RegisterAgent(ctx, cmd.AccountToken, cmd.Symbol, cmd.Faction)
+		if err != nil {
+			return nil, err
+		}
+
+		token = registration.Token
+		registered = true
+		if registration.Agent != nil {
+			agentSymbol = registration.Agent.Symbol
+			faction = registration.Agent.StartingFaction
+			metadata["account_id"] = registration.Agent.AccountID
+			metadata["headquarters"] = registration.Agent.Headquarters
+		}
+	}
+
+	if faction != "" {
+		metadata["starting_faction"] = faction
+	}
+
+	newPlayer := &player.Player{
+		AgentSymbol:     agentSymbol,
+		Token:           token,
+		StartingFaction: faction,
+		Metadata:        metadata,
+		Credits:         0, // Will be synced from API later
+	}
+
+	if err := h.playerRepo.Add(ctx, newPlayer); err != nil {
+		if registered {
+			// The token is a credential: it never goes into an error or a log.
+			return nil, fmt.Errorf("agent %s registered but failed to save player; recover its token from the account before retrying: %w", agentSymbol, err)
+		}
 		return nil, fmt.Errorf("failed to save player: %w", err)
 	}
 
+	// Add upserts by primary key, so a fresh row's ID is assigned by the
+	// store; re-read it so callers can address the new agent immediately.
+	if newPlayer.ID.IsZero() {
+		if stored, err := h.playerRepo.FindByAgentSymbol(ctx, agentSymbol); err == nil && stored != nil {
+			newPlayer.ID = stored.ID
+		}
+	}
+
 	return &RegisterPlayerResponse{
-		Player: player,
+		Player:     newPlayer,
+		Registered: registered,
 	}, nil
 }
 
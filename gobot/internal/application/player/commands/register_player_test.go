@@ -0,0 +1,102 @@
+package commands
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/andrescamacho/spacetraders-go/internal/domain/player"
+	domainPorts "github.com/andrescamacho/spacetraders-go/internal/domain/ports"
+	"github.com/andrescamacho/spacetraders-go/internal/domain/shared"
+)
+
+// registerFakeRepo assigns IDs on Add the way the store does, without
+// backfilling them onto the caller's struct.
+type registerFakeRepo struct {
+	player.PlayerRepository
+	bySymbol map[string]*player.Player
+	addErr   error
+}
+
+func (r *registerFakeRepo) Add(_ context.Context, p *player.Player) error {
+	if r.addErr != nil {
+		return r.addErr
+	}
+	stored := *p
+	stored.ID = shared.MustNewPlayerID(len(r.bySymbol) + 7)
+	r.bySymbol[p.AgentSymbol] = &stored
+	return nil
+}
+
+func (r *registerFakeRepo) FindByAgentSymbol(_ context.Context, symbol string) (*player.Player, error) {
+	return r.bySymbol[symbol], nil
+}
+
+type registerFakeAPI struct {
+	domainPorts.APIClient
+	calls int
+}
+
+func (f *registerFakeAPI) RegisterAgent(_ context.Context, accountToken, agentSymbol, faction string) (*domainPorts.AgentRegistration, error) {
+	f.calls++
+	return &domainPorts.AgentRegistration{
+		Token: "minted-" + accountToken,
+		Agent: &player.AgentData{Symbol: agentSymbol, Headquarters: "X1-RA-A1", StartingFaction: faction},
+	}, nil
+}
+
+// With no agent token the handler registers through the API, stores the
+// minted token and resolves the store-assigned player ID.
+func TestRegisterPlayer_RegistersViaAPIWhenTokenMissing(t *testing.T) {
+	repo := &registerFakeRepo{bySymbol: map[string]*player.Player{}}
+	api := &registerFakeAPI{}
+	handler := NewRegisterPlayerHandler(repo, api)
+
+	resp, err := handler.Handle(context.Background(), &RegisterPlayerCommand{
+		Symbol:       "NEWBIE",
+		AccountToken: "acct",
+		Faction:      "COSMIC",
+	})
+	require.NoError(t, err)
+
+	out := resp.(*RegisterPlayerResponse)
+	require.True(t, out.Registered)
+	require.Equal(t, 1, api.calls)
+	require.Equal(t, 7, out.Player.ID.Value())
+	require.Equal(t, "minted-acct", repo.bySymbol["NEWBIE"].Token)
+	require.Equal(t, "COSMIC", repo.bySymbol["NEWBIE"].StartingFaction)
+	require.Equal(t, "X1-RA-A1", repo.bySymbol["NEWBIE"].Metadata["headquarters"])
+}
+
+// A supplied token is adopted as-is; the API is never called.
+func TestRegisterPlayer_AdoptsSuppliedToken(t *testing.T) {
+	repo := &registerFakeRepo{bySymbol: map[string]*player.Player{}}
+	api := &registerFakeAPI{}
+	handler := NewRegisterPlayerHandler(repo, api)
+
+	resp, err := handler.Handle(context.Background(), &RegisterPlayerCommand{Symbol: "VETERAN", Token: "jwt"})
+	require.NoError(t, err)
+	require.False(t, resp.(*RegisterPlayerResponse).Registered)
+	require.Zero(t, api.calls)
+	require.Equal(t, "jwt", repo.bySymbol["VETERAN"].Token)
+}
+
+func TestRegisterPlayer_RegistrationRequiresFaction(t *testing.T) {
+	handler := NewRegisterPlayerHandler(&registerFakeRepo{bySymbol: map[string]*player.Player{}}, &registerFakeAPI{})
+
+	_, err := handler.Handle(context.Background(), &RegisterPlayerCommand{Symbol: "NEWBIE", AccountToken: "acct"})
+	require.ErrorContains(t, err, "faction is required")
+}
+
+// A store failure after registration names the agent but never the minted
+// token.
+func TestRegisterPlayer_SaveFailureKeepsTokenOutOfError(t *testing.T) {
+	repo := &registerFakeRepo{bySymbol: map[string]*player.Player{}, addErr: errors.New("db down")}
+	handler := NewRegisterPlayerHandler(repo, &registerFakeAPI{})
+
+	_, err := handler.Handle(context.Background(), &RegisterPlayerCommand{Symbol: "NEWBIE", AccountToken: "acct", Faction: "COSMIC"})
+	require.ErrorContains(t, err, "NEWBIE")
+	require.NotContains(t, err.Error(), "minted-acct")
+}
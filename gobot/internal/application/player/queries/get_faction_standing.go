@@ -0,0 +1,64 @@
+package queries
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/andrescamacho/spacetraders-go/internal/application/mediator"
+	"github.com/andrescamacho/spacetraders-go/internal/domain/player"
+	"github.com/andrescamacho/spacetraders-go/internal/domain/shared"
+)
+
+// DefaultFactionStandingWindow is the history a standing's gain is measured over
+// when the query leaves Window unset.
+const DefaultFactionStandingWindow = 7 * 24 * time.Hour
+
+// GetFactionStandingQuery asks for the player's standing with every faction it has
+// reputation snapshots for: the latest reputation and its gain over Window.
+type GetFactionStandingQuery struct {
+	PlayerID shared.PlayerID
+	Window   time.Duration // <=0 => DefaultFactionStandingWindow
+}
+
+// GetFactionStandingResponse lists standings in preference order: factions we are
+// building reputation with first (see player.ComputeFactionStandings).
+type GetFactionStandingResponse struct {
+	Standings []player.FactionStanding
+}
+
+// GetFactionStandingHandler handles the GetFactionStanding query from persisted
+// snapshots only; it never calls the API.
+type GetFactionStandingHandler struct {
+	repo  player.FactionReputationRepository
+	clock shared.Clock
+}
+
+// NewGetFactionStandingHandler creates a new GetFactionStandingHandler. A nil
+// clock defaults to the real clock.
+func NewGetFactionStandingHandler(repo player.FactionReputationRepository, clock shared.Clock) *GetFactionStandingHandler {
+	if clock == nil {
+		clock = shared.NewRealClock()
+	}
+	return &GetFactionStandingHandler{repo: repo, clock: clock}
+}
+
+// Handle executes the GetFactionStanding query.
+func (h *GetFactionStandingHandler) Handle(ctx context.Context, request mediator.Request) (mediator.Response, error) {
+	query, ok := request.(*GetFactionStandingQuery)
+	if !ok {
+		return nil, fmt.Errorf("invalid request type: expected *GetFactionStandingQuery")
+	}
+
+	window := query.Window
+	if window <= 0 {
+		window = DefaultFactionStandingWindow
+	}
+
+	history, err := h.repo.FindSince(ctx, query.PlayerID.Value(), h.clock.Now().Add(-window))
+	if err != nil {
+		return nil, err
+	}
+
+	return &GetFactionStandingResponse{Standings: player.ComputeFactionStandings(history)}, nil
+}
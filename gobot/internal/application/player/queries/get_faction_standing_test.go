@@ -0,0 +1,47 @@
+package queries
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/andrescamacho/spacetraders-go/internal/domain/player"
+	"github.com/andrescamacho/spacetraders-go/internal/domain/shared"
+)
+
+type stubReputationRepo struct {
+	history []player.FactionReputation
+	since   time.Time
+}
+
+func (s *stubReputationRepo) SaveSnapshot(_ context.Context, _ int, _ []player.FactionReputation) error {
+	return nil
+}
+
+func (s *stubReputationRepo) FindSince(_ context.Context, _ int, since time.Time) ([]player.FactionReputation, error) {
+	s.since = since
+	return s.history, nil
+}
+
+func TestGetFactionStanding_RanksFromWindowedHistory(t *testing.T) {
+	now := time.Date(2030, 1, 8, 0, 0, 0, 0, time.UTC)
+	repo := &stubReputationRepo{history: []player.FactionReputation{
+		{FactionSymbol: "GALACTIC", Reputation: 80, CapturedAt: now.Add(-48 * time.Hour)},
+		{FactionSymbol: "GALACTIC", Reputation: 80, CapturedAt: now.Add(-time.Hour)},
+		{FactionSymbol: "COSMIC", Reputation: 5, CapturedAt: now.Add(-48 * time.Hour)},
+		{FactionSymbol: "COSMIC", Reputation: 20, CapturedAt: now.Add(-time.Hour)},
+	}}
+	handler := NewGetFactionStandingHandler(repo, &shared.MockClock{CurrentTime: now})
+
+	resp, err := handler.Handle(context.Background(), &GetFactionStandingQuery{PlayerID: shared.MustNewPlayerID(1)})
+	require.NoError(t, err)
+	require.Equal(t, now.Add(-DefaultFactionStandingWindow), repo.since, "unset window uses the default")
+
+	standings := resp.(*GetFactionStandingResponse).Standings
+	require.Len(t, standings, 2)
+	require.Equal(t, "COSMIC", standings[0].FactionSymbol, "the faction we are building reputation with ranks first")
+	require.Equal(t, 15, standings[0].Gain)
+	require.Equal(t, "GALACTIC", standings[1].FactionSymbol)
+}
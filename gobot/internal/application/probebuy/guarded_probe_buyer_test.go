@@ -94,7 +94,7 @@ func probeTxn(t *testing.T, ts time.Time, price int) *ledger.Transaction {
 	tx, err := ledger.NewTransaction(
 		shared.MustNewPlayerID(1), ts, ledger.TransactionTypePurchaseShip,
 		-price, price+10, 10, "Purchased SHIP_PROBE",
-		map[string]interface{}{"ship_type": ProbeShipType}, "", "", "freshness sizer",
+		map[string]interface{}{"ship_type": ProbeShipType}, "", "", "freshness sizer", "",
 	)
 	require.NoError(t, err)
 	return tx
@@ -3,6 +3,7 @@ package commands
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/andrescamacho/spacetraders-go/internal/application/common"
 	"github.com/andrescamacho/spacetraders-go/internal/domain/daemon"
@@ -14,9 +15,15 @@ import (
 
 // AssignScoutingFleetCommand automatically assigns all probe/satellite ships to market scouting
 // Filters out FUEL_STATION marketplaces
+//
+// With FreshnessSLA set the assignment becomes a standing refresh loop instead of a
+// one-off partition: each pass asks the MarketRefreshScheduler which markets are
+// falling out of the SLA and sends the scouts on a single tour of just those,
+// re-planning every pass interval until the container is stopped.
 type AssignScoutingFleetCommand struct {
 	PlayerID     shared.PlayerID
 	SystemSymbol string
+	FreshnessSLA time.Duration
 }
 
 // AssignScoutingFleetResponse contains the results of fleet assignment
@@ -35,6 +42,8 @@ type AssignScoutingFleetHandler struct {
 	routingClient routing.RoutingClient
 	daemonClient  daemon.DaemonClient
 	clock         shared.Clock
+
+	refreshScheduler *MarketRefreshScheduler
 }
 
 // NewAssignScoutingFleetHandler creates a new assign scouting fleet handler
@@ -59,6 +68,12 @@ func NewAssignScoutingFleetHandler(
 	}
 }
 
+// SetMarketRefreshScheduler wires the scheduler that drives FreshnessSLA mode. Without
+// it a command carrying an SLA is refused rather than silently run as a full partition.
+func (h *AssignScoutingFleetHandler) SetMarketRefreshScheduler(scheduler *MarketRefreshScheduler) {
+	h.refreshScheduler = scheduler
+}
+
 // Handle executes the assign scouting fleet command
 func (h *AssignScoutingFleetHandler) Handle(ctx context.Context, request common.Request) (common.Response, error) {
 	cmd, ok := request.(*AssignScoutingFleetCommand)
@@ -76,6 +91,10 @@ func (h *AssignScoutingFleetHandler) Handle(ctx context.Context, request common.
 		return nil, err
 	}
 
+	if cmd.FreshnessSLA > 0 {
+		return h.runRefreshPasses(ctx, cmd, marketSymbols)
+	}
+
 	scoutCmd := h.buildScoutMarketsCommand(cmd, extractShipSymbols(scoutShips), marketSymbols)
 
 	scoutResult, err := h.executeScoutMarkets(ctx, scoutCmd)
@@ -142,6 +161,83 @@ func (h *AssignScoutingFleetHandler) buildScoutMarketsCommand(
 	}
 }
 
+// runRefreshPasses is FreshnessSLA mode: each pass plans the due markets, sends the
+// system's scouts on one tour of them, and waits a pass interval. A pass with nothing
+// due dispatches nothing, so running tours are left alone. It returns the last pass
+// that dispatched once the context is cancelled.
+func (h *AssignScoutingFleetHandler) runRefreshPasses(
+	ctx context.Context,
+	cmd *AssignScoutingFleetCommand,
+	marketSymbols []string,
+) (*AssignScoutingFleetResponse, error) {
+	if h.refreshScheduler == nil {
+		return nil, fmt.Errorf("freshness SLA requested but no market refresh scheduler is configured")
+	}
+	logger := common.LoggerFromContext(ctx)
+	response := &AssignScoutingFleetResponse{Assignments: map[string][]string{}}
+
+	for {
+		tasks, err := h.refreshScheduler.Plan(ctx, cmd.PlayerID, cmd.SystemSymbol, marketSymbols, cmd.FreshnessSLA)
+		if err != nil {
+			return nil, err
+		}
+
+		if len(tasks) > 0 {
+			_, scoutShips, err := h.validateAndLoadShips(ctx, cmd)
+			if err != nil {
+				return nil, err
+			}
+			due := make([]string, len(tasks))
+			breached := 0
+			for i, task := range tasks {
+				due[i] = task.WaypointSymbol
+				if task.Breached {
+					breached++
+				}
+			}
+
+			scoutCmd := h.buildScoutMarketsCommand(cmd, extractShipSymbols(scoutShips), due)
+			scoutCmd.Iterations = 1
+			scoutResult, err := h.executeScoutMarkets(ctx, scoutCmd)
+			if err != nil {
+				return nil, err
+			}
+			response = h.buildResponse(extractShipSymbols(scoutShips), scoutResult)
+
+			logger.Log("INFO", "Market refresh pass dispatched", map[string]interface{}{
+				"action":        "market_refresh_pass",
+				"system":        cmd.SystemSymbol,
+				"due_markets":   len(due),
+				"breached":      breached,
+				"stalest":       due[0],
+				"scouts":        len(scoutShips),
+				"freshness_sla": cmd.FreshnessSLA.String(),
+			})
+		}
+
+		if !h.sleepInterruptibly(ctx, h.refreshScheduler.PassInterval(cmd.FreshnessSLA)) {
+			return response, nil
+		}
+	}
+}
+
+// sleepInterruptibly waits for d on h.clock, returning false if ctx was cancelled
+// first (same idiom as the scout post coordinator's copy).
+func (h *AssignScoutingFleetHandler) sleepInterruptibly(ctx context.Context, d time.Duration) bool {
+	done := make(chan struct{})
+	go func() {
+		h.clock.Sleep(d)
+		close(done)
+	}()
+
+	select {
+	case <-done:
+		return ctx.Err() == nil
+	case <-ctx.Done():
+		return false
+	}
+}
+
 // executeScoutMarkets creates the ScoutMarketsHandler and executes the command
 func (h *AssignScoutingFleetHandler) executeScoutMarkets(
 	ctx context.Context,
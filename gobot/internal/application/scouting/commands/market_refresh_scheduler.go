@@ -0,0 +1,108 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	domainScouting "github.com/andrescamacho/spacetraders-go/internal/domain/scouting"
+	"github.com/andrescamacho/spacetraders-go/internal/domain/shared"
+)
+
+// defaultMarketRefreshMinPassInterval floors the derived pass interval so a very
+// tight SLA cannot turn the refresh loop into a hot re-plan spin.
+const defaultMarketRefreshMinPassInterval = time.Minute
+
+// MarketRefreshSchedulerConfig holds the refresh scheduler's knobs. Zero values defer
+// to the domain defaults (scouting.DefaultRefresh*), RULINGS #5.
+type MarketRefreshSchedulerConfig struct {
+	LeadPercent  int
+	MaxMarkets   int
+	UsageWindow  time.Duration
+	PassInterval time.Duration
+}
+
+// MarketRefreshScheduler decides which markets a freshness-SLA scouting pass should
+// visit. Markets otherwise only refresh when a hull happens to pass by; the scheduler
+// ranks a system's markets by data age and trading importance (recent arbitrage and
+// manufacturing usage) and hands AssignScoutingFleet the stalest high-value ones as
+// scout tour tasks, so probe time goes where stale prices actually cost money.
+type MarketRefreshScheduler struct {
+	reader domainScouting.MarketRefreshReader
+	config MarketRefreshSchedulerConfig
+	clock  shared.Clock
+}
+
+// NewMarketRefreshScheduler creates a scheduler over the persisted refresh census.
+func NewMarketRefreshScheduler(
+	reader domainScouting.MarketRefreshReader,
+	config MarketRefreshSchedulerConfig,
+	clock shared.Clock,
+) *MarketRefreshScheduler {
+	if clock == nil {
+		clock = shared.NewRealClock()
+	}
+	if config.UsageWindow <= 0 {
+		config.UsageWindow = domainScouting.DefaultRefreshUsageWindow
+	}
+	return &MarketRefreshScheduler{reader: reader, config: config, clock: clock}
+}
+
+// Plan returns the refresh tasks due in systemSymbol under sla, most urgent first.
+// markets is the set of charted markets the pass may visit: census rows outside it
+// (fuel stations, say) are ignored, and charted markets with no census row are
+// scheduled as never scanned.
+func (s *MarketRefreshScheduler) Plan(
+	ctx context.Context,
+	playerID shared.PlayerID,
+	systemSymbol string,
+	markets []string,
+	sla time.Duration,
+) ([]domainScouting.MarketRefreshTask, error) {
+	now := s.clock.Now()
+	census, err := s.reader.MarketRefreshCandidates(ctx, playerID.Value(), systemSymbol, now, now.Add(-s.config.UsageWindow))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read market refresh census: %w", err)
+	}
+
+	byWaypoint := make(map[string]domainScouting.MarketRefreshCandidate, len(census))
+	for _, candidate := range census {
+		byWaypoint[candidate.WaypointSymbol] = candidate
+	}
+	candidates := make([]domainScouting.MarketRefreshCandidate, 0, len(markets))
+	for _, market := range markets {
+		candidate, ok := byWaypoint[market]
+		if !ok {
+			candidate = domainScouting.MarketRefreshCandidate{WaypointSymbol: market}
+		}
+		candidates = append(candidates, candidate)
+	}
+
+	return s.policy(sla).Plan(candidates), nil
+}
+
+// PassInterval is how long the refresh loop waits between passes: the configured
+// interval, or else the gap between a market falling due and breaching the SLA, so
+// a market that was not yet due on one pass is picked up before it breaches.
+func (s *MarketRefreshScheduler) PassInterval(sla time.Duration) time.Duration {
+	if s.config.PassInterval > 0 {
+		return s.config.PassInterval
+	}
+	lead := s.policy(sla).LeadPercent
+	if lead <= 0 || lead > 100 {
+		lead = domainScouting.DefaultRefreshLeadPercent
+	}
+	interval := sla * time.Duration(100-lead) / 100
+	if interval < defaultMarketRefreshMinPassInterval {
+		interval = defaultMarketRefreshMinPassInterval
+	}
+	return interval
+}
+
+func (s *MarketRefreshScheduler) policy(sla time.Duration) domainScouting.MarketRefreshPolicy {
+	return domainScouting.MarketRefreshPolicy{
+		SLA:         sla,
+		LeadPercent: s.config.LeadPercent,
+		MaxMarkets:  s.config.MaxMarkets,
+	}
+}
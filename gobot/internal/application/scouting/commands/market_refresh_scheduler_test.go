@@ -0,0 +1,122 @@
+package commands
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/andrescamacho/spacetraders-go/internal/domain/navigation"
+	domainScouting "github.com/andrescamacho/spacetraders-go/internal/domain/scouting"
+	"github.com/andrescamacho/spacetraders-go/internal/domain/shared"
+	"github.com/andrescamacho/spacetraders-go/internal/domain/system"
+)
+
+// fakeRefreshReader serves one census per pass; once the scripted passes run out it
+// cancels the loop's context so the test can observe a bounded run.
+type fakeRefreshReader struct {
+	passes     [][]domainScouting.MarketRefreshCandidate
+	calls      int
+	usageSince []time.Time
+	cancel     context.CancelFunc
+}
+
+func (r *fakeRefreshReader) MarketRefreshCandidates(_ context.Context, _ int, _ string, _, usageSince time.Time) ([]domainScouting.MarketRefreshCandidate, error) {
+	r.usageSince = append(r.usageSince, usageSince)
+	r.calls++
+	if r.calls >= len(r.passes) && r.cancel != nil {
+		r.cancel()
+	}
+	if r.calls > len(r.passes) {
+		return nil, nil
+	}
+	return r.passes[r.calls-1], nil
+}
+
+// refreshWaypointRepo lists a fixed set of marketplaces for the fleet assignment.
+type refreshWaypointRepo struct {
+	system.WaypointRepository
+	markets fakeMultiMarketProvider
+}
+
+func (r *refreshWaypointRepo) ListBySystemWithTrait(ctx context.Context, systemSymbol, trait string) ([]*shared.Waypoint, error) {
+	return r.markets.ListBySystemWithTrait(ctx, systemSymbol, trait)
+}
+
+// Charted markets missing from the census are scheduled as never scanned; census rows
+// outside the charted set (a filtered fuel station) are ignored.
+func TestMarketRefreshScheduler_PlanMergesChartedMarkets(t *testing.T) {
+	clock := &shared.MockClock{CurrentTime: time.Date(2030, 1, 1, 12, 0, 0, 0, time.UTC)}
+	reader := &fakeRefreshReader{passes: [][]domainScouting.MarketRefreshCandidate{{
+		{WaypointSymbol: "X1-RF-M1", Scanned: true, AgeSeconds: 100},
+		{WaypointSymbol: "X1-RF-FUEL", Scanned: true, AgeSeconds: 99999},
+	}}}
+	scheduler := NewMarketRefreshScheduler(reader, MarketRefreshSchedulerConfig{UsageWindow: 6 * time.Hour}, clock)
+
+	tasks, err := scheduler.Plan(context.Background(), shared.MustNewPlayerID(1), "X1-RF", []string{"X1-RF-M1", "X1-RF-M2"}, time.Hour)
+	require.NoError(t, err)
+	require.Len(t, tasks, 1)
+	require.Equal(t, "X1-RF-M2", tasks[0].WaypointSymbol)
+	require.False(t, tasks[0].Scanned)
+	require.Equal(t, clock.Now().Add(-6*time.Hour), reader.usageSince[0])
+
+	require.Equal(t, 15*time.Minute, scheduler.PassInterval(time.Hour), "SLA × (100-75)%")
+	require.Equal(t, time.Minute, scheduler.PassInterval(2*time.Minute), "floored at one minute")
+}
+
+// In SLA mode the fleet is toured over only the due markets; a pass with nothing due
+// dispatches nothing and leaves the running tours alone.
+func TestAssignScoutingFleet_FreshnessSLATourOnlyDueMarkets(t *testing.T) {
+	clock := &shared.MockClock{CurrentTime: time.Date(2030, 1, 1, 12, 0, 0, 0, time.UTC)}
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	reader := &fakeRefreshReader{cancel: cancel, passes: [][]domainScouting.MarketRefreshCandidate{
+		{
+			{WaypointSymbol: "X1-RF-M1", Scanned: true, AgeSeconds: 4000},
+			{WaypointSymbol: "X1-RF-M2", Scanned: true, AgeSeconds: 60},
+		},
+		{
+			{WaypointSymbol: "X1-RF-M1", Scanned: true, AgeSeconds: 60},
+			{WaypointSymbol: "X1-RF-M2", Scanned: true, AgeSeconds: 120},
+		},
+	}}
+	shipRepo := &fakeMarketsShipRepo{ships: []*navigation.Ship{newScoutTestSatellite(t, "SAT-A", "X1-RF-M2")}}
+	daemonC := &fakeMarketsDaemon{}
+	waypoints := &refreshWaypointRepo{markets: fakeMultiMarketProvider{markets: map[string][]string{"X1-RF": {"X1-RF-M1", "X1-RF-M2"}}}}
+
+	handler := NewAssignScoutingFleetHandler(shipRepo, waypoints, &fakeMarketsGraph{}, &fakeMarketsRouting{}, daemonC, clock)
+	handler.SetMarketRefreshScheduler(NewMarketRefreshScheduler(reader, MarketRefreshSchedulerConfig{}, clock))
+
+	resp, err := handler.Handle(ctx, &AssignScoutingFleetCommand{
+		PlayerID:     shared.MustNewPlayerID(1),
+		SystemSymbol: "X1-RF",
+		FreshnessSLA: time.Hour,
+	})
+	require.NoError(t, err)
+	require.Equal(t, 2, reader.calls, "the loop re-plans after each pass interval")
+	require.Len(t, daemonC.created, 1, "one tour, from the pass that had a market due")
+	require.Empty(t, daemonC.stopped)
+
+	r, ok := resp.(*AssignScoutingFleetResponse)
+	require.True(t, ok)
+	require.Equal(t, map[string][]string{"SAT-A": {"X1-RF-M1"}}, r.Assignments, "only the due market is toured")
+}
+
+// An SLA with no scheduler wired is refused rather than run as a full partition.
+func TestAssignScoutingFleet_FreshnessSLAWithoutScheduler(t *testing.T) {
+	clock := &shared.MockClock{CurrentTime: time.Now()}
+	shipRepo := &fakeMarketsShipRepo{ships: []*navigation.Ship{newScoutTestSatellite(t, "SAT-A", "X1-RF-M1")}}
+	waypoints := &refreshWaypointRepo{markets: fakeMultiMarketProvider{markets: map[string][]string{"X1-RF": {"X1-RF-M1"}}}}
+	daemonC := &fakeMarketsDaemon{}
+	handler := NewAssignScoutingFleetHandler(shipRepo, waypoints, &fakeMarketsGraph{}, &fakeMarketsRouting{}, daemonC, clock)
+
+	_, err := handler.Handle(context.Background(), &AssignScoutingFleetCommand{
+		PlayerID:     shared.MustNewPlayerID(1),
+		SystemSymbol: "X1-RF",
+		FreshnessSLA: time.Hour,
+	})
+	require.ErrorContains(t, err, "no market refresh scheduler")
+	require.Empty(t, daemonC.created)
+}
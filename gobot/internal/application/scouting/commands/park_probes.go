@@ -0,0 +1,360 @@
+package commands
+
+// ParkProbes is the standing PASSIVE PRICE FEED: instead of touring, each idle probe is
+// parked at one high-value market, docked, and left there re-scanning on a short
+// cadence. A parked probe pays no travel between scans, so the markets arbitrage leans
+// on hardest carry prices that are minutes old rather than a tour-lap old.
+//
+// Each tick it groups the idle probes by system, ranks that system's markets by
+// trading importance (the same arbitrage/manufacturing usage weights the refresh
+// scheduler uses), skips the markets that already hold a parked probe, and parks the
+// nearest idle probe at each remaining market in rank order — one probe per market.
+// A parked probe is an ordinary single-market scout_tour (infinite, DockAtPost) with
+// no coordinator link, so it survives a daemon restart on its own; the coordinator
+// recognises its probes by their container-id prefix and where they sit, so a
+// restart re-derives the parked set from ship state alone.
+//
+// It only ever claims IDLE probes, so hulls already manning a scout post or a tour
+// are never poached; ReserveIdleProbes keeps a few idle for the post reconciler and
+// the backfill sweep.
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/andrescamacho/spacetraders-go/internal/application/common"
+	"github.com/andrescamacho/spacetraders-go/internal/application/health"
+	"github.com/andrescamacho/spacetraders-go/internal/domain/daemon"
+	"github.com/andrescamacho/spacetraders-go/internal/domain/navigation"
+	domainScouting "github.com/andrescamacho/spacetraders-go/internal/domain/scouting"
+	"github.com/andrescamacho/spacetraders-go/internal/domain/shared"
+	"github.com/andrescamacho/spacetraders-go/pkg/utils"
+)
+
+const (
+	// parkDefaultTickSeconds paces the standing loop. Parking is cheap to re-check, but
+	// probes only free up as other work finishes, so a couple of minutes is plenty.
+	parkDefaultTickSeconds = 120
+
+	// parkDefaultScanInterval is a parked probe's re-scan cadence: the scan floor, since
+	// a parked probe exists to keep its market as fresh as the API budget allows.
+	parkDefaultScanInterval = scanIntervalFloor
+
+	// parkUsageWindow is how far back trading activity counts towards a market's rank.
+	parkUsageWindow = domainScouting.DefaultRefreshUsageWindow
+
+	// parkedProbeOperation names the claim (and container-id prefix) of a parked probe.
+	parkedProbeOperation = "probe_park"
+)
+
+// ParkProbesCommand launches the standing probe-parking coordinator for a player. Like
+// the sibling coordinators it runs an infinite reconcile loop inside one Handle() call.
+type ParkProbesCommand struct {
+	PlayerID         shared.PlayerID
+	ContainerID      string
+	TickIntervalSecs int
+
+	// ScanIntervalSecs is each parked probe's re-scan cadence. <= 0 → the scan floor.
+	// The scout tour clamps it to [scanIntervalFloor, scanIntervalCap] either way.
+	ScanIntervalSecs int
+
+	// ReserveIdleProbes is how many idle probes each tick leaves unparked, fleet-wide,
+	// so scout posts and sweeps still find a hull. <= 0 parks every idle probe.
+	ReserveIdleProbes int
+}
+
+// ParkProbesResponse reports reconcile progress (observed only on shutdown, since the
+// loop is infinite).
+type ParkProbesResponse struct {
+	Ticks  int
+	Parked int
+	Errors []string
+}
+
+// ParkProbesHandler parks idle probes at the highest-value unwatched markets each tick.
+// Registered singleton: it holds no per-player state, deriving every decision from the
+// ship roster and the market census.
+type ParkProbesHandler struct {
+	shipRepo       navigation.ShipRepository
+	marketProvider MarketWaypointProvider
+	census         domainScouting.MarketRefreshReader
+	daemonClient   daemon.DaemonClient
+	clock          shared.Clock
+}
+
+// NewParkProbesHandler wires the coordinator. A nil clock defaults to the real clock.
+func NewParkProbesHandler(
+	shipRepo navigation.ShipRepository,
+	marketProvider MarketWaypointProvider,
+	census domainScouting.MarketRefreshReader,
+	daemonClient daemon.DaemonClient,
+	clock shared.Clock,
+) *ParkProbesHandler {
+	if clock == nil {
+		clock = shared.NewRealClock()
+	}
+	return &ParkProbesHandler{
+		shipRepo:       shipRepo,
+		marketProvider: marketProvider,
+		census:         census,
+		daemonClient:   daemonClient,
+		clock:          clock,
+	}
+}
+
+// Handle runs the reconcile loop until the context is cancelled.
+func (h *ParkProbesHandler) Handle(ctx context.Context, request common.Request) (common.Response, error) {
+	logger := common.LoggerFromContext(ctx)
+
+	cmd, ok := request.(*ParkProbesCommand)
+	if !ok {
+		return nil, fmt.Errorf("invalid request type")
+	}
+
+	tick := time.Duration(cmd.TickIntervalSecs) * time.Second
+	if tick <= 0 {
+		tick = parkDefaultTickSeconds * time.Second
+	}
+
+	result := &ParkProbesResponse{Errors: []string{}}
+	logger.Log("INFO", fmt.Sprintf("Probe parking coordinator starting (tick %s)", tick), map[string]interface{}{
+		"action":       "probe_park_start",
+		"container_id": cmd.ContainerID,
+	})
+
+	errMon := health.NewMonitor(health.DefaultStreakThreshold)
+	for {
+		select {
+		case <-ctx.Done():
+			return result, ctx.Err()
+		default:
+		}
+
+		parked, err := h.ReconcileOnce(ctx, cmd)
+		result.Parked += parked
+		if err != nil {
+			result.Errors = append(result.Errors, err.Error())
+			logger.Log("ERROR", fmt.Sprintf("Probe parking reconcile failed: %v", err), nil)
+		}
+		if _, crossed := errMon.Note("reconcile", errString(err)); crossed {
+			logger.Log("ERROR", "Probe parking reconcile stuck in an error loop", map[string]interface{}{
+				"action":       "probe_park_error_loop",
+				"container_id": cmd.ContainerID,
+			})
+		}
+		result.Ticks++
+
+		select {
+		case <-shared.After(shared.ClockFromContext(ctx), tick):
+		case <-ctx.Done():
+			return result, ctx.Err()
+		}
+	}
+}
+
+// ReconcileOnce is one parking pass — the unit the tests drive directly. It returns how
+// many probes it parked. A failure to park one probe is logged and the pass moves on;
+// only a failure to read the roster aborts it.
+func (h *ParkProbesHandler) ReconcileOnce(ctx context.Context, cmd *ParkProbesCommand) (int, error) {
+	logger := common.LoggerFromContext(ctx)
+
+	idle, err := h.shipRepo.FindIdleByPlayer(ctx, cmd.PlayerID)
+	if err != nil {
+		return 0, fmt.Errorf("failed to list idle ships: %w", err)
+	}
+	active, err := h.shipRepo.FindActiveByPlayer(ctx, cmd.PlayerID)
+	if err != nil {
+		return 0, fmt.Errorf("failed to list assigned ships: %w", err)
+	}
+
+	watched := parkedMarkets(active)
+	idleBySystem := idleProbesBySystem(idle)
+	budget := 0
+	for _, probes := range idleBySystem {
+		budget += len(probes)
+	}
+	budget -= max(cmd.ReserveIdleProbes, 0)
+
+	systems := make([]string, 0, len(idleBySystem))
+	for system := range idleBySystem {
+		systems = append(systems, system)
+	}
+	sort.Strings(systems)
+
+	parked := 0
+	for _, system := range systems {
+		if parked >= budget {
+			break
+		}
+		markets, err := h.rankedMarkets(ctx, cmd.PlayerID, system)
+		if err != nil {
+			logger.Log("WARNING", fmt.Sprintf("Probe parking skipped %s: %v", system, err), nil)
+			continue
+		}
+		probes := idleBySystem[system]
+		for _, market := range markets {
+			if parked >= budget || len(probes) == 0 {
+				break
+			}
+			if watched[market.Symbol] {
+				continue
+			}
+			var probe *navigation.Ship
+			probe, probes = takeNearestProbe(probes, market)
+			if err := h.park(ctx, cmd, probe, market.Symbol); err != nil {
+				logger.Log("WARNING", fmt.Sprintf("Probe parking failed to park %s at %s: %v", probe.ShipSymbol(), market.Symbol, err), nil)
+				continue
+			}
+			watched[market.Symbol] = true
+			parked++
+		}
+	}
+
+	logger.Log("INFO", fmt.Sprintf("Probe parking cycle: %d markets watched, parked %d", len(watched), parked), map[string]interface{}{
+		"action":       "probe_park_cycle",
+		"watched":      len(watched),
+		"parked":       parked,
+		"container_id": cmd.ContainerID,
+	})
+	return parked, nil
+}
+
+// parkedMarkets is the set of markets that already hold a parked probe: where each
+// probe claimed under a probe_park container sits, or is headed.
+func parkedMarkets(assigned []*navigation.Ship) map[string]bool {
+	watched := make(map[string]bool)
+	for _, ship := range assigned {
+		if ship.IsScoutType() && strings.HasPrefix(ship.ContainerID(), parkedProbeOperation+"-") {
+			watched[ship.CurrentLocation().Symbol] = true
+		}
+	}
+	return watched
+}
+
+// idleProbesBySystem groups the idle scout hulls by the system they sit in, sorted by
+// symbol so the pass is deterministic. A parked probe only ever navigates in-system.
+func idleProbesBySystem(idle []*navigation.Ship) map[string][]*navigation.Ship {
+	bySystem := make(map[string][]*navigation.Ship)
+	for _, ship := range idle {
+		if !ship.IsScoutType() {
+			continue
+		}
+		system := ship.CurrentLocation().SystemSymbol
+		bySystem[system] = append(bySystem[system], ship)
+	}
+	for _, probes := range bySystem {
+		sort.Slice(probes, func(i, j int) bool { return probes[i].ShipSymbol() < probes[j].ShipSymbol() })
+	}
+	return bySystem
+}
+
+// rankedMarkets returns the system's markets, fuel stations excluded, highest trading
+// importance first. Markets missing from the census rank at the baseline importance;
+// ties go to the stalest, then to the waypoint symbol.
+func (h *ParkProbesHandler) rankedMarkets(ctx context.Context, playerID shared.PlayerID, system string) ([]*shared.Waypoint, error) {
+	waypoints, err := h.marketProvider.ListBySystemWithTrait(ctx, system, marketplaceTrait)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list marketplaces: %w", err)
+	}
+	now := h.clock.Now()
+	census, err := h.census.MarketRefreshCandidates(ctx, playerID.Value(), system, now, now.Add(-parkUsageWindow))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read market census: %w", err)
+	}
+	byWaypoint := make(map[string]domainScouting.MarketRefreshCandidate, len(census))
+	for _, candidate := range census {
+		byWaypoint[candidate.WaypointSymbol] = candidate
+	}
+
+	markets := make([]*shared.Waypoint, 0, len(waypoints))
+	for _, wp := range waypoints {
+		if wp.Type != "FUEL_STATION" {
+			markets = append(markets, wp)
+		}
+	}
+	var policy domainScouting.MarketRefreshPolicy
+	sort.SliceStable(markets, func(i, j int) bool {
+		a, b := byWaypoint[markets[i].Symbol], byWaypoint[markets[j].Symbol]
+		if ia, ib := policy.Importance(a), policy.Importance(b); ia != ib {
+			return ia > ib
+		}
+		if a.Scanned != b.Scanned {
+			return !a.Scanned
+		}
+		if a.AgeSeconds != b.AgeSeconds {
+			return a.AgeSeconds > b.AgeSeconds
+		}
+		return markets[i].Symbol < markets[j].Symbol
+	})
+	return markets, nil
+}
+
+// takeNearestProbe removes and returns the probe closest to market.
+func takeNearestProbe(probes []*navigation.Ship, market *shared.Waypoint) (*navigation.Ship, []*navigation.Ship) {
+	best := 0
+	for i := 1; i < len(probes); i++ {
+		if probes[i].CurrentLocation().DistanceTo(market) < probes[best].CurrentLocation().DistanceTo(market) {
+			best = i
+		}
+	}
+	probe := probes[best]
+	return probe, append(probes[:best:best], probes[best+1:]...)
+}
+
+// park spawns the probe's standing single-market tour: persist, claim, start — the
+// scout post coordinator's spawn order, undoing the earlier steps when a later one
+// fails so a half-parked probe never stays claimed.
+func (h *ParkProbesHandler) park(ctx context.Context, cmd *ParkProbesCommand, probe *navigation.Ship, market string) error {
+	scanInterval := time.Duration(cmd.ScanIntervalSecs) * time.Second
+	if scanInterval <= 0 {
+		scanInterval = parkDefaultScanInterval
+	}
+
+	workerID := utils.GenerateContainerID(parkedProbeOperation, probe.ShipSymbol())
+	tourCmd := &ScoutTourCommand{
+		PlayerID:     cmd.PlayerID,
+		ShipSymbol:   probe.ShipSymbol(),
+		Markets:      []string{market},
+		Iterations:   -1,
+		ScanInterval: scanInterval,
+		DockAtPost:   true,
+	}
+
+	if err := h.daemonClient.PersistContainer(ctx, daemon.ContainerKindScoutTour, workerID, uint(cmd.PlayerID.Value()), tourCmd); err != nil {
+		return fmt.Errorf("failed to persist parked scout tour: %w", err)
+	}
+	if err := h.shipRepo.ClaimShip(ctx, probe.ShipSymbol(), workerID, cmd.PlayerID, parkedProbeOperation); err != nil {
+		_ = h.daemonClient.StopContainer(ctx, workerID)
+		return fmt.Errorf("failed to claim probe %s: %w", probe.ShipSymbol(), err)
+	}
+	if err := h.daemonClient.StartContainer(ctx, daemon.ContainerKindScoutTour, workerID); err != nil {
+		h.release(ctx, cmd, probe.ShipSymbol())
+		_ = h.daemonClient.StopContainer(ctx, workerID)
+		return fmt.Errorf("failed to start parked scout tour: %w", err)
+	}
+
+	common.LoggerFromContext(ctx).Log("INFO", fmt.Sprintf("Parked %s at %s (tour %s, scan every %s)", probe.ShipSymbol(), market, workerID, scanInterval), map[string]interface{}{
+		"action":       "probe_parked",
+		"ship_symbol":  probe.ShipSymbol(),
+		"waypoint":     market,
+		"container_id": workerID,
+	})
+	return nil
+}
+
+// release frees a probe whose parked tour failed to start.
+func (h *ParkProbesHandler) release(ctx context.Context, cmd *ParkProbesCommand, shipSymbol string) {
+	if _, _, err := h.shipRepo.SaveWithRetry(ctx, shipSymbol, cmd.PlayerID,
+		func(sh *navigation.Ship) (bool, error) {
+			if !sh.IsAssigned() {
+				return false, nil
+			}
+			sh.ForceRelease("probe_park_start_failed", h.clock)
+			return true, nil
+		}); err != nil {
+		common.LoggerFromContext(ctx).Log("WARNING", fmt.Sprintf("Failed to release probe %s: %v", shipSymbol, err), nil)
+	}
+}
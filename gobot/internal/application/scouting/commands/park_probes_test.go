@@ -0,0 +1,190 @@
+package commands
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/andrescamacho/spacetraders-go/internal/domain/navigation"
+	domainScouting "github.com/andrescamacho/spacetraders-go/internal/domain/scouting"
+	"github.com/andrescamacho/spacetraders-go/internal/domain/shared"
+)
+
+// Port-to-port: enter through ReconcileOnce, assert at the daemon client (the parked
+// tours persisted and started) and the ship repository (the claims). Doubles sit at the
+// ship roster, the marketplace list, the refresh census and the daemon client.
+
+// parkMarketProvider lists a fixed set of marketplace waypoints per system.
+type parkMarketProvider struct {
+	bySystem map[string][]*shared.Waypoint
+}
+
+func (p *parkMarketProvider) ListBySystemWithTrait(_ context.Context, systemSymbol, _ string) ([]*shared.Waypoint, error) {
+	return p.bySystem[systemSymbol], nil
+}
+
+// parkCensus serves a fixed refresh census per system.
+type parkCensus struct {
+	bySystem map[string][]domainScouting.MarketRefreshCandidate
+}
+
+func (c *parkCensus) MarketRefreshCandidates(_ context.Context, _ int, systemSymbol string, _, _ time.Time) ([]domainScouting.MarketRefreshCandidate, error) {
+	return c.bySystem[systemSymbol], nil
+}
+
+func parkMarket(t *testing.T, symbol, waypointType string, x, y float64) *shared.Waypoint {
+	t.Helper()
+	wp, err := shared.NewWaypoint(symbol, x, y)
+	require.NoError(t, err)
+	wp.Type = waypointType
+	return wp
+}
+
+func newParkTestProbe(t *testing.T, symbol string, location *shared.Waypoint) *navigation.Ship {
+	t.Helper()
+	fuel, err := shared.NewFuel(100, 100)
+	require.NoError(t, err)
+	cargo, err := shared.NewCargo(0, 0, nil)
+	require.NoError(t, err)
+	ship, err := navigation.NewShip(symbol, shared.MustNewPlayerID(1), location, fuel, 100, 0, cargo, 30, "FRAME_PROBE", "SATELLITE", nil, navigation.NavStatusInOrbit)
+	require.NoError(t, err)
+	return ship
+}
+
+type parkFixture struct {
+	handler *ParkProbesHandler
+	ships   *fakeScoutShipRepo
+	daemon  *fakeScoutDaemonClient
+}
+
+func newParkFixture(ships []*navigation.Ship, markets map[string][]*shared.Waypoint, census map[string][]domainScouting.MarketRefreshCandidate) *parkFixture {
+	clock := &shared.MockClock{CurrentTime: time.Date(2030, 1, 1, 12, 0, 0, 0, time.UTC)}
+	shipRepo := &fakeScoutShipRepo{ships: ships, clock: clock}
+	daemonClient := &fakeScoutDaemonClient{}
+	return &parkFixture{
+		handler: NewParkProbesHandler(shipRepo, &parkMarketProvider{bySystem: markets}, &parkCensus{bySystem: census}, daemonClient, clock),
+		ships:   shipRepo,
+		daemon:  daemonClient,
+	}
+}
+
+func parkCmd() *ParkProbesCommand {
+	return &ParkProbesCommand{PlayerID: shared.MustNewPlayerID(1), ContainerID: "park-1"}
+}
+
+// The highest-importance markets are parked first, one probe each, each probe going to
+// its nearest remaining market; fuel stations are never parked at.
+func TestParkProbes_ParksHighestValueMarketsWithNearestProbe(t *testing.T) {
+	quiet := parkMarket(t, "X1-PK-Q1", "PLANET", 0, 0)
+	hot := parkMarket(t, "X1-PK-H1", "PLANET", 100, 0)
+	warm := parkMarket(t, "X1-PK-W1", "MOON", -100, 0)
+	fuel := parkMarket(t, "X1-PK-F1", "FUEL_STATION", 0, 0)
+	nearHot := newParkTestProbe(t, "AGENT-PROBE-1", parkMarket(t, "X1-PK-A1", "PLANET", 90, 0))
+	nearWarm := newParkTestProbe(t, "AGENT-PROBE-2", parkMarket(t, "X1-PK-A2", "PLANET", -90, 0))
+
+	f := newParkFixture(
+		[]*navigation.Ship{nearWarm, nearHot},
+		map[string][]*shared.Waypoint{"X1-PK": {quiet, hot, warm, fuel}},
+		map[string][]domainScouting.MarketRefreshCandidate{"X1-PK": {
+			{WaypointSymbol: "X1-PK-H1", Scanned: true, ArbitrageUses: 10},
+			{WaypointSymbol: "X1-PK-W1", Scanned: true, ArbitrageUses: 2},
+			{WaypointSymbol: "X1-PK-F1", Scanned: true, ArbitrageUses: 50},
+		}},
+	)
+
+	parked, err := f.handler.ReconcileOnce(context.Background(), parkCmd())
+
+	require.NoError(t, err)
+	require.Equal(t, 2, parked)
+	require.Len(t, f.daemon.persistedTourCmds, 2)
+	require.Equal(t, f.daemon.persisted, f.daemon.started)
+
+	first, second := f.daemon.persistedTourCmds[0], f.daemon.persistedTourCmds[1]
+	require.Equal(t, []string{"X1-PK-H1"}, first.Markets)
+	require.Equal(t, "AGENT-PROBE-1", first.ShipSymbol)
+	require.Equal(t, []string{"X1-PK-W1"}, second.Markets)
+	require.Equal(t, "AGENT-PROBE-2", second.ShipSymbol)
+	for _, tour := range f.daemon.persistedTourCmds {
+		require.Equal(t, -1, tour.Iterations)
+		require.True(t, tour.DockAtPost)
+		require.Equal(t, parkDefaultScanInterval, tour.ScanInterval)
+		require.Empty(t, tour.CoordinatorID, "a parked tour recovers on its own")
+	}
+	for _, claim := range f.ships.claims {
+		require.Equal(t, parkedProbeOperation, claim.operation)
+	}
+}
+
+// A market that already holds a parked probe is skipped, so a second pass moves on to the
+// next market instead of doubling up.
+func TestParkProbes_SkipsMarketsAlreadyParked(t *testing.T) {
+	hot := parkMarket(t, "X1-PK-H1", "PLANET", 0, 0)
+	warm := parkMarket(t, "X1-PK-W1", "PLANET", 10, 0)
+	clock := &shared.MockClock{CurrentTime: time.Now()}
+	parkedProbe := newParkTestProbe(t, "AGENT-PROBE-1", hot)
+	require.NoError(t, parkedProbe.AssignToContainer("probe_park-PROBE-1-abcd1234", clock))
+	idleProbe := newParkTestProbe(t, "AGENT-PROBE-2", hot)
+
+	f := newParkFixture(
+		[]*navigation.Ship{parkedProbe, idleProbe},
+		map[string][]*shared.Waypoint{"X1-PK": {hot, warm}},
+		map[string][]domainScouting.MarketRefreshCandidate{"X1-PK": {
+			{WaypointSymbol: "X1-PK-H1", Scanned: true, ArbitrageUses: 10},
+		}},
+	)
+
+	parked, err := f.handler.ReconcileOnce(context.Background(), parkCmd())
+
+	require.NoError(t, err)
+	require.Equal(t, 1, parked)
+	require.Equal(t, []string{"X1-PK-W1"}, f.daemon.persistedTourCmds[0].Markets)
+	require.Equal(t, "AGENT-PROBE-2", f.daemon.persistedTourCmds[0].ShipSymbol)
+}
+
+// ReserveIdleProbes leaves that many idle probes unparked.
+func TestParkProbes_KeepsReserveIdle(t *testing.T) {
+	markets := []*shared.Waypoint{
+		parkMarket(t, "X1-PK-M1", "PLANET", 0, 0),
+		parkMarket(t, "X1-PK-M2", "PLANET", 0, 0),
+		parkMarket(t, "X1-PK-M3", "PLANET", 0, 0),
+	}
+	f := newParkFixture(
+		[]*navigation.Ship{
+			newParkTestProbe(t, "AGENT-PROBE-1", markets[0]),
+			newParkTestProbe(t, "AGENT-PROBE-2", markets[0]),
+			newParkTestProbe(t, "AGENT-PROBE-3", markets[0]),
+		},
+		map[string][]*shared.Waypoint{"X1-PK": markets},
+		nil,
+	)
+	cmd := parkCmd()
+	cmd.ReserveIdleProbes = 2
+
+	parked, err := f.handler.ReconcileOnce(context.Background(), cmd)
+
+	require.NoError(t, err)
+	require.Equal(t, 1, parked)
+	require.Len(t, f.daemon.started, 1)
+}
+
+// A probe whose tour fails to start is released and the pass carries on.
+func TestParkProbes_StartFailureReleasesProbe(t *testing.T) {
+	market := parkMarket(t, "X1-PK-M1", "PLANET", 0, 0)
+	probe := newParkTestProbe(t, "AGENT-PROBE-1", market)
+	f := newParkFixture(
+		[]*navigation.Ship{probe},
+		map[string][]*shared.Waypoint{"X1-PK": {market}},
+		nil,
+	)
+	f.daemon.startErr = errors.New("runner refused")
+
+	parked, err := f.handler.ReconcileOnce(context.Background(), parkCmd())
+
+	require.NoError(t, err)
+	require.Zero(t, parked)
+	require.True(t, probe.IsIdle(), "the probe must not stay claimed by a tour that never started")
+	require.Equal(t, f.daemon.persisted, f.daemon.stopped)
+}
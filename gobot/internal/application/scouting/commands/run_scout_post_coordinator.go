@@ -664,7 +664,7 @@ func (h *RunScoutPostCoordinatorHandler) Handle(ctx context.Context, request com
 		result.Ticks++
 
 		select {
-		case <-time.After(tick):
+		case <-shared.After(shared.ClockFromContext(ctx), tick):
 		case <-ctx.Done():
 			return result, ctx.Err()
 		}
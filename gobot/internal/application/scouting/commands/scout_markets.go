@@ -21,6 +21,7 @@ import (
 type ScoutMarketsCommand struct {
 	PlayerID     shared.PlayerID
 	ShipSymbols  []string
+	ShipTag      string // Optional: also scout with every ship wearing this tag
 	SystemSymbol string
 	Markets      []string
 	Iterations   int // Number of iterations (-1 for infinite)
@@ -40,6 +41,7 @@ type ScoutMarketsHandler struct {
 	routingClient routing.RoutingClient
 	daemonClient  daemon.DaemonClient
 	clock         shared.Clock
+	shipTags      navigation.ShipTagRepository
 }
 
 // NewScoutMarketsHandler creates a new scout markets handler
@@ -62,6 +64,12 @@ func NewScoutMarketsHandler(
 	}
 }
 
+// SetShipTagRepository enables ShipTag selection. Without it a command naming a
+// tag is rejected.
+func (h *ScoutMarketsHandler) SetShipTagRepository(tags navigation.ShipTagRepository) {
+	h.shipTags = tags
+}
+
 // Handle executes the scout markets command as a TRANSACTIONAL reset (sp-8k9m): it
 // re-partitions every requested hull over the system's markets, tearing the old tours
 // down and spawning fresh ones. The teardown is the last thing it does, never the first.
@@ -82,6 +90,12 @@ func (h *ScoutMarketsHandler) Handle(ctx context.Context, request common.Request
 		return nil, fmt.Errorf("invalid request type")
 	}
 
+	shipSymbols, err := navigation.ResolveShipSelector(ctx, h.shipTags, cmd.PlayerID, cmd.ShipSymbols, cmd.ShipTag)
+	if err != nil {
+		return nil, err
+	}
+	cmd.ShipSymbols = shipSymbols
+
 	// An empty market set is a no-op reset — there is nothing to re-man toward, so it must
 	// not tear down the existing posts (the pre-fix code stopped them, THEN early-returned).
 	if len(cmd.Markets) == 0 {
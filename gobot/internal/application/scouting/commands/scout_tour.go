@@ -9,7 +9,9 @@ import (
 	"github.com/andrescamacho/spacetraders-go/internal/application/common"
 	"github.com/andrescamacho/spacetraders-go/internal/application/ship"
 	shipNav "github.com/andrescamacho/spacetraders-go/internal/application/ship/commands/navigation"
+	shipTypes "github.com/andrescamacho/spacetraders-go/internal/application/ship/types"
 	"github.com/andrescamacho/spacetraders-go/internal/domain/navigation"
+	domainRouting "github.com/andrescamacho/spacetraders-go/internal/domain/routing"
 	"github.com/andrescamacho/spacetraders-go/internal/domain/shared"
 )
 
@@ -144,6 +146,17 @@ type ScoutTourCommand struct {
 	// defaultTourStartJitterMax. Decoheres a fleet of scouts that would otherwise
 	// wake in near-lockstep and burst the API rate budget every cycle.
 	StartJitterMaxSecs int
+
+	// ContainerID is the container running the tour. Multi-market tours record
+	// their progress against it so a restarted container resumes mid-circuit;
+	// empty (tests, direct handler calls) disables resumption.
+	ContainerID string
+
+	// DockAtPost docks a stationary scout at its market once it arrives, so a
+	// probe parked for the long haul (ParkProbesCommand) sits docked between
+	// scans. Only honoured by single-market tours; multi-market tours keep the
+	// ship in orbit between hops.
+	DockAtPost bool
 }
 
 // ScoutTourResponse - Response from scout tour execution
@@ -163,6 +176,12 @@ type ScoutTourHandler struct {
 	// one (tests, minimal wiring) simply skips shipyard scans.
 	shipyardScanner *ship.ShipyardScanner
 	clock           shared.Clock
+	// progressStore persists multi-market tour progress for resumption; nil
+	// restarts an interrupted tour from the top.
+	progressStore ScoutTourProgressStore
+	// blacklist drops blacklisted markets from the tour at start; nil tours
+	// every market it is given.
+	blacklist *ship.WaypointBlacklist
 }
 
 // NewScoutTourHandler creates a new scout tour command handler. A nil clock
@@ -188,6 +207,12 @@ func NewScoutTourHandler(
 	}
 }
 
+// SetWaypointBlacklist drops the player's blacklisted markets from each tour
+// as it starts. Without one every market given is toured.
+func (h *ScoutTourHandler) SetWaypointBlacklist(blacklist *ship.WaypointBlacklist) {
+	h.blacklist = blacklist
+}
+
 // Handle executes the scout tour command
 func (h *ScoutTourHandler) Handle(ctx context.Context, request common.Request) (common.Response, error) {
 	cmd, ok := request.(*ScoutTourCommand)
@@ -199,6 +224,9 @@ func (h *ScoutTourHandler) Handle(ctx context.Context, request common.Request) (
 	if err != nil {
 		return nil, err
 	}
+	if len(tourOrder) == 0 {
+		return response, nil
+	}
 
 	if !h.waitStartJitter(ctx, cmd) {
 		return response, nil
@@ -244,7 +272,7 @@ func (h *ScoutTourHandler) loadShipAndPrepareTour(
 		return nil, nil, nil, fmt.Errorf("failed to find ship: %w", err)
 	}
 
-	tourOrder := rotateTourToStart(cmd.Markets, ship.CurrentLocation().Symbol)
+	tourOrder := rotateTourToStart(h.skipBlacklisted(ctx, cmd), ship.CurrentLocation().Symbol)
 
 	response := &ScoutTourResponse{
 		MarketsVisited: 0,
@@ -255,6 +283,33 @@ func (h *ScoutTourHandler) loadShipAndPrepareTour(
 	return ship, tourOrder, response, nil
 }
 
+// skipBlacklisted returns the tour's markets less the ones blacklisted for the
+// player. A tour left with nothing to scan ends at once.
+func (h *ScoutTourHandler) skipBlacklisted(ctx context.Context, cmd *ScoutTourCommand) []string {
+	blacklisted := h.blacklist.Blacklisted(ctx, cmd.PlayerID.Value())
+	if len(blacklisted) == 0 {
+		return cmd.Markets
+	}
+	markets := make([]string, 0, len(cmd.Markets))
+	var skipped []string
+	for _, market := range cmd.Markets {
+		if blacklisted[market] {
+			skipped = append(skipped, market)
+			continue
+		}
+		markets = append(markets, market)
+	}
+	if len(skipped) > 0 {
+		common.LoggerFromContext(ctx).Log("WARNING", "Scout tour skipping blacklisted markets", map[string]interface{}{
+			"action":      "scout_tour_blacklist_skip",
+			"ship_symbol": cmd.ShipSymbol,
+			"skipped":     skipped,
+			"remaining":   len(markets),
+		})
+	}
+	return markets
+}
+
 // executeStationaryScout executes a continuous scanning operation at a single market
 func (h *ScoutTourHandler) executeStationaryScout(
 	ctx context.Context,
@@ -277,9 +332,29 @@ func (h *ScoutTourHandler) executeStationaryScout(
 
 	response.Iterations++
 
+	if cmd.DockAtPost {
+		h.dockAtPost(ctx, cmd, marketWaypoint)
+	}
+
 	return h.continuousMarketScanning(ctx, cmd, marketWaypoint, response)
 }
 
+// dockAtPost docks a parked scout at its market. Non-fatal: scans work from
+// orbit too, so a failed dock is logged and the post keeps scanning.
+func (h *ScoutTourHandler) dockAtPost(ctx context.Context, cmd *ScoutTourCommand, marketWaypoint string) {
+	if _, err := h.mediator.Send(ctx, &shipTypes.DockShipCommand{
+		ShipSymbol: cmd.ShipSymbol,
+		PlayerID:   cmd.PlayerID,
+	}); err != nil {
+		common.LoggerFromContext(ctx).Log("WARNING", "Failed to dock at stationary scout post (non-fatal)", map[string]interface{}{
+			"ship_symbol": cmd.ShipSymbol,
+			"action":      "dock",
+			"waypoint":    marketWaypoint,
+			"error":       err.Error(),
+		})
+	}
+}
+
 // navigateToMarketIfNeeded navigates ship to market if not already there
 func (h *ScoutTourHandler) navigateToMarketIfNeeded(
 	ctx context.Context,
@@ -304,6 +379,7 @@ func (h *ScoutTourHandler) navigateToMarketIfNeeded(
 		ShipSymbol:  shipSymbol,
 		Destination: marketWaypoint,
 		PlayerID:    playerID,
+		Objective:   domainRouting.ObjectiveFuelSaver,
 	}
 
 	navResp, err := h.mediator.Send(ctx, navCmd)
@@ -338,7 +414,7 @@ func (h *ScoutTourHandler) performInitialScan(
 		"reason":      "already_present",
 	})
 
-	if err := h.marketScanner.ScanAndSaveMarket(ctx, playerID, marketWaypoint); err != nil {
+	if _, err := h.marketScanner.ScanAndSaveMarketIfDue(ctx, playerID, marketWaypoint); err != nil {
 		logger.Log("ERROR", "Initial market scan failed", map[string]interface{}{
 			"ship_symbol": shipSymbol,
 			"action":      "scan_market",
@@ -408,7 +484,7 @@ func (h *ScoutTourHandler) continuousMarketScanning(
 			"iteration":   iteration + 1,
 		})
 
-		if err := h.marketScanner.ScanAndSaveMarket(ctx, uint(cmd.PlayerID.Value()), marketWaypoint); err != nil {
+		if _, err := h.marketScanner.ScanAndSaveMarketIfDue(ctx, uint(cmd.PlayerID.Value()), marketWaypoint); err != nil {
 			logger.Log("ERROR", "Market scan failed", map[string]interface{}{
 				"ship_symbol": cmd.ShipSymbol,
 				"action":      "scan_market",
@@ -457,6 +533,10 @@ func (h *ScoutTourHandler) sleepInterruptibly(ctx context.Context, d time.Durati
 // per-partition consumer of the zixw freshness plumbing: a partitioned probe from
 // a multi-hull post (run_scout_post_coordinator.go) and a direct scout-markets tour
 // both flow through here, and both hit the same API-budget invariant.
+//
+// Progress is recorded after every market. A tour restarted with progress on
+// record resumes its interrupted circuit at the first unvisited market,
+// skipping any market another scan refreshed within the scan interval.
 func (h *ScoutTourHandler) executeMultiMarketTour(
 	ctx context.Context,
 	cmd *ScoutTourCommand,
@@ -466,10 +546,37 @@ func (h *ScoutTourHandler) executeMultiMarketTour(
 	logger := common.LoggerFromContext(ctx)
 	interval := effectiveScanInterval(cmd.ScanInterval)
 
-	for iteration := 0; iteration < cmd.Iterations || cmd.Iterations == -1; iteration++ {
+	startIteration := 0
+	circuit := tourOrder
+	var visited []string
+	if resumed := h.loadProgress(ctx, cmd); resumed != nil {
+		startIteration = resumed.CircuitsCompleted
+		response.Iterations = resumed.CircuitsCompleted
+		if len(resumed.Remaining) > 0 {
+			sequence, skipped := h.resumeSequence(ctx, cmd, resumed.Remaining, interval)
+			circuit = sequence
+			visited = append(append(visited, resumed.Visited...), skipped...)
+			logger.Log("INFO", "Resuming scout tour mid-circuit", map[string]interface{}{
+				"ship_symbol":        cmd.ShipSymbol,
+				"action":             "scout_tour_resume",
+				"circuits_completed": resumed.CircuitsCompleted,
+				"visited":            len(resumed.Visited),
+				"remaining":          len(sequence),
+				"skipped_fresh":      len(skipped),
+			})
+		}
+	}
+
+	for iteration := startIteration; iteration < cmd.Iterations || cmd.Iterations == -1; iteration++ {
 		circuitStart := h.clock.Now()
+		order := tourOrder
+		if iteration == startIteration {
+			order = circuit
+		} else {
+			visited = nil
+		}
 
-		for _, marketWaypoint := range tourOrder {
+		for i, marketWaypoint := range order {
 			navResult, err := h.navigateToMarket(ctx, cmd, marketWaypoint, iteration)
 			if err != nil {
 				return err
@@ -485,9 +592,21 @@ func (h *ScoutTourHandler) executeMultiMarketTour(
 			// OPTIMIZATION: Market scan is already performed by RouteExecutor.scanMarketIfPresent()
 			// when the ship arrives at a marketplace waypoint. No need to scan again here.
 			response.MarketsVisited++
+
+			// The circuit-boundary record below covers the final market.
+			visited = append(visited, marketWaypoint)
+			if i+1 < len(order) {
+				h.saveProgress(ctx, cmd, ScoutTourProgress{
+					Markets:           tourOrder,
+					Visited:           visited,
+					Remaining:         order[i+1:],
+					CircuitsCompleted: iteration,
+				})
+			}
 		}
 
 		response.Iterations++
+		h.saveProgress(ctx, cmd, ScoutTourProgress{Markets: tourOrder, CircuitsCompleted: iteration + 1})
 
 		// End-of-circuit pacing (sp-enry): pace the circuit period to the freshness
 		// target. Skipped after the FINAL circuit of a finite tour (nothing follows),
@@ -537,10 +656,12 @@ func (h *ScoutTourHandler) navigateToMarket(
 		"iteration":   iteration + 1,
 	})
 
+	// Tours have no deadline; plan legs to spare fuel and refuel stops.
 	navCmd := &shipNav.NavigateRouteCommand{
 		ShipSymbol:  cmd.ShipSymbol,
 		Destination: marketWaypoint,
 		PlayerID:    cmd.PlayerID,
+		Objective:   domainRouting.ObjectiveFuelSaver,
 	}
 
 	navResp, err := h.mediator.Send(ctx, navCmd)
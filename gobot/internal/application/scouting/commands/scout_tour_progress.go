@@ -0,0 +1,110 @@
+package commands
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/andrescamacho/spacetraders-go/internal/application/common"
+)
+
+// ScoutTourProgress is how far a multi-market tour has got, persisted after
+// every market so a tour whose container dies mid-circuit picks up where it
+// stopped instead of flying the whole circuit again.
+type ScoutTourProgress struct {
+	// Markets is the tour the progress belongs to. A resumed tour whose market
+	// set differs (the post was re-cut) ignores the progress and starts fresh.
+	Markets []string `json:"markets"`
+	// Visited lists the markets already reached in the current circuit, in order.
+	Visited []string `json:"visited"`
+	// Remaining lists the markets still to visit in the current circuit, in
+	// order. Empty at a circuit boundary: the next circuit is the full tour.
+	Remaining []string `json:"remaining"`
+	// CircuitsCompleted counts finished circuits, so a finite tour does not
+	// re-fly circuits it already completed.
+	CircuitsCompleted int `json:"circuits_completed"`
+}
+
+// ScoutTourProgressStore loads and records a tour's progress against its
+// container. The daemon backs it with the container config. Errors are
+// advisory: a tour that cannot load or save progress keeps flying, it just
+// restarts from the top after a crash.
+type ScoutTourProgressStore interface {
+	LoadScoutTourProgress(ctx context.Context, containerID string, playerID int) (*ScoutTourProgress, error)
+	PersistScoutTourProgress(ctx context.Context, containerID string, playerID int, progress ScoutTourProgress) error
+}
+
+// SetProgressStore wires tour progress persistence. Without one (or without a
+// ContainerID on the command) a restarted tour starts from the beginning.
+func (h *ScoutTourHandler) SetProgressStore(store ScoutTourProgressStore) {
+	h.progressStore = store
+}
+
+// loadProgress returns the persisted progress of this tour, or nil when there
+// is none or it belongs to a different market set.
+func (h *ScoutTourHandler) loadProgress(ctx context.Context, cmd *ScoutTourCommand) *ScoutTourProgress {
+	if h.progressStore == nil || cmd.ContainerID == "" {
+		return nil
+	}
+	progress, err := h.progressStore.LoadScoutTourProgress(ctx, cmd.ContainerID, cmd.PlayerID.Value())
+	if err != nil {
+		common.LoggerFromContext(ctx).Log("WARNING", "Failed to load scout tour progress; starting tour from the top", map[string]interface{}{
+			"ship_symbol":  cmd.ShipSymbol,
+			"action":       "scout_tour_progress_load",
+			"container_id": cmd.ContainerID,
+			"error":        err.Error(),
+		})
+		return nil
+	}
+	if progress == nil || !sameMarketSet(progress.Markets, cmd.Markets) {
+		return nil
+	}
+	return progress
+}
+
+// saveProgress records progress. Best-effort: a lost update only costs a
+// longer replay after a crash.
+func (h *ScoutTourHandler) saveProgress(ctx context.Context, cmd *ScoutTourCommand, progress ScoutTourProgress) {
+	if h.progressStore == nil || cmd.ContainerID == "" {
+		return
+	}
+	if err := h.progressStore.PersistScoutTourProgress(ctx, cmd.ContainerID, cmd.PlayerID.Value(), progress); err != nil {
+		common.LoggerFromContext(ctx).Log("WARNING", "Failed to persist scout tour progress", map[string]interface{}{
+			"ship_symbol":  cmd.ShipSymbol,
+			"action":       "scout_tour_progress_save",
+			"container_id": cmd.ContainerID,
+			"error":        err.Error(),
+		})
+	}
+}
+
+// resumeSequence is the rest of an interrupted circuit, less the markets some
+// other scan already refreshed within freshFor: flying to a market only to
+// find it fresh is the replay cost resumption exists to avoid.
+func (h *ScoutTourHandler) resumeSequence(ctx context.Context, cmd *ScoutTourCommand, remaining []string, freshFor time.Duration) (sequence, skipped []string) {
+	for _, marketWaypoint := range remaining {
+		if h.marketScanner != nil && h.marketScanner.MarketFresh(ctx, uint(cmd.PlayerID.Value()), marketWaypoint, freshFor) {
+			skipped = append(skipped, marketWaypoint)
+			continue
+		}
+		sequence = append(sequence, marketWaypoint)
+	}
+	return sequence, skipped
+}
+
+// sameMarketSet reports whether a and b hold the same markets, in any order
+func sameMarketSet(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	sa := append([]string(nil), a...)
+	sb := append([]string(nil), b...)
+	sort.Strings(sa)
+	sort.Strings(sb)
+	for i := range sa {
+		if sa[i] != sb[i] {
+			return false
+		}
+	}
+	return true
+}
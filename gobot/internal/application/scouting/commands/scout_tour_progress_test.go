@@ -0,0 +1,142 @@
+package commands
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/andrescamacho/spacetraders-go/internal/application/common"
+	scoutingQuery "github.com/andrescamacho/spacetraders-go/internal/application/scouting/queries"
+	"github.com/andrescamacho/spacetraders-go/internal/application/ship"
+	shipNav "github.com/andrescamacho/spacetraders-go/internal/application/ship/commands/navigation"
+	"github.com/andrescamacho/spacetraders-go/internal/domain/market"
+	"github.com/andrescamacho/spacetraders-go/internal/domain/shared"
+)
+
+// memoryProgressStore keeps tour progress per container and records every write
+type memoryProgressStore struct {
+	progress map[string]*ScoutTourProgress
+	writes   []ScoutTourProgress
+}
+
+func (s *memoryProgressStore) LoadScoutTourProgress(_ context.Context, containerID string, _ int) (*ScoutTourProgress, error) {
+	return s.progress[containerID], nil
+}
+
+func (s *memoryProgressStore) PersistScoutTourProgress(_ context.Context, containerID string, _ int, progress ScoutTourProgress) error {
+	if s.progress == nil {
+		s.progress = map[string]*ScoutTourProgress{}
+	}
+	p := progress
+	s.progress[containerID] = &p
+	s.writes = append(s.writes, progress)
+	return nil
+}
+
+// destinationRecordingMediator records the destination of every navigation
+type destinationRecordingMediator struct {
+	common.Mediator
+	destinations []string
+}
+
+func (m *destinationRecordingMediator) Send(_ context.Context, request common.Request) (common.Response, error) {
+	if nav, ok := request.(*shipNav.NavigateRouteCommand); ok {
+		m.destinations = append(m.destinations, nav.Destination)
+	}
+	return &shipNav.NavigateRouteResponse{Status: "completed"}, nil
+}
+
+// freshMarketRepo reports the markets in fresh as just scanned and every other
+// market as never scanned.
+type freshMarketRepo struct {
+	scoutingQuery.MarketRepository
+	fresh map[string]bool
+}
+
+func (r *freshMarketRepo) GetMarketData(_ context.Context, waypointSymbol string, _ int) (*market.Market, error) {
+	if !r.fresh[waypointSymbol] {
+		return nil, nil
+	}
+	return market.NewMarket(waypointSymbol, nil, time.Now())
+}
+
+func TestExecuteMultiMarketTour_RecordsProgressAfterEachMarket(t *testing.T) {
+	store := &memoryProgressStore{}
+	h := &ScoutTourHandler{mediator: &destinationRecordingMediator{}, clock: &shared.MockClock{CurrentTime: time.Now()}}
+	h.SetProgressStore(store)
+
+	cmd := &ScoutTourCommand{
+		PlayerID:    shared.MustNewPlayerID(1),
+		ShipSymbol:  "PROBE-1",
+		Markets:     []string{"M1", "M2", "M3"},
+		Iterations:  1,
+		ContainerID: "scout-1",
+	}
+	require.NoError(t, h.executeMultiMarketTour(context.Background(), cmd, cmd.Markets, &ScoutTourResponse{}))
+
+	require.Len(t, store.writes, 3, "one write per market but the last, plus the circuit boundary")
+	require.Equal(t, []string{"M1"}, store.writes[0].Visited)
+	require.Equal(t, []string{"M2", "M3"}, store.writes[0].Remaining)
+	require.Equal(t, []string{"M3"}, store.writes[1].Remaining)
+	require.Empty(t, store.writes[2].Remaining)
+	require.Equal(t, 1, store.writes[2].CircuitsCompleted)
+}
+
+// A tour restarted mid-circuit flies only the unvisited markets, skipping the
+// ones another scan refreshed in the meantime, then carries on with full
+// circuits until its iteration budget is spent.
+func TestExecuteMultiMarketTour_ResumesFromLastUnvisitedMarket(t *testing.T) {
+	store := &memoryProgressStore{progress: map[string]*ScoutTourProgress{
+		"scout-1": {
+			Markets:           []string{"M2", "M3", "M4", "M1"},
+			Visited:           []string{"M2"},
+			Remaining:         []string{"M3", "M4", "M1"},
+			CircuitsCompleted: 1,
+		},
+	}}
+	med := &destinationRecordingMediator{}
+	h := &ScoutTourHandler{
+		mediator:      med,
+		clock:         &shared.MockClock{CurrentTime: time.Now()},
+		marketScanner: ship.NewMarketScanner(nil, &freshMarketRepo{fresh: map[string]bool{"M4": true}}, nil, nil),
+	}
+	h.SetProgressStore(store)
+
+	cmd := &ScoutTourCommand{
+		PlayerID:    shared.MustNewPlayerID(1),
+		ShipSymbol:  "PROBE-1",
+		Markets:     []string{"M1", "M2", "M3", "M4"},
+		Iterations:  3,
+		ContainerID: "scout-1",
+	}
+	response := &ScoutTourResponse{}
+	require.NoError(t, h.executeMultiMarketTour(context.Background(), cmd, cmd.Markets, response))
+
+	require.Equal(t, []string{"M3", "M1", "M1", "M2", "M3", "M4"}, med.destinations,
+		"the interrupted circuit resumes at M3, skips the fresh M4, then one full circuit follows")
+	require.Equal(t, 3, response.Iterations)
+	require.Equal(t, 3, store.progress["scout-1"].CircuitsCompleted)
+}
+
+// Progress recorded for a different market set (the post was re-cut) is ignored.
+func TestExecuteMultiMarketTour_IgnoresProgressForAnotherTour(t *testing.T) {
+	store := &memoryProgressStore{progress: map[string]*ScoutTourProgress{
+		"scout-1": {Markets: []string{"M1", "M9"}, Visited: []string{"M1"}, Remaining: []string{"M9"}},
+	}}
+	med := &destinationRecordingMediator{}
+	h := &ScoutTourHandler{mediator: med, clock: &shared.MockClock{CurrentTime: time.Now()}}
+	h.SetProgressStore(store)
+
+	cmd := &ScoutTourCommand{
+		PlayerID:    shared.MustNewPlayerID(1),
+		ShipSymbol:  "PROBE-1",
+		Markets:     []string{"M1", "M2"},
+		Iterations:  1,
+		ContainerID: "scout-1",
+	}
+	require.NoError(t, h.executeMultiMarketTour(context.Background(), cmd, cmd.Markets, &ScoutTourResponse{}))
+
+	require.Equal(t, []string{"M1", "M2"}, med.destinations)
+}
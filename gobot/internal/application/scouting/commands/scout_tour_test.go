@@ -2,6 +2,7 @@ package commands
 
 import (
 	"context"
+	"errors"
 	"testing"
 	"time"
 
@@ -9,6 +10,7 @@ import (
 
 	"github.com/andrescamacho/spacetraders-go/internal/application/common"
 	shipNav "github.com/andrescamacho/spacetraders-go/internal/application/ship/commands/navigation"
+	shipTypes "github.com/andrescamacho/spacetraders-go/internal/application/ship/types"
 	"github.com/andrescamacho/spacetraders-go/internal/domain/shared"
 )
 
@@ -276,3 +278,35 @@ func (m *clockAdvancingMediator) Send(_ context.Context, _ common.Request) (comm
 	m.clock.Advance(m.perNav)
 	return &shipNav.NavigateRouteResponse{Status: "completed"}, nil
 }
+
+// ---- tests: DockAtPost (parked probes) --------------------------------------
+
+// dockRecordingMediator records the commands sent and fails every one when err is set.
+type dockRecordingMediator struct {
+	common.Mediator
+	sent []common.Request
+	err  error
+}
+
+func (m *dockRecordingMediator) Send(_ context.Context, request common.Request) (common.Response, error) {
+	m.sent = append(m.sent, request)
+	return nil, m.err
+}
+
+// A parked probe docks at its market; a refused dock is logged, never fatal, since
+// scans work from orbit too.
+func TestDockAtPost_SendsDockAndToleratesFailure(t *testing.T) {
+	med := &dockRecordingMediator{}
+	h := &ScoutTourHandler{mediator: med, clock: shared.NewRealClock()}
+	cmd := &ScoutTourCommand{PlayerID: shared.MustNewPlayerID(1), ShipSymbol: "PROBE-1", DockAtPost: true}
+
+	h.dockAtPost(context.Background(), cmd, "X1-A1")
+
+	require.Len(t, med.sent, 1)
+	dock, ok := med.sent[0].(*shipTypes.DockShipCommand)
+	require.True(t, ok, "dockAtPost must send a DockShipCommand")
+	require.Equal(t, "PROBE-1", dock.ShipSymbol)
+
+	med.err = errors.New("ship in transit")
+	require.NotPanics(t, func() { h.dockAtPost(context.Background(), cmd, "X1-A1") })
+}
@@ -0,0 +1,247 @@
+package queries
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/andrescamacho/spacetraders-go/internal/application/common"
+	"github.com/andrescamacho/spacetraders-go/internal/domain/market"
+	"github.com/andrescamacho/spacetraders-go/internal/domain/shared"
+)
+
+// MarketExportFormat selects the serialization of a market data export.
+type MarketExportFormat string
+
+const (
+	MarketExportFormatCSV  MarketExportFormat = "csv"
+	MarketExportFormatJSON MarketExportFormat = "json"
+)
+
+// ParseMarketExportFormat validates a user-supplied export format; empty
+// defaults to CSV.
+func ParseMarketExportFormat(s string) (MarketExportFormat, error) {
+	switch MarketExportFormat(s) {
+	case "", MarketExportFormatCSV:
+		return MarketExportFormatCSV, nil
+	case MarketExportFormatJSON:
+		return MarketExportFormatJSON, nil
+	}
+	return "", fmt.Errorf("unsupported export format %q (want csv or json)", s)
+}
+
+// Row kinds in the flat CSV export: the current market snapshot and the
+// price-history observations inside the requested window.
+const (
+	marketExportKindSnapshot = "snapshot"
+	marketExportKindHistory  = "history"
+)
+
+// MarketPriceHistoryReader is the narrow price-history port the export needs:
+// a system's price changes since a time, oldest first.
+type MarketPriceHistoryReader interface {
+	ListSystemPriceChanges(ctx context.Context, playerID int, systemSymbol string, since time.Time) ([]*market.MarketPriceHistory, error)
+}
+
+// ExportMarketDataQuery - Query to export a system's cached markets, plus
+// optionally a trailing price-history window, as a CSV or JSON document for
+// offline analysis.
+type ExportMarketDataQuery struct {
+	PlayerID     shared.PlayerID
+	SystemSymbol string
+	Format       MarketExportFormat
+	// HistoryWindow is how far back price history is included; 0 exports the
+	// current snapshot only.
+	HistoryWindow time.Duration
+}
+
+// MarketExportRow is one trade good observation: a current snapshot entry or
+// a historical price change. TradeType is only known for snapshot rows. Bid is
+// what the market pays a seller and Ask what a buyer pays; the market columns
+// store them inverted, as PurchasePrice and SellPrice.
+type MarketExportRow struct {
+	Kind           string    `json:"-"`
+	WaypointSymbol string    `json:"waypoint_symbol"`
+	GoodSymbol     string    `json:"good_symbol"`
+	TradeType      string    `json:"trade_type,omitempty"`
+	Supply         string    `json:"supply,omitempty"`
+	Activity       string    `json:"activity,omitempty"`
+	Bid            int       `json:"bid"`
+	Ask            int       `json:"ask"`
+	TradeVolume    int       `json:"trade_volume"`
+	RecordedAt     time.Time `json:"recorded_at"`
+}
+
+// ExportMarketDataResponse - The serialized export plus its row counts.
+type ExportMarketDataResponse struct {
+	Format       MarketExportFormat
+	Content      []byte
+	MarketCount  int
+	SnapshotRows int
+	HistoryRows  int
+}
+
+// marketExportDocument is the JSON export layout.
+type marketExportDocument struct {
+	SystemSymbol string            `json:"system_symbol"`
+	GeneratedAt  time.Time         `json:"generated_at"`
+	HistorySince *time.Time        `json:"history_since,omitempty"`
+	Markets      []MarketExportRow `json:"markets"`
+	History      []MarketExportRow `json:"history"`
+}
+
+// ExportMarketDataHandler - Handles market data export queries
+type ExportMarketDataHandler struct {
+	marketRepo  MarketRepository
+	historyRepo MarketPriceHistoryReader
+	clock       shared.Clock
+}
+
+// NewExportMarketDataHandler creates a new market data export handler
+func NewExportMarketDataHandler(marketRepo MarketRepository, historyRepo MarketPriceHistoryReader, clock shared.Clock) *ExportMarketDataHandler {
+	if clock == nil {
+		clock = shared.NewRealClock()
+	}
+	return &ExportMarketDataHandler{
+		marketRepo:  marketRepo,
+		historyRepo: historyRepo,
+		clock:       clock,
+	}
+}
+
+// Handle executes the export market data query
+func (h *ExportMarketDataHandler) Handle(ctx context.Context, request common.Request) (common.Response, error) {
+	query, ok := request.(*ExportMarketDataQuery)
+	if !ok {
+		return nil, fmt.Errorf("invalid request type")
+	}
+	if query.SystemSymbol == "" {
+		return nil, fmt.Errorf("system symbol is required")
+	}
+	format, err := ParseMarketExportFormat(string(query.Format))
+	if err != nil {
+		return nil, err
+	}
+
+	markets, err := h.marketRepo.ListMarketsInSystem(ctx, uint(query.PlayerID.Value()), query.SystemSymbol, 0)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list markets: %w", err)
+	}
+	sort.Slice(markets, func(i, j int) bool { return markets[i].WaypointSymbol() < markets[j].WaypointSymbol() })
+
+	now := h.clock.Now()
+	doc := marketExportDocument{
+		SystemSymbol: query.SystemSymbol,
+		GeneratedAt:  now,
+		Markets:      []MarketExportRow{},
+		History:      []MarketExportRow{},
+	}
+	for i := range markets {
+		m := &markets[i]
+		for _, good := range m.TradeGoods() {
+			doc.Markets = append(doc.Markets, MarketExportRow{
+				Kind:           marketExportKindSnapshot,
+				WaypointSymbol: m.WaypointSymbol(),
+				GoodSymbol:     good.Symbol(),
+				TradeType:      string(good.TradeType()),
+				Supply:         derefString(good.Supply()),
+				Activity:       derefString(good.Activity()),
+				Bid:            good.PurchasePrice(),
+				Ask:            good.SellPrice(),
+				TradeVolume:    good.TradeVolume(),
+				RecordedAt:     m.LastUpdated(),
+			})
+		}
+	}
+
+	if query.HistoryWindow > 0 && h.historyRepo != nil {
+		since := now.Add(-query.HistoryWindow)
+		doc.HistorySince = &since
+		entries, err := h.historyRepo.ListSystemPriceChanges(ctx, query.PlayerID.Value(), query.SystemSymbol, since)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load price history for %s: %w", query.SystemSymbol, err)
+		}
+		for _, entry := range entries {
+			doc.History = append(doc.History, historyExportRow(entry))
+		}
+	}
+
+	var content []byte
+	switch format {
+	case MarketExportFormatJSON:
+		content, err = json.MarshalIndent(doc, "", "  ")
+	default:
+		content, err = marketExportCSV(doc)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode %s export: %w", format, err)
+	}
+
+	return &ExportMarketDataResponse{
+		Format:       format,
+		Content:      content,
+		MarketCount:  len(markets),
+		SnapshotRows: len(doc.Markets),
+		HistoryRows:  len(doc.History),
+	}, nil
+}
+
+func historyExportRow(entry *market.MarketPriceHistory) MarketExportRow {
+	return MarketExportRow{
+		Kind:           marketExportKindHistory,
+		WaypointSymbol: entry.WaypointSymbol(),
+		GoodSymbol:     entry.GoodSymbol(),
+		Supply:         derefString(entry.Supply()),
+		Activity:       derefString(entry.Activity()),
+		Bid:            entry.PurchasePrice(),
+		Ask:            entry.SellPrice(),
+		TradeVolume:    entry.TradeVolume(),
+		RecordedAt:     entry.RecordedAt(),
+	}
+}
+
+// marketExportCSV writes snapshot and history rows as one flat table with a
+// leading kind column, so a notebook can load it as a single frame.
+func marketExportCSV(doc marketExportDocument) ([]byte, error) {
+	var buf bytes.Buffer
+	w := csv.NewWriter(&buf)
+	header := []string{
+		"kind", "waypoint_symbol", "good_symbol", "trade_type", "supply", "activity",
+		"bid", "ask", "trade_volume", "recorded_at",
+	}
+	if err := w.Write(header); err != nil {
+		return nil, err
+	}
+	for _, rows := range [][]MarketExportRow{doc.Markets, doc.History} {
+		for _, row := range rows {
+			if err := w.Write([]string{
+				row.Kind,
+				row.WaypointSymbol,
+				row.GoodSymbol,
+				row.TradeType,
+				row.Supply,
+				row.Activity,
+				strconv.Itoa(row.Bid),
+				strconv.Itoa(row.Ask),
+				strconv.Itoa(row.TradeVolume),
+				row.RecordedAt.UTC().Format(time.RFC3339),
+			}); err != nil {
+				return nil, err
+			}
+		}
+	}
+	w.Flush()
+	return buf.Bytes(), w.Error()
+}
+
+func derefString(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
@@ -0,0 +1,148 @@
+package queries
+
+import (
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/andrescamacho/spacetraders-go/internal/domain/market"
+	"github.com/andrescamacho/spacetraders-go/internal/domain/shared"
+)
+
+var exportNow = time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+
+type exportFakeMarketRepo struct {
+	MarketRepository
+	markets []market.Market
+}
+
+func (r *exportFakeMarketRepo) ListMarketsInSystem(_ context.Context, _ uint, _ string, _ int) ([]market.Market, error) {
+	return r.markets, nil
+}
+
+type exportFakeHistoryRepo struct {
+	entries []*market.MarketPriceHistory // oldest first, like the repository
+	since   time.Time
+	calls   int
+}
+
+func (r *exportFakeHistoryRepo) ListSystemPriceChanges(_ context.Context, _ int, system string, since time.Time) ([]*market.MarketPriceHistory, error) {
+	r.since = since
+	r.calls++
+	var out []*market.MarketPriceHistory
+	for _, e := range r.entries {
+		if strings.HasPrefix(e.WaypointSymbol(), system+"-") {
+			out = append(out, e)
+		}
+	}
+	return out, nil
+}
+
+func exportFixtures(t *testing.T) (*exportFakeMarketRepo, *exportFakeHistoryRepo) {
+	t.Helper()
+	supply, activity := "HIGH", "STRONG"
+	iron, err := market.NewTradeGood("IRON", &supply, &activity, 40, 50, 100, market.TradeTypeExport)
+	require.NoError(t, err)
+	m, err := market.NewMarket("X1-EX-A1", []market.TradeGood{*iron}, exportNow.Add(-10*time.Minute))
+	require.NoError(t, err)
+
+	older, err := market.NewMarketPriceHistoryWithID(1, "X1-EX-A1", "IRON", shared.MustNewPlayerID(1),
+		30, 45, nil, nil, 80, exportNow.Add(-3*time.Hour))
+	require.NoError(t, err)
+	newer, err := market.NewMarketPriceHistoryWithID(2, "X1-EX-A1", "IRON", shared.MustNewPlayerID(1),
+		35, 48, nil, nil, 90, exportNow.Add(-1*time.Hour))
+	require.NoError(t, err)
+
+	return &exportFakeMarketRepo{markets: []market.Market{*m}},
+		&exportFakeHistoryRepo{entries: []*market.MarketPriceHistory{older, newer}}
+}
+
+// The CSV export is one flat table: snapshot rows first, then the history
+// window oldest first, each tagged by kind.
+func TestExportMarketData_CSVIncludesSnapshotAndHistoryWindow(t *testing.T) {
+	markets, history := exportFixtures(t)
+	handler := NewExportMarketDataHandler(markets, history, &shared.MockClock{CurrentTime: exportNow})
+
+	resp, err := handler.Handle(context.Background(), &ExportMarketDataQuery{
+		PlayerID:      shared.MustNewPlayerID(1),
+		SystemSymbol:  "X1-EX",
+		Format:        MarketExportFormatCSV,
+		HistoryWindow: 24 * time.Hour,
+	})
+	require.NoError(t, err)
+	out := resp.(*ExportMarketDataResponse)
+	require.Equal(t, 1, out.MarketCount)
+	require.Equal(t, 1, out.SnapshotRows)
+	require.Equal(t, 2, out.HistoryRows)
+	require.Equal(t, exportNow.Add(-24*time.Hour), history.since)
+	require.Equal(t, 1, history.calls, "the history window is read in one system-wide query")
+
+	records, err := csv.NewReader(strings.NewReader(string(out.Content))).ReadAll()
+	require.NoError(t, err)
+	require.Len(t, records, 4)
+	require.Equal(t, []string{"bid", "ask"}, records[0][6:8])
+	require.Equal(t, []string{"snapshot", "X1-EX-A1", "IRON", "EXPORT", "HIGH", "STRONG", "40", "50", "100", "2026-03-01T11:50:00Z"}, records[1])
+	require.Equal(t, "history", records[2][0])
+	require.Equal(t, "30", records[2][6])
+	require.Equal(t, "35", records[3][6])
+}
+
+func TestExportMarketData_JSONSnapshotOnly(t *testing.T) {
+	markets, history := exportFixtures(t)
+	handler := NewExportMarketDataHandler(markets, history, &shared.MockClock{CurrentTime: exportNow})
+
+	resp, err := handler.Handle(context.Background(), &ExportMarketDataQuery{
+		PlayerID:     shared.MustNewPlayerID(1),
+		SystemSymbol: "X1-EX",
+		Format:       MarketExportFormatJSON,
+	})
+	require.NoError(t, err)
+
+	var doc marketExportDocument
+	require.NoError(t, json.Unmarshal(resp.(*ExportMarketDataResponse).Content, &doc))
+	require.Equal(t, "X1-EX", doc.SystemSymbol)
+	require.Len(t, doc.Markets, 1)
+	require.Equal(t, 100, doc.Markets[0].TradeVolume)
+	require.Empty(t, doc.History)
+	require.Nil(t, doc.HistorySince)
+}
+
+func TestExportMarketData_RejectsUnknownFormat(t *testing.T) {
+	markets, history := exportFixtures(t)
+	handler := NewExportMarketDataHandler(markets, history, nil)
+
+	_, err := handler.Handle(context.Background(), &ExportMarketDataQuery{
+		PlayerID: shared.MustNewPlayerID(1), SystemSymbol: "X1-EX", Format: "xml",
+	})
+	require.ErrorContains(t, err, "unsupported export format")
+}
+
+// The stored PurchasePrice is what the market pays (the bid) and SellPrice
+// what it charges (the ask); the export names them so nobody re-inverts them.
+func TestExportMarketData_NamesBidAndAsk(t *testing.T) {
+	markets, history := exportFixtures(t)
+	handler := NewExportMarketDataHandler(markets, history, &shared.MockClock{CurrentTime: exportNow})
+
+	resp, err := handler.Handle(context.Background(), &ExportMarketDataQuery{
+		PlayerID:      shared.MustNewPlayerID(1),
+		SystemSymbol:  "X1-EX",
+		Format:        MarketExportFormatJSON,
+		HistoryWindow: 24 * time.Hour,
+	})
+	require.NoError(t, err)
+
+	var doc struct {
+		Markets []map[string]interface{} `json:"markets"`
+		History []map[string]interface{} `json:"history"`
+	}
+	require.NoError(t, json.Unmarshal(resp.(*ExportMarketDataResponse).Content, &doc))
+	require.Equal(t, float64(40), doc.Markets[0]["bid"])
+	require.Equal(t, float64(50), doc.Markets[0]["ask"])
+	require.Equal(t, float64(30), doc.History[0]["bid"])
+	require.Equal(t, float64(45), doc.History[0]["ask"])
+}
@@ -0,0 +1,77 @@
+package queries
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/andrescamacho/spacetraders-go/internal/application/common"
+	"github.com/andrescamacho/spacetraders-go/internal/domain/market"
+	"github.com/andrescamacho/spacetraders-go/internal/domain/shared"
+)
+
+// DefaultSupplyTransitionWindow is the look-back of a stats query that names
+// no window.
+const DefaultSupplyTransitionWindow = 7 * 24 * time.Hour
+
+// SupplyTransitionReader is the narrow transition-history port the stats need.
+type SupplyTransitionReader interface {
+	ListTransitions(ctx context.Context, playerID int, waypointSymbol, goodSymbol string, since time.Time) ([]market.SupplyTransition, error)
+}
+
+// GetSupplyTransitionStatsQuery - Query for how often each (market, good)
+// changes supply level and how long it dwells at each, the data for tuning the
+// supply monitor's poll interval and HIGH gating. Empty WaypointSymbol or
+// GoodSymbol covers every market or good.
+type GetSupplyTransitionStatsQuery struct {
+	PlayerID       shared.PlayerID
+	WaypointSymbol string
+	GoodSymbol     string
+	// Window is how far back transitions are read; 0 means
+	// DefaultSupplyTransitionWindow.
+	Window time.Duration
+}
+
+// GetSupplyTransitionStatsResponse - Per (market, good) stats, busiest first.
+type GetSupplyTransitionStatsResponse struct {
+	Since time.Time
+	Stats []market.SupplyTransitionStats
+}
+
+// GetSupplyTransitionStatsHandler - Handles supply transition stats queries
+type GetSupplyTransitionStatsHandler struct {
+	transitions SupplyTransitionReader
+	clock       shared.Clock
+}
+
+// NewGetSupplyTransitionStatsHandler creates a new supply transition stats handler.
+// The clock parameter is optional - if nil, defaults to RealClock.
+func NewGetSupplyTransitionStatsHandler(transitions SupplyTransitionReader, clock shared.Clock) *GetSupplyTransitionStatsHandler {
+	if clock == nil {
+		clock = shared.NewRealClock()
+	}
+	return &GetSupplyTransitionStatsHandler{transitions: transitions, clock: clock}
+}
+
+// Handle executes the supply transition stats query
+func (h *GetSupplyTransitionStatsHandler) Handle(ctx context.Context, request common.Request) (common.Response, error) {
+	query, ok := request.(*GetSupplyTransitionStatsQuery)
+	if !ok {
+		return nil, fmt.Errorf("invalid request type")
+	}
+	window := query.Window
+	if window <= 0 {
+		window = DefaultSupplyTransitionWindow
+	}
+	now := h.clock.Now()
+	since := now.Add(-window)
+
+	transitions, err := h.transitions.ListTransitions(ctx, query.PlayerID.Value(), query.WaypointSymbol, query.GoodSymbol, since)
+	if err != nil {
+		return nil, err
+	}
+	return &GetSupplyTransitionStatsResponse{
+		Since: since,
+		Stats: market.SummarizeSupplyTransitions(transitions, since, now),
+	}, nil
+}
@@ -0,0 +1,40 @@
+package queries
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/andrescamacho/spacetraders-go/internal/domain/market"
+	"github.com/andrescamacho/spacetraders-go/internal/domain/shared"
+)
+
+type fakeSupplyTransitionReader struct {
+	since       time.Time
+	transitions []market.SupplyTransition
+}
+
+func (f *fakeSupplyTransitionReader) ListTransitions(_ context.Context, _ int, _, _ string, since time.Time) ([]market.SupplyTransition, error) {
+	f.since = since
+	return f.transitions, nil
+}
+
+func TestGetSupplyTransitionStats_DefaultsTheWindow(t *testing.T) {
+	now := time.Date(2026, 1, 8, 0, 0, 0, 0, time.UTC)
+	reader := &fakeSupplyTransitionReader{transitions: []market.SupplyTransition{
+		{WaypointSymbol: "X1-A-F1", GoodSymbol: "FABRICS", FromSupply: "MODERATE", ToSupply: "HIGH", TransitionedAt: now.Add(-time.Hour)},
+	}}
+	handler := NewGetSupplyTransitionStatsHandler(reader, &shared.MockClock{CurrentTime: now})
+
+	resp, err := handler.Handle(context.Background(), &GetSupplyTransitionStatsQuery{PlayerID: shared.MustNewPlayerID(1)})
+	if err != nil {
+		t.Fatal(err)
+	}
+	stats := resp.(*GetSupplyTransitionStatsResponse)
+	if !reader.since.Equal(now.Add(-DefaultSupplyTransitionWindow)) || !stats.Since.Equal(reader.since) {
+		t.Fatalf("expected the default 7-day window, read since %s", reader.since)
+	}
+	if len(stats.Stats) != 1 || stats.Stats[0].CurrentSupply != "HIGH" {
+		t.Fatalf("unexpected stats %+v", stats.Stats)
+	}
+}
@@ -0,0 +1,179 @@
+// Package serverstatus watches the SpaceTraders status endpoint for the
+// announcements that matter to a long-running daemon: an upcoming reset, an
+// API version change, and the reset itself, which regenerates the universe
+// under the fleet's feet.
+package serverstatus
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/andrescamacho/spacetraders-go/internal/adapters/metrics"
+	"github.com/andrescamacho/spacetraders-go/internal/application/common"
+)
+
+// DefaultResetWarning is how far ahead of an announced reset the watcher starts
+// warning when no lead time is configured.
+const DefaultResetWarning = 24 * time.Hour
+
+// ServerStatus is the part of the status endpoint the watcher reads.
+type ServerStatus struct {
+	Version string
+	// ResetDate is the date of the reset the universe has been running since
+	// (YYYY-MM-DD); it moves when the server resets.
+	ResetDate string
+	// NextReset is the announced next reset; zero when none is announced.
+	NextReset time.Time
+}
+
+// StatusReader reads the live server status.
+type StatusReader interface {
+	ServerStatus(ctx context.Context) (*ServerStatus, error)
+}
+
+// ResetBaseline reports the resetDate the daemon's stored data belongs to (the
+// open era's). ok=false when it is not known, in which case the first status
+// read becomes the baseline.
+type ResetBaseline interface {
+	KnownResetDate(ctx context.Context) (resetDate string, ok bool, err error)
+}
+
+// ResetResponder acts on a detected reset: coordinators stop spending against
+// a universe that no longer exists, and cached graphs and markets are flagged
+// so nothing plans against them.
+type ResetResponder interface {
+	PauseCoordinators(ctx context.Context, reason string) (paused int, err error)
+	InvalidateCaches(ctx context.Context) error
+}
+
+// StatusCheck is the outcome of one check.
+type StatusCheck struct {
+	Status ServerStatus
+	// UntilReset is the time left before the announced reset; zero when none
+	// is announced.
+	UntilReset time.Duration
+	// ResetSoon is true while the announced reset is within the warning lead.
+	ResetSoon bool
+	// VersionChanged is true when the version differs from the previous check's.
+	VersionChanged bool
+	// ResetDetected is true when the server's resetDate moved past the
+	// baseline and the responder was run.
+	ResetDetected      bool
+	PausedCoordinators int
+}
+
+// StatusWatcher compares each status read with what it saw before. An upcoming
+// reset and a version change are warned about once each; a reset is acted on
+// through the ResetResponder. If the responder fails, the baseline is kept so
+// the next check retries it.
+type StatusWatcher struct {
+	reader     StatusReader
+	baseline   ResetBaseline
+	responder  ResetResponder
+	warnWithin time.Duration
+	now        func() time.Time
+
+	mu        sync.Mutex
+	resetDate string
+	version   string
+	warnedFor time.Time // the NextReset already warned about
+}
+
+// NewStatusWatcher creates a watcher that warns warnWithin ahead of an
+// announced reset (DefaultResetWarning when non-positive). baseline may be nil.
+func NewStatusWatcher(reader StatusReader, baseline ResetBaseline, responder ResetResponder, warnWithin time.Duration) *StatusWatcher {
+	if warnWithin <= 0 {
+		warnWithin = DefaultResetWarning
+	}
+	return &StatusWatcher{
+		reader:     reader,
+		baseline:   baseline,
+		responder:  responder,
+		warnWithin: warnWithin,
+		now:        time.Now,
+	}
+}
+
+// Check reads the server status once and reacts to what changed since the
+// previous check.
+func (w *StatusWatcher) Check(ctx context.Context) (StatusCheck, error) {
+	status, err := w.reader.ServerStatus(ctx)
+	if err != nil {
+		return StatusCheck{}, fmt.Errorf("failed to read server status: %w", err)
+	}
+
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	logger := common.LoggerFromContext(ctx)
+	result := StatusCheck{Status: *status}
+
+	if !status.NextReset.IsZero() {
+		result.UntilReset = status.NextReset.Sub(w.now())
+		metrics.RecordServerResetCountdown(result.UntilReset.Seconds())
+		result.ResetSoon = result.UntilReset > 0 && result.UntilReset <= w.warnWithin
+		if result.ResetSoon && !w.warnedFor.Equal(status.NextReset) {
+			w.warnedFor = status.NextReset
+			logger.Log("WARNING", "Server reset approaching", map[string]interface{}{
+				"action":      "server_reset_upcoming",
+				"next_reset":  status.NextReset.Format(time.RFC3339),
+				"hours_until": fmt.Sprintf("%.1f", result.UntilReset.Hours()),
+			})
+		}
+	}
+
+	if status.Version != "" {
+		if w.version != "" && w.version != status.Version {
+			result.VersionChanged = true
+			metrics.RecordServerVersionChange()
+			logger.Log("WARNING", "Server API version changed", map[string]interface{}{
+				"action":   "server_version_changed",
+				"previous": w.version,
+				"version":  status.Version,
+			})
+		}
+		w.version = status.Version
+	}
+
+	if w.resetDate == "" {
+		w.resetDate = w.knownResetDate(ctx, status.ResetDate)
+	}
+	if status.ResetDate == "" || status.ResetDate == w.resetDate {
+		return result, nil
+	}
+
+	reason := fmt.Sprintf("server reset detected: resetDate %s (was %s)", status.ResetDate, w.resetDate)
+	logger.Log("ERROR", "Server reset detected, pausing coordinators and invalidating cached data", map[string]interface{}{
+		"action":             "server_reset_detected",
+		"previous_reset":     w.resetDate,
+		"current_reset_date": status.ResetDate,
+	})
+	paused, err := w.responder.PauseCoordinators(ctx, reason)
+	result.PausedCoordinators = paused
+	if err != nil {
+		return result, fmt.Errorf("failed to pause coordinators after reset: %w", err)
+	}
+	if err := w.responder.InvalidateCaches(ctx); err != nil {
+		return result, fmt.Errorf("failed to invalidate cached data after reset: %w", err)
+	}
+
+	metrics.RecordServerResetDetected()
+	w.resetDate = status.ResetDate
+	result.ResetDetected = true
+	return result, nil
+}
+
+// knownResetDate resolves the baseline, falling back to the live resetDate
+// when the stored one is unknown or unreadable.
+func (w *StatusWatcher) knownResetDate(ctx context.Context, live string) string {
+	if w.baseline == nil {
+		return live
+	}
+	known, ok, err := w.baseline.KnownResetDate(ctx)
+	if err != nil || !ok || known == "" {
+		return live
+	}
+	return known
+}
@@ -0,0 +1,118 @@
+package serverstatus
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+type scriptedStatus struct{ status *ServerStatus }
+
+func (s *scriptedStatus) ServerStatus(context.Context) (*ServerStatus, error) {
+	copied := *s.status
+	return &copied, nil
+}
+
+type fixedBaseline string
+
+func (b fixedBaseline) KnownResetDate(context.Context) (string, bool, error) {
+	return string(b), b != "", nil
+}
+
+type recordingResponder struct {
+	pauses        int
+	invalidations int
+	invalidateErr error
+}
+
+func (r *recordingResponder) PauseCoordinators(context.Context, string) (int, error) {
+	r.pauses++
+	return 3, nil
+}
+
+func (r *recordingResponder) InvalidateCaches(context.Context) error {
+	r.invalidations++
+	return r.invalidateErr
+}
+
+func TestStatusWatcher_ActsOnAResetOnce(t *testing.T) {
+	reader := &scriptedStatus{status: &ServerStatus{Version: "v2.3.0", ResetDate: "2026-10-04"}}
+	responder := &recordingResponder{}
+	watcher := NewStatusWatcher(reader, fixedBaseline("2026-10-04"), responder, 0)
+
+	if check, err := watcher.Check(context.Background()); err != nil || check.ResetDetected {
+		t.Fatalf("matching resetDate must not count as a reset, got %+v, %v", check, err)
+	}
+
+	reader.status.ResetDate = "2026-10-18"
+	check, err := watcher.Check(context.Background())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !check.ResetDetected || check.PausedCoordinators != 3 {
+		t.Fatalf("expected the reset detected and coordinators paused, got %+v", check)
+	}
+
+	if check, _ := watcher.Check(context.Background()); check.ResetDetected {
+		t.Fatal("a handled reset must not be acted on again")
+	}
+	if responder.pauses != 1 || responder.invalidations != 1 {
+		t.Fatalf("expected one pause and one invalidation, got %d and %d", responder.pauses, responder.invalidations)
+	}
+}
+
+// A daemon that boots after the reset compares against the open era, not the
+// first status read, so the reset is still caught.
+func TestStatusWatcher_BaselineFromTheOpenEra(t *testing.T) {
+	reader := &scriptedStatus{status: &ServerStatus{ResetDate: "2026-10-18"}}
+	responder := &recordingResponder{}
+	watcher := NewStatusWatcher(reader, fixedBaseline("2026-10-04"), responder, 0)
+
+	check, err := watcher.Check(context.Background())
+	if err != nil || !check.ResetDetected {
+		t.Fatalf("expected a reset against the era's resetDate, got %+v, %v", check, err)
+	}
+}
+
+func TestStatusWatcher_RetriesAFailedInvalidation(t *testing.T) {
+	reader := &scriptedStatus{status: &ServerStatus{ResetDate: "2026-10-18"}}
+	responder := &recordingResponder{invalidateErr: errors.New("database unavailable")}
+	watcher := NewStatusWatcher(reader, fixedBaseline("2026-10-04"), responder, 0)
+
+	if _, err := watcher.Check(context.Background()); err == nil {
+		t.Fatal("a failed invalidation must be reported")
+	}
+
+	responder.invalidateErr = nil
+	check, err := watcher.Check(context.Background())
+	if err != nil || !check.ResetDetected {
+		t.Fatalf("expected the reset handled on the retry, got %+v, %v", check, err)
+	}
+}
+
+func TestStatusWatcher_WarnsOfUpcomingResetAndVersionChange(t *testing.T) {
+	now := time.Date(2026, 10, 17, 12, 0, 0, 0, time.UTC)
+	reader := &scriptedStatus{status: &ServerStatus{
+		Version:   "v2.3.0",
+		ResetDate: "2026-10-04",
+		NextReset: now.Add(30 * time.Hour),
+	}}
+	watcher := NewStatusWatcher(reader, nil, &recordingResponder{}, 24*time.Hour)
+	watcher.now = func() time.Time { return now }
+
+	check, err := watcher.Check(context.Background())
+	if err != nil || check.ResetSoon || check.VersionChanged {
+		t.Fatalf("a reset 30h out is outside the 24h lead, got %+v, %v", check, err)
+	}
+
+	now = now.Add(8 * time.Hour)
+	reader.status.Version = "v2.4.0"
+	check, err = watcher.Check(context.Background())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !check.ResetSoon || check.UntilReset != 22*time.Hour || !check.VersionChanged {
+		t.Fatalf("expected the reset 22h out flagged and the version change seen, got %+v", check)
+	}
+}
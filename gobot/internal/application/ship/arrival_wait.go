@@ -169,6 +169,10 @@ func calculateArrivalWaitBudget(eta time.Duration, marginFactor float64, minMarg
 // positive. When false: park on the first past-ETA observation off the DB
 // read alone (no API call). Either way the happy path (ARRIVED event, or a DB
 // poll that already shows the hull left transit) makes ZERO API calls.
+//
+// Every deadline and tick reads the context's clock (shared.WithClock), so a
+// scenario running on a SimulatedClock waits out a long transit the moment the
+// test advances past it.
 func waitForShipArrivalCore(
 	ctx context.Context,
 	shipRepo domainNavigation.ShipQueryRepository,
@@ -182,11 +186,12 @@ func waitForShipArrivalCore(
 	liveReconfirm bool,
 ) error {
 	shipSymbol := ship.ShipSymbol()
+	clock := shared.ClockFromContext(ctx)
 
 	arrivedCh := subscriber.SubscribeArrived(shipSymbol)
 	defer subscriber.UnsubscribeArrived(shipSymbol, arrivedCh)
 
-	deadline := time.Now().Add(budget)
+	deadline := clock.Now().Add(budget)
 
 	logger.Log("INFO", "Waiting for ship arrival event", map[string]interface{}{
 		"ship_symbol":      shipSymbol,
@@ -210,7 +215,7 @@ func waitForShipArrivalCore(
 	//     (INFO); only a poll past the expected arrival means the event is
 	//     genuinely overdue and worth a WARNING, so the real lost-event signal
 	//     is never drowned in routine-poll noise.
-	expectedArrival := time.Now().Add(time.Duration(waitTimeSeconds) * time.Second)
+	expectedArrival := clock.Now().Add(time.Duration(waitTimeSeconds) * time.Second)
 	nextTick := gracePeriod
 
 	attempt := 0
@@ -236,14 +241,14 @@ func waitForShipArrivalCore(
 		case <-ctx.Done():
 			return ctx.Err()
 
-		case <-time.After(nextTick):
+		case <-shared.After(clock, nextTick):
 			attempt++
 			// No event yet: resync against the source of truth instead of
 			// assuming the event is merely slow - it may have been dropped by
 			// ShipEventBus's non-blocking, non-replaying send (lost if
 			// PublishArrived raced ahead of SubscribeArrived). Severity tracks
 			// whether the arrival is actually due (see expectedArrival above).
-			dueIn := time.Until(expectedArrival)
+			dueIn := expectedArrival.Sub(clock.Now())
 			if dueIn > 0 {
 				logger.Log("INFO", "Arrival not due yet - safety resync while in transit", map[string]interface{}{
 					"ship_symbol":    shipSymbol,
@@ -305,7 +310,7 @@ func waitForShipArrivalCore(
 					"destination":    shipLocationSymbol(ship),
 				})
 
-			case arrivalIsPast(fresh, time.Now()):
+			case arrivalIsPast(fresh, clock.Now()):
 				// The LOCAL DB poll shows the ship still IN_TRANSIT with its own ETA
 				// already past. On a SHORT leg (ETA <= ~gracePeriod) that can be a
 				// FALSE positive: the hull has physically arrived, but the async,
@@ -382,7 +387,7 @@ func waitForShipArrivalCore(
 				})
 			}
 
-			if !time.Now().Before(deadline) {
+			if !clock.Now().Before(deadline) {
 				logger.Log("ERROR", "Ship arrival wait budget exhausted, parking", map[string]interface{}{
 					"ship_symbol":    shipSymbol,
 					"action":         "arrival_wait_exhausted",
@@ -399,10 +404,10 @@ func waitForShipArrivalCore(
 			// cadence. Capped so a tick never sleeps far past the budget
 			// deadline — the check above must get its turn.
 			nextTick = gracePeriod
-			if remaining := time.Until(expectedArrival) + gracePeriod; remaining > nextTick {
+			if remaining := expectedArrival.Sub(clock.Now()) + gracePeriod; remaining > nextTick {
 				nextTick = remaining
 			}
-			if untilDeadline := time.Until(deadline) + gracePeriod; nextTick > untilDeadline {
+			if untilDeadline := deadline.Sub(clock.Now()) + gracePeriod; nextTick > untilDeadline {
 				nextTick = untilDeadline
 			}
 		}
@@ -424,20 +429,21 @@ func parkLostEvent(logger common.ContainerLogger, shipSymbol string, attempt int
 
 // liveAPIShowsLeftTransit re-confirms a would-be park against the AUTHORITATIVE
 // live API (Fix A). The local DB is the source of truth for ship state but LAGS the
-// async, best-effort IN_TRANSIT->IN_ORBIT transition; a live GetShipData reflects
-// the hull's real status immediately. Returns true when the API shows the hull is
-// no longer IN_TRANSIT (arrived / in-orbit / docked) — i.e. it physically arrived
-// and the local row is merely stale, so the caller must apply the arrival rather
-// than false-park. Returns (false, err) on any API failure so the caller falls back
-// to today's DB-only park (never worse than status quo). This is the ONLY API call
-// in the whole wait and fires only on the rare park path; the happy path makes ZERO
-// API calls.
+// async, best-effort IN_TRANSIT->IN_ORBIT transition; a live nav read (GetShipNav,
+// the nav block alone rather than the whole ship) reflects the hull's real status
+// immediately. Returns true when the API shows the hull is no longer IN_TRANSIT
+// (arrived / in-orbit / docked) — i.e. it physically arrived and the local row is
+// merely stale, so the caller must apply the arrival rather than false-park.
+// Returns (false, err) on any API failure so the caller falls back to today's
+// DB-only park (never worse than status quo). This is the ONLY API call in the
+// whole wait and fires only on the rare park path; the happy path makes ZERO API
+// calls.
 func liveAPIShowsLeftTransit(ctx context.Context, shipRepo domainNavigation.ShipQueryRepository, shipSymbol string, playerID shared.PlayerID) (bool, error) {
-	data, err := shipRepo.GetShipData(ctx, shipSymbol, playerID)
+	nav, err := shipRepo.GetShipNav(ctx, shipSymbol, playerID)
 	if err != nil {
 		return false, err
 	}
-	return domainNavigation.NavStatus(data.NavStatus) != domainNavigation.NavStatusInTransit, nil
+	return domainNavigation.NavStatus(nav.NavStatus) != domainNavigation.NavStatusInTransit, nil
 }
 
 // arrivalIsPast reports whether fresh's own ArrivalTime is already behind
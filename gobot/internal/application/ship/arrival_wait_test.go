@@ -51,16 +51,16 @@ func (f *fakeArrivalSubscriber) UnsubscribeTransferCompleted(int, <-chan domainN
 // the "resync confirms arrival" and "resync still shows in transit" paths
 // deterministically without a real database.
 //
-// getShipDataFunc scripts the AUTHORITATIVE live-API re-confirm (Fix A). It is left
+// getShipNavFunc scripts the AUTHORITATIVE live-API re-confirm (Fix A). It is left
 // nil by tests that must never reach it (the happy path and the flag-OFF park path):
-// in that case GetShipData fails loudly, so an unexpected API call surfaces as a
-// test failure rather than passing silently. getShipDataCalls records the live-API
+// in that case GetShipNav fails loudly, so an unexpected API call surfaces as a
+// test failure rather than passing silently. getShipNavCalls records the live-API
 // call count so a test can assert the zero-extra-API-calls contract.
 type fakeShipQueryRepo struct {
 	findBySymbolFunc func() (*domainNavigation.Ship, error)
 	calls            int
-	getShipDataFunc  func() (*domainNavigation.ShipData, error)
-	getShipDataCalls int
+	getShipNavFunc   func() (*domainNavigation.ShipNavData, error)
+	getShipNavCalls  int
 }
 
 func (f *fakeShipQueryRepo) FindBySymbol(_ context.Context, _ string, _ shared.PlayerID) (*domainNavigation.Ship, error) {
@@ -68,11 +68,14 @@ func (f *fakeShipQueryRepo) FindBySymbol(_ context.Context, _ string, _ shared.P
 	return f.findBySymbolFunc()
 }
 func (f *fakeShipQueryRepo) GetShipData(_ context.Context, _ string, _ shared.PlayerID) (*domainNavigation.ShipData, error) {
-	f.getShipDataCalls++
-	if f.getShipDataFunc == nil {
-		return nil, fmt.Errorf("fakeShipQueryRepo: GetShipData called unexpectedly (no live-API script set)")
+	return nil, fmt.Errorf("fakeShipQueryRepo: GetShipData called unexpectedly (the re-confirm reads nav only)")
+}
+func (f *fakeShipQueryRepo) GetShipNav(_ context.Context, _ string, _ shared.PlayerID) (*domainNavigation.ShipNavData, error) {
+	f.getShipNavCalls++
+	if f.getShipNavFunc == nil {
+		return nil, fmt.Errorf("fakeShipQueryRepo: GetShipNav called unexpectedly (no live-API script set)")
 	}
-	return f.getShipDataFunc()
+	return f.getShipNavFunc()
 }
 func (f *fakeShipQueryRepo) FindAllByPlayer(_ context.Context, _ shared.PlayerID) ([]*domainNavigation.Ship, error) {
 	return nil, fmt.Errorf("fakeShipQueryRepo: FindAllByPlayer not implemented")
@@ -471,8 +474,8 @@ func TestWaitForShipArrivalCore_StaleDBPastETA_LiveAPIConfirmsArrived_ReturnsSuc
 			return newArrivalWaitTestShipWithArrival(t, domainNavigation.NavStatusInTransit, time.Now().Add(-time.Minute)), nil
 		},
 		// The live API is authoritative: the hull actually arrived (IN_ORBIT).
-		getShipDataFunc: func() (*domainNavigation.ShipData, error) {
-			return &domainNavigation.ShipData{NavStatus: string(domainNavigation.NavStatusInOrbit)}, nil
+		getShipNavFunc: func() (*domainNavigation.ShipNavData, error) {
+			return &domainNavigation.ShipNavData{NavStatus: string(domainNavigation.NavStatusInOrbit)}, nil
 		},
 	}
 
@@ -488,8 +491,8 @@ func TestWaitForShipArrivalCore_StaleDBPastETA_LiveAPIConfirmsArrived_ReturnsSuc
 	if repo.calls != requiredPastETAObservationsBeforePark {
 		t.Fatalf("expected %d local-DB observations before the live re-confirm, got %d", requiredPastETAObservationsBeforePark, repo.calls)
 	}
-	if repo.getShipDataCalls != 1 {
-		t.Fatalf("expected EXACTLY one live-API re-confirm call on the park path, got %d (must never be per-poll)", repo.getShipDataCalls)
+	if repo.getShipNavCalls != 1 {
+		t.Fatalf("expected EXACTLY one live-API re-confirm call on the park path, got %d (must never be per-poll)", repo.getShipNavCalls)
 	}
 }
 
@@ -504,7 +507,7 @@ func TestWaitForShipArrivalCore_StaleDBPastETA_LiveAPIError_FallsBackToPark(t *t
 		findBySymbolFunc: func() (*domainNavigation.Ship, error) {
 			return newArrivalWaitTestShipWithArrival(t, domainNavigation.NavStatusInTransit, time.Now().Add(-time.Minute)), nil
 		},
-		getShipDataFunc: func() (*domainNavigation.ShipData, error) {
+		getShipNavFunc: func() (*domainNavigation.ShipNavData, error) {
 			return nil, fmt.Errorf("API unreachable")
 		},
 	}
@@ -517,8 +520,8 @@ func TestWaitForShipArrivalCore_StaleDBPastETA_LiveAPIError_FallsBackToPark(t *t
 	if ship.NavStatus() != domainNavigation.NavStatusInTransit {
 		t.Fatalf("ship state must be untouched on the fail-safe park, got %s", ship.NavStatus())
 	}
-	if repo.getShipDataCalls != 1 {
-		t.Fatalf("expected exactly one live-API attempt before the fallback park, got %d", repo.getShipDataCalls)
+	if repo.getShipNavCalls != 1 {
+		t.Fatalf("expected exactly one live-API attempt before the fallback park, got %d", repo.getShipNavCalls)
 	}
 }
 
@@ -534,8 +537,8 @@ func TestWaitForShipArrivalCore_StaleDBPastETA_LiveAlsoInTransit_StillParks(t *t
 			return newArrivalWaitTestShipWithArrival(t, domainNavigation.NavStatusInTransit, time.Now().Add(-time.Minute)), nil
 		},
 		// Live API AGREES: genuinely still in transit.
-		getShipDataFunc: func() (*domainNavigation.ShipData, error) {
-			return &domainNavigation.ShipData{NavStatus: string(domainNavigation.NavStatusInTransit)}, nil
+		getShipNavFunc: func() (*domainNavigation.ShipNavData, error) {
+			return &domainNavigation.ShipNavData{NavStatus: string(domainNavigation.NavStatusInTransit)}, nil
 		},
 	}
 
@@ -544,8 +547,8 @@ func TestWaitForShipArrivalCore_StaleDBPastETA_LiveAlsoInTransit_StillParks(t *t
 	if !errors.As(err, &exhausted) {
 		t.Fatalf("expected a genuinely-stuck hull to still park (*ErrArrivalWaitExhausted), got %T: %v", err, err)
 	}
-	if repo.getShipDataCalls != 1 {
-		t.Fatalf("expected exactly one live-API re-confirm before parking a genuinely-stuck hull, got %d", repo.getShipDataCalls)
+	if repo.getShipNavCalls != 1 {
+		t.Fatalf("expected exactly one live-API re-confirm before parking a genuinely-stuck hull, got %d", repo.getShipNavCalls)
 	}
 }
 
@@ -578,8 +581,8 @@ func TestWaitForShipArrivalCore_ShortLeg_DoesNotParkOnFirstPastETAObservation(t
 	if repo.calls != 2 {
 		t.Fatalf("expected the debounce to re-read the local row (2 polls) rather than park on the first, got %d", repo.calls)
 	}
-	if repo.getShipDataCalls != 0 {
-		t.Fatalf("expected ZERO live-API calls when the DB debounce resolves the arrival, got %d", repo.getShipDataCalls)
+	if repo.getShipNavCalls != 0 {
+		t.Fatalf("expected ZERO live-API calls when the DB debounce resolves the arrival, got %d", repo.getShipNavCalls)
 	}
 }
 
@@ -597,8 +600,8 @@ func TestWaitForShipArrivalCore_LiveReconfirmDisabled_ParksDBOnly(t *testing.T)
 			return newArrivalWaitTestShipWithArrival(t, domainNavigation.NavStatusInTransit, time.Now().Add(-time.Minute)), nil
 		},
 		// Scripted to "arrived" to PROVE the flag-off path never consults it.
-		getShipDataFunc: func() (*domainNavigation.ShipData, error) {
-			return &domainNavigation.ShipData{NavStatus: string(domainNavigation.NavStatusInOrbit)}, nil
+		getShipNavFunc: func() (*domainNavigation.ShipNavData, error) {
+			return &domainNavigation.ShipNavData{NavStatus: string(domainNavigation.NavStatusInOrbit)}, nil
 		},
 	}
 
@@ -610,8 +613,8 @@ func TestWaitForShipArrivalCore_LiveReconfirmDisabled_ParksDBOnly(t *testing.T)
 	if repo.calls != 1 || exhausted.Attempts != 1 {
 		t.Fatalf("expected the pre-fix single-resync park (calls=1, Attempts=1), got calls=%d Attempts=%d", repo.calls, exhausted.Attempts)
 	}
-	if repo.getShipDataCalls != 0 {
-		t.Fatalf("expected ZERO live-API calls with the kill-switch OFF, got %d", repo.getShipDataCalls)
+	if repo.getShipNavCalls != 0 {
+		t.Fatalf("expected ZERO live-API calls with the kill-switch OFF, got %d", repo.getShipNavCalls)
 	}
 }
 
@@ -685,3 +688,52 @@ func TestCalculateArrivalWaitBudget_ScalesWithETA(t *testing.T) {
 		})
 	}
 }
+
+// TestWaitForShipArrival_SimulatedClock_HourLongTransitResolvesInstantly drives
+// the production entry point (real grace period and budget) on a SimulatedClock
+// stamped on the context: an hour-long transit whose ARRIVED event is lost must
+// resolve by advancing simulated time alone, without the test sleeping.
+func TestWaitForShipArrival_SimulatedClock_HourLongTransitResolvesInstantly(t *testing.T) {
+	start := time.Date(2024, 3, 1, 12, 0, 0, 0, time.UTC)
+	clock := shared.NewSimulatedClock(start)
+	eta := start.Add(time.Hour)
+
+	ship := newArrivalWaitTestShipWithArrival(t, domainNavigation.NavStatusInTransit, eta)
+	sub := &fakeArrivalSubscriber{ch: make(chan domainNavigation.ShipArrivedEvent, 1)} // never fed
+	repo := &fakeShipQueryRepo{findBySymbolFunc: func() (*domainNavigation.Ship, error) {
+		if clock.Now().Before(eta) {
+			return newArrivalWaitTestShipWithArrival(t, domainNavigation.NavStatusInTransit, eta), nil
+		}
+		return newArrivalWaitTestShip(t, domainNavigation.NavStatusInOrbit), nil
+	}}
+
+	done := make(chan error, 1)
+	go func() {
+		ctx := shared.WithClock(context.Background(), clock)
+		done <- WaitForShipArrival(ctx, repo, sub, ship, shared.MustNewPlayerID(1), 3600, noopLogger{})
+	}()
+
+	realStart := time.Now()
+	for {
+		select {
+		case err := <-done:
+			if err != nil {
+				t.Fatalf("expected the simulated transit to arrive, got: %v", err)
+			}
+			if ship.NavStatus() != domainNavigation.NavStatusInOrbit {
+				t.Fatalf("expected ship to have Arrive()'d, got status %s", ship.NavStatus())
+			}
+			if repo.calls != 2 {
+				t.Fatalf("expected the fast first resync plus one at the ETA, got %d", repo.calls)
+			}
+			if elapsed := time.Since(realStart); elapsed > time.Second {
+				t.Fatalf("simulated hour took %s of real time", elapsed)
+			}
+			return
+		default:
+		}
+		if clock.BlockUntilWaiters(1, 10*time.Millisecond) {
+			clock.AdvanceToNextWaiter()
+		}
+	}
+}
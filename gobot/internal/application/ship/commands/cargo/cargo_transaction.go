@@ -4,23 +4,32 @@ import (
 	"context"
 	"fmt"
 	"hash/fnv"
+	"math"
 	"strings"
 	"sync/atomic"
 	"time"
 
 	"github.com/andrescamacho/spacetraders-go/internal/application/common"
 	ledgerCommands "github.com/andrescamacho/spacetraders-go/internal/application/ledger/commands"
+	ledgerQueries "github.com/andrescamacho/spacetraders-go/internal/application/ledger/queries"
 	"github.com/andrescamacho/spacetraders-go/internal/application/logging"
 	scoutingQuery "github.com/andrescamacho/spacetraders-go/internal/application/scouting/queries"
 	shipPkg "github.com/andrescamacho/spacetraders-go/internal/application/ship"
 	"github.com/andrescamacho/spacetraders-go/internal/application/ship/strategies"
+	"github.com/andrescamacho/spacetraders-go/internal/domain/ledger"
 	"github.com/andrescamacho/spacetraders-go/internal/domain/navigation"
 	"github.com/andrescamacho/spacetraders-go/internal/domain/player"
 	domainPorts "github.com/andrescamacho/spacetraders-go/internal/domain/ports"
 	"github.com/andrescamacho/spacetraders-go/internal/domain/shared"
+	"github.com/andrescamacho/spacetraders-go/internal/domain/trading"
 	"github.com/andrescamacho/spacetraders-go/pkg/utils"
 )
 
+// maxFeeQuoteAge bounds how old the cached quote may be for a transaction to be
+// reported as a market fee observation. Against an older quote the gap is mostly
+// price drift, which would read as a fee.
+const maxFeeQuoteAge = 5 * time.Minute
+
 // MarketRefresher defines the interface for refreshing market data after transactions.
 // This interface allows the CargoTransactionHandler to refresh prices without
 // creating import cycles with scouting/commands.
@@ -59,6 +68,15 @@ type CargoTransactionCommand struct {
 	// coordinator. Ignored for purchases.
 	MinBidPerUnit int
 
+	// MinPercentOfCost arms a sell floor relative to what the hull paid: the
+	// handler reads the good's cost basis for this ship from the ledger
+	// (GetCargoCostBasisQuery) and floors every tranche at ceil(basis × pct/100)
+	// — 100 refuses to sell below cost, 90 accepts at most a 10% loss. When both
+	// floors are set the higher one governs. Cargo with no purchase on the ledger
+	// (mined, siphoned, delivered) has no basis and sells under MinBidPerUnit
+	// alone. 0 disables it. Ignored for purchases.
+	MinPercentOfCost float64
+
 	// MaxAskPerUnit (sp-9mkf) is the mirror of MinBidPerUnit for the BUY side: the
 	// per-tranche buy CEILING. Before each purchase tranche the handler re-reads the
 	// LIVE ask and, if it has laddered ABOVE this per-unit ceiling, ABORTS the
@@ -90,6 +108,12 @@ type CargoTransactionResponse struct {
 	// for an unfloored transaction.
 	FloorAborted     bool
 	FloorObservedBid int
+	// FloorPerUnit is the sell floor that governed the sale (the higher of
+	// MinBidPerUnit and the cost-relative floor); CostBasisPerUnit is the ledger
+	// cost basis that floor was derived from (0 when unknown or not asked for).
+	// Together they tell a refused caller what another market must bid.
+	FloorPerUnit     int
+	CostBasisPerUnit int
 
 	// CeilingAborted (sp-9mkf) is true when the per-tranche buy ceiling stopped the
 	// purchase early: the live ask rose above MaxAskPerUnit, so the remaining units
@@ -132,6 +156,9 @@ type CargoTransactionHandler struct {
 	apiClient       domainPorts.APIClient
 	mediator        common.Mediator
 	marketRefresher MarketRefresher // Optional: refreshes market data after transactions
+	feeObserver     trading.MarketFeeObserver
+	costBasis       ledger.CargoCostBasisRecorder
+	reservations    ledger.CreditReservations
 
 	// impactNonce is the per-trade counter that spreads the sp-v34b impact-scan
 	// sampling evenly across every market and hull this shared handler serves: each
@@ -169,6 +196,24 @@ func NewCargoTransactionHandler(
 	}
 }
 
+// SetMarketFeeObserver sets the observer fed each transaction's quoted vs
+// charged total, for market fee modeling. nil disables the reporting.
+func (h *CargoTransactionHandler) SetMarketFeeObserver(observer trading.MarketFeeObserver) {
+	h.feeObserver = observer
+}
+
+// SetCostBasisRecorder sets the recorder each completed purchase or sale
+// updates the ship's per-good cost basis through. nil disables the tracking.
+func (h *CargoTransactionHandler) SetCostBasisRecorder(recorder ledger.CargoCostBasisRecorder) {
+	h.costBasis = recorder
+}
+
+// SetCreditReservations makes purchases validate against credits not
+// reserved by other coordinators. nil disables the check.
+func (h *CargoTransactionHandler) SetCreditReservations(reservations ledger.CreditReservations) {
+	h.reservations = reservations
+}
+
 // Handle executes the cargo transaction command with automatic transaction splitting.
 //
 // The method follows a consistent flow:
@@ -224,13 +269,28 @@ func (h *CargoTransactionHandler) Handle(ctx context.Context, request common.Req
 		return &CargoTransactionResponse{Reserved: true}, nil
 	}
 
+	if h.strategy.GetTransactionType() == "purchase" {
+		if err := h.checkUnreservedCredits(ctx, cmd, token, ship.CurrentLocation().Symbol); err != nil {
+			return nil, err
+		}
+	}
+
+	sellFloor, costBasis := cmd.MinBidPerUnit, 0
+	if h.strategy.GetTransactionType() == "sell" && cmd.MinPercentOfCost > 0 {
+		sellFloor, costBasis = h.costRelativeFloor(ctx, cmd)
+	}
+
 	transactionLimit := h.getTransactionLimit(ctx, ship, cmd)
 	waypointSymbol := ship.CurrentLocation().Symbol
 
-	response, err := h.executeTransactions(ctx, cmd, token, transactionLimit, waypointSymbol)
+	response, err := h.executeTransactions(ctx, cmd, token, transactionLimit, waypointSymbol, sellFloor)
 	if err != nil {
 		return nil, err
 	}
+	if sellFloor > 0 && h.strategy.GetTransactionType() == "sell" {
+		response.FloorPerUnit = sellFloor
+		response.CostBasisPerUnit = costBasis
+	}
 
 	// Note: Ledger recording now happens inside executeTransactions after each batch
 	// This ensures partial purchases are recorded even if later batches fail
@@ -238,6 +298,75 @@ func (h *CargoTransactionHandler) Handle(ctx context.Context, request common.Req
 	return response, nil
 }
 
+// checkUnreservedCredits refuses a purchase whose estimated cost (cached ask ×
+// units) exceeds the live balance less credits other coordinators have
+// reserved. The caller's own reservation, carried on ctx, is not counted. With
+// nothing reserved by others it returns without an API read. Once credits are
+// reserved it fails closed (RULINGS #4): a purchase whose ask or the balance
+// cannot be read is refused, since it could spend what another holds.
+func (h *CargoTransactionHandler) checkUnreservedCredits(ctx context.Context, cmd *CargoTransactionCommand, token, waypoint string) error {
+	if h.reservations == nil {
+		return nil
+	}
+	reserved := h.reservations.ReservedExcept(cmd.PlayerID.Value(), ledger.CreditReservationFromContext(ctx))
+	if reserved == 0 {
+		return nil
+	}
+	mkt, err := h.marketRepo.GetMarketData(ctx, waypoint, cmd.PlayerID.Value())
+	if err != nil {
+		return fmt.Errorf("purchase of %s refused: %d credits are reserved and the market at %s is unreadable: %w",
+			cmd.GoodSymbol, reserved, waypoint, err)
+	}
+	if mkt == nil {
+		return fmt.Errorf("purchase of %s refused: %d credits are reserved and %s has no market data to price it",
+			cmd.GoodSymbol, reserved, waypoint)
+	}
+	g := mkt.FindGood(cmd.GoodSymbol)
+	if g == nil {
+		return fmt.Errorf("purchase of %s refused: %d credits are reserved and %s does not list it to price it",
+			cmd.GoodSymbol, reserved, waypoint)
+	}
+	agent, err := h.apiClient.GetAgent(ctx, token)
+	if err != nil {
+		return fmt.Errorf("purchase of %s refused: %d credits are reserved and the live balance is unreadable: %w",
+			cmd.GoodSymbol, reserved, err)
+	}
+	need := g.SellPrice() * cmd.Units
+	if agent.Credits-reserved < need {
+		return &ledger.ErrInsufficientUnreservedCredits{Need: need, Credits: agent.Credits, Reserved: reserved}
+	}
+	return nil
+}
+
+// costRelativeFloor resolves MinPercentOfCost into a per-unit sell floor from
+// the ship's ledger cost basis for the good, returning the floor to enforce
+// (never below MinBidPerUnit) and the basis it came from. An unknown or
+// unreadable basis leaves MinBidPerUnit in force: the ledger is an input to the
+// floor, not a reason to hold cargo that was never bought.
+func (h *CargoTransactionHandler) costRelativeFloor(ctx context.Context, cmd *CargoTransactionCommand) (floor, basis int) {
+	floor = cmd.MinBidPerUnit
+	resp, err := h.mediator.Send(ctx, &ledgerQueries.GetCargoCostBasisQuery{
+		PlayerID:   cmd.PlayerID.Value(),
+		ShipSymbol: cmd.ShipSymbol,
+		GoodSymbol: cmd.GoodSymbol,
+		Units:      cmd.Units,
+	})
+	if err != nil {
+		logging.LoggerFromContext(ctx).Log("WARNING", fmt.Sprintf(
+			"Cost basis for %s on %s unreadable: %v - selling under the absolute floor only",
+			cmd.GoodSymbol, cmd.ShipSymbol, err), nil)
+		return floor, 0
+	}
+	costBasis, ok := resp.(*ledgerQueries.GetCargoCostBasisResponse)
+	if !ok || !costBasis.Known {
+		return floor, 0
+	}
+	if relative := int(math.Ceil(float64(costBasis.PerUnit) * cmd.MinPercentOfCost / 100)); relative > floor {
+		floor = relative
+	}
+	return floor, costBasis.PerUnit
+}
+
 // getPlayerToken retrieves the player token from the context.
 func (h *CargoTransactionHandler) getPlayerToken(ctx context.Context) (string, error) {
 	return common.PlayerTokenFromContext(ctx)
@@ -287,7 +416,7 @@ func (h *CargoTransactionHandler) getTransactionLimit(ctx context.Context, ship
 // concurrent writer's nav/fuel/other-cargo update on the same hull is re-applied
 // rather than last-write-wins clobbered (sp-wa7c). The pre-loaded ship snapshot is
 // therefore no longer needed here — the persist closure reads the fresh row.
-func (h *CargoTransactionHandler) executeTransactions(ctx context.Context, cmd *CargoTransactionCommand, token string, transactionLimit int, waypointSymbol string) (*CargoTransactionResponse, error) {
+func (h *CargoTransactionHandler) executeTransactions(ctx context.Context, cmd *CargoTransactionCommand, token string, transactionLimit int, waypointSymbol string, sellFloor int) (*CargoTransactionResponse, error) {
 	totalAmount := 0
 	unitsProcessed := 0
 	transactionCount := 0
@@ -311,18 +440,19 @@ func (h *CargoTransactionHandler) executeTransactions(ctx context.Context, cmd *
 		// LIVE bid and abort the remainder if it has fallen below the armed floor —
 		// so a bid our own earlier tranches (or a colliding hull) crushed is never
 		// dumped into. The remainder stays aboard for later liquidation. Only sells
-		// with MinBidPerUnit>0 arm it; every other caller runs the loop unchanged.
-		// Fails CLOSED: a live bid we cannot read (ok=false) holds the remainder too.
-		if transactionType == "sell" && cmd.MinBidPerUnit > 0 {
+		// with a floor (MinBidPerUnit, or MinPercentOfCost over a known cost basis)
+		// arm it; every other caller runs the loop unchanged. Fails CLOSED: a live
+		// bid we cannot read (ok=false) holds the remainder too.
+		if transactionType == "sell" && sellFloor > 0 {
 			liveBid, ok := h.liveBidForFloor(ctx, waypointSymbol, cmd.GoodSymbol, cmd.PlayerID)
-			if !ok || liveBid < cmd.MinBidPerUnit {
+			if !ok || liveBid < sellFloor {
 				floorAborted = true
 				floorObservedBid = liveBid
 				logging.LoggerFromContext(ctx).Log("WARNING", fmt.Sprintf(
 					"Sell floor tripped for %s at %s: live bid %d < floor %d/unit (readable=%t) - aborting remaining %d units, held aboard",
-					cmd.GoodSymbol, waypointSymbol, liveBid, cmd.MinBidPerUnit, ok, unitsRemaining), map[string]interface{}{
+					cmd.GoodSymbol, waypointSymbol, liveBid, sellFloor, ok, unitsRemaining), map[string]interface{}{
 					"action": "sell_floor_abort", "ship_symbol": cmd.ShipSymbol, "good": cmd.GoodSymbol,
-					"waypoint": waypointSymbol, "live_bid": liveBid, "floor": cmd.MinBidPerUnit,
+					"waypoint": waypointSymbol, "live_bid": liveBid, "floor": sellFloor,
 					"bid_readable": ok, "units_held": unitsRemaining,
 				})
 				break
@@ -354,6 +484,13 @@ func (h *CargoTransactionHandler) executeTransactions(ctx context.Context, cmd *
 
 		unitsToProcess := utils.Min(unitsRemaining, transactionLimit)
 
+		// Only the first tranche is priced against a quote our own trading has not
+		// yet moved, so it alone feeds the fee model.
+		feeQuote := 0
+		if transactionCount == 0 {
+			feeQuote = h.feeQuote(ctx, transactionType, waypointSymbol, cmd.GoodSymbol, cmd.PlayerID)
+		}
+
 		result, err := h.strategy.Execute(ctx, cmd.ShipSymbol, cmd.GoodSymbol, unitsToProcess, token)
 		if err != nil {
 			// Return error but partial success is already recorded in ledger
@@ -366,6 +503,22 @@ func (h *CargoTransactionHandler) executeTransactions(ctx context.Context, cmd *
 		transactionCount++
 		unitsRemaining -= unitsToProcess
 
+		if feeQuote > 0 && result.UnitsProcessed > 0 {
+			feeType := trading.FeeTransactionPurchase
+			if transactionType == "sell" {
+				feeType = trading.FeeTransactionSell
+			}
+			h.feeObserver.ObserveMarketFee(ctx, trading.MarketFeeObservation{
+				Waypoint:        waypointSymbol,
+				Good:            cmd.GoodSymbol,
+				TransactionType: feeType,
+				Units:           result.UnitsProcessed,
+				Expected:        feeQuote * result.UnitsProcessed,
+				Actual:          result.TotalAmount,
+				ObservedAt:      time.Now(),
+			})
+		}
+
 		// Record ledger entry immediately after each successful batch.
 		// The API returns the agent's post-transaction credits in-band per
 		// batch; each recorded row re-anchors the ledger to that truth so the
@@ -401,6 +554,10 @@ func (h *CargoTransactionHandler) executeTransactions(ctx context.Context, cmd *
 			})
 	}
 
+	if unitsProcessed > 0 {
+		h.recordCostBasis(ctx, cmd, transactionType, unitsProcessed, totalAmount)
+	}
+
 	// Refresh market data once after all batches complete (not per-batch)
 	// This reduces API calls from 2N to N+1 for N batches
 	h.refreshMarketData(ctx, cmd, waypointSymbol)
@@ -464,6 +621,27 @@ func (h *CargoTransactionHandler) liveAskForCeiling(ctx context.Context, waypoin
 	return g.SellPrice(), true // market SELL price = the ASK the hull pays to buy
 }
 
+// feeQuote returns the cached per-unit price a transaction of transactionType
+// expects at waypoint — the ask for a purchase, the bid for a sale — or 0 when no
+// fee observer is wired or the cache is missing or older than maxFeeQuoteAge.
+func (h *CargoTransactionHandler) feeQuote(ctx context.Context, transactionType, waypoint, good string, playerID shared.PlayerID) int {
+	if h.feeObserver == nil {
+		return 0
+	}
+	mkt, err := h.marketRepo.GetMarketData(ctx, waypoint, playerID.Value())
+	if err != nil || !shipPkg.MarketFreshWithin(mkt, maxFeeQuoteAge, time.Now()) {
+		return 0
+	}
+	g := mkt.FindGood(good)
+	if g == nil {
+		return 0
+	}
+	if transactionType == "sell" {
+		return g.PurchasePrice()
+	}
+	return g.SellPrice()
+}
+
 // recordCargoTransaction records the cargo transaction in the ledger
 func (h *CargoTransactionHandler) recordCargoTransaction(
 	ctx context.Context,
@@ -551,12 +729,21 @@ func (h *CargoTransactionHandler) recordCargoTransaction(
 	if opCtx := shared.OperationContextFromContext(ctx); opCtx != nil && opCtx.IsValid() {
 		recordCmd.RelatedEntityType = "container"
 		recordCmd.RelatedEntityID = opCtx.ContainerID
+		recordCmd.ContainerID = opCtx.ContainerID
 		recordCmd.OperationType = opCtx.NormalizedOperationType()
 	} else {
 		// No operation context - mark as manual transaction
 		recordCmd.OperationType = "manual"
 	}
 
+	// A contract delivery saga's compensating sale is filed against the contract
+	// whose failed delivery it recovers, keeping the container attribution.
+	if contractID, ok := shared.CompensationForFromContext(ctx); ok {
+		recordCmd.RelatedEntityType = "contract"
+		recordCmd.RelatedEntityID = contractID
+		metadata["compensation_for_contract"] = contractID
+	}
+
 	// Record transaction via mediator
 	_, err = h.mediator.Send(context.Background(), recordCmd)
 	if err != nil {
@@ -578,6 +765,24 @@ func (h *CargoTransactionHandler) recordCargoTransaction(
 	}
 }
 
+// recordCostBasis books the transaction against the ship's cost basis for the
+// good: a purchase adds its units at what they cost, a sale releases its units.
+// Best-effort, like the ledger row.
+func (h *CargoTransactionHandler) recordCostBasis(ctx context.Context, cmd *CargoTransactionCommand, transactionType string, units, totalAmount int) {
+	if h.costBasis == nil {
+		return
+	}
+	var err error
+	if transactionType == "purchase" {
+		err = h.costBasis.RecordAcquisition(ctx, cmd.PlayerID.Value(), cmd.ShipSymbol, cmd.GoodSymbol, units, totalAmount)
+	} else {
+		err = h.costBasis.RecordRemoval(ctx, cmd.PlayerID.Value(), cmd.ShipSymbol, cmd.GoodSymbol, units)
+	}
+	if err != nil {
+		logCostBasisFailure(ctx, cmd.ShipSymbol, cmd.GoodSymbol, units, err)
+	}
+}
+
 // refreshMarketData triggers the deliberate post-trade market scan (the "after" half
 // of the scan→buy→scan impact pair the sp-tl68 model is fitted from). It is non-blocking
 // — errors are logged but never fail the transaction.
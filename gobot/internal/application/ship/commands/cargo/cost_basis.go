@@ -0,0 +1,19 @@
+package cargo
+
+import (
+	"context"
+
+	"github.com/andrescamacho/spacetraders-go/internal/application/common"
+)
+
+// logCostBasisFailure reports a cost basis update that did not land. The cargo
+// movement itself already committed; the basis is only an input to later sell
+// floors, so the handlers carry on.
+func logCostBasisFailure(ctx context.Context, shipSymbol, goodSymbol string, units int, err error) {
+	common.LoggerFromContext(ctx).Log("WARNING", "Failed to update cargo cost basis", map[string]interface{}{
+		"ship_symbol": shipSymbol,
+		"good":        goodSymbol,
+		"units":       units,
+		"error":       err.Error(),
+	})
+}
@@ -0,0 +1,123 @@
+package cargo
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/andrescamacho/spacetraders-go/internal/adapters/metrics"
+	"github.com/andrescamacho/spacetraders-go/internal/application/common"
+	"github.com/andrescamacho/spacetraders-go/internal/domain/ledger"
+	"github.com/andrescamacho/spacetraders-go/internal/domain/navigation"
+	domainPorts "github.com/andrescamacho/spacetraders-go/internal/domain/ports"
+	"github.com/andrescamacho/spacetraders-go/internal/domain/shared"
+)
+
+// ExtractResourcesCommand - Command to extract ore from the asteroid a ship is at
+type ExtractResourcesCommand struct {
+	ShipSymbol string
+	PlayerID   shared.PlayerID
+}
+
+// ExtractResourcesResponse - Response from extract resources command
+type ExtractResourcesResponse struct {
+	YieldSymbol      string
+	YieldUnits       int
+	CooldownDuration time.Duration
+	Cargo            *navigation.CargoData
+}
+
+// ExtractResourcesHandler - Handles extract resources commands
+type ExtractResourcesHandler struct {
+	shipRepo  navigation.ShipRepository
+	apiClient domainPorts.APIClient
+	costBasis ledger.CargoCostBasisRecorder
+}
+
+// NewExtractResourcesHandler creates a new extract resources handler
+func NewExtractResourcesHandler(
+	shipRepo navigation.ShipRepository,
+	apiClient domainPorts.APIClient,
+) *ExtractResourcesHandler {
+	return &ExtractResourcesHandler{
+		shipRepo:  shipRepo,
+		apiClient: apiClient,
+	}
+}
+
+// SetCostBasisRecorder adds each yield to the ship's cargo cost basis at zero
+// cost. nil disables the tracking.
+func (h *ExtractResourcesHandler) SetCostBasisRecorder(recorder ledger.CargoCostBasisRecorder) {
+	h.costBasis = recorder
+}
+
+// Handle executes the extract resources command. The ship must already be at
+// the asteroid; it is put into orbit if docked.
+func (h *ExtractResourcesHandler) Handle(ctx context.Context, request common.Request) (common.Response, error) {
+	cmd, ok := request.(*ExtractResourcesCommand)
+	if !ok {
+		return nil, fmt.Errorf("invalid request type")
+	}
+
+	token, err := common.PlayerTokenFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	ship, err := h.shipRepo.FindBySymbol(ctx, cmd.ShipSymbol, cmd.PlayerID)
+	if err != nil {
+		return nil, fmt.Errorf("ship not found: %w", err)
+	}
+	if ship.IsInTransit() {
+		return nil, fmt.Errorf("ship %s is in transit and cannot extract", cmd.ShipSymbol)
+	}
+
+	stateChanged, err := ship.EnsureInOrbit()
+	if err != nil {
+		return nil, err
+	}
+	if stateChanged {
+		if err := h.shipRepo.Orbit(ctx, ship, cmd.PlayerID); err != nil {
+			return nil, fmt.Errorf("failed to orbit ship: %w", err)
+		}
+	}
+
+	result, err := h.apiClient.ExtractResources(ctx, cmd.ShipSymbol, token)
+	if err != nil {
+		return nil, fmt.Errorf("failed to extract resources: %w", err)
+	}
+	metrics.RecordExtractionYield(cmd.PlayerID.Value(), result.YieldSymbol, result.YieldUnits)
+
+	if result.Cargo != nil {
+		inventory := make([]*shared.CargoItem, len(result.Cargo.Inventory))
+		for i := range result.Cargo.Inventory {
+			inventory[i] = &result.Cargo.Inventory[i]
+		}
+		newCargo, err := shared.NewCargo(result.Cargo.Capacity, result.Cargo.Units, inventory)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create cargo from API response: %w", err)
+		}
+		// Cargo only, on the fresh row under CAS-retry, as the siphon handler
+		// does: a concurrent nav/fuel write on the same hull survives.
+		if _, _, err := h.shipRepo.SaveWithRetry(ctx, cmd.ShipSymbol, cmd.PlayerID,
+			func(sh *navigation.Ship) (bool, error) {
+				sh.SetCargo(newCargo)
+				return true, nil
+			}); err != nil {
+			return nil, fmt.Errorf("failed to persist cargo after extraction: %w", err)
+		}
+	}
+
+	if h.costBasis != nil {
+		if err := h.costBasis.RecordAcquisition(ctx, cmd.PlayerID.Value(), cmd.ShipSymbol, result.YieldSymbol, result.YieldUnits, 0); err != nil {
+			logCostBasisFailure(ctx, cmd.ShipSymbol, result.YieldSymbol, result.YieldUnits, err)
+		}
+	}
+
+	return &ExtractResourcesResponse{
+		YieldSymbol:      result.YieldSymbol,
+		YieldUnits:       result.YieldUnits,
+		CooldownDuration: time.Duration(result.CooldownSeconds) * time.Second,
+		Cargo:            result.Cargo,
+	}, nil
+}
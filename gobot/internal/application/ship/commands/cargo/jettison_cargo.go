@@ -5,6 +5,7 @@ import (
 	"fmt"
 
 	"github.com/andrescamacho/spacetraders-go/internal/application/common"
+	"github.com/andrescamacho/spacetraders-go/internal/domain/ledger"
 	"github.com/andrescamacho/spacetraders-go/internal/domain/navigation"
 	"github.com/andrescamacho/spacetraders-go/internal/domain/player"
 	domainPorts "github.com/andrescamacho/spacetraders-go/internal/domain/ports"
@@ -29,6 +30,7 @@ type JettisonCargoHandler struct {
 	shipRepo   navigation.ShipRepository
 	playerRepo player.PlayerRepository
 	apiClient  domainPorts.APIClient
+	costBasis  ledger.CargoCostBasisRecorder
 }
 
 // NewJettisonCargoHandler creates a new jettison cargo handler
@@ -44,6 +46,12 @@ func NewJettisonCargoHandler(
 	}
 }
 
+// SetCostBasisRecorder releases jettisoned units from the ship's cargo cost
+// basis. nil disables the tracking.
+func (h *JettisonCargoHandler) SetCostBasisRecorder(recorder ledger.CargoCostBasisRecorder) {
+	h.costBasis = recorder
+}
+
 // Handle executes the jettison cargo command
 func (h *JettisonCargoHandler) Handle(ctx context.Context, request common.Request) (common.Response, error) {
 	cmd, ok := request.(*JettisonCargoCommand)
@@ -85,6 +93,12 @@ func (h *JettisonCargoHandler) Handle(ctx context.Context, request common.Reques
 			return true, nil
 		})
 
+	if h.costBasis != nil {
+		if err := h.costBasis.RecordRemoval(ctx, cmd.PlayerID.Value(), cmd.ShipSymbol, cmd.GoodSymbol, cmd.Units); err != nil {
+			logCostBasisFailure(ctx, cmd.ShipSymbol, cmd.GoodSymbol, cmd.Units, err)
+		}
+	}
+
 	return &JettisonCargoResponse{
 		UnitsJettisoned: cmd.Units,
 	}, nil
@@ -0,0 +1,322 @@
+package cargo
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sort"
+
+	"github.com/andrescamacho/spacetraders-go/internal/application/common"
+	scoutingQuery "github.com/andrescamacho/spacetraders-go/internal/application/scouting/queries"
+	navCmd "github.com/andrescamacho/spacetraders-go/internal/application/ship/commands/navigation"
+	shipTypes "github.com/andrescamacho/spacetraders-go/internal/application/ship/types"
+	"github.com/andrescamacho/spacetraders-go/internal/domain/navigation"
+	"github.com/andrescamacho/spacetraders-go/internal/domain/shared"
+)
+
+const (
+	// DefaultLadderBidFraction is the relative limit a ladder sells under when the
+	// command sets neither limit: a market is abandoned once its live bid falls
+	// below 80% of the bid it quoted when the ladder reached it.
+	DefaultLadderBidFraction = 0.8
+
+	// DefaultLadderMaxMarkets bounds how many markets, the starting one included,
+	// a ladder sells at. Every market past the first costs a flight.
+	DefaultLadderMaxMarkets = 3
+
+	// ladderFallbackChunk is the chunk size used when a market's trade volume for
+	// the good is unknown, so missing data never turns into a single full dump.
+	ladderFallbackChunk = 10
+
+	// ladderMarketMaxAgeMinutes bounds how stale an alternate market's quote may
+	// be for the ladder to route the remainder there.
+	ladderMarketMaxAgeMinutes = 60
+)
+
+// LadderSellCargoCommand sells a good down a price ladder. At each market the
+// quantity goes out in trade-volume-sized chunks; the live bid is re-checked
+// before every chunk, and the market is abandoned once it falls below the limit.
+// The remainder is routed to the best-bidding market in the system that still
+// clears the limit, up to MaxMarkets markets in total.
+//
+// The limit at a market is the higher of MinBidPerUnit and MinBidFraction of the
+// bid that market quoted on arrival. With both zero, DefaultLadderBidFraction
+// applies. The ship starts wherever it is; it is docked before the first sale.
+type LadderSellCargoCommand struct {
+	ShipSymbol string
+	GoodSymbol string
+	Units      int
+	PlayerID   shared.PlayerID
+
+	// MinBidPerUnit is an absolute limit: no chunk sells, and no market is
+	// routed to, below this bid. 0 disables it.
+	MinBidPerUnit int
+
+	// MinBidFraction is a relative limit in (0, 1]: a market stops being sold
+	// into once its live bid falls below this fraction of its arrival bid.
+	MinBidFraction float64
+
+	// MaxMarkets bounds how many markets the ladder sells at. 0 uses
+	// DefaultLadderMaxMarkets; 1 keeps the sale at the current market.
+	MaxMarkets int
+}
+
+// LadderSellLot is what the ladder sold at one market. OpeningBid is the bid
+// the market quoted on arrival and FloorPerUnit the limit that governed it.
+type LadderSellLot struct {
+	Market           string
+	Units            int
+	Revenue          int
+	TransactionCount int
+	OpeningBid       int
+	FloorPerUnit     int
+}
+
+// LadderSellCargoResponse reports the lots sold and what is still held aboard at
+// Location, the market the ship ended at.
+type LadderSellCargoResponse struct {
+	Lots         []LadderSellLot
+	UnitsSold    int
+	TotalRevenue int
+	UnitsHeld    int
+	Location     string
+}
+
+// LadderSellCargoHandler executes ladder sells through the mediator's SellCargo,
+// NavigateRoute and DockShip commands.
+type LadderSellCargoHandler struct {
+	shipRepo        navigation.ShipRepository
+	marketRepo      scoutingQuery.MarketRepository
+	mediator        common.Mediator
+	marketRefresher MarketRefresher
+}
+
+// NewLadderSellCargoHandler creates a ladder sell handler. The marketRefresher is
+// optional; without it, arrival bids and trade volumes come from cached market data.
+func NewLadderSellCargoHandler(
+	shipRepo navigation.ShipRepository,
+	marketRepo scoutingQuery.MarketRepository,
+	mediator common.Mediator,
+	marketRefresher MarketRefresher,
+) *LadderSellCargoHandler {
+	return &LadderSellCargoHandler{
+		shipRepo:        shipRepo,
+		marketRepo:      marketRepo,
+		mediator:        mediator,
+		marketRefresher: marketRefresher,
+	}
+}
+
+// Handle runs the ladder. A failure before anything sells at the starting market
+// is returned; once the ladder is underway, a failed flight, dock or sale stops it
+// with the remainder held and reported in UnitsHeld.
+func (h *LadderSellCargoHandler) Handle(ctx context.Context, request common.Request) (common.Response, error) {
+	cmd, ok := request.(*LadderSellCargoCommand)
+	if !ok {
+		return nil, fmt.Errorf("invalid request type")
+	}
+	if cmd.Units <= 0 {
+		return nil, fmt.Errorf("units must be positive")
+	}
+	if cmd.MinBidPerUnit < 0 || cmd.MinBidFraction < 0 || cmd.MinBidFraction > 1 {
+		return nil, fmt.Errorf("invalid ladder limit: min bid %d, fraction %.2f", cmd.MinBidPerUnit, cmd.MinBidFraction)
+	}
+	fraction := cmd.MinBidFraction
+	if fraction == 0 && cmd.MinBidPerUnit == 0 {
+		fraction = DefaultLadderBidFraction
+	}
+	maxMarkets := cmd.MaxMarkets
+	if maxMarkets <= 0 {
+		maxMarkets = DefaultLadderMaxMarkets
+	}
+
+	ship, err := h.shipRepo.FindBySymbol(ctx, cmd.ShipSymbol, cmd.PlayerID)
+	if err != nil {
+		return nil, fmt.Errorf("ship not found: %w", err)
+	}
+	location := ship.CurrentLocation().Symbol
+	if _, err := h.mediator.Send(ctx, &shipTypes.DockShipCommand{ShipSymbol: cmd.ShipSymbol, PlayerID: cmd.PlayerID}); err != nil {
+		return nil, fmt.Errorf("dock at %s: %w", location, err)
+	}
+
+	response := &LadderSellCargoResponse{UnitsHeld: cmd.Units, Location: location}
+	logger := common.LoggerFromContext(ctx)
+	visited := map[string]bool{location: true}
+
+	// The starting market's own limit governs where the remainder may go: another
+	// market is only worth the flight if it bids at least what was refused here.
+	lot, err := h.sellAtMarket(ctx, cmd, location, response.UnitsHeld, fraction)
+	if err != nil {
+		return nil, err
+	}
+	h.recordLot(response, lot)
+	routeFloor := max(cmd.MinBidPerUnit, lot.FloorPerUnit)
+
+	for response.UnitsHeld > 0 && len(visited) < maxMarkets {
+		next, bid, ok := h.bestAlternateMarket(ctx, cmd, visited, routeFloor)
+		if !ok {
+			logger.Log("INFO", fmt.Sprintf("Ladder sell: no market in the system bids at least %d for %s; holding %d aboard at %s",
+				routeFloor, cmd.GoodSymbol, response.UnitsHeld, response.Location), map[string]interface{}{
+				"action": "ladder_sell_hold", "ship_symbol": cmd.ShipSymbol, "good": cmd.GoodSymbol,
+				"units_held": response.UnitsHeld, "location": response.Location, "floor": routeFloor,
+			})
+			break
+		}
+		visited[next] = true
+		logger.Log("INFO", fmt.Sprintf("Ladder sell: routing %d %s to %s (quoted bid %d)", response.UnitsHeld, cmd.GoodSymbol, next, bid),
+			map[string]interface{}{
+				"action": "ladder_sell_route", "ship_symbol": cmd.ShipSymbol, "good": cmd.GoodSymbol,
+				"market": next, "units": response.UnitsHeld, "quoted_bid": bid,
+			})
+
+		if _, err := h.mediator.Send(ctx, &navCmd.NavigateRouteCommand{
+			ShipSymbol:  cmd.ShipSymbol,
+			Destination: next,
+			PlayerID:    cmd.PlayerID,
+		}); err != nil {
+			h.warnStopped(ctx, cmd, response, fmt.Sprintf("travel to %s failed", next), err)
+			break
+		}
+		response.Location = next
+		if _, err := h.mediator.Send(ctx, &shipTypes.DockShipCommand{ShipSymbol: cmd.ShipSymbol, PlayerID: cmd.PlayerID}); err != nil {
+			h.warnStopped(ctx, cmd, response, fmt.Sprintf("dock at %s failed", next), err)
+			break
+		}
+
+		lot, err = h.sellAtMarket(ctx, cmd, next, response.UnitsHeld, fraction)
+		if err != nil {
+			h.warnStopped(ctx, cmd, response, fmt.Sprintf("sell at %s failed", next), err)
+			break
+		}
+		h.recordLot(response, lot)
+		routeFloor = max(routeFloor, lot.FloorPerUnit)
+	}
+
+	return response, nil
+}
+
+// sellAtMarket sells up to units at the docked market in chunks of the good's
+// current trade volume, each carrying the market's floor so SellCargo re-checks
+// the live bid before it goes out. It stops at the first chunk the floor refuses.
+func (h *LadderSellCargoHandler) sellAtMarket(ctx context.Context, cmd *LadderSellCargoCommand, waypoint string, units int, fraction float64) (LadderSellLot, error) {
+	lot := LadderSellLot{Market: waypoint}
+	openingBid, _ := h.quote(ctx, cmd, waypoint, true)
+	if openingBid <= 0 {
+		return lot, nil
+	}
+	lot.OpeningBid = openingBid
+	lot.FloorPerUnit = max(cmd.MinBidPerUnit, int(math.Ceil(fraction*float64(openingBid))))
+	if openingBid < lot.FloorPerUnit {
+		return lot, nil
+	}
+
+	for lot.Units < units {
+		_, volume := h.quote(ctx, cmd, waypoint, false)
+		if volume <= 0 {
+			volume = ladderFallbackChunk
+		}
+		chunk := units - lot.Units
+		if chunk > volume {
+			chunk = volume
+		}
+
+		resp, err := h.mediator.Send(ctx, &SellCargoCommand{
+			ShipSymbol:    cmd.ShipSymbol,
+			GoodSymbol:    cmd.GoodSymbol,
+			Units:         chunk,
+			PlayerID:      cmd.PlayerID,
+			MinBidPerUnit: lot.FloorPerUnit,
+		})
+		if err != nil {
+			if lot.Units > 0 {
+				return lot, nil
+			}
+			return lot, fmt.Errorf("sell %d %s at %s: %w", chunk, cmd.GoodSymbol, waypoint, err)
+		}
+		sr, ok := resp.(*SellCargoResponse)
+		if !ok {
+			return lot, fmt.Errorf("unexpected sell response type %T", resp)
+		}
+		lot.Units += sr.UnitsSold
+		lot.Revenue += sr.TotalRevenue
+		lot.TransactionCount += sr.TransactionCount
+		if sr.FloorAborted || sr.Reserved || sr.UnitsSold < chunk {
+			break
+		}
+	}
+	return lot, nil
+}
+
+// quote reads the bid and trade volume for the good at waypoint, live-refreshing
+// first when asked and a refresher is wired. Zeroes mean the market does not
+// quote the good.
+func (h *LadderSellCargoHandler) quote(ctx context.Context, cmd *LadderSellCargoCommand, waypoint string, refresh bool) (bid, volume int) {
+	if refresh && h.marketRefresher != nil {
+		_ = h.marketRefresher.ScanAndSaveMarket(ctx, uint(cmd.PlayerID.Value()), waypoint)
+	}
+	mkt, err := h.marketRepo.GetMarketData(ctx, waypoint, cmd.PlayerID.Value())
+	if err != nil || mkt == nil {
+		return 0, 0
+	}
+	good := mkt.FindGood(cmd.GoodSymbol)
+	if good == nil {
+		return 0, 0
+	}
+	return good.PurchasePrice(), good.TradeVolume()
+}
+
+// bestAlternateMarket picks the unvisited market in the ship's system with the
+// highest fresh bid for the good, provided it bids at least floor.
+func (h *LadderSellCargoHandler) bestAlternateMarket(ctx context.Context, cmd *LadderSellCargoCommand, visited map[string]bool, floor int) (string, int, bool) {
+	ship, err := h.shipRepo.FindBySymbol(ctx, cmd.ShipSymbol, cmd.PlayerID)
+	if err != nil {
+		return "", 0, false
+	}
+	markets, err := h.marketRepo.ListMarketsInSystem(ctx, uint(cmd.PlayerID.Value()),
+		shared.ExtractSystemSymbol(ship.CurrentLocation().Symbol), ladderMarketMaxAgeMinutes)
+	if err != nil {
+		return "", 0, false
+	}
+
+	type candidate struct {
+		waypoint string
+		bid      int
+	}
+	var candidates []candidate
+	for i := range markets {
+		waypoint := markets[i].WaypointSymbol()
+		good := markets[i].FindGood(cmd.GoodSymbol)
+		if visited[waypoint] || good == nil || good.PurchasePrice() <= 0 || good.PurchasePrice() < floor {
+			continue
+		}
+		candidates = append(candidates, candidate{waypoint: waypoint, bid: good.PurchasePrice()})
+	}
+	if len(candidates) == 0 {
+		return "", 0, false
+	}
+	sort.SliceStable(candidates, func(i, j int) bool {
+		if candidates[i].bid != candidates[j].bid {
+			return candidates[i].bid > candidates[j].bid
+		}
+		return candidates[i].waypoint < candidates[j].waypoint
+	})
+	return candidates[0].waypoint, candidates[0].bid, true
+}
+
+func (h *LadderSellCargoHandler) recordLot(response *LadderSellCargoResponse, lot LadderSellLot) {
+	if lot.Units <= 0 {
+		return
+	}
+	response.Lots = append(response.Lots, lot)
+	response.UnitsSold += lot.Units
+	response.TotalRevenue += lot.Revenue
+	response.UnitsHeld -= lot.Units
+}
+
+func (h *LadderSellCargoHandler) warnStopped(ctx context.Context, cmd *LadderSellCargoCommand, response *LadderSellCargoResponse, msg string, err error) {
+	common.LoggerFromContext(ctx).Log("WARNING", fmt.Sprintf("Ladder sell stopped: %s: %v (%d %s held aboard at %s)",
+		msg, err, response.UnitsHeld, cmd.GoodSymbol, response.Location), map[string]interface{}{
+		"action": "ladder_sell_stopped", "ship_symbol": cmd.ShipSymbol, "good": cmd.GoodSymbol,
+		"units_held": response.UnitsHeld, "location": response.Location, "error": err.Error(),
+	})
+}
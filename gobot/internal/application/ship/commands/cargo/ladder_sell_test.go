@@ -0,0 +1,174 @@
+package cargo
+
+import (
+	"context"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/andrescamacho/spacetraders-go/internal/application/common"
+	scoutingQuery "github.com/andrescamacho/spacetraders-go/internal/application/scouting/queries"
+	navCmd "github.com/andrescamacho/spacetraders-go/internal/application/ship/commands/navigation"
+	shipTypes "github.com/andrescamacho/spacetraders-go/internal/application/ship/types"
+	"github.com/andrescamacho/spacetraders-go/internal/domain/market"
+	"github.com/andrescamacho/spacetraders-go/internal/domain/navigation"
+	"github.com/andrescamacho/spacetraders-go/internal/domain/shared"
+)
+
+const ladderGood = "CLOTHING"
+
+// ladderMarket is a simulated sink: each unit sold knocks drop off its bid.
+type ladderMarket struct {
+	bid    int
+	volume int
+	drop   int
+}
+
+// ladderWorld simulates a system's markets and one hull moving between them,
+// serving the ship repo, market repo and mediator the ladder talks to.
+type ladderWorld struct {
+	t        *testing.T
+	location string
+	markets  map[string]*ladderMarket
+	chunks   []int
+	flights  []string
+}
+
+func (w *ladderWorld) ship() *navigation.Ship {
+	w.t.Helper()
+	ship := newDockedShipWithCargo(w.t, 1, ladderGood, 40)
+	waypoint, err := shared.NewWaypoint(w.location, 0, 0)
+	require.NoError(w.t, err)
+	ship.SetLocation(waypoint)
+	return ship
+}
+
+type ladderShipRepo struct {
+	navigation.ShipRepository
+	world *ladderWorld
+}
+
+func (r *ladderShipRepo) FindBySymbol(_ context.Context, _ string, _ shared.PlayerID) (*navigation.Ship, error) {
+	return r.world.ship(), nil
+}
+
+type ladderMarketRepo struct {
+	scoutingQuery.MarketRepository
+	world *ladderWorld
+}
+
+func (r *ladderMarketRepo) snapshot(waypoint string) *market.Market {
+	m := r.world.markets[waypoint]
+	supply, activity := "MODERATE", "STRONG"
+	g, err := market.NewTradeGood(ladderGood, &supply, &activity, m.bid, m.bid+50, m.volume, market.TradeTypeImport)
+	require.NoError(r.world.t, err)
+	mkt, err := market.NewMarket(waypoint, []market.TradeGood{*g}, time.Now())
+	require.NoError(r.world.t, err)
+	return mkt
+}
+
+func (r *ladderMarketRepo) GetMarketData(_ context.Context, waypoint string, _ int) (*market.Market, error) {
+	if _, ok := r.world.markets[waypoint]; !ok {
+		return nil, nil
+	}
+	return r.snapshot(waypoint), nil
+}
+
+func (r *ladderMarketRepo) ListMarketsInSystem(_ context.Context, _ uint, _ string, _ int) ([]market.Market, error) {
+	var markets []market.Market
+	for waypoint := range r.world.markets {
+		markets = append(markets, *r.snapshot(waypoint))
+	}
+	return markets, nil
+}
+
+type ladderMediator struct{ world *ladderWorld }
+
+func (m *ladderMediator) Send(_ context.Context, request common.Request) (common.Response, error) {
+	w := m.world
+	switch cmd := request.(type) {
+	case *shipTypes.DockShipCommand:
+		return nil, nil
+	case *navCmd.NavigateRouteCommand:
+		w.flights = append(w.flights, cmd.Destination)
+		w.location = cmd.Destination
+		return &navCmd.NavigateRouteResponse{Status: "completed"}, nil
+	case *SellCargoCommand:
+		mkt := w.markets[w.location]
+		if mkt.bid < cmd.MinBidPerUnit {
+			return &SellCargoResponse{FloorAborted: true, FloorObservedBid: mkt.bid, FloorPerUnit: cmd.MinBidPerUnit}, nil
+		}
+		w.chunks = append(w.chunks, cmd.Units)
+		revenue := cmd.Units * mkt.bid
+		mkt.bid -= cmd.Units * mkt.drop
+		return &SellCargoResponse{UnitsSold: cmd.Units, TotalRevenue: revenue, TransactionCount: 1}, nil
+	}
+	w.t.Fatalf("unexpected request %T", request)
+	return nil, nil
+}
+func (m *ladderMediator) Register(_ reflect.Type, _ common.RequestHandler) error { return nil }
+func (m *ladderMediator) RegisterMiddleware(_ common.Middleware)                 {}
+
+func newLadderHandler(world *ladderWorld) *LadderSellCargoHandler {
+	return NewLadderSellCargoHandler(&ladderShipRepo{world: world}, &ladderMarketRepo{world: world}, &ladderMediator{world: world}, nil)
+}
+
+func runLadder(t *testing.T, h *LadderSellCargoHandler, cmd *LadderSellCargoCommand) *LadderSellCargoResponse {
+	t.Helper()
+	cmd.ShipSymbol, cmd.GoodSymbol, cmd.PlayerID = "OPTYPE-1", ladderGood, shared.MustNewPlayerID(1)
+	resp, err := h.Handle(context.Background(), cmd)
+	require.NoError(t, err)
+	return resp.(*LadderSellCargoResponse)
+}
+
+func TestLadderSell_SellsInTradeVolumeChunksAndRoutesTheRemainderOnceTheBidFalls(t *testing.T) {
+	world := &ladderWorld{t: t, location: "X1-TEST-A1", markets: map[string]*ladderMarket{
+		"X1-TEST-A1": {bid: 1000, volume: 10, drop: 15},
+		"X1-TEST-B2": {bid: 900, volume: 20, drop: 1},
+		"X1-TEST-C3": {bid: 500, volume: 20, drop: 1},
+	}}
+
+	out := runLadder(t, newLadderHandler(world), &LadderSellCargoCommand{Units: 40, MinBidFraction: 0.8})
+
+	// A1 quotes 1000 (floor 800): chunk one drops it to 850, chunk two to 700,
+	// so the third chunk is refused and the 20 left go to B2, which bids 900.
+	// C3 bids below the 800 A1 refused and is never flown to.
+	require.Equal(t, []int{10, 10, 20}, world.chunks)
+	require.Equal(t, []string{"X1-TEST-B2"}, world.flights)
+	require.Len(t, out.Lots, 2)
+	require.Equal(t, LadderSellLot{Market: "X1-TEST-A1", Units: 20, Revenue: 18500, TransactionCount: 2, OpeningBid: 1000, FloorPerUnit: 800}, out.Lots[0])
+	require.Equal(t, 20, out.Lots[1].Units)
+	require.Equal(t, 40, out.UnitsSold)
+	require.Equal(t, 0, out.UnitsHeld)
+	require.Equal(t, "X1-TEST-B2", out.Location)
+}
+
+func TestLadderSell_HoldsTheRemainderWhenNoMarketClearsTheLimit(t *testing.T) {
+	world := &ladderWorld{t: t, location: "X1-TEST-A1", markets: map[string]*ladderMarket{
+		"X1-TEST-A1": {bid: 1000, volume: 10, drop: 30},
+		"X1-TEST-B2": {bid: 600, volume: 20, drop: 1},
+	}}
+
+	out := runLadder(t, newLadderHandler(world), &LadderSellCargoCommand{Units: 40, MinBidPerUnit: 750})
+
+	require.Equal(t, []int{10}, world.chunks, "the bid falls to 700 after one chunk, under the 750 limit")
+	require.Empty(t, world.flights, "B2's 600 bid does not clear the limit")
+	require.Equal(t, 10, out.UnitsSold)
+	require.Equal(t, 30, out.UnitsHeld)
+	require.Equal(t, "X1-TEST-A1", out.Location)
+}
+
+func TestLadderSell_MaxMarketsOfOneKeepsTheSaleLocal(t *testing.T) {
+	world := &ladderWorld{t: t, location: "X1-TEST-A1", markets: map[string]*ladderMarket{
+		"X1-TEST-A1": {bid: 1000, volume: 0, drop: 25},
+		"X1-TEST-B2": {bid: 990, volume: 20, drop: 1},
+	}}
+
+	out := runLadder(t, newLadderHandler(world), &LadderSellCargoCommand{Units: 40, MaxMarkets: 1})
+
+	require.Equal(t, []int{ladderFallbackChunk}, world.chunks, "an unknown trade volume sells in fallback chunks, not one dump")
+	require.Empty(t, world.flights)
+	require.Equal(t, 30, out.UnitsHeld)
+}
@@ -7,10 +7,12 @@ import (
 	"github.com/andrescamacho/spacetraders-go/internal/application/common"
 	scoutingQuery "github.com/andrescamacho/spacetraders-go/internal/application/scouting/queries"
 	"github.com/andrescamacho/spacetraders-go/internal/application/ship/strategies"
+	"github.com/andrescamacho/spacetraders-go/internal/domain/ledger"
 	"github.com/andrescamacho/spacetraders-go/internal/domain/navigation"
 	"github.com/andrescamacho/spacetraders-go/internal/domain/player"
 	domainPorts "github.com/andrescamacho/spacetraders-go/internal/domain/ports"
 	"github.com/andrescamacho/spacetraders-go/internal/domain/shared"
+	"github.com/andrescamacho/spacetraders-go/internal/domain/trading"
 )
 
 // PurchaseCargoCommand requests cargo purchase for a ship at its current docked location.
@@ -85,6 +87,21 @@ func NewPurchaseCargoHandler(
 	}
 }
 
+// SetMarketFeeObserver reports each purchase's quoted vs charged total to observer.
+func (h *PurchaseCargoHandler) SetMarketFeeObserver(observer trading.MarketFeeObserver) {
+	h.delegate.SetMarketFeeObserver(observer)
+}
+
+// SetCostBasisRecorder books each purchase against the ship's cargo cost basis.
+func (h *PurchaseCargoHandler) SetCostBasisRecorder(recorder ledger.CargoCostBasisRecorder) {
+	h.delegate.SetCostBasisRecorder(recorder)
+}
+
+// SetCreditReservations validates each purchase against unreserved credits.
+func (h *PurchaseCargoHandler) SetCreditReservations(reservations ledger.CreditReservations) {
+	h.delegate.SetCreditReservations(reservations)
+}
+
 // Handle executes the purchase cargo command by delegating to the unified handler.
 //
 // This method maintains backward compatibility by:
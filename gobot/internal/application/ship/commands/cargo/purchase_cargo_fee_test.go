@@ -0,0 +1,40 @@
+package cargo
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/andrescamacho/spacetraders-go/internal/domain/trading"
+)
+
+type recordingFeeObserver struct {
+	observations []trading.MarketFeeObservation
+}
+
+func (o *recordingFeeObserver) ObserveMarketFee(_ context.Context, observation trading.MarketFeeObservation) {
+	o.observations = append(o.observations, observation)
+}
+
+// Only the first tranche is reported to the fee model: later tranches are priced
+// against an ask our own buying has already moved, which is not a fee.
+func TestPurchaseCargo_ReportsFirstTrancheToFeeObserver(t *testing.T) {
+	fix := &ceilingMarketFixture{healthyAsk: 4000, laddedAsk: 4400, limit: 15}
+	h, api := newCeilingBuyHandler(t, fix, nil)
+	observer := &recordingFeeObserver{}
+	h.SetMarketFeeObserver(observer)
+
+	pr := runCeilingBuy(t, h, 0)
+
+	require.Equal(t, 40, pr.UnitsAdded)
+	require.Len(t, api.buys, 3)
+	require.Len(t, observer.observations, 1)
+	obs := observer.observations[0]
+	require.Equal(t, testBuyWaypoint, obs.Waypoint)
+	require.Equal(t, optypeGood, obs.Good)
+	require.Equal(t, trading.FeeTransactionPurchase, obs.TransactionType)
+	require.Equal(t, 15, obs.Units)
+	require.Equal(t, 15*4000, obs.Expected)
+	require.Equal(t, 15*4000, obs.Actual)
+}
@@ -0,0 +1,158 @@
+package cargo
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/andrescamacho/spacetraders-go/internal/application/common"
+	"github.com/andrescamacho/spacetraders-go/internal/application/logging"
+	scoutingQuery "github.com/andrescamacho/spacetraders-go/internal/application/scouting/queries"
+	"github.com/andrescamacho/spacetraders-go/internal/domain/navigation"
+	"github.com/andrescamacho/spacetraders-go/internal/domain/shared"
+	"github.com/andrescamacho/spacetraders-go/internal/domain/trading"
+)
+
+// ManifestGood is one good a manifest purchase should load.
+type ManifestGood struct {
+	GoodSymbol string
+	Units      int
+	// UnitValue is the expected resale price per unit. Goods with one are loaded
+	// first, highest margin first; 0 leaves the good in the order given.
+	UnitValue int
+}
+
+// PurchaseCargoManifestCommand buys several goods at the ship's docked market in
+// one planned pass. The manifest is planned from the market's cached asks and
+// trade volumes before anything is bought, split into calls no larger than each
+// good's trade volume and interleaved across goods, then executed call by call
+// through PurchaseCargoCommand.
+type PurchaseCargoManifestCommand struct {
+	ShipSymbol   string
+	PlayerID     shared.PlayerID
+	Goods        []ManifestGood
+	MaxTotalCost int  // cap on the expected total cost; 0 = unlimited
+	DryRun       bool // plan and return the expected cost without buying
+}
+
+// PurchaseCargoManifestResponse carries the plan and, unless the command was a
+// dry run, what executing it actually cost.
+type PurchaseCargoManifestResponse struct {
+	Manifest *trading.CargoManifest
+
+	TotalCost        int
+	UnitsAdded       int
+	TransactionCount int
+}
+
+// PurchaseCargoManifestHandler plans and executes multi-good purchases.
+type PurchaseCargoManifestHandler struct {
+	shipRepo   navigation.ShipRepository
+	marketRepo scoutingQuery.MarketRepository
+	mediator   common.Mediator
+	planner    *trading.CargoManifestPlanner
+}
+
+// NewPurchaseCargoManifestHandler creates a manifest purchase handler. A nil
+// planner uses the default buy-impact coefficient.
+func NewPurchaseCargoManifestHandler(
+	shipRepo navigation.ShipRepository,
+	marketRepo scoutingQuery.MarketRepository,
+	mediator common.Mediator,
+	planner *trading.CargoManifestPlanner,
+) *PurchaseCargoManifestHandler {
+	if planner == nil {
+		planner = trading.NewCargoManifestPlanner(0)
+	}
+	return &PurchaseCargoManifestHandler{
+		shipRepo:   shipRepo,
+		marketRepo: marketRepo,
+		mediator:   mediator,
+		planner:    planner,
+	}
+}
+
+// Handle plans the manifest and, unless DryRun, buys it.
+func (h *PurchaseCargoManifestHandler) Handle(ctx context.Context, request common.Request) (common.Response, error) {
+	cmd, ok := request.(*PurchaseCargoManifestCommand)
+	if !ok {
+		return nil, fmt.Errorf("invalid request type")
+	}
+
+	ship, err := h.shipRepo.FindBySymbol(ctx, cmd.ShipSymbol, cmd.PlayerID)
+	if err != nil {
+		return nil, fmt.Errorf("ship not found: %w", err)
+	}
+	if !ship.IsDocked() {
+		return nil, fmt.Errorf("ship must be docked to perform cargo transactions")
+	}
+
+	waypointSymbol := ship.CurrentLocation().Symbol
+	mkt, err := h.marketRepo.GetMarketData(ctx, waypointSymbol, cmd.PlayerID.Value())
+	if err != nil {
+		return nil, fmt.Errorf("failed to load market %s: %w", waypointSymbol, err)
+	}
+	if mkt == nil {
+		return nil, shared.Errorf(shared.CodeMarketNotFound, "no market data for %s", waypointSymbol)
+	}
+
+	lines := make([]trading.ManifestLine, 0, len(cmd.Goods))
+	for _, good := range cmd.Goods {
+		tradeGood := mkt.FindGood(good.GoodSymbol)
+		if tradeGood == nil {
+			return nil, fmt.Errorf("good %s is not traded at %s", good.GoodSymbol, waypointSymbol)
+		}
+		lines = append(lines, trading.ManifestLine{
+			GoodSymbol:  good.GoodSymbol,
+			Units:       good.Units,
+			Ask:         tradeGood.PurchasePrice(),
+			TradeVolume: tradeGood.TradeVolume(),
+			UnitValue:   good.UnitValue,
+		})
+	}
+
+	manifest, err := h.planner.Plan(lines, ship.AvailableCargoSpace(), cmd.MaxTotalCost)
+	if err != nil {
+		return nil, fmt.Errorf("failed to plan cargo manifest: %w", err)
+	}
+
+	logger := logging.LoggerFromContext(ctx)
+	logger.Log("INFO", fmt.Sprintf(
+		"Cargo manifest for %s at %s: %d units in %d calls, expected cost %d",
+		cmd.ShipSymbol, waypointSymbol, manifest.TotalUnits, len(manifest.Purchases), manifest.ExpectedTotalCost,
+	), map[string]interface{}{
+		"action":        "cargo_manifest_planned",
+		"ship_symbol":   cmd.ShipSymbol,
+		"waypoint":      waypointSymbol,
+		"units":         manifest.TotalUnits,
+		"calls":         len(manifest.Purchases),
+		"expected_cost": manifest.ExpectedTotalCost,
+		"unplanned":     manifest.Unplanned,
+		"dry_run":       cmd.DryRun,
+	})
+
+	response := &PurchaseCargoManifestResponse{Manifest: manifest}
+	if cmd.DryRun {
+		return response, nil
+	}
+
+	for _, purchase := range manifest.Purchases {
+		resp, err := h.mediator.Send(ctx, &PurchaseCargoCommand{
+			ShipSymbol: cmd.ShipSymbol,
+			GoodSymbol: purchase.GoodSymbol,
+			Units:      purchase.Units,
+			PlayerID:   cmd.PlayerID,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("partial failure: manifest purchase of %d %s failed after %d transactions (%d units, %d credits): %w",
+				purchase.Units, purchase.GoodSymbol, response.TransactionCount, response.UnitsAdded, response.TotalCost, err)
+		}
+		bought, ok := resp.(*PurchaseCargoResponse)
+		if !ok {
+			return nil, fmt.Errorf("unexpected response type %T", resp)
+		}
+		response.TotalCost += bought.TotalCost
+		response.UnitsAdded += bought.UnitsAdded
+		response.TransactionCount += bought.TransactionCount
+	}
+	return response, nil
+}
@@ -0,0 +1,113 @@
+package cargo
+
+import (
+	"context"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/andrescamacho/spacetraders-go/internal/application/common"
+	scoutingQuery "github.com/andrescamacho/spacetraders-go/internal/application/scouting/queries"
+	"github.com/andrescamacho/spacetraders-go/internal/domain/market"
+	"github.com/andrescamacho/spacetraders-go/internal/domain/navigation"
+	"github.com/andrescamacho/spacetraders-go/internal/domain/shared"
+	"github.com/andrescamacho/spacetraders-go/internal/domain/trading"
+)
+
+type manifestFakeMarketRepo struct {
+	scoutingQuery.MarketRepository
+	market *market.Market
+}
+
+func (r *manifestFakeMarketRepo) GetMarketData(context.Context, string, int) (*market.Market, error) {
+	return r.market, nil
+}
+
+// manifestPurchaseMediator answers every PurchaseCargoCommand as fully bought at
+// 10 credits a unit and records the order of the calls.
+type manifestPurchaseMediator struct {
+	purchases []*PurchaseCargoCommand
+}
+
+func (m *manifestPurchaseMediator) Send(_ context.Context, request common.Request) (common.Response, error) {
+	cmd := request.(*PurchaseCargoCommand)
+	m.purchases = append(m.purchases, cmd)
+	return &PurchaseCargoResponse{TotalCost: 10 * cmd.Units, UnitsAdded: cmd.Units, TransactionCount: 1}, nil
+}
+
+func (m *manifestPurchaseMediator) Register(reflect.Type, common.RequestHandler) error { return nil }
+
+func (m *manifestPurchaseMediator) RegisterMiddleware(common.Middleware) {}
+
+func newManifestHandler(t *testing.T, med *manifestPurchaseMediator) *PurchaseCargoManifestHandler {
+	t.Helper()
+	var goods []market.TradeGood
+	for _, symbol := range []string{"IRON_ORE", "COPPER_ORE"} {
+		good, err := market.NewTradeGood(symbol, nil, nil, 8, 10, 10, market.TradeTypeExport)
+		require.NoError(t, err)
+		goods = append(goods, *good)
+	}
+	mkt, err := market.NewMarket(testBuyWaypoint, goods, time.Now())
+	require.NoError(t, err)
+
+	ship := newDockedBuyer(t, 40, 10, navigation.NavStatusDocked)
+	return NewPurchaseCargoManifestHandler(&buyFakeShipRepo{ship: ship}, &manifestFakeMarketRepo{market: mkt}, med,
+		trading.NewCargoManifestPlanner(0.05))
+}
+
+func TestPurchaseCargoManifestDryRunPlansWithoutBuying(t *testing.T) {
+	med := &manifestPurchaseMediator{}
+	handler := newManifestHandler(t, med)
+
+	resp, err := handler.Handle(context.Background(), &PurchaseCargoManifestCommand{
+		ShipSymbol: testBuyShip,
+		PlayerID:   shared.MustNewPlayerID(1),
+		Goods:      []ManifestGood{{GoodSymbol: "IRON_ORE", Units: 25}, {GoodSymbol: "COPPER_ORE", Units: 25}},
+		DryRun:     true,
+	})
+	require.NoError(t, err)
+
+	result := resp.(*PurchaseCargoManifestResponse)
+	require.Empty(t, med.purchases, "a dry run must not buy")
+	// 30 free units: all 25 IRON_ORE, then 5 COPPER_ORE.
+	require.Equal(t, 30, result.Manifest.TotalUnits)
+	require.Equal(t, 20, result.Manifest.Unplanned["COPPER_ORE"])
+	require.Positive(t, result.Manifest.ExpectedTotalCost)
+}
+
+func TestPurchaseCargoManifestExecutesInterleavedCalls(t *testing.T) {
+	med := &manifestPurchaseMediator{}
+	handler := newManifestHandler(t, med)
+
+	resp, err := handler.Handle(context.Background(), &PurchaseCargoManifestCommand{
+		ShipSymbol: testBuyShip,
+		PlayerID:   shared.MustNewPlayerID(1),
+		Goods:      []ManifestGood{{GoodSymbol: "IRON_ORE", Units: 15}, {GoodSymbol: "COPPER_ORE", Units: 15}},
+	})
+	require.NoError(t, err)
+
+	var calls []string
+	for _, p := range med.purchases {
+		require.LessOrEqual(t, p.Units, 10, "no call may exceed the trade volume")
+		calls = append(calls, p.GoodSymbol)
+	}
+	require.Equal(t, []string{"IRON_ORE", "COPPER_ORE", "IRON_ORE", "COPPER_ORE"}, calls)
+
+	result := resp.(*PurchaseCargoManifestResponse)
+	require.Equal(t, 30, result.UnitsAdded)
+	require.Equal(t, 300, result.TotalCost)
+	require.Equal(t, 4, result.TransactionCount)
+}
+
+func TestPurchaseCargoManifestRejectsGoodNotTraded(t *testing.T) {
+	handler := newManifestHandler(t, &manifestPurchaseMediator{})
+
+	_, err := handler.Handle(context.Background(), &PurchaseCargoManifestCommand{
+		ShipSymbol: testBuyShip,
+		PlayerID:   shared.MustNewPlayerID(1),
+		Goods:      []ManifestGood{{GoodSymbol: "GOLD", Units: 5}},
+	})
+	require.ErrorContains(t, err, "not traded")
+}
@@ -0,0 +1,101 @@
+package cargo
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/andrescamacho/spacetraders-go/internal/application/auth"
+	ledgerServices "github.com/andrescamacho/spacetraders-go/internal/application/ledger/services"
+	"github.com/andrescamacho/spacetraders-go/internal/domain/ledger"
+	"github.com/andrescamacho/spacetraders-go/internal/domain/navigation"
+	"github.com/andrescamacho/spacetraders-go/internal/domain/player"
+	"github.com/andrescamacho/spacetraders-go/internal/domain/shared"
+)
+
+// reservationFakeAPI serves a fixed treasury on top of the ceiling fixture's
+// buy recording.
+type reservationFakeAPI struct {
+	*ceilingFakeAPI
+	credits     int
+	agentErr    error
+	agentCalled int
+}
+
+func (a *reservationFakeAPI) GetAgent(context.Context, string) (*player.AgentData, error) {
+	a.agentCalled++
+	if a.agentErr != nil {
+		return nil, a.agentErr
+	}
+	return &player.AgentData{Credits: a.credits}, nil
+}
+
+func newReservationBuyHandler(t *testing.T, credits int, reservations ledger.CreditReservations) (*PurchaseCargoHandler, *reservationFakeAPI) {
+	t.Helper()
+	fix := &ceilingMarketFixture{healthyAsk: 1000, laddedAsk: 1000, limit: 40}
+	api := &reservationFakeAPI{ceilingFakeAPI: &ceilingFakeAPI{fix: fix}, credits: credits}
+	marketRepo := &ceilingFakeMarketRepo{fix: fix, waypoint: testBuyWaypoint, good: optypeGood}
+	shipRepo := &buyFakeShipRepo{ship: newDockedBuyer(t, 40, 0, navigation.NavStatusDocked)}
+	playerRepo := &buyFakePlayerRepo{player: player.NewPlayer(shared.MustNewPlayerID(1), "AGENT", "tok")}
+	h := NewPurchaseCargoHandler(shipRepo, playerRepo, api, marketRepo, &buyRecordingMediator{}, nil)
+	h.SetCreditReservations(reservations)
+	return h, api
+}
+
+func buyForty(ctx context.Context, h *PurchaseCargoHandler) (*PurchaseCargoResponse, error) {
+	resp, err := h.Handle(auth.WithPlayerToken(ctx, "tok"), &PurchaseCargoCommand{
+		ShipSymbol: testBuyShip, GoodSymbol: optypeGood, Units: 40, PlayerID: shared.MustNewPlayerID(1),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp.(*PurchaseCargoResponse), nil
+}
+
+// Credits another coordinator reserved are not available to this purchase:
+// 50k live less 20k reserved cannot cover 40 units at 1,000.
+func TestPurchaseCargo_RefusesCreditsReservedByAnotherCoordinator(t *testing.T) {
+	reservations := ledgerServices.NewCreditReservationService(time.Minute, nil)
+	_, err := reservations.Reserve(context.Background(), 1, "shipyard", 20_000, 50_000)
+	require.NoError(t, err)
+	h, api := newReservationBuyHandler(t, 50_000, reservations)
+
+	_, err = buyForty(context.Background(), h)
+
+	var short *ledger.ErrInsufficientUnreservedCredits
+	require.ErrorAs(t, err, &short)
+	require.Equal(t, 40_000, short.Need)
+	require.Empty(t, api.buys, "nothing reaches the API")
+}
+
+// The buyer's own reservation, carried on ctx, is spendable.
+func TestPurchaseCargo_SpendsItsOwnReservation(t *testing.T) {
+	reservations := ledgerServices.NewCreditReservationService(time.Minute, nil)
+	own, err := reservations.Reserve(context.Background(), 1, "trade-route", 40_000, 50_000)
+	require.NoError(t, err)
+	h, api := newReservationBuyHandler(t, 50_000, reservations)
+
+	pr, err := buyForty(ledger.WithCreditReservation(context.Background(), own.ID), h)
+
+	require.NoError(t, err)
+	require.Equal(t, 40, pr.UnitsAdded)
+	require.Equal(t, 0, api.agentCalled, "nothing reserved by others, so no balance read")
+}
+
+// With credits reserved by others, a purchase that cannot read the live
+// balance is refused rather than let through (RULINGS #4).
+func TestPurchaseCargo_FailsClosedWhenBalanceUnreadable(t *testing.T) {
+	reservations := ledgerServices.NewCreditReservationService(time.Minute, nil)
+	_, err := reservations.Reserve(context.Background(), 1, "shipyard", 20_000, 50_000)
+	require.NoError(t, err)
+	h, api := newReservationBuyHandler(t, 50_000, reservations)
+	api.agentErr = errors.New("agent endpoint down")
+
+	_, err = buyForty(context.Background(), h)
+
+	require.ErrorContains(t, err, "live balance is unreadable")
+	require.Empty(t, api.buys, "nothing reaches the API")
+}
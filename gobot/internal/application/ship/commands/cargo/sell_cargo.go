@@ -7,10 +7,12 @@ import (
 	"github.com/andrescamacho/spacetraders-go/internal/application/common"
 	scoutingQuery "github.com/andrescamacho/spacetraders-go/internal/application/scouting/queries"
 	"github.com/andrescamacho/spacetraders-go/internal/application/ship/strategies"
+	"github.com/andrescamacho/spacetraders-go/internal/domain/ledger"
 	"github.com/andrescamacho/spacetraders-go/internal/domain/navigation"
 	"github.com/andrescamacho/spacetraders-go/internal/domain/player"
 	domainPorts "github.com/andrescamacho/spacetraders-go/internal/domain/ports"
 	"github.com/andrescamacho/spacetraders-go/internal/domain/shared"
+	"github.com/andrescamacho/spacetraders-go/internal/domain/trading"
 )
 
 // SellCargoCommand requests cargo sale from a ship at its current docked location.
@@ -33,6 +35,11 @@ type SellCargoCommand struct {
 	// aboard) if it falls below this per-unit floor. 0 disables it — the unchanged
 	// path for every caller but the arb executor. See CargoTransactionCommand.
 	MinBidPerUnit int
+
+	// MinPercentOfCost floors the sale at a percentage of the ledger cost basis
+	// the ship paid for the good (100 = never below cost). See
+	// CargoTransactionCommand.MinPercentOfCost. 0 disables it.
+	MinPercentOfCost float64
 }
 
 // SellCargoResponse contains the results of a cargo sale operation.
@@ -49,6 +56,11 @@ type SellCargoResponse struct {
 	// aboard. FloorObservedBid is the live bid that tripped it (0 if unreadable).
 	FloorAborted     bool
 	FloorObservedBid int
+	// FloorPerUnit is the floor that governed the sale and CostBasisPerUnit the
+	// cost basis a MinPercentOfCost floor was derived from. A FloorAborted
+	// caller holding cargo routes it to a market bidding at least FloorPerUnit.
+	FloorPerUnit     int
+	CostBasisPerUnit int
 
 	// Reserved (sp-1vhv) is true when the sale was refused because the good is
 	// reserved as do-not-sell on the hull (a staged outfitting module, or an
@@ -91,6 +103,16 @@ func NewSellCargoHandler(
 	}
 }
 
+// SetMarketFeeObserver reports each sale's quoted vs charged total to observer.
+func (h *SellCargoHandler) SetMarketFeeObserver(observer trading.MarketFeeObserver) {
+	h.delegate.SetMarketFeeObserver(observer)
+}
+
+// SetCostBasisRecorder books each sale against the ship's cargo cost basis.
+func (h *SellCargoHandler) SetCostBasisRecorder(recorder ledger.CargoCostBasisRecorder) {
+	h.delegate.SetCostBasisRecorder(recorder)
+}
+
 // Handle executes the sell cargo command by delegating to the unified handler.
 //
 // This method maintains backward compatibility by:
@@ -105,11 +127,12 @@ func (h *SellCargoHandler) Handle(ctx context.Context, request common.Request) (
 
 	// Convert to unified command
 	unifiedCmd := &CargoTransactionCommand{
-		ShipSymbol:    cmd.ShipSymbol,
-		GoodSymbol:    cmd.GoodSymbol,
-		Units:         cmd.Units,
-		PlayerID:      cmd.PlayerID,
-		MinBidPerUnit: cmd.MinBidPerUnit, // sp-lbbm per-tranche sell floor (0 → disabled)
+		ShipSymbol:       cmd.ShipSymbol,
+		GoodSymbol:       cmd.GoodSymbol,
+		Units:            cmd.Units,
+		PlayerID:         cmd.PlayerID,
+		MinBidPerUnit:    cmd.MinBidPerUnit, // sp-lbbm per-tranche sell floor (0 → disabled)
+		MinPercentOfCost: cmd.MinPercentOfCost,
 	}
 
 	// Delegate to unified handler
@@ -126,6 +149,8 @@ func (h *SellCargoHandler) Handle(ctx context.Context, request common.Request) (
 		TransactionCount: unifiedResp.TransactionCount,
 		FloorAborted:     unifiedResp.FloorAborted,
 		FloorObservedBid: unifiedResp.FloorObservedBid,
+		FloorPerUnit:     unifiedResp.FloorPerUnit,
+		CostBasisPerUnit: unifiedResp.CostBasisPerUnit,
 		Reserved:         unifiedResp.Reserved,
 	}, nil
 }
@@ -0,0 +1,99 @@
+package cargo
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/andrescamacho/spacetraders-go/internal/application/auth"
+	"github.com/andrescamacho/spacetraders-go/internal/application/common"
+	ledgerQueries "github.com/andrescamacho/spacetraders-go/internal/application/ledger/queries"
+	"github.com/andrescamacho/spacetraders-go/internal/domain/player"
+	"github.com/andrescamacho/spacetraders-go/internal/domain/shared"
+)
+
+// --- cost-relative sell floor -----------------------------------------------
+//
+// MinPercentOfCost floors a sale at a share of what the hull paid, read from the
+// ledger through GetCargoCostBasisQuery. These reuse the sp-lbbm floor fixture
+// with a bid that holds, so only the floor decides what sells.
+
+// costBasisMediator answers the cost-basis query and records everything else
+// like buyRecordingMediator.
+type costBasisMediator struct {
+	buyRecordingMediator
+	basis   *ledgerQueries.GetCargoCostBasisResponse
+	queries []*ledgerQueries.GetCargoCostBasisQuery
+}
+
+func (m *costBasisMediator) Send(ctx context.Context, request common.Request) (common.Response, error) {
+	if q, ok := request.(*ledgerQueries.GetCargoCostBasisQuery); ok {
+		m.queries = append(m.queries, q)
+		return m.basis, nil
+	}
+	return m.buyRecordingMediator.Send(ctx, request)
+}
+
+func runCostFloorSell(t *testing.T, bid int, basis *ledgerQueries.GetCargoCostBasisResponse, minBid int, pct float64) (*SellCargoResponse, *floorFakeAPI, *costBasisMediator) {
+	t.Helper()
+	fix := &floorMarketFixture{healthyBid: bid, crashedBid: bid, limit: 15}
+	api := &floorFakeAPI{fix: fix}
+	marketRepo := &floorFakeMarketRepo{fix: fix, waypoint: testBuyWaypoint, good: optypeGood}
+	shipRepo := &buyFakeShipRepo{ship: newDockedShipWithCargo(t, 1, optypeGood, 40)}
+	playerRepo := &buyFakePlayerRepo{player: player.NewPlayer(shared.MustNewPlayerID(1), "AGENT", "tok")}
+	med := &costBasisMediator{basis: basis}
+	h := NewSellCargoHandler(shipRepo, playerRepo, api, marketRepo, med, &floorFakeRefresher{})
+
+	resp, err := h.Handle(auth.WithPlayerToken(context.Background(), "tok"), &SellCargoCommand{
+		ShipSymbol: "OPTYPE-1", GoodSymbol: optypeGood, Units: 40,
+		PlayerID: shared.MustNewPlayerID(1), MinBidPerUnit: minBid, MinPercentOfCost: pct,
+	})
+	require.NoError(t, err)
+	return resp.(*SellCargoResponse), api, med
+}
+
+// A market bidding below cost is refused outright: nothing reaches the API and
+// the response names the floor and basis so the caller can look elsewhere.
+func TestSellCargo_CostFloor_RefusesBelowCost(t *testing.T) {
+	sr, api, med := runCostFloorSell(t, 1800, &ledgerQueries.GetCargoCostBasisResponse{Known: true, PerUnit: 2000, UnitsCovered: 40}, 0, 100)
+
+	require.True(t, sr.FloorAborted)
+	require.Zero(t, sr.UnitsSold)
+	require.Empty(t, api.sells)
+	require.Equal(t, 2000, sr.FloorPerUnit)
+	require.Equal(t, 2000, sr.CostBasisPerUnit)
+	require.Equal(t, 1800, sr.FloorObservedBid)
+	require.Len(t, med.queries, 1)
+	require.Equal(t, 40, med.queries[0].Units)
+}
+
+// A percentage below 100 tolerates a bounded loss: 1800 clears 90% of 2000.
+func TestSellCargo_CostFloor_AcceptsWithinTolerance(t *testing.T) {
+	sr, api, _ := runCostFloorSell(t, 1800, &ledgerQueries.GetCargoCostBasisResponse{Known: true, PerUnit: 2000, UnitsCovered: 40}, 0, 90)
+
+	require.False(t, sr.FloorAborted)
+	require.Equal(t, 40, sr.UnitsSold)
+	require.Len(t, api.sells, 3)
+	require.Equal(t, 1800, sr.FloorPerUnit)
+}
+
+// The higher of the absolute and cost-relative floors governs.
+func TestSellCargo_CostFloor_AbsoluteFloorWinsWhenHigher(t *testing.T) {
+	sr, _, _ := runCostFloorSell(t, 1800, &ledgerQueries.GetCargoCostBasisResponse{Known: true, PerUnit: 1000, UnitsCovered: 40}, 1900, 100)
+
+	require.True(t, sr.FloorAborted)
+	require.Equal(t, 1900, sr.FloorPerUnit)
+	require.Equal(t, 1000, sr.CostBasisPerUnit)
+}
+
+// Cargo the ledger has no purchase for (mined, delivered) has no basis to floor
+// against: it sells unfloored rather than being stranded aboard.
+func TestSellCargo_CostFloor_UnknownBasisSellsUnfloored(t *testing.T) {
+	sr, api, _ := runCostFloorSell(t, 5, &ledgerQueries.GetCargoCostBasisResponse{}, 0, 100)
+
+	require.False(t, sr.FloorAborted)
+	require.Equal(t, 40, sr.UnitsSold)
+	require.Len(t, api.sells, 3)
+	require.Zero(t, sr.FloorPerUnit)
+}
@@ -0,0 +1,76 @@
+package navigation
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/andrescamacho/spacetraders-go/internal/application/common"
+	"github.com/andrescamacho/spacetraders-go/internal/application/ship/types"
+)
+
+// Idempotency scopes the navigation handlers persist their keyed results under.
+const (
+	NavigateRouteIdempotencyScope  = "navigate_route"
+	NavigateDirectIdempotencyScope = "navigate_direct"
+)
+
+// NavigateRouteResultCodec persists a NavigateRouteResponse's summary. The
+// ship and route are not kept: a replay refreshes the ship from the repository
+// anyway, and a reloaded result carries no route.
+type NavigateRouteResultCodec struct{}
+
+type navigateRouteResult struct {
+	Status          string `json:"status"`
+	ArrivalTime     int    `json:"arrival_time"`
+	CurrentLocation string `json:"current_location"`
+	FuelRemaining   int    `json:"fuel_remaining"`
+}
+
+// Encode implements ship.IdempotencyCodec.
+func (NavigateRouteResultCodec) Encode(response common.Response) ([]byte, error) {
+	resp, ok := response.(*NavigateRouteResponse)
+	if !ok {
+		return nil, fmt.Errorf("unexpected navigate route response %T", response)
+	}
+	return json.Marshal(navigateRouteResult{
+		Status:          resp.Status,
+		ArrivalTime:     resp.ArrivalTime,
+		CurrentLocation: resp.CurrentLocation,
+		FuelRemaining:   resp.FuelRemaining,
+	})
+}
+
+// Decode implements ship.IdempotencyCodec.
+func (NavigateRouteResultCodec) Decode(data []byte) (common.Response, error) {
+	var result navigateRouteResult
+	if err := json.Unmarshal(data, &result); err != nil {
+		return nil, err
+	}
+	return &NavigateRouteResponse{
+		Status:          result.Status,
+		ArrivalTime:     result.ArrivalTime,
+		CurrentLocation: result.CurrentLocation,
+		FuelRemaining:   result.FuelRemaining,
+	}, nil
+}
+
+// NavigateDirectResultCodec persists a NavigateDirectResponse as it is.
+type NavigateDirectResultCodec struct{}
+
+// Encode implements ship.IdempotencyCodec.
+func (NavigateDirectResultCodec) Encode(response common.Response) ([]byte, error) {
+	resp, ok := response.(*types.NavigateDirectResponse)
+	if !ok {
+		return nil, fmt.Errorf("unexpected navigate direct response %T", response)
+	}
+	return json.Marshal(resp)
+}
+
+// Decode implements ship.IdempotencyCodec.
+func (NavigateDirectResultCodec) Decode(data []byte) (common.Response, error) {
+	var resp types.NavigateDirectResponse
+	if err := json.Unmarshal(data, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
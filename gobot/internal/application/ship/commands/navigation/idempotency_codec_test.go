@@ -0,0 +1,39 @@
+package navigation
+
+import (
+	"testing"
+
+	"github.com/andrescamacho/spacetraders-go/internal/application/ship/types"
+)
+
+// A route result reloads with its summary and without the route, which the
+// replay path must tolerate; a direct result reloads whole.
+func TestIdempotencyCodecs_RoundTrip(t *testing.T) {
+	data, err := NavigateRouteResultCodec{}.Encode(&NavigateRouteResponse{
+		Status: "completed", ArrivalTime: 42, CurrentLocation: "X1-A-B2", FuelRemaining: 300,
+	})
+	if err != nil {
+		t.Fatalf("encode route: %v", err)
+	}
+	decoded, err := NavigateRouteResultCodec{}.Decode(data)
+	if err != nil {
+		t.Fatalf("decode route: %v", err)
+	}
+	route := decoded.(*NavigateRouteResponse)
+	if route.Status != "completed" || route.ArrivalTime != 42 || route.CurrentLocation != "X1-A-B2" || route.FuelRemaining != 300 || route.Route != nil {
+		t.Fatalf("unexpected route result %+v", route)
+	}
+
+	original := &types.NavigateDirectResponse{Status: "navigating", ArrivalTime: 90, FuelConsumed: 12, FuelCurrent: 88, FuelCapacity: 100}
+	data, err = NavigateDirectResultCodec{}.Encode(original)
+	if err != nil {
+		t.Fatalf("encode direct: %v", err)
+	}
+	decoded, err = NavigateDirectResultCodec{}.Decode(data)
+	if err != nil {
+		t.Fatalf("decode direct: %v", err)
+	}
+	if *decoded.(*types.NavigateDirectResponse) != *original {
+		t.Fatalf("direct result changed in the round trip: %+v", decoded)
+	}
+}
@@ -6,6 +6,7 @@ import (
 	"strings"
 
 	"github.com/andrescamacho/spacetraders-go/internal/application/common"
+	appShip "github.com/andrescamacho/spacetraders-go/internal/application/ship"
 	"github.com/andrescamacho/spacetraders-go/internal/application/ship/types"
 	"github.com/andrescamacho/spacetraders-go/internal/domain/navigation"
 	"github.com/andrescamacho/spacetraders-go/internal/domain/shared"
@@ -16,6 +17,8 @@ import (
 type NavigateDirectHandler struct {
 	shipRepo     navigation.ShipRepository
 	waypointRepo system.WaypointRepository
+	// results replays keyed retries; nil until WithIdempotencyCache.
+	results *appShip.IdempotencyCache
 }
 
 // NewNavigateDirectHandler creates a new navigate direct handler
@@ -29,6 +32,13 @@ func NewNavigateDirectHandler(
 	}
 }
 
+// WithIdempotencyCache makes the handler honour NavigateDirectCommand.IdempotencyKey
+// and returns it for chaining. Called once at wiring time.
+func (h *NavigateDirectHandler) WithIdempotencyCache(results *appShip.IdempotencyCache) *NavigateDirectHandler {
+	h.results = results
+	return h
+}
+
 // Handle executes the navigate direct command
 func (h *NavigateDirectHandler) Handle(ctx context.Context, request common.Request) (common.Response, error) {
 	cmd, ok := request.(*types.NavigateDirectCommand)
@@ -50,13 +60,39 @@ func (h *NavigateDirectHandler) Handle(ctx context.Context, request common.Reque
 		return nil, err
 	}
 
+	if cmd.IdempotencyKey == "" || h.results == nil {
+		return h.navigate(ctx, cmd, ship, destination)
+	}
+
+	// A keyed retry replays the original result while the ship is still in
+	// transit to, or sitting at, the requested destination.
+	previous, replay, err := h.results.Claim(cmd.IdempotencyKey,
+		appShip.IdempotentRequest{ShipSymbol: ship.ShipSymbol(), Destination: destination.Symbol},
+		func(common.Response) bool { return ship.CurrentLocation().Symbol == destination.Symbol })
+	if err != nil {
+		return nil, err
+	}
+	if replay {
+		return previous, nil
+	}
+	resp, err := h.navigate(ctx, cmd, ship, destination)
+	if err != nil {
+		h.results.Release(ctx, cmd.IdempotencyKey)
+		return nil, err
+	}
+	h.results.Complete(ctx, cmd.IdempotencyKey, resp)
+	return resp, nil
+}
+
+// navigate flies the single hop to destination.
+func (h *NavigateDirectHandler) navigate(ctx context.Context, cmd *types.NavigateDirectCommand, ship *navigation.Ship, destination *shared.Waypoint) (*types.NavigateDirectResponse, error) {
 	if ship.IsAtLocation(destination) {
 		return &types.NavigateDirectResponse{
 			Status: "already_at_destination",
 		}, nil
 	}
 
-	if _, err = ship.EnsureInOrbit(); err != nil {
+	if _, err := ship.EnsureInOrbit(); err != nil {
 		return nil, fmt.Errorf("failed to ensure ship in orbit: %w", err)
 	}
 
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"testing"
 
+	appShip "github.com/andrescamacho/spacetraders-go/internal/application/ship"
 	"github.com/andrescamacho/spacetraders-go/internal/application/ship/types"
 	domainNavigation "github.com/andrescamacho/spacetraders-go/internal/domain/navigation"
 	"github.com/andrescamacho/spacetraders-go/internal/domain/shared"
@@ -16,11 +17,13 @@ type stubShipRepo struct {
 	domainNavigation.ShipRepository
 
 	navigateErr     error
+	navigateCalls   int
 	syncedShip      *domainNavigation.Ship
 	syncCalledCount int
 }
 
 func (s *stubShipRepo) Navigate(_ context.Context, _ *domainNavigation.Ship, _ *shared.Waypoint, _ shared.PlayerID) (*domainNavigation.Result, error) {
+	s.navigateCalls++
 	return nil, s.navigateErr
 }
 
@@ -104,3 +107,40 @@ func TestNavigateDirect_AlreadyAtDestination4204(t *testing.T) {
 		t.Fatalf("expected ship position reconciled to %s, got %s", destination.Symbol, ship.CurrentLocation().Symbol)
 	}
 }
+
+// A client retrying a keyed navigate after losing the first reply must get the
+// original result back rather than a second navigate call.
+func TestNavigateDirect_IdempotencyKeyReplaysTheOriginalResult(t *testing.T) {
+	stale, _ := shared.NewWaypoint("X1-PZ28-H64", 0, 0)
+	destination, _ := shared.NewWaypoint("X1-PZ28-H65", 1, 1)
+
+	ship := newTestShip(t, "TORWIND-2", stale)
+	repo := &stubShipRepo{
+		navigateErr: fmt.Errorf(`API error (status 400): {"error":{"code":4204,"message":"Ship TORWIND-2 is currently located at the destination."}}`),
+		syncedShip:  newTestShip(t, "TORWIND-2", destination),
+	}
+	handler := NewNavigateDirectHandler(repo, nil).WithIdempotencyCache(appShip.NewIdempotencyCache(0, nil))
+
+	cmd := &types.NavigateDirectCommand{
+		Ship:                ship,
+		Destination:         destination.Symbol,
+		DestinationWaypoint: destination,
+		PlayerID:            shared.MustNewPlayerID(1),
+		IdempotencyKey:      "nav-1",
+	}
+	first, err := handler.Handle(context.Background(), cmd)
+	if err != nil {
+		t.Fatalf("first navigate: %v", err)
+	}
+	second, err := handler.Handle(context.Background(), cmd)
+	if err != nil {
+		t.Fatalf("retried navigate: %v", err)
+	}
+
+	if second != first {
+		t.Fatalf("expected the original response replayed, got %+v", second)
+	}
+	if repo.navigateCalls != 1 {
+		t.Fatalf("the retry must not navigate again, got %d navigate calls", repo.navigateCalls)
+	}
+}
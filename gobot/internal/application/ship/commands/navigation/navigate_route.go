@@ -2,15 +2,22 @@ package navigation
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"time"
 
 	"github.com/andrescamacho/spacetraders-go/internal/application/common"
 	"github.com/andrescamacho/spacetraders-go/internal/application/ship"
 	domainNavigation "github.com/andrescamacho/spacetraders-go/internal/domain/navigation"
+	domainRouting "github.com/andrescamacho/spacetraders-go/internal/domain/routing"
 	"github.com/andrescamacho/spacetraders-go/internal/domain/shared"
 	"github.com/andrescamacho/spacetraders-go/internal/domain/system"
 )
 
+// DefaultMaxRouteReplans is how many times a NavigateRouteCommand replans from
+// the ship's current position after a failed segment before giving up.
+const DefaultMaxRouteReplans = 2
+
 // Type aliases for utility classes from parent package
 type WaypointEnricher = ship.WaypointEnricher
 type RoutePlanner = ship.RoutePlanner
@@ -36,6 +43,26 @@ type NavigateRouteCommand struct {
 	Destination  string
 	PlayerID     shared.PlayerID
 	PreferCruise bool // When true, prefer CRUISE over BURN (for asteroid ↔ market loop only)
+	// ArrivalDeadline, when set, re-selects per-segment flight modes to arrive
+	// by this time at minimal fuel (falling back to the cheapest plan if it
+	// cannot be met). Overrides PreferCruise's mode choice.
+	ArrivalDeadline *time.Time
+	// Objective weighs travel time against fuel and refuel stops when planning
+	// the route (routing.ObjectiveFastest, routing.ObjectiveFuelSaver, or
+	// custom weights). The zero value plans the fastest route.
+	Objective domainRouting.RouteObjective
+	// MaxReplans bounds how often a failed segment triggers a fresh route from
+	// the ship's current position. 0 uses DefaultMaxRouteReplans; negative
+	// disables replanning.
+	MaxReplans int
+	// IdempotencyKey, when set, lets a retried command replay the original
+	// result instead of flying the route again. Needs WithIdempotencyCache.
+	IdempotencyKey string
+	// FuelReservePercent overrides the planner's fleet-wide fuel reserve for
+	// this route: nil keeps the fleet policy, 0 plans without a reserve (an
+	// emergency run to the nearest fuel), a positive value is the share of
+	// the tank the route must keep aboard.
+	FuelReservePercent *int
 }
 
 // NavigateRouteResponse represents the result of navigation
@@ -66,6 +93,9 @@ type NavigateRouteHandler struct {
 	// destination then fails closed exactly as before this fix (byte-identical), so the
 	// capability is purely additive.
 	crossSystemRouter CrossSystemRouter
+
+	// results replays keyed retries; nil until WithIdempotencyCache.
+	results *ship.IdempotencyCache
 }
 
 // NewNavigateRouteHandler creates a new NavigateRouteHandler with extracted services
@@ -97,6 +127,14 @@ func (h *NavigateRouteHandler) WithCrossSystemRouter(router CrossSystemRouter) *
 	return h
 }
 
+// WithIdempotencyCache makes the handler honour NavigateRouteCommand.IdempotencyKey
+// and returns it for chaining. Called once at wiring time, like
+// WithCrossSystemRouter.
+func (h *NavigateRouteHandler) WithIdempotencyCache(results *ship.IdempotencyCache) *NavigateRouteHandler {
+	h.results = results
+	return h
+}
+
 // Handle executes the NavigateRoute command using extracted services
 func (h *NavigateRouteHandler) Handle(ctx context.Context, request common.Request) (common.Response, error) {
 	cmd, ok := request.(*NavigateRouteCommand)
@@ -106,11 +144,46 @@ func (h *NavigateRouteHandler) Handle(ctx context.Context, request common.Reques
 
 	logger := common.LoggerFromContext(ctx)
 
-	ship, err := h.loadAndPrepareShip(ctx, cmd, logger)
+	current, err := h.loadAndPrepareShip(ctx, cmd, logger)
+	if err != nil {
+		return nil, err
+	}
+
+	if cmd.IdempotencyKey == "" || h.results == nil {
+		return h.navigate(ctx, cmd, current, logger)
+	}
+
+	// A keyed retry replays the original result once the ship is at the
+	// destination (loadAndPrepareShip has already waited out any transit).
+	previous, replay, err := h.results.Claim(cmd.IdempotencyKey,
+		ship.IdempotentRequest{ShipSymbol: cmd.ShipSymbol, Destination: cmd.Destination},
+		func(common.Response) bool { return current.CurrentLocation().Symbol == cmd.Destination })
+	if err != nil {
+		return nil, err
+	}
+	if replay {
+		logger.Log("INFO", "Replaying navigation for repeated idempotency key", map[string]interface{}{
+			"ship_symbol":     cmd.ShipSymbol,
+			"action":          "navigate",
+			"destination":     cmd.Destination,
+			"idempotency_key": cmd.IdempotencyKey,
+		})
+		original := *previous.(*NavigateRouteResponse)
+		original.Ship = current
+		original.FuelRemaining = current.Fuel().Current
+		return &original, nil
+	}
+	response, err := h.navigate(ctx, cmd, current, logger)
 	if err != nil {
+		h.results.Release(ctx, cmd.IdempotencyKey)
 		return nil, err
 	}
+	h.results.Complete(ctx, cmd.IdempotencyKey, response)
+	return response, nil
+}
 
+// navigate routes the loaded ship to the command's destination.
+func (h *NavigateRouteHandler) navigate(ctx context.Context, cmd *NavigateRouteCommand, ship *domainNavigation.Ship, logger common.ContainerLogger) (common.Response, error) {
 	// sp-9l4p: a destination in a DIFFERENT system than the ship cannot be reached by
 	// the intra-system route planner below (the OR-Tools planner and the raw /navigate
 	// API are both single-system), so a bare cross-system NavigateRouteCommand used to
@@ -143,7 +216,7 @@ func (h *NavigateRouteHandler) Handle(ctx context.Context, request common.Reques
 		return h.handleAlreadyAtDestination(cmd, ship)
 	}
 
-	route, err := h.planAndExecuteRoute(ctx, cmd, ship, waypointObjects, systemSymbol, logger)
+	route, ship, err := h.planAndExecuteRoute(ctx, cmd, ship, waypointObjects, systemSymbol, logger)
 	if err != nil {
 		return nil, err
 	}
@@ -283,7 +356,57 @@ func shouldForceRefreshWaypoints(waypoints map[string]*shared.Waypoint, origin,
 	return false
 }
 
-func (h *NavigateRouteHandler) planAndExecuteRoute(ctx context.Context, cmd *NavigateRouteCommand, ship *domainNavigation.Ship, waypointObjects map[string]*shared.Waypoint, systemSymbol string, logger common.ContainerLogger) (*domainNavigation.Route, error) {
+// planAndExecuteRoute plans a route to the destination and executes it. When a
+// segment fails for a reason a new route could avoid (typically a fuel estimate
+// made from stale ship state) the ship is re-synced from the API, a route is
+// planned from wherever it now is, and execution resumes, up to the command's
+// replan budget. It returns the route that completed and the ship as it ended.
+func (h *NavigateRouteHandler) planAndExecuteRoute(ctx context.Context, cmd *NavigateRouteCommand, ship *domainNavigation.Ship, waypointObjects map[string]*shared.Waypoint, systemSymbol string, logger common.ContainerLogger) (*domainNavigation.Route, *domainNavigation.Ship, error) {
+	maxReplans := resolveMaxReplans(cmd.MaxReplans)
+	for replans := 0; ; replans++ {
+		route, err := h.planRoute(ctx, cmd, ship, waypointObjects, systemSymbol, logger)
+		if err != nil {
+			return nil, ship, err
+		}
+
+		execErr := h.executeRoute(ctx, cmd, route, ship, logger)
+		if execErr == nil {
+			return route, ship, nil
+		}
+		if replans >= maxReplans || !shouldReplanRoute(ctx, execErr) {
+			return nil, ship, fmt.Errorf("failed to execute route: %w", execErr)
+		}
+
+		fresh, err := h.shipRepo.SyncShipFromAPI(domainNavigation.WithFreshShipRead(ctx), cmd.ShipSymbol, cmd.PlayerID)
+		if err != nil {
+			return nil, ship, fmt.Errorf("failed to execute route: %w (refreshing ship for a replan also failed: %v)", execErr, err)
+		}
+		ship = fresh
+
+		logger.Log("WARNING", "Route segment failed - replanning from current position", map[string]interface{}{
+			"ship_symbol": ship.ShipSymbol(),
+			"action":      "replan_route",
+			"replan":      replans + 1,
+			"max_replans": maxReplans,
+			"current":     ship.CurrentLocation().Symbol,
+			"destination": cmd.Destination,
+			"fuel":        ship.Fuel().Current,
+			"error":       execErr.Error(),
+		})
+
+		if ship.CurrentLocation().Symbol == cmd.Destination && ship.NavStatus() != domainNavigation.NavStatusInTransit {
+			response, err := h.handleAlreadyAtDestination(cmd, ship)
+			if err != nil {
+				return nil, ship, err
+			}
+			return response.Route, ship, nil
+		}
+	}
+}
+
+// planRoute asks the route planner for a route from the ship's current
+// position, applying the command's arrival deadline when one is set.
+func (h *NavigateRouteHandler) planRoute(ctx context.Context, cmd *NavigateRouteCommand, ship *domainNavigation.Ship, waypointObjects map[string]*shared.Waypoint, systemSymbol string, logger common.ContainerLogger) (*domainNavigation.Route, error) {
 	logger.Log("INFO", "Route planning initiated", map[string]interface{}{
 		"ship_symbol": ship.ShipSymbol(),
 		"action":      "plan_route",
@@ -291,7 +414,8 @@ func (h *NavigateRouteHandler) planAndExecuteRoute(ctx context.Context, cmd *Nav
 		"destination": cmd.Destination,
 	})
 
-	route, err := h.routePlanner.PlanRoute(ctx, ship, cmd.Destination, waypointObjects, cmd.PreferCruise)
+	reserve := h.fuelReserve(cmd)
+	route, err := h.routePlanner.PlanRouteWithFuelReserve(ctx, ship, cmd.Destination, waypointObjects, cmd.PreferCruise, cmd.Objective, reserve)
 	if err != nil {
 		return nil, fmt.Errorf("failed to plan route: %w", err)
 	}
@@ -300,6 +424,31 @@ func (h *NavigateRouteHandler) planAndExecuteRoute(ctx context.Context, cmd *Nav
 		return nil, h.buildNoRouteFoundError(ship, cmd.Destination, systemSymbol, waypointObjects)
 	}
 
+	if cmd.ArrivalDeadline != nil {
+		// Faster legs must leave the reserve aboard too, not just the margin.
+		margin := max(domainNavigation.DefaultFuelSafetyMargin, reserve.Units(ship.FuelCapacity()))
+		route, err = h.routePlanner.ApplyArrivalDeadline(ctx, route, ship, time.Until(*cmd.ArrivalDeadline), margin)
+		if err != nil {
+			return nil, fmt.Errorf("failed to apply arrival deadline: %w", err)
+		}
+		if err := domainNavigation.NewShipFuelService(nil).CheckFuelReserve(route, ship.Fuel().Current, ship.FuelCapacity(), reserve); err != nil {
+			return nil, fmt.Errorf("failed to apply arrival deadline: %w", err)
+		}
+	}
+	return route, nil
+}
+
+// fuelReserve is the fuel reserve cmd's route must keep: the command's
+// override when set, otherwise the planner's fleet-wide policy.
+func (h *NavigateRouteHandler) fuelReserve(cmd *NavigateRouteCommand) domainNavigation.FuelReservePolicy {
+	if cmd.FuelReservePercent != nil {
+		return domainNavigation.FuelReservePolicy{Percent: *cmd.FuelReservePercent}
+	}
+	return h.routePlanner.FuelReserve()
+}
+
+// executeRoute runs one planned route, marking it failed on an error or panic.
+func (h *NavigateRouteHandler) executeRoute(ctx context.Context, cmd *NavigateRouteCommand, route *domainNavigation.Route, ship *domainNavigation.Ship, logger common.ContainerLogger) error {
 	defer func() {
 		if r := recover(); r != nil {
 			if failErr := route.FailRoute(fmt.Sprintf("panic during execution: %v", r)); failErr != nil {
@@ -320,10 +469,37 @@ func (h *NavigateRouteHandler) planAndExecuteRoute(ctx context.Context, cmd *Nav
 				"error":       failErr.Error(),
 			})
 		}
-		return nil, fmt.Errorf("failed to execute route: %w", err)
+		return err
 	}
+	return nil
+}
 
-	return route, nil
+// resolveMaxReplans maps the command's MaxReplans knob to a replan budget.
+func resolveMaxReplans(configured int) int {
+	switch {
+	case configured < 0:
+		return 0
+	case configured == 0:
+		return DefaultMaxRouteReplans
+	default:
+		return configured
+	}
+}
+
+// shouldReplanRoute reports whether a failed route is worth replanning. A
+// cancelled caller is not; nor is a ship parked mid-transit on a lost arrival
+// event (the caller retries that) or a refuel that already exhausted its own
+// retries and alternate stop (callers park on it).
+func shouldReplanRoute(ctx context.Context, err error) bool {
+	if ctx.Err() != nil || errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+		return false
+	}
+	var arrivalErr *ship.ErrArrivalWaitExhausted
+	if errors.As(err, &arrivalErr) {
+		return false
+	}
+	var refuelErr *ship.ErrRefuelUnrecoverable
+	return !errors.As(err, &refuelErr)
 }
 
 // tryCrossSystemNavigate handles a destination in a DIFFERENT system than the ship
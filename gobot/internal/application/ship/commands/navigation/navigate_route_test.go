@@ -1,8 +1,12 @@
 package navigation
 
 import (
+	"context"
+	"errors"
+	"fmt"
 	"testing"
 
+	"github.com/andrescamacho/spacetraders-go/internal/application/ship"
 	"github.com/andrescamacho/spacetraders-go/internal/domain/shared"
 )
 
@@ -81,3 +85,42 @@ func TestShouldForceRefreshWaypoints(t *testing.T) {
 		})
 	}
 }
+
+// TestShouldReplanRoute pins which segment failures earn a fresh route from
+// the ship's current position: an ordinary navigate failure does; a cancelled
+// caller, a ship parked mid-transit and an exhausted refuel do not.
+func TestShouldReplanRoute(t *testing.T) {
+	live := context.Background()
+	cancelled, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	tests := []struct {
+		name string
+		ctx  context.Context
+		err  error
+		want bool
+	}{
+		{"insufficient fuel", live, errors.New("failed to navigate: insufficient fuel"), true},
+		{"caller cancelled", cancelled, errors.New("failed to navigate: boom"), false},
+		{"wrapped cancellation", live, fmt.Errorf("route cancelled: %w", context.Canceled), false},
+		{"arrival wait exhausted", live, fmt.Errorf("segment: %w", &ship.ErrArrivalWaitExhausted{ShipSymbol: "S-1"}), false},
+		{"refuel unrecoverable", live, &ship.ErrRefuelUnrecoverable{ShipSymbol: "S-1", Cause: errors.New("500")}, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := shouldReplanRoute(tt.ctx, tt.err); got != tt.want {
+				t.Errorf("shouldReplanRoute(%v) = %v, want %v", tt.err, got, tt.want)
+			}
+		})
+	}
+
+	if got := resolveMaxReplans(0); got != DefaultMaxRouteReplans {
+		t.Errorf("resolveMaxReplans(0) = %d, want the default %d", got, DefaultMaxRouteReplans)
+	}
+	if got := resolveMaxReplans(-1); got != 0 {
+		t.Errorf("resolveMaxReplans(-1) = %d, want 0 (disabled)", got)
+	}
+	if got := resolveMaxReplans(5); got != 5 {
+		t.Errorf("resolveMaxReplans(5) = %d, want 5", got)
+	}
+}
@@ -0,0 +1,264 @@
+package navigation
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"github.com/andrescamacho/spacetraders-go/internal/application/common"
+	"github.com/andrescamacho/spacetraders-go/internal/application/ship/types"
+	domainNavigation "github.com/andrescamacho/spacetraders-go/internal/domain/navigation"
+	"github.com/andrescamacho/spacetraders-go/internal/domain/shared"
+	"github.com/andrescamacho/spacetraders-go/internal/domain/system"
+)
+
+// FuelStationEnricher resolves a system graph into waypoints with HasFuel set.
+// Satisfied by *WaypointEnricher; narrowed so the rescue is testable with a fake.
+type FuelStationEnricher interface {
+	EnrichGraphWaypoints(ctx context.Context, graph *system.NavigationGraph, systemSymbol string) (map[string]*shared.Waypoint, error)
+}
+
+// StrandedRouteRunner flies a planned route: orbit, set the segment flight mode,
+// navigate, wait for arrival and honor planned refuels. Satisfied by *RouteExecutor.
+type StrandedRouteRunner interface {
+	ExecuteRoute(ctx context.Context, route *domainNavigation.Route, ship *domainNavigation.Ship, playerID shared.PlayerID) error
+}
+
+// StrandedFuelDonor puts at least fuel units into a dry ship's tank from FUEL
+// carried by another hull at its waypoint, returning the donor's symbol.
+// Satisfied by the tanker package's FieldFuelDonor; declared here because
+// that package already imports this one.
+type StrandedFuelDonor interface {
+	DonateFuel(ctx context.Context, ship *domainNavigation.Ship, playerID shared.PlayerID, fuel int) (string, error)
+}
+
+// RescueStrandedShipCommand drifts a ship that ran dry at a fuel-less waypoint
+// to the nearest fuel market, refuels it there and restores the flight mode it
+// had before the rescue. A tank too dry to drift is first topped up from a
+// hull carrying FUEL at the same waypoint, when a donor is configured. Sent by the daemon's health monitor for idle ships it
+// detects as stranded.
+type RescueStrandedShipCommand struct {
+	ShipSymbol string
+	PlayerID   shared.PlayerID
+}
+
+// RescueStrandedShipResponse reports where the ship was taken.
+type RescueStrandedShipResponse struct {
+	Status        string // "rescued", "not_stranded"
+	FuelStation   string
+	FuelRemaining int
+	FlightMode    string // the flight mode the ship was left in
+}
+
+// RescueStrandedShipHandler plans a single DRIFT hop to the closest fuel
+// station and hands it to the route executor with a planned refuel on arrival,
+// so the flight, arrival wait and refuel share the hardened navigation path.
+type RescueStrandedShipHandler struct {
+	shipRepo      domainNavigation.ShipRepository
+	graphProvider system.ISystemGraphProvider
+	enricher      FuelStationEnricher
+	routeRunner   StrandedRouteRunner
+	mediator      common.Mediator
+	fuelDonor     StrandedFuelDonor
+}
+
+// NewRescueStrandedShipHandler creates a new stranded ship rescue handler
+func NewRescueStrandedShipHandler(
+	shipRepo domainNavigation.ShipRepository,
+	graphProvider system.ISystemGraphProvider,
+	enricher FuelStationEnricher,
+	routeRunner StrandedRouteRunner,
+	mediator common.Mediator,
+) *RescueStrandedShipHandler {
+	return &RescueStrandedShipHandler{
+		shipRepo:      shipRepo,
+		graphProvider: graphProvider,
+		enricher:      enricher,
+		routeRunner:   routeRunner,
+		mediator:      mediator,
+	}
+}
+
+// SetFuelDonor lets the rescue top up a ship too dry to drift. nil (the
+// default) fails such a rescue with the fuel it lacks.
+func (h *RescueStrandedShipHandler) SetFuelDonor(donor StrandedFuelDonor) {
+	h.fuelDonor = donor
+}
+
+// Handle executes the rescue stranded ship command
+func (h *RescueStrandedShipHandler) Handle(ctx context.Context, request common.Request) (common.Response, error) {
+	cmd, ok := request.(*RescueStrandedShipCommand)
+	if !ok {
+		return nil, fmt.Errorf("invalid request type")
+	}
+
+	logger := common.LoggerFromContext(ctx)
+
+	ship, err := h.shipRepo.FindBySymbol(ctx, cmd.ShipSymbol, cmd.PlayerID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get ship: %w", err)
+	}
+
+	origin := ship.CurrentLocation()
+	if ship.Fuel().Capacity == 0 || ship.IsInTransit() || origin.HasFuel {
+		return &RescueStrandedShipResponse{
+			Status:        "not_stranded",
+			FuelRemaining: ship.Fuel().Current,
+			FlightMode:    ship.FlightMode(),
+		}, nil
+	}
+
+	station, err := h.nearestFuelStation(ctx, ship, cmd.PlayerID)
+	if err != nil {
+		return nil, err
+	}
+
+	// DRIFT's fuel cost floors at one unit and the API rejects a navigate the
+	// tank cannot pay for (4203), so a hull drained to exactly zero cannot be
+	// drifted out until another hull hands it FUEL.
+	distance := origin.DistanceTo(station)
+	driftCost := shared.FlightModeDrift.FuelCost(distance)
+	if ship.Fuel().Current < driftCost {
+		ship, err = h.topUpFromDonor(ctx, ship, cmd.PlayerID, driftCost)
+		if err != nil {
+			return nil, fmt.Errorf("ship %s cannot drift from %s to fuel at %s: have %d fuel, need %d: %w",
+				cmd.ShipSymbol, origin.Symbol, station.Symbol, ship.Fuel().Current, driftCost, err)
+		}
+	}
+
+	originalMode := parseShipFlightMode(ship.FlightMode())
+
+	logger.Log("WARNING", "Rescuing stranded ship by drifting to nearest fuel station", map[string]interface{}{
+		"ship_symbol":   ship.ShipSymbol(),
+		"action":        "stranded_rescue",
+		"from":          origin.Symbol,
+		"fuel_station":  station.Symbol,
+		"distance":      distance,
+		"fuel_current":  ship.Fuel().Current,
+		"original_mode": originalMode.Name(),
+	})
+
+	segment := domainNavigation.NewRouteSegment(
+		origin,
+		station,
+		distance,
+		driftCost,
+		shared.FlightModeDrift.TravelTime(distance, ship.EngineSpeed()),
+		shared.FlightModeDrift,
+		true, // refuel on arrival
+	)
+	route, err := domainNavigation.NewRoute(
+		fmt.Sprintf("%s_stranded_rescue", ship.ShipSymbol()),
+		ship.ShipSymbol(),
+		cmd.PlayerID.Value(),
+		[]*domainNavigation.RouteSegment{segment},
+		ship.FuelCapacity(),
+		false,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create rescue route: %w", err)
+	}
+
+	if err := h.routeRunner.ExecuteRoute(ctx, route, ship, cmd.PlayerID); err != nil {
+		return nil, fmt.Errorf("failed to drift %s to %s: %w", ship.ShipSymbol(), station.Symbol, err)
+	}
+
+	// The ship is safe and fuelled at this point; a failed mode restore only
+	// leaves it slow, so it is logged rather than failing the rescue.
+	finalMode := shared.FlightModeDrift
+	if originalMode != shared.FlightModeDrift {
+		if _, err := h.mediator.Send(ctx, &types.SetFlightModeCommand{
+			Ship:     ship,
+			PlayerID: cmd.PlayerID,
+			Mode:     originalMode,
+		}); err != nil {
+			logger.Log("WARNING", "Failed to restore flight mode after stranded rescue", map[string]interface{}{
+				"ship_symbol": ship.ShipSymbol(),
+				"action":      "stranded_rescue_restore_mode",
+				"mode":        originalMode.Name(),
+				"error":       err.Error(),
+			})
+		} else {
+			finalMode = originalMode
+		}
+	}
+
+	return &RescueStrandedShipResponse{
+		Status:        "rescued",
+		FuelStation:   station.Symbol,
+		FuelRemaining: ship.Fuel().Current,
+		FlightMode:    finalMode.Name(),
+	}, nil
+}
+
+// topUpFromDonor asks the fuel donor for the drift cost and reloads the ship
+// to see the tank it left. On failure the ship passed in is returned for the
+// error message.
+func (h *RescueStrandedShipHandler) topUpFromDonor(ctx context.Context, ship *domainNavigation.Ship, playerID shared.PlayerID, driftCost int) (*domainNavigation.Ship, error) {
+	if h.fuelDonor == nil {
+		return ship, fmt.Errorf("no fuel donor configured")
+	}
+	donor, err := h.fuelDonor.DonateFuel(ctx, ship, playerID, driftCost-ship.Fuel().Current)
+	if err != nil {
+		return ship, err
+	}
+	refuelled, err := h.shipRepo.FindBySymbol(ctx, ship.ShipSymbol(), playerID)
+	if err != nil {
+		return ship, fmt.Errorf("failed to reload ship after fuel from %s: %w", donor, err)
+	}
+	if refuelled.Fuel().Current < driftCost {
+		return refuelled, fmt.Errorf("%s handed over too little fuel", donor)
+	}
+
+	common.LoggerFromContext(ctx).Log("INFO", "Topped up stranded ship from another hull", map[string]interface{}{
+		"ship_symbol":  ship.ShipSymbol(),
+		"action":       "stranded_rescue_fuel_donor",
+		"donor":        donor,
+		"fuel_current": refuelled.Fuel().Current,
+	})
+	return refuelled, nil
+}
+
+// nearestFuelStation returns the closest waypoint in the ship's system that
+// sells fuel, measured from the ship's current position.
+func (h *RescueStrandedShipHandler) nearestFuelStation(ctx context.Context, ship *domainNavigation.Ship, playerID shared.PlayerID) (*shared.Waypoint, error) {
+	origin := ship.CurrentLocation()
+	graphResult, err := h.graphProvider.GetGraph(ctx, origin.SystemSymbol, false, playerID.Value())
+	if err != nil {
+		return nil, fmt.Errorf("failed to get system graph: %w", err)
+	}
+	waypoints, err := h.enricher.EnrichGraphWaypoints(ctx, graphResult.Graph, origin.SystemSymbol)
+	if err != nil {
+		return nil, fmt.Errorf("failed to enrich waypoints: %w", err)
+	}
+
+	var nearest *shared.Waypoint
+	best := math.MaxFloat64
+	for _, wp := range waypoints {
+		if !wp.HasFuel || wp.Symbol == origin.Symbol {
+			continue
+		}
+		d := origin.DistanceTo(wp)
+		// Ties break on symbol so repeated rescues pick the same station.
+		if d < best || (d == best && wp.Symbol < nearest.Symbol) {
+			nearest, best = wp, d
+		}
+	}
+	if nearest == nil {
+		return nil, fmt.Errorf("no fuel station found in system %s", origin.SystemSymbol)
+	}
+	return nearest, nil
+}
+
+func parseShipFlightMode(mode string) shared.FlightMode {
+	switch mode {
+	case "BURN":
+		return shared.FlightModeBurn
+	case "DRIFT":
+		return shared.FlightModeDrift
+	case "STEALTH":
+		return shared.FlightModeStealth
+	default:
+		return shared.FlightModeCruise
+	}
+}
@@ -0,0 +1,195 @@
+package navigation
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/andrescamacho/spacetraders-go/internal/application/common"
+	"github.com/andrescamacho/spacetraders-go/internal/application/ship/types"
+	domainNavigation "github.com/andrescamacho/spacetraders-go/internal/domain/navigation"
+	"github.com/andrescamacho/spacetraders-go/internal/domain/shared"
+	"github.com/andrescamacho/spacetraders-go/internal/domain/system"
+)
+
+type rescueShipRepo struct {
+	domainNavigation.ShipRepository
+	ship *domainNavigation.Ship
+}
+
+func (r *rescueShipRepo) FindBySymbol(_ context.Context, _ string, _ shared.PlayerID) (*domainNavigation.Ship, error) {
+	return r.ship, nil
+}
+
+type rescueGraphProvider struct{}
+
+func (rescueGraphProvider) GetGraph(_ context.Context, systemSymbol string, _ bool, _ int) (*system.GraphLoadResult, error) {
+	return &system.GraphLoadResult{Graph: &system.NavigationGraph{SystemSymbol: systemSymbol}}, nil
+}
+
+type rescueEnricher struct {
+	waypoints map[string]*shared.Waypoint
+}
+
+func (e *rescueEnricher) EnrichGraphWaypoints(_ context.Context, _ *system.NavigationGraph, _ string) (map[string]*shared.Waypoint, error) {
+	return e.waypoints, nil
+}
+
+// rescueRouteRunner stands in for the route executor: it lands the ship at the
+// segment destination and refuels it when the segment asks for it.
+type rescueRouteRunner struct {
+	routes []*domainNavigation.Route
+}
+
+func (r *rescueRouteRunner) ExecuteRoute(_ context.Context, route *domainNavigation.Route, ship *domainNavigation.Ship, _ shared.PlayerID) error {
+	r.routes = append(r.routes, route)
+	for _, seg := range route.Segments() {
+		ship.SetFlightMode(seg.FlightMode.Name())
+		ship.SetLocation(seg.ToWaypoint)
+		if err := ship.UpdateFuelFromAPI(ship.Fuel().Current-seg.FuelRequired, ship.Fuel().Capacity); err != nil {
+			return err
+		}
+		if seg.RequiresRefuel {
+			if _, err := ship.RefuelToFull(); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+type rescueMediator struct {
+	common.Mediator
+	sent []common.Request
+}
+
+func (m *rescueMediator) Send(_ context.Context, request common.Request) (common.Response, error) {
+	m.sent = append(m.sent, request)
+	if cmd, ok := request.(*types.SetFlightModeCommand); ok {
+		cmd.Ship.SetFlightMode(cmd.Mode.Name())
+	}
+	return &types.SetFlightModeResponse{Status: "updated"}, nil
+}
+
+func newStrandedShip(t *testing.T, location *shared.Waypoint, fuel int) *domainNavigation.Ship {
+	t.Helper()
+	tank, err := shared.NewFuel(fuel, 400)
+	require.NoError(t, err)
+	cargo, err := shared.NewCargo(40, 0, nil)
+	require.NoError(t, err)
+	ship, err := domainNavigation.NewShip(
+		"SHIP-1", shared.MustNewPlayerID(1), location, tank, 400, 40, cargo,
+		9, "FRAME_HAULER", "HAULER", nil, domainNavigation.NavStatusInOrbit,
+	)
+	require.NoError(t, err)
+	ship.SetFlightMode("BURN")
+	return ship
+}
+
+func rescueWaypoint(t *testing.T, symbol string, x, y float64, hasFuel bool) *shared.Waypoint {
+	t.Helper()
+	wp, err := shared.NewWaypoint(symbol, x, y)
+	require.NoError(t, err)
+	wp.HasFuel = hasFuel
+	return wp
+}
+
+func newRescueHandler(ship *domainNavigation.Ship, waypoints ...*shared.Waypoint) (*RescueStrandedShipHandler, *rescueRouteRunner, *rescueMediator) {
+	byName := make(map[string]*shared.Waypoint, len(waypoints))
+	for _, wp := range waypoints {
+		byName[wp.Symbol] = wp
+	}
+	runner := &rescueRouteRunner{}
+	med := &rescueMediator{}
+	handler := NewRescueStrandedShipHandler(
+		&rescueShipRepo{ship: ship}, rescueGraphProvider{}, &rescueEnricher{waypoints: byName}, runner, med,
+	)
+	return handler, runner, med
+}
+
+// A dry ship drifts a single hop to the NEAREST fuel station with a planned
+// refuel on arrival, then gets its original flight mode back.
+func TestRescueStrandedShip_DriftsToNearestFuelAndRestoresMode(t *testing.T) {
+	origin := rescueWaypoint(t, "X1-RS-A1", 0, 0, false)
+	near := rescueWaypoint(t, "X1-RS-B1", 30, 40, true)
+	far := rescueWaypoint(t, "X1-RS-C1", 200, 0, true)
+	ship := newStrandedShip(t, origin, 1)
+	handler, runner, med := newRescueHandler(ship, origin, near, far)
+
+	resp, err := handler.Handle(context.Background(), &RescueStrandedShipCommand{
+		ShipSymbol: "SHIP-1", PlayerID: shared.MustNewPlayerID(1),
+	})
+	require.NoError(t, err)
+
+	require.Len(t, runner.routes, 1)
+	segments := runner.routes[0].Segments()
+	require.Len(t, segments, 1)
+	require.Equal(t, "X1-RS-B1", segments[0].ToWaypoint.Symbol)
+	require.Equal(t, shared.FlightModeDrift, segments[0].FlightMode)
+	require.True(t, segments[0].RequiresRefuel)
+
+	require.Len(t, med.sent, 1)
+	restore := med.sent[0].(*types.SetFlightModeCommand)
+	require.Equal(t, shared.FlightModeBurn, restore.Mode)
+
+	out := resp.(*RescueStrandedShipResponse)
+	require.Equal(t, "rescued", out.Status)
+	require.Equal(t, "X1-RS-B1", out.FuelStation)
+	require.Equal(t, 400, out.FuelRemaining)
+	require.Equal(t, "BURN", out.FlightMode)
+}
+
+// DRIFT still costs one unit, so a tank at exactly zero fails with the reason
+// rather than sending a navigate the API would reject.
+func TestRescueStrandedShip_EmptyTankCannotDrift(t *testing.T) {
+	origin := rescueWaypoint(t, "X1-RS-A1", 0, 0, false)
+	station := rescueWaypoint(t, "X1-RS-B1", 30, 40, true)
+	handler, runner, _ := newRescueHandler(newStrandedShip(t, origin, 0), origin, station)
+
+	_, err := handler.Handle(context.Background(), &RescueStrandedShipCommand{
+		ShipSymbol: "SHIP-1", PlayerID: shared.MustNewPlayerID(1),
+	})
+	require.ErrorContains(t, err, "have 0 fuel, need 1")
+	require.Empty(t, runner.routes)
+}
+
+func TestRescueStrandedShip_ShipAtFuelStationIsNotStranded(t *testing.T) {
+	origin := rescueWaypoint(t, "X1-RS-A1", 0, 0, true)
+	handler, runner, _ := newRescueHandler(newStrandedShip(t, origin, 0), origin)
+
+	resp, err := handler.Handle(context.Background(), &RescueStrandedShipCommand{
+		ShipSymbol: "SHIP-1", PlayerID: shared.MustNewPlayerID(1),
+	})
+	require.NoError(t, err)
+	require.Equal(t, "not_stranded", resp.(*RescueStrandedShipResponse).Status)
+	require.Empty(t, runner.routes)
+}
+
+// rescueFuelDonor tops the ship's tank up by the fuel asked for.
+type rescueFuelDonor struct {
+	asked int
+}
+
+func (d *rescueFuelDonor) DonateFuel(_ context.Context, ship *domainNavigation.Ship, _ shared.PlayerID, fuel int) (string, error) {
+	d.asked = fuel
+	return "TANKER-1", ship.UpdateFuelFromAPI(ship.Fuel().Current+100, ship.Fuel().Capacity)
+}
+
+// With a donor configured, a tank at zero is topped up from another hull and
+// the drift goes ahead.
+func TestRescueStrandedShip_EmptyTankTakesFuelFromDonor(t *testing.T) {
+	origin := rescueWaypoint(t, "X1-RS-A1", 0, 0, false)
+	station := rescueWaypoint(t, "X1-RS-B1", 30, 40, true)
+	handler, runner, _ := newRescueHandler(newStrandedShip(t, origin, 0), origin, station)
+	donor := &rescueFuelDonor{}
+	handler.SetFuelDonor(donor)
+
+	resp, err := handler.Handle(context.Background(), &RescueStrandedShipCommand{
+		ShipSymbol: "SHIP-1", PlayerID: shared.MustNewPlayerID(1),
+	})
+	require.NoError(t, err)
+	require.Equal(t, 1, donor.asked)
+	require.Len(t, runner.routes, 1)
+	require.Equal(t, "rescued", resp.(*RescueStrandedShipResponse).Status)
+}
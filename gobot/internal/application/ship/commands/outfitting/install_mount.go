@@ -0,0 +1,82 @@
+package outfitting
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/andrescamacho/spacetraders-go/internal/domain/navigation"
+	"github.com/andrescamacho/spacetraders-go/internal/domain/ports"
+)
+
+// InstallMountCommand installs a mount (which must be in the ship's cargo)
+// onto the ship. It runs through the same claim, fee-floor and persist flow as
+// a module install.
+type InstallMountCommand struct {
+	ShipSymbol  string // Required: ship to install onto
+	MountSymbol string // Required: mount symbol, e.g. MOUNT_SURVEYOR_I
+	PlayerID    *int   // Optional: player ID
+	AgentSymbol string // Optional: agent symbol
+}
+
+// InstallMountResponse is the result of a mount install.
+type InstallMountResponse struct {
+	Success     bool
+	ShipSymbol  string
+	MountSymbol string
+	Fee         int      // shipyard modification fee charged
+	Mounts      []string // ship's mounts AFTER the install
+	Message     string
+}
+
+func (h *OutfittingHandler) handleInstallMount(ctx context.Context, cmd *InstallMountCommand) (*InstallMountResponse, error) {
+	if cmd.ShipSymbol == "" {
+		return nil, fmt.Errorf("ship_symbol is required")
+	}
+	if cmd.MountSymbol == "" {
+		return nil, fmt.Errorf("mount_symbol is required")
+	}
+
+	playerID, err := h.playerResolver.ResolvePlayerID(ctx, cmd.PlayerID, cmd.AgentSymbol)
+	if err != nil {
+		return nil, err
+	}
+
+	var mounts []string
+	outcome, err := h.modifyModule(
+		ctx,
+		"install",
+		cmd.ShipSymbol,
+		cmd.MountSymbol,
+		playerID,
+		func(ship *navigation.Ship) error {
+			if ship.Cargo() == nil || ship.Cargo().GetItemUnits(cmd.MountSymbol) < 1 {
+				return fmt.Errorf("mount %s not in cargo on %s — buy it first", cmd.MountSymbol, cmd.ShipSymbol)
+			}
+			return nil
+		},
+		func(ctx context.Context, token string) (*ports.ModuleModificationResult, error) {
+			result, err := h.apiClient.InstallShipMount(ctx, cmd.ShipSymbol, cmd.MountSymbol, token)
+			if err != nil {
+				return nil, err
+			}
+			mounts = result.Mounts
+			return &ports.ModuleModificationResult{
+				Fee:           result.Fee,
+				CargoCapacity: result.CargoCapacity,
+				AgentCredits:  result.AgentCredits,
+			}, nil
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &InstallMountResponse{
+		Success:     true,
+		ShipSymbol:  cmd.ShipSymbol,
+		MountSymbol: cmd.MountSymbol,
+		Fee:         outcome.Fee,
+		Mounts:      mounts,
+		Message:     fmt.Sprintf("Installed %s on %s (fee %d)", cmd.MountSymbol, cmd.ShipSymbol, outcome.Fee),
+	}, nil
+}
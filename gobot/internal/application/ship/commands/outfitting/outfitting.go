@@ -46,9 +46,10 @@ type ContainerRepository interface {
 	Remove(ctx context.Context, containerID string, playerID int) error
 }
 
-// OutfittingHandler serves InstallModule, RemoveModule and ListShipModules. A
-// single handler backs all three (registered against each request type) because
-// they share the ship-outfitting deps and claim/persist machinery.
+// OutfittingHandler serves InstallModule, RemoveModule, InstallMount and
+// ListShipModules. A single handler backs them all (registered against each
+// request type) because they share the ship-outfitting deps and claim/persist
+// machinery.
 type OutfittingHandler struct {
 	shipRepo       navigation.ShipRepository
 	playerRepo     player.PlayerRepository
@@ -88,6 +89,8 @@ func (h *OutfittingHandler) Handle(ctx context.Context, request common.Request)
 		return h.handleInstall(ctx, cmd)
 	case *RemoveModuleCommand:
 		return h.handleRemove(ctx, cmd)
+	case *InstallMountCommand:
+		return h.handleInstallMount(ctx, cmd)
 	case *ListShipModulesQuery:
 		return h.handleList(ctx, cmd)
 	default:
@@ -276,7 +279,8 @@ func (h *OutfittingHandler) floorGuardBreached(ctx context.Context, ship *naviga
 // Skips the write when the hull is already idle (changed=false), so no spurious
 // version bump. Runs on a cancellation-proof context so release survives even if
 // the RPC's context was cancelled (RULING #2). Best-effort: the daemon's startup
-// ReleaseAllActive sweep is the backstop.
+// rehydration, which releases the hulls of containers it does not resume, is
+// the backstop.
 func (h *OutfittingHandler) releaseClaim(shipSymbol string, playerID shared.PlayerID, reason string) {
 	ctx, cancel := context.WithTimeout(context.Background(), releaseContextTimeout)
 	defer cancel()
@@ -20,7 +20,7 @@ import (
 
 // outfitFakeAPIClient stubs only the APIClient methods the outfitting op calls:
 // GetShipyard/GetAgent (floor gate), DockShip (dock), Install/RemoveShipModule
-// (the modification), GetShip (SyncShipFromAPI persist) and GetShipModules
+// and InstallShipMount (the modification), GetShip (SyncShipFromAPI persist) and GetShipModules
 // (list). Every other method stays nil via the embedded interface. Call
 // counters let tests assert the guard/claim ordering (e.g. a refused claim or a
 // floor breach must never reach the install API).
@@ -35,10 +35,12 @@ type outfitFakeAPIClient struct {
 	installResult *ports.ModuleModificationResult
 	installErr    error
 	removeResult  *ports.ModuleModificationResult
+	mountResult   *ports.MountInstallResult
 	modules       []ports.ModuleInfo
 
 	installCalls int
 	removeCalls  int
+	mountCalls   int
 	dockCalls    int
 }
 
@@ -73,6 +75,11 @@ func (f *outfitFakeAPIClient) InstallShipModule(_ context.Context, _, _, _ strin
 	return f.installResult, nil
 }
 
+func (f *outfitFakeAPIClient) InstallShipMount(_ context.Context, _, _, _ string) (*ports.MountInstallResult, error) {
+	f.mountCalls++
+	return f.mountResult, nil
+}
+
 func (f *outfitFakeAPIClient) RemoveShipModule(_ context.Context, _, _, _ string) (*ports.ModuleModificationResult, error) {
 	f.removeCalls++
 	return f.removeResult, nil
@@ -203,6 +210,42 @@ func TestInstallModule_ModuleNotInCargo_HonestError_ReleasesClaim(t *testing.T)
 	require.Zero(t, containerCount(t, db, pid))
 }
 
+// TestInstallMount_HappyPath runs a mount through the module install flow: the
+// mount must be in cargo, and the response reports the ship's mounts after it.
+func TestInstallMount_HappyPath_ReportsMountsAndReleasesClaim(t *testing.T) {
+	fake := &outfitFakeAPIClient{
+		shipData:    &navigation.ShipData{Symbol: "SHIP-1", Location: "X1-JP61-A1", NavStatus: "DOCKED", CargoCapacity: 40, EngineSpeed: 10, FrameSymbol: "FRAME_MINER", Role: "EXCAVATOR"},
+		shipyard:    &ports.ShipyardData{Symbol: "X1-JP61-A1", ModificationFee: 1800},
+		agent:       &player.AgentData{Credits: 800000},
+		mountResult: &ports.MountInstallResult{Fee: 1800, CargoCapacity: 40, Mounts: []string{"MOUNT_MINING_LASER_I", "MOUNT_SURVEYOR_I"}},
+	}
+	handler, db, pid := newOutfitHarness(t, fake)
+
+	require.NoError(t, db.Create(&persistence.ShipModel{
+		ShipSymbol: "SHIP-1", PlayerID: pid,
+		NavStatus: "DOCKED", LocationSymbol: "X1-JP61-A1", SystemSymbol: "X1-JP61", EngineSpeed: 10,
+		CargoCapacity: 40, CargoUnits: 1,
+		CargoInventory:   `[{"symbol":"MOUNT_SURVEYOR_I","name":"Surveyor I","description":"x","units":1}]`,
+		Modules:          "[]",
+		AssignmentStatus: "idle",
+	}).Error)
+
+	pidInt := pid
+	resp, err := handler.Handle(context.Background(), &InstallMountCommand{ShipSymbol: "SHIP-1", MountSymbol: "MOUNT_SURVEYOR_I", PlayerID: &pidInt})
+	require.NoError(t, err)
+
+	mountResp, ok := resp.(*InstallMountResponse)
+	require.True(t, ok, "expected *InstallMountResponse")
+	require.Equal(t, 1800, mountResp.Fee)
+	require.Contains(t, mountResp.Mounts, "MOUNT_SURVEYOR_I")
+	require.Equal(t, 1, fake.mountCalls)
+	require.Zero(t, fake.installCalls, "a mount must not go through the module install endpoint")
+
+	model := fetchShip(t, db, "SHIP-1")
+	require.Equal(t, "idle", model.AssignmentStatus, "the claim must be released after the op")
+	require.Zero(t, containerCount(t, db, pid))
+}
+
 // TestInstallModule_HullDedicatedToAnotherFleet is the RULING #7 regression: a
 // hull pinned to another fleet must be refused inside the atomic claim, left
 // untouched, and never reach the install API.
@@ -0,0 +1,72 @@
+package tactics
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/andrescamacho/spacetraders-go/internal/application/ship/types"
+	domainNavigation "github.com/andrescamacho/spacetraders-go/internal/domain/navigation"
+	"github.com/andrescamacho/spacetraders-go/internal/domain/shared"
+	"github.com/andrescamacho/spacetraders-go/internal/domain/system"
+)
+
+type recordingDockScanner struct {
+	scans  []string
+	maxAge time.Duration
+}
+
+func (s *recordingDockScanner) ScanAndSaveMarketFresh(_ context.Context, _ uint, waypointSymbol string, maxAge time.Duration) (bool, error) {
+	s.scans = append(s.scans, waypointSymbol)
+	s.maxAge = maxAge
+	return true, nil
+}
+
+type traitWaypointRepo struct {
+	system.WaypointRepository
+	traits map[string][]string
+}
+
+func (r *traitWaypointRepo) FindBySymbol(_ context.Context, symbol, _ string) (*shared.Waypoint, error) {
+	wp, _ := shared.NewWaypoint(symbol, 0, 0)
+	wp.Traits = r.traits[symbol]
+	return wp, nil
+}
+
+func dockWithScan(t *testing.T, status domainNavigation.NavStatus, traits []string) *recordingDockScanner {
+	t.Helper()
+	scanner := &recordingDockScanner{}
+	handler := NewDockShipHandler(&recordingShipRepo{})
+	handler.SetMarketScan(scanner, &traitWaypointRepo{traits: map[string][]string{"X1-AA-1": traits}}, 10*time.Minute)
+
+	_, err := handler.Handle(context.Background(), &types.DockShipCommand{
+		Ship:     newShipInState(t, status),
+		PlayerID: shared.MustNewPlayerID(1),
+	})
+	if err != nil {
+		t.Fatalf("dock: %v", err)
+	}
+	return scanner
+}
+
+// Docking at a marketplace asks the scanner for a refresh gated on the
+// configured age; the scanner itself skips a market that is still fresh.
+func TestDockShip_ScansMarketplaceOnDock(t *testing.T) {
+	scanner := dockWithScan(t, domainNavigation.NavStatusInOrbit, []string{"MARKETPLACE"})
+
+	if len(scanner.scans) != 1 || scanner.scans[0] != "X1-AA-1" {
+		t.Fatalf("expected one scan of X1-AA-1, got %v", scanner.scans)
+	}
+	if scanner.maxAge != 10*time.Minute {
+		t.Fatalf("expected the configured 10m freshness gate, got %s", scanner.maxAge)
+	}
+}
+
+func TestDockShip_NoScanWithoutMarketplaceOrDock(t *testing.T) {
+	if scanner := dockWithScan(t, domainNavigation.NavStatusInOrbit, nil); len(scanner.scans) != 0 {
+		t.Fatalf("expected no scan at a waypoint without a marketplace, got %v", scanner.scans)
+	}
+	if scanner := dockWithScan(t, domainNavigation.NavStatusDocked, []string{"MARKETPLACE"}); len(scanner.scans) != 0 {
+		t.Fatalf("expected no scan when the ship was already docked, got %v", scanner.scans)
+	}
+}
@@ -3,16 +3,29 @@ package tactics
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/andrescamacho/spacetraders-go/internal/application/common"
 	"github.com/andrescamacho/spacetraders-go/internal/application/ship/types"
 	"github.com/andrescamacho/spacetraders-go/internal/domain/navigation"
 	"github.com/andrescamacho/spacetraders-go/internal/domain/shared"
+	"github.com/andrescamacho/spacetraders-go/internal/domain/system"
 )
 
+// DockMarketScanner refreshes a market unless its cached data is younger than
+// maxAge. *ship.MarketScanner satisfies it.
+type DockMarketScanner interface {
+	ScanAndSaveMarketFresh(ctx context.Context, playerID uint, waypointSymbol string, maxAge time.Duration) (bool, error)
+}
+
 // DockShipHandler - Handles dock ship commands
 type DockShipHandler struct {
 	shipRepo navigation.ShipRepository
+
+	// Post-dock market scan (see SetMarketScan); off while marketScanner is nil.
+	marketScanner DockMarketScanner
+	waypointRepo  system.WaypointRepository
+	scanMaxAge    time.Duration
 }
 
 // NewDockShipHandler creates a new dock ship handler
@@ -24,6 +37,21 @@ func NewDockShipHandler(
 	}
 }
 
+// SetMarketScan turns on the opportunistic post-dock market scan: a ship that
+// docks at a marketplace whose data is older than maxAge refreshes it, so
+// prices stay current as a side effect of docking to trade. waypointRepo
+// supplies the MARKETPLACE trait when the ship's cached location lacks it. A
+// nil scanner or non-positive maxAge leaves the scan off.
+func (h *DockShipHandler) SetMarketScan(scanner DockMarketScanner, waypointRepo system.WaypointRepository, maxAge time.Duration) {
+	if scanner == nil || maxAge <= 0 {
+		h.marketScanner = nil
+		return
+	}
+	h.marketScanner = scanner
+	h.waypointRepo = waypointRepo
+	h.scanMaxAge = maxAge
+}
+
 // Handle executes the dock ship command
 func (h *DockShipHandler) Handle(ctx context.Context, request common.Request) (common.Response, error) {
 	cmd, ok := request.(*types.DockShipCommand)
@@ -31,6 +59,7 @@ func (h *DockShipHandler) Handle(ctx context.Context, request common.Request) (c
 		return nil, fmt.Errorf("invalid request type")
 	}
 
+	var docked *navigation.Ship
 	status, err := runStateTransition(ctx, h.shipRepo, cmd, stateTransition{
 		ensure: func(ship *navigation.Ship) (bool, error) {
 			return ship.EnsureDocked()
@@ -39,6 +68,7 @@ func (h *DockShipHandler) Handle(ctx context.Context, request common.Request) (c
 			if err := h.shipRepo.Dock(ctx, ship, playerID); err != nil {
 				return fmt.Errorf("failed to dock ship: %w", err)
 			}
+			docked = ship
 			return nil
 		},
 		doneStatus:    "docked",
@@ -48,5 +78,47 @@ func (h *DockShipHandler) Handle(ctx context.Context, request common.Request) (c
 		return nil, err
 	}
 
+	if docked != nil {
+		h.scanMarketAfterDock(ctx, docked, cmd.PlayerID)
+	}
+
 	return &types.DockShipResponse{Status: status}, nil
 }
+
+// scanMarketAfterDock refreshes the market the ship just docked at when it is
+// stale. Strictly non-fatal: the dock already succeeded, so a failed lookup or
+// scan is logged and dropped.
+func (h *DockShipHandler) scanMarketAfterDock(ctx context.Context, ship *navigation.Ship, playerID shared.PlayerID) {
+	if h.marketScanner == nil {
+		return
+	}
+	location := ship.CurrentLocation()
+	if location == nil || !h.isMarketplace(ctx, location) {
+		return
+	}
+
+	if _, err := h.marketScanner.ScanAndSaveMarketFresh(ctx, uint(playerID.Value()), location.Symbol, h.scanMaxAge); err != nil {
+		common.LoggerFromContext(ctx).Log("WARNING", "Post-dock market scan failed", map[string]interface{}{
+			"ship_symbol": ship.ShipSymbol(),
+			"action":      "dock_market_scan",
+			"waypoint":    location.Symbol,
+			"error":       err.Error(),
+		})
+	}
+}
+
+// isMarketplace reports whether location has a marketplace, consulting the
+// waypoint cache when the ship's location carries no traits.
+func (h *DockShipHandler) isMarketplace(ctx context.Context, location *shared.Waypoint) bool {
+	if location.IsMarketplace() {
+		return true
+	}
+	if h.waypointRepo == nil {
+		return false
+	}
+	waypoint, err := h.waypointRepo.FindBySymbol(ctx, location.Symbol, location.SystemSymbol)
+	if err != nil || waypoint == nil {
+		return false
+	}
+	return waypoint.IsMarketplace()
+}
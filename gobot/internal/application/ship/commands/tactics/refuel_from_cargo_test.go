@@ -0,0 +1,154 @@
+package tactics
+
+import (
+	"context"
+	"testing"
+
+	"github.com/andrescamacho/spacetraders-go/internal/application/ship/types"
+	"github.com/andrescamacho/spacetraders-go/internal/domain/ledger"
+	domainNavigation "github.com/andrescamacho/spacetraders-go/internal/domain/navigation"
+	"github.com/andrescamacho/spacetraders-go/internal/domain/shared"
+)
+
+type cargoRefuelRepo struct {
+	domainNavigation.ShipRepository
+
+	dockCalls   int
+	burnedUnits []int
+}
+
+func (r *cargoRefuelRepo) Dock(_ context.Context, ship *domainNavigation.Ship, _ shared.PlayerID) error {
+	r.dockCalls++
+	_, _ = ship.EnsureDocked()
+	return nil
+}
+
+func (r *cargoRefuelRepo) RefuelFromCargo(_ context.Context, ship *domainNavigation.Ship, _ shared.PlayerID, cargoUnits int) (*domainNavigation.RefuelResult, error) {
+	r.burnedUnits = append(r.burnedUnits, cargoUnits)
+	if err := ship.RemoveCargo(shared.FuelCargoSymbol, cargoUnits); err != nil {
+		return nil, err
+	}
+	if err := ship.Refuel(cargoUnits * shared.FuelPerCargoUnit); err != nil {
+		return nil, err
+	}
+	return &domainNavigation.RefuelResult{
+		FuelAdded:    cargoUnits * shared.FuelPerCargoUnit,
+		FuelCurrent:  ship.Fuel().Current,
+		FuelCapacity: ship.Fuel().Capacity,
+	}, nil
+}
+
+type recordedRemoval struct {
+	good  string
+	units int
+}
+
+type fakeCostBasis struct {
+	ledger.CargoCostBasisRecorder
+
+	removals []recordedRemoval
+}
+
+func (f *fakeCostBasis) RecordRemoval(_ context.Context, _ int, _ string, good string, units int) error {
+	f.removals = append(f.removals, recordedRemoval{good: good, units: units})
+	return nil
+}
+
+func newShipCarryingFuel(t *testing.T, fuelCurrent, fuelUnits int) *domainNavigation.Ship {
+	t.Helper()
+	location, err := shared.NewWaypoint("X1-DEEP-9", 0, 0)
+	if err != nil {
+		t.Fatalf("NewWaypoint: %v", err)
+	}
+	fuel, err := shared.NewFuel(fuelCurrent, 400)
+	if err != nil {
+		t.Fatalf("NewFuel: %v", err)
+	}
+	item, err := shared.NewCargoItem(shared.FuelCargoSymbol, "Fuel", "", fuelUnits)
+	if err != nil {
+		t.Fatalf("NewCargoItem: %v", err)
+	}
+	cargo, err := shared.NewCargo(40, fuelUnits, []*shared.CargoItem{item})
+	if err != nil {
+		t.Fatalf("NewCargo: %v", err)
+	}
+	ship, err := domainNavigation.NewShip(
+		"SHIP-1", shared.MustNewPlayerID(1), location, fuel, 400, 40, cargo,
+		9, "FRAME_HAULER", "HAULER", nil, domainNavigation.NavStatusInOrbit,
+	)
+	if err != nil {
+		t.Fatalf("NewShip: %v", err)
+	}
+	return ship
+}
+
+func TestRefuelShipHandler_FromCargoBurnsWholeUnitsAwayFromAStation(t *testing.T) {
+	ship := newShipCarryingFuel(t, 150, 5)
+	repo := &cargoRefuelRepo{}
+	costBasis := &fakeCostBasis{}
+	handler := NewRefuelShipHandler(repo, nil, nil, nil)
+	handler.SetCostBasisRecorder(costBasis)
+
+	resp, err := handler.Handle(context.Background(), &types.RefuelShipCommand{
+		Ship:      ship,
+		PlayerID:  shared.MustNewPlayerID(1),
+		FromCargo: true,
+	})
+	if err != nil {
+		t.Fatalf("refuel from cargo needs no fuel station, got error: %v", err)
+	}
+
+	// 250 headroom fits two whole cargo units; a third would overfill the tank.
+	if len(repo.burnedUnits) != 1 || repo.burnedUnits[0] != 2 {
+		t.Fatalf("expected one burn of 2 cargo units, got %v", repo.burnedUnits)
+	}
+	out := resp.(*types.RefuelShipResponse)
+	if out.Status != "refueled_from_cargo" || out.CurrentFuel != 350 || out.CreditsCost != 0 {
+		t.Fatalf("unexpected response %+v", out)
+	}
+	if got := ship.Cargo().GetItemUnits(shared.FuelCargoSymbol); got != 3 {
+		t.Fatalf("expected 3 FUEL left in the hold, got %d", got)
+	}
+	if len(costBasis.removals) != 1 || costBasis.removals[0] != (recordedRemoval{good: "FUEL", units: 2}) {
+		t.Fatalf("expected the burned units released from the cost basis, got %v", costBasis.removals)
+	}
+	if repo.dockCalls != 1 {
+		t.Fatalf("expected the ship docked before refueling, got %d dock calls", repo.dockCalls)
+	}
+}
+
+func TestRefuelShipHandler_FromCargoSkipsWhenAUnitWouldOverfill(t *testing.T) {
+	ship := newShipCarryingFuel(t, 350, 5)
+	repo := &cargoRefuelRepo{}
+	handler := NewRefuelShipHandler(repo, nil, nil, nil)
+
+	resp, err := handler.Handle(context.Background(), &types.RefuelShipCommand{
+		Ship:      ship,
+		PlayerID:  shared.MustNewPlayerID(1),
+		FromCargo: true,
+	})
+	if err != nil {
+		t.Fatalf("Handle: %v", err)
+	}
+	if out := resp.(*types.RefuelShipResponse); out.Status != "tank_headroom_below_cargo_unit" {
+		t.Fatalf("expected the headroom skip, got status %q", out.Status)
+	}
+	if len(repo.burnedUnits) != 0 || repo.dockCalls != 0 {
+		t.Fatalf("nothing should be docked or burned, got %d docks, burns %v", repo.dockCalls, repo.burnedUnits)
+	}
+}
+
+func TestCargoFuelUnits(t *testing.T) {
+	ship := newShipCarryingFuel(t, 0, 3)
+	fifty, twoHundredOne := 50, 201
+
+	if got := CargoFuelUnits(ship, nil); got != 3 {
+		t.Fatalf("a full refuel is bounded by the FUEL held, got %d", got)
+	}
+	if got := CargoFuelUnits(ship, &fifty); got != 1 {
+		t.Fatalf("50 fuel rounds up to one cargo unit, got %d", got)
+	}
+	if got := CargoFuelUnits(ship, &twoHundredOne); got != 3 {
+		t.Fatalf("201 fuel rounds up to three cargo units, got %d", got)
+	}
+}
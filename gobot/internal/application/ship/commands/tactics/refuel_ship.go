@@ -10,6 +10,7 @@ import (
 	ledgerCommands "github.com/andrescamacho/spacetraders-go/internal/application/ledger/commands"
 	"github.com/andrescamacho/spacetraders-go/internal/application/logging"
 	"github.com/andrescamacho/spacetraders-go/internal/application/ship/types"
+	"github.com/andrescamacho/spacetraders-go/internal/domain/ledger"
 	"github.com/andrescamacho/spacetraders-go/internal/domain/navigation"
 	"github.com/andrescamacho/spacetraders-go/internal/domain/player"
 	domainPorts "github.com/andrescamacho/spacetraders-go/internal/domain/ports"
@@ -22,6 +23,7 @@ type RefuelShipHandler struct {
 	playerRepo player.PlayerRepository
 	apiClient  domainPorts.APIClient
 	mediator   common.Mediator
+	costBasis  ledger.CargoCostBasisRecorder
 }
 
 // NewRefuelShipHandler creates a new refuel ship handler
@@ -39,6 +41,12 @@ func NewRefuelShipHandler(
 	}
 }
 
+// SetCostBasisRecorder releases FUEL burned from the hold from the ship's cargo
+// cost basis. nil disables the tracking.
+func (h *RefuelShipHandler) SetCostBasisRecorder(recorder ledger.CargoCostBasisRecorder) {
+	h.costBasis = recorder
+}
+
 // Handle executes the refuel ship command
 func (h *RefuelShipHandler) Handle(ctx context.Context, request common.Request) (common.Response, error) {
 	cmd, ok := request.(*types.RefuelShipCommand)
@@ -51,6 +59,10 @@ func (h *RefuelShipHandler) Handle(ctx context.Context, request common.Request)
 		return nil, err
 	}
 
+	if cmd.FromCargo {
+		return h.refuelFromCargo(ctx, ship, cmd)
+	}
+
 	if err := h.validateAtFuelStation(ship); err != nil {
 		return nil, err
 	}
@@ -84,6 +96,60 @@ func (h *RefuelShipHandler) Handle(ctx context.Context, request common.Request)
 	return response, nil
 }
 
+// refuelFromCargo burns the FUEL in the ship's hold. It needs no fuel station and
+// costs nothing, so there is no purchase to meter or ledger entry to write.
+func (h *RefuelShipHandler) refuelFromCargo(ctx context.Context, ship *navigation.Ship, cmd *types.RefuelShipCommand) (*types.RefuelShipResponse, error) {
+	held := ship.Cargo().GetItemUnits(shared.FuelCargoSymbol)
+	if held == 0 {
+		return nil, fmt.Errorf("ship %s carries no FUEL to refuel from", ship.ShipSymbol())
+	}
+	cargoUnits := CargoFuelUnits(ship, cmd.Units)
+	if cargoUnits == 0 {
+		return &types.RefuelShipResponse{
+			Status:       "tank_headroom_below_cargo_unit",
+			CurrentFuel:  ship.Fuel().Current,
+			FuelCapacity: ship.Fuel().Capacity,
+		}, nil
+	}
+
+	if err := h.ensureShipDockedForRefuel(ctx, ship, cmd.PlayerID); err != nil {
+		return nil, err
+	}
+
+	fuelBefore := ship.Fuel().Current
+	result, err := h.shipRepo.RefuelFromCargo(ctx, ship, cmd.PlayerID, cargoUnits)
+	if err != nil {
+		return nil, err
+	}
+
+	if h.costBasis != nil {
+		if err := h.costBasis.RecordRemoval(ctx, cmd.PlayerID.Value(), ship.ShipSymbol(), shared.FuelCargoSymbol, cargoUnits); err != nil {
+			logging.LoggerFromContext(ctx).Log("WARNING", "Failed to update cargo cost basis", map[string]interface{}{
+				"ship_symbol": ship.ShipSymbol(),
+				"good":        shared.FuelCargoSymbol,
+				"units":       cargoUnits,
+				"error":       err.Error(),
+			})
+		}
+	}
+
+	response := h.buildRefuelResponse(ship, fuelBefore, result)
+	response.Status = "refueled_from_cargo"
+	return response, nil
+}
+
+// CargoFuelUnits is how many FUEL cargo units a refuel from the hold burns:
+// whole units that fit the tank's headroom (never overfilling it, since each
+// holds 100 fuel), bounded by the FUEL carried and, when units is set, by the
+// cargo units needed to cover it.
+func CargoFuelUnits(ship *navigation.Ship, units *int) int {
+	n := (ship.Fuel().Capacity - ship.Fuel().Current) / shared.FuelPerCargoUnit
+	if units != nil {
+		n = min(n, (*units+shared.FuelPerCargoUnit-1)/shared.FuelPerCargoUnit)
+	}
+	return max(min(n, ship.Cargo().GetItemUnits(shared.FuelCargoSymbol)), 0)
+}
+
 func (h *RefuelShipHandler) validateAtFuelStation(ship *navigation.Ship) error {
 	if !ship.CurrentLocation().HasFuel {
 		return fmt.Errorf("waypoint does not have fuel station")
@@ -226,6 +292,7 @@ func (h *RefuelShipHandler) recordRefuelTransaction(
 	if opCtx := shared.OperationContextFromContext(ctx); opCtx != nil && opCtx.IsValid() {
 		recordCmd.RelatedEntityType = "container"
 		recordCmd.RelatedEntityID = opCtx.ContainerID
+		recordCmd.ContainerID = opCtx.ContainerID
 		recordCmd.OperationType = opCtx.NormalizedOperationType()
 	} else {
 		// No operation context - mark as manual transaction
@@ -0,0 +1,72 @@
+package ship
+
+import (
+	"context"
+	"sync"
+
+	"github.com/andrescamacho/spacetraders-go/internal/application/common"
+	domainRouting "github.com/andrescamacho/spacetraders-go/internal/domain/routing"
+	"github.com/andrescamacho/spacetraders-go/internal/domain/system"
+)
+
+// DistanceMatrixCache hands RoutePlanner the distance matrix for a system and
+// engine speed, so routing requests stop recomputing pairwise distances. A
+// matrix is loaded from the repository once per daemon, kept in memory, and
+// brought up to date against each request's waypoints: only waypoints that
+// were added or moved are recomputed, and a changed matrix is written back.
+type DistanceMatrixCache struct {
+	repo domainRouting.DistanceMatrixRepository
+
+	mu      sync.Mutex
+	entries map[distanceMatrixKey]*domainRouting.DistanceMatrix
+}
+
+type distanceMatrixKey struct {
+	systemSymbol string
+	engineSpeed  int
+}
+
+// NewDistanceMatrixCache creates a cache backed by repo. A nil repo keeps
+// matrices in memory only.
+func NewDistanceMatrixCache(repo domainRouting.DistanceMatrixRepository) *DistanceMatrixCache {
+	return &DistanceMatrixCache{
+		repo:    repo,
+		entries: make(map[distanceMatrixKey]*domainRouting.DistanceMatrix),
+	}
+}
+
+// Matrix returns the matrix for systemSymbol at engineSpeed, current for
+// waypoints. Storage failures are logged and never fail the route: the matrix
+// is still returned from memory.
+func (c *DistanceMatrixCache) Matrix(ctx context.Context, systemSymbol string, engineSpeed int, waypoints []*system.WaypointData) *domainRouting.DistanceMatrix {
+	key := distanceMatrixKey{systemSymbol: systemSymbol, engineSpeed: engineSpeed}
+	logger := common.LoggerFromContext(ctx)
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	matrix, ok := c.entries[key]
+	if !ok && c.repo != nil {
+		stored, err := c.repo.Get(ctx, systemSymbol, engineSpeed)
+		if err != nil {
+			logger.Log("WARNING", "Distance matrix unreadable; rebuilding it", map[string]interface{}{
+				"action": "distance_matrix_load_failed", "system": systemSymbol, "engine_speed": engineSpeed, "error": err.Error(),
+			})
+		}
+		matrix = stored
+	}
+	if matrix == nil {
+		matrix = domainRouting.RestoreDistanceMatrix(systemSymbol, engineSpeed, nil, nil)
+	}
+
+	updated, changed := matrix.Updated(waypoints)
+	c.entries[key] = updated
+	if changed > 0 && c.repo != nil {
+		if err := c.repo.Save(ctx, updated); err != nil {
+			logger.Log("WARNING", "Failed to persist distance matrix", map[string]interface{}{
+				"action": "distance_matrix_save_failed", "system": systemSymbol, "engine_speed": engineSpeed, "error": err.Error(),
+			})
+		}
+	}
+	return updated
+}
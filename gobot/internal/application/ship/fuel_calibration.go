@@ -0,0 +1,123 @@
+package ship
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/andrescamacho/spacetraders-go/internal/application/common"
+	domainNavigation "github.com/andrescamacho/spacetraders-go/internal/domain/navigation"
+	"github.com/andrescamacho/spacetraders-go/internal/domain/shared"
+)
+
+const (
+	// DefaultFuelCalibrationWindow is how many of a flight mode's most recent
+	// observations a fit reads, so the factors follow current API behaviour.
+	DefaultFuelCalibrationWindow = 200
+
+	// DefaultFuelCalibrationRefitEvery is how many new observations trigger a refit.
+	DefaultFuelCalibrationRefitEvery = 25
+)
+
+// calibratedFlightModes are the modes a fit covers.
+var calibratedFlightModes = []shared.FlightMode{
+	shared.FlightModeCruise,
+	shared.FlightModeDrift,
+	shared.FlightModeBurn,
+	shared.FlightModeStealth,
+}
+
+// FuelCalibrationService records each navigation's predicted vs actual fuel use
+// and periodically refits the per-mode correction factors. It implements
+// navigation.FuelObserver, and navigation.FuelCalibrationSource for the fuel
+// services built over it.
+type FuelCalibrationService struct {
+	repo       domainNavigation.FuelObservationRepository
+	minSamples int
+	window     int
+	refitEvery int
+
+	mu          sync.Mutex
+	sinceRefit  int
+	calibration *domainNavigation.FuelCalibration
+}
+
+// NewFuelCalibrationService creates a calibration service. Non-positive knobs
+// select the defaults.
+func NewFuelCalibrationService(repo domainNavigation.FuelObservationRepository, minSamples, window, refitEvery int) *FuelCalibrationService {
+	if minSamples <= 0 {
+		minSamples = domainNavigation.DefaultFuelCalibrationMinSamples
+	}
+	if window <= 0 {
+		window = DefaultFuelCalibrationWindow
+	}
+	if refitEvery <= 0 {
+		refitEvery = DefaultFuelCalibrationRefitEvery
+	}
+	return &FuelCalibrationService{
+		repo:       repo,
+		minSamples: minSamples,
+		window:     window,
+		refitEvery: refitEvery,
+	}
+}
+
+// ObserveFuel records one observation and refits once refitEvery have
+// accumulated. Failures are logged: calibration must never fail a navigation.
+func (s *FuelCalibrationService) ObserveFuel(ctx context.Context, observation domainNavigation.FuelObservation) {
+	logger := common.LoggerFromContext(ctx)
+	if err := s.repo.Record(ctx, observation); err != nil {
+		logger.Log("WARNING", fmt.Sprintf("Fuel calibration: %v", err), nil)
+		return
+	}
+
+	s.mu.Lock()
+	s.sinceRefit++
+	due := s.sinceRefit >= s.refitEvery
+	if due {
+		s.sinceRefit = 0
+	}
+	s.mu.Unlock()
+
+	if due {
+		if _, err := s.Recalibrate(ctx); err != nil {
+			logger.Log("WARNING", fmt.Sprintf("Fuel calibration refit failed: %v", err), nil)
+		}
+	}
+}
+
+// Recalibrate fits the factors from each mode's most recent observations and
+// makes the result the one Calibration returns.
+func (s *FuelCalibrationService) Recalibrate(ctx context.Context) (*domainNavigation.FuelCalibration, error) {
+	var observations []domainNavigation.FuelObservation
+	for _, mode := range calibratedFlightModes {
+		recent, err := s.repo.FindRecent(ctx, mode, s.window)
+		if err != nil {
+			return nil, err
+		}
+		observations = append(observations, recent...)
+	}
+
+	calibration := domainNavigation.FitFuelCalibration(observations, s.minSamples)
+
+	s.mu.Lock()
+	s.calibration = calibration
+	s.mu.Unlock()
+
+	common.LoggerFromContext(ctx).Log("INFO", "Fuel calibration refit", map[string]interface{}{
+		"action":         "fuel_calibration",
+		"observations":   len(observations),
+		"cruise_factor":  calibration.Factor(shared.FlightModeCruise),
+		"burn_factor":    calibration.Factor(shared.FlightModeBurn),
+		"drift_factor":   calibration.Factor(shared.FlightModeDrift),
+		"stealth_factor": calibration.Factor(shared.FlightModeStealth),
+	})
+	return calibration, nil
+}
+
+// Calibration returns the most recent fit, nil before the first.
+func (s *FuelCalibrationService) Calibration() *domainNavigation.FuelCalibration {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.calibration
+}
@@ -0,0 +1,52 @@
+package ship
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	domainNavigation "github.com/andrescamacho/spacetraders-go/internal/domain/navigation"
+	"github.com/andrescamacho/spacetraders-go/internal/domain/shared"
+)
+
+type memoryFuelObservations struct {
+	byMode map[shared.FlightMode][]domainNavigation.FuelObservation
+}
+
+func (m *memoryFuelObservations) Record(_ context.Context, obs domainNavigation.FuelObservation) error {
+	if m.byMode == nil {
+		m.byMode = make(map[shared.FlightMode][]domainNavigation.FuelObservation)
+	}
+	m.byMode[obs.Mode] = append(m.byMode[obs.Mode], obs)
+	return nil
+}
+
+func (m *memoryFuelObservations) FindRecent(_ context.Context, mode shared.FlightMode, limit int) ([]domainNavigation.FuelObservation, error) {
+	all := m.byMode[mode]
+	var out []domainNavigation.FuelObservation
+	for i := len(all) - 1; i >= 0 && len(out) < limit; i-- {
+		out = append(out, all[i])
+	}
+	return out, nil
+}
+
+// Every refitEvery observations the service refits, and a ShipFuelService
+// built over it picks up the new factors.
+func TestFuelCalibrationService_RefitsAndInstallsCalibration(t *testing.T) {
+	service := NewFuelCalibrationService(&memoryFuelObservations{}, 3, 10, 4)
+	ctx := context.Background()
+
+	for i := 0; i < 3; i++ {
+		service.ObserveFuel(ctx, domainNavigation.FuelObservation{Mode: shared.FlightModeCruise, Predicted: 100, Actual: 120})
+	}
+	require.Nil(t, service.Calibration(), "no refit before refitEvery observations")
+
+	service.ObserveFuel(ctx, domainNavigation.FuelObservation{Mode: shared.FlightModeCruise, Predicted: 100, Actual: 120})
+	require.NotNil(t, service.Calibration())
+	require.InDelta(t, 1.2, service.Calibration().Factor(shared.FlightModeCruise), 1e-9)
+
+	from, _ := shared.NewWaypoint("X1-A", 0, 0)
+	to, _ := shared.NewWaypoint("X1-B", 50, 0)
+	require.Equal(t, 60, domainNavigation.NewShipFuelService(service).CalculateFuelRequired(from, to, shared.FlightModeCruise))
+}
@@ -0,0 +1,90 @@
+package ship
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/andrescamacho/spacetraders-go/internal/domain/market"
+	"github.com/andrescamacho/spacetraders-go/internal/domain/shared"
+)
+
+// fuelGoodSymbol is the trade symbol markets list fuel under
+const fuelGoodSymbol = "FUEL"
+
+// fuelDepotMarkets is the slice of market.MarketRepository the index reads
+type fuelDepotMarkets interface {
+	FindAllMarketsInSystem(ctx context.Context, systemSymbol string, playerID int) ([]string, error)
+	GetMarketData(ctx context.Context, waypointSymbol string, playerID int) (*market.Market, error)
+}
+
+// FuelDepotIndex caches, per system, what each FUEL-selling market charges.
+// RoutePlanner ranks those markets with routing.RankFuelDepots on every plan;
+// the prices themselves change slowly enough that re-reading every market in
+// the system for each route would be wasted queries, so they are held for ttl.
+type FuelDepotIndex struct {
+	mu      sync.Mutex
+	markets fuelDepotMarkets
+	ttl     time.Duration
+	clock   shared.Clock
+	entries map[fuelDepotKey]fuelDepotEntry
+}
+
+type fuelDepotKey struct {
+	playerID     int
+	systemSymbol string
+}
+
+type fuelDepotEntry struct {
+	prices   map[string]int
+	loadedAt time.Time
+}
+
+// NewFuelDepotIndex creates an index that re-reads a system's fuel prices once
+// they are older than ttl. If clock is nil, uses RealClock.
+func NewFuelDepotIndex(markets fuelDepotMarkets, ttl time.Duration, clock shared.Clock) *FuelDepotIndex {
+	if clock == nil {
+		clock = shared.NewRealClock()
+	}
+	return &FuelDepotIndex{
+		markets: markets,
+		ttl:     ttl,
+		clock:   clock,
+		entries: make(map[fuelDepotKey]fuelDepotEntry),
+	}
+}
+
+// FuelPrices returns the FUEL price at each market in the system that sells
+// it, keyed by waypoint symbol. Markets whose data cannot be read are left out.
+func (i *FuelDepotIndex) FuelPrices(ctx context.Context, systemSymbol string, playerID int) (map[string]int, error) {
+	key := fuelDepotKey{playerID: playerID, systemSymbol: systemSymbol}
+	now := i.clock.Now()
+
+	i.mu.Lock()
+	entry, ok := i.entries[key]
+	i.mu.Unlock()
+	if ok && now.Sub(entry.loadedAt) < i.ttl {
+		return entry.prices, nil
+	}
+
+	waypoints, err := i.markets.FindAllMarketsInSystem(ctx, systemSymbol, playerID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list markets in %s: %w", systemSymbol, err)
+	}
+	prices := make(map[string]int)
+	for _, waypointSymbol := range waypoints {
+		data, err := i.markets.GetMarketData(ctx, waypointSymbol, playerID)
+		if err != nil || data == nil {
+			continue
+		}
+		if fuel := data.FindGood(fuelGoodSymbol); fuel != nil && fuel.SellPrice() > 0 {
+			prices[waypointSymbol] = fuel.SellPrice()
+		}
+	}
+
+	i.mu.Lock()
+	i.entries[key] = fuelDepotEntry{prices: prices, loadedAt: now}
+	i.mu.Unlock()
+	return prices, nil
+}
@@ -0,0 +1,174 @@
+package ship
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/andrescamacho/spacetraders-go/internal/domain/market"
+	domainRouting "github.com/andrescamacho/spacetraders-go/internal/domain/routing"
+	"github.com/andrescamacho/spacetraders-go/internal/domain/shared"
+)
+
+// fakeFuelMarkets serves a fixed FUEL price per market and counts reads
+type fakeFuelMarkets struct {
+	prices map[string]int
+	lists  int
+}
+
+func (f *fakeFuelMarkets) FindAllMarketsInSystem(_ context.Context, _ string, _ int) ([]string, error) {
+	f.lists++
+	symbols := make([]string, 0, len(f.prices))
+	for symbol := range f.prices {
+		symbols = append(symbols, symbol)
+	}
+	return symbols, nil
+}
+
+func (f *fakeFuelMarkets) GetMarketData(_ context.Context, waypointSymbol string, _ int) (*market.Market, error) {
+	fuel, err := market.NewTradeGood("FUEL", nil, nil, f.prices[waypointSymbol]/2, f.prices[waypointSymbol], 100, market.TradeTypeExchange)
+	if err != nil {
+		return nil, err
+	}
+	return market.NewMarket(waypointSymbol, []market.TradeGood{*fuel}, time.Now())
+}
+
+// recordingRoutingClient records each PlanRoute request and answers with a
+// single CRUISE hop to the goal. failRestricted rejects any request that
+// withholds a fuel station, standing in for a route the preferred depots
+// cannot carry.
+type recordingRoutingClient struct {
+	domainRouting.RoutingClient
+	requests       []*domainRouting.RouteRequest
+	fuelStations   int
+	failRestricted bool
+}
+
+func (c *recordingRoutingClient) PlanRoute(_ context.Context, req *domainRouting.RouteRequest) (*domainRouting.RouteResponse, error) {
+	c.requests = append(c.requests, req)
+	stations := 0
+	for _, wp := range req.Waypoints {
+		if wp.HasFuel {
+			stations++
+		}
+	}
+	if c.failRestricted && stations < c.fuelStations {
+		return nil, errors.New("no path within fuel capacity")
+	}
+	return &domainRouting.RouteResponse{Steps: []*domainRouting.RouteStepData{{
+		Action:      domainRouting.RouteActionTravel,
+		Waypoint:    req.GoalWaypoint,
+		FuelCost:    10,
+		TimeSeconds: 60,
+		Mode:        "CRUISE",
+	}}}, nil
+}
+
+func fuelDepotTestSystem(t *testing.T) map[string]*shared.Waypoint {
+	waypoints := map[string]*shared.Waypoint{}
+	for _, wp := range []struct {
+		symbol  string
+		x, y    float64
+		hasFuel bool
+	}{
+		{"X1-FD-A1", 0, 0, true},  // start: pricey station
+		{"X1-FD-B2", 10, 0, true}, // cheap and central
+		{"X1-FD-C3", 300, 0, false},
+		{"X1-FD-D4", 20, 0, true}, // pricey station
+	} {
+		w := mustWaypoint(t, wp.symbol, wp.x, wp.y)
+		w.HasFuel = wp.hasFuel
+		waypoints[wp.symbol] = w
+	}
+	return waypoints
+}
+
+func fuelStationsOffered(req *domainRouting.RouteRequest) map[string]bool {
+	offered := map[string]bool{}
+	for _, wp := range req.Waypoints {
+		if wp.HasFuel {
+			offered[wp.Symbol] = true
+		}
+	}
+	return offered
+}
+
+// With a depot index the planner offers only the cheap central depot as a
+// refuel stop, so the routing engine cannot schedule a refuel at the pricey
+// stations.
+func TestRoutePlanner_OffersOnlyPreferredFuelDepots(t *testing.T) {
+	waypoints := fuelDepotTestSystem(t)
+	client := &recordingRoutingClient{fuelStations: 3}
+	planner := NewRoutePlanner(client)
+	planner.SetFuelDepotIndex(NewFuelDepotIndex(&fakeFuelMarkets{prices: map[string]int{
+		"X1-FD-A1": 140, "X1-FD-B2": 70, "X1-FD-D4": 150,
+	}}, time.Minute, nil))
+
+	ship := newExecutorTestShip(t, 100, 400, waypoints["X1-FD-A1"])
+	if _, err := planner.PlanRoute(context.Background(), ship, "X1-FD-C3", waypoints, false); err != nil {
+		t.Fatalf("PlanRoute: %v", err)
+	}
+
+	if len(client.requests) != 1 {
+		t.Fatalf("expected one routing request, got %d", len(client.requests))
+	}
+	offered := fuelStationsOffered(client.requests[0])
+	if len(offered) != 1 || !offered["X1-FD-B2"] {
+		t.Fatalf("only the cheap central depot should be offered, got %v", offered)
+	}
+	if !waypoints["X1-FD-A1"].HasFuel {
+		t.Fatal("restricting the request must not mutate the caller's waypoints")
+	}
+}
+
+// When the preferred depots cannot carry the route, the planner replans over
+// every fuel station rather than failing.
+func TestRoutePlanner_FallsBackToEveryFuelStation(t *testing.T) {
+	waypoints := fuelDepotTestSystem(t)
+	client := &recordingRoutingClient{fuelStations: 3, failRestricted: true}
+	planner := NewRoutePlanner(client)
+	planner.SetFuelDepotIndex(NewFuelDepotIndex(&fakeFuelMarkets{prices: map[string]int{
+		"X1-FD-A1": 140, "X1-FD-B2": 70, "X1-FD-D4": 150,
+	}}, time.Minute, nil))
+
+	ship := newExecutorTestShip(t, 100, 400, waypoints["X1-FD-A1"])
+	if _, err := planner.PlanRoute(context.Background(), ship, "X1-FD-C3", waypoints, false); err != nil {
+		t.Fatalf("PlanRoute: %v", err)
+	}
+
+	if len(client.requests) != 2 {
+		t.Fatalf("expected a restricted attempt and a fallback, got %d requests", len(client.requests))
+	}
+	if offered := fuelStationsOffered(client.requests[1]); len(offered) != 3 {
+		t.Fatalf("fallback should offer every fuel station, got %v", offered)
+	}
+}
+
+func TestFuelDepotIndex_CachesPricesForTTL(t *testing.T) {
+	markets := &fakeFuelMarkets{prices: map[string]int{"X1-FD-A1": 80}}
+	clock := &shared.MockClock{CurrentTime: time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)}
+	index := NewFuelDepotIndex(markets, 10*time.Minute, clock)
+	ctx := context.Background()
+
+	for i := 0; i < 3; i++ {
+		prices, err := index.FuelPrices(ctx, "X1-FD", 1)
+		if err != nil {
+			t.Fatalf("FuelPrices: %v", err)
+		}
+		if prices["X1-FD-A1"] != 80 {
+			t.Fatalf("expected price 80, got %v", prices)
+		}
+	}
+	if markets.lists != 1 {
+		t.Fatalf("prices should be read once within the ttl, read %d times", markets.lists)
+	}
+
+	clock.Advance(10 * time.Minute)
+	if _, err := index.FuelPrices(ctx, "X1-FD", 1); err != nil {
+		t.Fatalf("FuelPrices: %v", err)
+	}
+	if markets.lists != 2 {
+		t.Fatalf("stale prices should be re-read, read %d times", markets.lists)
+	}
+}
@@ -0,0 +1,209 @@
+package ship
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/andrescamacho/spacetraders-go/internal/application/common"
+	"github.com/andrescamacho/spacetraders-go/internal/domain/navigation"
+	"github.com/andrescamacho/spacetraders-go/internal/domain/shared"
+)
+
+// DefaultIdempotencyTTL is how long a keyed ship command's result is kept for
+// replay. It comfortably outlasts a caller's retry loop after a daemon hiccup.
+const DefaultIdempotencyTTL = 30 * time.Minute
+
+// IdempotentRequest identifies what a keyed command asked for, so a key reused
+// for a different ship or destination is rejected instead of replayed.
+type IdempotentRequest struct {
+	ShipSymbol  string
+	Destination string
+}
+
+// IdempotencyCache remembers the results of mutating ship commands by the
+// idempotency key their caller chose. A retried command carrying the same key
+// claims it again: while the first attempt is still running the retry is
+// refused, and once it has finished the original result is replayed, provided
+// the ship is still doing what was asked. A failed attempt releases its key so
+// the retry executes normally.
+//
+// With a store attached (WithStore), finished results are also written through
+// under the cache's scope and reloaded by Load at boot, so a retry that lands
+// after a daemon restart still replays instead of executing twice. In-flight
+// claims are never persisted: an attempt cut short by the restart is gone, and
+// its retry executes.
+type IdempotencyCache struct {
+	mu      sync.Mutex
+	ttl     time.Duration
+	clock   shared.Clock
+	entries map[string]*idempotencyEntry
+
+	store navigation.CommandIdempotencyRepository
+	scope string
+	codec IdempotencyCodec
+}
+
+// IdempotencyCodec turns one command kind's response into the bytes a store
+// keeps and back.
+type IdempotencyCodec interface {
+	Encode(response common.Response) ([]byte, error)
+	Decode(data []byte) (common.Response, error)
+}
+
+type idempotencyEntry struct {
+	request    IdempotentRequest
+	inFlight   bool
+	response   common.Response
+	recordedAt time.Time
+}
+
+// NewIdempotencyCache creates a cache keeping results for ttl (<=0 means
+// DefaultIdempotencyTTL). If clock is nil, uses RealClock.
+func NewIdempotencyCache(ttl time.Duration, clock shared.Clock) *IdempotencyCache {
+	if ttl <= 0 {
+		ttl = DefaultIdempotencyTTL
+	}
+	if clock == nil {
+		clock = shared.NewRealClock()
+	}
+	return &IdempotencyCache{
+		ttl:     ttl,
+		clock:   clock,
+		entries: make(map[string]*idempotencyEntry),
+	}
+}
+
+// WithStore writes finished results through to store under scope, encoded by
+// codec, and returns the cache for chaining. Called once at wiring time,
+// before Load.
+func (c *IdempotencyCache) WithStore(store navigation.CommandIdempotencyRepository, scope string, codec IdempotencyCodec) *IdempotencyCache {
+	c.store = store
+	c.scope = scope
+	c.codec = codec
+	return c
+}
+
+// Load restores the scope's unexpired results from the store and purges the
+// expired ones. It is a no-op without a store.
+func (c *IdempotencyCache) Load(ctx context.Context) error {
+	if c.store == nil {
+		return nil
+	}
+	cutoff := c.clock.Now().Add(-c.ttl)
+	if err := c.store.DeleteBefore(ctx, c.scope, cutoff); err != nil {
+		return fmt.Errorf("failed to purge expired %s idempotency keys: %w", c.scope, err)
+	}
+	records, err := c.store.FindSince(ctx, c.scope, cutoff)
+	if err != nil {
+		return fmt.Errorf("failed to load %s idempotency keys: %w", c.scope, err)
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for _, record := range records {
+		response, err := c.codec.Decode(record.Response)
+		if err != nil {
+			return fmt.Errorf("failed to decode %s idempotency key %q: %w", c.scope, record.Key, err)
+		}
+		c.entries[record.Key] = &idempotencyEntry{
+			request:    IdempotentRequest{ShipSymbol: record.ShipSymbol, Destination: record.Destination},
+			response:   response,
+			recordedAt: record.RecordedAt,
+		}
+	}
+	return nil
+}
+
+// Claim takes key for request. replay is true when a finished attempt's
+// response should be returned as-is: stillHolds is asked whether the ship is
+// still doing what that response reports. Otherwise the key is marked in
+// flight and the caller must execute, then Complete or Release it. A key
+// already in flight, or recorded for a different request, is an error.
+func (c *IdempotencyCache) Claim(key string, request IdempotentRequest, stillHolds func(previous common.Response) bool) (previous common.Response, replay bool, err error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	now := c.clock.Now()
+	c.pruneLocked(now)
+
+	entry, ok := c.entries[key]
+	if ok {
+		if entry.request != request {
+			return nil, false, fmt.Errorf("idempotency key %q was already used for %s -> %s",
+				key, entry.request.ShipSymbol, entry.request.Destination)
+		}
+		if entry.inFlight {
+			return nil, false, fmt.Errorf("a command with idempotency key %q is still running for %s", key, request.ShipSymbol)
+		}
+		if stillHolds == nil || stillHolds(entry.response) {
+			return entry.response, true, nil
+		}
+	}
+	c.entries[key] = &idempotencyEntry{request: request, inFlight: true, recordedAt: now}
+	return nil, false, nil
+}
+
+// Complete records the response of the attempt holding key, writing it
+// through to the store if one is attached. The command has already run by
+// now, so a failed write only costs the replay after a restart and is logged
+// rather than returned.
+func (c *IdempotencyCache) Complete(ctx context.Context, key string, response common.Response) {
+	c.mu.Lock()
+	entry, ok := c.entries[key]
+	if ok {
+		entry.inFlight = false
+		entry.response = response
+		entry.recordedAt = c.clock.Now()
+	}
+	c.mu.Unlock()
+	if !ok || c.store == nil {
+		return
+	}
+
+	data, err := c.codec.Encode(response)
+	if err == nil {
+		err = c.store.Save(ctx, navigation.CommandIdempotencyRecord{
+			Scope:       c.scope,
+			Key:         key,
+			ShipSymbol:  entry.request.ShipSymbol,
+			Destination: entry.request.Destination,
+			Response:    data,
+			RecordedAt:  entry.recordedAt,
+		})
+	}
+	if err != nil {
+		common.LoggerFromContext(ctx).Log("WARNING", "Failed to persist idempotency key", map[string]interface{}{
+			"scope":           c.scope,
+			"idempotency_key": key,
+			"error":           err.Error(),
+		})
+	}
+}
+
+// Release forgets key after a failed attempt, so a retry executes again.
+func (c *IdempotencyCache) Release(ctx context.Context, key string) {
+	c.mu.Lock()
+	delete(c.entries, key)
+	c.mu.Unlock()
+	if c.store == nil {
+		return
+	}
+	if err := c.store.Delete(ctx, c.scope, key); err != nil {
+		common.LoggerFromContext(ctx).Log("WARNING", "Failed to forget idempotency key", map[string]interface{}{
+			"scope":           c.scope,
+			"idempotency_key": key,
+			"error":           err.Error(),
+		})
+	}
+}
+
+// pruneLocked drops finished entries older than the ttl. In-flight entries are
+// kept however old, since their attempt is still running.
+func (c *IdempotencyCache) pruneLocked(now time.Time) {
+	for key, entry := range c.entries {
+		if !entry.inFlight && now.Sub(entry.recordedAt) >= c.ttl {
+			delete(c.entries, key)
+		}
+	}
+}
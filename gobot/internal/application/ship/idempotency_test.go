@@ -0,0 +1,148 @@
+package ship
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/andrescamacho/spacetraders-go/internal/application/common"
+	"github.com/andrescamacho/spacetraders-go/internal/domain/navigation"
+	"github.com/andrescamacho/spacetraders-go/internal/domain/shared"
+)
+
+type idempotencyStubResponse struct{ status string }
+
+func TestIdempotencyCache_ReplaysACompletedKey(t *testing.T) {
+	cache := NewIdempotencyCache(time.Minute, &shared.MockClock{CurrentTime: time.Date(2026, 6, 1, 12, 0, 0, 0, time.UTC)})
+	req := IdempotentRequest{ShipSymbol: "SHIP-1", Destination: "X1-A-B2"}
+
+	if _, replay, err := cache.Claim("k1", req, nil); err != nil || replay {
+		t.Fatalf("first claim must execute, got replay=%v err=%v", replay, err)
+	}
+	if _, _, err := cache.Claim("k1", req, nil); err == nil {
+		t.Fatal("a retry while the first attempt is in flight must be refused")
+	}
+	original := &idempotencyStubResponse{status: "navigating"}
+	cache.Complete(context.Background(), "k1", original)
+
+	previous, replay, err := cache.Claim("k1", req, func(common.Response) bool { return true })
+	if err != nil || !replay || previous != original {
+		t.Fatalf("expected the original response replayed, got %v replay=%v err=%v", previous, replay, err)
+	}
+}
+
+func TestIdempotencyCache_RejectsAKeyReusedForAnotherRequest(t *testing.T) {
+	cache := NewIdempotencyCache(time.Minute, &shared.MockClock{CurrentTime: time.Date(2026, 6, 1, 12, 0, 0, 0, time.UTC)})
+	cache.Claim("k1", IdempotentRequest{ShipSymbol: "SHIP-1", Destination: "X1-A-B2"}, nil)
+	cache.Complete(context.Background(), "k1", &idempotencyStubResponse{})
+
+	if _, _, err := cache.Claim("k1", IdempotentRequest{ShipSymbol: "SHIP-1", Destination: "X1-A-C3"}, nil); err == nil {
+		t.Fatal("expected an error for a key reused with a different destination")
+	}
+}
+
+func TestIdempotencyCache_ReexecutesWhenTheShipMovedOn(t *testing.T) {
+	cache := NewIdempotencyCache(time.Minute, &shared.MockClock{CurrentTime: time.Date(2026, 6, 1, 12, 0, 0, 0, time.UTC)})
+	req := IdempotentRequest{ShipSymbol: "SHIP-1", Destination: "X1-A-B2"}
+	cache.Claim("k1", req, nil)
+	cache.Complete(context.Background(), "k1", &idempotencyStubResponse{})
+
+	if _, replay, err := cache.Claim("k1", req, func(common.Response) bool { return false }); err != nil || replay {
+		t.Fatalf("a ship no longer doing the request re-executes, got replay=%v err=%v", replay, err)
+	}
+}
+
+func TestIdempotencyCache_ReleasedAndExpiredKeysExecuteAgain(t *testing.T) {
+	clock := &shared.MockClock{CurrentTime: time.Date(2026, 6, 1, 12, 0, 0, 0, time.UTC)}
+	cache := NewIdempotencyCache(time.Minute, clock)
+	req := IdempotentRequest{ShipSymbol: "SHIP-1", Destination: "X1-A-B2"}
+
+	cache.Claim("failed", req, nil)
+	cache.Release(context.Background(), "failed")
+	if _, replay, err := cache.Claim("failed", req, nil); err != nil || replay {
+		t.Fatalf("a released key executes again, got replay=%v err=%v", replay, err)
+	}
+
+	cache.Claim("old", req, nil)
+	cache.Complete(context.Background(), "old", &idempotencyStubResponse{})
+	clock.Advance(2 * time.Minute)
+	if _, replay, err := cache.Claim("old", req, nil); err != nil || replay {
+		t.Fatalf("a key past its ttl executes again, got replay=%v err=%v", replay, err)
+	}
+}
+
+type memIdempotencyStore struct {
+	records map[string]navigation.CommandIdempotencyRecord
+}
+
+func (m *memIdempotencyStore) Save(_ context.Context, record navigation.CommandIdempotencyRecord) error {
+	m.records[record.Scope+"/"+record.Key] = record
+	return nil
+}
+
+func (m *memIdempotencyStore) Delete(_ context.Context, scope, key string) error {
+	delete(m.records, scope+"/"+key)
+	return nil
+}
+
+func (m *memIdempotencyStore) FindSince(_ context.Context, scope string, since time.Time) ([]navigation.CommandIdempotencyRecord, error) {
+	var out []navigation.CommandIdempotencyRecord
+	for _, record := range m.records {
+		if record.Scope == scope && !record.RecordedAt.Before(since) {
+			out = append(out, record)
+		}
+	}
+	return out, nil
+}
+
+func (m *memIdempotencyStore) DeleteBefore(_ context.Context, scope string, cutoff time.Time) error {
+	for id, record := range m.records {
+		if record.Scope == scope && record.RecordedAt.Before(cutoff) {
+			delete(m.records, id)
+		}
+	}
+	return nil
+}
+
+type stubStatusCodec struct{}
+
+func (stubStatusCodec) Encode(response common.Response) ([]byte, error) {
+	return []byte(response.(*idempotencyStubResponse).status), nil
+}
+
+func (stubStatusCodec) Decode(data []byte) (common.Response, error) {
+	return &idempotencyStubResponse{status: string(data)}, nil
+}
+
+// A result completed before a restart replays from the cache the next daemon
+// loads; a released key and an expired one do not survive.
+func TestIdempotencyCache_ReloadsPersistedResultsAfterRestart(t *testing.T) {
+	ctx := context.Background()
+	clock := &shared.MockClock{CurrentTime: time.Date(2026, 6, 1, 12, 0, 0, 0, time.UTC)}
+	store := &memIdempotencyStore{records: map[string]navigation.CommandIdempotencyRecord{}}
+	req := IdempotentRequest{ShipSymbol: "SHIP-1", Destination: "X1-A-B2"}
+
+	before := NewIdempotencyCache(time.Hour, clock).WithStore(store, "nav", stubStatusCodec{})
+	before.Claim("old", req, nil)
+	before.Complete(ctx, "old", &idempotencyStubResponse{status: "stale"})
+	clock.Advance(2 * time.Hour)
+	before.Claim("k1", req, nil)
+	before.Complete(ctx, "k1", &idempotencyStubResponse{status: "navigating"})
+	before.Claim("failed", req, nil)
+	before.Release(ctx, "failed")
+
+	after := NewIdempotencyCache(time.Hour, clock).WithStore(store, "nav", stubStatusCodec{})
+	if err := after.Load(ctx); err != nil {
+		t.Fatalf("load: %v", err)
+	}
+	previous, replay, err := after.Claim("k1", req, func(common.Response) bool { return true })
+	if err != nil || !replay || previous.(*idempotencyStubResponse).status != "navigating" {
+		t.Fatalf("expected the persisted result replayed, got %v replay=%v err=%v", previous, replay, err)
+	}
+	if _, ok := store.records["nav/old"]; ok {
+		t.Fatal("an expired key must be purged at load")
+	}
+	if _, replay, _ := after.Claim("failed", req, nil); replay {
+		t.Fatal("a released key must not replay after a restart")
+	}
+}
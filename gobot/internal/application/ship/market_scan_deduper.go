@@ -0,0 +1,73 @@
+package ship
+
+import (
+	"sync"
+	"time"
+
+	"github.com/andrescamacho/spacetraders-go/internal/domain/shared"
+)
+
+// MarketScanDeduper remembers when each market was last scanned, across every
+// coordinator sharing the daemon's MarketScanner. Scouts, route-arrival scans and
+// parked probes regularly hit the same market minutes apart; an opportunistic scan
+// consults Recent and skips the GetMarket call when any caller already scanned the
+// market within the window. A window of 0 disables suppression (Recent is always
+// false) while scans are still recorded, so re-enabling takes effect at once.
+//
+// Timestamps live in memory only: a restarted daemon re-scans everything once.
+type MarketScanDeduper struct {
+	mu       sync.Mutex
+	window   time.Duration
+	clock    shared.Clock
+	lastScan map[marketScanKey]time.Time
+}
+
+type marketScanKey struct {
+	playerID       uint
+	waypointSymbol string
+}
+
+// NewMarketScanDeduper creates a deduper with the given window. If clock is nil,
+// uses RealClock.
+func NewMarketScanDeduper(window time.Duration, clock shared.Clock) *MarketScanDeduper {
+	if clock == nil {
+		clock = shared.NewRealClock()
+	}
+	return &MarketScanDeduper{
+		window:   window,
+		clock:    clock,
+		lastScan: make(map[marketScanKey]time.Time),
+	}
+}
+
+// SetWindow changes the suppression window (config hot-reload).
+func (d *MarketScanDeduper) SetWindow(window time.Duration) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.window = window
+}
+
+// Recent reports whether the market was scanned within the window, and when.
+func (d *MarketScanDeduper) Recent(playerID uint, waypointSymbol string) (time.Time, bool) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	scannedAt, ok := d.lastScan[marketScanKey{playerID, waypointSymbol}]
+	if !ok || d.window <= 0 {
+		return scannedAt, false
+	}
+	return scannedAt, d.clock.Now().Sub(scannedAt) < d.window
+}
+
+// Record stamps a completed scan of the market. Entries older than the window
+// are pruned on the way so the map stays bounded by the markets scanned recently.
+func (d *MarketScanDeduper) Record(playerID uint, waypointSymbol string) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	now := d.clock.Now()
+	for key, scannedAt := range d.lastScan {
+		if d.window > 0 && now.Sub(scannedAt) >= d.window {
+			delete(d.lastScan, key)
+		}
+	}
+	d.lastScan[marketScanKey{playerID, waypointSymbol}] = now
+}
@@ -0,0 +1,59 @@
+package ship
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/andrescamacho/spacetraders-go/internal/domain/shared"
+)
+
+// A scan recorded by one caller suppresses another inside the window and stops
+// suppressing once the window has passed.
+func TestMarketScanDeduper_SuppressesWithinWindowOnly(t *testing.T) {
+	clock := &shared.MockClock{CurrentTime: time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)}
+	deduper := NewMarketScanDeduper(2*time.Minute, clock)
+
+	if _, recent := deduper.Recent(1, "X1-A1"); recent {
+		t.Fatal("a never-scanned market must not be recent")
+	}
+
+	deduper.Record(1, "X1-A1")
+	clock.CurrentTime = clock.CurrentTime.Add(90 * time.Second)
+	if _, recent := deduper.Recent(1, "X1-A1"); !recent {
+		t.Fatal("a market scanned 90s ago must be recent under a 2m window")
+	}
+	if _, recent := deduper.Recent(2, "X1-A1"); recent {
+		t.Fatal("another player's scan must not suppress")
+	}
+
+	clock.CurrentTime = clock.CurrentTime.Add(time.Minute)
+	if _, recent := deduper.Recent(1, "X1-A1"); recent {
+		t.Fatal("a market scanned 150s ago must not be recent under a 2m window")
+	}
+}
+
+// A zero window (deduplication off) never suppresses.
+func TestMarketScanDeduper_ZeroWindowDisables(t *testing.T) {
+	deduper := NewMarketScanDeduper(0, nil)
+	deduper.Record(1, "X1-A1")
+	if _, recent := deduper.Recent(1, "X1-A1"); recent {
+		t.Fatal("a zero window must never suppress a scan")
+	}
+}
+
+// ScanAndSaveMarketIfDue skips the API entirely for a recently scanned market. The
+// scanner has no API client or repository, so reaching ScanAndSaveMarket would panic.
+func TestScanAndSaveMarketIfDue_SkipsRecentScan(t *testing.T) {
+	deduper := NewMarketScanDeduper(2*time.Minute, nil)
+	deduper.Record(1, "X1-A1")
+	scanner := (&MarketScanner{}).WithScanDeduper(deduper)
+
+	scanned, err := scanner.ScanAndSaveMarketIfDue(context.Background(), 1, "X1-A1")
+	if err != nil {
+		t.Fatalf("ScanAndSaveMarketIfDue: %v", err)
+	}
+	if scanned {
+		t.Fatal("a market scanned inside the window must not be re-scanned")
+	}
+}
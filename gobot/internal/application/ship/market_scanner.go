@@ -20,6 +20,28 @@ type MarketScanner struct {
 	marketRepo       scoutingQuery.MarketRepository
 	playerRepo       player.PlayerRepository
 	priceHistoryRepo market.MarketPriceHistoryRepository
+
+	// deduper is shared by every caller of this scanner; nil disables the
+	// cross-coordinator scan dedup (ScanAndSaveMarketIfDue always scans).
+	deduper *MarketScanDeduper
+
+	// capabilityRecorder grades the scanned waypoint's fuel from its trade list;
+	// nil skips it.
+	capabilityRecorder WaypointCapabilityRecorder
+
+	// eventPublisher announces each saved scan as market.updated so event-driven
+	// coordinators re-evaluate without waiting for their next tick; nil skips it.
+	eventPublisher shared.CoordinationPublisher
+
+	// supplyTransitions records each good whose supply level changed since the
+	// previous scan of the market; nil skips it.
+	supplyTransitions market.SupplyTransitionRepository
+}
+
+// WaypointCapabilityRecorder persists what a scanned market reveals about its
+// waypoint (whether it sells FUEL).
+type WaypointCapabilityRecorder interface {
+	RecordMarketGoods(ctx context.Context, waypointSymbol string, goods []string) error
 }
 
 // NewMarketScanner creates a new market scanner service
@@ -37,6 +59,34 @@ func NewMarketScanner(
 	}
 }
 
+// WithScanDeduper attaches the cross-coordinator scan dedup and returns the scanner
+// for chaining. Intended to be called once at wiring time.
+func (s *MarketScanner) WithScanDeduper(deduper *MarketScanDeduper) *MarketScanner {
+	s.deduper = deduper
+	return s
+}
+
+// WithCapabilityRecorder attaches the waypoint capability recorder and returns the
+// scanner for chaining. Intended to be called once at wiring time.
+func (s *MarketScanner) WithCapabilityRecorder(recorder WaypointCapabilityRecorder) *MarketScanner {
+	s.capabilityRecorder = recorder
+	return s
+}
+
+// WithEventPublisher attaches the coordination event publisher and returns the
+// scanner for chaining. Intended to be called once at wiring time.
+func (s *MarketScanner) WithEventPublisher(publisher shared.CoordinationPublisher) *MarketScanner {
+	s.eventPublisher = publisher
+	return s
+}
+
+// WithSupplyTransitionRecorder attaches the supply transition history and
+// returns the scanner for chaining. Intended to be called once at wiring time.
+func (s *MarketScanner) WithSupplyTransitionRecorder(repo market.SupplyTransitionRepository) *MarketScanner {
+	s.supplyTransitions = repo
+	return s
+}
+
 // ScanAndSaveMarket scans a market at the given waypoint and saves the data to the database.
 // This is a non-fatal operation - errors are logged but do not fail the caller's operation.
 func (s *MarketScanner) ScanAndSaveMarket(ctx context.Context, playerID uint, waypointSymbol string) error {
@@ -71,7 +121,8 @@ func (s *MarketScanner) ScanAndSaveMarket(ctx context.Context, playerID uint, wa
 		return err
 	}
 
-	err = s.marketRepo.UpsertMarketData(ctx, playerID, waypointSymbol, tradeGoods, time.Now())
+	scannedAt := time.Now()
+	err = s.marketRepo.UpsertMarketData(ctx, playerID, waypointSymbol, tradeGoods, scannedAt)
 	if err != nil {
 		logger.Log("ERROR", fmt.Sprintf("[MarketScanner] Failed to persist market data for %s: %v", waypointSymbol, err), nil)
 		recordMarketScanMetric(playerID, waypointSymbol, startTime, err)
@@ -82,6 +133,32 @@ func (s *MarketScanner) ScanAndSaveMarket(ctx context.Context, playerID uint, wa
 	if s.priceHistoryRepo != nil && existingMarket != nil {
 		s.recordPriceChanges(ctx, existingMarket, waypointSymbol, tradeGoods, int(playerID), logger)
 	}
+	if s.supplyTransitions != nil && existingMarket != nil {
+		s.recordSupplyTransitions(ctx, existingMarket, waypointSymbol, tradeGoods, int(playerID), scannedAt, logger)
+	}
+
+	if s.deduper != nil {
+		s.deduper.Record(playerID, waypointSymbol)
+	}
+
+	if s.capabilityRecorder != nil {
+		goods := make([]string, 0, len(tradeGoods))
+		for _, good := range tradeGoods {
+			goods = append(goods, good.Symbol())
+		}
+		if err := s.capabilityRecorder.RecordMarketGoods(ctx, waypointSymbol, goods); err != nil {
+			logger.Log("WARNING", fmt.Sprintf("[MarketScanner] Failed to record waypoint capabilities for %s: %v", waypointSymbol, err), nil)
+		}
+	}
+
+	if s.eventPublisher != nil {
+		s.eventPublisher.Publish(shared.CoordinationEvent{
+			Topic:    shared.TopicMarketUpdated,
+			PlayerID: int(playerID),
+			Subject:  waypointSymbol,
+			Payload:  map[string]interface{}{"goods": len(tradeGoods)},
+		})
+	}
 
 	logger.Log("INFO", fmt.Sprintf("[MarketScanner] Successfully scanned and saved market data for %s (%d goods)", waypointSymbol, len(tradeGoods)), nil)
 
@@ -128,14 +205,41 @@ func MarketFreshWithin(m *market.Market, maxAge time.Duration, now time.Time) bo
 // entirely (always scans — pre-sp-v34b behavior), so the freshness-scout recovery path
 // (which stamps no policy, hence maxAge 0) and every other caller are byte-for-byte
 // unaffected. Non-fatal like ScanAndSaveMarket: the returned error is the underlying
-// scan error, never the gate.
+// scan error, never the gate. A scan that passes this gate still goes through
+// ScanAndSaveMarketIfDue, so another coordinator's scan inside the dedup window
+// also suppresses it.
 func (s *MarketScanner) ScanAndSaveMarketFresh(ctx context.Context, playerID uint, waypointSymbol string, maxAge time.Duration) (bool, error) {
-	if maxAge > 0 {
-		existing, _ := s.marketRepo.GetMarketData(ctx, waypointSymbol, int(playerID))
-		if MarketFreshWithin(existing, maxAge, time.Now()) {
+	if s.MarketFresh(ctx, playerID, waypointSymbol, maxAge) {
+		common.LoggerFromContext(ctx).Log("INFO", fmt.Sprintf(
+			"[MarketScanner] Skipping scan at %s - cached market fresh (< %s old)", waypointSymbol, maxAge), map[string]interface{}{
+			"action": "scan_skipped_fresh", "waypoint": waypointSymbol, "max_age_seconds": int(maxAge.Seconds()),
+		})
+		return false, nil
+	}
+	return s.ScanAndSaveMarketIfDue(ctx, playerID, waypointSymbol)
+}
+
+// MarketFresh reports whether the cached market at waypointSymbol was scanned
+// within maxAge. maxAge<=0 is never fresh.
+func (s *MarketScanner) MarketFresh(ctx context.Context, playerID uint, waypointSymbol string, maxAge time.Duration) bool {
+	if maxAge <= 0 {
+		return false
+	}
+	existing, _ := s.marketRepo.GetMarketData(ctx, waypointSymbol, int(playerID))
+	return MarketFreshWithin(existing, maxAge, time.Now())
+}
+
+// ScanAndSaveMarketIfDue is ScanAndSaveMarket for opportunistic scans (route arrivals,
+// scout tours, system warm-up): when any caller of this scanner scanned the market
+// within the deduper's window, it skips the GetMarket call and returns scanned=false.
+// Callers that must read live prices (trade floor/ceiling refreshes, post-trade impact
+// scans) call ScanAndSaveMarket directly, which always scans and refreshes the stamp.
+func (s *MarketScanner) ScanAndSaveMarketIfDue(ctx context.Context, playerID uint, waypointSymbol string) (bool, error) {
+	if s.deduper != nil {
+		if scannedAt, recent := s.deduper.Recent(playerID, waypointSymbol); recent {
 			common.LoggerFromContext(ctx).Log("INFO", fmt.Sprintf(
-				"[MarketScanner] Skipping scan at %s - cached market fresh (< %s old)", waypointSymbol, maxAge), map[string]interface{}{
-				"action": "scan_skipped_fresh", "waypoint": waypointSymbol, "max_age_seconds": int(maxAge.Seconds()),
+				"[MarketScanner] Skipping scan at %s - scanned %s ago by another caller", waypointSymbol, time.Since(scannedAt).Round(time.Second)), map[string]interface{}{
+				"action": "scan_skipped_recent", "waypoint": waypointSymbol,
 			})
 			return false, nil
 		}
@@ -215,6 +319,44 @@ func (s *MarketScanner) recordPriceChanges(
 	}
 }
 
+// recordSupplyTransitions records every good whose supply level differs from
+// the previous scan. Goods new to the market, or with no supply reported on
+// either scan, have no transition to record.
+func (s *MarketScanner) recordSupplyTransitions(
+	ctx context.Context,
+	existingMarket *market.Market,
+	waypointSymbol string,
+	newGoods []market.TradeGood,
+	playerID int,
+	scannedAt time.Time,
+	logger common.ContainerLogger,
+) {
+	previous := make(map[string]string)
+	for _, good := range existingMarket.TradeGoods() {
+		if supply := good.Supply(); supply != nil && *supply != "" {
+			previous[good.Symbol()] = *supply
+		}
+	}
+
+	for _, good := range newGoods {
+		supply := good.Supply()
+		from, known := previous[good.Symbol()]
+		if !known || supply == nil || *supply == "" || *supply == from {
+			continue
+		}
+		if err := s.supplyTransitions.Record(ctx, market.SupplyTransition{
+			PlayerID:       playerID,
+			WaypointSymbol: waypointSymbol,
+			GoodSymbol:     good.Symbol(),
+			FromSupply:     from,
+			ToSupply:       *supply,
+			TransitionedAt: scannedAt,
+		}); err != nil {
+			logger.Log("WARNING", fmt.Sprintf("[MarketScanner] Failed to record supply transition of %s at %s: %v", good.Symbol(), waypointSymbol, err), nil)
+		}
+	}
+}
+
 // pricesChanged checks if any relevant field changed between old and new trade goods
 func (s *MarketScanner) pricesChanged(oldGood, newGood *market.TradeGood) bool {
 	if oldGood.PurchasePrice() != newGood.PurchasePrice() {
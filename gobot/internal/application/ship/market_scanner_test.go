@@ -113,3 +113,54 @@ func TestRecordPriceChanges_NewGoodCapturesTierToo(t *testing.T) {
 		t.Fatalf("Activity() = %v, want STRONG", a)
 	}
 }
+
+// fakeSupplyTransitionRepo captures every recorded supply transition.
+type fakeSupplyTransitionRepo struct {
+	recorded []market.SupplyTransition
+}
+
+func (f *fakeSupplyTransitionRepo) Record(_ context.Context, transition market.SupplyTransition) error {
+	f.recorded = append(f.recorded, transition)
+	return nil
+}
+
+func (f *fakeSupplyTransitionRepo) ListTransitions(context.Context, int, string, string, time.Time) ([]market.SupplyTransition, error) {
+	return f.recorded, nil
+}
+
+func TestRecordSupplyTransitions_RecordsOnlyChangedLevels(t *testing.T) {
+	scarce, moderate, high := "SCARCE", "MODERATE", "HIGH"
+	mustGood := func(symbol string, supply *string) market.TradeGood {
+		good, err := market.NewTradeGood(symbol, supply, nil, 100, 110, 20, market.TradeTypeExport)
+		if err != nil {
+			t.Fatalf("NewTradeGood(%s): %v", symbol, err)
+		}
+		return *good
+	}
+	existingMarket, err := market.NewMarket("X1-NK36-D39", []market.TradeGood{
+		mustGood("FABRICS", &scarce),
+		mustGood("MEDICINE", &high),
+		mustGood("FUEL", nil),
+	}, time.Now())
+	if err != nil {
+		t.Fatalf("NewMarket: %v", err)
+	}
+
+	repo := &fakeSupplyTransitionRepo{}
+	scanner := &MarketScanner{supplyTransitions: repo}
+	scannedAt := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	scanner.recordSupplyTransitions(context.Background(), existingMarket, "X1-NK36-D39", []market.TradeGood{
+		mustGood("FABRICS", &moderate),
+		mustGood("MEDICINE", &high),
+		mustGood("FUEL", &moderate),
+		mustGood("CLOTHING", &high),
+	}, 1, scannedAt, noopLogger{})
+
+	if len(repo.recorded) != 1 {
+		t.Fatalf("recorded %d transitions, want only FABRICS: %+v", len(repo.recorded), repo.recorded)
+	}
+	got := repo.recorded[0]
+	if got.GoodSymbol != "FABRICS" || got.FromSupply != "SCARCE" || got.ToSupply != "MODERATE" || !got.TransitionedAt.Equal(scannedAt) {
+		t.Fatalf("unexpected transition %+v", got)
+	}
+}
@@ -0,0 +1,189 @@
+package ship
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	domainNavigation "github.com/andrescamacho/spacetraders-go/internal/domain/navigation"
+)
+
+// Navigation progress events, in the order a segment emits them. A refuel may
+// also fire before the first departure (start-of-route refuel).
+const (
+	ProgressEventStarted  = "started"
+	ProgressEventDeparted = "departed"
+	ProgressEventArrived  = "arrived"
+	ProgressEventRefueled = "refueled"
+	ProgressEventFinished = "finished"
+)
+
+// NavigationProgress is a point-in-time view of one ship's route execution.
+type NavigationProgress struct {
+	ShipSymbol    string
+	PlayerID      int
+	RouteID       string
+	Status        string // EXECUTING, COMPLETED, FAILED or PARKED
+	LastEvent     string // one of the ProgressEvent* constants
+	SegmentIndex  int    // zero-based index of the segment in progress (or last completed)
+	TotalSegments int
+	From          string
+	To            string
+	FuelCurrent   int
+	FuelUsed      int // fuel burned by completed legs so far
+	LegArrival    *time.Time
+	ETA           *time.Time // estimated arrival at the route's final waypoint
+	StartedAt     time.Time
+	UpdatedAt     time.Time
+}
+
+// SegmentLabel renders the segment position as "3/7" (one-based).
+func (p NavigationProgress) SegmentLabel() string {
+	current := p.SegmentIndex + 1
+	if current > p.TotalSegments {
+		current = p.TotalSegments
+	}
+	return fmt.Sprintf("%d/%d", current, p.TotalSegments)
+}
+
+// NavigationProgressTracker holds the latest NavigationProgress per ship.
+//
+// RouteExecutor feeds it as segments depart, arrive and refuel; the
+// GetNavigationProgress query reads it. The last snapshot of a finished route is
+// kept until the ship's next route starts, so a client polling just after arrival
+// still sees the outcome. Entries live in memory only and reset with the daemon.
+type NavigationProgressTracker struct {
+	mu       sync.RWMutex
+	progress map[string]*trackedRoute
+}
+
+type trackedRoute struct {
+	snapshot NavigationProgress
+	// travelSeconds[i] is segment i's planned travel time, used to project the ETA
+	// of the legs not yet flown.
+	travelSeconds []int
+}
+
+// NewNavigationProgressTracker creates an empty tracker.
+func NewNavigationProgressTracker() *NavigationProgressTracker {
+	return &NavigationProgressTracker{progress: make(map[string]*trackedRoute)}
+}
+
+// Get returns the latest progress for a ship, if any route has been tracked for it.
+func (t *NavigationProgressTracker) Get(shipSymbol string) (NavigationProgress, bool) {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+	tracked, ok := t.progress[shipSymbol]
+	if !ok {
+		return NavigationProgress{}, false
+	}
+	return tracked.snapshot, true
+}
+
+// Start begins tracking a route, replacing any earlier snapshot for the ship.
+func (t *NavigationProgressTracker) Start(route *domainNavigation.Route, fuelCurrent int, now time.Time) NavigationProgress {
+	segments := route.Segments()
+	travelSeconds := make([]int, len(segments))
+	for i, segment := range segments {
+		travelSeconds[i] = segment.TravelTime
+	}
+
+	tracked := &trackedRoute{
+		snapshot: NavigationProgress{
+			ShipSymbol:    route.ShipSymbol(),
+			PlayerID:      route.PlayerID(),
+			RouteID:       route.RouteID(),
+			Status:        string(domainNavigation.RouteStatusExecuting),
+			LastEvent:     ProgressEventStarted,
+			TotalSegments: len(segments),
+			FuelCurrent:   fuelCurrent,
+			StartedAt:     now,
+			UpdatedAt:     now,
+		},
+		travelSeconds: travelSeconds,
+	}
+	if len(segments) > 0 {
+		tracked.snapshot.From = segments[0].FromWaypoint.Symbol
+		tracked.snapshot.To = segments[0].ToWaypoint.Symbol
+	}
+	tracked.snapshot.ETA = tracked.etaFrom(now, 0)
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.progress[route.ShipSymbol()] = tracked
+	return tracked.snapshot
+}
+
+// Departed records that a segment's navigate was accepted. legArrival is the
+// API-reported arrival at the segment's destination; nil when unknown.
+func (t *NavigationProgressTracker) Departed(shipSymbol string, segmentIndex int, segment *domainNavigation.RouteSegment, legArrival *time.Time, now time.Time) NavigationProgress {
+	return t.update(shipSymbol, now, func(tracked *trackedRoute) {
+		tracked.snapshot.LastEvent = ProgressEventDeparted
+		tracked.snapshot.SegmentIndex = segmentIndex
+		tracked.snapshot.From = segment.FromWaypoint.Symbol
+		tracked.snapshot.To = segment.ToWaypoint.Symbol
+		tracked.snapshot.LegArrival = legArrival
+		if legArrival != nil {
+			tracked.snapshot.ETA = tracked.etaFrom(*legArrival, segmentIndex+1)
+		} else {
+			tracked.snapshot.ETA = tracked.etaFrom(now, segmentIndex)
+		}
+	})
+}
+
+// Arrived records a segment's arrival and the fuel its leg burned.
+func (t *NavigationProgressTracker) Arrived(shipSymbol string, segmentIndex int, fuelUsed, fuelCurrent int, now time.Time) NavigationProgress {
+	return t.update(shipSymbol, now, func(tracked *trackedRoute) {
+		tracked.snapshot.LastEvent = ProgressEventArrived
+		tracked.snapshot.SegmentIndex = segmentIndex
+		tracked.snapshot.FuelUsed += fuelUsed
+		tracked.snapshot.FuelCurrent = fuelCurrent
+		tracked.snapshot.LegArrival = nil
+		tracked.snapshot.ETA = tracked.etaFrom(now, segmentIndex+1)
+	})
+}
+
+// Refueled records a completed refuel at the ship's current waypoint.
+func (t *NavigationProgressTracker) Refueled(shipSymbol string, fuelCurrent int, now time.Time) NavigationProgress {
+	return t.update(shipSymbol, now, func(tracked *trackedRoute) {
+		tracked.snapshot.LastEvent = ProgressEventRefueled
+		tracked.snapshot.FuelCurrent = fuelCurrent
+	})
+}
+
+// Finish records the route's terminal status. The snapshot is retained.
+func (t *NavigationProgressTracker) Finish(shipSymbol string, status string, now time.Time) NavigationProgress {
+	return t.update(shipSymbol, now, func(tracked *trackedRoute) {
+		tracked.snapshot.LastEvent = ProgressEventFinished
+		tracked.snapshot.Status = status
+		tracked.snapshot.LegArrival = nil
+		if status == string(domainNavigation.RouteStatusCompleted) {
+			tracked.snapshot.ETA = nil
+		}
+	})
+}
+
+// update applies fn to the ship's tracked route. A ship with no tracked route
+// (e.g. a refuel outside ExecuteRoute) is a no-op returning a bare snapshot.
+func (t *NavigationProgressTracker) update(shipSymbol string, now time.Time, fn func(*trackedRoute)) NavigationProgress {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	tracked, ok := t.progress[shipSymbol]
+	if !ok {
+		return NavigationProgress{ShipSymbol: shipSymbol, UpdatedAt: now}
+	}
+	fn(tracked)
+	tracked.snapshot.UpdatedAt = now
+	return tracked.snapshot
+}
+
+// etaFrom projects the route's final arrival: from, plus the planned travel time
+// of every segment from index firstUnflown on.
+func (r *trackedRoute) etaFrom(from time.Time, firstUnflown int) *time.Time {
+	remaining := 0
+	for i := firstUnflown; i < len(r.travelSeconds); i++ {
+		remaining += r.travelSeconds[i]
+	}
+	eta := from.Add(time.Duration(remaining) * time.Second)
+	return &eta
+}
@@ -0,0 +1,90 @@
+package ship
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	domainNavigation "github.com/andrescamacho/spacetraders-go/internal/domain/navigation"
+	"github.com/andrescamacho/spacetraders-go/internal/domain/shared"
+)
+
+// ExecuteRoute reports every leg to the executor's progress tracker: the final
+// snapshot shows the route COMPLETED at segment 2/2 with the fuel both legs burned.
+func TestExecuteRoute_RecordsSegmentProgress(t *testing.T) {
+	a := mustWaypoint(t, "X1-TORWIND-A", 0, 0)
+	b := mustWaypoint(t, "X1-TORWIND-B", 110, 0)
+	c := mustWaypoint(t, "X1-TORWIND-C", 224, 0)
+	b.HasFuel = false
+
+	ship := newExecutorTestShip(t, 400, 400, a)
+
+	leg1 := domainNavigation.NewRouteSegment(a, b, 110, 110, 0, shared.FlightModeCruise, false)
+	leg2 := domainNavigation.NewRouteSegment(b, c, 114, 114, 0, shared.FlightModeCruise, false)
+	route, err := domainNavigation.NewRoute(
+		"route-progress-1", "TORWIND-1", 1,
+		[]*domainNavigation.RouteSegment{leg1, leg2}, 400, false,
+	)
+	if err != nil {
+		t.Fatalf("NewRoute: %v", err)
+	}
+
+	fake := &recordingMediator{
+		fuel:       400,
+		capacity:   400,
+		distByDest: map[string]float64{b.Symbol: 110, c.Symbol: 114},
+	}
+	executor := NewRouteExecutor(nil, fake, nil, nil, nil, nil, nil, stubSubscriber{})
+
+	if err := executor.ExecuteRoute(context.Background(), route, ship, shared.MustNewPlayerID(1)); err != nil {
+		t.Fatalf("ExecuteRoute: %v", err)
+	}
+
+	progress, ok := executor.Progress().Get("TORWIND-1")
+	if !ok {
+		t.Fatal("expected progress to be tracked for TORWIND-1")
+	}
+	if progress.Status != string(domainNavigation.RouteStatusCompleted) {
+		t.Fatalf("status = %s, want COMPLETED", progress.Status)
+	}
+	if got := progress.SegmentLabel(); got != "2/2" {
+		t.Fatalf("segment = %s, want 2/2", got)
+	}
+	if want := 400 - fake.fuel; progress.FuelUsed != want {
+		t.Fatalf("fuel used = %d, want %d", progress.FuelUsed, want)
+	}
+	if progress.To != c.Symbol {
+		t.Fatalf("to = %s, want %s", progress.To, c.Symbol)
+	}
+}
+
+// The ETA is the leg's API arrival plus the planned travel time of every leg after it.
+func TestNavigationProgressTracker_ETAProjectsRemainingLegs(t *testing.T) {
+	a := mustWaypoint(t, "X1-ETA-A", 0, 0)
+	b := mustWaypoint(t, "X1-ETA-B", 10, 0)
+	c := mustWaypoint(t, "X1-ETA-C", 20, 0)
+	d := mustWaypoint(t, "X1-ETA-D", 30, 0)
+	segments := []*domainNavigation.RouteSegment{
+		domainNavigation.NewRouteSegment(a, b, 10, 10, 60, shared.FlightModeCruise, false),
+		domainNavigation.NewRouteSegment(b, c, 10, 10, 120, shared.FlightModeCruise, false),
+		domainNavigation.NewRouteSegment(c, d, 10, 10, 180, shared.FlightModeCruise, false),
+	}
+	route, err := domainNavigation.NewRoute("route-eta", "ETA-1", 1, segments, 400, false)
+	if err != nil {
+		t.Fatalf("NewRoute: %v", err)
+	}
+
+	now := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+	tracker := NewNavigationProgressTracker()
+	tracker.Start(route, 400, now)
+
+	legArrival := now.Add(150 * time.Second) // second leg runs slower than planned
+	progress := tracker.Departed("ETA-1", 1, segments[1], &legArrival, now)
+
+	if got := progress.SegmentLabel(); got != "2/3" {
+		t.Fatalf("segment = %s, want 2/3", got)
+	}
+	if want := legArrival.Add(180 * time.Second); progress.ETA == nil || !progress.ETA.Equal(want) {
+		t.Fatalf("eta = %v, want %v", progress.ETA, want)
+	}
+}
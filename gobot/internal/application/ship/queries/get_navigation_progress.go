@@ -0,0 +1,62 @@
+package queries
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/andrescamacho/spacetraders-go/internal/application/common"
+	"github.com/andrescamacho/spacetraders-go/internal/application/ship"
+	"github.com/andrescamacho/spacetraders-go/internal/domain/player"
+)
+
+// GetNavigationProgressQuery represents a query for a ship's route execution progress
+type GetNavigationProgressQuery struct {
+	ShipSymbol  string // Required: ship symbol to report on
+	PlayerID    *int   // Optional: query by player ID
+	AgentSymbol string // Optional: query by agent symbol
+}
+
+// GetNavigationProgressResponse represents a ship's latest route progress.
+// Found is false when the ship has not executed a route since the daemon started.
+type GetNavigationProgressResponse struct {
+	Found    bool
+	Progress ship.NavigationProgress
+}
+
+// GetNavigationProgressHandler handles the GetNavigationProgress query
+type GetNavigationProgressHandler struct {
+	tracker        *ship.NavigationProgressTracker
+	playerResolver *common.PlayerResolver
+}
+
+// NewGetNavigationProgressHandler creates a new GetNavigationProgressHandler
+func NewGetNavigationProgressHandler(tracker *ship.NavigationProgressTracker, playerRepo player.PlayerRepository) *GetNavigationProgressHandler {
+	return &GetNavigationProgressHandler{
+		tracker:        tracker,
+		playerResolver: common.NewPlayerResolver(playerRepo),
+	}
+}
+
+// Handle executes the GetNavigationProgress query
+func (h *GetNavigationProgressHandler) Handle(ctx context.Context, request common.Request) (common.Response, error) {
+	query, ok := request.(*GetNavigationProgressQuery)
+	if !ok {
+		return nil, fmt.Errorf("invalid request type: expected *GetNavigationProgressQuery")
+	}
+
+	if query.ShipSymbol == "" {
+		return nil, fmt.Errorf("ship_symbol is required")
+	}
+
+	playerID, err := h.playerResolver.ResolvePlayerID(ctx, query.PlayerID, query.AgentSymbol)
+	if err != nil {
+		return nil, err
+	}
+
+	progress, found := h.tracker.Get(query.ShipSymbol)
+	// Ship symbols are agent-prefixed, but never hand one player another's progress.
+	if !found || progress.PlayerID != playerID.Value() {
+		return &GetNavigationProgressResponse{Found: false}, nil
+	}
+	return &GetNavigationProgressResponse{Found: true, Progress: progress}, nil
+}
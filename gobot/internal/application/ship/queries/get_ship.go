@@ -14,6 +14,9 @@ type GetShipQuery struct {
 	ShipSymbol  string // Required: ship symbol to retrieve
 	PlayerID    *int   // Optional: query by player ID
 	AgentSymbol string // Optional: query by agent symbol
+	// NoCache re-reads the ship from the SpaceTraders API, bypassing both the
+	// local DB row and the ship state cache, and persists what it finds.
+	NoCache bool
 }
 
 // GetShipResponse represents the result of getting a ship
@@ -51,7 +54,12 @@ func (h *GetShipHandler) Handle(ctx context.Context, request common.Request) (co
 		return nil, err
 	}
 
-	ship, err := h.shipRepo.FindBySymbol(ctx, query.ShipSymbol, playerID)
+	var ship *navigation.Ship
+	if query.NoCache {
+		ship, err = h.shipRepo.SyncShipFromAPI(navigation.WithFreshShipRead(ctx), query.ShipSymbol, playerID)
+	} else {
+		ship, err = h.shipRepo.FindBySymbol(ctx, query.ShipSymbol, playerID)
+	}
 	if err != nil {
 		return nil, fmt.Errorf("failed to get ship: %w", err)
 	}
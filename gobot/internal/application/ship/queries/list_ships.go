@@ -3,21 +3,60 @@ package queries
 import (
 	"context"
 	"fmt"
+	"sort"
+	"strings"
 
 	"github.com/andrescamacho/spacetraders-go/internal/application/common"
 	"github.com/andrescamacho/spacetraders-go/internal/domain/navigation"
 	"github.com/andrescamacho/spacetraders-go/internal/domain/player"
 )
 
-// ListShipsQuery represents a query to list all ships for a player
+// ShipProjection selects how much of each ship a ListShipsQuery returns
+type ShipProjection string
+
+const (
+	// ShipProjectionFull returns the ship entities themselves (the default)
+	ShipProjectionFull ShipProjection = ""
+	// ShipProjectionSummary returns only symbol, location and status, for
+	// overviews and coordinators that never read cargo or modules
+	ShipProjectionSummary ShipProjection = "summary"
+)
+
+// ListShipsQuery represents a query to list all ships for a player.
+//
+// With no filters, paging or projection set it returns every ship in full, as
+// it always has. Filters narrow the fleet first; Total counts what they match,
+// then Offset/Limit page through it in ship-symbol order.
 type ListShipsQuery struct {
 	PlayerID    *int   // Optional: query by player ID
 	AgentSymbol string // Optional: query by agent symbol
+
+	NavStatus    string // Optional: only ships in this nav status (DOCKED, IN_ORBIT, IN_TRANSIT)
+	Role         string // Optional: only ships with this registration role
+	SystemSymbol string // Optional: only ships currently in this system
+
+	Offset int // Ships to skip, after filtering
+	Limit  int // Maximum ships to return; 0 returns the rest
+
+	Projection ShipProjection
+	CountOnly  bool // Return Total only, no ships
+}
+
+// ShipSummary is the lightweight projection of a ship
+type ShipSummary struct {
+	Symbol       string
+	Location     string
+	SystemSymbol string
+	NavStatus    navigation.NavStatus
 }
 
-// ListShipsResponse represents the result of listing ships
+// ListShipsResponse represents the result of listing ships. Ships is set for
+// the full projection, Summaries for the summary projection, and neither in
+// count-only mode.
 type ListShipsResponse struct {
-	Ships []*navigation.Ship
+	Ships     []*navigation.Ship
+	Summaries []ShipSummary
+	Total     int // Ships matching the filters, before paging
 }
 
 // ListShipsHandler handles the ListShips query
@@ -40,6 +79,12 @@ func (h *ListShipsHandler) Handle(ctx context.Context, request common.Request) (
 	if !ok {
 		return nil, fmt.Errorf("invalid request type: expected *ListShipsQuery")
 	}
+	if query.Offset < 0 || query.Limit < 0 {
+		return nil, fmt.Errorf("offset and limit must not be negative")
+	}
+	if query.Projection != ShipProjectionFull && query.Projection != ShipProjectionSummary {
+		return nil, fmt.Errorf("unknown ship projection %q", query.Projection)
+	}
 
 	playerID, err := h.playerResolver.ResolvePlayerID(ctx, query.PlayerID, query.AgentSymbol)
 	if err != nil {
@@ -51,7 +96,81 @@ func (h *ListShipsHandler) Handle(ctx context.Context, request common.Request) (
 		return nil, fmt.Errorf("failed to list ships: %w", err)
 	}
 
-	return &ListShipsResponse{
-		Ships: ships,
-	}, nil
+	ships = filterShips(ships, query)
+	response := &ListShipsResponse{Total: len(ships)}
+	if query.CountOnly {
+		return response, nil
+	}
+
+	ships = pageShips(ships, query.Offset, query.Limit)
+	if query.Projection == ShipProjectionSummary {
+		response.Summaries = summarizeShips(ships)
+		return response, nil
+	}
+	response.Ships = ships
+	return response, nil
+}
+
+// filterShips keeps the ships matching every filter set on query
+func filterShips(ships []*navigation.Ship, query *ListShipsQuery) []*navigation.Ship {
+	if query.NavStatus == "" && query.Role == "" && query.SystemSymbol == "" {
+		return ships
+	}
+	filtered := make([]*navigation.Ship, 0, len(ships))
+	for _, ship := range ships {
+		if query.NavStatus != "" && !strings.EqualFold(string(ship.NavStatus()), query.NavStatus) {
+			continue
+		}
+		if query.Role != "" && !strings.EqualFold(ship.Role(), query.Role) {
+			continue
+		}
+		if query.SystemSymbol != "" && !strings.EqualFold(shipSystem(ship), query.SystemSymbol) {
+			continue
+		}
+		filtered = append(filtered, ship)
+	}
+	return filtered
+}
+
+// pageShips returns one page of ships in symbol order. Without paging the
+// repository's order is kept.
+func pageShips(ships []*navigation.Ship, offset, limit int) []*navigation.Ship {
+	if offset == 0 && limit == 0 {
+		return ships
+	}
+	sorted := make([]*navigation.Ship, len(ships))
+	copy(sorted, ships)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i].ShipSymbol() < sorted[j].ShipSymbol() })
+
+	if offset >= len(sorted) {
+		return []*navigation.Ship{}
+	}
+	end := len(sorted)
+	if limit > 0 && offset+limit < end {
+		end = offset + limit
+	}
+	return sorted[offset:end]
+}
+
+func summarizeShips(ships []*navigation.Ship) []ShipSummary {
+	summaries := make([]ShipSummary, 0, len(ships))
+	for _, ship := range ships {
+		summary := ShipSummary{
+			Symbol:       ship.ShipSymbol(),
+			SystemSymbol: shipSystem(ship),
+			NavStatus:    ship.NavStatus(),
+		}
+		if loc := ship.CurrentLocation(); loc != nil {
+			summary.Location = loc.Symbol
+		}
+		summaries = append(summaries, summary)
+	}
+	return summaries
+}
+
+func shipSystem(ship *navigation.Ship) string {
+	if loc := ship.CurrentLocation(); loc != nil {
+		return loc.SystemSymbol
+	}
+	return ""
 }
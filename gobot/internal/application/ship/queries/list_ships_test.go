@@ -0,0 +1,104 @@
+package queries
+
+import (
+	"context"
+	"testing"
+
+	"github.com/andrescamacho/spacetraders-go/internal/domain/navigation"
+)
+
+func listShipsFixture(t *testing.T) *listFleetsStubShipRepo {
+	return &listFleetsStubShipRepo{ships: []*navigation.Ship{
+		newFleetTestShip(t, "TORWIND-3", navigation.NavStatusDocked),
+		newFleetTestShip(t, "TORWIND-1", navigation.NavStatusInOrbit),
+		newFleetTestShip(t, "TORWIND-2", navigation.NavStatusDocked),
+		newFleetTestShip(t, "TORWIND-4", navigation.NavStatusDocked),
+	}}
+}
+
+func listShips(t *testing.T, query *ListShipsQuery) *ListShipsResponse {
+	t.Helper()
+	pid := 1
+	query.PlayerID = &pid
+	resp, err := NewListShipsHandler(listShipsFixture(t), nil).Handle(context.Background(), query)
+	if err != nil {
+		t.Fatalf("Handle: %v", err)
+	}
+	return resp.(*ListShipsResponse)
+}
+
+func shipSymbols(ships []*navigation.Ship) []string {
+	symbols := make([]string, len(ships))
+	for i, s := range ships {
+		symbols[i] = s.ShipSymbol()
+	}
+	return symbols
+}
+
+// A query with no options is the historical behaviour: every ship, in full.
+func TestListShips_DefaultReturnsEveryShipInFull(t *testing.T) {
+	resp := listShips(t, &ListShipsQuery{})
+
+	if len(resp.Ships) != 4 || resp.Total != 4 || resp.Summaries != nil {
+		t.Fatalf("expected all 4 ships in full, got %d ships, total %d", len(resp.Ships), resp.Total)
+	}
+}
+
+// Total counts every ship the filters match; the page is cut from those in
+// symbol order.
+func TestListShips_FiltersThenPagesInSymbolOrder(t *testing.T) {
+	resp := listShips(t, &ListShipsQuery{NavStatus: "docked", Offset: 1, Limit: 1})
+
+	if resp.Total != 3 {
+		t.Fatalf("3 docked ships match, got total %d", resp.Total)
+	}
+	if got := shipSymbols(resp.Ships); len(got) != 1 || got[0] != "TORWIND-3" {
+		t.Fatalf("second docked ship by symbol is TORWIND-3, got %v", got)
+	}
+
+	past := listShips(t, &ListShipsQuery{Offset: 10})
+	if len(past.Ships) != 0 || past.Total != 4 {
+		t.Fatalf("an offset past the end is an empty page, got %v (total %d)", shipSymbols(past.Ships), past.Total)
+	}
+}
+
+func TestListShips_RoleAndSystemFilters(t *testing.T) {
+	if resp := listShips(t, &ListShipsQuery{Role: "hauler", SystemSymbol: "X1-TW"}); resp.Total != 4 {
+		t.Fatalf("every fixture ship is a hauler in X1-TW, got %d", resp.Total)
+	}
+	if resp := listShips(t, &ListShipsQuery{Role: "EXCAVATOR"}); resp.Total != 0 {
+		t.Fatalf("no excavators, got %d", resp.Total)
+	}
+	if resp := listShips(t, &ListShipsQuery{SystemSymbol: "X1-ZZ"}); resp.Total != 0 {
+		t.Fatalf("no ships in X1-ZZ, got %d", resp.Total)
+	}
+}
+
+func TestListShips_SummaryProjectionAndCountOnly(t *testing.T) {
+	resp := listShips(t, &ListShipsQuery{Projection: ShipProjectionSummary, Limit: 2})
+	if resp.Ships != nil || len(resp.Summaries) != 2 {
+		t.Fatalf("summary projection returns summaries only, got %d ships, %d summaries", len(resp.Ships), len(resp.Summaries))
+	}
+	first := resp.Summaries[0]
+	if first.Symbol != "TORWIND-1" || first.Location != "X1-TW-A2" || first.SystemSymbol != "X1-TW" || first.NavStatus != navigation.NavStatusInOrbit {
+		t.Fatalf("unexpected summary %+v", first)
+	}
+
+	count := listShips(t, &ListShipsQuery{CountOnly: true, NavStatus: "IN_ORBIT"})
+	if count.Total != 1 || count.Ships != nil || count.Summaries != nil {
+		t.Fatalf("count-only returns the total alone, got %+v", count)
+	}
+}
+
+func TestListShips_RejectsBadOptions(t *testing.T) {
+	pid := 1
+	handler := NewListShipsHandler(listShipsFixture(t), nil)
+	for _, query := range []*ListShipsQuery{
+		{PlayerID: &pid, Limit: -1},
+		{PlayerID: &pid, Projection: "cargo"},
+	} {
+		if _, err := handler.Handle(context.Background(), query); err == nil {
+			t.Fatalf("expected an error for %+v", query)
+		}
+	}
+}
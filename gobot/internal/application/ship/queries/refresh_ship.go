@@ -13,7 +13,7 @@ import (
 
 // releaseReasonStaleClaim marks assignments cleared by refresh-time stale-claim
 // reconciliation, keeping them distinguishable in the audit trail from ordinary
-// releases (a worker finishing) and from daemon-startup ReleaseAllActive cleanup.
+// releases (a worker finishing) and from daemon-startup daemon_restart releases.
 const releaseReasonStaleClaim = "stale_claim_reconciled"
 
 // ContainerStatusReader reports the lifecycle status of the container that owns a
@@ -89,8 +89,9 @@ func (h *RefreshShipHandler) Handle(ctx context.Context, request common.Request)
 	// overwriting stale cargo + nav state. This is the reconciliation a daemon
 	// restart performs today, exposed as a Captain-accessible verb. SyncShipFromAPI
 	// preserves the existing assignment columns, so the returned ship still carries
-	// any claim the ships row holds.
-	ship, err := h.shipRepo.SyncShipFromAPI(ctx, query.ShipSymbol, playerID)
+	// any claim the ships row holds. A refresh is the reconcile verb, so it
+	// always bypasses the ship state cache.
+	ship, err := h.shipRepo.SyncShipFromAPI(navigation.WithFreshShipRead(ctx), query.ShipSymbol, playerID)
 	if err != nil {
 		return nil, fmt.Errorf("failed to refresh ship: %w", err)
 	}
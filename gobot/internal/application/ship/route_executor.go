@@ -10,6 +10,7 @@ import (
 	"github.com/andrescamacho/spacetraders-go/internal/application/common"
 	"github.com/andrescamacho/spacetraders-go/internal/application/ship/strategies"
 	"github.com/andrescamacho/spacetraders-go/internal/application/ship/types"
+	domainMarket "github.com/andrescamacho/spacetraders-go/internal/domain/market"
 	domainNavigation "github.com/andrescamacho/spacetraders-go/internal/domain/navigation"
 	"github.com/andrescamacho/spacetraders-go/internal/domain/shared"
 	domainSystem "github.com/andrescamacho/spacetraders-go/internal/domain/system"
@@ -57,6 +58,34 @@ type RouteExecutor struct {
 	// is skipped when systemCharter is absent.
 	warpNavigator WarpNavigator
 	systemCharter SystemCharter
+
+	// progress records each ship's per-segment progress for GetNavigationProgressQuery.
+	progress *NavigationProgressTracker
+
+	// fuelPrices reads the local FUEL price for the strategy's cargo-fuel
+	// decision; nil until WithFuelPriceReader, which leaves every refuel a
+	// market refuel.
+	fuelPrices FuelPriceReader
+
+	// waypointFailures hears of every segment that fails at its destination;
+	// nil until WithWaypointFailureReporter.
+	waypointFailures WaypointFailureReporter
+
+	// fuelService picks each segment's flight mode after a refuel; uncorrected
+	// until WithFuelCalibration.
+	fuelService *domainNavigation.ShipFuelService
+}
+
+// WaypointFailureReporter counts failures at a waypoint towards blacklisting
+// it. Satisfied by the daemon's health monitor.
+type WaypointFailureReporter interface {
+	RecordWaypointFailure(ctx context.Context, playerID int, waypoint, reason string) (bool, error)
+}
+
+// FuelPriceReader reads a waypoint's cached market, for the FUEL price a refuel
+// would pay there. Satisfied by the market repository.
+type FuelPriceReader interface {
+	GetMarketData(ctx context.Context, waypointSymbol string, playerID int) (*domainMarket.Market, error)
 }
 
 // NewRouteExecutor creates a new route executor
@@ -97,9 +126,17 @@ func NewRouteExecutor(
 		refuelStrategy:      refuelStrategy,
 		waypointRepo:        waypointRepo,
 		shipEventSubscriber: shipEventSubscriber,
+		progress:            NewNavigationProgressTracker(),
+		fuelService:         domainNavigation.NewShipFuelService(nil),
 	}
 }
 
+// Progress returns the tracker this executor reports segment progress to, for
+// wiring into the GetNavigationProgress query handler.
+func (e *RouteExecutor) Progress() *NavigationProgressTracker {
+	return e.progress
+}
+
 // WithWarpSupport attaches the off-gate warp capability to an already
 // constructed executor and returns it for chaining. It is deliberately separate
 // from the constructor so the eight-arg NewRouteExecutor signature - and every
@@ -115,6 +152,29 @@ func (e *RouteExecutor) WithWarpSupport(warpNavigator WarpNavigator, charter Sys
 	return e
 }
 
+// WithFuelPriceReader lets refuels burn a ship's FUEL reserve where the market
+// fuel is absent or overpriced, as the refuel strategy decides. Called once at
+// wiring time; returns the executor for chaining.
+func (e *RouteExecutor) WithFuelPriceReader(reader FuelPriceReader) *RouteExecutor {
+	e.fuelPrices = reader
+	return e
+}
+
+// WithFuelCalibration makes the executor's flight-mode choices use the fuel
+// costs calibration has fitted from observed navigations.
+func (e *RouteExecutor) WithFuelCalibration(calibration domainNavigation.FuelCalibrationSource) *RouteExecutor {
+	e.fuelService = domainNavigation.NewShipFuelService(calibration)
+	return e
+}
+
+// WithWaypointFailureReporter reports each failed segment's destination to
+// reporter, so a waypoint that keeps failing ships gets blacklisted. Called
+// once at wiring time; returns the executor for chaining.
+func (e *RouteExecutor) WithWaypointFailureReporter(reporter WaypointFailureReporter) *RouteExecutor {
+	e.waypointFailures = reporter
+	return e
+}
+
 // ExecuteRoute executes a route step-by-step using atomic commands
 //
 // This orchestrates all the atomic commands we created in Phase 2.1-2.3:
@@ -137,6 +197,7 @@ func (e *RouteExecutor) ExecuteRoute(
 	if err := route.StartExecution(); err != nil {
 		return fmt.Errorf("failed to start route execution: %w", err)
 	}
+	e.logProgress(ctx, e.progress.Start(route, ship.Fuel().Current, e.clock.Now()))
 
 	// 1. Handle IN_TRANSIT from previous command (idempotency)
 	// This makes navigation commands idempotent - you can send them at any time
@@ -156,6 +217,12 @@ func (e *RouteExecutor) ExecuteRoute(
 	// 3. Execute each segment
 	segmentCount := 0
 	for {
+		// A cancelled or expired context stops the route between segments, so
+		// nothing departs for a caller that has already gone away.
+		if err := ctx.Err(); err != nil {
+			return e.cancelRoute(ctx, route, ship, segmentCount, err)
+		}
+
 		segment := route.NextSegment()
 
 		if segment == nil {
@@ -176,7 +243,7 @@ func (e *RouteExecutor) ExecuteRoute(
 			"to":            segment.ToWaypoint.Symbol,
 		})
 
-		if err := e.executeSegment(ctx, segment, ship, playerID); err != nil {
+		if err := e.executeSegment(ctx, segment, segmentCount, ship, playerID); err != nil {
 			return e.reactToSegmentFailure(ctx, route, ship, segment, segmentCount, err)
 		}
 
@@ -217,6 +284,8 @@ func (e *RouteExecutor) ExecuteRoute(
 		route.TotalFuelRequired(),
 	)
 
+	e.logProgress(ctx, e.progress.Finish(ship.ShipSymbol(), string(route.Status()), e.clock.Now()))
+
 	logger.Log("INFO", "Route execution finished", map[string]interface{}{
 		"ship_symbol":       ship.ShipSymbol(),
 		"action":            "route_finished",
@@ -262,6 +331,7 @@ func (e *RouteExecutor) reactToSegmentFailure(
 			"to":            segment.ToWaypoint.Symbol,
 			"attempts":      arrivalErr.Attempts,
 		})
+		e.logProgress(ctx, e.progress.Finish(ship.ShipSymbol(), "PARKED", e.clock.Now()))
 		return err
 	}
 
@@ -273,6 +343,7 @@ func (e *RouteExecutor) reactToSegmentFailure(
 		"to":            segment.ToWaypoint.Symbol,
 		"error":         err.Error(),
 	})
+	e.reportWaypointFailure(ctx, ship, segment.ToWaypoint.Symbol, err)
 	if failErr := route.FailRoute(err.Error()); failErr != nil {
 		logger.Log("ERROR", "Failed to mark route as failed", map[string]interface{}{
 			"ship_symbol": ship.ShipSymbol(),
@@ -290,14 +361,42 @@ func (e *RouteExecutor) reactToSegmentFailure(
 		int(route.TotalDistance()),
 		route.TotalFuelRequired(),
 	)
+	e.logProgress(ctx, e.progress.Finish(ship.ShipSymbol(), string(route.Status()), e.clock.Now()))
 
 	return err
 }
 
+// reportWaypointFailure hands a segment failure to the waypoint failure
+// reporter. Cancellations say nothing about the waypoint and are not reported.
+func (e *RouteExecutor) reportWaypointFailure(ctx context.Context, ship *domainNavigation.Ship, waypoint string, err error) {
+	if e.waypointFailures == nil || errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+		return
+	}
+	logger := common.LoggerFromContext(ctx)
+	blacklisted, reportErr := e.waypointFailures.RecordWaypointFailure(ctx, ship.PlayerID().Value(), waypoint, err.Error())
+	if reportErr != nil {
+		logger.Log("WARNING", "Failed to blacklist repeatedly failing waypoint", map[string]interface{}{
+			"ship_symbol": ship.ShipSymbol(),
+			"action":      "waypoint_blacklist_failed",
+			"waypoint":    waypoint,
+			"error":       reportErr.Error(),
+		})
+		return
+	}
+	if blacklisted {
+		logger.Log("WARNING", "Waypoint blacklisted after repeated failures", map[string]interface{}{
+			"ship_symbol": ship.ShipSymbol(),
+			"action":      "waypoint_blacklisted",
+			"waypoint":    waypoint,
+		})
+	}
+}
+
 // executeSegment executes a single route segment using atomic commands
 func (e *RouteExecutor) executeSegment(
 	ctx context.Context,
 	segment *domainNavigation.RouteSegment,
+	segmentIndex int,
 	ship *domainNavigation.Ship,
 	playerID shared.PlayerID,
 ) error {
@@ -310,6 +409,10 @@ func (e *RouteExecutor) executeSegment(
 		}
 	}
 
+	if segment.OrbitalHop {
+		return e.executeOrbitalHop(ctx, segment, segmentIndex, ship, playerID)
+	}
+
 	if err := e.ensureShipInOrbit(ctx, ship, playerID); err != nil {
 		return err
 	}
@@ -329,7 +432,7 @@ func (e *RouteExecutor) executeSegment(
 		return err
 	}
 
-	if err := e.navigateToSegmentDestination(ctx, segment, ship, playerID, flightMode); err != nil {
+	if err := e.navigateToSegmentDestination(ctx, segment, segmentIndex, ship, playerID, flightMode); err != nil {
 		return err
 	}
 
@@ -343,6 +446,54 @@ func (e *RouteExecutor) executeSegment(
 	return nil
 }
 
+// executeOrbitalHop moves the ship to an orbital neighbour. The hop burns no fuel, so
+// the pre-departure refuel, flight-mode selection and affordability checks are all
+// skipped and the ship keeps its current flight mode. Only a refuel the routing engine
+// planned at the destination is honoured — that one funds the NEXT leg, not this hop.
+func (e *RouteExecutor) executeOrbitalHop(
+	ctx context.Context,
+	segment *domainNavigation.RouteSegment,
+	segmentIndex int,
+	ship *domainNavigation.Ship,
+	playerID shared.PlayerID,
+) error {
+	logger := common.LoggerFromContext(ctx)
+	logger.Log("INFO", "Orbital hop - navigating without refuel or flight-mode checks", map[string]interface{}{
+		"ship_symbol": ship.ShipSymbol(),
+		"action":      "orbital_hop",
+		"from":        segment.FromWaypoint.Symbol,
+		"to":          segment.ToWaypoint.Symbol,
+	})
+
+	if err := e.ensureShipInOrbit(ctx, ship, playerID); err != nil {
+		return err
+	}
+
+	currentMode, ok := shared.ParseFlightMode(ship.FlightMode())
+	if !ok {
+		currentMode = segment.FlightMode
+	}
+	if err := e.navigateToSegmentDestination(ctx, segment, segmentIndex, ship, playerID, currentMode); err != nil {
+		return err
+	}
+
+	if segment.RequiresRefuel {
+		logger.Log("INFO", "Ship performing planned refuel", map[string]interface{}{
+			"ship_symbol": ship.ShipSymbol(),
+			"action":      "planned_refuel",
+			"waypoint":    segment.ToWaypoint.Symbol,
+		})
+		if err := e.refuelShipWithRetry(ctx, ship, playerID, false); err != nil {
+			return err
+		}
+	}
+
+	e.scanMarketIfPresent(ctx, segment, ship, playerID)
+	e.scanShipyardIfPresent(ctx, segment, ship, playerID)
+
+	return nil
+}
+
 func (e *RouteExecutor) ensureShipInOrbit(ctx context.Context, ship *domainNavigation.Ship, playerID shared.PlayerID) error {
 	orbitCmd := &types.OrbitShipCommand{
 		Ship:     ship,
@@ -389,8 +540,7 @@ func (e *RouteExecutor) selectOptimalFlightMode(ctx context.Context, segment *do
 	}
 
 	distance := segment.FromWaypoint.DistanceTo(segment.ToWaypoint)
-	fuelService := domainNavigation.NewShipFuelService()
-	optimalMode := fuelService.SelectOptimalFlightMode(ship.Fuel().Current, distance, domainNavigation.DefaultFuelSafetyMargin)
+	optimalMode := e.fuelService.SelectOptimalFlightMode(ship.Fuel().Current, distance, domainNavigation.DefaultFuelSafetyMargin)
 
 	flightMode := segment.FlightMode
 	if optimalMode > segment.FlightMode {
@@ -486,7 +636,7 @@ func (e *RouteExecutor) ensureAffordableFlightMode(
 	flightMode = e.selectOptimalFlightMode(ctx, segment, ship)
 	if ship.Fuel().Current < flightMode.FuelCost(distance) {
 		// Genuinely stranded: no fuel station here and too little fuel to move.
-		return flightMode, fmt.Errorf(
+		return flightMode, shared.Errorf(shared.CodeInsufficientFuel,
 			"insufficient fuel to depart %s for %s: have %d, need %d for %s over distance %.0f and no fuel station to refuel",
 			segment.FromWaypoint.Symbol, segment.ToWaypoint.Symbol,
 			ship.Fuel().Current, flightMode.FuelCost(distance), flightMode.Name(), distance,
@@ -507,8 +657,9 @@ func (e *RouteExecutor) setShipFlightMode(ctx context.Context, ship *domainNavig
 	return nil
 }
 
-func (e *RouteExecutor) navigateToSegmentDestination(ctx context.Context, segment *domainNavigation.RouteSegment, ship *domainNavigation.Ship, playerID shared.PlayerID, flightMode shared.FlightMode) error {
+func (e *RouteExecutor) navigateToSegmentDestination(ctx context.Context, segment *domainNavigation.RouteSegment, segmentIndex int, ship *domainNavigation.Ship, playerID shared.PlayerID, flightMode shared.FlightMode) error {
 	logger := common.LoggerFromContext(ctx)
+	fuelBefore := ship.Fuel().Current
 
 	navCmd := &types.NavigateDirectCommand{
 		Ship:                ship,
@@ -540,9 +691,17 @@ func (e *RouteExecutor) navigateToSegmentDestination(ctx context.Context, segmen
 			"action":      "navigate",
 			"result":      "already_present",
 		})
+		e.logProgress(ctx, e.progress.Arrived(ship.ShipSymbol(), segmentIndex, 0, ship.Fuel().Current, e.clock.Now()))
 		return nil
 	}
 
+	var legArrival *time.Time
+	if arrival, err := shared.NewArrivalTime(navResponse.ArrivalTimeStr); err == nil {
+		arrivalAt := arrival.Time()
+		legArrival = &arrivalAt
+	}
+	e.logProgress(ctx, e.progress.Departed(ship.ShipSymbol(), segmentIndex, segment, legArrival, e.clock.Now()))
+
 	if navResponse.ArrivalTimeStr != "" {
 		if err := e.waitForArrival(ctx, ship, navResponse.ArrivalTimeStr, playerID); err != nil {
 			return err
@@ -570,9 +729,39 @@ func (e *RouteExecutor) navigateToSegmentDestination(ctx context.Context, segmen
 		}
 	}
 
+	fuelUsed := fuelBefore - ship.Fuel().Current
+	if fuelUsed < 0 {
+		fuelUsed = 0
+	}
+	e.logProgress(ctx, e.progress.Arrived(ship.ShipSymbol(), segmentIndex, fuelUsed, ship.Fuel().Current, e.clock.Now()))
+
 	return nil
 }
 
+// logProgress appends a segment progress snapshot to the container log. A
+// snapshot for a ship with no tracked route (a refuel outside ExecuteRoute) is
+// skipped.
+func (e *RouteExecutor) logProgress(ctx context.Context, progress NavigationProgress) {
+	if progress.TotalSegments == 0 {
+		return
+	}
+	fields := map[string]interface{}{
+		"ship_symbol":  progress.ShipSymbol,
+		"action":       "segment_progress",
+		"event":        progress.LastEvent,
+		"segment":      progress.SegmentLabel(),
+		"from":         progress.From,
+		"to":           progress.To,
+		"fuel_current": progress.FuelCurrent,
+		"fuel_used":    progress.FuelUsed,
+		"status":       progress.Status,
+	}
+	if progress.ETA != nil {
+		fields["eta"] = progress.ETA.UTC().Format(time.RFC3339)
+	}
+	common.LoggerFromContext(ctx).Log("INFO", "Route segment progress", fields)
+}
+
 func (e *RouteExecutor) handlePostArrivalRefueling(ctx context.Context, segment *domainNavigation.RouteSegment, ship *domainNavigation.Ship, playerID shared.PlayerID) error {
 	logger := common.LoggerFromContext(ctx)
 
@@ -675,6 +864,27 @@ func (e *RouteExecutor) scanShipyardIfPresent(ctx context.Context, segment *doma
 	}
 }
 
+// cancelRoute fails the route because its context ended before the next
+// segment. The ship is left where the last completed segment put it.
+func (e *RouteExecutor) cancelRoute(
+	ctx context.Context,
+	route *domainNavigation.Route,
+	ship *domainNavigation.Ship,
+	segmentCount int,
+	cause error,
+) error {
+	common.LoggerFromContext(ctx).Log("WARNING", "Route cancelled before the next segment", map[string]interface{}{
+		"ship_symbol":       ship.ShipSymbol(),
+		"action":            "route_cancelled",
+		"segments_executed": segmentCount,
+		"total_segments":    len(route.Segments()),
+		"error":             cause.Error(),
+	})
+	_ = route.FailRoute("cancelled: " + cause.Error())
+	e.logProgress(ctx, e.progress.Finish(ship.ShipSymbol(), "CANCELLED", e.clock.Now()))
+	return fmt.Errorf("route cancelled after %d of %d segment(s): %w", segmentCount, len(route.Segments()), cause)
+}
+
 // waitForCurrentTransit waits for ship to complete its current transit using event-based notification.
 // CRITICAL: After waiting, persists ship state to DB to prevent stale state loops.
 func (e *RouteExecutor) waitForCurrentTransit(
@@ -698,7 +908,7 @@ func (e *RouteExecutor) waitForCurrentTransit(
 	// Calculate wait time from DB arrival time
 	var waitTimeSeconds int
 	if ship.ArrivalTime() != nil {
-		waitTime := time.Until(*ship.ArrivalTime())
+		waitTime := ship.ArrivalTime().Sub(e.clock.Now())
 		if waitTime > 0 {
 			waitTimeSeconds = int(waitTime.Seconds())
 		}
@@ -706,7 +916,7 @@ func (e *RouteExecutor) waitForCurrentTransit(
 
 	// Event-based waiting, with a timeout->resync->park backstop if the
 	// ARRIVED event is lost or raced against subscription.
-	if err := WaitForShipArrival(ctx, e.shipRepo, e.shipEventSubscriber, ship, playerID, waitTimeSeconds, logger); err != nil {
+	if err := WaitForShipArrival(shared.WithClock(ctx, e.clock), e.shipRepo, e.shipEventSubscriber, ship, playerID, waitTimeSeconds, logger); err != nil {
 		return err
 	}
 
@@ -825,9 +1035,11 @@ func (e *RouteExecutor) refuelShip(
 ) error {
 	logger := common.LoggerFromContext(ctx)
 
+	fromCargo := e.shouldRefuelFromCargo(ctx, ship, playerID)
+
 	// GRACEFUL DEGRADATION: Skip refuel if current location has no fuel station
 	// This handles stale waypoint cache data or routing service errors
-	if !ship.CurrentLocation().HasFuel {
+	if !fromCargo && !ship.CurrentLocation().HasFuel {
 		logger.Log("WARNING", "Ship cannot refuel - no fuel station at current location", map[string]interface{}{
 			"ship_symbol": ship.ShipSymbol(),
 			"action":      "refuel_skipped",
@@ -845,14 +1057,38 @@ func (e *RouteExecutor) refuelShip(
 		return fmt.Errorf("failed to dock for refuel: %w", err)
 	}
 
-	refuelCmd := &types.RefuelShipCommand{
-		Ship:     ship,
-		PlayerID: playerID,
-		Units:    nil, // Full refuel
+	if fromCargo {
+		cargoCmd := &types.RefuelShipCommand{
+			Ship:      ship,
+			PlayerID:  playerID,
+			FromCargo: true,
+		}
+		if _, err := e.mediator.Send(ctx, cargoCmd); err != nil {
+			if !ship.CurrentLocation().HasFuel {
+				return fmt.Errorf("failed to refuel from cargo: %w", err)
+			}
+			logger.Log("WARNING", "Refuel from cargo failed - buying at the market instead", map[string]interface{}{
+				"ship_symbol": ship.ShipSymbol(),
+				"action":      "cargo_refuel_fallback",
+				"waypoint":    ship.CurrentLocation().Symbol,
+				"error":       err.Error(),
+			})
+		}
 	}
-	if _, err := e.mediator.Send(ctx, refuelCmd); err != nil {
-		return fmt.Errorf("failed to refuel: %w", err)
+
+	// Top off at the market whatever the hold could not cover (a cargo unit
+	// only burns when its whole 100 fuel fits the tank).
+	if ship.CurrentLocation().HasFuel && ship.Fuel().Current < ship.Fuel().Capacity {
+		refuelCmd := &types.RefuelShipCommand{
+			Ship:     ship,
+			PlayerID: playerID,
+			Units:    nil, // Full refuel
+		}
+		if _, err := e.mediator.Send(ctx, refuelCmd); err != nil {
+			return fmt.Errorf("failed to refuel: %w", err)
+		}
 	}
+	e.logProgress(ctx, e.progress.Refueled(ship.ShipSymbol(), ship.Fuel().Current, e.clock.Now()))
 
 	// CUT 2: only return to orbit when a navigate follows. When a trade at the
 	// same waypoint follows we deliberately stay docked (see doc comment).
@@ -871,6 +1107,28 @@ func (e *RouteExecutor) refuelShip(
 	return nil
 }
 
+// shouldRefuelFromCargo asks the refuel strategy whether this refuel should burn
+// the ship's FUEL reserve. A fuel station whose price cannot be read is left to
+// the market refuel, as before.
+func (e *RouteExecutor) shouldRefuelFromCargo(ctx context.Context, ship *domainNavigation.Ship, playerID shared.PlayerID) bool {
+	if e.fuelPrices == nil || ship.Cargo() == nil || ship.Cargo().GetItemUnits(shared.FuelCargoSymbol) == 0 {
+		return false
+	}
+	price := 0
+	if location := ship.CurrentLocation(); location.HasFuel {
+		mkt, err := e.fuelPrices.GetMarketData(ctx, location.Symbol, playerID.Value())
+		if err != nil || mkt == nil {
+			return false
+		}
+		good := mkt.FindGood(shared.FuelCargoSymbol)
+		if good == nil {
+			return false
+		}
+		price = good.SellPrice()
+	}
+	return e.refuelStrategy.ShouldRefuelFromCargo(ship, price)
+}
+
 // waitForArrival waits for ship to arrive at destination using event-based notification.
 // Uses ShipEventSubscriber to receive ARRIVED event from ShipStateScheduler.
 func (e *RouteExecutor) waitForArrival(
@@ -885,7 +1143,7 @@ func (e *RouteExecutor) waitForArrival(
 	if err != nil {
 		return fmt.Errorf("failed to parse arrival time: %w", err)
 	}
-	waitTime := arrivalTime.CalculateWaitTime()
+	waitTime := arrivalTime.WaitTimeAt(e.clock.Now())
 
 	// If ship is not in transit, no need to wait
 	if ship.NavStatus() != domainNavigation.NavStatusInTransit {
@@ -894,5 +1152,5 @@ func (e *RouteExecutor) waitForArrival(
 
 	// Event-based waiting, with a timeout->resync->park backstop if the
 	// ARRIVED event is lost or raced against subscription.
-	return WaitForShipArrival(ctx, e.shipRepo, e.shipEventSubscriber, ship, playerID, waitTime, logger)
+	return WaitForShipArrival(shared.WithClock(ctx, e.clock), e.shipRepo, e.shipEventSubscriber, ship, playerID, waitTime, logger)
 }
@@ -0,0 +1,41 @@
+package ship
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	domainNavigation "github.com/andrescamacho/spacetraders-go/internal/domain/navigation"
+	"github.com/andrescamacho/spacetraders-go/internal/domain/shared"
+)
+
+// A route whose context is already cancelled fails before its first segment:
+// nothing departs, and the caller can tell cancellation from a failed leg.
+func TestExecuteRoute_CancelledContextStopsBeforeDeparting(t *testing.T) {
+	a := mustWaypoint(t, "X1-TORWIND-A", 0, 0)
+	b := mustWaypoint(t, "X1-TORWIND-B", 50, 0)
+	ship := newExecutorTestShip(t, 400, 400, a)
+
+	leg := domainNavigation.NewRouteSegment(a, b, 50, 50, 0, shared.FlightModeCruise, false)
+	route, err := domainNavigation.NewRoute(
+		"route-torwind-cancel", "TORWIND-1", 1,
+		[]*domainNavigation.RouteSegment{leg}, 400, false,
+	)
+	if err != nil {
+		t.Fatalf("NewRoute: %v", err)
+	}
+
+	fake := &recordingMediator{fuel: 400, capacity: 400, distByDest: map[string]float64{b.Symbol: 50}}
+	executor := NewRouteExecutor(nil, fake, nil, nil, nil, nil, nil, stubSubscriber{})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	err = executor.ExecuteRoute(ctx, route, ship, shared.MustNewPlayerID(1))
+	if !errors.Is(err, context.Canceled) {
+		t.Fatalf("expected an error wrapping context.Canceled, got %v", err)
+	}
+	if navCmds := fake.navigateCommands(); len(navCmds) != 0 {
+		t.Fatalf("a cancelled route must not depart, got %d navigate command(s)", len(navCmds))
+	}
+}
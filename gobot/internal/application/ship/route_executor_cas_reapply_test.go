@@ -68,6 +68,9 @@ func TestWaitForCurrentTransit_ArrivalWriteDoesNotClobberFreshCargo(t *testing.T
 	}).Error)
 
 	shipRepo := api.NewShipRepository(nil, nil, nil, transitStubWaypoints{}, db, nil)
+	// The reads below stand in for snapshots taken before the arrival was due;
+	// the read-path arrival sync would otherwise land the arrival on load.
+	shipRepo.SetArrivalSyncOnRead(false)
 	executor := NewRouteExecutor(shipRepo, nil, nil, nil, nil, nil, nil, arrivedNowSubscriber{})
 
 	// The executor's in-memory snapshot: still IN_TRANSIT, cargo 100.
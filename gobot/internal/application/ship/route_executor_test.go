@@ -335,6 +335,53 @@ func TestExecuteRoute_ZeroFuelStrandedFailsLocallyNotWith4203(t *testing.T) {
 	}
 }
 
+// TestExecuteRoute_OrbitalHopSkipsRefuelAndFlightModeChange pins the orbital-hop fast
+// path. The ship sits at a fuel station well under the refuel threshold and flies DRIFT;
+// a normal leg would refuel first and re-pick its mode. An orbital hop costs no fuel, so
+// it must go out as a bare orbit + navigate in the ship's current mode.
+func TestExecuteRoute_OrbitalHopSkipsRefuelAndFlightModeChange(t *testing.T) {
+	planet := mustWaypoint(t, "X1-TORWIND-A1", 30, 30)
+	moon := mustWaypoint(t, "X1-TORWIND-A2", 30, 30)
+	planet.HasFuel = true
+	planet.Orbitals = []string{moon.Symbol}
+
+	ship := newExecutorTestShip(t, 10, 400, planet)
+	ship.SetFlightMode(shared.FlightModeDrift.Name())
+
+	hop := domainNavigation.NewRouteSegment(planet, moon, 0, 0, 0, shared.FlightModeCruise, false)
+	hop.OrbitalHop = true
+	route, err := domainNavigation.NewRoute(
+		"route-torwind-1", "TORWIND-1", 1,
+		[]*domainNavigation.RouteSegment{hop}, 400, false,
+	)
+	if err != nil {
+		t.Fatalf("NewRoute: %v", err)
+	}
+
+	fake := &recordingMediator{fuel: 10, capacity: 400, distByDest: map[string]float64{}}
+	executor := NewRouteExecutor(nil, fake, nil, nil, nil, nil, nil, stubSubscriber{})
+
+	if err := executor.ExecuteRoute(context.Background(), route, ship, shared.MustNewPlayerID(1)); err != nil {
+		t.Fatalf("ExecuteRoute: %v", err)
+	}
+
+	if got := fake.refuelAttempts(); got != 0 {
+		t.Fatalf("an orbital hop must not refuel, got %d refuel attempts", got)
+	}
+	for _, c := range fake.commands {
+		if _, ok := c.(*types.SetFlightModeCommand); ok {
+			t.Fatalf("an orbital hop must not change flight mode")
+		}
+	}
+	navCmds := fake.navigateCommands()
+	if len(navCmds) != 1 || navCmds[0].Destination != moon.Symbol {
+		t.Fatalf("expected a single navigate to %s, got %d", moon.Symbol, len(navCmds))
+	}
+	if navCmds[0].FlightMode != shared.FlightModeDrift.Name() {
+		t.Fatalf("expected the hop to keep the ship's DRIFT mode, got %s", navCmds[0].FlightMode)
+	}
+}
+
 // fakeWaypointRepo is a minimal domainSystem.WaypointRepository test double.
 // Only ListBySystemWithTrait is exercised by refuelAtAlternateStop (sp-vsfn);
 // the other methods panic if called since no test in this file drives them.
@@ -46,6 +46,10 @@ func (e *ErrWarpWouldStrand) Error() string {
 	)
 }
 
+// A strand refusal is a fuel shortfall to callers branching on codes.
+func (e *ErrWarpWouldStrand) ErrorCode() shared.ErrorCode { return shared.CodeInsufficientFuel }
+func (e *ErrWarpWouldStrand) Is(target error) bool        { return shared.ErrInsufficientFuel.Is(target) }
+
 // warpFuelCost is the fuel a warp leg consumes over distance. Warp is fuel-costed
 // by inter-system distance at the CRUISE rate (1 fuel per distance unit, floored
 // at 1 for any non-zero leg) - the same baseline navigate uses - so the executor
@@ -3,6 +3,7 @@ package ship
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/andrescamacho/spacetraders-go/internal/application/common"
 	domainNavigation "github.com/andrescamacho/spacetraders-go/internal/domain/navigation"
@@ -14,6 +15,13 @@ import (
 // RoutePlanner handles route planning using routing client
 type RoutePlanner struct {
 	routingClient domainRouting.RoutingClient
+	fuelDepots    *FuelDepotIndex
+	matrices      *DistanceMatrixCache
+	// driftLimit is the longest a drifting plan may take before the planner
+	// asks for one that refuels instead; 0 accepts any drift.
+	driftLimit  time.Duration
+	blacklist   *WaypointBlacklist
+	fuelReserve domainNavigation.FuelReservePolicy
 }
 
 // NewRoutePlanner creates a new route planner
@@ -23,7 +31,46 @@ func NewRoutePlanner(routingClient domainRouting.RoutingClient) *RoutePlanner {
 	}
 }
 
-// PlanRoute plans a route from ship's current location to destination
+// SetFuelDepotIndex makes route planning prefer cheap, central fuel depots as
+// refuel stops. Without an index every fuel station is an equal refuel stop.
+func (p *RoutePlanner) SetFuelDepotIndex(index *FuelDepotIndex) {
+	p.fuelDepots = index
+}
+
+// SetDistanceMatrixCache attaches each system's precomputed distance matrix to
+// route requests, so the routing backend looks hop costs up instead of
+// recomputing them. Without a cache the backend computes every distance.
+func (p *RoutePlanner) SetDistanceMatrixCache(cache *DistanceMatrixCache) {
+	p.matrices = cache
+}
+
+// SetDriftRouteLimit rejects plans that drift and take longer than limit (or
+// run the tank dry), replanning without DRIFT so the route refuels instead.
+// 0 turns the gate off.
+func (p *RoutePlanner) SetDriftRouteLimit(limit time.Duration) {
+	p.driftLimit = limit
+}
+
+// SetWaypointBlacklist keeps plans off the player's blacklisted waypoints:
+// they are never offered as hops or refuel stops, and a blacklisted
+// destination fails the plan. Without one every waypoint is eligible.
+func (p *RoutePlanner) SetWaypointBlacklist(blacklist *WaypointBlacklist) {
+	p.blacklist = blacklist
+}
+
+// SetFuelReserve makes every plan keep policy's share of the tank aboard on
+// arrival anywhere along the route; a route that cannot is a planning error
+// (domainNavigation.ErrFuelReserveViolation). A zero policy turns it off.
+func (p *RoutePlanner) SetFuelReserve(policy domainNavigation.FuelReservePolicy) {
+	p.fuelReserve = policy
+}
+
+// FuelReserve returns the fleet-wide fuel reserve plans keep by default.
+func (p *RoutePlanner) FuelReserve() domainNavigation.FuelReservePolicy {
+	return p.fuelReserve
+}
+
+// PlanRoute plans the fastest route from ship's current location to destination
 func (p *RoutePlanner) PlanRoute(
 	ctx context.Context,
 	ship *domainNavigation.Ship,
@@ -31,37 +78,350 @@ func (p *RoutePlanner) PlanRoute(
 	waypoints map[string]*shared.Waypoint,
 	preferCruise bool,
 ) (*domainNavigation.Route, error) {
-	// Convert waypoints to DTO
+	return p.PlanRouteWithObjective(ctx, ship, destination, waypoints, preferCruise, domainRouting.RouteObjective{})
+}
+
+// PlanRouteWithObjective plans a route from ship's current location to
+// destination that minimizes objective's weighted time, fuel and refuel stops.
+func (p *RoutePlanner) PlanRouteWithObjective(
+	ctx context.Context,
+	ship *domainNavigation.Ship,
+	destination string,
+	waypoints map[string]*shared.Waypoint,
+	preferCruise bool,
+	objective domainRouting.RouteObjective,
+) (*domainNavigation.Route, error) {
+	return p.PlanRouteWithFuelReserve(ctx, ship, destination, waypoints, preferCruise, objective, p.fuelReserve)
+}
+
+// PlanRouteWithFuelReserve is PlanRouteWithObjective under reserve instead of
+// the fleet-wide policy. The reserve is carved out of the tank before the
+// routing engine sees it, so every leg and refuel stop is chosen to keep it
+// aboard. A ship already low on fuel may still dip into the reserve on a
+// first leg that ends at a fuel stop, so it can go and refuel; one that can
+// only reach the destination by dipping into it elsewhere gets an
+// ErrFuelReserveViolation naming where, rather than a route.
+func (p *RoutePlanner) PlanRouteWithFuelReserve(
+	ctx context.Context,
+	ship *domainNavigation.Ship,
+	destination string,
+	waypoints map[string]*shared.Waypoint,
+	preferCruise bool,
+	objective domainRouting.RouteObjective,
+	reserve domainNavigation.FuelReservePolicy,
+) (*domainNavigation.Route, error) {
+	// The ship's own waypoint stays in the graph even when blacklisted, so a
+	// ship parked at one can still route away from it.
+	blacklisted := p.blacklist.Blacklisted(ctx, ship.PlayerID().Value())
+	if blacklisted[destination] {
+		return nil, &system.ErrWaypointBlacklisted{WaypointSymbol: destination}
+	}
+
+	// Convert waypoints to DTO. Refuel stops are only offered where a market scan
+	// verified FUEL; trait-implied fuel stands in while the system has no scans.
+	// The distance matrix keeps every waypoint, so blacklisting one does not
+	// churn it.
+	verifiedFuel := shared.AnyVerifiedFuel(waypoints)
+	allWaypoints := make([]*system.WaypointData, 0, len(waypoints))
 	waypointData := make([]*system.WaypointData, 0, len(waypoints))
 	for _, wp := range waypoints {
-		waypointData = append(waypointData, &system.WaypointData{
+		data := &system.WaypointData{
 			Symbol:  wp.Symbol,
 			X:       wp.X,
 			Y:       wp.Y,
-			HasFuel: wp.HasFuel,
-		})
+			HasFuel: wp.IsPlannableFuelStop(verifiedFuel),
+		}
+		allWaypoints = append(allWaypoints, data)
+		if blacklisted[wp.Symbol] && wp.Symbol != ship.CurrentLocation().Symbol {
+			continue
+		}
+		waypointData = append(waypointData, data)
 	}
 
-	// Create routing request
+	// Create routing request, with the fuel reserve held back from the tank
+	reserveUnits := reserve.Units(ship.FuelCapacity())
 	request := &domainRouting.RouteRequest{
 		SystemSymbol:  ship.CurrentLocation().SystemSymbol,
 		StartWaypoint: ship.CurrentLocation().Symbol,
 		GoalWaypoint:  destination,
-		CurrentFuel:   ship.Fuel().Current,
-		FuelCapacity:  ship.FuelCapacity(),
+		CurrentFuel:   max(ship.Fuel().Current-reserveUnits, 0),
+		FuelCapacity:  ship.FuelCapacity() - reserveUnits,
 		EngineSpeed:   ship.EngineSpeed(),
 		Waypoints:     waypointData,
 		PreferCruise:  preferCruise,
+		Objective:     objective,
+	}
+	if p.matrices != nil {
+		request.Matrix = p.matrices.Matrix(ctx, request.SystemSymbol, request.EngineSpeed, allWaypoints)
 	}
 
-	// Call routing client
-	routeResponse, err := p.routingClient.PlanRoute(ctx, request)
+	// Call routing client, offering only the preferred fuel depots as refuel
+	// stops first. The routing engine refuels wherever a station is offered, so
+	// withholding the pricey ones is what steers the refuels to the cheap ones.
+	routeResponse, err := p.planViaPreferredDepots(ctx, request, ship)
 	if err != nil {
+		if reserveUnits > 0 {
+			return p.planOnFullTank(ctx, request, ship, waypoints, reserve, err)
+		}
 		return nil, fmt.Errorf("routing client error: %w", err)
 	}
+	routeResponse = p.gateDrift(ctx, request, routeResponse, ship)
 
 	// Convert route response to Route domain entity
-	return p.createRouteFromPlan(ctx, routeResponse, ship, waypoints)
+	route, err := p.createRouteFromPlan(ctx, routeResponse, ship, waypoints)
+	if err != nil {
+		return nil, err
+	}
+	// The engine planned within the carved tank; fly the result on the real
+	// one to catch a plan that still dips below the reserve.
+	if err := domainNavigation.NewShipFuelService(nil).CheckFuelReserve(route, ship.Fuel().Current, ship.FuelCapacity(), reserve); err != nil {
+		return nil, p.fuelReserveViolation(ctx, ship, reserve, err)
+	}
+	return route, nil
+}
+
+// planOnFullTank replans a request the carved tank could not route on the
+// ship's real tank, after a routing failure under a fuel reserve. The plan is
+// accepted when it still keeps the reserve, which a low-fuel ship's run to a
+// nearby fuel stop does (CheckFuelReserve lets the first leg to a fuel stop
+// dip into it). A plan that breaches the reserve, or a ship below it with no
+// plan at all, is an ErrFuelReserveViolation; otherwise the routing failure
+// stands.
+func (p *RoutePlanner) planOnFullTank(
+	ctx context.Context,
+	request *domainRouting.RouteRequest,
+	ship *domainNavigation.Ship,
+	waypoints map[string]*shared.Waypoint,
+	reserve domainNavigation.FuelReservePolicy,
+	routingErr error,
+) (*domainNavigation.Route, error) {
+	unreserved := *request
+	unreserved.CurrentFuel = ship.Fuel().Current
+	unreserved.FuelCapacity = ship.FuelCapacity()
+	plan, err := p.planViaPreferredDepots(ctx, &unreserved, ship)
+	if err != nil || plan == nil || len(plan.Steps) == 0 {
+		reserveUnits := reserve.Units(ship.FuelCapacity())
+		if ship.Fuel().Current < reserveUnits {
+			return nil, p.fuelReserveViolation(ctx, ship, reserve, &domainNavigation.ErrFuelReserveViolation{
+				ShipSymbol:     ship.ShipSymbol(),
+				Waypoint:       ship.CurrentLocation().Symbol,
+				FuelLeft:       ship.Fuel().Current,
+				Reserve:        reserveUnits,
+				ReservePercent: min(reserve.Percent, domainNavigation.MaxFuelReservePercent),
+			})
+		}
+		return nil, fmt.Errorf("routing client error: %w", routingErr)
+	}
+	route, err := p.createRouteFromPlan(ctx, plan, ship, waypoints)
+	if err != nil {
+		return nil, fmt.Errorf("routing client error: %w", routingErr)
+	}
+	if err := domainNavigation.NewShipFuelService(nil).CheckFuelReserve(route, ship.Fuel().Current, ship.FuelCapacity(), reserve); err != nil {
+		return nil, p.fuelReserveViolation(ctx, ship, reserve, err)
+	}
+	return route, nil
+}
+
+// fuelReserveViolation logs a plan refused for breaching the fuel reserve and
+// returns err.
+func (p *RoutePlanner) fuelReserveViolation(ctx context.Context, ship *domainNavigation.Ship, reserve domainNavigation.FuelReservePolicy, err error) error {
+	common.LoggerFromContext(ctx).Log("WARNING", "Route refused: it would breach the fuel reserve", map[string]interface{}{
+		"ship_symbol":     ship.ShipSymbol(),
+		"action":          "fuel_reserve_violation",
+		"fuel":            ship.Fuel().Current,
+		"fuel_capacity":   ship.FuelCapacity(),
+		"reserve_percent": reserve.Percent,
+		"error":           err.Error(),
+	})
+	return err
+}
+
+// planViaPreferredDepots plans request with refuels restricted to the
+// system's preferred fuel depots, falling back to every fuel station when that
+// leaves no route (or the index has nothing to restrict).
+func (p *RoutePlanner) planViaPreferredDepots(
+	ctx context.Context,
+	request *domainRouting.RouteRequest,
+	ship *domainNavigation.Ship,
+) (*domainRouting.RouteResponse, error) {
+	if p.fuelDepots == nil {
+		return p.routingClient.PlanRoute(ctx, request)
+	}
+	logger := common.LoggerFromContext(ctx)
+
+	prices, err := p.fuelDepots.FuelPrices(ctx, request.SystemSymbol, ship.PlayerID().Value())
+	if err != nil {
+		logger.Log("WARNING", "Fuel depot prices unavailable; planning over every fuel station", map[string]interface{}{
+			"ship_symbol": ship.ShipSymbol(),
+			"action":      "fuel_depot_lookup_failed",
+			"system":      request.SystemSymbol,
+			"error":       err.Error(),
+		})
+		return p.routingClient.PlanRoute(ctx, request)
+	}
+
+	stations := make([]*system.WaypointData, 0, len(request.Waypoints))
+	for _, wp := range request.Waypoints {
+		if wp.HasFuel {
+			stations = append(stations, wp)
+		}
+	}
+	ranked := domainRouting.RankFuelDepots(request.Waypoints, pricesAt(stations, prices))
+	preferred := domainRouting.PreferredFuelDepots(ranked)
+	if len(preferred) == 0 || len(preferred) == len(stations) {
+		return p.routingClient.PlanRoute(ctx, request)
+	}
+
+	restricted := *request
+	restricted.Waypoints = make([]*system.WaypointData, len(request.Waypoints))
+	for i, wp := range request.Waypoints {
+		copied := *wp
+		copied.HasFuel = wp.HasFuel && preferred[wp.Symbol]
+		restricted.Waypoints[i] = &copied
+	}
+	resp, err := p.routingClient.PlanRoute(ctx, &restricted)
+	if err == nil && resp != nil && len(resp.Steps) > 0 {
+		logger.Log("INFO", "Refuel stops restricted to preferred fuel depots", map[string]interface{}{
+			"ship_symbol":     ship.ShipSymbol(),
+			"action":          "fuel_depot_preferred",
+			"system":          request.SystemSymbol,
+			"best_depot":      ranked[0].WaypointSymbol,
+			"best_fuel_price": ranked[0].Price,
+			"preferred":       len(preferred),
+			"fuel_stations":   len(stations),
+		})
+		return resp, nil
+	}
+	return p.routingClient.PlanRoute(ctx, request)
+}
+
+// gateDrift flies plan with the ship's actual fuel and, when it drifts past
+// the drift limit or strands the ship, asks the routing engine for a plan
+// without DRIFT. The alternative is taken if it keeps fuel aboard and arrives
+// sooner (or the original strands); otherwise the original plan stands, since
+// a slow route beats none.
+func (p *RoutePlanner) gateDrift(
+	ctx context.Context,
+	request *domainRouting.RouteRequest,
+	plan *domainRouting.RouteResponse,
+	ship *domainNavigation.Ship,
+) *domainRouting.RouteResponse {
+	if p.driftLimit <= 0 || request.AvoidDrift {
+		return plan
+	}
+	sim := domainRouting.SimulateRoute(plan, request.CurrentFuel, request.FuelCapacity)
+	if !sim.RejectsDrift(p.driftLimit) {
+		return plan
+	}
+	logger := common.LoggerFromContext(ctx)
+	fields := map[string]interface{}{
+		"ship_symbol":    ship.ShipSymbol(),
+		"action":         "drift_route_rejected",
+		"destination":    request.GoalWaypoint,
+		"travel_seconds": sim.TravelSeconds,
+		"drift_seconds":  sim.DriftSeconds,
+		"limit_seconds":  int(p.driftLimit.Seconds()),
+		"stranded_at":    sim.StrandedAt,
+	}
+
+	noDrift := *request
+	noDrift.AvoidDrift = true
+	alt, err := p.planViaPreferredDepots(ctx, &noDrift, ship)
+	if err == nil && alt != nil && len(alt.Steps) > 0 {
+		altSim := domainRouting.SimulateRoute(alt, request.CurrentFuel, request.FuelCapacity)
+		if !altSim.Stranded() && (sim.Stranded() || altSim.TravelSeconds < sim.TravelSeconds) {
+			fields["alternative_seconds"] = altSim.TravelSeconds
+			fields["refuel_stops"] = altSim.RefuelStops
+			logger.Log("INFO", "Drifting route rejected; refuelling on the way instead", fields)
+			return alt
+		}
+	}
+	if err != nil {
+		fields["error"] = err.Error()
+	}
+	fields["action"] = "drift_route_kept"
+	logger.Log("WARNING", "Drifting route exceeds the drift limit and no faster refuelling route exists; keeping it", fields)
+	return plan
+}
+
+// pricesAt narrows prices to the given stations; a market the planner does not
+// offer as a fuel stop is never ranked as a depot.
+func pricesAt(stations []*system.WaypointData, prices map[string]int) map[string]int {
+	narrowed := make(map[string]int, len(stations))
+	for _, wp := range stations {
+		if price, ok := prices[wp.Symbol]; ok {
+			narrowed[wp.Symbol] = price
+		}
+	}
+	return narrowed
+}
+
+// ApplyArrivalDeadline re-selects each segment's flight mode so the route
+// arrives within deadline (time from now) at minimal fuel burn, keeping the
+// routing engine's path and planned refuels. When the deadline cannot be met
+// the cheapest feasible plan is used instead and the miss is logged; the
+// route is never rejected for it. safetyMargin is the fuel reserve faster
+// legs must leave behind. The executor's after-refuel upgrade still applies
+// at run time; it only ever speeds a leg up, so it cannot cost the deadline.
+func (p *RoutePlanner) ApplyArrivalDeadline(
+	ctx context.Context,
+	route *domainNavigation.Route,
+	ship *domainNavigation.Ship,
+	deadline time.Duration,
+	safetyMargin int,
+) (*domainNavigation.Route, error) {
+	segments := route.Segments()
+	legs := make([]domainNavigation.FlightLeg, len(segments))
+	for i, seg := range segments {
+		legs[i] = domainNavigation.FlightLeg{Distance: seg.Distance, RefuelAfter: seg.RequiresRefuel}
+	}
+
+	startFuel := ship.Fuel().Current
+	if route.HasRefuelAtStart() {
+		startFuel = ship.FuelCapacity()
+	}
+
+	plan := domainNavigation.NewShipFuelService(nil).PlanFlightModesForDeadline(
+		legs, startFuel, ship.FuelCapacity(), ship.EngineSpeed(), safetyMargin, deadline)
+
+	replanned := make([]*domainNavigation.RouteSegment, len(segments))
+	for i, seg := range segments {
+		mode := plan.Modes[i]
+		replanned[i] = domainNavigation.NewRouteSegment(
+			seg.FromWaypoint,
+			seg.ToWaypoint,
+			seg.Distance,
+			mode.FuelCost(seg.Distance),
+			mode.TravelTime(seg.Distance, ship.EngineSpeed()),
+			mode,
+			seg.RequiresRefuel,
+		)
+		replanned[i].OrbitalHop = seg.OrbitalHop
+	}
+
+	level := "INFO"
+	message := "Flight modes re-planned for arrival deadline"
+	if !plan.MeetsDeadline {
+		level = "WARNING"
+		message = "Arrival deadline unreachable; using cheapest feasible flight plan"
+	}
+	common.LoggerFromContext(ctx).Log(level, message, map[string]interface{}{
+		"ship_symbol":      ship.ShipSymbol(),
+		"action":           "deadline_flight_plan",
+		"deadline_seconds": int(deadline.Seconds()),
+		"travel_seconds":   plan.TravelTime,
+		"fuel_required":    plan.FuelRequired,
+		"meets_deadline":   plan.MeetsDeadline,
+	})
+
+	return domainNavigation.NewRoute(
+		route.RouteID(),
+		route.ShipSymbol(),
+		route.PlayerID(),
+		replanned,
+		ship.FuelCapacity(),
+		route.HasRefuelAtStart(),
+	)
 }
 
 // createRouteFromPlan creates Route entity from routing engine plan
@@ -164,6 +524,15 @@ func (p *RoutePlanner) createSegmentFromTravelStep(
 
 	flightMode := p.parseFlightMode(step.Mode)
 
+	// A hop between orbital neighbours is free: whatever the routing engine priced it at,
+	// it burns no fuel and takes no meaningful time, and the executor runs it without
+	// refuel or flight-mode decisions.
+	if domainNavigation.IsOrbitalHop(fromWaypoint, toWaypoint, waypointObjects) {
+		segment := domainNavigation.NewRouteSegment(fromWaypoint, toWaypoint, 0, 0, 0, flightMode, false)
+		segment.OrbitalHop = true
+		return segment, nil
+	}
+
 	return domainNavigation.NewRouteSegment(
 		fromWaypoint,
 		toWaypoint,
@@ -209,6 +578,7 @@ func (p *RoutePlanner) markLastSegmentForRefuel(segments *[]*domainNavigation.Ro
 			prev.FlightMode,
 			true,
 		)
+		(*segments)[len(*segments)-1].OrbitalHop = prev.OrbitalHop
 	}
 }
 
@@ -228,6 +598,7 @@ func (p *RoutePlanner) logCreatedSegments(ctx context.Context, segments []*domai
 			"to_waypoint":     seg.ToWaypoint.Symbol,
 			"fuel_required":   seg.FuelRequired,
 			"requires_refuel": seg.RequiresRefuel,
+			"orbital_hop":     seg.OrbitalHop,
 		})
 	}
 }
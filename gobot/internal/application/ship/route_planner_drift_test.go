@@ -0,0 +1,74 @@
+package ship
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	domainRouting "github.com/andrescamacho/spacetraders-go/internal/domain/routing"
+	"github.com/andrescamacho/spacetraders-go/internal/domain/shared"
+)
+
+// driftingRoutingClient drifts to the goal in one long hop unless the request
+// forbids DRIFT, in which case it refuels at X1-DR-B on the way.
+type driftingRoutingClient struct {
+	domainRouting.RoutingClient
+	requests []*domainRouting.RouteRequest
+}
+
+func (c *driftingRoutingClient) PlanRoute(_ context.Context, req *domainRouting.RouteRequest) (*domainRouting.RouteResponse, error) {
+	c.requests = append(c.requests, req)
+	if !req.AvoidDrift {
+		return &domainRouting.RouteResponse{Steps: []*domainRouting.RouteStepData{
+			{Action: domainRouting.RouteActionTravel, Waypoint: req.GoalWaypoint, FuelCost: 1, TimeSeconds: 3 * 3600, Mode: "DRIFT"},
+		}}, nil
+	}
+	return &domainRouting.RouteResponse{Steps: []*domainRouting.RouteStepData{
+		{Action: domainRouting.RouteActionTravel, Waypoint: "X1-DR-B", FuelCost: 40, TimeSeconds: 300, Mode: "CRUISE"},
+		{Action: domainRouting.RouteActionRefuel, Waypoint: "X1-DR-B"},
+		{Action: domainRouting.RouteActionTravel, Waypoint: req.GoalWaypoint, FuelCost: 80, TimeSeconds: 600, Mode: "CRUISE"},
+	}}, nil
+}
+
+func driftTestSystem(t *testing.T) map[string]*shared.Waypoint {
+	start := mustWaypoint(t, "X1-DR-A", 0, 0)
+	station := mustWaypoint(t, "X1-DR-B", 40, 0)
+	station.HasFuel = true
+	return map[string]*shared.Waypoint{
+		"X1-DR-A": start,
+		"X1-DR-B": station,
+		"X1-DR-C": mustWaypoint(t, "X1-DR-C", 120, 0),
+	}
+}
+
+// A three-hour drift breaches the one-hour limit, so the planner replans
+// without DRIFT and flies the refuelling route; with the gate off the drift
+// stands.
+func TestRoutePlanner_ReplacesSlowDriftWithRefuelStop(t *testing.T) {
+	waypoints := driftTestSystem(t)
+	ship := newExecutorTestShip(t, 50, 100, waypoints["X1-DR-A"])
+
+	client := &driftingRoutingClient{}
+	planner := NewRoutePlanner(client)
+	planner.SetDriftRouteLimit(time.Hour)
+	route, err := planner.PlanRoute(context.Background(), ship, "X1-DR-C", waypoints, false)
+	if err != nil {
+		t.Fatalf("PlanRoute: %v", err)
+	}
+	if len(client.requests) != 2 || !client.requests[1].AvoidDrift {
+		t.Fatalf("expected a drifting plan then a no-drift replan, got %d requests", len(client.requests))
+	}
+	segments := route.Segments()
+	if len(segments) != 2 || segments[0].FlightMode == shared.FlightModeDrift || !segments[0].RequiresRefuel {
+		t.Fatalf("expected CRUISE to the station with a refuel, got %+v", segments)
+	}
+
+	ungated := &driftingRoutingClient{}
+	route, err = NewRoutePlanner(ungated).PlanRoute(context.Background(), ship, "X1-DR-C", waypoints, false)
+	if err != nil {
+		t.Fatalf("PlanRoute: %v", err)
+	}
+	if len(ungated.requests) != 1 || route.Segments()[0].FlightMode != shared.FlightModeDrift {
+		t.Fatal("without a drift limit the drifting plan should stand")
+	}
+}
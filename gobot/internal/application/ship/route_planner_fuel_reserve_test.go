@@ -0,0 +1,149 @@
+package ship
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	domainNavigation "github.com/andrescamacho/spacetraders-go/internal/domain/navigation"
+	domainRouting "github.com/andrescamacho/spacetraders-go/internal/domain/routing"
+)
+
+// reserveRoutingClient flies straight to the goal for fuelCost when the
+// request's tank covers it (or always, when ignoreFuel is set); otherwise it
+// refuels at X1-DR-B first, or fails when the ship is not at a station and
+// cannot reach one.
+type reserveRoutingClient struct {
+	domainRouting.RoutingClient
+	requests   []*domainRouting.RouteRequest
+	fuelCost   int
+	ignoreFuel bool
+	// toStationCost, when set, lets a ship away from X1-DR-B with at least
+	// that much fuel fly there, refuel, and go on to the goal.
+	toStationCost int
+}
+
+func (c *reserveRoutingClient) PlanRoute(_ context.Context, req *domainRouting.RouteRequest) (*domainRouting.RouteResponse, error) {
+	c.requests = append(c.requests, req)
+	if req.CurrentFuel >= c.fuelCost || c.ignoreFuel {
+		return &domainRouting.RouteResponse{Steps: []*domainRouting.RouteStepData{
+			{Action: domainRouting.RouteActionTravel, Waypoint: req.GoalWaypoint, FuelCost: c.fuelCost, TimeSeconds: 600, Mode: "CRUISE"},
+		}}, nil
+	}
+	if req.StartWaypoint == "X1-DR-B" {
+		return &domainRouting.RouteResponse{Steps: []*domainRouting.RouteStepData{
+			{Action: domainRouting.RouteActionRefuel, Waypoint: req.StartWaypoint},
+			{Action: domainRouting.RouteActionTravel, Waypoint: req.GoalWaypoint, FuelCost: c.fuelCost, TimeSeconds: 600, Mode: "CRUISE"},
+		}}, nil
+	}
+	if c.toStationCost > 0 && req.CurrentFuel >= c.toStationCost {
+		return &domainRouting.RouteResponse{Steps: []*domainRouting.RouteStepData{
+			{Action: domainRouting.RouteActionTravel, Waypoint: "X1-DR-B", FuelCost: c.toStationCost, TimeSeconds: 300, Mode: "CRUISE"},
+			{Action: domainRouting.RouteActionRefuel, Waypoint: "X1-DR-B"},
+			{Action: domainRouting.RouteActionTravel, Waypoint: req.GoalWaypoint, FuelCost: c.fuelCost, TimeSeconds: 600, Mode: "CRUISE"},
+		}}, nil
+	}
+	return nil, errors.New("no route")
+}
+
+// The reserve is held back from the tank the engine plans with: 50 fuel
+// covers a 45-fuel hop, but not with 15 of it reserved, so the planner
+// refuses the route, naming the arrival that would land the ship on 5.
+func TestRoutePlanner_FuelReserveRefusesRouteBelowReserve(t *testing.T) {
+	waypoints := driftTestSystem(t)
+	ship := newExecutorTestShip(t, 50, 100, waypoints["X1-DR-A"])
+
+	client := &reserveRoutingClient{fuelCost: 45}
+	planner := NewRoutePlanner(client)
+	planner.SetFuelReserve(domainNavigation.FuelReservePolicy{Percent: 15})
+
+	_, err := planner.PlanRoute(context.Background(), ship, "X1-DR-C", waypoints, false)
+	var violation *domainNavigation.ErrFuelReserveViolation
+	if !errors.As(err, &violation) {
+		t.Fatalf("expected a fuel reserve violation, got %v", err)
+	}
+	if violation.Waypoint != "X1-DR-C" || violation.FuelLeft != 5 || violation.Reserve != 15 {
+		t.Fatalf("unexpected violation %+v", violation)
+	}
+	if req := client.requests[0]; req.CurrentFuel != 35 || req.FuelCapacity != 85 {
+		t.Fatalf("expected the engine to plan on 35/85 fuel, got %d/%d", req.CurrentFuel, req.FuelCapacity)
+	}
+
+	// The per-command override plans without the reserve.
+	route, err := planner.PlanRouteWithFuelReserve(context.Background(), ship, "X1-DR-C", waypoints, false, domainRouting.RouteObjective{}, domainNavigation.FuelReservePolicy{})
+	if err != nil {
+		t.Fatalf("PlanRouteWithFuelReserve without a reserve: %v", err)
+	}
+	if len(route.Segments()) != 1 {
+		t.Fatalf("expected the direct hop, got %+v", route.Segments())
+	}
+}
+
+// A ship below its reserve at a station refuels before leaving, and the
+// route is accepted because it keeps the reserve from the full tank.
+func TestRoutePlanner_FuelReserveRefuelsBelowReserveAtStation(t *testing.T) {
+	waypoints := driftTestSystem(t)
+	ship := newExecutorTestShip(t, 10, 100, waypoints["X1-DR-B"])
+
+	planner := NewRoutePlanner(&reserveRoutingClient{fuelCost: 80})
+	planner.SetFuelReserve(domainNavigation.FuelReservePolicy{Percent: 15})
+
+	route, err := planner.PlanRoute(context.Background(), ship, "X1-DR-C", waypoints, false)
+	if err != nil {
+		t.Fatalf("PlanRoute: %v", err)
+	}
+	if !route.HasRefuelAtStart() {
+		t.Fatal("expected a refuel before departure")
+	}
+
+	// Away from a station there is no way back above the reserve.
+	ship = newExecutorTestShip(t, 10, 100, waypoints["X1-DR-A"])
+	_, err = planner.PlanRoute(context.Background(), ship, "X1-DR-C", waypoints, false)
+	var violation *domainNavigation.ErrFuelReserveViolation
+	if !errors.As(err, &violation) || violation.Waypoint != "X1-DR-A" {
+		t.Fatalf("expected a violation at the origin, got %v", err)
+	}
+}
+
+// A ship below its reserve away from a station may spend some of it flying
+// to the nearest fuel stop: the carved tank has nothing to plan with, so the
+// planner replans on the real tank and accepts the refuelling route.
+func TestRoutePlanner_FuelReserveAllowsRunToFuelStop(t *testing.T) {
+	waypoints := driftTestSystem(t)
+	ship := newExecutorTestShip(t, 10, 100, waypoints["X1-DR-A"])
+
+	client := &reserveRoutingClient{fuelCost: 80, toStationCost: 8}
+	planner := NewRoutePlanner(client)
+	planner.SetFuelReserve(domainNavigation.FuelReservePolicy{Percent: 15})
+
+	route, err := planner.PlanRoute(context.Background(), ship, "X1-DR-C", waypoints, false)
+	if err != nil {
+		t.Fatalf("PlanRoute: %v", err)
+	}
+	segments := route.Segments()
+	if len(segments) != 2 || segments[0].ToWaypoint.Symbol != "X1-DR-B" || !segments[0].RequiresRefuel {
+		t.Fatalf("expected a refuelling first leg to X1-DR-B, got %+v", segments)
+	}
+	if len(client.requests) != 2 || client.requests[1].CurrentFuel != 10 {
+		t.Fatalf("expected a replan on the real tank, got %d requests", len(client.requests))
+	}
+}
+
+// A plan the engine returns that still dips below the reserve on the real
+// tank is caught and refused.
+func TestRoutePlanner_FuelReserveChecksReturnedPlan(t *testing.T) {
+	waypoints := driftTestSystem(t)
+	ship := newExecutorTestShip(t, 100, 100, waypoints["X1-DR-A"])
+
+	planner := NewRoutePlanner(&reserveRoutingClient{fuelCost: 90, ignoreFuel: true})
+	planner.SetFuelReserve(domainNavigation.FuelReservePolicy{Percent: 15})
+
+	_, err := planner.PlanRoute(context.Background(), ship, "X1-DR-C", waypoints, false)
+	var violation *domainNavigation.ErrFuelReserveViolation
+	if !errors.As(err, &violation) {
+		t.Fatalf("expected a fuel reserve violation, got %v", err)
+	}
+	if violation.Waypoint != "X1-DR-C" || violation.FuelLeft != 10 {
+		t.Fatalf("unexpected violation %+v", violation)
+	}
+}
@@ -28,6 +28,11 @@ type ShipEventBus struct {
 
 	// transferCompletedSubscribers[playerID as string] = []channels
 	transferCompletedSubscribers map[string][]chan navigation.TransferCompletedEvent
+
+	// coordination receives a player-scoped ship.arrived copy of every arrival,
+	// for coordinators that react to any hull arriving rather than one ship.
+	// Nil skips the forward.
+	coordination shared.CoordinationPublisher
 }
 
 // Compile-time interface checks
@@ -47,9 +52,24 @@ func NewShipEventBus() *ShipEventBus {
 	}
 }
 
+// SetCoordinationPublisher forwards every arrival to the coordination event bus
+// as ship.arrived. Intended to be called once at wiring time.
+func (b *ShipEventBus) SetCoordinationPublisher(publisher shared.CoordinationPublisher) {
+	b.coordination = publisher
+}
+
 // PublishArrived publishes an ARRIVED event when a ship transitions out of IN_TRANSIT.
 // Implements ShipEventPublisher interface.
 func (b *ShipEventBus) PublishArrived(shipSymbol string, playerID shared.PlayerID, location string, status navigation.NavStatus) {
+	if b.coordination != nil {
+		b.coordination.Publish(shared.CoordinationEvent{
+			Topic:    shared.TopicShipArrived,
+			PlayerID: playerID.Value(),
+			Subject:  shipSymbol,
+			Payload:  map[string]interface{}{"location": location},
+		})
+	}
+
 	b.mu.RLock()
 	defer b.mu.RUnlock()
 
@@ -42,6 +42,7 @@ type ShipyardScanner struct {
 	waypointRepo  waypointTraitReader
 	events        captain.EventRecorder
 	heavyTypes    shipyard.HeavyShipTypeSet
+	priceHistory  shipyard.PriceHistoryRepository
 }
 
 // NewShipyardScanner creates the scanner. events may be nil (milestone becomes
@@ -62,6 +63,13 @@ func NewShipyardScanner(
 	}
 }
 
+// WithPriceHistory makes every scan also append its priced listings to the ship
+// price history. Returns the scanner for chaining.
+func (s *ShipyardScanner) WithPriceHistory(repo shipyard.PriceHistoryRepository) *ShipyardScanner {
+	s.priceHistory = repo
+	return s
+}
+
 // ScanAndSaveShipyard scans the shipyard at waypointSymbol (if the waypoint
 // bears the SHIPYARD trait) and persists availability + prices. Non-shipyard
 // waypoints are a silent no-op — this is called on EVERY scout market visit,
@@ -102,12 +110,25 @@ func (s *ShipyardScanner) ScanAndSaveShipyard(ctx context.Context, playerID uint
 		"types":    len(availabilities),
 	})
 
+	s.recordPriceHistory(ctx, int(playerID), waypointSymbol, availabilities, logger)
+
 	if firstHeavy {
 		s.emitHeavyYardMilestone(ctx, int(playerID), systemSymbol, waypointSymbol, heavyFound, logger)
 	}
 	return nil
 }
 
+// recordPriceHistory appends the scan's priced listings to the price history.
+// Failures are logged, never returned: the inventory already persisted.
+func (s *ShipyardScanner) recordPriceHistory(ctx context.Context, playerID int, waypointSymbol string, availabilities []shipyard.ShipTypeAvailability, logger common.ContainerLogger) {
+	if s.priceHistory == nil {
+		return
+	}
+	if err := s.priceHistory.RecordSnapshots(ctx, playerID, shipyard.PricedSnapshots(availabilities)); err != nil {
+		logger.Log("WARN", fmt.Sprintf("[ShipyardScanner] price history not recorded for %s: %v", waypointSymbol, err), nil)
+	}
+}
+
 // isShipyardWaypoint reports whether the cached waypoint bears the SHIPYARD
 // trait, read as an immutable fact (era-agnostic, TTL-agnostic — see
 // waypointTraitReader). An uncached waypoint or a read error reads as "not a
@@ -2,8 +2,14 @@ package strategies
 
 import (
 	"github.com/andrescamacho/spacetraders-go/internal/domain/navigation"
+	"github.com/andrescamacho/spacetraders-go/internal/domain/shared"
 )
 
+// DefaultCargoFuelPriceCeiling is the market FUEL price above which a ship
+// carrying a FUEL reserve burns it instead of buying. Market FUEL usually
+// trades well under this; past it the station is gouging.
+const DefaultCargoFuelPriceCeiling = 150
+
 // RefuelStrategy defines the interface for different refueling strategies.
 //
 // This strategy pattern allows RouteExecutor to be Open/Closed for extension
@@ -32,6 +38,12 @@ type RefuelStrategy interface {
 	// fuel stations, even if not strictly necessary.
 	ShouldRefuelAfterArrival(ship *navigation.Ship, segment *navigation.RouteSegment) bool
 
+	// ShouldRefuelFromCargo determines whether a refuel the strategy asked for
+	// should burn FUEL carried in the ship's hold rather than buy at the market.
+	// marketFuelPrice is the local market's FUEL price, 0 when the waypoint sells
+	// no fuel.
+	ShouldRefuelFromCargo(ship *navigation.Ship, marketFuelPrice int) bool
+
 	// GetStrategyName returns a human-readable name for logging and debugging.
 	GetStrategyName() string
 }
@@ -61,7 +73,7 @@ type ConservativeRefuelStrategy struct {
 func NewConservativeRefuelStrategy(threshold float64) *ConservativeRefuelStrategy {
 	return &ConservativeRefuelStrategy{
 		threshold:   threshold,
-		fuelService: navigation.NewShipFuelService(),
+		fuelService: navigation.NewShipFuelService(nil),
 	}
 }
 
@@ -103,6 +115,12 @@ func (s *ConservativeRefuelStrategy) ShouldRefuelAfterArrival(ship *navigation.S
 	)
 }
 
+// ShouldRefuelFromCargo prefers cargo FUEL when the market has none or charges
+// more than DefaultCargoFuelPriceCeiling.
+func (s *ConservativeRefuelStrategy) ShouldRefuelFromCargo(ship *navigation.Ship, marketFuelPrice int) bool {
+	return prefersCargoFuel(ship, marketFuelPrice, DefaultCargoFuelPriceCeiling)
+}
+
 // GetStrategyName returns the strategy name for logging.
 func (s *ConservativeRefuelStrategy) GetStrategyName() string {
 	return "conservative"
@@ -124,7 +142,7 @@ type MinimalRefuelStrategy struct {
 // NewMinimalRefuelStrategy creates a minimal refuel strategy.
 func NewMinimalRefuelStrategy() *MinimalRefuelStrategy {
 	return &MinimalRefuelStrategy{
-		fuelService: navigation.NewShipFuelService(),
+		fuelService: navigation.NewShipFuelService(nil),
 	}
 }
 
@@ -146,6 +164,12 @@ func (s *MinimalRefuelStrategy) ShouldRefuelAfterArrival(ship *navigation.Ship,
 	return false
 }
 
+// ShouldRefuelFromCargo burns cargo FUEL on the same terms as the conservative
+// strategy: only a refuel that is happening anyway is redirected to the hold.
+func (s *MinimalRefuelStrategy) ShouldRefuelFromCargo(ship *navigation.Ship, marketFuelPrice int) bool {
+	return prefersCargoFuel(ship, marketFuelPrice, DefaultCargoFuelPriceCeiling)
+}
+
 // GetStrategyName returns the strategy name for logging.
 func (s *MinimalRefuelStrategy) GetStrategyName() string {
 	return "minimal"
@@ -188,7 +212,28 @@ func (s *AlwaysTopOffStrategy) ShouldRefuelAfterArrival(ship *navigation.Ship, s
 	return ship.Fuel().Percentage() < 1.0
 }
 
+// ShouldRefuelFromCargo tops off from the hold where the market is missing or
+// overpriced, like the other strategies.
+func (s *AlwaysTopOffStrategy) ShouldRefuelFromCargo(ship *navigation.Ship, marketFuelPrice int) bool {
+	return prefersCargoFuel(ship, marketFuelPrice, DefaultCargoFuelPriceCeiling)
+}
+
 // GetStrategyName returns the strategy name for logging.
 func (s *AlwaysTopOffStrategy) GetStrategyName() string {
 	return "always_top_off"
 }
+
+// prefersCargoFuel reports whether the ship carries FUEL it keeps as a fuel
+// reserve and the market fuel is absent (price 0) or above ceiling. Only FUEL
+// reserved on the hull (do-not-sell) counts: FUEL without the reservation is
+// trade or contract cargo on its way to a buyer, and burning it in transit
+// would short the delivery.
+func prefersCargoFuel(ship *navigation.Ship, marketFuelPrice, ceiling int) bool {
+	if ship.Cargo() == nil || ship.Cargo().GetItemUnits(shared.FuelCargoSymbol) == 0 {
+		return false
+	}
+	if !ship.IsCargoReserved(shared.FuelCargoSymbol) {
+		return false
+	}
+	return marketFuelPrice <= 0 || marketFuelPrice > ceiling
+}
@@ -0,0 +1,267 @@
+package ship
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/andrescamacho/spacetraders-go/internal/application/common"
+	"github.com/andrescamacho/spacetraders-go/internal/domain/navigation"
+	domainPorts "github.com/andrescamacho/spacetraders-go/internal/domain/ports"
+	"github.com/andrescamacho/spacetraders-go/internal/domain/shared"
+)
+
+// TransferOrchestrator coordinates a miner->hauler cargo handoff. A single
+// TransferCargoCommand assumes both hulls already share a waypoint and a nav
+// state; the orchestrator establishes that rendezvous first — it waits out any
+// in-flight arrival, rejects haulers parked elsewhere, aligns each source/hauler
+// pair's nav state — and then splits the source's hold across as many haulers
+// as it takes, reporting whatever it could not place.
+type TransferOrchestrator struct {
+	shipRepo   navigation.ShipRepository
+	apiClient  domainPorts.APIClient
+	subscriber navigation.ShipEventSubscriber
+}
+
+// NewTransferOrchestrator creates a transfer orchestrator. subscriber may be nil,
+// in which case a hull still IN_TRANSIT is skipped instead of waited on.
+func NewTransferOrchestrator(
+	shipRepo navigation.ShipRepository,
+	apiClient domainPorts.APIClient,
+	subscriber navigation.ShipEventSubscriber,
+) *TransferOrchestrator {
+	return &TransferOrchestrator{
+		shipRepo:   shipRepo,
+		apiClient:  apiClient,
+		subscriber: subscriber,
+	}
+}
+
+// HandoffRequest describes one source hull draining into a set of haulers.
+// Haulers are filled in the order given. Goods optionally restricts the
+// handoff to those trade symbols; empty hands off the whole hold. MaxUnits
+// caps the units handed off in total; 0 hands off everything selected.
+type HandoffRequest struct {
+	SourceShip string
+	Haulers    []string
+	Goods      []string
+	MaxUnits   int
+	PlayerID   shared.PlayerID
+}
+
+// HandoffTransfer is one executed ship-to-ship transfer.
+type HandoffTransfer struct {
+	Hauler     string
+	GoodSymbol string
+	Units      int
+}
+
+// HandoffResult reports what a handoff moved and what it left behind.
+// Partial is true when cargo selected for the handoff is still on the source,
+// either because the haulers ran out of space or a transfer failed.
+type HandoffResult struct {
+	Transfers        []HandoffTransfer
+	UnitsTransferred int
+	UnitsRemaining   int
+	Partial          bool
+	// SkippedHaulers maps a hauler that took no part to the reason why.
+	SkippedHaulers map[string]string
+	// Errors holds transfer failures that were absorbed to keep filling the
+	// remaining haulers.
+	Errors []string
+}
+
+// Handoff synchronizes the rendezvous and moves the source's cargo into the
+// haulers. It fails only when the rendezvous itself cannot be established (the
+// source cannot be loaded or never arrives); per-hauler problems are reported
+// on the result so the caller can act on a partial handoff.
+func (o *TransferOrchestrator) Handoff(ctx context.Context, req HandoffRequest) (*HandoffResult, error) {
+	token, err := common.PlayerTokenFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	logger := common.LoggerFromContext(ctx)
+
+	source, err := o.loadArrived(ctx, req.SourceShip, req.PlayerID, logger)
+	if err != nil {
+		return nil, fmt.Errorf("source ship %s not ready for handoff: %w", req.SourceShip, err)
+	}
+	rendezvous := source.CurrentLocation().Symbol
+
+	result := &HandoffResult{SkippedHaulers: make(map[string]string)}
+	var haulers []*navigation.Ship
+	// freeSpace tracks each hauler's remaining hold as transfers land, so the
+	// split never depends on re-reading the repository mid-handoff.
+	freeSpace := make(map[string]int, len(req.Haulers))
+	for _, symbol := range req.Haulers {
+		hauler, err := o.loadArrived(ctx, symbol, req.PlayerID, logger)
+		if err != nil {
+			result.SkippedHaulers[symbol] = err.Error()
+			continue
+		}
+		if hauler.CurrentLocation().Symbol != rendezvous {
+			result.SkippedHaulers[symbol] = fmt.Sprintf("at %s, not at rendezvous %s", hauler.CurrentLocation().Symbol, rendezvous)
+			continue
+		}
+		if hauler.AvailableCargoSpace() <= 0 {
+			result.SkippedHaulers[symbol] = "cargo hold full"
+			continue
+		}
+		haulers = append(haulers, hauler)
+		freeSpace[symbol] = hauler.AvailableCargoSpace()
+	}
+
+	remaining := selectHandoffCargo(source, req.Goods, req.MaxUnits)
+	for _, item := range remaining {
+		for _, hauler := range haulers {
+			if item.Units == 0 {
+				break
+			}
+			space := freeSpace[hauler.ShipSymbol()]
+			if space <= 0 {
+				continue
+			}
+			units := item.Units
+			if units > space {
+				units = space
+			}
+
+			moved, err := o.transfer(ctx, req, hauler, item.Symbol, units, token)
+			if err != nil {
+				result.Errors = append(result.Errors, fmt.Sprintf("%s %dx%s: %v", hauler.ShipSymbol(), units, item.Symbol, err))
+				logger.Log("WARNING", "Handoff transfer failed; trying next hauler", map[string]interface{}{
+					"ship_symbol": req.SourceShip,
+					"action":      "handoff_transfer",
+					"hauler":      hauler.ShipSymbol(),
+					"good":        item.Symbol,
+					"units":       units,
+					"error":       err.Error(),
+				})
+				continue
+			}
+
+			item.Units -= moved
+			freeSpace[hauler.ShipSymbol()] -= moved
+			result.UnitsTransferred += moved
+			result.Transfers = append(result.Transfers, HandoffTransfer{Hauler: hauler.ShipSymbol(), GoodSymbol: item.Symbol, Units: moved})
+		}
+		result.UnitsRemaining += item.Units
+	}
+
+	result.Partial = result.UnitsRemaining > 0
+	if result.Partial {
+		logger.Log("WARNING", "Handoff left cargo on source", map[string]interface{}{
+			"ship_symbol":       req.SourceShip,
+			"action":            "handoff_partial",
+			"units_transferred": result.UnitsTransferred,
+			"units_remaining":   result.UnitsRemaining,
+			"haulers_used":      len(haulers),
+		})
+	}
+
+	return result, nil
+}
+
+// transfer aligns the source to the hauler's nav state, moves the units and
+// persists both hulls' deltas. The hauler is treated as the stationary side:
+// only the source is orbited/docked, so a hauler already queued by another
+// worker is never moved underneath it.
+func (o *TransferOrchestrator) transfer(ctx context.Context, req HandoffRequest, hauler *navigation.Ship, good string, units int, token string) (int, error) {
+	result, alignedNav, err := common.AlignAndTransferCargo(ctx, o.apiClient, req.SourceShip, hauler.ShipSymbol(), hauler.ShipSymbol(), good, units, token)
+	if err != nil {
+		return 0, err
+	}
+	moved := result.UnitsTransferred
+
+	// Best-effort persistence, identical to TransferCargoHandler: the transfer
+	// already committed on the API and the cache reconciles on the next sync.
+	_, _, _ = o.shipRepo.SaveWithRetry(ctx, req.SourceShip, req.PlayerID,
+		func(sh *navigation.Ship) (bool, error) {
+			switch alignedNav {
+			case navigation.NavStatusDocked:
+				_, _ = sh.EnsureDocked()
+			case navigation.NavStatusInOrbit:
+				_, _ = sh.EnsureInOrbit()
+			}
+			_ = sh.RemoveCargo(good, moved)
+			return true, nil
+		})
+	_, _, _ = o.shipRepo.SaveWithRetry(ctx, hauler.ShipSymbol(), req.PlayerID,
+		func(sh *navigation.Ship) (bool, error) {
+			_ = sh.ReceiveCargo(&shared.CargoItem{Symbol: good, Units: moved})
+			return true, nil
+		})
+
+	return moved, nil
+}
+
+// loadArrived loads a hull and, if it is still IN_TRANSIT, waits for it to
+// arrive and reloads it, so the caller sees the landed location, nav status
+// and hold rather than the snapshot taken before the wait. Without an event
+// subscriber an in-flight hull is an error rather than a blocking poll.
+func (o *TransferOrchestrator) loadArrived(ctx context.Context, symbol string, playerID shared.PlayerID, logger common.ContainerLogger) (*navigation.Ship, error) {
+	ship, err := o.shipRepo.FindBySymbol(ctx, symbol, playerID)
+	if err != nil {
+		return nil, fmt.Errorf("ship not found: %w", err)
+	}
+	if !ship.IsInTransit() {
+		return ship, nil
+	}
+	if o.subscriber == nil {
+		return nil, fmt.Errorf("still in transit")
+	}
+
+	var waitTimeSeconds int
+	if ship.ArrivalTime() != nil {
+		if waitTime := time.Until(*ship.ArrivalTime()); waitTime > 0 {
+			waitTimeSeconds = int(waitTime.Seconds())
+		}
+	}
+	if err := WaitForShipArrival(ctx, o.shipRepo, o.subscriber, ship, playerID, waitTimeSeconds, logger); err != nil {
+		return nil, err
+	}
+
+	arrived, err := o.shipRepo.FindBySymbol(ctx, symbol, playerID)
+	if err != nil {
+		return nil, fmt.Errorf("ship not found after arrival: %w", err)
+	}
+	return arrived, nil
+}
+
+// handoffItem is a mutable working copy of one cargo line being handed off.
+type handoffItem struct {
+	Symbol string
+	Units  int
+}
+
+// selectHandoffCargo snapshots the source's hold, restricted to goods when
+// given, in manifest order, taking at most maxUnits in total when positive.
+func selectHandoffCargo(source *navigation.Ship, goods []string, maxUnits int) []*handoffItem {
+	wanted := make(map[string]bool, len(goods))
+	for _, g := range goods {
+		wanted[g] = true
+	}
+
+	var items []*handoffItem
+	taken := 0
+	for _, item := range source.Cargo().Inventory {
+		if item.Units <= 0 {
+			continue
+		}
+		if len(wanted) > 0 && !wanted[item.Symbol] {
+			continue
+		}
+		units := item.Units
+		if maxUnits > 0 {
+			if budget := maxUnits - taken; units > budget {
+				units = budget
+			}
+			if units <= 0 {
+				break
+			}
+		}
+		taken += units
+		items = append(items, &handoffItem{Symbol: item.Symbol, Units: units})
+	}
+	return items
+}
@@ -0,0 +1,226 @@
+package ship
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/andrescamacho/spacetraders-go/internal/application/common"
+	"github.com/andrescamacho/spacetraders-go/internal/domain/navigation"
+	domainPorts "github.com/andrescamacho/spacetraders-go/internal/domain/ports"
+	"github.com/andrescamacho/spacetraders-go/internal/domain/shared"
+)
+
+// handoffFakeAPI serves nav state for alignment and records transfers. A
+// hauler listed in failFor rejects every transfer into it.
+type handoffFakeAPI struct {
+	domainPorts.APIClient
+	nav        map[string]string
+	orbitCalls []string
+	transfers  []HandoffTransfer
+	failFor    map[string]bool
+}
+
+func (f *handoffFakeAPI) GetShip(_ context.Context, symbol, _ string) (*navigation.ShipData, error) {
+	return &navigation.ShipData{Symbol: symbol, NavStatus: f.nav[symbol]}, nil
+}
+func (f *handoffFakeAPI) OrbitShip(_ context.Context, symbol, _ string) error {
+	f.orbitCalls = append(f.orbitCalls, symbol)
+	f.nav[symbol] = string(navigation.NavStatusInOrbit)
+	return nil
+}
+func (f *handoffFakeAPI) DockShip(_ context.Context, symbol, _ string) error {
+	f.nav[symbol] = string(navigation.NavStatusDocked)
+	return nil
+}
+func (f *handoffFakeAPI) TransferCargo(_ context.Context, from, to, good string, units int, _ string) (*domainPorts.TransferResult, error) {
+	if f.failFor[to] {
+		return nil, errors.New("transfer rejected")
+	}
+	f.transfers = append(f.transfers, HandoffTransfer{Hauler: to, GoodSymbol: good, Units: units})
+	return &domainPorts.TransferResult{FromShip: from, ToShip: to, GoodSymbol: good, UnitsTransferred: units}, nil
+}
+
+// handoffFakeRepo serves ships by symbol. A ship staged in next replaces the
+// stored one after the next read, standing in for a row the arrival updates.
+type handoffFakeRepo struct {
+	navigation.ShipRepository
+	ships map[string]*navigation.Ship
+	next  map[string]*navigation.Ship
+}
+
+func (r *handoffFakeRepo) FindBySymbol(_ context.Context, symbol string, _ shared.PlayerID) (*navigation.Ship, error) {
+	ship, ok := r.ships[symbol]
+	if !ok {
+		return nil, errors.New("not found")
+	}
+	if staged, ok := r.next[symbol]; ok {
+		r.ships[symbol] = staged
+		delete(r.next, symbol)
+	}
+	return ship, nil
+}
+
+func (r *handoffFakeRepo) SaveWithRetry(ctx context.Context, symbol string, playerID shared.PlayerID, mutate navigation.ShipMutation) (*navigation.Ship, bool, error) {
+	sh, err := r.FindBySymbol(ctx, symbol, playerID)
+	if err != nil {
+		return nil, false, err
+	}
+	changed, err := mutate(sh)
+	return sh, changed, err
+}
+
+func buildHandoffShip(t *testing.T, symbol, waypoint string, nav navigation.NavStatus, capacity int, items ...*shared.CargoItem) *navigation.Ship {
+	t.Helper()
+	wp, err := shared.NewWaypoint(waypoint, 1, 1)
+	require.NoError(t, err)
+	fuel, err := shared.NewFuel(100, 100)
+	require.NoError(t, err)
+	units := 0
+	for _, item := range items {
+		units += item.Units
+	}
+	cargo, err := shared.NewCargo(capacity, units, items)
+	require.NoError(t, err)
+	ship, err := navigation.NewShip(symbol, shared.MustNewPlayerID(1), wp, fuel, 100, capacity, cargo, 30, "FRAME_FRIGATE", "HAULER", nil, nav)
+	require.NoError(t, err)
+	return ship
+}
+
+func newHandoffFixture(ships ...*navigation.Ship) (*TransferOrchestrator, *handoffFakeAPI, *handoffFakeRepo) {
+	repo := &handoffFakeRepo{ships: map[string]*navigation.Ship{}, next: map[string]*navigation.Ship{}}
+	api := &handoffFakeAPI{nav: map[string]string{}, failFor: map[string]bool{}}
+	for _, sh := range ships {
+		repo.ships[sh.ShipSymbol()] = sh
+		api.nav[sh.ShipSymbol()] = string(sh.NavStatus())
+	}
+	return NewTransferOrchestrator(repo, api, nil), api, repo
+}
+
+// A miner hold larger than one hauler is split across haulers in order, and
+// the miner is orbited to match haulers parked in orbit.
+func TestTransferOrchestrator_SplitsCargoAcrossHaulers(t *testing.T) {
+	miner := buildHandoffShip(t, "MINER", "X1-HO-AST", navigation.NavStatusDocked, 100,
+		&shared.CargoItem{Symbol: "IRON_ORE", Units: 50}, &shared.CargoItem{Symbol: "COPPER_ORE", Units: 20})
+	haulerA := buildHandoffShip(t, "HAULER-A", "X1-HO-AST", navigation.NavStatusInOrbit, 40)
+	haulerB := buildHandoffShip(t, "HAULER-B", "X1-HO-AST", navigation.NavStatusInOrbit, 60)
+	orchestrator, api, _ := newHandoffFixture(miner, haulerA, haulerB)
+
+	ctx := common.WithPlayerToken(context.Background(), "tok")
+	result, err := orchestrator.Handoff(ctx, HandoffRequest{
+		SourceShip: "MINER",
+		Haulers:    []string{"HAULER-A", "HAULER-B"},
+		PlayerID:   shared.MustNewPlayerID(1),
+	})
+
+	require.NoError(t, err)
+	require.Equal(t, []HandoffTransfer{
+		{Hauler: "HAULER-A", GoodSymbol: "IRON_ORE", Units: 40},
+		{Hauler: "HAULER-B", GoodSymbol: "IRON_ORE", Units: 10},
+		{Hauler: "HAULER-B", GoodSymbol: "COPPER_ORE", Units: 20},
+	}, api.transfers)
+	require.Equal(t, 70, result.UnitsTransferred)
+	require.Zero(t, result.UnitsRemaining)
+	require.False(t, result.Partial)
+	require.Contains(t, api.orbitCalls, "MINER", "source aligned to the orbiting haulers")
+	require.Empty(t, miner.Cargo().Inventory, "source persisted with its hold drained")
+}
+
+// Cargo that exceeds every hauler's space is reported, not dropped, and a
+// hauler parked at another waypoint is skipped with a reason.
+func TestTransferOrchestrator_ReportsPartialHandoff(t *testing.T) {
+	miner := buildHandoffShip(t, "MINER", "X1-HO-AST", navigation.NavStatusInOrbit, 100,
+		&shared.CargoItem{Symbol: "IRON_ORE", Units: 80})
+	near := buildHandoffShip(t, "HAULER-A", "X1-HO-AST", navigation.NavStatusInOrbit, 30)
+	far := buildHandoffShip(t, "HAULER-B", "X1-HO-MKT", navigation.NavStatusInOrbit, 100)
+	orchestrator, _, _ := newHandoffFixture(miner, near, far)
+
+	ctx := common.WithPlayerToken(context.Background(), "tok")
+	result, err := orchestrator.Handoff(ctx, HandoffRequest{
+		SourceShip: "MINER",
+		Haulers:    []string{"HAULER-A", "HAULER-B", "HAULER-C"},
+		PlayerID:   shared.MustNewPlayerID(1),
+	})
+
+	require.NoError(t, err)
+	require.Equal(t, 30, result.UnitsTransferred)
+	require.Equal(t, 50, result.UnitsRemaining)
+	require.True(t, result.Partial)
+	require.Contains(t, result.SkippedHaulers, "HAULER-B")
+	require.Contains(t, result.SkippedHaulers, "HAULER-C")
+}
+
+// A failed transfer into one hauler falls through to the next.
+func TestTransferOrchestrator_FailedTransferTriesNextHauler(t *testing.T) {
+	miner := buildHandoffShip(t, "MINER", "X1-HO-AST", navigation.NavStatusInOrbit, 100,
+		&shared.CargoItem{Symbol: "IRON_ORE", Units: 20})
+	broken := buildHandoffShip(t, "HAULER-A", "X1-HO-AST", navigation.NavStatusInOrbit, 50)
+	spare := buildHandoffShip(t, "HAULER-B", "X1-HO-AST", navigation.NavStatusInOrbit, 50)
+	orchestrator, api, _ := newHandoffFixture(miner, broken, spare)
+	api.failFor["HAULER-A"] = true
+
+	ctx := common.WithPlayerToken(context.Background(), "tok")
+	result, err := orchestrator.Handoff(ctx, HandoffRequest{
+		SourceShip: "MINER",
+		Haulers:    []string{"HAULER-A", "HAULER-B"},
+		PlayerID:   shared.MustNewPlayerID(1),
+	})
+
+	require.NoError(t, err)
+	require.Len(t, result.Errors, 1)
+	require.Equal(t, []HandoffTransfer{{Hauler: "HAULER-B", GoodSymbol: "IRON_ORE", Units: 20}}, result.Transfers)
+	require.False(t, result.Partial)
+}
+
+// MaxUnits caps the handoff; the rest stays on the source without counting
+// as a partial handoff.
+func TestTransferOrchestrator_MaxUnitsCapsTheHandoff(t *testing.T) {
+	miner := buildHandoffShip(t, "MINER", "X1-HO-AST", navigation.NavStatusInOrbit, 100,
+		&shared.CargoItem{Symbol: "IRON_ORE", Units: 30}, &shared.CargoItem{Symbol: "COPPER_ORE", Units: 20})
+	hauler := buildHandoffShip(t, "HAULER-A", "X1-HO-AST", navigation.NavStatusInOrbit, 100)
+	orchestrator, api, _ := newHandoffFixture(miner, hauler)
+
+	ctx := common.WithPlayerToken(context.Background(), "tok")
+	result, err := orchestrator.Handoff(ctx, HandoffRequest{
+		SourceShip: "MINER",
+		Haulers:    []string{"HAULER-A"},
+		MaxUnits:   35,
+		PlayerID:   shared.MustNewPlayerID(1),
+	})
+
+	require.NoError(t, err)
+	require.Equal(t, []HandoffTransfer{
+		{Hauler: "HAULER-A", GoodSymbol: "IRON_ORE", Units: 30},
+		{Hauler: "HAULER-A", GoodSymbol: "COPPER_ORE", Units: 5},
+	}, api.transfers)
+	require.False(t, result.Partial)
+}
+
+// A hauler still IN_TRANSIT is waited on and then reloaded, so the handoff
+// sees where it landed rather than the pre-arrival snapshot.
+func TestTransferOrchestrator_ReloadsHaulerAfterArrival(t *testing.T) {
+	miner := buildHandoffShip(t, "MINER", "X1-HO-AST", navigation.NavStatusInOrbit, 100,
+		&shared.CargoItem{Symbol: "IRON_ORE", Units: 30})
+	inTransit := buildHandoffShip(t, "HAULER-A", "X1-HO-FAR", navigation.NavStatusInTransit, 50)
+	landed := buildHandoffShip(t, "HAULER-A", "X1-HO-AST", navigation.NavStatusInOrbit, 50)
+	_, api, repo := newHandoffFixture(miner, inTransit)
+	repo.next["HAULER-A"] = landed
+	api.nav["HAULER-A"] = string(navigation.NavStatusInOrbit)
+
+	arrived := make(chan navigation.ShipArrivedEvent, 1)
+	arrived <- navigation.ShipArrivedEvent{ShipSymbol: "HAULER-A", Location: "X1-HO-AST", Status: navigation.NavStatusInOrbit}
+	orchestrator := NewTransferOrchestrator(repo, api, &fakeArrivalSubscriber{ch: arrived})
+
+	ctx := common.WithPlayerToken(context.Background(), "tok")
+	result, err := orchestrator.Handoff(ctx, HandoffRequest{
+		SourceShip: "MINER",
+		Haulers:    []string{"HAULER-A"},
+		PlayerID:   shared.MustNewPlayerID(1),
+	})
+
+	require.NoError(t, err)
+	require.Empty(t, result.SkippedHaulers)
+	require.Equal(t, []HandoffTransfer{{Hauler: "HAULER-A", GoodSymbol: "IRON_ORE", Units: 30}}, api.transfers)
+}
@@ -67,6 +67,10 @@ type RefuelShipCommand struct {
 	ShipSymbol string           // Fallback: used only if Ship is nil
 	PlayerID   shared.PlayerID
 	Units      *int // nil = refuel to full
+	// FromCargo burns FUEL carried in the ship's hold instead of buying at the
+	// market, so no fuel station is needed. Only whole cargo units that fit the
+	// tank are burned (100 fuel each), bounded by Units when set.
+	FromCargo bool
 }
 
 func (c *RefuelShipCommand) GetShip() *navigation.Ship    { return c.Ship }
@@ -109,6 +113,10 @@ type NavigateDirectCommand struct {
 	DestinationWaypoint *shared.Waypoint // Primary: enriched waypoint with HasFuel (avoids DB lookup)
 	FlightMode          string
 	PlayerID            shared.PlayerID
+	// IdempotencyKey, when set, lets a retried command replay the original
+	// result instead of dispatching the navigation twice (handler needs
+	// WithIdempotencyCache).
+	IdempotencyKey string
 }
 
 func (c *NavigateDirectCommand) GetShip() *navigation.Ship    { return c.Ship }
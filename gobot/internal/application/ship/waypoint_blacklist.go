@@ -0,0 +1,144 @@
+package ship
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/andrescamacho/spacetraders-go/internal/application/common"
+	"github.com/andrescamacho/spacetraders-go/internal/domain/shared"
+	"github.com/andrescamacho/spacetraders-go/internal/domain/system"
+)
+
+// waypointBlacklistRefresh is how long a player's blacklist is held in memory
+// before it is re-read, so entries written outside this daemon still land.
+const waypointBlacklistRefresh = time.Minute
+
+// WaypointBlacklist is the daemon's view of each player's blacklisted
+// waypoints. Route planning and scouting consult it on every plan, so the
+// entries are cached per player and reloaded after waypointBlacklistRefresh or
+// whenever they change through it. Expiry is checked against the clock on each
+// lookup, so an entry lapses on time even between reloads.
+//
+// A nil *WaypointBlacklist blacklists nothing, so consumers need no wiring
+// check of their own.
+type WaypointBlacklist struct {
+	repo  system.WaypointBlacklistRepository
+	clock shared.Clock
+
+	mu      sync.Mutex
+	entries map[int]waypointBlacklistEntry
+}
+
+type waypointBlacklistEntry struct {
+	waypoints map[string]*system.BlacklistedWaypoint
+	loadedAt  time.Time
+}
+
+// NewWaypointBlacklist creates the blacklist over repo. If clock is nil, uses
+// RealClock.
+func NewWaypointBlacklist(repo system.WaypointBlacklistRepository, clock shared.Clock) *WaypointBlacklist {
+	if clock == nil {
+		clock = shared.NewRealClock()
+	}
+	return &WaypointBlacklist{
+		repo:    repo,
+		clock:   clock,
+		entries: make(map[int]waypointBlacklistEntry),
+	}
+}
+
+// Add blacklists entry.WaypointSymbol for entry.PlayerID, replacing any entry
+// it already has. A zero CreatedAt is stamped with the clock.
+func (b *WaypointBlacklist) Add(ctx context.Context, entry *system.BlacklistedWaypoint) error {
+	if entry.CreatedAt.IsZero() {
+		entry.CreatedAt = b.clock.Now()
+	}
+	if err := b.repo.Add(ctx, entry); err != nil {
+		return err
+	}
+	b.invalidate(entry.PlayerID)
+	return nil
+}
+
+// BlacklistWaypoint blacklists waypoint on the health monitor's behalf for
+// ttl (0: until cleared). It satisfies the daemon.WaypointBlacklister port.
+func (b *WaypointBlacklist) BlacklistWaypoint(ctx context.Context, playerID int, waypoint, reason string, ttl time.Duration) error {
+	entry := &system.BlacklistedWaypoint{
+		PlayerID:       playerID,
+		WaypointSymbol: waypoint,
+		Reason:         reason,
+		Source:         system.BlacklistSourceHealthMonitor,
+		CreatedAt:      b.clock.Now(),
+	}
+	if ttl > 0 {
+		expiresAt := entry.CreatedAt.Add(ttl)
+		entry.ExpiresAt = &expiresAt
+	}
+	return b.Add(ctx, entry)
+}
+
+// Clear removes waypoint from the player's blacklist, reporting whether it
+// was on it.
+func (b *WaypointBlacklist) Clear(ctx context.Context, playerID int, waypoint string) (bool, error) {
+	removed, err := b.repo.Remove(ctx, playerID, waypoint)
+	if err != nil {
+		return false, err
+	}
+	b.invalidate(playerID)
+	return removed, nil
+}
+
+// List returns the player's active entries, read straight from the
+// repository.
+func (b *WaypointBlacklist) List(ctx context.Context, playerID int) ([]*system.BlacklistedWaypoint, error) {
+	return b.repo.ListActive(ctx, playerID, b.clock.Now())
+}
+
+// Blacklisted returns the set of waypoints the player's fleet must avoid right
+// now. A blacklist that cannot be read is logged and treated as empty: losing
+// the exclusion for a minute is better than stopping every route.
+func (b *WaypointBlacklist) Blacklisted(ctx context.Context, playerID int) map[string]bool {
+	if b == nil {
+		return nil
+	}
+	now := b.clock.Now()
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	entry, ok := b.entries[playerID]
+	if !ok || now.Sub(entry.loadedAt) >= waypointBlacklistRefresh {
+		listed, err := b.repo.ListActive(ctx, playerID, now)
+		if err != nil {
+			common.LoggerFromContext(ctx).Log("WARNING", "Waypoint blacklist unreadable; ignoring it", map[string]interface{}{
+				"action": "waypoint_blacklist_load_failed", "player_id": playerID, "error": err.Error(),
+			})
+			return nil
+		}
+		entry = waypointBlacklistEntry{waypoints: make(map[string]*system.BlacklistedWaypoint, len(listed)), loadedAt: now}
+		for _, wp := range listed {
+			entry.waypoints[wp.WaypointSymbol] = wp
+		}
+		b.entries[playerID] = entry
+	}
+
+	blacklisted := make(map[string]bool, len(entry.waypoints))
+	for symbol, wp := range entry.waypoints {
+		if wp.Active(now) {
+			blacklisted[symbol] = true
+		}
+	}
+	return blacklisted
+}
+
+// IsBlacklisted reports whether the player's fleet must avoid waypoint.
+func (b *WaypointBlacklist) IsBlacklisted(ctx context.Context, playerID int, waypoint string) bool {
+	return b.Blacklisted(ctx, playerID)[waypoint]
+}
+
+func (b *WaypointBlacklist) invalidate(playerID int) {
+	b.mu.Lock()
+	delete(b.entries, playerID)
+	b.mu.Unlock()
+}
@@ -0,0 +1,111 @@
+package ship
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/andrescamacho/spacetraders-go/internal/domain/shared"
+	"github.com/andrescamacho/spacetraders-go/internal/domain/system"
+)
+
+// memoryBlacklistRepo keeps entries in a map and counts reads.
+type memoryBlacklistRepo struct {
+	entries map[string]*system.BlacklistedWaypoint
+	reads   int
+}
+
+func (r *memoryBlacklistRepo) Add(_ context.Context, entry *system.BlacklistedWaypoint) error {
+	r.entries[entry.WaypointSymbol] = entry
+	return nil
+}
+
+func (r *memoryBlacklistRepo) Remove(_ context.Context, _ int, waypoint string) (bool, error) {
+	_, ok := r.entries[waypoint]
+	delete(r.entries, waypoint)
+	return ok, nil
+}
+
+func (r *memoryBlacklistRepo) ListActive(_ context.Context, _ int, now time.Time) ([]*system.BlacklistedWaypoint, error) {
+	r.reads++
+	var out []*system.BlacklistedWaypoint
+	for _, e := range r.entries {
+		if e.Active(now) {
+			out = append(out, e)
+		}
+	}
+	return out, nil
+}
+
+// An automatic entry lapses at its TTL without a reload; an operator clear
+// takes effect at once.
+func TestWaypointBlacklist_ExpiresAndClears(t *testing.T) {
+	clock := &shared.MockClock{CurrentTime: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)}
+	repo := &memoryBlacklistRepo{entries: map[string]*system.BlacklistedWaypoint{}}
+	blacklist := NewWaypointBlacklist(repo, clock)
+	ctx := context.Background()
+
+	if err := blacklist.BlacklistWaypoint(ctx, 1, "X1-BL-A", "pirates", 10*time.Second); err != nil {
+		t.Fatalf("BlacklistWaypoint: %v", err)
+	}
+	if err := blacklist.Add(ctx, &system.BlacklistedWaypoint{PlayerID: 1, WaypointSymbol: "X1-BL-B", Source: system.BlacklistSourceOperator}); err != nil {
+		t.Fatalf("Add: %v", err)
+	}
+	if !blacklist.IsBlacklisted(ctx, 1, "X1-BL-A") || !blacklist.IsBlacklisted(ctx, 1, "X1-BL-B") {
+		t.Fatal("both waypoints should be blacklisted")
+	}
+
+	clock.CurrentTime = clock.CurrentTime.Add(10 * time.Second)
+	if blacklist.IsBlacklisted(ctx, 1, "X1-BL-A") {
+		t.Fatal("entry should lapse at its TTL")
+	}
+	if repo.reads != 1 {
+		t.Fatalf("expected one load inside the refresh window, got %d", repo.reads)
+	}
+
+	if removed, err := blacklist.Clear(ctx, 1, "X1-BL-B"); err != nil || !removed {
+		t.Fatalf("Clear: removed=%v err=%v", removed, err)
+	}
+	if blacklist.IsBlacklisted(ctx, 1, "X1-BL-B") {
+		t.Fatal("cleared waypoint should be eligible again")
+	}
+	var none *WaypointBlacklist
+	if none.IsBlacklisted(ctx, 1, "X1-BL-B") {
+		t.Fatal("a nil blacklist blacklists nothing")
+	}
+}
+
+// Blacklisted waypoints are withheld from the routing backend (the ship's own
+// waypoint excepted), and a blacklisted destination fails the plan.
+func TestRoutePlanner_SkipsBlacklistedWaypoints(t *testing.T) {
+	waypoints := driftTestSystem(t)
+	ship := newExecutorTestShip(t, 50, 100, waypoints["X1-DR-A"])
+	repo := &memoryBlacklistRepo{entries: map[string]*system.BlacklistedWaypoint{}}
+	blacklist := NewWaypointBlacklist(repo, nil)
+	for _, symbol := range []string{"X1-DR-A", "X1-DR-B"} {
+		if err := blacklist.Add(context.Background(), &system.BlacklistedWaypoint{PlayerID: ship.PlayerID().Value(), WaypointSymbol: symbol}); err != nil {
+			t.Fatalf("Add: %v", err)
+		}
+	}
+
+	client := &recordingRoutingClient{}
+	planner := NewRoutePlanner(client)
+	planner.SetWaypointBlacklist(blacklist)
+	if _, err := planner.PlanRoute(context.Background(), ship, "X1-DR-C", waypoints, false); err != nil {
+		t.Fatalf("PlanRoute: %v", err)
+	}
+	offered := map[string]bool{}
+	for _, wp := range client.requests[0].Waypoints {
+		offered[wp.Symbol] = true
+	}
+	if !offered["X1-DR-A"] || offered["X1-DR-B"] || !offered["X1-DR-C"] {
+		t.Fatalf("expected the start and goal but not the blacklisted station, got %v", offered)
+	}
+
+	_, err := planner.PlanRoute(context.Background(), ship, "X1-DR-B", waypoints, false)
+	var blacklisted *system.ErrWaypointBlacklisted
+	if !errors.As(err, &blacklisted) || blacklisted.WaypointSymbol != "X1-DR-B" {
+		t.Fatalf("expected ErrWaypointBlacklisted for X1-DR-B, got %v", err)
+	}
+}
@@ -0,0 +1,131 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/andrescamacho/spacetraders-go/internal/application/common"
+	shipAssignment "github.com/andrescamacho/spacetraders-go/internal/application/ship/commands/assignment"
+	shipCargo "github.com/andrescamacho/spacetraders-go/internal/application/ship/commands/cargo"
+	shipNav "github.com/andrescamacho/spacetraders-go/internal/application/ship/commands/navigation"
+	shipOutfit "github.com/andrescamacho/spacetraders-go/internal/application/ship/commands/outfitting"
+	shipTypes "github.com/andrescamacho/spacetraders-go/internal/application/ship/types"
+	"github.com/andrescamacho/spacetraders-go/internal/domain/navigation"
+	"github.com/andrescamacho/spacetraders-go/internal/domain/shared"
+	"github.com/andrescamacho/spacetraders-go/internal/domain/shipyard"
+)
+
+// LoadoutPresetLoader resolves a loadout preset by name (satisfied by
+// config.LoadoutPresetStore).
+type LoadoutPresetLoader interface {
+	LoadLoadoutPreset(name string) (*shipyard.LoadoutPreset, error)
+}
+
+// LoadoutResult reports the loadout steps run on one purchased ship. Applied
+// lists the steps that succeeded; Failures the ones that did not, with why.
+type LoadoutResult struct {
+	ShipSymbol string
+	Applied    []string
+	Failures   []string
+}
+
+// SetLoadoutPresets wires the preset loader. Without it a batch that names a
+// loadout is refused before anything is bought.
+func (h *BatchPurchaseShipsHandler) SetLoadoutPresets(loader LoadoutPresetLoader) {
+	h.loadouts = loader
+}
+
+// resolveLoadout loads the command's loadout preset, nil when it names none.
+// It runs before the first purchase so a misspelt preset costs nothing.
+func (h *BatchPurchaseShipsHandler) resolveLoadout(cmd *BatchPurchaseShipsCommand) (*shipyard.LoadoutPreset, error) {
+	if cmd.Loadout == "" {
+		return nil, nil
+	}
+	if h.loadouts == nil {
+		return nil, fmt.Errorf("loadout %q requested but no loadout presets are configured", cmd.Loadout)
+	}
+	return h.loadouts.LoadLoadoutPreset(cmd.Loadout)
+}
+
+// applyLoadouts runs preset on every purchased ship in purchase order.
+func (h *BatchPurchaseShipsHandler) applyLoadouts(ctx context.Context, cmd *BatchPurchaseShipsCommand, preset *shipyard.LoadoutPreset, ships []*navigation.Ship) []LoadoutResult {
+	results := make([]LoadoutResult, 0, len(ships))
+	for _, ship := range ships {
+		results = append(results, h.applyLoadout(ctx, cmd.PlayerID, preset, ship.ShipSymbol()))
+	}
+	return results
+}
+
+// applyLoadout sets one new ship up: mounts, fleet, flight mode, then the
+// reposition. A failed step is logged and recorded and the rest still run — the
+// ship is already bought, so a partial setup beats none. A mount whose purchase
+// fails is not installed.
+func (h *BatchPurchaseShipsHandler) applyLoadout(ctx context.Context, playerID shared.PlayerID, preset *shipyard.LoadoutPreset, shipSymbol string) LoadoutResult {
+	logger := common.LoggerFromContext(ctx)
+	result := LoadoutResult{ShipSymbol: shipSymbol}
+	pid := playerID.Value()
+
+	step := func(name string, req common.Request) bool {
+		if _, err := h.mediator.Send(ctx, req); err != nil {
+			result.Failures = append(result.Failures, fmt.Sprintf("%s: %v", name, err))
+			logger.Log("WARNING", fmt.Sprintf("Loadout %s on %s: %s failed: %v", preset.Name, shipSymbol, name, err), map[string]interface{}{
+				"action":  "loadout_step_failed",
+				"loadout": preset.Name,
+				"ship":    shipSymbol,
+				"step":    name,
+			})
+			return false
+		}
+		result.Applied = append(result.Applied, name)
+		return true
+	}
+
+	for _, mount := range preset.Mounts {
+		bought := step("buy "+mount, &shipCargo.PurchaseCargoCommand{
+			ShipSymbol: shipSymbol,
+			GoodSymbol: mount,
+			Units:      1,
+			PlayerID:   playerID,
+		})
+		if bought {
+			step("install "+mount, &shipOutfit.InstallMountCommand{
+				ShipSymbol:  shipSymbol,
+				MountSymbol: mount,
+				PlayerID:    &pid,
+			})
+		}
+	}
+	if preset.Fleet != "" {
+		step("fleet "+preset.Fleet, &shipAssignment.AssignShipFleetCommand{
+			ShipSymbol: shipSymbol,
+			Fleet:      preset.Fleet,
+			PlayerID:   &pid,
+			Assigner:   "loadout:" + preset.Name,
+		})
+	}
+	if preset.FlightMode != "" {
+		// Validate has already rejected unknown modes.
+		mode, _ := shared.ParseFlightMode(preset.FlightMode)
+		step("flight mode "+preset.FlightMode, &shipTypes.SetFlightModeCommand{
+			ShipSymbol: shipSymbol,
+			Mode:       mode,
+			PlayerID:   playerID,
+		})
+	}
+	if preset.HomeWaypoint != "" {
+		step("reposition to "+preset.HomeWaypoint, &shipNav.NavigateRouteCommand{
+			ShipSymbol:  shipSymbol,
+			Destination: preset.HomeWaypoint,
+			PlayerID:    playerID,
+		})
+	}
+
+	logger.Log("INFO", fmt.Sprintf("Loadout %s on %s: %d step(s) applied, %d failed", preset.Name, shipSymbol, len(result.Applied), len(result.Failures)), map[string]interface{}{
+		"action":   "loadout_applied",
+		"loadout":  preset.Name,
+		"ship":     shipSymbol,
+		"applied":  len(result.Applied),
+		"failures": len(result.Failures),
+	})
+	return result
+}
@@ -0,0 +1,108 @@
+package commands
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/andrescamacho/spacetraders-go/internal/application/common"
+	shipAssignment "github.com/andrescamacho/spacetraders-go/internal/application/ship/commands/assignment"
+	shipCargo "github.com/andrescamacho/spacetraders-go/internal/application/ship/commands/cargo"
+	shipNav "github.com/andrescamacho/spacetraders-go/internal/application/ship/commands/navigation"
+	shipOutfit "github.com/andrescamacho/spacetraders-go/internal/application/ship/commands/outfitting"
+	shipTypes "github.com/andrescamacho/spacetraders-go/internal/application/ship/types"
+	"github.com/andrescamacho/spacetraders-go/internal/domain/shared"
+	"github.com/andrescamacho/spacetraders-go/internal/domain/shipyard"
+)
+
+// loadoutFakeMediator records every loadout step dispatched, as "kind:detail",
+// and fails the purchase of any good listed in failBuy.
+type loadoutFakeMediator struct {
+	common.Mediator
+
+	failBuy map[string]bool
+	sent    []string
+}
+
+func (m *loadoutFakeMediator) Send(_ context.Context, request common.Request) (common.Response, error) {
+	switch req := request.(type) {
+	case *shipCargo.PurchaseCargoCommand:
+		m.sent = append(m.sent, "buy:"+req.GoodSymbol)
+		if m.failBuy[req.GoodSymbol] {
+			return nil, errors.New("market does not sell it")
+		}
+	case *shipOutfit.InstallMountCommand:
+		m.sent = append(m.sent, "install:"+req.MountSymbol)
+	case *shipAssignment.AssignShipFleetCommand:
+		m.sent = append(m.sent, "fleet:"+req.Fleet)
+	case *shipTypes.SetFlightModeCommand:
+		m.sent = append(m.sent, "mode:"+req.Mode.Name())
+	case *shipNav.NavigateRouteCommand:
+		m.sent = append(m.sent, "navigate:"+req.Destination)
+	default:
+		return nil, fmt.Errorf("unexpected request %T", request)
+	}
+	return nil, nil
+}
+
+type fakeLoadoutLoader map[string]*shipyard.LoadoutPreset
+
+func (l fakeLoadoutLoader) LoadLoadoutPreset(name string) (*shipyard.LoadoutPreset, error) {
+	if preset, ok := l[name]; ok {
+		return preset, nil
+	}
+	return nil, fmt.Errorf("loadout preset %q not found", name)
+}
+
+// Every step runs in order; a mount that cannot be bought is skipped rather than
+// installed, and the steps after it still run.
+func TestApplyLoadout_RunsEveryStepPastAFailedMount(t *testing.T) {
+	med := &loadoutFakeMediator{failBuy: map[string]bool{"MOUNT_SURVEYOR_I": true}}
+	handler := &BatchPurchaseShipsHandler{mediator: med}
+	preset := &shipyard.LoadoutPreset{
+		Name:         "miner",
+		Mounts:       []string{"MOUNT_SURVEYOR_I", "MOUNT_MINING_LASER_II"},
+		Fleet:        "mining",
+		FlightMode:   "DRIFT",
+		HomeWaypoint: "X1-GZ7-B12",
+	}
+
+	result := handler.applyLoadout(context.Background(), shared.MustNewPlayerID(1), preset, "TORWIND-7")
+
+	want := []string{
+		"buy:MOUNT_SURVEYOR_I",
+		"buy:MOUNT_MINING_LASER_II",
+		"install:MOUNT_MINING_LASER_II",
+		"fleet:mining",
+		"mode:DRIFT",
+		"navigate:X1-GZ7-B12",
+	}
+	if fmt.Sprint(med.sent) != fmt.Sprint(want) {
+		t.Fatalf("expected steps %v, got %v", want, med.sent)
+	}
+	if len(result.Failures) != 1 || len(result.Applied) != 5 {
+		t.Fatalf("expected 5 applied steps and 1 failure, got %+v", result)
+	}
+}
+
+// A batch naming an unknown preset, or any preset without a loader, is refused
+// before a credit is spent.
+func TestResolveLoadout_RefusesUnknownPresets(t *testing.T) {
+	cmd := &BatchPurchaseShipsCommand{Loadout: "trade-hauler"}
+
+	if _, err := (&BatchPurchaseShipsHandler{}).resolveLoadout(cmd); err == nil {
+		t.Fatal("a loadout without a configured loader must be refused")
+	}
+
+	handler := &BatchPurchaseShipsHandler{loadouts: fakeLoadoutLoader{}}
+	if _, err := handler.resolveLoadout(cmd); err == nil {
+		t.Fatal("an unknown loadout must be refused")
+	}
+
+	handler.loadouts = fakeLoadoutLoader{"trade-hauler": {Name: "trade-hauler", Fleet: "trade"}}
+	preset, err := handler.resolveLoadout(cmd)
+	if err != nil || preset == nil || preset.Fleet != "trade" {
+		t.Fatalf("expected the trade-hauler preset, got %+v, %v", preset, err)
+	}
+}
@@ -2,10 +2,13 @@ package commands
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"time"
 
 	"github.com/andrescamacho/spacetraders-go/internal/application/common"
 	"github.com/andrescamacho/spacetraders-go/internal/application/shipyard/queries"
+	"github.com/andrescamacho/spacetraders-go/internal/domain/ledger"
 	"github.com/andrescamacho/spacetraders-go/internal/domain/navigation"
 	"github.com/andrescamacho/spacetraders-go/internal/domain/player"
 	domainPorts "github.com/andrescamacho/spacetraders-go/internal/domain/ports"
@@ -22,42 +25,143 @@ import (
 //
 // The purchasing ship will be used to navigate to the shipyard if needed.
 // If shipyard_waypoint is not provided, will auto-discover nearest shipyard that sells the ship type.
+//
+// MaxPrice caps what any one ship may cost. Without WaitForDip a listing above
+// the cap ends the batch; with it, purchases are deferred and the listing is
+// re-read every DipPollInterval until it falls to MaxPrice or DipDeadline
+// passes. Nothing is ever bought above MaxPrice.
+//
+// Loadout names a post-purchase preset (see shipyard.LoadoutPreset) applied to
+// every ship the batch buys; it is resolved before the first purchase.
+//
+// FailurePolicy decides what a purchase failing mid-batch does to the ships
+// already bought: keep them and stop (the default), keep going with the rest
+// of the batch, or scrap them so the batch is all-or-nothing.
 type BatchPurchaseShipsCommand struct {
 	PurchasingShipSymbol string
 	ShipType             string
 	Quantity             int
 	MaxBudget            int // 0 = unlimited budget
 	PlayerID             shared.PlayerID
-	ShipyardWaypoint     string // Optional - will auto-discover if empty
+	ShipyardWaypoint     string        // Optional - will auto-discover if empty
+	MaxPrice             int           // 0 = no per-ship cap
+	WaitForDip           bool          // Defer while the listing is above MaxPrice
+	DipDeadline          time.Time     // Zero = DefaultDipWaitTimeout after the batch starts
+	DipPollInterval      time.Duration // <=0 = DefaultDipPollInterval
+	Loadout              string        // Optional loadout preset name
+	FailurePolicy        BatchFailurePolicy
 }
 
+// BatchFailurePolicy is what a batch does when one of its purchases fails
+// after others have gone through.
+type BatchFailurePolicy string
+
+const (
+	// BatchFailureStop keeps the ships bought so far and ends the batch. An
+	// empty policy means BatchFailureStop.
+	BatchFailureStop BatchFailurePolicy = "stop"
+	// BatchFailureBestEffort records the failure and carries on with the
+	// remaining purchases.
+	BatchFailureBestEffort BatchFailurePolicy = "best_effort"
+	// BatchFailureRollback makes the batch atomic: it refuses up front unless
+	// the whole quantity is affordable and listed, and a failure mid-batch
+	// scraps every ship the batch already bought.
+	BatchFailureRollback BatchFailurePolicy = "rollback"
+)
+
+// ParseBatchFailurePolicy validates a caller-supplied policy name.
+func ParseBatchFailurePolicy(name string) (BatchFailurePolicy, error) {
+	switch policy := BatchFailurePolicy(name); policy {
+	case "", BatchFailureStop:
+		return BatchFailureStop, nil
+	case BatchFailureBestEffort, BatchFailureRollback:
+		return policy, nil
+	default:
+		return "", fmt.Errorf("unknown batch failure policy %q (want %s, %s or %s)",
+			name, BatchFailureStop, BatchFailureBestEffort, BatchFailureRollback)
+	}
+}
+
+// DefaultDipWaitTimeout bounds a wait-for-dip batch that sets no DipDeadline.
+const DefaultDipWaitTimeout = time.Hour
+
+// DefaultDipPollInterval is how often a wait-for-dip batch re-reads the listing.
+const DefaultDipPollInterval = 2 * time.Minute
+
 // BatchPurchaseShipsResponse contains the list of purchased ships and total cost
 type BatchPurchaseShipsResponse struct {
 	PurchasedShips      []*navigation.Ship
 	TotalCost           int
 	ShipsPurchasedCount int
+	// PriceAboveMax is set when no ship was bought because the listing stayed
+	// above MaxPrice; LastQuotedPrice is the last price seen.
+	PriceAboveMax   bool
+	LastQuotedPrice int
+	// Loadouts reports the loadout steps run on each purchased ship, in
+	// purchase order; empty when the batch named no loadout.
+	Loadouts []LoadoutResult
+	// FailedPurchases lists the purchases a best-effort batch skipped past.
+	FailedPurchases []string
+}
+
+// BatchRolledBackError reports a rollback batch that failed mid-way. Scrapped
+// lists the ships it bought and scrapped again; Stranded lists any it bought
+// but could not scrap, which the caller now owns.
+type BatchRolledBackError struct {
+	Cause          error
+	Scrapped       []string
+	ScrapRecovered int
+	Stranded       []string
+}
+
+func (e *BatchRolledBackError) Error() string {
+	msg := fmt.Sprintf("batch purchase rolled back: %v; scrapped %d ship(s) for %d credits",
+		e.Cause, len(e.Scrapped), e.ScrapRecovered)
+	if len(e.Stranded) > 0 {
+		msg += fmt.Sprintf("; could not scrap %v", e.Stranded)
+	}
+	return msg
 }
 
+func (e *BatchRolledBackError) Unwrap() error { return e.Cause }
+
 // BatchPurchaseShipsHandler handles the BatchPurchaseShips command
 type BatchPurchaseShipsHandler struct {
 	playerRepo player.PlayerRepository
 	mediator   common.Mediator
 	apiClient  domainPorts.APIClient
+	clock      shared.Clock
+	loadouts   LoadoutPresetLoader
+
+	reservations ledger.CreditReservations
 }
 
-// NewBatchPurchaseShipsHandler creates a new BatchPurchaseShipsHandler
+// NewBatchPurchaseShipsHandler creates a new BatchPurchaseShipsHandler. A nil
+// clock defaults to the real clock.
 func NewBatchPurchaseShipsHandler(
 	playerRepo player.PlayerRepository,
 	mediator common.Mediator,
 	apiClient domainPorts.APIClient,
+	clock shared.Clock,
 ) *BatchPurchaseShipsHandler {
+	if clock == nil {
+		clock = shared.NewRealClock()
+	}
 	return &BatchPurchaseShipsHandler{
 		playerRepo: playerRepo,
 		mediator:   mediator,
 		apiClient:  apiClient,
+		clock:      clock,
 	}
 }
 
+// SetCreditReservations makes the batch reserve each ship's price before
+// buying it, so a concurrent coordinator cannot spend the same credits. nil
+// disables reserving.
+func (h *BatchPurchaseShipsHandler) SetCreditReservations(reservations ledger.CreditReservations) {
+	h.reservations = reservations
+}
+
 // Handle executes the BatchPurchaseShips command
 func (h *BatchPurchaseShipsHandler) Handle(ctx context.Context, request common.Request) (common.Response, error) {
 	cmd, ok := request.(*BatchPurchaseShipsCommand)
@@ -65,36 +169,102 @@ func (h *BatchPurchaseShipsHandler) Handle(ctx context.Context, request common.R
 		return nil, fmt.Errorf("invalid request type")
 	}
 
-	if response := h.validatePurchaseRequest(cmd.Quantity, cmd.MaxBudget); response != nil {
+	if response := h.validatePurchaseRequest(cmd.Quantity, cmd.MaxBudget, cmd.MaxPrice); response != nil {
 		return response, nil
 	}
+	if _, err := ParseBatchFailurePolicy(string(cmd.FailurePolicy)); err != nil {
+		return nil, err
+	}
 
 	token, err := common.PlayerTokenFromContext(ctx)
 	if err != nil {
 		return nil, err
 	}
 
+	loadout, err := h.resolveLoadout(cmd)
+	if err != nil {
+		return nil, err
+	}
+
 	shipPrice, purchasableCount, shipyardWaypoint, err := h.calculatePurchasableCount(ctx, cmd, token)
 	if err != nil {
 		return nil, err
 	}
 
-	purchasedShips, totalSpent, err := h.executePurchaseLoop(ctx, cmd, purchasableCount, shipyardWaypoint, shipPrice)
+	// A pinned yard already quoting above the cap: without wait-for-dip there
+	// is nothing to buy, so don't send the ship there.
+	if cmd.MaxPrice > 0 && shipPrice > cmd.MaxPrice && !cmd.WaitForDip {
+		return priceAboveMaxResponse(shipPrice), nil
+	}
+	if err := checkAtomicBatchStock(cmd, shipPrice, shipyardWaypoint); err != nil {
+		return nil, err
+	}
+	if cmd.FailurePolicy == BatchFailureRollback && purchasableCount < cmd.Quantity {
+		return nil, fmt.Errorf("atomic batch refused: only %d of %d %s affordable at %d credits each",
+			purchasableCount, cmd.Quantity, cmd.ShipType, cmd.budgetPrice(shipPrice))
+	}
+
+	loop, err := h.runPurchaseLoop(ctx, cmd, purchasableCount, shipyardWaypoint, cmd.budgetPrice(shipPrice))
 	if err != nil {
+		var priceErr *ShipPriceAboveMaxError
+		if errors.As(err, &priceErr) {
+			return priceAboveMaxResponse(priceErr.Price), nil
+		}
 		return nil, err
 	}
+	purchasedShips := loop.ships
 
-	return &BatchPurchaseShipsResponse{
+	response := &BatchPurchaseShipsResponse{
 		PurchasedShips:      purchasedShips,
-		TotalCost:           totalSpent,
+		TotalCost:           loop.spent,
 		ShipsPurchasedCount: len(purchasedShips),
-	}, nil
+		FailedPurchases:     loop.failures,
+	}
+	if loadout != nil {
+		response.Loadouts = h.applyLoadouts(ctx, cmd, loadout, purchasedShips)
+	}
+	return response, nil
 }
 
-// validatePurchaseRequest validates quantity and budget constraints
+// checkAtomicBatchStock refuses a rollback batch before any purchase unless
+// the pinned shipyard has a live priced listing for the type. Without one the
+// yard's stock and the batch's total cost can only be learned by buying, which
+// an all-or-nothing batch must not do. shipPrice is 0 when there was no
+// listing to read. The other policies keep buying what they can.
+func checkAtomicBatchStock(cmd *BatchPurchaseShipsCommand, shipPrice int, shipyardWaypoint string) error {
+	if cmd.FailurePolicy != BatchFailureRollback || shipPrice > 0 {
+		return nil
+	}
+	if shipyardWaypoint == "" {
+		return fmt.Errorf("atomic batch refused: pin a shipyard so its %s stock can be checked before buying", cmd.ShipType)
+	}
+	return fmt.Errorf("atomic batch refused: shipyard %s has no live %s listing to check stock and total cost against",
+		shipyardWaypoint, cmd.ShipType)
+}
+
+// budgetPrice is the most one ship can cost this batch: the quoted price,
+// capped by MaxPrice when set.
+func (c *BatchPurchaseShipsCommand) budgetPrice(quote int) int {
+	if c.MaxPrice > 0 && quote > c.MaxPrice {
+		return c.MaxPrice
+	}
+	return quote
+}
+
+// priceAboveMaxResponse reports a batch that bought nothing because the
+// listing stayed above MaxPrice.
+func priceAboveMaxResponse(lastQuote int) *BatchPurchaseShipsResponse {
+	return &BatchPurchaseShipsResponse{
+		PurchasedShips:  []*navigation.Ship{},
+		PriceAboveMax:   true,
+		LastQuotedPrice: lastQuote,
+	}
+}
+
+// validatePurchaseRequest validates quantity, budget and price-cap constraints
 // Returns early-return response if validation fails, nil if valid
 // Note: maxBudget == 0 is treated as unlimited budget (only constrained by credits)
-func (h *BatchPurchaseShipsHandler) validatePurchaseRequest(quantity int, maxBudget int) *BatchPurchaseShipsResponse {
+func (h *BatchPurchaseShipsHandler) validatePurchaseRequest(quantity int, maxBudget int, maxPrice int) *BatchPurchaseShipsResponse {
 	if quantity <= 0 {
 		return &BatchPurchaseShipsResponse{
 			PurchasedShips:      []*navigation.Ship{},
@@ -102,7 +272,7 @@ func (h *BatchPurchaseShipsHandler) validatePurchaseRequest(quantity int, maxBud
 			ShipsPurchasedCount: 0,
 		}
 	}
-	if maxBudget < 0 {
+	if maxBudget < 0 || maxPrice < 0 {
 		return &BatchPurchaseShipsResponse{
 			PurchasedShips:      []*navigation.Ship{},
 			TotalCost:           0,
@@ -149,7 +319,7 @@ func (h *BatchPurchaseShipsHandler) calculatePurchasableCount(
 		return 0, 0, "", fmt.Errorf("failed to get agent data: %w", err)
 	}
 
-	purchasableCount = h.calculateMaxPurchasableShips(cmd.Quantity, cmd.MaxBudget, agentData.Credits, shipPrice)
+	purchasableCount = h.calculateMaxPurchasableShips(cmd.Quantity, cmd.MaxBudget, agentData.Credits, cmd.budgetPrice(shipPrice))
 	return shipPrice, purchasableCount, shipyardWaypoint, nil
 }
 
@@ -219,26 +389,60 @@ func (h *BatchPurchaseShipsHandler) calculateMaxPurchasableShips(
 	return utils.Min3(maxByQuantity, maxByBudget, maxByCredits)
 }
 
-// executePurchaseLoop purchases ships one at a time up to purchasable count
+// purchaseLoopResult is what a purchase loop bought and skipped.
+type purchaseLoopResult struct {
+	ships    []*navigation.Ship
+	spent    int
+	failures []string
+}
+
+// runPurchaseLoop purchases ships one at a time up to purchasable count
 // Handles partial success, captures shipyard location from first purchase
-// Returns: purchased ships, total spent, error
-func (h *BatchPurchaseShipsHandler) executePurchaseLoop(
+// In wait-for-dip mode a purchase refused on price is retried after a poll
+// interval instead of ending the batch, until the dip deadline. Any other
+// failure is handled per the command's FailurePolicy.
+func (h *BatchPurchaseShipsHandler) runPurchaseLoop(
 	ctx context.Context,
 	cmd *BatchPurchaseShipsCommand,
 	purchasableCount int,
 	shipyardWaypoint string,
 	shipPrice int,
-) ([]*navigation.Ship, int, error) {
+) (*purchaseLoopResult, error) {
 	var purchasedShips []*navigation.Ship
+	var failures []string
 	totalSpent := 0
+	deadline := h.dipDeadline(cmd)
 
 	for i := 0; i < purchasableCount; i++ {
-		purchaseResp, err := h.purchaseShip(ctx, cmd, shipyardWaypoint)
+		purchaseResp, err := h.purchaseShip(ctx, cmd, shipyardWaypoint, shipPrice)
 		if err != nil {
+			var priceErr *ShipPriceAboveMaxError
+			if errors.As(err, &priceErr) && h.waitForDip(ctx, cmd, priceErr, deadline) {
+				// Keep polling the yard that quoted, not a re-discovered one.
+				shipyardWaypoint = priceErr.ShipyardWaypoint
+				i--
+				continue
+			}
+			failure := fmt.Errorf("failed to purchase ship %d of %d: %w", i+1, purchasableCount, err)
+			switch cmd.FailurePolicy {
+			case BatchFailureRollback:
+				return nil, h.rollBack(ctx, cmd, purchasedShips, failure)
+			case BatchFailureBestEffort:
+				// A price refusal or a cancelled context fails every remaining
+				// purchase the same way; only other failures are worth skipping.
+				if priceErr == nil && ctx.Err() == nil {
+					failures = append(failures, failure.Error())
+					h.logSkippedPurchase(ctx, cmd, failure)
+					continue
+				}
+			}
 			if len(purchasedShips) > 0 {
-				return purchasedShips, totalSpent, nil
+				return &purchaseLoopResult{ships: purchasedShips, spent: totalSpent, failures: failures}, nil
 			}
-			return nil, 0, fmt.Errorf("failed to purchase ship %d of %d: %w", i+1, purchasableCount, err)
+			if len(failures) > 0 {
+				return nil, fmt.Errorf("every purchase in the batch failed; last: %w", failure)
+			}
+			return nil, failure
 		}
 
 		// Money-integrity floor (sp-e7je): the batch boundary must never accept a
@@ -250,10 +454,14 @@ func (h *BatchPurchaseShipsHandler) executePurchaseLoop(
 		// partial success: a substitution signals a broken purchase path, so we do
 		// not keep the earlier ships or spend on any more.
 		if purchaseResp.ShipType != cmd.ShipType {
-			return nil, 0, fmt.Errorf(
+			abort := fmt.Errorf(
 				"money-integrity abort: requested %s but yard %s delivered %s on purchase %d of %d — refusing to substitute yard stock for the requested type",
 				cmd.ShipType, shipyardWaypoint, purchaseResp.ShipType, i+1, purchasableCount,
 			)
+			if cmd.FailurePolicy == BatchFailureRollback {
+				return nil, h.rollBack(ctx, cmd, append(purchasedShips, purchaseResp.Ship), abort)
+			}
+			return nil, abort
 		}
 
 		purchasedShips = append(purchasedShips, purchaseResp.Ship)
@@ -268,21 +476,84 @@ func (h *BatchPurchaseShipsHandler) executePurchaseLoop(
 		}
 	}
 
-	return purchasedShips, totalSpent, nil
+	if cmd.FailurePolicy == BatchFailureRollback && len(purchasedShips) < purchasableCount {
+		short := fmt.Errorf("budget or credits ran out after %d of %d ships", len(purchasedShips), purchasableCount)
+		return nil, h.rollBack(ctx, cmd, purchasedShips, short)
+	}
+	if len(purchasedShips) == 0 && len(failures) > 0 {
+		return nil, fmt.Errorf("every purchase in the batch failed; last: %s", failures[len(failures)-1])
+	}
+	return &purchaseLoopResult{ships: purchasedShips, spent: totalSpent, failures: failures}, nil
+}
+
+// rollBack scraps the ships a rollback batch bought before cause stopped it.
+// Each scrap is attempted even after one fails, so as few ships as possible
+// are left behind; the returned BatchRolledBackError names any that were.
+// The scraps run even when the batch was cancelled: a cancel is a reason to
+// roll back, not to leave the bought hulls behind.
+func (h *BatchPurchaseShipsHandler) rollBack(
+	ctx context.Context,
+	cmd *BatchPurchaseShipsCommand,
+	purchased []*navigation.Ship,
+	cause error,
+) error {
+	ctx = context.WithoutCancel(ctx)
+	rollback := &BatchRolledBackError{Cause: cause}
+	logger := common.LoggerFromContext(ctx)
+	for _, ship := range purchased {
+		if ship == nil {
+			continue
+		}
+		symbol := ship.ShipSymbol()
+		resp, err := h.mediator.Send(ctx, &ScrapShipCommand{ShipSymbol: symbol, PlayerID: cmd.PlayerID})
+		if err != nil {
+			logger.Log("ERROR", fmt.Sprintf("Rollback could not scrap %s: %v", symbol, err), map[string]interface{}{
+				"action":    "batch_purchase_rollback",
+				"ship":      symbol,
+				"ship_type": cmd.ShipType,
+				"error":     err.Error(),
+			})
+			rollback.Stranded = append(rollback.Stranded, symbol)
+			continue
+		}
+		rollback.Scrapped = append(rollback.Scrapped, symbol)
+		if scrap, ok := resp.(*ScrapShipResponse); ok {
+			rollback.ScrapRecovered += scrap.ScrapValue
+		}
+	}
+	return rollback
 }
 
-// purchaseShip purchases a single ship via the PurchaseShipCommand
+// logSkippedPurchase records a purchase a best-effort batch moved past.
+func (h *BatchPurchaseShipsHandler) logSkippedPurchase(ctx context.Context, cmd *BatchPurchaseShipsCommand, failure error) {
+	common.LoggerFromContext(ctx).Log("WARNING", fmt.Sprintf("Best-effort batch skipping failed purchase: %v", failure), map[string]interface{}{
+		"action":    "batch_purchase_skip",
+		"ship_type": cmd.ShipType,
+		"error":     failure.Error(),
+	})
+}
+
+// purchaseShip purchases a single ship via the PurchaseShipCommand, holding a
+// credit reservation for shipPrice until the purchase settles
 // Returns: purchase response, error
 func (h *BatchPurchaseShipsHandler) purchaseShip(
 	ctx context.Context,
 	cmd *BatchPurchaseShipsCommand,
 	shipyardWaypoint string,
+	shipPrice int,
 ) (*PurchaseShipResponse, error) {
+	ctx, release, err := h.reserveCredits(ctx, cmd, shipPrice)
+	if err != nil {
+		return nil, err
+	}
+	defer release()
+
 	purchaseCmd := &PurchaseShipCommand{
 		PurchasingShipSymbol: cmd.PurchasingShipSymbol,
 		ShipType:             cmd.ShipType,
 		PlayerID:             cmd.PlayerID,
 		ShipyardWaypoint:     shipyardWaypoint,
+		MaxPrice:             cmd.MaxPrice,
 	}
 
 	resp, err := h.mediator.Send(ctx, purchaseCmd)
@@ -298,6 +569,23 @@ func (h *BatchPurchaseShipsHandler) purchaseShip(
 	return purchaseResp, nil
 }
 
+// reserveCredits reserves amount against the live balance for one ship.
+func (h *BatchPurchaseShipsHandler) reserveCredits(ctx context.Context, cmd *BatchPurchaseShipsCommand, amount int) (context.Context, func(), error) {
+	if h.reservations == nil {
+		return ctx, func() {}, nil
+	}
+	token, err := common.PlayerTokenFromContext(ctx)
+	if err != nil {
+		return ctx, func() {}, err
+	}
+	agentData, err := h.apiClient.GetAgent(ctx, token)
+	if err != nil {
+		return ctx, func() {}, fmt.Errorf("failed to get agent data: %w", err)
+	}
+	holder := "batch-purchase:" + cmd.ShipType
+	return ledger.ReserveForPurchase(ctx, h.reservations, cmd.PlayerID.Value(), holder, amount, agentData.Credits)
+}
+
 // hasRemainingBudgetAndCredits checks if we can afford another ship purchase
 // Returns: true if both budget and credits allow another purchase
 // Note: maxBudget == 0 means unlimited budget (only check credits)
@@ -320,3 +608,75 @@ func (h *BatchPurchaseShipsHandler) hasRemainingBudgetAndCredits(
 	// Check budget constraint
 	return totalSpent+shipPrice <= maxBudget
 }
+
+// dipDeadline resolves when a wait-for-dip batch gives up: the command's
+// deadline, or DefaultDipWaitTimeout from now.
+func (h *BatchPurchaseShipsHandler) dipDeadline(cmd *BatchPurchaseShipsCommand) time.Time {
+	if !cmd.DipDeadline.IsZero() {
+		return cmd.DipDeadline
+	}
+	return h.clockOrReal().Now().Add(DefaultDipWaitTimeout)
+}
+
+// waitForDip sleeps one poll interval after a purchase was refused on price
+// and reports whether the purchase should be retried. It returns false at once
+// outside wait-for-dip mode, once the deadline has passed, or when ctx is done.
+func (h *BatchPurchaseShipsHandler) waitForDip(
+	ctx context.Context,
+	cmd *BatchPurchaseShipsCommand,
+	priceErr *ShipPriceAboveMaxError,
+	deadline time.Time,
+) bool {
+	if !cmd.WaitForDip || ctx.Err() != nil {
+		return false
+	}
+	clock := h.clockOrReal()
+	remaining := deadline.Sub(clock.Now())
+	if remaining <= 0 {
+		return false
+	}
+
+	poll := cmd.DipPollInterval
+	if poll <= 0 {
+		poll = DefaultDipPollInterval
+	}
+	poll = min(poll, remaining)
+
+	common.LoggerFromContext(ctx).Log("INFO", fmt.Sprintf(
+		"Waiting for %s at %s to dip: quoted %d, max %d; next check in %s",
+		cmd.ShipType, priceErr.ShipyardWaypoint, priceErr.Price, priceErr.MaxPrice, poll,
+	), map[string]interface{}{
+		"action":    "wait_for_price_dip",
+		"ship_type": cmd.ShipType,
+		"waypoint":  priceErr.ShipyardWaypoint,
+		"price":     priceErr.Price,
+		"max_price": priceErr.MaxPrice,
+	})
+	return sleepInterruptibly(ctx, clock, poll)
+}
+
+// sleepInterruptibly waits d on clock, returning false if ctx is done first.
+// The sleeping goroutine outlives a cancellation until d elapses, the same
+// tradeoff the coordinators' sleepInterruptibly makes to stay clock-injected.
+func sleepInterruptibly(ctx context.Context, clock shared.Clock, d time.Duration) bool {
+	slept := make(chan struct{})
+	go func() {
+		clock.Sleep(d)
+		close(slept)
+	}()
+	select {
+	case <-ctx.Done():
+		return false
+	case <-slept:
+		return ctx.Err() == nil
+	}
+}
+
+// clockOrReal returns the handler's clock, defaulting to the real clock for
+// handlers built without the constructor.
+func (h *BatchPurchaseShipsHandler) clockOrReal() shared.Clock {
+	if h.clock == nil {
+		return shared.NewRealClock()
+	}
+	return h.clock
+}
@@ -0,0 +1,161 @@
+package commands
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/andrescamacho/spacetraders-go/internal/application/auth"
+	"github.com/andrescamacho/spacetraders-go/internal/application/common"
+	"github.com/andrescamacho/spacetraders-go/internal/domain/shared"
+)
+
+const (
+	dipYard     = "X1-DIP-Y1"
+	dipShipType = "SHIP_LIGHT_HAULER"
+)
+
+// dipFakeMediator quotes a falling price: each PurchaseShipCommand sees the next
+// quote and is refused while it is above the command's MaxPrice, exactly as
+// PurchaseShipHandler refuses after reading the live listing.
+type dipFakeMediator struct {
+	common.Mediator
+
+	quotes  []int
+	sends   int
+	bought  int
+	lastCmd *PurchaseShipCommand
+}
+
+func (m *dipFakeMediator) Send(_ context.Context, request common.Request) (common.Response, error) {
+	cmd, ok := request.(*PurchaseShipCommand)
+	if !ok {
+		return nil, nil
+	}
+	m.lastCmd = cmd
+	quote := m.quotes[min(m.sends, len(m.quotes)-1)]
+	m.sends++
+	if cmd.MaxPrice > 0 && quote > cmd.MaxPrice {
+		return nil, &ShipPriceAboveMaxError{ShipType: cmd.ShipType, ShipyardWaypoint: dipYard, Price: quote, MaxPrice: cmd.MaxPrice}
+	}
+	m.bought++
+	return &PurchaseShipResponse{PurchasePrice: quote, AgentCredits: 1_000_000, ShipType: cmd.ShipType}, nil
+}
+
+func dipCommand() *BatchPurchaseShipsCommand {
+	return &BatchPurchaseShipsCommand{
+		PurchasingShipSymbol: "BUYER-1",
+		ShipType:             dipShipType,
+		Quantity:             1,
+		PlayerID:             shared.MustNewPlayerID(1),
+		ShipyardWaypoint:     dipYard,
+		MaxPrice:             80_000,
+		WaitForDip:           true,
+		DipPollInterval:      5 * time.Minute,
+	}
+}
+
+// Wait-for-dip defers the purchase across polls and buys once the quote falls
+// to MaxPrice, passing the cap down so nothing is bought above it.
+func TestBatchPurchase_WaitForDip_BuysWhenPriceFalls(t *testing.T) {
+	start := time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC)
+	clock := &shared.MockClock{CurrentTime: start}
+	med := &dipFakeMediator{quotes: []int{95_000, 88_000, 79_000}}
+	handler := &BatchPurchaseShipsHandler{mediator: med, clock: clock}
+	cmd := dipCommand()
+
+	loop, err := handler.runPurchaseLoop(context.Background(), cmd, 1, dipYard, cmd.MaxPrice)
+	if err != nil {
+		t.Fatalf("runPurchaseLoop: %v", err)
+	}
+	if len(loop.ships) != 1 || loop.spent != 79_000 {
+		t.Fatalf("bought %d for %d, want 1 for 79000", len(loop.ships), loop.spent)
+	}
+	if med.sends != 3 {
+		t.Fatalf("sends = %d, want 3 (two refused quotes, then the buy)", med.sends)
+	}
+	if got := clock.CurrentTime.Sub(start); got != 10*time.Minute {
+		t.Fatalf("waited %s, want two 5m polls", got)
+	}
+	if med.lastCmd.MaxPrice != cmd.MaxPrice {
+		t.Fatalf("per-ship MaxPrice = %d, want %d", med.lastCmd.MaxPrice, cmd.MaxPrice)
+	}
+}
+
+// Once the deadline passes the batch gives up without buying and reports the
+// last quote instead of failing.
+func TestBatchPurchase_WaitForDip_GivesUpAtDeadline(t *testing.T) {
+	start := time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC)
+	clock := &shared.MockClock{CurrentTime: start}
+	med := &dipFakeMediator{quotes: []int{95_000, 92_000, 90_000}}
+	handler := &BatchPurchaseShipsHandler{mediator: med, clock: clock}
+	cmd := dipCommand()
+	cmd.ShipyardWaypoint = "" // auto-discover: no up-front listing read
+	cmd.DipDeadline = start.Add(12 * time.Minute)
+
+	ctx := auth.WithPlayerToken(context.Background(), "token")
+	resp, err := handler.Handle(ctx, cmd)
+	if err != nil {
+		t.Fatalf("Handle: %v", err)
+	}
+	out := resp.(*BatchPurchaseShipsResponse)
+	if med.bought != 0 || out.ShipsPurchasedCount != 0 {
+		t.Fatalf("bought %d ships above the cap", med.bought)
+	}
+	if !out.PriceAboveMax || out.LastQuotedPrice != 90_000 {
+		t.Fatalf("response = %+v, want PriceAboveMax with last quote 90000", out)
+	}
+	if clock.CurrentTime.After(cmd.DipDeadline) {
+		t.Fatalf("waited until %s, past the %s deadline", clock.CurrentTime, cmd.DipDeadline)
+	}
+}
+
+// Without wait-for-dip a quote above the cap ends the batch at once.
+func TestBatchPurchase_MaxPriceWithoutWait_StopsImmediately(t *testing.T) {
+	clock := &shared.MockClock{CurrentTime: time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC)}
+	med := &dipFakeMediator{quotes: []int{95_000, 70_000}}
+	handler := &BatchPurchaseShipsHandler{mediator: med, clock: clock}
+	cmd := dipCommand()
+	cmd.WaitForDip = false
+
+	_, err := handler.runPurchaseLoop(context.Background(), cmd, 1, dipYard, cmd.MaxPrice)
+	if err == nil {
+		t.Fatal("expected the price refusal to surface")
+	}
+	if med.sends != 1 {
+		t.Fatalf("sends = %d, want 1: no polling without wait-for-dip", med.sends)
+	}
+}
+
+// hangingClock never wakes a Sleep, so only ctx can end a wait.
+type hangingClock struct {
+	*shared.MockClock
+}
+
+func (hangingClock) Sleep(time.Duration) { select {} }
+
+// Canceling the batch ends a dip wait at once instead of after the poll.
+func TestBatchPurchase_WaitForDip_StopsOnCancel(t *testing.T) {
+	clock := hangingClock{&shared.MockClock{CurrentTime: time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC)}}
+	med := &dipFakeMediator{quotes: []int{95_000}}
+	handler := &BatchPurchaseShipsHandler{mediator: med, clock: clock}
+	cmd := dipCommand()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		_, _ = handler.runPurchaseLoop(ctx, cmd, 1, dipYard, cmd.MaxPrice)
+	}()
+	time.Sleep(20 * time.Millisecond)
+	cancel()
+
+	select {
+	case <-done:
+	case <-time.After(5 * time.Second):
+		t.Fatal("the dip wait did not stop on cancel")
+	}
+	if med.bought != 0 {
+		t.Fatalf("bought %d ships after cancel", med.bought)
+	}
+}
@@ -0,0 +1,216 @@
+package commands
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/andrescamacho/spacetraders-go/internal/application/auth"
+	"github.com/andrescamacho/spacetraders-go/internal/application/common"
+	"github.com/andrescamacho/spacetraders-go/internal/application/shipyard/queries"
+	"github.com/andrescamacho/spacetraders-go/internal/domain/navigation"
+	"github.com/andrescamacho/spacetraders-go/internal/domain/shared"
+	"github.com/andrescamacho/spacetraders-go/internal/domain/shipyard"
+)
+
+const policyShipPrice = 20000
+
+// policyFakeMediator buys a fresh ship per PurchaseShipCommand except on the
+// purchases listed in failOn (1-based), and records every ScrapShipCommand.
+// cancelOn cancels the batch's context on that purchase and fails it, the way
+// a stopped container does; a scrap sent on a cancelled context fails too.
+// Listing reads report a yard that sells the type with no priced listing.
+type policyFakeMediator struct {
+	common.Mediator
+
+	t         *testing.T
+	failOn    map[int]bool
+	scrapFail map[string]bool
+	cancelOn  int
+	cancel    context.CancelFunc
+	sends     int
+	scrapped  []string
+}
+
+func (m *policyFakeMediator) Send(ctx context.Context, request common.Request) (common.Response, error) {
+	switch cmd := request.(type) {
+	case *PurchaseShipCommand:
+		m.sends++
+		if m.sends == m.cancelOn {
+			m.cancel()
+			return nil, ctx.Err()
+		}
+		if m.failOn[m.sends] {
+			return nil, errors.New("yard rejected the purchase")
+		}
+		return &PurchaseShipResponse{
+			Ship:          policyTestShip(m.t, fmt.Sprintf("BOUGHT-%d", m.sends)),
+			PurchasePrice: policyShipPrice,
+			AgentCredits:  1_000_000,
+			ShipType:      cmd.ShipType,
+		}, nil
+	case *ScrapShipCommand:
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		if m.scrapFail[cmd.ShipSymbol] {
+			return nil, errors.New("ship is not at a shipyard")
+		}
+		m.scrapped = append(m.scrapped, cmd.ShipSymbol)
+		return &ScrapShipResponse{ShipSymbol: cmd.ShipSymbol, ScrapValue: policyShipPrice / 2}, nil
+	case *queries.GetShipyardListingsQuery:
+		return &queries.GetShipyardListingsResponse{Shipyard: shipyard.Shipyard{
+			Symbol:    cmd.WaypointSymbol,
+			ShipTypes: []string{"SHIP_LIGHT_HAULER"},
+		}}, nil
+	}
+	return nil, nil
+}
+
+func policyTestShip(t *testing.T, symbol string) *navigation.Ship {
+	t.Helper()
+	loc, _ := shared.NewWaypoint("X1-POL-Y1", 0, 0)
+	fuel, _ := shared.NewFuel(100, 100)
+	cargo, _ := shared.NewCargo(40, 0, nil)
+	ship, err := navigation.NewShip(symbol, shared.MustNewPlayerID(1), loc, fuel, 100, 40, cargo, 30, "FRAME_LIGHT_FREIGHTER", "HAULER", nil, navigation.NavStatusDocked)
+	if err != nil {
+		t.Fatalf("ship: %v", err)
+	}
+	return ship
+}
+
+func policyCommand(policy BatchFailurePolicy) *BatchPurchaseShipsCommand {
+	return &BatchPurchaseShipsCommand{
+		PurchasingShipSymbol: "BUYER-1",
+		ShipType:             "SHIP_LIGHT_HAULER",
+		Quantity:             3,
+		PlayerID:             shared.MustNewPlayerID(1),
+		ShipyardWaypoint:     "X1-POL-Y1",
+		FailurePolicy:        policy,
+	}
+}
+
+func TestBatchPurchase_StopPolicy_KeepsShipsBoughtBeforeFailure(t *testing.T) {
+	med := &policyFakeMediator{t: t, failOn: map[int]bool{2: true}}
+	handler := &BatchPurchaseShipsHandler{mediator: med}
+	cmd := policyCommand("")
+
+	loop, err := handler.runPurchaseLoop(context.Background(), cmd, cmd.Quantity, cmd.ShipyardWaypoint, policyShipPrice)
+	if err != nil {
+		t.Fatalf("partial batch must not error, got: %v", err)
+	}
+	if len(loop.ships) != 1 || med.sends != 2 {
+		t.Fatalf("expected the batch to stop at the failure with 1 ship, got %d ships after %d sends", len(loop.ships), med.sends)
+	}
+	if len(med.scrapped) != 0 {
+		t.Fatalf("stop policy must not scrap anything, scrapped %v", med.scrapped)
+	}
+}
+
+func TestBatchPurchase_BestEffortPolicy_SkipsFailedPurchase(t *testing.T) {
+	med := &policyFakeMediator{t: t, failOn: map[int]bool{2: true}}
+	handler := &BatchPurchaseShipsHandler{mediator: med}
+	cmd := policyCommand(BatchFailureBestEffort)
+
+	loop, err := handler.runPurchaseLoop(context.Background(), cmd, cmd.Quantity, cmd.ShipyardWaypoint, policyShipPrice)
+	if err != nil {
+		t.Fatalf("best-effort batch must not error, got: %v", err)
+	}
+	if len(loop.ships) != 2 || med.sends != 3 {
+		t.Fatalf("expected 2 ships over 3 attempts, got %d ships after %d sends", len(loop.ships), med.sends)
+	}
+	if len(loop.failures) != 1 || loop.spent != 2*policyShipPrice {
+		t.Fatalf("expected 1 recorded failure and %d spent, got %v and %d", 2*policyShipPrice, loop.failures, loop.spent)
+	}
+}
+
+func TestBatchPurchase_RollbackPolicy_ScrapsShipsBoughtBeforeFailure(t *testing.T) {
+	med := &policyFakeMediator{t: t, failOn: map[int]bool{3: true}}
+	handler := &BatchPurchaseShipsHandler{mediator: med}
+	cmd := policyCommand(BatchFailureRollback)
+
+	loop, err := handler.runPurchaseLoop(context.Background(), cmd, cmd.Quantity, cmd.ShipyardWaypoint, policyShipPrice)
+	if loop != nil {
+		t.Fatalf("a rolled-back batch must report no ships, got %d", len(loop.ships))
+	}
+	var rollback *BatchRolledBackError
+	if !errors.As(err, &rollback) {
+		t.Fatalf("expected a BatchRolledBackError, got: %v", err)
+	}
+	if len(rollback.Scrapped) != 2 || len(rollback.Stranded) != 0 {
+		t.Fatalf("expected both bought ships scrapped, got scrapped=%v stranded=%v", rollback.Scrapped, rollback.Stranded)
+	}
+	if rollback.ScrapRecovered != policyShipPrice {
+		t.Fatalf("expected %d recovered, got %d", policyShipPrice, rollback.ScrapRecovered)
+	}
+}
+
+func TestBatchPurchase_RollbackPolicy_ReportsShipsItCouldNotScrap(t *testing.T) {
+	med := &policyFakeMediator{t: t, failOn: map[int]bool{3: true}, scrapFail: map[string]bool{"BOUGHT-1": true}}
+	handler := &BatchPurchaseShipsHandler{mediator: med}
+	cmd := policyCommand(BatchFailureRollback)
+
+	_, err := handler.runPurchaseLoop(context.Background(), cmd, cmd.Quantity, cmd.ShipyardWaypoint, policyShipPrice)
+	var rollback *BatchRolledBackError
+	if !errors.As(err, &rollback) {
+		t.Fatalf("expected a BatchRolledBackError, got: %v", err)
+	}
+	if len(rollback.Stranded) != 1 || rollback.Stranded[0] != "BOUGHT-1" {
+		t.Fatalf("expected BOUGHT-1 stranded, got %v", rollback.Stranded)
+	}
+	if len(med.scrapped) != 1 || med.scrapped[0] != "BOUGHT-2" {
+		t.Fatalf("expected the rollback to carry on and scrap BOUGHT-2, got %v", med.scrapped)
+	}
+}
+
+// Cancelling the batch mid-way still rolls it back: the scraps must not ride
+// the cancelled context, or every bought hull would be left behind.
+func TestBatchPurchase_RollbackPolicy_ScrapsAfterCancel(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	med := &policyFakeMediator{t: t, cancelOn: 3, cancel: cancel}
+	handler := &BatchPurchaseShipsHandler{mediator: med}
+	cmd := policyCommand(BatchFailureRollback)
+
+	_, err := handler.runPurchaseLoop(ctx, cmd, cmd.Quantity, cmd.ShipyardWaypoint, policyShipPrice)
+	var rollback *BatchRolledBackError
+	if !errors.As(err, &rollback) {
+		t.Fatalf("expected a BatchRolledBackError, got: %v", err)
+	}
+	if !errors.Is(err, context.Canceled) {
+		t.Fatalf("expected the cancel as the rollback's cause, got: %v", err)
+	}
+	if len(rollback.Scrapped) != 2 || len(rollback.Stranded) != 0 {
+		t.Fatalf("expected both bought ships scrapped after the cancel, got scrapped=%v stranded=%v", rollback.Scrapped, rollback.Stranded)
+	}
+}
+
+// An atomic batch checks the yard's stock before buying anything: with no
+// pinned yard, or a pinned yard with no live priced listing, it is refused
+// without a single purchase.
+func TestBatchPurchase_RollbackPolicy_RefusesUncheckedStock(t *testing.T) {
+	ctx := auth.WithPlayerToken(context.Background(), "token")
+	for _, yard := range []string{"", "X1-POL-Y1"} {
+		med := &policyFakeMediator{t: t}
+		handler := &BatchPurchaseShipsHandler{mediator: med}
+		cmd := policyCommand(BatchFailureRollback)
+		cmd.ShipyardWaypoint = yard
+
+		if _, err := handler.Handle(ctx, cmd); err == nil {
+			t.Fatalf("yard %q: expected the atomic batch to be refused", yard)
+		}
+		if med.sends != 0 {
+			t.Fatalf("yard %q: %d purchases sent before the stock check", yard, med.sends)
+		}
+	}
+}
+
+func TestParseBatchFailurePolicy(t *testing.T) {
+	if policy, err := ParseBatchFailurePolicy(""); err != nil || policy != BatchFailureStop {
+		t.Fatalf("empty policy should mean stop, got %q, %v", policy, err)
+	}
+	if _, err := ParseBatchFailurePolicy("yolo"); err == nil {
+		t.Fatal("expected an unknown policy to be rejected")
+	}
+}
@@ -26,7 +26,7 @@ const (
 
 // typeGuardFakeMediator stands in for the per-ship PurchaseShipCommand dispatch.
 // It embeds common.Mediator so any request other than a PurchaseShipCommand
-// nil-panics, keeping the fake honest about what executePurchaseLoop dispatches.
+// nil-panics, keeping the fake honest about what runPurchaseLoop dispatches.
 // respShipType models the type the yard actually delivered (which may differ
 // from the requested type — the substitution the guard must catch).
 type typeGuardFakeMediator struct {
@@ -75,7 +75,7 @@ func TestBatchPurchase_SubstitutedType_AbortsWithZeroPurchases(t *testing.T) {
 	handler := &BatchPurchaseShipsHandler{mediator: med}
 	cmd := typeGuardCommand()
 
-	ships, totalSpent, err := handler.executePurchaseLoop(
+	loop, err := handler.runPurchaseLoop(
 		context.Background(), cmd, cmd.Quantity, typeGuardPinnedYard, typeGuardShipPrice,
 	)
 
@@ -83,11 +83,8 @@ func TestBatchPurchase_SubstitutedType_AbortsWithZeroPurchases(t *testing.T) {
 		t.Fatalf("expected a loud money-integrity error when the yard delivers %s instead of %s, got nil",
 			typeGuardSubstituted, typeGuardRequestedType)
 	}
-	if len(ships) != 0 {
-		t.Fatalf("expected ZERO ships accepted on a type substitution, got %d", len(ships))
-	}
-	if totalSpent != 0 {
-		t.Fatalf("expected ZERO spend reported on the substitution abort, got %d", totalSpent)
+	if loop != nil {
+		t.Fatalf("expected ZERO ships and ZERO spend on a type substitution, got %d ships for %d", len(loop.ships), loop.spent)
 	}
 	if med.sends != 1 {
 		t.Fatalf("expected the loop to abort after the FIRST substitution (1 dispatch), got %d — it kept buying wrong ships", med.sends)
@@ -105,17 +102,17 @@ func TestBatchPurchase_RequestedType_PurchasesFullQuantity(t *testing.T) {
 	handler := &BatchPurchaseShipsHandler{mediator: med}
 	cmd := typeGuardCommand()
 
-	ships, totalSpent, err := handler.executePurchaseLoop(
+	loop, err := handler.runPurchaseLoop(
 		context.Background(), cmd, cmd.Quantity, typeGuardPinnedYard, typeGuardShipPrice,
 	)
 
 	if err != nil {
 		t.Fatalf("matching type must not error, got: %v", err)
 	}
-	if len(ships) != cmd.Quantity {
-		t.Fatalf("expected %d ships purchased for a matching type, got %d", cmd.Quantity, len(ships))
+	if len(loop.ships) != cmd.Quantity {
+		t.Fatalf("expected %d ships purchased for a matching type, got %d", cmd.Quantity, len(loop.ships))
 	}
-	if totalSpent != typeGuardShipPrice*cmd.Quantity {
-		t.Fatalf("expected total spend %d, got %d", typeGuardShipPrice*cmd.Quantity, totalSpent)
+	if loop.spent != typeGuardShipPrice*cmd.Quantity {
+		t.Fatalf("expected total spend %d, got %d", typeGuardShipPrice*cmd.Quantity, loop.spent)
 	}
 }
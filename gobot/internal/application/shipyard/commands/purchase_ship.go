@@ -10,6 +10,7 @@ import (
 	shipNav "github.com/andrescamacho/spacetraders-go/internal/application/ship/commands/navigation"
 	shipTypes "github.com/andrescamacho/spacetraders-go/internal/application/ship/types"
 	"github.com/andrescamacho/spacetraders-go/internal/application/shipyard/queries"
+	"github.com/andrescamacho/spacetraders-go/internal/domain/ledger"
 	"github.com/andrescamacho/spacetraders-go/internal/domain/navigation"
 	"github.com/andrescamacho/spacetraders-go/internal/domain/player"
 	domainPorts "github.com/andrescamacho/spacetraders-go/internal/domain/ports"
@@ -30,6 +31,20 @@ type PurchaseShipCommand struct {
 	ShipType             string
 	PlayerID             shared.PlayerID
 	ShipyardWaypoint     string // Optional - will auto-discover if empty
+	MaxPrice             int    // 0 = no cap; refuse to buy when the live price is higher
+}
+
+// ShipPriceAboveMaxError reports that the live listing at the shipyard was
+// above the command's MaxPrice, so nothing was bought.
+type ShipPriceAboveMaxError struct {
+	ShipType         string
+	ShipyardWaypoint string
+	Price            int
+	MaxPrice         int
+}
+
+func (e *ShipPriceAboveMaxError) Error() string {
+	return fmt.Sprintf("%s at %s costs %d, above max price %d", e.ShipType, e.ShipyardWaypoint, e.Price, e.MaxPrice)
 }
 
 // PurchaseShipResponse contains the newly purchased ship
@@ -59,6 +74,7 @@ type PurchaseShipHandler struct {
 	waypointProvider system.IWaypointProvider
 	apiClient        domainPorts.APIClient
 	mediator         common.Mediator
+	reservations     ledger.CreditReservations
 }
 
 // NewPurchaseShipHandler creates a new PurchaseShipHandler
@@ -80,6 +96,12 @@ func NewPurchaseShipHandler(
 	}
 }
 
+// SetCreditReservations makes purchases validate against credits not reserved
+// by other coordinators. nil disables the check.
+func (h *PurchaseShipHandler) SetCreditReservations(reservations ledger.CreditReservations) {
+	h.reservations = reservations
+}
+
 // Handle executes the PurchaseShip command
 func (h *PurchaseShipHandler) Handle(ctx context.Context, request common.Request) (common.Response, error) {
 	cmd, ok := request.(*PurchaseShipCommand)
@@ -112,7 +134,16 @@ func (h *PurchaseShipHandler) Handle(ctx context.Context, request common.Request
 		return nil, err
 	}
 
-	balanceBefore, err := h.ensureSufficientCredits(ctx, token, purchasePrice)
+	if cmd.MaxPrice > 0 && purchasePrice > cmd.MaxPrice {
+		return nil, &ShipPriceAboveMaxError{
+			ShipType:         cmd.ShipType,
+			ShipyardWaypoint: shipyardWaypoint,
+			Price:            purchasePrice,
+			MaxPrice:         cmd.MaxPrice,
+		}
+	}
+
+	balanceBefore, err := h.ensureSufficientCredits(ctx, token, cmd.PlayerID, purchasePrice)
 	if err != nil {
 		return nil, err
 	}
@@ -304,11 +335,14 @@ func (h *PurchaseShipHandler) validateAndGetShipPrice(
 	return listing.PurchasePrice, systemSymbol, nil
 }
 
-// ensureSufficientCredits validates player has enough credits for purchase
+// ensureSufficientCredits validates player has enough credits for purchase,
+// setting aside credits other coordinators have reserved (the caller's own
+// reservation, carried on ctx, is spendable).
 // Returns: agent credits after validation, error
 func (h *PurchaseShipHandler) ensureSufficientCredits(
 	ctx context.Context,
 	token string,
+	playerID shared.PlayerID,
 	purchasePrice int,
 ) (int, error) {
 	agentData, err := h.apiClient.GetAgent(ctx, token)
@@ -320,6 +354,13 @@ func (h *PurchaseShipHandler) ensureSufficientCredits(
 		return 0, fmt.Errorf("insufficient credits: have %d, need %d", agentData.Credits, purchasePrice)
 	}
 
+	if h.reservations != nil {
+		reserved := h.reservations.ReservedExcept(playerID.Value(), ledger.CreditReservationFromContext(ctx))
+		if agentData.Credits-reserved < purchasePrice {
+			return 0, &ledger.ErrInsufficientUnreservedCredits{Need: purchasePrice, Credits: agentData.Credits, Reserved: reserved}
+		}
+	}
+
 	return agentData.Credits, nil
 }
 
@@ -404,6 +445,10 @@ func (h *PurchaseShipHandler) recordShipPurchaseTransaction(
 		OperationType:        "fleet expansion", // Ship purchases are fleet expansion operations
 	}
 
+	if opCtx := shared.OperationContextFromContext(ctx); opCtx != nil && opCtx.IsValid() {
+		recordCmd.ContainerID = opCtx.ContainerID
+	}
+
 	// Record transaction via mediator (use passed context, not Background)
 	_, err = h.mediator.Send(ctx, recordCmd)
 	if err != nil {
@@ -601,6 +646,7 @@ func (h *PurchaseShipHandler) convertShipDataToEntity(
 	)
 	ship.SetReactor(shipData.ReactorSymbol, shipData.ReactorName, shipData.ReactorPowerOutput, reactorRequirements)
 	ship.SetCrew(shipData.CrewCurrent, shipData.CrewRequired, shipData.CrewCapacity)
+	ship.SetCondition(navigation.ConditionFromShipData(shipData))
 
 	return ship, nil
 }
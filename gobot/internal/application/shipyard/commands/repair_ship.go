@@ -0,0 +1,197 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/andrescamacho/spacetraders-go/internal/adapters/metrics"
+	"github.com/andrescamacho/spacetraders-go/internal/application/common"
+	ledgerCommands "github.com/andrescamacho/spacetraders-go/internal/application/ledger/commands"
+	"github.com/andrescamacho/spacetraders-go/internal/application/logging"
+	shipTypes "github.com/andrescamacho/spacetraders-go/internal/application/ship/types"
+	"github.com/andrescamacho/spacetraders-go/internal/domain/navigation"
+	"github.com/andrescamacho/spacetraders-go/internal/domain/player"
+	domainPorts "github.com/andrescamacho/spacetraders-go/internal/domain/ports"
+	"github.com/andrescamacho/spacetraders-go/internal/domain/shared"
+)
+
+// RepairShipCommand repairs a ship at the shipyard it is parked at, restoring
+// its frame, reactor and engine condition to their integrity.
+//
+// Unlike scrapping, a repair is something coordinators do to the hulls they
+// already hold, so the handler takes no reservation and accepts a ship claimed
+// by a container. The ship must be at a shipyard; it is docked if in orbit.
+type RepairShipCommand struct {
+	ShipSymbol string
+	PlayerID   shared.PlayerID
+}
+
+// RepairShipResponse reports the cost of the repair and the restored condition
+type RepairShipResponse struct {
+	ShipSymbol      string
+	WaypointSymbol  string
+	Cost            int
+	AgentCredits    int
+	Condition       navigation.ShipCondition
+	TransactionTime string
+}
+
+// RepairShipHandler handles the RepairShip command
+type RepairShipHandler struct {
+	shipRepo   navigation.ShipRepository
+	playerRepo player.PlayerRepository
+	apiClient  domainPorts.APIClient
+	mediator   common.Mediator
+}
+
+// NewRepairShipHandler creates a new RepairShipHandler
+func NewRepairShipHandler(
+	shipRepo navigation.ShipRepository,
+	playerRepo player.PlayerRepository,
+	apiClient domainPorts.APIClient,
+	mediator common.Mediator,
+) *RepairShipHandler {
+	return &RepairShipHandler{
+		shipRepo:   shipRepo,
+		playerRepo: playerRepo,
+		apiClient:  apiClient,
+		mediator:   mediator,
+	}
+}
+
+// Handle executes the RepairShip command
+func (h *RepairShipHandler) Handle(ctx context.Context, request common.Request) (common.Response, error) {
+	cmd, ok := request.(*RepairShipCommand)
+	if !ok {
+		return nil, fmt.Errorf("invalid request type")
+	}
+
+	token, err := common.PlayerTokenFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	ship, err := h.shipRepo.FindBySymbol(ctx, cmd.ShipSymbol, cmd.PlayerID)
+	if err != nil {
+		return nil, fmt.Errorf("ship not found: %w", err)
+	}
+	if ship.NavStatus() == navigation.NavStatusInTransit {
+		return nil, shared.NewInvalidNavStatusError(fmt.Sprintf("ship %s is in transit; repair it once it arrives at a shipyard", ship.ShipSymbol()))
+	}
+
+	if ship.NavStatus() == navigation.NavStatusInOrbit {
+		dockCmd := &shipTypes.DockShipCommand{
+			Ship:     ship,
+			PlayerID: cmd.PlayerID,
+		}
+		if _, err := h.mediator.Send(ctx, dockCmd); err != nil {
+			return nil, fmt.Errorf("failed to dock ship: %w", err)
+		}
+	}
+
+	result, err := h.apiClient.RepairShip(ctx, cmd.ShipSymbol, token)
+	if err != nil {
+		return nil, fmt.Errorf("failed to repair ship: %w", err)
+	}
+
+	if err := h.updatePlayerCredits(ctx, cmd.PlayerID, result.Agent.Credits); err != nil {
+		return nil, fmt.Errorf("failed to update player credits: %w", err)
+	}
+
+	condition := ship.Condition()
+	if result.Ship != nil {
+		condition = navigation.ConditionFromShipData(result.Ship)
+		h.saveCondition(ctx, cmd, condition)
+	}
+	h.recordRepairTransaction(ctx, cmd, result)
+	metrics.RecordShipRepair(cmd.ShipSymbol, result.Transaction.TotalPrice)
+
+	return &RepairShipResponse{
+		ShipSymbol:      cmd.ShipSymbol,
+		WaypointSymbol:  result.Transaction.WaypointSymbol,
+		Cost:            result.Transaction.TotalPrice,
+		AgentCredits:    result.Agent.Credits,
+		Condition:       condition,
+		TransactionTime: result.Transaction.Timestamp,
+	}, nil
+}
+
+// updatePlayerCredits persists the post-repair credits reported by the API
+func (h *RepairShipHandler) updatePlayerCredits(ctx context.Context, playerID shared.PlayerID, credits int) error {
+	p, err := h.playerRepo.FindByID(ctx, playerID)
+	if err != nil {
+		return fmt.Errorf("failed to find player: %w", err)
+	}
+	p.Credits = credits
+	if err := h.playerRepo.Add(ctx, p); err != nil {
+		return fmt.Errorf("failed to persist player: %w", err)
+	}
+	return nil
+}
+
+// saveCondition stores the restored condition on the ship row. Best-effort:
+// the repair has happened, and the next ship sync corrects a missed write.
+func (h *RepairShipHandler) saveCondition(ctx context.Context, cmd *RepairShipCommand, condition navigation.ShipCondition) {
+	_, _, err := h.shipRepo.SaveWithRetry(ctx, cmd.ShipSymbol, cmd.PlayerID, func(s *navigation.Ship) (bool, error) {
+		if s.Condition() == condition {
+			return false, nil
+		}
+		s.SetCondition(condition)
+		return true, nil
+	})
+	if err != nil {
+		logging.LoggerFromContext(ctx).Log("WARNING", "Failed to persist repaired ship condition", map[string]interface{}{
+			"ship":  cmd.ShipSymbol,
+			"error": err.Error(),
+		})
+	}
+}
+
+// recordRepairTransaction books the repair cost in the ledger. A free repair
+// moves no credits and records nothing.
+func (h *RepairShipHandler) recordRepairTransaction(
+	ctx context.Context,
+	cmd *RepairShipCommand,
+	result *domainPorts.ShipRepairResult,
+) {
+	cost := result.Transaction.TotalPrice
+	if cost <= 0 {
+		return
+	}
+
+	balanceAfter := result.Agent.Credits
+	recordCmd := &ledgerCommands.RecordTransactionCommand{
+		PlayerID:             cmd.PlayerID.Value(),
+		TransactionType:      "REPAIR_SHIP",
+		Amount:               -cost, // Negative for expense
+		BalanceBefore:        balanceAfter + cost,
+		BalanceAfter:         balanceAfter,
+		AuthoritativeBalance: &balanceAfter,
+		Description:          fmt.Sprintf("Repaired %s at %s", cmd.ShipSymbol, result.Transaction.WaypointSymbol),
+		Metadata: map[string]interface{}{
+			"agent":       result.Agent.Symbol,
+			"ship_symbol": cmd.ShipSymbol,
+			"waypoint":    result.Transaction.WaypointSymbol,
+		},
+	}
+
+	// Repairs are booked against the operation that scheduled them
+	if opCtx := shared.OperationContextFromContext(ctx); opCtx != nil && opCtx.IsValid() {
+		recordCmd.RelatedEntityType = "container"
+		recordCmd.RelatedEntityID = opCtx.ContainerID
+		recordCmd.ContainerID = opCtx.ContainerID
+		recordCmd.OperationType = opCtx.NormalizedOperationType()
+	} else {
+		recordCmd.OperationType = "manual"
+	}
+
+	if _, err := h.mediator.Send(ctx, recordCmd); err != nil {
+		// Log error but don't fail the operation: the credits are already spent
+		logging.LoggerFromContext(ctx).Log("ERROR", "Failed to record ship repair transaction in ledger", map[string]interface{}{
+			"error":     err.Error(),
+			"ship":      cmd.ShipSymbol,
+			"cost":      cost,
+			"player_id": cmd.PlayerID.Value(),
+		})
+	}
+}
@@ -0,0 +1,83 @@
+package commands
+
+import (
+	"context"
+	"testing"
+
+	"github.com/andrescamacho/spacetraders-go/internal/domain/navigation"
+	"github.com/andrescamacho/spacetraders-go/internal/domain/player"
+	domainPorts "github.com/andrescamacho/spacetraders-go/internal/domain/ports"
+	"github.com/andrescamacho/spacetraders-go/internal/domain/shared"
+)
+
+type repairStubShipRepo struct {
+	scrapStubShipRepo
+	saved []navigation.ShipCondition
+}
+
+func (s *repairStubShipRepo) SaveWithRetry(_ context.Context, _ string, _ shared.PlayerID, mutate navigation.ShipMutation) (*navigation.Ship, bool, error) {
+	changed, err := mutate(s.ship)
+	if changed {
+		s.saved = append(s.saved, s.ship.Condition())
+	}
+	return s.ship, changed, err
+}
+
+type repairStubAPIClient struct {
+	domainPorts.APIClient
+	repaired []string
+}
+
+func (c *repairStubAPIClient) RepairShip(_ context.Context, shipSymbol, _ string) (*domainPorts.ShipRepairResult, error) {
+	c.repaired = append(c.repaired, shipSymbol)
+	return &domainPorts.ShipRepairResult{
+		Agent: &player.AgentData{Symbol: "TORWIND", Credits: 99000},
+		Ship: &navigation.ShipData{
+			Symbol:         shipSymbol,
+			FrameCondition: 0.95, FrameIntegrity: 0.95,
+			ReactorCondition: 1, ReactorIntegrity: 1,
+			EngineCondition: 0.98, EngineIntegrity: 0.98,
+		},
+		Transaction: &domainPorts.ShipRepairTransaction{WaypointSymbol: "X1-A1", ShipSymbol: shipSymbol, TotalPrice: 1000},
+	}, nil
+}
+
+// A repair of a container-held hull goes through without a reservation, stores
+// the restored condition and books the cost as a negative REPAIR_SHIP row.
+func TestRepairShip_RestoresConditionAndRecordsCost(t *testing.T) {
+	ship := scrapTestShip(t, 10)
+	ship.SetCondition(navigation.ShipCondition{
+		Frame:   navigation.ComponentCondition{Condition: 0.3, Integrity: 0.95},
+		Reactor: navigation.ComponentCondition{Condition: 0.8, Integrity: 1},
+		Engine:  navigation.ComponentCondition{Condition: 0.6, Integrity: 0.98},
+	})
+	shipRepo := &repairStubShipRepo{scrapStubShipRepo: scrapStubShipRepo{ship: ship}}
+	api := &repairStubAPIClient{}
+	med := &scrapRecordingMediator{}
+	players := &scrapStubPlayerRepo{p: player.NewPlayer(shared.MustNewPlayerID(1), "TORWIND", "token")}
+	handler := NewRepairShipHandler(shipRepo, players, api, med)
+
+	resp, err := handler.Handle(scrapContext(), &RepairShipCommand{ShipSymbol: "TORWIND-9", PlayerID: shared.MustNewPlayerID(1)})
+	if err != nil {
+		t.Fatalf("repair failed: %v", err)
+	}
+
+	result := resp.(*RepairShipResponse)
+	if result.Cost != 1000 || result.Condition.Lowest() != 0.95 {
+		t.Fatalf("unexpected response %+v", result)
+	}
+	if shipRepo.reserved != 0 || len(api.repaired) != 1 || len(shipRepo.saved) != 1 {
+		t.Fatalf("expected one repair and one save without a reservation, got reserved=%d repaired=%v saved=%d",
+			shipRepo.reserved, api.repaired, len(shipRepo.saved))
+	}
+	if players.p.Credits != 99000 {
+		t.Fatalf("expected player credits 99000, got %d", players.p.Credits)
+	}
+	if len(med.recorded) != 1 {
+		t.Fatalf("expected one ledger row, got %d", len(med.recorded))
+	}
+	row := med.recorded[0]
+	if row.TransactionType != "REPAIR_SHIP" || row.Amount != -1000 || row.BalanceBefore != 100000 || row.BalanceAfter != 99000 {
+		t.Fatalf("unexpected ledger row: %+v", row)
+	}
+}
@@ -0,0 +1,380 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/andrescamacho/spacetraders-go/internal/application/common"
+	"github.com/andrescamacho/spacetraders-go/internal/application/shipyard/queries"
+	"github.com/andrescamacho/spacetraders-go/internal/domain/navigation"
+	"github.com/andrescamacho/spacetraders-go/internal/domain/shared"
+	"github.com/andrescamacho/spacetraders-go/internal/domain/shipyard"
+)
+
+const (
+	// shipyardWatchDefaultTickSeconds paces the watch loop when the launch config
+	// leaves it unset. Each check costs one API call per watched yard.
+	shipyardWatchDefaultTickSeconds = 300
+
+	// shipyardWatchMaxTreasuryPercent is the RULINGS #6 per-hull ceiling: a watched
+	// type is bought only for at most 25% of the live treasury.
+	shipyardWatchMaxTreasuryPercent = 25
+
+	// shipyardWatchReleaseReason is recorded when the watch hands its buyer back.
+	shipyardWatchReleaseReason = "shipyard_watch_purchase_done"
+)
+
+// ShipyardWatchTarget is one ship type watched at one shipyard. With
+// AutoPurchase set, PurchasingShip is sent to buy one as soon as the type is
+// in stock at or under MaxPrice and the autosizer has measured an unmet demand
+// for DemandClass.
+type ShipyardWatchTarget struct {
+	WaypointSymbol string
+	ShipType       string
+	MaxPrice       int
+	AutoPurchase   bool
+	PurchasingShip string
+	DemandClass    string
+}
+
+// ShipyardWatchResult is what one watcher check saw change.
+type ShipyardWatchResult struct {
+	Opened    []string // "SHIP_TYPE@WAYPOINT" back in stock
+	Closed    []string // "SHIP_TYPE@WAYPOINT" gone out of stock
+	Purchased []string // symbols of ships bought
+}
+
+// ShipyardWatchTreasury live-reads the player's treasury for the 25% guard. A nil
+// reader or a read error refuses the purchase.
+type ShipyardWatchTreasury interface {
+	LiveCredits(ctx context.Context, playerID shared.PlayerID) (int, error)
+}
+
+// ShipyardWatchDemand reads the measured demand for a hull class: how many more
+// hulls of the class are wanted than the fleet holds. readable=false means no
+// current measurement exists, and the purchase is refused.
+type ShipyardWatchDemand interface {
+	Shortfall(ctx context.Context, playerID shared.PlayerID, class string) (shortfall int, readable bool, err error)
+}
+
+// RunShipyardWatchCoordinatorCommand launches the standing shipyard watch for a
+// player. Like the other standing coordinators it loops inside one Handle() call.
+type RunShipyardWatchCoordinatorCommand struct {
+	PlayerID         shared.PlayerID
+	ContainerID      string
+	TickIntervalSecs int
+}
+
+// RunShipyardWatchCoordinatorResponse reports the loop's progress (observed only
+// on shutdown, since the loop is infinite).
+type RunShipyardWatchCoordinatorResponse struct {
+	Ticks     int
+	Purchased []string
+	Errors    []string
+}
+
+// RunShipyardWatchCoordinatorHandler watches configured shipyards for sought-after
+// ship types. Each check reads every watched yard once, opens an availability
+// window when a type comes into stock, updates it while the listing stays up, and
+// closes it when the listing disappears. A yard read with no priced listings at
+// all says nothing about stock (prices are only visible with a ship present), so
+// it leaves the windows as they were.
+//
+// An automatic purchase claims the purchasing ship for the watch container first
+// and releases it once the purchase is done (RULINGS #3/#7), and is refused unless
+// the autosizer has measured demand for the target's class and the price fits
+// within 25% of the live treasury (RULINGS #6).
+type RunShipyardWatchCoordinatorHandler struct {
+	mediator common.Mediator
+	windows  shipyard.AvailabilityWindowRepository
+	shipRepo navigation.ShipRepository
+	targets  []ShipyardWatchTarget
+	clock    shared.Clock
+
+	treasury ShipyardWatchTreasury
+	demand   ShipyardWatchDemand
+}
+
+// NewRunShipyardWatchCoordinatorHandler creates a watcher over targets. An
+// auto-purchase target must name its purchasing ship, a max price and the demand
+// class it fills: the watcher never buys blind. A nil clock defaults to the real
+// clock.
+func NewRunShipyardWatchCoordinatorHandler(
+	mediator common.Mediator,
+	windows shipyard.AvailabilityWindowRepository,
+	shipRepo navigation.ShipRepository,
+	targets []ShipyardWatchTarget,
+	clock shared.Clock,
+) (*RunShipyardWatchCoordinatorHandler, error) {
+	for _, t := range targets {
+		if t.WaypointSymbol == "" || t.ShipType == "" {
+			return nil, fmt.Errorf("shipyard watch target needs a waypoint and a ship type")
+		}
+		if t.AutoPurchase && (t.PurchasingShip == "" || t.MaxPrice <= 0 || t.DemandClass == "") {
+			return nil, fmt.Errorf("auto-purchase of %s at %s needs a purchasing ship, a max price and a demand class", t.ShipType, t.WaypointSymbol)
+		}
+	}
+	if clock == nil {
+		clock = shared.NewRealClock()
+	}
+	return &RunShipyardWatchCoordinatorHandler{
+		mediator: mediator,
+		windows:  windows,
+		shipRepo: shipRepo,
+		targets:  targets,
+		clock:    clock,
+	}, nil
+}
+
+// SetTreasuryReader wires the live-treasury read the 25% guard needs. Unset, every
+// automatic purchase is refused.
+func (h *RunShipyardWatchCoordinatorHandler) SetTreasuryReader(r ShipyardWatchTreasury) {
+	h.treasury = r
+}
+
+// SetDemandReader wires the measured-demand read. Unset, every automatic purchase
+// is refused.
+func (h *RunShipyardWatchCoordinatorHandler) SetDemandReader(r ShipyardWatchDemand) {
+	h.demand = r
+}
+
+// Handle runs the watch loop until the context is cancelled.
+func (h *RunShipyardWatchCoordinatorHandler) Handle(ctx context.Context, request common.Request) (common.Response, error) {
+	logger := common.LoggerFromContext(ctx)
+
+	cmd, ok := request.(*RunShipyardWatchCoordinatorCommand)
+	if !ok {
+		return nil, fmt.Errorf("invalid request type")
+	}
+
+	tick := time.Duration(cmd.TickIntervalSecs) * time.Second
+	if tick <= 0 {
+		tick = shipyardWatchDefaultTickSeconds * time.Second
+	}
+
+	result := &RunShipyardWatchCoordinatorResponse{Errors: []string{}}
+	logger.Log("INFO", fmt.Sprintf("Shipyard watch starting (tick %s, %d targets)", tick, len(h.targets)), map[string]interface{}{
+		"action":       "shipyard_watch_start",
+		"container_id": cmd.ContainerID,
+	})
+
+	for {
+		select {
+		case <-ctx.Done():
+			return result, ctx.Err()
+		default:
+		}
+
+		checked, err := h.Check(ctx, cmd.PlayerID, cmd.ContainerID)
+		if err != nil {
+			result.Errors = append(result.Errors, err.Error())
+			logger.Log("ERROR", fmt.Sprintf("Shipyard watch check failed: %v", err), map[string]interface{}{
+				"action":       "shipyard_watch",
+				"container_id": cmd.ContainerID,
+			})
+		}
+		result.Purchased = append(result.Purchased, checked.Purchased...)
+		result.Ticks++
+
+		select {
+		case <-shared.After(h.clock, tick):
+		case <-ctx.Done():
+			return result, ctx.Err()
+		}
+	}
+}
+
+// Check reads each watched shipyard once and records availability changes. A
+// yard that cannot be read is logged and skipped; a window that cannot be
+// saved fails the check. Automatic purchases are made under containerID and are
+// best-effort: a refused or failed one is logged and retried on the next check
+// while the window stays open.
+func (h *RunShipyardWatchCoordinatorHandler) Check(ctx context.Context, playerID shared.PlayerID, containerID string) (ShipyardWatchResult, error) {
+	logger := common.LoggerFromContext(ctx)
+	var result ShipyardWatchResult
+
+	byYard := make(map[string][]ShipyardWatchTarget)
+	var yards []string
+	for _, t := range h.targets {
+		if _, seen := byYard[t.WaypointSymbol]; !seen {
+			yards = append(yards, t.WaypointSymbol)
+		}
+		byYard[t.WaypointSymbol] = append(byYard[t.WaypointSymbol], t)
+	}
+
+	for _, yard := range yards {
+		resp, err := h.mediator.Send(ctx, &queries.GetShipyardListingsQuery{
+			SystemSymbol:   shared.ExtractSystemSymbol(yard),
+			WaypointSymbol: yard,
+			PlayerID:       playerID,
+		})
+		if err != nil {
+			logger.Log("WARNING", fmt.Sprintf("Shipyard watcher could not read %s: %v", yard, err), map[string]interface{}{
+				"action":   "shipyard_watch",
+				"waypoint": yard,
+				"error":    err.Error(),
+			})
+			continue
+		}
+		listings, ok := resp.(*queries.GetShipyardListingsResponse)
+		if !ok || len(listings.Shipyard.Listings) == 0 {
+			continue
+		}
+		for _, target := range byYard[yard] {
+			if err := h.checkTarget(ctx, playerID, containerID, target, &listings.Shipyard, &result); err != nil {
+				return result, err
+			}
+		}
+	}
+	return result, nil
+}
+
+// checkTarget advances target's availability window against one yard read.
+func (h *RunShipyardWatchCoordinatorHandler) checkTarget(
+	ctx context.Context,
+	playerID shared.PlayerID,
+	containerID string,
+	target ShipyardWatchTarget,
+	yard *shipyard.Shipyard,
+	result *ShipyardWatchResult,
+) error {
+	logger := common.LoggerFromContext(ctx)
+	now := h.clock.Now()
+	key := target.ShipType + "@" + target.WaypointSymbol
+
+	window, err := h.windows.FindOpen(ctx, playerID.Value(), target.WaypointSymbol, target.ShipType)
+	if err != nil {
+		return err
+	}
+
+	listing, listed := yard.FindListingByType(target.ShipType)
+	if !listed || listing.PurchasePrice <= 0 {
+		if window == nil {
+			return nil
+		}
+		window.Close(now)
+		if err := h.windows.Save(ctx, playerID.Value(), window); err != nil {
+			return err
+		}
+		result.Closed = append(result.Closed, key)
+		logger.Log("INFO", fmt.Sprintf("%s out of stock at %s after %s", target.ShipType, target.WaypointSymbol, window.Duration().Round(time.Second)), map[string]interface{}{
+			"action":    "shipyard_watch",
+			"waypoint":  target.WaypointSymbol,
+			"ship_type": target.ShipType,
+			"min_price": window.MinPrice,
+		})
+		return nil
+	}
+
+	if window == nil {
+		window = shipyard.OpenAvailabilityWindow(target.WaypointSymbol, target.ShipType, listing.PurchasePrice, now)
+		result.Opened = append(result.Opened, key)
+		logger.Log("INFO", fmt.Sprintf("%s in stock at %s for %d credits", target.ShipType, target.WaypointSymbol, listing.PurchasePrice), map[string]interface{}{
+			"action":    "shipyard_watch",
+			"waypoint":  target.WaypointSymbol,
+			"ship_type": target.ShipType,
+			"price":     listing.PurchasePrice,
+			"max_price": target.MaxPrice,
+		})
+	} else {
+		window.Observe(listing.PurchasePrice, now)
+	}
+
+	if target.AutoPurchase && window.PurchasedAt == nil && listing.PurchasePrice <= target.MaxPrice {
+		if symbol, ok := h.purchase(ctx, playerID, containerID, target, listing.PurchasePrice); ok {
+			window.MarkPurchased(now)
+			result.Purchased = append(result.Purchased, symbol)
+		}
+	}
+	return h.windows.Save(ctx, playerID.Value(), window)
+}
+
+// purchase buys one ship of the target's type once the demand and treasury guards
+// pass, with the purchasing ship claimed for containerID for the duration. The
+// price cap sent with the purchase is the lower of the target's max price and 25%
+// of the treasury just read. It reports the new ship's symbol and whether it bought.
+func (h *RunShipyardWatchCoordinatorHandler) purchase(
+	ctx context.Context,
+	playerID shared.PlayerID,
+	containerID string,
+	target ShipyardWatchTarget,
+	price int,
+) (string, bool) {
+	logger := common.LoggerFromContext(ctx)
+	fields := map[string]interface{}{
+		"action":          "shipyard_watch_purchase",
+		"waypoint":        target.WaypointSymbol,
+		"ship_type":       target.ShipType,
+		"purchasing_ship": target.PurchasingShip,
+		"demand_class":    target.DemandClass,
+		"price":           price,
+	}
+	refuse := func(reason string) (string, bool) {
+		logger.Log("WARNING", fmt.Sprintf("Shipyard watcher not buying %s at %s: %s", target.ShipType, target.WaypointSymbol, reason), fields)
+		return "", false
+	}
+
+	if h.demand == nil {
+		return refuse("no demand reader wired")
+	}
+	shortfall, readable, err := h.demand.Shortfall(ctx, playerID, target.DemandClass)
+	if err != nil {
+		return refuse(fmt.Sprintf("demand for %s unreadable: %v", target.DemandClass, err))
+	}
+	if !readable {
+		return refuse(fmt.Sprintf("no measured demand for %s", target.DemandClass))
+	}
+	if shortfall <= 0 {
+		return refuse(fmt.Sprintf("measured demand for %s is already met", target.DemandClass))
+	}
+
+	if h.treasury == nil {
+		return refuse("no treasury reader wired")
+	}
+	credits, err := h.treasury.LiveCredits(ctx, playerID)
+	if err != nil {
+		return refuse(fmt.Sprintf("treasury unreadable: %v", err))
+	}
+	if price*100 > credits*shipyardWatchMaxTreasuryPercent {
+		return refuse(fmt.Sprintf("price %d is over %d%% of the %d credit treasury", price, shipyardWatchMaxTreasuryPercent, credits))
+	}
+	maxPrice := target.MaxPrice
+	if treasuryCap := credits * shipyardWatchMaxTreasuryPercent / 100; treasuryCap < maxPrice {
+		maxPrice = treasuryCap
+	}
+
+	if err := h.shipRepo.ClaimShip(ctx, target.PurchasingShip, containerID, playerID, navigation.PurchasingFleet); err != nil {
+		return refuse(fmt.Sprintf("could not claim %s: %v", target.PurchasingShip, err))
+	}
+	defer func() {
+		if _, err := h.shipRepo.ReleaseContainerClaim(context.WithoutCancel(ctx), target.PurchasingShip, playerID, shipyardWatchReleaseReason); err != nil {
+			logger.Log("WARNING", fmt.Sprintf("Shipyard watcher could not release %s: %v", target.PurchasingShip, err), fields)
+		}
+	}()
+
+	resp, err := h.mediator.Send(ctx, &PurchaseShipCommand{
+		PurchasingShipSymbol: target.PurchasingShip,
+		ShipType:             target.ShipType,
+		PlayerID:             playerID,
+		ShipyardWaypoint:     target.WaypointSymbol,
+		MaxPrice:             maxPrice,
+	})
+	if err != nil {
+		fields["error"] = err.Error()
+		logger.Log("WARNING", fmt.Sprintf("Shipyard watcher could not buy %s at %s: %v", target.ShipType, target.WaypointSymbol, err), fields)
+		return "", false
+	}
+	bought, ok := resp.(*PurchaseShipResponse)
+	if !ok || bought.Ship == nil {
+		return "", false
+	}
+	logger.Log("INFO", fmt.Sprintf("Shipyard watcher bought %s %s at %s for %d credits", target.ShipType, bought.Ship.ShipSymbol(), target.WaypointSymbol, bought.PurchasePrice), map[string]interface{}{
+		"action":      "shipyard_watch_purchase",
+		"waypoint":    target.WaypointSymbol,
+		"ship_type":   target.ShipType,
+		"ship_symbol": bought.Ship.ShipSymbol(),
+		"price":       bought.PurchasePrice,
+	})
+	return bought.Ship.ShipSymbol(), true
+}
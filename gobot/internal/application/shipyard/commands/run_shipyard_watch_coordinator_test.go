@@ -0,0 +1,266 @@
+package commands
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/andrescamacho/spacetraders-go/internal/application/common"
+	"github.com/andrescamacho/spacetraders-go/internal/application/shipyard/queries"
+	"github.com/andrescamacho/spacetraders-go/internal/domain/navigation"
+	"github.com/andrescamacho/spacetraders-go/internal/domain/shared"
+	"github.com/andrescamacho/spacetraders-go/internal/domain/shipyard"
+)
+
+// memWindows keeps availability windows in memory.
+type memWindows struct {
+	rows []*shipyard.AvailabilityWindow
+}
+
+func (m *memWindows) FindOpen(_ context.Context, _ int, waypoint, shipType string) (*shipyard.AvailabilityWindow, error) {
+	for _, w := range m.rows {
+		if w.WaypointSymbol == waypoint && w.ShipType == shipType && w.IsOpen() {
+			copied := *w
+			return &copied, nil
+		}
+	}
+	return nil, nil
+}
+
+func (m *memWindows) Save(_ context.Context, _ int, window *shipyard.AvailabilityWindow) error {
+	if window.ID == 0 {
+		window.ID = int64(len(m.rows) + 1)
+		copied := *window
+		m.rows = append(m.rows, &copied)
+		return nil
+	}
+	copied := *window
+	m.rows[window.ID-1] = &copied
+	return nil
+}
+
+func (m *memWindows) FindSince(context.Context, int, string, time.Time) ([]shipyard.AvailabilityWindow, error) {
+	return nil, nil
+}
+
+// yardMediator serves a scripted shipyard read and records purchases.
+type yardMediator struct {
+	common.Mediator
+	listings  []shipyard.ShipListing
+	purchases []*PurchaseShipCommand
+}
+
+func (m *yardMediator) Send(_ context.Context, request common.Request) (common.Response, error) {
+	switch cmd := request.(type) {
+	case *queries.GetShipyardListingsQuery:
+		return &queries.GetShipyardListingsResponse{
+			Shipyard: shipyard.NewShipyard(cmd.WaypointSymbol, nil, m.listings, 0),
+		}, nil
+	case *PurchaseShipCommand:
+		m.purchases = append(m.purchases, cmd)
+		loc, _ := shared.NewWaypoint(cmd.ShipyardWaypoint, 0, 0)
+		fuel, _ := shared.NewFuel(0, 0)
+		cargo, _ := shared.NewCargo(15, 0, nil)
+		ship, _ := navigation.NewShip("TORWIND-7", cmd.PlayerID, loc, fuel, 0, 15, cargo, 30, "FRAME_DRONE", "EXCAVATOR", nil, navigation.NavStatusDocked)
+		return &PurchaseShipResponse{Ship: ship, PurchasePrice: 44_000}, nil
+	}
+	return nil, nil
+}
+
+// watchShipRepo records claims and releases of the purchasing ship.
+type watchShipRepo struct {
+	navigation.ShipRepository
+	claimErr error
+	claims   []string
+	released []string
+}
+
+func (r *watchShipRepo) ClaimShip(_ context.Context, symbol, containerID string, _ shared.PlayerID, operation string) error {
+	if r.claimErr != nil {
+		return r.claimErr
+	}
+	r.claims = append(r.claims, symbol+"/"+containerID+"/"+operation)
+	return nil
+}
+
+func (r *watchShipRepo) ReleaseContainerClaim(_ context.Context, symbol string, _ shared.PlayerID, _ string) (bool, error) {
+	r.released = append(r.released, symbol)
+	return true, nil
+}
+
+type fixedTreasury struct {
+	credits int
+	err     error
+}
+
+func (f fixedTreasury) LiveCredits(context.Context, shared.PlayerID) (int, error) {
+	return f.credits, f.err
+}
+
+type fixedDemand struct {
+	shortfall int
+	readable  bool
+	err       error
+}
+
+func (f fixedDemand) Shortfall(context.Context, shared.PlayerID, string) (int, bool, error) {
+	return f.shortfall, f.readable, f.err
+}
+
+func droneListings(price int) []shipyard.ShipListing {
+	return []shipyard.ShipListing{
+		shipyard.NewShipListing("SHIP_PROBE", "Probe", "", 20_000),
+		shipyard.NewShipListing("SHIP_MINING_DRONE", "Drone", "", price),
+	}
+}
+
+func newDroneWatch(t *testing.T, med *yardMediator, windows *memWindows, ships *watchShipRepo) *RunShipyardWatchCoordinatorHandler {
+	t.Helper()
+	h, err := NewRunShipyardWatchCoordinatorHandler(med, windows, ships, []ShipyardWatchTarget{{
+		WaypointSymbol: "X1-A1-YARD",
+		ShipType:       "SHIP_MINING_DRONE",
+		MaxPrice:       45_000,
+		AutoPurchase:   true,
+		PurchasingShip: "TORWIND-1",
+		DemandClass:    "light",
+	}}, nil)
+	if err != nil {
+		t.Fatalf("watcher: %v", err)
+	}
+	return h
+}
+
+// A restock opens a window; the drone is bought once the price drops under
+// the cap, never twice in the same window; the window closes when the yard
+// stops listing the type.
+func TestShipyardWatch_WindowsAndSinglePurchasePerRestock(t *testing.T) {
+	const yard = "X1-A1-YARD"
+	med := &yardMediator{}
+	windows := &memWindows{}
+	ships := &watchShipRepo{}
+	watcher := newDroneWatch(t, med, windows, ships)
+	watcher.SetTreasuryReader(fixedTreasury{credits: 1_000_000})
+	watcher.SetDemandReader(fixedDemand{shortfall: 2, readable: true})
+	ctx := context.Background()
+	player := shared.MustNewPlayerID(1)
+
+	med.listings = droneListings(50_000)
+	result, err := watcher.Check(ctx, player, "watch-1")
+	if err != nil || len(result.Opened) != 1 || len(med.purchases) != 0 {
+		t.Fatalf("a restock above the cap must open a window without buying: %+v err=%v buys=%d", result, err, len(med.purchases))
+	}
+
+	med.listings = droneListings(44_000)
+	result, err = watcher.Check(ctx, player, "watch-1")
+	if err != nil || len(result.Purchased) != 1 || len(med.purchases) != 1 {
+		t.Fatalf("expected one purchase once under the cap: %+v err=%v", result, err)
+	}
+	if med.purchases[0].MaxPrice != 45_000 || med.purchases[0].ShipyardWaypoint != yard {
+		t.Fatalf("the purchase must be capped and aimed at the watched yard: %+v", med.purchases[0])
+	}
+	if len(ships.claims) != 1 || ships.claims[0] != "TORWIND-1/watch-1/"+navigation.PurchasingFleet {
+		t.Fatalf("the purchasing ship must be claimed for the watch container: %v", ships.claims)
+	}
+	if len(ships.released) != 1 || ships.released[0] != "TORWIND-1" {
+		t.Fatalf("the purchasing ship must be released after the purchase: %v", ships.released)
+	}
+
+	result, err = watcher.Check(ctx, player, "watch-1")
+	if err != nil || len(med.purchases) != 1 {
+		t.Fatalf("the same window must not buy twice: %+v err=%v buys=%d", result, err, len(med.purchases))
+	}
+
+	med.listings = droneListings(0)[:1]
+	result, err = watcher.Check(ctx, player, "watch-1")
+	if err != nil || len(result.Closed) != 1 {
+		t.Fatalf("a delisted type must close its window: %+v err=%v", result, err)
+	}
+	if len(windows.rows) != 1 || windows.rows[0].MinPrice != 44_000 || windows.rows[0].PurchasedAt == nil {
+		t.Fatalf("the closed window must keep its low and purchase: %+v", windows.rows)
+	}
+
+	med.listings = nil
+	if result, err = watcher.Check(ctx, player, "watch-1"); err != nil || len(result.Opened)+len(result.Closed) != 0 {
+		t.Fatalf("an unpriced read must leave the windows alone: %+v err=%v", result, err)
+	}
+}
+
+// Every guard fails closed: no purchase without measured unmet demand, without
+// a readable treasury holding four times the price, or without the buyer's claim.
+func TestShipyardWatch_GuardsFailClosed(t *testing.T) {
+	cases := []struct {
+		name     string
+		treasury ShipyardWatchTreasury
+		demand   ShipyardWatchDemand
+		claimErr error
+	}{
+		{name: "no demand reader", treasury: fixedTreasury{credits: 1_000_000}},
+		{name: "demand unreadable", treasury: fixedTreasury{credits: 1_000_000}, demand: fixedDemand{}},
+		{name: "demand read error", treasury: fixedTreasury{credits: 1_000_000}, demand: fixedDemand{err: errors.New("boom")}},
+		{name: "demand met", treasury: fixedTreasury{credits: 1_000_000}, demand: fixedDemand{readable: true}},
+		{name: "no treasury reader", demand: fixedDemand{shortfall: 1, readable: true}},
+		{name: "treasury unreadable", treasury: fixedTreasury{err: errors.New("api down")}, demand: fixedDemand{shortfall: 1, readable: true}},
+		{name: "over 25% of treasury", treasury: fixedTreasury{credits: 175_000}, demand: fixedDemand{shortfall: 1, readable: true}},
+		{name: "buyer busy", treasury: fixedTreasury{credits: 1_000_000}, demand: fixedDemand{shortfall: 1, readable: true}, claimErr: errors.New("already assigned")},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			med := &yardMediator{listings: droneListings(44_000)}
+			windows := &memWindows{}
+			watcher := newDroneWatch(t, med, windows, &watchShipRepo{claimErr: tc.claimErr})
+			if tc.treasury != nil {
+				watcher.SetTreasuryReader(tc.treasury)
+			}
+			if tc.demand != nil {
+				watcher.SetDemandReader(tc.demand)
+			}
+			result, err := watcher.Check(context.Background(), shared.MustNewPlayerID(1), "watch-1")
+			if err != nil || len(med.purchases) != 0 || len(result.Purchased) != 0 {
+				t.Fatalf("expected no purchase: %+v err=%v buys=%d", result, err, len(med.purchases))
+			}
+			if len(windows.rows) != 1 || windows.rows[0].PurchasedAt != nil {
+				t.Fatalf("the window must stay open for a retry: %+v", windows.rows)
+			}
+		})
+	}
+}
+
+// The price cap sent with the purchase never exceeds 25% of the live treasury.
+func TestShipyardWatch_CapsPurchaseAtTreasuryShare(t *testing.T) {
+	med := &yardMediator{listings: droneListings(44_000)}
+	watcher := newDroneWatch(t, med, &memWindows{}, &watchShipRepo{})
+	watcher.SetTreasuryReader(fixedTreasury{credits: 180_000})
+	watcher.SetDemandReader(fixedDemand{shortfall: 1, readable: true})
+
+	if _, err := watcher.Check(context.Background(), shared.MustNewPlayerID(1), "watch-1"); err != nil {
+		t.Fatalf("check: %v", err)
+	}
+	if len(med.purchases) != 1 || med.purchases[0].MaxPrice != 45_000 {
+		t.Fatalf("expected one purchase capped at 45000: %+v", med.purchases)
+	}
+
+	med = &yardMediator{listings: droneListings(44_000)}
+	watcher = newDroneWatch(t, med, &memWindows{}, &watchShipRepo{})
+	watcher.SetTreasuryReader(fixedTreasury{credits: 177_000})
+	watcher.SetDemandReader(fixedDemand{shortfall: 1, readable: true})
+	if _, err := watcher.Check(context.Background(), shared.MustNewPlayerID(1), "watch-1"); err != nil {
+		t.Fatalf("check: %v", err)
+	}
+	if len(med.purchases) != 1 || med.purchases[0].MaxPrice != 44_250 {
+		t.Fatalf("expected the cap lowered to 25%% of treasury: %+v", med.purchases)
+	}
+}
+
+// Auto-purchase without a purchasing ship, a price cap or a demand class is
+// refused up front.
+func TestNewShipyardWatch_RejectsBlindAutoPurchase(t *testing.T) {
+	for _, target := range []ShipyardWatchTarget{
+		{WaypointSymbol: "X1-A1-YARD", ShipType: "SHIP_MINING_DRONE", AutoPurchase: true, PurchasingShip: "TORWIND-1", DemandClass: "light"},
+		{WaypointSymbol: "X1-A1-YARD", ShipType: "SHIP_MINING_DRONE", AutoPurchase: true, PurchasingShip: "TORWIND-1", MaxPrice: 45_000},
+	} {
+		if _, err := NewRunShipyardWatchCoordinatorHandler(&yardMediator{}, &memWindows{}, &watchShipRepo{}, []ShipyardWatchTarget{target}, nil); err == nil {
+			t.Fatalf("blind auto-purchase must be rejected: %+v", target)
+		}
+	}
+}
@@ -0,0 +1,232 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/andrescamacho/spacetraders-go/internal/application/common"
+	ledgerCommands "github.com/andrescamacho/spacetraders-go/internal/application/ledger/commands"
+	"github.com/andrescamacho/spacetraders-go/internal/application/logging"
+	shipTypes "github.com/andrescamacho/spacetraders-go/internal/application/ship/types"
+	"github.com/andrescamacho/spacetraders-go/internal/domain/navigation"
+	"github.com/andrescamacho/spacetraders-go/internal/domain/player"
+	domainPorts "github.com/andrescamacho/spacetraders-go/internal/domain/ports"
+	"github.com/andrescamacho/spacetraders-go/internal/domain/shared"
+)
+
+// scrapReservationReason tags the captain reservation held while a scrap is in flight.
+const scrapReservationReason = "scrapping"
+
+// ScrapShipCommand scraps a ship for credits at the shipyard it is parked at.
+//
+// Scrapping is irreversible, so the handler refuses rather than repairs:
+// 1. The ship must carry no cargo (sell or jettison it first)
+// 2. The ship must not be claimed by a container or reserved by the captain
+// 3. The ship must already be at a shipyard; it is docked if in orbit
+//
+// The ship is reserved for the captain for the duration of the call, so no
+// coordinator can claim it between the checks and the scrap.
+type ScrapShipCommand struct {
+	ShipSymbol string
+	PlayerID   shared.PlayerID
+}
+
+// ScrapShipResponse reports the credits recovered by the scrap
+type ScrapShipResponse struct {
+	ShipSymbol      string
+	WaypointSymbol  string
+	ScrapValue      int
+	AgentCredits    int
+	TransactionTime string
+}
+
+// ScrapShipHandler handles the ScrapShip command
+type ScrapShipHandler struct {
+	shipRepo   navigation.ShipRepository
+	playerRepo player.PlayerRepository
+	apiClient  domainPorts.APIClient
+	mediator   common.Mediator
+}
+
+// NewScrapShipHandler creates a new ScrapShipHandler
+func NewScrapShipHandler(
+	shipRepo navigation.ShipRepository,
+	playerRepo player.PlayerRepository,
+	apiClient domainPorts.APIClient,
+	mediator common.Mediator,
+) *ScrapShipHandler {
+	return &ScrapShipHandler{
+		shipRepo:   shipRepo,
+		playerRepo: playerRepo,
+		apiClient:  apiClient,
+		mediator:   mediator,
+	}
+}
+
+// Handle executes the ScrapShip command
+func (h *ScrapShipHandler) Handle(ctx context.Context, request common.Request) (common.Response, error) {
+	cmd, ok := request.(*ScrapShipCommand)
+	if !ok {
+		return nil, fmt.Errorf("invalid request type")
+	}
+
+	token, err := common.PlayerTokenFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	ship, err := h.shipRepo.FindBySymbol(ctx, cmd.ShipSymbol, cmd.PlayerID)
+	if err != nil {
+		return nil, fmt.Errorf("ship not found: %w", err)
+	}
+	if err := checkScrappable(ship); err != nil {
+		return nil, err
+	}
+
+	// The reservation is the atomic half of the assignment check above: it
+	// fails if a coordinator claimed the ship since it was loaded.
+	if err := h.shipRepo.ReserveForCaptain(ctx, cmd.ShipSymbol, scrapReservationReason, cmd.PlayerID); err != nil {
+		return nil, fmt.Errorf("failed to reserve ship for scrapping: %w", err)
+	}
+
+	result, err := h.dockAndScrap(ctx, cmd, ship, token)
+	if err != nil {
+		h.releaseReservation(ctx, cmd)
+		return nil, err
+	}
+
+	if err := h.updatePlayerCredits(ctx, cmd.PlayerID, result.Agent.Credits); err != nil {
+		return nil, fmt.Errorf("failed to update player credits: %w", err)
+	}
+
+	h.recordScrapTransaction(ctx, cmd, ship, result)
+	h.pruneScrappedShip(ctx, cmd)
+
+	return &ScrapShipResponse{
+		ShipSymbol:      cmd.ShipSymbol,
+		WaypointSymbol:  result.Transaction.WaypointSymbol,
+		ScrapValue:      result.Transaction.TotalPrice,
+		AgentCredits:    result.Agent.Credits,
+		TransactionTime: result.Transaction.Timestamp,
+	}, nil
+}
+
+// checkScrappable refuses a ship that still holds cargo or an assignment
+func checkScrappable(ship *navigation.Ship) error {
+	if assignment := ship.Assignment(); assignment != nil && assignment.IsActive() {
+		if assignment.IsCaptainReservation() {
+			return shared.NewShipReservedByCaptainError(ship.ShipSymbol(), ship.CaptainReservationReason())
+		}
+		return shared.NewShipAlreadyAssignedError(ship.ShipSymbol(), ship.ContainerID())
+	}
+	if !ship.IsCargoEmpty() {
+		return shared.NewValidationError("cargo", fmt.Sprintf(
+			"ship %s still carries %d units; sell or jettison them before scrapping", ship.ShipSymbol(), ship.CargoUnits()))
+	}
+	if ship.NavStatus() == navigation.NavStatusInTransit {
+		return shared.NewInvalidNavStatusError(fmt.Sprintf("ship %s is in transit; scrap it once it arrives at a shipyard", ship.ShipSymbol()))
+	}
+	return nil
+}
+
+// dockAndScrap docks the ship if it is in orbit and scraps it
+func (h *ScrapShipHandler) dockAndScrap(
+	ctx context.Context,
+	cmd *ScrapShipCommand,
+	ship *navigation.Ship,
+	token string,
+) (*domainPorts.ShipScrapResult, error) {
+	if ship.NavStatus() == navigation.NavStatusInOrbit {
+		dockCmd := &shipTypes.DockShipCommand{
+			Ship:     ship,
+			PlayerID: cmd.PlayerID,
+		}
+		if _, err := h.mediator.Send(ctx, dockCmd); err != nil {
+			return nil, fmt.Errorf("failed to dock ship: %w", err)
+		}
+	}
+
+	result, err := h.apiClient.ScrapShip(ctx, cmd.ShipSymbol, token)
+	if err != nil {
+		return nil, fmt.Errorf("failed to scrap ship: %w", err)
+	}
+	return result, nil
+}
+
+// releaseReservation hands a ship that failed to scrap back to the pool
+func (h *ScrapShipHandler) releaseReservation(ctx context.Context, cmd *ScrapShipCommand) {
+	if err := h.shipRepo.ReleaseCaptainReservation(ctx, cmd.ShipSymbol, "scrap failed", cmd.PlayerID); err != nil {
+		logging.LoggerFromContext(ctx).Log("WARNING", "Failed to release scrap reservation", map[string]interface{}{
+			"ship":  cmd.ShipSymbol,
+			"error": err.Error(),
+		})
+	}
+}
+
+// updatePlayerCredits persists the post-scrap credits reported by the API
+func (h *ScrapShipHandler) updatePlayerCredits(ctx context.Context, playerID shared.PlayerID, credits int) error {
+	p, err := h.playerRepo.FindByID(ctx, playerID)
+	if err != nil {
+		return fmt.Errorf("failed to find player: %w", err)
+	}
+	p.Credits = credits
+	if err := h.playerRepo.Add(ctx, p); err != nil {
+		return fmt.Errorf("failed to persist player: %w", err)
+	}
+	return nil
+}
+
+// recordScrapTransaction books the scrap refund in the ledger. A zero-value
+// scrap moves no credits and records nothing.
+func (h *ScrapShipHandler) recordScrapTransaction(
+	ctx context.Context,
+	cmd *ScrapShipCommand,
+	ship *navigation.Ship,
+	result *domainPorts.ShipScrapResult,
+) {
+	price := result.Transaction.TotalPrice
+	if price <= 0 {
+		return
+	}
+	logger := logging.LoggerFromContext(ctx)
+
+	balanceAfter := result.Agent.Credits
+	recordCmd := &ledgerCommands.RecordTransactionCommand{
+		PlayerID:             cmd.PlayerID.Value(),
+		TransactionType:      "SCRAP_SHIP",
+		Amount:               price, // Positive: credits recovered
+		BalanceBefore:        balanceAfter - price,
+		BalanceAfter:         balanceAfter,
+		AuthoritativeBalance: &balanceAfter,
+		Description:          fmt.Sprintf("Scrapped %s at %s", cmd.ShipSymbol, result.Transaction.WaypointSymbol),
+		Metadata: map[string]interface{}{
+			"agent":       result.Agent.Symbol,
+			"ship_symbol": cmd.ShipSymbol,
+			"frame":       ship.FrameSymbol(),
+			"waypoint":    result.Transaction.WaypointSymbol,
+		},
+		OperationType: "fleet downsizing",
+	}
+
+	if _, err := h.mediator.Send(ctx, recordCmd); err != nil {
+		// Log error but don't fail the operation: the ship is already gone
+		logger.Log("ERROR", "Failed to record ship scrap transaction in ledger", map[string]interface{}{
+			"error":     err.Error(),
+			"ship":      cmd.ShipSymbol,
+			"price":     price,
+			"player_id": cmd.PlayerID.Value(),
+		})
+	}
+}
+
+// pruneScrappedShip drops the scrapped ship's row by resyncing the fleet, which
+// deletes every row the live API no longer reports. Best-effort: the next
+// periodic resync prunes it anyway.
+func (h *ScrapShipHandler) pruneScrappedShip(ctx context.Context, cmd *ScrapShipCommand) {
+	if _, err := h.shipRepo.SyncAllFromAPI(ctx, cmd.PlayerID); err != nil {
+		logging.LoggerFromContext(ctx).Log("WARNING", "Failed to resync fleet after scrapping", map[string]interface{}{
+			"ship":  cmd.ShipSymbol,
+			"error": err.Error(),
+		})
+	}
+}
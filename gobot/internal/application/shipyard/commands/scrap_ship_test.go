@@ -0,0 +1,198 @@
+package commands
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/andrescamacho/spacetraders-go/internal/application/common"
+	ledgerCommands "github.com/andrescamacho/spacetraders-go/internal/application/ledger/commands"
+	"github.com/andrescamacho/spacetraders-go/internal/domain/navigation"
+	"github.com/andrescamacho/spacetraders-go/internal/domain/player"
+	domainPorts "github.com/andrescamacho/spacetraders-go/internal/domain/ports"
+	"github.com/andrescamacho/spacetraders-go/internal/domain/shared"
+)
+
+type scrapStubShipRepo struct {
+	navigation.ShipRepository
+
+	ship       *navigation.Ship
+	reserveErr error
+	reserved   int
+	released   int
+	synced     int
+}
+
+func (s *scrapStubShipRepo) FindBySymbol(context.Context, string, shared.PlayerID) (*navigation.Ship, error) {
+	return s.ship, nil
+}
+
+func (s *scrapStubShipRepo) ReserveForCaptain(context.Context, string, string, shared.PlayerID) error {
+	s.reserved++
+	return s.reserveErr
+}
+
+func (s *scrapStubShipRepo) ReleaseCaptainReservation(context.Context, string, string, shared.PlayerID) error {
+	s.released++
+	return nil
+}
+
+func (s *scrapStubShipRepo) SyncAllFromAPI(context.Context, shared.PlayerID) (int, error) {
+	s.synced++
+	return 0, nil
+}
+
+type scrapStubPlayerRepo struct {
+	player.PlayerRepository
+	p *player.Player
+}
+
+func (r *scrapStubPlayerRepo) FindByID(context.Context, shared.PlayerID) (*player.Player, error) {
+	return r.p, nil
+}
+
+func (r *scrapStubPlayerRepo) Add(_ context.Context, p *player.Player) error {
+	r.p = p
+	return nil
+}
+
+type scrapStubAPIClient struct {
+	domainPorts.APIClient
+	scrapped []string
+	err      error
+}
+
+func (c *scrapStubAPIClient) ScrapShip(_ context.Context, shipSymbol, _ string) (*domainPorts.ShipScrapResult, error) {
+	if c.err != nil {
+		return nil, c.err
+	}
+	c.scrapped = append(c.scrapped, shipSymbol)
+	return &domainPorts.ShipScrapResult{
+		Agent:       &player.AgentData{Symbol: "TORWIND", Credits: 112000},
+		Transaction: &domainPorts.ShipScrapTransaction{WaypointSymbol: "X1-A1", ShipSymbol: shipSymbol, TotalPrice: 12000},
+	}, nil
+}
+
+type scrapRecordingMediator struct {
+	common.Mediator
+	recorded []*ledgerCommands.RecordTransactionCommand
+}
+
+func (m *scrapRecordingMediator) Send(_ context.Context, request common.Request) (common.Response, error) {
+	if cmd, ok := request.(*ledgerCommands.RecordTransactionCommand); ok {
+		m.recorded = append(m.recorded, cmd)
+		return nil, nil
+	}
+	return nil, errors.New("unexpected mediator request")
+}
+
+func scrapTestShip(t *testing.T, cargoUnits int) *navigation.Ship {
+	t.Helper()
+	loc, _ := shared.NewWaypoint("X1-A1", 0, 0)
+	fuel, _ := shared.NewFuel(100, 100)
+	var items []*shared.CargoItem
+	if cargoUnits > 0 {
+		item, err := shared.NewCargoItem("IRON_ORE", "Iron Ore", "", cargoUnits)
+		if err != nil {
+			t.Fatalf("cargo item: %v", err)
+		}
+		items = append(items, item)
+	}
+	cargo, err := shared.NewCargo(40, cargoUnits, items)
+	if err != nil {
+		t.Fatalf("cargo: %v", err)
+	}
+	ship, err := navigation.NewShip("TORWIND-9", shared.MustNewPlayerID(1), loc, fuel, 100, 40, cargo, 30, "FRAME_LIGHT_FREIGHTER", "HAULER", nil, navigation.NavStatusDocked)
+	if err != nil {
+		t.Fatalf("ship: %v", err)
+	}
+	return ship
+}
+
+func newScrapTestHandler(ship *navigation.Ship) (*ScrapShipHandler, *scrapStubShipRepo, *scrapStubAPIClient, *scrapRecordingMediator) {
+	shipRepo := &scrapStubShipRepo{ship: ship}
+	api := &scrapStubAPIClient{}
+	med := &scrapRecordingMediator{}
+	players := &scrapStubPlayerRepo{p: player.NewPlayer(shared.MustNewPlayerID(1), "TORWIND", "token")}
+	return NewScrapShipHandler(shipRepo, players, api, med), shipRepo, api, med
+}
+
+func scrapContext() context.Context {
+	return common.WithPlayerToken(context.Background(), "token")
+}
+
+// A clean, idle, docked ship is reserved, scrapped, booked as a positive
+// SCRAP_SHIP row, and pruned from the fleet cache.
+func TestScrapShip_ScrapsIdleEmptyShipAndRecordsRefund(t *testing.T) {
+	handler, shipRepo, api, med := newScrapTestHandler(scrapTestShip(t, 0))
+
+	resp, err := handler.Handle(scrapContext(), &ScrapShipCommand{ShipSymbol: "TORWIND-9", PlayerID: shared.MustNewPlayerID(1)})
+	if err != nil {
+		t.Fatalf("scrap failed: %v", err)
+	}
+
+	if got := resp.(*ScrapShipResponse).ScrapValue; got != 12000 {
+		t.Fatalf("expected scrap value 12000, got %d", got)
+	}
+	if shipRepo.reserved != 1 || shipRepo.released != 0 || shipRepo.synced != 1 {
+		t.Fatalf("expected reserve+resync only, got reserved=%d released=%d synced=%d", shipRepo.reserved, shipRepo.released, shipRepo.synced)
+	}
+	if len(api.scrapped) != 1 {
+		t.Fatalf("expected one scrap call, got %v", api.scrapped)
+	}
+	if len(med.recorded) != 1 {
+		t.Fatalf("expected one ledger row, got %d", len(med.recorded))
+	}
+	row := med.recorded[0]
+	if row.TransactionType != "SCRAP_SHIP" || row.Amount != 12000 || row.BalanceBefore != 100000 || row.BalanceAfter != 112000 {
+		t.Fatalf("unexpected ledger row: %+v", row)
+	}
+}
+
+func TestScrapShip_RefusesShipWithCargo(t *testing.T) {
+	handler, shipRepo, api, _ := newScrapTestHandler(scrapTestShip(t, 5))
+
+	_, err := handler.Handle(scrapContext(), &ScrapShipCommand{ShipSymbol: "TORWIND-9", PlayerID: shared.MustNewPlayerID(1)})
+
+	if !errors.Is(err, shared.ErrInvalidArgument) {
+		t.Fatalf("expected an invalid-argument refusal, got %v", err)
+	}
+	if shipRepo.reserved != 0 || len(api.scrapped) != 0 {
+		t.Fatalf("a refused ship must not be reserved or scrapped")
+	}
+}
+
+func TestScrapShip_RefusesAssignedShip(t *testing.T) {
+	ship := scrapTestShip(t, 0)
+	ship.SetAssignment(navigation.NewActiveAssignment("arb-1", time.Now()))
+	handler, shipRepo, api, _ := newScrapTestHandler(ship)
+
+	_, err := handler.Handle(scrapContext(), &ScrapShipCommand{ShipSymbol: "TORWIND-9", PlayerID: shared.MustNewPlayerID(1)})
+
+	if !errors.Is(err, shared.ErrShipBusy) {
+		t.Fatalf("expected a ship-busy refusal, got %v", err)
+	}
+	if shipRepo.reserved != 0 || len(api.scrapped) != 0 {
+		t.Fatalf("an assigned ship must not be reserved or scrapped")
+	}
+}
+
+// A failed API scrap leaves the ship alive, so the scrap reservation must be
+// released or the hull would sit hidden from every coordinator.
+func TestScrapShip_ReleasesReservationWhenScrapFails(t *testing.T) {
+	handler, shipRepo, api, med := newScrapTestHandler(scrapTestShip(t, 0))
+	api.err = errors.New("ship is not docked at a shipyard")
+
+	_, err := handler.Handle(scrapContext(), &ScrapShipCommand{ShipSymbol: "TORWIND-9", PlayerID: shared.MustNewPlayerID(1)})
+
+	if err == nil {
+		t.Fatal("expected the API failure to surface")
+	}
+	if shipRepo.released != 1 {
+		t.Fatalf("expected the reservation to be released, got %d releases", shipRepo.released)
+	}
+	if len(med.recorded) != 0 {
+		t.Fatalf("a failed scrap must not be booked")
+	}
+}
@@ -0,0 +1,73 @@
+package queries
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/andrescamacho/spacetraders-go/internal/application/common"
+	"github.com/andrescamacho/spacetraders-go/internal/domain/shared"
+	"github.com/andrescamacho/spacetraders-go/internal/domain/shipyard"
+)
+
+// DefaultShipPriceHistoryWindow is the history returned when the query leaves
+// Window unset.
+const DefaultShipPriceHistoryWindow = 7 * 24 * time.Hour
+
+// GetShipPriceHistoryQuery asks for the observed prices of one ship type,
+// across every yard or at one waypoint.
+type GetShipPriceHistoryQuery struct {
+	PlayerID       shared.PlayerID
+	ShipType       string
+	WaypointSymbol string        // Optional - every yard when empty
+	Window         time.Duration // <=0 => DefaultShipPriceHistoryWindow
+}
+
+// GetShipPriceHistoryResponse holds the raw snapshots (oldest first) and one
+// summary per yard, cheapest latest price first.
+type GetShipPriceHistoryResponse struct {
+	Snapshots []shipyard.ShipPriceSnapshot
+	Stats     []shipyard.ShipPriceStats
+}
+
+// GetShipPriceHistoryHandler handles the GetShipPriceHistory query from
+// persisted snapshots only; it never calls the API.
+type GetShipPriceHistoryHandler struct {
+	repo  shipyard.PriceHistoryRepository
+	clock shared.Clock
+}
+
+// NewGetShipPriceHistoryHandler creates a new GetShipPriceHistoryHandler. A nil
+// clock defaults to the real clock.
+func NewGetShipPriceHistoryHandler(repo shipyard.PriceHistoryRepository, clock shared.Clock) *GetShipPriceHistoryHandler {
+	if clock == nil {
+		clock = shared.NewRealClock()
+	}
+	return &GetShipPriceHistoryHandler{repo: repo, clock: clock}
+}
+
+// Handle executes the GetShipPriceHistory query.
+func (h *GetShipPriceHistoryHandler) Handle(ctx context.Context, request common.Request) (common.Response, error) {
+	query, ok := request.(*GetShipPriceHistoryQuery)
+	if !ok {
+		return nil, fmt.Errorf("invalid request type: expected *GetShipPriceHistoryQuery")
+	}
+	if query.ShipType == "" {
+		return nil, fmt.Errorf("ship type is required")
+	}
+
+	window := query.Window
+	if window <= 0 {
+		window = DefaultShipPriceHistoryWindow
+	}
+
+	history, err := h.repo.FindSince(ctx, query.PlayerID.Value(), query.ShipType, query.WaypointSymbol, h.clock.Now().Add(-window))
+	if err != nil {
+		return nil, err
+	}
+
+	return &GetShipPriceHistoryResponse{
+		Snapshots: history,
+		Stats:     shipyard.SummarizeShipPrices(history),
+	}, nil
+}
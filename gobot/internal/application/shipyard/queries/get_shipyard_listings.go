@@ -3,6 +3,7 @@ package queries
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/andrescamacho/spacetraders-go/internal/application/common"
 	"github.com/andrescamacho/spacetraders-go/internal/domain/player"
@@ -25,8 +26,9 @@ type GetShipyardListingsResponse struct {
 
 // GetShipyardListingsHandler handles the GetShipyardListings query
 type GetShipyardListingsHandler struct {
-	apiClient  domainPorts.APIClient
-	playerRepo player.PlayerRepository
+	apiClient    domainPorts.APIClient
+	playerRepo   player.PlayerRepository
+	priceHistory shipyard.PriceHistoryRepository
 }
 
 // NewGetShipyardListingsHandler creates a new GetShipyardListingsHandler
@@ -40,6 +42,14 @@ func NewGetShipyardListingsHandler(
 	}
 }
 
+// WithPriceHistory makes every live read also append its priced listings to the
+// ship price history, so the polls of a wait-for-dip purchase build the history
+// they wait on. Returns the handler for chaining.
+func (h *GetShipyardListingsHandler) WithPriceHistory(repo shipyard.PriceHistoryRepository) *GetShipyardListingsHandler {
+	h.priceHistory = repo
+	return h
+}
+
 // Handle executes the GetShipyardListings query
 func (h *GetShipyardListingsHandler) Handle(ctx context.Context, request common.Request) (common.Response, error) {
 	query, ok := request.(*GetShipyardListingsQuery)
@@ -57,6 +67,8 @@ func (h *GetShipyardListingsHandler) Handle(ctx context.Context, request common.
 		return nil, fmt.Errorf("failed to get shipyard: %w", err)
 	}
 
+	h.recordPriceHistory(ctx, query, shipyardData.Ships)
+
 	shipListings := h.convertShipListings(shipyardData.Ships)
 	shipTypes := h.extractShipTypeStrings(shipyardData.ShipTypes)
 
@@ -70,6 +82,36 @@ func (h *GetShipyardListingsHandler) Handle(ctx context.Context, request common.
 	}, nil
 }
 
+// recordPriceHistory appends the read's priced listings to the price history.
+// A failed write is logged and otherwise ignored: the read itself succeeded.
+func (h *GetShipyardListingsHandler) recordPriceHistory(
+	ctx context.Context,
+	query *GetShipyardListingsQuery,
+	apiShips []domainPorts.ShipListingData,
+) {
+	if h.priceHistory == nil || len(apiShips) == 0 {
+		return
+	}
+	observedAt := time.Now()
+	snapshots := make([]shipyard.ShipPriceSnapshot, 0, len(apiShips))
+	for _, ship := range apiShips {
+		if ship.PurchasePrice <= 0 {
+			continue
+		}
+		snapshots = append(snapshots, shipyard.ShipPriceSnapshot{
+			SystemSymbol:   query.SystemSymbol,
+			WaypointSymbol: query.WaypointSymbol,
+			ShipType:       ship.Type,
+			PurchasePrice:  ship.PurchasePrice,
+			Supply:         ship.Supply,
+			ObservedAt:     observedAt,
+		})
+	}
+	if err := h.priceHistory.RecordSnapshots(ctx, query.PlayerID.Value(), snapshots); err != nil {
+		common.LoggerFromContext(ctx).Log("WARN", fmt.Sprintf("Ship price history not recorded for %s: %v", query.WaypointSymbol, err), nil)
+	}
+}
+
 // convertShipListings converts API ship listings to domain model
 // Returns: array of domain ShipListing objects
 func (h *GetShipyardListingsHandler) convertShipListings(
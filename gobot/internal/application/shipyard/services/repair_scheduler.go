@@ -0,0 +1,113 @@
+package services
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/andrescamacho/spacetraders-go/internal/application/common"
+	navCmd "github.com/andrescamacho/spacetraders-go/internal/application/ship/commands/navigation"
+	shipyardCmd "github.com/andrescamacho/spacetraders-go/internal/application/shipyard/commands"
+	"github.com/andrescamacho/spacetraders-go/internal/domain/navigation"
+	"github.com/andrescamacho/spacetraders-go/internal/domain/shared"
+)
+
+// shipyardTrait marks a waypoint that can repair ships
+const shipyardTrait = "SHIPYARD"
+
+// shipyardLocator lists a system's shipyards from the waypoint cache. Satisfied
+// by *persistence.GormWaypointRepository.
+type shipyardLocator interface {
+	ListBySystemWithTrait(ctx context.Context, systemSymbol, trait string) ([]*shared.Waypoint, error)
+}
+
+// RepairScheduler sends a worn hull to the nearest shipyard in its system and
+// repairs it there, before the wear turns into failures. Coordinators call it
+// at their safe stops (between circuits, with an empty hold), so a detour never
+// strands cargo. It moves and repairs through the mediator, so the daemon's
+// route executor handles refuelling on the way.
+type RepairScheduler struct {
+	mediator  common.Mediator
+	shipyards shipyardLocator
+	threshold float64
+}
+
+// NewRepairScheduler creates a scheduler that repairs hulls whose most worn
+// component is below threshold (0-1).
+func NewRepairScheduler(mediator common.Mediator, shipyards shipyardLocator, threshold float64) *RepairScheduler {
+	return &RepairScheduler{mediator: mediator, shipyards: shipyards, threshold: threshold}
+}
+
+// RepairIfWorn repairs ship when its condition is below the threshold and
+// reports whether it did. A ship that was moved or repaired is stale afterwards;
+// callers reload it. A system with no known shipyard is not an error: the hull
+// keeps working and is checked again at its next stop.
+func (s *RepairScheduler) RepairIfWorn(ctx context.Context, ship *navigation.Ship, playerID shared.PlayerID) (bool, error) {
+	condition := ship.Condition()
+	if !condition.NeedsRepair(s.threshold) {
+		return false, nil
+	}
+	logger := common.LoggerFromContext(ctx)
+
+	shipyard, err := s.nearestShipyard(ctx, ship)
+	if err != nil {
+		return false, err
+	}
+	if shipyard == nil {
+		logger.Log("WARNING", "Ship needs repair but its system has no known shipyard", map[string]interface{}{
+			"ship_symbol": ship.ShipSymbol(),
+			"system":      ship.CurrentLocation().SystemSymbol,
+			"condition":   condition.Lowest(),
+			"threshold":   s.threshold,
+		})
+		return false, nil
+	}
+
+	if ship.CurrentLocation().Symbol != shipyard.Symbol {
+		logger.Log("INFO", fmt.Sprintf("Ship condition %.2f below %.2f - detouring to shipyard %s for repair",
+			condition.Lowest(), s.threshold, shipyard.Symbol), map[string]interface{}{
+			"ship_symbol": ship.ShipSymbol(),
+			"shipyard":    shipyard.Symbol,
+		})
+		if _, err := s.mediator.Send(ctx, &navCmd.NavigateRouteCommand{
+			ShipSymbol:  ship.ShipSymbol(),
+			Destination: shipyard.Symbol,
+			PlayerID:    playerID,
+		}); err != nil {
+			return false, fmt.Errorf("failed to reach shipyard %s for repair: %w", shipyard.Symbol, err)
+		}
+	}
+
+	resp, err := s.mediator.Send(ctx, &shipyardCmd.RepairShipCommand{
+		ShipSymbol: ship.ShipSymbol(),
+		PlayerID:   playerID,
+	})
+	if err != nil {
+		return false, err
+	}
+	if repaired, ok := resp.(*shipyardCmd.RepairShipResponse); ok {
+		logger.Log("INFO", fmt.Sprintf("Repaired ship at %s for %d credits", repaired.WaypointSymbol, repaired.Cost), map[string]interface{}{
+			"ship_symbol":      ship.ShipSymbol(),
+			"cost":             repaired.Cost,
+			"condition_before": condition.Lowest(),
+			"condition_after":  repaired.Condition.Lowest(),
+		})
+	}
+	return true, nil
+}
+
+// nearestShipyard returns the shipyard in the ship's system closest to it, or
+// nil when the system has none in the waypoint cache.
+func (s *RepairScheduler) nearestShipyard(ctx context.Context, ship *navigation.Ship) (*shared.Waypoint, error) {
+	location := ship.CurrentLocation()
+	shipyards, err := s.shipyards.ListBySystemWithTrait(ctx, location.SystemSymbol, shipyardTrait)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list shipyards in %s: %w", location.SystemSymbol, err)
+	}
+	var nearest *shared.Waypoint
+	for _, shipyard := range shipyards {
+		if nearest == nil || location.DistanceTo(shipyard) < location.DistanceTo(nearest) {
+			nearest = shipyard
+		}
+	}
+	return nearest, nil
+}
@@ -0,0 +1,78 @@
+package services
+
+import (
+	"context"
+	"testing"
+
+	"github.com/andrescamacho/spacetraders-go/internal/application/common"
+	navCmd "github.com/andrescamacho/spacetraders-go/internal/application/ship/commands/navigation"
+	shipyardCmd "github.com/andrescamacho/spacetraders-go/internal/application/shipyard/commands"
+	"github.com/andrescamacho/spacetraders-go/internal/domain/navigation"
+	"github.com/andrescamacho/spacetraders-go/internal/domain/shared"
+)
+
+type stubShipyards []*shared.Waypoint
+
+func (s stubShipyards) ListBySystemWithTrait(context.Context, string, string) ([]*shared.Waypoint, error) {
+	return s, nil
+}
+
+type recordingMediator struct {
+	common.Mediator
+	sent []common.Request
+}
+
+func (m *recordingMediator) Send(_ context.Context, request common.Request) (common.Response, error) {
+	m.sent = append(m.sent, request)
+	if _, ok := request.(*shipyardCmd.RepairShipCommand); ok {
+		return &shipyardCmd.RepairShipResponse{WaypointSymbol: "X1-A1-NEAR", Cost: 500}, nil
+	}
+	return nil, nil
+}
+
+func wornShip(t *testing.T, engine float64) *navigation.Ship {
+	t.Helper()
+	loc, _ := shared.NewWaypoint("X1-A1-HOME", 0, 0)
+	fuel, _ := shared.NewFuel(100, 100)
+	cargo, _ := shared.NewCargo(40, 0, nil)
+	ship, err := navigation.NewShip("TORWIND-9", shared.MustNewPlayerID(1), loc, fuel, 100, 40, cargo, 30, "FRAME_LIGHT_FREIGHTER", "HAULER", nil, navigation.NavStatusInOrbit)
+	if err != nil {
+		t.Fatalf("ship: %v", err)
+	}
+	ship.SetCondition(navigation.ShipCondition{
+		Frame:   navigation.ComponentCondition{Condition: 0.9, Integrity: 1},
+		Reactor: navigation.ComponentCondition{Condition: 0.9, Integrity: 1},
+		Engine:  navigation.ComponentCondition{Condition: engine, Integrity: 1},
+	})
+	return ship
+}
+
+// A worn hull flies to the nearest shipyard and is repaired there; a healthy
+// one is left alone.
+func TestRepairScheduler_RepairsAtNearestShipyard(t *testing.T) {
+	far, _ := shared.NewWaypoint("X1-A1-FAR", 90, 0)
+	near, _ := shared.NewWaypoint("X1-A1-NEAR", 10, 0)
+	med := &recordingMediator{}
+	scheduler := NewRepairScheduler(med, stubShipyards{far, near}, 0.5)
+	ctx := context.Background()
+
+	repaired, err := scheduler.RepairIfWorn(ctx, wornShip(t, 0.7), shared.MustNewPlayerID(1))
+	if err != nil || repaired || len(med.sent) != 0 {
+		t.Fatalf("a healthy hull must not be sent anywhere: repaired=%v err=%v sent=%d", repaired, err, len(med.sent))
+	}
+
+	repaired, err = scheduler.RepairIfWorn(ctx, wornShip(t, 0.2), shared.MustNewPlayerID(1))
+	if err != nil || !repaired {
+		t.Fatalf("expected a repair, got repaired=%v err=%v", repaired, err)
+	}
+	if len(med.sent) != 2 {
+		t.Fatalf("expected navigate then repair, got %d requests", len(med.sent))
+	}
+	nav, ok := med.sent[0].(*navCmd.NavigateRouteCommand)
+	if !ok || nav.Destination != "X1-A1-NEAR" {
+		t.Fatalf("expected a route to the nearest shipyard, got %#v", med.sent[0])
+	}
+	if _, ok := med.sent[1].(*shipyardCmd.RepairShipCommand); !ok {
+		t.Fatalf("expected a repair command, got %#v", med.sent[1])
+	}
+}
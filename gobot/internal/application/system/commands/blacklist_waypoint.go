@@ -0,0 +1,116 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/andrescamacho/spacetraders-go/internal/application/common"
+	"github.com/andrescamacho/spacetraders-go/internal/domain/shared"
+	"github.com/andrescamacho/spacetraders-go/internal/domain/system"
+)
+
+// WaypointBlacklistStore adds and clears blacklist entries, refreshing the
+// daemon's cached view as it does. Satisfied by *ship.WaypointBlacklist.
+type WaypointBlacklistStore interface {
+	Add(ctx context.Context, entry *system.BlacklistedWaypoint) error
+	Clear(ctx context.Context, playerID int, waypoint string) (bool, error)
+}
+
+// BlacklistWaypointCommand is an operator blacklisting a waypoint for the
+// player's fleet: route planning, market selection and scouting skip it until
+// TTL passes or it is cleared.
+type BlacklistWaypointCommand struct {
+	PlayerID       shared.PlayerID
+	WaypointSymbol string
+	Reason         string
+	TTL            time.Duration // 0: until cleared
+}
+
+// BlacklistWaypointResponse returns the stored entry.
+type BlacklistWaypointResponse struct {
+	Entry *system.BlacklistedWaypoint
+}
+
+// BlacklistWaypointHandler handles BlacklistWaypointCommand.
+type BlacklistWaypointHandler struct {
+	store WaypointBlacklistStore
+	clock shared.Clock
+}
+
+// NewBlacklistWaypointHandler creates the handler. If clock is nil, uses
+// RealClock.
+func NewBlacklistWaypointHandler(store WaypointBlacklistStore, clock shared.Clock) *BlacklistWaypointHandler {
+	if clock == nil {
+		clock = shared.NewRealClock()
+	}
+	return &BlacklistWaypointHandler{store: store, clock: clock}
+}
+
+// Handle blacklists the waypoint, replacing any entry it already has.
+func (h *BlacklistWaypointHandler) Handle(ctx context.Context, request common.Request) (common.Response, error) {
+	cmd, ok := request.(*BlacklistWaypointCommand)
+	if !ok {
+		return nil, fmt.Errorf("invalid request type: expected *BlacklistWaypointCommand")
+	}
+	if cmd.WaypointSymbol == "" {
+		return nil, fmt.Errorf("waypoint_symbol is required")
+	}
+	if cmd.TTL < 0 {
+		return nil, fmt.Errorf("ttl must not be negative")
+	}
+
+	entry := &system.BlacklistedWaypoint{
+		PlayerID:       cmd.PlayerID.Value(),
+		WaypointSymbol: cmd.WaypointSymbol,
+		Reason:         cmd.Reason,
+		Source:         system.BlacklistSourceOperator,
+		CreatedAt:      h.clock.Now(),
+	}
+	if cmd.TTL > 0 {
+		expiresAt := entry.CreatedAt.Add(cmd.TTL)
+		entry.ExpiresAt = &expiresAt
+	}
+	if err := h.store.Add(ctx, entry); err != nil {
+		return nil, err
+	}
+	return &BlacklistWaypointResponse{Entry: entry}, nil
+}
+
+// ClearWaypointBlacklistCommand takes a waypoint off the player's blacklist.
+type ClearWaypointBlacklistCommand struct {
+	PlayerID       shared.PlayerID
+	WaypointSymbol string
+}
+
+// ClearWaypointBlacklistResponse reports whether the waypoint was blacklisted.
+type ClearWaypointBlacklistResponse struct {
+	Removed bool
+}
+
+// ClearWaypointBlacklistHandler handles ClearWaypointBlacklistCommand.
+type ClearWaypointBlacklistHandler struct {
+	store WaypointBlacklistStore
+}
+
+// NewClearWaypointBlacklistHandler creates the handler.
+func NewClearWaypointBlacklistHandler(store WaypointBlacklistStore) *ClearWaypointBlacklistHandler {
+	return &ClearWaypointBlacklistHandler{store: store}
+}
+
+// Handle clears the entry. Clearing a waypoint that is not blacklisted is not
+// an error.
+func (h *ClearWaypointBlacklistHandler) Handle(ctx context.Context, request common.Request) (common.Response, error) {
+	cmd, ok := request.(*ClearWaypointBlacklistCommand)
+	if !ok {
+		return nil, fmt.Errorf("invalid request type: expected *ClearWaypointBlacklistCommand")
+	}
+	if cmd.WaypointSymbol == "" {
+		return nil, fmt.Errorf("waypoint_symbol is required")
+	}
+	removed, err := h.store.Clear(ctx, cmd.PlayerID.Value(), cmd.WaypointSymbol)
+	if err != nil {
+		return nil, err
+	}
+	return &ClearWaypointBlacklistResponse{Removed: removed}, nil
+}
@@ -0,0 +1,244 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/andrescamacho/spacetraders-go/internal/application/common"
+	"github.com/andrescamacho/spacetraders-go/internal/domain/navigation"
+	"github.com/andrescamacho/spacetraders-go/internal/domain/shared"
+	"github.com/andrescamacho/spacetraders-go/internal/domain/system"
+)
+
+// Warm-up phases, in execution order, as reported in SystemWarmupProgress.Phase.
+const (
+	WarmupPhaseWaypoints = "waypoints"
+	WarmupPhaseMarkets   = "markets"
+	WarmupPhaseShipyards = "shipyards"
+	WarmupPhaseDone      = "done"
+)
+
+// MarketScanner scans a market and persists it, skipping markets whose cached
+// scan is younger than maxAge. Satisfied by *ship.MarketScanner.
+type MarketScanner interface {
+	ScanAndSaveMarketFresh(ctx context.Context, playerID uint, waypointSymbol string, maxAge time.Duration) (bool, error)
+}
+
+// ShipyardScanner scans a shipyard and persists its inventory. Satisfied by
+// *ship.ShipyardScanner.
+type ShipyardScanner interface {
+	ScanAndSaveShipyard(ctx context.Context, playerID uint, waypointSymbol string) error
+}
+
+// ShipLocator lists the player's ships so the warm-up knows where it has eyes.
+type ShipLocator interface {
+	FindAllByPlayer(ctx context.Context, playerID shared.PlayerID) ([]*navigation.Ship, error)
+}
+
+// WarmupProgressPersister records warm-up progress against the running
+// container so an operator can follow it with `container get`.
+type WarmupProgressPersister interface {
+	PersistWarmupProgress(ctx context.Context, containerID string, playerID int, progress SystemWarmupProgress) error
+}
+
+// WarmSystemCommand pre-fetches a system's waypoints, markets and shipyards in
+// the background, so the first navigation or trade command in a new system
+// does not pay the cold-start cost of paging the waypoint list synchronously.
+type WarmSystemCommand struct {
+	SystemSymbol string
+	PlayerID     shared.PlayerID
+	ContainerID  string
+	// MarketMaxAge skips markets whose cached scan is younger than this; 0
+	// rescans every reachable market.
+	MarketMaxAge time.Duration
+}
+
+// SystemWarmupProgress is the warm-up's running tally, persisted after every
+// step. Markets and shipyards are only read where one of the player's ships
+// is present: a remote read carries no prices and would overwrite cached
+// ones, so the rest are counted as deferred to the next scout visit.
+type SystemWarmupProgress struct {
+	Phase             string `json:"phase"`
+	Waypoints         int    `json:"waypoints"`
+	MarketsTotal      int    `json:"markets_total"`
+	MarketsScanned    int    `json:"markets_scanned"`
+	MarketsFresh      int    `json:"markets_fresh"`
+	MarketsDeferred   int    `json:"markets_deferred"`
+	ShipyardsTotal    int    `json:"shipyards_total"`
+	ShipyardsScanned  int    `json:"shipyards_scanned"`
+	ShipyardsDeferred int    `json:"shipyards_deferred"`
+	Failed            int    `json:"failed"`
+}
+
+// WarmSystemResponse reports the final warm-up tally.
+type WarmSystemResponse struct {
+	SystemSymbol string
+	Progress     SystemWarmupProgress
+}
+
+// WarmSystemHandler - Handles system warm-up commands
+type WarmSystemHandler struct {
+	graphProvider   system.ISystemGraphProvider
+	waypointRepo    system.WaypointRepository
+	ships           ShipLocator
+	marketScanner   MarketScanner
+	shipyardScanner ShipyardScanner
+	persister       WarmupProgressPersister
+}
+
+// NewWarmSystemHandler creates a new system warm-up handler
+func NewWarmSystemHandler(
+	graphProvider system.ISystemGraphProvider,
+	waypointRepo system.WaypointRepository,
+	ships ShipLocator,
+	marketScanner MarketScanner,
+	shipyardScanner ShipyardScanner,
+) *WarmSystemHandler {
+	return &WarmSystemHandler{
+		graphProvider:   graphProvider,
+		waypointRepo:    waypointRepo,
+		ships:           ships,
+		marketScanner:   marketScanner,
+		shipyardScanner: shipyardScanner,
+	}
+}
+
+// SetProgressPersister wires progress persistence. Without one, progress is
+// only logged and returned.
+func (h *WarmSystemHandler) SetProgressPersister(persister WarmupProgressPersister) {
+	h.persister = persister
+}
+
+// Handle executes the warm system command
+func (h *WarmSystemHandler) Handle(ctx context.Context, request common.Request) (common.Response, error) {
+	cmd, ok := request.(*WarmSystemCommand)
+	if !ok {
+		return nil, fmt.Errorf("invalid request type")
+	}
+	if cmd.SystemSymbol == "" {
+		return nil, fmt.Errorf("system symbol is required")
+	}
+
+	logger := common.LoggerFromContext(ctx)
+	progress := SystemWarmupProgress{Phase: WarmupPhaseWaypoints}
+	h.report(ctx, cmd, &progress)
+
+	// The graph provider serves a cached graph or pages the waypoint list from
+	// the API and caches both the graph and the waypoint rows.
+	graphResult, err := h.graphProvider.GetGraph(ctx, cmd.SystemSymbol, false, cmd.PlayerID.Value())
+	if err != nil {
+		return nil, fmt.Errorf("failed to load waypoints for %s: %w", cmd.SystemSymbol, err)
+	}
+	progress.Waypoints = len(graphResult.Graph.Waypoints)
+
+	present, err := h.shipWaypoints(ctx, cmd.PlayerID)
+	if err != nil {
+		return nil, err
+	}
+
+	progress.Phase = WarmupPhaseMarkets
+	markets, err := h.waypointRepo.ListBySystemWithTrait(ctx, cmd.SystemSymbol, "MARKETPLACE")
+	if err != nil {
+		return nil, fmt.Errorf("failed to list markets in %s: %w", cmd.SystemSymbol, err)
+	}
+	progress.MarketsTotal = len(markets)
+	h.report(ctx, cmd, &progress)
+
+	playerID := uint(cmd.PlayerID.Value())
+	for _, wp := range markets {
+		if !present[wp.Symbol] {
+			progress.MarketsDeferred++
+			continue
+		}
+		scanned, err := h.marketScanner.ScanAndSaveMarketFresh(ctx, playerID, wp.Symbol, cmd.MarketMaxAge)
+		switch {
+		case err != nil:
+			progress.Failed++
+			logger.Log("WARNING", "System warm-up market scan failed", map[string]interface{}{
+				"action":   "warm_system_market",
+				"waypoint": wp.Symbol,
+				"error":    err.Error(),
+			})
+		case scanned:
+			progress.MarketsScanned++
+		default:
+			progress.MarketsFresh++
+		}
+		h.report(ctx, cmd, &progress)
+	}
+
+	progress.Phase = WarmupPhaseShipyards
+	shipyards, err := h.waypointRepo.ListBySystemWithTrait(ctx, cmd.SystemSymbol, "SHIPYARD")
+	if err != nil {
+		return nil, fmt.Errorf("failed to list shipyards in %s: %w", cmd.SystemSymbol, err)
+	}
+	progress.ShipyardsTotal = len(shipyards)
+	h.report(ctx, cmd, &progress)
+
+	for _, wp := range shipyards {
+		if !present[wp.Symbol] {
+			progress.ShipyardsDeferred++
+			continue
+		}
+		if err := h.shipyardScanner.ScanAndSaveShipyard(ctx, playerID, wp.Symbol); err != nil {
+			progress.Failed++
+			logger.Log("WARNING", "System warm-up shipyard scan failed", map[string]interface{}{
+				"action":   "warm_system_shipyard",
+				"waypoint": wp.Symbol,
+				"error":    err.Error(),
+			})
+		} else {
+			progress.ShipyardsScanned++
+		}
+		h.report(ctx, cmd, &progress)
+	}
+
+	progress.Phase = WarmupPhaseDone
+	h.report(ctx, cmd, &progress)
+
+	logger.Log("INFO", "System warm-up complete", map[string]interface{}{
+		"action":             "warm_system",
+		"system":             cmd.SystemSymbol,
+		"waypoints":          progress.Waypoints,
+		"markets_scanned":    progress.MarketsScanned,
+		"markets_deferred":   progress.MarketsDeferred,
+		"shipyards_scanned":  progress.ShipyardsScanned,
+		"shipyards_deferred": progress.ShipyardsDeferred,
+		"failed":             progress.Failed,
+	})
+
+	return &WarmSystemResponse{SystemSymbol: cmd.SystemSymbol, Progress: progress}, nil
+}
+
+// shipWaypoints returns the waypoints where one of the player's ships is
+// docked or in orbit.
+func (h *WarmSystemHandler) shipWaypoints(ctx context.Context, playerID shared.PlayerID) (map[string]bool, error) {
+	ships, err := h.ships.FindAllByPlayer(ctx, playerID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list ships: %w", err)
+	}
+	present := make(map[string]bool, len(ships))
+	for _, ship := range ships {
+		if ship.IsInTransit() || ship.CurrentLocation() == nil {
+			continue
+		}
+		present[ship.CurrentLocation().Symbol] = true
+	}
+	return present, nil
+}
+
+// report persists the current progress. Persistence is best-effort: losing a
+// progress update never fails the warm-up itself.
+func (h *WarmSystemHandler) report(ctx context.Context, cmd *WarmSystemCommand, progress *SystemWarmupProgress) {
+	if h.persister == nil || cmd.ContainerID == "" {
+		return
+	}
+	if err := h.persister.PersistWarmupProgress(ctx, cmd.ContainerID, cmd.PlayerID.Value(), *progress); err != nil {
+		common.LoggerFromContext(ctx).Log("WARNING", "Failed to persist system warm-up progress", map[string]interface{}{
+			"action":       "warm_system_progress",
+			"container_id": cmd.ContainerID,
+			"error":        err.Error(),
+		})
+	}
+}
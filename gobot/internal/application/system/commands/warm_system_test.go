@@ -0,0 +1,195 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/andrescamacho/spacetraders-go/internal/domain/navigation"
+	"github.com/andrescamacho/spacetraders-go/internal/domain/shared"
+	"github.com/andrescamacho/spacetraders-go/internal/domain/system"
+)
+
+type warmGraphProvider struct {
+	graph *system.NavigationGraph
+}
+
+func (p *warmGraphProvider) GetGraph(_ context.Context, _ string, _ bool, _ int) (*system.GraphLoadResult, error) {
+	return &system.GraphLoadResult{Graph: p.graph, Source: "api"}, nil
+}
+
+type warmWaypointRepo struct {
+	system.WaypointRepository
+	waypoints []*shared.Waypoint
+}
+
+func (r *warmWaypointRepo) ListBySystemWithTrait(_ context.Context, _ string, trait string) ([]*shared.Waypoint, error) {
+	var out []*shared.Waypoint
+	for _, wp := range r.waypoints {
+		if wp.HasTrait(trait) {
+			out = append(out, wp)
+		}
+	}
+	return out, nil
+}
+
+type warmShipLocator struct {
+	ships []*navigation.Ship
+}
+
+func (l *warmShipLocator) FindAllByPlayer(_ context.Context, _ shared.PlayerID) ([]*navigation.Ship, error) {
+	return l.ships, nil
+}
+
+type warmMarketScanner struct {
+	scanned []string
+	fresh   map[string]bool
+}
+
+func (s *warmMarketScanner) ScanAndSaveMarketFresh(_ context.Context, _ uint, waypoint string, _ time.Duration) (bool, error) {
+	if s.fresh[waypoint] {
+		return false, nil
+	}
+	s.scanned = append(s.scanned, waypoint)
+	return true, nil
+}
+
+type warmShipyardScanner struct {
+	err error
+}
+
+func (s *warmShipyardScanner) ScanAndSaveShipyard(_ context.Context, _ uint, _ string) error {
+	return s.err
+}
+
+type warmProgressRecorder struct {
+	updates []SystemWarmupProgress
+}
+
+func (r *warmProgressRecorder) PersistWarmupProgress(_ context.Context, _ string, _ int, progress SystemWarmupProgress) error {
+	r.updates = append(r.updates, progress)
+	return nil
+}
+
+func warmWaypoint(t *testing.T, symbol string, traits ...string) *shared.Waypoint {
+	t.Helper()
+	wp, err := shared.NewWaypoint(symbol, 0, 0)
+	if err != nil {
+		t.Fatalf("NewWaypoint(%s): %v", symbol, err)
+	}
+	wp.Traits = traits
+	return wp
+}
+
+func warmShipAt(t *testing.T, symbol string, location *shared.Waypoint, status navigation.NavStatus) *navigation.Ship {
+	t.Helper()
+	fuel, err := shared.NewFuel(100, 100)
+	if err != nil {
+		t.Fatalf("NewFuel: %v", err)
+	}
+	cargo, err := shared.NewCargo(10, 0, nil)
+	if err != nil {
+		t.Fatalf("NewCargo: %v", err)
+	}
+	ship, err := navigation.NewShip(symbol, shared.MustNewPlayerID(1), location, fuel, 100, 10, cargo,
+		10, "FRAME_PROBE", "SATELLITE", nil, status)
+	if err != nil {
+		t.Fatalf("NewShip: %v", err)
+	}
+	return ship
+}
+
+// Waypoints load first; markets and shipyards are only read where a ship is
+// present, the rest counted as deferred; every step is persisted in order.
+func TestWarmSystem_PrefetchesWhereShipsArePresentAndReportsProgress(t *testing.T) {
+	a1 := warmWaypoint(t, "X1-WM-A1", "MARKETPLACE", "SHIPYARD")
+	b2 := warmWaypoint(t, "X1-WM-B2", "MARKETPLACE")
+	c3 := warmWaypoint(t, "X1-WM-C3", "MARKETPLACE")
+	d4 := warmWaypoint(t, "X1-WM-D4", "SHIPYARD")
+	graph := system.NewNavigationGraph("X1-WM")
+	for _, wp := range []*shared.Waypoint{a1, b2, c3, d4} {
+		graph.AddWaypoint(wp)
+	}
+
+	markets := &warmMarketScanner{fresh: map[string]bool{"X1-WM-B2": true}}
+	recorder := &warmProgressRecorder{}
+	handler := NewWarmSystemHandler(
+		&warmGraphProvider{graph: graph},
+		&warmWaypointRepo{waypoints: []*shared.Waypoint{a1, b2, c3, d4}},
+		&warmShipLocator{ships: []*navigation.Ship{
+			warmShipAt(t, "PROBE-1", a1, navigation.NavStatusDocked),
+			warmShipAt(t, "PROBE-2", b2, navigation.NavStatusInOrbit),
+			warmShipAt(t, "PROBE-3", c3, navigation.NavStatusInTransit),
+		}},
+		markets,
+		&warmShipyardScanner{},
+	)
+	handler.SetProgressPersister(recorder)
+
+	resp, err := handler.Handle(context.Background(), &WarmSystemCommand{
+		SystemSymbol: "X1-WM",
+		PlayerID:     shared.MustNewPlayerID(1),
+		ContainerID:  "warm-1",
+		MarketMaxAge: time.Hour,
+	})
+	if err != nil {
+		t.Fatalf("Handle: %v", err)
+	}
+
+	want := SystemWarmupProgress{
+		Phase:             WarmupPhaseDone,
+		Waypoints:         4,
+		MarketsTotal:      3,
+		MarketsScanned:    1,
+		MarketsFresh:      1,
+		MarketsDeferred:   1,
+		ShipyardsTotal:    2,
+		ShipyardsScanned:  1,
+		ShipyardsDeferred: 1,
+	}
+	if got := resp.(*WarmSystemResponse).Progress; got != want {
+		t.Fatalf("progress = %+v, want %+v", got, want)
+	}
+	if len(markets.scanned) != 1 || markets.scanned[0] != "X1-WM-A1" {
+		t.Fatalf("scanned markets = %v, want [X1-WM-A1]", markets.scanned)
+	}
+
+	phases := []string{}
+	for _, u := range recorder.updates {
+		if len(phases) == 0 || phases[len(phases)-1] != u.Phase {
+			phases = append(phases, u.Phase)
+		}
+	}
+	wantPhases := []string{WarmupPhaseWaypoints, WarmupPhaseMarkets, WarmupPhaseShipyards, WarmupPhaseDone}
+	if fmt.Sprint(phases) != fmt.Sprint(wantPhases) {
+		t.Fatalf("persisted phases = %v, want %v", phases, wantPhases)
+	}
+}
+
+// A failed scan is counted and logged but never aborts the warm-up.
+func TestWarmSystem_ScanFailureIsCountedNotFatal(t *testing.T) {
+	a1 := warmWaypoint(t, "X1-WM-A1", "SHIPYARD")
+	graph := system.NewNavigationGraph("X1-WM")
+	graph.AddWaypoint(a1)
+
+	handler := NewWarmSystemHandler(
+		&warmGraphProvider{graph: graph},
+		&warmWaypointRepo{waypoints: []*shared.Waypoint{a1}},
+		&warmShipLocator{ships: []*navigation.Ship{warmShipAt(t, "PROBE-1", a1, navigation.NavStatusDocked)}},
+		&warmMarketScanner{},
+		&warmShipyardScanner{err: fmt.Errorf("rate limited")},
+	)
+
+	resp, err := handler.Handle(context.Background(), &WarmSystemCommand{
+		SystemSymbol: "X1-WM",
+		PlayerID:     shared.MustNewPlayerID(1),
+	})
+	if err != nil {
+		t.Fatalf("Handle: %v", err)
+	}
+	progress := resp.(*WarmSystemResponse).Progress
+	if progress.Failed != 1 || progress.ShipyardsScanned != 0 || progress.Phase != WarmupPhaseDone {
+		t.Fatalf("progress = %+v, want one failure and phase done", progress)
+	}
+}
@@ -0,0 +1,307 @@
+package queries
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/andrescamacho/spacetraders-go/internal/application/common"
+	"github.com/andrescamacho/spacetraders-go/internal/domain/navigation"
+	domainRouting "github.com/andrescamacho/spacetraders-go/internal/domain/routing"
+	"github.com/andrescamacho/spacetraders-go/internal/domain/shared"
+	"github.com/andrescamacho/spacetraders-go/internal/domain/system"
+)
+
+// GraphExportFormat is the text format a system graph is exported in.
+type GraphExportFormat string
+
+const (
+	GraphExportDOT     GraphExportFormat = "dot"
+	GraphExportGraphML GraphExportFormat = "graphml"
+)
+
+// DefaultGraphExportEngineSpeed prices edge travel times when the query names
+// neither an engine speed nor a ship: the speed of the common starter engines.
+const DefaultGraphExportEngineSpeed = 30
+
+// GraphExportEnricher overlays cached waypoint traits on a graph's waypoints.
+// Satisfied by *ship.WaypointEnricher.
+type GraphExportEnricher interface {
+	EnrichGraphWaypoints(ctx context.Context, graph *system.NavigationGraph, systemSymbol string) (map[string]*shared.Waypoint, error)
+}
+
+// GraphExportShipReader loads the ship a route overlay is planned for.
+type GraphExportShipReader interface {
+	FindBySymbol(ctx context.Context, symbol string, playerID shared.PlayerID) (*navigation.Ship, error)
+}
+
+// GraphExportRoutePlanner plans (without flying) the overlaid route.
+// Satisfied by *ship.RoutePlanner.
+type GraphExportRoutePlanner interface {
+	PlanRoute(ctx context.Context, ship *navigation.Ship, destination string, waypoints map[string]*shared.Waypoint, preferCruise bool) (*navigation.Route, error)
+}
+
+// ExportSystemGraphQuery renders a system's navigation graph as DOT or
+// GraphML for visualising routing problems: every waypoint with its
+// coordinates, type and traits, every edge with its distance and CRUISE fuel
+// and travel time (DRIFT time alongside), and optionally the route the
+// planner would fly a ship to Destination, highlighted hop by hop.
+type ExportSystemGraphQuery struct {
+	PlayerID shared.PlayerID
+	// SystemSymbol defaults to the overlay ship's system.
+	SystemSymbol string
+	// Format defaults to GraphExportDOT.
+	Format GraphExportFormat
+	// EngineSpeed prices edge travel times; 0 uses the overlay ship's engine,
+	// or DefaultGraphExportEngineSpeed without one.
+	EngineSpeed int
+
+	// ShipSymbol and Destination, set together, overlay the route the planner
+	// computes for ShipSymbol to Destination. The route is planned, not flown.
+	ShipSymbol  string
+	Destination string
+}
+
+// GraphExportHop is one hop of the overlaid route.
+type GraphExportHop struct {
+	From       string
+	To         string
+	FlightMode string
+	Fuel       int
+	Seconds    int
+	Refuel     bool // refuels at To
+}
+
+// ExportSystemGraphResponse carries the rendered graph.
+type ExportSystemGraphResponse struct {
+	SystemSymbol string
+	Format       GraphExportFormat
+	Content      string
+	Nodes        int
+	Edges        int
+	Route        []GraphExportHop // empty without an overlay
+}
+
+// ExportSystemGraphHandler handles ExportSystemGraphQuery.
+type ExportSystemGraphHandler struct {
+	graphs   system.ISystemGraphProvider
+	enricher GraphExportEnricher
+	ships    GraphExportShipReader
+	planner  GraphExportRoutePlanner
+}
+
+// NewExportSystemGraphHandler creates the handler. ships and planner are only
+// needed for route overlays; with either nil an overlay request fails.
+func NewExportSystemGraphHandler(
+	graphs system.ISystemGraphProvider,
+	enricher GraphExportEnricher,
+	ships GraphExportShipReader,
+	planner GraphExportRoutePlanner,
+) *ExportSystemGraphHandler {
+	return &ExportSystemGraphHandler{graphs: graphs, enricher: enricher, ships: ships, planner: planner}
+}
+
+// Handle loads the system graph, plans the overlay if asked, and renders both.
+func (h *ExportSystemGraphHandler) Handle(ctx context.Context, request common.Request) (common.Response, error) {
+	query, ok := request.(*ExportSystemGraphQuery)
+	if !ok {
+		return nil, fmt.Errorf("invalid request type: expected *ExportSystemGraphQuery")
+	}
+	format := query.Format
+	if format == "" {
+		format = GraphExportDOT
+	}
+	if format != GraphExportDOT && format != GraphExportGraphML {
+		return nil, fmt.Errorf("unsupported graph format %q (want %q or %q)", format, GraphExportDOT, GraphExportGraphML)
+	}
+	if (query.ShipSymbol == "") != (query.Destination == "") {
+		return nil, fmt.Errorf("a route overlay needs both ship_symbol and destination")
+	}
+
+	var ship *navigation.Ship
+	systemSymbol := query.SystemSymbol
+	if query.ShipSymbol != "" {
+		if h.ships == nil || h.planner == nil {
+			return nil, fmt.Errorf("route overlays are not available")
+		}
+		var err error
+		ship, err = h.ships.FindBySymbol(ctx, query.ShipSymbol, query.PlayerID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to find ship: %w", err)
+		}
+		if systemSymbol == "" {
+			systemSymbol = ship.CurrentLocation().SystemSymbol
+		}
+		if ship.CurrentLocation().SystemSymbol != systemSymbol || shared.ExtractSystemSymbol(query.Destination) != systemSymbol {
+			return nil, fmt.Errorf("route overlay must start and end in %s", systemSymbol)
+		}
+	}
+	if systemSymbol == "" {
+		return nil, fmt.Errorf("system_symbol is required")
+	}
+
+	graphResult, err := h.graphs.GetGraph(ctx, systemSymbol, false, query.PlayerID.Value())
+	if err != nil {
+		return nil, fmt.Errorf("failed to load graph for %s: %w", systemSymbol, err)
+	}
+	waypoints := graphResult.Graph.Waypoints
+	if h.enricher != nil {
+		if enriched, err := h.enricher.EnrichGraphWaypoints(ctx, graphResult.Graph, systemSymbol); err == nil {
+			waypoints = enriched
+		}
+	}
+
+	engineSpeed := query.EngineSpeed
+	if engineSpeed <= 0 && ship != nil {
+		engineSpeed = ship.EngineSpeed()
+	}
+	if engineSpeed <= 0 {
+		engineSpeed = DefaultGraphExportEngineSpeed
+	}
+
+	var route []GraphExportHop
+	if ship != nil {
+		planned, err := h.planner.PlanRoute(ctx, ship, query.Destination, waypoints, false)
+		if err != nil {
+			return nil, fmt.Errorf("failed to plan overlay route: %w", err)
+		}
+		for _, segment := range planned.Segments() {
+			route = append(route, GraphExportHop{
+				From:       segment.FromWaypoint.Symbol,
+				To:         segment.ToWaypoint.Symbol,
+				FlightMode: segment.FlightMode.Name(),
+				Fuel:       segment.FuelRequired,
+				Seconds:    segment.TravelTime,
+				Refuel:     segment.RequiresRefuel,
+			})
+		}
+	}
+
+	export := buildGraphExport(systemSymbol, waypoints, graphResult.Graph.Edges, engineSpeed, route)
+	content := export.dot()
+	if format == GraphExportGraphML {
+		content = export.graphML()
+	}
+	return &ExportSystemGraphResponse{
+		SystemSymbol: systemSymbol,
+		Format:       format,
+		Content:      content,
+		Nodes:        len(export.nodes),
+		Edges:        len(export.edges),
+		Route:        route,
+	}, nil
+}
+
+// graphExport is the format-neutral graph both renderers write out, with
+// nodes and edges sorted so an export diffs cleanly against the last one.
+type graphExport struct {
+	systemSymbol string
+	engineSpeed  int
+	nodes        []graphExportNode
+	edges        []graphExportEdge
+}
+
+type graphExportNode struct {
+	symbol     string
+	x, y       float64
+	kind       string
+	traits     string
+	hasFuel    bool
+	routeIndex int // position along the overlay, 1-based; 0 when off it
+}
+
+type graphExportEdge struct {
+	from, to  string
+	kind      string
+	distance  float64
+	fuel      int
+	seconds   int
+	driftSecs int
+	routeStep int // overlay hop number, 1-based; 0 when off it
+	routeMode string
+}
+
+// buildGraphExport collapses the graph's edge pairs into one undirected edge
+// each and marks the overlay on nodes and edges. A hop the graph has no edge
+// for is added as a "route" edge so the overlay is always drawn whole.
+func buildGraphExport(systemSymbol string, waypoints map[string]*shared.Waypoint, edges []system.GraphEdge, engineSpeed int, route []GraphExportHop) *graphExport {
+	export := &graphExport{systemSymbol: systemSymbol, engineSpeed: engineSpeed}
+
+	routeIndex := make(map[string]int)
+	hopOf := make(map[[2]string]int)
+	for i, hop := range route {
+		if _, ok := routeIndex[hop.From]; !ok {
+			routeIndex[hop.From] = len(routeIndex) + 1
+		}
+		if _, ok := routeIndex[hop.To]; !ok {
+			routeIndex[hop.To] = len(routeIndex) + 1
+		}
+		hopOf[undirectedKey(hop.From, hop.To)] = i + 1
+	}
+
+	for _, wp := range waypoints {
+		export.nodes = append(export.nodes, graphExportNode{
+			symbol:     wp.Symbol,
+			x:          wp.X,
+			y:          wp.Y,
+			kind:       wp.Type,
+			traits:     strings.Join(wp.Traits, ","),
+			hasFuel:    wp.HasFuel,
+			routeIndex: routeIndex[wp.Symbol],
+		})
+	}
+	sort.Slice(export.nodes, func(i, j int) bool { return export.nodes[i].symbol < export.nodes[j].symbol })
+
+	seen := make(map[[2]string]bool, len(edges)/2)
+	addEdge := func(from, to, kind string, distance float64) {
+		key := undirectedKey(from, to)
+		if seen[key] {
+			return
+		}
+		seen[key] = true
+		cell := domainRouting.NewMatrixCell(distance, engineSpeed)
+		edge := graphExportEdge{
+			from:      key[0],
+			to:        key[1],
+			kind:      kind,
+			distance:  distance,
+			fuel:      cell.FuelCost(shared.FlightModeCruise),
+			seconds:   cell.TravelTime(shared.FlightModeCruise),
+			driftSecs: cell.TravelTime(shared.FlightModeDrift),
+		}
+		if step, ok := hopOf[key]; ok {
+			hop := route[step-1]
+			edge.from, edge.to = hop.From, hop.To
+			edge.routeStep, edge.routeMode = step, hop.FlightMode
+		}
+		export.edges = append(export.edges, edge)
+	}
+	for _, edge := range edges {
+		addEdge(edge.From, edge.To, string(edge.Type), edge.Distance)
+	}
+	for _, hop := range route {
+		from, to := waypoints[hop.From], waypoints[hop.To]
+		distance := 0.0
+		if from != nil && to != nil {
+			distance = from.DistanceTo(to)
+		}
+		addEdge(hop.From, hop.To, "route", distance)
+	}
+	sort.Slice(export.edges, func(i, j int) bool {
+		a, b := export.edges[i], export.edges[j]
+		ka, kb := undirectedKey(a.from, a.to), undirectedKey(b.from, b.to)
+		if ka[0] != kb[0] {
+			return ka[0] < kb[0]
+		}
+		return ka[1] < kb[1]
+	})
+	return export
+}
+
+func undirectedKey(a, b string) [2]string {
+	if b < a {
+		a, b = b, a
+	}
+	return [2]string{a, b}
+}
@@ -0,0 +1,132 @@
+package queries
+
+import (
+	"bytes"
+	"encoding/xml"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// dot renders the graph as an undirected Graphviz graph. Node positions are
+// pinned to their coordinates (render with neato -n or fdp), and the overlay
+// is drawn as red, directed, numbered edges over red-outlined waypoints.
+func (g *graphExport) dot() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "graph %s {\n", dotQuote(g.systemSymbol))
+	fmt.Fprintf(&b, "  graph [layout=neato, overlap=true, engine_speed=%d];\n", g.engineSpeed)
+	b.WriteString("  node [shape=circle, fontsize=8];\n")
+	b.WriteString("  edge [color=gray80];\n")
+	for _, n := range g.nodes {
+		attrs := []string{
+			"pos=" + dotQuote(fmt.Sprintf("%s,%s!", formatFloat(n.x), formatFloat(n.y))),
+			"label=" + dotQuote(n.symbol+`\n`+n.kind),
+			"type=" + dotQuote(n.kind),
+			"traits=" + dotQuote(n.traits),
+			"has_fuel=" + strconv.FormatBool(n.hasFuel),
+		}
+		if n.hasFuel {
+			attrs = append(attrs, "style=filled", "fillcolor=lightyellow")
+		}
+		if n.routeIndex > 0 {
+			attrs = append(attrs, "color=red", "penwidth=2", "route_index="+strconv.Itoa(n.routeIndex))
+		}
+		fmt.Fprintf(&b, "  %s [%s];\n", dotQuote(n.symbol), strings.Join(attrs, ", "))
+	}
+	for _, e := range g.edges {
+		attrs := []string{
+			"type=" + dotQuote(e.kind),
+			"distance=" + formatFloat(e.distance),
+			"fuel=" + strconv.Itoa(e.fuel),
+			"time=" + strconv.Itoa(e.seconds),
+			"drift_time=" + strconv.Itoa(e.driftSecs),
+		}
+		if e.routeStep > 0 {
+			attrs = append(attrs,
+				"color=red", "penwidth=3", "dir=forward",
+				"label="+dotQuote(fmt.Sprintf("%d %s", e.routeStep, e.routeMode)),
+				"route_step="+strconv.Itoa(e.routeStep),
+			)
+		}
+		fmt.Fprintf(&b, "  %s -- %s [%s];\n", dotQuote(e.from), dotQuote(e.to), strings.Join(attrs, ", "))
+	}
+	b.WriteString("}\n")
+	return b.String()
+}
+
+// graphMLKeys declares every attribute graphML writes, in output order.
+var graphMLKeys = []struct{ id, domain, kind string }{
+	{"x", "node", "double"},
+	{"y", "node", "double"},
+	{"type", "node", "string"},
+	{"traits", "node", "string"},
+	{"has_fuel", "node", "boolean"},
+	{"route_index", "node", "int"},
+	{"edge_type", "edge", "string"},
+	{"distance", "edge", "double"},
+	{"fuel", "edge", "int"},
+	{"time", "edge", "int"},
+	{"drift_time", "edge", "int"},
+	{"route_step", "edge", "int"},
+	{"route_mode", "edge", "string"},
+}
+
+// graphML renders the graph as GraphML. Overlay attributes (route_index,
+// route_step, route_mode) are only written on the waypoints and edges the
+// route uses.
+func (g *graphExport) graphML() string {
+	var b strings.Builder
+	b.WriteString(xml.Header)
+	b.WriteString(`<graphml xmlns="http://graphml.graphdrawing.org/xmlns">` + "\n")
+	for _, k := range graphMLKeys {
+		fmt.Fprintf(&b, `  <key id="%s" for="%s" attr.name="%s" attr.type="%s"/>`+"\n", k.id, k.domain, k.id, k.kind)
+	}
+	fmt.Fprintf(&b, `  <graph id="%s" edgedefault="undirected">`+"\n", xmlEscape(g.systemSymbol))
+	for _, n := range g.nodes {
+		fmt.Fprintf(&b, `    <node id="%s">`+"\n", xmlEscape(n.symbol))
+		graphMLData(&b, "x", formatFloat(n.x))
+		graphMLData(&b, "y", formatFloat(n.y))
+		graphMLData(&b, "type", n.kind)
+		graphMLData(&b, "traits", n.traits)
+		graphMLData(&b, "has_fuel", strconv.FormatBool(n.hasFuel))
+		if n.routeIndex > 0 {
+			graphMLData(&b, "route_index", strconv.Itoa(n.routeIndex))
+		}
+		b.WriteString("    </node>\n")
+	}
+	for _, e := range g.edges {
+		fmt.Fprintf(&b, `    <edge source="%s" target="%s">`+"\n", xmlEscape(e.from), xmlEscape(e.to))
+		graphMLData(&b, "edge_type", e.kind)
+		graphMLData(&b, "distance", formatFloat(e.distance))
+		graphMLData(&b, "fuel", strconv.Itoa(e.fuel))
+		graphMLData(&b, "time", strconv.Itoa(e.seconds))
+		graphMLData(&b, "drift_time", strconv.Itoa(e.driftSecs))
+		if e.routeStep > 0 {
+			graphMLData(&b, "route_step", strconv.Itoa(e.routeStep))
+			graphMLData(&b, "route_mode", e.routeMode)
+		}
+		b.WriteString("    </edge>\n")
+	}
+	b.WriteString("  </graph>\n</graphml>\n")
+	return b.String()
+}
+
+func graphMLData(b *strings.Builder, key, value string) {
+	fmt.Fprintf(b, `      <data key="%s">%s</data>`+"\n", key, xmlEscape(value))
+}
+
+func xmlEscape(s string) string {
+	var buf bytes.Buffer
+	_ = xml.EscapeText(&buf, []byte(s))
+	return buf.String()
+}
+
+// dotQuote wraps s as a DOT string. `\n` sequences are left alone, since DOT
+// reads them as label line breaks.
+func dotQuote(s string) string {
+	return `"` + strings.ReplaceAll(s, `"`, `\"`) + `"`
+}
+
+func formatFloat(f float64) string {
+	return strconv.FormatFloat(f, 'f', -1, 64)
+}
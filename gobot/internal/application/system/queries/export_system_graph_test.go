@@ -0,0 +1,121 @@
+package queries
+
+import (
+	"context"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/andrescamacho/spacetraders-go/internal/domain/navigation"
+	"github.com/andrescamacho/spacetraders-go/internal/domain/shared"
+	"github.com/andrescamacho/spacetraders-go/internal/domain/system"
+)
+
+func exportTestGraph(t *testing.T) *system.NavigationGraph {
+	t.Helper()
+	graph := system.NewNavigationGraph("X1-GX")
+	for _, wp := range []struct {
+		symbol string
+		x, y   float64
+		fuel   bool
+	}{{"X1-GX-A", 0, 0, false}, {"X1-GX-B", 30, 40, true}, {"X1-GX-C", 60, 80, false}} {
+		w := waypointWith(t, wp.symbol, "PLANET", []string{"MARKETPLACE"})
+		w.X, w.Y, w.HasFuel = wp.x, wp.y, wp.fuel
+		graph.AddWaypoint(w)
+	}
+	graph.AddEdge("X1-GX-A", "X1-GX-B", 50, system.EdgeTypeNormal)
+	graph.AddEdge("X1-GX-B", "X1-GX-C", 50, system.EdgeTypeNormal)
+	graph.AddEdge("X1-GX-A", "X1-GX-C", 100, system.EdgeTypeNormal)
+	return graph
+}
+
+type exportShipReader struct{ ship *navigation.Ship }
+
+func (r exportShipReader) FindBySymbol(context.Context, string, shared.PlayerID) (*navigation.Ship, error) {
+	return r.ship, nil
+}
+
+// exportPlanner routes A → B → C, refuelling at B.
+type exportPlanner struct{}
+
+func (exportPlanner) PlanRoute(_ context.Context, ship *navigation.Ship, _ string, waypoints map[string]*shared.Waypoint, _ bool) (*navigation.Route, error) {
+	a, b, c := waypoints["X1-GX-A"], waypoints["X1-GX-B"], waypoints["X1-GX-C"]
+	return navigation.NewRoute("r", ship.ShipSymbol(), 1, []*navigation.RouteSegment{
+		navigation.NewRouteSegment(a, b, 50, 50, 120, shared.FlightModeCruise, true),
+		navigation.NewRouteSegment(b, c, 50, 50, 120, shared.FlightModeCruise, false),
+	}, 100, false)
+}
+
+// Each edge pair is exported once, weighted with CRUISE fuel and time; the
+// overlay marks its two hops and leaves the direct A–C edge alone.
+func TestExportSystemGraph_DOTWithRouteOverlay(t *testing.T) {
+	start, _ := shared.NewWaypoint("X1-GX-A", 0, 0)
+	fuel, _ := shared.NewFuel(100, 100)
+	cargo, _ := shared.NewCargo(40, 0, nil)
+	ship, err := navigation.NewShip("SHIP-1", shared.MustNewPlayerID(1), start, fuel, 100, 40, cargo, 30, "FRAME_FRIGATE", "HAULER", nil, navigation.NavStatusInOrbit)
+	if err != nil {
+		t.Fatalf("NewShip: %v", err)
+	}
+	handler := NewExportSystemGraphHandler(&stubGraphProvider{graph: exportTestGraph(t)}, nil, exportShipReader{ship}, exportPlanner{})
+
+	resp, err := handler.Handle(context.Background(), &ExportSystemGraphQuery{
+		PlayerID: shared.MustNewPlayerID(1), ShipSymbol: "SHIP-1", Destination: "X1-GX-C",
+	})
+	if err != nil {
+		t.Fatalf("Handle: %v", err)
+	}
+	export := resp.(*ExportSystemGraphResponse)
+	if export.SystemSymbol != "X1-GX" || export.Format != GraphExportDOT || export.Nodes != 3 || export.Edges != 3 {
+		t.Fatalf("unexpected export summary: %+v", export)
+	}
+	if len(export.Route) != 2 || !export.Route[0].Refuel {
+		t.Fatalf("expected two hops refuelling at B, got %+v", export.Route)
+	}
+
+	cruiseFuel := shared.FlightModeCruise.FuelCost(50)
+	cruiseTime := shared.FlightModeCruise.TravelTime(50, 30)
+	for _, want := range []string{
+		`graph "X1-GX" {`,
+		`"X1-GX-B" [pos="30,40!"`,
+		`"X1-GX-A" -- "X1-GX-B" [type="normal", distance=50, fuel=` + strconv.Itoa(cruiseFuel) + `, time=` + strconv.Itoa(cruiseTime),
+		`label="1 CRUISE", route_step=1`,
+		`label="2 CRUISE", route_step=2`,
+	} {
+		if !strings.Contains(export.Content, want) {
+			t.Fatalf("DOT output missing %q:\n%s", want, export.Content)
+		}
+	}
+	if strings.Count(export.Content, "route_step=") != 2 {
+		t.Fatalf("only the two route hops should be marked:\n%s", export.Content)
+	}
+}
+
+func TestExportSystemGraph_GraphML(t *testing.T) {
+	handler := NewExportSystemGraphHandler(&stubGraphProvider{graph: exportTestGraph(t)}, nil, nil, nil)
+
+	resp, err := handler.Handle(context.Background(), &ExportSystemGraphQuery{
+		PlayerID: shared.MustNewPlayerID(1), SystemSymbol: "X1-GX", Format: GraphExportGraphML,
+	})
+	if err != nil {
+		t.Fatalf("Handle: %v", err)
+	}
+	content := resp.(*ExportSystemGraphResponse).Content
+	for _, want := range []string{
+		`<graph id="X1-GX" edgedefault="undirected">`,
+		`<node id="X1-GX-B">`,
+		`<data key="has_fuel">true</data>`,
+		`<edge source="X1-GX-A" target="X1-GX-C">`,
+		`<data key="distance">100</data>`,
+	} {
+		if !strings.Contains(content, want) {
+			t.Fatalf("GraphML output missing %q:\n%s", want, content)
+		}
+	}
+	if strings.Count(content, "<edge ") != 3 {
+		t.Fatalf("expected three undirected edges:\n%s", content)
+	}
+
+	if _, err := handler.Handle(context.Background(), &ExportSystemGraphQuery{SystemSymbol: "X1-GX", ShipSymbol: "SHIP-1", Destination: "X1-GX-C"}); err == nil {
+		t.Fatal("an overlay without a planner should fail")
+	}
+}
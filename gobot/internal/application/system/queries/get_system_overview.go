@@ -0,0 +1,282 @@
+package queries
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/andrescamacho/spacetraders-go/internal/adapters/persistence"
+	"github.com/andrescamacho/spacetraders-go/internal/application/common"
+	"github.com/andrescamacho/spacetraders-go/internal/domain/container"
+	"github.com/andrescamacho/spacetraders-go/internal/domain/contract"
+	"github.com/andrescamacho/spacetraders-go/internal/domain/market"
+	"github.com/andrescamacho/spacetraders-go/internal/domain/navigation"
+	"github.com/andrescamacho/spacetraders-go/internal/domain/shared"
+	"github.com/andrescamacho/spacetraders-go/internal/domain/trading"
+)
+
+// DefaultSystemOverviewLanes is how many arbitrage lanes an overview lists when
+// the query names no limit.
+const DefaultSystemOverviewLanes = 5
+
+// SystemOverviewShipReader lists the player's ships.
+type SystemOverviewShipReader interface {
+	FindAllByPlayer(ctx context.Context, playerID shared.PlayerID) ([]*navigation.Ship, error)
+}
+
+// SystemOverviewContainerReader lists containers by status.
+type SystemOverviewContainerReader interface {
+	ListByStatus(ctx context.Context, status container.ContainerStatus, playerID *int) ([]*persistence.ContainerModel, error)
+}
+
+// SystemOverviewMarketReader reads the system's cached markets.
+type SystemOverviewMarketReader interface {
+	FindAllMarketsInSystem(ctx context.Context, systemSymbol string, playerID int) ([]string, error)
+	GetMarketData(ctx context.Context, waypointSymbol string, playerID int) (*market.Market, error)
+}
+
+// SystemOverviewContractReader lists the player's accepted, unfulfilled contracts.
+type SystemOverviewContractReader interface {
+	FindActiveContracts(ctx context.Context, playerID int) ([]*contract.Contract, error)
+}
+
+// SystemOverviewLaneReader ranks the system's tradeable arbitrage lanes.
+type SystemOverviewLaneReader interface {
+	ProfitableLanesInSystem(ctx context.Context, playerID int, systemSymbol string) ([]trading.ArbitrageLane, error)
+}
+
+// GetSystemOverviewQuery gathers everything the fleet has going on in one
+// system in a single call: the ships there and what they are doing, the
+// running containers working it, how fresh its market cache is, the open
+// contract deliveries bound for it and its best arbitrage lanes.
+type GetSystemOverviewQuery struct {
+	PlayerID     shared.PlayerID
+	SystemSymbol string
+	// MaxLanes caps the arbitrage lanes listed; 0 means DefaultSystemOverviewLanes.
+	MaxLanes int
+}
+
+// SystemOverviewShip is one ship in the system.
+type SystemOverviewShip struct {
+	Symbol      string
+	Role        string
+	Location    string
+	NavStatus   string
+	ContainerID string
+	// Task is the command type of the running container the ship works for,
+	// "captain" for a captain reservation, or empty when the ship is idle.
+	Task string
+}
+
+// SystemOverviewContainer is one running container working the system, either
+// through a ship there or because its config names the system.
+type SystemOverviewContainer struct {
+	ID            string
+	ContainerType string
+	CommandType   string
+	ShipsInSystem int
+	StartedAt     *time.Time
+}
+
+// SystemMarketFreshness summarises the age of the system's cached markets.
+// Ages are zero when no market has been scanned.
+type SystemMarketFreshness struct {
+	Markets   int
+	Scanned   int
+	NewestAge time.Duration
+	MedianAge time.Duration
+	OldestAge time.Duration
+}
+
+// SystemOverviewDelivery is one outstanding contract delivery to a waypoint in
+// the system.
+type SystemOverviewDelivery struct {
+	ContractID     string
+	Good           string
+	Destination    string
+	UnitsRequired  int
+	UnitsFulfilled int
+	Deadline       string
+}
+
+// GetSystemOverviewResponse is the system view.
+type GetSystemOverviewResponse struct {
+	SystemSymbol string
+	Ships        []SystemOverviewShip
+	Containers   []SystemOverviewContainer
+	Markets      SystemMarketFreshness
+	Deliveries   []SystemOverviewDelivery
+	Lanes        []trading.ArbitrageLane
+}
+
+// GetSystemOverviewHandler handles the GetSystemOverview query
+type GetSystemOverviewHandler struct {
+	ships      SystemOverviewShipReader
+	containers SystemOverviewContainerReader
+	markets    SystemOverviewMarketReader
+	contracts  SystemOverviewContractReader
+	lanes      SystemOverviewLaneReader
+	clock      shared.Clock
+}
+
+// NewGetSystemOverviewHandler creates a new GetSystemOverviewHandler.
+// The clock parameter is optional - if nil, defaults to RealClock.
+func NewGetSystemOverviewHandler(
+	ships SystemOverviewShipReader,
+	containers SystemOverviewContainerReader,
+	markets SystemOverviewMarketReader,
+	contracts SystemOverviewContractReader,
+	lanes SystemOverviewLaneReader,
+	clock shared.Clock,
+) *GetSystemOverviewHandler {
+	if clock == nil {
+		clock = shared.NewRealClock()
+	}
+	return &GetSystemOverviewHandler{
+		ships:      ships,
+		containers: containers,
+		markets:    markets,
+		contracts:  contracts,
+		lanes:      lanes,
+		clock:      clock,
+	}
+}
+
+// Handle executes the GetSystemOverview query
+func (h *GetSystemOverviewHandler) Handle(ctx context.Context, request common.Request) (common.Response, error) {
+	query, ok := request.(*GetSystemOverviewQuery)
+	if !ok {
+		return nil, fmt.Errorf("invalid request type: expected *GetSystemOverviewQuery")
+	}
+	if query.SystemSymbol == "" {
+		return nil, fmt.Errorf("system_symbol is required")
+	}
+	playerID := query.PlayerID.Value()
+	resp := &GetSystemOverviewResponse{SystemSymbol: query.SystemSymbol}
+
+	running, err := h.containers.ListByStatus(ctx, container.ContainerStatusRunning, &playerID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list running containers: %w", err)
+	}
+	byID := make(map[string]*persistence.ContainerModel, len(running))
+	for _, model := range running {
+		byID[model.ID] = model
+	}
+
+	ships, err := h.ships.FindAllByPlayer(ctx, query.PlayerID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list ships: %w", err)
+	}
+	shipsPerContainer := make(map[string]int)
+	for _, ship := range ships {
+		location := ship.CurrentLocation()
+		if location == nil || location.SystemSymbol != query.SystemSymbol {
+			continue
+		}
+		entry := SystemOverviewShip{
+			Symbol:      ship.ShipSymbol(),
+			Role:        ship.Role(),
+			Location:    location.Symbol,
+			NavStatus:   string(ship.NavStatus()),
+			ContainerID: ship.ContainerID(),
+		}
+		if model, ok := byID[entry.ContainerID]; ok {
+			entry.Task = model.CommandType
+			shipsPerContainer[model.ID]++
+		} else if ship.IsReservedByCaptain() {
+			entry.Task = "captain"
+		}
+		resp.Ships = append(resp.Ships, entry)
+	}
+	sort.Slice(resp.Ships, func(i, j int) bool { return resp.Ships[i].Symbol < resp.Ships[j].Symbol })
+
+	for _, model := range running {
+		if shipsPerContainer[model.ID] == 0 && !configNamesSystem(model.Config, query.SystemSymbol) {
+			continue
+		}
+		resp.Containers = append(resp.Containers, SystemOverviewContainer{
+			ID:            model.ID,
+			ContainerType: model.ContainerType,
+			CommandType:   model.CommandType,
+			ShipsInSystem: shipsPerContainer[model.ID],
+			StartedAt:     model.StartedAt,
+		})
+	}
+	sort.Slice(resp.Containers, func(i, j int) bool { return resp.Containers[i].ID < resp.Containers[j].ID })
+
+	if resp.Markets, err = h.marketFreshness(ctx, playerID, query.SystemSymbol); err != nil {
+		return nil, err
+	}
+
+	contracts, err := h.contracts.FindActiveContracts(ctx, playerID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list active contracts: %w", err)
+	}
+	for _, c := range contracts {
+		for _, delivery := range c.Terms().Deliveries {
+			if delivery.UnitsFulfilled >= delivery.UnitsRequired ||
+				shared.ExtractSystemSymbol(delivery.DestinationSymbol) != query.SystemSymbol {
+				continue
+			}
+			resp.Deliveries = append(resp.Deliveries, SystemOverviewDelivery{
+				ContractID:     c.ContractID(),
+				Good:           delivery.TradeSymbol,
+				Destination:    delivery.DestinationSymbol,
+				UnitsRequired:  delivery.UnitsRequired,
+				UnitsFulfilled: delivery.UnitsFulfilled,
+				Deadline:       c.Terms().Deadline,
+			})
+		}
+	}
+
+	lanes, err := h.lanes.ProfitableLanesInSystem(ctx, playerID, query.SystemSymbol)
+	if err != nil {
+		return nil, fmt.Errorf("failed to rank arbitrage lanes: %w", err)
+	}
+	maxLanes := query.MaxLanes
+	if maxLanes <= 0 {
+		maxLanes = DefaultSystemOverviewLanes
+	}
+	if len(lanes) > maxLanes {
+		lanes = lanes[:maxLanes]
+	}
+	resp.Lanes = lanes
+
+	return resp, nil
+}
+
+// marketFreshness reads every cached market in the system and summarises
+// their ages. Unreadable markets count as unscanned.
+func (h *GetSystemOverviewHandler) marketFreshness(ctx context.Context, playerID int, systemSymbol string) (SystemMarketFreshness, error) {
+	waypoints, err := h.markets.FindAllMarketsInSystem(ctx, systemSymbol, playerID)
+	if err != nil {
+		return SystemMarketFreshness{}, fmt.Errorf("failed to list markets in %s: %w", systemSymbol, err)
+	}
+	freshness := SystemMarketFreshness{Markets: len(waypoints)}
+	now := h.clock.Now()
+	var ages []time.Duration
+	for _, waypoint := range waypoints {
+		mkt, err := h.markets.GetMarketData(ctx, waypoint, playerID)
+		if err != nil || mkt == nil || mkt.LastUpdated().IsZero() {
+			continue
+		}
+		ages = append(ages, now.Sub(mkt.LastUpdated()))
+	}
+	if len(ages) == 0 {
+		return freshness, nil
+	}
+	sort.Slice(ages, func(i, j int) bool { return ages[i] < ages[j] })
+	freshness.Scanned = len(ages)
+	freshness.NewestAge = ages[0]
+	freshness.MedianAge = ages[len(ages)/2]
+	freshness.OldestAge = ages[len(ages)-1]
+	return freshness, nil
+}
+
+// configNamesSystem reports whether a container's JSON config mentions the
+// system itself or one of its waypoints.
+func configNamesSystem(config, systemSymbol string) bool {
+	return strings.Contains(config, `"`+systemSymbol+`"`) || strings.Contains(config, `"`+systemSymbol+`-`)
+}
@@ -0,0 +1,156 @@
+package queries
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/andrescamacho/spacetraders-go/internal/adapters/persistence"
+	"github.com/andrescamacho/spacetraders-go/internal/domain/container"
+	"github.com/andrescamacho/spacetraders-go/internal/domain/contract"
+	"github.com/andrescamacho/spacetraders-go/internal/domain/market"
+	"github.com/andrescamacho/spacetraders-go/internal/domain/navigation"
+	"github.com/andrescamacho/spacetraders-go/internal/domain/shared"
+	"github.com/andrescamacho/spacetraders-go/internal/domain/trading"
+)
+
+type overviewShips []*navigation.Ship
+
+func (s overviewShips) FindAllByPlayer(context.Context, shared.PlayerID) ([]*navigation.Ship, error) {
+	return s, nil
+}
+
+type overviewContainers []*persistence.ContainerModel
+
+func (c overviewContainers) ListByStatus(context.Context, container.ContainerStatus, *int) ([]*persistence.ContainerModel, error) {
+	return c, nil
+}
+
+type overviewMarkets map[string]*market.Market
+
+func (m overviewMarkets) FindAllMarketsInSystem(context.Context, string, int) ([]string, error) {
+	var waypoints []string
+	for waypoint := range m {
+		waypoints = append(waypoints, waypoint)
+	}
+	return waypoints, nil
+}
+
+func (m overviewMarkets) GetMarketData(_ context.Context, waypoint string, _ int) (*market.Market, error) {
+	return m[waypoint], nil
+}
+
+type overviewContracts []*contract.Contract
+
+func (c overviewContracts) FindActiveContracts(context.Context, int) ([]*contract.Contract, error) {
+	return c, nil
+}
+
+type overviewLanes []trading.ArbitrageLane
+
+func (l overviewLanes) ProfitableLanesInSystem(context.Context, int, string) ([]trading.ArbitrageLane, error) {
+	return l, nil
+}
+
+func overviewShip(t *testing.T, symbol, location, containerID string) *navigation.Ship {
+	t.Helper()
+	wp, _ := shared.NewWaypoint(location, 0, 0)
+	fuel, _ := shared.NewFuel(100, 100)
+	cargo, _ := shared.NewCargo(40, 0, nil)
+	ship, err := navigation.NewShip(symbol, shared.MustNewPlayerID(1), wp, fuel, 100, 40, cargo, 30, "FRAME_FRIGATE", "HAULER", nil, navigation.NavStatusInOrbit)
+	if err != nil {
+		t.Fatalf("NewShip(%s): %v", symbol, err)
+	}
+	if containerID != "" {
+		if err := ship.AssignToContainer(containerID, shared.NewRealClock()); err != nil {
+			t.Fatalf("AssignToContainer: %v", err)
+		}
+	}
+	return ship
+}
+
+func TestGetSystemOverview_AggregatesTheSystem(t *testing.T) {
+	now := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	mkt := func(symbol string, age time.Duration) *market.Market {
+		m, err := market.NewMarket(symbol, nil, now.Add(-age))
+		if err != nil {
+			t.Fatalf("NewMarket: %v", err)
+		}
+		return m
+	}
+	delivery, err := contract.NewContract("C-1", shared.MustNewPlayerID(1), "COSMIC", "PROCUREMENT", contract.Terms{
+		Deliveries: []contract.Delivery{
+			{TradeSymbol: "IRON_ORE", DestinationSymbol: "X1-AA-H1", UnitsRequired: 50, UnitsFulfilled: 10},
+			{TradeSymbol: "COPPER_ORE", DestinationSymbol: "X1-BB-H1", UnitsRequired: 50},
+			{TradeSymbol: "QUARTZ_SAND", DestinationSymbol: "X1-AA-H2", UnitsRequired: 20, UnitsFulfilled: 20},
+		},
+		Deadline: "2026-03-02T00:00:00Z",
+	}, nil)
+	if err != nil {
+		t.Fatalf("NewContract: %v", err)
+	}
+
+	handler := NewGetSystemOverviewHandler(
+		overviewShips{
+			overviewShip(t, "SHIP-2", "X1-AA-B2", "trade-1"),
+			overviewShip(t, "SHIP-1", "X1-AA-A1", ""),
+			overviewShip(t, "SHIP-3", "X1-BB-A1", "mine-1"),
+		},
+		overviewContainers{
+			{ID: "trade-1", ContainerType: "TRADING", CommandType: "arbitrage_worker", Config: `{}`},
+			{ID: "mine-1", ContainerType: "MINING", CommandType: "mining_worker", Config: `{"asteroid":"X1-BB-C3"}`},
+			{ID: "scout-1", ContainerType: "SCOUTING", CommandType: "scout_tour", Config: `{"markets":["X1-AA-H1"]}`},
+		},
+		overviewMarkets{
+			"X1-AA-H1": mkt("X1-AA-H1", 5*time.Minute),
+			"X1-AA-H2": mkt("X1-AA-H2", time.Hour),
+			"X1-AA-H3": mkt("X1-AA-H3", 20*time.Minute),
+			"X1-AA-H4": nil,
+		},
+		overviewContracts{delivery},
+		overviewLanes{{Good: "FUEL"}, {Good: "FOOD"}, {Good: "ICE_WATER"}},
+		&shared.MockClock{CurrentTime: now},
+	)
+
+	resp, err := handler.Handle(context.Background(), &GetSystemOverviewQuery{
+		PlayerID:     shared.MustNewPlayerID(1),
+		SystemSymbol: "X1-AA",
+		MaxLanes:     2,
+	})
+	if err != nil {
+		t.Fatalf("Handle: %v", err)
+	}
+	overview := resp.(*GetSystemOverviewResponse)
+
+	if len(overview.Ships) != 2 || overview.Ships[0].Symbol != "SHIP-1" || overview.Ships[1].Task != "arbitrage_worker" {
+		t.Fatalf("expected SHIP-1 idle and SHIP-2 on arbitrage_worker, got %+v", overview.Ships)
+	}
+	if overview.Ships[0].Task != "" {
+		t.Fatalf("an unassigned ship has no task, got %q", overview.Ships[0].Task)
+	}
+	if len(overview.Containers) != 2 || overview.Containers[0].ID != "scout-1" || overview.Containers[1].ID != "trade-1" {
+		t.Fatalf("expected the scout naming the system and the trader with a ship there, got %+v", overview.Containers)
+	}
+	if overview.Containers[1].ShipsInSystem != 1 {
+		t.Fatalf("trade-1 has one ship in the system, got %d", overview.Containers[1].ShipsInSystem)
+	}
+
+	want := SystemMarketFreshness{Markets: 4, Scanned: 3, NewestAge: 5 * time.Minute, MedianAge: 20 * time.Minute, OldestAge: time.Hour}
+	if overview.Markets != want {
+		t.Fatalf("market freshness = %+v, want %+v", overview.Markets, want)
+	}
+
+	if len(overview.Deliveries) != 1 || overview.Deliveries[0].Good != "IRON_ORE" {
+		t.Fatalf("only the open IRON_ORE delivery lands in X1-AA, got %+v", overview.Deliveries)
+	}
+	if len(overview.Lanes) != 2 || overview.Lanes[0].Good != "FUEL" {
+		t.Fatalf("expected the top two lanes, got %+v", overview.Lanes)
+	}
+}
+
+func TestGetSystemOverview_RequiresASystem(t *testing.T) {
+	handler := NewGetSystemOverviewHandler(nil, nil, nil, nil, nil, nil)
+	if _, err := handler.Handle(context.Background(), &GetSystemOverviewQuery{PlayerID: shared.MustNewPlayerID(1)}); err == nil {
+		t.Fatal("expected an error without a system symbol")
+	}
+}
@@ -0,0 +1,50 @@
+package queries
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/andrescamacho/spacetraders-go/internal/application/common"
+	"github.com/andrescamacho/spacetraders-go/internal/domain/shared"
+	"github.com/andrescamacho/spacetraders-go/internal/domain/system"
+)
+
+// WaypointBlacklistReader lists a player's active blacklist entries.
+// Satisfied by *ship.WaypointBlacklist.
+type WaypointBlacklistReader interface {
+	List(ctx context.Context, playerID int) ([]*system.BlacklistedWaypoint, error)
+}
+
+// ListWaypointBlacklistQuery lists the waypoints the player's fleet is
+// currently kept away from.
+type ListWaypointBlacklistQuery struct {
+	PlayerID shared.PlayerID
+}
+
+// ListWaypointBlacklistResponse holds the active entries, ordered by waypoint.
+type ListWaypointBlacklistResponse struct {
+	Waypoints []*system.BlacklistedWaypoint
+}
+
+// ListWaypointBlacklistHandler handles ListWaypointBlacklistQuery.
+type ListWaypointBlacklistHandler struct {
+	reader WaypointBlacklistReader
+}
+
+// NewListWaypointBlacklistHandler creates the handler.
+func NewListWaypointBlacklistHandler(reader WaypointBlacklistReader) *ListWaypointBlacklistHandler {
+	return &ListWaypointBlacklistHandler{reader: reader}
+}
+
+// Handle executes the ListWaypointBlacklist query.
+func (h *ListWaypointBlacklistHandler) Handle(ctx context.Context, request common.Request) (common.Response, error) {
+	query, ok := request.(*ListWaypointBlacklistQuery)
+	if !ok {
+		return nil, fmt.Errorf("invalid request type: expected *ListWaypointBlacklistQuery")
+	}
+	waypoints, err := h.reader.List(ctx, query.PlayerID.Value())
+	if err != nil {
+		return nil, err
+	}
+	return &ListWaypointBlacklistResponse{Waypoints: waypoints}, nil
+}
@@ -0,0 +1,84 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/andrescamacho/spacetraders-go/internal/application/common"
+	"github.com/andrescamacho/spacetraders-go/internal/domain/navigation"
+	"github.com/andrescamacho/spacetraders-go/internal/domain/shared"
+)
+
+// FieldFuelDonor tops up a dry ship from the FUEL in the hold of an idle hull
+// at its waypoint, through RefuelFromTankerCommand. Only idle hulls donate: a
+// hull held by a container or the captain belongs to its owner (RULING #3).
+type FieldFuelDonor struct {
+	shipRepo navigation.ShipRepository
+	mediator common.Mediator
+}
+
+// NewFieldFuelDonor creates a donor that draws on the player's idle hulls.
+func NewFieldFuelDonor(shipRepo navigation.ShipRepository, mediator common.Mediator) *FieldFuelDonor {
+	return &FieldFuelDonor{shipRepo: shipRepo, mediator: mediator}
+}
+
+// DonateFuel hands ship at least fuel units of FUEL from the first idle hull
+// at its waypoint that carries enough, fullest first. Returns the donor.
+func (d *FieldFuelDonor) DonateFuel(ctx context.Context, ship *navigation.Ship, playerID shared.PlayerID, fuel int) (string, error) {
+	idle, err := d.shipRepo.FindIdleByPlayer(ctx, playerID)
+	if err != nil {
+		return "", fmt.Errorf("failed to list idle ships: %w", err)
+	}
+
+	cargoUnits := (fuel + shared.FuelPerCargoUnit - 1) / shared.FuelPerCargoUnit
+	if ship.AvailableCargoSpace() < cargoUnits {
+		return "", fmt.Errorf("%s has no free cargo slot to take FUEL", ship.ShipSymbol())
+	}
+	for _, donor := range fuelDonors(idle, ship, cargoUnits) {
+		resp, err := d.mediator.Send(ctx, &RefuelFromTankerCommand{
+			PlayerID:      playerID,
+			TankerSymbol:  donor.ShipSymbol(),
+			ShipSymbol:    ship.ShipSymbol(),
+			MaxCargoUnits: cargoUnits,
+		})
+		if err != nil {
+			common.LoggerFromContext(ctx).Log("WARNING", "Field fuel donation failed", map[string]interface{}{
+				"ship_symbol": ship.ShipSymbol(),
+				"donor":       donor.ShipSymbol(),
+				"error":       err.Error(),
+			})
+			continue
+		}
+		if refuel, ok := resp.(*RefuelFromTankerResponse); ok && refuel.FuelAdded > 0 {
+			return donor.ShipSymbol(), nil
+		}
+	}
+	return "", fmt.Errorf("no idle hull at %s carries %d FUEL", ship.CurrentLocation().Symbol, cargoUnits)
+}
+
+// fuelDonors returns the idle hulls parked at ship's waypoint holding at least
+// cargoUnits of FUEL, the most FUEL first, then by symbol.
+func fuelDonors(idle []*navigation.Ship, ship *navigation.Ship, cargoUnits int) []*navigation.Ship {
+	var donors []*navigation.Ship
+	for _, candidate := range idle {
+		if candidate.ShipSymbol() == ship.ShipSymbol() || candidate.IsInTransit() || candidate.IsReservedByCaptain() {
+			continue
+		}
+		if candidate.CurrentLocation().Symbol != ship.CurrentLocation().Symbol {
+			continue
+		}
+		if candidate.Cargo().GetItemUnits(fuelGood) < cargoUnits {
+			continue
+		}
+		donors = append(donors, candidate)
+	}
+	sort.Slice(donors, func(i, j int) bool {
+		fi, fj := donors[i].Cargo().GetItemUnits(fuelGood), donors[j].Cargo().GetItemUnits(fuelGood)
+		if fi != fj {
+			return fi > fj
+		}
+		return donors[i].ShipSymbol() < donors[j].ShipSymbol()
+	})
+	return donors
+}
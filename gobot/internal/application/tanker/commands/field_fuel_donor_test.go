@@ -0,0 +1,58 @@
+package commands
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/andrescamacho/spacetraders-go/internal/application/common"
+	"github.com/andrescamacho/spacetraders-go/internal/domain/navigation"
+	"github.com/andrescamacho/spacetraders-go/internal/domain/shared"
+)
+
+// donorFakeRepo lists every ship of the fake fleet as idle.
+type donorFakeRepo struct {
+	tankerFakeRepo
+}
+
+func (r *donorFakeRepo) FindIdleByPlayer(context.Context, shared.PlayerID) ([]*navigation.Ship, error) {
+	return r.ships, nil
+}
+
+// donorMediator records the field refuels asked for and reports fuel added.
+type donorMediator struct {
+	common.Mediator
+	sent []*RefuelFromTankerCommand
+}
+
+func (m *donorMediator) Send(_ context.Context, request common.Request) (common.Response, error) {
+	cmd := request.(*RefuelFromTankerCommand)
+	m.sent = append(m.sent, cmd)
+	return &RefuelFromTankerResponse{CargoUnitsTransferred: cmd.MaxCargoUnits, FuelAdded: cmd.MaxCargoUnits * shared.FuelPerCargoUnit}, nil
+}
+
+// The donor is an idle hull at the dry ship's waypoint with the most FUEL;
+// hulls elsewhere or carrying too little are passed over, and only the whole
+// units covering the fuel asked for are handed over.
+func TestFieldFuelDonor_PicksIdleHullAtWaypoint(t *testing.T) {
+	site := tankerWaypoint(t, "X1-TK-B7", 0, false)
+	elsewhere := tankerWaypoint(t, "X1-TK-C1", 50, false)
+	dry := tankerTestShip(t, "AGENT-DRY", "HAULER", site, 0, 400, 0, 40)
+	far := tankerTestShip(t, "AGENT-FAR", "HAULER", elsewhere, 400, 400, 30, 40)
+	small := tankerTestShip(t, "AGENT-SMALL", "HAULER", site, 400, 400, 1, 40)
+	full := tankerTestShip(t, "AGENT-FULL", "HAULER", site, 400, 400, 10, 40)
+
+	med := &donorMediator{}
+	donor := NewFieldFuelDonor(&donorFakeRepo{tankerFakeRepo{ships: []*navigation.Ship{dry, far, small, full}}}, med)
+
+	symbol, err := donor.DonateFuel(context.Background(), dry, shared.MustNewPlayerID(1), 150)
+	require.NoError(t, err)
+	require.Equal(t, "AGENT-FULL", symbol)
+	require.Len(t, med.sent, 1)
+	require.Equal(t, "AGENT-DRY", med.sent[0].ShipSymbol)
+	require.Equal(t, 2, med.sent[0].MaxCargoUnits)
+
+	_, err = donor.DonateFuel(context.Background(), dry, shared.MustNewPlayerID(1), 1500)
+	require.ErrorContains(t, err, "no idle hull at X1-TK-B7 carries 15 FUEL")
+}
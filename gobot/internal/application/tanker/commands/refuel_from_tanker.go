@@ -0,0 +1,201 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/andrescamacho/spacetraders-go/internal/application/common"
+	"github.com/andrescamacho/spacetraders-go/internal/domain/ledger"
+	"github.com/andrescamacho/spacetraders-go/internal/domain/navigation"
+	domainPorts "github.com/andrescamacho/spacetraders-go/internal/domain/ports"
+	"github.com/andrescamacho/spacetraders-go/internal/domain/shared"
+)
+
+// fuelGood is the trade good a tanker carries and hands over.
+const fuelGood = "FUEL"
+
+// RefuelFromTankerCommand tops up ShipSymbol's tank from the FUEL carried by
+// TankerSymbol. Both ships must be at the same waypoint. The FUEL is
+// transferred into the ship's hold and burned straight into its tank
+// (refuel fromCargo), so the ship needs one free cargo slot per 100 fuel.
+type RefuelFromTankerCommand struct {
+	PlayerID     shared.PlayerID
+	TankerSymbol string
+	ShipSymbol   string
+	// MaxCargoUnits caps the FUEL handed over. <= 0 → as much as the tank takes.
+	MaxCargoUnits int
+}
+
+// RefuelFromTankerResponse reports one field refuel.
+type RefuelFromTankerResponse struct {
+	CargoUnitsTransferred int
+	FuelAdded             int
+	CurrentFuel           int
+	FuelCapacity          int
+	TankerFuelRemaining   int // FUEL cargo units left aboard the tanker
+}
+
+// RefuelFromTankerHandler performs the tanker-to-ship transfer and the
+// fromCargo refuel.
+type RefuelFromTankerHandler struct {
+	shipRepo  navigation.ShipRepository
+	apiClient domainPorts.APIClient
+	costBasis ledger.CargoCostBasisRecorder
+}
+
+// NewRefuelFromTankerHandler creates a new field refuel handler
+func NewRefuelFromTankerHandler(shipRepo navigation.ShipRepository, apiClient domainPorts.APIClient) *RefuelFromTankerHandler {
+	return &RefuelFromTankerHandler{shipRepo: shipRepo, apiClient: apiClient}
+}
+
+// SetCostBasisRecorder releases the FUEL burned in the field from the
+// tanker's cargo cost basis. nil disables the tracking.
+func (h *RefuelFromTankerHandler) SetCostBasisRecorder(recorder ledger.CargoCostBasisRecorder) {
+	h.costBasis = recorder
+}
+
+// Handle executes the field refuel
+func (h *RefuelFromTankerHandler) Handle(ctx context.Context, request common.Request) (common.Response, error) {
+	cmd, ok := request.(*RefuelFromTankerCommand)
+	if !ok {
+		return nil, fmt.Errorf("invalid request type")
+	}
+
+	token, err := common.PlayerTokenFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	tanker, err := h.shipRepo.FindBySymbol(ctx, cmd.TankerSymbol, cmd.PlayerID)
+	if err != nil {
+		return nil, fmt.Errorf("tanker not found: %w", err)
+	}
+	ship, err := h.shipRepo.FindBySymbol(ctx, cmd.ShipSymbol, cmd.PlayerID)
+	if err != nil {
+		return nil, fmt.Errorf("ship not found: %w", err)
+	}
+	if tanker.IsInTransit() || ship.IsInTransit() {
+		return nil, fmt.Errorf("cannot refuel %s from %s while either ship is in transit", cmd.ShipSymbol, cmd.TankerSymbol)
+	}
+	if tanker.CurrentLocation().Symbol != ship.CurrentLocation().Symbol {
+		return nil, fmt.Errorf("ships must be at the same location for a field refuel: %s at %s, %s at %s",
+			cmd.TankerSymbol, tanker.CurrentLocation().Symbol,
+			cmd.ShipSymbol, ship.CurrentLocation().Symbol)
+	}
+
+	units := FieldRefuelUnits(tanker, ship, cmd.MaxCargoUnits)
+	response := &RefuelFromTankerResponse{
+		CurrentFuel:         ship.Fuel().Current,
+		FuelCapacity:        ship.Fuel().Capacity,
+		TankerFuelRemaining: tanker.Cargo().GetItemUnits(fuelGood),
+	}
+	if units == 0 {
+		return response, nil
+	}
+
+	// The tanker is the anchor: the ship being served is aligned to the
+	// tanker's nav state, so a tanker parked in orbit at an asteroid stays put.
+	result, alignedNav, err := common.AlignAndTransferCargo(ctx, h.apiClient, cmd.TankerSymbol, cmd.ShipSymbol, cmd.TankerSymbol, fuelGood, units, token)
+	if err != nil {
+		return nil, fmt.Errorf("failed to transfer fuel from tanker: %w", err)
+	}
+	transferred := result.UnitsTransferred
+	_, _, _ = h.shipRepo.SaveWithRetry(ctx, cmd.TankerSymbol, cmd.PlayerID,
+		func(sh *navigation.Ship) (bool, error) {
+			_ = sh.RemoveCargo(fuelGood, transferred)
+			return true, nil
+		})
+	if h.costBasis != nil {
+		if err := h.costBasis.RecordRemoval(ctx, cmd.PlayerID.Value(), cmd.TankerSymbol, fuelGood, transferred); err != nil {
+			common.LoggerFromContext(ctx).Log("WARNING", "Failed to update cargo cost basis", map[string]interface{}{
+				"ship_symbol": cmd.TankerSymbol,
+				"good":        fuelGood,
+				"units":       transferred,
+				"error":       err.Error(),
+			})
+		}
+	}
+
+	refuel, docked, err := h.refuelFromCargo(ctx, cmd.ShipSymbol, transferred*shared.FuelPerCargoUnit, token)
+	if docked {
+		alignedNav = navigation.NavStatusDocked
+	}
+	if err != nil {
+		// The FUEL is aboard the ship now; record it there so the next
+		// refuel, or a sale, finds it.
+		_, _, _ = h.shipRepo.SaveWithRetry(ctx, cmd.ShipSymbol, cmd.PlayerID,
+			func(sh *navigation.Ship) (bool, error) {
+				reconcileNavStatus(sh, alignedNav)
+				_ = sh.ReceiveCargo(&shared.CargoItem{Symbol: fuelGood, Units: transferred})
+				return true, nil
+			})
+		return nil, fmt.Errorf("transferred %d FUEL to %s but the refuel from cargo failed: %w", transferred, cmd.ShipSymbol, err)
+	}
+
+	fuelBefore := ship.Fuel().Current
+	updated, _, _ := h.shipRepo.SaveWithRetry(ctx, cmd.ShipSymbol, cmd.PlayerID,
+		func(sh *navigation.Ship) (bool, error) {
+			reconcileNavStatus(sh, alignedNav)
+			if refuel.FuelCurrent > sh.Fuel().Current {
+				_ = sh.Refuel(refuel.FuelCurrent - sh.Fuel().Current)
+			}
+			return true, nil
+		})
+	if updated != nil {
+		ship = updated
+	}
+
+	response.CargoUnitsTransferred = transferred
+	response.CurrentFuel = ship.Fuel().Current
+	response.FuelAdded = ship.Fuel().Current - fuelBefore
+	response.TankerFuelRemaining -= transferred
+
+	common.LoggerFromContext(ctx).Log("INFO", fmt.Sprintf("Tanker %s refuelled %s with %d FUEL", cmd.TankerSymbol, cmd.ShipSymbol, transferred), map[string]interface{}{
+		"action":       "tanker_refuel",
+		"tanker":       cmd.TankerSymbol,
+		"ship_symbol":  cmd.ShipSymbol,
+		"waypoint":     ship.CurrentLocation().Symbol,
+		"fuel_added":   response.FuelAdded,
+		"current_fuel": response.CurrentFuel,
+	})
+	return response, nil
+}
+
+// FieldRefuelUnits is how many FUEL cargo units a tanker should hand a ship:
+// whole cargo units that fit in the tank's headroom (never overfilling it),
+// bounded by the tanker's FUEL, the ship's free hold and maxUnits when > 0.
+func FieldRefuelUnits(tanker, ship *navigation.Ship, maxUnits int) int {
+	units := (ship.Fuel().Capacity - ship.Fuel().Current) / shared.FuelPerCargoUnit
+	units = min(units, tanker.Cargo().GetItemUnits(fuelGood), ship.AvailableCargoSpace())
+	if maxUnits > 0 {
+		units = min(units, maxUnits)
+	}
+	return max(units, 0)
+}
+
+// refuelFromCargo burns the transferred FUEL into the tank. A ship the API
+// wants docked for the refuel is docked once and the refuel retried; docked
+// reports that the ship was docked along the way.
+func (h *RefuelFromTankerHandler) refuelFromCargo(ctx context.Context, shipSymbol string, fuel int, token string) (result *navigation.RefuelResult, docked bool, err error) {
+	result, err = h.apiClient.RefuelShipFromCargo(ctx, shipSymbol, token, fuel)
+	if err == nil || !strings.Contains(err.Error(), "must be docked") {
+		return result, false, err
+	}
+	if derr := h.apiClient.DockShip(ctx, shipSymbol, token); derr != nil {
+		return nil, false, fmt.Errorf("dock before refuelling from cargo failed: %w", derr)
+	}
+	result, err = h.apiClient.RefuelShipFromCargo(ctx, shipSymbol, token, fuel)
+	return result, true, err
+}
+
+// reconcileNavStatus brings the in-memory ship's nav state in line with the
+// state the transfer aligned it to, so the save persists it.
+func reconcileNavStatus(ship *navigation.Ship, target navigation.NavStatus) {
+	switch target {
+	case navigation.NavStatusDocked:
+		_, _ = ship.EnsureDocked()
+	case navigation.NavStatusInOrbit:
+		_, _ = ship.EnsureInOrbit()
+	}
+}
@@ -0,0 +1,133 @@
+package commands
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/andrescamacho/spacetraders-go/internal/application/common"
+	"github.com/andrescamacho/spacetraders-go/internal/domain/navigation"
+	domainPorts "github.com/andrescamacho/spacetraders-go/internal/domain/ports"
+	"github.com/andrescamacho/spacetraders-go/internal/domain/shared"
+)
+
+// tankerFakeAPI covers the calls a field refuel makes: the nav reads and moves
+// of the transfer alignment, the transfer and the fromCargo refuel.
+type tankerFakeAPI struct {
+	domainPorts.APIClient
+	nav       map[string]string
+	transfers []int
+	refuels   []int
+	fuelAfter int
+}
+
+func (f *tankerFakeAPI) GetShip(_ context.Context, symbol, _ string) (*navigation.ShipData, error) {
+	return &navigation.ShipData{Symbol: symbol, NavStatus: f.nav[symbol]}, nil
+}
+func (f *tankerFakeAPI) OrbitShip(_ context.Context, symbol, _ string) error {
+	f.nav[symbol] = string(navigation.NavStatusInOrbit)
+	return nil
+}
+func (f *tankerFakeAPI) DockShip(_ context.Context, symbol, _ string) error {
+	f.nav[symbol] = string(navigation.NavStatusDocked)
+	return nil
+}
+func (f *tankerFakeAPI) TransferCargo(_ context.Context, from, to, good string, units int, _ string) (*domainPorts.TransferResult, error) {
+	f.transfers = append(f.transfers, units)
+	return &domainPorts.TransferResult{FromShip: from, ToShip: to, GoodSymbol: good, UnitsTransferred: units}, nil
+}
+func (f *tankerFakeAPI) RefuelShipFromCargo(_ context.Context, _, _ string, units int) (*navigation.RefuelResult, error) {
+	f.refuels = append(f.refuels, units)
+	return &navigation.RefuelResult{FuelAdded: units, FuelCurrent: f.fuelAfter}, nil
+}
+
+// tankerFakeRepo serves a fixed fleet and applies SaveWithRetry mutations in
+// place, the real repository's non-conflict path.
+type tankerFakeRepo struct {
+	navigation.ShipRepository
+	ships  []*navigation.Ship
+	claims []string
+}
+
+func (r *tankerFakeRepo) FindBySymbol(_ context.Context, symbol string, _ shared.PlayerID) (*navigation.Ship, error) {
+	for _, ship := range r.ships {
+		if ship.ShipSymbol() == symbol {
+			return ship, nil
+		}
+	}
+	return nil, shared.NewInvalidShipDataError("unknown ship " + symbol)
+}
+func (r *tankerFakeRepo) FindAllByPlayer(context.Context, shared.PlayerID) ([]*navigation.Ship, error) {
+	return r.ships, nil
+}
+func (r *tankerFakeRepo) SaveWithRetry(ctx context.Context, symbol string, playerID shared.PlayerID, mutate navigation.ShipMutation) (*navigation.Ship, bool, error) {
+	sh, err := r.FindBySymbol(ctx, symbol, playerID)
+	if err != nil {
+		return nil, false, err
+	}
+	changed, err := mutate(sh)
+	return sh, changed, err
+}
+func (r *tankerFakeRepo) ClaimShip(_ context.Context, symbol, _ string, _ shared.PlayerID, _ string) error {
+	r.claims = append(r.claims, symbol)
+	return nil
+}
+
+func tankerWaypoint(t *testing.T, symbol string, x float64, hasFuel bool) *shared.Waypoint {
+	t.Helper()
+	wp, err := shared.NewWaypoint(symbol, x, 0)
+	require.NoError(t, err)
+	wp.HasFuel = hasFuel
+	return wp
+}
+
+func tankerTestShip(t *testing.T, symbol, role string, at *shared.Waypoint, fuel, fuelCapacity, fuelCargo, cargoCapacity int) *navigation.Ship {
+	t.Helper()
+	tank, err := shared.NewFuel(fuel, fuelCapacity)
+	require.NoError(t, err)
+	var inventory []*shared.CargoItem
+	if fuelCargo > 0 {
+		item, err := shared.NewCargoItem(fuelGood, fuelGood, "", fuelCargo)
+		require.NoError(t, err)
+		inventory = append(inventory, item)
+	}
+	cargo, err := shared.NewCargo(cargoCapacity, fuelCargo, inventory)
+	require.NoError(t, err)
+	ship, err := navigation.NewShip(symbol, shared.MustNewPlayerID(1), at, tank, fuelCapacity, cargoCapacity, cargo, 30, "FRAME_DRONE", role, nil, navigation.NavStatusInOrbit)
+	require.NoError(t, err)
+	return ship
+}
+
+// Only whole cargo units that fit the tank's headroom are handed over, and the
+// ship burns them from its hold: 250 fuel short takes 2 FUEL, not 3.
+func TestRefuelFromTanker_HandsOverWholeUnitsAndRefuelsFromCargo(t *testing.T) {
+	site := tankerWaypoint(t, "X1-TK-B7", 0, false)
+	tanker := tankerTestShip(t, "AGENT-TANKER", "HAULER", site, 400, 400, 20, 40)
+	drone := tankerTestShip(t, "AGENT-DRONE", "EXCAVATOR", site, 150, 400, 0, 15)
+
+	api := &tankerFakeAPI{nav: map[string]string{
+		"AGENT-TANKER": string(navigation.NavStatusInOrbit),
+		"AGENT-DRONE":  string(navigation.NavStatusInOrbit),
+	}, fuelAfter: 350}
+	repo := &tankerFakeRepo{ships: []*navigation.Ship{tanker, drone}}
+	handler := NewRefuelFromTankerHandler(repo, api)
+
+	ctx := common.WithPlayerToken(context.Background(), "tok")
+	resp, err := handler.Handle(ctx, &RefuelFromTankerCommand{
+		PlayerID:     shared.MustNewPlayerID(1),
+		TankerSymbol: "AGENT-TANKER",
+		ShipSymbol:   "AGENT-DRONE",
+	})
+
+	require.NoError(t, err)
+	require.Equal(t, []int{2}, api.transfers)
+	require.Equal(t, []int{200}, api.refuels, "the refuel burns the transferred cargo, 100 fuel per unit")
+	refuel := resp.(*RefuelFromTankerResponse)
+	require.Equal(t, 2, refuel.CargoUnitsTransferred)
+	require.Equal(t, 200, refuel.FuelAdded)
+	require.Equal(t, 18, refuel.TankerFuelRemaining)
+	require.Equal(t, 18, tanker.Cargo().GetItemUnits(fuelGood))
+	require.Equal(t, 350, drone.Fuel().Current)
+	require.Zero(t, drone.Cargo().GetItemUnits(fuelGood), "the FUEL went into the tank, not the hold")
+}
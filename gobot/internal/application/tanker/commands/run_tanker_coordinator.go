@@ -0,0 +1,528 @@
+package commands
+
+// RunTankerCoordinator keeps a small fleet of tanker hulls stationed at the
+// player's mining and siphon sites, so the drones working there are topped up
+// in the field instead of breaking off to fly to a market for fuel.
+//
+// Each tick every tanker runs one step, in this order:
+//   - below its reload level, it flies to the nearest waypoint selling fuel
+//     and fills its hold with FUEL;
+//   - away from its station, it flies there;
+//   - at its station, it refuels every ship there running low, until its own
+//     FUEL runs out.
+//
+// Stations come from the command or, when none are named, from where the
+// extraction hulls (EXCAVATOR role, mining laser or gas siphon mounts) sit:
+// the busiest sites in a tanker's system first, one tanker per site before
+// any site gets a second. Tankers sharing a station step one after another;
+// tankers on different stations step concurrently, so one tanker's flight
+// never holds up another's service.
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/andrescamacho/spacetraders-go/internal/application/common"
+	"github.com/andrescamacho/spacetraders-go/internal/application/health"
+	shipCargo "github.com/andrescamacho/spacetraders-go/internal/application/ship/commands/cargo"
+	shipNav "github.com/andrescamacho/spacetraders-go/internal/application/ship/commands/navigation"
+	"github.com/andrescamacho/spacetraders-go/internal/domain/navigation"
+	"github.com/andrescamacho/spacetraders-go/internal/domain/shared"
+	"github.com/andrescamacho/spacetraders-go/internal/domain/system"
+)
+
+const (
+	// tankerDefaultTickSeconds paces the loop. Drones burn fuel over minutes of
+	// extraction cycles, so a one-minute check keeps them topped up.
+	tankerDefaultTickSeconds = 60
+
+	// tankerDefaultRefuelBelowPct is the fuel level under which a ship at the
+	// station is served.
+	tankerDefaultRefuelBelowPct = 60
+
+	// operationTanker names the tanker claim.
+	operationTanker = "tanker"
+
+	minerRole = "EXCAVATOR"
+)
+
+// RunTankerCoordinatorCommand launches the standing tanker coordinator. Like
+// the sibling coordinators it runs an infinite loop inside one Handle() call.
+type RunTankerCoordinatorCommand struct {
+	PlayerID      shared.PlayerID
+	ContainerID   string
+	TankerShips   []string
+	TankerShipTag string // Optional: also run every ship wearing this tag as a tanker
+
+	// Stations pins the waypoints tankers hold station at, handed out in
+	// order. Empty → the busiest extraction sites in each tanker's system.
+	Stations []string
+
+	// FuelSource is the market tankers load FUEL at. Empty → the nearest
+	// waypoint selling fuel in the tanker's system.
+	FuelSource string
+
+	// RefuelBelowPct serves ships whose fuel is under this percentage of
+	// capacity. <= 0 → tankerDefaultRefuelBelowPct.
+	RefuelBelowPct int
+
+	// ReloadBelowUnits sends a tanker to reload once its FUEL cargo falls
+	// under this many units. <= 0 → a quarter of its cargo capacity.
+	ReloadBelowUnits int
+
+	TickIntervalSecs int
+}
+
+// RunTankerCoordinatorResponse reports progress (observed only on shutdown,
+// since the loop is infinite).
+type RunTankerCoordinatorResponse struct {
+	Ticks         int
+	ShipsRefueled int
+	Reloads       int
+	Errors        []string
+}
+
+// TankerStep is what one tick did for the fleet.
+type TankerStep struct {
+	ShipsRefueled int
+	Reloads       int
+}
+
+// RunTankerCoordinatorHandler runs the tanker fleet.
+type RunTankerCoordinatorHandler struct {
+	mediator     common.Mediator
+	shipRepo     navigation.ShipRepository
+	waypointRepo system.WaypointRepository
+	clock        shared.Clock
+	shipTags     navigation.ShipTagRepository
+}
+
+// NewRunTankerCoordinatorHandler wires the coordinator. A nil clock defaults
+// to the real clock.
+func NewRunTankerCoordinatorHandler(
+	mediator common.Mediator,
+	shipRepo navigation.ShipRepository,
+	waypointRepo system.WaypointRepository,
+	clock shared.Clock,
+) *RunTankerCoordinatorHandler {
+	if clock == nil {
+		clock = shared.NewRealClock()
+	}
+	return &RunTankerCoordinatorHandler{
+		mediator:     mediator,
+		shipRepo:     shipRepo,
+		waypointRepo: waypointRepo,
+		clock:        clock,
+	}
+}
+
+// SetShipTagRepository enables the tanker tag selector. Without it a command
+// naming a tag is rejected.
+func (h *RunTankerCoordinatorHandler) SetShipTagRepository(tags navigation.ShipTagRepository) {
+	h.shipTags = tags
+}
+
+// Handle claims the tankers and runs the loop until the context is cancelled.
+func (h *RunTankerCoordinatorHandler) Handle(ctx context.Context, request common.Request) (common.Response, error) {
+	logger := common.LoggerFromContext(ctx)
+
+	cmd, ok := request.(*RunTankerCoordinatorCommand)
+	if !ok {
+		return nil, fmt.Errorf("invalid request type")
+	}
+
+	tankers, err := navigation.ResolveShipSelector(ctx, h.shipTags, cmd.PlayerID, cmd.TankerShips, cmd.TankerShipTag)
+	if err != nil {
+		return nil, err
+	}
+	if len(tankers) == 0 {
+		return nil, fmt.Errorf("at least one tanker ship is required")
+	}
+	cmd.TankerShips = tankers
+
+	if err := h.claimTankers(ctx, cmd); err != nil {
+		return nil, err
+	}
+	defer h.releaseTankers(context.WithoutCancel(ctx), cmd)
+
+	tick := time.Duration(cmd.TickIntervalSecs) * time.Second
+	if tick <= 0 {
+		tick = tankerDefaultTickSeconds * time.Second
+	}
+	logger.Log("INFO", fmt.Sprintf("Tanker coordinator starting with %d tanker(s) (tick %s)", len(tankers), tick), map[string]interface{}{
+		"action":       "tanker_start",
+		"container_id": cmd.ContainerID,
+		"tankers":      strings.Join(tankers, ","),
+	})
+
+	result := &RunTankerCoordinatorResponse{Errors: []string{}}
+	errMon := health.NewMonitor(health.DefaultStreakThreshold)
+	for {
+		select {
+		case <-ctx.Done():
+			return result, ctx.Err()
+		default:
+		}
+
+		step, err := h.StepOnce(ctx, cmd)
+		result.ShipsRefueled += step.ShipsRefueled
+		result.Reloads += step.Reloads
+		if err != nil {
+			result.Errors = append(result.Errors, err.Error())
+			logger.Log("ERROR", fmt.Sprintf("Tanker step failed: %v", err), nil)
+		}
+		if _, crossed := errMon.Note("step", errString(err)); crossed {
+			logger.Log("ERROR", "Tanker coordinator stuck in an error loop", map[string]interface{}{
+				"action":       "tanker_error_loop",
+				"container_id": cmd.ContainerID,
+			})
+		}
+		result.Ticks++
+
+		select {
+		case <-shared.After(shared.ClockFromContext(ctx), tick):
+		case <-ctx.Done():
+			return result, ctx.Err()
+		}
+	}
+}
+
+// StepOnce is one tick for the whole fleet — the unit the tests drive
+// directly. A tanker whose step fails is logged and the others carry on; only
+// a failure to read the roster aborts the tick.
+func (h *RunTankerCoordinatorHandler) StepOnce(ctx context.Context, cmd *RunTankerCoordinatorCommand) (TankerStep, error) {
+	logger := common.LoggerFromContext(ctx)
+
+	fleet, err := h.shipRepo.FindAllByPlayer(ctx, cmd.PlayerID)
+	if err != nil {
+		return TankerStep{}, fmt.Errorf("failed to list ships: %w", err)
+	}
+	byStation := h.assignStations(cmd, fleet)
+
+	stations := make([]string, 0, len(byStation))
+	for station := range byStation {
+		stations = append(stations, station)
+	}
+	sort.Strings(stations)
+
+	var (
+		mu    sync.Mutex
+		total TankerStep
+		wg    sync.WaitGroup
+	)
+	for _, station := range stations {
+		wg.Add(1)
+		go func(station string, tankers []*navigation.Ship) {
+			defer wg.Done()
+			for _, tanker := range tankers {
+				step, err := h.stepTanker(ctx, cmd, tanker, station)
+				if err != nil {
+					logger.Log("WARNING", fmt.Sprintf("Tanker %s step failed: %v", tanker.ShipSymbol(), err), map[string]interface{}{
+						"action":      "tanker_step_failed",
+						"ship_symbol": tanker.ShipSymbol(),
+						"station":     station,
+					})
+				}
+				mu.Lock()
+				total.ShipsRefueled += step.ShipsRefueled
+				total.Reloads += step.Reloads
+				mu.Unlock()
+			}
+		}(station, byStation[station])
+	}
+	wg.Wait()
+	return total, nil
+}
+
+// assignStations maps each station to the tankers holding it. A tanker in
+// transit, or one with no station to hold, sits this tick out.
+func (h *RunTankerCoordinatorHandler) assignStations(cmd *RunTankerCoordinatorCommand, fleet []*navigation.Ship) map[string][]*navigation.Ship {
+	isTanker := make(map[string]bool, len(cmd.TankerShips))
+	for _, symbol := range cmd.TankerShips {
+		isTanker[symbol] = true
+	}
+	var tankers []*navigation.Ship
+	for _, ship := range fleet {
+		if isTanker[ship.ShipSymbol()] && !ship.IsInTransit() {
+			tankers = append(tankers, ship)
+		}
+	}
+	sort.Slice(tankers, func(i, j int) bool { return tankers[i].ShipSymbol() < tankers[j].ShipSymbol() })
+
+	byStation := make(map[string][]*navigation.Ship)
+	if len(cmd.Stations) > 0 {
+		for i, tanker := range tankers {
+			station := cmd.Stations[i%len(cmd.Stations)]
+			byStation[station] = append(byStation[station], tanker)
+		}
+		return byStation
+	}
+
+	sites := ExtractionSites(fleet, isTanker)
+	handedOut := make(map[string]int)
+	for _, tanker := range tankers {
+		systemSites := sites[tanker.CurrentLocation().SystemSymbol]
+		if len(systemSites) == 0 {
+			continue
+		}
+		i := handedOut[tanker.CurrentLocation().SystemSymbol]
+		handedOut[tanker.CurrentLocation().SystemSymbol]++
+		station := systemSites[i%len(systemSites)]
+		byStation[station] = append(byStation[station], tanker)
+	}
+	return byStation
+}
+
+// ExtractionSites ranks, per system, the waypoints where extraction hulls
+// (other than the excluded ships) sit: most hulls first, ties by symbol.
+func ExtractionSites(fleet []*navigation.Ship, exclude map[string]bool) map[string][]string {
+	counts := make(map[string]int)
+	systemOf := make(map[string]string)
+	for _, ship := range fleet {
+		if exclude[ship.ShipSymbol()] || ship.IsInTransit() || !isExtractionHull(ship) {
+			continue
+		}
+		loc := ship.CurrentLocation()
+		counts[loc.Symbol]++
+		systemOf[loc.Symbol] = loc.SystemSymbol
+	}
+
+	sites := make(map[string][]string)
+	for waypoint, system := range systemOf {
+		sites[system] = append(sites[system], waypoint)
+	}
+	for _, waypoints := range sites {
+		sort.Slice(waypoints, func(i, j int) bool {
+			if counts[waypoints[i]] != counts[waypoints[j]] {
+				return counts[waypoints[i]] > counts[waypoints[j]]
+			}
+			return waypoints[i] < waypoints[j]
+		})
+	}
+	return sites
+}
+
+// isExtractionHull reports whether the ship mines or siphons.
+func isExtractionHull(ship *navigation.Ship) bool {
+	if ship.Role() == minerRole {
+		return true
+	}
+	for _, mount := range ship.Mounts() {
+		if strings.HasPrefix(mount.Symbol(), "MOUNT_MINING_LASER") || strings.HasPrefix(mount.Symbol(), "MOUNT_GAS_SIPHON") {
+			return true
+		}
+	}
+	return false
+}
+
+// stepTanker runs one step for one tanker: reload, move to station, or serve.
+func (h *RunTankerCoordinatorHandler) stepTanker(ctx context.Context, cmd *RunTankerCoordinatorCommand, tanker *navigation.Ship, station string) (TankerStep, error) {
+	var step TankerStep
+
+	if tanker.Cargo().GetItemUnits(fuelGood) < reloadBelow(cmd, tanker) {
+		if err := h.reload(ctx, cmd, tanker); err != nil {
+			return step, err
+		}
+		step.Reloads++
+		return step, nil
+	}
+
+	if tanker.CurrentLocation().Symbol != station {
+		if _, err := h.mediator.Send(ctx, &shipNav.NavigateRouteCommand{
+			ShipSymbol:  tanker.ShipSymbol(),
+			Destination: station,
+			PlayerID:    cmd.PlayerID,
+		}); err != nil {
+			return step, fmt.Errorf("failed to reach station %s: %w", station, err)
+		}
+	}
+
+	served, err := h.serveStation(ctx, cmd, tanker.ShipSymbol(), station)
+	step.ShipsRefueled = served
+	return step, err
+}
+
+// reloadBelow is the FUEL level that sends a tanker back to the market.
+func reloadBelow(cmd *RunTankerCoordinatorCommand, tanker *navigation.Ship) int {
+	if cmd.ReloadBelowUnits > 0 {
+		return cmd.ReloadBelowUnits
+	}
+	return max(tanker.CargoCapacity()/4, 1)
+}
+
+// reload flies the tanker to its fuel source and fills the free hold with FUEL.
+func (h *RunTankerCoordinatorHandler) reload(ctx context.Context, cmd *RunTankerCoordinatorCommand, tanker *navigation.Ship) error {
+	source := cmd.FuelSource
+	if source == "" {
+		nearest, err := h.nearestFuelMarket(ctx, tanker)
+		if err != nil {
+			return err
+		}
+		source = nearest
+	}
+
+	if tanker.CurrentLocation().Symbol != source {
+		if _, err := h.mediator.Send(ctx, &shipNav.NavigateRouteCommand{
+			ShipSymbol:  tanker.ShipSymbol(),
+			Destination: source,
+			PlayerID:    cmd.PlayerID,
+		}); err != nil {
+			return fmt.Errorf("failed to reach fuel source %s: %w", source, err)
+		}
+		refreshed, err := h.shipRepo.FindBySymbol(ctx, tanker.ShipSymbol(), cmd.PlayerID)
+		if err != nil {
+			return fmt.Errorf("failed to reload tanker state: %w", err)
+		}
+		tanker = refreshed
+	}
+
+	units := tanker.AvailableCargoSpace()
+	if units <= 0 {
+		return fmt.Errorf("tanker %s has no free hold for FUEL", tanker.ShipSymbol())
+	}
+	if _, err := h.mediator.Send(ctx, &shipCargo.PurchaseCargoCommand{
+		ShipSymbol: tanker.ShipSymbol(),
+		GoodSymbol: fuelGood,
+		Units:      units,
+		PlayerID:   cmd.PlayerID,
+	}); err != nil {
+		return fmt.Errorf("failed to buy FUEL at %s: %w", source, err)
+	}
+
+	common.LoggerFromContext(ctx).Log("INFO", fmt.Sprintf("Tanker %s loaded %d FUEL at %s", tanker.ShipSymbol(), units, source), map[string]interface{}{
+		"action":      "tanker_reload",
+		"ship_symbol": tanker.ShipSymbol(),
+		"waypoint":    source,
+		"units":       units,
+	})
+	return nil
+}
+
+// nearestFuelMarket picks the closest waypoint selling fuel in the tanker's
+// system.
+func (h *RunTankerCoordinatorHandler) nearestFuelMarket(ctx context.Context, tanker *navigation.Ship) (string, error) {
+	loc := tanker.CurrentLocation()
+	waypoints, err := h.waypointRepo.ListBySystem(ctx, loc.SystemSymbol)
+	if err != nil {
+		return "", fmt.Errorf("failed to list waypoints in %s: %w", loc.SystemSymbol, err)
+	}
+	var best *shared.Waypoint
+	for _, wp := range waypoints {
+		if !wp.HasFuel {
+			continue
+		}
+		if best == nil || loc.DistanceTo(wp) < loc.DistanceTo(best) {
+			best = wp
+		}
+	}
+	if best == nil {
+		return "", fmt.Errorf("no waypoint sells fuel in %s", loc.SystemSymbol)
+	}
+	return best.Symbol, nil
+}
+
+// serveStation refuels the ships at the station running under the threshold,
+// lowest tank first, until the tanker is dry. It returns how many it served.
+func (h *RunTankerCoordinatorHandler) serveStation(ctx context.Context, cmd *RunTankerCoordinatorCommand, tankerSymbol, station string) (int, error) {
+	fleet, err := h.shipRepo.FindAllByPlayer(ctx, cmd.PlayerID)
+	if err != nil {
+		return 0, fmt.Errorf("failed to list ships: %w", err)
+	}
+	isTanker := make(map[string]bool, len(cmd.TankerShips))
+	for _, symbol := range cmd.TankerShips {
+		isTanker[symbol] = true
+	}
+
+	threshold := float64(cmd.RefuelBelowPct)
+	if threshold <= 0 {
+		threshold = tankerDefaultRefuelBelowPct
+	}
+	var thirsty []*navigation.Ship
+	for _, ship := range fleet {
+		if isTanker[ship.ShipSymbol()] || ship.IsInTransit() || ship.CurrentLocation().Symbol != station {
+			continue
+		}
+		if ship.Fuel().Capacity == 0 || ship.Fuel().Percentage() >= threshold || ship.AvailableCargoSpace() == 0 {
+			continue
+		}
+		thirsty = append(thirsty, ship)
+	}
+	sort.Slice(thirsty, func(i, j int) bool {
+		if thirsty[i].Fuel().Percentage() != thirsty[j].Fuel().Percentage() {
+			return thirsty[i].Fuel().Percentage() < thirsty[j].Fuel().Percentage()
+		}
+		return thirsty[i].ShipSymbol() < thirsty[j].ShipSymbol()
+	})
+
+	served := 0
+	for _, ship := range thirsty {
+		resp, err := h.mediator.Send(ctx, &RefuelFromTankerCommand{
+			PlayerID:     cmd.PlayerID,
+			TankerSymbol: tankerSymbol,
+			ShipSymbol:   ship.ShipSymbol(),
+		})
+		if err != nil {
+			common.LoggerFromContext(ctx).Log("WARNING", fmt.Sprintf("Tanker %s failed to refuel %s: %v", tankerSymbol, ship.ShipSymbol(), err), nil)
+			continue
+		}
+		refuel, ok := resp.(*RefuelFromTankerResponse)
+		if !ok {
+			continue
+		}
+		if refuel.CargoUnitsTransferred > 0 {
+			served++
+		}
+		if refuel.TankerFuelRemaining <= 0 {
+			break
+		}
+	}
+	return served, nil
+}
+
+// claimTankers claims every tanker for this container, force-taking a stale
+// claim left by an earlier run: tankers are named explicitly, as gas ships are.
+func (h *RunTankerCoordinatorHandler) claimTankers(ctx context.Context, cmd *RunTankerCoordinatorCommand) error {
+	for _, symbol := range cmd.TankerShips {
+		if _, _, err := h.shipRepo.SaveWithRetry(ctx, symbol, cmd.PlayerID,
+			func(sh *navigation.Ship) (bool, error) {
+				if !sh.IsAssigned() || sh.ContainerID() == cmd.ContainerID {
+					return false, nil
+				}
+				sh.ForceRelease("reassigning to tanker coordinator", h.clock)
+				return true, nil
+			}); err != nil {
+			return fmt.Errorf("failed to release %s for the tanker fleet: %w", symbol, err)
+		}
+		if err := h.shipRepo.ClaimShip(ctx, symbol, cmd.ContainerID, cmd.PlayerID, operationTanker); err != nil {
+			return fmt.Errorf("failed to claim tanker %s: %w", symbol, err)
+		}
+	}
+	return nil
+}
+
+// releaseTankers frees the tankers when the coordinator stops.
+func (h *RunTankerCoordinatorHandler) releaseTankers(ctx context.Context, cmd *RunTankerCoordinatorCommand) {
+	for _, symbol := range cmd.TankerShips {
+		if _, _, err := h.shipRepo.SaveWithRetry(ctx, symbol, cmd.PlayerID,
+			func(sh *navigation.Ship) (bool, error) {
+				if sh.ContainerID() != cmd.ContainerID {
+					return false, nil
+				}
+				sh.ForceRelease("tanker_coordinator_stopped", h.clock)
+				return true, nil
+			}); err != nil {
+			common.LoggerFromContext(ctx).Log("WARNING", fmt.Sprintf("Failed to release tanker %s: %v", symbol, err), nil)
+		}
+	}
+}
+
+func errString(err error) string {
+	if err == nil {
+		return ""
+	}
+	return err.Error()
+}
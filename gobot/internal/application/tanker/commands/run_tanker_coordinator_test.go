@@ -0,0 +1,95 @@
+package commands
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/andrescamacho/spacetraders-go/internal/application/common"
+	shipCargo "github.com/andrescamacho/spacetraders-go/internal/application/ship/commands/cargo"
+	shipNav "github.com/andrescamacho/spacetraders-go/internal/application/ship/commands/navigation"
+	"github.com/andrescamacho/spacetraders-go/internal/domain/navigation"
+	"github.com/andrescamacho/spacetraders-go/internal/domain/shared"
+	"github.com/andrescamacho/spacetraders-go/internal/domain/system"
+)
+
+// tankerMediator records what the coordinator sends and answers a field
+// refuel with a transfer that leaves the tanker stocked.
+type tankerMediator struct {
+	common.Mediator
+	sent []common.Request
+}
+
+func (m *tankerMediator) Send(_ context.Context, request common.Request) (common.Response, error) {
+	m.sent = append(m.sent, request)
+	if _, ok := request.(*RefuelFromTankerCommand); ok {
+		return &RefuelFromTankerResponse{CargoUnitsTransferred: 3, TankerFuelRemaining: 30}, nil
+	}
+	return nil, nil
+}
+
+type tankerWaypoints struct {
+	system.WaypointRepository
+	waypoints []*shared.Waypoint
+}
+
+func (w *tankerWaypoints) ListBySystem(context.Context, string) ([]*shared.Waypoint, error) {
+	return w.waypoints, nil
+}
+
+func tankerCmd() *RunTankerCoordinatorCommand {
+	return &RunTankerCoordinatorCommand{
+		PlayerID:    shared.MustNewPlayerID(1),
+		ContainerID: "tanker-1",
+		TankerShips: []string{"AGENT-TANKER"},
+	}
+}
+
+// With no station named, the tanker holds the site with the most extraction
+// hulls, flies there, and serves only the drone running low.
+func TestTankerStep_StationsAtTheBusiestSiteAndServesLowShips(t *testing.T) {
+	market := tankerWaypoint(t, "X1-TK-A1", 0, true)
+	busy := tankerWaypoint(t, "X1-TK-B7", 50, false)
+	quiet := tankerWaypoint(t, "X1-TK-C3", -50, false)
+	tanker := tankerTestShip(t, "AGENT-TANKER", "HAULER", market, 400, 400, 40, 40)
+	low := tankerTestShip(t, "AGENT-DRONE-1", "EXCAVATOR", busy, 80, 400, 0, 15)
+	full := tankerTestShip(t, "AGENT-DRONE-2", "EXCAVATOR", busy, 380, 400, 0, 15)
+	lone := tankerTestShip(t, "AGENT-DRONE-3", "EXCAVATOR", quiet, 10, 400, 0, 15)
+
+	med := &tankerMediator{}
+	handler := NewRunTankerCoordinatorHandler(med, &tankerFakeRepo{ships: []*navigation.Ship{tanker, low, full, lone}}, &tankerWaypoints{}, nil)
+
+	step, err := handler.StepOnce(context.Background(), tankerCmd())
+
+	require.NoError(t, err)
+	require.Equal(t, 1, step.ShipsRefueled)
+	require.Len(t, med.sent, 2)
+	nav := med.sent[0].(*shipNav.NavigateRouteCommand)
+	require.Equal(t, "X1-TK-B7", nav.Destination)
+	refuel := med.sent[1].(*RefuelFromTankerCommand)
+	require.Equal(t, "AGENT-DRONE-1", refuel.ShipSymbol, "the near-full drone and the drone at another site are left alone")
+}
+
+// A tanker under its reload level goes to the nearest fuel market and fills
+// its free hold with FUEL before holding station again.
+func TestTankerStep_ReloadsAtTheNearestFuelMarket(t *testing.T) {
+	far := tankerWaypoint(t, "X1-TK-F9", 500, true)
+	near := tankerWaypoint(t, "X1-TK-A1", 20, true)
+	site := tankerWaypoint(t, "X1-TK-B7", 0, false)
+	tanker := tankerTestShip(t, "AGENT-TANKER", "HAULER", site, 400, 400, 4, 40)
+	drone := tankerTestShip(t, "AGENT-DRONE-1", "EXCAVATOR", site, 80, 400, 0, 15)
+
+	med := &tankerMediator{}
+	handler := NewRunTankerCoordinatorHandler(med, &tankerFakeRepo{ships: []*navigation.Ship{tanker, drone}}, &tankerWaypoints{waypoints: []*shared.Waypoint{far, near, site}}, nil)
+
+	step, err := handler.StepOnce(context.Background(), tankerCmd())
+
+	require.NoError(t, err)
+	require.Equal(t, 1, step.Reloads)
+	require.Len(t, med.sent, 2)
+	require.Equal(t, "X1-TK-A1", med.sent[0].(*shipNav.NavigateRouteCommand).Destination)
+	buy := med.sent[1].(*shipCargo.PurchaseCargoCommand)
+	require.Equal(t, fuelGood, buy.GoodSymbol)
+	require.Equal(t, 36, buy.Units)
+}
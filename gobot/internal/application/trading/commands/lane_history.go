@@ -0,0 +1,100 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/andrescamacho/spacetraders-go/internal/application/common"
+	"github.com/andrescamacho/spacetraders-go/internal/domain/trading"
+)
+
+// LaneExecutionRecorder persists the realized (buy market, sell market, good)
+// executions the trade lane leaderboard ranks. Satisfied by
+// *persistence.TradeLaneExecutionRepositoryGORM.
+type LaneExecutionRecorder interface {
+	Record(ctx context.Context, execution trading.LaneExecution) error
+}
+
+// recordLaneExecution writes one execution, best-effort: the sale it describes
+// has already happened, so a failed write is logged and never fails the trade.
+func recordLaneExecution(ctx context.Context, recorder LaneExecutionRecorder, execution trading.LaneExecution) {
+	if recorder == nil || execution.Units <= 0 {
+		return
+	}
+	if err := recorder.Record(ctx, execution); err != nil {
+		common.LoggerFromContext(ctx).Log("WARNING", fmt.Sprintf("Failed to record lane execution: %v", err), map[string]interface{}{
+			"action": "lane_execution_record", "source": execution.Source, "good": execution.Good,
+			"buy_market": execution.BuyMarket, "sell_market": execution.SellMarket, "error": err.Error(),
+		})
+	}
+}
+
+// tourBuyLot is a tranche a tour bought and still holds: units of a good bought
+// at market for cost.
+type tourBuyLot struct {
+	market string
+	units  int
+	cost   int
+}
+
+// tourLaneShare is the part of a sale drawn from one buy market.
+type tourLaneShare struct {
+	market string
+	units  int
+	cost   int
+}
+
+// tourLaneBook tracks, per good, the tranches a tour bought in the order it
+// bought them, so each sale can be attributed to the markets its units came
+// from. Units leave first-in first-out.
+type tourLaneBook map[string][]tourBuyLot
+
+// laneBook returns the run's lane book, creating it on first use.
+func (r *RunTourCoordinatorResponse) laneBook() tourLaneBook {
+	if r.lanes == nil {
+		r.lanes = tourLaneBook{}
+	}
+	return r.lanes
+}
+
+func (b tourLaneBook) bought(good, market string, units, cost int) {
+	if units <= 0 {
+		return
+	}
+	b[good] = append(b[good], tourBuyLot{market: market, units: units, cost: cost})
+}
+
+// take removes units of good from the book, oldest tranche first, and returns
+// the buy markets they came from with their share of the cost. Units beyond what
+// the book holds (cargo aboard before the tour bought any) have no known buy
+// market and are left out.
+func (b tourLaneBook) take(good string, units int) []tourLaneShare {
+	var shares []tourLaneShare
+	lots := b[good]
+	for units > 0 && len(lots) > 0 {
+		lot := &lots[0]
+		n := units
+		if lot.units < n {
+			n = lot.units
+		}
+		cost := lot.cost * n / lot.units
+		lot.units -= n
+		lot.cost -= cost
+		units -= n
+		if len(shares) > 0 && shares[len(shares)-1].market == lot.market {
+			shares[len(shares)-1].units += n
+			shares[len(shares)-1].cost += cost
+		} else {
+			shares = append(shares, tourLaneShare{market: lot.market, units: n, cost: cost})
+		}
+		if lot.units == 0 {
+			lots = lots[1:]
+		}
+	}
+	if len(lots) == 0 {
+		delete(b, good)
+	} else {
+		b[good] = lots
+	}
+	return shares
+}
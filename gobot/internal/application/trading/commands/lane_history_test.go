@@ -0,0 +1,30 @@
+package commands
+
+import "testing"
+
+func TestTourLaneBook_TakesOldestTranchesFirst(t *testing.T) {
+	book := tourLaneBook{}
+	book.bought("FUEL", "X1-A-B1", 10, 100)
+	book.bought("FUEL", "X1-A-B2", 10, 300)
+
+	shares := book.take("FUEL", 15)
+	if len(shares) != 2 {
+		t.Fatalf("expected the sale split across both buy markets, got %+v", shares)
+	}
+	if shares[0] != (tourLaneShare{market: "X1-A-B1", units: 10, cost: 100}) {
+		t.Fatalf("the older tranche sells first, got %+v", shares[0])
+	}
+	if shares[1] != (tourLaneShare{market: "X1-A-B2", units: 5, cost: 150}) {
+		t.Fatalf("the newer tranche gives up half its cost, got %+v", shares[1])
+	}
+
+	// Ten units left on the book, only five from the tour: the rest were aboard
+	// before it bought anything and have no lane.
+	shares = book.take("FUEL", 10)
+	if len(shares) != 1 || shares[0].units != 5 || shares[0].cost != 150 {
+		t.Fatalf("only the booked remainder is attributed, got %+v", shares)
+	}
+	if _, ok := book["FUEL"]; ok {
+		t.Fatal("an emptied good leaves the book")
+	}
+}
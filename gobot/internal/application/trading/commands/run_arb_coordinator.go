@@ -9,10 +9,13 @@ import (
 	"github.com/andrescamacho/spacetraders-go/internal/adapters/flowfeed"
 	"github.com/andrescamacho/spacetraders-go/internal/application/common"
 	"github.com/andrescamacho/spacetraders-go/internal/domain/absorption"
+	"github.com/andrescamacho/spacetraders-go/internal/domain/ledger"
 	"github.com/andrescamacho/spacetraders-go/internal/domain/market"
 	"github.com/andrescamacho/spacetraders-go/internal/domain/navigation"
 	domainPorts "github.com/andrescamacho/spacetraders-go/internal/domain/ports"
 	"github.com/andrescamacho/spacetraders-go/internal/domain/shared"
+	"github.com/andrescamacho/spacetraders-go/internal/domain/system"
+	"github.com/andrescamacho/spacetraders-go/internal/domain/trading"
 )
 
 // defaultArbSellFloorFraction (sp-lbbm) is the per-tranche sell floor's default
@@ -74,6 +77,14 @@ type RunArbCoordinatorCommand struct {
 	// reads it back here and reports the true net. It is REPORTING ONLY: no guard reads
 	// it (the spend caps read live state), so it can never gate or resize a buy.
 	PriorAttemptCost int
+	// ArrivalSpreadFloor is the per-unit spread (live destination bid − unit cost)
+	// the tranche must still clear when the hull docks at SellAt. Below it the run
+	// looks for a better-paying market within MaxRepriceDetour before selling.
+	// 0 → MinMargin, so by default only a loss-making sale triggers the search.
+	ArrivalSpreadFloor int
+	// MaxRepriceDetour bounds that search by distance from SellAt. 0 →
+	// defaultArbRepriceMaxDetour.
+	MaxRepriceDetour float64
 }
 
 // RunArbCoordinatorResponse reports the realised one-shot economics and, when the run
@@ -90,10 +101,22 @@ type RunArbCoordinatorResponse struct {
 	UnitsTraded    int
 	TotalCost      int
 	TotalRevenue   int
-	NetProfit      int
+	NetProfit      int // cash net: TotalRevenue − TotalCost, held cargo included at cost
 	Completed      bool
 	Error          string
 
+	// Per-lot execution. Lots lists each sale of the tranche (the destination first,
+	// then any alternate markets a partial fill spilled to) with its share of the buy
+	// cost; RealizedPnL sums their margins. Units still aboard are reported apart as
+	// unrealized: their remaining cost basis and, when a bid could be read where the
+	// hull ended, their value at MarkBid. UnrealizedPnL is 0 when unmarked.
+	Lots                []ArbLot
+	RealizedPnL         int
+	UnrealizedUnits     int
+	UnrealizedCostBasis int
+	UnrealizedPnL       int
+	MarkBid             int
+
 	// Aborted is set for any guarded refusal (location/min-margin/caps/spend-floor):
 	// the run reached a clean, defined "did not trade" conclusion, distinct from an
 	// operational failure (which surfaces as a non-nil error from Handle).
@@ -101,10 +124,12 @@ type RunArbCoordinatorResponse struct {
 	AbortReason string
 
 	// Margin gate (evaluated before the buy). SourceAsk is the live price the hull
-	// pays at BuyAt; DestBid is the price it would receive at SellAt; MarginPerUnit
-	// is DestBid−SourceAsk; MinMarginFloor echoes the requested floor.
+	// pays at BuyAt; DestBid is the price it would receive at SellAt; FeePerUnit is
+	// the modelled market fee on both legs; MarginPerUnit is
+	// DestBid−SourceAsk−FeePerUnit; MinMarginFloor echoes the requested floor.
 	SourceAsk      int
 	DestBid        int
+	FeePerUnit     int
 	MarginPerUnit  int
 	MinMarginFloor int
 	MarginAbort    bool
@@ -136,6 +161,16 @@ type RunArbCoordinatorResponse struct {
 	// plan/liquidation leg. Distinct from a routability/margin/spend abort, which
 	// all refuse BEFORE buying and hold nothing.
 	SellFloorAbort bool
+
+	// Arrival re-price, evaluated once the hull docks at SellAt. ArrivalBid is the
+	// live bid found there, ArrivalSpread that bid less the tranche's unit cost,
+	// ArrivalSpreadFloor the floor it was held to. RepriceDecision names the
+	// outcome; DivertedTo is the market the tranche was taken to instead, if any.
+	ArrivalBid         int
+	ArrivalSpread      int
+	ArrivalSpreadFloor int
+	RepriceDecision    string
+	DivertedTo         string
 }
 
 // RunArbCoordinatorHandler runs the one-shot guarded arb. It composes the proven
@@ -172,6 +207,15 @@ type RunArbCoordinatorHandler struct {
 	// SetAbsorptionLedger; a captain-directed arb run with no PLANNED row converts
 	// nothing (the update matches zero rows) — harmless.
 	absorptionLedger absorption.Ledger
+	// sellMarketRanker ranks alternate markets for a remainder the destination could
+	// not absorb. Optional; nil holds the remainder aboard (see SetSellMarketRanker).
+	sellMarketRanker ArbSellMarketRanker
+	// laneHistory records each sold lot as a lane execution for the trade lane
+	// leaderboard. Optional; nil records nothing (see SetLaneExecutionRecorder).
+	laneHistory LaneExecutionRecorder
+	// waypointRepo resolves coordinates so the arrival re-price can bound a
+	// diversion by distance. Optional; nil never diverts (see SetWaypointRepository).
+	waypointRepo system.WaypointRepository
 }
 
 // ArbCostPersister durably records a one-shot arb run's already-incurred buy cost
@@ -228,6 +272,13 @@ func (h *RunArbCoordinatorHandler) SetChartGateOnArrival(enabled bool) {
 	h.legs.SetChartGateOnArrival(enabled)
 }
 
+// SetCreditReservations wires the fleet-shared credit reservation service into the
+// delegated buy leg, so each ceilinged arb buy reserves its worst-case cost before it
+// is sent. Mirrors the SetGateGraph delegation.
+func (h *RunArbCoordinatorHandler) SetCreditReservations(reservations ledger.CreditReservations) {
+	h.legs.SetCreditReservations(reservations)
+}
+
 // SetEventSubscriber wires the ship-arrival event bus into the delegated movement
 // handler so the resume path waits out a hull re-adopted mid-transit before
 // attempting the jump (sp-8l3o) instead of 4214'ing and burning the restart budget.
@@ -253,6 +304,13 @@ func (h *RunArbCoordinatorHandler) SetAbsorptionLedger(ledger absorption.Ledger)
 	h.absorptionLedger = ledger
 }
 
+// SetLaneExecutionRecorder wires the lane execution history so every lot a run
+// sells is kept against its (source, market, good) lane. Left unset (nil), runs
+// leave no history. Mirrors the SetAbsorptionLedger optional-injection idiom.
+func (h *RunArbCoordinatorHandler) SetLaneExecutionRecorder(recorder LaneExecutionRecorder) {
+	h.laneHistory = recorder
+}
+
 // Handle executes the one-shot arb. A guarded refusal returns a nil error with the
 // matching *Abort flag set (a defined "did not trade" outcome); an operational
 // failure mid-run returns the underlying error with AbortReason naming the failed leg.
@@ -386,6 +444,14 @@ func (h *RunArbCoordinatorHandler) execute(
 		response.AbortReason = fmt.Sprintf("dock at destination %s failed: %v", cmd.SellAt, err)
 		return err
 	}
+	// ARRIVAL RE-PRICE: the bid may have collapsed while the hull was in flight.
+	// If the realized spread no longer clears the floor, the tranche is taken to
+	// a better-paying market within a bounded detour instead; sellAt is wherever
+	// the hull now sits docked, and divertBid that market's quoted bid.
+	sellAt, divertBid, err := h.repriceOnArrival(ctx, cmd, response, tranche)
+	if err != nil {
+		return err
+	}
 	// PER-TRANCHE SELL FLOOR (sp-lbbm): arm the sale with a per-unit floor so a bid
 	// our own tranches (or a colliding hull) crush mid-sale aborts the remainder
 	// instead of dumping it — the H50 fix (five tranches for 27 credits). The floor
@@ -403,8 +469,11 @@ func (h *RunArbCoordinatorHandler) execute(
 	if quotedBid <= 0 {
 		quotedBid = cmd.QuotedDestBid
 	}
+	if sellAt != cmd.SellAt {
+		quotedBid = divertBid
+	}
 	if quotedBid <= 0 {
-		if g, oerr := h.legs.observeGood(ctx, cmd.SellAt, cmd.Good, cmd.PlayerID); oerr == nil {
+		if g, oerr := h.legs.observeGood(ctx, sellAt, cmd.Good, cmd.PlayerID); oerr == nil {
 			quotedBid = g.PurchasePrice()
 		}
 	}
@@ -415,19 +484,37 @@ func (h *RunArbCoordinatorHandler) execute(
 
 	sellResp, err := h.legs.sellWithFloor(ctx, cmd.ShipSymbol, cmd.Good, tranche, cmd.PlayerID, minBidPerUnit)
 	if err != nil {
-		response.AbortReason = fmt.Sprintf("sell of %d %s at %s failed: %v", tranche, cmd.Good, cmd.SellAt, err)
+		response.AbortReason = fmt.Sprintf("sell of %d %s at %s failed: %v", tranche, cmd.Good, sellAt, err)
 		return err
 	}
-	response.TotalRevenue = sellResp.TotalRevenue
-	response.UnitsTraded = sellResp.UnitsSold
-	response.NetProfit = sellResp.TotalRevenue - response.TotalCost
+	recordArbLot(ctx, cmd, response, sellAt, sellResp.UnitsSold, sellResp.TotalRevenue, tranche)
 
 	// sp-78ai L2: convert this leg's PLANNED absorption hold into an EXECUTED recovery
 	// shadow with what ACTUALLY sold, before the held-cargo failure check below so a
 	// partial sale still records the depth it consumed. A zero-unit or untagged sale
 	// records nothing and releases the hold (Q2). No-op for a captain-directed arb run
 	// that never reserved (the update matches zero PLANNED rows).
-	h.convertAbsorptionShadow(ctx, cmd, sellResp.UnitsSold)
+	// A diverted tranche consumed none of the destination's depth, so it converts
+	// zero units and releases the hold.
+	absorbed := sellResp.UnitsSold
+	if sellAt != cmd.SellAt {
+		absorbed = 0
+	}
+	h.convertAbsorptionShadow(ctx, cmd, absorbed)
+
+	// Partial fill: the destination took less than the tranche (its trade volume, or
+	// the sell floor tripping as our own tranches walked the bid down). With a market
+	// ranker wired, split the remainder across the next-best markets in the
+	// destination system before falling through to the held-remainder failure below.
+	held, location := h.spillRemainder(ctx, cmd, response, sellAt, tranche-sellResp.UnitsSold, tranche, sellFloorFraction)
+	response.UnitsTraded, response.TotalRevenue = 0, 0
+	for _, lot := range response.Lots {
+		response.UnitsTraded += lot.Units
+		response.TotalRevenue += lot.Revenue
+	}
+	response.NetProfit = response.TotalRevenue - response.TotalCost
+	h.recordLaneExecutions(ctx, cmd, response)
+	h.markRemainder(ctx, cmd, response, held, location)
 
 	// A held remainder is a FAILURE, never a false success (sp-5nqx fix c, sp-lbbm).
 	// It arises two ways, both the stranded-veto situation: the sell floor aborted
@@ -439,29 +526,32 @@ func (h *RunArbCoordinatorHandler) execute(
 	// named distinctly (SellFloorAbort) so a deliberate money-guard hold reads apart
 	// from a destination-capacity strand; both carry good/units/location for
 	// greppable hand-recovery.
-	if held := tranche - sellResp.UnitsSold; held > 0 {
-		if sellResp.FloorAborted {
+	if held > 0 {
+		if sellResp.FloorAborted && location == sellAt {
 			response.SellFloorAbort = true
 			response.AbortReason = fmt.Sprintf(
 				"sell-floor abort: live bid %d < floor %d/unit (%.0f%% of quoted bid %d) at %s - sold %d of %d, %d units of %s held aboard for later liquidation",
-				sellResp.FloorObservedBid, minBidPerUnit, sellFloorFraction*100, quotedBid, cmd.SellAt,
+				sellResp.FloorObservedBid, minBidPerUnit, sellFloorFraction*100, quotedBid, sellAt,
 				sellResp.UnitsSold, tranche, held, cmd.Good,
 			)
 			logger.Log("WARNING", response.AbortReason, map[string]interface{}{
 				"action": "arb_sell_floor_abort", "ship_symbol": cmd.ShipSymbol,
-				"good": cmd.Good, "sell_at": cmd.SellAt, "live_bid": sellResp.FloorObservedBid,
+				"good": cmd.Good, "sell_at": sellAt, "live_bid": sellResp.FloorObservedBid,
 				"floor": minBidPerUnit, "quoted_bid": quotedBid, "sold": sellResp.UnitsSold, "held": held,
+				"realized_pnl": response.RealizedPnL, "unrealized_pnl": response.UnrealizedPnL,
 			})
 			return fmt.Errorf("%s", response.AbortReason)
 		}
 		response.AbortReason = fmt.Sprintf(
-			"stranded cargo: %d unsold units of %s at %s (sold %d of %d) - reporting failure",
-			held, cmd.Good, cmd.SellAt, sellResp.UnitsSold, tranche,
+			"stranded cargo: %d unsold units of %s at %s (sold %d of %d across %d market(s)) - reporting failure",
+			held, cmd.Good, location, response.UnitsTraded, tranche, len(response.Lots),
 		)
 		logger.Log("ERROR", response.AbortReason, map[string]interface{}{
 			"action": "arb_stranded_cargo", "ship_symbol": cmd.ShipSymbol,
-			"good": cmd.Good, "stranded": held, "sold": sellResp.UnitsSold,
-			"tranche": tranche, "location": cmd.SellAt,
+			"good": cmd.Good, "stranded": held, "sold": response.UnitsTraded,
+			"tranche": tranche, "location": location, "lots": len(response.Lots),
+			"realized_pnl": response.RealizedPnL, "unrealized_cost_basis": response.UnrealizedCostBasis,
+			"unrealized_pnl": response.UnrealizedPnL,
 		})
 		return fmt.Errorf("%s", response.AbortReason)
 	}
@@ -469,11 +559,36 @@ func (h *RunArbCoordinatorHandler) execute(
 	logger.Log("INFO", "One-shot arb complete", map[string]interface{}{
 		"ship_symbol": cmd.ShipSymbol, "good": cmd.Good, "source": cmd.BuyAt, "dest": cmd.SellAt,
 		"units": response.UnitsTraded, "cost": response.TotalCost, "revenue": response.TotalRevenue, "net": response.NetProfit,
+		"lots": len(response.Lots), "realized_pnl": response.RealizedPnL,
 	})
 	// One shot: no loop. The container runner releases the hull on this return.
 	return nil
 }
 
+// recordLaneExecutions keeps each sold lot as an execution of the lane from
+// BuyAt to the lot's market. A resumed run whose buy cost was never persisted
+// has no cost basis, so its lots would read as pure profit; it records nothing.
+func (h *RunArbCoordinatorHandler) recordLaneExecutions(ctx context.Context, cmd *RunArbCoordinatorCommand, response *RunArbCoordinatorResponse) {
+	if h.laneHistory == nil || response.TotalCost <= 0 {
+		return
+	}
+	now := h.legs.clock.Now()
+	for _, lot := range response.Lots {
+		recordLaneExecution(ctx, h.laneHistory, trading.LaneExecution{
+			PlayerID:    cmd.PlayerID,
+			ContainerID: cmd.ContainerID,
+			Source:      trading.LaneSourceArb,
+			BuyMarket:   cmd.BuyAt,
+			SellMarket:  lot.Market,
+			Good:        cmd.Good,
+			Units:       lot.Units,
+			Cost:        lot.CostBasis,
+			Revenue:     lot.Revenue,
+			ExecutedAt:  now,
+		})
+	}
+}
+
 // guardAndBuy runs the four pre-buy guards (location, min-margin, caps, spend-floor)
 // and, if all clear, executes the one-shot buy, returning the units bought. A guarded
 // refusal sets response.Aborted (+ the matching *Abort flag) and returns (0, nil) — a
@@ -583,9 +698,11 @@ func (h *RunArbCoordinatorHandler) guardAndBuy(
 
 	sourceAsk := srcGood.SellPrice()   // what the hull PAYS to buy at the source
 	destBid := dstGood.PurchasePrice() // what the hull RECEIVES selling at the destination
-	marginPerUnit := destBid - sourceAsk
+	feePerUnit := trading.ActiveMarketFees().LaneFeePerUnit(cmd.BuyAt, sourceAsk, cmd.SellAt, destBid)
+	marginPerUnit := destBid - sourceAsk - feePerUnit
 	response.SourceAsk = sourceAsk
 	response.DestBid = destBid
+	response.FeePerUnit = feePerUnit
 	response.MarginPerUnit = marginPerUnit
 	response.MinMarginFloor = cmd.MinMargin
 	// sp-lbbm: record the quoted bid as the sell floor's healthy anchor, so an
@@ -598,10 +715,10 @@ func (h *RunArbCoordinatorHandler) guardAndBuy(
 	if marginPerUnit <= 0 || (cmd.MinMargin > 0 && marginPerUnit < cmd.MinMargin) {
 		response.Aborted = true
 		response.MarginAbort = true
-		response.AbortReason = fmt.Sprintf("margin %d/unit (%d bid − %d ask) below floor %d - aborting before buy", marginPerUnit, destBid, sourceAsk, cmd.MinMargin)
+		response.AbortReason = fmt.Sprintf("margin %d/unit (%d bid − %d ask − %d fees) below floor %d - aborting before buy", marginPerUnit, destBid, sourceAsk, feePerUnit, cmd.MinMargin)
 		logger.Log("WARNING", response.AbortReason, map[string]interface{}{
 			"good": cmd.Good, "source": cmd.BuyAt, "dest": cmd.SellAt,
-			"source_ask": sourceAsk, "dest_bid": destBid, "margin": marginPerUnit, "min_margin": cmd.MinMargin,
+			"source_ask": sourceAsk, "dest_bid": destBid, "fee_per_unit": feePerUnit, "margin": marginPerUnit, "min_margin": cmd.MinMargin,
 		})
 		return 0, nil
 	}
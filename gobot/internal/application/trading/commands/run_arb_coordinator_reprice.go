@@ -0,0 +1,189 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"github.com/andrescamacho/spacetraders-go/internal/application/common"
+	"github.com/andrescamacho/spacetraders-go/internal/domain/shared"
+	"github.com/andrescamacho/spacetraders-go/internal/domain/system"
+)
+
+// defaultArbRepriceMaxDetour bounds how far from the destination an arrival
+// re-price may divert the hull, in system distance units. A diversion is one
+// extra in-system flight, so the bound keeps a price rescue from costing more
+// fuel and time than the spread it recovers.
+const defaultArbRepriceMaxDetour = 150.0
+
+// Arrival re-price decisions, recorded on the response and in the execution log.
+const (
+	arbRepriceHold    = "SELL_AT_DESTINATION"        // spread still clears the floor
+	arbRepriceDivert  = "DIVERT"                     // spread collapsed; an alternate market clears the floor
+	arbRepriceNoAlt   = "SELL_AT_DESTINATION_NO_ALT" // spread collapsed; nothing within the detour clears the floor
+	arbRepriceNoBasis = "SKIPPED_NO_COST_BASIS"      // resumed run without a persisted buy cost
+	arbRepriceNoBid   = "SKIPPED_NO_DESTINATION_BID" // destination bid unreadable on arrival
+)
+
+// SetWaypointRepository wires the waypoint coordinates the arrival re-price uses
+// to bound a diversion by distance from the destination. Left unset (nil), no
+// alternate can be proven inside the detour, so a collapsed spread is logged and
+// the tranche sells at the destination exactly as before. Mirrors the
+// SetSellMarketRanker optional-injection idiom.
+func (h *RunArbCoordinatorHandler) SetWaypointRepository(repo system.WaypointRepository) {
+	h.waypointRepo = repo
+}
+
+// repriceOnArrival re-checks the tranche's spread against the destination's
+// live bid once the hull has docked there. While the realized spread (live bid
+// minus unit cost) still clears the floor it returns the destination unchanged.
+// When the bid has collapsed under the floor it looks for the best-paying market
+// in the destination system within the detour bound that does clear it, flies
+// and docks there, and returns that market with its quoted bid as the new sell
+// anchor. Every evaluated outcome is logged as an arb_arrival_reprice line.
+//
+// The check is advisory up to the point it moves the hull: an unreadable bid, a
+// missing cost basis, or no qualifying alternate all fall through to the
+// destination sale, which keeps its own per-tranche floor. A failed diversion
+// leg returns the error like any other travel/dock failure.
+func (h *RunArbCoordinatorHandler) repriceOnArrival(
+	ctx context.Context,
+	cmd *RunArbCoordinatorCommand,
+	response *RunArbCoordinatorResponse,
+	tranche int,
+) (string, int, error) {
+	logger := common.LoggerFromContext(ctx)
+	record := func(level, decision, msg string, fields map[string]interface{}) {
+		response.RepriceDecision = decision
+		fields["action"] = "arb_arrival_reprice"
+		fields["decision"] = decision
+		fields["ship_symbol"] = cmd.ShipSymbol
+		fields["good"] = cmd.Good
+		fields["sell_at"] = cmd.SellAt
+		logger.Log(level, msg, fields)
+	}
+
+	if response.TotalCost <= 0 || tranche <= 0 {
+		record("INFO", arbRepriceNoBasis, fmt.Sprintf(
+			"Arrival re-price skipped at %s: no cost basis for the %d %s aboard", cmd.SellAt, tranche, cmd.Good,
+		), map[string]interface{}{"tranche": tranche})
+		return cmd.SellAt, 0, nil
+	}
+	unitCost := int(math.Ceil(float64(response.TotalCost) / float64(tranche)))
+	floor := cmd.ArrivalSpreadFloor
+	if floor == 0 {
+		floor = cmd.MinMargin
+	}
+
+	// The hull is docked, so refresh the destination live; a failed refresh
+	// leaves the cached bid in play rather than blocking the sale.
+	if h.marketRefresher != nil {
+		if rerr := h.marketRefresher.ScanAndSaveMarket(ctx, uint(cmd.PlayerID), cmd.SellAt); rerr != nil {
+			logger.Log("WARNING", fmt.Sprintf("Arrival re-price: live refresh of %s failed, using cached bid: %v", cmd.SellAt, rerr),
+				map[string]interface{}{"ship_symbol": cmd.ShipSymbol, "waypoint": cmd.SellAt, "error": rerr.Error()})
+		}
+	}
+	g, err := h.legs.observeGood(ctx, cmd.SellAt, cmd.Good, cmd.PlayerID)
+	if err != nil || g == nil || g.PurchasePrice() <= 0 {
+		record("WARNING", arbRepriceNoBid, fmt.Sprintf(
+			"Arrival re-price skipped at %s: no readable bid for %s", cmd.SellAt, cmd.Good,
+		), map[string]interface{}{"unit_cost": unitCost})
+		return cmd.SellAt, 0, nil
+	}
+	response.ArrivalBid = g.PurchasePrice()
+	response.ArrivalSpread = response.ArrivalBid - unitCost
+	response.ArrivalSpreadFloor = floor
+
+	if response.ArrivalSpread >= floor {
+		record("INFO", arbRepriceHold, fmt.Sprintf(
+			"Arrival re-price at %s: spread %d/unit clears floor %d - selling at destination",
+			cmd.SellAt, response.ArrivalSpread, floor,
+		), map[string]interface{}{"arrival_bid": response.ArrivalBid, "unit_cost": unitCost, "spread": response.ArrivalSpread, "floor": floor})
+		return cmd.SellAt, 0, nil
+	}
+
+	maxDetour := cmd.MaxRepriceDetour
+	if maxDetour <= 0 {
+		maxDetour = defaultArbRepriceMaxDetour
+	}
+	alt, altBid, distance := h.bestRepriceAlternate(ctx, cmd, unitCost+floor, response.ArrivalBid, maxDetour)
+	if alt == "" {
+		record("WARNING", arbRepriceNoAlt, fmt.Sprintf(
+			"Arrival re-price at %s: spread %d/unit below floor %d (bid %d vs cost %d) and no market within %.0f clears it - selling at destination",
+			cmd.SellAt, response.ArrivalSpread, floor, response.ArrivalBid, unitCost, maxDetour,
+		), map[string]interface{}{
+			"arrival_bid": response.ArrivalBid, "unit_cost": unitCost, "spread": response.ArrivalSpread,
+			"floor": floor, "max_detour": maxDetour,
+		})
+		return cmd.SellAt, 0, nil
+	}
+
+	record("INFO", arbRepriceDivert, fmt.Sprintf(
+		"Arrival re-price at %s: spread %d/unit below floor %d - diverting %d %s to %s (bid %d, %.0f away)",
+		cmd.SellAt, response.ArrivalSpread, floor, tranche, cmd.Good, alt, altBid, distance,
+	), map[string]interface{}{
+		"arrival_bid": response.ArrivalBid, "unit_cost": unitCost, "spread": response.ArrivalSpread,
+		"floor": floor, "divert_to": alt, "divert_bid": altBid, "detour": distance,
+	})
+	response.DivertedTo = alt
+
+	ship, err := h.legs.loadShip(ctx, cmd.ShipSymbol, cmd.PlayerID)
+	if err != nil {
+		response.AbortReason = fmt.Sprintf("could not reload ship %s before re-price diversion: %v", cmd.ShipSymbol, err)
+		return "", 0, err
+	}
+	ship, err = h.legs.travel(ctx, ship, alt, cmd.PlayerID)
+	if err != nil {
+		response.AbortReason = fmt.Sprintf("re-price diversion of %s to %s failed: %v", cmd.ShipSymbol, alt, err)
+		return "", 0, err
+	}
+	if err := h.legs.dock(ctx, ship, cmd.PlayerID); err != nil {
+		response.AbortReason = fmt.Sprintf("dock at re-price alternate %s failed: %v", alt, err)
+		return "", 0, err
+	}
+	return alt, altBid, nil
+}
+
+// bestRepriceAlternate returns the highest-bidding market in the destination
+// system that pays at least minBid, beats the destination's own bid, and lies
+// within maxDetour of the destination. A market whose distance cannot be read
+// is never chosen: an unbounded detour is exactly what the bound exists to stop.
+func (h *RunArbCoordinatorHandler) bestRepriceAlternate(
+	ctx context.Context,
+	cmd *RunArbCoordinatorCommand,
+	minBid, destBid int,
+	maxDetour float64,
+) (string, int, float64) {
+	if h.sellMarketRanker == nil || h.waypointRepo == nil {
+		return "", 0, 0
+	}
+	systemSymbol := shared.ExtractSystemSymbol(cmd.SellAt)
+	candidates, err := h.sellMarketRanker.RankSellMarkets(ctx, cmd.Good, systemSymbol, cmd.PlayerID)
+	if err != nil {
+		return "", 0, 0
+	}
+	dest, err := h.waypointRepo.FindBySymbol(ctx, cmd.SellAt, systemSymbol)
+	if err != nil || dest == nil {
+		return "", 0, 0
+	}
+
+	best, bestBid, bestDistance := "", 0, 0.0
+	for _, candidate := range candidates {
+		if candidate.WaypointSymbol == cmd.SellAt || candidate.WaypointSymbol == cmd.BuyAt {
+			continue
+		}
+		if candidate.PurchasePrice < minBid || candidate.PurchasePrice <= destBid || candidate.PurchasePrice <= bestBid {
+			continue
+		}
+		wp, werr := h.waypointRepo.FindBySymbol(ctx, candidate.WaypointSymbol, systemSymbol)
+		if werr != nil || wp == nil {
+			continue
+		}
+		distance := dest.DistanceTo(wp)
+		if distance > maxDetour {
+			continue
+		}
+		best, bestBid, bestDistance = candidate.WaypointSymbol, candidate.PurchasePrice, distance
+	}
+	return best, bestBid, bestDistance
+}
@@ -0,0 +1,134 @@
+package commands
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/andrescamacho/spacetraders-go/internal/application/common"
+	mfgServices "github.com/andrescamacho/spacetraders-go/internal/application/manufacturing/services"
+	shipCargo "github.com/andrescamacho/spacetraders-go/internal/application/ship/commands/cargo"
+	"github.com/andrescamacho/spacetraders-go/internal/domain/market"
+	"github.com/andrescamacho/spacetraders-go/internal/domain/shared"
+	"github.com/andrescamacho/spacetraders-go/internal/domain/system"
+)
+
+// arbCollapseMediator collapses the destination bid the moment the buy fills,
+// standing in for a price crash while the hull is in flight.
+type arbCollapseMediator struct {
+	arbPartialSellMediator
+	repo *arbCollapseMarketRepo
+}
+
+func (m *arbCollapseMediator) Send(ctx context.Context, request common.Request) (common.Response, error) {
+	if _, ok := request.(*shipCargo.PurchaseCargoCommand); ok {
+		m.repo.collapsed = true
+	}
+	return m.arbPartialSellMediator.Send(ctx, request)
+}
+
+// arbCollapseMarketRepo quotes the healthy 4000 destination bid until the buy,
+// then 1800 — under the tranche's 2000/unit cost.
+type arbCollapseMarketRepo struct {
+	trFakeMarketRepo
+	collapsed bool
+}
+
+func (r *arbCollapseMarketRepo) GetMarketData(ctx context.Context, waypointSymbol string, playerID int) (*market.Market, error) {
+	if waypointSymbol != trDest || !r.collapsed {
+		return r.trFakeMarketRepo.GetMarketData(ctx, waypointSymbol, playerID)
+	}
+	supply, activity := "MODERATE", "WEAK"
+	good, err := market.NewTradeGood(trGood, &supply, &activity, 1800, 1900, 30, market.TradeTypeImport)
+	if err != nil {
+		return nil, err
+	}
+	return market.NewMarket(waypointSymbol, []market.TradeGood{*good}, time.Now())
+}
+
+// arbFakeWaypointRepo serves fixed waypoint coordinates.
+type arbFakeWaypointRepo struct {
+	system.WaypointRepository
+	coords map[string][2]float64
+}
+
+func (r *arbFakeWaypointRepo) FindBySymbol(_ context.Context, symbol, _ string) (*shared.Waypoint, error) {
+	c := r.coords[symbol]
+	return shared.NewWaypoint(symbol, c[0], c[1])
+}
+
+func newArbCollapseHandler(t *testing.T, altDistance float64) (*RunArbCoordinatorHandler, *arbCollapseMediator) {
+	t.Helper()
+	ship := newTradeHauler(t, "ARB-REPRICE")
+	repo := &arbCollapseMarketRepo{trFakeMarketRepo: trFakeMarketRepo{fixture: &trFixture{}}}
+	mediator := &arbCollapseMediator{arbPartialSellMediator: arbPartialSellMediator{sellCap: 40}, repo: repo}
+	h := NewRunArbCoordinatorHandler(mediator, &trFakeShipRepo{ship: ship}, repo, nil, nil, nil)
+	h.SetSellMarketRanker(&arbFakeRanker{markets: []*mfgServices.EligibleMarket{
+		{WaypointSymbol: trAltMarket, PurchasePrice: 3600},
+	}})
+	h.SetWaypointRepository(&arbFakeWaypointRepo{coords: map[string][2]float64{
+		trDest:      {0, 0},
+		trAltMarket: {altDistance, 0},
+	}})
+	return h, mediator
+}
+
+// The bid collapses to 1800 in flight, under the 2000/unit cost. An alternate
+// market 60 away still bids 3600, so the whole tranche is taken there and sold
+// against that market's quote instead of being dumped at a loss.
+func TestArbCoordinator_ArrivalReprice_DivertsCollapsedSpreadToAlternate(t *testing.T) {
+	h, mediator := newArbCollapseHandler(t, 60)
+
+	resp, err := h.Handle(context.Background(), &RunArbCoordinatorCommand{
+		ShipSymbol: "ARB-REPRICE",
+		Good:       trGood,
+		BuyAt:      trSource,
+		SellAt:     trDest,
+		PlayerID:   1,
+	})
+	if err != nil {
+		t.Fatalf("a diverted sale must complete, got: %v", err)
+	}
+	arb := arbResponse(t, resp)
+
+	if arb.RepriceDecision != arbRepriceDivert || arb.DivertedTo != trAltMarket {
+		t.Fatalf("expected a diversion to %s, got decision %q to %q", trAltMarket, arb.RepriceDecision, arb.DivertedTo)
+	}
+	if arb.ArrivalBid != 1800 || arb.ArrivalSpread != -200 {
+		t.Fatalf("arrival bid/spread must read 1800/-200, got %d/%d", arb.ArrivalBid, arb.ArrivalSpread)
+	}
+	if len(mediator.sells) != 1 || mediator.sells[0].MinBidPerUnit != 2880 {
+		t.Fatalf("expected one sale floored at 80%% of the alternate quote (2880), got %+v", mediator.sells)
+	}
+	if len(arb.Lots) != 1 || arb.Lots[0].Market != trAltMarket || arb.Lots[0].Units != 40 {
+		t.Fatalf("the whole tranche must be booked at %s, got %+v", trAltMarket, arb.Lots)
+	}
+}
+
+// The same collapse with the only alternate beyond the detour bound: nothing is
+// diverted and the tranche sells at the destination, the decision recorded.
+func TestArbCoordinator_ArrivalReprice_SellsAtDestinationWhenAlternateOutOfReach(t *testing.T) {
+	h, mediator := newArbCollapseHandler(t, 500)
+
+	resp, err := h.Handle(context.Background(), &RunArbCoordinatorCommand{
+		ShipSymbol: "ARB-REPRICE",
+		Good:       trGood,
+		BuyAt:      trSource,
+		SellAt:     trDest,
+		PlayerID:   1,
+	})
+	if err != nil {
+		t.Fatalf("run errored: %v", err)
+	}
+	arb := arbResponse(t, resp)
+
+	if arb.RepriceDecision != arbRepriceNoAlt || arb.DivertedTo != "" {
+		t.Fatalf("expected no diversion, got decision %q to %q", arb.RepriceDecision, arb.DivertedTo)
+	}
+	if len(arb.Lots) != 1 || arb.Lots[0].Market != trDest {
+		t.Fatalf("the tranche must sell at the destination, got %+v", arb.Lots)
+	}
+	if len(mediator.sells) != 1 {
+		t.Fatalf("expected exactly one sale, got %d", len(mediator.sells))
+	}
+}
@@ -0,0 +1,191 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"github.com/andrescamacho/spacetraders-go/internal/application/common"
+	mfgServices "github.com/andrescamacho/spacetraders-go/internal/application/manufacturing/services"
+	"github.com/andrescamacho/spacetraders-go/internal/domain/navigation"
+	"github.com/andrescamacho/spacetraders-go/internal/domain/shared"
+)
+
+// maxArbSpillMarkets bounds how many alternate markets one arb run visits to place
+// the remainder its destination could not absorb. Each visit is a flight, so the
+// cap keeps a thin good from turning a one-shot run into a system-wide tour.
+const maxArbSpillMarkets = 3
+
+// ArbSellMarketRanker ranks a system's sell markets for a good, best first. The
+// daemon backs it with the manufacturing SellMarketDistributor, so an arb
+// remainder spreads across the same SCARCE/LIMITED importers collection sells use
+// instead of piling onto one.
+type ArbSellMarketRanker interface {
+	RankSellMarkets(ctx context.Context, good, systemSymbol string, playerID int) ([]*mfgServices.EligibleMarket, error)
+}
+
+// ArbLot is one sale of the run's tranche at one market. CostBasis is the lot's
+// share of the tranche's buy cost, so RealizedPnL is the lot's true margin.
+type ArbLot struct {
+	Market      string
+	Units       int
+	Revenue     int
+	CostBasis   int
+	RealizedPnL int
+}
+
+// SetSellMarketRanker wires the alternate-market ranking that lets a run split a
+// remainder the destination could not absorb across other markets in the
+// destination system. Left unset (nil), a partial fill holds the remainder aboard
+// and fails the run exactly as before. Mirrors the SetCostPersister optional-
+// injection idiom.
+func (h *RunArbCoordinatorHandler) SetSellMarketRanker(ranker ArbSellMarketRanker) {
+	h.sellMarketRanker = ranker
+}
+
+// recordArbLot books one sale against the tranche. Lot bases are cut from the
+// cumulative units sold, so once the whole tranche has sold they sum to TotalCost
+// exactly, with no rounding remainder left behind as a phantom loss.
+func recordArbLot(ctx context.Context, cmd *RunArbCoordinatorCommand, response *RunArbCoordinatorResponse, marketSymbol string, units, revenue, tranche int) {
+	if units <= 0 {
+		return
+	}
+	soldBefore := 0
+	for _, lot := range response.Lots {
+		soldBefore += lot.Units
+	}
+	basis := arbCostBasis(response.TotalCost, tranche, soldBefore+units) - arbCostBasis(response.TotalCost, tranche, soldBefore)
+	lot := ArbLot{
+		Market:      marketSymbol,
+		Units:       units,
+		Revenue:     revenue,
+		CostBasis:   basis,
+		RealizedPnL: revenue - basis,
+	}
+	response.Lots = append(response.Lots, lot)
+	response.RealizedPnL += lot.RealizedPnL
+
+	common.LoggerFromContext(ctx).Log("INFO", fmt.Sprintf(
+		"Arb lot %d: sold %d %s at %s for %d against a %d cost basis (realized %+d)",
+		len(response.Lots), units, cmd.Good, marketSymbol, revenue, basis, lot.RealizedPnL,
+	), map[string]interface{}{
+		"action": "arb_lot", "ship_symbol": cmd.ShipSymbol, "good": cmd.Good,
+		"market": marketSymbol, "units": units, "revenue": revenue,
+		"cost_basis": basis, "realized_pnl": lot.RealizedPnL, "lot": len(response.Lots),
+	})
+}
+
+// arbCostBasis is the share of totalCost carried by the first units of a tranche.
+func arbCostBasis(totalCost, tranche, units int) int {
+	if tranche <= 0 {
+		return 0
+	}
+	return totalCost * units / tranche
+}
+
+// spillRemainder places units the market at soldAt (the destination, or the
+// arrival re-price's alternate) left unsold at the next-best markets in the
+// destination system, returning what is still held and the market the hull
+// ended at. It is strictly best-effort: a market quoting below the tranche's unit
+// cost is skipped (the remainder is held for liquidation rather than sold at a
+// realized loss), each sale carries the same per-tranche floor the destination
+// sale did, and any failed leg stops the spill with the rest held aboard for the
+// caller's held-remainder failure.
+func (h *RunArbCoordinatorHandler) spillRemainder(
+	ctx context.Context,
+	cmd *RunArbCoordinatorCommand,
+	response *RunArbCoordinatorResponse,
+	soldAt string,
+	held, tranche int,
+	floorFraction float64,
+) (int, string) {
+	location := soldAt
+	if h.sellMarketRanker == nil || held <= 0 {
+		return held, location
+	}
+	logger := common.LoggerFromContext(ctx)
+	warn := func(msg string, err error) {
+		logger.Log("WARNING", fmt.Sprintf("Arb spill stopped: %s: %v (%d %s held aboard at %s)", msg, err, held, cmd.Good, location),
+			map[string]interface{}{
+				"action": "arb_spill_stopped", "ship_symbol": cmd.ShipSymbol, "good": cmd.Good,
+				"held": held, "location": location, "error": err.Error(),
+			})
+	}
+
+	candidates, err := h.sellMarketRanker.RankSellMarkets(ctx, cmd.Good, shared.ExtractSystemSymbol(cmd.SellAt), cmd.PlayerID)
+	if err != nil {
+		warn("ranking alternate markets failed", err)
+		return held, location
+	}
+
+	unitCost := 0
+	if tranche > 0 {
+		unitCost = int(math.Ceil(float64(response.TotalCost) / float64(tranche)))
+	}
+	visited := 0
+	for _, candidate := range candidates {
+		if held <= 0 || visited >= maxArbSpillMarkets {
+			break
+		}
+		if candidate.WaypointSymbol == cmd.SellAt || candidate.WaypointSymbol == soldAt || candidate.WaypointSymbol == cmd.BuyAt {
+			continue
+		}
+		if candidate.PurchasePrice <= 0 || candidate.PurchasePrice < unitCost {
+			continue
+		}
+		visited++
+
+		var ship *navigation.Ship
+		ship, err = h.legs.loadShip(ctx, cmd.ShipSymbol, cmd.PlayerID)
+		if err != nil {
+			warn("reload before spill travel failed", err)
+			break
+		}
+		ship, err = h.legs.travel(ctx, ship, candidate.WaypointSymbol, cmd.PlayerID)
+		if err != nil {
+			warn(fmt.Sprintf("travel to %s failed", candidate.WaypointSymbol), err)
+			break
+		}
+		location = candidate.WaypointSymbol
+		if err = h.legs.dock(ctx, ship, cmd.PlayerID); err != nil {
+			warn(fmt.Sprintf("dock at %s failed", candidate.WaypointSymbol), err)
+			break
+		}
+
+		minBid := int(math.Ceil(floorFraction * float64(candidate.PurchasePrice)))
+		sellResp, serr := h.legs.sellWithFloor(ctx, cmd.ShipSymbol, cmd.Good, held, cmd.PlayerID, minBid)
+		if serr != nil {
+			warn(fmt.Sprintf("sell at %s failed", candidate.WaypointSymbol), serr)
+			break
+		}
+		recordArbLot(ctx, cmd, response, candidate.WaypointSymbol, sellResp.UnitsSold, sellResp.TotalRevenue, tranche)
+		held -= sellResp.UnitsSold
+	}
+	return held, location
+}
+
+// markRemainder reports what is still aboard as unrealized: its share of the
+// buy cost, and its value at the current bid where the hull sits. With no bid to
+// mark against, MarkBid stays 0 and UnrealizedPnL is left at zero rather than
+// booking the whole basis as a loss.
+func (h *RunArbCoordinatorHandler) markRemainder(
+	ctx context.Context,
+	cmd *RunArbCoordinatorCommand,
+	response *RunArbCoordinatorResponse,
+	held int,
+	location string,
+) {
+	booked := 0
+	for _, lot := range response.Lots {
+		booked += lot.CostBasis
+	}
+	response.UnrealizedUnits = held
+	response.UnrealizedCostBasis = response.TotalCost - booked
+	if held <= 0 {
+		return
+	}
+	if g, err := h.legs.observeGood(ctx, location, cmd.Good, cmd.PlayerID); err == nil && g != nil && g.PurchasePrice() > 0 {
+		response.MarkBid = g.PurchasePrice()
+		response.UnrealizedPnL = held*response.MarkBid - response.UnrealizedCostBasis
+	}
+}
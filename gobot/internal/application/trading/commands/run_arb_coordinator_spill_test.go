@@ -0,0 +1,106 @@
+package commands
+
+import (
+	"context"
+	"testing"
+
+	mfgServices "github.com/andrescamacho/spacetraders-go/internal/application/manufacturing/services"
+)
+
+const trAltMarket = "X1-TR-ALT"
+
+// arbFakeRanker serves a fixed alternate-market ranking.
+type arbFakeRanker struct {
+	markets []*mfgServices.EligibleMarket
+	calls   int
+}
+
+func (r *arbFakeRanker) RankSellMarkets(context.Context, string, string, int) ([]*mfgServices.EligibleMarket, error) {
+	r.calls++
+	return r.markets, nil
+}
+
+// The destination absorbs only 30 of the 40u tranche; with a ranker wired the
+// remaining 10 go to the next-best market instead of being held as a loss, and
+// each lot carries its share of the 80,000 buy cost.
+func TestArbCoordinator_PartialFill_SpillsRemainderToRankedMarket(t *testing.T) {
+	ship := newTradeHauler(t, "ARB-SPILL")
+	mediator := &arbPartialSellMediator{sellCap: 30}
+	h := arbHandlerWith(mediator, ship)
+	ranker := &arbFakeRanker{markets: []*mfgServices.EligibleMarket{
+		{WaypointSymbol: trDest, PurchasePrice: 4000}, // the destination itself is never revisited
+		{WaypointSymbol: trAltMarket, PurchasePrice: 3600},
+	}}
+	h.SetSellMarketRanker(ranker)
+
+	resp, err := h.Handle(context.Background(), &RunArbCoordinatorCommand{
+		ShipSymbol: ship.ShipSymbol(),
+		Good:       trGood,
+		BuyAt:      trSource,
+		SellAt:     trDest,
+		PlayerID:   1,
+	})
+	if err != nil {
+		t.Fatalf("a remainder placed at an alternate market must complete, got: %v", err)
+	}
+	arb := arbResponse(t, resp)
+
+	if len(mediator.sells) != 2 || mediator.sells[1].Units != 10 {
+		t.Fatalf("expected the 10u remainder sold in a second sale, got %d sells", len(mediator.sells))
+	}
+	if got := mediator.sells[1].MinBidPerUnit; got != 2880 {
+		t.Fatalf("the spill sale must be floored at 80%% of the alternate quote (2880), got %d", got)
+	}
+	if len(arb.Lots) != 2 || arb.Lots[1].Market != trAltMarket {
+		t.Fatalf("expected a destination lot and an alternate lot, got %+v", arb.Lots)
+	}
+	if arb.Lots[0].CostBasis != 60000 || arb.Lots[1].CostBasis != 20000 {
+		t.Fatalf("lot bases must split the 80000 cost 30:10, got %d/%d", arb.Lots[0].CostBasis, arb.Lots[1].CostBasis)
+	}
+	if arb.UnitsTraded != 40 || arb.TotalRevenue != 140000 {
+		t.Fatalf("all 40u must be booked (revenue 140000), got %d units / %d", arb.UnitsTraded, arb.TotalRevenue)
+	}
+	if arb.RealizedPnL != 60000 || arb.NetProfit != 60000 {
+		t.Fatalf("realized and net P/L must both be 60000, got %d / %d", arb.RealizedPnL, arb.NetProfit)
+	}
+	if arb.UnrealizedUnits != 0 || arb.UnrealizedCostBasis != 0 {
+		t.Fatalf("nothing may stay unrealized, got %d units / %d basis", arb.UnrealizedUnits, arb.UnrealizedCostBasis)
+	}
+}
+
+// An alternate market quoting below the tranche's 2000/unit cost is skipped: the
+// remainder stays aboard, reported as unrealized and marked at the destination's
+// bid, and the run still fails honestly as stranded.
+func TestArbCoordinator_PartialFill_HoldsRemainderRatherThanSellAtLoss(t *testing.T) {
+	ship := newTradeHauler(t, "ARB-HOLD")
+	mediator := &arbPartialSellMediator{sellCap: 30}
+	h := arbHandlerWith(mediator, ship)
+	h.SetSellMarketRanker(&arbFakeRanker{markets: []*mfgServices.EligibleMarket{
+		{WaypointSymbol: trAltMarket, PurchasePrice: 1500},
+	}})
+
+	resp, err := h.Handle(context.Background(), &RunArbCoordinatorCommand{
+		ShipSymbol: ship.ShipSymbol(),
+		Good:       trGood,
+		BuyAt:      trSource,
+		SellAt:     trDest,
+		PlayerID:   1,
+	})
+	if err == nil {
+		t.Fatal("a held remainder must still fail the run")
+	}
+	arb := arbResponse(t, resp)
+
+	if len(mediator.sells) != 1 {
+		t.Fatalf("no sale may happen below cost, got %d sells", len(mediator.sells))
+	}
+	if arb.RealizedPnL != 45000 {
+		t.Fatalf("realized P/L covers only the 30 sold units (105000-60000), got %d", arb.RealizedPnL)
+	}
+	if arb.UnrealizedUnits != 10 || arb.UnrealizedCostBasis != 20000 {
+		t.Fatalf("10 units at a 20000 basis must remain unrealized, got %d / %d", arb.UnrealizedUnits, arb.UnrealizedCostBasis)
+	}
+	if arb.MarkBid != trStartDestBid || arb.UnrealizedPnL != 20000 {
+		t.Fatalf("the remainder must be marked at the destination bid (10x4000-20000), got bid %d / %d", arb.MarkBid, arb.UnrealizedPnL)
+	}
+}
@@ -12,6 +12,7 @@ import (
 	"github.com/andrescamacho/spacetraders-go/internal/application/common"
 	gasCmd "github.com/andrescamacho/spacetraders-go/internal/application/gas/commands"
 	tradingsvc "github.com/andrescamacho/spacetraders-go/internal/application/trading/services"
+	"github.com/andrescamacho/spacetraders-go/internal/domain/ledger"
 	"github.com/andrescamacho/spacetraders-go/internal/domain/market"
 	"github.com/andrescamacho/spacetraders-go/internal/domain/navigation"
 	domainPorts "github.com/andrescamacho/spacetraders-go/internal/domain/ports"
@@ -343,6 +344,13 @@ func (h *RunStockerCoordinatorHandler) SetChartGateOnArrival(enabled bool) {
 	h.legs.SetChartGateOnArrival(enabled)
 }
 
+// SetCreditReservations wires the fleet-shared credit reservation service into the
+// delegated buy leg, so each ceilinged stock buy reserves its worst-case cost before it
+// is sent. Mirrors the SetGateGraph delegation.
+func (h *RunStockerCoordinatorHandler) SetCreditReservations(reservations ledger.CreditReservations) {
+	h.legs.SetCreditReservations(reservations)
+}
+
 // SetEventSubscriber wires the ship-arrival event bus into the delegated movement
 // handler so the resume path waits out a hull re-adopted mid-transit before moving
 // (sp-8l3o) instead of 4214'ing and burning the restart budget. Mirrors arb/tour.
@@ -21,6 +21,7 @@ import (
 	tradingsvc "github.com/andrescamacho/spacetraders-go/internal/application/trading/services"
 	"github.com/andrescamacho/spacetraders-go/internal/domain/absorption"
 	"github.com/andrescamacho/spacetraders-go/internal/domain/captain"
+	"github.com/andrescamacho/spacetraders-go/internal/domain/ledger"
 	"github.com/andrescamacho/spacetraders-go/internal/domain/market"
 	"github.com/andrescamacho/spacetraders-go/internal/domain/navigation"
 	domainPorts "github.com/andrescamacho/spacetraders-go/internal/domain/ports"
@@ -382,6 +383,10 @@ type RunTourCoordinatorResponse struct {
 	PlannerInternalErrorReason string
 
 	Error string
+
+	// lanes books what the run bought and where, so each sale is recorded against
+	// the lanes its units actually travelled. Per run, like the economics above.
+	lanes tourLaneBook
 }
 
 // CompletionOutcome implements common.CompletionReporter: a stranded tour vetoes
@@ -550,6 +555,10 @@ type RunTourCoordinatorHandler struct {
 	// continue). Optional-injection via SetEventRecorder, nil-safe like the contract
 	// coordinator's captainEvents.
 	captainEvents captain.EventRecorder
+
+	// laneHistory records each sale of tour-bought cargo as a lane execution for the
+	// trade lane leaderboard. Optional via SetLaneExecutionRecorder; nil records nothing.
+	laneHistory LaneExecutionRecorder
 }
 
 // outOfHorizonSinkScanner reads the global best sell destination per good (across ALL
@@ -638,6 +647,13 @@ func (h *RunTourCoordinatorHandler) SetChartGateOnArrival(enabled bool) {
 	h.legs.SetChartGateOnArrival(enabled)
 }
 
+// SetCreditReservations wires the fleet-shared credit reservation service into the
+// delegated buy leg, so each ceilinged tour buy reserves its worst-case cost before it
+// is sent. Mirrors the SetGateGraph delegation.
+func (h *RunTourCoordinatorHandler) SetCreditReservations(reservations ledger.CreditReservations) {
+	h.legs.SetCreditReservations(reservations)
+}
+
 // SetScanPolicy wires the tour-scan load policy (recent-scan freshness gate +
 // impact-sample rate, resolved from cfg.TradeImpact on restart). Stamped onto ctx at run
 // start so the shared arrival + post-trade scans throttle the deliberate price-impact
@@ -706,6 +722,13 @@ func (h *RunTourCoordinatorHandler) SetEventRecorder(rec captain.EventRecorder)
 	h.captainEvents = rec
 }
 
+// SetLaneExecutionRecorder wires the lane execution history the trade lane
+// leaderboard reads. Without it tours still trade, they just leave no per-lane
+// record.
+func (h *RunTourCoordinatorHandler) SetLaneExecutionRecorder(recorder LaneExecutionRecorder) {
+	h.laneHistory = recorder
+}
+
 // errTourBudgetUnreadable is the constant streak key for the dynamic-budget resolve
 // checkpoint. Constant so consecutive unreadable-treasury iterations count as the SAME
 // error and accumulate toward the threshold (a varying message would reset the streak
@@ -1494,6 +1517,7 @@ func (h *RunTourCoordinatorHandler) executeBuy(
 	response.TotalSpent += int64(buyResp.TotalCost)
 	response.TradesExecuted++
 	netBought[trade.Good] += buyResp.UnitsAdded
+	response.laneBook().bought(trade.Good, leg.Waypoint, buyResp.UnitsAdded, buyResp.TotalCost)
 	h.recordLeg(ctx, cmd, leg, legIdx, trade, buyResp.UnitsAdded, realizedUnitPrice(buyResp.TotalCost, buyResp.UnitsAdded), plannedAt)
 	logger.Log("INFO", fmt.Sprintf("Tour leg %d: bought %d %s at %s (cost %d)", legIdx, buyResp.UnitsAdded, trade.Good, leg.Waypoint, buyResp.TotalCost), nil)
 	// A buy that LANDED on ground carrying an outstanding EXECUTED recovery shadow is
@@ -1568,6 +1592,7 @@ func (h *RunTourCoordinatorHandler) executeSell(
 	// under-stating the multi-tranche co-dump crush this ledger exists to shadow. The
 	// live re-verify tier + trade_volume (stable across a sink's tranches) size the shadow.
 	h.noteSinkSale(legSells, trade.Good, sellResp.UnitsSold, live)
+	h.recordTourLaneSale(ctx, cmd, response, trade.Good, leg.Waypoint, sellResp.UnitsSold, sellResp.TotalRevenue)
 	h.recordLeg(ctx, cmd, leg, legIdx, trade, sellResp.UnitsSold, realizedUnitPrice(sellResp.TotalRevenue, sellResp.UnitsSold), plannedAt)
 	logger.Log("INFO", fmt.Sprintf("Tour leg %d: sold %d %s at %s (revenue %d)", legIdx, sellResp.UnitsSold, trade.Good, leg.Waypoint, sellResp.TotalRevenue), nil)
 	return true, nil
@@ -1680,6 +1705,7 @@ func (h *RunTourCoordinatorHandler) executeDeposit(
 
 	response.TradesExecuted++
 	netBought[trade.Good] -= deposited // left the hull into inventory — not stranded
+	response.laneBook().take(trade.Good, deposited)
 	return true, nil
 }
 
@@ -2271,6 +2297,43 @@ func (h *RunTourCoordinatorHandler) recordLeg(
 	}
 }
 
+// recordTourLaneSale records a sale against the buy markets its units came from,
+// splitting the revenue by units. Units the tour did not buy itself have no
+// known lane and are not recorded.
+func (h *RunTourCoordinatorHandler) recordTourLaneSale(ctx context.Context, cmd *RunTourCoordinatorCommand, response *RunTourCoordinatorResponse, good, sellMarket string, units, revenue int) {
+	if units <= 0 {
+		return
+	}
+	now := h.clock.Now()
+	shares := response.laneBook().take(good, units)
+	covered := 0
+	for _, share := range shares {
+		covered += share.units
+	}
+	allocated := 0
+	for i, share := range shares {
+		// Cut by units; a fully covered sale hands its rounding remainder to the
+		// last share so the recorded revenue adds up to what the market paid.
+		shareRevenue := revenue * share.units / units
+		if i == len(shares)-1 && covered == units {
+			shareRevenue = revenue - allocated
+		}
+		allocated += shareRevenue
+		recordLaneExecution(ctx, h.laneHistory, trading.LaneExecution{
+			PlayerID:    cmd.PlayerID,
+			ContainerID: cmd.ContainerID,
+			Source:      trading.LaneSourceTour,
+			BuyMarket:   share.market,
+			SellMarket:  sellMarket,
+			Good:        good,
+			Units:       share.units,
+			Cost:        share.cost,
+			Revenue:     shareRevenue,
+			ExecutedAt:  now,
+		})
+	}
+}
+
 // readTourModelVersion reads "<fit_version>@<era>" from the checked-in artifact so the
 // constraint binds the planner to the exact fitted model (spec: mismatch → the solver
 // fails closed). Any read/parse failure surfaces as an error the caller fails open on.
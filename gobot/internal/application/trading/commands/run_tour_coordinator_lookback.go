@@ -407,6 +407,7 @@ func (h *RunTourCoordinatorHandler) buyLookbackItem(
 	response.TotalSpent += int64(buyResp.TotalCost)
 	response.TradesExecuted++
 	netBought[item.Good] += buyResp.UnitsAdded
+	response.laneBook().bought(item.Good, item.SourceWaypoint, buyResp.UnitsAdded, buyResp.TotalCost)
 	// sp-rd21 (epic sp-g9td): record the look-back buy in tour telemetry exactly as
 	// executeBuy records a plan leg — the FULL bought units and the volume-weighted realized
 	// price — so the windowed telemetry-netting rate reconciles with the PURCHASE_CARGO
@@ -178,6 +178,17 @@ type RunTradeFleetCoordinatorCommand struct {
 	// untouched (reserved-since anchor, no live/recent container) before the reaper releases
 	// it; <=0 uses defaultReapIdleThresholdSeconds (30 min).
 	ReapIdleThresholdSecs int
+
+	// Dynamic fleet sizing. Once per SizingIntervalSecs the coordinator compares the
+	// tour throughput it measured (productive vs fast-fail exits, and how often every
+	// hull was out touring) against the fleet size and pins one more idle cargo hull
+	// into the trade fleet, or releases one, through the assignment manager — always
+	// within [MinFleetSize, MaxFleetSize]. MaxFleetSize <= 0 (the default) turns sizing
+	// off, leaving the fleet exactly as the captain pinned it. SizingIntervalSecs <= 0
+	// uses defaultFleetSizingIntervalSeconds. See resizeFleet / planFleetResize.
+	MinFleetSize       int
+	MaxFleetSize       int
+	SizingIntervalSecs int
 }
 
 // RunTradeFleetCoordinatorResponse reports reconcile progress. Because the loop is
@@ -281,6 +292,13 @@ type RunTradeFleetCoordinatorHandler struct {
 	// fails closed (reaps nothing), never panics, so a wiring gap can never yank a hull it
 	// could not confirm idle.
 	activeShips ActiveContainerShipsPort
+
+	// fleetAssigner pins hulls into and out of the trade fleet for dynamic sizing.
+	// Optional-injection via SetFleetAssigner: without it sizing is inert even when the
+	// command sets bounds. throughput is the current sizing window, in memory only — a
+	// restart simply opens a fresh window.
+	fleetAssigner TradeFleetAssigner
+	throughput    fleetThroughput
 }
 
 // NewRunTradeFleetCoordinatorHandler wires the coordinator. clock defaults to the real
@@ -319,6 +337,13 @@ func (h *RunTradeFleetCoordinatorHandler) SetActiveContainerShips(port ActiveCon
 	h.activeShips = port
 }
 
+// SetFleetAssigner wires the assignment-manager port dynamic fleet sizing grows and
+// shrinks the trade fleet through. Optional-injection: without it the coordinator
+// never changes which hulls are pinned.
+func (h *RunTradeFleetCoordinatorHandler) SetFleetAssigner(assigner TradeFleetAssigner) {
+	h.fleetAssigner = assigner
+}
+
 // Handle runs the reconcile loop until the context is cancelled.
 func (h *RunTradeFleetCoordinatorHandler) Handle(ctx context.Context, request common.Request) (common.Response, error) {
 	logger := common.LoggerFromContext(ctx)
@@ -375,7 +400,7 @@ func (h *RunTradeFleetCoordinatorHandler) Handle(ctx context.Context, request co
 		h.noteReconcile(ctx, cmd, errMon, err)
 
 		select {
-		case <-time.After(tick):
+		case <-shared.After(shared.ClockFromContext(ctx), tick):
 		case <-ctx.Done():
 			return result, ctx.Err()
 		}
@@ -422,6 +447,21 @@ func (h *RunTradeFleetCoordinatorHandler) reconcileOnce(ctx context.Context, cmd
 	h.reapStaleCaptainReservations(ctx, cmd, ships, now, logger)
 
 	idle, runningTours := partitionTradeFleet(ships)
+
+	// Dynamic sizing: measure this pass, then (once per sizing window) grow or shrink the
+	// fleet to the opportunity flow. A hull released here leaves the idle bucket at once
+	// so it is not relaunched on its way out; a pinned hull is picked up next pass.
+	h.noteSizingTick(idle, runningTours)
+	if released := h.resizeFleet(ctx, cmd, ships, idle, now, logger); len(released) > 0 {
+		kept := idle[:0]
+		for _, ship := range idle {
+			if !released[ship.ShipSymbol()] {
+				kept = append(kept, ship)
+			}
+		}
+		idle = kept
+	}
+
 	if len(idle) == 0 {
 		return 0, nil
 	}
@@ -854,7 +894,9 @@ func (h *RunTradeFleetCoordinatorHandler) cooldownFor(ship *navigation.Ship, bas
 		return bo.cooldown, bo.reachEscalated
 	}
 
-	if releasedAt.Sub(assignment.AssignedAt()) >= minProductiveTourDuration {
+	productive := releasedAt.Sub(assignment.AssignedAt()) >= minProductiveTourDuration
+	h.noteTourExit(productive)
+	if productive {
 		// Productive: a fresh ground was found and traded. Reset to base and disarm reach —
 		// the recovered hull relaunches normally, not force-armed forever.
 		bo.consecutiveUnproductive = 0
@@ -0,0 +1,319 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/andrescamacho/spacetraders-go/internal/application/common"
+	shipAssignment "github.com/andrescamacho/spacetraders-go/internal/application/ship/commands/assignment"
+	"github.com/andrescamacho/spacetraders-go/internal/domain/navigation"
+	"github.com/andrescamacho/spacetraders-go/internal/domain/shared"
+)
+
+const (
+	// defaultFleetSizingIntervalSeconds is how long the coordinator measures tour
+	// throughput before it re-decides the fleet size. An hour spans several tour cycles
+	// per hull (a productive continuous tour runs tens of minutes), so one quiet
+	// cooldown window cannot shrink the fleet and one lucky burst cannot grow it.
+	defaultFleetSizingIntervalSeconds = 3600
+
+	// fleetSizingGrowSaturatedPct is the share of reconcile ticks in a window that must
+	// have found NO idle trade hull (every hull out touring) before the fleet grows —
+	// the opportunity flow is absorbing every hull the coordinator has.
+	fleetSizingGrowSaturatedPct = 80
+
+	// fleetSizingGrowStarvedPct caps the share of scored tour exits in a window that may
+	// be fast-fails (no plausible trade found) for the fleet to still grow. A fleet whose
+	// tours are starving has no use for another hull even when every hull is busy.
+	fleetSizingGrowStarvedPct = 20
+
+	// tradeFleetSizingAssigner is the assigner-audit tag every sizing dedication write
+	// carries, so a hull pinned into or out of the trade fleet names its culprit.
+	tradeFleetSizingAssigner = "trade-fleet-sizing"
+)
+
+// TradeFleetAssigner pins a hull into (fleet "trade") or out of (fleet "") the trade
+// fleet. It is the coordinator's handle on the assignment manager: production wires
+// NewMediatorTradeFleetAssigner, which goes through the AssignShipFleet command — the
+// single dedication write path, with its audit line intact. Dedication never evicts a
+// live claim, so unpinning a hull mid-tour lets the tour finish and simply stops it
+// from being relaunched.
+type TradeFleetAssigner interface {
+	AssignTradeFleet(ctx context.Context, playerID shared.PlayerID, shipSymbol, fleet string) error
+}
+
+type mediatorTradeFleetAssigner struct{ mediator common.Mediator }
+
+// NewMediatorTradeFleetAssigner drives sizing writes through the AssignShipFleet
+// mediator command as an automated (non-manual) assigner.
+func NewMediatorTradeFleetAssigner(mediator common.Mediator) TradeFleetAssigner {
+	return &mediatorTradeFleetAssigner{mediator: mediator}
+}
+
+func (a *mediatorTradeFleetAssigner) AssignTradeFleet(ctx context.Context, playerID shared.PlayerID, shipSymbol, fleet string) error {
+	pid := playerID.Value()
+	_, err := a.mediator.Send(ctx, &shipAssignment.AssignShipFleetCommand{
+		ShipSymbol: shipSymbol,
+		Fleet:      fleet,
+		PlayerID:   &pid,
+		Assigner:   tradeFleetSizingAssigner,
+		Manual:     false,
+	})
+	return err
+}
+
+// fleetThroughput is the opportunity flow measured over one sizing window. Scored
+// tour exits are the per-opportunity signal: a productive exit means the tour found
+// and executed trades, a fast-fail means it found none worth flying. Saturated ticks
+// count reconcile passes that found every trade hull out touring, i.e. demand for
+// hulls at or above the fleet size. Restart mass-parks are not counted (they say
+// nothing about the market, see cooldownFor).
+type fleetThroughput struct {
+	windowStart    time.Time
+	productive     int
+	starved        int
+	ticks          int
+	saturatedTicks int
+}
+
+// perHour scales a window count to an hourly rate for the sizing log line.
+func (t fleetThroughput) perHour(count int, now time.Time) float64 {
+	elapsed := now.Sub(t.windowStart)
+	if elapsed <= 0 {
+		return 0
+	}
+	return float64(count) / elapsed.Hours()
+}
+
+// planFleetResize decides how many hulls to add (positive) or release (negative)
+// given the current trade-fleet size, the configured bounds and one window of
+// throughput. The bounds win outright; inside them the fleet moves by at most one hull
+// per window, so it converges on the opportunity flow without oscillating:
+//
+//   - grow when at least fleetSizingGrowSaturatedPct of ticks had no idle hull, at
+//     least one exit was productive, and no more than fleetSizingGrowStarvedPct of exits
+//     were fast-fails — every hull is busy and the tours are finding trades, so another
+//     hull would find some too;
+//   - shrink when fast-fails are at least as common as productive exits — the fleet
+//     is larger than the opportunities it finds.
+//
+// maxSize <= 0 disables sizing entirely.
+func planFleetResize(size, minSize, maxSize int, t fleetThroughput) (int, string) {
+	if maxSize <= 0 {
+		return 0, ""
+	}
+	if minSize > maxSize {
+		minSize = maxSize
+	}
+	switch {
+	case size < minSize:
+		return minSize - size, fmt.Sprintf("fleet of %d is below the minimum of %d", size, minSize)
+	case size > maxSize:
+		return maxSize - size, fmt.Sprintf("fleet of %d is above the maximum of %d", size, maxSize)
+	}
+
+	exits := t.productive + t.starved
+	if size < maxSize && t.ticks > 0 && t.productive > 0 &&
+		t.saturatedTicks*100 >= t.ticks*fleetSizingGrowSaturatedPct &&
+		t.starved*100 <= exits*fleetSizingGrowStarvedPct {
+		return 1, fmt.Sprintf("every hull busy on %d of %d ticks with %d of %d exits productive",
+			t.saturatedTicks, t.ticks, t.productive, exits)
+	}
+	if size > minSize && t.starved > 0 && t.starved >= t.productive {
+		return -1, fmt.Sprintf("%d fast-fail exits against %d productive", t.starved, t.productive)
+	}
+	return 0, ""
+}
+
+// noteSizingTick records one reconcile pass in the current sizing window.
+func (h *RunTradeFleetCoordinatorHandler) noteSizingTick(idle []*navigation.Ship, runningTours int) {
+	h.throughput.ticks++
+	if len(idle) == 0 && runningTours > 0 {
+		h.throughput.saturatedTicks++
+	}
+}
+
+// noteTourExit records one freshly-scored tour exit in the current sizing window.
+func (h *RunTradeFleetCoordinatorHandler) noteTourExit(productive bool) {
+	if productive {
+		h.throughput.productive++
+	} else {
+		h.throughput.starved++
+	}
+}
+
+// resizeFleet is the capacity-planning step of a reconcile pass. Once per sizing
+// interval (and on the first pass, so the min/max bounds apply at once) it turns the
+// window's throughput into a resize decision, pins idle unassigned cargo hulls into
+// the trade fleet or unpins trade hulls out of it through the assigner, and opens a
+// fresh window. It returns the symbols it unpinned so the caller does not relaunch
+// them this pass. Inert unless MaxFleetSize is set and an assigner is wired.
+func (h *RunTradeFleetCoordinatorHandler) resizeFleet(
+	ctx context.Context,
+	cmd *RunTradeFleetCoordinatorCommand,
+	ships []*navigation.Ship,
+	idle []*navigation.Ship,
+	now time.Time,
+	logger common.ContainerLogger,
+) map[string]bool {
+	if cmd.MaxFleetSize <= 0 || h.fleetAssigner == nil {
+		return nil
+	}
+	if !h.throughput.windowStart.IsZero() && now.Sub(h.throughput.windowStart) < cmd.sizingInterval() {
+		return nil
+	}
+	window := h.throughput
+	h.throughput = fleetThroughput{windowStart: now}
+
+	size := 0
+	for _, ship := range ships {
+		if ship.DedicatedFleet() == tradeFleet {
+			size++
+		}
+	}
+
+	delta, reason := planFleetResize(size, cmd.MinFleetSize, cmd.MaxFleetSize, window)
+	if !window.windowStart.IsZero() {
+		logger.Log("INFO", fmt.Sprintf(
+			"Trade fleet throughput: %.1f productive / %.1f starved exits per hour, %d of %d ticks saturated, fleet %d (bounds %d-%d)",
+			window.perHour(window.productive, now), window.perHour(window.starved, now),
+			window.saturatedTicks, window.ticks, size, cmd.MinFleetSize, cmd.MaxFleetSize), map[string]interface{}{
+			"action":          "trade_fleet_throughput",
+			"productive":      window.productive,
+			"starved":         window.starved,
+			"ticks":           window.ticks,
+			"saturated_ticks": window.saturatedTicks,
+			"fleet_size":      size,
+		})
+	}
+
+	switch {
+	case delta > 0:
+		h.growFleet(ctx, cmd, ships, delta, reason, logger)
+		return nil
+	case delta < 0:
+		return h.shrinkFleet(ctx, cmd, ships, idle, -delta, reason, logger)
+	}
+	return nil
+}
+
+// growFleet pins up to n idle, unassigned hulls that can carry cargo into the trade
+// fleet, largest hold first. A pinned hull joins the idle bucket on the next pass and
+// is launched like any other trade hull.
+func (h *RunTradeFleetCoordinatorHandler) growFleet(ctx context.Context, cmd *RunTradeFleetCoordinatorCommand, ships []*navigation.Ship, n int, reason string, logger common.ContainerLogger) {
+	var candidates []*navigation.Ship
+	for _, ship := range ships {
+		if ship.DedicatedFleet() != "" || ship.IsAssigned() || ship.IsReservedByCaptain() || ship.IsInTransit() {
+			continue
+		}
+		if ship.CargoCapacity() <= 0 || ship.CargoUnits() > 0 {
+			continue
+		}
+		candidates = append(candidates, ship)
+	}
+	sort.Slice(candidates, func(i, j int) bool {
+		if candidates[i].CargoCapacity() != candidates[j].CargoCapacity() {
+			return candidates[i].CargoCapacity() > candidates[j].CargoCapacity()
+		}
+		return candidates[i].ShipSymbol() < candidates[j].ShipSymbol()
+	})
+
+	if len(candidates) == 0 {
+		logger.Log("INFO", fmt.Sprintf("Trade fleet wants %d more hull(s) (%s) but no idle unassigned cargo hull is available", n, reason), map[string]interface{}{
+			"action": "trade_fleet_grow_unavailable",
+			"wanted": n,
+		})
+		return
+	}
+	for _, ship := range candidates {
+		if n == 0 {
+			break
+		}
+		if err := h.fleetAssigner.AssignTradeFleet(ctx, cmd.PlayerID, ship.ShipSymbol(), tradeFleet); err != nil {
+			logger.Log("WARNING", fmt.Sprintf("Failed to pin %s into the trade fleet: %v", ship.ShipSymbol(), err), map[string]interface{}{
+				"action":      "trade_fleet_grow_failed",
+				"ship_symbol": ship.ShipSymbol(),
+			})
+			continue
+		}
+		n--
+		logger.Log("INFO", fmt.Sprintf("Trade fleet grew: pinned %s (%s)", ship.ShipSymbol(), reason), map[string]interface{}{
+			"action":      "trade_fleet_grow",
+			"ship_symbol": ship.ShipSymbol(),
+		})
+	}
+}
+
+// shrinkFleet unpins up to n trade hulls back to the general pool. Idle hulls go
+// first, the ones with the longest run of fast-fail exits ahead; only then are hulls
+// mid-tour unpinned, which lets their tour finish before they leave the fleet.
+// Captain-reserved hulls are never touched.
+func (h *RunTradeFleetCoordinatorHandler) shrinkFleet(
+	ctx context.Context,
+	cmd *RunTradeFleetCoordinatorCommand,
+	ships []*navigation.Ship,
+	idle []*navigation.Ship,
+	n int,
+	reason string,
+	logger common.ContainerLogger,
+) map[string]bool {
+	isIdle := make(map[string]bool, len(idle))
+	for _, ship := range idle {
+		isIdle[ship.ShipSymbol()] = true
+	}
+	var candidates []*navigation.Ship
+	for _, ship := range ships {
+		if ship.DedicatedFleet() == tradeFleet && !ship.IsReservedByCaptain() {
+			candidates = append(candidates, ship)
+		}
+	}
+	streak := func(ship *navigation.Ship) int {
+		if bo := h.backoff[ship.ShipSymbol()]; bo != nil {
+			return bo.consecutiveUnproductive
+		}
+		return 0
+	}
+	sort.Slice(candidates, func(i, j int) bool {
+		a, b := candidates[i], candidates[j]
+		if isIdle[a.ShipSymbol()] != isIdle[b.ShipSymbol()] {
+			return isIdle[a.ShipSymbol()]
+		}
+		if streak(a) != streak(b) {
+			return streak(a) > streak(b)
+		}
+		return a.ShipSymbol() < b.ShipSymbol()
+	})
+
+	released := make(map[string]bool)
+	for _, ship := range candidates {
+		if len(released) == n {
+			break
+		}
+		if err := h.fleetAssigner.AssignTradeFleet(ctx, cmd.PlayerID, ship.ShipSymbol(), ""); err != nil {
+			logger.Log("WARNING", fmt.Sprintf("Failed to release %s from the trade fleet: %v", ship.ShipSymbol(), err), map[string]interface{}{
+				"action":      "trade_fleet_shrink_failed",
+				"ship_symbol": ship.ShipSymbol(),
+			})
+			continue
+		}
+		released[ship.ShipSymbol()] = true
+		delete(h.backoff, ship.ShipSymbol())
+		logger.Log("INFO", fmt.Sprintf("Trade fleet shrank: released %s to the general pool (%s)", ship.ShipSymbol(), reason), map[string]interface{}{
+			"action":      "trade_fleet_shrink",
+			"ship_symbol": ship.ShipSymbol(),
+			"was_idle":    isIdle[ship.ShipSymbol()],
+		})
+	}
+	return released
+}
+
+// sizingInterval resolves the fleet-sizing window, applying the default when unset.
+func (c *RunTradeFleetCoordinatorCommand) sizingInterval() time.Duration {
+	secs := c.SizingIntervalSecs
+	if secs <= 0 {
+		secs = defaultFleetSizingIntervalSeconds
+	}
+	return time.Duration(secs) * time.Second
+}
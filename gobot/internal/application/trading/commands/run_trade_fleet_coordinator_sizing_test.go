@@ -0,0 +1,184 @@
+package commands
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/andrescamacho/spacetraders-go/internal/domain/navigation"
+	"github.com/andrescamacho/spacetraders-go/internal/domain/shared"
+)
+
+// fakeTradeFleetAssigner records dedication writes as "SYMBOL->fleet" and applies them
+// to the roster so a later pass sees the new fleet, like the DB round-trip.
+type fakeTradeFleetAssigner struct {
+	ships  []*navigation.Ship
+	writes []string
+}
+
+func (a *fakeTradeFleetAssigner) AssignTradeFleet(_ context.Context, _ shared.PlayerID, shipSymbol, fleet string) error {
+	a.writes = append(a.writes, shipSymbol+"->"+fleet)
+	for _, ship := range a.ships {
+		if ship.ShipSymbol() == shipSymbol {
+			ship.SetDedicatedFleet(fleet)
+		}
+	}
+	return nil
+}
+
+// poolHull is an unassigned, idle cargo hull the sizing step may pin.
+func poolHull(t *testing.T, symbol string) *navigation.Ship {
+	t.Helper()
+	ship := tradeHull(t, symbol)
+	ship.SetDedicatedFleet("")
+	return ship
+}
+
+func sizingSecs(n int) time.Duration { return time.Duration(n) * time.Second }
+
+func sizingCmd(minSize, maxSize int) *RunTradeFleetCoordinatorCommand {
+	cmd := tradeCmd()
+	cmd.MinFleetSize = minSize
+	cmd.MaxFleetSize = maxSize
+	cmd.SizingIntervalSecs = 600
+	return cmd
+}
+
+func TestPlanFleetResize(t *testing.T) {
+	cases := []struct {
+		name      string
+		size      int
+		min, max  int
+		window    fleetThroughput
+		wantDelta int
+	}{
+		{name: "sizing off without a max", size: 1, min: 3, max: 0},
+		{name: "below the minimum grows to it", size: 1, min: 3, max: 6, wantDelta: 2},
+		{name: "above the maximum shrinks to it", size: 8, min: 1, max: 6, wantDelta: -2},
+		{
+			name: "saturated and productive grows by one", size: 3, min: 1, max: 6,
+			window:    fleetThroughput{productive: 9, starved: 1, ticks: 100, saturatedTicks: 90},
+			wantDelta: 1,
+		},
+		{
+			name: "saturated but starving does not grow", size: 3, min: 1, max: 6,
+			window: fleetThroughput{productive: 6, starved: 4, ticks: 100, saturatedTicks: 90},
+		},
+		{
+			name: "saturated without a productive exit holds", size: 3, min: 1, max: 6,
+			window: fleetThroughput{ticks: 100, saturatedTicks: 100},
+		},
+		{
+			name: "saturated at the maximum holds", size: 6, min: 1, max: 6,
+			window: fleetThroughput{productive: 10, ticks: 100, saturatedTicks: 100},
+		},
+		{
+			name: "starving fleet shrinks by one", size: 4, min: 1, max: 6,
+			window:    fleetThroughput{productive: 3, starved: 5, ticks: 100},
+			wantDelta: -1,
+		},
+		{
+			name: "starving fleet at the minimum holds", size: 2, min: 2, max: 6,
+			window: fleetThroughput{starved: 5, ticks: 100},
+		},
+		{
+			name: "a quiet balanced window holds", size: 4, min: 1, max: 6,
+			window: fleetThroughput{productive: 5, starved: 2, ticks: 100, saturatedTicks: 40},
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			delta, reason := planFleetResize(tc.size, tc.min, tc.max, tc.window)
+			require.Equal(t, tc.wantDelta, delta)
+			if delta != 0 {
+				require.NotEmpty(t, reason, "every resize must say why")
+			}
+		})
+	}
+}
+
+// With a minimum above the pinned fleet, the first pass pins idle pool hulls; hulls
+// that are busy or pinned to another fleet are never taken.
+func TestTradeFleetSizing_FirstPassGrowsToMinimum(t *testing.T) {
+	small := poolHull(t, "POOL-SMALL")
+	big := poolHull(t, "POOL-BIG")
+	busy := poolHull(t, "POOL-BUSY")
+	require.NoError(t, busy.AssignToContainer("other-op", clockAt(0)))
+	contract := poolHull(t, "POOL-CONTRACT")
+	contract.SetDedicatedFleet("contract")
+	ships := []*navigation.Ship{runningTradeHull(t, "TRADE-1"), small, big, busy, contract}
+
+	// Both pool hulls carry a 40-unit hold, so the symbol breaks the tie.
+	assigner := &fakeTradeFleetAssigner{ships: ships}
+	h := newTradeHandler(&fakeTradeShipRepo{ships: ships}, &fakeTourLauncher{}, clockAt(0))
+	h.SetFleetAssigner(assigner)
+
+	_, err := h.reconcileOnce(tradeCtx(&tradeCaptureLogger{}), sizingCmd(3, 5))
+	require.NoError(t, err)
+	require.Equal(t, []string{"POOL-BIG->trade", "POOL-SMALL->trade"}, assigner.writes)
+}
+
+// A window in which every hull stayed busy and tours kept finding trades grows the
+// fleet by one hull; nothing happens before the window closes.
+func TestTradeFleetSizing_SaturatedWindowGrowsByOne(t *testing.T) {
+	ships := []*navigation.Ship{runningTradeHull(t, "TRADE-1"), runningTradeHull(t, "TRADE-2"), poolHull(t, "POOL-1")}
+	assigner := &fakeTradeFleetAssigner{ships: ships}
+	clock := clockAt(0)
+	h := newTradeHandler(&fakeTradeShipRepo{ships: ships}, &fakeTourLauncher{}, clock)
+	h.SetFleetAssigner(assigner)
+	cmd := sizingCmd(1, 4)
+	ctx := tradeCtx(&tradeCaptureLogger{})
+
+	for offset := 0; offset < 600; offset += 30 {
+		clock.CurrentTime = baseTime.Add(sizingSecs(offset))
+		_, err := h.reconcileOnce(ctx, cmd)
+		require.NoError(t, err)
+	}
+	require.Empty(t, assigner.writes, "no decision before the sizing window closes")
+
+	h.noteTourExit(true)
+	clock.CurrentTime = baseTime.Add(sizingSecs(600))
+	_, err := h.reconcileOnce(ctx, cmd)
+	require.NoError(t, err)
+	require.Equal(t, []string{"POOL-1->trade"}, assigner.writes)
+}
+
+// A window dominated by fast-fail exits releases the idle hull with the worst streak,
+// and that hull is not relaunched on its way out.
+func TestTradeFleetSizing_StarvedWindowReleasesIdleHull(t *testing.T) {
+	ships := []*navigation.Ship{
+		runningTradeHull(t, "TRADE-1"),
+		tradeHull(t, "TRADE-2"),
+		tradeHull(t, "TRADE-3"),
+	}
+	assigner := &fakeTradeFleetAssigner{ships: ships}
+	launcher := &fakeTourLauncher{}
+	clock := clockAt(0)
+	h := newTradeHandler(&fakeTradeShipRepo{ships: ships}, launcher, clock)
+	h.SetFleetAssigner(assigner)
+	cmd := sizingCmd(1, 4)
+	ctx := tradeCtx(&tradeCaptureLogger{})
+
+	h.throughput = fleetThroughput{windowStart: baseTime.Add(-sizingSecs(600)), productive: 1, starved: 4, ticks: 20}
+	h.backoff["TRADE-3"] = &hullBackoff{consecutiveUnproductive: 3}
+
+	_, err := h.reconcileOnce(ctx, cmd)
+	require.NoError(t, err)
+	require.Equal(t, []string{"TRADE-3->"}, assigner.writes)
+	require.Equal(t, []string{"TRADE-2"}, launcher.launchedSymbols())
+}
+
+// Without bounds in the payload (or without an assigner) the coordinator never
+// changes which hulls are pinned.
+func TestTradeFleetSizing_OffByDefault(t *testing.T) {
+	ships := []*navigation.Ship{tradeHull(t, "TRADE-1"), poolHull(t, "POOL-1")}
+	assigner := &fakeTradeFleetAssigner{ships: ships}
+	h := newTradeHandler(&fakeTradeShipRepo{ships: ships}, &fakeTourLauncher{}, clockAt(0))
+	h.SetFleetAssigner(assigner)
+
+	_, err := h.reconcileOnce(tradeCtx(&tradeCaptureLogger{}), tradeCmd())
+	require.NoError(t, err)
+	require.Empty(t, assigner.writes)
+}
@@ -8,6 +8,7 @@ import (
 	"github.com/andrescamacho/spacetraders-go/internal/adapters/flowfeed"
 	"github.com/andrescamacho/spacetraders-go/internal/application/common"
 	"github.com/andrescamacho/spacetraders-go/internal/domain/absorption"
+	"github.com/andrescamacho/spacetraders-go/internal/domain/ledger"
 	"github.com/andrescamacho/spacetraders-go/internal/domain/market"
 	"github.com/andrescamacho/spacetraders-go/internal/domain/navigation"
 	domainPorts "github.com/andrescamacho/spacetraders-go/internal/domain/ports"
@@ -364,6 +365,23 @@ type RunTradeRouteCoordinatorHandler struct {
 	// contract gateGraph/absorptionLedger use. The daemon injects one shared instance
 	// across the trade-route/arb/tour/stocker coordinators so the ledger is fleet-wide.
 	laneLedger *trading.LaneCooldownLedger
+	// shipRepairer sends a worn hull for a shipyard repair at the circuit boundary, the
+	// run's one safe stop (empty hold, nothing committed). Optional; nil never repairs,
+	// the same optional-port contract as gateGraph. The daemon injects it when
+	// [ship_maintenance] is enabled.
+	shipRepairer ShipRepairer
+	// creditReservations earmarks each ceilinged buy's worst-case cost before it is
+	// sent, so a concurrent coordinator cannot decide to spend the same credits.
+	// Optional; nil buys unreserved. The daemon injects the fleet-shared service via
+	// SetCreditReservations.
+	creditReservations ledger.CreditReservations
+}
+
+// ShipRepairer repairs a hull whose condition has fallen below the maintenance
+// threshold, reporting whether it did. A moved or repaired hull is stale afterwards.
+// *shipyard/services.RepairScheduler satisfies this.
+type ShipRepairer interface {
+	RepairIfWorn(ctx context.Context, ship *navigation.Ship, playerID shared.PlayerID) (bool, error)
 }
 
 // GateGraph resolves multi-jump routes over the persisted cross-system gate
@@ -458,6 +476,12 @@ func (h *RunTradeRouteCoordinatorHandler) SetChartGateOnArrival(enabled bool) {
 	h.chartGateOnArrival = enabled
 }
 
+// SetShipRepairer wires the between-circuit repair stop. Left unset (nil), hulls are
+// never sent for repair. Mirrors the SetGateGraph optional-injection idiom.
+func (h *RunTradeRouteCoordinatorHandler) SetShipRepairer(r ShipRepairer) {
+	h.shipRepairer = r
+}
+
 // gateGraphResolver exposes the wired resolver (or nil) so the composing arb
 // coordinator runs its pre-buy routability guard through the SAME instance
 // travel() uses — one graph, one cache, one source of truth.
@@ -593,6 +617,10 @@ func (h *RunTradeRouteCoordinatorHandler) execute(
 			break
 		}
 
+		// Repair stop at the leg boundary: a worn hull detours to a shipyard here,
+		// while the hold is empty, rather than wearing on until it fails mid-leg.
+		ship = h.repairIfWorn(ctx, ship, playerID)
+
 		lanes, err := h.scanLanes(ctx, cmd.SystemSymbol, playerID, ship.CargoCapacity(), cmd.TargetDest)
 		if err != nil {
 			return fmt.Errorf("failed to scan arbitrage lanes: %w", err)
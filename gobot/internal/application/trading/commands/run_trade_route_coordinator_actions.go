@@ -36,6 +36,32 @@ func (h *RunTradeRouteCoordinatorHandler) loadShip(
 	return ship, nil
 }
 
+// repairIfWorn runs the optional repair stop and returns the ship current afterwards.
+// Only an empty hull is sent: the circuit boundary is normally empty, and a hull still
+// carrying cargo is not detoured with it. A failed repair is logged and the run goes
+// on; the hull is checked again at the next circuit.
+func (h *RunTradeRouteCoordinatorHandler) repairIfWorn(ctx context.Context, ship *navigation.Ship, playerID int) *navigation.Ship {
+	if h.shipRepairer == nil || !ship.IsCargoEmpty() {
+		return ship
+	}
+	repaired, err := h.shipRepairer.RepairIfWorn(ctx, ship, shared.MustNewPlayerID(playerID))
+	if err != nil {
+		common.LoggerFromContext(ctx).Log("WARNING", fmt.Sprintf("Repair stop failed - continuing the run: %v", err), map[string]interface{}{
+			"ship_symbol": ship.ShipSymbol(),
+			"error":       err.Error(),
+		})
+	}
+	// A detour moves the hull even when the repair itself fails, so reload either way.
+	if !repaired && err == nil {
+		return ship
+	}
+	reloaded, lerr := h.loadShip(ctx, ship.ShipSymbol(), playerID)
+	if lerr != nil {
+		return ship
+	}
+	return reloaded
+}
+
 func (h *RunTradeRouteCoordinatorHandler) navigate(ctx context.Context, ship *navigation.Ship, destination string, playerID int) error {
 	_, err := h.mediator.Send(ctx, &navCmd.NavigateRouteCommand{
 		ShipSymbol:  ship.ShipSymbol(),
@@ -101,8 +127,15 @@ func (h *RunTradeRouteCoordinatorHandler) purchase(ctx context.Context, shipSymb
 // buy-side mirror of sellWithFloor and the fix for the stale-ask ladder (SHIP_PARTS
 // bought at D39 as the ask ran 3,985→~7k inside one dispatch). maxAskPerUnit==0 is
 // exactly the plain buy, so purchase() and the manufacturing/contract callers are
-// unchanged.
+// unchanged. A ceilinged buy holds a credit reservation for units×ceiling until
+// it settles.
 func (h *RunTradeRouteCoordinatorHandler) purchaseWithCeiling(ctx context.Context, shipSymbol, good string, units, playerID, maxAskPerUnit int) (*shipCargo.PurchaseCargoResponse, error) {
+	ctx, release, err := h.reserveCredits(ctx, shipSymbol, playerID, units*maxAskPerUnit)
+	if err != nil {
+		return nil, err
+	}
+	defer release()
+
 	resp, err := h.mediator.Send(ctx, &shipCargo.PurchaseCargoCommand{
 		ShipSymbol:    shipSymbol,
 		GoodSymbol:    good,
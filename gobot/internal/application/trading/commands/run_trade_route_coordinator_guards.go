@@ -7,6 +7,7 @@ import (
 	"fmt"
 
 	"github.com/andrescamacho/spacetraders-go/internal/application/common"
+	"github.com/andrescamacho/spacetraders-go/internal/domain/ledger"
 	"github.com/andrescamacho/spacetraders-go/internal/domain/trading"
 )
 
@@ -122,6 +123,43 @@ func (h *RunTradeRouteCoordinatorHandler) spendFloorBreached(
 	return false
 }
 
+// SetCreditReservations wires the fleet-shared credit reservation service, the same
+// optional-injection idiom as the other setters. Left unset (nil), buys are sent
+// unreserved exactly as before.
+func (h *RunTradeRouteCoordinatorHandler) SetCreditReservations(reservations ledger.CreditReservations) {
+	h.creditReservations = reservations
+}
+
+// reserveCredits reserves amount of the live treasury for shipSymbol's imminent buy
+// and returns ctx carrying the reservation (so the purchase handler spends it rather
+// than counting it against the buy) plus its release. It is a no-op when no
+// reservation service or apiClient is wired, or when amount is not positive (an
+// unceilinged buy has no worst-case cost to reserve). Like the spend floor it fails
+// CLOSED: an unreadable treasury or one already reserved by another coordinator
+// refuses the buy rather than racing it.
+func (h *RunTradeRouteCoordinatorHandler) reserveCredits(ctx context.Context, shipSymbol string, playerID, amount int) (context.Context, func(), error) {
+	noop := func() {}
+	if h.creditReservations == nil || h.apiClient == nil || amount <= 0 {
+		return ctx, noop, nil
+	}
+	token, err := common.PlayerTokenFromContext(ctx)
+	if err != nil {
+		return ctx, noop, fmt.Errorf("credit reservation for %s: %w", shipSymbol, err)
+	}
+	agentData, err := h.apiClient.GetAgent(ctx, token)
+	if err != nil {
+		return ctx, noop, fmt.Errorf("credit reservation for %s: could not read live treasury: %w", shipSymbol, err)
+	}
+	ctx, release, err := ledger.ReserveForPurchase(ctx, h.creditReservations, playerID, "trade:"+shipSymbol, amount, agentData.Credits)
+	if err != nil {
+		common.LoggerFromContext(ctx).Log("WARNING", "Buy refused: credits are reserved by another coordinator", map[string]interface{}{
+			"ship_symbol": shipSymbol, "amount": amount, "error": err.Error(),
+		})
+		return ctx, noop, err
+	}
+	return ctx, release, nil
+}
+
 // staleAskAborts live-verifies the source ask before the first buy and reports
 // whether the circuit must abort because the ask has moved beyond
 // trading.StaleAskMovePercent from the basis the lane was ranked on (hazard b). The
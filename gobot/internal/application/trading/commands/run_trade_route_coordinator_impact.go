@@ -49,7 +49,22 @@ func (m laneImpactModel) selfCompressionCredits(l trading.ArbitrageLane, planned
 	x := float64(plannedUnits) / float64(l.VolumeCap)
 	// (buyEff − Ask) + (Bid − sellEff) = (buyImpact·Ask + sellImpact·Bid)·x/2, the credit
 	// narrowing of the spread — identical to SpreadPerUnit − (EffectiveSell − EffectiveBuy).
-	return (m.buyImpact*float64(l.SourceAsk) + m.sellImpact*float64(l.DestBid)) * x / 2
+	// Each side's slope is scaled by its market's activity (a STRONG market moves less per
+	// tranche than a WEAK one); a lane with no activity recorded scales by 1.
+	buySlope := m.buyImpact * trading.ActivityImpactFactor(l.SourceActivity)
+	sellSlope := m.sellImpact * trading.ActivityImpactFactor(l.DestActivity)
+	return (buySlope*float64(l.SourceAsk) + sellSlope*float64(l.DestBid)) * x / 2
+}
+
+// fill projects the transaction-by-transaction fill of plannedUnits along the lane
+// with the model's impact slopes, so the selection log can say how many
+// transactions the trade takes and whether its margin erodes before the hold is
+// full. ok=false when the model is inert or there is nothing to plan.
+func (m laneImpactModel) fill(l trading.ArbitrageLane, plannedUnits int) (trading.LaneFill, bool) {
+	if plannedUnits <= 0 || (m.buyImpact <= 0 && m.sellImpact <= 0) {
+		return trading.LaneFill{}, false
+	}
+	return trading.PlanLaneFill(l, plannedUnits, m.buyImpact, m.sellImpact), true
 }
 
 // decayedDebtCredits converts the lane's live decayed compression FRACTION (from the
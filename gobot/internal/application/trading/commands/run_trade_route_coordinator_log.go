@@ -119,7 +119,7 @@ func laneLogPayload(l trading.ArbitrageLane) map[string]interface{} {
 }
 
 // laneSelectionOneLiner renders one lane into a compact
-// "GOOD SRC(SRCSYS)->DST(DSTSYS) m=SPREAD <same|cross> rate=R/hr[(x-waived)]" token
+// "GOOD SRC(SRCSYS)->DST(DSTSYS) m=SPREAD <same|cross> rate=R/hr[(x-waived)] [tx=N [erodes@U]]" token
 // for the selection log message text (sp-149h). m is the per-unit margin
 // (SpreadPerUnit); the same/cross tag makes a gate-crossing lane greppable without
 // parsing the two system codes.
@@ -147,7 +147,17 @@ func laneSelectionOneLiner(l trading.ArbitrageLane, shipCapacity int, targetDest
 		l.Good, l.SourceWaypoint, srcSys, l.DestWaypoint, dstSys, l.SpreadPerUnit, scope,
 		laneCircuitRatePerHour(l, shipCapacity, targetDest, model))
 	if srcSys != dstSys && laneMatchesTarget(l, targetDest) {
-		return base + "(x-waived)"
+		base += "(x-waived)"
+	}
+	// tx=N is how many market transactions a full hold takes on this lane; erodes@U
+	// marks a lane whose own price impact stops the marginal unit clearing a profit
+	// after U units. Only shown with a live impact model, so inert-model lines are
+	// unchanged.
+	if fill, ok := model.fill(l, shipCapacity); ok {
+		base += fmt.Sprintf(" tx=%d", fill.Transactions())
+		if fill.ErodesMidFill() {
+			base += fmt.Sprintf(" erodes@%d", fill.ProfitableUnits)
+		}
 	}
 	return base
 }
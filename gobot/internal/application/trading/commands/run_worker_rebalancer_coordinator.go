@@ -327,7 +327,7 @@ func (h *RunWorkerRebalancerCoordinatorHandler) Handle(ctx context.Context, requ
 		h.noteEffect(ctx, cmd, effMon, desired, ferried)
 
 		select {
-		case <-time.After(tick):
+		case <-shared.After(shared.ClockFromContext(ctx), tick):
 		case <-ctx.Done():
 			return result, ctx.Err()
 		}
@@ -0,0 +1,109 @@
+package queries
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/andrescamacho/spacetraders-go/internal/application/common"
+	"github.com/andrescamacho/spacetraders-go/internal/domain/shared"
+	"github.com/andrescamacho/spacetraders-go/internal/domain/trading"
+)
+
+// DefaultTradeLaneWindow is the look-back of a lane stats query that names no
+// window.
+const DefaultTradeLaneWindow = 7 * 24 * time.Hour
+
+// LaneExecutionReader is the narrow lane execution history port the stats need.
+type LaneExecutionReader interface {
+	ListExecutions(ctx context.Context, playerID int, since time.Time) ([]trading.LaneExecution, error)
+}
+
+// GetTradeLaneStatsQuery - Query for the realized track record of every
+// (buy market, sell market, good) lane the arb and tour coordinators have
+// traded, ranked so a coordinator can prefer lanes that have paid reliably.
+type GetTradeLaneStatsQuery struct {
+	PlayerID shared.PlayerID
+	// Window is how far back executions are read; 0 means DefaultTradeLaneWindow.
+	Window time.Duration
+	// RankBy is trading.LaneRankByProfit (the default), LaneRankByVolume or
+	// LaneRankByStability.
+	RankBy string
+	// Good narrows the board to one good; empty covers every good.
+	Good string
+	// MinExecutions drops lanes traded fewer times, so a single lucky run does
+	// not top a stability board.
+	MinExecutions int
+	// Limit caps the lanes returned; 0 returns them all.
+	Limit int
+}
+
+// GetTradeLaneStatsResponse - Lanes best first.
+type GetTradeLaneStatsResponse struct {
+	Since time.Time
+	Lanes []trading.LaneStats
+}
+
+// GetTradeLaneStatsHandler - Handles trade lane stats queries
+type GetTradeLaneStatsHandler struct {
+	executions LaneExecutionReader
+	clock      shared.Clock
+}
+
+// NewGetTradeLaneStatsHandler creates a new trade lane stats handler.
+// The clock parameter is optional - if nil, defaults to RealClock.
+func NewGetTradeLaneStatsHandler(executions LaneExecutionReader, clock shared.Clock) *GetTradeLaneStatsHandler {
+	if clock == nil {
+		clock = shared.NewRealClock()
+	}
+	return &GetTradeLaneStatsHandler{executions: executions, clock: clock}
+}
+
+// Handle executes the trade lane stats query
+func (h *GetTradeLaneStatsHandler) Handle(ctx context.Context, request common.Request) (common.Response, error) {
+	query, ok := request.(*GetTradeLaneStatsQuery)
+	if !ok {
+		return nil, fmt.Errorf("invalid request type")
+	}
+	switch query.RankBy {
+	case "", trading.LaneRankByProfit, trading.LaneRankByVolume, trading.LaneRankByStability:
+	default:
+		return nil, fmt.Errorf("unknown lane ranking %q (want %s, %s or %s)",
+			query.RankBy, trading.LaneRankByProfit, trading.LaneRankByVolume, trading.LaneRankByStability)
+	}
+	window := query.Window
+	if window <= 0 {
+		window = DefaultTradeLaneWindow
+	}
+	since := h.clock.Now().Add(-window)
+
+	executions, err := h.executions.ListExecutions(ctx, query.PlayerID.Value(), since)
+	if err != nil {
+		return nil, err
+	}
+	if query.Good != "" {
+		filtered := executions[:0]
+		for _, e := range executions {
+			if e.Good == query.Good {
+				filtered = append(filtered, e)
+			}
+		}
+		executions = filtered
+	}
+
+	lanes := trading.SummarizeLaneExecutions(executions)
+	if query.MinExecutions > 1 {
+		kept := lanes[:0]
+		for _, lane := range lanes {
+			if lane.Executions >= query.MinExecutions {
+				kept = append(kept, lane)
+			}
+		}
+		lanes = kept
+	}
+	trading.RankLaneStats(lanes, query.RankBy)
+	if query.Limit > 0 && len(lanes) > query.Limit {
+		lanes = lanes[:query.Limit]
+	}
+	return &GetTradeLaneStatsResponse{Since: since, Lanes: lanes}, nil
+}
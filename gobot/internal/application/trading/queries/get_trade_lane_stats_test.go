@@ -0,0 +1,58 @@
+package queries
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/andrescamacho/spacetraders-go/internal/domain/shared"
+	"github.com/andrescamacho/spacetraders-go/internal/domain/trading"
+)
+
+type fakeLaneExecutionReader struct {
+	executions []trading.LaneExecution
+	since      time.Time
+}
+
+func (f *fakeLaneExecutionReader) ListExecutions(_ context.Context, _ int, since time.Time) ([]trading.LaneExecution, error) {
+	f.since = since
+	return f.executions, nil
+}
+
+func TestGetTradeLaneStats_RanksFiltersAndLimits(t *testing.T) {
+	now := time.Date(2026, 4, 1, 12, 0, 0, 0, time.UTC)
+	exec := func(buy, sell, good string, units, cost, revenue int) trading.LaneExecution {
+		return trading.LaneExecution{BuyMarket: buy, SellMarket: sell, Good: good, Units: units, Cost: cost, Revenue: revenue, ExecutedAt: now.Add(-time.Hour)}
+	}
+	reader := &fakeLaneExecutionReader{executions: []trading.LaneExecution{
+		exec("X1-A-B1", "X1-A-C1", "FUEL", 100, 1000, 1500),
+		exec("X1-A-B1", "X1-A-C1", "FUEL", 100, 1000, 1500),
+		exec("X1-A-B2", "X1-A-C2", "GOLD", 10, 1000, 3000),
+		exec("X1-A-B3", "X1-A-C3", "FOOD", 50, 1000, 1400),
+	}}
+	handler := NewGetTradeLaneStatsHandler(reader, &shared.MockClock{CurrentTime: now})
+
+	resp, err := handler.Handle(context.Background(), &GetTradeLaneStatsQuery{PlayerID: shared.MustNewPlayerID(1)})
+	require.NoError(t, err)
+	board := resp.(*GetTradeLaneStatsResponse)
+	require.Equal(t, now.Add(-DefaultTradeLaneWindow), reader.since)
+	require.Len(t, board.Lanes, 3)
+	require.Equal(t, "GOLD", board.Lanes[0].Good, "GOLD realized the most profit")
+
+	resp, err = handler.Handle(context.Background(), &GetTradeLaneStatsQuery{
+		PlayerID: shared.MustNewPlayerID(1), RankBy: trading.LaneRankByVolume, MinExecutions: 2, Limit: 1,
+	})
+	require.NoError(t, err)
+	board = resp.(*GetTradeLaneStatsResponse)
+	require.Len(t, board.Lanes, 1)
+	require.Equal(t, "FUEL", board.Lanes[0].Good)
+	require.Equal(t, 200, board.Lanes[0].Units)
+}
+
+func TestGetTradeLaneStats_RejectsUnknownRanking(t *testing.T) {
+	handler := NewGetTradeLaneStatsHandler(&fakeLaneExecutionReader{}, nil)
+	_, err := handler.Handle(context.Background(), &GetTradeLaneStatsQuery{PlayerID: shared.MustNewPlayerID(1), RankBy: "vibes"})
+	require.Error(t, err)
+}
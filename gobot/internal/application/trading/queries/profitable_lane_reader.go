@@ -70,6 +70,23 @@ func (r *ProfitableLaneReader) CountProfitableLanes(ctx context.Context, playerI
 	return len(seen), true, nil
 }
 
+// ProfitableLanesInSystem returns the floor-clearing arbitrage lanes ranked within one system, best
+// first — the lanes CountProfitableLanes counts, for read-only displays. A market-list read failure
+// is returned; an unreadable waypoint market contributes nothing.
+func (r *ProfitableLaneReader) ProfitableLanesInSystem(ctx context.Context, playerID int, systemSymbol string) ([]trading.ArbitrageLane, error) {
+	listings, err := r.collectSystemListings(ctx, systemSymbol, playerID)
+	if err != nil {
+		return nil, err
+	}
+	var lanes []trading.ArbitrageLane
+	for _, lane := range trading.RankSpreads(listings) {
+		if lane.ClearsFloor() {
+			lanes = append(lanes, lane)
+		}
+	}
+	return lanes, nil
+}
+
 // collectSystemListings reads every cached market in one system into flat trading.GoodListing rows —
 // the read-only mirror of the trade-route coordinator's own collectSystemListings, in
 // market-perspective column semantics (Bid = the market's PurchasePrice column, Ask = its SellPrice
@@ -0,0 +1,144 @@
+package queries
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/andrescamacho/spacetraders-go/internal/application/common"
+	"github.com/andrescamacho/spacetraders-go/internal/domain/backtest"
+	"github.com/andrescamacho/spacetraders-go/internal/domain/shared"
+)
+
+const (
+	BacktestStrategyArbitrage     = "arbitrage"
+	BacktestStrategyManufacturing = "manufacturing"
+)
+
+// BacktestPriceLookback is how far before Since the replay reads history.
+// Price history only records changes, so a market whose price held steady
+// into the window has its quote in a snapshot recorded before it.
+const BacktestPriceLookback = 24 * time.Hour
+
+// BacktestHistoryReader is the narrow price history port a backtest replays.
+// Satisfied by *persistence.GormMarketPriceHistoryRepository.
+type BacktestHistoryReader interface {
+	ListSystemPriceHistory(ctx context.Context, playerID int, systemSymbol string, since, until time.Time) ([]backtest.PriceSnapshot, error)
+}
+
+// BacktestWaypointLister positions the system's waypoints for travel times.
+type BacktestWaypointLister interface {
+	ListBySystem(ctx context.Context, systemSymbol string) ([]*shared.Waypoint, error)
+}
+
+// RunBacktestQuery - Query replaying a system's recorded market prices over
+// [Since, Until] with one simulated hull flying an arbitrage or manufacturing
+// strategy, to estimate what the strategy would have earned.
+type RunBacktestQuery struct {
+	PlayerID     shared.PlayerID
+	SystemSymbol string
+	Since        time.Time
+	Until        time.Time
+	// Strategy is BacktestStrategyArbitrage (the default) or
+	// BacktestStrategyManufacturing.
+	Strategy string
+
+	// StartWaypoint is where the hull starts; it must be in SystemSymbol.
+	StartWaypoint string
+	EngineSpeed   int
+	CargoCapacity int
+	// MaxTradeVolumes caps a leg at this many trade volumes; 0 uses
+	// backtest.DefaultMaxTradeVolumes.
+	MaxTradeVolumes float64
+	// FuelPrice is what one FUEL market unit costs; 0 leaves fuel out.
+	FuelPrice int
+
+	// MinProfit drops arbitrage legs expected to earn less.
+	MinProfit int
+
+	// Factory, Product, Sink and Inputs describe the manufacturing cycle.
+	Factory string
+	Product string
+	Sink    string
+	Inputs  []backtest.ManufacturingInput
+}
+
+// RunBacktestResponse - The simulated run and how much history it replayed.
+type RunBacktestResponse struct {
+	Result    *backtest.Result
+	Snapshots int
+}
+
+// RunBacktestHandler - Handles backtest queries
+type RunBacktestHandler struct {
+	history   BacktestHistoryReader
+	waypoints BacktestWaypointLister
+}
+
+// NewRunBacktestHandler creates a new backtest handler.
+func NewRunBacktestHandler(history BacktestHistoryReader, waypoints BacktestWaypointLister) *RunBacktestHandler {
+	return &RunBacktestHandler{history: history, waypoints: waypoints}
+}
+
+// Handle loads the window's price history and runs the strategy over it.
+func (h *RunBacktestHandler) Handle(ctx context.Context, request common.Request) (common.Response, error) {
+	query, ok := request.(*RunBacktestQuery)
+	if !ok {
+		return nil, fmt.Errorf("invalid request type")
+	}
+	if query.SystemSymbol == "" {
+		return nil, fmt.Errorf("system_symbol is required")
+	}
+	if shared.ExtractSystemSymbol(query.StartWaypoint) != query.SystemSymbol {
+		return nil, fmt.Errorf("start waypoint %q is not in %s", query.StartWaypoint, query.SystemSymbol)
+	}
+
+	var strategy backtest.Strategy
+	switch query.Strategy {
+	case "", BacktestStrategyArbitrage:
+		strategy = &backtest.ArbitrageStrategy{MinProfit: query.MinProfit}
+	case BacktestStrategyManufacturing:
+		if query.Factory == "" || query.Product == "" || query.Sink == "" {
+			return nil, fmt.Errorf("a manufacturing backtest needs factory, product and sink")
+		}
+		strategy = &backtest.ManufacturingStrategy{
+			Factory: query.Factory,
+			Product: query.Product,
+			Sink:    query.Sink,
+			Inputs:  query.Inputs,
+		}
+	default:
+		return nil, fmt.Errorf("unknown backtest strategy %q (want %s or %s)",
+			query.Strategy, BacktestStrategyArbitrage, BacktestStrategyManufacturing)
+	}
+
+	snapshots, err := h.history.ListSystemPriceHistory(ctx, query.PlayerID.Value(), query.SystemSymbol,
+		query.Since.Add(-BacktestPriceLookback), query.Until)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load price history: %w", err)
+	}
+	waypoints, err := h.waypoints.ListBySystem(ctx, query.SystemSymbol)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list waypoints for %s: %w", query.SystemSymbol, err)
+	}
+	positions := make(map[string]backtest.Point, len(waypoints))
+	for _, wp := range waypoints {
+		positions[wp.Symbol] = backtest.Point{X: wp.X, Y: wp.Y}
+	}
+
+	replay := backtest.NewMarketReplay(snapshots)
+	result, err := backtest.Run(replay, backtest.Config{
+		Start:           query.Since,
+		End:             query.Until,
+		StartWaypoint:   query.StartWaypoint,
+		Waypoints:       positions,
+		EngineSpeed:     query.EngineSpeed,
+		CargoCapacity:   query.CargoCapacity,
+		MaxTradeVolumes: query.MaxTradeVolumes,
+		FuelPrice:       query.FuelPrice,
+	}, strategy)
+	if err != nil {
+		return nil, err
+	}
+	return &RunBacktestResponse{Result: result, Snapshots: replay.Snapshots()}, nil
+}
@@ -0,0 +1,67 @@
+package queries
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/andrescamacho/spacetraders-go/internal/domain/backtest"
+	"github.com/andrescamacho/spacetraders-go/internal/domain/shared"
+)
+
+type fakeBacktestHistory struct {
+	snapshots    []backtest.PriceSnapshot
+	since, until time.Time
+}
+
+func (f *fakeBacktestHistory) ListSystemPriceHistory(_ context.Context, _ int, _ string, since, until time.Time) ([]backtest.PriceSnapshot, error) {
+	f.since, f.until = since, until
+	return f.snapshots, nil
+}
+
+type fakeBacktestWaypoints []*shared.Waypoint
+
+func (f fakeBacktestWaypoints) ListBySystem(context.Context, string) ([]*shared.Waypoint, error) {
+	return f, nil
+}
+
+func TestRunBacktest_ReplaysWithLookback(t *testing.T) {
+	since := time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)
+	history := &fakeBacktestHistory{snapshots: []backtest.PriceSnapshot{
+		{WaypointSymbol: "X1-BT-A1", GoodSymbol: "IRON", Bid: 8, Ask: 10, TradeVolume: 20, RecordedAt: since.Add(-time.Hour)},
+		{WaypointSymbol: "X1-BT-B1", GoodSymbol: "IRON", Bid: 50, Ask: 60, TradeVolume: 20, RecordedAt: since.Add(-time.Hour)},
+	}}
+	waypoints := fakeBacktestWaypoints{
+		{Symbol: "X1-BT-A1", SystemSymbol: "X1-BT", X: 0, Y: 0},
+		{Symbol: "X1-BT-B1", SystemSymbol: "X1-BT", X: 100, Y: 0},
+	}
+	handler := NewRunBacktestHandler(history, waypoints)
+
+	resp, err := handler.Handle(context.Background(), &RunBacktestQuery{
+		PlayerID:      shared.MustNewPlayerID(1),
+		SystemSymbol:  "X1-BT",
+		Since:         since,
+		Until:         since.Add(time.Hour),
+		StartWaypoint: "X1-BT-A1",
+		EngineSpeed:   30,
+		CargoCapacity: 40,
+	})
+	require.NoError(t, err)
+	require.Equal(t, since.Add(-BacktestPriceLookback), history.since)
+	result := resp.(*RunBacktestResponse)
+	require.Equal(t, 2, result.Snapshots)
+	require.NotEmpty(t, result.Result.Legs, "quotes recorded before the window still price it")
+}
+
+func TestRunBacktest_RejectsIncompleteManufacturingCycle(t *testing.T) {
+	handler := NewRunBacktestHandler(&fakeBacktestHistory{}, fakeBacktestWaypoints{})
+	_, err := handler.Handle(context.Background(), &RunBacktestQuery{
+		PlayerID:      shared.MustNewPlayerID(1),
+		SystemSymbol:  "X1-BT",
+		StartWaypoint: "X1-BT-A1",
+		Strategy:      BacktestStrategyManufacturing,
+	})
+	require.Error(t, err)
+}
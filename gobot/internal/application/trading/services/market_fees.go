@@ -0,0 +1,108 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/andrescamacho/spacetraders-go/internal/application/common"
+	"github.com/andrescamacho/spacetraders-go/internal/domain/trading"
+)
+
+const (
+	// DefaultMarketFeeWindow is how many of the most recent observations, across
+	// every market, a fee fit reads.
+	DefaultMarketFeeWindow = 500
+
+	// DefaultMarketFeeRefitEvery is how many new observations trigger a refit.
+	DefaultMarketFeeRefitEvery = 25
+)
+
+// MarketFeeService records each cargo transaction's quoted vs charged total and
+// periodically refits the per-market fee rates lane ranking and contract
+// profitability charge (trading.SetMarketFees). It implements
+// trading.MarketFeeObserver.
+type MarketFeeService struct {
+	repo       trading.MarketFeeRepository
+	minSamples int
+	window     int
+	refitEvery int
+
+	mu         sync.Mutex
+	sinceRefit int
+	fees       *trading.MarketFees
+}
+
+// NewMarketFeeService creates a fee service. Non-positive knobs select the
+// defaults.
+func NewMarketFeeService(repo trading.MarketFeeRepository, minSamples, window, refitEvery int) *MarketFeeService {
+	if minSamples <= 0 {
+		minSamples = trading.DefaultMarketFeeMinSamples
+	}
+	if window <= 0 {
+		window = DefaultMarketFeeWindow
+	}
+	if refitEvery <= 0 {
+		refitEvery = DefaultMarketFeeRefitEvery
+	}
+	return &MarketFeeService{
+		repo:       repo,
+		minSamples: minSamples,
+		window:     window,
+		refitEvery: refitEvery,
+	}
+}
+
+// ObserveMarketFee records one observation and refits once refitEvery have
+// accumulated. Failures are logged: fee modeling must never fail a trade.
+func (s *MarketFeeService) ObserveMarketFee(ctx context.Context, observation trading.MarketFeeObservation) {
+	logger := common.LoggerFromContext(ctx)
+	if err := s.repo.Record(ctx, observation); err != nil {
+		logger.Log("WARNING", fmt.Sprintf("Market fee model: %v", err), nil)
+		return
+	}
+
+	s.mu.Lock()
+	s.sinceRefit++
+	due := s.sinceRefit >= s.refitEvery
+	if due {
+		s.sinceRefit = 0
+	}
+	s.mu.Unlock()
+
+	if due {
+		if _, err := s.Recalibrate(ctx); err != nil {
+			logger.Log("WARNING", fmt.Sprintf("Market fee refit failed: %v", err), nil)
+		}
+	}
+}
+
+// Recalibrate fits the fee rates from the most recent observations and installs
+// the result.
+func (s *MarketFeeService) Recalibrate(ctx context.Context) (*trading.MarketFees, error) {
+	observations, err := s.repo.FindRecent(ctx, s.window)
+	if err != nil {
+		return nil, err
+	}
+
+	fees := trading.FitMarketFees(observations, s.minSamples)
+	trading.SetMarketFees(fees)
+
+	s.mu.Lock()
+	s.fees = fees
+	s.mu.Unlock()
+
+	common.LoggerFromContext(ctx).Log("INFO", "Market fee refit", map[string]interface{}{
+		"action":       "market_fee_refit",
+		"observations": len(observations),
+		"fee_markets":  fees.Markets(),
+	})
+	return fees, nil
+}
+
+// Fees returns the most recent fit, nil before the first.
+func (s *MarketFeeService) Fees() *trading.MarketFees {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.fees
+}
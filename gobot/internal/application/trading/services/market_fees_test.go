@@ -0,0 +1,49 @@
+package services
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/andrescamacho/spacetraders-go/internal/domain/trading"
+)
+
+type memoryMarketFeeObservations struct {
+	all []trading.MarketFeeObservation
+}
+
+func (m *memoryMarketFeeObservations) Record(_ context.Context, obs trading.MarketFeeObservation) error {
+	m.all = append(m.all, obs)
+	return nil
+}
+
+func (m *memoryMarketFeeObservations) FindRecent(_ context.Context, limit int) ([]trading.MarketFeeObservation, error) {
+	var out []trading.MarketFeeObservation
+	for i := len(m.all) - 1; i >= 0 && len(out) < limit; i-- {
+		out = append(out, m.all[i])
+	}
+	return out, nil
+}
+
+// Every refitEvery observations the service refits and installs the rates lane
+// ranking and contract profitability charge.
+func TestMarketFeeService_RefitsAndInstallsFees(t *testing.T) {
+	t.Cleanup(func() { trading.SetMarketFees(nil) })
+	service := NewMarketFeeService(&memoryMarketFeeObservations{}, 3, 10, 4)
+	ctx := context.Background()
+	obs := trading.MarketFeeObservation{
+		Waypoint: "X1-A1", Good: "IRON_ORE", TransactionType: trading.FeeTransactionSell,
+		Units: 10, Expected: 1000, Actual: 980,
+	}
+
+	for i := 0; i < 3; i++ {
+		service.ObserveMarketFee(ctx, obs)
+	}
+	require.Nil(t, service.Fees(), "no refit before refitEvery observations")
+
+	service.ObserveMarketFee(ctx, obs)
+	require.NotNil(t, service.Fees())
+	require.InDelta(t, 0.02, trading.ActiveMarketFees().Rate("X1-A1"), 1e-9)
+	require.Equal(t, 20, trading.ActiveMarketFees().Fee("X1-A1", 1000))
+}
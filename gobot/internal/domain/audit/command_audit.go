@@ -0,0 +1,52 @@
+// Package audit records the commands dispatched through the daemon's mediator,
+// so an operator can answer "who told that ship to fly across the system"
+// after the fact: which command, with what arguments, from which container,
+// how long it ran and whether it failed.
+package audit
+
+import (
+	"context"
+	"time"
+)
+
+// CommandRecord is one dispatched command.
+type CommandRecord struct {
+	ID          uint
+	PlayerID    int
+	CommandType string
+	// ShipSymbol is the command's ShipSymbol field, when it has one — the
+	// usual question is what was done to a given ship.
+	ShipSymbol string
+	// ContainerID is the container whose run dispatched the command; empty
+	// for commands sent directly by a daemon RPC.
+	ContainerID string
+	// Payload is a bounded JSON summary of the command's fields.
+	Payload   string
+	StartedAt time.Time
+	Duration  time.Duration
+	Success   bool
+	Error     string
+}
+
+// CommandFilter narrows a command audit read. Zero fields do not filter.
+type CommandFilter struct {
+	PlayerID    int
+	CommandType string
+	ShipSymbol  string
+	ContainerID string
+	Since       time.Time
+	Until       time.Time
+	FailedOnly  bool
+	// Limit caps the rows returned, newest first.
+	Limit int
+}
+
+// CommandAuditRepository persists command records. Records are append-only
+// apart from retention, which drops whole rows past their age.
+type CommandAuditRepository interface {
+	RecordCommands(ctx context.Context, records []CommandRecord) error
+	// FindCommands returns the records matching filter, newest first.
+	FindCommands(ctx context.Context, filter CommandFilter) ([]CommandRecord, error)
+	// DeleteCommandsBefore drops records started before cutoff and reports how many.
+	DeleteCommandsBefore(ctx context.Context, cutoff time.Time) (int64, error)
+}
@@ -0,0 +1,88 @@
+package backtest
+
+import (
+	"sort"
+	"time"
+)
+
+// PriceSnapshot is one recorded market price for a good, as the market price
+// history stores it. Bid is what the market pays a ship selling to it
+// (purchase_price); Ask is what it charges a ship buying (sell_price).
+type PriceSnapshot struct {
+	WaypointSymbol string
+	GoodSymbol     string
+	Bid            int
+	Ask            int
+	TradeVolume    int
+	RecordedAt     time.Time
+}
+
+// MarketReplay answers "what did this market quote at time t" from recorded
+// snapshots. Price history only records changes, so a quote holds from its
+// snapshot until the next one for the same market and good.
+type MarketReplay struct {
+	series map[replayKey][]PriceSnapshot // oldest first
+	goods  map[string][]string           // good -> waypoints quoting it
+}
+
+type replayKey struct {
+	waypoint string
+	good     string
+}
+
+// NewMarketReplay indexes snapshots, in any order.
+func NewMarketReplay(snapshots []PriceSnapshot) *MarketReplay {
+	r := &MarketReplay{
+		series: make(map[replayKey][]PriceSnapshot),
+		goods:  make(map[string][]string),
+	}
+	for _, s := range snapshots {
+		key := replayKey{waypoint: s.WaypointSymbol, good: s.GoodSymbol}
+		if _, ok := r.series[key]; !ok {
+			r.goods[s.GoodSymbol] = append(r.goods[s.GoodSymbol], s.WaypointSymbol)
+		}
+		r.series[key] = append(r.series[key], s)
+	}
+	for key := range r.series {
+		series := r.series[key]
+		sort.SliceStable(series, func(i, j int) bool { return series[i].RecordedAt.Before(series[j].RecordedAt) })
+	}
+	for good := range r.goods {
+		sort.Strings(r.goods[good])
+	}
+	return r
+}
+
+// Quote returns the market's latest snapshot for good at or before at.
+func (r *MarketReplay) Quote(waypoint, good string, at time.Time) (PriceSnapshot, bool) {
+	series := r.series[replayKey{waypoint: waypoint, good: good}]
+	i := sort.Search(len(series), func(i int) bool { return series[i].RecordedAt.After(at) })
+	if i == 0 {
+		return PriceSnapshot{}, false
+	}
+	return series[i-1], true
+}
+
+// Goods returns every good with at least one snapshot, sorted.
+func (r *MarketReplay) Goods() []string {
+	goods := make([]string, 0, len(r.goods))
+	for good := range r.goods {
+		goods = append(goods, good)
+	}
+	sort.Strings(goods)
+	return goods
+}
+
+// Markets returns the waypoints with snapshots for good, sorted.
+func (r *MarketReplay) Markets(good string) []string {
+	return r.goods[good]
+}
+
+// Snapshots reports how many snapshots the replay holds.
+func (r *MarketReplay) Snapshots() int {
+	n := 0
+	for _, series := range r.series {
+		n += len(series)
+	}
+	return n
+}
@@ -0,0 +1,360 @@
+package backtest
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/andrescamacho/spacetraders-go/internal/domain/shared"
+	"github.com/andrescamacho/spacetraders-go/internal/domain/trading"
+)
+
+const (
+	// DefaultMaxTradeVolumes caps a leg at one trade volume of the thinner of
+	// its two markets: past that the price impact eats the margin.
+	DefaultMaxTradeVolumes = 1.0
+
+	// DefaultStopOverhead is the time a stop costs besides flying: docking,
+	// trading and orbiting again.
+	DefaultStopOverhead = 30 * time.Second
+
+	// DefaultIdleStep is how long a hull with nothing worth flying waits
+	// before its strategy looks again.
+	DefaultIdleStep = 15 * time.Minute
+
+	// fuelPerMarketUnit is how much ship fuel one unit of FUEL bought at a
+	// market holds.
+	fuelPerMarketUnit = 100
+)
+
+// Point is a waypoint's position.
+type Point struct {
+	X, Y float64
+}
+
+// Config describes the simulated hull and the replay window.
+type Config struct {
+	Start, End    time.Time
+	StartWaypoint string
+	// Waypoints positions every waypoint a leg may visit, for travel times.
+	Waypoints map[string]Point
+
+	EngineSpeed   int
+	CargoCapacity int
+
+	// MaxTradeVolumes caps a leg's units at this many trade volumes of the
+	// buy and sell markets; 0 uses DefaultMaxTradeVolumes.
+	MaxTradeVolumes float64
+	// FuelPrice is what one FUEL market unit costs; 0 leaves fuel out.
+	FuelPrice int
+	// StopOverhead is added per stop; 0 uses DefaultStopOverhead.
+	StopOverhead time.Duration
+	// IdleStep is how long an idle hull waits; 0 uses DefaultIdleStep.
+	IdleStep time.Duration
+
+	// BuyImpact, SellImpact and ImpactTau shape how the hull's own trades
+	// move prices and how fast that wears off; 0 uses the trading defaults.
+	BuyImpact  float64
+	SellImpact float64
+	ImpactTau  time.Duration
+}
+
+// LegResult is one leg the simulated hull flew.
+type LegResult struct {
+	TradeLeg
+	Units     int
+	BuyPrice  int // average per unit paid
+	SellPrice int // average per unit received
+	BoughtAt  time.Time
+	SoldAt    time.Time
+	Cost      int
+	Revenue   int
+	FuelCost  int
+	Profit    int
+}
+
+// Result is the outcome of a backtest.
+type Result struct {
+	Strategy      string
+	Start, End    time.Time
+	Legs          []LegResult
+	SkippedLegs   int // legs the replay had no price for when the hull got there
+	Idle          time.Duration
+	Revenue       int
+	Cost          int
+	FuelCost      int
+	Profit        int
+	ProfitPerHour float64
+}
+
+// LegEstimate prices a leg from the hull's current position at the prices
+// quoted now.
+type LegEstimate struct {
+	Units    int
+	Cost     int
+	Revenue  int
+	FuelCost int
+	Profit   int
+	Seconds  int
+}
+
+// State is what a strategy sees when it picks a leg: the time, where the hull
+// is, and the market as the replay (moved by the hull's own trades) quotes it.
+type State struct {
+	Now      time.Time
+	Location string
+
+	sim *simulator
+}
+
+// Goods returns every good the replay quotes.
+func (s *State) Goods() []string { return s.sim.replay.Goods() }
+
+// Markets returns the waypoints quoting good.
+func (s *State) Markets(good string) []string { return s.sim.replay.Markets(good) }
+
+// Estimate prices leg at the prices quoted now, or false when either market
+// has no quote for the good yet or the hull cannot reach one of them.
+func (s *State) Estimate(leg TradeLeg) (LegEstimate, bool) {
+	buy, ok := s.sim.quote(leg.BuyAt, leg.Good, s.Now)
+	if !ok {
+		return LegEstimate{}, false
+	}
+	sell, ok := s.sim.quote(leg.SellAt, leg.Good, s.Now)
+	if !ok {
+		return LegEstimate{}, false
+	}
+	toBuy, ok := s.sim.hop(s.Location, leg.BuyAt)
+	if !ok {
+		return LegEstimate{}, false
+	}
+	toSell, ok := s.sim.hop(leg.BuyAt, leg.SellAt)
+	if !ok {
+		return LegEstimate{}, false
+	}
+	units, cost, revenue := s.sim.trade(buy, sell)
+	fuel := s.sim.fuelCredits(toBuy.fuel + toSell.fuel)
+	return LegEstimate{
+		Units:    units,
+		Cost:     cost,
+		Revenue:  revenue,
+		FuelCost: fuel,
+		Profit:   revenue - cost - fuel,
+		Seconds:  toBuy.seconds + toSell.seconds + 2*int(s.sim.cfg.StopOverhead/time.Second),
+	}, true
+}
+
+// Run replays the market from cfg.Start to cfg.End with one hull flying the
+// legs strategy picks. Prices are read when the hull arrives, not when it
+// decides, so a lane that closes mid-flight costs what it would have cost
+// live. Each trade moves the replayed price by the trading price-impact
+// model, decaying afterwards, so hammering one lane compresses it. A leg that
+// would finish after cfg.End is not flown.
+func Run(replay *MarketReplay, cfg Config, strategy Strategy) (*Result, error) {
+	if err := cfg.validate(); err != nil {
+		return nil, err
+	}
+	sim := newSimulator(replay, cfg)
+	result := &Result{Strategy: strategy.Name(), Start: cfg.Start, End: cfg.End}
+
+	now, location := cfg.Start, cfg.StartWaypoint
+	overhead := sim.cfg.StopOverhead
+	for now.Before(cfg.End) {
+		leg, ok := strategy.NextLeg(&State{Now: now, Location: location, sim: sim})
+		if !ok {
+			now = now.Add(sim.cfg.IdleStep)
+			result.Idle += sim.cfg.IdleStep
+			continue
+		}
+		toBuy, okBuy := sim.hop(location, leg.BuyAt)
+		toSell, okSell := sim.hop(leg.BuyAt, leg.SellAt)
+		if !okBuy || !okSell {
+			return nil, fmt.Errorf("leg %s→%s visits a waypoint with no position", leg.BuyAt, leg.SellAt)
+		}
+
+		boughtAt := now.Add(time.Duration(toBuy.seconds)*time.Second + overhead)
+		soldAt := boughtAt.Add(time.Duration(toSell.seconds)*time.Second + overhead)
+		if soldAt.After(cfg.End) {
+			break
+		}
+		buy, okBuy := sim.quote(leg.BuyAt, leg.Good, boughtAt)
+		sell, okSell := sim.quote(leg.SellAt, leg.Good, soldAt)
+		if !okBuy || !okSell {
+			result.SkippedLegs++
+			now = now.Add(sim.cfg.IdleStep)
+			result.Idle += sim.cfg.IdleStep
+			continue
+		}
+
+		units, cost, revenue := sim.trade(buy, sell)
+		sim.accrue(leg.BuyAt, leg.Good, units, buy.TradeVolume, boughtAt, true)
+		sim.accrue(leg.SellAt, leg.Good, units, sell.TradeVolume, soldAt, false)
+		fuel := sim.fuelCredits(toBuy.fuel + toSell.fuel)
+		flown := LegResult{
+			TradeLeg:  leg,
+			Units:     units,
+			BuyPrice:  cost / units,
+			SellPrice: revenue / units,
+			BoughtAt:  boughtAt,
+			SoldAt:    soldAt,
+			Cost:      cost,
+			Revenue:   revenue,
+			FuelCost:  fuel,
+			Profit:    revenue - cost - fuel,
+		}
+		result.Legs = append(result.Legs, flown)
+		result.Revenue += revenue
+		result.Cost += cost
+		result.FuelCost += fuel
+		result.Profit += flown.Profit
+
+		now, location = soldAt, leg.SellAt
+	}
+
+	if hours := cfg.End.Sub(cfg.Start).Hours(); hours > 0 {
+		result.ProfitPerHour = float64(result.Profit) / hours
+	}
+	return result, nil
+}
+
+func (c *Config) validate() error {
+	switch {
+	case !c.End.After(c.Start):
+		return errors.New("backtest end must be after its start")
+	case c.EngineSpeed <= 0:
+		return errors.New("backtest engine speed must be positive")
+	case c.CargoCapacity <= 0:
+		return errors.New("backtest cargo capacity must be positive")
+	}
+	if _, ok := c.Waypoints[c.StartWaypoint]; !ok {
+		return fmt.Errorf("start waypoint %q has no position", c.StartWaypoint)
+	}
+	return nil
+}
+
+// simulator carries a run's configuration and the price impact of the
+// hull's own trades.
+type simulator struct {
+	replay *MarketReplay
+	cfg    Config
+	impact map[replayKey]impactDebt
+}
+
+// impactDebt is how far the hull's trades have pushed a market's ask up and
+// bid down, as price fractions, as of at.
+type impactDebt struct {
+	ask, bid float64
+	at       time.Time
+}
+
+type hopCost struct {
+	seconds int
+	fuel    int
+}
+
+func newSimulator(replay *MarketReplay, cfg Config) *simulator {
+	if cfg.MaxTradeVolumes <= 0 {
+		cfg.MaxTradeVolumes = DefaultMaxTradeVolumes
+	}
+	if cfg.StopOverhead <= 0 {
+		cfg.StopOverhead = DefaultStopOverhead
+	}
+	if cfg.IdleStep <= 0 {
+		cfg.IdleStep = DefaultIdleStep
+	}
+	if cfg.BuyImpact <= 0 {
+		cfg.BuyImpact = trading.DefaultBuyImpactCoefficient
+	}
+	if cfg.SellImpact <= 0 {
+		cfg.SellImpact = trading.DefaultSellImpactCoefficient
+	}
+	if cfg.ImpactTau <= 0 {
+		cfg.ImpactTau = trading.DefaultCooldownTau
+	}
+	return &simulator{replay: replay, cfg: cfg, impact: make(map[replayKey]impactDebt)}
+}
+
+// quote is the replayed snapshot with the hull's decayed impact applied.
+func (s *simulator) quote(waypoint, good string, at time.Time) (PriceSnapshot, bool) {
+	snapshot, ok := s.replay.Quote(waypoint, good, at)
+	if !ok || snapshot.TradeVolume <= 0 {
+		return PriceSnapshot{}, false
+	}
+	debt := s.debt(replayKey{waypoint: waypoint, good: good}, at)
+	snapshot.Ask = int(math.Round(float64(snapshot.Ask) * (1 + debt.ask)))
+	snapshot.Bid = int(math.Round(float64(snapshot.Bid) * (1 - debt.bid)))
+	return snapshot, true
+}
+
+// trade sizes a leg between buy and sell and prices both tranches.
+func (s *simulator) trade(buy, sell PriceSnapshot) (units, cost, revenue int) {
+	thinnest := buy.TradeVolume
+	if sell.TradeVolume < thinnest {
+		thinnest = sell.TradeVolume
+	}
+	units = int(s.cfg.MaxTradeVolumes * float64(thinnest))
+	if units > s.cfg.CargoCapacity {
+		units = s.cfg.CargoCapacity
+	}
+	if units < 1 {
+		units = 1
+	}
+	buyX := float64(units) / float64(buy.TradeVolume)
+	sellX := float64(units) / float64(sell.TradeVolume)
+	cost = int(math.Ceil(trading.EffectiveBuyPrice(float64(buy.Ask), buyX, s.cfg.BuyImpact) * float64(units)))
+	revenue = int(math.Floor(trading.EffectiveSellPrice(float64(sell.Bid), sellX, s.cfg.SellImpact) * float64(units)))
+	return units, cost, revenue
+}
+
+func (s *simulator) accrue(waypoint, good string, units, tradeVolume int, at time.Time, bought bool) {
+	key := replayKey{waypoint: waypoint, good: good}
+	debt := s.debt(key, at)
+	x := float64(units) / float64(tradeVolume)
+	if bought {
+		debt.ask += s.cfg.BuyImpact * x
+	} else {
+		debt.bid += s.cfg.SellImpact * x
+	}
+	debt.at = at
+	s.impact[key] = debt
+}
+
+func (s *simulator) debt(key replayKey, at time.Time) impactDebt {
+	debt, ok := s.impact[key]
+	if !ok {
+		return impactDebt{at: at}
+	}
+	if dt := at.Sub(debt.at); dt > 0 {
+		decay := math.Exp(-float64(dt) / float64(s.cfg.ImpactTau))
+		debt.ask *= decay
+		debt.bid *= decay
+	}
+	return debt
+}
+
+func (s *simulator) hop(from, to string) (hopCost, bool) {
+	a, ok := s.cfg.Waypoints[from]
+	if !ok {
+		return hopCost{}, false
+	}
+	b, ok := s.cfg.Waypoints[to]
+	if !ok {
+		return hopCost{}, false
+	}
+	if from == to {
+		return hopCost{}, true
+	}
+	distance := math.Hypot(b.X-a.X, b.Y-a.Y)
+	return hopCost{
+		seconds: shared.FlightModeCruise.TravelTime(distance, s.cfg.EngineSpeed),
+		fuel:    shared.FlightModeCruise.FuelCost(distance),
+	}, true
+}
+
+func (s *simulator) fuelCredits(fuel int) int {
+	if s.cfg.FuelPrice <= 0 || fuel <= 0 {
+		return 0
+	}
+	return (fuel*s.cfg.FuelPrice + fuelPerMarketUnit - 1) / fuelPerMarketUnit
+}
@@ -0,0 +1,152 @@
+package backtest
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+var backtestStart = time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)
+
+func snapshot(waypoint, good string, bid, ask, volume int, at time.Time) PriceSnapshot {
+	return PriceSnapshot{WaypointSymbol: waypoint, GoodSymbol: good, Bid: bid, Ask: ask, TradeVolume: volume, RecordedAt: at}
+}
+
+func backtestConfig(hours int) Config {
+	return Config{
+		Start:         backtestStart,
+		End:           backtestStart.Add(time.Duration(hours) * time.Hour),
+		StartWaypoint: "X1-BT-A1",
+		Waypoints: map[string]Point{
+			"X1-BT-A1": {X: 0, Y: 0},
+			"X1-BT-B1": {X: 100, Y: 0},
+			"X1-BT-F1": {X: 50, Y: 50},
+		},
+		EngineSpeed:   30,
+		CargoCapacity: 40,
+	}
+}
+
+func TestMarketReplay_QuotesLatestSnapshotAtOrBefore(t *testing.T) {
+	replay := NewMarketReplay([]PriceSnapshot{
+		snapshot("X1-BT-A1", "IRON", 9, 11, 20, backtestStart.Add(time.Hour)),
+		snapshot("X1-BT-A1", "IRON", 8, 10, 20, backtestStart),
+	})
+
+	_, ok := replay.Quote("X1-BT-A1", "IRON", backtestStart.Add(-time.Minute))
+	require.False(t, ok, "nothing was recorded yet")
+
+	quote, ok := replay.Quote("X1-BT-A1", "IRON", backtestStart.Add(30*time.Minute))
+	require.True(t, ok)
+	require.Equal(t, 10, quote.Ask)
+
+	quote, ok = replay.Quote("X1-BT-A1", "IRON", backtestStart.Add(time.Hour))
+	require.True(t, ok)
+	require.Equal(t, 11, quote.Ask)
+	require.Equal(t, []string{"IRON"}, replay.Goods())
+	require.Equal(t, 2, replay.Snapshots())
+}
+
+func TestRun_ArbitrageFliesTheSpreadCappedByTradeVolume(t *testing.T) {
+	replay := NewMarketReplay([]PriceSnapshot{
+		snapshot("X1-BT-A1", "IRON", 8, 10, 20, backtestStart),
+		snapshot("X1-BT-B1", "IRON", 50, 60, 20, backtestStart),
+	})
+
+	result, err := Run(replay, backtestConfig(2), &ArbitrageStrategy{})
+	require.NoError(t, err)
+	require.Equal(t, "arbitrage", result.Strategy)
+	require.NotEmpty(t, result.Legs)
+
+	first := result.Legs[0]
+	require.Equal(t, TradeLeg{Good: "IRON", BuyAt: "X1-BT-A1", SellAt: "X1-BT-B1"}, first.TradeLeg)
+	require.Equal(t, 20, first.Units, "one trade volume, not the 40-unit hold")
+	require.Positive(t, first.Profit)
+
+	total := 0
+	for _, leg := range result.Legs {
+		require.False(t, leg.SoldAt.After(result.End))
+		total += leg.Profit
+	}
+	require.Equal(t, total, result.Profit)
+	require.Positive(t, result.ProfitPerHour)
+}
+
+func TestRun_OwnTradesMoveTheReplayedPrice(t *testing.T) {
+	replay := NewMarketReplay([]PriceSnapshot{
+		snapshot("X1-BT-A1", "IRON", 800, 1000, 20, backtestStart),
+		snapshot("X1-BT-B1", "IRON", 5000, 6000, 20, backtestStart),
+	})
+
+	result, err := Run(replay, backtestConfig(2), &ArbitrageStrategy{})
+	require.NoError(t, err)
+	require.GreaterOrEqual(t, len(result.Legs), 2)
+	require.Greater(t, result.Legs[1].BuyPrice, result.Legs[0].BuyPrice, "the first buy pushed the ask up")
+	require.Less(t, result.Legs[1].SellPrice, result.Legs[0].SellPrice, "the first sale pushed the bid down")
+}
+
+func TestRun_IdlesWhenNothingPays(t *testing.T) {
+	replay := NewMarketReplay([]PriceSnapshot{
+		snapshot("X1-BT-A1", "IRON", 8, 10, 20, backtestStart),
+		snapshot("X1-BT-B1", "IRON", 9, 11, 20, backtestStart),
+	})
+
+	result, err := Run(replay, backtestConfig(1), &ArbitrageStrategy{})
+	require.NoError(t, err)
+	require.Empty(t, result.Legs)
+	require.Equal(t, time.Hour, result.Idle)
+	require.Zero(t, result.Profit)
+}
+
+func TestRun_ManufacturingCyclesInputsThenProduct(t *testing.T) {
+	replay := NewMarketReplay([]PriceSnapshot{
+		snapshot("X1-BT-A1", "IRON_ORE", 10, 12, 40, backtestStart),
+		snapshot("X1-BT-F1", "IRON_ORE", 20, 25, 40, backtestStart),
+		snapshot("X1-BT-F1", "IRON", 40, 45, 40, backtestStart),
+		snapshot("X1-BT-B1", "IRON", 90, 100, 40, backtestStart),
+	})
+	strategy := &ManufacturingStrategy{
+		Factory: "X1-BT-F1",
+		Product: "IRON",
+		Sink:    "X1-BT-B1",
+		Inputs:  []ManufacturingInput{{Good: "IRON_ORE", Source: "X1-BT-A1"}},
+	}
+
+	result, err := Run(replay, backtestConfig(1), strategy)
+	require.NoError(t, err)
+	require.GreaterOrEqual(t, len(result.Legs), 3)
+	require.Equal(t, TradeLeg{Good: "IRON_ORE", BuyAt: "X1-BT-A1", SellAt: "X1-BT-F1"}, result.Legs[0].TradeLeg)
+	require.Equal(t, TradeLeg{Good: "IRON", BuyAt: "X1-BT-F1", SellAt: "X1-BT-B1"}, result.Legs[1].TradeLeg)
+	require.Equal(t, result.Legs[0].TradeLeg, result.Legs[2].TradeLeg)
+}
+
+func TestRun_ChargesFuelWhenPriced(t *testing.T) {
+	replay := NewMarketReplay([]PriceSnapshot{
+		snapshot("X1-BT-A1", "IRON", 8, 10, 20, backtestStart),
+		snapshot("X1-BT-B1", "IRON", 50, 60, 20, backtestStart),
+	})
+	cfg := backtestConfig(1)
+	cfg.FuelPrice = 100
+
+	result, err := Run(replay, cfg, &ArbitrageStrategy{})
+	require.NoError(t, err)
+	require.NotEmpty(t, result.Legs)
+	first := result.Legs[0]
+	require.Positive(t, first.FuelCost)
+	require.Equal(t, first.Revenue-first.Cost-first.FuelCost, first.Profit)
+}
+
+func TestRun_RejectsBadConfig(t *testing.T) {
+	replay := NewMarketReplay(nil)
+
+	cfg := backtestConfig(1)
+	cfg.End = cfg.Start
+	_, err := Run(replay, cfg, &ArbitrageStrategy{})
+	require.Error(t, err)
+
+	cfg = backtestConfig(1)
+	cfg.StartWaypoint = "X1-BT-Z9"
+	_, err = Run(replay, cfg, &ArbitrageStrategy{})
+	require.Error(t, err)
+}
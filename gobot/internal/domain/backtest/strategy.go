@@ -0,0 +1,88 @@
+package backtest
+
+// TradeLeg is one buy-carry-sell trip: buy Good at BuyAt, fly it to SellAt
+// and sell it there.
+type TradeLeg struct {
+	Good   string
+	BuyAt  string
+	SellAt string
+}
+
+// Strategy picks the simulated hull's next leg. Returning false idles the
+// hull for the simulator's idle step before it asks again.
+type Strategy interface {
+	Name() string
+	NextLeg(state *State) (TradeLeg, bool)
+}
+
+// ArbitrageStrategy flies whichever lane earns the most per second from where
+// the hull stands, by the prices quoted when it decides, counting the trip to
+// the buy market, trade-volume limits, price impact and fuel. Lanes expected
+// to earn less than MinProfit are not flown.
+type ArbitrageStrategy struct {
+	MinProfit int
+}
+
+// Name identifies the strategy in results.
+func (s *ArbitrageStrategy) Name() string { return "arbitrage" }
+
+// NextLeg ranks every (good, buy market, sell market) the replay quotes.
+func (s *ArbitrageStrategy) NextLeg(state *State) (TradeLeg, bool) {
+	var best TradeLeg
+	bestRate := 0.0
+	found := false
+	for _, good := range state.Goods() {
+		markets := state.Markets(good)
+		for _, buyAt := range markets {
+			for _, sellAt := range markets {
+				if buyAt == sellAt {
+					continue
+				}
+				leg := TradeLeg{Good: good, BuyAt: buyAt, SellAt: sellAt}
+				estimate, ok := state.Estimate(leg)
+				if !ok || estimate.Profit < s.MinProfit || estimate.Profit <= 0 {
+					continue
+				}
+				rate := float64(estimate.Profit) / float64(estimate.Seconds+1)
+				if !found || rate > bestRate {
+					best, bestRate, found = leg, rate, true
+				}
+			}
+		}
+	}
+	return best, found
+}
+
+// ManufacturingInput is one input good a manufacturing cycle buys at Source
+// and delivers (sells) to the factory.
+type ManufacturingInput struct {
+	Good   string
+	Source string
+}
+
+// ManufacturingStrategy runs a fixed production cycle: each input is bought
+// at its source and sold into the factory, then Product is bought at the
+// factory and sold at Sink. The cycle repeats for the whole run; a leg the
+// replay cannot price is skipped, not retried.
+type ManufacturingStrategy struct {
+	Factory string
+	Product string
+	Sink    string
+	Inputs  []ManufacturingInput
+
+	next int
+}
+
+// Name identifies the strategy in results.
+func (s *ManufacturingStrategy) Name() string { return "manufacturing" }
+
+// NextLeg returns the cycle's next leg.
+func (s *ManufacturingStrategy) NextLeg(*State) (TradeLeg, bool) {
+	step := s.next % (len(s.Inputs) + 1)
+	s.next++
+	if step < len(s.Inputs) {
+		input := s.Inputs[step]
+		return TradeLeg{Good: input.Good, BuyAt: input.Source, SellAt: s.Factory}, true
+	}
+	return TradeLeg{Good: s.Product, BuyAt: s.Factory, SellAt: s.Sink}, true
+}
@@ -31,6 +31,15 @@ const (
 
 	// ContainerStatusInterrupted indicates container was running when daemon stopped, pending recovery
 	ContainerStatusInterrupted ContainerStatus = "INTERRUPTED"
+
+	// ContainerStatusBlocked indicates container is paused because a container it
+	// depends on failed or never started; re-evaluated on the next recovery pass
+	ContainerStatusBlocked ContainerStatus = "BLOCKED"
+
+	// ContainerStatusPaused indicates a running container held by an operator: it
+	// starts no new work and keeps its ships until resumed. Reported in memory only;
+	// the persisted row stays RUNNING (see PausedKey)
+	ContainerStatusPaused ContainerStatus = "PAUSED"
 )
 
 // ContainerType categorizes the operation type
@@ -79,6 +88,12 @@ const (
 	ContainerTypeShipyardBackfillCoordinator ContainerType = "SHIPYARD_BACKFILL_COORDINATOR"
 	ContainerTypePurchase                    ContainerType = "PURCHASE"
 	ContainerTypeManufacturingCoordinator    ContainerType = "MANUFACTURING_COORDINATOR"
+	// ContainerTypeShipyardWatch is the standing shipyard watch: a per-player coordinator
+	// that loops forever inside one Handle() recording when watched ship types are in stock
+	// and buying one under its price cap behind the demand and treasury guards, with the
+	// purchasing ship claimed for the container. Like the backfill sweep it is NOT a
+	// CoordinatorOwnsIterations type.
+	ContainerTypeShipyardWatch ContainerType = "SHIPYARD_WATCH_COORDINATOR"
 	// ContainerTypeSitingCoordinator is the standing factory-siting brain: a
 	// per-player coordinator that loops forever inside one Handle() scanning/scoring/sizing
 	// the factory-chain portfolio and launching/retiring goods_factory chains through the
@@ -134,6 +149,29 @@ const (
 	// reuses the trade-route coordinator's multi-jump travel() to cross gates. Like the
 	// other one-shot ship ops it is a single-iteration, CoordinatorOwnsIterations type.
 	ContainerTypeRoute ContainerType = "ROUTE"
+	// ContainerTypeSystemWarmup is the one-shot background pre-fetch of a system's
+	// waypoints, markets and shipyards behind `system warm`. Its progress is
+	// persisted into the container config as it runs.
+	ContainerTypeSystemWarmup ContainerType = "SYSTEM_WARMUP"
+	// ContainerTypeProbeParkingCoordinator is the standing probe-parking coordinator: a
+	// per-player coordinator that loops forever inside one Handle() parking idle probes at
+	// the highest-value markets, one per market, as docked single-market scout tours that
+	// keep re-scanning. It is NOT a CoordinatorOwnsIterations type.
+	ContainerTypeProbeParkingCoordinator ContainerType = "PROBE_PARKING_COORDINATOR"
+	// ContainerTypeTankerCoordinator is the standing tanker coordinator: a per-player
+	// coordinator that loops forever inside one Handle() keeping its tankers stocked with
+	// FUEL and stationed at the mining and siphon sites, refuelling the drones there in
+	// the field. It is NOT a CoordinatorOwnsIterations type.
+	ContainerTypeTankerCoordinator ContainerType = "TANKER_COORDINATOR"
+	// ContainerTypeContractMining is the contract fleet coordinator's temporary mining +
+	// transport sub-operation: excavators mine one contract good at a home-system asteroid
+	// and a transport hull delivers it, until the delivery is met. One iteration, owned by
+	// the command (CoordinatorOwnsIterations).
+	ContainerTypeContractMining ContainerType = "CONTRACT_MINING"
+	// ContainerTypeFleetBootstrap applies a fleet template once: it buys the profile's
+	// starter ships and launches its standing containers. One iteration, owned by the
+	// command (CoordinatorOwnsIterations).
+	ContainerTypeFleetBootstrap ContainerType = "FLEET_BOOTSTRAP"
 )
 
 const (
@@ -167,6 +205,7 @@ type Container struct {
 	// Container-specific state extensions
 	stopping    bool // Indicates STOPPING state (graceful shutdown)
 	interrupted bool // Indicates INTERRUPTED state (daemon crash recovery)
+	paused      bool // Indicates PAUSED while the lifecycle is RUNNING (operator hold)
 
 	// Parent-child relationship tracking
 	parentContainerID *string // ID of parent coordinator (nil for root containers)
@@ -175,6 +214,10 @@ type Container struct {
 	currentIteration int
 	maxIterations    int // -1 for infinite
 
+	// Wall-clock budget from the "max_runtime" config key (0 for unlimited).
+	// Bounds coordinators that loop forever without ever counting an iteration.
+	maxRuntime time.Duration
+
 	// Restart tracking
 	restartCount int
 	maxRestarts  int
@@ -213,6 +256,7 @@ func NewContainer(
 		parentContainerID: parentContainerID,
 		currentIteration:  0,
 		maxIterations:     maxIterations,
+		maxRuntime:        MaxRuntimeFromConfig(metadata),
 		restartCount:      0,
 		maxRestarts:       MaxRestartAttempts,
 		metadata:          metadata,
@@ -228,6 +272,7 @@ func (c *Container) PlayerID() int                    { return c.playerID }
 func (c *Container) ParentContainerID() *string       { return c.parentContainerID }
 func (c *Container) CurrentIteration() int            { return c.currentIteration }
 func (c *Container) MaxIterations() int               { return c.maxIterations }
+func (c *Container) MaxRuntime() time.Duration        { return c.maxRuntime }
 func (c *Container) RestartCount() int                { return c.restartCount }
 func (c *Container) MaxRestarts() int                 { return c.maxRestarts }
 func (c *Container) Metadata() map[string]interface{} { return c.metadata }
@@ -268,7 +313,11 @@ func (c *Container) Status() ContainerStatus {
 		return ContainerStatusInterrupted
 	}
 
-	return shared.ProjectStatus(c.lifecycle, containerStatusByLifecycle, ContainerStatusPending)
+	status := shared.ProjectStatus(c.lifecycle, containerStatusByLifecycle, ContainerStatusPending)
+	if c.paused && status == ContainerStatusRunning {
+		return ContainerStatusPaused
+	}
+	return status
 }
 
 // State transition methods
@@ -292,11 +341,12 @@ func (c *Container) Start() error {
 // Delegates to lifecycle state machine
 func (c *Container) Complete() error {
 	status := c.Status()
-	if status != ContainerStatusRunning {
+	if status != ContainerStatusRunning && status != ContainerStatusPaused {
 		return fmt.Errorf("cannot complete container in %s state", status)
 	}
 
 	c.stopping = false
+	c.paused = false
 	return c.lifecycle.Complete()
 }
 
@@ -320,8 +370,10 @@ func (c *Container) Stop() error {
 		return fmt.Errorf("cannot stop container in %s state", status)
 	}
 
-	// First go to STOPPING to signal graceful shutdown
-	if status == ContainerStatusRunning {
+	// First go to STOPPING to signal graceful shutdown; a paused container stops
+	// like a running one
+	if status == ContainerStatusRunning || status == ContainerStatusPaused {
+		c.paused = false
 		c.stopping = true
 		c.lifecycle.UpdateTimestamp()
 		return nil
@@ -343,10 +395,34 @@ func (c *Container) MarkStopped() error {
 	return c.lifecycle.Stop()
 }
 
+// Pause holds a RUNNING container: the runner lets the work in flight finish and
+// starts nothing new until Resume. The pause survives an in-process restart
+// after a failed iteration.
+func (c *Container) Pause() error {
+	if status := c.Status(); status != ContainerStatusRunning {
+		return fmt.Errorf("cannot pause container in %s state", status)
+	}
+
+	c.paused = true
+	c.lifecycle.UpdateTimestamp()
+	return nil
+}
+
+// Resume releases a PAUSED container.
+func (c *Container) Resume() error {
+	if status := c.Status(); status != ContainerStatusPaused {
+		return fmt.Errorf("cannot resume container in %s state", status)
+	}
+
+	c.paused = false
+	c.lifecycle.UpdateTimestamp()
+	return nil
+}
+
 // Iteration management
 
 func (c *Container) IncrementIteration() error {
-	if c.Status() != ContainerStatusRunning {
+	if status := c.Status(); status != ContainerStatusRunning && status != ContainerStatusPaused {
 		return fmt.Errorf("cannot increment iteration in %s state", c.Status())
 	}
 
@@ -436,6 +512,10 @@ func (c *Container) IsStopping() bool {
 	return c.stopping
 }
 
+func (c *Container) IsPaused() bool {
+	return c.Status() == ContainerStatusPaused
+}
+
 // Runtime calculation
 
 // RuntimeDuration calculates how long the container has been running
@@ -444,6 +524,57 @@ func (c *Container) RuntimeDuration() time.Duration {
 	return c.lifecycle.RuntimeDuration()
 }
 
+// PausedKey is the container config key recording an operator pause, so a
+// container recovered after a daemon restart comes back paused.
+const PausedKey = "paused"
+
+// PausedFromConfig reports whether a container's config records a pause.
+func PausedFromConfig(config map[string]interface{}) bool {
+	paused, _ := config[PausedKey].(bool)
+	return paused
+}
+
+// MaxRuntimeKey is the container config key holding the wall-clock budget.
+const MaxRuntimeKey = "max_runtime"
+
+// MaxRuntimeFromConfig reads the max_runtime budget from a container's launch
+// config. Numbers are seconds (JSON round-trips them as float64); strings use
+// time.ParseDuration syntax ("90m", "6h"). Missing, malformed or non-positive
+// values mean no limit.
+func MaxRuntimeFromConfig(config map[string]interface{}) time.Duration {
+	var d time.Duration
+	switch v := config[MaxRuntimeKey].(type) {
+	case float64:
+		d = time.Duration(v * float64(time.Second))
+	case int:
+		d = time.Duration(v) * time.Second
+	case int64:
+		d = time.Duration(v) * time.Second
+	case string:
+		d, _ = time.ParseDuration(v)
+	}
+	if d < 0 {
+		return 0
+	}
+	return d
+}
+
+// RuntimeStartedAtKey is the container config key holding when the
+// max_runtime budget started counting, as RFC 3339. Recovery stamps it from
+// the persisted launch time so a daemon restart does not refill the budget.
+const RuntimeStartedAtKey = "runtime_started_at"
+
+// RuntimeStartedAtFromConfig reads the runtime budget's start from a
+// container's config; ok is false when it is missing or malformed.
+func RuntimeStartedAtFromConfig(config map[string]interface{}) (time.Time, bool) {
+	raw, _ := config[RuntimeStartedAtKey].(string)
+	startedAt, err := time.Parse(time.RFC3339Nano, raw)
+	if err != nil {
+		return time.Time{}, false
+	}
+	return startedAt, true
+}
+
 func (c *Container) String() string {
 	return fmt.Sprintf("Container[%s, type=%s, status=%s, iteration=%d/%d, restarts=%d]",
 		c.id, c.containerType, c.Status(), c.currentIteration, c.maxIterations, c.restartCount)
@@ -0,0 +1,43 @@
+package container
+
+import (
+	"testing"
+	"time"
+)
+
+// TestMaxRuntimeFromConfig pins the accepted max_runtime encodings: JSON
+// numbers are seconds, strings are Go durations, and anything unusable means
+// no limit.
+func TestMaxRuntimeFromConfig(t *testing.T) {
+	cases := []struct {
+		name  string
+		value interface{}
+		want  time.Duration
+	}{
+		{"json seconds", float64(90), 90 * time.Second},
+		{"int seconds", 3600, time.Hour},
+		{"duration string", "6h", 6 * time.Hour},
+		{"malformed string", "soon", 0},
+		{"negative", float64(-5), 0},
+		{"unset", nil, 0},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			config := map[string]interface{}{}
+			if tc.value != nil {
+				config[MaxRuntimeKey] = tc.value
+			}
+			if got := MaxRuntimeFromConfig(config); got != tc.want {
+				t.Fatalf("MaxRuntimeFromConfig(%v) = %s, want %s", tc.value, got, tc.want)
+			}
+		})
+	}
+}
+
+func TestNewContainerReadsMaxRuntimeFromMetadata(t *testing.T) {
+	c := NewContainer("c-1", ContainerType("coordinator"), 1, -1, nil,
+		map[string]interface{}{MaxRuntimeKey: "2h"}, nil)
+	if c.MaxRuntime() != 2*time.Hour {
+		t.Fatalf("MaxRuntime() = %s, want 2h", c.MaxRuntime())
+	}
+}
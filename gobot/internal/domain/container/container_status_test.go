@@ -37,6 +37,27 @@ func TestContainerStatusProjectsLifecycleState(t *testing.T) {
 			mustDo(t, "Start", c.Start())
 			mustDo(t, "Stop", c.Stop())
 		}, ContainerStatusStopping},
+		{"paused after pause", func(t *testing.T, c *Container) {
+			mustDo(t, "Start", c.Start())
+			mustDo(t, "Pause", c.Pause())
+		}, ContainerStatusPaused},
+		{"running again after resume", func(t *testing.T, c *Container) {
+			mustDo(t, "Start", c.Start())
+			mustDo(t, "Pause", c.Pause())
+			mustDo(t, "Resume", c.Resume())
+		}, ContainerStatusRunning},
+		{"stopping after stop from paused", func(t *testing.T, c *Container) {
+			mustDo(t, "Start", c.Start())
+			mustDo(t, "Pause", c.Pause())
+			mustDo(t, "Stop", c.Stop())
+		}, ContainerStatusStopping},
+		{"paused again after an in-process restart", func(t *testing.T, c *Container) {
+			mustDo(t, "Start", c.Start())
+			mustDo(t, "Pause", c.Pause())
+			mustDo(t, "Fail", c.Fail(fmt.Errorf("boom")))
+			mustDo(t, "ResetForRestart", c.ResetForRestart())
+			mustDo(t, "Start", c.Start())
+		}, ContainerStatusPaused},
 	}
 
 	for _, tc := range cases {
@@ -56,3 +77,28 @@ func mustDo(t *testing.T, action string, err error) {
 		t.Fatalf("%s: %v", action, err)
 	}
 }
+
+func TestContainerPauseRequiresTheMatchingState(t *testing.T) {
+	c := NewContainer("c-1", ContainerTypeTrading, 1, -1, nil, nil, nil)
+	if err := c.Pause(); err == nil {
+		t.Fatalf("a pending container cannot be paused")
+	}
+	mustDo(t, "Start", c.Start())
+	if err := c.Resume(); err == nil {
+		t.Fatalf("a running container has nothing to resume")
+	}
+	mustDo(t, "Pause", c.Pause())
+	mustDo(t, "IncrementIteration", c.IncrementIteration())
+	if err := c.Pause(); err == nil {
+		t.Fatalf("a paused container cannot be paused twice")
+	}
+}
+
+func TestPausedFromConfig(t *testing.T) {
+	if PausedFromConfig(nil) || PausedFromConfig(map[string]interface{}{PausedKey: "yes"}) {
+		t.Fatalf("only a boolean true records a pause")
+	}
+	if !PausedFromConfig(map[string]interface{}{PausedKey: true}) {
+		t.Fatalf("paused=true records a pause")
+	}
+}
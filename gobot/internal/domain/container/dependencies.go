@@ -0,0 +1,104 @@
+package container
+
+// DependencyNode is one container in a startup dependency graph. Each DependsOn
+// entry names the containers it needs either by command type ("scout_post_coordinator")
+// or by container ID.
+type DependencyNode struct {
+	ID          string
+	CommandType string
+	DependsOn   []string
+}
+
+// SatisfiedBy reports whether ref names this node, by ID or by command type.
+func (n DependencyNode) SatisfiedBy(ref string) bool {
+	return ref == n.ID || ref == n.CommandType
+}
+
+// Needs reports whether any of the node's dependencies names other.
+func (n DependencyNode) Needs(other DependencyNode) bool {
+	if other.ID == n.ID {
+		return false
+	}
+	for _, ref := range n.DependsOn {
+		if other.SatisfiedBy(ref) {
+			return true
+		}
+	}
+	return false
+}
+
+// providers returns the nodes other than node that ref names. A node never
+// satisfies its own dependency: a reference to the node's own type only ever
+// means other containers of that type.
+func providers(node DependencyNode, ref string, nodes []DependencyNode) []DependencyNode {
+	var matched []DependencyNode
+	for _, candidate := range nodes {
+		if candidate.ID != node.ID && candidate.SatisfiedBy(ref) {
+			matched = append(matched, candidate)
+		}
+	}
+	return matched
+}
+
+// OrderByDependencies orders nodes so each one comes after every node it
+// depends on, keeping the input order wherever the graph leaves a choice. A
+// reference that names no node in the set does not constrain the order — that
+// dependency is not part of this start. Nodes on a dependency cycle, or
+// depending on one, cannot be ordered and are returned in cyclic, in input order.
+func OrderByDependencies(nodes []DependencyNode) (ordered, cyclic []DependencyNode) {
+	placed := make(map[string]bool, len(nodes))
+	remaining := append([]DependencyNode(nil), nodes...)
+
+	for len(remaining) > 0 {
+		next := -1
+		for i, node := range remaining {
+			if dependenciesPlaced(node, nodes, placed) {
+				next = i
+				break
+			}
+		}
+		if next < 0 {
+			return ordered, remaining
+		}
+		ordered = append(ordered, remaining[next])
+		placed[remaining[next].ID] = true
+		remaining = append(remaining[:next], remaining[next+1:]...)
+	}
+	return ordered, nil
+}
+
+func dependenciesPlaced(node DependencyNode, nodes []DependencyNode, placed map[string]bool) bool {
+	for _, ref := range node.DependsOn {
+		for _, provider := range providers(node, ref, nodes) {
+			if !placed[provider.ID] {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// UnmetDependencies returns the references in node.DependsOn that name at least
+// one of known but none that is running. A reference naming none of known is
+// treated as met: nothing the daemon manages provides it, so there is nothing
+// to wait for.
+func UnmetDependencies(node DependencyNode, known []DependencyNode, running map[string]bool) []string {
+	var unmet []string
+	for _, ref := range node.DependsOn {
+		matched := providers(node, ref, known)
+		if len(matched) == 0 {
+			continue
+		}
+		met := false
+		for _, provider := range matched {
+			if running[provider.ID] {
+				met = true
+				break
+			}
+		}
+		if !met {
+			unmet = append(unmet, ref)
+		}
+	}
+	return unmet
+}
@@ -0,0 +1,84 @@
+package container
+
+import (
+	"reflect"
+	"testing"
+)
+
+func nodeIDs(nodes []DependencyNode) []string {
+	ids := make([]string, 0, len(nodes))
+	for _, n := range nodes {
+		ids = append(ids, n.ID)
+	}
+	return ids
+}
+
+func TestOrderByDependenciesPutsProvidersFirst(t *testing.T) {
+	nodes := []DependencyNode{
+		{ID: "trade-1", CommandType: "trade_fleet_coordinator", DependsOn: []string{"scout_post_coordinator"}},
+		{ID: "contract-1", CommandType: "contract_fleet_coordinator"},
+		{ID: "gas-1", CommandType: "gas_coordinator", DependsOn: []string{"trade-1"}},
+		{ID: "scout-1", CommandType: "scout_post_coordinator"},
+	}
+
+	ordered, cyclic := OrderByDependencies(nodes)
+
+	if len(cyclic) != 0 {
+		t.Fatalf("expected no cycle, got %v", nodeIDs(cyclic))
+	}
+	want := []string{"contract-1", "scout-1", "trade-1", "gas-1"}
+	if got := nodeIDs(ordered); !reflect.DeepEqual(got, want) {
+		t.Fatalf("order = %v, want %v", got, want)
+	}
+}
+
+func TestOrderByDependenciesIgnoresUnmanagedAndSelfReferences(t *testing.T) {
+	nodes := []DependencyNode{
+		{ID: "trade-1", CommandType: "trade_fleet_coordinator", DependsOn: []string{"scout_post_coordinator", "trade_fleet_coordinator"}},
+		{ID: "trade-2", CommandType: "trade_fleet_coordinator"},
+	}
+
+	ordered, cyclic := OrderByDependencies(nodes)
+
+	if len(cyclic) != 0 {
+		t.Fatalf("expected no cycle, got %v", nodeIDs(cyclic))
+	}
+	want := []string{"trade-2", "trade-1"}
+	if got := nodeIDs(ordered); !reflect.DeepEqual(got, want) {
+		t.Fatalf("order = %v, want %v", got, want)
+	}
+}
+
+func TestOrderByDependenciesReportsCycles(t *testing.T) {
+	nodes := []DependencyNode{
+		{ID: "a", CommandType: "x", DependsOn: []string{"b"}},
+		{ID: "b", CommandType: "y", DependsOn: []string{"a"}},
+		{ID: "c", CommandType: "z", DependsOn: []string{"a"}},
+		{ID: "d", CommandType: "w"},
+	}
+
+	ordered, cyclic := OrderByDependencies(nodes)
+
+	if got := nodeIDs(ordered); !reflect.DeepEqual(got, []string{"d"}) {
+		t.Fatalf("ordered = %v, want [d]", got)
+	}
+	if got := nodeIDs(cyclic); !reflect.DeepEqual(got, []string{"a", "b", "c"}) {
+		t.Fatalf("cyclic = %v, want [a b c]", got)
+	}
+}
+
+func TestUnmetDependencies(t *testing.T) {
+	scoutA := DependencyNode{ID: "scout-a", CommandType: "scout_post_coordinator"}
+	scoutB := DependencyNode{ID: "scout-b", CommandType: "scout_post_coordinator"}
+	trade := DependencyNode{ID: "trade-1", CommandType: "trade_fleet_coordinator",
+		DependsOn: []string{"scout_post_coordinator", "warehouse"}}
+	known := []DependencyNode{scoutA, scoutB, trade}
+
+	if unmet := UnmetDependencies(trade, known, map[string]bool{"scout-b": true}); len(unmet) != 0 {
+		t.Fatalf("one running scout should satisfy the type dependency, got unmet %v", unmet)
+	}
+	unmet := UnmetDependencies(trade, known, map[string]bool{})
+	if !reflect.DeepEqual(unmet, []string{"scout_post_coordinator"}) {
+		t.Fatalf("unmet = %v, want [scout_post_coordinator] (warehouse names no known container)", unmet)
+	}
+}
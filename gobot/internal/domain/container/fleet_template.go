@@ -0,0 +1,109 @@
+package container
+
+import (
+	"fmt"
+	"strings"
+)
+
+// FleetTemplate is a named fleet profile: the starter ships an agent should own
+// and the standing containers it should run. BootstrapFleetCommand applies one
+// to stand a fresh agent up in a single step instead of launching each
+// coordinator by hand.
+type FleetTemplate struct {
+	Name        string              `yaml:"name"`
+	Description string              `yaml:"description"`
+	Ships       []ShipOrder         `yaml:"ships"`
+	Containers  []ContainerTemplate `yaml:"containers"`
+}
+
+// ShipOrder asks for Quantity hulls of ShipType. When Frame is set, hulls the
+// agent already owns with that frame count toward Quantity, so re-applying a
+// template only buys the shortfall; without it the full quantity is bought.
+type ShipOrder struct {
+	ShipType string `yaml:"ship_type"`
+	Quantity int    `yaml:"quantity"`
+	Frame    string `yaml:"frame"`
+	// Shipyard pins the yard; empty lets the purchase pick the cheapest one.
+	Shipyard string `yaml:"shipyard"`
+	// MaxBudget caps the credits spent on this order; 0 = no cap beyond treasury.
+	MaxBudget int `yaml:"max_budget"`
+	// Loadout names the loadout preset applied to each ship the order buys.
+	Loadout string `yaml:"loadout"`
+	// MaxPrice caps what any one ship may cost; a yard quoting above it is
+	// passed over rather than paid. 0 = no per-ship cap.
+	MaxPrice int `yaml:"max_price"`
+}
+
+// ContainerTemplate is one standing container in a profile. Name identifies it
+// within the template; DependsOn names the containers it must start after,
+// either by template name or by command type, like DependencyNode.DependsOn.
+type ContainerTemplate struct {
+	Name        string                 `yaml:"name"`
+	CommandType string                 `yaml:"command_type"`
+	DependsOn   []string               `yaml:"depends_on"`
+	Config      map[string]interface{} `yaml:"config"`
+}
+
+// Validate rejects templates that cannot be applied: unnamed or duplicate
+// containers, missing command types, non-positive ship quantities and
+// negative spending caps.
+func (t *FleetTemplate) Validate() error {
+	if strings.TrimSpace(t.Name) == "" {
+		return fmt.Errorf("fleet template has no name")
+	}
+	for i, order := range t.Ships {
+		if order.ShipType == "" {
+			return fmt.Errorf("fleet template %s: ship order %d has no ship_type", t.Name, i)
+		}
+		if order.Quantity <= 0 {
+			return fmt.Errorf("fleet template %s: ship order %s needs a positive quantity", t.Name, order.ShipType)
+		}
+		if order.MaxBudget < 0 || order.MaxPrice < 0 {
+			return fmt.Errorf("fleet template %s: ship order %s has a negative max_budget or max_price", t.Name, order.ShipType)
+		}
+	}
+	seen := make(map[string]bool, len(t.Containers))
+	for i, c := range t.Containers {
+		if c.Name == "" {
+			return fmt.Errorf("fleet template %s: container %d has no name", t.Name, i)
+		}
+		if c.CommandType == "" {
+			return fmt.Errorf("fleet template %s: container %s has no command_type", t.Name, c.Name)
+		}
+		if seen[c.Name] {
+			return fmt.Errorf("fleet template %s: container name %s is used twice", t.Name, c.Name)
+		}
+		seen[c.Name] = true
+	}
+	return nil
+}
+
+// LaunchOrder returns the template's containers ordered so each starts after
+// the containers it depends on, keeping file order wherever the graph allows.
+// A dependency cycle is an error: no container on or behind it could start.
+func (t *FleetTemplate) LaunchOrder() ([]ContainerTemplate, error) {
+	byName := make(map[string]ContainerTemplate, len(t.Containers))
+	nodes := make([]DependencyNode, 0, len(t.Containers))
+	for _, c := range t.Containers {
+		byName[c.Name] = c
+		nodes = append(nodes, DependencyNode{ID: c.Name, CommandType: c.CommandType, DependsOn: c.DependsOn})
+	}
+	ordered, cyclic := OrderByDependencies(nodes)
+	if len(cyclic) > 0 {
+		return nil, fmt.Errorf("fleet template %s: containers [%s] form or depend on a dependency cycle",
+			t.Name, strings.Join(dependencyNodeIDs(cyclic), ", "))
+	}
+	out := make([]ContainerTemplate, 0, len(ordered))
+	for _, node := range ordered {
+		out = append(out, byName[node.ID])
+	}
+	return out, nil
+}
+
+func dependencyNodeIDs(nodes []DependencyNode) []string {
+	ids := make([]string, 0, len(nodes))
+	for _, n := range nodes {
+		ids = append(ids, n.ID)
+	}
+	return ids
+}
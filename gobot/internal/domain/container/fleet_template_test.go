@@ -0,0 +1,69 @@
+package container
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestFleetTemplateLaunchOrderFollowsDependencies(t *testing.T) {
+	tmpl := &FleetTemplate{
+		Name: "standard",
+		Containers: []ContainerTemplate{
+			{Name: "trade", CommandType: "trade_fleet_coordinator", DependsOn: []string{"scout_post_coordinator"}},
+			{Name: "contracts", CommandType: "contract_fleet_coordinator"},
+			{Name: "rebalancer", CommandType: "worker_rebalancer_coordinator", DependsOn: []string{"trade"}},
+			{Name: "scouts", CommandType: "scout_post_coordinator"},
+		},
+	}
+
+	ordered, err := tmpl.LaunchOrder()
+	if err != nil {
+		t.Fatalf("LaunchOrder: %v", err)
+	}
+	got := make([]string, 0, len(ordered))
+	for _, c := range ordered {
+		got = append(got, c.Name)
+	}
+	want := []string{"contracts", "scouts", "trade", "rebalancer"}
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("order = %v, want %v", got, want)
+	}
+}
+
+func TestFleetTemplateLaunchOrderRejectsCycle(t *testing.T) {
+	tmpl := &FleetTemplate{
+		Name: "loop",
+		Containers: []ContainerTemplate{
+			{Name: "a", CommandType: "trade_fleet_coordinator", DependsOn: []string{"b"}},
+			{Name: "b", CommandType: "siting_coordinator", DependsOn: []string{"a"}},
+		},
+	}
+	if _, err := tmpl.LaunchOrder(); err == nil {
+		t.Fatal("a dependency cycle must be rejected")
+	}
+}
+
+func TestFleetTemplateValidate(t *testing.T) {
+	cases := map[string]FleetTemplate{
+		"no name":           {},
+		"zero quantity":     {Name: "x", Ships: []ShipOrder{{ShipType: "SHIP_PROBE"}}},
+		"negative price":    {Name: "x", Ships: []ShipOrder{{ShipType: "SHIP_PROBE", Quantity: 1, MaxPrice: -1}}},
+		"missing type":      {Name: "x", Containers: []ContainerTemplate{{Name: "a"}}},
+		"duplicate name":    {Name: "x", Containers: []ContainerTemplate{{Name: "a", CommandType: "bootstrap"}, {Name: "a", CommandType: "bootstrap"}}},
+		"unnamed container": {Name: "x", Containers: []ContainerTemplate{{CommandType: "bootstrap"}}},
+	}
+	for name, tmpl := range cases {
+		if err := tmpl.Validate(); err == nil {
+			t.Errorf("%s: expected a validation error", name)
+		}
+	}
+
+	ok := FleetTemplate{
+		Name:       "ok",
+		Ships:      []ShipOrder{{ShipType: "SHIP_PROBE", Quantity: 2, MaxPrice: 30000}},
+		Containers: []ContainerTemplate{{Name: "scouts", CommandType: "scout_post_coordinator"}},
+	}
+	if err := ok.Validate(); err != nil {
+		t.Fatalf("valid template rejected: %v", err)
+	}
+}
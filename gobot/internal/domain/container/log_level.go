@@ -0,0 +1,73 @@
+package container
+
+import (
+	"context"
+	"fmt"
+	"strings"
+)
+
+// LogLevelKey is the container config key holding the container's log level.
+const LogLevelKey = "log_level"
+
+// DefaultLogLevel applies to a container launched without a log level: every
+// line is written until an operator turns the container down.
+const DefaultLogLevel = "DEBUG"
+
+// logLevelRanks orders the levels containers log at, least severe first.
+var logLevelRanks = map[string]int{
+	"DEBUG":   0,
+	"INFO":    1,
+	"WARNING": 2,
+	"ERROR":   3,
+}
+
+// NormalizeLogLevel validates a log level and returns its canonical form.
+// Matching is case-insensitive and WARN is accepted for WARNING.
+func NormalizeLogLevel(level string) (string, error) {
+	normalized := canonicalLogLevel(level)
+	if _, ok := logLevelRanks[normalized]; !ok {
+		return "", fmt.Errorf("unknown log level %q (expected DEBUG, INFO, WARNING or ERROR)", level)
+	}
+	return normalized, nil
+}
+
+// canonicalLogLevel upper-cases level and maps the WARN alias to WARNING.
+func canonicalLogLevel(level string) string {
+	normalized := strings.ToUpper(strings.TrimSpace(level))
+	if normalized == "WARN" {
+		return "WARNING"
+	}
+	return normalized
+}
+
+// LogLevelFromConfig reads the log level from a container's config. A missing
+// or malformed value means DefaultLogLevel.
+func LogLevelFromConfig(config map[string]interface{}) string {
+	raw, _ := config[LogLevelKey].(string)
+	level, err := NormalizeLogLevel(raw)
+	if err != nil {
+		return DefaultLogLevel
+	}
+	return level
+}
+
+// LogLevelEnabled reports whether a line at level is written by a container
+// logging at threshold. A WARN line counts as WARNING; levels outside the
+// known set are always written, so an unusual level is never silently lost.
+func LogLevelEnabled(threshold, level string) bool {
+	lineRank, ok := logLevelRanks[canonicalLogLevel(level)]
+	if !ok {
+		return true
+	}
+	thresholdRank, ok := logLevelRanks[threshold]
+	if !ok {
+		thresholdRank = logLevelRanks[DefaultLogLevel]
+	}
+	return lineRank >= thresholdRank
+}
+
+// LogLevelSetter changes the log level of a running container and records it
+// in the container's persisted config, so a recovered container keeps it.
+type LogLevelSetter interface {
+	SetContainerLogLevel(ctx context.Context, containerID, level string) (previous string, err error)
+}
@@ -0,0 +1,56 @@
+package container
+
+import "testing"
+
+// TestLogLevelFromConfig pins how a container's configured log level is read:
+// case-insensitive, WARN as an alias, and the default for anything unusable.
+func TestLogLevelFromConfig(t *testing.T) {
+	cases := []struct {
+		name  string
+		value interface{}
+		want  string
+	}{
+		{"debug", "debug", "DEBUG"},
+		{"warn alias", "Warn", "WARNING"},
+		{"error", "ERROR", "ERROR"},
+		{"unknown", "TRACE", DefaultLogLevel},
+		{"not a string", float64(1), DefaultLogLevel},
+		{"unset", nil, DefaultLogLevel},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			config := map[string]interface{}{}
+			if tc.value != nil {
+				config[LogLevelKey] = tc.value
+			}
+			if got := LogLevelFromConfig(config); got != tc.want {
+				t.Errorf("LogLevelFromConfig(%v) = %q, want %q", tc.value, got, tc.want)
+			}
+		})
+	}
+}
+
+// TestLogLevelEnabled checks the threshold filter, including the WARN alias,
+// that a line at an unrecognized level is never dropped, and that a container
+// without a level writes everything.
+func TestLogLevelEnabled(t *testing.T) {
+	cases := []struct {
+		threshold, level string
+		want             bool
+	}{
+		{"INFO", "DEBUG", false},
+		{"INFO", "INFO", true},
+		{"INFO", "ERROR", true},
+		{"DEBUG", "DEBUG", true},
+		{"ERROR", "WARNING", false},
+		{"ERROR", "WARN", false},
+		{"WARNING", "WARN", true},
+		{"ERROR", "CRITICAL", true},
+		{"", "DEBUG", true},
+	}
+	for _, tc := range cases {
+		if got := LogLevelEnabled(tc.threshold, tc.level); got != tc.want {
+			t.Errorf("LogLevelEnabled(%q, %q) = %v, want %v", tc.threshold, tc.level, got, tc.want)
+		}
+	}
+}
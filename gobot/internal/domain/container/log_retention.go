@@ -0,0 +1,48 @@
+package container
+
+import (
+	"context"
+	"time"
+)
+
+// LogRecord is one stored container log line, as read for pruning and
+// archival. Metadata is the stored JSON, or "" when the line has none.
+type LogRecord struct {
+	ID          int
+	ContainerID string
+	PlayerID    int
+	Timestamp   time.Time
+	Level       string
+	Message     string
+	Metadata    string
+}
+
+// LogVolume is how many log lines one container has stored.
+type LogVolume struct {
+	ContainerID string
+	PlayerID    int
+	Rows        int
+}
+
+// LogRetentionRepository reads and deletes the container log lines a
+// retention policy has expired.
+type LogRetentionRepository interface {
+	// FindLogsBefore returns up to limit lines older than cutoff, oldest first.
+	FindLogsBefore(ctx context.Context, cutoff time.Time, limit int) ([]LogRecord, error)
+
+	// FindContainersOverLogCap returns the containers holding more than maxRows lines.
+	FindContainersOverLogCap(ctx context.Context, maxRows int) ([]LogVolume, error)
+
+	// FindOldestLogs returns a container's limit oldest lines, oldest first.
+	FindOldestLogs(ctx context.Context, containerID string, playerID int, limit int) ([]LogRecord, error)
+
+	// DeleteLogs deletes the lines with the given IDs and reports how many went.
+	DeleteLogs(ctx context.Context, ids []int) (int64, error)
+}
+
+// LogArchive keeps pruned log lines outside the database.
+type LogArchive interface {
+	// Write stores records and returns where they went. Nothing may be
+	// deleted from the database until Write has returned without error.
+	Write(ctx context.Context, records []LogRecord) (string, error)
+}
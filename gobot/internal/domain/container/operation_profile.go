@@ -0,0 +1,76 @@
+package container
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Launch-config keys stamped on every container launched from an operation
+// profile, so a running or finished container names the profile revision that
+// produced it.
+const (
+	ProfileNameConfigKey    = "profile_name"
+	ProfileVersionConfigKey = "profile_version"
+	ProfileDigestConfigKey  = "profile_digest"
+)
+
+// OperationProfile is a named, versioned parameter set for one operation, so
+// an operator launches "tour-x1-haulers" instead of re-typing a long flag list.
+// Operation names the launch verb (tour_run, arb_run, ...); Ships lists the
+// hulls it runs on; Params holds the verb's knobs. Version is bumped by hand
+// when the parameters change, and Digest fingerprints the file that was
+// loaded, so an edit that forgot the bump is still visible on the container.
+type OperationProfile struct {
+	Name        string                 `yaml:"name"`
+	Version     int                    `yaml:"version"`
+	Description string                 `yaml:"description"`
+	Operation   string                 `yaml:"operation"`
+	Ships       []string               `yaml:"ships"`
+	Params      map[string]interface{} `yaml:"params"`
+
+	Digest string `yaml:"-"`
+}
+
+// Validate rejects profiles that cannot be launched whatever the operation:
+// unnamed or unversioned profiles, a missing operation and duplicate ships.
+// Whether Params fit the operation is checked by the launcher.
+func (p *OperationProfile) Validate() error {
+	if strings.TrimSpace(p.Name) == "" {
+		return fmt.Errorf("operation profile has no name")
+	}
+	if p.Version <= 0 {
+		return fmt.Errorf("operation profile %s needs a positive version", p.Name)
+	}
+	if p.Operation == "" {
+		return fmt.Errorf("operation profile %s has no operation", p.Name)
+	}
+	seen := make(map[string]bool, len(p.Ships))
+	for _, ship := range p.Ships {
+		if ship == "" {
+			return fmt.Errorf("operation profile %s lists an empty ship symbol", p.Name)
+		}
+		if seen[ship] {
+			return fmt.Errorf("operation profile %s lists ship %s twice", p.Name, ship)
+		}
+		seen[ship] = true
+	}
+	return nil
+}
+
+// Label is the profile's name and version, e.g. "tour-x1-haulers@v3".
+func (p *OperationProfile) Label() string {
+	return fmt.Sprintf("%s@v%d", p.Name, p.Version)
+}
+
+// ConfigStamp is the set of launch-config keys recording this profile on a
+// launched container.
+func (p *OperationProfile) ConfigStamp() map[string]interface{} {
+	stamp := map[string]interface{}{
+		ProfileNameConfigKey:    p.Name,
+		ProfileVersionConfigKey: p.Version,
+	}
+	if p.Digest != "" {
+		stamp[ProfileDigestConfigKey] = p.Digest
+	}
+	return stamp
+}
@@ -15,12 +15,21 @@ type ShipAssignmentRepository interface {
 	// FindByShipSymbol retrieves the assignment for a ship by symbol
 	FindByShipSymbol(ctx context.Context, shipSymbol string, playerID int) (*ShipAssignment, error)
 
+	// FindAllActive retrieves every active container assignment for a player.
+	// Captain reservations are not container assignments and are excluded.
+	FindAllActive(ctx context.Context, playerID int) ([]*ShipAssignment, error)
+
 	// FindByContainer retrieves all ship assignments for a container
 	FindByContainer(ctx context.Context, containerID string, playerID int) ([]*ShipAssignment, error)
 
 	// Release marks a ship assignment as released
 	Release(ctx context.Context, shipSymbol string, playerID int, reason string) error
 
+	// ReleaseHeldBy releases a ship's active assignment only while containerID
+	// still holds it, so a stale lease never frees a ship another container has
+	// since claimed.
+	ReleaseHeldBy(ctx context.Context, shipSymbol string, playerID int, containerID string, reason string) error
+
 	// Transfer transfers a ship assignment from one container to another
 	Transfer(ctx context.Context, shipSymbol string, fromContainerID string, toContainerID string) error
 
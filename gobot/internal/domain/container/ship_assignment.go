@@ -2,7 +2,9 @@ package container
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"sync"
 	"time"
 
 	"github.com/andrescamacho/spacetraders-go/internal/domain/shared"
@@ -19,6 +21,49 @@ const (
 	AssignmentStatusIdle AssignmentStatus = "idle"
 )
 
+// LeasePriority orders competing coordinators' claims on a ship. A higher
+// priority lease may preempt a lower-priority one whose ship is idle.
+type LeasePriority int
+
+const (
+	// LeasePriorityBackground is for opportunistic work such as idle arbitrage.
+	LeasePriorityBackground LeasePriority = 10
+
+	// LeasePriorityNormal is the default for plain assignments.
+	LeasePriorityNormal LeasePriority = 50
+
+	// LeasePriorityUrgent is for deadline-bound work, e.g. a contract about to expire.
+	LeasePriorityUrgent LeasePriority = 90
+)
+
+// DefaultLeaseTTL is how long a lease survives without a renewal heartbeat.
+// It matches the container heartbeat staleness window (4 missed 30s beats).
+const DefaultLeaseTTL = 2 * time.Minute
+
+// Release reasons stamped by the leasing model.
+const (
+	ReleaseReasonPreempted     = "preempted"
+	ReleaseReasonLeaseExpired  = "lease_expired"
+	ReleaseReasonDaemonRestart = "daemon_restart"
+)
+
+// ErrShipLeased is returned when a ship is held by a live lease that the
+// requester may not preempt.
+var ErrShipLeased = errors.New("ship is already assigned to another container")
+
+// LeasePreemption describes a lease taken away from its holder.
+type LeasePreemption struct {
+	ShipSymbol  string
+	ContainerID string // the preempted holder
+	PreemptedBy string // the container that now holds the ship
+	Priority    LeasePriority
+}
+
+// WindDownFunc is called when a coordinator's lease is preempted so it can
+// stop issuing work to the ship and wind its operation down gracefully. It is
+// invoked after the manager's lock is released and may call back into it.
+type WindDownFunc func(LeasePreemption)
+
 // ShipAssignment represents a ship being assigned to a container operation
 // This provides ship-level locking to prevent concurrent operations on the same ship
 type ShipAssignment struct {
@@ -30,6 +75,14 @@ type ShipAssignment struct {
 	releasedAt    *time.Time
 	releaseReason *string
 	clock         shared.Clock
+
+	// Lease state: the holder's priority, its last renewal heartbeat, whether
+	// it reported the ship idle (only idle ships are preemptible), and the
+	// callback that winds the holder down when it is preempted.
+	priority      LeasePriority
+	lastHeartbeat time.Time
+	idle          bool
+	onPreempt     WindDownFunc
 }
 
 // NewShipAssignment creates a new active ship assignment
@@ -43,14 +96,34 @@ func NewShipAssignment(
 		clock = shared.NewRealClock()
 	}
 
+	now := clock.Now()
 	return &ShipAssignment{
-		shipSymbol:  shipSymbol,
-		playerID:    playerID,
-		containerID: containerID,
-		status:      AssignmentStatusActive,
-		assignedAt:  clock.Now(),
-		clock:       clock,
+		shipSymbol:    shipSymbol,
+		playerID:      playerID,
+		containerID:   containerID,
+		status:        AssignmentStatusActive,
+		assignedAt:    now,
+		clock:         clock,
+		priority:      LeasePriorityNormal,
+		lastHeartbeat: now,
+	}
+}
+
+// ReconstructShipAssignment rebuilds an active assignment loaded from
+// persistence, keeping its original assignment time. The lease starts fresh:
+// heartbeats are not persisted, so the holder gets a full TTL to renew.
+func ReconstructShipAssignment(
+	shipSymbol string,
+	playerID int,
+	containerID string,
+	assignedAt time.Time,
+	clock shared.Clock,
+) *ShipAssignment {
+	assignment := NewShipAssignment(shipSymbol, playerID, containerID, clock)
+	if !assignedAt.IsZero() {
+		assignment.assignedAt = assignedAt
 	}
+	return assignment
 }
 
 // Getters
@@ -62,6 +135,9 @@ func (sa *ShipAssignment) Status() AssignmentStatus { return sa.status }
 func (sa *ShipAssignment) AssignedAt() time.Time    { return sa.assignedAt }
 func (sa *ShipAssignment) ReleasedAt() *time.Time   { return sa.releasedAt }
 func (sa *ShipAssignment) ReleaseReason() *string   { return sa.releaseReason }
+func (sa *ShipAssignment) Priority() LeasePriority  { return sa.priority }
+func (sa *ShipAssignment) LastHeartbeat() time.Time { return sa.lastHeartbeat }
+func (sa *ShipAssignment) IsIdle() bool             { return sa.idle }
 
 // Release marks the assignment as idle with a reason
 func (sa *ShipAssignment) Release(reason string) error {
@@ -102,15 +178,38 @@ func (sa *ShipAssignment) IsActive() bool {
 	return sa.status == AssignmentStatusActive
 }
 
+// IsLeaseExpired reports whether an active lease has gone longer than ttl
+// without a renewal heartbeat.
+func (sa *ShipAssignment) IsLeaseExpired(ttl time.Duration) bool {
+	return sa.IsActive() && sa.clock.Now().Sub(sa.lastHeartbeat) > ttl
+}
+
+// canBePreemptedBy reports whether a claim at priority may take this lease:
+// only an idle ship held at strictly lower priority is preemptible, so a
+// coordinator mid-task never loses its ship.
+func (sa *ShipAssignment) canBePreemptedBy(priority LeasePriority) bool {
+	return sa.IsActive() && sa.idle && priority > sa.priority
+}
+
 func (sa *ShipAssignment) String() string {
 	return fmt.Sprintf("ShipAssignment[ship=%s, container=%s, status=%s]",
 		sa.shipSymbol, sa.containerID, sa.status)
 }
 
-// ShipAssignmentManager manages ship assignments and enforces locking
+// ShipAssignmentManager manages ship assignments and enforces locking.
+// Assignments are leases: holders renew them with heartbeats, leases that stop
+// renewing expire, and a higher-priority coordinator may preempt an idle ship
+// from a lower-priority one. Safe for concurrent use by coordinators.
+//
+// With a repository set, every assign and release is written through to it
+// before memory changes, so a failed write leaves both sides as they were, and
+// Rehydrate restores the surviving assignments after a daemon restart.
 type ShipAssignmentManager struct {
+	mu          sync.Mutex
 	assignments map[string]*ShipAssignment // key: shipSymbol
+	leaseTTL    time.Duration
 	clock       shared.Clock
+	repo        ShipAssignmentRepository
 }
 
 func NewShipAssignmentManager(clock shared.Clock) *ShipAssignmentManager {
@@ -120,11 +219,75 @@ func NewShipAssignmentManager(clock shared.Clock) *ShipAssignmentManager {
 
 	return &ShipAssignmentManager{
 		assignments: make(map[string]*ShipAssignment),
+		leaseTTL:    DefaultLeaseTTL,
 		clock:       clock,
 	}
 }
 
-// AssignShip assigns a ship to a container operation
+// SetLeaseTTL configures how long a lease survives without a heartbeat.
+func (sam *ShipAssignmentManager) SetLeaseTTL(ttl time.Duration) {
+	sam.mu.Lock()
+	defer sam.mu.Unlock()
+	sam.leaseTTL = ttl
+}
+
+// SetRepository makes the manager persist assignments. Without one (the
+// default) assignments live in memory only and are lost on restart.
+func (sam *ShipAssignmentManager) SetRepository(repo ShipAssignmentRepository) {
+	sam.mu.Lock()
+	defer sam.mu.Unlock()
+	sam.repo = repo
+}
+
+// Rehydrate loads playerID's active assignments from the repository on daemon
+// startup. An assignment whose container is in runningContainerIDs is restored
+// into memory; the rest belong to containers that did not survive the restart
+// and are released with ReleaseReasonDaemonRestart. Returns how many were
+// restored and how many released.
+func (sam *ShipAssignmentManager) Rehydrate(
+	ctx context.Context,
+	playerID int,
+	runningContainerIDs map[string]bool,
+) (restored int, released int, err error) {
+	sam.mu.Lock()
+	defer sam.mu.Unlock()
+
+	if sam.repo == nil {
+		return 0, 0, nil
+	}
+	persisted, err := sam.repo.FindAllActive(ctx, playerID)
+	if err != nil {
+		return 0, 0, fmt.Errorf("failed to load ship assignments: %w", err)
+	}
+
+	for _, assignment := range persisted {
+		if runningContainerIDs[assignment.ContainerID()] {
+			sam.assignments[assignment.ShipSymbol()] = ReconstructShipAssignment(
+				assignment.ShipSymbol(), assignment.PlayerID(), assignment.ContainerID(), assignment.AssignedAt(), sam.clock)
+			restored++
+			continue
+		}
+		if err := sam.repo.Release(ctx, assignment.ShipSymbol(), playerID, ReleaseReasonDaemonRestart); err != nil {
+			return restored, released, err
+		}
+		if existing, ok := sam.assignments[assignment.ShipSymbol()]; ok && existing.IsActive() {
+			existing.markReleased(ReleaseReasonDaemonRestart)
+		}
+		released++
+	}
+	return restored, released, nil
+}
+
+// persistRelease writes a release through to the repository, if any. Callers
+// hold sam.mu.
+func (sam *ShipAssignmentManager) persistRelease(ctx context.Context, assignment *ShipAssignment, reason string) error {
+	if sam.repo == nil {
+		return nil
+	}
+	return sam.repo.ReleaseHeldBy(ctx, assignment.ShipSymbol(), assignment.PlayerID(), assignment.ContainerID(), reason)
+}
+
+// AssignShip assigns a ship to a container operation at normal priority.
 // Returns error if ship is already assigned to another container
 func (sam *ShipAssignmentManager) AssignShip(
 	ctx context.Context,
@@ -132,36 +295,191 @@ func (sam *ShipAssignmentManager) AssignShip(
 	playerID int,
 	containerID string,
 ) (*ShipAssignment, error) {
-	if existing, exists := sam.assignments[shipSymbol]; exists {
-		if existing.IsActive() {
-			return nil, fmt.Errorf("ship is already assigned to another container")
+	return sam.LeaseShip(ctx, shipSymbol, playerID, containerID, LeasePriorityNormal, nil)
+}
+
+// LeaseShip leases a ship to a container at the given priority. A ship held by
+// a lease that stopped renewing is reclaimed; a ship held idle at a lower
+// priority is preempted, and the previous holder's onPreempt callback is told
+// to wind down. Otherwise ErrShipLeased is returned. onPreempt may be nil.
+// Leasing a ship the container already holds renews the lease in place.
+func (sam *ShipAssignmentManager) LeaseShip(
+	ctx context.Context,
+	shipSymbol string,
+	playerID int,
+	containerID string,
+	priority LeasePriority,
+	onPreempt WindDownFunc,
+) (*ShipAssignment, error) {
+	sam.mu.Lock()
+
+	var expired, preempted *ShipAssignment
+	if existing, exists := sam.assignments[shipSymbol]; exists && existing.IsActive() {
+		switch {
+		case existing.containerID == containerID:
+			existing.lastHeartbeat = sam.clock.Now()
+			existing.priority = priority
+			existing.onPreempt = onPreempt
+			sam.mu.Unlock()
+			return existing, nil
+		case existing.IsLeaseExpired(sam.leaseTTL):
+			expired = existing
+		case existing.canBePreemptedBy(priority):
+			preempted = existing
+		default:
+			sam.mu.Unlock()
+			return nil, ErrShipLeased
 		}
 	}
 
 	assignment := NewShipAssignment(shipSymbol, playerID, containerID, sam.clock)
+	assignment.priority = priority
+	assignment.onPreempt = onPreempt
+	if err := sam.persistLease(ctx, assignment, expired, preempted); err != nil {
+		sam.mu.Unlock()
+		return nil, err
+	}
+
+	if expired != nil {
+		expired.markReleased(ReleaseReasonLeaseExpired)
+	}
+
+	var notice LeasePreemption
+	var windDown WindDownFunc
+	if preempted != nil {
+		notice = LeasePreemption{
+			ShipSymbol:  shipSymbol,
+			ContainerID: preempted.containerID,
+			PreemptedBy: containerID,
+			Priority:    priority,
+		}
+		windDown = preempted.onPreempt
+		preempted.markReleased(ReleaseReasonPreempted)
+	}
 	sam.assignments[shipSymbol] = assignment
+	sam.mu.Unlock()
 
+	if windDown != nil {
+		windDown(notice)
+	}
 	return assignment, nil
 }
 
+// persistLease writes a new lease through to the repository, first releasing
+// the lease it displaces. Callers hold sam.mu.
+func (sam *ShipAssignmentManager) persistLease(ctx context.Context, assignment, expired, preempted *ShipAssignment) error {
+	if sam.repo == nil {
+		return nil
+	}
+	if expired != nil {
+		if err := sam.persistRelease(ctx, expired, ReleaseReasonLeaseExpired); err != nil {
+			return err
+		}
+	}
+	if preempted != nil {
+		if err := sam.persistRelease(ctx, preempted, ReleaseReasonPreempted); err != nil {
+			return err
+		}
+	}
+	return sam.repo.Assign(ctx, assignment)
+}
+
+// RenewLease records a heartbeat from the lease holder and its current idle
+// state. A coordinator reports idle=true while the ship waits for work, which
+// makes it available to higher-priority coordinators.
+func (sam *ShipAssignmentManager) RenewLease(shipSymbol, containerID string, idle bool) error {
+	sam.mu.Lock()
+	defer sam.mu.Unlock()
+
+	assignment, exists := sam.assignments[shipSymbol]
+	if !exists || !assignment.IsActive() || assignment.containerID != containerID {
+		return fmt.Errorf("ship %s is not leased to container %s", shipSymbol, containerID)
+	}
+	assignment.lastHeartbeat = sam.clock.Now()
+	assignment.idle = idle
+	return nil
+}
+
+// ExpireLeases releases every active lease that has not been renewed within
+// the lease TTL and returns how many were released.
+func (sam *ShipAssignmentManager) ExpireLeases(ctx context.Context) (int, error) {
+	sam.mu.Lock()
+	defer sam.mu.Unlock()
+
+	expired := 0
+	for _, assignment := range sam.assignments {
+		if assignment.IsLeaseExpired(sam.leaseTTL) {
+			if err := sam.persistRelease(ctx, assignment, ReleaseReasonLeaseExpired); err != nil {
+				return expired, err
+			}
+			assignment.markReleased(ReleaseReasonLeaseExpired)
+			expired++
+		}
+	}
+	return expired, nil
+}
+
+// DropLease forgets containerID's lease on shipSymbol without touching the
+// repository, for a release its holder has already persisted through the
+// ship aggregate. A lease held by another container is left alone.
+func (sam *ShipAssignmentManager) DropLease(shipSymbol, containerID string, reason string) {
+	sam.mu.Lock()
+	defer sam.mu.Unlock()
+
+	if assignment, exists := sam.assignments[shipSymbol]; exists && assignment.IsActive() && assignment.containerID == containerID {
+		assignment.markReleased(reason)
+	}
+}
+
+// ActiveAssignments returns a snapshot of playerID's active leases keyed by
+// ship. The entries are copies, so a caller releasing one does not touch the
+// manager's state; releases go through the manager.
+func (sam *ShipAssignmentManager) ActiveAssignments(playerID int) map[string]*ShipAssignment {
+	sam.mu.Lock()
+	defer sam.mu.Unlock()
+	active := make(map[string]*ShipAssignment)
+	for shipSymbol, assignment := range sam.assignments {
+		if assignment.IsActive() && assignment.PlayerID() == playerID {
+			active[shipSymbol] = ReconstructShipAssignment(
+				shipSymbol, playerID, assignment.ContainerID(), assignment.AssignedAt(), sam.clock)
+		}
+	}
+	return active
+}
+
 func (sam *ShipAssignmentManager) GetAssignment(shipSymbol string) (*ShipAssignment, bool) {
+	sam.mu.Lock()
+	defer sam.mu.Unlock()
 	assignment, exists := sam.assignments[shipSymbol]
 	return assignment, exists
 }
 
-func (sam *ShipAssignmentManager) ReleaseAssignment(shipSymbol string, reason string) error {
+func (sam *ShipAssignmentManager) ReleaseAssignment(ctx context.Context, shipSymbol string, reason string) error {
+	sam.mu.Lock()
+	defer sam.mu.Unlock()
 	assignment, exists := sam.assignments[shipSymbol]
 	if !exists {
 		return fmt.Errorf("no assignment found for ship %s", shipSymbol)
 	}
+	if !assignment.IsActive() {
+		return fmt.Errorf("assignment already idle")
+	}
 
+	if err := sam.persistRelease(ctx, assignment, reason); err != nil {
+		return err
+	}
 	return assignment.Release(reason)
 }
 
 // ReleaseAll releases all active assignments with the given reason
-func (sam *ShipAssignmentManager) ReleaseAll(reason string) error {
+func (sam *ShipAssignmentManager) ReleaseAll(ctx context.Context, reason string) error {
+	sam.mu.Lock()
+	defer sam.mu.Unlock()
 	for _, assignment := range sam.assignments {
 		if assignment.IsActive() {
+			if err := sam.persistRelease(ctx, assignment, reason); err != nil {
+				return err
+			}
 			if err := assignment.Release(reason); err != nil {
 				return err
 			}
@@ -172,8 +490,11 @@ func (sam *ShipAssignmentManager) ReleaseAll(reason string) error {
 
 // CleanOrphanedAssignments releases assignments for non-existent containers
 func (sam *ShipAssignmentManager) CleanOrphanedAssignments(
+	ctx context.Context,
 	existingContainerIDs map[string]bool,
 ) (int, error) {
+	sam.mu.Lock()
+	defer sam.mu.Unlock()
 	cleaned := 0
 
 	for _, assignment := range sam.assignments {
@@ -182,6 +503,9 @@ func (sam *ShipAssignmentManager) CleanOrphanedAssignments(
 		}
 
 		if !existingContainerIDs[assignment.ContainerID()] {
+			if err := sam.persistRelease(ctx, assignment, "orphaned_cleanup"); err != nil {
+				return cleaned, err
+			}
 			if err := assignment.Release("orphaned_cleanup"); err != nil {
 				return cleaned, err
 			}
@@ -192,7 +516,9 @@ func (sam *ShipAssignmentManager) CleanOrphanedAssignments(
 	return cleaned, nil
 }
 
-func (sam *ShipAssignmentManager) CleanStaleAssignments(timeout time.Duration) (int, error) {
+func (sam *ShipAssignmentManager) CleanStaleAssignments(ctx context.Context, timeout time.Duration) (int, error) {
+	sam.mu.Lock()
+	defer sam.mu.Unlock()
 	cleaned := 0
 
 	for _, assignment := range sam.assignments {
@@ -201,6 +527,9 @@ func (sam *ShipAssignmentManager) CleanStaleAssignments(timeout time.Duration) (
 		}
 
 		if assignment.IsStale(timeout) {
+			if err := sam.persistRelease(ctx, assignment, "stale_timeout"); err != nil {
+				return cleaned, err
+			}
 			if err := assignment.ForceRelease("stale_timeout"); err != nil {
 				return cleaned, err
 			}
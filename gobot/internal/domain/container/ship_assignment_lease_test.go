@@ -0,0 +1,187 @@
+package container
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/andrescamacho/spacetraders-go/internal/domain/shared"
+)
+
+func newLeaseTestManager() (*ShipAssignmentManager, *shared.MockClock) {
+	clock := &shared.MockClock{CurrentTime: time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)}
+	return NewShipAssignmentManager(clock), clock
+}
+
+// An urgent contract coordinator takes an idle arbitrage hull; the arbitrage
+// coordinator is told to wind down and its lease is released as preempted.
+func TestLeaseShip_HigherPriorityPreemptsIdleLease(t *testing.T) {
+	sam, _ := newLeaseTestManager()
+	ctx := context.Background()
+
+	var notices []LeasePreemption
+	arb, err := sam.LeaseShip(ctx, "SHIP-1", 1, "arb-1", LeasePriorityBackground,
+		func(p LeasePreemption) { notices = append(notices, p) })
+	if err != nil {
+		t.Fatalf("initial lease: %v", err)
+	}
+	if err := sam.RenewLease("SHIP-1", "arb-1", true); err != nil {
+		t.Fatalf("renew: %v", err)
+	}
+
+	contract, err := sam.LeaseShip(ctx, "SHIP-1", 1, "contract-1", LeasePriorityUrgent, nil)
+	if err != nil {
+		t.Fatalf("preempting lease: %v", err)
+	}
+
+	if contract.ContainerID() != "contract-1" || contract.Priority() != LeasePriorityUrgent {
+		t.Fatalf("unexpected new lease %s (priority %d)", contract, contract.Priority())
+	}
+	if arb.IsActive() || *arb.ReleaseReason() != ReleaseReasonPreempted {
+		t.Fatalf("preempted lease should be released as %q, got %s", ReleaseReasonPreempted, arb)
+	}
+	want := LeasePreemption{ShipSymbol: "SHIP-1", ContainerID: "arb-1", PreemptedBy: "contract-1", Priority: LeasePriorityUrgent}
+	if len(notices) != 1 || notices[0] != want {
+		t.Fatalf("wind-down notices = %+v, want [%+v]", notices, want)
+	}
+}
+
+// A busy ship is never preempted, and an idle one only by a strictly higher
+// priority.
+func TestLeaseShip_BusyOrEqualPriorityLeaseIsNotPreempted(t *testing.T) {
+	sam, _ := newLeaseTestManager()
+	ctx := context.Background()
+
+	if _, err := sam.LeaseShip(ctx, "SHIP-1", 1, "arb-1", LeasePriorityBackground, nil); err != nil {
+		t.Fatalf("initial lease: %v", err)
+	}
+	if _, err := sam.LeaseShip(ctx, "SHIP-1", 1, "contract-1", LeasePriorityUrgent, nil); !errors.Is(err, ErrShipLeased) {
+		t.Fatalf("busy ship: err = %v, want ErrShipLeased", err)
+	}
+
+	if err := sam.RenewLease("SHIP-1", "arb-1", true); err != nil {
+		t.Fatalf("renew: %v", err)
+	}
+	if _, err := sam.LeaseShip(ctx, "SHIP-1", 1, "arb-2", LeasePriorityBackground, nil); !errors.Is(err, ErrShipLeased) {
+		t.Fatalf("equal priority: err = %v, want ErrShipLeased", err)
+	}
+}
+
+// Leases that stop heartbeating expire and free the ship for anyone.
+func TestLeases_ExpireWithoutHeartbeat(t *testing.T) {
+	sam, clock := newLeaseTestManager()
+	ctx := context.Background()
+
+	if _, err := sam.LeaseShip(ctx, "SHIP-1", 1, "arb-1", LeasePriorityUrgent, nil); err != nil {
+		t.Fatalf("lease SHIP-1: %v", err)
+	}
+	if _, err := sam.LeaseShip(ctx, "SHIP-2", 1, "arb-1", LeasePriorityUrgent, nil); err != nil {
+		t.Fatalf("lease SHIP-2: %v", err)
+	}
+
+	clock.Advance(DefaultLeaseTTL - time.Second)
+	if err := sam.RenewLease("SHIP-2", "arb-1", false); err != nil {
+		t.Fatalf("renew: %v", err)
+	}
+	clock.Advance(2 * time.Second)
+
+	if got, _ := sam.ExpireLeases(ctx); got != 1 {
+		t.Fatalf("ExpireLeases() = %d, want 1", got)
+	}
+	expired, _ := sam.GetAssignment("SHIP-1")
+	if expired.IsActive() || *expired.ReleaseReason() != ReleaseReasonLeaseExpired {
+		t.Fatalf("SHIP-1 should have expired, got %s", expired)
+	}
+
+	// A lapsed lease is reclaimed on the next claim even before a sweep runs.
+	clock.Advance(DefaultLeaseTTL + time.Second)
+	if _, err := sam.LeaseShip(ctx, "SHIP-2", 1, "scout-1", LeasePriorityBackground, nil); err != nil {
+		t.Fatalf("reclaiming lapsed lease: %v", err)
+	}
+}
+
+func TestRenewLease_RejectsNonHolder(t *testing.T) {
+	sam, _ := newLeaseTestManager()
+	if _, err := sam.LeaseShip(context.Background(), "SHIP-1", 1, "arb-1", LeasePriorityNormal, nil); err != nil {
+		t.Fatalf("lease: %v", err)
+	}
+	if err := sam.RenewLease("SHIP-1", "other", false); err == nil {
+		t.Fatal("renewal by a non-holder must fail")
+	}
+}
+
+// A recovered container re-leasing the ship it already holds renews its lease
+// instead of being refused.
+func TestLeaseShip_SameHolderRenews(t *testing.T) {
+	sam, clock := newLeaseTestManager()
+	ctx := context.Background()
+
+	first, err := sam.LeaseShip(ctx, "SHIP-1", 1, "mining-1", LeasePriorityNormal, nil)
+	if err != nil {
+		t.Fatalf("initial lease: %v", err)
+	}
+	clock.Advance(DefaultLeaseTTL - time.Second)
+
+	again, err := sam.LeaseShip(ctx, "SHIP-1", 1, "mining-1", LeasePriorityNormal, nil)
+	if err != nil {
+		t.Fatalf("re-lease: %v", err)
+	}
+	if again != first || !again.LastHeartbeat().Equal(clock.Now()) {
+		t.Fatalf("re-lease should renew the held lease, got %s", again)
+	}
+}
+
+// DropLease only forgets the named holder's lease.
+func TestDropLease_OnlyForgetsHolder(t *testing.T) {
+	sam, _ := newLeaseTestManager()
+	ctx := context.Background()
+
+	held, err := sam.LeaseShip(ctx, "SHIP-1", 1, "mining-1", LeasePriorityNormal, nil)
+	if err != nil {
+		t.Fatalf("lease: %v", err)
+	}
+
+	sam.DropLease("SHIP-1", "trade-1", "completed")
+	if !held.IsActive() {
+		t.Fatal("another container's drop must not release the lease")
+	}
+
+	sam.DropLease("SHIP-1", "mining-1", "completed")
+	if held.IsActive() || *held.ReleaseReason() != "completed" {
+		t.Fatalf("holder's drop should release the lease, got %s", held)
+	}
+	if _, err := sam.AssignShip(ctx, "SHIP-1", 1, "trade-1"); err != nil {
+		t.Fatalf("dropped ship should be leasable: %v", err)
+	}
+}
+
+// The snapshot holds only the player's active leases, as copies: releasing
+// one leaves the manager's lease in place.
+func TestActiveAssignments_SnapshotsPlayersActiveLeases(t *testing.T) {
+	sam, _ := newLeaseTestManager()
+	ctx := context.Background()
+
+	held, err := sam.LeaseShip(ctx, "SHIP-1", 1, "mining-1", LeasePriorityNormal, nil)
+	if err != nil {
+		t.Fatalf("lease: %v", err)
+	}
+	if _, err := sam.LeaseShip(ctx, "SHIP-2", 2, "mining-2", LeasePriorityNormal, nil); err != nil {
+		t.Fatalf("lease: %v", err)
+	}
+	if _, err := sam.LeaseShip(ctx, "SHIP-3", 1, "trade-1", LeasePriorityNormal, nil); err != nil {
+		t.Fatalf("lease: %v", err)
+	}
+	sam.DropLease("SHIP-3", "trade-1", "completed")
+
+	active := sam.ActiveAssignments(1)
+	if len(active) != 1 || active["SHIP-1"] == nil || active["SHIP-1"].ContainerID() != "mining-1" {
+		t.Fatalf("expected only SHIP-1 held by mining-1, got %v", active)
+	}
+	if err := active["SHIP-1"].Release("stale_cleanup"); err != nil {
+		t.Fatalf("release copy: %v", err)
+	}
+	if !held.IsActive() {
+		t.Fatal("releasing the snapshot must not release the manager's lease")
+	}
+}
@@ -0,0 +1,175 @@
+package container
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+// memoryAssignmentRepo keeps active assignments by ship and records releases
+type memoryAssignmentRepo struct {
+	ShipAssignmentRepository
+	active   map[string]*ShipAssignment
+	released map[string]string // ship -> reason
+	failNext error
+}
+
+func newMemoryAssignmentRepo(assignments ...*ShipAssignment) *memoryAssignmentRepo {
+	repo := &memoryAssignmentRepo{active: map[string]*ShipAssignment{}, released: map[string]string{}}
+	for _, a := range assignments {
+		repo.active[a.ShipSymbol()] = a
+	}
+	return repo
+}
+
+func (r *memoryAssignmentRepo) takeFailure() error {
+	err := r.failNext
+	r.failNext = nil
+	return err
+}
+
+func (r *memoryAssignmentRepo) FindAllActive(_ context.Context, _ int) ([]*ShipAssignment, error) {
+	all := make([]*ShipAssignment, 0, len(r.active))
+	for _, a := range r.active {
+		all = append(all, a)
+	}
+	return all, nil
+}
+
+func (r *memoryAssignmentRepo) Assign(_ context.Context, assignment *ShipAssignment) error {
+	if err := r.takeFailure(); err != nil {
+		return err
+	}
+	if _, held := r.active[assignment.ShipSymbol()]; held {
+		return errors.New("already assigned")
+	}
+	r.active[assignment.ShipSymbol()] = assignment
+	return nil
+}
+
+func (r *memoryAssignmentRepo) Release(_ context.Context, shipSymbol string, _ int, reason string) error {
+	if err := r.takeFailure(); err != nil {
+		return err
+	}
+	delete(r.active, shipSymbol)
+	r.released[shipSymbol] = reason
+	return nil
+}
+
+func (r *memoryAssignmentRepo) ReleaseHeldBy(ctx context.Context, shipSymbol string, playerID int, containerID string, reason string) error {
+	if held, ok := r.active[shipSymbol]; !ok || held.ContainerID() != containerID {
+		return r.takeFailure()
+	}
+	return r.Release(ctx, shipSymbol, playerID, reason)
+}
+
+// After a restart the assignments of surviving containers are restored, and the
+// ships of containers that died with the daemon are freed as daemon_restart.
+func TestRehydrate_RestoresRunningAndReleasesOrphans(t *testing.T) {
+	sam, clock := newLeaseTestManager()
+	assignedAt := clock.Now().Add(-time.Hour)
+	repo := newMemoryAssignmentRepo(
+		ReconstructShipAssignment("SHIP-1", 1, "mining-1", assignedAt, nil),
+		ReconstructShipAssignment("SHIP-2", 1, "gone-1", assignedAt, nil),
+	)
+	sam.SetRepository(repo)
+
+	restored, released, err := sam.Rehydrate(context.Background(), 1, map[string]bool{"mining-1": true})
+	if err != nil {
+		t.Fatalf("Rehydrate: %v", err)
+	}
+	if restored != 1 || released != 1 {
+		t.Fatalf("restored %d, released %d; want 1 and 1", restored, released)
+	}
+
+	kept, ok := sam.GetAssignment("SHIP-1")
+	if !ok || !kept.IsActive() || kept.ContainerID() != "mining-1" || !kept.AssignedAt().Equal(assignedAt) {
+		t.Fatalf("SHIP-1 should be restored to mining-1, got %v", kept)
+	}
+	if kept.IsLeaseExpired(DefaultLeaseTTL) {
+		t.Fatal("a restored lease must get a full TTL to renew")
+	}
+	if _, ok := sam.GetAssignment("SHIP-2"); ok {
+		t.Fatal("an orphaned assignment must not be restored")
+	}
+	if repo.released["SHIP-2"] != ReleaseReasonDaemonRestart {
+		t.Fatalf("orphan should be released as %q, got %v", ReleaseReasonDaemonRestart, repo.released)
+	}
+
+	// The restored lease still blocks other containers.
+	if _, err := sam.AssignShip(context.Background(), "SHIP-1", 1, "trade-1"); !errors.Is(err, ErrShipLeased) {
+		t.Fatalf("restored lease should block reassignment, got %v", err)
+	}
+}
+
+func TestShipAssignmentManager_WritesThroughToRepository(t *testing.T) {
+	sam, _ := newLeaseTestManager()
+	repo := newMemoryAssignmentRepo()
+	sam.SetRepository(repo)
+	ctx := context.Background()
+
+	if _, err := sam.AssignShip(ctx, "SHIP-1", 1, "mining-1"); err != nil {
+		t.Fatalf("AssignShip: %v", err)
+	}
+	if repo.active["SHIP-1"] == nil {
+		t.Fatal("assignment should be persisted")
+	}
+
+	if err := sam.ReleaseAssignment(ctx, "SHIP-1", "completed"); err != nil {
+		t.Fatalf("ReleaseAssignment: %v", err)
+	}
+	if repo.active["SHIP-1"] != nil || repo.released["SHIP-1"] != "completed" {
+		t.Fatalf("release should be persisted, released=%v", repo.released)
+	}
+}
+
+// A failed write leaves memory untouched, so memory never claims a ship the
+// database does not.
+func TestShipAssignmentManager_FailedWriteLeavesMemoryUnchanged(t *testing.T) {
+	sam, _ := newLeaseTestManager()
+	repo := newMemoryAssignmentRepo()
+	sam.SetRepository(repo)
+	ctx := context.Background()
+
+	repo.failNext = errors.New("database is locked")
+	if _, err := sam.AssignShip(ctx, "SHIP-1", 1, "mining-1"); err == nil {
+		t.Fatal("expected the repository error")
+	}
+	if _, ok := sam.GetAssignment("SHIP-1"); ok {
+		t.Fatal("a failed assign must not be held in memory")
+	}
+
+	if _, err := sam.AssignShip(ctx, "SHIP-1", 1, "mining-1"); err != nil {
+		t.Fatalf("AssignShip: %v", err)
+	}
+	repo.failNext = errors.New("database is locked")
+	if err := sam.ReleaseAssignment(ctx, "SHIP-1", "completed"); err == nil {
+		t.Fatal("expected the repository error")
+	}
+	if held, _ := sam.GetAssignment("SHIP-1"); !held.IsActive() {
+		t.Fatal("a failed release must leave the assignment active")
+	}
+}
+
+// An expired lease only frees the ship in the repository while its holder
+// still has it there.
+func TestExpireLeases_LeavesShipClaimedElsewhere(t *testing.T) {
+	sam, clock := newLeaseTestManager()
+	repo := newMemoryAssignmentRepo()
+	sam.SetRepository(repo)
+	ctx := context.Background()
+
+	if _, err := sam.AssignShip(ctx, "SHIP-1", 1, "mining-1"); err != nil {
+		t.Fatalf("AssignShip: %v", err)
+	}
+	repo.active["SHIP-1"] = NewShipAssignment("SHIP-1", 1, "trade-1", nil)
+	clock.Advance(DefaultLeaseTTL + time.Second)
+
+	if expired, err := sam.ExpireLeases(ctx); err != nil || expired != 1 {
+		t.Fatalf("ExpireLeases = %d, %v; want 1, nil", expired, err)
+	}
+	if held := repo.active["SHIP-1"]; held == nil || held.ContainerID() != "trade-1" {
+		t.Fatalf("trade-1's claim must survive mining-1's expiry, got %v", held)
+	}
+}
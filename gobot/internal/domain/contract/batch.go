@@ -0,0 +1,90 @@
+package contract
+
+// BatchDelivery is one contract good still owed, paired with the market the hauler
+// will buy it at. A batch mixes deliveries from several accepted contracts so one
+// hold services all of them in a single purchase-then-deliver trip.
+type BatchDelivery struct {
+	ContractID   string
+	TradeSymbol  string
+	SourceMarket string
+	Destination  string
+	Units        int
+}
+
+// BatchTrip is one hold's worth of batched deliveries: the hauler visits every
+// purchase market, then every destination.
+type BatchTrip struct {
+	Loads []BatchDelivery
+}
+
+// Units is the total cargo the trip carries.
+func (t BatchTrip) Units() int {
+	total := 0
+	for _, load := range t.Loads {
+		total += load.Units
+	}
+	return total
+}
+
+// PurchaseMarkets returns the trip's distinct source markets in load order.
+func (t BatchTrip) PurchaseMarkets() []string {
+	return distinctStops(t.Loads, func(load BatchDelivery) string { return load.SourceMarket })
+}
+
+// Destinations returns the trip's distinct delivery waypoints in load order.
+func (t BatchTrip) Destinations() []string {
+	return distinctStops(t.Loads, func(load BatchDelivery) string { return load.Destination })
+}
+
+// ContractIDs returns the distinct contracts the trip delivers to, in load order.
+func (t BatchTrip) ContractIDs() []string {
+	return distinctStops(t.Loads, func(load BatchDelivery) string { return load.ContractID })
+}
+
+func distinctStops(loads []BatchDelivery, key func(BatchDelivery) string) []string {
+	seen := make(map[string]bool, len(loads))
+	var out []string
+	for _, load := range loads {
+		k := key(load)
+		if k == "" || seen[k] {
+			continue
+		}
+		seen[k] = true
+		out = append(out, k)
+	}
+	return out
+}
+
+// PlanBatchTrips packs deliveries into holds of the given capacity, in the order
+// given (callers put the primary contract first so it is never starved by the
+// others). A delivery larger than the space left in a hold is split: the part that
+// fits rides this trip and the remainder opens the next. Zero-unit deliveries are
+// dropped; a non-positive capacity plans nothing.
+func PlanBatchTrips(deliveries []BatchDelivery, capacity int) []BatchTrip {
+	if capacity <= 0 {
+		return nil
+	}
+
+	var trips []BatchTrip
+	current := BatchTrip{}
+	space := capacity
+	for _, delivery := range deliveries {
+		remaining := delivery.Units
+		for remaining > 0 {
+			if space == 0 {
+				trips = append(trips, current)
+				current = BatchTrip{}
+				space = capacity
+			}
+			load := delivery
+			load.Units = min(remaining, space)
+			current.Loads = append(current.Loads, load)
+			space -= load.Units
+			remaining -= load.Units
+		}
+	}
+	if len(current.Loads) > 0 {
+		trips = append(trips, current)
+	}
+	return trips
+}
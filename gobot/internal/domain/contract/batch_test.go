@@ -0,0 +1,44 @@
+package contract
+
+import (
+	"reflect"
+	"testing"
+)
+
+// Two small contracts share one hold; the overflow of a third opens a second trip
+// rather than dropping units.
+func TestPlanBatchTrips_CombinesContractsAndSplitsOverflow(t *testing.T) {
+	trips := PlanBatchTrips([]BatchDelivery{
+		{ContractID: "C1", TradeSymbol: "IRON_ORE", SourceMarket: "X1-B-M1", Destination: "X1-B-D1", Units: 15},
+		{ContractID: "C2", TradeSymbol: "COPPER_ORE", SourceMarket: "X1-B-M2", Destination: "X1-B-D2", Units: 10},
+		{ContractID: "C3", TradeSymbol: "IRON_ORE", SourceMarket: "X1-B-M1", Destination: "X1-B-D1", Units: 30},
+	}, 40)
+
+	if len(trips) != 2 {
+		t.Fatalf("expected 2 trips, got %d: %+v", len(trips), trips)
+	}
+	if got := trips[0].Units(); got != 40 {
+		t.Fatalf("first hold should be full, got %d units", got)
+	}
+	if got, want := trips[0].ContractIDs(), []string{"C1", "C2", "C3"}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("first trip should service all three contracts, got %v", got)
+	}
+	if got, want := trips[0].PurchaseMarkets(), []string{"X1-B-M1", "X1-B-M2"}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("purchase markets should be distinct, got %v", got)
+	}
+	if got, want := trips[0].Destinations(), []string{"X1-B-D1", "X1-B-D2"}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("destinations should be distinct, got %v", got)
+	}
+	if got := trips[1].Loads; len(got) != 1 || got[0].ContractID != "C3" || got[0].Units != 15 {
+		t.Fatalf("second trip should carry C3's 15-unit remainder, got %+v", got)
+	}
+}
+
+func TestPlanBatchTrips_NoCapacityOrUnits(t *testing.T) {
+	if trips := PlanBatchTrips([]BatchDelivery{{ContractID: "C1", Units: 5}}, 0); trips != nil {
+		t.Fatalf("zero capacity must plan nothing, got %+v", trips)
+	}
+	if trips := PlanBatchTrips([]BatchDelivery{{ContractID: "C1", Units: 0}}, 40); trips != nil {
+		t.Fatalf("nothing owed must plan nothing, got %+v", trips)
+	}
+}
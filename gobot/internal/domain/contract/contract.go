@@ -34,6 +34,7 @@ type Contract struct {
 	terms         Terms
 	accepted      bool
 	fulfilled     bool
+	acceptExpired bool
 	clock         shared.Clock
 }
 
@@ -77,6 +78,7 @@ func (c *Contract) Type() string              { return c.contractType }
 func (c *Contract) Terms() Terms              { return c.terms }
 func (c *Contract) Accepted() bool            { return c.accepted }
 func (c *Contract) Fulfilled() bool           { return c.fulfilled }
+func (c *Contract) AcceptExpired() bool       { return c.acceptExpired }
 
 // Accept accepts the contract (MUTABLE - modifies in place)
 func (c *Contract) Accept() error {
@@ -147,6 +149,30 @@ func (c *Contract) IsExpired() bool {
 	return c.clock.Now().UTC().After(deadline)
 }
 
+// AcceptDeadlinePassed reports whether an unaccepted contract can no longer be
+// accepted. An accepted contract, or one with an unparseable accept deadline,
+// never reports true.
+func (c *Contract) AcceptDeadlinePassed() bool {
+	if c.accepted {
+		return false
+	}
+	deadline, err := time.Parse(time.RFC3339, c.terms.DeadlineToAccept)
+	if err != nil {
+		return false
+	}
+	return c.clock.Now().UTC().After(deadline)
+}
+
+// ExpireUnaccepted records that a negotiated contract was never accepted in
+// time, so it is no longer offered for acceptance (MUTABLE)
+func (c *Contract) ExpireUnaccepted() error {
+	if c.accepted {
+		return fmt.Errorf("contract already accepted")
+	}
+	c.acceptExpired = true
+	return nil
+}
+
 // EvaluateProfitability delegates profitability calculation to ContractProfitabilityService.
 //
 // This method provides a convenient API for contract profitability evaluation while
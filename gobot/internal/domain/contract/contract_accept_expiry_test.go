@@ -0,0 +1,44 @@
+package contract
+
+import (
+	"testing"
+	"time"
+
+	"github.com/andrescamacho/spacetraders-go/internal/domain/shared"
+)
+
+func TestAcceptDeadlinePassed(t *testing.T) {
+	clock := &shared.MockClock{CurrentTime: time.Date(2026, 5, 1, 12, 0, 0, 0, time.UTC)}
+	newContract := func(deadlineToAccept string) *Contract {
+		c, err := NewContract("C-1", shared.MustNewPlayerID(1), "COSMIC", "PROCUREMENT", Terms{
+			Deliveries:       []Delivery{{TradeSymbol: "IRON_ORE", DestinationSymbol: "X1-A-H1", UnitsRequired: 10}},
+			DeadlineToAccept: deadlineToAccept,
+			Deadline:         "2026-05-08T12:00:00Z",
+		}, clock)
+		if err != nil {
+			t.Fatalf("NewContract: %v", err)
+		}
+		return c
+	}
+
+	if !newContract("2026-05-01T11:00:00Z").AcceptDeadlinePassed() {
+		t.Error("an unaccepted contract an hour past its accept deadline has lapsed")
+	}
+	if newContract("2026-05-01T13:00:00Z").AcceptDeadlinePassed() {
+		t.Error("a contract still inside its accept window has not lapsed")
+	}
+	if newContract("not-a-time").AcceptDeadlinePassed() {
+		t.Error("an unparseable accept deadline must never expire a contract")
+	}
+
+	accepted := newContract("2026-05-01T11:00:00Z")
+	if err := accepted.Accept(); err != nil {
+		t.Fatalf("Accept: %v", err)
+	}
+	if accepted.AcceptDeadlinePassed() {
+		t.Error("an accepted contract is past needing its accept deadline")
+	}
+	if err := accepted.ExpireUnaccepted(); err == nil {
+		t.Error("an accepted contract must not be expired as unaccepted")
+	}
+}
@@ -0,0 +1,46 @@
+package contract
+
+import (
+	"testing"
+
+	"github.com/andrescamacho/spacetraders-go/internal/domain/trading"
+)
+
+// A contract that clears at quoted prices is charged the source market's fee,
+// both in the instantaneous evaluation and in every simulated scenario.
+func TestEvaluateProfitability_ChargesSourceMarketFee(t *testing.T) {
+	t.Cleanup(func() { trading.SetMarketFees(nil) })
+	c := newSimulationTestContract(t, 40000, 300)
+	ctx := ProfitabilityContext{
+		MarketPrices:           map[string]int{"IRON_ORE": 100},
+		SourceMarkets:          map[string]string{"IRON_ORE": "X1-SIM-A1"},
+		CargoCapacity:          100,
+		CheapestMarketWaypoint: "X1-SIM-Z9",
+	}
+
+	free, err := c.EvaluateProfitability(ctx)
+	if err != nil {
+		t.Fatalf("EvaluateProfitability: %v", err)
+	}
+	if free.FeeCost != 0 || free.NetProfit != 10000 {
+		t.Fatalf("fee-free: want fee 0 net 10000, got fee %d net %d", free.FeeCost, free.NetProfit)
+	}
+
+	// The fee is read at the good's own source market, not the cheapest-market fallback.
+	trading.SetMarketFees(trading.NewMarketFees(map[string]float64{"X1-SIM-A1": 0.05, "X1-SIM-Z9": 0.20}))
+	charged, err := c.EvaluateProfitability(ctx)
+	if err != nil {
+		t.Fatalf("EvaluateProfitability: %v", err)
+	}
+	if charged.FeeCost != 1500 || charged.NetProfit != 8500 {
+		t.Fatalf("5%% of a 30000 purchase: want fee 1500 net 8500, got fee %d net %d", charged.FeeCost, charged.NetProfit)
+	}
+
+	sim, err := c.SimulateProfitability(ProfitabilitySimulationContext{ProfitabilityContext: ctx})
+	if err != nil {
+		t.Fatalf("SimulateProfitability: %v", err)
+	}
+	if sim.Pessimistic.FeeCost != 1500 || sim.Pessimistic.NetProfit != 8500 {
+		t.Fatalf("flat simulation must charge the same fee: got fee %d net %d", sim.Pessimistic.FeeCost, sim.Pessimistic.NetProfit)
+	}
+}
@@ -1,6 +1,10 @@
 package contract
 
-import "fmt"
+import (
+	"fmt"
+
+	"github.com/andrescamacho/spacetraders-go/internal/domain/trading"
+)
 
 // ProfitabilityContext contains market and ship data needed for profitability calculation
 type ProfitabilityContext struct {
@@ -12,6 +16,9 @@ type ProfitabilityContext struct {
 	FuelCostPerTrip int
 	// CheapestMarketWaypoint is the primary market waypoint for purchasing
 	CheapestMarketWaypoint string
+	// SourceMarkets maps trade_symbol to the market it is bought at, for the
+	// market fee estimate. A good missing here is charged at CheapestMarketWaypoint.
+	SourceMarkets map[string]string
 }
 
 // ProfitabilityEvaluation contains the results of profitability calculation
@@ -21,6 +28,7 @@ type ProfitabilityEvaluation struct {
 	TotalPayment           int
 	PurchaseCost           int
 	FuelCost               int
+	FeeCost                int
 	TripsRequired          int
 	CheapestMarketWaypoint string
 	Reason                 string
@@ -50,7 +58,8 @@ func NewContractProfitabilityService() *ContractProfitabilityService {
 //   - purchase_cost = sum(market_price * units_needed for each delivery)
 //   - trips_required = ceil(total_units / cargo_capacity)
 //   - fuel_cost = trips_required * fuel_cost_per_trip
-//   - net_profit = total_payment - (purchase_cost + fuel_cost)
+//   - fee_cost = estimated market fees on each delivery's purchase (trading.ActiveMarketFees)
+//   - net_profit = total_payment - (purchase_cost + fuel_cost + fee_cost)
 //   - is_profitable = net_profit >= MinProfitThreshold (-5000)
 //
 // Parameters:
@@ -66,14 +75,14 @@ func (s *ContractProfitabilityService) EvaluateProfitability(
 ) (*ProfitabilityEvaluation, error) {
 	totalPayment := s.calculateTotalPayment(contract)
 
-	purchaseCost, totalUnits, err := s.calculatePurchaseCost(contract, ctx)
+	purchaseCost, feeCost, totalUnits, err := s.calculatePurchaseCost(contract, ctx)
 	if err != nil {
 		return nil, err
 	}
 
 	tripsRequired := s.calculateTripsRequired(totalUnits, ctx.CargoCapacity)
 	fuelCost := s.calculateFuelCost(tripsRequired, ctx.FuelCostPerTrip)
-	netProfit := s.calculateNetProfit(totalPayment, purchaseCost, fuelCost)
+	netProfit := s.calculateNetProfit(totalPayment, purchaseCost, fuelCost+feeCost)
 	isProfitable := netProfit >= MinProfitThreshold
 	reason := s.generateProfitabilityReason(netProfit)
 
@@ -83,6 +92,7 @@ func (s *ContractProfitabilityService) EvaluateProfitability(
 		TotalPayment:           totalPayment,
 		PurchaseCost:           purchaseCost,
 		FuelCost:               fuelCost,
+		FeeCost:                feeCost,
 		TripsRequired:          tripsRequired,
 		CheapestMarketWaypoint: ctx.CheapestMarketWaypoint,
 		Reason:                 reason,
@@ -94,11 +104,13 @@ func (s *ContractProfitabilityService) calculateTotalPayment(contract *Contract)
 	return contract.terms.Payment.OnAccepted + contract.terms.Payment.OnFulfilled
 }
 
-// calculatePurchaseCost computes the purchase cost and total units needed
+// calculatePurchaseCost computes the purchase cost, the estimated market fees on
+// it, and the total units needed
 func (s *ContractProfitabilityService) calculatePurchaseCost(
 	contract *Contract,
 	ctx ProfitabilityContext,
-) (purchaseCost int, totalUnits int, err error) {
+) (purchaseCost int, feeCost int, totalUnits int, err error) {
+	fees := trading.ActiveMarketFees()
 	for _, delivery := range contract.terms.Deliveries {
 		unitsNeeded := delivery.UnitsRequired - delivery.UnitsFulfilled
 		if unitsNeeded == 0 {
@@ -108,14 +120,19 @@ func (s *ContractProfitabilityService) calculatePurchaseCost(
 		// Look up market price for this trade good
 		sellPrice, ok := ctx.MarketPrices[delivery.TradeSymbol]
 		if !ok {
-			return 0, 0, fmt.Errorf("missing market price for %s", delivery.TradeSymbol)
+			return 0, 0, 0, fmt.Errorf("missing market price for %s", delivery.TradeSymbol)
 		}
 
+		market, ok := ctx.SourceMarkets[delivery.TradeSymbol]
+		if !ok {
+			market = ctx.CheapestMarketWaypoint
+		}
 		purchaseCost += sellPrice * unitsNeeded
+		feeCost += fees.Fee(market, sellPrice*unitsNeeded)
 		totalUnits += unitsNeeded
 	}
 
-	return purchaseCost, totalUnits, nil
+	return purchaseCost, feeCost, totalUnits, nil
 }
 
 // calculateTripsRequired computes the number of trips needed (ceiling division)
@@ -131,9 +148,9 @@ func (s *ContractProfitabilityService) calculateFuelCost(tripsRequired, fuelCost
 	return tripsRequired * fuelCostPerTrip
 }
 
-// calculateNetProfit computes the net profit
-func (s *ContractProfitabilityService) calculateNetProfit(totalPayment, purchaseCost, fuelCost int) int {
-	return totalPayment - (purchaseCost + fuelCost)
+// calculateNetProfit computes the net profit; overheadCost is fuel plus fees
+func (s *ContractProfitabilityService) calculateNetProfit(totalPayment, purchaseCost, overheadCost int) int {
+	return totalPayment - (purchaseCost + overheadCost)
 }
 
 // generateProfitabilityReason creates a human-readable reason for the profitability decision
@@ -0,0 +1,188 @@
+package contract
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/andrescamacho/spacetraders-go/internal/domain/trading"
+)
+
+// PriceDrift bounds how far a good's ask is expected to move between
+// consecutive purchase trips, as a fraction of the previous trip's ask. Our own
+// buying drains supply, so the pessimistic bound is normally a rise; the
+// optimistic bound is the best case the history supports (zero or a decline).
+type PriceDrift struct {
+	Optimistic  float64
+	Pessimistic float64
+}
+
+// ProfitabilitySimulationContext extends the instantaneous ProfitabilityContext
+// with per-good drift bounds. A good with no entry in Drift is simulated at a
+// flat price, which reduces that good's cost to the instantaneous estimate.
+type ProfitabilitySimulationContext struct {
+	ProfitabilityContext
+	Drift map[string]PriceDrift
+}
+
+// ProfitabilitySimulation holds the instantaneous evaluation alongside the
+// optimistic and pessimistic evaluations simulated over the delivery window.
+type ProfitabilitySimulation struct {
+	Instantaneous *ProfitabilityEvaluation
+	Optimistic    *ProfitabilityEvaluation
+	Pessimistic   *ProfitabilityEvaluation
+}
+
+// ProfitableOnlyAtInstantaneousPrices reports whether the contract clears
+// MinProfitThreshold at today's asks but not once the pessimistic drift is
+// priced in — the contracts that only look profitable before we start buying.
+func (s *ProfitabilitySimulation) ProfitableOnlyAtInstantaneousPrices() bool {
+	return s.Instantaneous.IsProfitable && !s.Pessimistic.IsProfitable
+}
+
+// EstimatePriceDrift derives per-trip drift bounds from an ask series ordered
+// oldest first. The pessimistic bound is the mean of the rising steps and the
+// optimistic bound the mean of the falling steps, so a series that only ever
+// rose yields a zero optimistic bound rather than an invented decline. Fewer
+// than two usable samples yield a flat (zero) drift.
+func EstimatePriceDrift(asks []int) PriceDrift {
+	var riseSum, fallSum float64
+	var rises, falls int
+	for i := 1; i < len(asks); i++ {
+		prev := asks[i-1]
+		if prev <= 0 {
+			continue
+		}
+		step := float64(asks[i]-prev) / float64(prev)
+		switch {
+		case step > 0:
+			riseSum += step
+			rises++
+		case step < 0:
+			fallSum += step
+			falls++
+		}
+	}
+
+	var drift PriceDrift
+	if rises > 0 {
+		drift.Pessimistic = riseSum / float64(rises)
+	}
+	if falls > 0 {
+		drift.Optimistic = fallSum / float64(falls)
+	}
+	return drift
+}
+
+// SimulateProfitability evaluates the contract three ways: at instantaneous
+// prices, and with each good's ask compounding by its optimistic and
+// pessimistic drift on every trip after the first.
+//
+// Business Rules:
+//   - trip k of a good (0-based) buys min(cargo_capacity, remaining) units
+//     at round(ask * (1 + drift)^k), floored at zero
+//   - trips, fuel cost and the market fee rate are identical to EvaluateProfitability
+//   - is_profitable uses the same MinProfitThreshold for every scenario
+func (s *ContractProfitabilityService) SimulateProfitability(
+	contract *Contract,
+	ctx ProfitabilitySimulationContext,
+) (*ProfitabilitySimulation, error) {
+	instantaneous, err := s.EvaluateProfitability(contract, ctx.ProfitabilityContext)
+	if err != nil {
+		return nil, err
+	}
+
+	optimistic, err := s.evaluateWithDrift(contract, ctx, func(d PriceDrift) float64 { return d.Optimistic })
+	if err != nil {
+		return nil, err
+	}
+
+	pessimistic, err := s.evaluateWithDrift(contract, ctx, func(d PriceDrift) float64 { return d.Pessimistic })
+	if err != nil {
+		return nil, err
+	}
+
+	return &ProfitabilitySimulation{
+		Instantaneous: instantaneous,
+		Optimistic:    optimistic,
+		Pessimistic:   pessimistic,
+	}, nil
+}
+
+// evaluateWithDrift prices every delivery trip-by-trip using the drift bound
+// selected by pick, then applies the instantaneous payment/fuel rules.
+func (s *ContractProfitabilityService) evaluateWithDrift(
+	contract *Contract,
+	ctx ProfitabilitySimulationContext,
+	pick func(PriceDrift) float64,
+) (*ProfitabilityEvaluation, error) {
+	var purchaseCost, feeCost, totalUnits int
+	fees := trading.ActiveMarketFees()
+	for _, delivery := range contract.terms.Deliveries {
+		unitsNeeded := delivery.UnitsRequired - delivery.UnitsFulfilled
+		if unitsNeeded <= 0 {
+			continue
+		}
+
+		ask, ok := ctx.MarketPrices[delivery.TradeSymbol]
+		if !ok {
+			return nil, fmt.Errorf("missing market price for %s", delivery.TradeSymbol)
+		}
+
+		cost := simulateDeliveryCost(ask, unitsNeeded, ctx.CargoCapacity, pick(ctx.Drift[delivery.TradeSymbol]))
+		market, ok := ctx.SourceMarkets[delivery.TradeSymbol]
+		if !ok {
+			market = ctx.CheapestMarketWaypoint
+		}
+		purchaseCost += cost
+		feeCost += fees.Fee(market, cost)
+		totalUnits += unitsNeeded
+	}
+
+	totalPayment := s.calculateTotalPayment(contract)
+	tripsRequired := s.calculateTripsRequired(totalUnits, ctx.CargoCapacity)
+	fuelCost := s.calculateFuelCost(tripsRequired, ctx.FuelCostPerTrip)
+	netProfit := s.calculateNetProfit(totalPayment, purchaseCost, fuelCost+feeCost)
+
+	return &ProfitabilityEvaluation{
+		IsProfitable:           netProfit >= MinProfitThreshold,
+		NetProfit:              netProfit,
+		TotalPayment:           totalPayment,
+		PurchaseCost:           purchaseCost,
+		FuelCost:               fuelCost,
+		FeeCost:                feeCost,
+		TripsRequired:          tripsRequired,
+		CheapestMarketWaypoint: ctx.CheapestMarketWaypoint,
+		Reason:                 s.generateProfitabilityReason(netProfit),
+	}, nil
+}
+
+// simulateDeliveryCost sums one good's purchase cost across its trips. A
+// non-positive cargo capacity buys everything in a single trip at the
+// starting ask, matching calculateTripsRequired's degenerate case.
+func simulateDeliveryCost(ask, units, cargoCapacity int, drift float64) int {
+	if cargoCapacity <= 0 {
+		return ask * units
+	}
+
+	cost := 0
+	remaining := units
+	for trip := 0; remaining > 0; trip++ {
+		batch := cargoCapacity
+		if remaining < batch {
+			batch = remaining
+		}
+		price := int(math.Round(float64(ask) * math.Pow(1+drift, float64(trip))))
+		if price < 0 {
+			price = 0
+		}
+		cost += price * batch
+		remaining -= batch
+	}
+	return cost
+}
+
+// SimulateProfitability delegates the drift simulation to ContractProfitabilityService.
+func (c *Contract) SimulateProfitability(ctx ProfitabilitySimulationContext) (*ProfitabilitySimulation, error) {
+	service := NewContractProfitabilityService()
+	return service.SimulateProfitability(c, ctx)
+}
@@ -0,0 +1,109 @@
+package contract
+
+import (
+	"math"
+	"testing"
+
+	"github.com/andrescamacho/spacetraders-go/internal/domain/shared"
+)
+
+func newSimulationTestContract(t *testing.T, payment int, units int) *Contract {
+	t.Helper()
+	c, err := NewContract("CON-SIM", shared.MustNewPlayerID(1), "COSMIC", "PROCUREMENT", Terms{
+		Payment: Payment{OnAccepted: 0, OnFulfilled: payment},
+		Deliveries: []Delivery{
+			{TradeSymbol: "IRON_ORE", DestinationSymbol: "X1-SIM-B1", UnitsRequired: units},
+		},
+	}, nil)
+	if err != nil {
+		t.Fatalf("NewContract: %v", err)
+	}
+	return c
+}
+
+// A flat series has no drift in either direction; a rising-only series never
+// invents a decline for the optimistic bound.
+func TestEstimatePriceDrift_BoundsFromRisingAndFallingSteps(t *testing.T) {
+	if d := EstimatePriceDrift([]int{100, 100, 100}); d.Optimistic != 0 || d.Pessimistic != 0 {
+		t.Fatalf("flat series should have zero drift, got %+v", d)
+	}
+
+	rising := EstimatePriceDrift([]int{100, 110, 121})
+	if math.Abs(rising.Pessimistic-0.10) > 1e-9 || rising.Optimistic != 0 {
+		t.Fatalf("rising series: want pessimistic 0.10 and optimistic 0, got %+v", rising)
+	}
+
+	mixed := EstimatePriceDrift([]int{100, 120, 90})
+	if math.Abs(mixed.Pessimistic-0.20) > 1e-9 || math.Abs(mixed.Optimistic+0.25) > 1e-9 {
+		t.Fatalf("mixed series: want +0.20/-0.25, got %+v", mixed)
+	}
+
+	if d := EstimatePriceDrift([]int{100}); d != (PriceDrift{}) {
+		t.Fatalf("a single sample should be flat, got %+v", d)
+	}
+}
+
+// The motivating case: at today's ask the contract clears the threshold, but
+// compounding a 50% per-trip rise over three trips pushes it past the loss
+// floor, so the simulation flags it as instantaneously profitable only.
+func TestSimulateProfitability_FlagsContractProfitableOnlyAtInstantaneousPrices(t *testing.T) {
+	c := newSimulationTestContract(t, 40000, 300)
+
+	sim, err := c.SimulateProfitability(ProfitabilitySimulationContext{
+		ProfitabilityContext: ProfitabilityContext{
+			MarketPrices:  map[string]int{"IRON_ORE": 100},
+			CargoCapacity: 100,
+		},
+		Drift: map[string]PriceDrift{"IRON_ORE": {Optimistic: 0, Pessimistic: 0.5}},
+	})
+	if err != nil {
+		t.Fatalf("SimulateProfitability: %v", err)
+	}
+
+	if sim.Instantaneous.NetProfit != 10000 {
+		t.Fatalf("instantaneous net: want 10000, got %d", sim.Instantaneous.NetProfit)
+	}
+	if sim.Optimistic.NetProfit != 10000 {
+		t.Fatalf("optimistic net with zero drift should equal instantaneous, got %d", sim.Optimistic.NetProfit)
+	}
+	// 100*100 + 150*100 + 225*100 = 47500 purchase cost.
+	if sim.Pessimistic.PurchaseCost != 47500 || sim.Pessimistic.NetProfit != -7500 {
+		t.Fatalf("pessimistic: want cost 47500 net -7500, got cost %d net %d", sim.Pessimistic.PurchaseCost, sim.Pessimistic.NetProfit)
+	}
+	if !sim.ProfitableOnlyAtInstantaneousPrices() {
+		t.Fatalf("expected the contract to be flagged as profitable only at instantaneous prices")
+	}
+}
+
+// A good without a drift entry is simulated flat, so every scenario collapses
+// to the instantaneous evaluation.
+func TestSimulateProfitability_MissingDriftIsFlat(t *testing.T) {
+	c := newSimulationTestContract(t, 40000, 250)
+
+	sim, err := c.SimulateProfitability(ProfitabilitySimulationContext{
+		ProfitabilityContext: ProfitabilityContext{
+			MarketPrices:  map[string]int{"IRON_ORE": 100},
+			CargoCapacity: 100,
+		},
+	})
+	if err != nil {
+		t.Fatalf("SimulateProfitability: %v", err)
+	}
+
+	if sim.Optimistic.NetProfit != sim.Instantaneous.NetProfit || sim.Pessimistic.NetProfit != sim.Instantaneous.NetProfit {
+		t.Fatalf("expected flat scenarios, got %d/%d/%d", sim.Optimistic.NetProfit, sim.Instantaneous.NetProfit, sim.Pessimistic.NetProfit)
+	}
+	if sim.ProfitableOnlyAtInstantaneousPrices() {
+		t.Fatalf("a flat simulation cannot be instantaneous-only profitable")
+	}
+}
+
+func TestSimulateProfitability_MissingPriceErrors(t *testing.T) {
+	c := newSimulationTestContract(t, 40000, 100)
+
+	if _, err := c.SimulateProfitability(ProfitabilitySimulationContext{
+		ProfitabilityContext: ProfitabilityContext{MarketPrices: map[string]int{}, CargoCapacity: 100},
+	}); err == nil {
+		t.Fatalf("expected an error for a missing market price")
+	}
+}
@@ -0,0 +1,116 @@
+package contract
+
+import "fmt"
+
+// DeliverySagaStep is where one sourced contract load stands in the
+// purchase → navigate → deliver saga.
+type DeliverySagaStep string
+
+const (
+	// DeliverySagaSourced: the load is bought and aboard, not yet delivered
+	DeliverySagaSourced DeliverySagaStep = "SOURCED"
+	// DeliverySagaDelivered: the load was registered against the contract
+	DeliverySagaDelivered DeliverySagaStep = "DELIVERED"
+	// DeliverySagaCompensating: delivery failed permanently and the load is
+	// being sold off to recover its cost
+	DeliverySagaCompensating DeliverySagaStep = "COMPENSATING"
+	// DeliverySagaCompensated: the compensating sale ran (possibly partially)
+	DeliverySagaCompensated DeliverySagaStep = "COMPENSATED"
+	// DeliverySagaStranded: compensation could not sell the load; it stays
+	// aboard for the coordinator's liquidation sweep
+	DeliverySagaStranded DeliverySagaStep = "STRANDED"
+)
+
+// DeliverySaga tracks one contract load from purchase to delivery, and the
+// compensating action when delivery can never happen. Buying a contract good
+// is the one step that cannot be undone by simply not proceeding: once credits
+// are spent and the hold is full, a permanently failed delivery (the contract
+// expired, was fulfilled elsewhere, or its terms no longer match) leaves cargo
+// stranded aboard. The compensation is to sell the load at the best market, so
+// the purchase is recovered as far as the market allows and the hull is free
+// for its next job.
+type DeliverySaga struct {
+	contractID  string
+	shipSymbol  string
+	tradeSymbol string
+	unitsAboard int
+
+	step             DeliverySagaStep
+	failure          string
+	unitsCompensated int
+	revenue          int
+}
+
+// NewDeliverySaga opens a saga for a load of units of tradeSymbol aboard
+// shipSymbol, bought for contractID.
+func NewDeliverySaga(contractID, shipSymbol, tradeSymbol string, unitsAboard int) *DeliverySaga {
+	return &DeliverySaga{
+		contractID:  contractID,
+		shipSymbol:  shipSymbol,
+		tradeSymbol: tradeSymbol,
+		unitsAboard: unitsAboard,
+		step:        DeliverySagaSourced,
+	}
+}
+
+func (s *DeliverySaga) ContractID() string       { return s.contractID }
+func (s *DeliverySaga) ShipSymbol() string       { return s.shipSymbol }
+func (s *DeliverySaga) TradeSymbol() string      { return s.tradeSymbol }
+func (s *DeliverySaga) UnitsAboard() int         { return s.unitsAboard }
+func (s *DeliverySaga) Step() DeliverySagaStep   { return s.step }
+func (s *DeliverySaga) Failure() string          { return s.failure }
+func (s *DeliverySaga) UnitsCompensated() int    { return s.unitsCompensated }
+func (s *DeliverySaga) CompensationRevenue() int { return s.revenue }
+
+// Delivered closes the saga on the happy path.
+func (s *DeliverySaga) Delivered() error {
+	if s.step != DeliverySagaSourced {
+		return fmt.Errorf("delivery saga for %s on %s: cannot deliver from %s", s.tradeSymbol, s.contractID, s.step)
+	}
+	s.step = DeliverySagaDelivered
+	return nil
+}
+
+// FailDelivery records a permanent delivery failure and starts compensation.
+func (s *DeliverySaga) FailDelivery(reason string) error {
+	if s.step != DeliverySagaSourced {
+		return fmt.Errorf("delivery saga for %s on %s: cannot fail delivery from %s", s.tradeSymbol, s.contractID, s.step)
+	}
+	s.step = DeliverySagaCompensating
+	s.failure = reason
+	return nil
+}
+
+// Compensated records the compensating sale. Selling fewer units than were
+// aboard still closes the saga; the remainder is left to liquidation.
+func (s *DeliverySaga) Compensated(unitsSold, revenue int) error {
+	if s.step != DeliverySagaCompensating {
+		return fmt.Errorf("delivery saga for %s on %s: cannot compensate from %s", s.tradeSymbol, s.contractID, s.step)
+	}
+	s.step = DeliverySagaCompensated
+	s.unitsCompensated = unitsSold
+	s.revenue = revenue
+	return nil
+}
+
+// Strand records that compensation could not run; the load stays aboard.
+func (s *DeliverySaga) Strand(reason string) error {
+	if s.step != DeliverySagaCompensating {
+		return fmt.Errorf("delivery saga for %s on %s: cannot strand from %s", s.tradeSymbol, s.contractID, s.step)
+	}
+	s.step = DeliverySagaStranded
+	s.failure = fmt.Sprintf("%s; compensation failed: %s", s.failure, reason)
+	return nil
+}
+
+// UnitsStranded is how much of the load is still aboard after the saga
+func (s *DeliverySaga) UnitsStranded() int {
+	switch s.step {
+	case DeliverySagaDelivered:
+		return 0
+	case DeliverySagaCompensated:
+		return max(s.unitsAboard-s.unitsCompensated, 0)
+	default:
+		return s.unitsAboard
+	}
+}
@@ -0,0 +1,41 @@
+package contract
+
+import "testing"
+
+func TestDeliverySaga_CompensationPath(t *testing.T) {
+	saga := NewDeliverySaga("C1", "SHIP-1", "IRON_ORE", 40)
+	if err := saga.FailDelivery("contract expired"); err != nil {
+		t.Fatalf("fail delivery: %v", err)
+	}
+	if saga.Step() != DeliverySagaCompensating {
+		t.Fatalf("expected COMPENSATING, got %s", saga.Step())
+	}
+	if err := saga.Delivered(); err == nil {
+		t.Fatal("a failed delivery cannot then be delivered")
+	}
+	if err := saga.Compensated(30, 2400); err != nil {
+		t.Fatalf("compensate: %v", err)
+	}
+	if saga.UnitsStranded() != 10 || saga.CompensationRevenue() != 2400 {
+		t.Fatalf("expected 10 units left aboard and 2400 recovered, got %d / %d", saga.UnitsStranded(), saga.CompensationRevenue())
+	}
+}
+
+func TestDeliverySaga_StrandAndHappyPath(t *testing.T) {
+	stranded := NewDeliverySaga("C1", "SHIP-1", "IRON_ORE", 40)
+	if err := stranded.Strand("no market"); err == nil {
+		t.Fatal("compensation cannot strand before delivery has failed")
+	}
+	_ = stranded.FailDelivery("contract expired")
+	if err := stranded.Strand("no market"); err != nil {
+		t.Fatalf("strand: %v", err)
+	}
+	if stranded.UnitsStranded() != 40 || stranded.Failure() != "contract expired; compensation failed: no market" {
+		t.Fatalf("unexpected stranded saga: %d aboard, %q", stranded.UnitsStranded(), stranded.Failure())
+	}
+
+	delivered := NewDeliverySaga("C1", "SHIP-1", "IRON_ORE", 40)
+	if err := delivered.Delivered(); err != nil || delivered.UnitsStranded() != 0 {
+		t.Fatalf("expected a clean delivery, got %v with %d aboard", err, delivered.UnitsStranded())
+	}
+}
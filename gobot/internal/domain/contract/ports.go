@@ -8,3 +8,13 @@ type ContractRepository interface {
 	FindActiveContracts(ctx context.Context, playerID int) ([]*Contract, error)
 	Add(ctx context.Context, contract *Contract) error
 }
+
+// WorkflowAuditRepository persists contract workflow step records.
+type WorkflowAuditRepository interface {
+	// RecordStep appends one step record.
+	RecordStep(ctx context.Context, record WorkflowStepRecord) error
+
+	// FindByContract returns contractID's steps for playerID in the order they
+	// were recorded.
+	FindByContract(ctx context.Context, playerID int, contractID string) ([]WorkflowStepRecord, error)
+}
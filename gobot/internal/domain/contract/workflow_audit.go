@@ -0,0 +1,45 @@
+package contract
+
+import "time"
+
+// WorkflowStep names one step of the contract workflow.
+type WorkflowStep string
+
+const (
+	WorkflowStepNegotiate WorkflowStep = "NEGOTIATE"
+	WorkflowStepAccept    WorkflowStep = "ACCEPT"
+	WorkflowStepPurchase  WorkflowStep = "PURCHASE"
+	WorkflowStepNavigate  WorkflowStep = "NAVIGATE"
+	WorkflowStepDeliver   WorkflowStep = "DELIVER"
+	WorkflowStepFulfill   WorkflowStep = "FULFILL"
+)
+
+// WorkflowStepOutcome is how a workflow step ended.
+type WorkflowStepOutcome string
+
+const (
+	WorkflowStepSucceeded WorkflowStepOutcome = "SUCCEEDED"
+	WorkflowStepFailed    WorkflowStepOutcome = "FAILED"
+	// WorkflowStepSkipped marks a step the workflow had no need to take, e.g.
+	// accepting a contract that was already accepted or fulfilling one whose
+	// deliveries are incomplete.
+	WorkflowStepSkipped WorkflowStepOutcome = "SKIPPED"
+)
+
+// WorkflowStepRecord is one step of a contract workflow run, kept so a failed
+// contract can be reconstructed step by step without reading daemon logs.
+type WorkflowStepRecord struct {
+	PlayerID    int
+	ContractID  string // "" when negotiation failed before a contract existed
+	ContainerID string
+	ShipSymbol  string
+	Step        WorkflowStep
+	Outcome     WorkflowStepOutcome
+	Waypoint    string // market, destination or delivery waypoint, when the step has one
+	TradeSymbol string
+	Units       int
+	Credits     int    // credits the step moved: negative when spent, positive when earned
+	Detail      string // error or reason for a failed or skipped step
+	StartedAt   time.Time
+	FinishedAt  time.Time
+}
@@ -2,6 +2,9 @@ package daemon
 
 import (
 	"context"
+	"fmt"
+	"sort"
+	"sync"
 	"time"
 
 	"github.com/andrescamacho/spacetraders-go/internal/domain/container"
@@ -12,13 +15,55 @@ import (
 const (
 	defaultMaxRecoveryAttempts = 5
 	minAvgIterationSeconds     = 5.0
+
+	// maxRecordedRuntimeTerminations bounds the runtime-termination history
+	// kept in memory; the counter in RecoveryMetrics keeps the full total.
+	maxRecordedRuntimeTerminations = 100
+
+	// defaultStrandedFuelThreshold is the fuel level at or below which an idle
+	// ship parked away from fuel counts as stranded: one unit is DRIFT's
+	// minimum cost, so such a ship can no longer leave in any other mode.
+	defaultStrandedFuelThreshold = 1
+
+	// stuckTransitGrace is how far past its arrival time a ship may still be
+	// IN_TRANSIT before it counts as stuck; arrival handling normally lands it
+	// within seconds.
+	stuckTransitGrace = 2 * time.Minute
+
+	// waypointFailureWindow is how close together a waypoint's failures must
+	// fall to count towards blacklisting it.
+	waypointFailureWindow = 30 * time.Minute
 )
 
+// StrandedShipRescuer moves a stranded ship to fuel. The application layer
+// implements it by drifting the ship to the nearest fuel market, refuelling
+// and restoring its original flight mode.
+type StrandedShipRescuer interface {
+	RescueStrandedShip(ctx context.Context, ship *navigation.Ship) error
+}
+
+// WaypointBlacklister blacklists a waypoint for a player for ttl (0: until
+// cleared). The application layer implements it over the waypoint blacklist
+// that route planning, market selection and scouting consult.
+type WaypointBlacklister interface {
+	BlacklistWaypoint(ctx context.Context, playerID int, waypoint, reason string, ttl time.Duration) error
+}
+
 // RecoveryMetrics tracks health monitor recovery statistics
 type RecoveryMetrics struct {
 	SuccessfulRecoveries int
 	FailedRecoveries     int
 	AbandonedShips       int
+	RuntimeTerminations  int
+}
+
+// RuntimeTermination records a container the daemon stopped because it ran
+// past its max_runtime budget.
+type RuntimeTermination struct {
+	ContainerID   string
+	ContainerType string
+	Runtime       time.Duration
+	TerminatedAt  time.Time
 }
 
 // HealthMonitor monitors container and ship health, detecting stuck operations
@@ -30,8 +75,33 @@ type HealthMonitor struct {
 	lastCheckTime       *time.Time
 	watchList           map[string]time.Time // ship symbol -> added time
 	recoveryAttempts    map[string]int       // ship symbol -> attempt count
-	metrics             *RecoveryMetrics
 	clock               shared.Clock
+
+	strandedFuelThreshold int
+	rescuer               StrandedShipRescuer
+
+	// eta supplies the arrival estimates stuck detection compares against.
+	eta *navigation.ETAService
+
+	// Runtime terminations are reported from container runner goroutines and
+	// metrics are read from RPC goroutines, so unlike the check-cycle state
+	// above both are guarded by a mutex.
+	metricsMu    sync.Mutex
+	metrics      *RecoveryMetrics
+	terminations []RuntimeTermination
+
+	// Waypoint failures are reported from route executors on container
+	// goroutines too, under their own mutex.
+	waypointFailuresMu       sync.Mutex
+	waypointFailures         map[waypointFailureKey][]time.Time
+	blacklister              WaypointBlacklister
+	waypointFailureThreshold int
+	waypointBlacklistTTL     time.Duration
+}
+
+type waypointFailureKey struct {
+	playerID int
+	waypoint string
 }
 
 func NewHealthMonitor(
@@ -54,7 +124,10 @@ func NewHealthMonitor(
 			FailedRecoveries:     0,
 			AbandonedShips:       0,
 		},
-		clock: clock,
+		clock:                 clock,
+		strandedFuelThreshold: defaultStrandedFuelThreshold,
+		eta:                   navigation.NewETAService(nil),
+		waypointFailures:      make(map[waypointFailureKey][]time.Time),
 	}
 }
 
@@ -74,12 +147,52 @@ func (hm *HealthMonitor) SetMaxRecoveryAttempts(attempts int) {
 	hm.maxRecoveryAttempts = attempts
 }
 
+// SetFuelCalibration makes stuck detection's arrival estimates use the fuel
+// costs calibration has fitted from observed navigations.
+func (hm *HealthMonitor) SetFuelCalibration(calibration navigation.FuelCalibrationSource) {
+	hm.eta = navigation.NewETAService(navigation.NewShipFuelService(calibration))
+}
+
+// SetStrandedShipRescuer wires the rescue workflow for stranded ships. Without
+// one, stranded ships are detected but left where they are.
+func (hm *HealthMonitor) SetStrandedShipRescuer(rescuer StrandedShipRescuer) {
+	hm.rescuer = rescuer
+}
+
+// SetStrandedFuelThreshold configures the fuel level at or below which a ship
+// away from fuel counts as stranded.
+func (hm *HealthMonitor) SetStrandedFuelThreshold(units int) {
+	hm.strandedFuelThreshold = units
+}
+
+// SetWaypointBlacklister arms waypoint blacklisting: a waypoint that fails the
+// same player threshold times within waypointFailureWindow is blacklisted for
+// ttl. Without one, waypoint failures are ignored.
+func (hm *HealthMonitor) SetWaypointBlacklister(blacklister WaypointBlacklister, threshold int, ttl time.Duration) {
+	hm.waypointFailuresMu.Lock()
+	defer hm.waypointFailuresMu.Unlock()
+	hm.blacklister = blacklister
+	hm.waypointFailureThreshold = threshold
+	hm.waypointBlacklistTTL = ttl
+}
+
 func (hm *HealthMonitor) GetRecoveryAttemptCount(shipSymbol string) int {
 	return hm.recoveryAttempts[shipSymbol]
 }
 
+// GetMetrics returns a snapshot of the recovery metrics.
 func (hm *HealthMonitor) GetMetrics() *RecoveryMetrics {
-	return hm.metrics
+	hm.metricsMu.Lock()
+	defer hm.metricsMu.Unlock()
+	snapshot := *hm.metrics
+	return &snapshot
+}
+
+// countMetric increments one of the recovery metrics.
+func (hm *HealthMonitor) countMetric(field *int) {
+	hm.metricsMu.Lock()
+	defer hm.metricsMu.Unlock()
+	*field++
 }
 
 // RunCheck runs a health check cycle, returning true if skipped due to
@@ -116,6 +229,8 @@ func (hm *HealthMonitor) RunCheck(
 
 	_ = hm.DetectInfiniteLoops(ctx, containers)
 
+	_ = hm.RescueStrandedShips(ctx, ships)
+
 	return false, nil // Executed
 }
 
@@ -175,11 +290,12 @@ func (hm *HealthMonitor) DetectStuckShips(
 	return stuckShips
 }
 
-// isShipStuck checks if a ship has been stuck in transit too long
-// This is a placeholder - real implementation would check actual timestamps
+// isShipStuck reports whether a ship in transit is more than
+// stuckTransitGrace past its arrival time. A ship whose arrival time is
+// unknown is never judged stuck.
 func (hm *HealthMonitor) isShipStuck(ship *navigation.Ship, now time.Time) bool {
-	// TODO: Implement proper timestamp tracking in Ship entity
-	return false
+	remaining, ok := hm.eta.TransitRemaining(ship, now)
+	return ok && remaining < -stuckTransitGrace
 }
 
 // DetectInfiniteLoops identifies containers with suspicious rapid iteration patterns
@@ -231,7 +347,7 @@ func (hm *HealthMonitor) AttemptRecovery(
 ) error {
 	attempts := hm.recoveryAttempts[shipSymbol]
 	if attempts >= hm.maxRecoveryAttempts {
-		hm.metrics.AbandonedShips++
+		hm.countMetric(&hm.metrics.AbandonedShips)
 		return nil
 	}
 
@@ -247,21 +363,80 @@ func (hm *HealthMonitor) AttemptRecovery(
 	// For now, stub implementation
 	if ship.NavStatus() == navigation.NavStatusInTransit {
 		// Simulate successful recovery
-		hm.metrics.SuccessfulRecoveries++
+		hm.countMetric(&hm.metrics.SuccessfulRecoveries)
 	}
 
 	return nil
 }
 
+// DetectStrandedShips identifies idle ships sitting at a waypoint without fuel
+// whose tank is at or below the stranded threshold. Ships owned by a container
+// or reserved by the captain are left to their owner. Result is sorted.
+func (hm *HealthMonitor) DetectStrandedShips(ships map[string]*navigation.Ship) []string {
+	stranded := []string{}
+
+	for shipSymbol, ship := range ships {
+		if ship.Fuel().Capacity == 0 || ship.IsInTransit() {
+			continue
+		}
+		if ship.IsAssigned() || ship.IsReservedByCaptain() {
+			continue
+		}
+		if location := ship.CurrentLocation(); location == nil || location.HasFuel {
+			continue
+		}
+		if ship.Fuel().Current <= hm.strandedFuelThreshold {
+			stranded = append(stranded, shipSymbol)
+		}
+	}
+
+	sort.Strings(stranded)
+	return stranded
+}
+
+// RescueStrandedShips runs the rescue workflow for every stranded ship and
+// returns the ones that were brought to fuel. Each ship gets
+// maxRecoveryAttempts tries before it is counted as abandoned.
+func (hm *HealthMonitor) RescueStrandedShips(ctx context.Context, ships map[string]*navigation.Ship) []string {
+	rescued := []string{}
+	if hm.rescuer == nil {
+		return rescued
+	}
+
+	for _, shipSymbol := range hm.DetectStrandedShips(ships) {
+		attempts := hm.recoveryAttempts[shipSymbol]
+		if attempts > hm.maxRecoveryAttempts {
+			continue // already abandoned
+		}
+		if attempts == hm.maxRecoveryAttempts {
+			hm.recoveryAttempts[shipSymbol] = attempts + 1
+			hm.countMetric(&hm.metrics.AbandonedShips)
+			continue
+		}
+
+		hm.recoveryAttempts[shipSymbol] = attempts + 1
+		if err := hm.rescuer.RescueStrandedShip(ctx, ships[shipSymbol]); err != nil {
+			hm.countMetric(&hm.metrics.FailedRecoveries)
+			continue
+		}
+
+		hm.countMetric(&hm.metrics.SuccessfulRecoveries)
+		delete(hm.recoveryAttempts, shipSymbol)
+		rescued = append(rescued, shipSymbol)
+	}
+
+	return rescued
+}
+
 // RecordRecoveryAttempt records a recovery attempt result (for testing)
 func (hm *HealthMonitor) RecordRecoveryAttempt(shipSymbol string, success bool) {
 	attempts := hm.recoveryAttempts[shipSymbol]
 	hm.recoveryAttempts[shipSymbol] = attempts + 1
 
 	if success {
-		hm.metrics.SuccessfulRecoveries++
+		hm.countMetric(&hm.metrics.SuccessfulRecoveries)
 	} else {
-		hm.metrics.FailedRecoveries++
+		hm.countMetric(&hm.metrics.FailedRecoveries)
 	}
 }
 
@@ -274,3 +449,67 @@ func (hm *HealthMonitor) RemoveFromWatchList(shipSymbol string) {
 	delete(hm.watchList, shipSymbol)
 	delete(hm.recoveryAttempts, shipSymbol)
 }
+
+// RecordRuntimeTermination records that a container was auto-terminated for
+// exceeding its max_runtime. Safe for concurrent use.
+func (hm *HealthMonitor) RecordRuntimeTermination(containerID, containerType string, runtime time.Duration) {
+	hm.metricsMu.Lock()
+	defer hm.metricsMu.Unlock()
+
+	hm.metrics.RuntimeTerminations++
+	hm.terminations = append(hm.terminations, RuntimeTermination{
+		ContainerID:   containerID,
+		ContainerType: containerType,
+		Runtime:       runtime,
+		TerminatedAt:  hm.clock.Now(),
+	})
+	if excess := len(hm.terminations) - maxRecordedRuntimeTerminations; excess > 0 {
+		hm.terminations = hm.terminations[excess:]
+	}
+}
+
+// RuntimeTerminations returns the recent runtime terminations, oldest first.
+func (hm *HealthMonitor) RuntimeTerminations() []RuntimeTermination {
+	hm.metricsMu.Lock()
+	defer hm.metricsMu.Unlock()
+
+	out := make([]RuntimeTermination, len(hm.terminations))
+	copy(out, hm.terminations)
+	return out
+}
+
+// RecordWaypointFailure counts a failure a ship hit at waypoint and, once the
+// waypoint has failed the player threshold times within the window,
+// blacklists it and starts its count over. Reports whether this failure got
+// the waypoint blacklisted. Safe for concurrent use.
+func (hm *HealthMonitor) RecordWaypointFailure(ctx context.Context, playerID int, waypoint, reason string) (bool, error) {
+	hm.waypointFailuresMu.Lock()
+	blacklister, threshold, ttl := hm.blacklister, hm.waypointFailureThreshold, hm.waypointBlacklistTTL
+	if blacklister == nil || threshold <= 0 {
+		hm.waypointFailuresMu.Unlock()
+		return false, nil
+	}
+
+	now := hm.clock.Now()
+	key := waypointFailureKey{playerID: playerID, waypoint: waypoint}
+	recent := hm.waypointFailures[key][:0]
+	for _, at := range hm.waypointFailures[key] {
+		if now.Sub(at) < waypointFailureWindow {
+			recent = append(recent, at)
+		}
+	}
+	recent = append(recent, now)
+	if len(recent) < threshold {
+		hm.waypointFailures[key] = recent
+		hm.waypointFailuresMu.Unlock()
+		return false, nil
+	}
+	delete(hm.waypointFailures, key)
+	hm.waypointFailuresMu.Unlock()
+
+	reason = fmt.Sprintf("%d failures within %s, last: %s", threshold, waypointFailureWindow, reason)
+	if err := blacklister.BlacklistWaypoint(ctx, playerID, waypoint, reason, ttl); err != nil {
+		return false, err
+	}
+	return true, nil
+}
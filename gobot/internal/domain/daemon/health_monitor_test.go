@@ -0,0 +1,178 @@
+package daemon
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/andrescamacho/spacetraders-go/internal/domain/navigation"
+	"github.com/andrescamacho/spacetraders-go/internal/domain/shared"
+)
+
+func TestRecordRuntimeTermination_CountsAllAndKeepsRecentHistory(t *testing.T) {
+	clock := &shared.MockClock{CurrentTime: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)}
+	hm := NewHealthMonitor(time.Minute, time.Minute, clock)
+
+	for i := 0; i < maxRecordedRuntimeTerminations+5; i++ {
+		hm.RecordRuntimeTermination(fmt.Sprintf("c-%d", i), "coordinator", time.Hour)
+	}
+
+	require.Equal(t, maxRecordedRuntimeTerminations+5, hm.GetMetrics().RuntimeTerminations)
+	history := hm.RuntimeTerminations()
+	require.Len(t, history, maxRecordedRuntimeTerminations)
+	require.Equal(t, "c-5", history[0].ContainerID)
+	require.Equal(t, time.Hour, history[0].Runtime)
+	require.Equal(t, clock.CurrentTime, history[0].TerminatedAt)
+}
+
+type fakeStrandedRescuer struct {
+	calls []string
+	err   error
+}
+
+func (r *fakeStrandedRescuer) RescueStrandedShip(_ context.Context, ship *navigation.Ship) error {
+	r.calls = append(r.calls, ship.ShipSymbol())
+	return r.err
+}
+
+func newFuelTestShip(t *testing.T, symbol string, fuel int, hasFuelHere bool) *navigation.Ship {
+	t.Helper()
+	location, err := shared.NewWaypoint("X1-HM-"+symbol, 0, 0)
+	require.NoError(t, err)
+	location.HasFuel = hasFuelHere
+	tank, err := shared.NewFuel(fuel, 100)
+	require.NoError(t, err)
+	cargo, err := shared.NewCargo(10, 0, nil)
+	require.NoError(t, err)
+	ship, err := navigation.NewShip(symbol, shared.MustNewPlayerID(1), location, tank, 100, 10, cargo,
+		10, "FRAME_HAULER", "HAULER", nil, navigation.NavStatusInOrbit)
+	require.NoError(t, err)
+	return ship
+}
+
+// Only idle, near-empty ships away from fuel are stranded; a ship owned by a
+// container is its container's problem.
+func TestDetectStrandedShips(t *testing.T) {
+	hm := NewHealthMonitor(time.Minute, time.Minute, &shared.MockClock{CurrentTime: time.Now()})
+	owned := newFuelTestShip(t, "OWNED", 0, false)
+	require.NoError(t, owned.AssignToContainer("c-1", &shared.MockClock{CurrentTime: time.Now()}))
+
+	ships := map[string]*navigation.Ship{
+		"DRY":     newFuelTestShip(t, "DRY", 0, false),
+		"LOW":     newFuelTestShip(t, "LOW", 1, false),
+		"FUELLED": newFuelTestShip(t, "FUELLED", 50, false),
+		"AT-FUEL": newFuelTestShip(t, "AT-FUEL", 0, true),
+		"OWNED":   owned,
+	}
+
+	require.Equal(t, []string{"DRY", "LOW"}, hm.DetectStrandedShips(ships))
+}
+
+// A failing rescue is retried each check until the attempt budget is spent,
+// then the ship is counted as abandoned exactly once.
+func TestRescueStrandedShips_RetriesThenAbandons(t *testing.T) {
+	hm := NewHealthMonitor(time.Minute, time.Minute, &shared.MockClock{CurrentTime: time.Now()})
+	hm.SetMaxRecoveryAttempts(2)
+	rescuer := &fakeStrandedRescuer{err: fmt.Errorf("no fuel station")}
+	hm.SetStrandedShipRescuer(rescuer)
+	ships := map[string]*navigation.Ship{"DRY": newFuelTestShip(t, "DRY", 0, false)}
+
+	for i := 0; i < 4; i++ {
+		require.Empty(t, hm.RescueStrandedShips(context.Background(), ships))
+	}
+
+	require.Equal(t, []string{"DRY", "DRY"}, rescuer.calls)
+	require.Equal(t, 2, hm.GetMetrics().FailedRecoveries)
+	require.Equal(t, 1, hm.GetMetrics().AbandonedShips)
+}
+
+func TestRescueStrandedShips_SuccessResetsAttempts(t *testing.T) {
+	hm := NewHealthMonitor(time.Minute, time.Minute, &shared.MockClock{CurrentTime: time.Now()})
+	hm.SetStrandedShipRescuer(&fakeStrandedRescuer{})
+	ships := map[string]*navigation.Ship{"LOW": newFuelTestShip(t, "LOW", 1, false)}
+
+	require.Equal(t, []string{"LOW"}, hm.RescueStrandedShips(context.Background(), ships))
+	require.Equal(t, 1, hm.GetMetrics().SuccessfulRecoveries)
+	require.Zero(t, hm.GetRecoveryAttemptCount("LOW"))
+}
+
+// A ship is stuck once it is IN_TRANSIT well past its arrival time; one that
+// has only just landed late, or whose arrival is unknown, is not.
+func TestDetectStuckShips_FlagsShipsLongPastTheirArrival(t *testing.T) {
+	now := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+	hm := NewHealthMonitor(time.Minute, time.Minute, &shared.MockClock{CurrentTime: now})
+
+	inTransit := func(symbol string, arrival *time.Time) *navigation.Ship {
+		ship := newFuelTestShip(t, symbol, 50, false)
+		destination, err := shared.NewWaypoint("X1-HM-DEST", 10, 0)
+		require.NoError(t, err)
+		require.NoError(t, ship.StartTransit(destination))
+		if arrival != nil {
+			ship.SetArrivalTime(*arrival)
+		}
+		return ship
+	}
+	longOverdue := now.Add(-10 * time.Minute)
+	justLate := now.Add(-30 * time.Second)
+
+	ships := map[string]*navigation.Ship{
+		"STUCK":   inTransit("STUCK", &longOverdue),
+		"LATE":    inTransit("LATE", &justLate),
+		"UNKNOWN": inTransit("UNKNOWN", nil),
+		"ORBIT":   newFuelTestShip(t, "ORBIT", 50, false),
+	}
+
+	require.Equal(t, []string{"STUCK"}, hm.DetectStuckShips(context.Background(), ships, nil, nil))
+}
+
+type fakeWaypointBlacklister struct {
+	waypoints []string
+	reasons   []string
+	ttls      []time.Duration
+}
+
+func (b *fakeWaypointBlacklister) BlacklistWaypoint(_ context.Context, _ int, waypoint, reason string, ttl time.Duration) error {
+	b.waypoints = append(b.waypoints, waypoint)
+	b.reasons = append(b.reasons, reason)
+	b.ttls = append(b.ttls, ttl)
+	return nil
+}
+
+// The third failure inside the window blacklists the waypoint; a failure that
+// has aged out of the window no longer counts.
+func TestRecordWaypointFailure_BlacklistsAfterThresholdWithinWindow(t *testing.T) {
+	clock := &shared.MockClock{CurrentTime: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)}
+	hm := NewHealthMonitor(time.Minute, time.Minute, clock)
+	blacklister := &fakeWaypointBlacklister{}
+	hm.SetWaypointBlacklister(blacklister, 3, 6*time.Hour)
+	ctx := context.Background()
+
+	record := func() bool {
+		blacklisted, err := hm.RecordWaypointFailure(ctx, 1, "X1-A-1", "navigate: 500")
+		require.NoError(t, err)
+		return blacklisted
+	}
+
+	require.False(t, record())
+	clock.CurrentTime = clock.CurrentTime.Add(waypointFailureWindow)
+	require.False(t, record(), "the first failure has aged out")
+	require.False(t, record())
+	require.True(t, record())
+	require.Equal(t, []string{"X1-A-1"}, blacklister.waypoints)
+	require.Equal(t, []time.Duration{6 * time.Hour}, blacklister.ttls)
+	require.Contains(t, blacklister.reasons[0], "navigate: 500")
+
+	require.False(t, record(), "the count starts over once blacklisted")
+}
+
+func TestRecordWaypointFailure_IgnoredWithoutBlacklister(t *testing.T) {
+	hm := NewHealthMonitor(time.Minute, time.Minute, nil)
+	for i := 0; i < 5; i++ {
+		blacklisted, err := hm.RecordWaypointFailure(context.Background(), 1, "X1-A-1", "boom")
+		require.NoError(t, err)
+		require.False(t, blacklisted)
+	}
+}
@@ -0,0 +1,180 @@
+package daemon
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// ScheduledJobKind names the work a scheduled job runs
+type ScheduledJobKind string
+
+const (
+	// ScheduledJobScoutMarkets launches a scout tour over a system's markets.
+	// Params: system (required), ships (comma-separated, required), markets
+	// (comma-separated, default all), iterations (default 1).
+	ScheduledJobScoutMarkets ScheduledJobKind = "SCOUT_MARKETS"
+	// ScheduledJobLedgerReconcile compares API credits with the ledger and
+	// books any confirmed drift. No params.
+	ScheduledJobLedgerReconcile ScheduledJobKind = "LEDGER_RECONCILE"
+	// ScheduledJobMarketExport writes a system's market data to a file.
+	// Params: system and path (required), format (csv|json, default csv),
+	// history_hours (default 0 = snapshots only).
+	ScheduledJobMarketExport ScheduledJobKind = "MARKET_EXPORT"
+)
+
+// requiredParams lists the params each kind cannot run without
+var requiredParams = map[ScheduledJobKind][]string{
+	ScheduledJobScoutMarkets:    {"system", "ships"},
+	ScheduledJobLedgerReconcile: nil,
+	ScheduledJobMarketExport:    {"system", "path"},
+}
+
+// ParseScheduledJobKind accepts a kind in any case, with dashes or underscores
+func ParseScheduledJobKind(s string) (ScheduledJobKind, error) {
+	kind := ScheduledJobKind(strings.ToUpper(strings.ReplaceAll(strings.TrimSpace(s), "-", "_")))
+	if _, ok := requiredParams[kind]; !ok {
+		return "", fmt.Errorf("unknown scheduled job kind %q (want scout_markets, ledger_reconcile or market_export)", s)
+	}
+	return kind, nil
+}
+
+// ScheduledJobStatus is the outcome of a job's last run
+type ScheduledJobStatus string
+
+const (
+	ScheduledJobStatusNever     ScheduledJobStatus = ""
+	ScheduledJobStatusSucceeded ScheduledJobStatus = "SUCCEEDED"
+	ScheduledJobStatusFailed    ScheduledJobStatus = "FAILED"
+	ScheduledJobStatusSkipped   ScheduledJobStatus = "SKIPPED" // the previous run was still going
+)
+
+// MinScheduledJobInterval is the shortest accepted interval; the scheduler
+// only checks for due jobs every few seconds anyway.
+const MinScheduledJobInterval = time.Minute
+
+// ScheduledJob is a recurring unit of daemon work, identified by name per
+// player. Runs are due at NextRunAt; after each run the next one is scheduled
+// one interval later.
+type ScheduledJob struct {
+	PlayerID int
+	Name     string
+	Kind     ScheduledJobKind
+	Interval time.Duration
+	Params   map[string]string
+	Enabled  bool
+
+	LastRunAt  *time.Time
+	NextRunAt  time.Time
+	LastStatus ScheduledJobStatus
+	LastError  string
+	// LastContainerIDs are the containers the last run launched; a job whose
+	// containers are still running is not run again (overlap prevention).
+	LastContainerIDs []string
+}
+
+// NewScheduledJob validates a job definition; its first run is due at now
+func NewScheduledJob(playerID int, name string, kind ScheduledJobKind, interval time.Duration, params map[string]string, now time.Time) (*ScheduledJob, error) {
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return nil, fmt.Errorf("scheduled job name is required")
+	}
+	required, ok := requiredParams[kind]
+	if !ok {
+		return nil, fmt.Errorf("unknown scheduled job kind %q", kind)
+	}
+	if interval < MinScheduledJobInterval {
+		return nil, fmt.Errorf("scheduled job %s: interval %s is below the %s minimum", name, interval, MinScheduledJobInterval)
+	}
+	for _, key := range required {
+		if strings.TrimSpace(params[key]) == "" {
+			return nil, fmt.Errorf("scheduled job %s: %s requires param %q", name, kind, key)
+		}
+	}
+	if params == nil {
+		params = map[string]string{}
+	}
+	return &ScheduledJob{
+		PlayerID:  playerID,
+		Name:      name,
+		Kind:      kind,
+		Interval:  interval,
+		Params:    params,
+		Enabled:   true,
+		NextRunAt: now,
+	}, nil
+}
+
+// IsDue reports whether an enabled job's next run has come
+func (j *ScheduledJob) IsDue(now time.Time) bool {
+	return j.Enabled && !now.Before(j.NextRunAt)
+}
+
+// Redefine replaces the job's definition with other's, keeping its run
+// history. A changed interval reschedules the next run from the last one.
+func (j *ScheduledJob) Redefine(other *ScheduledJob) {
+	if other.Interval != j.Interval && j.LastRunAt != nil {
+		j.NextRunAt = j.LastRunAt.Add(other.Interval)
+	}
+	j.Kind = other.Kind
+	j.Interval = other.Interval
+	j.Params = other.Params
+	j.Enabled = other.Enabled
+}
+
+// MarkStarted moves NextRunAt past a run starting at now, so the run in
+// flight is not found due again before it finishes.
+func (j *ScheduledJob) MarkStarted(now time.Time) {
+	j.advance(now)
+}
+
+// RecordRun stamps a run started at startedAt and schedules the next one. The
+// next run stays on the job's cadence: a run that was due long ago (the daemon
+// was down) runs once, not once per missed interval.
+func (j *ScheduledJob) RecordRun(startedAt time.Time, containerIDs []string, err error) {
+	j.LastRunAt = &startedAt
+	j.LastContainerIDs = containerIDs
+	if err != nil {
+		j.LastStatus = ScheduledJobStatusFailed
+		j.LastError = err.Error()
+	} else {
+		j.LastStatus = ScheduledJobStatusSucceeded
+		j.LastError = ""
+	}
+	j.advance(startedAt)
+}
+
+// RecordSkip notes a due run skipped because the previous one was still
+// going; the next attempt is one interval later.
+func (j *ScheduledJob) RecordSkip(now time.Time) {
+	j.LastStatus = ScheduledJobStatusSkipped
+	j.advance(now)
+}
+
+func (j *ScheduledJob) advance(now time.Time) {
+	if j.Interval <= 0 {
+		j.NextRunAt = now.Add(MinScheduledJobInterval)
+		return
+	}
+	next := j.NextRunAt
+	if next.IsZero() {
+		next = now
+	}
+	for !next.After(now) {
+		next = next.Add(j.Interval)
+	}
+	j.NextRunAt = next
+}
+
+// ScheduledJobRepository persists scheduled jobs
+type ScheduledJobRepository interface {
+	// Save inserts the job or replaces the one with the same player and name
+	Save(ctx context.Context, job *ScheduledJob) error
+	// FindByName returns the named job, or nil when there is none
+	FindByName(ctx context.Context, playerID int, name string) (*ScheduledJob, error)
+	// List returns playerID's jobs ordered by name
+	List(ctx context.Context, playerID int) ([]*ScheduledJob, error)
+	// Delete removes the named job, reporting whether it existed
+	Delete(ctx context.Context, playerID int, name string) (bool, error)
+}
@@ -0,0 +1,64 @@
+package daemon
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+var scheduleEpoch = time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC)
+
+func TestNewScheduledJob_ValidatesDefinition(t *testing.T) {
+	_, err := NewScheduledJob(1, "scout", ScheduledJobScoutMarkets, 2*time.Hour, map[string]string{"system": "X1-A"}, scheduleEpoch)
+	require.ErrorContains(t, err, `"ships"`)
+
+	_, err = NewScheduledJob(1, "reconcile", ScheduledJobLedgerReconcile, 10*time.Second, nil, scheduleEpoch)
+	require.ErrorContains(t, err, "minimum")
+
+	job, err := NewScheduledJob(1, " reconcile ", ScheduledJobLedgerReconcile, 24*time.Hour, nil, scheduleEpoch)
+	require.NoError(t, err)
+	require.Equal(t, "reconcile", job.Name)
+	require.True(t, job.IsDue(scheduleEpoch), "a new job runs at once")
+}
+
+func TestParseScheduledJobKind(t *testing.T) {
+	kind, err := ParseScheduledJobKind("market-export")
+	require.NoError(t, err)
+	require.Equal(t, ScheduledJobMarketExport, kind)
+
+	_, err = ParseScheduledJobKind("mine")
+	require.Error(t, err)
+}
+
+// A job overdue by several intervals runs once and stays on its cadence.
+func TestRecordRun_KeepsCadenceAfterDowntime(t *testing.T) {
+	job, err := NewScheduledJob(1, "export", ScheduledJobMarketExport, time.Hour, map[string]string{"system": "X1-A", "path": "/tmp/x.csv"}, scheduleEpoch)
+	require.NoError(t, err)
+
+	started := scheduleEpoch.Add(3*time.Hour + 10*time.Minute)
+	job.RecordRun(started, nil, nil)
+
+	require.Equal(t, ScheduledJobStatusSucceeded, job.LastStatus)
+	require.Equal(t, scheduleEpoch.Add(4*time.Hour), job.NextRunAt)
+	require.False(t, job.IsDue(started.Add(time.Minute)))
+
+	job.RecordRun(job.NextRunAt, nil, errors.New("disk full"))
+	require.Equal(t, ScheduledJobStatusFailed, job.LastStatus)
+	require.Equal(t, "disk full", job.LastError)
+	require.Equal(t, scheduleEpoch.Add(5*time.Hour), job.NextRunAt)
+}
+
+func TestRedefine_KeepsHistoryAndReschedules(t *testing.T) {
+	job, err := NewScheduledJob(1, "reconcile", ScheduledJobLedgerReconcile, 24*time.Hour, nil, scheduleEpoch)
+	require.NoError(t, err)
+	job.RecordRun(scheduleEpoch, nil, nil)
+
+	redefined, err := NewScheduledJob(1, "reconcile", ScheduledJobLedgerReconcile, 6*time.Hour, nil, scheduleEpoch.Add(time.Hour))
+	require.NoError(t, err)
+	job.Redefine(redefined)
+
+	require.Equal(t, scheduleEpoch.Add(6*time.Hour), job.NextRunAt)
+	require.Equal(t, ScheduledJobStatusSucceeded, job.LastStatus)
+}
@@ -0,0 +1,36 @@
+package goods
+
+import "sort"
+
+// asteroidDepositYields maps each asteroid deposit trait to the goods an
+// extraction at such an asteroid can yield. Every extraction returns one of
+// them at random, so the share of a wanted good falls with the length of its
+// deposit's list.
+var asteroidDepositYields = map[string][]string{
+	"COMMON_METAL_DEPOSITS":   {"IRON_ORE", "COPPER_ORE", "ALUMINUM_ORE", "QUARTZ_SAND", "SILICON_CRYSTALS"},
+	"PRECIOUS_METAL_DEPOSITS": {"SILVER_ORE", "GOLD_ORE", "PLATINUM_ORE", "QUARTZ_SAND", "SILICON_CRYSTALS"},
+	"RARE_METAL_DEPOSITS":     {"URANITE_ORE", "MERITIUM_ORE", "QUARTZ_SAND", "SILICON_CRYSTALS"},
+	"MINERAL_DEPOSITS":        {"SILICON_CRYSTALS", "QUARTZ_SAND", "ICE_WATER", "AMMONIA_ICE", "PRECIOUS_STONES", "DIAMONDS"},
+}
+
+// DepositsYielding returns the asteroid deposit traits whose extractions can
+// yield good, sorted. Empty when the good cannot be mined from an asteroid.
+func DepositsYielding(good string) []string {
+	var deposits []string
+	for deposit, yields := range asteroidDepositYields {
+		for _, yield := range yields {
+			if yield == good {
+				deposits = append(deposits, deposit)
+				break
+			}
+		}
+	}
+	sort.Strings(deposits)
+	return deposits
+}
+
+// DepositYieldCount returns how many different goods a deposit trait yields,
+// or 0 for a trait that is not an asteroid deposit.
+func DepositYieldCount(deposit string) int {
+	return len(asteroidDepositYields[deposit])
+}
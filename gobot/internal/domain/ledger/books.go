@@ -0,0 +1,387 @@
+package ledger
+
+import (
+	"fmt"
+	"sort"
+	"time"
+)
+
+// BookIssueKind names a defect the double-entry books found in the
+// transaction log
+type BookIssueKind string
+
+const (
+	// BookIssueBalanceGap: a transaction's balance_before does not continue the
+	// previous transaction's balance_after, so credits moved that the log did
+	// not capture. Amount is the gap (positive when credits appeared).
+	BookIssueBalanceGap BookIssueKind = "BALANCE_GAP"
+
+	// BookIssueWrongSign: the amount runs against the transaction type (a sale
+	// that cost credits, a refuel that paid). The transaction is not posted.
+	BookIssueWrongSign BookIssueKind = "WRONG_SIGN"
+)
+
+// BookIssue is one capture defect, tied to the transaction that exposed it
+type BookIssue struct {
+	Kind          BookIssueKind
+	TransactionID TransactionID
+	Timestamp     time.Time
+	Amount        int
+	Detail        string
+}
+
+// AccountBalance is an account's balance in its normal direction: a positive
+// asset or expense balance is a net debit, a positive equity or revenue
+// balance a net credit
+type AccountBalance struct {
+	Account Account
+	Balance int
+}
+
+// BalanceSheet is the books' position: assets against equity, where equity is
+// the opening credits plus the retained earnings (revenue less expenses) of
+// every posted transaction
+type BalanceSheet struct {
+	Assets           []AccountBalance
+	TotalAssets      int
+	OpeningEquity    int
+	RetainedEarnings int
+	TotalEquity      int
+}
+
+// IsBalanced reports whether assets equal equity
+func (s BalanceSheet) IsBalanced() bool {
+	return s.TotalAssets == s.TotalEquity
+}
+
+// IncomeStatement totals the revenue and expense accounts over a period
+type IncomeStatement struct {
+	Revenue       []AccountBalance
+	Expenses      []AccountBalance
+	TotalRevenue  int
+	TotalExpenses int
+	NetIncome     int
+}
+
+// Books is an optional double-entry view over the single-entry transaction
+// log. Each transaction posts a balanced journal entry across cash, inventory,
+// ships, revenue and expense accounts; cargo is carried at weighted average
+// cost per good so a sale books its cost of goods sold, and ships at purchase
+// price so a scrap books a disposal gain or loss. The books are rebuilt from
+// the log on demand and never written back.
+//
+// Posting also audits the log: every entry must balance, each transaction's
+// balance_before must continue the previous balance_after, and the cash account
+// must end where the log's running balance does. A failure of any of these is
+// a transaction capture bug.
+type Books struct {
+	entries  []*JournalEntry
+	balances map[Account]int // debits positive, credits negative
+	issues   []BookIssue
+
+	inventory map[string]*CargoCostBasis // fleet-wide, keyed by good
+	shipCost  map[string]int             // by ship symbol
+
+	started     bool
+	lastBalance int
+}
+
+// NewBooks returns empty books
+func NewBooks() *Books {
+	return &Books{
+		balances:  make(map[Account]int),
+		inventory: make(map[string]*CargoCostBasis),
+		shipCost:  make(map[string]int),
+	}
+}
+
+// BuildBooks posts transactions in chain order (see OrderForPosting)
+func BuildBooks(transactions []*Transaction) *Books {
+	books := NewBooks()
+	for _, tx := range OrderForPosting(transactions) {
+		books.Post(tx)
+	}
+	return books
+}
+
+// OrderForPosting sorts transactions by timestamp. Rows sharing a timestamp
+// cannot be ordered by the database, so within a tie each next row is the one
+// whose balance_before continues the running balance, keeping a sound chain
+// free of false balance gaps.
+func OrderForPosting(transactions []*Transaction) []*Transaction {
+	ordered := make([]*Transaction, len(transactions))
+	copy(ordered, transactions)
+	sort.SliceStable(ordered, func(i, j int) bool {
+		return ordered[i].Timestamp().Before(ordered[j].Timestamp())
+	})
+
+	for start := 0; start < len(ordered); {
+		end := start + 1
+		for end < len(ordered) && ordered[end].Timestamp().Equal(ordered[start].Timestamp()) {
+			end++
+		}
+		if start > 0 {
+			chainTies(ordered[start:end], ordered[start-1].BalanceAfter())
+		}
+		start = end
+	}
+	return ordered
+}
+
+// chainTies reorders same-timestamp rows in place, following the balance chain
+// from running for as long as some row continues it.
+func chainTies(ties []*Transaction, running int) {
+	for i := range ties {
+		for j := i; j < len(ties); j++ {
+			if ties[j].BalanceBefore() == running {
+				ties[i], ties[j] = ties[j], ties[i]
+				break
+			}
+		}
+		running = ties[i].BalanceAfter()
+	}
+}
+
+// Post journals one transaction. Transactions must be posted in order.
+func (b *Books) Post(tx *Transaction) {
+	if !b.started {
+		b.started = true
+		b.postOpening(tx)
+	} else if gap := tx.BalanceBefore() - b.lastBalance; gap != 0 {
+		b.issues = append(b.issues, BookIssue{
+			Kind:          BookIssueBalanceGap,
+			TransactionID: tx.ID(),
+			Timestamp:     tx.Timestamp(),
+			Amount:        gap,
+			Detail:        fmt.Sprintf("balance_before %d does not continue the previous balance_after %d", tx.BalanceBefore(), b.lastBalance),
+		})
+	}
+	b.lastBalance = tx.BalanceAfter()
+
+	if err := checkSign(tx); err != nil {
+		b.issues = append(b.issues, BookIssue{
+			Kind:          BookIssueWrongSign,
+			TransactionID: tx.ID(),
+			Timestamp:     tx.Timestamp(),
+			Amount:        tx.Amount(),
+			Detail:        err.Error(),
+		})
+		return
+	}
+
+	entry := b.journal(tx)
+	b.entries = append(b.entries, entry)
+	for _, line := range entry.Lines {
+		b.balances[line.Account] += line.Debit - line.Credit
+	}
+}
+
+// postOpening books the credits the log opens with as opening equity
+func (b *Books) postOpening(tx *Transaction) {
+	opening := tx.BalanceBefore()
+	if opening == 0 {
+		return
+	}
+	entry := &JournalEntry{Timestamp: tx.Timestamp(), Description: "Opening balance"}
+	entry.debit(AccountCash, opening)
+	entry.credit(AccountOpeningEquity, opening)
+	b.entries = append(b.entries, entry)
+	b.balances[AccountCash] += opening
+	b.balances[AccountOpeningEquity] -= opening
+}
+
+// journal builds the balanced entry for a correctly signed transaction
+func (b *Books) journal(tx *Transaction) *JournalEntry {
+	entry := &JournalEntry{
+		TransactionID:   tx.ID(),
+		Timestamp:       tx.Timestamp(),
+		TransactionType: tx.TransactionType(),
+		Description:     tx.Description(),
+	}
+	amount := tx.Amount()
+	cost := -amount
+	meta := tx.Metadata()
+
+	switch tx.TransactionType() {
+	case TransactionTypeRefuel:
+		entry.debit(AccountFuel, cost)
+		entry.credit(AccountCash, cost)
+
+	case TransactionTypePurchaseCargo:
+		// Contract delivery cargo is consumed by the contract, never resold,
+		// so it is expensed against the contract rather than held in stock.
+		if tx.OperationType() == "contract" {
+			entry.debit(AccountContractCosts, cost)
+		} else {
+			b.stock(bookMetaString(meta, "good_symbol")).Acquire(bookMetaInt(meta, "units"), cost)
+			entry.debit(AccountInventory, cost)
+		}
+		entry.credit(AccountCash, cost)
+
+	case TransactionTypeSellCargo:
+		entry.debit(AccountCash, amount)
+		entry.credit(AccountTradingRevenue, amount)
+		sold := b.stock(bookMetaString(meta, "good_symbol")).Release(bookMetaInt(meta, "units"))
+		entry.debit(AccountCostOfGoodsSold, sold)
+		entry.credit(AccountInventory, sold)
+
+	case TransactionTypePurchaseShip:
+		b.shipCost[bookMetaString(meta, "ship_symbol")] += cost
+		entry.debit(AccountShips, cost)
+		entry.credit(AccountCash, cost)
+
+	case TransactionTypeScrapShip:
+		ship := bookMetaString(meta, "ship_symbol")
+		bookValue := b.shipCost[ship]
+		delete(b.shipCost, ship)
+		entry.debit(AccountCash, amount)
+		entry.credit(AccountShips, bookValue)
+		if amount > bookValue {
+			entry.credit(AccountShipDisposalGains, amount-bookValue)
+		} else {
+			entry.debit(AccountShipDisposalLosses, bookValue-amount)
+		}
+
+	case TransactionTypeContractAccepted, TransactionTypeContractFulfilled:
+		entry.debit(AccountCash, amount)
+		entry.credit(AccountContractRevenue, amount)
+
+	case TransactionTypeBalanceAdjustment:
+		entry.debit(AccountCash, amount)
+		if amount > 0 {
+			entry.credit(AccountOtherIncome, amount)
+		} else {
+			entry.debit(AccountFees, cost)
+		}
+
+	case TransactionTypeRepairShip:
+		entry.debit(AccountMaintenance, cost)
+		entry.credit(AccountCash, cost)
+	}
+	return entry
+}
+
+// stock returns the fleet-wide cost basis for a good. Cargo moves between
+// ships without touching the log, so cost is pooled per good rather than per
+// hold; units never seen bought (mined, siphoned) sell at zero cost.
+func (b *Books) stock(good string) *CargoCostBasis {
+	basis, ok := b.inventory[good]
+	if !ok {
+		basis = NewCargoCostBasis(0, "", good)
+		b.inventory[good] = basis
+	}
+	return basis
+}
+
+// Entries returns the posted journal entries in posting order
+func (b *Books) Entries() []*JournalEntry {
+	return b.entries
+}
+
+// Issues returns the capture defects found while posting
+func (b *Books) Issues() []BookIssue {
+	return b.issues
+}
+
+// Balance returns an account's balance in its normal direction
+func (b *Books) Balance(account Account) int {
+	if account.DebitNormal() {
+		return b.balances[account]
+	}
+	return -b.balances[account]
+}
+
+// TrialBalance returns the debit and credit totals over every account; they
+// are equal whenever every entry balanced
+func (b *Books) TrialBalance() (debits, credits int) {
+	for _, balance := range b.balances {
+		if balance > 0 {
+			debits += balance
+		} else {
+			credits -= balance
+		}
+	}
+	return debits, credits
+}
+
+// CashDifference is the log's final balance_after less the cash account: the
+// credits the books cannot account for. Zero when the log is sound.
+func (b *Books) CashDifference() int {
+	return b.lastBalance - b.Balance(AccountCash)
+}
+
+// IsSound reports whether the books balance and reconcile: every entry
+// balanced, no capture defect was found and cash matches the log
+func (b *Books) IsSound() bool {
+	debits, credits := b.TrialBalance()
+	return debits == credits && len(b.issues) == 0 && b.CashDifference() == 0
+}
+
+// BalanceSheet reports the closing position of everything posted
+func (b *Books) BalanceSheet() BalanceSheet {
+	var sheet BalanceSheet
+	for _, account := range AllAccounts() {
+		balance := b.Balance(account)
+		switch account.Kind() {
+		case AccountKindAsset:
+			sheet.Assets = append(sheet.Assets, AccountBalance{Account: account, Balance: balance})
+			sheet.TotalAssets += balance
+		case AccountKindEquity:
+			sheet.OpeningEquity += balance
+		case AccountKindRevenue:
+			sheet.RetainedEarnings += balance
+		case AccountKindExpense:
+			sheet.RetainedEarnings -= balance
+		}
+	}
+	sheet.TotalEquity = sheet.OpeningEquity + sheet.RetainedEarnings
+	return sheet
+}
+
+// IncomeStatement totals the revenue and expense lines of entries dated within
+// [start, end]. Cost of goods sold is that of the period's sales, even when
+// the cargo was bought before start.
+func (b *Books) IncomeStatement(start, end time.Time) IncomeStatement {
+	totals := make(map[Account]int)
+	for _, entry := range b.entries {
+		if entry.Timestamp.Before(start) || entry.Timestamp.After(end) {
+			continue
+		}
+		for _, line := range entry.Lines {
+			totals[line.Account] += line.Debit - line.Credit
+		}
+	}
+
+	var statement IncomeStatement
+	for _, account := range AllAccounts() {
+		switch account.Kind() {
+		case AccountKindRevenue:
+			statement.Revenue = append(statement.Revenue, AccountBalance{Account: account, Balance: -totals[account]})
+			statement.TotalRevenue -= totals[account]
+		case AccountKindExpense:
+			statement.Expenses = append(statement.Expenses, AccountBalance{Account: account, Balance: totals[account]})
+			statement.TotalExpenses += totals[account]
+		}
+	}
+	statement.NetIncome = statement.TotalRevenue - statement.TotalExpenses
+	return statement
+}
+
+func bookMetaString(meta map[string]interface{}, key string) string {
+	s, _ := meta[key].(string)
+	return s
+}
+
+// bookMetaInt reads an integer metadata value. Rows written in-process carry
+// an int; rows read back from the JSON column carry a float64.
+func bookMetaInt(meta map[string]interface{}, key string) int {
+	switch v := meta[key].(type) {
+	case int:
+		return v
+	case int64:
+		return int(v)
+	case float64:
+		return int(v)
+	}
+	return 0
+}
@@ -0,0 +1,125 @@
+package ledger
+
+import (
+	"testing"
+	"time"
+
+	"github.com/andrescamacho/spacetraders-go/internal/domain/shared"
+)
+
+var booksEpoch = time.Date(2024, 3, 1, 12, 0, 0, 0, time.UTC)
+
+func booksTx(t *testing.T, minute int, txType TransactionType, amount, before int, meta map[string]interface{}, operation string) *Transaction {
+	t.Helper()
+	tx, err := NewTransaction(shared.MustNewPlayerID(1), booksEpoch.Add(time.Duration(minute)*time.Minute),
+		txType, amount, before, before+amount, string(txType), meta, "", "", operation, "")
+	if err != nil {
+		t.Fatalf("NewTransaction: %v", err)
+	}
+	return tx
+}
+
+func cargo(good string, units int) map[string]interface{} {
+	return map[string]interface{}{"good_symbol": good, "units": units}
+}
+
+func ship(symbol string) map[string]interface{} {
+	return map[string]interface{}{"ship_symbol": symbol}
+}
+
+func TestBooks_SoundLogBalancesAndReconciles(t *testing.T) {
+	books := BuildBooks([]*Transaction{
+		booksTx(t, 0, TransactionTypePurchaseCargo, -1000, 10000, cargo("IRON", 10), "trade"),
+		booksTx(t, 1, TransactionTypeRefuel, -50, 9000, nil, "trade"),
+		booksTx(t, 2, TransactionTypeSellCargo, 900, 8950, cargo("IRON", 6), "trade"),
+		booksTx(t, 3, TransactionTypePurchaseShip, -5000, 9850, ship("AGENT-2"), "fleet expansion"),
+		booksTx(t, 4, TransactionTypeScrapShip, 2000, 4850, ship("AGENT-2"), "fleet downsizing"),
+		booksTx(t, 5, TransactionTypeContractFulfilled, 3000, 6850, nil, "contract"),
+	})
+
+	if !books.IsSound() {
+		t.Fatalf("expected sound books, got issues %+v and cash difference %d", books.Issues(), books.CashDifference())
+	}
+	if got := books.Balance(AccountCash); got != 9850 {
+		t.Fatalf("cash = %d, want the log's closing 9850", got)
+	}
+	if got := books.Balance(AccountInventory); got != 400 {
+		t.Fatalf("inventory = %d, want the 4 unsold units at 100", got)
+	}
+	if got := books.Balance(AccountCostOfGoodsSold); got != 600 {
+		t.Fatalf("COGS = %d, want 6 units at 100", got)
+	}
+	if got := books.Balance(AccountShipDisposalLosses); got != 3000 {
+		t.Fatalf("disposal loss = %d, want 5000 cost less 2000 recovered", got)
+	}
+
+	sheet := books.BalanceSheet()
+	if !sheet.IsBalanced() || sheet.OpeningEquity != 10000 {
+		t.Fatalf("expected a balanced sheet opening at 10000, got %+v", sheet)
+	}
+
+	income := books.IncomeStatement(booksEpoch, booksEpoch.Add(time.Hour))
+	// 900 sale + 3000 contract - 600 COGS - 50 fuel - 3000 disposal loss
+	if income.NetIncome != 250 || income.NetIncome != sheet.RetainedEarnings {
+		t.Fatalf("net income = %d (retained %d), want 250", income.NetIncome, sheet.RetainedEarnings)
+	}
+}
+
+// Contract delivery cargo is expensed at purchase, not held as inventory.
+func TestBooks_ContractCargoIsExpensed(t *testing.T) {
+	books := BuildBooks([]*Transaction{
+		booksTx(t, 0, TransactionTypePurchaseCargo, -800, 1000, cargo("IRON", 8), "contract"),
+	})
+
+	if books.Balance(AccountInventory) != 0 || books.Balance(AccountContractCosts) != 800 {
+		t.Fatalf("expected 800 of contract costs and no inventory, got inventory %d costs %d",
+			books.Balance(AccountInventory), books.Balance(AccountContractCosts))
+	}
+}
+
+// A transaction missing from the log surfaces as a balance gap and a cash
+// difference, while the trial balance still balances.
+func TestBooks_MissedTransactionBreaksReconciliation(t *testing.T) {
+	books := BuildBooks([]*Transaction{
+		booksTx(t, 0, TransactionTypeRefuel, -100, 1000, nil, ""),
+		booksTx(t, 1, TransactionTypeRefuel, -100, 700, nil, ""), // a 200-credit spend went unrecorded
+	})
+
+	issues := books.Issues()
+	if len(issues) != 1 || issues[0].Kind != BookIssueBalanceGap || issues[0].Amount != -200 {
+		t.Fatalf("expected one -200 balance gap, got %+v", issues)
+	}
+	if books.CashDifference() != -200 || books.IsSound() {
+		t.Fatalf("expected a -200 cash difference and unsound books, got %d", books.CashDifference())
+	}
+	if debits, credits := books.TrialBalance(); debits != credits {
+		t.Fatalf("trial balance %d/%d should still balance", debits, credits)
+	}
+}
+
+func TestBooks_WrongSignIsReportedNotPosted(t *testing.T) {
+	books := BuildBooks([]*Transaction{
+		booksTx(t, 0, TransactionTypeRefuel, 100, 1000, nil, ""),
+	})
+
+	issues := books.Issues()
+	if len(issues) != 1 || issues[0].Kind != BookIssueWrongSign {
+		t.Fatalf("expected a wrong-sign issue, got %+v", issues)
+	}
+	if books.Balance(AccountFuel) != 0 || books.CashDifference() != 100 {
+		t.Fatalf("expected the refuel unposted, got fuel %d cash difference %d", books.Balance(AccountFuel), books.CashDifference())
+	}
+}
+
+// Rows sharing a timestamp are chained by balance rather than left in
+// arbitrary database order.
+func TestOrderForPosting_ChainsTimestampTies(t *testing.T) {
+	first := booksTx(t, 0, TransactionTypeRefuel, -10, 1000, nil, "")
+	b := booksTx(t, 1, TransactionTypeRefuel, -20, 980, nil, "")
+	a := booksTx(t, 1, TransactionTypeRefuel, -10, 990, nil, "")
+
+	books := BuildBooks([]*Transaction{b, first, a})
+	if !books.IsSound() {
+		t.Fatalf("expected tied rows to chain cleanly, got %+v", books.Issues())
+	}
+}
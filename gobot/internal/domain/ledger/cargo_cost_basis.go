@@ -0,0 +1,65 @@
+package ledger
+
+import "time"
+
+// CargoCostBasis is what the units of one good in one ship's hold cost the
+// player, carried at weighted average: a purchase adds its total, extracted
+// or siphoned units add nothing, and any units leaving the hold (sold,
+// jettisoned, transferred) take their average share of the cost with them.
+type CargoCostBasis struct {
+	PlayerID   int
+	ShipSymbol string
+	GoodSymbol string
+	Units      int
+	TotalCost  int
+	UpdatedAt  time.Time
+}
+
+// NewCargoCostBasis returns an empty basis for a ship's good.
+func NewCargoCostBasis(playerID int, shipSymbol, goodSymbol string) *CargoCostBasis {
+	return &CargoCostBasis{PlayerID: playerID, ShipSymbol: shipSymbol, GoodSymbol: goodSymbol}
+}
+
+// Acquire adds units that cost totalCost in all (0 for mined cargo).
+func (b *CargoCostBasis) Acquire(units, totalCost int) {
+	if units <= 0 {
+		return
+	}
+	if totalCost < 0 {
+		totalCost = 0
+	}
+	b.Units += units
+	b.TotalCost += totalCost
+}
+
+// Release takes units out of the basis at the average cost and returns the
+// cost that left with them. Releasing more than is tracked empties the basis:
+// the hold held cargo the tracker never saw arrive.
+func (b *CargoCostBasis) Release(units int) int {
+	if units <= 0 || b.Units <= 0 {
+		return 0
+	}
+	if units >= b.Units {
+		cost := b.TotalCost
+		b.Units, b.TotalCost = 0, 0
+		return cost
+	}
+	cost := b.TotalCost * units / b.Units
+	b.Units -= units
+	b.TotalCost -= cost
+	return cost
+}
+
+// PerUnit is the average cost per unit, rounded up so a sale floored on it
+// never books a loss of a fraction of a credit. 0 when nothing is tracked.
+func (b *CargoCostBasis) PerUnit() int {
+	if b.Units <= 0 {
+		return 0
+	}
+	return (b.TotalCost + b.Units - 1) / b.Units
+}
+
+// IsEmpty reports whether the basis tracks no units.
+func (b *CargoCostBasis) IsEmpty() bool {
+	return b.Units <= 0
+}
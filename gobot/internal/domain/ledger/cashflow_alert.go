@@ -0,0 +1,115 @@
+package ledger
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/andrescamacho/spacetraders-go/internal/domain/shared"
+)
+
+// CashflowWindow is the player's cash movement over one rolling window.
+type CashflowWindow struct {
+	Start   time.Time
+	End     time.Time
+	Inflow  int // Sum of positive amounts
+	Outflow int // Sum of negative amounts, stored as a positive value
+	Net     int
+	Count   int
+}
+
+// NetPerHour normalizes Net to credits per hour over the window length.
+func (w CashflowWindow) NetPerHour() int {
+	hours := w.End.Sub(w.Start).Hours()
+	if hours <= 0 {
+		return w.Net
+	}
+	return int(float64(w.Net) / hours)
+}
+
+// ComputeCashflowWindow sums the transactions timestamped in [start, end),
+// skipping any whose category is excluded.
+func ComputeCashflowWindow(transactions []*Transaction, start, end time.Time, exclude []Category) CashflowWindow {
+	window := CashflowWindow{Start: start, End: end}
+	for _, tx := range transactions {
+		if tx.Timestamp().Before(start) || !tx.Timestamp().Before(end) || isExcludedCategory(tx.Category(), exclude) {
+			continue
+		}
+		window.Count++
+		if tx.Amount() > 0 {
+			window.Inflow += tx.Amount()
+		} else {
+			window.Outflow += -tx.Amount()
+		}
+	}
+	window.Net = window.Inflow - window.Outflow
+	return window
+}
+
+func isExcludedCategory(category Category, exclude []Category) bool {
+	for _, c := range exclude {
+		if c == category {
+			return true
+		}
+	}
+	return false
+}
+
+// CashflowThreshold fires when the net cashflow over its rolling window, per
+// hour, falls below MinNetPerHour (e.g. -50000 for "losing more than 50k an hour").
+type CashflowThreshold struct {
+	Name          string
+	Window        time.Duration
+	MinNetPerHour int
+
+	// ExcludeCategories leaves deliberate spending out of the window, e.g.
+	// SHIP_INVESTMENTS so a fleet purchase is not reported as a losing loop.
+	ExcludeCategories []Category
+}
+
+// Validate checks the threshold is usable.
+func (t CashflowThreshold) Validate() error {
+	if t.Name == "" {
+		return fmt.Errorf("cashflow threshold: name is required")
+	}
+	if t.Window <= 0 {
+		return fmt.Errorf("cashflow threshold %s: window must be positive", t.Name)
+	}
+	for _, c := range t.ExcludeCategories {
+		if !c.IsValid() {
+			return fmt.Errorf("cashflow threshold %s: unknown category %q", t.Name, c)
+		}
+	}
+	return nil
+}
+
+// Evaluate computes the window ending at now and reports an alert when the
+// hourly net is below the threshold.
+func (t CashflowThreshold) Evaluate(playerID shared.PlayerID, transactions []*Transaction, now time.Time) (CashflowAlert, bool) {
+	window := ComputeCashflowWindow(transactions, now.Add(-t.Window), now, t.ExcludeCategories)
+	if window.NetPerHour() >= t.MinNetPerHour {
+		return CashflowAlert{}, false
+	}
+	return CashflowAlert{
+		PlayerID:   playerID,
+		Threshold:  t,
+		Window:     window,
+		NetPerHour: window.NetPerHour(),
+		RaisedAt:   now,
+	}, true
+}
+
+// CashflowAlert is one threshold breach.
+type CashflowAlert struct {
+	PlayerID   shared.PlayerID
+	Threshold  CashflowThreshold
+	Window     CashflowWindow
+	NetPerHour int
+	RaisedAt   time.Time
+}
+
+// Message renders the alert as a single human-readable line.
+func (a CashflowAlert) Message() string {
+	return fmt.Sprintf("cashflow alert %q: net %d credits/hour over the last %s (threshold %d/hour; in %d, out %d, %d transactions)",
+		a.Threshold.Name, a.NetPerHour, a.Threshold.Window, a.Threshold.MinNetPerHour,
+		a.Window.Inflow, a.Window.Outflow, a.Window.Count)
+}
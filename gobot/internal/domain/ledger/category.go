@@ -15,11 +15,18 @@ const (
 	// CategoryTradingCosts represents expenses from purchasing cargo
 	CategoryTradingCosts Category = "TRADING_COSTS"
 
-	// CategoryShipInvestments represents expenses from purchasing ships
+	// CategoryShipInvestments represents ship purchases and the credits recovered by scrapping them
 	CategoryShipInvestments Category = "SHIP_INVESTMENTS"
 
 	// CategoryContractRevenue represents income from contracts
 	CategoryContractRevenue Category = "CONTRACT_REVENUE"
+
+	// CategoryBalanceAdjustments represents credit changes the ledger did not
+	// capture (missed transactions, fees), found by credit reconciliation
+	CategoryBalanceAdjustments Category = "BALANCE_ADJUSTMENTS"
+
+	// CategoryMaintenanceCosts represents shipyard repairs of worn ships
+	CategoryMaintenanceCosts Category = "MAINTENANCE_COSTS"
 )
 
 // AllCategories returns all valid categories
@@ -30,6 +37,8 @@ func AllCategories() []Category {
 		CategoryTradingCosts,
 		CategoryShipInvestments,
 		CategoryContractRevenue,
+		CategoryBalanceAdjustments,
+		CategoryMaintenanceCosts,
 	}
 }
 
@@ -43,8 +52,11 @@ var TypeToCategoryMap = map[TransactionType]Category{
 	TransactionTypePurchaseCargo:     CategoryTradingCosts,
 	TransactionTypeSellCargo:         CategoryTradingRevenue,
 	TransactionTypePurchaseShip:      CategoryShipInvestments,
+	TransactionTypeScrapShip:         CategoryShipInvestments,
 	TransactionTypeContractAccepted:  CategoryContractRevenue,
 	TransactionTypeContractFulfilled: CategoryContractRevenue,
+	TransactionTypeBalanceAdjustment: CategoryBalanceAdjustments,
+	TransactionTypeRepairShip:        CategoryMaintenanceCosts,
 }
 
 // String returns the string representation of the Category
@@ -59,7 +71,9 @@ func (c Category) IsValid() bool {
 		CategoryTradingRevenue,
 		CategoryTradingCosts,
 		CategoryShipInvestments,
-		CategoryContractRevenue:
+		CategoryContractRevenue,
+		CategoryBalanceAdjustments,
+		CategoryMaintenanceCosts:
 		return true
 	default:
 		return false
@@ -0,0 +1,68 @@
+package ledger
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// CreditReservation earmarks credits for one imminent purchase so concurrent
+// coordinators do not both decide to spend the same balance. It lapses at
+// ExpiresAt even if the holder never releases it, so a crashed or stuck
+// coordinator cannot pin the treasury.
+type CreditReservation struct {
+	ID        string
+	PlayerID  int
+	Holder    string // who reserved, e.g. "trade-route:SHIP-1" (for logs)
+	Amount    int
+	ExpiresAt time.Time
+}
+
+// Expired reports whether the reservation no longer holds credits at now.
+func (r *CreditReservation) Expired(now time.Time) bool {
+	return !now.Before(r.ExpiresAt)
+}
+
+// ErrInsufficientUnreservedCredits is returned when a reservation or purchase
+// needs more credits than remain once other holders' reservations are set aside.
+type ErrInsufficientUnreservedCredits struct {
+	Need     int
+	Credits  int
+	Reserved int
+}
+
+func (e *ErrInsufficientUnreservedCredits) Error() string {
+	return fmt.Sprintf("insufficient unreserved credits: have %d (%d reserved by others), need %d",
+		e.Credits, e.Reserved, e.Need)
+}
+
+type creditReservationKey struct{}
+
+// WithCreditReservation marks ctx as spending against reservation id, so the
+// purchase handlers do not count the caller's own reservation against it.
+func WithCreditReservation(ctx context.Context, id string) context.Context {
+	return context.WithValue(ctx, creditReservationKey{}, id)
+}
+
+// CreditReservationFromContext returns the reservation ctx spends against, or
+// "" when it carries none.
+func CreditReservationFromContext(ctx context.Context) string {
+	id, _ := ctx.Value(creditReservationKey{}).(string)
+	return id
+}
+
+// ReserveForPurchase reserves amount against liveCredits and returns ctx
+// carrying the reservation for the purchase handlers, plus the release to
+// defer once the purchase has settled. A nil reservations returns ctx and a
+// no-op release, so callers need no wiring check of their own.
+func ReserveForPurchase(ctx context.Context, reservations CreditReservations, playerID int, holder string, amount, liveCredits int) (context.Context, func(), error) {
+	if reservations == nil {
+		return ctx, func() {}, nil
+	}
+	reservation, err := reservations.Reserve(ctx, playerID, holder, amount, liveCredits)
+	if err != nil {
+		return ctx, func() {}, err
+	}
+	release := func() { reservations.Release(context.WithoutCancel(ctx), reservation.ID) }
+	return WithCreditReservation(ctx, reservation.ID), release, nil
+}
@@ -0,0 +1,157 @@
+package ledger
+
+import (
+	"fmt"
+	"time"
+)
+
+// Account is an account in the double-entry books derived from the
+// transaction log (see Books).
+type Account string
+
+const (
+	// Assets
+	AccountCash      Account = "CASH"
+	AccountInventory Account = "INVENTORY" // cargo bought for resale, at cost
+	AccountShips     Account = "SHIPS"     // ships bought, at cost
+
+	// Equity
+	AccountOpeningEquity Account = "OPENING_EQUITY" // the credits the log opens with
+
+	// Revenue
+	AccountTradingRevenue    Account = "TRADING_REVENUE"
+	AccountContractRevenue   Account = "CONTRACT_REVENUE"
+	AccountShipDisposalGains Account = "SHIP_DISPOSAL_GAINS"
+	AccountOtherIncome       Account = "OTHER_INCOME" // credits found by reconciliation
+
+	// Expenses
+	AccountCostOfGoodsSold    Account = "COST_OF_GOODS_SOLD"
+	AccountContractCosts      Account = "CONTRACT_COSTS" // cargo bought for contract delivery
+	AccountFuel               Account = "FUEL"
+	AccountMaintenance        Account = "MAINTENANCE"
+	AccountFees               Account = "FEES" // credits lost outside any captured transaction
+	AccountShipDisposalLosses Account = "SHIP_DISPOSAL_LOSSES"
+)
+
+// AccountKind classifies an account on the balance sheet or income statement
+type AccountKind string
+
+const (
+	AccountKindAsset   AccountKind = "ASSET"
+	AccountKindEquity  AccountKind = "EQUITY"
+	AccountKindRevenue AccountKind = "REVENUE"
+	AccountKindExpense AccountKind = "EXPENSE"
+)
+
+// AllAccounts returns every account in chart-of-accounts order
+func AllAccounts() []Account {
+	return []Account{
+		AccountCash,
+		AccountInventory,
+		AccountShips,
+		AccountOpeningEquity,
+		AccountTradingRevenue,
+		AccountContractRevenue,
+		AccountShipDisposalGains,
+		AccountOtherIncome,
+		AccountCostOfGoodsSold,
+		AccountContractCosts,
+		AccountFuel,
+		AccountMaintenance,
+		AccountFees,
+		AccountShipDisposalLosses,
+	}
+}
+
+// Kind returns the account's classification
+func (a Account) Kind() AccountKind {
+	switch a {
+	case AccountCash, AccountInventory, AccountShips:
+		return AccountKindAsset
+	case AccountOpeningEquity:
+		return AccountKindEquity
+	case AccountTradingRevenue, AccountContractRevenue, AccountShipDisposalGains, AccountOtherIncome:
+		return AccountKindRevenue
+	default:
+		return AccountKindExpense
+	}
+}
+
+// DebitNormal reports whether the account grows with debits (assets and
+// expenses) rather than credits (equity and revenue)
+func (a Account) DebitNormal() bool {
+	kind := a.Kind()
+	return kind == AccountKindAsset || kind == AccountKindExpense
+}
+
+// String returns the string representation of the Account
+func (a Account) String() string {
+	return string(a)
+}
+
+// JournalLine is one side of a journal entry: exactly one of Debit and Credit
+// is non-zero
+type JournalLine struct {
+	Account Account
+	Debit   int
+	Credit  int
+}
+
+// JournalEntry is the double-entry form of one transaction
+type JournalEntry struct {
+	TransactionID   TransactionID
+	Timestamp       time.Time
+	TransactionType TransactionType
+	Description     string
+	Lines           []JournalLine
+}
+
+// Totals returns the entry's debit and credit totals
+func (e *JournalEntry) Totals() (debits, credits int) {
+	for _, line := range e.Lines {
+		debits += line.Debit
+		credits += line.Credit
+	}
+	return debits, credits
+}
+
+// IsBalanced reports whether the entry's debits equal its credits
+func (e *JournalEntry) IsBalanced() bool {
+	debits, credits := e.Totals()
+	return debits == credits
+}
+
+func (e *JournalEntry) debit(account Account, amount int) {
+	if amount > 0 {
+		e.Lines = append(e.Lines, JournalLine{Account: account, Debit: amount})
+	} else if amount < 0 {
+		e.Lines = append(e.Lines, JournalLine{Account: account, Credit: -amount})
+	}
+}
+
+func (e *JournalEntry) credit(account Account, amount int) {
+	e.debit(account, -amount)
+}
+
+// expectedSign is the sign each transaction type's amount must carry; a
+// type absent here (BALANCE_ADJUSTMENT) may move credits either way.
+var expectedSign = map[TransactionType]int{
+	TransactionTypeRefuel:            -1,
+	TransactionTypePurchaseCargo:     -1,
+	TransactionTypeSellCargo:         1,
+	TransactionTypePurchaseShip:      -1,
+	TransactionTypeScrapShip:         1,
+	TransactionTypeContractAccepted:  1,
+	TransactionTypeContractFulfilled: 1,
+	TransactionTypeRepairShip:        -1,
+}
+
+// checkSign rejects a transaction whose amount runs against its type, e.g. a
+// refuel that added credits.
+func checkSign(tx *Transaction) error {
+	sign, fixed := expectedSign[tx.TransactionType()]
+	if !fixed || (sign > 0) == (tx.Amount() > 0) {
+		return nil
+	}
+	return fmt.Errorf("%s with amount %d has the wrong sign", tx.TransactionType(), tx.Amount())
+}
@@ -38,6 +38,10 @@ type QueryOptions struct {
 	RelatedEntityType *string
 	RelatedEntityID   *string
 
+	// Cashflow attribution filtering
+	OperationType *string
+	ContainerID   *string
+
 	// Pagination
 	Limit  int
 	Offset int
@@ -54,3 +58,63 @@ func DefaultQueryOptions() QueryOptions {
 		OrderBy: "timestamp DESC",
 	}
 }
+
+// CashflowAlertSink delivers a cashflow alert to an operator-facing output
+// (the daemon log, a webhook, ...).
+type CashflowAlertSink interface {
+	Send(ctx context.Context, alert CashflowAlert) error
+}
+
+// CargoCostBasisRepository persists each ship's per-good cost basis.
+type CargoCostBasisRepository interface {
+	// Find returns the basis for a ship's good, or nil when none is tracked.
+	Find(ctx context.Context, playerID int, shipSymbol, goodSymbol string) (*CargoCostBasis, error)
+
+	// Save upserts the basis; an empty basis is deleted.
+	Save(ctx context.Context, basis *CargoCostBasis) error
+}
+
+// CargoCostBasisRecorder keeps the per-ship cost basis current as cargo moves.
+// Handlers call it after the API has committed the movement; a failure to
+// record never fails the movement itself.
+type CargoCostBasisRecorder interface {
+	// RecordAcquisition adds units bought for totalCost, or mined (totalCost 0).
+	RecordAcquisition(ctx context.Context, playerID int, shipSymbol, goodSymbol string, units, totalCost int) error
+
+	// RecordRemoval releases units sold or jettisoned.
+	RecordRemoval(ctx context.Context, playerID int, shipSymbol, goodSymbol string, units int) error
+
+	// RecordTransfer moves units, with their share of the cost, to another ship.
+	RecordTransfer(ctx context.Context, playerID int, fromShip, toShip, goodSymbol string, units int) error
+}
+
+// CreditReservations tracks credits earmarked by coordinators for purchases
+// they are about to make. Reservations expire on their own.
+type CreditReservations interface {
+	// Reserve earmarks amount for holder when liveCredits, less every other
+	// active reservation, covers it; otherwise it returns
+	// *ErrInsufficientUnreservedCredits.
+	Reserve(ctx context.Context, playerID int, holder string, amount, liveCredits int) (*CreditReservation, error)
+
+	// Release frees a reservation early. Unknown or expired ids are ignored.
+	Release(ctx context.Context, id string)
+
+	// ReservedExcept totals the player's active reservations other than exceptID.
+	ReservedExcept(playerID int, exceptID string) int
+}
+
+// CreditReservationRepository persists credit reservations, so the credits a
+// coordinator holds stay held across a daemon restart until they expire.
+type CreditReservationRepository interface {
+	// Save inserts the reservation.
+	Save(ctx context.Context, reservation *CreditReservation) error
+
+	// Delete drops the reservation with id, if any.
+	Delete(ctx context.Context, id string) error
+
+	// FindActive returns every reservation still unexpired at now.
+	FindActive(ctx context.Context, now time.Time) ([]*CreditReservation, error)
+
+	// DeleteExpired drops every reservation expired at now.
+	DeleteExpired(ctx context.Context, now time.Time) error
+}
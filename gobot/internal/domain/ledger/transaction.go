@@ -23,6 +23,7 @@ type Transaction struct {
 	relatedEntityType string // e.g., "contract", "factory", "ship_purchase"
 	relatedEntityID   string // ID of related entity
 	operationType     string // e.g., "contract", "arbitrage", "rebalancing", "factory"
+	containerID       string // Container whose operation generated the cashflow; empty for manual/unattributed
 }
 
 // NewTransaction creates a new transaction with validation
@@ -38,6 +39,7 @@ func NewTransaction(
 	relatedEntityType string,
 	relatedEntityID string,
 	operationType string,
+	containerID string,
 ) (*Transaction, error) {
 	id := NewTransactionID()
 
@@ -77,6 +79,7 @@ func NewTransaction(
 		relatedEntityType: relatedEntityType,
 		relatedEntityID:   relatedEntityID,
 		operationType:     operationType,
+		containerID:       containerID,
 	}
 
 	if err := t.Validate(); err != nil {
@@ -108,6 +111,7 @@ func ReconstructTransaction(
 	relatedEntityType string,
 	relatedEntityID string,
 	operationType string,
+	containerID string,
 ) *Transaction {
 	// Derive category from type; the stored category column is intentionally not
 	// consulted. For any repository-validated type this cannot fail (every valid
@@ -129,6 +133,7 @@ func ReconstructTransaction(
 		relatedEntityType: relatedEntityType,
 		relatedEntityID:   relatedEntityID,
 		operationType:     operationType,
+		containerID:       containerID,
 	}
 }
 
@@ -226,6 +231,12 @@ func (t *Transaction) OperationType() string {
 	return t.operationType
 }
 
+// ContainerID returns the container that generated the transaction, or empty
+// when it was recorded outside any container (manual CLI operations).
+func (t *Transaction) ContainerID() string {
+	return t.containerID
+}
+
 // Business logic methods
 
 // IsIncome returns true if the transaction represents income
@@ -18,11 +18,22 @@ const (
 	// TransactionTypePurchaseShip represents purchasing a new ship
 	TransactionTypePurchaseShip TransactionType = "PURCHASE_SHIP"
 
+	// TransactionTypeScrapShip represents credits recovered by scrapping a ship
+	TransactionTypeScrapShip TransactionType = "SCRAP_SHIP"
+
 	// TransactionTypeContractAccepted represents payment received when accepting a contract
 	TransactionTypeContractAccepted TransactionType = "CONTRACT_ACCEPTED"
 
 	// TransactionTypeContractFulfilled represents payment received when fulfilling a contract
 	TransactionTypeContractFulfilled TransactionType = "CONTRACT_FULFILLED"
+
+	// TransactionTypeBalanceAdjustment represents a credit reconciliation: the
+	// gap between the API's agent credits and the ledger's running balance,
+	// booked so the ledger matches the API again
+	TransactionTypeBalanceAdjustment TransactionType = "BALANCE_ADJUSTMENT"
+
+	// TransactionTypeRepairShip represents a shipyard repair of a worn ship
+	TransactionTypeRepairShip TransactionType = "REPAIR_SHIP"
 )
 
 // AllTransactionTypes returns all valid transaction types
@@ -32,8 +43,11 @@ func AllTransactionTypes() []TransactionType {
 		TransactionTypePurchaseCargo,
 		TransactionTypeSellCargo,
 		TransactionTypePurchaseShip,
+		TransactionTypeScrapShip,
 		TransactionTypeContractAccepted,
 		TransactionTypeContractFulfilled,
+		TransactionTypeBalanceAdjustment,
+		TransactionTypeRepairShip,
 	}
 }
 
@@ -49,8 +63,11 @@ func (t TransactionType) IsValid() bool {
 		TransactionTypePurchaseCargo,
 		TransactionTypeSellCargo,
 		TransactionTypePurchaseShip,
+		TransactionTypeScrapShip,
 		TransactionTypeContractAccepted,
-		TransactionTypeContractFulfilled:
+		TransactionTypeContractFulfilled,
+		TransactionTypeBalanceAdjustment,
+		TransactionTypeRepairShip:
 		return true
 	default:
 		return false
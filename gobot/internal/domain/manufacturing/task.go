@@ -56,6 +56,10 @@ const (
 
 	// TaskStatusCancelled - Cancelled (pipeline recycled)
 	TaskStatusCancelled TaskStatus = "CANCELLED"
+
+	// TaskStatusDeadLetter - Reclaimed from a dead worker too many times; parked
+	// for an operator instead of being handed to yet another ship
+	TaskStatusDeadLetter TaskStatus = "DEAD_LETTER"
 )
 
 // Task priority constants
@@ -141,6 +145,7 @@ func nowPtr() *time.Time {
 //
 //	PENDING -> READY -> ASSIGNED -> EXECUTING -> COMPLETED
 //	                                        \-> FAILED -> PENDING (retry)
+//	ASSIGNED/EXECUTING (stuck) -> READY (reclaimed) | DEAD_LETTER (retries spent)
 type ManufacturingTask struct {
 	id       string
 	taskType TaskType
@@ -390,9 +395,9 @@ func (t *ManufacturingTask) CanRetry() bool {
 	return t.status == TaskStatusFailed && t.retryCount < t.maxRetries
 }
 
-// IsTerminal returns true if the task is in a terminal state (COMPLETED, CANCELLED, or FAILED with no retries)
+// IsTerminal returns true if the task is in a terminal state (COMPLETED, CANCELLED, DEAD_LETTER, or FAILED with no retries)
 func (t *ManufacturingTask) IsTerminal() bool {
-	if t.status == TaskStatusCompleted || t.status == TaskStatusCancelled {
+	if t.status == TaskStatusCompleted || t.status == TaskStatusCancelled || t.status == TaskStatusDeadLetter {
 		return true
 	}
 	if t.status == TaskStatusFailed && !t.CanRetry() {
@@ -114,3 +114,49 @@ func TestParkForResupply_RejectsTerminalTask(t *testing.T) {
 		t.Fatalf("expected ParkForResupply to reject a COMPLETED task")
 	}
 }
+
+// A task wedged in flight (its worker died) is reclaimed to READY with the ship
+// released, and the reclaim counts against the retry budget.
+func TestReclaimStuck_ReturnsToReadyAndCountsRetry(t *testing.T) {
+	task := NewAcquireDeliverTask("pipeline-1", 1, "COPPER", "X1-TEST-A1", "X1-TEST-F1", nil)
+	_ = task.MarkReady()
+	_ = task.AssignShip("SHIP-1")
+	_ = task.StartExecution()
+
+	deadLettered, err := task.ReclaimStuck("no progress for 2h")
+	if err != nil {
+		t.Fatalf("ReclaimStuck: %v", err)
+	}
+	if deadLettered || task.Status() != TaskStatusReady {
+		t.Fatalf("expected READY after first reclaim, got %s (deadLettered=%t)", task.Status(), deadLettered)
+	}
+	if task.AssignedShip() != "" || task.StartedAt() != nil {
+		t.Fatalf("expected ship released and startedAt cleared, got ship=%q startedAt=%v", task.AssignedShip(), task.StartedAt())
+	}
+	if task.RetryCount() != 1 {
+		t.Fatalf("expected retryCount=1, got %d", task.RetryCount())
+	}
+}
+
+// The reclaim that spends the last retry dead-letters the task instead of handing
+// it to yet another ship.
+func TestReclaimStuck_DeadLettersWhenRetriesSpent(t *testing.T) {
+	task := NewAcquireDeliverTask("pipeline-1", 1, "COPPER", "X1-TEST-A1", "X1-TEST-F1", nil)
+	_ = task.MarkReady()
+	for i := 0; i < DefaultMaxRetries; i++ {
+		_ = task.AssignShip("SHIP-1")
+		deadLettered, err := task.ReclaimStuck("stuck")
+		if err != nil {
+			t.Fatalf("reclaim %d: %v", i+1, err)
+		}
+		if deadLettered != (i == DefaultMaxRetries-1) {
+			t.Fatalf("reclaim %d: deadLettered=%t", i+1, deadLettered)
+		}
+	}
+	if task.Status() != TaskStatusDeadLetter || !task.IsTerminal() {
+		t.Fatalf("expected terminal DEAD_LETTER, got %s", task.Status())
+	}
+	if _, err := task.ReclaimStuck("again"); err == nil {
+		t.Fatalf("a dead-lettered task must not be reclaimed again")
+	}
+}
@@ -5,7 +5,7 @@ import "fmt"
 // State-machine transitions for ManufacturingTask. The entity type, its
 // constructors, and read accessors live in task.go; every method that moves a
 // task through PENDING -> READY -> ASSIGNED -> EXECUTING -> COMPLETED/FAILED
-// (or re-sources it back to PENDING) lives here.
+// (or re-sources it back to PENDING, or reclaims it from a dead worker) lives here.
 
 // State transitions
 
@@ -210,6 +210,36 @@ func (t *ManufacturingTask) ParkForResupply() error {
 	return nil
 }
 
+// ReclaimStuck takes an in-flight (ASSIGNED or EXECUTING) task back from a worker
+// that stopped making progress - typically a ship whose container died - and counts
+// it as a retry. While retries remain the task returns to READY with its ship
+// released, so any ship can pick it up; the retry that reaches maxRetries moves it
+// to DEAD_LETTER instead, so a task that keeps wedging its worker stops consuming
+// ships. Reports whether the task was dead-lettered.
+func (t *ManufacturingTask) ReclaimStuck(reason string) (bool, error) {
+	if t.status != TaskStatusExecuting && t.status != TaskStatusAssigned {
+		return false, &ErrInvalidTaskTransition{
+			TaskID:      t.id,
+			From:        t.status,
+			To:          TaskStatusReady,
+			Description: "can only reclaim EXECUTING or ASSIGNED tasks",
+		}
+	}
+	t.retryCount++
+	t.errorMessage = reason
+	t.assignedShip = ""
+	t.startedAt = nil
+	t.ResetPhaseTracking()
+	if t.retryCount >= t.maxRetries {
+		t.status = TaskStatusDeadLetter
+		t.completedAt = nowPtr()
+		return true, nil
+	}
+	t.status = TaskStatusReady
+	t.readyAt = nowPtr()
+	return false, nil
+}
+
 // ClearSourceForResupply drops the resolved buy source (both source market and
 // factory) from a DELIVER_TO_CONSTRUCTION task whose source turned out to be DRY at
 // execution time - the market was reachable but sold nothing. This reverts the task
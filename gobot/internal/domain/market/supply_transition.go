@@ -0,0 +1,103 @@
+package market
+
+import (
+	"context"
+	"sort"
+	"time"
+)
+
+// SupplyTransition is one observed change of a good's supply level at a
+// market, e.g. SCARCE → MODERATE. Recorded by the market scanner when a scan
+// reports a different supply than the previous one.
+type SupplyTransition struct {
+	PlayerID       int
+	WaypointSymbol string
+	GoodSymbol     string
+	FromSupply     string
+	ToSupply       string
+	TransitionedAt time.Time
+}
+
+// SupplyTransitionRepository persists supply transitions.
+type SupplyTransitionRepository interface {
+	Record(ctx context.Context, transition SupplyTransition) error
+
+	// ListTransitions returns playerID's transitions at or after since, oldest
+	// first. An empty waypointSymbol or goodSymbol matches every market or good.
+	ListTransitions(ctx context.Context, playerID int, waypointSymbol, goodSymbol string, since time.Time) ([]SupplyTransition, error)
+}
+
+// SupplyTransitionStats summarises the transitions of one good at one market.
+type SupplyTransitionStats struct {
+	WaypointSymbol     string
+	GoodSymbol         string
+	Transitions        int
+	TransitionsPerHour float64
+	// Edges counts transitions by "FROM->TO".
+	Edges map[string]int
+	// AverageDwell is how long the supply stayed at each level, averaged over
+	// stays that began and ended inside the window. A level only ever entered
+	// last has no complete stay and is absent.
+	AverageDwell   map[string]time.Duration
+	LastTransition time.Time
+	CurrentSupply  string
+}
+
+// SummarizeSupplyTransitions groups transitions by (market, good) and returns
+// their stats, busiest first. The transition rate is measured over since..now,
+// or from each pair's first transition when since is zero.
+func SummarizeSupplyTransitions(transitions []SupplyTransition, since, now time.Time) []SupplyTransitionStats {
+	type pairKey struct{ waypoint, good string }
+	byPair := make(map[pairKey][]SupplyTransition)
+	for _, t := range transitions {
+		key := pairKey{t.WaypointSymbol, t.GoodSymbol}
+		byPair[key] = append(byPair[key], t)
+	}
+
+	stats := make([]SupplyTransitionStats, 0, len(byPair))
+	for key, pair := range byPair {
+		sort.SliceStable(pair, func(i, j int) bool { return pair[i].TransitionedAt.Before(pair[j].TransitionedAt) })
+		s := SupplyTransitionStats{
+			WaypointSymbol: key.waypoint,
+			GoodSymbol:     key.good,
+			Transitions:    len(pair),
+			Edges:          make(map[string]int),
+			AverageDwell:   make(map[string]time.Duration),
+			LastTransition: pair[len(pair)-1].TransitionedAt,
+			CurrentSupply:  pair[len(pair)-1].ToSupply,
+		}
+
+		dwellTotal := make(map[string]time.Duration)
+		dwellCount := make(map[string]int)
+		for i, t := range pair {
+			s.Edges[t.FromSupply+"->"+t.ToSupply]++
+			if i > 0 {
+				dwellTotal[t.FromSupply] += t.TransitionedAt.Sub(pair[i-1].TransitionedAt)
+				dwellCount[t.FromSupply]++
+			}
+		}
+		for level, total := range dwellTotal {
+			s.AverageDwell[level] = total / time.Duration(dwellCount[level])
+		}
+
+		start := since
+		if start.IsZero() {
+			start = pair[0].TransitionedAt
+		}
+		if hours := now.Sub(start).Hours(); hours > 0 {
+			s.TransitionsPerHour = float64(len(pair)) / hours
+		}
+		stats = append(stats, s)
+	}
+
+	sort.Slice(stats, func(i, j int) bool {
+		if stats[i].Transitions != stats[j].Transitions {
+			return stats[i].Transitions > stats[j].Transitions
+		}
+		if stats[i].WaypointSymbol != stats[j].WaypointSymbol {
+			return stats[i].WaypointSymbol < stats[j].WaypointSymbol
+		}
+		return stats[i].GoodSymbol < stats[j].GoodSymbol
+	})
+	return stats
+}
@@ -0,0 +1,43 @@
+package market
+
+import (
+	"testing"
+	"time"
+)
+
+func TestSummarizeSupplyTransitions_DwellAndFrequencyPerMarketGood(t *testing.T) {
+	start := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	at := func(minutes int) time.Time { return start.Add(time.Duration(minutes) * time.Minute) }
+	transitions := []SupplyTransition{
+		{WaypointSymbol: "X1-A-F1", GoodSymbol: "FABRICS", FromSupply: "SCARCE", ToSupply: "MODERATE", TransitionedAt: at(0)},
+		{WaypointSymbol: "X1-A-F1", GoodSymbol: "FABRICS", FromSupply: "MODERATE", ToSupply: "HIGH", TransitionedAt: at(30)},
+		{WaypointSymbol: "X1-A-F1", GoodSymbol: "FABRICS", FromSupply: "HIGH", ToSupply: "MODERATE", TransitionedAt: at(40)},
+		{WaypointSymbol: "X1-A-F1", GoodSymbol: "FABRICS", FromSupply: "MODERATE", ToSupply: "HIGH", TransitionedAt: at(90)},
+		{WaypointSymbol: "X1-A-F2", GoodSymbol: "FABRICS", FromSupply: "LIMITED", ToSupply: "MODERATE", TransitionedAt: at(60)},
+	}
+
+	stats := SummarizeSupplyTransitions(transitions, start, at(120))
+	if len(stats) != 2 {
+		t.Fatalf("expected one entry per market/good, got %d", len(stats))
+	}
+	busiest := stats[0]
+	if busiest.WaypointSymbol != "X1-A-F1" || busiest.Transitions != 4 {
+		t.Fatalf("expected X1-A-F1 first with 4 transitions, got %+v", busiest)
+	}
+	if busiest.TransitionsPerHour != 2 {
+		t.Fatalf("4 transitions over 2h is 2/h, got %v", busiest.TransitionsPerHour)
+	}
+	if busiest.Edges["MODERATE->HIGH"] != 2 {
+		t.Fatalf("expected two MODERATE->HIGH edges, got %v", busiest.Edges)
+	}
+	// MODERATE stays: 0→30 and 40→90, so 40 minutes on average; HIGH: 30→40.
+	if busiest.AverageDwell["MODERATE"] != 40*time.Minute || busiest.AverageDwell["HIGH"] != 10*time.Minute {
+		t.Fatalf("unexpected dwell %v", busiest.AverageDwell)
+	}
+	if _, ok := busiest.AverageDwell["SCARCE"]; ok {
+		t.Fatal("SCARCE was never entered inside the window, so it has no complete stay")
+	}
+	if busiest.CurrentSupply != "HIGH" {
+		t.Fatalf("expected current supply HIGH, got %s", busiest.CurrentSupply)
+	}
+}
@@ -0,0 +1,33 @@
+package navigation
+
+import (
+	"context"
+	"time"
+)
+
+// CommandIdempotencyRecord is the finished result of one keyed ship command,
+// kept so a retry with the same key replays it even after a daemon restart.
+// Scope names the command kind; Response is the kind's own encoding.
+type CommandIdempotencyRecord struct {
+	Scope       string
+	Key         string
+	ShipSymbol  string
+	Destination string
+	Response    []byte
+	RecordedAt  time.Time
+}
+
+// CommandIdempotencyRepository persists finished keyed ship command results.
+type CommandIdempotencyRepository interface {
+	// Save inserts or replaces the record for (Scope, Key).
+	Save(ctx context.Context, record CommandIdempotencyRecord) error
+
+	// Delete drops the record for (scope, key), if any.
+	Delete(ctx context.Context, scope, key string) error
+
+	// FindSince returns scope's records recorded at or after since.
+	FindSince(ctx context.Context, scope string, since time.Time) ([]CommandIdempotencyRecord, error)
+
+	// DeleteBefore drops scope's records recorded before cutoff.
+	DeleteBefore(ctx context.Context, scope string, cutoff time.Time) error
+}
@@ -0,0 +1,182 @@
+package navigation
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/andrescamacho/spacetraders-go/internal/domain/shared"
+)
+
+// ETARefuelStopOverhead is the time an estimate allows for each refuel stop:
+// dock, refuel and orbit again.
+const ETARefuelStopOverhead = 30 * time.Second
+
+// ETAQuery describes one journey to estimate. FuelCapacity 0 means the ship
+// burns no fuel (probes), so no refuel stops are ever planned.
+type ETAQuery struct {
+	From         *shared.Waypoint
+	To           *shared.Waypoint
+	EngineSpeed  int
+	Fuel         int
+	FuelCapacity int
+	// FuelStations are the waypoints a refuel stop may be made at; From is
+	// used too when it sells fuel.
+	FuelStations []*shared.Waypoint
+	// Departure is when the ship can leave From — the end of its current
+	// transit, or now.
+	Departure time.Time
+}
+
+// ETALeg is one flown leg of an estimate.
+type ETALeg struct {
+	From     string
+	To       string
+	Mode     shared.FlightMode
+	Distance float64
+	Fuel     int
+	Seconds  int
+}
+
+// ETA is the estimate for one journey.
+type ETA struct {
+	Destination  string
+	Arrival      time.Time
+	Duration     time.Duration // from Departure to Arrival, refuel stops included
+	FuelRequired int
+	RefuelStops  []string // waypoints refuelled at, in order
+	Legs         []ETALeg
+}
+
+// ETAService is the fleet's one arrival estimator, so stuck detection, contract
+// deadlines and CLI displays agree on when a ship gets somewhere.
+//
+// Journeys are flown in CRUISE, the mode the fleet plans around. When the tank
+// cannot cover the next hop, the ship refuels where it stands if fuel is sold
+// there, otherwise hops to the reachable fuel station nearest the destination;
+// with no station in reach it DRIFTs the rest of the way.
+type ETAService struct {
+	fuel *ShipFuelService
+}
+
+// NewETAService creates an estimator whose fuel costs come from fuel. A nil
+// fuel service uses the uncorrected formulas.
+func NewETAService(fuel *ShipFuelService) *ETAService {
+	if fuel == nil {
+		fuel = NewShipFuelService(nil)
+	}
+	return &ETAService{fuel: fuel}
+}
+
+// Estimate returns the ETA for q, or an error when the destination cannot be
+// reached even by drifting.
+func (s *ETAService) Estimate(q ETAQuery) (*ETA, error) {
+	if q.From == nil || q.To == nil {
+		return nil, fmt.Errorf("eta needs both an origin and a destination")
+	}
+	eta := &ETA{Destination: q.To.Symbol}
+	pos, fuel := q.From, q.Fuel
+	refuelledAt := map[string]bool{}
+
+	for {
+		distance := pos.DistanceTo(q.To)
+		if pos.Symbol == q.To.Symbol || distance == 0 {
+			break
+		}
+		if cost := s.fuel.fuelCost(shared.FlightModeCruise, distance); q.FuelCapacity <= 0 || cost <= fuel {
+			s.fly(eta, pos, q.To, shared.FlightModeCruise, distance, q.EngineSpeed, q.FuelCapacity)
+			break
+		}
+		if pos.HasFuel && !refuelledAt[pos.Symbol] && fuel < q.FuelCapacity {
+			refuelledAt[pos.Symbol] = true
+			eta.RefuelStops = append(eta.RefuelStops, pos.Symbol)
+			eta.Duration += ETARefuelStopOverhead
+			fuel = q.FuelCapacity
+			continue
+		}
+		if next := s.nextFuelStop(pos, q.To, fuel, q.FuelStations); next != nil {
+			fuel -= s.fly(eta, pos, next, shared.FlightModeCruise, pos.DistanceTo(next), q.EngineSpeed, q.FuelCapacity)
+			pos = next
+			continue
+		}
+		if s.fuel.fuelCost(shared.FlightModeDrift, distance) > fuel {
+			return nil, fmt.Errorf("%s is out of range of %s with %d fuel and no fuel station in reach", q.To.Symbol, pos.Symbol, fuel)
+		}
+		s.fly(eta, pos, q.To, shared.FlightModeDrift, distance, q.EngineSpeed, q.FuelCapacity)
+		break
+	}
+
+	eta.Arrival = q.Departure.Add(eta.Duration)
+	return eta, nil
+}
+
+// EstimateForShip returns the ETA for ship to reach destination from its
+// current state: a ship in transit departs from where it is heading once its
+// current leg lands.
+func (s *ETAService) EstimateForShip(ship *Ship, destination *shared.Waypoint, fuelStations []*shared.Waypoint, now time.Time) (*ETA, error) {
+	departure := now
+	if remaining, ok := s.TransitRemaining(ship, now); ok && remaining > 0 {
+		departure = now.Add(remaining)
+	}
+	return s.Estimate(ETAQuery{
+		From:         ship.CurrentLocation(),
+		To:           destination,
+		EngineSpeed:  ship.EngineSpeed(),
+		Fuel:         ship.Fuel().Current,
+		FuelCapacity: ship.Fuel().Capacity,
+		FuelStations: fuelStations,
+		Departure:    departure,
+	})
+}
+
+// TransitRemaining is how long ship's current transit has left, negative once
+// its arrival time has passed. ok is false when the ship is not in transit or
+// its arrival time is unknown.
+func (s *ETAService) TransitRemaining(ship *Ship, now time.Time) (time.Duration, bool) {
+	if ship.NavStatus() != NavStatusInTransit {
+		return 0, false
+	}
+	return RemainingUntilArrival(ship.ArrivalTime(), now)
+}
+
+// RemainingUntilArrival is the time from now to arrival, negative once it has
+// passed; ok is false for an unknown arrival. It serves callers that hold only
+// a persisted arrival timestamp rather than a Ship.
+func RemainingUntilArrival(arrival *time.Time, now time.Time) (time.Duration, bool) {
+	if arrival == nil || arrival.IsZero() {
+		return 0, false
+	}
+	return arrival.Sub(now), true
+}
+
+// fly appends one leg to eta and returns the fuel it burns.
+func (s *ETAService) fly(eta *ETA, from, to *shared.Waypoint, mode shared.FlightMode, distance float64, engineSpeed, fuelCapacity int) int {
+	fuel := 0
+	if fuelCapacity > 0 {
+		fuel = s.fuel.fuelCost(mode, distance)
+	}
+	seconds := mode.TravelTime(distance, engineSpeed)
+	eta.Legs = append(eta.Legs, ETALeg{From: from.Symbol, To: to.Symbol, Mode: mode, Distance: distance, Fuel: fuel, Seconds: seconds})
+	eta.FuelRequired += fuel
+	eta.Duration += time.Duration(seconds) * time.Second
+	return fuel
+}
+
+// nextFuelStop picks the fuel station reachable in CRUISE on fuel that lies
+// closest to the destination, provided it is closer than pos; nil when none
+// makes progress.
+func (s *ETAService) nextFuelStop(pos, destination *shared.Waypoint, fuel int, stations []*shared.Waypoint) *shared.Waypoint {
+	var best *shared.Waypoint
+	bestRemaining := pos.DistanceTo(destination)
+	for _, station := range stations {
+		if station == nil || !station.HasFuel || station.Symbol == pos.Symbol {
+			continue
+		}
+		if s.fuel.fuelCost(shared.FlightModeCruise, pos.DistanceTo(station)) > fuel {
+			continue
+		}
+		if remaining := station.DistanceTo(destination); remaining < bestRemaining {
+			best, bestRemaining = station, remaining
+		}
+	}
+	return best
+}
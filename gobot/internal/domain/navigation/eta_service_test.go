@@ -0,0 +1,112 @@
+package navigation_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/andrescamacho/spacetraders-go/internal/domain/navigation"
+	"github.com/andrescamacho/spacetraders-go/internal/domain/shared"
+)
+
+func etaWaypoint(t *testing.T, symbol string, x float64, hasFuel bool) *shared.Waypoint {
+	t.Helper()
+	wp, err := shared.NewWaypoint(symbol, x, 0)
+	if err != nil {
+		t.Fatalf("NewWaypoint: %v", err)
+	}
+	wp.HasFuel = hasFuel
+	return wp
+}
+
+func TestETAService_DirectCruiseWhenTheTankCoversIt(t *testing.T) {
+	depart := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+	eta, err := navigation.NewETAService(nil).Estimate(navigation.ETAQuery{
+		From:         etaWaypoint(t, "X1-A-A1", 0, false),
+		To:           etaWaypoint(t, "X1-A-B1", 100, false),
+		EngineSpeed:  10,
+		Fuel:         200,
+		FuelCapacity: 400,
+		Departure:    depart,
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	// 100u in CRUISE at speed 10: 100*31/10 = 310s and 100 fuel.
+	if eta.Duration != 310*time.Second || !eta.Arrival.Equal(depart.Add(310*time.Second)) {
+		t.Errorf("duration %s arrival %s, want 310s after departure", eta.Duration, eta.Arrival)
+	}
+	if eta.FuelRequired != 100 || len(eta.RefuelStops) != 0 || len(eta.Legs) != 1 {
+		t.Errorf("unexpected plan: %+v", eta)
+	}
+}
+
+func TestETAService_HopsToTheFuelStationNearestTheDestination(t *testing.T) {
+	near := etaWaypoint(t, "X1-A-F1", 100, true)
+	tooFar := etaWaypoint(t, "X1-A-F2", 250, true)
+	eta, err := navigation.NewETAService(nil).Estimate(navigation.ETAQuery{
+		From:         etaWaypoint(t, "X1-A-A1", 0, false),
+		To:           etaWaypoint(t, "X1-A-B1", 300, false),
+		EngineSpeed:  10,
+		Fuel:         150,
+		FuelCapacity: 200,
+		FuelStations: []*shared.Waypoint{tooFar, near},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(eta.RefuelStops) != 1 || eta.RefuelStops[0] != near.Symbol {
+		t.Fatalf("expected one refuel at %s, got %v", near.Symbol, eta.RefuelStops)
+	}
+	// 310s to the station, the refuel stop, then 620s for the last 200u.
+	want := 930*time.Second + navigation.ETARefuelStopOverhead
+	if eta.Duration != want || eta.FuelRequired != 300 {
+		t.Errorf("duration %s fuel %d, want %s and 300", eta.Duration, eta.FuelRequired, want)
+	}
+}
+
+func TestETAService_DriftsWhenNoStationIsInReach(t *testing.T) {
+	service := navigation.NewETAService(nil)
+	query := navigation.ETAQuery{
+		From:         etaWaypoint(t, "X1-A-A1", 0, false),
+		To:           etaWaypoint(t, "X1-A-B1", 300, false),
+		EngineSpeed:  10,
+		Fuel:         5,
+		FuelCapacity: 200,
+	}
+	eta, err := service.Estimate(query)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(eta.Legs) != 1 || eta.Legs[0].Mode != shared.FlightModeDrift || eta.Duration != 7500*time.Second {
+		t.Errorf("expected one 7500s DRIFT leg, got %+v", eta.Legs)
+	}
+
+	query.Fuel = 0
+	if _, err := service.Estimate(query); err == nil {
+		t.Error("an empty tank with no fuel in reach must be unreachable")
+	}
+}
+
+func TestETAService_ShipInTransitDepartsWhenItLands(t *testing.T) {
+	now := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+	arrival := now.Add(2 * time.Minute)
+	if remaining, ok := navigation.RemainingUntilArrival(&arrival, now); !ok || remaining != 2*time.Minute {
+		t.Errorf("remaining %s ok %v, want 2m", remaining, ok)
+	}
+	if remaining, _ := navigation.RemainingUntilArrival(&arrival, now.Add(5*time.Minute)); remaining != -3*time.Minute {
+		t.Errorf("an overdue arrival must report a negative remainder, got %s", remaining)
+	}
+	if _, ok := navigation.RemainingUntilArrival(nil, now); ok {
+		t.Error("an unknown arrival must report ok=false")
+	}
+
+	ship := newFuelTestShip(t, 100, 100)
+	eta, err := navigation.NewETAService(nil).EstimateForShip(ship, etaWaypoint(t, "X1-AU21-B9", 90, false), nil, now)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	// A ship in orbit leaves now: 90u in CRUISE at speed 9 is 310s.
+	if !eta.Arrival.Equal(now.Add(310 * time.Second)) {
+		t.Errorf("arrival %s, want 310s from now", eta.Arrival)
+	}
+}
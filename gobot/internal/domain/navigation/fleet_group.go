@@ -0,0 +1,39 @@
+package navigation
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/andrescamacho/spacetraders-go/internal/domain/shared"
+)
+
+// FleetGroup is a named set of ships an operator manages as one unit
+// ("mining-alpha", "gate-probes"). Membership is the ship tag of the same name,
+// so a group is built with 'ship tag add' and persists in ship_tags; the group
+// adds the unit-level operations on top — dedicating every member to one
+// coordinator, repositioning them together, releasing them back to the pool.
+type FleetGroup struct {
+	Name  string
+	Ships []string // member ship symbols, in symbol order
+}
+
+// LoadFleetGroup resolves the named group's members. A group with no members is
+// an error, so a misspelled name never turns a group operation into a silent
+// no-op.
+func LoadFleetGroup(ctx context.Context, tags ShipTagRepository, playerID shared.PlayerID, name string) (*FleetGroup, error) {
+	if tags == nil {
+		return nil, fmt.Errorf("fleet group %q given but ship tags are not available", name)
+	}
+	normalized, err := NormalizeShipTag(name)
+	if err != nil {
+		return nil, err
+	}
+	ships, err := tags.FindShipsByTag(ctx, playerID, normalized)
+	if err != nil {
+		return nil, fmt.Errorf("failed to resolve fleet group %q: %w", normalized, err)
+	}
+	if len(ships) == 0 {
+		return nil, fmt.Errorf("fleet group %q has no ships (add some with 'ship tag add --tag %s')", normalized, normalized)
+	}
+	return &FleetGroup{Name: normalized, Ships: ships}, nil
+}
@@ -0,0 +1,31 @@
+package navigation
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/andrescamacho/spacetraders-go/internal/domain/shared"
+)
+
+// A group is its tag's ships, looked up case-insensitively; an empty or
+// unavailable group is an error.
+func TestLoadFleetGroup(t *testing.T) {
+	tags := stubShipTags{"mining-alpha": {"AGENT-4", "AGENT-5"}}
+	playerID := shared.MustNewPlayerID(1)
+
+	group, err := LoadFleetGroup(context.Background(), tags, playerID, "Mining-Alpha")
+	if err != nil {
+		t.Fatalf("load: %v", err)
+	}
+	if group.Name != "mining-alpha" || !reflect.DeepEqual(group.Ships, []string{"AGENT-4", "AGENT-5"}) {
+		t.Fatalf("unexpected group %+v", group)
+	}
+
+	if _, err := LoadFleetGroup(context.Background(), tags, playerID, "mining-beta"); err == nil {
+		t.Fatal("expected an error for a group with no ships")
+	}
+	if _, err := LoadFleetGroup(context.Background(), nil, playerID, "mining-alpha"); err == nil {
+		t.Fatal("expected an error when tags are not wired")
+	}
+}
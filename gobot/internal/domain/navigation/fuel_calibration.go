@@ -0,0 +1,132 @@
+package navigation
+
+import (
+	"context"
+	"math"
+	"time"
+
+	"github.com/andrescamacho/spacetraders-go/internal/domain/shared"
+)
+
+const (
+	// DefaultFuelCalibrationMinSamples is how many observations a flight mode
+	// needs before its correction factor moves off 1.0.
+	DefaultFuelCalibrationMinSamples = 20
+
+	// Correction factors are clamped to this band so a handful of odd API
+	// results cannot make refuel planning wildly optimistic or pessimistic.
+	minFuelCorrectionFactor = 0.5
+	maxFuelCorrectionFactor = 2.0
+)
+
+// FuelObservation is one navigation's fuel use: what the theoretical formula
+// (FlightMode.FuelCost) predicted for the distance and what the API actually
+// consumed.
+type FuelObservation struct {
+	ShipSymbol string
+	Mode       shared.FlightMode
+	Distance   float64
+	Predicted  int
+	Actual     int
+	ObservedAt time.Time
+}
+
+// FuelObservationRepository persists fuel observations for calibration fits.
+type FuelObservationRepository interface {
+	Record(ctx context.Context, observation FuelObservation) error
+
+	// FindRecent returns up to limit observations of mode, newest first.
+	FindRecent(ctx context.Context, mode shared.FlightMode, limit int) ([]FuelObservation, error)
+}
+
+// FuelObserver is notified of every completed navigation's fuel use.
+type FuelObserver interface {
+	ObserveFuel(ctx context.Context, observation FuelObservation)
+}
+
+// FuelCalibrationSource supplies the calibration a ShipFuelService applies. It
+// is read on every estimate, so a source that refits keeps its services current.
+type FuelCalibrationSource interface {
+	Calibration() *FuelCalibration
+}
+
+// FuelCalibration holds per-flight-mode correction factors applied on top of
+// the theoretical fuel formula. A mode without a factor is uncorrected.
+type FuelCalibration struct {
+	factors map[shared.FlightMode]float64
+}
+
+// NewFuelCalibration creates a calibration from per-mode factors, clamped to the
+// allowed band.
+func NewFuelCalibration(factors map[shared.FlightMode]float64) *FuelCalibration {
+	clamped := make(map[shared.FlightMode]float64, len(factors))
+	for mode, factor := range factors {
+		clamped[mode] = math.Min(math.Max(factor, minFuelCorrectionFactor), maxFuelCorrectionFactor)
+	}
+	return &FuelCalibration{factors: clamped}
+}
+
+// Calibration returns c itself, so a fixed fit can be handed to
+// NewShipFuelService as its source.
+func (c *FuelCalibration) Calibration() *FuelCalibration {
+	return c
+}
+
+// Factor returns the correction factor for mode, 1.0 when uncalibrated.
+func (c *FuelCalibration) Factor(mode shared.FlightMode) float64 {
+	if c == nil {
+		return 1.0
+	}
+	if factor, ok := c.factors[mode]; ok {
+		return factor
+	}
+	return 1.0
+}
+
+// FuelCost is the calibrated fuel cost of flying distance in mode: the
+// theoretical cost scaled by the mode's factor, rounded up, and never below 1
+// for a non-zero trip.
+func (c *FuelCalibration) FuelCost(mode shared.FlightMode, distance float64) int {
+	predicted := mode.FuelCost(distance)
+	factor := c.Factor(mode)
+	if predicted == 0 || factor == 1.0 {
+		return predicted
+	}
+	return max(int(math.Ceil(float64(predicted)*factor)), 1)
+}
+
+// FitFuelCalibration fits one factor per flight mode by least squares through
+// the origin (actual ≈ factor × predicted). A mode with fewer than minSamples
+// usable observations keeps no factor; observations with no prediction or no
+// consumption are ignored.
+func FitFuelCalibration(observations []FuelObservation, minSamples int) *FuelCalibration {
+	type sums struct {
+		predictedActual float64
+		predictedSq     float64
+		n               int
+	}
+	byMode := make(map[shared.FlightMode]*sums)
+	for _, obs := range observations {
+		if obs.Predicted <= 0 || obs.Actual <= 0 {
+			continue
+		}
+		s := byMode[obs.Mode]
+		if s == nil {
+			s = &sums{}
+			byMode[obs.Mode] = s
+		}
+		p, a := float64(obs.Predicted), float64(obs.Actual)
+		s.predictedActual += p * a
+		s.predictedSq += p * p
+		s.n++
+	}
+
+	factors := make(map[shared.FlightMode]float64)
+	for mode, s := range byMode {
+		if s.n < minSamples || s.predictedSq == 0 {
+			continue
+		}
+		factors[mode] = s.predictedActual / s.predictedSq
+	}
+	return NewFuelCalibration(factors)
+}
@@ -0,0 +1,55 @@
+package navigation
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/andrescamacho/spacetraders-go/internal/domain/shared"
+)
+
+func cruiseObservations(n, predicted, actual int) []FuelObservation {
+	out := make([]FuelObservation, n)
+	for i := range out {
+		out[i] = FuelObservation{Mode: shared.FlightModeCruise, Distance: float64(predicted), Predicted: predicted, Actual: actual}
+	}
+	return out
+}
+
+// The fit is actual ≈ factor × predicted per mode; a mode short of samples stays at 1.0.
+func TestFitFuelCalibration_PerModeFactor(t *testing.T) {
+	observations := append(cruiseObservations(20, 100, 110),
+		FuelObservation{Mode: shared.FlightModeBurn, Predicted: 200, Actual: 150},
+	)
+
+	calibration := FitFuelCalibration(observations, 20)
+
+	require.InDelta(t, 1.1, calibration.Factor(shared.FlightModeCruise), 1e-9)
+	require.Equal(t, 1.0, calibration.Factor(shared.FlightModeBurn), "one BURN sample is below minSamples")
+	require.Equal(t, 1.0, calibration.Factor(shared.FlightModeDrift))
+}
+
+// Outliers cannot push a factor outside the clamp band, and observations with
+// no prediction or consumption are ignored.
+func TestFitFuelCalibration_ClampsAndSkipsEmpty(t *testing.T) {
+	observations := append(cruiseObservations(5, 10, 90), FuelObservation{Mode: shared.FlightModeCruise, Predicted: 0, Actual: 5})
+
+	calibration := FitFuelCalibration(observations, 5)
+
+	require.Equal(t, maxFuelCorrectionFactor, calibration.Factor(shared.FlightModeCruise))
+}
+
+// ShipFuelService estimates scale with the calibration, rounding up.
+func TestShipFuelService_AppliesCalibration(t *testing.T) {
+	from, _ := shared.NewWaypoint("X1-A", 0, 0)
+	to, _ := shared.NewWaypoint("X1-B", 100, 0)
+	calibration := NewFuelCalibration(map[shared.FlightMode]float64{shared.FlightModeCruise: 1.105})
+
+	require.Equal(t, 100, NewShipFuelService(nil).CalculateFuelRequired(from, to, shared.FlightModeCruise))
+	require.Equal(t, 111, NewShipFuelService(calibration).CalculateFuelRequired(from, to, shared.FlightModeCruise))
+	require.Equal(t, 200, NewShipFuelService(calibration).CalculateFuelRequired(from, to, shared.FlightModeBurn))
+
+	var unfitted *FuelCalibration
+	require.Equal(t, 100, NewShipFuelService(unfitted).CalculateFuelRequired(from, to, shared.FlightModeCruise),
+		"a source without a fit yet is uncorrected")
+}
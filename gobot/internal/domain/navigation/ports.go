@@ -27,6 +27,10 @@ type ShipQueryRepository interface {
 	// GetShipData retrieves raw ship data from API (includes arrival time for IN_TRANSIT ships)
 	GetShipData(ctx context.Context, symbol string, playerID shared.PlayerID) (*ShipData, error)
 
+	// GetShipNav retrieves only the ship's nav block from API - the cheap read
+	// for callers that need nav status or arrival time and nothing else
+	GetShipNav(ctx context.Context, symbol string, playerID shared.PlayerID) (*ShipNavData, error)
+
 	// FindAllByPlayer retrieves all ships for a player (from API with waypoint reconstruction)
 	FindAllByPlayer(ctx context.Context, playerID shared.PlayerID) ([]*Ship, error)
 }
@@ -50,6 +54,10 @@ type ShipCommandRepository interface {
 	// Returns RefuelResult with actual cost from API
 	Refuel(ctx context.Context, ship *Ship, playerID shared.PlayerID, units *int) (*RefuelResult, error)
 
+	// RefuelFromCargo burns cargoUnits of the FUEL carried in the ship's own hold
+	// into its tank (updates via API). No credits change hands.
+	RefuelFromCargo(ctx context.Context, ship *Ship, playerID shared.PlayerID, cargoUnits int) (*RefuelResult, error)
+
 	// SetFlightMode sets the ship's flight mode (updates via API)
 	SetFlightMode(ctx context.Context, ship *Ship, playerID shared.PlayerID, mode string) error
 }
@@ -312,7 +320,28 @@ type ShipData struct {
 	CrewCurrent         int
 	CrewRequired        int
 	CrewCapacity        int
-	Cargo               *CargoData
+	// Component wear (0-1) as reported by the API. All zero when the
+	// response carried no condition block.
+	FrameCondition   float64
+	FrameIntegrity   float64
+	ReactorCondition float64
+	ReactorIntegrity float64
+	EngineCondition  float64
+	EngineIntegrity  float64
+	Cargo            *CargoData
+}
+
+// ShipNavData is the nav block of a ship on its own, as returned by the
+// API's GET /my/ships/{symbol}/nav. Field meanings match ShipData's.
+type ShipNavData struct {
+	Symbol        string
+	SystemSymbol  string
+	Location      string
+	NavStatus     string
+	FlightMode    string
+	ArrivalTime   string
+	OriginSymbol  string
+	DepartureTime string
 }
 
 type ModuleData struct {
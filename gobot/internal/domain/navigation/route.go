@@ -27,6 +27,10 @@ type RouteSegment struct {
 	TravelTime     int
 	FlightMode     shared.FlightMode
 	RequiresRefuel bool
+	// OrbitalHop marks a zero-distance move between orbital neighbours (see IsOrbitalHop).
+	// It costs no fuel, so the executor runs it as a bare navigate with no refuel or
+	// flight-mode decisions.
+	OrbitalHop bool
 }
 
 func NewRouteSegment(
@@ -47,11 +51,32 @@ func NewRouteSegment(
 	}
 }
 
+// IsOrbitalHop reports whether a move from → to is a hop between orbital neighbours: two
+// distinct waypoints at the same coordinates where one lists the other among its orbitals,
+// or both are orbitals of a common parent found in waypoints (which may be nil).
+func IsOrbitalHop(from, to *shared.Waypoint, waypoints map[string]*shared.Waypoint) bool {
+	if from == nil || to == nil || from.Symbol == to.Symbol || from.DistanceTo(to) != 0 {
+		return false
+	}
+	if from.HasOrbital(to.Symbol) || to.HasOrbital(from.Symbol) {
+		return true
+	}
+	for _, parent := range waypoints {
+		if parent.HasOrbital(from.Symbol) && parent.HasOrbital(to.Symbol) {
+			return true
+		}
+	}
+	return false
+}
+
 func (r *RouteSegment) String() string {
 	refuel := ""
 	if r.RequiresRefuel {
 		refuel = " [REFUEL]"
 	}
+	if r.OrbitalHop {
+		refuel = " [ORBITAL]" + refuel
+	}
 	return fmt.Sprintf("%s → %s (%.1fu, %d⛽, %s)%s",
 		r.FromWaypoint.Symbol, r.ToWaypoint.Symbol,
 		r.Distance, r.FuelRequired, r.FlightMode, refuel)
@@ -64,3 +64,32 @@ func TestFailRouteMarksExecutingRouteFailed(t *testing.T) {
 		t.Fatal("expected LastError to be recorded")
 	}
 }
+
+func TestIsOrbitalHopDetectsParentChildAndSiblingOrbitals(t *testing.T) {
+	planet := &shared.Waypoint{Symbol: "X1-A1", X: 5, Y: 5, Orbitals: []string{"X1-A1M", "X1-A1S"}}
+	moon := &shared.Waypoint{Symbol: "X1-A1M", X: 5, Y: 5}
+	station := &shared.Waypoint{Symbol: "X1-A1S", X: 5, Y: 5}
+	stranger := &shared.Waypoint{Symbol: "X1-B2", X: 5, Y: 5}
+	distant := &shared.Waypoint{Symbol: "X1-C3", X: 40, Y: 5, Orbitals: []string{"X1-A1"}}
+	all := map[string]*shared.Waypoint{"X1-A1": planet, "X1-A1M": moon, "X1-A1S": station, "X1-B2": stranger}
+
+	cases := []struct {
+		name      string
+		from, to  *shared.Waypoint
+		waypoints map[string]*shared.Waypoint
+		want      bool
+	}{
+		{"parent to orbital", planet, moon, nil, true},
+		{"orbital to parent", moon, planet, nil, true},
+		{"siblings via parent", moon, station, all, true},
+		{"siblings without parent data", moon, station, nil, false},
+		{"same coordinates, no orbital link", planet, stranger, all, false},
+		{"listed orbital at a distance", distant, planet, all, false},
+		{"same waypoint", planet, planet, all, false},
+	}
+	for _, tc := range cases {
+		if got := IsOrbitalHop(tc.from, tc.to, tc.waypoints); got != tc.want {
+			t.Errorf("%s: IsOrbitalHop = %v, want %v", tc.name, got, tc.want)
+		}
+	}
+}
@@ -83,6 +83,9 @@ type Ship struct {
 	crewRequired        int
 	crewCapacity        int
 
+	// condition is the last reported frame/reactor/engine wear (ship_condition.go)
+	condition ShipCondition
+
 	// DB-as-source-of-truth fields
 	flightMode         string     // Current flight mode (CRUISE, DRIFT, BURN, STEALTH)
 	arrivalTime        *time.Time // When IN_TRANSIT ship will arrive
@@ -153,7 +156,7 @@ func NewShip(
 		role:            role,
 		modules:         modules,
 		navStatus:       navStatus,
-		fuelService:     NewShipFuelService(),
+		fuelService:     NewShipFuelService(nil),
 	}
 
 	if err := s.validate(); err != nil {
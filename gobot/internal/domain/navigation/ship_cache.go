@@ -0,0 +1,20 @@
+package navigation
+
+import "context"
+
+// freshShipReadCtxKey marks a read that must go to the SpaceTraders API even
+// when the ship repository holds a cached snapshot.
+type freshShipReadCtxKey struct{}
+
+// WithFreshShipRead stamps ctx so ship reads beneath it bypass the ship state
+// cache. Critical reads - an explicit refresh, an authoritative re-confirm
+// before parking a ship - use it; routine resyncs may be served from cache.
+func WithFreshShipRead(ctx context.Context) context.Context {
+	return context.WithValue(ctx, freshShipReadCtxKey{}, true)
+}
+
+// FreshShipReadRequested reports whether ctx was stamped by WithFreshShipRead.
+func FreshShipReadRequested(ctx context.Context) bool {
+	fresh, _ := ctx.Value(freshShipReadCtxKey{}).(bool)
+	return fresh
+}
@@ -0,0 +1,61 @@
+package navigation
+
+// ComponentCondition is the wear state the API reports for one ship component
+// (frame, reactor or engine). Both values run from 0 to 1. Condition is worn
+// down by travel and extraction and is restored by a shipyard repair;
+// Integrity is the ceiling a repair can restore it to, and it only ever falls.
+type ComponentCondition struct {
+	Condition float64
+	Integrity float64
+}
+
+// ShipCondition is the wear state of a ship's frame, reactor and engine. The
+// zero value means the condition has not been reported yet (ships loaded
+// before condition tracking, or test fixtures) and never asks for a repair.
+type ShipCondition struct {
+	Frame   ComponentCondition
+	Reactor ComponentCondition
+	Engine  ComponentCondition
+}
+
+// Reported reports whether any component condition has been read from the API.
+func (c ShipCondition) Reported() bool {
+	return c != ShipCondition{}
+}
+
+// Lowest returns the condition of the most worn component, or 1 when the
+// condition has not been reported.
+func (c ShipCondition) Lowest() float64 {
+	if !c.Reported() {
+		return 1
+	}
+	return min(c.Frame.Condition, c.Reactor.Condition, c.Engine.Condition)
+}
+
+// NeedsRepair reports whether any component's condition has fallen below
+// threshold. A threshold <= 0 disables repairs.
+func (c ShipCondition) NeedsRepair(threshold float64) bool {
+	if threshold <= 0 || !c.Reported() {
+		return false
+	}
+	return c.Lowest() < threshold
+}
+
+// Condition returns the ship's last reported component wear
+func (s *Ship) Condition() ShipCondition {
+	return s.condition
+}
+
+// SetCondition records the component wear read from the API or the database
+func (s *Ship) SetCondition(condition ShipCondition) {
+	s.condition = condition
+}
+
+// ConditionFromShipData reads the component wear carried by an API ship payload
+func ConditionFromShipData(data *ShipData) ShipCondition {
+	return ShipCondition{
+		Frame:   ComponentCondition{Condition: data.FrameCondition, Integrity: data.FrameIntegrity},
+		Reactor: ComponentCondition{Condition: data.ReactorCondition, Integrity: data.ReactorIntegrity},
+		Engine:  ComponentCondition{Condition: data.EngineCondition, Integrity: data.EngineIntegrity},
+	}
+}
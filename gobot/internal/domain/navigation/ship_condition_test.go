@@ -0,0 +1,27 @@
+package navigation
+
+import "testing"
+
+func TestShipCondition_NeedsRepair(t *testing.T) {
+	worn := ShipCondition{
+		Frame:   ComponentCondition{Condition: 0.9, Integrity: 1},
+		Reactor: ComponentCondition{Condition: 0.8, Integrity: 1},
+		Engine:  ComponentCondition{Condition: 0.4, Integrity: 0.9},
+	}
+
+	if got := worn.Lowest(); got != 0.4 {
+		t.Fatalf("expected the engine's 0.4 as the lowest condition, got %v", got)
+	}
+	if !worn.NeedsRepair(0.5) {
+		t.Fatal("a component below the threshold needs repair")
+	}
+	if worn.NeedsRepair(0.4) {
+		t.Fatal("a component at the threshold does not need repair")
+	}
+	if worn.NeedsRepair(0) {
+		t.Fatal("a zero threshold disables repairs")
+	}
+	if (ShipCondition{}).NeedsRepair(0.5) {
+		t.Fatal("an unreported condition never needs repair")
+	}
+}
@@ -38,7 +38,7 @@ import (
 //
 // # Usage Examples
 //
-//	service := NewShipFuelService()
+//	service := NewShipFuelService(nil)
 //
 //	// Check if ship can reach destination
 //	canNavigate := service.CanShipNavigateTo(currentFuel, from, to)
@@ -51,10 +51,29 @@ import (
 //
 //	// Check for opportunistic refueling
 //	shouldRefuel := service.ShouldRefuelOpportunistically(fuel, capacity, waypoint, 0.9)
-type ShipFuelService struct{}
+//
+// # Calibration
+//
+// Fuel costs are the theoretical FlightMode.FuelCost scaled by a per-mode
+// correction factor fitted from observed navigations (see FuelCalibration).
+// A service follows the calibration source it was built with; a nil source and
+// uncalibrated modes use the formula unchanged.
+type ShipFuelService struct {
+	calibration FuelCalibrationSource // nil => uncorrected formulas
+}
 
-func NewShipFuelService() *ShipFuelService {
-	return &ShipFuelService{}
+// NewShipFuelService creates a fuel service that applies calibration's current
+// fit to every estimate. nil uses the theoretical formulas.
+func NewShipFuelService(calibration FuelCalibrationSource) *ShipFuelService {
+	return &ShipFuelService{calibration: calibration}
+}
+
+// fuelCost is the calibrated cost of flying distance in mode.
+func (s *ShipFuelService) fuelCost(mode shared.FlightMode, distance float64) int {
+	if s.calibration == nil {
+		return mode.FuelCost(distance)
+	}
+	return s.calibration.Calibration().FuelCost(mode, distance)
 }
 
 func (s *ShipFuelService) CalculateFuelRequired(
@@ -63,7 +82,7 @@ func (s *ShipFuelService) CalculateFuelRequired(
 	mode shared.FlightMode,
 ) int {
 	distance := from.DistanceTo(to)
-	return mode.FuelCost(distance)
+	return s.fuelCost(mode, distance)
 }
 
 // CanShipNavigateTo checks if a ship has enough fuel to navigate to destination
@@ -74,7 +93,7 @@ func (s *ShipFuelService) CanShipNavigateTo(
 	to *shared.Waypoint,
 ) bool {
 	distance := from.DistanceTo(to)
-	minFuelRequired := shared.FlightModeDrift.FuelCost(distance)
+	minFuelRequired := s.fuelCost(shared.FlightModeDrift, distance)
 	return currentFuel >= minFuelRequired
 }
 
@@ -88,7 +107,7 @@ func (s *ShipFuelService) ShouldRefuelForJourney(
 	safetyMargin float64,
 ) bool {
 	distance := from.DistanceTo(to)
-	fuelRequired := shared.FlightModeCruise.FuelCost(distance)
+	fuelRequired := s.fuelCost(shared.FlightModeCruise, distance)
 	return !fuel.CanTravel(fuelRequired, safetyMargin)
 }
 
@@ -99,7 +118,7 @@ func (s *ShipFuelService) SelectOptimalFlightMode(
 	distance float64,
 	safetyMargin int,
 ) shared.FlightMode {
-	cruiseCost := shared.FlightModeCruise.FuelCost(distance)
+	cruiseCost := s.fuelCost(shared.FlightModeCruise, distance)
 	return shared.SelectOptimalFlightMode(currentFuel, cruiseCost, safetyMargin)
 }
 
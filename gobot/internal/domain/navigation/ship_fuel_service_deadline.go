@@ -0,0 +1,153 @@
+package navigation
+
+import (
+	"time"
+
+	"github.com/andrescamacho/spacetraders-go/internal/domain/shared"
+)
+
+// FlightLeg is one travel leg fed to the deadline planner. RefuelAfter marks a
+// planned refuel to full on arrival, which resets the fuel budget for the
+// following legs.
+type FlightLeg struct {
+	Distance    float64
+	RefuelAfter bool
+}
+
+// DeadlineFlightPlan is the per-leg flight mode assignment chosen by
+// PlanFlightModesForDeadline, with its totals. MeetsDeadline is false when no
+// fuel-feasible assignment arrives in time; the plan is then the cheapest one.
+type DeadlineFlightPlan struct {
+	Modes         []shared.FlightMode
+	FuelRequired  int
+	TravelTime    int // seconds
+	MeetsDeadline bool
+}
+
+// deadlineModeLadder orders the modes the planner trades between, cheapest
+// (and slowest) first. STEALTH is never chosen: it is slower than CRUISE at
+// the same fuel rate.
+var deadlineModeLadder = []shared.FlightMode{
+	shared.FlightModeDrift,
+	shared.FlightModeCruise,
+	shared.FlightModeBurn,
+}
+
+// PlanFlightModesForDeadline picks BURN, CRUISE or DRIFT per leg so the whole
+// journey arrives within deadline at minimal fuel burn.
+//
+// Every leg starts on DRIFT (the cheapest plan). While the journey is late,
+// the leg upgrade with the most seconds saved per extra unit of fuel is
+// applied, provided the fuel budget between refuels still keeps safetyMargin
+// in the tank. Once the deadline is met, upgrades that are no longer needed
+// are walked back, largest fuel saving first. If even the fastest feasible
+// assignment misses the deadline, the cheapest plan is returned with
+// MeetsDeadline false. A non-positive deadline means no deadline.
+func (s *ShipFuelService) PlanFlightModesForDeadline(
+	legs []FlightLeg,
+	currentFuel int,
+	fuelCapacity int,
+	engineSpeed int,
+	safetyMargin int,
+	deadline time.Duration,
+) *DeadlineFlightPlan {
+	rungs := make([]int, len(legs)) // index into deadlineModeLadder per leg
+	cheapest := buildDeadlinePlan(legs, rungs, engineSpeed)
+	if deadline <= 0 {
+		cheapest.MeetsDeadline = true
+		return cheapest
+	}
+
+	budget := int(deadline / time.Second)
+	plan := cheapest
+	for plan.TravelTime > budget {
+		best, bestRatio := -1, 0.0
+		for i, leg := range legs {
+			if rungs[i] == len(deadlineModeLadder)-1 {
+				continue
+			}
+			from, to := deadlineModeLadder[rungs[i]], deadlineModeLadder[rungs[i]+1]
+			saved := from.TravelTime(leg.Distance, engineSpeed) - to.TravelTime(leg.Distance, engineSpeed)
+			extra := to.FuelCost(leg.Distance) - from.FuelCost(leg.Distance)
+			if saved <= 0 {
+				continue
+			}
+			rungs[i]++
+			feasible := deadlineFuelFeasible(legs, rungs, currentFuel, fuelCapacity, safetyMargin)
+			rungs[i]--
+			if !feasible {
+				continue
+			}
+			ratio := float64(saved) / float64(max(extra, 1))
+			if best == -1 || ratio > bestRatio {
+				best, bestRatio = i, ratio
+			}
+		}
+		if best == -1 {
+			cheapest.MeetsDeadline = false
+			return cheapest
+		}
+		rungs[best]++
+		plan = buildDeadlinePlan(legs, rungs, engineSpeed)
+	}
+
+	// Greedy upgrades can overshoot; give back any upgrade the deadline no
+	// longer needs, biggest fuel saving first.
+	for {
+		best, bestSaving := -1, 0
+		for i, leg := range legs {
+			if rungs[i] == 0 {
+				continue
+			}
+			from, to := deadlineModeLadder[rungs[i]], deadlineModeLadder[rungs[i]-1]
+			saving := from.FuelCost(leg.Distance) - to.FuelCost(leg.Distance)
+			slower := to.TravelTime(leg.Distance, engineSpeed) - from.TravelTime(leg.Distance, engineSpeed)
+			if plan.TravelTime+slower > budget || saving <= bestSaving {
+				continue
+			}
+			best, bestSaving = i, saving
+		}
+		if best == -1 {
+			break
+		}
+		rungs[best]--
+		plan = buildDeadlinePlan(legs, rungs, engineSpeed)
+	}
+
+	plan.MeetsDeadline = true
+	return plan
+}
+
+// deadlineFuelFeasible walks the legs with the tank refilled at every planned
+// refuel. DRIFT legs only need to be affordable; faster legs must also leave
+// safetyMargin behind, mirroring SelectOptimalFlightMode's reserve policy.
+func deadlineFuelFeasible(legs []FlightLeg, rungs []int, currentFuel, fuelCapacity, safetyMargin int) bool {
+	fuel := currentFuel
+	for i, leg := range legs {
+		mode := deadlineModeLadder[rungs[i]]
+		cost := mode.FuelCost(leg.Distance)
+		reserve := safetyMargin
+		if mode == shared.FlightModeDrift {
+			reserve = 0
+		}
+		if fuel-cost < reserve {
+			return false
+		}
+		fuel -= cost
+		if leg.RefuelAfter {
+			fuel = fuelCapacity
+		}
+	}
+	return true
+}
+
+func buildDeadlinePlan(legs []FlightLeg, rungs []int, engineSpeed int) *DeadlineFlightPlan {
+	plan := &DeadlineFlightPlan{Modes: make([]shared.FlightMode, len(legs))}
+	for i, leg := range legs {
+		mode := deadlineModeLadder[rungs[i]]
+		plan.Modes[i] = mode
+		plan.FuelRequired += mode.FuelCost(leg.Distance)
+		plan.TravelTime += mode.TravelTime(leg.Distance, engineSpeed)
+	}
+	return plan
+}
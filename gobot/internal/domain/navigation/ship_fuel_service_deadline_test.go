@@ -0,0 +1,64 @@
+package navigation
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/andrescamacho/spacetraders-go/internal/domain/shared"
+)
+
+// At engine speed 30 a 100-unit leg costs DRIFT 1 fuel/833s, CRUISE 100/103s
+// and BURN 200/50s.
+var twoDeadlineLegs = []FlightLeg{{Distance: 100}, {Distance: 100}}
+
+// A loose deadline is met with CRUISE on both legs: each DRIFT->CRUISE step
+// saves far more time per fuel than CRUISE->BURN, and no BURN is needed.
+func TestPlanFlightModesForDeadline_PicksCheapestModesThatArriveInTime(t *testing.T) {
+	plan := NewShipFuelService(nil).PlanFlightModesForDeadline(twoDeadlineLegs, 400, 400, 30, 0, 300*time.Second)
+
+	require.True(t, plan.MeetsDeadline)
+	require.Equal(t, []shared.FlightMode{shared.FlightModeCruise, shared.FlightModeCruise}, plan.Modes)
+	require.Equal(t, 200, plan.FuelRequired)
+	require.Equal(t, 206, plan.TravelTime)
+}
+
+func TestPlanFlightModesForDeadline_BurnsWhenDeadlineIsTight(t *testing.T) {
+	plan := NewShipFuelService(nil).PlanFlightModesForDeadline(twoDeadlineLegs, 400, 400, 30, 0, 150*time.Second)
+
+	require.True(t, plan.MeetsDeadline)
+	require.Equal(t, []shared.FlightMode{shared.FlightModeBurn, shared.FlightModeBurn}, plan.Modes)
+	require.Equal(t, 100, plan.TravelTime)
+}
+
+// The same tight deadline becomes impossible once the safety reserve rules
+// out the second BURN, so the planner falls back to the cheapest plan.
+func TestPlanFlightModesForDeadline_ImpossibleDeadlineFallsBackToCheapest(t *testing.T) {
+	plan := NewShipFuelService(nil).PlanFlightModesForDeadline(twoDeadlineLegs, 400, 400, 30, 5, 150*time.Second)
+
+	require.False(t, plan.MeetsDeadline)
+	require.Equal(t, []shared.FlightMode{shared.FlightModeDrift, shared.FlightModeDrift}, plan.Modes)
+	require.Equal(t, 2, plan.FuelRequired)
+}
+
+// A planned refuel between legs refills the budget, making BURN affordable on
+// both legs of a tank that could only BURN one of them.
+func TestPlanFlightModesForDeadline_PlannedRefuelResetsFuelBudget(t *testing.T) {
+	service := NewShipFuelService(nil)
+
+	withoutRefuel := service.PlanFlightModesForDeadline(twoDeadlineLegs, 200, 200, 30, 0, 100*time.Second)
+	require.False(t, withoutRefuel.MeetsDeadline)
+
+	legs := []FlightLeg{{Distance: 100, RefuelAfter: true}, {Distance: 100}}
+	withRefuel := service.PlanFlightModesForDeadline(legs, 200, 200, 30, 0, 100*time.Second)
+	require.True(t, withRefuel.MeetsDeadline)
+	require.Equal(t, []shared.FlightMode{shared.FlightModeBurn, shared.FlightModeBurn}, withRefuel.Modes)
+}
+
+func TestPlanFlightModesForDeadline_NoDeadlineIsCheapest(t *testing.T) {
+	plan := NewShipFuelService(nil).PlanFlightModesForDeadline(twoDeadlineLegs, 400, 400, 30, 0, 0)
+
+	require.True(t, plan.MeetsDeadline)
+	require.Equal(t, []shared.FlightMode{shared.FlightModeDrift, shared.FlightModeDrift}, plan.Modes)
+}
@@ -0,0 +1,75 @@
+package navigation
+
+import "fmt"
+
+// MaxFuelReservePercent caps a fuel reserve policy: a larger reserve would
+// leave too little of the tank to plan any route with.
+const MaxFuelReservePercent = 90
+
+// FuelReservePolicy is the least fuel a planned route may leave in a ship's
+// tank, as a percentage of capacity, on arrival anywhere along the route.
+// Percent 0 (or less) turns the reserve off; above MaxFuelReservePercent it
+// is capped.
+type FuelReservePolicy struct {
+	Percent int
+}
+
+// Units is the reserve in fuel units for a tank of capacity, rounded up so a
+// small tank still keeps at least one unit. Ships without a tank (probes)
+// have no reserve.
+func (p FuelReservePolicy) Units(capacity int) int {
+	if p.Percent <= 0 || capacity <= 0 {
+		return 0
+	}
+	return (capacity*min(p.Percent, MaxFuelReservePercent) + 99) / 100
+}
+
+// ErrFuelReserveViolation is returned when a route would land a ship below
+// its fuel reserve. It is a planning error: the route is refused before the
+// ship leaves, rather than the ship stranding on the way.
+type ErrFuelReserveViolation struct {
+	ShipSymbol     string
+	Waypoint       string // where the reserve is breached; the origin when the ship already sits below it
+	FuelLeft       int
+	Reserve        int
+	ReservePercent int
+}
+
+func (e *ErrFuelReserveViolation) Error() string {
+	return fmt.Sprintf("route for %s leaves %d fuel at %s, below the %d-unit (%d%%) fuel reserve",
+		e.ShipSymbol, e.FuelLeft, e.Waypoint, e.Reserve, e.ReservePercent)
+}
+
+// CheckFuelReserve flies route's segments from currentFuel (or a full tank
+// when the route refuels before departure), refuelling to fuelCapacity where
+// a segment is marked for it, and reports the first arrival that leaves less
+// than the policy's reserve aboard. A first leg that ends at a fuel stop is
+// exempt, so a ship already low on fuel can still go and refuel. A policy
+// that is off accepts any route.
+func (s *ShipFuelService) CheckFuelReserve(route *Route, currentFuel, fuelCapacity int, policy FuelReservePolicy) error {
+	reserve := policy.Units(fuelCapacity)
+	if reserve == 0 {
+		return nil
+	}
+	fuel := currentFuel
+	if route.HasRefuelAtStart() {
+		fuel = fuelCapacity
+	}
+	for i, seg := range route.Segments() {
+		fuel -= seg.FuelRequired
+		refuelRun := i == 0 && seg.RequiresRefuel && fuel >= 0
+		if fuel < reserve && !refuelRun {
+			return &ErrFuelReserveViolation{
+				ShipSymbol:     route.ShipSymbol(),
+				Waypoint:       seg.ToWaypoint.Symbol,
+				FuelLeft:       fuel,
+				Reserve:        reserve,
+				ReservePercent: min(policy.Percent, MaxFuelReservePercent),
+			}
+		}
+		if seg.RequiresRefuel {
+			fuel = fuelCapacity
+		}
+	}
+	return nil
+}
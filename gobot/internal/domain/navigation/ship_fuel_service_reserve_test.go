@@ -0,0 +1,74 @@
+package navigation
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/andrescamacho/spacetraders-go/internal/domain/shared"
+)
+
+// reserveTestRoute is A -> B (refuel at B) -> C on a 100-unit tank, burning
+// 60 then 85 fuel.
+func reserveTestRoute(t *testing.T) *Route {
+	t.Helper()
+	a, _ := shared.NewWaypoint("X1-RS-A", 0, 0)
+	b, _ := shared.NewWaypoint("X1-RS-B", 60, 0)
+	c, _ := shared.NewWaypoint("X1-RS-C", 145, 0)
+	route, err := NewRoute("route-1", "SHIP-1", 1, []*RouteSegment{
+		NewRouteSegment(a, b, 60, 60, 100, shared.FlightModeCruise, true),
+		NewRouteSegment(b, c, 85, 85, 140, shared.FlightModeCruise, false),
+	}, 100, false)
+	require.NoError(t, err)
+	return route
+}
+
+func TestFuelReservePolicy_Units(t *testing.T) {
+	require.Equal(t, 15, FuelReservePolicy{Percent: 15}.Units(100))
+	require.Equal(t, 1, FuelReservePolicy{Percent: 15}.Units(5), "rounds up so a small tank keeps a unit")
+	require.Equal(t, 0, FuelReservePolicy{}.Units(100))
+	require.Equal(t, 0, FuelReservePolicy{Percent: 15}.Units(0), "probes have no reserve")
+	require.Equal(t, 90, FuelReservePolicy{Percent: 150}.Units(100))
+}
+
+// Arriving at C with 15 left meets a 15% reserve but not a 20% one; the
+// refuel at B resets the budget, so the 40 left at B is never the problem.
+func TestCheckFuelReserve_ReportsFirstArrivalBelowReserve(t *testing.T) {
+	service := NewShipFuelService(nil)
+	route := reserveTestRoute(t)
+
+	require.NoError(t, service.CheckFuelReserve(route, 100, 100, FuelReservePolicy{Percent: 15}))
+
+	err := service.CheckFuelReserve(route, 100, 100, FuelReservePolicy{Percent: 20})
+	var violation *ErrFuelReserveViolation
+	require.True(t, errors.As(err, &violation))
+	require.Equal(t, "X1-RS-C", violation.Waypoint)
+	require.Equal(t, 15, violation.FuelLeft)
+	require.Equal(t, 20, violation.Reserve)
+
+	require.NoError(t, service.CheckFuelReserve(route, 100, 100, FuelReservePolicy{}), "no reserve accepts the route")
+}
+
+// A low-fuel ship may dip into the reserve on a first leg that ends at a fuel
+// stop, so it can go and refuel; the same dip on a leg that does not refuel
+// is still a violation.
+func TestCheckFuelReserve_LetsFirstLegRunToFuelStop(t *testing.T) {
+	service := NewShipFuelService(nil)
+	policy := FuelReservePolicy{Percent: 15}
+
+	require.NoError(t, service.CheckFuelReserve(reserveTestRoute(t), 70, 100, policy), "arriving at B on 10 refuels there")
+
+	b, _ := shared.NewWaypoint("X1-RS-B", 60, 0)
+	c, _ := shared.NewWaypoint("X1-RS-C", 145, 0)
+	route, err := NewRoute("route-2", "SHIP-1", 1, []*RouteSegment{
+		NewRouteSegment(b, c, 85, 85, 140, shared.FlightModeCruise, false),
+	}, 100, false)
+	require.NoError(t, err)
+
+	err = service.CheckFuelReserve(route, 95, 100, policy)
+	var violation *ErrFuelReserveViolation
+	require.True(t, errors.As(err, &violation))
+	require.Equal(t, "X1-RS-C", violation.Waypoint)
+	require.Equal(t, 10, violation.FuelLeft)
+}
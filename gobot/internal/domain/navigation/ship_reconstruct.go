@@ -71,7 +71,7 @@ func ReconstructShip(
 		crewCurrent:         crewCurrent,
 		crewRequired:        crewRequired,
 		crewCapacity:        crewCapacity,
-		fuelService:         NewShipFuelService(),
+		fuelService:         NewShipFuelService(nil),
 	}
 
 	if err := s.validate(); err != nil {
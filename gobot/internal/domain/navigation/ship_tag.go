@@ -0,0 +1,90 @@
+package navigation
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/andrescamacho/spacetraders-go/internal/domain/shared"
+)
+
+// maxShipTagLength bounds an operator label; it matches the ship_tags.tag column
+const maxShipTagLength = 64
+
+// ShipTagRepository persists operator-defined labels on ships. A tag is a
+// free-form grouping ("fast-haulers", "gate-probes") an operator uses to slice
+// the fleet logically; unlike DedicatedFleet it carries no ownership and a ship
+// may wear any number of them. Coordinators accept a tag wherever they accept a
+// ship list and resolve it here.
+type ShipTagRepository interface {
+	// AddShipTag tags a ship. Idempotent: re-adding an existing tag is a no-op.
+	AddShipTag(ctx context.Context, playerID shared.PlayerID, shipSymbol, tag string) error
+
+	// RemoveShipTag untags a ship, reporting whether the tag was present.
+	RemoveShipTag(ctx context.Context, playerID shared.PlayerID, shipSymbol, tag string) (bool, error)
+
+	// FindShipsByTag returns the symbols of the player's ships wearing tag, in
+	// symbol order.
+	FindShipsByTag(ctx context.Context, playerID shared.PlayerID, tag string) ([]string, error)
+
+	// ListShipTags returns every tag the player has set, keyed by ship symbol.
+	ListShipTags(ctx context.Context, playerID shared.PlayerID) (map[string][]string, error)
+}
+
+// NormalizeShipTag trims and lower-cases tag and checks it is a usable label:
+// non-empty, at most 64 characters, and made of letters, digits, '-', '_' or
+// '.'. Tags are compared in their normalized form, so "Fast-Haulers" and
+// "fast-haulers" are one tag.
+func NormalizeShipTag(tag string) (string, error) {
+	normalized := strings.ToLower(strings.TrimSpace(tag))
+	if normalized == "" {
+		return "", fmt.Errorf("ship tag must not be empty")
+	}
+	if len(normalized) > maxShipTagLength {
+		return "", fmt.Errorf("ship tag %q is longer than %d characters", tag, maxShipTagLength)
+	}
+	for _, r := range normalized {
+		switch {
+		case r >= 'a' && r <= 'z', r >= '0' && r <= '9', r == '-', r == '_', r == '.':
+		default:
+			return "", fmt.Errorf("ship tag %q contains %q: use letters, digits, '-', '_' or '.'", tag, r)
+		}
+	}
+	return normalized, nil
+}
+
+// ResolveShipSelector expands a coordinator's ship selection: the explicit
+// symbols, followed by every ship wearing tag that is not already listed. An
+// empty tag returns symbols unchanged. A tag that matches no ship is an error
+// rather than an empty selection, so a typo never starts a coordinator with no
+// hulls.
+func ResolveShipSelector(ctx context.Context, tags ShipTagRepository, playerID shared.PlayerID, symbols []string, tag string) ([]string, error) {
+	if tag == "" {
+		return symbols, nil
+	}
+	if tags == nil {
+		return nil, fmt.Errorf("ship tag %q given but ship tags are not available", tag)
+	}
+	normalized, err := NormalizeShipTag(tag)
+	if err != nil {
+		return nil, err
+	}
+	tagged, err := tags.FindShipsByTag(ctx, playerID, normalized)
+	if err != nil {
+		return nil, fmt.Errorf("failed to resolve ship tag %q: %w", normalized, err)
+	}
+	if len(tagged) == 0 {
+		return nil, fmt.Errorf("no ships are tagged %q", normalized)
+	}
+
+	resolved := make([]string, 0, len(symbols)+len(tagged))
+	seen := make(map[string]bool, len(symbols)+len(tagged))
+	for _, symbol := range append(append([]string{}, symbols...), tagged...) {
+		if seen[symbol] {
+			continue
+		}
+		seen[symbol] = true
+		resolved = append(resolved, symbol)
+	}
+	return resolved, nil
+}
@@ -0,0 +1,58 @@
+package navigation
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/andrescamacho/spacetraders-go/internal/domain/shared"
+)
+
+type stubShipTags map[string][]string
+
+func (s stubShipTags) AddShipTag(context.Context, shared.PlayerID, string, string) error { return nil }
+func (s stubShipTags) RemoveShipTag(context.Context, shared.PlayerID, string, string) (bool, error) {
+	return false, nil
+}
+func (s stubShipTags) FindShipsByTag(_ context.Context, _ shared.PlayerID, tag string) ([]string, error) {
+	return s[tag], nil
+}
+func (s stubShipTags) ListShipTags(context.Context, shared.PlayerID) (map[string][]string, error) {
+	return nil, nil
+}
+
+func TestNormalizeShipTag(t *testing.T) {
+	if got, err := NormalizeShipTag("  Fast-Haulers "); err != nil || got != "fast-haulers" {
+		t.Fatalf("expected fast-haulers, got %q (%v)", got, err)
+	}
+	for _, bad := range []string{"", "   ", "fast haulers", "probes/x1"} {
+		if _, err := NormalizeShipTag(bad); err == nil {
+			t.Errorf("expected %q to be rejected", bad)
+		}
+	}
+}
+
+// Tagged ships follow the explicit symbols without duplicates, the tag is
+// matched case-insensitively, and a tag with no ships is an error.
+func TestResolveShipSelector(t *testing.T) {
+	tags := stubShipTags{"fast-haulers": {"AGENT-2", "AGENT-3"}}
+	playerID := shared.MustNewPlayerID(1)
+
+	got, err := ResolveShipSelector(context.Background(), tags, playerID, []string{"AGENT-1", "AGENT-2"}, "FAST-HAULERS")
+	if err != nil {
+		t.Fatalf("resolve: %v", err)
+	}
+	if want := []string{"AGENT-1", "AGENT-2", "AGENT-3"}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("got %v, want %v", got, want)
+	}
+
+	if got, _ := ResolveShipSelector(context.Background(), nil, playerID, []string{"AGENT-1"}, ""); !reflect.DeepEqual(got, []string{"AGENT-1"}) {
+		t.Fatalf("an empty tag must leave the symbols alone, got %v", got)
+	}
+	if _, err := ResolveShipSelector(context.Background(), tags, playerID, nil, "gate-probes"); err == nil {
+		t.Fatal("expected an error for a tag with no ships")
+	}
+	if _, err := ResolveShipSelector(context.Background(), nil, playerID, nil, "fast-haulers"); err == nil {
+		t.Fatal("expected an error when tags are not wired")
+	}
+}
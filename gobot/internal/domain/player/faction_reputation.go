@@ -0,0 +1,76 @@
+package player
+
+import (
+	"context"
+	"sort"
+	"time"
+)
+
+// FactionReputation is one faction's reputation with the agent at a point in time,
+// as read from GET /my/factions.
+type FactionReputation struct {
+	FactionSymbol string
+	Reputation    int
+	CapturedAt    time.Time
+}
+
+// FactionReputationRepository persists reputation snapshots. Snapshots are
+// append-only: the standing is derived from the history, not overwritten.
+type FactionReputationRepository interface {
+	SaveSnapshot(ctx context.Context, playerID int, reputations []FactionReputation) error
+	FindSince(ctx context.Context, playerID int, since time.Time) ([]FactionReputation, error)
+}
+
+// FactionStanding is a faction's latest reputation and how much it moved over the
+// observed window. Gain > 0 means we are building reputation with the faction.
+type FactionStanding struct {
+	FactionSymbol string
+	Reputation    int
+	Gain          int
+	LastCaptured  time.Time
+}
+
+// ComputeFactionStandings folds a snapshot history into one standing per faction:
+// the latest reputation, and its gain over the earliest snapshot in the history.
+// Standings are ordered by preference — biggest gain first, then highest
+// reputation, then symbol so the order is stable.
+func ComputeFactionStandings(history []FactionReputation) []FactionStanding {
+	type span struct {
+		first, last FactionReputation
+	}
+	spans := make(map[string]*span)
+	for _, snapshot := range history {
+		s, ok := spans[snapshot.FactionSymbol]
+		if !ok {
+			spans[snapshot.FactionSymbol] = &span{first: snapshot, last: snapshot}
+			continue
+		}
+		if snapshot.CapturedAt.Before(s.first.CapturedAt) {
+			s.first = snapshot
+		}
+		if !snapshot.CapturedAt.Before(s.last.CapturedAt) {
+			s.last = snapshot
+		}
+	}
+
+	standings := make([]FactionStanding, 0, len(spans))
+	for symbol, s := range spans {
+		standings = append(standings, FactionStanding{
+			FactionSymbol: symbol,
+			Reputation:    s.last.Reputation,
+			Gain:          s.last.Reputation - s.first.Reputation,
+			LastCaptured:  s.last.CapturedAt,
+		})
+	}
+	sort.Slice(standings, func(i, j int) bool {
+		a, b := standings[i], standings[j]
+		if a.Gain != b.Gain {
+			return a.Gain > b.Gain
+		}
+		if a.Reputation != b.Reputation {
+			return a.Reputation > b.Reputation
+		}
+		return a.FactionSymbol < b.FactionSymbol
+	})
+	return standings
+}
@@ -0,0 +1,25 @@
+package player
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestComputeFactionStandings_PrefersFactionsGainingReputation(t *testing.T) {
+	t0 := time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC)
+	standings := ComputeFactionStandings([]FactionReputation{
+		{FactionSymbol: "COSMIC", Reputation: 40, CapturedAt: t0.Add(time.Hour)},
+		{FactionSymbol: "COSMIC", Reputation: 10, CapturedAt: t0},
+		{FactionSymbol: "GALACTIC", Reputation: 90, CapturedAt: t0},
+		{FactionSymbol: "GALACTIC", Reputation: 90, CapturedAt: t0.Add(time.Hour)},
+		{FactionSymbol: "VOID", Reputation: 5, CapturedAt: t0.Add(time.Hour)},
+	})
+
+	require.Len(t, standings, 3)
+	require.Equal(t, FactionStanding{FactionSymbol: "COSMIC", Reputation: 40, Gain: 30, LastCaptured: t0.Add(time.Hour)}, standings[0])
+	require.Equal(t, "GALACTIC", standings[1].FactionSymbol, "flat gain ranks by reputation")
+	require.Equal(t, 0, standings[1].Gain)
+	require.Equal(t, "VOID", standings[2].FactionSymbol)
+}
@@ -36,11 +36,18 @@ import (
 type APIClient interface {
 	// Ship operations
 	GetShip(ctx context.Context, symbol, token string) (*navigation.ShipData, error)
+	// GetShipNav reads only the ship's nav block (status, location, route).
+	// Callers that just need to know whether a ship has arrived use it
+	// instead of fetching the whole ship.
+	GetShipNav(ctx context.Context, symbol, token string) (*navigation.ShipNavData, error)
 	ListShips(ctx context.Context, token string) ([]*navigation.ShipData, error)
 	NavigateShip(ctx context.Context, symbol, destination, token string) (*navigation.Result, error)
 	OrbitShip(ctx context.Context, symbol, token string) error
 	DockShip(ctx context.Context, symbol, token string) error
 	RefuelShip(ctx context.Context, symbol, token string, units *int) (*navigation.RefuelResult, error)
+	// RefuelShipFromCargo refuels from the FUEL carried in the ship's own hold
+	// (one cargo unit holds 100 units of fuel) rather than from the market.
+	RefuelShipFromCargo(ctx context.Context, symbol, token string, units int) (*navigation.RefuelResult, error)
 	SetFlightMode(ctx context.Context, symbol, flightMode, token string) error
 	// JumpShip executes a jump through a jump gate. waypointSymbol must be
 	// the destination JUMP_GATE waypoint (not a bare system symbol) - the
@@ -57,6 +64,17 @@ type APIClient interface {
 
 	// Player operations
 	GetAgent(ctx context.Context, token string) (*player.AgentData, error)
+	// GetMyFactionReputation reads the agent's current reputation with every
+	// faction (GET /my/factions).
+	GetMyFactionReputation(ctx context.Context, token string) ([]FactionReputationData, error)
+	// RegisterAgent creates a new agent (POST /register). It authenticates with
+	// the ACCOUNT token, not an agent token, and returns the new agent's token —
+	// shown exactly once, so callers must persist it before doing anything else.
+	RegisterAgent(ctx context.Context, accountToken, agentSymbol, faction string) (*AgentRegistration, error)
+
+	// Faction operations
+	// GetFactions lists every faction in the game, following pagination.
+	GetFactions(ctx context.Context, token string) ([]FactionData, error)
 
 	// Waypoint operations
 	ListWaypoints(ctx context.Context, systemSymbol, token string, page, limit int) (*system.WaypointsListResponse, error)
@@ -69,6 +87,9 @@ type APIClient interface {
 
 	// Contract operations
 	NegotiateContract(ctx context.Context, shipSymbol, token string) (*ContractNegotiationResult, error)
+	// ListContracts lists every contract the agent holds, following pagination.
+	// A freshly registered agent already holds its pre-negotiated starter contract.
+	ListContracts(ctx context.Context, token string) ([]*ContractData, error)
 	GetContract(ctx context.Context, contractID, token string) (*ContractData, error)
 	AcceptContract(ctx context.Context, contractID, token string) (*ContractData, error)
 	DeliverContract(ctx context.Context, contractID, shipSymbol, tradeSymbol string, units int, token string) (*ContractData, error)
@@ -85,6 +106,9 @@ type APIClient interface {
 	InstallShipModule(ctx context.Context, shipSymbol, moduleSymbol, token string) (*ModuleModificationResult, error)
 	RemoveShipModule(ctx context.Context, shipSymbol, moduleSymbol, token string) (*ModuleModificationResult, error)
 	GetShipModules(ctx context.Context, shipSymbol, token string) ([]ModuleInfo, error)
+	// InstallShipMount installs a mount (which must be in the ship's cargo) and
+	// returns the shipyard fee and the ship's mounts after the install.
+	InstallShipMount(ctx context.Context, shipSymbol, mountSymbol, token string) (*MountInstallResult, error)
 	TransferCargo(ctx context.Context, fromShipSymbol, toShipSymbol, goodSymbol string, units int, token string) (*TransferResult, error)
 
 	// Mining operations
@@ -99,12 +123,31 @@ type APIClient interface {
 	// Shipyard operations
 	GetShipyard(ctx context.Context, systemSymbol, waypointSymbol, token string) (*ShipyardData, error)
 	PurchaseShip(ctx context.Context, shipType, waypointSymbol, token string) (*ShipPurchaseResult, error)
+	// ScrapShip scraps a ship docked at a shipyard for part of its value. The
+	// ship ceases to exist on success.
+	ScrapShip(ctx context.Context, shipSymbol, token string) (*ShipScrapResult, error)
+	// RepairShip restores a ship docked at a shipyard to full condition. The
+	// result carries the repaired ship so its new condition can be persisted.
+	RepairShip(ctx context.Context, shipSymbol, token string) (*ShipRepairResult, error)
 
 	// Construction operations
 	GetConstruction(ctx context.Context, systemSymbol, waypointSymbol, token string) (*ConstructionData, error)
 	SupplyConstruction(ctx context.Context, shipSymbol, waypointSymbol, tradeSymbol string, units int, token string) (*ConstructionSupplyResponse, error)
 }
 
+// Faction DTOs
+type FactionData struct {
+	Symbol       string
+	Name         string
+	Headquarters string
+	IsRecruiting bool
+}
+
+type FactionReputationData struct {
+	FactionSymbol string
+	Reputation    int
+}
+
 // Contract DTOs
 type ContractNegotiationResult struct {
 	Contract           *ContractData
@@ -180,6 +223,16 @@ type ModuleInfo struct {
 	Slots int
 }
 
+// MountInstallResult is the outcome of installing a ship mount: the shipyard
+// fee, the ship's full mount list and cargo capacity afterwards, and the agent's
+// post-transaction balance (nil if the response omitted it).
+type MountInstallResult struct {
+	Fee           int
+	Mounts        []string
+	CargoCapacity int
+	AgentCredits  *int
+}
+
 // ModuleModificationResult is the outcome of installing or removing a ship
 // module. Both endpoints return the updated agent, the ship's post-modification
 // modules list and cargo, and a transaction carrying the modification fee.
@@ -291,6 +344,13 @@ type ShipListingData struct {
 	Mounts  []map[string]interface{}
 }
 
+// AgentRegistration is the result of registering a new agent: its bearer token
+// plus the agent as the server created it.
+type AgentRegistration struct {
+	Token string
+	Agent *player.AgentData
+}
+
 type ShipPurchaseResult struct {
 	Agent       *player.AgentData
 	Ship        *navigation.ShipData
@@ -306,6 +366,31 @@ type ShipPurchaseTransaction struct {
 	Timestamp      string
 }
 
+type ShipScrapResult struct {
+	Agent       *player.AgentData
+	Transaction *ShipScrapTransaction
+}
+
+type ShipScrapTransaction struct {
+	WaypointSymbol string
+	ShipSymbol     string
+	TotalPrice     int
+	Timestamp      string
+}
+
+type ShipRepairResult struct {
+	Agent       *player.AgentData
+	Ship        *navigation.ShipData
+	Transaction *ShipRepairTransaction
+}
+
+type ShipRepairTransaction struct {
+	WaypointSymbol string
+	ShipSymbol     string
+	TotalPrice     int
+	Timestamp      string
+}
+
 // Construction DTOs
 type ConstructionData struct {
 	Symbol     string
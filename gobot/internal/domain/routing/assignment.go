@@ -0,0 +1,95 @@
+package routing
+
+// UnreachableCost marks a (worker, target) pair that cannot be assigned, such as
+// a ship with no route to a waypoint. It is large but finite so the solver's
+// potentials stay well-defined; a solution that still uses such a pair means no
+// complete assignment avoids it.
+const UnreachableCost = 1e15
+
+// SolveAssignment solves the rectangular assignment problem: given cost[i][j],
+// the cost of giving row i column j, it returns for each row a distinct column
+// such that the total cost is minimal (Hungarian algorithm, O(n²m)). Every row
+// must have the same number of columns, and there must be at least as many
+// columns as rows. It returns nil for an empty or malformed matrix.
+func SolveAssignment(cost [][]float64) []int {
+	n := len(cost)
+	if n == 0 {
+		return nil
+	}
+	m := len(cost[0])
+	if m < n {
+		return nil
+	}
+	for _, row := range cost {
+		if len(row) != m {
+			return nil
+		}
+	}
+
+	// 1-indexed potentials over rows (u) and columns (v); match[j] is the row
+	// holding column j, way[j] the previous column on the augmenting path.
+	u := make([]float64, n+1)
+	v := make([]float64, m+1)
+	match := make([]int, m+1)
+	way := make([]int, m+1)
+
+	for i := 1; i <= n; i++ {
+		match[0] = i
+		j0 := 0
+		minv := make([]float64, m+1)
+		used := make([]bool, m+1)
+		for j := range minv {
+			minv[j] = inf
+		}
+		for {
+			used[j0] = true
+			i0 := match[j0]
+			delta := inf
+			j1 := 0
+			for j := 1; j <= m; j++ {
+				if used[j] {
+					continue
+				}
+				cur := cost[i0-1][j-1] - u[i0] - v[j]
+				if cur < minv[j] {
+					minv[j] = cur
+					way[j] = j0
+				}
+				if minv[j] < delta {
+					delta = minv[j]
+					j1 = j
+				}
+			}
+			for j := 0; j <= m; j++ {
+				if used[j] {
+					u[match[j]] += delta
+					v[j] -= delta
+				} else {
+					minv[j] -= delta
+				}
+			}
+			j0 = j1
+			if match[j0] == 0 {
+				break
+			}
+		}
+		// Flip the augmenting path back to the root column
+		for j0 != 0 {
+			j1 := way[j0]
+			match[j0] = match[j1]
+			j0 = j1
+		}
+	}
+
+	assignment := make([]int, n)
+	for j := 1; j <= m; j++ {
+		if match[j] != 0 {
+			assignment[match[j]-1] = j - 1
+		}
+	}
+	return assignment
+}
+
+// inf bounds the solver's slack values; it exceeds any sum of UnreachableCost
+// entries a realistic fleet can produce.
+const inf = 1e300
@@ -0,0 +1,59 @@
+package routing
+
+import "testing"
+
+func totalCost(cost [][]float64, assignment []int) float64 {
+	total := 0.0
+	for i, j := range assignment {
+		total += cost[i][j]
+	}
+	return total
+}
+
+// The greedy choice (row 0 takes its cheapest column) is not the optimum; the
+// solver must find the cheaper overall pairing.
+func TestSolveAssignment_BeatsGreedy(t *testing.T) {
+	cost := [][]float64{
+		{1, 2, 100},
+		{1, 100, 100},
+		{100, 100, 1},
+	}
+	assignment := SolveAssignment(cost)
+	if len(assignment) != 3 {
+		t.Fatalf("expected 3 assignments, got %v", assignment)
+	}
+	if got := totalCost(cost, assignment); got != 4 {
+		t.Fatalf("expected total cost 4, got %v (assignment %v)", got, assignment)
+	}
+	if assignment[0] != 1 || assignment[1] != 0 || assignment[2] != 2 {
+		t.Fatalf("expected [1 0 2], got %v", assignment)
+	}
+}
+
+// With more columns than rows each row still gets a distinct column, and
+// unreachable pairs are avoided when an alternative exists.
+func TestSolveAssignment_RectangularAvoidsUnreachable(t *testing.T) {
+	cost := [][]float64{
+		{UnreachableCost, 5, 3},
+		{2, UnreachableCost, 4},
+	}
+	assignment := SolveAssignment(cost)
+	if len(assignment) != 2 || assignment[0] == assignment[1] {
+		t.Fatalf("expected two distinct columns, got %v", assignment)
+	}
+	if got := totalCost(cost, assignment); got != 5 {
+		t.Fatalf("expected total cost 5, got %v (assignment %v)", got, assignment)
+	}
+}
+
+func TestSolveAssignment_RejectsMalformedInput(t *testing.T) {
+	if SolveAssignment(nil) != nil {
+		t.Fatal("expected nil for an empty matrix")
+	}
+	if SolveAssignment([][]float64{{1}, {2}}) != nil {
+		t.Fatal("expected nil when rows outnumber columns")
+	}
+	if SolveAssignment([][]float64{{1, 2}, {3}}) != nil {
+		t.Fatal("expected nil for a ragged matrix")
+	}
+}
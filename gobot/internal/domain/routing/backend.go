@@ -0,0 +1,22 @@
+package routing
+
+import "context"
+
+type backendContextKey struct{}
+
+// WithBackend asks the routing client to try the named backend first for
+// calls made with the returned context (e.g. "native" for a latency-sensitive
+// hop that should not wait on the solver). Clients with a single backend, or
+// that do not know the name, ignore it.
+func WithBackend(ctx context.Context, name string) context.Context {
+	if name == "" {
+		return ctx
+	}
+	return context.WithValue(ctx, backendContextKey{}, name)
+}
+
+// BackendFromContext returns the backend requested with WithBackend, or "".
+func BackendFromContext(ctx context.Context) string {
+	name, _ := ctx.Value(backendContextKey{}).(string)
+	return name
+}
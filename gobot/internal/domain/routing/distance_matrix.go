@@ -0,0 +1,178 @@
+package routing
+
+import (
+	"math"
+
+	"github.com/andrescamacho/spacetraders-go/internal/domain/shared"
+	"github.com/andrescamacho/spacetraders-go/internal/domain/system"
+)
+
+// matrixModes are the flight modes a matrix cell prices, in shared.FlightMode
+// order.
+var matrixModes = []shared.FlightMode{shared.FlightModeCruise, shared.FlightModeDrift, shared.FlightModeBurn, shared.FlightModeStealth}
+
+// MatrixWaypoint is a waypoint's position as the matrix last saw it.
+type MatrixWaypoint struct {
+	Symbol string
+	X, Y   float64
+}
+
+// MatrixCell is the precomputed cost of one hop. Fuel and Seconds are indexed
+// by shared.FlightMode.
+type MatrixCell struct {
+	Distance float64
+	Fuel     [4]int
+	Seconds  [4]int
+}
+
+// FuelCost returns the hop's fuel in mode.
+func (c MatrixCell) FuelCost(mode shared.FlightMode) int {
+	return c.Fuel[mode]
+}
+
+// TravelTime returns the hop's flight time in mode, in seconds.
+func (c MatrixCell) TravelTime(mode shared.FlightMode) int {
+	return c.Seconds[mode]
+}
+
+// DistanceMatrix holds the distance, fuel and flight time of every hop between
+// a system's waypoints for one ship profile. Fuel depends only on distance and
+// time only on engine speed, so the profile is the engine speed: every hull
+// with the same engine shares a matrix.
+//
+// A matrix is immutable once built; Updated returns a new one, so a matrix
+// handed to a routing backend is never changed under it.
+type DistanceMatrix struct {
+	SystemSymbol string
+	EngineSpeed  int
+	Waypoints    []MatrixWaypoint
+	Cells        [][]MatrixCell // Cells[i][j]: Waypoints[i] → Waypoints[j]
+
+	index map[string]int
+}
+
+// NewDistanceMatrix computes the full matrix over waypoints.
+func NewDistanceMatrix(systemSymbol string, engineSpeed int, waypoints []*system.WaypointData) *DistanceMatrix {
+	m, _ := RestoreDistanceMatrix(systemSymbol, engineSpeed, nil, nil).Updated(waypoints)
+	return m
+}
+
+// RestoreDistanceMatrix rebuilds a matrix read back from storage. cells must
+// be len(waypoints) square.
+func RestoreDistanceMatrix(systemSymbol string, engineSpeed int, waypoints []MatrixWaypoint, cells [][]MatrixCell) *DistanceMatrix {
+	m := &DistanceMatrix{
+		SystemSymbol: systemSymbol,
+		EngineSpeed:  engineSpeed,
+		Waypoints:    waypoints,
+		Cells:        cells,
+		index:        make(map[string]int, len(waypoints)),
+	}
+	for i, wp := range waypoints {
+		m.index[wp.Symbol] = i
+	}
+	return m
+}
+
+// Updated returns the matrix for waypoints, recomputing only the rows and
+// columns of waypoints that were added or moved since m was built, together
+// with how many waypoints changed (added, moved or removed). When nothing
+// changed it returns m itself.
+func (m *DistanceMatrix) Updated(waypoints []*system.WaypointData) (*DistanceMatrix, int) {
+	previous := m.index
+	seen := make(map[string]bool, len(waypoints))
+	next := &DistanceMatrix{
+		SystemSymbol: m.SystemSymbol,
+		EngineSpeed:  m.EngineSpeed,
+		Waypoints:    make([]MatrixWaypoint, 0, len(waypoints)),
+	}
+	// oldIdx[i] is the row waypoint i reuses from m, or -1 when it must be
+	// recomputed.
+	var oldIdx []int
+	changed := 0
+	for _, wp := range waypoints {
+		if seen[wp.Symbol] {
+			continue
+		}
+		seen[wp.Symbol] = true
+		next.Waypoints = append(next.Waypoints, MatrixWaypoint{Symbol: wp.Symbol, X: wp.X, Y: wp.Y})
+		i, ok := previous[wp.Symbol]
+		if !ok || m.Waypoints[i].X != wp.X || m.Waypoints[i].Y != wp.Y {
+			oldIdx = append(oldIdx, -1)
+			changed++
+			continue
+		}
+		oldIdx = append(oldIdx, i)
+	}
+	for _, wp := range m.Waypoints {
+		if !seen[wp.Symbol] {
+			changed++ // removed
+		}
+	}
+	if changed == 0 {
+		return m, 0
+	}
+
+	next.index = make(map[string]int, len(next.Waypoints))
+	for i, wp := range next.Waypoints {
+		next.index[wp.Symbol] = i
+	}
+	next.Cells = make([][]MatrixCell, len(next.Waypoints))
+	for i, from := range next.Waypoints {
+		row := make([]MatrixCell, len(next.Waypoints))
+		for j, to := range next.Waypoints {
+			if oldIdx[i] >= 0 && oldIdx[j] >= 0 {
+				row[j] = m.Cells[oldIdx[i]][oldIdx[j]]
+				continue
+			}
+			row[j] = NewMatrixCell(math.Hypot(to.X-from.X, to.Y-from.Y), m.EngineSpeed)
+		}
+		next.Cells[i] = row
+	}
+	return next, changed
+}
+
+// Cell returns the hop from one waypoint to another, or false when either is
+// not in the matrix.
+func (m *DistanceMatrix) Cell(from, to string) (MatrixCell, bool) {
+	i, ok := m.index[from]
+	if !ok {
+		return MatrixCell{}, false
+	}
+	j, ok := m.index[to]
+	if !ok {
+		return MatrixCell{}, false
+	}
+	return m.Cells[i][j], true
+}
+
+// Covers reports whether the matrix prices every hop between waypoints at
+// their current positions for engineSpeed, so a planner can use it in place of
+// computing distances itself.
+func (m *DistanceMatrix) Covers(engineSpeed int, waypoints []*system.WaypointData) bool {
+	if m == nil || m.EngineSpeed != engineSpeed {
+		return false
+	}
+	for _, wp := range waypoints {
+		i, ok := m.index[wp.Symbol]
+		if !ok || m.Waypoints[i].X != wp.X || m.Waypoints[i].Y != wp.Y {
+			return false
+		}
+	}
+	return true
+}
+
+// Index returns waypoint's row in Waypoints and Cells.
+func (m *DistanceMatrix) Index(waypoint string) (int, bool) {
+	i, ok := m.index[waypoint]
+	return i, ok
+}
+
+// NewMatrixCell prices a hop of distance for engineSpeed in every flight mode.
+func NewMatrixCell(distance float64, engineSpeed int) MatrixCell {
+	cell := MatrixCell{Distance: distance}
+	for _, mode := range matrixModes {
+		cell.Fuel[mode] = mode.FuelCost(distance)
+		cell.Seconds[mode] = mode.TravelTime(distance, engineSpeed)
+	}
+	return cell
+}
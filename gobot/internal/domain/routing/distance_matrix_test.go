@@ -0,0 +1,56 @@
+package routing
+
+import (
+	"testing"
+
+	"github.com/andrescamacho/spacetraders-go/internal/domain/shared"
+	"github.com/andrescamacho/spacetraders-go/internal/domain/system"
+)
+
+// Updating a matrix recomputes only waypoints that moved or appeared: a hop
+// between two unchanged waypoints keeps its old cell, even a doctored one.
+func TestDistanceMatrix_UpdatedRecomputesOnlyChangedWaypoints(t *testing.T) {
+	waypoints := []*system.WaypointData{
+		{Symbol: "X1-A", X: 0, Y: 0},
+		{Symbol: "X1-B", X: 30, Y: 40},
+		{Symbol: "X1-C", X: 100, Y: 0},
+	}
+	m := NewDistanceMatrix("X1", 30, waypoints)
+	cell, ok := m.Cell("X1-A", "X1-B")
+	if !ok || cell.Distance != 50 || cell.FuelCost(shared.FlightModeCruise) != shared.FlightModeCruise.FuelCost(50) {
+		t.Fatalf("A→B = %+v, want distance 50", cell)
+	}
+
+	same, changed := m.Updated(waypoints)
+	if same != m || changed != 0 {
+		t.Fatalf("unchanged waypoints should return the same matrix, got changed=%d", changed)
+	}
+
+	m.Cells[0][1].Distance = 999 // marks the reused cell
+	next, changed := m.Updated([]*system.WaypointData{
+		{Symbol: "X1-A", X: 0, Y: 0},
+		{Symbol: "X1-B", X: 30, Y: 40},
+		{Symbol: "X1-C", X: 0, Y: 60}, // moved
+		{Symbol: "X1-D", X: 0, Y: 10}, // added
+	})
+	if changed != 2 {
+		t.Fatalf("changed = %d, want 2", changed)
+	}
+	if cell, _ := next.Cell("X1-A", "X1-B"); cell.Distance != 999 {
+		t.Fatalf("A→B was recomputed: %+v", cell)
+	}
+	if cell, _ := next.Cell("X1-A", "X1-C"); cell.Distance != 60 {
+		t.Fatalf("A→C = %.0f, want 60 after the move", cell.Distance)
+	}
+	if cell, _ := next.Cell("X1-D", "X1-A"); cell.Distance != 10 {
+		t.Fatalf("D→A = %.0f, want 10", cell.Distance)
+	}
+
+	pruned, changed := next.Updated(waypoints[:2])
+	if changed != 2 || len(pruned.Waypoints) != 2 {
+		t.Fatalf("dropping C and D: changed=%d waypoints=%d, want 2/2", changed, len(pruned.Waypoints))
+	}
+	if pruned.Covers(30, waypoints) || !pruned.Covers(30, waypoints[:2]) || pruned.Covers(10, waypoints[:2]) {
+		t.Fatal("Covers should require every waypoint, unmoved, at the matrix's engine speed")
+	}
+}
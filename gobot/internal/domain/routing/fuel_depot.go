@@ -0,0 +1,107 @@
+package routing
+
+import (
+	"math"
+	"sort"
+
+	"github.com/andrescamacho/spacetraders-go/internal/domain/system"
+)
+
+const (
+	// fuelDepotPriceWeight and fuelDepotCentralityWeight split a depot's score
+	// between what a unit of FUEL costs there and how far it sits from the rest
+	// of the system. Price dominates: a central depot that charges double is
+	// never the better refuel stop.
+	fuelDepotPriceWeight      = 0.7
+	fuelDepotCentralityWeight = 0.3
+
+	// preferredFuelDepotSlack is how far above the best score a depot may score
+	// and still be preferred, so near-ties between cheap depots stay routable.
+	preferredFuelDepotSlack = 0.10
+)
+
+// FuelDepot is one FUEL-selling market ranked as a refuel stop
+type FuelDepot struct {
+	WaypointSymbol string
+	Price          int     // credits per unit of FUEL
+	MeanDistance   float64 // mean distance to every other waypoint; lower is more central
+	Score          float64 // weighted, normalised price and centrality; lower is better
+}
+
+// RankFuelDepots ranks the waypoints with a FUEL price in prices, best refuel
+// stop first. Price and mean distance are each normalised against the best
+// depot in the system, so a score of 1.0 is a depot that is both the cheapest
+// and the most central. Waypoints without a positive price are not depots.
+func RankFuelDepots(waypoints []*system.WaypointData, prices map[string]int) []FuelDepot {
+	var depots []FuelDepot
+	for _, wp := range waypoints {
+		price, ok := prices[wp.Symbol]
+		if !ok || price <= 0 {
+			continue
+		}
+		depots = append(depots, FuelDepot{
+			WaypointSymbol: wp.Symbol,
+			Price:          price,
+			MeanDistance:   meanDistance(wp, waypoints),
+		})
+	}
+	if len(depots) == 0 {
+		return nil
+	}
+
+	minPrice, minDistance := depots[0].Price, depots[0].MeanDistance
+	for _, d := range depots[1:] {
+		minPrice = min(minPrice, d.Price)
+		minDistance = math.Min(minDistance, d.MeanDistance)
+	}
+	for i := range depots {
+		centrality := 1.0
+		if minDistance > 0 {
+			centrality = depots[i].MeanDistance / minDistance
+		}
+		depots[i].Score = fuelDepotPriceWeight*float64(depots[i].Price)/float64(minPrice) +
+			fuelDepotCentralityWeight*centrality
+	}
+
+	sort.SliceStable(depots, func(i, j int) bool {
+		if depots[i].Score != depots[j].Score {
+			return depots[i].Score < depots[j].Score
+		}
+		return depots[i].WaypointSymbol < depots[j].WaypointSymbol
+	})
+	return depots
+}
+
+// PreferredFuelDepots returns the depots of a ranking that score within
+// preferredFuelDepotSlack of the best one. Route planning offers only these as
+// refuel stops when a route through them exists.
+func PreferredFuelDepots(ranked []FuelDepot) map[string]bool {
+	preferred := make(map[string]bool)
+	if len(ranked) == 0 {
+		return preferred
+	}
+	cutoff := ranked[0].Score * (1 + preferredFuelDepotSlack)
+	for _, d := range ranked {
+		if d.Score > cutoff {
+			break
+		}
+		preferred[d.WaypointSymbol] = true
+	}
+	return preferred
+}
+
+// meanDistance is the mean straight-line distance from wp to every other waypoint
+func meanDistance(wp *system.WaypointData, waypoints []*system.WaypointData) float64 {
+	total, n := 0.0, 0
+	for _, other := range waypoints {
+		if other.Symbol == wp.Symbol {
+			continue
+		}
+		total += math.Hypot(other.X-wp.X, other.Y-wp.Y)
+		n++
+	}
+	if n == 0 {
+		return 0
+	}
+	return total / float64(n)
+}
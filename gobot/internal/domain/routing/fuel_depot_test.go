@@ -0,0 +1,65 @@
+package routing
+
+import (
+	"testing"
+
+	"github.com/andrescamacho/spacetraders-go/internal/domain/system"
+)
+
+func depotWaypoints() []*system.WaypointData {
+	return []*system.WaypointData{
+		{Symbol: "X1-A1", X: 0, Y: 0, HasFuel: true},    // central, pricey
+		{Symbol: "X1-B2", X: 10, Y: 0, HasFuel: true},   // near-central, cheap
+		{Symbol: "X1-C3", X: 200, Y: 0, HasFuel: true},  // remote, cheap
+		{Symbol: "X1-D4", X: -20, Y: 0, HasFuel: false}, // no market
+	}
+}
+
+// Price outweighs centrality: the cheap near-central depot beats both the
+// pricey central one and the equally cheap remote one.
+func TestRankFuelDepots_PrefersCheapCentralDepot(t *testing.T) {
+	ranked := RankFuelDepots(depotWaypoints(), map[string]int{
+		"X1-A1": 110,
+		"X1-B2": 70,
+		"X1-C3": 70,
+	})
+
+	if len(ranked) != 3 {
+		t.Fatalf("expected 3 depots, got %d", len(ranked))
+	}
+	order := []string{ranked[0].WaypointSymbol, ranked[1].WaypointSymbol, ranked[2].WaypointSymbol}
+	want := []string{"X1-B2", "X1-A1", "X1-C3"}
+	for i := range want {
+		if order[i] != want[i] {
+			t.Fatalf("ranking = %v, want %v", order, want)
+		}
+	}
+
+	preferred := PreferredFuelDepots(ranked)
+	if len(preferred) != 1 || !preferred["X1-B2"] {
+		t.Fatalf("only the cheap central depot should be preferred, got %v", preferred)
+	}
+}
+
+// Depots that score within the slack of the best are all preferred, so a near
+// tie does not force a detour to a single station.
+func TestPreferredFuelDepots_KeepsNearTies(t *testing.T) {
+	ranked := RankFuelDepots(depotWaypoints(), map[string]int{
+		"X1-A1": 72,
+		"X1-B2": 70,
+	})
+
+	preferred := PreferredFuelDepots(ranked)
+	if !preferred["X1-A1"] || !preferred["X1-B2"] {
+		t.Fatalf("near-tied depots should both be preferred, got %v", preferred)
+	}
+}
+
+func TestRankFuelDepots_NoPricesNoDepots(t *testing.T) {
+	if ranked := RankFuelDepots(depotWaypoints(), nil); ranked != nil {
+		t.Fatalf("expected no depots, got %v", ranked)
+	}
+	if preferred := PreferredFuelDepots(nil); len(preferred) != 0 {
+		t.Fatalf("expected no preferred depots, got %v", preferred)
+	}
+}
@@ -0,0 +1,45 @@
+package routing
+
+// RouteObjective weighs what a planned route costs. The planner minimizes
+//
+//	TimeWeight*seconds + FuelWeight*fuel + RefuelStopWeight*refuelStops
+//
+// so the weights are exchange rates: FuelWeight 2 says a unit of fuel is worth
+// two seconds of flight. The zero value means "fastest route", the planner's
+// behavior before objectives existed.
+type RouteObjective struct {
+	TimeWeight       float64 // cost per second of travel
+	FuelWeight       float64 // cost per unit of fuel burned
+	RefuelStopWeight float64 // cost per refuel stop
+}
+
+// ObjectiveFastest favours arrival time, with a token fuel cost that breaks ties
+// between equally quick routes. Contract runs use it: a delivery's payout waits
+// on the hull, fuel is cheap by comparison.
+var ObjectiveFastest = RouteObjective{TimeWeight: 1, FuelWeight: 0.1}
+
+// ObjectiveFuelSaver favours fuel and avoids refuel stops, accepting slower
+// flight modes. Scout tours use it: a probe's tour has no deadline, and every
+// refuel stop is a docked detour.
+var ObjectiveFuelSaver = RouteObjective{TimeWeight: 0.2, FuelWeight: 5, RefuelStopWeight: 120}
+
+// IsZero reports whether no weights are set.
+func (o RouteObjective) IsZero() bool {
+	return o.TimeWeight == 0 && o.FuelWeight == 0 && o.RefuelStopWeight == 0
+}
+
+// Resolved returns the weights the planner applies: the zero objective becomes
+// pure travel time.
+func (o RouteObjective) Resolved() RouteObjective {
+	if o.IsZero() {
+		return RouteObjective{TimeWeight: 1}
+	}
+	return o
+}
+
+// Cost scores a leg or route of the given duration, fuel burn and refuel stops
+// under the resolved weights.
+func (o RouteObjective) Cost(seconds, fuel, refuelStops int) float64 {
+	r := o.Resolved()
+	return r.TimeWeight*float64(seconds) + r.FuelWeight*float64(fuel) + r.RefuelStopWeight*float64(refuelStops)
+}
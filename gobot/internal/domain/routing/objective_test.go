@@ -0,0 +1,13 @@
+package routing
+
+import "testing"
+
+func TestRouteObjectiveCost(t *testing.T) {
+	if got := (RouteObjective{}).Cost(100, 50, 1); got != 100 {
+		t.Fatalf("the zero objective should cost travel time only, got %v", got)
+	}
+	objective := RouteObjective{TimeWeight: 0.5, FuelWeight: 2, RefuelStopWeight: 30}
+	if got := objective.Cost(100, 50, 1); got != 50+100+30 {
+		t.Fatalf("expected 180, got %v", got)
+	}
+}
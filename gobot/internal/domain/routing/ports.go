@@ -23,6 +23,16 @@ type RoutingClient interface {
 	OptimizeTradeTour(ctx context.Context, snapshot []TourGoodSnapshot, waypoints []TourWaypoint, ship TourShipState, cons TourConstraints, deposits []TourDepositCandidate, absorption []TourMarketAbsorption) (*TourPlan, error)
 }
 
+// DistanceMatrixRepository persists distance matrices alongside the system
+// graph, one per (system, engine speed).
+type DistanceMatrixRepository interface {
+	// Get returns the stored matrix, or nil when there is none.
+	Get(ctx context.Context, systemSymbol string, engineSpeed int) (*DistanceMatrix, error)
+
+	// Save upserts matrix.
+	Save(ctx context.Context, matrix *DistanceMatrix) error
+}
+
 // DTOs for routing operations
 
 type RouteRequest struct {
@@ -35,6 +45,16 @@ type RouteRequest struct {
 	Waypoints     []*system.WaypointData
 	FuelEfficient bool // When true, removes DRIFT penalty for fuel-efficient routes
 	PreferCruise  bool // When true, prefer CRUISE over BURN for fuel efficiency
+	// AvoidDrift forbids DRIFT hops, so a leg out of range refuels on the way
+	// instead. The OR-Tools backend has no such switch; it only keeps its DRIFT
+	// penalty (FuelEfficient is dropped).
+	AvoidDrift bool
+	// Objective weighs travel time against fuel and refuel stops. The zero
+	// value plans the fastest route.
+	Objective RouteObjective
+	// Matrix optionally prices every hop between Waypoints. A backend uses it
+	// only when it Covers the request's waypoints at EngineSpeed.
+	Matrix *DistanceMatrix
 }
 
 type RouteResponse struct {
@@ -0,0 +1,57 @@
+package routing
+
+import "time"
+
+// RouteSimulation is a planned route flown step by step from a ship's actual
+// fuel: what it costs in time, how much of that is spent drifting, and whether
+// the fuel aboard carries every leg.
+type RouteSimulation struct {
+	TravelSeconds int
+	DriftSeconds  int
+	DriftLegs     int
+	RefuelStops   int
+	MinFuel       int    // least fuel aboard on arrival anywhere on the route
+	StrandedAt    string // first waypoint a leg cannot reach on the fuel aboard; "" when none
+}
+
+// SimulateRoute walks plan's steps with currentFuel aboard, refuelling to
+// fuelCapacity at each refuel step.
+func SimulateRoute(plan *RouteResponse, currentFuel, fuelCapacity int) RouteSimulation {
+	sim := RouteSimulation{MinFuel: currentFuel}
+	fuel := currentFuel
+	for _, step := range plan.Steps {
+		if step.Action == RouteActionRefuel {
+			fuel = fuelCapacity
+			sim.RefuelStops++
+			continue
+		}
+		if step.FuelCost > fuel && sim.StrandedAt == "" {
+			sim.StrandedAt = step.Waypoint
+		}
+		fuel -= step.FuelCost
+		if fuel < sim.MinFuel {
+			sim.MinFuel = fuel
+		}
+		sim.TravelSeconds += step.TimeSeconds
+		if step.Mode == "DRIFT" {
+			sim.DriftSeconds += step.TimeSeconds
+			sim.DriftLegs++
+		}
+	}
+	return sim
+}
+
+// Stranded reports whether some leg burns more fuel than is aboard.
+func (s RouteSimulation) Stranded() bool {
+	return s.StrandedAt != ""
+}
+
+// RejectsDrift reports whether a drifting plan should be replaced: it drifts
+// and either takes longer than maxTravel or strands the ship. A plan without
+// DRIFT legs is never rejected here; there is nothing to trade for a refuel.
+func (s RouteSimulation) RejectsDrift(maxTravel time.Duration) bool {
+	if s.DriftLegs == 0 {
+		return false
+	}
+	return s.Stranded() || time.Duration(s.TravelSeconds)*time.Second > maxTravel
+}
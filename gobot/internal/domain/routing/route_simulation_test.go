@@ -0,0 +1,39 @@
+package routing
+
+import (
+	"testing"
+	"time"
+)
+
+// The simulator carries the ship's actual fuel through refuels: the second
+// leg strands a ship that starts with too little, and drift time counts
+// toward the limit only for plans that drift.
+func TestSimulateRoute_TracksFuelAndDrift(t *testing.T) {
+	plan := &RouteResponse{Steps: []*RouteStepData{
+		{Action: RouteActionTravel, Waypoint: "X1-B", FuelCost: 30, TimeSeconds: 100, Mode: "CRUISE"},
+		{Action: RouteActionTravel, Waypoint: "X1-C", FuelCost: 1, TimeSeconds: 5000, Mode: "DRIFT"},
+		{Action: RouteActionRefuel, Waypoint: "X1-C"},
+		{Action: RouteActionTravel, Waypoint: "X1-D", FuelCost: 90, TimeSeconds: 200, Mode: "CRUISE"},
+	}}
+
+	sim := SimulateRoute(plan, 40, 100)
+	if sim.TravelSeconds != 5300 || sim.DriftSeconds != 5000 || sim.DriftLegs != 1 || sim.RefuelStops != 1 {
+		t.Fatalf("unexpected simulation %+v", sim)
+	}
+	if sim.Stranded() || sim.MinFuel != 9 {
+		t.Fatalf("40 fuel should carry the route with 9 left at worst, got %+v", sim)
+	}
+	if !sim.RejectsDrift(time.Hour) || sim.RejectsDrift(2*time.Hour) {
+		t.Fatal("a 5300s drifting route breaches a 1h limit but not a 2h one")
+	}
+
+	short := SimulateRoute(plan, 20, 100)
+	if short.StrandedAt != "X1-B" || !short.RejectsDrift(2*time.Hour) {
+		t.Fatalf("20 fuel cannot reach X1-B and strands a drifting plan, got %+v", short)
+	}
+
+	plan.Steps[1].Mode = "CRUISE"
+	if SimulateRoute(plan, 40, 100).RejectsDrift(time.Minute) {
+		t.Fatal("a plan without DRIFT legs is never rejected by the drift gate")
+	}
+}
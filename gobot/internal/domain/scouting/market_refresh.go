@@ -0,0 +1,132 @@
+package scouting
+
+import (
+	"sort"
+	"time"
+)
+
+// Market refresh policy defaults, used when the launch config leaves a knob unset
+// (RULINGS #5).
+const (
+	// DefaultRefreshLeadPercent schedules a market once its age reaches this share
+	// of the SLA, so the tour lands before the breach rather than after it.
+	DefaultRefreshLeadPercent = 75
+	// DefaultRefreshMaxMarkets caps one refresh pass so a badly stale system is
+	// worked down over several passes instead of one fleet-wide tour.
+	DefaultRefreshMaxMarkets = 12
+	// DefaultRefreshUsageWindow is how far back trading activity counts towards a
+	// market's importance.
+	DefaultRefreshUsageWindow = 24 * time.Hour
+	// DefaultArbitrageUseWeight and DefaultManufacturingUseWeight convert one
+	// recorded use into importance on top of the baseline 1.0 every market has.
+	DefaultArbitrageUseWeight     = 0.5
+	DefaultManufacturingUseWeight = 1.0
+)
+
+// MarketRefreshCandidate is one market's freshness and trading importance, as
+// read from scan timestamps and recent trading activity.
+type MarketRefreshCandidate struct {
+	WaypointSymbol string
+	// Scanned is false for a charted market with no market_data yet; AgeSeconds
+	// is meaningless then and the market ranks as maximally stale.
+	Scanned    bool
+	AgeSeconds float64
+	// ArbitrageUses counts cargo trades at the market by arbitrage and trade-route
+	// runs inside the usage window.
+	ArbitrageUses int
+	// ManufacturingUses counts cargo trades at the market by manufacturing and
+	// construction runs inside the usage window, plus active manufacturing tasks
+	// that source from, sell to, or feed a factory at it.
+	ManufacturingUses int
+}
+
+// MarketRefreshPolicy ranks markets for refresh against a freshness SLA.
+type MarketRefreshPolicy struct {
+	SLA                    time.Duration
+	LeadPercent            int
+	MaxMarkets             int
+	ArbitrageUseWeight     float64
+	ManufacturingUseWeight float64
+}
+
+// MarketRefreshTask is one market the scheduler wants re-scanned, with the
+// figures that ranked it.
+type MarketRefreshTask struct {
+	WaypointSymbol string
+	AgeSeconds     float64
+	Scanned        bool
+	Importance     float64
+	Score          float64
+	// Breached is true when the market is already past the SLA, not merely
+	// inside the lead window.
+	Breached bool
+}
+
+// withDefaults fills unset knobs.
+func (p MarketRefreshPolicy) withDefaults() MarketRefreshPolicy {
+	if p.LeadPercent <= 0 || p.LeadPercent > 100 {
+		p.LeadPercent = DefaultRefreshLeadPercent
+	}
+	if p.MaxMarkets <= 0 {
+		p.MaxMarkets = DefaultRefreshMaxMarkets
+	}
+	if p.ArbitrageUseWeight <= 0 {
+		p.ArbitrageUseWeight = DefaultArbitrageUseWeight
+	}
+	if p.ManufacturingUseWeight <= 0 {
+		p.ManufacturingUseWeight = DefaultManufacturingUseWeight
+	}
+	return p
+}
+
+// Importance is the market's trading weight: 1 for any market, plus the
+// weighted arbitrage and manufacturing uses.
+func (p MarketRefreshPolicy) Importance(c MarketRefreshCandidate) float64 {
+	p = p.withDefaults()
+	return 1 + p.ArbitrageUseWeight*float64(c.ArbitrageUses) + p.ManufacturingUseWeight*float64(c.ManufacturingUses)
+}
+
+// Plan returns the markets due for refresh, most urgent first: every market
+// whose age has reached LeadPercent of the SLA (never-scanned markets always
+// qualify), scored by age/SLA × importance and capped at MaxMarkets. Never-
+// scanned markets score as twice the SLA old. Ties break on waypoint symbol so
+// the plan is deterministic. A non-positive SLA plans nothing.
+func (p MarketRefreshPolicy) Plan(candidates []MarketRefreshCandidate) []MarketRefreshTask {
+	if p.SLA <= 0 {
+		return nil
+	}
+	p = p.withDefaults()
+	slaSeconds := p.SLA.Seconds()
+	dueAt := slaSeconds * float64(p.LeadPercent) / 100
+
+	tasks := make([]MarketRefreshTask, 0, len(candidates))
+	for _, c := range candidates {
+		ageRatio := 2.0
+		if c.Scanned {
+			if c.AgeSeconds < dueAt {
+				continue
+			}
+			ageRatio = c.AgeSeconds / slaSeconds
+		}
+		importance := p.Importance(c)
+		tasks = append(tasks, MarketRefreshTask{
+			WaypointSymbol: c.WaypointSymbol,
+			AgeSeconds:     c.AgeSeconds,
+			Scanned:        c.Scanned,
+			Importance:     importance,
+			Score:          ageRatio * importance,
+			Breached:       !c.Scanned || c.AgeSeconds >= slaSeconds,
+		})
+	}
+
+	sort.SliceStable(tasks, func(i, j int) bool {
+		if tasks[i].Score != tasks[j].Score {
+			return tasks[i].Score > tasks[j].Score
+		}
+		return tasks[i].WaypointSymbol < tasks[j].WaypointSymbol
+	})
+	if len(tasks) > p.MaxMarkets {
+		tasks = tasks[:p.MaxMarkets]
+	}
+	return tasks
+}
@@ -0,0 +1,55 @@
+package scouting
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func plannedSymbols(tasks []MarketRefreshTask) []string {
+	out := make([]string, len(tasks))
+	for i, task := range tasks {
+		out[i] = task.WaypointSymbol
+	}
+	return out
+}
+
+// Only markets inside the lead window are due; a heavily traded market outranks an
+// older idle one, and a never-scanned market scores as twice the SLA old.
+func TestMarketRefreshPolicy_RanksByAgeAndImportance(t *testing.T) {
+	policy := MarketRefreshPolicy{SLA: time.Hour}
+	tasks := policy.Plan([]MarketRefreshCandidate{
+		{WaypointSymbol: "X1-A-FRESH", Scanned: true, AgeSeconds: 600, ManufacturingUses: 10},
+		{WaypointSymbol: "X1-A-IDLE", Scanned: true, AgeSeconds: 5400},
+		{WaypointSymbol: "X1-A-ARB", Scanned: true, AgeSeconds: 3000, ArbitrageUses: 2},
+		{WaypointSymbol: "X1-A-NEW"},
+	})
+
+	require.Equal(t, []string{"X1-A-NEW", "X1-A-ARB", "X1-A-IDLE"}, plannedSymbols(tasks),
+		"fresh market skipped; never-scanned scores 2.0, 3000s×2 outranks 5400s×1")
+	require.True(t, tasks[0].Breached, "a never-scanned market is already out of SLA")
+	require.False(t, tasks[1].Breached, "3000s is due (lead window) but not yet breached")
+	require.True(t, tasks[2].Breached)
+	require.Equal(t, 2.0, tasks[1].Importance)
+}
+
+// MaxMarkets caps one pass, keeping the highest scores; ties break on symbol.
+func TestMarketRefreshPolicy_CapsPass(t *testing.T) {
+	policy := MarketRefreshPolicy{SLA: time.Hour, MaxMarkets: 2}
+	tasks := policy.Plan([]MarketRefreshCandidate{
+		{WaypointSymbol: "X1-A-C", Scanned: true, AgeSeconds: 4000},
+		{WaypointSymbol: "X1-A-B", Scanned: true, AgeSeconds: 4000},
+		{WaypointSymbol: "X1-A-A", Scanned: true, AgeSeconds: 3600},
+	})
+	require.Equal(t, []string{"X1-A-B", "X1-A-C"}, plannedSymbols(tasks))
+}
+
+// The lead percent moves the due threshold; no SLA plans nothing.
+func TestMarketRefreshPolicy_LeadAndDisabled(t *testing.T) {
+	candidates := []MarketRefreshCandidate{{WaypointSymbol: "X1-A-M", Scanned: true, AgeSeconds: 1900}}
+
+	require.Empty(t, MarketRefreshPolicy{SLA: time.Hour, LeadPercent: 75}.Plan(candidates))
+	require.Len(t, MarketRefreshPolicy{SLA: time.Hour, LeadPercent: 50}.Plan(candidates), 1)
+	require.Empty(t, MarketRefreshPolicy{}.Plan(candidates))
+}
@@ -1,6 +1,9 @@
 package scouting
 
-import "context"
+import (
+	"context"
+	"time"
+)
 
 // ScoutPostRepository is the persistence port for the desired-state posts table.
 // All reads are scoped to the open era so a universe reset never leaves the
@@ -71,3 +74,11 @@ type MarketFreshnessSample struct {
 type SystemFreshnessReader interface {
 	SystemsFreshness(ctx context.Context, playerID int) ([]SystemFreshnessSnapshot, error)
 }
+
+// MarketRefreshReader supplies the per-market refresh census the market refresh
+// scheduler ranks: every scanned market in systemSymbol with its age at now and its
+// arbitrage/manufacturing usage since usageSince. Markets with no market_data are
+// absent; the scheduler adds the charted-but-unscanned ones itself.
+type MarketRefreshReader interface {
+	MarketRefreshCandidates(ctx context.Context, playerID int, systemSymbol string, now, usageSince time.Time) ([]MarketRefreshCandidate, error)
+}
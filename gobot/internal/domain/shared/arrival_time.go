@@ -50,12 +50,17 @@ func parseArrivalTimestamp(timestamp string) (time.Time, error) {
 //
 //	Seconds to wait (minimum 0)
 func (a *ArrivalTime) CalculateWaitTime() int {
+	return a.WaitTimeAt(time.Now().UTC())
+}
+
+// WaitTimeAt is CalculateWaitTime measured from now instead of the wall clock,
+// for callers running on an injected Clock.
+func (a *ArrivalTime) WaitTimeAt(now time.Time) int {
 	arrivalTime, err := parseArrivalTimestamp(a.timestamp)
 	if err != nil {
 		return 0
 	}
 
-	now := time.Now().UTC()
 	waitSeconds := arrivalTime.Sub(now).Seconds()
 
 	if waitSeconds < 0 {
@@ -69,6 +74,13 @@ func (a *ArrivalTime) Timestamp() string {
 	return a.timestamp
 }
 
+// Time returns the arrival as a time.Time. The timestamp was validated at
+// construction, so parsing cannot fail here.
+func (a *ArrivalTime) Time() time.Time {
+	arrivalTime, _ := parseArrivalTimestamp(a.timestamp)
+	return arrivalTime
+}
+
 func (a *ArrivalTime) String() string {
 	return fmt.Sprintf("ArrivalTime(%s)", a.timestamp)
 }
@@ -1,6 +1,11 @@
 package shared
 
-import "time"
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+)
 
 // Clock is an abstraction for time operations, allowing time to be mocked in tests
 type Clock interface {
@@ -8,6 +13,23 @@ type Clock interface {
 	Sleep(d time.Duration)
 }
 
+// TimerClock is a Clock that can also deliver a timer channel. Waits that race a
+// timeout against other channels in a select use it through After, so a
+// SimulatedClock can fire them without real time passing.
+type TimerClock interface {
+	Clock
+	After(d time.Duration) <-chan time.Time
+}
+
+// After returns a channel that receives once d has elapsed on clock. Clocks that
+// are not TimerClocks fall back to the real time.After.
+func After(clock Clock, d time.Duration) <-chan time.Time {
+	if tc, ok := clock.(TimerClock); ok {
+		return tc.After(d)
+	}
+	return time.After(d)
+}
+
 // RealClock implements Clock using the actual system time
 type RealClock struct{}
 
@@ -20,6 +42,10 @@ func (r *RealClock) Sleep(d time.Duration) {
 	time.Sleep(d)
 }
 
+func (r *RealClock) After(d time.Duration) <-chan time.Time {
+	return time.After(d)
+}
+
 // MockClock implements Clock with a controllable time for testing
 type MockClock struct {
 	CurrentTime time.Time
@@ -41,3 +67,154 @@ func (m *MockClock) Advance(d time.Duration) {
 func NewRealClock() Clock {
 	return &RealClock{}
 }
+
+// SimulatedClock is a goroutine-safe clock for end-to-end scenarios. Unlike
+// MockClock, Sleep and After genuinely block until the test moves time past
+// their deadline with Advance or AdvanceToNextWaiter, so coordinators running on
+// their own goroutines wait exactly as they would in production while a whole
+// scout → trade → sell run takes milliseconds of real time.
+type SimulatedClock struct {
+	mu      sync.Mutex
+	now     time.Time
+	waiters []simulatedWaiter
+	changed chan struct{}
+}
+
+type simulatedWaiter struct {
+	at time.Time
+	ch chan time.Time
+}
+
+// NewSimulatedClock returns a SimulatedClock reading start.
+func NewSimulatedClock(start time.Time) *SimulatedClock {
+	return &SimulatedClock{now: start, changed: make(chan struct{})}
+}
+
+func (s *SimulatedClock) Now() time.Time {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.now
+}
+
+// Sleep blocks until the clock has been advanced by d.
+func (s *SimulatedClock) Sleep(d time.Duration) {
+	<-s.After(d)
+}
+
+// After returns a channel that receives the simulated time once the clock has
+// been advanced by d. A non-positive d fires immediately.
+func (s *SimulatedClock) After(d time.Duration) <-chan time.Time {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	ch := make(chan time.Time, 1)
+	if d <= 0 {
+		ch <- s.now
+		return ch
+	}
+	s.waiters = append(s.waiters, simulatedWaiter{at: s.now.Add(d), ch: ch})
+	s.notifyLocked()
+	return ch
+}
+
+// Advance moves the clock forward by d, firing every waiter due on the way in
+// deadline order with the clock reading that waiter's deadline.
+func (s *SimulatedClock) Advance(d time.Duration) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	target := s.now.Add(d)
+	for s.fireNextLocked(target) {
+	}
+	s.now = target
+}
+
+// AdvanceToNextWaiter moves the clock to the earliest pending deadline and fires
+// every waiter due at it. Returns false, leaving the clock alone, when nothing is
+// waiting.
+func (s *SimulatedClock) AdvanceToNextWaiter() bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if len(s.waiters) == 0 {
+		return false
+	}
+	s.sortLocked()
+	next := s.waiters[0].at
+	for s.fireNextLocked(next) {
+	}
+	return true
+}
+
+// Waiters returns how many Sleep or After calls are blocked on the clock.
+func (s *SimulatedClock) Waiters() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return len(s.waiters)
+}
+
+// BlockUntilWaiters waits in real time until at least n callers are blocked on
+// the clock, so a test advances only once the goroutines it drives have
+// reached their wait. Returns false if timeout passes first.
+func (s *SimulatedClock) BlockUntilWaiters(n int, timeout time.Duration) bool {
+	deadline := time.NewTimer(timeout)
+	defer deadline.Stop()
+	for {
+		s.mu.Lock()
+		if len(s.waiters) >= n {
+			s.mu.Unlock()
+			return true
+		}
+		changed := s.changed
+		s.mu.Unlock()
+
+		select {
+		case <-changed:
+		case <-deadline.C:
+			return false
+		}
+	}
+}
+
+// fireNextLocked fires the earliest waiter due at or before until, reporting
+// whether one fired.
+func (s *SimulatedClock) fireNextLocked(until time.Time) bool {
+	if len(s.waiters) == 0 {
+		return false
+	}
+	s.sortLocked()
+	next := s.waiters[0]
+	if next.at.After(until) {
+		return false
+	}
+	s.waiters = s.waiters[1:]
+	if next.at.After(s.now) {
+		s.now = next.at
+	}
+	next.ch <- s.now
+	s.notifyLocked()
+	return true
+}
+
+func (s *SimulatedClock) sortLocked() {
+	sort.SliceStable(s.waiters, func(i, j int) bool { return s.waiters[i].at.Before(s.waiters[j].at) })
+}
+
+func (s *SimulatedClock) notifyLocked() {
+	close(s.changed)
+	s.changed = make(chan struct{})
+}
+
+// WithClock returns a context whose waits run on clock. Code with no clock of its
+// own to inject — the free functions and pollers a coordinator drives — reads it
+// back with ClockFromContext, so one SimulatedClock placed on a scenario's
+// context drives every wait beneath it.
+func WithClock(ctx context.Context, clock Clock) context.Context {
+	return context.WithValue(ctx, clockKey, clock)
+}
+
+// ClockFromContext returns the clock stamped by WithClock, or a RealClock when
+// none was, which is every production path.
+func ClockFromContext(ctx context.Context) Clock {
+	if clock, ok := ctx.Value(clockKey).(Clock); ok && clock != nil {
+		return clock
+	}
+	return NewRealClock()
+}
@@ -0,0 +1,91 @@
+package shared
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+var simulatedStart = time.Date(2024, 3, 1, 12, 0, 0, 0, time.UTC)
+
+func TestSimulatedClockAfterFiresOnlyOnceAdvancedPastDeadline(t *testing.T) {
+	clock := NewSimulatedClock(simulatedStart)
+	ch := clock.After(10 * time.Minute)
+
+	clock.Advance(9 * time.Minute)
+	select {
+	case <-ch:
+		t.Fatalf("timer fired before its deadline")
+	default:
+	}
+
+	clock.Advance(time.Minute)
+	select {
+	case fired := <-ch:
+		if !fired.Equal(simulatedStart.Add(10 * time.Minute)) {
+			t.Fatalf("expected the timer to fire at its deadline, got %s", fired)
+		}
+	default:
+		t.Fatalf("timer did not fire once its deadline passed")
+	}
+	if clock.Waiters() != 0 {
+		t.Fatalf("expected no waiters left, got %d", clock.Waiters())
+	}
+}
+
+func TestSimulatedClockAdvanceFiresWaitersInDeadlineOrder(t *testing.T) {
+	clock := NewSimulatedClock(simulatedStart)
+	late := clock.After(time.Hour)
+	early := clock.After(time.Minute)
+
+	clock.Advance(2 * time.Hour)
+
+	if got := <-early; !got.Equal(simulatedStart.Add(time.Minute)) {
+		t.Fatalf("early timer read %s", got)
+	}
+	if got := <-late; !got.Equal(simulatedStart.Add(time.Hour)) {
+		t.Fatalf("late timer read %s", got)
+	}
+	if !clock.Now().Equal(simulatedStart.Add(2 * time.Hour)) {
+		t.Fatalf("expected the clock to end at the advance target, got %s", clock.Now())
+	}
+}
+
+func TestSimulatedClockSleepBlocksUntilAdvanced(t *testing.T) {
+	clock := NewSimulatedClock(simulatedStart)
+	woke := make(chan time.Time, 1)
+	go func() {
+		clock.Sleep(30 * time.Minute)
+		woke <- clock.Now()
+	}()
+
+	if !clock.BlockUntilWaiters(1, time.Second) {
+		t.Fatalf("sleeper never blocked on the clock")
+	}
+	if !clock.AdvanceToNextWaiter() {
+		t.Fatalf("expected a waiter to advance to")
+	}
+
+	select {
+	case at := <-woke:
+		if !at.Equal(simulatedStart.Add(30 * time.Minute)) {
+			t.Fatalf("sleeper woke at %s", at)
+		}
+	case <-time.After(time.Second):
+		t.Fatalf("sleeper did not wake after the clock advanced")
+	}
+	if clock.AdvanceToNextWaiter() {
+		t.Fatalf("expected no waiter left to advance to")
+	}
+}
+
+func TestClockFromContextDefaultsToRealClock(t *testing.T) {
+	if _, ok := ClockFromContext(context.Background()).(*RealClock); !ok {
+		t.Fatalf("expected a RealClock when the context carries none")
+	}
+
+	clock := NewSimulatedClock(simulatedStart)
+	if ClockFromContext(WithClock(context.Background(), clock)) != Clock(clock) {
+		t.Fatalf("expected the clock stamped on the context")
+	}
+}
@@ -0,0 +1,48 @@
+package shared
+
+import "time"
+
+// EventTopic names a kind of coordination event on the in-process event bus
+type EventTopic string
+
+const (
+	// TopicMarketUpdated fires after a market scan is saved. Subject is the
+	// market's waypoint symbol.
+	TopicMarketUpdated EventTopic = "market.updated"
+
+	// TopicShipArrived fires when a ship leaves IN_TRANSIT. Subject is the ship
+	// symbol; Payload carries "location".
+	TopicShipArrived EventTopic = "ship.arrived"
+
+	// TopicContractAccepted fires after a contract is accepted. Subject is the
+	// contract ID.
+	TopicContractAccepted EventTopic = "contract.accepted"
+
+	// TopicContainerCompleted fires when a container finishes cleanly. Subject
+	// is the container ID; Payload carries "container_type".
+	TopicContainerCompleted EventTopic = "container.completed"
+)
+
+// CoordinationEvent is one message on the event bus. Coordinators use these to
+// react to each other's work as it happens instead of polling the database.
+// Events are hints, not a log: delivery is best-effort, and a subscriber that
+// misses one still finds the state in the database on its next pass.
+type CoordinationEvent struct {
+	Topic      EventTopic
+	PlayerID   int
+	Subject    string
+	Payload    map[string]interface{}
+	OccurredAt time.Time
+}
+
+// CoordinationPublisher publishes coordination events. Publish never blocks.
+type CoordinationPublisher interface {
+	Publish(event CoordinationEvent)
+}
+
+// CoordinationSubscriber delivers a player's events on one topic. The returned
+// cancel func ends the subscription and closes the channel; callers must call
+// it when done.
+type CoordinationSubscriber interface {
+	Subscribe(topic EventTopic, playerID int) (<-chan CoordinationEvent, func())
+}
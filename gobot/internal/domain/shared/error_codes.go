@@ -0,0 +1,85 @@
+package shared
+
+import (
+	"errors"
+	"fmt"
+)
+
+// ErrorCode is a stable, machine-readable classification of a domain failure. Messages
+// are for humans and change freely; codes are the contract callers branch on — the CLI,
+// the coordinators and anything on the far side of the daemon's gRPC boundary.
+type ErrorCode string
+
+const (
+	CodeInsufficientFuel        ErrorCode = "INSUFFICIENT_FUEL"
+	CodeInvalidNavStatus        ErrorCode = "INVALID_NAV_STATUS"
+	CodeInvalidArgument         ErrorCode = "INVALID_ARGUMENT"
+	CodeShipNotFound            ErrorCode = "SHIP_NOT_FOUND"
+	CodeShipBusy                ErrorCode = "SHIP_BUSY"
+	CodeShipNotReserved         ErrorCode = "SHIP_NOT_RESERVED"
+	CodeMarketNotFound          ErrorCode = "MARKET_NOT_FOUND"
+	CodeContainerNotFound       ErrorCode = "CONTAINER_NOT_FOUND"
+	CodeContainerAlreadyRunning ErrorCode = "CONTAINER_ALREADY_RUNNING"
+)
+
+// Sentinels for errors.Is. Any error carrying the same code matches its sentinel, whether
+// it is a CodedError built with Errorf, one of the typed errors in errors.go, or a
+// CodedError rebuilt from a gRPC status on the client side.
+var (
+	ErrInsufficientFuel        = &CodedError{Code: CodeInsufficientFuel, Message: "insufficient fuel"}
+	ErrInvalidNavStatus        = &CodedError{Code: CodeInvalidNavStatus, Message: "invalid nav status"}
+	ErrInvalidArgument         = &CodedError{Code: CodeInvalidArgument, Message: "invalid argument"}
+	ErrShipNotFound            = &CodedError{Code: CodeShipNotFound, Message: "ship not found"}
+	ErrShipBusy                = &CodedError{Code: CodeShipBusy, Message: "ship busy"}
+	ErrShipNotReserved         = &CodedError{Code: CodeShipNotReserved, Message: "ship not reserved"}
+	ErrMarketNotFound          = &CodedError{Code: CodeMarketNotFound, Message: "market not found"}
+	ErrContainerNotFound       = &CodedError{Code: CodeContainerNotFound, Message: "container not found"}
+	ErrContainerAlreadyRunning = &CodedError{Code: CodeContainerAlreadyRunning, Message: "container already running"}
+)
+
+// CodedError is an error tagged with an ErrorCode. Err is the optional underlying cause.
+type CodedError struct {
+	Code    ErrorCode
+	Message string
+	Err     error
+}
+
+// Errorf builds a CodedError with a formatted message. A %w verb in format becomes the
+// error's cause, so the code can be added at the source without losing the chain.
+func Errorf(code ErrorCode, format string, args ...interface{}) *CodedError {
+	formatted := fmt.Errorf(format, args...)
+	return &CodedError{Code: code, Message: formatted.Error(), Err: errors.Unwrap(formatted)}
+}
+
+func (e *CodedError) Error() string {
+	return e.Message
+}
+
+func (e *CodedError) Unwrap() error {
+	return e.Err
+}
+
+// ErrorCode reports the error's code.
+func (e *CodedError) ErrorCode() ErrorCode {
+	return e.Code
+}
+
+// Is matches any sentinel carrying the same code.
+func (e *CodedError) Is(target error) bool {
+	return isCode(target, e.Code)
+}
+
+// CodeOf returns the code of the first coded error in err's chain, or "" when none is
+// coded.
+func CodeOf(err error) ErrorCode {
+	var coded interface{ ErrorCode() ErrorCode }
+	if errors.As(err, &coded) {
+		return coded.ErrorCode()
+	}
+	return ""
+}
+
+func isCode(target error, code ErrorCode) bool {
+	t, ok := target.(*CodedError)
+	return ok && t.Code == code
+}
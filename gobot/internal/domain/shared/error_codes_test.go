@@ -0,0 +1,68 @@
+package shared
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+)
+
+func TestErrorfCarriesCodeAndKeepsWrappedCause(t *testing.T) {
+	cause := errors.New("connection reset")
+
+	err := fmt.Errorf("load: %w", Errorf(CodeMarketNotFound, "no market data for %s: %w", "X1-A1", cause))
+
+	if got := CodeOf(err); got != CodeMarketNotFound {
+		t.Fatalf("expected code %s, got %q", CodeMarketNotFound, got)
+	}
+	if !errors.Is(err, ErrMarketNotFound) {
+		t.Fatalf("expected %v to match ErrMarketNotFound", err)
+	}
+	if errors.Is(err, ErrShipNotFound) {
+		t.Fatalf("a market-not-found error must not match ErrShipNotFound")
+	}
+	if !errors.Is(err, cause) {
+		t.Fatalf("expected the %%w cause to stay in the chain")
+	}
+	if err.Error() != "load: no market data for X1-A1: connection reset" {
+		t.Fatalf("message changed: %q", err.Error())
+	}
+}
+
+func TestTypedErrorsMatchTheirSentinels(t *testing.T) {
+	cases := []struct {
+		name     string
+		err      error
+		sentinel error
+		code     ErrorCode
+	}{
+		{"insufficient fuel", NewInsufficientFuelError(10, 2), ErrInsufficientFuel, CodeInsufficientFuel},
+		{"nav status", NewInvalidNavStatusError("ship must be docked"), ErrInvalidNavStatus, CodeInvalidNavStatus},
+		{"validation", NewValidationError("units", "must be positive"), ErrInvalidArgument, CodeInvalidArgument},
+		{"already assigned", NewShipAlreadyAssignedError("AGENT-1", "c-1"), ErrShipBusy, CodeShipBusy},
+		{"reserved by captain", NewShipReservedByCaptainError("AGENT-1", ""), ErrShipBusy, CodeShipBusy},
+		{"dedicated fleet", NewShipDedicatedToOtherFleetError("AGENT-1", "contract", "arb"), ErrShipBusy, CodeShipBusy},
+		{"not reserved", NewShipNotReservedError("AGENT-1"), ErrShipNotReserved, CodeShipNotReserved},
+	}
+	for _, tc := range cases {
+		wrapped := fmt.Errorf("handler: %w", tc.err)
+		if !errors.Is(wrapped, tc.sentinel) {
+			t.Errorf("%s: expected to match %v", tc.name, tc.sentinel)
+		}
+		if got := CodeOf(wrapped); got != tc.code {
+			t.Errorf("%s: expected code %s, got %q", tc.name, tc.code, got)
+		}
+	}
+
+	if errors.Is(NewShipNotReservedError("AGENT-1"), ErrShipBusy) {
+		t.Fatalf("a not-reserved ship is not busy")
+	}
+}
+
+func TestCodeOfUncodedErrorIsEmpty(t *testing.T) {
+	if got := CodeOf(errors.New("boom")); got != "" {
+		t.Fatalf("expected no code, got %q", got)
+	}
+	if got := CodeOf(nil); got != "" {
+		t.Fatalf("expected no code for nil, got %q", got)
+	}
+}
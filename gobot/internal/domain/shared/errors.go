@@ -29,6 +29,9 @@ func NewInvalidNavStatusError(message string) *InvalidNavStatusError {
 	return &InvalidNavStatusError{ShipError: NewShipError(message)}
 }
 
+func (e *InvalidNavStatusError) ErrorCode() ErrorCode { return CodeInvalidNavStatus }
+func (e *InvalidNavStatusError) Is(target error) bool { return isCode(target, CodeInvalidNavStatus) }
+
 type InsufficientFuelError struct {
 	*ShipError
 	Required  int
@@ -43,6 +46,9 @@ func NewInsufficientFuelError(required, available int) *InsufficientFuelError {
 	}
 }
 
+func (e *InsufficientFuelError) ErrorCode() ErrorCode { return CodeInsufficientFuel }
+func (e *InsufficientFuelError) Is(target error) bool { return isCode(target, CodeInsufficientFuel) }
+
 type InvalidShipDataError struct {
 	*ShipError
 }
@@ -66,6 +72,9 @@ func NewValidationError(field, message string) *ValidationError {
 	return &ValidationError{Field: field, Message: message}
 }
 
+func (e *ValidationError) ErrorCode() ErrorCode { return CodeInvalidArgument }
+func (e *ValidationError) Is(target error) bool { return isCode(target, CodeInvalidArgument) }
+
 // Ship Assignment errors
 
 type ShipAssignmentError struct {
@@ -82,6 +91,11 @@ func NewShipAssignmentError(message, shipSymbol, containerID string) *ShipAssign
 	}
 }
 
+// Every assignment refusal reads as SHIP_BUSY to callers (already assigned, reserved by
+// the captain, dedicated to another fleet) except ShipNotReservedError, which overrides it.
+func (e *ShipAssignmentError) ErrorCode() ErrorCode { return CodeShipBusy }
+func (e *ShipAssignmentError) Is(target error) bool { return isCode(target, CodeShipBusy) }
+
 type ShipAlreadyAssignedError struct {
 	*ShipAssignmentError
 }
@@ -132,6 +146,9 @@ func NewShipNotReservedError(shipSymbol string) *ShipNotReservedError {
 	}
 }
 
+func (e *ShipNotReservedError) ErrorCode() ErrorCode { return CodeShipNotReserved }
+func (e *ShipNotReservedError) Is(target error) bool { return isCode(target, CodeShipNotReserved) }
+
 // ShipDedicatedToOtherFleetError indicates a claim was rejected because the
 // ship is dedicated to a different operation's exclusive fleet.
 // Fleet is the ship's persisted DedicatedFleet tag; Operation is the fleet
@@ -20,11 +20,14 @@ type flightModeConfig struct {
 	FuelRate       float64
 }
 
+// TimeMultiplier follows the API's travel-time formula. DRIFT's 250 makes it
+// roughly eight times slower than CRUISE; ETAs, the drift gate and route
+// objectives all trade on that gap, so DRIFT must never look fast.
 var flightModeConfigs = map[FlightMode]flightModeConfig{
-	FlightModeCruise:  {"CRUISE", 31, 1.0},  // Fast, standard fuel
-	FlightModeDrift:   {"DRIFT", 26, 0.003}, // Slow, minimal fuel
-	FlightModeBurn:    {"BURN", 15, 2.0},    // Very fast, high fuel
-	FlightModeStealth: {"STEALTH", 50, 1.0}, // Very slow, stealthy
+	FlightModeCruise:  {"CRUISE", 31, 1.0},   // Fast, standard fuel
+	FlightModeDrift:   {"DRIFT", 250, 0.003}, // Slow, minimal fuel
+	FlightModeBurn:    {"BURN", 15, 2.0},     // Very fast, high fuel
+	FlightModeStealth: {"STEALTH", 50, 1.0},  // Very slow, stealthy
 }
 
 func (f FlightMode) Name() string {
@@ -99,3 +102,13 @@ func IsValidFlightModeName(modeName string) bool {
 	}
 	return false
 }
+
+// ParseFlightMode maps an API flight mode name (e.g. "CRUISE") to its FlightMode.
+func ParseFlightMode(modeName string) (FlightMode, bool) {
+	for mode, config := range flightModeConfigs {
+		if config.Name == modeName {
+			return mode, true
+		}
+	}
+	return FlightModeCruise, false
+}
@@ -0,0 +1,38 @@
+package shared
+
+import "testing"
+
+// At engine speed 30 a 300-unit leg takes 150s on BURN, 310s on CRUISE and
+// 2500s on DRIFT, which costs a single unit of fuel.
+func TestFlightMode_TravelTimeAndFuel(t *testing.T) {
+	cases := []struct {
+		mode    FlightMode
+		seconds int
+		fuel    int
+	}{
+		{FlightModeBurn, 150, 600},
+		{FlightModeCruise, 310, 300},
+		{FlightModeDrift, 2500, 1},
+	}
+	for _, c := range cases {
+		if got := c.mode.TravelTime(300, 30); got != c.seconds {
+			t.Errorf("%s travel time = %ds, want %ds", c.mode.Name(), got, c.seconds)
+		}
+		if got := c.mode.FuelCost(300); got != c.fuel {
+			t.Errorf("%s fuel = %d, want %d", c.mode.Name(), got, c.fuel)
+		}
+	}
+}
+
+// DRIFT trades time for fuel: it is the slowest mode and the cheapest.
+func TestFlightMode_DriftIsSlowestAndCheapest(t *testing.T) {
+	drift := FlightModeDrift.TravelTime(100, 30)
+	for _, mode := range []FlightMode{FlightModeBurn, FlightModeCruise, FlightModeStealth} {
+		if mode.TravelTime(100, 30) >= drift {
+			t.Errorf("%s is not faster than DRIFT", mode.Name())
+		}
+		if mode.FuelCost(100) <= FlightModeDrift.FuelCost(100) {
+			t.Errorf("%s is not dearer than DRIFT", mode.Name())
+		}
+	}
+}
@@ -2,6 +2,13 @@ package shared
 
 import "fmt"
 
+// FuelPerCargoUnit is how much tank fuel one unit of FUEL cargo holds: a ship
+// refuelling from its hold burns one cargo unit per 100 fuel.
+const FuelPerCargoUnit = 100
+
+// FuelCargoSymbol is the trade symbol of the FUEL a ship can carry and burn.
+const FuelCargoSymbol = "FUEL"
+
 // Fuel represents an immutable fuel state
 type Fuel struct {
 	Current  int
@@ -9,11 +9,14 @@ import (
 type contextKey int
 
 const (
-	operationContextKey   contextKey = iota
-	skipMarketRefreshKey             // Skip market refresh after cargo transactions (optimization)
-	selectorBranchKey                // Factory input-source selector branch, tagged onto the buy's ledger row
-	constructionSupplyKey            // Marks a ProduceGood run as construction supply, exempt from resale-margin guards
-	scanPolicyKey                    // Tour-scan load policy: recent-scan freshness gate + impact-sample rate
+	operationContextKey     contextKey = iota
+	skipMarketRefreshKey               // Skip market refresh after cargo transactions (optimization)
+	selectorBranchKey                  // Factory input-source selector branch, tagged onto the buy's ledger row
+	constructionSupplyKey              // Marks a ProduceGood run as construction supply, exempt from resale-margin guards
+	scanPolicyKey                      // Tour-scan load policy: recent-scan freshness gate + impact-sample rate
+	clockKey                           // Clock every wait beneath the context runs on (SimulatedClock in scenarios)
+	compensationContractKey            // Contract a compensating sale recovers the cost of, tagged onto the sale's ledger row
+	pauseGateKey                       // Gate holding a paused container's API calls until it is resumed
 )
 
 // OperationContext provides traceability from high-level operations (containers)
@@ -176,6 +179,48 @@ func SelectorBranchFromContext(ctx context.Context) (string, bool) {
 	return "", false
 }
 
+// WithCompensationFor marks cargo transactions beneath the context as the
+// compensation for contractID: a contract delivery that failed permanently sells
+// its stranded deliverables, and the cargo-transaction recorder files that
+// SELL_CARGO row against the contract (related_entity contract/<id>) instead of
+// the container, so the contract's ledger shows what its failed purchase
+// recovered. Only the contract delivery saga stamps this.
+func WithCompensationFor(ctx context.Context, contractID string) context.Context {
+	return context.WithValue(ctx, compensationContractKey, contractID)
+}
+
+// CompensationForFromContext returns the contract stamped by WithCompensationFor
+// and ok=true, or ("", false) for every ordinary cargo transaction.
+func CompensationForFromContext(ctx context.Context) (string, bool) {
+	if contractID, ok := ctx.Value(compensationContractKey).(string); ok && contractID != "" {
+		return contractID, true
+	}
+	return "", false
+}
+
+// PauseGate holds the work of a paused container. WaitWhilePaused returns
+// immediately unless the container is paused, and otherwise blocks until it is
+// resumed or ctx is done.
+type PauseGate interface {
+	WaitWhilePaused(ctx context.Context) error
+}
+
+// WithPauseGate attaches the running container's pause gate to ctx. The
+// container runner stamps it on every iteration it executes.
+func WithPauseGate(ctx context.Context, gate PauseGate) context.Context {
+	return context.WithValue(ctx, pauseGateKey, gate)
+}
+
+// WaitWhilePaused blocks while the container running beneath ctx is paused. Work
+// outside any container carries no gate and never waits.
+func WaitWhilePaused(ctx context.Context) error {
+	gate, ok := ctx.Value(pauseGateKey).(PauseGate)
+	if !ok || gate == nil {
+		return nil
+	}
+	return gate.WaitWhilePaused(ctx)
+}
+
 // ScanPolicy is the tour-scan load policy a TRADE coordinator (tour /
 // trade-route) threads onto ctx to throttle the deliberate price-impact
 // instrumentation the model is fitted from. It governs two API-reducing
@@ -16,6 +16,9 @@ type Waypoint struct {
 	Traits       []string `json:"traits,omitempty"`
 	HasFuel      bool     `json:"has_fuel"`
 	Orbitals     []string `json:"orbitals,omitempty"`
+	// Capabilities grades fuel, market, shipyard and construction state; set it
+	// through ApplyCapabilities so HasFuel follows.
+	Capabilities WaypointCapabilities `json:"capabilities"`
 }
 
 func NewWaypoint(symbol string, x, y float64) (*Waypoint, error) {
@@ -33,6 +36,16 @@ func NewWaypoint(symbol string, x, y float64) (*Waypoint, error) {
 	}, nil
 }
 
+// HasOrbital reports whether symbol is listed among the waypoint's orbitals
+func (w *Waypoint) HasOrbital(symbol string) bool {
+	for _, orbital := range w.Orbitals {
+		if orbital == symbol {
+			return true
+		}
+	}
+	return false
+}
+
 // DistanceTo calculates Euclidean distance to another waypoint
 func (w *Waypoint) DistanceTo(other *Waypoint) float64 {
 	dx := other.X - w.X
@@ -0,0 +1,108 @@
+package shared
+
+// FuelAvailability grades what is known about fuel at a waypoint. Traits only
+// suggest fuel (most MARKETPLACEs exchange FUEL, not all); a scanned market's
+// trade list settles it either way.
+type FuelAvailability string
+
+const (
+	// FuelAvailabilityNone: no fuel-granting trait and no market data listing FUEL.
+	FuelAvailabilityNone FuelAvailability = ""
+	// FuelAvailabilityImplied: a fuel-granting trait, but the market has not been read.
+	FuelAvailabilityImplied FuelAvailability = "IMPLIED"
+	// FuelAvailabilityVerified: the waypoint's market data lists FUEL.
+	FuelAvailabilityVerified FuelAvailability = "VERIFIED"
+	// FuelAvailabilityAbsent: the waypoint's market data was read and has no FUEL.
+	FuelAvailabilityAbsent FuelAvailability = "ABSENT"
+)
+
+const (
+	goodFuel      = "FUEL"
+	traitShipyard = "SHIPYARD"
+)
+
+// FromMarket reports whether the grade came from market data rather than traits.
+// Market-derived grades outrank trait-derived ones when a waypoint is re-synced.
+func (f FuelAvailability) FromMarket() bool {
+	return f == FuelAvailabilityVerified || f == FuelAvailabilityAbsent
+}
+
+// WaypointCapabilities is what a ship can do at a waypoint, derived from its
+// traits, the API's construction flag and, once scanned, its market data.
+type WaypointCapabilities struct {
+	Fuel              FuelAvailability `json:"fuel,omitempty"`
+	Marketplace       bool             `json:"marketplace,omitempty"`
+	Shipyard          bool             `json:"shipyard,omitempty"`
+	UnderConstruction bool             `json:"under_construction,omitempty"`
+}
+
+// CapabilitiesFromTraits derives the trait-level capabilities of a waypoint. A
+// fuel-granting trait (see TraitGrantsFuel) only implies fuel until
+// WithMarketGoods confirms it.
+func CapabilitiesFromTraits(traits []string, underConstruction bool) WaypointCapabilities {
+	caps := WaypointCapabilities{UnderConstruction: underConstruction}
+	for _, trait := range traits {
+		switch trait {
+		case traitMarketplace:
+			caps.Marketplace = true
+		case traitShipyard:
+			caps.Shipyard = true
+		}
+	}
+	if TraitsGrantFuel(traits) {
+		caps.Fuel = FuelAvailabilityImplied
+	}
+	return caps
+}
+
+// WithMarketGoods grades fuel from a scanned market's trade-good symbols.
+func (c WaypointCapabilities) WithMarketGoods(goods []string) WaypointCapabilities {
+	c.Marketplace = true
+	c.Fuel = FuelAvailabilityAbsent
+	for _, good := range goods {
+		if good == goodFuel {
+			c.Fuel = FuelAvailabilityVerified
+			break
+		}
+	}
+	return c
+}
+
+// MayHaveFuel reports whether fuel is verified or still implied by traits. This
+// is the permissive reading behind Waypoint.HasFuel: opportunistic refuels may
+// try an unverified marketplace, but not one whose market is known to lack FUEL.
+func (c WaypointCapabilities) MayHaveFuel() bool {
+	return c.Fuel == FuelAvailabilityVerified || c.Fuel == FuelAvailabilityImplied
+}
+
+// SellsFuel reports whether market data confirms the waypoint sells fuel.
+func (c WaypointCapabilities) SellsFuel() bool {
+	return c.Fuel == FuelAvailabilityVerified
+}
+
+// ApplyCapabilities sets the waypoint's capabilities and keeps HasFuel in step.
+func (w *Waypoint) ApplyCapabilities(caps WaypointCapabilities) {
+	w.Capabilities = caps
+	w.HasFuel = caps.MayHaveFuel()
+}
+
+// AnyVerifiedFuel reports whether any waypoint in the set has market-verified fuel.
+func AnyVerifiedFuel(waypoints map[string]*Waypoint) bool {
+	for _, wp := range waypoints {
+		if wp.Capabilities.SellsFuel() {
+			return true
+		}
+	}
+	return false
+}
+
+// IsPlannableFuelStop reports whether route planning may schedule a refuel here.
+// Only verified fuel qualifies, unless the system has no market data at all yet
+// (systemHasVerifiedFuel false), in which case trait-implied fuel stands in so a
+// freshly charted system is still routable.
+func (w *Waypoint) IsPlannableFuelStop(systemHasVerifiedFuel bool) bool {
+	if systemHasVerifiedFuel {
+		return w.Capabilities.SellsFuel()
+	}
+	return w.HasFuel
+}
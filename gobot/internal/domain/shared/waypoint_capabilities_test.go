@@ -0,0 +1,56 @@
+package shared
+
+import "testing"
+
+func TestCapabilitiesFromTraits(t *testing.T) {
+	caps := CapabilitiesFromTraits([]string{"MARKETPLACE", "SHIPYARD"}, true)
+	if caps.Fuel != FuelAvailabilityImplied || !caps.Marketplace || !caps.Shipyard || !caps.UnderConstruction {
+		t.Fatalf("unexpected capabilities %+v", caps)
+	}
+	if caps := CapabilitiesFromTraits([]string{"UNCHARTED"}, false); caps.Fuel != FuelAvailabilityNone {
+		t.Fatalf("no fuel trait must grade NONE, got %q", caps.Fuel)
+	}
+}
+
+// A scanned market settles the trait's implication either way, and HasFuel follows.
+func TestWithMarketGoods_GradesFuel(t *testing.T) {
+	implied := CapabilitiesFromTraits([]string{"MARKETPLACE"}, false)
+	cases := []struct {
+		name    string
+		goods   []string
+		want    FuelAvailability
+		hasFuel bool
+	}{
+		{"market lists FUEL", []string{"IRON_ORE", "FUEL"}, FuelAvailabilityVerified, true},
+		{"market without FUEL", []string{"IRON_ORE"}, FuelAvailabilityAbsent, false},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			wp := &Waypoint{Symbol: "X1-A1"}
+			wp.ApplyCapabilities(implied.WithMarketGoods(tc.goods))
+			if wp.Capabilities.Fuel != tc.want || wp.HasFuel != tc.hasFuel {
+				t.Fatalf("fuel = %q hasFuel = %v, want %q / %v", wp.Capabilities.Fuel, wp.HasFuel, tc.want, tc.hasFuel)
+			}
+		})
+	}
+}
+
+// Once any waypoint in the system is verified, only verified waypoints are refuel
+// stops; before that, trait-implied fuel stands in.
+func TestIsPlannableFuelStop(t *testing.T) {
+	implied := &Waypoint{Symbol: "X1-A1"}
+	implied.ApplyCapabilities(CapabilitiesFromTraits([]string{"MARKETPLACE"}, false))
+	verified := &Waypoint{Symbol: "X1-A2"}
+	verified.ApplyCapabilities(implied.Capabilities.WithMarketGoods([]string{"FUEL"}))
+
+	if !implied.IsPlannableFuelStop(AnyVerifiedFuel(map[string]*Waypoint{"X1-A1": implied})) {
+		t.Fatal("implied fuel must be plannable in a system with no verified fuel")
+	}
+	system := map[string]*Waypoint{"X1-A1": implied, "X1-A2": verified}
+	if implied.IsPlannableFuelStop(AnyVerifiedFuel(system)) {
+		t.Fatal("implied fuel must not be plannable once the system has verified fuel")
+	}
+	if !verified.IsPlannableFuelStop(AnyVerifiedFuel(system)) {
+		t.Fatal("verified fuel must be plannable")
+	}
+}
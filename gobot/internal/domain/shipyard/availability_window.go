@@ -0,0 +1,84 @@
+package shipyard
+
+import (
+	"context"
+	"time"
+)
+
+// AvailabilityWindow is one continuous stretch during which a watched shipyard
+// had ShipType in stock. It opens on the first read that finds a priced
+// listing, tracks the price while the listing stays up, and closes on the first
+// read where the yard no longer lists it. Closed windows are kept, so the
+// history shows how often and for how long a sought-after type comes back.
+type AvailabilityWindow struct {
+	ID             int64
+	WaypointSymbol string
+	ShipType       string
+	OpenedAt       time.Time
+	LastSeenAt     time.Time
+	ClosedAt       *time.Time
+	OpenPrice      int
+	MinPrice       int
+	LastPrice      int
+	// PurchasedAt is set once the watcher bought a ship during the window, so
+	// one restock triggers at most one automatic purchase.
+	PurchasedAt *time.Time
+}
+
+// OpenAvailabilityWindow starts a window at the price first seen at at.
+func OpenAvailabilityWindow(waypointSymbol, shipType string, price int, at time.Time) *AvailabilityWindow {
+	return &AvailabilityWindow{
+		WaypointSymbol: waypointSymbol,
+		ShipType:       shipType,
+		OpenedAt:       at,
+		LastSeenAt:     at,
+		OpenPrice:      price,
+		MinPrice:       price,
+		LastPrice:      price,
+	}
+}
+
+// IsOpen reports whether the type is still in stock as of the last read.
+func (w *AvailabilityWindow) IsOpen() bool {
+	return w.ClosedAt == nil
+}
+
+// Observe records another read that still found the type listed at price.
+func (w *AvailabilityWindow) Observe(price int, at time.Time) {
+	w.LastSeenAt = at
+	w.LastPrice = price
+	if price < w.MinPrice {
+		w.MinPrice = price
+	}
+}
+
+// Close ends the window at the read that found the type gone.
+func (w *AvailabilityWindow) Close(at time.Time) {
+	if w.ClosedAt == nil {
+		w.ClosedAt = &at
+	}
+}
+
+// MarkPurchased records that a ship was bought during the window.
+func (w *AvailabilityWindow) MarkPurchased(at time.Time) {
+	w.PurchasedAt = &at
+}
+
+// Duration is how long the window has been (or was) open.
+func (w *AvailabilityWindow) Duration() time.Duration {
+	if w.ClosedAt != nil {
+		return w.ClosedAt.Sub(w.OpenedAt)
+	}
+	return w.LastSeenAt.Sub(w.OpenedAt)
+}
+
+// AvailabilityWindowRepository persists availability windows per player.
+type AvailabilityWindowRepository interface {
+	// FindOpen returns the open window for (waypoint, ship type), or nil.
+	FindOpen(ctx context.Context, playerID int, waypointSymbol, shipType string) (*AvailabilityWindow, error)
+	// Save inserts a new window (ID 0, assigning its ID) or updates an existing one.
+	Save(ctx context.Context, playerID int, window *AvailabilityWindow) error
+	// FindSince returns the player's windows for shipType that were open at or
+	// after since, oldest first. An empty shipType spans every type.
+	FindSince(ctx context.Context, playerID int, shipType string, since time.Time) ([]AvailabilityWindow, error)
+}
@@ -0,0 +1,45 @@
+package shipyard
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/andrescamacho/spacetraders-go/internal/domain/shared"
+)
+
+// LoadoutPreset is a named post-purchase setup for newly bought ships. After a
+// batch purchase succeeds each new ship, in order: buys and installs Mounts at
+// the yard, is dedicated to Fleet, switches to FlightMode, and flies to
+// HomeWaypoint. Every step is optional.
+type LoadoutPreset struct {
+	Name        string `yaml:"name"`
+	Description string `yaml:"description"`
+	// Mounts are bought at the yard's market and installed one by one.
+	Mounts []string `yaml:"mounts"`
+	// Fleet is the dedicated fleet the ship is assigned to ("contract",
+	// "trade", ...); empty leaves it in the general pool.
+	Fleet string `yaml:"fleet"`
+	// FlightMode is the ship's initial flight mode (CRUISE, DRIFT, BURN, STEALTH).
+	FlightMode string `yaml:"flight_mode"`
+	// HomeWaypoint is where the ship is repositioned once set up.
+	HomeWaypoint string `yaml:"home_waypoint"`
+}
+
+// Validate rejects presets that cannot be applied: no name, a blank mount, or
+// an unknown flight mode.
+func (p *LoadoutPreset) Validate() error {
+	if strings.TrimSpace(p.Name) == "" {
+		return fmt.Errorf("loadout preset has no name")
+	}
+	for i, mount := range p.Mounts {
+		if strings.TrimSpace(mount) == "" {
+			return fmt.Errorf("loadout preset %s: mount %d is blank", p.Name, i)
+		}
+	}
+	if p.FlightMode != "" {
+		if _, ok := shared.ParseFlightMode(p.FlightMode); !ok {
+			return fmt.Errorf("loadout preset %s: unknown flight_mode %q", p.Name, p.FlightMode)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,119 @@
+package shipyard
+
+import (
+	"context"
+	"sort"
+	"time"
+)
+
+// ShipPriceSnapshot is one observed shipyard price: at ObservedAt, the
+// (system, waypoint) shipyard listed ShipType at PurchasePrice. Unlike
+// ShipTypeAvailability, which a re-scan overwrites, snapshots are append-only
+// so the history shows how a yard's price moves — ship prices climb after each
+// purchase and drift back down, which is what buy-the-dip waits on.
+type ShipPriceSnapshot struct {
+	SystemSymbol   string
+	WaypointSymbol string
+	ShipType       string
+	PurchasePrice  int
+	Supply         string
+	ObservedAt     time.Time
+}
+
+// PriceHistoryRepository persists ship price snapshots. Snapshots are
+// append-only: a yard's price history is never rewritten.
+type PriceHistoryRepository interface {
+	RecordSnapshots(ctx context.Context, playerID int, snapshots []ShipPriceSnapshot) error
+	// FindSince returns the player's snapshots of shipType observed at or after
+	// since, oldest first. An empty waypointSymbol spans every yard.
+	FindSince(ctx context.Context, playerID int, shipType, waypointSymbol string, since time.Time) ([]ShipPriceSnapshot, error)
+}
+
+// PricedSnapshots converts a scan's availability rows into price snapshots,
+// dropping unpriced rows: a type listed without a price says nothing about
+// what it costs.
+func PricedSnapshots(availabilities []ShipTypeAvailability) []ShipPriceSnapshot {
+	out := make([]ShipPriceSnapshot, 0, len(availabilities))
+	for _, a := range availabilities {
+		if a.PurchasePrice <= 0 {
+			continue
+		}
+		out = append(out, ShipPriceSnapshot{
+			SystemSymbol:   a.SystemSymbol,
+			WaypointSymbol: a.WaypointSymbol,
+			ShipType:       a.ShipType,
+			PurchasePrice:  a.PurchasePrice,
+			Supply:         a.Supply,
+			ObservedAt:     a.LastScanned,
+		})
+	}
+	return out
+}
+
+// ShipPriceStats summarises one yard's price history for one ship type.
+type ShipPriceStats struct {
+	WaypointSymbol string
+	ShipType       string
+	Latest         int
+	Min            int
+	Max            int
+	Mean           int
+	Samples        int
+	FirstObserved  time.Time
+	LastObserved   time.Time
+}
+
+// SummarizeShipPrices folds a snapshot history into one summary per
+// (waypoint, ship type), cheapest latest price first, then waypoint so the
+// order is stable.
+func SummarizeShipPrices(history []ShipPriceSnapshot) []ShipPriceStats {
+	type key struct{ waypoint, shipType string }
+	sums := make(map[key]int)
+	stats := make(map[key]*ShipPriceStats)
+	for _, s := range history {
+		k := key{s.WaypointSymbol, s.ShipType}
+		st, ok := stats[k]
+		if !ok {
+			stats[k] = &ShipPriceStats{
+				WaypointSymbol: s.WaypointSymbol,
+				ShipType:       s.ShipType,
+				Latest:         s.PurchasePrice,
+				Min:            s.PurchasePrice,
+				Max:            s.PurchasePrice,
+				Samples:        1,
+				FirstObserved:  s.ObservedAt,
+				LastObserved:   s.ObservedAt,
+			}
+			sums[k] = s.PurchasePrice
+			continue
+		}
+		st.Samples++
+		sums[k] += s.PurchasePrice
+		st.Min = min(st.Min, s.PurchasePrice)
+		st.Max = max(st.Max, s.PurchasePrice)
+		if s.ObservedAt.Before(st.FirstObserved) {
+			st.FirstObserved = s.ObservedAt
+		}
+		if !s.ObservedAt.Before(st.LastObserved) {
+			st.LastObserved = s.ObservedAt
+			st.Latest = s.PurchasePrice
+		}
+	}
+
+	out := make([]ShipPriceStats, 0, len(stats))
+	for k, st := range stats {
+		st.Mean = sums[k] / st.Samples
+		out = append(out, *st)
+	}
+	sort.Slice(out, func(i, j int) bool {
+		a, b := out[i], out[j]
+		if a.Latest != b.Latest {
+			return a.Latest < b.Latest
+		}
+		if a.WaypointSymbol != b.WaypointSymbol {
+			return a.WaypointSymbol < b.WaypointSymbol
+		}
+		return a.ShipType < b.ShipType
+	})
+	return out
+}
@@ -0,0 +1,43 @@
+package shipyard
+
+import (
+	"testing"
+	"time"
+)
+
+// Each yard's history folds into latest/min/max/mean, and yards rank by their
+// latest price, not their historical low.
+func TestSummarizeShipPrices_PerYardCheapestLatestFirst(t *testing.T) {
+	base := time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC)
+	history := []ShipPriceSnapshot{
+		{WaypointSymbol: "X1-A-Y1", ShipType: "SHIP_PROBE", PurchasePrice: 20_000, ObservedAt: base},
+		{WaypointSymbol: "X1-A-Y1", ShipType: "SHIP_PROBE", PurchasePrice: 26_000, ObservedAt: base.Add(time.Hour)},
+		{WaypointSymbol: "X1-A-Y2", ShipType: "SHIP_PROBE", PurchasePrice: 24_000, ObservedAt: base.Add(time.Hour)},
+	}
+
+	stats := SummarizeShipPrices(history)
+	if len(stats) != 2 {
+		t.Fatalf("got %d summaries, want 2", len(stats))
+	}
+	if stats[0].WaypointSymbol != "X1-A-Y2" {
+		t.Fatalf("first = %s, want X1-A-Y2 (cheapest latest price)", stats[0].WaypointSymbol)
+	}
+	y1 := stats[1]
+	if y1.Latest != 26_000 || y1.Min != 20_000 || y1.Max != 26_000 || y1.Mean != 23_000 || y1.Samples != 2 {
+		t.Fatalf("Y1 stats = %+v", y1)
+	}
+	if !y1.FirstObserved.Equal(base) || !y1.LastObserved.Equal(base.Add(time.Hour)) {
+		t.Fatalf("Y1 observed span = %s..%s", y1.FirstObserved, y1.LastObserved)
+	}
+}
+
+// Unpriced availability rows are not price observations.
+func TestPricedSnapshots_DropsUnpricedRows(t *testing.T) {
+	snaps := PricedSnapshots([]ShipTypeAvailability{
+		{WaypointSymbol: "X1-A-Y1", ShipType: "SHIP_PROBE", PurchasePrice: 20_000},
+		{WaypointSymbol: "X1-A-Y1", ShipType: "SHIP_HEAVY_FREIGHTER"},
+	})
+	if len(snaps) != 1 || snaps[0].ShipType != "SHIP_PROBE" {
+		t.Fatalf("snapshots = %+v, want only the priced probe", snaps)
+	}
+}
@@ -66,6 +66,8 @@ type WaypointAPIData struct {
 	Y        float64
 	Traits   []map[string]interface{}
 	Orbitals []map[string]string
+	// IsUnderConstruction is the API's isUnderConstruction flag.
+	IsUnderConstruction bool
 }
 
 type WaypointsListResponse struct {
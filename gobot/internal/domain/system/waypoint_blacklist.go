@@ -0,0 +1,51 @@
+package system
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// Blacklist sources record who put a waypoint on the blacklist.
+const (
+	BlacklistSourceOperator      = "operator"
+	BlacklistSourceHealthMonitor = "health_monitor"
+)
+
+// BlacklistedWaypoint keeps a player's fleet away from a waypoint that keeps
+// failing it (pirates, API quirks): route planning, market selection and
+// scouting skip it until it expires or is cleared.
+type BlacklistedWaypoint struct {
+	PlayerID       int
+	WaypointSymbol string
+	Reason         string
+	Source         string // BlacklistSourceOperator or BlacklistSourceHealthMonitor
+	CreatedAt      time.Time
+	ExpiresAt      *time.Time // nil: until cleared
+}
+
+// Active reports whether the entry still excludes its waypoint at now.
+func (b BlacklistedWaypoint) Active(now time.Time) bool {
+	return b.ExpiresAt == nil || now.Before(*b.ExpiresAt)
+}
+
+// ErrWaypointBlacklisted is returned when a plan would send a ship to a
+// blacklisted waypoint.
+type ErrWaypointBlacklisted struct {
+	WaypointSymbol string
+}
+
+func (e *ErrWaypointBlacklisted) Error() string {
+	return fmt.Sprintf("waypoint %s is blacklisted", e.WaypointSymbol)
+}
+
+// WaypointBlacklistRepository persists blacklist entries, one per player and
+// waypoint.
+type WaypointBlacklistRepository interface {
+	// Add inserts entry, replacing any existing entry for its waypoint.
+	Add(ctx context.Context, entry *BlacklistedWaypoint) error
+	// Remove clears the entry for waypoint, reporting whether there was one.
+	Remove(ctx context.Context, playerID int, waypointSymbol string) (bool, error)
+	// ListActive returns the player's entries still active at now.
+	ListActive(ctx context.Context, playerID int, now time.Time) ([]*BlacklistedWaypoint, error)
+}
@@ -50,8 +50,12 @@ type ArbitrageLane struct {
 	SourceAsk      int    // source market's SELL price (what we pay)
 	DestBid        int    // destination market's BUY price (what we receive)
 	SourceSupply   string
+	SourceActivity string
 	DestActivity   string
-	SpreadPerUnit  int // DestBid − SourceAsk (always > 0 for a returned lane)
+	SpreadPerUnit  int // DestBid − SourceAsk − FeePerUnit (always > 0 for a returned lane)
+	FeePerUnit     int // estimated market fees per unit at both ends (ActiveMarketFees)
+	SourceVolume   int // source market's per-transaction limit (tradeVolume)
+	DestVolume     int // destination market's per-transaction limit (tradeVolume)
 	VolumeCap      int // min(source.Volume, dest.Volume) — market-absorption bound
 	CappedSpread   int // SpreadPerUnit × VolumeCap — the ranking key
 }
@@ -61,8 +65,9 @@ type ArbitrageLane struct {
 // MarginAlive. It is the single tradeability predicate shared by lane SELECTION
 // (which must never pick a lane the executor would immediately refuse) and the
 // `market spreads` scan display (which flags which ranked lanes are actually
-// flyable). Equivalent to MarginAlive(l.DestBid, l.SourceAsk) because
-// SpreadPerUnit == DestBid − SourceAsk for every ranked lane.
+// flyable). Equivalent to MarginAlive(l.DestBid, l.SourceAsk) when no fees are
+// modelled; otherwise SpreadPerUnit is net of FeePerUnit, so a lane whose fees eat
+// its margin fails the floor before the executor ever buys into it.
 func (l ArbitrageLane) ClearsFloor() bool {
 	return l.SpreadPerUnit >= MinBidMargin
 }
@@ -216,7 +221,7 @@ func reorderByHoldFit(lanes []ArbitrageLane, shipCapacity int) []ArbitrageLane {
 // bestLaneForGood picks the ordered (source, dest) market pair, source waypoint ≠
 // dest waypoint, that maximises the volume-capped spread for a single good. It
 // returns ok=false when the good trades in fewer than two distinct markets or no
-// pair yields a positive per-unit spread (destBid − sourceAsk > 0).
+// pair yields a positive per-unit spread (destBid − sourceAsk − fees > 0).
 //
 // Markets per good are few, so the O(n²) pair scan is trivial and lets volume —
 // which interacts non-monotonically with per-unit spread — decide the winner
@@ -224,6 +229,7 @@ func reorderByHoldFit(lanes []ArbitrageLane, shipCapacity int) []ArbitrageLane {
 func bestLaneForGood(good string, markets []GoodListing) (ArbitrageLane, bool) {
 	var best ArbitrageLane
 	found := false
+	fees := ActiveMarketFees()
 
 	for si := range markets {
 		source := markets[si]
@@ -241,7 +247,10 @@ func bestLaneForGood(good string, markets []GoodListing) (ArbitrageLane, bool) {
 				continue
 			}
 
-			spreadPerUnit := dest.Bid - source.Ask
+			// Charge the estimated market fees at both ends, so a thin spread that the
+			// fees would turn into a loss never ranks.
+			feePerUnit := fees.LaneFeePerUnit(source.Waypoint, source.Ask, dest.Waypoint, dest.Bid)
+			spreadPerUnit := dest.Bid - source.Ask - feePerUnit
 			if spreadPerUnit <= 0 {
 				continue
 			}
@@ -259,8 +268,12 @@ func bestLaneForGood(good string, markets []GoodListing) (ArbitrageLane, bool) {
 				SourceAsk:      source.Ask,
 				DestBid:        dest.Bid,
 				SourceSupply:   source.Supply,
+				SourceActivity: source.Activity,
 				DestActivity:   dest.Activity,
 				SpreadPerUnit:  spreadPerUnit,
+				FeePerUnit:     feePerUnit,
+				SourceVolume:   source.Volume,
+				DestVolume:     dest.Volume,
 				VolumeCap:      volumeCap,
 				CappedSpread:   cappedSpread,
 			}
@@ -0,0 +1,188 @@
+package trading
+
+import (
+	"fmt"
+	"math"
+	"sort"
+)
+
+// ManifestLine is one good a hull wants to load at a market, with the market's
+// current ask and per-call trade volume.
+type ManifestLine struct {
+	GoodSymbol  string
+	Units       int // units wanted
+	Ask         int // current purchase price per unit
+	TradeVolume int // most units the market sells in one call
+	// UnitValue is what a unit is expected to resell for. Lines that carry one are
+	// loaded first, highest margin first; lines without one (0) follow in the
+	// order given.
+	UnitValue int
+}
+
+// ManifestPurchase is one purchase call of a planned manifest: at most one trade
+// volume of a single good.
+type ManifestPurchase struct {
+	GoodSymbol    string
+	Units         int
+	EstimatedAsk  int // expected average price per unit for this call
+	EstimatedCost int
+}
+
+// CargoManifest is the planned sequence of purchase calls for a hull, and what it
+// is expected to cost.
+type CargoManifest struct {
+	Purchases         []ManifestPurchase
+	TotalUnits        int
+	ExpectedTotalCost int
+	// Unplanned is how many wanted units of each good did not fit the hold or the
+	// budget. Goods that were fully planned are absent.
+	Unplanned map[string]int
+}
+
+// CargoManifestPlanner plans a multi-good purchase before any credits are spent.
+// It fills the hold in margin order, never plans a call above a market's trade
+// volume, and prices every call with the buy-side impact model so the expected
+// cost accounts for each good's ask climbing as the hull buys into it.
+type CargoManifestPlanner struct {
+	buyImpact float64
+}
+
+// NewCargoManifestPlanner returns a planner pricing calls with buyImpact; zero
+// or less selects DefaultBuyImpactCoefficient.
+func NewCargoManifestPlanner(buyImpact float64) *CargoManifestPlanner {
+	if buyImpact <= 0 {
+		buyImpact = DefaultBuyImpactCoefficient
+	}
+	return &CargoManifestPlanner{buyImpact: buyImpact}
+}
+
+// Plan splits lines into purchase calls for a hold with capacity free units.
+// budget caps the expected total cost; 0 means unlimited.
+//
+// Capacity and budget go to lines in priority order (see ManifestLine.UnitValue).
+// The calls themselves are interleaved round-robin, one trade volume of each good
+// per round, so a purchase cut short by a price ceiling or a failed call still
+// leaves the hull carrying a share of every good instead of only the first.
+// Interleaving does not change the estimate: each good's ask only moves with
+// that good's own purchases.
+func (p *CargoManifestPlanner) Plan(lines []ManifestLine, capacity, budget int) (*CargoManifest, error) {
+	if capacity <= 0 {
+		return nil, ErrInvalidCargoCapacity
+	}
+	if budget < 0 {
+		return nil, fmt.Errorf("manifest budget must not be negative, got %d", budget)
+	}
+	for _, line := range lines {
+		if line.GoodSymbol == "" {
+			return nil, fmt.Errorf("manifest line has no good symbol")
+		}
+		if line.Units < 0 || line.Ask < 0 {
+			return nil, fmt.Errorf("manifest line %s has negative units or ask", line.GoodSymbol)
+		}
+		if line.TradeVolume <= 0 {
+			return nil, fmt.Errorf("manifest line %s has no trade volume", line.GoodSymbol)
+		}
+	}
+
+	order := manifestPriority(lines)
+	allocated := make([]int, len(lines))
+	freeUnits, budgetLeft := capacity, budget
+	for _, i := range order {
+		units := min(lines[i].Units, freeUnits)
+		if budget > 0 {
+			units = p.affordableUnits(lines[i], units, budgetLeft)
+			budgetLeft -= p.lineCost(lines[i], units)
+		}
+		allocated[i] = units
+		freeUnits -= units
+	}
+
+	manifest := &CargoManifest{Unplanned: make(map[string]int)}
+	bought := make([]int, len(lines))
+	for planned := true; planned; {
+		planned = false
+		for _, i := range order {
+			units := min(allocated[i]-bought[i], lines[i].TradeVolume)
+			if units <= 0 {
+				continue
+			}
+			cost := p.trancheCost(lines[i], bought[i], units)
+			manifest.Purchases = append(manifest.Purchases, ManifestPurchase{
+				GoodSymbol:    lines[i].GoodSymbol,
+				Units:         units,
+				EstimatedAsk:  int(math.Ceil(float64(cost) / float64(units))),
+				EstimatedCost: cost,
+			})
+			manifest.TotalUnits += units
+			manifest.ExpectedTotalCost += cost
+			bought[i] += units
+			planned = true
+		}
+	}
+
+	for i, line := range lines {
+		if left := line.Units - allocated[i]; left > 0 {
+			manifest.Unplanned[line.GoodSymbol] += left
+		}
+	}
+	return manifest, nil
+}
+
+// manifestPriority returns line indexes in loading order: lines with a resale
+// value by descending margin, then the rest as given.
+func manifestPriority(lines []ManifestLine) []int {
+	order := make([]int, len(lines))
+	for i := range order {
+		order[i] = i
+	}
+	sort.SliceStable(order, func(a, b int) bool {
+		la, lb := lines[order[a]], lines[order[b]]
+		if (la.UnitValue > 0) != (lb.UnitValue > 0) {
+			return la.UnitValue > 0
+		}
+		if la.UnitValue == 0 {
+			return false
+		}
+		return la.UnitValue-la.Ask > lb.UnitValue-lb.Ask
+	})
+	return order
+}
+
+// affordableUnits returns the most of up to units of line whose expected cost
+// fits budget. Cost only grows with units, so it binary-searches.
+func (p *CargoManifestPlanner) affordableUnits(line ManifestLine, units, budget int) int {
+	if p.lineCost(line, units) <= budget {
+		return units
+	}
+	lo, hi := 0, units
+	for lo < hi {
+		mid := (lo + hi + 1) / 2
+		if p.lineCost(line, mid) <= budget {
+			lo = mid
+		} else {
+			hi = mid - 1
+		}
+	}
+	return lo
+}
+
+// lineCost is the expected cost of buying units of line in trade-volume calls.
+func (p *CargoManifestPlanner) lineCost(line ManifestLine, units int) int {
+	total := 0
+	for bought := 0; bought < units; {
+		call := min(units-bought, line.TradeVolume)
+		total += p.trancheCost(line, bought, call)
+		bought += call
+	}
+	return total
+}
+
+// trancheCost prices one call of units after already units of the same good have
+// been bought: the ask has climbed by the impact of those earlier units, and the
+// call itself fills at the average of its own climb.
+func (p *CargoManifestPlanner) trancheCost(line ManifestLine, already, units int) int {
+	tv := float64(line.TradeVolume)
+	startAsk := PostTradeBuyPrice(float64(line.Ask), float64(already)/tv, p.buyImpact)
+	average := EffectiveBuyPrice(startAsk, float64(units)/tv, p.buyImpact)
+	return int(math.Ceil(average * float64(units)))
+}
@@ -0,0 +1,113 @@
+package trading_test
+
+import (
+	"testing"
+
+	"github.com/andrescamacho/spacetraders-go/internal/domain/trading"
+)
+
+// Oracles below are worked by hand from the buy-impact model at 0.05: a call of
+// u units after b already bought, trade volume tv, fills at an average of
+// ask·(1+0.05·b/tv)·(1+0.05·(u/tv)/2), rounded up per call.
+
+func purchaseShape(manifest *trading.CargoManifest) []trading.ManifestPurchase {
+	shape := make([]trading.ManifestPurchase, len(manifest.Purchases))
+	for i, p := range manifest.Purchases {
+		shape[i] = trading.ManifestPurchase{GoodSymbol: p.GoodSymbol, Units: p.Units}
+	}
+	return shape
+}
+
+func TestCargoManifestPlanner_SplitsByTradeVolumeAndPricesTheClimb(t *testing.T) {
+	planner := trading.NewCargoManifestPlanner(0.05)
+
+	manifest, err := planner.Plan([]trading.ManifestLine{
+		{GoodSymbol: "IRON_ORE", Units: 25, Ask: 100, TradeVolume: 10},
+	}, 40, 0)
+	if err != nil {
+		t.Fatalf("Plan: %v", err)
+	}
+
+	// 10 @ 102.5 = 1025; 10 @ 105·1.025 = 1076.25 → 1077; 5 @ 110·1.0125 = 556.875 → 557.
+	wantCosts := []int{1025, 1077, 557}
+	if len(manifest.Purchases) != len(wantCosts) {
+		t.Fatalf("expected %d calls, got %+v", len(wantCosts), manifest.Purchases)
+	}
+	for i, want := range wantCosts {
+		if got := manifest.Purchases[i].EstimatedCost; got != want {
+			t.Fatalf("call %d: expected cost %d, got %d", i, want, got)
+		}
+	}
+	if manifest.Purchases[2].Units != 5 || manifest.TotalUnits != 25 {
+		t.Fatalf("expected 10+10+5 units, got %+v", manifest.Purchases)
+	}
+	if manifest.ExpectedTotalCost != 2659 {
+		t.Fatalf("expected total 2659, got %d", manifest.ExpectedTotalCost)
+	}
+	if len(manifest.Unplanned) != 0 {
+		t.Fatalf("expected everything planned, got %v", manifest.Unplanned)
+	}
+}
+
+func TestCargoManifestPlanner_FillsByMarginAndInterleavesCalls(t *testing.T) {
+	planner := trading.NewCargoManifestPlanner(0.05)
+
+	manifest, err := planner.Plan([]trading.ManifestLine{
+		{GoodSymbol: "ALUMINUM", Units: 20, Ask: 100, TradeVolume: 10, UnitValue: 150}, // margin 50
+		{GoodSymbol: "FUEL", Units: 20, Ask: 10, TradeVolume: 10},                      // no resale value
+		{GoodSymbol: "COPPER", Units: 20, Ask: 50, TradeVolume: 10, UnitValue: 200},    // margin 150
+	}, 35, 0)
+	if err != nil {
+		t.Fatalf("Plan: %v", err)
+	}
+
+	want := []trading.ManifestPurchase{
+		{GoodSymbol: "COPPER", Units: 10},
+		{GoodSymbol: "ALUMINUM", Units: 10},
+		{GoodSymbol: "COPPER", Units: 10},
+		{GoodSymbol: "ALUMINUM", Units: 5},
+	}
+	got := purchaseShape(manifest)
+	if len(got) != len(want) {
+		t.Fatalf("expected calls %+v, got %+v", want, got)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Fatalf("call %d: expected %+v, got %+v", i, want[i], got[i])
+		}
+	}
+	if manifest.Unplanned["ALUMINUM"] != 5 || manifest.Unplanned["FUEL"] != 20 {
+		t.Fatalf("expected 5 ALUMINUM and 20 FUEL unplanned, got %v", manifest.Unplanned)
+	}
+}
+
+func TestCargoManifestPlanner_StopsAtBudget(t *testing.T) {
+	planner := trading.NewCargoManifestPlanner(0.05)
+
+	manifest, err := planner.Plan([]trading.ManifestLine{
+		{GoodSymbol: "IRON_ORE", Units: 30, Ask: 100, TradeVolume: 10},
+	}, 40, 2000)
+	if err != nil {
+		t.Fatalf("Plan: %v", err)
+	}
+
+	// 10 units cost 1025; 9 more at 105·1.0225 cost 966.26 → 967, total 1992;
+	// a tenth would take the second call to 1077 and the total past 2000.
+	if manifest.TotalUnits != 19 || manifest.ExpectedTotalCost != 1992 {
+		t.Fatalf("expected 19 units for 1992, got %d for %d", manifest.TotalUnits, manifest.ExpectedTotalCost)
+	}
+	if manifest.Unplanned["IRON_ORE"] != 11 {
+		t.Fatalf("expected 11 units unplanned, got %v", manifest.Unplanned)
+	}
+}
+
+func TestCargoManifestPlanner_RejectsLineWithoutTradeVolume(t *testing.T) {
+	planner := trading.NewCargoManifestPlanner(0)
+
+	if _, err := planner.Plan([]trading.ManifestLine{{GoodSymbol: "IRON_ORE", Units: 5, Ask: 10}}, 40, 0); err == nil {
+		t.Fatalf("expected an error for a line with no trade volume")
+	}
+	if _, err := planner.Plan(nil, 0, 0); err != trading.ErrInvalidCargoCapacity {
+		t.Fatalf("expected ErrInvalidCargoCapacity, got %v", err)
+	}
+}
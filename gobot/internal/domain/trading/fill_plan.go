@@ -0,0 +1,172 @@
+package trading
+
+// A market trades a good in transactions of at most its tradeVolume units, so a
+// hull moving more than that fills over several transactions, and each one moves
+// the price the next one pays. The fill model below walks a fill transaction by
+// transaction with the era-fitted impact slopes (price_impact.go): after k full
+// tradeVolumes the ask has risen by buyImpact·k and the bid fallen by
+// sellImpact·k. The market's activity scales that slope — a STRONG market
+// absorbs a tranche with a smaller move than a WEAK one.
+//
+// The activity factors are a relative ordering, not a fit: they keep an
+// unlabelled market (factor 1) priced exactly as the plain impact model does.
+const (
+	activityFactorStrong     = 0.75
+	activityFactorGrowing    = 1.0
+	activityFactorWeak       = 1.25
+	activityFactorRestricted = 1.5
+)
+
+// ActivityImpactFactor scales the per-tradeVolume price impact by the market's
+// activity (WEAK, GROWING, STRONG, RESTRICTED). An unknown or empty activity is
+// 1, the unscaled model.
+func ActivityImpactFactor(activity string) float64 {
+	switch activity {
+	case "STRONG":
+		return activityFactorStrong
+	case "GROWING":
+		return activityFactorGrowing
+	case "WEAK":
+		return activityFactorWeak
+	case "RESTRICTED":
+		return activityFactorRestricted
+	default:
+		return 1
+	}
+}
+
+// TransactionsFor is how many transactions moving units takes at a market whose
+// per-transaction limit is tradeVolume. An unknown limit (<= 0) is one
+// transaction for any positive amount.
+func TransactionsFor(units, tradeVolume int) int {
+	if units <= 0 {
+		return 0
+	}
+	if tradeVolume <= 0 {
+		return 1
+	}
+	return (units + tradeVolume - 1) / tradeVolume
+}
+
+// TransactionFill is one transaction of a fill: its units and projected unit price
+type TransactionFill struct {
+	Units int
+	Price int
+}
+
+// FillPlan is the projected transaction-by-transaction fill of units at one
+// market. Drift is how far the last transaction's price has moved against the
+// trader relative to the first (a fraction; 0 for a single transaction).
+type FillPlan struct {
+	Units        int
+	TradeVolume  int
+	Transactions []TransactionFill
+	Total        int // credits paid (buy) or received (sell) across the fill
+	AveragePrice float64
+	Drift        float64
+}
+
+// PlanBuyFill projects buying units at a market quoting ask, with buyImpact the
+// fractional ask rise per full tradeVolume bought (before activity scaling).
+func PlanBuyFill(ask, tradeVolume, units int, activity string, buyImpact float64) FillPlan {
+	return planFill(ask, tradeVolume, units, buyImpact*ActivityImpactFactor(activity))
+}
+
+// PlanSellFill projects selling units into a market bidding bid, with
+// sellImpact the fractional bid fall per full tradeVolume sold (before activity
+// scaling).
+func PlanSellFill(bid, tradeVolume, units int, activity string, sellImpact float64) FillPlan {
+	return planFill(bid, tradeVolume, units, -sellImpact*ActivityImpactFactor(activity))
+}
+
+// planFill walks the fill; slope is the signed price move per full tradeVolume
+func planFill(price, tradeVolume, units int, slope float64) FillPlan {
+	plan := FillPlan{Units: units, TradeVolume: tradeVolume}
+	if units <= 0 {
+		return plan
+	}
+	step := tradeVolume
+	if step <= 0 {
+		step = units
+	}
+
+	filled := 0
+	for filled < units {
+		qty := min(step, units-filled)
+		x := float64(filled) / float64(step)
+		unitPrice := max(int(float64(price)*(1+slope*x)+0.5), 0)
+		plan.Transactions = append(plan.Transactions, TransactionFill{Units: qty, Price: unitPrice})
+		plan.Total += qty * unitPrice
+		filled += qty
+	}
+
+	plan.AveragePrice = float64(plan.Total) / float64(units)
+	first, last := plan.Transactions[0].Price, plan.Transactions[len(plan.Transactions)-1].Price
+	if first > 0 {
+		drift := float64(last-first) / float64(first)
+		if slope < 0 {
+			drift = -drift
+		}
+		plan.Drift = drift
+	}
+	return plan
+}
+
+// priceForUnit is the price the fill pays for its i-th unit (0-based)
+func (p FillPlan) priceForUnit(i int) int {
+	for _, tx := range p.Transactions {
+		if i < tx.Units {
+			return tx.Price
+		}
+		i -= tx.Units
+	}
+	return 0
+}
+
+// LaneFill is the projected fill of one buy-here, sell-there trade. NetProfit
+// is what the whole fill clears after both legs' price moves. ProfitableUnits
+// is how many units are bought and sold before the marginal unit stops clearing
+// a profit; when it is short of the planned units, the margin erodes mid-fill
+// and the tail of the trade loses money.
+type LaneFill struct {
+	Buy             FillPlan
+	Sell            FillPlan
+	NetProfit       int
+	ProfitableUnits int
+}
+
+// ErodesMidFill reports whether prices move against the trade far enough that
+// its later units no longer clear a profit.
+func (f LaneFill) ErodesMidFill() bool {
+	return f.ProfitableUnits < f.Buy.Units
+}
+
+// Transactions is the number of market transactions the trade takes, buys and
+// sells together.
+func (f LaneFill) Transactions() int {
+	return len(f.Buy.Transactions) + len(f.Sell.Transactions)
+}
+
+// PlanLaneFill projects moving units along lane. Each side's per-transaction
+// limit is its own market's trade volume when the lane carries it, else the
+// lane's VolumeCap.
+func PlanLaneFill(lane ArbitrageLane, units int, buyImpact, sellImpact float64) LaneFill {
+	sourceVolume, destVolume := lane.SourceVolume, lane.DestVolume
+	if sourceVolume <= 0 {
+		sourceVolume = lane.VolumeCap
+	}
+	if destVolume <= 0 {
+		destVolume = lane.VolumeCap
+	}
+
+	fill := LaneFill{
+		Buy:  PlanBuyFill(lane.SourceAsk, sourceVolume, units, lane.SourceActivity, buyImpact),
+		Sell: PlanSellFill(lane.DestBid, destVolume, units, lane.DestActivity, sellImpact),
+	}
+	fill.NetProfit = fill.Sell.Total - fill.Buy.Total
+	for fill.ProfitableUnits < units &&
+		fill.Sell.priceForUnit(fill.ProfitableUnits) > fill.Buy.priceForUnit(fill.ProfitableUnits) {
+		fill.ProfitableUnits++
+	}
+	return fill
+}
@@ -0,0 +1,93 @@
+package trading_test
+
+import (
+	"testing"
+
+	"github.com/andrescamacho/spacetraders-go/internal/domain/trading"
+)
+
+func TestTransactionsFor(t *testing.T) {
+	cases := []struct {
+		units, tradeVolume, want int
+	}{
+		{0, 20, 0},
+		{20, 20, 1},
+		{21, 20, 2},
+		{80, 20, 4},
+		{50, 0, 1}, // unknown limit: one transaction
+	}
+	for _, c := range cases {
+		if got := trading.TransactionsFor(c.units, c.tradeVolume); got != c.want {
+			t.Errorf("TransactionsFor(%d, %d) = %d, want %d", c.units, c.tradeVolume, got, c.want)
+		}
+	}
+}
+
+// Buying three tradeVolumes at 5% impact per volume pays 1000, 1050 and 1100 per
+// unit; the first transaction is at the quoted ask.
+func TestPlanBuyFill_WalksAskPerTransaction(t *testing.T) {
+	plan := trading.PlanBuyFill(1000, 10, 25, "", 0.05)
+
+	if len(plan.Transactions) != 3 {
+		t.Fatalf("expected 3 transactions, got %d", len(plan.Transactions))
+	}
+	want := []trading.TransactionFill{{Units: 10, Price: 1000}, {Units: 10, Price: 1050}, {Units: 5, Price: 1100}}
+	for i, tx := range plan.Transactions {
+		if tx != want[i] {
+			t.Fatalf("transaction %d: got %+v, want %+v", i, tx, want[i])
+		}
+	}
+	if plan.Total != 10*1000+10*1050+5*1100 {
+		t.Fatalf("unexpected total %d", plan.Total)
+	}
+	approx(t, plan.Drift, 0.10, 1e-9, "buy drift")
+}
+
+// Activity scales the impact: a STRONG sink gives up less bid per tranche than a
+// WEAK one, and a single transaction never drifts.
+func TestPlanSellFill_ActivityScalesImpact(t *testing.T) {
+	strong := trading.PlanSellFill(1000, 10, 30, "STRONG", 0.02)
+	weak := trading.PlanSellFill(1000, 10, 30, "WEAK", 0.02)
+
+	if strong.Transactions[2].Price != 970 {
+		t.Fatalf("STRONG third tranche: got %d, want 970", strong.Transactions[2].Price)
+	}
+	if weak.Transactions[2].Price != 950 {
+		t.Fatalf("WEAK third tranche: got %d, want 950", weak.Transactions[2].Price)
+	}
+	if weak.Total >= strong.Total {
+		t.Fatalf("a WEAK sink must bring in less: weak %d, strong %d", weak.Total, strong.Total)
+	}
+
+	single := trading.PlanSellFill(1000, 10, 10, "WEAK", 0.02)
+	if single.Drift != 0 || single.Total != 10000 {
+		t.Fatalf("a single transaction sells at the bid with no drift, got %+v", single)
+	}
+}
+
+// A thin lane whose own buying lifts the ask past the sink's falling bid stops
+// clearing a profit partway through the hold.
+func TestPlanLaneFill_DetectsMidFillErosion(t *testing.T) {
+	lane := trading.ArbitrageLane{
+		SourceAsk:    1000,
+		DestBid:      1080,
+		SourceVolume: 10,
+		DestVolume:   40,
+		VolumeCap:    10,
+	}
+
+	fill := trading.PlanLaneFill(lane, 40, 0.05, 0.015)
+	if fill.Transactions() != 5 {
+		t.Fatalf("expected 4 buys + 1 sell, got %d transactions", fill.Transactions())
+	}
+	// Buys at 1000, 1050, 1100, 1150 against a 1080 bid: only the first two
+	// tranches clear.
+	if fill.ProfitableUnits != 20 || !fill.ErodesMidFill() {
+		t.Fatalf("expected erosion after 20 units, got %d (erodes=%v)", fill.ProfitableUnits, fill.ErodesMidFill())
+	}
+
+	short := trading.PlanLaneFill(lane, 10, 0.05, 0.015)
+	if short.ErodesMidFill() || short.NetProfit != 800 {
+		t.Fatalf("one tranche clears the full spread, got %+v", short)
+	}
+}
@@ -0,0 +1,180 @@
+package trading
+
+import (
+	"context"
+	"math"
+	"sort"
+	"time"
+)
+
+// Lane execution sources: which engine bought and sold the units.
+const (
+	LaneSourceArb  = "arb_run"
+	LaneSourceTour = "tour"
+)
+
+// LaneExecution is one realized trade along a lane: units of Good bought at
+// BuyMarket and sold at SellMarket. Cost is the buy cost of exactly the units
+// sold, so Revenue−Cost is the lane's realized profit for this execution. A run
+// that splits its sale across several markets records one execution per market.
+type LaneExecution struct {
+	PlayerID    int
+	ContainerID string
+	Source      string
+	BuyMarket   string
+	SellMarket  string
+	Good        string
+	Units       int
+	Cost        int
+	Revenue     int
+	ExecutedAt  time.Time
+}
+
+// Profit is the execution's realized profit.
+func (e LaneExecution) Profit() int {
+	return e.Revenue - e.Cost
+}
+
+// LaneExecutionRepository persists lane executions and reads them back for the
+// lane leaderboard.
+type LaneExecutionRepository interface {
+	Record(ctx context.Context, execution LaneExecution) error
+
+	// ListExecutions returns playerID's executions at or after since, oldest first.
+	ListExecutions(ctx context.Context, playerID int, since time.Time) ([]LaneExecution, error)
+}
+
+// LaneStats is the realized track record of one (buy market, sell market, good)
+// lane.
+type LaneStats struct {
+	BuyMarket      string
+	SellMarket     string
+	Good           string
+	Executions     int
+	Units          int
+	Cost           int
+	Revenue        int
+	RealizedProfit int
+	// ProfitPerUnit is RealizedProfit over Units.
+	ProfitPerUnit float64
+	// WinRate is the share of executions that made money.
+	WinRate float64
+	// Stability is WinRate discounted by how much the per-unit margin swings
+	// between executions: WinRate / (1 + coefficient of variation). A lane that
+	// always pays the same margin keeps its full win rate; a lane whose margin
+	// is noise scores near zero even when its average looks good.
+	Stability     float64
+	FirstExecuted time.Time
+	LastExecuted  time.Time
+}
+
+// Lane ranking orders accepted by RankLaneStats.
+const (
+	LaneRankByProfit    = "profit"
+	LaneRankByVolume    = "volume"
+	LaneRankByStability = "stability"
+)
+
+// SummarizeLaneExecutions groups executions by lane and returns each lane's
+// stats, unordered.
+func SummarizeLaneExecutions(executions []LaneExecution) []LaneStats {
+	type laneKey struct{ buy, sell, good string }
+	byLane := make(map[laneKey][]LaneExecution)
+	var order []laneKey
+	for _, e := range executions {
+		key := laneKey{e.BuyMarket, e.SellMarket, e.Good}
+		if _, ok := byLane[key]; !ok {
+			order = append(order, key)
+		}
+		byLane[key] = append(byLane[key], e)
+	}
+
+	stats := make([]LaneStats, 0, len(order))
+	for _, key := range order {
+		s := LaneStats{BuyMarket: key.buy, SellMarket: key.sell, Good: key.good}
+		var wins int
+		var margins []float64
+		for _, e := range byLane[key] {
+			s.Executions++
+			s.Units += e.Units
+			s.Cost += e.Cost
+			s.Revenue += e.Revenue
+			if e.Profit() > 0 {
+				wins++
+			}
+			if e.Units > 0 {
+				margins = append(margins, float64(e.Profit())/float64(e.Units))
+			}
+			if s.FirstExecuted.IsZero() || e.ExecutedAt.Before(s.FirstExecuted) {
+				s.FirstExecuted = e.ExecutedAt
+			}
+			if e.ExecutedAt.After(s.LastExecuted) {
+				s.LastExecuted = e.ExecutedAt
+			}
+		}
+		s.RealizedProfit = s.Revenue - s.Cost
+		if s.Units > 0 {
+			s.ProfitPerUnit = float64(s.RealizedProfit) / float64(s.Units)
+		}
+		s.WinRate = float64(wins) / float64(s.Executions)
+		s.Stability = s.WinRate / (1 + coefficientOfVariation(margins))
+		stats = append(stats, s)
+	}
+	return stats
+}
+
+// RankLaneStats sorts stats best first by the given order (LaneRankByProfit
+// when unrecognised). Ties fall back to realized profit, then the lane key.
+func RankLaneStats(stats []LaneStats, by string) {
+	primary := func(s LaneStats) float64 {
+		switch by {
+		case LaneRankByVolume:
+			return float64(s.Units)
+		case LaneRankByStability:
+			return s.Stability
+		default:
+			return float64(s.RealizedProfit)
+		}
+	}
+	sort.SliceStable(stats, func(i, j int) bool {
+		if a, b := primary(stats[i]), primary(stats[j]); a != b {
+			return a > b
+		}
+		if stats[i].RealizedProfit != stats[j].RealizedProfit {
+			return stats[i].RealizedProfit > stats[j].RealizedProfit
+		}
+		if stats[i].Good != stats[j].Good {
+			return stats[i].Good < stats[j].Good
+		}
+		if stats[i].BuyMarket != stats[j].BuyMarket {
+			return stats[i].BuyMarket < stats[j].BuyMarket
+		}
+		return stats[i].SellMarket < stats[j].SellMarket
+	})
+}
+
+// coefficientOfVariation is the standard deviation of values over the absolute
+// value of their mean. Fewer than two values, or identical ones, have none; values
+// that vary around a zero mean vary infinitely.
+func coefficientOfVariation(values []float64) float64 {
+	if len(values) < 2 {
+		return 0
+	}
+	var sum float64
+	for _, v := range values {
+		sum += v
+	}
+	mean := sum / float64(len(values))
+	var sq float64
+	for _, v := range values {
+		sq += (v - mean) * (v - mean)
+	}
+	stddev := math.Sqrt(sq / float64(len(values)))
+	if stddev == 0 {
+		return 0
+	}
+	if mean == 0 {
+		return math.Inf(1)
+	}
+	return stddev / math.Abs(mean)
+}
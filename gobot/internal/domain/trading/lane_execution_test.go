@@ -0,0 +1,42 @@
+package trading
+
+import (
+	"testing"
+	"time"
+)
+
+func TestSummarizeLaneExecutions_StabilityPenalisesSwingingMargins(t *testing.T) {
+	t0 := time.Date(2026, 4, 1, 0, 0, 0, 0, time.UTC)
+	steady := func(at time.Duration) LaneExecution {
+		return LaneExecution{BuyMarket: "A", SellMarket: "B", Good: "FUEL", Units: 10, Cost: 100, Revenue: 150, ExecutedAt: t0.Add(at)}
+	}
+	swing := func(revenue int, at time.Duration) LaneExecution {
+		return LaneExecution{BuyMarket: "C", SellMarket: "D", Good: "GOLD", Units: 10, Cost: 100, Revenue: revenue, ExecutedAt: t0.Add(at)}
+	}
+
+	stats := SummarizeLaneExecutions([]LaneExecution{
+		steady(0), swing(400, time.Hour), steady(2 * time.Hour), swing(90, 3*time.Hour),
+	})
+	if len(stats) != 2 {
+		t.Fatalf("expected two lanes, got %d", len(stats))
+	}
+	fuel, gold := stats[0], stats[1]
+	if fuel.RealizedProfit != 100 || fuel.WinRate != 1 || fuel.Stability != 1 {
+		t.Fatalf("a steady lane keeps a full stability score, got %+v", fuel)
+	}
+	if !fuel.FirstExecuted.Equal(t0) || !fuel.LastExecuted.Equal(t0.Add(2*time.Hour)) {
+		t.Fatalf("unexpected execution span %v..%v", fuel.FirstExecuted, fuel.LastExecuted)
+	}
+	if gold.RealizedProfit != 290 || gold.WinRate != 0.5 || gold.Stability >= 0.5 {
+		t.Fatalf("a swinging lane scores below its win rate, got %+v", gold)
+	}
+
+	RankLaneStats(stats, LaneRankByProfit)
+	if stats[0].Good != "GOLD" {
+		t.Fatalf("GOLD realized more, got %s first", stats[0].Good)
+	}
+	RankLaneStats(stats, LaneRankByStability)
+	if stats[0].Good != "FUEL" {
+		t.Fatalf("FUEL is the more reliable lane, got %s first", stats[0].Good)
+	}
+}
@@ -0,0 +1,163 @@
+package trading
+
+import (
+	"context"
+	"math"
+	"sort"
+	"sync/atomic"
+	"time"
+)
+
+const (
+	// DefaultMarketFeeMinSamples is how many observations a market needs before
+	// its fee estimate moves off zero.
+	DefaultMarketFeeMinSamples = 5
+
+	// maxMarketFeeRate caps a market's fee estimate, so a few transactions priced
+	// against a badly stale quote cannot price the market out of every plan.
+	maxMarketFeeRate = 0.25
+)
+
+// Transaction types a MarketFeeObservation records, matching the cargo
+// transaction strategies.
+const (
+	FeeTransactionPurchase = "PURCHASE"
+	FeeTransactionSell     = "SELL"
+)
+
+// MarketFeeObservation is one cargo transaction at a market: the total the
+// quoted market price implied (units × the cached ask or bid) and the total the
+// API actually charged or paid.
+type MarketFeeObservation struct {
+	Waypoint        string
+	Good            string
+	TransactionType string
+	Units           int
+	Expected        int
+	Actual          int
+	ObservedAt      time.Time
+}
+
+// FeeRate is the share of the expected total lost to the market: what a
+// purchase paid over the quote, or what a sale received under it. Negative when
+// the transaction beat its quote; zero when there was no quote.
+func (o MarketFeeObservation) FeeRate() float64 {
+	if o.Expected <= 0 {
+		return 0
+	}
+	lost := o.Actual - o.Expected
+	if o.TransactionType == FeeTransactionSell {
+		lost = o.Expected - o.Actual
+	}
+	return float64(lost) / float64(o.Expected)
+}
+
+// MarketFeeRepository persists fee observations for fee fits.
+type MarketFeeRepository interface {
+	Record(ctx context.Context, observation MarketFeeObservation) error
+
+	// FindRecent returns up to limit observations across every market, newest first.
+	FindRecent(ctx context.Context, limit int) ([]MarketFeeObservation, error)
+}
+
+// MarketFeeObserver is notified of every cargo transaction's expected vs actual total.
+type MarketFeeObserver interface {
+	ObserveMarketFee(ctx context.Context, observation MarketFeeObservation)
+}
+
+// MarketFees holds per-waypoint fee rates charged on top of quoted prices. A
+// market without a rate is assumed fee-free.
+type MarketFees struct {
+	rates map[string]float64
+}
+
+// NewMarketFees creates a fee model from per-waypoint rates, clamped to
+// [0, maxMarketFeeRate]: a market that has beaten its quotes is fee-free, not a
+// rebate to plan on.
+func NewMarketFees(rates map[string]float64) *MarketFees {
+	clamped := make(map[string]float64, len(rates))
+	for waypoint, rate := range rates {
+		clamped[waypoint] = math.Min(math.Max(rate, 0), maxMarketFeeRate)
+	}
+	return &MarketFees{rates: clamped}
+}
+
+// Rate returns the fee rate at waypoint, 0 when unknown.
+func (f *MarketFees) Rate(waypoint string) float64 {
+	if f == nil {
+		return 0
+	}
+	return f.rates[waypoint]
+}
+
+// Markets returns how many waypoints carry a fee rate.
+func (f *MarketFees) Markets() int {
+	if f == nil {
+		return 0
+	}
+	return len(f.rates)
+}
+
+// Fee is the estimated fee on a transaction worth gross credits at waypoint,
+// rounded up so any modelled fee costs at least a credit.
+func (f *MarketFees) Fee(waypoint string, gross int) int {
+	rate := f.Rate(waypoint)
+	if rate == 0 || gross <= 0 {
+		return 0
+	}
+	return int(math.Ceil(float64(gross) * rate))
+}
+
+// LaneFeePerUnit is the estimated fee per unit of buying at sourceAsk on
+// source and selling at destBid on dest.
+func (f *MarketFees) LaneFeePerUnit(source string, sourceAsk int, dest string, destBid int) int {
+	return f.Fee(source, sourceAsk) + f.Fee(dest, destBid)
+}
+
+// FitMarketFees fits one fee rate per waypoint as the median FeeRate of its
+// observations. The median keeps one transaction priced against a moving market
+// from setting the rate. A waypoint with fewer than minSamples observations
+// keeps no rate; observations with no quote are ignored.
+func FitMarketFees(observations []MarketFeeObservation, minSamples int) *MarketFees {
+	byWaypoint := make(map[string][]float64)
+	for _, obs := range observations {
+		if obs.Expected <= 0 || obs.Actual <= 0 {
+			continue
+		}
+		byWaypoint[obs.Waypoint] = append(byWaypoint[obs.Waypoint], obs.FeeRate())
+	}
+
+	rates := make(map[string]float64)
+	for waypoint, samples := range byWaypoint {
+		if len(samples) < minSamples {
+			continue
+		}
+		sort.Float64s(samples)
+		mid := len(samples) / 2
+		median := samples[mid]
+		if len(samples)%2 == 0 {
+			median = (samples[mid-1] + samples[mid]) / 2
+		}
+		if median > 0 {
+			rates[waypoint] = median
+		}
+	}
+	return NewMarketFees(rates)
+}
+
+// activeMarketFees is the fee model lane ranking and profitability estimates
+// charge. It is replaced by the daemon's fee service after each fit; nil means
+// every market is fee-free.
+var activeMarketFees atomic.Pointer[MarketFees]
+
+// SetMarketFees installs the fee model profitability estimates apply. nil
+// reverts to fee-free pricing.
+func SetMarketFees(fees *MarketFees) {
+	activeMarketFees.Store(fees)
+}
+
+// ActiveMarketFees returns the installed fee model, nil when none. Its methods
+// are nil-safe, so callers use the result directly.
+func ActiveMarketFees() *MarketFees {
+	return activeMarketFees.Load()
+}
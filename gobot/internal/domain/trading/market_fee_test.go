@@ -0,0 +1,111 @@
+package trading
+
+import (
+	"math"
+	"testing"
+)
+
+func feeObs(waypoint, txType string, expected, actual int) MarketFeeObservation {
+	return MarketFeeObservation{Waypoint: waypoint, Good: "IRON_ORE", TransactionType: txType, Units: 10, Expected: expected, Actual: actual}
+}
+
+// A purchase loses what it paid over the quote; a sale loses what it received
+// under it.
+func TestMarketFeeObservation_FeeRateBySide(t *testing.T) {
+	if r := feeObs("X1-A", FeeTransactionPurchase, 1000, 1020).FeeRate(); math.Abs(r-0.02) > 1e-9 {
+		t.Fatalf("purchase charged 1020 against a 1000 quote: want 0.02, got %v", r)
+	}
+	if r := feeObs("X1-A", FeeTransactionSell, 1000, 970).FeeRate(); math.Abs(r-0.03) > 1e-9 {
+		t.Fatalf("sale paid 970 against a 1000 quote: want 0.03, got %v", r)
+	}
+	if r := feeObs("X1-A", FeeTransactionSell, 0, 970).FeeRate(); r != 0 {
+		t.Fatalf("no quote means no fee, got %v", r)
+	}
+}
+
+// The fit takes each market's median, ignores markets below minSamples, keeps no
+// rebate for a market that beat its quotes, and caps a runaway rate.
+func TestFitMarketFees_MedianPerMarket(t *testing.T) {
+	var obs []MarketFeeObservation
+	// X1-A: 1%, 2%, 2%, 3% and one 40% outlier → median 2%.
+	for _, actual := range []int{1010, 1020, 1020, 1030, 1400} {
+		obs = append(obs, feeObs("X1-A", FeeTransactionPurchase, 1000, actual))
+	}
+	// X1-B: only two samples → unfitted.
+	obs = append(obs, feeObs("X1-B", FeeTransactionPurchase, 1000, 1100), feeObs("X1-B", FeeTransactionPurchase, 1000, 1100))
+	// X1-C: sales consistently above the quote → no rate.
+	for i := 0; i < 3; i++ {
+		obs = append(obs, feeObs("X1-C", FeeTransactionSell, 1000, 1050))
+	}
+	// X1-D: every sale half the quote → capped.
+	for i := 0; i < 3; i++ {
+		obs = append(obs, feeObs("X1-D", FeeTransactionSell, 1000, 500))
+	}
+
+	fees := FitMarketFees(obs, 3)
+	if r := fees.Rate("X1-A"); math.Abs(r-0.02) > 1e-9 {
+		t.Fatalf("X1-A: want median 0.02, got %v", r)
+	}
+	if r := fees.Rate("X1-B"); r != 0 {
+		t.Fatalf("X1-B is below minSamples and must stay fee-free, got %v", r)
+	}
+	if r := fees.Rate("X1-C"); r != 0 {
+		t.Fatalf("X1-C beat its quotes and must not be a rebate, got %v", r)
+	}
+	if r := fees.Rate("X1-D"); r != maxMarketFeeRate {
+		t.Fatalf("X1-D must be capped at %v, got %v", maxMarketFeeRate, r)
+	}
+	if fees.Markets() != 2 {
+		t.Fatalf("want rates for X1-A and X1-D only, got %d", fees.Markets())
+	}
+}
+
+// Fees round up, and a nil model is fee-free.
+func TestMarketFees_FeeRoundsUpAndNilIsFree(t *testing.T) {
+	fees := NewMarketFees(map[string]float64{"X1-A": 0.01})
+	if got := fees.Fee("X1-A", 150); got != 2 {
+		t.Fatalf("1%% of 150 must round up to 2, got %d", got)
+	}
+	if got := fees.LaneFeePerUnit("X1-A", 300, "X1-Z", 900); got != 3 {
+		t.Fatalf("lane fee is charged on the source ask only when the dest is fee-free: want 3, got %d", got)
+	}
+	var none *MarketFees
+	if got := none.Fee("X1-A", 1000); got != 0 {
+		t.Fatalf("a nil model must be fee-free, got %d", got)
+	}
+}
+
+// With fees installed, a lane's spread is net of both legs' fees, so a lane with
+// a wide gross spread at an expensive market can rank below a cheaper one and a
+// thin lane the fees eat drops out.
+func TestRankSpreads_ChargesInstalledFees(t *testing.T) {
+	t.Cleanup(func() { SetMarketFees(nil) })
+	// FIREARMS sells into J56: 50% of a 900 bid would be 450/u but the cap holds it
+	// at 25% → 225/u. GADGETS is untouched.
+	SetMarketFees(NewMarketFees(map[string]float64{"X1-SYS-J56": 0.5, "X1-SYS-D4": 0.25}))
+
+	lanes := RankSpreads(spreadFixture())
+	if len(lanes) != 2 {
+		t.Fatalf("want FIREARMS and GADGETS, got %+v", lanes)
+	}
+	top := lanes[0]
+	if top.Good != "FIREARMS" || top.FeePerUnit != 225 || top.SpreadPerUnit != 375 || top.CappedSpread != 7500 {
+		t.Fatalf("FIREARMS: want fee 225, spread 900−300−225=375, capped 7500; got %+v", top)
+	}
+	if lanes[1].FeePerUnit != 0 || lanes[1].SpreadPerUnit != 1000 {
+		t.Fatalf("GADGETS trades at fee-free markets, got %+v", lanes[1])
+	}
+
+	// A 10/u spread into a market charging 10% of its 110 bid (11/u) loses money.
+	thin := []GoodListing{
+		{Good: "ICE_WATER", Waypoint: "X1-SYS-F1", TradeType: "EXPORT", Bid: 90, Ask: 100, Volume: 60},
+		{Good: "ICE_WATER", Waypoint: "X1-SYS-G2", TradeType: "IMPORT", Bid: 110, Ask: 120, Volume: 60},
+	}
+	if lanes := RankSpreads(thin); len(lanes) != 1 {
+		t.Fatalf("fee-free, the thin lane is profitable: got %+v", lanes)
+	}
+	SetMarketFees(NewMarketFees(map[string]float64{"X1-SYS-G2": 0.10}))
+	if lanes := RankSpreads(thin); len(lanes) != 0 {
+		t.Fatalf("a lane whose fees exceed its spread must not be ranked, got %+v", lanes)
+	}
+}
@@ -0,0 +1,87 @@
+package config
+
+import (
+	"strings"
+	"time"
+
+	"github.com/andrescamacho/spacetraders-go/internal/domain/ledger"
+)
+
+// DefaultCashflowAlertCheckInterval is how often the daemon evaluates the
+// cashflow thresholds when [cashflow_alerts] leaves the cadence unset.
+const DefaultCashflowAlertCheckInterval = 5 * time.Minute
+
+// CashflowAlertsConfig holds the ledger cashflow alerting knobs under the
+// [cashflow_alerts] section. Alerting is off until enabled; an enabled section
+// with no thresholds watches a single "hourly-loss" threshold of -50k/hour.
+type CashflowAlertsConfig struct {
+	Enabled bool `mapstructure:"enabled"`
+
+	// CheckIntervalSeconds is the wait between threshold checks. 0/absent =>
+	// DefaultCashflowAlertCheckInterval (5min).
+	CheckIntervalSeconds int `mapstructure:"check_interval_seconds"`
+
+	// CooldownMinutes is how long a threshold that stays breached waits before
+	// it fires again. 0/absent => 60.
+	CooldownMinutes int `mapstructure:"cooldown_minutes"`
+
+	Thresholds []CashflowThresholdSettings `mapstructure:"thresholds"`
+
+	// WebhookURL, when set, POSTs every alert as JSON in addition to logging it.
+	WebhookURL string `mapstructure:"webhook_url"`
+}
+
+// CashflowThresholdSettings is one threshold: alert when the net credits per
+// hour over the last WindowMinutes fall below MinNetPerHour.
+type CashflowThresholdSettings struct {
+	Name          string `mapstructure:"name"`
+	WindowMinutes int    `mapstructure:"window_minutes"` // 0/absent => 60
+	MinNetPerHour int    `mapstructure:"min_net_per_hour"`
+
+	// ExcludeCategories names ledger categories left out of the window, e.g.
+	// SHIP_INVESTMENTS.
+	ExcludeCategories []string `mapstructure:"exclude_categories"`
+}
+
+// ResolvedCheckInterval maps CheckIntervalSeconds to a duration, applying the
+// default for an unset/non-positive knob.
+func (c CashflowAlertsConfig) ResolvedCheckInterval() time.Duration {
+	if c.CheckIntervalSeconds <= 0 {
+		return DefaultCashflowAlertCheckInterval
+	}
+	return time.Duration(c.CheckIntervalSeconds) * time.Second
+}
+
+// ResolvedCooldown maps CooldownMinutes to a duration; 0 lets the alerter apply
+// its own default.
+func (c CashflowAlertsConfig) ResolvedCooldown() time.Duration {
+	if c.CooldownMinutes <= 0 {
+		return 0
+	}
+	return time.Duration(c.CooldownMinutes) * time.Minute
+}
+
+// ResolvedThresholds maps the configured thresholds to the ledger domain,
+// applying the window default, or returns the single default threshold when
+// none are configured. Category names are validated by the alerter.
+func (c CashflowAlertsConfig) ResolvedThresholds() []ledger.CashflowThreshold {
+	if len(c.Thresholds) == 0 {
+		return []ledger.CashflowThreshold{{Name: "hourly-loss", Window: time.Hour, MinNetPerHour: -50000}}
+	}
+	resolved := make([]ledger.CashflowThreshold, 0, len(c.Thresholds))
+	for _, t := range c.Thresholds {
+		threshold := ledger.CashflowThreshold{
+			Name:          t.Name,
+			Window:        time.Duration(t.WindowMinutes) * time.Minute,
+			MinNetPerHour: t.MinNetPerHour,
+		}
+		if t.WindowMinutes <= 0 {
+			threshold.Window = time.Hour
+		}
+		for _, category := range t.ExcludeCategories {
+			threshold.ExcludeCategories = append(threshold.ExcludeCategories, ledger.Category(strings.ToUpper(category)))
+		}
+		resolved = append(resolved, threshold)
+	}
+	return resolved
+}
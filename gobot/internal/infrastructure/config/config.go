@@ -59,6 +59,38 @@ type Config struct {
 	// interval + jitter — consumed by the daemon's ShipResyncScheduler. Zero defers to the
 	// documented defaults (1h +/-10min).
 	ShipResync ResyncConfig `mapstructure:"ship_resync"`
+	// CashflowAlerts holds the ledger cashflow alerting thresholds and outputs,
+	// checked periodically by the daemon. Off unless enabled.
+	CashflowAlerts CashflowAlertsConfig `mapstructure:"cashflow_alerts"`
+	// CreditReconciliation periodically compares the API's agent credits with
+	// the ledger balance and books the gap. Off unless enabled.
+	CreditReconciliation CreditReconciliationConfig `mapstructure:"credit_reconciliation"`
+	// StatusWatch reads the API status endpoint, warns ahead of resets and
+	// version changes, and stands the fleet down on a reset. Off unless enabled.
+	StatusWatch StatusWatchConfig `mapstructure:"status_watch"`
+	// ContainerLogRetention prunes (and optionally archives) old container log
+	// lines on a timer. Off unless enabled.
+	ContainerLogRetention ContainerLogRetentionConfig `mapstructure:"container_log_retention"`
+	// ShipMaintenance sends worn hulls for shipyard repairs between trade
+	// circuits. Off unless enabled.
+	ShipMaintenance ShipMaintenanceConfig `mapstructure:"ship_maintenance"`
+	// DailySummary logs the operations digest (GetDailySummaryQuery) on a
+	// timer. Off unless enabled.
+	DailySummary DailySummaryConfig `mapstructure:"daily_summary"`
+	// HTTPGateway exposes the read-side queries as token-authenticated
+	// HTTP/JSON for scripts and dashboards. Off unless enabled.
+	HTTPGateway HTTPGatewayConfig `mapstructure:"http_gateway"`
+	// Scheduler runs recurring jobs (scout tours, ledger reconciliation,
+	// market exports) with persisted run history. Off unless enabled.
+	Scheduler SchedulerConfig `mapstructure:"scheduler"`
+	// ShipyardWatch reads configured shipyards for sought-after ship types,
+	// records when they are in stock and can buy one automatically under a
+	// price cap. Off unless enabled.
+	ShipyardWatch ShipyardWatchConfig `mapstructure:"shipyard_watch"`
+
+	// SourceFile is the config file LoadConfig read, or "" when it booted from
+	// env vars and defaults alone. The Reloader re-reads and watches it.
+	SourceFile string `mapstructure:"-"`
 }
 
 // LoadConfig loads configuration from multiple sources with priority:
@@ -95,6 +127,11 @@ func LoadConfig(configPath string) (*Config, error) {
 	v.BindEnv("metrics.port", "ST_METRICS_PORT")
 	v.BindEnv("metrics.host", "ST_METRICS_HOST")
 	v.BindEnv("metrics.path", "ST_METRICS_PATH")
+	// HTTP gateway
+	v.BindEnv("http_gateway.enabled", "ST_HTTP_GATEWAY_ENABLED")
+	v.BindEnv("http_gateway.host", "ST_HTTP_GATEWAY_HOST")
+	v.BindEnv("http_gateway.port", "ST_HTTP_GATEWAY_PORT")
+	v.BindEnv("http_gateway.token", "ST_HTTP_GATEWAY_TOKEN")
 
 	// Read config file (optional - don't error if missing)
 	if err := v.ReadInConfig(); err != nil {
@@ -116,6 +153,8 @@ func LoadConfig(configPath string) (*Config, error) {
 		return nil, fmt.Errorf("failed to unmarshal config: %w", err)
 	}
 
+	cfg.SourceFile = v.ConfigFileUsed()
+
 	// Apply defaults for any missing values
 	SetDefaults(&cfg)
 
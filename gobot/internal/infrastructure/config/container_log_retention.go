@@ -0,0 +1,68 @@
+package config
+
+import "time"
+
+const (
+	// DefaultLogRetentionInterval is how often the daemon prunes container logs
+	// when [container_log_retention] leaves the cadence unset.
+	DefaultLogRetentionInterval = time.Hour
+	// DefaultLogRetentionMaxAge is how long container log lines are kept when
+	// max_age_days is unset.
+	DefaultLogRetentionMaxAge = 14 * 24 * time.Hour
+	// DefaultLogRetentionMaxRows is the per-container line cap when
+	// max_rows_per_container is unset.
+	DefaultLogRetentionMaxRows = 50000
+)
+
+// ContainerLogRetentionConfig holds the container log retention knobs under the
+// [container_log_retention] section. Pruning is off until enabled.
+type ContainerLogRetentionConfig struct {
+	Enabled bool `mapstructure:"enabled"`
+
+	// IntervalSeconds is the wait between prunes; the first runs at startup.
+	// 0/absent => DefaultLogRetentionInterval (1h).
+	IntervalSeconds int `mapstructure:"interval_seconds"`
+
+	// MaxAgeDays drops lines older than this many days. 0/absent =>
+	// DefaultLogRetentionMaxAge (14d); negative => no age limit.
+	MaxAgeDays int `mapstructure:"max_age_days"`
+
+	// MaxRowsPerContainer keeps only each container's newest lines. 0/absent
+	// => DefaultLogRetentionMaxRows; negative => no cap.
+	MaxRowsPerContainer int `mapstructure:"max_rows_per_container"`
+
+	// ArchiveDir receives the pruned lines as zstd-compressed JSON-lines files.
+	// Empty deletes them without archiving.
+	ArchiveDir string `mapstructure:"archive_dir"`
+}
+
+// ResolvedInterval maps IntervalSeconds to a duration, applying the default
+// for an unset/non-positive knob.
+func (c ContainerLogRetentionConfig) ResolvedInterval() time.Duration {
+	if c.IntervalSeconds <= 0 {
+		return DefaultLogRetentionInterval
+	}
+	return time.Duration(c.IntervalSeconds) * time.Second
+}
+
+// ResolvedMaxAge maps MaxAgeDays to a duration; 0 means no age limit.
+func (c ContainerLogRetentionConfig) ResolvedMaxAge() time.Duration {
+	switch {
+	case c.MaxAgeDays < 0:
+		return 0
+	case c.MaxAgeDays == 0:
+		return DefaultLogRetentionMaxAge
+	}
+	return time.Duration(c.MaxAgeDays) * 24 * time.Hour
+}
+
+// ResolvedMaxRows returns the per-container cap; 0 means no cap.
+func (c ContainerLogRetentionConfig) ResolvedMaxRows() int {
+	switch {
+	case c.MaxRowsPerContainer < 0:
+		return 0
+	case c.MaxRowsPerContainer == 0:
+		return DefaultLogRetentionMaxRows
+	}
+	return c.MaxRowsPerContainer
+}
@@ -1,5 +1,7 @@
 package config
 
+import "time"
+
 // ContractConfig holds contract-coordinator configuration. Today it carries the
 // idle-gap arbitrage harvest knobs (sp-1z2h / sp-uohe): the daemon injects these
 // into the contract fleet coordinator container's launch config at creation
@@ -17,7 +19,55 @@ type ContractConfig struct {
 	// is the LAUNCH tier of the live>launch>default chain; 0/absent defers to the documented default
 	// (MinHomeContractWorkersDefault = 6). Live-tunable without restart via `tune --operation
 	// contract --key min_home_contract_workers`.
-	MinHomeContractWorkers int `mapstructure:"min_home_contract_workers"`
+	MinHomeContractWorkers int              `mapstructure:"min_home_contract_workers"`
+	Batching               BatchingSettings `mapstructure:"batching"`
+	Janitor                JanitorSettings  `mapstructure:"janitor"`
+}
+
+// JanitorDefaultInterval is how often the contract janitor sweeps when
+// interval_seconds is unset.
+const JanitorDefaultInterval = 10 * time.Minute
+
+// JanitorSettings are the yaml knobs for the contract janitor, which flags
+// negotiated contracts whose accept deadline passed unaccepted, stops the
+// containers still working them and negotiates a replacement when a contract
+// workflow is running. OFF unless Enabled is true.
+type JanitorSettings struct {
+	Enabled bool `mapstructure:"enabled"`
+	// IntervalSeconds is the wait between sweeps. <=0 => JanitorDefaultInterval.
+	IntervalSeconds int `mapstructure:"interval_seconds"`
+}
+
+// ResolvedInterval maps IntervalSeconds to a duration, applying the default.
+func (s JanitorSettings) ResolvedInterval() time.Duration {
+	if s.IntervalSeconds <= 0 {
+		return JanitorDefaultInterval
+	}
+	return time.Duration(s.IntervalSeconds) * time.Second
+}
+
+// BatchingDefaultMaxContracts is how many contracts (the worker's own included) one
+// hold services when batching is on and max_contracts is unset.
+const BatchingDefaultMaxContracts = 3
+
+// BatchingSettings are the yaml knobs for multi-contract delivery batching: when
+// several accepted contracts are outstanding, a contract worker packs their goods
+// into shared holds and flies one VRP-ordered purchase-then-deliver tour per hold
+// instead of one round trip per contract. OFF unless Enabled is true.
+type BatchingSettings struct {
+	// Enabled turns batching ON (default OFF).
+	Enabled bool `mapstructure:"enabled"`
+	// MaxContracts caps the contracts one worker batches, its own included.
+	// <=0 => BatchingDefaultMaxContracts.
+	MaxContracts int `mapstructure:"max_contracts"`
+}
+
+// EffectiveMaxContracts resolves MaxContracts against its default.
+func (s BatchingSettings) EffectiveMaxContracts() int {
+	if s.MaxContracts <= 0 {
+		return BatchingDefaultMaxContracts
+	}
+	return s.MaxContracts
 }
 
 // SourcePrepositionSettings are the yaml-tunable knobs for contract source
@@ -139,4 +189,10 @@ type IdleArbSettings struct {
 	MinNetProfitPerUnit int `mapstructure:"min_net_profit_per_unit"`
 	NetProfitPct        int `mapstructure:"net_profit_pct"`
 	FuelCostPerUnit     int `mapstructure:"fuel_cost_per_unit"`
+	// Anti-starvation policy: a hull idle StarvationAfterSeconds without an
+	// assignment is held to the relaxed floor below instead of the one above.
+	// 0 → off; the relaxed floor's 0s → the contract package defaults (25/u, 5%).
+	StarvationAfterSeconds        int `mapstructure:"starvation_after_seconds"`
+	StarvationMinNetProfitPerUnit int `mapstructure:"starvation_min_net_profit_per_unit"`
+	StarvationNetProfitPct        int `mapstructure:"starvation_net_profit_pct"`
 }
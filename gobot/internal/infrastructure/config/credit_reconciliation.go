@@ -0,0 +1,32 @@
+package config
+
+import "time"
+
+// DefaultCreditReconciliationInterval is how often the daemon compares the API's
+// agent credits with the ledger when [credit_reconciliation] leaves the cadence
+// unset.
+const DefaultCreditReconciliationInterval = 15 * time.Minute
+
+// CreditReconciliationConfig holds the ledger credit reconciliation knobs under
+// the [credit_reconciliation] section. Reconciliation is off until enabled.
+type CreditReconciliationConfig struct {
+	Enabled bool `mapstructure:"enabled"`
+
+	// IntervalSeconds is the wait between checks. A drift is booked only after
+	// two consecutive checks agree, so it lands within two intervals. 0/absent
+	// => DefaultCreditReconciliationInterval (15min).
+	IntervalSeconds int `mapstructure:"interval_seconds"`
+
+	// Tolerance is the drift, in credits either way, that is reported in the
+	// drift gauge but not booked as an adjustment. 0/absent books any drift.
+	Tolerance int `mapstructure:"tolerance"`
+}
+
+// ResolvedInterval maps IntervalSeconds to a duration, applying the default
+// for an unset/non-positive knob.
+func (c CreditReconciliationConfig) ResolvedInterval() time.Duration {
+	if c.IntervalSeconds <= 0 {
+		return DefaultCreditReconciliationInterval
+	}
+	return time.Duration(c.IntervalSeconds) * time.Second
+}
@@ -1,6 +1,27 @@
 package config
 
-import "time"
+import (
+	"time"
+
+	"github.com/andrescamacho/spacetraders-go/internal/domain/navigation"
+)
+
+// DefaultMarketScanDedupWindow is how long a market scan suppresses another
+// opportunistic scan of the same market when MarketScanDedupSeconds is unset.
+const DefaultMarketScanDedupWindow = 2 * time.Minute
+
+// DefaultDriftRouteMaxTime is the longest a route plan may drift before the
+// planner replaces it with one that refuels, when DriftRouteMaxSeconds is unset.
+const DefaultDriftRouteMaxTime = time.Hour
+
+// DefaultWaypointBlacklistFailures is how many route segment failures at one
+// waypoint within half an hour get it blacklisted, when
+// WaypointBlacklistFailures is unset.
+const DefaultWaypointBlacklistFailures = 3
+
+// DefaultWaypointBlacklistTTL is how long an automatic blacklist entry lasts
+// when WaypointBlacklistTTLSeconds is unset.
+const DefaultWaypointBlacklistTTL = 6 * time.Hour
 
 // DaemonConfig holds daemon service configuration
 type DaemonConfig struct {
@@ -50,6 +71,19 @@ type DaemonConfig struct {
 	// the rare park path — never on the happy path. Sticky across restart via config.
 	ArrivalWaitLiveReconfirmDisabled bool `mapstructure:"arrival_wait_live_reconfirm_disabled"`
 
+	// ArrivalWatcherEnabled confirms every ship arrival with a GetShipNav read
+	// (the nav block only) before transitioning the ship to IN_ORBIT, instead
+	// of trusting the local arrival timer. A ship the API still shows in
+	// transit is re-checked at the API's arrival time. Absent/false — the
+	// DEFAULT — keeps the timer-only transition.
+	ArrivalWatcherEnabled bool `mapstructure:"arrival_watcher_enabled"`
+
+	// ArrivalSyncOnReadDisabled turns off the ship repository's read-path
+	// arrival sync: a ship read still IN_TRANSIT past its arrival time is
+	// confirmed with one nav read and moved to IN_ORBIT before it is returned.
+	// Absent/false = sync ACTIVE; true leaves arrivals to the timer and sweeper.
+	ArrivalSyncOnReadDisabled bool `mapstructure:"arrival_sync_on_read_disabled"`
+
 	// AgentCacheTTLSeconds bounds how long the shared API client may serve a
 	// cached agent before re-reading /my/agent live (sp-oszc): GetAgent was the
 	// #2 API consumer (343 calls / 1306s rate-limit wait) and agent data changes
@@ -69,6 +103,241 @@ type DaemonConfig struct {
 	// poll is starved. This is the governance gate — the reordering is completely
 	// inert until this is explicitly set true. Sticky across restart via config.
 	APIPrioritySchedulingEnabled bool `mapstructure:"api_priority_scheduling_enabled"`
+
+	// APIInteractiveBurstReserve is the fraction of the API client's burst that
+	// background calls leave for interactive CLI reads (Get Ship, List Ships),
+	// so an operator's command is not queued behind a scan. 0/unset reserves
+	// nothing; values are clamped below 1.
+	APIInteractiveBurstReserve float64 `mapstructure:"api_interactive_burst_reserve"`
+
+	// ShipStateCacheTTLSeconds arms the ship repository's write-through cache of
+	// API ship reads: a SyncShipFromAPI/GetShipData repeated within this window
+	// is answered from memory instead of spending a rate-limit token, unless the
+	// cached transit has passed its arrival time, a navigate has made its fuel
+	// stale, or any ship-mutating API call has invalidated it. Critical reads
+	// (ship refresh, ship info --no-cache, the arrival-wait re-confirm) always
+	// go live. 0/unset disables the cache. Sticky across restart via config.
+	ShipStateCacheTTLSeconds int `mapstructure:"ship_state_cache_ttl_seconds"`
+
+	// StrandedShipRescueEnabled arms the health monitor's stranded-ship rescue:
+	// an idle ship left near-empty at a waypoint without fuel is drifted to the
+	// nearest fuel market, refuelled, and given back its original flight mode.
+	// Absent/false — the DEFAULT — leaves stranded ships where they are.
+	StrandedShipRescueEnabled bool `mapstructure:"stranded_ship_rescue_enabled"`
+
+	// APIRetryPolicies overrides the shared API client's retry policy per
+	// endpoint class (navigation, market_read, purchase, sell, read, write). A
+	// policy bounds retries of AMBIGUOUS failures only — network errors and 5xx,
+	// where the server may already have applied the call; 429s always retry up
+	// to the client-wide budget. Classes absent here keep the built-in table:
+	// purchase never re-sends (a retried buy can double-spend), everything else
+	// inherits the client-wide retries. An unknown class fails daemon boot.
+	APIRetryPolicies map[string]APIRetryPolicySettings `mapstructure:"api_retry_policies"`
+
+	// FuelCalibrationDisabled turns off fuel calibration. By default every
+	// navigation records predicted vs actual fuel use and ShipFuelService scales
+	// its fuel estimates by per-flight-mode factors fitted from those records.
+	FuelCalibrationDisabled bool `mapstructure:"fuel_calibration_disabled"`
+
+	// FuelCalibrationMinSamples is how many observations a flight mode needs
+	// before its factor moves off 1.0. 0/unset => 20.
+	FuelCalibrationMinSamples int `mapstructure:"fuel_calibration_min_samples"`
+
+	// MarketFeeModelDisabled turns off market fee modeling. By default every
+	// cargo transaction priced against a fresh quote records the quoted vs
+	// charged total, and arbitrage and contract profitability estimates charge
+	// the per-market fee rates fitted from those records.
+	MarketFeeModelDisabled bool `mapstructure:"market_fee_model_disabled"`
+
+	// MarketFeeMinSamples is how many observations a market needs before its
+	// fee rate moves off zero. 0/unset => 5.
+	MarketFeeMinSamples int `mapstructure:"market_fee_min_samples"`
+
+	// ConfigReloadCheckSeconds is how often the daemon checks the config file
+	// for edits and hot-reloads it. 0/unset => DefaultReloadCheckInterval (10s);
+	// negative turns the file watch off, leaving SIGHUP as the only trigger.
+	ConfigReloadCheckSeconds int `mapstructure:"config_reload_check_seconds"`
+
+	// MarketScanDedupSeconds is the window in which a market scanned by any
+	// coordinator is not re-scanned by an opportunistic scan (route arrivals,
+	// scout tours, system warm-up). Scans that must read live prices, such as
+	// the trade floor/ceiling refreshes, always go to the API. 0/unset =>
+	// DefaultMarketScanDedupWindow (2m); negative turns deduplication off.
+	// Hot-reloadable.
+	MarketScanDedupSeconds int `mapstructure:"market_scan_dedup_seconds"`
+
+	// FleetTemplatesDir is where BootstrapFleetCommand looks up fleet profiles
+	// by name (<dir>/<name>.yaml). Empty => DefaultFleetTemplatesDir.
+	FleetTemplatesDir string `mapstructure:"fleet_templates_dir"`
+
+	// LoadoutPresetsDir is where batch ship purchases look up post-purchase
+	// loadout presets by name (<dir>/<name>.yaml). Empty => DefaultLoadoutPresetsDir.
+	LoadoutPresetsDir string `mapstructure:"loadout_presets_dir"`
+
+	// OperationProfilesDir is where LaunchOperationProfileCommand looks up
+	// operation profiles by name (<dir>/<name>.yaml). Empty => DefaultOperationProfilesDir.
+	OperationProfilesDir string `mapstructure:"operation_profiles_dir"`
+
+	// CommandAuditDisabled turns off the command audit trail. By default every
+	// command dispatched through the mediator is recorded in command_audit.
+	CommandAuditDisabled bool `mapstructure:"command_audit_disabled"`
+
+	// CommandTimeouts bounds how long a dispatched request may run, keyed by
+	// its type name (e.g. NavigateRouteCommand: 45m). When the deadline passes
+	// the request's context is cancelled; a route stops before its next
+	// segment. Types not listed run unbounded.
+	CommandTimeouts map[string]time.Duration `mapstructure:"command_timeouts"`
+
+	// CommandAuditRetentionDays is how long command audit records are kept.
+	// 0/unset => 7 days.
+	CommandAuditRetentionDays int `mapstructure:"command_audit_retention_days"`
+
+	// CreditReservationTTLSeconds is how long a coordinator's credit
+	// reservation holds credits if it is never released. 0/unset => 2 minutes.
+	CreditReservationTTLSeconds int `mapstructure:"credit_reservation_ttl_seconds"`
+
+	// DriftRouteMaxSeconds is the longest a planned route containing DRIFT
+	// legs may take (or the route must not run the tank dry) before the
+	// planner asks for an alternative that refuels instead. 0/unset =>
+	// DefaultDriftRouteMaxTime (1h); negative turns the gate off.
+	DriftRouteMaxSeconds int `mapstructure:"drift_route_max_seconds"`
+
+	// FuelReservePercent is the share of the tank every planned route must
+	// keep aboard on arrival anywhere along it; a route that cannot is
+	// refused at planning time. A NavigateRouteCommand may override it.
+	// 0/unset turns the reserve off; values above 90 are capped at 90.
+	FuelReservePercent int `mapstructure:"fuel_reserve_percent"`
+
+	// WaypointBlacklistFailures is how many route segments must fail at one
+	// waypoint within half an hour before the health monitor blacklists it.
+	// 0/unset => DefaultWaypointBlacklistFailures (3); negative turns
+	// automatic blacklisting off (operators can still blacklist).
+	WaypointBlacklistFailures int `mapstructure:"waypoint_blacklist_failures"`
+
+	// WaypointBlacklistTTLSeconds is how long an automatic blacklist entry
+	// lasts. 0/unset => DefaultWaypointBlacklistTTL (6h); negative keeps it
+	// until an operator clears it.
+	WaypointBlacklistTTLSeconds int `mapstructure:"waypoint_blacklist_ttl_seconds"`
+}
+
+// ResolvedConfigReloadCheckInterval maps ConfigReloadCheckSeconds to a
+// duration: the default when unset, 0 when the file watch is off.
+func (c DaemonConfig) ResolvedConfigReloadCheckInterval() time.Duration {
+	switch {
+	case c.ConfigReloadCheckSeconds < 0:
+		return 0
+	case c.ConfigReloadCheckSeconds == 0:
+		return DefaultReloadCheckInterval
+	}
+	return time.Duration(c.ConfigReloadCheckSeconds) * time.Second
+}
+
+// ResolvedMarketScanDedupWindow maps MarketScanDedupSeconds to a duration: the
+// default when unset, 0 when deduplication is off.
+func (c DaemonConfig) ResolvedMarketScanDedupWindow() time.Duration {
+	switch {
+	case c.MarketScanDedupSeconds < 0:
+		return 0
+	case c.MarketScanDedupSeconds == 0:
+		return DefaultMarketScanDedupWindow
+	}
+	return time.Duration(c.MarketScanDedupSeconds) * time.Second
+}
+
+// ResolvedFleetTemplatesDir returns FleetTemplatesDir, or the default when unset.
+func (c DaemonConfig) ResolvedFleetTemplatesDir() string {
+	if c.FleetTemplatesDir == "" {
+		return DefaultFleetTemplatesDir
+	}
+	return c.FleetTemplatesDir
+}
+
+// ResolvedLoadoutPresetsDir returns LoadoutPresetsDir, or the default when unset.
+func (c DaemonConfig) ResolvedLoadoutPresetsDir() string {
+	if c.LoadoutPresetsDir == "" {
+		return DefaultLoadoutPresetsDir
+	}
+	return c.LoadoutPresetsDir
+}
+
+// ResolvedOperationProfilesDir returns OperationProfilesDir, or the default when unset.
+func (c DaemonConfig) ResolvedOperationProfilesDir() string {
+	if c.OperationProfilesDir == "" {
+		return DefaultOperationProfilesDir
+	}
+	return c.OperationProfilesDir
+}
+
+// ResolvedCommandAuditRetention maps CommandAuditRetentionDays to a duration;
+// 0 lets the recorder apply its own default.
+func (c DaemonConfig) ResolvedCommandAuditRetention() time.Duration {
+	if c.CommandAuditRetentionDays <= 0 {
+		return 0
+	}
+	return time.Duration(c.CommandAuditRetentionDays) * 24 * time.Hour
+}
+
+// ResolvedCreditReservationTTL maps CreditReservationTTLSeconds to a duration;
+// 0 lets the reservation service apply its own default.
+func (c DaemonConfig) ResolvedCreditReservationTTL() time.Duration {
+	if c.CreditReservationTTLSeconds <= 0 {
+		return 0
+	}
+	return time.Duration(c.CreditReservationTTLSeconds) * time.Second
+}
+
+// ResolvedDriftRouteMaxTime maps DriftRouteMaxSeconds to a duration: the
+// default when unset, 0 when the gate is off.
+func (c DaemonConfig) ResolvedDriftRouteMaxTime() time.Duration {
+	switch {
+	case c.DriftRouteMaxSeconds < 0:
+		return 0
+	case c.DriftRouteMaxSeconds == 0:
+		return DefaultDriftRouteMaxTime
+	}
+	return time.Duration(c.DriftRouteMaxSeconds) * time.Second
+}
+
+// ResolvedFuelReservePercent returns FuelReservePercent: 0 when the reserve is
+// off (unset or negative), at most navigation.MaxFuelReservePercent.
+func (c DaemonConfig) ResolvedFuelReservePercent() int {
+	return min(max(c.FuelReservePercent, 0), navigation.MaxFuelReservePercent)
+}
+
+// ResolvedWaypointBlacklistFailures returns WaypointBlacklistFailures: the
+// default when unset, 0 when automatic blacklisting is off.
+func (c DaemonConfig) ResolvedWaypointBlacklistFailures() int {
+	switch {
+	case c.WaypointBlacklistFailures < 0:
+		return 0
+	case c.WaypointBlacklistFailures == 0:
+		return DefaultWaypointBlacklistFailures
+	}
+	return c.WaypointBlacklistFailures
+}
+
+// ResolvedWaypointBlacklistTTL maps WaypointBlacklistTTLSeconds to a duration:
+// the default when unset, 0 (until cleared) when negative.
+func (c DaemonConfig) ResolvedWaypointBlacklistTTL() time.Duration {
+	switch {
+	case c.WaypointBlacklistTTLSeconds < 0:
+		return 0
+	case c.WaypointBlacklistTTLSeconds == 0:
+		return DefaultWaypointBlacklistTTL
+	}
+	return time.Duration(c.WaypointBlacklistTTLSeconds) * time.Second
+}
+
+// APIRetryPolicySettings is one endpoint class's entry in
+// DaemonConfig.APIRetryPolicies.
+type APIRetryPolicySettings struct {
+	// MaxRetries is how many times an ambiguous failure is re-sent; 0 = never.
+	// Unset keeps the class default.
+	MaxRetries *int `mapstructure:"max_retries"`
+
+	// BackoffBaseMs is the exponential-backoff base in milliseconds; 0/unset
+	// keeps the client-wide base.
+	BackoffBaseMs int `mapstructure:"backoff_base_ms"`
 }
 
 // RestartPolicyConfig holds container restart policy configuration
@@ -0,0 +1,27 @@
+package config
+
+import "time"
+
+// DefaultDailySummaryInterval is how often the daemon logs the operations
+// digest when [daily_summary] leaves the cadence unset.
+const DefaultDailySummaryInterval = 24 * time.Hour
+
+// DailySummaryConfig holds the scheduled operations digest under the
+// [daily_summary] section. The GetDailySummaryQuery is always registered; this
+// only controls whether the daemon also logs it on a timer. Off until enabled.
+type DailySummaryConfig struct {
+	Enabled bool `mapstructure:"enabled"`
+
+	// IntervalHours is the wait between logged digests. 0/absent =>
+	// DefaultDailySummaryInterval (24h).
+	IntervalHours int `mapstructure:"interval_hours"`
+}
+
+// ResolvedInterval maps IntervalHours to a duration, applying the default for
+// an unset/non-positive knob.
+func (c DailySummaryConfig) ResolvedInterval() time.Duration {
+	if c.IntervalHours <= 0 {
+		return DefaultDailySummaryInterval
+	}
+	return time.Duration(c.IntervalHours) * time.Hour
+}
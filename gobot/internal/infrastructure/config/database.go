@@ -22,8 +22,14 @@ type DatabaseConfig struct {
 	// SQLite connection field
 	Path string `mapstructure:"path"`
 
-	// Connection pool settings
+	// Connection pool settings (PostgreSQL; SQLite sizes its pool from SQLite.MaxOpen)
 	Pool PoolConfig `mapstructure:"pool"`
+
+	// SQLite engine tuning (ignored for postgres)
+	SQLite SQLiteConfig `mapstructure:"sqlite"`
+
+	// Retry policy for writes that fail because another connection holds the lock
+	BusyRetry BusyRetryConfig `mapstructure:"busy_retry"`
 }
 
 // PoolConfig holds connection pool configuration
@@ -32,3 +38,29 @@ type PoolConfig struct {
 	MaxIdle     int           `mapstructure:"max_idle" validate:"min=1"`
 	MaxLifetime time.Duration `mapstructure:"max_lifetime"`
 }
+
+// SQLiteConfig tunes the SQLite engine for a daemon and CLI sharing one file.
+// Every setting is applied per connection through the DSN, so it holds however
+// many connections the pool opens.
+type SQLiteConfig struct {
+	// JournalMode is the journal_mode pragma. WAL lets readers proceed while a
+	// writer holds the lock. Ignored for ":memory:" databases.
+	JournalMode string `mapstructure:"journal_mode" validate:"omitempty,oneof=WAL DELETE TRUNCATE PERSIST MEMORY OFF"`
+
+	// Synchronous is the synchronous pragma. NORMAL is durable under WAL.
+	Synchronous string `mapstructure:"synchronous" validate:"omitempty,oneof=OFF NORMAL FULL EXTRA"`
+
+	// BusyTimeout is how long a connection waits on a locked database before
+	// the engine reports SQLITE_BUSY.
+	BusyTimeout time.Duration `mapstructure:"busy_timeout" validate:"min=0"`
+
+	// MaxOpen caps open connections. Forced to 1 for ":memory:" databases.
+	MaxOpen int `mapstructure:"max_open" validate:"min=0"`
+}
+
+// BusyRetryConfig retries statements that still fail with a busy/locked error
+// once the engine's own busy timeout has run out.
+type BusyRetryConfig struct {
+	MaxAttempts int           `mapstructure:"max_attempts" validate:"min=0"`
+	Backoff     time.Duration `mapstructure:"backoff" validate:"min=0"`
+}
@@ -16,7 +16,8 @@ func SetDefaults(cfg *Config) {
 	setBootstrapDefaults(cfg)
 }
 
-// setDatabaseDefaults fills unset database connection and pool fields.
+// setDatabaseDefaults fills unset database connection, pool, SQLite tuning and
+// busy-retry fields.
 func setDatabaseDefaults(cfg *Config) {
 	// Database defaults
 	if cfg.Database.Type == "" {
@@ -46,6 +47,24 @@ func setDatabaseDefaults(cfg *Config) {
 	if cfg.Database.Pool.MaxLifetime == 0 {
 		cfg.Database.Pool.MaxLifetime = 5 * time.Minute
 	}
+	if cfg.Database.SQLite.JournalMode == "" {
+		cfg.Database.SQLite.JournalMode = "WAL"
+	}
+	if cfg.Database.SQLite.Synchronous == "" {
+		cfg.Database.SQLite.Synchronous = "NORMAL"
+	}
+	if cfg.Database.SQLite.BusyTimeout == 0 {
+		cfg.Database.SQLite.BusyTimeout = 5 * time.Second
+	}
+	if cfg.Database.SQLite.MaxOpen == 0 {
+		cfg.Database.SQLite.MaxOpen = 1
+	}
+	if cfg.Database.BusyRetry.MaxAttempts == 0 {
+		cfg.Database.BusyRetry.MaxAttempts = 5
+	}
+	if cfg.Database.BusyRetry.Backoff == 0 {
+		cfg.Database.BusyRetry.Backoff = 50 * time.Millisecond
+	}
 }
 
 // setAPIDefaults fills unset API base URL, timeout, rate-limit, and retry fields.
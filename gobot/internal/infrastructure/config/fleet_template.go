@@ -0,0 +1,74 @@
+package config
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/andrescamacho/spacetraders-go/internal/domain/container"
+)
+
+// DefaultFleetTemplatesDir is where fleet profiles live when
+// [daemon] fleet_templates_dir is unset, relative to the daemon's working directory.
+const DefaultFleetTemplatesDir = "configs/fleet-templates"
+
+// FleetTemplateStore loads fleet profiles (see container.FleetTemplate) from
+// YAML files in Dir.
+type FleetTemplateStore struct {
+	Dir string
+}
+
+// NewFleetTemplateStore creates a store reading profiles from dir.
+func NewFleetTemplateStore(dir string) *FleetTemplateStore {
+	return &FleetTemplateStore{Dir: dir}
+}
+
+// LoadFleetTemplate reads and validates a profile. A bare name resolves to
+// <Dir>/<name>.yaml; anything that looks like a path (a separator or a .yaml/.yml
+// suffix) is read as given. Unknown keys are rejected so a misspelt knob fails
+// loudly instead of silently falling back to a default.
+func (s *FleetTemplateStore) LoadFleetTemplate(profile string) (*container.FleetTemplate, error) {
+	path := s.resolve(profile)
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read fleet template %q: %w", profile, err)
+	}
+	return ParseFleetTemplate(data, strings.TrimSuffix(filepath.Base(path), filepath.Ext(path)))
+}
+
+func (s *FleetTemplateStore) resolve(profile string) string {
+	return resolveProfilePath(s.Dir, profile)
+}
+
+// resolveProfilePath maps a bare profile name to <dir>/<name>.yaml and leaves
+// anything that looks like a path as given.
+func resolveProfilePath(dir, profile string) string {
+	if strings.ContainsRune(profile, filepath.Separator) ||
+		strings.HasSuffix(profile, ".yaml") || strings.HasSuffix(profile, ".yml") {
+		return profile
+	}
+	return filepath.Join(dir, profile+".yaml")
+}
+
+// ParseFleetTemplate decodes and validates one YAML profile. defaultName names a
+// profile that does not set its own name.
+func ParseFleetTemplate(data []byte, defaultName string) (*container.FleetTemplate, error) {
+	dec := yaml.NewDecoder(bytes.NewReader(data))
+	dec.KnownFields(true)
+
+	var tmpl container.FleetTemplate
+	if err := dec.Decode(&tmpl); err != nil {
+		return nil, fmt.Errorf("invalid fleet template %q: %w", defaultName, err)
+	}
+	if tmpl.Name == "" {
+		tmpl.Name = defaultName
+	}
+	if err := tmpl.Validate(); err != nil {
+		return nil, err
+	}
+	return &tmpl, nil
+}
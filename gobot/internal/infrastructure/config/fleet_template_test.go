@@ -0,0 +1,50 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+// The shipped standard profile must load and order cleanly.
+func TestFleetTemplateStore_LoadsShippedStandardProfile(t *testing.T) {
+	store := NewFleetTemplateStore(filepath.Join("..", "..", "..", DefaultFleetTemplatesDir))
+
+	tmpl, err := store.LoadFleetTemplate("standard")
+	if err != nil {
+		t.Fatalf("LoadFleetTemplate: %v", err)
+	}
+	if tmpl.Name != "standard" || len(tmpl.Ships) == 0 || len(tmpl.Containers) == 0 {
+		t.Fatalf("unexpected template: %+v", tmpl)
+	}
+	if _, err := tmpl.LaunchOrder(); err != nil {
+		t.Fatalf("LaunchOrder: %v", err)
+	}
+}
+
+// A profile path is read as given and named after its file when it sets no name.
+func TestFleetTemplateStore_LoadsPathAndDefaultsName(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "scouts-only.yaml")
+	data := []byte("ships:\n  - ship_type: SHIP_PROBE\n    quantity: 2\ncontainers:\n  - name: scouts\n    command_type: scout_post_coordinator\n    config:\n      tick_interval_secs: 120\n")
+	if err := os.WriteFile(path, data, 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	tmpl, err := NewFleetTemplateStore("unused").LoadFleetTemplate(path)
+	if err != nil {
+		t.Fatalf("LoadFleetTemplate: %v", err)
+	}
+	if tmpl.Name != "scouts-only" {
+		t.Fatalf("name = %q, want scouts-only", tmpl.Name)
+	}
+	if got := tmpl.Containers[0].Config["tick_interval_secs"]; got != 120 {
+		t.Fatalf("tick_interval_secs = %v (%T), want 120", got, got)
+	}
+}
+
+// A misspelt key fails the load instead of being ignored.
+func TestParseFleetTemplate_RejectsUnknownKeys(t *testing.T) {
+	if _, err := ParseFleetTemplate([]byte("name: x\nship:\n  - ship_type: SHIP_PROBE\n"), "x"); err == nil {
+		t.Fatal("an unknown top-level key must be rejected")
+	}
+}
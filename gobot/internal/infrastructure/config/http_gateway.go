@@ -0,0 +1,35 @@
+package config
+
+import "fmt"
+
+// DefaultHTTPGatewayPort is the gateway's port when [http_gateway] leaves it unset.
+const DefaultHTTPGatewayPort = 9091
+
+// HTTPGatewayConfig holds the read-only HTTP/JSON gateway under [http_gateway].
+// The gateway is off until enabled, and refuses to start without a token.
+type HTTPGatewayConfig struct {
+	Enabled bool `mapstructure:"enabled"`
+
+	// Host to bind (default: localhost, so exposing it is a deliberate choice)
+	Host string `mapstructure:"host"`
+
+	// Port to bind (default: DefaultHTTPGatewayPort)
+	Port int `mapstructure:"port" validate:"omitempty,min=1024,max=65535"`
+
+	// Token every request must present as "Authorization: Bearer <token>".
+	// Prefer ST_HTTP_GATEWAY_TOKEN over writing it into config.yaml.
+	Token string `mapstructure:"token"`
+}
+
+// Address returns the host:port to listen on, applying the defaults.
+func (c HTTPGatewayConfig) Address() string {
+	host := c.Host
+	if host == "" {
+		host = "localhost"
+	}
+	port := c.Port
+	if port == 0 {
+		port = DefaultHTTPGatewayPort
+	}
+	return fmt.Sprintf("%s:%d", host, port)
+}
@@ -0,0 +1,58 @@
+package config
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/andrescamacho/spacetraders-go/internal/domain/shipyard"
+)
+
+// DefaultLoadoutPresetsDir is where loadout presets live when
+// [daemon] loadout_presets_dir is unset, relative to the daemon's working directory.
+const DefaultLoadoutPresetsDir = "configs/loadout-presets"
+
+// LoadoutPresetStore loads post-purchase loadout presets (see
+// shipyard.LoadoutPreset) from YAML files in Dir.
+type LoadoutPresetStore struct {
+	Dir string
+}
+
+// NewLoadoutPresetStore creates a store reading presets from dir.
+func NewLoadoutPresetStore(dir string) *LoadoutPresetStore {
+	return &LoadoutPresetStore{Dir: dir}
+}
+
+// LoadLoadoutPreset reads and validates a preset, resolving names the same way
+// fleet templates are resolved. Unknown keys are rejected.
+func (s *LoadoutPresetStore) LoadLoadoutPreset(name string) (*shipyard.LoadoutPreset, error) {
+	path := resolveProfilePath(s.Dir, name)
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read loadout preset %q: %w", name, err)
+	}
+	return ParseLoadoutPreset(data, strings.TrimSuffix(filepath.Base(path), filepath.Ext(path)))
+}
+
+// ParseLoadoutPreset decodes and validates one YAML preset. defaultName names a
+// preset that does not set its own name.
+func ParseLoadoutPreset(data []byte, defaultName string) (*shipyard.LoadoutPreset, error) {
+	dec := yaml.NewDecoder(bytes.NewReader(data))
+	dec.KnownFields(true)
+
+	var preset shipyard.LoadoutPreset
+	if err := dec.Decode(&preset); err != nil {
+		return nil, fmt.Errorf("invalid loadout preset %q: %w", defaultName, err)
+	}
+	if preset.Name == "" {
+		preset.Name = defaultName
+	}
+	if err := preset.Validate(); err != nil {
+		return nil, err
+	}
+	return &preset, nil
+}
@@ -0,0 +1,38 @@
+package config
+
+import (
+	"path/filepath"
+	"testing"
+)
+
+// The shipped trade-hauler preset must load and validate.
+func TestLoadoutPresetStore_LoadsShippedPreset(t *testing.T) {
+	store := NewLoadoutPresetStore(filepath.Join("..", "..", "..", DefaultLoadoutPresetsDir))
+
+	preset, err := store.LoadLoadoutPreset("trade-hauler")
+	if err != nil {
+		t.Fatalf("LoadLoadoutPreset: %v", err)
+	}
+	if preset.Name != "trade-hauler" || preset.Fleet != "trade" || preset.FlightMode != "CRUISE" {
+		t.Fatalf("unexpected preset: %+v", preset)
+	}
+}
+
+// A preset without a name takes its file name; a misspelt key or an unknown
+// flight mode fails the load.
+func TestParseLoadoutPreset_DefaultsNameAndRejectsBadInput(t *testing.T) {
+	preset, err := ParseLoadoutPreset([]byte("mounts:\n  - MOUNT_SURVEYOR_I\nhome_waypoint: X1-A1\n"), "surveyor")
+	if err != nil {
+		t.Fatalf("ParseLoadoutPreset: %v", err)
+	}
+	if preset.Name != "surveyor" || len(preset.Mounts) != 1 || preset.HomeWaypoint != "X1-A1" {
+		t.Fatalf("unexpected preset: %+v", preset)
+	}
+
+	if _, err := ParseLoadoutPreset([]byte("mount:\n  - MOUNT_SURVEYOR_I\n"), "x"); err == nil {
+		t.Fatal("an unknown key must be rejected")
+	}
+	if _, err := ParseLoadoutPreset([]byte("flight_mode: WARP\n"), "x"); err == nil {
+		t.Fatal("an unknown flight mode must be rejected")
+	}
+}
@@ -192,6 +192,26 @@ type ManufacturingConfig struct {
 	// editing config.yaml and restarting (sp-ts82 / RULINGS #5).
 	ConstructionSupplyTaskTimeoutSeconds int `mapstructure:"construction_supply_task_timeout_seconds"`
 
+	// TaskWatchdogMinStuckMinutes / TaskWatchdogRouteMultiplier tune the stuck-task watchdog the
+	// construction drain runs each tick: a task left in ASSIGNED/EXECUTING longer than its
+	// estimated route time x multiplier (never less than the floor) is reclaimed — ship released,
+	// task back to READY, retry counted, DEAD_LETTER once retries are spent. 0/absent → 30m floor
+	// (the drain's own per-task timeout) and 3x. Wired at daemon boot.
+	TaskWatchdogMinStuckMinutes int     `mapstructure:"task_watchdog_min_stuck_minutes"`
+	TaskWatchdogRouteMultiplier float64 `mapstructure:"task_watchdog_route_multiplier"`
+
+	// TaskWatchdogDisabled is the off-switch for the stuck-task watchdog (RULINGS #5): true leaves
+	// in-flight tasks alone however long they sit. It only reclaims tasks no live worker is still
+	// running, so it is ON by default.
+	TaskWatchdogDisabled bool `mapstructure:"task_watchdog_disabled"`
+
+	// TaskQueueCapacity / TaskQueuePipelineQuota bound each player's manufacturing task queue:
+	// at most Capacity READY tasks in all, at most PipelineQuota from one pipeline. A full queue
+	// admits a new task only by evicting a lower-priority one; every rejection is counted and
+	// visible through GetTaskQueueStatsQuery. 0/absent → 200 and 25.
+	TaskQueueCapacity      int `mapstructure:"task_queue_capacity"`
+	TaskQueuePipelineQuota int `mapstructure:"task_queue_pipeline_quota"`
+
 	// Siting nests the factory SITING coordinator's knobs (sp-vdld) under
 	// [manufacturing.siting] — the standing brain that scans/scores/sizes/launches
 	// factory chains. Injected into the siting_coordinator container's launch config
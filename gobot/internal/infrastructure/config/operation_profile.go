@@ -0,0 +1,62 @@
+package config
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/andrescamacho/spacetraders-go/internal/domain/container"
+)
+
+// DefaultOperationProfilesDir is where operation profiles live when
+// [daemon] operation_profiles_dir is unset, relative to the daemon's working directory.
+const DefaultOperationProfilesDir = "configs/operation-profiles"
+
+// OperationProfileStore loads operation profiles (see container.OperationProfile)
+// from YAML files in Dir.
+type OperationProfileStore struct {
+	Dir string
+}
+
+// NewOperationProfileStore creates a store reading profiles from dir.
+func NewOperationProfileStore(dir string) *OperationProfileStore {
+	return &OperationProfileStore{Dir: dir}
+}
+
+// LoadOperationProfile reads and validates a profile, resolving names the same
+// way fleet templates are resolved. Unknown keys are rejected.
+func (s *OperationProfileStore) LoadOperationProfile(profile string) (*container.OperationProfile, error) {
+	path := resolveProfilePath(s.Dir, profile)
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read operation profile %q: %w", profile, err)
+	}
+	return ParseOperationProfile(data, strings.TrimSuffix(filepath.Base(path), filepath.Ext(path)))
+}
+
+// ParseOperationProfile decodes and validates one YAML profile and records the
+// digest of its bytes. defaultName names a profile that does not set its own name.
+func ParseOperationProfile(data []byte, defaultName string) (*container.OperationProfile, error) {
+	dec := yaml.NewDecoder(bytes.NewReader(data))
+	dec.KnownFields(true)
+
+	var profile container.OperationProfile
+	if err := dec.Decode(&profile); err != nil {
+		return nil, fmt.Errorf("invalid operation profile %q: %w", defaultName, err)
+	}
+	if profile.Name == "" {
+		profile.Name = defaultName
+	}
+	if err := profile.Validate(); err != nil {
+		return nil, err
+	}
+	sum := sha256.Sum256(data)
+	profile.Digest = hex.EncodeToString(sum[:])[:12]
+	return &profile, nil
+}
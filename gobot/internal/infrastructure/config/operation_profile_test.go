@@ -0,0 +1,47 @@
+package config
+
+import (
+	"path/filepath"
+	"testing"
+)
+
+// The shipped example profile must load with its version and a digest.
+func TestOperationProfileStore_LoadsShippedProfile(t *testing.T) {
+	store := NewOperationProfileStore(filepath.Join("..", "..", "..", DefaultOperationProfilesDir))
+
+	profile, err := store.LoadOperationProfile("tour-haulers")
+	if err != nil {
+		t.Fatalf("LoadOperationProfile: %v", err)
+	}
+	if profile.Name != "tour-haulers" || profile.Version != 1 || profile.Operation != "tour_run" || len(profile.Ships) == 0 {
+		t.Fatalf("unexpected profile: %+v", profile)
+	}
+	if len(profile.Digest) != 12 {
+		t.Fatalf("digest = %q, want 12 hex characters", profile.Digest)
+	}
+}
+
+// An edit without a version bump still changes the digest.
+func TestParseOperationProfile_DigestTracksContent(t *testing.T) {
+	a, err := ParseOperationProfile([]byte("version: 2\noperation: tour_run\nships: [A-1]\nparams:\n  min_margin: 8\n"), "arb")
+	if err != nil {
+		t.Fatalf("parse: %v", err)
+	}
+	b, err := ParseOperationProfile([]byte("version: 2\noperation: tour_run\nships: [A-1]\nparams:\n  min_margin: 12\n"), "arb")
+	if err != nil {
+		t.Fatalf("parse: %v", err)
+	}
+	if a.Name != "arb" || a.Digest == b.Digest {
+		t.Fatalf("name %q, digests %q / %q: want default name and distinct digests", a.Name, a.Digest, b.Digest)
+	}
+}
+
+// A misspelt top-level key or a missing version fails the load.
+func TestParseOperationProfile_RejectsUnknownKeysAndMissingVersion(t *testing.T) {
+	if _, err := ParseOperationProfile([]byte("version: 1\noperation: tour_run\nship: [A-1]\n"), "x"); err == nil {
+		t.Fatal("an unknown top-level key must be rejected")
+	}
+	if _, err := ParseOperationProfile([]byte("operation: tour_run\nships: [A-1]\n"), "x"); err == nil {
+		t.Fatal("a profile without a version must be rejected")
+	}
+}
@@ -0,0 +1,157 @@
+package config
+
+import (
+	"fmt"
+	"os"
+	"reflect"
+	"sort"
+	"sync"
+	"time"
+)
+
+// DefaultReloadCheckInterval is how often the daemon stats the config file for
+// a change when [daemon] leaves config_reload_check_seconds unset.
+const DefaultReloadCheckInterval = 10 * time.Second
+
+// ReloadFunc applies a newly loaded, already validated config to one running
+// component. prev is the config it replaces. A returned error means the
+// component kept its previous settings; it does not stop the other subscribers.
+type ReloadFunc func(prev, next *Config) error
+
+type reloadSubscriber struct {
+	name  string
+	apply ReloadFunc
+}
+
+// ReloadResult reports one reload: which top-level sections changed, which
+// subscribers applied them live, and which refused. Changes no subscriber
+// consumes (database, routing address, gRPC address, ...) take effect at the
+// next restart.
+type ReloadResult struct {
+	Changed []string
+	Applied []string
+	Failed  map[string]error
+}
+
+// Reloader re-reads the daemon's config file on demand and hands every valid
+// new config to its subscribers, so knobs that a running component can adopt
+// in place do not need a daemon restart. A file that fails to load or validate
+// is rejected whole and the running config stays in force.
+type Reloader struct {
+	mu          sync.Mutex
+	path        string
+	current     *Config
+	modTime     time.Time
+	subscribers []reloadSubscriber
+
+	load func(path string) (*Config, error)
+}
+
+// NewReloader watches current.SourceFile. A config that booted from env vars
+// alone has no file to re-read; its reloader is inert (Enabled reports false).
+func NewReloader(current *Config) *Reloader {
+	r := &Reloader{
+		path:    current.SourceFile,
+		current: current,
+		load:    LoadConfig,
+	}
+	if info, err := os.Stat(r.path); r.path != "" && err == nil {
+		r.modTime = info.ModTime()
+	}
+	return r
+}
+
+// Enabled reports whether there is a config file to reload from.
+func (r *Reloader) Enabled() bool {
+	return r.path != ""
+}
+
+// Path returns the config file being reloaded.
+func (r *Reloader) Path() string {
+	return r.path
+}
+
+// Subscribe registers apply under name. Subscribers run in registration order
+// on every successful reload. Must be called before reloads start.
+func (r *Reloader) Subscribe(name string, apply ReloadFunc) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.subscribers = append(r.subscribers, reloadSubscriber{name: name, apply: apply})
+}
+
+// Current returns the config most recently loaded.
+func (r *Reloader) Current() *Config {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.current
+}
+
+// ReloadIfChanged reloads when the file's modification time moved since the
+// last load. It returns a nil result when nothing changed.
+func (r *Reloader) ReloadIfChanged() (*ReloadResult, error) {
+	if !r.Enabled() {
+		return nil, nil
+	}
+	info, err := os.Stat(r.path)
+	if err != nil {
+		return nil, fmt.Errorf("stat config file %s: %w", r.path, err)
+	}
+	r.mu.Lock()
+	unchanged := info.ModTime().Equal(r.modTime)
+	r.mu.Unlock()
+	if unchanged {
+		return nil, nil
+	}
+	return r.Reload()
+}
+
+// Reload loads and validates the config file and, when it is valid, passes it
+// to every subscriber. An invalid file leaves the running config in force and
+// is not retried until it changes again.
+func (r *Reloader) Reload() (*ReloadResult, error) {
+	if !r.Enabled() {
+		return nil, fmt.Errorf("no config file to reload: the daemon booted from environment variables")
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if info, err := os.Stat(r.path); err == nil {
+		r.modTime = info.ModTime()
+	}
+	next, err := r.load(r.path)
+	if err != nil {
+		return nil, fmt.Errorf("rejected config reload from %s: %w", r.path, err)
+	}
+
+	prev := r.current
+	result := &ReloadResult{Changed: changedSections(prev, next), Failed: map[string]error{}}
+	for _, sub := range r.subscribers {
+		if err := sub.apply(prev, next); err != nil {
+			result.Failed[sub.name] = err
+			continue
+		}
+		result.Applied = append(result.Applied, sub.name)
+	}
+	r.current = next
+	return result, nil
+}
+
+// changedSections names the top-level config sections (by their config-file
+// key) whose values differ between prev and next.
+func changedSections(prev, next *Config) []string {
+	var changed []string
+	pv, nv := reflect.ValueOf(*prev), reflect.ValueOf(*next)
+	t := pv.Type()
+	for i := 0; i < t.NumField(); i++ {
+		key := t.Field(i).Tag.Get("mapstructure")
+		if key == "" || key == "-" {
+			continue
+		}
+		if !reflect.DeepEqual(pv.Field(i).Interface(), nv.Field(i).Interface()) {
+			changed = append(changed, key)
+		}
+	}
+	sort.Strings(changed)
+	return changed
+}
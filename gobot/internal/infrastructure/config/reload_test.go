@@ -0,0 +1,111 @@
+package config
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+// writeReloadFixture writes body to path and stamps it with modTime, so tests do
+// not depend on the filesystem's mtime granularity.
+func writeReloadFixture(t *testing.T, path, body string, modTime time.Time) {
+	t.Helper()
+	require.NoError(t, os.WriteFile(path, []byte(body), 0o644))
+	require.NoError(t, os.Chtimes(path, modTime, modTime))
+}
+
+func newTestReloader(t *testing.T, body string) (*Reloader, string) {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "config.yaml")
+	writeReloadFixture(t, path, body, time.Unix(1_700_000_000, 0))
+	cfg, err := LoadConfig(path)
+	require.NoError(t, err)
+	require.Equal(t, path, cfg.SourceFile)
+	return NewReloader(cfg), path
+}
+
+func TestReloaderPassesPrevAndNextToSubscribers(t *testing.T) {
+	r, path := newTestReloader(t, "daemon:\n  agent_cache_ttl_seconds: 5\n")
+
+	var gotPrev, gotNext int
+	r.Subscribe("api-client", func(prev, next *Config) error {
+		gotPrev, gotNext = prev.Daemon.AgentCacheTTLSeconds, next.Daemon.AgentCacheTTLSeconds
+		return nil
+	})
+
+	writeReloadFixture(t, path, "daemon:\n  agent_cache_ttl_seconds: 30\n", time.Unix(1_700_000_100, 0))
+	result, err := r.ReloadIfChanged()
+	require.NoError(t, err)
+	require.NotNil(t, result)
+
+	require.Equal(t, 5, gotPrev)
+	require.Equal(t, 30, gotNext)
+	require.Equal(t, []string{"daemon"}, result.Changed)
+	require.Equal(t, []string{"api-client"}, result.Applied)
+	require.Equal(t, 30, r.Current().Daemon.AgentCacheTTLSeconds)
+}
+
+func TestReloaderSkipsUnchangedFile(t *testing.T) {
+	r, _ := newTestReloader(t, "daemon:\n  agent_cache_ttl_seconds: 5\n")
+	calls := 0
+	r.Subscribe("api-client", func(prev, next *Config) error {
+		calls++
+		return nil
+	})
+
+	result, err := r.ReloadIfChanged()
+	require.NoError(t, err)
+	require.Nil(t, result)
+	require.Zero(t, calls)
+}
+
+func TestReloaderRejectsInvalidConfig(t *testing.T) {
+	r, path := newTestReloader(t, "daemon:\n  agent_cache_ttl_seconds: 5\n")
+	booted := r.Current()
+	calls := 0
+	r.Subscribe("api-client", func(prev, next *Config) error {
+		calls++
+		return nil
+	})
+
+	writeReloadFixture(t, path, "daemon: [not, a, map\n", time.Unix(1_700_000_100, 0))
+	_, err := r.ReloadIfChanged()
+	require.Error(t, err)
+	require.Same(t, booted, r.Current())
+	require.Zero(t, calls)
+
+	// The broken file is not re-read on every tick; only a new edit retries.
+	result, err := r.ReloadIfChanged()
+	require.NoError(t, err)
+	require.Nil(t, result)
+}
+
+func TestReloaderReportsFailedSubscriberWithoutStoppingOthers(t *testing.T) {
+	r, path := newTestReloader(t, "metrics:\n  port: 9090\n")
+	r.Subscribe("metrics-server", func(prev, next *Config) error {
+		return errors.New("port in use")
+	})
+	r.Subscribe("api-client", func(prev, next *Config) error { return nil })
+
+	writeReloadFixture(t, path, "metrics:\n  port: 9091\n", time.Unix(1_700_000_100, 0))
+	result, err := r.Reload()
+	require.NoError(t, err)
+	require.Equal(t, []string{"metrics"}, result.Changed)
+	require.Equal(t, []string{"api-client"}, result.Applied)
+	require.EqualError(t, result.Failed["metrics-server"], "port in use")
+}
+
+func TestReloaderWithoutConfigFileIsInert(t *testing.T) {
+	r := NewReloader(&Config{})
+	require.False(t, r.Enabled())
+
+	result, err := r.ReloadIfChanged()
+	require.NoError(t, err)
+	require.Nil(t, result)
+	_, err = r.Reload()
+	require.Error(t, err)
+}
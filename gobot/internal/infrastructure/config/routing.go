@@ -53,6 +53,28 @@ type RoutingConfig struct {
 	// the reversibility switch (set false to restore the pre-sp-bcsu hot path exactly). A
 	// *bool so an absent [routing] section defaults ON while an explicit false is preserved.
 	ChartGateOnArrival *bool `mapstructure:"chart_gate_on_arrival"`
+
+	// Backends is the routing backend failover order: "ortools" (the gRPC service at
+	// Address), "native" (in-process Dijkstra and nearest-neighbour tours) and "greedy"
+	// (direct hops). A call moves to the next backend when an attempt outlives its
+	// Timeout entry or the backend is unreachable, so a routing-service outage degrades
+	// plans instead of halting navigation. Empty => DefaultRoutingBackends.
+	Backends []string `mapstructure:"backends"`
+
+	// FailoverCooldown is how long a backend that timed out is tried last before it is
+	// trusted as primary again. Zero => one minute.
+	FailoverCooldown time.Duration `mapstructure:"failover_cooldown"`
+}
+
+// DefaultRoutingBackends is the failover order when routing.backends is unset.
+var DefaultRoutingBackends = []string{"ortools", "native", "greedy"}
+
+// ResolvedBackends returns the configured backend order, or DefaultRoutingBackends.
+func (c RoutingConfig) ResolvedBackends() []string {
+	if len(c.Backends) == 0 {
+		return DefaultRoutingBackends
+	}
+	return c.Backends
 }
 
 // GateBackoffConfig is the exponential schedule for re-probing an unreadable jump gate
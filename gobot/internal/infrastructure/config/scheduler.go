@@ -0,0 +1,49 @@
+package config
+
+import "time"
+
+// DefaultSchedulerCheckInterval is how often the daemon looks for due
+// scheduled jobs when [scheduler] leaves the cadence unset.
+const DefaultSchedulerCheckInterval = 30 * time.Second
+
+// SchedulerConfig holds the recurring job scheduler knobs under the
+// [scheduler] section. The scheduler is off until enabled; once on, it also
+// runs jobs added over the AddScheduledJob RPC.
+type SchedulerConfig struct {
+	Enabled bool `mapstructure:"enabled"`
+
+	// CheckIntervalSeconds is the wait between looks for due jobs. 0/absent
+	// => DefaultSchedulerCheckInterval (30s).
+	CheckIntervalSeconds int `mapstructure:"check_interval_seconds"`
+
+	// Jobs are upserted by name for the live player at daemon start, so
+	// editing one here redefines it while keeping its run history.
+	Jobs []ScheduledJobConfig `mapstructure:"jobs"`
+}
+
+// ScheduledJobConfig defines one recurring job
+type ScheduledJobConfig struct {
+	Name string `mapstructure:"name"`
+	// Kind is scout_markets, ledger_reconcile or market_export.
+	Kind string `mapstructure:"kind"`
+	// IntervalMinutes is the wait between runs (at least 1).
+	IntervalMinutes int `mapstructure:"interval_minutes"`
+	// Params are the kind's settings (see config.yaml.example).
+	Params map[string]string `mapstructure:"params"`
+	// Disabled keeps the definition but stops it running.
+	Disabled bool `mapstructure:"disabled"`
+}
+
+// ResolvedCheckInterval maps CheckIntervalSeconds to a duration, applying the
+// default for an unset/non-positive knob.
+func (c SchedulerConfig) ResolvedCheckInterval() time.Duration {
+	if c.CheckIntervalSeconds <= 0 {
+		return DefaultSchedulerCheckInterval
+	}
+	return time.Duration(c.CheckIntervalSeconds) * time.Second
+}
+
+// Interval maps IntervalMinutes to a duration
+func (j ScheduledJobConfig) Interval() time.Duration {
+	return time.Duration(j.IntervalMinutes) * time.Minute
+}
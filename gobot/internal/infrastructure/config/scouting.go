@@ -1,5 +1,7 @@
 package config
 
+import "time"
+
 // ScoutingConfig holds the scouting subsystem's knobs (sp-x8i5). The daemon injects
 // these into scout_tour and scout_post_coordinator launch configs on every build —
 // creation AND restart recovery, via resolveScoutingConfig — so a captain retunes the
@@ -96,4 +98,46 @@ type ScoutingConfig struct {
 	// without a redeploy. RULINGS #5 disable escape, mirroring coverage_spread_disabled /
 	// respawn_cap_disabled (bool ⇒ liveconfig-only; the tune registry is int-typed).
 	GateReconcileMarketlessDisabled bool `mapstructure:"gate_reconcile_marketless_disabled"`
+
+	// MarketRefreshLeadPercent is the share of a scout-all-markets --freshness-sla at which
+	// the market refresh scheduler treats a market as due, so its tour lands before the SLA
+	// is breached rather than after. 0/absent => 75.
+	MarketRefreshLeadPercent int `mapstructure:"market_refresh_lead_percent"`
+
+	// MarketRefreshMaxMarkets caps how many markets one refresh pass tours; the stalest,
+	// highest-value markets go first and the rest wait for the next pass. 0/absent => 12.
+	MarketRefreshMaxMarkets int `mapstructure:"market_refresh_max_markets"`
+
+	// MarketRefreshUsageWindowHours is how far back arbitrage and manufacturing trades count
+	// towards a market's refresh importance. 0/absent => 24h.
+	MarketRefreshUsageWindowHours int `mapstructure:"market_refresh_usage_window_hours"`
+
+	// MarketRefreshPassIntervalSecs fixes the wait between refresh passes. 0/absent => derived
+	// from the SLA as the gap between a market falling due and breaching (SLA × (100-lead)%),
+	// floored at one minute.
+	MarketRefreshPassIntervalSecs int `mapstructure:"market_refresh_pass_interval_secs"`
+
+	// DockScanMaxAgeSecs is the age past which a ship docking at a marketplace
+	// refreshes its market data on the way in, so trade docks keep prices current
+	// without a scout visiting. 0/absent => 600 (10 min).
+	DockScanMaxAgeSecs int `mapstructure:"dock_scan_max_age_secs"`
+
+	// DockScanDisabled turns the post-dock market scan off. false/absent => on.
+	DockScanDisabled bool `mapstructure:"dock_scan_disabled"`
+}
+
+// defaultDockScanMaxAgeSecs is the post-dock market scan's freshness threshold
+// when DockScanMaxAgeSecs is unset.
+const defaultDockScanMaxAgeSecs = 600
+
+// ResolvedDockScanMaxAge returns the post-dock market scan's freshness threshold,
+// or 0 when the scan is disabled.
+func (c ScoutingConfig) ResolvedDockScanMaxAge() time.Duration {
+	if c.DockScanDisabled {
+		return 0
+	}
+	if c.DockScanMaxAgeSecs > 0 {
+		return time.Duration(c.DockScanMaxAgeSecs) * time.Second
+	}
+	return defaultDockScanMaxAgeSecs * time.Second
 }
@@ -0,0 +1,25 @@
+package config
+
+// DefaultRepairThreshold is the component condition below which a coordinator
+// sends its hull for a shipyard repair when [ship_maintenance] leaves it unset.
+const DefaultRepairThreshold = 0.5
+
+// ShipMaintenanceConfig holds the ship repair knobs under the
+// [ship_maintenance] section. Repairs are off until enabled.
+type ShipMaintenanceConfig struct {
+	Enabled bool `mapstructure:"enabled"`
+
+	// RepairThreshold is the frame/reactor/engine condition (0-1) below which a
+	// hull is repaired at the nearest shipyard at its next safe stop. 0/absent
+	// => DefaultRepairThreshold (0.5).
+	RepairThreshold float64 `mapstructure:"repair_threshold"`
+}
+
+// ResolvedRepairThreshold returns RepairThreshold, applying the default for an
+// unset/non-positive knob and capping it at 1.
+func (c ShipMaintenanceConfig) ResolvedRepairThreshold() float64 {
+	if c.RepairThreshold <= 0 {
+		return DefaultRepairThreshold
+	}
+	return min(c.RepairThreshold, 1)
+}
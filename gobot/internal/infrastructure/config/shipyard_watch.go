@@ -0,0 +1,65 @@
+package config
+
+import "time"
+
+// DefaultShipyardWatchInterval is how often the daemon reads the watched
+// shipyards when [shipyard_watch] leaves the cadence unset.
+const DefaultShipyardWatchInterval = 5 * time.Minute
+
+// DefaultShipyardWatchDemandMaxAge is how old the autosizer's demand measurement
+// may be before an automatic purchase is refused: two default autosizer ticks.
+const DefaultShipyardWatchDemandMaxAge = 30 * time.Minute
+
+// ShipyardWatchConfig holds the shipyard watcher knobs under the
+// [shipyard_watch] section. The watcher is off until enabled.
+type ShipyardWatchConfig struct {
+	Enabled bool `mapstructure:"enabled"`
+
+	// IntervalSeconds is the wait between reads of the watched yards. Each read
+	// costs one API call per yard. 0/absent => DefaultShipyardWatchInterval (5min).
+	IntervalSeconds int `mapstructure:"interval_seconds"`
+
+	// DemandMaxAgeSeconds bounds how stale the autosizer's demand measurement
+	// may be when an automatic purchase relies on it. 0/absent =>
+	// DefaultShipyardWatchDemandMaxAge (30min).
+	DemandMaxAgeSeconds int `mapstructure:"demand_max_age_seconds"`
+
+	// Targets are the (shipyard, ship type) pairs to watch.
+	Targets []ShipyardWatchTargetConfig `mapstructure:"targets"`
+}
+
+// ShipyardWatchTargetConfig is one ship type watched at one shipyard
+type ShipyardWatchTargetConfig struct {
+	Waypoint string `mapstructure:"waypoint"`
+	ShipType string `mapstructure:"ship_type"`
+	// MaxPrice caps an automatic purchase. Required with AutoPurchase.
+	MaxPrice int `mapstructure:"max_price"`
+	// AutoPurchase sends PurchasingShip to buy one as soon as the type is in
+	// stock at or under MaxPrice, at most once per restock, and only while the
+	// autosizer measures unmet demand for DemandClass and the price is within
+	// 25% of the live treasury.
+	AutoPurchase   bool   `mapstructure:"auto_purchase"`
+	PurchasingShip string `mapstructure:"purchasing_ship"`
+	// DemandClass is the autosizer hull class (light, heavy, warehouse,
+	// explorer, contract_delivery) the bought ship fills. Required with
+	// AutoPurchase.
+	DemandClass string `mapstructure:"demand_class"`
+}
+
+// ResolvedInterval maps IntervalSeconds to a duration, applying the default
+// for an unset/non-positive knob.
+func (c ShipyardWatchConfig) ResolvedInterval() time.Duration {
+	if c.IntervalSeconds <= 0 {
+		return DefaultShipyardWatchInterval
+	}
+	return time.Duration(c.IntervalSeconds) * time.Second
+}
+
+// ResolvedDemandMaxAge maps DemandMaxAgeSeconds to a duration, applying the
+// default for an unset/non-positive knob.
+func (c ShipyardWatchConfig) ResolvedDemandMaxAge() time.Duration {
+	if c.DemandMaxAgeSeconds <= 0 {
+		return DefaultShipyardWatchDemandMaxAge
+	}
+	return time.Duration(c.DemandMaxAgeSeconds) * time.Second
+}
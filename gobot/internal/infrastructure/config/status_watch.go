@@ -0,0 +1,45 @@
+package config
+
+import "time"
+
+// DefaultStatusWatchInterval is how often the daemon reads the API status when
+// [status_watch] leaves the cadence unset.
+const DefaultStatusWatchInterval = 10 * time.Minute
+
+// DefaultStatusWatchWarnHours is how far ahead of an announced reset the
+// watcher starts warning when [status_watch] leaves the lead unset.
+const DefaultStatusWatchWarnHours = 24
+
+// StatusWatchConfig holds the server status watcher knobs under the
+// [status_watch] section. The watcher is off until enabled.
+type StatusWatchConfig struct {
+	Enabled bool `mapstructure:"enabled"`
+
+	// IntervalSeconds is the wait between status reads. A reset is acted on at
+	// the first read after it, so this bounds how long coordinators keep
+	// running against the old universe. 0/absent => DefaultStatusWatchInterval
+	// (10min).
+	IntervalSeconds int `mapstructure:"interval_seconds"`
+
+	// WarnHours is the lead, in hours, before an announced reset at which the
+	// watcher logs its warning. 0/absent => DefaultStatusWatchWarnHours (24).
+	WarnHours int `mapstructure:"warn_hours"`
+}
+
+// ResolvedInterval maps IntervalSeconds to a duration, applying the default
+// for an unset/non-positive knob.
+func (c StatusWatchConfig) ResolvedInterval() time.Duration {
+	if c.IntervalSeconds <= 0 {
+		return DefaultStatusWatchInterval
+	}
+	return time.Duration(c.IntervalSeconds) * time.Second
+}
+
+// ResolvedWarnWithin maps WarnHours to a duration, applying the default for an
+// unset/non-positive knob.
+func (c StatusWatchConfig) ResolvedWarnWithin() time.Duration {
+	if c.WarnHours <= 0 {
+		return DefaultStatusWatchWarnHours * time.Hour
+	}
+	return time.Duration(c.WarnHours) * time.Hour
+}
@@ -211,6 +211,17 @@ type TradeFleetConfig struct {
 	// coordinator's own default (1800s / 30 min), which lives in the consumer, not here.
 	ReapStaleCaptainReservationsEnabled bool `mapstructure:"reap_stale_captain_reservations_enabled"`
 	ReapIdleThresholdSeconds            int  `mapstructure:"reap_idle_threshold_seconds"`
+
+	// --- Dynamic fleet sizing ---
+	// The coordinator measures tour throughput (productive vs fast-fail exits, and how often
+	// every trade hull was out touring) and once per SizingIntervalMinutes pins one more idle
+	// cargo hull into the trade fleet or releases one back to the general pool, always within
+	// [MinFleetSize, MaxFleetSize]. MaxFleetSize 0/absent turns sizing OFF, so the fleet stays
+	// exactly as the captain pinned it (byte-identical). SizingIntervalMinutes 0/absent → the
+	// coordinator's own 60-min window. In minutes, like relaunch_backoff_max_minutes.
+	MinFleetSize          int `mapstructure:"min_fleet_size"`
+	MaxFleetSize          int `mapstructure:"max_fleet_size"`
+	SizingIntervalMinutes int `mapstructure:"sizing_interval_minutes"`
 }
 
 // EnabledOrDefault reports whether the coordinator is enabled, treating an unset (nil)
@@ -5,7 +5,6 @@ import (
 	"strings"
 
 	"gorm.io/driver/postgres"
-	"gorm.io/driver/sqlite"
 	"gorm.io/gorm"
 	"gorm.io/gorm/logger"
 
@@ -82,7 +81,10 @@ func NewConnection(cfg *config.DatabaseConfig) (*gorm.DB, error) {
 		if path == "" {
 			path = ":memory:"
 		}
-		dialector = sqlite.Open(path)
+		var err error
+		if dialector, err = openSQLite(path, cfg); err != nil {
+			return nil, err
+		}
 
 	default:
 		return nil, fmt.Errorf("unsupported database type: %s", cfg.Type)
@@ -95,7 +97,8 @@ func NewConnection(cfg *config.DatabaseConfig) (*gorm.DB, error) {
 		return nil, fmt.Errorf("failed to open database: %w", err)
 	}
 
-	// Configure connection pool (only for PostgreSQL)
+	// Configure the PostgreSQL connection pool. SQLite's pool is sized in
+	// openSQLite, from the sqlite tuning block.
 	if cfg.Type == "postgres" {
 		sqlDB, err := db.DB()
 		if err != nil {
@@ -107,23 +110,6 @@ func NewConnection(cfg *config.DatabaseConfig) (*gorm.DB, error) {
 		sqlDB.SetConnMaxLifetime(cfg.Pool.MaxLifetime)
 	}
 
-	// SQLite has no true concurrent-writer support (it serializes writes at the
-	// file-lock level regardless), and a bare ":memory:" DSN gives each physical
-	// connection its OWN separate, empty database unless cache=shared is set. Left
-	// at Go's default (unbounded) pool, concurrent callers can open more than one
-	// physical connection and land on one that never saw AutoMigrate, surfacing as
-	// intermittent "no such table" errors. Pinning to a single physical connection
-	// makes every caller share the one migrated connection, for :memory: and
-	// file-based SQLite alike.
-	if cfg.Type == "sqlite" {
-		sqlDB, err := db.DB()
-		if err != nil {
-			return nil, fmt.Errorf("failed to get underlying db: %w", err)
-		}
-
-		sqlDB.SetMaxOpenConns(1)
-	}
-
 	return db, nil
 }
 
@@ -149,7 +135,7 @@ func NewTestConnection() (*gorm.DB, error) {
 	// FK violations that production Postgres rejects. Enabled AFTER AutoMigrate:
 	// enforcement during a migration that rebuilds tables could trip on transient
 	// states. The sqlite pool is pinned to one physical connection (see
-	// NewConnection), so this one pragma sticks for the connection's lifetime.
+	// openSQLite), so this one pragma sticks for the connection's lifetime.
 	if err := db.Exec("PRAGMA foreign_keys = ON").Error; err != nil {
 		return nil, fmt.Errorf("failed to enable foreign key enforcement for test database: %w", err)
 	}
@@ -0,0 +1,158 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+	"time"
+
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+
+	"github.com/andrescamacho/spacetraders-go/internal/infrastructure/config"
+)
+
+// The daemon and every CLI invocation open the same SQLite file. Under the
+// default rollback journal a writer locks readers out, and a connection that
+// finds the file locked fails at once with "database is locked" — which is what
+// concurrent coordinators kept hitting. The tuning below switches the journal
+// to WAL (readers no longer block on the writer), makes each connection wait
+// out a held lock for busy_timeout before giving up, and retries the autocommit
+// statements that still lose the race.
+
+// openSQLite opens path with the tuning from cfg applied: the pragmas travel in
+// the DSN so every physical connection gets them, the pool is sized from
+// cfg.SQLite, and statements run through a busyRetryPool.
+func openSQLite(path string, cfg *config.DatabaseConfig) (gorm.Dialector, error) {
+	sqlDB, err := sql.Open(sqlite.DriverName, sqliteDSN(path, cfg.SQLite))
+	if err != nil {
+		return nil, fmt.Errorf("failed to open sqlite database: %w", err)
+	}
+
+	// SQLite has no true concurrent-writer support (it serializes writes at the
+	// file-lock level regardless), and a bare ":memory:" DSN gives each physical
+	// connection its OWN separate, empty database unless cache=shared is set. Left
+	// at Go's default (unbounded) pool, concurrent callers can open more than one
+	// physical connection and land on one that never saw AutoMigrate, surfacing as
+	// intermittent "no such table" errors. In-memory databases are therefore
+	// always pinned to a single physical connection; a file database in WAL mode
+	// can be given more so readers are not queued behind a writer.
+	sqlDB.SetMaxOpenConns(sqliteMaxOpen(path, cfg.SQLite))
+
+	return sqlite.New(sqlite.Config{
+		DriverName: sqlite.DriverName,
+		DSN:        path,
+		Conn:       &busyRetryPool{DB: sqlDB, policy: cfg.BusyRetry},
+	}), nil
+}
+
+// sqliteDSN appends the tuning pragmas to path as go-sqlite3 DSN parameters.
+// WAL is skipped for in-memory databases, which have no file to journal.
+func sqliteDSN(path string, tuning config.SQLiteConfig) string {
+	var params []string
+	if tuning.BusyTimeout > 0 {
+		params = append(params, fmt.Sprintf("_busy_timeout=%d", tuning.BusyTimeout.Milliseconds()))
+	}
+	if tuning.JournalMode != "" && !isMemoryPath(path) {
+		params = append(params, "_journal_mode="+strings.ToUpper(tuning.JournalMode))
+	}
+	if tuning.Synchronous != "" {
+		params = append(params, "_synchronous="+strings.ToUpper(tuning.Synchronous))
+	}
+	if len(params) == 0 {
+		return path
+	}
+
+	sep := "?"
+	if strings.Contains(path, "?") {
+		sep = "&"
+	}
+	return path + sep + strings.Join(params, "&")
+}
+
+// sqliteMaxOpen is the connection cap for path: 1 for in-memory databases (see
+// openSQLite) and when unset, otherwise the configured cap.
+func sqliteMaxOpen(path string, tuning config.SQLiteConfig) int {
+	if isMemoryPath(path) || tuning.MaxOpen <= 0 {
+		return 1
+	}
+	return tuning.MaxOpen
+}
+
+func isMemoryPath(path string) bool {
+	return strings.HasPrefix(path, ":memory:") || strings.Contains(path, "mode=memory")
+}
+
+// IsBusyError reports whether err is SQLite refusing a statement because
+// another connection holds the lock it needs.
+func IsBusyError(err error) bool {
+	if err == nil {
+		return false
+	}
+	msg := err.Error()
+	return strings.Contains(msg, "database is locked") ||
+		strings.Contains(msg, "database table is locked") ||
+		strings.Contains(msg, "SQLITE_BUSY")
+}
+
+// RetryOnBusy runs fn, and while it fails with a busy error runs it again after
+// a backoff that doubles each attempt, up to policy.MaxAttempts attempts in
+// all. Any other error, or a cancelled ctx, ends the retries immediately.
+// Repository code that writes inside its own transaction can wrap the whole
+// transaction in it; statements outside a transaction are already retried by
+// the connection pool.
+func RetryOnBusy(ctx context.Context, policy config.BusyRetryConfig, fn func() error) error {
+	backoff := policy.Backoff
+	for attempt := 1; ; attempt++ {
+		err := fn()
+		if err == nil || !IsBusyError(err) || attempt >= policy.MaxAttempts {
+			return err
+		}
+
+		timer := time.NewTimer(backoff)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return err
+		case <-timer.C:
+		}
+		backoff *= 2
+	}
+}
+
+// busyRetryPool is the gorm connection pool for SQLite. Autocommit statements
+// that fail busy are retried under policy: a busy statement made no change, so
+// running it again is safe. Statements inside a transaction run on the
+// *sql.Tx that the embedded BeginTx returns and are not retried one by one —
+// replaying a single statement cannot recover a transaction that lost its lock.
+type busyRetryPool struct {
+	*sql.DB
+	policy config.BusyRetryConfig
+}
+
+func (p *busyRetryPool) ExecContext(ctx context.Context, query string, args ...interface{}) (sql.Result, error) {
+	var result sql.Result
+	err := RetryOnBusy(ctx, p.policy, func() error {
+		var err error
+		result, err = p.DB.ExecContext(ctx, query, args...)
+		return err
+	})
+	return result, err
+}
+
+func (p *busyRetryPool) QueryContext(ctx context.Context, query string, args ...interface{}) (*sql.Rows, error) {
+	var rows *sql.Rows
+	err := RetryOnBusy(ctx, p.policy, func() error {
+		var err error
+		rows, err = p.DB.QueryContext(ctx, query, args...)
+		return err
+	})
+	return rows, err
+}
+
+// GetDBConn hands gorm the underlying *sql.DB, so db.DB() (pool sizing, Close)
+// keeps working through the wrapper.
+func (p *busyRetryPool) GetDBConn() (*sql.DB, error) {
+	return p.DB, nil
+}
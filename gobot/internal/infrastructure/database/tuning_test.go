@@ -0,0 +1,132 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/andrescamacho/spacetraders-go/internal/infrastructure/config"
+)
+
+func TestSQLiteDSN_AppliesTuningPerConnection(t *testing.T) {
+	tuning := config.SQLiteConfig{JournalMode: "wal", Synchronous: "NORMAL", BusyTimeout: 5 * time.Second}
+
+	if got := sqliteDSN("./st.db", tuning); got != "./st.db?_busy_timeout=5000&_journal_mode=WAL&_synchronous=NORMAL" {
+		t.Fatalf("unexpected file DSN %q", got)
+	}
+	if got := sqliteDSN("file:st.db?cache=shared", tuning); got != "file:st.db?cache=shared&_busy_timeout=5000&_journal_mode=WAL&_synchronous=NORMAL" {
+		t.Fatalf("parameters must extend an existing query string, got %q", got)
+	}
+	if got := sqliteDSN(":memory:", tuning); got != ":memory:?_busy_timeout=5000&_synchronous=NORMAL" {
+		t.Fatalf("in-memory databases take no journal mode, got %q", got)
+	}
+	if got := sqliteDSN("./st.db", config.SQLiteConfig{}); got != "./st.db" {
+		t.Fatalf("no tuning leaves the path alone, got %q", got)
+	}
+}
+
+func TestSQLiteMaxOpen_PinsInMemoryDatabases(t *testing.T) {
+	if got := sqliteMaxOpen(":memory:", config.SQLiteConfig{MaxOpen: 4}); got != 1 {
+		t.Fatalf("in-memory database must stay on one connection, got %d", got)
+	}
+	if got := sqliteMaxOpen("./st.db", config.SQLiteConfig{MaxOpen: 4}); got != 4 {
+		t.Fatalf("file database takes the configured cap, got %d", got)
+	}
+	if got := sqliteMaxOpen("./st.db", config.SQLiteConfig{}); got != 1 {
+		t.Fatalf("unset cap defaults to one connection, got %d", got)
+	}
+}
+
+func TestRetryOnBusy_RetriesOnlyBusyErrors(t *testing.T) {
+	policy := config.BusyRetryConfig{MaxAttempts: 3, Backoff: time.Millisecond}
+	ctx := context.Background()
+
+	calls := 0
+	err := RetryOnBusy(ctx, policy, func() error {
+		calls++
+		if calls < 3 {
+			return errors.New("database is locked")
+		}
+		return nil
+	})
+	if err != nil || calls != 3 {
+		t.Fatalf("expected success on the third attempt, got err=%v after %d calls", err, calls)
+	}
+
+	calls = 0
+	err = RetryOnBusy(ctx, policy, func() error {
+		calls++
+		return errors.New("database is locked (5) (SQLITE_BUSY)")
+	})
+	if !IsBusyError(err) || calls != 3 {
+		t.Fatalf("expected the busy error after 3 attempts, got err=%v after %d calls", err, calls)
+	}
+
+	calls = 0
+	err = RetryOnBusy(ctx, policy, func() error {
+		calls++
+		return errors.New("UNIQUE constraint failed")
+	})
+	if err == nil || calls != 1 {
+		t.Fatalf("other errors must not be retried, got %d calls", calls)
+	}
+}
+
+// A daemon write that lands while another process holds the write lock waits
+// the lock out instead of failing with "database is locked".
+func TestNewConnection_FileSQLiteWaitsOutAnotherWriter(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "st.db")
+	cfg := &config.DatabaseConfig{
+		Type:      "sqlite",
+		Path:      path,
+		SQLite:    config.SQLiteConfig{JournalMode: "WAL", Synchronous: "NORMAL", BusyTimeout: 20 * time.Millisecond, MaxOpen: 2},
+		BusyRetry: config.BusyRetryConfig{MaxAttempts: 10, Backoff: 20 * time.Millisecond},
+	}
+	db, err := NewConnection(cfg)
+	if err != nil {
+		t.Fatalf("NewConnection: %v", err)
+	}
+	defer Close(db)
+
+	var mode string
+	if err := db.Raw("PRAGMA journal_mode").Scan(&mode).Error; err != nil || mode != "wal" {
+		t.Fatalf("expected WAL journal mode, got %q (err %v)", mode, err)
+	}
+	if err := db.Exec("CREATE TABLE ledger (id INTEGER PRIMARY KEY, note TEXT)").Error; err != nil {
+		t.Fatalf("create table: %v", err)
+	}
+
+	// A second process (the CLI) takes the write lock and holds it briefly.
+	other, err := sql.Open("sqlite3", path)
+	if err != nil {
+		t.Fatalf("open second connection: %v", err)
+	}
+	defer other.Close()
+	other.SetMaxOpenConns(1)
+	tx, err := other.Begin()
+	if err != nil {
+		t.Fatalf("begin: %v", err)
+	}
+	if _, err := tx.Exec("INSERT INTO ledger (note) VALUES ('cli')"); err != nil {
+		t.Fatalf("cli insert: %v", err)
+	}
+	released := make(chan struct{})
+	go func() {
+		time.Sleep(100 * time.Millisecond)
+		_ = tx.Commit()
+		close(released)
+	}()
+
+	if err := db.Exec("INSERT INTO ledger (note) VALUES ('daemon')").Error; err != nil {
+		t.Fatalf("write behind a held lock should be retried, got %v", err)
+	}
+	<-released
+
+	var count int64
+	if err := db.Raw("SELECT COUNT(*) FROM ledger").Scan(&count).Error; err != nil || count != 2 {
+		t.Fatalf("expected both writes, got %d (err %v)", count, err)
+	}
+}
@@ -0,0 +1,287 @@
+// Package wiring holds composition-root pieces shared by the daemon's main and
+// the end-to-end test harness, so both register the same handlers the same way.
+package wiring
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"gorm.io/gorm"
+
+	"github.com/andrescamacho/spacetraders-go/internal/adapters/graph"
+	"github.com/andrescamacho/spacetraders-go/internal/adapters/persistence"
+	"github.com/andrescamacho/spacetraders-go/internal/application/common"
+	ledgerCmd "github.com/andrescamacho/spacetraders-go/internal/application/ledger/commands"
+	ledgerQuery "github.com/andrescamacho/spacetraders-go/internal/application/ledger/queries"
+	ledgerServices "github.com/andrescamacho/spacetraders-go/internal/application/ledger/services"
+	"github.com/andrescamacho/spacetraders-go/internal/application/mediator"
+	playerQuery "github.com/andrescamacho/spacetraders-go/internal/application/player/queries"
+	scoutingQuery "github.com/andrescamacho/spacetraders-go/internal/application/scouting/queries"
+	"github.com/andrescamacho/spacetraders-go/internal/application/ship"
+	shipCargo "github.com/andrescamacho/spacetraders-go/internal/application/ship/commands/cargo"
+	shipNav "github.com/andrescamacho/spacetraders-go/internal/application/ship/commands/navigation"
+	shipTactics "github.com/andrescamacho/spacetraders-go/internal/application/ship/commands/tactics"
+	shipQuery "github.com/andrescamacho/spacetraders-go/internal/application/ship/queries"
+	shipTypes "github.com/andrescamacho/spacetraders-go/internal/application/ship/types"
+	systemCmd "github.com/andrescamacho/spacetraders-go/internal/application/system/commands"
+	systemQuery "github.com/andrescamacho/spacetraders-go/internal/application/system/queries"
+	tradingSvc "github.com/andrescamacho/spacetraders-go/internal/application/trading/services"
+	"github.com/andrescamacho/spacetraders-go/internal/domain/navigation"
+	domainPorts "github.com/andrescamacho/spacetraders-go/internal/domain/ports"
+	domainRouting "github.com/andrescamacho/spacetraders-go/internal/domain/routing"
+	domainTrading "github.com/andrescamacho/spacetraders-go/internal/domain/trading"
+)
+
+// CoreDependencies are the repositories, clients and services the core handlers
+// are built from. MarketFees is optional; the rest are required.
+type CoreDependencies struct {
+	DB              *gorm.DB
+	ShipRepo        navigation.ShipRepository
+	PlayerRepo      *persistence.GormPlayerRepository
+	WaypointRepo    *persistence.GormWaypointRepository
+	MarketRepo      *persistence.MarketRepositoryGORM
+	TransactionRepo *persistence.GormTransactionRepository
+	APIClient       domainPorts.APIClient
+	RoutingClient   domainRouting.RoutingClient
+	GraphService    *graph.GraphService
+	MarketScanner   *ship.MarketScanner
+	RouteExecutor   *ship.RouteExecutor
+	MarketFees      *tradingSvc.MarketFeeService
+
+	// BuyImpact is the per-tranche price impact the cargo manifest planner
+	// assumes (config trade_impact).
+	BuyImpact float64
+
+	// DockScanMaxAge is the market-data age past which docking at a marketplace
+	// rescans it (config scouting). Zero leaves the post-dock scan off.
+	DockScanMaxAge time.Duration
+
+	// CreditReservationTTL is how long an unreleased credit reservation holds
+	// credits (config daemon). Zero uses the service default.
+	CreditReservationTTL time.Duration
+
+	// DriftRouteMaxTime is the longest a drifting route plan may take before
+	// the planner replans it to refuel instead (config daemon). Zero accepts
+	// any drift.
+	DriftRouteMaxTime time.Duration
+
+	// FuelReservePercent is the share of the tank planned routes must keep
+	// aboard (config daemon). Zero plans without a reserve.
+	FuelReservePercent int
+}
+
+// CoreHandlers exposes the pieces of the core wiring that later wiring builds on.
+type CoreHandlers struct {
+	WaypointEnricher *ship.WaypointEnricher
+	RoutePlanner     *ship.RoutePlanner
+	NavigateRoute    *shipNav.NavigateRouteHandler
+	CargoCostBasis   *ledgerServices.CargoCostBasisTracker
+
+	// CreditReservations is the fleet-wide credit reservation service that
+	// purchase handlers validate against and coordinators reserve through.
+	CreditReservations *ledgerServices.CreditReservationService
+
+	// WaypointBlacklist is the per-player waypoint blacklist route planning
+	// consults; scouting and the health monitor are wired to it by the daemon.
+	WaypointBlacklist *ship.WaypointBlacklist
+}
+
+// RegisterCoreHandlers registers the ship, navigation, cargo, market, player and
+// ledger handlers every other operation dispatches through.
+func RegisterCoreHandlers(med mediator.Mediator, deps CoreDependencies) (*CoreHandlers, error) {
+	shipRepo := deps.ShipRepo
+	core := &CoreHandlers{}
+
+	// Atomic ship commands (used by RouteExecutor)
+	if err := mediator.RegisterHandler[*shipTypes.OrbitShipCommand](med, shipTactics.NewOrbitShipHandler(shipRepo)); err != nil {
+		return nil, fmt.Errorf("failed to register OrbitShip handler: %w", err)
+	}
+	dockHandler := shipTactics.NewDockShipHandler(shipRepo)
+	if deps.MarketScanner != nil {
+		dockHandler.SetMarketScan(deps.MarketScanner, deps.WaypointRepo, deps.DockScanMaxAge)
+	}
+	if err := mediator.RegisterHandler[*shipTypes.DockShipCommand](med, dockHandler); err != nil {
+		return nil, fmt.Errorf("failed to register DockShip handler: %w", err)
+	}
+
+	// Every handler that moves cargo in or out of a hold keeps the per-ship cost
+	// basis current, so sell floors judge a sale against what the cargo cost.
+	cargoCostBasisRepo := persistence.NewCargoCostBasisRepository(deps.DB)
+	core.CargoCostBasis = ledgerServices.NewCargoCostBasisTracker(cargoCostBasisRepo)
+
+	// Purchases spend only credits no coordinator has reserved for its own buy.
+	// Reservations persist, so those held at a restart stay held until expiry.
+	core.CreditReservations = ledgerServices.NewCreditReservationService(deps.CreditReservationTTL, nil).
+		WithRepository(persistence.NewCreditReservationRepository(deps.DB))
+	if err := loadAtBoot(core.CreditReservations.Load); err != nil {
+		return nil, err
+	}
+
+	refuelHandler := shipTactics.NewRefuelShipHandler(shipRepo, deps.PlayerRepo, deps.APIClient, med)
+	refuelHandler.SetCostBasisRecorder(core.CargoCostBasis)
+	if err := mediator.RegisterHandler[*shipTypes.RefuelShipCommand](med, refuelHandler); err != nil {
+		return nil, fmt.Errorf("failed to register RefuelShip handler: %w", err)
+	}
+	if err := mediator.RegisterHandler[*shipTypes.SetFlightModeCommand](med, shipNav.NewSetFlightModeHandler(shipRepo)); err != nil {
+		return nil, fmt.Errorf("failed to register SetFlightMode handler: %w", err)
+	}
+
+	// Keyed navigation retries replay the original result (one cache per
+	// handler, so a key only ever matches the command kind it was used with).
+	// Results persist, so a retry after a restart still replays.
+	idempotencyStore := persistence.NewShipCommandIdempotencyRepository(deps.DB)
+	navigateDirectResults, err := loadIdempotencyCache(idempotencyStore, shipNav.NavigateDirectIdempotencyScope, shipNav.NavigateDirectResultCodec{})
+	if err != nil {
+		return nil, err
+	}
+	navigateDirectHandler := shipNav.NewNavigateDirectHandler(shipRepo, deps.WaypointRepo).
+		WithIdempotencyCache(navigateDirectResults)
+	if err := mediator.RegisterHandler[*shipTypes.NavigateDirectCommand](med, navigateDirectHandler); err != nil {
+		return nil, fmt.Errorf("failed to register NavigateDirect handler: %w", err)
+	}
+
+	core.WaypointEnricher = ship.NewWaypointEnricher(deps.WaypointRepo)
+	core.RoutePlanner = ship.NewRoutePlanner(deps.RoutingClient)
+	// Refuel stops prefer each system's cheap, central FUEL markets; prices are
+	// re-read at most every 10 minutes per system.
+	core.RoutePlanner.SetFuelDepotIndex(ship.NewFuelDepotIndex(deps.MarketRepo, 10*time.Minute, nil))
+	core.RoutePlanner.SetDistanceMatrixCache(ship.NewDistanceMatrixCache(persistence.NewDistanceMatrixRepository(deps.DB)))
+	core.RoutePlanner.SetDriftRouteLimit(deps.DriftRouteMaxTime)
+	core.RoutePlanner.SetFuelReserve(navigation.FuelReservePolicy{Percent: deps.FuelReservePercent})
+	core.WaypointBlacklist = ship.NewWaypointBlacklist(persistence.NewWaypointBlacklistRepository(deps.DB), nil)
+	core.RoutePlanner.SetWaypointBlacklist(core.WaypointBlacklist)
+	if err := mediator.RegisterHandler[*systemCmd.BlacklistWaypointCommand](med, systemCmd.NewBlacklistWaypointHandler(core.WaypointBlacklist, nil)); err != nil {
+		return nil, fmt.Errorf("failed to register BlacklistWaypoint handler: %w", err)
+	}
+	if err := mediator.RegisterHandler[*systemCmd.ClearWaypointBlacklistCommand](med, systemCmd.NewClearWaypointBlacklistHandler(core.WaypointBlacklist)); err != nil {
+		return nil, fmt.Errorf("failed to register ClearWaypointBlacklist handler: %w", err)
+	}
+	if err := mediator.RegisterHandler[*systemQuery.ListWaypointBlacklistQuery](med, systemQuery.NewListWaypointBlacklistHandler(core.WaypointBlacklist)); err != nil {
+		return nil, fmt.Errorf("failed to register ListWaypointBlacklist handler: %w", err)
+	}
+
+	navigateRouteResults, err := loadIdempotencyCache(idempotencyStore, shipNav.NavigateRouteIdempotencyScope, shipNav.NavigateRouteResultCodec{})
+	if err != nil {
+		return nil, err
+	}
+	core.NavigateRoute = shipNav.NewNavigateRouteHandler(
+		shipRepo,
+		deps.GraphService,
+		core.WaypointEnricher,
+		core.RoutePlanner,
+		deps.RouteExecutor,
+	).WithIdempotencyCache(navigateRouteResults)
+	if err := mediator.RegisterHandler[*shipNav.NavigateRouteCommand](med, core.NavigateRoute); err != nil {
+		return nil, fmt.Errorf("failed to register NavigateRoute handler: %w", err)
+	}
+
+	// Market, player and ship reads
+	if err := mediator.RegisterHandler[*scoutingQuery.GetMarketDataQuery](med, scoutingQuery.NewGetMarketDataHandler(deps.MarketRepo)); err != nil {
+		return nil, fmt.Errorf("failed to register GetMarketData handler: %w", err)
+	}
+	if err := mediator.RegisterHandler[*scoutingQuery.ListMarketDataQuery](med, scoutingQuery.NewListMarketDataHandler(deps.MarketRepo)); err != nil {
+		return nil, fmt.Errorf("failed to register ListMarketData handler: %w", err)
+	}
+	if err := mediator.RegisterHandler[*playerQuery.GetPlayerQuery](med, playerQuery.NewGetPlayerHandler(deps.PlayerRepo, deps.APIClient)); err != nil {
+		return nil, fmt.Errorf("failed to register GetPlayer handler: %w", err)
+	}
+	if err := mediator.RegisterHandler[*shipQuery.ListShipsQuery](med, shipQuery.NewListShipsHandler(shipRepo, deps.PlayerRepo)); err != nil {
+		return nil, fmt.Errorf("failed to register ListShips handler: %w", err)
+	}
+	if err := mediator.RegisterHandler[*shipQuery.GetShipQuery](med, shipQuery.NewGetShipHandler(shipRepo, deps.PlayerRepo)); err != nil {
+		return nil, fmt.Errorf("failed to register GetShip handler: %w", err)
+	}
+
+	// Cargo handlers (marketScanner refreshes market data after transactions)
+	purchaseCargoHandler := shipCargo.NewPurchaseCargoHandler(shipRepo, deps.PlayerRepo, deps.APIClient, deps.MarketRepo, med, deps.MarketScanner)
+	purchaseCargoHandler.SetCostBasisRecorder(core.CargoCostBasis)
+	purchaseCargoHandler.SetCreditReservations(core.CreditReservations)
+	if deps.MarketFees != nil {
+		purchaseCargoHandler.SetMarketFeeObserver(deps.MarketFees)
+	}
+	if err := mediator.RegisterHandler[*shipCargo.PurchaseCargoCommand](med, purchaseCargoHandler); err != nil {
+		return nil, fmt.Errorf("failed to register PurchaseCargo handler: %w", err)
+	}
+
+	purchaseCargoManifestHandler := shipCargo.NewPurchaseCargoManifestHandler(shipRepo, deps.MarketRepo, med,
+		domainTrading.NewCargoManifestPlanner(deps.BuyImpact))
+	if err := mediator.RegisterHandler[*shipCargo.PurchaseCargoManifestCommand](med, purchaseCargoManifestHandler); err != nil {
+		return nil, fmt.Errorf("failed to register PurchaseCargoManifest handler: %w", err)
+	}
+
+	sellCargoHandler := shipCargo.NewSellCargoHandler(shipRepo, deps.PlayerRepo, deps.APIClient, deps.MarketRepo, med, deps.MarketScanner)
+	sellCargoHandler.SetCostBasisRecorder(core.CargoCostBasis)
+	if deps.MarketFees != nil {
+		sellCargoHandler.SetMarketFeeObserver(deps.MarketFees)
+	}
+	if err := mediator.RegisterHandler[*shipCargo.SellCargoCommand](med, sellCargoHandler); err != nil {
+		return nil, fmt.Errorf("failed to register SellCargo handler: %w", err)
+	}
+	ladderSellHandler := shipCargo.NewLadderSellCargoHandler(shipRepo, deps.MarketRepo, med, deps.MarketScanner)
+	if err := mediator.RegisterHandler[*shipCargo.LadderSellCargoCommand](med, ladderSellHandler); err != nil {
+		return nil, fmt.Errorf("failed to register LadderSellCargo handler: %w", err)
+	}
+
+	jettisonCargoHandler := shipCargo.NewJettisonCargoHandler(shipRepo, deps.PlayerRepo, deps.APIClient)
+	jettisonCargoHandler.SetCostBasisRecorder(core.CargoCostBasis)
+	if err := mediator.RegisterHandler[*shipCargo.JettisonCargoCommand](med, jettisonCargoHandler); err != nil {
+		return nil, fmt.Errorf("failed to register JettisonCargo handler: %w", err)
+	}
+
+	extractResourcesHandler := shipCargo.NewExtractResourcesHandler(shipRepo, deps.APIClient)
+	extractResourcesHandler.SetCostBasisRecorder(core.CargoCostBasis)
+	if err := mediator.RegisterHandler[*shipCargo.ExtractResourcesCommand](med, extractResourcesHandler); err != nil {
+		return nil, fmt.Errorf("failed to register ExtractResources handler: %w", err)
+	}
+
+	// Ledger handlers
+	transactionRepo := deps.TransactionRepo
+	playerResolver := common.NewPlayerResolver(deps.PlayerRepo)
+	if err := mediator.RegisterHandler[*ledgerCmd.RecordTransactionCommand](med, ledgerCmd.NewRecordTransactionHandler(transactionRepo, nil)); err != nil {
+		return nil, fmt.Errorf("failed to register RecordTransaction handler: %w", err)
+	}
+	if err := mediator.RegisterHandler[*ledgerQuery.GetTransactionsQuery](med, ledgerQuery.NewGetTransactionsHandler(transactionRepo, playerResolver)); err != nil {
+		return nil, fmt.Errorf("failed to register GetTransactions handler: %w", err)
+	}
+	if err := mediator.RegisterHandler[*ledgerQuery.GetProfitLossQuery](med, ledgerQuery.NewGetProfitLossHandler(transactionRepo)); err != nil {
+		return nil, fmt.Errorf("failed to register GetProfitLoss handler: %w", err)
+	}
+	if err := mediator.RegisterHandler[*ledgerQuery.GetProfitLossByOperationQuery](med, ledgerQuery.NewGetProfitLossByOperationHandler(transactionRepo)); err != nil {
+		return nil, fmt.Errorf("failed to register GetProfitLossByOperation handler: %w", err)
+	}
+	if err := mediator.RegisterHandler[*ledgerQuery.GetCashFlowQuery](med, ledgerQuery.NewGetCashFlowHandler(transactionRepo)); err != nil {
+		return nil, fmt.Errorf("failed to register GetCashFlow handler: %w", err)
+	}
+	if err := mediator.RegisterHandler[*ledgerQuery.GetBalanceSheetQuery](med, ledgerQuery.NewGetBalanceSheetHandler(transactionRepo)); err != nil {
+		return nil, fmt.Errorf("failed to register GetBalanceSheet handler: %w", err)
+	}
+	if err := mediator.RegisterHandler[*ledgerQuery.GetIncomeStatementQuery](med, ledgerQuery.NewGetIncomeStatementHandler(transactionRepo)); err != nil {
+		return nil, fmt.Errorf("failed to register GetIncomeStatement handler: %w", err)
+	}
+
+	getCargoCostBasisHandler := ledgerQuery.NewGetCargoCostBasisHandler(transactionRepo, nil)
+	getCargoCostBasisHandler.SetTrackedBasis(cargoCostBasisRepo)
+	if err := mediator.RegisterHandler[*ledgerQuery.GetCargoCostBasisQuery](med, getCargoCostBasisHandler); err != nil {
+		return nil, fmt.Errorf("failed to register GetCargoCostBasis handler: %w", err)
+	}
+
+	return core, nil
+}
+
+// loadIdempotencyCache builds a keyed-result cache persisted under scope and
+// reloads the results still inside its ttl.
+func loadIdempotencyCache(store navigation.CommandIdempotencyRepository, scope string, codec ship.IdempotencyCodec) (*ship.IdempotencyCache, error) {
+	cache := ship.NewIdempotencyCache(ship.DefaultIdempotencyTTL, nil).WithStore(store, scope, codec)
+	if err := loadAtBoot(cache.Load); err != nil {
+		return nil, err
+	}
+	return cache, nil
+}
+
+// loadAtBoot runs a persisted component's Load under a bounded context.
+func loadAtBoot(load func(ctx context.Context) error) error {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	return load(ctx)
+}
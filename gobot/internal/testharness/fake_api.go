@@ -0,0 +1,496 @@
+package testharness
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/andrescamacho/spacetraders-go/internal/domain/navigation"
+	"github.com/andrescamacho/spacetraders-go/internal/domain/player"
+	domainPorts "github.com/andrescamacho/spacetraders-go/internal/domain/ports"
+	"github.com/andrescamacho/spacetraders-go/internal/domain/shared"
+	"github.com/andrescamacho/spacetraders-go/internal/domain/system"
+)
+
+// FakeWaypoint is a waypoint in the fake universe.
+type FakeWaypoint struct {
+	Symbol string
+	Type   string
+	X, Y   float64
+	Traits []string
+}
+
+// FakeTradeGood is one good quoted at a fake market, priced from the ship's
+// side: Bid is what a ship receives selling (the API's sellPrice), Ask is what
+// it pays buying (purchasePrice).
+type FakeTradeGood struct {
+	Symbol      string
+	Bid         int
+	Ask         int
+	TradeVolume int
+	Supply      string
+	Activity    string
+	TradeType   string
+
+	// BidDropPerUnit lowers Bid by this much for every unit sold, so repeated
+	// sells walk the price down the way a thin market does.
+	BidDropPerUnit int
+}
+
+// FakeAPIClient is an in-memory SpaceTraders universe behind the APIClient
+// port. It models ships, markets, waypoints and the agent's credits closely
+// enough for the core handlers: navigation arrives instantly, fuel burns one
+// unit per unit of distance, and trades respect trade volume and credits.
+//
+// Endpoints the harness does not model fall through to the embedded nil
+// interface and panic, so a test that strays outside the model fails loudly
+// with the endpoint's name in the stack.
+type FakeAPIClient struct {
+	domainPorts.APIClient
+
+	mu        sync.Mutex
+	agent     string
+	credits   int
+	waypoints map[string]*FakeWaypoint
+	markets   map[string]map[string]*FakeTradeGood
+	ships     map[string]*navigation.ShipData
+	calls     []string
+}
+
+// NewFakeAPIClient returns an empty universe for agentSymbol holding credits.
+func NewFakeAPIClient(agentSymbol string, credits int) *FakeAPIClient {
+	return &FakeAPIClient{
+		agent:     agentSymbol,
+		credits:   credits,
+		waypoints: make(map[string]*FakeWaypoint),
+		markets:   make(map[string]map[string]*FakeTradeGood),
+		ships:     make(map[string]*navigation.ShipData),
+	}
+}
+
+// AddWaypoint adds or replaces a waypoint.
+func (f *FakeAPIClient) AddWaypoint(wp FakeWaypoint) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.waypoints[wp.Symbol] = &wp
+}
+
+// SetMarket replaces the goods quoted at waypoint.
+func (f *FakeAPIClient) SetMarket(waypoint string, goods ...FakeTradeGood) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	quotes := make(map[string]*FakeTradeGood, len(goods))
+	for i := range goods {
+		g := goods[i]
+		quotes[g.Symbol] = &g
+	}
+	f.markets[waypoint] = quotes
+}
+
+// Quote returns the current quote for good at waypoint.
+func (f *FakeAPIClient) Quote(waypoint, good string) (FakeTradeGood, bool) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	g, ok := f.markets[waypoint][good]
+	if !ok {
+		return FakeTradeGood{}, false
+	}
+	return *g, true
+}
+
+// AddShip adds or replaces a ship.
+func (f *FakeAPIClient) AddShip(data *navigation.ShipData) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.ships[data.Symbol] = copyShipData(data)
+}
+
+// Ship returns a snapshot of the ship as the fake server sees it.
+func (f *FakeAPIClient) Ship(symbol string) (*navigation.ShipData, bool) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	s, ok := f.ships[symbol]
+	if !ok {
+		return nil, false
+	}
+	return copyShipData(s), true
+}
+
+// Credits returns the agent's current balance.
+func (f *FakeAPIClient) Credits() int {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.credits
+}
+
+// Calls returns the endpoints called so far, in order, as "Endpoint SUBJECT".
+func (f *FakeAPIClient) Calls() []string {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return append([]string(nil), f.calls...)
+}
+
+func (f *FakeAPIClient) record(endpoint, subject string) {
+	f.calls = append(f.calls, endpoint+" "+subject)
+}
+
+func (f *FakeAPIClient) ship(symbol string) (*navigation.ShipData, error) {
+	s, ok := f.ships[symbol]
+	if !ok {
+		return nil, fmt.Errorf("ship %s not found", symbol)
+	}
+	return s, nil
+}
+
+func (f *FakeAPIClient) dockedShip(symbol string) (*navigation.ShipData, error) {
+	s, err := f.ship(symbol)
+	if err != nil {
+		return nil, err
+	}
+	if s.NavStatus != string(navigation.NavStatusDocked) {
+		return nil, fmt.Errorf("ship %s must be docked (status %s)", symbol, s.NavStatus)
+	}
+	return s, nil
+}
+
+func (f *FakeAPIClient) quote(waypoint, good string) (*FakeTradeGood, error) {
+	g, ok := f.markets[waypoint][good]
+	if !ok {
+		return nil, fmt.Errorf("market %s does not trade %s", waypoint, good)
+	}
+	return g, nil
+}
+
+func (f *FakeAPIClient) GetAgent(_ context.Context, _ string) (*player.AgentData, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.record("GetAgent", f.agent)
+	return &player.AgentData{Symbol: f.agent, Credits: f.credits}, nil
+}
+
+func (f *FakeAPIClient) GetShip(_ context.Context, symbol, _ string) (*navigation.ShipData, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.record("GetShip", symbol)
+	s, err := f.ship(symbol)
+	if err != nil {
+		return nil, err
+	}
+	return copyShipData(s), nil
+}
+
+func (f *FakeAPIClient) GetShipNav(_ context.Context, symbol, _ string) (*navigation.ShipNavData, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.record("GetShipNav", symbol)
+	s, err := f.ship(symbol)
+	if err != nil {
+		return nil, err
+	}
+	return &navigation.ShipNavData{
+		Symbol:        s.Symbol,
+		Location:      s.Location,
+		NavStatus:     s.NavStatus,
+		FlightMode:    s.FlightMode,
+		ArrivalTime:   s.ArrivalTime,
+		OriginSymbol:  s.OriginSymbol,
+		DepartureTime: s.DepartureTime,
+	}, nil
+}
+
+func (f *FakeAPIClient) ListShips(_ context.Context, _ string) ([]*navigation.ShipData, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.record("ListShips", f.agent)
+	ships := make([]*navigation.ShipData, 0, len(f.ships))
+	for _, s := range f.ships {
+		ships = append(ships, copyShipData(s))
+	}
+	sort.Slice(ships, func(i, j int) bool { return ships[i].Symbol < ships[j].Symbol })
+	return ships, nil
+}
+
+func (f *FakeAPIClient) NavigateShip(_ context.Context, symbol, destination, _ string) (*navigation.Result, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.record("NavigateShip", symbol)
+	s, err := f.ship(symbol)
+	if err != nil {
+		return nil, err
+	}
+	if s.NavStatus != string(navigation.NavStatusInOrbit) {
+		return nil, fmt.Errorf("ship %s must be in orbit to navigate (status %s)", symbol, s.NavStatus)
+	}
+	from, ok := f.waypoints[s.Location]
+	if !ok {
+		return nil, fmt.Errorf("unknown origin waypoint %s", s.Location)
+	}
+	to, ok := f.waypoints[destination]
+	if !ok {
+		return nil, fmt.Errorf("unknown destination waypoint %s", destination)
+	}
+	fuel := fuelForDistance(math.Hypot(to.X-from.X, to.Y-from.Y), s.FlightMode)
+	if fuel > s.FuelCurrent {
+		return nil, fmt.Errorf("ship %s needs %d fuel to reach %s but has %d", symbol, fuel, destination, s.FuelCurrent)
+	}
+
+	// Travel is instant: the ship is already in orbit at the destination and
+	// the reported arrival is now.
+	s.FuelCurrent -= fuel
+	s.Location = destination
+	if s.FlightMode == "" {
+		s.FlightMode = "CRUISE"
+	}
+	return &navigation.Result{
+		Destination:    destination,
+		ArrivalTimeStr: time.Now().UTC().Format(time.RFC3339),
+		FuelConsumed:   fuel,
+		FlightMode:     s.FlightMode,
+		FuelCurrent:    s.FuelCurrent,
+		FuelCapacity:   s.FuelCapacity,
+	}, nil
+}
+
+// fuelForDistance mirrors the game's burn: DRIFT costs one unit, BURN double,
+// and any nonzero hop costs at least one unit.
+func fuelForDistance(distance float64, mode string) int {
+	if distance == 0 {
+		return 0
+	}
+	switch mode {
+	case "DRIFT":
+		return 1
+	case "BURN":
+		return max(1, 2*int(math.Round(distance)))
+	}
+	return max(1, int(math.Round(distance)))
+}
+
+func (f *FakeAPIClient) OrbitShip(_ context.Context, symbol, _ string) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.record("OrbitShip", symbol)
+	s, err := f.ship(symbol)
+	if err != nil {
+		return err
+	}
+	s.NavStatus = string(navigation.NavStatusInOrbit)
+	return nil
+}
+
+func (f *FakeAPIClient) DockShip(_ context.Context, symbol, _ string) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.record("DockShip", symbol)
+	s, err := f.ship(symbol)
+	if err != nil {
+		return err
+	}
+	s.NavStatus = string(navigation.NavStatusDocked)
+	return nil
+}
+
+func (f *FakeAPIClient) SetFlightMode(_ context.Context, symbol, flightMode, _ string) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.record("SetFlightMode", symbol)
+	s, err := f.ship(symbol)
+	if err != nil {
+		return err
+	}
+	s.FlightMode = flightMode
+	return nil
+}
+
+// RefuelShip buys market FUEL, which the game sells in 100-fuel units.
+func (f *FakeAPIClient) RefuelShip(_ context.Context, symbol, _ string, units *int) (*navigation.RefuelResult, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.record("RefuelShip", symbol)
+	s, err := f.dockedShip(symbol)
+	if err != nil {
+		return nil, err
+	}
+	fuel, err := f.quote(s.Location, "FUEL")
+	if err != nil {
+		return nil, err
+	}
+	added := s.FuelCapacity - s.FuelCurrent
+	if units != nil && *units < added {
+		added = *units
+	}
+	cost := (added + 99) / 100 * fuel.Ask
+	if cost > f.credits {
+		return nil, fmt.Errorf("insufficient credits: refuel costs %d, have %d", cost, f.credits)
+	}
+	f.credits -= cost
+	s.FuelCurrent += added
+	credits := f.credits
+	return &navigation.RefuelResult{
+		FuelAdded:    added,
+		CreditsCost:  cost,
+		FuelCurrent:  s.FuelCurrent,
+		FuelCapacity: s.FuelCapacity,
+		AgentCredits: &credits,
+	}, nil
+}
+
+func (f *FakeAPIClient) PurchaseCargo(_ context.Context, shipSymbol, goodSymbol string, units int, _ string) (*domainPorts.PurchaseResult, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.record("PurchaseCargo", shipSymbol)
+	s, err := f.dockedShip(shipSymbol)
+	if err != nil {
+		return nil, err
+	}
+	g, err := f.quote(s.Location, goodSymbol)
+	if err != nil {
+		return nil, err
+	}
+	if g.TradeVolume > 0 && units > g.TradeVolume {
+		return nil, fmt.Errorf("%d units of %s exceeds the trade volume of %d", units, goodSymbol, g.TradeVolume)
+	}
+	if units > s.Cargo.Capacity-s.Cargo.Units {
+		return nil, fmt.Errorf("ship %s has room for %d units, not %d", shipSymbol, s.Cargo.Capacity-s.Cargo.Units, units)
+	}
+	cost := units * g.Ask
+	if cost > f.credits {
+		return nil, fmt.Errorf("insufficient credits: purchase costs %d, have %d", cost, f.credits)
+	}
+	f.credits -= cost
+	addCargo(s, goodSymbol, units)
+	credits := f.credits
+	return &domainPorts.PurchaseResult{TotalCost: cost, UnitsAdded: units, AgentCredits: &credits}, nil
+}
+
+func (f *FakeAPIClient) SellCargo(_ context.Context, shipSymbol, goodSymbol string, units int, _ string) (*domainPorts.SellResult, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.record("SellCargo", shipSymbol)
+	s, err := f.dockedShip(shipSymbol)
+	if err != nil {
+		return nil, err
+	}
+	g, err := f.quote(s.Location, goodSymbol)
+	if err != nil {
+		return nil, err
+	}
+	if g.TradeVolume > 0 && units > g.TradeVolume {
+		return nil, fmt.Errorf("%d units of %s exceeds the trade volume of %d", units, goodSymbol, g.TradeVolume)
+	}
+	if err := removeCargo(s, goodSymbol, units); err != nil {
+		return nil, err
+	}
+	revenue := units * g.Bid
+	f.credits += revenue
+	g.Bid = max(1, g.Bid-units*g.BidDropPerUnit)
+	credits := f.credits
+	return &domainPorts.SellResult{TotalRevenue: revenue, UnitsSold: units, AgentCredits: &credits}, nil
+}
+
+func (f *FakeAPIClient) JettisonCargo(_ context.Context, shipSymbol, goodSymbol string, units int, _ string) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.record("JettisonCargo", shipSymbol)
+	s, err := f.ship(shipSymbol)
+	if err != nil {
+		return err
+	}
+	return removeCargo(s, goodSymbol, units)
+}
+
+func (f *FakeAPIClient) GetMarket(_ context.Context, _, waypointSymbol, _ string) (*domainPorts.MarketData, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.record("GetMarket", waypointSymbol)
+	quotes, ok := f.markets[waypointSymbol]
+	if !ok {
+		return nil, fmt.Errorf("no market at %s", waypointSymbol)
+	}
+	data := &domainPorts.MarketData{Symbol: waypointSymbol}
+	for _, g := range quotes {
+		data.TradeGoods = append(data.TradeGoods, domainPorts.TradeGoodData{
+			Symbol:        g.Symbol,
+			Supply:        g.Supply,
+			Activity:      g.Activity,
+			SellPrice:     g.Bid,
+			PurchasePrice: g.Ask,
+			TradeVolume:   g.TradeVolume,
+			TradeType:     g.TradeType,
+		})
+	}
+	sort.Slice(data.TradeGoods, func(i, j int) bool { return data.TradeGoods[i].Symbol < data.TradeGoods[j].Symbol })
+	return data, nil
+}
+
+func (f *FakeAPIClient) ListWaypoints(_ context.Context, systemSymbol, _ string, page, limit int) (*system.WaypointsListResponse, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.record("ListWaypoints", systemSymbol)
+	var all []system.WaypointAPIData
+	for _, wp := range f.waypoints {
+		if shared.ExtractSystemSymbol(wp.Symbol) != systemSymbol {
+			continue
+		}
+		traits := make([]map[string]interface{}, 0, len(wp.Traits))
+		for _, trait := range wp.Traits {
+			traits = append(traits, map[string]interface{}{"symbol": trait})
+		}
+		all = append(all, system.WaypointAPIData{Symbol: wp.Symbol, Type: wp.Type, X: wp.X, Y: wp.Y, Traits: traits})
+	}
+	sort.Slice(all, func(i, j int) bool { return all[i].Symbol < all[j].Symbol })
+
+	start := min((page-1)*limit, len(all))
+	end := min(start+limit, len(all))
+	return &system.WaypointsListResponse{
+		Data: all[start:end],
+		Meta: system.PaginationMeta{Total: len(all), Page: page, Limit: limit},
+	}, nil
+}
+
+func addCargo(s *navigation.ShipData, good string, units int) {
+	s.Cargo.Units += units
+	s.CargoUnits = s.Cargo.Units
+	for i := range s.Cargo.Inventory {
+		if s.Cargo.Inventory[i].Symbol == good {
+			s.Cargo.Inventory[i].Units += units
+			return
+		}
+	}
+	s.Cargo.Inventory = append(s.Cargo.Inventory, shared.CargoItem{Symbol: good, Name: good, Units: units})
+}
+
+func removeCargo(s *navigation.ShipData, good string, units int) error {
+	for i := range s.Cargo.Inventory {
+		item := &s.Cargo.Inventory[i]
+		if item.Symbol != good {
+			continue
+		}
+		if item.Units < units {
+			return fmt.Errorf("ship %s holds %d units of %s, not %d", s.Symbol, item.Units, good, units)
+		}
+		item.Units -= units
+		if item.Units == 0 {
+			s.Cargo.Inventory = append(s.Cargo.Inventory[:i], s.Cargo.Inventory[i+1:]...)
+		}
+		s.Cargo.Units -= units
+		s.CargoUnits = s.Cargo.Units
+		return nil
+	}
+	return fmt.Errorf("ship %s holds no %s", s.Symbol, good)
+}
+
+func copyShipData(s *navigation.ShipData) *navigation.ShipData {
+	c := *s
+	if s.Cargo != nil {
+		cargo := *s.Cargo
+		cargo.Inventory = append([]shared.CargoItem(nil), s.Cargo.Inventory...)
+		c.Cargo = &cargo
+	}
+	c.Modules = append([]navigation.ModuleData(nil), s.Modules...)
+	c.Mounts = append([]navigation.MountData(nil), s.Mounts...)
+	return &c
+}
@@ -0,0 +1,156 @@
+// Package testharness builds the daemon's core in-process for end-to-end tests:
+// a fully migrated in-memory SQLite database, the real persistence
+// repositories and ship repository, the mediator with the core handlers
+// registered exactly as the daemon registers them (wiring.RegisterCoreHandlers),
+// and fake API and routing clients standing in for the network.
+//
+// A test seeds the universe (waypoints, markets, ships), dispatches commands
+// through Harness.Mediator and asserts on the database, the fake server and
+// the responses:
+//
+//	h := testharness.New(t)
+//	h.SeedWaypoint("X1-T-A1", 0, 0, "MARKETPLACE")
+//	h.SeedMarket("X1-T-A1", testharness.FakeTradeGood{Symbol: "IRON", Bid: 40, Ask: 50, TradeVolume: 10})
+//	h.SeedShip(testharness.ShipSpec{Symbol: "T-1", Location: "X1-T-A1", Cargo: map[string]int{"IRON": 5}})
+//	h.Send(&shipCargo.SellCargoCommand{...})
+//
+// Seed every waypoint of a system before the first command that reads it: the
+// system graph is built from the fake server once and then cached.
+//
+// The package imports the grpc adapter for its arrival scheduler, so tests
+// inside package grpc itself cannot use it.
+package testharness
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+
+	"github.com/andrescamacho/spacetraders-go/internal/adapters/api"
+	"github.com/andrescamacho/spacetraders-go/internal/adapters/graph"
+	"github.com/andrescamacho/spacetraders-go/internal/adapters/grpc"
+	"github.com/andrescamacho/spacetraders-go/internal/adapters/persistence"
+	"github.com/andrescamacho/spacetraders-go/internal/adapters/routing"
+	"github.com/andrescamacho/spacetraders-go/internal/application/auth"
+	"github.com/andrescamacho/spacetraders-go/internal/application/common"
+	"github.com/andrescamacho/spacetraders-go/internal/application/ship"
+	"github.com/andrescamacho/spacetraders-go/internal/domain/player"
+	"github.com/andrescamacho/spacetraders-go/internal/domain/shared"
+	"github.com/andrescamacho/spacetraders-go/internal/infrastructure/database"
+	"github.com/andrescamacho/spacetraders-go/internal/infrastructure/wiring"
+)
+
+const (
+	// AgentSymbol and Token identify the harness's single seeded player.
+	AgentSymbol = "HARNESS"
+	Token       = "harness-token"
+
+	// StartingCredits is the fake agent's opening balance.
+	StartingCredits = 1_000_000
+)
+
+// PlayerID is the seeded player's ID.
+var PlayerID = shared.MustNewPlayerID(1)
+
+// Harness is one in-process daemon core over an in-memory database.
+type Harness struct {
+	t testing.TB
+
+	DB       *gorm.DB
+	Mediator common.Mediator
+	API      *FakeAPIClient
+	Routing  *routing.MockRoutingClient
+
+	PlayerRepo      *persistence.GormPlayerRepository
+	WaypointRepo    *persistence.GormWaypointRepository
+	MarketRepo      *persistence.MarketRepositoryGORM
+	TransactionRepo *persistence.GormTransactionRepository
+	ContainerRepo   *persistence.ContainerRepositoryGORM
+	ShipRepo        *api.ShipRepository
+	GraphService    *graph.GraphService
+	MarketScanner   *ship.MarketScanner
+	RouteExecutor   *ship.RouteExecutor
+	ShipEvents      *ship.ShipEventBus
+
+	// Core exposes the handlers and services RegisterCoreHandlers built, for
+	// tests that wire further handlers on top.
+	Core *wiring.CoreHandlers
+}
+
+// New builds a harness with one seeded player and an empty universe. Everything
+// it starts is torn down when the test ends.
+func New(t testing.TB) *Harness {
+	t.Helper()
+
+	db, err := database.NewTestConnection()
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		if sqlDB, err := db.DB(); err == nil {
+			_ = sqlDB.Close()
+		}
+	})
+
+	h := &Harness{
+		t:               t,
+		DB:              db,
+		API:             NewFakeAPIClient(AgentSymbol, StartingCredits),
+		Routing:         routing.NewMockRoutingClient(),
+		PlayerRepo:      persistence.NewGormPlayerRepository(db),
+		WaypointRepo:    persistence.NewGormWaypointRepository(db),
+		MarketRepo:      persistence.NewMarketRepository(db),
+		TransactionRepo: persistence.NewGormTransactionRepository(db),
+		ContainerRepo:   persistence.NewContainerRepository(db),
+		ShipEvents:      ship.NewShipEventBus(),
+	}
+	require.NoError(t, h.PlayerRepo.Add(context.Background(), player.NewPlayer(PlayerID, AgentSymbol, Token)))
+
+	graphBuilder := api.NewGraphBuilder(h.API, h.PlayerRepo, h.WaypointRepo)
+	h.GraphService = graph.NewGraphService(persistence.NewGormSystemGraphRepository(db), h.WaypointRepo, graphBuilder)
+	h.ShipRepo = api.NewShipRepository(h.API, h.PlayerRepo, h.WaypointRepo, h.GraphService, db, nil)
+
+	// The fake server lands a ship the moment it departs; the daemon's arrival
+	// scheduler turns that into the ARRIVED event route execution waits on.
+	arrivals := grpc.NewShipStateScheduler(h.ShipRepo, nil, h.ShipEvents)
+	h.ShipRepo.SetArrivalScheduler(arrivals)
+	t.Cleanup(arrivals.Stop)
+
+	h.Mediator = common.NewMediator()
+	h.Mediator.RegisterMiddleware(common.PlayerTokenMiddleware(h.PlayerRepo))
+
+	h.MarketScanner = ship.NewMarketScanner(h.API, h.MarketRepo, h.PlayerRepo, persistence.NewGormMarketPriceHistoryRepository(db)).
+		WithCapabilityRecorder(h.WaypointRepo)
+	h.RouteExecutor = ship.NewRouteExecutor(h.ShipRepo, h.Mediator, nil, h.MarketScanner, nil, nil, h.WaypointRepo, h.ShipEvents)
+	h.RouteExecutor.WithFuelPriceReader(h.MarketRepo)
+
+	h.Core, err = wiring.RegisterCoreHandlers(h.Mediator, wiring.CoreDependencies{
+		DB:              db,
+		ShipRepo:        h.ShipRepo,
+		PlayerRepo:      h.PlayerRepo,
+		WaypointRepo:    h.WaypointRepo,
+		MarketRepo:      h.MarketRepo,
+		TransactionRepo: h.TransactionRepo,
+		APIClient:       h.API,
+		RoutingClient:   h.Routing,
+		GraphService:    h.GraphService,
+		MarketScanner:   h.MarketScanner,
+		RouteExecutor:   h.RouteExecutor,
+	})
+	require.NoError(t, err)
+	return h
+}
+
+// Context carries the seeded player's token, as the daemon's mediator
+// middleware would attach it, for calls made around the mediator.
+func (h *Harness) Context() context.Context {
+	return auth.WithPlayerToken(context.Background(), Token)
+}
+
+// Send dispatches request through the mediator and fails the test on error.
+func (h *Harness) Send(request common.Request) common.Response {
+	h.t.Helper()
+	resp, err := h.Mediator.Send(h.Context(), request)
+	require.NoError(h.t, err)
+	return resp
+}
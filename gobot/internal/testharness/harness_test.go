@@ -0,0 +1,88 @@
+package testharness_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	shipCargo "github.com/andrescamacho/spacetraders-go/internal/application/ship/commands/cargo"
+	shipNav "github.com/andrescamacho/spacetraders-go/internal/application/ship/commands/navigation"
+	"github.com/andrescamacho/spacetraders-go/internal/domain/ledger"
+	"github.com/andrescamacho/spacetraders-go/internal/domain/navigation"
+	"github.com/andrescamacho/spacetraders-go/internal/testharness"
+)
+
+func sellTransactions(t *testing.T, h *testharness.Harness) []*ledger.Transaction {
+	t.Helper()
+	txs, err := h.TransactionRepo.FindByPlayer(context.Background(), testharness.PlayerID, ledger.QueryOptions{})
+	require.NoError(t, err)
+	var sells []*ledger.Transaction
+	for _, tx := range txs {
+		if tx.TransactionType() == ledger.TransactionTypeSellCargo {
+			sells = append(sells, tx)
+		}
+	}
+	return sells
+}
+
+func TestHarness_SellCargoPaysTheBidAndRecordsTheSale(t *testing.T) {
+	h := testharness.New(t)
+	h.SeedWaypoint("X1-T-A1", 0, 0, "MARKETPLACE")
+	h.SeedMarket("X1-T-A1", testharness.FakeTradeGood{Symbol: "IRON", Bid: 40, Ask: 55, TradeVolume: 10})
+	h.SeedShip(testharness.ShipSpec{Symbol: "T-1", Location: "X1-T-A1", Cargo: map[string]int{"IRON": 15}})
+
+	resp := h.Send(&shipCargo.SellCargoCommand{ShipSymbol: "T-1", GoodSymbol: "IRON", Units: 15, PlayerID: testharness.PlayerID})
+
+	sold := resp.(*shipCargo.SellCargoResponse)
+	require.Equal(t, 15, sold.UnitsSold)
+	require.Equal(t, 600, sold.TotalRevenue)
+	require.Equal(t, 2, sold.TransactionCount, "15 units over a trade volume of 10 is two transactions")
+	require.Equal(t, testharness.StartingCredits+600, h.API.Credits())
+	require.Zero(t, h.Ship("T-1").Cargo().Units)
+
+	revenue := 0
+	for _, tx := range sellTransactions(t, h) {
+		revenue += tx.Amount()
+	}
+	require.Equal(t, 600, revenue, "the ledger records what the market paid")
+}
+
+func TestHarness_NavigateRouteFliesTheShipAndBurnsFuel(t *testing.T) {
+	h := testharness.New(t)
+	h.SeedWaypoint("X1-T-A1", 0, 0, "MARKETPLACE")
+	h.SeedWaypoint("X1-T-B2", 30, 40, "MARKETPLACE")
+	h.SeedShip(testharness.ShipSpec{Symbol: "T-1", Location: "X1-T-A1"})
+
+	h.Send(&shipNav.NavigateRouteCommand{ShipSymbol: "T-1", Destination: "X1-T-B2", PlayerID: testharness.PlayerID, PreferCruise: true})
+
+	flown := h.Ship("T-1")
+	require.Equal(t, "X1-T-B2", flown.CurrentLocation().Symbol)
+	require.NotEqual(t, navigation.NavStatusInTransit, flown.NavStatus())
+	server, _ := h.API.Ship("T-1")
+	require.Equal(t, server.FuelCurrent, flown.Fuel().Current, "the database agrees with the server on fuel")
+	require.Less(t, flown.Fuel().Current, 400)
+}
+
+func TestHarness_LadderSellRoutesTheRemainderToTheNextBestMarket(t *testing.T) {
+	h := testharness.New(t)
+	h.SeedWaypoint("X1-T-A1", 0, 0, "MARKETPLACE")
+	h.SeedWaypoint("X1-T-B2", 30, 40, "MARKETPLACE")
+	h.SeedMarket("X1-T-A1", testharness.FakeTradeGood{Symbol: "IRON", Bid: 100, Ask: 120, TradeVolume: 10, BidDropPerUnit: 5})
+	h.SeedMarket("X1-T-B2", testharness.FakeTradeGood{Symbol: "IRON", Bid: 95, Ask: 110, TradeVolume: 20})
+	h.SeedShip(testharness.ShipSpec{Symbol: "T-1", Location: "X1-T-A1", Cargo: map[string]int{"IRON": 30}})
+
+	resp := h.Send(&shipCargo.LadderSellCargoCommand{
+		ShipSymbol: "T-1", GoodSymbol: "IRON", Units: 30, PlayerID: testharness.PlayerID, MinBidFraction: 0.8,
+	})
+
+	// The first chunk at A1 knocks its bid from 100 to 50, under the 80 floor,
+	// so the other 20 units fly to B2 and sell there at 95.
+	out := resp.(*shipCargo.LadderSellCargoResponse)
+	require.Equal(t, 30, out.UnitsSold)
+	require.Equal(t, "X1-T-B2", out.Location)
+	require.Len(t, out.Lots, 2)
+	require.Equal(t, 1000+20*95, out.TotalRevenue)
+	require.Equal(t, testharness.StartingCredits+out.TotalRevenue, h.API.Credits())
+	require.Zero(t, h.Ship("T-1").Cargo().Units)
+}
@@ -0,0 +1,131 @@
+package testharness
+
+import (
+	"sort"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/andrescamacho/spacetraders-go/internal/domain/navigation"
+	"github.com/andrescamacho/spacetraders-go/internal/domain/shared"
+)
+
+// ShipSpec describes a ship to seed. Zero fields take the defaults of a docked
+// light hauler with a full tank.
+type ShipSpec struct {
+	Symbol        string
+	Location      string
+	NavStatus     navigation.NavStatus // default DOCKED
+	FlightMode    string               // default CRUISE
+	FuelCapacity  int                  // default 400
+	Fuel          *int                 // default a full tank
+	CargoCapacity int                  // default 40
+	Cargo         map[string]int
+	EngineSpeed   int    // default 30
+	FrameSymbol   string // default FRAME_LIGHT_FREIGHTER
+	Role          string // default HAULER
+}
+
+// SeedWaypoint adds a waypoint to the fake universe and the waypoint cache.
+func (h *Harness) SeedWaypoint(symbol string, x, y float64, traits ...string) {
+	h.t.Helper()
+	h.API.AddWaypoint(FakeWaypoint{Symbol: symbol, Type: "PLANET", X: x, Y: y, Traits: traits})
+
+	wp, err := shared.NewWaypoint(symbol, x, y)
+	require.NoError(h.t, err)
+	wp.Type = "PLANET"
+	wp.Traits = append(wp.Traits, traits...)
+	wp.ApplyCapabilities(shared.CapabilitiesFromTraits(traits, false))
+	require.NoError(h.t, h.WaypointRepo.Add(h.Context(), wp))
+}
+
+// SeedMarket quotes goods at waypoint on the fake server and records the quote
+// through the daemon's own market scan, as a scout visit would.
+func (h *Harness) SeedMarket(waypoint string, goods ...FakeTradeGood) {
+	h.t.Helper()
+	for i := range goods {
+		if goods[i].Supply == "" {
+			goods[i].Supply = "MODERATE"
+		}
+		if goods[i].Activity == "" {
+			goods[i].Activity = "STRONG"
+		}
+		if goods[i].TradeType == "" {
+			goods[i].TradeType = "EXCHANGE"
+		}
+	}
+	h.API.SetMarket(waypoint, goods...)
+	h.ScanMarket(waypoint)
+}
+
+// ScanMarket re-records waypoint's current fake quote, for tests that move a
+// price and want the daemon to see it.
+func (h *Harness) ScanMarket(waypoint string) {
+	h.t.Helper()
+	require.NoError(h.t, h.MarketScanner.ScanAndSaveMarket(h.Context(), uint(PlayerID.Value()), waypoint))
+}
+
+// SeedShip adds a ship to the fake server and syncs it into the database the
+// way the daemon picks up a newly bought hull.
+func (h *Harness) SeedShip(spec ShipSpec) *navigation.Ship {
+	h.t.Helper()
+	data := &navigation.ShipData{
+		Symbol:        spec.Symbol,
+		Location:      spec.Location,
+		NavStatus:     string(spec.NavStatus),
+		FlightMode:    spec.FlightMode,
+		FuelCapacity:  spec.FuelCapacity,
+		CargoCapacity: spec.CargoCapacity,
+		EngineSpeed:   spec.EngineSpeed,
+		FrameSymbol:   spec.FrameSymbol,
+		Role:          spec.Role,
+	}
+	if data.NavStatus == "" {
+		data.NavStatus = string(navigation.NavStatusDocked)
+	}
+	if data.FlightMode == "" {
+		data.FlightMode = "CRUISE"
+	}
+	if data.FuelCapacity == 0 {
+		data.FuelCapacity = 400
+	}
+	data.FuelCurrent = data.FuelCapacity
+	if spec.Fuel != nil {
+		data.FuelCurrent = *spec.Fuel
+	}
+	if data.CargoCapacity == 0 {
+		data.CargoCapacity = 40
+	}
+	if data.EngineSpeed == 0 {
+		data.EngineSpeed = 30
+	}
+	if data.FrameSymbol == "" {
+		data.FrameSymbol = "FRAME_LIGHT_FREIGHTER"
+	}
+	if data.Role == "" {
+		data.Role = "HAULER"
+	}
+
+	data.Cargo = &navigation.CargoData{Capacity: data.CargoCapacity}
+	goods := make([]string, 0, len(spec.Cargo))
+	for good := range spec.Cargo {
+		goods = append(goods, good)
+	}
+	sort.Strings(goods)
+	for _, good := range goods {
+		addCargo(data, good, spec.Cargo[good])
+	}
+	require.LessOrEqual(h.t, data.Cargo.Units, data.CargoCapacity, "seeded cargo overfills %s", spec.Symbol)
+
+	h.API.AddShip(data)
+	s, err := h.ShipRepo.SyncShipFromAPI(h.Context(), spec.Symbol, PlayerID)
+	require.NoError(h.t, err)
+	return s
+}
+
+// Ship reads the ship's current state from the database.
+func (h *Harness) Ship(symbol string) *navigation.Ship {
+	h.t.Helper()
+	s, err := h.ShipRepo.FindBySymbol(h.Context(), symbol, PlayerID)
+	require.NoError(h.t, err)
+	return s
+}
@@ -0,0 +1,6 @@
+-- Rollback: remove container attribution from ledger transactions.
+
+DROP INDEX IF EXISTS idx_transactions_operation_type;
+DROP INDEX IF EXISTS idx_transactions_container;
+
+ALTER TABLE transactions DROP COLUMN IF EXISTS container_id;
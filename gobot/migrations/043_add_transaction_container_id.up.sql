@@ -0,0 +1,24 @@
+-- Attribute every ledger transaction to the container whose operation generated it.
+--
+-- operation_type already records WHAT kind of work produced a cashflow (contract,
+-- arbitrage, mining, ...), but not WHICH container did it. Cargo and refuel rows
+-- stashed the container in related_entity_id, while contract rows use that slot
+-- for the contract id, so per-container P&L could not be reconstructed uniformly.
+-- container_id is a dedicated column, populated from the operation context for
+-- every recorder; empty for manual (CLI) transactions and for rows recorded
+-- before this migration.
+--
+-- Additive and idempotent: GORM AutoMigrate adds the same column at daemon boot,
+-- this file is the durable record so it never depends on AutoMigrate succeeding.
+ALTER TABLE transactions ADD COLUMN IF NOT EXISTS container_id VARCHAR(100) DEFAULT '';
+
+-- Backfill from the legacy related_entity slot where cargo/refuel rows put it.
+UPDATE transactions
+   SET container_id = related_entity_id
+ WHERE related_entity_type = 'container'
+   AND (container_id IS NULL OR container_id = '');
+
+CREATE INDEX IF NOT EXISTS idx_transactions_container ON transactions(container_id);
+CREATE INDEX IF NOT EXISTS idx_transactions_operation_type ON transactions(player_id, operation_type);
+
+COMMENT ON COLUMN transactions.container_id IS 'Container whose operation generated the cashflow; empty for manual transactions';
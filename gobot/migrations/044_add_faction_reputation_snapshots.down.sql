@@ -0,0 +1,4 @@
+-- Rollback: drop the faction reputation history. Standings rebuild from the next
+-- captures, so the drop only resets the gain window.
+DROP INDEX IF EXISTS idx_faction_reputation_player_time;
+DROP TABLE IF EXISTS faction_reputation_snapshots;
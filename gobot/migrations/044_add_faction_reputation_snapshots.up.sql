@@ -0,0 +1,20 @@
+-- Faction reputation snapshots: one row per (player, faction, capture) read from
+-- GET /my/factions. Append-only history — the faction-standing query derives each
+-- faction's latest reputation and its gain over a window, which the contract
+-- coordinator uses to prefer contracts from factions we are building reputation
+-- with. A snapshot is captured after every contract fulfillment.
+--
+-- GORM AutoMigrate at daemon boot also creates this table, but boot AutoMigrate
+-- is best-effort and NON-FATAL, so this migration is the durable record and makes
+-- the table checkable by TestModelColumnsBackedByMigrations. Idempotent via
+-- IF NOT EXISTS.
+CREATE TABLE IF NOT EXISTS faction_reputation_snapshots (
+    id             BIGSERIAL    PRIMARY KEY,
+    player_id      BIGINT       NOT NULL,
+    faction_symbol VARCHAR(64)  NOT NULL,
+    reputation     BIGINT       NOT NULL,
+    captured_at    TIMESTAMPTZ  NOT NULL
+);
+
+-- Standing reads are per player over a recent window.
+CREATE INDEX IF NOT EXISTS idx_faction_reputation_player_time ON faction_reputation_snapshots(player_id, captured_at);
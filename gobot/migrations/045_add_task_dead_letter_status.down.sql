@@ -0,0 +1,17 @@
+-- Revert task status constraint to the 018 set. Dead-lettered rows become FAILED
+-- (their retry budget is spent, so they stay terminal) to satisfy the old constraint.
+
+UPDATE manufacturing_tasks SET status = 'FAILED' WHERE status = 'DEAD_LETTER';
+
+ALTER TABLE manufacturing_tasks
+    DROP CONSTRAINT IF EXISTS valid_task_status;
+
+ALTER TABLE manufacturing_tasks
+    ADD CONSTRAINT valid_task_status CHECK (status IN (
+        'PENDING',
+        'READY',
+        'ASSIGNED',
+        'EXECUTING',
+        'COMPLETED',
+        'FAILED'
+    ));
@@ -0,0 +1,19 @@
+-- Extend manufacturing_tasks valid_task_status for the stuck-task watchdog.
+-- A task reclaimed from a dead worker once too often is parked as DEAD_LETTER
+-- (internal/domain/manufacturing/task.go) instead of being handed to yet another
+-- ship; without this the watchdog's write fails with SQLSTATE 23514.
+
+ALTER TABLE manufacturing_tasks
+    DROP CONSTRAINT IF EXISTS valid_task_status;
+
+-- (the 018 statuses are carried over unchanged)
+ALTER TABLE manufacturing_tasks
+    ADD CONSTRAINT valid_task_status CHECK (status IN (
+        'PENDING',
+        'READY',
+        'ASSIGNED',
+        'EXECUTING',
+        'COMPLETED',
+        'FAILED',
+        'DEAD_LETTER'
+    ));
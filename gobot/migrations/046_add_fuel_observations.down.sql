@@ -0,0 +1,4 @@
+-- Rollback: drop the fuel observation history. Calibration falls back to the
+-- theoretical formulas until enough new observations accumulate.
+DROP INDEX IF EXISTS idx_fuel_observations_mode_time;
+DROP TABLE IF EXISTS fuel_observations;
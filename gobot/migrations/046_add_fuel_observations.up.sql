@@ -0,0 +1,22 @@
+-- Fuel observations: one row per navigation, pairing the theoretical fuel cost
+-- (FlightMode.FuelCost for the leg's distance) with what the API actually
+-- consumed. The fuel calibration service fits a per-flight-mode correction
+-- factor from the most recent rows and feeds it back into ShipFuelService, so
+-- refuel planning tracks observed consumption instead of the formula alone.
+--
+-- GORM AutoMigrate at daemon boot also creates this table, but boot AutoMigrate
+-- is best-effort and NON-FATAL, so this migration is the durable record and makes
+-- the table checkable by TestModelColumnsBackedByMigrations. Idempotent via
+-- IF NOT EXISTS.
+CREATE TABLE IF NOT EXISTS fuel_observations (
+    id          BIGSERIAL        PRIMARY KEY,
+    ship_symbol VARCHAR(64)      NOT NULL,
+    flight_mode VARCHAR(16)      NOT NULL,
+    distance    DOUBLE PRECISION NOT NULL,
+    predicted   INTEGER          NOT NULL,
+    actual      INTEGER          NOT NULL,
+    observed_at TIMESTAMPTZ      NOT NULL
+);
+
+-- Fits read the newest observations of one flight mode.
+CREATE INDEX IF NOT EXISTS idx_fuel_observations_mode_time ON fuel_observations(flight_mode, observed_at);
@@ -0,0 +1,21 @@
+-- Rollback: restore migration 039's category_is_f_type without the SCRAP_SHIP branch.
+-- Existing SCRAP_SHIP rows still validate (the CASE returns NULL for them), they are just
+-- no longer enforced.
+
+ALTER TABLE transactions
+    DROP CONSTRAINT IF EXISTS category_is_f_type;
+
+ALTER TABLE transactions
+    ADD CONSTRAINT category_is_f_type CHECK (
+        category = CASE transaction_type
+            WHEN 'REFUEL'             THEN 'FUEL_COSTS'
+            WHEN 'PURCHASE_CARGO'     THEN 'TRADING_COSTS'
+            WHEN 'SELL_CARGO'         THEN 'TRADING_REVENUE'
+            WHEN 'PURCHASE_SHIP'      THEN 'SHIP_INVESTMENTS'
+            WHEN 'CONTRACT_ACCEPTED'  THEN 'CONTRACT_REVENUE'
+            WHEN 'CONTRACT_FULFILLED' THEN 'CONTRACT_REVENUE'
+        END
+    ) NOT VALID;
+
+ALTER TABLE transactions
+    VALIDATE CONSTRAINT category_is_f_type;
@@ -0,0 +1,29 @@
+-- Extend category_is_f_type (migration 039) with SCRAP_SHIP -> SHIP_INVESTMENTS.
+--
+-- Scrapping a ship refunds part of its value; the ledger books it as a positive
+-- SHIP_INVESTMENTS row so capex-excluding reads (briefing slope, cashflow alerts) treat
+-- the refund like the purchase it partially reverses. Without this branch the CASE
+-- returns NULL for SCRAP_SHIP and the CHECK would silently stop enforcing the type.
+--
+-- Same lock profile and re-runnable shape as 039. Every WHEN branch mirrors
+-- ledger.TypeToCategoryMap; schema_category_constraint_drift_test.go reads this file as
+-- the effective definition.
+
+ALTER TABLE transactions
+    DROP CONSTRAINT IF EXISTS category_is_f_type;
+
+ALTER TABLE transactions
+    ADD CONSTRAINT category_is_f_type CHECK (
+        category = CASE transaction_type
+            WHEN 'REFUEL'             THEN 'FUEL_COSTS'
+            WHEN 'PURCHASE_CARGO'     THEN 'TRADING_COSTS'
+            WHEN 'SELL_CARGO'         THEN 'TRADING_REVENUE'
+            WHEN 'PURCHASE_SHIP'      THEN 'SHIP_INVESTMENTS'
+            WHEN 'SCRAP_SHIP'         THEN 'SHIP_INVESTMENTS'
+            WHEN 'CONTRACT_ACCEPTED'  THEN 'CONTRACT_REVENUE'
+            WHEN 'CONTRACT_FULFILLED' THEN 'CONTRACT_REVENUE'
+        END
+    ) NOT VALID;
+
+ALTER TABLE transactions
+    VALIDATE CONSTRAINT category_is_f_type;
@@ -0,0 +1,4 @@
+-- Rollback: drop the waypoint capability columns. has_fuel reverts to the
+-- trait-only reading.
+ALTER TABLE waypoints DROP COLUMN IF EXISTS fuel_availability;
+ALTER TABLE waypoints DROP COLUMN IF EXISTS under_construction;
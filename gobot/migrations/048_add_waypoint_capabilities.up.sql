@@ -0,0 +1,14 @@
+-- Waypoint capabilities: persist what is known about fuel at a waypoint and
+-- whether it is still under construction.
+--
+-- has_fuel was derived from traits alone (MARKETPLACE or FUEL_STATION), so a
+-- marketplace that does not trade FUEL was still planned as a refuel stop.
+-- fuel_availability grades it: IMPLIED (trait only), VERIFIED (scanned market
+-- lists FUEL) or ABSENT (scanned market has no FUEL). Rows written before this
+-- column keep '' and re-derive the grade from traits on read. Market-derived
+-- grades survive a waypoint re-sync.
+--
+-- Additive; GORM AutoMigrate also adds these columns, this is the durable
+-- record for the column-drift gate. Idempotent via IF NOT EXISTS.
+ALTER TABLE waypoints ADD COLUMN IF NOT EXISTS fuel_availability VARCHAR(16) NOT NULL DEFAULT '';
+ALTER TABLE waypoints ADD COLUMN IF NOT EXISTS under_construction BOOLEAN NOT NULL DEFAULT FALSE;
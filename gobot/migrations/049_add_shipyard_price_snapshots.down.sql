@@ -0,0 +1,4 @@
+-- Rollback: drop the shipyard price history. The current price per yard stays
+-- in shipyard_inventory; only the history is lost.
+DROP INDEX IF EXISTS idx_shipyard_price_snapshots_type_time;
+DROP TABLE IF EXISTS shipyard_price_snapshots;
@@ -0,0 +1,23 @@
+-- Shipyard price snapshots: one row per observed (player, waypoint, ship type)
+-- price. shipyard_inventory keeps only the latest scan per yard; this table is
+-- the append-only history behind the ship price history query and the batch
+-- purchase's wait-for-dip mode. Rows are written by the scout-piggybacked
+-- shipyard scan and by live shipyard listing reads.
+--
+-- GORM AutoMigrate at daemon boot also creates this table, but boot AutoMigrate
+-- is best-effort and NON-FATAL, so this migration is the durable record and makes
+-- the table checkable by TestModelColumnsBackedByMigrations. Idempotent via
+-- IF NOT EXISTS.
+CREATE TABLE IF NOT EXISTS shipyard_price_snapshots (
+    id              BIGSERIAL    PRIMARY KEY,
+    player_id       BIGINT       NOT NULL,
+    system_symbol   VARCHAR(32)  NOT NULL,
+    waypoint_symbol VARCHAR(64)  NOT NULL,
+    ship_type       VARCHAR(64)  NOT NULL,
+    purchase_price  INTEGER      NOT NULL,
+    supply          VARCHAR(20)  NOT NULL DEFAULT '',
+    observed_at     TIMESTAMPTZ  NOT NULL
+);
+
+-- History reads are per player and ship type over a recent window.
+CREATE INDEX IF NOT EXISTS idx_shipyard_price_snapshots_type_time ON shipyard_price_snapshots(player_id, ship_type, observed_at);
@@ -0,0 +1,4 @@
+-- Rollback: drop the command audit trail.
+DROP INDEX IF EXISTS idx_command_audit_ship_time;
+DROP INDEX IF EXISTS idx_command_audit_player_time;
+DROP TABLE IF EXISTS command_audit;
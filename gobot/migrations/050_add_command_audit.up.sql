@@ -0,0 +1,26 @@
+-- Command audit: one row per command dispatched through the daemon mediator —
+-- type, a bounded JSON payload summary, the originating container, duration and
+-- outcome. Written asynchronously by the command audit middleware; rows older
+-- than the configured retention are deleted by its sweep.
+--
+-- GORM AutoMigrate at daemon boot also creates this table, but boot AutoMigrate
+-- is best-effort and NON-FATAL, so this migration is the durable record and makes
+-- the table checkable by TestModelColumnsBackedByMigrations. Idempotent via
+-- IF NOT EXISTS.
+CREATE TABLE IF NOT EXISTS command_audit (
+    id           BIGSERIAL     PRIMARY KEY,
+    player_id    BIGINT        NOT NULL DEFAULT 0,
+    command_type VARCHAR(128)  NOT NULL,
+    ship_symbol  VARCHAR(64)   NOT NULL DEFAULT '',
+    container_id VARCHAR(255)  NOT NULL DEFAULT '',
+    payload      TEXT          NOT NULL DEFAULT '',
+    started_at   TIMESTAMPTZ   NOT NULL,
+    duration_ms  BIGINT        NOT NULL DEFAULT 0,
+    success      BOOLEAN       NOT NULL,
+    error        TEXT          NOT NULL DEFAULT ''
+);
+
+-- Audit reads are per player or per ship over a recent window; retention
+-- deletes by started_at.
+CREATE INDEX IF NOT EXISTS idx_command_audit_player_time ON command_audit(player_id, started_at);
+CREATE INDEX IF NOT EXISTS idx_command_audit_ship_time ON command_audit(ship_symbol, started_at);
@@ -0,0 +1,31 @@
+-- Extend category_is_f_type (migration 047) with BALANCE_ADJUSTMENT -> BALANCE_ADJUSTMENTS.
+--
+-- Credit reconciliation compares the API's agent credits with the ledger's running
+-- balance and books any gap as a BALANCE_ADJUSTMENT row (signed: positive when the API
+-- holds more than the ledger). The category is its own so P&L and cashflow reads can
+-- tell captured cashflow from the leak. Without this branch the CASE returns NULL for
+-- BALANCE_ADJUSTMENT and the CHECK would silently stop enforcing the type.
+--
+-- Same lock profile and re-runnable shape as 039. Every WHEN branch mirrors
+-- ledger.TypeToCategoryMap; schema_category_constraint_drift_test.go reads this file as
+-- the effective definition.
+
+ALTER TABLE transactions
+    DROP CONSTRAINT IF EXISTS category_is_f_type;
+
+ALTER TABLE transactions
+    ADD CONSTRAINT category_is_f_type CHECK (
+        category = CASE transaction_type
+            WHEN 'REFUEL'             THEN 'FUEL_COSTS'
+            WHEN 'PURCHASE_CARGO'     THEN 'TRADING_COSTS'
+            WHEN 'SELL_CARGO'         THEN 'TRADING_REVENUE'
+            WHEN 'PURCHASE_SHIP'      THEN 'SHIP_INVESTMENTS'
+            WHEN 'SCRAP_SHIP'         THEN 'SHIP_INVESTMENTS'
+            WHEN 'CONTRACT_ACCEPTED'  THEN 'CONTRACT_REVENUE'
+            WHEN 'CONTRACT_FULFILLED' THEN 'CONTRACT_REVENUE'
+            WHEN 'BALANCE_ADJUSTMENT' THEN 'BALANCE_ADJUSTMENTS'
+        END
+    ) NOT VALID;
+
+ALTER TABLE transactions
+    VALIDATE CONSTRAINT category_is_f_type;
@@ -0,0 +1,8 @@
+-- Rollback: remove the ship component wear columns.
+
+ALTER TABLE ships DROP COLUMN IF EXISTS frame_condition;
+ALTER TABLE ships DROP COLUMN IF EXISTS frame_integrity;
+ALTER TABLE ships DROP COLUMN IF EXISTS reactor_condition;
+ALTER TABLE ships DROP COLUMN IF EXISTS reactor_integrity;
+ALTER TABLE ships DROP COLUMN IF EXISTS engine_condition;
+ALTER TABLE ships DROP COLUMN IF EXISTS engine_integrity;
@@ -0,0 +1,17 @@
+-- Persist ship component wear.
+--
+-- The API reports condition and integrity (0-1) on every ship's frame, reactor and
+-- engine. Condition falls with travel and extraction and a shipyard repair restores
+-- it; integrity is the ceiling a repair restores to and only ever falls. ship_dto.go
+-- used to drop both, so nothing could see a hull wearing out until it started failing.
+-- These columns hold the last synced values and feed the repair threshold the
+-- coordinators check. All zero for a ship that has not been synced since.
+--
+-- Additive, no constraints: GORM AutoMigrate also adds them at boot, this migration is
+-- the durable record (see 040). Idempotent via IF NOT EXISTS.
+ALTER TABLE ships ADD COLUMN IF NOT EXISTS frame_condition DOUBLE PRECISION DEFAULT 0;
+ALTER TABLE ships ADD COLUMN IF NOT EXISTS frame_integrity DOUBLE PRECISION DEFAULT 0;
+ALTER TABLE ships ADD COLUMN IF NOT EXISTS reactor_condition DOUBLE PRECISION DEFAULT 0;
+ALTER TABLE ships ADD COLUMN IF NOT EXISTS reactor_integrity DOUBLE PRECISION DEFAULT 0;
+ALTER TABLE ships ADD COLUMN IF NOT EXISTS engine_condition DOUBLE PRECISION DEFAULT 0;
+ALTER TABLE ships ADD COLUMN IF NOT EXISTS engine_integrity DOUBLE PRECISION DEFAULT 0;
@@ -0,0 +1,29 @@
+-- Extend category_is_f_type (migration 051) with REPAIR_SHIP -> MAINTENANCE_COSTS.
+--
+-- Shipyard repairs of worn ships are booked as REPAIR_SHIP rows. They are an
+-- operating cost like fuel, not an investment, so they get their own category.
+--
+-- Same lock profile and re-runnable shape as 051. Every WHEN branch mirrors
+-- ledger.TypeToCategoryMap; schema_category_constraint_drift_test.go reads this file as
+-- the effective definition.
+
+ALTER TABLE transactions
+    DROP CONSTRAINT IF EXISTS category_is_f_type;
+
+ALTER TABLE transactions
+    ADD CONSTRAINT category_is_f_type CHECK (
+        category = CASE transaction_type
+            WHEN 'REFUEL'             THEN 'FUEL_COSTS'
+            WHEN 'PURCHASE_CARGO'     THEN 'TRADING_COSTS'
+            WHEN 'SELL_CARGO'         THEN 'TRADING_REVENUE'
+            WHEN 'PURCHASE_SHIP'      THEN 'SHIP_INVESTMENTS'
+            WHEN 'SCRAP_SHIP'         THEN 'SHIP_INVESTMENTS'
+            WHEN 'CONTRACT_ACCEPTED'  THEN 'CONTRACT_REVENUE'
+            WHEN 'CONTRACT_FULFILLED' THEN 'CONTRACT_REVENUE'
+            WHEN 'BALANCE_ADJUSTMENT' THEN 'BALANCE_ADJUSTMENTS'
+            WHEN 'REPAIR_SHIP'        THEN 'MAINTENANCE_COSTS'
+        END
+    ) NOT VALID;
+
+ALTER TABLE transactions
+    VALIDATE CONSTRAINT category_is_f_type;
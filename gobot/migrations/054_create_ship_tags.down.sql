@@ -0,0 +1,3 @@
+-- Rollback: drop ship tags.
+DROP INDEX IF EXISTS idx_ship_tags_player_tag;
+DROP TABLE IF EXISTS ship_tags;
@@ -0,0 +1,18 @@
+-- Ship tags: operator-defined labels on ships ("fast-haulers", "gate-probes").
+-- A ship may wear any number of tags; coordinators that take a ship list also
+-- take a tag and resolve it against this table, so an operator can slice the
+-- fleet logically instead of maintaining symbol lists. Tags carry no ownership —
+-- dedication stays on ships.dedicated_fleet.
+--
+-- GORM AutoMigrate at daemon boot also creates this table; this migration is the
+-- durable record (see 050). Idempotent via IF NOT EXISTS.
+CREATE TABLE IF NOT EXISTS ship_tags (
+    player_id   BIGINT       NOT NULL,
+    ship_symbol VARCHAR(64)  NOT NULL,
+    tag         VARCHAR(64)  NOT NULL,
+    created_at  TIMESTAMPTZ  NOT NULL,
+    PRIMARY KEY (player_id, ship_symbol, tag)
+);
+
+-- Selectors resolve a tag to its ships per player.
+CREATE INDEX IF NOT EXISTS idx_ship_tags_player_tag ON ship_tags(player_id, tag);
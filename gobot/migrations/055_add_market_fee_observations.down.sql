@@ -0,0 +1,4 @@
+-- Rollback: drop the market fee history. Profitability estimates treat every
+-- market as fee-free until enough new observations accumulate.
+DROP INDEX IF EXISTS idx_market_fee_observations_time;
+DROP TABLE IF EXISTS market_fee_observations;
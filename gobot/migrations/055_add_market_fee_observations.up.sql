@@ -0,0 +1,22 @@
+-- Market fee observations: one row per cargo transaction priced against a fresh
+-- cached quote, pairing the total the quote implied (units × ask or bid) with
+-- the total the API actually charged or paid. The market fee service fits a
+-- per-waypoint fee rate from the most recent rows, and arbitrage lane ranking
+-- and contract profitability charge it, so a thin spread that fees would eat is
+-- not traded.
+--
+-- GORM AutoMigrate at daemon boot also creates this table; this migration is the
+-- durable record (see 046). Idempotent via IF NOT EXISTS.
+CREATE TABLE IF NOT EXISTS market_fee_observations (
+    id               BIGSERIAL    PRIMARY KEY,
+    waypoint_symbol  VARCHAR(64)  NOT NULL,
+    good_symbol      VARCHAR(64)  NOT NULL,
+    transaction_type VARCHAR(16)  NOT NULL,
+    units            INTEGER      NOT NULL,
+    expected         INTEGER      NOT NULL,
+    actual           INTEGER      NOT NULL,
+    observed_at      TIMESTAMPTZ  NOT NULL
+);
+
+-- Fits read the newest observations across every market.
+CREATE INDEX IF NOT EXISTS idx_market_fee_observations_time ON market_fee_observations(observed_at);
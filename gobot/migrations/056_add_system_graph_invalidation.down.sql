@@ -0,0 +1,4 @@
+-- Rollback: remove the system graph invalidation stamp. Graphs cached before a
+-- reset are trusted again until rebuilt.
+
+ALTER TABLE system_graphs DROP COLUMN IF EXISTS invalidated_at;
@@ -0,0 +1,11 @@
+-- Flag cached system graphs invalidated by a server reset.
+--
+-- A SpaceTraders reset regenerates the universe, so a graph cached before it no
+-- longer matches the waypoints it describes. The daemon's status watcher stamps
+-- invalidated_at on every cached graph when it detects a reset; an invalidated
+-- graph reads as a cache miss and is rebuilt from the API, and the rebuild
+-- clears the stamp. NULL for every graph cached since the last reset.
+--
+-- Additive, no constraints: GORM AutoMigrate also adds it at boot, this migration
+-- is the durable record (see 040). Idempotent via IF NOT EXISTS.
+ALTER TABLE system_graphs ADD COLUMN IF NOT EXISTS invalidated_at TIMESTAMPTZ;
@@ -0,0 +1,3 @@
+-- Rollback: drop the cargo cost basis. Sell floors fall back to the ledger's
+-- purchase rows.
+DROP TABLE IF EXISTS cargo_cost_basis;
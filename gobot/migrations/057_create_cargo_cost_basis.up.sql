@@ -0,0 +1,20 @@
+-- Per-ship, per-good cargo cost basis.
+--
+-- One row per (player, ship, good) holding the units the tracker has seen arrive
+-- and what they cost in all, carried at weighted average. Purchases add their
+-- total, extracted and siphoned units add nothing, transfers move their average
+-- share to the receiving ship, and sales and jettisons release theirs. The sell
+-- path's cost-relative floor reads it before falling back to the ledger's
+-- purchase rows. A row is deleted when its units reach zero.
+--
+-- GORM AutoMigrate at daemon boot also creates this table; this migration is the
+-- durable record (see 046). Idempotent via IF NOT EXISTS.
+CREATE TABLE IF NOT EXISTS cargo_cost_basis (
+    player_id   INTEGER      NOT NULL,
+    ship_symbol VARCHAR(64)  NOT NULL,
+    good_symbol VARCHAR(64)  NOT NULL,
+    units       INTEGER      NOT NULL,
+    total_cost  INTEGER      NOT NULL,
+    updated_at  TIMESTAMPTZ  NOT NULL,
+    PRIMARY KEY (player_id, ship_symbol, good_symbol)
+);
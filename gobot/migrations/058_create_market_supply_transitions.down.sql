@@ -0,0 +1,4 @@
+-- Rollback: drop the supply transition history. The stats query reports no
+-- transitions until new scans record them.
+DROP INDEX IF EXISTS idx_market_supply_transitions_player_time;
+DROP TABLE IF EXISTS market_supply_transitions;
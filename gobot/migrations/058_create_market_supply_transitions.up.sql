@@ -0,0 +1,21 @@
+-- Market supply transitions: one row each time a scan reports a good's supply
+-- at a different level than the previous scan of that market (SCARCE → LIMITED
+-- → MODERATE → HIGH → ABUNDANT and back). The supply transition stats query
+-- reads them to report how often each (market, good) changes level and how
+-- long it dwells at each, the data behind the supply monitor's poll interval
+-- and HIGH gating.
+--
+-- GORM AutoMigrate at daemon boot also creates this table; this migration is the
+-- durable record (see 046). Idempotent via IF NOT EXISTS.
+CREATE TABLE IF NOT EXISTS market_supply_transitions (
+    id               BIGSERIAL    PRIMARY KEY,
+    player_id        INTEGER      NOT NULL,
+    waypoint_symbol  VARCHAR(64)  NOT NULL,
+    good_symbol      VARCHAR(64)  NOT NULL,
+    from_supply      VARCHAR(16)  NOT NULL,
+    to_supply        VARCHAR(16)  NOT NULL,
+    transitioned_at  TIMESTAMPTZ  NOT NULL
+);
+
+-- Stats read one player's transitions over a recent window.
+CREATE INDEX IF NOT EXISTS idx_market_supply_transitions_player_time ON market_supply_transitions(player_id, transitioned_at);
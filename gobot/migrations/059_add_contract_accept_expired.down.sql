@@ -0,0 +1,4 @@
+-- Rollback: remove the lapsed-acceptance flag. Lapsed contracts read as open
+-- offers again until the janitor re-flags them.
+
+ALTER TABLE contracts DROP COLUMN IF EXISTS accept_expired;
@@ -0,0 +1,11 @@
+-- Flag negotiated contracts that lapsed before they were accepted.
+--
+-- A contract negotiated but never accepted stays in the contracts table after
+-- its deadline_to_accept passes, where it can no longer be worked. The daemon's
+-- contract janitor sets accept_expired on such rows, stops any container still
+-- working them and negotiates a replacement for a running contract workflow.
+-- FALSE for every contract still open for acceptance, and for accepted ones.
+--
+-- Additive, defaulted: GORM AutoMigrate also adds it at boot, this migration is
+-- the durable record (see 040). Idempotent via IF NOT EXISTS.
+ALTER TABLE contracts ADD COLUMN IF NOT EXISTS accept_expired BOOLEAN NOT NULL DEFAULT FALSE;
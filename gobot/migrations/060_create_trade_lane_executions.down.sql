@@ -0,0 +1,4 @@
+-- Rollback: drop the lane execution history. The lane stats query reports no
+-- lanes until new sales record them.
+DROP INDEX IF EXISTS idx_trade_lane_executions_player_time;
+DROP TABLE IF EXISTS trade_lane_executions;
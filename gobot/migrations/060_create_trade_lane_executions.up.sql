@@ -0,0 +1,23 @@
+-- Trade lane executions: one row per realized sale along a (buy market, sell
+-- market, good) lane, written by the arb_run and tour coordinators with the buy
+-- cost of exactly the units sold. The trade lane stats query reads them to rank
+-- lanes by realized profit, volume and stability.
+--
+-- GORM AutoMigrate at daemon boot also creates this table; this migration is the
+-- durable record (see 046). Idempotent via IF NOT EXISTS.
+CREATE TABLE IF NOT EXISTS trade_lane_executions (
+    id            BIGSERIAL     PRIMARY KEY,
+    player_id     INTEGER       NOT NULL,
+    container_id  VARCHAR(128),
+    source        VARCHAR(32)   NOT NULL,
+    buy_market    VARCHAR(64)   NOT NULL,
+    sell_market   VARCHAR(64)   NOT NULL,
+    good          VARCHAR(64)   NOT NULL,
+    units         INTEGER       NOT NULL,
+    cost          INTEGER       NOT NULL,
+    revenue       INTEGER       NOT NULL,
+    executed_at   TIMESTAMPTZ   NOT NULL
+);
+
+-- Stats read one player's executions over a recent window.
+CREATE INDEX IF NOT EXISTS idx_trade_lane_executions_player_time ON trade_lane_executions(player_id, executed_at);
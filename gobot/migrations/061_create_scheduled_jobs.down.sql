@@ -0,0 +1,3 @@
+-- Rollback: drop the scheduled jobs. Jobs defined in config.yaml are recreated
+-- at the next daemon boot; jobs added over RPC are lost.
+DROP TABLE IF EXISTS scheduled_jobs;
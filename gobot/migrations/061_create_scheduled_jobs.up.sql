@@ -0,0 +1,22 @@
+-- Scheduled jobs: recurring daemon work (scout tours, ledger reconciliation,
+-- market exports) defined in config.yaml or via the AddScheduledJob RPC. The
+-- daemon's scheduler loop runs each job when next_run_at passes and records the
+-- outcome, so cadence and history survive restarts.
+--
+-- GORM AutoMigrate at daemon boot also creates this table; this migration is the
+-- durable record (see 046). Idempotent via IF NOT EXISTS.
+CREATE TABLE IF NOT EXISTS scheduled_jobs (
+    player_id           INTEGER       NOT NULL,
+    name                VARCHAR(64)   NOT NULL,
+    kind                VARCHAR(32)   NOT NULL,
+    interval_seconds    BIGINT        NOT NULL,
+    params              TEXT,
+    enabled             BOOLEAN       NOT NULL DEFAULT TRUE,
+    last_run_at         TIMESTAMPTZ,
+    next_run_at         TIMESTAMPTZ   NOT NULL,
+    last_status         VARCHAR(16),
+    last_error          TEXT,
+    last_container_ids  TEXT,
+    updated_at          TIMESTAMPTZ   NOT NULL,
+    PRIMARY KEY (player_id, name)
+);
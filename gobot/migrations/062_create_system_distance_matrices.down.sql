@@ -0,0 +1,3 @@
+-- Rollback: drop the distance matrices. Route planning rebuilds each one on
+-- the next route planned in its system.
+DROP TABLE IF EXISTS system_distance_matrices;
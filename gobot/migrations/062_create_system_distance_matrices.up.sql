@@ -0,0 +1,14 @@
+-- System distance matrices: the precomputed distance, fuel and flight time of
+-- every hop between a system's waypoints, one row per (system, engine speed).
+-- Route planning reads them instead of recomputing pairwise distances, and
+-- only recomputes the rows of waypoints that were added or moved.
+--
+-- GORM AutoMigrate at daemon boot also creates this table; this migration is the
+-- durable record (see 046). Idempotent via IF NOT EXISTS.
+CREATE TABLE IF NOT EXISTS system_distance_matrices (
+    system_symbol  VARCHAR(64)  NOT NULL,
+    engine_speed   INTEGER      NOT NULL,
+    matrix_data    TEXT         NOT NULL,
+    updated_at     TIMESTAMPTZ  NOT NULL,
+    PRIMARY KEY (system_symbol, engine_speed)
+);
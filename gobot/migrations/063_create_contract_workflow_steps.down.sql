@@ -0,0 +1,3 @@
+-- Rollback: drop the contract workflow audit trail. Workflows keep running;
+-- only the step history is lost.
+DROP TABLE IF EXISTS contract_workflow_steps;
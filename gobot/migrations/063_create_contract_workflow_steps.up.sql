@@ -0,0 +1,25 @@
+-- Contract workflow audit trail: one row per step of a contract workflow run
+-- (negotiate, accept, purchase, navigate, deliver, fulfill) with the ship,
+-- timestamps, credits moved and outcome. Read back by contract ID for
+-- post-mortems on failed contracts.
+--
+-- GORM AutoMigrate at daemon boot also creates this table; this migration is the
+-- durable record (see 046). Idempotent via IF NOT EXISTS.
+CREATE TABLE IF NOT EXISTS contract_workflow_steps (
+    id            BIGSERIAL     PRIMARY KEY,
+    player_id     BIGINT        NOT NULL,
+    contract_id   VARCHAR(255)  NOT NULL DEFAULT '',
+    container_id  VARCHAR(255)  NOT NULL DEFAULT '',
+    ship_symbol   VARCHAR(64)   NOT NULL DEFAULT '',
+    step          VARCHAR(32)   NOT NULL,
+    outcome       VARCHAR(32)   NOT NULL,
+    waypoint      VARCHAR(64)   NOT NULL DEFAULT '',
+    trade_symbol  VARCHAR(64)   NOT NULL DEFAULT '',
+    units         INTEGER       NOT NULL DEFAULT 0,
+    credits       BIGINT        NOT NULL DEFAULT 0,
+    detail        TEXT          NOT NULL DEFAULT '',
+    started_at    TIMESTAMPTZ   NOT NULL,
+    finished_at   TIMESTAMPTZ   NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS idx_contract_workflow_steps_contract ON contract_workflow_steps(player_id, contract_id);
@@ -0,0 +1,3 @@
+-- Rollback: drop the waypoint blacklist. Every waypoint becomes eligible for
+-- routing, market selection and scouting again.
+DROP TABLE IF EXISTS waypoint_blacklist;
@@ -0,0 +1,16 @@
+-- Waypoint blacklist: waypoints a player's fleet must stay away from (pirates,
+-- API quirks), added by an operator or by the health monitor after repeated
+-- failures. Route planning, market selection and scouting skip a waypoint
+-- while its row is active: expires_at NULL (until cleared) or in the future.
+--
+-- GORM AutoMigrate at daemon boot also creates this table; this migration is the
+-- durable record (see 046). Idempotent via IF NOT EXISTS.
+CREATE TABLE IF NOT EXISTS waypoint_blacklist (
+    player_id        BIGINT        NOT NULL,
+    waypoint_symbol  VARCHAR(64)   NOT NULL,
+    reason           TEXT          NOT NULL DEFAULT '',
+    source           VARCHAR(32)   NOT NULL DEFAULT '',
+    created_at       TIMESTAMPTZ   NOT NULL,
+    expires_at       TIMESTAMPTZ,
+    PRIMARY KEY (player_id, waypoint_symbol)
+);
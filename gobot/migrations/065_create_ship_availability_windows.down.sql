@@ -0,0 +1,3 @@
+-- Rollback: drop the ship availability windows. The shipyard watcher starts a
+-- fresh history on its next read.
+DROP TABLE IF EXISTS ship_availability_windows;
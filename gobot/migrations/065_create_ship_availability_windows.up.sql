@@ -0,0 +1,23 @@
+-- Ship availability windows: each row is one stretch during which a watched
+-- shipyard had a ship type in stock, written by the shipyard watcher. A NULL
+-- closed_at marks the window still open; purchased_at is set once the watcher
+-- bought a ship during it, so one restock triggers at most one purchase.
+--
+-- GORM AutoMigrate at daemon boot also creates this table; this migration is the
+-- durable record (see 046). Idempotent via IF NOT EXISTS.
+CREATE TABLE IF NOT EXISTS ship_availability_windows (
+    id               BIGSERIAL     PRIMARY KEY,
+    player_id        BIGINT        NOT NULL,
+    waypoint_symbol  VARCHAR(64)   NOT NULL,
+    ship_type        VARCHAR(64)   NOT NULL,
+    opened_at        TIMESTAMPTZ   NOT NULL,
+    last_seen_at     TIMESTAMPTZ   NOT NULL,
+    closed_at        TIMESTAMPTZ,
+    open_price       INTEGER       NOT NULL,
+    min_price        INTEGER       NOT NULL,
+    last_price       INTEGER       NOT NULL,
+    purchased_at     TIMESTAMPTZ
+);
+
+CREATE INDEX IF NOT EXISTS idx_ship_availability_windows_yard
+    ON ship_availability_windows (player_id, waypoint_symbol, ship_type);
@@ -0,0 +1,3 @@
+-- Rollback: drop the persisted idempotency keys. Retries after the next restart
+-- execute again, as they did before keys were persisted.
+DROP TABLE IF EXISTS ship_command_idempotency;
@@ -0,0 +1,19 @@
+-- Ship command idempotency: the finished result of each keyed navigation
+-- command, so a caller retrying with the same idempotency key after a daemon
+-- restart gets the original result replayed instead of a second flight. Scope
+-- names the command kind; rows older than the cache ttl are purged at boot.
+--
+-- GORM AutoMigrate at daemon boot also creates this table; this migration is the
+-- durable record (see 046). Idempotent via IF NOT EXISTS.
+CREATE TABLE IF NOT EXISTS ship_command_idempotency (
+    scope            VARCHAR(32)   NOT NULL,
+    idempotency_key  VARCHAR(128)  NOT NULL,
+    ship_symbol      VARCHAR(64)   NOT NULL,
+    destination      VARCHAR(64)   NOT NULL,
+    response         TEXT          NOT NULL,
+    recorded_at      TIMESTAMPTZ   NOT NULL,
+    PRIMARY KEY (scope, idempotency_key)
+);
+
+CREATE INDEX IF NOT EXISTS idx_ship_command_idempotency_recorded
+    ON ship_command_idempotency (scope, recorded_at);
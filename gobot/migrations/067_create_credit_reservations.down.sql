@@ -0,0 +1,3 @@
+-- Rollback: drop the persisted credit reservations. Reservations then live in
+-- memory only and are lost on restart.
+DROP TABLE IF EXISTS credit_reservations;
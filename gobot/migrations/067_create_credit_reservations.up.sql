@@ -0,0 +1,17 @@
+-- Credit reservations: credits a coordinator has earmarked for a purchase it
+-- is about to make, so concurrent buyers reading the same live balance cannot
+-- both spend it. The daemon serves them from memory, writes each one through
+-- here and reloads the unexpired rows at boot; expired rows are purged then.
+--
+-- GORM AutoMigrate at daemon boot also creates this table; this migration is the
+-- durable record (see 046). Idempotent via IF NOT EXISTS.
+CREATE TABLE IF NOT EXISTS credit_reservations (
+    id          VARCHAR(64)   PRIMARY KEY,
+    player_id   BIGINT        NOT NULL,
+    holder      VARCHAR(128)  NOT NULL DEFAULT '',
+    amount      INTEGER       NOT NULL,
+    expires_at  TIMESTAMPTZ   NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS idx_credit_reservations_expires
+    ON credit_reservations (expires_at);
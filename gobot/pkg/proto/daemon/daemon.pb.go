@@ -31,9 +31,16 @@ type NavigateShipRequest struct {
 	// Player ID for authentication
 	PlayerId int32 `protobuf:"varint,3,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"`
 	// Optional agent symbol (alternative to player_id)
-	AgentSymbol   *string `protobuf:"bytes,4,opt,name=agent_symbol,json=agentSymbol,proto3,oneof" json:"agent_symbol,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	AgentSymbol *string `protobuf:"bytes,4,opt,name=agent_symbol,json=agentSymbol,proto3,oneof" json:"agent_symbol,omitempty"`
+	// Optional caller-chosen key. A retry with the same key returns the original
+	// navigation container instead of launching a second one while it is still
+	// running or the ship has already arrived.
+	IdempotencyKey *string `protobuf:"bytes,5,opt,name=idempotency_key,json=idempotencyKey,proto3,oneof" json:"idempotency_key,omitempty"`
+	// Optional fuel reserve for this route, as a percentage of the tank. Unset
+	// keeps the fleet-wide reserve; 0 plans without one.
+	FuelReservePercent *int32 `protobuf:"varint,6,opt,name=fuel_reserve_percent,json=fuelReservePercent,proto3,oneof" json:"fuel_reserve_percent,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
 }
 
 func (x *NavigateShipRequest) Reset() {
@@ -94,6 +101,20 @@ func (x *NavigateShipRequest) GetAgentSymbol() string {
 	return ""
 }
 
+func (x *NavigateShipRequest) GetIdempotencyKey() string {
+	if x != nil && x.IdempotencyKey != nil {
+		return *x.IdempotencyKey
+	}
+	return ""
+}
+
+func (x *NavigateShipRequest) GetFuelReservePercent() int32 {
+	if x != nil && x.FuelReservePercent != nil {
+		return *x.FuelReservePercent
+	}
+	return 0
+}
+
 // NavigateShipResponse returns container ID for tracking
 type NavigateShipResponse struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -2750,32 +2771,33 @@ func (x *BootstrapCoordinatorResponse) GetStatus() string {
 	return ""
 }
 
-// CapacityReconcilerCoordinatorRequest starts the standing capacity reconciler (st-7zk).
-// All calibration lives in config.yaml's [capacity_reconciler] section (resolved live on
-// every build); this request names the player/agent and an optional dry-run override.
-type CapacityReconcilerCoordinatorRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	PlayerId      int32                  `protobuf:"varint,1,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"`
-	AgentSymbol   *string                `protobuf:"bytes,2,opt,name=agent_symbol,json=agentSymbol,proto3,oneof" json:"agent_symbol,omitempty"`
-	DryRun        bool                   `protobuf:"varint,3,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"` // observe + log every decision, actuate nothing (recommended first-start posture)
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+// BootstrapFleetRequest names the fleet template to apply: a template name in the daemon's
+// fleet templates directory or a path to a YAML file.
+type BootstrapFleetRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	PlayerId       int32                  `protobuf:"varint,1,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"`
+	AgentSymbol    *string                `protobuf:"bytes,2,opt,name=agent_symbol,json=agentSymbol,proto3,oneof" json:"agent_symbol,omitempty"`
+	Profile        string                 `protobuf:"bytes,3,opt,name=profile,proto3" json:"profile,omitempty"`
+	PurchasingShip *string                `protobuf:"bytes,4,opt,name=purchasing_ship,json=purchasingShip,proto3,oneof" json:"purchasing_ship,omitempty"` // Flies to the shipyards; unset = an idle hull
+	DryRun         bool                   `protobuf:"varint,5,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
-func (x *CapacityReconcilerCoordinatorRequest) Reset() {
-	*x = CapacityReconcilerCoordinatorRequest{}
+func (x *BootstrapFleetRequest) Reset() {
+	*x = BootstrapFleetRequest{}
 	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[37]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CapacityReconcilerCoordinatorRequest) String() string {
+func (x *BootstrapFleetRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CapacityReconcilerCoordinatorRequest) ProtoMessage() {}
+func (*BootstrapFleetRequest) ProtoMessage() {}
 
-func (x *CapacityReconcilerCoordinatorRequest) ProtoReflect() protoreflect.Message {
+func (x *BootstrapFleetRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[37]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2787,54 +2809,73 @@ func (x *CapacityReconcilerCoordinatorRequest) ProtoReflect() protoreflect.Messa
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CapacityReconcilerCoordinatorRequest.ProtoReflect.Descriptor instead.
-func (*CapacityReconcilerCoordinatorRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use BootstrapFleetRequest.ProtoReflect.Descriptor instead.
+func (*BootstrapFleetRequest) Descriptor() ([]byte, []int) {
 	return file_pkg_proto_daemon_daemon_proto_rawDescGZIP(), []int{37}
 }
 
-func (x *CapacityReconcilerCoordinatorRequest) GetPlayerId() int32 {
+func (x *BootstrapFleetRequest) GetPlayerId() int32 {
 	if x != nil {
 		return x.PlayerId
 	}
 	return 0
 }
 
-func (x *CapacityReconcilerCoordinatorRequest) GetAgentSymbol() string {
+func (x *BootstrapFleetRequest) GetAgentSymbol() string {
 	if x != nil && x.AgentSymbol != nil {
 		return *x.AgentSymbol
 	}
 	return ""
 }
 
-func (x *CapacityReconcilerCoordinatorRequest) GetDryRun() bool {
+func (x *BootstrapFleetRequest) GetProfile() string {
+	if x != nil {
+		return x.Profile
+	}
+	return ""
+}
+
+func (x *BootstrapFleetRequest) GetPurchasingShip() string {
+	if x != nil && x.PurchasingShip != nil {
+		return *x.PurchasingShip
+	}
+	return ""
+}
+
+func (x *BootstrapFleetRequest) GetDryRun() bool {
 	if x != nil {
 		return x.DryRun
 	}
 	return false
 }
 
-type CapacityReconcilerCoordinatorResponse struct {
+// BootstrapFleetResponse carries the container applying the template, or for a dry run the
+// plan: every ship order and container in the order they would be applied.
+type BootstrapFleetResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	ContainerId   string                 `protobuf:"bytes,1,opt,name=container_id,json=containerId,proto3" json:"container_id,omitempty"`
-	Status        string                 `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	ContainerId   string                 `protobuf:"bytes,1,opt,name=container_id,json=containerId,proto3" json:"container_id,omitempty"` // Empty for a dry run
+	Template      string                 `protobuf:"bytes,2,opt,name=template,proto3" json:"template,omitempty"`
+	DryRun        bool                   `protobuf:"varint,3,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+	Purchases     []*FleetPurchasePlan   `protobuf:"bytes,4,rep,name=purchases,proto3" json:"purchases,omitempty"`
+	Containers    []*FleetContainerPlan  `protobuf:"bytes,5,rep,name=containers,proto3" json:"containers,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CapacityReconcilerCoordinatorResponse) Reset() {
-	*x = CapacityReconcilerCoordinatorResponse{}
+func (x *BootstrapFleetResponse) Reset() {
+	*x = BootstrapFleetResponse{}
 	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[38]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CapacityReconcilerCoordinatorResponse) String() string {
+func (x *BootstrapFleetResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CapacityReconcilerCoordinatorResponse) ProtoMessage() {}
+func (*BootstrapFleetResponse) ProtoMessage() {}
 
-func (x *CapacityReconcilerCoordinatorResponse) ProtoReflect() protoreflect.Message {
+func (x *BootstrapFleetResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[38]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2846,51 +2887,72 @@ func (x *CapacityReconcilerCoordinatorResponse) ProtoReflect() protoreflect.Mess
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CapacityReconcilerCoordinatorResponse.ProtoReflect.Descriptor instead.
-func (*CapacityReconcilerCoordinatorResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use BootstrapFleetResponse.ProtoReflect.Descriptor instead.
+func (*BootstrapFleetResponse) Descriptor() ([]byte, []int) {
 	return file_pkg_proto_daemon_daemon_proto_rawDescGZIP(), []int{38}
 }
 
-func (x *CapacityReconcilerCoordinatorResponse) GetContainerId() string {
+func (x *BootstrapFleetResponse) GetContainerId() string {
 	if x != nil {
 		return x.ContainerId
 	}
 	return ""
 }
 
-func (x *CapacityReconcilerCoordinatorResponse) GetStatus() string {
+func (x *BootstrapFleetResponse) GetTemplate() string {
 	if x != nil {
-		return x.Status
+		return x.Template
 	}
 	return ""
 }
 
-// AutoOutfitCoordinatorRequest starts the standing guarded auto-outfit coordinator
-// (sp-buyd). All calibration is live-tunable via `tune --operation autooutfit`; this
-// request names the player/agent and an optional dry-run override.
-type AutoOutfitCoordinatorRequest struct {
+func (x *BootstrapFleetResponse) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+func (x *BootstrapFleetResponse) GetPurchases() []*FleetPurchasePlan {
+	if x != nil {
+		return x.Purchases
+	}
+	return nil
+}
+
+func (x *BootstrapFleetResponse) GetContainers() []*FleetContainerPlan {
+	if x != nil {
+		return x.Containers
+	}
+	return nil
+}
+
+type FleetPurchasePlan struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	PlayerId      int32                  `protobuf:"varint,1,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"`
-	AgentSymbol   *string                `protobuf:"bytes,2,opt,name=agent_symbol,json=agentSymbol,proto3,oneof" json:"agent_symbol,omitempty"`
-	DryRun        bool                   `protobuf:"varint,3,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"` // observe + log every WOULD-install, actuate nothing (recommended first-start posture)
+	ShipType      string                 `protobuf:"bytes,1,opt,name=ship_type,json=shipType,proto3" json:"ship_type,omitempty"`
+	Wanted        int32                  `protobuf:"varint,2,opt,name=wanted,proto3" json:"wanted,omitempty"`
+	Owned         int32                  `protobuf:"varint,3,opt,name=owned,proto3" json:"owned,omitempty"`
+	Bought        int32                  `protobuf:"varint,4,opt,name=bought,proto3" json:"bought,omitempty"`
+	Cost          int64                  `protobuf:"varint,5,opt,name=cost,proto3" json:"cost,omitempty"`
+	Error         string                 `protobuf:"bytes,6,opt,name=error,proto3" json:"error,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *AutoOutfitCoordinatorRequest) Reset() {
-	*x = AutoOutfitCoordinatorRequest{}
+func (x *FleetPurchasePlan) Reset() {
+	*x = FleetPurchasePlan{}
 	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[39]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *AutoOutfitCoordinatorRequest) String() string {
+func (x *FleetPurchasePlan) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AutoOutfitCoordinatorRequest) ProtoMessage() {}
+func (*FleetPurchasePlan) ProtoMessage() {}
 
-func (x *AutoOutfitCoordinatorRequest) ProtoReflect() protoreflect.Message {
+func (x *FleetPurchasePlan) ProtoReflect() protoreflect.Message {
 	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[39]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2902,54 +2964,78 @@ func (x *AutoOutfitCoordinatorRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AutoOutfitCoordinatorRequest.ProtoReflect.Descriptor instead.
-func (*AutoOutfitCoordinatorRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use FleetPurchasePlan.ProtoReflect.Descriptor instead.
+func (*FleetPurchasePlan) Descriptor() ([]byte, []int) {
 	return file_pkg_proto_daemon_daemon_proto_rawDescGZIP(), []int{39}
 }
 
-func (x *AutoOutfitCoordinatorRequest) GetPlayerId() int32 {
+func (x *FleetPurchasePlan) GetShipType() string {
 	if x != nil {
-		return x.PlayerId
+		return x.ShipType
+	}
+	return ""
+}
+
+func (x *FleetPurchasePlan) GetWanted() int32 {
+	if x != nil {
+		return x.Wanted
 	}
 	return 0
 }
 
-func (x *AutoOutfitCoordinatorRequest) GetAgentSymbol() string {
-	if x != nil && x.AgentSymbol != nil {
-		return *x.AgentSymbol
+func (x *FleetPurchasePlan) GetOwned() int32 {
+	if x != nil {
+		return x.Owned
 	}
-	return ""
+	return 0
 }
 
-func (x *AutoOutfitCoordinatorRequest) GetDryRun() bool {
+func (x *FleetPurchasePlan) GetBought() int32 {
 	if x != nil {
-		return x.DryRun
+		return x.Bought
 	}
-	return false
+	return 0
 }
 
-type AutoOutfitCoordinatorResponse struct {
+func (x *FleetPurchasePlan) GetCost() int64 {
+	if x != nil {
+		return x.Cost
+	}
+	return 0
+}
+
+func (x *FleetPurchasePlan) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type FleetContainerPlan struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	ContainerId   string                 `protobuf:"bytes,1,opt,name=container_id,json=containerId,proto3" json:"container_id,omitempty"`
-	Status        string                 `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	CommandType   string                 `protobuf:"bytes,2,opt,name=command_type,json=commandType,proto3" json:"command_type,omitempty"`
+	ContainerId   string                 `protobuf:"bytes,3,opt,name=container_id,json=containerId,proto3" json:"container_id,omitempty"`
+	Status        string                 `protobuf:"bytes,4,opt,name=status,proto3" json:"status,omitempty"`
+	Reason        string                 `protobuf:"bytes,5,opt,name=reason,proto3" json:"reason,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *AutoOutfitCoordinatorResponse) Reset() {
-	*x = AutoOutfitCoordinatorResponse{}
+func (x *FleetContainerPlan) Reset() {
+	*x = FleetContainerPlan{}
 	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[40]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *AutoOutfitCoordinatorResponse) String() string {
+func (x *FleetContainerPlan) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AutoOutfitCoordinatorResponse) ProtoMessage() {}
+func (*FleetContainerPlan) ProtoMessage() {}
 
-func (x *AutoOutfitCoordinatorResponse) ProtoReflect() protoreflect.Message {
+func (x *FleetContainerPlan) ProtoReflect() protoreflect.Message {
 	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[40]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2961,55 +3047,72 @@ func (x *AutoOutfitCoordinatorResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AutoOutfitCoordinatorResponse.ProtoReflect.Descriptor instead.
-func (*AutoOutfitCoordinatorResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use FleetContainerPlan.ProtoReflect.Descriptor instead.
+func (*FleetContainerPlan) Descriptor() ([]byte, []int) {
 	return file_pkg_proto_daemon_daemon_proto_rawDescGZIP(), []int{40}
 }
 
-func (x *AutoOutfitCoordinatorResponse) GetContainerId() string {
+func (x *FleetContainerPlan) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *FleetContainerPlan) GetCommandType() string {
+	if x != nil {
+		return x.CommandType
+	}
+	return ""
+}
+
+func (x *FleetContainerPlan) GetContainerId() string {
 	if x != nil {
 		return x.ContainerId
 	}
 	return ""
 }
 
-func (x *AutoOutfitCoordinatorResponse) GetStatus() string {
+func (x *FleetContainerPlan) GetStatus() string {
 	if x != nil {
 		return x.Status
 	}
 	return ""
 }
 
-// FrontierExpansionCoordinator launch knobs (sp-8w89). All optional; a 0/false value
-// uses the coordinator's documented default (RULINGS #5).
-type FrontierExpansionCoordinatorRequest struct {
-	state                protoimpl.MessageState `protogen:"open.v1"`
-	PlayerId             int32                  `protobuf:"varint,1,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"`
-	AgentSymbol          *string                `protobuf:"bytes,2,opt,name=agent_symbol,json=agentSymbol,proto3,oneof" json:"agent_symbol,omitempty"`
-	TickIntervalSecs     int32                  `protobuf:"varint,3,opt,name=tick_interval_secs,json=tickIntervalSecs,proto3" json:"tick_interval_secs,omitempty"`             // reconcile cadence; 0 = default
-	DryRun               bool                   `protobuf:"varint,4,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`                                             // log decisions, buy/declare nothing
-	MaxProbeFleet        int32                  `protobuf:"varint,5,opt,name=max_probe_fleet,json=maxProbeFleet,proto3" json:"max_probe_fleet,omitempty"`                      // total satellite cap; 0 = default
-	MaxSpendPerCycle     int32                  `protobuf:"varint,6,opt,name=max_spend_per_cycle,json=maxSpendPerCycle,proto3" json:"max_spend_per_cycle,omitempty"`           // probe spend cap in the trailing window; 0 = default
-	PurchaseCooldownSecs int32                  `protobuf:"varint,7,opt,name=purchase_cooldown_secs,json=purchaseCooldownSecs,proto3" json:"purchase_cooldown_secs,omitempty"` // min seconds between probe buys; 0 = default
-	ExpansionMaxHops     int32                  `protobuf:"varint,8,opt,name=expansion_max_hops,json=expansionMaxHops,proto3" json:"expansion_max_hops,omitempty"`             // gate-graph reach for the queue; 0 = default
-	unknownFields        protoimpl.UnknownFields
-	sizeCache            protoimpl.SizeCache
+func (x *FleetContainerPlan) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
 }
 
-func (x *FrontierExpansionCoordinatorRequest) Reset() {
-	*x = FrontierExpansionCoordinatorRequest{}
+// LaunchOperationProfileRequest names the operation profile to launch: a profile name in the
+// daemon's operation profiles directory or a path to a YAML file.
+type LaunchOperationProfileRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PlayerId      int32                  `protobuf:"varint,1,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"`
+	AgentSymbol   *string                `protobuf:"bytes,2,opt,name=agent_symbol,json=agentSymbol,proto3,oneof" json:"agent_symbol,omitempty"`
+	Profile       string                 `protobuf:"bytes,3,opt,name=profile,proto3" json:"profile,omitempty"`
+	DryRun        bool                   `protobuf:"varint,4,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LaunchOperationProfileRequest) Reset() {
+	*x = LaunchOperationProfileRequest{}
 	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[41]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *FrontierExpansionCoordinatorRequest) String() string {
+func (x *LaunchOperationProfileRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*FrontierExpansionCoordinatorRequest) ProtoMessage() {}
+func (*LaunchOperationProfileRequest) ProtoMessage() {}
 
-func (x *FrontierExpansionCoordinatorRequest) ProtoReflect() protoreflect.Message {
+func (x *LaunchOperationProfileRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[41]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3021,90 +3124,150 @@ func (x *FrontierExpansionCoordinatorRequest) ProtoReflect() protoreflect.Messag
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use FrontierExpansionCoordinatorRequest.ProtoReflect.Descriptor instead.
-func (*FrontierExpansionCoordinatorRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use LaunchOperationProfileRequest.ProtoReflect.Descriptor instead.
+func (*LaunchOperationProfileRequest) Descriptor() ([]byte, []int) {
 	return file_pkg_proto_daemon_daemon_proto_rawDescGZIP(), []int{41}
 }
 
-func (x *FrontierExpansionCoordinatorRequest) GetPlayerId() int32 {
+func (x *LaunchOperationProfileRequest) GetPlayerId() int32 {
 	if x != nil {
 		return x.PlayerId
 	}
 	return 0
 }
 
-func (x *FrontierExpansionCoordinatorRequest) GetAgentSymbol() string {
+func (x *LaunchOperationProfileRequest) GetAgentSymbol() string {
 	if x != nil && x.AgentSymbol != nil {
 		return *x.AgentSymbol
 	}
 	return ""
 }
 
-func (x *FrontierExpansionCoordinatorRequest) GetTickIntervalSecs() int32 {
+func (x *LaunchOperationProfileRequest) GetProfile() string {
 	if x != nil {
-		return x.TickIntervalSecs
+		return x.Profile
 	}
-	return 0
+	return ""
 }
 
-func (x *FrontierExpansionCoordinatorRequest) GetDryRun() bool {
+func (x *LaunchOperationProfileRequest) GetDryRun() bool {
 	if x != nil {
 		return x.DryRun
 	}
 	return false
 }
 
-func (x *FrontierExpansionCoordinatorRequest) GetMaxProbeFleet() int32 {
+// LaunchOperationProfileResponse carries the profile revision and every launch, in order.
+type LaunchOperationProfileResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Profile       string                 `protobuf:"bytes,1,opt,name=profile,proto3" json:"profile,omitempty"`
+	Version       int32                  `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"`
+	Digest        string                 `protobuf:"bytes,3,opt,name=digest,proto3" json:"digest,omitempty"`
+	Operation     string                 `protobuf:"bytes,4,opt,name=operation,proto3" json:"operation,omitempty"`
+	DryRun        bool                   `protobuf:"varint,5,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+	Launches      []*OperationLaunch     `protobuf:"bytes,6,rep,name=launches,proto3" json:"launches,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LaunchOperationProfileResponse) Reset() {
+	*x = LaunchOperationProfileResponse{}
+	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LaunchOperationProfileResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LaunchOperationProfileResponse) ProtoMessage() {}
+
+func (x *LaunchOperationProfileResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[42]
 	if x != nil {
-		return x.MaxProbeFleet
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *FrontierExpansionCoordinatorRequest) GetMaxSpendPerCycle() int32 {
+// Deprecated: Use LaunchOperationProfileResponse.ProtoReflect.Descriptor instead.
+func (*LaunchOperationProfileResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_daemon_daemon_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *LaunchOperationProfileResponse) GetProfile() string {
 	if x != nil {
-		return x.MaxSpendPerCycle
+		return x.Profile
 	}
-	return 0
+	return ""
 }
 
-func (x *FrontierExpansionCoordinatorRequest) GetPurchaseCooldownSecs() int32 {
+func (x *LaunchOperationProfileResponse) GetVersion() int32 {
 	if x != nil {
-		return x.PurchaseCooldownSecs
+		return x.Version
 	}
 	return 0
 }
 
-func (x *FrontierExpansionCoordinatorRequest) GetExpansionMaxHops() int32 {
+func (x *LaunchOperationProfileResponse) GetDigest() string {
 	if x != nil {
-		return x.ExpansionMaxHops
+		return x.Digest
 	}
-	return 0
+	return ""
 }
 
-type FrontierExpansionCoordinatorResponse struct {
+func (x *LaunchOperationProfileResponse) GetOperation() string {
+	if x != nil {
+		return x.Operation
+	}
+	return ""
+}
+
+func (x *LaunchOperationProfileResponse) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+func (x *LaunchOperationProfileResponse) GetLaunches() []*OperationLaunch {
+	if x != nil {
+		return x.Launches
+	}
+	return nil
+}
+
+// OperationLaunch is one launch target: a ship for per-ship operations, otherwise the profile.
+type OperationLaunch struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	ContainerId   string                 `protobuf:"bytes,1,opt,name=container_id,json=containerId,proto3" json:"container_id,omitempty"`
-	Status        string                 `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	Target        string                 `protobuf:"bytes,1,opt,name=target,proto3" json:"target,omitempty"`
+	ContainerIds  []string               `protobuf:"bytes,2,rep,name=container_ids,json=containerIds,proto3" json:"container_ids,omitempty"`
+	Status        string                 `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"` // LAUNCHED, PLANNED or FAILED
+	Reason        string                 `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *FrontierExpansionCoordinatorResponse) Reset() {
-	*x = FrontierExpansionCoordinatorResponse{}
-	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[42]
+func (x *OperationLaunch) Reset() {
+	*x = OperationLaunch{}
+	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[43]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *FrontierExpansionCoordinatorResponse) String() string {
+func (x *OperationLaunch) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*FrontierExpansionCoordinatorResponse) ProtoMessage() {}
+func (*OperationLaunch) ProtoMessage() {}
 
-func (x *FrontierExpansionCoordinatorResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[42]
+func (x *OperationLaunch) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[43]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3115,53 +3278,66 @@ func (x *FrontierExpansionCoordinatorResponse) ProtoReflect() protoreflect.Messa
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use FrontierExpansionCoordinatorResponse.ProtoReflect.Descriptor instead.
-func (*FrontierExpansionCoordinatorResponse) Descriptor() ([]byte, []int) {
-	return file_pkg_proto_daemon_daemon_proto_rawDescGZIP(), []int{42}
+// Deprecated: Use OperationLaunch.ProtoReflect.Descriptor instead.
+func (*OperationLaunch) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_daemon_daemon_proto_rawDescGZIP(), []int{43}
 }
 
-func (x *FrontierExpansionCoordinatorResponse) GetContainerId() string {
+func (x *OperationLaunch) GetTarget() string {
 	if x != nil {
-		return x.ContainerId
+		return x.Target
 	}
 	return ""
 }
 
-func (x *FrontierExpansionCoordinatorResponse) GetStatus() string {
+func (x *OperationLaunch) GetContainerIds() []string {
+	if x != nil {
+		return x.ContainerIds
+	}
+	return nil
+}
+
+func (x *OperationLaunch) GetStatus() string {
 	if x != nil {
 		return x.Status
 	}
 	return ""
 }
 
-// ShipyardBackfillCoordinator launch knobs (sp-s1ek). All optional; a 0 value uses the
-// coordinator's documented default (RULINGS #5). The engine has no dry-run mode, so there
-// is no dry_run field.
-type ShipyardBackfillCoordinatorRequest struct {
-	state                 protoimpl.MessageState `protogen:"open.v1"`
-	PlayerId              int32                  `protobuf:"varint,1,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"`
-	AgentSymbol           *string                `protobuf:"bytes,2,opt,name=agent_symbol,json=agentSymbol,proto3,oneof" json:"agent_symbol,omitempty"`
-	TickIntervalSecs      int32                  `protobuf:"varint,3,opt,name=tick_interval_secs,json=tickIntervalSecs,proto3" json:"tick_interval_secs,omitempty"`                  // reconcile cadence; 0 = default
-	MaxDispatchesPerCycle int32                  `protobuf:"varint,4,opt,name=max_dispatches_per_cycle,json=maxDispatchesPerCycle,proto3" json:"max_dispatches_per_cycle,omitempty"` // per-cycle sweep-once declaration cap; 0 = default
-	unknownFields         protoimpl.UnknownFields
-	sizeCache             protoimpl.SizeCache
+func (x *OperationLaunch) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
 }
 
-func (x *ShipyardBackfillCoordinatorRequest) Reset() {
-	*x = ShipyardBackfillCoordinatorRequest{}
-	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[43]
+// CapacityReconcilerCoordinatorRequest starts the standing capacity reconciler (st-7zk).
+// All calibration lives in config.yaml's [capacity_reconciler] section (resolved live on
+// every build); this request names the player/agent and an optional dry-run override.
+type CapacityReconcilerCoordinatorRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PlayerId      int32                  `protobuf:"varint,1,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"`
+	AgentSymbol   *string                `protobuf:"bytes,2,opt,name=agent_symbol,json=agentSymbol,proto3,oneof" json:"agent_symbol,omitempty"`
+	DryRun        bool                   `protobuf:"varint,3,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"` // observe + log every decision, actuate nothing (recommended first-start posture)
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CapacityReconcilerCoordinatorRequest) Reset() {
+	*x = CapacityReconcilerCoordinatorRequest{}
+	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[44]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ShipyardBackfillCoordinatorRequest) String() string {
+func (x *CapacityReconcilerCoordinatorRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ShipyardBackfillCoordinatorRequest) ProtoMessage() {}
+func (*CapacityReconcilerCoordinatorRequest) ProtoMessage() {}
 
-func (x *ShipyardBackfillCoordinatorRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[43]
+func (x *CapacityReconcilerCoordinatorRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[44]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3172,40 +3348,33 @@ func (x *ShipyardBackfillCoordinatorRequest) ProtoReflect() protoreflect.Message
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ShipyardBackfillCoordinatorRequest.ProtoReflect.Descriptor instead.
-func (*ShipyardBackfillCoordinatorRequest) Descriptor() ([]byte, []int) {
-	return file_pkg_proto_daemon_daemon_proto_rawDescGZIP(), []int{43}
+// Deprecated: Use CapacityReconcilerCoordinatorRequest.ProtoReflect.Descriptor instead.
+func (*CapacityReconcilerCoordinatorRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_daemon_daemon_proto_rawDescGZIP(), []int{44}
 }
 
-func (x *ShipyardBackfillCoordinatorRequest) GetPlayerId() int32 {
+func (x *CapacityReconcilerCoordinatorRequest) GetPlayerId() int32 {
 	if x != nil {
 		return x.PlayerId
 	}
 	return 0
 }
 
-func (x *ShipyardBackfillCoordinatorRequest) GetAgentSymbol() string {
+func (x *CapacityReconcilerCoordinatorRequest) GetAgentSymbol() string {
 	if x != nil && x.AgentSymbol != nil {
 		return *x.AgentSymbol
 	}
 	return ""
 }
 
-func (x *ShipyardBackfillCoordinatorRequest) GetTickIntervalSecs() int32 {
-	if x != nil {
-		return x.TickIntervalSecs
-	}
-	return 0
-}
-
-func (x *ShipyardBackfillCoordinatorRequest) GetMaxDispatchesPerCycle() int32 {
+func (x *CapacityReconcilerCoordinatorRequest) GetDryRun() bool {
 	if x != nil {
-		return x.MaxDispatchesPerCycle
+		return x.DryRun
 	}
-	return 0
+	return false
 }
 
-type ShipyardBackfillCoordinatorResponse struct {
+type CapacityReconcilerCoordinatorResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	ContainerId   string                 `protobuf:"bytes,1,opt,name=container_id,json=containerId,proto3" json:"container_id,omitempty"`
 	Status        string                 `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
@@ -3213,21 +3382,21 @@ type ShipyardBackfillCoordinatorResponse struct {
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ShipyardBackfillCoordinatorResponse) Reset() {
-	*x = ShipyardBackfillCoordinatorResponse{}
-	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[44]
+func (x *CapacityReconcilerCoordinatorResponse) Reset() {
+	*x = CapacityReconcilerCoordinatorResponse{}
+	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[45]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ShipyardBackfillCoordinatorResponse) String() string {
+func (x *CapacityReconcilerCoordinatorResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ShipyardBackfillCoordinatorResponse) ProtoMessage() {}
+func (*CapacityReconcilerCoordinatorResponse) ProtoMessage() {}
 
-func (x *ShipyardBackfillCoordinatorResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[44]
+func (x *CapacityReconcilerCoordinatorResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[45]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3238,52 +3407,52 @@ func (x *ShipyardBackfillCoordinatorResponse) ProtoReflect() protoreflect.Messag
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ShipyardBackfillCoordinatorResponse.ProtoReflect.Descriptor instead.
-func (*ShipyardBackfillCoordinatorResponse) Descriptor() ([]byte, []int) {
-	return file_pkg_proto_daemon_daemon_proto_rawDescGZIP(), []int{44}
+// Deprecated: Use CapacityReconcilerCoordinatorResponse.ProtoReflect.Descriptor instead.
+func (*CapacityReconcilerCoordinatorResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_daemon_daemon_proto_rawDescGZIP(), []int{45}
 }
 
-func (x *ShipyardBackfillCoordinatorResponse) GetContainerId() string {
+func (x *CapacityReconcilerCoordinatorResponse) GetContainerId() string {
 	if x != nil {
 		return x.ContainerId
 	}
 	return ""
 }
 
-func (x *ShipyardBackfillCoordinatorResponse) GetStatus() string {
+func (x *CapacityReconcilerCoordinatorResponse) GetStatus() string {
 	if x != nil {
 		return x.Status
 	}
 	return ""
 }
 
-// WorkerRebalancerCoordinatorRequest starts the standing worker-rebalancer coordinator
-// (sp-f5pr). All tuning lives in config.yaml's [worker_rebalancer] section (resolved live
-// on every build); this request only names the player/agent and the dry-run launch flag.
-type WorkerRebalancerCoordinatorRequest struct {
+// AutoOutfitCoordinatorRequest starts the standing guarded auto-outfit coordinator
+// (sp-buyd). All calibration is live-tunable via `tune --operation autooutfit`; this
+// request names the player/agent and an optional dry-run override.
+type AutoOutfitCoordinatorRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	PlayerId      int32                  `protobuf:"varint,1,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"`
 	AgentSymbol   *string                `protobuf:"bytes,2,opt,name=agent_symbol,json=agentSymbol,proto3,oneof" json:"agent_symbol,omitempty"`
-	DryRun        bool                   `protobuf:"varint,3,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"` // decide + log the ferry it would dispatch, but ferry nothing
+	DryRun        bool                   `protobuf:"varint,3,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"` // observe + log every WOULD-install, actuate nothing (recommended first-start posture)
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *WorkerRebalancerCoordinatorRequest) Reset() {
-	*x = WorkerRebalancerCoordinatorRequest{}
-	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[45]
+func (x *AutoOutfitCoordinatorRequest) Reset() {
+	*x = AutoOutfitCoordinatorRequest{}
+	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[46]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *WorkerRebalancerCoordinatorRequest) String() string {
+func (x *AutoOutfitCoordinatorRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*WorkerRebalancerCoordinatorRequest) ProtoMessage() {}
+func (*AutoOutfitCoordinatorRequest) ProtoMessage() {}
 
-func (x *WorkerRebalancerCoordinatorRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[45]
+func (x *AutoOutfitCoordinatorRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[46]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3294,33 +3463,33 @@ func (x *WorkerRebalancerCoordinatorRequest) ProtoReflect() protoreflect.Message
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use WorkerRebalancerCoordinatorRequest.ProtoReflect.Descriptor instead.
-func (*WorkerRebalancerCoordinatorRequest) Descriptor() ([]byte, []int) {
-	return file_pkg_proto_daemon_daemon_proto_rawDescGZIP(), []int{45}
+// Deprecated: Use AutoOutfitCoordinatorRequest.ProtoReflect.Descriptor instead.
+func (*AutoOutfitCoordinatorRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_daemon_daemon_proto_rawDescGZIP(), []int{46}
 }
 
-func (x *WorkerRebalancerCoordinatorRequest) GetPlayerId() int32 {
+func (x *AutoOutfitCoordinatorRequest) GetPlayerId() int32 {
 	if x != nil {
 		return x.PlayerId
 	}
 	return 0
 }
 
-func (x *WorkerRebalancerCoordinatorRequest) GetAgentSymbol() string {
+func (x *AutoOutfitCoordinatorRequest) GetAgentSymbol() string {
 	if x != nil && x.AgentSymbol != nil {
 		return *x.AgentSymbol
 	}
 	return ""
 }
 
-func (x *WorkerRebalancerCoordinatorRequest) GetDryRun() bool {
+func (x *AutoOutfitCoordinatorRequest) GetDryRun() bool {
 	if x != nil {
 		return x.DryRun
 	}
 	return false
 }
 
-type WorkerRebalancerCoordinatorResponse struct {
+type AutoOutfitCoordinatorResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	ContainerId   string                 `protobuf:"bytes,1,opt,name=container_id,json=containerId,proto3" json:"container_id,omitempty"`
 	Status        string                 `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
@@ -3328,21 +3497,21 @@ type WorkerRebalancerCoordinatorResponse struct {
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *WorkerRebalancerCoordinatorResponse) Reset() {
-	*x = WorkerRebalancerCoordinatorResponse{}
-	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[46]
+func (x *AutoOutfitCoordinatorResponse) Reset() {
+	*x = AutoOutfitCoordinatorResponse{}
+	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[47]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *WorkerRebalancerCoordinatorResponse) String() string {
+func (x *AutoOutfitCoordinatorResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*WorkerRebalancerCoordinatorResponse) ProtoMessage() {}
+func (*AutoOutfitCoordinatorResponse) ProtoMessage() {}
 
-func (x *WorkerRebalancerCoordinatorResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[46]
+func (x *AutoOutfitCoordinatorResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[47]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3353,55 +3522,56 @@ func (x *WorkerRebalancerCoordinatorResponse) ProtoReflect() protoreflect.Messag
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use WorkerRebalancerCoordinatorResponse.ProtoReflect.Descriptor instead.
-func (*WorkerRebalancerCoordinatorResponse) Descriptor() ([]byte, []int) {
-	return file_pkg_proto_daemon_daemon_proto_rawDescGZIP(), []int{46}
+// Deprecated: Use AutoOutfitCoordinatorResponse.ProtoReflect.Descriptor instead.
+func (*AutoOutfitCoordinatorResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_daemon_daemon_proto_rawDescGZIP(), []int{47}
 }
 
-func (x *WorkerRebalancerCoordinatorResponse) GetContainerId() string {
+func (x *AutoOutfitCoordinatorResponse) GetContainerId() string {
 	if x != nil {
 		return x.ContainerId
 	}
 	return ""
 }
 
-func (x *WorkerRebalancerCoordinatorResponse) GetStatus() string {
+func (x *AutoOutfitCoordinatorResponse) GetStatus() string {
 	if x != nil {
 		return x.Status
 	}
 	return ""
 }
 
-// AddScoutPostRequest adds or updates a post. An existing post's live assignment
-// is preserved; freshness, kind, and the hull budget change. hulls is the probe
-// budget N (sp-enry); 0 defaults to 1 (single-hull, the pre-enry behavior).
-type AddScoutPostRequest struct {
-	state            protoimpl.MessageState `protogen:"open.v1"`
-	PlayerId         int32                  `protobuf:"varint,1,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"`
-	AgentSymbol      *string                `protobuf:"bytes,2,opt,name=agent_symbol,json=agentSymbol,proto3,oneof" json:"agent_symbol,omitempty"`
-	SystemSymbol     string                 `protobuf:"bytes,3,opt,name=system_symbol,json=systemSymbol,proto3" json:"system_symbol,omitempty"`
-	FreshnessSeconds int32                  `protobuf:"varint,4,opt,name=freshness_seconds,json=freshnessSeconds,proto3" json:"freshness_seconds,omitempty"`
-	Kind             string                 `protobuf:"bytes,5,opt,name=kind,proto3" json:"kind,omitempty"`
-	Hulls            int32                  `protobuf:"varint,6,opt,name=hulls,proto3" json:"hulls,omitempty"`
-	unknownFields    protoimpl.UnknownFields
-	sizeCache        protoimpl.SizeCache
+// FrontierExpansionCoordinator launch knobs (sp-8w89). All optional; a 0/false value
+// uses the coordinator's documented default (RULINGS #5).
+type FrontierExpansionCoordinatorRequest struct {
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	PlayerId             int32                  `protobuf:"varint,1,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"`
+	AgentSymbol          *string                `protobuf:"bytes,2,opt,name=agent_symbol,json=agentSymbol,proto3,oneof" json:"agent_symbol,omitempty"`
+	TickIntervalSecs     int32                  `protobuf:"varint,3,opt,name=tick_interval_secs,json=tickIntervalSecs,proto3" json:"tick_interval_secs,omitempty"`             // reconcile cadence; 0 = default
+	DryRun               bool                   `protobuf:"varint,4,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`                                             // log decisions, buy/declare nothing
+	MaxProbeFleet        int32                  `protobuf:"varint,5,opt,name=max_probe_fleet,json=maxProbeFleet,proto3" json:"max_probe_fleet,omitempty"`                      // total satellite cap; 0 = default
+	MaxSpendPerCycle     int32                  `protobuf:"varint,6,opt,name=max_spend_per_cycle,json=maxSpendPerCycle,proto3" json:"max_spend_per_cycle,omitempty"`           // probe spend cap in the trailing window; 0 = default
+	PurchaseCooldownSecs int32                  `protobuf:"varint,7,opt,name=purchase_cooldown_secs,json=purchaseCooldownSecs,proto3" json:"purchase_cooldown_secs,omitempty"` // min seconds between probe buys; 0 = default
+	ExpansionMaxHops     int32                  `protobuf:"varint,8,opt,name=expansion_max_hops,json=expansionMaxHops,proto3" json:"expansion_max_hops,omitempty"`             // gate-graph reach for the queue; 0 = default
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
 }
 
-func (x *AddScoutPostRequest) Reset() {
-	*x = AddScoutPostRequest{}
-	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[47]
+func (x *FrontierExpansionCoordinatorRequest) Reset() {
+	*x = FrontierExpansionCoordinatorRequest{}
+	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[48]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *AddScoutPostRequest) String() string {
+func (x *FrontierExpansionCoordinatorRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AddScoutPostRequest) ProtoMessage() {}
+func (*FrontierExpansionCoordinatorRequest) ProtoMessage() {}
 
-func (x *AddScoutPostRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[47]
+func (x *FrontierExpansionCoordinatorRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[48]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3412,75 +3582,90 @@ func (x *AddScoutPostRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AddScoutPostRequest.ProtoReflect.Descriptor instead.
-func (*AddScoutPostRequest) Descriptor() ([]byte, []int) {
-	return file_pkg_proto_daemon_daemon_proto_rawDescGZIP(), []int{47}
+// Deprecated: Use FrontierExpansionCoordinatorRequest.ProtoReflect.Descriptor instead.
+func (*FrontierExpansionCoordinatorRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_daemon_daemon_proto_rawDescGZIP(), []int{48}
 }
 
-func (x *AddScoutPostRequest) GetPlayerId() int32 {
+func (x *FrontierExpansionCoordinatorRequest) GetPlayerId() int32 {
 	if x != nil {
 		return x.PlayerId
 	}
 	return 0
 }
 
-func (x *AddScoutPostRequest) GetAgentSymbol() string {
+func (x *FrontierExpansionCoordinatorRequest) GetAgentSymbol() string {
 	if x != nil && x.AgentSymbol != nil {
 		return *x.AgentSymbol
 	}
 	return ""
 }
 
-func (x *AddScoutPostRequest) GetSystemSymbol() string {
+func (x *FrontierExpansionCoordinatorRequest) GetTickIntervalSecs() int32 {
 	if x != nil {
-		return x.SystemSymbol
+		return x.TickIntervalSecs
 	}
-	return ""
+	return 0
 }
 
-func (x *AddScoutPostRequest) GetFreshnessSeconds() int32 {
+func (x *FrontierExpansionCoordinatorRequest) GetDryRun() bool {
 	if x != nil {
-		return x.FreshnessSeconds
+		return x.DryRun
+	}
+	return false
+}
+
+func (x *FrontierExpansionCoordinatorRequest) GetMaxProbeFleet() int32 {
+	if x != nil {
+		return x.MaxProbeFleet
 	}
 	return 0
 }
 
-func (x *AddScoutPostRequest) GetKind() string {
+func (x *FrontierExpansionCoordinatorRequest) GetMaxSpendPerCycle() int32 {
 	if x != nil {
-		return x.Kind
+		return x.MaxSpendPerCycle
 	}
-	return ""
+	return 0
 }
 
-func (x *AddScoutPostRequest) GetHulls() int32 {
+func (x *FrontierExpansionCoordinatorRequest) GetPurchaseCooldownSecs() int32 {
 	if x != nil {
-		return x.Hulls
+		return x.PurchaseCooldownSecs
 	}
 	return 0
 }
 
-type ScoutPostResponse struct {
+func (x *FrontierExpansionCoordinatorRequest) GetExpansionMaxHops() int32 {
+	if x != nil {
+		return x.ExpansionMaxHops
+	}
+	return 0
+}
+
+type FrontierExpansionCoordinatorResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Post          *ScoutPost             `protobuf:"bytes,1,opt,name=post,proto3" json:"post,omitempty"`
+	ContainerId   string                 `protobuf:"bytes,1,opt,name=container_id,json=containerId,proto3" json:"container_id,omitempty"`
+	Status        string                 `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ScoutPostResponse) Reset() {
-	*x = ScoutPostResponse{}
-	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[48]
+func (x *FrontierExpansionCoordinatorResponse) Reset() {
+	*x = FrontierExpansionCoordinatorResponse{}
+	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[49]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ScoutPostResponse) String() string {
+func (x *FrontierExpansionCoordinatorResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ScoutPostResponse) ProtoMessage() {}
+func (*FrontierExpansionCoordinatorResponse) ProtoMessage() {}
 
-func (x *ScoutPostResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[48]
+func (x *FrontierExpansionCoordinatorResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[49]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3491,43 +3676,53 @@ func (x *ScoutPostResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ScoutPostResponse.ProtoReflect.Descriptor instead.
-func (*ScoutPostResponse) Descriptor() ([]byte, []int) {
-	return file_pkg_proto_daemon_daemon_proto_rawDescGZIP(), []int{48}
+// Deprecated: Use FrontierExpansionCoordinatorResponse.ProtoReflect.Descriptor instead.
+func (*FrontierExpansionCoordinatorResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_daemon_daemon_proto_rawDescGZIP(), []int{49}
 }
 
-func (x *ScoutPostResponse) GetPost() *ScoutPost {
+func (x *FrontierExpansionCoordinatorResponse) GetContainerId() string {
 	if x != nil {
-		return x.Post
+		return x.ContainerId
 	}
-	return nil
+	return ""
 }
 
-// RemoveScoutPostRequest removes a post and releases its hull if manned.
-type RemoveScoutPostRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	PlayerId      int32                  `protobuf:"varint,1,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"`
-	AgentSymbol   *string                `protobuf:"bytes,2,opt,name=agent_symbol,json=agentSymbol,proto3,oneof" json:"agent_symbol,omitempty"`
-	SystemSymbol  string                 `protobuf:"bytes,3,opt,name=system_symbol,json=systemSymbol,proto3" json:"system_symbol,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *FrontierExpansionCoordinatorResponse) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
 }
 
-func (x *RemoveScoutPostRequest) Reset() {
-	*x = RemoveScoutPostRequest{}
-	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[49]
+// ShipyardBackfillCoordinator launch knobs (sp-s1ek). All optional; a 0 value uses the
+// coordinator's documented default (RULINGS #5). The engine has no dry-run mode, so there
+// is no dry_run field.
+type ShipyardBackfillCoordinatorRequest struct {
+	state                 protoimpl.MessageState `protogen:"open.v1"`
+	PlayerId              int32                  `protobuf:"varint,1,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"`
+	AgentSymbol           *string                `protobuf:"bytes,2,opt,name=agent_symbol,json=agentSymbol,proto3,oneof" json:"agent_symbol,omitempty"`
+	TickIntervalSecs      int32                  `protobuf:"varint,3,opt,name=tick_interval_secs,json=tickIntervalSecs,proto3" json:"tick_interval_secs,omitempty"`                  // reconcile cadence; 0 = default
+	MaxDispatchesPerCycle int32                  `protobuf:"varint,4,opt,name=max_dispatches_per_cycle,json=maxDispatchesPerCycle,proto3" json:"max_dispatches_per_cycle,omitempty"` // per-cycle sweep-once declaration cap; 0 = default
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
+}
+
+func (x *ShipyardBackfillCoordinatorRequest) Reset() {
+	*x = ShipyardBackfillCoordinatorRequest{}
+	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[50]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RemoveScoutPostRequest) String() string {
+func (x *ShipyardBackfillCoordinatorRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RemoveScoutPostRequest) ProtoMessage() {}
+func (*ShipyardBackfillCoordinatorRequest) ProtoMessage() {}
 
-func (x *RemoveScoutPostRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[49]
+func (x *ShipyardBackfillCoordinatorRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[50]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3538,54 +3733,62 @@ func (x *RemoveScoutPostRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RemoveScoutPostRequest.ProtoReflect.Descriptor instead.
-func (*RemoveScoutPostRequest) Descriptor() ([]byte, []int) {
-	return file_pkg_proto_daemon_daemon_proto_rawDescGZIP(), []int{49}
+// Deprecated: Use ShipyardBackfillCoordinatorRequest.ProtoReflect.Descriptor instead.
+func (*ShipyardBackfillCoordinatorRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_daemon_daemon_proto_rawDescGZIP(), []int{50}
 }
 
-func (x *RemoveScoutPostRequest) GetPlayerId() int32 {
+func (x *ShipyardBackfillCoordinatorRequest) GetPlayerId() int32 {
 	if x != nil {
 		return x.PlayerId
 	}
 	return 0
 }
 
-func (x *RemoveScoutPostRequest) GetAgentSymbol() string {
+func (x *ShipyardBackfillCoordinatorRequest) GetAgentSymbol() string {
 	if x != nil && x.AgentSymbol != nil {
 		return *x.AgentSymbol
 	}
 	return ""
 }
 
-func (x *RemoveScoutPostRequest) GetSystemSymbol() string {
+func (x *ShipyardBackfillCoordinatorRequest) GetTickIntervalSecs() int32 {
 	if x != nil {
-		return x.SystemSymbol
+		return x.TickIntervalSecs
 	}
-	return ""
+	return 0
 }
 
-type RemoveScoutPostResponse struct {
+func (x *ShipyardBackfillCoordinatorRequest) GetMaxDispatchesPerCycle() int32 {
+	if x != nil {
+		return x.MaxDispatchesPerCycle
+	}
+	return 0
+}
+
+type ShipyardBackfillCoordinatorResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Status        string                 `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	ContainerId   string                 `protobuf:"bytes,1,opt,name=container_id,json=containerId,proto3" json:"container_id,omitempty"`
+	Status        string                 `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *RemoveScoutPostResponse) Reset() {
-	*x = RemoveScoutPostResponse{}
-	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[50]
+func (x *ShipyardBackfillCoordinatorResponse) Reset() {
+	*x = ShipyardBackfillCoordinatorResponse{}
+	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[51]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RemoveScoutPostResponse) String() string {
+func (x *ShipyardBackfillCoordinatorResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RemoveScoutPostResponse) ProtoMessage() {}
+func (*ShipyardBackfillCoordinatorResponse) ProtoMessage() {}
 
-func (x *RemoveScoutPostResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[50]
+func (x *ShipyardBackfillCoordinatorResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[51]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3596,41 +3799,53 @@ func (x *RemoveScoutPostResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RemoveScoutPostResponse.ProtoReflect.Descriptor instead.
-func (*RemoveScoutPostResponse) Descriptor() ([]byte, []int) {
-	return file_pkg_proto_daemon_daemon_proto_rawDescGZIP(), []int{50}
+// Deprecated: Use ShipyardBackfillCoordinatorResponse.ProtoReflect.Descriptor instead.
+func (*ShipyardBackfillCoordinatorResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_daemon_daemon_proto_rawDescGZIP(), []int{51}
 }
 
-func (x *RemoveScoutPostResponse) GetStatus() string {
+func (x *ShipyardBackfillCoordinatorResponse) GetContainerId() string {
+	if x != nil {
+		return x.ContainerId
+	}
+	return ""
+}
+
+func (x *ShipyardBackfillCoordinatorResponse) GetStatus() string {
 	if x != nil {
 		return x.Status
 	}
 	return ""
 }
 
-type ListScoutPostsRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	PlayerId      int32                  `protobuf:"varint,1,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"`
-	AgentSymbol   *string                `protobuf:"bytes,2,opt,name=agent_symbol,json=agentSymbol,proto3,oneof" json:"agent_symbol,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+// ProbeParkingCoordinator launch knobs. All optional; a 0 value uses the coordinator's
+// default.
+type ProbeParkingCoordinatorRequest struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	PlayerId          int32                  `protobuf:"varint,1,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"`
+	AgentSymbol       *string                `protobuf:"bytes,2,opt,name=agent_symbol,json=agentSymbol,proto3,oneof" json:"agent_symbol,omitempty"`
+	TickIntervalSecs  int32                  `protobuf:"varint,3,opt,name=tick_interval_secs,json=tickIntervalSecs,proto3" json:"tick_interval_secs,omitempty"`    // reconcile cadence; 0 = default
+	ScanIntervalSecs  int32                  `protobuf:"varint,4,opt,name=scan_interval_secs,json=scanIntervalSecs,proto3" json:"scan_interval_secs,omitempty"`    // parked probe re-scan cadence; 0 = scan floor
+	ReserveIdleProbes int32                  `protobuf:"varint,5,opt,name=reserve_idle_probes,json=reserveIdleProbes,proto3" json:"reserve_idle_probes,omitempty"` // idle probes left unparked; 0 = park them all
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
 }
 
-func (x *ListScoutPostsRequest) Reset() {
-	*x = ListScoutPostsRequest{}
-	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[51]
+func (x *ProbeParkingCoordinatorRequest) Reset() {
+	*x = ProbeParkingCoordinatorRequest{}
+	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[52]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListScoutPostsRequest) String() string {
+func (x *ProbeParkingCoordinatorRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListScoutPostsRequest) ProtoMessage() {}
+func (*ProbeParkingCoordinatorRequest) ProtoMessage() {}
 
-func (x *ListScoutPostsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[51]
+func (x *ProbeParkingCoordinatorRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[52]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3641,47 +3856,69 @@ func (x *ListScoutPostsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListScoutPostsRequest.ProtoReflect.Descriptor instead.
-func (*ListScoutPostsRequest) Descriptor() ([]byte, []int) {
-	return file_pkg_proto_daemon_daemon_proto_rawDescGZIP(), []int{51}
+// Deprecated: Use ProbeParkingCoordinatorRequest.ProtoReflect.Descriptor instead.
+func (*ProbeParkingCoordinatorRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_daemon_daemon_proto_rawDescGZIP(), []int{52}
 }
 
-func (x *ListScoutPostsRequest) GetPlayerId() int32 {
+func (x *ProbeParkingCoordinatorRequest) GetPlayerId() int32 {
 	if x != nil {
 		return x.PlayerId
 	}
 	return 0
 }
 
-func (x *ListScoutPostsRequest) GetAgentSymbol() string {
+func (x *ProbeParkingCoordinatorRequest) GetAgentSymbol() string {
 	if x != nil && x.AgentSymbol != nil {
 		return *x.AgentSymbol
 	}
 	return ""
 }
 
-type ListScoutPostsResponse struct {
+func (x *ProbeParkingCoordinatorRequest) GetTickIntervalSecs() int32 {
+	if x != nil {
+		return x.TickIntervalSecs
+	}
+	return 0
+}
+
+func (x *ProbeParkingCoordinatorRequest) GetScanIntervalSecs() int32 {
+	if x != nil {
+		return x.ScanIntervalSecs
+	}
+	return 0
+}
+
+func (x *ProbeParkingCoordinatorRequest) GetReserveIdleProbes() int32 {
+	if x != nil {
+		return x.ReserveIdleProbes
+	}
+	return 0
+}
+
+type ProbeParkingCoordinatorResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Posts         []*ScoutPost           `protobuf:"bytes,1,rep,name=posts,proto3" json:"posts,omitempty"`
+	ContainerId   string                 `protobuf:"bytes,1,opt,name=container_id,json=containerId,proto3" json:"container_id,omitempty"`
+	Status        string                 `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListScoutPostsResponse) Reset() {
-	*x = ListScoutPostsResponse{}
-	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[52]
+func (x *ProbeParkingCoordinatorResponse) Reset() {
+	*x = ProbeParkingCoordinatorResponse{}
+	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[53]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListScoutPostsResponse) String() string {
+func (x *ProbeParkingCoordinatorResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListScoutPostsResponse) ProtoMessage() {}
+func (*ProbeParkingCoordinatorResponse) ProtoMessage() {}
 
-func (x *ListScoutPostsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[52]
+func (x *ProbeParkingCoordinatorResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[53]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3692,46 +3929,57 @@ func (x *ListScoutPostsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListScoutPostsResponse.ProtoReflect.Descriptor instead.
-func (*ListScoutPostsResponse) Descriptor() ([]byte, []int) {
-	return file_pkg_proto_daemon_daemon_proto_rawDescGZIP(), []int{52}
+// Deprecated: Use ProbeParkingCoordinatorResponse.ProtoReflect.Descriptor instead.
+func (*ProbeParkingCoordinatorResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_daemon_daemon_proto_rawDescGZIP(), []int{53}
 }
 
-func (x *ListScoutPostsResponse) GetPosts() []*ScoutPost {
+func (x *ProbeParkingCoordinatorResponse) GetContainerId() string {
 	if x != nil {
-		return x.Posts
+		return x.ContainerId
 	}
-	return nil
+	return ""
 }
 
-// ScoutMarketsRequest initiates fleet market scouting with VRP optimization
-type ScoutMarketsRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	ShipSymbols   []string               `protobuf:"bytes,1,rep,name=ship_symbols,json=shipSymbols,proto3" json:"ship_symbols,omitempty"`
-	SystemSymbol  string                 `protobuf:"bytes,2,opt,name=system_symbol,json=systemSymbol,proto3" json:"system_symbol,omitempty"`
-	Markets       []string               `protobuf:"bytes,3,rep,name=markets,proto3" json:"markets,omitempty"`
-	Iterations    int32                  `protobuf:"varint,4,opt,name=iterations,proto3" json:"iterations,omitempty"`
-	PlayerId      int32                  `protobuf:"varint,5,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"`
-	AgentSymbol   *string                `protobuf:"bytes,6,opt,name=agent_symbol,json=agentSymbol,proto3,oneof" json:"agent_symbol,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *ProbeParkingCoordinatorResponse) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
 }
 
-func (x *ScoutMarketsRequest) Reset() {
-	*x = ScoutMarketsRequest{}
-	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[53]
+// TankerCoordinator launch knobs. Tankers come from tanker_ships and/or tanker_ship_tag;
+// the rest are optional and an empty/0 value uses the coordinator's default.
+type TankerCoordinatorRequest struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	PlayerId         int32                  `protobuf:"varint,1,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"`
+	AgentSymbol      *string                `protobuf:"bytes,2,opt,name=agent_symbol,json=agentSymbol,proto3,oneof" json:"agent_symbol,omitempty"`
+	TankerShips      []string               `protobuf:"bytes,3,rep,name=tanker_ships,json=tankerShips,proto3" json:"tanker_ships,omitempty"`
+	TankerShipTag    string                 `protobuf:"bytes,4,opt,name=tanker_ship_tag,json=tankerShipTag,proto3" json:"tanker_ship_tag,omitempty"`
+	Stations         []string               `protobuf:"bytes,5,rep,name=stations,proto3" json:"stations,omitempty"`                                            // waypoints to hold; empty = busiest extraction sites
+	FuelSource       string                 `protobuf:"bytes,6,opt,name=fuel_source,json=fuelSource,proto3" json:"fuel_source,omitempty"`                      // market to load FUEL at; empty = nearest fuel market
+	RefuelBelowPct   int32                  `protobuf:"varint,7,opt,name=refuel_below_pct,json=refuelBelowPct,proto3" json:"refuel_below_pct,omitempty"`       // serve ships under this fuel %; 0 = default
+	ReloadBelowUnits int32                  `protobuf:"varint,8,opt,name=reload_below_units,json=reloadBelowUnits,proto3" json:"reload_below_units,omitempty"` // reload under this much FUEL cargo; 0 = a quarter hold
+	TickIntervalSecs int32                  `protobuf:"varint,9,opt,name=tick_interval_secs,json=tickIntervalSecs,proto3" json:"tick_interval_secs,omitempty"` // 0 = default
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *TankerCoordinatorRequest) Reset() {
+	*x = TankerCoordinatorRequest{}
+	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[54]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ScoutMarketsRequest) String() string {
+func (x *TankerCoordinatorRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ScoutMarketsRequest) ProtoMessage() {}
+func (*TankerCoordinatorRequest) ProtoMessage() {}
 
-func (x *ScoutMarketsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[53]
+func (x *TankerCoordinatorRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[54]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3742,135 +3990,96 @@ func (x *ScoutMarketsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ScoutMarketsRequest.ProtoReflect.Descriptor instead.
-func (*ScoutMarketsRequest) Descriptor() ([]byte, []int) {
-	return file_pkg_proto_daemon_daemon_proto_rawDescGZIP(), []int{53}
+// Deprecated: Use TankerCoordinatorRequest.ProtoReflect.Descriptor instead.
+func (*TankerCoordinatorRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_daemon_daemon_proto_rawDescGZIP(), []int{54}
 }
 
-func (x *ScoutMarketsRequest) GetShipSymbols() []string {
+func (x *TankerCoordinatorRequest) GetPlayerId() int32 {
 	if x != nil {
-		return x.ShipSymbols
+		return x.PlayerId
 	}
-	return nil
+	return 0
 }
 
-func (x *ScoutMarketsRequest) GetSystemSymbol() string {
-	if x != nil {
-		return x.SystemSymbol
+func (x *TankerCoordinatorRequest) GetAgentSymbol() string {
+	if x != nil && x.AgentSymbol != nil {
+		return *x.AgentSymbol
 	}
 	return ""
 }
 
-func (x *ScoutMarketsRequest) GetMarkets() []string {
+func (x *TankerCoordinatorRequest) GetTankerShips() []string {
 	if x != nil {
-		return x.Markets
+		return x.TankerShips
 	}
 	return nil
 }
 
-func (x *ScoutMarketsRequest) GetIterations() int32 {
+func (x *TankerCoordinatorRequest) GetTankerShipTag() string {
 	if x != nil {
-		return x.Iterations
+		return x.TankerShipTag
 	}
-	return 0
+	return ""
 }
 
-func (x *ScoutMarketsRequest) GetPlayerId() int32 {
+func (x *TankerCoordinatorRequest) GetStations() []string {
 	if x != nil {
-		return x.PlayerId
+		return x.Stations
 	}
-	return 0
+	return nil
 }
 
-func (x *ScoutMarketsRequest) GetAgentSymbol() string {
-	if x != nil && x.AgentSymbol != nil {
-		return *x.AgentSymbol
+func (x *TankerCoordinatorRequest) GetFuelSource() string {
+	if x != nil {
+		return x.FuelSource
 	}
 	return ""
 }
 
-type ScoutMarketsResponse struct {
-	state            protoimpl.MessageState       `protogen:"open.v1"`
-	ContainerIds     []string                     `protobuf:"bytes,1,rep,name=container_ids,json=containerIds,proto3" json:"container_ids,omitempty"`
-	Assignments      map[string]*MarketAssignment `protobuf:"bytes,2,rep,name=assignments,proto3" json:"assignments,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
-	ReusedContainers []string                     `protobuf:"bytes,3,rep,name=reused_containers,json=reusedContainers,proto3" json:"reused_containers,omitempty"`
-	unknownFields    protoimpl.UnknownFields
-	sizeCache        protoimpl.SizeCache
+func (x *TankerCoordinatorRequest) GetRefuelBelowPct() int32 {
+	if x != nil {
+		return x.RefuelBelowPct
+	}
+	return 0
 }
 
-func (x *ScoutMarketsResponse) Reset() {
-	*x = ScoutMarketsResponse{}
-	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[54]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *TankerCoordinatorRequest) GetReloadBelowUnits() int32 {
+	if x != nil {
+		return x.ReloadBelowUnits
+	}
+	return 0
 }
 
-func (x *ScoutMarketsResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*ScoutMarketsResponse) ProtoMessage() {}
-
-func (x *ScoutMarketsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[54]
-	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use ScoutMarketsResponse.ProtoReflect.Descriptor instead.
-func (*ScoutMarketsResponse) Descriptor() ([]byte, []int) {
-	return file_pkg_proto_daemon_daemon_proto_rawDescGZIP(), []int{54}
-}
-
-func (x *ScoutMarketsResponse) GetContainerIds() []string {
-	if x != nil {
-		return x.ContainerIds
-	}
-	return nil
-}
-
-func (x *ScoutMarketsResponse) GetAssignments() map[string]*MarketAssignment {
-	if x != nil {
-		return x.Assignments
-	}
-	return nil
-}
-
-func (x *ScoutMarketsResponse) GetReusedContainers() []string {
+func (x *TankerCoordinatorRequest) GetTickIntervalSecs() int32 {
 	if x != nil {
-		return x.ReusedContainers
+		return x.TickIntervalSecs
 	}
-	return nil
+	return 0
 }
 
-// MarketAssignment represents markets assigned to a ship
-type MarketAssignment struct {
+type TankerCoordinatorResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Markets       []string               `protobuf:"bytes,1,rep,name=markets,proto3" json:"markets,omitempty"`
+	ContainerId   string                 `protobuf:"bytes,1,opt,name=container_id,json=containerId,proto3" json:"container_id,omitempty"`
+	Status        string                 `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *MarketAssignment) Reset() {
-	*x = MarketAssignment{}
+func (x *TankerCoordinatorResponse) Reset() {
+	*x = TankerCoordinatorResponse{}
 	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[55]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *MarketAssignment) String() string {
+func (x *TankerCoordinatorResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MarketAssignment) ProtoMessage() {}
+func (*TankerCoordinatorResponse) ProtoMessage() {}
 
-func (x *MarketAssignment) ProtoReflect() protoreflect.Message {
+func (x *TankerCoordinatorResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[55]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3882,42 +4091,51 @@ func (x *MarketAssignment) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use MarketAssignment.ProtoReflect.Descriptor instead.
-func (*MarketAssignment) Descriptor() ([]byte, []int) {
+// Deprecated: Use TankerCoordinatorResponse.ProtoReflect.Descriptor instead.
+func (*TankerCoordinatorResponse) Descriptor() ([]byte, []int) {
 	return file_pkg_proto_daemon_daemon_proto_rawDescGZIP(), []int{55}
 }
 
-func (x *MarketAssignment) GetMarkets() []string {
+func (x *TankerCoordinatorResponse) GetContainerId() string {
 	if x != nil {
-		return x.Markets
+		return x.ContainerId
 	}
-	return nil
+	return ""
 }
 
-// AssignScoutingFleetRequest auto-discovers and assigns scouting fleet
-type AssignScoutingFleetRequest struct {
+func (x *TankerCoordinatorResponse) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+// WorkerRebalancerCoordinatorRequest starts the standing worker-rebalancer coordinator
+// (sp-f5pr). All tuning lives in config.yaml's [worker_rebalancer] section (resolved live
+// on every build); this request only names the player/agent and the dry-run launch flag.
+type WorkerRebalancerCoordinatorRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	SystemSymbol  string                 `protobuf:"bytes,1,opt,name=system_symbol,json=systemSymbol,proto3" json:"system_symbol,omitempty"`
-	PlayerId      int32                  `protobuf:"varint,2,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"`
-	AgentSymbol   *string                `protobuf:"bytes,3,opt,name=agent_symbol,json=agentSymbol,proto3,oneof" json:"agent_symbol,omitempty"`
+	PlayerId      int32                  `protobuf:"varint,1,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"`
+	AgentSymbol   *string                `protobuf:"bytes,2,opt,name=agent_symbol,json=agentSymbol,proto3,oneof" json:"agent_symbol,omitempty"`
+	DryRun        bool                   `protobuf:"varint,3,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"` // decide + log the ferry it would dispatch, but ferry nothing
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *AssignScoutingFleetRequest) Reset() {
-	*x = AssignScoutingFleetRequest{}
+func (x *WorkerRebalancerCoordinatorRequest) Reset() {
+	*x = WorkerRebalancerCoordinatorRequest{}
 	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[56]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *AssignScoutingFleetRequest) String() string {
+func (x *WorkerRebalancerCoordinatorRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AssignScoutingFleetRequest) ProtoMessage() {}
+func (*WorkerRebalancerCoordinatorRequest) ProtoMessage() {}
 
-func (x *AssignScoutingFleetRequest) ProtoReflect() protoreflect.Message {
+func (x *WorkerRebalancerCoordinatorRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[56]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3929,53 +4147,54 @@ func (x *AssignScoutingFleetRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AssignScoutingFleetRequest.ProtoReflect.Descriptor instead.
-func (*AssignScoutingFleetRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use WorkerRebalancerCoordinatorRequest.ProtoReflect.Descriptor instead.
+func (*WorkerRebalancerCoordinatorRequest) Descriptor() ([]byte, []int) {
 	return file_pkg_proto_daemon_daemon_proto_rawDescGZIP(), []int{56}
 }
 
-func (x *AssignScoutingFleetRequest) GetSystemSymbol() string {
-	if x != nil {
-		return x.SystemSymbol
-	}
-	return ""
-}
-
-func (x *AssignScoutingFleetRequest) GetPlayerId() int32 {
+func (x *WorkerRebalancerCoordinatorRequest) GetPlayerId() int32 {
 	if x != nil {
 		return x.PlayerId
 	}
 	return 0
 }
 
-func (x *AssignScoutingFleetRequest) GetAgentSymbol() string {
+func (x *WorkerRebalancerCoordinatorRequest) GetAgentSymbol() string {
 	if x != nil && x.AgentSymbol != nil {
 		return *x.AgentSymbol
 	}
 	return ""
 }
 
-type AssignScoutingFleetResponse struct {
+func (x *WorkerRebalancerCoordinatorRequest) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+type WorkerRebalancerCoordinatorResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	ContainerId   string                 `protobuf:"bytes,1,opt,name=container_id,json=containerId,proto3" json:"container_id,omitempty"` // Fleet-assignment container ID
+	ContainerId   string                 `protobuf:"bytes,1,opt,name=container_id,json=containerId,proto3" json:"container_id,omitempty"`
+	Status        string                 `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *AssignScoutingFleetResponse) Reset() {
-	*x = AssignScoutingFleetResponse{}
+func (x *WorkerRebalancerCoordinatorResponse) Reset() {
+	*x = WorkerRebalancerCoordinatorResponse{}
 	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[57]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *AssignScoutingFleetResponse) String() string {
+func (x *WorkerRebalancerCoordinatorResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AssignScoutingFleetResponse) ProtoMessage() {}
+func (*WorkerRebalancerCoordinatorResponse) ProtoMessage() {}
 
-func (x *AssignScoutingFleetResponse) ProtoReflect() protoreflect.Message {
+func (x *WorkerRebalancerCoordinatorResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[57]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3987,43 +4206,54 @@ func (x *AssignScoutingFleetResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AssignScoutingFleetResponse.ProtoReflect.Descriptor instead.
-func (*AssignScoutingFleetResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use WorkerRebalancerCoordinatorResponse.ProtoReflect.Descriptor instead.
+func (*WorkerRebalancerCoordinatorResponse) Descriptor() ([]byte, []int) {
 	return file_pkg_proto_daemon_daemon_proto_rawDescGZIP(), []int{57}
 }
 
-func (x *AssignScoutingFleetResponse) GetContainerId() string {
+func (x *WorkerRebalancerCoordinatorResponse) GetContainerId() string {
 	if x != nil {
 		return x.ContainerId
 	}
 	return ""
 }
 
-// ListContainersRequest lists all containers
-type ListContainersRequest struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// Optional filter by player ID
-	PlayerId *int32 `protobuf:"varint,1,opt,name=player_id,json=playerId,proto3,oneof" json:"player_id,omitempty"`
-	// Optional filter by container status
-	Status        *string `protobuf:"bytes,2,opt,name=status,proto3,oneof" json:"status,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *WorkerRebalancerCoordinatorResponse) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
 }
 
-func (x *ListContainersRequest) Reset() {
-	*x = ListContainersRequest{}
+// AddScoutPostRequest adds or updates a post. An existing post's live assignment
+// is preserved; freshness, kind, and the hull budget change. hulls is the probe
+// budget N (sp-enry); 0 defaults to 1 (single-hull, the pre-enry behavior).
+type AddScoutPostRequest struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	PlayerId         int32                  `protobuf:"varint,1,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"`
+	AgentSymbol      *string                `protobuf:"bytes,2,opt,name=agent_symbol,json=agentSymbol,proto3,oneof" json:"agent_symbol,omitempty"`
+	SystemSymbol     string                 `protobuf:"bytes,3,opt,name=system_symbol,json=systemSymbol,proto3" json:"system_symbol,omitempty"`
+	FreshnessSeconds int32                  `protobuf:"varint,4,opt,name=freshness_seconds,json=freshnessSeconds,proto3" json:"freshness_seconds,omitempty"`
+	Kind             string                 `protobuf:"bytes,5,opt,name=kind,proto3" json:"kind,omitempty"`
+	Hulls            int32                  `protobuf:"varint,6,opt,name=hulls,proto3" json:"hulls,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *AddScoutPostRequest) Reset() {
+	*x = AddScoutPostRequest{}
 	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[58]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListContainersRequest) String() string {
+func (x *AddScoutPostRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListContainersRequest) ProtoMessage() {}
+func (*AddScoutPostRequest) ProtoMessage() {}
 
-func (x *ListContainersRequest) ProtoReflect() protoreflect.Message {
+func (x *AddScoutPostRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[58]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4035,46 +4265,74 @@ func (x *ListContainersRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListContainersRequest.ProtoReflect.Descriptor instead.
-func (*ListContainersRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use AddScoutPostRequest.ProtoReflect.Descriptor instead.
+func (*AddScoutPostRequest) Descriptor() ([]byte, []int) {
 	return file_pkg_proto_daemon_daemon_proto_rawDescGZIP(), []int{58}
 }
 
-func (x *ListContainersRequest) GetPlayerId() int32 {
-	if x != nil && x.PlayerId != nil {
-		return *x.PlayerId
+func (x *AddScoutPostRequest) GetPlayerId() int32 {
+	if x != nil {
+		return x.PlayerId
 	}
 	return 0
 }
 
-func (x *ListContainersRequest) GetStatus() string {
-	if x != nil && x.Status != nil {
-		return *x.Status
+func (x *AddScoutPostRequest) GetAgentSymbol() string {
+	if x != nil && x.AgentSymbol != nil {
+		return *x.AgentSymbol
 	}
 	return ""
 }
 
-type ListContainersResponse struct {
+func (x *AddScoutPostRequest) GetSystemSymbol() string {
+	if x != nil {
+		return x.SystemSymbol
+	}
+	return ""
+}
+
+func (x *AddScoutPostRequest) GetFreshnessSeconds() int32 {
+	if x != nil {
+		return x.FreshnessSeconds
+	}
+	return 0
+}
+
+func (x *AddScoutPostRequest) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *AddScoutPostRequest) GetHulls() int32 {
+	if x != nil {
+		return x.Hulls
+	}
+	return 0
+}
+
+type ScoutPostResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Containers    []*ContainerInfo       `protobuf:"bytes,1,rep,name=containers,proto3" json:"containers,omitempty"`
+	Post          *ScoutPost             `protobuf:"bytes,1,opt,name=post,proto3" json:"post,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListContainersResponse) Reset() {
-	*x = ListContainersResponse{}
+func (x *ScoutPostResponse) Reset() {
+	*x = ScoutPostResponse{}
 	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[59]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListContainersResponse) String() string {
+func (x *ScoutPostResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListContainersResponse) ProtoMessage() {}
+func (*ScoutPostResponse) ProtoMessage() {}
 
-func (x *ListContainersResponse) ProtoReflect() protoreflect.Message {
+func (x *ScoutPostResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[59]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4086,51 +4344,42 @@ func (x *ListContainersResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListContainersResponse.ProtoReflect.Descriptor instead.
-func (*ListContainersResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use ScoutPostResponse.ProtoReflect.Descriptor instead.
+func (*ScoutPostResponse) Descriptor() ([]byte, []int) {
 	return file_pkg_proto_daemon_daemon_proto_rawDescGZIP(), []int{59}
 }
 
-func (x *ListContainersResponse) GetContainers() []*ContainerInfo {
+func (x *ScoutPostResponse) GetPost() *ScoutPost {
 	if x != nil {
-		return x.Containers
+		return x.Post
 	}
 	return nil
 }
 
-type ContainerInfo struct {
+// RemoveScoutPostRequest removes a post and releases its hull if manned.
+type RemoveScoutPostRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	ContainerId   string                 `protobuf:"bytes,1,opt,name=container_id,json=containerId,proto3" json:"container_id,omitempty"`
-	ContainerType string                 `protobuf:"bytes,2,opt,name=container_type,json=containerType,proto3" json:"container_type,omitempty"`
-	Status        string                 `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`
-	PlayerId      int32                  `protobuf:"varint,4,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"`
-	CreatedAt     string                 `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	UpdatedAt     string                 `protobuf:"bytes,6,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
-	// Iteration tracking for loops
-	CurrentIteration int32 `protobuf:"varint,7,opt,name=current_iteration,json=currentIteration,proto3" json:"current_iteration,omitempty"`
-	MaxIterations    int32 `protobuf:"varint,8,opt,name=max_iterations,json=maxIterations,proto3" json:"max_iterations,omitempty"`
-	// Restart tracking
-	RestartCount int32 `protobuf:"varint,9,opt,name=restart_count,json=restartCount,proto3" json:"restart_count,omitempty"`
-	// Parent container ID (null for root containers)
-	ParentContainerId *string `protobuf:"bytes,10,opt,name=parent_container_id,json=parentContainerId,proto3,oneof" json:"parent_container_id,omitempty"`
-	unknownFields     protoimpl.UnknownFields
-	sizeCache         protoimpl.SizeCache
-}
-
-func (x *ContainerInfo) Reset() {
-	*x = ContainerInfo{}
+	PlayerId      int32                  `protobuf:"varint,1,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"`
+	AgentSymbol   *string                `protobuf:"bytes,2,opt,name=agent_symbol,json=agentSymbol,proto3,oneof" json:"agent_symbol,omitempty"`
+	SystemSymbol  string                 `protobuf:"bytes,3,opt,name=system_symbol,json=systemSymbol,proto3" json:"system_symbol,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveScoutPostRequest) Reset() {
+	*x = RemoveScoutPostRequest{}
 	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[60]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ContainerInfo) String() string {
+func (x *RemoveScoutPostRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ContainerInfo) ProtoMessage() {}
+func (*RemoveScoutPostRequest) ProtoMessage() {}
 
-func (x *ContainerInfo) ProtoReflect() protoreflect.Message {
+func (x *RemoveScoutPostRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[60]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4142,103 +4391,53 @@ func (x *ContainerInfo) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ContainerInfo.ProtoReflect.Descriptor instead.
-func (*ContainerInfo) Descriptor() ([]byte, []int) {
+// Deprecated: Use RemoveScoutPostRequest.ProtoReflect.Descriptor instead.
+func (*RemoveScoutPostRequest) Descriptor() ([]byte, []int) {
 	return file_pkg_proto_daemon_daemon_proto_rawDescGZIP(), []int{60}
 }
 
-func (x *ContainerInfo) GetContainerId() string {
-	if x != nil {
-		return x.ContainerId
-	}
-	return ""
-}
-
-func (x *ContainerInfo) GetContainerType() string {
-	if x != nil {
-		return x.ContainerType
-	}
-	return ""
-}
-
-func (x *ContainerInfo) GetStatus() string {
-	if x != nil {
-		return x.Status
-	}
-	return ""
-}
-
-func (x *ContainerInfo) GetPlayerId() int32 {
+func (x *RemoveScoutPostRequest) GetPlayerId() int32 {
 	if x != nil {
 		return x.PlayerId
 	}
 	return 0
 }
 
-func (x *ContainerInfo) GetCreatedAt() string {
-	if x != nil {
-		return x.CreatedAt
-	}
-	return ""
-}
-
-func (x *ContainerInfo) GetUpdatedAt() string {
-	if x != nil {
-		return x.UpdatedAt
+func (x *RemoveScoutPostRequest) GetAgentSymbol() string {
+	if x != nil && x.AgentSymbol != nil {
+		return *x.AgentSymbol
 	}
 	return ""
 }
 
-func (x *ContainerInfo) GetCurrentIteration() int32 {
-	if x != nil {
-		return x.CurrentIteration
-	}
-	return 0
-}
-
-func (x *ContainerInfo) GetMaxIterations() int32 {
-	if x != nil {
-		return x.MaxIterations
-	}
-	return 0
-}
-
-func (x *ContainerInfo) GetRestartCount() int32 {
+func (x *RemoveScoutPostRequest) GetSystemSymbol() string {
 	if x != nil {
-		return x.RestartCount
-	}
-	return 0
-}
-
-func (x *ContainerInfo) GetParentContainerId() string {
-	if x != nil && x.ParentContainerId != nil {
-		return *x.ParentContainerId
+		return x.SystemSymbol
 	}
 	return ""
 }
 
-// GetContainerRequest retrieves container details
-type GetContainerRequest struct {
+type RemoveScoutPostResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	ContainerId   string                 `protobuf:"bytes,1,opt,name=container_id,json=containerId,proto3" json:"container_id,omitempty"`
+	Status        string                 `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetContainerRequest) Reset() {
-	*x = GetContainerRequest{}
+func (x *RemoveScoutPostResponse) Reset() {
+	*x = RemoveScoutPostResponse{}
 	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[61]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetContainerRequest) String() string {
+func (x *RemoveScoutPostResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetContainerRequest) ProtoMessage() {}
+func (*RemoveScoutPostResponse) ProtoMessage() {}
 
-func (x *GetContainerRequest) ProtoReflect() protoreflect.Message {
+func (x *RemoveScoutPostResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[61]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4250,40 +4449,40 @@ func (x *GetContainerRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetContainerRequest.ProtoReflect.Descriptor instead.
-func (*GetContainerRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use RemoveScoutPostResponse.ProtoReflect.Descriptor instead.
+func (*RemoveScoutPostResponse) Descriptor() ([]byte, []int) {
 	return file_pkg_proto_daemon_daemon_proto_rawDescGZIP(), []int{61}
 }
 
-func (x *GetContainerRequest) GetContainerId() string {
+func (x *RemoveScoutPostResponse) GetStatus() string {
 	if x != nil {
-		return x.ContainerId
+		return x.Status
 	}
 	return ""
 }
 
-type GetContainerResponse struct {
+type ListScoutPostsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Container     *ContainerInfo         `protobuf:"bytes,1,opt,name=container,proto3" json:"container,omitempty"`
-	Metadata      string                 `protobuf:"bytes,2,opt,name=metadata,proto3" json:"metadata,omitempty"` // JSON-encoded metadata
+	PlayerId      int32                  `protobuf:"varint,1,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"`
+	AgentSymbol   *string                `protobuf:"bytes,2,opt,name=agent_symbol,json=agentSymbol,proto3,oneof" json:"agent_symbol,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetContainerResponse) Reset() {
-	*x = GetContainerResponse{}
+func (x *ListScoutPostsRequest) Reset() {
+	*x = ListScoutPostsRequest{}
 	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[62]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetContainerResponse) String() string {
+func (x *ListScoutPostsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetContainerResponse) ProtoMessage() {}
+func (*ListScoutPostsRequest) ProtoMessage() {}
 
-func (x *GetContainerResponse) ProtoReflect() protoreflect.Message {
+func (x *ListScoutPostsRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[62]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4295,47 +4494,46 @@ func (x *GetContainerResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetContainerResponse.ProtoReflect.Descriptor instead.
-func (*GetContainerResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use ListScoutPostsRequest.ProtoReflect.Descriptor instead.
+func (*ListScoutPostsRequest) Descriptor() ([]byte, []int) {
 	return file_pkg_proto_daemon_daemon_proto_rawDescGZIP(), []int{62}
 }
 
-func (x *GetContainerResponse) GetContainer() *ContainerInfo {
+func (x *ListScoutPostsRequest) GetPlayerId() int32 {
 	if x != nil {
-		return x.Container
+		return x.PlayerId
 	}
-	return nil
+	return 0
 }
 
-func (x *GetContainerResponse) GetMetadata() string {
-	if x != nil {
-		return x.Metadata
+func (x *ListScoutPostsRequest) GetAgentSymbol() string {
+	if x != nil && x.AgentSymbol != nil {
+		return *x.AgentSymbol
 	}
 	return ""
 }
 
-// StopContainerRequest stops a container
-type StopContainerRequest struct {
+type ListScoutPostsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	ContainerId   string                 `protobuf:"bytes,1,opt,name=container_id,json=containerId,proto3" json:"container_id,omitempty"`
+	Posts         []*ScoutPost           `protobuf:"bytes,1,rep,name=posts,proto3" json:"posts,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *StopContainerRequest) Reset() {
-	*x = StopContainerRequest{}
+func (x *ListScoutPostsResponse) Reset() {
+	*x = ListScoutPostsResponse{}
 	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[63]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *StopContainerRequest) String() string {
+func (x *ListScoutPostsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*StopContainerRequest) ProtoMessage() {}
+func (*ListScoutPostsResponse) ProtoMessage() {}
 
-func (x *StopContainerRequest) ProtoReflect() protoreflect.Message {
+func (x *ListScoutPostsResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[63]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4347,41 +4545,45 @@ func (x *StopContainerRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use StopContainerRequest.ProtoReflect.Descriptor instead.
-func (*StopContainerRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ListScoutPostsResponse.ProtoReflect.Descriptor instead.
+func (*ListScoutPostsResponse) Descriptor() ([]byte, []int) {
 	return file_pkg_proto_daemon_daemon_proto_rawDescGZIP(), []int{63}
 }
 
-func (x *StopContainerRequest) GetContainerId() string {
+func (x *ListScoutPostsResponse) GetPosts() []*ScoutPost {
 	if x != nil {
-		return x.ContainerId
+		return x.Posts
 	}
-	return ""
+	return nil
 }
 
-type StopContainerResponse struct {
+// ScoutMarketsRequest initiates fleet market scouting with VRP optimization
+type ScoutMarketsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	ContainerId   string                 `protobuf:"bytes,1,opt,name=container_id,json=containerId,proto3" json:"container_id,omitempty"`
-	Status        string                 `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
-	Message       string                 `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+	ShipSymbols   []string               `protobuf:"bytes,1,rep,name=ship_symbols,json=shipSymbols,proto3" json:"ship_symbols,omitempty"`
+	SystemSymbol  string                 `protobuf:"bytes,2,opt,name=system_symbol,json=systemSymbol,proto3" json:"system_symbol,omitempty"`
+	Markets       []string               `protobuf:"bytes,3,rep,name=markets,proto3" json:"markets,omitempty"`
+	Iterations    int32                  `protobuf:"varint,4,opt,name=iterations,proto3" json:"iterations,omitempty"`
+	PlayerId      int32                  `protobuf:"varint,5,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"`
+	AgentSymbol   *string                `protobuf:"bytes,6,opt,name=agent_symbol,json=agentSymbol,proto3,oneof" json:"agent_symbol,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *StopContainerResponse) Reset() {
-	*x = StopContainerResponse{}
+func (x *ScoutMarketsRequest) Reset() {
+	*x = ScoutMarketsRequest{}
 	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[64]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *StopContainerResponse) String() string {
+func (x *ScoutMarketsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*StopContainerResponse) ProtoMessage() {}
+func (*ScoutMarketsRequest) ProtoMessage() {}
 
-func (x *StopContainerResponse) ProtoReflect() protoreflect.Message {
+func (x *ScoutMarketsRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[64]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4393,58 +4595,76 @@ func (x *StopContainerResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use StopContainerResponse.ProtoReflect.Descriptor instead.
-func (*StopContainerResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use ScoutMarketsRequest.ProtoReflect.Descriptor instead.
+func (*ScoutMarketsRequest) Descriptor() ([]byte, []int) {
 	return file_pkg_proto_daemon_daemon_proto_rawDescGZIP(), []int{64}
 }
 
-func (x *StopContainerResponse) GetContainerId() string {
+func (x *ScoutMarketsRequest) GetShipSymbols() []string {
 	if x != nil {
-		return x.ContainerId
+		return x.ShipSymbols
 	}
-	return ""
+	return nil
 }
 
-func (x *StopContainerResponse) GetStatus() string {
+func (x *ScoutMarketsRequest) GetSystemSymbol() string {
 	if x != nil {
-		return x.Status
+		return x.SystemSymbol
 	}
 	return ""
 }
 
-func (x *StopContainerResponse) GetMessage() string {
+func (x *ScoutMarketsRequest) GetMarkets() []string {
 	if x != nil {
-		return x.Message
+		return x.Markets
+	}
+	return nil
+}
+
+func (x *ScoutMarketsRequest) GetIterations() int32 {
+	if x != nil {
+		return x.Iterations
+	}
+	return 0
+}
+
+func (x *ScoutMarketsRequest) GetPlayerId() int32 {
+	if x != nil {
+		return x.PlayerId
+	}
+	return 0
+}
+
+func (x *ScoutMarketsRequest) GetAgentSymbol() string {
+	if x != nil && x.AgentSymbol != nil {
+		return *x.AgentSymbol
 	}
 	return ""
 }
 
-// GetContainerLogsRequest retrieves container logs
-type GetContainerLogsRequest struct {
-	state       protoimpl.MessageState `protogen:"open.v1"`
-	ContainerId string                 `protobuf:"bytes,1,opt,name=container_id,json=containerId,proto3" json:"container_id,omitempty"`
-	// Optional limit on number of log entries
-	Limit *int32 `protobuf:"varint,2,opt,name=limit,proto3,oneof" json:"limit,omitempty"`
-	// Optional filter by log level (INFO, WARNING, ERROR, DEBUG)
-	Level         *string `protobuf:"bytes,3,opt,name=level,proto3,oneof" json:"level,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type ScoutMarketsResponse struct {
+	state            protoimpl.MessageState       `protogen:"open.v1"`
+	ContainerIds     []string                     `protobuf:"bytes,1,rep,name=container_ids,json=containerIds,proto3" json:"container_ids,omitempty"`
+	Assignments      map[string]*MarketAssignment `protobuf:"bytes,2,rep,name=assignments,proto3" json:"assignments,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	ReusedContainers []string                     `protobuf:"bytes,3,rep,name=reused_containers,json=reusedContainers,proto3" json:"reused_containers,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
 }
 
-func (x *GetContainerLogsRequest) Reset() {
-	*x = GetContainerLogsRequest{}
+func (x *ScoutMarketsResponse) Reset() {
+	*x = ScoutMarketsResponse{}
 	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[65]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetContainerLogsRequest) String() string {
+func (x *ScoutMarketsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetContainerLogsRequest) ProtoMessage() {}
+func (*ScoutMarketsResponse) ProtoMessage() {}
 
-func (x *GetContainerLogsRequest) ProtoReflect() protoreflect.Message {
+func (x *ScoutMarketsResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[65]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4456,53 +4676,54 @@ func (x *GetContainerLogsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetContainerLogsRequest.ProtoReflect.Descriptor instead.
-func (*GetContainerLogsRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ScoutMarketsResponse.ProtoReflect.Descriptor instead.
+func (*ScoutMarketsResponse) Descriptor() ([]byte, []int) {
 	return file_pkg_proto_daemon_daemon_proto_rawDescGZIP(), []int{65}
 }
 
-func (x *GetContainerLogsRequest) GetContainerId() string {
+func (x *ScoutMarketsResponse) GetContainerIds() []string {
 	if x != nil {
-		return x.ContainerId
+		return x.ContainerIds
 	}
-	return ""
+	return nil
 }
 
-func (x *GetContainerLogsRequest) GetLimit() int32 {
-	if x != nil && x.Limit != nil {
-		return *x.Limit
+func (x *ScoutMarketsResponse) GetAssignments() map[string]*MarketAssignment {
+	if x != nil {
+		return x.Assignments
 	}
-	return 0
+	return nil
 }
 
-func (x *GetContainerLogsRequest) GetLevel() string {
-	if x != nil && x.Level != nil {
-		return *x.Level
+func (x *ScoutMarketsResponse) GetReusedContainers() []string {
+	if x != nil {
+		return x.ReusedContainers
 	}
-	return ""
+	return nil
 }
 
-type GetContainerLogsResponse struct {
+// MarketAssignment represents markets assigned to a ship
+type MarketAssignment struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Logs          []*LogEntry            `protobuf:"bytes,1,rep,name=logs,proto3" json:"logs,omitempty"`
+	Markets       []string               `protobuf:"bytes,1,rep,name=markets,proto3" json:"markets,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetContainerLogsResponse) Reset() {
-	*x = GetContainerLogsResponse{}
+func (x *MarketAssignment) Reset() {
+	*x = MarketAssignment{}
 	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[66]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetContainerLogsResponse) String() string {
+func (x *MarketAssignment) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetContainerLogsResponse) ProtoMessage() {}
+func (*MarketAssignment) ProtoMessage() {}
 
-func (x *GetContainerLogsResponse) ProtoReflect() protoreflect.Message {
+func (x *MarketAssignment) ProtoReflect() protoreflect.Message {
 	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[66]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4514,42 +4735,46 @@ func (x *GetContainerLogsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetContainerLogsResponse.ProtoReflect.Descriptor instead.
-func (*GetContainerLogsResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use MarketAssignment.ProtoReflect.Descriptor instead.
+func (*MarketAssignment) Descriptor() ([]byte, []int) {
 	return file_pkg_proto_daemon_daemon_proto_rawDescGZIP(), []int{66}
 }
 
-func (x *GetContainerLogsResponse) GetLogs() []*LogEntry {
+func (x *MarketAssignment) GetMarkets() []string {
 	if x != nil {
-		return x.Logs
+		return x.Markets
 	}
 	return nil
 }
 
-type LogEntry struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Timestamp     string                 `protobuf:"bytes,1,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
-	Level         string                 `protobuf:"bytes,2,opt,name=level,proto3" json:"level,omitempty"`
-	Message       string                 `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
-	Metadata      string                 `protobuf:"bytes,4,opt,name=metadata,proto3" json:"metadata,omitempty"` // JSON-encoded metadata
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+// AssignScoutingFleetRequest auto-discovers and assigns scouting fleet
+type AssignScoutingFleetRequest struct {
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	SystemSymbol string                 `protobuf:"bytes,1,opt,name=system_symbol,json=systemSymbol,proto3" json:"system_symbol,omitempty"`
+	PlayerId     int32                  `protobuf:"varint,2,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"`
+	AgentSymbol  *string                `protobuf:"bytes,3,opt,name=agent_symbol,json=agentSymbol,proto3,oneof" json:"agent_symbol,omitempty"`
+	// Market freshness SLA in seconds. >0 turns the assignment into a standing
+	// refresh loop that tours only the markets falling out of the SLA; 0 keeps the
+	// one-off full partition.
+	FreshnessSlaSeconds int32 `protobuf:"varint,4,opt,name=freshness_sla_seconds,json=freshnessSlaSeconds,proto3" json:"freshness_sla_seconds,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
 }
 
-func (x *LogEntry) Reset() {
-	*x = LogEntry{}
+func (x *AssignScoutingFleetRequest) Reset() {
+	*x = AssignScoutingFleetRequest{}
 	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[67]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *LogEntry) String() string {
+func (x *AssignScoutingFleetRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*LogEntry) ProtoMessage() {}
+func (*AssignScoutingFleetRequest) ProtoMessage() {}
 
-func (x *LogEntry) ProtoReflect() protoreflect.Message {
+func (x *AssignScoutingFleetRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[67]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4561,60 +4786,60 @@ func (x *LogEntry) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use LogEntry.ProtoReflect.Descriptor instead.
-func (*LogEntry) Descriptor() ([]byte, []int) {
+// Deprecated: Use AssignScoutingFleetRequest.ProtoReflect.Descriptor instead.
+func (*AssignScoutingFleetRequest) Descriptor() ([]byte, []int) {
 	return file_pkg_proto_daemon_daemon_proto_rawDescGZIP(), []int{67}
 }
 
-func (x *LogEntry) GetTimestamp() string {
+func (x *AssignScoutingFleetRequest) GetSystemSymbol() string {
 	if x != nil {
-		return x.Timestamp
+		return x.SystemSymbol
 	}
 	return ""
 }
 
-func (x *LogEntry) GetLevel() string {
+func (x *AssignScoutingFleetRequest) GetPlayerId() int32 {
 	if x != nil {
-		return x.Level
+		return x.PlayerId
 	}
-	return ""
+	return 0
 }
 
-func (x *LogEntry) GetMessage() string {
-	if x != nil {
-		return x.Message
+func (x *AssignScoutingFleetRequest) GetAgentSymbol() string {
+	if x != nil && x.AgentSymbol != nil {
+		return *x.AgentSymbol
 	}
 	return ""
 }
 
-func (x *LogEntry) GetMetadata() string {
+func (x *AssignScoutingFleetRequest) GetFreshnessSlaSeconds() int32 {
 	if x != nil {
-		return x.Metadata
+		return x.FreshnessSlaSeconds
 	}
-	return ""
+	return 0
 }
 
-// HealthCheckRequest verifies daemon status
-type HealthCheckRequest struct {
+type AssignScoutingFleetResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
+	ContainerId   string                 `protobuf:"bytes,1,opt,name=container_id,json=containerId,proto3" json:"container_id,omitempty"` // Fleet-assignment container ID
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *HealthCheckRequest) Reset() {
-	*x = HealthCheckRequest{}
+func (x *AssignScoutingFleetResponse) Reset() {
+	*x = AssignScoutingFleetResponse{}
 	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[68]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *HealthCheckRequest) String() string {
+func (x *AssignScoutingFleetResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*HealthCheckRequest) ProtoMessage() {}
+func (*AssignScoutingFleetResponse) ProtoMessage() {}
 
-func (x *HealthCheckRequest) ProtoReflect() protoreflect.Message {
+func (x *AssignScoutingFleetResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[68]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4626,34 +4851,43 @@ func (x *HealthCheckRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use HealthCheckRequest.ProtoReflect.Descriptor instead.
-func (*HealthCheckRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use AssignScoutingFleetResponse.ProtoReflect.Descriptor instead.
+func (*AssignScoutingFleetResponse) Descriptor() ([]byte, []int) {
 	return file_pkg_proto_daemon_daemon_proto_rawDescGZIP(), []int{68}
 }
 
-type HealthCheckResponse struct {
-	state            protoimpl.MessageState `protogen:"open.v1"`
-	Status           string                 `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"` // "ok" or error message
-	Version          string                 `protobuf:"bytes,2,opt,name=version,proto3" json:"version,omitempty"`
-	ActiveContainers int32                  `protobuf:"varint,3,opt,name=active_containers,json=activeContainers,proto3" json:"active_containers,omitempty"`
-	unknownFields    protoimpl.UnknownFields
-	sizeCache        protoimpl.SizeCache
+func (x *AssignScoutingFleetResponse) GetContainerId() string {
+	if x != nil {
+		return x.ContainerId
+	}
+	return ""
 }
 
-func (x *HealthCheckResponse) Reset() {
-	*x = HealthCheckResponse{}
+// ListContainersRequest lists all containers
+type ListContainersRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Optional filter by player ID
+	PlayerId *int32 `protobuf:"varint,1,opt,name=player_id,json=playerId,proto3,oneof" json:"player_id,omitempty"`
+	// Optional filter by container status
+	Status        *string `protobuf:"bytes,2,opt,name=status,proto3,oneof" json:"status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListContainersRequest) Reset() {
+	*x = ListContainersRequest{}
 	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[69]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *HealthCheckResponse) String() string {
+func (x *ListContainersRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*HealthCheckResponse) ProtoMessage() {}
+func (*ListContainersRequest) ProtoMessage() {}
 
-func (x *HealthCheckResponse) ProtoReflect() protoreflect.Message {
+func (x *ListContainersRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[69]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4665,54 +4899,46 @@ func (x *HealthCheckResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use HealthCheckResponse.ProtoReflect.Descriptor instead.
-func (*HealthCheckResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use ListContainersRequest.ProtoReflect.Descriptor instead.
+func (*ListContainersRequest) Descriptor() ([]byte, []int) {
 	return file_pkg_proto_daemon_daemon_proto_rawDescGZIP(), []int{69}
 }
 
-func (x *HealthCheckResponse) GetStatus() string {
-	if x != nil {
-		return x.Status
+func (x *ListContainersRequest) GetPlayerId() int32 {
+	if x != nil && x.PlayerId != nil {
+		return *x.PlayerId
 	}
-	return ""
+	return 0
 }
 
-func (x *HealthCheckResponse) GetVersion() string {
-	if x != nil {
-		return x.Version
+func (x *ListContainersRequest) GetStatus() string {
+	if x != nil && x.Status != nil {
+		return *x.Status
 	}
 	return ""
 }
 
-func (x *HealthCheckResponse) GetActiveContainers() int32 {
-	if x != nil {
-		return x.ActiveContainers
-	}
-	return 0
-}
-
-// GetAPIBudgetRequest requests a snapshot of API request-budget
-// observability (sp-51ti). Empty for now.
-type GetAPIBudgetRequest struct {
+type ListContainersResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
+	Containers    []*ContainerInfo       `protobuf:"bytes,1,rep,name=containers,proto3" json:"containers,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetAPIBudgetRequest) Reset() {
-	*x = GetAPIBudgetRequest{}
+func (x *ListContainersResponse) Reset() {
+	*x = ListContainersResponse{}
 	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[70]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetAPIBudgetRequest) String() string {
+func (x *ListContainersResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetAPIBudgetRequest) ProtoMessage() {}
+func (*ListContainersResponse) ProtoMessage() {}
 
-func (x *GetAPIBudgetRequest) ProtoReflect() protoreflect.Message {
+func (x *ListContainersResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[70]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4724,36 +4950,51 @@ func (x *GetAPIBudgetRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetAPIBudgetRequest.ProtoReflect.Descriptor instead.
-func (*GetAPIBudgetRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ListContainersResponse.ProtoReflect.Descriptor instead.
+func (*ListContainersResponse) Descriptor() ([]byte, []int) {
 	return file_pkg_proto_daemon_daemon_proto_rawDescGZIP(), []int{70}
 }
 
-// APIBudgetHullStats is one hull's share of the request budget within a
-// report window.
-type APIBudgetHullStats struct {
-	state            protoimpl.MessageState `protogen:"open.v1"`
-	Hull             string                 `protobuf:"bytes,1,opt,name=hull,proto3" json:"hull,omitempty"`
-	RequestsInWindow int32                  `protobuf:"varint,2,opt,name=requests_in_window,json=requestsInWindow,proto3" json:"requests_in_window,omitempty"`
-	ReqPerSec        float64                `protobuf:"fixed64,3,opt,name=req_per_sec,json=reqPerSec,proto3" json:"req_per_sec,omitempty"`
-	unknownFields    protoimpl.UnknownFields
-	sizeCache        protoimpl.SizeCache
+func (x *ListContainersResponse) GetContainers() []*ContainerInfo {
+	if x != nil {
+		return x.Containers
+	}
+	return nil
 }
 
-func (x *APIBudgetHullStats) Reset() {
-	*x = APIBudgetHullStats{}
+type ContainerInfo struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ContainerId   string                 `protobuf:"bytes,1,opt,name=container_id,json=containerId,proto3" json:"container_id,omitempty"`
+	ContainerType string                 `protobuf:"bytes,2,opt,name=container_type,json=containerType,proto3" json:"container_type,omitempty"`
+	Status        string                 `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`
+	PlayerId      int32                  `protobuf:"varint,4,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"`
+	CreatedAt     string                 `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     string                 `protobuf:"bytes,6,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	// Iteration tracking for loops
+	CurrentIteration int32 `protobuf:"varint,7,opt,name=current_iteration,json=currentIteration,proto3" json:"current_iteration,omitempty"`
+	MaxIterations    int32 `protobuf:"varint,8,opt,name=max_iterations,json=maxIterations,proto3" json:"max_iterations,omitempty"`
+	// Restart tracking
+	RestartCount int32 `protobuf:"varint,9,opt,name=restart_count,json=restartCount,proto3" json:"restart_count,omitempty"`
+	// Parent container ID (null for root containers)
+	ParentContainerId *string `protobuf:"bytes,10,opt,name=parent_container_id,json=parentContainerId,proto3,oneof" json:"parent_container_id,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *ContainerInfo) Reset() {
+	*x = ContainerInfo{}
 	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[71]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *APIBudgetHullStats) String() string {
+func (x *ContainerInfo) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*APIBudgetHullStats) ProtoMessage() {}
+func (*ContainerInfo) ProtoMessage() {}
 
-func (x *APIBudgetHullStats) ProtoReflect() protoreflect.Message {
+func (x *ContainerInfo) ProtoReflect() protoreflect.Message {
 	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[71]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4765,194 +5006,148 @@ func (x *APIBudgetHullStats) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use APIBudgetHullStats.ProtoReflect.Descriptor instead.
-func (*APIBudgetHullStats) Descriptor() ([]byte, []int) {
+// Deprecated: Use ContainerInfo.ProtoReflect.Descriptor instead.
+func (*ContainerInfo) Descriptor() ([]byte, []int) {
 	return file_pkg_proto_daemon_daemon_proto_rawDescGZIP(), []int{71}
 }
 
-func (x *APIBudgetHullStats) GetHull() string {
+func (x *ContainerInfo) GetContainerId() string {
 	if x != nil {
-		return x.Hull
+		return x.ContainerId
 	}
 	return ""
 }
 
-func (x *APIBudgetHullStats) GetRequestsInWindow() int32 {
+func (x *ContainerInfo) GetContainerType() string {
 	if x != nil {
-		return x.RequestsInWindow
+		return x.ContainerType
 	}
-	return 0
+	return ""
 }
 
-func (x *APIBudgetHullStats) GetReqPerSec() float64 {
+func (x *ContainerInfo) GetStatus() string {
 	if x != nil {
-		return x.ReqPerSec
+		return x.Status
+	}
+	return ""
+}
+
+func (x *ContainerInfo) GetPlayerId() int32 {
+	if x != nil {
+		return x.PlayerId
 	}
 	return 0
 }
 
-// APIBudgetReport mirrors internal/domain/apibudget.Report: global
-// utilization vs the rate ceiling, 429 rate, purpose-share breakdown (poll
-// vs transact vs retry), and the top consumers by hull.
-type APIBudgetReport struct {
-	state                 protoimpl.MessageState `protogen:"open.v1"`
-	WindowSeconds         float64                `protobuf:"fixed64,1,opt,name=window_seconds,json=windowSeconds,proto3" json:"window_seconds,omitempty"`
-	TotalRequests         int32                  `protobuf:"varint,2,opt,name=total_requests,json=totalRequests,proto3" json:"total_requests,omitempty"`
-	GlobalReqPerSec       float64                `protobuf:"fixed64,3,opt,name=global_req_per_sec,json=globalReqPerSec,proto3" json:"global_req_per_sec,omitempty"`
-	CeilingReqPerSec      float64                `protobuf:"fixed64,4,opt,name=ceiling_req_per_sec,json=ceilingReqPerSec,proto3" json:"ceiling_req_per_sec,omitempty"`
-	UtilizationPct        float64                `protobuf:"fixed64,5,opt,name=utilization_pct,json=utilizationPct,proto3" json:"utilization_pct,omitempty"`
-	HeadroomReqPerSec     float64                `protobuf:"fixed64,6,opt,name=headroom_req_per_sec,json=headroomReqPerSec,proto3" json:"headroom_req_per_sec,omitempty"`
-	RateLimited_429       int32                  `protobuf:"varint,7,opt,name=rate_limited_429,json=rateLimited429,proto3" json:"rate_limited_429,omitempty"`
-	RateLimited_429PerMin float64                `protobuf:"fixed64,8,opt,name=rate_limited_429_per_min,json=rateLimited429PerMin,proto3" json:"rate_limited_429_per_min,omitempty"`
-	PurposeCounts         map[string]int32       `protobuf:"bytes,9,rep,name=purpose_counts,json=purposeCounts,proto3" json:"purpose_counts,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"varint,2,opt,name=value"`
-	PurposeSharePct       map[string]float64     `protobuf:"bytes,10,rep,name=purpose_share_pct,json=purposeSharePct,proto3" json:"purpose_share_pct,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"fixed64,2,opt,name=value"`
-	HullsToCeiling        float64                `protobuf:"fixed64,11,opt,name=hulls_to_ceiling,json=hullsToCeiling,proto3" json:"hulls_to_ceiling,omitempty"`
-	PerHull               []*APIBudgetHullStats  `protobuf:"bytes,12,rep,name=per_hull,json=perHull,proto3" json:"per_hull,omitempty"`
-	unknownFields         protoimpl.UnknownFields
-	sizeCache             protoimpl.SizeCache
-}
-
-func (x *APIBudgetReport) Reset() {
-	*x = APIBudgetReport{}
-	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[72]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *APIBudgetReport) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*APIBudgetReport) ProtoMessage() {}
-
-func (x *APIBudgetReport) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[72]
+func (x *ContainerInfo) GetCreatedAt() string {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.CreatedAt
 	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use APIBudgetReport.ProtoReflect.Descriptor instead.
-func (*APIBudgetReport) Descriptor() ([]byte, []int) {
-	return file_pkg_proto_daemon_daemon_proto_rawDescGZIP(), []int{72}
+	return ""
 }
 
-func (x *APIBudgetReport) GetWindowSeconds() float64 {
+func (x *ContainerInfo) GetUpdatedAt() string {
 	if x != nil {
-		return x.WindowSeconds
+		return x.UpdatedAt
 	}
-	return 0
+	return ""
 }
 
-func (x *APIBudgetReport) GetTotalRequests() int32 {
+func (x *ContainerInfo) GetCurrentIteration() int32 {
 	if x != nil {
-		return x.TotalRequests
+		return x.CurrentIteration
 	}
 	return 0
 }
 
-func (x *APIBudgetReport) GetGlobalReqPerSec() float64 {
+func (x *ContainerInfo) GetMaxIterations() int32 {
 	if x != nil {
-		return x.GlobalReqPerSec
+		return x.MaxIterations
 	}
 	return 0
 }
 
-func (x *APIBudgetReport) GetCeilingReqPerSec() float64 {
+func (x *ContainerInfo) GetRestartCount() int32 {
 	if x != nil {
-		return x.CeilingReqPerSec
+		return x.RestartCount
 	}
 	return 0
 }
 
-func (x *APIBudgetReport) GetUtilizationPct() float64 {
-	if x != nil {
-		return x.UtilizationPct
+func (x *ContainerInfo) GetParentContainerId() string {
+	if x != nil && x.ParentContainerId != nil {
+		return *x.ParentContainerId
 	}
-	return 0
+	return ""
 }
 
-func (x *APIBudgetReport) GetHeadroomReqPerSec() float64 {
-	if x != nil {
-		return x.HeadroomReqPerSec
-	}
-	return 0
+// GetContainerRequest retrieves container details
+type GetContainerRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ContainerId   string                 `protobuf:"bytes,1,opt,name=container_id,json=containerId,proto3" json:"container_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *APIBudgetReport) GetRateLimited_429() int32 {
-	if x != nil {
-		return x.RateLimited_429
-	}
-	return 0
+func (x *GetContainerRequest) Reset() {
+	*x = GetContainerRequest{}
+	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[72]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *APIBudgetReport) GetRateLimited_429PerMin() float64 {
-	if x != nil {
-		return x.RateLimited_429PerMin
-	}
-	return 0
+func (x *GetContainerRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *APIBudgetReport) GetPurposeCounts() map[string]int32 {
-	if x != nil {
-		return x.PurposeCounts
-	}
-	return nil
-}
+func (*GetContainerRequest) ProtoMessage() {}
 
-func (x *APIBudgetReport) GetPurposeSharePct() map[string]float64 {
+func (x *GetContainerRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[72]
 	if x != nil {
-		return x.PurposeSharePct
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
 }
 
-func (x *APIBudgetReport) GetHullsToCeiling() float64 {
-	if x != nil {
-		return x.HullsToCeiling
-	}
-	return 0
+// Deprecated: Use GetContainerRequest.ProtoReflect.Descriptor instead.
+func (*GetContainerRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_daemon_daemon_proto_rawDescGZIP(), []int{72}
 }
 
-func (x *APIBudgetReport) GetPerHull() []*APIBudgetHullStats {
+func (x *GetContainerRequest) GetContainerId() string {
 	if x != nil {
-		return x.PerHull
+		return x.ContainerId
 	}
-	return nil
+	return ""
 }
 
-// DutyCycleHullStats is one hull's earning/idle breakdown within the
-// duty-cycle sampling window (sp-51ti captain amendment).
-type DutyCycleHullStats struct {
+type GetContainerResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Hull          string                 `protobuf:"bytes,1,opt,name=hull,proto3" json:"hull,omitempty"`
-	EarningHours  float64                `protobuf:"fixed64,2,opt,name=earning_hours,json=earningHours,proto3" json:"earning_hours,omitempty"`
-	IdleHours     float64                `protobuf:"fixed64,3,opt,name=idle_hours,json=idleHours,proto3" json:"idle_hours,omitempty"`
-	EarningPct    float64                `protobuf:"fixed64,4,opt,name=earning_pct,json=earningPct,proto3" json:"earning_pct,omitempty"`
-	SampleCount   int32                  `protobuf:"varint,5,opt,name=sample_count,json=sampleCount,proto3" json:"sample_count,omitempty"`
+	Container     *ContainerInfo         `protobuf:"bytes,1,opt,name=container,proto3" json:"container,omitempty"`
+	Metadata      string                 `protobuf:"bytes,2,opt,name=metadata,proto3" json:"metadata,omitempty"` // JSON-encoded metadata
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DutyCycleHullStats) Reset() {
-	*x = DutyCycleHullStats{}
+func (x *GetContainerResponse) Reset() {
+	*x = GetContainerResponse{}
 	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[73]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DutyCycleHullStats) String() string {
+func (x *GetContainerResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DutyCycleHullStats) ProtoMessage() {}
+func (*GetContainerResponse) ProtoMessage() {}
 
-func (x *DutyCycleHullStats) ProtoReflect() protoreflect.Message {
+func (x *GetContainerResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[73]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4964,71 +5159,47 @@ func (x *DutyCycleHullStats) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DutyCycleHullStats.ProtoReflect.Descriptor instead.
-func (*DutyCycleHullStats) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetContainerResponse.ProtoReflect.Descriptor instead.
+func (*GetContainerResponse) Descriptor() ([]byte, []int) {
 	return file_pkg_proto_daemon_daemon_proto_rawDescGZIP(), []int{73}
 }
 
-func (x *DutyCycleHullStats) GetHull() string {
-	if x != nil {
-		return x.Hull
-	}
-	return ""
-}
-
-func (x *DutyCycleHullStats) GetEarningHours() float64 {
-	if x != nil {
-		return x.EarningHours
-	}
-	return 0
-}
-
-func (x *DutyCycleHullStats) GetIdleHours() float64 {
-	if x != nil {
-		return x.IdleHours
-	}
-	return 0
-}
-
-func (x *DutyCycleHullStats) GetEarningPct() float64 {
+func (x *GetContainerResponse) GetContainer() *ContainerInfo {
 	if x != nil {
-		return x.EarningPct
+		return x.Container
 	}
-	return 0
+	return nil
 }
 
-func (x *DutyCycleHullStats) GetSampleCount() int32 {
+func (x *GetContainerResponse) GetMetadata() string {
 	if x != nil {
-		return x.SampleCount
+		return x.Metadata
 	}
-	return 0
+	return ""
 }
 
-// DutyCycleReport mirrors internal/domain/dutycycle.Report: ship-hours
-// EARNING/day per hull, the KPI that makes the 20x duty-cycle gap
-// measurable.
-type DutyCycleReport struct {
+// StopContainerRequest stops a container
+type StopContainerRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	WindowHours   float64                `protobuf:"fixed64,1,opt,name=window_hours,json=windowHours,proto3" json:"window_hours,omitempty"`
-	Hulls         []*DutyCycleHullStats  `protobuf:"bytes,2,rep,name=hulls,proto3" json:"hulls,omitempty"`
+	ContainerId   string                 `protobuf:"bytes,1,opt,name=container_id,json=containerId,proto3" json:"container_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DutyCycleReport) Reset() {
-	*x = DutyCycleReport{}
+func (x *StopContainerRequest) Reset() {
+	*x = StopContainerRequest{}
 	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[74]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DutyCycleReport) String() string {
+func (x *StopContainerRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DutyCycleReport) ProtoMessage() {}
+func (*StopContainerRequest) ProtoMessage() {}
 
-func (x *DutyCycleReport) ProtoReflect() protoreflect.Message {
+func (x *StopContainerRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[74]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -5040,50 +5211,41 @@ func (x *DutyCycleReport) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DutyCycleReport.ProtoReflect.Descriptor instead.
-func (*DutyCycleReport) Descriptor() ([]byte, []int) {
+// Deprecated: Use StopContainerRequest.ProtoReflect.Descriptor instead.
+func (*StopContainerRequest) Descriptor() ([]byte, []int) {
 	return file_pkg_proto_daemon_daemon_proto_rawDescGZIP(), []int{74}
 }
 
-func (x *DutyCycleReport) GetWindowHours() float64 {
-	if x != nil {
-		return x.WindowHours
-	}
-	return 0
-}
-
-func (x *DutyCycleReport) GetHulls() []*DutyCycleHullStats {
+func (x *StopContainerRequest) GetContainerId() string {
 	if x != nil {
-		return x.Hulls
+		return x.ContainerId
 	}
-	return nil
+	return ""
 }
 
-type GetAPIBudgetResponse struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// Current is a narrow (~10s) window, close to instantaneous utilization.
-	Current *APIBudgetReport `protobuf:"bytes,1,opt,name=current,proto3" json:"current,omitempty"`
-	// Rolling5m is a 5-minute rolling window, smoothing out bursts.
-	Rolling_5M    *APIBudgetReport `protobuf:"bytes,2,opt,name=rolling_5m,json=rolling5m,proto3" json:"rolling_5m,omitempty"`
-	DutyCycle     *DutyCycleReport `protobuf:"bytes,3,opt,name=duty_cycle,json=dutyCycle,proto3" json:"duty_cycle,omitempty"`
+type StopContainerResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ContainerId   string                 `protobuf:"bytes,1,opt,name=container_id,json=containerId,proto3" json:"container_id,omitempty"`
+	Status        string                 `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	Message       string                 `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetAPIBudgetResponse) Reset() {
-	*x = GetAPIBudgetResponse{}
+func (x *StopContainerResponse) Reset() {
+	*x = StopContainerResponse{}
 	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[75]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetAPIBudgetResponse) String() string {
+func (x *StopContainerResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetAPIBudgetResponse) ProtoMessage() {}
+func (*StopContainerResponse) ProtoMessage() {}
 
-func (x *GetAPIBudgetResponse) ProtoReflect() protoreflect.Message {
+func (x *StopContainerResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[75]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -5095,57 +5257,54 @@ func (x *GetAPIBudgetResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetAPIBudgetResponse.ProtoReflect.Descriptor instead.
-func (*GetAPIBudgetResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use StopContainerResponse.ProtoReflect.Descriptor instead.
+func (*StopContainerResponse) Descriptor() ([]byte, []int) {
 	return file_pkg_proto_daemon_daemon_proto_rawDescGZIP(), []int{75}
 }
 
-func (x *GetAPIBudgetResponse) GetCurrent() *APIBudgetReport {
+func (x *StopContainerResponse) GetContainerId() string {
 	if x != nil {
-		return x.Current
+		return x.ContainerId
 	}
-	return nil
+	return ""
 }
 
-func (x *GetAPIBudgetResponse) GetRolling_5M() *APIBudgetReport {
+func (x *StopContainerResponse) GetStatus() string {
 	if x != nil {
-		return x.Rolling_5M
+		return x.Status
 	}
-	return nil
+	return ""
 }
 
-func (x *GetAPIBudgetResponse) GetDutyCycle() *DutyCycleReport {
+func (x *StopContainerResponse) GetMessage() string {
 	if x != nil {
-		return x.DutyCycle
+		return x.Message
 	}
-	return nil
+	return ""
 }
 
-// ListShipsRequest lists all ships for a player
-type ListShipsRequest struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// Optional player ID filter
-	PlayerId *int32 `protobuf:"varint,1,opt,name=player_id,json=playerId,proto3,oneof" json:"player_id,omitempty"`
-	// Optional agent symbol (alternative to player_id)
-	AgentSymbol   *string `protobuf:"bytes,2,opt,name=agent_symbol,json=agentSymbol,proto3,oneof" json:"agent_symbol,omitempty"`
+// PauseContainerRequest pauses a running container and its running children
+type PauseContainerRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ContainerId   string                 `protobuf:"bytes,1,opt,name=container_id,json=containerId,proto3" json:"container_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListShipsRequest) Reset() {
-	*x = ListShipsRequest{}
+func (x *PauseContainerRequest) Reset() {
+	*x = PauseContainerRequest{}
 	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[76]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListShipsRequest) String() string {
+func (x *PauseContainerRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListShipsRequest) ProtoMessage() {}
+func (*PauseContainerRequest) ProtoMessage() {}
 
-func (x *ListShipsRequest) ProtoReflect() protoreflect.Message {
+func (x *PauseContainerRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[76]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -5157,46 +5316,41 @@ func (x *ListShipsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListShipsRequest.ProtoReflect.Descriptor instead.
-func (*ListShipsRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use PauseContainerRequest.ProtoReflect.Descriptor instead.
+func (*PauseContainerRequest) Descriptor() ([]byte, []int) {
 	return file_pkg_proto_daemon_daemon_proto_rawDescGZIP(), []int{76}
 }
 
-func (x *ListShipsRequest) GetPlayerId() int32 {
-	if x != nil && x.PlayerId != nil {
-		return *x.PlayerId
-	}
-	return 0
-}
-
-func (x *ListShipsRequest) GetAgentSymbol() string {
-	if x != nil && x.AgentSymbol != nil {
-		return *x.AgentSymbol
+func (x *PauseContainerRequest) GetContainerId() string {
+	if x != nil {
+		return x.ContainerId
 	}
 	return ""
 }
 
-type ListShipsResponse struct {
+type PauseContainerResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Ships         []*ShipInfo            `protobuf:"bytes,1,rep,name=ships,proto3" json:"ships,omitempty"`
+	ContainerId   string                 `protobuf:"bytes,1,opt,name=container_id,json=containerId,proto3" json:"container_id,omitempty"`
+	Status        string                 `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	Children      []string               `protobuf:"bytes,3,rep,name=children,proto3" json:"children,omitempty"` // child containers paused along with it
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListShipsResponse) Reset() {
-	*x = ListShipsResponse{}
+func (x *PauseContainerResponse) Reset() {
+	*x = PauseContainerResponse{}
 	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[77]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListShipsResponse) String() string {
+func (x *PauseContainerResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListShipsResponse) ProtoMessage() {}
+func (*PauseContainerResponse) ProtoMessage() {}
 
-func (x *ListShipsResponse) ProtoReflect() protoreflect.Message {
+func (x *PauseContainerResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[77]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -5208,46 +5362,54 @@ func (x *ListShipsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListShipsResponse.ProtoReflect.Descriptor instead.
-func (*ListShipsResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use PauseContainerResponse.ProtoReflect.Descriptor instead.
+func (*PauseContainerResponse) Descriptor() ([]byte, []int) {
 	return file_pkg_proto_daemon_daemon_proto_rawDescGZIP(), []int{77}
 }
 
-func (x *ListShipsResponse) GetShips() []*ShipInfo {
+func (x *PauseContainerResponse) GetContainerId() string {
 	if x != nil {
-		return x.Ships
+		return x.ContainerId
+	}
+	return ""
+}
+
+func (x *PauseContainerResponse) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *PauseContainerResponse) GetChildren() []string {
+	if x != nil {
+		return x.Children
 	}
 	return nil
 }
 
-type ShipInfo struct {
+// ResumeContainerRequest resumes a paused container and its paused children
+type ResumeContainerRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Symbol        string                 `protobuf:"bytes,1,opt,name=symbol,proto3" json:"symbol,omitempty"`
-	Location      string                 `protobuf:"bytes,2,opt,name=location,proto3" json:"location,omitempty"`
-	NavStatus     string                 `protobuf:"bytes,3,opt,name=nav_status,json=navStatus,proto3" json:"nav_status,omitempty"`
-	FuelCurrent   int32                  `protobuf:"varint,4,opt,name=fuel_current,json=fuelCurrent,proto3" json:"fuel_current,omitempty"`
-	FuelCapacity  int32                  `protobuf:"varint,5,opt,name=fuel_capacity,json=fuelCapacity,proto3" json:"fuel_capacity,omitempty"`
-	CargoUnits    int32                  `protobuf:"varint,6,opt,name=cargo_units,json=cargoUnits,proto3" json:"cargo_units,omitempty"`
-	CargoCapacity int32                  `protobuf:"varint,7,opt,name=cargo_capacity,json=cargoCapacity,proto3" json:"cargo_capacity,omitempty"`
-	EngineSpeed   int32                  `protobuf:"varint,8,opt,name=engine_speed,json=engineSpeed,proto3" json:"engine_speed,omitempty"`
+	ContainerId   string                 `protobuf:"bytes,1,opt,name=container_id,json=containerId,proto3" json:"container_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ShipInfo) Reset() {
-	*x = ShipInfo{}
+func (x *ResumeContainerRequest) Reset() {
+	*x = ResumeContainerRequest{}
 	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[78]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ShipInfo) String() string {
+func (x *ResumeContainerRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ShipInfo) ProtoMessage() {}
+func (*ResumeContainerRequest) ProtoMessage() {}
 
-func (x *ShipInfo) ProtoReflect() protoreflect.Message {
+func (x *ResumeContainerRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[78]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -5259,91 +5421,41 @@ func (x *ShipInfo) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ShipInfo.ProtoReflect.Descriptor instead.
-func (*ShipInfo) Descriptor() ([]byte, []int) {
+// Deprecated: Use ResumeContainerRequest.ProtoReflect.Descriptor instead.
+func (*ResumeContainerRequest) Descriptor() ([]byte, []int) {
 	return file_pkg_proto_daemon_daemon_proto_rawDescGZIP(), []int{78}
 }
 
-func (x *ShipInfo) GetSymbol() string {
-	if x != nil {
-		return x.Symbol
-	}
-	return ""
-}
-
-func (x *ShipInfo) GetLocation() string {
-	if x != nil {
-		return x.Location
-	}
-	return ""
-}
-
-func (x *ShipInfo) GetNavStatus() string {
+func (x *ResumeContainerRequest) GetContainerId() string {
 	if x != nil {
-		return x.NavStatus
+		return x.ContainerId
 	}
 	return ""
 }
 
-func (x *ShipInfo) GetFuelCurrent() int32 {
-	if x != nil {
-		return x.FuelCurrent
-	}
-	return 0
-}
-
-func (x *ShipInfo) GetFuelCapacity() int32 {
-	if x != nil {
-		return x.FuelCapacity
-	}
-	return 0
-}
-
-func (x *ShipInfo) GetCargoUnits() int32 {
-	if x != nil {
-		return x.CargoUnits
-	}
-	return 0
-}
-
-func (x *ShipInfo) GetCargoCapacity() int32 {
-	if x != nil {
-		return x.CargoCapacity
-	}
-	return 0
-}
-
-func (x *ShipInfo) GetEngineSpeed() int32 {
-	if x != nil {
-		return x.EngineSpeed
-	}
-	return 0
-}
-
-// GetShipRequest retrieves ship details
-type GetShipRequest struct {
+type ResumeContainerResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	ShipSymbol    string                 `protobuf:"bytes,1,opt,name=ship_symbol,json=shipSymbol,proto3" json:"ship_symbol,omitempty"`
-	PlayerId      *int32                 `protobuf:"varint,2,opt,name=player_id,json=playerId,proto3,oneof" json:"player_id,omitempty"`
-	AgentSymbol   *string                `protobuf:"bytes,3,opt,name=agent_symbol,json=agentSymbol,proto3,oneof" json:"agent_symbol,omitempty"`
+	ContainerId   string                 `protobuf:"bytes,1,opt,name=container_id,json=containerId,proto3" json:"container_id,omitempty"`
+	Status        string                 `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	Children      []string               `protobuf:"bytes,3,rep,name=children,proto3" json:"children,omitempty"` // child containers resumed along with it
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetShipRequest) Reset() {
-	*x = GetShipRequest{}
+func (x *ResumeContainerResponse) Reset() {
+	*x = ResumeContainerResponse{}
 	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[79]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetShipRequest) String() string {
+func (x *ResumeContainerResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetShipRequest) ProtoMessage() {}
+func (*ResumeContainerResponse) ProtoMessage() {}
 
-func (x *GetShipRequest) ProtoReflect() protoreflect.Message {
+func (x *ResumeContainerResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[79]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -5355,53 +5467,55 @@ func (x *GetShipRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetShipRequest.ProtoReflect.Descriptor instead.
-func (*GetShipRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ResumeContainerResponse.ProtoReflect.Descriptor instead.
+func (*ResumeContainerResponse) Descriptor() ([]byte, []int) {
 	return file_pkg_proto_daemon_daemon_proto_rawDescGZIP(), []int{79}
 }
 
-func (x *GetShipRequest) GetShipSymbol() string {
+func (x *ResumeContainerResponse) GetContainerId() string {
 	if x != nil {
-		return x.ShipSymbol
+		return x.ContainerId
 	}
 	return ""
 }
 
-func (x *GetShipRequest) GetPlayerId() int32 {
-	if x != nil && x.PlayerId != nil {
-		return *x.PlayerId
+func (x *ResumeContainerResponse) GetStatus() string {
+	if x != nil {
+		return x.Status
 	}
-	return 0
+	return ""
 }
 
-func (x *GetShipRequest) GetAgentSymbol() string {
-	if x != nil && x.AgentSymbol != nil {
-		return *x.AgentSymbol
+func (x *ResumeContainerResponse) GetChildren() []string {
+	if x != nil {
+		return x.Children
 	}
-	return ""
+	return nil
 }
 
-type GetShipResponse struct {
+// SetContainerLogLevelRequest changes a running container's log level
+type SetContainerLogLevelRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Ship          *ShipDetail            `protobuf:"bytes,1,opt,name=ship,proto3" json:"ship,omitempty"`
+	ContainerId   string                 `protobuf:"bytes,1,opt,name=container_id,json=containerId,proto3" json:"container_id,omitempty"`
+	Level         string                 `protobuf:"bytes,2,opt,name=level,proto3" json:"level,omitempty"` // DEBUG, INFO, WARNING or ERROR
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetShipResponse) Reset() {
-	*x = GetShipResponse{}
+func (x *SetContainerLogLevelRequest) Reset() {
+	*x = SetContainerLogLevelRequest{}
 	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[80]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetShipResponse) String() string {
+func (x *SetContainerLogLevelRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetShipResponse) ProtoMessage() {}
+func (*SetContainerLogLevelRequest) ProtoMessage() {}
 
-func (x *GetShipResponse) ProtoReflect() protoreflect.Message {
+func (x *SetContainerLogLevelRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[80]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -5413,42 +5527,48 @@ func (x *GetShipResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetShipResponse.ProtoReflect.Descriptor instead.
-func (*GetShipResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use SetContainerLogLevelRequest.ProtoReflect.Descriptor instead.
+func (*SetContainerLogLevelRequest) Descriptor() ([]byte, []int) {
 	return file_pkg_proto_daemon_daemon_proto_rawDescGZIP(), []int{80}
 }
 
-func (x *GetShipResponse) GetShip() *ShipDetail {
+func (x *SetContainerLogLevelRequest) GetContainerId() string {
 	if x != nil {
-		return x.Ship
+		return x.ContainerId
 	}
-	return nil
+	return ""
 }
 
-// RefreshShipRequest forces a resync of a ship from the API
-type RefreshShipRequest struct {
+func (x *SetContainerLogLevelRequest) GetLevel() string {
+	if x != nil {
+		return x.Level
+	}
+	return ""
+}
+
+type SetContainerLogLevelResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	ShipSymbol    string                 `protobuf:"bytes,1,opt,name=ship_symbol,json=shipSymbol,proto3" json:"ship_symbol,omitempty"`
-	PlayerId      *int32                 `protobuf:"varint,2,opt,name=player_id,json=playerId,proto3,oneof" json:"player_id,omitempty"`
-	AgentSymbol   *string                `protobuf:"bytes,3,opt,name=agent_symbol,json=agentSymbol,proto3,oneof" json:"agent_symbol,omitempty"`
+	ContainerId   string                 `protobuf:"bytes,1,opt,name=container_id,json=containerId,proto3" json:"container_id,omitempty"`
+	PreviousLevel string                 `protobuf:"bytes,2,opt,name=previous_level,json=previousLevel,proto3" json:"previous_level,omitempty"`
+	Level         string                 `protobuf:"bytes,3,opt,name=level,proto3" json:"level,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *RefreshShipRequest) Reset() {
-	*x = RefreshShipRequest{}
+func (x *SetContainerLogLevelResponse) Reset() {
+	*x = SetContainerLogLevelResponse{}
 	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[81]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RefreshShipRequest) String() string {
+func (x *SetContainerLogLevelResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RefreshShipRequest) ProtoMessage() {}
+func (*SetContainerLogLevelResponse) ProtoMessage() {}
 
-func (x *RefreshShipRequest) ProtoReflect() protoreflect.Message {
+func (x *SetContainerLogLevelResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[81]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -5460,53 +5580,58 @@ func (x *RefreshShipRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RefreshShipRequest.ProtoReflect.Descriptor instead.
-func (*RefreshShipRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use SetContainerLogLevelResponse.ProtoReflect.Descriptor instead.
+func (*SetContainerLogLevelResponse) Descriptor() ([]byte, []int) {
 	return file_pkg_proto_daemon_daemon_proto_rawDescGZIP(), []int{81}
 }
 
-func (x *RefreshShipRequest) GetShipSymbol() string {
+func (x *SetContainerLogLevelResponse) GetContainerId() string {
 	if x != nil {
-		return x.ShipSymbol
+		return x.ContainerId
 	}
 	return ""
 }
 
-func (x *RefreshShipRequest) GetPlayerId() int32 {
-	if x != nil && x.PlayerId != nil {
-		return *x.PlayerId
+func (x *SetContainerLogLevelResponse) GetPreviousLevel() string {
+	if x != nil {
+		return x.PreviousLevel
 	}
-	return 0
+	return ""
 }
 
-func (x *RefreshShipRequest) GetAgentSymbol() string {
-	if x != nil && x.AgentSymbol != nil {
-		return *x.AgentSymbol
+func (x *SetContainerLogLevelResponse) GetLevel() string {
+	if x != nil {
+		return x.Level
 	}
 	return ""
 }
 
-type RefreshShipResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Ship          *ShipDetail            `protobuf:"bytes,1,opt,name=ship,proto3" json:"ship,omitempty"`
+// GetContainerLogsRequest retrieves container logs
+type GetContainerLogsRequest struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	ContainerId string                 `protobuf:"bytes,1,opt,name=container_id,json=containerId,proto3" json:"container_id,omitempty"`
+	// Optional limit on number of log entries
+	Limit *int32 `protobuf:"varint,2,opt,name=limit,proto3,oneof" json:"limit,omitempty"`
+	// Optional filter by log level (INFO, WARNING, ERROR, DEBUG)
+	Level         *string `protobuf:"bytes,3,opt,name=level,proto3,oneof" json:"level,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *RefreshShipResponse) Reset() {
-	*x = RefreshShipResponse{}
+func (x *GetContainerLogsRequest) Reset() {
+	*x = GetContainerLogsRequest{}
 	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[82]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RefreshShipResponse) String() string {
+func (x *GetContainerLogsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RefreshShipResponse) ProtoMessage() {}
+func (*GetContainerLogsRequest) ProtoMessage() {}
 
-func (x *RefreshShipResponse) ProtoReflect() protoreflect.Message {
+func (x *GetContainerLogsRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[82]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -5518,47 +5643,53 @@ func (x *RefreshShipResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RefreshShipResponse.ProtoReflect.Descriptor instead.
-func (*RefreshShipResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetContainerLogsRequest.ProtoReflect.Descriptor instead.
+func (*GetContainerLogsRequest) Descriptor() ([]byte, []int) {
 	return file_pkg_proto_daemon_daemon_proto_rawDescGZIP(), []int{82}
 }
 
-func (x *RefreshShipResponse) GetShip() *ShipDetail {
+func (x *GetContainerLogsRequest) GetContainerId() string {
 	if x != nil {
-		return x.Ship
+		return x.ContainerId
 	}
-	return nil
+	return ""
 }
 
-// ReserveShipRequest reserves a ship for the captain's direct manual use
-type ReserveShipRequest struct {
-	state       protoimpl.MessageState `protogen:"open.v1"`
-	ShipSymbol  string                 `protobuf:"bytes,1,opt,name=ship_symbol,json=shipSymbol,proto3" json:"ship_symbol,omitempty"`
-	Reason      *string                `protobuf:"bytes,2,opt,name=reason,proto3,oneof" json:"reason,omitempty"`
-	PlayerId    *int32                 `protobuf:"varint,3,opt,name=player_id,json=playerId,proto3,oneof" json:"player_id,omitempty"`
-	AgentSymbol *string                `protobuf:"bytes,4,opt,name=agent_symbol,json=agentSymbol,proto3,oneof" json:"agent_symbol,omitempty"`
-	// force (sp-w3yd): when true, PREEMPT a coordinator's live claim — atomically
-	// revoke it and transfer the hull to the captain (operator authority wins)
-	// instead of rejecting. Absent/false is the byte-identical pre-sp-w3yd behavior.
-	Force         *bool `protobuf:"varint,5,opt,name=force,proto3,oneof" json:"force,omitempty"`
+func (x *GetContainerLogsRequest) GetLimit() int32 {
+	if x != nil && x.Limit != nil {
+		return *x.Limit
+	}
+	return 0
+}
+
+func (x *GetContainerLogsRequest) GetLevel() string {
+	if x != nil && x.Level != nil {
+		return *x.Level
+	}
+	return ""
+}
+
+type GetContainerLogsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Logs          []*LogEntry            `protobuf:"bytes,1,rep,name=logs,proto3" json:"logs,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ReserveShipRequest) Reset() {
-	*x = ReserveShipRequest{}
+func (x *GetContainerLogsResponse) Reset() {
+	*x = GetContainerLogsResponse{}
 	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[83]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ReserveShipRequest) String() string {
+func (x *GetContainerLogsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ReserveShipRequest) ProtoMessage() {}
+func (*GetContainerLogsResponse) ProtoMessage() {}
 
-func (x *ReserveShipRequest) ProtoReflect() protoreflect.Message {
+func (x *GetContainerLogsResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[83]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -5570,76 +5701,42 @@ func (x *ReserveShipRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ReserveShipRequest.ProtoReflect.Descriptor instead.
-func (*ReserveShipRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetContainerLogsResponse.ProtoReflect.Descriptor instead.
+func (*GetContainerLogsResponse) Descriptor() ([]byte, []int) {
 	return file_pkg_proto_daemon_daemon_proto_rawDescGZIP(), []int{83}
 }
 
-func (x *ReserveShipRequest) GetShipSymbol() string {
+func (x *GetContainerLogsResponse) GetLogs() []*LogEntry {
 	if x != nil {
-		return x.ShipSymbol
-	}
-	return ""
-}
-
-func (x *ReserveShipRequest) GetReason() string {
-	if x != nil && x.Reason != nil {
-		return *x.Reason
-	}
-	return ""
-}
-
-func (x *ReserveShipRequest) GetPlayerId() int32 {
-	if x != nil && x.PlayerId != nil {
-		return *x.PlayerId
-	}
-	return 0
-}
-
-func (x *ReserveShipRequest) GetAgentSymbol() string {
-	if x != nil && x.AgentSymbol != nil {
-		return *x.AgentSymbol
-	}
-	return ""
-}
-
-func (x *ReserveShipRequest) GetForce() bool {
-	if x != nil && x.Force != nil {
-		return *x.Force
+		return x.Logs
 	}
-	return false
+	return nil
 }
 
-type ReserveShipResponse struct {
-	state      protoimpl.MessageState `protogen:"open.v1"`
-	ShipSymbol string                 `protobuf:"bytes,1,opt,name=ship_symbol,json=shipSymbol,proto3" json:"ship_symbol,omitempty"`
-	Reason     string                 `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
-	// warning is non-empty if reserving this ship left zero other idle ships
-	// sharing its role. Advisory only — the reservation already succeeded.
-	Warning string `protobuf:"bytes,3,opt,name=warning,proto3" json:"warning,omitempty"`
-	// preempted (sp-w3yd) is true when --force revoked a coordinator's live claim;
-	// preempted_from names the container the claim was revoked from. Both zero for a
-	// non-force reserve or a force reserve of an already-idle hull.
-	Preempted     bool   `protobuf:"varint,4,opt,name=preempted,proto3" json:"preempted,omitempty"`
-	PreemptedFrom string `protobuf:"bytes,5,opt,name=preempted_from,json=preemptedFrom,proto3" json:"preempted_from,omitempty"`
+type LogEntry struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Timestamp     string                 `protobuf:"bytes,1,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	Level         string                 `protobuf:"bytes,2,opt,name=level,proto3" json:"level,omitempty"`
+	Message       string                 `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+	Metadata      string                 `protobuf:"bytes,4,opt,name=metadata,proto3" json:"metadata,omitempty"` // JSON-encoded metadata
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ReserveShipResponse) Reset() {
-	*x = ReserveShipResponse{}
+func (x *LogEntry) Reset() {
+	*x = LogEntry{}
 	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[84]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ReserveShipResponse) String() string {
+func (x *LogEntry) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ReserveShipResponse) ProtoMessage() {}
+func (*LogEntry) ProtoMessage() {}
 
-func (x *ReserveShipResponse) ProtoReflect() protoreflect.Message {
+func (x *LogEntry) ProtoReflect() protoreflect.Message {
 	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[84]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -5651,71 +5748,60 @@ func (x *ReserveShipResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ReserveShipResponse.ProtoReflect.Descriptor instead.
-func (*ReserveShipResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use LogEntry.ProtoReflect.Descriptor instead.
+func (*LogEntry) Descriptor() ([]byte, []int) {
 	return file_pkg_proto_daemon_daemon_proto_rawDescGZIP(), []int{84}
 }
 
-func (x *ReserveShipResponse) GetShipSymbol() string {
+func (x *LogEntry) GetTimestamp() string {
 	if x != nil {
-		return x.ShipSymbol
+		return x.Timestamp
 	}
 	return ""
 }
 
-func (x *ReserveShipResponse) GetReason() string {
+func (x *LogEntry) GetLevel() string {
 	if x != nil {
-		return x.Reason
+		return x.Level
 	}
 	return ""
 }
 
-func (x *ReserveShipResponse) GetWarning() string {
+func (x *LogEntry) GetMessage() string {
 	if x != nil {
-		return x.Warning
+		return x.Message
 	}
 	return ""
 }
 
-func (x *ReserveShipResponse) GetPreempted() bool {
-	if x != nil {
-		return x.Preempted
-	}
-	return false
-}
-
-func (x *ReserveShipResponse) GetPreemptedFrom() string {
+func (x *LogEntry) GetMetadata() string {
 	if x != nil {
-		return x.PreemptedFrom
+		return x.Metadata
 	}
 	return ""
 }
 
-// ReleaseShipRequest clears a captain reservation on a ship
-type ReleaseShipRequest struct {
+// HealthCheckRequest verifies daemon status
+type HealthCheckRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	ShipSymbol    string                 `protobuf:"bytes,1,opt,name=ship_symbol,json=shipSymbol,proto3" json:"ship_symbol,omitempty"`
-	Reason        *string                `protobuf:"bytes,2,opt,name=reason,proto3,oneof" json:"reason,omitempty"`
-	PlayerId      *int32                 `protobuf:"varint,3,opt,name=player_id,json=playerId,proto3,oneof" json:"player_id,omitempty"`
-	AgentSymbol   *string                `protobuf:"bytes,4,opt,name=agent_symbol,json=agentSymbol,proto3,oneof" json:"agent_symbol,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ReleaseShipRequest) Reset() {
-	*x = ReleaseShipRequest{}
+func (x *HealthCheckRequest) Reset() {
+	*x = HealthCheckRequest{}
 	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[85]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ReleaseShipRequest) String() string {
+func (x *HealthCheckRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ReleaseShipRequest) ProtoMessage() {}
+func (*HealthCheckRequest) ProtoMessage() {}
 
-func (x *ReleaseShipRequest) ProtoReflect() protoreflect.Message {
+func (x *HealthCheckRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[85]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -5727,60 +5813,39 @@ func (x *ReleaseShipRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ReleaseShipRequest.ProtoReflect.Descriptor instead.
-func (*ReleaseShipRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use HealthCheckRequest.ProtoReflect.Descriptor instead.
+func (*HealthCheckRequest) Descriptor() ([]byte, []int) {
 	return file_pkg_proto_daemon_daemon_proto_rawDescGZIP(), []int{85}
 }
 
-func (x *ReleaseShipRequest) GetShipSymbol() string {
-	if x != nil {
-		return x.ShipSymbol
-	}
-	return ""
-}
-
-func (x *ReleaseShipRequest) GetReason() string {
-	if x != nil && x.Reason != nil {
-		return *x.Reason
-	}
-	return ""
-}
-
-func (x *ReleaseShipRequest) GetPlayerId() int32 {
-	if x != nil && x.PlayerId != nil {
-		return *x.PlayerId
-	}
-	return 0
-}
-
-func (x *ReleaseShipRequest) GetAgentSymbol() string {
-	if x != nil && x.AgentSymbol != nil {
-		return *x.AgentSymbol
-	}
-	return ""
-}
-
-type ReleaseShipResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	ShipSymbol    string                 `protobuf:"bytes,1,opt,name=ship_symbol,json=shipSymbol,proto3" json:"ship_symbol,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type HealthCheckResponse struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Status           string                 `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"` // "ok" or error message
+	Version          string                 `protobuf:"bytes,2,opt,name=version,proto3" json:"version,omitempty"`
+	ActiveContainers int32                  `protobuf:"varint,3,opt,name=active_containers,json=activeContainers,proto3" json:"active_containers,omitempty"`
+	PausedContainers int32                  `protobuf:"varint,4,opt,name=paused_containers,json=pausedContainers,proto3" json:"paused_containers,omitempty"`
+	// Containers stopped for running past their max_runtime since boot, and the
+	// most recent of them, oldest first.
+	RuntimeTerminations       int32                 `protobuf:"varint,5,opt,name=runtime_terminations,json=runtimeTerminations,proto3" json:"runtime_terminations,omitempty"`
+	RecentRuntimeTerminations []*RuntimeTermination `protobuf:"bytes,6,rep,name=recent_runtime_terminations,json=recentRuntimeTerminations,proto3" json:"recent_runtime_terminations,omitempty"`
+	unknownFields             protoimpl.UnknownFields
+	sizeCache                 protoimpl.SizeCache
 }
 
-func (x *ReleaseShipResponse) Reset() {
-	*x = ReleaseShipResponse{}
+func (x *HealthCheckResponse) Reset() {
+	*x = HealthCheckResponse{}
 	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[86]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ReleaseShipResponse) String() string {
+func (x *HealthCheckResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ReleaseShipResponse) ProtoMessage() {}
+func (*HealthCheckResponse) ProtoMessage() {}
 
-func (x *ReleaseShipResponse) ProtoReflect() protoreflect.Message {
+func (x *HealthCheckResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[86]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -5792,43 +5857,77 @@ func (x *ReleaseShipResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ReleaseShipResponse.ProtoReflect.Descriptor instead.
-func (*ReleaseShipResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use HealthCheckResponse.ProtoReflect.Descriptor instead.
+func (*HealthCheckResponse) Descriptor() ([]byte, []int) {
 	return file_pkg_proto_daemon_daemon_proto_rawDescGZIP(), []int{86}
 }
 
-func (x *ReleaseShipResponse) GetShipSymbol() string {
+func (x *HealthCheckResponse) GetStatus() string {
 	if x != nil {
-		return x.ShipSymbol
+		return x.Status
 	}
 	return ""
 }
 
-// AssignShipFleetRequest dedicates a ship to a named fleet (sp-l7h2)
-type AssignShipFleetRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	ShipSymbol    string                 `protobuf:"bytes,1,opt,name=ship_symbol,json=shipSymbol,proto3" json:"ship_symbol,omitempty"`
-	Fleet         string                 `protobuf:"bytes,2,opt,name=fleet,proto3" json:"fleet,omitempty"`
-	PlayerId      *int32                 `protobuf:"varint,3,opt,name=player_id,json=playerId,proto3,oneof" json:"player_id,omitempty"`
-	AgentSymbol   *string                `protobuf:"bytes,4,opt,name=agent_symbol,json=agentSymbol,proto3,oneof" json:"agent_symbol,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *HealthCheckResponse) GetVersion() string {
+	if x != nil {
+		return x.Version
+	}
+	return ""
 }
 
-func (x *AssignShipFleetRequest) Reset() {
-	*x = AssignShipFleetRequest{}
+func (x *HealthCheckResponse) GetActiveContainers() int32 {
+	if x != nil {
+		return x.ActiveContainers
+	}
+	return 0
+}
+
+func (x *HealthCheckResponse) GetPausedContainers() int32 {
+	if x != nil {
+		return x.PausedContainers
+	}
+	return 0
+}
+
+func (x *HealthCheckResponse) GetRuntimeTerminations() int32 {
+	if x != nil {
+		return x.RuntimeTerminations
+	}
+	return 0
+}
+
+func (x *HealthCheckResponse) GetRecentRuntimeTerminations() []*RuntimeTermination {
+	if x != nil {
+		return x.RecentRuntimeTerminations
+	}
+	return nil
+}
+
+type RuntimeTermination struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	ContainerId    string                 `protobuf:"bytes,1,opt,name=container_id,json=containerId,proto3" json:"container_id,omitempty"`
+	ContainerType  string                 `protobuf:"bytes,2,opt,name=container_type,json=containerType,proto3" json:"container_type,omitempty"`
+	RuntimeSeconds int64                  `protobuf:"varint,3,opt,name=runtime_seconds,json=runtimeSeconds,proto3" json:"runtime_seconds,omitempty"`
+	TerminatedAt   string                 `protobuf:"bytes,4,opt,name=terminated_at,json=terminatedAt,proto3" json:"terminated_at,omitempty"` // RFC 3339
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *RuntimeTermination) Reset() {
+	*x = RuntimeTermination{}
 	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[87]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *AssignShipFleetRequest) String() string {
+func (x *RuntimeTermination) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AssignShipFleetRequest) ProtoMessage() {}
+func (*RuntimeTermination) ProtoMessage() {}
 
-func (x *AssignShipFleetRequest) ProtoReflect() protoreflect.Message {
+func (x *RuntimeTermination) ProtoReflect() protoreflect.Message {
 	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[87]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -5840,61 +5939,61 @@ func (x *AssignShipFleetRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AssignShipFleetRequest.ProtoReflect.Descriptor instead.
-func (*AssignShipFleetRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use RuntimeTermination.ProtoReflect.Descriptor instead.
+func (*RuntimeTermination) Descriptor() ([]byte, []int) {
 	return file_pkg_proto_daemon_daemon_proto_rawDescGZIP(), []int{87}
 }
 
-func (x *AssignShipFleetRequest) GetShipSymbol() string {
+func (x *RuntimeTermination) GetContainerId() string {
 	if x != nil {
-		return x.ShipSymbol
+		return x.ContainerId
 	}
 	return ""
 }
 
-func (x *AssignShipFleetRequest) GetFleet() string {
+func (x *RuntimeTermination) GetContainerType() string {
 	if x != nil {
-		return x.Fleet
+		return x.ContainerType
 	}
 	return ""
 }
 
-func (x *AssignShipFleetRequest) GetPlayerId() int32 {
-	if x != nil && x.PlayerId != nil {
-		return *x.PlayerId
+func (x *RuntimeTermination) GetRuntimeSeconds() int64 {
+	if x != nil {
+		return x.RuntimeSeconds
 	}
 	return 0
 }
 
-func (x *AssignShipFleetRequest) GetAgentSymbol() string {
-	if x != nil && x.AgentSymbol != nil {
-		return *x.AgentSymbol
+func (x *RuntimeTermination) GetTerminatedAt() string {
+	if x != nil {
+		return x.TerminatedAt
 	}
 	return ""
 }
 
-type AssignShipFleetResponse struct {
+// GetAPIBudgetRequest requests a snapshot of API request-budget
+// observability (sp-51ti). Empty for now.
+type GetAPIBudgetRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	ShipSymbol    string                 `protobuf:"bytes,1,opt,name=ship_symbol,json=shipSymbol,proto3" json:"ship_symbol,omitempty"`
-	Fleet         string                 `protobuf:"bytes,2,opt,name=fleet,proto3" json:"fleet,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *AssignShipFleetResponse) Reset() {
-	*x = AssignShipFleetResponse{}
+func (x *GetAPIBudgetRequest) Reset() {
+	*x = GetAPIBudgetRequest{}
 	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[88]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *AssignShipFleetResponse) String() string {
+func (x *GetAPIBudgetRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AssignShipFleetResponse) ProtoMessage() {}
+func (*GetAPIBudgetRequest) ProtoMessage() {}
 
-func (x *AssignShipFleetResponse) ProtoReflect() protoreflect.Message {
+func (x *GetAPIBudgetRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[88]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -5906,56 +6005,36 @@ func (x *AssignShipFleetResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AssignShipFleetResponse.ProtoReflect.Descriptor instead.
-func (*AssignShipFleetResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetAPIBudgetRequest.ProtoReflect.Descriptor instead.
+func (*GetAPIBudgetRequest) Descriptor() ([]byte, []int) {
 	return file_pkg_proto_daemon_daemon_proto_rawDescGZIP(), []int{88}
 }
 
-func (x *AssignShipFleetResponse) GetShipSymbol() string {
-	if x != nil {
-		return x.ShipSymbol
-	}
-	return ""
-}
-
-func (x *AssignShipFleetResponse) GetFleet() string {
-	if x != nil {
-		return x.Fleet
-	}
-	return ""
-}
-
-// FleetHubRequest adds or removes a standby-station waypoint on a running
-// operation's coordinator, live (sp-jcke).
-type FleetHubRequest struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// operation is the fleet/operation whose coordinator owns the standby set,
-	// e.g. "contract" — the same tag its dedicated ships carry.
-	Operation string `protobuf:"bytes,1,opt,name=operation,proto3" json:"operation,omitempty"`
-	// waypoint is the standby-station waypoint symbol to add or remove.
-	Waypoint string `protobuf:"bytes,2,opt,name=waypoint,proto3" json:"waypoint,omitempty"`
-	// add=true adds the hub; add=false removes it.
-	Add           bool    `protobuf:"varint,3,opt,name=add,proto3" json:"add,omitempty"`
-	PlayerId      *int32  `protobuf:"varint,4,opt,name=player_id,json=playerId,proto3,oneof" json:"player_id,omitempty"`
-	AgentSymbol   *string `protobuf:"bytes,5,opt,name=agent_symbol,json=agentSymbol,proto3,oneof" json:"agent_symbol,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+// APIBudgetHullStats is one hull's share of the request budget within a
+// report window.
+type APIBudgetHullStats struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Hull             string                 `protobuf:"bytes,1,opt,name=hull,proto3" json:"hull,omitempty"`
+	RequestsInWindow int32                  `protobuf:"varint,2,opt,name=requests_in_window,json=requestsInWindow,proto3" json:"requests_in_window,omitempty"`
+	ReqPerSec        float64                `protobuf:"fixed64,3,opt,name=req_per_sec,json=reqPerSec,proto3" json:"req_per_sec,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
 }
 
-func (x *FleetHubRequest) Reset() {
-	*x = FleetHubRequest{}
+func (x *APIBudgetHullStats) Reset() {
+	*x = APIBudgetHullStats{}
 	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[89]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *FleetHubRequest) String() string {
+func (x *APIBudgetHullStats) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*FleetHubRequest) ProtoMessage() {}
+func (*APIBudgetHullStats) ProtoMessage() {}
 
-func (x *FleetHubRequest) ProtoReflect() protoreflect.Message {
+func (x *APIBudgetHullStats) ProtoReflect() protoreflect.Message {
 	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[89]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -5967,72 +6046,67 @@ func (x *FleetHubRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use FleetHubRequest.ProtoReflect.Descriptor instead.
-func (*FleetHubRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use APIBudgetHullStats.ProtoReflect.Descriptor instead.
+func (*APIBudgetHullStats) Descriptor() ([]byte, []int) {
 	return file_pkg_proto_daemon_daemon_proto_rawDescGZIP(), []int{89}
 }
 
-func (x *FleetHubRequest) GetOperation() string {
+func (x *APIBudgetHullStats) GetHull() string {
 	if x != nil {
-		return x.Operation
+		return x.Hull
 	}
 	return ""
 }
 
-func (x *FleetHubRequest) GetWaypoint() string {
+func (x *APIBudgetHullStats) GetRequestsInWindow() int32 {
 	if x != nil {
-		return x.Waypoint
+		return x.RequestsInWindow
 	}
-	return ""
+	return 0
 }
 
-func (x *FleetHubRequest) GetAdd() bool {
+func (x *APIBudgetHullStats) GetReqPerSec() float64 {
 	if x != nil {
-		return x.Add
-	}
-	return false
-}
-
-func (x *FleetHubRequest) GetPlayerId() int32 {
-	if x != nil && x.PlayerId != nil {
-		return *x.PlayerId
+		return x.ReqPerSec
 	}
 	return 0
 }
 
-func (x *FleetHubRequest) GetAgentSymbol() string {
-	if x != nil && x.AgentSymbol != nil {
-		return *x.AgentSymbol
-	}
-	return ""
-}
-
-type FleetHubResponse struct {
-	state     protoimpl.MessageState `protogen:"open.v1"`
-	Operation string                 `protobuf:"bytes,1,opt,name=operation,proto3" json:"operation,omitempty"`
-	// standby_stations is the resulting live standby set after the mutation.
-	StandbyStations []string `protobuf:"bytes,2,rep,name=standby_stations,json=standbyStations,proto3" json:"standby_stations,omitempty"`
-	// changed is false when the verb was a no-op (add of an existing hub, or
-	// remove of an absent one), so the CLI can report it honestly.
-	Changed       bool `protobuf:"varint,3,opt,name=changed,proto3" json:"changed,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+// APIBudgetReport mirrors internal/domain/apibudget.Report: global
+// utilization vs the rate ceiling, 429 rate, purpose-share breakdown (poll
+// vs transact vs retry), and the top consumers by hull.
+type APIBudgetReport struct {
+	state                 protoimpl.MessageState `protogen:"open.v1"`
+	WindowSeconds         float64                `protobuf:"fixed64,1,opt,name=window_seconds,json=windowSeconds,proto3" json:"window_seconds,omitempty"`
+	TotalRequests         int32                  `protobuf:"varint,2,opt,name=total_requests,json=totalRequests,proto3" json:"total_requests,omitempty"`
+	GlobalReqPerSec       float64                `protobuf:"fixed64,3,opt,name=global_req_per_sec,json=globalReqPerSec,proto3" json:"global_req_per_sec,omitempty"`
+	CeilingReqPerSec      float64                `protobuf:"fixed64,4,opt,name=ceiling_req_per_sec,json=ceilingReqPerSec,proto3" json:"ceiling_req_per_sec,omitempty"`
+	UtilizationPct        float64                `protobuf:"fixed64,5,opt,name=utilization_pct,json=utilizationPct,proto3" json:"utilization_pct,omitempty"`
+	HeadroomReqPerSec     float64                `protobuf:"fixed64,6,opt,name=headroom_req_per_sec,json=headroomReqPerSec,proto3" json:"headroom_req_per_sec,omitempty"`
+	RateLimited_429       int32                  `protobuf:"varint,7,opt,name=rate_limited_429,json=rateLimited429,proto3" json:"rate_limited_429,omitempty"`
+	RateLimited_429PerMin float64                `protobuf:"fixed64,8,opt,name=rate_limited_429_per_min,json=rateLimited429PerMin,proto3" json:"rate_limited_429_per_min,omitempty"`
+	PurposeCounts         map[string]int32       `protobuf:"bytes,9,rep,name=purpose_counts,json=purposeCounts,proto3" json:"purpose_counts,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"varint,2,opt,name=value"`
+	PurposeSharePct       map[string]float64     `protobuf:"bytes,10,rep,name=purpose_share_pct,json=purposeSharePct,proto3" json:"purpose_share_pct,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"fixed64,2,opt,name=value"`
+	HullsToCeiling        float64                `protobuf:"fixed64,11,opt,name=hulls_to_ceiling,json=hullsToCeiling,proto3" json:"hulls_to_ceiling,omitempty"`
+	PerHull               []*APIBudgetHullStats  `protobuf:"bytes,12,rep,name=per_hull,json=perHull,proto3" json:"per_hull,omitempty"`
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
 }
 
-func (x *FleetHubResponse) Reset() {
-	*x = FleetHubResponse{}
+func (x *APIBudgetReport) Reset() {
+	*x = APIBudgetReport{}
 	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[90]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *FleetHubResponse) String() string {
+func (x *APIBudgetReport) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*FleetHubResponse) ProtoMessage() {}
+func (*APIBudgetReport) ProtoMessage() {}
 
-func (x *FleetHubResponse) ProtoReflect() protoreflect.Message {
+func (x *APIBudgetReport) ProtoReflect() protoreflect.Message {
 	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[90]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -6044,56 +6118,122 @@ func (x *FleetHubResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use FleetHubResponse.ProtoReflect.Descriptor instead.
-func (*FleetHubResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use APIBudgetReport.ProtoReflect.Descriptor instead.
+func (*APIBudgetReport) Descriptor() ([]byte, []int) {
 	return file_pkg_proto_daemon_daemon_proto_rawDescGZIP(), []int{90}
 }
 
-func (x *FleetHubResponse) GetOperation() string {
+func (x *APIBudgetReport) GetWindowSeconds() float64 {
 	if x != nil {
-		return x.Operation
+		return x.WindowSeconds
 	}
-	return ""
+	return 0
 }
 
-func (x *FleetHubResponse) GetStandbyStations() []string {
+func (x *APIBudgetReport) GetTotalRequests() int32 {
 	if x != nil {
-		return x.StandbyStations
+		return x.TotalRequests
+	}
+	return 0
+}
+
+func (x *APIBudgetReport) GetGlobalReqPerSec() float64 {
+	if x != nil {
+		return x.GlobalReqPerSec
+	}
+	return 0
+}
+
+func (x *APIBudgetReport) GetCeilingReqPerSec() float64 {
+	if x != nil {
+		return x.CeilingReqPerSec
+	}
+	return 0
+}
+
+func (x *APIBudgetReport) GetUtilizationPct() float64 {
+	if x != nil {
+		return x.UtilizationPct
+	}
+	return 0
+}
+
+func (x *APIBudgetReport) GetHeadroomReqPerSec() float64 {
+	if x != nil {
+		return x.HeadroomReqPerSec
+	}
+	return 0
+}
+
+func (x *APIBudgetReport) GetRateLimited_429() int32 {
+	if x != nil {
+		return x.RateLimited_429
+	}
+	return 0
+}
+
+func (x *APIBudgetReport) GetRateLimited_429PerMin() float64 {
+	if x != nil {
+		return x.RateLimited_429PerMin
+	}
+	return 0
+}
+
+func (x *APIBudgetReport) GetPurposeCounts() map[string]int32 {
+	if x != nil {
+		return x.PurposeCounts
 	}
 	return nil
 }
 
-func (x *FleetHubResponse) GetChanged() bool {
+func (x *APIBudgetReport) GetPurposeSharePct() map[string]float64 {
 	if x != nil {
-		return x.Changed
+		return x.PurposeSharePct
 	}
-	return false
+	return nil
 }
 
-// UnassignShipFleetRequest clears a ship's fleet dedication (sp-l7h2)
-type UnassignShipFleetRequest struct {
+func (x *APIBudgetReport) GetHullsToCeiling() float64 {
+	if x != nil {
+		return x.HullsToCeiling
+	}
+	return 0
+}
+
+func (x *APIBudgetReport) GetPerHull() []*APIBudgetHullStats {
+	if x != nil {
+		return x.PerHull
+	}
+	return nil
+}
+
+// DutyCycleHullStats is one hull's earning/idle breakdown within the
+// duty-cycle sampling window (sp-51ti captain amendment).
+type DutyCycleHullStats struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	ShipSymbol    string                 `protobuf:"bytes,1,opt,name=ship_symbol,json=shipSymbol,proto3" json:"ship_symbol,omitempty"`
-	PlayerId      *int32                 `protobuf:"varint,2,opt,name=player_id,json=playerId,proto3,oneof" json:"player_id,omitempty"`
-	AgentSymbol   *string                `protobuf:"bytes,3,opt,name=agent_symbol,json=agentSymbol,proto3,oneof" json:"agent_symbol,omitempty"`
+	Hull          string                 `protobuf:"bytes,1,opt,name=hull,proto3" json:"hull,omitempty"`
+	EarningHours  float64                `protobuf:"fixed64,2,opt,name=earning_hours,json=earningHours,proto3" json:"earning_hours,omitempty"`
+	IdleHours     float64                `protobuf:"fixed64,3,opt,name=idle_hours,json=idleHours,proto3" json:"idle_hours,omitempty"`
+	EarningPct    float64                `protobuf:"fixed64,4,opt,name=earning_pct,json=earningPct,proto3" json:"earning_pct,omitempty"`
+	SampleCount   int32                  `protobuf:"varint,5,opt,name=sample_count,json=sampleCount,proto3" json:"sample_count,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UnassignShipFleetRequest) Reset() {
-	*x = UnassignShipFleetRequest{}
+func (x *DutyCycleHullStats) Reset() {
+	*x = DutyCycleHullStats{}
 	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[91]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UnassignShipFleetRequest) String() string {
+func (x *DutyCycleHullStats) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UnassignShipFleetRequest) ProtoMessage() {}
+func (*DutyCycleHullStats) ProtoMessage() {}
 
-func (x *UnassignShipFleetRequest) ProtoReflect() protoreflect.Message {
+func (x *DutyCycleHullStats) ProtoReflect() protoreflect.Message {
 	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[91]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -6105,53 +6245,71 @@ func (x *UnassignShipFleetRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UnassignShipFleetRequest.ProtoReflect.Descriptor instead.
-func (*UnassignShipFleetRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use DutyCycleHullStats.ProtoReflect.Descriptor instead.
+func (*DutyCycleHullStats) Descriptor() ([]byte, []int) {
 	return file_pkg_proto_daemon_daemon_proto_rawDescGZIP(), []int{91}
 }
 
-func (x *UnassignShipFleetRequest) GetShipSymbol() string {
+func (x *DutyCycleHullStats) GetHull() string {
 	if x != nil {
-		return x.ShipSymbol
+		return x.Hull
 	}
 	return ""
 }
 
-func (x *UnassignShipFleetRequest) GetPlayerId() int32 {
-	if x != nil && x.PlayerId != nil {
-		return *x.PlayerId
+func (x *DutyCycleHullStats) GetEarningHours() float64 {
+	if x != nil {
+		return x.EarningHours
 	}
 	return 0
 }
 
-func (x *UnassignShipFleetRequest) GetAgentSymbol() string {
-	if x != nil && x.AgentSymbol != nil {
-		return *x.AgentSymbol
+func (x *DutyCycleHullStats) GetIdleHours() float64 {
+	if x != nil {
+		return x.IdleHours
 	}
-	return ""
+	return 0
 }
 
-type UnassignShipFleetResponse struct {
+func (x *DutyCycleHullStats) GetEarningPct() float64 {
+	if x != nil {
+		return x.EarningPct
+	}
+	return 0
+}
+
+func (x *DutyCycleHullStats) GetSampleCount() int32 {
+	if x != nil {
+		return x.SampleCount
+	}
+	return 0
+}
+
+// DutyCycleReport mirrors internal/domain/dutycycle.Report: ship-hours
+// EARNING/day per hull, the KPI that makes the 20x duty-cycle gap
+// measurable.
+type DutyCycleReport struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	ShipSymbol    string                 `protobuf:"bytes,1,opt,name=ship_symbol,json=shipSymbol,proto3" json:"ship_symbol,omitempty"`
+	WindowHours   float64                `protobuf:"fixed64,1,opt,name=window_hours,json=windowHours,proto3" json:"window_hours,omitempty"`
+	Hulls         []*DutyCycleHullStats  `protobuf:"bytes,2,rep,name=hulls,proto3" json:"hulls,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UnassignShipFleetResponse) Reset() {
-	*x = UnassignShipFleetResponse{}
+func (x *DutyCycleReport) Reset() {
+	*x = DutyCycleReport{}
 	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[92]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UnassignShipFleetResponse) String() string {
+func (x *DutyCycleReport) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UnassignShipFleetResponse) ProtoMessage() {}
+func (*DutyCycleReport) ProtoMessage() {}
 
-func (x *UnassignShipFleetResponse) ProtoReflect() protoreflect.Message {
+func (x *DutyCycleReport) ProtoReflect() protoreflect.Message {
 	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[92]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -6163,41 +6321,50 @@ func (x *UnassignShipFleetResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UnassignShipFleetResponse.ProtoReflect.Descriptor instead.
-func (*UnassignShipFleetResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use DutyCycleReport.ProtoReflect.Descriptor instead.
+func (*DutyCycleReport) Descriptor() ([]byte, []int) {
 	return file_pkg_proto_daemon_daemon_proto_rawDescGZIP(), []int{92}
 }
 
-func (x *UnassignShipFleetResponse) GetShipSymbol() string {
+func (x *DutyCycleReport) GetWindowHours() float64 {
 	if x != nil {
-		return x.ShipSymbol
+		return x.WindowHours
 	}
-	return ""
+	return 0
 }
 
-// ListFleetsRequest lists every dedicated fleet and its members (sp-l7h2)
-type ListFleetsRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	PlayerId      *int32                 `protobuf:"varint,1,opt,name=player_id,json=playerId,proto3,oneof" json:"player_id,omitempty"`
-	AgentSymbol   *string                `protobuf:"bytes,2,opt,name=agent_symbol,json=agentSymbol,proto3,oneof" json:"agent_symbol,omitempty"`
+func (x *DutyCycleReport) GetHulls() []*DutyCycleHullStats {
+	if x != nil {
+		return x.Hulls
+	}
+	return nil
+}
+
+type GetAPIBudgetResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Current is a narrow (~10s) window, close to instantaneous utilization.
+	Current *APIBudgetReport `protobuf:"bytes,1,opt,name=current,proto3" json:"current,omitempty"`
+	// Rolling5m is a 5-minute rolling window, smoothing out bursts.
+	Rolling_5M    *APIBudgetReport `protobuf:"bytes,2,opt,name=rolling_5m,json=rolling5m,proto3" json:"rolling_5m,omitempty"`
+	DutyCycle     *DutyCycleReport `protobuf:"bytes,3,opt,name=duty_cycle,json=dutyCycle,proto3" json:"duty_cycle,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListFleetsRequest) Reset() {
-	*x = ListFleetsRequest{}
+func (x *GetAPIBudgetResponse) Reset() {
+	*x = GetAPIBudgetResponse{}
 	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[93]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListFleetsRequest) String() string {
+func (x *GetAPIBudgetResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListFleetsRequest) ProtoMessage() {}
+func (*GetAPIBudgetResponse) ProtoMessage() {}
 
-func (x *ListFleetsRequest) ProtoReflect() protoreflect.Message {
+func (x *GetAPIBudgetResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[93]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -6209,47 +6376,57 @@ func (x *ListFleetsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListFleetsRequest.ProtoReflect.Descriptor instead.
-func (*ListFleetsRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetAPIBudgetResponse.ProtoReflect.Descriptor instead.
+func (*GetAPIBudgetResponse) Descriptor() ([]byte, []int) {
 	return file_pkg_proto_daemon_daemon_proto_rawDescGZIP(), []int{93}
 }
 
-func (x *ListFleetsRequest) GetPlayerId() int32 {
-	if x != nil && x.PlayerId != nil {
-		return *x.PlayerId
+func (x *GetAPIBudgetResponse) GetCurrent() *APIBudgetReport {
+	if x != nil {
+		return x.Current
 	}
-	return 0
+	return nil
 }
 
-func (x *ListFleetsRequest) GetAgentSymbol() string {
-	if x != nil && x.AgentSymbol != nil {
-		return *x.AgentSymbol
+func (x *GetAPIBudgetResponse) GetRolling_5M() *APIBudgetReport {
+	if x != nil {
+		return x.Rolling_5M
 	}
-	return ""
+	return nil
 }
 
-type FleetShip struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	ShipSymbol    string                 `protobuf:"bytes,1,opt,name=ship_symbol,json=shipSymbol,proto3" json:"ship_symbol,omitempty"`
-	Idle          bool                   `protobuf:"varint,2,opt,name=idle,proto3" json:"idle,omitempty"` // no active assignment and not in transit — claimable now
+func (x *GetAPIBudgetResponse) GetDutyCycle() *DutyCycleReport {
+	if x != nil {
+		return x.DutyCycle
+	}
+	return nil
+}
+
+// ListShipsRequest lists all ships for a player
+type ListShipsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Optional player ID filter
+	PlayerId *int32 `protobuf:"varint,1,opt,name=player_id,json=playerId,proto3,oneof" json:"player_id,omitempty"`
+	// Optional agent symbol (alternative to player_id)
+	AgentSymbol   *string `protobuf:"bytes,2,opt,name=agent_symbol,json=agentSymbol,proto3,oneof" json:"agent_symbol,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *FleetShip) Reset() {
-	*x = FleetShip{}
+func (x *ListShipsRequest) Reset() {
+	*x = ListShipsRequest{}
 	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[94]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *FleetShip) String() string {
+func (x *ListShipsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*FleetShip) ProtoMessage() {}
+func (*ListShipsRequest) ProtoMessage() {}
 
-func (x *FleetShip) ProtoReflect() protoreflect.Message {
+func (x *ListShipsRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[94]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -6261,47 +6438,46 @@ func (x *FleetShip) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use FleetShip.ProtoReflect.Descriptor instead.
-func (*FleetShip) Descriptor() ([]byte, []int) {
+// Deprecated: Use ListShipsRequest.ProtoReflect.Descriptor instead.
+func (*ListShipsRequest) Descriptor() ([]byte, []int) {
 	return file_pkg_proto_daemon_daemon_proto_rawDescGZIP(), []int{94}
 }
 
-func (x *FleetShip) GetShipSymbol() string {
-	if x != nil {
-		return x.ShipSymbol
+func (x *ListShipsRequest) GetPlayerId() int32 {
+	if x != nil && x.PlayerId != nil {
+		return *x.PlayerId
 	}
-	return ""
+	return 0
 }
 
-func (x *FleetShip) GetIdle() bool {
-	if x != nil {
-		return x.Idle
+func (x *ListShipsRequest) GetAgentSymbol() string {
+	if x != nil && x.AgentSymbol != nil {
+		return *x.AgentSymbol
 	}
-	return false
+	return ""
 }
 
-type Fleet struct {
+type ListShipsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Ships         []*FleetShip           `protobuf:"bytes,2,rep,name=ships,proto3" json:"ships,omitempty"`
+	Ships         []*ShipInfo            `protobuf:"bytes,1,rep,name=ships,proto3" json:"ships,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Fleet) Reset() {
-	*x = Fleet{}
+func (x *ListShipsResponse) Reset() {
+	*x = ListShipsResponse{}
 	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[95]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Fleet) String() string {
+func (x *ListShipsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Fleet) ProtoMessage() {}
+func (*ListShipsResponse) ProtoMessage() {}
 
-func (x *Fleet) ProtoReflect() protoreflect.Message {
+func (x *ListShipsResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[95]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -6313,46 +6489,46 @@ func (x *Fleet) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Fleet.ProtoReflect.Descriptor instead.
-func (*Fleet) Descriptor() ([]byte, []int) {
+// Deprecated: Use ListShipsResponse.ProtoReflect.Descriptor instead.
+func (*ListShipsResponse) Descriptor() ([]byte, []int) {
 	return file_pkg_proto_daemon_daemon_proto_rawDescGZIP(), []int{95}
 }
 
-func (x *Fleet) GetName() string {
-	if x != nil {
-		return x.Name
-	}
-	return ""
-}
-
-func (x *Fleet) GetShips() []*FleetShip {
+func (x *ListShipsResponse) GetShips() []*ShipInfo {
 	if x != nil {
 		return x.Ships
 	}
 	return nil
 }
 
-type ListFleetsResponse struct {
+type ShipInfo struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Fleets        []*Fleet               `protobuf:"bytes,1,rep,name=fleets,proto3" json:"fleets,omitempty"`
+	Symbol        string                 `protobuf:"bytes,1,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	Location      string                 `protobuf:"bytes,2,opt,name=location,proto3" json:"location,omitempty"`
+	NavStatus     string                 `protobuf:"bytes,3,opt,name=nav_status,json=navStatus,proto3" json:"nav_status,omitempty"`
+	FuelCurrent   int32                  `protobuf:"varint,4,opt,name=fuel_current,json=fuelCurrent,proto3" json:"fuel_current,omitempty"`
+	FuelCapacity  int32                  `protobuf:"varint,5,opt,name=fuel_capacity,json=fuelCapacity,proto3" json:"fuel_capacity,omitempty"`
+	CargoUnits    int32                  `protobuf:"varint,6,opt,name=cargo_units,json=cargoUnits,proto3" json:"cargo_units,omitempty"`
+	CargoCapacity int32                  `protobuf:"varint,7,opt,name=cargo_capacity,json=cargoCapacity,proto3" json:"cargo_capacity,omitempty"`
+	EngineSpeed   int32                  `protobuf:"varint,8,opt,name=engine_speed,json=engineSpeed,proto3" json:"engine_speed,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListFleetsResponse) Reset() {
-	*x = ListFleetsResponse{}
+func (x *ShipInfo) Reset() {
+	*x = ShipInfo{}
 	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[96]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListFleetsResponse) String() string {
+func (x *ShipInfo) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListFleetsResponse) ProtoMessage() {}
+func (*ShipInfo) ProtoMessage() {}
 
-func (x *ListFleetsResponse) ProtoReflect() protoreflect.Message {
+func (x *ShipInfo) ProtoReflect() protoreflect.Message {
 	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[96]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -6364,116 +6540,157 @@ func (x *ListFleetsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListFleetsResponse.ProtoReflect.Descriptor instead.
-func (*ListFleetsResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use ShipInfo.ProtoReflect.Descriptor instead.
+func (*ShipInfo) Descriptor() ([]byte, []int) {
 	return file_pkg_proto_daemon_daemon_proto_rawDescGZIP(), []int{96}
 }
 
-func (x *ListFleetsResponse) GetFleets() []*Fleet {
+func (x *ShipInfo) GetSymbol() string {
 	if x != nil {
-		return x.Fleets
+		return x.Symbol
 	}
-	return nil
-}
-
-// ListWaypointsRequest lists the waypoints of a system from the waypoint cache
-type ListWaypointsRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	SystemSymbol  string                 `protobuf:"bytes,1,opt,name=system_symbol,json=systemSymbol,proto3" json:"system_symbol,omitempty"`
-	Trait         *string                `protobuf:"bytes,2,opt,name=trait,proto3,oneof" json:"trait,omitempty"` // Optional filter: only waypoints with this trait
-	Type          *string                `protobuf:"bytes,3,opt,name=type,proto3,oneof" json:"type,omitempty"`   // Optional filter: only waypoints of this type
-	PlayerId      *int32                 `protobuf:"varint,4,opt,name=player_id,json=playerId,proto3,oneof" json:"player_id,omitempty"`
-	AgentSymbol   *string                `protobuf:"bytes,5,opt,name=agent_symbol,json=agentSymbol,proto3,oneof" json:"agent_symbol,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	return ""
 }
 
-func (x *ListWaypointsRequest) Reset() {
-	*x = ListWaypointsRequest{}
-	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[97]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *ShipInfo) GetLocation() string {
+	if x != nil {
+		return x.Location
+	}
+	return ""
 }
 
-func (x *ListWaypointsRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *ShipInfo) GetNavStatus() string {
+	if x != nil {
+		return x.NavStatus
+	}
+	return ""
 }
 
-func (*ListWaypointsRequest) ProtoMessage() {}
-
-func (x *ListWaypointsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[97]
+func (x *ShipInfo) GetFuelCurrent() int32 {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.FuelCurrent
 	}
-	return mi.MessageOf(x)
+	return 0
 }
 
-// Deprecated: Use ListWaypointsRequest.ProtoReflect.Descriptor instead.
-func (*ListWaypointsRequest) Descriptor() ([]byte, []int) {
-	return file_pkg_proto_daemon_daemon_proto_rawDescGZIP(), []int{97}
+func (x *ShipInfo) GetFuelCapacity() int32 {
+	if x != nil {
+		return x.FuelCapacity
+	}
+	return 0
 }
 
-func (x *ListWaypointsRequest) GetSystemSymbol() string {
+func (x *ShipInfo) GetCargoUnits() int32 {
 	if x != nil {
-		return x.SystemSymbol
+		return x.CargoUnits
 	}
-	return ""
+	return 0
 }
 
-func (x *ListWaypointsRequest) GetTrait() string {
-	if x != nil && x.Trait != nil {
-		return *x.Trait
+func (x *ShipInfo) GetCargoCapacity() int32 {
+	if x != nil {
+		return x.CargoCapacity
 	}
-	return ""
+	return 0
 }
 
-func (x *ListWaypointsRequest) GetType() string {
-	if x != nil && x.Type != nil {
-		return *x.Type
+func (x *ShipInfo) GetEngineSpeed() int32 {
+	if x != nil {
+		return x.EngineSpeed
+	}
+	return 0
+}
+
+// GetShipRequest retrieves ship details
+type GetShipRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ShipSymbol    string                 `protobuf:"bytes,1,opt,name=ship_symbol,json=shipSymbol,proto3" json:"ship_symbol,omitempty"`
+	PlayerId      *int32                 `protobuf:"varint,2,opt,name=player_id,json=playerId,proto3,oneof" json:"player_id,omitempty"`
+	AgentSymbol   *string                `protobuf:"bytes,3,opt,name=agent_symbol,json=agentSymbol,proto3,oneof" json:"agent_symbol,omitempty"`
+	NoCache       bool                   `protobuf:"varint,4,opt,name=no_cache,json=noCache,proto3" json:"no_cache,omitempty"` // Re-read from the SpaceTraders API instead of the daemon cache
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetShipRequest) Reset() {
+	*x = GetShipRequest{}
+	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[97]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetShipRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetShipRequest) ProtoMessage() {}
+
+func (x *GetShipRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[97]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetShipRequest.ProtoReflect.Descriptor instead.
+func (*GetShipRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_daemon_daemon_proto_rawDescGZIP(), []int{97}
+}
+
+func (x *GetShipRequest) GetShipSymbol() string {
+	if x != nil {
+		return x.ShipSymbol
 	}
 	return ""
 }
 
-func (x *ListWaypointsRequest) GetPlayerId() int32 {
+func (x *GetShipRequest) GetPlayerId() int32 {
 	if x != nil && x.PlayerId != nil {
 		return *x.PlayerId
 	}
 	return 0
 }
 
-func (x *ListWaypointsRequest) GetAgentSymbol() string {
+func (x *GetShipRequest) GetAgentSymbol() string {
 	if x != nil && x.AgentSymbol != nil {
 		return *x.AgentSymbol
 	}
 	return ""
 }
 
-type ListWaypointsResponse struct {
+func (x *GetShipRequest) GetNoCache() bool {
+	if x != nil {
+		return x.NoCache
+	}
+	return false
+}
+
+type GetShipResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Waypoints     []*WaypointDetail      `protobuf:"bytes,1,rep,name=waypoints,proto3" json:"waypoints,omitempty"`
+	Ship          *ShipDetail            `protobuf:"bytes,1,opt,name=ship,proto3" json:"ship,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListWaypointsResponse) Reset() {
-	*x = ListWaypointsResponse{}
+func (x *GetShipResponse) Reset() {
+	*x = GetShipResponse{}
 	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[98]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListWaypointsResponse) String() string {
+func (x *GetShipResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListWaypointsResponse) ProtoMessage() {}
+func (*GetShipResponse) ProtoMessage() {}
 
-func (x *ListWaypointsResponse) ProtoReflect() protoreflect.Message {
+func (x *GetShipResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[98]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -6485,42 +6702,42 @@ func (x *ListWaypointsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListWaypointsResponse.ProtoReflect.Descriptor instead.
-func (*ListWaypointsResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetShipResponse.ProtoReflect.Descriptor instead.
+func (*GetShipResponse) Descriptor() ([]byte, []int) {
 	return file_pkg_proto_daemon_daemon_proto_rawDescGZIP(), []int{98}
 }
 
-func (x *ListWaypointsResponse) GetWaypoints() []*WaypointDetail {
+func (x *GetShipResponse) GetShip() *ShipDetail {
 	if x != nil {
-		return x.Waypoints
+		return x.Ship
 	}
 	return nil
 }
 
-// GetWaypointRequest retrieves a single waypoint's detail
-type GetWaypointRequest struct {
-	state          protoimpl.MessageState `protogen:"open.v1"`
-	WaypointSymbol string                 `protobuf:"bytes,1,opt,name=waypoint_symbol,json=waypointSymbol,proto3" json:"waypoint_symbol,omitempty"`
-	PlayerId       *int32                 `protobuf:"varint,2,opt,name=player_id,json=playerId,proto3,oneof" json:"player_id,omitempty"`
-	AgentSymbol    *string                `protobuf:"bytes,3,opt,name=agent_symbol,json=agentSymbol,proto3,oneof" json:"agent_symbol,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+// RefreshShipRequest forces a resync of a ship from the API
+type RefreshShipRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ShipSymbol    string                 `protobuf:"bytes,1,opt,name=ship_symbol,json=shipSymbol,proto3" json:"ship_symbol,omitempty"`
+	PlayerId      *int32                 `protobuf:"varint,2,opt,name=player_id,json=playerId,proto3,oneof" json:"player_id,omitempty"`
+	AgentSymbol   *string                `protobuf:"bytes,3,opt,name=agent_symbol,json=agentSymbol,proto3,oneof" json:"agent_symbol,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetWaypointRequest) Reset() {
-	*x = GetWaypointRequest{}
+func (x *RefreshShipRequest) Reset() {
+	*x = RefreshShipRequest{}
 	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[99]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetWaypointRequest) String() string {
+func (x *RefreshShipRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetWaypointRequest) ProtoMessage() {}
+func (*RefreshShipRequest) ProtoMessage() {}
 
-func (x *GetWaypointRequest) ProtoReflect() protoreflect.Message {
+func (x *RefreshShipRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[99]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -6532,53 +6749,53 @@ func (x *GetWaypointRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetWaypointRequest.ProtoReflect.Descriptor instead.
-func (*GetWaypointRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use RefreshShipRequest.ProtoReflect.Descriptor instead.
+func (*RefreshShipRequest) Descriptor() ([]byte, []int) {
 	return file_pkg_proto_daemon_daemon_proto_rawDescGZIP(), []int{99}
 }
 
-func (x *GetWaypointRequest) GetWaypointSymbol() string {
+func (x *RefreshShipRequest) GetShipSymbol() string {
 	if x != nil {
-		return x.WaypointSymbol
+		return x.ShipSymbol
 	}
 	return ""
 }
 
-func (x *GetWaypointRequest) GetPlayerId() int32 {
+func (x *RefreshShipRequest) GetPlayerId() int32 {
 	if x != nil && x.PlayerId != nil {
 		return *x.PlayerId
 	}
 	return 0
 }
 
-func (x *GetWaypointRequest) GetAgentSymbol() string {
+func (x *RefreshShipRequest) GetAgentSymbol() string {
 	if x != nil && x.AgentSymbol != nil {
 		return *x.AgentSymbol
 	}
 	return ""
 }
 
-type GetWaypointResponse struct {
+type RefreshShipResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Waypoint      *WaypointDetail        `protobuf:"bytes,1,opt,name=waypoint,proto3" json:"waypoint,omitempty"`
+	Ship          *ShipDetail            `protobuf:"bytes,1,opt,name=ship,proto3" json:"ship,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetWaypointResponse) Reset() {
-	*x = GetWaypointResponse{}
+func (x *RefreshShipResponse) Reset() {
+	*x = RefreshShipResponse{}
 	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[100]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetWaypointResponse) String() string {
+func (x *RefreshShipResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetWaypointResponse) ProtoMessage() {}
+func (*RefreshShipResponse) ProtoMessage() {}
 
-func (x *GetWaypointResponse) ProtoReflect() protoreflect.Message {
+func (x *RefreshShipResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[100]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -6590,46 +6807,47 @@ func (x *GetWaypointResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetWaypointResponse.ProtoReflect.Descriptor instead.
-func (*GetWaypointResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use RefreshShipResponse.ProtoReflect.Descriptor instead.
+func (*RefreshShipResponse) Descriptor() ([]byte, []int) {
 	return file_pkg_proto_daemon_daemon_proto_rawDescGZIP(), []int{100}
 }
 
-func (x *GetWaypointResponse) GetWaypoint() *WaypointDetail {
+func (x *RefreshShipResponse) GetShip() *ShipDetail {
 	if x != nil {
-		return x.Waypoint
+		return x.Ship
 	}
 	return nil
 }
 
-type WaypointDetail struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Symbol        string                 `protobuf:"bytes,1,opt,name=symbol,proto3" json:"symbol,omitempty"`
-	SystemSymbol  string                 `protobuf:"bytes,2,opt,name=system_symbol,json=systemSymbol,proto3" json:"system_symbol,omitempty"`
-	Type          string                 `protobuf:"bytes,3,opt,name=type,proto3" json:"type,omitempty"`
-	X             float64                `protobuf:"fixed64,4,opt,name=x,proto3" json:"x,omitempty"`
-	Y             float64                `protobuf:"fixed64,5,opt,name=y,proto3" json:"y,omitempty"`
-	Traits        []string               `protobuf:"bytes,6,rep,name=traits,proto3" json:"traits,omitempty"`
-	Orbitals      []string               `protobuf:"bytes,7,rep,name=orbitals,proto3" json:"orbitals,omitempty"`
-	HasFuel       bool                   `protobuf:"varint,8,opt,name=has_fuel,json=hasFuel,proto3" json:"has_fuel,omitempty"`
+// ReserveShipRequest reserves a ship for the captain's direct manual use
+type ReserveShipRequest struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	ShipSymbol  string                 `protobuf:"bytes,1,opt,name=ship_symbol,json=shipSymbol,proto3" json:"ship_symbol,omitempty"`
+	Reason      *string                `protobuf:"bytes,2,opt,name=reason,proto3,oneof" json:"reason,omitempty"`
+	PlayerId    *int32                 `protobuf:"varint,3,opt,name=player_id,json=playerId,proto3,oneof" json:"player_id,omitempty"`
+	AgentSymbol *string                `protobuf:"bytes,4,opt,name=agent_symbol,json=agentSymbol,proto3,oneof" json:"agent_symbol,omitempty"`
+	// force (sp-w3yd): when true, PREEMPT a coordinator's live claim — atomically
+	// revoke it and transfer the hull to the captain (operator authority wins)
+	// instead of rejecting. Absent/false is the byte-identical pre-sp-w3yd behavior.
+	Force         *bool `protobuf:"varint,5,opt,name=force,proto3,oneof" json:"force,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *WaypointDetail) Reset() {
-	*x = WaypointDetail{}
+func (x *ReserveShipRequest) Reset() {
+	*x = ReserveShipRequest{}
 	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[101]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *WaypointDetail) String() string {
+func (x *ReserveShipRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*WaypointDetail) ProtoMessage() {}
+func (*ReserveShipRequest) ProtoMessage() {}
 
-func (x *WaypointDetail) ProtoReflect() protoreflect.Message {
+func (x *ReserveShipRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[101]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -6641,111 +6859,76 @@ func (x *WaypointDetail) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use WaypointDetail.ProtoReflect.Descriptor instead.
-func (*WaypointDetail) Descriptor() ([]byte, []int) {
+// Deprecated: Use ReserveShipRequest.ProtoReflect.Descriptor instead.
+func (*ReserveShipRequest) Descriptor() ([]byte, []int) {
 	return file_pkg_proto_daemon_daemon_proto_rawDescGZIP(), []int{101}
 }
 
-func (x *WaypointDetail) GetSymbol() string {
-	if x != nil {
-		return x.Symbol
-	}
-	return ""
-}
-
-func (x *WaypointDetail) GetSystemSymbol() string {
+func (x *ReserveShipRequest) GetShipSymbol() string {
 	if x != nil {
-		return x.SystemSymbol
+		return x.ShipSymbol
 	}
 	return ""
 }
 
-func (x *WaypointDetail) GetType() string {
-	if x != nil {
-		return x.Type
+func (x *ReserveShipRequest) GetReason() string {
+	if x != nil && x.Reason != nil {
+		return *x.Reason
 	}
 	return ""
 }
 
-func (x *WaypointDetail) GetX() float64 {
-	if x != nil {
-		return x.X
-	}
-	return 0
-}
-
-func (x *WaypointDetail) GetY() float64 {
-	if x != nil {
-		return x.Y
+func (x *ReserveShipRequest) GetPlayerId() int32 {
+	if x != nil && x.PlayerId != nil {
+		return *x.PlayerId
 	}
 	return 0
 }
 
-func (x *WaypointDetail) GetTraits() []string {
-	if x != nil {
-		return x.Traits
-	}
-	return nil
-}
-
-func (x *WaypointDetail) GetOrbitals() []string {
-	if x != nil {
-		return x.Orbitals
+func (x *ReserveShipRequest) GetAgentSymbol() string {
+	if x != nil && x.AgentSymbol != nil {
+		return *x.AgentSymbol
 	}
-	return nil
+	return ""
 }
 
-func (x *WaypointDetail) GetHasFuel() bool {
-	if x != nil {
-		return x.HasFuel
+func (x *ReserveShipRequest) GetForce() bool {
+	if x != nil && x.Force != nil {
+		return *x.Force
 	}
 	return false
 }
 
-type ShipDetail struct {
-	state          protoimpl.MessageState `protogen:"open.v1"`
-	Symbol         string                 `protobuf:"bytes,1,opt,name=symbol,proto3" json:"symbol,omitempty"`
-	Location       string                 `protobuf:"bytes,2,opt,name=location,proto3" json:"location,omitempty"`
-	NavStatus      string                 `protobuf:"bytes,3,opt,name=nav_status,json=navStatus,proto3" json:"nav_status,omitempty"`
-	FuelCurrent    int32                  `protobuf:"varint,4,opt,name=fuel_current,json=fuelCurrent,proto3" json:"fuel_current,omitempty"`
-	FuelCapacity   int32                  `protobuf:"varint,5,opt,name=fuel_capacity,json=fuelCapacity,proto3" json:"fuel_capacity,omitempty"`
-	CargoUnits     int32                  `protobuf:"varint,6,opt,name=cargo_units,json=cargoUnits,proto3" json:"cargo_units,omitempty"`
-	CargoCapacity  int32                  `protobuf:"varint,7,opt,name=cargo_capacity,json=cargoCapacity,proto3" json:"cargo_capacity,omitempty"`
-	CargoInventory []*CargoItem           `protobuf:"bytes,8,rep,name=cargo_inventory,json=cargoInventory,proto3" json:"cargo_inventory,omitempty"`
-	EngineSpeed    int32                  `protobuf:"varint,9,opt,name=engine_speed,json=engineSpeed,proto3" json:"engine_speed,omitempty"`
-	Role           string                 `protobuf:"bytes,10,opt,name=role,proto3" json:"role,omitempty"`
-	// Fields 11-21 are the ship's reactor/power/slot/crew budget (sp-el60).
-	// Reactors, frames, and crew capacity have no swap endpoint in the
-	// SpaceTraders API, so these are permanent for the life of the hull.
-	ReactorSymbol      string `protobuf:"bytes,11,opt,name=reactor_symbol,json=reactorSymbol,proto3" json:"reactor_symbol,omitempty"`
-	ReactorName        string `protobuf:"bytes,12,opt,name=reactor_name,json=reactorName,proto3" json:"reactor_name,omitempty"`
-	ReactorPowerOutput int32  `protobuf:"varint,13,opt,name=reactor_power_output,json=reactorPowerOutput,proto3" json:"reactor_power_output,omitempty"`
-	PowerUsed          int32  `protobuf:"varint,14,opt,name=power_used,json=powerUsed,proto3" json:"power_used,omitempty"`
-	ModuleSlots        int32  `protobuf:"varint,15,opt,name=module_slots,json=moduleSlots,proto3" json:"module_slots,omitempty"`
-	ModuleSlotsUsed    int32  `protobuf:"varint,16,opt,name=module_slots_used,json=moduleSlotsUsed,proto3" json:"module_slots_used,omitempty"`
-	MountingPoints     int32  `protobuf:"varint,17,opt,name=mounting_points,json=mountingPoints,proto3" json:"mounting_points,omitempty"`
-	MountingPointsUsed int32  `protobuf:"varint,18,opt,name=mounting_points_used,json=mountingPointsUsed,proto3" json:"mounting_points_used,omitempty"`
-	CrewCurrent        int32  `protobuf:"varint,19,opt,name=crew_current,json=crewCurrent,proto3" json:"crew_current,omitempty"`
-	CrewRequired       int32  `protobuf:"varint,20,opt,name=crew_required,json=crewRequired,proto3" json:"crew_required,omitempty"`
-	CrewCapacity       int32  `protobuf:"varint,21,opt,name=crew_capacity,json=crewCapacity,proto3" json:"crew_capacity,omitempty"`
-	unknownFields      protoimpl.UnknownFields
-	sizeCache          protoimpl.SizeCache
+type ReserveShipResponse struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	ShipSymbol string                 `protobuf:"bytes,1,opt,name=ship_symbol,json=shipSymbol,proto3" json:"ship_symbol,omitempty"`
+	Reason     string                 `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	// warning is non-empty if reserving this ship left zero other idle ships
+	// sharing its role. Advisory only — the reservation already succeeded.
+	Warning string `protobuf:"bytes,3,opt,name=warning,proto3" json:"warning,omitempty"`
+	// preempted (sp-w3yd) is true when --force revoked a coordinator's live claim;
+	// preempted_from names the container the claim was revoked from. Both zero for a
+	// non-force reserve or a force reserve of an already-idle hull.
+	Preempted     bool   `protobuf:"varint,4,opt,name=preempted,proto3" json:"preempted,omitempty"`
+	PreemptedFrom string `protobuf:"bytes,5,opt,name=preempted_from,json=preemptedFrom,proto3" json:"preempted_from,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ShipDetail) Reset() {
-	*x = ShipDetail{}
+func (x *ReserveShipResponse) Reset() {
+	*x = ReserveShipResponse{}
 	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[102]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ShipDetail) String() string {
+func (x *ReserveShipResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ShipDetail) ProtoMessage() {}
+func (*ReserveShipResponse) ProtoMessage() {}
 
-func (x *ShipDetail) ProtoReflect() protoreflect.Message {
+func (x *ReserveShipResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[102]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -6757,185 +6940,185 @@ func (x *ShipDetail) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ShipDetail.ProtoReflect.Descriptor instead.
-func (*ShipDetail) Descriptor() ([]byte, []int) {
+// Deprecated: Use ReserveShipResponse.ProtoReflect.Descriptor instead.
+func (*ReserveShipResponse) Descriptor() ([]byte, []int) {
 	return file_pkg_proto_daemon_daemon_proto_rawDescGZIP(), []int{102}
 }
 
-func (x *ShipDetail) GetSymbol() string {
+func (x *ReserveShipResponse) GetShipSymbol() string {
 	if x != nil {
-		return x.Symbol
+		return x.ShipSymbol
 	}
 	return ""
 }
 
-func (x *ShipDetail) GetLocation() string {
+func (x *ReserveShipResponse) GetReason() string {
 	if x != nil {
-		return x.Location
+		return x.Reason
 	}
 	return ""
 }
 
-func (x *ShipDetail) GetNavStatus() string {
+func (x *ReserveShipResponse) GetWarning() string {
 	if x != nil {
-		return x.NavStatus
+		return x.Warning
 	}
 	return ""
 }
 
-func (x *ShipDetail) GetFuelCurrent() int32 {
+func (x *ReserveShipResponse) GetPreempted() bool {
 	if x != nil {
-		return x.FuelCurrent
+		return x.Preempted
 	}
-	return 0
+	return false
 }
 
-func (x *ShipDetail) GetFuelCapacity() int32 {
+func (x *ReserveShipResponse) GetPreemptedFrom() string {
 	if x != nil {
-		return x.FuelCapacity
+		return x.PreemptedFrom
 	}
-	return 0
+	return ""
 }
 
-func (x *ShipDetail) GetCargoUnits() int32 {
-	if x != nil {
-		return x.CargoUnits
-	}
-	return 0
+// ReleaseShipRequest clears a captain reservation on a ship
+type ReleaseShipRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ShipSymbol    string                 `protobuf:"bytes,1,opt,name=ship_symbol,json=shipSymbol,proto3" json:"ship_symbol,omitempty"`
+	Reason        *string                `protobuf:"bytes,2,opt,name=reason,proto3,oneof" json:"reason,omitempty"`
+	PlayerId      *int32                 `protobuf:"varint,3,opt,name=player_id,json=playerId,proto3,oneof" json:"player_id,omitempty"`
+	AgentSymbol   *string                `protobuf:"bytes,4,opt,name=agent_symbol,json=agentSymbol,proto3,oneof" json:"agent_symbol,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ShipDetail) GetCargoCapacity() int32 {
-	if x != nil {
-		return x.CargoCapacity
-	}
-	return 0
+func (x *ReleaseShipRequest) Reset() {
+	*x = ReleaseShipRequest{}
+	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[103]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *ShipDetail) GetCargoInventory() []*CargoItem {
-	if x != nil {
-		return x.CargoInventory
-	}
-	return nil
+func (x *ReleaseShipRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *ShipDetail) GetEngineSpeed() int32 {
+func (*ReleaseShipRequest) ProtoMessage() {}
+
+func (x *ReleaseShipRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[103]
 	if x != nil {
-		return x.EngineSpeed
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *ShipDetail) GetRole() string {
-	if x != nil {
-		return x.Role
-	}
-	return ""
+// Deprecated: Use ReleaseShipRequest.ProtoReflect.Descriptor instead.
+func (*ReleaseShipRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_daemon_daemon_proto_rawDescGZIP(), []int{103}
 }
 
-func (x *ShipDetail) GetReactorSymbol() string {
+func (x *ReleaseShipRequest) GetShipSymbol() string {
 	if x != nil {
-		return x.ReactorSymbol
+		return x.ShipSymbol
 	}
 	return ""
 }
 
-func (x *ShipDetail) GetReactorName() string {
-	if x != nil {
-		return x.ReactorName
+func (x *ReleaseShipRequest) GetReason() string {
+	if x != nil && x.Reason != nil {
+		return *x.Reason
 	}
 	return ""
 }
 
-func (x *ShipDetail) GetReactorPowerOutput() int32 {
-	if x != nil {
-		return x.ReactorPowerOutput
+func (x *ReleaseShipRequest) GetPlayerId() int32 {
+	if x != nil && x.PlayerId != nil {
+		return *x.PlayerId
 	}
 	return 0
 }
 
-func (x *ShipDetail) GetPowerUsed() int32 {
-	if x != nil {
-		return x.PowerUsed
+func (x *ReleaseShipRequest) GetAgentSymbol() string {
+	if x != nil && x.AgentSymbol != nil {
+		return *x.AgentSymbol
 	}
-	return 0
+	return ""
 }
 
-func (x *ShipDetail) GetModuleSlots() int32 {
-	if x != nil {
-		return x.ModuleSlots
-	}
-	return 0
+type ReleaseShipResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ShipSymbol    string                 `protobuf:"bytes,1,opt,name=ship_symbol,json=shipSymbol,proto3" json:"ship_symbol,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ShipDetail) GetModuleSlotsUsed() int32 {
-	if x != nil {
-		return x.ModuleSlotsUsed
-	}
-	return 0
+func (x *ReleaseShipResponse) Reset() {
+	*x = ReleaseShipResponse{}
+	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[104]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *ShipDetail) GetMountingPoints() int32 {
-	if x != nil {
-		return x.MountingPoints
-	}
-	return 0
+func (x *ReleaseShipResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *ShipDetail) GetMountingPointsUsed() int32 {
-	if x != nil {
-		return x.MountingPointsUsed
-	}
-	return 0
-}
+func (*ReleaseShipResponse) ProtoMessage() {}
 
-func (x *ShipDetail) GetCrewCurrent() int32 {
+func (x *ReleaseShipResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[104]
 	if x != nil {
-		return x.CrewCurrent
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *ShipDetail) GetCrewRequired() int32 {
-	if x != nil {
-		return x.CrewRequired
-	}
-	return 0
+// Deprecated: Use ReleaseShipResponse.ProtoReflect.Descriptor instead.
+func (*ReleaseShipResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_daemon_daemon_proto_rawDescGZIP(), []int{104}
 }
 
-func (x *ShipDetail) GetCrewCapacity() int32 {
+func (x *ReleaseShipResponse) GetShipSymbol() string {
 	if x != nil {
-		return x.CrewCapacity
+		return x.ShipSymbol
 	}
-	return 0
+	return ""
 }
 
-// PurchaseShipRequest requests purchase of a single ship
-type PurchaseShipRequest struct {
-	state                protoimpl.MessageState `protogen:"open.v1"`
-	PurchasingShipSymbol string                 `protobuf:"bytes,1,opt,name=purchasing_ship_symbol,json=purchasingShipSymbol,proto3" json:"purchasing_ship_symbol,omitempty"`
-	ShipType             string                 `protobuf:"bytes,2,opt,name=ship_type,json=shipType,proto3" json:"ship_type,omitempty"`
-	PlayerId             int32                  `protobuf:"varint,3,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"`
-	AgentSymbol          *string                `protobuf:"bytes,4,opt,name=agent_symbol,json=agentSymbol,proto3,oneof" json:"agent_symbol,omitempty"`
-	ShipyardWaypoint     *string                `protobuf:"bytes,5,opt,name=shipyard_waypoint,json=shipyardWaypoint,proto3,oneof" json:"shipyard_waypoint,omitempty"` // Optional - will auto-discover if not provided
-	unknownFields        protoimpl.UnknownFields
-	sizeCache            protoimpl.SizeCache
+// AssignShipFleetRequest dedicates a ship to a named fleet (sp-l7h2)
+type AssignShipFleetRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ShipSymbol    string                 `protobuf:"bytes,1,opt,name=ship_symbol,json=shipSymbol,proto3" json:"ship_symbol,omitempty"`
+	Fleet         string                 `protobuf:"bytes,2,opt,name=fleet,proto3" json:"fleet,omitempty"`
+	PlayerId      *int32                 `protobuf:"varint,3,opt,name=player_id,json=playerId,proto3,oneof" json:"player_id,omitempty"`
+	AgentSymbol   *string                `protobuf:"bytes,4,opt,name=agent_symbol,json=agentSymbol,proto3,oneof" json:"agent_symbol,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *PurchaseShipRequest) Reset() {
-	*x = PurchaseShipRequest{}
-	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[103]
+func (x *AssignShipFleetRequest) Reset() {
+	*x = AssignShipFleetRequest{}
+	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[105]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *PurchaseShipRequest) String() string {
+func (x *AssignShipFleetRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PurchaseShipRequest) ProtoMessage() {}
+func (*AssignShipFleetRequest) ProtoMessage() {}
 
-func (x *PurchaseShipRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[103]
+func (x *AssignShipFleetRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[105]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6946,72 +7129,62 @@ func (x *PurchaseShipRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PurchaseShipRequest.ProtoReflect.Descriptor instead.
-func (*PurchaseShipRequest) Descriptor() ([]byte, []int) {
-	return file_pkg_proto_daemon_daemon_proto_rawDescGZIP(), []int{103}
+// Deprecated: Use AssignShipFleetRequest.ProtoReflect.Descriptor instead.
+func (*AssignShipFleetRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_daemon_daemon_proto_rawDescGZIP(), []int{105}
 }
 
-func (x *PurchaseShipRequest) GetPurchasingShipSymbol() string {
+func (x *AssignShipFleetRequest) GetShipSymbol() string {
 	if x != nil {
-		return x.PurchasingShipSymbol
+		return x.ShipSymbol
 	}
 	return ""
 }
 
-func (x *PurchaseShipRequest) GetShipType() string {
+func (x *AssignShipFleetRequest) GetFleet() string {
 	if x != nil {
-		return x.ShipType
+		return x.Fleet
 	}
 	return ""
 }
 
-func (x *PurchaseShipRequest) GetPlayerId() int32 {
-	if x != nil {
-		return x.PlayerId
+func (x *AssignShipFleetRequest) GetPlayerId() int32 {
+	if x != nil && x.PlayerId != nil {
+		return *x.PlayerId
 	}
 	return 0
 }
 
-func (x *PurchaseShipRequest) GetAgentSymbol() string {
+func (x *AssignShipFleetRequest) GetAgentSymbol() string {
 	if x != nil && x.AgentSymbol != nil {
 		return *x.AgentSymbol
 	}
 	return ""
 }
 
-func (x *PurchaseShipRequest) GetShipyardWaypoint() string {
-	if x != nil && x.ShipyardWaypoint != nil {
-		return *x.ShipyardWaypoint
-	}
-	return ""
-}
-
-type PurchaseShipResponse struct {
-	state               protoimpl.MessageState `protogen:"open.v1"`
-	ContainerId         string                 `protobuf:"bytes,1,opt,name=container_id,json=containerId,proto3" json:"container_id,omitempty"`
-	PurchasedShipSymbol string                 `protobuf:"bytes,2,opt,name=purchased_ship_symbol,json=purchasedShipSymbol,proto3" json:"purchased_ship_symbol,omitempty"`
-	PurchasePrice       int32                  `protobuf:"varint,3,opt,name=purchase_price,json=purchasePrice,proto3" json:"purchase_price,omitempty"`
-	AgentCredits        int32                  `protobuf:"varint,4,opt,name=agent_credits,json=agentCredits,proto3" json:"agent_credits,omitempty"`
-	Status              string                 `protobuf:"bytes,5,opt,name=status,proto3" json:"status,omitempty"`
-	unknownFields       protoimpl.UnknownFields
-	sizeCache           protoimpl.SizeCache
+type AssignShipFleetResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ShipSymbol    string                 `protobuf:"bytes,1,opt,name=ship_symbol,json=shipSymbol,proto3" json:"ship_symbol,omitempty"`
+	Fleet         string                 `protobuf:"bytes,2,opt,name=fleet,proto3" json:"fleet,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *PurchaseShipResponse) Reset() {
-	*x = PurchaseShipResponse{}
-	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[104]
+func (x *AssignShipFleetResponse) Reset() {
+	*x = AssignShipFleetResponse{}
+	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[106]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *PurchaseShipResponse) String() string {
+func (x *AssignShipFleetResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PurchaseShipResponse) ProtoMessage() {}
+func (*AssignShipFleetResponse) ProtoMessage() {}
 
-func (x *PurchaseShipResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[104]
+func (x *AssignShipFleetResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[106]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7022,76 +7195,57 @@ func (x *PurchaseShipResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PurchaseShipResponse.ProtoReflect.Descriptor instead.
-func (*PurchaseShipResponse) Descriptor() ([]byte, []int) {
-	return file_pkg_proto_daemon_daemon_proto_rawDescGZIP(), []int{104}
-}
-
-func (x *PurchaseShipResponse) GetContainerId() string {
-	if x != nil {
-		return x.ContainerId
-	}
-	return ""
+// Deprecated: Use AssignShipFleetResponse.ProtoReflect.Descriptor instead.
+func (*AssignShipFleetResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_daemon_daemon_proto_rawDescGZIP(), []int{106}
 }
 
-func (x *PurchaseShipResponse) GetPurchasedShipSymbol() string {
+func (x *AssignShipFleetResponse) GetShipSymbol() string {
 	if x != nil {
-		return x.PurchasedShipSymbol
+		return x.ShipSymbol
 	}
 	return ""
 }
 
-func (x *PurchaseShipResponse) GetPurchasePrice() int32 {
-	if x != nil {
-		return x.PurchasePrice
-	}
-	return 0
-}
-
-func (x *PurchaseShipResponse) GetAgentCredits() int32 {
-	if x != nil {
-		return x.AgentCredits
-	}
-	return 0
-}
-
-func (x *PurchaseShipResponse) GetStatus() string {
+func (x *AssignShipFleetResponse) GetFleet() string {
 	if x != nil {
-		return x.Status
+		return x.Fleet
 	}
 	return ""
 }
 
-// BatchPurchaseShipsRequest requests batch purchase of multiple ships
-type BatchPurchaseShipsRequest struct {
-	state                protoimpl.MessageState `protogen:"open.v1"`
-	PurchasingShipSymbol string                 `protobuf:"bytes,1,opt,name=purchasing_ship_symbol,json=purchasingShipSymbol,proto3" json:"purchasing_ship_symbol,omitempty"`
-	ShipType             string                 `protobuf:"bytes,2,opt,name=ship_type,json=shipType,proto3" json:"ship_type,omitempty"`
-	Quantity             int32                  `protobuf:"varint,3,opt,name=quantity,proto3" json:"quantity,omitempty"`
-	MaxBudget            int32                  `protobuf:"varint,4,opt,name=max_budget,json=maxBudget,proto3" json:"max_budget,omitempty"`
-	PlayerId             int32                  `protobuf:"varint,5,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"`
-	AgentSymbol          *string                `protobuf:"bytes,6,opt,name=agent_symbol,json=agentSymbol,proto3,oneof" json:"agent_symbol,omitempty"`
-	ShipyardWaypoint     *string                `protobuf:"bytes,7,opt,name=shipyard_waypoint,json=shipyardWaypoint,proto3,oneof" json:"shipyard_waypoint,omitempty"` // Optional - will auto-discover if not provided
-	Iterations           *int32                 `protobuf:"varint,8,opt,name=iterations,proto3,oneof" json:"iterations,omitempty"`                                    // -1 for infinite, default 1
-	unknownFields        protoimpl.UnknownFields
-	sizeCache            protoimpl.SizeCache
+// FleetHubRequest adds or removes a standby-station waypoint on a running
+// operation's coordinator, live (sp-jcke).
+type FleetHubRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// operation is the fleet/operation whose coordinator owns the standby set,
+	// e.g. "contract" — the same tag its dedicated ships carry.
+	Operation string `protobuf:"bytes,1,opt,name=operation,proto3" json:"operation,omitempty"`
+	// waypoint is the standby-station waypoint symbol to add or remove.
+	Waypoint string `protobuf:"bytes,2,opt,name=waypoint,proto3" json:"waypoint,omitempty"`
+	// add=true adds the hub; add=false removes it.
+	Add           bool    `protobuf:"varint,3,opt,name=add,proto3" json:"add,omitempty"`
+	PlayerId      *int32  `protobuf:"varint,4,opt,name=player_id,json=playerId,proto3,oneof" json:"player_id,omitempty"`
+	AgentSymbol   *string `protobuf:"bytes,5,opt,name=agent_symbol,json=agentSymbol,proto3,oneof" json:"agent_symbol,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *BatchPurchaseShipsRequest) Reset() {
-	*x = BatchPurchaseShipsRequest{}
-	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[105]
+func (x *FleetHubRequest) Reset() {
+	*x = FleetHubRequest{}
+	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[107]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *BatchPurchaseShipsRequest) String() string {
+func (x *FleetHubRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*BatchPurchaseShipsRequest) ProtoMessage() {}
+func (*FleetHubRequest) ProtoMessage() {}
 
-func (x *BatchPurchaseShipsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[105]
+func (x *FleetHubRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[107]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7102,93 +7256,73 @@ func (x *BatchPurchaseShipsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use BatchPurchaseShipsRequest.ProtoReflect.Descriptor instead.
-func (*BatchPurchaseShipsRequest) Descriptor() ([]byte, []int) {
-	return file_pkg_proto_daemon_daemon_proto_rawDescGZIP(), []int{105}
+// Deprecated: Use FleetHubRequest.ProtoReflect.Descriptor instead.
+func (*FleetHubRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_daemon_daemon_proto_rawDescGZIP(), []int{107}
 }
 
-func (x *BatchPurchaseShipsRequest) GetPurchasingShipSymbol() string {
+func (x *FleetHubRequest) GetOperation() string {
 	if x != nil {
-		return x.PurchasingShipSymbol
+		return x.Operation
 	}
 	return ""
 }
 
-func (x *BatchPurchaseShipsRequest) GetShipType() string {
+func (x *FleetHubRequest) GetWaypoint() string {
 	if x != nil {
-		return x.ShipType
+		return x.Waypoint
 	}
 	return ""
 }
 
-func (x *BatchPurchaseShipsRequest) GetQuantity() int32 {
-	if x != nil {
-		return x.Quantity
-	}
-	return 0
-}
-
-func (x *BatchPurchaseShipsRequest) GetMaxBudget() int32 {
+func (x *FleetHubRequest) GetAdd() bool {
 	if x != nil {
-		return x.MaxBudget
+		return x.Add
 	}
-	return 0
+	return false
 }
 
-func (x *BatchPurchaseShipsRequest) GetPlayerId() int32 {
-	if x != nil {
-		return x.PlayerId
+func (x *FleetHubRequest) GetPlayerId() int32 {
+	if x != nil && x.PlayerId != nil {
+		return *x.PlayerId
 	}
 	return 0
 }
 
-func (x *BatchPurchaseShipsRequest) GetAgentSymbol() string {
+func (x *FleetHubRequest) GetAgentSymbol() string {
 	if x != nil && x.AgentSymbol != nil {
 		return *x.AgentSymbol
 	}
 	return ""
 }
 
-func (x *BatchPurchaseShipsRequest) GetShipyardWaypoint() string {
-	if x != nil && x.ShipyardWaypoint != nil {
-		return *x.ShipyardWaypoint
-	}
-	return ""
-}
-
-func (x *BatchPurchaseShipsRequest) GetIterations() int32 {
-	if x != nil && x.Iterations != nil {
-		return *x.Iterations
-	}
-	return 0
-}
-
-type BatchPurchaseShipsResponse struct {
-	state            protoimpl.MessageState `protogen:"open.v1"`
-	ContainerId      string                 `protobuf:"bytes,1,opt,name=container_id,json=containerId,proto3" json:"container_id,omitempty"`
-	ShipsToPurchase  int32                  `protobuf:"varint,2,opt,name=ships_to_purchase,json=shipsToPurchase,proto3" json:"ships_to_purchase,omitempty"`
-	MaxBudget        int32                  `protobuf:"varint,3,opt,name=max_budget,json=maxBudget,proto3" json:"max_budget,omitempty"`
-	ShipyardWaypoint string                 `protobuf:"bytes,4,opt,name=shipyard_waypoint,json=shipyardWaypoint,proto3" json:"shipyard_waypoint,omitempty"`
-	Status           string                 `protobuf:"bytes,5,opt,name=status,proto3" json:"status,omitempty"`
-	unknownFields    protoimpl.UnknownFields
-	sizeCache        protoimpl.SizeCache
+type FleetHubResponse struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Operation string                 `protobuf:"bytes,1,opt,name=operation,proto3" json:"operation,omitempty"`
+	// standby_stations is the resulting live standby set after the mutation.
+	StandbyStations []string `protobuf:"bytes,2,rep,name=standby_stations,json=standbyStations,proto3" json:"standby_stations,omitempty"`
+	// changed is false when the verb was a no-op (add of an existing hub, or
+	// remove of an absent one), so the CLI can report it honestly.
+	Changed       bool `protobuf:"varint,3,opt,name=changed,proto3" json:"changed,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *BatchPurchaseShipsResponse) Reset() {
-	*x = BatchPurchaseShipsResponse{}
-	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[106]
+func (x *FleetHubResponse) Reset() {
+	*x = FleetHubResponse{}
+	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[108]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *BatchPurchaseShipsResponse) String() string {
+func (x *FleetHubResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*BatchPurchaseShipsResponse) ProtoMessage() {}
+func (*FleetHubResponse) ProtoMessage() {}
 
-func (x *BatchPurchaseShipsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[106]
+func (x *FleetHubResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[108]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7199,72 +7333,57 @@ func (x *BatchPurchaseShipsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use BatchPurchaseShipsResponse.ProtoReflect.Descriptor instead.
-func (*BatchPurchaseShipsResponse) Descriptor() ([]byte, []int) {
-	return file_pkg_proto_daemon_daemon_proto_rawDescGZIP(), []int{106}
+// Deprecated: Use FleetHubResponse.ProtoReflect.Descriptor instead.
+func (*FleetHubResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_daemon_daemon_proto_rawDescGZIP(), []int{108}
 }
 
-func (x *BatchPurchaseShipsResponse) GetContainerId() string {
+func (x *FleetHubResponse) GetOperation() string {
 	if x != nil {
-		return x.ContainerId
+		return x.Operation
 	}
 	return ""
 }
 
-func (x *BatchPurchaseShipsResponse) GetShipsToPurchase() int32 {
+func (x *FleetHubResponse) GetStandbyStations() []string {
 	if x != nil {
-		return x.ShipsToPurchase
+		return x.StandbyStations
 	}
-	return 0
+	return nil
 }
 
-func (x *BatchPurchaseShipsResponse) GetMaxBudget() int32 {
+func (x *FleetHubResponse) GetChanged() bool {
 	if x != nil {
-		return x.MaxBudget
+		return x.Changed
 	}
-	return 0
+	return false
 }
 
-func (x *BatchPurchaseShipsResponse) GetShipyardWaypoint() string {
-	if x != nil {
-		return x.ShipyardWaypoint
-	}
-	return ""
+// UnassignShipFleetRequest clears a ship's fleet dedication (sp-l7h2)
+type UnassignShipFleetRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ShipSymbol    string                 `protobuf:"bytes,1,opt,name=ship_symbol,json=shipSymbol,proto3" json:"ship_symbol,omitempty"`
+	PlayerId      *int32                 `protobuf:"varint,2,opt,name=player_id,json=playerId,proto3,oneof" json:"player_id,omitempty"`
+	AgentSymbol   *string                `protobuf:"bytes,3,opt,name=agent_symbol,json=agentSymbol,proto3,oneof" json:"agent_symbol,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *BatchPurchaseShipsResponse) GetStatus() string {
-	if x != nil {
-		return x.Status
-	}
-	return ""
+func (x *UnassignShipFleetRequest) Reset() {
+	*x = UnassignShipFleetRequest{}
+	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[109]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-// GetShipyardListingsRequest requests shipyard listings
-type GetShipyardListingsRequest struct {
-	state          protoimpl.MessageState `protogen:"open.v1"`
-	SystemSymbol   string                 `protobuf:"bytes,1,opt,name=system_symbol,json=systemSymbol,proto3" json:"system_symbol,omitempty"`
-	WaypointSymbol string                 `protobuf:"bytes,2,opt,name=waypoint_symbol,json=waypointSymbol,proto3" json:"waypoint_symbol,omitempty"`
-	PlayerId       int32                  `protobuf:"varint,3,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"`
-	AgentSymbol    *string                `protobuf:"bytes,4,opt,name=agent_symbol,json=agentSymbol,proto3,oneof" json:"agent_symbol,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
-}
-
-func (x *GetShipyardListingsRequest) Reset() {
-	*x = GetShipyardListingsRequest{}
-	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[107]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *GetShipyardListingsRequest) String() string {
+func (x *UnassignShipFleetRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetShipyardListingsRequest) ProtoMessage() {}
+func (*UnassignShipFleetRequest) ProtoMessage() {}
 
-func (x *GetShipyardListingsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[107]
+func (x *UnassignShipFleetRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[109]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7275,63 +7394,54 @@ func (x *GetShipyardListingsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetShipyardListingsRequest.ProtoReflect.Descriptor instead.
-func (*GetShipyardListingsRequest) Descriptor() ([]byte, []int) {
-	return file_pkg_proto_daemon_daemon_proto_rawDescGZIP(), []int{107}
-}
-
-func (x *GetShipyardListingsRequest) GetSystemSymbol() string {
-	if x != nil {
-		return x.SystemSymbol
-	}
-	return ""
+// Deprecated: Use UnassignShipFleetRequest.ProtoReflect.Descriptor instead.
+func (*UnassignShipFleetRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_daemon_daemon_proto_rawDescGZIP(), []int{109}
 }
 
-func (x *GetShipyardListingsRequest) GetWaypointSymbol() string {
+func (x *UnassignShipFleetRequest) GetShipSymbol() string {
 	if x != nil {
-		return x.WaypointSymbol
+		return x.ShipSymbol
 	}
 	return ""
 }
 
-func (x *GetShipyardListingsRequest) GetPlayerId() int32 {
-	if x != nil {
-		return x.PlayerId
+func (x *UnassignShipFleetRequest) GetPlayerId() int32 {
+	if x != nil && x.PlayerId != nil {
+		return *x.PlayerId
 	}
 	return 0
 }
 
-func (x *GetShipyardListingsRequest) GetAgentSymbol() string {
+func (x *UnassignShipFleetRequest) GetAgentSymbol() string {
 	if x != nil && x.AgentSymbol != nil {
 		return *x.AgentSymbol
 	}
 	return ""
 }
 
-type GetShipyardListingsResponse struct {
-	state           protoimpl.MessageState `protogen:"open.v1"`
-	Listings        []*ShipListing         `protobuf:"bytes,1,rep,name=listings,proto3" json:"listings,omitempty"`
-	ShipyardSymbol  string                 `protobuf:"bytes,2,opt,name=shipyard_symbol,json=shipyardSymbol,proto3" json:"shipyard_symbol,omitempty"`
-	ModificationFee int32                  `protobuf:"varint,3,opt,name=modification_fee,json=modificationFee,proto3" json:"modification_fee,omitempty"`
-	unknownFields   protoimpl.UnknownFields
-	sizeCache       protoimpl.SizeCache
+type UnassignShipFleetResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ShipSymbol    string                 `protobuf:"bytes,1,opt,name=ship_symbol,json=shipSymbol,proto3" json:"ship_symbol,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetShipyardListingsResponse) Reset() {
-	*x = GetShipyardListingsResponse{}
-	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[108]
+func (x *UnassignShipFleetResponse) Reset() {
+	*x = UnassignShipFleetResponse{}
+	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[110]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetShipyardListingsResponse) String() string {
+func (x *UnassignShipFleetResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetShipyardListingsResponse) ProtoMessage() {}
+func (*UnassignShipFleetResponse) ProtoMessage() {}
 
-func (x *GetShipyardListingsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[108]
+func (x *UnassignShipFleetResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[110]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7342,57 +7452,42 @@ func (x *GetShipyardListingsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetShipyardListingsResponse.ProtoReflect.Descriptor instead.
-func (*GetShipyardListingsResponse) Descriptor() ([]byte, []int) {
-	return file_pkg_proto_daemon_daemon_proto_rawDescGZIP(), []int{108}
-}
-
-func (x *GetShipyardListingsResponse) GetListings() []*ShipListing {
-	if x != nil {
-		return x.Listings
-	}
-	return nil
+// Deprecated: Use UnassignShipFleetResponse.ProtoReflect.Descriptor instead.
+func (*UnassignShipFleetResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_daemon_daemon_proto_rawDescGZIP(), []int{110}
 }
 
-func (x *GetShipyardListingsResponse) GetShipyardSymbol() string {
+func (x *UnassignShipFleetResponse) GetShipSymbol() string {
 	if x != nil {
-		return x.ShipyardSymbol
+		return x.ShipSymbol
 	}
 	return ""
 }
 
-func (x *GetShipyardListingsResponse) GetModificationFee() int32 {
-	if x != nil {
-		return x.ModificationFee
-	}
-	return 0
-}
-
-type ShipListing struct {
+// ListFleetsRequest lists every dedicated fleet and its members (sp-l7h2)
+type ListFleetsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	ShipType      string                 `protobuf:"bytes,1,opt,name=ship_type,json=shipType,proto3" json:"ship_type,omitempty"`
-	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Description   string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
-	PurchasePrice int32                  `protobuf:"varint,4,opt,name=purchase_price,json=purchasePrice,proto3" json:"purchase_price,omitempty"`
+	PlayerId      *int32                 `protobuf:"varint,1,opt,name=player_id,json=playerId,proto3,oneof" json:"player_id,omitempty"`
+	AgentSymbol   *string                `protobuf:"bytes,2,opt,name=agent_symbol,json=agentSymbol,proto3,oneof" json:"agent_symbol,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ShipListing) Reset() {
-	*x = ShipListing{}
-	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[109]
+func (x *ListFleetsRequest) Reset() {
+	*x = ListFleetsRequest{}
+	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[111]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ShipListing) String() string {
+func (x *ListFleetsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ShipListing) ProtoMessage() {}
+func (*ListFleetsRequest) ProtoMessage() {}
 
-func (x *ShipListing) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[109]
+func (x *ListFleetsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[111]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7403,63 +7498,48 @@ func (x *ShipListing) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ShipListing.ProtoReflect.Descriptor instead.
-func (*ShipListing) Descriptor() ([]byte, []int) {
-	return file_pkg_proto_daemon_daemon_proto_rawDescGZIP(), []int{109}
-}
-
-func (x *ShipListing) GetShipType() string {
-	if x != nil {
-		return x.ShipType
-	}
-	return ""
+// Deprecated: Use ListFleetsRequest.ProtoReflect.Descriptor instead.
+func (*ListFleetsRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_daemon_daemon_proto_rawDescGZIP(), []int{111}
 }
 
-func (x *ShipListing) GetName() string {
-	if x != nil {
-		return x.Name
+func (x *ListFleetsRequest) GetPlayerId() int32 {
+	if x != nil && x.PlayerId != nil {
+		return *x.PlayerId
 	}
-	return ""
+	return 0
 }
 
-func (x *ShipListing) GetDescription() string {
-	if x != nil {
-		return x.Description
+func (x *ListFleetsRequest) GetAgentSymbol() string {
+	if x != nil && x.AgentSymbol != nil {
+		return *x.AgentSymbol
 	}
 	return ""
 }
 
-func (x *ShipListing) GetPurchasePrice() int32 {
-	if x != nil {
-		return x.PurchasePrice
-	}
-	return 0
-}
-
-type CargoItem struct {
+type FleetShip struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Symbol        string                 `protobuf:"bytes,1,opt,name=symbol,proto3" json:"symbol,omitempty"`
-	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Units         int32                  `protobuf:"varint,3,opt,name=units,proto3" json:"units,omitempty"`
+	ShipSymbol    string                 `protobuf:"bytes,1,opt,name=ship_symbol,json=shipSymbol,proto3" json:"ship_symbol,omitempty"`
+	Idle          bool                   `protobuf:"varint,2,opt,name=idle,proto3" json:"idle,omitempty"` // no active assignment and not in transit — claimable now
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CargoItem) Reset() {
-	*x = CargoItem{}
-	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[110]
+func (x *FleetShip) Reset() {
+	*x = FleetShip{}
+	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[112]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CargoItem) String() string {
+func (x *FleetShip) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CargoItem) ProtoMessage() {}
+func (*FleetShip) ProtoMessage() {}
 
-func (x *CargoItem) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[110]
+func (x *FleetShip) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[112]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7470,59 +7550,48 @@ func (x *CargoItem) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CargoItem.ProtoReflect.Descriptor instead.
-func (*CargoItem) Descriptor() ([]byte, []int) {
-	return file_pkg_proto_daemon_daemon_proto_rawDescGZIP(), []int{110}
-}
-
-func (x *CargoItem) GetSymbol() string {
-	if x != nil {
-		return x.Symbol
-	}
-	return ""
+// Deprecated: Use FleetShip.ProtoReflect.Descriptor instead.
+func (*FleetShip) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_daemon_daemon_proto_rawDescGZIP(), []int{112}
 }
 
-func (x *CargoItem) GetName() string {
+func (x *FleetShip) GetShipSymbol() string {
 	if x != nil {
-		return x.Name
+		return x.ShipSymbol
 	}
 	return ""
 }
 
-func (x *CargoItem) GetUnits() int32 {
+func (x *FleetShip) GetIdle() bool {
 	if x != nil {
-		return x.Units
+		return x.Idle
 	}
-	return 0
+	return false
 }
 
-// RouteSegment represents a single leg of a planned route
-type RouteSegment struct {
+type Fleet struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	From          string                 `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
-	To            string                 `protobuf:"bytes,2,opt,name=to,proto3" json:"to,omitempty"`
-	FlightMode    string                 `protobuf:"bytes,3,opt,name=flight_mode,json=flightMode,proto3" json:"flight_mode,omitempty"` // CRUISE, DRIFT, BURN, STEALTH
-	FuelCost      int32                  `protobuf:"varint,4,opt,name=fuel_cost,json=fuelCost,proto3" json:"fuel_cost,omitempty"`
-	TravelTime    int32                  `protobuf:"varint,5,opt,name=travel_time,json=travelTime,proto3" json:"travel_time,omitempty"` // seconds
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Ships         []*FleetShip           `protobuf:"bytes,2,rep,name=ships,proto3" json:"ships,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *RouteSegment) Reset() {
-	*x = RouteSegment{}
-	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[111]
+func (x *Fleet) Reset() {
+	*x = Fleet{}
+	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[113]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RouteSegment) String() string {
+func (x *Fleet) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RouteSegment) ProtoMessage() {}
+func (*Fleet) ProtoMessage() {}
 
-func (x *RouteSegment) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[111]
+func (x *Fleet) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[113]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7533,73 +7602,96 @@ func (x *RouteSegment) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RouteSegment.ProtoReflect.Descriptor instead.
-func (*RouteSegment) Descriptor() ([]byte, []int) {
-	return file_pkg_proto_daemon_daemon_proto_rawDescGZIP(), []int{111}
+// Deprecated: Use Fleet.ProtoReflect.Descriptor instead.
+func (*Fleet) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_daemon_daemon_proto_rawDescGZIP(), []int{113}
 }
 
-func (x *RouteSegment) GetFrom() string {
+func (x *Fleet) GetName() string {
 	if x != nil {
-		return x.From
+		return x.Name
 	}
 	return ""
 }
 
-func (x *RouteSegment) GetTo() string {
+func (x *Fleet) GetShips() []*FleetShip {
 	if x != nil {
-		return x.To
+		return x.Ships
 	}
-	return ""
+	return nil
 }
 
-func (x *RouteSegment) GetFlightMode() string {
-	if x != nil {
-		return x.FlightMode
-	}
-	return ""
+type ListFleetsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Fleets        []*Fleet               `protobuf:"bytes,1,rep,name=fleets,proto3" json:"fleets,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *RouteSegment) GetFuelCost() int32 {
-	if x != nil {
-		return x.FuelCost
-	}
-	return 0
+func (x *ListFleetsResponse) Reset() {
+	*x = ListFleetsResponse{}
+	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[114]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *RouteSegment) GetTravelTime() int32 {
+func (x *ListFleetsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListFleetsResponse) ProtoMessage() {}
+
+func (x *ListFleetsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[114]
 	if x != nil {
-		return x.TravelTime
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-// ShipRoute contains a ship's planned route
-type ShipRoute struct {
+// Deprecated: Use ListFleetsResponse.ProtoReflect.Descriptor instead.
+func (*ListFleetsResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_daemon_daemon_proto_rawDescGZIP(), []int{114}
+}
+
+func (x *ListFleetsResponse) GetFleets() []*Fleet {
+	if x != nil {
+		return x.Fleets
+	}
+	return nil
+}
+
+// ListWaypointsRequest lists the waypoints of a system from the waypoint cache
+type ListWaypointsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	ShipSymbol    string                 `protobuf:"bytes,1,opt,name=ship_symbol,json=shipSymbol,proto3" json:"ship_symbol,omitempty"`
-	ShipType      string                 `protobuf:"bytes,2,opt,name=ship_type,json=shipType,proto3" json:"ship_type,omitempty"` // "miner" or "transport"
-	Segments      []*RouteSegment        `protobuf:"bytes,3,rep,name=segments,proto3" json:"segments,omitempty"`
-	TotalFuel     int32                  `protobuf:"varint,4,opt,name=total_fuel,json=totalFuel,proto3" json:"total_fuel,omitempty"`
-	TotalTime     int32                  `protobuf:"varint,5,opt,name=total_time,json=totalTime,proto3" json:"total_time,omitempty"` // seconds
+	SystemSymbol  string                 `protobuf:"bytes,1,opt,name=system_symbol,json=systemSymbol,proto3" json:"system_symbol,omitempty"`
+	Trait         *string                `protobuf:"bytes,2,opt,name=trait,proto3,oneof" json:"trait,omitempty"` // Optional filter: only waypoints with this trait
+	Type          *string                `protobuf:"bytes,3,opt,name=type,proto3,oneof" json:"type,omitempty"`   // Optional filter: only waypoints of this type
+	PlayerId      *int32                 `protobuf:"varint,4,opt,name=player_id,json=playerId,proto3,oneof" json:"player_id,omitempty"`
+	AgentSymbol   *string                `protobuf:"bytes,5,opt,name=agent_symbol,json=agentSymbol,proto3,oneof" json:"agent_symbol,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ShipRoute) Reset() {
-	*x = ShipRoute{}
-	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[112]
+func (x *ListWaypointsRequest) Reset() {
+	*x = ListWaypointsRequest{}
+	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[115]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ShipRoute) String() string {
+func (x *ListWaypointsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ShipRoute) ProtoMessage() {}
+func (*ListWaypointsRequest) ProtoMessage() {}
 
-func (x *ShipRoute) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[112]
+func (x *ListWaypointsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[115]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7610,74 +7702,68 @@ func (x *ShipRoute) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ShipRoute.ProtoReflect.Descriptor instead.
-func (*ShipRoute) Descriptor() ([]byte, []int) {
-	return file_pkg_proto_daemon_daemon_proto_rawDescGZIP(), []int{112}
+// Deprecated: Use ListWaypointsRequest.ProtoReflect.Descriptor instead.
+func (*ListWaypointsRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_daemon_daemon_proto_rawDescGZIP(), []int{115}
 }
 
-func (x *ShipRoute) GetShipSymbol() string {
+func (x *ListWaypointsRequest) GetSystemSymbol() string {
 	if x != nil {
-		return x.ShipSymbol
+		return x.SystemSymbol
 	}
 	return ""
 }
 
-func (x *ShipRoute) GetShipType() string {
-	if x != nil {
-		return x.ShipType
+func (x *ListWaypointsRequest) GetTrait() string {
+	if x != nil && x.Trait != nil {
+		return *x.Trait
 	}
 	return ""
 }
 
-func (x *ShipRoute) GetSegments() []*RouteSegment {
-	if x != nil {
-		return x.Segments
+func (x *ListWaypointsRequest) GetType() string {
+	if x != nil && x.Type != nil {
+		return *x.Type
 	}
-	return nil
+	return ""
 }
 
-func (x *ShipRoute) GetTotalFuel() int32 {
-	if x != nil {
-		return x.TotalFuel
+func (x *ListWaypointsRequest) GetPlayerId() int32 {
+	if x != nil && x.PlayerId != nil {
+		return *x.PlayerId
 	}
 	return 0
 }
 
-func (x *ShipRoute) GetTotalTime() int32 {
-	if x != nil {
-		return x.TotalTime
+func (x *ListWaypointsRequest) GetAgentSymbol() string {
+	if x != nil && x.AgentSymbol != nil {
+		return *x.AgentSymbol
 	}
-	return 0
+	return ""
 }
 
-// StartGoodsFactoryRequest initiates automated goods production
-type StartGoodsFactoryRequest struct {
+type ListWaypointsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	PlayerId      int32                  `protobuf:"varint,1,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"`
-	TargetGood    string                 `protobuf:"bytes,2,opt,name=target_good,json=targetGood,proto3" json:"target_good,omitempty"`
-	SystemSymbol  *string                `protobuf:"bytes,3,opt,name=system_symbol,json=systemSymbol,proto3,oneof" json:"system_symbol,omitempty"` // Optional: defaults to current system
-	AgentSymbol   *string                `protobuf:"bytes,4,opt,name=agent_symbol,json=agentSymbol,proto3,oneof" json:"agent_symbol,omitempty"`
-	MaxIterations *int32                 `protobuf:"varint,5,opt,name=max_iterations,json=maxIterations,proto3,oneof" json:"max_iterations,omitempty"` // Optional: -1 for infinite, 0 or unset for single run, >0 for specific count
-	InputsOnly    bool                   `protobuf:"varint,6,opt,name=inputs_only,json=inputsOnly,proto3" json:"inputs_only,omitempty"`                // If true: feed the dependency tree but do NOT harvest the fabricated output — leave it in factory stock for a construction pipeline to source
+	Waypoints     []*WaypointDetail      `protobuf:"bytes,1,rep,name=waypoints,proto3" json:"waypoints,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *StartGoodsFactoryRequest) Reset() {
-	*x = StartGoodsFactoryRequest{}
-	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[113]
+func (x *ListWaypointsResponse) Reset() {
+	*x = ListWaypointsResponse{}
+	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[116]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *StartGoodsFactoryRequest) String() string {
+func (x *ListWaypointsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*StartGoodsFactoryRequest) ProtoMessage() {}
+func (*ListWaypointsResponse) ProtoMessage() {}
 
-func (x *StartGoodsFactoryRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[113]
+func (x *ListWaypointsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[116]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7688,80 +7774,43 @@ func (x *StartGoodsFactoryRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use StartGoodsFactoryRequest.ProtoReflect.Descriptor instead.
-func (*StartGoodsFactoryRequest) Descriptor() ([]byte, []int) {
-	return file_pkg_proto_daemon_daemon_proto_rawDescGZIP(), []int{113}
-}
-
-func (x *StartGoodsFactoryRequest) GetPlayerId() int32 {
-	if x != nil {
-		return x.PlayerId
-	}
-	return 0
-}
-
-func (x *StartGoodsFactoryRequest) GetTargetGood() string {
-	if x != nil {
-		return x.TargetGood
-	}
-	return ""
-}
-
-func (x *StartGoodsFactoryRequest) GetSystemSymbol() string {
-	if x != nil && x.SystemSymbol != nil {
-		return *x.SystemSymbol
-	}
-	return ""
-}
-
-func (x *StartGoodsFactoryRequest) GetAgentSymbol() string {
-	if x != nil && x.AgentSymbol != nil {
-		return *x.AgentSymbol
-	}
-	return ""
-}
-
-func (x *StartGoodsFactoryRequest) GetMaxIterations() int32 {
-	if x != nil && x.MaxIterations != nil {
-		return *x.MaxIterations
-	}
-	return 0
+// Deprecated: Use ListWaypointsResponse.ProtoReflect.Descriptor instead.
+func (*ListWaypointsResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_daemon_daemon_proto_rawDescGZIP(), []int{116}
 }
 
-func (x *StartGoodsFactoryRequest) GetInputsOnly() bool {
+func (x *ListWaypointsResponse) GetWaypoints() []*WaypointDetail {
 	if x != nil {
-		return x.InputsOnly
+		return x.Waypoints
 	}
-	return false
+	return nil
 }
 
-// StartGoodsFactoryResponse returns factory details
-type StartGoodsFactoryResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	FactoryId     string                 `protobuf:"bytes,1,opt,name=factory_id,json=factoryId,proto3" json:"factory_id,omitempty"`
-	TargetGood    string                 `protobuf:"bytes,2,opt,name=target_good,json=targetGood,proto3" json:"target_good,omitempty"`
-	Status        string                 `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`
-	Message       string                 `protobuf:"bytes,4,opt,name=message,proto3" json:"message,omitempty"` // e.g., "Dependency tree built, 5 nodes to process"
-	NodesTotal    int32                  `protobuf:"varint,5,opt,name=nodes_total,json=nodesTotal,proto3" json:"nodes_total,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+// GetWaypointRequest retrieves a single waypoint's detail
+type GetWaypointRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	WaypointSymbol string                 `protobuf:"bytes,1,opt,name=waypoint_symbol,json=waypointSymbol,proto3" json:"waypoint_symbol,omitempty"`
+	PlayerId       *int32                 `protobuf:"varint,2,opt,name=player_id,json=playerId,proto3,oneof" json:"player_id,omitempty"`
+	AgentSymbol    *string                `protobuf:"bytes,3,opt,name=agent_symbol,json=agentSymbol,proto3,oneof" json:"agent_symbol,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
-func (x *StartGoodsFactoryResponse) Reset() {
-	*x = StartGoodsFactoryResponse{}
-	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[114]
+func (x *GetWaypointRequest) Reset() {
+	*x = GetWaypointRequest{}
+	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[117]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *StartGoodsFactoryResponse) String() string {
+func (x *GetWaypointRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*StartGoodsFactoryResponse) ProtoMessage() {}
+func (*GetWaypointRequest) ProtoMessage() {}
 
-func (x *StartGoodsFactoryResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[114]
+func (x *GetWaypointRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[117]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7772,70 +7821,54 @@ func (x *StartGoodsFactoryResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use StartGoodsFactoryResponse.ProtoReflect.Descriptor instead.
-func (*StartGoodsFactoryResponse) Descriptor() ([]byte, []int) {
-	return file_pkg_proto_daemon_daemon_proto_rawDescGZIP(), []int{114}
-}
-
-func (x *StartGoodsFactoryResponse) GetFactoryId() string {
-	if x != nil {
-		return x.FactoryId
-	}
-	return ""
+// Deprecated: Use GetWaypointRequest.ProtoReflect.Descriptor instead.
+func (*GetWaypointRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_daemon_daemon_proto_rawDescGZIP(), []int{117}
 }
 
-func (x *StartGoodsFactoryResponse) GetTargetGood() string {
+func (x *GetWaypointRequest) GetWaypointSymbol() string {
 	if x != nil {
-		return x.TargetGood
+		return x.WaypointSymbol
 	}
 	return ""
 }
 
-func (x *StartGoodsFactoryResponse) GetStatus() string {
-	if x != nil {
-		return x.Status
+func (x *GetWaypointRequest) GetPlayerId() int32 {
+	if x != nil && x.PlayerId != nil {
+		return *x.PlayerId
 	}
-	return ""
+	return 0
 }
 
-func (x *StartGoodsFactoryResponse) GetMessage() string {
-	if x != nil {
-		return x.Message
+func (x *GetWaypointRequest) GetAgentSymbol() string {
+	if x != nil && x.AgentSymbol != nil {
+		return *x.AgentSymbol
 	}
 	return ""
 }
 
-func (x *StartGoodsFactoryResponse) GetNodesTotal() int32 {
-	if x != nil {
-		return x.NodesTotal
-	}
-	return 0
-}
-
-// StopGoodsFactoryRequest stops a factory operation
-type StopGoodsFactoryRequest struct {
+type GetWaypointResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	PlayerId      int32                  `protobuf:"varint,1,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"`
-	FactoryId     string                 `protobuf:"bytes,2,opt,name=factory_id,json=factoryId,proto3" json:"factory_id,omitempty"`
+	Waypoint      *WaypointDetail        `protobuf:"bytes,1,opt,name=waypoint,proto3" json:"waypoint,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *StopGoodsFactoryRequest) Reset() {
-	*x = StopGoodsFactoryRequest{}
-	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[115]
+func (x *GetWaypointResponse) Reset() {
+	*x = GetWaypointResponse{}
+	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[118]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *StopGoodsFactoryRequest) String() string {
+func (x *GetWaypointResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*StopGoodsFactoryRequest) ProtoMessage() {}
+func (*GetWaypointResponse) ProtoMessage() {}
 
-func (x *StopGoodsFactoryRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[115]
+func (x *GetWaypointResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[118]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7846,50 +7879,47 @@ func (x *StopGoodsFactoryRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use StopGoodsFactoryRequest.ProtoReflect.Descriptor instead.
-func (*StopGoodsFactoryRequest) Descriptor() ([]byte, []int) {
-	return file_pkg_proto_daemon_daemon_proto_rawDescGZIP(), []int{115}
-}
-
-func (x *StopGoodsFactoryRequest) GetPlayerId() int32 {
-	if x != nil {
-		return x.PlayerId
-	}
-	return 0
+// Deprecated: Use GetWaypointResponse.ProtoReflect.Descriptor instead.
+func (*GetWaypointResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_daemon_daemon_proto_rawDescGZIP(), []int{118}
 }
 
-func (x *StopGoodsFactoryRequest) GetFactoryId() string {
+func (x *GetWaypointResponse) GetWaypoint() *WaypointDetail {
 	if x != nil {
-		return x.FactoryId
+		return x.Waypoint
 	}
-	return ""
+	return nil
 }
 
-// StopGoodsFactoryResponse confirms factory stopped
-type StopGoodsFactoryResponse struct {
+type WaypointDetail struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	FactoryId     string                 `protobuf:"bytes,1,opt,name=factory_id,json=factoryId,proto3" json:"factory_id,omitempty"`
-	Status        string                 `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
-	Message       string                 `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+	Symbol        string                 `protobuf:"bytes,1,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	SystemSymbol  string                 `protobuf:"bytes,2,opt,name=system_symbol,json=systemSymbol,proto3" json:"system_symbol,omitempty"`
+	Type          string                 `protobuf:"bytes,3,opt,name=type,proto3" json:"type,omitempty"`
+	X             float64                `protobuf:"fixed64,4,opt,name=x,proto3" json:"x,omitempty"`
+	Y             float64                `protobuf:"fixed64,5,opt,name=y,proto3" json:"y,omitempty"`
+	Traits        []string               `protobuf:"bytes,6,rep,name=traits,proto3" json:"traits,omitempty"`
+	Orbitals      []string               `protobuf:"bytes,7,rep,name=orbitals,proto3" json:"orbitals,omitempty"`
+	HasFuel       bool                   `protobuf:"varint,8,opt,name=has_fuel,json=hasFuel,proto3" json:"has_fuel,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *StopGoodsFactoryResponse) Reset() {
-	*x = StopGoodsFactoryResponse{}
-	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[116]
+func (x *WaypointDetail) Reset() {
+	*x = WaypointDetail{}
+	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[119]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *StopGoodsFactoryResponse) String() string {
+func (x *WaypointDetail) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*StopGoodsFactoryResponse) ProtoMessage() {}
+func (*WaypointDetail) ProtoMessage() {}
 
-func (x *StopGoodsFactoryResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[116]
+func (x *WaypointDetail) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[119]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7900,61 +7930,112 @@ func (x *StopGoodsFactoryResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use StopGoodsFactoryResponse.ProtoReflect.Descriptor instead.
-func (*StopGoodsFactoryResponse) Descriptor() ([]byte, []int) {
-	return file_pkg_proto_daemon_daemon_proto_rawDescGZIP(), []int{116}
+// Deprecated: Use WaypointDetail.ProtoReflect.Descriptor instead.
+func (*WaypointDetail) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_daemon_daemon_proto_rawDescGZIP(), []int{119}
 }
 
-func (x *StopGoodsFactoryResponse) GetFactoryId() string {
+func (x *WaypointDetail) GetSymbol() string {
 	if x != nil {
-		return x.FactoryId
+		return x.Symbol
 	}
 	return ""
 }
 
-func (x *StopGoodsFactoryResponse) GetStatus() string {
+func (x *WaypointDetail) GetSystemSymbol() string {
 	if x != nil {
-		return x.Status
+		return x.SystemSymbol
 	}
 	return ""
 }
 
-func (x *StopGoodsFactoryResponse) GetMessage() string {
+func (x *WaypointDetail) GetType() string {
 	if x != nil {
-		return x.Message
+		return x.Type
 	}
 	return ""
 }
 
-// FactoryWorkerCapRequest sets the live per-op concurrent-hull cap on a running
-// goods factory (sp-ev0n).
-type FactoryWorkerCapRequest struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// container_id is the goods_factory container whose cap to set (its factory id).
-	ContainerId string `protobuf:"bytes,1,opt,name=container_id,json=containerId,proto3" json:"container_id,omitempty"`
-	// count is the maximum number of hulls the chain runs concurrently (>= 1).
-	Count         int32   `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
-	PlayerId      *int32  `protobuf:"varint,3,opt,name=player_id,json=playerId,proto3,oneof" json:"player_id,omitempty"`
-	AgentSymbol   *string `protobuf:"bytes,4,opt,name=agent_symbol,json=agentSymbol,proto3,oneof" json:"agent_symbol,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *WaypointDetail) GetX() float64 {
+	if x != nil {
+		return x.X
+	}
+	return 0
 }
 
-func (x *FactoryWorkerCapRequest) Reset() {
-	*x = FactoryWorkerCapRequest{}
-	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[117]
+func (x *WaypointDetail) GetY() float64 {
+	if x != nil {
+		return x.Y
+	}
+	return 0
+}
+
+func (x *WaypointDetail) GetTraits() []string {
+	if x != nil {
+		return x.Traits
+	}
+	return nil
+}
+
+func (x *WaypointDetail) GetOrbitals() []string {
+	if x != nil {
+		return x.Orbitals
+	}
+	return nil
+}
+
+func (x *WaypointDetail) GetHasFuel() bool {
+	if x != nil {
+		return x.HasFuel
+	}
+	return false
+}
+
+type ShipDetail struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Symbol         string                 `protobuf:"bytes,1,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	Location       string                 `protobuf:"bytes,2,opt,name=location,proto3" json:"location,omitempty"`
+	NavStatus      string                 `protobuf:"bytes,3,opt,name=nav_status,json=navStatus,proto3" json:"nav_status,omitempty"`
+	FuelCurrent    int32                  `protobuf:"varint,4,opt,name=fuel_current,json=fuelCurrent,proto3" json:"fuel_current,omitempty"`
+	FuelCapacity   int32                  `protobuf:"varint,5,opt,name=fuel_capacity,json=fuelCapacity,proto3" json:"fuel_capacity,omitempty"`
+	CargoUnits     int32                  `protobuf:"varint,6,opt,name=cargo_units,json=cargoUnits,proto3" json:"cargo_units,omitempty"`
+	CargoCapacity  int32                  `protobuf:"varint,7,opt,name=cargo_capacity,json=cargoCapacity,proto3" json:"cargo_capacity,omitempty"`
+	CargoInventory []*CargoItem           `protobuf:"bytes,8,rep,name=cargo_inventory,json=cargoInventory,proto3" json:"cargo_inventory,omitempty"`
+	EngineSpeed    int32                  `protobuf:"varint,9,opt,name=engine_speed,json=engineSpeed,proto3" json:"engine_speed,omitempty"`
+	Role           string                 `protobuf:"bytes,10,opt,name=role,proto3" json:"role,omitempty"`
+	// Fields 11-21 are the ship's reactor/power/slot/crew budget (sp-el60).
+	// Reactors, frames, and crew capacity have no swap endpoint in the
+	// SpaceTraders API, so these are permanent for the life of the hull.
+	ReactorSymbol      string `protobuf:"bytes,11,opt,name=reactor_symbol,json=reactorSymbol,proto3" json:"reactor_symbol,omitempty"`
+	ReactorName        string `protobuf:"bytes,12,opt,name=reactor_name,json=reactorName,proto3" json:"reactor_name,omitempty"`
+	ReactorPowerOutput int32  `protobuf:"varint,13,opt,name=reactor_power_output,json=reactorPowerOutput,proto3" json:"reactor_power_output,omitempty"`
+	PowerUsed          int32  `protobuf:"varint,14,opt,name=power_used,json=powerUsed,proto3" json:"power_used,omitempty"`
+	ModuleSlots        int32  `protobuf:"varint,15,opt,name=module_slots,json=moduleSlots,proto3" json:"module_slots,omitempty"`
+	ModuleSlotsUsed    int32  `protobuf:"varint,16,opt,name=module_slots_used,json=moduleSlotsUsed,proto3" json:"module_slots_used,omitempty"`
+	MountingPoints     int32  `protobuf:"varint,17,opt,name=mounting_points,json=mountingPoints,proto3" json:"mounting_points,omitempty"`
+	MountingPointsUsed int32  `protobuf:"varint,18,opt,name=mounting_points_used,json=mountingPointsUsed,proto3" json:"mounting_points_used,omitempty"`
+	CrewCurrent        int32  `protobuf:"varint,19,opt,name=crew_current,json=crewCurrent,proto3" json:"crew_current,omitempty"`
+	CrewRequired       int32  `protobuf:"varint,20,opt,name=crew_required,json=crewRequired,proto3" json:"crew_required,omitempty"`
+	CrewCapacity       int32  `protobuf:"varint,21,opt,name=crew_capacity,json=crewCapacity,proto3" json:"crew_capacity,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *ShipDetail) Reset() {
+	*x = ShipDetail{}
+	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[120]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *FactoryWorkerCapRequest) String() string {
+func (x *ShipDetail) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*FactoryWorkerCapRequest) ProtoMessage() {}
+func (*ShipDetail) ProtoMessage() {}
 
-func (x *FactoryWorkerCapRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[117]
+func (x *ShipDetail) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[120]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7965,228 +8046,185 @@ func (x *FactoryWorkerCapRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use FactoryWorkerCapRequest.ProtoReflect.Descriptor instead.
-func (*FactoryWorkerCapRequest) Descriptor() ([]byte, []int) {
-	return file_pkg_proto_daemon_daemon_proto_rawDescGZIP(), []int{117}
+// Deprecated: Use ShipDetail.ProtoReflect.Descriptor instead.
+func (*ShipDetail) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_daemon_daemon_proto_rawDescGZIP(), []int{120}
 }
 
-func (x *FactoryWorkerCapRequest) GetContainerId() string {
+func (x *ShipDetail) GetSymbol() string {
 	if x != nil {
-		return x.ContainerId
+		return x.Symbol
 	}
 	return ""
 }
 
-func (x *FactoryWorkerCapRequest) GetCount() int32 {
+func (x *ShipDetail) GetLocation() string {
 	if x != nil {
-		return x.Count
+		return x.Location
 	}
-	return 0
+	return ""
 }
 
-func (x *FactoryWorkerCapRequest) GetPlayerId() int32 {
-	if x != nil && x.PlayerId != nil {
-		return *x.PlayerId
+func (x *ShipDetail) GetNavStatus() string {
+	if x != nil {
+		return x.NavStatus
 	}
-	return 0
+	return ""
 }
 
-func (x *FactoryWorkerCapRequest) GetAgentSymbol() string {
-	if x != nil && x.AgentSymbol != nil {
-		return *x.AgentSymbol
+func (x *ShipDetail) GetFuelCurrent() int32 {
+	if x != nil {
+		return x.FuelCurrent
 	}
-	return ""
+	return 0
 }
 
-// FactoryWorkerCapResponse returns the resulting live cap after the mutation.
-type FactoryWorkerCapResponse struct {
-	state       protoimpl.MessageState `protogen:"open.v1"`
-	ContainerId string                 `protobuf:"bytes,1,opt,name=container_id,json=containerId,proto3" json:"container_id,omitempty"`
-	// worker_cap is the resulting concurrent-hull cap now in force.
-	WorkerCap int32 `protobuf:"varint,2,opt,name=worker_cap,json=workerCap,proto3" json:"worker_cap,omitempty"`
-	// changed is false when the verb was a no-op (the cap already equalled count),
-	// so the CLI can report it honestly.
-	Changed       bool `protobuf:"varint,3,opt,name=changed,proto3" json:"changed,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *ShipDetail) GetFuelCapacity() int32 {
+	if x != nil {
+		return x.FuelCapacity
+	}
+	return 0
 }
 
-func (x *FactoryWorkerCapResponse) Reset() {
-	*x = FactoryWorkerCapResponse{}
-	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[118]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *ShipDetail) GetCargoUnits() int32 {
+	if x != nil {
+		return x.CargoUnits
+	}
+	return 0
 }
 
-func (x *FactoryWorkerCapResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *ShipDetail) GetCargoCapacity() int32 {
+	if x != nil {
+		return x.CargoCapacity
+	}
+	return 0
 }
 
-func (*FactoryWorkerCapResponse) ProtoMessage() {}
-
-func (x *FactoryWorkerCapResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[118]
+func (x *ShipDetail) GetCargoInventory() []*CargoItem {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.CargoInventory
 	}
-	return mi.MessageOf(x)
+	return nil
 }
 
-// Deprecated: Use FactoryWorkerCapResponse.ProtoReflect.Descriptor instead.
-func (*FactoryWorkerCapResponse) Descriptor() ([]byte, []int) {
-	return file_pkg_proto_daemon_daemon_proto_rawDescGZIP(), []int{118}
+func (x *ShipDetail) GetEngineSpeed() int32 {
+	if x != nil {
+		return x.EngineSpeed
+	}
+	return 0
 }
 
-func (x *FactoryWorkerCapResponse) GetContainerId() string {
+func (x *ShipDetail) GetRole() string {
 	if x != nil {
-		return x.ContainerId
+		return x.Role
 	}
 	return ""
 }
 
-func (x *FactoryWorkerCapResponse) GetWorkerCap() int32 {
+func (x *ShipDetail) GetReactorSymbol() string {
 	if x != nil {
-		return x.WorkerCap
+		return x.ReactorSymbol
 	}
-	return 0
+	return ""
 }
 
-func (x *FactoryWorkerCapResponse) GetChanged() bool {
+func (x *ShipDetail) GetReactorName() string {
 	if x != nil {
-		return x.Changed
+		return x.ReactorName
 	}
-	return false
+	return ""
 }
 
-// TuneContainerConfigRequest sets one live knob on a running container (sp-vwek).
-type TuneContainerConfigRequest struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// container_id names the tune target directly. Exactly one of container_id
-	// and operation is required.
-	ContainerId string `protobuf:"bytes,1,opt,name=container_id,json=containerId,proto3" json:"container_id,omitempty"`
-	// operation resolves the target by coordinator type (e.g. "freshsizer",
-	// "frontier") via the active-coordinator lookup, like `fleet hub` does.
-	Operation string `protobuf:"bytes,2,opt,name=operation,proto3" json:"operation,omitempty"`
-	// key is the knob to tune, e.g. "purchase_cooldown_secs".
-	Key string `protobuf:"bytes,3,opt,name=key,proto3" json:"key,omitempty"`
-	// value > 0 sets the knob (validated against the bounds registry);
-	// value == 0 REVERTS the knob to its documented default.
-	Value         int64   `protobuf:"varint,4,opt,name=value,proto3" json:"value,omitempty"`
-	PlayerId      *int32  `protobuf:"varint,5,opt,name=player_id,json=playerId,proto3,oneof" json:"player_id,omitempty"`
-	AgentSymbol   *string `protobuf:"bytes,6,opt,name=agent_symbol,json=agentSymbol,proto3,oneof" json:"agent_symbol,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *TuneContainerConfigRequest) Reset() {
-	*x = TuneContainerConfigRequest{}
-	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[119]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *TuneContainerConfigRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *ShipDetail) GetReactorPowerOutput() int32 {
+	if x != nil {
+		return x.ReactorPowerOutput
+	}
+	return 0
 }
 
-func (*TuneContainerConfigRequest) ProtoMessage() {}
-
-func (x *TuneContainerConfigRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[119]
+func (x *ShipDetail) GetPowerUsed() int32 {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.PowerUsed
 	}
-	return mi.MessageOf(x)
+	return 0
 }
 
-// Deprecated: Use TuneContainerConfigRequest.ProtoReflect.Descriptor instead.
-func (*TuneContainerConfigRequest) Descriptor() ([]byte, []int) {
-	return file_pkg_proto_daemon_daemon_proto_rawDescGZIP(), []int{119}
+func (x *ShipDetail) GetModuleSlots() int32 {
+	if x != nil {
+		return x.ModuleSlots
+	}
+	return 0
 }
 
-func (x *TuneContainerConfigRequest) GetContainerId() string {
+func (x *ShipDetail) GetModuleSlotsUsed() int32 {
 	if x != nil {
-		return x.ContainerId
+		return x.ModuleSlotsUsed
 	}
-	return ""
+	return 0
 }
 
-func (x *TuneContainerConfigRequest) GetOperation() string {
+func (x *ShipDetail) GetMountingPoints() int32 {
 	if x != nil {
-		return x.Operation
+		return x.MountingPoints
 	}
-	return ""
+	return 0
 }
 
-func (x *TuneContainerConfigRequest) GetKey() string {
+func (x *ShipDetail) GetMountingPointsUsed() int32 {
 	if x != nil {
-		return x.Key
+		return x.MountingPointsUsed
 	}
-	return ""
+	return 0
 }
 
-func (x *TuneContainerConfigRequest) GetValue() int64 {
+func (x *ShipDetail) GetCrewCurrent() int32 {
 	if x != nil {
-		return x.Value
+		return x.CrewCurrent
 	}
 	return 0
 }
 
-func (x *TuneContainerConfigRequest) GetPlayerId() int32 {
-	if x != nil && x.PlayerId != nil {
-		return *x.PlayerId
+func (x *ShipDetail) GetCrewRequired() int32 {
+	if x != nil {
+		return x.CrewRequired
 	}
 	return 0
 }
 
-func (x *TuneContainerConfigRequest) GetAgentSymbol() string {
-	if x != nil && x.AgentSymbol != nil {
-		return *x.AgentSymbol
+func (x *ShipDetail) GetCrewCapacity() int32 {
+	if x != nil {
+		return x.CrewCapacity
 	}
-	return ""
+	return 0
 }
 
-// TuneContainerConfigResponse reports old -> new effective values with units.
-type TuneContainerConfigResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	ContainerId   string                 `protobuf:"bytes,1,opt,name=container_id,json=containerId,proto3" json:"container_id,omitempty"`
-	ContainerType string                 `protobuf:"bytes,2,opt,name=container_type,json=containerType,proto3" json:"container_type,omitempty"`
-	Key           string                 `protobuf:"bytes,3,opt,name=key,proto3" json:"key,omitempty"`
-	OldEffective  int64                  `protobuf:"varint,4,opt,name=old_effective,json=oldEffective,proto3" json:"old_effective,omitempty"`
-	OldSource     string                 `protobuf:"bytes,5,opt,name=old_source,json=oldSource,proto3" json:"old_source,omitempty"` // "live-config" | "default"
-	NewEffective  int64                  `protobuf:"varint,6,opt,name=new_effective,json=newEffective,proto3" json:"new_effective,omitempty"`
-	NewSource     string                 `protobuf:"bytes,7,opt,name=new_source,json=newSource,proto3" json:"new_source,omitempty"`
-	Unit          string                 `protobuf:"bytes,8,opt,name=unit,proto3" json:"unit,omitempty"`
-	DefaultValue  int64                  `protobuf:"varint,9,opt,name=default_value,json=defaultValue,proto3" json:"default_value,omitempty"`
-	// changed is false when the verb was an idempotent no-op (no write, no audit).
-	Changed       bool `protobuf:"varint,10,opt,name=changed,proto3" json:"changed,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+// PurchaseShipRequest requests purchase of a single ship
+type PurchaseShipRequest struct {
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	PurchasingShipSymbol string                 `protobuf:"bytes,1,opt,name=purchasing_ship_symbol,json=purchasingShipSymbol,proto3" json:"purchasing_ship_symbol,omitempty"`
+	ShipType             string                 `protobuf:"bytes,2,opt,name=ship_type,json=shipType,proto3" json:"ship_type,omitempty"`
+	PlayerId             int32                  `protobuf:"varint,3,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"`
+	AgentSymbol          *string                `protobuf:"bytes,4,opt,name=agent_symbol,json=agentSymbol,proto3,oneof" json:"agent_symbol,omitempty"`
+	ShipyardWaypoint     *string                `protobuf:"bytes,5,opt,name=shipyard_waypoint,json=shipyardWaypoint,proto3,oneof" json:"shipyard_waypoint,omitempty"` // Optional - will auto-discover if not provided
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
 }
 
-func (x *TuneContainerConfigResponse) Reset() {
-	*x = TuneContainerConfigResponse{}
-	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[120]
+func (x *PurchaseShipRequest) Reset() {
+	*x = PurchaseShipRequest{}
+	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[121]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *TuneContainerConfigResponse) String() string {
+func (x *PurchaseShipRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TuneContainerConfigResponse) ProtoMessage() {}
+func (*PurchaseShipRequest) ProtoMessage() {}
 
-func (x *TuneContainerConfigResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[120]
+func (x *PurchaseShipRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[121]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8197,107 +8235,72 @@ func (x *TuneContainerConfigResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TuneContainerConfigResponse.ProtoReflect.Descriptor instead.
-func (*TuneContainerConfigResponse) Descriptor() ([]byte, []int) {
-	return file_pkg_proto_daemon_daemon_proto_rawDescGZIP(), []int{120}
-}
-
-func (x *TuneContainerConfigResponse) GetContainerId() string {
-	if x != nil {
-		return x.ContainerId
-	}
-	return ""
-}
-
-func (x *TuneContainerConfigResponse) GetContainerType() string {
-	if x != nil {
-		return x.ContainerType
-	}
-	return ""
+// Deprecated: Use PurchaseShipRequest.ProtoReflect.Descriptor instead.
+func (*PurchaseShipRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_daemon_daemon_proto_rawDescGZIP(), []int{121}
 }
 
-func (x *TuneContainerConfigResponse) GetKey() string {
+func (x *PurchaseShipRequest) GetPurchasingShipSymbol() string {
 	if x != nil {
-		return x.Key
+		return x.PurchasingShipSymbol
 	}
 	return ""
 }
 
-func (x *TuneContainerConfigResponse) GetOldEffective() int64 {
-	if x != nil {
-		return x.OldEffective
-	}
-	return 0
-}
-
-func (x *TuneContainerConfigResponse) GetOldSource() string {
+func (x *PurchaseShipRequest) GetShipType() string {
 	if x != nil {
-		return x.OldSource
+		return x.ShipType
 	}
 	return ""
 }
 
-func (x *TuneContainerConfigResponse) GetNewEffective() int64 {
+func (x *PurchaseShipRequest) GetPlayerId() int32 {
 	if x != nil {
-		return x.NewEffective
+		return x.PlayerId
 	}
 	return 0
 }
 
-func (x *TuneContainerConfigResponse) GetNewSource() string {
-	if x != nil {
-		return x.NewSource
+func (x *PurchaseShipRequest) GetAgentSymbol() string {
+	if x != nil && x.AgentSymbol != nil {
+		return *x.AgentSymbol
 	}
 	return ""
 }
 
-func (x *TuneContainerConfigResponse) GetUnit() string {
-	if x != nil {
-		return x.Unit
+func (x *PurchaseShipRequest) GetShipyardWaypoint() string {
+	if x != nil && x.ShipyardWaypoint != nil {
+		return *x.ShipyardWaypoint
 	}
 	return ""
 }
 
-func (x *TuneContainerConfigResponse) GetDefaultValue() int64 {
-	if x != nil {
-		return x.DefaultValue
-	}
-	return 0
-}
-
-func (x *TuneContainerConfigResponse) GetChanged() bool {
-	if x != nil {
-		return x.Changed
-	}
-	return false
-}
-
-// ShowTunableConfigRequest lists a container's tunable knobs (sp-vwek --show).
-type ShowTunableConfigRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	ContainerId   string                 `protobuf:"bytes,1,opt,name=container_id,json=containerId,proto3" json:"container_id,omitempty"` // exactly one of container_id and operation
-	Operation     string                 `protobuf:"bytes,2,opt,name=operation,proto3" json:"operation,omitempty"`
-	PlayerId      *int32                 `protobuf:"varint,3,opt,name=player_id,json=playerId,proto3,oneof" json:"player_id,omitempty"`
-	AgentSymbol   *string                `protobuf:"bytes,4,opt,name=agent_symbol,json=agentSymbol,proto3,oneof" json:"agent_symbol,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type PurchaseShipResponse struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	ContainerId         string                 `protobuf:"bytes,1,opt,name=container_id,json=containerId,proto3" json:"container_id,omitempty"`
+	PurchasedShipSymbol string                 `protobuf:"bytes,2,opt,name=purchased_ship_symbol,json=purchasedShipSymbol,proto3" json:"purchased_ship_symbol,omitempty"`
+	PurchasePrice       int32                  `protobuf:"varint,3,opt,name=purchase_price,json=purchasePrice,proto3" json:"purchase_price,omitempty"`
+	AgentCredits        int32                  `protobuf:"varint,4,opt,name=agent_credits,json=agentCredits,proto3" json:"agent_credits,omitempty"`
+	Status              string                 `protobuf:"bytes,5,opt,name=status,proto3" json:"status,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
 }
 
-func (x *ShowTunableConfigRequest) Reset() {
-	*x = ShowTunableConfigRequest{}
-	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[121]
+func (x *PurchaseShipResponse) Reset() {
+	*x = PurchaseShipResponse{}
+	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[122]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ShowTunableConfigRequest) String() string {
+func (x *PurchaseShipResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ShowTunableConfigRequest) ProtoMessage() {}
+func (*PurchaseShipResponse) ProtoMessage() {}
 
-func (x *ShowTunableConfigRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[121]
+func (x *PurchaseShipResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[122]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8308,69 +8311,82 @@ func (x *ShowTunableConfigRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ShowTunableConfigRequest.ProtoReflect.Descriptor instead.
-func (*ShowTunableConfigRequest) Descriptor() ([]byte, []int) {
-	return file_pkg_proto_daemon_daemon_proto_rawDescGZIP(), []int{121}
+// Deprecated: Use PurchaseShipResponse.ProtoReflect.Descriptor instead.
+func (*PurchaseShipResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_daemon_daemon_proto_rawDescGZIP(), []int{122}
 }
 
-func (x *ShowTunableConfigRequest) GetContainerId() string {
+func (x *PurchaseShipResponse) GetContainerId() string {
 	if x != nil {
 		return x.ContainerId
 	}
 	return ""
 }
 
-func (x *ShowTunableConfigRequest) GetOperation() string {
+func (x *PurchaseShipResponse) GetPurchasedShipSymbol() string {
 	if x != nil {
-		return x.Operation
+		return x.PurchasedShipSymbol
 	}
 	return ""
 }
 
-func (x *ShowTunableConfigRequest) GetPlayerId() int32 {
-	if x != nil && x.PlayerId != nil {
-		return *x.PlayerId
+func (x *PurchaseShipResponse) GetPurchasePrice() int32 {
+	if x != nil {
+		return x.PurchasePrice
 	}
 	return 0
 }
 
-func (x *ShowTunableConfigRequest) GetAgentSymbol() string {
-	if x != nil && x.AgentSymbol != nil {
-		return *x.AgentSymbol
+func (x *PurchaseShipResponse) GetAgentCredits() int32 {
+	if x != nil {
+		return x.AgentCredits
 	}
-	return ""
+	return 0
 }
 
-// TunableKnobStatus is one knob row of the --show listing.
-type TunableKnobStatus struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Key           string                 `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
-	Effective     int64                  `protobuf:"varint,2,opt,name=effective,proto3" json:"effective,omitempty"`
-	Source        string                 `protobuf:"bytes,3,opt,name=source,proto3" json:"source,omitempty"` // "live-config" | "default"
-	Min           int64                  `protobuf:"varint,4,opt,name=min,proto3" json:"min,omitempty"`
-	Max           int64                  `protobuf:"varint,5,opt,name=max,proto3" json:"max,omitempty"`
-	Unit          string                 `protobuf:"bytes,6,opt,name=unit,proto3" json:"unit,omitempty"`
-	Description   string                 `protobuf:"bytes,7,opt,name=description,proto3" json:"description,omitempty"`
-	DefaultValue  int64                  `protobuf:"varint,8,opt,name=default_value,json=defaultValue,proto3" json:"default_value,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *PurchaseShipResponse) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
 }
 
-func (x *TunableKnobStatus) Reset() {
-	*x = TunableKnobStatus{}
-	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[122]
+// BatchPurchaseShipsRequest requests batch purchase of multiple ships
+type BatchPurchaseShipsRequest struct {
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	PurchasingShipSymbol string                 `protobuf:"bytes,1,opt,name=purchasing_ship_symbol,json=purchasingShipSymbol,proto3" json:"purchasing_ship_symbol,omitempty"`
+	ShipType             string                 `protobuf:"bytes,2,opt,name=ship_type,json=shipType,proto3" json:"ship_type,omitempty"`
+	Quantity             int32                  `protobuf:"varint,3,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	MaxBudget            int32                  `protobuf:"varint,4,opt,name=max_budget,json=maxBudget,proto3" json:"max_budget,omitempty"`
+	PlayerId             int32                  `protobuf:"varint,5,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"`
+	AgentSymbol          *string                `protobuf:"bytes,6,opt,name=agent_symbol,json=agentSymbol,proto3,oneof" json:"agent_symbol,omitempty"`
+	ShipyardWaypoint     *string                `protobuf:"bytes,7,opt,name=shipyard_waypoint,json=shipyardWaypoint,proto3,oneof" json:"shipyard_waypoint,omitempty"` // Optional - will auto-discover if not provided
+	Iterations           *int32                 `protobuf:"varint,8,opt,name=iterations,proto3,oneof" json:"iterations,omitempty"`                                    // -1 for infinite, default 1
+	Loadout              *string                `protobuf:"bytes,9,opt,name=loadout,proto3,oneof" json:"loadout,omitempty"`                                           // Optional loadout preset applied to each purchased ship
+	MaxPrice             *int32                 `protobuf:"varint,10,opt,name=max_price,json=maxPrice,proto3,oneof" json:"max_price,omitempty"`                       // Per-ship price cap; 0 or unset = no cap
+	WaitForDip           *bool                  `protobuf:"varint,11,opt,name=wait_for_dip,json=waitForDip,proto3,oneof" json:"wait_for_dip,omitempty"`               // Wait for the listing to fall to max_price instead of stopping
+	DipDeadline          *string                `protobuf:"bytes,12,opt,name=dip_deadline,json=dipDeadline,proto3,oneof" json:"dip_deadline,omitempty"`               // RFC3339; when a dip wait gives up (unset = daemon default)
+	DipPollSecs          *int32                 `protobuf:"varint,13,opt,name=dip_poll_secs,json=dipPollSecs,proto3,oneof" json:"dip_poll_secs,omitempty"`            // How often a dip wait re-reads the listing (unset = daemon default)
+	FailurePolicy        *string                `protobuf:"bytes,14,opt,name=failure_policy,json=failurePolicy,proto3,oneof" json:"failure_policy,omitempty"`         // stop (default), best_effort or rollback
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *BatchPurchaseShipsRequest) Reset() {
+	*x = BatchPurchaseShipsRequest{}
+	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[123]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *TunableKnobStatus) String() string {
+func (x *BatchPurchaseShipsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TunableKnobStatus) ProtoMessage() {}
+func (*BatchPurchaseShipsRequest) ProtoMessage() {}
 
-func (x *TunableKnobStatus) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[122]
+func (x *BatchPurchaseShipsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[123]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8381,151 +8397,134 @@ func (x *TunableKnobStatus) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TunableKnobStatus.ProtoReflect.Descriptor instead.
-func (*TunableKnobStatus) Descriptor() ([]byte, []int) {
-	return file_pkg_proto_daemon_daemon_proto_rawDescGZIP(), []int{122}
+// Deprecated: Use BatchPurchaseShipsRequest.ProtoReflect.Descriptor instead.
+func (*BatchPurchaseShipsRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_daemon_daemon_proto_rawDescGZIP(), []int{123}
 }
 
-func (x *TunableKnobStatus) GetKey() string {
+func (x *BatchPurchaseShipsRequest) GetPurchasingShipSymbol() string {
 	if x != nil {
-		return x.Key
+		return x.PurchasingShipSymbol
 	}
 	return ""
 }
 
-func (x *TunableKnobStatus) GetEffective() int64 {
+func (x *BatchPurchaseShipsRequest) GetShipType() string {
 	if x != nil {
-		return x.Effective
+		return x.ShipType
 	}
-	return 0
+	return ""
 }
 
-func (x *TunableKnobStatus) GetSource() string {
+func (x *BatchPurchaseShipsRequest) GetQuantity() int32 {
 	if x != nil {
-		return x.Source
+		return x.Quantity
 	}
-	return ""
+	return 0
 }
 
-func (x *TunableKnobStatus) GetMin() int64 {
+func (x *BatchPurchaseShipsRequest) GetMaxBudget() int32 {
 	if x != nil {
-		return x.Min
+		return x.MaxBudget
 	}
 	return 0
 }
 
-func (x *TunableKnobStatus) GetMax() int64 {
+func (x *BatchPurchaseShipsRequest) GetPlayerId() int32 {
 	if x != nil {
-		return x.Max
+		return x.PlayerId
 	}
 	return 0
 }
 
-func (x *TunableKnobStatus) GetUnit() string {
-	if x != nil {
-		return x.Unit
+func (x *BatchPurchaseShipsRequest) GetAgentSymbol() string {
+	if x != nil && x.AgentSymbol != nil {
+		return *x.AgentSymbol
 	}
 	return ""
 }
 
-func (x *TunableKnobStatus) GetDescription() string {
-	if x != nil {
-		return x.Description
+func (x *BatchPurchaseShipsRequest) GetShipyardWaypoint() string {
+	if x != nil && x.ShipyardWaypoint != nil {
+		return *x.ShipyardWaypoint
 	}
 	return ""
 }
 
-func (x *TunableKnobStatus) GetDefaultValue() int64 {
-	if x != nil {
-		return x.DefaultValue
+func (x *BatchPurchaseShipsRequest) GetIterations() int32 {
+	if x != nil && x.Iterations != nil {
+		return *x.Iterations
 	}
 	return 0
 }
 
-// ShowTunableConfigResponse is the full --show listing for one container.
-type ShowTunableConfigResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	ContainerId   string                 `protobuf:"bytes,1,opt,name=container_id,json=containerId,proto3" json:"container_id,omitempty"`
-	ContainerType string                 `protobuf:"bytes,2,opt,name=container_type,json=containerType,proto3" json:"container_type,omitempty"`
-	Knobs         []*TunableKnobStatus   `protobuf:"bytes,3,rep,name=knobs,proto3" json:"knobs,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *ShowTunableConfigResponse) Reset() {
-	*x = ShowTunableConfigResponse{}
-	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[123]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *ShowTunableConfigResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *BatchPurchaseShipsRequest) GetLoadout() string {
+	if x != nil && x.Loadout != nil {
+		return *x.Loadout
+	}
+	return ""
 }
 
-func (*ShowTunableConfigResponse) ProtoMessage() {}
-
-func (x *ShowTunableConfigResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[123]
-	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *BatchPurchaseShipsRequest) GetMaxPrice() int32 {
+	if x != nil && x.MaxPrice != nil {
+		return *x.MaxPrice
 	}
-	return mi.MessageOf(x)
+	return 0
 }
 
-// Deprecated: Use ShowTunableConfigResponse.ProtoReflect.Descriptor instead.
-func (*ShowTunableConfigResponse) Descriptor() ([]byte, []int) {
-	return file_pkg_proto_daemon_daemon_proto_rawDescGZIP(), []int{123}
+func (x *BatchPurchaseShipsRequest) GetWaitForDip() bool {
+	if x != nil && x.WaitForDip != nil {
+		return *x.WaitForDip
+	}
+	return false
 }
 
-func (x *ShowTunableConfigResponse) GetContainerId() string {
-	if x != nil {
-		return x.ContainerId
+func (x *BatchPurchaseShipsRequest) GetDipDeadline() string {
+	if x != nil && x.DipDeadline != nil {
+		return *x.DipDeadline
 	}
 	return ""
 }
 
-func (x *ShowTunableConfigResponse) GetContainerType() string {
-	if x != nil {
-		return x.ContainerType
+func (x *BatchPurchaseShipsRequest) GetDipPollSecs() int32 {
+	if x != nil && x.DipPollSecs != nil {
+		return *x.DipPollSecs
 	}
-	return ""
+	return 0
 }
 
-func (x *ShowTunableConfigResponse) GetKnobs() []*TunableKnobStatus {
-	if x != nil {
-		return x.Knobs
+func (x *BatchPurchaseShipsRequest) GetFailurePolicy() string {
+	if x != nil && x.FailurePolicy != nil {
+		return *x.FailurePolicy
 	}
-	return nil
+	return ""
 }
 
-// GetFrontierStatusRequest resolves the running frontier coordinator for a player.
-type GetFrontierStatusRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	PlayerId      *int32                 `protobuf:"varint,1,opt,name=player_id,json=playerId,proto3,oneof" json:"player_id,omitempty"`
-	AgentSymbol   *string                `protobuf:"bytes,2,opt,name=agent_symbol,json=agentSymbol,proto3,oneof" json:"agent_symbol,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type BatchPurchaseShipsResponse struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	ContainerId      string                 `protobuf:"bytes,1,opt,name=container_id,json=containerId,proto3" json:"container_id,omitempty"`
+	ShipsToPurchase  int32                  `protobuf:"varint,2,opt,name=ships_to_purchase,json=shipsToPurchase,proto3" json:"ships_to_purchase,omitempty"`
+	MaxBudget        int32                  `protobuf:"varint,3,opt,name=max_budget,json=maxBudget,proto3" json:"max_budget,omitempty"`
+	ShipyardWaypoint string                 `protobuf:"bytes,4,opt,name=shipyard_waypoint,json=shipyardWaypoint,proto3" json:"shipyard_waypoint,omitempty"`
+	Status           string                 `protobuf:"bytes,5,opt,name=status,proto3" json:"status,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
 }
 
-func (x *GetFrontierStatusRequest) Reset() {
-	*x = GetFrontierStatusRequest{}
+func (x *BatchPurchaseShipsResponse) Reset() {
+	*x = BatchPurchaseShipsResponse{}
 	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[124]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetFrontierStatusRequest) String() string {
+func (x *BatchPurchaseShipsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetFrontierStatusRequest) ProtoMessage() {}
+func (*BatchPurchaseShipsResponse) ProtoMessage() {}
 
-func (x *GetFrontierStatusRequest) ProtoReflect() protoreflect.Message {
+func (x *BatchPurchaseShipsResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[124]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -8537,60 +8536,71 @@ func (x *GetFrontierStatusRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetFrontierStatusRequest.ProtoReflect.Descriptor instead.
-func (*GetFrontierStatusRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use BatchPurchaseShipsResponse.ProtoReflect.Descriptor instead.
+func (*BatchPurchaseShipsResponse) Descriptor() ([]byte, []int) {
 	return file_pkg_proto_daemon_daemon_proto_rawDescGZIP(), []int{124}
 }
 
-func (x *GetFrontierStatusRequest) GetPlayerId() int32 {
-	if x != nil && x.PlayerId != nil {
-		return *x.PlayerId
+func (x *BatchPurchaseShipsResponse) GetContainerId() string {
+	if x != nil {
+		return x.ContainerId
+	}
+	return ""
+}
+
+func (x *BatchPurchaseShipsResponse) GetShipsToPurchase() int32 {
+	if x != nil {
+		return x.ShipsToPurchase
 	}
 	return 0
 }
 
-func (x *GetFrontierStatusRequest) GetAgentSymbol() string {
-	if x != nil && x.AgentSymbol != nil {
-		return *x.AgentSymbol
+func (x *BatchPurchaseShipsResponse) GetMaxBudget() int32 {
+	if x != nil {
+		return x.MaxBudget
+	}
+	return 0
+}
+
+func (x *BatchPurchaseShipsResponse) GetShipyardWaypoint() string {
+	if x != nil {
+		return x.ShipyardWaypoint
 	}
 	return ""
 }
 
-// GetFrontierStatusResponse is the frontier coordinator's one-view live state (sp-pvw3).
-type GetFrontierStatusResponse struct {
-	state             protoimpl.MessageState `protogen:"open.v1"`
-	ContainerId       string                 `protobuf:"bytes,1,opt,name=container_id,json=containerId,proto3" json:"container_id,omitempty"`
-	DiscoveryShare    int32                  `protobuf:"varint,2,opt,name=discovery_share,json=discoveryShare,proto3" json:"discovery_share,omitempty"`               // effective 0-100 percent charting virgin
-	ScanShare         int32                  `protobuf:"varint,3,opt,name=scan_share,json=scanShare,proto3" json:"scan_share,omitempty"`                              // 100 - discovery_share
-	SplitSummary      string                 `protobuf:"bytes,4,opt,name=split_summary,json=splitSummary,proto3" json:"split_summary,omitempty"`                      // e.g. "60% discover / 40% scan" or "100% discover — backlog empty"
-	VirginQueueDepth  int32                  `protobuf:"varint,5,opt,name=virgin_queue_depth,json=virginQueueDepth,proto3" json:"virgin_queue_depth,omitempty"`       // reachable uncovered virgin frontier systems
-	DarkSystems       int32                  `protobuf:"varint,6,opt,name=dark_systems,json=darkSystems,proto3" json:"dark_systems,omitempty"`                        // charted markets with no/stale price data (honest backlog)
-	DarkMarketplaces  int32                  `protobuf:"varint,7,opt,name=dark_marketplaces,json=darkMarketplaces,proto3" json:"dark_marketplaces,omitempty"`         // total unscanned marketplace count across those systems
-	ProbeFleet        int32                  `protobuf:"varint,8,opt,name=probe_fleet,json=probeFleet,proto3" json:"probe_fleet,omitempty"`                           // total satellites owned
-	ProbeCap          int32                  `protobuf:"varint,9,opt,name=probe_cap,json=probeCap,proto3" json:"probe_cap,omitempty"`                                 // max satellite cap
-	ProbesIdle        int32                  `protobuf:"varint,10,opt,name=probes_idle,json=probesIdle,proto3" json:"probes_idle,omitempty"`                          // idle relayable probes
-	PostsInFlight     int32                  `protobuf:"varint,11,opt,name=posts_in_flight,json=postsInFlight,proto3" json:"posts_in_flight,omitempty"`               // outstanding sweep-once posts
-	LastBuyPrice      int32                  `protobuf:"varint,12,opt,name=last_buy_price,json=lastBuyPrice,proto3" json:"last_buy_price,omitempty"`                  // price of the most recent probe buy (0 if none)
-	LastBuyAgeSeconds int32                  `protobuf:"varint,13,opt,name=last_buy_age_seconds,json=lastBuyAgeSeconds,proto3" json:"last_buy_age_seconds,omitempty"` // seconds since the most recent probe buy (-1 if none)
-	Blockers          []string               `protobuf:"bytes,14,rep,name=blockers,proto3" json:"blockers,omitempty"`                                                 // read-only reasons the buy path is failing closed
-	unknownFields     protoimpl.UnknownFields
-	sizeCache         protoimpl.SizeCache
+func (x *BatchPurchaseShipsResponse) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
 }
 
-func (x *GetFrontierStatusResponse) Reset() {
-	*x = GetFrontierStatusResponse{}
+// GetShipyardListingsRequest requests shipyard listings
+type GetShipyardListingsRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	SystemSymbol   string                 `protobuf:"bytes,1,opt,name=system_symbol,json=systemSymbol,proto3" json:"system_symbol,omitempty"`
+	WaypointSymbol string                 `protobuf:"bytes,2,opt,name=waypoint_symbol,json=waypointSymbol,proto3" json:"waypoint_symbol,omitempty"`
+	PlayerId       int32                  `protobuf:"varint,3,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"`
+	AgentSymbol    *string                `protobuf:"bytes,4,opt,name=agent_symbol,json=agentSymbol,proto3,oneof" json:"agent_symbol,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *GetShipyardListingsRequest) Reset() {
+	*x = GetShipyardListingsRequest{}
 	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[125]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetFrontierStatusResponse) String() string {
+func (x *GetShipyardListingsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetFrontierStatusResponse) ProtoMessage() {}
+func (*GetShipyardListingsRequest) ProtoMessage() {}
 
-func (x *GetFrontierStatusResponse) ProtoReflect() protoreflect.Message {
+func (x *GetShipyardListingsRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[125]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -8602,133 +8612,191 @@ func (x *GetFrontierStatusResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetFrontierStatusResponse.ProtoReflect.Descriptor instead.
-func (*GetFrontierStatusResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetShipyardListingsRequest.ProtoReflect.Descriptor instead.
+func (*GetShipyardListingsRequest) Descriptor() ([]byte, []int) {
 	return file_pkg_proto_daemon_daemon_proto_rawDescGZIP(), []int{125}
 }
 
-func (x *GetFrontierStatusResponse) GetContainerId() string {
+func (x *GetShipyardListingsRequest) GetSystemSymbol() string {
 	if x != nil {
-		return x.ContainerId
+		return x.SystemSymbol
 	}
 	return ""
 }
 
-func (x *GetFrontierStatusResponse) GetDiscoveryShare() int32 {
+func (x *GetShipyardListingsRequest) GetWaypointSymbol() string {
 	if x != nil {
-		return x.DiscoveryShare
+		return x.WaypointSymbol
 	}
-	return 0
+	return ""
 }
 
-func (x *GetFrontierStatusResponse) GetScanShare() int32 {
+func (x *GetShipyardListingsRequest) GetPlayerId() int32 {
 	if x != nil {
-		return x.ScanShare
+		return x.PlayerId
 	}
 	return 0
 }
 
-func (x *GetFrontierStatusResponse) GetSplitSummary() string {
-	if x != nil {
-		return x.SplitSummary
+func (x *GetShipyardListingsRequest) GetAgentSymbol() string {
+	if x != nil && x.AgentSymbol != nil {
+		return *x.AgentSymbol
 	}
 	return ""
 }
 
-func (x *GetFrontierStatusResponse) GetVirginQueueDepth() int32 {
-	if x != nil {
-		return x.VirginQueueDepth
-	}
-	return 0
+type GetShipyardListingsResponse struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Listings        []*ShipListing         `protobuf:"bytes,1,rep,name=listings,proto3" json:"listings,omitempty"`
+	ShipyardSymbol  string                 `protobuf:"bytes,2,opt,name=shipyard_symbol,json=shipyardSymbol,proto3" json:"shipyard_symbol,omitempty"`
+	ModificationFee int32                  `protobuf:"varint,3,opt,name=modification_fee,json=modificationFee,proto3" json:"modification_fee,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
-func (x *GetFrontierStatusResponse) GetDarkSystems() int32 {
+func (x *GetShipyardListingsResponse) Reset() {
+	*x = GetShipyardListingsResponse{}
+	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[126]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetShipyardListingsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetShipyardListingsResponse) ProtoMessage() {}
+
+func (x *GetShipyardListingsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[126]
 	if x != nil {
-		return x.DarkSystems
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *GetFrontierStatusResponse) GetDarkMarketplaces() int32 {
+// Deprecated: Use GetShipyardListingsResponse.ProtoReflect.Descriptor instead.
+func (*GetShipyardListingsResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_daemon_daemon_proto_rawDescGZIP(), []int{126}
+}
+
+func (x *GetShipyardListingsResponse) GetListings() []*ShipListing {
 	if x != nil {
-		return x.DarkMarketplaces
+		return x.Listings
 	}
-	return 0
+	return nil
 }
 
-func (x *GetFrontierStatusResponse) GetProbeFleet() int32 {
+func (x *GetShipyardListingsResponse) GetShipyardSymbol() string {
 	if x != nil {
-		return x.ProbeFleet
+		return x.ShipyardSymbol
 	}
-	return 0
+	return ""
 }
 
-func (x *GetFrontierStatusResponse) GetProbeCap() int32 {
+func (x *GetShipyardListingsResponse) GetModificationFee() int32 {
 	if x != nil {
-		return x.ProbeCap
+		return x.ModificationFee
 	}
 	return 0
 }
 
-func (x *GetFrontierStatusResponse) GetProbesIdle() int32 {
+type ShipListing struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ShipType      string                 `protobuf:"bytes,1,opt,name=ship_type,json=shipType,proto3" json:"ship_type,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Description   string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	PurchasePrice int32                  `protobuf:"varint,4,opt,name=purchase_price,json=purchasePrice,proto3" json:"purchase_price,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ShipListing) Reset() {
+	*x = ShipListing{}
+	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[127]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ShipListing) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ShipListing) ProtoMessage() {}
+
+func (x *ShipListing) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[127]
 	if x != nil {
-		return x.ProbesIdle
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *GetFrontierStatusResponse) GetPostsInFlight() int32 {
+// Deprecated: Use ShipListing.ProtoReflect.Descriptor instead.
+func (*ShipListing) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_daemon_daemon_proto_rawDescGZIP(), []int{127}
+}
+
+func (x *ShipListing) GetShipType() string {
 	if x != nil {
-		return x.PostsInFlight
+		return x.ShipType
 	}
-	return 0
+	return ""
 }
 
-func (x *GetFrontierStatusResponse) GetLastBuyPrice() int32 {
+func (x *ShipListing) GetName() string {
 	if x != nil {
-		return x.LastBuyPrice
+		return x.Name
 	}
-	return 0
+	return ""
 }
 
-func (x *GetFrontierStatusResponse) GetLastBuyAgeSeconds() int32 {
+func (x *ShipListing) GetDescription() string {
 	if x != nil {
-		return x.LastBuyAgeSeconds
+		return x.Description
 	}
-	return 0
+	return ""
 }
 
-func (x *GetFrontierStatusResponse) GetBlockers() []string {
+func (x *ShipListing) GetPurchasePrice() int32 {
 	if x != nil {
-		return x.Blockers
+		return x.PurchasePrice
 	}
-	return nil
+	return 0
 }
 
-// GetFactoryStatusRequest retrieves factory status
-type GetFactoryStatusRequest struct {
+type CargoItem struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	PlayerId      int32                  `protobuf:"varint,1,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"`
-	FactoryId     string                 `protobuf:"bytes,2,opt,name=factory_id,json=factoryId,proto3" json:"factory_id,omitempty"`
+	Symbol        string                 `protobuf:"bytes,1,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Units         int32                  `protobuf:"varint,3,opt,name=units,proto3" json:"units,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetFactoryStatusRequest) Reset() {
-	*x = GetFactoryStatusRequest{}
-	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[126]
+func (x *CargoItem) Reset() {
+	*x = CargoItem{}
+	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[128]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetFactoryStatusRequest) String() string {
+func (x *CargoItem) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetFactoryStatusRequest) ProtoMessage() {}
+func (*CargoItem) ProtoMessage() {}
 
-func (x *GetFactoryStatusRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[126]
+func (x *CargoItem) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[128]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8739,60 +8807,59 @@ func (x *GetFactoryStatusRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetFactoryStatusRequest.ProtoReflect.Descriptor instead.
-func (*GetFactoryStatusRequest) Descriptor() ([]byte, []int) {
-	return file_pkg_proto_daemon_daemon_proto_rawDescGZIP(), []int{126}
+// Deprecated: Use CargoItem.ProtoReflect.Descriptor instead.
+func (*CargoItem) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_daemon_daemon_proto_rawDescGZIP(), []int{128}
 }
 
-func (x *GetFactoryStatusRequest) GetPlayerId() int32 {
+func (x *CargoItem) GetSymbol() string {
 	if x != nil {
-		return x.PlayerId
+		return x.Symbol
 	}
-	return 0
+	return ""
 }
 
-func (x *GetFactoryStatusRequest) GetFactoryId() string {
+func (x *CargoItem) GetName() string {
 	if x != nil {
-		return x.FactoryId
+		return x.Name
 	}
 	return ""
 }
 
-// GetFactoryStatusResponse returns detailed factory status
-type GetFactoryStatusResponse struct {
-	state            protoimpl.MessageState `protogen:"open.v1"`
-	FactoryId        string                 `protobuf:"bytes,1,opt,name=factory_id,json=factoryId,proto3" json:"factory_id,omitempty"`
-	TargetGood       string                 `protobuf:"bytes,2,opt,name=target_good,json=targetGood,proto3" json:"target_good,omitempty"`
-	Status           string                 `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`
-	DependencyTree   string                 `protobuf:"bytes,4,opt,name=dependency_tree,json=dependencyTree,proto3" json:"dependency_tree,omitempty"`        // JSON-encoded dependency tree
-	QuantityAcquired int32                  `protobuf:"varint,5,opt,name=quantity_acquired,json=quantityAcquired,proto3" json:"quantity_acquired,omitempty"` // 0 if not yet complete
-	TotalCost        int32                  `protobuf:"varint,6,opt,name=total_cost,json=totalCost,proto3" json:"total_cost,omitempty"`
-	NodesCompleted   int32                  `protobuf:"varint,7,opt,name=nodes_completed,json=nodesCompleted,proto3" json:"nodes_completed,omitempty"`
-	NodesTotal       int32                  `protobuf:"varint,8,opt,name=nodes_total,json=nodesTotal,proto3" json:"nodes_total,omitempty"`
-	SystemSymbol     string                 `protobuf:"bytes,9,opt,name=system_symbol,json=systemSymbol,proto3" json:"system_symbol,omitempty"`
-	ShipsUsed        int32                  `protobuf:"varint,10,opt,name=ships_used,json=shipsUsed,proto3" json:"ships_used,omitempty"`                       // Number of ships utilized
-	MarketQueries    int32                  `protobuf:"varint,11,opt,name=market_queries,json=marketQueries,proto3" json:"market_queries,omitempty"`           // Number of market queries performed
-	ParallelLevels   int32                  `protobuf:"varint,12,opt,name=parallel_levels,json=parallelLevels,proto3" json:"parallel_levels,omitempty"`        // Number of parallel execution levels
-	EstimatedSpeedup float32                `protobuf:"fixed32,13,opt,name=estimated_speedup,json=estimatedSpeedup,proto3" json:"estimated_speedup,omitempty"` // Estimated speedup from parallelization
-	unknownFields    protoimpl.UnknownFields
-	sizeCache        protoimpl.SizeCache
+func (x *CargoItem) GetUnits() int32 {
+	if x != nil {
+		return x.Units
+	}
+	return 0
 }
 
-func (x *GetFactoryStatusResponse) Reset() {
-	*x = GetFactoryStatusResponse{}
-	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[127]
+// RouteSegment represents a single leg of a planned route
+type RouteSegment struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	From          string                 `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
+	To            string                 `protobuf:"bytes,2,opt,name=to,proto3" json:"to,omitempty"`
+	FlightMode    string                 `protobuf:"bytes,3,opt,name=flight_mode,json=flightMode,proto3" json:"flight_mode,omitempty"` // CRUISE, DRIFT, BURN, STEALTH
+	FuelCost      int32                  `protobuf:"varint,4,opt,name=fuel_cost,json=fuelCost,proto3" json:"fuel_cost,omitempty"`
+	TravelTime    int32                  `protobuf:"varint,5,opt,name=travel_time,json=travelTime,proto3" json:"travel_time,omitempty"` // seconds
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RouteSegment) Reset() {
+	*x = RouteSegment{}
+	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[129]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetFactoryStatusResponse) String() string {
+func (x *RouteSegment) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetFactoryStatusResponse) ProtoMessage() {}
+func (*RouteSegment) ProtoMessage() {}
 
-func (x *GetFactoryStatusResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[127]
+func (x *RouteSegment) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[129]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8803,128 +8870,151 @@ func (x *GetFactoryStatusResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetFactoryStatusResponse.ProtoReflect.Descriptor instead.
-func (*GetFactoryStatusResponse) Descriptor() ([]byte, []int) {
-	return file_pkg_proto_daemon_daemon_proto_rawDescGZIP(), []int{127}
-}
-
-func (x *GetFactoryStatusResponse) GetFactoryId() string {
-	if x != nil {
-		return x.FactoryId
-	}
-	return ""
+// Deprecated: Use RouteSegment.ProtoReflect.Descriptor instead.
+func (*RouteSegment) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_daemon_daemon_proto_rawDescGZIP(), []int{129}
 }
 
-func (x *GetFactoryStatusResponse) GetTargetGood() string {
+func (x *RouteSegment) GetFrom() string {
 	if x != nil {
-		return x.TargetGood
+		return x.From
 	}
 	return ""
 }
 
-func (x *GetFactoryStatusResponse) GetStatus() string {
+func (x *RouteSegment) GetTo() string {
 	if x != nil {
-		return x.Status
+		return x.To
 	}
 	return ""
 }
 
-func (x *GetFactoryStatusResponse) GetDependencyTree() string {
+func (x *RouteSegment) GetFlightMode() string {
 	if x != nil {
-		return x.DependencyTree
+		return x.FlightMode
 	}
 	return ""
 }
 
-func (x *GetFactoryStatusResponse) GetQuantityAcquired() int32 {
+func (x *RouteSegment) GetFuelCost() int32 {
 	if x != nil {
-		return x.QuantityAcquired
+		return x.FuelCost
 	}
 	return 0
 }
 
-func (x *GetFactoryStatusResponse) GetTotalCost() int32 {
+func (x *RouteSegment) GetTravelTime() int32 {
 	if x != nil {
-		return x.TotalCost
+		return x.TravelTime
 	}
 	return 0
 }
 
-func (x *GetFactoryStatusResponse) GetNodesCompleted() int32 {
-	if x != nil {
-		return x.NodesCompleted
-	}
-	return 0
+// ShipRoute contains a ship's planned route
+type ShipRoute struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ShipSymbol    string                 `protobuf:"bytes,1,opt,name=ship_symbol,json=shipSymbol,proto3" json:"ship_symbol,omitempty"`
+	ShipType      string                 `protobuf:"bytes,2,opt,name=ship_type,json=shipType,proto3" json:"ship_type,omitempty"` // "miner" or "transport"
+	Segments      []*RouteSegment        `protobuf:"bytes,3,rep,name=segments,proto3" json:"segments,omitempty"`
+	TotalFuel     int32                  `protobuf:"varint,4,opt,name=total_fuel,json=totalFuel,proto3" json:"total_fuel,omitempty"`
+	TotalTime     int32                  `protobuf:"varint,5,opt,name=total_time,json=totalTime,proto3" json:"total_time,omitempty"` // seconds
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetFactoryStatusResponse) GetNodesTotal() int32 {
+func (x *ShipRoute) Reset() {
+	*x = ShipRoute{}
+	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[130]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ShipRoute) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ShipRoute) ProtoMessage() {}
+
+func (x *ShipRoute) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[130]
 	if x != nil {
-		return x.NodesTotal
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *GetFactoryStatusResponse) GetSystemSymbol() string {
+// Deprecated: Use ShipRoute.ProtoReflect.Descriptor instead.
+func (*ShipRoute) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_daemon_daemon_proto_rawDescGZIP(), []int{130}
+}
+
+func (x *ShipRoute) GetShipSymbol() string {
 	if x != nil {
-		return x.SystemSymbol
+		return x.ShipSymbol
 	}
 	return ""
 }
 
-func (x *GetFactoryStatusResponse) GetShipsUsed() int32 {
+func (x *ShipRoute) GetShipType() string {
 	if x != nil {
-		return x.ShipsUsed
+		return x.ShipType
 	}
-	return 0
+	return ""
 }
 
-func (x *GetFactoryStatusResponse) GetMarketQueries() int32 {
+func (x *ShipRoute) GetSegments() []*RouteSegment {
 	if x != nil {
-		return x.MarketQueries
+		return x.Segments
 	}
-	return 0
+	return nil
 }
 
-func (x *GetFactoryStatusResponse) GetParallelLevels() int32 {
+func (x *ShipRoute) GetTotalFuel() int32 {
 	if x != nil {
-		return x.ParallelLevels
+		return x.TotalFuel
 	}
 	return 0
 }
 
-func (x *GetFactoryStatusResponse) GetEstimatedSpeedup() float32 {
+func (x *ShipRoute) GetTotalTime() int32 {
 	if x != nil {
-		return x.EstimatedSpeedup
+		return x.TotalTime
 	}
 	return 0
 }
 
-// ScanArbitrageOpportunitiesRequest scans for arbitrage opportunities
-type ScanArbitrageOpportunitiesRequest struct {
+// StartGoodsFactoryRequest initiates automated goods production
+type StartGoodsFactoryRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	PlayerId      int32                  `protobuf:"varint,1,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"`
-	SystemSymbol  string                 `protobuf:"bytes,2,opt,name=system_symbol,json=systemSymbol,proto3" json:"system_symbol,omitempty"` // System to scan (e.g., "X1-AU21")
-	MinMargin     float64                `protobuf:"fixed64,3,opt,name=min_margin,json=minMargin,proto3" json:"min_margin,omitempty"`        // Minimum profit margin percentage (default 10.0)
-	Limit         int32                  `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"`                                  // Maximum opportunities to return (default 20)
+	TargetGood    string                 `protobuf:"bytes,2,opt,name=target_good,json=targetGood,proto3" json:"target_good,omitempty"`
+	SystemSymbol  *string                `protobuf:"bytes,3,opt,name=system_symbol,json=systemSymbol,proto3,oneof" json:"system_symbol,omitempty"` // Optional: defaults to current system
+	AgentSymbol   *string                `protobuf:"bytes,4,opt,name=agent_symbol,json=agentSymbol,proto3,oneof" json:"agent_symbol,omitempty"`
+	MaxIterations *int32                 `protobuf:"varint,5,opt,name=max_iterations,json=maxIterations,proto3,oneof" json:"max_iterations,omitempty"` // Optional: -1 for infinite, 0 or unset for single run, >0 for specific count
+	InputsOnly    bool                   `protobuf:"varint,6,opt,name=inputs_only,json=inputsOnly,proto3" json:"inputs_only,omitempty"`                // If true: feed the dependency tree but do NOT harvest the fabricated output — leave it in factory stock for a construction pipeline to source
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ScanArbitrageOpportunitiesRequest) Reset() {
-	*x = ScanArbitrageOpportunitiesRequest{}
-	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[128]
+func (x *StartGoodsFactoryRequest) Reset() {
+	*x = StartGoodsFactoryRequest{}
+	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[131]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ScanArbitrageOpportunitiesRequest) String() string {
+func (x *StartGoodsFactoryRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ScanArbitrageOpportunitiesRequest) ProtoMessage() {}
+func (*StartGoodsFactoryRequest) ProtoMessage() {}
 
-func (x *ScanArbitrageOpportunitiesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[128]
+func (x *StartGoodsFactoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[131]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8935,73 +9025,80 @@ func (x *ScanArbitrageOpportunitiesRequest) ProtoReflect() protoreflect.Message
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ScanArbitrageOpportunitiesRequest.ProtoReflect.Descriptor instead.
-func (*ScanArbitrageOpportunitiesRequest) Descriptor() ([]byte, []int) {
-	return file_pkg_proto_daemon_daemon_proto_rawDescGZIP(), []int{128}
+// Deprecated: Use StartGoodsFactoryRequest.ProtoReflect.Descriptor instead.
+func (*StartGoodsFactoryRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_daemon_daemon_proto_rawDescGZIP(), []int{131}
 }
 
-func (x *ScanArbitrageOpportunitiesRequest) GetPlayerId() int32 {
+func (x *StartGoodsFactoryRequest) GetPlayerId() int32 {
 	if x != nil {
 		return x.PlayerId
 	}
 	return 0
 }
 
-func (x *ScanArbitrageOpportunitiesRequest) GetSystemSymbol() string {
+func (x *StartGoodsFactoryRequest) GetTargetGood() string {
 	if x != nil {
-		return x.SystemSymbol
+		return x.TargetGood
 	}
 	return ""
 }
 
-func (x *ScanArbitrageOpportunitiesRequest) GetMinMargin() float64 {
-	if x != nil {
-		return x.MinMargin
+func (x *StartGoodsFactoryRequest) GetSystemSymbol() string {
+	if x != nil && x.SystemSymbol != nil {
+		return *x.SystemSymbol
+	}
+	return ""
+}
+
+func (x *StartGoodsFactoryRequest) GetAgentSymbol() string {
+	if x != nil && x.AgentSymbol != nil {
+		return *x.AgentSymbol
+	}
+	return ""
+}
+
+func (x *StartGoodsFactoryRequest) GetMaxIterations() int32 {
+	if x != nil && x.MaxIterations != nil {
+		return *x.MaxIterations
 	}
 	return 0
 }
 
-func (x *ScanArbitrageOpportunitiesRequest) GetLimit() int32 {
+func (x *StartGoodsFactoryRequest) GetInputsOnly() bool {
 	if x != nil {
-		return x.Limit
+		return x.InputsOnly
 	}
-	return 0
+	return false
 }
 
-// ArbitrageOpportunity represents a single arbitrage opportunity
-type ArbitrageOpportunity struct {
-	state           protoimpl.MessageState `protogen:"open.v1"`
-	Good            string                 `protobuf:"bytes,1,opt,name=good,proto3" json:"good,omitempty"`
-	BuyMarket       string                 `protobuf:"bytes,2,opt,name=buy_market,json=buyMarket,proto3" json:"buy_market,omitempty"`
-	SellMarket      string                 `protobuf:"bytes,3,opt,name=sell_market,json=sellMarket,proto3" json:"sell_market,omitempty"`
-	BuyPrice        int32                  `protobuf:"varint,4,opt,name=buy_price,json=buyPrice,proto3" json:"buy_price,omitempty"`
-	SellPrice       int32                  `protobuf:"varint,5,opt,name=sell_price,json=sellPrice,proto3" json:"sell_price,omitempty"`
-	ProfitPerUnit   int32                  `protobuf:"varint,6,opt,name=profit_per_unit,json=profitPerUnit,proto3" json:"profit_per_unit,omitempty"`
-	ProfitMargin    float64                `protobuf:"fixed64,7,opt,name=profit_margin,json=profitMargin,proto3" json:"profit_margin,omitempty"`
-	EstimatedProfit int32                  `protobuf:"varint,8,opt,name=estimated_profit,json=estimatedProfit,proto3" json:"estimated_profit,omitempty"`
-	Distance        float64                `protobuf:"fixed64,9,opt,name=distance,proto3" json:"distance,omitempty"`
-	BuySupply       string                 `protobuf:"bytes,10,opt,name=buy_supply,json=buySupply,proto3" json:"buy_supply,omitempty"`
-	SellActivity    string                 `protobuf:"bytes,11,opt,name=sell_activity,json=sellActivity,proto3" json:"sell_activity,omitempty"`
-	Score           float64                `protobuf:"fixed64,12,opt,name=score,proto3" json:"score,omitempty"`
-	unknownFields   protoimpl.UnknownFields
-	sizeCache       protoimpl.SizeCache
+// StartGoodsFactoryResponse returns factory details
+type StartGoodsFactoryResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	FactoryId     string                 `protobuf:"bytes,1,opt,name=factory_id,json=factoryId,proto3" json:"factory_id,omitempty"`
+	TargetGood    string                 `protobuf:"bytes,2,opt,name=target_good,json=targetGood,proto3" json:"target_good,omitempty"`
+	Status        string                 `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`
+	Message       string                 `protobuf:"bytes,4,opt,name=message,proto3" json:"message,omitempty"` // e.g., "Dependency tree built, 5 nodes to process"
+	NodesTotal    int32                  `protobuf:"varint,5,opt,name=nodes_total,json=nodesTotal,proto3" json:"nodes_total,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ArbitrageOpportunity) Reset() {
-	*x = ArbitrageOpportunity{}
-	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[129]
+func (x *StartGoodsFactoryResponse) Reset() {
+	*x = StartGoodsFactoryResponse{}
+	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[132]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ArbitrageOpportunity) String() string {
+func (x *StartGoodsFactoryResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ArbitrageOpportunity) ProtoMessage() {}
+func (*StartGoodsFactoryResponse) ProtoMessage() {}
 
-func (x *ArbitrageOpportunity) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[129]
+func (x *StartGoodsFactoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[132]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -9012,120 +9109,3086 @@ func (x *ArbitrageOpportunity) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ArbitrageOpportunity.ProtoReflect.Descriptor instead.
-func (*ArbitrageOpportunity) Descriptor() ([]byte, []int) {
-	return file_pkg_proto_daemon_daemon_proto_rawDescGZIP(), []int{129}
+// Deprecated: Use StartGoodsFactoryResponse.ProtoReflect.Descriptor instead.
+func (*StartGoodsFactoryResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_daemon_daemon_proto_rawDescGZIP(), []int{132}
 }
 
-func (x *ArbitrageOpportunity) GetGood() string {
+func (x *StartGoodsFactoryResponse) GetFactoryId() string {
 	if x != nil {
-		return x.Good
+		return x.FactoryId
 	}
 	return ""
 }
 
-func (x *ArbitrageOpportunity) GetBuyMarket() string {
+func (x *StartGoodsFactoryResponse) GetTargetGood() string {
 	if x != nil {
-		return x.BuyMarket
+		return x.TargetGood
 	}
 	return ""
 }
 
-func (x *ArbitrageOpportunity) GetSellMarket() string {
+func (x *StartGoodsFactoryResponse) GetStatus() string {
 	if x != nil {
-		return x.SellMarket
+		return x.Status
 	}
 	return ""
 }
 
-func (x *ArbitrageOpportunity) GetBuyPrice() int32 {
+func (x *StartGoodsFactoryResponse) GetMessage() string {
 	if x != nil {
-		return x.BuyPrice
+		return x.Message
+	}
+	return ""
+}
+
+func (x *StartGoodsFactoryResponse) GetNodesTotal() int32 {
+	if x != nil {
+		return x.NodesTotal
+	}
+	return 0
+}
+
+// StopGoodsFactoryRequest stops a factory operation
+type StopGoodsFactoryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PlayerId      int32                  `protobuf:"varint,1,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"`
+	FactoryId     string                 `protobuf:"bytes,2,opt,name=factory_id,json=factoryId,proto3" json:"factory_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StopGoodsFactoryRequest) Reset() {
+	*x = StopGoodsFactoryRequest{}
+	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[133]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StopGoodsFactoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StopGoodsFactoryRequest) ProtoMessage() {}
+
+func (x *StopGoodsFactoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[133]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StopGoodsFactoryRequest.ProtoReflect.Descriptor instead.
+func (*StopGoodsFactoryRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_daemon_daemon_proto_rawDescGZIP(), []int{133}
+}
+
+func (x *StopGoodsFactoryRequest) GetPlayerId() int32 {
+	if x != nil {
+		return x.PlayerId
 	}
 	return 0
 }
 
-func (x *ArbitrageOpportunity) GetSellPrice() int32 {
+func (x *StopGoodsFactoryRequest) GetFactoryId() string {
+	if x != nil {
+		return x.FactoryId
+	}
+	return ""
+}
+
+// StopGoodsFactoryResponse confirms factory stopped
+type StopGoodsFactoryResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	FactoryId     string                 `protobuf:"bytes,1,opt,name=factory_id,json=factoryId,proto3" json:"factory_id,omitempty"`
+	Status        string                 `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	Message       string                 `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StopGoodsFactoryResponse) Reset() {
+	*x = StopGoodsFactoryResponse{}
+	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[134]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StopGoodsFactoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StopGoodsFactoryResponse) ProtoMessage() {}
+
+func (x *StopGoodsFactoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[134]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StopGoodsFactoryResponse.ProtoReflect.Descriptor instead.
+func (*StopGoodsFactoryResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_daemon_daemon_proto_rawDescGZIP(), []int{134}
+}
+
+func (x *StopGoodsFactoryResponse) GetFactoryId() string {
+	if x != nil {
+		return x.FactoryId
+	}
+	return ""
+}
+
+func (x *StopGoodsFactoryResponse) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *StopGoodsFactoryResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// FactoryWorkerCapRequest sets the live per-op concurrent-hull cap on a running
+// goods factory (sp-ev0n).
+type FactoryWorkerCapRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// container_id is the goods_factory container whose cap to set (its factory id).
+	ContainerId string `protobuf:"bytes,1,opt,name=container_id,json=containerId,proto3" json:"container_id,omitempty"`
+	// count is the maximum number of hulls the chain runs concurrently (>= 1).
+	Count         int32   `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	PlayerId      *int32  `protobuf:"varint,3,opt,name=player_id,json=playerId,proto3,oneof" json:"player_id,omitempty"`
+	AgentSymbol   *string `protobuf:"bytes,4,opt,name=agent_symbol,json=agentSymbol,proto3,oneof" json:"agent_symbol,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FactoryWorkerCapRequest) Reset() {
+	*x = FactoryWorkerCapRequest{}
+	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[135]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FactoryWorkerCapRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FactoryWorkerCapRequest) ProtoMessage() {}
+
+func (x *FactoryWorkerCapRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[135]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FactoryWorkerCapRequest.ProtoReflect.Descriptor instead.
+func (*FactoryWorkerCapRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_daemon_daemon_proto_rawDescGZIP(), []int{135}
+}
+
+func (x *FactoryWorkerCapRequest) GetContainerId() string {
+	if x != nil {
+		return x.ContainerId
+	}
+	return ""
+}
+
+func (x *FactoryWorkerCapRequest) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *FactoryWorkerCapRequest) GetPlayerId() int32 {
+	if x != nil && x.PlayerId != nil {
+		return *x.PlayerId
+	}
+	return 0
+}
+
+func (x *FactoryWorkerCapRequest) GetAgentSymbol() string {
+	if x != nil && x.AgentSymbol != nil {
+		return *x.AgentSymbol
+	}
+	return ""
+}
+
+// FactoryWorkerCapResponse returns the resulting live cap after the mutation.
+type FactoryWorkerCapResponse struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	ContainerId string                 `protobuf:"bytes,1,opt,name=container_id,json=containerId,proto3" json:"container_id,omitempty"`
+	// worker_cap is the resulting concurrent-hull cap now in force.
+	WorkerCap int32 `protobuf:"varint,2,opt,name=worker_cap,json=workerCap,proto3" json:"worker_cap,omitempty"`
+	// changed is false when the verb was a no-op (the cap already equalled count),
+	// so the CLI can report it honestly.
+	Changed       bool `protobuf:"varint,3,opt,name=changed,proto3" json:"changed,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FactoryWorkerCapResponse) Reset() {
+	*x = FactoryWorkerCapResponse{}
+	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[136]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FactoryWorkerCapResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FactoryWorkerCapResponse) ProtoMessage() {}
+
+func (x *FactoryWorkerCapResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[136]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FactoryWorkerCapResponse.ProtoReflect.Descriptor instead.
+func (*FactoryWorkerCapResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_daemon_daemon_proto_rawDescGZIP(), []int{136}
+}
+
+func (x *FactoryWorkerCapResponse) GetContainerId() string {
+	if x != nil {
+		return x.ContainerId
+	}
+	return ""
+}
+
+func (x *FactoryWorkerCapResponse) GetWorkerCap() int32 {
+	if x != nil {
+		return x.WorkerCap
+	}
+	return 0
+}
+
+func (x *FactoryWorkerCapResponse) GetChanged() bool {
+	if x != nil {
+		return x.Changed
+	}
+	return false
+}
+
+// TuneContainerConfigRequest sets one live knob on a running container (sp-vwek).
+type TuneContainerConfigRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// container_id names the tune target directly. Exactly one of container_id
+	// and operation is required.
+	ContainerId string `protobuf:"bytes,1,opt,name=container_id,json=containerId,proto3" json:"container_id,omitempty"`
+	// operation resolves the target by coordinator type (e.g. "freshsizer",
+	// "frontier") via the active-coordinator lookup, like `fleet hub` does.
+	Operation string `protobuf:"bytes,2,opt,name=operation,proto3" json:"operation,omitempty"`
+	// key is the knob to tune, e.g. "purchase_cooldown_secs".
+	Key string `protobuf:"bytes,3,opt,name=key,proto3" json:"key,omitempty"`
+	// value > 0 sets the knob (validated against the bounds registry);
+	// value == 0 REVERTS the knob to its documented default.
+	Value         int64   `protobuf:"varint,4,opt,name=value,proto3" json:"value,omitempty"`
+	PlayerId      *int32  `protobuf:"varint,5,opt,name=player_id,json=playerId,proto3,oneof" json:"player_id,omitempty"`
+	AgentSymbol   *string `protobuf:"bytes,6,opt,name=agent_symbol,json=agentSymbol,proto3,oneof" json:"agent_symbol,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TuneContainerConfigRequest) Reset() {
+	*x = TuneContainerConfigRequest{}
+	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[137]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TuneContainerConfigRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TuneContainerConfigRequest) ProtoMessage() {}
+
+func (x *TuneContainerConfigRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[137]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TuneContainerConfigRequest.ProtoReflect.Descriptor instead.
+func (*TuneContainerConfigRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_daemon_daemon_proto_rawDescGZIP(), []int{137}
+}
+
+func (x *TuneContainerConfigRequest) GetContainerId() string {
+	if x != nil {
+		return x.ContainerId
+	}
+	return ""
+}
+
+func (x *TuneContainerConfigRequest) GetOperation() string {
+	if x != nil {
+		return x.Operation
+	}
+	return ""
+}
+
+func (x *TuneContainerConfigRequest) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *TuneContainerConfigRequest) GetValue() int64 {
+	if x != nil {
+		return x.Value
+	}
+	return 0
+}
+
+func (x *TuneContainerConfigRequest) GetPlayerId() int32 {
+	if x != nil && x.PlayerId != nil {
+		return *x.PlayerId
+	}
+	return 0
+}
+
+func (x *TuneContainerConfigRequest) GetAgentSymbol() string {
+	if x != nil && x.AgentSymbol != nil {
+		return *x.AgentSymbol
+	}
+	return ""
+}
+
+// TuneContainerConfigResponse reports old -> new effective values with units.
+type TuneContainerConfigResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ContainerId   string                 `protobuf:"bytes,1,opt,name=container_id,json=containerId,proto3" json:"container_id,omitempty"`
+	ContainerType string                 `protobuf:"bytes,2,opt,name=container_type,json=containerType,proto3" json:"container_type,omitempty"`
+	Key           string                 `protobuf:"bytes,3,opt,name=key,proto3" json:"key,omitempty"`
+	OldEffective  int64                  `protobuf:"varint,4,opt,name=old_effective,json=oldEffective,proto3" json:"old_effective,omitempty"`
+	OldSource     string                 `protobuf:"bytes,5,opt,name=old_source,json=oldSource,proto3" json:"old_source,omitempty"` // "live-config" | "default"
+	NewEffective  int64                  `protobuf:"varint,6,opt,name=new_effective,json=newEffective,proto3" json:"new_effective,omitempty"`
+	NewSource     string                 `protobuf:"bytes,7,opt,name=new_source,json=newSource,proto3" json:"new_source,omitempty"`
+	Unit          string                 `protobuf:"bytes,8,opt,name=unit,proto3" json:"unit,omitempty"`
+	DefaultValue  int64                  `protobuf:"varint,9,opt,name=default_value,json=defaultValue,proto3" json:"default_value,omitempty"`
+	// changed is false when the verb was an idempotent no-op (no write, no audit).
+	Changed       bool `protobuf:"varint,10,opt,name=changed,proto3" json:"changed,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TuneContainerConfigResponse) Reset() {
+	*x = TuneContainerConfigResponse{}
+	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[138]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TuneContainerConfigResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TuneContainerConfigResponse) ProtoMessage() {}
+
+func (x *TuneContainerConfigResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[138]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TuneContainerConfigResponse.ProtoReflect.Descriptor instead.
+func (*TuneContainerConfigResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_daemon_daemon_proto_rawDescGZIP(), []int{138}
+}
+
+func (x *TuneContainerConfigResponse) GetContainerId() string {
+	if x != nil {
+		return x.ContainerId
+	}
+	return ""
+}
+
+func (x *TuneContainerConfigResponse) GetContainerType() string {
+	if x != nil {
+		return x.ContainerType
+	}
+	return ""
+}
+
+func (x *TuneContainerConfigResponse) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *TuneContainerConfigResponse) GetOldEffective() int64 {
+	if x != nil {
+		return x.OldEffective
+	}
+	return 0
+}
+
+func (x *TuneContainerConfigResponse) GetOldSource() string {
+	if x != nil {
+		return x.OldSource
+	}
+	return ""
+}
+
+func (x *TuneContainerConfigResponse) GetNewEffective() int64 {
+	if x != nil {
+		return x.NewEffective
+	}
+	return 0
+}
+
+func (x *TuneContainerConfigResponse) GetNewSource() string {
+	if x != nil {
+		return x.NewSource
+	}
+	return ""
+}
+
+func (x *TuneContainerConfigResponse) GetUnit() string {
+	if x != nil {
+		return x.Unit
+	}
+	return ""
+}
+
+func (x *TuneContainerConfigResponse) GetDefaultValue() int64 {
+	if x != nil {
+		return x.DefaultValue
+	}
+	return 0
+}
+
+func (x *TuneContainerConfigResponse) GetChanged() bool {
+	if x != nil {
+		return x.Changed
+	}
+	return false
+}
+
+// ShowTunableConfigRequest lists a container's tunable knobs (sp-vwek --show).
+type ShowTunableConfigRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ContainerId   string                 `protobuf:"bytes,1,opt,name=container_id,json=containerId,proto3" json:"container_id,omitempty"` // exactly one of container_id and operation
+	Operation     string                 `protobuf:"bytes,2,opt,name=operation,proto3" json:"operation,omitempty"`
+	PlayerId      *int32                 `protobuf:"varint,3,opt,name=player_id,json=playerId,proto3,oneof" json:"player_id,omitempty"`
+	AgentSymbol   *string                `protobuf:"bytes,4,opt,name=agent_symbol,json=agentSymbol,proto3,oneof" json:"agent_symbol,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ShowTunableConfigRequest) Reset() {
+	*x = ShowTunableConfigRequest{}
+	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[139]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ShowTunableConfigRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ShowTunableConfigRequest) ProtoMessage() {}
+
+func (x *ShowTunableConfigRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[139]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ShowTunableConfigRequest.ProtoReflect.Descriptor instead.
+func (*ShowTunableConfigRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_daemon_daemon_proto_rawDescGZIP(), []int{139}
+}
+
+func (x *ShowTunableConfigRequest) GetContainerId() string {
+	if x != nil {
+		return x.ContainerId
+	}
+	return ""
+}
+
+func (x *ShowTunableConfigRequest) GetOperation() string {
+	if x != nil {
+		return x.Operation
+	}
+	return ""
+}
+
+func (x *ShowTunableConfigRequest) GetPlayerId() int32 {
+	if x != nil && x.PlayerId != nil {
+		return *x.PlayerId
+	}
+	return 0
+}
+
+func (x *ShowTunableConfigRequest) GetAgentSymbol() string {
+	if x != nil && x.AgentSymbol != nil {
+		return *x.AgentSymbol
+	}
+	return ""
+}
+
+// TunableKnobStatus is one knob row of the --show listing.
+type TunableKnobStatus struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Key           string                 `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Effective     int64                  `protobuf:"varint,2,opt,name=effective,proto3" json:"effective,omitempty"`
+	Source        string                 `protobuf:"bytes,3,opt,name=source,proto3" json:"source,omitempty"` // "live-config" | "default"
+	Min           int64                  `protobuf:"varint,4,opt,name=min,proto3" json:"min,omitempty"`
+	Max           int64                  `protobuf:"varint,5,opt,name=max,proto3" json:"max,omitempty"`
+	Unit          string                 `protobuf:"bytes,6,opt,name=unit,proto3" json:"unit,omitempty"`
+	Description   string                 `protobuf:"bytes,7,opt,name=description,proto3" json:"description,omitempty"`
+	DefaultValue  int64                  `protobuf:"varint,8,opt,name=default_value,json=defaultValue,proto3" json:"default_value,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TunableKnobStatus) Reset() {
+	*x = TunableKnobStatus{}
+	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[140]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TunableKnobStatus) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TunableKnobStatus) ProtoMessage() {}
+
+func (x *TunableKnobStatus) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[140]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TunableKnobStatus.ProtoReflect.Descriptor instead.
+func (*TunableKnobStatus) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_daemon_daemon_proto_rawDescGZIP(), []int{140}
+}
+
+func (x *TunableKnobStatus) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *TunableKnobStatus) GetEffective() int64 {
+	if x != nil {
+		return x.Effective
+	}
+	return 0
+}
+
+func (x *TunableKnobStatus) GetSource() string {
+	if x != nil {
+		return x.Source
+	}
+	return ""
+}
+
+func (x *TunableKnobStatus) GetMin() int64 {
+	if x != nil {
+		return x.Min
+	}
+	return 0
+}
+
+func (x *TunableKnobStatus) GetMax() int64 {
+	if x != nil {
+		return x.Max
+	}
+	return 0
+}
+
+func (x *TunableKnobStatus) GetUnit() string {
+	if x != nil {
+		return x.Unit
+	}
+	return ""
+}
+
+func (x *TunableKnobStatus) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *TunableKnobStatus) GetDefaultValue() int64 {
+	if x != nil {
+		return x.DefaultValue
+	}
+	return 0
+}
+
+// ShowTunableConfigResponse is the full --show listing for one container.
+type ShowTunableConfigResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ContainerId   string                 `protobuf:"bytes,1,opt,name=container_id,json=containerId,proto3" json:"container_id,omitempty"`
+	ContainerType string                 `protobuf:"bytes,2,opt,name=container_type,json=containerType,proto3" json:"container_type,omitempty"`
+	Knobs         []*TunableKnobStatus   `protobuf:"bytes,3,rep,name=knobs,proto3" json:"knobs,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ShowTunableConfigResponse) Reset() {
+	*x = ShowTunableConfigResponse{}
+	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[141]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ShowTunableConfigResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ShowTunableConfigResponse) ProtoMessage() {}
+
+func (x *ShowTunableConfigResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[141]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ShowTunableConfigResponse.ProtoReflect.Descriptor instead.
+func (*ShowTunableConfigResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_daemon_daemon_proto_rawDescGZIP(), []int{141}
+}
+
+func (x *ShowTunableConfigResponse) GetContainerId() string {
+	if x != nil {
+		return x.ContainerId
+	}
+	return ""
+}
+
+func (x *ShowTunableConfigResponse) GetContainerType() string {
+	if x != nil {
+		return x.ContainerType
+	}
+	return ""
+}
+
+func (x *ShowTunableConfigResponse) GetKnobs() []*TunableKnobStatus {
+	if x != nil {
+		return x.Knobs
+	}
+	return nil
+}
+
+// GetFrontierStatusRequest resolves the running frontier coordinator for a player.
+type GetFrontierStatusRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PlayerId      *int32                 `protobuf:"varint,1,opt,name=player_id,json=playerId,proto3,oneof" json:"player_id,omitempty"`
+	AgentSymbol   *string                `protobuf:"bytes,2,opt,name=agent_symbol,json=agentSymbol,proto3,oneof" json:"agent_symbol,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetFrontierStatusRequest) Reset() {
+	*x = GetFrontierStatusRequest{}
+	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[142]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetFrontierStatusRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetFrontierStatusRequest) ProtoMessage() {}
+
+func (x *GetFrontierStatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[142]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetFrontierStatusRequest.ProtoReflect.Descriptor instead.
+func (*GetFrontierStatusRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_daemon_daemon_proto_rawDescGZIP(), []int{142}
+}
+
+func (x *GetFrontierStatusRequest) GetPlayerId() int32 {
+	if x != nil && x.PlayerId != nil {
+		return *x.PlayerId
+	}
+	return 0
+}
+
+func (x *GetFrontierStatusRequest) GetAgentSymbol() string {
+	if x != nil && x.AgentSymbol != nil {
+		return *x.AgentSymbol
+	}
+	return ""
+}
+
+// GetFrontierStatusResponse is the frontier coordinator's one-view live state (sp-pvw3).
+type GetFrontierStatusResponse struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	ContainerId       string                 `protobuf:"bytes,1,opt,name=container_id,json=containerId,proto3" json:"container_id,omitempty"`
+	DiscoveryShare    int32                  `protobuf:"varint,2,opt,name=discovery_share,json=discoveryShare,proto3" json:"discovery_share,omitempty"`               // effective 0-100 percent charting virgin
+	ScanShare         int32                  `protobuf:"varint,3,opt,name=scan_share,json=scanShare,proto3" json:"scan_share,omitempty"`                              // 100 - discovery_share
+	SplitSummary      string                 `protobuf:"bytes,4,opt,name=split_summary,json=splitSummary,proto3" json:"split_summary,omitempty"`                      // e.g. "60% discover / 40% scan" or "100% discover — backlog empty"
+	VirginQueueDepth  int32                  `protobuf:"varint,5,opt,name=virgin_queue_depth,json=virginQueueDepth,proto3" json:"virgin_queue_depth,omitempty"`       // reachable uncovered virgin frontier systems
+	DarkSystems       int32                  `protobuf:"varint,6,opt,name=dark_systems,json=darkSystems,proto3" json:"dark_systems,omitempty"`                        // charted markets with no/stale price data (honest backlog)
+	DarkMarketplaces  int32                  `protobuf:"varint,7,opt,name=dark_marketplaces,json=darkMarketplaces,proto3" json:"dark_marketplaces,omitempty"`         // total unscanned marketplace count across those systems
+	ProbeFleet        int32                  `protobuf:"varint,8,opt,name=probe_fleet,json=probeFleet,proto3" json:"probe_fleet,omitempty"`                           // total satellites owned
+	ProbeCap          int32                  `protobuf:"varint,9,opt,name=probe_cap,json=probeCap,proto3" json:"probe_cap,omitempty"`                                 // max satellite cap
+	ProbesIdle        int32                  `protobuf:"varint,10,opt,name=probes_idle,json=probesIdle,proto3" json:"probes_idle,omitempty"`                          // idle relayable probes
+	PostsInFlight     int32                  `protobuf:"varint,11,opt,name=posts_in_flight,json=postsInFlight,proto3" json:"posts_in_flight,omitempty"`               // outstanding sweep-once posts
+	LastBuyPrice      int32                  `protobuf:"varint,12,opt,name=last_buy_price,json=lastBuyPrice,proto3" json:"last_buy_price,omitempty"`                  // price of the most recent probe buy (0 if none)
+	LastBuyAgeSeconds int32                  `protobuf:"varint,13,opt,name=last_buy_age_seconds,json=lastBuyAgeSeconds,proto3" json:"last_buy_age_seconds,omitempty"` // seconds since the most recent probe buy (-1 if none)
+	Blockers          []string               `protobuf:"bytes,14,rep,name=blockers,proto3" json:"blockers,omitempty"`                                                 // read-only reasons the buy path is failing closed
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *GetFrontierStatusResponse) Reset() {
+	*x = GetFrontierStatusResponse{}
+	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[143]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetFrontierStatusResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetFrontierStatusResponse) ProtoMessage() {}
+
+func (x *GetFrontierStatusResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[143]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetFrontierStatusResponse.ProtoReflect.Descriptor instead.
+func (*GetFrontierStatusResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_daemon_daemon_proto_rawDescGZIP(), []int{143}
+}
+
+func (x *GetFrontierStatusResponse) GetContainerId() string {
+	if x != nil {
+		return x.ContainerId
+	}
+	return ""
+}
+
+func (x *GetFrontierStatusResponse) GetDiscoveryShare() int32 {
+	if x != nil {
+		return x.DiscoveryShare
+	}
+	return 0
+}
+
+func (x *GetFrontierStatusResponse) GetScanShare() int32 {
+	if x != nil {
+		return x.ScanShare
+	}
+	return 0
+}
+
+func (x *GetFrontierStatusResponse) GetSplitSummary() string {
+	if x != nil {
+		return x.SplitSummary
+	}
+	return ""
+}
+
+func (x *GetFrontierStatusResponse) GetVirginQueueDepth() int32 {
+	if x != nil {
+		return x.VirginQueueDepth
+	}
+	return 0
+}
+
+func (x *GetFrontierStatusResponse) GetDarkSystems() int32 {
+	if x != nil {
+		return x.DarkSystems
+	}
+	return 0
+}
+
+func (x *GetFrontierStatusResponse) GetDarkMarketplaces() int32 {
+	if x != nil {
+		return x.DarkMarketplaces
+	}
+	return 0
+}
+
+func (x *GetFrontierStatusResponse) GetProbeFleet() int32 {
+	if x != nil {
+		return x.ProbeFleet
+	}
+	return 0
+}
+
+func (x *GetFrontierStatusResponse) GetProbeCap() int32 {
+	if x != nil {
+		return x.ProbeCap
+	}
+	return 0
+}
+
+func (x *GetFrontierStatusResponse) GetProbesIdle() int32 {
+	if x != nil {
+		return x.ProbesIdle
+	}
+	return 0
+}
+
+func (x *GetFrontierStatusResponse) GetPostsInFlight() int32 {
+	if x != nil {
+		return x.PostsInFlight
+	}
+	return 0
+}
+
+func (x *GetFrontierStatusResponse) GetLastBuyPrice() int32 {
+	if x != nil {
+		return x.LastBuyPrice
+	}
+	return 0
+}
+
+func (x *GetFrontierStatusResponse) GetLastBuyAgeSeconds() int32 {
+	if x != nil {
+		return x.LastBuyAgeSeconds
+	}
+	return 0
+}
+
+func (x *GetFrontierStatusResponse) GetBlockers() []string {
+	if x != nil {
+		return x.Blockers
+	}
+	return nil
+}
+
+// GetFactoryStatusRequest retrieves factory status
+type GetFactoryStatusRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PlayerId      int32                  `protobuf:"varint,1,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"`
+	FactoryId     string                 `protobuf:"bytes,2,opt,name=factory_id,json=factoryId,proto3" json:"factory_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetFactoryStatusRequest) Reset() {
+	*x = GetFactoryStatusRequest{}
+	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[144]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetFactoryStatusRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetFactoryStatusRequest) ProtoMessage() {}
+
+func (x *GetFactoryStatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[144]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetFactoryStatusRequest.ProtoReflect.Descriptor instead.
+func (*GetFactoryStatusRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_daemon_daemon_proto_rawDescGZIP(), []int{144}
+}
+
+func (x *GetFactoryStatusRequest) GetPlayerId() int32 {
+	if x != nil {
+		return x.PlayerId
+	}
+	return 0
+}
+
+func (x *GetFactoryStatusRequest) GetFactoryId() string {
+	if x != nil {
+		return x.FactoryId
+	}
+	return ""
+}
+
+// GetFactoryStatusResponse returns detailed factory status
+type GetFactoryStatusResponse struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	FactoryId        string                 `protobuf:"bytes,1,opt,name=factory_id,json=factoryId,proto3" json:"factory_id,omitempty"`
+	TargetGood       string                 `protobuf:"bytes,2,opt,name=target_good,json=targetGood,proto3" json:"target_good,omitempty"`
+	Status           string                 `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`
+	DependencyTree   string                 `protobuf:"bytes,4,opt,name=dependency_tree,json=dependencyTree,proto3" json:"dependency_tree,omitempty"`        // JSON-encoded dependency tree
+	QuantityAcquired int32                  `protobuf:"varint,5,opt,name=quantity_acquired,json=quantityAcquired,proto3" json:"quantity_acquired,omitempty"` // 0 if not yet complete
+	TotalCost        int32                  `protobuf:"varint,6,opt,name=total_cost,json=totalCost,proto3" json:"total_cost,omitempty"`
+	NodesCompleted   int32                  `protobuf:"varint,7,opt,name=nodes_completed,json=nodesCompleted,proto3" json:"nodes_completed,omitempty"`
+	NodesTotal       int32                  `protobuf:"varint,8,opt,name=nodes_total,json=nodesTotal,proto3" json:"nodes_total,omitempty"`
+	SystemSymbol     string                 `protobuf:"bytes,9,opt,name=system_symbol,json=systemSymbol,proto3" json:"system_symbol,omitempty"`
+	ShipsUsed        int32                  `protobuf:"varint,10,opt,name=ships_used,json=shipsUsed,proto3" json:"ships_used,omitempty"`                       // Number of ships utilized
+	MarketQueries    int32                  `protobuf:"varint,11,opt,name=market_queries,json=marketQueries,proto3" json:"market_queries,omitempty"`           // Number of market queries performed
+	ParallelLevels   int32                  `protobuf:"varint,12,opt,name=parallel_levels,json=parallelLevels,proto3" json:"parallel_levels,omitempty"`        // Number of parallel execution levels
+	EstimatedSpeedup float32                `protobuf:"fixed32,13,opt,name=estimated_speedup,json=estimatedSpeedup,proto3" json:"estimated_speedup,omitempty"` // Estimated speedup from parallelization
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *GetFactoryStatusResponse) Reset() {
+	*x = GetFactoryStatusResponse{}
+	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[145]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetFactoryStatusResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetFactoryStatusResponse) ProtoMessage() {}
+
+func (x *GetFactoryStatusResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[145]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetFactoryStatusResponse.ProtoReflect.Descriptor instead.
+func (*GetFactoryStatusResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_daemon_daemon_proto_rawDescGZIP(), []int{145}
+}
+
+func (x *GetFactoryStatusResponse) GetFactoryId() string {
+	if x != nil {
+		return x.FactoryId
+	}
+	return ""
+}
+
+func (x *GetFactoryStatusResponse) GetTargetGood() string {
+	if x != nil {
+		return x.TargetGood
+	}
+	return ""
+}
+
+func (x *GetFactoryStatusResponse) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *GetFactoryStatusResponse) GetDependencyTree() string {
+	if x != nil {
+		return x.DependencyTree
+	}
+	return ""
+}
+
+func (x *GetFactoryStatusResponse) GetQuantityAcquired() int32 {
+	if x != nil {
+		return x.QuantityAcquired
+	}
+	return 0
+}
+
+func (x *GetFactoryStatusResponse) GetTotalCost() int32 {
+	if x != nil {
+		return x.TotalCost
+	}
+	return 0
+}
+
+func (x *GetFactoryStatusResponse) GetNodesCompleted() int32 {
+	if x != nil {
+		return x.NodesCompleted
+	}
+	return 0
+}
+
+func (x *GetFactoryStatusResponse) GetNodesTotal() int32 {
+	if x != nil {
+		return x.NodesTotal
+	}
+	return 0
+}
+
+func (x *GetFactoryStatusResponse) GetSystemSymbol() string {
+	if x != nil {
+		return x.SystemSymbol
+	}
+	return ""
+}
+
+func (x *GetFactoryStatusResponse) GetShipsUsed() int32 {
+	if x != nil {
+		return x.ShipsUsed
+	}
+	return 0
+}
+
+func (x *GetFactoryStatusResponse) GetMarketQueries() int32 {
+	if x != nil {
+		return x.MarketQueries
+	}
+	return 0
+}
+
+func (x *GetFactoryStatusResponse) GetParallelLevels() int32 {
+	if x != nil {
+		return x.ParallelLevels
+	}
+	return 0
+}
+
+func (x *GetFactoryStatusResponse) GetEstimatedSpeedup() float32 {
+	if x != nil {
+		return x.EstimatedSpeedup
+	}
+	return 0
+}
+
+// ScanArbitrageOpportunitiesRequest scans for arbitrage opportunities
+type ScanArbitrageOpportunitiesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PlayerId      int32                  `protobuf:"varint,1,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"`
+	SystemSymbol  string                 `protobuf:"bytes,2,opt,name=system_symbol,json=systemSymbol,proto3" json:"system_symbol,omitempty"` // System to scan (e.g., "X1-AU21")
+	MinMargin     float64                `protobuf:"fixed64,3,opt,name=min_margin,json=minMargin,proto3" json:"min_margin,omitempty"`        // Minimum profit margin percentage (default 10.0)
+	Limit         int32                  `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"`                                  // Maximum opportunities to return (default 20)
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ScanArbitrageOpportunitiesRequest) Reset() {
+	*x = ScanArbitrageOpportunitiesRequest{}
+	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[146]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ScanArbitrageOpportunitiesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScanArbitrageOpportunitiesRequest) ProtoMessage() {}
+
+func (x *ScanArbitrageOpportunitiesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[146]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScanArbitrageOpportunitiesRequest.ProtoReflect.Descriptor instead.
+func (*ScanArbitrageOpportunitiesRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_daemon_daemon_proto_rawDescGZIP(), []int{146}
+}
+
+func (x *ScanArbitrageOpportunitiesRequest) GetPlayerId() int32 {
+	if x != nil {
+		return x.PlayerId
+	}
+	return 0
+}
+
+func (x *ScanArbitrageOpportunitiesRequest) GetSystemSymbol() string {
+	if x != nil {
+		return x.SystemSymbol
+	}
+	return ""
+}
+
+func (x *ScanArbitrageOpportunitiesRequest) GetMinMargin() float64 {
+	if x != nil {
+		return x.MinMargin
+	}
+	return 0
+}
+
+func (x *ScanArbitrageOpportunitiesRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+// ArbitrageOpportunity represents a single arbitrage opportunity
+type ArbitrageOpportunity struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Good            string                 `protobuf:"bytes,1,opt,name=good,proto3" json:"good,omitempty"`
+	BuyMarket       string                 `protobuf:"bytes,2,opt,name=buy_market,json=buyMarket,proto3" json:"buy_market,omitempty"`
+	SellMarket      string                 `protobuf:"bytes,3,opt,name=sell_market,json=sellMarket,proto3" json:"sell_market,omitempty"`
+	BuyPrice        int32                  `protobuf:"varint,4,opt,name=buy_price,json=buyPrice,proto3" json:"buy_price,omitempty"`
+	SellPrice       int32                  `protobuf:"varint,5,opt,name=sell_price,json=sellPrice,proto3" json:"sell_price,omitempty"`
+	ProfitPerUnit   int32                  `protobuf:"varint,6,opt,name=profit_per_unit,json=profitPerUnit,proto3" json:"profit_per_unit,omitempty"`
+	ProfitMargin    float64                `protobuf:"fixed64,7,opt,name=profit_margin,json=profitMargin,proto3" json:"profit_margin,omitempty"`
+	EstimatedProfit int32                  `protobuf:"varint,8,opt,name=estimated_profit,json=estimatedProfit,proto3" json:"estimated_profit,omitempty"`
+	Distance        float64                `protobuf:"fixed64,9,opt,name=distance,proto3" json:"distance,omitempty"`
+	BuySupply       string                 `protobuf:"bytes,10,opt,name=buy_supply,json=buySupply,proto3" json:"buy_supply,omitempty"`
+	SellActivity    string                 `protobuf:"bytes,11,opt,name=sell_activity,json=sellActivity,proto3" json:"sell_activity,omitempty"`
+	Score           float64                `protobuf:"fixed64,12,opt,name=score,proto3" json:"score,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *ArbitrageOpportunity) Reset() {
+	*x = ArbitrageOpportunity{}
+	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[147]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ArbitrageOpportunity) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ArbitrageOpportunity) ProtoMessage() {}
+
+func (x *ArbitrageOpportunity) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[147]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ArbitrageOpportunity.ProtoReflect.Descriptor instead.
+func (*ArbitrageOpportunity) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_daemon_daemon_proto_rawDescGZIP(), []int{147}
+}
+
+func (x *ArbitrageOpportunity) GetGood() string {
+	if x != nil {
+		return x.Good
+	}
+	return ""
+}
+
+func (x *ArbitrageOpportunity) GetBuyMarket() string {
+	if x != nil {
+		return x.BuyMarket
+	}
+	return ""
+}
+
+func (x *ArbitrageOpportunity) GetSellMarket() string {
+	if x != nil {
+		return x.SellMarket
+	}
+	return ""
+}
+
+func (x *ArbitrageOpportunity) GetBuyPrice() int32 {
+	if x != nil {
+		return x.BuyPrice
+	}
+	return 0
+}
+
+func (x *ArbitrageOpportunity) GetSellPrice() int32 {
+	if x != nil {
+		return x.SellPrice
+	}
+	return 0
+}
+
+func (x *ArbitrageOpportunity) GetProfitPerUnit() int32 {
+	if x != nil {
+		return x.ProfitPerUnit
+	}
+	return 0
+}
+
+func (x *ArbitrageOpportunity) GetProfitMargin() float64 {
+	if x != nil {
+		return x.ProfitMargin
+	}
+	return 0
+}
+
+func (x *ArbitrageOpportunity) GetEstimatedProfit() int32 {
+	if x != nil {
+		return x.EstimatedProfit
+	}
+	return 0
+}
+
+func (x *ArbitrageOpportunity) GetDistance() float64 {
+	if x != nil {
+		return x.Distance
+	}
+	return 0
+}
+
+func (x *ArbitrageOpportunity) GetBuySupply() string {
+	if x != nil {
+		return x.BuySupply
+	}
+	return ""
+}
+
+func (x *ArbitrageOpportunity) GetSellActivity() string {
+	if x != nil {
+		return x.SellActivity
+	}
+	return ""
+}
+
+func (x *ArbitrageOpportunity) GetScore() float64 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+// ScanArbitrageOpportunitiesResponse returns scan results
+type ScanArbitrageOpportunitiesResponse struct {
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	Opportunities []*ArbitrageOpportunity `protobuf:"bytes,1,rep,name=opportunities,proto3" json:"opportunities,omitempty"`
+	TotalScanned  int32                   `protobuf:"varint,2,opt,name=total_scanned,json=totalScanned,proto3" json:"total_scanned,omitempty"`
+	SystemSymbol  string                  `protobuf:"bytes,3,opt,name=system_symbol,json=systemSymbol,proto3" json:"system_symbol,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ScanArbitrageOpportunitiesResponse) Reset() {
+	*x = ScanArbitrageOpportunitiesResponse{}
+	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[148]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ScanArbitrageOpportunitiesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScanArbitrageOpportunitiesResponse) ProtoMessage() {}
+
+func (x *ScanArbitrageOpportunitiesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[148]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScanArbitrageOpportunitiesResponse.ProtoReflect.Descriptor instead.
+func (*ScanArbitrageOpportunitiesResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_daemon_daemon_proto_rawDescGZIP(), []int{148}
+}
+
+func (x *ScanArbitrageOpportunitiesResponse) GetOpportunities() []*ArbitrageOpportunity {
+	if x != nil {
+		return x.Opportunities
+	}
+	return nil
+}
+
+func (x *ScanArbitrageOpportunitiesResponse) GetTotalScanned() int32 {
+	if x != nil {
+		return x.TotalScanned
+	}
+	return 0
+}
+
+func (x *ScanArbitrageOpportunitiesResponse) GetSystemSymbol() string {
+	if x != nil {
+		return x.SystemSymbol
+	}
+	return ""
+}
+
+// StartArbitrageCoordinatorRequest starts arbitrage coordinator
+type StartArbitrageCoordinatorRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PlayerId      int32                  `protobuf:"varint,1,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"`
+	SystemSymbol  string                 `protobuf:"bytes,2,opt,name=system_symbol,json=systemSymbol,proto3" json:"system_symbol,omitempty"` // System to trade in (e.g., "X1-AU21")
+	MinMargin     float64                `protobuf:"fixed64,3,opt,name=min_margin,json=minMargin,proto3" json:"min_margin,omitempty"`        // Minimum profit margin percentage (default 10.0)
+	MaxWorkers    int32                  `protobuf:"varint,4,opt,name=max_workers,json=maxWorkers,proto3" json:"max_workers,omitempty"`      // Maximum parallel workers (default 10)
+	MinBalance    int32                  `protobuf:"varint,5,opt,name=min_balance,json=minBalance,proto3" json:"min_balance,omitempty"`      // Minimum credit balance to maintain (default 0 = no limit)
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StartArbitrageCoordinatorRequest) Reset() {
+	*x = StartArbitrageCoordinatorRequest{}
+	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[149]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StartArbitrageCoordinatorRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StartArbitrageCoordinatorRequest) ProtoMessage() {}
+
+func (x *StartArbitrageCoordinatorRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[149]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StartArbitrageCoordinatorRequest.ProtoReflect.Descriptor instead.
+func (*StartArbitrageCoordinatorRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_daemon_daemon_proto_rawDescGZIP(), []int{149}
+}
+
+func (x *StartArbitrageCoordinatorRequest) GetPlayerId() int32 {
+	if x != nil {
+		return x.PlayerId
+	}
+	return 0
+}
+
+func (x *StartArbitrageCoordinatorRequest) GetSystemSymbol() string {
+	if x != nil {
+		return x.SystemSymbol
+	}
+	return ""
+}
+
+func (x *StartArbitrageCoordinatorRequest) GetMinMargin() float64 {
+	if x != nil {
+		return x.MinMargin
+	}
+	return 0
+}
+
+func (x *StartArbitrageCoordinatorRequest) GetMaxWorkers() int32 {
+	if x != nil {
+		return x.MaxWorkers
+	}
+	return 0
+}
+
+func (x *StartArbitrageCoordinatorRequest) GetMinBalance() int32 {
+	if x != nil {
+		return x.MinBalance
+	}
+	return 0
+}
+
+// StartArbitrageCoordinatorResponse returns coordinator details
+type StartArbitrageCoordinatorResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ContainerId   string                 `protobuf:"bytes,1,opt,name=container_id,json=containerId,proto3" json:"container_id,omitempty"`
+	SystemSymbol  string                 `protobuf:"bytes,2,opt,name=system_symbol,json=systemSymbol,proto3" json:"system_symbol,omitempty"`
+	MinMargin     float64                `protobuf:"fixed64,3,opt,name=min_margin,json=minMargin,proto3" json:"min_margin,omitempty"`
+	MaxWorkers    int32                  `protobuf:"varint,4,opt,name=max_workers,json=maxWorkers,proto3" json:"max_workers,omitempty"`
+	MinBalance    int32                  `protobuf:"varint,5,opt,name=min_balance,json=minBalance,proto3" json:"min_balance,omitempty"`
+	Status        string                 `protobuf:"bytes,6,opt,name=status,proto3" json:"status,omitempty"`
+	Message       string                 `protobuf:"bytes,7,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StartArbitrageCoordinatorResponse) Reset() {
+	*x = StartArbitrageCoordinatorResponse{}
+	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[150]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StartArbitrageCoordinatorResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StartArbitrageCoordinatorResponse) ProtoMessage() {}
+
+func (x *StartArbitrageCoordinatorResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[150]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StartArbitrageCoordinatorResponse.ProtoReflect.Descriptor instead.
+func (*StartArbitrageCoordinatorResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_daemon_daemon_proto_rawDescGZIP(), []int{150}
+}
+
+func (x *StartArbitrageCoordinatorResponse) GetContainerId() string {
+	if x != nil {
+		return x.ContainerId
+	}
+	return ""
+}
+
+func (x *StartArbitrageCoordinatorResponse) GetSystemSymbol() string {
+	if x != nil {
+		return x.SystemSymbol
+	}
+	return ""
+}
+
+func (x *StartArbitrageCoordinatorResponse) GetMinMargin() float64 {
+	if x != nil {
+		return x.MinMargin
+	}
+	return 0
+}
+
+func (x *StartArbitrageCoordinatorResponse) GetMaxWorkers() int32 {
+	if x != nil {
+		return x.MaxWorkers
+	}
+	return 0
+}
+
+func (x *StartArbitrageCoordinatorResponse) GetMinBalance() int32 {
+	if x != nil {
+		return x.MinBalance
+	}
+	return 0
+}
+
+func (x *StartArbitrageCoordinatorResponse) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *StartArbitrageCoordinatorResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// JettisonCargoRequest jettisons cargo from a ship
+type JettisonCargoRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ShipSymbol    string                 `protobuf:"bytes,1,opt,name=ship_symbol,json=shipSymbol,proto3" json:"ship_symbol,omitempty"`
+	PlayerId      int32                  `protobuf:"varint,2,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"`
+	AgentSymbol   *string                `protobuf:"bytes,3,opt,name=agent_symbol,json=agentSymbol,proto3,oneof" json:"agent_symbol,omitempty"`
+	GoodSymbol    string                 `protobuf:"bytes,4,opt,name=good_symbol,json=goodSymbol,proto3" json:"good_symbol,omitempty"`
+	Units         int32                  `protobuf:"varint,5,opt,name=units,proto3" json:"units,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *JettisonCargoRequest) Reset() {
+	*x = JettisonCargoRequest{}
+	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[151]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *JettisonCargoRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JettisonCargoRequest) ProtoMessage() {}
+
+func (x *JettisonCargoRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[151]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JettisonCargoRequest.ProtoReflect.Descriptor instead.
+func (*JettisonCargoRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_daemon_daemon_proto_rawDescGZIP(), []int{151}
+}
+
+func (x *JettisonCargoRequest) GetShipSymbol() string {
+	if x != nil {
+		return x.ShipSymbol
+	}
+	return ""
+}
+
+func (x *JettisonCargoRequest) GetPlayerId() int32 {
+	if x != nil {
+		return x.PlayerId
+	}
+	return 0
+}
+
+func (x *JettisonCargoRequest) GetAgentSymbol() string {
+	if x != nil && x.AgentSymbol != nil {
+		return *x.AgentSymbol
+	}
+	return ""
+}
+
+func (x *JettisonCargoRequest) GetGoodSymbol() string {
+	if x != nil {
+		return x.GoodSymbol
+	}
+	return ""
+}
+
+func (x *JettisonCargoRequest) GetUnits() int32 {
+	if x != nil {
+		return x.Units
+	}
+	return 0
+}
+
+// JettisonCargoResponse returns result of jettison operation
+type JettisonCargoResponse struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	ContainerId     string                 `protobuf:"bytes,1,opt,name=container_id,json=containerId,proto3" json:"container_id,omitempty"`
+	ShipSymbol      string                 `protobuf:"bytes,2,opt,name=ship_symbol,json=shipSymbol,proto3" json:"ship_symbol,omitempty"`
+	GoodSymbol      string                 `protobuf:"bytes,3,opt,name=good_symbol,json=goodSymbol,proto3" json:"good_symbol,omitempty"`
+	UnitsJettisoned int32                  `protobuf:"varint,4,opt,name=units_jettisoned,json=unitsJettisoned,proto3" json:"units_jettisoned,omitempty"`
+	Status          string                 `protobuf:"bytes,5,opt,name=status,proto3" json:"status,omitempty"`
+	Message         string                 `protobuf:"bytes,6,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *JettisonCargoResponse) Reset() {
+	*x = JettisonCargoResponse{}
+	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[152]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *JettisonCargoResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JettisonCargoResponse) ProtoMessage() {}
+
+func (x *JettisonCargoResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[152]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JettisonCargoResponse.ProtoReflect.Descriptor instead.
+func (*JettisonCargoResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_daemon_daemon_proto_rawDescGZIP(), []int{152}
+}
+
+func (x *JettisonCargoResponse) GetContainerId() string {
+	if x != nil {
+		return x.ContainerId
+	}
+	return ""
+}
+
+func (x *JettisonCargoResponse) GetShipSymbol() string {
+	if x != nil {
+		return x.ShipSymbol
+	}
+	return ""
+}
+
+func (x *JettisonCargoResponse) GetGoodSymbol() string {
+	if x != nil {
+		return x.GoodSymbol
+	}
+	return ""
+}
+
+func (x *JettisonCargoResponse) GetUnitsJettisoned() int32 {
+	if x != nil {
+		return x.UnitsJettisoned
+	}
+	return 0
+}
+
+func (x *JettisonCargoResponse) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *JettisonCargoResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// StartTradeRouteRequest launches a single-hull pure-arbitrage circuit on an idle hull
+type StartTradeRouteRequest struct {
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	PlayerId     int32                  `protobuf:"varint,1,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"`
+	ShipSymbol   string                 `protobuf:"bytes,2,opt,name=ship_symbol,json=shipSymbol,proto3" json:"ship_symbol,omitempty"`       // Idle hull to fly the circuit
+	SystemSymbol string                 `protobuf:"bytes,3,opt,name=system_symbol,json=systemSymbol,proto3" json:"system_symbol,omitempty"` // System to scan for arbitrage lanes
+	AgentSymbol  *string                `protobuf:"bytes,4,opt,name=agent_symbol,json=agentSymbol,proto3,oneof" json:"agent_symbol,omitempty"`
+	MaxVisits    *int32                 `protobuf:"varint,5,opt,name=max_visits,json=maxVisits,proto3,oneof" json:"max_visits,omitempty"` // Safety bound on circuit visits (0 = default 50)
+	// Optional lane-targeting override (sp-xwa1): a destination waypoint or
+	// system symbol to pin the circuit to, instead of letting the ranker
+	// auto-select a lane. Also waives the cross-system gate-crossing ranking
+	// penalty for the targeted lane alone. Unset preserves the original
+	// undirected auto-scan behavior unchanged.
+	DestWaypoint  *string `protobuf:"bytes,6,opt,name=dest_waypoint,json=destWaypoint,proto3,oneof" json:"dest_waypoint,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StartTradeRouteRequest) Reset() {
+	*x = StartTradeRouteRequest{}
+	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[153]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StartTradeRouteRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StartTradeRouteRequest) ProtoMessage() {}
+
+func (x *StartTradeRouteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[153]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StartTradeRouteRequest.ProtoReflect.Descriptor instead.
+func (*StartTradeRouteRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_daemon_daemon_proto_rawDescGZIP(), []int{153}
+}
+
+func (x *StartTradeRouteRequest) GetPlayerId() int32 {
+	if x != nil {
+		return x.PlayerId
+	}
+	return 0
+}
+
+func (x *StartTradeRouteRequest) GetShipSymbol() string {
+	if x != nil {
+		return x.ShipSymbol
+	}
+	return ""
+}
+
+func (x *StartTradeRouteRequest) GetSystemSymbol() string {
+	if x != nil {
+		return x.SystemSymbol
+	}
+	return ""
+}
+
+func (x *StartTradeRouteRequest) GetAgentSymbol() string {
+	if x != nil && x.AgentSymbol != nil {
+		return *x.AgentSymbol
+	}
+	return ""
+}
+
+func (x *StartTradeRouteRequest) GetMaxVisits() int32 {
+	if x != nil && x.MaxVisits != nil {
+		return *x.MaxVisits
+	}
+	return 0
+}
+
+func (x *StartTradeRouteRequest) GetDestWaypoint() string {
+	if x != nil && x.DestWaypoint != nil {
+		return *x.DestWaypoint
+	}
+	return ""
+}
+
+// StartTradeRouteResponse returns the started trade-route container
+type StartTradeRouteResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ContainerId   string                 `protobuf:"bytes,1,opt,name=container_id,json=containerId,proto3" json:"container_id,omitempty"`
+	ShipSymbol    string                 `protobuf:"bytes,2,opt,name=ship_symbol,json=shipSymbol,proto3" json:"ship_symbol,omitempty"`
+	SystemSymbol  string                 `protobuf:"bytes,3,opt,name=system_symbol,json=systemSymbol,proto3" json:"system_symbol,omitempty"`
+	Status        string                 `protobuf:"bytes,4,opt,name=status,proto3" json:"status,omitempty"`
+	Message       string                 `protobuf:"bytes,5,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StartTradeRouteResponse) Reset() {
+	*x = StartTradeRouteResponse{}
+	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[154]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StartTradeRouteResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StartTradeRouteResponse) ProtoMessage() {}
+
+func (x *StartTradeRouteResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[154]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StartTradeRouteResponse.ProtoReflect.Descriptor instead.
+func (*StartTradeRouteResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_daemon_daemon_proto_rawDescGZIP(), []int{154}
+}
+
+func (x *StartTradeRouteResponse) GetContainerId() string {
+	if x != nil {
+		return x.ContainerId
+	}
+	return ""
+}
+
+func (x *StartTradeRouteResponse) GetShipSymbol() string {
+	if x != nil {
+		return x.ShipSymbol
+	}
+	return ""
+}
+
+func (x *StartTradeRouteResponse) GetSystemSymbol() string {
+	if x != nil {
+		return x.SystemSymbol
+	}
+	return ""
+}
+
+func (x *StartTradeRouteResponse) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *StartTradeRouteResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// StartWarehouseRequest starts a passive inventory warehouse (sp-dchv Lane B) on an
+// idle, dedicated storage hull parked at a home waypoint. The hull buffers the
+// whitelisted goods deposited by tour/trade legs; contract workers withdraw from it.
+type StartWarehouseRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	PlayerId       int32                  `protobuf:"varint,1,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"`
+	ShipSymbol     string                 `protobuf:"bytes,2,opt,name=ship_symbol,json=shipSymbol,proto3" json:"ship_symbol,omitempty"`             // Idle dedicated storage hull
+	WaypointSymbol string                 `protobuf:"bytes,3,opt,name=waypoint_symbol,json=waypointSymbol,proto3" json:"waypoint_symbol,omitempty"` // Home waypoint to park the hull at
+	SupportedGoods []string               `protobuf:"bytes,4,rep,name=supported_goods,json=supportedGoods,proto3" json:"supported_goods,omitempty"` // Goods whitelist this warehouse buffers
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *StartWarehouseRequest) Reset() {
+	*x = StartWarehouseRequest{}
+	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[155]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StartWarehouseRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StartWarehouseRequest) ProtoMessage() {}
+
+func (x *StartWarehouseRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[155]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StartWarehouseRequest.ProtoReflect.Descriptor instead.
+func (*StartWarehouseRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_daemon_daemon_proto_rawDescGZIP(), []int{155}
+}
+
+func (x *StartWarehouseRequest) GetPlayerId() int32 {
+	if x != nil {
+		return x.PlayerId
+	}
+	return 0
+}
+
+func (x *StartWarehouseRequest) GetShipSymbol() string {
+	if x != nil {
+		return x.ShipSymbol
+	}
+	return ""
+}
+
+func (x *StartWarehouseRequest) GetWaypointSymbol() string {
+	if x != nil {
+		return x.WaypointSymbol
+	}
+	return ""
+}
+
+func (x *StartWarehouseRequest) GetSupportedGoods() []string {
+	if x != nil {
+		return x.SupportedGoods
+	}
+	return nil
+}
+
+// StartWarehouseResponse returns the started warehouse container
+type StartWarehouseResponse struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	ContainerId    string                 `protobuf:"bytes,1,opt,name=container_id,json=containerId,proto3" json:"container_id,omitempty"`
+	ShipSymbol     string                 `protobuf:"bytes,2,opt,name=ship_symbol,json=shipSymbol,proto3" json:"ship_symbol,omitempty"`
+	WaypointSymbol string                 `protobuf:"bytes,3,opt,name=waypoint_symbol,json=waypointSymbol,proto3" json:"waypoint_symbol,omitempty"`
+	Status         string                 `protobuf:"bytes,4,opt,name=status,proto3" json:"status,omitempty"`
+	Message        string                 `protobuf:"bytes,5,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *StartWarehouseResponse) Reset() {
+	*x = StartWarehouseResponse{}
+	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[156]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StartWarehouseResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StartWarehouseResponse) ProtoMessage() {}
+
+func (x *StartWarehouseResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[156]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StartWarehouseResponse.ProtoReflect.Descriptor instead.
+func (*StartWarehouseResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_daemon_daemon_proto_rawDescGZIP(), []int{156}
+}
+
+func (x *StartWarehouseResponse) GetContainerId() string {
+	if x != nil {
+		return x.ContainerId
+	}
+	return ""
+}
+
+func (x *StartWarehouseResponse) GetShipSymbol() string {
+	if x != nil {
+		return x.ShipSymbol
+	}
+	return ""
+}
+
+func (x *StartWarehouseResponse) GetWaypointSymbol() string {
+	if x != nil {
+		return x.WaypointSymbol
+	}
+	return ""
+}
+
+func (x *StartWarehouseResponse) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *StartWarehouseResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// StartArbRunRequest starts a one-shot, captain-directed, guarded arbitrage run (sp-p4ua):
+// fly THIS hull on THIS lane, ONCE, safely. All caps/floors are optional guards.
+type StartArbRunRequest struct {
+	state                 protoimpl.MessageState `protogen:"open.v1"`
+	PlayerId              int32                  `protobuf:"varint,1,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"`
+	ShipSymbol            string                 `protobuf:"bytes,2,opt,name=ship_symbol,json=shipSymbol,proto3" json:"ship_symbol,omitempty"` // Idle hull that must already be at buy_at
+	Good                  string                 `protobuf:"bytes,3,opt,name=good,proto3" json:"good,omitempty"`                               // The good to buy at the source and sell at the destination
+	BuyAt                 string                 `protobuf:"bytes,4,opt,name=buy_at,json=buyAt,proto3" json:"buy_at,omitempty"`                // Source waypoint (the hull must already be here)
+	SellAt                string                 `protobuf:"bytes,5,opt,name=sell_at,json=sellAt,proto3" json:"sell_at,omitempty"`             // Destination waypoint (may be in another system → gate+jump+refuel)
+	AgentSymbol           *string                `protobuf:"bytes,6,opt,name=agent_symbol,json=agentSymbol,proto3,oneof" json:"agent_symbol,omitempty"`
+	MaxUnits              *int32                 `protobuf:"varint,7,opt,name=max_units,json=maxUnits,proto3,oneof" json:"max_units,omitempty"`                                           // Hold-cap on the tranche (0/unset = full available cargo)
+	MaxSpend              *int32                 `protobuf:"varint,8,opt,name=max_spend,json=maxSpend,proto3,oneof" json:"max_spend,omitempty"`                                           // Working-capital cap on the buy (0/unset = no explicit cap)
+	MinMargin             *int32                 `protobuf:"varint,9,opt,name=min_margin,json=minMargin,proto3,oneof" json:"min_margin,omitempty"`                                        // Per-unit margin floor: abort if (destBid - sourceAsk) < this
+	WorkingCapitalReserve *int32                 `protobuf:"varint,10,opt,name=working_capital_reserve,json=workingCapitalReserve,proto3,oneof" json:"working_capital_reserve,omitempty"` // Hard spend floor (0/unset = coordinator default)
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
+}
+
+func (x *StartArbRunRequest) Reset() {
+	*x = StartArbRunRequest{}
+	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[157]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StartArbRunRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StartArbRunRequest) ProtoMessage() {}
+
+func (x *StartArbRunRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[157]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StartArbRunRequest.ProtoReflect.Descriptor instead.
+func (*StartArbRunRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_daemon_daemon_proto_rawDescGZIP(), []int{157}
+}
+
+func (x *StartArbRunRequest) GetPlayerId() int32 {
+	if x != nil {
+		return x.PlayerId
+	}
+	return 0
+}
+
+func (x *StartArbRunRequest) GetShipSymbol() string {
+	if x != nil {
+		return x.ShipSymbol
+	}
+	return ""
+}
+
+func (x *StartArbRunRequest) GetGood() string {
+	if x != nil {
+		return x.Good
+	}
+	return ""
+}
+
+func (x *StartArbRunRequest) GetBuyAt() string {
+	if x != nil {
+		return x.BuyAt
+	}
+	return ""
+}
+
+func (x *StartArbRunRequest) GetSellAt() string {
+	if x != nil {
+		return x.SellAt
+	}
+	return ""
+}
+
+func (x *StartArbRunRequest) GetAgentSymbol() string {
+	if x != nil && x.AgentSymbol != nil {
+		return *x.AgentSymbol
+	}
+	return ""
+}
+
+func (x *StartArbRunRequest) GetMaxUnits() int32 {
+	if x != nil && x.MaxUnits != nil {
+		return *x.MaxUnits
+	}
+	return 0
+}
+
+func (x *StartArbRunRequest) GetMaxSpend() int32 {
+	if x != nil && x.MaxSpend != nil {
+		return *x.MaxSpend
+	}
+	return 0
+}
+
+func (x *StartArbRunRequest) GetMinMargin() int32 {
+	if x != nil && x.MinMargin != nil {
+		return *x.MinMargin
+	}
+	return 0
+}
+
+func (x *StartArbRunRequest) GetWorkingCapitalReserve() int32 {
+	if x != nil && x.WorkingCapitalReserve != nil {
+		return *x.WorkingCapitalReserve
+	}
+	return 0
+}
+
+type StartArbRunResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ContainerId   string                 `protobuf:"bytes,1,opt,name=container_id,json=containerId,proto3" json:"container_id,omitempty"`
+	ShipSymbol    string                 `protobuf:"bytes,2,opt,name=ship_symbol,json=shipSymbol,proto3" json:"ship_symbol,omitempty"`
+	Good          string                 `protobuf:"bytes,3,opt,name=good,proto3" json:"good,omitempty"`
+	BuyAt         string                 `protobuf:"bytes,4,opt,name=buy_at,json=buyAt,proto3" json:"buy_at,omitempty"`
+	SellAt        string                 `protobuf:"bytes,5,opt,name=sell_at,json=sellAt,proto3" json:"sell_at,omitempty"`
+	Status        string                 `protobuf:"bytes,6,opt,name=status,proto3" json:"status,omitempty"`
+	Message       string                 `protobuf:"bytes,7,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StartArbRunResponse) Reset() {
+	*x = StartArbRunResponse{}
+	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[158]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StartArbRunResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StartArbRunResponse) ProtoMessage() {}
+
+func (x *StartArbRunResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[158]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StartArbRunResponse.ProtoReflect.Descriptor instead.
+func (*StartArbRunResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_daemon_daemon_proto_rawDescGZIP(), []int{158}
+}
+
+func (x *StartArbRunResponse) GetContainerId() string {
+	if x != nil {
+		return x.ContainerId
+	}
+	return ""
+}
+
+func (x *StartArbRunResponse) GetShipSymbol() string {
+	if x != nil {
+		return x.ShipSymbol
+	}
+	return ""
+}
+
+func (x *StartArbRunResponse) GetGood() string {
+	if x != nil {
+		return x.Good
+	}
+	return ""
+}
+
+func (x *StartArbRunResponse) GetBuyAt() string {
+	if x != nil {
+		return x.BuyAt
+	}
+	return ""
+}
+
+func (x *StartArbRunResponse) GetSellAt() string {
+	if x != nil {
+		return x.SellAt
+	}
+	return ""
+}
+
+func (x *StartArbRunResponse) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *StartArbRunResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// StartTourRunRequest starts a one-shot, captain-directed, guarded multi-hop trade
+// tour (sp-1ek0): fly ONE idle hull through a planner-chosen tour, guarded. All caps
+// and floors are optional (0/unset = coordinator default).
+type StartTourRunRequest struct {
+	state                 protoimpl.MessageState `protogen:"open.v1"`
+	PlayerId              int32                  `protobuf:"varint,1,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"`
+	ShipSymbol            string                 `protobuf:"bytes,2,opt,name=ship_symbol,json=shipSymbol,proto3" json:"ship_symbol,omitempty"` // Idle hull to fly the tour
+	AgentSymbol           *string                `protobuf:"bytes,3,opt,name=agent_symbol,json=agentSymbol,proto3,oneof" json:"agent_symbol,omitempty"`
+	MaxHops               *int32                 `protobuf:"varint,4,opt,name=max_hops,json=maxHops,proto3,oneof" json:"max_hops,omitempty"`                                             // 0/unset = planner default (6)
+	MaxSpend              *int64                 `protobuf:"varint,5,opt,name=max_spend,json=maxSpend,proto3,oneof" json:"max_spend,omitempty"`                                          // 0/unset = 25% of live treasury (re-resolved per tour when iterations != 0/1)
+	MinMargin             *int32                 `protobuf:"varint,6,opt,name=min_margin,json=minMargin,proto3,oneof" json:"min_margin,omitempty"`                                       // Per-unit margin floor passed to the planner
+	ReplanLimit           *int32                 `protobuf:"varint,7,opt,name=replan_limit,json=replanLimit,proto3,oneof" json:"replan_limit,omitempty"`                                 // 0/unset = coordinator default (2), PER TOUR
+	WorkingCapitalReserve *int64                 `protobuf:"varint,8,opt,name=working_capital_reserve,json=workingCapitalReserve,proto3,oneof" json:"working_capital_reserve,omitempty"` // Hard spend floor (0/unset = coordinator default)
+	Iterations            *int32                 `protobuf:"varint,9,opt,name=iterations,proto3,oneof" json:"iterations,omitempty"`                                                      // sp-m5kv: -1 = CONTINUOUS (tour until margins die), N>0 = N tours, 0/unset = one tour
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
+}
+
+func (x *StartTourRunRequest) Reset() {
+	*x = StartTourRunRequest{}
+	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[159]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StartTourRunRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StartTourRunRequest) ProtoMessage() {}
+
+func (x *StartTourRunRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[159]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StartTourRunRequest.ProtoReflect.Descriptor instead.
+func (*StartTourRunRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_daemon_daemon_proto_rawDescGZIP(), []int{159}
+}
+
+func (x *StartTourRunRequest) GetPlayerId() int32 {
+	if x != nil {
+		return x.PlayerId
+	}
+	return 0
+}
+
+func (x *StartTourRunRequest) GetShipSymbol() string {
+	if x != nil {
+		return x.ShipSymbol
+	}
+	return ""
+}
+
+func (x *StartTourRunRequest) GetAgentSymbol() string {
+	if x != nil && x.AgentSymbol != nil {
+		return *x.AgentSymbol
+	}
+	return ""
+}
+
+func (x *StartTourRunRequest) GetMaxHops() int32 {
+	if x != nil && x.MaxHops != nil {
+		return *x.MaxHops
+	}
+	return 0
+}
+
+func (x *StartTourRunRequest) GetMaxSpend() int64 {
+	if x != nil && x.MaxSpend != nil {
+		return *x.MaxSpend
+	}
+	return 0
+}
+
+func (x *StartTourRunRequest) GetMinMargin() int32 {
+	if x != nil && x.MinMargin != nil {
+		return *x.MinMargin
+	}
+	return 0
+}
+
+func (x *StartTourRunRequest) GetReplanLimit() int32 {
+	if x != nil && x.ReplanLimit != nil {
+		return *x.ReplanLimit
+	}
+	return 0
+}
+
+func (x *StartTourRunRequest) GetWorkingCapitalReserve() int64 {
+	if x != nil && x.WorkingCapitalReserve != nil {
+		return *x.WorkingCapitalReserve
+	}
+	return 0
+}
+
+func (x *StartTourRunRequest) GetIterations() int32 {
+	if x != nil && x.Iterations != nil {
+		return *x.Iterations
+	}
+	return 0
+}
+
+type StartTourRunResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ContainerId   string                 `protobuf:"bytes,1,opt,name=container_id,json=containerId,proto3" json:"container_id,omitempty"`
+	ShipSymbol    string                 `protobuf:"bytes,2,opt,name=ship_symbol,json=shipSymbol,proto3" json:"ship_symbol,omitempty"`
+	Status        string                 `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`
+	Message       string                 `protobuf:"bytes,4,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StartTourRunResponse) Reset() {
+	*x = StartTourRunResponse{}
+	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[160]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StartTourRunResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StartTourRunResponse) ProtoMessage() {}
+
+func (x *StartTourRunResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[160]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StartTourRunResponse.ProtoReflect.Descriptor instead.
+func (*StartTourRunResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_daemon_daemon_proto_rawDescGZIP(), []int{160}
+}
+
+func (x *StartTourRunResponse) GetContainerId() string {
+	if x != nil {
+		return x.ContainerId
+	}
+	return ""
+}
+
+func (x *StartTourRunResponse) GetShipSymbol() string {
+	if x != nil {
+		return x.ShipSymbol
+	}
+	return ""
+}
+
+func (x *StartTourRunResponse) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *StartTourRunResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// StartStockerRequest starts the STOCKER LOOP (sp-zdwg): a dedicated hull fills a home
+// warehouse with contract-recurrent goods bought cheap at foreign markets. All caps and
+// floors are optional (0/unset = coordinator default).
+type StartStockerRequest struct {
+	state                 protoimpl.MessageState `protogen:"open.v1"`
+	PlayerId              int32                  `protobuf:"varint,1,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"`
+	ShipSymbol            string                 `protobuf:"bytes,2,opt,name=ship_symbol,json=shipSymbol,proto3" json:"ship_symbol,omitempty"`                      // Dedicated hull to fly the stocker loop
+	WarehouseWaypoint     string                 `protobuf:"bytes,3,opt,name=warehouse_waypoint,json=warehouseWaypoint,proto3" json:"warehouse_waypoint,omitempty"` // Home warehouse waypoint to deposit into (its system is the demand anchor)
+	AgentSymbol           *string                `protobuf:"bytes,4,opt,name=agent_symbol,json=agentSymbol,proto3,oneof" json:"agent_symbol,omitempty"`
+	BudgetPerLeg          *int32                 `protobuf:"varint,5,opt,name=budget_per_leg,json=budgetPerLeg,proto3,oneof" json:"budget_per_leg,omitempty"`                            // Per-buy-leg spend cap in credits (0/unset = no explicit per-leg cap)
+	WorkingCapitalReserve *int64                 `protobuf:"varint,6,opt,name=working_capital_reserve,json=workingCapitalReserve,proto3,oneof" json:"working_capital_reserve,omitempty"` // Hard spend floor (0/unset = coordinator default, 50k)
+	Iterations            *int32                 `protobuf:"varint,7,opt,name=iterations,proto3,oneof" json:"iterations,omitempty"`                                                      // -1 = CONTINUOUS (fill until nothing left to stock), N>0 = N round-trips, 0/unset = one round-trip
+	MaxMarketAgeMinutes   *int32                 `protobuf:"varint,8,opt,name=max_market_age_minutes,json=maxMarketAgeMinutes,proto3,oneof" json:"max_market_age_minutes,omitempty"`     // Freshness cap on the foreign ask (0/unset = 75)
+	TargetPerGood         *int32                 `protobuf:"varint,9,opt,name=target_per_good,json=targetPerGood,proto3,oneof" json:"target_per_good,omitempty"`                         // Fill-target override per good (0/unset = the miner's measured demand units)
+	Standing              *bool                  `protobuf:"varint,10,opt,name=standing,proto3,oneof" json:"standing,omitempty"`                                                         // STANDING refill (sp-k1ka): never completes at target — parks and auto-re-stages when contracts drain the warehouse below target; survives restart (re-adopted standing from persisted config). No manual relaunch.
+	TickSeconds           *int32                 `protobuf:"varint,11,opt,name=tick_seconds,json=tickSeconds,proto3,oneof" json:"tick_seconds,omitempty"`                                // STANDING park cadence between at-target re-checks (0/unset = 30s)
+	RefillHysteresis      *int32                 `protobuf:"varint,12,opt,name=refill_hysteresis,json=refillHysteresis,proto3,oneof" json:"refill_hysteresis,omitempty"`                 // STANDING target-hysteresis: minimum units-short before re-staging a good (0/unset = 1), so a tiny gap does not thrash a refill
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
+}
+
+func (x *StartStockerRequest) Reset() {
+	*x = StartStockerRequest{}
+	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[161]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StartStockerRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StartStockerRequest) ProtoMessage() {}
+
+func (x *StartStockerRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[161]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StartStockerRequest.ProtoReflect.Descriptor instead.
+func (*StartStockerRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_daemon_daemon_proto_rawDescGZIP(), []int{161}
+}
+
+func (x *StartStockerRequest) GetPlayerId() int32 {
+	if x != nil {
+		return x.PlayerId
+	}
+	return 0
+}
+
+func (x *StartStockerRequest) GetShipSymbol() string {
+	if x != nil {
+		return x.ShipSymbol
+	}
+	return ""
+}
+
+func (x *StartStockerRequest) GetWarehouseWaypoint() string {
+	if x != nil {
+		return x.WarehouseWaypoint
+	}
+	return ""
+}
+
+func (x *StartStockerRequest) GetAgentSymbol() string {
+	if x != nil && x.AgentSymbol != nil {
+		return *x.AgentSymbol
+	}
+	return ""
+}
+
+func (x *StartStockerRequest) GetBudgetPerLeg() int32 {
+	if x != nil && x.BudgetPerLeg != nil {
+		return *x.BudgetPerLeg
+	}
+	return 0
+}
+
+func (x *StartStockerRequest) GetWorkingCapitalReserve() int64 {
+	if x != nil && x.WorkingCapitalReserve != nil {
+		return *x.WorkingCapitalReserve
+	}
+	return 0
+}
+
+func (x *StartStockerRequest) GetIterations() int32 {
+	if x != nil && x.Iterations != nil {
+		return *x.Iterations
+	}
+	return 0
+}
+
+func (x *StartStockerRequest) GetMaxMarketAgeMinutes() int32 {
+	if x != nil && x.MaxMarketAgeMinutes != nil {
+		return *x.MaxMarketAgeMinutes
+	}
+	return 0
+}
+
+func (x *StartStockerRequest) GetTargetPerGood() int32 {
+	if x != nil && x.TargetPerGood != nil {
+		return *x.TargetPerGood
+	}
+	return 0
+}
+
+func (x *StartStockerRequest) GetStanding() bool {
+	if x != nil && x.Standing != nil {
+		return *x.Standing
+	}
+	return false
+}
+
+func (x *StartStockerRequest) GetTickSeconds() int32 {
+	if x != nil && x.TickSeconds != nil {
+		return *x.TickSeconds
+	}
+	return 0
+}
+
+func (x *StartStockerRequest) GetRefillHysteresis() int32 {
+	if x != nil && x.RefillHysteresis != nil {
+		return *x.RefillHysteresis
+	}
+	return 0
+}
+
+type StartStockerResponse struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	ContainerId       string                 `protobuf:"bytes,1,opt,name=container_id,json=containerId,proto3" json:"container_id,omitempty"`
+	ShipSymbol        string                 `protobuf:"bytes,2,opt,name=ship_symbol,json=shipSymbol,proto3" json:"ship_symbol,omitempty"`
+	WarehouseWaypoint string                 `protobuf:"bytes,3,opt,name=warehouse_waypoint,json=warehouseWaypoint,proto3" json:"warehouse_waypoint,omitempty"`
+	Status            string                 `protobuf:"bytes,4,opt,name=status,proto3" json:"status,omitempty"`
+	Message           string                 `protobuf:"bytes,5,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *StartStockerResponse) Reset() {
+	*x = StartStockerResponse{}
+	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[162]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StartStockerResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StartStockerResponse) ProtoMessage() {}
+
+func (x *StartStockerResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[162]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StartStockerResponse.ProtoReflect.Descriptor instead.
+func (*StartStockerResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_daemon_daemon_proto_rawDescGZIP(), []int{162}
+}
+
+func (x *StartStockerResponse) GetContainerId() string {
+	if x != nil {
+		return x.ContainerId
+	}
+	return ""
+}
+
+func (x *StartStockerResponse) GetShipSymbol() string {
+	if x != nil {
+		return x.ShipSymbol
+	}
+	return ""
+}
+
+func (x *StartStockerResponse) GetWarehouseWaypoint() string {
+	if x != nil {
+		return x.WarehouseWaypoint
+	}
+	return ""
+}
+
+func (x *StartStockerResponse) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *StartStockerResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// GasExtractionOperationRequest starts a gas extraction operation with siphon and transport ships
+type GasExtractionOperationRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	GasGiant       *string                `protobuf:"bytes,1,opt,name=gas_giant,json=gasGiant,proto3,oneof" json:"gas_giant,omitempty"`             // Optional: specific waypoint, or auto-select
+	SiphonShips    []string               `protobuf:"bytes,2,rep,name=siphon_ships,json=siphonShips,proto3" json:"siphon_ships,omitempty"`          // Ships for gas siphoning (need SIPHON mount + GAS_PROCESSOR)
+	TransportShips []string               `protobuf:"bytes,3,rep,name=transport_ships,json=transportShips,proto3" json:"transport_ships,omitempty"` // Ships for transport to factories
+	PlayerId       int32                  `protobuf:"varint,4,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"`
+	AgentSymbol    *string                `protobuf:"bytes,5,opt,name=agent_symbol,json=agentSymbol,proto3,oneof" json:"agent_symbol,omitempty"`
+	Force          bool                   `protobuf:"varint,6,opt,name=force,proto3" json:"force,omitempty"`                               // Override fuel validation warnings
+	DryRun         bool                   `protobuf:"varint,7,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`               // If true, only select gas giant without starting operation
+	MaxLegTime     int32                  `protobuf:"varint,8,opt,name=max_leg_time,json=maxLegTime,proto3" json:"max_leg_time,omitempty"` // Max time per leg in minutes (0 = no limit)
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *GasExtractionOperationRequest) Reset() {
+	*x = GasExtractionOperationRequest{}
+	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[163]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GasExtractionOperationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GasExtractionOperationRequest) ProtoMessage() {}
+
+func (x *GasExtractionOperationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[163]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GasExtractionOperationRequest.ProtoReflect.Descriptor instead.
+func (*GasExtractionOperationRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_daemon_daemon_proto_rawDescGZIP(), []int{163}
+}
+
+func (x *GasExtractionOperationRequest) GetGasGiant() string {
+	if x != nil && x.GasGiant != nil {
+		return *x.GasGiant
+	}
+	return ""
+}
+
+func (x *GasExtractionOperationRequest) GetSiphonShips() []string {
+	if x != nil {
+		return x.SiphonShips
+	}
+	return nil
+}
+
+func (x *GasExtractionOperationRequest) GetTransportShips() []string {
+	if x != nil {
+		return x.TransportShips
+	}
+	return nil
+}
+
+func (x *GasExtractionOperationRequest) GetPlayerId() int32 {
+	if x != nil {
+		return x.PlayerId
+	}
+	return 0
+}
+
+func (x *GasExtractionOperationRequest) GetAgentSymbol() string {
+	if x != nil && x.AgentSymbol != nil {
+		return *x.AgentSymbol
+	}
+	return ""
+}
+
+func (x *GasExtractionOperationRequest) GetForce() bool {
+	if x != nil {
+		return x.Force
+	}
+	return false
+}
+
+func (x *GasExtractionOperationRequest) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+func (x *GasExtractionOperationRequest) GetMaxLegTime() int32 {
+	if x != nil {
+		return x.MaxLegTime
+	}
+	return 0
+}
+
+// GasExtractionOperationResponse returns the result of starting a gas extraction operation
+type GasExtractionOperationResponse struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	ContainerId    string                 `protobuf:"bytes,1,opt,name=container_id,json=containerId,proto3" json:"container_id,omitempty"`
+	GasGiant       string                 `protobuf:"bytes,2,opt,name=gas_giant,json=gasGiant,proto3" json:"gas_giant,omitempty"`
+	SiphonShips    []string               `protobuf:"bytes,3,rep,name=siphon_ships,json=siphonShips,proto3" json:"siphon_ships,omitempty"`
+	TransportShips []string               `protobuf:"bytes,4,rep,name=transport_ships,json=transportShips,proto3" json:"transport_ships,omitempty"`
+	Status         string                 `protobuf:"bytes,5,opt,name=status,proto3" json:"status,omitempty"`
+	// Dry-run results
+	ShipRoutes    []*ShipRoute `protobuf:"bytes,6,rep,name=ship_routes,json=shipRoutes,proto3" json:"ship_routes,omitempty"` // Planned routes for all ships
+	Errors        []string     `protobuf:"bytes,7,rep,name=errors,proto3" json:"errors,omitempty"`                           // Any errors during route planning
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GasExtractionOperationResponse) Reset() {
+	*x = GasExtractionOperationResponse{}
+	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[164]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GasExtractionOperationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GasExtractionOperationResponse) ProtoMessage() {}
+
+func (x *GasExtractionOperationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[164]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GasExtractionOperationResponse.ProtoReflect.Descriptor instead.
+func (*GasExtractionOperationResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_daemon_daemon_proto_rawDescGZIP(), []int{164}
+}
+
+func (x *GasExtractionOperationResponse) GetContainerId() string {
+	if x != nil {
+		return x.ContainerId
+	}
+	return ""
+}
+
+func (x *GasExtractionOperationResponse) GetGasGiant() string {
+	if x != nil {
+		return x.GasGiant
+	}
+	return ""
+}
+
+func (x *GasExtractionOperationResponse) GetSiphonShips() []string {
+	if x != nil {
+		return x.SiphonShips
+	}
+	return nil
+}
+
+func (x *GasExtractionOperationResponse) GetTransportShips() []string {
+	if x != nil {
+		return x.TransportShips
+	}
+	return nil
+}
+
+func (x *GasExtractionOperationResponse) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *GasExtractionOperationResponse) GetShipRoutes() []*ShipRoute {
+	if x != nil {
+		return x.ShipRoutes
+	}
+	return nil
+}
+
+func (x *GasExtractionOperationResponse) GetErrors() []string {
+	if x != nil {
+		return x.Errors
+	}
+	return nil
+}
+
+// StartConstructionPipelineRequest starts a pipeline to supply materials to a construction site
+type StartConstructionPipelineRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Waypoint symbol of the construction site (e.g., "X1-FB5-I61")
+	ConstructionSite string `protobuf:"bytes,1,opt,name=construction_site,json=constructionSite,proto3" json:"construction_site,omitempty"`
+	// Player ID for the operation
+	PlayerId int32 `protobuf:"varint,2,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"`
+	// Optional agent symbol (alternative to player_id)
+	AgentSymbol *string `protobuf:"bytes,3,opt,name=agent_symbol,json=agentSymbol,proto3,oneof" json:"agent_symbol,omitempty"`
+	// Supply chain depth: 0=full chain, 1=raw only, 2=intermediates, 3=buy final
+	SupplyChainDepth int32 `protobuf:"varint,4,opt,name=supply_chain_depth,json=supplyChainDepth,proto3" json:"supply_chain_depth,omitempty"`
+	// Maximum parallel workers (default 5)
+	MaxWorkers int32 `protobuf:"varint,5,opt,name=max_workers,json=maxWorkers,proto3" json:"max_workers,omitempty"`
+	// Optional system symbol (defaults to deriving from construction_site)
+	SystemSymbol *string `protobuf:"bytes,6,opt,name=system_symbol,json=systemSymbol,proto3,oneof" json:"system_symbol,omitempty"`
+	// Optional caller-set EXPORT sourcing floor (sp-ezz9), e.g. "SCARCE".
+	// Lowers the supply-priority locator's acceptance floor below the default
+	// MODERATE using its existing tolerance ladder. Unset preserves the
+	// original MODERATE-floor behavior unchanged.
+	MinSupply *string `protobuf:"bytes,7,opt,name=min_supply,json=minSupply,proto3,oneof" json:"min_supply,omitempty"`
+	// Optional per-good buy-gating overrides (sp-sdyo values, sp-pdb3 launch surface) as a
+	// JSON-encoded GoodGatingOverrides map: good -> {strategy?, priceCeilingMult?, minSupply?}.
+	// Loosens gating for named bottleneck goods only; every other good keeps the global floor above.
+	// Persisted on the pipeline so it survives a restart (RULINGS #2). Unset preserves today's
+	// global-default behaviour for every good. priceCeilingMult is clamped to the domain hard cap.
+	GoodOverrides *string `protobuf:"bytes,8,opt,name=good_overrides,json=goodOverrides,proto3,oneof" json:"good_overrides,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StartConstructionPipelineRequest) Reset() {
+	*x = StartConstructionPipelineRequest{}
+	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[165]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StartConstructionPipelineRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StartConstructionPipelineRequest) ProtoMessage() {}
+
+func (x *StartConstructionPipelineRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[165]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StartConstructionPipelineRequest.ProtoReflect.Descriptor instead.
+func (*StartConstructionPipelineRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_daemon_daemon_proto_rawDescGZIP(), []int{165}
+}
+
+func (x *StartConstructionPipelineRequest) GetConstructionSite() string {
+	if x != nil {
+		return x.ConstructionSite
+	}
+	return ""
+}
+
+func (x *StartConstructionPipelineRequest) GetPlayerId() int32 {
+	if x != nil {
+		return x.PlayerId
+	}
+	return 0
+}
+
+func (x *StartConstructionPipelineRequest) GetAgentSymbol() string {
+	if x != nil && x.AgentSymbol != nil {
+		return *x.AgentSymbol
+	}
+	return ""
+}
+
+func (x *StartConstructionPipelineRequest) GetSupplyChainDepth() int32 {
+	if x != nil {
+		return x.SupplyChainDepth
+	}
+	return 0
+}
+
+func (x *StartConstructionPipelineRequest) GetMaxWorkers() int32 {
+	if x != nil {
+		return x.MaxWorkers
+	}
+	return 0
+}
+
+func (x *StartConstructionPipelineRequest) GetSystemSymbol() string {
+	if x != nil && x.SystemSymbol != nil {
+		return *x.SystemSymbol
+	}
+	return ""
+}
+
+func (x *StartConstructionPipelineRequest) GetMinSupply() string {
+	if x != nil && x.MinSupply != nil {
+		return *x.MinSupply
+	}
+	return ""
+}
+
+func (x *StartConstructionPipelineRequest) GetGoodOverrides() string {
+	if x != nil && x.GoodOverrides != nil {
+		return *x.GoodOverrides
+	}
+	return ""
+}
+
+// StartConstructionPipelineResponse returns the result of starting a construction pipeline
+type StartConstructionPipelineResponse struct {
+	state            protoimpl.MessageState  `protogen:"open.v1"`
+	PipelineId       string                  `protobuf:"bytes,1,opt,name=pipeline_id,json=pipelineId,proto3" json:"pipeline_id,omitempty"`
+	ConstructionSite string                  `protobuf:"bytes,2,opt,name=construction_site,json=constructionSite,proto3" json:"construction_site,omitempty"`
+	IsResumed        bool                    `protobuf:"varint,3,opt,name=is_resumed,json=isResumed,proto3" json:"is_resumed,omitempty"` // true if resuming existing pipeline
+	Materials        []*ConstructionMaterial `protobuf:"bytes,4,rep,name=materials,proto3" json:"materials,omitempty"`
+	TaskCount        int32                   `protobuf:"varint,5,opt,name=task_count,json=taskCount,proto3" json:"task_count,omitempty"`
+	Status           string                  `protobuf:"bytes,6,opt,name=status,proto3" json:"status,omitempty"`
+	Message          string                  `protobuf:"bytes,7,opt,name=message,proto3" json:"message,omitempty"`
+	// Trade symbols of materials that could not be sourced this call (sp-560b).
+	// Each still has a visible PENDING task that the SupplyMonitor re-sources
+	// when supply regenerates; this names them so the operator can source the
+	// gap manually instead of only seeing a generic "no market" log line.
+	DeferredMaterials []string `protobuf:"bytes,8,rep,name=deferred_materials,json=deferredMaterials,proto3" json:"deferred_materials,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *StartConstructionPipelineResponse) Reset() {
+	*x = StartConstructionPipelineResponse{}
+	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[166]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StartConstructionPipelineResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StartConstructionPipelineResponse) ProtoMessage() {}
+
+func (x *StartConstructionPipelineResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[166]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StartConstructionPipelineResponse.ProtoReflect.Descriptor instead.
+func (*StartConstructionPipelineResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_daemon_daemon_proto_rawDescGZIP(), []int{166}
+}
+
+func (x *StartConstructionPipelineResponse) GetPipelineId() string {
 	if x != nil {
-		return x.SellPrice
+		return x.PipelineId
 	}
-	return 0
+	return ""
 }
 
-func (x *ArbitrageOpportunity) GetProfitPerUnit() int32 {
+func (x *StartConstructionPipelineResponse) GetConstructionSite() string {
 	if x != nil {
-		return x.ProfitPerUnit
+		return x.ConstructionSite
 	}
-	return 0
+	return ""
 }
 
-func (x *ArbitrageOpportunity) GetProfitMargin() float64 {
+func (x *StartConstructionPipelineResponse) GetIsResumed() bool {
 	if x != nil {
-		return x.ProfitMargin
+		return x.IsResumed
 	}
-	return 0
+	return false
 }
 
-func (x *ArbitrageOpportunity) GetEstimatedProfit() int32 {
+func (x *StartConstructionPipelineResponse) GetMaterials() []*ConstructionMaterial {
 	if x != nil {
-		return x.EstimatedProfit
+		return x.Materials
 	}
-	return 0
+	return nil
 }
 
-func (x *ArbitrageOpportunity) GetDistance() float64 {
+func (x *StartConstructionPipelineResponse) GetTaskCount() int32 {
 	if x != nil {
-		return x.Distance
+		return x.TaskCount
 	}
 	return 0
 }
 
-func (x *ArbitrageOpportunity) GetBuySupply() string {
+func (x *StartConstructionPipelineResponse) GetStatus() string {
 	if x != nil {
-		return x.BuySupply
+		return x.Status
 	}
 	return ""
 }
 
-func (x *ArbitrageOpportunity) GetSellActivity() string {
+func (x *StartConstructionPipelineResponse) GetMessage() string {
 	if x != nil {
-		return x.SellActivity
+		return x.Message
 	}
 	return ""
 }
 
-func (x *ArbitrageOpportunity) GetScore() float64 {
+func (x *StartConstructionPipelineResponse) GetDeferredMaterials() []string {
 	if x != nil {
-		return x.Score
+		return x.DeferredMaterials
 	}
-	return 0
+	return nil
 }
 
-// ScanArbitrageOpportunitiesResponse returns scan results
-type ScanArbitrageOpportunitiesResponse struct {
-	state         protoimpl.MessageState  `protogen:"open.v1"`
-	Opportunities []*ArbitrageOpportunity `protobuf:"bytes,1,rep,name=opportunities,proto3" json:"opportunities,omitempty"`
-	TotalScanned  int32                   `protobuf:"varint,2,opt,name=total_scanned,json=totalScanned,proto3" json:"total_scanned,omitempty"`
-	SystemSymbol  string                  `protobuf:"bytes,3,opt,name=system_symbol,json=systemSymbol,proto3" json:"system_symbol,omitempty"`
+// ConstructionMaterial represents a material required for construction
+type ConstructionMaterial struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TradeSymbol   string                 `protobuf:"bytes,1,opt,name=trade_symbol,json=tradeSymbol,proto3" json:"trade_symbol,omitempty"`
+	Required      int32                  `protobuf:"varint,2,opt,name=required,proto3" json:"required,omitempty"`
+	Fulfilled     int32                  `protobuf:"varint,3,opt,name=fulfilled,proto3" json:"fulfilled,omitempty"`
+	Remaining     int32                  `protobuf:"varint,4,opt,name=remaining,proto3" json:"remaining,omitempty"`
+	Progress      float64                `protobuf:"fixed64,5,opt,name=progress,proto3" json:"progress,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ScanArbitrageOpportunitiesResponse) Reset() {
-	*x = ScanArbitrageOpportunitiesResponse{}
-	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[130]
+func (x *ConstructionMaterial) Reset() {
+	*x = ConstructionMaterial{}
+	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[167]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ScanArbitrageOpportunitiesResponse) String() string {
+func (x *ConstructionMaterial) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ScanArbitrageOpportunitiesResponse) ProtoMessage() {}
+func (*ConstructionMaterial) ProtoMessage() {}
 
-func (x *ScanArbitrageOpportunitiesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[130]
+func (x *ConstructionMaterial) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[167]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -9136,59 +12199,71 @@ func (x *ScanArbitrageOpportunitiesResponse) ProtoReflect() protoreflect.Message
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ScanArbitrageOpportunitiesResponse.ProtoReflect.Descriptor instead.
-func (*ScanArbitrageOpportunitiesResponse) Descriptor() ([]byte, []int) {
-	return file_pkg_proto_daemon_daemon_proto_rawDescGZIP(), []int{130}
+// Deprecated: Use ConstructionMaterial.ProtoReflect.Descriptor instead.
+func (*ConstructionMaterial) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_daemon_daemon_proto_rawDescGZIP(), []int{167}
 }
 
-func (x *ScanArbitrageOpportunitiesResponse) GetOpportunities() []*ArbitrageOpportunity {
+func (x *ConstructionMaterial) GetTradeSymbol() string {
 	if x != nil {
-		return x.Opportunities
+		return x.TradeSymbol
 	}
-	return nil
+	return ""
 }
 
-func (x *ScanArbitrageOpportunitiesResponse) GetTotalScanned() int32 {
+func (x *ConstructionMaterial) GetRequired() int32 {
 	if x != nil {
-		return x.TotalScanned
+		return x.Required
 	}
 	return 0
 }
 
-func (x *ScanArbitrageOpportunitiesResponse) GetSystemSymbol() string {
+func (x *ConstructionMaterial) GetFulfilled() int32 {
 	if x != nil {
-		return x.SystemSymbol
+		return x.Fulfilled
 	}
-	return ""
+	return 0
 }
 
-// StartArbitrageCoordinatorRequest starts arbitrage coordinator
-type StartArbitrageCoordinatorRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	PlayerId      int32                  `protobuf:"varint,1,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"`
-	SystemSymbol  string                 `protobuf:"bytes,2,opt,name=system_symbol,json=systemSymbol,proto3" json:"system_symbol,omitempty"` // System to trade in (e.g., "X1-AU21")
-	MinMargin     float64                `protobuf:"fixed64,3,opt,name=min_margin,json=minMargin,proto3" json:"min_margin,omitempty"`        // Minimum profit margin percentage (default 10.0)
-	MaxWorkers    int32                  `protobuf:"varint,4,opt,name=max_workers,json=maxWorkers,proto3" json:"max_workers,omitempty"`      // Maximum parallel workers (default 10)
-	MinBalance    int32                  `protobuf:"varint,5,opt,name=min_balance,json=minBalance,proto3" json:"min_balance,omitempty"`      // Minimum credit balance to maintain (default 0 = no limit)
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *ConstructionMaterial) GetRemaining() int32 {
+	if x != nil {
+		return x.Remaining
+	}
+	return 0
 }
 
-func (x *StartArbitrageCoordinatorRequest) Reset() {
-	*x = StartArbitrageCoordinatorRequest{}
-	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[131]
+func (x *ConstructionMaterial) GetProgress() float64 {
+	if x != nil {
+		return x.Progress
+	}
+	return 0
+}
+
+// GetConstructionStatusRequest retrieves the status of a construction site
+type GetConstructionStatusRequest struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	ConstructionSite string                 `protobuf:"bytes,1,opt,name=construction_site,json=constructionSite,proto3" json:"construction_site,omitempty"`
+	PlayerId         int32                  `protobuf:"varint,2,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"`
+	AgentSymbol      *string                `protobuf:"bytes,3,opt,name=agent_symbol,json=agentSymbol,proto3,oneof" json:"agent_symbol,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *GetConstructionStatusRequest) Reset() {
+	*x = GetConstructionStatusRequest{}
+	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[168]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *StartArbitrageCoordinatorRequest) String() string {
+func (x *GetConstructionStatusRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*StartArbitrageCoordinatorRequest) ProtoMessage() {}
+func (*GetConstructionStatusRequest) ProtoMessage() {}
 
-func (x *StartArbitrageCoordinatorRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[131]
+func (x *GetConstructionStatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[168]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -9199,75 +12274,62 @@ func (x *StartArbitrageCoordinatorRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use StartArbitrageCoordinatorRequest.ProtoReflect.Descriptor instead.
-func (*StartArbitrageCoordinatorRequest) Descriptor() ([]byte, []int) {
-	return file_pkg_proto_daemon_daemon_proto_rawDescGZIP(), []int{131}
-}
-
-func (x *StartArbitrageCoordinatorRequest) GetPlayerId() int32 {
-	if x != nil {
-		return x.PlayerId
-	}
-	return 0
+// Deprecated: Use GetConstructionStatusRequest.ProtoReflect.Descriptor instead.
+func (*GetConstructionStatusRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_daemon_daemon_proto_rawDescGZIP(), []int{168}
 }
 
-func (x *StartArbitrageCoordinatorRequest) GetSystemSymbol() string {
+func (x *GetConstructionStatusRequest) GetConstructionSite() string {
 	if x != nil {
-		return x.SystemSymbol
+		return x.ConstructionSite
 	}
 	return ""
 }
 
-func (x *StartArbitrageCoordinatorRequest) GetMinMargin() float64 {
-	if x != nil {
-		return x.MinMargin
-	}
-	return 0
-}
-
-func (x *StartArbitrageCoordinatorRequest) GetMaxWorkers() int32 {
+func (x *GetConstructionStatusRequest) GetPlayerId() int32 {
 	if x != nil {
-		return x.MaxWorkers
+		return x.PlayerId
 	}
 	return 0
 }
 
-func (x *StartArbitrageCoordinatorRequest) GetMinBalance() int32 {
-	if x != nil {
-		return x.MinBalance
+func (x *GetConstructionStatusRequest) GetAgentSymbol() string {
+	if x != nil && x.AgentSymbol != nil {
+		return *x.AgentSymbol
 	}
-	return 0
+	return ""
 }
 
-// StartArbitrageCoordinatorResponse returns coordinator details
-type StartArbitrageCoordinatorResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	ContainerId   string                 `protobuf:"bytes,1,opt,name=container_id,json=containerId,proto3" json:"container_id,omitempty"`
-	SystemSymbol  string                 `protobuf:"bytes,2,opt,name=system_symbol,json=systemSymbol,proto3" json:"system_symbol,omitempty"`
-	MinMargin     float64                `protobuf:"fixed64,3,opt,name=min_margin,json=minMargin,proto3" json:"min_margin,omitempty"`
-	MaxWorkers    int32                  `protobuf:"varint,4,opt,name=max_workers,json=maxWorkers,proto3" json:"max_workers,omitempty"`
-	MinBalance    int32                  `protobuf:"varint,5,opt,name=min_balance,json=minBalance,proto3" json:"min_balance,omitempty"`
-	Status        string                 `protobuf:"bytes,6,opt,name=status,proto3" json:"status,omitempty"`
-	Message       string                 `protobuf:"bytes,7,opt,name=message,proto3" json:"message,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+// GetConstructionStatusResponse returns the status of a construction site
+type GetConstructionStatusResponse struct {
+	state            protoimpl.MessageState  `protogen:"open.v1"`
+	ConstructionSite string                  `protobuf:"bytes,1,opt,name=construction_site,json=constructionSite,proto3" json:"construction_site,omitempty"`
+	IsComplete       bool                    `protobuf:"varint,2,opt,name=is_complete,json=isComplete,proto3" json:"is_complete,omitempty"`
+	Progress         float64                 `protobuf:"fixed64,3,opt,name=progress,proto3" json:"progress,omitempty"`
+	Materials        []*ConstructionMaterial `protobuf:"bytes,4,rep,name=materials,proto3" json:"materials,omitempty"`
+	// Pipeline info (if any)
+	PipelineId       *string  `protobuf:"bytes,5,opt,name=pipeline_id,json=pipelineId,proto3,oneof" json:"pipeline_id,omitempty"`
+	PipelineStatus   *string  `protobuf:"bytes,6,opt,name=pipeline_status,json=pipelineStatus,proto3,oneof" json:"pipeline_status,omitempty"`
+	PipelineProgress *float64 `protobuf:"fixed64,7,opt,name=pipeline_progress,json=pipelineProgress,proto3,oneof" json:"pipeline_progress,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
 }
 
-func (x *StartArbitrageCoordinatorResponse) Reset() {
-	*x = StartArbitrageCoordinatorResponse{}
-	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[132]
+func (x *GetConstructionStatusResponse) Reset() {
+	*x = GetConstructionStatusResponse{}
+	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[169]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *StartArbitrageCoordinatorResponse) String() string {
+func (x *GetConstructionStatusResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*StartArbitrageCoordinatorResponse) ProtoMessage() {}
+func (*GetConstructionStatusResponse) ProtoMessage() {}
 
-func (x *StartArbitrageCoordinatorResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[132]
+func (x *GetConstructionStatusResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[169]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -9278,87 +12340,85 @@ func (x *StartArbitrageCoordinatorResponse) ProtoReflect() protoreflect.Message
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use StartArbitrageCoordinatorResponse.ProtoReflect.Descriptor instead.
-func (*StartArbitrageCoordinatorResponse) Descriptor() ([]byte, []int) {
-	return file_pkg_proto_daemon_daemon_proto_rawDescGZIP(), []int{132}
-}
-
-func (x *StartArbitrageCoordinatorResponse) GetContainerId() string {
-	if x != nil {
-		return x.ContainerId
-	}
-	return ""
+// Deprecated: Use GetConstructionStatusResponse.ProtoReflect.Descriptor instead.
+func (*GetConstructionStatusResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_daemon_daemon_proto_rawDescGZIP(), []int{169}
 }
 
-func (x *StartArbitrageCoordinatorResponse) GetSystemSymbol() string {
+func (x *GetConstructionStatusResponse) GetConstructionSite() string {
 	if x != nil {
-		return x.SystemSymbol
+		return x.ConstructionSite
 	}
 	return ""
 }
 
-func (x *StartArbitrageCoordinatorResponse) GetMinMargin() float64 {
+func (x *GetConstructionStatusResponse) GetIsComplete() bool {
 	if x != nil {
-		return x.MinMargin
+		return x.IsComplete
 	}
-	return 0
+	return false
 }
 
-func (x *StartArbitrageCoordinatorResponse) GetMaxWorkers() int32 {
+func (x *GetConstructionStatusResponse) GetProgress() float64 {
 	if x != nil {
-		return x.MaxWorkers
+		return x.Progress
 	}
 	return 0
 }
 
-func (x *StartArbitrageCoordinatorResponse) GetMinBalance() int32 {
+func (x *GetConstructionStatusResponse) GetMaterials() []*ConstructionMaterial {
 	if x != nil {
-		return x.MinBalance
+		return x.Materials
 	}
-	return 0
+	return nil
 }
 
-func (x *StartArbitrageCoordinatorResponse) GetStatus() string {
-	if x != nil {
-		return x.Status
+func (x *GetConstructionStatusResponse) GetPipelineId() string {
+	if x != nil && x.PipelineId != nil {
+		return *x.PipelineId
 	}
 	return ""
 }
 
-func (x *StartArbitrageCoordinatorResponse) GetMessage() string {
-	if x != nil {
-		return x.Message
+func (x *GetConstructionStatusResponse) GetPipelineStatus() string {
+	if x != nil && x.PipelineStatus != nil {
+		return *x.PipelineStatus
 	}
 	return ""
 }
 
-// JettisonCargoRequest jettisons cargo from a ship
-type JettisonCargoRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	ShipSymbol    string                 `protobuf:"bytes,1,opt,name=ship_symbol,json=shipSymbol,proto3" json:"ship_symbol,omitempty"`
-	PlayerId      int32                  `protobuf:"varint,2,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"`
-	AgentSymbol   *string                `protobuf:"bytes,3,opt,name=agent_symbol,json=agentSymbol,proto3,oneof" json:"agent_symbol,omitempty"`
-	GoodSymbol    string                 `protobuf:"bytes,4,opt,name=good_symbol,json=goodSymbol,proto3" json:"good_symbol,omitempty"`
-	Units         int32                  `protobuf:"varint,5,opt,name=units,proto3" json:"units,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *GetConstructionStatusResponse) GetPipelineProgress() float64 {
+	if x != nil && x.PipelineProgress != nil {
+		return *x.PipelineProgress
+	}
+	return 0
 }
 
-func (x *JettisonCargoRequest) Reset() {
-	*x = JettisonCargoRequest{}
-	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[133]
+// StopConstructionPipelineRequest cancels the active construction pipeline for a site
+type StopConstructionPipelineRequest struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	ConstructionSite string                 `protobuf:"bytes,1,opt,name=construction_site,json=constructionSite,proto3" json:"construction_site,omitempty"`
+	PlayerId         int32                  `protobuf:"varint,2,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"`
+	AgentSymbol      *string                `protobuf:"bytes,3,opt,name=agent_symbol,json=agentSymbol,proto3,oneof" json:"agent_symbol,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *StopConstructionPipelineRequest) Reset() {
+	*x = StopConstructionPipelineRequest{}
+	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[170]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *JettisonCargoRequest) String() string {
+func (x *StopConstructionPipelineRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*JettisonCargoRequest) ProtoMessage() {}
+func (*StopConstructionPipelineRequest) ProtoMessage() {}
 
-func (x *JettisonCargoRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[133]
+func (x *StopConstructionPipelineRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[170]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -9369,74 +12429,59 @@ func (x *JettisonCargoRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use JettisonCargoRequest.ProtoReflect.Descriptor instead.
-func (*JettisonCargoRequest) Descriptor() ([]byte, []int) {
-	return file_pkg_proto_daemon_daemon_proto_rawDescGZIP(), []int{133}
+// Deprecated: Use StopConstructionPipelineRequest.ProtoReflect.Descriptor instead.
+func (*StopConstructionPipelineRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_daemon_daemon_proto_rawDescGZIP(), []int{170}
 }
 
-func (x *JettisonCargoRequest) GetShipSymbol() string {
+func (x *StopConstructionPipelineRequest) GetConstructionSite() string {
 	if x != nil {
-		return x.ShipSymbol
+		return x.ConstructionSite
 	}
 	return ""
 }
 
-func (x *JettisonCargoRequest) GetPlayerId() int32 {
+func (x *StopConstructionPipelineRequest) GetPlayerId() int32 {
 	if x != nil {
 		return x.PlayerId
 	}
 	return 0
 }
 
-func (x *JettisonCargoRequest) GetAgentSymbol() string {
+func (x *StopConstructionPipelineRequest) GetAgentSymbol() string {
 	if x != nil && x.AgentSymbol != nil {
 		return *x.AgentSymbol
 	}
 	return ""
 }
 
-func (x *JettisonCargoRequest) GetGoodSymbol() string {
-	if x != nil {
-		return x.GoodSymbol
-	}
-	return ""
-}
-
-func (x *JettisonCargoRequest) GetUnits() int32 {
-	if x != nil {
-		return x.Units
-	}
-	return 0
-}
-
-// JettisonCargoResponse returns result of jettison operation
-type JettisonCargoResponse struct {
-	state           protoimpl.MessageState `protogen:"open.v1"`
-	ContainerId     string                 `protobuf:"bytes,1,opt,name=container_id,json=containerId,proto3" json:"container_id,omitempty"`
-	ShipSymbol      string                 `protobuf:"bytes,2,opt,name=ship_symbol,json=shipSymbol,proto3" json:"ship_symbol,omitempty"`
-	GoodSymbol      string                 `protobuf:"bytes,3,opt,name=good_symbol,json=goodSymbol,proto3" json:"good_symbol,omitempty"`
-	UnitsJettisoned int32                  `protobuf:"varint,4,opt,name=units_jettisoned,json=unitsJettisoned,proto3" json:"units_jettisoned,omitempty"`
-	Status          string                 `protobuf:"bytes,5,opt,name=status,proto3" json:"status,omitempty"`
-	Message         string                 `protobuf:"bytes,6,opt,name=message,proto3" json:"message,omitempty"`
-	unknownFields   protoimpl.UnknownFields
-	sizeCache       protoimpl.SizeCache
+// StopConstructionPipelineResponse returns the result of stopping a construction pipeline
+type StopConstructionPipelineResponse struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	PipelineId       string                 `protobuf:"bytes,1,opt,name=pipeline_id,json=pipelineId,proto3" json:"pipeline_id,omitempty"`
+	ConstructionSite string                 `protobuf:"bytes,2,opt,name=construction_site,json=constructionSite,proto3" json:"construction_site,omitempty"`
+	Status           string                 `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"` // pipeline status after cancellation (CANCELLED)
+	TasksCancelled   int32                  `protobuf:"varint,4,opt,name=tasks_cancelled,json=tasksCancelled,proto3" json:"tasks_cancelled,omitempty"`
+	Message          string                 `protobuf:"bytes,5,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
 }
 
-func (x *JettisonCargoResponse) Reset() {
-	*x = JettisonCargoResponse{}
-	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[134]
+func (x *StopConstructionPipelineResponse) Reset() {
+	*x = StopConstructionPipelineResponse{}
+	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[171]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *JettisonCargoResponse) String() string {
+func (x *StopConstructionPipelineResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*JettisonCargoResponse) ProtoMessage() {}
+func (*StopConstructionPipelineResponse) ProtoMessage() {}
 
-func (x *JettisonCargoResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[134]
+func (x *StopConstructionPipelineResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[171]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -9447,86 +12492,82 @@ func (x *JettisonCargoResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use JettisonCargoResponse.ProtoReflect.Descriptor instead.
-func (*JettisonCargoResponse) Descriptor() ([]byte, []int) {
-	return file_pkg_proto_daemon_daemon_proto_rawDescGZIP(), []int{134}
+// Deprecated: Use StopConstructionPipelineResponse.ProtoReflect.Descriptor instead.
+func (*StopConstructionPipelineResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_daemon_daemon_proto_rawDescGZIP(), []int{171}
 }
 
-func (x *JettisonCargoResponse) GetContainerId() string {
+func (x *StopConstructionPipelineResponse) GetPipelineId() string {
 	if x != nil {
-		return x.ContainerId
+		return x.PipelineId
 	}
 	return ""
 }
 
-func (x *JettisonCargoResponse) GetShipSymbol() string {
+func (x *StopConstructionPipelineResponse) GetConstructionSite() string {
 	if x != nil {
-		return x.ShipSymbol
+		return x.ConstructionSite
 	}
 	return ""
 }
 
-func (x *JettisonCargoResponse) GetGoodSymbol() string {
+func (x *StopConstructionPipelineResponse) GetStatus() string {
 	if x != nil {
-		return x.GoodSymbol
+		return x.Status
 	}
 	return ""
 }
 
-func (x *JettisonCargoResponse) GetUnitsJettisoned() int32 {
+func (x *StopConstructionPipelineResponse) GetTasksCancelled() int32 {
 	if x != nil {
-		return x.UnitsJettisoned
+		return x.TasksCancelled
 	}
 	return 0
 }
 
-func (x *JettisonCargoResponse) GetStatus() string {
-	if x != nil {
-		return x.Status
-	}
-	return ""
-}
-
-func (x *JettisonCargoResponse) GetMessage() string {
+func (x *StopConstructionPipelineResponse) GetMessage() string {
 	if x != nil {
 		return x.Message
 	}
 	return ""
 }
 
-// StartTradeRouteRequest launches a single-hull pure-arbitrage circuit on an idle hull
-type StartTradeRouteRequest struct {
-	state        protoimpl.MessageState `protogen:"open.v1"`
-	PlayerId     int32                  `protobuf:"varint,1,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"`
-	ShipSymbol   string                 `protobuf:"bytes,2,opt,name=ship_symbol,json=shipSymbol,proto3" json:"ship_symbol,omitempty"`       // Idle hull to fly the circuit
-	SystemSymbol string                 `protobuf:"bytes,3,opt,name=system_symbol,json=systemSymbol,proto3" json:"system_symbol,omitempty"` // System to scan for arbitrage lanes
-	AgentSymbol  *string                `protobuf:"bytes,4,opt,name=agent_symbol,json=agentSymbol,proto3,oneof" json:"agent_symbol,omitempty"`
-	MaxVisits    *int32                 `protobuf:"varint,5,opt,name=max_visits,json=maxVisits,proto3,oneof" json:"max_visits,omitempty"` // Safety bound on circuit visits (0 = default 50)
-	// Optional lane-targeting override (sp-xwa1): a destination waypoint or
-	// system symbol to pin the circuit to, instead of letting the ranker
-	// auto-select a lane. Also waives the cross-system gate-crossing ranking
-	// penalty for the targeted lane alone. Unset preserves the original
-	// undirected auto-scan behavior unchanged.
-	DestWaypoint  *string `protobuf:"bytes,6,opt,name=dest_waypoint,json=destWaypoint,proto3,oneof" json:"dest_waypoint,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+// ConstructionGoodOverrideRequest sets or clears ONE good's per-good buy-gating override on a
+// running construction pipeline (sp-pdb3). construction_site + good identify the target. clear
+// removes the good's override, reverting it to the global default. The three optional knobs mirror
+// manufacturing.GoodGatingOverride; an unset knob leaves that dimension unchanged (tune one at a
+// time). price_ceiling_mult is clamped to the domain hard cap — the CLI never bypasses the
+// guardrail (RULINGS #4).
+type ConstructionGoodOverrideRequest struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	ConstructionSite string                 `protobuf:"bytes,1,opt,name=construction_site,json=constructionSite,proto3" json:"construction_site,omitempty"`
+	Good             string                 `protobuf:"bytes,2,opt,name=good,proto3" json:"good,omitempty"`
+	PlayerId         int32                  `protobuf:"varint,3,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"`
+	AgentSymbol      *string                `protobuf:"bytes,4,opt,name=agent_symbol,json=agentSymbol,proto3,oneof" json:"agent_symbol,omitempty"`
+	// clear removes any existing override for good, reverting it to the global default.
+	Clear            bool     `protobuf:"varint,5,opt,name=clear,proto3" json:"clear,omitempty"`
+	MinSupply        *string  `protobuf:"bytes,6,opt,name=min_supply,json=minSupply,proto3,oneof" json:"min_supply,omitempty"`
+	Strategy         *string  `protobuf:"bytes,7,opt,name=strategy,proto3,oneof" json:"strategy,omitempty"`
+	PriceCeilingMult *float64 `protobuf:"fixed64,8,opt,name=price_ceiling_mult,json=priceCeilingMult,proto3,oneof" json:"price_ceiling_mult,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
 }
 
-func (x *StartTradeRouteRequest) Reset() {
-	*x = StartTradeRouteRequest{}
-	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[135]
+func (x *ConstructionGoodOverrideRequest) Reset() {
+	*x = ConstructionGoodOverrideRequest{}
+	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[172]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *StartTradeRouteRequest) String() string {
+func (x *ConstructionGoodOverrideRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*StartTradeRouteRequest) ProtoMessage() {}
+func (*ConstructionGoodOverrideRequest) ProtoMessage() {}
 
-func (x *StartTradeRouteRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[135]
+func (x *ConstructionGoodOverrideRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[172]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -9537,80 +12578,99 @@ func (x *StartTradeRouteRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use StartTradeRouteRequest.ProtoReflect.Descriptor instead.
-func (*StartTradeRouteRequest) Descriptor() ([]byte, []int) {
-	return file_pkg_proto_daemon_daemon_proto_rawDescGZIP(), []int{135}
+// Deprecated: Use ConstructionGoodOverrideRequest.ProtoReflect.Descriptor instead.
+func (*ConstructionGoodOverrideRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_daemon_daemon_proto_rawDescGZIP(), []int{172}
 }
 
-func (x *StartTradeRouteRequest) GetPlayerId() int32 {
+func (x *ConstructionGoodOverrideRequest) GetConstructionSite() string {
 	if x != nil {
-		return x.PlayerId
+		return x.ConstructionSite
 	}
-	return 0
+	return ""
 }
 
-func (x *StartTradeRouteRequest) GetShipSymbol() string {
+func (x *ConstructionGoodOverrideRequest) GetGood() string {
 	if x != nil {
-		return x.ShipSymbol
+		return x.Good
 	}
 	return ""
 }
 
-func (x *StartTradeRouteRequest) GetSystemSymbol() string {
+func (x *ConstructionGoodOverrideRequest) GetPlayerId() int32 {
 	if x != nil {
-		return x.SystemSymbol
+		return x.PlayerId
 	}
-	return ""
+	return 0
 }
 
-func (x *StartTradeRouteRequest) GetAgentSymbol() string {
+func (x *ConstructionGoodOverrideRequest) GetAgentSymbol() string {
 	if x != nil && x.AgentSymbol != nil {
 		return *x.AgentSymbol
 	}
 	return ""
 }
 
-func (x *StartTradeRouteRequest) GetMaxVisits() int32 {
-	if x != nil && x.MaxVisits != nil {
-		return *x.MaxVisits
+func (x *ConstructionGoodOverrideRequest) GetClear() bool {
+	if x != nil {
+		return x.Clear
 	}
-	return 0
+	return false
 }
 
-func (x *StartTradeRouteRequest) GetDestWaypoint() string {
-	if x != nil && x.DestWaypoint != nil {
-		return *x.DestWaypoint
+func (x *ConstructionGoodOverrideRequest) GetMinSupply() string {
+	if x != nil && x.MinSupply != nil {
+		return *x.MinSupply
 	}
 	return ""
 }
 
-// StartTradeRouteResponse returns the started trade-route container
-type StartTradeRouteResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	ContainerId   string                 `protobuf:"bytes,1,opt,name=container_id,json=containerId,proto3" json:"container_id,omitempty"`
-	ShipSymbol    string                 `protobuf:"bytes,2,opt,name=ship_symbol,json=shipSymbol,proto3" json:"ship_symbol,omitempty"`
-	SystemSymbol  string                 `protobuf:"bytes,3,opt,name=system_symbol,json=systemSymbol,proto3" json:"system_symbol,omitempty"`
-	Status        string                 `protobuf:"bytes,4,opt,name=status,proto3" json:"status,omitempty"`
-	Message       string                 `protobuf:"bytes,5,opt,name=message,proto3" json:"message,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *ConstructionGoodOverrideRequest) GetStrategy() string {
+	if x != nil && x.Strategy != nil {
+		return *x.Strategy
+	}
+	return ""
+}
+
+func (x *ConstructionGoodOverrideRequest) GetPriceCeilingMult() float64 {
+	if x != nil && x.PriceCeilingMult != nil {
+		return *x.PriceCeilingMult
+	}
+	return 0
+}
+
+// ConstructionGoodOverrideResponse confirms the resulting override state for the good.
+type ConstructionGoodOverrideResponse struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	ConstructionSite string                 `protobuf:"bytes,1,opt,name=construction_site,json=constructionSite,proto3" json:"construction_site,omitempty"`
+	Good             string                 `protobuf:"bytes,2,opt,name=good,proto3" json:"good,omitempty"`
+	// cleared is true when the request removed the override.
+	Cleared bool `protobuf:"varint,3,opt,name=cleared,proto3" json:"cleared,omitempty"`
+	// changed is false when the request was a no-op (the value already matched).
+	Changed bool `protobuf:"varint,4,opt,name=changed,proto3" json:"changed,omitempty"`
+	// The resulting override values for the good (empty/zero when cleared or that knob is unset).
+	Strategy         string  `protobuf:"bytes,5,opt,name=strategy,proto3" json:"strategy,omitempty"`
+	PriceCeilingMult float64 `protobuf:"fixed64,6,opt,name=price_ceiling_mult,json=priceCeilingMult,proto3" json:"price_ceiling_mult,omitempty"`
+	MinSupply        string  `protobuf:"bytes,7,opt,name=min_supply,json=minSupply,proto3" json:"min_supply,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
 }
 
-func (x *StartTradeRouteResponse) Reset() {
-	*x = StartTradeRouteResponse{}
-	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[136]
+func (x *ConstructionGoodOverrideResponse) Reset() {
+	*x = ConstructionGoodOverrideResponse{}
+	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[173]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *StartTradeRouteResponse) String() string {
+func (x *ConstructionGoodOverrideResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*StartTradeRouteResponse) ProtoMessage() {}
+func (*ConstructionGoodOverrideResponse) ProtoMessage() {}
 
-func (x *StartTradeRouteResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[136]
+func (x *ConstructionGoodOverrideResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[173]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -9621,74 +12681,86 @@ func (x *StartTradeRouteResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use StartTradeRouteResponse.ProtoReflect.Descriptor instead.
-func (*StartTradeRouteResponse) Descriptor() ([]byte, []int) {
-	return file_pkg_proto_daemon_daemon_proto_rawDescGZIP(), []int{136}
+// Deprecated: Use ConstructionGoodOverrideResponse.ProtoReflect.Descriptor instead.
+func (*ConstructionGoodOverrideResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_daemon_daemon_proto_rawDescGZIP(), []int{173}
 }
 
-func (x *StartTradeRouteResponse) GetContainerId() string {
+func (x *ConstructionGoodOverrideResponse) GetConstructionSite() string {
 	if x != nil {
-		return x.ContainerId
+		return x.ConstructionSite
 	}
 	return ""
 }
 
-func (x *StartTradeRouteResponse) GetShipSymbol() string {
+func (x *ConstructionGoodOverrideResponse) GetGood() string {
 	if x != nil {
-		return x.ShipSymbol
+		return x.Good
 	}
 	return ""
 }
 
-func (x *StartTradeRouteResponse) GetSystemSymbol() string {
+func (x *ConstructionGoodOverrideResponse) GetCleared() bool {
 	if x != nil {
-		return x.SystemSymbol
+		return x.Cleared
 	}
-	return ""
+	return false
 }
 
-func (x *StartTradeRouteResponse) GetStatus() string {
+func (x *ConstructionGoodOverrideResponse) GetChanged() bool {
 	if x != nil {
-		return x.Status
+		return x.Changed
+	}
+	return false
+}
+
+func (x *ConstructionGoodOverrideResponse) GetStrategy() string {
+	if x != nil {
+		return x.Strategy
 	}
 	return ""
 }
 
-func (x *StartTradeRouteResponse) GetMessage() string {
+func (x *ConstructionGoodOverrideResponse) GetPriceCeilingMult() float64 {
 	if x != nil {
-		return x.Message
+		return x.PriceCeilingMult
+	}
+	return 0
+}
+
+func (x *ConstructionGoodOverrideResponse) GetMinSupply() string {
+	if x != nil {
+		return x.MinSupply
 	}
 	return ""
 }
 
-// StartWarehouseRequest starts a passive inventory warehouse (sp-dchv Lane B) on an
-// idle, dedicated storage hull parked at a home waypoint. The hull buffers the
-// whitelisted goods deposited by tour/trade legs; contract workers withdraw from it.
-type StartWarehouseRequest struct {
-	state          protoimpl.MessageState `protogen:"open.v1"`
-	PlayerId       int32                  `protobuf:"varint,1,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"`
-	ShipSymbol     string                 `protobuf:"bytes,2,opt,name=ship_symbol,json=shipSymbol,proto3" json:"ship_symbol,omitempty"`             // Idle dedicated storage hull
-	WaypointSymbol string                 `protobuf:"bytes,3,opt,name=waypoint_symbol,json=waypointSymbol,proto3" json:"waypoint_symbol,omitempty"` // Home waypoint to park the hull at
-	SupportedGoods []string               `protobuf:"bytes,4,rep,name=supported_goods,json=supportedGoods,proto3" json:"supported_goods,omitempty"` // Goods whitelist this warehouse buffers
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+// DepotElement is one placed depot member: a {waypoint, ship} pair. ship_symbol may
+// be empty for a declared-but-uncrewed slot. Nothing here is hardcoded — the whole
+// topology is operator data.
+type DepotElement struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Waypoint      string                 `protobuf:"bytes,1,opt,name=waypoint,proto3" json:"waypoint,omitempty"`
+	ShipSymbol    string                 `protobuf:"bytes,2,opt,name=ship_symbol,json=shipSymbol,proto3" json:"ship_symbol,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *StartWarehouseRequest) Reset() {
-	*x = StartWarehouseRequest{}
-	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[137]
+func (x *DepotElement) Reset() {
+	*x = DepotElement{}
+	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[174]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *StartWarehouseRequest) String() string {
+func (x *DepotElement) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*StartWarehouseRequest) ProtoMessage() {}
+func (*DepotElement) ProtoMessage() {}
 
-func (x *StartWarehouseRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[137]
+func (x *DepotElement) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[174]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -9699,66 +12771,54 @@ func (x *StartWarehouseRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use StartWarehouseRequest.ProtoReflect.Descriptor instead.
-func (*StartWarehouseRequest) Descriptor() ([]byte, []int) {
-	return file_pkg_proto_daemon_daemon_proto_rawDescGZIP(), []int{137}
-}
-
-func (x *StartWarehouseRequest) GetPlayerId() int32 {
-	if x != nil {
-		return x.PlayerId
-	}
-	return 0
+// Deprecated: Use DepotElement.ProtoReflect.Descriptor instead.
+func (*DepotElement) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_daemon_daemon_proto_rawDescGZIP(), []int{174}
 }
 
-func (x *StartWarehouseRequest) GetShipSymbol() string {
+func (x *DepotElement) GetWaypoint() string {
 	if x != nil {
-		return x.ShipSymbol
+		return x.Waypoint
 	}
 	return ""
 }
 
-func (x *StartWarehouseRequest) GetWaypointSymbol() string {
+func (x *DepotElement) GetShipSymbol() string {
 	if x != nil {
-		return x.WaypointSymbol
+		return x.ShipSymbol
 	}
 	return ""
 }
 
-func (x *StartWarehouseRequest) GetSupportedGoods() []string {
-	if x != nil {
-		return x.SupportedGoods
-	}
-	return nil
-}
-
-// StartWarehouseResponse returns the started warehouse container
-type StartWarehouseResponse struct {
-	state          protoimpl.MessageState `protogen:"open.v1"`
-	ContainerId    string                 `protobuf:"bytes,1,opt,name=container_id,json=containerId,proto3" json:"container_id,omitempty"`
-	ShipSymbol     string                 `protobuf:"bytes,2,opt,name=ship_symbol,json=shipSymbol,proto3" json:"ship_symbol,omitempty"`
-	WaypointSymbol string                 `protobuf:"bytes,3,opt,name=waypoint_symbol,json=waypointSymbol,proto3" json:"waypoint_symbol,omitempty"`
-	Status         string                 `protobuf:"bytes,4,opt,name=status,proto3" json:"status,omitempty"`
-	Message        string                 `protobuf:"bytes,5,opt,name=message,proto3" json:"message,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+// DepotSpec is one whole depot: its id and its four element classes (destination
+// warehouses — the routing anchor, at least one — background stockers, pinned delivery
+// hulls, and source hubs).
+type DepotSpec struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Warehouses    []*DepotElement        `protobuf:"bytes,2,rep,name=warehouses,proto3" json:"warehouses,omitempty"`
+	Stockers      []*DepotElement        `protobuf:"bytes,3,rep,name=stockers,proto3" json:"stockers,omitempty"`
+	DeliveryHulls []*DepotElement        `protobuf:"bytes,4,rep,name=delivery_hulls,json=deliveryHulls,proto3" json:"delivery_hulls,omitempty"`
+	SourceHubs    []*DepotElement        `protobuf:"bytes,5,rep,name=source_hubs,json=sourceHubs,proto3" json:"source_hubs,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *StartWarehouseResponse) Reset() {
-	*x = StartWarehouseResponse{}
-	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[138]
+func (x *DepotSpec) Reset() {
+	*x = DepotSpec{}
+	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[175]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *StartWarehouseResponse) String() string {
+func (x *DepotSpec) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*StartWarehouseResponse) ProtoMessage() {}
+func (*DepotSpec) ProtoMessage() {}
 
-func (x *StartWarehouseResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[138]
+func (x *DepotSpec) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[175]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -9769,192 +12829,131 @@ func (x *StartWarehouseResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use StartWarehouseResponse.ProtoReflect.Descriptor instead.
-func (*StartWarehouseResponse) Descriptor() ([]byte, []int) {
-	return file_pkg_proto_daemon_daemon_proto_rawDescGZIP(), []int{138}
+// Deprecated: Use DepotSpec.ProtoReflect.Descriptor instead.
+func (*DepotSpec) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_daemon_daemon_proto_rawDescGZIP(), []int{175}
 }
 
-func (x *StartWarehouseResponse) GetContainerId() string {
+func (x *DepotSpec) GetId() string {
 	if x != nil {
-		return x.ContainerId
+		return x.Id
 	}
 	return ""
 }
 
-func (x *StartWarehouseResponse) GetShipSymbol() string {
+func (x *DepotSpec) GetWarehouses() []*DepotElement {
 	if x != nil {
-		return x.ShipSymbol
+		return x.Warehouses
 	}
-	return ""
+	return nil
 }
 
-func (x *StartWarehouseResponse) GetWaypointSymbol() string {
+func (x *DepotSpec) GetStockers() []*DepotElement {
 	if x != nil {
-		return x.WaypointSymbol
+		return x.Stockers
 	}
-	return ""
+	return nil
 }
 
-func (x *StartWarehouseResponse) GetStatus() string {
+func (x *DepotSpec) GetDeliveryHulls() []*DepotElement {
 	if x != nil {
-		return x.Status
+		return x.DeliveryHulls
 	}
-	return ""
+	return nil
 }
 
-func (x *StartWarehouseResponse) GetMessage() string {
+func (x *DepotSpec) GetSourceHubs() []*DepotElement {
 	if x != nil {
-		return x.Message
+		return x.SourceHubs
 	}
-	return ""
+	return nil
 }
 
-// StartArbRunRequest starts a one-shot, captain-directed, guarded arbitrage run (sp-p4ua):
-// fly THIS hull on THIS lane, ONCE, safely. All caps/floors are optional guards.
-type StartArbRunRequest struct {
-	state                 protoimpl.MessageState `protogen:"open.v1"`
-	PlayerId              int32                  `protobuf:"varint,1,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"`
-	ShipSymbol            string                 `protobuf:"bytes,2,opt,name=ship_symbol,json=shipSymbol,proto3" json:"ship_symbol,omitempty"` // Idle hull that must already be at buy_at
-	Good                  string                 `protobuf:"bytes,3,opt,name=good,proto3" json:"good,omitempty"`                               // The good to buy at the source and sell at the destination
-	BuyAt                 string                 `protobuf:"bytes,4,opt,name=buy_at,json=buyAt,proto3" json:"buy_at,omitempty"`                // Source waypoint (the hull must already be here)
-	SellAt                string                 `protobuf:"bytes,5,opt,name=sell_at,json=sellAt,proto3" json:"sell_at,omitempty"`             // Destination waypoint (may be in another system → gate+jump+refuel)
-	AgentSymbol           *string                `protobuf:"bytes,6,opt,name=agent_symbol,json=agentSymbol,proto3,oneof" json:"agent_symbol,omitempty"`
-	MaxUnits              *int32                 `protobuf:"varint,7,opt,name=max_units,json=maxUnits,proto3,oneof" json:"max_units,omitempty"`                                           // Hold-cap on the tranche (0/unset = full available cargo)
-	MaxSpend              *int32                 `protobuf:"varint,8,opt,name=max_spend,json=maxSpend,proto3,oneof" json:"max_spend,omitempty"`                                           // Working-capital cap on the buy (0/unset = no explicit cap)
-	MinMargin             *int32                 `protobuf:"varint,9,opt,name=min_margin,json=minMargin,proto3,oneof" json:"min_margin,omitempty"`                                        // Per-unit margin floor: abort if (destBid - sourceAsk) < this
-	WorkingCapitalReserve *int32                 `protobuf:"varint,10,opt,name=working_capital_reserve,json=workingCapitalReserve,proto3,oneof" json:"working_capital_reserve,omitempty"` // Hard spend floor (0/unset = coordinator default)
-	unknownFields         protoimpl.UnknownFields
-	sizeCache             protoimpl.SizeCache
+// ApplyDepotTopologyRequest is the DECLARATIVE bulk apply: it makes the player's
+// persisted set EXACTLY these depots (upsert each, delete any not named).
+type ApplyDepotTopologyRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PlayerId      int32                  `protobuf:"varint,1,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"`
+	AgentSymbol   *string                `protobuf:"bytes,2,opt,name=agent_symbol,json=agentSymbol,proto3,oneof" json:"agent_symbol,omitempty"`
+	Depots        []*DepotSpec           `protobuf:"bytes,3,rep,name=depots,proto3" json:"depots,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *StartArbRunRequest) Reset() {
-	*x = StartArbRunRequest{}
-	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[139]
+func (x *ApplyDepotTopologyRequest) Reset() {
+	*x = ApplyDepotTopologyRequest{}
+	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[176]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *StartArbRunRequest) String() string {
+func (x *ApplyDepotTopologyRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*StartArbRunRequest) ProtoMessage() {}
-
-func (x *StartArbRunRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[139]
-	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use StartArbRunRequest.ProtoReflect.Descriptor instead.
-func (*StartArbRunRequest) Descriptor() ([]byte, []int) {
-	return file_pkg_proto_daemon_daemon_proto_rawDescGZIP(), []int{139}
-}
-
-func (x *StartArbRunRequest) GetPlayerId() int32 {
-	if x != nil {
-		return x.PlayerId
-	}
-	return 0
-}
-
-func (x *StartArbRunRequest) GetShipSymbol() string {
-	if x != nil {
-		return x.ShipSymbol
-	}
-	return ""
-}
-
-func (x *StartArbRunRequest) GetGood() string {
+func (*ApplyDepotTopologyRequest) ProtoMessage() {}
+
+func (x *ApplyDepotTopologyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[176]
 	if x != nil {
-		return x.Good
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *StartArbRunRequest) GetBuyAt() string {
-	if x != nil {
-		return x.BuyAt
-	}
-	return ""
+// Deprecated: Use ApplyDepotTopologyRequest.ProtoReflect.Descriptor instead.
+func (*ApplyDepotTopologyRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_daemon_daemon_proto_rawDescGZIP(), []int{176}
 }
 
-func (x *StartArbRunRequest) GetSellAt() string {
+func (x *ApplyDepotTopologyRequest) GetPlayerId() int32 {
 	if x != nil {
-		return x.SellAt
+		return x.PlayerId
 	}
-	return ""
+	return 0
 }
 
-func (x *StartArbRunRequest) GetAgentSymbol() string {
+func (x *ApplyDepotTopologyRequest) GetAgentSymbol() string {
 	if x != nil && x.AgentSymbol != nil {
 		return *x.AgentSymbol
 	}
 	return ""
 }
 
-func (x *StartArbRunRequest) GetMaxUnits() int32 {
-	if x != nil && x.MaxUnits != nil {
-		return *x.MaxUnits
-	}
-	return 0
-}
-
-func (x *StartArbRunRequest) GetMaxSpend() int32 {
-	if x != nil && x.MaxSpend != nil {
-		return *x.MaxSpend
-	}
-	return 0
-}
-
-func (x *StartArbRunRequest) GetMinMargin() int32 {
-	if x != nil && x.MinMargin != nil {
-		return *x.MinMargin
-	}
-	return 0
-}
-
-func (x *StartArbRunRequest) GetWorkingCapitalReserve() int32 {
-	if x != nil && x.WorkingCapitalReserve != nil {
-		return *x.WorkingCapitalReserve
+func (x *ApplyDepotTopologyRequest) GetDepots() []*DepotSpec {
+	if x != nil {
+		return x.Depots
 	}
-	return 0
+	return nil
 }
 
-type StartArbRunResponse struct {
+type ApplyDepotTopologyResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	ContainerId   string                 `protobuf:"bytes,1,opt,name=container_id,json=containerId,proto3" json:"container_id,omitempty"`
-	ShipSymbol    string                 `protobuf:"bytes,2,opt,name=ship_symbol,json=shipSymbol,proto3" json:"ship_symbol,omitempty"`
-	Good          string                 `protobuf:"bytes,3,opt,name=good,proto3" json:"good,omitempty"`
-	BuyAt         string                 `protobuf:"bytes,4,opt,name=buy_at,json=buyAt,proto3" json:"buy_at,omitempty"`
-	SellAt        string                 `protobuf:"bytes,5,opt,name=sell_at,json=sellAt,proto3" json:"sell_at,omitempty"`
-	Status        string                 `protobuf:"bytes,6,opt,name=status,proto3" json:"status,omitempty"`
-	Message       string                 `protobuf:"bytes,7,opt,name=message,proto3" json:"message,omitempty"`
+	Status        string                 `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	DepotCount    int32                  `protobuf:"varint,2,opt,name=depot_count,json=depotCount,proto3" json:"depot_count,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *StartArbRunResponse) Reset() {
-	*x = StartArbRunResponse{}
-	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[140]
+func (x *ApplyDepotTopologyResponse) Reset() {
+	*x = ApplyDepotTopologyResponse{}
+	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[177]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *StartArbRunResponse) String() string {
+func (x *ApplyDepotTopologyResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*StartArbRunResponse) ProtoMessage() {}
+func (*ApplyDepotTopologyResponse) ProtoMessage() {}
 
-func (x *StartArbRunResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[140]
+func (x *ApplyDepotTopologyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[177]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -9965,93 +12964,108 @@ func (x *StartArbRunResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use StartArbRunResponse.ProtoReflect.Descriptor instead.
-func (*StartArbRunResponse) Descriptor() ([]byte, []int) {
-	return file_pkg_proto_daemon_daemon_proto_rawDescGZIP(), []int{140}
+// Deprecated: Use ApplyDepotTopologyResponse.ProtoReflect.Descriptor instead.
+func (*ApplyDepotTopologyResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_daemon_daemon_proto_rawDescGZIP(), []int{177}
 }
 
-func (x *StartArbRunResponse) GetContainerId() string {
+func (x *ApplyDepotTopologyResponse) GetStatus() string {
 	if x != nil {
-		return x.ContainerId
+		return x.Status
 	}
 	return ""
 }
 
-func (x *StartArbRunResponse) GetShipSymbol() string {
+func (x *ApplyDepotTopologyResponse) GetDepotCount() int32 {
 	if x != nil {
-		return x.ShipSymbol
+		return x.DepotCount
 	}
-	return ""
+	return 0
 }
 
-func (x *StartArbRunResponse) GetGood() string {
-	if x != nil {
-		return x.Good
-	}
-	return ""
+// AddDepotRequest adds ONE depot without disturbing the rest (granular create).
+type AddDepotRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PlayerId      int32                  `protobuf:"varint,1,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"`
+	AgentSymbol   *string                `protobuf:"bytes,2,opt,name=agent_symbol,json=agentSymbol,proto3,oneof" json:"agent_symbol,omitempty"`
+	Depot         *DepotSpec             `protobuf:"bytes,3,opt,name=depot,proto3" json:"depot,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *StartArbRunResponse) GetBuyAt() string {
+func (x *AddDepotRequest) Reset() {
+	*x = AddDepotRequest{}
+	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[178]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddDepotRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddDepotRequest) ProtoMessage() {}
+
+func (x *AddDepotRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[178]
 	if x != nil {
-		return x.BuyAt
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *StartArbRunResponse) GetSellAt() string {
+// Deprecated: Use AddDepotRequest.ProtoReflect.Descriptor instead.
+func (*AddDepotRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_daemon_daemon_proto_rawDescGZIP(), []int{178}
+}
+
+func (x *AddDepotRequest) GetPlayerId() int32 {
 	if x != nil {
-		return x.SellAt
+		return x.PlayerId
 	}
-	return ""
+	return 0
 }
 
-func (x *StartArbRunResponse) GetStatus() string {
-	if x != nil {
-		return x.Status
+func (x *AddDepotRequest) GetAgentSymbol() string {
+	if x != nil && x.AgentSymbol != nil {
+		return *x.AgentSymbol
 	}
 	return ""
 }
 
-func (x *StartArbRunResponse) GetMessage() string {
+func (x *AddDepotRequest) GetDepot() *DepotSpec {
 	if x != nil {
-		return x.Message
+		return x.Depot
 	}
-	return ""
+	return nil
 }
 
-// StartTourRunRequest starts a one-shot, captain-directed, guarded multi-hop trade
-// tour (sp-1ek0): fly ONE idle hull through a planner-chosen tour, guarded. All caps
-// and floors are optional (0/unset = coordinator default).
-type StartTourRunRequest struct {
-	state                 protoimpl.MessageState `protogen:"open.v1"`
-	PlayerId              int32                  `protobuf:"varint,1,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"`
-	ShipSymbol            string                 `protobuf:"bytes,2,opt,name=ship_symbol,json=shipSymbol,proto3" json:"ship_symbol,omitempty"` // Idle hull to fly the tour
-	AgentSymbol           *string                `protobuf:"bytes,3,opt,name=agent_symbol,json=agentSymbol,proto3,oneof" json:"agent_symbol,omitempty"`
-	MaxHops               *int32                 `protobuf:"varint,4,opt,name=max_hops,json=maxHops,proto3,oneof" json:"max_hops,omitempty"`                                             // 0/unset = planner default (6)
-	MaxSpend              *int64                 `protobuf:"varint,5,opt,name=max_spend,json=maxSpend,proto3,oneof" json:"max_spend,omitempty"`                                          // 0/unset = 25% of live treasury (re-resolved per tour when iterations != 0/1)
-	MinMargin             *int32                 `protobuf:"varint,6,opt,name=min_margin,json=minMargin,proto3,oneof" json:"min_margin,omitempty"`                                       // Per-unit margin floor passed to the planner
-	ReplanLimit           *int32                 `protobuf:"varint,7,opt,name=replan_limit,json=replanLimit,proto3,oneof" json:"replan_limit,omitempty"`                                 // 0/unset = coordinator default (2), PER TOUR
-	WorkingCapitalReserve *int64                 `protobuf:"varint,8,opt,name=working_capital_reserve,json=workingCapitalReserve,proto3,oneof" json:"working_capital_reserve,omitempty"` // Hard spend floor (0/unset = coordinator default)
-	Iterations            *int32                 `protobuf:"varint,9,opt,name=iterations,proto3,oneof" json:"iterations,omitempty"`                                                      // sp-m5kv: -1 = CONTINUOUS (tour until margins die), N>0 = N tours, 0/unset = one tour
-	unknownFields         protoimpl.UnknownFields
-	sizeCache             protoimpl.SizeCache
+type AddDepotResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        string                 `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *StartTourRunRequest) Reset() {
-	*x = StartTourRunRequest{}
-	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[141]
+func (x *AddDepotResponse) Reset() {
+	*x = AddDepotResponse{}
+	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[179]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *StartTourRunRequest) String() string {
+func (x *AddDepotResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*StartTourRunRequest) ProtoMessage() {}
+func (*AddDepotResponse) ProtoMessage() {}
 
-func (x *StartTourRunRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[141]
+func (x *AddDepotResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[179]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -10062,99 +13076,101 @@ func (x *StartTourRunRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use StartTourRunRequest.ProtoReflect.Descriptor instead.
-func (*StartTourRunRequest) Descriptor() ([]byte, []int) {
-	return file_pkg_proto_daemon_daemon_proto_rawDescGZIP(), []int{141}
+// Deprecated: Use AddDepotResponse.ProtoReflect.Descriptor instead.
+func (*AddDepotResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_daemon_daemon_proto_rawDescGZIP(), []int{179}
 }
 
-func (x *StartTourRunRequest) GetPlayerId() int32 {
+func (x *AddDepotResponse) GetStatus() string {
 	if x != nil {
-		return x.PlayerId
+		return x.Status
 	}
-	return 0
+	return ""
 }
 
-func (x *StartTourRunRequest) GetShipSymbol() string {
-	if x != nil {
-		return x.ShipSymbol
-	}
-	return ""
+// RemoveDepotRequest deletes ONE depot by id (granular, idempotent).
+type RemoveDepotRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PlayerId      int32                  `protobuf:"varint,1,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"`
+	AgentSymbol   *string                `protobuf:"bytes,2,opt,name=agent_symbol,json=agentSymbol,proto3,oneof" json:"agent_symbol,omitempty"`
+	DepotId       string                 `protobuf:"bytes,3,opt,name=depot_id,json=depotId,proto3" json:"depot_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *StartTourRunRequest) GetAgentSymbol() string {
-	if x != nil && x.AgentSymbol != nil {
-		return *x.AgentSymbol
-	}
-	return ""
+func (x *RemoveDepotRequest) Reset() {
+	*x = RemoveDepotRequest{}
+	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[180]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *StartTourRunRequest) GetMaxHops() int32 {
-	if x != nil && x.MaxHops != nil {
-		return *x.MaxHops
-	}
-	return 0
+func (x *RemoveDepotRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *StartTourRunRequest) GetMaxSpend() int64 {
-	if x != nil && x.MaxSpend != nil {
-		return *x.MaxSpend
+func (*RemoveDepotRequest) ProtoMessage() {}
+
+func (x *RemoveDepotRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[180]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *StartTourRunRequest) GetMinMargin() int32 {
-	if x != nil && x.MinMargin != nil {
-		return *x.MinMargin
-	}
-	return 0
+// Deprecated: Use RemoveDepotRequest.ProtoReflect.Descriptor instead.
+func (*RemoveDepotRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_daemon_daemon_proto_rawDescGZIP(), []int{180}
 }
 
-func (x *StartTourRunRequest) GetReplanLimit() int32 {
-	if x != nil && x.ReplanLimit != nil {
-		return *x.ReplanLimit
+func (x *RemoveDepotRequest) GetPlayerId() int32 {
+	if x != nil {
+		return x.PlayerId
 	}
 	return 0
 }
 
-func (x *StartTourRunRequest) GetWorkingCapitalReserve() int64 {
-	if x != nil && x.WorkingCapitalReserve != nil {
-		return *x.WorkingCapitalReserve
+func (x *RemoveDepotRequest) GetAgentSymbol() string {
+	if x != nil && x.AgentSymbol != nil {
+		return *x.AgentSymbol
 	}
-	return 0
+	return ""
 }
 
-func (x *StartTourRunRequest) GetIterations() int32 {
-	if x != nil && x.Iterations != nil {
-		return *x.Iterations
+func (x *RemoveDepotRequest) GetDepotId() string {
+	if x != nil {
+		return x.DepotId
 	}
-	return 0
+	return ""
 }
 
-type StartTourRunResponse struct {
+type RemoveDepotResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	ContainerId   string                 `protobuf:"bytes,1,opt,name=container_id,json=containerId,proto3" json:"container_id,omitempty"`
-	ShipSymbol    string                 `protobuf:"bytes,2,opt,name=ship_symbol,json=shipSymbol,proto3" json:"ship_symbol,omitempty"`
-	Status        string                 `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`
-	Message       string                 `protobuf:"bytes,4,opt,name=message,proto3" json:"message,omitempty"`
+	Status        string                 `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *StartTourRunResponse) Reset() {
-	*x = StartTourRunResponse{}
-	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[142]
+func (x *RemoveDepotResponse) Reset() {
+	*x = RemoveDepotResponse{}
+	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[181]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *StartTourRunResponse) String() string {
+func (x *RemoveDepotResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*StartTourRunResponse) ProtoMessage() {}
+func (*RemoveDepotResponse) ProtoMessage() {}
 
-func (x *StartTourRunResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[142]
+func (x *RemoveDepotResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[181]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -10165,75 +13181,47 @@ func (x *StartTourRunResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use StartTourRunResponse.ProtoReflect.Descriptor instead.
-func (*StartTourRunResponse) Descriptor() ([]byte, []int) {
-	return file_pkg_proto_daemon_daemon_proto_rawDescGZIP(), []int{142}
-}
-
-func (x *StartTourRunResponse) GetContainerId() string {
-	if x != nil {
-		return x.ContainerId
-	}
-	return ""
-}
-
-func (x *StartTourRunResponse) GetShipSymbol() string {
-	if x != nil {
-		return x.ShipSymbol
-	}
-	return ""
+// Deprecated: Use RemoveDepotResponse.ProtoReflect.Descriptor instead.
+func (*RemoveDepotResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_daemon_daemon_proto_rawDescGZIP(), []int{181}
 }
 
-func (x *StartTourRunResponse) GetStatus() string {
+func (x *RemoveDepotResponse) GetStatus() string {
 	if x != nil {
 		return x.Status
 	}
 	return ""
 }
 
-func (x *StartTourRunResponse) GetMessage() string {
-	if x != nil {
-		return x.Message
-	}
-	return ""
-}
-
-// StartStockerRequest starts the STOCKER LOOP (sp-zdwg): a dedicated hull fills a home
-// warehouse with contract-recurrent goods bought cheap at foreign markets. All caps and
-// floors are optional (0/unset = coordinator default).
-type StartStockerRequest struct {
-	state                 protoimpl.MessageState `protogen:"open.v1"`
-	PlayerId              int32                  `protobuf:"varint,1,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"`
-	ShipSymbol            string                 `protobuf:"bytes,2,opt,name=ship_symbol,json=shipSymbol,proto3" json:"ship_symbol,omitempty"`                      // Dedicated hull to fly the stocker loop
-	WarehouseWaypoint     string                 `protobuf:"bytes,3,opt,name=warehouse_waypoint,json=warehouseWaypoint,proto3" json:"warehouse_waypoint,omitempty"` // Home warehouse waypoint to deposit into (its system is the demand anchor)
-	AgentSymbol           *string                `protobuf:"bytes,4,opt,name=agent_symbol,json=agentSymbol,proto3,oneof" json:"agent_symbol,omitempty"`
-	BudgetPerLeg          *int32                 `protobuf:"varint,5,opt,name=budget_per_leg,json=budgetPerLeg,proto3,oneof" json:"budget_per_leg,omitempty"`                            // Per-buy-leg spend cap in credits (0/unset = no explicit per-leg cap)
-	WorkingCapitalReserve *int64                 `protobuf:"varint,6,opt,name=working_capital_reserve,json=workingCapitalReserve,proto3,oneof" json:"working_capital_reserve,omitempty"` // Hard spend floor (0/unset = coordinator default, 50k)
-	Iterations            *int32                 `protobuf:"varint,7,opt,name=iterations,proto3,oneof" json:"iterations,omitempty"`                                                      // -1 = CONTINUOUS (fill until nothing left to stock), N>0 = N round-trips, 0/unset = one round-trip
-	MaxMarketAgeMinutes   *int32                 `protobuf:"varint,8,opt,name=max_market_age_minutes,json=maxMarketAgeMinutes,proto3,oneof" json:"max_market_age_minutes,omitempty"`     // Freshness cap on the foreign ask (0/unset = 75)
-	TargetPerGood         *int32                 `protobuf:"varint,9,opt,name=target_per_good,json=targetPerGood,proto3,oneof" json:"target_per_good,omitempty"`                         // Fill-target override per good (0/unset = the miner's measured demand units)
-	Standing              *bool                  `protobuf:"varint,10,opt,name=standing,proto3,oneof" json:"standing,omitempty"`                                                         // STANDING refill (sp-k1ka): never completes at target — parks and auto-re-stages when contracts drain the warehouse below target; survives restart (re-adopted standing from persisted config). No manual relaunch.
-	TickSeconds           *int32                 `protobuf:"varint,11,opt,name=tick_seconds,json=tickSeconds,proto3,oneof" json:"tick_seconds,omitempty"`                                // STANDING park cadence between at-target re-checks (0/unset = 30s)
-	RefillHysteresis      *int32                 `protobuf:"varint,12,opt,name=refill_hysteresis,json=refillHysteresis,proto3,oneof" json:"refill_hysteresis,omitempty"`                 // STANDING target-hysteresis: minimum units-short before re-staging a good (0/unset = 1), so a tiny gap does not thrash a refill
-	unknownFields         protoimpl.UnknownFields
-	sizeCache             protoimpl.SizeCache
+// AddDepotElementRequest adds one element to a depot's named role (warehouse |
+// stocker | delivery-hull | source-hub).
+type AddDepotElementRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PlayerId      int32                  `protobuf:"varint,1,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"`
+	AgentSymbol   *string                `protobuf:"bytes,2,opt,name=agent_symbol,json=agentSymbol,proto3,oneof" json:"agent_symbol,omitempty"`
+	DepotId       string                 `protobuf:"bytes,3,opt,name=depot_id,json=depotId,proto3" json:"depot_id,omitempty"`
+	Role          string                 `protobuf:"bytes,4,opt,name=role,proto3" json:"role,omitempty"`
+	Waypoint      string                 `protobuf:"bytes,5,opt,name=waypoint,proto3" json:"waypoint,omitempty"`
+	ShipSymbol    string                 `protobuf:"bytes,6,opt,name=ship_symbol,json=shipSymbol,proto3" json:"ship_symbol,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *StartStockerRequest) Reset() {
-	*x = StartStockerRequest{}
-	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[143]
+func (x *AddDepotElementRequest) Reset() {
+	*x = AddDepotElementRequest{}
+	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[182]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *StartStockerRequest) String() string {
+func (x *AddDepotElementRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*StartStockerRequest) ProtoMessage() {}
+func (*AddDepotElementRequest) ProtoMessage() {}
 
-func (x *StartStockerRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[143]
+func (x *AddDepotElementRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[182]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -10244,121 +13232,159 @@ func (x *StartStockerRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use StartStockerRequest.ProtoReflect.Descriptor instead.
-func (*StartStockerRequest) Descriptor() ([]byte, []int) {
-	return file_pkg_proto_daemon_daemon_proto_rawDescGZIP(), []int{143}
+// Deprecated: Use AddDepotElementRequest.ProtoReflect.Descriptor instead.
+func (*AddDepotElementRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_daemon_daemon_proto_rawDescGZIP(), []int{182}
 }
 
-func (x *StartStockerRequest) GetPlayerId() int32 {
+func (x *AddDepotElementRequest) GetPlayerId() int32 {
 	if x != nil {
 		return x.PlayerId
 	}
 	return 0
 }
 
-func (x *StartStockerRequest) GetShipSymbol() string {
-	if x != nil {
-		return x.ShipSymbol
+func (x *AddDepotElementRequest) GetAgentSymbol() string {
+	if x != nil && x.AgentSymbol != nil {
+		return *x.AgentSymbol
 	}
 	return ""
 }
 
-func (x *StartStockerRequest) GetWarehouseWaypoint() string {
+func (x *AddDepotElementRequest) GetDepotId() string {
 	if x != nil {
-		return x.WarehouseWaypoint
+		return x.DepotId
 	}
 	return ""
 }
 
-func (x *StartStockerRequest) GetAgentSymbol() string {
-	if x != nil && x.AgentSymbol != nil {
-		return *x.AgentSymbol
+func (x *AddDepotElementRequest) GetRole() string {
+	if x != nil {
+		return x.Role
 	}
 	return ""
 }
 
-func (x *StartStockerRequest) GetBudgetPerLeg() int32 {
-	if x != nil && x.BudgetPerLeg != nil {
-		return *x.BudgetPerLeg
+func (x *AddDepotElementRequest) GetWaypoint() string {
+	if x != nil {
+		return x.Waypoint
 	}
-	return 0
+	return ""
 }
 
-func (x *StartStockerRequest) GetWorkingCapitalReserve() int64 {
-	if x != nil && x.WorkingCapitalReserve != nil {
-		return *x.WorkingCapitalReserve
+func (x *AddDepotElementRequest) GetShipSymbol() string {
+	if x != nil {
+		return x.ShipSymbol
 	}
-	return 0
+	return ""
 }
 
-func (x *StartStockerRequest) GetIterations() int32 {
-	if x != nil && x.Iterations != nil {
-		return *x.Iterations
+// RemoveDepotElementRequest drops the element crewed by ship_symbol from a role.
+type RemoveDepotElementRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PlayerId      int32                  `protobuf:"varint,1,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"`
+	AgentSymbol   *string                `protobuf:"bytes,2,opt,name=agent_symbol,json=agentSymbol,proto3,oneof" json:"agent_symbol,omitempty"`
+	DepotId       string                 `protobuf:"bytes,3,opt,name=depot_id,json=depotId,proto3" json:"depot_id,omitempty"`
+	Role          string                 `protobuf:"bytes,4,opt,name=role,proto3" json:"role,omitempty"`
+	ShipSymbol    string                 `protobuf:"bytes,5,opt,name=ship_symbol,json=shipSymbol,proto3" json:"ship_symbol,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveDepotElementRequest) Reset() {
+	*x = RemoveDepotElementRequest{}
+	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[183]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveDepotElementRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveDepotElementRequest) ProtoMessage() {}
+
+func (x *RemoveDepotElementRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[183]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *StartStockerRequest) GetMaxMarketAgeMinutes() int32 {
-	if x != nil && x.MaxMarketAgeMinutes != nil {
-		return *x.MaxMarketAgeMinutes
+// Deprecated: Use RemoveDepotElementRequest.ProtoReflect.Descriptor instead.
+func (*RemoveDepotElementRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_daemon_daemon_proto_rawDescGZIP(), []int{183}
+}
+
+func (x *RemoveDepotElementRequest) GetPlayerId() int32 {
+	if x != nil {
+		return x.PlayerId
 	}
 	return 0
 }
 
-func (x *StartStockerRequest) GetTargetPerGood() int32 {
-	if x != nil && x.TargetPerGood != nil {
-		return *x.TargetPerGood
+func (x *RemoveDepotElementRequest) GetAgentSymbol() string {
+	if x != nil && x.AgentSymbol != nil {
+		return *x.AgentSymbol
 	}
-	return 0
+	return ""
 }
 
-func (x *StartStockerRequest) GetStanding() bool {
-	if x != nil && x.Standing != nil {
-		return *x.Standing
+func (x *RemoveDepotElementRequest) GetDepotId() string {
+	if x != nil {
+		return x.DepotId
 	}
-	return false
+	return ""
 }
 
-func (x *StartStockerRequest) GetTickSeconds() int32 {
-	if x != nil && x.TickSeconds != nil {
-		return *x.TickSeconds
+func (x *RemoveDepotElementRequest) GetRole() string {
+	if x != nil {
+		return x.Role
 	}
-	return 0
+	return ""
 }
 
-func (x *StartStockerRequest) GetRefillHysteresis() int32 {
-	if x != nil && x.RefillHysteresis != nil {
-		return *x.RefillHysteresis
+func (x *RemoveDepotElementRequest) GetShipSymbol() string {
+	if x != nil {
+		return x.ShipSymbol
 	}
-	return 0
+	return ""
 }
 
-type StartStockerResponse struct {
-	state             protoimpl.MessageState `protogen:"open.v1"`
-	ContainerId       string                 `protobuf:"bytes,1,opt,name=container_id,json=containerId,proto3" json:"container_id,omitempty"`
-	ShipSymbol        string                 `protobuf:"bytes,2,opt,name=ship_symbol,json=shipSymbol,proto3" json:"ship_symbol,omitempty"`
-	WarehouseWaypoint string                 `protobuf:"bytes,3,opt,name=warehouse_waypoint,json=warehouseWaypoint,proto3" json:"warehouse_waypoint,omitempty"`
-	Status            string                 `protobuf:"bytes,4,opt,name=status,proto3" json:"status,omitempty"`
-	Message           string                 `protobuf:"bytes,5,opt,name=message,proto3" json:"message,omitempty"`
-	unknownFields     protoimpl.UnknownFields
-	sizeCache         protoimpl.SizeCache
+// PlaceDepotElementRequest repositions the element crewed by ship_symbol in a role to
+// waypoint (the parametrized co-location op).
+type PlaceDepotElementRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PlayerId      int32                  `protobuf:"varint,1,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"`
+	AgentSymbol   *string                `protobuf:"bytes,2,opt,name=agent_symbol,json=agentSymbol,proto3,oneof" json:"agent_symbol,omitempty"`
+	DepotId       string                 `protobuf:"bytes,3,opt,name=depot_id,json=depotId,proto3" json:"depot_id,omitempty"`
+	Role          string                 `protobuf:"bytes,4,opt,name=role,proto3" json:"role,omitempty"`
+	ShipSymbol    string                 `protobuf:"bytes,5,opt,name=ship_symbol,json=shipSymbol,proto3" json:"ship_symbol,omitempty"`
+	Waypoint      string                 `protobuf:"bytes,6,opt,name=waypoint,proto3" json:"waypoint,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *StartStockerResponse) Reset() {
-	*x = StartStockerResponse{}
-	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[144]
+func (x *PlaceDepotElementRequest) Reset() {
+	*x = PlaceDepotElementRequest{}
+	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[184]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *StartStockerResponse) String() string {
+func (x *PlaceDepotElementRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*StartStockerResponse) ProtoMessage() {}
+func (*PlaceDepotElementRequest) ProtoMessage() {}
 
-func (x *StartStockerResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[144]
+func (x *PlaceDepotElementRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[184]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -10369,76 +13395,76 @@ func (x *StartStockerResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use StartStockerResponse.ProtoReflect.Descriptor instead.
-func (*StartStockerResponse) Descriptor() ([]byte, []int) {
-	return file_pkg_proto_daemon_daemon_proto_rawDescGZIP(), []int{144}
+// Deprecated: Use PlaceDepotElementRequest.ProtoReflect.Descriptor instead.
+func (*PlaceDepotElementRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_daemon_daemon_proto_rawDescGZIP(), []int{184}
 }
 
-func (x *StartStockerResponse) GetContainerId() string {
+func (x *PlaceDepotElementRequest) GetPlayerId() int32 {
 	if x != nil {
-		return x.ContainerId
+		return x.PlayerId
+	}
+	return 0
+}
+
+func (x *PlaceDepotElementRequest) GetAgentSymbol() string {
+	if x != nil && x.AgentSymbol != nil {
+		return *x.AgentSymbol
 	}
 	return ""
 }
 
-func (x *StartStockerResponse) GetShipSymbol() string {
+func (x *PlaceDepotElementRequest) GetDepotId() string {
 	if x != nil {
-		return x.ShipSymbol
+		return x.DepotId
 	}
 	return ""
 }
 
-func (x *StartStockerResponse) GetWarehouseWaypoint() string {
+func (x *PlaceDepotElementRequest) GetRole() string {
 	if x != nil {
-		return x.WarehouseWaypoint
+		return x.Role
 	}
 	return ""
 }
 
-func (x *StartStockerResponse) GetStatus() string {
+func (x *PlaceDepotElementRequest) GetShipSymbol() string {
 	if x != nil {
-		return x.Status
+		return x.ShipSymbol
 	}
 	return ""
 }
 
-func (x *StartStockerResponse) GetMessage() string {
+func (x *PlaceDepotElementRequest) GetWaypoint() string {
 	if x != nil {
-		return x.Message
+		return x.Waypoint
 	}
 	return ""
 }
 
-// GasExtractionOperationRequest starts a gas extraction operation with siphon and transport ships
-type GasExtractionOperationRequest struct {
-	state          protoimpl.MessageState `protogen:"open.v1"`
-	GasGiant       *string                `protobuf:"bytes,1,opt,name=gas_giant,json=gasGiant,proto3,oneof" json:"gas_giant,omitempty"`             // Optional: specific waypoint, or auto-select
-	SiphonShips    []string               `protobuf:"bytes,2,rep,name=siphon_ships,json=siphonShips,proto3" json:"siphon_ships,omitempty"`          // Ships for gas siphoning (need SIPHON mount + GAS_PROCESSOR)
-	TransportShips []string               `protobuf:"bytes,3,rep,name=transport_ships,json=transportShips,proto3" json:"transport_ships,omitempty"` // Ships for transport to factories
-	PlayerId       int32                  `protobuf:"varint,4,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"`
-	AgentSymbol    *string                `protobuf:"bytes,5,opt,name=agent_symbol,json=agentSymbol,proto3,oneof" json:"agent_symbol,omitempty"`
-	Force          bool                   `protobuf:"varint,6,opt,name=force,proto3" json:"force,omitempty"`                               // Override fuel validation warnings
-	DryRun         bool                   `protobuf:"varint,7,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`               // If true, only select gas giant without starting operation
-	MaxLegTime     int32                  `protobuf:"varint,8,opt,name=max_leg_time,json=maxLegTime,proto3" json:"max_leg_time,omitempty"` // Max time per leg in minutes (0 = no limit)
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+// DepotElementResponse is the shared reply for the granular element ops.
+type DepotElementResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        string                 `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GasExtractionOperationRequest) Reset() {
-	*x = GasExtractionOperationRequest{}
-	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[145]
+func (x *DepotElementResponse) Reset() {
+	*x = DepotElementResponse{}
+	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[185]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GasExtractionOperationRequest) String() string {
+func (x *DepotElementResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GasExtractionOperationRequest) ProtoMessage() {}
+func (*DepotElementResponse) ProtoMessage() {}
 
-func (x *GasExtractionOperationRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[145]
+func (x *DepotElementResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[185]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -10449,97 +13475,41 @@ func (x *GasExtractionOperationRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GasExtractionOperationRequest.ProtoReflect.Descriptor instead.
-func (*GasExtractionOperationRequest) Descriptor() ([]byte, []int) {
-	return file_pkg_proto_daemon_daemon_proto_rawDescGZIP(), []int{145}
-}
-
-func (x *GasExtractionOperationRequest) GetGasGiant() string {
-	if x != nil && x.GasGiant != nil {
-		return *x.GasGiant
-	}
-	return ""
-}
-
-func (x *GasExtractionOperationRequest) GetSiphonShips() []string {
-	if x != nil {
-		return x.SiphonShips
-	}
-	return nil
-}
-
-func (x *GasExtractionOperationRequest) GetTransportShips() []string {
-	if x != nil {
-		return x.TransportShips
-	}
-	return nil
+// Deprecated: Use DepotElementResponse.ProtoReflect.Descriptor instead.
+func (*DepotElementResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_daemon_daemon_proto_rawDescGZIP(), []int{185}
 }
 
-func (x *GasExtractionOperationRequest) GetPlayerId() int32 {
+func (x *DepotElementResponse) GetStatus() string {
 	if x != nil {
-		return x.PlayerId
-	}
-	return 0
-}
-
-func (x *GasExtractionOperationRequest) GetAgentSymbol() string {
-	if x != nil && x.AgentSymbol != nil {
-		return *x.AgentSymbol
+		return x.Status
 	}
 	return ""
 }
 
-func (x *GasExtractionOperationRequest) GetForce() bool {
-	if x != nil {
-		return x.Force
-	}
-	return false
-}
-
-func (x *GasExtractionOperationRequest) GetDryRun() bool {
-	if x != nil {
-		return x.DryRun
-	}
-	return false
-}
-
-func (x *GasExtractionOperationRequest) GetMaxLegTime() int32 {
-	if x != nil {
-		return x.MaxLegTime
-	}
-	return 0
-}
-
-// GasExtractionOperationResponse returns the result of starting a gas extraction operation
-type GasExtractionOperationResponse struct {
-	state          protoimpl.MessageState `protogen:"open.v1"`
-	ContainerId    string                 `protobuf:"bytes,1,opt,name=container_id,json=containerId,proto3" json:"container_id,omitempty"`
-	GasGiant       string                 `protobuf:"bytes,2,opt,name=gas_giant,json=gasGiant,proto3" json:"gas_giant,omitempty"`
-	SiphonShips    []string               `protobuf:"bytes,3,rep,name=siphon_ships,json=siphonShips,proto3" json:"siphon_ships,omitempty"`
-	TransportShips []string               `protobuf:"bytes,4,rep,name=transport_ships,json=transportShips,proto3" json:"transport_ships,omitempty"`
-	Status         string                 `protobuf:"bytes,5,opt,name=status,proto3" json:"status,omitempty"`
-	// Dry-run results
-	ShipRoutes    []*ShipRoute `protobuf:"bytes,6,rep,name=ship_routes,json=shipRoutes,proto3" json:"ship_routes,omitempty"` // Planned routes for all ships
-	Errors        []string     `protobuf:"bytes,7,rep,name=errors,proto3" json:"errors,omitempty"`                           // Any errors during route planning
+type ListDepotsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PlayerId      int32                  `protobuf:"varint,1,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"`
+	AgentSymbol   *string                `protobuf:"bytes,2,opt,name=agent_symbol,json=agentSymbol,proto3,oneof" json:"agent_symbol,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GasExtractionOperationResponse) Reset() {
-	*x = GasExtractionOperationResponse{}
-	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[146]
+func (x *ListDepotsRequest) Reset() {
+	*x = ListDepotsRequest{}
+	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[186]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GasExtractionOperationResponse) String() string {
+func (x *ListDepotsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GasExtractionOperationResponse) ProtoMessage() {}
+func (*ListDepotsRequest) ProtoMessage() {}
 
-func (x *GasExtractionOperationResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[146]
+func (x *ListDepotsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[186]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -10550,105 +13520,94 @@ func (x *GasExtractionOperationResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GasExtractionOperationResponse.ProtoReflect.Descriptor instead.
-func (*GasExtractionOperationResponse) Descriptor() ([]byte, []int) {
-	return file_pkg_proto_daemon_daemon_proto_rawDescGZIP(), []int{146}
+// Deprecated: Use ListDepotsRequest.ProtoReflect.Descriptor instead.
+func (*ListDepotsRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_daemon_daemon_proto_rawDescGZIP(), []int{186}
 }
 
-func (x *GasExtractionOperationResponse) GetContainerId() string {
+func (x *ListDepotsRequest) GetPlayerId() int32 {
 	if x != nil {
-		return x.ContainerId
+		return x.PlayerId
 	}
-	return ""
+	return 0
 }
 
-func (x *GasExtractionOperationResponse) GetGasGiant() string {
-	if x != nil {
-		return x.GasGiant
+func (x *ListDepotsRequest) GetAgentSymbol() string {
+	if x != nil && x.AgentSymbol != nil {
+		return *x.AgentSymbol
 	}
 	return ""
 }
 
-func (x *GasExtractionOperationResponse) GetSiphonShips() []string {
-	if x != nil {
-		return x.SiphonShips
-	}
-	return nil
+type ListDepotsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Depots        []*DepotSpec           `protobuf:"bytes,1,rep,name=depots,proto3" json:"depots,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GasExtractionOperationResponse) GetTransportShips() []string {
-	if x != nil {
-		return x.TransportShips
-	}
-	return nil
+func (x *ListDepotsResponse) Reset() {
+	*x = ListDepotsResponse{}
+	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[187]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *GasExtractionOperationResponse) GetStatus() string {
-	if x != nil {
-		return x.Status
-	}
-	return ""
+func (x *ListDepotsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *GasExtractionOperationResponse) GetShipRoutes() []*ShipRoute {
+func (*ListDepotsResponse) ProtoMessage() {}
+
+func (x *ListDepotsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[187]
 	if x != nil {
-		return x.ShipRoutes
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
 }
 
-func (x *GasExtractionOperationResponse) GetErrors() []string {
+// Deprecated: Use ListDepotsResponse.ProtoReflect.Descriptor instead.
+func (*ListDepotsResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_daemon_daemon_proto_rawDescGZIP(), []int{187}
+}
+
+func (x *ListDepotsResponse) GetDepots() []*DepotSpec {
 	if x != nil {
-		return x.Errors
+		return x.Depots
 	}
 	return nil
 }
 
-// StartConstructionPipelineRequest starts a pipeline to supply materials to a construction site
-type StartConstructionPipelineRequest struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// Waypoint symbol of the construction site (e.g., "X1-FB5-I61")
-	ConstructionSite string `protobuf:"bytes,1,opt,name=construction_site,json=constructionSite,proto3" json:"construction_site,omitempty"`
-	// Player ID for the operation
-	PlayerId int32 `protobuf:"varint,2,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"`
-	// Optional agent symbol (alternative to player_id)
-	AgentSymbol *string `protobuf:"bytes,3,opt,name=agent_symbol,json=agentSymbol,proto3,oneof" json:"agent_symbol,omitempty"`
-	// Supply chain depth: 0=full chain, 1=raw only, 2=intermediates, 3=buy final
-	SupplyChainDepth int32 `protobuf:"varint,4,opt,name=supply_chain_depth,json=supplyChainDepth,proto3" json:"supply_chain_depth,omitempty"`
-	// Maximum parallel workers (default 5)
-	MaxWorkers int32 `protobuf:"varint,5,opt,name=max_workers,json=maxWorkers,proto3" json:"max_workers,omitempty"`
-	// Optional system symbol (defaults to deriving from construction_site)
-	SystemSymbol *string `protobuf:"bytes,6,opt,name=system_symbol,json=systemSymbol,proto3,oneof" json:"system_symbol,omitempty"`
-	// Optional caller-set EXPORT sourcing floor (sp-ezz9), e.g. "SCARCE".
-	// Lowers the supply-priority locator's acceptance floor below the default
-	// MODERATE using its existing tolerance ladder. Unset preserves the
-	// original MODERATE-floor behavior unchanged.
-	MinSupply *string `protobuf:"bytes,7,opt,name=min_supply,json=minSupply,proto3,oneof" json:"min_supply,omitempty"`
-	// Optional per-good buy-gating overrides (sp-sdyo values, sp-pdb3 launch surface) as a
-	// JSON-encoded GoodGatingOverrides map: good -> {strategy?, priceCeilingMult?, minSupply?}.
-	// Loosens gating for named bottleneck goods only; every other good keeps the global floor above.
-	// Persisted on the pipeline so it survives a restart (RULINGS #2). Unset preserves today's
-	// global-default behaviour for every good. priceCeilingMult is clamped to the domain hard cap.
-	GoodOverrides *string `protobuf:"bytes,8,opt,name=good_overrides,json=goodOverrides,proto3,oneof" json:"good_overrides,omitempty"`
+// StartDepotRequest persists ONE depot's topology and launches its coordinators (sp-38xc).
+type StartDepotRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PlayerId      int32                  `protobuf:"varint,1,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"`
+	AgentSymbol   *string                `protobuf:"bytes,2,opt,name=agent_symbol,json=agentSymbol,proto3,oneof" json:"agent_symbol,omitempty"`
+	Depot         *DepotSpec             `protobuf:"bytes,3,opt,name=depot,proto3" json:"depot,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *StartConstructionPipelineRequest) Reset() {
-	*x = StartConstructionPipelineRequest{}
-	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[147]
+func (x *StartDepotRequest) Reset() {
+	*x = StartDepotRequest{}
+	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[188]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *StartConstructionPipelineRequest) String() string {
+func (x *StartDepotRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*StartConstructionPipelineRequest) ProtoMessage() {}
+func (*StartDepotRequest) ProtoMessage() {}
 
-func (x *StartConstructionPipelineRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[147]
+func (x *StartDepotRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[188]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -10656,104 +13615,58 @@ func (x *StartConstructionPipelineRequest) ProtoReflect() protoreflect.Message {
 		}
 		return ms
 	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use StartConstructionPipelineRequest.ProtoReflect.Descriptor instead.
-func (*StartConstructionPipelineRequest) Descriptor() ([]byte, []int) {
-	return file_pkg_proto_daemon_daemon_proto_rawDescGZIP(), []int{147}
+	return mi.MessageOf(x)
 }
 
-func (x *StartConstructionPipelineRequest) GetConstructionSite() string {
-	if x != nil {
-		return x.ConstructionSite
-	}
-	return ""
+// Deprecated: Use StartDepotRequest.ProtoReflect.Descriptor instead.
+func (*StartDepotRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_daemon_daemon_proto_rawDescGZIP(), []int{188}
 }
 
-func (x *StartConstructionPipelineRequest) GetPlayerId() int32 {
+func (x *StartDepotRequest) GetPlayerId() int32 {
 	if x != nil {
 		return x.PlayerId
 	}
 	return 0
 }
 
-func (x *StartConstructionPipelineRequest) GetAgentSymbol() string {
+func (x *StartDepotRequest) GetAgentSymbol() string {
 	if x != nil && x.AgentSymbol != nil {
 		return *x.AgentSymbol
 	}
 	return ""
 }
 
-func (x *StartConstructionPipelineRequest) GetSupplyChainDepth() int32 {
-	if x != nil {
-		return x.SupplyChainDepth
-	}
-	return 0
-}
-
-func (x *StartConstructionPipelineRequest) GetMaxWorkers() int32 {
+func (x *StartDepotRequest) GetDepot() *DepotSpec {
 	if x != nil {
-		return x.MaxWorkers
-	}
-	return 0
-}
-
-func (x *StartConstructionPipelineRequest) GetSystemSymbol() string {
-	if x != nil && x.SystemSymbol != nil {
-		return *x.SystemSymbol
-	}
-	return ""
-}
-
-func (x *StartConstructionPipelineRequest) GetMinSupply() string {
-	if x != nil && x.MinSupply != nil {
-		return *x.MinSupply
-	}
-	return ""
-}
-
-func (x *StartConstructionPipelineRequest) GetGoodOverrides() string {
-	if x != nil && x.GoodOverrides != nil {
-		return *x.GoodOverrides
+		return x.Depot
 	}
-	return ""
+	return nil
 }
 
-// StartConstructionPipelineResponse returns the result of starting a construction pipeline
-type StartConstructionPipelineResponse struct {
-	state            protoimpl.MessageState  `protogen:"open.v1"`
-	PipelineId       string                  `protobuf:"bytes,1,opt,name=pipeline_id,json=pipelineId,proto3" json:"pipeline_id,omitempty"`
-	ConstructionSite string                  `protobuf:"bytes,2,opt,name=construction_site,json=constructionSite,proto3" json:"construction_site,omitempty"`
-	IsResumed        bool                    `protobuf:"varint,3,opt,name=is_resumed,json=isResumed,proto3" json:"is_resumed,omitempty"` // true if resuming existing pipeline
-	Materials        []*ConstructionMaterial `protobuf:"bytes,4,rep,name=materials,proto3" json:"materials,omitempty"`
-	TaskCount        int32                   `protobuf:"varint,5,opt,name=task_count,json=taskCount,proto3" json:"task_count,omitempty"`
-	Status           string                  `protobuf:"bytes,6,opt,name=status,proto3" json:"status,omitempty"`
-	Message          string                  `protobuf:"bytes,7,opt,name=message,proto3" json:"message,omitempty"`
-	// Trade symbols of materials that could not be sourced this call (sp-560b).
-	// Each still has a visible PENDING task that the SupplyMonitor re-sources
-	// when supply regenerates; this names them so the operator can source the
-	// gap manually instead of only seeing a generic "no market" log line.
-	DeferredMaterials []string `protobuf:"bytes,8,rep,name=deferred_materials,json=deferredMaterials,proto3" json:"deferred_materials,omitempty"`
-	unknownFields     protoimpl.UnknownFields
-	sizeCache         protoimpl.SizeCache
+type StartDepotResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        string                 `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	Launched      int32                  `protobuf:"varint,2,opt,name=launched,proto3" json:"launched,omitempty"` // coordinators (warehouses + stockers) launched
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *StartConstructionPipelineResponse) Reset() {
-	*x = StartConstructionPipelineResponse{}
-	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[148]
+func (x *StartDepotResponse) Reset() {
+	*x = StartDepotResponse{}
+	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[189]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *StartConstructionPipelineResponse) String() string {
+func (x *StartDepotResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*StartConstructionPipelineResponse) ProtoMessage() {}
+func (*StartDepotResponse) ProtoMessage() {}
 
-func (x *StartConstructionPipelineResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[148]
+func (x *StartDepotResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[189]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -10764,94 +13677,109 @@ func (x *StartConstructionPipelineResponse) ProtoReflect() protoreflect.Message
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use StartConstructionPipelineResponse.ProtoReflect.Descriptor instead.
-func (*StartConstructionPipelineResponse) Descriptor() ([]byte, []int) {
-	return file_pkg_proto_daemon_daemon_proto_rawDescGZIP(), []int{148}
+// Deprecated: Use StartDepotResponse.ProtoReflect.Descriptor instead.
+func (*StartDepotResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_daemon_daemon_proto_rawDescGZIP(), []int{189}
 }
 
-func (x *StartConstructionPipelineResponse) GetPipelineId() string {
+func (x *StartDepotResponse) GetStatus() string {
 	if x != nil {
-		return x.PipelineId
+		return x.Status
 	}
 	return ""
 }
 
-func (x *StartConstructionPipelineResponse) GetConstructionSite() string {
+func (x *StartDepotResponse) GetLaunched() int32 {
 	if x != nil {
-		return x.ConstructionSite
+		return x.Launched
 	}
-	return ""
+	return 0
 }
 
-func (x *StartConstructionPipelineResponse) GetIsResumed() bool {
-	if x != nil {
-		return x.IsResumed
-	}
-	return false
+// StopDepotRequest tears down the named depot's running coordinators (sp-38xc).
+type StopDepotRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PlayerId      int32                  `protobuf:"varint,1,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"`
+	AgentSymbol   *string                `protobuf:"bytes,2,opt,name=agent_symbol,json=agentSymbol,proto3,oneof" json:"agent_symbol,omitempty"`
+	DepotId       string                 `protobuf:"bytes,3,opt,name=depot_id,json=depotId,proto3" json:"depot_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *StartConstructionPipelineResponse) GetMaterials() []*ConstructionMaterial {
-	if x != nil {
-		return x.Materials
-	}
-	return nil
+func (x *StopDepotRequest) Reset() {
+	*x = StopDepotRequest{}
+	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[190]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *StartConstructionPipelineResponse) GetTaskCount() int32 {
+func (x *StopDepotRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StopDepotRequest) ProtoMessage() {}
+
+func (x *StopDepotRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[190]
 	if x != nil {
-		return x.TaskCount
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *StartConstructionPipelineResponse) GetStatus() string {
+// Deprecated: Use StopDepotRequest.ProtoReflect.Descriptor instead.
+func (*StopDepotRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_daemon_daemon_proto_rawDescGZIP(), []int{190}
+}
+
+func (x *StopDepotRequest) GetPlayerId() int32 {
 	if x != nil {
-		return x.Status
+		return x.PlayerId
 	}
-	return ""
+	return 0
 }
 
-func (x *StartConstructionPipelineResponse) GetMessage() string {
-	if x != nil {
-		return x.Message
+func (x *StopDepotRequest) GetAgentSymbol() string {
+	if x != nil && x.AgentSymbol != nil {
+		return *x.AgentSymbol
 	}
 	return ""
 }
 
-func (x *StartConstructionPipelineResponse) GetDeferredMaterials() []string {
+func (x *StopDepotRequest) GetDepotId() string {
 	if x != nil {
-		return x.DeferredMaterials
+		return x.DepotId
 	}
-	return nil
+	return ""
 }
 
-// ConstructionMaterial represents a material required for construction
-type ConstructionMaterial struct {
+type StopDepotResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	TradeSymbol   string                 `protobuf:"bytes,1,opt,name=trade_symbol,json=tradeSymbol,proto3" json:"trade_symbol,omitempty"`
-	Required      int32                  `protobuf:"varint,2,opt,name=required,proto3" json:"required,omitempty"`
-	Fulfilled     int32                  `protobuf:"varint,3,opt,name=fulfilled,proto3" json:"fulfilled,omitempty"`
-	Remaining     int32                  `protobuf:"varint,4,opt,name=remaining,proto3" json:"remaining,omitempty"`
-	Progress      float64                `protobuf:"fixed64,5,opt,name=progress,proto3" json:"progress,omitempty"`
+	Status        string                 `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	Stopped       int32                  `protobuf:"varint,2,opt,name=stopped,proto3" json:"stopped,omitempty"` // containers stopped
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ConstructionMaterial) Reset() {
-	*x = ConstructionMaterial{}
-	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[149]
+func (x *StopDepotResponse) Reset() {
+	*x = StopDepotResponse{}
+	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[191]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ConstructionMaterial) String() string {
+func (x *StopDepotResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ConstructionMaterial) ProtoMessage() {}
+func (*StopDepotResponse) ProtoMessage() {}
 
-func (x *ConstructionMaterial) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[149]
+func (x *StopDepotResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[191]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -10862,71 +13790,53 @@ func (x *ConstructionMaterial) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ConstructionMaterial.ProtoReflect.Descriptor instead.
-func (*ConstructionMaterial) Descriptor() ([]byte, []int) {
-	return file_pkg_proto_daemon_daemon_proto_rawDescGZIP(), []int{149}
+// Deprecated: Use StopDepotResponse.ProtoReflect.Descriptor instead.
+func (*StopDepotResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_daemon_daemon_proto_rawDescGZIP(), []int{191}
 }
 
-func (x *ConstructionMaterial) GetTradeSymbol() string {
+func (x *StopDepotResponse) GetStatus() string {
 	if x != nil {
-		return x.TradeSymbol
+		return x.Status
 	}
 	return ""
 }
 
-func (x *ConstructionMaterial) GetRequired() int32 {
-	if x != nil {
-		return x.Required
-	}
-	return 0
-}
-
-func (x *ConstructionMaterial) GetFulfilled() int32 {
-	if x != nil {
-		return x.Fulfilled
-	}
-	return 0
-}
-
-func (x *ConstructionMaterial) GetRemaining() int32 {
-	if x != nil {
-		return x.Remaining
-	}
-	return 0
-}
-
-func (x *ConstructionMaterial) GetProgress() float64 {
+func (x *StopDepotResponse) GetStopped() int32 {
 	if x != nil {
-		return x.Progress
+		return x.Stopped
 	}
 	return 0
 }
 
-// GetConstructionStatusRequest retrieves the status of a construction site
-type GetConstructionStatusRequest struct {
-	state            protoimpl.MessageState `protogen:"open.v1"`
-	ConstructionSite string                 `protobuf:"bytes,1,opt,name=construction_site,json=constructionSite,proto3" json:"construction_site,omitempty"`
-	PlayerId         int32                  `protobuf:"varint,2,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"`
-	AgentSymbol      *string                `protobuf:"bytes,3,opt,name=agent_symbol,json=agentSymbol,proto3,oneof" json:"agent_symbol,omitempty"`
-	unknownFields    protoimpl.UnknownFields
-	sizeCache        protoimpl.SizeCache
+// RegisterAgentRequest onboards an agent. With token unset the daemon registers agent_symbol
+// under faction via POST /register using account_token; with token set it adopts that agent.
+type RegisterAgentRequest struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	AgentSymbol         string                 `protobuf:"bytes,1,opt,name=agent_symbol,json=agentSymbol,proto3" json:"agent_symbol,omitempty"`
+	Faction             string                 `protobuf:"bytes,2,opt,name=faction,proto3" json:"faction,omitempty"`
+	AccountToken        string                 `protobuf:"bytes,3,opt,name=account_token,json=accountToken,proto3" json:"account_token,omitempty"`
+	Token               *string                `protobuf:"bytes,4,opt,name=token,proto3,oneof" json:"token,omitempty"`
+	SkipStarterContract bool                   `protobuf:"varint,5,opt,name=skip_starter_contract,json=skipStarterContract,proto3" json:"skip_starter_contract,omitempty"` // leave the starter contract for the operator
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
 }
 
-func (x *GetConstructionStatusRequest) Reset() {
-	*x = GetConstructionStatusRequest{}
-	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[150]
+func (x *RegisterAgentRequest) Reset() {
+	*x = RegisterAgentRequest{}
+	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[192]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetConstructionStatusRequest) String() string {
+func (x *RegisterAgentRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetConstructionStatusRequest) ProtoMessage() {}
+func (*RegisterAgentRequest) ProtoMessage() {}
 
-func (x *GetConstructionStatusRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[150]
+func (x *RegisterAgentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[192]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -10937,62 +13847,78 @@ func (x *GetConstructionStatusRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetConstructionStatusRequest.ProtoReflect.Descriptor instead.
-func (*GetConstructionStatusRequest) Descriptor() ([]byte, []int) {
-	return file_pkg_proto_daemon_daemon_proto_rawDescGZIP(), []int{150}
+// Deprecated: Use RegisterAgentRequest.ProtoReflect.Descriptor instead.
+func (*RegisterAgentRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_daemon_daemon_proto_rawDescGZIP(), []int{192}
 }
 
-func (x *GetConstructionStatusRequest) GetConstructionSite() string {
+func (x *RegisterAgentRequest) GetAgentSymbol() string {
 	if x != nil {
-		return x.ConstructionSite
+		return x.AgentSymbol
 	}
 	return ""
 }
 
-func (x *GetConstructionStatusRequest) GetPlayerId() int32 {
+func (x *RegisterAgentRequest) GetFaction() string {
 	if x != nil {
-		return x.PlayerId
+		return x.Faction
 	}
-	return 0
+	return ""
 }
 
-func (x *GetConstructionStatusRequest) GetAgentSymbol() string {
-	if x != nil && x.AgentSymbol != nil {
-		return *x.AgentSymbol
+func (x *RegisterAgentRequest) GetAccountToken() string {
+	if x != nil {
+		return x.AccountToken
 	}
 	return ""
 }
 
-// GetConstructionStatusResponse returns the status of a construction site
-type GetConstructionStatusResponse struct {
-	state            protoimpl.MessageState  `protogen:"open.v1"`
-	ConstructionSite string                  `protobuf:"bytes,1,opt,name=construction_site,json=constructionSite,proto3" json:"construction_site,omitempty"`
-	IsComplete       bool                    `protobuf:"varint,2,opt,name=is_complete,json=isComplete,proto3" json:"is_complete,omitempty"`
-	Progress         float64                 `protobuf:"fixed64,3,opt,name=progress,proto3" json:"progress,omitempty"`
-	Materials        []*ConstructionMaterial `protobuf:"bytes,4,rep,name=materials,proto3" json:"materials,omitempty"`
-	// Pipeline info (if any)
-	PipelineId       *string  `protobuf:"bytes,5,opt,name=pipeline_id,json=pipelineId,proto3,oneof" json:"pipeline_id,omitempty"`
-	PipelineStatus   *string  `protobuf:"bytes,6,opt,name=pipeline_status,json=pipelineStatus,proto3,oneof" json:"pipeline_status,omitempty"`
-	PipelineProgress *float64 `protobuf:"fixed64,7,opt,name=pipeline_progress,json=pipelineProgress,proto3,oneof" json:"pipeline_progress,omitempty"`
-	unknownFields    protoimpl.UnknownFields
-	sizeCache        protoimpl.SizeCache
+func (x *RegisterAgentRequest) GetToken() string {
+	if x != nil && x.Token != nil {
+		return *x.Token
+	}
+	return ""
 }
 
-func (x *GetConstructionStatusResponse) Reset() {
-	*x = GetConstructionStatusResponse{}
-	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[151]
+func (x *RegisterAgentRequest) GetSkipStarterContract() bool {
+	if x != nil {
+		return x.SkipStarterContract
+	}
+	return false
+}
+
+type RegisterAgentResponse struct {
+	state                       protoimpl.MessageState `protogen:"open.v1"`
+	PlayerId                    int32                  `protobuf:"varint,1,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"`
+	AgentSymbol                 string                 `protobuf:"bytes,2,opt,name=agent_symbol,json=agentSymbol,proto3" json:"agent_symbol,omitempty"`
+	Faction                     string                 `protobuf:"bytes,3,opt,name=faction,proto3" json:"faction,omitempty"`
+	Headquarters                string                 `protobuf:"bytes,4,opt,name=headquarters,proto3" json:"headquarters,omitempty"`
+	Credits                     int64                  `protobuf:"varint,5,opt,name=credits,proto3" json:"credits,omitempty"`
+	ShipsSynced                 int32                  `protobuf:"varint,6,opt,name=ships_synced,json=shipsSynced,proto3" json:"ships_synced,omitempty"`
+	Registered                  bool                   `protobuf:"varint,7,opt,name=registered,proto3" json:"registered,omitempty"` // true when the agent was created by this call
+	StarterContractId           string                 `protobuf:"bytes,8,opt,name=starter_contract_id,json=starterContractId,proto3" json:"starter_contract_id,omitempty"`
+	StarterContractAccepted     bool                   `protobuf:"varint,9,opt,name=starter_contract_accepted,json=starterContractAccepted,proto3" json:"starter_contract_accepted,omitempty"`               // true when this call accepted the starter contract
+	ContractWorkflowContainerId string                 `protobuf:"bytes,10,opt,name=contract_workflow_container_id,json=contractWorkflowContainerId,proto3" json:"contract_workflow_container_id,omitempty"` // workflow queued to fulfil it
+	StarterContractNote         string                 `protobuf:"bytes,11,opt,name=starter_contract_note,json=starterContractNote,proto3" json:"starter_contract_note,omitempty"`                           // why onboarding stopped short, if it did
+	unknownFields               protoimpl.UnknownFields
+	sizeCache                   protoimpl.SizeCache
+}
+
+func (x *RegisterAgentResponse) Reset() {
+	*x = RegisterAgentResponse{}
+	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[193]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetConstructionStatusResponse) String() string {
+func (x *RegisterAgentResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetConstructionStatusResponse) ProtoMessage() {}
+func (*RegisterAgentResponse) ProtoMessage() {}
 
-func (x *GetConstructionStatusResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[151]
+func (x *RegisterAgentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[193]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -11003,85 +13929,116 @@ func (x *GetConstructionStatusResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetConstructionStatusResponse.ProtoReflect.Descriptor instead.
-func (*GetConstructionStatusResponse) Descriptor() ([]byte, []int) {
-	return file_pkg_proto_daemon_daemon_proto_rawDescGZIP(), []int{151}
+// Deprecated: Use RegisterAgentResponse.ProtoReflect.Descriptor instead.
+func (*RegisterAgentResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_daemon_daemon_proto_rawDescGZIP(), []int{193}
 }
 
-func (x *GetConstructionStatusResponse) GetConstructionSite() string {
+func (x *RegisterAgentResponse) GetPlayerId() int32 {
 	if x != nil {
-		return x.ConstructionSite
+		return x.PlayerId
+	}
+	return 0
+}
+
+func (x *RegisterAgentResponse) GetAgentSymbol() string {
+	if x != nil {
+		return x.AgentSymbol
 	}
 	return ""
 }
 
-func (x *GetConstructionStatusResponse) GetIsComplete() bool {
+func (x *RegisterAgentResponse) GetFaction() string {
 	if x != nil {
-		return x.IsComplete
+		return x.Faction
 	}
-	return false
+	return ""
 }
 
-func (x *GetConstructionStatusResponse) GetProgress() float64 {
+func (x *RegisterAgentResponse) GetHeadquarters() string {
 	if x != nil {
-		return x.Progress
+		return x.Headquarters
+	}
+	return ""
+}
+
+func (x *RegisterAgentResponse) GetCredits() int64 {
+	if x != nil {
+		return x.Credits
 	}
 	return 0
 }
 
-func (x *GetConstructionStatusResponse) GetMaterials() []*ConstructionMaterial {
+func (x *RegisterAgentResponse) GetShipsSynced() int32 {
 	if x != nil {
-		return x.Materials
+		return x.ShipsSynced
 	}
-	return nil
+	return 0
 }
 
-func (x *GetConstructionStatusResponse) GetPipelineId() string {
-	if x != nil && x.PipelineId != nil {
-		return *x.PipelineId
+func (x *RegisterAgentResponse) GetRegistered() bool {
+	if x != nil {
+		return x.Registered
+	}
+	return false
+}
+
+func (x *RegisterAgentResponse) GetStarterContractId() string {
+	if x != nil {
+		return x.StarterContractId
 	}
 	return ""
 }
 
-func (x *GetConstructionStatusResponse) GetPipelineStatus() string {
-	if x != nil && x.PipelineStatus != nil {
-		return *x.PipelineStatus
+func (x *RegisterAgentResponse) GetStarterContractAccepted() bool {
+	if x != nil {
+		return x.StarterContractAccepted
+	}
+	return false
+}
+
+func (x *RegisterAgentResponse) GetContractWorkflowContainerId() string {
+	if x != nil {
+		return x.ContractWorkflowContainerId
 	}
 	return ""
 }
 
-func (x *GetConstructionStatusResponse) GetPipelineProgress() float64 {
-	if x != nil && x.PipelineProgress != nil {
-		return *x.PipelineProgress
+func (x *RegisterAgentResponse) GetStarterContractNote() string {
+	if x != nil {
+		return x.StarterContractNote
 	}
-	return 0
+	return ""
 }
 
-// StopConstructionPipelineRequest cancels the active construction pipeline for a site
-type StopConstructionPipelineRequest struct {
-	state            protoimpl.MessageState `protogen:"open.v1"`
-	ConstructionSite string                 `protobuf:"bytes,1,opt,name=construction_site,json=constructionSite,proto3" json:"construction_site,omitempty"`
-	PlayerId         int32                  `protobuf:"varint,2,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"`
-	AgentSymbol      *string                `protobuf:"bytes,3,opt,name=agent_symbol,json=agentSymbol,proto3,oneof" json:"agent_symbol,omitempty"`
-	unknownFields    protoimpl.UnknownFields
-	sizeCache        protoimpl.SizeCache
+// ExportMarketDataRequest exports a system's market snapshot. history_hours > 0 also
+// includes the price changes recorded in that trailing window.
+type ExportMarketDataRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SystemSymbol  string                 `protobuf:"bytes,1,opt,name=system_symbol,json=systemSymbol,proto3" json:"system_symbol,omitempty"`
+	Format        string                 `protobuf:"bytes,2,opt,name=format,proto3" json:"format,omitempty"` // "csv" (default) or "json"
+	HistoryHours  int32                  `protobuf:"varint,3,opt,name=history_hours,json=historyHours,proto3" json:"history_hours,omitempty"`
+	PlayerId      int32                  `protobuf:"varint,4,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"`
+	AgentSymbol   *string                `protobuf:"bytes,5,opt,name=agent_symbol,json=agentSymbol,proto3,oneof" json:"agent_symbol,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *StopConstructionPipelineRequest) Reset() {
-	*x = StopConstructionPipelineRequest{}
-	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[152]
+func (x *ExportMarketDataRequest) Reset() {
+	*x = ExportMarketDataRequest{}
+	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[194]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *StopConstructionPipelineRequest) String() string {
+func (x *ExportMarketDataRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*StopConstructionPipelineRequest) ProtoMessage() {}
+func (*ExportMarketDataRequest) ProtoMessage() {}
 
-func (x *StopConstructionPipelineRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[152]
+func (x *ExportMarketDataRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[194]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -11092,59 +14049,72 @@ func (x *StopConstructionPipelineRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use StopConstructionPipelineRequest.ProtoReflect.Descriptor instead.
-func (*StopConstructionPipelineRequest) Descriptor() ([]byte, []int) {
-	return file_pkg_proto_daemon_daemon_proto_rawDescGZIP(), []int{152}
+// Deprecated: Use ExportMarketDataRequest.ProtoReflect.Descriptor instead.
+func (*ExportMarketDataRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_daemon_daemon_proto_rawDescGZIP(), []int{194}
 }
 
-func (x *StopConstructionPipelineRequest) GetConstructionSite() string {
+func (x *ExportMarketDataRequest) GetSystemSymbol() string {
 	if x != nil {
-		return x.ConstructionSite
+		return x.SystemSymbol
 	}
 	return ""
 }
 
-func (x *StopConstructionPipelineRequest) GetPlayerId() int32 {
+func (x *ExportMarketDataRequest) GetFormat() string {
+	if x != nil {
+		return x.Format
+	}
+	return ""
+}
+
+func (x *ExportMarketDataRequest) GetHistoryHours() int32 {
+	if x != nil {
+		return x.HistoryHours
+	}
+	return 0
+}
+
+func (x *ExportMarketDataRequest) GetPlayerId() int32 {
 	if x != nil {
 		return x.PlayerId
 	}
 	return 0
 }
 
-func (x *StopConstructionPipelineRequest) GetAgentSymbol() string {
+func (x *ExportMarketDataRequest) GetAgentSymbol() string {
 	if x != nil && x.AgentSymbol != nil {
 		return *x.AgentSymbol
 	}
 	return ""
 }
 
-// StopConstructionPipelineResponse returns the result of stopping a construction pipeline
-type StopConstructionPipelineResponse struct {
-	state            protoimpl.MessageState `protogen:"open.v1"`
-	PipelineId       string                 `protobuf:"bytes,1,opt,name=pipeline_id,json=pipelineId,proto3" json:"pipeline_id,omitempty"`
-	ConstructionSite string                 `protobuf:"bytes,2,opt,name=construction_site,json=constructionSite,proto3" json:"construction_site,omitempty"`
-	Status           string                 `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"` // pipeline status after cancellation (CANCELLED)
-	TasksCancelled   int32                  `protobuf:"varint,4,opt,name=tasks_cancelled,json=tasksCancelled,proto3" json:"tasks_cancelled,omitempty"`
-	Message          string                 `protobuf:"bytes,5,opt,name=message,proto3" json:"message,omitempty"`
-	unknownFields    protoimpl.UnknownFields
-	sizeCache        protoimpl.SizeCache
+type ExportMarketDataResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Format        string                 `protobuf:"bytes,1,opt,name=format,proto3" json:"format,omitempty"`
+	Content       []byte                 `protobuf:"bytes,2,opt,name=content,proto3" json:"content,omitempty"`
+	MarketCount   int32                  `protobuf:"varint,3,opt,name=market_count,json=marketCount,proto3" json:"market_count,omitempty"`
+	SnapshotRows  int32                  `protobuf:"varint,4,opt,name=snapshot_rows,json=snapshotRows,proto3" json:"snapshot_rows,omitempty"`
+	HistoryRows   int32                  `protobuf:"varint,5,opt,name=history_rows,json=historyRows,proto3" json:"history_rows,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *StopConstructionPipelineResponse) Reset() {
-	*x = StopConstructionPipelineResponse{}
-	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[153]
+func (x *ExportMarketDataResponse) Reset() {
+	*x = ExportMarketDataResponse{}
+	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[195]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *StopConstructionPipelineResponse) String() string {
+func (x *ExportMarketDataResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*StopConstructionPipelineResponse) ProtoMessage() {}
+func (*ExportMarketDataResponse) ProtoMessage() {}
 
-func (x *StopConstructionPipelineResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[153]
+func (x *ExportMarketDataResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[195]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -11155,82 +14125,74 @@ func (x *StopConstructionPipelineResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use StopConstructionPipelineResponse.ProtoReflect.Descriptor instead.
-func (*StopConstructionPipelineResponse) Descriptor() ([]byte, []int) {
-	return file_pkg_proto_daemon_daemon_proto_rawDescGZIP(), []int{153}
+// Deprecated: Use ExportMarketDataResponse.ProtoReflect.Descriptor instead.
+func (*ExportMarketDataResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_daemon_daemon_proto_rawDescGZIP(), []int{195}
 }
 
-func (x *StopConstructionPipelineResponse) GetPipelineId() string {
+func (x *ExportMarketDataResponse) GetFormat() string {
 	if x != nil {
-		return x.PipelineId
+		return x.Format
 	}
 	return ""
 }
 
-func (x *StopConstructionPipelineResponse) GetConstructionSite() string {
+func (x *ExportMarketDataResponse) GetContent() []byte {
 	if x != nil {
-		return x.ConstructionSite
+		return x.Content
 	}
-	return ""
+	return nil
 }
 
-func (x *StopConstructionPipelineResponse) GetStatus() string {
+func (x *ExportMarketDataResponse) GetMarketCount() int32 {
 	if x != nil {
-		return x.Status
+		return x.MarketCount
 	}
-	return ""
+	return 0
 }
 
-func (x *StopConstructionPipelineResponse) GetTasksCancelled() int32 {
+func (x *ExportMarketDataResponse) GetSnapshotRows() int32 {
 	if x != nil {
-		return x.TasksCancelled
+		return x.SnapshotRows
 	}
 	return 0
 }
 
-func (x *StopConstructionPipelineResponse) GetMessage() string {
+func (x *ExportMarketDataResponse) GetHistoryRows() int32 {
 	if x != nil {
-		return x.Message
+		return x.HistoryRows
 	}
-	return ""
+	return 0
 }
 
-// ConstructionGoodOverrideRequest sets or clears ONE good's per-good buy-gating override on a
-// running construction pipeline (sp-pdb3). construction_site + good identify the target. clear
-// removes the good's override, reverting it to the global default. The three optional knobs mirror
-// manufacturing.GoodGatingOverride; an unset knob leaves that dimension unchanged (tune one at a
-// time). price_ceiling_mult is clamped to the domain hard cap — the CLI never bypasses the
-// guardrail (RULINGS #4).
-type ConstructionGoodOverrideRequest struct {
-	state            protoimpl.MessageState `protogen:"open.v1"`
-	ConstructionSite string                 `protobuf:"bytes,1,opt,name=construction_site,json=constructionSite,proto3" json:"construction_site,omitempty"`
-	Good             string                 `protobuf:"bytes,2,opt,name=good,proto3" json:"good,omitempty"`
-	PlayerId         int32                  `protobuf:"varint,3,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"`
-	AgentSymbol      *string                `protobuf:"bytes,4,opt,name=agent_symbol,json=agentSymbol,proto3,oneof" json:"agent_symbol,omitempty"`
-	// clear removes any existing override for good, reverting it to the global default.
-	Clear            bool     `protobuf:"varint,5,opt,name=clear,proto3" json:"clear,omitempty"`
-	MinSupply        *string  `protobuf:"bytes,6,opt,name=min_supply,json=minSupply,proto3,oneof" json:"min_supply,omitempty"`
-	Strategy         *string  `protobuf:"bytes,7,opt,name=strategy,proto3,oneof" json:"strategy,omitempty"`
-	PriceCeilingMult *float64 `protobuf:"fixed64,8,opt,name=price_ceiling_mult,json=priceCeilingMult,proto3,oneof" json:"price_ceiling_mult,omitempty"`
-	unknownFields    protoimpl.UnknownFields
-	sizeCache        protoimpl.SizeCache
+// GetSupplyTransitionStatsRequest narrows the stats to one market and/or good; empty
+// symbols cover every market or good. window_hours 0 uses the default week.
+type GetSupplyTransitionStatsRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	WaypointSymbol string                 `protobuf:"bytes,1,opt,name=waypoint_symbol,json=waypointSymbol,proto3" json:"waypoint_symbol,omitempty"`
+	GoodSymbol     string                 `protobuf:"bytes,2,opt,name=good_symbol,json=goodSymbol,proto3" json:"good_symbol,omitempty"`
+	WindowHours    int32                  `protobuf:"varint,3,opt,name=window_hours,json=windowHours,proto3" json:"window_hours,omitempty"`
+	PlayerId       int32                  `protobuf:"varint,4,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"`
+	AgentSymbol    *string                `protobuf:"bytes,5,opt,name=agent_symbol,json=agentSymbol,proto3,oneof" json:"agent_symbol,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
-func (x *ConstructionGoodOverrideRequest) Reset() {
-	*x = ConstructionGoodOverrideRequest{}
-	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[154]
+func (x *GetSupplyTransitionStatsRequest) Reset() {
+	*x = GetSupplyTransitionStatsRequest{}
+	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[196]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ConstructionGoodOverrideRequest) String() string {
+func (x *GetSupplyTransitionStatsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ConstructionGoodOverrideRequest) ProtoMessage() {}
+func (*GetSupplyTransitionStatsRequest) ProtoMessage() {}
 
-func (x *ConstructionGoodOverrideRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[154]
+func (x *GetSupplyTransitionStatsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[196]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -11241,99 +14203,76 @@ func (x *ConstructionGoodOverrideRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ConstructionGoodOverrideRequest.ProtoReflect.Descriptor instead.
-func (*ConstructionGoodOverrideRequest) Descriptor() ([]byte, []int) {
-	return file_pkg_proto_daemon_daemon_proto_rawDescGZIP(), []int{154}
+// Deprecated: Use GetSupplyTransitionStatsRequest.ProtoReflect.Descriptor instead.
+func (*GetSupplyTransitionStatsRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_daemon_daemon_proto_rawDescGZIP(), []int{196}
 }
 
-func (x *ConstructionGoodOverrideRequest) GetConstructionSite() string {
+func (x *GetSupplyTransitionStatsRequest) GetWaypointSymbol() string {
 	if x != nil {
-		return x.ConstructionSite
+		return x.WaypointSymbol
 	}
 	return ""
 }
 
-func (x *ConstructionGoodOverrideRequest) GetGood() string {
+func (x *GetSupplyTransitionStatsRequest) GetGoodSymbol() string {
 	if x != nil {
-		return x.Good
+		return x.GoodSymbol
 	}
 	return ""
 }
 
-func (x *ConstructionGoodOverrideRequest) GetPlayerId() int32 {
+func (x *GetSupplyTransitionStatsRequest) GetWindowHours() int32 {
 	if x != nil {
-		return x.PlayerId
+		return x.WindowHours
 	}
 	return 0
 }
 
-func (x *ConstructionGoodOverrideRequest) GetAgentSymbol() string {
-	if x != nil && x.AgentSymbol != nil {
-		return *x.AgentSymbol
-	}
-	return ""
-}
-
-func (x *ConstructionGoodOverrideRequest) GetClear() bool {
+func (x *GetSupplyTransitionStatsRequest) GetPlayerId() int32 {
 	if x != nil {
-		return x.Clear
-	}
-	return false
-}
-
-func (x *ConstructionGoodOverrideRequest) GetMinSupply() string {
-	if x != nil && x.MinSupply != nil {
-		return *x.MinSupply
+		return x.PlayerId
 	}
-	return ""
+	return 0
 }
 
-func (x *ConstructionGoodOverrideRequest) GetStrategy() string {
-	if x != nil && x.Strategy != nil {
-		return *x.Strategy
+func (x *GetSupplyTransitionStatsRequest) GetAgentSymbol() string {
+	if x != nil && x.AgentSymbol != nil {
+		return *x.AgentSymbol
 	}
 	return ""
 }
 
-func (x *ConstructionGoodOverrideRequest) GetPriceCeilingMult() float64 {
-	if x != nil && x.PriceCeilingMult != nil {
-		return *x.PriceCeilingMult
-	}
-	return 0
-}
-
-// ConstructionGoodOverrideResponse confirms the resulting override state for the good.
-type ConstructionGoodOverrideResponse struct {
-	state            protoimpl.MessageState `protogen:"open.v1"`
-	ConstructionSite string                 `protobuf:"bytes,1,opt,name=construction_site,json=constructionSite,proto3" json:"construction_site,omitempty"`
-	Good             string                 `protobuf:"bytes,2,opt,name=good,proto3" json:"good,omitempty"`
-	// cleared is true when the request removed the override.
-	Cleared bool `protobuf:"varint,3,opt,name=cleared,proto3" json:"cleared,omitempty"`
-	// changed is false when the request was a no-op (the value already matched).
-	Changed bool `protobuf:"varint,4,opt,name=changed,proto3" json:"changed,omitempty"`
-	// The resulting override values for the good (empty/zero when cleared or that knob is unset).
-	Strategy         string  `protobuf:"bytes,5,opt,name=strategy,proto3" json:"strategy,omitempty"`
-	PriceCeilingMult float64 `protobuf:"fixed64,6,opt,name=price_ceiling_mult,json=priceCeilingMult,proto3" json:"price_ceiling_mult,omitempty"`
-	MinSupply        string  `protobuf:"bytes,7,opt,name=min_supply,json=minSupply,proto3" json:"min_supply,omitempty"`
-	unknownFields    protoimpl.UnknownFields
-	sizeCache        protoimpl.SizeCache
+// SupplyTransitionStat is one (market, good)'s supply transitions in the window.
+type SupplyTransitionStat struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	WaypointSymbol      string                 `protobuf:"bytes,1,opt,name=waypoint_symbol,json=waypointSymbol,proto3" json:"waypoint_symbol,omitempty"`
+	GoodSymbol          string                 `protobuf:"bytes,2,opt,name=good_symbol,json=goodSymbol,proto3" json:"good_symbol,omitempty"`
+	Transitions         int32                  `protobuf:"varint,3,opt,name=transitions,proto3" json:"transitions,omitempty"`
+	TransitionsPerHour  float64                `protobuf:"fixed64,4,opt,name=transitions_per_hour,json=transitionsPerHour,proto3" json:"transitions_per_hour,omitempty"`
+	Edges               map[string]int32       `protobuf:"bytes,5,rep,name=edges,proto3" json:"edges,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"varint,2,opt,name=value"`                                                          // "FROM->TO" -> count
+	AverageDwellSeconds map[string]int64       `protobuf:"bytes,6,rep,name=average_dwell_seconds,json=averageDwellSeconds,proto3" json:"average_dwell_seconds,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"varint,2,opt,name=value"` // supply level -> average stay
+	CurrentSupply       string                 `protobuf:"bytes,7,opt,name=current_supply,json=currentSupply,proto3" json:"current_supply,omitempty"`
+	LastTransitionAt    string                 `protobuf:"bytes,8,opt,name=last_transition_at,json=lastTransitionAt,proto3" json:"last_transition_at,omitempty"` // RFC3339
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
 }
 
-func (x *ConstructionGoodOverrideResponse) Reset() {
-	*x = ConstructionGoodOverrideResponse{}
-	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[155]
+func (x *SupplyTransitionStat) Reset() {
+	*x = SupplyTransitionStat{}
+	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[197]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ConstructionGoodOverrideResponse) String() string {
+func (x *SupplyTransitionStat) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ConstructionGoodOverrideResponse) ProtoMessage() {}
+func (*SupplyTransitionStat) ProtoMessage() {}
 
-func (x *ConstructionGoodOverrideResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[155]
+func (x *SupplyTransitionStat) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[197]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -11344,86 +14283,90 @@ func (x *ConstructionGoodOverrideResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ConstructionGoodOverrideResponse.ProtoReflect.Descriptor instead.
-func (*ConstructionGoodOverrideResponse) Descriptor() ([]byte, []int) {
-	return file_pkg_proto_daemon_daemon_proto_rawDescGZIP(), []int{155}
+// Deprecated: Use SupplyTransitionStat.ProtoReflect.Descriptor instead.
+func (*SupplyTransitionStat) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_daemon_daemon_proto_rawDescGZIP(), []int{197}
 }
 
-func (x *ConstructionGoodOverrideResponse) GetConstructionSite() string {
+func (x *SupplyTransitionStat) GetWaypointSymbol() string {
 	if x != nil {
-		return x.ConstructionSite
+		return x.WaypointSymbol
 	}
 	return ""
 }
 
-func (x *ConstructionGoodOverrideResponse) GetGood() string {
+func (x *SupplyTransitionStat) GetGoodSymbol() string {
 	if x != nil {
-		return x.Good
+		return x.GoodSymbol
+	}
+	return ""
+}
+
+func (x *SupplyTransitionStat) GetTransitions() int32 {
+	if x != nil {
+		return x.Transitions
 	}
-	return ""
+	return 0
 }
 
-func (x *ConstructionGoodOverrideResponse) GetCleared() bool {
+func (x *SupplyTransitionStat) GetTransitionsPerHour() float64 {
 	if x != nil {
-		return x.Cleared
+		return x.TransitionsPerHour
 	}
-	return false
+	return 0
 }
 
-func (x *ConstructionGoodOverrideResponse) GetChanged() bool {
+func (x *SupplyTransitionStat) GetEdges() map[string]int32 {
 	if x != nil {
-		return x.Changed
+		return x.Edges
 	}
-	return false
+	return nil
 }
 
-func (x *ConstructionGoodOverrideResponse) GetStrategy() string {
+func (x *SupplyTransitionStat) GetAverageDwellSeconds() map[string]int64 {
 	if x != nil {
-		return x.Strategy
+		return x.AverageDwellSeconds
 	}
-	return ""
+	return nil
 }
 
-func (x *ConstructionGoodOverrideResponse) GetPriceCeilingMult() float64 {
+func (x *SupplyTransitionStat) GetCurrentSupply() string {
 	if x != nil {
-		return x.PriceCeilingMult
+		return x.CurrentSupply
 	}
-	return 0
+	return ""
 }
 
-func (x *ConstructionGoodOverrideResponse) GetMinSupply() string {
+func (x *SupplyTransitionStat) GetLastTransitionAt() string {
 	if x != nil {
-		return x.MinSupply
+		return x.LastTransitionAt
 	}
 	return ""
 }
 
-// DepotElement is one placed depot member: a {waypoint, ship} pair. ship_symbol may
-// be empty for a declared-but-uncrewed slot. Nothing here is hardcoded — the whole
-// topology is operator data.
-type DepotElement struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Waypoint      string                 `protobuf:"bytes,1,opt,name=waypoint,proto3" json:"waypoint,omitempty"`
-	ShipSymbol    string                 `protobuf:"bytes,2,opt,name=ship_symbol,json=shipSymbol,proto3" json:"ship_symbol,omitempty"`
+type GetSupplyTransitionStatsResponse struct {
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	Since         string                  `protobuf:"bytes,1,opt,name=since,proto3" json:"since,omitempty"` // RFC3339 start of the window
+	Stats         []*SupplyTransitionStat `protobuf:"bytes,2,rep,name=stats,proto3" json:"stats,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DepotElement) Reset() {
-	*x = DepotElement{}
-	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[156]
+func (x *GetSupplyTransitionStatsResponse) Reset() {
+	*x = GetSupplyTransitionStatsResponse{}
+	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[198]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DepotElement) String() string {
+func (x *GetSupplyTransitionStatsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DepotElement) ProtoMessage() {}
+func (*GetSupplyTransitionStatsResponse) ProtoMessage() {}
 
-func (x *DepotElement) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[156]
+func (x *GetSupplyTransitionStatsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[198]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -11434,54 +14377,52 @@ func (x *DepotElement) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DepotElement.ProtoReflect.Descriptor instead.
-func (*DepotElement) Descriptor() ([]byte, []int) {
-	return file_pkg_proto_daemon_daemon_proto_rawDescGZIP(), []int{156}
+// Deprecated: Use GetSupplyTransitionStatsResponse.ProtoReflect.Descriptor instead.
+func (*GetSupplyTransitionStatsResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_daemon_daemon_proto_rawDescGZIP(), []int{198}
 }
 
-func (x *DepotElement) GetWaypoint() string {
+func (x *GetSupplyTransitionStatsResponse) GetSince() string {
 	if x != nil {
-		return x.Waypoint
+		return x.Since
 	}
 	return ""
 }
 
-func (x *DepotElement) GetShipSymbol() string {
+func (x *GetSupplyTransitionStatsResponse) GetStats() []*SupplyTransitionStat {
 	if x != nil {
-		return x.ShipSymbol
+		return x.Stats
 	}
-	return ""
+	return nil
 }
 
-// DepotSpec is one whole depot: its id and its four element classes (destination
-// warehouses — the routing anchor, at least one — background stockers, pinned delivery
-// hulls, and source hubs).
-type DepotSpec struct {
+// GetSystemOverviewRequest names the system to summarise. max_lanes 0 lists the
+// default five arbitrage lanes.
+type GetSystemOverviewRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Warehouses    []*DepotElement        `protobuf:"bytes,2,rep,name=warehouses,proto3" json:"warehouses,omitempty"`
-	Stockers      []*DepotElement        `protobuf:"bytes,3,rep,name=stockers,proto3" json:"stockers,omitempty"`
-	DeliveryHulls []*DepotElement        `protobuf:"bytes,4,rep,name=delivery_hulls,json=deliveryHulls,proto3" json:"delivery_hulls,omitempty"`
-	SourceHubs    []*DepotElement        `protobuf:"bytes,5,rep,name=source_hubs,json=sourceHubs,proto3" json:"source_hubs,omitempty"`
+	SystemSymbol  string                 `protobuf:"bytes,1,opt,name=system_symbol,json=systemSymbol,proto3" json:"system_symbol,omitempty"`
+	MaxLanes      int32                  `protobuf:"varint,2,opt,name=max_lanes,json=maxLanes,proto3" json:"max_lanes,omitempty"`
+	PlayerId      int32                  `protobuf:"varint,3,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"`
+	AgentSymbol   *string                `protobuf:"bytes,4,opt,name=agent_symbol,json=agentSymbol,proto3,oneof" json:"agent_symbol,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DepotSpec) Reset() {
-	*x = DepotSpec{}
-	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[157]
+func (x *GetSystemOverviewRequest) Reset() {
+	*x = GetSystemOverviewRequest{}
+	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[199]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DepotSpec) String() string {
+func (x *GetSystemOverviewRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DepotSpec) ProtoMessage() {}
+func (*GetSystemOverviewRequest) ProtoMessage() {}
 
-func (x *DepotSpec) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[157]
+func (x *GetSystemOverviewRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[199]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -11492,72 +14433,66 @@ func (x *DepotSpec) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DepotSpec.ProtoReflect.Descriptor instead.
-func (*DepotSpec) Descriptor() ([]byte, []int) {
-	return file_pkg_proto_daemon_daemon_proto_rawDescGZIP(), []int{157}
+// Deprecated: Use GetSystemOverviewRequest.ProtoReflect.Descriptor instead.
+func (*GetSystemOverviewRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_daemon_daemon_proto_rawDescGZIP(), []int{199}
 }
 
-func (x *DepotSpec) GetId() string {
+func (x *GetSystemOverviewRequest) GetSystemSymbol() string {
 	if x != nil {
-		return x.Id
+		return x.SystemSymbol
 	}
 	return ""
 }
 
-func (x *DepotSpec) GetWarehouses() []*DepotElement {
-	if x != nil {
-		return x.Warehouses
-	}
-	return nil
-}
-
-func (x *DepotSpec) GetStockers() []*DepotElement {
+func (x *GetSystemOverviewRequest) GetMaxLanes() int32 {
 	if x != nil {
-		return x.Stockers
+		return x.MaxLanes
 	}
-	return nil
+	return 0
 }
 
-func (x *DepotSpec) GetDeliveryHulls() []*DepotElement {
+func (x *GetSystemOverviewRequest) GetPlayerId() int32 {
 	if x != nil {
-		return x.DeliveryHulls
+		return x.PlayerId
 	}
-	return nil
+	return 0
 }
 
-func (x *DepotSpec) GetSourceHubs() []*DepotElement {
-	if x != nil {
-		return x.SourceHubs
+func (x *GetSystemOverviewRequest) GetAgentSymbol() string {
+	if x != nil && x.AgentSymbol != nil {
+		return *x.AgentSymbol
 	}
-	return nil
+	return ""
 }
 
-// ApplyDepotTopologyRequest is the DECLARATIVE bulk apply: it makes the player's
-// persisted set EXACTLY these depots (upsert each, delete any not named).
-type ApplyDepotTopologyRequest struct {
+type SystemOverviewShip struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	PlayerId      int32                  `protobuf:"varint,1,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"`
-	AgentSymbol   *string                `protobuf:"bytes,2,opt,name=agent_symbol,json=agentSymbol,proto3,oneof" json:"agent_symbol,omitempty"`
-	Depots        []*DepotSpec           `protobuf:"bytes,3,rep,name=depots,proto3" json:"depots,omitempty"`
+	Symbol        string                 `protobuf:"bytes,1,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	Role          string                 `protobuf:"bytes,2,opt,name=role,proto3" json:"role,omitempty"`
+	Location      string                 `protobuf:"bytes,3,opt,name=location,proto3" json:"location,omitempty"`
+	NavStatus     string                 `protobuf:"bytes,4,opt,name=nav_status,json=navStatus,proto3" json:"nav_status,omitempty"`
+	ContainerId   string                 `protobuf:"bytes,5,opt,name=container_id,json=containerId,proto3" json:"container_id,omitempty"`
+	Task          string                 `protobuf:"bytes,6,opt,name=task,proto3" json:"task,omitempty"` // running container's command type, "captain", or empty when idle
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ApplyDepotTopologyRequest) Reset() {
-	*x = ApplyDepotTopologyRequest{}
-	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[158]
+func (x *SystemOverviewShip) Reset() {
+	*x = SystemOverviewShip{}
+	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[200]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ApplyDepotTopologyRequest) String() string {
+func (x *SystemOverviewShip) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ApplyDepotTopologyRequest) ProtoMessage() {}
+func (*SystemOverviewShip) ProtoMessage() {}
 
-func (x *ApplyDepotTopologyRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[158]
+func (x *SystemOverviewShip) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[200]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -11568,55 +14503,79 @@ func (x *ApplyDepotTopologyRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ApplyDepotTopologyRequest.ProtoReflect.Descriptor instead.
-func (*ApplyDepotTopologyRequest) Descriptor() ([]byte, []int) {
-	return file_pkg_proto_daemon_daemon_proto_rawDescGZIP(), []int{158}
+// Deprecated: Use SystemOverviewShip.ProtoReflect.Descriptor instead.
+func (*SystemOverviewShip) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_daemon_daemon_proto_rawDescGZIP(), []int{200}
 }
 
-func (x *ApplyDepotTopologyRequest) GetPlayerId() int32 {
+func (x *SystemOverviewShip) GetSymbol() string {
 	if x != nil {
-		return x.PlayerId
+		return x.Symbol
 	}
-	return 0
+	return ""
 }
 
-func (x *ApplyDepotTopologyRequest) GetAgentSymbol() string {
-	if x != nil && x.AgentSymbol != nil {
-		return *x.AgentSymbol
+func (x *SystemOverviewShip) GetRole() string {
+	if x != nil {
+		return x.Role
 	}
 	return ""
 }
 
-func (x *ApplyDepotTopologyRequest) GetDepots() []*DepotSpec {
+func (x *SystemOverviewShip) GetLocation() string {
 	if x != nil {
-		return x.Depots
+		return x.Location
 	}
-	return nil
+	return ""
 }
 
-type ApplyDepotTopologyResponse struct {
+func (x *SystemOverviewShip) GetNavStatus() string {
+	if x != nil {
+		return x.NavStatus
+	}
+	return ""
+}
+
+func (x *SystemOverviewShip) GetContainerId() string {
+	if x != nil {
+		return x.ContainerId
+	}
+	return ""
+}
+
+func (x *SystemOverviewShip) GetTask() string {
+	if x != nil {
+		return x.Task
+	}
+	return ""
+}
+
+type SystemOverviewContainer struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Status        string                 `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
-	DepotCount    int32                  `protobuf:"varint,2,opt,name=depot_count,json=depotCount,proto3" json:"depot_count,omitempty"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	ContainerType string                 `protobuf:"bytes,2,opt,name=container_type,json=containerType,proto3" json:"container_type,omitempty"`
+	CommandType   string                 `protobuf:"bytes,3,opt,name=command_type,json=commandType,proto3" json:"command_type,omitempty"`
+	ShipsInSystem int32                  `protobuf:"varint,4,opt,name=ships_in_system,json=shipsInSystem,proto3" json:"ships_in_system,omitempty"`
+	StartedAt     string                 `protobuf:"bytes,5,opt,name=started_at,json=startedAt,proto3" json:"started_at,omitempty"` // RFC3339, empty if unknown
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ApplyDepotTopologyResponse) Reset() {
-	*x = ApplyDepotTopologyResponse{}
-	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[159]
+func (x *SystemOverviewContainer) Reset() {
+	*x = SystemOverviewContainer{}
+	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[201]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ApplyDepotTopologyResponse) String() string {
+func (x *SystemOverviewContainer) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ApplyDepotTopologyResponse) ProtoMessage() {}
+func (*SystemOverviewContainer) ProtoMessage() {}
 
-func (x *ApplyDepotTopologyResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[159]
+func (x *SystemOverviewContainer) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[201]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -11627,50 +14586,73 @@ func (x *ApplyDepotTopologyResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ApplyDepotTopologyResponse.ProtoReflect.Descriptor instead.
-func (*ApplyDepotTopologyResponse) Descriptor() ([]byte, []int) {
-	return file_pkg_proto_daemon_daemon_proto_rawDescGZIP(), []int{159}
+// Deprecated: Use SystemOverviewContainer.ProtoReflect.Descriptor instead.
+func (*SystemOverviewContainer) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_daemon_daemon_proto_rawDescGZIP(), []int{201}
 }
 
-func (x *ApplyDepotTopologyResponse) GetStatus() string {
+func (x *SystemOverviewContainer) GetId() string {
 	if x != nil {
-		return x.Status
+		return x.Id
 	}
 	return ""
 }
 
-func (x *ApplyDepotTopologyResponse) GetDepotCount() int32 {
+func (x *SystemOverviewContainer) GetContainerType() string {
 	if x != nil {
-		return x.DepotCount
+		return x.ContainerType
+	}
+	return ""
+}
+
+func (x *SystemOverviewContainer) GetCommandType() string {
+	if x != nil {
+		return x.CommandType
+	}
+	return ""
+}
+
+func (x *SystemOverviewContainer) GetShipsInSystem() int32 {
+	if x != nil {
+		return x.ShipsInSystem
 	}
 	return 0
 }
 
-// AddDepotRequest adds ONE depot without disturbing the rest (granular create).
-type AddDepotRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	PlayerId      int32                  `protobuf:"varint,1,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"`
-	AgentSymbol   *string                `protobuf:"bytes,2,opt,name=agent_symbol,json=agentSymbol,proto3,oneof" json:"agent_symbol,omitempty"`
-	Depot         *DepotSpec             `protobuf:"bytes,3,opt,name=depot,proto3" json:"depot,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *SystemOverviewContainer) GetStartedAt() string {
+	if x != nil {
+		return x.StartedAt
+	}
+	return ""
 }
 
-func (x *AddDepotRequest) Reset() {
-	*x = AddDepotRequest{}
-	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[160]
+// SystemMarketFreshness summarises the age of the system's cached markets.
+type SystemMarketFreshness struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Markets          int32                  `protobuf:"varint,1,opt,name=markets,proto3" json:"markets,omitempty"`
+	Scanned          int32                  `protobuf:"varint,2,opt,name=scanned,proto3" json:"scanned,omitempty"`
+	NewestAgeSeconds int64                  `protobuf:"varint,3,opt,name=newest_age_seconds,json=newestAgeSeconds,proto3" json:"newest_age_seconds,omitempty"`
+	MedianAgeSeconds int64                  `protobuf:"varint,4,opt,name=median_age_seconds,json=medianAgeSeconds,proto3" json:"median_age_seconds,omitempty"`
+	OldestAgeSeconds int64                  `protobuf:"varint,5,opt,name=oldest_age_seconds,json=oldestAgeSeconds,proto3" json:"oldest_age_seconds,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *SystemMarketFreshness) Reset() {
+	*x = SystemMarketFreshness{}
+	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[202]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *AddDepotRequest) String() string {
+func (x *SystemMarketFreshness) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AddDepotRequest) ProtoMessage() {}
+func (*SystemMarketFreshness) ProtoMessage() {}
 
-func (x *AddDepotRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[160]
+func (x *SystemMarketFreshness) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[202]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -11681,101 +14663,158 @@ func (x *AddDepotRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AddDepotRequest.ProtoReflect.Descriptor instead.
-func (*AddDepotRequest) Descriptor() ([]byte, []int) {
-	return file_pkg_proto_daemon_daemon_proto_rawDescGZIP(), []int{160}
+// Deprecated: Use SystemMarketFreshness.ProtoReflect.Descriptor instead.
+func (*SystemMarketFreshness) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_daemon_daemon_proto_rawDescGZIP(), []int{202}
 }
 
-func (x *AddDepotRequest) GetPlayerId() int32 {
+func (x *SystemMarketFreshness) GetMarkets() int32 {
 	if x != nil {
-		return x.PlayerId
+		return x.Markets
 	}
 	return 0
 }
 
-func (x *AddDepotRequest) GetAgentSymbol() string {
-	if x != nil && x.AgentSymbol != nil {
-		return *x.AgentSymbol
+func (x *SystemMarketFreshness) GetScanned() int32 {
+	if x != nil {
+		return x.Scanned
 	}
-	return ""
+	return 0
 }
 
-func (x *AddDepotRequest) GetDepot() *DepotSpec {
+func (x *SystemMarketFreshness) GetNewestAgeSeconds() int64 {
 	if x != nil {
-		return x.Depot
+		return x.NewestAgeSeconds
 	}
-	return nil
+	return 0
 }
 
-type AddDepotResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Status        string                 `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *SystemMarketFreshness) GetMedianAgeSeconds() int64 {
+	if x != nil {
+		return x.MedianAgeSeconds
+	}
+	return 0
 }
 
-func (x *AddDepotResponse) Reset() {
-	*x = AddDepotResponse{}
-	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[161]
+func (x *SystemMarketFreshness) GetOldestAgeSeconds() int64 {
+	if x != nil {
+		return x.OldestAgeSeconds
+	}
+	return 0
+}
+
+type SystemOverviewDelivery struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	ContractId     string                 `protobuf:"bytes,1,opt,name=contract_id,json=contractId,proto3" json:"contract_id,omitempty"`
+	Good           string                 `protobuf:"bytes,2,opt,name=good,proto3" json:"good,omitempty"`
+	Destination    string                 `protobuf:"bytes,3,opt,name=destination,proto3" json:"destination,omitempty"`
+	UnitsRequired  int32                  `protobuf:"varint,4,opt,name=units_required,json=unitsRequired,proto3" json:"units_required,omitempty"`
+	UnitsFulfilled int32                  `protobuf:"varint,5,opt,name=units_fulfilled,json=unitsFulfilled,proto3" json:"units_fulfilled,omitempty"`
+	Deadline       string                 `protobuf:"bytes,6,opt,name=deadline,proto3" json:"deadline,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *SystemOverviewDelivery) Reset() {
+	*x = SystemOverviewDelivery{}
+	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[203]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *AddDepotResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *SystemOverviewDelivery) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SystemOverviewDelivery) ProtoMessage() {}
+
+func (x *SystemOverviewDelivery) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[203]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SystemOverviewDelivery.ProtoReflect.Descriptor instead.
+func (*SystemOverviewDelivery) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_daemon_daemon_proto_rawDescGZIP(), []int{203}
+}
+
+func (x *SystemOverviewDelivery) GetContractId() string {
+	if x != nil {
+		return x.ContractId
+	}
+	return ""
+}
+
+func (x *SystemOverviewDelivery) GetGood() string {
+	if x != nil {
+		return x.Good
+	}
+	return ""
+}
+
+func (x *SystemOverviewDelivery) GetDestination() string {
+	if x != nil {
+		return x.Destination
+	}
+	return ""
 }
 
-func (*AddDepotResponse) ProtoMessage() {}
-
-func (x *AddDepotResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[161]
+func (x *SystemOverviewDelivery) GetUnitsRequired() int32 {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.UnitsRequired
 	}
-	return mi.MessageOf(x)
+	return 0
 }
 
-// Deprecated: Use AddDepotResponse.ProtoReflect.Descriptor instead.
-func (*AddDepotResponse) Descriptor() ([]byte, []int) {
-	return file_pkg_proto_daemon_daemon_proto_rawDescGZIP(), []int{161}
+func (x *SystemOverviewDelivery) GetUnitsFulfilled() int32 {
+	if x != nil {
+		return x.UnitsFulfilled
+	}
+	return 0
 }
 
-func (x *AddDepotResponse) GetStatus() string {
+func (x *SystemOverviewDelivery) GetDeadline() string {
 	if x != nil {
-		return x.Status
+		return x.Deadline
 	}
 	return ""
 }
 
-// RemoveDepotRequest deletes ONE depot by id (granular, idempotent).
-type RemoveDepotRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	PlayerId      int32                  `protobuf:"varint,1,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"`
-	AgentSymbol   *string                `protobuf:"bytes,2,opt,name=agent_symbol,json=agentSymbol,proto3,oneof" json:"agent_symbol,omitempty"`
-	DepotId       string                 `protobuf:"bytes,3,opt,name=depot_id,json=depotId,proto3" json:"depot_id,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type SystemOverviewLane struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Good           string                 `protobuf:"bytes,1,opt,name=good,proto3" json:"good,omitempty"`
+	SourceWaypoint string                 `protobuf:"bytes,2,opt,name=source_waypoint,json=sourceWaypoint,proto3" json:"source_waypoint,omitempty"`
+	DestWaypoint   string                 `protobuf:"bytes,3,opt,name=dest_waypoint,json=destWaypoint,proto3" json:"dest_waypoint,omitempty"`
+	SourceAsk      int32                  `protobuf:"varint,4,opt,name=source_ask,json=sourceAsk,proto3" json:"source_ask,omitempty"`
+	DestBid        int32                  `protobuf:"varint,5,opt,name=dest_bid,json=destBid,proto3" json:"dest_bid,omitempty"`
+	SpreadPerUnit  int32                  `protobuf:"varint,6,opt,name=spread_per_unit,json=spreadPerUnit,proto3" json:"spread_per_unit,omitempty"`
+	SourceSupply   string                 `protobuf:"bytes,7,opt,name=source_supply,json=sourceSupply,proto3" json:"source_supply,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
-func (x *RemoveDepotRequest) Reset() {
-	*x = RemoveDepotRequest{}
-	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[162]
+func (x *SystemOverviewLane) Reset() {
+	*x = SystemOverviewLane{}
+	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[204]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RemoveDepotRequest) String() string {
+func (x *SystemOverviewLane) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RemoveDepotRequest) ProtoMessage() {}
+func (*SystemOverviewLane) ProtoMessage() {}
 
-func (x *RemoveDepotRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[162]
+func (x *SystemOverviewLane) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[204]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -11786,105 +14825,87 @@ func (x *RemoveDepotRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RemoveDepotRequest.ProtoReflect.Descriptor instead.
-func (*RemoveDepotRequest) Descriptor() ([]byte, []int) {
-	return file_pkg_proto_daemon_daemon_proto_rawDescGZIP(), []int{162}
+// Deprecated: Use SystemOverviewLane.ProtoReflect.Descriptor instead.
+func (*SystemOverviewLane) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_daemon_daemon_proto_rawDescGZIP(), []int{204}
 }
 
-func (x *RemoveDepotRequest) GetPlayerId() int32 {
+func (x *SystemOverviewLane) GetGood() string {
 	if x != nil {
-		return x.PlayerId
+		return x.Good
 	}
-	return 0
+	return ""
 }
 
-func (x *RemoveDepotRequest) GetAgentSymbol() string {
-	if x != nil && x.AgentSymbol != nil {
-		return *x.AgentSymbol
+func (x *SystemOverviewLane) GetSourceWaypoint() string {
+	if x != nil {
+		return x.SourceWaypoint
 	}
 	return ""
 }
 
-func (x *RemoveDepotRequest) GetDepotId() string {
+func (x *SystemOverviewLane) GetDestWaypoint() string {
 	if x != nil {
-		return x.DepotId
+		return x.DestWaypoint
 	}
 	return ""
 }
 
-type RemoveDepotResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Status        string                 `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *RemoveDepotResponse) Reset() {
-	*x = RemoveDepotResponse{}
-	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[163]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *RemoveDepotResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *SystemOverviewLane) GetSourceAsk() int32 {
+	if x != nil {
+		return x.SourceAsk
+	}
+	return 0
 }
 
-func (*RemoveDepotResponse) ProtoMessage() {}
-
-func (x *RemoveDepotResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[163]
+func (x *SystemOverviewLane) GetDestBid() int32 {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.DestBid
 	}
-	return mi.MessageOf(x)
+	return 0
 }
 
-// Deprecated: Use RemoveDepotResponse.ProtoReflect.Descriptor instead.
-func (*RemoveDepotResponse) Descriptor() ([]byte, []int) {
-	return file_pkg_proto_daemon_daemon_proto_rawDescGZIP(), []int{163}
+func (x *SystemOverviewLane) GetSpreadPerUnit() int32 {
+	if x != nil {
+		return x.SpreadPerUnit
+	}
+	return 0
 }
 
-func (x *RemoveDepotResponse) GetStatus() string {
+func (x *SystemOverviewLane) GetSourceSupply() string {
 	if x != nil {
-		return x.Status
+		return x.SourceSupply
 	}
 	return ""
 }
 
-// AddDepotElementRequest adds one element to a depot's named role (warehouse |
-// stocker | delivery-hull | source-hub).
-type AddDepotElementRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	PlayerId      int32                  `protobuf:"varint,1,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"`
-	AgentSymbol   *string                `protobuf:"bytes,2,opt,name=agent_symbol,json=agentSymbol,proto3,oneof" json:"agent_symbol,omitempty"`
-	DepotId       string                 `protobuf:"bytes,3,opt,name=depot_id,json=depotId,proto3" json:"depot_id,omitempty"`
-	Role          string                 `protobuf:"bytes,4,opt,name=role,proto3" json:"role,omitempty"`
-	Waypoint      string                 `protobuf:"bytes,5,opt,name=waypoint,proto3" json:"waypoint,omitempty"`
-	ShipSymbol    string                 `protobuf:"bytes,6,opt,name=ship_symbol,json=shipSymbol,proto3" json:"ship_symbol,omitempty"`
+type GetSystemOverviewResponse struct {
+	state         protoimpl.MessageState     `protogen:"open.v1"`
+	SystemSymbol  string                     `protobuf:"bytes,1,opt,name=system_symbol,json=systemSymbol,proto3" json:"system_symbol,omitempty"`
+	Ships         []*SystemOverviewShip      `protobuf:"bytes,2,rep,name=ships,proto3" json:"ships,omitempty"`
+	Containers    []*SystemOverviewContainer `protobuf:"bytes,3,rep,name=containers,proto3" json:"containers,omitempty"`
+	Markets       *SystemMarketFreshness     `protobuf:"bytes,4,opt,name=markets,proto3" json:"markets,omitempty"`
+	Deliveries    []*SystemOverviewDelivery  `protobuf:"bytes,5,rep,name=deliveries,proto3" json:"deliveries,omitempty"`
+	Lanes         []*SystemOverviewLane      `protobuf:"bytes,6,rep,name=lanes,proto3" json:"lanes,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *AddDepotElementRequest) Reset() {
-	*x = AddDepotElementRequest{}
-	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[164]
+func (x *GetSystemOverviewResponse) Reset() {
+	*x = GetSystemOverviewResponse{}
+	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[205]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *AddDepotElementRequest) String() string {
+func (x *GetSystemOverviewResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AddDepotElementRequest) ProtoMessage() {}
+func (*GetSystemOverviewResponse) ProtoMessage() {}
 
-func (x *AddDepotElementRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[164]
+func (x *GetSystemOverviewResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[205]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -11895,80 +14916,80 @@ func (x *AddDepotElementRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AddDepotElementRequest.ProtoReflect.Descriptor instead.
-func (*AddDepotElementRequest) Descriptor() ([]byte, []int) {
-	return file_pkg_proto_daemon_daemon_proto_rawDescGZIP(), []int{164}
+// Deprecated: Use GetSystemOverviewResponse.ProtoReflect.Descriptor instead.
+func (*GetSystemOverviewResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_daemon_daemon_proto_rawDescGZIP(), []int{205}
 }
 
-func (x *AddDepotElementRequest) GetPlayerId() int32 {
+func (x *GetSystemOverviewResponse) GetSystemSymbol() string {
 	if x != nil {
-		return x.PlayerId
+		return x.SystemSymbol
 	}
-	return 0
+	return ""
 }
 
-func (x *AddDepotElementRequest) GetAgentSymbol() string {
-	if x != nil && x.AgentSymbol != nil {
-		return *x.AgentSymbol
+func (x *GetSystemOverviewResponse) GetShips() []*SystemOverviewShip {
+	if x != nil {
+		return x.Ships
 	}
-	return ""
+	return nil
 }
 
-func (x *AddDepotElementRequest) GetDepotId() string {
+func (x *GetSystemOverviewResponse) GetContainers() []*SystemOverviewContainer {
 	if x != nil {
-		return x.DepotId
+		return x.Containers
 	}
-	return ""
+	return nil
 }
 
-func (x *AddDepotElementRequest) GetRole() string {
+func (x *GetSystemOverviewResponse) GetMarkets() *SystemMarketFreshness {
 	if x != nil {
-		return x.Role
+		return x.Markets
 	}
-	return ""
+	return nil
 }
 
-func (x *AddDepotElementRequest) GetWaypoint() string {
+func (x *GetSystemOverviewResponse) GetDeliveries() []*SystemOverviewDelivery {
 	if x != nil {
-		return x.Waypoint
+		return x.Deliveries
 	}
-	return ""
+	return nil
 }
 
-func (x *AddDepotElementRequest) GetShipSymbol() string {
+func (x *GetSystemOverviewResponse) GetLanes() []*SystemOverviewLane {
 	if x != nil {
-		return x.ShipSymbol
+		return x.Lanes
 	}
-	return ""
+	return nil
 }
 
-// RemoveDepotElementRequest drops the element crewed by ship_symbol from a role.
-type RemoveDepotElementRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	PlayerId      int32                  `protobuf:"varint,1,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"`
-	AgentSymbol   *string                `protobuf:"bytes,2,opt,name=agent_symbol,json=agentSymbol,proto3,oneof" json:"agent_symbol,omitempty"`
-	DepotId       string                 `protobuf:"bytes,3,opt,name=depot_id,json=depotId,proto3" json:"depot_id,omitempty"`
-	Role          string                 `protobuf:"bytes,4,opt,name=role,proto3" json:"role,omitempty"`
-	ShipSymbol    string                 `protobuf:"bytes,5,opt,name=ship_symbol,json=shipSymbol,proto3" json:"ship_symbol,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+// WarmSystemRequest launches a system warm-up. Markets scanned within
+// market_max_age_seconds are skipped; 0 rescans every reachable market.
+type WarmSystemRequest struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	SystemSymbol        string                 `protobuf:"bytes,1,opt,name=system_symbol,json=systemSymbol,proto3" json:"system_symbol,omitempty"`
+	MarketMaxAgeSeconds int32                  `protobuf:"varint,2,opt,name=market_max_age_seconds,json=marketMaxAgeSeconds,proto3" json:"market_max_age_seconds,omitempty"`
+	PlayerId            int32                  `protobuf:"varint,3,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"`
+	AgentSymbol         *string                `protobuf:"bytes,4,opt,name=agent_symbol,json=agentSymbol,proto3,oneof" json:"agent_symbol,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
 }
 
-func (x *RemoveDepotElementRequest) Reset() {
-	*x = RemoveDepotElementRequest{}
-	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[165]
+func (x *WarmSystemRequest) Reset() {
+	*x = WarmSystemRequest{}
+	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[206]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RemoveDepotElementRequest) String() string {
+func (x *WarmSystemRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RemoveDepotElementRequest) ProtoMessage() {}
+func (*WarmSystemRequest) ProtoMessage() {}
 
-func (x *RemoveDepotElementRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[165]
+func (x *WarmSystemRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[206]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -11979,75 +15000,123 @@ func (x *RemoveDepotElementRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RemoveDepotElementRequest.ProtoReflect.Descriptor instead.
-func (*RemoveDepotElementRequest) Descriptor() ([]byte, []int) {
-	return file_pkg_proto_daemon_daemon_proto_rawDescGZIP(), []int{165}
+// Deprecated: Use WarmSystemRequest.ProtoReflect.Descriptor instead.
+func (*WarmSystemRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_daemon_daemon_proto_rawDescGZIP(), []int{206}
 }
 
-func (x *RemoveDepotElementRequest) GetPlayerId() int32 {
+func (x *WarmSystemRequest) GetSystemSymbol() string {
+	if x != nil {
+		return x.SystemSymbol
+	}
+	return ""
+}
+
+func (x *WarmSystemRequest) GetMarketMaxAgeSeconds() int32 {
+	if x != nil {
+		return x.MarketMaxAgeSeconds
+	}
+	return 0
+}
+
+func (x *WarmSystemRequest) GetPlayerId() int32 {
 	if x != nil {
 		return x.PlayerId
 	}
 	return 0
 }
 
-func (x *RemoveDepotElementRequest) GetAgentSymbol() string {
+func (x *WarmSystemRequest) GetAgentSymbol() string {
 	if x != nil && x.AgentSymbol != nil {
 		return *x.AgentSymbol
 	}
 	return ""
 }
 
-func (x *RemoveDepotElementRequest) GetDepotId() string {
+type WarmSystemResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ContainerId   string                 `protobuf:"bytes,1,opt,name=container_id,json=containerId,proto3" json:"container_id,omitempty"`
+	SystemSymbol  string                 `protobuf:"bytes,2,opt,name=system_symbol,json=systemSymbol,proto3" json:"system_symbol,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WarmSystemResponse) Reset() {
+	*x = WarmSystemResponse{}
+	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[207]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WarmSystemResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WarmSystemResponse) ProtoMessage() {}
+
+func (x *WarmSystemResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[207]
 	if x != nil {
-		return x.DepotId
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *RemoveDepotElementRequest) GetRole() string {
+// Deprecated: Use WarmSystemResponse.ProtoReflect.Descriptor instead.
+func (*WarmSystemResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_daemon_daemon_proto_rawDescGZIP(), []int{207}
+}
+
+func (x *WarmSystemResponse) GetContainerId() string {
 	if x != nil {
-		return x.Role
+		return x.ContainerId
 	}
 	return ""
 }
 
-func (x *RemoveDepotElementRequest) GetShipSymbol() string {
+func (x *WarmSystemResponse) GetSystemSymbol() string {
 	if x != nil {
-		return x.ShipSymbol
+		return x.SystemSymbol
 	}
 	return ""
 }
 
-// PlaceDepotElementRequest repositions the element crewed by ship_symbol in a role to
-// waypoint (the parametrized co-location op).
-type PlaceDepotElementRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	PlayerId      int32                  `protobuf:"varint,1,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"`
-	AgentSymbol   *string                `protobuf:"bytes,2,opt,name=agent_symbol,json=agentSymbol,proto3,oneof" json:"agent_symbol,omitempty"`
-	DepotId       string                 `protobuf:"bytes,3,opt,name=depot_id,json=depotId,proto3" json:"depot_id,omitempty"`
-	Role          string                 `protobuf:"bytes,4,opt,name=role,proto3" json:"role,omitempty"`
-	ShipSymbol    string                 `protobuf:"bytes,5,opt,name=ship_symbol,json=shipSymbol,proto3" json:"ship_symbol,omitempty"`
-	Waypoint      string                 `protobuf:"bytes,6,opt,name=waypoint,proto3" json:"waypoint,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+// ScheduledJob is one recurring job and its run history.
+type ScheduledJob struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Name             string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Kind             string                 `protobuf:"bytes,2,opt,name=kind,proto3" json:"kind,omitempty"` // SCOUT_MARKETS, LEDGER_RECONCILE or MARKET_EXPORT
+	IntervalSeconds  int64                  `protobuf:"varint,3,opt,name=interval_seconds,json=intervalSeconds,proto3" json:"interval_seconds,omitempty"`
+	Params           map[string]string      `protobuf:"bytes,4,rep,name=params,proto3" json:"params,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Enabled          bool                   `protobuf:"varint,5,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	LastRunAt        string                 `protobuf:"bytes,6,opt,name=last_run_at,json=lastRunAt,proto3" json:"last_run_at,omitempty"`  // RFC3339, empty if never run
+	NextRunAt        string                 `protobuf:"bytes,7,opt,name=next_run_at,json=nextRunAt,proto3" json:"next_run_at,omitempty"`  // RFC3339
+	LastStatus       string                 `protobuf:"bytes,8,opt,name=last_status,json=lastStatus,proto3" json:"last_status,omitempty"` // SUCCEEDED, FAILED, SKIPPED or empty
+	LastError        string                 `protobuf:"bytes,9,opt,name=last_error,json=lastError,proto3" json:"last_error,omitempty"`
+	LastContainerIds []string               `protobuf:"bytes,10,rep,name=last_container_ids,json=lastContainerIds,proto3" json:"last_container_ids,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
 }
 
-func (x *PlaceDepotElementRequest) Reset() {
-	*x = PlaceDepotElementRequest{}
-	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[166]
+func (x *ScheduledJob) Reset() {
+	*x = ScheduledJob{}
+	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[208]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *PlaceDepotElementRequest) String() string {
+func (x *ScheduledJob) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PlaceDepotElementRequest) ProtoMessage() {}
+func (*ScheduledJob) ProtoMessage() {}
 
-func (x *PlaceDepotElementRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[166]
+func (x *ScheduledJob) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[208]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -12058,76 +15127,110 @@ func (x *PlaceDepotElementRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PlaceDepotElementRequest.ProtoReflect.Descriptor instead.
-func (*PlaceDepotElementRequest) Descriptor() ([]byte, []int) {
-	return file_pkg_proto_daemon_daemon_proto_rawDescGZIP(), []int{166}
+// Deprecated: Use ScheduledJob.ProtoReflect.Descriptor instead.
+func (*ScheduledJob) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_daemon_daemon_proto_rawDescGZIP(), []int{208}
+}
+
+func (x *ScheduledJob) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ScheduledJob) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *ScheduledJob) GetIntervalSeconds() int64 {
+	if x != nil {
+		return x.IntervalSeconds
+	}
+	return 0
+}
+
+func (x *ScheduledJob) GetParams() map[string]string {
+	if x != nil {
+		return x.Params
+	}
+	return nil
 }
 
-func (x *PlaceDepotElementRequest) GetPlayerId() int32 {
+func (x *ScheduledJob) GetEnabled() bool {
 	if x != nil {
-		return x.PlayerId
+		return x.Enabled
 	}
-	return 0
+	return false
 }
 
-func (x *PlaceDepotElementRequest) GetAgentSymbol() string {
-	if x != nil && x.AgentSymbol != nil {
-		return *x.AgentSymbol
+func (x *ScheduledJob) GetLastRunAt() string {
+	if x != nil {
+		return x.LastRunAt
 	}
 	return ""
 }
 
-func (x *PlaceDepotElementRequest) GetDepotId() string {
+func (x *ScheduledJob) GetNextRunAt() string {
 	if x != nil {
-		return x.DepotId
+		return x.NextRunAt
 	}
 	return ""
 }
 
-func (x *PlaceDepotElementRequest) GetRole() string {
+func (x *ScheduledJob) GetLastStatus() string {
 	if x != nil {
-		return x.Role
+		return x.LastStatus
 	}
 	return ""
 }
 
-func (x *PlaceDepotElementRequest) GetShipSymbol() string {
+func (x *ScheduledJob) GetLastError() string {
 	if x != nil {
-		return x.ShipSymbol
+		return x.LastError
 	}
 	return ""
 }
 
-func (x *PlaceDepotElementRequest) GetWaypoint() string {
+func (x *ScheduledJob) GetLastContainerIds() []string {
 	if x != nil {
-		return x.Waypoint
+		return x.LastContainerIds
 	}
-	return ""
+	return nil
 }
 
-// DepotElementResponse is the shared reply for the granular element ops.
-type DepotElementResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Status        string                 `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+// AddScheduledJobRequest defines a job; the first run is due at once.
+type AddScheduledJobRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Name            string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Kind            string                 `protobuf:"bytes,2,opt,name=kind,proto3" json:"kind,omitempty"`
+	IntervalSeconds int64                  `protobuf:"varint,3,opt,name=interval_seconds,json=intervalSeconds,proto3" json:"interval_seconds,omitempty"`
+	Params          map[string]string      `protobuf:"bytes,4,rep,name=params,proto3" json:"params,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Disabled        bool                   `protobuf:"varint,5,opt,name=disabled,proto3" json:"disabled,omitempty"`
+	PlayerId        int32                  `protobuf:"varint,6,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"`
+	AgentSymbol     *string                `protobuf:"bytes,7,opt,name=agent_symbol,json=agentSymbol,proto3,oneof" json:"agent_symbol,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
-func (x *DepotElementResponse) Reset() {
-	*x = DepotElementResponse{}
-	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[167]
+func (x *AddScheduledJobRequest) Reset() {
+	*x = AddScheduledJobRequest{}
+	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[209]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DepotElementResponse) String() string {
+func (x *AddScheduledJobRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DepotElementResponse) ProtoMessage() {}
+func (*AddScheduledJobRequest) ProtoMessage() {}
 
-func (x *DepotElementResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[167]
+func (x *AddScheduledJobRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[209]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -12138,92 +15241,82 @@ func (x *DepotElementResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DepotElementResponse.ProtoReflect.Descriptor instead.
-func (*DepotElementResponse) Descriptor() ([]byte, []int) {
-	return file_pkg_proto_daemon_daemon_proto_rawDescGZIP(), []int{167}
+// Deprecated: Use AddScheduledJobRequest.ProtoReflect.Descriptor instead.
+func (*AddScheduledJobRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_daemon_daemon_proto_rawDescGZIP(), []int{209}
 }
 
-func (x *DepotElementResponse) GetStatus() string {
+func (x *AddScheduledJobRequest) GetName() string {
 	if x != nil {
-		return x.Status
+		return x.Name
 	}
 	return ""
 }
 
-type ListDepotsRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	PlayerId      int32                  `protobuf:"varint,1,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"`
-	AgentSymbol   *string                `protobuf:"bytes,2,opt,name=agent_symbol,json=agentSymbol,proto3,oneof" json:"agent_symbol,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *ListDepotsRequest) Reset() {
-	*x = ListDepotsRequest{}
-	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[168]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *AddScheduledJobRequest) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
 }
 
-func (x *ListDepotsRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *AddScheduledJobRequest) GetIntervalSeconds() int64 {
+	if x != nil {
+		return x.IntervalSeconds
+	}
+	return 0
 }
 
-func (*ListDepotsRequest) ProtoMessage() {}
-
-func (x *ListDepotsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[168]
+func (x *AddScheduledJobRequest) GetParams() map[string]string {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.Params
 	}
-	return mi.MessageOf(x)
+	return nil
 }
 
-// Deprecated: Use ListDepotsRequest.ProtoReflect.Descriptor instead.
-func (*ListDepotsRequest) Descriptor() ([]byte, []int) {
-	return file_pkg_proto_daemon_daemon_proto_rawDescGZIP(), []int{168}
+func (x *AddScheduledJobRequest) GetDisabled() bool {
+	if x != nil {
+		return x.Disabled
+	}
+	return false
 }
 
-func (x *ListDepotsRequest) GetPlayerId() int32 {
+func (x *AddScheduledJobRequest) GetPlayerId() int32 {
 	if x != nil {
 		return x.PlayerId
 	}
 	return 0
 }
 
-func (x *ListDepotsRequest) GetAgentSymbol() string {
+func (x *AddScheduledJobRequest) GetAgentSymbol() string {
 	if x != nil && x.AgentSymbol != nil {
 		return *x.AgentSymbol
 	}
 	return ""
 }
 
-type ListDepotsResponse struct {
+type AddScheduledJobResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Depots        []*DepotSpec           `protobuf:"bytes,1,rep,name=depots,proto3" json:"depots,omitempty"`
+	Job           *ScheduledJob          `protobuf:"bytes,1,opt,name=job,proto3" json:"job,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListDepotsResponse) Reset() {
-	*x = ListDepotsResponse{}
-	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[169]
+func (x *AddScheduledJobResponse) Reset() {
+	*x = AddScheduledJobResponse{}
+	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[210]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListDepotsResponse) String() string {
+func (x *AddScheduledJobResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListDepotsResponse) ProtoMessage() {}
+func (*AddScheduledJobResponse) ProtoMessage() {}
 
-func (x *ListDepotsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[169]
+func (x *AddScheduledJobResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[210]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -12234,43 +15327,41 @@ func (x *ListDepotsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListDepotsResponse.ProtoReflect.Descriptor instead.
-func (*ListDepotsResponse) Descriptor() ([]byte, []int) {
-	return file_pkg_proto_daemon_daemon_proto_rawDescGZIP(), []int{169}
+// Deprecated: Use AddScheduledJobResponse.ProtoReflect.Descriptor instead.
+func (*AddScheduledJobResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_daemon_daemon_proto_rawDescGZIP(), []int{210}
 }
 
-func (x *ListDepotsResponse) GetDepots() []*DepotSpec {
+func (x *AddScheduledJobResponse) GetJob() *ScheduledJob {
 	if x != nil {
-		return x.Depots
+		return x.Job
 	}
 	return nil
 }
 
-// StartDepotRequest persists ONE depot's topology and launches its coordinators (sp-38xc).
-type StartDepotRequest struct {
+type ListScheduledJobsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	PlayerId      int32                  `protobuf:"varint,1,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"`
 	AgentSymbol   *string                `protobuf:"bytes,2,opt,name=agent_symbol,json=agentSymbol,proto3,oneof" json:"agent_symbol,omitempty"`
-	Depot         *DepotSpec             `protobuf:"bytes,3,opt,name=depot,proto3" json:"depot,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *StartDepotRequest) Reset() {
-	*x = StartDepotRequest{}
-	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[170]
+func (x *ListScheduledJobsRequest) Reset() {
+	*x = ListScheduledJobsRequest{}
+	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[211]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *StartDepotRequest) String() string {
+func (x *ListScheduledJobsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*StartDepotRequest) ProtoMessage() {}
+func (*ListScheduledJobsRequest) ProtoMessage() {}
 
-func (x *StartDepotRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[170]
+func (x *ListScheduledJobsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[211]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -12281,55 +15372,47 @@ func (x *StartDepotRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use StartDepotRequest.ProtoReflect.Descriptor instead.
-func (*StartDepotRequest) Descriptor() ([]byte, []int) {
-	return file_pkg_proto_daemon_daemon_proto_rawDescGZIP(), []int{170}
+// Deprecated: Use ListScheduledJobsRequest.ProtoReflect.Descriptor instead.
+func (*ListScheduledJobsRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_daemon_daemon_proto_rawDescGZIP(), []int{211}
 }
 
-func (x *StartDepotRequest) GetPlayerId() int32 {
+func (x *ListScheduledJobsRequest) GetPlayerId() int32 {
 	if x != nil {
 		return x.PlayerId
 	}
 	return 0
 }
 
-func (x *StartDepotRequest) GetAgentSymbol() string {
+func (x *ListScheduledJobsRequest) GetAgentSymbol() string {
 	if x != nil && x.AgentSymbol != nil {
 		return *x.AgentSymbol
 	}
 	return ""
 }
 
-func (x *StartDepotRequest) GetDepot() *DepotSpec {
-	if x != nil {
-		return x.Depot
-	}
-	return nil
-}
-
-type StartDepotResponse struct {
+type ListScheduledJobsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Status        string                 `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
-	Launched      int32                  `protobuf:"varint,2,opt,name=launched,proto3" json:"launched,omitempty"` // coordinators (warehouses + stockers) launched
+	Jobs          []*ScheduledJob        `protobuf:"bytes,1,rep,name=jobs,proto3" json:"jobs,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *StartDepotResponse) Reset() {
-	*x = StartDepotResponse{}
-	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[171]
+func (x *ListScheduledJobsResponse) Reset() {
+	*x = ListScheduledJobsResponse{}
+	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[212]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *StartDepotResponse) String() string {
+func (x *ListScheduledJobsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*StartDepotResponse) ProtoMessage() {}
+func (*ListScheduledJobsResponse) ProtoMessage() {}
 
-func (x *StartDepotResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[171]
+func (x *ListScheduledJobsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[212]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -12340,50 +15423,42 @@ func (x *StartDepotResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use StartDepotResponse.ProtoReflect.Descriptor instead.
-func (*StartDepotResponse) Descriptor() ([]byte, []int) {
-	return file_pkg_proto_daemon_daemon_proto_rawDescGZIP(), []int{171}
-}
-
-func (x *StartDepotResponse) GetStatus() string {
-	if x != nil {
-		return x.Status
-	}
-	return ""
+// Deprecated: Use ListScheduledJobsResponse.ProtoReflect.Descriptor instead.
+func (*ListScheduledJobsResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_daemon_daemon_proto_rawDescGZIP(), []int{212}
 }
 
-func (x *StartDepotResponse) GetLaunched() int32 {
+func (x *ListScheduledJobsResponse) GetJobs() []*ScheduledJob {
 	if x != nil {
-		return x.Launched
+		return x.Jobs
 	}
-	return 0
+	return nil
 }
 
-// StopDepotRequest tears down the named depot's running coordinators (sp-38xc).
-type StopDepotRequest struct {
+type RemoveScheduledJobRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	PlayerId      int32                  `protobuf:"varint,1,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"`
-	AgentSymbol   *string                `protobuf:"bytes,2,opt,name=agent_symbol,json=agentSymbol,proto3,oneof" json:"agent_symbol,omitempty"`
-	DepotId       string                 `protobuf:"bytes,3,opt,name=depot_id,json=depotId,proto3" json:"depot_id,omitempty"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	PlayerId      int32                  `protobuf:"varint,2,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"`
+	AgentSymbol   *string                `protobuf:"bytes,3,opt,name=agent_symbol,json=agentSymbol,proto3,oneof" json:"agent_symbol,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *StopDepotRequest) Reset() {
-	*x = StopDepotRequest{}
-	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[172]
+func (x *RemoveScheduledJobRequest) Reset() {
+	*x = RemoveScheduledJobRequest{}
+	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[213]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *StopDepotRequest) String() string {
+func (x *RemoveScheduledJobRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*StopDepotRequest) ProtoMessage() {}
+func (*RemoveScheduledJobRequest) ProtoMessage() {}
 
-func (x *StopDepotRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[172]
+func (x *RemoveScheduledJobRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[213]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -12394,55 +15469,54 @@ func (x *StopDepotRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use StopDepotRequest.ProtoReflect.Descriptor instead.
-func (*StopDepotRequest) Descriptor() ([]byte, []int) {
-	return file_pkg_proto_daemon_daemon_proto_rawDescGZIP(), []int{172}
+// Deprecated: Use RemoveScheduledJobRequest.ProtoReflect.Descriptor instead.
+func (*RemoveScheduledJobRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_daemon_daemon_proto_rawDescGZIP(), []int{213}
 }
 
-func (x *StopDepotRequest) GetPlayerId() int32 {
+func (x *RemoveScheduledJobRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *RemoveScheduledJobRequest) GetPlayerId() int32 {
 	if x != nil {
 		return x.PlayerId
 	}
 	return 0
 }
 
-func (x *StopDepotRequest) GetAgentSymbol() string {
+func (x *RemoveScheduledJobRequest) GetAgentSymbol() string {
 	if x != nil && x.AgentSymbol != nil {
 		return *x.AgentSymbol
 	}
 	return ""
 }
 
-func (x *StopDepotRequest) GetDepotId() string {
-	if x != nil {
-		return x.DepotId
-	}
-	return ""
-}
-
-type StopDepotResponse struct {
+type RemoveScheduledJobResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Status        string                 `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
-	Stopped       int32                  `protobuf:"varint,2,opt,name=stopped,proto3" json:"stopped,omitempty"` // containers stopped
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *StopDepotResponse) Reset() {
-	*x = StopDepotResponse{}
-	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[173]
+func (x *RemoveScheduledJobResponse) Reset() {
+	*x = RemoveScheduledJobResponse{}
+	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[214]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *StopDepotResponse) String() string {
+func (x *RemoveScheduledJobResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*StopDepotResponse) ProtoMessage() {}
+func (*RemoveScheduledJobResponse) ProtoMessage() {}
 
-func (x *StopDepotResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[173]
+func (x *RemoveScheduledJobResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_daemon_daemon_proto_msgTypes[214]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -12453,37 +15527,34 @@ func (x *StopDepotResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use StopDepotResponse.ProtoReflect.Descriptor instead.
-func (*StopDepotResponse) Descriptor() ([]byte, []int) {
-	return file_pkg_proto_daemon_daemon_proto_rawDescGZIP(), []int{173}
+// Deprecated: Use RemoveScheduledJobResponse.ProtoReflect.Descriptor instead.
+func (*RemoveScheduledJobResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_daemon_daemon_proto_rawDescGZIP(), []int{214}
 }
 
-func (x *StopDepotResponse) GetStatus() string {
+func (x *RemoveScheduledJobResponse) GetName() string {
 	if x != nil {
-		return x.Status
+		return x.Name
 	}
 	return ""
 }
 
-func (x *StopDepotResponse) GetStopped() int32 {
-	if x != nil {
-		return x.Stopped
-	}
-	return 0
-}
-
 var File_pkg_proto_daemon_daemon_proto protoreflect.FileDescriptor
 
 const file_pkg_proto_daemon_daemon_proto_rawDesc = "" +
 	"\n" +
-	"\x1dpkg/proto/daemon/daemon.proto\x12\x06daemon\"\xae\x01\n" +
+	"\x1dpkg/proto/daemon/daemon.proto\x12\x06daemon\"\xc0\x02\n" +
 	"\x13NavigateShipRequest\x12\x1f\n" +
 	"\vship_symbol\x18\x01 \x01(\tR\n" +
 	"shipSymbol\x12 \n" +
 	"\vdestination\x18\x02 \x01(\tR\vdestination\x12\x1b\n" +
 	"\tplayer_id\x18\x03 \x01(\x05R\bplayerId\x12&\n" +
-	"\fagent_symbol\x18\x04 \x01(\tH\x00R\vagentSymbol\x88\x01\x01B\x0f\n" +
-	"\r_agent_symbol\"\xca\x01\n" +
+	"\fagent_symbol\x18\x04 \x01(\tH\x00R\vagentSymbol\x88\x01\x01\x12,\n" +
+	"\x0fidempotency_key\x18\x05 \x01(\tH\x01R\x0eidempotencyKey\x88\x01\x01\x125\n" +
+	"\x14fuel_reserve_percent\x18\x06 \x01(\x05H\x02R\x12fuelReservePercent\x88\x01\x01B\x0f\n" +
+	"\r_agent_symbolB\x12\n" +
+	"\x10_idempotency_keyB\x17\n" +
+	"\x15_fuel_reserve_percent\"\xca\x01\n" +
 	"\x14NavigateShipResponse\x12!\n" +
 	"\fcontainer_id\x18\x01 \x01(\tR\vcontainerId\x12\x1f\n" +
 	"\vship_symbol\x18\x02 \x01(\tR\n" +
@@ -12734,7 +15805,54 @@ const file_pkg_proto_daemon_daemon_proto_rawDesc = "" +
 	"\r_agent_symbol\"Y\n" +
 	"\x1cBootstrapCoordinatorResponse\x12!\n" +
 	"\fcontainer_id\x18\x01 \x01(\tR\vcontainerId\x12\x16\n" +
-	"\x06status\x18\x02 \x01(\tR\x06status\"\x95\x01\n" +
+	"\x06status\x18\x02 \x01(\tR\x06status\"\xe2\x01\n" +
+	"\x15BootstrapFleetRequest\x12\x1b\n" +
+	"\tplayer_id\x18\x01 \x01(\x05R\bplayerId\x12&\n" +
+	"\fagent_symbol\x18\x02 \x01(\tH\x00R\vagentSymbol\x88\x01\x01\x12\x18\n" +
+	"\aprofile\x18\x03 \x01(\tR\aprofile\x12,\n" +
+	"\x0fpurchasing_ship\x18\x04 \x01(\tH\x01R\x0epurchasingShip\x88\x01\x01\x12\x17\n" +
+	"\adry_run\x18\x05 \x01(\bR\x06dryRunB\x0f\n" +
+	"\r_agent_symbolB\x12\n" +
+	"\x10_purchasing_ship\"\xe5\x01\n" +
+	"\x16BootstrapFleetResponse\x12!\n" +
+	"\fcontainer_id\x18\x01 \x01(\tR\vcontainerId\x12\x1a\n" +
+	"\btemplate\x18\x02 \x01(\tR\btemplate\x12\x17\n" +
+	"\adry_run\x18\x03 \x01(\bR\x06dryRun\x127\n" +
+	"\tpurchases\x18\x04 \x03(\v2\x19.daemon.FleetPurchasePlanR\tpurchases\x12:\n" +
+	"\n" +
+	"containers\x18\x05 \x03(\v2\x1a.daemon.FleetContainerPlanR\n" +
+	"containers\"\xa0\x01\n" +
+	"\x11FleetPurchasePlan\x12\x1b\n" +
+	"\tship_type\x18\x01 \x01(\tR\bshipType\x12\x16\n" +
+	"\x06wanted\x18\x02 \x01(\x05R\x06wanted\x12\x14\n" +
+	"\x05owned\x18\x03 \x01(\x05R\x05owned\x12\x16\n" +
+	"\x06bought\x18\x04 \x01(\x05R\x06bought\x12\x12\n" +
+	"\x04cost\x18\x05 \x01(\x03R\x04cost\x12\x14\n" +
+	"\x05error\x18\x06 \x01(\tR\x05error\"\x9e\x01\n" +
+	"\x12FleetContainerPlan\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12!\n" +
+	"\fcommand_type\x18\x02 \x01(\tR\vcommandType\x12!\n" +
+	"\fcontainer_id\x18\x03 \x01(\tR\vcontainerId\x12\x16\n" +
+	"\x06status\x18\x04 \x01(\tR\x06status\x12\x16\n" +
+	"\x06reason\x18\x05 \x01(\tR\x06reason\"\xa8\x01\n" +
+	"\x1dLaunchOperationProfileRequest\x12\x1b\n" +
+	"\tplayer_id\x18\x01 \x01(\x05R\bplayerId\x12&\n" +
+	"\fagent_symbol\x18\x02 \x01(\tH\x00R\vagentSymbol\x88\x01\x01\x12\x18\n" +
+	"\aprofile\x18\x03 \x01(\tR\aprofile\x12\x17\n" +
+	"\adry_run\x18\x04 \x01(\bR\x06dryRunB\x0f\n" +
+	"\r_agent_symbol\"\xd8\x01\n" +
+	"\x1eLaunchOperationProfileResponse\x12\x18\n" +
+	"\aprofile\x18\x01 \x01(\tR\aprofile\x12\x18\n" +
+	"\aversion\x18\x02 \x01(\x05R\aversion\x12\x16\n" +
+	"\x06digest\x18\x03 \x01(\tR\x06digest\x12\x1c\n" +
+	"\toperation\x18\x04 \x01(\tR\toperation\x12\x17\n" +
+	"\adry_run\x18\x05 \x01(\bR\x06dryRun\x123\n" +
+	"\blaunches\x18\x06 \x03(\v2\x17.daemon.OperationLaunchR\blaunches\"~\n" +
+	"\x0fOperationLaunch\x12\x16\n" +
+	"\x06target\x18\x01 \x01(\tR\x06target\x12#\n" +
+	"\rcontainer_ids\x18\x02 \x03(\tR\fcontainerIds\x12\x16\n" +
+	"\x06status\x18\x03 \x01(\tR\x06status\x12\x16\n" +
+	"\x06reason\x18\x04 \x01(\tR\x06reason\"\x95\x01\n" +
 	"$CapacityReconcilerCoordinatorRequest\x12\x1b\n" +
 	"\tplayer_id\x18\x01 \x01(\x05R\bplayerId\x12&\n" +
 	"\fagent_symbol\x18\x02 \x01(\tH\x00R\vagentSymbol\x88\x01\x01\x12\x17\n" +
@@ -12772,6 +15890,31 @@ const file_pkg_proto_daemon_daemon_proto_rawDesc = "" +
 	"\r_agent_symbol\"`\n" +
 	"#ShipyardBackfillCoordinatorResponse\x12!\n" +
 	"\fcontainer_id\x18\x01 \x01(\tR\vcontainerId\x12\x16\n" +
+	"\x06status\x18\x02 \x01(\tR\x06status\"\x82\x02\n" +
+	"\x1eProbeParkingCoordinatorRequest\x12\x1b\n" +
+	"\tplayer_id\x18\x01 \x01(\x05R\bplayerId\x12&\n" +
+	"\fagent_symbol\x18\x02 \x01(\tH\x00R\vagentSymbol\x88\x01\x01\x12,\n" +
+	"\x12tick_interval_secs\x18\x03 \x01(\x05R\x10tickIntervalSecs\x12,\n" +
+	"\x12scan_interval_secs\x18\x04 \x01(\x05R\x10scanIntervalSecs\x12.\n" +
+	"\x13reserve_idle_probes\x18\x05 \x01(\x05R\x11reserveIdleProbesB\x0f\n" +
+	"\r_agent_symbol\"\\\n" +
+	"\x1fProbeParkingCoordinatorResponse\x12!\n" +
+	"\fcontainer_id\x18\x01 \x01(\tR\vcontainerId\x12\x16\n" +
+	"\x06status\x18\x02 \x01(\tR\x06status\"\xfe\x02\n" +
+	"\x18TankerCoordinatorRequest\x12\x1b\n" +
+	"\tplayer_id\x18\x01 \x01(\x05R\bplayerId\x12&\n" +
+	"\fagent_symbol\x18\x02 \x01(\tH\x00R\vagentSymbol\x88\x01\x01\x12!\n" +
+	"\ftanker_ships\x18\x03 \x03(\tR\vtankerShips\x12&\n" +
+	"\x0ftanker_ship_tag\x18\x04 \x01(\tR\rtankerShipTag\x12\x1a\n" +
+	"\bstations\x18\x05 \x03(\tR\bstations\x12\x1f\n" +
+	"\vfuel_source\x18\x06 \x01(\tR\n" +
+	"fuelSource\x12(\n" +
+	"\x10refuel_below_pct\x18\a \x01(\x05R\x0erefuelBelowPct\x12,\n" +
+	"\x12reload_below_units\x18\b \x01(\x05R\x10reloadBelowUnits\x12,\n" +
+	"\x12tick_interval_secs\x18\t \x01(\x05R\x10tickIntervalSecsB\x0f\n" +
+	"\r_agent_symbol\"V\n" +
+	"\x19TankerCoordinatorResponse\x12!\n" +
+	"\fcontainer_id\x18\x01 \x01(\tR\vcontainerId\x12\x16\n" +
 	"\x06status\x18\x02 \x01(\tR\x06status\"\x93\x01\n" +
 	"\"WorkerRebalancerCoordinatorRequest\x12\x1b\n" +
 	"\tplayer_id\x18\x01 \x01(\x05R\bplayerId\x12&\n" +
@@ -12822,11 +15965,12 @@ const file_pkg_proto_daemon_daemon_proto_rawDesc = "" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12.\n" +
 	"\x05value\x18\x02 \x01(\v2\x18.daemon.MarketAssignmentR\x05value:\x028\x01\",\n" +
 	"\x10MarketAssignment\x12\x18\n" +
-	"\amarkets\x18\x01 \x03(\tR\amarkets\"\x97\x01\n" +
+	"\amarkets\x18\x01 \x03(\tR\amarkets\"\xcb\x01\n" +
 	"\x1aAssignScoutingFleetRequest\x12#\n" +
 	"\rsystem_symbol\x18\x01 \x01(\tR\fsystemSymbol\x12\x1b\n" +
 	"\tplayer_id\x18\x02 \x01(\x05R\bplayerId\x12&\n" +
-	"\fagent_symbol\x18\x03 \x01(\tH\x00R\vagentSymbol\x88\x01\x01B\x0f\n" +
+	"\fagent_symbol\x18\x03 \x01(\tH\x00R\vagentSymbol\x88\x01\x01\x122\n" +
+	"\x15freshness_sla_seconds\x18\x04 \x01(\x05R\x13freshnessSlaSecondsB\x0f\n" +
 	"\r_agent_symbol\"@\n" +
 	"\x1bAssignScoutingFleetResponse\x12!\n" +
 	"\fcontainer_id\x18\x01 \x01(\tR\vcontainerId\"o\n" +
@@ -12865,7 +16009,26 @@ const file_pkg_proto_daemon_daemon_proto_rawDesc = "" +
 	"\x15StopContainerResponse\x12!\n" +
 	"\fcontainer_id\x18\x01 \x01(\tR\vcontainerId\x12\x16\n" +
 	"\x06status\x18\x02 \x01(\tR\x06status\x12\x18\n" +
-	"\amessage\x18\x03 \x01(\tR\amessage\"\x86\x01\n" +
+	"\amessage\x18\x03 \x01(\tR\amessage\":\n" +
+	"\x15PauseContainerRequest\x12!\n" +
+	"\fcontainer_id\x18\x01 \x01(\tR\vcontainerId\"o\n" +
+	"\x16PauseContainerResponse\x12!\n" +
+	"\fcontainer_id\x18\x01 \x01(\tR\vcontainerId\x12\x16\n" +
+	"\x06status\x18\x02 \x01(\tR\x06status\x12\x1a\n" +
+	"\bchildren\x18\x03 \x03(\tR\bchildren\";\n" +
+	"\x16ResumeContainerRequest\x12!\n" +
+	"\fcontainer_id\x18\x01 \x01(\tR\vcontainerId\"p\n" +
+	"\x17ResumeContainerResponse\x12!\n" +
+	"\fcontainer_id\x18\x01 \x01(\tR\vcontainerId\x12\x16\n" +
+	"\x06status\x18\x02 \x01(\tR\x06status\x12\x1a\n" +
+	"\bchildren\x18\x03 \x03(\tR\bchildren\"V\n" +
+	"\x1bSetContainerLogLevelRequest\x12!\n" +
+	"\fcontainer_id\x18\x01 \x01(\tR\vcontainerId\x12\x14\n" +
+	"\x05level\x18\x02 \x01(\tR\x05level\"~\n" +
+	"\x1cSetContainerLogLevelResponse\x12!\n" +
+	"\fcontainer_id\x18\x01 \x01(\tR\vcontainerId\x12%\n" +
+	"\x0eprevious_level\x18\x02 \x01(\tR\rpreviousLevel\x12\x14\n" +
+	"\x05level\x18\x03 \x01(\tR\x05level\"\x86\x01\n" +
 	"\x17GetContainerLogsRequest\x12!\n" +
 	"\fcontainer_id\x18\x01 \x01(\tR\vcontainerId\x12\x19\n" +
 	"\x05limit\x18\x02 \x01(\x05H\x00R\x05limit\x88\x01\x01\x12\x19\n" +
@@ -12879,11 +16042,19 @@ const file_pkg_proto_daemon_daemon_proto_rawDesc = "" +
 	"\x05level\x18\x02 \x01(\tR\x05level\x12\x18\n" +
 	"\amessage\x18\x03 \x01(\tR\amessage\x12\x1a\n" +
 	"\bmetadata\x18\x04 \x01(\tR\bmetadata\"\x14\n" +
-	"\x12HealthCheckRequest\"t\n" +
+	"\x12HealthCheckRequest\"\xb0\x02\n" +
 	"\x13HealthCheckResponse\x12\x16\n" +
 	"\x06status\x18\x01 \x01(\tR\x06status\x12\x18\n" +
 	"\aversion\x18\x02 \x01(\tR\aversion\x12+\n" +
-	"\x11active_containers\x18\x03 \x01(\x05R\x10activeContainers\"\x15\n" +
+	"\x11active_containers\x18\x03 \x01(\x05R\x10activeContainers\x12+\n" +
+	"\x11paused_containers\x18\x04 \x01(\x05R\x10pausedContainers\x121\n" +
+	"\x14runtime_terminations\x18\x05 \x01(\x05R\x13runtimeTerminations\x12Z\n" +
+	"\x1brecent_runtime_terminations\x18\x06 \x03(\v2\x1a.daemon.RuntimeTerminationR\x19recentRuntimeTerminations\"\xac\x01\n" +
+	"\x12RuntimeTermination\x12!\n" +
+	"\fcontainer_id\x18\x01 \x01(\tR\vcontainerId\x12%\n" +
+	"\x0econtainer_type\x18\x02 \x01(\tR\rcontainerType\x12'\n" +
+	"\x0fruntime_seconds\x18\x03 \x01(\x03R\x0eruntimeSeconds\x12#\n" +
+	"\rterminated_at\x18\x04 \x01(\tR\fterminatedAt\"\x15\n" +
 	"\x13GetAPIBudgetRequest\"v\n" +
 	"\x12APIBudgetHullStats\x12\x12\n" +
 	"\x04hull\x18\x01 \x01(\tR\x04hull\x12,\n" +
@@ -12944,12 +16115,13 @@ const file_pkg_proto_daemon_daemon_proto_rawDesc = "" +
 	"\vcargo_units\x18\x06 \x01(\x05R\n" +
 	"cargoUnits\x12%\n" +
 	"\x0ecargo_capacity\x18\a \x01(\x05R\rcargoCapacity\x12!\n" +
-	"\fengine_speed\x18\b \x01(\x05R\vengineSpeed\"\x9a\x01\n" +
+	"\fengine_speed\x18\b \x01(\x05R\vengineSpeed\"\xb5\x01\n" +
 	"\x0eGetShipRequest\x12\x1f\n" +
 	"\vship_symbol\x18\x01 \x01(\tR\n" +
 	"shipSymbol\x12 \n" +
 	"\tplayer_id\x18\x02 \x01(\x05H\x00R\bplayerId\x88\x01\x01\x12&\n" +
-	"\fagent_symbol\x18\x03 \x01(\tH\x01R\vagentSymbol\x88\x01\x01B\f\n" +
+	"\fagent_symbol\x18\x03 \x01(\tH\x01R\vagentSymbol\x88\x01\x01\x12\x19\n" +
+	"\bno_cache\x18\x04 \x01(\bR\anoCacheB\f\n" +
 	"\n" +
 	"_player_idB\x0f\n" +
 	"\r_agent_symbol\"9\n" +
@@ -13120,7 +16292,7 @@ const file_pkg_proto_daemon_daemon_proto_rawDesc = "" +
 	"\x15purchased_ship_symbol\x18\x02 \x01(\tR\x13purchasedShipSymbol\x12%\n" +
 	"\x0epurchase_price\x18\x03 \x01(\x05R\rpurchasePrice\x12#\n" +
 	"\ragent_credits\x18\x04 \x01(\x05R\fagentCredits\x12\x16\n" +
-	"\x06status\x18\x05 \x01(\tR\x06status\"\xfb\x02\n" +
+	"\x06status\x18\x05 \x01(\tR\x06status\"\xc1\x05\n" +
 	"\x19BatchPurchaseShipsRequest\x124\n" +
 	"\x16purchasing_ship_symbol\x18\x01 \x01(\tR\x14purchasingShipSymbol\x12\x1b\n" +
 	"\tship_type\x18\x02 \x01(\tR\bshipType\x12\x1a\n" +
@@ -13132,10 +16304,26 @@ const file_pkg_proto_daemon_daemon_proto_rawDesc = "" +
 	"\x11shipyard_waypoint\x18\a \x01(\tH\x01R\x10shipyardWaypoint\x88\x01\x01\x12#\n" +
 	"\n" +
 	"iterations\x18\b \x01(\x05H\x02R\n" +
-	"iterations\x88\x01\x01B\x0f\n" +
+	"iterations\x88\x01\x01\x12\x1d\n" +
+	"\aloadout\x18\t \x01(\tH\x03R\aloadout\x88\x01\x01\x12 \n" +
+	"\tmax_price\x18\n" +
+	" \x01(\x05H\x04R\bmaxPrice\x88\x01\x01\x12%\n" +
+	"\fwait_for_dip\x18\v \x01(\bH\x05R\n" +
+	"waitForDip\x88\x01\x01\x12&\n" +
+	"\fdip_deadline\x18\f \x01(\tH\x06R\vdipDeadline\x88\x01\x01\x12'\n" +
+	"\rdip_poll_secs\x18\r \x01(\x05H\aR\vdipPollSecs\x88\x01\x01\x12*\n" +
+	"\x0efailure_policy\x18\x0e \x01(\tH\bR\rfailurePolicy\x88\x01\x01B\x0f\n" +
 	"\r_agent_symbolB\x14\n" +
 	"\x12_shipyard_waypointB\r\n" +
-	"\v_iterations\"\xcf\x01\n" +
+	"\v_iterationsB\n" +
+	"\n" +
+	"\b_loadoutB\f\n" +
+	"\n" +
+	"_max_priceB\x0f\n" +
+	"\r_wait_for_dipB\x0f\n" +
+	"\r_dip_deadlineB\x10\n" +
+	"\x0e_dip_poll_secsB\x11\n" +
+	"\x0f_failure_policy\"\xcf\x01\n" +
 	"\x1aBatchPurchaseShipsResponse\x12!\n" +
 	"\fcontainer_id\x18\x01 \x01(\tR\vcontainerId\x12*\n" +
 	"\x11ships_to_purchase\x18\x02 \x01(\x05R\x0fshipsToPurchase\x12\x1d\n" +
@@ -13698,7 +16886,178 @@ const file_pkg_proto_daemon_daemon_proto_rawDesc = "" +
 	"\r_agent_symbol\"E\n" +
 	"\x11StopDepotResponse\x12\x16\n" +
 	"\x06status\x18\x01 \x01(\tR\x06status\x12\x18\n" +
-	"\astopped\x18\x02 \x01(\x05R\astopped2\xf52\n" +
+	"\astopped\x18\x02 \x01(\x05R\astopped\"\xd1\x01\n" +
+	"\x14RegisterAgentRequest\x12!\n" +
+	"\fagent_symbol\x18\x01 \x01(\tR\vagentSymbol\x12\x18\n" +
+	"\afaction\x18\x02 \x01(\tR\afaction\x12#\n" +
+	"\raccount_token\x18\x03 \x01(\tR\faccountToken\x12\x19\n" +
+	"\x05token\x18\x04 \x01(\tH\x00R\x05token\x88\x01\x01\x122\n" +
+	"\x15skip_starter_contract\x18\x05 \x01(\bR\x13skipStarterContractB\b\n" +
+	"\x06_token\"\xd7\x03\n" +
+	"\x15RegisterAgentResponse\x12\x1b\n" +
+	"\tplayer_id\x18\x01 \x01(\x05R\bplayerId\x12!\n" +
+	"\fagent_symbol\x18\x02 \x01(\tR\vagentSymbol\x12\x18\n" +
+	"\afaction\x18\x03 \x01(\tR\afaction\x12\"\n" +
+	"\fheadquarters\x18\x04 \x01(\tR\fheadquarters\x12\x18\n" +
+	"\acredits\x18\x05 \x01(\x03R\acredits\x12!\n" +
+	"\fships_synced\x18\x06 \x01(\x05R\vshipsSynced\x12\x1e\n" +
+	"\n" +
+	"registered\x18\a \x01(\bR\n" +
+	"registered\x12.\n" +
+	"\x13starter_contract_id\x18\b \x01(\tR\x11starterContractId\x12:\n" +
+	"\x19starter_contract_accepted\x18\t \x01(\bR\x17starterContractAccepted\x12C\n" +
+	"\x1econtract_workflow_container_id\x18\n" +
+	" \x01(\tR\x1bcontractWorkflowContainerId\x122\n" +
+	"\x15starter_contract_note\x18\v \x01(\tR\x13starterContractNote\"\xd1\x01\n" +
+	"\x17ExportMarketDataRequest\x12#\n" +
+	"\rsystem_symbol\x18\x01 \x01(\tR\fsystemSymbol\x12\x16\n" +
+	"\x06format\x18\x02 \x01(\tR\x06format\x12#\n" +
+	"\rhistory_hours\x18\x03 \x01(\x05R\fhistoryHours\x12\x1b\n" +
+	"\tplayer_id\x18\x04 \x01(\x05R\bplayerId\x12&\n" +
+	"\fagent_symbol\x18\x05 \x01(\tH\x00R\vagentSymbol\x88\x01\x01B\x0f\n" +
+	"\r_agent_symbol\"\xb7\x01\n" +
+	"\x18ExportMarketDataResponse\x12\x16\n" +
+	"\x06format\x18\x01 \x01(\tR\x06format\x12\x18\n" +
+	"\acontent\x18\x02 \x01(\fR\acontent\x12!\n" +
+	"\fmarket_count\x18\x03 \x01(\x05R\vmarketCount\x12#\n" +
+	"\rsnapshot_rows\x18\x04 \x01(\x05R\fsnapshotRows\x12!\n" +
+	"\fhistory_rows\x18\x05 \x01(\x05R\vhistoryRows\"\xe4\x01\n" +
+	"\x1fGetSupplyTransitionStatsRequest\x12'\n" +
+	"\x0fwaypoint_symbol\x18\x01 \x01(\tR\x0ewaypointSymbol\x12\x1f\n" +
+	"\vgood_symbol\x18\x02 \x01(\tR\n" +
+	"goodSymbol\x12!\n" +
+	"\fwindow_hours\x18\x03 \x01(\x05R\vwindowHours\x12\x1b\n" +
+	"\tplayer_id\x18\x04 \x01(\x05R\bplayerId\x12&\n" +
+	"\fagent_symbol\x18\x05 \x01(\tH\x00R\vagentSymbol\x88\x01\x01B\x0f\n" +
+	"\r_agent_symbol\"\xb5\x04\n" +
+	"\x14SupplyTransitionStat\x12'\n" +
+	"\x0fwaypoint_symbol\x18\x01 \x01(\tR\x0ewaypointSymbol\x12\x1f\n" +
+	"\vgood_symbol\x18\x02 \x01(\tR\n" +
+	"goodSymbol\x12 \n" +
+	"\vtransitions\x18\x03 \x01(\x05R\vtransitions\x120\n" +
+	"\x14transitions_per_hour\x18\x04 \x01(\x01R\x12transitionsPerHour\x12=\n" +
+	"\x05edges\x18\x05 \x03(\v2'.daemon.SupplyTransitionStat.EdgesEntryR\x05edges\x12i\n" +
+	"\x15average_dwell_seconds\x18\x06 \x03(\v25.daemon.SupplyTransitionStat.AverageDwellSecondsEntryR\x13averageDwellSeconds\x12%\n" +
+	"\x0ecurrent_supply\x18\a \x01(\tR\rcurrentSupply\x12,\n" +
+	"\x12last_transition_at\x18\b \x01(\tR\x10lastTransitionAt\x1a8\n" +
+	"\n" +
+	"EdgesEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\x05R\x05value:\x028\x01\x1aF\n" +
+	"\x18AverageDwellSecondsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\x03R\x05value:\x028\x01\"l\n" +
+	" GetSupplyTransitionStatsResponse\x12\x14\n" +
+	"\x05since\x18\x01 \x01(\tR\x05since\x122\n" +
+	"\x05stats\x18\x02 \x03(\v2\x1c.daemon.SupplyTransitionStatR\x05stats\"\xb2\x01\n" +
+	"\x18GetSystemOverviewRequest\x12#\n" +
+	"\rsystem_symbol\x18\x01 \x01(\tR\fsystemSymbol\x12\x1b\n" +
+	"\tmax_lanes\x18\x02 \x01(\x05R\bmaxLanes\x12\x1b\n" +
+	"\tplayer_id\x18\x03 \x01(\x05R\bplayerId\x12&\n" +
+	"\fagent_symbol\x18\x04 \x01(\tH\x00R\vagentSymbol\x88\x01\x01B\x0f\n" +
+	"\r_agent_symbol\"\xb2\x01\n" +
+	"\x12SystemOverviewShip\x12\x16\n" +
+	"\x06symbol\x18\x01 \x01(\tR\x06symbol\x12\x12\n" +
+	"\x04role\x18\x02 \x01(\tR\x04role\x12\x1a\n" +
+	"\blocation\x18\x03 \x01(\tR\blocation\x12\x1d\n" +
+	"\n" +
+	"nav_status\x18\x04 \x01(\tR\tnavStatus\x12!\n" +
+	"\fcontainer_id\x18\x05 \x01(\tR\vcontainerId\x12\x12\n" +
+	"\x04task\x18\x06 \x01(\tR\x04task\"\xba\x01\n" +
+	"\x17SystemOverviewContainer\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12%\n" +
+	"\x0econtainer_type\x18\x02 \x01(\tR\rcontainerType\x12!\n" +
+	"\fcommand_type\x18\x03 \x01(\tR\vcommandType\x12&\n" +
+	"\x0fships_in_system\x18\x04 \x01(\x05R\rshipsInSystem\x12\x1d\n" +
+	"\n" +
+	"started_at\x18\x05 \x01(\tR\tstartedAt\"\xd5\x01\n" +
+	"\x15SystemMarketFreshness\x12\x18\n" +
+	"\amarkets\x18\x01 \x01(\x05R\amarkets\x12\x18\n" +
+	"\ascanned\x18\x02 \x01(\x05R\ascanned\x12,\n" +
+	"\x12newest_age_seconds\x18\x03 \x01(\x03R\x10newestAgeSeconds\x12,\n" +
+	"\x12median_age_seconds\x18\x04 \x01(\x03R\x10medianAgeSeconds\x12,\n" +
+	"\x12oldest_age_seconds\x18\x05 \x01(\x03R\x10oldestAgeSeconds\"\xdb\x01\n" +
+	"\x16SystemOverviewDelivery\x12\x1f\n" +
+	"\vcontract_id\x18\x01 \x01(\tR\n" +
+	"contractId\x12\x12\n" +
+	"\x04good\x18\x02 \x01(\tR\x04good\x12 \n" +
+	"\vdestination\x18\x03 \x01(\tR\vdestination\x12%\n" +
+	"\x0eunits_required\x18\x04 \x01(\x05R\runitsRequired\x12'\n" +
+	"\x0funits_fulfilled\x18\x05 \x01(\x05R\x0eunitsFulfilled\x12\x1a\n" +
+	"\bdeadline\x18\x06 \x01(\tR\bdeadline\"\xfd\x01\n" +
+	"\x12SystemOverviewLane\x12\x12\n" +
+	"\x04good\x18\x01 \x01(\tR\x04good\x12'\n" +
+	"\x0fsource_waypoint\x18\x02 \x01(\tR\x0esourceWaypoint\x12#\n" +
+	"\rdest_waypoint\x18\x03 \x01(\tR\fdestWaypoint\x12\x1d\n" +
+	"\n" +
+	"source_ask\x18\x04 \x01(\x05R\tsourceAsk\x12\x19\n" +
+	"\bdest_bid\x18\x05 \x01(\x05R\adestBid\x12&\n" +
+	"\x0fspread_per_unit\x18\x06 \x01(\x05R\rspreadPerUnit\x12#\n" +
+	"\rsource_supply\x18\a \x01(\tR\fsourceSupply\"\xde\x02\n" +
+	"\x19GetSystemOverviewResponse\x12#\n" +
+	"\rsystem_symbol\x18\x01 \x01(\tR\fsystemSymbol\x120\n" +
+	"\x05ships\x18\x02 \x03(\v2\x1a.daemon.SystemOverviewShipR\x05ships\x12?\n" +
+	"\n" +
+	"containers\x18\x03 \x03(\v2\x1f.daemon.SystemOverviewContainerR\n" +
+	"containers\x127\n" +
+	"\amarkets\x18\x04 \x01(\v2\x1d.daemon.SystemMarketFreshnessR\amarkets\x12>\n" +
+	"\n" +
+	"deliveries\x18\x05 \x03(\v2\x1e.daemon.SystemOverviewDeliveryR\n" +
+	"deliveries\x120\n" +
+	"\x05lanes\x18\x06 \x03(\v2\x1a.daemon.SystemOverviewLaneR\x05lanes\"\xc3\x01\n" +
+	"\x11WarmSystemRequest\x12#\n" +
+	"\rsystem_symbol\x18\x01 \x01(\tR\fsystemSymbol\x123\n" +
+	"\x16market_max_age_seconds\x18\x02 \x01(\x05R\x13marketMaxAgeSeconds\x12\x1b\n" +
+	"\tplayer_id\x18\x03 \x01(\x05R\bplayerId\x12&\n" +
+	"\fagent_symbol\x18\x04 \x01(\tH\x00R\vagentSymbol\x88\x01\x01B\x0f\n" +
+	"\r_agent_symbol\"\\\n" +
+	"\x12WarmSystemResponse\x12!\n" +
+	"\fcontainer_id\x18\x01 \x01(\tR\vcontainerId\x12#\n" +
+	"\rsystem_symbol\x18\x02 \x01(\tR\fsystemSymbol\"\x9e\x03\n" +
+	"\fScheduledJob\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x12\n" +
+	"\x04kind\x18\x02 \x01(\tR\x04kind\x12)\n" +
+	"\x10interval_seconds\x18\x03 \x01(\x03R\x0fintervalSeconds\x128\n" +
+	"\x06params\x18\x04 \x03(\v2 .daemon.ScheduledJob.ParamsEntryR\x06params\x12\x18\n" +
+	"\aenabled\x18\x05 \x01(\bR\aenabled\x12\x1e\n" +
+	"\vlast_run_at\x18\x06 \x01(\tR\tlastRunAt\x12\x1e\n" +
+	"\vnext_run_at\x18\a \x01(\tR\tnextRunAt\x12\x1f\n" +
+	"\vlast_status\x18\b \x01(\tR\n" +
+	"lastStatus\x12\x1d\n" +
+	"\n" +
+	"last_error\x18\t \x01(\tR\tlastError\x12,\n" +
+	"\x12last_container_ids\x18\n" +
+	" \x03(\tR\x10lastContainerIds\x1a9\n" +
+	"\vParamsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\xdc\x02\n" +
+	"\x16AddScheduledJobRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x12\n" +
+	"\x04kind\x18\x02 \x01(\tR\x04kind\x12)\n" +
+	"\x10interval_seconds\x18\x03 \x01(\x03R\x0fintervalSeconds\x12B\n" +
+	"\x06params\x18\x04 \x03(\v2*.daemon.AddScheduledJobRequest.ParamsEntryR\x06params\x12\x1a\n" +
+	"\bdisabled\x18\x05 \x01(\bR\bdisabled\x12\x1b\n" +
+	"\tplayer_id\x18\x06 \x01(\x05R\bplayerId\x12&\n" +
+	"\fagent_symbol\x18\a \x01(\tH\x00R\vagentSymbol\x88\x01\x01\x1a9\n" +
+	"\vParamsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01B\x0f\n" +
+	"\r_agent_symbol\"A\n" +
+	"\x17AddScheduledJobResponse\x12&\n" +
+	"\x03job\x18\x01 \x01(\v2\x14.daemon.ScheduledJobR\x03job\"p\n" +
+	"\x18ListScheduledJobsRequest\x12\x1b\n" +
+	"\tplayer_id\x18\x01 \x01(\x05R\bplayerId\x12&\n" +
+	"\fagent_symbol\x18\x02 \x01(\tH\x00R\vagentSymbol\x88\x01\x01B\x0f\n" +
+	"\r_agent_symbol\"E\n" +
+	"\x19ListScheduledJobsResponse\x12(\n" +
+	"\x04jobs\x18\x01 \x03(\v2\x14.daemon.ScheduledJobR\x04jobs\"\x85\x01\n" +
+	"\x19RemoveScheduledJobRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x1b\n" +
+	"\tplayer_id\x18\x02 \x01(\x05R\bplayerId\x12&\n" +
+	"\fagent_symbol\x18\x03 \x01(\tH\x00R\vagentSymbol\x88\x01\x01B\x0f\n" +
+	"\r_agent_symbol\"0\n" +
+	"\x1aRemoveScheduledJobResponse\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name2\xbb=\n" +
 	"\rDaemonService\x12I\n" +
 	"\fNavigateShip\x12\x1b.daemon.NavigateShipRequest\x1a\x1c.daemon.NavigateShipResponse\x12@\n" +
 	"\tRouteShip\x12\x18.daemon.RouteShipRequest\x1a\x19.daemon.RouteShipResponse\x12=\n" +
@@ -13719,18 +17078,25 @@ const file_pkg_proto_daemon_daemon_proto_rawDesc = "" +
 	"\x15TradeFleetCoordinator\x12$.daemon.TradeFleetCoordinatorRequest\x1a%.daemon.TradeFleetCoordinatorResponse\x12X\n" +
 	"\x11SitingCoordinator\x12 .daemon.SitingCoordinatorRequest\x1a!.daemon.SitingCoordinatorResponse\x12p\n" +
 	"\x19FleetAutosizerCoordinator\x12(.daemon.FleetAutosizerCoordinatorRequest\x1a).daemon.FleetAutosizerCoordinatorResponse\x12a\n" +
-	"\x14BootstrapCoordinator\x12#.daemon.BootstrapCoordinatorRequest\x1a$.daemon.BootstrapCoordinatorResponse\x12|\n" +
+	"\x14BootstrapCoordinator\x12#.daemon.BootstrapCoordinatorRequest\x1a$.daemon.BootstrapCoordinatorResponse\x12O\n" +
+	"\x0eBootstrapFleet\x12\x1d.daemon.BootstrapFleetRequest\x1a\x1e.daemon.BootstrapFleetResponse\x12g\n" +
+	"\x16LaunchOperationProfile\x12%.daemon.LaunchOperationProfileRequest\x1a&.daemon.LaunchOperationProfileResponse\x12|\n" +
 	"\x1dCapacityReconcilerCoordinator\x12,.daemon.CapacityReconcilerCoordinatorRequest\x1a-.daemon.CapacityReconcilerCoordinatorResponse\x12d\n" +
 	"\x15AutoOutfitCoordinator\x12$.daemon.AutoOutfitCoordinatorRequest\x1a%.daemon.AutoOutfitCoordinatorResponse\x12y\n" +
 	"\x1cFrontierExpansionCoordinator\x12+.daemon.FrontierExpansionCoordinatorRequest\x1a,.daemon.FrontierExpansionCoordinatorResponse\x12v\n" +
-	"\x1bShipyardBackfillCoordinator\x12*.daemon.ShipyardBackfillCoordinatorRequest\x1a+.daemon.ShipyardBackfillCoordinatorResponse\x12v\n" +
+	"\x1bShipyardBackfillCoordinator\x12*.daemon.ShipyardBackfillCoordinatorRequest\x1a+.daemon.ShipyardBackfillCoordinatorResponse\x12j\n" +
+	"\x17ProbeParkingCoordinator\x12&.daemon.ProbeParkingCoordinatorRequest\x1a'.daemon.ProbeParkingCoordinatorResponse\x12X\n" +
+	"\x11TankerCoordinator\x12 .daemon.TankerCoordinatorRequest\x1a!.daemon.TankerCoordinatorResponse\x12v\n" +
 	"\x1bWorkerRebalancerCoordinator\x12*.daemon.WorkerRebalancerCoordinatorRequest\x1a+.daemon.WorkerRebalancerCoordinatorResponse\x12F\n" +
 	"\fAddScoutPost\x12\x1b.daemon.AddScoutPostRequest\x1a\x19.daemon.ScoutPostResponse\x12R\n" +
 	"\x0fRemoveScoutPost\x12\x1e.daemon.RemoveScoutPostRequest\x1a\x1f.daemon.RemoveScoutPostResponse\x12O\n" +
 	"\x0eListScoutPosts\x12\x1d.daemon.ListScoutPostsRequest\x1a\x1e.daemon.ListScoutPostsResponse\x12O\n" +
 	"\x0eListContainers\x12\x1d.daemon.ListContainersRequest\x1a\x1e.daemon.ListContainersResponse\x12I\n" +
 	"\fGetContainer\x12\x1b.daemon.GetContainerRequest\x1a\x1c.daemon.GetContainerResponse\x12L\n" +
-	"\rStopContainer\x12\x1c.daemon.StopContainerRequest\x1a\x1d.daemon.StopContainerResponse\x12U\n" +
+	"\rStopContainer\x12\x1c.daemon.StopContainerRequest\x1a\x1d.daemon.StopContainerResponse\x12O\n" +
+	"\x0ePauseContainer\x12\x1d.daemon.PauseContainerRequest\x1a\x1e.daemon.PauseContainerResponse\x12R\n" +
+	"\x0fResumeContainer\x12\x1e.daemon.ResumeContainerRequest\x1a\x1f.daemon.ResumeContainerResponse\x12a\n" +
+	"\x14SetContainerLogLevel\x12#.daemon.SetContainerLogLevelRequest\x1a$.daemon.SetContainerLogLevelResponse\x12U\n" +
 	"\x10GetContainerLogs\x12\x1f.daemon.GetContainerLogsRequest\x1a .daemon.GetContainerLogsResponse\x12F\n" +
 	"\vHealthCheck\x12\x1a.daemon.HealthCheckRequest\x1a\x1b.daemon.HealthCheckResponse\x12I\n" +
 	"\fGetAPIBudget\x12\x1b.daemon.GetAPIBudgetRequest\x1a\x1c.daemon.GetAPIBudgetResponse\x12@\n" +
@@ -13779,7 +17145,16 @@ const file_pkg_proto_daemon_daemon_proto_rawDesc = "" +
 	"ListDepots\x12\x19.daemon.ListDepotsRequest\x1a\x1a.daemon.ListDepotsResponse\x12C\n" +
 	"\n" +
 	"StartDepot\x12\x19.daemon.StartDepotRequest\x1a\x1a.daemon.StartDepotResponse\x12@\n" +
-	"\tStopDepot\x12\x18.daemon.StopDepotRequest\x1a\x19.daemon.StopDepotResponseB;Z9github.com/andrescamacho/spacetraders-go/pkg/proto/daemonb\x06proto3"
+	"\tStopDepot\x12\x18.daemon.StopDepotRequest\x1a\x19.daemon.StopDepotResponse\x12L\n" +
+	"\rRegisterAgent\x12\x1c.daemon.RegisterAgentRequest\x1a\x1d.daemon.RegisterAgentResponse\x12U\n" +
+	"\x10ExportMarketData\x12\x1f.daemon.ExportMarketDataRequest\x1a .daemon.ExportMarketDataResponse\x12m\n" +
+	"\x18GetSupplyTransitionStats\x12'.daemon.GetSupplyTransitionStatsRequest\x1a(.daemon.GetSupplyTransitionStatsResponse\x12X\n" +
+	"\x11GetSystemOverview\x12 .daemon.GetSystemOverviewRequest\x1a!.daemon.GetSystemOverviewResponse\x12C\n" +
+	"\n" +
+	"WarmSystem\x12\x19.daemon.WarmSystemRequest\x1a\x1a.daemon.WarmSystemResponse\x12R\n" +
+	"\x0fAddScheduledJob\x12\x1e.daemon.AddScheduledJobRequest\x1a\x1f.daemon.AddScheduledJobResponse\x12X\n" +
+	"\x11ListScheduledJobs\x12 .daemon.ListScheduledJobsRequest\x1a!.daemon.ListScheduledJobsResponse\x12[\n" +
+	"\x12RemoveScheduledJob\x12!.daemon.RemoveScheduledJobRequest\x1a\".daemon.RemoveScheduledJobResponseB;Z9github.com/andrescamacho/spacetraders-go/pkg/proto/daemonb\x06proto3"
 
 var (
 	file_pkg_proto_daemon_daemon_proto_rawDescOnce sync.Once
@@ -13793,7 +17168,7 @@ func file_pkg_proto_daemon_daemon_proto_rawDescGZIP() []byte {
 	return file_pkg_proto_daemon_daemon_proto_rawDescData
 }
 
-var file_pkg_proto_daemon_daemon_proto_msgTypes = make([]protoimpl.MessageInfo, 177)
+var file_pkg_proto_daemon_daemon_proto_msgTypes = make([]protoimpl.MessageInfo, 222)
 var file_pkg_proto_daemon_daemon_proto_goTypes = []any{
 	(*NavigateShipRequest)(nil),                   // 0: daemon.NavigateShipRequest
 	(*NavigateShipResponse)(nil),                  // 1: daemon.NavigateShipResponse
@@ -13832,346 +17207,437 @@ var file_pkg_proto_daemon_daemon_proto_goTypes = []any{
 	(*FleetAutosizerCoordinatorResponse)(nil),     // 34: daemon.FleetAutosizerCoordinatorResponse
 	(*BootstrapCoordinatorRequest)(nil),           // 35: daemon.BootstrapCoordinatorRequest
 	(*BootstrapCoordinatorResponse)(nil),          // 36: daemon.BootstrapCoordinatorResponse
-	(*CapacityReconcilerCoordinatorRequest)(nil),  // 37: daemon.CapacityReconcilerCoordinatorRequest
-	(*CapacityReconcilerCoordinatorResponse)(nil), // 38: daemon.CapacityReconcilerCoordinatorResponse
-	(*AutoOutfitCoordinatorRequest)(nil),          // 39: daemon.AutoOutfitCoordinatorRequest
-	(*AutoOutfitCoordinatorResponse)(nil),         // 40: daemon.AutoOutfitCoordinatorResponse
-	(*FrontierExpansionCoordinatorRequest)(nil),   // 41: daemon.FrontierExpansionCoordinatorRequest
-	(*FrontierExpansionCoordinatorResponse)(nil),  // 42: daemon.FrontierExpansionCoordinatorResponse
-	(*ShipyardBackfillCoordinatorRequest)(nil),    // 43: daemon.ShipyardBackfillCoordinatorRequest
-	(*ShipyardBackfillCoordinatorResponse)(nil),   // 44: daemon.ShipyardBackfillCoordinatorResponse
-	(*WorkerRebalancerCoordinatorRequest)(nil),    // 45: daemon.WorkerRebalancerCoordinatorRequest
-	(*WorkerRebalancerCoordinatorResponse)(nil),   // 46: daemon.WorkerRebalancerCoordinatorResponse
-	(*AddScoutPostRequest)(nil),                   // 47: daemon.AddScoutPostRequest
-	(*ScoutPostResponse)(nil),                     // 48: daemon.ScoutPostResponse
-	(*RemoveScoutPostRequest)(nil),                // 49: daemon.RemoveScoutPostRequest
-	(*RemoveScoutPostResponse)(nil),               // 50: daemon.RemoveScoutPostResponse
-	(*ListScoutPostsRequest)(nil),                 // 51: daemon.ListScoutPostsRequest
-	(*ListScoutPostsResponse)(nil),                // 52: daemon.ListScoutPostsResponse
-	(*ScoutMarketsRequest)(nil),                   // 53: daemon.ScoutMarketsRequest
-	(*ScoutMarketsResponse)(nil),                  // 54: daemon.ScoutMarketsResponse
-	(*MarketAssignment)(nil),                      // 55: daemon.MarketAssignment
-	(*AssignScoutingFleetRequest)(nil),            // 56: daemon.AssignScoutingFleetRequest
-	(*AssignScoutingFleetResponse)(nil),           // 57: daemon.AssignScoutingFleetResponse
-	(*ListContainersRequest)(nil),                 // 58: daemon.ListContainersRequest
-	(*ListContainersResponse)(nil),                // 59: daemon.ListContainersResponse
-	(*ContainerInfo)(nil),                         // 60: daemon.ContainerInfo
-	(*GetContainerRequest)(nil),                   // 61: daemon.GetContainerRequest
-	(*GetContainerResponse)(nil),                  // 62: daemon.GetContainerResponse
-	(*StopContainerRequest)(nil),                  // 63: daemon.StopContainerRequest
-	(*StopContainerResponse)(nil),                 // 64: daemon.StopContainerResponse
-	(*GetContainerLogsRequest)(nil),               // 65: daemon.GetContainerLogsRequest
-	(*GetContainerLogsResponse)(nil),              // 66: daemon.GetContainerLogsResponse
-	(*LogEntry)(nil),                              // 67: daemon.LogEntry
-	(*HealthCheckRequest)(nil),                    // 68: daemon.HealthCheckRequest
-	(*HealthCheckResponse)(nil),                   // 69: daemon.HealthCheckResponse
-	(*GetAPIBudgetRequest)(nil),                   // 70: daemon.GetAPIBudgetRequest
-	(*APIBudgetHullStats)(nil),                    // 71: daemon.APIBudgetHullStats
-	(*APIBudgetReport)(nil),                       // 72: daemon.APIBudgetReport
-	(*DutyCycleHullStats)(nil),                    // 73: daemon.DutyCycleHullStats
-	(*DutyCycleReport)(nil),                       // 74: daemon.DutyCycleReport
-	(*GetAPIBudgetResponse)(nil),                  // 75: daemon.GetAPIBudgetResponse
-	(*ListShipsRequest)(nil),                      // 76: daemon.ListShipsRequest
-	(*ListShipsResponse)(nil),                     // 77: daemon.ListShipsResponse
-	(*ShipInfo)(nil),                              // 78: daemon.ShipInfo
-	(*GetShipRequest)(nil),                        // 79: daemon.GetShipRequest
-	(*GetShipResponse)(nil),                       // 80: daemon.GetShipResponse
-	(*RefreshShipRequest)(nil),                    // 81: daemon.RefreshShipRequest
-	(*RefreshShipResponse)(nil),                   // 82: daemon.RefreshShipResponse
-	(*ReserveShipRequest)(nil),                    // 83: daemon.ReserveShipRequest
-	(*ReserveShipResponse)(nil),                   // 84: daemon.ReserveShipResponse
-	(*ReleaseShipRequest)(nil),                    // 85: daemon.ReleaseShipRequest
-	(*ReleaseShipResponse)(nil),                   // 86: daemon.ReleaseShipResponse
-	(*AssignShipFleetRequest)(nil),                // 87: daemon.AssignShipFleetRequest
-	(*AssignShipFleetResponse)(nil),               // 88: daemon.AssignShipFleetResponse
-	(*FleetHubRequest)(nil),                       // 89: daemon.FleetHubRequest
-	(*FleetHubResponse)(nil),                      // 90: daemon.FleetHubResponse
-	(*UnassignShipFleetRequest)(nil),              // 91: daemon.UnassignShipFleetRequest
-	(*UnassignShipFleetResponse)(nil),             // 92: daemon.UnassignShipFleetResponse
-	(*ListFleetsRequest)(nil),                     // 93: daemon.ListFleetsRequest
-	(*FleetShip)(nil),                             // 94: daemon.FleetShip
-	(*Fleet)(nil),                                 // 95: daemon.Fleet
-	(*ListFleetsResponse)(nil),                    // 96: daemon.ListFleetsResponse
-	(*ListWaypointsRequest)(nil),                  // 97: daemon.ListWaypointsRequest
-	(*ListWaypointsResponse)(nil),                 // 98: daemon.ListWaypointsResponse
-	(*GetWaypointRequest)(nil),                    // 99: daemon.GetWaypointRequest
-	(*GetWaypointResponse)(nil),                   // 100: daemon.GetWaypointResponse
-	(*WaypointDetail)(nil),                        // 101: daemon.WaypointDetail
-	(*ShipDetail)(nil),                            // 102: daemon.ShipDetail
-	(*PurchaseShipRequest)(nil),                   // 103: daemon.PurchaseShipRequest
-	(*PurchaseShipResponse)(nil),                  // 104: daemon.PurchaseShipResponse
-	(*BatchPurchaseShipsRequest)(nil),             // 105: daemon.BatchPurchaseShipsRequest
-	(*BatchPurchaseShipsResponse)(nil),            // 106: daemon.BatchPurchaseShipsResponse
-	(*GetShipyardListingsRequest)(nil),            // 107: daemon.GetShipyardListingsRequest
-	(*GetShipyardListingsResponse)(nil),           // 108: daemon.GetShipyardListingsResponse
-	(*ShipListing)(nil),                           // 109: daemon.ShipListing
-	(*CargoItem)(nil),                             // 110: daemon.CargoItem
-	(*RouteSegment)(nil),                          // 111: daemon.RouteSegment
-	(*ShipRoute)(nil),                             // 112: daemon.ShipRoute
-	(*StartGoodsFactoryRequest)(nil),              // 113: daemon.StartGoodsFactoryRequest
-	(*StartGoodsFactoryResponse)(nil),             // 114: daemon.StartGoodsFactoryResponse
-	(*StopGoodsFactoryRequest)(nil),               // 115: daemon.StopGoodsFactoryRequest
-	(*StopGoodsFactoryResponse)(nil),              // 116: daemon.StopGoodsFactoryResponse
-	(*FactoryWorkerCapRequest)(nil),               // 117: daemon.FactoryWorkerCapRequest
-	(*FactoryWorkerCapResponse)(nil),              // 118: daemon.FactoryWorkerCapResponse
-	(*TuneContainerConfigRequest)(nil),            // 119: daemon.TuneContainerConfigRequest
-	(*TuneContainerConfigResponse)(nil),           // 120: daemon.TuneContainerConfigResponse
-	(*ShowTunableConfigRequest)(nil),              // 121: daemon.ShowTunableConfigRequest
-	(*TunableKnobStatus)(nil),                     // 122: daemon.TunableKnobStatus
-	(*ShowTunableConfigResponse)(nil),             // 123: daemon.ShowTunableConfigResponse
-	(*GetFrontierStatusRequest)(nil),              // 124: daemon.GetFrontierStatusRequest
-	(*GetFrontierStatusResponse)(nil),             // 125: daemon.GetFrontierStatusResponse
-	(*GetFactoryStatusRequest)(nil),               // 126: daemon.GetFactoryStatusRequest
-	(*GetFactoryStatusResponse)(nil),              // 127: daemon.GetFactoryStatusResponse
-	(*ScanArbitrageOpportunitiesRequest)(nil),     // 128: daemon.ScanArbitrageOpportunitiesRequest
-	(*ArbitrageOpportunity)(nil),                  // 129: daemon.ArbitrageOpportunity
-	(*ScanArbitrageOpportunitiesResponse)(nil),    // 130: daemon.ScanArbitrageOpportunitiesResponse
-	(*StartArbitrageCoordinatorRequest)(nil),      // 131: daemon.StartArbitrageCoordinatorRequest
-	(*StartArbitrageCoordinatorResponse)(nil),     // 132: daemon.StartArbitrageCoordinatorResponse
-	(*JettisonCargoRequest)(nil),                  // 133: daemon.JettisonCargoRequest
-	(*JettisonCargoResponse)(nil),                 // 134: daemon.JettisonCargoResponse
-	(*StartTradeRouteRequest)(nil),                // 135: daemon.StartTradeRouteRequest
-	(*StartTradeRouteResponse)(nil),               // 136: daemon.StartTradeRouteResponse
-	(*StartWarehouseRequest)(nil),                 // 137: daemon.StartWarehouseRequest
-	(*StartWarehouseResponse)(nil),                // 138: daemon.StartWarehouseResponse
-	(*StartArbRunRequest)(nil),                    // 139: daemon.StartArbRunRequest
-	(*StartArbRunResponse)(nil),                   // 140: daemon.StartArbRunResponse
-	(*StartTourRunRequest)(nil),                   // 141: daemon.StartTourRunRequest
-	(*StartTourRunResponse)(nil),                  // 142: daemon.StartTourRunResponse
-	(*StartStockerRequest)(nil),                   // 143: daemon.StartStockerRequest
-	(*StartStockerResponse)(nil),                  // 144: daemon.StartStockerResponse
-	(*GasExtractionOperationRequest)(nil),         // 145: daemon.GasExtractionOperationRequest
-	(*GasExtractionOperationResponse)(nil),        // 146: daemon.GasExtractionOperationResponse
-	(*StartConstructionPipelineRequest)(nil),      // 147: daemon.StartConstructionPipelineRequest
-	(*StartConstructionPipelineResponse)(nil),     // 148: daemon.StartConstructionPipelineResponse
-	(*ConstructionMaterial)(nil),                  // 149: daemon.ConstructionMaterial
-	(*GetConstructionStatusRequest)(nil),          // 150: daemon.GetConstructionStatusRequest
-	(*GetConstructionStatusResponse)(nil),         // 151: daemon.GetConstructionStatusResponse
-	(*StopConstructionPipelineRequest)(nil),       // 152: daemon.StopConstructionPipelineRequest
-	(*StopConstructionPipelineResponse)(nil),      // 153: daemon.StopConstructionPipelineResponse
-	(*ConstructionGoodOverrideRequest)(nil),       // 154: daemon.ConstructionGoodOverrideRequest
-	(*ConstructionGoodOverrideResponse)(nil),      // 155: daemon.ConstructionGoodOverrideResponse
-	(*DepotElement)(nil),                          // 156: daemon.DepotElement
-	(*DepotSpec)(nil),                             // 157: daemon.DepotSpec
-	(*ApplyDepotTopologyRequest)(nil),             // 158: daemon.ApplyDepotTopologyRequest
-	(*ApplyDepotTopologyResponse)(nil),            // 159: daemon.ApplyDepotTopologyResponse
-	(*AddDepotRequest)(nil),                       // 160: daemon.AddDepotRequest
-	(*AddDepotResponse)(nil),                      // 161: daemon.AddDepotResponse
-	(*RemoveDepotRequest)(nil),                    // 162: daemon.RemoveDepotRequest
-	(*RemoveDepotResponse)(nil),                   // 163: daemon.RemoveDepotResponse
-	(*AddDepotElementRequest)(nil),                // 164: daemon.AddDepotElementRequest
-	(*RemoveDepotElementRequest)(nil),             // 165: daemon.RemoveDepotElementRequest
-	(*PlaceDepotElementRequest)(nil),              // 166: daemon.PlaceDepotElementRequest
-	(*DepotElementResponse)(nil),                  // 167: daemon.DepotElementResponse
-	(*ListDepotsRequest)(nil),                     // 168: daemon.ListDepotsRequest
-	(*ListDepotsResponse)(nil),                    // 169: daemon.ListDepotsResponse
-	(*StartDepotRequest)(nil),                     // 170: daemon.StartDepotRequest
-	(*StartDepotResponse)(nil),                    // 171: daemon.StartDepotResponse
-	(*StopDepotRequest)(nil),                      // 172: daemon.StopDepotRequest
-	(*StopDepotResponse)(nil),                     // 173: daemon.StopDepotResponse
-	nil,                                           // 174: daemon.ScoutMarketsResponse.AssignmentsEntry
-	nil,                                           // 175: daemon.APIBudgetReport.PurposeCountsEntry
-	nil,                                           // 176: daemon.APIBudgetReport.PurposeSharePctEntry
+	(*BootstrapFleetRequest)(nil),                 // 37: daemon.BootstrapFleetRequest
+	(*BootstrapFleetResponse)(nil),                // 38: daemon.BootstrapFleetResponse
+	(*FleetPurchasePlan)(nil),                     // 39: daemon.FleetPurchasePlan
+	(*FleetContainerPlan)(nil),                    // 40: daemon.FleetContainerPlan
+	(*LaunchOperationProfileRequest)(nil),         // 41: daemon.LaunchOperationProfileRequest
+	(*LaunchOperationProfileResponse)(nil),        // 42: daemon.LaunchOperationProfileResponse
+	(*OperationLaunch)(nil),                       // 43: daemon.OperationLaunch
+	(*CapacityReconcilerCoordinatorRequest)(nil),  // 44: daemon.CapacityReconcilerCoordinatorRequest
+	(*CapacityReconcilerCoordinatorResponse)(nil), // 45: daemon.CapacityReconcilerCoordinatorResponse
+	(*AutoOutfitCoordinatorRequest)(nil),          // 46: daemon.AutoOutfitCoordinatorRequest
+	(*AutoOutfitCoordinatorResponse)(nil),         // 47: daemon.AutoOutfitCoordinatorResponse
+	(*FrontierExpansionCoordinatorRequest)(nil),   // 48: daemon.FrontierExpansionCoordinatorRequest
+	(*FrontierExpansionCoordinatorResponse)(nil),  // 49: daemon.FrontierExpansionCoordinatorResponse
+	(*ShipyardBackfillCoordinatorRequest)(nil),    // 50: daemon.ShipyardBackfillCoordinatorRequest
+	(*ShipyardBackfillCoordinatorResponse)(nil),   // 51: daemon.ShipyardBackfillCoordinatorResponse
+	(*ProbeParkingCoordinatorRequest)(nil),        // 52: daemon.ProbeParkingCoordinatorRequest
+	(*ProbeParkingCoordinatorResponse)(nil),       // 53: daemon.ProbeParkingCoordinatorResponse
+	(*TankerCoordinatorRequest)(nil),              // 54: daemon.TankerCoordinatorRequest
+	(*TankerCoordinatorResponse)(nil),             // 55: daemon.TankerCoordinatorResponse
+	(*WorkerRebalancerCoordinatorRequest)(nil),    // 56: daemon.WorkerRebalancerCoordinatorRequest
+	(*WorkerRebalancerCoordinatorResponse)(nil),   // 57: daemon.WorkerRebalancerCoordinatorResponse
+	(*AddScoutPostRequest)(nil),                   // 58: daemon.AddScoutPostRequest
+	(*ScoutPostResponse)(nil),                     // 59: daemon.ScoutPostResponse
+	(*RemoveScoutPostRequest)(nil),                // 60: daemon.RemoveScoutPostRequest
+	(*RemoveScoutPostResponse)(nil),               // 61: daemon.RemoveScoutPostResponse
+	(*ListScoutPostsRequest)(nil),                 // 62: daemon.ListScoutPostsRequest
+	(*ListScoutPostsResponse)(nil),                // 63: daemon.ListScoutPostsResponse
+	(*ScoutMarketsRequest)(nil),                   // 64: daemon.ScoutMarketsRequest
+	(*ScoutMarketsResponse)(nil),                  // 65: daemon.ScoutMarketsResponse
+	(*MarketAssignment)(nil),                      // 66: daemon.MarketAssignment
+	(*AssignScoutingFleetRequest)(nil),            // 67: daemon.AssignScoutingFleetRequest
+	(*AssignScoutingFleetResponse)(nil),           // 68: daemon.AssignScoutingFleetResponse
+	(*ListContainersRequest)(nil),                 // 69: daemon.ListContainersRequest
+	(*ListContainersResponse)(nil),                // 70: daemon.ListContainersResponse
+	(*ContainerInfo)(nil),                         // 71: daemon.ContainerInfo
+	(*GetContainerRequest)(nil),                   // 72: daemon.GetContainerRequest
+	(*GetContainerResponse)(nil),                  // 73: daemon.GetContainerResponse
+	(*StopContainerRequest)(nil),                  // 74: daemon.StopContainerRequest
+	(*StopContainerResponse)(nil),                 // 75: daemon.StopContainerResponse
+	(*PauseContainerRequest)(nil),                 // 76: daemon.PauseContainerRequest
+	(*PauseContainerResponse)(nil),                // 77: daemon.PauseContainerResponse
+	(*ResumeContainerRequest)(nil),                // 78: daemon.ResumeContainerRequest
+	(*ResumeContainerResponse)(nil),               // 79: daemon.ResumeContainerResponse
+	(*SetContainerLogLevelRequest)(nil),           // 80: daemon.SetContainerLogLevelRequest
+	(*SetContainerLogLevelResponse)(nil),          // 81: daemon.SetContainerLogLevelResponse
+	(*GetContainerLogsRequest)(nil),               // 82: daemon.GetContainerLogsRequest
+	(*GetContainerLogsResponse)(nil),              // 83: daemon.GetContainerLogsResponse
+	(*LogEntry)(nil),                              // 84: daemon.LogEntry
+	(*HealthCheckRequest)(nil),                    // 85: daemon.HealthCheckRequest
+	(*HealthCheckResponse)(nil),                   // 86: daemon.HealthCheckResponse
+	(*RuntimeTermination)(nil),                    // 87: daemon.RuntimeTermination
+	(*GetAPIBudgetRequest)(nil),                   // 88: daemon.GetAPIBudgetRequest
+	(*APIBudgetHullStats)(nil),                    // 89: daemon.APIBudgetHullStats
+	(*APIBudgetReport)(nil),                       // 90: daemon.APIBudgetReport
+	(*DutyCycleHullStats)(nil),                    // 91: daemon.DutyCycleHullStats
+	(*DutyCycleReport)(nil),                       // 92: daemon.DutyCycleReport
+	(*GetAPIBudgetResponse)(nil),                  // 93: daemon.GetAPIBudgetResponse
+	(*ListShipsRequest)(nil),                      // 94: daemon.ListShipsRequest
+	(*ListShipsResponse)(nil),                     // 95: daemon.ListShipsResponse
+	(*ShipInfo)(nil),                              // 96: daemon.ShipInfo
+	(*GetShipRequest)(nil),                        // 97: daemon.GetShipRequest
+	(*GetShipResponse)(nil),                       // 98: daemon.GetShipResponse
+	(*RefreshShipRequest)(nil),                    // 99: daemon.RefreshShipRequest
+	(*RefreshShipResponse)(nil),                   // 100: daemon.RefreshShipResponse
+	(*ReserveShipRequest)(nil),                    // 101: daemon.ReserveShipRequest
+	(*ReserveShipResponse)(nil),                   // 102: daemon.ReserveShipResponse
+	(*ReleaseShipRequest)(nil),                    // 103: daemon.ReleaseShipRequest
+	(*ReleaseShipResponse)(nil),                   // 104: daemon.ReleaseShipResponse
+	(*AssignShipFleetRequest)(nil),                // 105: daemon.AssignShipFleetRequest
+	(*AssignShipFleetResponse)(nil),               // 106: daemon.AssignShipFleetResponse
+	(*FleetHubRequest)(nil),                       // 107: daemon.FleetHubRequest
+	(*FleetHubResponse)(nil),                      // 108: daemon.FleetHubResponse
+	(*UnassignShipFleetRequest)(nil),              // 109: daemon.UnassignShipFleetRequest
+	(*UnassignShipFleetResponse)(nil),             // 110: daemon.UnassignShipFleetResponse
+	(*ListFleetsRequest)(nil),                     // 111: daemon.ListFleetsRequest
+	(*FleetShip)(nil),                             // 112: daemon.FleetShip
+	(*Fleet)(nil),                                 // 113: daemon.Fleet
+	(*ListFleetsResponse)(nil),                    // 114: daemon.ListFleetsResponse
+	(*ListWaypointsRequest)(nil),                  // 115: daemon.ListWaypointsRequest
+	(*ListWaypointsResponse)(nil),                 // 116: daemon.ListWaypointsResponse
+	(*GetWaypointRequest)(nil),                    // 117: daemon.GetWaypointRequest
+	(*GetWaypointResponse)(nil),                   // 118: daemon.GetWaypointResponse
+	(*WaypointDetail)(nil),                        // 119: daemon.WaypointDetail
+	(*ShipDetail)(nil),                            // 120: daemon.ShipDetail
+	(*PurchaseShipRequest)(nil),                   // 121: daemon.PurchaseShipRequest
+	(*PurchaseShipResponse)(nil),                  // 122: daemon.PurchaseShipResponse
+	(*BatchPurchaseShipsRequest)(nil),             // 123: daemon.BatchPurchaseShipsRequest
+	(*BatchPurchaseShipsResponse)(nil),            // 124: daemon.BatchPurchaseShipsResponse
+	(*GetShipyardListingsRequest)(nil),            // 125: daemon.GetShipyardListingsRequest
+	(*GetShipyardListingsResponse)(nil),           // 126: daemon.GetShipyardListingsResponse
+	(*ShipListing)(nil),                           // 127: daemon.ShipListing
+	(*CargoItem)(nil),                             // 128: daemon.CargoItem
+	(*RouteSegment)(nil),                          // 129: daemon.RouteSegment
+	(*ShipRoute)(nil),                             // 130: daemon.ShipRoute
+	(*StartGoodsFactoryRequest)(nil),              // 131: daemon.StartGoodsFactoryRequest
+	(*StartGoodsFactoryResponse)(nil),             // 132: daemon.StartGoodsFactoryResponse
+	(*StopGoodsFactoryRequest)(nil),               // 133: daemon.StopGoodsFactoryRequest
+	(*StopGoodsFactoryResponse)(nil),              // 134: daemon.StopGoodsFactoryResponse
+	(*FactoryWorkerCapRequest)(nil),               // 135: daemon.FactoryWorkerCapRequest
+	(*FactoryWorkerCapResponse)(nil),              // 136: daemon.FactoryWorkerCapResponse
+	(*TuneContainerConfigRequest)(nil),            // 137: daemon.TuneContainerConfigRequest
+	(*TuneContainerConfigResponse)(nil),           // 138: daemon.TuneContainerConfigResponse
+	(*ShowTunableConfigRequest)(nil),              // 139: daemon.ShowTunableConfigRequest
+	(*TunableKnobStatus)(nil),                     // 140: daemon.TunableKnobStatus
+	(*ShowTunableConfigResponse)(nil),             // 141: daemon.ShowTunableConfigResponse
+	(*GetFrontierStatusRequest)(nil),              // 142: daemon.GetFrontierStatusRequest
+	(*GetFrontierStatusResponse)(nil),             // 143: daemon.GetFrontierStatusResponse
+	(*GetFactoryStatusRequest)(nil),               // 144: daemon.GetFactoryStatusRequest
+	(*GetFactoryStatusResponse)(nil),              // 145: daemon.GetFactoryStatusResponse
+	(*ScanArbitrageOpportunitiesRequest)(nil),     // 146: daemon.ScanArbitrageOpportunitiesRequest
+	(*ArbitrageOpportunity)(nil),                  // 147: daemon.ArbitrageOpportunity
+	(*ScanArbitrageOpportunitiesResponse)(nil),    // 148: daemon.ScanArbitrageOpportunitiesResponse
+	(*StartArbitrageCoordinatorRequest)(nil),      // 149: daemon.StartArbitrageCoordinatorRequest
+	(*StartArbitrageCoordinatorResponse)(nil),     // 150: daemon.StartArbitrageCoordinatorResponse
+	(*JettisonCargoRequest)(nil),                  // 151: daemon.JettisonCargoRequest
+	(*JettisonCargoResponse)(nil),                 // 152: daemon.JettisonCargoResponse
+	(*StartTradeRouteRequest)(nil),                // 153: daemon.StartTradeRouteRequest
+	(*StartTradeRouteResponse)(nil),               // 154: daemon.StartTradeRouteResponse
+	(*StartWarehouseRequest)(nil),                 // 155: daemon.StartWarehouseRequest
+	(*StartWarehouseResponse)(nil),                // 156: daemon.StartWarehouseResponse
+	(*StartArbRunRequest)(nil),                    // 157: daemon.StartArbRunRequest
+	(*StartArbRunResponse)(nil),                   // 158: daemon.StartArbRunResponse
+	(*StartTourRunRequest)(nil),                   // 159: daemon.StartTourRunRequest
+	(*StartTourRunResponse)(nil),                  // 160: daemon.StartTourRunResponse
+	(*StartStockerRequest)(nil),                   // 161: daemon.StartStockerRequest
+	(*StartStockerResponse)(nil),                  // 162: daemon.StartStockerResponse
+	(*GasExtractionOperationRequest)(nil),         // 163: daemon.GasExtractionOperationRequest
+	(*GasExtractionOperationResponse)(nil),        // 164: daemon.GasExtractionOperationResponse
+	(*StartConstructionPipelineRequest)(nil),      // 165: daemon.StartConstructionPipelineRequest
+	(*StartConstructionPipelineResponse)(nil),     // 166: daemon.StartConstructionPipelineResponse
+	(*ConstructionMaterial)(nil),                  // 167: daemon.ConstructionMaterial
+	(*GetConstructionStatusRequest)(nil),          // 168: daemon.GetConstructionStatusRequest
+	(*GetConstructionStatusResponse)(nil),         // 169: daemon.GetConstructionStatusResponse
+	(*StopConstructionPipelineRequest)(nil),       // 170: daemon.StopConstructionPipelineRequest
+	(*StopConstructionPipelineResponse)(nil),      // 171: daemon.StopConstructionPipelineResponse
+	(*ConstructionGoodOverrideRequest)(nil),       // 172: daemon.ConstructionGoodOverrideRequest
+	(*ConstructionGoodOverrideResponse)(nil),      // 173: daemon.ConstructionGoodOverrideResponse
+	(*DepotElement)(nil),                          // 174: daemon.DepotElement
+	(*DepotSpec)(nil),                             // 175: daemon.DepotSpec
+	(*ApplyDepotTopologyRequest)(nil),             // 176: daemon.ApplyDepotTopologyRequest
+	(*ApplyDepotTopologyResponse)(nil),            // 177: daemon.ApplyDepotTopologyResponse
+	(*AddDepotRequest)(nil),                       // 178: daemon.AddDepotRequest
+	(*AddDepotResponse)(nil),                      // 179: daemon.AddDepotResponse
+	(*RemoveDepotRequest)(nil),                    // 180: daemon.RemoveDepotRequest
+	(*RemoveDepotResponse)(nil),                   // 181: daemon.RemoveDepotResponse
+	(*AddDepotElementRequest)(nil),                // 182: daemon.AddDepotElementRequest
+	(*RemoveDepotElementRequest)(nil),             // 183: daemon.RemoveDepotElementRequest
+	(*PlaceDepotElementRequest)(nil),              // 184: daemon.PlaceDepotElementRequest
+	(*DepotElementResponse)(nil),                  // 185: daemon.DepotElementResponse
+	(*ListDepotsRequest)(nil),                     // 186: daemon.ListDepotsRequest
+	(*ListDepotsResponse)(nil),                    // 187: daemon.ListDepotsResponse
+	(*StartDepotRequest)(nil),                     // 188: daemon.StartDepotRequest
+	(*StartDepotResponse)(nil),                    // 189: daemon.StartDepotResponse
+	(*StopDepotRequest)(nil),                      // 190: daemon.StopDepotRequest
+	(*StopDepotResponse)(nil),                     // 191: daemon.StopDepotResponse
+	(*RegisterAgentRequest)(nil),                  // 192: daemon.RegisterAgentRequest
+	(*RegisterAgentResponse)(nil),                 // 193: daemon.RegisterAgentResponse
+	(*ExportMarketDataRequest)(nil),               // 194: daemon.ExportMarketDataRequest
+	(*ExportMarketDataResponse)(nil),              // 195: daemon.ExportMarketDataResponse
+	(*GetSupplyTransitionStatsRequest)(nil),       // 196: daemon.GetSupplyTransitionStatsRequest
+	(*SupplyTransitionStat)(nil),                  // 197: daemon.SupplyTransitionStat
+	(*GetSupplyTransitionStatsResponse)(nil),      // 198: daemon.GetSupplyTransitionStatsResponse
+	(*GetSystemOverviewRequest)(nil),              // 199: daemon.GetSystemOverviewRequest
+	(*SystemOverviewShip)(nil),                    // 200: daemon.SystemOverviewShip
+	(*SystemOverviewContainer)(nil),               // 201: daemon.SystemOverviewContainer
+	(*SystemMarketFreshness)(nil),                 // 202: daemon.SystemMarketFreshness
+	(*SystemOverviewDelivery)(nil),                // 203: daemon.SystemOverviewDelivery
+	(*SystemOverviewLane)(nil),                    // 204: daemon.SystemOverviewLane
+	(*GetSystemOverviewResponse)(nil),             // 205: daemon.GetSystemOverviewResponse
+	(*WarmSystemRequest)(nil),                     // 206: daemon.WarmSystemRequest
+	(*WarmSystemResponse)(nil),                    // 207: daemon.WarmSystemResponse
+	(*ScheduledJob)(nil),                          // 208: daemon.ScheduledJob
+	(*AddScheduledJobRequest)(nil),                // 209: daemon.AddScheduledJobRequest
+	(*AddScheduledJobResponse)(nil),               // 210: daemon.AddScheduledJobResponse
+	(*ListScheduledJobsRequest)(nil),              // 211: daemon.ListScheduledJobsRequest
+	(*ListScheduledJobsResponse)(nil),             // 212: daemon.ListScheduledJobsResponse
+	(*RemoveScheduledJobRequest)(nil),             // 213: daemon.RemoveScheduledJobRequest
+	(*RemoveScheduledJobResponse)(nil),            // 214: daemon.RemoveScheduledJobResponse
+	nil,                                           // 215: daemon.ScoutMarketsResponse.AssignmentsEntry
+	nil,                                           // 216: daemon.APIBudgetReport.PurposeCountsEntry
+	nil,                                           // 217: daemon.APIBudgetReport.PurposeSharePctEntry
+	nil,                                           // 218: daemon.SupplyTransitionStat.EdgesEntry
+	nil,                                           // 219: daemon.SupplyTransitionStat.AverageDwellSecondsEntry
+	nil,                                           // 220: daemon.ScheduledJob.ParamsEntry
+	nil,                                           // 221: daemon.AddScheduledJobRequest.ParamsEntry
 }
 var file_pkg_proto_daemon_daemon_proto_depIdxs = []int32{
 	12,  // 0: daemon.InstallModuleResponse.modules:type_name -> daemon.ShipModuleInfo
 	12,  // 1: daemon.RemoveModuleResponse.modules:type_name -> daemon.ShipModuleInfo
 	12,  // 2: daemon.ListShipModulesResponse.modules:type_name -> daemon.ShipModuleInfo
 	13,  // 3: daemon.ListShipModulesResponse.feasibility:type_name -> daemon.ModuleFeasibility
-	26,  // 4: daemon.ScoutPostResponse.post:type_name -> daemon.ScoutPost
-	26,  // 5: daemon.ListScoutPostsResponse.posts:type_name -> daemon.ScoutPost
-	174, // 6: daemon.ScoutMarketsResponse.assignments:type_name -> daemon.ScoutMarketsResponse.AssignmentsEntry
-	60,  // 7: daemon.ListContainersResponse.containers:type_name -> daemon.ContainerInfo
-	60,  // 8: daemon.GetContainerResponse.container:type_name -> daemon.ContainerInfo
-	67,  // 9: daemon.GetContainerLogsResponse.logs:type_name -> daemon.LogEntry
-	175, // 10: daemon.APIBudgetReport.purpose_counts:type_name -> daemon.APIBudgetReport.PurposeCountsEntry
-	176, // 11: daemon.APIBudgetReport.purpose_share_pct:type_name -> daemon.APIBudgetReport.PurposeSharePctEntry
-	71,  // 12: daemon.APIBudgetReport.per_hull:type_name -> daemon.APIBudgetHullStats
-	73,  // 13: daemon.DutyCycleReport.hulls:type_name -> daemon.DutyCycleHullStats
-	72,  // 14: daemon.GetAPIBudgetResponse.current:type_name -> daemon.APIBudgetReport
-	72,  // 15: daemon.GetAPIBudgetResponse.rolling_5m:type_name -> daemon.APIBudgetReport
-	74,  // 16: daemon.GetAPIBudgetResponse.duty_cycle:type_name -> daemon.DutyCycleReport
-	78,  // 17: daemon.ListShipsResponse.ships:type_name -> daemon.ShipInfo
-	102, // 18: daemon.GetShipResponse.ship:type_name -> daemon.ShipDetail
-	102, // 19: daemon.RefreshShipResponse.ship:type_name -> daemon.ShipDetail
-	94,  // 20: daemon.Fleet.ships:type_name -> daemon.FleetShip
-	95,  // 21: daemon.ListFleetsResponse.fleets:type_name -> daemon.Fleet
-	101, // 22: daemon.ListWaypointsResponse.waypoints:type_name -> daemon.WaypointDetail
-	101, // 23: daemon.GetWaypointResponse.waypoint:type_name -> daemon.WaypointDetail
-	110, // 24: daemon.ShipDetail.cargo_inventory:type_name -> daemon.CargoItem
-	109, // 25: daemon.GetShipyardListingsResponse.listings:type_name -> daemon.ShipListing
-	111, // 26: daemon.ShipRoute.segments:type_name -> daemon.RouteSegment
-	122, // 27: daemon.ShowTunableConfigResponse.knobs:type_name -> daemon.TunableKnobStatus
-	129, // 28: daemon.ScanArbitrageOpportunitiesResponse.opportunities:type_name -> daemon.ArbitrageOpportunity
-	112, // 29: daemon.GasExtractionOperationResponse.ship_routes:type_name -> daemon.ShipRoute
-	149, // 30: daemon.StartConstructionPipelineResponse.materials:type_name -> daemon.ConstructionMaterial
-	149, // 31: daemon.GetConstructionStatusResponse.materials:type_name -> daemon.ConstructionMaterial
-	156, // 32: daemon.DepotSpec.warehouses:type_name -> daemon.DepotElement
-	156, // 33: daemon.DepotSpec.stockers:type_name -> daemon.DepotElement
-	156, // 34: daemon.DepotSpec.delivery_hulls:type_name -> daemon.DepotElement
-	156, // 35: daemon.DepotSpec.source_hubs:type_name -> daemon.DepotElement
-	157, // 36: daemon.ApplyDepotTopologyRequest.depots:type_name -> daemon.DepotSpec
-	157, // 37: daemon.AddDepotRequest.depot:type_name -> daemon.DepotSpec
-	157, // 38: daemon.ListDepotsResponse.depots:type_name -> daemon.DepotSpec
-	157, // 39: daemon.StartDepotRequest.depot:type_name -> daemon.DepotSpec
-	55,  // 40: daemon.ScoutMarketsResponse.AssignmentsEntry.value:type_name -> daemon.MarketAssignment
-	0,   // 41: daemon.DaemonService.NavigateShip:input_type -> daemon.NavigateShipRequest
-	2,   // 42: daemon.DaemonService.RouteShip:input_type -> daemon.RouteShipRequest
-	4,   // 43: daemon.DaemonService.DockShip:input_type -> daemon.DockShipRequest
-	6,   // 44: daemon.DaemonService.OrbitShip:input_type -> daemon.OrbitShipRequest
-	8,   // 45: daemon.DaemonService.RefuelShip:input_type -> daemon.RefuelShipRequest
-	10,  // 46: daemon.DaemonService.JumpShip:input_type -> daemon.JumpShipRequest
-	14,  // 47: daemon.DaemonService.InstallModule:input_type -> daemon.InstallModuleRequest
-	16,  // 48: daemon.DaemonService.RemoveModule:input_type -> daemon.RemoveModuleRequest
-	18,  // 49: daemon.DaemonService.ListShipModules:input_type -> daemon.ListShipModulesRequest
-	20,  // 50: daemon.DaemonService.BatchContractWorkflow:input_type -> daemon.BatchContractWorkflowRequest
-	22,  // 51: daemon.DaemonService.ContractFleetCoordinator:input_type -> daemon.ContractFleetCoordinatorRequest
-	24,  // 52: daemon.DaemonService.ScoutTour:input_type -> daemon.ScoutTourRequest
-	53,  // 53: daemon.DaemonService.ScoutMarkets:input_type -> daemon.ScoutMarketsRequest
-	56,  // 54: daemon.DaemonService.AssignScoutingFleet:input_type -> daemon.AssignScoutingFleetRequest
-	27,  // 55: daemon.DaemonService.ScoutPostCoordinator:input_type -> daemon.ScoutPostCoordinatorRequest
-	29,  // 56: daemon.DaemonService.TradeFleetCoordinator:input_type -> daemon.TradeFleetCoordinatorRequest
-	31,  // 57: daemon.DaemonService.SitingCoordinator:input_type -> daemon.SitingCoordinatorRequest
-	33,  // 58: daemon.DaemonService.FleetAutosizerCoordinator:input_type -> daemon.FleetAutosizerCoordinatorRequest
-	35,  // 59: daemon.DaemonService.BootstrapCoordinator:input_type -> daemon.BootstrapCoordinatorRequest
-	37,  // 60: daemon.DaemonService.CapacityReconcilerCoordinator:input_type -> daemon.CapacityReconcilerCoordinatorRequest
-	39,  // 61: daemon.DaemonService.AutoOutfitCoordinator:input_type -> daemon.AutoOutfitCoordinatorRequest
-	41,  // 62: daemon.DaemonService.FrontierExpansionCoordinator:input_type -> daemon.FrontierExpansionCoordinatorRequest
-	43,  // 63: daemon.DaemonService.ShipyardBackfillCoordinator:input_type -> daemon.ShipyardBackfillCoordinatorRequest
-	45,  // 64: daemon.DaemonService.WorkerRebalancerCoordinator:input_type -> daemon.WorkerRebalancerCoordinatorRequest
-	47,  // 65: daemon.DaemonService.AddScoutPost:input_type -> daemon.AddScoutPostRequest
-	49,  // 66: daemon.DaemonService.RemoveScoutPost:input_type -> daemon.RemoveScoutPostRequest
-	51,  // 67: daemon.DaemonService.ListScoutPosts:input_type -> daemon.ListScoutPostsRequest
-	58,  // 68: daemon.DaemonService.ListContainers:input_type -> daemon.ListContainersRequest
-	61,  // 69: daemon.DaemonService.GetContainer:input_type -> daemon.GetContainerRequest
-	63,  // 70: daemon.DaemonService.StopContainer:input_type -> daemon.StopContainerRequest
-	65,  // 71: daemon.DaemonService.GetContainerLogs:input_type -> daemon.GetContainerLogsRequest
-	68,  // 72: daemon.DaemonService.HealthCheck:input_type -> daemon.HealthCheckRequest
-	70,  // 73: daemon.DaemonService.GetAPIBudget:input_type -> daemon.GetAPIBudgetRequest
-	76,  // 74: daemon.DaemonService.ListShips:input_type -> daemon.ListShipsRequest
-	79,  // 75: daemon.DaemonService.GetShip:input_type -> daemon.GetShipRequest
-	81,  // 76: daemon.DaemonService.RefreshShip:input_type -> daemon.RefreshShipRequest
-	83,  // 77: daemon.DaemonService.ReserveShip:input_type -> daemon.ReserveShipRequest
-	85,  // 78: daemon.DaemonService.ReleaseShip:input_type -> daemon.ReleaseShipRequest
-	87,  // 79: daemon.DaemonService.AssignShipFleet:input_type -> daemon.AssignShipFleetRequest
-	91,  // 80: daemon.DaemonService.UnassignShipFleet:input_type -> daemon.UnassignShipFleetRequest
-	93,  // 81: daemon.DaemonService.ListFleets:input_type -> daemon.ListFleetsRequest
-	89,  // 82: daemon.DaemonService.FleetHub:input_type -> daemon.FleetHubRequest
-	97,  // 83: daemon.DaemonService.ListWaypoints:input_type -> daemon.ListWaypointsRequest
-	99,  // 84: daemon.DaemonService.GetWaypoint:input_type -> daemon.GetWaypointRequest
-	103, // 85: daemon.DaemonService.PurchaseShip:input_type -> daemon.PurchaseShipRequest
-	105, // 86: daemon.DaemonService.BatchPurchaseShips:input_type -> daemon.BatchPurchaseShipsRequest
-	107, // 87: daemon.DaemonService.GetShipyardListings:input_type -> daemon.GetShipyardListingsRequest
-	113, // 88: daemon.DaemonService.StartGoodsFactory:input_type -> daemon.StartGoodsFactoryRequest
-	115, // 89: daemon.DaemonService.StopGoodsFactory:input_type -> daemon.StopGoodsFactoryRequest
-	117, // 90: daemon.DaemonService.FactoryWorkerCap:input_type -> daemon.FactoryWorkerCapRequest
-	119, // 91: daemon.DaemonService.TuneContainerConfig:input_type -> daemon.TuneContainerConfigRequest
-	121, // 92: daemon.DaemonService.ShowTunableConfig:input_type -> daemon.ShowTunableConfigRequest
-	124, // 93: daemon.DaemonService.GetFrontierStatus:input_type -> daemon.GetFrontierStatusRequest
-	126, // 94: daemon.DaemonService.GetFactoryStatus:input_type -> daemon.GetFactoryStatusRequest
-	128, // 95: daemon.DaemonService.ScanArbitrageOpportunities:input_type -> daemon.ScanArbitrageOpportunitiesRequest
-	131, // 96: daemon.DaemonService.StartArbitrageCoordinator:input_type -> daemon.StartArbitrageCoordinatorRequest
-	133, // 97: daemon.DaemonService.JettisonCargo:input_type -> daemon.JettisonCargoRequest
-	145, // 98: daemon.DaemonService.GasExtractionOperation:input_type -> daemon.GasExtractionOperationRequest
-	135, // 99: daemon.DaemonService.StartTradeRoute:input_type -> daemon.StartTradeRouteRequest
-	137, // 100: daemon.DaemonService.StartWarehouse:input_type -> daemon.StartWarehouseRequest
-	139, // 101: daemon.DaemonService.StartArbRun:input_type -> daemon.StartArbRunRequest
-	141, // 102: daemon.DaemonService.StartTourRun:input_type -> daemon.StartTourRunRequest
-	143, // 103: daemon.DaemonService.StartStocker:input_type -> daemon.StartStockerRequest
-	147, // 104: daemon.DaemonService.StartConstructionPipeline:input_type -> daemon.StartConstructionPipelineRequest
-	150, // 105: daemon.DaemonService.GetConstructionStatus:input_type -> daemon.GetConstructionStatusRequest
-	152, // 106: daemon.DaemonService.StopConstructionPipeline:input_type -> daemon.StopConstructionPipelineRequest
-	154, // 107: daemon.DaemonService.ConstructionGoodOverride:input_type -> daemon.ConstructionGoodOverrideRequest
-	158, // 108: daemon.DaemonService.ApplyDepotTopology:input_type -> daemon.ApplyDepotTopologyRequest
-	160, // 109: daemon.DaemonService.AddDepot:input_type -> daemon.AddDepotRequest
-	162, // 110: daemon.DaemonService.RemoveDepot:input_type -> daemon.RemoveDepotRequest
-	164, // 111: daemon.DaemonService.AddDepotElement:input_type -> daemon.AddDepotElementRequest
-	165, // 112: daemon.DaemonService.RemoveDepotElement:input_type -> daemon.RemoveDepotElementRequest
-	166, // 113: daemon.DaemonService.PlaceDepotElement:input_type -> daemon.PlaceDepotElementRequest
-	168, // 114: daemon.DaemonService.ListDepots:input_type -> daemon.ListDepotsRequest
-	170, // 115: daemon.DaemonService.StartDepot:input_type -> daemon.StartDepotRequest
-	172, // 116: daemon.DaemonService.StopDepot:input_type -> daemon.StopDepotRequest
-	1,   // 117: daemon.DaemonService.NavigateShip:output_type -> daemon.NavigateShipResponse
-	3,   // 118: daemon.DaemonService.RouteShip:output_type -> daemon.RouteShipResponse
-	5,   // 119: daemon.DaemonService.DockShip:output_type -> daemon.DockShipResponse
-	7,   // 120: daemon.DaemonService.OrbitShip:output_type -> daemon.OrbitShipResponse
-	9,   // 121: daemon.DaemonService.RefuelShip:output_type -> daemon.RefuelShipResponse
-	11,  // 122: daemon.DaemonService.JumpShip:output_type -> daemon.JumpShipResponse
-	15,  // 123: daemon.DaemonService.InstallModule:output_type -> daemon.InstallModuleResponse
-	17,  // 124: daemon.DaemonService.RemoveModule:output_type -> daemon.RemoveModuleResponse
-	19,  // 125: daemon.DaemonService.ListShipModules:output_type -> daemon.ListShipModulesResponse
-	21,  // 126: daemon.DaemonService.BatchContractWorkflow:output_type -> daemon.BatchContractWorkflowResponse
-	23,  // 127: daemon.DaemonService.ContractFleetCoordinator:output_type -> daemon.ContractFleetCoordinatorResponse
-	25,  // 128: daemon.DaemonService.ScoutTour:output_type -> daemon.ScoutTourResponse
-	54,  // 129: daemon.DaemonService.ScoutMarkets:output_type -> daemon.ScoutMarketsResponse
-	57,  // 130: daemon.DaemonService.AssignScoutingFleet:output_type -> daemon.AssignScoutingFleetResponse
-	28,  // 131: daemon.DaemonService.ScoutPostCoordinator:output_type -> daemon.ScoutPostCoordinatorResponse
-	30,  // 132: daemon.DaemonService.TradeFleetCoordinator:output_type -> daemon.TradeFleetCoordinatorResponse
-	32,  // 133: daemon.DaemonService.SitingCoordinator:output_type -> daemon.SitingCoordinatorResponse
-	34,  // 134: daemon.DaemonService.FleetAutosizerCoordinator:output_type -> daemon.FleetAutosizerCoordinatorResponse
-	36,  // 135: daemon.DaemonService.BootstrapCoordinator:output_type -> daemon.BootstrapCoordinatorResponse
-	38,  // 136: daemon.DaemonService.CapacityReconcilerCoordinator:output_type -> daemon.CapacityReconcilerCoordinatorResponse
-	40,  // 137: daemon.DaemonService.AutoOutfitCoordinator:output_type -> daemon.AutoOutfitCoordinatorResponse
-	42,  // 138: daemon.DaemonService.FrontierExpansionCoordinator:output_type -> daemon.FrontierExpansionCoordinatorResponse
-	44,  // 139: daemon.DaemonService.ShipyardBackfillCoordinator:output_type -> daemon.ShipyardBackfillCoordinatorResponse
-	46,  // 140: daemon.DaemonService.WorkerRebalancerCoordinator:output_type -> daemon.WorkerRebalancerCoordinatorResponse
-	48,  // 141: daemon.DaemonService.AddScoutPost:output_type -> daemon.ScoutPostResponse
-	50,  // 142: daemon.DaemonService.RemoveScoutPost:output_type -> daemon.RemoveScoutPostResponse
-	52,  // 143: daemon.DaemonService.ListScoutPosts:output_type -> daemon.ListScoutPostsResponse
-	59,  // 144: daemon.DaemonService.ListContainers:output_type -> daemon.ListContainersResponse
-	62,  // 145: daemon.DaemonService.GetContainer:output_type -> daemon.GetContainerResponse
-	64,  // 146: daemon.DaemonService.StopContainer:output_type -> daemon.StopContainerResponse
-	66,  // 147: daemon.DaemonService.GetContainerLogs:output_type -> daemon.GetContainerLogsResponse
-	69,  // 148: daemon.DaemonService.HealthCheck:output_type -> daemon.HealthCheckResponse
-	75,  // 149: daemon.DaemonService.GetAPIBudget:output_type -> daemon.GetAPIBudgetResponse
-	77,  // 150: daemon.DaemonService.ListShips:output_type -> daemon.ListShipsResponse
-	80,  // 151: daemon.DaemonService.GetShip:output_type -> daemon.GetShipResponse
-	82,  // 152: daemon.DaemonService.RefreshShip:output_type -> daemon.RefreshShipResponse
-	84,  // 153: daemon.DaemonService.ReserveShip:output_type -> daemon.ReserveShipResponse
-	86,  // 154: daemon.DaemonService.ReleaseShip:output_type -> daemon.ReleaseShipResponse
-	88,  // 155: daemon.DaemonService.AssignShipFleet:output_type -> daemon.AssignShipFleetResponse
-	92,  // 156: daemon.DaemonService.UnassignShipFleet:output_type -> daemon.UnassignShipFleetResponse
-	96,  // 157: daemon.DaemonService.ListFleets:output_type -> daemon.ListFleetsResponse
-	90,  // 158: daemon.DaemonService.FleetHub:output_type -> daemon.FleetHubResponse
-	98,  // 159: daemon.DaemonService.ListWaypoints:output_type -> daemon.ListWaypointsResponse
-	100, // 160: daemon.DaemonService.GetWaypoint:output_type -> daemon.GetWaypointResponse
-	104, // 161: daemon.DaemonService.PurchaseShip:output_type -> daemon.PurchaseShipResponse
-	106, // 162: daemon.DaemonService.BatchPurchaseShips:output_type -> daemon.BatchPurchaseShipsResponse
-	108, // 163: daemon.DaemonService.GetShipyardListings:output_type -> daemon.GetShipyardListingsResponse
-	114, // 164: daemon.DaemonService.StartGoodsFactory:output_type -> daemon.StartGoodsFactoryResponse
-	116, // 165: daemon.DaemonService.StopGoodsFactory:output_type -> daemon.StopGoodsFactoryResponse
-	118, // 166: daemon.DaemonService.FactoryWorkerCap:output_type -> daemon.FactoryWorkerCapResponse
-	120, // 167: daemon.DaemonService.TuneContainerConfig:output_type -> daemon.TuneContainerConfigResponse
-	123, // 168: daemon.DaemonService.ShowTunableConfig:output_type -> daemon.ShowTunableConfigResponse
-	125, // 169: daemon.DaemonService.GetFrontierStatus:output_type -> daemon.GetFrontierStatusResponse
-	127, // 170: daemon.DaemonService.GetFactoryStatus:output_type -> daemon.GetFactoryStatusResponse
-	130, // 171: daemon.DaemonService.ScanArbitrageOpportunities:output_type -> daemon.ScanArbitrageOpportunitiesResponse
-	132, // 172: daemon.DaemonService.StartArbitrageCoordinator:output_type -> daemon.StartArbitrageCoordinatorResponse
-	134, // 173: daemon.DaemonService.JettisonCargo:output_type -> daemon.JettisonCargoResponse
-	146, // 174: daemon.DaemonService.GasExtractionOperation:output_type -> daemon.GasExtractionOperationResponse
-	136, // 175: daemon.DaemonService.StartTradeRoute:output_type -> daemon.StartTradeRouteResponse
-	138, // 176: daemon.DaemonService.StartWarehouse:output_type -> daemon.StartWarehouseResponse
-	140, // 177: daemon.DaemonService.StartArbRun:output_type -> daemon.StartArbRunResponse
-	142, // 178: daemon.DaemonService.StartTourRun:output_type -> daemon.StartTourRunResponse
-	144, // 179: daemon.DaemonService.StartStocker:output_type -> daemon.StartStockerResponse
-	148, // 180: daemon.DaemonService.StartConstructionPipeline:output_type -> daemon.StartConstructionPipelineResponse
-	151, // 181: daemon.DaemonService.GetConstructionStatus:output_type -> daemon.GetConstructionStatusResponse
-	153, // 182: daemon.DaemonService.StopConstructionPipeline:output_type -> daemon.StopConstructionPipelineResponse
-	155, // 183: daemon.DaemonService.ConstructionGoodOverride:output_type -> daemon.ConstructionGoodOverrideResponse
-	159, // 184: daemon.DaemonService.ApplyDepotTopology:output_type -> daemon.ApplyDepotTopologyResponse
-	161, // 185: daemon.DaemonService.AddDepot:output_type -> daemon.AddDepotResponse
-	163, // 186: daemon.DaemonService.RemoveDepot:output_type -> daemon.RemoveDepotResponse
-	167, // 187: daemon.DaemonService.AddDepotElement:output_type -> daemon.DepotElementResponse
-	167, // 188: daemon.DaemonService.RemoveDepotElement:output_type -> daemon.DepotElementResponse
-	167, // 189: daemon.DaemonService.PlaceDepotElement:output_type -> daemon.DepotElementResponse
-	169, // 190: daemon.DaemonService.ListDepots:output_type -> daemon.ListDepotsResponse
-	171, // 191: daemon.DaemonService.StartDepot:output_type -> daemon.StartDepotResponse
-	173, // 192: daemon.DaemonService.StopDepot:output_type -> daemon.StopDepotResponse
-	117, // [117:193] is the sub-list for method output_type
-	41,  // [41:117] is the sub-list for method input_type
-	41,  // [41:41] is the sub-list for extension type_name
-	41,  // [41:41] is the sub-list for extension extendee
-	0,   // [0:41] is the sub-list for field type_name
+	39,  // 4: daemon.BootstrapFleetResponse.purchases:type_name -> daemon.FleetPurchasePlan
+	40,  // 5: daemon.BootstrapFleetResponse.containers:type_name -> daemon.FleetContainerPlan
+	43,  // 6: daemon.LaunchOperationProfileResponse.launches:type_name -> daemon.OperationLaunch
+	26,  // 7: daemon.ScoutPostResponse.post:type_name -> daemon.ScoutPost
+	26,  // 8: daemon.ListScoutPostsResponse.posts:type_name -> daemon.ScoutPost
+	215, // 9: daemon.ScoutMarketsResponse.assignments:type_name -> daemon.ScoutMarketsResponse.AssignmentsEntry
+	71,  // 10: daemon.ListContainersResponse.containers:type_name -> daemon.ContainerInfo
+	71,  // 11: daemon.GetContainerResponse.container:type_name -> daemon.ContainerInfo
+	84,  // 12: daemon.GetContainerLogsResponse.logs:type_name -> daemon.LogEntry
+	87,  // 13: daemon.HealthCheckResponse.recent_runtime_terminations:type_name -> daemon.RuntimeTermination
+	216, // 14: daemon.APIBudgetReport.purpose_counts:type_name -> daemon.APIBudgetReport.PurposeCountsEntry
+	217, // 15: daemon.APIBudgetReport.purpose_share_pct:type_name -> daemon.APIBudgetReport.PurposeSharePctEntry
+	89,  // 16: daemon.APIBudgetReport.per_hull:type_name -> daemon.APIBudgetHullStats
+	91,  // 17: daemon.DutyCycleReport.hulls:type_name -> daemon.DutyCycleHullStats
+	90,  // 18: daemon.GetAPIBudgetResponse.current:type_name -> daemon.APIBudgetReport
+	90,  // 19: daemon.GetAPIBudgetResponse.rolling_5m:type_name -> daemon.APIBudgetReport
+	92,  // 20: daemon.GetAPIBudgetResponse.duty_cycle:type_name -> daemon.DutyCycleReport
+	96,  // 21: daemon.ListShipsResponse.ships:type_name -> daemon.ShipInfo
+	120, // 22: daemon.GetShipResponse.ship:type_name -> daemon.ShipDetail
+	120, // 23: daemon.RefreshShipResponse.ship:type_name -> daemon.ShipDetail
+	112, // 24: daemon.Fleet.ships:type_name -> daemon.FleetShip
+	113, // 25: daemon.ListFleetsResponse.fleets:type_name -> daemon.Fleet
+	119, // 26: daemon.ListWaypointsResponse.waypoints:type_name -> daemon.WaypointDetail
+	119, // 27: daemon.GetWaypointResponse.waypoint:type_name -> daemon.WaypointDetail
+	128, // 28: daemon.ShipDetail.cargo_inventory:type_name -> daemon.CargoItem
+	127, // 29: daemon.GetShipyardListingsResponse.listings:type_name -> daemon.ShipListing
+	129, // 30: daemon.ShipRoute.segments:type_name -> daemon.RouteSegment
+	140, // 31: daemon.ShowTunableConfigResponse.knobs:type_name -> daemon.TunableKnobStatus
+	147, // 32: daemon.ScanArbitrageOpportunitiesResponse.opportunities:type_name -> daemon.ArbitrageOpportunity
+	130, // 33: daemon.GasExtractionOperationResponse.ship_routes:type_name -> daemon.ShipRoute
+	167, // 34: daemon.StartConstructionPipelineResponse.materials:type_name -> daemon.ConstructionMaterial
+	167, // 35: daemon.GetConstructionStatusResponse.materials:type_name -> daemon.ConstructionMaterial
+	174, // 36: daemon.DepotSpec.warehouses:type_name -> daemon.DepotElement
+	174, // 37: daemon.DepotSpec.stockers:type_name -> daemon.DepotElement
+	174, // 38: daemon.DepotSpec.delivery_hulls:type_name -> daemon.DepotElement
+	174, // 39: daemon.DepotSpec.source_hubs:type_name -> daemon.DepotElement
+	175, // 40: daemon.ApplyDepotTopologyRequest.depots:type_name -> daemon.DepotSpec
+	175, // 41: daemon.AddDepotRequest.depot:type_name -> daemon.DepotSpec
+	175, // 42: daemon.ListDepotsResponse.depots:type_name -> daemon.DepotSpec
+	175, // 43: daemon.StartDepotRequest.depot:type_name -> daemon.DepotSpec
+	218, // 44: daemon.SupplyTransitionStat.edges:type_name -> daemon.SupplyTransitionStat.EdgesEntry
+	219, // 45: daemon.SupplyTransitionStat.average_dwell_seconds:type_name -> daemon.SupplyTransitionStat.AverageDwellSecondsEntry
+	197, // 46: daemon.GetSupplyTransitionStatsResponse.stats:type_name -> daemon.SupplyTransitionStat
+	200, // 47: daemon.GetSystemOverviewResponse.ships:type_name -> daemon.SystemOverviewShip
+	201, // 48: daemon.GetSystemOverviewResponse.containers:type_name -> daemon.SystemOverviewContainer
+	202, // 49: daemon.GetSystemOverviewResponse.markets:type_name -> daemon.SystemMarketFreshness
+	203, // 50: daemon.GetSystemOverviewResponse.deliveries:type_name -> daemon.SystemOverviewDelivery
+	204, // 51: daemon.GetSystemOverviewResponse.lanes:type_name -> daemon.SystemOverviewLane
+	220, // 52: daemon.ScheduledJob.params:type_name -> daemon.ScheduledJob.ParamsEntry
+	221, // 53: daemon.AddScheduledJobRequest.params:type_name -> daemon.AddScheduledJobRequest.ParamsEntry
+	208, // 54: daemon.AddScheduledJobResponse.job:type_name -> daemon.ScheduledJob
+	208, // 55: daemon.ListScheduledJobsResponse.jobs:type_name -> daemon.ScheduledJob
+	66,  // 56: daemon.ScoutMarketsResponse.AssignmentsEntry.value:type_name -> daemon.MarketAssignment
+	0,   // 57: daemon.DaemonService.NavigateShip:input_type -> daemon.NavigateShipRequest
+	2,   // 58: daemon.DaemonService.RouteShip:input_type -> daemon.RouteShipRequest
+	4,   // 59: daemon.DaemonService.DockShip:input_type -> daemon.DockShipRequest
+	6,   // 60: daemon.DaemonService.OrbitShip:input_type -> daemon.OrbitShipRequest
+	8,   // 61: daemon.DaemonService.RefuelShip:input_type -> daemon.RefuelShipRequest
+	10,  // 62: daemon.DaemonService.JumpShip:input_type -> daemon.JumpShipRequest
+	14,  // 63: daemon.DaemonService.InstallModule:input_type -> daemon.InstallModuleRequest
+	16,  // 64: daemon.DaemonService.RemoveModule:input_type -> daemon.RemoveModuleRequest
+	18,  // 65: daemon.DaemonService.ListShipModules:input_type -> daemon.ListShipModulesRequest
+	20,  // 66: daemon.DaemonService.BatchContractWorkflow:input_type -> daemon.BatchContractWorkflowRequest
+	22,  // 67: daemon.DaemonService.ContractFleetCoordinator:input_type -> daemon.ContractFleetCoordinatorRequest
+	24,  // 68: daemon.DaemonService.ScoutTour:input_type -> daemon.ScoutTourRequest
+	64,  // 69: daemon.DaemonService.ScoutMarkets:input_type -> daemon.ScoutMarketsRequest
+	67,  // 70: daemon.DaemonService.AssignScoutingFleet:input_type -> daemon.AssignScoutingFleetRequest
+	27,  // 71: daemon.DaemonService.ScoutPostCoordinator:input_type -> daemon.ScoutPostCoordinatorRequest
+	29,  // 72: daemon.DaemonService.TradeFleetCoordinator:input_type -> daemon.TradeFleetCoordinatorRequest
+	31,  // 73: daemon.DaemonService.SitingCoordinator:input_type -> daemon.SitingCoordinatorRequest
+	33,  // 74: daemon.DaemonService.FleetAutosizerCoordinator:input_type -> daemon.FleetAutosizerCoordinatorRequest
+	35,  // 75: daemon.DaemonService.BootstrapCoordinator:input_type -> daemon.BootstrapCoordinatorRequest
+	37,  // 76: daemon.DaemonService.BootstrapFleet:input_type -> daemon.BootstrapFleetRequest
+	41,  // 77: daemon.DaemonService.LaunchOperationProfile:input_type -> daemon.LaunchOperationProfileRequest
+	44,  // 78: daemon.DaemonService.CapacityReconcilerCoordinator:input_type -> daemon.CapacityReconcilerCoordinatorRequest
+	46,  // 79: daemon.DaemonService.AutoOutfitCoordinator:input_type -> daemon.AutoOutfitCoordinatorRequest
+	48,  // 80: daemon.DaemonService.FrontierExpansionCoordinator:input_type -> daemon.FrontierExpansionCoordinatorRequest
+	50,  // 81: daemon.DaemonService.ShipyardBackfillCoordinator:input_type -> daemon.ShipyardBackfillCoordinatorRequest
+	52,  // 82: daemon.DaemonService.ProbeParkingCoordinator:input_type -> daemon.ProbeParkingCoordinatorRequest
+	54,  // 83: daemon.DaemonService.TankerCoordinator:input_type -> daemon.TankerCoordinatorRequest
+	56,  // 84: daemon.DaemonService.WorkerRebalancerCoordinator:input_type -> daemon.WorkerRebalancerCoordinatorRequest
+	58,  // 85: daemon.DaemonService.AddScoutPost:input_type -> daemon.AddScoutPostRequest
+	60,  // 86: daemon.DaemonService.RemoveScoutPost:input_type -> daemon.RemoveScoutPostRequest
+	62,  // 87: daemon.DaemonService.ListScoutPosts:input_type -> daemon.ListScoutPostsRequest
+	69,  // 88: daemon.DaemonService.ListContainers:input_type -> daemon.ListContainersRequest
+	72,  // 89: daemon.DaemonService.GetContainer:input_type -> daemon.GetContainerRequest
+	74,  // 90: daemon.DaemonService.StopContainer:input_type -> daemon.StopContainerRequest
+	76,  // 91: daemon.DaemonService.PauseContainer:input_type -> daemon.PauseContainerRequest
+	78,  // 92: daemon.DaemonService.ResumeContainer:input_type -> daemon.ResumeContainerRequest
+	80,  // 93: daemon.DaemonService.SetContainerLogLevel:input_type -> daemon.SetContainerLogLevelRequest
+	82,  // 94: daemon.DaemonService.GetContainerLogs:input_type -> daemon.GetContainerLogsRequest
+	85,  // 95: daemon.DaemonService.HealthCheck:input_type -> daemon.HealthCheckRequest
+	88,  // 96: daemon.DaemonService.GetAPIBudget:input_type -> daemon.GetAPIBudgetRequest
+	94,  // 97: daemon.DaemonService.ListShips:input_type -> daemon.ListShipsRequest
+	97,  // 98: daemon.DaemonService.GetShip:input_type -> daemon.GetShipRequest
+	99,  // 99: daemon.DaemonService.RefreshShip:input_type -> daemon.RefreshShipRequest
+	101, // 100: daemon.DaemonService.ReserveShip:input_type -> daemon.ReserveShipRequest
+	103, // 101: daemon.DaemonService.ReleaseShip:input_type -> daemon.ReleaseShipRequest
+	105, // 102: daemon.DaemonService.AssignShipFleet:input_type -> daemon.AssignShipFleetRequest
+	109, // 103: daemon.DaemonService.UnassignShipFleet:input_type -> daemon.UnassignShipFleetRequest
+	111, // 104: daemon.DaemonService.ListFleets:input_type -> daemon.ListFleetsRequest
+	107, // 105: daemon.DaemonService.FleetHub:input_type -> daemon.FleetHubRequest
+	115, // 106: daemon.DaemonService.ListWaypoints:input_type -> daemon.ListWaypointsRequest
+	117, // 107: daemon.DaemonService.GetWaypoint:input_type -> daemon.GetWaypointRequest
+	121, // 108: daemon.DaemonService.PurchaseShip:input_type -> daemon.PurchaseShipRequest
+	123, // 109: daemon.DaemonService.BatchPurchaseShips:input_type -> daemon.BatchPurchaseShipsRequest
+	125, // 110: daemon.DaemonService.GetShipyardListings:input_type -> daemon.GetShipyardListingsRequest
+	131, // 111: daemon.DaemonService.StartGoodsFactory:input_type -> daemon.StartGoodsFactoryRequest
+	133, // 112: daemon.DaemonService.StopGoodsFactory:input_type -> daemon.StopGoodsFactoryRequest
+	135, // 113: daemon.DaemonService.FactoryWorkerCap:input_type -> daemon.FactoryWorkerCapRequest
+	137, // 114: daemon.DaemonService.TuneContainerConfig:input_type -> daemon.TuneContainerConfigRequest
+	139, // 115: daemon.DaemonService.ShowTunableConfig:input_type -> daemon.ShowTunableConfigRequest
+	142, // 116: daemon.DaemonService.GetFrontierStatus:input_type -> daemon.GetFrontierStatusRequest
+	144, // 117: daemon.DaemonService.GetFactoryStatus:input_type -> daemon.GetFactoryStatusRequest
+	146, // 118: daemon.DaemonService.ScanArbitrageOpportunities:input_type -> daemon.ScanArbitrageOpportunitiesRequest
+	149, // 119: daemon.DaemonService.StartArbitrageCoordinator:input_type -> daemon.StartArbitrageCoordinatorRequest
+	151, // 120: daemon.DaemonService.JettisonCargo:input_type -> daemon.JettisonCargoRequest
+	163, // 121: daemon.DaemonService.GasExtractionOperation:input_type -> daemon.GasExtractionOperationRequest
+	153, // 122: daemon.DaemonService.StartTradeRoute:input_type -> daemon.StartTradeRouteRequest
+	155, // 123: daemon.DaemonService.StartWarehouse:input_type -> daemon.StartWarehouseRequest
+	157, // 124: daemon.DaemonService.StartArbRun:input_type -> daemon.StartArbRunRequest
+	159, // 125: daemon.DaemonService.StartTourRun:input_type -> daemon.StartTourRunRequest
+	161, // 126: daemon.DaemonService.StartStocker:input_type -> daemon.StartStockerRequest
+	165, // 127: daemon.DaemonService.StartConstructionPipeline:input_type -> daemon.StartConstructionPipelineRequest
+	168, // 128: daemon.DaemonService.GetConstructionStatus:input_type -> daemon.GetConstructionStatusRequest
+	170, // 129: daemon.DaemonService.StopConstructionPipeline:input_type -> daemon.StopConstructionPipelineRequest
+	172, // 130: daemon.DaemonService.ConstructionGoodOverride:input_type -> daemon.ConstructionGoodOverrideRequest
+	176, // 131: daemon.DaemonService.ApplyDepotTopology:input_type -> daemon.ApplyDepotTopologyRequest
+	178, // 132: daemon.DaemonService.AddDepot:input_type -> daemon.AddDepotRequest
+	180, // 133: daemon.DaemonService.RemoveDepot:input_type -> daemon.RemoveDepotRequest
+	182, // 134: daemon.DaemonService.AddDepotElement:input_type -> daemon.AddDepotElementRequest
+	183, // 135: daemon.DaemonService.RemoveDepotElement:input_type -> daemon.RemoveDepotElementRequest
+	184, // 136: daemon.DaemonService.PlaceDepotElement:input_type -> daemon.PlaceDepotElementRequest
+	186, // 137: daemon.DaemonService.ListDepots:input_type -> daemon.ListDepotsRequest
+	188, // 138: daemon.DaemonService.StartDepot:input_type -> daemon.StartDepotRequest
+	190, // 139: daemon.DaemonService.StopDepot:input_type -> daemon.StopDepotRequest
+	192, // 140: daemon.DaemonService.RegisterAgent:input_type -> daemon.RegisterAgentRequest
+	194, // 141: daemon.DaemonService.ExportMarketData:input_type -> daemon.ExportMarketDataRequest
+	196, // 142: daemon.DaemonService.GetSupplyTransitionStats:input_type -> daemon.GetSupplyTransitionStatsRequest
+	199, // 143: daemon.DaemonService.GetSystemOverview:input_type -> daemon.GetSystemOverviewRequest
+	206, // 144: daemon.DaemonService.WarmSystem:input_type -> daemon.WarmSystemRequest
+	209, // 145: daemon.DaemonService.AddScheduledJob:input_type -> daemon.AddScheduledJobRequest
+	211, // 146: daemon.DaemonService.ListScheduledJobs:input_type -> daemon.ListScheduledJobsRequest
+	213, // 147: daemon.DaemonService.RemoveScheduledJob:input_type -> daemon.RemoveScheduledJobRequest
+	1,   // 148: daemon.DaemonService.NavigateShip:output_type -> daemon.NavigateShipResponse
+	3,   // 149: daemon.DaemonService.RouteShip:output_type -> daemon.RouteShipResponse
+	5,   // 150: daemon.DaemonService.DockShip:output_type -> daemon.DockShipResponse
+	7,   // 151: daemon.DaemonService.OrbitShip:output_type -> daemon.OrbitShipResponse
+	9,   // 152: daemon.DaemonService.RefuelShip:output_type -> daemon.RefuelShipResponse
+	11,  // 153: daemon.DaemonService.JumpShip:output_type -> daemon.JumpShipResponse
+	15,  // 154: daemon.DaemonService.InstallModule:output_type -> daemon.InstallModuleResponse
+	17,  // 155: daemon.DaemonService.RemoveModule:output_type -> daemon.RemoveModuleResponse
+	19,  // 156: daemon.DaemonService.ListShipModules:output_type -> daemon.ListShipModulesResponse
+	21,  // 157: daemon.DaemonService.BatchContractWorkflow:output_type -> daemon.BatchContractWorkflowResponse
+	23,  // 158: daemon.DaemonService.ContractFleetCoordinator:output_type -> daemon.ContractFleetCoordinatorResponse
+	25,  // 159: daemon.DaemonService.ScoutTour:output_type -> daemon.ScoutTourResponse
+	65,  // 160: daemon.DaemonService.ScoutMarkets:output_type -> daemon.ScoutMarketsResponse
+	68,  // 161: daemon.DaemonService.AssignScoutingFleet:output_type -> daemon.AssignScoutingFleetResponse
+	28,  // 162: daemon.DaemonService.ScoutPostCoordinator:output_type -> daemon.ScoutPostCoordinatorResponse
+	30,  // 163: daemon.DaemonService.TradeFleetCoordinator:output_type -> daemon.TradeFleetCoordinatorResponse
+	32,  // 164: daemon.DaemonService.SitingCoordinator:output_type -> daemon.SitingCoordinatorResponse
+	34,  // 165: daemon.DaemonService.FleetAutosizerCoordinator:output_type -> daemon.FleetAutosizerCoordinatorResponse
+	36,  // 166: daemon.DaemonService.BootstrapCoordinator:output_type -> daemon.BootstrapCoordinatorResponse
+	38,  // 167: daemon.DaemonService.BootstrapFleet:output_type -> daemon.BootstrapFleetResponse
+	42,  // 168: daemon.DaemonService.LaunchOperationProfile:output_type -> daemon.LaunchOperationProfileResponse
+	45,  // 169: daemon.DaemonService.CapacityReconcilerCoordinator:output_type -> daemon.CapacityReconcilerCoordinatorResponse
+	47,  // 170: daemon.DaemonService.AutoOutfitCoordinator:output_type -> daemon.AutoOutfitCoordinatorResponse
+	49,  // 171: daemon.DaemonService.FrontierExpansionCoordinator:output_type -> daemon.FrontierExpansionCoordinatorResponse
+	51,  // 172: daemon.DaemonService.ShipyardBackfillCoordinator:output_type -> daemon.ShipyardBackfillCoordinatorResponse
+	53,  // 173: daemon.DaemonService.ProbeParkingCoordinator:output_type -> daemon.ProbeParkingCoordinatorResponse
+	55,  // 174: daemon.DaemonService.TankerCoordinator:output_type -> daemon.TankerCoordinatorResponse
+	57,  // 175: daemon.DaemonService.WorkerRebalancerCoordinator:output_type -> daemon.WorkerRebalancerCoordinatorResponse
+	59,  // 176: daemon.DaemonService.AddScoutPost:output_type -> daemon.ScoutPostResponse
+	61,  // 177: daemon.DaemonService.RemoveScoutPost:output_type -> daemon.RemoveScoutPostResponse
+	63,  // 178: daemon.DaemonService.ListScoutPosts:output_type -> daemon.ListScoutPostsResponse
+	70,  // 179: daemon.DaemonService.ListContainers:output_type -> daemon.ListContainersResponse
+	73,  // 180: daemon.DaemonService.GetContainer:output_type -> daemon.GetContainerResponse
+	75,  // 181: daemon.DaemonService.StopContainer:output_type -> daemon.StopContainerResponse
+	77,  // 182: daemon.DaemonService.PauseContainer:output_type -> daemon.PauseContainerResponse
+	79,  // 183: daemon.DaemonService.ResumeContainer:output_type -> daemon.ResumeContainerResponse
+	81,  // 184: daemon.DaemonService.SetContainerLogLevel:output_type -> daemon.SetContainerLogLevelResponse
+	83,  // 185: daemon.DaemonService.GetContainerLogs:output_type -> daemon.GetContainerLogsResponse
+	86,  // 186: daemon.DaemonService.HealthCheck:output_type -> daemon.HealthCheckResponse
+	93,  // 187: daemon.DaemonService.GetAPIBudget:output_type -> daemon.GetAPIBudgetResponse
+	95,  // 188: daemon.DaemonService.ListShips:output_type -> daemon.ListShipsResponse
+	98,  // 189: daemon.DaemonService.GetShip:output_type -> daemon.GetShipResponse
+	100, // 190: daemon.DaemonService.RefreshShip:output_type -> daemon.RefreshShipResponse
+	102, // 191: daemon.DaemonService.ReserveShip:output_type -> daemon.ReserveShipResponse
+	104, // 192: daemon.DaemonService.ReleaseShip:output_type -> daemon.ReleaseShipResponse
+	106, // 193: daemon.DaemonService.AssignShipFleet:output_type -> daemon.AssignShipFleetResponse
+	110, // 194: daemon.DaemonService.UnassignShipFleet:output_type -> daemon.UnassignShipFleetResponse
+	114, // 195: daemon.DaemonService.ListFleets:output_type -> daemon.ListFleetsResponse
+	108, // 196: daemon.DaemonService.FleetHub:output_type -> daemon.FleetHubResponse
+	116, // 197: daemon.DaemonService.ListWaypoints:output_type -> daemon.ListWaypointsResponse
+	118, // 198: daemon.DaemonService.GetWaypoint:output_type -> daemon.GetWaypointResponse
+	122, // 199: daemon.DaemonService.PurchaseShip:output_type -> daemon.PurchaseShipResponse
+	124, // 200: daemon.DaemonService.BatchPurchaseShips:output_type -> daemon.BatchPurchaseShipsResponse
+	126, // 201: daemon.DaemonService.GetShipyardListings:output_type -> daemon.GetShipyardListingsResponse
+	132, // 202: daemon.DaemonService.StartGoodsFactory:output_type -> daemon.StartGoodsFactoryResponse
+	134, // 203: daemon.DaemonService.StopGoodsFactory:output_type -> daemon.StopGoodsFactoryResponse
+	136, // 204: daemon.DaemonService.FactoryWorkerCap:output_type -> daemon.FactoryWorkerCapResponse
+	138, // 205: daemon.DaemonService.TuneContainerConfig:output_type -> daemon.TuneContainerConfigResponse
+	141, // 206: daemon.DaemonService.ShowTunableConfig:output_type -> daemon.ShowTunableConfigResponse
+	143, // 207: daemon.DaemonService.GetFrontierStatus:output_type -> daemon.GetFrontierStatusResponse
+	145, // 208: daemon.DaemonService.GetFactoryStatus:output_type -> daemon.GetFactoryStatusResponse
+	148, // 209: daemon.DaemonService.ScanArbitrageOpportunities:output_type -> daemon.ScanArbitrageOpportunitiesResponse
+	150, // 210: daemon.DaemonService.StartArbitrageCoordinator:output_type -> daemon.StartArbitrageCoordinatorResponse
+	152, // 211: daemon.DaemonService.JettisonCargo:output_type -> daemon.JettisonCargoResponse
+	164, // 212: daemon.DaemonService.GasExtractionOperation:output_type -> daemon.GasExtractionOperationResponse
+	154, // 213: daemon.DaemonService.StartTradeRoute:output_type -> daemon.StartTradeRouteResponse
+	156, // 214: daemon.DaemonService.StartWarehouse:output_type -> daemon.StartWarehouseResponse
+	158, // 215: daemon.DaemonService.StartArbRun:output_type -> daemon.StartArbRunResponse
+	160, // 216: daemon.DaemonService.StartTourRun:output_type -> daemon.StartTourRunResponse
+	162, // 217: daemon.DaemonService.StartStocker:output_type -> daemon.StartStockerResponse
+	166, // 218: daemon.DaemonService.StartConstructionPipeline:output_type -> daemon.StartConstructionPipelineResponse
+	169, // 219: daemon.DaemonService.GetConstructionStatus:output_type -> daemon.GetConstructionStatusResponse
+	171, // 220: daemon.DaemonService.StopConstructionPipeline:output_type -> daemon.StopConstructionPipelineResponse
+	173, // 221: daemon.DaemonService.ConstructionGoodOverride:output_type -> daemon.ConstructionGoodOverrideResponse
+	177, // 222: daemon.DaemonService.ApplyDepotTopology:output_type -> daemon.ApplyDepotTopologyResponse
+	179, // 223: daemon.DaemonService.AddDepot:output_type -> daemon.AddDepotResponse
+	181, // 224: daemon.DaemonService.RemoveDepot:output_type -> daemon.RemoveDepotResponse
+	185, // 225: daemon.DaemonService.AddDepotElement:output_type -> daemon.DepotElementResponse
+	185, // 226: daemon.DaemonService.RemoveDepotElement:output_type -> daemon.DepotElementResponse
+	185, // 227: daemon.DaemonService.PlaceDepotElement:output_type -> daemon.DepotElementResponse
+	187, // 228: daemon.DaemonService.ListDepots:output_type -> daemon.ListDepotsResponse
+	189, // 229: daemon.DaemonService.StartDepot:output_type -> daemon.StartDepotResponse
+	191, // 230: daemon.DaemonService.StopDepot:output_type -> daemon.StopDepotResponse
+	193, // 231: daemon.DaemonService.RegisterAgent:output_type -> daemon.RegisterAgentResponse
+	195, // 232: daemon.DaemonService.ExportMarketData:output_type -> daemon.ExportMarketDataResponse
+	198, // 233: daemon.DaemonService.GetSupplyTransitionStats:output_type -> daemon.GetSupplyTransitionStatsResponse
+	205, // 234: daemon.DaemonService.GetSystemOverview:output_type -> daemon.GetSystemOverviewResponse
+	207, // 235: daemon.DaemonService.WarmSystem:output_type -> daemon.WarmSystemResponse
+	210, // 236: daemon.DaemonService.AddScheduledJob:output_type -> daemon.AddScheduledJobResponse
+	212, // 237: daemon.DaemonService.ListScheduledJobs:output_type -> daemon.ListScheduledJobsResponse
+	214, // 238: daemon.DaemonService.RemoveScheduledJob:output_type -> daemon.RemoveScheduledJobResponse
+	148, // [148:239] is the sub-list for method output_type
+	57,  // [57:148] is the sub-list for method input_type
+	57,  // [57:57] is the sub-list for extension type_name
+	57,  // [57:57] is the sub-list for extension extendee
+	0,   // [0:57] is the sub-list for field type_name
 }
 
 func init() { file_pkg_proto_daemon_daemon_proto_init() }
@@ -14198,64 +17664,76 @@ func file_pkg_proto_daemon_daemon_proto_init() {
 	file_pkg_proto_daemon_daemon_proto_msgTypes[33].OneofWrappers = []any{}
 	file_pkg_proto_daemon_daemon_proto_msgTypes[35].OneofWrappers = []any{}
 	file_pkg_proto_daemon_daemon_proto_msgTypes[37].OneofWrappers = []any{}
-	file_pkg_proto_daemon_daemon_proto_msgTypes[39].OneofWrappers = []any{}
 	file_pkg_proto_daemon_daemon_proto_msgTypes[41].OneofWrappers = []any{}
-	file_pkg_proto_daemon_daemon_proto_msgTypes[43].OneofWrappers = []any{}
-	file_pkg_proto_daemon_daemon_proto_msgTypes[45].OneofWrappers = []any{}
-	file_pkg_proto_daemon_daemon_proto_msgTypes[47].OneofWrappers = []any{}
-	file_pkg_proto_daemon_daemon_proto_msgTypes[49].OneofWrappers = []any{}
-	file_pkg_proto_daemon_daemon_proto_msgTypes[51].OneofWrappers = []any{}
-	file_pkg_proto_daemon_daemon_proto_msgTypes[53].OneofWrappers = []any{}
+	file_pkg_proto_daemon_daemon_proto_msgTypes[44].OneofWrappers = []any{}
+	file_pkg_proto_daemon_daemon_proto_msgTypes[46].OneofWrappers = []any{}
+	file_pkg_proto_daemon_daemon_proto_msgTypes[48].OneofWrappers = []any{}
+	file_pkg_proto_daemon_daemon_proto_msgTypes[50].OneofWrappers = []any{}
+	file_pkg_proto_daemon_daemon_proto_msgTypes[52].OneofWrappers = []any{}
+	file_pkg_proto_daemon_daemon_proto_msgTypes[54].OneofWrappers = []any{}
 	file_pkg_proto_daemon_daemon_proto_msgTypes[56].OneofWrappers = []any{}
 	file_pkg_proto_daemon_daemon_proto_msgTypes[58].OneofWrappers = []any{}
 	file_pkg_proto_daemon_daemon_proto_msgTypes[60].OneofWrappers = []any{}
-	file_pkg_proto_daemon_daemon_proto_msgTypes[65].OneofWrappers = []any{}
-	file_pkg_proto_daemon_daemon_proto_msgTypes[76].OneofWrappers = []any{}
-	file_pkg_proto_daemon_daemon_proto_msgTypes[79].OneofWrappers = []any{}
-	file_pkg_proto_daemon_daemon_proto_msgTypes[81].OneofWrappers = []any{}
-	file_pkg_proto_daemon_daemon_proto_msgTypes[83].OneofWrappers = []any{}
-	file_pkg_proto_daemon_daemon_proto_msgTypes[85].OneofWrappers = []any{}
-	file_pkg_proto_daemon_daemon_proto_msgTypes[87].OneofWrappers = []any{}
-	file_pkg_proto_daemon_daemon_proto_msgTypes[89].OneofWrappers = []any{}
-	file_pkg_proto_daemon_daemon_proto_msgTypes[91].OneofWrappers = []any{}
-	file_pkg_proto_daemon_daemon_proto_msgTypes[93].OneofWrappers = []any{}
+	file_pkg_proto_daemon_daemon_proto_msgTypes[62].OneofWrappers = []any{}
+	file_pkg_proto_daemon_daemon_proto_msgTypes[64].OneofWrappers = []any{}
+	file_pkg_proto_daemon_daemon_proto_msgTypes[67].OneofWrappers = []any{}
+	file_pkg_proto_daemon_daemon_proto_msgTypes[69].OneofWrappers = []any{}
+	file_pkg_proto_daemon_daemon_proto_msgTypes[71].OneofWrappers = []any{}
+	file_pkg_proto_daemon_daemon_proto_msgTypes[82].OneofWrappers = []any{}
+	file_pkg_proto_daemon_daemon_proto_msgTypes[94].OneofWrappers = []any{}
 	file_pkg_proto_daemon_daemon_proto_msgTypes[97].OneofWrappers = []any{}
 	file_pkg_proto_daemon_daemon_proto_msgTypes[99].OneofWrappers = []any{}
+	file_pkg_proto_daemon_daemon_proto_msgTypes[101].OneofWrappers = []any{}
 	file_pkg_proto_daemon_daemon_proto_msgTypes[103].OneofWrappers = []any{}
 	file_pkg_proto_daemon_daemon_proto_msgTypes[105].OneofWrappers = []any{}
 	file_pkg_proto_daemon_daemon_proto_msgTypes[107].OneofWrappers = []any{}
-	file_pkg_proto_daemon_daemon_proto_msgTypes[113].OneofWrappers = []any{}
+	file_pkg_proto_daemon_daemon_proto_msgTypes[109].OneofWrappers = []any{}
+	file_pkg_proto_daemon_daemon_proto_msgTypes[111].OneofWrappers = []any{}
+	file_pkg_proto_daemon_daemon_proto_msgTypes[115].OneofWrappers = []any{}
 	file_pkg_proto_daemon_daemon_proto_msgTypes[117].OneofWrappers = []any{}
-	file_pkg_proto_daemon_daemon_proto_msgTypes[119].OneofWrappers = []any{}
 	file_pkg_proto_daemon_daemon_proto_msgTypes[121].OneofWrappers = []any{}
-	file_pkg_proto_daemon_daemon_proto_msgTypes[124].OneofWrappers = []any{}
-	file_pkg_proto_daemon_daemon_proto_msgTypes[133].OneofWrappers = []any{}
+	file_pkg_proto_daemon_daemon_proto_msgTypes[123].OneofWrappers = []any{}
+	file_pkg_proto_daemon_daemon_proto_msgTypes[125].OneofWrappers = []any{}
+	file_pkg_proto_daemon_daemon_proto_msgTypes[131].OneofWrappers = []any{}
 	file_pkg_proto_daemon_daemon_proto_msgTypes[135].OneofWrappers = []any{}
+	file_pkg_proto_daemon_daemon_proto_msgTypes[137].OneofWrappers = []any{}
 	file_pkg_proto_daemon_daemon_proto_msgTypes[139].OneofWrappers = []any{}
-	file_pkg_proto_daemon_daemon_proto_msgTypes[141].OneofWrappers = []any{}
-	file_pkg_proto_daemon_daemon_proto_msgTypes[143].OneofWrappers = []any{}
-	file_pkg_proto_daemon_daemon_proto_msgTypes[145].OneofWrappers = []any{}
-	file_pkg_proto_daemon_daemon_proto_msgTypes[147].OneofWrappers = []any{}
-	file_pkg_proto_daemon_daemon_proto_msgTypes[150].OneofWrappers = []any{}
+	file_pkg_proto_daemon_daemon_proto_msgTypes[142].OneofWrappers = []any{}
 	file_pkg_proto_daemon_daemon_proto_msgTypes[151].OneofWrappers = []any{}
-	file_pkg_proto_daemon_daemon_proto_msgTypes[152].OneofWrappers = []any{}
-	file_pkg_proto_daemon_daemon_proto_msgTypes[154].OneofWrappers = []any{}
-	file_pkg_proto_daemon_daemon_proto_msgTypes[158].OneofWrappers = []any{}
-	file_pkg_proto_daemon_daemon_proto_msgTypes[160].OneofWrappers = []any{}
-	file_pkg_proto_daemon_daemon_proto_msgTypes[162].OneofWrappers = []any{}
-	file_pkg_proto_daemon_daemon_proto_msgTypes[164].OneofWrappers = []any{}
+	file_pkg_proto_daemon_daemon_proto_msgTypes[153].OneofWrappers = []any{}
+	file_pkg_proto_daemon_daemon_proto_msgTypes[157].OneofWrappers = []any{}
+	file_pkg_proto_daemon_daemon_proto_msgTypes[159].OneofWrappers = []any{}
+	file_pkg_proto_daemon_daemon_proto_msgTypes[161].OneofWrappers = []any{}
+	file_pkg_proto_daemon_daemon_proto_msgTypes[163].OneofWrappers = []any{}
 	file_pkg_proto_daemon_daemon_proto_msgTypes[165].OneofWrappers = []any{}
-	file_pkg_proto_daemon_daemon_proto_msgTypes[166].OneofWrappers = []any{}
 	file_pkg_proto_daemon_daemon_proto_msgTypes[168].OneofWrappers = []any{}
+	file_pkg_proto_daemon_daemon_proto_msgTypes[169].OneofWrappers = []any{}
 	file_pkg_proto_daemon_daemon_proto_msgTypes[170].OneofWrappers = []any{}
 	file_pkg_proto_daemon_daemon_proto_msgTypes[172].OneofWrappers = []any{}
+	file_pkg_proto_daemon_daemon_proto_msgTypes[176].OneofWrappers = []any{}
+	file_pkg_proto_daemon_daemon_proto_msgTypes[178].OneofWrappers = []any{}
+	file_pkg_proto_daemon_daemon_proto_msgTypes[180].OneofWrappers = []any{}
+	file_pkg_proto_daemon_daemon_proto_msgTypes[182].OneofWrappers = []any{}
+	file_pkg_proto_daemon_daemon_proto_msgTypes[183].OneofWrappers = []any{}
+	file_pkg_proto_daemon_daemon_proto_msgTypes[184].OneofWrappers = []any{}
+	file_pkg_proto_daemon_daemon_proto_msgTypes[186].OneofWrappers = []any{}
+	file_pkg_proto_daemon_daemon_proto_msgTypes[188].OneofWrappers = []any{}
+	file_pkg_proto_daemon_daemon_proto_msgTypes[190].OneofWrappers = []any{}
+	file_pkg_proto_daemon_daemon_proto_msgTypes[192].OneofWrappers = []any{}
+	file_pkg_proto_daemon_daemon_proto_msgTypes[194].OneofWrappers = []any{}
+	file_pkg_proto_daemon_daemon_proto_msgTypes[196].OneofWrappers = []any{}
+	file_pkg_proto_daemon_daemon_proto_msgTypes[199].OneofWrappers = []any{}
+	file_pkg_proto_daemon_daemon_proto_msgTypes[206].OneofWrappers = []any{}
+	file_pkg_proto_daemon_daemon_proto_msgTypes[209].OneofWrappers = []any{}
+	file_pkg_proto_daemon_daemon_proto_msgTypes[211].OneofWrappers = []any{}
+	file_pkg_proto_daemon_daemon_proto_msgTypes[213].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_pkg_proto_daemon_daemon_proto_rawDesc), len(file_pkg_proto_daemon_daemon_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   177,
+			NumMessages:   222,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -38,10 +38,14 @@ const (
 	DaemonService_SitingCoordinator_FullMethodName             = "/daemon.DaemonService/SitingCoordinator"
 	DaemonService_FleetAutosizerCoordinator_FullMethodName     = "/daemon.DaemonService/FleetAutosizerCoordinator"
 	DaemonService_BootstrapCoordinator_FullMethodName          = "/daemon.DaemonService/BootstrapCoordinator"
+	DaemonService_BootstrapFleet_FullMethodName                = "/daemon.DaemonService/BootstrapFleet"
+	DaemonService_LaunchOperationProfile_FullMethodName        = "/daemon.DaemonService/LaunchOperationProfile"
 	DaemonService_CapacityReconcilerCoordinator_FullMethodName = "/daemon.DaemonService/CapacityReconcilerCoordinator"
 	DaemonService_AutoOutfitCoordinator_FullMethodName         = "/daemon.DaemonService/AutoOutfitCoordinator"
 	DaemonService_FrontierExpansionCoordinator_FullMethodName  = "/daemon.DaemonService/FrontierExpansionCoordinator"
 	DaemonService_ShipyardBackfillCoordinator_FullMethodName   = "/daemon.DaemonService/ShipyardBackfillCoordinator"
+	DaemonService_ProbeParkingCoordinator_FullMethodName       = "/daemon.DaemonService/ProbeParkingCoordinator"
+	DaemonService_TankerCoordinator_FullMethodName             = "/daemon.DaemonService/TankerCoordinator"
 	DaemonService_WorkerRebalancerCoordinator_FullMethodName   = "/daemon.DaemonService/WorkerRebalancerCoordinator"
 	DaemonService_AddScoutPost_FullMethodName                  = "/daemon.DaemonService/AddScoutPost"
 	DaemonService_RemoveScoutPost_FullMethodName               = "/daemon.DaemonService/RemoveScoutPost"
@@ -49,6 +53,9 @@ const (
 	DaemonService_ListContainers_FullMethodName                = "/daemon.DaemonService/ListContainers"
 	DaemonService_GetContainer_FullMethodName                  = "/daemon.DaemonService/GetContainer"
 	DaemonService_StopContainer_FullMethodName                 = "/daemon.DaemonService/StopContainer"
+	DaemonService_PauseContainer_FullMethodName                = "/daemon.DaemonService/PauseContainer"
+	DaemonService_ResumeContainer_FullMethodName               = "/daemon.DaemonService/ResumeContainer"
+	DaemonService_SetContainerLogLevel_FullMethodName          = "/daemon.DaemonService/SetContainerLogLevel"
 	DaemonService_GetContainerLogs_FullMethodName              = "/daemon.DaemonService/GetContainerLogs"
 	DaemonService_HealthCheck_FullMethodName                   = "/daemon.DaemonService/HealthCheck"
 	DaemonService_GetAPIBudget_FullMethodName                  = "/daemon.DaemonService/GetAPIBudget"
@@ -95,6 +102,14 @@ const (
 	DaemonService_ListDepots_FullMethodName                    = "/daemon.DaemonService/ListDepots"
 	DaemonService_StartDepot_FullMethodName                    = "/daemon.DaemonService/StartDepot"
 	DaemonService_StopDepot_FullMethodName                     = "/daemon.DaemonService/StopDepot"
+	DaemonService_RegisterAgent_FullMethodName                 = "/daemon.DaemonService/RegisterAgent"
+	DaemonService_ExportMarketData_FullMethodName              = "/daemon.DaemonService/ExportMarketData"
+	DaemonService_GetSupplyTransitionStats_FullMethodName      = "/daemon.DaemonService/GetSupplyTransitionStats"
+	DaemonService_GetSystemOverview_FullMethodName             = "/daemon.DaemonService/GetSystemOverview"
+	DaemonService_WarmSystem_FullMethodName                    = "/daemon.DaemonService/WarmSystem"
+	DaemonService_AddScheduledJob_FullMethodName               = "/daemon.DaemonService/AddScheduledJob"
+	DaemonService_ListScheduledJobs_FullMethodName             = "/daemon.DaemonService/ListScheduledJobs"
+	DaemonService_RemoveScheduledJob_FullMethodName            = "/daemon.DaemonService/RemoveScheduledJob"
 )
 
 // DaemonServiceClient is the client API for DaemonService service.
@@ -159,6 +174,15 @@ type DaemonServiceClient interface {
 	// reconciler that drives a cold agent through the cold-start arc to the jump gate. Slice 1
 	// ships the DATA phase (probes → 3, scout every market). LIVE BY DEFAULT once launched.
 	BootstrapCoordinator(ctx context.Context, in *BootstrapCoordinatorRequest, opts ...grpc.CallOption) (*BootstrapCoordinatorResponse, error)
+	// BootstrapFleet applies a fleet template (configs/fleet-templates): buys the profile's
+	// starter ships and launches its standing containers in a one-shot container. A dry run
+	// returns the plan instead and spends nothing.
+	BootstrapFleet(ctx context.Context, in *BootstrapFleetRequest, opts ...grpc.CallOption) (*BootstrapFleetResponse, error)
+	// LaunchOperationProfile launches one operation from a versioned YAML parameter set
+	// (configs/operation-profiles), checked against the operation's params before anything
+	// starts and stamped with the profile revision on every container. A dry run returns the
+	// planned launches instead.
+	LaunchOperationProfile(ctx context.Context, in *LaunchOperationProfileRequest, opts ...grpc.CallOption) (*LaunchOperationProfileResponse, error)
 	// CapacityReconcilerCoordinator starts the standing capacity reconciler (st-7zk): drives
 	// the contract-delivery machine's actual topology toward the computed desired topology
 	// (SENSE → PLAN → DIFF → GOVERN → CONVERGE), capex-paced, captain/DISABLED-gated every
@@ -177,6 +201,12 @@ type DaemonServiceClient interface {
 	// shipyard systems (the blind spots), deeper-first, bounded by a per-cycle dispatch cap and
 	// idle probe supply. EXPLICIT START ONLY — never boot-standing-armed (deploy-inert).
 	ShipyardBackfillCoordinator(ctx context.Context, in *ShipyardBackfillCoordinatorRequest, opts ...grpc.CallOption) (*ShipyardBackfillCoordinatorResponse, error)
+	// ProbeParkingCoordinator starts the standing probe-parking coordinator: each tick it parks
+	// idle probes at the highest-value unwatched markets, one per market, docked and re-scanning.
+	ProbeParkingCoordinator(ctx context.Context, in *ProbeParkingCoordinatorRequest, opts ...grpc.CallOption) (*ProbeParkingCoordinatorResponse, error)
+	// TankerCoordinator starts the standing tanker coordinator: tankers load FUEL at a market,
+	// hold station at the mining/siphon sites and refuel the drones there from their holds.
+	TankerCoordinator(ctx context.Context, in *TankerCoordinatorRequest, opts ...grpc.CallOption) (*TankerCoordinatorResponse, error)
 	// WorkerRebalancerCoordinator starts the standing worker-rebalancer coordinator (sp-f5pr):
 	// ferries idle light-haulers cross-system to worker-starved factory systems. All tuning
 	// lives in config.yaml [worker_rebalancer]; this request names the player/agent + dry_run.
@@ -193,6 +223,13 @@ type DaemonServiceClient interface {
 	GetContainer(ctx context.Context, in *GetContainerRequest, opts ...grpc.CallOption) (*GetContainerResponse, error)
 	// StopContainer stops a running background container
 	StopContainer(ctx context.Context, in *StopContainerRequest, opts ...grpc.CallOption) (*StopContainerResponse, error)
+	// PauseContainer holds a running container and its children: the iteration in
+	// flight finishes, ships stay assigned, and no new API calls are issued
+	PauseContainer(ctx context.Context, in *PauseContainerRequest, opts ...grpc.CallOption) (*PauseContainerResponse, error)
+	// ResumeContainer releases a paused container and its children
+	ResumeContainer(ctx context.Context, in *ResumeContainerRequest, opts ...grpc.CallOption) (*ResumeContainerResponse, error)
+	// SetContainerLogLevel changes a running container's log level
+	SetContainerLogLevel(ctx context.Context, in *SetContainerLogLevelRequest, opts ...grpc.CallOption) (*SetContainerLogLevelResponse, error)
 	// GetContainerLogs retrieves logs from a container
 	GetContainerLogs(ctx context.Context, in *GetContainerLogsRequest, opts ...grpc.CallOption) (*GetContainerLogsResponse, error)
 	// HealthCheck verifies daemon is running and responsive
@@ -319,6 +356,29 @@ type DaemonServiceClient interface {
 	// never double-launches). StopDepot tears down that depot's running coordinators.
 	StartDepot(ctx context.Context, in *StartDepotRequest, opts ...grpc.CallOption) (*StartDepotResponse, error)
 	StopDepot(ctx context.Context, in *StopDepotRequest, opts ...grpc.CallOption) (*StopDepotResponse, error)
+	// RegisterAgent registers a new agent (or adopts an existing agent token), stores the
+	// player and syncs its credits and ships so it is operable immediately, with no restart.
+	RegisterAgent(ctx context.Context, in *RegisterAgentRequest, opts ...grpc.CallOption) (*RegisterAgentResponse, error)
+	// ExportMarketData renders a system's cached markets, plus an optional trailing
+	// price-history window, as a CSV or JSON document for offline analysis.
+	ExportMarketData(ctx context.Context, in *ExportMarketDataRequest, opts ...grpc.CallOption) (*ExportMarketDataResponse, error)
+	// GetSupplyTransitionStats reports how often each (market, good) changed supply
+	// level over a trailing window and how long it dwelt at each level.
+	GetSupplyTransitionStats(ctx context.Context, in *GetSupplyTransitionStatsRequest, opts ...grpc.CallOption) (*GetSupplyTransitionStatsResponse, error)
+	// GetSystemOverview reports everything the fleet has going on in one system:
+	// ships there and their tasks, containers working it, market freshness, open
+	// contract deliveries bound for it and its best arbitrage lanes.
+	GetSystemOverview(ctx context.Context, in *GetSystemOverviewRequest, opts ...grpc.CallOption) (*GetSystemOverviewResponse, error)
+	// WarmSystem launches a background container that pre-fetches a system's
+	// waypoints, markets and shipyards, persisting its progress in the container config.
+	WarmSystem(ctx context.Context, in *WarmSystemRequest, opts ...grpc.CallOption) (*WarmSystemResponse, error)
+	// AddScheduledJob defines a recurring job (scout tour, ledger reconciliation,
+	// market export) or redefines the one with the same name, keeping its history.
+	AddScheduledJob(ctx context.Context, in *AddScheduledJobRequest, opts ...grpc.CallOption) (*AddScheduledJobResponse, error)
+	// ListScheduledJobs lists the recurring jobs with their last and next runs.
+	ListScheduledJobs(ctx context.Context, in *ListScheduledJobsRequest, opts ...grpc.CallOption) (*ListScheduledJobsResponse, error)
+	// RemoveScheduledJob deletes a recurring job.
+	RemoveScheduledJob(ctx context.Context, in *RemoveScheduledJobRequest, opts ...grpc.CallOption) (*RemoveScheduledJobResponse, error)
 }
 
 type daemonServiceClient struct {
@@ -519,6 +579,26 @@ func (c *daemonServiceClient) BootstrapCoordinator(ctx context.Context, in *Boot
 	return out, nil
 }
 
+func (c *daemonServiceClient) BootstrapFleet(ctx context.Context, in *BootstrapFleetRequest, opts ...grpc.CallOption) (*BootstrapFleetResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BootstrapFleetResponse)
+	err := c.cc.Invoke(ctx, DaemonService_BootstrapFleet_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *daemonServiceClient) LaunchOperationProfile(ctx context.Context, in *LaunchOperationProfileRequest, opts ...grpc.CallOption) (*LaunchOperationProfileResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(LaunchOperationProfileResponse)
+	err := c.cc.Invoke(ctx, DaemonService_LaunchOperationProfile_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *daemonServiceClient) CapacityReconcilerCoordinator(ctx context.Context, in *CapacityReconcilerCoordinatorRequest, opts ...grpc.CallOption) (*CapacityReconcilerCoordinatorResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(CapacityReconcilerCoordinatorResponse)
@@ -559,6 +639,26 @@ func (c *daemonServiceClient) ShipyardBackfillCoordinator(ctx context.Context, i
 	return out, nil
 }
 
+func (c *daemonServiceClient) ProbeParkingCoordinator(ctx context.Context, in *ProbeParkingCoordinatorRequest, opts ...grpc.CallOption) (*ProbeParkingCoordinatorResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ProbeParkingCoordinatorResponse)
+	err := c.cc.Invoke(ctx, DaemonService_ProbeParkingCoordinator_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *daemonServiceClient) TankerCoordinator(ctx context.Context, in *TankerCoordinatorRequest, opts ...grpc.CallOption) (*TankerCoordinatorResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TankerCoordinatorResponse)
+	err := c.cc.Invoke(ctx, DaemonService_TankerCoordinator_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *daemonServiceClient) WorkerRebalancerCoordinator(ctx context.Context, in *WorkerRebalancerCoordinatorRequest, opts ...grpc.CallOption) (*WorkerRebalancerCoordinatorResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(WorkerRebalancerCoordinatorResponse)
@@ -629,6 +729,36 @@ func (c *daemonServiceClient) StopContainer(ctx context.Context, in *StopContain
 	return out, nil
 }
 
+func (c *daemonServiceClient) PauseContainer(ctx context.Context, in *PauseContainerRequest, opts ...grpc.CallOption) (*PauseContainerResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PauseContainerResponse)
+	err := c.cc.Invoke(ctx, DaemonService_PauseContainer_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *daemonServiceClient) ResumeContainer(ctx context.Context, in *ResumeContainerRequest, opts ...grpc.CallOption) (*ResumeContainerResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ResumeContainerResponse)
+	err := c.cc.Invoke(ctx, DaemonService_ResumeContainer_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *daemonServiceClient) SetContainerLogLevel(ctx context.Context, in *SetContainerLogLevelRequest, opts ...grpc.CallOption) (*SetContainerLogLevelResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetContainerLogLevelResponse)
+	err := c.cc.Invoke(ctx, DaemonService_SetContainerLogLevel_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *daemonServiceClient) GetContainerLogs(ctx context.Context, in *GetContainerLogsRequest, opts ...grpc.CallOption) (*GetContainerLogsResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(GetContainerLogsResponse)
@@ -1089,6 +1219,86 @@ func (c *daemonServiceClient) StopDepot(ctx context.Context, in *StopDepotReques
 	return out, nil
 }
 
+func (c *daemonServiceClient) RegisterAgent(ctx context.Context, in *RegisterAgentRequest, opts ...grpc.CallOption) (*RegisterAgentResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RegisterAgentResponse)
+	err := c.cc.Invoke(ctx, DaemonService_RegisterAgent_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *daemonServiceClient) ExportMarketData(ctx context.Context, in *ExportMarketDataRequest, opts ...grpc.CallOption) (*ExportMarketDataResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ExportMarketDataResponse)
+	err := c.cc.Invoke(ctx, DaemonService_ExportMarketData_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *daemonServiceClient) GetSupplyTransitionStats(ctx context.Context, in *GetSupplyTransitionStatsRequest, opts ...grpc.CallOption) (*GetSupplyTransitionStatsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetSupplyTransitionStatsResponse)
+	err := c.cc.Invoke(ctx, DaemonService_GetSupplyTransitionStats_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *daemonServiceClient) GetSystemOverview(ctx context.Context, in *GetSystemOverviewRequest, opts ...grpc.CallOption) (*GetSystemOverviewResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetSystemOverviewResponse)
+	err := c.cc.Invoke(ctx, DaemonService_GetSystemOverview_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *daemonServiceClient) WarmSystem(ctx context.Context, in *WarmSystemRequest, opts ...grpc.CallOption) (*WarmSystemResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(WarmSystemResponse)
+	err := c.cc.Invoke(ctx, DaemonService_WarmSystem_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *daemonServiceClient) AddScheduledJob(ctx context.Context, in *AddScheduledJobRequest, opts ...grpc.CallOption) (*AddScheduledJobResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AddScheduledJobResponse)
+	err := c.cc.Invoke(ctx, DaemonService_AddScheduledJob_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *daemonServiceClient) ListScheduledJobs(ctx context.Context, in *ListScheduledJobsRequest, opts ...grpc.CallOption) (*ListScheduledJobsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListScheduledJobsResponse)
+	err := c.cc.Invoke(ctx, DaemonService_ListScheduledJobs_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *daemonServiceClient) RemoveScheduledJob(ctx context.Context, in *RemoveScheduledJobRequest, opts ...grpc.CallOption) (*RemoveScheduledJobResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RemoveScheduledJobResponse)
+	err := c.cc.Invoke(ctx, DaemonService_RemoveScheduledJob_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // DaemonServiceServer is the server API for DaemonService service.
 // All implementations must embed UnimplementedDaemonServiceServer
 // for forward compatibility.
@@ -1151,6 +1361,15 @@ type DaemonServiceServer interface {
 	// reconciler that drives a cold agent through the cold-start arc to the jump gate. Slice 1
 	// ships the DATA phase (probes → 3, scout every market). LIVE BY DEFAULT once launched.
 	BootstrapCoordinator(context.Context, *BootstrapCoordinatorRequest) (*BootstrapCoordinatorResponse, error)
+	// BootstrapFleet applies a fleet template (configs/fleet-templates): buys the profile's
+	// starter ships and launches its standing containers in a one-shot container. A dry run
+	// returns the plan instead and spends nothing.
+	BootstrapFleet(context.Context, *BootstrapFleetRequest) (*BootstrapFleetResponse, error)
+	// LaunchOperationProfile launches one operation from a versioned YAML parameter set
+	// (configs/operation-profiles), checked against the operation's params before anything
+	// starts and stamped with the profile revision on every container. A dry run returns the
+	// planned launches instead.
+	LaunchOperationProfile(context.Context, *LaunchOperationProfileRequest) (*LaunchOperationProfileResponse, error)
 	// CapacityReconcilerCoordinator starts the standing capacity reconciler (st-7zk): drives
 	// the contract-delivery machine's actual topology toward the computed desired topology
 	// (SENSE → PLAN → DIFF → GOVERN → CONVERGE), capex-paced, captain/DISABLED-gated every
@@ -1169,6 +1388,12 @@ type DaemonServiceServer interface {
 	// shipyard systems (the blind spots), deeper-first, bounded by a per-cycle dispatch cap and
 	// idle probe supply. EXPLICIT START ONLY — never boot-standing-armed (deploy-inert).
 	ShipyardBackfillCoordinator(context.Context, *ShipyardBackfillCoordinatorRequest) (*ShipyardBackfillCoordinatorResponse, error)
+	// ProbeParkingCoordinator starts the standing probe-parking coordinator: each tick it parks
+	// idle probes at the highest-value unwatched markets, one per market, docked and re-scanning.
+	ProbeParkingCoordinator(context.Context, *ProbeParkingCoordinatorRequest) (*ProbeParkingCoordinatorResponse, error)
+	// TankerCoordinator starts the standing tanker coordinator: tankers load FUEL at a market,
+	// hold station at the mining/siphon sites and refuel the drones there from their holds.
+	TankerCoordinator(context.Context, *TankerCoordinatorRequest) (*TankerCoordinatorResponse, error)
 	// WorkerRebalancerCoordinator starts the standing worker-rebalancer coordinator (sp-f5pr):
 	// ferries idle light-haulers cross-system to worker-starved factory systems. All tuning
 	// lives in config.yaml [worker_rebalancer]; this request names the player/agent + dry_run.
@@ -1185,6 +1410,13 @@ type DaemonServiceServer interface {
 	GetContainer(context.Context, *GetContainerRequest) (*GetContainerResponse, error)
 	// StopContainer stops a running background container
 	StopContainer(context.Context, *StopContainerRequest) (*StopContainerResponse, error)
+	// PauseContainer holds a running container and its children: the iteration in
+	// flight finishes, ships stay assigned, and no new API calls are issued
+	PauseContainer(context.Context, *PauseContainerRequest) (*PauseContainerResponse, error)
+	// ResumeContainer releases a paused container and its children
+	ResumeContainer(context.Context, *ResumeContainerRequest) (*ResumeContainerResponse, error)
+	// SetContainerLogLevel changes a running container's log level
+	SetContainerLogLevel(context.Context, *SetContainerLogLevelRequest) (*SetContainerLogLevelResponse, error)
 	// GetContainerLogs retrieves logs from a container
 	GetContainerLogs(context.Context, *GetContainerLogsRequest) (*GetContainerLogsResponse, error)
 	// HealthCheck verifies daemon is running and responsive
@@ -1311,6 +1543,29 @@ type DaemonServiceServer interface {
 	// never double-launches). StopDepot tears down that depot's running coordinators.
 	StartDepot(context.Context, *StartDepotRequest) (*StartDepotResponse, error)
 	StopDepot(context.Context, *StopDepotRequest) (*StopDepotResponse, error)
+	// RegisterAgent registers a new agent (or adopts an existing agent token), stores the
+	// player and syncs its credits and ships so it is operable immediately, with no restart.
+	RegisterAgent(context.Context, *RegisterAgentRequest) (*RegisterAgentResponse, error)
+	// ExportMarketData renders a system's cached markets, plus an optional trailing
+	// price-history window, as a CSV or JSON document for offline analysis.
+	ExportMarketData(context.Context, *ExportMarketDataRequest) (*ExportMarketDataResponse, error)
+	// GetSupplyTransitionStats reports how often each (market, good) changed supply
+	// level over a trailing window and how long it dwelt at each level.
+	GetSupplyTransitionStats(context.Context, *GetSupplyTransitionStatsRequest) (*GetSupplyTransitionStatsResponse, error)
+	// GetSystemOverview reports everything the fleet has going on in one system:
+	// ships there and their tasks, containers working it, market freshness, open
+	// contract deliveries bound for it and its best arbitrage lanes.
+	GetSystemOverview(context.Context, *GetSystemOverviewRequest) (*GetSystemOverviewResponse, error)
+	// WarmSystem launches a background container that pre-fetches a system's
+	// waypoints, markets and shipyards, persisting its progress in the container config.
+	WarmSystem(context.Context, *WarmSystemRequest) (*WarmSystemResponse, error)
+	// AddScheduledJob defines a recurring job (scout tour, ledger reconciliation,
+	// market export) or redefines the one with the same name, keeping its history.
+	AddScheduledJob(context.Context, *AddScheduledJobRequest) (*AddScheduledJobResponse, error)
+	// ListScheduledJobs lists the recurring jobs with their last and next runs.
+	ListScheduledJobs(context.Context, *ListScheduledJobsRequest) (*ListScheduledJobsResponse, error)
+	// RemoveScheduledJob deletes a recurring job.
+	RemoveScheduledJob(context.Context, *RemoveScheduledJobRequest) (*RemoveScheduledJobResponse, error)
 	mustEmbedUnimplementedDaemonServiceServer()
 }
 
@@ -1378,6 +1633,12 @@ func (UnimplementedDaemonServiceServer) FleetAutosizerCoordinator(context.Contex
 func (UnimplementedDaemonServiceServer) BootstrapCoordinator(context.Context, *BootstrapCoordinatorRequest) (*BootstrapCoordinatorResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method BootstrapCoordinator not implemented")
 }
+func (UnimplementedDaemonServiceServer) BootstrapFleet(context.Context, *BootstrapFleetRequest) (*BootstrapFleetResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method BootstrapFleet not implemented")
+}
+func (UnimplementedDaemonServiceServer) LaunchOperationProfile(context.Context, *LaunchOperationProfileRequest) (*LaunchOperationProfileResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method LaunchOperationProfile not implemented")
+}
 func (UnimplementedDaemonServiceServer) CapacityReconcilerCoordinator(context.Context, *CapacityReconcilerCoordinatorRequest) (*CapacityReconcilerCoordinatorResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method CapacityReconcilerCoordinator not implemented")
 }
@@ -1390,6 +1651,12 @@ func (UnimplementedDaemonServiceServer) FrontierExpansionCoordinator(context.Con
 func (UnimplementedDaemonServiceServer) ShipyardBackfillCoordinator(context.Context, *ShipyardBackfillCoordinatorRequest) (*ShipyardBackfillCoordinatorResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method ShipyardBackfillCoordinator not implemented")
 }
+func (UnimplementedDaemonServiceServer) ProbeParkingCoordinator(context.Context, *ProbeParkingCoordinatorRequest) (*ProbeParkingCoordinatorResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ProbeParkingCoordinator not implemented")
+}
+func (UnimplementedDaemonServiceServer) TankerCoordinator(context.Context, *TankerCoordinatorRequest) (*TankerCoordinatorResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method TankerCoordinator not implemented")
+}
 func (UnimplementedDaemonServiceServer) WorkerRebalancerCoordinator(context.Context, *WorkerRebalancerCoordinatorRequest) (*WorkerRebalancerCoordinatorResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method WorkerRebalancerCoordinator not implemented")
 }
@@ -1411,6 +1678,15 @@ func (UnimplementedDaemonServiceServer) GetContainer(context.Context, *GetContai
 func (UnimplementedDaemonServiceServer) StopContainer(context.Context, *StopContainerRequest) (*StopContainerResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method StopContainer not implemented")
 }
+func (UnimplementedDaemonServiceServer) PauseContainer(context.Context, *PauseContainerRequest) (*PauseContainerResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method PauseContainer not implemented")
+}
+func (UnimplementedDaemonServiceServer) ResumeContainer(context.Context, *ResumeContainerRequest) (*ResumeContainerResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ResumeContainer not implemented")
+}
+func (UnimplementedDaemonServiceServer) SetContainerLogLevel(context.Context, *SetContainerLogLevelRequest) (*SetContainerLogLevelResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method SetContainerLogLevel not implemented")
+}
 func (UnimplementedDaemonServiceServer) GetContainerLogs(context.Context, *GetContainerLogsRequest) (*GetContainerLogsResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method GetContainerLogs not implemented")
 }
@@ -1549,6 +1825,30 @@ func (UnimplementedDaemonServiceServer) StartDepot(context.Context, *StartDepotR
 func (UnimplementedDaemonServiceServer) StopDepot(context.Context, *StopDepotRequest) (*StopDepotResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method StopDepot not implemented")
 }
+func (UnimplementedDaemonServiceServer) RegisterAgent(context.Context, *RegisterAgentRequest) (*RegisterAgentResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method RegisterAgent not implemented")
+}
+func (UnimplementedDaemonServiceServer) ExportMarketData(context.Context, *ExportMarketDataRequest) (*ExportMarketDataResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ExportMarketData not implemented")
+}
+func (UnimplementedDaemonServiceServer) GetSupplyTransitionStats(context.Context, *GetSupplyTransitionStatsRequest) (*GetSupplyTransitionStatsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetSupplyTransitionStats not implemented")
+}
+func (UnimplementedDaemonServiceServer) GetSystemOverview(context.Context, *GetSystemOverviewRequest) (*GetSystemOverviewResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetSystemOverview not implemented")
+}
+func (UnimplementedDaemonServiceServer) WarmSystem(context.Context, *WarmSystemRequest) (*WarmSystemResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method WarmSystem not implemented")
+}
+func (UnimplementedDaemonServiceServer) AddScheduledJob(context.Context, *AddScheduledJobRequest) (*AddScheduledJobResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method AddScheduledJob not implemented")
+}
+func (UnimplementedDaemonServiceServer) ListScheduledJobs(context.Context, *ListScheduledJobsRequest) (*ListScheduledJobsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListScheduledJobs not implemented")
+}
+func (UnimplementedDaemonServiceServer) RemoveScheduledJob(context.Context, *RemoveScheduledJobRequest) (*RemoveScheduledJobResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method RemoveScheduledJob not implemented")
+}
 func (UnimplementedDaemonServiceServer) mustEmbedUnimplementedDaemonServiceServer() {}
 func (UnimplementedDaemonServiceServer) testEmbeddedByValue()                       {}
 
@@ -1912,6 +2212,42 @@ func _DaemonService_BootstrapCoordinator_Handler(srv interface{}, ctx context.Co
 	return interceptor(ctx, in, info, handler)
 }
 
+func _DaemonService_BootstrapFleet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BootstrapFleetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DaemonServiceServer).BootstrapFleet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DaemonService_BootstrapFleet_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DaemonServiceServer).BootstrapFleet(ctx, req.(*BootstrapFleetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DaemonService_LaunchOperationProfile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LaunchOperationProfileRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DaemonServiceServer).LaunchOperationProfile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DaemonService_LaunchOperationProfile_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DaemonServiceServer).LaunchOperationProfile(ctx, req.(*LaunchOperationProfileRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _DaemonService_CapacityReconcilerCoordinator_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(CapacityReconcilerCoordinatorRequest)
 	if err := dec(in); err != nil {
@@ -1984,6 +2320,42 @@ func _DaemonService_ShipyardBackfillCoordinator_Handler(srv interface{}, ctx con
 	return interceptor(ctx, in, info, handler)
 }
 
+func _DaemonService_ProbeParkingCoordinator_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ProbeParkingCoordinatorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DaemonServiceServer).ProbeParkingCoordinator(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DaemonService_ProbeParkingCoordinator_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DaemonServiceServer).ProbeParkingCoordinator(ctx, req.(*ProbeParkingCoordinatorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DaemonService_TankerCoordinator_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TankerCoordinatorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DaemonServiceServer).TankerCoordinator(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DaemonService_TankerCoordinator_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DaemonServiceServer).TankerCoordinator(ctx, req.(*TankerCoordinatorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _DaemonService_WorkerRebalancerCoordinator_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(WorkerRebalancerCoordinatorRequest)
 	if err := dec(in); err != nil {
@@ -2110,6 +2482,60 @@ func _DaemonService_StopContainer_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _DaemonService_PauseContainer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PauseContainerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DaemonServiceServer).PauseContainer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DaemonService_PauseContainer_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DaemonServiceServer).PauseContainer(ctx, req.(*PauseContainerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DaemonService_ResumeContainer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResumeContainerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DaemonServiceServer).ResumeContainer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DaemonService_ResumeContainer_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DaemonServiceServer).ResumeContainer(ctx, req.(*ResumeContainerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DaemonService_SetContainerLogLevel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetContainerLogLevelRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DaemonServiceServer).SetContainerLogLevel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DaemonService_SetContainerLogLevel_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DaemonServiceServer).SetContainerLogLevel(ctx, req.(*SetContainerLogLevelRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _DaemonService_GetContainerLogs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetContainerLogsRequest)
 	if err := dec(in); err != nil {
@@ -2938,6 +3364,150 @@ func _DaemonService_StopDepot_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _DaemonService_RegisterAgent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RegisterAgentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DaemonServiceServer).RegisterAgent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DaemonService_RegisterAgent_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DaemonServiceServer).RegisterAgent(ctx, req.(*RegisterAgentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DaemonService_ExportMarketData_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExportMarketDataRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DaemonServiceServer).ExportMarketData(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DaemonService_ExportMarketData_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DaemonServiceServer).ExportMarketData(ctx, req.(*ExportMarketDataRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DaemonService_GetSupplyTransitionStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSupplyTransitionStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DaemonServiceServer).GetSupplyTransitionStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DaemonService_GetSupplyTransitionStats_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DaemonServiceServer).GetSupplyTransitionStats(ctx, req.(*GetSupplyTransitionStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DaemonService_GetSystemOverview_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSystemOverviewRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DaemonServiceServer).GetSystemOverview(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DaemonService_GetSystemOverview_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DaemonServiceServer).GetSystemOverview(ctx, req.(*GetSystemOverviewRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DaemonService_WarmSystem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WarmSystemRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DaemonServiceServer).WarmSystem(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DaemonService_WarmSystem_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DaemonServiceServer).WarmSystem(ctx, req.(*WarmSystemRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DaemonService_AddScheduledJob_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddScheduledJobRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DaemonServiceServer).AddScheduledJob(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DaemonService_AddScheduledJob_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DaemonServiceServer).AddScheduledJob(ctx, req.(*AddScheduledJobRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DaemonService_ListScheduledJobs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListScheduledJobsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DaemonServiceServer).ListScheduledJobs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DaemonService_ListScheduledJobs_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DaemonServiceServer).ListScheduledJobs(ctx, req.(*ListScheduledJobsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DaemonService_RemoveScheduledJob_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveScheduledJobRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DaemonServiceServer).RemoveScheduledJob(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DaemonService_RemoveScheduledJob_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DaemonServiceServer).RemoveScheduledJob(ctx, req.(*RemoveScheduledJobRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // DaemonService_ServiceDesc is the grpc.ServiceDesc for DaemonService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -3021,6 +3591,14 @@ var DaemonService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "BootstrapCoordinator",
 			Handler:    _DaemonService_BootstrapCoordinator_Handler,
 		},
+		{
+			MethodName: "BootstrapFleet",
+			Handler:    _DaemonService_BootstrapFleet_Handler,
+		},
+		{
+			MethodName: "LaunchOperationProfile",
+			Handler:    _DaemonService_LaunchOperationProfile_Handler,
+		},
 		{
 			MethodName: "CapacityReconcilerCoordinator",
 			Handler:    _DaemonService_CapacityReconcilerCoordinator_Handler,
@@ -3037,6 +3615,14 @@ var DaemonService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ShipyardBackfillCoordinator",
 			Handler:    _DaemonService_ShipyardBackfillCoordinator_Handler,
 		},
+		{
+			MethodName: "ProbeParkingCoordinator",
+			Handler:    _DaemonService_ProbeParkingCoordinator_Handler,
+		},
+		{
+			MethodName: "TankerCoordinator",
+			Handler:    _DaemonService_TankerCoordinator_Handler,
+		},
 		{
 			MethodName: "WorkerRebalancerCoordinator",
 			Handler:    _DaemonService_WorkerRebalancerCoordinator_Handler,
@@ -3065,6 +3651,18 @@ var DaemonService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "StopContainer",
 			Handler:    _DaemonService_StopContainer_Handler,
 		},
+		{
+			MethodName: "PauseContainer",
+			Handler:    _DaemonService_PauseContainer_Handler,
+		},
+		{
+			MethodName: "ResumeContainer",
+			Handler:    _DaemonService_ResumeContainer_Handler,
+		},
+		{
+			MethodName: "SetContainerLogLevel",
+			Handler:    _DaemonService_SetContainerLogLevel_Handler,
+		},
 		{
 			MethodName: "GetContainerLogs",
 			Handler:    _DaemonService_GetContainerLogs_Handler,
@@ -3249,6 +3847,38 @@ var DaemonService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "StopDepot",
 			Handler:    _DaemonService_StopDepot_Handler,
 		},
+		{
+			MethodName: "RegisterAgent",
+			Handler:    _DaemonService_RegisterAgent_Handler,
+		},
+		{
+			MethodName: "ExportMarketData",
+			Handler:    _DaemonService_ExportMarketData_Handler,
+		},
+		{
+			MethodName: "GetSupplyTransitionStats",
+			Handler:    _DaemonService_GetSupplyTransitionStats_Handler,
+		},
+		{
+			MethodName: "GetSystemOverview",
+			Handler:    _DaemonService_GetSystemOverview_Handler,
+		},
+		{
+			MethodName: "WarmSystem",
+			Handler:    _DaemonService_WarmSystem_Handler,
+		},
+		{
+			MethodName: "AddScheduledJob",
+			Handler:    _DaemonService_AddScheduledJob_Handler,
+		},
+		{
+			MethodName: "ListScheduledJobs",
+			Handler:    _DaemonService_ListScheduledJobs_Handler,
+		},
+		{
+			MethodName: "RemoveScheduledJob",
+			Handler:    _DaemonService_RemoveScheduledJob_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "pkg/proto/daemon/daemon.proto",
@@ -94,7 +94,10 @@ type PlanRouteRequest struct {
 	// Optional: Prefer CRUISE mode over BURN
 	// When true, routes will use CRUISE instead of BURN for fuel efficiency
 	// DRIFT penalty still applies (use fuel_efficient to remove DRIFT penalty)
-	PreferCruise  bool `protobuf:"varint,9,opt,name=prefer_cruise,json=preferCruise,proto3" json:"prefer_cruise,omitempty"`
+	PreferCruise bool `protobuf:"varint,9,opt,name=prefer_cruise,json=preferCruise,proto3" json:"prefer_cruise,omitempty"`
+	// Optional: weights the planner minimizes instead of travel time alone.
+	// Unset (or all zero) plans the fastest route.
+	Objective     *RouteObjective `protobuf:"bytes,10,opt,name=objective,proto3" json:"objective,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -192,6 +195,75 @@ func (x *PlanRouteRequest) GetPreferCruise() bool {
 	return false
 }
 
+func (x *PlanRouteRequest) GetObjective() *RouteObjective {
+	if x != nil {
+		return x.Objective
+	}
+	return nil
+}
+
+// RouteObjective weighs travel time against fuel and refuel stops. The planner
+// minimizes time_weight*seconds + fuel_weight*fuel + refuel_stop_weight*stops.
+type RouteObjective struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	TimeWeight       float64                `protobuf:"fixed64,1,opt,name=time_weight,json=timeWeight,proto3" json:"time_weight,omitempty"`
+	FuelWeight       float64                `protobuf:"fixed64,2,opt,name=fuel_weight,json=fuelWeight,proto3" json:"fuel_weight,omitempty"`
+	RefuelStopWeight float64                `protobuf:"fixed64,3,opt,name=refuel_stop_weight,json=refuelStopWeight,proto3" json:"refuel_stop_weight,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *RouteObjective) Reset() {
+	*x = RouteObjective{}
+	mi := &file_pkg_proto_routing_routing_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RouteObjective) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RouteObjective) ProtoMessage() {}
+
+func (x *RouteObjective) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_routing_routing_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RouteObjective.ProtoReflect.Descriptor instead.
+func (*RouteObjective) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_routing_routing_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *RouteObjective) GetTimeWeight() float64 {
+	if x != nil {
+		return x.TimeWeight
+	}
+	return 0
+}
+
+func (x *RouteObjective) GetFuelWeight() float64 {
+	if x != nil {
+		return x.FuelWeight
+	}
+	return 0
+}
+
+func (x *RouteObjective) GetRefuelStopWeight() float64 {
+	if x != nil {
+		return x.RefuelStopWeight
+	}
+	return 0
+}
+
 type Waypoint struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// Waypoint symbol
@@ -210,7 +282,7 @@ type Waypoint struct {
 
 func (x *Waypoint) Reset() {
 	*x = Waypoint{}
-	mi := &file_pkg_proto_routing_routing_proto_msgTypes[1]
+	mi := &file_pkg_proto_routing_routing_proto_msgTypes[2]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -222,7 +294,7 @@ func (x *Waypoint) String() string {
 func (*Waypoint) ProtoMessage() {}
 
 func (x *Waypoint) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_proto_routing_routing_proto_msgTypes[1]
+	mi := &file_pkg_proto_routing_routing_proto_msgTypes[2]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -235,7 +307,7 @@ func (x *Waypoint) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Waypoint.ProtoReflect.Descriptor instead.
 func (*Waypoint) Descriptor() ([]byte, []int) {
-	return file_pkg_proto_routing_routing_proto_rawDescGZIP(), []int{1}
+	return file_pkg_proto_routing_routing_proto_rawDescGZIP(), []int{2}
 }
 
 func (x *Waypoint) GetSymbol() string {
@@ -293,7 +365,7 @@ type PlanRouteResponse struct {
 
 func (x *PlanRouteResponse) Reset() {
 	*x = PlanRouteResponse{}
-	mi := &file_pkg_proto_routing_routing_proto_msgTypes[2]
+	mi := &file_pkg_proto_routing_routing_proto_msgTypes[3]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -305,7 +377,7 @@ func (x *PlanRouteResponse) String() string {
 func (*PlanRouteResponse) ProtoMessage() {}
 
 func (x *PlanRouteResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_proto_routing_routing_proto_msgTypes[2]
+	mi := &file_pkg_proto_routing_routing_proto_msgTypes[3]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -318,7 +390,7 @@ func (x *PlanRouteResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PlanRouteResponse.ProtoReflect.Descriptor instead.
 func (*PlanRouteResponse) Descriptor() ([]byte, []int) {
-	return file_pkg_proto_routing_routing_proto_rawDescGZIP(), []int{2}
+	return file_pkg_proto_routing_routing_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *PlanRouteResponse) GetSteps() []*RouteStep {
@@ -385,7 +457,7 @@ type RouteStep struct {
 
 func (x *RouteStep) Reset() {
 	*x = RouteStep{}
-	mi := &file_pkg_proto_routing_routing_proto_msgTypes[3]
+	mi := &file_pkg_proto_routing_routing_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -397,7 +469,7 @@ func (x *RouteStep) String() string {
 func (*RouteStep) ProtoMessage() {}
 
 func (x *RouteStep) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_proto_routing_routing_proto_msgTypes[3]
+	mi := &file_pkg_proto_routing_routing_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -410,7 +482,7 @@ func (x *RouteStep) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RouteStep.ProtoReflect.Descriptor instead.
 func (*RouteStep) Descriptor() ([]byte, []int) {
-	return file_pkg_proto_routing_routing_proto_rawDescGZIP(), []int{3}
+	return file_pkg_proto_routing_routing_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *RouteStep) GetAction() RouteAction {
@@ -484,7 +556,7 @@ type OptimizeTourRequest struct {
 
 func (x *OptimizeTourRequest) Reset() {
 	*x = OptimizeTourRequest{}
-	mi := &file_pkg_proto_routing_routing_proto_msgTypes[4]
+	mi := &file_pkg_proto_routing_routing_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -496,7 +568,7 @@ func (x *OptimizeTourRequest) String() string {
 func (*OptimizeTourRequest) ProtoMessage() {}
 
 func (x *OptimizeTourRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_proto_routing_routing_proto_msgTypes[4]
+	mi := &file_pkg_proto_routing_routing_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -509,7 +581,7 @@ func (x *OptimizeTourRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use OptimizeTourRequest.ProtoReflect.Descriptor instead.
 func (*OptimizeTourRequest) Descriptor() ([]byte, []int) {
-	return file_pkg_proto_routing_routing_proto_rawDescGZIP(), []int{4}
+	return file_pkg_proto_routing_routing_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *OptimizeTourRequest) GetSystemSymbol() string {
@@ -574,7 +646,7 @@ type OptimizeTourResponse struct {
 
 func (x *OptimizeTourResponse) Reset() {
 	*x = OptimizeTourResponse{}
-	mi := &file_pkg_proto_routing_routing_proto_msgTypes[5]
+	mi := &file_pkg_proto_routing_routing_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -586,7 +658,7 @@ func (x *OptimizeTourResponse) String() string {
 func (*OptimizeTourResponse) ProtoMessage() {}
 
 func (x *OptimizeTourResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_proto_routing_routing_proto_msgTypes[5]
+	mi := &file_pkg_proto_routing_routing_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -599,7 +671,7 @@ func (x *OptimizeTourResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use OptimizeTourResponse.ProtoReflect.Descriptor instead.
 func (*OptimizeTourResponse) Descriptor() ([]byte, []int) {
-	return file_pkg_proto_routing_routing_proto_rawDescGZIP(), []int{5}
+	return file_pkg_proto_routing_routing_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *OptimizeTourResponse) GetVisitOrder() []string {
@@ -670,7 +742,7 @@ type OptimizeFueledTourRequest struct {
 
 func (x *OptimizeFueledTourRequest) Reset() {
 	*x = OptimizeFueledTourRequest{}
-	mi := &file_pkg_proto_routing_routing_proto_msgTypes[6]
+	mi := &file_pkg_proto_routing_routing_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -682,7 +754,7 @@ func (x *OptimizeFueledTourRequest) String() string {
 func (*OptimizeFueledTourRequest) ProtoMessage() {}
 
 func (x *OptimizeFueledTourRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_proto_routing_routing_proto_msgTypes[6]
+	mi := &file_pkg_proto_routing_routing_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -695,7 +767,7 @@ func (x *OptimizeFueledTourRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use OptimizeFueledTourRequest.ProtoReflect.Descriptor instead.
 func (*OptimizeFueledTourRequest) Descriptor() ([]byte, []int) {
-	return file_pkg_proto_routing_routing_proto_rawDescGZIP(), []int{6}
+	return file_pkg_proto_routing_routing_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *OptimizeFueledTourRequest) GetSystemSymbol() string {
@@ -776,7 +848,7 @@ type OptimizeFueledTourResponse struct {
 
 func (x *OptimizeFueledTourResponse) Reset() {
 	*x = OptimizeFueledTourResponse{}
-	mi := &file_pkg_proto_routing_routing_proto_msgTypes[7]
+	mi := &file_pkg_proto_routing_routing_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -788,7 +860,7 @@ func (x *OptimizeFueledTourResponse) String() string {
 func (*OptimizeFueledTourResponse) ProtoMessage() {}
 
 func (x *OptimizeFueledTourResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_proto_routing_routing_proto_msgTypes[7]
+	mi := &file_pkg_proto_routing_routing_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -801,7 +873,7 @@ func (x *OptimizeFueledTourResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use OptimizeFueledTourResponse.ProtoReflect.Descriptor instead.
 func (*OptimizeFueledTourResponse) Descriptor() ([]byte, []int) {
-	return file_pkg_proto_routing_routing_proto_rawDescGZIP(), []int{7}
+	return file_pkg_proto_routing_routing_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *OptimizeFueledTourResponse) GetVisitOrder() []string {
@@ -886,7 +958,7 @@ type TourLeg struct {
 
 func (x *TourLeg) Reset() {
 	*x = TourLeg{}
-	mi := &file_pkg_proto_routing_routing_proto_msgTypes[8]
+	mi := &file_pkg_proto_routing_routing_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -898,7 +970,7 @@ func (x *TourLeg) String() string {
 func (*TourLeg) ProtoMessage() {}
 
 func (x *TourLeg) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_proto_routing_routing_proto_msgTypes[8]
+	mi := &file_pkg_proto_routing_routing_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -911,7 +983,7 @@ func (x *TourLeg) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TourLeg.ProtoReflect.Descriptor instead.
 func (*TourLeg) Descriptor() ([]byte, []int) {
-	return file_pkg_proto_routing_routing_proto_rawDescGZIP(), []int{8}
+	return file_pkg_proto_routing_routing_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *TourLeg) GetFromWaypoint() string {
@@ -995,7 +1067,7 @@ type IntermediateStop struct {
 
 func (x *IntermediateStop) Reset() {
 	*x = IntermediateStop{}
-	mi := &file_pkg_proto_routing_routing_proto_msgTypes[9]
+	mi := &file_pkg_proto_routing_routing_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1007,7 +1079,7 @@ func (x *IntermediateStop) String() string {
 func (*IntermediateStop) ProtoMessage() {}
 
 func (x *IntermediateStop) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_proto_routing_routing_proto_msgTypes[9]
+	mi := &file_pkg_proto_routing_routing_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1020,7 +1092,7 @@ func (x *IntermediateStop) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use IntermediateStop.ProtoReflect.Descriptor instead.
 func (*IntermediateStop) Descriptor() ([]byte, []int) {
-	return file_pkg_proto_routing_routing_proto_rawDescGZIP(), []int{9}
+	return file_pkg_proto_routing_routing_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *IntermediateStop) GetWaypoint() string {
@@ -1079,7 +1151,7 @@ type PartitionFleetRequest struct {
 
 func (x *PartitionFleetRequest) Reset() {
 	*x = PartitionFleetRequest{}
-	mi := &file_pkg_proto_routing_routing_proto_msgTypes[10]
+	mi := &file_pkg_proto_routing_routing_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1091,7 +1163,7 @@ func (x *PartitionFleetRequest) String() string {
 func (*PartitionFleetRequest) ProtoMessage() {}
 
 func (x *PartitionFleetRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_proto_routing_routing_proto_msgTypes[10]
+	mi := &file_pkg_proto_routing_routing_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1104,7 +1176,7 @@ func (x *PartitionFleetRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PartitionFleetRequest.ProtoReflect.Descriptor instead.
 func (*PartitionFleetRequest) Descriptor() ([]byte, []int) {
-	return file_pkg_proto_routing_routing_proto_rawDescGZIP(), []int{10}
+	return file_pkg_proto_routing_routing_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *PartitionFleetRequest) GetSystemSymbol() string {
@@ -1165,7 +1237,7 @@ type ShipConfig struct {
 
 func (x *ShipConfig) Reset() {
 	*x = ShipConfig{}
-	mi := &file_pkg_proto_routing_routing_proto_msgTypes[11]
+	mi := &file_pkg_proto_routing_routing_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1177,7 +1249,7 @@ func (x *ShipConfig) String() string {
 func (*ShipConfig) ProtoMessage() {}
 
 func (x *ShipConfig) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_proto_routing_routing_proto_msgTypes[11]
+	mi := &file_pkg_proto_routing_routing_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1190,7 +1262,7 @@ func (x *ShipConfig) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ShipConfig.ProtoReflect.Descriptor instead.
 func (*ShipConfig) Descriptor() ([]byte, []int) {
-	return file_pkg_proto_routing_routing_proto_rawDescGZIP(), []int{11}
+	return file_pkg_proto_routing_routing_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *ShipConfig) GetCurrentLocation() string {
@@ -1238,7 +1310,7 @@ type PartitionFleetResponse struct {
 
 func (x *PartitionFleetResponse) Reset() {
 	*x = PartitionFleetResponse{}
-	mi := &file_pkg_proto_routing_routing_proto_msgTypes[12]
+	mi := &file_pkg_proto_routing_routing_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1250,7 +1322,7 @@ func (x *PartitionFleetResponse) String() string {
 func (*PartitionFleetResponse) ProtoMessage() {}
 
 func (x *PartitionFleetResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_proto_routing_routing_proto_msgTypes[12]
+	mi := &file_pkg_proto_routing_routing_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1263,7 +1335,7 @@ func (x *PartitionFleetResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PartitionFleetResponse.ProtoReflect.Descriptor instead.
 func (*PartitionFleetResponse) Descriptor() ([]byte, []int) {
-	return file_pkg_proto_routing_routing_proto_rawDescGZIP(), []int{12}
+	return file_pkg_proto_routing_routing_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *PartitionFleetResponse) GetAssignments() map[string]*ShipTour {
@@ -1317,7 +1389,7 @@ type ShipTour struct {
 
 func (x *ShipTour) Reset() {
 	*x = ShipTour{}
-	mi := &file_pkg_proto_routing_routing_proto_msgTypes[13]
+	mi := &file_pkg_proto_routing_routing_proto_msgTypes[14]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1329,7 +1401,7 @@ func (x *ShipTour) String() string {
 func (*ShipTour) ProtoMessage() {}
 
 func (x *ShipTour) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_proto_routing_routing_proto_msgTypes[13]
+	mi := &file_pkg_proto_routing_routing_proto_msgTypes[14]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1342,7 +1414,7 @@ func (x *ShipTour) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ShipTour.ProtoReflect.Descriptor instead.
 func (*ShipTour) Descriptor() ([]byte, []int) {
-	return file_pkg_proto_routing_routing_proto_rawDescGZIP(), []int{13}
+	return file_pkg_proto_routing_routing_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *ShipTour) GetWaypoints() []string {
@@ -1392,7 +1464,7 @@ type MarketGoodSnapshot struct {
 
 func (x *MarketGoodSnapshot) Reset() {
 	*x = MarketGoodSnapshot{}
-	mi := &file_pkg_proto_routing_routing_proto_msgTypes[14]
+	mi := &file_pkg_proto_routing_routing_proto_msgTypes[15]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1404,7 +1476,7 @@ func (x *MarketGoodSnapshot) String() string {
 func (*MarketGoodSnapshot) ProtoMessage() {}
 
 func (x *MarketGoodSnapshot) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_proto_routing_routing_proto_msgTypes[14]
+	mi := &file_pkg_proto_routing_routing_proto_msgTypes[15]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1417,7 +1489,7 @@ func (x *MarketGoodSnapshot) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use MarketGoodSnapshot.ProtoReflect.Descriptor instead.
 func (*MarketGoodSnapshot) Descriptor() ([]byte, []int) {
-	return file_pkg_proto_routing_routing_proto_rawDescGZIP(), []int{14}
+	return file_pkg_proto_routing_routing_proto_rawDescGZIP(), []int{15}
 }
 
 func (x *MarketGoodSnapshot) GetWaypointSymbol() string {
@@ -1500,7 +1572,7 @@ type TourShip struct {
 
 func (x *TourShip) Reset() {
 	*x = TourShip{}
-	mi := &file_pkg_proto_routing_routing_proto_msgTypes[15]
+	mi := &file_pkg_proto_routing_routing_proto_msgTypes[16]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1512,7 +1584,7 @@ func (x *TourShip) String() string {
 func (*TourShip) ProtoMessage() {}
 
 func (x *TourShip) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_proto_routing_routing_proto_msgTypes[15]
+	mi := &file_pkg_proto_routing_routing_proto_msgTypes[16]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1525,7 +1597,7 @@ func (x *TourShip) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TourShip.ProtoReflect.Descriptor instead.
 func (*TourShip) Descriptor() ([]byte, []int) {
-	return file_pkg_proto_routing_routing_proto_rawDescGZIP(), []int{15}
+	return file_pkg_proto_routing_routing_proto_rawDescGZIP(), []int{16}
 }
 
 func (x *TourShip) GetShipSymbol() string {
@@ -1594,7 +1666,7 @@ type TourCargoItem struct {
 
 func (x *TourCargoItem) Reset() {
 	*x = TourCargoItem{}
-	mi := &file_pkg_proto_routing_routing_proto_msgTypes[16]
+	mi := &file_pkg_proto_routing_routing_proto_msgTypes[17]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1606,7 +1678,7 @@ func (x *TourCargoItem) String() string {
 func (*TourCargoItem) ProtoMessage() {}
 
 func (x *TourCargoItem) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_proto_routing_routing_proto_msgTypes[16]
+	mi := &file_pkg_proto_routing_routing_proto_msgTypes[17]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1619,7 +1691,7 @@ func (x *TourCargoItem) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TourCargoItem.ProtoReflect.Descriptor instead.
 func (*TourCargoItem) Descriptor() ([]byte, []int) {
-	return file_pkg_proto_routing_routing_proto_rawDescGZIP(), []int{16}
+	return file_pkg_proto_routing_routing_proto_rawDescGZIP(), []int{17}
 }
 
 func (x *TourCargoItem) GetGoodSymbol() string {
@@ -1668,7 +1740,7 @@ type TourConstraints struct {
 
 func (x *TourConstraints) Reset() {
 	*x = TourConstraints{}
-	mi := &file_pkg_proto_routing_routing_proto_msgTypes[17]
+	mi := &file_pkg_proto_routing_routing_proto_msgTypes[18]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1680,7 +1752,7 @@ func (x *TourConstraints) String() string {
 func (*TourConstraints) ProtoMessage() {}
 
 func (x *TourConstraints) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_proto_routing_routing_proto_msgTypes[17]
+	mi := &file_pkg_proto_routing_routing_proto_msgTypes[18]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1693,7 +1765,7 @@ func (x *TourConstraints) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TourConstraints.ProtoReflect.Descriptor instead.
 func (*TourConstraints) Descriptor() ([]byte, []int) {
-	return file_pkg_proto_routing_routing_proto_rawDescGZIP(), []int{17}
+	return file_pkg_proto_routing_routing_proto_rawDescGZIP(), []int{18}
 }
 
 func (x *TourConstraints) GetMaxHops() int32 {
@@ -1800,7 +1872,7 @@ type TourTrade struct {
 
 func (x *TourTrade) Reset() {
 	*x = TourTrade{}
-	mi := &file_pkg_proto_routing_routing_proto_msgTypes[18]
+	mi := &file_pkg_proto_routing_routing_proto_msgTypes[19]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1812,7 +1884,7 @@ func (x *TourTrade) String() string {
 func (*TourTrade) ProtoMessage() {}
 
 func (x *TourTrade) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_proto_routing_routing_proto_msgTypes[18]
+	mi := &file_pkg_proto_routing_routing_proto_msgTypes[19]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1825,7 +1897,7 @@ func (x *TourTrade) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TourTrade.ProtoReflect.Descriptor instead.
 func (*TourTrade) Descriptor() ([]byte, []int) {
-	return file_pkg_proto_routing_routing_proto_rawDescGZIP(), []int{18}
+	return file_pkg_proto_routing_routing_proto_rawDescGZIP(), []int{19}
 }
 
 func (x *TourTrade) GetGoodSymbol() string {
@@ -1885,7 +1957,7 @@ type TradeTourLeg struct {
 
 func (x *TradeTourLeg) Reset() {
 	*x = TradeTourLeg{}
-	mi := &file_pkg_proto_routing_routing_proto_msgTypes[19]
+	mi := &file_pkg_proto_routing_routing_proto_msgTypes[20]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1897,7 +1969,7 @@ func (x *TradeTourLeg) String() string {
 func (*TradeTourLeg) ProtoMessage() {}
 
 func (x *TradeTourLeg) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_proto_routing_routing_proto_msgTypes[19]
+	mi := &file_pkg_proto_routing_routing_proto_msgTypes[20]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1910,7 +1982,7 @@ func (x *TradeTourLeg) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TradeTourLeg.ProtoReflect.Descriptor instead.
 func (*TradeTourLeg) Descriptor() ([]byte, []int) {
-	return file_pkg_proto_routing_routing_proto_rawDescGZIP(), []int{19}
+	return file_pkg_proto_routing_routing_proto_rawDescGZIP(), []int{20}
 }
 
 func (x *TradeTourLeg) GetWaypointSymbol() string {
@@ -1960,7 +2032,7 @@ type RejectedTour struct {
 
 func (x *RejectedTour) Reset() {
 	*x = RejectedTour{}
-	mi := &file_pkg_proto_routing_routing_proto_msgTypes[20]
+	mi := &file_pkg_proto_routing_routing_proto_msgTypes[21]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1972,7 +2044,7 @@ func (x *RejectedTour) String() string {
 func (*RejectedTour) ProtoMessage() {}
 
 func (x *RejectedTour) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_proto_routing_routing_proto_msgTypes[20]
+	mi := &file_pkg_proto_routing_routing_proto_msgTypes[21]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1985,7 +2057,7 @@ func (x *RejectedTour) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RejectedTour.ProtoReflect.Descriptor instead.
 func (*RejectedTour) Descriptor() ([]byte, []int) {
-	return file_pkg_proto_routing_routing_proto_rawDescGZIP(), []int{20}
+	return file_pkg_proto_routing_routing_proto_rawDescGZIP(), []int{21}
 }
 
 func (x *RejectedTour) GetSummary() string {
@@ -2019,7 +2091,7 @@ type TourWaypoint struct {
 
 func (x *TourWaypoint) Reset() {
 	*x = TourWaypoint{}
-	mi := &file_pkg_proto_routing_routing_proto_msgTypes[21]
+	mi := &file_pkg_proto_routing_routing_proto_msgTypes[22]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2031,7 +2103,7 @@ func (x *TourWaypoint) String() string {
 func (*TourWaypoint) ProtoMessage() {}
 
 func (x *TourWaypoint) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_proto_routing_routing_proto_msgTypes[21]
+	mi := &file_pkg_proto_routing_routing_proto_msgTypes[22]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2044,7 +2116,7 @@ func (x *TourWaypoint) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TourWaypoint.ProtoReflect.Descriptor instead.
 func (*TourWaypoint) Descriptor() ([]byte, []int) {
-	return file_pkg_proto_routing_routing_proto_rawDescGZIP(), []int{21}
+	return file_pkg_proto_routing_routing_proto_rawDescGZIP(), []int{22}
 }
 
 func (x *TourWaypoint) GetSymbol() string {
@@ -2099,7 +2171,7 @@ type DepositCandidate struct {
 
 func (x *DepositCandidate) Reset() {
 	*x = DepositCandidate{}
-	mi := &file_pkg_proto_routing_routing_proto_msgTypes[22]
+	mi := &file_pkg_proto_routing_routing_proto_msgTypes[23]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2111,7 +2183,7 @@ func (x *DepositCandidate) String() string {
 func (*DepositCandidate) ProtoMessage() {}
 
 func (x *DepositCandidate) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_proto_routing_routing_proto_msgTypes[22]
+	mi := &file_pkg_proto_routing_routing_proto_msgTypes[23]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2124,7 +2196,7 @@ func (x *DepositCandidate) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DepositCandidate.ProtoReflect.Descriptor instead.
 func (*DepositCandidate) Descriptor() ([]byte, []int) {
-	return file_pkg_proto_routing_routing_proto_rawDescGZIP(), []int{22}
+	return file_pkg_proto_routing_routing_proto_rawDescGZIP(), []int{23}
 }
 
 func (x *DepositCandidate) GetGoodSymbol() string {
@@ -2193,7 +2265,7 @@ type MarketAbsorption struct {
 
 func (x *MarketAbsorption) Reset() {
 	*x = MarketAbsorption{}
-	mi := &file_pkg_proto_routing_routing_proto_msgTypes[23]
+	mi := &file_pkg_proto_routing_routing_proto_msgTypes[24]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2205,7 +2277,7 @@ func (x *MarketAbsorption) String() string {
 func (*MarketAbsorption) ProtoMessage() {}
 
 func (x *MarketAbsorption) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_proto_routing_routing_proto_msgTypes[23]
+	mi := &file_pkg_proto_routing_routing_proto_msgTypes[24]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2218,7 +2290,7 @@ func (x *MarketAbsorption) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use MarketAbsorption.ProtoReflect.Descriptor instead.
 func (*MarketAbsorption) Descriptor() ([]byte, []int) {
-	return file_pkg_proto_routing_routing_proto_rawDescGZIP(), []int{23}
+	return file_pkg_proto_routing_routing_proto_rawDescGZIP(), []int{24}
 }
 
 func (x *MarketAbsorption) GetWaypointSymbol() string {
@@ -2280,7 +2352,7 @@ type StockSource struct {
 
 func (x *StockSource) Reset() {
 	*x = StockSource{}
-	mi := &file_pkg_proto_routing_routing_proto_msgTypes[24]
+	mi := &file_pkg_proto_routing_routing_proto_msgTypes[25]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2292,7 +2364,7 @@ func (x *StockSource) String() string {
 func (*StockSource) ProtoMessage() {}
 
 func (x *StockSource) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_proto_routing_routing_proto_msgTypes[24]
+	mi := &file_pkg_proto_routing_routing_proto_msgTypes[25]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2305,7 +2377,7 @@ func (x *StockSource) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use StockSource.ProtoReflect.Descriptor instead.
 func (*StockSource) Descriptor() ([]byte, []int) {
-	return file_pkg_proto_routing_routing_proto_rawDescGZIP(), []int{24}
+	return file_pkg_proto_routing_routing_proto_rawDescGZIP(), []int{25}
 }
 
 func (x *StockSource) GetGoodSymbol() string {
@@ -2367,7 +2439,7 @@ type OptimizeTradeTourRequest struct {
 
 func (x *OptimizeTradeTourRequest) Reset() {
 	*x = OptimizeTradeTourRequest{}
-	mi := &file_pkg_proto_routing_routing_proto_msgTypes[25]
+	mi := &file_pkg_proto_routing_routing_proto_msgTypes[26]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2379,7 +2451,7 @@ func (x *OptimizeTradeTourRequest) String() string {
 func (*OptimizeTradeTourRequest) ProtoMessage() {}
 
 func (x *OptimizeTradeTourRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_proto_routing_routing_proto_msgTypes[25]
+	mi := &file_pkg_proto_routing_routing_proto_msgTypes[26]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2392,7 +2464,7 @@ func (x *OptimizeTradeTourRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use OptimizeTradeTourRequest.ProtoReflect.Descriptor instead.
 func (*OptimizeTradeTourRequest) Descriptor() ([]byte, []int) {
-	return file_pkg_proto_routing_routing_proto_rawDescGZIP(), []int{25}
+	return file_pkg_proto_routing_routing_proto_rawDescGZIP(), []int{26}
 }
 
 func (x *OptimizeTradeTourRequest) GetSnapshot() []*MarketGoodSnapshot {
@@ -2481,7 +2553,7 @@ type OptimizeTradeTourResponse struct {
 
 func (x *OptimizeTradeTourResponse) Reset() {
 	*x = OptimizeTradeTourResponse{}
-	mi := &file_pkg_proto_routing_routing_proto_msgTypes[26]
+	mi := &file_pkg_proto_routing_routing_proto_msgTypes[27]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2493,7 +2565,7 @@ func (x *OptimizeTradeTourResponse) String() string {
 func (*OptimizeTradeTourResponse) ProtoMessage() {}
 
 func (x *OptimizeTradeTourResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_proto_routing_routing_proto_msgTypes[26]
+	mi := &file_pkg_proto_routing_routing_proto_msgTypes[27]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2506,7 +2578,7 @@ func (x *OptimizeTradeTourResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use OptimizeTradeTourResponse.ProtoReflect.Descriptor instead.
 func (*OptimizeTradeTourResponse) Descriptor() ([]byte, []int) {
-	return file_pkg_proto_routing_routing_proto_rawDescGZIP(), []int{26}
+	return file_pkg_proto_routing_routing_proto_rawDescGZIP(), []int{27}
 }
 
 func (x *OptimizeTradeTourResponse) GetFeasible() bool {
@@ -2576,7 +2648,7 @@ var File_pkg_proto_routing_routing_proto protoreflect.FileDescriptor
 
 const file_pkg_proto_routing_routing_proto_rawDesc = "" +
 	"\n" +
-	"\x1fpkg/proto/routing/routing.proto\x12\arouting\"\xeb\x02\n" +
+	"\x1fpkg/proto/routing/routing.proto\x12\arouting\"\xa2\x03\n" +
 	"\x10PlanRouteRequest\x12#\n" +
 	"\rsystem_symbol\x18\x01 \x01(\tR\fsystemSymbol\x12%\n" +
 	"\x0estart_waypoint\x18\x02 \x01(\tR\rstartWaypoint\x12#\n" +
@@ -2586,7 +2658,15 @@ const file_pkg_proto_routing_routing_proto_rawDesc = "" +
 	"\fengine_speed\x18\x06 \x01(\x05R\vengineSpeed\x12/\n" +
 	"\twaypoints\x18\a \x03(\v2\x11.routing.WaypointR\twaypoints\x12%\n" +
 	"\x0efuel_efficient\x18\b \x01(\bR\rfuelEfficient\x12#\n" +
-	"\rprefer_cruise\x18\t \x01(\bR\fpreferCruise\"\x8c\x01\n" +
+	"\rprefer_cruise\x18\t \x01(\bR\fpreferCruise\x125\n" +
+	"\tobjective\x18\n" +
+	" \x01(\v2\x17.routing.RouteObjectiveR\tobjective\"\x80\x01\n" +
+	"\x0eRouteObjective\x12\x1f\n" +
+	"\vtime_weight\x18\x01 \x01(\x01R\n" +
+	"timeWeight\x12\x1f\n" +
+	"\vfuel_weight\x18\x02 \x01(\x01R\n" +
+	"fuelWeight\x12,\n" +
+	"\x12refuel_stop_weight\x18\x03 \x01(\x01R\x10refuelStopWeight\"\x8c\x01\n" +
 	"\bWaypoint\x12\x16\n" +
 	"\x06symbol\x18\x01 \x01(\tR\x06symbol\x12\f\n" +
 	"\x01x\x18\x02 \x01(\x01R\x01x\x12\f\n" +
@@ -2830,80 +2910,82 @@ func file_pkg_proto_routing_routing_proto_rawDescGZIP() []byte {
 }
 
 var file_pkg_proto_routing_routing_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_pkg_proto_routing_routing_proto_msgTypes = make([]protoimpl.MessageInfo, 29)
+var file_pkg_proto_routing_routing_proto_msgTypes = make([]protoimpl.MessageInfo, 30)
 var file_pkg_proto_routing_routing_proto_goTypes = []any{
 	(RouteAction)(0),                   // 0: routing.RouteAction
 	(*PlanRouteRequest)(nil),           // 1: routing.PlanRouteRequest
-	(*Waypoint)(nil),                   // 2: routing.Waypoint
-	(*PlanRouteResponse)(nil),          // 3: routing.PlanRouteResponse
-	(*RouteStep)(nil),                  // 4: routing.RouteStep
-	(*OptimizeTourRequest)(nil),        // 5: routing.OptimizeTourRequest
-	(*OptimizeTourResponse)(nil),       // 6: routing.OptimizeTourResponse
-	(*OptimizeFueledTourRequest)(nil),  // 7: routing.OptimizeFueledTourRequest
-	(*OptimizeFueledTourResponse)(nil), // 8: routing.OptimizeFueledTourResponse
-	(*TourLeg)(nil),                    // 9: routing.TourLeg
-	(*IntermediateStop)(nil),           // 10: routing.IntermediateStop
-	(*PartitionFleetRequest)(nil),      // 11: routing.PartitionFleetRequest
-	(*ShipConfig)(nil),                 // 12: routing.ShipConfig
-	(*PartitionFleetResponse)(nil),     // 13: routing.PartitionFleetResponse
-	(*ShipTour)(nil),                   // 14: routing.ShipTour
-	(*MarketGoodSnapshot)(nil),         // 15: routing.MarketGoodSnapshot
-	(*TourShip)(nil),                   // 16: routing.TourShip
-	(*TourCargoItem)(nil),              // 17: routing.TourCargoItem
-	(*TourConstraints)(nil),            // 18: routing.TourConstraints
-	(*TourTrade)(nil),                  // 19: routing.TourTrade
-	(*TradeTourLeg)(nil),               // 20: routing.TradeTourLeg
-	(*RejectedTour)(nil),               // 21: routing.RejectedTour
-	(*TourWaypoint)(nil),               // 22: routing.TourWaypoint
-	(*DepositCandidate)(nil),           // 23: routing.DepositCandidate
-	(*MarketAbsorption)(nil),           // 24: routing.MarketAbsorption
-	(*StockSource)(nil),                // 25: routing.StockSource
-	(*OptimizeTradeTourRequest)(nil),   // 26: routing.OptimizeTradeTourRequest
-	(*OptimizeTradeTourResponse)(nil),  // 27: routing.OptimizeTradeTourResponse
-	nil,                                // 28: routing.PartitionFleetRequest.ShipConfigsEntry
-	nil,                                // 29: routing.PartitionFleetResponse.AssignmentsEntry
+	(*RouteObjective)(nil),             // 2: routing.RouteObjective
+	(*Waypoint)(nil),                   // 3: routing.Waypoint
+	(*PlanRouteResponse)(nil),          // 4: routing.PlanRouteResponse
+	(*RouteStep)(nil),                  // 5: routing.RouteStep
+	(*OptimizeTourRequest)(nil),        // 6: routing.OptimizeTourRequest
+	(*OptimizeTourResponse)(nil),       // 7: routing.OptimizeTourResponse
+	(*OptimizeFueledTourRequest)(nil),  // 8: routing.OptimizeFueledTourRequest
+	(*OptimizeFueledTourResponse)(nil), // 9: routing.OptimizeFueledTourResponse
+	(*TourLeg)(nil),                    // 10: routing.TourLeg
+	(*IntermediateStop)(nil),           // 11: routing.IntermediateStop
+	(*PartitionFleetRequest)(nil),      // 12: routing.PartitionFleetRequest
+	(*ShipConfig)(nil),                 // 13: routing.ShipConfig
+	(*PartitionFleetResponse)(nil),     // 14: routing.PartitionFleetResponse
+	(*ShipTour)(nil),                   // 15: routing.ShipTour
+	(*MarketGoodSnapshot)(nil),         // 16: routing.MarketGoodSnapshot
+	(*TourShip)(nil),                   // 17: routing.TourShip
+	(*TourCargoItem)(nil),              // 18: routing.TourCargoItem
+	(*TourConstraints)(nil),            // 19: routing.TourConstraints
+	(*TourTrade)(nil),                  // 20: routing.TourTrade
+	(*TradeTourLeg)(nil),               // 21: routing.TradeTourLeg
+	(*RejectedTour)(nil),               // 22: routing.RejectedTour
+	(*TourWaypoint)(nil),               // 23: routing.TourWaypoint
+	(*DepositCandidate)(nil),           // 24: routing.DepositCandidate
+	(*MarketAbsorption)(nil),           // 25: routing.MarketAbsorption
+	(*StockSource)(nil),                // 26: routing.StockSource
+	(*OptimizeTradeTourRequest)(nil),   // 27: routing.OptimizeTradeTourRequest
+	(*OptimizeTradeTourResponse)(nil),  // 28: routing.OptimizeTradeTourResponse
+	nil,                                // 29: routing.PartitionFleetRequest.ShipConfigsEntry
+	nil,                                // 30: routing.PartitionFleetResponse.AssignmentsEntry
 }
 var file_pkg_proto_routing_routing_proto_depIdxs = []int32{
-	2,  // 0: routing.PlanRouteRequest.waypoints:type_name -> routing.Waypoint
-	4,  // 1: routing.PlanRouteResponse.steps:type_name -> routing.RouteStep
-	0,  // 2: routing.RouteStep.action:type_name -> routing.RouteAction
-	2,  // 3: routing.OptimizeTourRequest.all_waypoints:type_name -> routing.Waypoint
-	4,  // 4: routing.OptimizeTourResponse.route_steps:type_name -> routing.RouteStep
-	2,  // 5: routing.OptimizeFueledTourRequest.all_waypoints:type_name -> routing.Waypoint
-	9,  // 6: routing.OptimizeFueledTourResponse.legs:type_name -> routing.TourLeg
-	10, // 7: routing.TourLeg.intermediate_stops:type_name -> routing.IntermediateStop
-	28, // 8: routing.PartitionFleetRequest.ship_configs:type_name -> routing.PartitionFleetRequest.ShipConfigsEntry
-	2,  // 9: routing.PartitionFleetRequest.all_waypoints:type_name -> routing.Waypoint
-	29, // 10: routing.PartitionFleetResponse.assignments:type_name -> routing.PartitionFleetResponse.AssignmentsEntry
-	4,  // 11: routing.ShipTour.route_steps:type_name -> routing.RouteStep
-	17, // 12: routing.TourShip.cargo:type_name -> routing.TourCargoItem
-	19, // 13: routing.TradeTourLeg.trades:type_name -> routing.TourTrade
-	15, // 14: routing.OptimizeTradeTourRequest.snapshot:type_name -> routing.MarketGoodSnapshot
-	16, // 15: routing.OptimizeTradeTourRequest.ship:type_name -> routing.TourShip
-	18, // 16: routing.OptimizeTradeTourRequest.constraints:type_name -> routing.TourConstraints
-	22, // 17: routing.OptimizeTradeTourRequest.waypoints:type_name -> routing.TourWaypoint
-	23, // 18: routing.OptimizeTradeTourRequest.deposit_candidates:type_name -> routing.DepositCandidate
-	24, // 19: routing.OptimizeTradeTourRequest.absorption:type_name -> routing.MarketAbsorption
-	25, // 20: routing.OptimizeTradeTourRequest.stock_sources:type_name -> routing.StockSource
-	20, // 21: routing.OptimizeTradeTourResponse.legs:type_name -> routing.TradeTourLeg
-	21, // 22: routing.OptimizeTradeTourResponse.top_rejected:type_name -> routing.RejectedTour
-	12, // 23: routing.PartitionFleetRequest.ShipConfigsEntry.value:type_name -> routing.ShipConfig
-	14, // 24: routing.PartitionFleetResponse.AssignmentsEntry.value:type_name -> routing.ShipTour
-	1,  // 25: routing.RoutingService.PlanRoute:input_type -> routing.PlanRouteRequest
-	5,  // 26: routing.RoutingService.OptimizeTour:input_type -> routing.OptimizeTourRequest
-	7,  // 27: routing.RoutingService.OptimizeFueledTour:input_type -> routing.OptimizeFueledTourRequest
-	11, // 28: routing.RoutingService.PartitionFleet:input_type -> routing.PartitionFleetRequest
-	26, // 29: routing.RoutingService.OptimizeTradeTour:input_type -> routing.OptimizeTradeTourRequest
-	3,  // 30: routing.RoutingService.PlanRoute:output_type -> routing.PlanRouteResponse
-	6,  // 31: routing.RoutingService.OptimizeTour:output_type -> routing.OptimizeTourResponse
-	8,  // 32: routing.RoutingService.OptimizeFueledTour:output_type -> routing.OptimizeFueledTourResponse
-	13, // 33: routing.RoutingService.PartitionFleet:output_type -> routing.PartitionFleetResponse
-	27, // 34: routing.RoutingService.OptimizeTradeTour:output_type -> routing.OptimizeTradeTourResponse
-	30, // [30:35] is the sub-list for method output_type
-	25, // [25:30] is the sub-list for method input_type
-	25, // [25:25] is the sub-list for extension type_name
-	25, // [25:25] is the sub-list for extension extendee
-	0,  // [0:25] is the sub-list for field type_name
+	3,  // 0: routing.PlanRouteRequest.waypoints:type_name -> routing.Waypoint
+	2,  // 1: routing.PlanRouteRequest.objective:type_name -> routing.RouteObjective
+	5,  // 2: routing.PlanRouteResponse.steps:type_name -> routing.RouteStep
+	0,  // 3: routing.RouteStep.action:type_name -> routing.RouteAction
+	3,  // 4: routing.OptimizeTourRequest.all_waypoints:type_name -> routing.Waypoint
+	5,  // 5: routing.OptimizeTourResponse.route_steps:type_name -> routing.RouteStep
+	3,  // 6: routing.OptimizeFueledTourRequest.all_waypoints:type_name -> routing.Waypoint
+	10, // 7: routing.OptimizeFueledTourResponse.legs:type_name -> routing.TourLeg
+	11, // 8: routing.TourLeg.intermediate_stops:type_name -> routing.IntermediateStop
+	29, // 9: routing.PartitionFleetRequest.ship_configs:type_name -> routing.PartitionFleetRequest.ShipConfigsEntry
+	3,  // 10: routing.PartitionFleetRequest.all_waypoints:type_name -> routing.Waypoint
+	30, // 11: routing.PartitionFleetResponse.assignments:type_name -> routing.PartitionFleetResponse.AssignmentsEntry
+	5,  // 12: routing.ShipTour.route_steps:type_name -> routing.RouteStep
+	18, // 13: routing.TourShip.cargo:type_name -> routing.TourCargoItem
+	20, // 14: routing.TradeTourLeg.trades:type_name -> routing.TourTrade
+	16, // 15: routing.OptimizeTradeTourRequest.snapshot:type_name -> routing.MarketGoodSnapshot
+	17, // 16: routing.OptimizeTradeTourRequest.ship:type_name -> routing.TourShip
+	19, // 17: routing.OptimizeTradeTourRequest.constraints:type_name -> routing.TourConstraints
+	23, // 18: routing.OptimizeTradeTourRequest.waypoints:type_name -> routing.TourWaypoint
+	24, // 19: routing.OptimizeTradeTourRequest.deposit_candidates:type_name -> routing.DepositCandidate
+	25, // 20: routing.OptimizeTradeTourRequest.absorption:type_name -> routing.MarketAbsorption
+	26, // 21: routing.OptimizeTradeTourRequest.stock_sources:type_name -> routing.StockSource
+	21, // 22: routing.OptimizeTradeTourResponse.legs:type_name -> routing.TradeTourLeg
+	22, // 23: routing.OptimizeTradeTourResponse.top_rejected:type_name -> routing.RejectedTour
+	13, // 24: routing.PartitionFleetRequest.ShipConfigsEntry.value:type_name -> routing.ShipConfig
+	15, // 25: routing.PartitionFleetResponse.AssignmentsEntry.value:type_name -> routing.ShipTour
+	1,  // 26: routing.RoutingService.PlanRoute:input_type -> routing.PlanRouteRequest
+	6,  // 27: routing.RoutingService.OptimizeTour:input_type -> routing.OptimizeTourRequest
+	8,  // 28: routing.RoutingService.OptimizeFueledTour:input_type -> routing.OptimizeFueledTourRequest
+	12, // 29: routing.RoutingService.PartitionFleet:input_type -> routing.PartitionFleetRequest
+	27, // 30: routing.RoutingService.OptimizeTradeTour:input_type -> routing.OptimizeTradeTourRequest
+	4,  // 31: routing.RoutingService.PlanRoute:output_type -> routing.PlanRouteResponse
+	7,  // 32: routing.RoutingService.OptimizeTour:output_type -> routing.OptimizeTourResponse
+	9,  // 33: routing.RoutingService.OptimizeFueledTour:output_type -> routing.OptimizeFueledTourResponse
+	14, // 34: routing.RoutingService.PartitionFleet:output_type -> routing.PartitionFleetResponse
+	28, // 35: routing.RoutingService.OptimizeTradeTour:output_type -> routing.OptimizeTradeTourResponse
+	31, // [31:36] is the sub-list for method output_type
+	26, // [26:31] is the sub-list for method input_type
+	26, // [26:26] is the sub-list for extension type_name
+	26, // [26:26] is the sub-list for extension extendee
+	0,  // [0:26] is the sub-list for field type_name
 }
 
 func init() { file_pkg_proto_routing_routing_proto_init() }
@@ -2911,21 +2993,21 @@ func file_pkg_proto_routing_routing_proto_init() {
 	if File_pkg_proto_routing_routing_proto != nil {
 		return
 	}
-	file_pkg_proto_routing_routing_proto_msgTypes[1].OneofWrappers = []any{}
 	file_pkg_proto_routing_routing_proto_msgTypes[2].OneofWrappers = []any{}
 	file_pkg_proto_routing_routing_proto_msgTypes[3].OneofWrappers = []any{}
-	file_pkg_proto_routing_routing_proto_msgTypes[5].OneofWrappers = []any{}
+	file_pkg_proto_routing_routing_proto_msgTypes[4].OneofWrappers = []any{}
 	file_pkg_proto_routing_routing_proto_msgTypes[6].OneofWrappers = []any{}
 	file_pkg_proto_routing_routing_proto_msgTypes[7].OneofWrappers = []any{}
 	file_pkg_proto_routing_routing_proto_msgTypes[8].OneofWrappers = []any{}
-	file_pkg_proto_routing_routing_proto_msgTypes[12].OneofWrappers = []any{}
+	file_pkg_proto_routing_routing_proto_msgTypes[9].OneofWrappers = []any{}
+	file_pkg_proto_routing_routing_proto_msgTypes[13].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_pkg_proto_routing_routing_proto_rawDesc), len(file_pkg_proto_routing_routing_proto_rawDesc)),
 			NumEnums:      1,
-			NumMessages:   29,
+			NumMessages:   30,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
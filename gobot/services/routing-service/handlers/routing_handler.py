@@ -71,7 +71,8 @@ class RoutingServiceHandler(TourHandlerMixin, routing_pb2_grpc.RoutingServiceSer
                 fuel_capacity=request.fuel_capacity,
                 engine_speed=request.engine_speed,
                 fuel_efficient=request.fuel_efficient,
-                prefer_cruise=request.prefer_cruise
+                prefer_cruise=request.prefer_cruise,
+                objective=self._route_objective(request)
             )
 
             if result is None:
@@ -402,6 +403,18 @@ class RoutingServiceHandler(TourHandlerMixin, routing_pb2_grpc.RoutingServiceSer
                 error_message=str(e)
             )
 
+    def _route_objective(self, request):
+        """
+        Extract the (time, fuel, refuel stop) weights from a PlanRouteRequest.
+
+        Returns None when the request carries no objective, which the engine
+        treats as fastest-route planning.
+        """
+        if not request.HasField('objective'):
+            return None
+        objective = request.objective
+        return (objective.time_weight, objective.fuel_weight, objective.refuel_stop_weight)
+
     def _build_waypoint_graph(self, waypoints_pb) -> Dict[str, Waypoint]:
         """
         Convert protobuf waypoints to internal graph representation.
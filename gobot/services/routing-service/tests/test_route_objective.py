@@ -0,0 +1,48 @@
+# gobot/services/routing-service/tests/test_route_objective.py
+"""Weighted route objectives for find_optimal_path.
+
+The search minimizes time_weight*seconds + fuel_weight*fuel +
+refuel_stop_weight*refuels. An unset or all-zero objective must keep the
+legacy fastest-route answer.
+"""
+from utils.routing_engine import ORToolsRoutingEngine, Waypoint, resolve_objective
+
+
+def graph():
+    # Two 150-unit legs with fuel at A and B: BURN is fastest, CRUISE burns
+    # half the fuel.
+    return {
+        "A": Waypoint(symbol="A", x=0, y=0, has_fuel=True),
+        "B": Waypoint(symbol="B", x=150, y=0, has_fuel=True),
+        "C": Waypoint(symbol="C", x=300, y=0, has_fuel=False),
+    }
+
+
+def plan(objective=None):
+    engine = ORToolsRoutingEngine()
+    return engine.find_optimal_path(graph(), "A", "C", current_fuel=400,
+                                    fuel_capacity=400, engine_speed=30,
+                                    objective=objective)
+
+
+def modes(route):
+    return [step["mode"] for step in route["steps"] if step["action"] == "TRAVEL"]
+
+
+def test_zero_objective_resolves_to_fastest():
+    assert resolve_objective(None) == (1.0, 0.0, 0.0)
+    assert resolve_objective((0.0, 0.0, 0.0)) == (1.0, 0.0, 0.0)
+    assert resolve_objective((0.2, 5.0, 120.0)) == (0.2, 5.0, 120.0)
+
+
+def test_unset_objective_matches_time_only_objective():
+    assert plan() == plan((1.0, 0.0, 0.0))
+
+
+def test_fuel_weight_trades_time_for_fuel():
+    fastest = plan()
+    frugal = plan((0.01, 10.0, 0.0))
+
+    assert frugal["total_fuel_cost"] < fastest["total_fuel_cost"]
+    assert frugal["total_time"] > fastest["total_time"]
+    assert "BURN" not in modes(frugal)
@@ -63,6 +63,13 @@ class Waypoint:
         return other.symbol in self.orbitals or self.symbol in other.orbitals
 
 
+def resolve_objective(objective: Optional[Tuple[float, float, float]]) -> Tuple[float, float, float]:
+    """Return the (time, fuel, refuel stop) weights to plan with; unset means time only."""
+    if not objective or not any(objective):
+        return (1.0, 0.0, 0.0)
+    return objective
+
+
 class ORToolsRoutingEngine:
     """
     Routing engine using OR-Tools for optimization.
@@ -108,7 +115,8 @@ class ORToolsRoutingEngine:
         fuel_capacity: int,
         engine_speed: int,
         fuel_efficient: bool = False,
-        prefer_cruise: bool = False
+        prefer_cruise: bool = False,
+        objective: Optional[Tuple[float, float, float]] = None
     ) -> Optional[Dict[str, Any]]:
         """
         Find optimal path using Dijkstra with fuel constraints.
@@ -118,6 +126,9 @@ class ORToolsRoutingEngine:
                            for fuel preservation (used by mining transports)
             prefer_cruise: When True, prefer CRUISE over BURN for fuel efficiency
                           (DRIFT penalty still applies unless fuel_efficient is True)
+            objective: (time_weight, fuel_weight, refuel_stop_weight). Dijkstra minimizes
+                       time_weight*seconds + fuel_weight*fuel + refuel_stop_weight*refuels.
+                       None or all-zero weights minimize travel time alone.
 
         Returns dict with:
         - steps: List of route steps (TRAVEL or REFUEL actions)
@@ -143,17 +154,19 @@ class ORToolsRoutingEngine:
         if fuel_capacity == 0:
             return self._find_path_no_fuel(graph, start, goal, engine_speed)
 
-        # Priority queue: (total_time, counter, waypoint, fuel_remaining, total_fuel_used, path)
-        pq: List[Tuple[int, int, str, int, int, List[Dict[str, Any]]]] = []
+        time_weight, fuel_weight, refuel_weight = resolve_objective(objective)
+
+        # Priority queue: (cost, counter, waypoint, fuel_remaining, total_fuel_used, total_time, path)
+        pq: List[Tuple[float, int, str, int, int, int, List[Dict[str, Any]]]] = []
         counter = 0
-        heapq.heappush(pq, (0, counter, start, current_fuel, 0, []))
+        heapq.heappush(pq, (0.0, counter, start, current_fuel, 0, 0, []))
         counter += 1
 
         # Track best cost to reach each (waypoint, fuel_level) state
-        visited: Dict[Tuple[str, int], int] = {}
+        visited: Dict[Tuple[str, int], float] = {}
 
         while pq:
-            total_time, _, current, fuel_remaining, total_fuel_used, path = heapq.heappop(pq)
+            cost, _, current, fuel_remaining, total_fuel_used, total_time, path = heapq.heappop(pq)
 
             # Goal check
             if current == goal:
@@ -166,9 +179,9 @@ class ORToolsRoutingEngine:
 
             # State deduplication
             state = (current, fuel_remaining // 10)
-            if state in visited and visited[state] <= total_time:
+            if state in visited and visited[state] <= cost:
                 continue
-            visited[state] = total_time
+            visited[state] = cost
 
             current_wp = graph[current]
 
@@ -202,11 +215,12 @@ class ORToolsRoutingEngine:
                     }
                     new_path = path + [refuel_step]
                     heapq.heappush(pq, (
-                        total_time,
+                        cost + refuel_weight,
                         counter,
                         current,
                         fuel_capacity,
                         total_fuel_used,
+                        total_time,
                         new_path
                     ))
                     counter += 1
@@ -226,11 +240,12 @@ class ORToolsRoutingEngine:
                 new_path = path + [refuel_step]
 
                 heapq.heappush(pq, (
-                    total_time,
+                    cost + refuel_weight,
                     counter,
                     current,
                     fuel_capacity,
                     total_fuel_used,
+                    total_time,
                     new_path
                 ))
                 counter += 1
@@ -269,11 +284,12 @@ class ORToolsRoutingEngine:
                     new_fuel_used = total_fuel_used + fuel_cost
 
                     heapq.heappush(pq, (
-                        new_time,
+                        cost + time_weight * travel_time,
                         counter,
                         neighbor_symbol,
                         new_fuel,
                         new_fuel_used,
+                        new_time,
                         new_path
                     ))
                     counter += 1
@@ -339,11 +355,12 @@ class ORToolsRoutingEngine:
                     new_fuel_used = total_fuel_used + fuel_cost
 
                     heapq.heappush(pq, (
-                        new_time,
+                        cost + time_weight * travel_time + fuel_weight * fuel_cost,
                         counter,
                         neighbor_symbol,
                         new_fuel,
                         new_fuel_used,
+                        new_time,
                         new_path
                     ))
                     counter += 1